@@ -0,0 +1,125 @@
+// Command fhirgen generates Go models from the FHIR StructureDefinitions of
+// a specification directory.
+//
+// Usage:
+//
+//	fhirgen [flags]
+//
+// The flags are:
+//
+//	-spec dir
+//		directory of the specification JSON files (default "spec")
+//	-out dir
+//		output directory (default "r5")
+//	-package name
+//		name of the generated package (default "models")
+//	-resources list
+//		comma-separated resources to generate, all when empty
+//	-exclude list
+//		comma-separated resources not to generate
+//	-bson, -validation, -xml
+//		generate the BSON encoding, validation and the XML encoding
+//		(default true; turn off with e.g. -xml=false); the runtime files
+//		only used by validation, such as the FHIRPath engine, and by the
+//		BSON and XML encodings are left out with them
+//	-check
+//		write nothing, and exit with status 1 listing the files of the
+//		output directory that are out of date, or reporting a hand-written
+//		file that generation would overwrite
+//
+// The output only depends on the specification and the flags, so
+// regenerating unchanged input gives identical files. A manifest in the
+// output directory, fhirgen-manifest.json, records the generated files:
+// a run with the same specification files and flags over unedited output
+// does nothing, files with unchanged content are not rewritten, and only
+// files the generator wrote before are deleted, so hand-written files can
+// be kept in the output directory.
+package main
+
+//go:generate go run . -spec ../../spec -out ../../r5
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gruzdev-dev/fhir/gen"
+	"github.com/gruzdev-dev/fhir/tools/text"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("fhirgen: ")
+	g, check, err := parseFlags(os.Args[1:], os.Stderr)
+	if err != nil {
+		os.Exit(2)
+	}
+	if !check {
+		if err := g.Run(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	stale, err := g.Check()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(stale) > 0 {
+		fmt.Fprintf(os.Stderr, "fhirgen: %s is out of date with %s; regenerate it:\n", g.OutputPath, g.SpecPath)
+		for _, name := range stale {
+			fmt.Fprintf(os.Stderr, "\t%s\n", name)
+		}
+		os.Exit(1)
+	}
+}
+
+// parseFlags returns the generator the command line describes, and whether
+// it asks for a check.
+func parseFlags(args []string, output io.Writer) (*gen.Generator, bool, error) {
+	fs := flag.NewFlagSet("fhirgen", flag.ContinueOnError)
+	fs.SetOutput(output)
+	opts := gen.DefaultOptions()
+	specPath := fs.String("spec", "spec", "directory of the specification JSON files")
+	outputPath := fs.String("out", "r5", "output directory")
+	fs.StringVar(&opts.PackageName, "package", opts.PackageName, "name of the generated package")
+	resources := fs.String("resources", "", "comma-separated resources to generate, all when empty")
+	exclude := fs.String("exclude", "", "comma-separated resources not to generate")
+	fs.BoolVar(&opts.BSONTags, "bson", opts.BSONTags, "generate bson struct tags and BSON methods")
+	fs.BoolVar(&opts.Validation, "validation", opts.Validation, "generate validation, invariants, profiles and bindings")
+	fs.BoolVar(&opts.XML, "xml", opts.XML, "generate the FHIR XML encoding")
+	check := fs.Bool("check", false, "report out of date files instead of writing them")
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+	if fs.NArg() > 0 {
+		err := fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+		fmt.Fprintln(output, err)
+		fs.Usage()
+		return nil, false, err
+	}
+	if !text.IsValidGoIdentifier(opts.PackageName) {
+		err := fmt.Errorf("invalid package name %q", opts.PackageName)
+		fmt.Fprintln(output, err)
+		return nil, false, err
+	}
+	opts.Resources = splitList(*resources)
+	opts.ExcludeResources = splitList(*exclude)
+
+	g := gen.NewGenerator(*specPath, *outputPath)
+	g.Options = opts
+	return g, *check, nil
+}
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
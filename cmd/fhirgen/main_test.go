@@ -0,0 +1,49 @@
+package main
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/gruzdev-dev/fhir/gen"
+)
+
+func TestParseFlags(t *testing.T) {
+	g, check, err := parseFlags([]string{
+		"-spec", "specs/r5", "-out", "models", "-package", "fhir",
+		"-resources", "Patient, Observation", "-exclude", "Bundle",
+		"-bson=false", "-xml=false", "--check",
+	}, io.Discard)
+	if err != nil {
+		t.Fatalf("parseFlags() error = %v", err)
+	}
+	if !check {
+		t.Error("parseFlags() should ask for a check")
+	}
+	if g.SpecPath != "specs/r5" || g.OutputPath != "models" {
+		t.Errorf("parseFlags() paths = %q, %q", g.SpecPath, g.OutputPath)
+	}
+	want := gen.Options{
+		PackageName:      "fhir",
+		Resources:        []string{"Patient", "Observation"},
+		ExcludeResources: []string{"Bundle"},
+		Validation:       true,
+	}
+	if !reflect.DeepEqual(g.Options, want) {
+		t.Errorf("parseFlags() options = %+v, want %+v", g.Options, want)
+	}
+
+	g, check, err = parseFlags(nil, io.Discard)
+	if err != nil {
+		t.Fatalf("parseFlags() error = %v", err)
+	}
+	if check || g.SpecPath != "spec" || g.OutputPath != "r5" || !reflect.DeepEqual(g.Options, gen.DefaultOptions()) {
+		t.Errorf("parseFlags() defaults = %q, %q, %+v", g.SpecPath, g.OutputPath, g.Options)
+	}
+
+	for _, args := range [][]string{{"-package", "fhir-models"}, {"extra"}, {"-unknown"}} {
+		if _, _, err := parseFlags(args, io.Discard); err == nil {
+			t.Errorf("parseFlags(%q) should fail", args)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Package fhirpath evaluates FHIRPath expressions against the models of the
+// r5 package.
+//
+// Expressions navigate the generated structs directly, by the JSON names of
+// their fields:
+//
+//	expr := fhirpath.MustCompile("name.where(use = 'official').given")
+//	given, err := expr.Evaluate(patient)
+//
+// Items of a result are pointers to the generated structs, or one of
+// string, bool, int64, r5.Decimal, r5.Date, r5.DateTime, r5.Instant,
+// r5.Time and Quantity.
+package fhirpath
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gruzdev-dev/fhir/fhirpath/hook"
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// Expression is a compiled FHIRPath expression. It is safe for concurrent
+// use.
+type Expression struct {
+	compiled hook.Expression
+}
+
+// Quantity is a quantity computed by an expression, such as 4 'mg' or 3
+// days.
+type Quantity = models.FHIRPathQuantity
+
+// Compile parses a FHIRPath expression. Compiled expressions are cached by
+// their text, so compiling the same expression again is cheap.
+func Compile(expression string) (*Expression, error) {
+	compile, ok := hook.Lookup(reflect.TypeOf(Quantity{}).PkgPath())
+	if !ok {
+		return nil, errors.New("fhirpath: the r5 package was generated without the FHIRPath engine")
+	}
+	compiled, err := compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &Expression{compiled: compiled}, nil
+}
+
+// MustCompile is like Compile but panics if the expression cannot be
+// parsed.
+func MustCompile(expression string) *Expression {
+	e, err := Compile(expression)
+	if err != nil {
+		panic(fmt.Sprintf("fhirpath: Compile(%q): %v", expression, err))
+	}
+	return e
+}
+
+func (e *Expression) String() string {
+	return e.compiled.String()
+}
+
+// Evaluate evaluates e with input as $this and %context. When input is a
+// resource it is also %resource and %rootResource unless options set them.
+func (e *Expression) Evaluate(input any, opts ...Option) ([]any, error) {
+	var ctx hook.Context
+	for _, opt := range opts {
+		opt(&ctx)
+	}
+	return e.compiled.Evaluate(input, ctx)
+}
+
+// EvaluateBool evaluates e and returns its result as a boolean. An empty
+// result is false; any other result must be a single boolean.
+func (e *Expression) EvaluateBool(input any, opts ...Option) (bool, error) {
+	result, err := e.Evaluate(input, opts...)
+	if err != nil {
+		return false, err
+	}
+	switch len(result) {
+	case 0:
+		return false, nil
+	case 1:
+		if b, ok := result[0].(bool); ok {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("fhirpath: %s: expected a boolean, got %v", e, result)
+}
+
+// Evaluate compiles expression and evaluates it against input.
+func Evaluate(input any, expression string, opts ...Option) ([]any, error) {
+	e, err := Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	return e.Evaluate(input, opts...)
+}
+
+// Option configures the environment of an evaluation.
+type Option func(*hook.Context)
+
+// WithResource sets %resource, the resource containing the input.
+func WithResource(resource models.Resource) Option {
+	return func(ctx *hook.Context) {
+		ctx.Resource = resource
+	}
+}
+
+// WithRootResource sets %rootResource, the resource containing %resource
+// when it is a contained resource.
+func WithRootResource(resource models.Resource) Option {
+	return func(ctx *hook.Context) {
+		ctx.RootResource = resource
+	}
+}
+
+// WithVariable sets %name. The value may be a single item or a []any.
+func WithVariable(name string, value any) Option {
+	return func(ctx *hook.Context) {
+		if ctx.Variables == nil {
+			ctx.Variables = make(map[string]any)
+		}
+		ctx.Variables[name] = value
+	}
+}
+
+// WithResolver sets the resolver resolve() uses for references other than
+// those to contained resources.
+func WithResolver(r Resolver) Option {
+	return func(ctx *hook.Context) {
+		ctx.Resolver = func(reference string) (any, error) {
+			res, err := r.Resolve(reference)
+			if err != nil || res == nil {
+				return nil, err
+			}
+			return res, nil
+		}
+	}
+}
+
+// WithNow sets the time today(), now() and timeOfDay() return.
+func WithNow(now time.Time) Option {
+	return func(ctx *hook.Context) {
+		ctx.Now = now
+	}
+}
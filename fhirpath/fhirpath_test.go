@@ -0,0 +1,258 @@
+package fhirpath
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+func TestCompile(t *testing.T) {
+	expr, err := Compile("name.given.first()")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if got := expr.String(); got != "name.given.first()" {
+		t.Errorf("String() = %q, want %q", got, "name.given.first()")
+	}
+	if _, err := Compile("name.where("); err == nil {
+		t.Error("Compile() of an unterminated call succeeded, want error")
+	}
+}
+
+func TestMustCompile_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustCompile() did not panic on an invalid expression")
+		}
+	}()
+	MustCompile("1 +")
+}
+
+func TestEvaluate_Patient(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+
+	given, err := Evaluate(patient, "name.where(use = 'official').given")
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(given) != 2 || given[0] != "Peter" || given[1] != "James" {
+		t.Errorf("Evaluate() = %v, want [Peter James]", given)
+	}
+
+	names, err := Evaluate(patient, "name.first()")
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("Evaluate() = %v, want one name", names)
+	}
+	if name, ok := names[0].(*models.HumanName); !ok || name.Family == nil || *name.Family != "Chalmers" {
+		t.Errorf("Evaluate() = %#v, want the official *HumanName", names[0])
+	}
+}
+
+func TestEvaluateBool(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+	tests := []struct {
+		expression string
+		want       bool
+		wantErr    bool
+	}{
+		{expression: "active", want: true},
+		{expression: "deceased.exists() and deceased = false", want: true},
+		{expression: "multipleBirth", want: false},
+		{expression: "name.given", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := MustCompile(tt.expression).EvaluateBool(patient)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EvaluateBool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("EvaluateBool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Variables(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+
+	got, err := Evaluate(patient, "name.where(use = %use).family",
+		WithVariable("use", "maiden"))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "Windsor" {
+		t.Errorf("Evaluate() = %v, want [Windsor]", got)
+	}
+
+	got, err = Evaluate(nil, "%uses.count()", WithVariable("uses", []any{"home", "work"}))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != int64(2) {
+		t.Errorf("Evaluate() = %v, want [2]", got)
+	}
+
+	if _, err := Evaluate(patient, "%undefined"); err == nil {
+		t.Error("Evaluate() of an undefined variable succeeded, want error")
+	}
+}
+
+func TestEvaluate_Resource(t *testing.T) {
+	patient := loadResource(t, "patient-example.json").(*models.Patient)
+	name := &patient.Name[0]
+
+	got, err := Evaluate(name, "%resource.id")
+	if err == nil {
+		t.Errorf("Evaluate() without a resource = %v, want error", got)
+	}
+	got, err = Evaluate(name, "%resource.id & '/' & family", WithResource(patient))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "example/Chalmers" {
+		t.Errorf("Evaluate() = %v, want [example/Chalmers]", got)
+	}
+}
+
+func TestEvaluate_Now(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	patient := loadResource(t, "patient-example.json")
+
+	got, err := Evaluate(patient, "today()", WithNow(now))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != models.NewDate(now, models.PrecisionDay) {
+		t.Errorf("Evaluate() = %v, want [2024-03-15]", got)
+	}
+
+	ok, err := MustCompile("birthDate + 49 years < today()").EvaluateBool(patient, WithNow(now))
+	if err != nil {
+		t.Fatalf("EvaluateBool() error = %v", err)
+	}
+	if !ok {
+		t.Error("EvaluateBool() = false, want the patient to be 49 in March 2024")
+	}
+}
+
+func TestEvaluate_Quantity(t *testing.T) {
+	observation := loadResource(t, "observation-example.json")
+
+	got, err := Evaluate(observation, "value * 2")
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	want := Quantity{Value: models.MustParseDecimal("370"), Unit: "[lb_av]"}
+	if len(got) != 1 {
+		t.Fatalf("Evaluate() = %v, want [%v]", got, want)
+	}
+	q, ok := got[0].(Quantity)
+	if !ok || !q.Value.Equal(want.Value) || q.Unit != want.Unit {
+		t.Errorf("Evaluate() = %#v, want %#v", got[0], want)
+	}
+
+	ok, err = MustCompile("value > %limit").EvaluateBool(observation, WithVariable("limit", want))
+	if err != nil {
+		t.Fatalf("EvaluateBool() error = %v", err)
+	}
+	if ok {
+		t.Error("EvaluateBool() = true, want 185 [lb_av] below 370 [lb_av]")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	observation := loadResource(t, "observation-example.json")
+	patient := loadResource(t, "patient-example.json")
+
+	resolver := ResolverFunc(func(reference string) (models.Resource, error) {
+		if reference == "Patient/example" {
+			return patient, nil
+		}
+		return nil, nil
+	})
+	got, err := Evaluate(observation, "subject.resolve().name.first().family", WithResolver(resolver))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != "Chalmers" {
+		t.Errorf("Evaluate() = %v, want [Chalmers]", got)
+	}
+
+	stub := ResolverFunc(func(string) (models.Resource, error) {
+		return &models.Patient{}, nil
+	})
+	ok, err := MustCompile("subject.resolve() is Patient").EvaluateBool(observation, WithResolver(stub))
+	if err != nil || !ok {
+		t.Errorf("EvaluateBool() of a resource without resourceType = %v, %v, want true", ok, err)
+	}
+
+	got, err = Evaluate(observation, "subject.resolve()")
+	if err != nil || len(got) != 0 {
+		t.Errorf("Evaluate() without a resolver = %v, %v, want nothing", got, err)
+	}
+
+	failing := ResolverFunc(func(string) (models.Resource, error) {
+		return nil, errors.New("unreachable")
+	})
+	if _, err := Evaluate(observation, "subject.resolve()", WithResolver(failing)); err == nil {
+		t.Error("Evaluate() with a failing resolver succeeded, want error")
+	}
+}
+
+func TestBundleResolver(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+	observation := loadResource(t, "observation-example.json")
+	fullURL := "urn:uuid:5d1b4c3e-9a8f-4f2b-8c3d-2e1f0a9b8c7d"
+	bundle := &models.Bundle{Entry: []models.BundleEntry{
+		{FullUrl: &fullURL, Resource: patient},
+		{Resource: observation},
+	}}
+	resolver := BundleResolver(bundle)
+
+	tests := []struct {
+		reference string
+		want      models.Resource
+	}{
+		{reference: fullURL, want: patient},
+		{reference: "Patient/example", want: patient},
+		{reference: "http://example.org/fhir/Observation/example", want: observation},
+		{reference: "Patient/other", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got, err := resolver.Resolve(tt.reference)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompile_Concurrent(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+	expr := MustCompile("name.given.count()")
+	done := make(chan error)
+	for i := 0; i < 8; i++ {
+		go func() {
+			got, err := expr.Evaluate(patient)
+			if err == nil && (len(got) != 1 || got[0] != int64(5)) {
+				err = errors.New("unexpected result")
+			}
+			done <- err
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		if err := <-done; err != nil {
+			t.Errorf("Evaluate() error = %v", err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Package hook connects the fhirpath package to the FHIRPath engine of a
+// generated models package. The engine is part of the generated runtime and
+// is not exported; a package generated with validation registers it here
+// under its import path when it is initialized.
+package hook
+
+import (
+	"sync"
+	"time"
+)
+
+// Context is the environment an expression is evaluated in.
+type Context struct {
+	// Resource is %resource. It defaults to the input when the input is a
+	// resource.
+	Resource any
+	// RootResource is %rootResource, the resource containing Resource when
+	// it is a contained resource. It defaults to Resource.
+	RootResource any
+	// Variables are the values of %name for each name. A value may be a
+	// single item or a slice of items.
+	Variables map[string]any
+	// Resolver is called by resolve() for references other than those to
+	// contained resources. Without a resolver such references resolve to
+	// nothing.
+	Resolver func(reference string) (any, error)
+	// Now is the time today(), now() and timeOfDay() return. It defaults
+	// to the current time.
+	Now time.Time
+}
+
+// Expression is a compiled FHIRPath expression.
+type Expression interface {
+	String() string
+	Evaluate(input any, ctx Context) ([]any, error)
+}
+
+// CompileFunc parses a FHIRPath expression.
+type CompileFunc func(source string) (Expression, error)
+
+var engines sync.Map // import path -> CompileFunc
+
+// Register records the engine of the models package with import path pkg.
+func Register(pkg string, compile CompileFunc) {
+	engines.Store(pkg, compile)
+}
+
+// Lookup returns the engine the models package with import path pkg
+// registered, if it was generated with validation.
+func Lookup(pkg string) (CompileFunc, bool) {
+	compile, ok := engines.Load(pkg)
+	if !ok {
+		return nil, false
+	}
+	return compile.(CompileFunc), true
+}
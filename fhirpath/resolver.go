@@ -0,0 +1,54 @@
+package fhirpath
+
+import (
+	"strings"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// Resolver resolves the references resolve() is called on. A reference
+// that cannot be found resolves to a nil resource and no error; an error
+// aborts the evaluation.
+type Resolver interface {
+	Resolve(reference string) (models.Resource, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface.
+type ResolverFunc func(reference string) (models.Resource, error)
+
+func (f ResolverFunc) Resolve(reference string) (models.Resource, error) {
+	return f(reference)
+}
+
+// BundleResolver resolves references to the entries of a bundle, by their
+// full URL or by a relative Type/id reference.
+func BundleResolver(bundle *models.Bundle) Resolver {
+	byURL := make(map[string]models.Resource)
+	if bundle != nil {
+		for _, entry := range bundle.Entry {
+			if entry.Resource == nil {
+				continue
+			}
+			if entry.FullUrl != nil {
+				byURL[*entry.FullUrl] = entry.Resource
+			}
+			if id := entry.Resource.GetID(); id != "" {
+				byURL[entry.Resource.GetResourceType()+"/"+id] = entry.Resource
+			}
+		}
+	}
+	return ResolverFunc(func(reference string) (models.Resource, error) {
+		if res, ok := byURL[reference]; ok {
+			return res, nil
+		}
+		// An absolute reference also matches an entry by its trailing
+		// Type/id, as when entries have urn:uuid full URLs.
+		parts := strings.Split(strings.TrimRight(reference, "/"), "/")
+		if len(parts) >= 2 {
+			if res, ok := byURL[strings.Join(parts[len(parts)-2:], "/")]; ok {
+				return res, nil
+			}
+		}
+		return nil, nil
+	})
+}
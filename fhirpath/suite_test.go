@@ -0,0 +1,185 @@
+package fhirpath
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// suiteFiles are the test suites run by TestFHIRPathSuite: the hand-written
+// tests, and the official suite of the FHIR test cases when it is copied
+// into testdata with its input files.
+var suiteFiles = []string{"fhirpath-tests.xml", "tests-fhir-r5.xml"}
+
+// suiteSkips lists the tests of the hand-written suite the engine does not
+// pass, with the reason. Every name must be a test of that suite.
+var suiteSkips = map[string]string{
+	"testSimpleFail":             "paths are not checked against the model when compiling",
+	"testSimpleWithWrongContext": "paths are not checked against the model when compiling",
+	"testPolymorphismB":          "paths are not checked against the model when compiling",
+}
+
+type testSuite struct {
+	Groups []testGroup `xml:"group"`
+}
+
+type testGroup struct {
+	Name  string      `xml:"name,attr"`
+	Tests []suiteTest `xml:"test"`
+}
+
+type suiteTest struct {
+	Name       string `xml:"name,attr"`
+	InputFile  string `xml:"inputfile,attr"`
+	Predicate  bool   `xml:"predicate,attr"`
+	Expression struct {
+		Text    string `xml:",chardata"`
+		Invalid string `xml:"invalid,attr"`
+	} `xml:"expression"`
+	Outputs []struct {
+		Type  string `xml:"type,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"output"`
+}
+
+func TestFHIRPathSuite(t *testing.T) {
+	inputs := make(map[string]models.Resource)
+	for _, file := range suiteFiles {
+		t.Run(file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", file))
+			if os.IsNotExist(err) && file != suiteFiles[0] {
+				t.Skipf("%s is not in testdata", file)
+			}
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			var suite testSuite
+			if err := xml.Unmarshal(data, &suite); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if file == suiteFiles[0] {
+				checkSuiteSkips(t, suite)
+			}
+			runSuite(t, suite, inputs)
+		})
+	}
+}
+
+// checkSuiteSkips reports the names of suiteSkips that are not tests of
+// suite, so that the list only holds tests that are actually skipped.
+func checkSuiteSkips(t *testing.T, suite testSuite) {
+	names := make(map[string]bool)
+	for _, group := range suite.Groups {
+		for _, tt := range group.Tests {
+			names[tt.Name] = true
+		}
+	}
+	for name := range suiteSkips {
+		if !names[name] {
+			t.Errorf("suiteSkips lists %s, which is not a test of the suite", name)
+		}
+	}
+}
+
+func runSuite(t *testing.T, suite testSuite, inputs map[string]models.Resource) {
+	for _, group := range suite.Groups {
+		group := group
+		t.Run(group.Name, func(t *testing.T) {
+			for _, tt := range group.Tests {
+				tt := tt
+				t.Run(tt.Name, func(t *testing.T) {
+					if reason, ok := suiteSkips[tt.Name]; ok {
+						t.Skip(reason)
+					}
+					var input any
+					if tt.InputFile != "" {
+						res, ok := inputs[tt.InputFile]
+						if !ok {
+							res = loadResource(t, tt.InputFile)
+							inputs[tt.InputFile] = res
+						}
+						input = res
+					}
+					runSuiteTest(t, tt, input)
+				})
+			}
+		})
+	}
+}
+
+func runSuiteTest(t *testing.T, tt suiteTest, input any) {
+	expr, err := Compile(tt.Expression.Text)
+	var result []any
+	if err == nil {
+		result, err = expr.Evaluate(input)
+	}
+	if tt.Expression.Invalid != "" {
+		if err == nil {
+			t.Errorf("%s = %v, want %s error", tt.Expression.Text, result, tt.Expression.Invalid)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("%s: error = %v", tt.Expression.Text, err)
+	}
+	if tt.Predicate {
+		result = []any{len(result) > 0}
+	}
+
+	var got, want []string
+	for _, item := range result {
+		got = append(got, formatItem(item))
+	}
+	for _, out := range tt.Outputs {
+		want = append(want, out.Value)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("%s = %q, want %q", tt.Expression.Text, got, want)
+	}
+}
+
+// formatItem formats an item of a result as the suite writes outputs.
+func formatItem(item any) string {
+	switch v := item.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case models.Date:
+		return "@" + v.String()
+	case models.DateTime:
+		return "@" + v.String()
+	case models.Instant:
+		return "@" + v.String()
+	case models.Time:
+		return "@T" + v.String()
+	case Quantity:
+		return fmt.Sprintf("%s '%s'", v.Value, v.Unit)
+	}
+	return fmt.Sprint(item)
+}
+
+func loadResource(t *testing.T, name string) models.Resource {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	unmarshal := models.UnmarshalResource
+	if strings.HasSuffix(name, ".xml") {
+		unmarshal = models.UnmarshalResourceXML
+	}
+	res, err := unmarshal(data)
+	if err != nil {
+		t.Fatalf("UnmarshalResource(%s) error = %v", name, err)
+	}
+	return res
+}
@@ -0,0 +1,130 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// codedTypes are the types of the elements whose bindings are checked
+// against a terminology service.
+var codedTypes = map[string]bool{"code": true, "Coding": true, "CodeableConcept": true}
+
+// resourceBindings returns the bound coded elements of a resource, in
+// snapshot order.
+func resourceBindings(def StructureDefinition) []ElementDefinition {
+	var elements []ElementDefinition
+	for _, el := range def.Snapshot.Element {
+		if el.Binding == nil || el.Binding.ValueSet == "" || el.Binding.Strength == "" {
+			continue
+		}
+		for _, t := range el.Type {
+			if codedTypes[t.Code] {
+				elements = append(elements, el)
+				break
+			}
+		}
+	}
+	return elements
+}
+
+// GenerateBindings writes the value set bindings of the coded elements of
+// every resource as a table, with a lookup by resource type and BoundValues,
+// which collects the bound items of a resource for terminology validation.
+func (g *Generator) GenerateBindings() error {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+
+	index := make(map[string][]int)
+	fmt.Fprintf(&buf, "var bindingDefinitions = []BindingDefinition{\n")
+	n := 0
+	for _, name := range g.concreteResources() {
+		for _, el := range resourceBindings(g.Definitions[name]) {
+			fmt.Fprintf(&buf, "\t{Path: %q, Strength: %q, ValueSet: %q", el.Path, el.Binding.Strength, normalizeValueSetURL(el.Binding.ValueSet))
+			if desc := strings.TrimSpace(el.Binding.Description); desc != "" {
+				fmt.Fprintf(&buf, ", Description: %q", desc)
+			}
+			fmt.Fprintf(&buf, "},\n")
+			index[name] = append(index[name], n)
+			n++
+		}
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	names := make([]string, 0, len(index))
+	for name := range index {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(&buf, "// bindingIndex maps each resource type to the positions of its bindings\n")
+	fmt.Fprintf(&buf, "// in bindingDefinitions.\n")
+	fmt.Fprintf(&buf, "var bindingIndex = map[string][]int{\n")
+	for _, name := range names {
+		positions := make([]string, len(index[name]))
+		for i, pos := range index[name] {
+			positions[i] = fmt.Sprint(pos)
+		}
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", name, strings.Join(positions, ", "))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// BindingsFor returns the bindings of the code, Coding and CodeableConcept\n")
+	fmt.Fprintf(&buf, "// elements of a resource type.\n")
+	fmt.Fprintf(&buf, "func BindingsFor(resourceType string) []*BindingDefinition {\n")
+	fmt.Fprintf(&buf, "\tpositions := bindingIndex[resourceType]\n")
+	fmt.Fprintf(&buf, "\tbindings := make([]*BindingDefinition, len(positions))\n")
+	fmt.Fprintf(&buf, "\tfor i, pos := range positions {\n")
+	fmt.Fprintf(&buf, "\t\tbindings[i] = &bindingDefinitions[pos]\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn bindings\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// BoundValue is an item of a coded element of a resource together with\n")
+	fmt.Fprintf(&buf, "// the binding of its element.\n")
+	fmt.Fprintf(&buf, "type BoundValue struct {\n")
+	fmt.Fprintf(&buf, "\tBinding *BindingDefinition\n")
+	fmt.Fprintf(&buf, "\t// Path is the location of the item, such as Observation.category[1].\n")
+	fmt.Fprintf(&buf, "\tPath string\n")
+	fmt.Fprintf(&buf, "\t// Type is the type of the item: code, Coding or CodeableConcept.\n")
+	fmt.Fprintf(&buf, "\tType string\n")
+	fmt.Fprintf(&buf, "\t// Codings are the codes of the item. The code of a code element is\n")
+	fmt.Fprintf(&buf, "\t// given as a Coding without a system.\n")
+	fmt.Fprintf(&buf, "\tCodings []Coding\n")
+	fmt.Fprintf(&buf, "\t// Text is the text of a CodeableConcept.\n")
+	fmt.Fprintf(&buf, "\tText string\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// BoundValues returns the items of the bound coded elements of res, element\n")
+	fmt.Fprintf(&buf, "// by element in the order of BindingsFor. Only the elements of the\n")
+	fmt.Fprintf(&buf, "// resource's own definition are included, not those of the data types it\n")
+	fmt.Fprintf(&buf, "// uses, and the variants of other types of choice elements are left out.\n")
+	fmt.Fprintf(&buf, "func BoundValues(res Resource) []BoundValue {\n")
+	fmt.Fprintf(&buf, "\tvar values []BoundValue\n")
+	fmt.Fprintf(&buf, "\tfor _, binding := range BindingsFor(res.GetResourceType()) {\n")
+	fmt.Fprintf(&buf, "\t\tfor _, item := range bindingLocations(res, binding) {\n")
+	fmt.Fprintf(&buf, "\t\t\tv := BoundValue{Binding: binding, Path: item.path}\n")
+	fmt.Fprintf(&buf, "\t\t\tswitch value := item.value.Interface().(type) {\n")
+	fmt.Fprintf(&buf, "\t\t\tcase Coding:\n")
+	fmt.Fprintf(&buf, "\t\t\t\tv.Type, v.Codings = \"Coding\", []Coding{value}\n")
+	fmt.Fprintf(&buf, "\t\t\tcase CodeableConcept:\n")
+	fmt.Fprintf(&buf, "\t\t\t\tv.Type, v.Codings = \"CodeableConcept\", value.Coding\n")
+	fmt.Fprintf(&buf, "\t\t\t\tif value.Text != nil {\n")
+	fmt.Fprintf(&buf, "\t\t\t\t\tv.Text = *value.Text\n")
+	fmt.Fprintf(&buf, "\t\t\t\t}\n")
+	fmt.Fprintf(&buf, "\t\t\tdefault:\n")
+	fmt.Fprintf(&buf, "\t\t\t\tcode, ok := boundCode(item)\n")
+	fmt.Fprintf(&buf, "\t\t\t\tif !ok {\n")
+	fmt.Fprintf(&buf, "\t\t\t\t\tcontinue\n")
+	fmt.Fprintf(&buf, "\t\t\t\t}\n")
+	fmt.Fprintf(&buf, "\t\t\t\tv.Type, v.Codings = \"code\", []Coding{{Code: &code}}\n")
+	fmt.Fprintf(&buf, "\t\t\t}\n")
+	fmt.Fprintf(&buf, "\t\t\tvalues = append(values, v)\n")
+	fmt.Fprintf(&buf, "\t\t}\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn values\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return g.writeFormatted("bindings", "bindings.go", buf.Bytes())
+}
@@ -0,0 +1,66 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func bindingTestGenerator(t *testing.T) *Generator {
+	g := NewGenerator(t.TempDir(), t.TempDir())
+	g.Definitions["Observation"] = StructureDefinition{
+		Name: "Observation", Kind: "resource",
+		Snapshot: Snapshot{Element: []ElementDefinition{
+			{ID: "Observation", Path: "Observation", Min: 0, Max: "*"},
+			{ID: "Observation.status", Path: "Observation.status", Min: 1, Max: "1", Type: []ElementDataType{{Code: "code"}},
+				Binding: &Binding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status|5.0.0"}},
+			{ID: "Observation.interpretation", Path: "Observation.interpretation", Min: 0, Max: "*",
+				Type:    []ElementDataType{{Code: "CodeableConcept"}},
+				Binding: &Binding{Strength: "extensible", Description: " Codes identifying interpretations. ", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation"}},
+			{ID: "Observation.bodyStructure", Path: "Observation.bodyStructure", Min: 0, Max: "1",
+				Type:    []ElementDataType{{Code: "CodeableReference"}},
+				Binding: &Binding{Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/body-site"}},
+			{ID: "Observation.note", Path: "Observation.note", Min: 0, Max: "*", Type: []ElementDataType{{Code: "Annotation"}}},
+		}},
+	}
+	g.Definitions["Bundle"] = StructureDefinition{Name: "Bundle", Kind: "resource"}
+	return g
+}
+
+func TestResourceBindings(t *testing.T) {
+	g := bindingTestGenerator(t)
+	var got []string
+	for _, el := range resourceBindings(g.Definitions["Observation"]) {
+		got = append(got, el.Path)
+	}
+	if strings.Join(got, ",") != "Observation.status,Observation.interpretation" {
+		t.Errorf("resourceBindings() = %v, want status and interpretation", got)
+	}
+}
+
+func TestGenerateBindings(t *testing.T) {
+	g := bindingTestGenerator(t)
+	if err := g.GenerateBindings(); err != nil {
+		t.Fatalf("GenerateBindings() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "bindings.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		`{Path: "Observation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status"},`,
+		`Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation", Description: "Codes identifying interpretations."}`,
+		`"Observation": {0, 1},`,
+		"func BindingsFor(resourceType string) []*BindingDefinition",
+		"func BoundValues(res Resource) []BoundValue",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("bindings.go does not contain %q", want)
+		}
+	}
+	if strings.Contains(code, `"Bundle"`) {
+		t.Error("bindings.go indexes Bundle, which has no bindings")
+	}
+}
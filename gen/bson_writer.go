@@ -0,0 +1,25 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// writeBSONMethods writes the MarshalBSON and UnmarshalBSON methods of a
+// struct. The MongoDB driver calls them in place of its own struct codec,
+// which cannot decode resources and choice elements into their interfaces
+// and loses decimals and dates; the runtime encodes the struct instead,
+// following its bson tags.
+func (g *Generator) writeBSONMethods(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	if !g.writesStruct(structName, fields) {
+		return
+	}
+
+	fmt.Fprintf(buf, "func (r %s) MarshalBSON() ([]byte, error) {\n", structName)
+	fmt.Fprintf(buf, "\treturn marshalBSON(&r)\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) UnmarshalBSON(data []byte) error {\n", structName)
+	fmt.Fprintf(buf, "\treturn unmarshalBSON(data, r)\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
@@ -0,0 +1,32 @@
+package gen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteBSONMethods(t *testing.T) {
+	g := NewGenerator("", "")
+	g.Definitions["Element"] = StructureDefinition{Name: "Element", Kind: "complex-type"}
+
+	var buf bytes.Buffer
+	g.writeBSONMethods(&buf, "TestResource", choiceFields(g))
+
+	output := buf.String()
+	expected := []string{
+		"func (r TestResource) MarshalBSON() ([]byte, error) {\n\treturn marshalBSON(&r)\n}",
+		"func (r *TestResource) UnmarshalBSON(data []byte) error {\n\treturn unmarshalBSON(data, r)\n}",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+
+	buf.Reset()
+	g.writeBSONMethods(&buf, "Missing", nil)
+	if buf.Len() != 0 {
+		t.Errorf("expected no methods for a struct that is not written, got:\n%s", buf.String())
+	}
+}
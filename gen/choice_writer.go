@@ -0,0 +1,240 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gruzdev-dev/fhir/tools/text"
+)
+
+// ChoiceInfo describes a choice element such as Observation.value[x], which
+// is generated as a sealed interface with one variant type per allowed type.
+type ChoiceInfo struct {
+	Interface  string
+	JSONName   string
+	Path       string
+	Variants   []ChoiceVariant
+	HasElement bool
+}
+
+// ChoiceVariant is one allowed type of a choice element.
+type ChoiceVariant struct {
+	TypeName string
+	FHIRType string
+	GoType   string
+}
+
+// choiceInfo builds the choice type named typeName for a multi-typed
+// element. Types that cannot be represented in Go are dropped; nil is
+// returned when none remain.
+func (g *Generator) choiceInfo(el ElementDefinition, baseName, typeName string, isPrimitiveType bool) *ChoiceInfo {
+	choice := &ChoiceInfo{
+		Interface: typeName,
+		JSONName:  baseName,
+		Path:      el.Path,
+	}
+	seen := make(map[string]bool)
+	for _, fhirType := range el.Type {
+		singleTypeEl := el
+		singleTypeEl.Type = []ElementDataType{fhirType}
+		goType := g.mapGoType(singleTypeEl)
+		if !text.IsValidGoIdentifier(goType) || g.isInterfaceType(goType) || seen[fhirType.Code] {
+			continue
+		}
+		seen[fhirType.Code] = true
+
+		choice.Variants = append(choice.Variants, ChoiceVariant{
+			TypeName: choice.Interface + text.TitleCase(fhirType.Code),
+			FHIRType: fhirType.Code,
+			GoType:   goType,
+		})
+		if g.hasPrimitiveElements(fhirType.Code, isPrimitiveType) {
+			choice.HasElement = true
+		}
+	}
+	if choice.Interface == "" || len(choice.Variants) == 0 {
+		return nil
+	}
+	return choice
+}
+
+// conflictField is the unexported field recording the JSON properties of a
+// choice element when a document carries more than one of its variants.
+func conflictField(f FieldInfo) string {
+	return strings.ToLower(f.Name[:1]) + f.Name[1:] + "Variants"
+}
+
+func variantsVar(choice *ChoiceInfo) string {
+	return strings.ToLower(choice.Interface[:1]) + choice.Interface[1:] + "Variants"
+}
+
+func hasChoiceFields(fields []FieldInfo) bool {
+	for _, f := range fields {
+		if f.Choice != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// writeChoiceTypes writes the interface and variant types of every choice
+// element of a struct.
+func (g *Generator) writeChoiceTypes(buf *bytes.Buffer, fields []FieldInfo) {
+	for _, f := range fields {
+		if f.Choice == nil {
+			continue
+		}
+		choice := f.Choice
+		marker := "is" + choice.Interface
+
+		fmt.Fprintf(buf, "// %s is the type of %s. It is implemented by the %s* variant types.\n", choice.Interface, choice.Path, choice.Interface)
+		fmt.Fprintf(buf, "type %s interface {\n", choice.Interface)
+		fmt.Fprintf(buf, "\tFHIRType() string\n")
+		fmt.Fprintf(buf, "\tValidate() error\n")
+		fmt.Fprintf(buf, "\tvalidateAll(path string, issues *ValidationIssues)\n")
+		fmt.Fprintf(buf, "\t%s()\n", marker)
+		fmt.Fprintf(buf, "}\n\n")
+
+		for _, v := range choice.Variants {
+			fmt.Fprintf(buf, "// %s is the %s variant of %s.\n", v.TypeName, v.FHIRType, choice.Interface)
+			switch {
+			case isBuiltinType(v.GoType):
+				fmt.Fprintf(buf, "type %s %s\n\n", v.TypeName, v.GoType)
+				fmt.Fprintf(buf, "func (v %s) Validate() error {\n", v.TypeName)
+				fmt.Fprintf(buf, "\treturn nil\n")
+				fmt.Fprintf(buf, "}\n\n")
+				fmt.Fprintf(buf, "func (v %s) validateAll(path string, issues *ValidationIssues) {}\n\n", v.TypeName)
+			default:
+				fmt.Fprintf(buf, "type %s struct {\n", v.TypeName)
+				fmt.Fprintf(buf, "\t%s\n", v.GoType)
+				fmt.Fprintf(buf, "}\n\n")
+				fmt.Fprintf(buf, "func (v %s) Validate() error {\n", v.TypeName)
+				fmt.Fprintf(buf, "\treturn v.%s.Validate()\n", v.GoType)
+				fmt.Fprintf(buf, "}\n\n")
+				fmt.Fprintf(buf, "func (v %s) validateAll(path string, issues *ValidationIssues) {\n", v.TypeName)
+				if isRuntimeType(v.GoType) {
+					fmt.Fprintf(buf, "\tif err := v.%s.Validate(); err != nil {\n", v.GoType)
+					fmt.Fprintf(buf, "\t\tissues.add(\"value\", path, err.Error())\n")
+					fmt.Fprintf(buf, "\t}\n")
+				} else {
+					fmt.Fprintf(buf, "\tv.%s.validateAll(path, issues)\n", v.GoType)
+				}
+				fmt.Fprintf(buf, "}\n\n")
+			}
+			fmt.Fprintf(buf, "func (v %s) FHIRType() string {\n", v.TypeName)
+			fmt.Fprintf(buf, "\treturn %q\n", v.FHIRType)
+			fmt.Fprintf(buf, "}\n\n")
+			fmt.Fprintf(buf, "func (v %s) %s() {}\n\n", v.TypeName, marker)
+		}
+
+		fmt.Fprintf(buf, "var %s = []%s{\n", variantsVar(choice), choice.Interface)
+		for _, v := range choice.Variants {
+			if isBuiltinType(v.GoType) {
+				fmt.Fprintf(buf, "\t%s(%s),\n", v.TypeName, zeroLiteral(v.GoType))
+			} else {
+				fmt.Fprintf(buf, "\t%s{},\n", v.TypeName)
+			}
+		}
+		fmt.Fprintf(buf, "}\n\n")
+	}
+}
+
+// writeChoiceVariants writes the choiceVariants method the runtime encoders
+// name the choice elements of a struct with.
+func writeChoiceVariants(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	if !hasChoiceFields(fields) {
+		return
+	}
+	fmt.Fprintf(buf, "func (r *%s) choiceVariants(field string) (string, []choiceValue) {\n", structName)
+	fmt.Fprintf(buf, "\tswitch field {\n")
+	for _, f := range fields {
+		if f.Choice == nil {
+			continue
+		}
+		fmt.Fprintf(buf, "\tcase %q:\n", f.Name)
+		fmt.Fprintf(buf, "\t\treturn %q, choiceValues(%s)\n", f.Choice.JSONName, variantsVar(f.Choice))
+	}
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\treturn \"\", nil\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+func zeroLiteral(goType string) string {
+	switch goType {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	}
+	return "0"
+}
+
+// writeChoiceMarshal appends the choice elements of a struct to the encoded
+// object held in data.
+func writeChoiceMarshal(buf *bytes.Buffer, fields []FieldInfo) {
+	elements := choiceElements(fields)
+	for _, f := range fields {
+		if f.Choice == nil {
+			continue
+		}
+		element := "nil"
+		if name, ok := elements[f.Name]; ok {
+			element = "r." + name
+		}
+		fmt.Fprintf(buf, "\tif data, err = marshalChoice(data, %q, r.%s, %s); err != nil {\n", f.Choice.JSONName, f.Name, element)
+		fmt.Fprintf(buf, "\t\treturn nil, err\n")
+		fmt.Fprintf(buf, "\t}\n")
+	}
+}
+
+// writeChoiceUnmarshal decodes the choice elements of a struct from the
+// properties of the JSON object in data.
+func writeChoiceUnmarshal(buf *bytes.Buffer, fields []FieldInfo) {
+	elements := choiceElements(fields)
+	fmt.Fprintf(buf, "\tvar fields map[string]json.RawMessage\n")
+	fmt.Fprintf(buf, "\tif err := json.Unmarshal(data, &fields); err != nil {\n")
+	fmt.Fprintf(buf, "\t\treturn err\n")
+	fmt.Fprintf(buf, "\t}\n")
+	if len(elements) > 0 {
+		fmt.Fprintf(buf, "\tvar element json.RawMessage\n")
+	}
+	for _, f := range fields {
+		if f.Choice == nil {
+			continue
+		}
+		name, ok := elements[f.Name]
+		if !ok {
+			fmt.Fprintf(buf, "\tif r.%s, _, r.%s, err = unmarshalChoice(fields, %q, %s); err != nil {\n", f.Name, conflictField(f), f.Choice.JSONName, variantsVar(f.Choice))
+			fmt.Fprintf(buf, "\t\treturn err\n")
+			fmt.Fprintf(buf, "\t}\n")
+			continue
+		}
+		fmt.Fprintf(buf, "\tif r.%s, element, r.%s, err = unmarshalChoice(fields, %q, %s); err != nil {\n", f.Name, conflictField(f), f.Choice.JSONName, variantsVar(f.Choice))
+		fmt.Fprintf(buf, "\t\treturn err\n")
+		fmt.Fprintf(buf, "\t}\n")
+		fmt.Fprintf(buf, "\tr.%s = nil\n", name)
+		fmt.Fprintf(buf, "\tif element != nil {\n")
+		fmt.Fprintf(buf, "\t\tif err := json.Unmarshal(element, &r.%s); err != nil {\n", name)
+		fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"_%s: %%w\", err)\n", f.Choice.JSONName)
+		fmt.Fprintf(buf, "\t\t}\n")
+		fmt.Fprintf(buf, "\t}\n")
+	}
+}
+
+// choiceElements maps each choice field to its "_" companion field.
+func choiceElements(fields []FieldInfo) map[string]string {
+	choices := make(map[string]bool)
+	for _, f := range fields {
+		if f.Choice != nil {
+			choices[f.Name] = true
+		}
+	}
+	elements := make(map[string]string)
+	for _, f := range fields {
+		if f.ElementOf != "" && choices[f.ElementOf] {
+			elements[f.ElementOf] = f.Name
+		}
+	}
+	return elements
+}
@@ -0,0 +1,102 @@
+package gen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func choiceFields(g *Generator) []FieldInfo {
+	elements := []ElementDefinition{
+		{ID: "TestResource", Path: "TestResource", Min: 0, Max: "*"},
+		{
+			ID:    "TestResource.value[x]",
+			Path:  "TestResource.value[x]",
+			Min:   1,
+			Max:   "1",
+			Type:  []ElementDataType{{Code: "string"}, {Code: "Quantity"}},
+			Short: "Choice type field",
+		},
+	}
+	def := StructureDefinition{Name: "TestResource", Kind: "resource"}
+	return g.ProcessElements("TestResource", elements, def)["TestResource"]
+}
+
+func TestWriteChoiceTypes(t *testing.T) {
+	g := NewGenerator("", "")
+	var buf bytes.Buffer
+	g.writeChoiceTypes(&buf, choiceFields(g))
+
+	output := buf.String()
+	expected := []string{
+		"type TestResourceValue interface {",
+		"\tFHIRType() string\n\tValidate() error\n\tvalidateAll(path string, issues *ValidationIssues)\n\tisTestResourceValue()\n",
+		"type TestResourceValueString string",
+		"type TestResourceValueQuantity struct {\n\tQuantity\n}",
+		"func (v TestResourceValueQuantity) Validate() error {\n\treturn v.Quantity.Validate()\n}",
+		"func (v TestResourceValueString) FHIRType() string {\n\treturn \"string\"\n}",
+		"func (v TestResourceValueQuantity) validateAll(path string, issues *ValidationIssues) {\n\tv.Quantity.validateAll(path, issues)\n}",
+		"func (v TestResourceValueString) validateAll(path string, issues *ValidationIssues) {}",
+		"func (v TestResourceValueQuantity) isTestResourceValue() {}",
+		"var testResourceValueVariants = []TestResourceValue{\n\tTestResourceValueString(\"\"),\n\tTestResourceValueQuantity{},\n}",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+}
+
+func TestWriteChoiceJSONAndValidation(t *testing.T) {
+	g := NewGenerator("", "")
+	g.Definitions["Element"] = StructureDefinition{Name: "Element", Kind: "complex-type"}
+	fields := choiceFields(g)
+
+	var buf bytes.Buffer
+	g.writeStruct(&buf, "TestResource", "", fields)
+	g.writeValidateMethod(&buf, "TestResource", fields, map[string][]FieldInfo{})
+	g.writeMarshalJSON(&buf, "TestResource", fields)
+	g.writeUnmarshalJSON(&buf, "TestResource", fields)
+
+	output := buf.String()
+	expected := []string{
+		"Value TestResourceValue `json:\"-\" bson:\"value,omitempty\"`",
+		"ValueElement *Element `json:\"-\" bson:\"value_element,omitempty\"`",
+		"valueVariants []string",
+		"if len(r.valueVariants) > 1 {",
+		"return fmt.Errorf(\"field 'Value' must have a single type, got %v\", r.valueVariants)",
+		"if r.Value == nil {",
+		"if err := r.Value.Validate(); err != nil {",
+		"if data, err = marshalChoice(data, \"value\", r.Value, r.ValueElement); err != nil {",
+		"if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, \"value\", testResourceValueVariants); err != nil {",
+		"if err := json.Unmarshal(element, &r.ValueElement); err != nil {",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+}
+
+func TestWriteChoiceVariants(t *testing.T) {
+	g := NewGenerator("", "")
+	var buf bytes.Buffer
+	writeChoiceVariants(&buf, "TestResource", choiceFields(g))
+
+	output := buf.String()
+	expected := []string{
+		"func (r *TestResource) choiceVariants(field string) (string, []choiceValue) {",
+		"case \"Value\":\n\t\treturn \"value\", choiceValues(testResourceValueVariants)",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+
+	buf.Reset()
+	writeChoiceVariants(&buf, "TestResource", nil)
+	if buf.Len() != 0 {
+		t.Errorf("expected no choiceVariants without choice elements, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,105 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// snapshotElements indexes the elements of a snapshot by path, leaving out
+// slices, which share the path of the element they slice.
+func snapshotElements(def StructureDefinition) map[string]ElementDefinition {
+	elements := make(map[string]ElementDefinition)
+	for _, el := range def.Snapshot.Element {
+		if _, ok := elements[el.Path]; ok || strings.Contains(el.ID, ":") {
+			continue
+		}
+		elements[el.Path] = el
+	}
+	return elements
+}
+
+// metadataTypes returns the FHIR types of an element. Elements defined by
+// a content reference have the types of the element they refer to.
+func metadataTypes(el ElementDefinition, elements map[string]ElementDefinition) []string {
+	if ref, ok := strings.CutPrefix(el.ContentReference, "#"); ok {
+		el = elements[ref]
+	}
+	types := make([]string, len(el.Type))
+	for i, t := range el.Type {
+		types[i] = t.FHIRType()
+	}
+	return types
+}
+
+// writeElementMetadata writes the metadata table of the elements of a
+// struct, in field order, and the ElementMetadata method returning it. The
+// companion fields of primitive elements and the resourceType field have
+// no element of their own.
+func (g *Generator) writeElementMetadata(buf *bytes.Buffer, structName string, fields []FieldInfo, elements map[string]ElementDefinition) {
+	if !g.writesStruct(structName, fields) {
+		return
+	}
+	names := elementNames(fields)
+	varName := strings.ToLower(structName[:1]) + structName[1:] + "ElementMetadata"
+	fmt.Fprintf(buf, "var %s = []ElementMetadata{\n", varName)
+	for i, f := range fields {
+		el, ok := elements[f.Path]
+		if !ok || f.ElementOf != "" {
+			continue
+		}
+		fmt.Fprintf(buf, "\t{Path: %q, Name: %q, Field: %d, Min: %d, Max: %q", el.Path, names[f.Name], i, el.Min, el.Max)
+		if types := metadataTypes(el, elements); len(types) > 0 {
+			fmt.Fprintf(buf, ", Types: %s", stringSliceLiteral(types))
+		}
+		var targets []string
+		for _, t := range el.Type {
+			targets = append(targets, t.TargetProfile...)
+		}
+		if len(targets) > 0 {
+			fmt.Fprintf(buf, ", TargetProfiles: %s", stringSliceLiteral(targets))
+		}
+		if b := el.Binding; b != nil && b.Strength != "" {
+			fmt.Fprintf(buf, ", Binding: &BindingDefinition{Path: %q, Strength: %q, ValueSet: %q", el.Path, b.Strength, normalizeValueSetURL(b.ValueSet))
+			if desc := strings.TrimSpace(b.Description); desc != "" {
+				fmt.Fprintf(buf, ", Description: %q", desc)
+			}
+			fmt.Fprintf(buf, "}")
+		}
+		if el.IsSummary {
+			fmt.Fprintf(buf, ", Summary: true")
+		}
+		if el.IsModifier {
+			fmt.Fprintf(buf, ", Modifier: true")
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) ElementMetadata() []ElementMetadata {\n", structName)
+	fmt.Fprintf(buf, "\treturn %s\n", varName)
+	fmt.Fprintf(buf, "}\n\n")
+
+	g.metadataTables = append(g.metadataTables, varName)
+}
+
+// writeElementMetadataIndex writes the list of the metadata tables of all
+// generated types, which ElementMetadataByPath looks elements up in.
+func (g *Generator) writeElementMetadataIndex() error {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+
+	tables := append([]string(nil), g.metadataTables...)
+	sort.Strings(tables)
+	fmt.Fprintf(&buf, "func init() {\n")
+	fmt.Fprintf(&buf, "\telementMetadataTables = [][]ElementMetadata{\n")
+	for _, table := range tables {
+		fmt.Fprintf(&buf, "\t\t%s,\n", table)
+	}
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return g.writeFormatted("element metadata", "element_metadata_index.go", buf.Bytes())
+}
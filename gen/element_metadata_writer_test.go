@@ -0,0 +1,57 @@
+package gen
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestWriteElementMetadata(t *testing.T) {
+	g := NewGenerator("", "")
+	def := StructureDefinition{Name: "TestResource", Kind: "resource", Snapshot: Snapshot{Element: []ElementDefinition{
+		{ID: "TestResource", Path: "TestResource", Max: "*"},
+		{ID: "TestResource.status", Path: "TestResource.status", Min: 1, Max: "1", Type: []ElementDataType{{Code: "code"}},
+			Binding: &Binding{Strength: "required", ValueSet: "http://example.org/vs|1"}, IsSummary: true, IsModifier: true},
+		{ID: "TestResource.subject", Path: "TestResource.subject", Max: "1",
+			Type: []ElementDataType{{Code: "Reference", TargetProfile: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}}}},
+		{ID: "TestResource.subject:patient", Path: "TestResource.subject", SliceName: "patient", Max: "1"},
+		{ID: "TestResource.part", Path: "TestResource.part", Max: "*", Type: []ElementDataType{{Code: "BackboneElement"}}},
+		{ID: "TestResource.part.part", Path: "TestResource.part.part", Max: "*", ContentReference: "#TestResource.part"},
+	}}}
+	fields := []FieldInfo{
+		{Name: "ResourceType", GoType: "string", JSONTag: "`json:\"resourceType\"`", Path: "TestResource.resourceType"},
+		{Name: "Status", GoType: "string", JSONTag: "`json:\"status\"`", Path: "TestResource.status"},
+		{Name: "StatusElement", GoType: "*Element", JSONTag: "`json:\"_status,omitempty\"`", Path: "TestResource.status", ElementOf: "Status"},
+		{Name: "Subject", GoType: "*Reference", JSONTag: "`json:\"subject,omitempty\"`", Path: "TestResource.subject"},
+		{Name: "Part", GoType: "[]TestResourcePart", JSONTag: "`json:\"part,omitempty\"`", Path: "TestResource.part"},
+	}
+
+	var buf bytes.Buffer
+	g.writeElementMetadata(&buf, "TestResource", fields, snapshotElements(def))
+	g.writeElementMetadata(&buf, "TestResourcePart", []FieldInfo{
+		{Name: "Part", GoType: "[]TestResourcePart", JSONTag: "`json:\"part,omitempty\"`", Path: "TestResource.part.part"},
+	}, snapshotElements(def))
+
+	want := "var testResourceElementMetadata = []ElementMetadata{\n" +
+		"\t{Path: \"TestResource.status\", Name: \"status\", Field: 1, Min: 1, Max: \"1\", Types: []string{\"code\"}, " +
+		"Binding: &BindingDefinition{Path: \"TestResource.status\", Strength: \"required\", ValueSet: \"http://example.org/vs\"}, Summary: true, Modifier: true},\n" +
+		"\t{Path: \"TestResource.subject\", Name: \"subject\", Field: 3, Min: 0, Max: \"1\", Types: []string{\"Reference\"}, " +
+		"TargetProfiles: []string{\"http://hl7.org/fhir/StructureDefinition/Patient\"}},\n" +
+		"\t{Path: \"TestResource.part\", Name: \"part\", Field: 4, Min: 0, Max: \"*\", Types: []string{\"BackboneElement\"}},\n" +
+		"}\n\n" +
+		"func (r *TestResource) ElementMetadata() []ElementMetadata {\n" +
+		"\treturn testResourceElementMetadata\n" +
+		"}\n\n" +
+		"var testResourcePartElementMetadata = []ElementMetadata{\n" +
+		"\t{Path: \"TestResource.part.part\", Name: \"part\", Field: 0, Min: 0, Max: \"*\", Types: []string{\"BackboneElement\"}},\n" +
+		"}\n\n" +
+		"func (r *TestResourcePart) ElementMetadata() []ElementMetadata {\n" +
+		"\treturn testResourcePartElementMetadata\n" +
+		"}\n\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeElementMetadata() =\n%s\nwant\n%s", got, want)
+	}
+	if want := []string{"testResourceElementMetadata", "testResourcePartElementMetadata"}; !reflect.DeepEqual(g.metadataTables, want) {
+		t.Errorf("metadataTables = %v, want %v", g.metadataTables, want)
+	}
+}
@@ -8,17 +8,22 @@ import (
 )
 
 type FieldInfo struct {
-	Name       string
-	GoType     string
-	JSONTag    string
-	BSONTag    string
-	Comment    string
-	Min        int
-	MaxLength  *int
-	Pattern    string
-	Fixed      any
-	IsRequired bool
-	Path       string
+	Name         string
+	GoType       string
+	JSONTag      string
+	BSONTag      string
+	Comment      string
+	Min          int
+	MaxLength    *int
+	Pattern      string
+	Fixed        any
+	PatternValue any
+	IsRequired   bool
+	Path         string
+	ElementOf    string
+	Choice       *ChoiceInfo
+	FHIRType     string
+	Targets      []string
 }
 
 func (g *Generator) ProcessElements(name string, elements []ElementDefinition, def StructureDefinition) map[string][]FieldInfo {
@@ -46,7 +51,7 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 			case "integer64":
 				valueType = "*int64"
 			case "decimal":
-				valueType = "*float64"
+				valueType = "*Decimal"
 			default:
 				continue
 			}
@@ -58,17 +63,19 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 			bsonTag := generateBSONTag("Value", el.Min == 0)
 
 			structs[name] = append(structs[name], FieldInfo{
-				Name:       "Value",
-				GoType:     valueType,
-				JSONTag:    jsonTag,
-				BSONTag:    bsonTag,
-				Comment:    el.Short,
-				Min:        el.Min,
-				MaxLength:  el.MaxLength,
-				Pattern:    el.Pattern,
-				Fixed:      el.Fixed,
-				IsRequired: el.Min > 0,
-				Path:       el.Path,
+				Name:         "Value",
+				GoType:       valueType,
+				JSONTag:      jsonTag,
+				BSONTag:      bsonTag,
+				Comment:      el.Short,
+				Min:          el.Min,
+				MaxLength:    el.MaxLength,
+				Pattern:      el.Pattern,
+				Fixed:        el.Fixed,
+				PatternValue: el.PatternValue,
+				IsRequired:   el.Min > 0,
+				Path:         el.Path,
+				FHIRType:     originalName,
 			})
 			continue
 		}
@@ -81,17 +88,18 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 			bsonTag := generateBSONTag("Id", el.Min == 0)
 
 			structs[name] = append(structs[name], FieldInfo{
-				Name:       "Id",
-				GoType:     "*string",
-				JSONTag:    jsonTag,
-				BSONTag:    bsonTag,
-				Comment:    el.Short,
-				Min:        el.Min,
-				MaxLength:  el.MaxLength,
-				Pattern:    el.Pattern,
-				Fixed:      el.Fixed,
-				IsRequired: el.Min > 0,
-				Path:       el.Path,
+				Name:         "Id",
+				GoType:       "*string",
+				JSONTag:      jsonTag,
+				BSONTag:      bsonTag,
+				Comment:      el.Short,
+				Min:          el.Min,
+				MaxLength:    el.MaxLength,
+				Pattern:      el.Pattern,
+				Fixed:        el.Fixed,
+				PatternValue: el.PatternValue,
+				IsRequired:   el.Min > 0,
+				Path:         el.Path,
 			})
 			continue
 		}
@@ -115,12 +123,12 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 			}
 		}
 
-		if strings.HasPrefix(lastPart, "_") || lastPart == "extension" || lastPart == "modifierExtension" {
+		if strings.HasPrefix(lastPart, "_") {
 			continue
 		}
 
 		var structName string
-		if len(parts) == 1 {
+		if len(parts) == 1 || (isPrimitiveType && len(parts) == 2 && parts[0] == originalName) {
 			structName = name
 		} else {
 			parentPath := strings.Join(parts[:len(parts)-1], ".")
@@ -144,10 +152,10 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 
 			if el.Max == "*" {
 				goType = "[]" + goType
-			} else if el.Min == 0 && !strings.HasPrefix(goType, "[]") && goType != "json.RawMessage" && goType != "any" {
+			} else if el.Min == 0 && !strings.HasPrefix(goType, "[]") && goType != "json.RawMessage" && goType != "any" && !g.isInterfaceType(goType) {
 				goType = "*" + goType
 			} else if el.Min > 0 && !strings.HasPrefix(goType, "[]") {
-				isPrimitive := goType == "bool" || goType == "string" || goType == "int" || goType == "int64" || goType == "float64" || goType == "json.RawMessage" || goType == "any"
+				isPrimitive := goType == "bool" || goType == "string" || goType == "int" || goType == "int64" || goType == "float64" || goType == "json.RawMessage" || goType == "any" || g.isInterfaceType(goType)
 				if !isPrimitive {
 					goType = "*" + goType
 				}
@@ -161,54 +169,58 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 			bsonTag := generateBSONTag(cleanName, el.Min == 0)
 
 			structs[structName] = append(structs[structName], FieldInfo{
-				Name:       cleanName,
-				GoType:     goType,
-				JSONTag:    jsonTag,
-				BSONTag:    bsonTag,
-				Comment:    el.Short,
-				Min:        el.Min,
-				MaxLength:  el.MaxLength,
-				Pattern:    el.Pattern,
-				Fixed:      el.Fixed,
-				IsRequired: el.Min > 0,
-				Path:       el.Path,
+				Name:         cleanName,
+				GoType:       goType,
+				JSONTag:      jsonTag,
+				BSONTag:      bsonTag,
+				Comment:      el.Short,
+				Min:          el.Min,
+				MaxLength:    el.MaxLength,
+				Pattern:      el.Pattern,
+				Fixed:        el.Fixed,
+				PatternValue: el.PatternValue,
+				IsRequired:   el.Min > 0,
+				Path:         el.Path,
 			})
 			continue
 		}
 
-		if strings.Contains(lastPart, "[x]") {
+		if strings.Contains(lastPart, "[x]") || len(el.Type) > 1 {
 			baseName := strings.ReplaceAll(lastPart, "[x]", "")
-			for _, fhirType := range el.Type {
-				singleTypeEl := el
-				singleTypeEl.Type = []ElementDataType{fhirType}
-
-				goType := g.mapGoType(singleTypeEl)
-
-				typeSuffix := text.TitleCase(fhirType.Code)
-				fieldName := text.TitleCase(baseName) + typeSuffix
-				jsonTag := fmt.Sprintf("`json:\"%s%s\"`", baseName, typeSuffix)
-				if el.Min == 0 {
-					jsonTag = fmt.Sprintf("`json:\"%s%s,omitempty\"`", baseName, typeSuffix)
-				}
-				bsonTag := generateBSONTag(fieldName, el.Min == 0)
-
-				if !strings.HasPrefix(goType, "*") && !strings.HasPrefix(goType, "[]") && goType != "any" {
-					goType = "*" + goType
+			siblingPath := strings.Join(append(parts[:len(parts)-1:len(parts)-1], baseName), ".")
+			hasSibling := false
+			for _, other := range elements {
+				if other.Path == siblingPath {
+					hasSibling = true
+					break
 				}
+			}
+			fieldName := text.TitleCase(baseName)
+			typeName := g.deriveNestedTypeName(el.Path)
+			if hasSibling {
+				fieldName += "Choice"
+				typeName += "Choice"
+			}
+			choice := g.choiceInfo(el, baseName, typeName, isPrimitiveType)
+			if choice == nil {
+				continue
+			}
 
-				structs[structName] = append(structs[structName], FieldInfo{
-					Name:       fieldName,
-					GoType:     goType,
-					JSONTag:    jsonTag,
-					BSONTag:    bsonTag,
-					Comment:    el.Short,
-					Min:        el.Min,
-					MaxLength:  el.MaxLength,
-					Pattern:    el.Pattern,
-					Fixed:      el.Fixed,
-					IsRequired: el.Min > 0,
-					Path:       el.Path,
-				})
+			structs[structName] = append(structs[structName], FieldInfo{
+				Name:       fieldName,
+				GoType:     choice.Interface,
+				JSONTag:    "`json:\"-\"`",
+				BSONTag:    generateBSONTag(fieldName, true),
+				Comment:    el.Short,
+				Min:        el.Min,
+				IsRequired: el.Min > 0,
+				Path:       el.Path,
+				Choice:     choice,
+			})
+			if choice.HasElement {
+				companion := primitiveElementField(fieldName, baseName+"[x]", false, el.Path)
+				companion.JSONTag = "`json:\"-\"`"
+				structs[structName] = append(structs[structName], companion)
 			}
 			continue
 		}
@@ -221,6 +233,9 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 		}
 
 		goType := g.mapGoType(el)
+		if enum := g.enumType(el); enum != "" {
+			goType = enum
+		}
 
 		if len(el.Type) > 0 &&
 			(el.Type[0].Code == "BackboneElement" || el.Type[0].Code == "Element") {
@@ -250,10 +265,10 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 
 		if el.Max == "*" {
 			goType = "[]" + goType
-		} else if el.Min == 0 && !strings.HasPrefix(goType, "[]") && goType != "json.RawMessage" && goType != "any" {
+		} else if el.Min == 0 && !strings.HasPrefix(goType, "[]") && goType != "json.RawMessage" && goType != "any" && !g.isInterfaceType(goType) {
 			goType = "*" + goType
 		} else if el.Min > 0 && !strings.HasPrefix(goType, "[]") {
-			isPrimitive := goType == "bool" || goType == "string" || goType == "int" || goType == "int64" || goType == "float64" || goType == "json.RawMessage" || goType == "any"
+			isPrimitive := goType == "bool" || goType == "string" || goType == "int" || goType == "int64" || goType == "float64" || goType == "json.RawMessage" || goType == "any" || g.isEnumType(goType) || g.isInterfaceType(goType)
 			if !isPrimitive {
 				goType = "*" + goType
 			}
@@ -267,22 +282,65 @@ func (g *Generator) ProcessElements(name string, elements []ElementDefinition, d
 		bsonTag := generateBSONTag(cleanName, el.Min == 0)
 
 		structs[structName] = append(structs[structName], FieldInfo{
-			Name:       cleanName,
-			GoType:     goType,
-			JSONTag:    jsonTag,
-			BSONTag:    bsonTag,
-			Comment:    el.Short,
-			Min:        el.Min,
-			MaxLength:  el.MaxLength,
-			Pattern:    el.Pattern,
-			Fixed:      el.Fixed,
-			IsRequired: el.Min > 0,
-			Path:       el.Path,
+			Name:         cleanName,
+			GoType:       goType,
+			JSONTag:      jsonTag,
+			BSONTag:      bsonTag,
+			Comment:      el.Short,
+			Min:          el.Min,
+			MaxLength:    el.MaxLength,
+			Pattern:      el.Pattern,
+			Fixed:        el.Fixed,
+			PatternValue: el.PatternValue,
+			IsRequired:   el.Min > 0,
+			Path:         el.Path,
+			FHIRType:     elementFHIRType(el),
+			Targets:      g.referenceTargets(el),
 		})
+		if len(el.Type) == 1 && g.hasPrimitiveElements(el.Type[0].Code, isPrimitiveType) {
+			structs[structName] = append(structs[structName], primitiveElementField(cleanName, lastPart, el.Max == "*", el.Path))
+		}
 	}
 	return structs
 }
 
+// elementFHIRType returns the FHIR type of a single-typed element, or "".
+func elementFHIRType(el ElementDefinition) string {
+	if len(el.Type) != 1 {
+		return ""
+	}
+	return el.Type[0].FHIRType()
+}
+
+// hasPrimitiveElements reports whether an element of the given FHIR type gets
+// a companion field for the JSON "_name" property carrying its id and
+// extensions. Companions are typed as Element, so they are only generated
+// when that datatype is part of the generated package.
+func (g *Generator) hasPrimitiveElements(fhirType string, isPrimitiveType bool) bool {
+	if isPrimitiveType || !isFHIRPrimitive(fhirType) || fhirType == "xhtml" {
+		return false
+	}
+	_, ok := g.Definitions["Element"]
+	return ok
+}
+
+func primitiveElementField(valueField, jsonName string, repeating bool, path string) FieldInfo {
+	goType := "*Element"
+	if repeating {
+		goType = "[]*Element"
+	}
+	name := valueField + "Element"
+	return FieldInfo{
+		Name:      name,
+		GoType:    goType,
+		JSONTag:   fmt.Sprintf("`json:\"_%s,omitempty\"`", jsonName),
+		BSONTag:   generateBSONTag(name, true),
+		Comment:   "Extensions for " + jsonName,
+		Path:      path,
+		ElementOf: valueField,
+	}
+}
+
 func generateBSONTag(fieldName string, hasOmitEmpty bool) string {
 	snakeName := text.ToSnakeCase(fieldName)
 	bsonTag := fmt.Sprintf("`bson:\"%s\"`", snakeName)
@@ -1,6 +1,7 @@
 package gen
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -136,156 +137,71 @@ func TestProcessElements_RequiredFields(t *testing.T) {
 }
 
 func TestProcessElements_ChoiceTypes(t *testing.T) {
-	tests := []struct {
-		name     string
-		elements []ElementDefinition
-		want     []struct {
-			fieldName string
-			goType    string
-			jsonTag   string
-		}
-	}{
+	elements := []ElementDefinition{
+		{ID: "TestResource", Path: "TestResource", Min: 0, Max: "*"},
 		{
-			name: "choice type [x] creates separate fields",
-			elements: []ElementDefinition{
-				{
-					ID:   "TestResource",
-					Path: "TestResource",
-					Min:  0,
-					Max:  "*",
-				},
-				{
-					ID:   "TestResource.value[x]",
-					Path: "TestResource.value[x]",
-					Min:  0,
-					Max:  "1",
-					Type: []ElementDataType{
-						{Code: "string"},
-						{Code: "integer"},
-					},
-					Short: "Choice type field",
-				},
-			},
-			want: []struct {
-				fieldName string
-				goType    string
-				jsonTag   string
-			}{
-				{
-					fieldName: "ValueString",
-					goType:    "*string",
-					jsonTag:   "valueString",
-				},
-				{
-					fieldName: "ValueInteger",
-					goType:    "*int",
-					jsonTag:   "valueInteger",
-				},
-			},
+			ID:    "TestResource.value[x]",
+			Path:  "TestResource.value[x]",
+			Min:   1,
+			Max:   "1",
+			Type:  []ElementDataType{{Code: "string"}, {Code: "integer"}, {Code: "Quantity"}},
+			Short: "Choice type field",
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewGenerator("", "")
-			def := StructureDefinition{
-				Name: "TestResource",
-				Kind: "resource",
-			}
-			structs := g.ProcessElements("TestResource", tt.elements, def)
-
-			fields, ok := structs["TestResource"]
-			if !ok {
-				t.Fatalf("struct TestResource not found")
-			}
-
-			if len(fields) < len(tt.want) {
-				t.Fatalf("got %d fields, want at least %d", len(fields), len(tt.want))
-			}
+	g := NewGenerator("", "")
+	def := StructureDefinition{Name: "TestResource", Kind: "resource"}
+	structs := g.ProcessElements("TestResource", elements, def)
 
-			for _, expected := range tt.want {
-				var found bool
-				for _, field := range fields {
-					if field.Name == expected.fieldName {
-						found = true
-						if !strings.Contains(field.GoType, expected.goType) {
-							t.Errorf("field %s: GoType = %s, want contains %s", field.Name, field.GoType, expected.goType)
-						}
-						if !strings.Contains(field.JSONTag, expected.jsonTag) {
-							t.Errorf("field %s: JSONTag = %s, want contains %s", field.Name, field.JSONTag, expected.jsonTag)
-						}
-						break
-					}
-				}
+	fields := structs["TestResource"]
+	if len(fields) != 1 {
+		t.Fatalf("got %d fields, want a single choice field: %+v", len(fields), fields)
+	}
+	field := fields[0]
+	if field.Name != "Value" || field.GoType != "TestResourceValue" {
+		t.Errorf("field = %s %s, want Value TestResourceValue", field.Name, field.GoType)
+	}
+	if field.JSONTag != "`json:\"-\"`" {
+		t.Errorf("JSONTag = %s, want json:\"-\"", field.JSONTag)
+	}
+	if !field.IsRequired {
+		t.Error("choice field with min 1 should be required")
+	}
+	if field.Choice == nil {
+		t.Fatal("Choice = nil")
+	}
+	if field.Choice.JSONName != "value" {
+		t.Errorf("JSONName = %s, want value", field.Choice.JSONName)
+	}
 
-				if !found {
-					t.Errorf("field %s not found", expected.fieldName)
-				}
-			}
-		})
+	want := []ChoiceVariant{
+		{TypeName: "TestResourceValueString", FHIRType: "string", GoType: "string"},
+		{TypeName: "TestResourceValueInteger", FHIRType: "integer", GoType: "int"},
+		{TypeName: "TestResourceValueQuantity", FHIRType: "Quantity", GoType: "Quantity"},
+	}
+	if !reflect.DeepEqual(field.Choice.Variants, want) {
+		t.Errorf("Variants = %+v, want %+v", field.Choice.Variants, want)
 	}
 }
 
-func TestProcessElements_ChoiceTypesJSONTags(t *testing.T) {
-	tests := []struct {
-		name     string
-		elements []ElementDefinition
-		wantJSON string
-	}{
-		{
-			name: "choice type [x] JSON tags should be valueString, valueInteger (not just value)",
-			elements: []ElementDefinition{
-				{
-					ID:   "TestResource",
-					Path: "TestResource",
-					Min:  0,
-					Max:  "*",
-				},
-				{
-					ID:   "TestResource.value[x]",
-					Path: "TestResource.value[x]",
-					Min:  0,
-					Max:  "1",
-					Type: []ElementDataType{
-						{Code: "string"},
-						{Code: "integer"},
-					},
-					Short: "Choice type field",
-				},
-			},
-			wantJSON: "valueString",
-		},
+func TestProcessElements_ChoiceTypeSiblingCollision(t *testing.T) {
+	elements := []ElementDefinition{
+		{ID: "TestResource", Path: "TestResource", Min: 0, Max: "*"},
+		{ID: "TestResource.type", Path: "TestResource.type", Min: 0, Max: "1", Type: []ElementDataType{{Code: "code"}}},
+		{ID: "TestResource.type[x]", Path: "TestResource.type[x]", Min: 0, Max: "1", Type: []ElementDataType{{Code: "canonical"}, {Code: "Reference"}}},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			g := NewGenerator("", "")
-			def := StructureDefinition{
-				Name: "TestResource",
-				Kind: "resource",
-			}
-			structs := g.ProcessElements("TestResource", tt.elements, def)
-
-			fields, ok := structs["TestResource"]
-			if !ok {
-				t.Fatalf("struct TestResource not found")
-			}
-
-			var found bool
-			for _, field := range fields {
-				if strings.Contains(field.JSONTag, tt.wantJSON) {
-					found = true
-					if strings.Contains(field.JSONTag, "value,") || strings.Contains(field.JSONTag, "\"value\"") {
-						t.Errorf("choice type JSON tag should be %s, not just 'value': %s", tt.wantJSON, field.JSONTag)
-					}
-					break
-				}
-			}
+	g := NewGenerator("", "")
+	def := StructureDefinition{Name: "TestResource", Kind: "resource"}
+	structs := g.ProcessElements("TestResource", elements, def)
 
-			if !found {
-				t.Errorf("field with JSON tag %s not found", tt.wantJSON)
-			}
-		})
+	var names []string
+	for _, field := range structs["TestResource"] {
+		names = append(names, field.Name+" "+field.GoType)
+	}
+	want := []string{"Type *string", "TypeChoice TestResourceTypeChoice"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("fields = %v, want %v", names, want)
 	}
 }
 
@@ -448,41 +364,6 @@ func TestProcessElements_SkipElements(t *testing.T) {
 			wantSkip:         []string{"ImplicitRules"},
 			shouldHaveStruct: true,
 		},
-		{
-			name: "skip extension and modifierExtension",
-			elements: []ElementDefinition{
-				{
-					ID:   "TestResource",
-					Path: "TestResource",
-					Min:  0,
-					Max:  "*",
-				},
-				{
-					ID:   "TestResource.extension",
-					Path: "TestResource.extension",
-					Min:  0,
-					Max:  "*",
-					Type: []ElementDataType{{Code: "Extension"}},
-				},
-				{
-					ID:   "TestResource.modifierExtension",
-					Path: "TestResource.modifierExtension",
-					Min:  0,
-					Max:  "*",
-					Type: []ElementDataType{{Code: "Extension"}},
-				},
-				{
-					ID:    "TestResource.validField",
-					Path:  "TestResource.validField",
-					Min:   0,
-					Max:   "1",
-					Type:  []ElementDataType{{Code: "string"}},
-					Short: "Valid field",
-				},
-			},
-			wantSkip:         []string{"Extension"},
-			shouldHaveStruct: true,
-		},
 	}
 
 	for _, tt := range tests {
@@ -512,6 +393,109 @@ func TestProcessElements_SkipElements(t *testing.T) {
 	}
 }
 
+func TestProcessElements_ExtensionFields(t *testing.T) {
+	elements := []ElementDefinition{
+		{
+			ID:   "TestResource",
+			Path: "TestResource",
+			Min:  0,
+			Max:  "*",
+		},
+		{
+			ID:    "TestResource.extension",
+			Path:  "TestResource.extension",
+			Min:   0,
+			Max:   "*",
+			Type:  []ElementDataType{{Code: "Extension"}},
+			Short: "Additional content defined by implementations",
+		},
+		{
+			ID:    "TestResource.modifierExtension",
+			Path:  "TestResource.modifierExtension",
+			Min:   0,
+			Max:   "*",
+			Type:  []ElementDataType{{Code: "Extension"}},
+			Short: "Extensions that cannot be ignored",
+		},
+		{
+			ID:   "TestResource.component",
+			Path: "TestResource.component",
+			Min:  0,
+			Max:  "*",
+			Type: []ElementDataType{{Code: "BackboneElement"}},
+		},
+		{
+			ID:   "TestResource.component.modifierExtension",
+			Path: "TestResource.component.modifierExtension",
+			Min:  0,
+			Max:  "*",
+			Type: []ElementDataType{{Code: "Extension"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		structName string
+		fieldName  string
+		wantType   string
+		wantJSON   string
+	}{
+		{
+			name:       "resource extension",
+			structName: "TestResource",
+			fieldName:  "Extension",
+			wantType:   "[]Extension",
+			wantJSON:   "`json:\"extension,omitempty\"`",
+		},
+		{
+			name:       "resource modifierExtension",
+			structName: "TestResource",
+			fieldName:  "ModifierExtension",
+			wantType:   "[]Extension",
+			wantJSON:   "`json:\"modifierExtension,omitempty\"`",
+		},
+		{
+			name:       "backbone element modifierExtension",
+			structName: "TestResourceComponent",
+			fieldName:  "ModifierExtension",
+			wantType:   "[]Extension",
+			wantJSON:   "`json:\"modifierExtension,omitempty\"`",
+		},
+	}
+
+	g := NewGenerator("", "")
+	def := StructureDefinition{
+		Name: "TestResource",
+		Kind: "resource",
+	}
+	structs := g.ProcessElements("TestResource", elements, def)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var found *FieldInfo
+			for i, field := range structs[tt.structName] {
+				if field.Name == tt.fieldName {
+					found = &structs[tt.structName][i]
+					break
+				}
+			}
+			if found == nil {
+				t.Fatalf("field %s not found in struct %s", tt.fieldName, tt.structName)
+			}
+			if found.GoType != tt.wantType {
+				t.Errorf("GoType = %v, want %v", found.GoType, tt.wantType)
+			}
+			if found.JSONTag != tt.wantJSON {
+				t.Errorf("JSONTag = %v, want %v", found.JSONTag, tt.wantJSON)
+			}
+		})
+	}
+
+	if !g.usedTypes["Extension"] {
+		t.Error("Extension should be tracked in usedTypes")
+	}
+}
+
 func getStructNames(structs map[string][]FieldInfo) []string {
 	names := make([]string, 0, len(structs))
 	for name := range structs {
@@ -715,3 +699,177 @@ func getFieldNames(fields []FieldInfo) []string {
 	}
 	return names
 }
+
+func TestProcessElements_PrimitiveElementFields(t *testing.T) {
+	elements := []ElementDefinition{
+		{
+			ID:   "TestResource",
+			Path: "TestResource",
+			Min:  0,
+			Max:  "*",
+		},
+		{
+			ID:   "TestResource.birthDate",
+			Path: "TestResource.birthDate",
+			Min:  0,
+			Max:  "1",
+			Type: []ElementDataType{{Code: "date"}},
+		},
+		{
+			ID:   "TestResource.given",
+			Path: "TestResource.given",
+			Min:  0,
+			Max:  "*",
+			Type: []ElementDataType{{Code: "string"}},
+		},
+		{
+			ID:   "TestResource.value[x]",
+			Path: "TestResource.value[x]",
+			Min:  0,
+			Max:  "1",
+			Type: []ElementDataType{{Code: "boolean"}, {Code: "Quantity"}},
+		},
+		{
+			ID:   "TestResource.div",
+			Path: "TestResource.div",
+			Min:  0,
+			Max:  "1",
+			Type: []ElementDataType{{Code: "xhtml"}},
+		},
+	}
+	def := StructureDefinition{Name: "TestResource", Kind: "resource"}
+
+	t.Run("without Element definition", func(t *testing.T) {
+		g := NewGenerator("", "")
+		structs := g.ProcessElements("TestResource", elements, def)
+		for _, field := range structs["TestResource"] {
+			if field.ElementOf != "" {
+				t.Errorf("unexpected companion field %s", field.Name)
+			}
+		}
+	})
+
+	t.Run("with Element definition", func(t *testing.T) {
+		g := NewGenerator("", "")
+		g.Definitions["Element"] = StructureDefinition{Name: "Element", Kind: "complex-type"}
+		structs := g.ProcessElements("TestResource", elements, def)
+
+		tests := []struct {
+			fieldName string
+			wantType  string
+			wantJSON  string
+			wantOf    string
+		}{
+			{"BirthDateElement", "*Element", "`json:\"_birthDate,omitempty\"`", "BirthDate"},
+			{"GivenElement", "[]*Element", "`json:\"_given,omitempty\"`", "Given"},
+			{"ValueElement", "*Element", "`json:\"-\"`", "Value"},
+		}
+		for _, tt := range tests {
+			var found *FieldInfo
+			for i, field := range structs["TestResource"] {
+				if field.Name == tt.fieldName {
+					found = &structs["TestResource"][i]
+					break
+				}
+			}
+			if found == nil {
+				t.Errorf("field %s not found", tt.fieldName)
+				continue
+			}
+			if found.GoType != tt.wantType {
+				t.Errorf("%s GoType = %v, want %v", tt.fieldName, found.GoType, tt.wantType)
+			}
+			if found.JSONTag != tt.wantJSON {
+				t.Errorf("%s JSONTag = %v, want %v", tt.fieldName, found.JSONTag, tt.wantJSON)
+			}
+			if found.ElementOf != tt.wantOf {
+				t.Errorf("%s ElementOf = %v, want %v", tt.fieldName, found.ElementOf, tt.wantOf)
+			}
+		}
+
+		for _, field := range structs["TestResource"] {
+			if field.Name == "ValueQuantityElement" || field.Name == "DivElement" {
+				t.Errorf("unexpected companion field %s", field.Name)
+			}
+		}
+	})
+}
+
+func TestProcessElements_PrimitiveTypeExtension(t *testing.T) {
+	elements := []ElementDefinition{
+		{ID: "decimal", Path: "decimal", Min: 0, Max: "*"},
+		{ID: "decimal.id", Path: "decimal.id", Min: 0, Max: "1", Type: []ElementDataType{{Code: "http://hl7.org/fhirpath/System.String"}}},
+		{ID: "decimal.extension", Path: "decimal.extension", Min: 0, Max: "*", Type: []ElementDataType{{Code: "Extension"}}},
+		{ID: "decimal.value", Path: "decimal.value", Min: 0, Max: "1", Type: []ElementDataType{{Code: "http://hl7.org/fhirpath/System.Decimal"}}},
+	}
+
+	g := NewGenerator("", "")
+	def := StructureDefinition{Name: "decimal", Kind: "primitive-type"}
+	structs := g.ProcessElements("FHIRDecimal", elements, def)
+
+	if len(structs) != 1 {
+		t.Fatalf("expected only FHIRDecimal struct, got %v", structs)
+	}
+	var names []string
+	for _, field := range structs["FHIRDecimal"] {
+		names = append(names, field.Name)
+	}
+	if strings.Join(names, ",") != "Id,Extension,Value" {
+		t.Errorf("FHIRDecimal fields = %v, want [Id Extension Value]", names)
+	}
+}
+
+func TestProcessElements_RequiredBindingEnums(t *testing.T) {
+	elements := []ElementDefinition{
+		{ID: "TestResource", Path: "TestResource", Min: 0, Max: "*"},
+		{
+			ID:      "TestResource.status",
+			Path:    "TestResource.status",
+			Min:     1,
+			Max:     "1",
+			Type:    []ElementDataType{{Code: "code"}},
+			Binding: &Binding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/test-status|5.0.0"},
+		},
+		{
+			ID:      "TestResource.priority",
+			Path:    "TestResource.priority",
+			Min:     0,
+			Max:     "1",
+			Type:    []ElementDataType{{Code: "code"}},
+			Binding: &Binding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/test-status"},
+		},
+		{
+			ID:      "TestResource.language",
+			Path:    "TestResource.language",
+			Min:     0,
+			Max:     "1",
+			Type:    []ElementDataType{{Code: "code"}},
+			Binding: &Binding{Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/test-status"},
+		},
+		{
+			ID:      "TestResource.kind",
+			Path:    "TestResource.kind",
+			Min:     0,
+			Max:     "*",
+			Type:    []ElementDataType{{Code: "code"}},
+			Binding: &Binding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/unknown"},
+		},
+	}
+
+	g := NewGenerator("", "")
+	g.valueSetTypes = map[string]string{"http://hl7.org/fhir/ValueSet/test-status": "TestStatus"}
+	g.enumTypes = map[string]bool{"TestStatus": true}
+	structs := g.ProcessElements("TestResource", elements, StructureDefinition{Name: "TestResource", Kind: "resource"})
+
+	want := map[string]string{
+		"Status":   "TestStatus",
+		"Priority": "*TestStatus",
+		"Language": "*string",
+		"Kind":     "[]string",
+	}
+	for _, field := range structs["TestResource"] {
+		if wantType, ok := want[field.Name]; ok && field.GoType != wantType {
+			t.Errorf("%s GoType = %v, want %v", field.Name, field.GoType, wantType)
+		}
+	}
+}
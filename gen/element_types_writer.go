@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// goPrimitiveTypes maps the Go type of a primitive element to the FHIR type
+// it stands for when the element does not record another one.
+var goPrimitiveTypes = map[string]string{
+	"string":   "string",
+	"bool":     "boolean",
+	"int":      "integer",
+	"int64":    "integer64",
+	"Decimal":  "decimal",
+	"Date":     "date",
+	"DateTime": "dateTime",
+	"Instant":  "instant",
+	"Time":     "time",
+}
+
+// elementTypes returns the primitive elements of a struct whose FHIR type
+// its Go type does not tell, such as a uri held in a string, in field
+// order.
+func (g *Generator) elementTypes(fields []FieldInfo) []FieldInfo {
+	var typed []FieldInfo
+	for _, f := range fields {
+		if f.Choice != nil || f.ElementOf != "" || !isFHIRPrimitive(f.FHIRType) {
+			continue
+		}
+		baseType := extractBaseType(f.GoType)
+		if g.isEnumType(baseType) || goPrimitiveTypes[baseType] == f.FHIRType {
+			continue
+		}
+		typed = append(typed, f)
+	}
+	return typed
+}
+
+// writeElementTypes writes the elementTypes method the runtime reads the
+// FHIR types of a struct's primitive elements from, keyed by element name,
+// when any differ from those of their Go types.
+func (g *Generator) writeElementTypes(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	if !g.writesStruct(structName, fields) {
+		return
+	}
+	typed := g.elementTypes(fields)
+	if len(typed) == 0 {
+		return
+	}
+
+	names := elementNames(fields)
+	varName := strings.ToLower(structName[:1]) + structName[1:] + "ElementTypes"
+	fmt.Fprintf(buf, "var %s = map[string]string{\n", varName)
+	for _, f := range typed {
+		fmt.Fprintf(buf, "\t%q: %q,\n", names[f.Name], f.FHIRType)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) elementTypes() map[string]string {\n", structName)
+	fmt.Fprintf(buf, "\treturn %s\n", varName)
+	fmt.Fprintf(buf, "}\n\n")
+}
@@ -0,0 +1,71 @@
+package gen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteElementTypes(t *testing.T) {
+	g := NewGenerator("", "")
+	fields := []FieldInfo{
+		{Name: "Url", GoType: "string", JSONTag: "`json:\"url,omitempty\"`", FHIRType: "uri"},
+		{Name: "Status", GoType: "*string", JSONTag: "`json:\"status,omitempty\"`", FHIRType: "code"},
+		{Name: "StatusElement", GoType: "*Element", JSONTag: "`json:\"_status,omitempty\"`", ElementOf: "Status"},
+		{Name: "Count", GoType: "*int", JSONTag: "`json:\"count,omitempty\"`", FHIRType: "positiveInt"},
+		{Name: "Title", GoType: "*string", JSONTag: "`json:\"title,omitempty\"`", FHIRType: "string"},
+		{Name: "Date", GoType: "*DateTime", JSONTag: "`json:\"date,omitempty\"`", FHIRType: "dateTime"},
+		{Name: "Code", GoType: "*CodeableConcept", JSONTag: "`json:\"code,omitempty\"`", FHIRType: "CodeableConcept"},
+	}
+
+	var buf bytes.Buffer
+	g.writeElementTypes(&buf, "TestResource", fields)
+
+	want := "var testResourceElementTypes = map[string]string{\n" +
+		"\t\"url\": \"uri\",\n" +
+		"\t\"status\": \"code\",\n" +
+		"\t\"count\": \"positiveInt\",\n" +
+		"}\n\n" +
+		"func (r *TestResource) elementTypes() map[string]string {\n" +
+		"\treturn testResourceElementTypes\n" +
+		"}\n\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeElementTypes() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteElementTypes_SkipsDefaultTypes(t *testing.T) {
+	g := NewGenerator("", "")
+	fields := []FieldInfo{
+		{Name: "Title", GoType: "*string", JSONTag: "`json:\"title,omitempty\"`", FHIRType: "string"},
+		{Name: "Active", GoType: "*bool", JSONTag: "`json:\"active,omitempty\"`", FHIRType: "boolean"},
+	}
+
+	var buf bytes.Buffer
+	g.writeElementTypes(&buf, "TestResource", fields)
+	if strings.Contains(buf.String(), "elementTypes") {
+		t.Errorf("expected no element types, got:\n%s", buf.String())
+	}
+}
+
+func TestElementDataType_FHIRType(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  ElementDataType
+		want string
+	}{
+		{"named type", ElementDataType{Code: "uri"}, "uri"},
+		{"system type", ElementDataType{Code: "http://hl7.org/fhirpath/System.String"}, "string"},
+		{"extension", ElementDataType{
+			Code:      "http://hl7.org/fhirpath/System.String",
+			Extension: []TypeExtension{{URL: fhirTypeExtension, ValueURL: "id"}},
+		}, "id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.typ.FHIRType(); got != tt.want {
+				t.Errorf("FHIRType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,30 +4,70 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/gruzdev-dev/fhir/tools/text"
 )
 
+// Options select what the generator writes. The zero value is not useful;
+// start from DefaultOptions.
+type Options struct {
+	// PackageName is the name of the generated package.
+	PackageName string `json:"package"`
+	// Resources limits generation to the named resources when not empty.
+	Resources []string `json:"resources,omitempty"`
+	// ExcludeResources names resources that are not generated.
+	ExcludeResources []string `json:"exclude,omitempty"`
+	// BSONTags adds bson struct tags next to the json ones, and the
+	// MarshalBSON and UnmarshalBSON methods that encode structs by them.
+	BSONTags bool `json:"bson"`
+	// Validation generates the checks of Validate and ValidateAll, the
+	// invariants, the profiles and the value set bindings, together with
+	// the runtime files they need, such as the FHIRPath engine. Without it,
+	// Validate and ValidateAll accept every value.
+	Validation bool `json:"validation"`
+	// XML generates the FHIR XML encoding of the models and its runtime
+	// file.
+	XML bool `json:"xml"`
+}
+
+// DefaultOptions returns the options the r5 package is generated with.
+func DefaultOptions() Options {
+	return Options{PackageName: "models", BSONTags: true, Validation: true, XML: true}
+}
+
 type Generator struct {
 	SpecPath    string
 	OutputPath  string
+	Options     Options
 	Definitions map[string]StructureDefinition
+	Profiles    []StructureDefinition
 	usedTypes   map[string]bool
+
+	valueSetTypes  map[string]string
+	enumTypes      map[string]bool
+	metadataTables []string
+
+	previous *Manifest
+	inputs   map[string]string
+	files    map[string]string
+	checking bool
+	stale    []string
 }
 
 func NewGenerator(specPath, outputPath string) *Generator {
 	return &Generator{
 		SpecPath:    specPath,
 		OutputPath:  outputPath,
+		Options:     DefaultOptions(),
 		Definitions: make(map[string]StructureDefinition),
 		usedTypes:   make(map[string]bool),
 	}
 }
 
 func (g *Generator) Load(filename string) error {
-	path := filepath.Join(g.SpecPath, filename)
-	data, err := os.ReadFile(path)
+	data, err := g.readSpec(filename)
 	if err != nil {
 		return err
 	}
@@ -38,6 +78,9 @@ func (g *Generator) Load(filename string) error {
 	}
 
 	for _, entry := range bundle.Entry {
+		if entry.Resource.Kind == "operation" || entry.Resource.Kind == "query" {
+			continue
+		}
 		if entry.Resource.Name != "" {
 			g.Definitions[entry.Resource.Name] = entry.Resource
 		}
@@ -46,29 +89,161 @@ func (g *Generator) Load(filename string) error {
 }
 
 func (g *Generator) Generate() error {
-	if err := os.MkdirAll(g.OutputPath, 0755); err != nil {
+	if err := g.checkResourceOptions(); err != nil {
 		return err
 	}
-
-	entries, err := os.ReadDir(g.OutputPath)
-	if err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("read output directory: %w", err)
-	}
-	for _, entry := range entries {
-		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".go" {
-			if err := os.Remove(filepath.Join(g.OutputPath, entry.Name())); err != nil {
-				return fmt.Errorf("remove old file %s: %w", entry.Name(), err)
-			}
+	if !g.checking {
+		if err := os.MkdirAll(g.OutputPath, 0755); err != nil {
+			return err
 		}
 	}
+	if err := g.readManifest(); err != nil {
+		return err
+	}
+	g.files = make(map[string]string)
+	g.metadataTables = nil
+	if err := g.WriteRuntime(); err != nil {
+		return err
+	}
 
-	for _, def := range g.Definitions {
-		if !text.IsValidGoIdentifier(def.Name) {
+	for _, name := range g.definitionNames() {
+		def := g.Definitions[name]
+		if !text.IsValidGoIdentifier(def.Name) || !g.generates(def) || g.sharesPrimitiveType(def) {
 			continue
 		}
 		if err := g.WriteResource(def); err != nil {
 			return err
 		}
 	}
+
+	if g.hasResourceInterface() {
+		if err := g.writeResourceRegistry(); err != nil {
+			return err
+		}
+	}
+	if g.hasOperationOutcome() {
+		if err := g.writeValidationOutcome(); err != nil {
+			return err
+		}
+	}
+	if len(g.metadataTables) > 0 {
+		if err := g.writeElementMetadataIndex(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run loads the specification and generates the whole package: the models,
+// value set constants, search parameters, operation parameters and, with
+// validation, the bindings and profiles. It finishes with WriteManifest, so
+// files whose content is unchanged are left alone and files no longer
+// generated are deleted. When the manifest shows the output was generated
+// from the same specification files and options and is unchanged, Run
+// does nothing.
+func (g *Generator) Run() error {
+	if err := g.readManifest(); err != nil {
+		return err
+	}
+	if ok, err := g.upToDate(); err != nil || ok {
+		return err
+	}
+	for _, file := range []string{"profiles-types.json", "profiles-resources.json"} {
+		if err := g.Load(file); err != nil {
+			return fmt.Errorf("load %s: %w", file, err)
+		}
+	}
+	if g.Options.Validation {
+		if err := g.LoadProfiles("profiles-others.json"); err != nil {
+			return fmt.Errorf("load profiles: %w", err)
+		}
+	}
+	if err := g.ResolveValueSetTypes(); err != nil {
+		return fmt.Errorf("resolve value sets: %w", err)
+	}
+	if err := g.Generate(); err != nil {
+		return err
+	}
+	if err := g.GenerateValueSets(); err != nil {
+		return fmt.Errorf("generate value sets: %w", err)
+	}
+	if err := g.GenerateSearchParameters(); err != nil {
+		return fmt.Errorf("generate search parameters: %w", err)
+	}
+	if err := g.GenerateOperations(); err != nil {
+		return fmt.Errorf("generate operations: %w", err)
+	}
+	if g.Options.Validation {
+		if err := g.GenerateBindings(); err != nil {
+			return fmt.Errorf("generate bindings: %w", err)
+		}
+		if err := g.GenerateProfiles(); err != nil {
+			return fmt.Errorf("generate profiles: %w", err)
+		}
+	}
+	return g.WriteManifest()
+}
+
+// definitionNames returns the names of the loaded definitions, sorted, so
+// that files are generated in the same order on every run.
+func (g *Generator) definitionNames() []string {
+	names := make([]string, 0, len(g.Definitions))
+	for name := range g.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// generates reports whether a definition is generated under the resource
+// options. Data types and abstract resources are always generated.
+func (g *Generator) generates(def StructureDefinition) bool {
+	if def.Kind != "resource" || def.Abstract {
+		return true
+	}
+	return g.generatesResource(def.Name)
+}
+
+func (g *Generator) generatesResource(name string) bool {
+	if len(g.Options.Resources) > 0 && !containsName(g.Options.Resources, name) {
+		return false
+	}
+	return !containsName(g.Options.ExcludeResources, name)
+}
+
+// checkResourceOptions rejects resource options naming resources that are
+// not loaded, which would otherwise be silently ignored.
+func (g *Generator) checkResourceOptions() error {
+	for _, name := range append(append([]string(nil), g.Options.Resources...), g.Options.ExcludeResources...) {
+		def, ok := g.Definitions[name]
+		if !ok || def.Kind != "resource" || def.Abstract {
+			return fmt.Errorf("unknown resource %q", name)
+		}
+	}
 	return nil
 }
+
+// sharesPrimitiveType reports whether a primitive is generated as the Go
+// type of another loaded primitive, such as uri and code as the FHIRString
+// of string. Only that primitive writes the type.
+func (g *Generator) sharesPrimitiveType(def StructureDefinition) bool {
+	if def.Kind != "primitive-type" || !needsFHIRPrefix(def.Name) {
+		return false
+	}
+	owner := strings.TrimPrefix(getFHIRTypeName(def.Name), "FHIR")
+	owner = strings.ToLower(owner[:1]) + owner[1:]
+	if owner == def.Name {
+		return false
+	}
+	_, ok := g.Definitions[owner]
+	return ok
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Invariant is a constraint of a structure definition attached to the
+// generated struct it is evaluated on. Context is the JSON name of the
+// child element the constraint is declared on, empty when it is declared
+// on the element the struct represents.
+type Invariant struct {
+	Constraint
+	Context string
+}
+
+// collectInvariants assigns the constraints of def to the structs
+// generated for it. Constraints of the root and of backbone elements go on
+// their own struct. Constraints of other elements go on the parent struct
+// with the element as context, except those inherited from the element's
+// type, which the struct of that type carries itself.
+func (g *Generator) collectInvariants(name string, def StructureDefinition, structMap map[string][]FieldInfo) map[string][]Invariant {
+	if def.Kind == "primitive-type" || len(def.Snapshot.Element) == 0 {
+		return nil
+	}
+	invariants := make(map[string][]Invariant)
+	root := def.Snapshot.Element[0].Path
+	for _, el := range def.Snapshot.Element {
+		if len(el.Constraint) == 0 || strings.Contains(el.ID, ":") {
+			continue
+		}
+		if el.Path == root {
+			for _, c := range el.Constraint {
+				invariants[name] = append(invariants[name], Invariant{Constraint: c})
+			}
+			continue
+		}
+		if isBackboneElement(el) {
+			structName := g.deriveNestedTypeName(el.Path)
+			if _, ok := structMap[structName]; ok {
+				for _, c := range el.Constraint {
+					invariants[structName] = append(invariants[structName], Invariant{Constraint: c})
+				}
+			}
+			continue
+		}
+
+		parts := strings.Split(el.Path, ".")
+		parent := name
+		if len(parts) > 2 {
+			parent = g.deriveNestedTypeName(strings.Join(parts[:len(parts)-1], "."))
+		}
+		if _, ok := structMap[parent]; !ok {
+			continue
+		}
+		context := strings.TrimSuffix(parts[len(parts)-1], "[x]")
+		for _, c := range el.Constraint {
+			if g.declaredBy(c, def, el) {
+				invariants[parent] = append(invariants[parent], Invariant{Constraint: c, Context: context})
+			}
+		}
+	}
+	return invariants
+}
+
+// isBackboneElement reports whether el is generated as a nested struct.
+func isBackboneElement(el ElementDefinition) bool {
+	return len(el.Type) > 0 && (el.Type[0].Code == "BackboneElement" || el.Type[0].Code == "Element") && el.ContentReference == ""
+}
+
+// declaredBy reports whether constraint c of element el is declared by def
+// itself rather than inherited from the element's type. Without a source
+// the constraint is taken as inherited when the root of one of the types
+// declares the same key.
+func (g *Generator) declaredBy(c Constraint, def StructureDefinition, el ElementDefinition) bool {
+	if c.Source != "" {
+		if def.URL != "" {
+			return c.Source == def.URL
+		}
+		return path.Base(c.Source) == def.ID
+	}
+	for _, t := range el.Type {
+		typeDef, ok := g.Definitions[t.Code]
+		if !ok || len(typeDef.Snapshot.Element) == 0 {
+			continue
+		}
+		for _, inherited := range typeDef.Snapshot.Element[0].Constraint {
+			if inherited.Key == c.Key {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// writeInvariants writes the invariants of a struct as a package-level
+// table returned by its invariants method.
+func writeInvariants(buf *bytes.Buffer, structName string, invariants []Invariant) {
+	if len(invariants) == 0 {
+		return
+	}
+	table := strings.ToLower(structName[:1]) + structName[1:] + "Invariants"
+	fmt.Fprintf(buf, "var %s = []invariant{\n", table)
+	for _, inv := range invariants {
+		fmt.Fprintf(buf, "\t{key: %q, severity: %q, human: %q, expression: %q", inv.Key, inv.Severity, inv.Human, inv.Expression)
+		if inv.Context != "" {
+			fmt.Fprintf(buf, ", context: %q", inv.Context)
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "func (r *%s) invariants() []invariant {\n", structName)
+	fmt.Fprintf(buf, "\treturn %s\n", table)
+	fmt.Fprintf(buf, "}\n\n")
+}
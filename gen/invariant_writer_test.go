@@ -0,0 +1,125 @@
+package gen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func invariantTestDefinition() StructureDefinition {
+	const url = "http://hl7.org/fhir/StructureDefinition/TestObservation"
+	return StructureDefinition{
+		Name: "TestObservation",
+		URL:  url,
+		Kind: "resource",
+		Snapshot: Snapshot{Element: []ElementDefinition{
+			{ID: "TestObservation", Path: "TestObservation", Constraint: []Constraint{
+				{Key: "tst-1", Severity: "error", Human: "Value or reason", Expression: "dataAbsentReason.empty() or value.empty()", Source: url},
+			}},
+			{ID: "TestObservation.value[x]", Path: "TestObservation.value[x]", Max: "1", Type: []ElementDataType{{Code: "string"}, {Code: "boolean"}}, Constraint: []Constraint{
+				{Key: "ele-1", Severity: "error", Human: "All FHIR elements must have a @value or children", Expression: "hasValue() or (children().count() > id.count())", Source: "http://hl7.org/fhir/StructureDefinition/Element"},
+			}},
+			{ID: "TestObservation.dataAbsentReason", Path: "TestObservation.dataAbsentReason", Max: "1", Type: []ElementDataType{{Code: "string"}}},
+			{ID: "TestObservation.range", Path: "TestObservation.range", Max: "*", Type: []ElementDataType{{Code: "BackboneElement"}}, Constraint: []Constraint{
+				{Key: "tst-2", Severity: "warning", Human: "Low or high", Expression: "low.exists() or high.exists()", Source: url},
+			}},
+			{ID: "TestObservation.range.low", Path: "TestObservation.range.low", Max: "1", Type: []ElementDataType{{Code: "string"}}, Constraint: []Constraint{
+				{Key: "tst-3", Severity: "error", Human: "Short low", Expression: "length() < 10", Source: url},
+			}},
+			{ID: "TestObservation.range.high", Path: "TestObservation.range.high", Max: "1", Type: []ElementDataType{{Code: "string"}}},
+		}},
+	}
+}
+
+func TestCollectInvariants(t *testing.T) {
+	g := NewGenerator("", t.TempDir())
+	def := invariantTestDefinition()
+	g.Definitions[def.Name] = def
+	structMap := g.ProcessElements(def.Name, def.Snapshot.Element, def)
+
+	invariants := g.collectInvariants(def.Name, def, structMap)
+	keys := make(map[string][]string)
+	for structName, list := range invariants {
+		for _, inv := range list {
+			keys[structName] = append(keys[structName], inv.Key+"@"+inv.Context)
+		}
+	}
+	want := map[string][]string{
+		"TestObservation":      {"tst-1@"},
+		"TestObservationRange": {"tst-2@", "tst-3@low"},
+	}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("collectInvariants() = %v, want %v", keys, want)
+	}
+}
+
+func TestDeclaredBy_WithoutSource(t *testing.T) {
+	g := NewGenerator("", t.TempDir())
+	g.Definitions["Quantity"] = StructureDefinition{Name: "Quantity", Snapshot: Snapshot{Element: []ElementDefinition{
+		{Path: "Quantity", Constraint: []Constraint{{Key: "qty-3"}}},
+	}}}
+	el := ElementDefinition{Path: "Test.amount", Type: []ElementDataType{{Code: "Quantity"}}}
+	def := StructureDefinition{ID: "Test", URL: "http://example.org/Test"}
+
+	if g.declaredBy(Constraint{Key: "qty-3"}, def, el) {
+		t.Error("qty-3 is inherited from Quantity")
+	}
+	if !g.declaredBy(Constraint{Key: "tst-1"}, def, el) {
+		t.Error("tst-1 is declared by Test")
+	}
+	if g.declaredBy(Constraint{Key: "tst-1", Source: "http://example.org/Other"}, def, el) {
+		t.Error("a constraint with another source is inherited")
+	}
+}
+
+func TestWriteInvariants(t *testing.T) {
+	var buf bytes.Buffer
+	writeInvariants(&buf, "TestObservationRange", []Invariant{
+		{Constraint: Constraint{Key: "tst-3", Severity: "error", Human: "Short low", Expression: "length() < 10"}, Context: "low"},
+	})
+	code := buf.String()
+	for _, exp := range []string{
+		"var testObservationRangeInvariants = []invariant{",
+		`{key: "tst-3", severity: "error", human: "Short low", expression: "length() < 10", context: "low"},`,
+		"func (r *TestObservationRange) invariants() []invariant {",
+		"return testObservationRangeInvariants",
+	} {
+		if !strings.Contains(code, exp) {
+			t.Errorf("writeInvariants() missing %q in:\n%s", exp, code)
+		}
+	}
+
+	buf.Reset()
+	writeInvariants(&buf, "Empty", nil)
+	if buf.Len() != 0 {
+		t.Errorf("writeInvariants() without invariants = %q, want nothing", buf.String())
+	}
+}
+
+func TestWriteResource_EvaluatesInvariants(t *testing.T) {
+	g := NewGenerator("", t.TempDir())
+	def := invariantTestDefinition()
+	g.Definitions[def.Name] = def
+
+	if err := g.WriteResource(def); err != nil {
+		t.Fatalf("WriteResource() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "test_observation.go"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	code := string(data)
+	for _, exp := range []string{
+		`return validateInvariants(r, "TestObservation")`,
+		`checkInvariants(r, "TestObservation", &issues)`,
+		"func (r *TestObservation) invariants() []invariant {",
+		"func (r *TestObservationRange) invariants() []invariant {",
+	} {
+		if !strings.Contains(code, exp) {
+			t.Errorf("generated code missing %q", exp)
+		}
+	}
+}
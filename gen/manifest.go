@@ -0,0 +1,197 @@
+package gen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Version identifies the generator in manifests. Bump it with changes that
+// change the generated code, so manifests tell which generator wrote them.
+const Version = "2"
+
+// ManifestFile is the name of the manifest kept in the output directory.
+const ManifestFile = "fhirgen-manifest.json"
+
+// Manifest records a generation run: the generator version and options,
+// the checksums of the specification files read and of the files written.
+// The next run is skipped when none of them changed, and otherwise only
+// replaces files whose content changed and only deletes files listed here,
+// so hand-written files can live next to generated ones.
+type Manifest struct {
+	Generator string            `json:"generator"`
+	Options   Options           `json:"options"`
+	Inputs    map[string]string `json:"inputs"`
+	Files     map[string]string `json:"files"`
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// readSpec reads a file of the specification directory, recording its
+// checksum as an input of the run.
+func (g *Generator) readSpec(filename string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(g.SpecPath, filename))
+	if err != nil {
+		return nil, err
+	}
+	if g.inputs == nil {
+		g.inputs = make(map[string]string)
+	}
+	g.inputs[filename] = checksum(data)
+	return data, nil
+}
+
+// readManifest reads the manifest of the previous run, if any.
+func (g *Generator) readManifest() error {
+	g.previous = nil
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, ManifestFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("read %s: %w", ManifestFile, err)
+	}
+	g.previous = &m
+	return nil
+}
+
+// upToDate reports whether the previous run used this generator version
+// and runtime files, the same options and the same specification files,
+// and its files are unchanged, so that running again would write the same
+// output.
+func (g *Generator) upToDate() (bool, error) {
+	m := g.previous
+	if m == nil || m.Generator != Version || len(m.Inputs) == 0 {
+		return false, nil
+	}
+	previous, err := json.Marshal(m.Options)
+	if err != nil {
+		return false, err
+	}
+	current, err := json.Marshal(g.Options)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(previous, current) {
+		return false, nil
+	}
+	if changed, err := g.runtimeChanged(m); err != nil || changed {
+		return false, err
+	}
+	for name, sum := range m.Inputs {
+		data, err := os.ReadFile(filepath.Join(g.SpecPath, name))
+		if err != nil || checksum(data) != sum {
+			return false, nil
+		}
+	}
+	for name, sum := range m.Files {
+		data, err := os.ReadFile(filepath.Join(g.OutputPath, name))
+		if err != nil || checksum(data) != sum {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// writeFile writes a generated file unless it already has the content. A
+// file the previous run did not generate is not overwritten, and is an
+// error in check mode too. Otherwise check mode records the files it would
+// write as stale.
+func (g *Generator) writeFile(fileName string, data []byte) error {
+	if g.files == nil {
+		g.files = make(map[string]string)
+	}
+	g.files[fileName] = checksum(data)
+
+	path := filepath.Join(g.OutputPath, fileName)
+	current, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(current, data) {
+		return nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil && g.previous != nil {
+		if _, generated := g.previous.Files[fileName]; !generated {
+			return fmt.Errorf("%s was not generated and would be overwritten", fileName)
+		}
+	}
+	if g.checking {
+		g.stale = append(g.stale, fileName)
+		return nil
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// WriteManifest finishes a run: it deletes the files the previous run
+// generated that this one did not, and writes the manifest. Without a
+// previous manifest no file is deleted.
+func (g *Generator) WriteManifest() error {
+	if g.previous != nil {
+		var names []string
+		for name := range g.previous.Files {
+			if _, ok := g.files[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			path := filepath.Join(g.OutputPath, name)
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				continue
+			}
+			if g.checking {
+				g.stale = append(g.stale, name)
+				continue
+			}
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("remove old file %s: %w", name, err)
+			}
+		}
+	}
+
+	m := Manifest{Generator: Version, Options: g.Options, Inputs: g.inputs, Files: g.files}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	path := filepath.Join(g.OutputPath, ManifestFile)
+	if current, err := os.ReadFile(path); err == nil && bytes.Equal(current, data) {
+		return nil
+	}
+	if g.checking {
+		g.stale = append(g.stale, ManifestFile)
+		return nil
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Check generates the package in memory and returns the files of the
+// output directory that differ from it: files to be written, rewritten or
+// deleted, and the manifest when the specification or options changed.
+// Nothing is written. A hand-written file generation would overwrite is an
+// error, as it is for Run.
+func (g *Generator) Check() ([]string, error) {
+	g.checking = true
+	defer func() { g.checking = false }()
+	g.stale = nil
+	if err := g.Run(); err != nil {
+		return nil, err
+	}
+	stale := append([]string(nil), g.stale...)
+	sort.Strings(stale)
+	return stale, nil
+}
@@ -0,0 +1,170 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const emptyBundle = `{"resourceType": "Bundle", "entry": []}`
+
+// manifestTestGenerator returns a generator over a specification with the
+// resources Alpha and Beta.
+func manifestTestGenerator(t *testing.T, outputPath string) *Generator {
+	specPath := t.TempDir()
+	resources := `{"resourceType": "Bundle", "entry": [
+		{"resource": {"resourceType": "StructureDefinition", "name": "Alpha", "kind": "resource", "snapshot": {"element": [
+			{"id": "Alpha", "path": "Alpha", "min": 0, "max": "*"},
+			{"id": "Alpha.name", "path": "Alpha.name", "min": 0, "max": "1", "type": [{"code": "string"}]}]}}},
+		{"resource": {"resourceType": "StructureDefinition", "name": "Beta", "kind": "resource", "snapshot": {"element": [
+			{"id": "Beta", "path": "Beta", "min": 0, "max": "*"},
+			{"id": "Beta.name", "path": "Beta.name", "min": 0, "max": "1", "type": [{"code": "string"}]}]}}}
+	]}`
+	files := map[string]string{
+		"profiles-types.json":     emptyBundle,
+		"profiles-resources.json": resources,
+		"profiles-others.json":    emptyBundle,
+		"valuesets.json":          emptyBundle,
+		"search-parameters.json":  emptyBundle,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(specPath, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewGenerator(specPath, outputPath)
+}
+
+func TestRun_Manifest(t *testing.T) {
+	out := t.TempDir()
+	if err := manifestTestGenerator(t, out).Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, name := range []string{"alpha.go", "beta.go", ManifestFile} {
+		if _, err := os.Stat(filepath.Join(out, name)); err != nil {
+			t.Fatalf("Run() did not write %s: %v", name, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(out, "helpers.go"), []byte("package models\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(filepath.Join(out, "alpha.go"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	g := manifestTestGenerator(t, out)
+	g.Options.ExcludeResources = []string{"Beta"}
+	stale, err := g.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if !reflect.DeepEqual(stale, []string{"beta.go", "element_metadata_index.go", ManifestFile}) {
+		t.Errorf("Check() = %v, want beta.go, the metadata index and the manifest", stale)
+	}
+	if _, err := os.Stat(filepath.Join(out, "beta.go")); err != nil {
+		t.Errorf("Check() changed the output directory: %v", err)
+	}
+
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(out, "beta.go")); !os.IsNotExist(err) {
+		t.Error("Run() kept beta.go, which is no longer generated")
+	}
+	if _, err := os.Stat(filepath.Join(out, "helpers.go")); err != nil {
+		t.Errorf("Run() deleted the hand-written helpers.go: %v", err)
+	}
+	if info, err := os.Stat(filepath.Join(out, "alpha.go")); err != nil || !info.ModTime().Equal(old) {
+		t.Errorf("Run() rewrote the unchanged alpha.go")
+	}
+	if stale, err := g.Check(); err != nil || len(stale) != 0 {
+		t.Errorf("Check() = %v, %v after Run, want nothing stale", stale, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(out, "alpha.go"), []byte("package models\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if stale, _ := g.Check(); !reflect.DeepEqual(stale, []string{"alpha.go"}) {
+		t.Errorf("Check() = %v, want the edited alpha.go", stale)
+	}
+}
+
+func TestRun_KeepsHandWrittenFiles(t *testing.T) {
+	out := t.TempDir()
+	g := manifestTestGenerator(t, out)
+	g.Options.ExcludeResources = []string{"Beta"}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(out, "beta.go"), []byte("package models\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := manifestTestGenerator(t, out).Run()
+	if err == nil || !strings.Contains(err.Error(), "beta.go was not generated") {
+		t.Errorf("Run() error = %v, want a refusal to overwrite beta.go", err)
+	}
+}
+
+func TestCheck_HandWrittenFile(t *testing.T) {
+	out := t.TempDir()
+	g := manifestTestGenerator(t, out)
+	g.Options.ExcludeResources = []string{"Beta"}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(out, "beta.go"), []byte("package models\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stale, err := manifestTestGenerator(t, out).Check()
+	if err == nil || !strings.Contains(err.Error(), "beta.go was not generated") {
+		t.Errorf("Check() = %v, %v, want a conflict on beta.go", stale, err)
+	}
+}
+
+func TestRun_SkipsUnchangedInput(t *testing.T) {
+	out := t.TempDir()
+	if err := manifestTestGenerator(t, out).Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	g := manifestTestGenerator(t, out)
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(g.Definitions) != 0 {
+		t.Error("Run() loaded the specification, want the unchanged run skipped")
+	}
+
+	resources := filepath.Join(g.SpecPath, "profiles-resources.json")
+	data, err := os.ReadFile(resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(resources, append(data, '\n'), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(g.Definitions) == 0 {
+		t.Error("Run() skipped the run of a changed specification")
+	}
+	if err := g.readManifest(); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := g.upToDate(); err != nil || !ok {
+		t.Errorf("upToDate() = %v, %v after Run, want true", ok, err)
+	}
+
+	// a runtime file edited in gen/runtime since the run
+	g.previous.Files["temporal.go"] = checksum([]byte("package models\n"))
+	if changed, err := g.runtimeChanged(g.previous); err != nil || !changed {
+		t.Errorf("runtimeChanged() = %v, %v with an edited runtime file, want true", changed, err)
+	}
+}
@@ -0,0 +1,35 @@
+package gen
+
+type OperationDefinitionBundle struct {
+	ResourceType string                           `json:"resourceType"`
+	Entry        []OperationDefinitionBundleEntry `json:"entry"`
+}
+
+type OperationDefinitionBundleEntry struct {
+	FullUrl  string              `json:"fullUrl"`
+	Resource OperationDefinition `json:"resource"`
+}
+
+type OperationDefinition struct {
+	ResourceType string               `json:"resourceType"`
+	ID           string               `json:"id"`
+	URL          string               `json:"url,omitempty"`
+	Name         string               `json:"name"`
+	Code         string               `json:"code,omitempty"`
+	Description  string               `json:"description,omitempty"`
+	Resource     []string             `json:"resource,omitempty"`
+	Parameter    []OperationParameter `json:"parameter,omitempty"`
+}
+
+// OperationParameter is an input or output parameter of an operation. A
+// parameter with parts has no type of its own.
+type OperationParameter struct {
+	Name          string               `json:"name"`
+	Use           string               `json:"use,omitempty"`
+	Min           int                  `json:"min"`
+	Max           string               `json:"max"`
+	Documentation string               `json:"documentation,omitempty"`
+	Type          string               `json:"type,omitempty"`
+	TargetProfile []string             `json:"targetProfile,omitempty"`
+	Part          []OperationParameter `json:"part,omitempty"`
+}
@@ -0,0 +1,458 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gruzdev-dev/fhir/tools/text"
+)
+
+// LoadOperations reads the OperationDefinition resources of
+// profiles-resources.json, sorted by name. Of operations sharing a name,
+// the last one is kept.
+func (g *Generator) LoadOperations() ([]OperationDefinition, error) {
+	data, err := g.readSpec("profiles-resources.json")
+	if err != nil {
+		return nil, fmt.Errorf("read profiles-resources.json: %w", err)
+	}
+
+	var bundle OperationDefinitionBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("unmarshal profiles-resources.json: %w", err)
+	}
+
+	byName := make(map[string]OperationDefinition)
+	for _, entry := range bundle.Entry {
+		if entry.Resource.ResourceType == "OperationDefinition" && entry.Resource.Name != "" {
+			byName[entry.Resource.Name] = entry.Resource
+		}
+	}
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ops := make([]OperationDefinition, len(names))
+	for i, name := range names {
+		ops[i] = byName[name]
+	}
+	return ops, nil
+}
+
+// GenerateOperations writes the input and output parameters of every
+// operation as the types <Name>In and <Name>Out, converting to and from a
+// Parameters resource. Operations need the Parameters resource and the
+// Resource interface, and are not generated without them.
+func (g *Generator) GenerateOperations() error {
+	if _, ok := g.Definitions["Parameters"]; !ok || !g.generatesResource("Parameters") || !g.hasResourceInterface() {
+		return nil
+	}
+	ops, err := g.LoadOperations()
+	if err != nil {
+		return err
+	}
+	variants := g.parameterValueVariants()
+	written := false
+	for _, op := range ops {
+		if _, defined := g.Definitions[op.Name]; defined || !text.IsValidGoIdentifier(op.Name) {
+			continue
+		}
+		if err := g.writeOperation(op, variants); err != nil {
+			return err
+		}
+		written = true
+	}
+	if !written {
+		return nil
+	}
+	return g.writeOperationHelpers()
+}
+
+// parameterValueVariants maps the FHIR types a parameter value can have to
+// the variants of ParametersParameterValue holding them.
+func (g *Generator) parameterValueVariants() map[string]ChoiceVariant {
+	variants := make(map[string]ChoiceVariant)
+	for _, el := range g.Definitions["Parameters"].Snapshot.Element {
+		if el.Path != "Parameters.parameter.value[x]" {
+			continue
+		}
+		choice := g.choiceInfo(el, "value", g.deriveNestedTypeName(el.Path), false)
+		if choice == nil {
+			break
+		}
+		for _, v := range choice.Variants {
+			if isBuiltinType(v.GoType) || unicode.IsUpper(rune(v.GoType[0])) {
+				variants[v.FHIRType] = v
+			}
+		}
+	}
+	return variants
+}
+
+// operationField is a parameter of an operation as a field of the struct
+// holding the parameters or parts next to it. At most one of Variant,
+// Resource, AnyResource and Part is set; a parameter with none of them can
+// have a value of any type.
+type operationField struct {
+	Param       OperationParameter
+	Name        string
+	Repeats     bool
+	Max         int
+	Variant     *ChoiceVariant
+	Resource    string
+	AnyResource bool
+	Part        string
+}
+
+// goType returns the type of the field, which is a pointer for parameters
+// that occur at most once and a slice for repeating ones.
+func (f operationField) goType() string {
+	var elem string
+	switch {
+	case f.Variant != nil:
+		elem = f.Variant.GoType
+	case f.Part != "":
+		elem = f.Part
+	case f.Resource != "":
+		elem = "*" + f.Resource
+	case f.AnyResource:
+		elem = "Resource"
+	default:
+		elem = "ParametersParameterValue"
+	}
+	if f.Repeats {
+		return "[]" + elem
+	}
+	if strings.HasPrefix(elem, "*") || elem == "Resource" || elem == "ParametersParameterValue" {
+		return elem
+	}
+	return "*" + elem
+}
+
+// operationStruct is a struct holding the parameters of an operation or
+// the parts of a parameter.
+type operationStruct struct {
+	Name    string
+	Comment string
+	Fields  []operationField
+	Top     bool
+}
+
+// operationStructs returns the struct named name holding params, followed
+// by the structs of their parts.
+func (g *Generator) operationStructs(name, comment string, params []OperationParameter, variants map[string]ChoiceVariant) []operationStruct {
+	s := operationStruct{Name: name, Comment: comment}
+	var nested []operationStruct
+	seen := make(map[string]bool)
+	for _, p := range params {
+		field := operationField{Param: p, Name: operationFieldName(p.Name)}
+		if field.Name == "" || seen[field.Name] || p.Max == "0" {
+			continue
+		}
+		seen[field.Name] = true
+		if p.Max != "1" {
+			field.Repeats = true
+			field.Max, _ = strconv.Atoi(p.Max)
+		}
+		switch def, isResource := g.Definitions[p.Type]; {
+		case len(p.Part) > 0:
+			field.Part = name + field.Name
+			nested = append(nested, g.operationStructs(field.Part, fmt.Sprintf("%s holds the parts of the %s parameter.", field.Part, p.Name), p.Part, variants)...)
+		case isResource && def.Kind == "resource":
+			if !def.Abstract && g.generatesResource(p.Type) {
+				field.Resource = p.Type
+			} else {
+				field.AnyResource = true
+			}
+		default:
+			if v, ok := variants[p.Type]; ok {
+				field.Variant = &v
+			}
+		}
+		s.Fields = append(s.Fields, field)
+	}
+	return append([]operationStruct{s}, nested...)
+}
+
+// operationFieldName returns the Go name of a parameter, such as TxResource
+// for tx-resource and Since for _since, or "" when it has none.
+func operationFieldName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = text.TitleCase(w)
+	}
+	fieldName := strings.Join(words, "")
+	if !text.IsValidGoIdentifier(fieldName) || !unicode.IsUpper(rune(fieldName[0])) {
+		return ""
+	}
+	return fieldName
+}
+
+func (g *Generator) writeOperation(op OperationDefinition, variants map[string]ChoiceVariant) error {
+	code := op.Code
+	if code == "" {
+		code = op.Name
+	}
+	var in, out []OperationParameter
+	for _, p := range op.Parameter {
+		switch p.Use {
+		case "in":
+			in = append(in, p)
+		case "out":
+			out = append(out, p)
+		}
+	}
+	inComment := fmt.Sprintf("%sIn holds the input parameters of the $%s operation.", op.Name, code)
+	if desc := sanitizeComment(op.Description); desc != "" {
+		inComment += " " + desc
+	}
+	structs := g.operationStructs(op.Name+"In", inComment, in, variants)
+	structs[0].Top = true
+	outStructs := g.operationStructs(op.Name+"Out", fmt.Sprintf("%sOut holds the output parameters of the $%s operation.", op.Name, code), out, variants)
+	outStructs[0].Top = true
+	structs = append(structs, outStructs...)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+	if operationNeedsFmt(structs) {
+		fmt.Fprintf(&buf, "import \"fmt\"\n\n")
+	}
+	for _, s := range structs {
+		writeOperationStruct(&buf, s)
+	}
+	return g.writeFormatted(op.Name, text.ToSnakeCase(op.Name)+".go", buf.Bytes())
+}
+
+// operationNeedsFmt reports whether the code written for structs reports
+// errors of its own, rather than only passing those of the helpers on.
+func operationNeedsFmt(structs []operationStruct) bool {
+	for _, s := range structs {
+		for _, f := range s.Fields {
+			if !f.Repeats || f.Param.Min > 0 || f.Max > 0 || f.Part != "" || f.goType() == "[]ParametersParameterValue" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func writeOperationStruct(buf *bytes.Buffer, s operationStruct) {
+	fmt.Fprintf(buf, "// %s\n", s.Comment)
+	fmt.Fprintf(buf, "type %s struct {\n", s.Name)
+	for _, f := range s.Fields {
+		if doc := sanitizeComment(f.Param.Documentation); doc != "" {
+			fmt.Fprintf(buf, "\t// %s\n", doc)
+		}
+		fmt.Fprintf(buf, "\t%s %s\n", f.Name, f.goType())
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	writeOperationValidate(buf, s)
+	if s.Top {
+		fmt.Fprintf(buf, "func (p *%s) ToParameters() (*Parameters, error) {\n", s.Name)
+		fmt.Fprintf(buf, "\tif err := p.Validate(); err != nil {\n")
+		fmt.Fprintf(buf, "\t\treturn nil, err\n")
+		fmt.Fprintf(buf, "\t}\n")
+		fmt.Fprintf(buf, "\treturn &Parameters{ResourceType: \"Parameters\", Parameter: p.parameters()}, nil\n")
+		fmt.Fprintf(buf, "}\n\n")
+
+		fmt.Fprintf(buf, "func (p *%s) FromParameters(params *Parameters) error {\n", s.Name)
+		fmt.Fprintf(buf, "\t*p = %s{}\n", s.Name)
+		fmt.Fprintf(buf, "\tif err := p.setParameters(params.Parameter); err != nil {\n")
+		fmt.Fprintf(buf, "\t\treturn err\n")
+		fmt.Fprintf(buf, "\t}\n")
+		fmt.Fprintf(buf, "\treturn p.Validate()\n")
+		fmt.Fprintf(buf, "}\n\n")
+	}
+	writeOperationParameters(buf, s)
+	writeOperationSetParameters(buf, s)
+}
+
+// writeOperationValidate writes Validate, which checks the number of each
+// parameter against its min and max and validates the parts.
+func writeOperationValidate(buf *bytes.Buffer, s operationStruct) {
+	fmt.Fprintf(buf, "func (p *%s) Validate() error {\n", s.Name)
+	for _, f := range s.Fields {
+		name := f.Param.Name
+		switch {
+		case !f.Repeats && f.Param.Min > 0:
+			fmt.Fprintf(buf, "\tif p.%s == nil {\n", f.Name)
+			fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"parameter '%s' is required\")\n", name)
+			fmt.Fprintf(buf, "\t}\n")
+		case f.Repeats && f.Param.Min > 0:
+			fmt.Fprintf(buf, "\tif len(p.%s) < %d {\n", f.Name, f.Param.Min)
+			fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"parameter '%s' must occur at least %d times\")\n", name, f.Param.Min)
+			fmt.Fprintf(buf, "\t}\n")
+		}
+		if f.Max > 0 {
+			fmt.Fprintf(buf, "\tif len(p.%s) > %d {\n", f.Name, f.Max)
+			fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"parameter '%s' must occur at most %d times\")\n", name, f.Max)
+			fmt.Fprintf(buf, "\t}\n")
+		}
+		if f.Part == "" {
+			continue
+		}
+		if f.Repeats {
+			fmt.Fprintf(buf, "\tfor i := range p.%s {\n", f.Name)
+			fmt.Fprintf(buf, "\t\tif err := p.%s[i].Validate(); err != nil {\n", f.Name)
+			fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"parameter '%s'[%%d]: %%w\", i, err)\n", name)
+			fmt.Fprintf(buf, "\t\t}\n")
+			fmt.Fprintf(buf, "\t}\n")
+		} else {
+			fmt.Fprintf(buf, "\tif p.%s != nil {\n", f.Name)
+			fmt.Fprintf(buf, "\t\tif err := p.%s.Validate(); err != nil {\n", f.Name)
+			fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"parameter '%s': %%w\", err)\n", name)
+			fmt.Fprintf(buf, "\t\t}\n")
+			fmt.Fprintf(buf, "\t}\n")
+		}
+	}
+	fmt.Fprintf(buf, "\treturn nil\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+// writeOperationParameters writes parameters, which returns the fields
+// that are set as parameters, in definition order.
+func writeOperationParameters(buf *bytes.Buffer, s operationStruct) {
+	fmt.Fprintf(buf, "func (p *%s) parameters() []ParametersParameter {\n", s.Name)
+	if len(s.Fields) == 0 {
+		fmt.Fprintf(buf, "\treturn nil\n")
+		fmt.Fprintf(buf, "}\n\n")
+		return
+	}
+	fmt.Fprintf(buf, "\tvar params []ParametersParameter\n")
+	for _, f := range s.Fields {
+		value := "v"
+		if !f.Repeats {
+			fmt.Fprintf(buf, "\tif p.%s != nil {\n", f.Name)
+			value = "p." + f.Name
+			if f.Variant != nil && isBuiltinType(f.Variant.GoType) {
+				value = "*" + value
+			}
+		} else {
+			fmt.Fprintf(buf, "\tfor _, v := range p.%s {\n", f.Name)
+		}
+		var content string
+		switch {
+		case f.Variant != nil && isBuiltinType(f.Variant.GoType):
+			content = fmt.Sprintf("Value: %s(%s)", f.Variant.TypeName, value)
+		case f.Variant != nil && f.Repeats:
+			content = fmt.Sprintf("Value: %s{%s: v}", f.Variant.TypeName, f.Variant.GoType)
+		case f.Variant != nil:
+			content = fmt.Sprintf("Value: %s{%s: *%s}", f.Variant.TypeName, f.Variant.GoType, value)
+		case f.Part != "":
+			content = fmt.Sprintf("Part: %s.parameters()", value)
+		case f.Resource != "" || f.AnyResource:
+			content = fmt.Sprintf("Resource: %s", value)
+		default:
+			content = fmt.Sprintf("Value: %s", value)
+		}
+		fmt.Fprintf(buf, "\t\tparams = append(params, ParametersParameter{Name: %q, %s})\n", f.Param.Name, content)
+		fmt.Fprintf(buf, "\t}\n")
+	}
+	fmt.Fprintf(buf, "\treturn params\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+// writeOperationSetParameters writes setParameters, which sets the fields
+// from parameters, rejecting values of the wrong type and repeated
+// parameters that occur at most once.
+func writeOperationSetParameters(buf *bytes.Buffer, s operationStruct) {
+	fmt.Fprintf(buf, "func (p *%s) setParameters(params []ParametersParameter) error {\n", s.Name)
+	if len(s.Fields) == 0 {
+		fmt.Fprintf(buf, "\treturn nil\n")
+		fmt.Fprintf(buf, "}\n\n")
+		return
+	}
+	fmt.Fprintf(buf, "\tfor _, param := range params {\n")
+	fmt.Fprintf(buf, "\t\tswitch param.Name {\n")
+	for _, f := range s.Fields {
+		name := f.Param.Name
+		fmt.Fprintf(buf, "\t\tcase %q:\n", name)
+		if !f.Repeats {
+			fmt.Fprintf(buf, "\t\t\tif p.%s != nil {\n", f.Name)
+			fmt.Fprintf(buf, "\t\t\t\treturn fmt.Errorf(\"parameter '%s' must occur at most once\")\n", name)
+			fmt.Fprintf(buf, "\t\t\t}\n")
+		}
+		var value string
+		switch {
+		case f.Variant != nil:
+			fmt.Fprintf(buf, "\t\t\tv, err := parameterValue[%s](param.Name, param.Value)\n", f.Variant.TypeName)
+			fmt.Fprintf(buf, "\t\t\tif err != nil {\n")
+			fmt.Fprintf(buf, "\t\t\t\treturn err\n")
+			fmt.Fprintf(buf, "\t\t\t}\n")
+			switch {
+			case isBuiltinType(f.Variant.GoType) && f.Repeats:
+				value = fmt.Sprintf("%s(v)", f.Variant.GoType)
+			case isBuiltinType(f.Variant.GoType):
+				fmt.Fprintf(buf, "\t\t\tvalue := %s(v)\n", f.Variant.GoType)
+				value = "&value"
+			case f.Repeats:
+				value = "v." + f.Variant.GoType
+			default:
+				value = "&v." + f.Variant.GoType
+			}
+		case f.Part != "":
+			fmt.Fprintf(buf, "\t\t\tvar v %s\n", f.Part)
+			fmt.Fprintf(buf, "\t\t\tif err := v.setParameters(param.Part); err != nil {\n")
+			fmt.Fprintf(buf, "\t\t\t\treturn fmt.Errorf(\"parameter '%s': %%w\", err)\n", name)
+			fmt.Fprintf(buf, "\t\t\t}\n")
+			value = "v"
+			if !f.Repeats {
+				value = "&v"
+			}
+		case f.Resource != "" || f.AnyResource:
+			resourceType, fhirType := "Resource", "Resource"
+			if f.Resource != "" {
+				resourceType, fhirType = "*"+f.Resource, f.Resource
+			}
+			fmt.Fprintf(buf, "\t\t\tv, err := parameterResource[%s](param.Name, param.Resource, %q)\n", resourceType, fhirType)
+			fmt.Fprintf(buf, "\t\t\tif err != nil {\n")
+			fmt.Fprintf(buf, "\t\t\t\treturn err\n")
+			fmt.Fprintf(buf, "\t\t\t}\n")
+			value = "v"
+		default:
+			fmt.Fprintf(buf, "\t\t\tif param.Value == nil {\n")
+			fmt.Fprintf(buf, "\t\t\t\treturn fmt.Errorf(\"parameter '%s' must have a value\")\n", name)
+			fmt.Fprintf(buf, "\t\t\t}\n")
+			value = "param.Value"
+		}
+		if f.Repeats {
+			fmt.Fprintf(buf, "\t\t\tp.%s = append(p.%s, %s)\n", f.Name, f.Name, value)
+		} else {
+			fmt.Fprintf(buf, "\t\t\tp.%s = %s\n", f.Name, value)
+		}
+	}
+	fmt.Fprintf(buf, "\t\t}\n")
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\treturn nil\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+// writeOperationHelpers writes operations.go with the OperationParameters
+// interface, and the runtime helpers reading parameter values.
+func (g *Generator) writeOperationHelpers() error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+	fmt.Fprintf(&buf, "// OperationParameters is implemented by the input and output parameters of\n")
+	fmt.Fprintf(&buf, "// every operation, such as ExpandIn and ExpandOut.\n")
+	fmt.Fprintf(&buf, "type OperationParameters interface {\n")
+	fmt.Fprintf(&buf, "\t// Validate checks the number of each parameter against its definition.\n")
+	fmt.Fprintf(&buf, "\tValidate() error\n")
+	fmt.Fprintf(&buf, "\t// ToParameters returns the parameters as a Parameters resource.\n")
+	fmt.Fprintf(&buf, "\tToParameters() (*Parameters, error)\n")
+	fmt.Fprintf(&buf, "\t// FromParameters sets the parameters from a Parameters resource,\n")
+	fmt.Fprintf(&buf, "\t// ignoring parameters the operation does not define.\n")
+	fmt.Fprintf(&buf, "\tFromParameters(params *Parameters) error\n")
+	fmt.Fprintf(&buf, "}\n")
+	if err := g.writeFormatted("operation parameters", "operations.go", buf.Bytes()); err != nil {
+		return err
+	}
+	return g.writeRuntimeFile(operationRuntimeFile)
+}
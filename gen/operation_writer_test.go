@@ -0,0 +1,67 @@
+package gen
+
+import "testing"
+
+func TestOperationFieldName(t *testing.T) {
+	tests := map[string]string{
+		"url":             "Url",
+		"tx-resource":     "TxResource",
+		"_since":          "Since",
+		"codeableConcept": "CodeableConcept",
+		"return":          "Return",
+		"-":               "",
+	}
+	for name, want := range tests {
+		if got := operationFieldName(name); got != want {
+			t.Errorf("operationFieldName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestOperationStructs(t *testing.T) {
+	g := NewGenerator("", "")
+	g.Definitions["ValueSet"] = StructureDefinition{Name: "ValueSet", Kind: "resource"}
+	g.Definitions["Resource"] = StructureDefinition{Name: "Resource", Kind: "resource", Abstract: true}
+	variants := map[string]ChoiceVariant{
+		"uri":    {TypeName: "ParametersParameterValueUri", FHIRType: "uri", GoType: "string"},
+		"Coding": {TypeName: "ParametersParameterValueCoding", FHIRType: "Coding", GoType: "Coding"},
+	}
+	params := []OperationParameter{
+		{Name: "url", Min: 1, Max: "1", Type: "uri"},
+		{Name: "coding", Max: "3", Type: "Coding"},
+		{Name: "valueSet", Max: "1", Type: "ValueSet"},
+		{Name: "tx-resource", Max: "*", Type: "Resource"},
+		{Name: "value", Max: "1"},
+		{Name: "unused", Max: "0", Type: "uri"},
+		{Name: "designation", Max: "*", Part: []OperationParameter{
+			{Name: "value", Min: 1, Max: "1", Type: "uri"},
+		}},
+	}
+
+	structs := g.operationStructs("TestIn", "", params, variants)
+	if len(structs) != 2 || structs[0].Name != "TestIn" || structs[1].Name != "TestInDesignation" {
+		t.Fatalf("operationStructs() = %+v, want TestIn and TestInDesignation", structs)
+	}
+	want := map[string]string{
+		"Url":         "*string",
+		"Coding":      "[]Coding",
+		"ValueSet":    "*ValueSet",
+		"TxResource":  "[]Resource",
+		"Value":       "ParametersParameterValue",
+		"Designation": "[]TestInDesignation",
+	}
+	if len(structs[0].Fields) != len(want) {
+		t.Errorf("TestIn has %d fields, want %d", len(structs[0].Fields), len(want))
+	}
+	for _, f := range structs[0].Fields {
+		if got := f.goType(); got != want[f.Name] {
+			t.Errorf("field %s has type %q, want %q", f.Name, got, want[f.Name])
+		}
+	}
+	if f := structs[0].Fields[1]; !f.Repeats || f.Max != 3 {
+		t.Errorf("field Coding: Repeats = %v, Max = %d, want true, 3", f.Repeats, f.Max)
+	}
+	if f := structs[1].Fields[0]; f.goType() != "*string" || f.Param.Min != 1 {
+		t.Errorf("part field Value = %+v, want a required *string", f)
+	}
+}
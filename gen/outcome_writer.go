@@ -0,0 +1,89 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// hasOperationOutcome reports whether the OperationOutcome resource is
+// loaded with the issue elements ValidationIssues converts into.
+func (g *Generator) hasOperationOutcome() bool {
+	return g.outcomeIssueFields() != nil
+}
+
+// outcomeIssueFields returns the generated fields of OperationOutcome.issue
+// by name, or nil when OperationOutcome is not loaded or not generated.
+func (g *Generator) outcomeIssueFields() map[string]FieldInfo {
+	def, ok := g.Definitions["OperationOutcome"]
+	if !ok || def.Kind != "resource" || !g.generates(def) {
+		return nil
+	}
+	structs := g.ProcessElements(def.Name, def.Snapshot.Element, def)
+	fields := make(map[string]FieldInfo)
+	for _, f := range structs["OperationOutcomeIssue"] {
+		fields[f.Name] = f
+	}
+	want := map[string]func(goType string) bool{
+		"Severity":    isStringLike,
+		"Code":        isStringLike,
+		"Diagnostics": func(goType string) bool { return goType == "*string" },
+		"Expression":  func(goType string) bool { return goType == "[]string" },
+	}
+	for name, ok := range want {
+		if f, exists := fields[name]; !exists || !ok(f.GoType) {
+			return nil
+		}
+	}
+	return fields
+}
+
+// writeValidationOutcome writes the conversion of ValidationIssues into an
+// OperationOutcome resource.
+func (g *Generator) writeValidationOutcome() error {
+	fields := g.outcomeIssueFields()
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+
+	fmt.Fprintf(&buf, "// OperationOutcome converts the issues into an OperationOutcome resource,\n")
+	fmt.Fprintf(&buf, "// one issue per entry with its FHIRPath location as the expression. An\n")
+	fmt.Fprintf(&buf, "// empty list yields a single informational issue, as OperationOutcome\n")
+	fmt.Fprintf(&buf, "// requires at least one.\n")
+	fmt.Fprintf(&buf, "func (l ValidationIssues) OperationOutcome() *OperationOutcome {\n")
+	fmt.Fprintf(&buf, "\toutcome := &OperationOutcome{ResourceType: \"OperationOutcome\"}\n")
+	fmt.Fprintf(&buf, "\tfor _, issue := range l {\n")
+	fmt.Fprintf(&buf, "\t\tdiagnostics := issue.Message\n")
+	fmt.Fprintf(&buf, "\t\toutcome.Issue = append(outcome.Issue, OperationOutcomeIssue{\n")
+	fmt.Fprintf(&buf, "\t\t\tSeverity:    %s,\n", convertString(fields["Severity"].GoType, "issue.Severity"))
+	fmt.Fprintf(&buf, "\t\t\tCode:        %s,\n", convertString(fields["Code"].GoType, "issue.Code"))
+	fmt.Fprintf(&buf, "\t\t\tDiagnostics: &diagnostics,\n")
+	fmt.Fprintf(&buf, "\t\t\tExpression:  []string{issue.Path},\n")
+	fmt.Fprintf(&buf, "\t\t})\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tif len(outcome.Issue) == 0 {\n")
+	fmt.Fprintf(&buf, "\t\tdiagnostics := \"No issues detected\"\n")
+	fmt.Fprintf(&buf, "\t\toutcome.Issue = append(outcome.Issue, OperationOutcomeIssue{\n")
+	fmt.Fprintf(&buf, "\t\t\tSeverity:    %s,\n", convertString(fields["Severity"].GoType, `"information"`))
+	fmt.Fprintf(&buf, "\t\t\tCode:        %s,\n", convertString(fields["Code"].GoType, `"informational"`))
+	fmt.Fprintf(&buf, "\t\t\tDiagnostics: &diagnostics,\n")
+	fmt.Fprintf(&buf, "\t\t})\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn outcome\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return g.writeFormatted("validation outcome", "validation_outcome.go", buf.Bytes())
+}
+
+func isStringLike(goType string) bool {
+	return goType != "" && !strings.HasPrefix(goType, "*") && !strings.HasPrefix(goType, "[]")
+}
+
+// convertString converts the string expression expr to goType, which is
+// string or a code enum.
+func convertString(goType, expr string) string {
+	if goType == "string" || strings.HasPrefix(expr, `"`) {
+		return expr
+	}
+	return fmt.Sprintf("%s(%s)", goType, expr)
+}
@@ -0,0 +1,54 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteValidationOutcome(t *testing.T) {
+	g := NewGenerator("", t.TempDir())
+	g.Definitions["OperationOutcome"] = StructureDefinition{
+		Name: "OperationOutcome",
+		Kind: "resource",
+		Snapshot: Snapshot{Element: []ElementDefinition{
+			{ID: "OperationOutcome", Path: "OperationOutcome"},
+			{ID: "OperationOutcome.issue", Path: "OperationOutcome.issue", Min: 1, Max: "*", Type: []ElementDataType{{Code: "BackboneElement"}}},
+			{ID: "OperationOutcome.issue.severity", Path: "OperationOutcome.issue.severity", Min: 1, Max: "1", Type: []ElementDataType{{Code: "code"}},
+				Binding: &Binding{Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/issue-severity|5.0.0"}},
+			{ID: "OperationOutcome.issue.code", Path: "OperationOutcome.issue.code", Min: 1, Max: "1", Type: []ElementDataType{{Code: "code"}}},
+			{ID: "OperationOutcome.issue.diagnostics", Path: "OperationOutcome.issue.diagnostics", Min: 0, Max: "1", Type: []ElementDataType{{Code: "string"}}},
+			{ID: "OperationOutcome.issue.expression", Path: "OperationOutcome.issue.expression", Min: 0, Max: "*", Type: []ElementDataType{{Code: "string"}}},
+		}},
+	}
+	g.valueSetTypes = map[string]string{"http://hl7.org/fhir/ValueSet/issue-severity": "IssueSeverity"}
+	g.enumTypes = map[string]bool{"IssueSeverity": true}
+
+	if !g.hasOperationOutcome() {
+		t.Fatal("hasOperationOutcome() = false")
+	}
+	if err := g.writeValidationOutcome(); err != nil {
+		t.Fatalf("writeValidationOutcome() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "validation_outcome.go"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	code := string(data)
+	for _, exp := range []string{
+		"func (l ValidationIssues) OperationOutcome() *OperationOutcome {",
+		"Severity:    IssueSeverity(issue.Severity),",
+		"Code:        issue.Code,",
+		"Expression:  []string{issue.Path},",
+	} {
+		if !strings.Contains(code, exp) {
+			t.Errorf("expected %q in validation_outcome.go, got:\n%s", exp, code)
+		}
+	}
+
+	if NewGenerator("", "").hasOperationOutcome() {
+		t.Error("hasOperationOutcome() without OperationOutcome should be false")
+	}
+}
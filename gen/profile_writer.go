@@ -0,0 +1,471 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LoadProfiles reads the constraint profiles of a bundle, such as
+// profiles-others.json, on the resource types loaded before. Unlike Load it
+// does not add them to Definitions: profiles are generated as validators
+// of their resource type, not as types of their own.
+func (g *Generator) LoadProfiles(filename string) error {
+	data, err := g.readSpec(filename)
+	if err != nil {
+		return err
+	}
+
+	var bundle StructureDefinitionBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return fmt.Errorf("unmarshal %s: %w", filename, err)
+	}
+	for _, entry := range bundle.Entry {
+		def := entry.Resource
+		resourceType, _ := def.Type.(string)
+		if def.Derivation != "constraint" || def.Kind != "resource" || def.URL == "" {
+			continue
+		}
+		if _, ok := g.Definitions[resourceType]; !ok {
+			continue
+		}
+		g.Profiles = append(g.Profiles, def)
+	}
+	return nil
+}
+
+// ProfileElementInfo is an element a profile constrains further than its
+// resource type, with the rules the profile sets on it.
+type ProfileElementInfo struct {
+	Path        string
+	Min         int
+	Max         int
+	Types       []string
+	Fixed       string
+	Pattern     string
+	Profiles    []string
+	Constraints []Constraint
+	Slicing     *ProfileSlicingInfo
+}
+
+// ProfileSlicingInfo is the slicing of an element with its slices.
+type ProfileSlicingInfo struct {
+	Discriminators []Discriminator
+	Ordered        bool
+	Rules          string
+	Slices         []ProfileSliceInfo
+}
+
+// ProfileSliceInfo is a slice of an element with the rules it sets on its
+// items.
+type ProfileSliceInfo struct {
+	Name     string
+	Min      int
+	Max      int
+	Elements []ProfileElementInfo
+}
+
+// profileElements returns the elements of a profile's snapshot that add
+// rules to those of the resource type: a higher minimum, a lower maximum,
+// fewer types, a fixed value or pattern, profiles, constraints of their
+// own or slices.
+func (g *Generator) profileElements(profile StructureDefinition) []ProfileElementInfo {
+	coreURLs := make(map[string]bool)
+	for _, def := range g.Definitions {
+		coreURLs[def.URL] = true
+	}
+	return g.profileContext(profile, "", nil, coreURLs)
+}
+
+// profileContext returns the elements of a profile below the slice with the
+// id sliceID, or the elements outside any slice when sliceID is empty. The
+// elements the discriminators of the slice name are kept with their types
+// and values even when they add no rules, as the slice is told apart by
+// them.
+func (g *Generator) profileContext(profile StructureDefinition, sliceID string, discriminators []Discriminator, coreURLs map[string]bool) []ProfileElementInfo {
+	var elements []ProfileElementInfo
+	for _, el := range profile.Snapshot.Element {
+		rest := el.ID
+		if sliceID != "" {
+			var ok bool
+			if rest, ok = strings.CutPrefix(el.ID, sliceID); !ok || (rest != "" && rest[0] != '.') {
+				continue
+			}
+		}
+		if strings.Contains(rest, ":") {
+			continue
+		}
+		info, changed := g.profileElement(el, coreURLs)
+		if el.ID == sliceID {
+			// The cardinality of a slice is checked on the sliced element.
+			changed = info.hasRules()
+		}
+		if d, ok := discriminatorOf(discriminators, sliceID, el.ID); ok {
+			changed = true
+			if d.Type == "type" || d.Type == "profile" {
+				info.Types = elementTypeCodes(el)
+			}
+		}
+		if el.Slicing != nil {
+			if info.Slicing = g.profileSlicing(profile, el, coreURLs); len(info.Slicing.Slices) > 0 {
+				changed = true
+			} else {
+				info.Slicing = nil
+			}
+		}
+		if changed {
+			elements = append(elements, info)
+		}
+	}
+	return elements
+}
+
+// profileElement returns the rules a profile sets on an element, and
+// whether they add to those of the element in the resource type.
+func (g *Generator) profileElement(el ElementDefinition, coreURLs map[string]bool) (ProfileElementInfo, bool) {
+	base, hasBase := g.baseElement(el.Path)
+	info := ProfileElementInfo{Path: el.Path, Min: el.Min, Max: maxOccurs(el.Max)}
+	changed := !hasBase && (el.Min > 0 || info.Max >= 0)
+	if hasBase {
+		baseMax := maxOccurs(base.Max)
+		changed = el.Min > base.Min || (info.Max >= 0 && (baseMax < 0 || info.Max < baseMax))
+		if types := elementTypeCodes(el); len(types) > 0 && strings.Join(types, "|") != strings.Join(elementTypeCodes(base), "|") {
+			info.Types = types
+		}
+	}
+	info.Fixed = valueJSON(el.Fixed)
+	info.Pattern = valueJSON(el.PatternValue)
+	for _, t := range el.Type {
+		info.Profiles = append(info.Profiles, t.Profile...)
+	}
+	baseKeys := make(map[string]bool)
+	for _, c := range base.Constraint {
+		baseKeys[c.Key] = true
+	}
+	for _, c := range el.Constraint {
+		if !baseKeys[c.Key] && !coreURLs[c.Source] {
+			info.Constraints = append(info.Constraints, c)
+		}
+	}
+	return info, changed || info.hasRules()
+}
+
+// hasRules reports whether the element has rules other than cardinality.
+func (info ProfileElementInfo) hasRules() bool {
+	return len(info.Types) > 0 || info.Fixed != "" || info.Pattern != "" ||
+		len(info.Profiles) > 0 || len(info.Constraints) > 0
+}
+
+// profileSlicing returns the slicing of the element el with its slices,
+// the elements of the snapshot whose id is that of el followed by
+// :sliceName.
+func (g *Generator) profileSlicing(profile StructureDefinition, el ElementDefinition, coreURLs map[string]bool) *ProfileSlicingInfo {
+	slicing := &ProfileSlicingInfo{
+		Discriminators: el.Slicing.Discriminator,
+		Ordered:        el.Slicing.Ordered,
+		Rules:          el.Slicing.Rules,
+	}
+	for _, slice := range profile.Snapshot.Element {
+		if slice.SliceName == "" || slice.ID != el.ID+":"+slice.SliceName {
+			continue
+		}
+		info := ProfileSliceInfo{
+			Name:     slice.SliceName,
+			Min:      slice.Min,
+			Max:      maxOccurs(slice.Max),
+			Elements: g.profileContext(profile, slice.ID, slicing.Discriminators, coreURLs),
+		}
+		info.Elements = appendExtensionURL(info.Elements, el.Path, slice, slicing.Discriminators)
+		slicing.Slices = append(slicing.Slices, info)
+	}
+	return slicing
+}
+
+// appendExtensionURL adds the url of an extension slice sliced by url, as
+// in the slices of Extension elements, whose url is that of the profile of
+// the slice when the snapshot does not fix it.
+func appendExtensionURL(elements []ProfileElementInfo, slicedPath string, slice ElementDefinition, discriminators []Discriminator) []ProfileElementInfo {
+	byURL := false
+	for _, d := range discriminators {
+		byURL = byURL || (d.Path == "url" && (d.Type == "value" || d.Type == "pattern"))
+	}
+	if !byURL || len(slice.Type) != 1 || slice.Type[0].Code != "Extension" || len(slice.Type[0].Profile) != 1 {
+		return elements
+	}
+	for _, el := range elements {
+		if el.Path == slicedPath+".url" && (el.Fixed != "" || el.Pattern != "") {
+			return elements
+		}
+	}
+	url, _ := json.Marshal(slice.Type[0].Profile[0])
+	return append(elements, ProfileElementInfo{Path: slicedPath + ".url", Min: 1, Max: 1, Fixed: string(url)})
+}
+
+// discriminatorOf returns the discriminator whose path selects the element
+// with the id elementID in the slice with the id sliceID.
+func discriminatorOf(discriminators []Discriminator, sliceID, elementID string) (Discriminator, bool) {
+	if sliceID == "" {
+		return Discriminator{}, false
+	}
+	for _, d := range discriminators {
+		id := sliceID
+		if d.Path != "$this" {
+			id += "." + d.Path
+		}
+		if strings.ReplaceAll(id, "[x]", "") == strings.ReplaceAll(elementID, "[x]", "") {
+			return d, true
+		}
+	}
+	return Discriminator{}, false
+}
+
+// baseElement returns the definition of an element path in the resource
+// types and data types of the specification, following the types of the
+// elements along the path, such as Bundle.identifier.system to
+// Identifier.system.
+func (g *Generator) baseElement(path string) (ElementDefinition, bool) {
+	parts := strings.Split(path, ".")
+	def, ok := g.Definitions[parts[0]]
+	if !ok || len(def.Snapshot.Element) == 0 {
+		return ElementDefinition{}, false
+	}
+	if len(parts) == 1 {
+		return def.Snapshot.Element[0], true
+	}
+
+	current := parts[0]
+	for i, part := range parts[1:] {
+		el, found := findElement(def, current+"."+part)
+		if !found {
+			return ElementDefinition{}, false
+		}
+		if i == len(parts)-2 {
+			return el, true
+		}
+		switch {
+		case el.ContentReference != "":
+			_, current, _ = strings.Cut(el.ContentReference, "#")
+		case isBackboneElement(el):
+			current = el.Path
+		case len(el.Type) == 1:
+			current = el.Type[0].Code
+			if def, ok = g.Definitions[current]; !ok {
+				return ElementDefinition{}, false
+			}
+		default:
+			return ElementDefinition{}, false
+		}
+	}
+	return ElementDefinition{}, false
+}
+
+func findElement(def StructureDefinition, path string) (ElementDefinition, bool) {
+	for _, el := range def.Snapshot.Element {
+		if el.Path == path && !strings.Contains(el.ID, ":") {
+			return el, true
+		}
+	}
+	return ElementDefinition{}, false
+}
+
+func elementTypeCodes(el ElementDefinition) []string {
+	codes := make([]string, len(el.Type))
+	for i, t := range el.Type {
+		codes[i] = t.Code
+	}
+	return codes
+}
+
+// maxOccurs returns the maximum cardinality of an element, -1 for "*".
+func maxOccurs(max string) int {
+	var n int
+	if _, err := fmt.Sscan(max, &n); err != nil {
+		return -1
+	}
+	return n
+}
+
+// valueJSON returns the JSON of a fixed value or pattern, or "" for none.
+func valueJSON(value any) string {
+	if value == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// writeProfileElements writes the items of a []ProfileElement literal,
+// with the slices of sliced elements.
+func writeProfileElements(buf *bytes.Buffer, elements []ProfileElementInfo) {
+	for _, el := range elements {
+		fmt.Fprintf(buf, "{Path: %q, Min: %d, Max: %d", el.Path, el.Min, el.Max)
+		if len(el.Types) > 0 {
+			fmt.Fprintf(buf, ", Types: %s", stringSliceLiteral(el.Types))
+		}
+		if el.Fixed != "" {
+			fmt.Fprintf(buf, ", Fixed: %q", el.Fixed)
+		}
+		if el.Pattern != "" {
+			fmt.Fprintf(buf, ", Pattern: %q", el.Pattern)
+		}
+		if len(el.Profiles) > 0 {
+			fmt.Fprintf(buf, ", Profiles: %s", stringSliceLiteral(el.Profiles))
+		}
+		if len(el.Constraints) > 0 {
+			fmt.Fprintf(buf, ", Constraints: []ProfileConstraint{\n")
+			for _, c := range el.Constraints {
+				fmt.Fprintf(buf, "{Key: %q, Severity: %q, Human: %q, Expression: %q},\n", c.Key, c.Severity, c.Human, c.Expression)
+			}
+			fmt.Fprintf(buf, "}")
+		}
+		if el.Slicing != nil {
+			fmt.Fprintf(buf, ", Slicing: &ProfileSlicing{\n")
+			fmt.Fprintf(buf, "Discriminators: []ProfileDiscriminator{")
+			for i, d := range el.Slicing.Discriminators {
+				if i > 0 {
+					fmt.Fprintf(buf, ", ")
+				}
+				fmt.Fprintf(buf, "{Type: %q, Path: %q}", d.Type, d.Path)
+			}
+			fmt.Fprintf(buf, "},\n")
+			if el.Slicing.Ordered {
+				fmt.Fprintf(buf, "Ordered: true,\n")
+			}
+			fmt.Fprintf(buf, "Rules: %q,\n", el.Slicing.Rules)
+			fmt.Fprintf(buf, "Slices: []ProfileSlice{\n")
+			for _, slice := range el.Slicing.Slices {
+				fmt.Fprintf(buf, "{Name: %q, Min: %d, Max: %d, Elements: []ProfileElement{\n", slice.Name, slice.Min, slice.Max)
+				writeProfileElements(buf, slice.Elements)
+				fmt.Fprintf(buf, "}},\n")
+			}
+			fmt.Fprintf(buf, "},\n")
+			fmt.Fprintf(buf, "}")
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+}
+
+// generatedProfiles returns the loaded profiles of the generated resources.
+func (g *Generator) generatedProfiles() []StructureDefinition {
+	var profiles []StructureDefinition
+	for _, profile := range g.Profiles {
+		if resourceType, _ := profile.Type.(string); g.generatesResource(resourceType) {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles
+}
+
+// GenerateProfiles writes the profiles loaded with LoadProfiles as a table
+// of ProfileDefinitions, with a variable for each profile and lookups by
+// URL and resource type.
+func (g *Generator) GenerateProfiles() error {
+	var buf bytes.Buffer
+	profiles := g.generatedProfiles()
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+	fmt.Fprintf(&buf, "import (\n")
+	fmt.Fprintf(&buf, "\t\"encoding/json\"\n")
+	fmt.Fprintf(&buf, "\t\"strings\"\n")
+	fmt.Fprintf(&buf, ")\n\n")
+
+	fmt.Fprintf(&buf, "var profileDefinitions = []ProfileDefinition{\n")
+	for _, profile := range profiles {
+		resourceType, _ := profile.Type.(string)
+		fmt.Fprintf(&buf, "\t{\n")
+		fmt.Fprintf(&buf, "\t\tURL: %q, Name: %q, Type: %q, BaseDefinition: %q,\n", profile.URL, profile.Name, resourceType, profile.BaseDefinition)
+		fmt.Fprintf(&buf, "\t\tElements: []ProfileElement{\n")
+		writeProfileElements(&buf, g.profileElements(profile))
+		fmt.Fprintf(&buf, "\t\t},\n")
+		fmt.Fprintf(&buf, "\t},\n")
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	if len(profiles) > 0 {
+		fmt.Fprintf(&buf, "var (\n")
+		for i, profile := range profiles {
+			fmt.Fprintf(&buf, "\t%sProfile = &profileDefinitions[%d]\n", profile.Name, i)
+		}
+		fmt.Fprintf(&buf, ")\n\n")
+	}
+
+	fmt.Fprintf(&buf, "func init() {\n")
+	fmt.Fprintf(&buf, "\tlookupProfile = ProfileByURL\n")
+	fmt.Fprintf(&buf, "\tdecodeProfileValues(profileDefinitions)\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// Profiles returns the constraint profiles of the specification.\n")
+	fmt.Fprintf(&buf, "func Profiles() []*ProfileDefinition {\n")
+	fmt.Fprintf(&buf, "\tprofiles := make([]*ProfileDefinition, len(profileDefinitions))\n")
+	fmt.Fprintf(&buf, "\tfor i := range profileDefinitions {\n")
+	fmt.Fprintf(&buf, "\t\tprofiles[i] = &profileDefinitions[i]\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn profiles\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// ProfilesFor returns the constraint profiles of a resource type.\n")
+	fmt.Fprintf(&buf, "func ProfilesFor(resourceType string) []*ProfileDefinition {\n")
+	fmt.Fprintf(&buf, "\tvar profiles []*ProfileDefinition\n")
+	fmt.Fprintf(&buf, "\tfor i := range profileDefinitions {\n")
+	fmt.Fprintf(&buf, "\t\tif profileDefinitions[i].Type == resourceType {\n")
+	fmt.Fprintf(&buf, "\t\t\tprofiles = append(profiles, &profileDefinitions[i])\n")
+	fmt.Fprintf(&buf, "\t\t}\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn profiles\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// ProfileByURL returns a constraint profile by its canonical URL, as\n")
+	fmt.Fprintf(&buf, "// meta.profile refers to it.\n")
+	fmt.Fprintf(&buf, "func ProfileByURL(url string) (*ProfileDefinition, bool) {\n")
+	fmt.Fprintf(&buf, "\tfor i := range profileDefinitions {\n")
+	fmt.Fprintf(&buf, "\t\tif profileDefinitions[i].URL == url {\n")
+	fmt.Fprintf(&buf, "\t\t\treturn &profileDefinitions[i], true\n")
+	fmt.Fprintf(&buf, "\t\t}\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn nil, false\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// Validate checks res against the profile: the cardinality, types, fixed\n")
+	fmt.Fprintf(&buf, "// values, patterns and constraints the profile sets on its elements. It\n")
+	fmt.Fprintf(&buf, "// reports only what the profile adds; ValidateAll checks the rest.\n")
+	fmt.Fprintf(&buf, "func (p *ProfileDefinition) Validate(res Resource) ValidationIssues {\n")
+	fmt.Fprintf(&buf, "\treturn p.validate(res)\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// New returns a resource of the profile's type claiming the profile in\n")
+	fmt.Fprintf(&buf, "// meta.profile, with the fixed values and patterns of its top-level\n")
+	fmt.Fprintf(&buf, "// elements set, such as the LOINC code of a vital sign.\n")
+	fmt.Fprintf(&buf, "func (p *ProfileDefinition) New() (Resource, error) {\n")
+	fmt.Fprintf(&buf, "\tdata, err := json.Marshal(p.template())\n")
+	fmt.Fprintf(&buf, "\tif err != nil {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, err\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn UnmarshalResource(data)\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// ValidateProfiles checks res against each profile of the specification\n")
+	fmt.Fprintf(&buf, "// its meta.profile claims, ignoring the version of versioned URLs. Profiles\n")
+	fmt.Fprintf(&buf, "// defined elsewhere are not checked.\n")
+	fmt.Fprintf(&buf, "func ValidateProfiles(res Resource) ValidationIssues {\n")
+	fmt.Fprintf(&buf, "\tmeta := res.GetMeta()\n")
+	fmt.Fprintf(&buf, "\tif meta == nil {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tvar issues ValidationIssues\n")
+	fmt.Fprintf(&buf, "\tfor _, url := range meta.Profile {\n")
+	fmt.Fprintf(&buf, "\t\turl, _, _ = strings.Cut(url, \"|\")\n")
+	fmt.Fprintf(&buf, "\t\tif profile, ok := ProfileByURL(url); ok {\n")
+	fmt.Fprintf(&buf, "\t\t\tissues = append(issues, profile.Validate(res)...)\n")
+	fmt.Fprintf(&buf, "\t\t}\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn issues\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return g.writeFormatted("profiles", "profiles.go", buf.Bytes())
+}
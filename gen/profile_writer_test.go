@@ -0,0 +1,200 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func profileTestGenerator(t *testing.T) *Generator {
+	g := NewGenerator(t.TempDir(), t.TempDir())
+	g.Definitions["Observation"] = StructureDefinition{
+		Name: "Observation", Kind: "resource", URL: "http://hl7.org/fhir/StructureDefinition/Observation",
+		Snapshot: Snapshot{Element: []ElementDefinition{
+			{ID: "Observation", Path: "Observation", Min: 0, Max: "*"},
+			{ID: "Observation.status", Path: "Observation.status", Min: 1, Max: "1", Type: []ElementDataType{{Code: "code"}}},
+			{ID: "Observation.code", Path: "Observation.code", Min: 1, Max: "1", Type: []ElementDataType{{Code: "CodeableConcept"}}},
+			{ID: "Observation.subject", Path: "Observation.subject", Min: 0, Max: "1", Type: []ElementDataType{{Code: "Reference"}}},
+			{ID: "Observation.value[x]", Path: "Observation.value[x]", Min: 0, Max: "1",
+				Type: []ElementDataType{{Code: "Quantity"}, {Code: "string"}}},
+			{ID: "Observation.component", Path: "Observation.component", Min: 0, Max: "*",
+				Type: []ElementDataType{{Code: "BackboneElement"}}},
+			{ID: "Observation.component.code", Path: "Observation.component.code", Min: 1, Max: "1",
+				Type: []ElementDataType{{Code: "CodeableConcept"}}},
+		}},
+	}
+	g.Definitions["CodeableConcept"] = StructureDefinition{
+		Name: "CodeableConcept", Kind: "complex-type", URL: "http://hl7.org/fhir/StructureDefinition/CodeableConcept",
+		Snapshot: Snapshot{Element: []ElementDefinition{
+			{ID: "CodeableConcept", Path: "CodeableConcept", Min: 0, Max: "*"},
+			{ID: "CodeableConcept.coding", Path: "CodeableConcept.coding", Min: 0, Max: "*", Type: []ElementDataType{{Code: "Coding"}}},
+			{ID: "CodeableConcept.text", Path: "CodeableConcept.text", Min: 0, Max: "1", Type: []ElementDataType{{Code: "string"}}},
+		}},
+	}
+	return g
+}
+
+const profileTestBundle = `{"resourceType": "Bundle", "entry": [
+	{"resource": {"resourceType": "StructureDefinition", "url": "http://example.org/StructureDefinition/bp",
+		"name": "ExampleBP", "kind": "resource", "type": "Observation", "derivation": "constraint",
+		"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Observation",
+		"snapshot": {"element": [
+			{"id": "Observation", "path": "Observation", "min": 0, "max": "*",
+				"constraint": [{"key": "ex-1", "severity": "error", "human": "Needs a value or components",
+					"expression": "value.exists() or component.exists()", "source": "http://example.org/StructureDefinition/bp"}]},
+			{"id": "Observation.status", "path": "Observation.status", "min": 1, "max": "1", "type": [{"code": "code"}]},
+			{"id": "Observation.code", "path": "Observation.code", "min": 1, "max": "1", "type": [{"code": "CodeableConcept"}],
+				"patternCodeableConcept": {"coding": [{"system": "http://loinc.org", "code": "85354-9"}]}},
+			{"id": "Observation.subject", "path": "Observation.subject", "min": 1, "max": "1", "type": [{"code": "Reference"}]},
+			{"id": "Observation.value[x]", "path": "Observation.value[x]", "min": 0, "max": "0", "type": [{"code": "Quantity"}]},
+			{"id": "Observation.component", "path": "Observation.component", "min": 2, "max": "*", "type": [{"code": "BackboneElement"}],
+				"slicing": {"discriminator": [{"type": "value", "path": "code"}], "ordered": true, "rules": "closed"}},
+			{"id": "Observation.component:systolic", "path": "Observation.component", "sliceName": "systolic", "min": 1, "max": "1",
+				"type": [{"code": "BackboneElement"}]},
+			{"id": "Observation.component:systolic.code", "path": "Observation.component.code", "min": 1, "max": "1",
+				"type": [{"code": "CodeableConcept"}], "patternCodeableConcept": {"coding": [{"code": "8480-6"}]}},
+			{"id": "Observation.component:systolic.code.text", "path": "Observation.component.code.text", "min": 0, "max": "1",
+				"type": [{"code": "string"}]},
+			{"id": "Observation.component.code", "path": "Observation.component.code", "min": 1, "max": "1", "type": [{"code": "CodeableConcept"}]},
+			{"id": "Observation.component.code.text", "path": "Observation.component.code.text", "min": 0, "max": "1", "type": [{"code": "string"}],
+				"fixedString": "Blood pressure"}
+		]}}},
+	{"resource": {"resourceType": "StructureDefinition", "url": "http://example.org/StructureDefinition/ext",
+		"name": "ExampleExtension", "kind": "complex-type", "type": "Extension", "derivation": "constraint"}},
+	{"resource": {"resourceType": "StructureDefinition", "url": "http://example.org/StructureDefinition/Thing",
+		"name": "Thing", "kind": "resource", "type": "Thing", "derivation": "specialization"}}
+]}`
+
+func loadProfileTestBundle(t *testing.T) *Generator {
+	g := profileTestGenerator(t)
+	if err := os.WriteFile(filepath.Join(g.SpecPath, "profiles-others.json"), []byte(profileTestBundle), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.LoadProfiles("profiles-others.json"); err != nil {
+		t.Fatalf("LoadProfiles() error = %v", err)
+	}
+	return g
+}
+
+func TestLoadProfiles(t *testing.T) {
+	g := loadProfileTestBundle(t)
+	if len(g.Profiles) != 1 || g.Profiles[0].Name != "ExampleBP" {
+		t.Fatalf("Profiles = %v, want only ExampleBP", g.Profiles)
+	}
+	if _, ok := g.Definitions["ExampleBP"]; ok {
+		t.Error("LoadProfiles() added the profile to Definitions")
+	}
+}
+
+func TestProfileElements(t *testing.T) {
+	g := loadProfileTestBundle(t)
+	got := g.profileElements(g.Profiles[0])
+	for i := range got {
+		got[i].Slicing = nil
+	}
+
+	want := []ProfileElementInfo{
+		{Path: "Observation", Min: 0, Max: -1, Constraints: []Constraint{{Key: "ex-1", Severity: "error",
+			Human: "Needs a value or components", Expression: "value.exists() or component.exists()",
+			Source: "http://example.org/StructureDefinition/bp"}}},
+		{Path: "Observation.code", Min: 1, Max: 1, Pattern: `{"coding":[{"code":"85354-9","system":"http://loinc.org"}]}`},
+		{Path: "Observation.subject", Min: 1, Max: 1},
+		{Path: "Observation.value[x]", Min: 0, Max: 0, Types: []string{"Quantity"}},
+		{Path: "Observation.component", Min: 2, Max: -1},
+		{Path: "Observation.component.code.text", Min: 0, Max: 1, Fixed: `"Blood pressure"`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("profileElements() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestProfileElements_Slicing(t *testing.T) {
+	g := loadProfileTestBundle(t)
+	var slicing *ProfileSlicingInfo
+	for _, el := range g.profileElements(g.Profiles[0]) {
+		if el.Path == "Observation.component" {
+			slicing = el.Slicing
+		}
+	}
+
+	want := &ProfileSlicingInfo{
+		Discriminators: []Discriminator{{Type: "value", Path: "code"}},
+		Ordered:        true,
+		Rules:          "closed",
+		Slices: []ProfileSliceInfo{{Name: "systolic", Min: 1, Max: 1, Elements: []ProfileElementInfo{
+			{Path: "Observation.component.code", Min: 1, Max: 1, Pattern: `{"coding":[{"code":"8480-6"}]}`},
+		}}},
+	}
+	if !reflect.DeepEqual(slicing, want) {
+		t.Errorf("Observation.component slicing =\n%+v\nwant\n%+v", slicing, want)
+	}
+}
+
+func TestAppendExtensionURL(t *testing.T) {
+	slice := ElementDefinition{ID: "Composition.extension:period", SliceName: "period",
+		Type: []ElementDataType{{Code: "Extension", Profile: []string{"http://example.org/ext/period"}}}}
+	byURL := []Discriminator{{Type: "value", Path: "url"}}
+
+	got := appendExtensionURL(nil, "Composition.extension", slice, byURL)
+	want := []ProfileElementInfo{{Path: "Composition.extension.url", Min: 1, Max: 1, Fixed: `"http://example.org/ext/period"`}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("appendExtensionURL() = %+v, want %+v", got, want)
+	}
+	if got := appendExtensionURL(nil, "Composition.extension", slice, []Discriminator{{Type: "exists", Path: "url"}}); got != nil {
+		t.Errorf("appendExtensionURL() = %+v, want nothing for other discriminators", got)
+	}
+}
+
+func TestBaseElement(t *testing.T) {
+	g := profileTestGenerator(t)
+	tests := []struct {
+		path   string
+		want   string
+		wantOK bool
+	}{
+		{path: "Observation", want: "Observation", wantOK: true},
+		{path: "Observation.component.code", want: "Observation.component.code", wantOK: true},
+		{path: "Observation.code.text", want: "CodeableConcept.text", wantOK: true},
+		{path: "Observation.value[x].unit", wantOK: false},
+		{path: "Unknown.code", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			el, ok := g.baseElement(tt.path)
+			if ok != tt.wantOK || el.Path != tt.want {
+				t.Errorf("baseElement(%q) = %q, %v, want %q, %v", tt.path, el.Path, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateProfiles(t *testing.T) {
+	g := loadProfileTestBundle(t)
+	if err := g.GenerateProfiles(); err != nil {
+		t.Fatalf("GenerateProfiles() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "profiles.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		`URL: "http://example.org/StructureDefinition/bp", Name: "ExampleBP", Type: "Observation"`,
+		`{Path: "Observation.value[x]", Min: 0, Max: 0, Types: []string{"Quantity"}}`,
+		`Pattern: "{\"coding\":[{\"code\":\"85354-9\",\"system\":\"http://loinc.org\"}]}"`,
+		`{Key: "ex-1", Severity: "error"`,
+		`Discriminators: []ProfileDiscriminator{{Type: "value", Path: "code"}}`,
+		`{Name: "systolic", Min: 1, Max: 1, Elements: []ProfileElement{`,
+		"lookupProfile = ProfileByURL",
+		"decodeProfileValues(profileDefinitions)",
+		"ExampleBPProfile = &profileDefinitions[0]",
+		"func ProfileByURL(url string) (*ProfileDefinition, bool)",
+		"func ValidateProfiles(res Resource) ValidationIssues",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("profiles.go does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// referenceTargets returns the resource types a Reference or
+// CodeableReference element may refer to, from the targetProfile of its
+// type, or nil when it may refer to any resource or names a profile that
+// was not loaded.
+func (g *Generator) referenceTargets(el ElementDefinition) []string {
+	if len(el.Type) != 1 {
+		return nil
+	}
+	t := el.Type[0]
+	if t.Code != "Reference" && t.Code != "CodeableReference" {
+		return nil
+	}
+	var targets []string
+	for _, profile := range t.TargetProfile {
+		target := g.profileResourceType(profile)
+		if target == "" {
+			return nil
+		}
+		if !containsName(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+// profileResourceType returns the concrete resource type a profile URL
+// constrains, or "" for abstract types and unknown profiles.
+func (g *Generator) profileResourceType(url string) string {
+	url, _, _ = strings.Cut(url, "|")
+	if name, ok := strings.CutPrefix(url, "http://hl7.org/fhir/StructureDefinition/"); ok {
+		if def, ok := g.Definitions[name]; ok && def.Kind == "resource" && !def.Abstract {
+			return name
+		}
+	}
+	for _, profile := range g.Profiles {
+		if profile.URL == url {
+			resourceType, _ := profile.Type.(string)
+			return resourceType
+		}
+	}
+	return ""
+}
+
+// referenceTargetsVar names the table of the resource types a field of a
+// struct may refer to.
+func referenceTargetsVar(structName string, f FieldInfo) string {
+	return strings.ToLower(structName[:1]) + structName[1:] + f.Name + "Targets"
+}
+
+// writeReferenceTargets writes the target tables of the Reference and
+// CodeableReference fields of a struct that Validate checks.
+func writeReferenceTargets(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	for _, f := range fields {
+		if len(f.Targets) > 0 {
+			fmt.Fprintf(buf, "var %s = %s\n\n", referenceTargetsVar(structName, f), stringSliceLiteral(f.Targets))
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package gen
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestReferenceTargets(t *testing.T) {
+	g := NewGenerator("", "")
+	g.Definitions["Patient"] = StructureDefinition{Name: "Patient", Kind: "resource"}
+	g.Definitions["Group"] = StructureDefinition{Name: "Group", Kind: "resource"}
+	g.Definitions["DomainResource"] = StructureDefinition{Name: "DomainResource", Kind: "resource", Abstract: true}
+	g.Profiles = []StructureDefinition{{URL: "http://example.org/StructureDefinition/vip-patient", Type: "Patient"}}
+
+	reference := func(code string, targets ...string) ElementDefinition {
+		return ElementDefinition{Type: []ElementDataType{{Code: code, TargetProfile: targets}}}
+	}
+	tests := []struct {
+		name string
+		el   ElementDefinition
+		want []string
+	}{
+		{"core", reference("Reference", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Group"), []string{"Patient", "Group"}},
+		{"codeable", reference("CodeableReference", "http://hl7.org/fhir/StructureDefinition/Group|5.0.0"), []string{"Group"}},
+		{"profile", reference("Reference", "http://example.org/StructureDefinition/vip-patient", "http://hl7.org/fhir/StructureDefinition/Patient"), []string{"Patient"}},
+		{"any resource", reference("Reference", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/DomainResource"), nil},
+		{"unknown profile", reference("Reference", "http://example.org/StructureDefinition/other"), nil},
+		{"no targets", reference("Reference"), nil},
+		{"canonical", reference("canonical", "http://hl7.org/fhir/StructureDefinition/Patient"), nil},
+	}
+	for _, tt := range tests {
+		if got := g.referenceTargets(tt.el); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: referenceTargets() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWriteReferenceTargets(t *testing.T) {
+	var buf bytes.Buffer
+	writeReferenceTargets(&buf, "TestResource", []FieldInfo{
+		{Name: "Status", GoType: "string"},
+		{Name: "Subject", GoType: "*Reference", Targets: []string{"Patient", "Group"}},
+	})
+	want := "var testResourceSubjectTargets = []string{\"Patient\", \"Group\"}\n\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeReferenceTargets() = %q, want %q", got, want)
+	}
+}
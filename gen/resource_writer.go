@@ -0,0 +1,254 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+
+	"github.com/gruzdev-dev/fhir/tools/text"
+)
+
+// writeResourceInterface writes the abstract Resource and DomainResource
+// definitions as Go interfaces implemented by the concrete resources.
+func (g *Generator) writeResourceInterface(def StructureDefinition) error {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+	if def.Description != "" {
+		fmt.Fprintf(&buf, "// %s\n", sanitizeComment(def.Description))
+		fmt.Fprintf(&buf, "//\n")
+	}
+
+	switch def.Name {
+	case "Resource":
+		fmt.Fprintf(&buf, "// Resource is implemented by every concrete resource type and is used for\n")
+		fmt.Fprintf(&buf, "// elements that hold a whole resource, such as contained resources and\n")
+		fmt.Fprintf(&buf, "// Bundle entries.\n")
+		fmt.Fprintf(&buf, "type Resource interface {\n")
+		fmt.Fprintf(&buf, "\tGetResourceType() string\n")
+		fmt.Fprintf(&buf, "\tGetID() string\n")
+		fmt.Fprintf(&buf, "\tSetID(id string)\n")
+		fmt.Fprintf(&buf, "\tGetMeta() *Meta\n")
+		fmt.Fprintf(&buf, "\tSetMeta(meta *Meta)\n")
+		fmt.Fprintf(&buf, "\tValidate() error\n")
+		fmt.Fprintf(&buf, "\tValidateAll() ValidationIssues\n")
+		fmt.Fprintf(&buf, "}\n")
+	case "DomainResource":
+		fmt.Fprintf(&buf, "// DomainResource is implemented by every resource that carries narrative,\n")
+		fmt.Fprintf(&buf, "// contained resources and extensions, i.e. all resources except Bundle,\n")
+		fmt.Fprintf(&buf, "// Binary and Parameters.\n")
+		fmt.Fprintf(&buf, "type DomainResource interface {\n")
+		fmt.Fprintf(&buf, "\tResource\n")
+		fmt.Fprintf(&buf, "\tGetText() *Narrative\n")
+		fmt.Fprintf(&buf, "\tGetContained() []Resource\n")
+		fmt.Fprintf(&buf, "\tGetExtension() []Extension\n")
+		fmt.Fprintf(&buf, "}\n")
+	}
+
+	return g.writeFormatted(def.Name, text.ToSnakeCase(def.Name)+".go", buf.Bytes())
+}
+
+// writeResourceMethods writes the accessors that make a concrete resource
+// satisfy the Resource interface, and DomainResource where applicable,
+// followed by a compile-time assertion.
+func (g *Generator) writeResourceMethods(buf *bytes.Buffer, def StructureDefinition, fields []FieldInfo) error {
+	name := def.Name
+	has := make(map[string]bool)
+	for _, f := range fields {
+		has[f.Name] = true
+	}
+
+	fmt.Fprintf(buf, "func (r *%s) GetResourceType() string {\n", name)
+	fmt.Fprintf(buf, "\treturn %q\n", name)
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) GetID() string {\n", name)
+	fmt.Fprintf(buf, "\tif r.Id == nil {\n")
+	fmt.Fprintf(buf, "\t\treturn \"\"\n")
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\treturn *r.Id\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) SetID(id string) {\n", name)
+	fmt.Fprintf(buf, "\tif id == \"\" {\n")
+	fmt.Fprintf(buf, "\t\tr.Id = nil\n")
+	fmt.Fprintf(buf, "\t\treturn\n")
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\tr.Id = &id\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) GetMeta() *Meta {\n", name)
+	fmt.Fprintf(buf, "\treturn r.Meta\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) SetMeta(meta *Meta) {\n", name)
+	fmt.Fprintf(buf, "\tr.Meta = meta\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	domain, err := g.isDomainResource(def)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	iface := "Resource"
+	if domain && has["Text"] && has["Contained"] && has["Extension"] {
+		iface = "DomainResource"
+
+		fmt.Fprintf(buf, "func (r *%s) GetText() *Narrative {\n", name)
+		fmt.Fprintf(buf, "\treturn r.Text\n")
+		fmt.Fprintf(buf, "}\n\n")
+
+		fmt.Fprintf(buf, "func (r *%s) GetContained() []Resource {\n", name)
+		fmt.Fprintf(buf, "\treturn r.Contained\n")
+		fmt.Fprintf(buf, "}\n\n")
+
+		fmt.Fprintf(buf, "func (r *%s) GetExtension() []Extension {\n", name)
+		fmt.Fprintf(buf, "\treturn r.Extension\n")
+		fmt.Fprintf(buf, "}\n\n")
+	}
+
+	fmt.Fprintf(buf, "var _ %s = (*%s)(nil)\n\n", iface, name)
+	return nil
+}
+
+// concreteResources returns the names of the generated non-abstract
+// resources, sorted.
+func (g *Generator) concreteResources() []string {
+	var names []string
+	for _, def := range g.Definitions {
+		if def.Kind == "resource" && !def.Abstract && text.IsValidGoIdentifier(def.Name) && g.generatesResource(def.Name) {
+			names = append(names, def.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeResourceRegistry writes the resourceType → constructor table used to
+// decode resources polymorphically.
+func (g *Generator) writeResourceRegistry() error {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+	fmt.Fprintf(&buf, "import (\n")
+	fmt.Fprintf(&buf, "\t\"bytes\"\n")
+	fmt.Fprintf(&buf, "\t\"encoding/json\"\n")
+	if g.Options.XML {
+		fmt.Fprintf(&buf, "\t\"encoding/xml\"\n")
+	}
+	fmt.Fprintf(&buf, "\t\"fmt\"\n")
+	fmt.Fprintf(&buf, ")\n\n")
+
+	fmt.Fprintf(&buf, "var resourceFactories = map[string]func() Resource{\n")
+	for _, name := range g.concreteResources() {
+		fmt.Fprintf(&buf, "\t%q: func() Resource { return &%s{} },\n", name, name)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// NewResource returns a new, empty resource of the given type. ok is false\n")
+	fmt.Fprintf(&buf, "// when the type is not a known concrete resource.\n")
+	fmt.Fprintf(&buf, "func NewResource(resourceType string) (res Resource, ok bool) {\n")
+	fmt.Fprintf(&buf, "\tfactory, ok := resourceFactories[resourceType]\n")
+	fmt.Fprintf(&buf, "\tif !ok {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, false\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn factory(), true\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// UnmarshalResource decodes a JSON resource into the concrete type named by\n")
+	fmt.Fprintf(&buf, "// its resourceType, e.g. *Patient or *Bundle.\n")
+	fmt.Fprintf(&buf, "func UnmarshalResource(data []byte) (Resource, error) {\n")
+	fmt.Fprintf(&buf, "\tvar header struct {\n")
+	fmt.Fprintf(&buf, "\t\tResourceType string `json:\"resourceType\"`\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tif err := json.Unmarshal(data, &header); err != nil {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, err\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tif header.ResourceType == \"\" {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, fmt.Errorf(\"missing resourceType\")\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tres, ok := NewResource(header.ResourceType)\n")
+	fmt.Fprintf(&buf, "\tif !ok {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, fmt.Errorf(\"unknown resourceType '%%s'\", header.ResourceType)\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\tif err := json.Unmarshal(data, res); err != nil {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, fmt.Errorf(\"%%s: %%w\", header.ResourceType, err)\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn res, nil\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "func unmarshalOptionalResource(data json.RawMessage) (Resource, error) {\n")
+	fmt.Fprintf(&buf, "\tif len(data) == 0 || bytes.Equal(data, []byte(\"null\")) {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, nil\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn UnmarshalResource(data)\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "func init() {\n")
+	fmt.Fprintf(&buf, "\tnewResourceValue = func(resourceType string) (any, bool) {\n")
+	fmt.Fprintf(&buf, "\t\treturn NewResource(resourceType)\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	if g.Options.XML {
+		fmt.Fprintf(&buf, "// MarshalResourceXML encodes a resource as FHIR XML, in the element named\n")
+		fmt.Fprintf(&buf, "// after its type.\n")
+		fmt.Fprintf(&buf, "func MarshalResourceXML(res Resource) ([]byte, error) {\n")
+		fmt.Fprintf(&buf, "\treturn xml.Marshal(res)\n")
+		fmt.Fprintf(&buf, "}\n\n")
+
+		fmt.Fprintf(&buf, "// UnmarshalResourceXML decodes a FHIR XML resource into the concrete type\n")
+		fmt.Fprintf(&buf, "// named by its root element, e.g. *Patient or *Bundle.\n")
+		fmt.Fprintf(&buf, "func UnmarshalResourceXML(data []byte) (Resource, error) {\n")
+		fmt.Fprintf(&buf, "\td := xml.NewDecoder(bytes.NewReader(data))\n")
+		fmt.Fprintf(&buf, "\tfor {\n")
+		fmt.Fprintf(&buf, "\t\ttok, err := d.Token()\n")
+		fmt.Fprintf(&buf, "\t\tif err != nil {\n")
+		fmt.Fprintf(&buf, "\t\t\treturn nil, err\n")
+		fmt.Fprintf(&buf, "\t\t}\n")
+		fmt.Fprintf(&buf, "\t\tstart, ok := tok.(xml.StartElement)\n")
+		fmt.Fprintf(&buf, "\t\tif !ok {\n")
+		fmt.Fprintf(&buf, "\t\t\tcontinue\n")
+		fmt.Fprintf(&buf, "\t\t}\n")
+		fmt.Fprintf(&buf, "\t\tif start.Name.Space != fhirNamespace {\n")
+		fmt.Fprintf(&buf, "\t\t\treturn nil, fmt.Errorf(\"element %%s is not in the FHIR namespace\", start.Name.Local)\n")
+		fmt.Fprintf(&buf, "\t\t}\n")
+		fmt.Fprintf(&buf, "\t\tres, ok := NewResource(start.Name.Local)\n")
+		fmt.Fprintf(&buf, "\t\tif !ok {\n")
+		fmt.Fprintf(&buf, "\t\t\treturn nil, fmt.Errorf(\"unknown resourceType '%%s'\", start.Name.Local)\n")
+		fmt.Fprintf(&buf, "\t\t}\n")
+		fmt.Fprintf(&buf, "\t\tif err := d.DecodeElement(res, &start); err != nil {\n")
+		fmt.Fprintf(&buf, "\t\t\treturn nil, fmt.Errorf(\"%%s: %%w\", start.Name.Local, err)\n")
+		fmt.Fprintf(&buf, "\t\t}\n")
+		fmt.Fprintf(&buf, "\t\treturn res, nil\n")
+		fmt.Fprintf(&buf, "\t}\n")
+		fmt.Fprintf(&buf, "}\n\n")
+	}
+
+	fmt.Fprintf(&buf, "// MarshalResourceTurtle encodes a resource as FHIR RDF in Turtle syntax. A\n")
+	fmt.Fprintf(&buf, "// resource with an id is named by its type and id relative to base, which\n")
+	fmt.Fprintf(&buf, "// defaults to http://hl7.org/fhir/ and also resolves relative references.\n")
+	fmt.Fprintf(&buf, "func MarshalResourceTurtle(res Resource, base string) ([]byte, error) {\n")
+	fmt.Fprintf(&buf, "\treturn marshalTurtle(res, base)\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// UnmarshalResourceTurtle decodes the FHIR RDF resource of a Turtle document,\n")
+	fmt.Fprintf(&buf, "// the node with fhir:nodeRole fhir:treeRoot, into its concrete type.\n")
+	fmt.Fprintf(&buf, "func UnmarshalResourceTurtle(data []byte) (Resource, error) {\n")
+	fmt.Fprintf(&buf, "\tres, err := unmarshalTurtle(data)\n")
+	fmt.Fprintf(&buf, "\tif err != nil {\n")
+	fmt.Fprintf(&buf, "\t\treturn nil, err\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn res.(Resource), nil\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return g.writeFormatted("resource registry", "resource_registry.go", buf.Bytes())
+}
+
+func (g *Generator) writeFormatted(name, fileName string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format error for %s: %w", name, err)
+	}
+	return g.writeFile(fileName, formatted)
+}
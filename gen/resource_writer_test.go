@@ -0,0 +1,164 @@
+package gen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newResourceGenerator(t *testing.T) *Generator {
+	t.Helper()
+	g := NewGenerator("", t.TempDir())
+	g.Definitions["Resource"] = StructureDefinition{Name: "Resource", Kind: "resource", Abstract: true}
+	g.Definitions["Patient"] = StructureDefinition{Name: "Patient", Kind: "resource"}
+	g.Definitions["Bundle"] = StructureDefinition{Name: "Bundle", Kind: "resource"}
+	g.Definitions["DomainResource"] = StructureDefinition{Name: "DomainResource", Kind: "resource", Abstract: true}
+	return g
+}
+
+func TestMapGoType_Resource(t *testing.T) {
+	el := ElementDefinition{Path: "Bundle.entry.resource", Type: []ElementDataType{{Code: "Resource"}}}
+
+	g := NewGenerator("", "")
+	if got := g.mapGoType(el); got != "json.RawMessage" {
+		t.Errorf("without Resource definition mapGoType() = %v, want json.RawMessage", got)
+	}
+
+	g = newResourceGenerator(t)
+	if got := g.mapGoType(el); got != "Resource" {
+		t.Errorf("with Resource definition mapGoType() = %v, want Resource", got)
+	}
+}
+
+func TestWriteUnmarshalJSON_ResourceFields(t *testing.T) {
+	g := newResourceGenerator(t)
+	fields := []FieldInfo{
+		{Name: "Contained", GoType: "[]Resource", JSONTag: "`json:\"contained,omitempty\"`"},
+		{Name: "Outcome", GoType: "Resource", JSONTag: "`json:\"outcome,omitempty\"`"},
+	}
+
+	var buf bytes.Buffer
+	g.writeUnmarshalJSON(&buf, "TestResource", fields)
+
+	output := buf.String()
+	expected := []string{
+		"func (r *TestResource) UnmarshalJSON(data []byte) error {",
+		"Contained []json.RawMessage `json:\"contained,omitempty\"`",
+		"Outcome json.RawMessage `json:\"outcome,omitempty\"`",
+		"res, err := unmarshalOptionalResource(raw)",
+		`return fmt.Errorf("contained[%d]: %w", i, err)`,
+		"if r.Outcome, err = unmarshalOptionalResource(aux.Outcome); err != nil {",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+
+	buf.Reset()
+	g.writeUnmarshalJSON(&buf, "TestResource", []FieldInfo{{Name: "Id", GoType: "*string"}})
+	if buf.Len() != 0 {
+		t.Errorf("expected no UnmarshalJSON without resource fields, got:\n%s", buf.String())
+	}
+}
+
+func TestWriteResourceRegistry(t *testing.T) {
+	g := newResourceGenerator(t)
+	if err := g.writeResourceRegistry(); err != nil {
+		t.Fatalf("writeResourceRegistry() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "resource_registry.go"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	code := string(data)
+
+	if !strings.Contains(code, `"Bundle":  func() Resource { return &Bundle{} },`) ||
+		!strings.Contains(code, `"Patient": func() Resource { return &Patient{} },`) {
+		t.Errorf("expected concrete resources in registry, got:\n%s", code)
+	}
+	if strings.Contains(code, "&DomainResource{}") || strings.Contains(code, "&Resource{}") {
+		t.Errorf("abstract resources should not be registered, got:\n%s", code)
+	}
+	if strings.Index(code, `"Bundle"`) > strings.Index(code, `"Patient"`) {
+		t.Error("registry entries should be sorted")
+	}
+	if !strings.Contains(code, "func UnmarshalResourceXML(data []byte) (Resource, error) {") ||
+		!strings.Contains(code, "newResourceValue = func(resourceType string) (any, bool) {") {
+		t.Errorf("expected XML entry points in registry, got:\n%s", code)
+	}
+	if !strings.Contains(code, "func MarshalResourceTurtle(res Resource, base string) ([]byte, error) {") ||
+		!strings.Contains(code, "func UnmarshalResourceTurtle(data []byte) (Resource, error) {") {
+		t.Errorf("expected Turtle entry points in registry, got:\n%s", code)
+	}
+}
+
+func TestWriteResourceMethods(t *testing.T) {
+	g := newResourceGenerator(t)
+	g.Definitions["Patient"] = StructureDefinition{
+		Name: "Patient", Kind: "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/DomainResource",
+	}
+	g.Definitions["Bundle"] = StructureDefinition{
+		Name: "Bundle", Kind: "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Resource",
+	}
+	domainFields := []FieldInfo{
+		{Name: "Id", GoType: "*string"},
+		{Name: "Meta", GoType: "*Meta"},
+		{Name: "Text", GoType: "*Narrative"},
+		{Name: "Contained", GoType: "[]Resource"},
+		{Name: "Extension", GoType: "[]Extension"},
+	}
+
+	var buf bytes.Buffer
+	if err := g.writeResourceMethods(&buf, g.Definitions["Patient"], domainFields); err != nil {
+		t.Fatalf("writeResourceMethods() error = %v", err)
+	}
+	output := buf.String()
+	for _, exp := range []string{
+		"func (r *Patient) GetResourceType() string {",
+		"func (r *Patient) GetID() string {",
+		"func (r *Patient) SetID(id string) {",
+		"func (r *Patient) SetMeta(meta *Meta) {",
+		"func (r *Patient) GetContained() []Resource {",
+		"var _ DomainResource = (*Patient)(nil)",
+	} {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+
+	buf.Reset()
+	if err := g.writeResourceMethods(&buf, g.Definitions["Bundle"], domainFields[:2]); err != nil {
+		t.Fatalf("writeResourceMethods() error = %v", err)
+	}
+	output = buf.String()
+	if !strings.Contains(output, "var _ Resource = (*Bundle)(nil)") {
+		t.Errorf("expected Resource assertion for Bundle, got:\n%s", output)
+	}
+	if strings.Contains(output, "GetText") {
+		t.Errorf("Bundle should not get DomainResource accessors, got:\n%s", output)
+	}
+}
+
+func TestWriteResourceInterface_DomainResource(t *testing.T) {
+	g := newResourceGenerator(t)
+	if err := g.writeResourceInterface(g.Definitions["DomainResource"]); err != nil {
+		t.Fatalf("writeResourceInterface() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "domain_resource.go"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	code := string(data)
+	for _, exp := range []string{"type DomainResource interface {", "\tResource\n", "GetContained() []Resource"} {
+		if !strings.Contains(code, exp) {
+			t.Errorf("expected %q in domain_resource.go, got:\n%s", exp, code)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package gen
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+)
+
+// runtimeFiles holds hand-written support code shared by all generated
+// models. It is copied into the output package under its name.
+//
+//go:embed runtime/*.go
+var runtimeFiles embed.FS
+
+// operationRuntimeFile holds the helpers of the generated operation
+// parameters. It is written by GenerateOperations, with the operations.
+const operationRuntimeFile = "operation_parameters.go"
+
+// WriteRuntime copies the runtime files into the output directory. Generated
+// code depends on them: Generate writes them first, and a caller writing
+// single definitions with WriteResource calls it once itself.
+func (g *Generator) WriteRuntime() error {
+	entries, err := runtimeFiles.ReadDir("runtime")
+	if err != nil {
+		return fmt.Errorf("read runtime files: %w", err)
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), "_test.go") || entry.Name() == operationRuntimeFile || !g.needsRuntimeFile(entry.Name()) {
+			continue
+		}
+		if err := g.writeRuntimeFile(entry.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRuntimeFile copies the runtime file name into the output directory,
+// in the package of the generated code.
+func (g *Generator) writeRuntimeFile(name string) error {
+	data, err := g.runtimeFile(name)
+	if err != nil {
+		return err
+	}
+	if err := g.writeFile(name, data); err != nil {
+		return fmt.Errorf("write runtime file %s: %w", name, err)
+	}
+	return nil
+}
+
+// runtimeFile returns the runtime file name as it is written, in the
+// package of the generated code.
+func (g *Generator) runtimeFile(name string) ([]byte, error) {
+	data, err := runtimeFiles.ReadFile("runtime/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("read runtime file %s: %w", name, err)
+	}
+	return bytes.Replace(data, []byte("package models\n"), []byte("package "+g.Options.PackageName+"\n"), 1), nil
+}
+
+// runtimeChanged reports whether the runtime files the options need differ
+// from the ones a manifest records, as they do after editing gen/runtime.
+// The operation helpers are compared when the manifest has them.
+func (g *Generator) runtimeChanged(m *Manifest) (bool, error) {
+	entries, err := runtimeFiles.ReadDir("runtime")
+	if err != nil {
+		return false, fmt.Errorf("read runtime files: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		sum, recorded := m.Files[name]
+		if strings.HasSuffix(name, "_test.go") || !g.needsRuntimeFile(name) || (name == operationRuntimeFile && !recorded) {
+			continue
+		}
+		data, err := g.runtimeFile(name)
+		if err != nil {
+			return false, err
+		}
+		if checksum(data) != sum {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// needsRuntimeFile reports whether the output the options ask for uses the
+// runtime file name.
+func (g *Generator) needsRuntimeFile(name string) bool {
+	switch {
+	case name == "xml.go":
+		return g.Options.XML
+	case name == "bson.go":
+		return g.Options.BSONTags
+	case name == "invariant.go", name == "fixed_value.go", name == "reference_target.go",
+		name == "profile_definition.go", name == "bound_values.go", strings.HasPrefix(name, "fhirpath"):
+		return g.Options.Validation
+	}
+	return true
+}
@@ -0,0 +1,13 @@
+package models
+
+// BindingDefinition is the binding of a coded element of a resource to the
+// value set its codes are drawn from.
+type BindingDefinition struct {
+	// Path is the path of the element, such as Observation.interpretation.
+	Path string
+	// Strength is one of required, extensible, preferred and example.
+	Strength string
+	// ValueSet is the canonical URL of the value set, without a version.
+	ValueSet    string
+	Description string
+}
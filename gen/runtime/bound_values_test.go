@@ -0,0 +1,52 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBindingLocations(t *testing.T) {
+	obs := testObservationValue()
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "Observation.status", want: []string{"Observation.status"}},
+		{path: "Observation.code", want: []string{"Observation.code[0]", "Observation.code[1]"}},
+		{path: "Observation.component.code", want: []string{"Observation.component[0].code[0]", "Observation.component[1].code[0]"}},
+		{path: "Observation.value[x]", want: []string{"Observation.value.ofType(Quantity)"}},
+		{path: "Observation.method"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			var got []string
+			for _, item := range bindingLocations(obs, &BindingDefinition{Path: tt.path}) {
+				got = append(got, item.path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("bindingLocations(%s) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoundCode(t *testing.T) {
+	obs := testObservationValue()
+	status := bindingLocations(obs, &BindingDefinition{Path: "Observation.status"})
+	if code, ok := boundCode(status[0]); !ok || code != "final" {
+		t.Errorf("boundCode(status) = %q, %v, want final", code, ok)
+	}
+
+	component := bindingLocations(obs, &BindingDefinition{Path: "Observation.component.value[x]"})
+	if code, ok := boundCode(component[0]); ok {
+		t.Errorf("boundCode(string variant) = %q, want no code", code)
+	}
+	if _, ok := boundCode(bindingLocations(obs, &BindingDefinition{Path: "Observation.code"})[0]); ok {
+		t.Error("boundCode(Coding) found a code")
+	}
+
+	obs.Status = " "
+	if _, ok := boundCode(bindingLocations(obs, &BindingDefinition{Path: "Observation.status"})[0]); ok {
+		t.Error("boundCode() found a blank code")
+	}
+}
@@ -0,0 +1,159 @@
+package models
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testBSONPatient struct {
+	ResourceType string     `bson:"resource_type"`
+	Id           *string    `bson:"id,omitempty"`
+	Active       *bool      `bson:"active,omitempty"`
+	Given        []string   `bson:"given,omitempty"`
+	Rank         int        `bson:"rank,omitempty"`
+	Total        int64      `bson:"total,omitempty"`
+	BirthDate    *Date      `bson:"birth_date,omitempty"`
+	Weight       *Decimal   `bson:"weight,omitempty"`
+	Deceased     testChoice `bson:"deceased,omitempty"`
+	Contained    []any      `bson:"contained,omitempty"`
+	Link         *string    `bson:"link"`
+	variants     []string
+}
+
+func (r *testBSONPatient) choiceVariants(field string) (string, []choiceValue) {
+	if field == "Deceased" {
+		return "deceased", choiceValues(testChoiceVariants)
+	}
+	return "", nil
+}
+
+// withBSONRegistry makes testBSONPatient the only registered resource type
+// for the duration of a test.
+func withBSONRegistry(t *testing.T) {
+	t.Helper()
+	saved := newResourceValue
+	newResourceValue = func(resourceType string) (any, bool) {
+		if resourceType == "Patient" {
+			return &testBSONPatient{}, true
+		}
+		return nil, false
+	}
+	t.Cleanup(func() { newResourceValue = saved })
+}
+
+// bsonTestDocument builds a document from raw elements, each a type, a key and
+// the encoded value.
+func bsonTestDocument(elements ...[]byte) []byte {
+	doc := []byte{0, 0, 0, 0}
+	for _, el := range elements {
+		doc = append(doc, el...)
+	}
+	doc = append(doc, 0)
+	binary.LittleEndian.PutUint32(doc, uint32(len(doc)))
+	return doc
+}
+
+func TestBSON_RoundTrip(t *testing.T) {
+	withBSONRegistry(t)
+
+	in := testBSONPatient{
+		ResourceType: "Patient",
+		Id:           ptrTo("p1"),
+		Active:       ptrTo(false),
+		Given:        []string{"Amy", "Lee"},
+		Rank:         3,
+		Total:        1 << 40,
+		BirthDate:    ptrTo(Date{literal: "1974-12"}),
+		Weight:       ptrTo(MustParseDecimal("72.50")),
+		Deceased:     testChoiceDateTime{DateTime{literal: "2020-01-02T10:00:00Z"}},
+		Contained:    []any{&testBSONPatient{ResourceType: "Patient", Id: ptrTo("c1")}},
+	}
+	data, err := marshalBSON(&in)
+	if err != nil {
+		t.Fatalf("marshalBSON() error = %v", err)
+	}
+	for _, key := range []string{"resource_type", "birth_date", "deceased_date_time", "contained"} {
+		if !bytes.Contains(data, []byte(key+"\x00")) {
+			t.Errorf("document has no %q key", key)
+		}
+	}
+
+	var out testBSONPatient
+	if err := unmarshalBSON(data, &out); err != nil {
+		t.Fatalf("unmarshalBSON() error = %v", err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("unmarshalBSON() = %+v, want %+v", out, in)
+	}
+	if got := out.Weight.String(); got != "72.50" {
+		t.Errorf("Weight = %s, want 72.50", got)
+	}
+}
+
+func TestBSON_ResourceTypeDefaultsToTypeName(t *testing.T) {
+	data, err := marshalBSON(&testBSONPatient{})
+	if err != nil {
+		t.Fatalf("marshalBSON() error = %v", err)
+	}
+	if !bytes.Contains(data, []byte("testBSONPatient\x00")) {
+		t.Errorf("marshalBSON() = %q, want the type name as resource_type", data)
+	}
+}
+
+func TestBSON_SkipsUnknownKeys(t *testing.T) {
+	id := append([]byte{bsonObjectID}, "_id\x00"...)
+	id = append(id, make([]byte, 12)...)
+	regex := append([]byte{bsonRegex}, "pattern\x00a+\x00i\x00"...)
+	binaryValue := append([]byte{bsonBinary}, "blob\x00"...)
+	binaryValue = append(binaryValue, 2, 0, 0, 0, 0, 'h', 'i')
+	name := appendBSONString(append([]byte{bsonString}, "id\x00"...), "p1")
+
+	var out testBSONPatient
+	if err := unmarshalBSON(bsonTestDocument(id, regex, binaryValue, name), &out); err != nil {
+		t.Fatalf("unmarshalBSON() error = %v", err)
+	}
+	if out.Id == nil || *out.Id != "p1" {
+		t.Errorf("Id = %v, want p1", out.Id)
+	}
+}
+
+func TestBSON_NumbersAsDecimals(t *testing.T) {
+	weight := binary.LittleEndian.AppendUint32(append([]byte{bsonInt32}, "weight\x00"...), 72)
+
+	var out testBSONPatient
+	if err := unmarshalBSON(bsonTestDocument(weight), &out); err != nil {
+		t.Fatalf("unmarshalBSON() error = %v", err)
+	}
+	if out.Weight == nil || out.Weight.String() != "72" {
+		t.Errorf("Weight = %v, want 72", out.Weight)
+	}
+}
+
+func TestBSON_Errors(t *testing.T) {
+	withBSONRegistry(t)
+
+	unknown := bsonTestDocument(appendBSONString(append([]byte{bsonString}, "resource_type\x00"...), "Unknown"))
+	contained := append(append([]byte{bsonArray}, "contained\x00"...), bsonTestDocument(append(append([]byte{bsonDocument}, "0\x00"...), unknown...))...)
+
+	tests := map[string]struct {
+		doc  []byte
+		want string
+	}{
+		"malformed":             {[]byte{5, 0, 0}, "malformed BSON document"},
+		"wrong type":            {bsonTestDocument(appendBSONString(append([]byte{bsonString}, "active\x00"...), "yes")), "active: cannot decode BSON type 0x02 into a boolean"},
+		"unknown resource type": {bsonTestDocument(contained), `contained[0]: unknown resource type "Unknown"`},
+		"truncated value":       {bsonTestDocument(append([]byte{bsonInt64}, "total\x00\x01\x02"...)), "total: truncated BSON value"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out testBSONPatient
+			err := unmarshalBSON(tt.doc, &out)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("unmarshalBSON() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testChoice interface {
+	FHIRType() string
+}
+
+type testChoiceString string
+
+func (testChoiceString) FHIRType() string { return "string" }
+
+type testChoiceDateTime struct {
+	DateTime
+}
+
+func (testChoiceDateTime) FHIRType() string { return "dateTime" }
+
+var testChoiceVariants = []testChoice{testChoiceString(""), testChoiceDateTime{}}
+
+type testElement struct {
+	ID string `json:"id"`
+}
+
+func TestChoiceProperty(t *testing.T) {
+	tests := map[string]string{"string": "valueString", "dateTime": "valueDateTime", "Quantity": "valueQuantity"}
+	for fhirType, want := range tests {
+		if got := choiceProperty("value", fhirType); got != want {
+			t.Errorf("choiceProperty(value, %s) = %s, want %s", fhirType, got, want)
+		}
+	}
+}
+
+func TestMarshalChoice(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		value   choiceValue
+		element any
+		want    string
+	}{
+		{"empty object", `{}`, testChoiceString("x"), nil, `{"valueString":"x"}`},
+		{"appends", `{"id":"1"}`, testChoiceString("x"), nil, `{"id":"1","valueString":"x"}`},
+		{"nil value", `{"id":"1"}`, nil, nil, `{"id":"1"}`},
+		{"typed nil element", `{}`, testChoiceString("x"), (*testElement)(nil), `{"valueString":"x"}`},
+		{"element", `{}`, testChoiceDateTime{DateTime{literal: "2024-03"}}, &testElement{ID: "e"}, `{"valueDateTime":"2024-03","_valueDateTime":{"id":"e"}}`},
+		{"element without value", `{}`, testChoiceDateTime{}, &testElement{ID: "e"}, `{"_valueDateTime":{"id":"e"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := marshalChoice([]byte(tt.data), "value", tt.value, tt.element)
+			if err != nil {
+				t.Fatalf("marshalChoice() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("marshalChoice() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalChoice(t *testing.T) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(`{"valueDateTime":"2024-03","_valueDateTime":{"id":"e"}}`), &fields); err != nil {
+		t.Fatal(err)
+	}
+	value, element, conflicts, err := unmarshalChoice(fields, "value", testChoiceVariants)
+	if err != nil {
+		t.Fatalf("unmarshalChoice() error = %v", err)
+	}
+	dt, ok := value.(testChoiceDateTime)
+	if !ok || dt.String() != "2024-03" {
+		t.Errorf("value = %#v, want dateTime 2024-03", value)
+	}
+	if string(element) != `{"id":"e"}` {
+		t.Errorf("element = %s", element)
+	}
+	if conflicts != nil {
+		t.Errorf("conflicts = %v, want nil", conflicts)
+	}
+}
+
+func TestUnmarshalChoice_ElementOnly(t *testing.T) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(`{"_valueDateTime":{"id":"e"}}`), &fields); err != nil {
+		t.Fatal(err)
+	}
+	value, element, conflicts, err := unmarshalChoice(fields, "value", testChoiceVariants)
+	if err != nil {
+		t.Fatalf("unmarshalChoice() error = %v", err)
+	}
+	if value != (testChoiceDateTime{}) {
+		t.Errorf("value = %#v, want the zero dateTime variant", value)
+	}
+	if string(element) != `{"id":"e"}` || conflicts != nil {
+		t.Errorf("element = %s, conflicts = %v", element, conflicts)
+	}
+
+	data, err := marshalChoice([]byte(`{}`), "value", value, &testElement{ID: "e"})
+	if err != nil {
+		t.Fatalf("marshalChoice() error = %v", err)
+	}
+	if string(data) != `{"_valueDateTime":{"id":"e"}}` {
+		t.Errorf("marshalChoice() = %s, want the companion alone", data)
+	}
+}
+
+func TestUnmarshalChoice_MultipleVariants(t *testing.T) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(`{"valueString":"x","valueDateTime":"2024"}`), &fields); err != nil {
+		t.Fatal(err)
+	}
+	value, _, conflicts, err := unmarshalChoice(fields, "value", testChoiceVariants)
+	if err != nil {
+		t.Fatalf("unmarshalChoice() error = %v", err)
+	}
+	if value != testChoiceString("x") {
+		t.Errorf("value = %#v, want the first variant", value)
+	}
+	if want := []string{"valueString", "valueDateTime"}; !reflect.DeepEqual(conflicts, want) {
+		t.Errorf("conflicts = %v, want %v", conflicts, want)
+	}
+}
+
+func TestUnmarshalChoice_Absent(t *testing.T) {
+	value, element, conflicts, err := unmarshalChoice(map[string]json.RawMessage{}, "value", testChoiceVariants)
+	if err != nil || value != nil || element != nil || conflicts != nil {
+		t.Errorf("unmarshalChoice() = %v, %s, %v, %v, want all empty", value, element, conflicts, err)
+	}
+}
+
+func TestUnmarshalChoice_InvalidJSON(t *testing.T) {
+	fields := map[string]json.RawMessage{"valueString": json.RawMessage(`42`)}
+	if _, _, _, err := unmarshalChoice(fields, "value", testChoiceVariants); err == nil {
+		t.Error("unmarshalChoice() expected error")
+	}
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var datePattern = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1]))?)?$`)
+
+// Date is a FHIR date: a year, year-month or full date without a time or
+// timezone, e.g. "1974", "1974-12" or "1974-12-25". The original text is kept,
+// so the precision survives a round trip.
+type Date struct {
+	literal string
+}
+
+// ParseDate parses and validates a FHIR date literal.
+func ParseDate(s string) (Date, error) {
+	d := Date{literal: s}
+	if _, err := d.parse(); err != nil {
+		return Date{}, err
+	}
+	return d, nil
+}
+
+// NewDate returns the date of t at the given precision, which is capped at
+// PrecisionDay.
+func NewDate(t time.Time, precision TemporalPrecision) Date {
+	switch precision {
+	case PrecisionYear:
+		return Date{literal: t.Format("2006")}
+	case PrecisionMonth:
+		return Date{literal: t.Format("2006-01")}
+	default:
+		return Date{literal: t.Format("2006-01-02")}
+	}
+}
+
+func (d Date) String() string {
+	return d.literal
+}
+
+// IsZero reports whether d holds no value.
+func (d Date) IsZero() bool {
+	return d.literal == ""
+}
+
+// Validate checks d against the regex of the date type and rejects dates
+// that do not exist, such as 2023-02-29.
+func (d Date) Validate() error {
+	if d.IsZero() {
+		return nil
+	}
+	_, err := d.parse()
+	return err
+}
+
+// Precision returns the precision d was written with.
+func (d Date) Precision() TemporalPrecision {
+	t, err := d.parse()
+	if err != nil {
+		return 0
+	}
+	return t.precision
+}
+
+// Range returns the half-open interval [start, end) covered by d, in UTC.
+func (d Date) Range() (time.Time, time.Time, error) {
+	t, err := d.parse()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return t.start(), t.end(), nil
+}
+
+// Compare compares d and other with FHIR semantics. The result is -1, 0 or
+// +1; ok is false when the values have different precisions and overlap, so
+// that their order cannot be determined (e.g. "2024" and "2024-03").
+func (d Date) Compare(other Date) (result int, ok bool) {
+	a, err := d.parse()
+	if err != nil {
+		return 0, false
+	}
+	b, err := other.parse()
+	if err != nil {
+		return 0, false
+	}
+	return compareRanges(a.start(), a.end(), b.start(), b.end())
+}
+
+// DateTime returns d as a dateTime with the same precision.
+func (d Date) DateTime() DateTime {
+	return DateTime{literal: d.literal}
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return marshalTemporal(d.literal)
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalTemporal(data, &d.literal)
+}
+
+func (d Date) parse() (temporal, error) {
+	if !datePattern.MatchString(d.literal) {
+		return temporal{}, fmt.Errorf("invalid date %q", d.literal)
+	}
+	t, err := parseTemporal(d.literal)
+	if err != nil {
+		return temporal{}, fmt.Errorf("invalid date %q: %w", d.literal, err)
+	}
+	return t, nil
+}
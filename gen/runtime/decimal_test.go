@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimal_JSONRoundTrip(t *testing.T) {
+	tests := []string{"1.50", "0", "-0.001", "100", "3.141592653589793238462643383279", "1e3", "1.0E-2"}
+	for _, literal := range tests {
+		t.Run(literal, func(t *testing.T) {
+			var d Decimal
+			if err := json.Unmarshal([]byte(literal), &d); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			out, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(out) != literal {
+				t.Errorf("Marshal() = %s, want %s", out, literal)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalInvalid(t *testing.T) {
+	for _, input := range []string{`"abc"`, `true`, `"1.2.3"`, `"01"`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(input), &d); err == nil {
+			t.Errorf("Unmarshal(%s) expected error", input)
+		}
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"add keeps precision", MustParseDecimal("1.50").Add(MustParseDecimal("2")), "3.50"},
+		{"sub to negative", MustParseDecimal("1.5").Sub(MustParseDecimal("2.25")), "-0.75"},
+		{"mul sums precision", MustParseDecimal("1.5").Mul(MustParseDecimal("0.20")), "0.300"},
+		{"exponent", MustParseDecimal("1e2").Add(MustParseDecimal("0.5")), "100.5"},
+		{"neg", MustParseDecimal("0.10").Neg(), "-0.10"},
+		{"abs", MustParseDecimal("-2.0").Abs(), "2.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+
+	q, err := MustParseDecimal("2").Quo(MustParseDecimal("3"), 3)
+	if err != nil || q.String() != "0.667" {
+		t.Errorf("Quo() = %s, %v, want 0.667", q, err)
+	}
+	if _, err := MustParseDecimal("1").Quo(Decimal{}, 2); err == nil {
+		t.Error("Quo() by zero expected error")
+	}
+}
+
+func TestDecimal_Compare(t *testing.T) {
+	if !MustParseDecimal("1.50").Equal(MustParseDecimal("1.5")) {
+		t.Error("1.50 should equal 1.5")
+	}
+	if MustParseDecimal("-1").Cmp(MustParseDecimal("0.1")) != -1 {
+		t.Error("-1 should be less than 0.1")
+	}
+	if MustParseDecimal("1.50").Precision() != 2 {
+		t.Error("Precision() of 1.50 should be 2")
+	}
+	if !(Decimal{}).IsZero() || MustParseDecimal("0.0").Sign() != 0 {
+		t.Error("zero decimals should report zero")
+	}
+	if got := NewDecimalFromFloat(0.1).String(); got != "0.1" {
+		t.Errorf("NewDecimalFromFloat(0.1) = %s", got)
+	}
+}
@@ -0,0 +1,294 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// choiceStruct is implemented by generated structs with choice elements.
+// choiceVariants returns the element name and allowed variants of the
+// choice held by the Go field named field.
+type choiceStruct interface {
+	choiceVariants(field string) (string, []choiceValue)
+}
+
+// choiceValues converts the variants of a generated choice type for
+// choiceVariants.
+func choiceValues[T choiceValue](variants []T) []choiceValue {
+	values := make([]choiceValue, len(variants))
+	for i, v := range variants {
+		values[i] = v
+	}
+	return values
+}
+
+// elementTyped is implemented by generated structs with primitive elements
+// whose FHIR type their Go type does not tell, such as a uri held in a
+// string. elementTypes maps those element names to their FHIR types.
+type elementTyped interface {
+	elementTypes() map[string]string
+}
+
+// newResourceValue returns a new resource of the given type, for the
+// contained resources and other resource-valued elements decoded from XML
+// and RDF. It is set by the resource registry.
+var newResourceValue func(resourceType string) (any, bool)
+
+// elementField is an element of a generated struct as the XML and RDF
+// formats write it.
+type elementField struct {
+	name     string
+	index    int
+	element  int    // index of the Element companion of a primitive, or -1
+	fhirType string // FHIR type of a primitive, or "" for other elements
+	attr     bool   // written as an XML attribute: id of elements, url of Extension
+	resource bool   // holds resources, wrapped in an element named after their type
+	xhtml    bool   // Narrative.div, written as embedded XHTML
+	variants []choiceValue
+}
+
+var elementFieldCache sync.Map
+
+// elementFields returns the elements of a generated struct type in the order
+// of their declaration, which follows the element order of the snapshot.
+func elementFields(t reflect.Type) []elementField {
+	if cached, ok := elementFieldCache.Load(t); ok {
+		return cached.([]elementField)
+	}
+	_, isResource := t.FieldByName("ResourceType")
+	var choices choiceStruct
+	if c, ok := reflect.New(t).Interface().(choiceStruct); ok {
+		choices = c
+	}
+	var types map[string]string
+	if typed, ok := reflect.New(t).Interface().(elementTyped); ok {
+		types = typed.elementTypes()
+	}
+	var fields []elementField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		field := elementField{name: name, index: i, element: -1}
+		switch {
+		case sf.Type.Kind() == reflect.Interface && sf.Type.Implements(choiceValueType):
+			if choices == nil {
+				continue
+			}
+			field.name, field.variants = choices.choiceVariants(sf.Name)
+			if field.name == "" {
+				continue
+			}
+		case name == "" || name == "-" || name == "resourceType" || strings.HasPrefix(name, "_"):
+			continue
+		}
+		elemType := sf.Type
+		if elemType.Kind() == reflect.Slice {
+			elemType = elemType.Elem()
+		}
+		field.resource = elemType.Kind() == reflect.Interface && field.variants == nil
+		if field.variants == nil {
+			field.fhirType = types[name]
+			if field.fhirType == "" {
+				field.fhirType = primitiveType(elemType)
+			}
+		}
+		field.attr = !isResource && (name == "id" || (name == "url" && t.Name() == "Extension"))
+		field.xhtml = name == "div" && t.Name() == "Narrative"
+		if companion, ok := t.FieldByName(sf.Name + "Element"); ok && len(companion.Index) == 1 {
+			if tag, _, _ := strings.Cut(companion.Tag.Get("json"), ","); tag == "_"+name || (tag == "-" && field.variants != nil) {
+				field.element = companion.Index[0]
+			}
+		}
+		fields = append(fields, field)
+	}
+	// the structs of primitive types hold only id, extension and value,
+	// which FHIR XML writes as the value attribute
+	if len(fields) == 3 && fields[0].name == "id" && fields[1].name == "extension" && fields[2].name == "value" && fields[2].variants == nil {
+		fields[2].attr = true
+	}
+	elementFieldCache.Store(t, fields)
+	return fields
+}
+
+var choiceValueType = reflect.TypeOf((*choiceValue)(nil)).Elem()
+
+// primitiveType returns the FHIR type a Go primitive type stands for, or
+// "" for other types.
+func primitiveType(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case reflect.TypeOf(Decimal{}):
+		return "decimal"
+	case reflect.TypeOf(Date{}):
+		return "date"
+	case reflect.TypeOf(DateTime{}):
+		return "dateTime"
+	case reflect.TypeOf(Instant{}):
+		return "instant"
+	case reflect.TypeOf(Time{}):
+		return "time"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int:
+		return "integer"
+	case reflect.Int64:
+		return "integer64"
+	}
+	return ""
+}
+
+// resourceTypeOf returns the type of a resource struct, or "" for other
+// structs.
+func resourceTypeOf(v reflect.Value) string {
+	f := v.FieldByName("ResourceType")
+	if !f.IsValid() {
+		return ""
+	}
+	if f.String() != "" {
+		return f.String()
+	}
+	return v.Type().Name()
+}
+
+// indirectValue follows pointers and unwraps choice variants that embed the
+// type they hold, returning an invalid value for nil.
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.IsValid() {
+		switch {
+		case v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		case v.Kind() == reflect.Struct && v.NumField() == 1 && v.Type().Field(0).Anonymous:
+			v = v.Field(0)
+		default:
+			return v
+		}
+	}
+	return v
+}
+
+var primitiveStructs = map[reflect.Type]bool{
+	reflect.TypeOf(Decimal{}):  true,
+	reflect.TypeOf(Date{}):     true,
+	reflect.TypeOf(DateTime{}): true,
+	reflect.TypeOf(Instant{}):  true,
+	reflect.TypeOf(Time{}):     true,
+}
+
+func isPrimitiveStruct(t reflect.Type) bool {
+	return primitiveStructs[t]
+}
+
+// primitiveString returns the lexical value of a primitive. ok is
+// false for absent values: nil pointers, empty strings and zero temporal
+// values.
+func primitiveString(v reflect.Value) (string, bool) {
+	v = indirectValue(v)
+	if !v.IsValid() {
+		return "", false
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), v.Len() > 0
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	}
+	switch p := v.Interface().(type) {
+	case Decimal:
+		return p.String(), true
+	case Date:
+		return p.String(), !p.IsZero()
+	case DateTime:
+		return p.String(), !p.IsZero()
+	case Instant:
+		return p.String(), !p.IsZero()
+	case Time:
+		return p.String(), !p.IsZero()
+	}
+	return "", false
+}
+
+// choiceItem returns the value held by the choice element v, or an invalid
+// value when it only carries the extensions of its companion element: a
+// primitive variant holding its zero value next to a non-empty element.
+func choiceItem(v, element reflect.Value) reflect.Value {
+	item := v.Elem()
+	if e := indirectValue(element); item.IsZero() && !isComplexValue(indirectValue(item)) && e.IsValid() && !e.IsZero() {
+		return reflect.Value{}
+	}
+	return item
+}
+
+// isComplexValue reports whether v is written with children rather than a
+// value attribute.
+func isComplexValue(v reflect.Value) bool {
+	return v.Kind() == reflect.Struct && !isPrimitiveStruct(v.Type())
+}
+
+// setPrimitive parses the lexical value s into the primitive v.
+func setPrimitive(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Pointer {
+		target := reflect.New(v.Type().Elem())
+		if err := setPrimitive(target.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(target)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil || (s != "true" && s != "false") {
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		v.SetInt(n)
+		return nil
+	}
+	u, ok := v.Addr().Interface().(json.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("cannot decode %q into %s", s, v.Type())
+	}
+	if _, isDecimal := v.Interface().(Decimal); isDecimal {
+		return u.UnmarshalJSON([]byte(s))
+	}
+	quoted, _ := json.Marshal(s)
+	return u.UnmarshalJSON(quoted)
+}
+
+// childPath appends the name of a child element to the path of its parent,
+// for the errors of the decoders.
+func childPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,66 @@
+package models
+
+import "sync"
+
+// ElementMetadata describes an element of a generated type as the snapshot
+// of its StructureDefinition defines it, for code that works on elements
+// generically, such as validators, FHIRPath engines and form builders.
+type ElementMetadata struct {
+	// Path is the path of the element, such as Patient.contact.name.
+	Path string
+	// Name is the JSON property of the element. Choice elements have the
+	// name the property of each type starts with, such as value for
+	// value[x].
+	Name string
+	// Field is the index of the Go struct field holding the element, for
+	// reflect.Value.Field.
+	Field int
+	Min   int
+	// Max is the maximum cardinality, "*" when unbounded.
+	Max string
+	// Types are the FHIR types the element may have.
+	Types []string
+	// TargetProfiles are the profiles the resources a Reference or
+	// canonical element points to must conform to.
+	TargetProfiles []string
+	// Binding is the value set binding of a coded element, or nil.
+	Binding *BindingDefinition
+	// Summary reports whether the element is part of the summary view.
+	Summary bool
+	// Modifier reports whether the element changes the meaning of the
+	// element that contains it.
+	Modifier bool
+}
+
+// ElementMetadataHolder is implemented by the generated types, whose
+// ElementMetadata method returns the metadata of their elements in field
+// order.
+type ElementMetadataHolder interface {
+	ElementMetadata() []ElementMetadata
+}
+
+// elementMetadataTables holds the metadata tables of all generated types.
+// It is set by the generated code.
+var elementMetadataTables [][]ElementMetadata
+
+var (
+	elementMetadataOnce  sync.Once
+	elementMetadataIndex map[string]*ElementMetadata
+)
+
+// ElementMetadataByPath returns the metadata of an element by its path,
+// such as Patient.contact.name.
+func ElementMetadataByPath(path string) (*ElementMetadata, bool) {
+	elementMetadataOnce.Do(func() {
+		elementMetadataIndex = make(map[string]*ElementMetadata)
+		for _, table := range elementMetadataTables {
+			for i := range table {
+				if _, ok := elementMetadataIndex[table[i].Path]; !ok {
+					elementMetadataIndex[table[i].Path] = &table[i]
+				}
+			}
+		}
+	})
+	m, ok := elementMetadataIndex[path]
+	return m, ok
+}
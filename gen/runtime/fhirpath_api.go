@@ -0,0 +1,134 @@
+package models
+
+import (
+	"reflect"
+	"time"
+)
+
+// fhirpathExpression is a compiled FHIRPath expression, the entry point of
+// the engine for callers outside the invariants, such as the fhirpath
+// package. It holds no state of its own and is safe for concurrent use.
+type fhirpathExpression struct {
+	source string
+	expr   fhirpathExpr
+}
+
+// compileFHIRPathExpression parses a FHIRPath expression. Compiled
+// expressions are cached by their text, so compiling the same expression
+// again is cheap. Calls of unknown functions are reported by evaluate, not
+// here.
+func compileFHIRPathExpression(source string) (*fhirpathExpression, error) {
+	expr, err := compileFHIRPath(source)
+	if err != nil {
+		return nil, err
+	}
+	return &fhirpathExpression{source: source, expr: expr}, nil
+}
+
+func (e *fhirpathExpression) String() string {
+	return e.source
+}
+
+// fhirpathContext is the environment an expression is evaluated in.
+type fhirpathContext struct {
+	// Resource is %resource. It defaults to the input when the input is a
+	// resource.
+	Resource any
+	// RootResource is %rootResource, the resource containing Resource when
+	// it is a contained resource. It defaults to Resource.
+	RootResource any
+	// Variables are the values of %name for each name. A value may be a
+	// single item or a slice of items.
+	Variables map[string]any
+	// Resolver is called by resolve() for references other than those to
+	// contained resources, which resolve within RootResource. Without a
+	// resolver such references resolve to nothing.
+	Resolver func(reference string) (any, error)
+	// Now is the time today(), now() and timeOfDay() return. It defaults
+	// to the current time.
+	Now time.Time
+}
+
+// FHIRPathQuantity is a quantity computed by an expression, such as the
+// literal 4 'mg' or the result of adding two Quantity elements. Calendar
+// durations such as 3 days have the singular keyword as unit.
+type FHIRPathQuantity struct {
+	Value Decimal
+	Unit  string
+}
+
+// evaluate evaluates e with input as $this and %context. Items of the
+// result are pointers to the generated structs, or one of string, bool,
+// int64, Decimal, Date, DateTime, Instant, Time and FHIRPathQuantity. A
+// primitive element with extensions but no value is returned as its
+// *Element.
+func (e *fhirpathExpression) evaluate(input any, ctx fhirpathContext) ([]any, error) {
+	nodes := fhirpathInput(input)
+	env := &fhirpathEnv{
+		this:         nodes,
+		context:      nodes,
+		resource:     fhirpathInput(ctx.Resource),
+		rootResource: fhirpathInput(ctx.RootResource),
+		resolver:     ctx.Resolver,
+		now:          ctx.Now,
+	}
+	if env.resource == nil && len(nodes) == 1 && nodes[0].isResource() {
+		env.resource = nodes
+	}
+	if env.rootResource == nil {
+		env.rootResource = env.resource
+	}
+	if len(ctx.Variables) > 0 {
+		env.variables = make(map[string][]fhirpathNode, len(ctx.Variables))
+		for name, value := range ctx.Variables {
+			env.variables[name] = fhirpathInput(value)
+		}
+	}
+
+	result, err := e.expr.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]any, len(result))
+	for i, n := range result {
+		items[i] = n.interfaceValue()
+	}
+	return items, nil
+}
+
+// fhirpathInput converts a Go value, or a slice of values, into collection
+// items.
+func fhirpathInput(v any) []fhirpathNode {
+	switch x := v.(type) {
+	case nil:
+		return nil
+	case FHIRPathQuantity:
+		return []fhirpathNode{{value: fhirpathQuantity{value: x.Value, unit: x.Unit}}}
+	case []any:
+		var nodes []fhirpathNode
+		for _, item := range x {
+			nodes = append(nodes, fhirpathInput(item)...)
+		}
+		return nodes
+	}
+	return fhirpathValues(reflect.ValueOf(v))
+}
+
+// interfaceValue returns the Go value of an item as documented on
+// evaluate.
+func (n fhirpathNode) interfaceValue() any {
+	if n.object.IsValid() {
+		return n.object.Addr().Interface()
+	}
+	switch v := n.value.(type) {
+	case nil:
+		if n.element.IsValid() {
+			return n.element.Addr().Interface()
+		}
+	case fhirpathQuantity:
+		return FHIRPathQuantity{Value: v.value, Unit: v.unit}
+	case time.Time:
+		return NewDateTime(v, PrecisionSubsecond)
+	}
+	return n.value
+}
@@ -0,0 +1,324 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// fhirpathConverter converts an item to one of the FHIRPath system types,
+// reporting false when it is not convertible.
+type fhirpathConverter func(n fhirpathNode) (fhirpathNode, bool)
+
+// conversionFunctions returns the toX and convertsToX functions of each
+// system type.
+func conversionFunctions() map[string]fhirpathFunction {
+	converters := map[string]fhirpathConverter{
+		"Boolean":  toBoolean,
+		"Integer":  toInteger,
+		"Decimal":  toDecimal,
+		"String":   toStringNode,
+		"Date":     toDate,
+		"DateTime": toDateTime,
+		"Time":     toTime,
+		"Quantity": func(n fhirpathNode) (fhirpathNode, bool) { return toQuantity(n, "") },
+	}
+	functions := make(map[string]fhirpathFunction)
+	for name, convert := range converters {
+		name, convert := name, convert
+		functions["to"+name] = fhirpathFunction{0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) == 0 {
+				return nil, nil
+			}
+			if len(input) > 1 {
+				return nil, fmt.Errorf("to%s expects a single item, got %d", name, len(input))
+			}
+			if n, ok := convert(input[0]); ok {
+				return []fhirpathNode{n}, nil
+			}
+			return nil, nil
+		}}
+		functions["convertsTo"+name] = fhirpathFunction{0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) == 0 {
+				return nil, nil
+			}
+			if len(input) > 1 {
+				return nil, fmt.Errorf("convertsTo%s expects a single item, got %d", name, len(input))
+			}
+			_, ok := convert(input[0])
+			return boolResult(ok), nil
+		}}
+	}
+	functions["toQuantity"] = fhirpathFunction{0, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+		if len(input) != 1 {
+			return nil, nil
+		}
+		unit := ""
+		if len(args) == 1 {
+			var err error
+			if unit, _, err = stringArgument(env, args[0]); err != nil {
+				return nil, err
+			}
+		}
+		if n, ok := toQuantity(input[0], unit); ok {
+			return []fhirpathNode{n}, nil
+		}
+		return nil, nil
+	}}
+	return functions
+}
+
+func toBoolean(n fhirpathNode) (fhirpathNode, bool) {
+	switch v := n.value.(type) {
+	case bool:
+		return n, true
+	case int64:
+		if v == 0 || v == 1 {
+			return fhirpathNode{value: v == 1}, true
+		}
+	case Decimal:
+		if v.IsZero() || v.Equal(NewDecimalFromInt(1)) {
+			return fhirpathNode{value: !v.IsZero()}, true
+		}
+	case string:
+		switch strings.ToLower(v) {
+		case "true", "t", "yes", "y", "1", "1.0":
+			return fhirpathNode{value: true}, true
+		case "false", "f", "no", "n", "0", "0.0":
+			return fhirpathNode{value: false}, true
+		}
+	}
+	return fhirpathNode{}, false
+}
+
+func toInteger(n fhirpathNode) (fhirpathNode, bool) {
+	switch v := n.value.(type) {
+	case int64:
+		return n, true
+	case bool:
+		if v {
+			return fhirpathNode{value: int64(1)}, true
+		}
+		return fhirpathNode{value: int64(0)}, true
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return fhirpathNode{value: i}, true
+		}
+	}
+	return fhirpathNode{}, false
+}
+
+func toDecimal(n fhirpathNode) (fhirpathNode, bool) {
+	switch v := n.value.(type) {
+	case int64, Decimal:
+		d, _ := decimalOf(v)
+		return fhirpathNode{value: d}, true
+	case bool:
+		if v {
+			return fhirpathNode{value: MustParseDecimal("1.0")}, true
+		}
+		return fhirpathNode{value: MustParseDecimal("0.0")}, true
+	case string:
+		if d, err := ParseDecimal(v); err == nil {
+			return fhirpathNode{value: d}, true
+		}
+	}
+	return fhirpathNode{}, false
+}
+
+func toStringNode(n fhirpathNode) (fhirpathNode, bool) {
+	s, ok := nodeString(n)
+	return fhirpathNode{value: s}, ok
+}
+
+func toDate(n fhirpathNode) (fhirpathNode, bool) {
+	switch v := n.value.(type) {
+	case Date:
+		return n, true
+	case DateTime, Instant:
+		literal := fmt.Sprint(v)
+		date, _, _ := strings.Cut(literal, "T")
+		return fhirpathNode{value: Date{literal: date}}, true
+	case string:
+		if d, err := ParseDate(v); err == nil {
+			return fhirpathNode{value: d}, true
+		}
+		if dt, err := parsePartialDateTime(v); err == nil {
+			return toDate(fhirpathNode{value: dt})
+		}
+	}
+	return fhirpathNode{}, false
+}
+
+func toDateTime(n fhirpathNode) (fhirpathNode, bool) {
+	switch v := n.value.(type) {
+	case DateTime:
+		return n, true
+	case Date:
+		return fhirpathNode{value: v.DateTime()}, true
+	case Instant:
+		return fhirpathNode{value: DateTime{literal: v.String()}}, true
+	case string:
+		if dt, err := parsePartialDateTime(v); err == nil {
+			return fhirpathNode{value: dt}, true
+		}
+	}
+	return fhirpathNode{}, false
+}
+
+func toTime(n fhirpathNode) (fhirpathNode, bool) {
+	switch v := n.value.(type) {
+	case Time:
+		return n, true
+	case string:
+		if t, err := parsePartialTime(strings.TrimPrefix(v, "T")); err == nil {
+			return fhirpathNode{value: t}, true
+		}
+	}
+	return fhirpathNode{}, false
+}
+
+var quantityPattern = regexp.MustCompile(`^([+-]?[0-9]+(?:\.[0-9]+)?)\s*(?:'([^']+)'|([a-z]+))?$`)
+
+// toQuantity converts n to a quantity, in unit when it is not empty.
+// Numbers and booleans become quantities of the unit '1'; strings such as
+// "4 'mg'" and "3 days" are parsed.
+func toQuantity(n fhirpathNode, unit string) (fhirpathNode, bool) {
+	q, ok := quantityOf(n)
+	if !ok {
+		switch v := n.value.(type) {
+		case int64, Decimal:
+			d, _ := decimalOf(v)
+			q, ok = fhirpathQuantity{value: d, unit: "1"}, true
+		case bool:
+			d, _ := toDecimal(n)
+			q, ok = fhirpathQuantity{value: d.value.(Decimal), unit: "1"}, true
+		case string:
+			q, ok = parseQuantity(v)
+		}
+	}
+	if !ok {
+		return fhirpathNode{}, false
+	}
+	if unit != "" && unit != q.unit {
+		target := fhirpathQuantity{value: NewDecimalFromInt(1), unit: unit}
+		converted, scale, comparable := commonUnit(q, target)
+		if !comparable {
+			return fhirpathNode{}, false
+		}
+		value, err := converted.value.Quo(scale.value, 8)
+		if err != nil {
+			return fhirpathNode{}, false
+		}
+		q = fhirpathQuantity{value: value, unit: unit}
+	}
+	return fhirpathNode{value: q}, true
+}
+
+func parseQuantity(s string) (fhirpathQuantity, bool) {
+	m := quantityPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return fhirpathQuantity{}, false
+	}
+	d, err := ParseDecimal(m[1])
+	if err != nil {
+		return fhirpathQuantity{}, false
+	}
+	switch {
+	case m[2] != "":
+		return fhirpathQuantity{value: d, unit: m[2]}, true
+	case m[3] != "":
+		unit, ok := calendarUnits[m[3]]
+		return fhirpathQuantity{value: d, unit: unit}, ok
+	}
+	return fhirpathQuantity{value: d, unit: "1"}, true
+}
+
+// codingFunction implements encode and decode for the base64, urlbase64
+// and hex encodings.
+func codingFunction(encode bool) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+		s, ok, err := singletonString(input)
+		if err != nil || !ok {
+			return nil, err
+		}
+		format, _, err := stringArgument(env, args[0])
+		if err != nil {
+			return nil, err
+		}
+		var encoding interface {
+			EncodeToString([]byte) string
+			DecodeString(string) ([]byte, error)
+		}
+		switch format {
+		case "base64":
+			encoding = base64.StdEncoding
+		case "urlbase64":
+			encoding = base64.URLEncoding
+		case "hex":
+			encoding = hexEncoding{}
+		default:
+			return nil, fmt.Errorf("unknown encoding %q", format)
+		}
+		if encode {
+			return []fhirpathNode{{value: encoding.EncodeToString([]byte(s))}}, nil
+		}
+		decoded, err := encoding.DecodeString(s)
+		if err != nil {
+			return nil, nil
+		}
+		return []fhirpathNode{{value: string(decoded)}}, nil
+	}
+}
+
+type hexEncoding struct{}
+
+func (hexEncoding) EncodeToString(b []byte) string { return hex.EncodeToString(b) }
+
+func (hexEncoding) DecodeString(s string) ([]byte, error) { return hex.DecodeString(s) }
+
+// htmlEscaper escapes the characters the FHIRPath specification lists for
+// the html target, using named entities for quotes.
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&#39;")
+
+// escapeFunction implements escape and unescape for the html and json
+// targets.
+func escapeFunction(escape bool) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+		s, ok, err := singletonString(input)
+		if err != nil || !ok {
+			return nil, err
+		}
+		target, _, err := stringArgument(env, args[0])
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case target == "html" && escape:
+			return []fhirpathNode{{value: htmlEscaper.Replace(s)}}, nil
+		case target == "html":
+			return []fhirpathNode{{value: html.UnescapeString(s)}}, nil
+		case target == "json" && escape:
+			var buf strings.Builder
+			encoder := json.NewEncoder(&buf)
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(s); err != nil {
+				return nil, err
+			}
+			quoted := strings.TrimSuffix(buf.String(), "\n")
+			return []fhirpathNode{{value: quoted[1 : len(quoted)-1]}}, nil
+		case target == "json":
+			var unquoted string
+			if err := json.Unmarshal([]byte(`"`+s+`"`), &unquoted); err != nil {
+				return nil, nil
+			}
+			return []fhirpathNode{{value: unquoted}}, nil
+		}
+		return nil, fmt.Errorf("unknown escape target %q", target)
+	}
+}
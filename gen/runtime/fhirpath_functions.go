@@ -0,0 +1,823 @@
+package models
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fhirpathFunction is a FHIRPath function. Arguments are passed
+// unevaluated, as functions such as where evaluate them once per item.
+type fhirpathFunction struct {
+	minArgs, maxArgs int
+	eval             func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error)
+}
+
+var fhirpathFunctions map[string]fhirpathFunction
+
+func init() {
+	fhirpathFunctions = map[string]fhirpathFunction{
+		"empty": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return boolResult(len(input) == 0), nil
+		}},
+		"exists": {0, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(args) == 1 {
+				matches, err := filter(env, input, args[0])
+				return boolResult(len(matches) > 0), err
+			}
+			return boolResult(len(input) > 0), nil
+		}},
+		"not": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			b, ok, err := singletonBool(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return boolResult(!b), nil
+		}},
+		"count": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return []fhirpathNode{{value: int64(len(input))}}, nil
+		}},
+		"hasValue": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return boolResult(len(input) == 1 && input[0].hasValue()), nil
+		}},
+		"children": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			var result []fhirpathNode
+			for _, n := range input {
+				result = append(result, n.children()...)
+			}
+			return result, nil
+		}},
+		"descendants": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			var result []fhirpathNode
+			pending := input
+			for len(pending) > 0 {
+				var next []fhirpathNode
+				for _, n := range pending {
+					next = append(next, n.children()...)
+				}
+				result = append(result, next...)
+				pending = next
+			}
+			return result, nil
+		}},
+		"where": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			return filter(env, input, args[0])
+		}},
+		"select": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			var result []fhirpathNode
+			for i, n := range input {
+				items, err := args[0].eval(env.iterate(n, i))
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, items...)
+			}
+			return result, nil
+		}},
+		"all": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			for i, n := range input {
+				items, err := args[0].eval(env.iterate(n, i))
+				if err != nil {
+					return nil, err
+				}
+				if b, ok, err := singletonBool(items); err != nil || !ok || !b {
+					return boolResult(false), err
+				}
+			}
+			return boolResult(true), nil
+		}},
+		"allTrue":  {0, 0, booleanAggregate(true, true)},
+		"anyTrue":  {0, 0, booleanAggregate(false, true)},
+		"allFalse": {0, 0, booleanAggregate(true, false)},
+		"anyFalse": {0, 0, booleanAggregate(false, false)},
+		"first": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) == 0 {
+				return nil, nil
+			}
+			return input[:1], nil
+		}},
+		"last": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) == 0 {
+				return nil, nil
+			}
+			return input[len(input)-1:], nil
+		}},
+		"tail": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) == 0 {
+				return nil, nil
+			}
+			return input[1:], nil
+		}},
+		"skip": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			n, err := integerArgument(env, args[0])
+			if err != nil {
+				return nil, err
+			}
+			if n >= int64(len(input)) {
+				return nil, nil
+			}
+			return input[max(n, 0):], nil
+		}},
+		"take": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			n, err := integerArgument(env, args[0])
+			if err != nil || n <= 0 {
+				return nil, err
+			}
+			return input[:min(n, int64(len(input)))], nil
+		}},
+		"single": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) > 1 {
+				return nil, fmt.Errorf("expected a single item, got %d", len(input))
+			}
+			return input, nil
+		}},
+		"distinct": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return distinctNodes(input), nil
+		}},
+		"isDistinct": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return boolResult(len(distinctNodes(input)) == len(input)), nil
+		}},
+		"union": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			return distinctNodes(append(append([]fhirpathNode(nil), input...), other...)), err
+		}},
+		"combine": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			return append(append([]fhirpathNode(nil), input...), other...), err
+		}},
+		"intersect": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			var result []fhirpathNode
+			for _, n := range distinctNodes(input) {
+				if containsNode(other, n) {
+					result = append(result, n)
+				}
+			}
+			return result, err
+		}},
+		"exclude": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			var result []fhirpathNode
+			for _, n := range input {
+				if !containsNode(other, n) {
+					result = append(result, n)
+				}
+			}
+			return result, err
+		}},
+		"subsetOf": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			return boolResult(isSubset(input, other)), err
+		}},
+		"supersetOf": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			return boolResult(isSubset(other, input)), err
+		}},
+		"iif": {2, 3, func(env *fhirpathEnv, _ []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			criterion, err := args[0].eval(env)
+			if err != nil {
+				return nil, err
+			}
+			b, ok, err := singletonBool(criterion)
+			switch {
+			case err != nil:
+				return nil, err
+			case ok && b:
+				return args[1].eval(env)
+			case len(args) == 3:
+				return args[2].eval(env)
+			}
+			return nil, nil
+		}},
+		"trace": {1, 2, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return input, nil
+		}},
+		"ofType": {1, 1, ofType},
+		"as":     {1, 1, ofType},
+		"is": {1, 1, func(_ *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			typeName, err := typeArgument(args[0])
+			if err != nil || len(input) == 0 {
+				return nil, err
+			}
+			if len(input) > 1 {
+				return nil, fmt.Errorf("expected a single item, got %d", len(input))
+			}
+			return boolResult(input[0].is(typeName)), nil
+		}},
+		"extension": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			url, _, err := stringArgument(env, args[0])
+			var result []fhirpathNode
+			for _, n := range input {
+				for _, ext := range n.child("extension") {
+					if u, _, _ := singletonString(ext.child("url")); u == url {
+						result = append(result, ext)
+					}
+				}
+			}
+			return result, err
+		}},
+		"resolve": {0, 0, func(env *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			var result []fhirpathNode
+			for _, n := range input {
+				resolved, err := resolveReference(env, n)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, resolved...)
+			}
+			return result, nil
+		}},
+		"defineVariable": {1, 2, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			name, ok, err := stringArgument(env.nested(), args[0])
+			if err != nil {
+				return nil, err
+			}
+			if !ok || name == "" {
+				return nil, errors.New("expected a variable name")
+			}
+			value := input
+			if len(args) == 2 {
+				c := env.nested()
+				c.this = input
+				if value, err = args[1].eval(c); err != nil {
+					return nil, err
+				}
+			}
+			return input, env.define(name, value)
+		}},
+		"precision": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			if len(input) == 0 {
+				return nil, nil
+			}
+			if len(input) > 1 {
+				return nil, fmt.Errorf("expected a single item, got %d", len(input))
+			}
+			digits, ok := precisionDigits(input[0].value)
+			if !ok {
+				return nil, nil
+			}
+			return []fhirpathNode{{value: int64(digits)}}, nil
+		}},
+		"lowBoundary":  {0, 1, boundaryFunction(false)},
+		"highBoundary": {0, 1, boundaryFunction(true)},
+		"comparable": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			other, err := args[0].eval(env)
+			if err != nil || len(input) != 1 || len(other) != 1 {
+				return nil, err
+			}
+			qa, ok := quantityOf(input[0])
+			qb, otherOK := quantityOf(other[0])
+			return boolResult(ok && otherOK && qa.unit == qb.unit), nil
+		}},
+		"htmlChecks": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return boolResult(checkNarrative(s) == nil), nil
+		}},
+		"startsWith": {1, 1, stringFunction(func(s string, arg string) any { return strings.HasPrefix(s, arg) })},
+		"endsWith":   {1, 1, stringFunction(func(s string, arg string) any { return strings.HasSuffix(s, arg) })},
+		"contains":   {1, 1, stringFunction(func(s string, arg string) any { return strings.Contains(s, arg) })},
+		"indexOf":    {1, 1, stringFunction(func(s string, arg string) any { return int64(strings.Index(s, arg)) })},
+		"matches": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			pattern, ok, err := stringArgument(env, args[0])
+			if err != nil || !ok {
+				return nil, err
+			}
+			re, err := compileRegexp(pattern)
+			if err != nil {
+				return nil, err
+			}
+			return boolResult(re.MatchString(s)), nil
+		}},
+		"replaceMatches": {2, 2, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			pattern, _, err := stringArgument(env, args[0])
+			if err != nil {
+				return nil, err
+			}
+			substitution, _, err := stringArgument(env, args[1])
+			if err != nil {
+				return nil, err
+			}
+			re, err := compileRegexp(pattern)
+			if err != nil {
+				return nil, err
+			}
+			return []fhirpathNode{{value: re.ReplaceAllString(s, substitution)}}, nil
+		}},
+		"replace": {2, 2, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			pattern, _, err := stringArgument(env, args[0])
+			if err != nil {
+				return nil, err
+			}
+			substitution, _, err := stringArgument(env, args[1])
+			return []fhirpathNode{{value: strings.ReplaceAll(s, pattern, substitution)}}, err
+		}},
+		"substring": {1, 2, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			runes := []rune(s)
+			start, err := integerArgument(env, args[0])
+			if err != nil || start < 0 || start >= int64(len(runes)) {
+				return nil, err
+			}
+			end := int64(len(runes))
+			if len(args) == 2 {
+				length, err := integerArgument(env, args[1])
+				if err != nil {
+					return nil, err
+				}
+				end = min(end, start+max(length, 0))
+			}
+			return []fhirpathNode{{value: string(runes[start:end])}}, nil
+		}},
+		"length": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return []fhirpathNode{{value: int64(len([]rune(s)))}}, nil
+		}},
+		"upper": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return []fhirpathNode{{value: strings.ToUpper(s)}}, nil
+		}},
+		"lower": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return []fhirpathNode{{value: strings.ToLower(s)}}, nil
+		}},
+		"trim": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return []fhirpathNode{{value: strings.TrimSpace(s)}}, nil
+		}},
+		"lastIndexOf": {1, 1, stringFunction(func(s string, arg string) any { return int64(strings.LastIndex(s, arg)) })},
+		"split": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			separator, _, err := stringArgument(env, args[0])
+			if err != nil {
+				return nil, err
+			}
+			var result []fhirpathNode
+			for _, part := range strings.Split(s, separator) {
+				result = append(result, fhirpathNode{value: part})
+			}
+			return result, nil
+		}},
+		"join": {0, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			separator := ""
+			if len(args) == 1 {
+				var err error
+				if separator, _, err = stringArgument(env, args[0]); err != nil {
+					return nil, err
+				}
+			}
+			parts := make([]string, len(input))
+			for i, n := range input {
+				s, isString := n.value.(string)
+				if !isString {
+					return nil, fmt.Errorf("join expects strings, got %s", n.typeName())
+				}
+				parts[i] = s
+			}
+			return []fhirpathNode{{value: strings.Join(parts, separator)}}, nil
+		}},
+		"toChars": {0, 0, func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			s, ok, err := singletonString(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			var result []fhirpathNode
+			for _, r := range s {
+				result = append(result, fhirpathNode{value: string(r)})
+			}
+			return result, nil
+		}},
+		"encode":   {1, 1, codingFunction(true)},
+		"decode":   {1, 1, codingFunction(false)},
+		"escape":   {1, 1, escapeFunction(true)},
+		"unescape": {1, 1, escapeFunction(false)},
+		"repeat": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			var result []fhirpathNode
+			pending := input
+			for len(pending) > 0 {
+				var next []fhirpathNode
+				for i, n := range pending {
+					items, err := args[0].eval(env.iterate(n, i))
+					if err != nil {
+						return nil, err
+					}
+					for _, item := range items {
+						if !containsNode(result, item) {
+							result = append(result, item)
+							next = append(next, item)
+						}
+					}
+				}
+				pending = next
+			}
+			return result, nil
+		}},
+		"aggregate": {1, 2, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			var total []fhirpathNode
+			if len(args) == 2 {
+				var err error
+				if total, err = args[1].eval(env); err != nil {
+					return nil, err
+				}
+			}
+			for i, n := range input {
+				c := env.iterate(n, i)
+				c.total = total
+				var err error
+				if total, err = args[0].eval(c); err != nil {
+					return nil, err
+				}
+			}
+			return total, nil
+		}},
+		"today": {0, 0, func(env *fhirpathEnv, _ []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return []fhirpathNode{{value: NewDate(env.clock(), PrecisionDay)}}, nil
+		}},
+		"now": {0, 0, func(env *fhirpathEnv, _ []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return []fhirpathNode{{value: NewDateTime(env.clock(), PrecisionSubsecond)}}, nil
+		}},
+		"timeOfDay": {0, 0, func(env *fhirpathEnv, _ []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+			return []fhirpathNode{{value: NewTime(env.clock(), PrecisionSubsecond)}}, nil
+		}},
+	}
+	for _, more := range []map[string]fhirpathFunction{mathFunctions(), conversionFunctions()} {
+		for name, f := range more {
+			fhirpathFunctions[name] = f
+		}
+	}
+}
+
+// filter returns the items of input for which criteria is true.
+func filter(env *fhirpathEnv, input []fhirpathNode, criteria fhirpathExpr) ([]fhirpathNode, error) {
+	var result []fhirpathNode
+	for i, n := range input {
+		items, err := criteria.eval(env.iterate(n, i))
+		if err != nil {
+			return nil, err
+		}
+		if b, ok, err := singletonBool(items); err != nil {
+			return nil, err
+		} else if ok && b {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+// booleanAggregate implements allTrue, anyTrue, allFalse and anyFalse.
+func booleanAggregate(all, want bool) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+		for _, n := range input {
+			b, ok := n.value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("expected booleans, got %s", n.typeName())
+			}
+			if all && b != want {
+				return boolResult(false), nil
+			}
+			if !all && b == want {
+				return boolResult(true), nil
+			}
+		}
+		return boolResult(all), nil
+	}
+}
+
+func isSubset(items, of []fhirpathNode) bool {
+	for _, n := range items {
+		if !containsNode(of, n) {
+			return false
+		}
+	}
+	return true
+}
+
+// typeArgument reads the type name passed to ofType, as or is.
+func typeArgument(arg fhirpathExpr) (string, error) {
+	switch a := arg.(type) {
+	case *memberExpr:
+		if a.target == nil {
+			return a.name, nil
+		}
+		if qualifier, ok := a.target.(*memberExpr); ok && qualifier.target == nil {
+			return qualifier.name + "." + a.name, nil
+		}
+	}
+	return "", errors.New("expected a type name")
+}
+
+// ofType keeps the items of the type named by its argument. The as
+// function behaves the same on collections.
+func ofType(_ *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+	typeName, err := typeArgument(args[0])
+	if err != nil {
+		return nil, err
+	}
+	var result []fhirpathNode
+	for _, n := range input {
+		if n.is(typeName) {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+func stringArgument(env *fhirpathEnv, arg fhirpathExpr) (string, bool, error) {
+	items, err := arg.eval(env)
+	if err != nil {
+		return "", false, err
+	}
+	return singletonString(items)
+}
+
+func integerArgument(env *fhirpathEnv, arg fhirpathExpr) (int64, error) {
+	items, err := arg.eval(env)
+	if err != nil {
+		return 0, err
+	}
+	i, ok, err := singletonInteger(items)
+	if err == nil && !ok {
+		err = errors.New("expected an integer argument")
+	}
+	return i, err
+}
+
+// stringFunction builds a function of a single string input and a single
+// string argument. The result is empty when either of them is.
+func stringFunction(fn func(s, arg string) any) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+		s, ok, err := singletonString(input)
+		if err != nil || !ok {
+			return nil, err
+		}
+		arg, ok, err := stringArgument(env, args[0])
+		if err != nil || !ok {
+			return nil, err
+		}
+		return []fhirpathNode{{value: fn(s, arg)}}, nil
+	}
+}
+
+var regexpCache sync.Map
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexpCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexpCache.Store(pattern, re)
+	return re, nil
+}
+
+// nodeString converts a primitive item to its string representation.
+func nodeString(n fhirpathNode) (string, bool) {
+	switch v := n.value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case Decimal:
+		return v.String(), true
+	case Date:
+		return v.String(), true
+	case DateTime:
+		return v.String(), true
+	case Instant:
+		return v.String(), true
+	case Time:
+		return v.String(), true
+	case time.Time:
+		return v.Format(time.RFC3339Nano), true
+	case fhirpathQuantity:
+		return v.value.String() + " '" + v.unit + "'", true
+	}
+	return "", false
+}
+
+// resolveReference resolves a Reference element or reference string.
+// References to contained resources ("#id") and to the container itself
+// ("#") are resolved within %rootResource; others are passed to the
+// resolver of env, yielding an empty collection without one.
+func resolveReference(env *fhirpathEnv, n fhirpathNode) ([]fhirpathNode, error) {
+	ref, ok := n.value.(string)
+	if n.object.IsValid() {
+		ref, ok, _ = singletonString(n.child("reference"))
+	}
+	if !ok {
+		return nil, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		if env.resolver == nil {
+			return nil, nil
+		}
+		resolved, err := env.resolver(ref)
+		if err != nil {
+			return nil, fmt.Errorf("resolve %s: %w", ref, err)
+		}
+		return objectNode(resolved), nil
+	}
+	if ref == "#" {
+		return env.rootResource, nil
+	}
+	for _, root := range env.rootResource {
+		for _, contained := range root.child("contained") {
+			if id, _, _ := singletonString(contained.child("id")); id == ref[1:] {
+				return []fhirpathNode{contained}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// boundaryFunction implements lowBoundary and highBoundary: the least or
+// greatest value the input may stand for given its precision, so that
+// 1.5 covers [1.45, 1.55] and 2024-03 the whole month of March.
+func boundaryFunction(high bool) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+		if len(input) != 1 {
+			return nil, nil
+		}
+		n := input[0]
+		if q, ok := quantityOf(n); ok {
+			q.value = decimalBoundary(q.value, high)
+			return []fhirpathNode{{value: q}}, nil
+		}
+		if d, ok := decimalOf(n.value); ok {
+			return []fhirpathNode{{value: decimalBoundary(d, high)}}, nil
+		}
+		if v, ok := temporalBoundary(n.value, high); ok {
+			return []fhirpathNode{{value: v}}, nil
+		}
+		return nil, nil
+	}
+}
+
+// precisionDigits returns the number of digits a value was written with:
+// the decimal places of a decimal, and the digits of a date, dateTime or
+// time, such as 8 for 2014-01-05 and 4 for 10:30.
+func precisionDigits(v any) (int, bool) {
+	var (
+		t   temporal
+		err error
+	)
+	switch x := v.(type) {
+	case Decimal:
+		return x.Precision(), true
+	case Date:
+		t, err = x.parse()
+	case DateTime:
+		t, err = x.parse()
+	case Instant:
+		t, err = x.parse()
+	case Time:
+		t, err = x.parse()
+		if err != nil {
+			return 0, false
+		}
+		return 2*int(min(t.precision, PrecisionSecond)-PrecisionDay) + t.fractionDigits, true
+	default:
+		return 0, false
+	}
+	if err != nil {
+		return 0, false
+	}
+	// the year has four digits and every other component two
+	return 2 + 2*int(min(t.precision, PrecisionSecond)) + t.fractionDigits, true
+}
+
+// temporalBoundary returns the first or last millisecond a date, dateTime
+// or time stands for, as a value of the same type. Dates stop at days.
+func temporalBoundary(v any, high bool) (any, bool) {
+	var (
+		t   temporal
+		err error
+	)
+	switch x := v.(type) {
+	case Date:
+		t, err = x.parse()
+	case DateTime:
+		t, err = x.parse()
+	case Instant:
+		t, err = x.parse()
+	case Time:
+		t, err = x.parse()
+	default:
+		return nil, false
+	}
+	if err != nil {
+		return nil, false
+	}
+	bound := t.start()
+	if high {
+		bound = t.end().Add(-time.Millisecond)
+	}
+	switch v.(type) {
+	case Date:
+		return NewDate(bound, PrecisionDay), true
+	case Time:
+		return Time{literal: bound.Format("15:04:05.000")}, true
+	}
+	literal := bound.Format("2006-01-02T15:04:05.000")
+	if t.hasOffset() {
+		literal += bound.Format("Z07:00")
+	}
+	return DateTime{literal: literal}, true
+}
+
+func decimalBoundary(d Decimal, high bool) Decimal {
+	half := MustParseDecimal("0." + strings.Repeat("0", d.Precision()) + "5")
+	if high {
+		return d.Add(half)
+	}
+	return d.Sub(half)
+}
+
+// narrativeForbidden lists the elements FHIR does not allow in narrative.
+var narrativeForbidden = map[string]bool{
+	"head": true, "body": true, "script": true, "style": true, "base": true, "link": true,
+	"meta": true, "xlink": true, "form": true, "input": true, "button": true, "object": true,
+	"applet": true, "embed": true, "iframe": true, "frame": true, "frameset": true,
+}
+
+// checkNarrative checks the XHTML of a Narrative: a well-formed div
+// without active content.
+func checkNarrative(div string) error {
+	dec := xml.NewDecoder(strings.NewReader(div))
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			if depth != 0 {
+				return errors.New("unterminated element")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 && t.Name.Local != "div" {
+				return fmt.Errorf("root element is %s, not div", t.Name.Local)
+			}
+			if narrativeForbidden[t.Name.Local] {
+				return fmt.Errorf("element %s is not allowed", t.Name.Local)
+			}
+			for _, attr := range t.Attr {
+				if strings.HasPrefix(strings.ToLower(attr.Name.Local), "on") {
+					return fmt.Errorf("attribute %s is not allowed", attr.Name.Local)
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				return errors.New("text outside the div")
+			}
+		}
+	}
+}
@@ -0,0 +1,544 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type binaryExpr struct {
+	op          string
+	left, right fhirpathExpr
+}
+
+func (e *binaryExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	left, err := e.left.eval(env.nested())
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "and", "or", "xor", "implies":
+		return e.logical(env.nested(), left)
+	}
+	right, err := e.right.eval(env.nested())
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "|":
+		return distinctNodes(append(append([]fhirpathNode(nil), left...), right...)), nil
+	case "=", "!=":
+		equal, ok := equalCollections(left, right)
+		if !ok {
+			return nil, nil
+		}
+		return boolResult(equal == (e.op == "=")), nil
+	case "~", "!~":
+		return boolResult(equivalentCollections(left, right) == (e.op == "~")), nil
+	case "in":
+		return membership(left, right)
+	case "contains":
+		return membership(right, left)
+	case "&":
+		return concatenate(left, right)
+	}
+	if len(left) == 0 || len(right) == 0 {
+		return nil, nil
+	}
+	if len(left) > 1 || len(right) > 1 {
+		return nil, fmt.Errorf("operator %s expects single items, got %d and %d", e.op, len(left), len(right))
+	}
+	switch e.op {
+	case "<", "<=", ">", ">=":
+		c, ok, err := compareNodes(left[0], right[0])
+		if err != nil || !ok {
+			return nil, err
+		}
+		switch e.op {
+		case "<":
+			return boolResult(c < 0), nil
+		case "<=":
+			return boolResult(c <= 0), nil
+		case ">":
+			return boolResult(c > 0), nil
+		}
+		return boolResult(c >= 0), nil
+	}
+	return arithmetic(e.op, left[0], right[0])
+}
+
+// logical evaluates the three-valued boolean operators, skipping the right
+// operand when the left one decides the result.
+func (e *binaryExpr) logical(env *fhirpathEnv, leftItems []fhirpathNode) ([]fhirpathNode, error) {
+	left, leftOK, err := singletonBool(leftItems)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case e.op == "and" && leftOK && !left,
+		e.op == "implies" && leftOK && !left:
+		return boolResult(e.op == "implies"), nil
+	case e.op == "or" && leftOK && left:
+		return boolResult(true), nil
+	}
+	rightItems, err := e.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	right, rightOK, err := singletonBool(rightItems)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "and":
+		if rightOK && !right {
+			return boolResult(false), nil
+		}
+		if leftOK && rightOK {
+			return boolResult(true), nil
+		}
+	case "or":
+		if rightOK && right {
+			return boolResult(true), nil
+		}
+		if leftOK && rightOK {
+			return boolResult(false), nil
+		}
+	case "xor":
+		if leftOK && rightOK {
+			return boolResult(left != right), nil
+		}
+	case "implies":
+		if rightOK && right {
+			return boolResult(true), nil
+		}
+		if leftOK && rightOK {
+			return boolResult(false), nil
+		}
+	}
+	return nil, nil
+}
+
+func boolResult(b bool) []fhirpathNode {
+	return []fhirpathNode{{value: b}}
+}
+
+// singletonBool evaluates a collection in a boolean context. ok is false for
+// the empty collection; a single item that is not a boolean counts as true.
+func singletonBool(items []fhirpathNode) (value, ok bool, err error) {
+	switch len(items) {
+	case 0:
+		return false, false, nil
+	case 1:
+		if b, isBool := items[0].value.(bool); isBool {
+			return b, true, nil
+		}
+		return true, true, nil
+	}
+	return false, false, fmt.Errorf("expected a single boolean, got %d items", len(items))
+}
+
+// singletonString returns the single string of a collection. ok is false
+// for the empty collection.
+func singletonString(items []fhirpathNode) (value string, ok bool, err error) {
+	switch len(items) {
+	case 0:
+		return "", false, nil
+	case 1:
+		if s, isString := items[0].value.(string); isString {
+			return s, true, nil
+		}
+		return "", false, fmt.Errorf("expected a string, got %s", items[0].typeName())
+	}
+	return "", false, fmt.Errorf("expected a single string, got %d items", len(items))
+}
+
+// singletonInteger returns the single integer of a collection. ok is false
+// for the empty collection.
+func singletonInteger(items []fhirpathNode) (value int64, ok bool, err error) {
+	switch len(items) {
+	case 0:
+		return 0, false, nil
+	case 1:
+		if i, isInt := items[0].value.(int64); isInt {
+			return i, true, nil
+		}
+		return 0, false, fmt.Errorf("expected an integer, got %s", items[0].typeName())
+	}
+	return 0, false, fmt.Errorf("expected a single integer, got %d items", len(items))
+}
+
+// equalCollections implements =. ok is false when the result is empty.
+func equalCollections(a, b []fhirpathNode) (equal, ok bool) {
+	if len(a) == 0 || len(b) == 0 {
+		return false, false
+	}
+	if len(a) != len(b) {
+		return false, true
+	}
+	for i := range a {
+		equal, ok := equalNodes(a[i], b[i])
+		if !ok || !equal {
+			return equal, ok
+		}
+	}
+	return true, true
+}
+
+// equalNodes compares two items. ok is false when equality cannot be
+// determined, such as for dates of different precision or quantities of
+// different units.
+func equalNodes(a, b fhirpathNode) (equal, ok bool) {
+	if qa, isQuantity := quantityOf(a); isQuantity {
+		qb, isQuantity := quantityOf(b)
+		if !isQuantity {
+			return false, true
+		}
+		qa, qb, comparable := commonUnit(qa, qb)
+		if !comparable {
+			return false, false
+		}
+		return qa.value.Equal(qb.value), true
+	}
+	if a.object.IsValid() || b.object.IsValid() {
+		if !a.object.IsValid() || !b.object.IsValid() {
+			return false, true
+		}
+		return reflect.DeepEqual(a.object.Interface(), b.object.Interface()), true
+	}
+	if da, isNumber := decimalOf(a.value); isNumber {
+		db, isNumber := decimalOf(b.value)
+		return isNumber && da.Equal(db), true
+	}
+	if _, _, isTimeA, isTemporal := temporalRange(a.value); isTemporal {
+		_, _, isTimeB, isTemporal := temporalRange(b.value)
+		if !isTemporal || isTimeA != isTimeB {
+			return false, true
+		}
+		c, ok := compareTemporal(a.value, b.value)
+		return c == 0, ok
+	}
+	return a.value == b.value, true
+}
+
+// equivalentCollections implements ~: order is ignored, strings compare
+// case-insensitively and empty collections are equivalent.
+func equivalentCollections(a, b []fhirpathNode) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	matched := make([]bool, len(b))
+	for _, x := range a {
+		found := false
+		for j, y := range b {
+			if !matched[j] && equivalentNodes(x, y) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func equivalentNodes(a, b fhirpathNode) bool {
+	sa, isString := a.value.(string)
+	sb, isOtherString := b.value.(string)
+	if isString && isOtherString {
+		return strings.EqualFold(strings.Join(strings.Fields(sa), " "), strings.Join(strings.Fields(sb), " "))
+	}
+	if da, isNumber := decimalOf(a.value); isNumber {
+		if db, isNumber := decimalOf(b.value); isNumber {
+			precision := min(da.Precision(), db.Precision())
+			ra, _ := da.Quo(NewDecimalFromInt(1), precision)
+			rb, _ := db.Quo(NewDecimalFromInt(1), precision)
+			return ra.Equal(rb)
+		}
+	}
+	equal, ok := equalNodes(a, b)
+	return equal && ok
+}
+
+// membership implements item in collection.
+func membership(item, collection []fhirpathNode) ([]fhirpathNode, error) {
+	switch len(item) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("membership test on %d items", len(item))
+	}
+	for _, n := range collection {
+		if equal, ok := equalNodes(item[0], n); ok && equal {
+			return boolResult(true), nil
+		}
+	}
+	return boolResult(false), nil
+}
+
+// distinctNodes removes later duplicates from items.
+func distinctNodes(items []fhirpathNode) []fhirpathNode {
+	var result []fhirpathNode
+	for _, n := range items {
+		if !containsNode(result, n) {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+func containsNode(items []fhirpathNode, n fhirpathNode) bool {
+	for _, m := range items {
+		if equal, ok := equalNodes(m, n); ok && equal {
+			return true
+		}
+	}
+	return false
+}
+
+// concatenate implements &, which treats empty operands as "".
+func concatenate(left, right []fhirpathNode) ([]fhirpathNode, error) {
+	a, _, err := singletonString(left)
+	if err != nil {
+		return nil, err
+	}
+	b, _, err := singletonString(right)
+	if err != nil {
+		return nil, err
+	}
+	return []fhirpathNode{{value: a + b}}, nil
+}
+
+// compareNodes orders two items. ok is false when the order is unknown.
+func compareNodes(a, b fhirpathNode) (result int, ok bool, err error) {
+	if qa, isQuantity := quantityOf(a); isQuantity {
+		qb, isQuantity := quantityOf(b)
+		if !isQuantity {
+			return 0, false, fmt.Errorf("cannot compare Quantity with %s", b.typeName())
+		}
+		qa, qb, comparable := commonUnit(qa, qb)
+		if !comparable {
+			return 0, false, nil
+		}
+		return qa.value.Cmp(qb.value), true, nil
+	}
+	if da, isNumber := decimalOf(a.value); isNumber {
+		db, isNumber := decimalOf(b.value)
+		if !isNumber {
+			return 0, false, fmt.Errorf("cannot compare %s with %s", a.typeName(), b.typeName())
+		}
+		return da.Cmp(db), true, nil
+	}
+	if sa, isString := a.value.(string); isString {
+		sb, isString := b.value.(string)
+		if !isString {
+			return 0, false, fmt.Errorf("cannot compare string with %s", b.typeName())
+		}
+		return strings.Compare(sa, sb), true, nil
+	}
+	if _, _, _, isTemporal := temporalRange(a.value); isTemporal {
+		c, ok := compareTemporal(a.value, b.value)
+		return c, ok, nil
+	}
+	return 0, false, fmt.Errorf("cannot compare %s with %s", a.typeName(), b.typeName())
+}
+
+// decimalOf returns the numeric value of an integer or decimal.
+func decimalOf(v any) (Decimal, bool) {
+	switch n := v.(type) {
+	case int64:
+		return NewDecimalFromInt(n), true
+	case Decimal:
+		return n, true
+	}
+	return Decimal{}, false
+}
+
+// quantityOf returns the value and unit of a quantity literal or of a
+// Quantity element. The UCUM code is preferred over the human unit.
+func quantityOf(n fhirpathNode) (fhirpathQuantity, bool) {
+	if q, ok := n.value.(fhirpathQuantity); ok {
+		return q, true
+	}
+	if !n.object.IsValid() || n.isResource() {
+		return fhirpathQuantity{}, false
+	}
+	values := n.child("value")
+	if len(values) != 1 {
+		return fhirpathQuantity{}, false
+	}
+	d, ok := values[0].value.(Decimal)
+	if !ok {
+		return fhirpathQuantity{}, false
+	}
+	q := fhirpathQuantity{value: d}
+	for _, name := range []string{"code", "unit", "currency"} {
+		if unit, ok, _ := singletonString(n.child(name)); ok {
+			q.unit = unit
+			break
+		}
+	}
+	return q, true
+}
+
+// temporalRange returns the interval covered by a date, dateTime, instant,
+// time or boundary value. isTime is true for times of day.
+func temporalRange(v any) (start, end time.Time, isTime, ok bool) {
+	var (
+		t   temporal
+		err error
+	)
+	switch x := v.(type) {
+	case Date:
+		t, err = x.parse()
+	case DateTime:
+		t, err = x.parse()
+	case Instant:
+		t, err = x.parse()
+	case Time:
+		t, err = x.parse()
+		isTime = true
+	case time.Time:
+		return x, x.Add(time.Nanosecond), false, true
+	default:
+		return time.Time{}, time.Time{}, false, false
+	}
+	if err != nil {
+		return time.Time{}, time.Time{}, false, false
+	}
+	if t.precision >= PrecisionSecond {
+		// Seconds and fractions of a second are a single precision, so
+		// 10:30:00 equals 10:30:00.000.
+		return t.start(), t.start().Add(time.Nanosecond), isTime, true
+	}
+	return t.start(), t.end(), isTime, true
+}
+
+// compareTemporal compares two temporal values with FHIR semantics.
+func compareTemporal(a, b any) (int, bool) {
+	aStart, aEnd, aTime, ok := temporalRange(a)
+	if !ok {
+		return 0, false
+	}
+	bStart, bEnd, bTime, ok := temporalRange(b)
+	if !ok || aTime != bTime {
+		return 0, false
+	}
+	return compareRanges(aStart, aEnd, bStart, bEnd)
+}
+
+// arithmetic implements + - * / div mod on single items.
+func arithmetic(op string, a, b fhirpathNode) ([]fhirpathNode, error) {
+	if sa, isString := a.value.(string); isString && op == "+" {
+		sb, isString := b.value.(string)
+		if !isString {
+			return nil, fmt.Errorf("cannot add %s to string", b.typeName())
+		}
+		return []fhirpathNode{{value: sa + sb}}, nil
+	}
+	if ia, isInt := a.value.(int64); isInt {
+		if ib, isInt := b.value.(int64); isInt {
+			switch op {
+			case "+":
+				return []fhirpathNode{{value: ia + ib}}, nil
+			case "-":
+				return []fhirpathNode{{value: ia - ib}}, nil
+			case "*":
+				return []fhirpathNode{{value: ia * ib}}, nil
+			case "div", "mod":
+				if ib == 0 {
+					return nil, nil
+				}
+				if op == "div" {
+					return []fhirpathNode{{value: ia / ib}}, nil
+				}
+				return []fhirpathNode{{value: ia % ib}}, nil
+			}
+		}
+	}
+	if _, _, _, isTemporal := temporalRange(a.value); isTemporal && (op == "+" || op == "-") {
+		q, isQuantity := quantityOf(b)
+		if !isQuantity {
+			return nil, fmt.Errorf("operator %s is not defined for %s and %s", op, a.typeName(), b.typeName())
+		}
+		result, err := addDuration(a.value, q, op == "-")
+		if err != nil {
+			return nil, err
+		}
+		return []fhirpathNode{{value: result}}, nil
+	}
+	if qa, isQuantity := quantityOf(a); isQuantity {
+		return quantityArithmetic(op, qa, b)
+	}
+	da, isNumber := decimalOf(a.value)
+	db, isOtherNumber := decimalOf(b.value)
+	if !isNumber || !isOtherNumber {
+		return nil, fmt.Errorf("operator %s is not defined for %s and %s", op, a.typeName(), b.typeName())
+	}
+	return decimalArithmetic(op, da, db)
+}
+
+// quantityArithmetic adds or subtracts quantities of convertible units and
+// multiplies or divides a quantity by a number or another quantity.
+func quantityArithmetic(op string, qa fhirpathQuantity, b fhirpathNode) ([]fhirpathNode, error) {
+	if d, isNumber := decimalOf(b.value); isNumber && (op == "*" || op == "/") {
+		result, err := decimalArithmetic(op, qa.value, d)
+		if len(result) == 0 || err != nil {
+			return nil, err
+		}
+		qa.value = result[0].value.(Decimal)
+		return []fhirpathNode{{value: qa}}, nil
+	}
+	if qb, isQuantity := quantityOf(b); isQuantity && (op == "*" || op == "/") {
+		return multiplyQuantities(op, qa, qb)
+	}
+	if op == "+" || op == "-" {
+		qb, isQuantity := quantityOf(b)
+		if !isQuantity {
+			return nil, fmt.Errorf("operator %s is not defined for Quantity and %s", op, b.typeName())
+		}
+		if qa.unit != qb.unit {
+			var comparable bool
+			if qa, qb, comparable = commonUnit(qa, qb); !comparable {
+				return nil, nil
+			}
+		}
+		if op == "+" {
+			qa.value = qa.value.Add(qb.value)
+		} else {
+			qa.value = qa.value.Sub(qb.value)
+		}
+		return []fhirpathNode{{value: qa}}, nil
+	}
+	return nil, fmt.Errorf("operator %s is not defined for Quantity and %s", op, b.typeName())
+}
+
+// decimalArithmetic implements the operators on decimals, and on an
+// integer mixed with a decimal. Division by zero yields an empty result.
+func decimalArithmetic(op string, da, db Decimal) ([]fhirpathNode, error) {
+	switch op {
+	case "+":
+		return []fhirpathNode{{value: da.Add(db)}}, nil
+	case "-":
+		return []fhirpathNode{{value: da.Sub(db)}}, nil
+	case "*":
+		return []fhirpathNode{{value: da.Mul(db)}}, nil
+	}
+	if db.IsZero() {
+		return nil, nil
+	}
+	if op == "/" {
+		q, err := da.Quo(db, 8)
+		return []fhirpathNode{{value: q}}, err
+	}
+	r := new(big.Rat).Quo(da.Rat(), db.Rat())
+	quo := new(big.Int).Quo(r.Num(), r.Denom())
+	if op == "div" {
+		return []fhirpathNode{{value: formatDecimal(quo, 0)}}, nil
+	}
+	return []fhirpathNode{{value: da.Sub(db.Mul(formatDecimal(quo, 0)))}}, nil
+}
@@ -0,0 +1,283 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+type testCoding struct {
+	System *string `json:"system,omitempty"`
+	Code   *string `json:"code,omitempty"`
+}
+
+// TestQuantity is exported like the generated types embedded by choice
+// variants.
+type TestQuantity struct {
+	Value *Decimal `json:"value,omitempty"`
+	Unit  *string  `json:"unit,omitempty"`
+	Code  *string  `json:"code,omitempty"`
+}
+
+type testChoiceQuantity struct {
+	TestQuantity
+}
+
+func (testChoiceQuantity) FHIRType() string { return "Quantity" }
+
+type testComponent struct {
+	Code  []testCoding `json:"code,omitempty"`
+	Value testChoice   `json:"-"`
+}
+
+type testObservation struct {
+	ResourceType  string          `json:"resourceType"`
+	Id            *string         `json:"id,omitempty"`
+	Contained     []any           `json:"contained,omitempty"`
+	Status        string          `json:"status"`
+	StatusElement *testElement    `json:"_status,omitempty"`
+	Code          []testCoding    `json:"code,omitempty"`
+	Effective     testChoice      `json:"-"`
+	Value         testChoice      `json:"-"`
+	Component     []testComponent `json:"component,omitempty"`
+	Subject       *string         `json:"subject,omitempty"`
+}
+
+type testPatient struct {
+	ResourceType string  `json:"resourceType"`
+	Id           *string `json:"id,omitempty"`
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func testObservationValue() *testObservation {
+	return &testObservation{
+		ResourceType: "Observation",
+		Id:           ptrTo("obs1"),
+		Contained:    []any{&testPatient{ResourceType: "Patient", Id: ptrTo("p1")}},
+		Status:       "final",
+		Code: []testCoding{
+			{System: ptrTo("http://loinc.org"), Code: ptrTo("8867-4")},
+			{System: ptrTo("http://snomed.info/sct"), Code: ptrTo("364075005")},
+		},
+		Effective: testChoiceDateTime{DateTime{literal: "2024-03"}},
+		Value:     testChoiceQuantity{TestQuantity{Value: ptrTo(MustParseDecimal("72.5")), Unit: ptrTo("/min"), Code: ptrTo("/min")}},
+		Component: []testComponent{
+			{Code: []testCoding{{Code: ptrTo("a")}}, Value: testChoiceString("x")},
+			{Code: []testCoding{{Code: ptrTo("b")}}},
+		},
+		Subject: ptrTo("#p1"),
+	}
+}
+
+// evalFHIRPath evaluates expr on root and renders the result as a
+// comma-separated list, using the type name for structs.
+func evalFHIRPath(t *testing.T, root any, expr string) string {
+	t.Helper()
+	compiled, err := compileFHIRPath(expr)
+	if err != nil {
+		t.Fatalf("compile %q: %v", expr, err)
+	}
+	nodes := objectNode(root)
+	env := &fhirpathEnv{this: nodes, context: nodes, resource: nodes, rootResource: nodes}
+	result, err := compiled.eval(env)
+	if err != nil {
+		t.Fatalf("eval %q: %v", expr, err)
+	}
+	items := make([]string, len(result))
+	for i, n := range result {
+		if s, ok := nodeString(n); ok {
+			items[i] = s
+		} else {
+			items[i] = n.typeName()
+		}
+	}
+	return strings.Join(items, ",")
+}
+
+func TestFHIRPath_Evaluate(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"status", "final"},
+		{"Observation.status", "final"},
+		{"code.system", "http://loinc.org,http://snomed.info/sct"},
+		{"code[1].code", "364075005"},
+		{"code.count()", "2"},
+		{"missing.empty()", "true"},
+		{"value.exists() and status = 'final'", "true"},
+		{"value is Quantity", "true"},
+		{"value.ofType(Quantity).value", "72.5"},
+		{"value.value > 70", "true"},
+		{"value.value > 72.50", "false"},
+		{"value > 80 '/min'", "false"},
+		{"effective.ofType(dateTime) < @2024-04-01", "true"},
+		{"effective < @2024-03-15", ""},
+		{"component.where(value.exists()).code.code", "a"},
+		{"component.select(code.code)", "a,b"},
+		{"component.all(code.exists())", "true"},
+		{"component.value is string", "true"},
+		{"code.code.isDistinct()", "true"},
+		{"(code.system | code.system).count()", "2"},
+		{"status in ('final' | 'amended')", "true"},
+		{"'final' ~ 'FINAL'", "true"},
+		{"status != 'final'", "false"},
+		{"{}.exists() or true", "true"},
+		{"{} and false", "false"},
+		{"{} and true", ""},
+		{"false implies {}", "true"},
+		{"true xor false", "true"},
+		{"iif(status = 'final', 'done', 'pending')", "done"},
+		{"subject.resolve().id", "p1"},
+		{"subject.resolve() is Patient", "true"},
+		{"contained.id", "p1"},
+		{"'#' + contained.id = %resource.subject", "true"},
+		{"descendants().ofType(testCoding).count()", "4"},
+		{"%ucum", "http://unitsofmeasure.org"},
+		{"code.first().system.startsWith('http:')", "true"},
+		{"code.first().system.matches('^http://[a-z.]+$')", "true"},
+		{"code.first().system.substring(7, 5)", "loinc"},
+		{"'5'.toInteger() + 2 * 3", "11"},
+		{"7 div 2 + 7 mod 2", "4"},
+		{"1.5.lowBoundary()", "1.45"},
+		{"1.5.highBoundary()", "1.55"},
+		{"effective.lowBoundary() <= effective.highBoundary()", "true"},
+		{"children().count() > id.count()", "true"},
+		{"status.hasValue() and code.hasValue().not()", "true"},
+		{"component.code.code.intersect('b' | 'c')", "b"},
+		{"(true | false).allTrue()", "false"},
+		{"'a' & {} & 'b'", "ab"},
+		{"value.value.toString().contains('.')", "true"},
+		{"text.`div`.exists()", "false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			if got := evalFHIRPath(t, testObservationValue(), tt.expr); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFHIRPath_Temporal(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"@2015-02-04.is(Date)", "true"},
+		{"@2019-03-01 + 25 hours", "2019-03-02"},
+		{"@2019-01-31 + 1 month", "2019-02-28"},
+		{"@2019-03-01T10:00:00.000Z - 90 'min'", "2019-03-01T08:30:00.000Z"},
+		{"@T23:30:00 + 1 hour", "00:30:00"},
+		{"@2014-01.highBoundary()", "2014-01-31"},
+		{"@2014-01-01T10:30:00Z.lowBoundary()", "2014-01-01T10:30:00.000Z"},
+		{"@2014-01-01T10:30:00 = @2014-01-01T10:30:00.000", "true"},
+		{"7 days = 1 week", "true"},
+		{"1 'mo' = 30 days", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			if got := evalFHIRPath(t, testObservationValue(), tt.expr); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommonUnit(t *testing.T) {
+	q := func(value, unit string) fhirpathQuantity {
+		return fhirpathQuantity{value: MustParseDecimal(value), unit: unit}
+	}
+	tests := []struct {
+		a, b fhirpathQuantity
+		ok   bool
+	}{
+		{q("4", "g"), q("4000", "mg"), true},
+		{q("1", "mg/dL"), q("10", "mg/L"), true},
+		{q("1", "mmol/L"), q("1", "umol/mL"), true},
+		{q("1", "h"), q("60", "min"), true},
+		{q("1", "g"), q("1", "m"), false},
+		{q("1", "[lb_av]"), q("453.59237", "g"), false},
+		{q("1", "a"), q("365", "d"), false},
+		{q("1", "g/m"), q("1", "g.m-1"), true},
+		{q("1", "cm2"), q("0.0001", "m2"), true},
+		{q("1", "kg.m/s2"), q("1000", "g.m.s-2"), true},
+		{q("1", "m2"), q("1", "m"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.unit+"/"+tt.b.unit, func(t *testing.T) {
+			a, b, ok := commonUnit(tt.a, tt.b)
+			if ok != tt.ok {
+				t.Fatalf("commonUnit() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && (a.unit != b.unit || !a.value.Equal(b.value)) {
+				t.Errorf("commonUnit() = %s '%s', %s '%s', want equal quantities", a.value, a.unit, b.value, b.unit)
+			}
+		})
+	}
+}
+
+func TestFHIRPath_Errors(t *testing.T) {
+	for _, expr := range []string{"status.", "code[0", "'unterminated", "exists(", "a ! b", "unknownFunction()", "where()"} {
+		if _, err := parseFHIRPath(expr); err == nil {
+			t.Errorf("parseFHIRPath(%q) expected error", expr)
+		}
+	}
+
+	compiled, err := compileFHIRPath("code.system.startsWith('http')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes := objectNode(testObservationValue())
+	if _, err := compiled.eval(&fhirpathEnv{this: nodes}); err == nil {
+		t.Error("startsWith on two items expected error")
+	}
+}
+
+// TestFHIRPath_SpecInvariants parses invariants of the FHIR specification
+// that use less common syntax.
+func TestFHIRPath_SpecInvariants(t *testing.T) {
+	for _, expr := range []string{
+		`hasValue() or (children().count() > id.count())`,
+		`contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()`,
+		`text.` + "`div`" + `.exists()`,
+		`min.empty() or max.empty() or (max = '*') or iif(max != '*', min <= max.toInteger())`,
+		`path.matches('^[^\\s\\.,:;\\\'"\\/|?!@#$%&*()\\[\\]{}]{1,64}(\\.[^\\s\\.,:;\\\'"\\/|?!@#$%&*()\\[\\]{}]{1,64}(\\[x\\])?(\\:[^\\s\\.]+)?)*$')`,
+		`type.where(code in 'code' | 'Coding' | 'CodeableConcept' | 'CodeableReference' | 'uri' | 'string' | 'Quantity').count() > 1 implies binding.empty()`,
+		`offset.empty() or (when.exists() and when.select($this in ('C' | 'CM' | 'CD' | 'CV')).allFalse())`,
+		`low.value.empty() or high.value.empty() or low.lowBoundary().comparable(high.highBoundary()).not() or (low.lowBoundary() <= high.highBoundary())`,
+		`(type = 'history') or entry.where(fullUrl.exists()).select(fullUrl&iif(resource.meta.versionId.exists(), resource.meta.versionId, '')).isDistinct()`,
+		`type = 'document' implies entry.first().resource.is(Composition)`,
+		`who.resolve().ofType(PractitionerRole).practitioner.resolve().exists() and onBehalfOf.resolve().ofType(Practitioner).exists() implies who.resolve().practitioner.resolve() != onBehalfOf.resolve()`,
+		`reference.exists()  implies (reference.startsWith('#').not() or (reference.substring(1).trace('url') in %rootResource.contained.id.trace('ids')) or (reference='#' and %rootResource!=%resource))`,
+		`artifact is markdown or artifact is canonical`,
+	} {
+		expr, err := parseFHIRPath(expr)
+		if err != nil {
+			t.Errorf("parse error: %v", err)
+			continue
+		}
+		nodes := objectNode(testObservationValue())
+		if _, err := expr.eval(&fhirpathEnv{this: nodes, resource: nodes, rootResource: nodes}); err != nil {
+			t.Errorf("eval error: %v", err)
+		}
+	}
+}
+
+func TestCheckNarrative(t *testing.T) {
+	tests := map[string]bool{
+		`<div xmlns="http://www.w3.org/1999/xhtml"><p>ok</p></div>`:              true,
+		`<div xmlns="http://www.w3.org/1999/xhtml"><script>x()</script></div>`:   false,
+		`<div xmlns="http://www.w3.org/1999/xhtml"><p onclick="x()">a</p></div>`: false,
+		`<p>not a div</p>`:       false,
+		`<div><p>unclosed</div>`: false,
+	}
+	for div, want := range tests {
+		if got := checkNarrative(div) == nil; got != want {
+			t.Errorf("checkNarrative(%s) ok = %v, want %v", div, got, want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package models
+
+import "reflect"
+
+// matchesFixedValue reports whether v, the value of an element, equals the
+// JSON of the element's fixed[x] exactly.
+func matchesFixedValue(v any, fixed string) bool {
+	value, err := profileJSON(reflect.ValueOf(v))
+	return err == nil && reflect.DeepEqual(value, mustProfileJSON(fixed))
+}
+
+// matchesPatternValue reports whether v, the value of an element, contains
+// the JSON of the element's pattern[x], as matchesPattern compares them.
+func matchesPatternValue(v any, pattern string) bool {
+	value, err := profileJSON(reflect.ValueOf(v))
+	return err == nil && matchesPattern(mustProfileJSON(pattern), value)
+}
@@ -0,0 +1,42 @@
+package models
+
+import "testing"
+
+func TestMatchesFixedValue(t *testing.T) {
+	coding := &testCoding{System: ptrTo("http://loinc.org"), Code: ptrTo("8867-4")}
+	tests := []struct {
+		fixed string
+		want  bool
+	}{
+		{`{"system":"http://loinc.org","code":"8867-4"}`, true},
+		{`{"system":"http://loinc.org"}`, false},
+		{`{"system":"http://loinc.org","code":"8867-4","display":"Heart rate"}`, false},
+	}
+	for _, tt := range tests {
+		if got := matchesFixedValue(coding, tt.fixed); got != tt.want {
+			t.Errorf("matchesFixedValue(%s) = %v, want %v", tt.fixed, got, tt.want)
+		}
+	}
+	if !matchesFixedValue(ptrTo("final"), `"final"`) || matchesFixedValue(ptrTo("draft"), `"final"`) {
+		t.Error("matchesFixedValue() does not compare primitive values")
+	}
+}
+
+func TestMatchesPatternValue(t *testing.T) {
+	obs := testObservationValue()
+	tests := []struct {
+		pattern string
+		want    bool
+	}{
+		{`{"code":[{"system":"http://loinc.org"}]}`, true},
+		{`{"code":[{"system":"http://loinc.org","code":"8867-4"},{"code":"364075005"}]}`, true},
+		{`{"code":[{"system":"http://unitsofmeasure.org"}]}`, false},
+		{`{"status":"final"}`, true},
+		{`{"status":"amended"}`, false},
+	}
+	for _, tt := range tests {
+		if got := matchesPatternValue(obs, tt.pattern); got != tt.want {
+			t.Errorf("matchesPatternValue(%s) = %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
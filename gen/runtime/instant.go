@@ -0,0 +1,111 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var instantPattern = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)-(0[1-9]|1[0-2])-(0[1-9]|[1-2][0-9]|3[0-1])T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00))$`)
+
+// Instant is a FHIR instant: a point in time known at least to the second and
+// always with a timezone offset, e.g. "2015-02-07T13:28:17.239+02:00". The
+// original text is kept, so precision and offset survive a round trip.
+type Instant struct {
+	literal string
+}
+
+// ParseInstant parses and validates a FHIR instant literal.
+func ParseInstant(s string) (Instant, error) {
+	i := Instant{literal: s}
+	if _, err := i.parse(); err != nil {
+		return Instant{}, err
+	}
+	return i, nil
+}
+
+// NewInstant returns t as an instant in t's location, keeping sub-second
+// digits when t has any.
+func NewInstant(t time.Time) Instant {
+	return Instant{literal: t.Format("2006-01-02T15:04:05") + formatFraction(t) + t.Format("Z07:00")}
+}
+
+func (i Instant) String() string {
+	return i.literal
+}
+
+// IsZero reports whether i holds no value.
+func (i Instant) IsZero() bool {
+	return i.literal == ""
+}
+
+// Validate checks i against the regex of the instant type and rejects dates
+// that do not exist.
+func (i Instant) Validate() error {
+	if i.IsZero() {
+		return nil
+	}
+	_, err := i.parse()
+	return err
+}
+
+// Precision returns PrecisionSecond or PrecisionSubsecond.
+func (i Instant) Precision() TemporalPrecision {
+	t, err := i.parse()
+	if err != nil {
+		return 0
+	}
+	return t.precision
+}
+
+// Time returns i as a time.Time in the offset it was written with.
+func (i Instant) Time() (time.Time, error) {
+	t, err := i.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.start(), nil
+}
+
+// Range returns the half-open interval [start, end) covered by i at its
+// precision.
+func (i Instant) Range() (time.Time, time.Time, error) {
+	t, err := i.parse()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return t.start(), t.end(), nil
+}
+
+// Compare compares i and other with FHIR semantics. The result is -1, 0 or
+// +1; ok is false when the values have different precisions and overlap.
+func (i Instant) Compare(other Instant) (result int, ok bool) {
+	a, err := i.parse()
+	if err != nil {
+		return 0, false
+	}
+	b, err := other.parse()
+	if err != nil {
+		return 0, false
+	}
+	return compareRanges(a.start(), a.end(), b.start(), b.end())
+}
+
+func (i Instant) MarshalJSON() ([]byte, error) {
+	return marshalTemporal(i.literal)
+}
+
+func (i *Instant) UnmarshalJSON(data []byte) error {
+	return unmarshalTemporal(data, &i.literal)
+}
+
+func (i Instant) parse() (temporal, error) {
+	if !instantPattern.MatchString(i.literal) {
+		return temporal{}, fmt.Errorf("invalid instant %q", i.literal)
+	}
+	t, err := parseTemporal(i.literal)
+	if err != nil {
+		return temporal{}, fmt.Errorf("invalid instant %q: %w", i.literal, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testInvariantRange struct {
+	Low  *TestQuantity `json:"low,omitempty"`
+	High *TestQuantity `json:"high,omitempty"`
+}
+
+func (r *testInvariantRange) invariants() []invariant {
+	return []invariant{
+		{key: "rng-2", severity: "error", human: "If present, low SHALL have a lower value than high", expression: "low.empty() or high.empty() or (low.value <= high.value)"},
+		{key: "rng-x", severity: "warning", human: "low should have a unit", expression: "unit.exists()", context: "low"},
+	}
+}
+
+type testInvariantResource struct {
+	ResourceType string               `json:"resourceType"`
+	Id           *string              `json:"id,omitempty"`
+	Contained    []any                `json:"contained,omitempty"`
+	Range        []testInvariantRange `json:"range,omitempty"`
+	Value        testChoice           `json:"-"`
+	Subject      *string              `json:"subject,omitempty"`
+}
+
+func (r *testInvariantResource) invariants() []invariant {
+	return []invariant{
+		{key: "tst-1", severity: "error", human: "Subject SHALL reference a contained resource", expression: "subject.empty() or subject.resolve().exists()"},
+		{key: "tst-2", severity: "error", human: "unsupported", expression: "value.unknownFunction()"},
+	}
+}
+
+func TestCheckInvariants(t *testing.T) {
+	r := &testInvariantResource{
+		ResourceType: "Test",
+		Contained:    []any{&testInvariantResource{ResourceType: "Test", Id: ptrTo("c1"), Subject: ptrTo("#missing")}},
+		Range: []testInvariantRange{
+			{Low: &TestQuantity{Value: ptrTo(MustParseDecimal("1")), Unit: ptrTo("mg")}, High: &TestQuantity{Value: ptrTo(MustParseDecimal("2"))}},
+			{Low: &TestQuantity{Value: ptrTo(MustParseDecimal("3"))}, High: &TestQuantity{Value: ptrTo(MustParseDecimal("2"))}},
+		},
+		Value:   testChoiceString("x"),
+		Subject: ptrTo("#c1"),
+	}
+
+	var issues ValidationIssues
+	checkInvariants(r, "Test", &issues)
+	want := ValidationIssues{
+		{Severity: "warning", Code: "not-supported", Path: "Test", Message: `tst-2 could not be checked: fhirpath "value.unknownFunction()": unsupported function unknownFunction()`},
+		{Severity: "error", Code: "invariant", Path: "Test.range[1]", Message: "rng-2: If present, low SHALL have a lower value than high"},
+		{Severity: "warning", Code: "invariant", Path: "Test.range[1].low", Message: "rng-x: low should have a unit"},
+	}
+	if !reflect.DeepEqual(issues, want) {
+		t.Errorf("checkInvariants() = %v, want %v", issues, want)
+	}
+
+	err := validateInvariants(r, "Test")
+	if err == nil || err.Error() != "constraint rng-2 failed at Test.range[1]: If present, low SHALL have a lower value than high" {
+		t.Errorf("validateInvariants() = %v", err)
+	}
+
+	r.Range = r.Range[:1]
+	r.Subject = ptrTo("#other")
+	if err := validateInvariants(r, "Test"); err == nil || err.Error() != "constraint tst-1 failed at Test: Subject SHALL reference a contained resource" {
+		t.Errorf("validateInvariants() = %v", err)
+	}
+}
+
+func TestCheckInvariants_WarningsOnly(t *testing.T) {
+	r := &testInvariantRange{Low: &TestQuantity{Value: ptrTo(MustParseDecimal("1"))}}
+	var issues ValidationIssues
+	checkInvariants(r, "Range", &issues)
+	if len(issues) != 1 || issues[0].Severity != "warning" || issues[0].Path != "Range.low" {
+		t.Errorf("checkInvariants() = %v, want one warning at Range.low", issues)
+	}
+	if issues.HasErrors() {
+		t.Error("warnings must not count as errors")
+	}
+	if err := validateInvariants(r, "Range"); err != nil {
+		t.Errorf("validateInvariants() = %v, want nil for warnings", err)
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import "fmt"
+
+// typedResource is implemented by every generated resource.
+type typedResource interface {
+	GetResourceType() string
+}
+
+// parameterValue returns the value of the parameter name as the variant V.
+func parameterValue[V choiceValue](name string, value choiceValue) (V, error) {
+	v, ok := value.(V)
+	if ok {
+		return v, nil
+	}
+	if value == nil {
+		return v, fmt.Errorf("parameter '%s' must have a value of type %s", name, v.FHIRType())
+	}
+	return v, fmt.Errorf("parameter '%s' must have a value of type %s, got %s", name, v.FHIRType(), value.FHIRType())
+}
+
+// parameterResource returns the resource of the parameter name as R, the Go
+// type of the resource type named resourceType.
+func parameterResource[R typedResource](name string, res typedResource, resourceType string) (R, error) {
+	r, ok := res.(R)
+	if ok {
+		return r, nil
+	}
+	if res == nil {
+		return r, fmt.Errorf("parameter '%s' must have a resource of type %s", name, resourceType)
+	}
+	return r, fmt.Errorf("parameter '%s' must have a resource of type %s, got %s", name, resourceType, res.GetResourceType())
+}
@@ -0,0 +1,44 @@
+package models
+
+import "testing"
+
+type testParameterResource struct{ resourceType string }
+
+func (r *testParameterResource) GetResourceType() string { return r.resourceType }
+
+func TestParameterValue(t *testing.T) {
+	v, err := parameterValue[testChoiceString]("name", testChoiceString("LOINC"))
+	if err != nil || v != "LOINC" {
+		t.Errorf("parameterValue() = %q, %v, want LOINC", v, err)
+	}
+
+	tests := []struct {
+		value   choiceValue
+		wantErr string
+	}{
+		{testChoiceDateTime{}, "parameter 'name' must have a value of type string, got dateTime"},
+		{nil, "parameter 'name' must have a value of type string"},
+	}
+	for _, tt := range tests {
+		if _, err := parameterValue[testChoiceString]("name", tt.value); err == nil || err.Error() != tt.wantErr {
+			t.Errorf("parameterValue(%v) error = %v, want %s", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParameterResource(t *testing.T) {
+	patient := &testParameterResource{resourceType: "Patient"}
+	r, err := parameterResource[*testParameterResource]("subject", patient, "Patient")
+	if err != nil || r != patient {
+		t.Errorf("parameterResource() = %v, %v, want the patient", r, err)
+	}
+
+	type otherResource struct{ *testParameterResource }
+	wrong := otherResource{&testParameterResource{resourceType: "Group"}}
+	if _, err := parameterResource[*testParameterResource]("subject", wrong, "Patient"); err == nil || err.Error() != "parameter 'subject' must have a resource of type Patient, got Group" {
+		t.Errorf("parameterResource() error = %v, want the wrong resource type", err)
+	}
+	if _, err := parameterResource[*testParameterResource]("subject", nil, "Patient"); err == nil || err.Error() != "parameter 'subject' must have a resource of type Patient" {
+		t.Errorf("parameterResource() error = %v, want a missing resource", err)
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import "fmt"
+
+// alignPrimitiveArray prepares a repeating primitive and its "_name" companion
+// for JSON output. Both arrays are padded to the same length so that the
+// extensions line up with their values. An item whose element is set and
+// whose value is the zero value of its type only carries extensions and is
+// written as null; this is how such items decode, so a zero integer or false
+// with an id or extensions is written as null as well. The companion is
+// dropped when no item has an id or extensions.
+func alignPrimitiveArray[T comparable, E any](values []T, elements []*E) ([]*T, []*E) {
+	n := len(values)
+	if len(elements) > n {
+		n = len(elements)
+	}
+
+	hasElements := false
+	for _, e := range elements {
+		if e != nil {
+			hasElements = true
+			break
+		}
+	}
+
+	outValues := make([]*T, n)
+	var zero T
+	for i := range values {
+		v := values[i]
+		if v == zero && i < len(elements) && elements[i] != nil {
+			continue
+		}
+		outValues[i] = &v
+	}
+	if len(values) == 0 && !hasElements {
+		outValues = nil
+	}
+
+	if !hasElements {
+		return outValues, nil
+	}
+	outElements := make([]*E, n)
+	copy(outElements, elements)
+	return outValues, outElements
+}
+
+// splitPrimitiveArray returns the values of a decoded repeating primitive,
+// with null items, which only carry extensions in the companion, as zero
+// values. It fails when both arrays are present but differ in length, since
+// the extensions could not be matched to their values.
+func splitPrimitiveArray[T, E any](items []*T, elements []*E) ([]T, error) {
+	if items != nil && elements != nil && len(items) != len(elements) {
+		return nil, fmt.Errorf("%d values but %d items in the \"_\" companion", len(items), len(elements))
+	}
+	if items == nil {
+		return nil, nil
+	}
+	values := make([]T, len(items))
+	for i, item := range items {
+		if item != nil {
+			values[i] = *item
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+type element struct{ ID string }
+
+func TestAlignPrimitiveArray(t *testing.T) {
+	ext := &element{ID: "x"}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name         string
+		values       []string
+		elements     []*element
+		wantValues   []*string
+		wantElements []*element
+	}{
+		{
+			name:       "no companions",
+			values:     []string{"Amy", "Lee"},
+			wantValues: []*string{str("Amy"), str("Lee")},
+		},
+		{
+			name:         "value without extension is padded",
+			values:       []string{"Amy", ""},
+			elements:     []*element{nil, ext},
+			wantValues:   []*string{str("Amy"), nil},
+			wantElements: []*element{nil, ext},
+		},
+		{
+			name:         "companion shorter than values",
+			values:       []string{"Amy", "Lee"},
+			elements:     []*element{ext},
+			wantValues:   []*string{str("Amy"), str("Lee")},
+			wantElements: []*element{ext, nil},
+		},
+		{
+			name:         "extension-only values",
+			elements:     []*element{ext},
+			wantValues:   []*string{nil},
+			wantElements: []*element{ext},
+		},
+		{
+			name:       "all-nil companions are dropped",
+			values:     []string{"Amy"},
+			elements:   []*element{nil},
+			wantValues: []*string{str("Amy")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValues, gotElements := alignPrimitiveArray(tt.values, tt.elements)
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("values = %v, want %v", gotValues, tt.wantValues)
+			}
+			if !reflect.DeepEqual(gotElements, tt.wantElements) {
+				t.Errorf("elements = %v, want %v", gotElements, tt.wantElements)
+			}
+		})
+	}
+}
+
+func TestAlignPrimitiveArray_NullItems(t *testing.T) {
+	ext := &element{ID: "x"}
+	elements := []*element{nil, ext, nil}
+
+	ints, err := splitPrimitiveArray([]*int{ptrTo(1), nil, ptrTo(0)}, elements)
+	if err != nil || !reflect.DeepEqual(ints, []int{1, 0, 0}) {
+		t.Fatalf("splitPrimitiveArray() = %v, %v", ints, err)
+	}
+	gotInts, _ := alignPrimitiveArray(ints, elements)
+	if !reflect.DeepEqual(gotInts, []*int{ptrTo(1), nil, ptrTo(0)}) {
+		t.Errorf("int values = %v, want [1 null 0]", gotInts)
+	}
+
+	bools, err := splitPrimitiveArray([]*bool{nil, ptrTo(false)}, []*element{ext, nil})
+	if err != nil {
+		t.Fatalf("splitPrimitiveArray() error = %v", err)
+	}
+	gotBools, _ := alignPrimitiveArray(bools, []*element{ext, nil})
+	if !reflect.DeepEqual(gotBools, []*bool{nil, ptrTo(false)}) {
+		t.Errorf("bool values = %v, want [null false]", gotBools)
+	}
+
+	ints[1] = 7
+	if gotInts, _ := alignPrimitiveArray(ints, elements); gotInts[1] == nil || *gotInts[1] != 7 {
+		t.Errorf("a value set after decoding should not be written as null")
+	}
+
+	ints = append(ints, 0)
+	elements = append(elements, ext)
+	if gotInts, _ := alignPrimitiveArray(ints, elements); gotInts[3] != nil {
+		t.Errorf("an appended item with only extensions should be written as null, got %v", *gotInts[3])
+	}
+}
+
+func TestSplitPrimitiveArray_LengthMismatch(t *testing.T) {
+	ext := &element{ID: "x"}
+	if _, err := splitPrimitiveArray([]*string{ptrTo("a"), ptrTo("b")}, []*element{ext}); err == nil {
+		t.Error("splitPrimitiveArray() with a shorter companion should fail")
+	}
+	if values, err := splitPrimitiveArray([]*string{ptrTo("a")}, []*element(nil)); err != nil || len(values) != 1 {
+		t.Errorf("splitPrimitiveArray() without companion = %v, %v", values, err)
+	}
+	if values, err := splitPrimitiveArray([]*string(nil), []*element{ext}); err != nil || values != nil {
+		t.Errorf("splitPrimitiveArray() with only a companion = %v, %v", values, err)
+	}
+}
@@ -0,0 +1,323 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testProfile = &ProfileDefinition{
+	URL:  "http://example.org/StructureDefinition/heart-rate",
+	Name: "HeartRate",
+	Type: "Observation",
+	Elements: []ProfileElement{
+		{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+			{Key: "hr-1", Severity: "error", Human: "A subject or value is required", Expression: "subject.exists() or value.exists()"},
+		}},
+		{Path: "Observation.status", Min: 1, Max: 1, Fixed: `"final"`},
+		{Path: "Observation.code", Min: 1, Max: -1, Pattern: `{"system":"http://loinc.org"}`},
+		{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+		{Path: "Observation.component", Min: 0, Max: 1},
+		{Path: "Observation.component.code", Min: 1, Max: 1},
+		{Path: "Observation.subject", Min: 1, Max: 1},
+	},
+}
+
+func TestProfileDefinition_Validate(t *testing.T) {
+	if issues := testProfile.validate(testObservationValue()); len(issues) != 2 {
+		t.Errorf("validate() = %v, want the component count and code issues", issues)
+	}
+
+	obs := testObservationValue()
+	obs.Code = obs.Code[:1]
+	obs.Component = obs.Component[:1]
+	if issues := testProfile.validate(obs); len(issues) != 0 {
+		t.Errorf("validate() = %v, want no issues", issues)
+	}
+
+	obs = &testObservation{
+		ResourceType: "Observation",
+		Status:       "preliminary",
+		Code:         []testCoding{{System: ptrTo("http://snomed.info/sct")}},
+		Component:    []testComponent{{}},
+	}
+	want := []string{
+		"Observation: profile HeartRate: hr-1: A subject or value is required",
+		"Observation.status: profile HeartRate: value must be exactly \"final\"",
+		"Observation.code[0]: profile HeartRate: value does not match the pattern {\"system\":\"http://loinc.org\"}",
+		"Observation.component[0].code: profile HeartRate: minimum required = 1, but only found 0",
+		"Observation.subject: profile HeartRate: minimum required = 1, but only found 0",
+	}
+	var got []string
+	for _, issue := range testProfile.validate(obs) {
+		got = append(got, issue.String())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestProfileDefinition_ValidateType(t *testing.T) {
+	obs := testObservationValue()
+	obs.Value = testChoiceString("fast")
+	issues := testProfile.validate(obs)
+	if len(issues) != 3 || issues[1].String() != "Observation.value.ofType(string): profile HeartRate: type string is not allowed, expected Quantity" {
+		t.Errorf("validate() = %v, want a value type issue", issues)
+	}
+
+	issues = testProfile.validate(&testPatient{ResourceType: "Patient"})
+	if len(issues) != 1 || issues[0].Code != "structure" {
+		t.Errorf("validate() = %v, want a resource type issue", issues)
+	}
+}
+
+func TestProfileDefinition_DecimalPattern(t *testing.T) {
+	profiles := []ProfileDefinition{
+		{Name: "Exact", Type: "Observation", Elements: []ProfileElement{{Path: "Observation.value[x]", Max: 1, Pattern: `{"value":72.5}`}}},
+		{Name: "Precise", Type: "Observation", Elements: []ProfileElement{{Path: "Observation.value[x]", Max: 1, Pattern: `{"value":72.50}`}}},
+		{Name: "Sliced", Type: "Observation", Elements: []ProfileElement{{Path: "Observation.component", Slicing: &ProfileSlicing{
+			Slices: []ProfileSlice{{Name: "a", Elements: []ProfileElement{{Path: "Observation.component.code", Fixed: `[{"code":"a"}]`}}}},
+		}}}},
+	}
+	decodeProfileValues(profiles)
+	if profiles[0].Elements[0].pattern == nil || profiles[2].Elements[0].Slicing.Slices[0].Elements[0].fixed == nil {
+		t.Fatal("decodeProfileValues() left values undecoded")
+	}
+
+	// the observation's value is 72.5, which does not have the precision of 72.50
+	if issues := profiles[0].validate(testObservationValue()); len(issues) != 0 {
+		t.Errorf("validate() = %v, want no issues", issues)
+	}
+	if issues := profiles[1].validate(testObservationValue()); len(issues) != 1 || issues[0].Code != "value" {
+		t.Errorf("validate() = %v, want a pattern issue", issues)
+	}
+}
+
+func TestMatchesPattern(t *testing.T) {
+	pattern := mustProfileJSON(`{"coding":[{"system":"http://loinc.org","code":"85354-9"}]}`)
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{`{"coding":[{"system":"http://loinc.org","code":"85354-9","display":"BP"}],"text":"BP"}`, true},
+		{`{"coding":[{"system":"http://snomed.info/sct","code":"1"},{"system":"http://loinc.org","code":"85354-9"}]}`, true},
+		{`{"coding":[{"system":"http://loinc.org","code":"8480-6"}]}`, false},
+		{`{"coding":[{"system":"http://loinc.org"},{"code":"85354-9"}]}`, false},
+		{`{"text":"BP"}`, false},
+	}
+	for _, tt := range tests {
+		if got := matchesPattern(pattern, mustProfileJSON(tt.value)); got != tt.want {
+			t.Errorf("matchesPattern(%s) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestProfileDefinition_Template(t *testing.T) {
+	got := testProfile.template()
+	want := map[string]any{
+		"resourceType": "Observation",
+		"meta":         map[string]any{"profile": []any{"http://example.org/StructureDefinition/heart-rate"}},
+		"status":       "final",
+		"code":         []any{map[string]any{"system": "http://loinc.org"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("template() = %v, want %v", got, want)
+	}
+}
+
+var testSlicedProfile = &ProfileDefinition{
+	URL:  "http://example.org/StructureDefinition/panel",
+	Name: "Panel",
+	Type: "Observation",
+	Elements: []ProfileElement{
+		{Path: "Observation.component", Min: 0, Max: -1, Slicing: &ProfileSlicing{
+			Discriminators: []ProfileDiscriminator{{Type: "value", Path: "code"}},
+			Ordered:        true,
+			Rules:          "closed",
+			Slices: []ProfileSlice{
+				{Name: "Systolic", Min: 1, Max: 1, Elements: []ProfileElement{
+					{Path: "Observation.component.code", Min: 1, Max: -1, Pattern: `{"code":"8480-6"}`},
+					{Path: "Observation.component.value[x]", Min: 1, Max: 1, Types: []string{"string"}},
+				}},
+				{Name: "Diastolic", Min: 1, Max: 1, Elements: []ProfileElement{
+					{Path: "Observation.component.code", Min: 1, Max: -1, Pattern: `{"code":"8462-4"}`},
+				}},
+			},
+		}},
+	},
+}
+
+func testComponentCode(code string, value testChoice) testComponent {
+	return testComponent{Code: []testCoding{{System: ptrTo("http://loinc.org"), Code: ptrTo(code)}}, Value: value}
+}
+
+func TestProfileDefinition_ValidateSlicing(t *testing.T) {
+	systolic := testComponentCode("8480-6", testChoiceString("120"))
+	diastolic := testComponentCode("8462-4", testChoiceString("80"))
+	tests := []struct {
+		name      string
+		component []testComponent
+		want      []string
+	}{
+		{name: "conforms", component: []testComponent{systolic, diastolic}},
+		{
+			name:      "missing slice",
+			component: []testComponent{systolic},
+			want:      []string{"Observation.component: profile Panel: slice Diastolic: minimum required = 1, but only found 0"},
+		},
+		{
+			name:      "too many",
+			component: []testComponent{systolic, systolic, diastolic},
+			want:      []string{"Observation.component: profile Panel: slice Systolic: maximum allowed = 1, but found 2"},
+		},
+		{
+			name:      "closed",
+			component: []testComponent{systolic, diastolic, testComponentCode("8478-0", nil)},
+			want:      []string{"Observation.component[2]: profile Panel: item does not match any slice of Observation.component"},
+		},
+		{
+			name:      "out of order",
+			component: []testComponent{diastolic, systolic},
+			want:      []string{"Observation.component[1]: profile Panel: slice Systolic: item is out of order"},
+		},
+		{
+			name:      "slice rules",
+			component: []testComponent{testComponentCode("8480-6", nil), diastolic},
+			want:      []string{"Observation.component[0].value: profile Panel: slice Systolic: minimum required = 1, but only found 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obs := &testObservation{ResourceType: "Observation", Component: tt.component}
+			var got []string
+			for _, issue := range testSlicedProfile.validate(obs) {
+				got = append(got, issue.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestProfileSlicing_SliceOf(t *testing.T) {
+	obs := testObservationValue()
+	value := childLocations(reflect.ValueOf(obs).Elem(), "value", "Observation")[0]
+	component := childLocations(reflect.ValueOf(obs).Elem(), "component", "Observation")[0]
+
+	previous := lookupProfile
+	lookupProfile = func(url string) (*ProfileDefinition, bool) {
+		return testProfile, url == testProfile.URL
+	}
+	t.Cleanup(func() { lookupProfile = previous })
+
+	root := locatedValue{value: reflect.ValueOf(obs).Elem(), path: "Observation"}
+	tests := []struct {
+		name       string
+		slicedPath string
+		slicing    ProfileSlicing
+		item       locatedValue
+		want       int
+	}{
+		{
+			name:       "type",
+			slicedPath: "Observation.value[x]",
+			slicing: ProfileSlicing{Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}}, Slices: []ProfileSlice{
+				{Name: "valueString", Max: 1, Elements: []ProfileElement{{Path: "Observation.value[x]", Types: []string{"string"}}}},
+				{Name: "valueQuantity", Max: 1, Elements: []ProfileElement{{Path: "Observation.value[x]", Types: []string{"Quantity"}}}},
+			}},
+			item: value,
+			want: 1,
+		},
+		{
+			name:       "exists",
+			slicedPath: "Observation",
+			slicing: ProfileSlicing{Discriminators: []ProfileDiscriminator{{Type: "exists", Path: "value"}}, Slices: []ProfileSlice{
+				{Name: "noValue", Max: -1, Elements: []ProfileElement{{Path: "Observation.value[x]", Max: 0}}},
+				{Name: "withValue", Max: -1, Elements: []ProfileElement{{Path: "Observation.value[x]", Min: 1, Max: 1}}},
+			}},
+			item: root,
+			want: 1,
+		},
+		{
+			name:       "pattern",
+			slicedPath: "Observation.component",
+			slicing: ProfileSlicing{Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "code"}}, Slices: []ProfileSlice{
+				{Name: "other", Max: -1, Elements: []ProfileElement{{Path: "Observation.component.code", Pattern: `{"code":"8462-4"}`}}},
+			}},
+			item: component,
+			want: -1,
+		},
+		{
+			name:       "profile",
+			slicedPath: "Observation",
+			slicing: ProfileSlicing{Discriminators: []ProfileDiscriminator{{Type: "profile", Path: "$this"}}, Slices: []ProfileSlice{
+				{Name: "heartRate", Max: -1, Elements: []ProfileElement{{Path: "Observation", Profiles: []string{testProfile.URL}}}},
+				{Name: "any", Max: -1},
+			}},
+			item: root,
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.slicing.sliceOf(tt.slicedPath, tt.item, make([]int, len(tt.slicing.Slices))); got != tt.want {
+				t.Errorf("sliceOf() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileDefinition_ValidateOpenAtEnd(t *testing.T) {
+	profile := *testSlicedProfile
+	slicing := *profile.Elements[0].Slicing
+	slicing.Rules = "openAtEnd"
+	profile.Elements = []ProfileElement{{Path: "Observation.component", Min: 0, Max: -1, Slicing: &slicing}}
+
+	obs := &testObservation{ResourceType: "Observation", Component: []testComponent{
+		testComponentCode("8480-6", testChoiceString("120")),
+		testComponentCode("8478-0", nil),
+		testComponentCode("8462-4", nil),
+	}}
+	issues := profile.validate(obs)
+	if len(issues) != 1 || issues[0].String() != "Observation.component[2]: profile Panel: slice Diastolic: item comes after items that match no slice" {
+		t.Errorf("validate() = %v, want an openAtEnd issue", issues)
+	}
+}
+
+func TestDiscriminatorValues(t *testing.T) {
+	obs := testObservationValue()
+	item := locatedValue{value: reflect.ValueOf(obs).Elem(), path: "Observation"}
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "$this", want: []string{"Observation"}},
+		{path: "code.system", want: []string{"Observation.code[0].system", "Observation.code[1].system"}},
+		{path: "value.ofType(Quantity)", want: []string{"Observation.value.ofType(Quantity)"}},
+		{path: "value.ofType(string)", want: nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, v := range discriminatorValues(item, tt.path) {
+			got = append(got, v.path)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("discriminatorValues(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+	if got := splitDiscriminatorPath("extension('http://example.org/a.b').value"); !reflect.DeepEqual(got, []string{"extension('http://example.org/a.b')", "value"}) {
+		t.Errorf("splitDiscriminatorPath() = %v", got)
+	}
+}
+
+func TestProfileDefinition_TemplateSlices(t *testing.T) {
+	got := testSlicedProfile.template()["component"]
+	want := []any{
+		map[string]any{"code": []any{map[string]any{"code": "8480-6"}}},
+		map[string]any{"code": []any{map[string]any{"code": "8462-4"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("template() component = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import "strings"
+
+// invalidReferenceTarget returns the resource type a Reference refers to when
+// it is not one of targets, the types its element allows, and "" otherwise.
+// The type is read from the literal reference, relative ("Patient/1"),
+// absolute ("http://example.org/fhir/Patient/1"), versioned or conditional,
+// and from Reference.Type, a type name or a StructureDefinition URL. Internal
+// ("#id") and urn:uuid: or urn:oid: references carry no type.
+func invalidReferenceTarget(reference, refType *string, targets []string) string {
+	if reference != nil {
+		if t := referenceType(*reference); t != "" && !containsTarget(targets, t) {
+			return t
+		}
+	}
+	if refType != nil && *refType != "" {
+		t := *refType
+		if i := strings.LastIndexByte(t, '/'); i >= 0 {
+			t = t[i+1:]
+		}
+		if !containsTarget(targets, t) {
+			return t
+		}
+	}
+	return ""
+}
+
+// referenceType returns the resource type of a literal reference, or "" when
+// the reference does not name one.
+func referenceType(reference string) string {
+	if strings.HasPrefix(reference, "#") || strings.HasPrefix(reference, "urn:") {
+		return ""
+	}
+	if i := strings.IndexByte(reference, '#'); i >= 0 {
+		reference = reference[:i]
+	}
+	conditional := false
+	if i := strings.IndexByte(reference, '?'); i >= 0 {
+		reference, conditional = reference[:i], true
+	}
+	segments := strings.Split(reference, "/")
+	if n := len(segments); n >= 4 && segments[n-2] == "_history" {
+		segments = segments[:n-2]
+	}
+	var t string
+	switch {
+	case conditional && len(segments) >= 1:
+		t = segments[len(segments)-1]
+	case len(segments) >= 2:
+		t = segments[len(segments)-2]
+	}
+	if !isResourceTypeName(t) {
+		return ""
+	}
+	return t
+}
+
+func isResourceTypeName(s string) bool {
+	if s == "" || s[0] < 'A' || s[0] > 'Z' {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func containsTarget(targets []string, t string) bool {
+	for _, target := range targets {
+		if target == t {
+			return true
+		}
+	}
+	return false
+}
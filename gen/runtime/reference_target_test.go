@@ -0,0 +1,42 @@
+package models
+
+import "testing"
+
+func TestInvalidReferenceTarget(t *testing.T) {
+	targets := []string{"Patient", "Group"}
+	tests := []struct {
+		reference string
+		refType   string
+		want      string
+	}{
+		{"Patient/1", "", ""},
+		{"Medication/1", "", "Medication"},
+		{"Patient/1/_history/2", "", ""},
+		{"Medication/1/_history/2", "", "Medication"},
+		{"http://example.org/fhir/Group/g1", "", ""},
+		{"http://example.org/fhir/Device/d1/_history/1", "", "Device"},
+		{"Patient?identifier=http://example.org|123", "", ""},
+		{"Location?name=ward", "", "Location"},
+		{"urn:uuid:9d4a5b4e-3f0c-4a53-9c2b-4b2e0c1a7f10", "", ""},
+		{"urn:uuid:9d4a5b4e-3f0c-4a53-9c2b-4b2e0c1a7f10", "Medication", "Medication"},
+		{"urn:oid:1.2.3", "Patient", ""},
+		{"#p1", "", ""},
+		{"", "Patient", ""},
+		{"", "http://hl7.org/fhir/StructureDefinition/Group", ""},
+		{"", "http://hl7.org/fhir/StructureDefinition/Device", "Device"},
+		{"Patient/1", "Device", "Device"},
+		{"http://example.org/some/page", "", ""},
+	}
+	for _, tt := range tests {
+		var reference, refType *string
+		if tt.reference != "" {
+			reference = ptrTo(tt.reference)
+		}
+		if tt.refType != "" {
+			refType = ptrTo(tt.refType)
+		}
+		if got := invalidReferenceTarget(reference, refType, targets); got != tt.want {
+			t.Errorf("invalidReferenceTarget(%q, %q) = %q, want %q", tt.reference, tt.refType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+// SearchParameterDefinition is a search parameter defined by the
+// specification: the name it is searched by, the resources it applies to
+// and the FHIRPath expression selecting the values a resource is indexed by.
+type SearchParameterDefinition struct {
+	URL  string
+	Code string
+	// Base lists the resource types the parameter is defined on, which may
+	// be Resource or DomainResource.
+	Base []string
+	// Type is one of number, date, string, token, reference, composite,
+	// quantity, uri, special and resource.
+	Type       string
+	Expression string
+	// Target lists the resource types a reference parameter may refer to.
+	Target     []string
+	Modifier   []string
+	Comparator []string
+	// MultipleOr is false for parameters that accept a single value only.
+	MultipleOr bool
+	// Components are the parts of a composite parameter, each a parameter
+	// evaluated on the items the composite's expression selects.
+	Components []SearchParameterDefinitionComponent
+}
+
+// SearchParameterDefinitionComponent is a part of a composite search parameter.
+type SearchParameterDefinitionComponent struct {
+	// Definition is the URL of the search parameter the part is searched
+	// as.
+	Definition string
+	Expression string
+}
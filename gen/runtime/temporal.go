@@ -0,0 +1,222 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TemporalPrecision is the precision a FHIR date, dateTime, instant or time
+// value was written with. FHIR treats the precision as significant: the date
+// "2024-03" denotes the whole month of March 2024. Hours and minutes only
+// occur in FHIRPath literals such as @T14:30, as FHIR values need seconds
+// once they have a time.
+type TemporalPrecision int
+
+const (
+	PrecisionYear TemporalPrecision = iota + 1
+	PrecisionMonth
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+	PrecisionSubsecond
+)
+
+func (p TemporalPrecision) String() string {
+	switch p {
+	case PrecisionYear:
+		return "year"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionDay:
+		return "day"
+	case PrecisionHour:
+		return "hour"
+	case PrecisionMinute:
+		return "minute"
+	case PrecisionSecond:
+		return "second"
+	case PrecisionSubsecond:
+		return "subsecond"
+	}
+	return "unknown"
+}
+
+// temporal is the parsed form of a date, dateTime, instant or time literal.
+type temporal struct {
+	year, month, day     int
+	hour, minute, second int
+	nanosecond           int
+	fractionDigits       int
+	precision            TemporalPrecision
+	location             *time.Location
+}
+
+// parseTemporal parses the date and time parts of a literal that has already
+// been checked against the type's regex or the looser one of FHIRPath
+// literals.
+func parseTemporal(s string) (temporal, error) {
+	var t temporal
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+
+	fields := strings.Split(datePart, "-")
+	t.year, _ = strconv.Atoi(fields[0])
+	t.month, t.day = 1, 1
+	t.precision = PrecisionYear
+	if len(fields) > 1 {
+		t.month, _ = strconv.Atoi(fields[1])
+		t.precision = PrecisionMonth
+	}
+	if len(fields) > 2 {
+		t.day, _ = strconv.Atoi(fields[2])
+		t.precision = PrecisionDay
+	}
+
+	if hasTime {
+		clock := timePart
+		if i := strings.IndexAny(timePart, "Z+-"); i >= 0 {
+			clock = timePart[:i]
+			loc, err := parseOffset(timePart[i:])
+			if err != nil {
+				return temporal{}, err
+			}
+			t.location = loc
+		}
+		if err := t.parseClock(clock); err != nil {
+			return temporal{}, err
+		}
+	}
+
+	if t.precision >= PrecisionDay {
+		days := time.Date(t.year, time.Month(t.month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if t.day > days {
+			return temporal{}, fmt.Errorf("day %d out of range for %04d-%02d", t.day, t.year, t.month)
+		}
+	}
+	return t, nil
+}
+
+// parseClock reads hh, hh:mm or hh:mm:ss with an optional fraction.
+func (t *temporal) parseClock(clock string) error {
+	parts := strings.Split(clock, ":")
+	if len(parts) > 3 {
+		return fmt.Errorf("invalid time %q", clock)
+	}
+	t.hour, _ = strconv.Atoi(parts[0])
+	t.precision = PrecisionHour
+	if len(parts) == 1 {
+		return nil
+	}
+	t.minute, _ = strconv.Atoi(parts[1])
+	t.precision = PrecisionMinute
+	if len(parts) == 2 {
+		return nil
+	}
+	seconds, fraction, hasFraction := strings.Cut(parts[2], ".")
+	t.second, _ = strconv.Atoi(seconds)
+	t.precision = PrecisionSecond
+	if hasFraction {
+		t.fractionDigits = len(fraction)
+		nanos, _ := strconv.Atoi((fraction + "000000000")[:9])
+		t.nanosecond = nanos
+		t.precision = PrecisionSubsecond
+	}
+	return nil
+}
+
+func parseOffset(s string) (*time.Location, error) {
+	if s == "Z" {
+		return time.UTC, nil
+	}
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	hours, minutes, ok := strings.Cut(s[1:], ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid offset %q", s)
+	}
+	h, _ := strconv.Atoi(hours)
+	m, _ := strconv.Atoi(minutes)
+	return time.FixedZone("", sign*(h*3600+m*60)), nil
+}
+
+// hasOffset reports whether the literal carried a timezone offset.
+func (t temporal) hasOffset() bool {
+	return t.location != nil
+}
+
+// start returns the first instant covered by the value. Values without an
+// offset are interpreted in UTC.
+func (t temporal) start() time.Time {
+	loc := t.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(t.year, time.Month(t.month), t.day, t.hour, t.minute, t.second, t.nanosecond, loc)
+}
+
+// end returns the first instant after the range covered by the value.
+func (t temporal) end() time.Time {
+	start := t.start()
+	switch t.precision {
+	case PrecisionYear:
+		return start.AddDate(1, 0, 0)
+	case PrecisionMonth:
+		return start.AddDate(0, 1, 0)
+	case PrecisionDay:
+		return start.AddDate(0, 0, 1)
+	case PrecisionHour:
+		return start.Add(time.Hour)
+	case PrecisionMinute:
+		return start.Add(time.Minute)
+	case PrecisionSecond:
+		return start.Add(time.Second)
+	default:
+		step := time.Duration(1)
+		for i := t.fractionDigits; i < 9; i++ {
+			step *= 10
+		}
+		return start.Add(step)
+	}
+}
+
+// compareRanges compares two half-open ranges with FHIR semantics: the result
+// is only determinate when the ranges are identical or do not overlap.
+func compareRanges(aStart, aEnd, bStart, bEnd time.Time) (int, bool) {
+	switch {
+	case aStart.Equal(bStart) && aEnd.Equal(bEnd):
+		return 0, true
+	case !aEnd.After(bStart):
+		return -1, true
+	case !bEnd.After(aStart):
+		return 1, true
+	}
+	return 0, false
+}
+
+func marshalTemporal(literal string) ([]byte, error) {
+	return json.Marshal(literal)
+}
+
+// unmarshalTemporal reads a JSON string without checking its format, so that
+// documents with malformed values can still be loaded and reported by
+// Validate.
+func unmarshalTemporal(data []byte, literal *string) error {
+	if string(data) == "null" {
+		return nil
+	}
+	return json.Unmarshal(data, literal)
+}
+
+// formatFraction returns the fractional seconds of t without trailing zeros,
+// or "" when t falls on a whole second.
+func formatFraction(t time.Time) string {
+	if t.Nanosecond() == 0 {
+		return ""
+	}
+	return strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond()), "0")
+}
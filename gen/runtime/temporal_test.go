@@ -0,0 +1,185 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTemporal_JSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		value interface {
+			Validate() error
+		}
+	}{
+		{"date year", `"1974"`, &Date{}},
+		{"date month", `"1974-12"`, &Date{}},
+		{"dateTime offset", `"2015-02-07T13:28:17.2390+02:00"`, &DateTime{}},
+		{"dateTime negative offset", `"2015-02-07T13:28:17-05:00"`, &DateTime{}},
+		{"instant", `"2015-02-07T13:28:17.239Z"`, &Instant{}},
+		{"time", `"13:28:17.50"`, &Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.input), tt.value); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if err := tt.value.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+			out, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(out) != tt.input {
+				t.Errorf("Marshal() = %s, want %s", out, tt.input)
+			}
+		})
+	}
+}
+
+func TestTemporal_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{ Validate() error }
+		wantErr bool
+	}{
+		{"zero date", Date{}, false},
+		{"full date", Date{literal: "2024-02-29"}, false},
+		{"nonexistent date", Date{literal: "2023-02-29"}, true},
+		{"date with time", Date{literal: "2023-02-01T10:00:00Z"}, true},
+		{"dateTime year", DateTime{literal: "2023"}, false},
+		{"dateTime bad hour", DateTime{literal: "2023-01-01T24:00:00Z"}, true},
+		{"dateTime minutes only", DateTime{literal: "2023-01-01T10:00Z"}, true},
+		{"dateTime hour only", DateTime{literal: "2023-01-01T10"}, true},
+		{"instant without offset", Instant{literal: "2023-01-01T10:00:00"}, true},
+		{"instant partial", Instant{literal: "2023-01-01"}, true},
+		{"time", Time{literal: "23:59:60"}, false},
+		{"time with offset", Time{literal: "10:00:00Z"}, true},
+		{"time minutes only", Time{literal: "10:00"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.value.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTemporal_Precision(t *testing.T) {
+	tests := []struct {
+		got  TemporalPrecision
+		want TemporalPrecision
+	}{
+		{mustDate(t, "2024").Precision(), PrecisionYear},
+		{mustDate(t, "2024-03").Precision(), PrecisionMonth},
+		{mustDate(t, "2024-03-01").Precision(), PrecisionDay},
+		{DateTime{literal: "2024-03-01T10:00:00Z"}.Precision(), PrecisionSecond},
+		{DateTime{literal: "2024-03-01T10:00:00.1Z"}.Precision(), PrecisionSubsecond},
+		// FHIRPath literals may stop at hours or minutes
+		{DateTime{literal: "2024-03-01T10"}.Precision(), PrecisionHour},
+		{Time{literal: "10:30"}.Precision(), PrecisionMinute},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("Precision() = %v, want %v", tt.got, tt.want)
+		}
+	}
+}
+
+func TestTemporal_Range(t *testing.T) {
+	start, end, err := DateTime{literal: "2024-02"}.Range()
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if !start.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Range() = [%v, %v)", start, end)
+	}
+
+	start, end, err = DateTime{literal: "2024-02-01T10:00:00.5+02:00"}.Range()
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if !start.Equal(time.Date(2024, 2, 1, 8, 0, 0, 5e8, time.UTC)) || end.Sub(start) != 100*time.Millisecond {
+		t.Errorf("Range() = [%v, %v)", start, end)
+	}
+
+	loc, ok := DateTime{literal: "2024-02-01T10:00:00-05:00"}.Location()
+	if !ok {
+		t.Fatal("Location() should report the offset")
+	}
+	if _, offset := start.In(loc).Zone(); offset != -5*3600 {
+		t.Errorf("offset = %d, want -18000", offset)
+	}
+	if _, ok := (DateTime{literal: "2024-02-01"}).Location(); ok {
+		t.Error("Location() should be absent for a date")
+	}
+}
+
+func TestTemporal_Compare(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   DateTime
+		want   int
+		wantOK bool
+	}{
+		{"equal", DateTime{literal: "2024-03"}, DateTime{literal: "2024-03"}, 0, true},
+		{"before", DateTime{literal: "2023"}, DateTime{literal: "2024-01-01"}, -1, true},
+		{"after", DateTime{literal: "2024-03-02"}, DateTime{literal: "2024-03"}, 0, false},
+		{"different offsets", DateTime{literal: "2024-03-01T10:00:00+02:00"}, DateTime{literal: "2024-03-01T08:00:00Z"}, 0, true},
+		{"later", DateTime{literal: "2024-04"}, DateTime{literal: "2024-03-31T23:59:59Z"}, 1, true},
+		{"overlapping precision", DateTime{literal: "2024"}, DateTime{literal: "2024-03"}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.a.Compare(tt.b)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Compare() = %d, %v, want %d, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	a, b := Time{literal: "10:00:00"}, Time{literal: "10:00:01.5"}
+	if got, ok := a.Compare(b); got != -1 || !ok {
+		t.Errorf("Time.Compare() = %d, %v, want -1, true", got, ok)
+	}
+}
+
+func TestTemporal_Constructors(t *testing.T) {
+	ts := time.Date(2024, 3, 5, 14, 7, 9, 120000000, time.FixedZone("", 2*3600))
+
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{NewDate(ts, PrecisionMonth).String(), "2024-03"},
+		{NewDateTime(ts, PrecisionDay).String(), "2024-03-05"},
+		{NewDateTime(ts, PrecisionSecond).String(), "2024-03-05T14:07:09+02:00"},
+		{NewDateTime(ts, PrecisionSubsecond).String(), "2024-03-05T14:07:09.12+02:00"},
+		{NewInstant(ts.UTC()).String(), "2024-03-05T12:07:09.12Z"},
+		{NewTime(ts, PrecisionSecond).String(), "14:07:09"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %s, want %s", tt.got, tt.want)
+		}
+	}
+
+	d, err := Time{literal: "01:02:03"}.SinceMidnight()
+	if err != nil || d != time.Hour+2*time.Minute+3*time.Second {
+		t.Errorf("SinceMidnight() = %v, %v", d, err)
+	}
+}
+
+func mustDate(t *testing.T, s string) Date {
+	t.Helper()
+	d, err := ParseDate(s)
+	if err != nil {
+		t.Fatalf("ParseDate(%q) error = %v", s, err)
+	}
+	return d
+}
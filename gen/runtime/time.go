@@ -0,0 +1,129 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var timePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?$`)
+
+// partialTimePattern also accepts the hours and minutes of FHIRPath time
+// literals such as @T14 and @T14:30.
+var partialTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3])(:[0-5][0-9](:([0-5][0-9]|60)(\.[0-9]{1,9})?)?)?$`)
+
+// Time is a FHIR time: a time of day without a date or timezone, e.g.
+// "13:28:17" or "13:28:17.239". The original text is kept, so the precision
+// survives a round trip.
+type Time struct {
+	literal string
+}
+
+// ParseTime parses and validates a FHIR time literal.
+func ParseTime(s string) (Time, error) {
+	t := Time{literal: s}
+	if err := t.check(); err != nil {
+		return Time{}, err
+	}
+	return t, nil
+}
+
+// NewTime returns the time of day of t at PrecisionSecond or
+// PrecisionSubsecond.
+func NewTime(t time.Time, precision TemporalPrecision) Time {
+	if precision == PrecisionSubsecond {
+		return Time{literal: t.Format("15:04:05") + formatFraction(t)}
+	}
+	return Time{literal: t.Format("15:04:05")}
+}
+
+func (t Time) String() string {
+	return t.literal
+}
+
+// IsZero reports whether t holds no value.
+func (t Time) IsZero() bool {
+	return t.literal == ""
+}
+
+// Validate checks t against the regex of the time type.
+func (t Time) Validate() error {
+	if t.IsZero() {
+		return nil
+	}
+	return t.check()
+}
+
+// Precision returns PrecisionSecond or PrecisionSubsecond, or
+// PrecisionHour or PrecisionMinute for a FHIRPath literal.
+func (t Time) Precision() TemporalPrecision {
+	p, err := t.parse()
+	if err != nil {
+		return 0
+	}
+	return p.precision
+}
+
+// SinceMidnight returns the time of day as the duration since midnight.
+func (t Time) SinceMidnight() (time.Duration, error) {
+	p, err := t.parse()
+	if err != nil {
+		return 0, err
+	}
+	return p.start().Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)), nil
+}
+
+// On returns the instant at which t occurs on the day of date, in date's
+// location.
+func (t Time) On(date time.Time) (time.Time, error) {
+	p, err := t.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	y, m, d := date.Date()
+	return time.Date(y, m, d, p.hour, p.minute, p.second, p.nanosecond, date.Location()), nil
+}
+
+// Compare compares t and other with FHIR semantics. The result is -1, 0 or
+// +1; ok is false when the values have different precisions and overlap.
+func (t Time) Compare(other Time) (result int, ok bool) {
+	a, err := t.parse()
+	if err != nil {
+		return 0, false
+	}
+	b, err := other.parse()
+	if err != nil {
+		return 0, false
+	}
+	return compareRanges(a.start(), a.end(), b.start(), b.end())
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return marshalTemporal(t.literal)
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return unmarshalTemporal(data, &t.literal)
+}
+
+// check parses t, rejecting the partial times only FHIRPath allows.
+func (t Time) check() error {
+	if !timePattern.MatchString(t.literal) {
+		return fmt.Errorf("invalid time %q", t.literal)
+	}
+	_, err := t.parse()
+	return err
+}
+
+// parse reads the clock of t on day zero, so that start and end yield the
+// range covered by t.
+func (t Time) parse() (temporal, error) {
+	if !partialTimePattern.MatchString(t.literal) {
+		return temporal{}, fmt.Errorf("invalid time %q", t.literal)
+	}
+	p := temporal{year: 0, month: 1, day: 1}
+	if err := p.parseClock(t.literal); err != nil {
+		return temporal{}, fmt.Errorf("invalid time %q: %w", t.literal, err)
+	}
+	return p, nil
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMarshalTurtle(t *testing.T) {
+	got, err := marshalTurtle(testXMLPatientValue(), "http://example.org/fhir")
+	if err != nil {
+		t.Fatalf("marshalTurtle() error = %v", err)
+	}
+	for _, want := range []string{
+		"@prefix fhir: <http://hl7.org/fhir/> .\n",
+		"<http://example.org/fhir/testXMLPatient/p1.ttl> a owl:Ontology ;\n  owl:imports fhir:fhir.ttl .\n",
+		"<http://example.org/fhir/testXMLPatient/p1> a fhir:testXMLPatient ;\n  fhir:nodeRole fhir:treeRoot ;\n  fhir:id [ fhir:v \"p1\" ] ;\n",
+		`fhir:div "<div xmlns=\"http://www.w3.org/1999/xhtml\"><p class=\"x\">Peter &amp; <b>Jim</b><br/></p></div>"^^rdf:XMLLiteral`,
+		"fhir:contained ( [\n    a fhir:testXMLPatient ;\n    fhir:id [ fhir:v \"c1\" ] ;\n    fhir:active [ fhir:v false ]\n  ] )",
+		"fhir:given ( [ fhir:v \"Peter\" ] [ fhir:id [ fhir:v \"g2\" ] ] [ fhir:v \"James\" ] )",
+		`fhir:birthDate [ fhir:v "1974-12"^^xsd:gYearMonth ]`,
+		"fhir:deceased [\n    a fhir:dateTime ;\n    fhir:v \"2020-01-02\"^^xsd:date ;\n    fhir:id [ fhir:v \"d\" ]\n  ]",
+		`fhir:value [ fhir:v "72.50"^^xsd:decimal ]`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("marshalTurtle() =\n%s\nmissing\n%s", got, want)
+		}
+	}
+}
+
+func TestMarshalTurtle_BlankRoot(t *testing.T) {
+	got, err := marshalTurtle(&testXMLPatient{ResourceType: "testXMLPatient", Active: ptrTo(true)}, "")
+	if err != nil {
+		t.Fatalf("marshalTurtle() error = %v", err)
+	}
+	want := "[] a fhir:testXMLPatient ;\n  fhir:nodeRole fhir:treeRoot ;\n  fhir:active [ fhir:v true ] .\n"
+	if !strings.HasSuffix(string(got), "\n\n"+want) || strings.Contains(string(got), "owl:Ontology") {
+		t.Errorf("marshalTurtle() =\n%s\nwant suffix\n%s", got, want)
+	}
+}
+
+func TestTurtle_RoundTrip(t *testing.T) {
+	withXMLResourceFactory(t)
+
+	data, err := marshalTurtle(testXMLPatientValue(), "")
+	if err != nil {
+		t.Fatalf("marshalTurtle() error = %v", err)
+	}
+	got, err := unmarshalTurtle(data)
+	if err != nil {
+		t.Fatalf("unmarshalTurtle() error = %v\n%s", err, data)
+	}
+	if want := testXMLPatientValue(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unmarshalTurtle() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshalTurtle_Syntax(t *testing.T) {
+	withXMLResourceFactory(t)
+
+	data := `# written by hand
+PREFIX f: <http://hl7.org/fhir/>
+@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .
+@base <http://example.org/> .
+
+<other> a f:Observation .
+
+<testXMLPatient/p1> a f:testXMLPatient ;
+  f:nodeRole f:treeRoot ;
+  f:unknown [ f:v "skipped" ] ;
+  f:id [ f:v 'p1' ] ;
+  f:active _:active ;
+  f:given [ f:v """Peter
+Paul""" ], [ f:v "Jämes"@en ] ;
+  f:quantity [ f:value [ f:v 72.50 ] ; ] .
+
+_:active f:v "true"^^xsd:boolean .
+`
+	got, err := unmarshalTurtle([]byte(data))
+	if err != nil {
+		t.Fatalf("unmarshalTurtle() error = %v", err)
+	}
+	want := &testXMLPatient{
+		ResourceType: "testXMLPatient",
+		Id:           ptrTo("p1"),
+		Active:       ptrTo(true),
+		Given:        []string{"Peter\nPaul", "Jämes"},
+		Quantity:     &TestQuantity{Value: ptrTo(MustParseDecimal("72.50"))},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unmarshalTurtle() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshalTurtle_Errors(t *testing.T) {
+	withXMLResourceFactory(t)
+
+	const prefix = "@prefix fhir: <http://hl7.org/fhir/> .\n"
+	tests := map[string]string{
+		"no resource":      prefix + `[] a fhir:Observation ; fhir:nodeRole fhir:treeRoot .`,
+		"undefined prefix": `[] a fhir:testXMLPatient .`,
+		"unterminated":     prefix + `[] a fhir:testXMLPatient ; fhir:active [ fhir:v true .`,
+		"string":           prefix + `[] a fhir:testXMLPatient ; fhir:id [ fhir:v "p1 ] .`,
+		"boolean":          prefix + `[] a fhir:testXMLPatient ; fhir:active [ fhir:v "yes" ] .`,
+		"choice type":      prefix + `[] a fhir:testXMLPatient ; fhir:deceased [ a fhir:Period ] .`,
+		"contained type":   prefix + `[] a fhir:testXMLPatient ; fhir:contained ( [ a fhir:Unknown ] ) .`,
+		"literal element":  prefix + `[] a fhir:testXMLPatient ; fhir:active true .`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got, err := unmarshalTurtle([]byte(data)); err == nil {
+				t.Errorf("unmarshalTurtle() = %+v, want error", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidationIssues_Err(t *testing.T) {
+	var issues ValidationIssues
+	if err := issues.Err(); err != nil {
+		t.Errorf("Err() on empty issues = %v, want nil", err)
+	}
+
+	issues = append(issues, ValidationIssue{Severity: "warning", Path: "Patient.name[0]", Message: "no family name"})
+	if err := issues.Err(); err != nil {
+		t.Errorf("Err() with only warnings = %v, want nil", err)
+	}
+
+	issues.add("required", "Patient.identifier[2].system", "field 'System' is required")
+	err := issues.Err()
+	if err == nil {
+		t.Fatal("Err() = nil, want error")
+	}
+	want := "Patient.name[0]: no family name; Patient.identifier[2].system: field 'System' is required"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestValidationIssues_AddNested(t *testing.T) {
+	nested := ValidationIssues{
+		{Severity: "error", Code: "invalid", Path: "Organization", Message: "bad resourceType"},
+		{Severity: "error", Code: "required", Path: "Organization.contact[0].name", Message: "missing"},
+	}
+
+	var issues ValidationIssues
+	issues.addNested("Patient.contained[1]", nested)
+
+	var paths []string
+	for _, issue := range issues {
+		paths = append(paths, issue.Path)
+	}
+	want := []string{"Patient.contained[1]", "Patient.contained[1].contact[0].name"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+	if nested[1].Path != "Organization.contact[0].name" {
+		t.Error("addNested should not modify the nested issues")
+	}
+}
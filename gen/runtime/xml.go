@@ -0,0 +1,473 @@
+package models
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+const (
+	fhirNamespace  = "http://hl7.org/fhir"
+	xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+)
+
+// marshalXML writes v, a pointer to a generated struct, as the element
+// start in the FHIR namespace. Resources are named after their type.
+func marshalXML(e *xml.Encoder, start xml.StartElement, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return nil
+	}
+	rv = rv.Elem()
+	if name := resourceTypeOf(rv); name != "" {
+		start.Name.Local = name
+	}
+	start.Name.Space = fhirNamespace
+	return writeXMLStruct(e, start, rv, reflect.Value{})
+}
+
+// writeXMLStruct writes the struct v as the element start. The value
+// attribute of a primitive is passed in start; its Element companion, when
+// valid, adds the id attribute and extensions.
+func writeXMLStruct(e *xml.Encoder, start xml.StartElement, v, element reflect.Value) error {
+	var attrs []xml.Attr
+	var children []func() error
+	for _, part := range []reflect.Value{v, element} {
+		if !part.IsValid() {
+			continue
+		}
+		for _, f := range elementFields(part.Type()) {
+			fv := part.Field(f.index)
+			if f.attr {
+				if s, ok := primitiveString(fv); ok {
+					attrs = append(attrs, xml.Attr{Name: xml.Name{Local: f.name}, Value: s})
+				}
+				continue
+			}
+			f, fv, part := f, fv, part
+			children = append(children, func() error { return writeXMLField(e, f, fv, part) })
+		}
+	}
+	start.Attr = append(attrs, start.Attr...)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := child(); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// writeXMLField writes the items of the field f of the struct parent.
+func writeXMLField(e *xml.Encoder, f elementField, v, parent reflect.Value) error {
+	var element reflect.Value
+	if f.element >= 0 {
+		element = parent.Field(f.element)
+	}
+	switch {
+	case f.xhtml:
+		return writeXHTML(e, f.name, v.String())
+	case f.variants != nil:
+		if v.IsNil() {
+			return nil
+		}
+		name := choiceProperty(f.name, v.Interface().(choiceValue).FHIRType())
+		return writeXMLValue(e, name, choiceItem(v, element), element)
+	case v.Kind() == reflect.Slice:
+		n := v.Len()
+		if element.IsValid() && element.Kind() == reflect.Slice && element.Len() > n {
+			n = element.Len()
+		}
+		for i := 0; i < n; i++ {
+			var item, itemElement reflect.Value
+			if i < v.Len() {
+				item = v.Index(i)
+			}
+			if element.IsValid() && element.Kind() == reflect.Slice && i < element.Len() {
+				itemElement = element.Index(i)
+			}
+			if err := writeXMLItem(e, f, item, itemElement); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return writeXMLItem(e, f, v, element)
+}
+
+func writeXMLItem(e *xml.Encoder, f elementField, v, element reflect.Value) error {
+	if !f.resource {
+		return writeXMLValue(e, f.name, v, element)
+	}
+	if !v.IsValid() || v.IsNil() {
+		return nil
+	}
+	res := v.Elem()
+	for res.Kind() == reflect.Pointer {
+		if res.IsNil() {
+			return nil
+		}
+		res = res.Elem()
+	}
+	wrapper := xml.StartElement{Name: xml.Name{Local: f.name}}
+	if err := e.EncodeToken(wrapper); err != nil {
+		return err
+	}
+	start := xml.StartElement{Name: xml.Name{Local: resourceTypeOf(res)}}
+	if err := writeXMLStruct(e, start, res, reflect.Value{}); err != nil {
+		return err
+	}
+	return e.EncodeToken(wrapper.End())
+}
+
+// writeXMLValue writes a primitive with its value attribute, or a struct
+// with its children, as the element name. Absent values are skipped.
+func writeXMLValue(e *xml.Encoder, name string, v, element reflect.Value) error {
+	v = indirectValue(v)
+	element = indirectValue(element)
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if isComplexValue(v) {
+		return writeXMLStruct(e, start, v, reflect.Value{})
+	}
+	s, ok := primitiveString(v)
+	if !ok && !element.IsValid() {
+		return nil
+	}
+	if ok {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "value"}, Value: s})
+	}
+	return writeXMLStruct(e, start, reflect.Value{}, element)
+}
+
+// writeXHTML writes the narrative div, held as XHTML text, as embedded
+// elements in the XHTML namespace.
+func writeXHTML(e *xml.Encoder, name, div string) error {
+	if div == "" {
+		return nil
+	}
+	d := xml.NewDecoder(strings.NewReader(div))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	depth := 0
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			attrs := t.Attr[:0:0]
+			for _, a := range t.Attr {
+				if a.Name.Space != "xmlns" && a.Name.Local != "xmlns" {
+					attrs = append(attrs, a)
+				}
+			}
+			t.Attr = attrs
+			t.Name.Space = ""
+			if depth == 0 {
+				t.Name.Space = xhtmlNamespace
+			}
+			depth++
+			tok = t
+		case xml.EndElement:
+			depth--
+			t.Name.Space = ""
+			if depth == 0 {
+				t.Name.Space = xhtmlNamespace
+			}
+			tok = t
+		case xml.ProcInst, xml.Directive:
+			continue
+		}
+		if err := e.EncodeToken(xml.CopyToken(tok)); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// unmarshalXML decodes the element start into v, a pointer to a generated
+// struct. Resources must be named after their type.
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, v any) error {
+	rv := reflect.ValueOf(v).Elem()
+	if name := resourceTypeOf(rv); name != "" {
+		if start.Name.Local != rv.Type().Name() {
+			return fmt.Errorf("expected %s element, got %s", rv.Type().Name(), start.Name.Local)
+		}
+		rv.FieldByName("ResourceType").SetString(start.Name.Local)
+	}
+	return readXMLStruct(d, start, rv, "")
+}
+
+// readXMLStruct decodes the attributes and children of start into the
+// struct v. path is the FHIR path of v, for errors. Unknown elements are
+// skipped, as JSON decoding ignores unknown properties.
+func readXMLStruct(d *xml.Decoder, start xml.StartElement, v reflect.Value, path string) error {
+	fields := elementFields(v.Type())
+	byName := make(map[string]int, len(fields))
+	for i, f := range fields {
+		if f.variants == nil {
+			byName[f.name] = i
+			continue
+		}
+		for _, variant := range f.variants {
+			byName[choiceProperty(f.name, variant.FHIRType())] = i
+		}
+	}
+
+	for _, a := range start.Attr {
+		i, ok := byName[a.Name.Local]
+		if !ok || !fields[i].attr || a.Name.Space != "" {
+			continue
+		}
+		if err := setPrimitive(v.Field(fields[i].index), a.Value); err != nil {
+			return fmt.Errorf("%s: %w", childPath(path, a.Name.Local), err)
+		}
+	}
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			i, ok := byName[t.Name.Local]
+			if !ok || fields[i].attr {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := readXMLField(d, t, v, fields[i], childPath(path, t.Name.Local)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readXMLField decodes the element start into the field f of the struct v,
+// appending to repeating fields.
+func readXMLField(d *xml.Decoder, start xml.StartElement, v reflect.Value, f elementField, path string) error {
+	fv := v.Field(f.index)
+	var element reflect.Value
+	if f.element >= 0 {
+		element = v.Field(f.element)
+	}
+
+	switch {
+	case f.xhtml:
+		div, err := readXHTML(d, start)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		fv.SetString(div)
+		return nil
+	case f.variants != nil:
+		for _, variant := range f.variants {
+			if choiceProperty(f.name, variant.FHIRType()) != start.Name.Local {
+				continue
+			}
+			if !fv.IsNil() {
+				// a second variant of the same choice; the first is kept
+				return d.Skip()
+			}
+			item := reflect.New(reflect.TypeOf(variant)).Elem()
+			if err := readXMLValue(d, start, item, element, path); err != nil {
+				return err
+			}
+			fv.Set(item)
+		}
+		return nil
+	case fv.Kind() == reflect.Slice:
+		item := reflect.New(fv.Type().Elem()).Elem()
+		var itemElement reflect.Value
+		if element.IsValid() && element.Kind() == reflect.Slice {
+			itemElement = reflect.New(element.Type().Elem()).Elem()
+		}
+		if err := readXMLItem(d, start, item, itemElement, f, path); err != nil {
+			return err
+		}
+		if itemElement.IsValid() && !itemElement.IsZero() {
+			for element.Len() < fv.Len() {
+				element.Set(reflect.Append(element, reflect.Zero(element.Type().Elem())))
+			}
+			element.Set(reflect.Append(element, itemElement))
+		}
+		fv.Set(reflect.Append(fv, item))
+		return nil
+	}
+	return readXMLItem(d, start, fv, element, f, path)
+}
+
+func readXMLItem(d *xml.Decoder, start xml.StartElement, v, element reflect.Value, f elementField, path string) error {
+	if !f.resource {
+		return readXMLValue(d, start, v, element, path)
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			if newResourceValue == nil {
+				return fmt.Errorf("%s: no resource registry", path)
+			}
+			res, ok := newResourceValue(t.Name.Local)
+			if !ok {
+				return fmt.Errorf("%s: unknown resource type %q", path, t.Name.Local)
+			}
+			rv := reflect.ValueOf(res).Elem()
+			rv.FieldByName("ResourceType").SetString(t.Name.Local)
+			if err := readXMLStruct(d, t, rv, path); err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(res))
+		}
+	}
+}
+
+// readXMLValue decodes the element start into v, allocating pointers. The
+// id and extensions of a primitive go to its Element companion, when v
+// has one and they are present.
+func readXMLValue(d *xml.Decoder, start xml.StartElement, v, element reflect.Value, path string) error {
+	if v.Kind() == reflect.Pointer {
+		target := reflect.New(v.Type().Elem())
+		if err := readXMLValue(d, start, target.Elem(), element, path); err != nil {
+			return err
+		}
+		// a primitive without a value attribute only has an id or extensions
+		if _, ok := xmlValueAttr(start); ok || isComplexValue(target.Elem()) {
+			v.Set(target)
+		}
+		return nil
+	}
+	if v.Kind() == reflect.Struct && v.NumField() == 1 && v.Type().Field(0).Anonymous {
+		return readXMLValue(d, start, v.Field(0), element, path)
+	}
+	if isComplexValue(v) {
+		return readXMLStruct(d, start, v, path)
+	}
+
+	if value, ok := xmlValueAttr(start); ok {
+		if err := setPrimitive(v, value); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if !element.IsValid() {
+		return d.Skip()
+	}
+	companion := reflect.New(element.Type().Elem())
+	if err := readXMLStruct(d, start, companion.Elem(), path); err != nil {
+		return err
+	}
+	if !companion.Elem().IsZero() {
+		element.Set(companion)
+	}
+	return nil
+}
+
+func xmlValueAttr(start xml.StartElement) (string, bool) {
+	for _, a := range start.Attr {
+		if a.Name.Local == "value" && a.Name.Space == "" {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// readXHTML reads the narrative div start as XHTML text, declaring the
+// XHTML namespace on the div.
+func readXHTML(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var b strings.Builder
+	depth := 0
+	open := false
+	tok := xml.Token(start)
+	for {
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if open {
+				b.WriteByte('>')
+			}
+			b.WriteString("<" + xhtmlName(t.Name))
+			if depth == 0 {
+				b.WriteString(` xmlns="` + xhtmlNamespace + `"`)
+			}
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" {
+					continue
+				}
+				b.WriteString(" " + xhtmlName(a.Name) + `="`)
+				escapeXHTML(&b, a.Value, true)
+				b.WriteByte('"')
+			}
+			open = true
+			depth++
+		case xml.EndElement:
+			depth--
+			if open {
+				b.WriteString("/>")
+			} else {
+				b.WriteString("</" + xhtmlName(t.Name) + ">")
+			}
+			open = false
+			if depth == 0 {
+				return b.String(), nil
+			}
+		case xml.CharData:
+			if open {
+				b.WriteByte('>')
+				open = false
+			}
+			escapeXHTML(&b, string(t), false)
+		case xml.Comment:
+			if open {
+				b.WriteByte('>')
+				open = false
+			}
+			b.WriteString("<!--" + string(t) + "-->")
+		}
+		var err error
+		if tok, err = d.Token(); err != nil {
+			return "", err
+		}
+	}
+}
+
+// xhtmlName returns the name of an XHTML element or attribute, keeping
+// the xml prefix of attributes such as xml:lang.
+func xhtmlName(name xml.Name) string {
+	if name.Space == "http://www.w3.org/XML/1998/namespace" || name.Space == "xml" {
+		return "xml:" + name.Local
+	}
+	return name.Local
+}
+
+func escapeXHTML(b *strings.Builder, s string, attr bool) {
+	for _, r := range s {
+		switch {
+		case r == '&':
+			b.WriteString("&amp;")
+		case r == '<':
+			b.WriteString("&lt;")
+		case r == '>':
+			b.WriteString("&gt;")
+		case r == '"' && attr:
+			b.WriteString("&quot;")
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
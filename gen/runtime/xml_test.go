@@ -0,0 +1,186 @@
+package models
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testXMLElement struct {
+	Id        *string     `json:"id,omitempty"`
+	Extension []Extension `json:"extension,omitempty"`
+}
+
+// Extension and Narrative are named like the generated types whose url
+// and div the XML encoding treats specially.
+type Extension struct {
+	Id    *string    `json:"id,omitempty"`
+	Url   string     `json:"url"`
+	Value testChoice `json:"-"`
+}
+
+func (r *Extension) choiceVariants(field string) (string, []choiceValue) {
+	if field == "Value" {
+		return "value", choiceValues(testChoiceVariants)
+	}
+	return "", nil
+}
+
+type Narrative struct {
+	Status string `json:"status"`
+	Div    string `json:"div"`
+}
+
+type testXMLPatient struct {
+	ResourceType    string            `json:"resourceType"`
+	Id              *string           `json:"id,omitempty"`
+	Text            *Narrative        `json:"text,omitempty"`
+	Contained       []any             `json:"contained,omitempty"`
+	Extension       []Extension       `json:"extension,omitempty"`
+	Active          *bool             `json:"active,omitempty"`
+	ActiveElement   *testXMLElement   `json:"_active,omitempty"`
+	Given           []string          `json:"given,omitempty"`
+	GivenElement    []*testXMLElement `json:"_given,omitempty"`
+	BirthDate       *Date             `json:"birthDate,omitempty"`
+	Deceased        testChoice        `json:"-"`
+	DeceasedElement *testXMLElement   `json:"-"`
+	Quantity        *TestQuantity     `json:"quantity,omitempty"`
+}
+
+func (r *testXMLPatient) choiceVariants(field string) (string, []choiceValue) {
+	if field == "Deceased" {
+		return "deceased", choiceValues(testChoiceVariants)
+	}
+	return "", nil
+}
+
+func (r testXMLPatient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *testXMLPatient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+const testXMLDocument = `<testXMLPatient xmlns="http://hl7.org/fhir">` +
+	`<id value="p1"></id>` +
+	`<text><status value="generated"></status><div xmlns="http://www.w3.org/1999/xhtml"><p class="x">Peter &amp; <b>Jim</b><br></br></p></div></text>` +
+	`<contained><testXMLPatient><id value="c1"></id><active value="false"></active></testXMLPatient></contained>` +
+	`<extension url="http://example.org/nickname"><valueString value="Pete"></valueString></extension>` +
+	`<active value="true"><extension url="http://example.org/source"><valueString value="registry"></valueString></extension></active>` +
+	`<given value="Peter"></given><given id="g2"></given><given value="James"></given>` +
+	`<birthDate value="1974-12"></birthDate>` +
+	`<deceasedDateTime id="d" value="2020-01-02"></deceasedDateTime>` +
+	`<quantity><value value="72.50"></value><unit value="/min"></unit></quantity>` +
+	`</testXMLPatient>`
+
+func testXMLPatientValue() *testXMLPatient {
+	return &testXMLPatient{
+		ResourceType: "testXMLPatient",
+		Id:           ptrTo("p1"),
+		Text: &Narrative{
+			Status: "generated",
+			Div:    `<div xmlns="http://www.w3.org/1999/xhtml"><p class="x">Peter &amp; <b>Jim</b><br/></p></div>`,
+		},
+		Contained: []any{&testXMLPatient{ResourceType: "testXMLPatient", Id: ptrTo("c1"), Active: ptrTo(false)}},
+		Extension: []Extension{{Url: "http://example.org/nickname", Value: testChoiceString("Pete")}},
+		Active:    ptrTo(true),
+		ActiveElement: &testXMLElement{Extension: []Extension{
+			{Url: "http://example.org/source", Value: testChoiceString("registry")},
+		}},
+		Given:           []string{"Peter", "", "James"},
+		GivenElement:    []*testXMLElement{nil, {Id: ptrTo("g2")}},
+		BirthDate:       ptrTo(Date{literal: "1974-12"}),
+		Deceased:        testChoiceDateTime{DateTime{literal: "2020-01-02"}},
+		DeceasedElement: &testXMLElement{Id: ptrTo("d")},
+		Quantity:        &TestQuantity{Value: ptrTo(MustParseDecimal("72.50")), Unit: ptrTo("/min")},
+	}
+}
+
+func withXMLResourceFactory(t *testing.T) {
+	previous := newResourceValue
+	newResourceValue = func(resourceType string) (any, bool) {
+		if resourceType != "testXMLPatient" {
+			return nil, false
+		}
+		return &testXMLPatient{}, true
+	}
+	t.Cleanup(func() { newResourceValue = previous })
+}
+
+func TestMarshalXML(t *testing.T) {
+	got, err := xml.Marshal(testXMLPatientValue())
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	if string(got) != testXMLDocument {
+		t.Errorf("xml.Marshal() =\n%s\nwant\n%s", got, testXMLDocument)
+	}
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	withXMLResourceFactory(t)
+
+	var got testXMLPatient
+	if err := xml.Unmarshal([]byte(testXMLDocument), &got); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if want := testXMLPatientValue(); !reflect.DeepEqual(&got, want) {
+		t.Errorf("xml.Unmarshal() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshalXML_Lenient(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<!-- comment -->
+<testXMLPatient xmlns="http://hl7.org/fhir">
+  <id value="p1"/>
+  <unknown><value value="x"/></unknown>
+  <active value="true"/>
+</testXMLPatient>`
+	var got testXMLPatient
+	if err := xml.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	want := testXMLPatient{ResourceType: "testXMLPatient", Id: ptrTo("p1"), Active: ptrTo(true)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("xml.Unmarshal() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshalXML_Errors(t *testing.T) {
+	withXMLResourceFactory(t)
+
+	tests := map[string]string{
+		"resource type":    `<Observation xmlns="http://hl7.org/fhir"/>`,
+		"boolean":          `<testXMLPatient xmlns="http://hl7.org/fhir"><active value="yes"/></testXMLPatient>`,
+		"decimal":          `<testXMLPatient xmlns="http://hl7.org/fhir"><quantity><value value="x"/></quantity></testXMLPatient>`,
+		"contained type":   `<testXMLPatient xmlns="http://hl7.org/fhir"><contained><Unknown/></contained></testXMLPatient>`,
+		"truncated":        `<testXMLPatient xmlns="http://hl7.org/fhir"><active value="true">`,
+		"narrative markup": `<testXMLPatient xmlns="http://hl7.org/fhir"><text><div xmlns="http://www.w3.org/1999/xhtml"><p></div></text></testXMLPatient>`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got testXMLPatient
+			if err := xml.Unmarshal([]byte(data), &got); err == nil {
+				t.Errorf("xml.Unmarshal() = %+v, want error", got)
+			}
+		})
+	}
+}
+
+func TestWriteXHTML_Entities(t *testing.T) {
+	var b strings.Builder
+	e := xml.NewEncoder(&b)
+	if err := writeXHTML(e, "div", `<div xmlns="http://www.w3.org/1999/xhtml">a&nbsp;b <span xml:lang="en">c</span></div>`); err != nil {
+		t.Fatalf("writeXHTML() error = %v", err)
+	}
+	if err := e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := "<div xmlns=\"http://www.w3.org/1999/xhtml\">a\u00a0b <span xml:lang=\"en\">c</span></div>"
+	if b.String() != want {
+		t.Errorf("writeXHTML() = %s, want %s", b.String(), want)
+	}
+}
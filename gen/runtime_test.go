@@ -0,0 +1,122 @@
+package gen
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteRuntime_Options(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Options)
+		want    []string
+		wantNot []string
+	}{
+		{
+			name:    "defaults",
+			want:    []string{"decimal.go", "xml.go", "bson.go", "invariant.go", "fhirpath.go", "fhirpath_hook.go", "bound_values.go", "binding_definition.go"},
+			wantNot: []string{operationRuntimeFile},
+		},
+		{
+			name:    "without XML",
+			modify:  func(o *Options) { o.XML = false },
+			want:    []string{"turtle.go", "fhirpath.go"},
+			wantNot: []string{"xml.go"},
+		},
+		{
+			name:    "without BSON",
+			modify:  func(o *Options) { o.BSONTags = false },
+			want:    []string{"xml.go"},
+			wantNot: []string{"bson.go"},
+		},
+		{
+			name:    "without validation",
+			modify:  func(o *Options) { o.Validation = false },
+			want:    []string{"validation.go", "binding_definition.go", "xml.go"},
+			wantNot: []string{"invariant.go", "fixed_value.go", "reference_target.go", "profile_definition.go", "bound_values.go", "fhirpath.go", "fhirpath_hook.go", "fhirpath_node.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGenerator("", t.TempDir())
+			if tt.modify != nil {
+				tt.modify(&g.Options)
+			}
+			if err := g.WriteRuntime(); err != nil {
+				t.Fatalf("WriteRuntime() error = %v", err)
+			}
+			for _, name := range tt.want {
+				if _, err := os.Stat(filepath.Join(g.OutputPath, name)); err != nil {
+					t.Errorf("%s not written: %v", name, err)
+				}
+			}
+			for _, name := range tt.wantNot {
+				if _, err := os.Stat(filepath.Join(g.OutputPath, name)); err == nil {
+					t.Errorf("%s written, want it left out", name)
+				}
+			}
+		})
+	}
+}
+
+// TestRun_BuildsWithOptions generates a package with the resources of
+// testdata/spec and the data types of the specification under every
+// combination of the BSON, validation and XML options, and type-checks it
+// with go vet, so that a runtime file used without the option that writes
+// it fails here.
+func TestRun_BuildsWithOptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated packages")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("no go command")
+	}
+	spec := t.TempDir()
+	files := map[string]string{
+		"profiles-types.json":     filepath.Join("..", "spec", "profiles-types.json"),
+		"profiles-resources.json": filepath.Join("testdata", "spec", "profiles-resources.json"),
+	}
+	for name, source := range files {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(spec, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"profiles-others.json", "valuesets.json", "search-parameters.json"} {
+		if err := os.WriteFile(filepath.Join(spec, name), []byte(emptyBundle), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, bson := range []bool{false, true} {
+		for _, validation := range []bool{false, true} {
+			for _, xml := range []bool{false, true} {
+				t.Run(fmt.Sprintf("bson=%v,validation=%v,xml=%v", bson, validation, xml), func(t *testing.T) {
+					// the package is built inside the module, where the
+					// FHIRPath hook it imports resolves
+					out, err := os.MkdirTemp("testdata", "build-")
+					if err != nil {
+						t.Fatal(err)
+					}
+					t.Cleanup(func() { os.RemoveAll(out) })
+
+					g := NewGenerator(spec, out)
+					g.Options.BSONTags, g.Options.Validation, g.Options.XML = bson, validation, xml
+					if err := g.Run(); err != nil {
+						t.Fatalf("Run() error = %v", err)
+					}
+					if output, err := exec.Command(goTool, "vet", "./"+filepath.ToSlash(out)).CombinedOutput(); err != nil {
+						t.Errorf("go vet: %v\n%s", err, output)
+					}
+				})
+			}
+		}
+	}
+}
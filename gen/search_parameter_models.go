@@ -0,0 +1,31 @@
+package gen
+
+type SearchParameterBundle struct {
+	ResourceType string                       `json:"resourceType"`
+	Entry        []SearchParameterBundleEntry `json:"entry"`
+}
+
+type SearchParameterBundleEntry struct {
+	FullUrl  string                  `json:"fullUrl"`
+	Resource SearchParameterResource `json:"resource"`
+}
+
+type SearchParameterResource struct {
+	ResourceType string                     `json:"resourceType"`
+	ID           string                     `json:"id"`
+	URL          string                     `json:"url"`
+	Code         string                     `json:"code"`
+	Base         []string                   `json:"base"`
+	Type         string                     `json:"type"`
+	Expression   string                     `json:"expression,omitempty"`
+	Target       []string                   `json:"target,omitempty"`
+	Modifier     []string                   `json:"modifier,omitempty"`
+	Comparator   []string                   `json:"comparator,omitempty"`
+	MultipleOr   *bool                      `json:"multipleOr,omitempty"`
+	Component    []SearchParameterComponent `json:"component,omitempty"`
+}
+
+type SearchParameterComponent struct {
+	Definition string `json:"definition"`
+	Expression string `json:"expression"`
+}
@@ -0,0 +1,165 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LoadSearchParameters reads the SearchParameter resources of
+// search-parameters.json.
+func (g *Generator) LoadSearchParameters() ([]SearchParameterResource, error) {
+	data, err := g.readSpec("search-parameters.json")
+	if err != nil {
+		return nil, fmt.Errorf("read search-parameters.json: %w", err)
+	}
+
+	var bundle SearchParameterBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("unmarshal search-parameters.json: %w", err)
+	}
+
+	var params []SearchParameterResource
+	for _, entry := range bundle.Entry {
+		if entry.Resource.ResourceType == "SearchParameter" && entry.Resource.Code != "" {
+			params = append(params, entry.Resource)
+		}
+	}
+	return params, nil
+}
+
+// GenerateSearchParameters writes the search parameters of the
+// specification as a table, with lookups by resource type, code and URL.
+func (g *Generator) GenerateSearchParameters() error {
+	params, err := g.LoadSearchParameters()
+	if err != nil {
+		return err
+	}
+	return g.writeSearchParameters(params)
+}
+
+// searchParameterResources returns the concrete resources a parameter
+// applies to, expanding the Resource and DomainResource bases.
+func (g *Generator) searchParameterResources(p SearchParameterResource) ([]string, error) {
+	var names []string
+	for _, name := range g.concreteResources() {
+		domain, err := g.isDomainResource(g.Definitions[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, base := range p.Base {
+			if base == name || base == "Resource" || (base == "DomainResource" && domain) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+func (g *Generator) writeSearchParameters(params []SearchParameterResource) error {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
+
+	index := make(map[string][]int)
+	fmt.Fprintf(&buf, "var searchParameterDefinitions = []SearchParameterDefinition{\n")
+	for i, p := range params {
+		fmt.Fprintf(&buf, "\t{URL: %q, Code: %q, Base: %s, Type: %q", p.URL, p.Code, stringSliceLiteral(p.Base), p.Type)
+		if p.Expression != "" {
+			fmt.Fprintf(&buf, ", Expression: %q", p.Expression)
+		}
+		if len(p.Target) > 0 {
+			fmt.Fprintf(&buf, ", Target: %s", stringSliceLiteral(p.Target))
+		}
+		if len(p.Modifier) > 0 {
+			fmt.Fprintf(&buf, ", Modifier: %s", stringSliceLiteral(p.Modifier))
+		}
+		if len(p.Comparator) > 0 {
+			fmt.Fprintf(&buf, ", Comparator: %s", stringSliceLiteral(p.Comparator))
+		}
+		fmt.Fprintf(&buf, ", MultipleOr: %t", p.MultipleOr == nil || *p.MultipleOr)
+		if len(p.Component) > 0 {
+			fmt.Fprintf(&buf, ", Components: []SearchParameterDefinitionComponent{")
+			for j, c := range p.Component {
+				if j > 0 {
+					fmt.Fprintf(&buf, ", ")
+				}
+				fmt.Fprintf(&buf, "{Definition: %q, Expression: %q}", c.Definition, c.Expression)
+			}
+			fmt.Fprintf(&buf, "}")
+		}
+		fmt.Fprintf(&buf, "},\n")
+
+		resources, err := g.searchParameterResources(p)
+		if err != nil {
+			return fmt.Errorf("search parameter %s: %w", p.URL, err)
+		}
+		for _, name := range resources {
+			index[name] = append(index[name], i)
+		}
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	names := make([]string, 0, len(index))
+	for name := range index {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(&buf, "// searchParameterIndex maps each resource type to the positions of its\n")
+	fmt.Fprintf(&buf, "// parameters in searchParameterDefinitions, including those defined on\n")
+	fmt.Fprintf(&buf, "// Resource and DomainResource.\n")
+	fmt.Fprintf(&buf, "var searchParameterIndex = map[string][]int{\n")
+	for _, name := range names {
+		positions := make([]string, len(index[name]))
+		for i, pos := range index[name] {
+			positions[i] = fmt.Sprint(pos)
+		}
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", name, strings.Join(positions, ", "))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// SearchParametersFor returns the search parameters of a resource type.\n")
+	fmt.Fprintf(&buf, "func SearchParametersFor(resourceType string) []*SearchParameterDefinition {\n")
+	fmt.Fprintf(&buf, "\tpositions := searchParameterIndex[resourceType]\n")
+	fmt.Fprintf(&buf, "\tparams := make([]*SearchParameterDefinition, len(positions))\n")
+	fmt.Fprintf(&buf, "\tfor i, pos := range positions {\n")
+	fmt.Fprintf(&buf, "\t\tparams[i] = &searchParameterDefinitions[pos]\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn params\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// LookupSearchParameter returns the search parameter of a resource type by\n")
+	fmt.Fprintf(&buf, "// its code, such as birthdate for Patient.\n")
+	fmt.Fprintf(&buf, "func LookupSearchParameter(resourceType, code string) (*SearchParameterDefinition, bool) {\n")
+	fmt.Fprintf(&buf, "\tfor _, pos := range searchParameterIndex[resourceType] {\n")
+	fmt.Fprintf(&buf, "\t\tif searchParameterDefinitions[pos].Code == code {\n")
+	fmt.Fprintf(&buf, "\t\t\treturn &searchParameterDefinitions[pos], true\n")
+	fmt.Fprintf(&buf, "\t\t}\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn nil, false\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// SearchParameterByURL returns a search parameter by its canonical URL, as\n")
+	fmt.Fprintf(&buf, "// the components of composite parameters refer to them.\n")
+	fmt.Fprintf(&buf, "func SearchParameterByURL(url string) (*SearchParameterDefinition, bool) {\n")
+	fmt.Fprintf(&buf, "\tfor i := range searchParameterDefinitions {\n")
+	fmt.Fprintf(&buf, "\t\tif searchParameterDefinitions[i].URL == url {\n")
+	fmt.Fprintf(&buf, "\t\t\treturn &searchParameterDefinitions[i], true\n")
+	fmt.Fprintf(&buf, "\t\t}\n")
+	fmt.Fprintf(&buf, "\t}\n")
+	fmt.Fprintf(&buf, "\treturn nil, false\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	return g.writeFormatted("search parameters", "search_parameters.go", buf.Bytes())
+}
+
+func stringSliceLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
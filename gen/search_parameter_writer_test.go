@@ -0,0 +1,100 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func searchParameterTestGenerator(t *testing.T) *Generator {
+	g := NewGenerator(t.TempDir(), t.TempDir())
+	g.Definitions["Bundle"] = StructureDefinition{Name: "Bundle", Kind: "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Resource"}
+	g.Definitions["Patient"] = StructureDefinition{Name: "Patient", Kind: "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/DomainResource"}
+	g.Definitions["Observation"] = StructureDefinition{Name: "Observation", Kind: "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/DomainResource"}
+	g.Definitions["DomainResource"] = StructureDefinition{Name: "DomainResource", Kind: "resource", Abstract: true,
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Resource"}
+	return g
+}
+
+func TestSearchParameterResources(t *testing.T) {
+	g := searchParameterTestGenerator(t)
+	tests := []struct {
+		base []string
+		want []string
+	}{
+		{base: []string{"Resource"}, want: []string{"Bundle", "Observation", "Patient"}},
+		{base: []string{"DomainResource"}, want: []string{"Observation", "Patient"}},
+		{base: []string{"Patient", "Observation"}, want: []string{"Observation", "Patient"}},
+		{base: []string{"Unknown"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.base, ","), func(t *testing.T) {
+			got, err := g.searchParameterResources(SearchParameterResource{Base: tt.base})
+			if err != nil {
+				t.Fatalf("searchParameterResources() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchParameterResources() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadSearchParameters(t *testing.T) {
+	g := searchParameterTestGenerator(t)
+	bundle := `{"resourceType": "Bundle", "entry": [
+		{"resource": {"resourceType": "SearchParameter", "url": "http://example.org/sp/birthdate", "code": "birthdate",
+			"base": ["Patient"], "type": "date", "expression": "Patient.birthDate", "comparator": ["eq", "lt"]}},
+		{"resource": {"resourceType": "CapabilityStatement"}}
+	]}`
+	if err := os.WriteFile(filepath.Join(g.SpecPath, "search-parameters.json"), []byte(bundle), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	params, err := g.LoadSearchParameters()
+	if err != nil {
+		t.Fatalf("LoadSearchParameters() error = %v", err)
+	}
+	if len(params) != 1 || params[0].Code != "birthdate" || !reflect.DeepEqual(params[0].Comparator, []string{"eq", "lt"}) {
+		t.Errorf("LoadSearchParameters() = %+v, want the birthdate parameter", params)
+	}
+}
+
+func TestWriteSearchParameters(t *testing.T) {
+	g := searchParameterTestGenerator(t)
+	multipleOr := false
+	params := []SearchParameterResource{
+		{URL: "http://example.org/sp/id", Code: "_id", Base: []string{"Resource"}, Type: "token", Expression: "Resource.id"},
+		{URL: "http://example.org/sp/birthdate", Code: "birthdate", Base: []string{"Patient"}, Type: "date",
+			Expression: "Patient.birthDate", Comparator: []string{"eq", "lt"}, MultipleOr: &multipleOr},
+		{URL: "http://example.org/sp/code-value", Code: "code-value", Base: []string{"Observation"}, Type: "composite",
+			Expression: "Observation", Component: []SearchParameterComponent{{Definition: "http://example.org/sp/code", Expression: "code"}}},
+	}
+	if err := g.writeSearchParameters(params); err != nil {
+		t.Fatalf("writeSearchParameters() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(g.OutputPath, "search_parameters.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(data)
+
+	for _, want := range []string{
+		`Code: "_id", Base: []string{"Resource"}, Type: "token", Expression: "Resource.id", MultipleOr: true}`,
+		`Comparator: []string{"eq", "lt"}, MultipleOr: false}`,
+		`Components: []SearchParameterDefinitionComponent{{Definition: "http://example.org/sp/code", Expression: "code"}}`,
+		`"Bundle":      {0},`,
+		`"Observation": {0, 2},`,
+		`"Patient":     {0, 1},`,
+		"func LookupSearchParameter(resourceType, code string)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("search_parameters.go does not contain %q:\n%s", want, src)
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package gen
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 type StructureDefinitionBundle struct {
 	ResourceType string        `json:"resourceType"`
 	ID           string        `json:"id"`
@@ -14,12 +19,14 @@ type BundleEntry struct {
 
 type StructureDefinition struct {
 	ID             string   `json:"id"`
+	URL            string   `json:"url,omitempty"`
 	Name           string   `json:"name"`
 	Description    string   `json:"description,omitempty"`
 	Kind           string   `json:"kind"`
 	Type           any      `json:"type,omitempty"`
 	Abstract       bool     `json:"abstract"`
 	BaseDefinition string   `json:"baseDefinition,omitempty"`
+	Derivation     string   `json:"derivation,omitempty"`
 	Snapshot       Snapshot `json:"snapshot"`
 }
 
@@ -30,6 +37,8 @@ type Snapshot struct {
 type ElementDefinition struct {
 	ID               string            `json:"id"`
 	Path             string            `json:"path"`
+	SliceName        string            `json:"sliceName,omitempty"`
+	Slicing          *Slicing          `json:"slicing,omitempty"`
 	Min              int               `json:"min"`
 	Max              string            `json:"max"`
 	Type             []ElementDataType `json:"type,omitempty"`
@@ -37,10 +46,82 @@ type ElementDefinition struct {
 	Short            string            `json:"short"`
 	Definition       string            `json:"definition,omitempty"`
 	Binding          *Binding          `json:"binding,omitempty"`
+	IsModifier       bool              `json:"isModifier,omitempty"`
+	IsSummary        bool              `json:"isSummary,omitempty"`
 	Constraint       []Constraint      `json:"constraint,omitempty"`
 	MaxLength        *int              `json:"maxLength,omitempty"`
 	Pattern          string            `json:"pattern,omitempty"`
 	Fixed            any               `json:"fixed,omitempty"`
+	FixedType        string            `json:"-"`
+	PatternValue     any               `json:"-"`
+	PatternType      string            `json:"-"`
+}
+
+// UnmarshalJSON decodes an element definition with its fixed[x] and
+// pattern[x] values, which the specification names after their type, into
+// Fixed and PatternValue with their types: the element must equal Fixed
+// exactly and contain PatternValue. Pattern is the regular expression of
+// the element's string form, not its pattern[x].
+func (el *ElementDefinition) UnmarshalJSON(data []byte) error {
+	type plain ElementDefinition
+	if err := json.Unmarshal(data, (*plain)(el)); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for key, value := range fields {
+		if typeName, ok := typedKey(key, "fixed"); ok {
+			if err := json.Unmarshal(value, &el.Fixed); err != nil {
+				return err
+			}
+			el.FixedType = valueTypeName(typeName, el.Fixed)
+		}
+		if typeName, ok := typedKey(key, "pattern"); ok {
+			if err := json.Unmarshal(value, &el.PatternValue); err != nil {
+				return err
+			}
+			el.PatternType = valueTypeName(typeName, el.PatternValue)
+		}
+	}
+	return nil
+}
+
+// typedKey returns the type name of a key made of prefix and a type, as in
+// patternCodeableConcept.
+func typedKey(key, prefix string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, prefix)
+	if !ok || rest == "" || rest[0] < 'A' || rest[0] > 'Z' {
+		return "", false
+	}
+	return rest, true
+}
+
+// valueTypeName returns the FHIR type of a fixed or pattern value from the
+// type name of its key: complex types keep their name, primitive types
+// start in lower case, as Uri in fixedUri names uri.
+func valueTypeName(typeName string, value any) string {
+	if _, ok := value.(map[string]any); ok {
+		return typeName
+	}
+	return strings.ToLower(typeName[:1]) + typeName[1:]
+}
+
+// Slicing describes how a profile divides the items of a repeating element
+// into slices, which follow the element in the snapshot with a sliceName.
+type Slicing struct {
+	Discriminator []Discriminator `json:"discriminator,omitempty"`
+	Description   string          `json:"description,omitempty"`
+	Ordered       bool            `json:"ordered,omitempty"`
+	Rules         string          `json:"rules"`
+}
+
+// Discriminator is an element of the items of a sliced element that tells
+// the slices apart, by value, pattern, type, profile or presence.
+type Discriminator struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
 }
 
 type Binding struct {
@@ -59,6 +140,30 @@ type Constraint struct {
 }
 
 type ElementDataType struct {
-	Code          string   `json:"code"`
-	TargetProfile []string `json:"targetProfile,omitempty"`
+	Code          string          `json:"code"`
+	Profile       []string        `json:"profile,omitempty"`
+	TargetProfile []string        `json:"targetProfile,omitempty"`
+	Extension     []TypeExtension `json:"extension,omitempty"`
+}
+
+type TypeExtension struct {
+	URL      string `json:"url"`
+	ValueURL string `json:"valueUrl,omitempty"`
+}
+
+const fhirTypeExtension = "http://hl7.org/fhir/StructureDefinition/structuredefinition-fhir-type"
+
+// FHIRType returns the FHIR type of an element type. Elements such as
+// Element.id and Extension.url are typed with a FHIRPath system type and
+// name their FHIR type in an extension.
+func (t ElementDataType) FHIRType() string {
+	for _, ext := range t.Extension {
+		if ext.URL == fhirTypeExtension && ext.ValueURL != "" {
+			return ext.ValueURL
+		}
+	}
+	if system, ok := strings.CutPrefix(t.Code, "http://hl7.org/fhirpath/System."); ok {
+		return strings.ToLower(system[:1]) + system[1:]
+	}
+	return t.Code
 }
@@ -2,6 +2,7 @@ package gen
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -233,6 +234,26 @@ func TestElementDefinition_ParseMaxLengthPatternFixed(t *testing.T) {
 	}
 }
 
+func TestElementDefinition_ParseTypedFixedPattern(t *testing.T) {
+	var el ElementDefinition
+	data := `{"id": "Observation.code", "path": "Observation.code", "min": 1, "max": "1",
+		"fixedUri": "http://loinc.org", "patternCodeableConcept": {"coding": [{"system": "http://loinc.org", "code": "85354-9"}]},
+		"pattern": "[0-9]+"}`
+	if err := json.Unmarshal([]byte(data), &el); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if el.Fixed != "http://loinc.org" || el.FixedType != "uri" {
+		t.Errorf("Fixed = %v (%s), want http://loinc.org (uri)", el.Fixed, el.FixedType)
+	}
+	want := map[string]any{"coding": []any{map[string]any{"system": "http://loinc.org", "code": "85354-9"}}}
+	if !reflect.DeepEqual(el.PatternValue, want) || el.PatternType != "CodeableConcept" {
+		t.Errorf("PatternValue = %v (%s), want %v (CodeableConcept)", el.PatternValue, el.PatternType, want)
+	}
+	if el.Pattern != "[0-9]+" || el.Min != 1 || el.Path != "Observation.code" {
+		t.Errorf("ElementDefinition = %+v, want the other fields decoded", el)
+	}
+}
+
 func TestElementDefinition_ParseFull(t *testing.T) {
 	jsonData := `{
 		"id": "TestElement.full",
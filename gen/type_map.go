@@ -1,6 +1,7 @@
 package gen
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/gruzdev-dev/fhir/tools/text"
@@ -31,10 +32,15 @@ func (g *Generator) mapGoType(el ElementDefinition) string {
 	case "integer64":
 		return "int64"
 	case "decimal":
-		return "float64"
+		return "Decimal"
 	case "dateTime", "date", "instant", "time":
-		return "string"
-	case "Resource", "ResourceList":
+		return text.TitleCase(fhirType)
+	case "Resource":
+		if g.hasResourceInterface() {
+			return "Resource"
+		}
+		return "json.RawMessage"
+	case "ResourceList":
 		return "json.RawMessage"
 	case "BackboneElement", "Element":
 		return g.deriveNestedTypeName(el.Path)
@@ -55,9 +61,9 @@ func (g *Generator) mapGoType(el ElementDefinition) string {
 				case "Integer64", "Long":
 					return "int64"
 				case "Decimal":
-					return "float64"
+					return "Decimal"
 				case "Date", "DateTime", "Time":
-					return "string"
+					return lastPart
 				default:
 					if text.IsValidGoIdentifier(lastPart) {
 						g.usedTypes[lastPart] = true
@@ -89,6 +95,81 @@ func (g *Generator) deriveNestedTypeName(path string) string {
 	return strings.Join(parts, "")
 }
 
+func isFHIRPrimitive(fhirType string) bool {
+	switch fhirType {
+	case "base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant",
+		"integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt",
+		"uri", "url", "uuid", "xhtml":
+		return true
+	}
+	return false
+}
+
+// hasResourceInterface reports whether the abstract Resource definition is
+// loaded, in which case it is generated as an interface implemented by every
+// concrete resource and resource-typed elements decode polymorphically.
+func (g *Generator) hasResourceInterface() bool {
+	def, ok := g.Definitions["Resource"]
+	return ok && def.Kind == "resource" && def.Abstract
+}
+
+// isInterfaceType reports whether t is generated as a Go interface, so fields
+// of that type are never pointers.
+func (g *Generator) isInterfaceType(t string) bool {
+	switch t {
+	case "Resource", "DomainResource":
+		return g.hasResourceInterface()
+	}
+	return false
+}
+
+// isDomainResource reports whether a resource definition specializes
+// DomainResource, directly or through abstract intermediates. A chain of
+// base definitions that loops back on itself is an error.
+func (g *Generator) isDomainResource(def StructureDefinition) (bool, error) {
+	visited := map[string]bool{def.Name: true}
+	for def.BaseDefinition != "" {
+		baseName := extractBaseTypeName(def.BaseDefinition)
+		if baseName == "DomainResource" {
+			return true, nil
+		}
+		if visited[baseName] {
+			return false, fmt.Errorf("base definition cycle through %s", baseName)
+		}
+		visited[baseName] = true
+		base, ok := g.Definitions[baseName]
+		if !ok {
+			return false, nil
+		}
+		def = base
+	}
+	return false, nil
+}
+
+// isRuntimeType reports whether t is provided by the hand-written runtime
+// files copied into the output package rather than generated from a
+// structure definition.
+func isRuntimeType(t string) bool {
+	switch t {
+	case "Decimal", "Date", "DateTime", "Instant", "Time":
+		return true
+	}
+	return false
+}
+
+// replacedByRuntime reports whether a primitive type definition is fully
+// represented by a runtime type, so no wrapper struct is generated for it.
+func replacedByRuntime(def StructureDefinition) bool {
+	if def.Kind != "primitive-type" {
+		return false
+	}
+	switch def.Name {
+	case "date", "dateTime", "instant", "time":
+		return true
+	}
+	return false
+}
+
 func needsFHIRPrefix(name string) bool {
 	switch name {
 	case "string", "bool", "int", "int64", "float64",
@@ -53,7 +53,7 @@ func TestMapGoType_PrimitiveTypes(t *testing.T) {
 				Path: "TestResource.field",
 				Type: []ElementDataType{{Code: "decimal"}},
 			},
-			want:     "float64",
+			want:     "Decimal",
 			wantUsed: false,
 		},
 		{
@@ -62,7 +62,7 @@ func TestMapGoType_PrimitiveTypes(t *testing.T) {
 				Path: "TestResource.field",
 				Type: []ElementDataType{{Code: "date"}},
 			},
-			want:     "string",
+			want:     "Date",
 			wantUsed: false,
 		},
 		{
@@ -71,7 +71,7 @@ func TestMapGoType_PrimitiveTypes(t *testing.T) {
 				Path: "TestResource.field",
 				Type: []ElementDataType{{Code: "dateTime"}},
 			},
-			want:     "string",
+			want:     "DateTime",
 			wantUsed: false,
 		},
 		{
@@ -359,3 +359,23 @@ func TestMapGoType_UsedTypesIsolation(t *testing.T) {
 		}
 	})
 }
+
+func TestIsDomainResource(t *testing.T) {
+	base := func(name string) string { return "http://hl7.org/fhir/StructureDefinition/" + name }
+	g := NewGenerator("", "")
+	g.Definitions["MetadataResource"] = StructureDefinition{Name: "MetadataResource", Kind: "resource", Abstract: true, BaseDefinition: base("DomainResource")}
+	g.Definitions["Library"] = StructureDefinition{Name: "Library", Kind: "resource", BaseDefinition: base("MetadataResource")}
+	g.Definitions["Bundle"] = StructureDefinition{Name: "Bundle", Kind: "resource", BaseDefinition: base("Resource")}
+	g.Definitions["Loop"] = StructureDefinition{Name: "Loop", Kind: "resource", Abstract: true, BaseDefinition: base("Cycle")}
+	g.Definitions["Cycle"] = StructureDefinition{Name: "Cycle", Kind: "resource", BaseDefinition: base("Loop")}
+
+	for name, want := range map[string]bool{"Library": true, "Bundle": false} {
+		got, err := g.isDomainResource(g.Definitions[name])
+		if err != nil || got != want {
+			t.Errorf("isDomainResource(%s) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+	if _, err := g.isDomainResource(g.Definitions["Cycle"]); err == nil || err.Error() != "base definition cycle through Cycle" {
+		t.Errorf("isDomainResource(Cycle) error = %v, want a base definition cycle", err)
+	}
+}
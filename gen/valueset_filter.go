@@ -3,8 +3,6 @@ package gen
 import (
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"strings"
 )
 
@@ -23,8 +21,7 @@ func (g *Generator) ExtractRequiredValueSets() (map[string]bool, error) {
 }
 
 func (g *Generator) extractRequiredFromFile(filename string, requiredURLs map[string]bool) error {
-	path := filepath.Join(g.SpecPath, filename)
-	data, err := os.ReadFile(path)
+	data, err := g.readSpec(filename)
 	if err != nil {
 		return err
 	}
@@ -3,13 +3,10 @@ package gen
 import (
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 )
 
 func (g *Generator) LoadValueSets() (map[string]ValueSetResource, map[string]CodeSystemResource, error) {
-	path := filepath.Join(g.SpecPath, "valuesets.json")
-	data, err := os.ReadFile(path)
+	data, err := g.readSpec("valuesets.json")
 	if err != nil {
 		return nil, nil, fmt.Errorf("read valuesets.json: %w", err)
 	}
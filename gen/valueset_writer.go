@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"go/format"
 	"os"
-	"path/filepath"
 	"sort"
 	"strings"
 
@@ -19,14 +18,59 @@ type ValueSetConstants struct {
 }
 
 func (g *Generator) GenerateValueSets() error {
+	constants, err := g.buildValueSetConstants()
+	if err != nil {
+		return err
+	}
+	return g.writeConstantsFiles(constants)
+}
+
+// ResolveValueSetTypes records the Go type generated for each required value
+// set, so that code elements bound to it are declared with that type instead
+// of string. It must run before Generate for the bindings to take effect.
+func (g *Generator) ResolveValueSetTypes() error {
+	constants, err := g.buildValueSetConstants()
+	if err != nil {
+		return err
+	}
+
+	g.valueSetTypes = make(map[string]string)
+	g.enumTypes = make(map[string]bool)
+	for _, c := range constants {
+		if g.enumTypes[c.TypeName] {
+			continue
+		}
+		g.enumTypes[c.TypeName] = true
+		g.valueSetTypes[c.URL] = c.TypeName
+	}
+	return nil
+}
+
+// enumType returns the Go type for a code element with a required binding,
+// or "" when the element is not bound to a generated value set type.
+func (g *Generator) enumType(el ElementDefinition) string {
+	if el.Binding == nil || el.Binding.Strength != "required" || el.Binding.ValueSet == "" {
+		return ""
+	}
+	if len(el.Type) != 1 || el.Type[0].Code != "code" {
+		return ""
+	}
+	return g.valueSetTypes[normalizeValueSetURL(el.Binding.ValueSet)]
+}
+
+func (g *Generator) isEnumType(typeName string) bool {
+	return g.enumTypes[typeName]
+}
+
+func (g *Generator) buildValueSetConstants() ([]ValueSetConstants, error) {
 	requiredURLs, err := g.ExtractRequiredValueSets()
 	if err != nil {
-		return fmt.Errorf("extract required value sets: %w", err)
+		return nil, fmt.Errorf("extract required value sets: %w", err)
 	}
 
 	valueSets, codeSystems, err := g.LoadValueSets()
 	if err != nil {
-		return fmt.Errorf("load value sets: %w", err)
+		return nil, fmt.Errorf("load value sets: %w", err)
 	}
 
 	filteredValueSets := make([]ValueSetResource, 0)
@@ -61,10 +105,13 @@ func (g *Generator) GenerateValueSets() error {
 	}
 
 	sort.Slice(constants, func(i, j int) bool {
-		return constants[i].TypeName < constants[j].TypeName
+		if constants[i].TypeName != constants[j].TypeName {
+			return constants[i].TypeName < constants[j].TypeName
+		}
+		return constants[i].URL < constants[j].URL
 	})
 
-	return g.writeConstantsFiles(constants)
+	return constants, nil
 }
 
 func removeDuplicateCodes(codes []Code) []Code {
@@ -180,7 +227,7 @@ func groupByAlphabet(constants []ValueSetConstants) map[string][]ValueSetConstan
 func (g *Generator) writeConstantsFile(filename string, constants []ValueSetConstants) error {
 	var buf bytes.Buffer
 
-	fmt.Fprintf(&buf, "package models\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
 
 	usedConstantNames := make(map[string]bool)
 	usedTypeNames := make(map[string]bool)
@@ -202,8 +249,7 @@ func (g *Generator) writeConstantsFile(filename string, constants []ValueSetCons
 		return fmt.Errorf("format error for %s: %w. Check debug_valueset_failed.go", filename, err)
 	}
 
-	filePath := filepath.Join(g.OutputPath, filename)
-	return os.WriteFile(filePath, formatted, 0644)
+	return g.writeFile(filename, formatted)
 }
 
 func (g *Generator) writeValueSetConstants(buf *bytes.Buffer, c ValueSetConstants, usedConstantNames map[string]bool) error {
@@ -211,6 +257,7 @@ func (g *Generator) writeValueSetConstants(buf *bytes.Buffer, c ValueSetConstant
 	fmt.Fprintf(buf, "type %s string\n\n", c.TypeName)
 	fmt.Fprintf(buf, "const (\n")
 
+	var constantNames []string
 	for _, code := range c.Codes {
 		constantName := normalizeConstantName(c.TypeName, code.Code)
 
@@ -226,8 +273,18 @@ func (g *Generator) writeValueSetConstants(buf *bytes.Buffer, c ValueSetConstant
 		codeValue = strings.ReplaceAll(codeValue, "\"", "\\\"")
 
 		fmt.Fprintf(buf, "\t%s %s = %q\n", constantName, c.TypeName, codeValue)
+		constantNames = append(constantNames, constantName)
 	}
 
-	fmt.Fprintf(buf, ")\n")
+	fmt.Fprintf(buf, ")\n\n")
+
+	fmt.Fprintf(buf, "// IsValid reports whether c is one of the %s codes.\n", c.TypeName)
+	fmt.Fprintf(buf, "func (c %s) IsValid() bool {\n", c.TypeName)
+	fmt.Fprintf(buf, "\tswitch c {\n")
+	fmt.Fprintf(buf, "\tcase %s:\n", strings.Join(constantNames, ",\n\t\t"))
+	fmt.Fprintf(buf, "\t\treturn true\n")
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\treturn false\n")
+	fmt.Fprintf(buf, "}\n")
 	return nil
 }
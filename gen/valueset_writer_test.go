@@ -0,0 +1,34 @@
+package gen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteValueSetConstants_IsValid(t *testing.T) {
+	c := ValueSetConstants{
+		TypeName: "TestStatus",
+		URL:      "http://hl7.org/fhir/ValueSet/test-status",
+		Codes:    []Code{{Code: "final"}, {Code: "entered-in-error"}},
+	}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	if err := g.writeValueSetConstants(&buf, c, make(map[string]bool)); err != nil {
+		t.Fatalf("writeValueSetConstants() error = %v", err)
+	}
+
+	output := buf.String()
+	expected := []string{
+		"type TestStatus string",
+		`TestStatusFinal TestStatus = "final"`,
+		"func (c TestStatus) IsValid() bool {",
+		"case TestStatusFinal,\n\t\tTestStatusEnteredInError:",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+}
@@ -5,14 +5,22 @@ import (
 	"fmt"
 	"go/format"
 	"os"
-	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/gruzdev-dev/fhir/tools/text"
 )
 
 func (g *Generator) WriteResource(def StructureDefinition) error {
+	if replacedByRuntime(def) {
+		return nil
+	}
+	if (def.Name == "Resource" || def.Name == "DomainResource") && def.Abstract && g.hasResourceInterface() {
+		return g.writeResourceInterface(def)
+	}
+
 	var buf bytes.Buffer
 
 	actualName := def.Name
@@ -21,6 +29,11 @@ func (g *Generator) WriteResource(def StructureDefinition) error {
 	}
 
 	structMap := g.ProcessElements(actualName, def.Snapshot.Element, def)
+	elements := snapshotElements(def)
+	var invariants map[string][]Invariant
+	if g.Options.Validation {
+		invariants = g.collectInvariants(actualName, def, structMap)
+	}
 
 	if def.Kind == "resource" {
 		resourceTypeField := FieldInfo{
@@ -41,21 +54,29 @@ func (g *Generator) WriteResource(def StructureDefinition) error {
 	needsFmt := g.needsFmt(structMap)
 
 	for _, fields := range structMap {
+		if needsMarshalJSON(fields) || g.needsUnmarshalJSON(fields) {
+			needsJSON = true
+		}
 		for _, f := range fields {
 			if strings.Contains(f.GoType, "json.RawMessage") {
 				needsJSON = true
 			}
-			if f.Pattern != "" {
+			if f.Pattern != "" && g.Options.Validation {
 				needsRegexp = true
 			}
 		}
 	}
 
-	fmt.Fprintf(&buf, "package models\n\n")
+	needsXML := g.Options.XML && g.writesStruct(actualName, structMap[actualName])
+
+	fmt.Fprintf(&buf, "package %s\n\n", g.Options.PackageName)
 	var imports []string
 	if needsJSON {
 		imports = append(imports, "\"encoding/json\"")
 	}
+	if needsXML {
+		imports = append(imports, "\"encoding/xml\"")
+	}
 	if needsRegexp {
 		imports = append(imports, "\"regexp\"")
 	}
@@ -73,8 +94,11 @@ func (g *Generator) WriteResource(def StructureDefinition) error {
 	usedTypesInFile := make(map[string]bool)
 	for _, fields := range structMap {
 		for _, f := range fields {
+			if f.Choice != nil {
+				continue
+			}
 			baseType := extractBaseType(f.GoType)
-			if baseType != "" && !isBuiltinType(baseType) {
+			if baseType != "" && !isBuiltinType(baseType) && !isRuntimeType(baseType) && !g.isEnumType(baseType) {
 				usedTypesInFile[baseType] = true
 			}
 		}
@@ -96,12 +120,34 @@ func (g *Generator) WriteResource(def StructureDefinition) error {
 	}
 
 	g.writeStruct(&buf, actualName, def.Description, structMap[actualName])
-	g.writeValidateMethod(&buf, actualName, structMap[actualName], structMap)
-
-	for sName, fields := range structMap {
-		if sName == actualName {
-			continue
+	writeReferenceTargets(&buf, actualName, g.checkedFields(structMap[actualName]))
+	if def.Kind == "resource" {
+		g.writeValidateEntry(&buf, actualName, def.Name, g.checkedFields(structMap[actualName]), structMap)
+	} else {
+		g.writeValidateMethod(&buf, actualName, g.checkedFields(structMap[actualName]), structMap)
+	}
+	g.writeValidateAllMethod(&buf, actualName, g.checkedFields(structMap[actualName]), structMap)
+	g.writeValidateAllEntry(&buf, actualName, def.Name)
+	writeInvariants(&buf, actualName, invariants[actualName])
+	g.writeMarshalJSON(&buf, actualName, structMap[actualName])
+	g.writeUnmarshalJSON(&buf, actualName, structMap[actualName])
+	if g.Options.XML {
+		g.writeXMLMethods(&buf, actualName, structMap[actualName])
+	}
+	if g.Options.BSONTags {
+		g.writeBSONMethods(&buf, actualName, structMap[actualName])
+	}
+	writeChoiceVariants(&buf, actualName, structMap[actualName])
+	g.writeElementTypes(&buf, actualName, structMap[actualName])
+	g.writeElementMetadata(&buf, actualName, structMap[actualName], elements)
+	g.writeChoiceTypes(&buf, structMap[actualName])
+	if def.Kind == "resource" && !def.Abstract && g.hasResourceInterface() {
+		if err := g.writeResourceMethods(&buf, def, structMap[actualName]); err != nil {
+			return err
 		}
+	}
+
+	for _, sName := range nestedStructOrder(actualName, structMap) {
 		if _, defined := g.Definitions[sName]; defined {
 			continue
 		}
@@ -111,8 +157,24 @@ func (g *Generator) WriteResource(def StructureDefinition) error {
 				continue
 			}
 		}
+		fields := structMap[sName]
 		g.writeStruct(&buf, sName, "", fields)
-		g.writeValidateMethod(&buf, sName, fields, structMap)
+		writeReferenceTargets(&buf, sName, g.checkedFields(fields))
+		g.writeValidateMethod(&buf, sName, g.checkedFields(fields), structMap)
+		g.writeValidateAllMethod(&buf, sName, g.checkedFields(fields), structMap)
+		writeInvariants(&buf, sName, invariants[sName])
+		g.writeMarshalJSON(&buf, sName, fields)
+		g.writeUnmarshalJSON(&buf, sName, fields)
+		if g.Options.XML {
+			g.writeXMLMethods(&buf, sName, fields)
+		}
+		if g.Options.BSONTags {
+			g.writeBSONMethods(&buf, sName, fields)
+		}
+		writeChoiceVariants(&buf, sName, fields)
+		g.writeElementTypes(&buf, sName, fields)
+		g.writeElementMetadata(&buf, sName, fields, elements)
+		g.writeChoiceTypes(&buf, fields)
 	}
 
 	formatted, err := format.Source(buf.Bytes())
@@ -123,7 +185,46 @@ func (g *Generator) WriteResource(def StructureDefinition) error {
 	}
 
 	fileName := text.ToSnakeCase(actualName) + ".go"
-	return os.WriteFile(filepath.Join(g.OutputPath, fileName), formatted, 0644)
+	return g.writeFile(fileName, formatted)
+}
+
+// nestedStructOrder returns the structs of a file other than root in the
+// order they are first reached from root through field types, followed by
+// any left over in name order, so that a file is written the same on every
+// run.
+func nestedStructOrder(root string, structMap map[string][]FieldInfo) []string {
+	seen := map[string]bool{root: true}
+	var order []string
+	var visit func(name string)
+	visit = func(name string) {
+		for _, f := range structMap[name] {
+			baseType := extractBaseType(f.GoType)
+			if _, ok := structMap[baseType]; !ok || seen[baseType] {
+				continue
+			}
+			seen[baseType] = true
+			order = append(order, baseType)
+			visit(baseType)
+		}
+	}
+	visit(root)
+	var rest []string
+	for name := range structMap {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(order, rest...)
+}
+
+// checkedFields returns the fields validation checks, which are none when
+// validation is not generated.
+func (g *Generator) checkedFields(fields []FieldInfo) []FieldInfo {
+	if !g.Options.Validation {
+		return nil
+	}
+	return fields
 }
 
 func (g *Generator) writeStruct(buf *bytes.Buffer, name, comment string, fields []FieldInfo) {
@@ -143,7 +244,7 @@ func (g *Generator) writeStruct(buf *bytes.Buffer, name, comment string, fields
 	for _, f := range fields {
 		goType := f.GoType
 		if after, ok := strings.CutPrefix(goType, "[]"); ok {
-			baseType := after
+			baseType := strings.TrimPrefix(after, "*")
 			if !text.IsValidGoIdentifier(baseType) && baseType != "any" && baseType != "json.RawMessage" {
 				goType = "[]any"
 			}
@@ -169,7 +270,7 @@ func (g *Generator) writeStruct(buf *bytes.Buffer, name, comment string, fields
 		var tagParts []string
 		tagParts = append(tagParts, fmt.Sprintf("json:\"%s\"", jsonTagValue))
 
-		if f.BSONTag != "" {
+		if f.BSONTag != "" && g.Options.BSONTags {
 			bsonTagValue := strings.TrimPrefix(strings.TrimSuffix(f.BSONTag, "`"), "`bson:")
 			bsonTagValue = strings.Trim(bsonTagValue, "\"")
 			tagParts = append(tagParts, fmt.Sprintf("bson:\"%s\"", bsonTagValue))
@@ -178,6 +279,14 @@ func (g *Generator) writeStruct(buf *bytes.Buffer, name, comment string, fields
 		tags := "`" + strings.Join(tagParts, " ") + "`"
 		fmt.Fprintf(buf, "\t%s %s %s%s\n", f.Name, goType, tags, commentPart)
 	}
+	if hasChoiceFields(fields) {
+		fmt.Fprintf(buf, "\n")
+		for _, f := range fields {
+			if f.Choice != nil {
+				fmt.Fprintf(buf, "\t%s []string // JSON properties of %s when more than one was decoded\n", conflictField(f), f.Path)
+			}
+		}
+	}
 	fmt.Fprintf(buf, "}\n\n")
 }
 
@@ -231,13 +340,33 @@ func isBuiltinType(t string) bool {
 	return false
 }
 
+// needsFmt reports whether the code written for the structs of a file uses
+// fmt: decoding resource fields, repeating primitives and choice elements
+// with a "_" companion wraps errors, and validation formats its messages.
 func (g *Generator) needsFmt(structMap map[string][]FieldInfo) bool {
+	for _, fields := range structMap {
+		if len(choiceElements(fields)) > 0 || len(primitiveArrays(fields)) > 0 {
+			return true
+		}
+		for _, f := range fields {
+			if g.isInterfaceType(extractBaseType(f.GoType)) {
+				return true
+			}
+		}
+	}
+	if !g.Options.Validation {
+		return false
+	}
 	for _, fields := range structMap {
 		if len(fields) == 0 {
 			continue
 		}
 
 		for _, f := range fields {
+			if f.Choice != nil {
+				return true
+			}
+
 			baseType := extractBaseType(f.GoType)
 			isArray := strings.HasPrefix(f.GoType, "[]")
 			isPointer := strings.HasPrefix(f.GoType, "*")
@@ -259,7 +388,11 @@ func (g *Generator) needsFmt(structMap map[string][]FieldInfo) bool {
 				return true
 			}
 
-			if f.Fixed != nil {
+			if f.Fixed != nil || f.PatternValue != nil || len(f.Targets) > 0 {
+				return true
+			}
+
+			if isRuntimeType(baseType) || g.isEnumType(baseType) || g.isInterfaceType(baseType) {
 				return true
 			}
 
@@ -284,23 +417,68 @@ func (g *Generator) needsFmt(structMap map[string][]FieldInfo) bool {
 }
 
 func (g *Generator) writeValidateMethod(buf *bytes.Buffer, structName string, fields []FieldInfo, structMap map[string][]FieldInfo) {
-	fmt.Fprintf(buf, "func (r *%s) Validate() error {\n", structName)
+	g.writeValidation(buf, structName, "", fields, structMap, false)
+}
+
+// writeValidateEntry writes Validate for a resource. After the checks of
+// its own fields it evaluates the invariants of the resource and of every
+// element below it, rooting their paths at its FHIR name. Data types leave
+// their invariants to the resource holding them, which can resolve
+// %resource and local references.
+func (g *Generator) writeValidateEntry(buf *bytes.Buffer, structName, fhirName string, fields []FieldInfo, structMap map[string][]FieldInfo) {
+	g.writeValidation(buf, structName, fhirName, fields, structMap, false)
+}
+
+// writeValidateAllMethod writes validateAll, which runs the same checks as
+// Validate but records every failure as an issue located by the FHIRPath of
+// the offending element instead of returning the first one.
+func (g *Generator) writeValidateAllMethod(buf *bytes.Buffer, structName string, fields []FieldInfo, structMap map[string][]FieldInfo) {
+	g.writeValidation(buf, structName, "", fields, structMap, true)
+}
+
+// writeValidateAllEntry writes the exported ValidateAll method of a type
+// generated from a structure definition, rooting paths at its FHIR name.
+func (g *Generator) writeValidateAllEntry(buf *bytes.Buffer, structName, fhirName string) {
+	fmt.Fprintf(buf, "func (r *%s) ValidateAll() ValidationIssues {\n", structName)
+	fmt.Fprintf(buf, "\tvar issues ValidationIssues\n")
+	fmt.Fprintf(buf, "\tr.validateAll(%q, &issues)\n", fhirName)
+	if g.Options.Validation {
+		fmt.Fprintf(buf, "\tcheckInvariants(r, %q, &issues)\n", fhirName)
+	}
+	fmt.Fprintf(buf, "\treturn issues\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+func (g *Generator) writeValidation(buf *bytes.Buffer, structName, fhirName string, fields []FieldInfo, structMap map[string][]FieldInfo, collect bool) {
+	w := &validationWriter{buf: buf, collect: collect, jsonNames: elementNames(fields)}
+	if g.Options.Validation {
+		w.invariantsPath = fhirName
+	}
+	if collect {
+		fmt.Fprintf(buf, "func (r *%s) validateAll(path string, issues *ValidationIssues) {\n", structName)
+	} else {
+		fmt.Fprintf(buf, "func (r *%s) Validate() error {\n", structName)
+	}
 
 	if len(fields) == 0 {
-		fmt.Fprintf(buf, "\treturn nil\n")
-		fmt.Fprintf(buf, "}\n\n")
+		w.end()
 		return
 	}
 
 	emptyStringDeclared := false
 
 	for _, f := range fields {
+		at := w.at(f.Name, false)
 		if f.Name == "ResourceType" && f.GoType == "string" {
 			fmt.Fprintf(buf, "\tif r.ResourceType != \"%s\" {\n", structName)
-			fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"invalid resourceType: expected '%s', got '%%s'\", r.ResourceType)\n", structName)
+			w.fail("\t\t", "invalid", at, fmt.Sprintf("invalid resourceType: expected '%s', got '%%s'", structName), "r.ResourceType")
 			fmt.Fprintf(buf, "\t}\n")
 			continue
 		}
+		if f.Choice != nil {
+			w.choice(f)
+			continue
+		}
 
 		baseType := extractBaseType(f.GoType)
 		isArray := strings.HasPrefix(f.GoType, "[]")
@@ -314,69 +492,61 @@ func (g *Generator) writeValidateMethod(buf *bytes.Buffer, structName string, fi
 		if f.IsRequired {
 			if isArray {
 				fmt.Fprintf(buf, "\tif len(r.%s) < %d {\n", f.Name, f.Min)
-				fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' must have at least %d elements\")\n", f.Name, f.Min)
+				w.fail("\t\t", "required", at, fmt.Sprintf("field '%s' must have at least %d elements", f.Name, f.Min))
 				fmt.Fprintf(buf, "\t}\n")
 			} else if isPointer {
 				fmt.Fprintf(buf, "\tif r.%s == nil {\n", f.Name)
-				fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' is required\")\n", f.Name)
+				w.fail("\t\t", "required", at, fmt.Sprintf("field '%s' is required", f.Name))
 				fmt.Fprintf(buf, "\t}\n")
 			} else if isBuiltin {
 				switch baseType {
 				case "string":
-					if isPointer {
-						fmt.Fprintf(buf, "\tif r.%s == nil || *r.%s == \"\" {\n", f.Name, f.Name)
-						fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' is required\")\n", f.Name)
-						fmt.Fprintf(buf, "\t}\n")
-					} else {
-						if !emptyStringDeclared {
-							fmt.Fprintf(buf, "\tvar emptyString string\n")
-							emptyStringDeclared = true
-						}
-						fmt.Fprintf(buf, "\tif r.%s == emptyString {\n", f.Name)
-						fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' is required\")\n", f.Name)
-						fmt.Fprintf(buf, "\t}\n")
+					if !emptyStringDeclared {
+						fmt.Fprintf(buf, "\tvar emptyString string\n")
+						emptyStringDeclared = true
 					}
+					fmt.Fprintf(buf, "\tif r.%s == emptyString {\n", f.Name)
+					w.fail("\t\t", "required", at, fmt.Sprintf("field '%s' is required", f.Name))
+					fmt.Fprintf(buf, "\t}\n")
 				case "bool":
 				default:
-					if isPointer {
-						fmt.Fprintf(buf, "\tif r.%s == nil {\n", f.Name)
-						fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' is required\")\n", f.Name)
-						fmt.Fprintf(buf, "\t}\n")
-					} else {
-						fmt.Fprintf(buf, "\tif r.%s == 0 {\n", f.Name)
-						fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' is required\")\n", f.Name)
-						fmt.Fprintf(buf, "\t}\n")
-					}
+					fmt.Fprintf(buf, "\tif r.%s == 0 {\n", f.Name)
+					w.fail("\t\t", "required", at, fmt.Sprintf("field '%s' is required", f.Name))
+					fmt.Fprintf(buf, "\t}\n")
 				}
+			} else if g.isEnumType(baseType) {
+				fmt.Fprintf(buf, "\tif r.%s == \"\" {\n", f.Name)
+				w.fail("\t\t", "required", at, fmt.Sprintf("field '%s' is required", f.Name))
+				fmt.Fprintf(buf, "\t}\n")
 			}
 		}
 
+		if g.isEnumType(baseType) {
+			w.enum(f, isArray, isPointer)
+		}
+
 		if f.MaxLength != nil && (baseType == "string" || (isPointer && baseType == "string")) {
+			if !emptyStringDeclared {
+				fmt.Fprintf(buf, "\tvar emptyString string\n")
+				emptyStringDeclared = true
+			}
 			if isPointer {
-				if !emptyStringDeclared {
-					fmt.Fprintf(buf, "\tvar emptyString string\n")
-					emptyStringDeclared = true
-				}
 				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
 				fmt.Fprintf(buf, "\t\tvalStr := \"\"\n")
 				fmt.Fprintf(buf, "\t\tif *r.%s != emptyString {\n", f.Name)
 				fmt.Fprintf(buf, "\t\t\tvalStr = fmt.Sprint(*r.%s)\n", f.Name)
 				fmt.Fprintf(buf, "\t\t}\n")
 				fmt.Fprintf(buf, "\t\tif len(valStr) > %d {\n", *f.MaxLength)
-				fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"field '%s' exceeds maxLength %d\")\n", f.Name, *f.MaxLength)
+				w.fail("\t\t\t", "value", at, fmt.Sprintf("field '%s' exceeds maxLength %d", f.Name, *f.MaxLength))
 				fmt.Fprintf(buf, "\t\t}\n")
 				fmt.Fprintf(buf, "\t}\n")
 			} else {
-				if !emptyStringDeclared {
-					fmt.Fprintf(buf, "\tvar emptyString string\n")
-					emptyStringDeclared = true
-				}
 				fmt.Fprintf(buf, "\tvalStr := \"\"\n")
 				fmt.Fprintf(buf, "\tif r.%s != emptyString {\n", f.Name)
 				fmt.Fprintf(buf, "\t\tvalStr = fmt.Sprint(r.%s)\n", f.Name)
 				fmt.Fprintf(buf, "\t}\n")
 				fmt.Fprintf(buf, "\tif len(valStr) > %d {\n", *f.MaxLength)
-				fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' exceeds maxLength %d\")\n", f.Name, *f.MaxLength)
+				w.fail("\t\t", "value", at, fmt.Sprintf("field '%s' exceeds maxLength %d", f.Name, *f.MaxLength))
 				fmt.Fprintf(buf, "\t}\n")
 			}
 		}
@@ -386,7 +556,7 @@ func (g *Generator) writeValidateMethod(buf *bytes.Buffer, structName string, fi
 				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
 				fmt.Fprintf(buf, "\t\tmatched, _ := regexp.MatchString(`%s`, *r.%s)\n", f.Pattern, f.Name)
 				fmt.Fprintf(buf, "\t\tif !matched {\n")
-				fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"field '%s' does not match pattern '%s'\")\n", f.Name, f.Pattern)
+				w.fail("\t\t\t", "value", at, fmt.Sprintf("field '%s' does not match pattern '%s'", f.Name, f.Pattern))
 				fmt.Fprintf(buf, "\t\t}\n")
 				fmt.Fprintf(buf, "\t}\n")
 			} else {
@@ -400,59 +570,489 @@ func (g *Generator) writeValidateMethod(buf *bytes.Buffer, structName string, fi
 				fmt.Fprintf(buf, "\t}\n")
 				fmt.Fprintf(buf, "\tmatched, _ := regexp.MatchString(`%s`, valStr)\n", f.Pattern)
 				fmt.Fprintf(buf, "\tif !matched {\n")
-				fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' does not match pattern '%s'\")\n", f.Name, f.Pattern)
+				w.fail("\t\t", "value", at, fmt.Sprintf("field '%s' does not match pattern '%s'", f.Name, f.Pattern))
 				fmt.Fprintf(buf, "\t}\n")
 			}
 		}
 
-		if f.Fixed != nil {
+		if _, complexValue := f.Fixed.(map[string]any); f.Fixed != nil && !complexValue && !isArray {
 			if isPointer {
 				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
-				g.writeFixedValidation(buf, f.Name, f.Fixed, baseType, true)
+				g.writeFixedValidation(w, f, baseType, true)
 				fmt.Fprintf(buf, "\t}\n")
 			} else {
-				g.writeFixedValidation(buf, f.Name, f.Fixed, baseType, false)
+				g.writeFixedValidation(w, f, baseType, false)
 			}
+		} else if f.Fixed != nil {
+			w.valueMatch(f, "matchesFixedValue", "must be", valueJSON(f.Fixed), isArray, isPointer)
+		}
+		if f.PatternValue != nil {
+			w.valueMatch(f, "matchesPatternValue", "does not match the pattern", valueJSON(f.PatternValue), isArray, isPointer)
+		}
+		if len(f.Targets) > 0 {
+			w.referenceTarget(f, referenceTargetsVar(structName, f), isArray, isPointer)
 		}
 
-		if !isBuiltin && !isArray && isPointer {
-			if _, exists := structMap[baseType]; exists {
-				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
-				fmt.Fprintf(buf, "\t\tif err := r.%s.Validate(); err != nil {\n", f.Name)
-				fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"%s: %%w\", err)\n", f.Name)
+		switch {
+		case g.isInterfaceType(baseType):
+			if isArray {
+				fmt.Fprintf(buf, "\tfor i, item := range r.%s {\n", f.Name)
+				fmt.Fprintf(buf, "\t\tif item == nil {\n")
+				fmt.Fprintf(buf, "\t\t\tcontinue\n")
 				fmt.Fprintf(buf, "\t\t}\n")
+				w.resource("\t\t", "item", w.at(f.Name, true))
 				fmt.Fprintf(buf, "\t}\n")
-			} else if def, ok := g.Definitions[baseType]; ok && def.Name != "" {
+			} else {
 				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
-				fmt.Fprintf(buf, "\t\tif err := r.%s.Validate(); err != nil {\n", f.Name)
-				fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"%s: %%w\", err)\n", f.Name)
-				fmt.Fprintf(buf, "\t\t}\n")
+				w.resource("\t\t", "r."+f.Name, at)
 				fmt.Fprintf(buf, "\t}\n")
 			}
-		} else if !isBuiltin && isArray {
-			if _, exists := structMap[baseType]; exists {
+		case isRuntimeType(baseType):
+			if isArray {
 				fmt.Fprintf(buf, "\tfor i, item := range r.%s {\n", f.Name)
-				fmt.Fprintf(buf, "\t\tif err := item.Validate(); err != nil {\n")
-				fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"%s[%%d]: %%w\", i, err)\n", f.Name)
-				fmt.Fprintf(buf, "\t\t}\n")
+				w.primitive("\t\t", "item", w.at(f.Name, true))
 				fmt.Fprintf(buf, "\t}\n")
-			} else if def, ok := g.Definitions[baseType]; ok && def.Name != "" {
+			} else if isPointer {
+				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
+				w.primitive("\t\t", "r."+f.Name, at)
+				fmt.Fprintf(buf, "\t}\n")
+			}
+		case !isBuiltin && (isArray || isPointer) && g.hasValidate(baseType, structMap):
+			if isArray {
 				fmt.Fprintf(buf, "\tfor i, item := range r.%s {\n", f.Name)
-				fmt.Fprintf(buf, "\t\tif err := item.Validate(); err != nil {\n")
-				fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"%s[%%d]: %%w\", i, err)\n", f.Name)
-				fmt.Fprintf(buf, "\t\t}\n")
+				writeNilItemSkip(buf, f.GoType)
+				w.nested("\t\t", "item", w.at(f.Name, true))
+				fmt.Fprintf(buf, "\t}\n")
+			} else {
+				fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
+				w.nested("\t\t", "r."+f.Name, at)
 				fmt.Fprintf(buf, "\t}\n")
 			}
 		}
 	}
 
+	w.end()
+}
+
+// hasValidate reports whether a generated struct type has Validate and
+// validateAll methods.
+func (g *Generator) hasValidate(baseType string, structMap map[string][]FieldInfo) bool {
+	if _, exists := structMap[baseType]; exists {
+		return true
+	}
+	def, ok := g.Definitions[baseType]
+	return ok && def.Name != ""
+}
+
+// elementNames maps Go field names to the FHIR element names used in
+// issue paths. "_name" companions share the name of their value.
+func elementNames(fields []FieldInfo) map[string]string {
+	names := make(map[string]string)
+	for _, f := range fields {
+		name := strings.Split(strings.Trim(strings.TrimPrefix(strings.Trim(f.JSONTag, "`"), "json:"), "\""), ",")[0]
+		if f.Choice != nil {
+			name = f.Choice.JSONName
+		}
+		names[f.Name] = strings.TrimPrefix(name, "_")
+	}
+	for _, f := range fields {
+		if f.ElementOf != "" {
+			names[f.Name] = names[f.ElementOf]
+		}
+	}
+	return names
+}
+
+// validationWriter emits the body of either Validate, which returns the
+// first failure as an error, or validateAll, which records each failure in
+// issues and carries on.
+type validationWriter struct {
+	buf       *bytes.Buffer
+	collect   bool
+	jsonNames map[string]string
+	// invariantsPath is the FHIR name Validate evaluates invariants from,
+	// empty for types that are only validated as part of another and
+	// without the Validation option.
+	invariantsPath string
+}
+
+// location identifies the element a check applies to. Indexed locations
+// refer to item i of a repeating element.
+type location struct {
+	field   string
+	element string
+	indexed bool
+}
+
+func (w *validationWriter) at(field string, indexed bool) location {
+	return location{field: field, element: w.jsonNames[field], indexed: indexed}
+}
+
+// goName is the field reference used in Validate error messages.
+func (l location) goName() string {
+	if l.indexed {
+		return l.field + "[%d]"
+	}
+	return l.field
+}
+
+// path is the Go expression for the FHIRPath of the element.
+func (l location) path() string {
+	if l.indexed {
+		return fmt.Sprintf("fmt.Sprintf(\"%%s.%s[%%d]\", path, i)", l.element)
+	}
+	return fmt.Sprintf("path + \".%s\"", l.element)
+}
+
+func (w *validationWriter) end() {
+	switch {
+	case w.collect:
+	case w.invariantsPath != "":
+		fmt.Fprintf(w.buf, "\treturn validateInvariants(r, %q)\n", w.invariantsPath)
+	default:
+		fmt.Fprintf(w.buf, "\treturn nil\n")
+	}
+	fmt.Fprintf(w.buf, "}\n\n")
+}
+
+// fail writes the statement for a failed check. format and args form the
+// message, as for fmt.Errorf.
+func (w *validationWriter) fail(indent, code string, at location, format string, args ...string) {
+	argList := ""
+	if len(args) > 0 {
+		argList = ", " + strings.Join(args, ", ")
+	}
+	if !w.collect {
+		fmt.Fprintf(w.buf, "%sreturn fmt.Errorf(\"%s\"%s)\n", indent, format, argList)
+		return
+	}
+	message := fmt.Sprintf("\"%s\"", format)
+	if len(args) > 0 {
+		message = fmt.Sprintf("fmt.Sprintf(\"%s\"%s)", format, argList)
+	}
+	fmt.Fprintf(w.buf, "%sissues.add(%q, %s, %s)\n", indent, code, at.path(), message)
+}
+
+// nested validates a generated struct value held in recv.
+func (w *validationWriter) nested(indent, recv string, at location) {
+	if w.collect {
+		fmt.Fprintf(w.buf, "%s%s.validateAll(%s, issues)\n", indent, recv, at.path())
+		return
+	}
+	w.wrapError(indent, recv, at)
+}
+
+// primitive validates a runtime primitive, such as a Decimal or DateTime.
+func (w *validationWriter) primitive(indent, recv string, at location) {
+	if w.collect {
+		fmt.Fprintf(w.buf, "%sif err := %s.Validate(); err != nil {\n", indent, recv)
+		fmt.Fprintf(w.buf, "%s\tissues.add(\"value\", %s, err.Error())\n", indent, at.path())
+		fmt.Fprintf(w.buf, "%s}\n", indent)
+		return
+	}
+	w.wrapError(indent, recv, at)
+}
+
+// resource validates a nested resource, rebasing its issues onto the path
+// of the element holding it.
+func (w *validationWriter) resource(indent, recv string, at location) {
+	if w.collect {
+		fmt.Fprintf(w.buf, "%sissues.addNested(%s, %s.ValidateAll())\n", indent, at.path(), recv)
+		return
+	}
+	w.wrapError(indent, recv, at)
+}
+
+func (w *validationWriter) wrapError(indent, recv string, at location) {
+	index := ""
+	if at.indexed {
+		index = ", i"
+	}
+	fmt.Fprintf(w.buf, "%sif err := %s.Validate(); err != nil {\n", indent, recv)
+	fmt.Fprintf(w.buf, "%s\treturn fmt.Errorf(\"%s: %%w\"%s, err)\n", indent, at.goName(), index)
+	fmt.Fprintf(w.buf, "%s}\n", indent)
+}
+
+func (w *validationWriter) enum(f FieldInfo, isArray, isPointer bool) {
+	buf := w.buf
+	at := w.at(f.Name, false)
+	switch {
+	case isArray:
+		fmt.Fprintf(buf, "\tfor i, item := range r.%s {\n", f.Name)
+		fmt.Fprintf(buf, "\t\tif !item.IsValid() {\n")
+		w.fail("\t\t\t", "code-invalid", w.at(f.Name, true), fmt.Sprintf("field '%s[%%d]' has invalid code '%%s'", f.Name), "i", "item")
+		fmt.Fprintf(buf, "\t\t}\n")
+		fmt.Fprintf(buf, "\t}\n")
+	case isPointer:
+		fmt.Fprintf(buf, "\tif r.%s != nil && !r.%s.IsValid() {\n", f.Name, f.Name)
+		w.fail("\t\t", "code-invalid", at, fmt.Sprintf("field '%s' has invalid code '%%s'", f.Name), "*r."+f.Name)
+		fmt.Fprintf(buf, "\t}\n")
+	case f.IsRequired && !w.collect:
+		fmt.Fprintf(buf, "\tif !r.%s.IsValid() {\n", f.Name)
+		w.fail("\t\t", "code-invalid", at, fmt.Sprintf("field '%s' has invalid code '%%s'", f.Name), "r."+f.Name)
+		fmt.Fprintf(buf, "\t}\n")
+	default:
+		fmt.Fprintf(buf, "\tif r.%s != \"\" && !r.%s.IsValid() {\n", f.Name, f.Name)
+		w.fail("\t\t", "code-invalid", at, fmt.Sprintf("field '%s' has invalid code '%%s'", f.Name), "r."+f.Name)
+		fmt.Fprintf(buf, "\t}\n")
+	}
+}
+
+// valueMatch checks a complex or repeating element against the JSON of its
+// fixed[x] or pattern[x] with the runtime function match, item by item for
+// repeating elements.
+func (w *validationWriter) valueMatch(f FieldInfo, match, failure, value string, isArray, isPointer bool) {
+	buf := w.buf
+	literal := strconv.Quote(value)
+	switch {
+	case isArray:
+		fmt.Fprintf(buf, "\tfor i, item := range r.%s {\n", f.Name)
+		fmt.Fprintf(buf, "\t\tif !%s(item, %s) {\n", match, literal)
+		w.fail("\t\t\t", "value", w.at(f.Name, true), fmt.Sprintf("field '%s[%%d]' %s %%s", f.Name, failure), "i", literal)
+		fmt.Fprintf(buf, "\t\t}\n")
+		fmt.Fprintf(buf, "\t}\n")
+	case isPointer:
+		fmt.Fprintf(buf, "\tif r.%s != nil && !%s(r.%s, %s) {\n", f.Name, match, f.Name, literal)
+		w.fail("\t\t", "value", w.at(f.Name, false), fmt.Sprintf("field '%s' %s %%s", f.Name, failure), literal)
+		fmt.Fprintf(buf, "\t}\n")
+	default:
+		fmt.Fprintf(buf, "\tif !%s(r.%s, %s) {\n", match, f.Name, literal)
+		w.fail("\t\t", "value", w.at(f.Name, false), fmt.Sprintf("field '%s' %s %%s", f.Name, failure), literal)
+		fmt.Fprintf(buf, "\t}\n")
+	}
+}
+
+// referenceTarget checks that the literal reference and type of a Reference
+// or CodeableReference element name one of the resource types in its
+// targets table.
+func (w *validationWriter) referenceTarget(f FieldInfo, targets string, isArray, isPointer bool) {
+	buf := w.buf
+	indent, recv, at, name := "\t", "r."+f.Name, w.at(f.Name, false), f.Name
+	args := []string{"target", targets}
+	var guards []string
+	if isArray {
+		fmt.Fprintf(buf, "\tfor i, item := range r.%s {\n", f.Name)
+		writeNilItemSkip(buf, f.GoType)
+		indent, recv, at, name = "\t\t", "item", w.at(f.Name, true), f.Name+"[%d]"
+		args = append([]string{"i"}, args...)
+	} else if isPointer {
+		guards = append(guards, recv+" != nil")
+	}
+	if extractBaseType(f.GoType) == "CodeableReference" {
+		recv += ".Reference"
+		guards = append(guards, recv+" != nil")
+	}
+	inner := indent
+	if len(guards) > 0 {
+		fmt.Fprintf(buf, "%sif %s {\n", indent, strings.Join(guards, " && "))
+		inner += "\t"
+	}
+	fmt.Fprintf(buf, "%sif target := invalidReferenceTarget(%s.Reference, %s.Type, %s); target != \"\" {\n", inner, recv, recv, targets)
+	w.fail(inner+"\t", "value", at, fmt.Sprintf("field '%s' cannot refer to %%s, expected one of %%v", name), args...)
+	fmt.Fprintf(buf, "%s}\n", inner)
+	if len(guards) > 0 {
+		fmt.Fprintf(buf, "%s}\n", indent)
+	}
+	if isArray {
+		fmt.Fprintf(buf, "\t}\n")
+	}
+}
+
+// choice checks that a choice element was decoded from a single variant,
+// is present when required, and holds a valid value.
+func (w *validationWriter) choice(f FieldInfo) {
+	buf := w.buf
+	at := w.at(f.Name, false)
+	conflicts := conflictField(f)
+	fmt.Fprintf(buf, "\tif len(r.%s) > 1 {\n", conflicts)
+	w.fail("\t\t", "structure", at, fmt.Sprintf("field '%s' must have a single type, got %%v", f.Name), "r."+conflicts)
+	fmt.Fprintf(buf, "\t}\n")
+	if f.IsRequired {
+		fmt.Fprintf(buf, "\tif r.%s == nil {\n", f.Name)
+		w.fail("\t\t", "required", at, fmt.Sprintf("field '%s' is required", f.Name))
+		fmt.Fprintf(buf, "\t}\n")
+	}
+	fmt.Fprintf(buf, "\tif r.%s != nil {\n", f.Name)
+	if w.collect {
+		fmt.Fprintf(buf, "\t\tr.%s.validateAll(path+\".%s.ofType(\"+r.%s.FHIRType()+\")\", issues)\n", f.Name, at.element, f.Name)
+	} else {
+		w.wrapError("\t\t", "r."+f.Name, at)
+	}
+	fmt.Fprintf(buf, "\t}\n")
+}
+
+func writeNilItemSkip(buf *bytes.Buffer, goType string) {
+	if strings.HasPrefix(goType, "[]*") {
+		fmt.Fprintf(buf, "\t\tif item == nil {\n")
+		fmt.Fprintf(buf, "\t\t\tcontinue\n")
+		fmt.Fprintf(buf, "\t\t}\n")
+	}
+}
+
+// primitiveArrays returns the "_name" companions of the repeating primitives
+// of a struct, which must be null-aligned with their values in JSON.
+func primitiveArrays(fields []FieldInfo) []FieldInfo {
+	var companions []FieldInfo
+	for _, f := range fields {
+		if f.ElementOf != "" && strings.HasPrefix(f.GoType, "[]") {
+			companions = append(companions, f)
+		}
+	}
+	return companions
+}
+
+// needsMarshalJSON reports whether a struct has repeating primitives with
+// "_name" companions or choice elements, whose property name depends on the
+// type they hold.
+func needsMarshalJSON(fields []FieldInfo) bool {
+	return len(primitiveArrays(fields)) > 0 || hasChoiceFields(fields)
+}
+
+func (g *Generator) writeMarshalJSON(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	if !needsMarshalJSON(fields) {
+		return
+	}
+
+	valueFields := make(map[string]FieldInfo)
+	for _, f := range fields {
+		valueFields[f.Name] = f
+	}
+
+	fmt.Fprintf(buf, "func (r %s) MarshalJSON() ([]byte, error) {\n", structName)
+	fmt.Fprintf(buf, "\ttype alias %s\n", structName)
+	fmt.Fprintf(buf, "\tout := struct {\n")
+	fmt.Fprintf(buf, "\t\talias\n")
+	for _, f := range primitiveArrays(fields) {
+		value := valueFields[f.ElementOf]
+		fmt.Fprintf(buf, "\t\t%s []*%s %s\n", value.Name, strings.TrimPrefix(value.GoType, "[]"), value.JSONTag)
+		fmt.Fprintf(buf, "\t\t%s %s %s\n", f.Name, f.GoType, f.JSONTag)
+	}
+	fmt.Fprintf(buf, "\t}{alias: alias(r)}\n")
+	for _, f := range primitiveArrays(fields) {
+		fmt.Fprintf(buf, "\tout.%s, out.%s = alignPrimitiveArray(r.%s, r.%s)\n", f.ElementOf, f.Name, f.ElementOf, f.Name)
+	}
+	if !hasChoiceFields(fields) {
+		fmt.Fprintf(buf, "\treturn json.Marshal(out)\n")
+		fmt.Fprintf(buf, "}\n\n")
+		return
+	}
+	fmt.Fprintf(buf, "\tdata, err := json.Marshal(out)\n")
+	fmt.Fprintf(buf, "\tif err != nil {\n")
+	fmt.Fprintf(buf, "\t\treturn nil, err\n")
+	fmt.Fprintf(buf, "\t}\n")
+	writeChoiceMarshal(buf, fields)
+	fmt.Fprintf(buf, "\treturn data, nil\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+// needsUnmarshalJSON reports whether a struct has resource-typed fields,
+// which decode through the resource registry into their concrete types,
+// repeating primitives with "_name" companions, which must match their values
+// in length, or choice elements, which decode into the variant named by their
+// property.
+func (g *Generator) needsUnmarshalJSON(fields []FieldInfo) bool {
+	for _, f := range fields {
+		if g.isInterfaceType(extractBaseType(f.GoType)) {
+			return true
+		}
+	}
+	return len(primitiveArrays(fields)) > 0 || hasChoiceFields(fields)
+}
+
+func (g *Generator) writeUnmarshalJSON(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	if !g.needsUnmarshalJSON(fields) {
+		return
+	}
+
+	var resourceFields []FieldInfo
+	for _, f := range fields {
+		if g.isInterfaceType(extractBaseType(f.GoType)) {
+			resourceFields = append(resourceFields, f)
+		}
+	}
+
+	fmt.Fprintf(buf, "func (r *%s) UnmarshalJSON(data []byte) error {\n", structName)
+	fmt.Fprintf(buf, "\ttype alias %s\n", structName)
+	fmt.Fprintf(buf, "\taux := struct {\n")
+	fmt.Fprintf(buf, "\t\t*alias\n")
+	for _, f := range resourceFields {
+		rawType := "json.RawMessage"
+		if strings.HasPrefix(f.GoType, "[]") {
+			rawType = "[]json.RawMessage"
+		}
+		fmt.Fprintf(buf, "\t\t%s %s %s\n", f.Name, rawType, f.JSONTag)
+	}
+	valueFields := make(map[string]FieldInfo)
+	for _, f := range fields {
+		valueFields[f.Name] = f
+	}
+	for _, f := range primitiveArrays(fields) {
+		value := valueFields[f.ElementOf]
+		fmt.Fprintf(buf, "\t\t%s []*%s %s\n", value.Name, strings.TrimPrefix(value.GoType, "[]"), value.JSONTag)
+	}
+	fmt.Fprintf(buf, "\t}{alias: (*alias)(r)}\n")
+	fmt.Fprintf(buf, "\tif err := json.Unmarshal(data, &aux); err != nil {\n")
+	fmt.Fprintf(buf, "\t\treturn err\n")
+	fmt.Fprintf(buf, "\t}\n")
+	needsErr := hasChoiceFields(fields) || len(primitiveArrays(fields)) > 0
+	for _, f := range resourceFields {
+		if !strings.HasPrefix(f.GoType, "[]") {
+			needsErr = true
+		}
+	}
+	if needsErr {
+		fmt.Fprintf(buf, "\tvar err error\n")
+	}
+	for _, f := range resourceFields {
+		jsonName := strings.Split(strings.Trim(strings.TrimPrefix(strings.Trim(f.JSONTag, "`"), "json:"), "\""), ",")[0]
+		if strings.HasPrefix(f.GoType, "[]") {
+			fmt.Fprintf(buf, "\tr.%s = nil\n", f.Name)
+			fmt.Fprintf(buf, "\tfor i, raw := range aux.%s {\n", f.Name)
+			fmt.Fprintf(buf, "\t\tres, err := unmarshalOptionalResource(raw)\n")
+			fmt.Fprintf(buf, "\t\tif err != nil {\n")
+			fmt.Fprintf(buf, "\t\t\treturn fmt.Errorf(\"%s[%%d]: %%w\", i, err)\n", jsonName)
+			fmt.Fprintf(buf, "\t\t}\n")
+			fmt.Fprintf(buf, "\t\tr.%s = append(r.%s, res)\n", f.Name, f.Name)
+			fmt.Fprintf(buf, "\t}\n")
+		} else {
+			fmt.Fprintf(buf, "\tif r.%s, err = unmarshalOptionalResource(aux.%s); err != nil {\n", f.Name, f.Name)
+			fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"%s: %%w\", err)\n", jsonName)
+			fmt.Fprintf(buf, "\t}\n")
+		}
+	}
+	for _, f := range primitiveArrays(fields) {
+		value := valueFields[f.ElementOf]
+		jsonName := strings.Split(strings.Trim(strings.TrimPrefix(strings.Trim(value.JSONTag, "`"), "json:"), "\""), ",")[0]
+		fmt.Fprintf(buf, "\tif r.%s, err = splitPrimitiveArray(aux.%s, r.%s); err != nil {\n", f.ElementOf, f.ElementOf, f.Name)
+		fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"%s: %%w\", err)\n", jsonName)
+		fmt.Fprintf(buf, "\t}\n")
+	}
+	if hasChoiceFields(fields) {
+		writeChoiceUnmarshal(buf, fields)
+	}
 	fmt.Fprintf(buf, "\treturn nil\n")
 	fmt.Fprintf(buf, "}\n\n")
 }
 
-func (g *Generator) writeFixedValidation(buf *bytes.Buffer, fieldName string, fixed any, baseType string, isPointer bool) {
+func (g *Generator) writeFixedValidation(w *validationWriter, f FieldInfo, baseType string, isPointer bool) {
+	buf := w.buf
+	at := w.at(f.Name, false)
+	message := fmt.Sprintf("field '%s' must be %v", f.Name, f.Fixed)
+	if baseType == "Decimal" {
+		fmt.Fprintf(buf, "\tif !r.%s.Equal(MustParseDecimal(\"%v\")) {\n", f.Name, f.Fixed)
+		w.fail("\t\t", "value", at, message)
+		fmt.Fprintf(buf, "\t}\n")
+		return
+	}
+	if isRuntimeType(baseType) {
+		fmt.Fprintf(buf, "\tif r.%s.String() != \"%v\" {\n", f.Name, f.Fixed)
+		w.fail("\t\t", "value", at, message)
+		fmt.Fprintf(buf, "\t}\n")
+		return
+	}
+
 	var valueStr string
-	switch v := fixed.(type) {
+	switch v := f.Fixed.(type) {
 	case string:
 		valueStr = fmt.Sprintf(`"%s"`, v)
 	case float64:
@@ -468,10 +1068,10 @@ func (g *Generator) writeFixedValidation(buf *bytes.Buffer, fieldName string, fi
 	}
 
 	if isPointer {
-		fmt.Fprintf(buf, "\t\tif *r.%s != %s {\n", fieldName, valueStr)
+		fmt.Fprintf(buf, "\t\tif *r.%s != %s {\n", f.Name, valueStr)
 	} else {
-		fmt.Fprintf(buf, "\tif r.%s != %s {\n", fieldName, valueStr)
+		fmt.Fprintf(buf, "\tif r.%s != %s {\n", f.Name, valueStr)
 	}
-	fmt.Fprintf(buf, "\t\treturn fmt.Errorf(\"field '%s' must be %v\")\n", fieldName, fixed)
+	w.fail("\t\t", "value", at, message)
 	fmt.Fprintf(buf, "\t}\n")
 }
@@ -112,11 +112,33 @@ func TestWriteStruct_BSONTags(t *testing.T) {
 				"OptionalField": "optional_field,omitempty",
 			},
 		},
+		{
+			name: "resource fields keep their tags",
+			fields: []FieldInfo{
+				{
+					Name:    "Contained",
+					GoType:  "[]Resource",
+					JSONTag: "`json:\"contained,omitempty\"`",
+					BSONTag: "`bson:\"contained,omitempty\"`",
+				},
+				{
+					Name:    "Resource",
+					GoType:  "Resource",
+					JSONTag: "`json:\"resource,omitempty\"`",
+					BSONTag: "`bson:\"resource,omitempty\"`",
+				},
+			},
+			wantBSON: map[string]string{
+				"Contained": "contained,omitempty",
+				"Resource":  "resource,omitempty",
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewGenerator("", "")
+			g.Definitions["Resource"] = StructureDefinition{Name: "Resource", Kind: "resource", Abstract: true}
 			var buf bytes.Buffer
 			g.writeStruct(&buf, "TestStruct", "", tt.fields)
 
@@ -510,3 +532,214 @@ func TestWriteValidateMethod_NestedStructures(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteMarshalJSON_PrimitiveElements(t *testing.T) {
+	fields := []FieldInfo{
+		{Name: "Family", GoType: "*string", JSONTag: "`json:\"family,omitempty\"`"},
+		{Name: "FamilyElement", GoType: "*Element", JSONTag: "`json:\"_family,omitempty\"`", ElementOf: "Family"},
+		{Name: "Given", GoType: "[]string", JSONTag: "`json:\"given,omitempty\"`"},
+		{Name: "GivenElement", GoType: "[]*Element", JSONTag: "`json:\"_given,omitempty\"`", ElementOf: "Given"},
+	}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	g.writeMarshalJSON(&buf, "HumanName", fields)
+
+	output := buf.String()
+	expected := []string{
+		"func (r HumanName) MarshalJSON() ([]byte, error) {",
+		"type alias HumanName",
+		"Given []*string `json:\"given,omitempty\"`",
+		"GivenElement []*Element `json:\"_given,omitempty\"`",
+		"out.Given, out.GivenElement = alignPrimitiveArray(r.Given, r.GivenElement)",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+	if strings.Contains(output, "FamilyElement") {
+		t.Errorf("single-valued companions should use default marshaling, got:\n%s", output)
+	}
+
+	buf.Reset()
+	g.writeUnmarshalJSON(&buf, "HumanName", fields)
+	unmarshal := "if r.Given, err = splitPrimitiveArray(aux.Given, r.GivenElement); err != nil {\n\t\treturn fmt.Errorf(\"given: %w\", err)"
+	if !strings.Contains(buf.String(), unmarshal) {
+		t.Errorf("expected UnmarshalJSON to contain %q, got:\n%s", unmarshal, buf.String())
+	}
+
+	buf.Reset()
+	g.writeMarshalJSON(&buf, "HumanName", fields[:2])
+	if buf.Len() != 0 {
+		t.Errorf("expected no MarshalJSON without repeating companions, got:\n%s", buf.String())
+	}
+}
+
+func TestWriteValidateMethod_SkipsNilPointerItems(t *testing.T) {
+	fields := []FieldInfo{
+		{Name: "GivenElement", GoType: "[]*Element"},
+	}
+	structMap := map[string][]FieldInfo{
+		"Element": {},
+	}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	g.writeValidateMethod(&buf, "HumanName", fields, structMap)
+
+	output := buf.String()
+	if !strings.Contains(output, "if item == nil {") {
+		t.Errorf("expected nil items to be skipped, got:\n%s", output)
+	}
+}
+
+func TestWriteValidateMethod_FixedDecimal(t *testing.T) {
+	fields := []FieldInfo{
+		{Name: "Factor", GoType: "*Decimal", Fixed: 1.5},
+	}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	g.writeValidateMethod(&buf, "TestStruct", fields, make(map[string][]FieldInfo))
+
+	output := buf.String()
+	if !strings.Contains(output, `if !r.Factor.Equal(MustParseDecimal("1.5")) {`) {
+		t.Errorf("expected decimal fixed value comparison, got:\n%s", output)
+	}
+	if !strings.Contains(output, "if err := r.Factor.Validate(); err != nil {") {
+		t.Errorf("expected runtime type to be validated, got:\n%s", output)
+	}
+}
+
+func TestWriteValidateMethod_FixedAndPatternValues(t *testing.T) {
+	fields := []FieldInfo{
+		{Name: "Code", GoType: "*CodeableConcept", PatternValue: map[string]any{
+			"coding": []any{map[string]any{"system": "http://loinc.org", "code": "85354-9"}},
+		}},
+		{Name: "Unit", GoType: "*Quantity", Fixed: map[string]any{"system": "http://unitsofmeasure.org", "code": "mm[Hg]"}},
+		{Name: "Category", GoType: "[]CodeableConcept", PatternValue: map[string]any{"text": "vital signs"}},
+		{Name: "Status", GoType: "*string", PatternValue: "final"},
+	}
+	structMap := map[string][]FieldInfo{"CodeableConcept": {}, "Quantity": {}}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	g.writeValidateMethod(&buf, "TestStruct", fields, structMap)
+
+	output := buf.String()
+	expected := []string{
+		`if r.Code != nil && !matchesPatternValue(r.Code, "{\"coding\":[{\"code\":\"85354-9\",\"system\":\"http://loinc.org\"}]}") {`,
+		`if r.Unit != nil && !matchesFixedValue(r.Unit, "{\"code\":\"mm[Hg]\",\"system\":\"http://unitsofmeasure.org\"}") {`,
+		`return fmt.Errorf("field 'Unit' must be %s", "{\"code\":\"mm[Hg]\",\"system\":\"http://unitsofmeasure.org\"}")`,
+		"for i, item := range r.Category {",
+		`if !matchesPatternValue(item, "{\"text\":\"vital signs\"}") {`,
+		`return fmt.Errorf("field 'Category[%d]' does not match the pattern %s", i, "{\"text\":\"vital signs\"}")`,
+		`if r.Status != nil && !matchesPatternValue(r.Status, "\"final\"") {`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+}
+
+func TestWriteValidateMethod_TemporalTypes(t *testing.T) {
+	fields := []FieldInfo{
+		{Name: "BirthDate", GoType: "*Date"},
+		{Name: "Issued", GoType: "*Instant", IsRequired: true, Min: 1},
+		{Name: "Event", GoType: "[]DateTime"},
+	}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	g.writeValidateMethod(&buf, "TestStruct", fields, make(map[string][]FieldInfo))
+
+	output := buf.String()
+	expected := []string{
+		"if err := r.BirthDate.Validate(); err != nil {",
+		"if r.Issued == nil {",
+		"if err := r.Issued.Validate(); err != nil {",
+		"for i, item := range r.Event {",
+		`return fmt.Errorf("Event[%d]: %w", i, err)`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+}
+
+func TestWriteValidateMethod_EnumFields(t *testing.T) {
+	fields := []FieldInfo{
+		{Name: "Status", GoType: "TestStatus", IsRequired: true, Min: 1},
+		{Name: "Priority", GoType: "*TestStatus"},
+		{Name: "Kinds", GoType: "[]TestStatus"},
+	}
+
+	var buf bytes.Buffer
+	g := NewGenerator("", "")
+	g.enumTypes = map[string]bool{"TestStatus": true}
+	g.writeValidateMethod(&buf, "TestStruct", fields, make(map[string][]FieldInfo))
+
+	output := buf.String()
+	expected := []string{
+		`if r.Status == "" {`,
+		"if !r.Status.IsValid() {",
+		`return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)`,
+		"if r.Priority != nil && !r.Priority.IsValid() {",
+		"for i, item := range r.Kinds {",
+		`return fmt.Errorf("field 'Kinds[%d]' has invalid code '%s'", i, item)`,
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+	if strings.Contains(output, "emptyString") {
+		t.Errorf("enum fields should compare with an untyped empty string, got:\n%s", output)
+	}
+}
+
+func TestWriteValidateAllMethod(t *testing.T) {
+	g := NewGenerator("", "")
+	g.Definitions["Identifier"] = StructureDefinition{Name: "Identifier", Kind: "complex-type"}
+	g.enumTypes = map[string]bool{"AdministrativeGender": true}
+	fields := []FieldInfo{
+		{Name: "ResourceType", GoType: "string", JSONTag: "`json:\"resourceType\"`", IsRequired: true},
+		{Name: "Identifier", GoType: "[]Identifier", JSONTag: "`json:\"identifier,omitempty\"`"},
+		{Name: "Code", GoType: "*Identifier", JSONTag: "`json:\"code\"`", IsRequired: true, Min: 1},
+		{Name: "Gender", GoType: "AdministrativeGender", JSONTag: "`json:\"gender\"`", IsRequired: true, Min: 1},
+		{Name: "BirthDate", GoType: "*Date", JSONTag: "`json:\"birthDate,omitempty\"`"},
+		{Name: "BirthDateElement", GoType: "*Element", JSONTag: "`json:\"_birthDate,omitempty\"`", ElementOf: "BirthDate"},
+	}
+
+	var buf bytes.Buffer
+	g.writeValidateAllMethod(&buf, "TestResource", fields, make(map[string][]FieldInfo))
+
+	output := buf.String()
+	wantCode := []string{
+		"func (r *TestResource) validateAll(path string, issues *ValidationIssues) {",
+		`issues.add("invalid", path + ".resourceType", fmt.Sprintf("invalid resourceType: expected 'TestResource', got '%s'", r.ResourceType))`,
+		`item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)`,
+		`issues.add("required", path + ".code", "field 'Code' is required")`,
+		`r.Code.validateAll(path + ".code", issues)`,
+		`issues.add("required", path + ".gender", "field 'Gender' is required")`,
+		`if r.Gender != "" && !r.Gender.IsValid() {`,
+		`issues.add("value", path + ".birthDate", err.Error())`,
+	}
+	for _, want := range wantCode {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected code to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "return") {
+		t.Errorf("validateAll should not return early, got:\n%s", output)
+	}
+
+	buf.Reset()
+	g.writeValidateMethod(&buf, "TestResource", fields, make(map[string][]FieldInfo))
+	if !strings.Contains(buf.String(), "if !r.Gender.IsValid() {") {
+		t.Errorf("Validate should keep the fail-fast enum check, got:\n%s", buf.String())
+	}
+}
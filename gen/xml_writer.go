@@ -0,0 +1,31 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// writesStruct reports whether writeStruct emits a type for the struct.
+func (g *Generator) writesStruct(name string, fields []FieldInfo) bool {
+	if len(fields) > 0 {
+		return true
+	}
+	_, defined := g.Definitions[name]
+	return defined
+}
+
+// writeXMLMethods writes the xml.Marshaler and xml.Unmarshaler methods of a
+// struct, which encode it following the FHIR XML rules through the runtime.
+func (g *Generator) writeXMLMethods(buf *bytes.Buffer, structName string, fields []FieldInfo) {
+	if !g.writesStruct(structName, fields) {
+		return
+	}
+
+	fmt.Fprintf(buf, "func (r %s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", structName)
+	fmt.Fprintf(buf, "\treturn marshalXML(e, start, &r)\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (r *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {\n", structName)
+	fmt.Fprintf(buf, "\treturn unmarshalXML(d, start, r)\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
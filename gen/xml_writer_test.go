@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteXMLMethods(t *testing.T) {
+	g := NewGenerator("", "")
+	g.Definitions["Element"] = StructureDefinition{Name: "Element", Kind: "complex-type"}
+	fields := choiceFields(g)
+
+	var buf bytes.Buffer
+	g.writeXMLMethods(&buf, "TestResource", fields)
+
+	output := buf.String()
+	expected := []string{
+		"func (r TestResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n\treturn marshalXML(e, start, &r)\n}",
+		"func (r *TestResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {\n\treturn unmarshalXML(d, start, r)\n}",
+	}
+	for _, exp := range expected {
+		if !strings.Contains(output, exp) {
+			t.Errorf("expected output to contain %q, got:\n%s", exp, output)
+		}
+	}
+}
+
+func TestWriteXMLMethods_SkipsUnwrittenStructs(t *testing.T) {
+	g := NewGenerator("", "")
+
+	var buf bytes.Buffer
+	g.writeXMLMethods(&buf, "Missing", nil)
+	if buf.Len() != 0 {
+		t.Errorf("expected no methods for a struct that is not written, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,160 @@
+// Package ndjson reads and writes FHIR resources as newline-delimited JSON,
+// the format of Bulk Data exports: one resource per line.
+//
+// Reader decodes one line at a time into the concrete r5 type named by its
+// resourceType, so files of any size are read with the memory of their
+// longest line:
+//
+//	r := ndjson.NewReader(f, ndjson.OnError(func(err *ndjson.LineError) error {
+//		log.Print(err) // e.g. "ndjson: line 42: unknown resourceType 'Foo'"
+//		return nil     // skip the line and go on
+//	}))
+//	for {
+//		res, err := r.Read()
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+//
+// Writer writes resources to a single stream; SplitWriter writes each
+// resource type to its own file, such as Patient.ndjson and
+// Observation.ndjson, as Bulk Data servers do.
+package ndjson
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// DefaultMaxLineSize is the longest line a Reader accepts by default.
+const DefaultMaxLineSize = 64 << 20
+
+// ErrLineTooLong is the error of a line longer than the Reader's maximum
+// line size.
+var ErrLineTooLong = errors.New("line too long")
+
+// LineError is the error of a line that does not hold a valid resource.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("ndjson: line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ReaderOption configures a Reader.
+type ReaderOption func(*Reader)
+
+// OnError sets the function a Reader passes the errors of invalid lines to.
+// When it returns nil the line is skipped and reading goes on; otherwise
+// Read returns its error. Without it, Read returns the first LineError.
+func OnError(fn func(err *LineError) error) ReaderOption {
+	return func(r *Reader) { r.onError = fn }
+}
+
+// MaxLineSize sets the longest line a Reader accepts, in bytes. Longer
+// lines are reported as a LineError wrapping ErrLineTooLong without being
+// held in memory.
+func MaxLineSize(n int) ReaderOption {
+	return func(r *Reader) { r.maxLineSize = n }
+}
+
+// Reader reads resources from newline-delimited JSON. Blank lines are
+// skipped and lines may end with \r\n.
+type Reader struct {
+	br          *bufio.Reader
+	buf         []byte
+	line        int
+	onError     func(err *LineError) error
+	maxLineSize int
+}
+
+// NewReader returns a Reader reading from r.
+func NewReader(r io.Reader, opts ...ReaderOption) *Reader {
+	reader := &Reader{br: bufio.NewReader(r), maxLineSize: DefaultMaxLineSize}
+	for _, opt := range opts {
+		opt(reader)
+	}
+	return reader
+}
+
+// Read returns the next resource, as its concrete type such as
+// *r5.Patient. It returns io.EOF at the end of the input.
+func (r *Reader) Read() (models.Resource, error) {
+	for {
+		data, err := r.readLine()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil && !errors.Is(err, ErrLineTooLong) {
+			return nil, err
+		}
+		if err == nil {
+			if len(bytes.TrimSpace(data)) == 0 {
+				continue
+			}
+			var res models.Resource
+			if res, err = models.UnmarshalResource(data); err == nil {
+				return res, nil
+			}
+		}
+		lineErr := &LineError{Line: r.line, Err: err}
+		if r.onError == nil {
+			return nil, lineErr
+		}
+		if err := r.onError(lineErr); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Line returns the number of the line the last resource was read from,
+// counting from 1.
+func (r *Reader) Line() int {
+	return r.line
+}
+
+// readLine returns the next line without its line ending. The line is
+// only valid until the next call.
+func (r *Reader) readLine() ([]byte, error) {
+	r.buf = r.buf[:0]
+	tooLong, read := false, false
+	for {
+		chunk, err := r.br.ReadSlice('\n')
+		read = read || len(chunk) > 0
+		if !tooLong && len(r.buf)+len(chunk) > r.maxLineSize+len("\r\n") {
+			tooLong = true
+			r.buf = r.buf[:0]
+		}
+		if !tooLong {
+			r.buf = append(r.buf, chunk...)
+		}
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue
+		}
+		if err == io.EOF && !read {
+			return nil, io.EOF
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		r.line++
+		break
+	}
+	if tooLong {
+		return nil, ErrLineTooLong
+	}
+	line := bytes.TrimSuffix(r.buf, []byte("\n"))
+	return bytes.TrimSuffix(line, []byte("\r")), nil
+}
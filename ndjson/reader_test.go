@@ -0,0 +1,127 @@
+package ndjson
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+const testInput = `{"resourceType":"Patient","id":"p1","active":true}
+{"resourceType":"Observation","id":"o1","status":"final","code":{"text":"Heart rate"}}
+
+{"resourceType":"Unknown","id":"x"}
+{"resourceType":"Patient","id":"p2","birthDate":"1974-12-25"}` + "\r\n" + `not json
+{"resourceType":"Patient","id":"p3"}`
+
+func readAll(t *testing.T, r *Reader) ([]models.Resource, error) {
+	t.Helper()
+
+	var resources []models.Resource
+	for {
+		res, err := r.Read()
+		if err == io.EOF {
+			return resources, nil
+		}
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, res)
+	}
+}
+
+func TestReader_OnError(t *testing.T) {
+	var lineErrors []*LineError
+	r := NewReader(strings.NewReader(testInput), OnError(func(err *LineError) error {
+		lineErrors = append(lineErrors, err)
+		return nil
+	}))
+
+	resources, err := readAll(t, r)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	var ids []string
+	for _, res := range resources {
+		ids = append(ids, res.GetResourceType()+"/"+res.GetID())
+	}
+	if got := strings.Join(ids, ","); got != "Patient/p1,Observation/o1,Patient/p2,Patient/p3" {
+		t.Errorf("Read() = %s", got)
+	}
+	if patient, ok := resources[2].(*models.Patient); !ok || patient.BirthDate == nil || patient.BirthDate.String() != "1974-12-25" {
+		t.Errorf("Read() = %#v, want *Patient with birthDate", resources[2])
+	}
+	if r.Line() != 7 {
+		t.Errorf("Line() = %d, want 7", r.Line())
+	}
+
+	if len(lineErrors) != 2 {
+		t.Fatalf("errors = %v, want 2", lineErrors)
+	}
+	if lineErrors[0].Line != 4 || !strings.Contains(lineErrors[0].Error(), "ndjson: line 4: unknown resourceType 'Unknown'") {
+		t.Errorf("errors[0] = %v", lineErrors[0])
+	}
+	if lineErrors[1].Line != 6 {
+		t.Errorf("errors[1] = %v, want line 6", lineErrors[1])
+	}
+}
+
+func TestReader_StopsAtFirstError(t *testing.T) {
+	r := NewReader(strings.NewReader(testInput))
+
+	resources, err := readAll(t, r)
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 4 {
+		t.Fatalf("Read() error = %v, want LineError at line 4", err)
+	}
+	if len(resources) != 2 {
+		t.Errorf("Read() = %d resources before the error, want 2", len(resources))
+	}
+}
+
+func TestReader_OnErrorAborts(t *testing.T) {
+	stop := errors.New("stop")
+	r := NewReader(strings.NewReader(testInput), OnError(func(err *LineError) error { return stop }))
+
+	if _, err := readAll(t, r); err != stop {
+		t.Errorf("Read() error = %v, want %v", err, stop)
+	}
+}
+
+func TestReader_MaxLineSize(t *testing.T) {
+	long := `{"resourceType":"Patient","id":"` + strings.Repeat("a", 5000) + `"}`
+	input := long + "\n" + `{"resourceType":"Patient","id":"short"}` + "\n"
+
+	var lineErrors []*LineError
+	r := NewReader(strings.NewReader(input), MaxLineSize(100), OnError(func(err *LineError) error {
+		lineErrors = append(lineErrors, err)
+		return nil
+	}))
+	resources, err := readAll(t, r)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(resources) != 1 || resources[0].GetID() != "short" {
+		t.Errorf("Read() = %v, want the short patient", resources)
+	}
+	if len(lineErrors) != 1 || lineErrors[0].Line != 1 || !errors.Is(lineErrors[0], ErrLineTooLong) {
+		t.Errorf("errors = %v, want line 1 too long", lineErrors)
+	}
+}
+
+func TestReader_LongLines(t *testing.T) {
+	// Lines longer than the read buffer are assembled from several reads.
+	id := strings.Repeat("b", 64)
+	given := `"` + strings.Repeat("x", 10000) + `"`
+	input := `{"resourceType":"Patient","id":"` + id + `","name":[{"given":[` + given + `]}]}`
+
+	resources, err := readAll(t, NewReader(strings.NewReader(input)))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(resources) != 1 || resources[0].GetID() != id {
+		t.Errorf("Read() = %v", resources)
+	}
+}
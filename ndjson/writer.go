@@ -0,0 +1,126 @@
+package ndjson
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// Writer writes resources as newline-delimited JSON. Each resource is
+// encoded on its own into a fixed-size buffer, so memory does not grow
+// with the number of resources written.
+type Writer struct {
+	bw  *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewWriter returns a Writer writing to w. Call Flush when done.
+func NewWriter(w io.Writer) *Writer {
+	bw := bufio.NewWriter(w)
+	return &Writer{bw: bw, enc: json.NewEncoder(bw)}
+}
+
+// Write writes res as a single line.
+func (w *Writer) Write(res models.Resource) error {
+	if res == nil {
+		return errors.New("ndjson: nil resource")
+	}
+	if err := w.enc.Encode(res); err != nil {
+		return fmt.Errorf("ndjson: %s: %w", res.GetResourceType(), err)
+	}
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.bw.Flush()
+}
+
+// Output is a file written by a SplitWriter, as listed in the output of a
+// Bulk Data export manifest.
+type Output struct {
+	Type  string
+	Count int
+}
+
+// SplitWriter writes the resources of each type to their own file. Files
+// are created when the first resource of their type is written.
+type SplitWriter struct {
+	create func(resourceType string) (io.WriteCloser, error)
+	files  map[string]*splitFile
+}
+
+type splitFile struct {
+	w      *Writer
+	closer io.Closer
+	count  int
+}
+
+// NewSplitWriter returns a SplitWriter creating the file of a resource type
+// with create. Call Close when done.
+func NewSplitWriter(create func(resourceType string) (io.WriteCloser, error)) *SplitWriter {
+	return &SplitWriter{create: create, files: make(map[string]*splitFile)}
+}
+
+// NewDirWriter returns a SplitWriter writing the resources of each type to
+// <Type>.ndjson in dir, such as Patient.ndjson.
+func NewDirWriter(dir string) *SplitWriter {
+	return NewSplitWriter(func(resourceType string) (io.WriteCloser, error) {
+		return os.Create(filepath.Join(dir, resourceType+".ndjson"))
+	})
+}
+
+// Write writes res to the file of its type.
+func (s *SplitWriter) Write(res models.Resource) error {
+	if res == nil {
+		return errors.New("ndjson: nil resource")
+	}
+	resourceType := res.GetResourceType()
+	file, ok := s.files[resourceType]
+	if !ok {
+		w, err := s.create(resourceType)
+		if err != nil {
+			return fmt.Errorf("ndjson: %s: %w", resourceType, err)
+		}
+		file = &splitFile{w: NewWriter(w), closer: w}
+		s.files[resourceType] = file
+	}
+	if err := file.w.Write(res); err != nil {
+		return err
+	}
+	file.count++
+	return nil
+}
+
+// Outputs returns the files written so far with their number of resources,
+// sorted by resource type.
+func (s *SplitWriter) Outputs() []Output {
+	outputs := make([]Output, 0, len(s.files))
+	for resourceType, file := range s.files {
+		outputs = append(outputs, Output{Type: resourceType, Count: file.count})
+	}
+	sort.Slice(outputs, func(i, j int) bool { return outputs[i].Type < outputs[j].Type })
+	return outputs
+}
+
+// Close flushes and closes every file, returning the first error.
+func (s *SplitWriter) Close() error {
+	var first error
+	for _, output := range s.Outputs() {
+		file := s.files[output.Type]
+		if err := file.w.Flush(); err != nil && first == nil {
+			first = err
+		}
+		if err := file.closer.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
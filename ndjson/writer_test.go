@@ -0,0 +1,88 @@
+package ndjson
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	resources := []models.Resource{
+		&models.Patient{ResourceType: "Patient", Id: ptr("p1"), Active: ptr(true)},
+		&models.Observation{ResourceType: "Observation", Id: ptr("o1"), Status: "final", Code: &models.CodeableConcept{Text: ptr("Heart rate")}},
+	}
+	for _, res := range resources {
+		if err := w.Write(res); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Write() wrote %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+
+	read, err := readAll(t, NewReader(&buf))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if !reflect.DeepEqual(read, resources) {
+		t.Errorf("Read() = %v, want %v", read, resources)
+	}
+}
+
+func TestWriter_Nil(t *testing.T) {
+	if err := NewWriter(io.Discard).Write(nil); err == nil {
+		t.Error("Write(nil) succeeded, want error")
+	}
+}
+
+func TestDirWriter(t *testing.T) {
+	dir := t.TempDir()
+	w := NewDirWriter(dir)
+	for _, res := range []models.Resource{
+		&models.Patient{ResourceType: "Patient", Id: ptr("p1")},
+		&models.Observation{ResourceType: "Observation", Id: ptr("o1"), Status: "final"},
+		&models.Patient{ResourceType: "Patient", Id: ptr("p2")},
+	} {
+		if err := w.Write(res); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	want := []Output{{Type: "Observation", Count: 1}, {Type: "Patient", Count: 2}}
+	if got := w.Outputs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Outputs() = %v, want %v", got, want)
+	}
+
+	f, err := os.Open(filepath.Join(dir, "Patient.ndjson"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	patients, err := readAll(t, NewReader(f))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(patients) != 2 || patients[0].GetID() != "p1" || patients[1].GetID() != "p2" {
+		t.Errorf("Patient.ndjson = %v", patients)
+	}
+}
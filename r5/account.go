@@ -2,38 +2,51 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A financial tool for tracking value accrued for a particular purpose.  In the healthcare field, used to track charges for a patient, cost centers, etc.
 type Account struct {
-	ResourceType  string             `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string            `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta              `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string            `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative         `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage  `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier       `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Account number
-	Status        string             `json:"status" bson:"status"`                                    // active | inactive | entered-in-error | on-hold | unknown
-	BillingStatus *CodeableConcept   `json:"billingStatus,omitempty" bson:"billing_status,omitempty"` // Tracks the lifecycle of the account through the billing process
-	Type          *CodeableConcept   `json:"type,omitempty" bson:"type,omitempty"`                    // E.g. patient, expense, depreciation
-	Name          *string            `json:"name,omitempty" bson:"name,omitempty"`                    // Human-readable label
-	Subject       []Reference        `json:"subject,omitempty" bson:"subject,omitempty"`              // The entity that caused the expenses
-	ServicePeriod *Period            `json:"servicePeriod,omitempty" bson:"service_period,omitempty"` // Transaction window
-	Covers        []Reference        `json:"covers,omitempty" bson:"covers,omitempty"`                // Episodic account covering these encounters/episodes of care
-	Coverage      []AccountCoverage  `json:"coverage,omitempty" bson:"coverage,omitempty"`            // The party(s) that are responsible for covering the payment of this account, and what order should they be applied to the account
-	Owner         *Reference         `json:"owner,omitempty" bson:"owner,omitempty"`                  // Entity managing the Account
-	Description   *string            `json:"description,omitempty" bson:"description,omitempty"`      // Explanation of purpose/use
-	Guarantor     []AccountGuarantor `json:"guarantor,omitempty" bson:"guarantor,omitempty"`          // The parties ultimately responsible for balancing the Account
-	Diagnosis     []AccountDiagnosis `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`          // The list of diagnoses relevant to this account
-	Procedure     []AccountProcedure `json:"procedure,omitempty" bson:"procedure,omitempty"`          // The list of procedures relevant to this account
-	Parent        *Reference         `json:"parent,omitempty" bson:"parent,omitempty"`                // Reference to an associated parent Account
-	Currency      *CodeableConcept   `json:"currency,omitempty" bson:"currency,omitempty"`            // The base or default currency
-	Balance       []AccountBalance   `json:"balance,omitempty" bson:"balance,omitempty"`              // Calculated account balance(s)
-	CalculatedAt  *string            `json:"calculatedAt,omitempty" bson:"calculated_at,omitempty"`   // Time the balance amount was calculated
+	ResourceType         string             `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string            `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta              `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element           `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string            `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element           `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative         `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource         `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier       `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Account number
+	Status               AccountStatus      `json:"status" bson:"status"`                                             // active | inactive | entered-in-error | on-hold | unknown
+	StatusElement        *Element           `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	BillingStatus        *CodeableConcept   `json:"billingStatus,omitempty" bson:"billing_status,omitempty"`          // Tracks the lifecycle of the account through the billing process
+	Type                 *CodeableConcept   `json:"type,omitempty" bson:"type,omitempty"`                             // E.g. patient, expense, depreciation
+	Name                 *string            `json:"name,omitempty" bson:"name,omitempty"`                             // Human-readable label
+	NameElement          *Element           `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Subject              []Reference        `json:"subject,omitempty" bson:"subject,omitempty"`                       // The entity that caused the expenses
+	ServicePeriod        *Period            `json:"servicePeriod,omitempty" bson:"service_period,omitempty"`          // Transaction window
+	Covers               []Reference        `json:"covers,omitempty" bson:"covers,omitempty"`                         // Episodic account covering these encounters/episodes of care
+	Coverage             []AccountCoverage  `json:"coverage,omitempty" bson:"coverage,omitempty"`                     // The party(s) that are responsible for covering the payment of this account, and what order should they be applied to the account
+	Owner                *Reference         `json:"owner,omitempty" bson:"owner,omitempty"`                           // Entity managing the Account
+	Description          *string            `json:"description,omitempty" bson:"description,omitempty"`               // Explanation of purpose/use
+	DescriptionElement   *Element           `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Guarantor            []AccountGuarantor `json:"guarantor,omitempty" bson:"guarantor,omitempty"`                   // The parties ultimately responsible for balancing the Account
+	Diagnosis            []AccountDiagnosis `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                   // The list of diagnoses relevant to this account
+	Procedure            []AccountProcedure `json:"procedure,omitempty" bson:"procedure,omitempty"`                   // The list of procedures relevant to this account
+	Parent               *Reference         `json:"parent,omitempty" bson:"parent,omitempty"`                         // Reference to an associated parent Account
+	Currency             *CodeableConcept   `json:"currency,omitempty" bson:"currency,omitempty"`                     // The base or default currency
+	Balance              []AccountBalance   `json:"balance,omitempty" bson:"balance,omitempty"`                       // Calculated account balance(s)
+	CalculatedAt         *Instant           `json:"calculatedAt,omitempty" bson:"calculated_at,omitempty"`            // Time the balance amount was calculated
+	CalculatedAtElement  *Element           `json:"_calculatedAt,omitempty" bson:"calculated_at_element,omitempty"`   // Extensions for calculatedAt
 }
 
+var accountSubjectTargets = []string{"Device", "HealthcareService", "Location", "Organization", "Patient", "Practitioner", "PractitionerRole"}
+
+var accountOwnerTargets = []string{"Organization"}
+
 func (r *Account) Validate() error {
 	if r.ResourceType != "Account" {
 		return fmt.Errorf("invalid resourceType: expected 'Account', got '%s'", r.ResourceType)
@@ -43,20 +56,55 @@ func (r *Account) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.BillingStatus != nil {
 		if err := r.BillingStatus.Validate(); err != nil {
 			return fmt.Errorf("BillingStatus: %w", err)
@@ -67,6 +115,16 @@ func (r *Account) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	for i, item := range r.Subject {
+		if target := invalidReferenceTarget(item.Reference, item.Type, accountSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject[%d]' cannot refer to %s, expected one of %v", i, target, accountSubjectTargets)
+		}
+	}
 	for i, item := range r.Subject {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Subject[%d]: %w", i, err)
@@ -87,11 +145,21 @@ func (r *Account) Validate() error {
 			return fmt.Errorf("Coverage[%d]: %w", i, err)
 		}
 	}
+	if r.Owner != nil {
+		if target := invalidReferenceTarget(r.Owner.Reference, r.Owner.Type, accountOwnerTargets); target != "" {
+			return fmt.Errorf("field 'Owner' cannot refer to %s, expected one of %v", target, accountOwnerTargets)
+		}
+	}
 	if r.Owner != nil {
 		if err := r.Owner.Validate(); err != nil {
 			return fmt.Errorf("Owner: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Guarantor {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Guarantor[%d]: %w", i, err)
@@ -122,16 +190,284 @@ func (r *Account) Validate() error {
 			return fmt.Errorf("Balance[%d]: %w", i, err)
 		}
 	}
+	if r.CalculatedAt != nil {
+		if err := r.CalculatedAt.Validate(); err != nil {
+			return fmt.Errorf("CalculatedAt: %w", err)
+		}
+	}
+	if r.CalculatedAtElement != nil {
+		if err := r.CalculatedAtElement.Validate(); err != nil {
+			return fmt.Errorf("CalculatedAtElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "Account")
+}
+
+func (r *Account) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Account" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Account', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.BillingStatus != nil {
+		r.BillingStatus.validateAll(path+".billingStatus", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	for i, item := range r.Subject {
+		if target := invalidReferenceTarget(item.Reference, item.Type, accountSubjectTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.subject[%d]", path, i), fmt.Sprintf("field 'Subject[%d]' cannot refer to %s, expected one of %v", i, target, accountSubjectTargets))
+		}
+	}
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
+	}
+	if r.ServicePeriod != nil {
+		r.ServicePeriod.validateAll(path+".servicePeriod", issues)
+	}
+	for i, item := range r.Covers {
+		item.validateAll(fmt.Sprintf("%s.covers[%d]", path, i), issues)
+	}
+	for i, item := range r.Coverage {
+		item.validateAll(fmt.Sprintf("%s.coverage[%d]", path, i), issues)
+	}
+	if r.Owner != nil {
+		if target := invalidReferenceTarget(r.Owner.Reference, r.Owner.Type, accountOwnerTargets); target != "" {
+			issues.add("value", path+".owner", fmt.Sprintf("field 'Owner' cannot refer to %s, expected one of %v", target, accountOwnerTargets))
+		}
+	}
+	if r.Owner != nil {
+		r.Owner.validateAll(path+".owner", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Guarantor {
+		item.validateAll(fmt.Sprintf("%s.guarantor[%d]", path, i), issues)
+	}
+	for i, item := range r.Diagnosis {
+		item.validateAll(fmt.Sprintf("%s.diagnosis[%d]", path, i), issues)
+	}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
+	}
+	if r.Parent != nil {
+		r.Parent.validateAll(path+".parent", issues)
+	}
+	if r.Currency != nil {
+		r.Currency.validateAll(path+".currency", issues)
+	}
+	for i, item := range r.Balance {
+		item.validateAll(fmt.Sprintf("%s.balance[%d]", path, i), issues)
+	}
+	if r.CalculatedAt != nil {
+		if err := r.CalculatedAt.Validate(); err != nil {
+			issues.add("value", path+".calculatedAt", err.Error())
+		}
+	}
+	if r.CalculatedAtElement != nil {
+		r.CalculatedAtElement.validateAll(path+".calculatedAt", issues)
+	}
+}
+
+func (r *Account) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Account", &issues)
+	checkInvariants(r, "Account", &issues)
+	return issues
+}
+
+var accountInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Account) invariants() []invariant {
+	return accountInvariants
+}
+
+func (r *Account) UnmarshalJSON(data []byte) error {
+	type alias Account
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Account) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Account) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Account) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Account) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var accountElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *Account) elementTypes() map[string]string {
+	return accountElementTypes
+}
+
+var accountElementMetadata = []ElementMetadata{
+	{Path: "Account.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Account.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Account.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Account.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Account.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Account.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Account.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Account.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Account.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Account.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Account.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Account.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/account-status"}},
+	{Path: "Account.billingStatus", Name: "billingStatus", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Account.type", Name: "type", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Account.name", Name: "name", Field: 16, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Account.subject", Name: "subject", Field: 18, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "Account.servicePeriod", Name: "servicePeriod", Field: 19, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Account.covers", Name: "covers", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Account.coverage", Name: "coverage", Field: 21, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Account.owner", Name: "owner", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Account.description", Name: "description", Field: 23, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Account.guarantor", Name: "guarantor", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Account.diagnosis", Name: "diagnosis", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Account.procedure", Name: "procedure", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Account.parent", Name: "parent", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Account.currency", Name: "currency", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Account.balance", Name: "balance", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Account.calculatedAt", Name: "calculatedAt", Field: 31, Min: 0, Max: "1", Types: []string{"instant"}},
+}
+
+func (r *Account) ElementMetadata() []ElementMetadata {
+	return accountElementMetadata
+}
+
+func (r *Account) GetResourceType() string {
+	return "Account"
+}
+
+func (r *Account) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Account) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Account) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Account) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Account) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Account) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Account) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Account)(nil)
+
 type AccountCoverage struct {
-	Id       *string    `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Coverage *Reference `json:"coverage" bson:"coverage"`                     // The party(s), such as insurances, that may contribute to the payment of this account
-	Priority *int       `json:"priority,omitempty" bson:"priority,omitempty"` // The priority of the coverage in the context of this account
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Coverage          *Reference  `json:"coverage" bson:"coverage"`                                        // The party(s), such as insurances, that may contribute to the payment of this account
+	Priority          *int        `json:"priority,omitempty" bson:"priority,omitempty"`                    // The priority of the coverage in the context of this account
+	PriorityElement   *Element    `json:"_priority,omitempty" bson:"priority_element,omitempty"`           // Extensions for priority
 }
 
 func (r *AccountCoverage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Coverage == nil {
 		return fmt.Errorf("field 'Coverage' is required")
 	}
@@ -140,31 +476,131 @@ func (r *AccountCoverage) Validate() error {
 			return fmt.Errorf("Coverage: %w", err)
 		}
 	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *AccountCoverage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Coverage == nil {
+		issues.add("required", path+".coverage", "field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+}
+
+var accountCoverageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AccountCoverage) invariants() []invariant {
+	return accountCoverageInvariants
+}
+
+func (r AccountCoverage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AccountCoverage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AccountCoverage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AccountCoverage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var accountCoverageElementTypes = map[string]string{
+	"priority": "positiveInt",
+}
+
+func (r *AccountCoverage) elementTypes() map[string]string {
+	return accountCoverageElementTypes
+}
+
+var accountCoverageElementMetadata = []ElementMetadata{
+	{Path: "Account.coverage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Account.coverage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Account.coverage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Account.coverage.coverage", Name: "coverage", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "Account.coverage.priority", Name: "priority", Field: 4, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *AccountCoverage) ElementMetadata() []ElementMetadata {
+	return accountCoverageElementMetadata
+}
+
 type AccountGuarantor struct {
-	Id             *string    `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Party          *Reference `json:"party,omitempty" bson:"party,omitempty"`                   // Responsible entity
-	OnHold         *bool      `json:"onHold,omitempty" bson:"on_hold,omitempty"`                // Credit or other hold applied
-	Period         *Period    `json:"period,omitempty" bson:"period,omitempty"`                 // Guarantee account during
-	Account        *Reference `json:"account,omitempty" bson:"account,omitempty"`               // A specific Account for the guarantor
-	Responsibility *Quantity  `json:"responsibility,omitempty" bson:"responsibility,omitempty"` // Responsible %'age of charges
-	Limit          *Money     `json:"limit,omitempty" bson:"limit,omitempty"`                   // Responsible financial limit
-	Rank           *int       `json:"rank,omitempty" bson:"rank,omitempty"`                     // Rank order of guarator
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Party             *Reference  `json:"party,omitempty" bson:"party,omitempty"`                          // Responsible entity
+	OnHold            *bool       `json:"onHold,omitempty" bson:"on_hold,omitempty"`                       // Credit or other hold applied
+	OnHoldElement     *Element    `json:"_onHold,omitempty" bson:"on_hold_element,omitempty"`              // Extensions for onHold
+	Period            *Period     `json:"period,omitempty" bson:"period,omitempty"`                        // Guarantee account during
+	Account           *Reference  `json:"account,omitempty" bson:"account,omitempty"`                      // A specific Account for the guarantor
+	Responsibility    *Quantity   `json:"responsibility,omitempty" bson:"responsibility,omitempty"`        // Responsible %'age of charges
+	Limit             *Money      `json:"limit,omitempty" bson:"limit,omitempty"`                          // Responsible financial limit
+	Rank              *int        `json:"rank,omitempty" bson:"rank,omitempty"`                            // Rank order of guarator
+	RankElement       *Element    `json:"_rank,omitempty" bson:"rank_element,omitempty"`                   // Extensions for rank
 }
 
+var accountGuarantorPartyTargets = []string{"Organization", "Patient", "RelatedPerson"}
+
+var accountGuarantorAccountTargets = []string{"Account"}
+
 func (r *AccountGuarantor) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, accountGuarantorPartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, accountGuarantorPartyTargets)
+		}
+	}
 	if r.Party != nil {
 		if err := r.Party.Validate(); err != nil {
 			return fmt.Errorf("Party: %w", err)
 		}
 	}
+	if r.OnHoldElement != nil {
+		if err := r.OnHoldElement.Validate(); err != nil {
+			return fmt.Errorf("OnHoldElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Account != nil {
+		if target := invalidReferenceTarget(r.Account.Reference, r.Account.Type, accountGuarantorAccountTargets); target != "" {
+			return fmt.Errorf("field 'Account' cannot refer to %s, expected one of %v", target, accountGuarantorAccountTargets)
+		}
+	}
 	if r.Account != nil {
 		if err := r.Account.Validate(); err != nil {
 			return fmt.Errorf("Account: %w", err)
@@ -180,20 +616,134 @@ func (r *AccountGuarantor) Validate() error {
 			return fmt.Errorf("Limit: %w", err)
 		}
 	}
+	if r.RankElement != nil {
+		if err := r.RankElement.Validate(); err != nil {
+			return fmt.Errorf("RankElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *AccountGuarantor) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, accountGuarantorPartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, accountGuarantorPartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+	if r.OnHoldElement != nil {
+		r.OnHoldElement.validateAll(path+".onHold", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Account != nil {
+		if target := invalidReferenceTarget(r.Account.Reference, r.Account.Type, accountGuarantorAccountTargets); target != "" {
+			issues.add("value", path+".account", fmt.Sprintf("field 'Account' cannot refer to %s, expected one of %v", target, accountGuarantorAccountTargets))
+		}
+	}
+	if r.Account != nil {
+		r.Account.validateAll(path+".account", issues)
+	}
+	if r.Responsibility != nil {
+		r.Responsibility.validateAll(path+".responsibility", issues)
+	}
+	if r.Limit != nil {
+		r.Limit.validateAll(path+".limit", issues)
+	}
+	if r.RankElement != nil {
+		r.RankElement.validateAll(path+".rank", issues)
+	}
+}
+
+var accountGuarantorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AccountGuarantor) invariants() []invariant {
+	return accountGuarantorInvariants
+}
+
+func (r AccountGuarantor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AccountGuarantor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AccountGuarantor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AccountGuarantor) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var accountGuarantorElementTypes = map[string]string{
+	"rank": "positiveInt",
+}
+
+func (r *AccountGuarantor) elementTypes() map[string]string {
+	return accountGuarantorElementTypes
+}
+
+var accountGuarantorElementMetadata = []ElementMetadata{
+	{Path: "Account.guarantor.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Account.guarantor.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Account.guarantor.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Account.guarantor.party", Name: "party", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Account.guarantor.onHold", Name: "onHold", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Account.guarantor.period", Name: "period", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Account.guarantor.account", Name: "account", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account"}},
+	{Path: "Account.guarantor.responsibility", Name: "responsibility", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Account.guarantor.limit", Name: "limit", Field: 9, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Account.guarantor.rank", Name: "rank", Field: 10, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *AccountGuarantor) ElementMetadata() []ElementMetadata {
+	return accountGuarantorElementMetadata
+}
+
 type AccountDiagnosis struct {
-	Id              *string            `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
-	Sequence        *int               `json:"sequence,omitempty" bson:"sequence,omitempty"`                 // Ranking of the diagnosis (for each type)
-	Condition       *CodeableReference `json:"condition" bson:"condition"`                                   // The diagnosis relevant to the account
-	DateOfDiagnosis *string            `json:"dateOfDiagnosis,omitempty" bson:"date_of_diagnosis,omitempty"` // Date of the diagnosis (when coded diagnosis)
-	Type            []CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                         // Type that this diagnosis has relevant to the account (e.g. admission, billing, discharge …)
-	OnAdmission     *bool              `json:"onAdmission,omitempty" bson:"on_admission,omitempty"`          // Diagnosis present on Admission
-	PackageCode     []CodeableConcept  `json:"packageCode,omitempty" bson:"package_code,omitempty"`          // Package Code specific for billing
+	Id                     *string            `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension              []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension      []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	Sequence               *int               `json:"sequence,omitempty" bson:"sequence,omitempty"`                          // Ranking of the diagnosis (for each type)
+	SequenceElement        *Element           `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                 // Extensions for sequence
+	Condition              *CodeableReference `json:"condition" bson:"condition"`                                            // The diagnosis relevant to the account
+	DateOfDiagnosis        *DateTime          `json:"dateOfDiagnosis,omitempty" bson:"date_of_diagnosis,omitempty"`          // Date of the diagnosis (when coded diagnosis)
+	DateOfDiagnosisElement *Element           `json:"_dateOfDiagnosis,omitempty" bson:"date_of_diagnosis_element,omitempty"` // Extensions for dateOfDiagnosis
+	Type                   []CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                                  // Type that this diagnosis has relevant to the account (e.g. admission, billing, discharge …)
+	OnAdmission            *bool              `json:"onAdmission,omitempty" bson:"on_admission,omitempty"`                   // Diagnosis present on Admission
+	OnAdmissionElement     *Element           `json:"_onAdmission,omitempty" bson:"on_admission_element,omitempty"`          // Extensions for onAdmission
+	PackageCode            []CodeableConcept  `json:"packageCode,omitempty" bson:"package_code,omitempty"`                   // Package Code specific for billing
 }
 
 func (r *AccountDiagnosis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
 	if r.Condition == nil {
 		return fmt.Errorf("field 'Condition' is required")
 	}
@@ -202,11 +752,26 @@ func (r *AccountDiagnosis) Validate() error {
 			return fmt.Errorf("Condition: %w", err)
 		}
 	}
+	if r.DateOfDiagnosis != nil {
+		if err := r.DateOfDiagnosis.Validate(); err != nil {
+			return fmt.Errorf("DateOfDiagnosis: %w", err)
+		}
+	}
+	if r.DateOfDiagnosisElement != nil {
+		if err := r.DateOfDiagnosisElement.Validate(); err != nil {
+			return fmt.Errorf("DateOfDiagnosisElement: %w", err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
 		}
 	}
+	if r.OnAdmissionElement != nil {
+		if err := r.OnAdmissionElement.Validate(); err != nil {
+			return fmt.Errorf("OnAdmissionElement: %w", err)
+		}
+	}
 	for i, item := range r.PackageCode {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PackageCode[%d]: %w", i, err)
@@ -215,17 +780,119 @@ func (r *AccountDiagnosis) Validate() error {
 	return nil
 }
 
+func (r *AccountDiagnosis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Condition == nil {
+		issues.add("required", path+".condition", "field 'Condition' is required")
+	}
+	if r.Condition != nil {
+		r.Condition.validateAll(path+".condition", issues)
+	}
+	if r.DateOfDiagnosis != nil {
+		if err := r.DateOfDiagnosis.Validate(); err != nil {
+			issues.add("value", path+".dateOfDiagnosis", err.Error())
+		}
+	}
+	if r.DateOfDiagnosisElement != nil {
+		r.DateOfDiagnosisElement.validateAll(path+".dateOfDiagnosis", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.OnAdmissionElement != nil {
+		r.OnAdmissionElement.validateAll(path+".onAdmission", issues)
+	}
+	for i, item := range r.PackageCode {
+		item.validateAll(fmt.Sprintf("%s.packageCode[%d]", path, i), issues)
+	}
+}
+
+var accountDiagnosisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AccountDiagnosis) invariants() []invariant {
+	return accountDiagnosisInvariants
+}
+
+func (r AccountDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AccountDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AccountDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AccountDiagnosis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var accountDiagnosisElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *AccountDiagnosis) elementTypes() map[string]string {
+	return accountDiagnosisElementTypes
+}
+
+var accountDiagnosisElementMetadata = []ElementMetadata{
+	{Path: "Account.diagnosis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Account.diagnosis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Account.diagnosis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Account.diagnosis.sequence", Name: "sequence", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Account.diagnosis.condition", Name: "condition", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Account.diagnosis.dateOfDiagnosis", Name: "dateOfDiagnosis", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Account.diagnosis.type", Name: "type", Field: 8, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Account.diagnosis.onAdmission", Name: "onAdmission", Field: 9, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Account.diagnosis.packageCode", Name: "packageCode", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *AccountDiagnosis) ElementMetadata() []ElementMetadata {
+	return accountDiagnosisElementMetadata
+}
+
 type AccountProcedure struct {
-	Id            *string            `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Sequence      *int               `json:"sequence,omitempty" bson:"sequence,omitempty"`             // Ranking of the procedure (for each type)
-	Code          *CodeableReference `json:"code" bson:"code"`                                         // The procedure relevant to the account
-	DateOfService *string            `json:"dateOfService,omitempty" bson:"date_of_service,omitempty"` // Date of the procedure (when coded procedure)
-	Type          []CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                     // How this procedure value should be used in charging the account
-	PackageCode   []CodeableConcept  `json:"packageCode,omitempty" bson:"package_code,omitempty"`      // Package Code specific for billing
-	Device        []Reference        `json:"device,omitempty" bson:"device,omitempty"`                 // Any devices that were associated with the procedure
+	Id                   *string            `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
+	Extension            []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension    []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored even if unrecognized
+	Sequence             *int               `json:"sequence,omitempty" bson:"sequence,omitempty"`                      // Ranking of the procedure (for each type)
+	SequenceElement      *Element           `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`             // Extensions for sequence
+	Code                 *CodeableReference `json:"code" bson:"code"`                                                  // The procedure relevant to the account
+	DateOfService        *DateTime          `json:"dateOfService,omitempty" bson:"date_of_service,omitempty"`          // Date of the procedure (when coded procedure)
+	DateOfServiceElement *Element           `json:"_dateOfService,omitempty" bson:"date_of_service_element,omitempty"` // Extensions for dateOfService
+	Type                 []CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                              // How this procedure value should be used in charging the account
+	PackageCode          []CodeableConcept  `json:"packageCode,omitempty" bson:"package_code,omitempty"`               // Package Code specific for billing
+	Device               []Reference        `json:"device,omitempty" bson:"device,omitempty"`                          // Any devices that were associated with the procedure
 }
 
 func (r *AccountProcedure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -234,6 +901,16 @@ func (r *AccountProcedure) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.DateOfService != nil {
+		if err := r.DateOfService.Validate(); err != nil {
+			return fmt.Errorf("DateOfService: %w", err)
+		}
+	}
+	if r.DateOfServiceElement != nil {
+		if err := r.DateOfServiceElement.Validate(); err != nil {
+			return fmt.Errorf("DateOfServiceElement: %w", err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
@@ -252,15 +929,111 @@ func (r *AccountProcedure) Validate() error {
 	return nil
 }
 
+func (r *AccountProcedure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DateOfService != nil {
+		if err := r.DateOfService.Validate(); err != nil {
+			issues.add("value", path+".dateOfService", err.Error())
+		}
+	}
+	if r.DateOfServiceElement != nil {
+		r.DateOfServiceElement.validateAll(path+".dateOfService", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.PackageCode {
+		item.validateAll(fmt.Sprintf("%s.packageCode[%d]", path, i), issues)
+	}
+	for i, item := range r.Device {
+		item.validateAll(fmt.Sprintf("%s.device[%d]", path, i), issues)
+	}
+}
+
+var accountProcedureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AccountProcedure) invariants() []invariant {
+	return accountProcedureInvariants
+}
+
+func (r AccountProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AccountProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AccountProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AccountProcedure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var accountProcedureElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *AccountProcedure) elementTypes() map[string]string {
+	return accountProcedureElementTypes
+}
+
+var accountProcedureElementMetadata = []ElementMetadata{
+	{Path: "Account.procedure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Account.procedure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Account.procedure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Account.procedure.sequence", Name: "sequence", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Account.procedure.code", Name: "code", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Account.procedure.dateOfService", Name: "dateOfService", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Account.procedure.type", Name: "type", Field: 8, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Account.procedure.packageCode", Name: "packageCode", Field: 9, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Account.procedure.device", Name: "device", Field: 10, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *AccountProcedure) ElementMetadata() []ElementMetadata {
+	return accountProcedureElementMetadata
+}
+
 type AccountBalance struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Aggregate *CodeableConcept `json:"aggregate,omitempty" bson:"aggregate,omitempty"` // Who is expected to pay this part of the balance
-	Term      *CodeableConcept `json:"term,omitempty" bson:"term,omitempty"`           // current | 30 | 60 | 90 | 120
-	Estimate  *bool            `json:"estimate,omitempty" bson:"estimate,omitempty"`   // Estimated balance
-	Amount    *Money           `json:"amount" bson:"amount"`                           // Calculated amount
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Aggregate         *CodeableConcept `json:"aggregate,omitempty" bson:"aggregate,omitempty"`                  // Who is expected to pay this part of the balance
+	Term              *CodeableConcept `json:"term,omitempty" bson:"term,omitempty"`                            // current | 30 | 60 | 90 | 120
+	Estimate          *bool            `json:"estimate,omitempty" bson:"estimate,omitempty"`                    // Estimated balance
+	EstimateElement   *Element         `json:"_estimate,omitempty" bson:"estimate_element,omitempty"`           // Extensions for estimate
+	Amount            *Money           `json:"amount" bson:"amount"`                                            // Calculated amount
 }
 
 func (r *AccountBalance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Aggregate != nil {
 		if err := r.Aggregate.Validate(); err != nil {
 			return fmt.Errorf("Aggregate: %w", err)
@@ -271,6 +1044,11 @@ func (r *AccountBalance) Validate() error {
 			return fmt.Errorf("Term: %w", err)
 		}
 	}
+	if r.EstimateElement != nil {
+		if err := r.EstimateElement.Validate(); err != nil {
+			return fmt.Errorf("EstimateElement: %w", err)
+		}
+	}
 	if r.Amount == nil {
 		return fmt.Errorf("field 'Amount' is required")
 	}
@@ -281,3 +1059,65 @@ func (r *AccountBalance) Validate() error {
 	}
 	return nil
 }
+
+func (r *AccountBalance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Aggregate != nil {
+		r.Aggregate.validateAll(path+".aggregate", issues)
+	}
+	if r.Term != nil {
+		r.Term.validateAll(path+".term", issues)
+	}
+	if r.EstimateElement != nil {
+		r.EstimateElement.validateAll(path+".estimate", issues)
+	}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+var accountBalanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AccountBalance) invariants() []invariant {
+	return accountBalanceInvariants
+}
+
+func (r AccountBalance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AccountBalance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AccountBalance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AccountBalance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var accountBalanceElementMetadata = []ElementMetadata{
+	{Path: "Account.balance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Account.balance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Account.balance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Account.balance.aggregate", Name: "aggregate", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Account.balance.term", Name: "term", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Account.balance.estimate", Name: "estimate", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Account.balance.amount", Name: "amount", Field: 7, Min: 1, Max: "1", Types: []string{"Money"}},
+}
+
+func (r *AccountBalance) ElementMetadata() []ElementMetadata {
+	return accountBalanceElementMetadata
+}
@@ -2,76 +2,107 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource allows for the definition of some activity to be performed, independent of a particular patient, practitioner, or other performance context.
 type ActivityDefinition struct {
-	ResourceType                 string                           `json:"resourceType" bson:"resource_type"`                                                      // Type of resource
-	Id                           *string                          `json:"id,omitempty" bson:"id,omitempty"`                                                       // Logical id of this artifact
-	Meta                         *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                                                   // Metadata about the resource
-	ImplicitRules                *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                // A set of rules under which this content was created
-	Language                     *string                          `json:"language,omitempty" bson:"language,omitempty"`                                           // Language of the resource content
-	Text                         *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                                                   // Text summary of the resource, for human interpretation
-	Contained                    []json.RawMessage                `json:"contained,omitempty" bson:"contained,omitempty"`                                         // Contained, inline Resources
-	Url                          *string                          `json:"url,omitempty" bson:"url,omitempty"`                                                     // Canonical identifier for this activity definition, represented as a URI (globally unique)
-	Identifier                   []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                                       // Additional identifier for the activity definition
-	Version                      *string                          `json:"version,omitempty" bson:"version,omitempty"`                                             // Business version of the activity definition
-	VersionAlgorithmString       *string                          `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"`             // How to compare versions
-	VersionAlgorithmCoding       *Coding                          `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"`             // How to compare versions
-	Name                         *string                          `json:"name,omitempty" bson:"name,omitempty"`                                                   // Name for this activity definition (computer friendly)
-	Title                        *string                          `json:"title,omitempty" bson:"title,omitempty"`                                                 // Name for this activity definition (human friendly)
-	Subtitle                     *string                          `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                                           // Subordinate title of the activity definition
-	Status                       string                           `json:"status" bson:"status"`                                                                   // draft | active | retired | unknown
-	Experimental                 *bool                            `json:"experimental,omitempty" bson:"experimental,omitempty"`                                   // For testing only - never for real usage
-	SubjectCodeableConcept       *CodeableConcept                 `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"`             // Type of individual the activity definition is intended for
-	SubjectReference             *Reference                       `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`                          // Type of individual the activity definition is intended for
-	SubjectCanonical             *string                          `json:"subjectCanonical,omitempty" bson:"subject_canonical,omitempty"`                          // Type of individual the activity definition is intended for
-	Date                         *string                          `json:"date,omitempty" bson:"date,omitempty"`                                                   // Date last changed
-	Publisher                    *string                          `json:"publisher,omitempty" bson:"publisher,omitempty"`                                         // Name of the publisher/steward (organization or individual)
-	Contact                      []ContactDetail                  `json:"contact,omitempty" bson:"contact,omitempty"`                                             // Contact details for the publisher
-	Description                  *string                          `json:"description,omitempty" bson:"description,omitempty"`                                     // Natural language description of the activity definition
-	UseContext                   []UsageContext                   `json:"useContext,omitempty" bson:"use_context,omitempty"`                                      // The context that the content is intended to support
-	Jurisdiction                 []CodeableConcept                `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                   // Jurisdiction of the authority that maintains the activity definition (if applicable)
-	Purpose                      *string                          `json:"purpose,omitempty" bson:"purpose,omitempty"`                                             // Why this activity definition is defined
-	Usage                        *string                          `json:"usage,omitempty" bson:"usage,omitempty"`                                                 // Describes the clinical usage of the activity definition
-	Copyright                    *string                          `json:"copyright,omitempty" bson:"copyright,omitempty"`                                         // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel               *string                          `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                              // Copyright holder and year(s)
-	ApprovalDate                 *string                          `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                                  // When the activity definition was approved by publisher
-	LastReviewDate               *string                          `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                             // When the activity definition was last reviewed by the publisher
-	EffectivePeriod              *Period                          `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                            // When the activity definition is expected to be used
-	Topic                        []CodeableConcept                `json:"topic,omitempty" bson:"topic,omitempty"`                                                 // E.g. Education, Treatment, Assessment, etc
-	Author                       []ContactDetail                  `json:"author,omitempty" bson:"author,omitempty"`                                               // Who authored the content
-	Editor                       []ContactDetail                  `json:"editor,omitempty" bson:"editor,omitempty"`                                               // Who edited the content
-	Reviewer                     []ContactDetail                  `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                                           // Who reviewed the content
-	Endorser                     []ContactDetail                  `json:"endorser,omitempty" bson:"endorser,omitempty"`                                           // Who endorsed the content
-	RelatedArtifact              []RelatedArtifact                `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                            // Additional documentation, citations, etc
-	Library                      []string                         `json:"library,omitempty" bson:"library,omitempty"`                                             // Logic used by the activity definition
-	Kind                         *string                          `json:"kind,omitempty" bson:"kind,omitempty"`                                                   // Kind of resource
-	Profile                      *string                          `json:"profile,omitempty" bson:"profile,omitempty"`                                             // What profile the resource needs to conform to
-	Code                         *CodeableConcept                 `json:"code,omitempty" bson:"code,omitempty"`                                                   // Detail type of activity
-	Intent                       *string                          `json:"intent,omitempty" bson:"intent,omitempty"`                                               // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Priority                     *string                          `json:"priority,omitempty" bson:"priority,omitempty"`                                           // routine | urgent | asap | stat
-	DoNotPerform                 *bool                            `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`                                 // True if the activity should not be performed
-	TimingTiming                 *Timing                          `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`                                  // When activity is to occur
-	TimingAge                    *Age                             `json:"timingAge,omitempty" bson:"timing_age,omitempty"`                                        // When activity is to occur
-	TimingRange                  *Range                           `json:"timingRange,omitempty" bson:"timing_range,omitempty"`                                    // When activity is to occur
-	TimingDuration               *Duration                        `json:"timingDuration,omitempty" bson:"timing_duration,omitempty"`                              // When activity is to occur
-	TimingRelativeTime           *RelativeTime                    `json:"timingRelativeTime,omitempty" bson:"timing_relative_time,omitempty"`                     // When activity is to occur
-	AsNeededBoolean              *bool                            `json:"asNeededBoolean,omitempty" bson:"as_needed_boolean,omitempty"`                           // Preconditions for service
-	AsNeededCodeableConcept      *CodeableConcept                 `json:"asNeededCodeableConcept,omitempty" bson:"as_needed_codeable_concept,omitempty"`          // Preconditions for service
-	Location                     *CodeableReference               `json:"location,omitempty" bson:"location,omitempty"`                                           // Where it should happen
-	Participant                  []ActivityDefinitionParticipant  `json:"participant,omitempty" bson:"participant,omitempty"`                                     // Who should participate in the action
-	ProductReference             *Reference                       `json:"productReference,omitempty" bson:"product_reference,omitempty"`                          // What's administered/supplied
-	ProductCodeableConcept       *CodeableConcept                 `json:"productCodeableConcept,omitempty" bson:"product_codeable_concept,omitempty"`             // What's administered/supplied
-	Quantity                     *Quantity                        `json:"quantity,omitempty" bson:"quantity,omitempty"`                                           // How much is administered/consumed/supplied
-	Dosage                       []Dosage                         `json:"dosage,omitempty" bson:"dosage,omitempty"`                                               // Detailed dosage instructions
-	BodySite                     []CodeableConcept                `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                          // What part of body to perform on
-	SpecimenRequirement          []string                         `json:"specimenRequirement,omitempty" bson:"specimen_requirement,omitempty"`                    // What specimens are required to perform this action
-	ObservationRequirement       []string                         `json:"observationRequirement,omitempty" bson:"observation_requirement,omitempty"`              // What observations are required to perform this action
-	ObservationResultRequirement []string                         `json:"observationResultRequirement,omitempty" bson:"observation_result_requirement,omitempty"` // What observations must be produced by this action
-	Transform                    *string                          `json:"transform,omitempty" bson:"transform,omitempty"`                                         // Transform to apply the template
-	DynamicValue                 []ActivityDefinitionDynamicValue `json:"dynamicValue,omitempty" bson:"dynamic_value,omitempty"`                                  // Dynamic aspects of the definition
+	ResourceType                        string                             `json:"resourceType" bson:"resource_type"`                                                               // Type of resource
+	Id                                  *string                            `json:"id,omitempty" bson:"id,omitempty"`                                                                // Logical id of this artifact
+	Meta                                *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                                                            // Metadata about the resource
+	ImplicitRules                       *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                         // A set of rules under which this content was created
+	ImplicitRulesElement                *Element                           `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                                // Extensions for implicitRules
+	Language                            *string                            `json:"language,omitempty" bson:"language,omitempty"`                                                    // Language of the resource content
+	LanguageElement                     *Element                           `json:"_language,omitempty" bson:"language_element,omitempty"`                                           // Extensions for language
+	Text                                *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                                                            // Text summary of the resource, for human interpretation
+	Contained                           []Resource                         `json:"contained,omitempty" bson:"contained,omitempty"`                                                  // Contained, inline Resources
+	Extension                           []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                                                  // Additional content defined by implementations
+	ModifierExtension                   []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                                 // Extensions that cannot be ignored
+	Url                                 *string                            `json:"url,omitempty" bson:"url,omitempty"`                                                              // Canonical identifier for this activity definition, represented as a URI (globally unique)
+	UrlElement                          *Element                           `json:"_url,omitempty" bson:"url_element,omitempty"`                                                     // Extensions for url
+	Identifier                          []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                                                // Additional identifier for the activity definition
+	Version                             *string                            `json:"version,omitempty" bson:"version,omitempty"`                                                      // Business version of the activity definition
+	VersionElement                      *Element                           `json:"_version,omitempty" bson:"version_element,omitempty"`                                             // Extensions for version
+	VersionAlgorithm                    ActivityDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                                            // How to compare versions
+	VersionAlgorithmElement             *Element                           `json:"-" bson:"version_algorithm_element,omitempty"`                                                    // Extensions for versionAlgorithm[x]
+	Name                                *string                            `json:"name,omitempty" bson:"name,omitempty"`                                                            // Name for this activity definition (computer friendly)
+	NameElement                         *Element                           `json:"_name,omitempty" bson:"name_element,omitempty"`                                                   // Extensions for name
+	Title                               *string                            `json:"title,omitempty" bson:"title,omitempty"`                                                          // Name for this activity definition (human friendly)
+	TitleElement                        *Element                           `json:"_title,omitempty" bson:"title_element,omitempty"`                                                 // Extensions for title
+	Subtitle                            *string                            `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                                                    // Subordinate title of the activity definition
+	SubtitleElement                     *Element                           `json:"_subtitle,omitempty" bson:"subtitle_element,omitempty"`                                           // Extensions for subtitle
+	Status                              PublicationStatus                  `json:"status" bson:"status"`                                                                            // draft | active | retired | unknown
+	StatusElement                       *Element                           `json:"_status,omitempty" bson:"status_element,omitempty"`                                               // Extensions for status
+	Experimental                        *bool                              `json:"experimental,omitempty" bson:"experimental,omitempty"`                                            // For testing only - never for real usage
+	ExperimentalElement                 *Element                           `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                                   // Extensions for experimental
+	Subject                             ActivityDefinitionSubject          `json:"-" bson:"subject,omitempty"`                                                                      // Type of individual the activity definition is intended for
+	SubjectElement                      *Element                           `json:"-" bson:"subject_element,omitempty"`                                                              // Extensions for subject[x]
+	Date                                *DateTime                          `json:"date,omitempty" bson:"date,omitempty"`                                                            // Date last changed
+	DateElement                         *Element                           `json:"_date,omitempty" bson:"date_element,omitempty"`                                                   // Extensions for date
+	Publisher                           *string                            `json:"publisher,omitempty" bson:"publisher,omitempty"`                                                  // Name of the publisher/steward (organization or individual)
+	PublisherElement                    *Element                           `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                                         // Extensions for publisher
+	Contact                             []ContactDetail                    `json:"contact,omitempty" bson:"contact,omitempty"`                                                      // Contact details for the publisher
+	Description                         *string                            `json:"description,omitempty" bson:"description,omitempty"`                                              // Natural language description of the activity definition
+	DescriptionElement                  *Element                           `json:"_description,omitempty" bson:"description_element,omitempty"`                                     // Extensions for description
+	UseContext                          []UsageContext                     `json:"useContext,omitempty" bson:"use_context,omitempty"`                                               // The context that the content is intended to support
+	Jurisdiction                        []CodeableConcept                  `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                            // Jurisdiction of the authority that maintains the activity definition (if applicable)
+	Purpose                             *string                            `json:"purpose,omitempty" bson:"purpose,omitempty"`                                                      // Why this activity definition is defined
+	PurposeElement                      *Element                           `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                                             // Extensions for purpose
+	Usage                               *string                            `json:"usage,omitempty" bson:"usage,omitempty"`                                                          // Describes the clinical usage of the activity definition
+	UsageElement                        *Element                           `json:"_usage,omitempty" bson:"usage_element,omitempty"`                                                 // Extensions for usage
+	Copyright                           *string                            `json:"copyright,omitempty" bson:"copyright,omitempty"`                                                  // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement                    *Element                           `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                                         // Extensions for copyright
+	CopyrightLabel                      *string                            `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                                       // Copyright holder and year(s)
+	CopyrightLabelElement               *Element                           `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`                              // Extensions for copyrightLabel
+	ApprovalDate                        *Date                              `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                                           // When the activity definition was approved by publisher
+	ApprovalDateElement                 *Element                           `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`                                  // Extensions for approvalDate
+	LastReviewDate                      *Date                              `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                                      // When the activity definition was last reviewed by the publisher
+	LastReviewDateElement               *Element                           `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`                             // Extensions for lastReviewDate
+	EffectivePeriod                     *Period                            `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                                     // When the activity definition is expected to be used
+	Topic                               []CodeableConcept                  `json:"topic,omitempty" bson:"topic,omitempty"`                                                          // E.g. Education, Treatment, Assessment, etc
+	Author                              []ContactDetail                    `json:"author,omitempty" bson:"author,omitempty"`                                                        // Who authored the content
+	Editor                              []ContactDetail                    `json:"editor,omitempty" bson:"editor,omitempty"`                                                        // Who edited the content
+	Reviewer                            []ContactDetail                    `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                                                    // Who reviewed the content
+	Endorser                            []ContactDetail                    `json:"endorser,omitempty" bson:"endorser,omitempty"`                                                    // Who endorsed the content
+	RelatedArtifact                     []RelatedArtifact                  `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                                     // Additional documentation, citations, etc
+	Library                             []string                           `json:"library,omitempty" bson:"library,omitempty"`                                                      // Logic used by the activity definition
+	LibraryElement                      []*Element                         `json:"_library,omitempty" bson:"library_element,omitempty"`                                             // Extensions for library
+	Kind                                *string                            `json:"kind,omitempty" bson:"kind,omitempty"`                                                            // Kind of resource
+	KindElement                         *Element                           `json:"_kind,omitempty" bson:"kind_element,omitempty"`                                                   // Extensions for kind
+	Profile                             *string                            `json:"profile,omitempty" bson:"profile,omitempty"`                                                      // What profile the resource needs to conform to
+	ProfileElement                      *Element                           `json:"_profile,omitempty" bson:"profile_element,omitempty"`                                             // Extensions for profile
+	Code                                *CodeableConcept                   `json:"code,omitempty" bson:"code,omitempty"`                                                            // Detail type of activity
+	Intent                              *CarePlanIntent                    `json:"intent,omitempty" bson:"intent,omitempty"`                                                        // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement                       *Element                           `json:"_intent,omitempty" bson:"intent_element,omitempty"`                                               // Extensions for intent
+	Priority                            *RequestPriority                   `json:"priority,omitempty" bson:"priority,omitempty"`                                                    // routine | urgent | asap | stat
+	PriorityElement                     *Element                           `json:"_priority,omitempty" bson:"priority_element,omitempty"`                                           // Extensions for priority
+	DoNotPerform                        *bool                              `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`                                          // True if the activity should not be performed
+	DoNotPerformElement                 *Element                           `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`                                 // Extensions for doNotPerform
+	Timing                              ActivityDefinitionTiming           `json:"-" bson:"timing,omitempty"`                                                                       // When activity is to occur
+	AsNeeded                            ActivityDefinitionAsNeeded         `json:"-" bson:"as_needed,omitempty"`                                                                    // Preconditions for service
+	AsNeededElement                     *Element                           `json:"-" bson:"as_needed_element,omitempty"`                                                            // Extensions for asNeeded[x]
+	Location                            *CodeableReference                 `json:"location,omitempty" bson:"location,omitempty"`                                                    // Where it should happen
+	Participant                         []ActivityDefinitionParticipant    `json:"participant,omitempty" bson:"participant,omitempty"`                                              // Who should participate in the action
+	Product                             ActivityDefinitionProduct          `json:"-" bson:"product,omitempty"`                                                                      // What's administered/supplied
+	Quantity                            *Quantity                          `json:"quantity,omitempty" bson:"quantity,omitempty"`                                                    // How much is administered/consumed/supplied
+	Dosage                              []Dosage                           `json:"dosage,omitempty" bson:"dosage,omitempty"`                                                        // Detailed dosage instructions
+	BodySite                            []CodeableConcept                  `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                                   // What part of body to perform on
+	SpecimenRequirement                 []string                           `json:"specimenRequirement,omitempty" bson:"specimen_requirement,omitempty"`                             // What specimens are required to perform this action
+	SpecimenRequirementElement          []*Element                         `json:"_specimenRequirement,omitempty" bson:"specimen_requirement_element,omitempty"`                    // Extensions for specimenRequirement
+	ObservationRequirement              []string                           `json:"observationRequirement,omitempty" bson:"observation_requirement,omitempty"`                       // What observations are required to perform this action
+	ObservationRequirementElement       []*Element                         `json:"_observationRequirement,omitempty" bson:"observation_requirement_element,omitempty"`              // Extensions for observationRequirement
+	ObservationResultRequirement        []string                           `json:"observationResultRequirement,omitempty" bson:"observation_result_requirement,omitempty"`          // What observations must be produced by this action
+	ObservationResultRequirementElement []*Element                         `json:"_observationResultRequirement,omitempty" bson:"observation_result_requirement_element,omitempty"` // Extensions for observationResultRequirement
+	Transform                           *string                            `json:"transform,omitempty" bson:"transform,omitempty"`                                                  // Transform to apply the template
+	TransformElement                    *Element                           `json:"_transform,omitempty" bson:"transform_element,omitempty"`                                         // Extensions for transform
+	DynamicValue                        []ActivityDefinitionDynamicValue   `json:"dynamicValue,omitempty" bson:"dynamic_value,omitempty"`                                           // Dynamic aspects of the definition
+
+	versionAlgorithmVariants []string // JSON properties of ActivityDefinition.versionAlgorithm[x] when more than one was decoded
+	subjectVariants          []string // JSON properties of ActivityDefinition.subject[x] when more than one was decoded
+	timingVariants           []string // JSON properties of ActivityDefinition.timing[x] when more than one was decoded
+	asNeededVariants         []string // JSON properties of ActivityDefinition.asNeeded[x] when more than one was decoded
+	productVariants          []string // JSON properties of ActivityDefinition.product[x] when more than one was decoded
 }
 
 func (r *ActivityDefinition) Validate() error {
@@ -83,33 +114,124 @@ func (r *ActivityDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.SubtitleElement != nil {
+		if err := r.SubtitleElement.Validate(); err != nil {
+			return fmt.Errorf("SubtitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.SubjectElement != nil {
+		if err := r.SubjectElement.Validate(); err != nil {
+			return fmt.Errorf("SubjectElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -117,6 +239,11 @@ func (r *ActivityDefinition) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -127,6 +254,46 @@ func (r *ActivityDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -162,39 +329,69 @@ func (r *ActivityDefinition) Validate() error {
 			return fmt.Errorf("RelatedArtifact[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LibraryElement[%d]: %w", i, err)
+		}
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	if r.ProfileElement != nil {
+		if err := r.ProfileElement.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
+	if r.Intent != nil && !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", *r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
 		}
 	}
-	if r.TimingAge != nil {
-		if err := r.TimingAge.Validate(); err != nil {
-			return fmt.Errorf("TimingAge: %w", err)
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
 		}
 	}
-	if r.TimingRange != nil {
-		if err := r.TimingRange.Validate(); err != nil {
-			return fmt.Errorf("TimingRange: %w", err)
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
 		}
 	}
-	if r.TimingDuration != nil {
-		if err := r.TimingDuration.Validate(); err != nil {
-			return fmt.Errorf("TimingDuration: %w", err)
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
+	}
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
 		}
 	}
-	if r.TimingRelativeTime != nil {
-		if err := r.TimingRelativeTime.Validate(); err != nil {
-			return fmt.Errorf("TimingRelativeTime: %w", err)
+	if len(r.asNeededVariants) > 1 {
+		return fmt.Errorf("field 'AsNeeded' must have a single type, got %v", r.asNeededVariants)
+	}
+	if r.AsNeeded != nil {
+		if err := r.AsNeeded.Validate(); err != nil {
+			return fmt.Errorf("AsNeeded: %w", err)
 		}
 	}
-	if r.AsNeededCodeableConcept != nil {
-		if err := r.AsNeededCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("AsNeededCodeableConcept: %w", err)
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
 		}
 	}
 	if r.Location != nil {
@@ -207,14 +404,12 @@ func (r *ActivityDefinition) Validate() error {
 			return fmt.Errorf("Participant[%d]: %w", i, err)
 		}
 	}
-	if r.ProductReference != nil {
-		if err := r.ProductReference.Validate(); err != nil {
-			return fmt.Errorf("ProductReference: %w", err)
-		}
+	if len(r.productVariants) > 1 {
+		return fmt.Errorf("field 'Product' must have a single type, got %v", r.productVariants)
 	}
-	if r.ProductCodeableConcept != nil {
-		if err := r.ProductCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ProductCodeableConcept: %w", err)
+	if r.Product != nil {
+		if err := r.Product.Validate(); err != nil {
+			return fmt.Errorf("Product: %w", err)
 		}
 	}
 	if r.Quantity != nil {
@@ -232,60 +427,1219 @@ func (r *ActivityDefinition) Validate() error {
 			return fmt.Errorf("BodySite[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.SpecimenRequirementElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SpecimenRequirementElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ObservationRequirementElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ObservationRequirementElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ObservationResultRequirementElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ObservationResultRequirementElement[%d]: %w", i, err)
+		}
+	}
+	if r.TransformElement != nil {
+		if err := r.TransformElement.Validate(); err != nil {
+			return fmt.Errorf("TransformElement: %w", err)
+		}
+	}
 	for i, item := range r.DynamicValue {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("DynamicValue[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ActivityDefinitionParticipant struct {
-	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Type          *string          `json:"type,omitempty" bson:"type,omitempty"`                    // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
-	TypeCanonical *string          `json:"typeCanonical,omitempty" bson:"type_canonical,omitempty"` // Who or what can participate
-	TypeReference *Reference       `json:"typeReference,omitempty" bson:"type_reference,omitempty"` // Who or what can participate
-	Role          *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                    // E.g. Nurse, Surgeon, Parent, etc
-	Function      *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`            // E.g. Author, Reviewer, Witness, etc
+	return validateInvariants(r, "ActivityDefinition")
 }
 
-func (r *ActivityDefinitionParticipant) Validate() error {
-	if r.TypeReference != nil {
-		if err := r.TypeReference.Validate(); err != nil {
-			return fmt.Errorf("TypeReference: %w", err)
+func (r *ActivityDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ActivityDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ActivityDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.SubtitleElement != nil {
+		r.SubtitleElement.validateAll(path+".subtitle", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.SubjectElement != nil {
+		r.SubjectElement.validateAll(path+".subject", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.Function != nil {
-		if err := r.Function.Validate(); err != nil {
-			return fmt.Errorf("Function: %w", err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	return nil
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.library[%d]", path, i), issues)
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.ProfileElement != nil {
+		r.ProfileElement.validateAll(path+".profile", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Intent != nil && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", *r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if len(r.asNeededVariants) > 1 {
+		issues.add("structure", path+".asNeeded", fmt.Sprintf("field 'AsNeeded' must have a single type, got %v", r.asNeededVariants))
+	}
+	if r.AsNeeded != nil {
+		r.AsNeeded.validateAll(path+".asNeeded.ofType("+r.AsNeeded.FHIRType()+")", issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	if len(r.productVariants) > 1 {
+		issues.add("structure", path+".product", fmt.Sprintf("field 'Product' must have a single type, got %v", r.productVariants))
+	}
+	if r.Product != nil {
+		r.Product.validateAll(path+".product.ofType("+r.Product.FHIRType()+")", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	for i, item := range r.Dosage {
+		item.validateAll(fmt.Sprintf("%s.dosage[%d]", path, i), issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	for i, item := range r.SpecimenRequirementElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.specimenRequirement[%d]", path, i), issues)
+	}
+	for i, item := range r.ObservationRequirementElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.observationRequirement[%d]", path, i), issues)
+	}
+	for i, item := range r.ObservationResultRequirementElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.observationResultRequirement[%d]", path, i), issues)
+	}
+	if r.TransformElement != nil {
+		r.TransformElement.validateAll(path+".transform", issues)
+	}
+	for i, item := range r.DynamicValue {
+		item.validateAll(fmt.Sprintf("%s.dynamicValue[%d]", path, i), issues)
+	}
 }
 
-type ActivityDefinitionDynamicValue struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Path       string      `json:"path" bson:"path"`                 // The path to the element to be set dynamically
-	Expression *Expression `json:"expression" bson:"expression"`     // An expression that provides the dynamic value for the customization
+func (r *ActivityDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ActivityDefinition", &issues)
+	checkInvariants(r, "ActivityDefinition", &issues)
+	return issues
 }
 
-func (r *ActivityDefinitionDynamicValue) Validate() error {
-	var emptyString string
-	if r.Path == emptyString {
-		return fmt.Errorf("field 'Path' is required")
+var activityDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ActivityDefinition) invariants() []invariant {
+	return activityDefinitionInvariants
+}
+
+func (r ActivityDefinition) MarshalJSON() ([]byte, error) {
+	type alias ActivityDefinition
+	out := struct {
+		alias
+		Library                             []*string  `json:"library,omitempty"`
+		LibraryElement                      []*Element `json:"_library,omitempty"`
+		SpecimenRequirement                 []*string  `json:"specimenRequirement,omitempty"`
+		SpecimenRequirementElement          []*Element `json:"_specimenRequirement,omitempty"`
+		ObservationRequirement              []*string  `json:"observationRequirement,omitempty"`
+		ObservationRequirementElement       []*Element `json:"_observationRequirement,omitempty"`
+		ObservationResultRequirement        []*string  `json:"observationResultRequirement,omitempty"`
+		ObservationResultRequirementElement []*Element `json:"_observationResultRequirement,omitempty"`
+	}{alias: alias(r)}
+	out.Library, out.LibraryElement = alignPrimitiveArray(r.Library, r.LibraryElement)
+	out.SpecimenRequirement, out.SpecimenRequirementElement = alignPrimitiveArray(r.SpecimenRequirement, r.SpecimenRequirementElement)
+	out.ObservationRequirement, out.ObservationRequirementElement = alignPrimitiveArray(r.ObservationRequirement, r.ObservationRequirementElement)
+	out.ObservationResultRequirement, out.ObservationResultRequirementElement = alignPrimitiveArray(r.ObservationResultRequirement, r.ObservationResultRequirementElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.Expression == nil {
-		return fmt.Errorf("field 'Expression' is required")
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	if r.Expression != nil {
-		if err := r.Expression.Validate(); err != nil {
-			return fmt.Errorf("Expression: %w", err)
+	if data, err = marshalChoice(data, "subject", r.Subject, r.SubjectElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, nil); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "asNeeded", r.AsNeeded, r.AsNeededElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "product", r.Product, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ActivityDefinition) UnmarshalJSON(data []byte) error {
+	type alias ActivityDefinition
+	aux := struct {
+		*alias
+		Contained                    []json.RawMessage `json:"contained,omitempty"`
+		Library                      []*string         `json:"library,omitempty"`
+		SpecimenRequirement          []*string         `json:"specimenRequirement,omitempty"`
+		ObservationRequirement       []*string         `json:"observationRequirement,omitempty"`
+		ObservationResultRequirement []*string         `json:"observationResultRequirement,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Library, err = splitPrimitiveArray(aux.Library, r.LibraryElement); err != nil {
+		return fmt.Errorf("library: %w", err)
+	}
+	if r.SpecimenRequirement, err = splitPrimitiveArray(aux.SpecimenRequirement, r.SpecimenRequirementElement); err != nil {
+		return fmt.Errorf("specimenRequirement: %w", err)
+	}
+	if r.ObservationRequirement, err = splitPrimitiveArray(aux.ObservationRequirement, r.ObservationRequirementElement); err != nil {
+		return fmt.Errorf("observationRequirement: %w", err)
+	}
+	if r.ObservationResultRequirement, err = splitPrimitiveArray(aux.ObservationResultRequirement, r.ObservationResultRequirementElement); err != nil {
+		return fmt.Errorf("observationResultRequirement: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", activityDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.Subject, element, r.subjectVariants, err = unmarshalChoice(fields, "subject", activityDefinitionSubjectVariants); err != nil {
+		return err
+	}
+	r.SubjectElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.SubjectElement); err != nil {
+			return fmt.Errorf("_subject: %w", err)
+		}
+	}
+	if r.Timing, _, r.timingVariants, err = unmarshalChoice(fields, "timing", activityDefinitionTimingVariants); err != nil {
+		return err
+	}
+	if r.AsNeeded, element, r.asNeededVariants, err = unmarshalChoice(fields, "asNeeded", activityDefinitionAsNeededVariants); err != nil {
+		return err
+	}
+	r.AsNeededElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AsNeededElement); err != nil {
+			return fmt.Errorf("_asNeeded: %w", err)
 		}
 	}
+	if r.Product, _, r.productVariants, err = unmarshalChoice(fields, "product", activityDefinitionProductVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ActivityDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ActivityDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ActivityDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ActivityDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ActivityDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(activityDefinitionVersionAlgorithmVariants)
+	case "Subject":
+		return "subject", choiceValues(activityDefinitionSubjectVariants)
+	case "Timing":
+		return "timing", choiceValues(activityDefinitionTimingVariants)
+	case "AsNeeded":
+		return "asNeeded", choiceValues(activityDefinitionAsNeededVariants)
+	case "Product":
+		return "product", choiceValues(activityDefinitionProductVariants)
+	}
+	return "", nil
+}
+
+var activityDefinitionElementTypes = map[string]string{
+	"id":                           "id",
+	"implicitRules":                "uri",
+	"language":                     "code",
+	"url":                          "uri",
+	"description":                  "markdown",
+	"purpose":                      "markdown",
+	"usage":                        "markdown",
+	"copyright":                    "markdown",
+	"library":                      "canonical",
+	"kind":                         "code",
+	"profile":                      "canonical",
+	"specimenRequirement":          "canonical",
+	"observationRequirement":       "canonical",
+	"observationResultRequirement": "canonical",
+	"transform":                    "canonical",
+}
+
+func (r *ActivityDefinition) elementTypes() map[string]string {
+	return activityDefinitionElementTypes
+}
+
+var activityDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ActivityDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ActivityDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ActivityDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ActivityDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActivityDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ActivityDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ActivityDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ActivityDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ActivityDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ActivityDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ActivityDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ActivityDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ActivityDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.subtitle", Name: "subtitle", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActivityDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ActivityDefinition.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ActivityDefinition.subject[x]", Name: "subject", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "ActivityDefinition.date", Name: "date", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ActivityDefinition.publisher", Name: "publisher", Field: 32, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.contact", Name: "contact", Field: 34, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ActivityDefinition.description", Name: "description", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActivityDefinition.useContext", Name: "useContext", Field: 37, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ActivityDefinition.jurisdiction", Name: "jurisdiction", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ActivityDefinition.purpose", Name: "purpose", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActivityDefinition.usage", Name: "usage", Field: 41, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActivityDefinition.copyright", Name: "copyright", Field: 43, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActivityDefinition.copyrightLabel", Name: "copyrightLabel", Field: 45, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.approvalDate", Name: "approvalDate", Field: 47, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ActivityDefinition.lastReviewDate", Name: "lastReviewDate", Field: 49, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ActivityDefinition.effectivePeriod", Name: "effectivePeriod", Field: 51, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ActivityDefinition.topic", Name: "topic", Field: 52, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ActivityDefinition.author", Name: "author", Field: 53, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ActivityDefinition.editor", Name: "editor", Field: 54, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ActivityDefinition.reviewer", Name: "reviewer", Field: 55, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ActivityDefinition.endorser", Name: "endorser", Field: 56, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ActivityDefinition.relatedArtifact", Name: "relatedArtifact", Field: 57, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "ActivityDefinition.library", Name: "library", Field: 58, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ActivityDefinition.kind", Name: "kind", Field: 60, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ActivityDefinition.profile", Name: "profile", Field: 62, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ActivityDefinition.code", Name: "code", Field: 64, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ActivityDefinition.intent", Name: "intent", Field: 65, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActivityDefinition.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"}},
+	{Path: "ActivityDefinition.priority", Name: "priority", Field: 67, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActivityDefinition.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "ActivityDefinition.doNotPerform", Name: "doNotPerform", Field: 69, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ActivityDefinition.timing[x]", Name: "timing", Field: 71, Min: 0, Max: "1", Types: []string{"Timing", "Age", "Range", "Duration", "RelativeTime"}},
+	{Path: "ActivityDefinition.asNeeded[x]", Name: "asNeeded", Field: 72, Min: 0, Max: "1", Types: []string{"boolean", "CodeableConcept"}},
+	{Path: "ActivityDefinition.location", Name: "location", Field: 74, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ActivityDefinition.participant", Name: "participant", Field: 75, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ActivityDefinition.product[x]", Name: "product", Field: 76, Min: 0, Max: "1", Types: []string{"Reference", "CodeableConcept"}},
+	{Path: "ActivityDefinition.quantity", Name: "quantity", Field: 77, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ActivityDefinition.dosage", Name: "dosage", Field: 78, Min: 0, Max: "*", Types: []string{"Dosage"}},
+	{Path: "ActivityDefinition.bodySite", Name: "bodySite", Field: 79, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ActivityDefinition.specimenRequirement", Name: "specimenRequirement", Field: 80, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ActivityDefinition.observationRequirement", Name: "observationRequirement", Field: 82, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ActivityDefinition.observationResultRequirement", Name: "observationResultRequirement", Field: 84, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ActivityDefinition.transform", Name: "transform", Field: 86, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ActivityDefinition.dynamicValue", Name: "dynamicValue", Field: 88, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ActivityDefinition) ElementMetadata() []ElementMetadata {
+	return activityDefinitionElementMetadata
+}
+
+// ActivityDefinitionVersionAlgorithm is the type of ActivityDefinition.versionAlgorithm[x]. It is implemented by the ActivityDefinitionVersionAlgorithm* variant types.
+type ActivityDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActivityDefinitionVersionAlgorithm()
+}
+
+// ActivityDefinitionVersionAlgorithmString is the string variant of ActivityDefinitionVersionAlgorithm.
+type ActivityDefinitionVersionAlgorithmString string
+
+func (v ActivityDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v ActivityDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ActivityDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v ActivityDefinitionVersionAlgorithmString) isActivityDefinitionVersionAlgorithm() {}
+
+// ActivityDefinitionVersionAlgorithmCoding is the Coding variant of ActivityDefinitionVersionAlgorithm.
+type ActivityDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ActivityDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ActivityDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ActivityDefinitionVersionAlgorithmCoding) isActivityDefinitionVersionAlgorithm() {}
+
+var activityDefinitionVersionAlgorithmVariants = []ActivityDefinitionVersionAlgorithm{
+	ActivityDefinitionVersionAlgorithmString(""),
+	ActivityDefinitionVersionAlgorithmCoding{},
+}
+
+// ActivityDefinitionSubject is the type of ActivityDefinition.subject[x]. It is implemented by the ActivityDefinitionSubject* variant types.
+type ActivityDefinitionSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActivityDefinitionSubject()
+}
+
+// ActivityDefinitionSubjectCodeableConcept is the CodeableConcept variant of ActivityDefinitionSubject.
+type ActivityDefinitionSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ActivityDefinitionSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ActivityDefinitionSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ActivityDefinitionSubjectCodeableConcept) isActivityDefinitionSubject() {}
+
+// ActivityDefinitionSubjectReference is the Reference variant of ActivityDefinitionSubject.
+type ActivityDefinitionSubjectReference struct {
+	Reference
+}
+
+func (v ActivityDefinitionSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ActivityDefinitionSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ActivityDefinitionSubjectReference) isActivityDefinitionSubject() {}
+
+// ActivityDefinitionSubjectCanonical is the canonical variant of ActivityDefinitionSubject.
+type ActivityDefinitionSubjectCanonical string
+
+func (v ActivityDefinitionSubjectCanonical) Validate() error {
+	return nil
+}
+
+func (v ActivityDefinitionSubjectCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ActivityDefinitionSubjectCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ActivityDefinitionSubjectCanonical) isActivityDefinitionSubject() {}
+
+var activityDefinitionSubjectVariants = []ActivityDefinitionSubject{
+	ActivityDefinitionSubjectCodeableConcept{},
+	ActivityDefinitionSubjectReference{},
+	ActivityDefinitionSubjectCanonical(""),
+}
+
+// ActivityDefinitionTiming is the type of ActivityDefinition.timing[x]. It is implemented by the ActivityDefinitionTiming* variant types.
+type ActivityDefinitionTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActivityDefinitionTiming()
+}
+
+// ActivityDefinitionTimingTiming is the Timing variant of ActivityDefinitionTiming.
+type ActivityDefinitionTimingTiming struct {
+	Timing
+}
+
+func (v ActivityDefinitionTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ActivityDefinitionTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ActivityDefinitionTimingTiming) isActivityDefinitionTiming() {}
+
+// ActivityDefinitionTimingAge is the Age variant of ActivityDefinitionTiming.
+type ActivityDefinitionTimingAge struct {
+	Age
+}
+
+func (v ActivityDefinitionTimingAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ActivityDefinitionTimingAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionTimingAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ActivityDefinitionTimingAge) isActivityDefinitionTiming() {}
+
+// ActivityDefinitionTimingRange is the Range variant of ActivityDefinitionTiming.
+type ActivityDefinitionTimingRange struct {
+	Range
+}
+
+func (v ActivityDefinitionTimingRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ActivityDefinitionTimingRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionTimingRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ActivityDefinitionTimingRange) isActivityDefinitionTiming() {}
+
+// ActivityDefinitionTimingDuration is the Duration variant of ActivityDefinitionTiming.
+type ActivityDefinitionTimingDuration struct {
+	Duration
+}
+
+func (v ActivityDefinitionTimingDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ActivityDefinitionTimingDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionTimingDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ActivityDefinitionTimingDuration) isActivityDefinitionTiming() {}
+
+// ActivityDefinitionTimingRelativeTime is the RelativeTime variant of ActivityDefinitionTiming.
+type ActivityDefinitionTimingRelativeTime struct {
+	RelativeTime
+}
+
+func (v ActivityDefinitionTimingRelativeTime) Validate() error {
+	return v.RelativeTime.Validate()
+}
+
+func (v ActivityDefinitionTimingRelativeTime) validateAll(path string, issues *ValidationIssues) {
+	v.RelativeTime.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionTimingRelativeTime) FHIRType() string {
+	return "RelativeTime"
+}
+
+func (v ActivityDefinitionTimingRelativeTime) isActivityDefinitionTiming() {}
+
+var activityDefinitionTimingVariants = []ActivityDefinitionTiming{
+	ActivityDefinitionTimingTiming{},
+	ActivityDefinitionTimingAge{},
+	ActivityDefinitionTimingRange{},
+	ActivityDefinitionTimingDuration{},
+	ActivityDefinitionTimingRelativeTime{},
+}
+
+// ActivityDefinitionAsNeeded is the type of ActivityDefinition.asNeeded[x]. It is implemented by the ActivityDefinitionAsNeeded* variant types.
+type ActivityDefinitionAsNeeded interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActivityDefinitionAsNeeded()
+}
+
+// ActivityDefinitionAsNeededBoolean is the boolean variant of ActivityDefinitionAsNeeded.
+type ActivityDefinitionAsNeededBoolean bool
+
+func (v ActivityDefinitionAsNeededBoolean) Validate() error {
+	return nil
+}
+
+func (v ActivityDefinitionAsNeededBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ActivityDefinitionAsNeededBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ActivityDefinitionAsNeededBoolean) isActivityDefinitionAsNeeded() {}
+
+// ActivityDefinitionAsNeededCodeableConcept is the CodeableConcept variant of ActivityDefinitionAsNeeded.
+type ActivityDefinitionAsNeededCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ActivityDefinitionAsNeededCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ActivityDefinitionAsNeededCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionAsNeededCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ActivityDefinitionAsNeededCodeableConcept) isActivityDefinitionAsNeeded() {}
+
+var activityDefinitionAsNeededVariants = []ActivityDefinitionAsNeeded{
+	ActivityDefinitionAsNeededBoolean(false),
+	ActivityDefinitionAsNeededCodeableConcept{},
+}
+
+// ActivityDefinitionProduct is the type of ActivityDefinition.product[x]. It is implemented by the ActivityDefinitionProduct* variant types.
+type ActivityDefinitionProduct interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActivityDefinitionProduct()
+}
+
+// ActivityDefinitionProductReference is the Reference variant of ActivityDefinitionProduct.
+type ActivityDefinitionProductReference struct {
+	Reference
+}
+
+func (v ActivityDefinitionProductReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ActivityDefinitionProductReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionProductReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ActivityDefinitionProductReference) isActivityDefinitionProduct() {}
+
+// ActivityDefinitionProductCodeableConcept is the CodeableConcept variant of ActivityDefinitionProduct.
+type ActivityDefinitionProductCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ActivityDefinitionProductCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ActivityDefinitionProductCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionProductCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ActivityDefinitionProductCodeableConcept) isActivityDefinitionProduct() {}
+
+var activityDefinitionProductVariants = []ActivityDefinitionProduct{
+	ActivityDefinitionProductReference{},
+	ActivityDefinitionProductCodeableConcept{},
+}
+
+func (r *ActivityDefinition) GetResourceType() string {
+	return "ActivityDefinition"
+}
+
+func (r *ActivityDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ActivityDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ActivityDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ActivityDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ActivityDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ActivityDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ActivityDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ActivityDefinition)(nil)
+
+type ActivityDefinitionParticipant struct {
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *ActionParticipantType                  `json:"type,omitempty" bson:"type,omitempty"`                            // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
+	TypeElement       *Element                                `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	TypeChoice        ActivityDefinitionParticipantTypeChoice `json:"-" bson:"type_choice,omitempty"`                                  // Who or what can participate
+	TypeChoiceElement *Element                                `json:"-" bson:"type_choice_element,omitempty"`                          // Extensions for type[x]
+	Role              *CodeableConcept                        `json:"role,omitempty" bson:"role,omitempty"`                            // E.g. Nurse, Surgeon, Parent, etc
+	Function          *CodeableConcept                        `json:"function,omitempty" bson:"function,omitempty"`                    // E.g. Author, Reviewer, Witness, etc
+
+	typeChoiceVariants []string // JSON properties of ActivityDefinition.participant.type[x] when more than one was decoded
+}
+
+func (r *ActivityDefinitionParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		return fmt.Errorf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants)
+	}
+	if r.TypeChoice != nil {
+		if err := r.TypeChoice.Validate(); err != nil {
+			return fmt.Errorf("TypeChoice: %w", err)
+		}
+	}
+	if r.TypeChoiceElement != nil {
+		if err := r.TypeChoiceElement.Validate(); err != nil {
+			return fmt.Errorf("TypeChoiceElement: %w", err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	if r.Function != nil {
+		if err := r.Function.Validate(); err != nil {
+			return fmt.Errorf("Function: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ActivityDefinitionParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		issues.add("structure", path+".type", fmt.Sprintf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants))
+	}
+	if r.TypeChoice != nil {
+		r.TypeChoice.validateAll(path+".type.ofType("+r.TypeChoice.FHIRType()+")", issues)
+	}
+	if r.TypeChoiceElement != nil {
+		r.TypeChoiceElement.validateAll(path+".type", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+}
+
+var activityDefinitionParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ActivityDefinitionParticipant) invariants() []invariant {
+	return activityDefinitionParticipantInvariants
+}
+
+func (r ActivityDefinitionParticipant) MarshalJSON() ([]byte, error) {
+	type alias ActivityDefinitionParticipant
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "type", r.TypeChoice, r.TypeChoiceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ActivityDefinitionParticipant) UnmarshalJSON(data []byte) error {
+	type alias ActivityDefinitionParticipant
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.TypeChoice, element, r.typeChoiceVariants, err = unmarshalChoice(fields, "type", activityDefinitionParticipantTypeChoiceVariants); err != nil {
+		return err
+	}
+	r.TypeChoiceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TypeChoiceElement); err != nil {
+			return fmt.Errorf("_type: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ActivityDefinitionParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ActivityDefinitionParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ActivityDefinitionParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ActivityDefinitionParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ActivityDefinitionParticipant) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "TypeChoice":
+		return "type", choiceValues(activityDefinitionParticipantTypeChoiceVariants)
+	}
+	return "", nil
+}
+
+var activityDefinitionParticipantElementMetadata = []ElementMetadata{
+	{Path: "ActivityDefinition.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ActivityDefinition.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ActivityDefinition.participant.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActivityDefinition.participant.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"}},
+	{Path: "ActivityDefinition.participant.type[x]", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"canonical", "Reference"}},
+	{Path: "ActivityDefinition.participant.role", Name: "role", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ActivityDefinition.participant.function", Name: "function", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ActivityDefinitionParticipant) ElementMetadata() []ElementMetadata {
+	return activityDefinitionParticipantElementMetadata
+}
+
+// ActivityDefinitionParticipantTypeChoice is the type of ActivityDefinition.participant.type[x]. It is implemented by the ActivityDefinitionParticipantTypeChoice* variant types.
+type ActivityDefinitionParticipantTypeChoice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActivityDefinitionParticipantTypeChoice()
+}
+
+// ActivityDefinitionParticipantTypeChoiceCanonical is the canonical variant of ActivityDefinitionParticipantTypeChoice.
+type ActivityDefinitionParticipantTypeChoiceCanonical string
+
+func (v ActivityDefinitionParticipantTypeChoiceCanonical) Validate() error {
+	return nil
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceCanonical) isActivityDefinitionParticipantTypeChoice() {
+}
+
+// ActivityDefinitionParticipantTypeChoiceReference is the Reference variant of ActivityDefinitionParticipantTypeChoice.
+type ActivityDefinitionParticipantTypeChoiceReference struct {
+	Reference
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ActivityDefinitionParticipantTypeChoiceReference) isActivityDefinitionParticipantTypeChoice() {
+}
+
+var activityDefinitionParticipantTypeChoiceVariants = []ActivityDefinitionParticipantTypeChoice{
+	ActivityDefinitionParticipantTypeChoiceCanonical(""),
+	ActivityDefinitionParticipantTypeChoiceReference{},
+}
+
+type ActivityDefinitionDynamicValue struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Path              string      `json:"path" bson:"path"`                                                // The path to the element to be set dynamically
+	PathElement       *Element    `json:"_path,omitempty" bson:"path_element,omitempty"`                   // Extensions for path
+	Expression        *Expression `json:"expression" bson:"expression"`                                    // An expression that provides the dynamic value for the customization
+}
+
+func (r *ActivityDefinitionDynamicValue) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		return fmt.Errorf("field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	if r.Expression == nil {
+		return fmt.Errorf("field 'Expression' is required")
+	}
+	if r.Expression != nil {
+		if err := r.Expression.Validate(); err != nil {
+			return fmt.Errorf("Expression: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ActivityDefinitionDynamicValue) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		issues.add("required", path+".path", "field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.Expression == nil {
+		issues.add("required", path+".expression", "field 'Expression' is required")
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+}
+
+var activityDefinitionDynamicValueInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ActivityDefinitionDynamicValue) invariants() []invariant {
+	return activityDefinitionDynamicValueInvariants
+}
+
+func (r ActivityDefinitionDynamicValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ActivityDefinitionDynamicValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ActivityDefinitionDynamicValue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ActivityDefinitionDynamicValue) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var activityDefinitionDynamicValueElementMetadata = []ElementMetadata{
+	{Path: "ActivityDefinition.dynamicValue.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.dynamicValue.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ActivityDefinition.dynamicValue.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ActivityDefinition.dynamicValue.path", Name: "path", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ActivityDefinition.dynamicValue.expression", Name: "expression", Field: 5, Min: 1, Max: "1", Types: []string{"Expression"}},
+}
+
+func (r *ActivityDefinitionDynamicValue) ElementMetadata() []ElementMetadata {
+	return activityDefinitionDynamicValueElementMetadata
+}
@@ -2,41 +2,64 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The ActorDefinition resource is used to describe an actor - a human or an application that plays a role in data exchange, and that may have obligations associated with the role the actor plays.
 type ActorDefinition struct {
-	ResourceType           string            `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string           `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string           `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string           `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this actor definition, represented as a URI (globally unique)
-	Identifier             []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the actor definition (business identifier)
-	Version                *string           `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the actor definition
-	VersionAlgorithmString *string           `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding           `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string           `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this actor definition (computer friendly)
-	Title                  *string           `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this actor definition (human friendly)
-	Status                 string            `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool             `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string           `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string           `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail   `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string           `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the actor
-	UseContext             []UsageContext    `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the actor definition (if applicable)
-	Purpose                *string           `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this actor definition is defined
-	Copyright              *string           `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string           `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Type                   string            `json:"type" bson:"type"`                                                           // person | system | collective | other
-	Category               []CodeableConcept `json:"category,omitempty" bson:"category,omitempty"`                               // Further details about the type of actor
-	Documentation          *string           `json:"documentation,omitempty" bson:"documentation,omitempty"`                     // Explanation and details about the actor
-	Reference              []string          `json:"reference,omitempty" bson:"reference,omitempty"`                             // Reference to more information about the actor
-	BaseDefinition         []string          `json:"baseDefinition,omitempty" bson:"base_definition,omitempty"`                  // Parent actor definition
+	ResourceType            string                          `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                         `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     *string                         `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this actor definition, represented as a URI (globally unique)
+	UrlElement              *Element                        `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the actor definition (business identifier)
+	Version                 *string                         `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the actor definition
+	VersionElement          *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        ActorDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                        `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    *string                         `json:"name,omitempty" bson:"name,omitempty"`                               // Name for this actor definition (computer friendly)
+	NameElement             *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                         `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this actor definition (human friendly)
+	TitleElement            *Element                        `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus               `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                        `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                       `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                        `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                        `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                         `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the actor
+	DescriptionElement      *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept               `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the actor definition (if applicable)
+	Purpose                 *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this actor definition is defined
+	PurposeElement          *Element                        `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                        `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	Type                    ActorDefinitionActorType        `json:"type" bson:"type"`                                                   // person | system | collective | other
+	TypeElement             *Element                        `json:"_type,omitempty" bson:"type_element,omitempty"`                      // Extensions for type
+	Category                []CodeableConcept               `json:"category,omitempty" bson:"category,omitempty"`                       // Further details about the type of actor
+	Documentation           *string                         `json:"documentation,omitempty" bson:"documentation,omitempty"`             // Explanation and details about the actor
+	DocumentationElement    *Element                        `json:"_documentation,omitempty" bson:"documentation_element,omitempty"`    // Extensions for documentation
+	Reference               []string                        `json:"reference,omitempty" bson:"reference,omitempty"`                     // Reference to more information about the actor
+	ReferenceElement        []*Element                      `json:"_reference,omitempty" bson:"reference_element,omitempty"`            // Extensions for reference
+	BaseDefinition          []string                        `json:"baseDefinition,omitempty" bson:"base_definition,omitempty"`          // Parent actor definition
+	BaseDefinitionElement   []*Element                      `json:"_baseDefinition,omitempty" bson:"base_definition_element,omitempty"` // Extensions for baseDefinition
+
+	versionAlgorithmVariants []string // JSON properties of ActorDefinition.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *ActorDefinition) Validate() error {
@@ -48,30 +71,118 @@ func (r *ActorDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -82,13 +193,431 @@ func (r *ActorDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
-	if r.Type == emptyString {
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	for i, item := range r.ReferenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.BaseDefinitionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BaseDefinitionElement[%d]: %w", i, err)
+		}
+	}
+	return validateInvariants(r, "ActorDefinition")
+}
+
+func (r *ActorDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ActorDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ActorDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	for i, item := range r.ReferenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.reference[%d]", path, i), issues)
+	}
+	for i, item := range r.BaseDefinitionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.baseDefinition[%d]", path, i), issues)
+	}
+}
+
+func (r *ActorDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ActorDefinition", &issues)
+	checkInvariants(r, "ActorDefinition", &issues)
+	return issues
+}
+
+var actorDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ActorDefinition) invariants() []invariant {
+	return actorDefinitionInvariants
+}
+
+func (r ActorDefinition) MarshalJSON() ([]byte, error) {
+	type alias ActorDefinition
+	out := struct {
+		alias
+		Reference             []*string  `json:"reference,omitempty"`
+		ReferenceElement      []*Element `json:"_reference,omitempty"`
+		BaseDefinition        []*string  `json:"baseDefinition,omitempty"`
+		BaseDefinitionElement []*Element `json:"_baseDefinition,omitempty"`
+	}{alias: alias(r)}
+	out.Reference, out.ReferenceElement = alignPrimitiveArray(r.Reference, r.ReferenceElement)
+	out.BaseDefinition, out.BaseDefinitionElement = alignPrimitiveArray(r.BaseDefinition, r.BaseDefinitionElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ActorDefinition) UnmarshalJSON(data []byte) error {
+	type alias ActorDefinition
+	aux := struct {
+		*alias
+		Contained      []json.RawMessage `json:"contained,omitempty"`
+		Reference      []*string         `json:"reference,omitempty"`
+		BaseDefinition []*string         `json:"baseDefinition,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Reference, err = splitPrimitiveArray(aux.Reference, r.ReferenceElement); err != nil {
+		return fmt.Errorf("reference: %w", err)
+	}
+	if r.BaseDefinition, err = splitPrimitiveArray(aux.BaseDefinition, r.BaseDefinitionElement); err != nil {
+		return fmt.Errorf("baseDefinition: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", actorDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ActorDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ActorDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ActorDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ActorDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ActorDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(actorDefinitionVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var actorDefinitionElementTypes = map[string]string{
+	"id":             "id",
+	"implicitRules":  "uri",
+	"language":       "code",
+	"url":            "uri",
+	"description":    "markdown",
+	"purpose":        "markdown",
+	"copyright":      "markdown",
+	"documentation":  "markdown",
+	"reference":      "url",
+	"baseDefinition": "canonical",
+}
+
+func (r *ActorDefinition) elementTypes() map[string]string {
+	return actorDefinitionElementTypes
+}
+
+var actorDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ActorDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ActorDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ActorDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ActorDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActorDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ActorDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ActorDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ActorDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ActorDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ActorDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ActorDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ActorDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActorDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ActorDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActorDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActorDefinition.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActorDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ActorDefinition.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ActorDefinition.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ActorDefinition.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActorDefinition.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ActorDefinition.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActorDefinition.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ActorDefinition.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ActorDefinition.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActorDefinition.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActorDefinition.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ActorDefinition.type", Name: "type", Field: 41, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ActorDefinition.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/actordefinition-actor-type"}},
+	{Path: "ActorDefinition.category", Name: "category", Field: 43, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ActorDefinition.documentation", Name: "documentation", Field: 44, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ActorDefinition.reference", Name: "reference", Field: 46, Min: 0, Max: "*", Types: []string{"url"}},
+	{Path: "ActorDefinition.baseDefinition", Name: "baseDefinition", Field: 48, Min: 0, Max: "*", Types: []string{"canonical"}},
+}
+
+func (r *ActorDefinition) ElementMetadata() []ElementMetadata {
+	return actorDefinitionElementMetadata
+}
+
+// ActorDefinitionVersionAlgorithm is the type of ActorDefinition.versionAlgorithm[x]. It is implemented by the ActorDefinitionVersionAlgorithm* variant types.
+type ActorDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isActorDefinitionVersionAlgorithm()
+}
+
+// ActorDefinitionVersionAlgorithmString is the string variant of ActorDefinitionVersionAlgorithm.
+type ActorDefinitionVersionAlgorithmString string
+
+func (v ActorDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v ActorDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ActorDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v ActorDefinitionVersionAlgorithmString) isActorDefinitionVersionAlgorithm() {}
+
+// ActorDefinitionVersionAlgorithmCoding is the Coding variant of ActorDefinitionVersionAlgorithm.
+type ActorDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ActorDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ActorDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ActorDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ActorDefinitionVersionAlgorithmCoding) isActorDefinitionVersionAlgorithm() {}
+
+var actorDefinitionVersionAlgorithmVariants = []ActorDefinitionVersionAlgorithm{
+	ActorDefinitionVersionAlgorithmString(""),
+	ActorDefinitionVersionAlgorithmCoding{},
+}
+
+func (r *ActorDefinition) GetResourceType() string {
+	return "ActorDefinition"
+}
+
+func (r *ActorDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ActorDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ActorDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ActorDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ActorDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ActorDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ActorDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ActorDefinition)(nil)
@@ -1,25 +1,96 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Address Type: An address expressed using postal conventions (as opposed to GPS or other location definition formats).  This data type may be used to convey addresses for use in delivering mail as well as for visiting locations which might not be valid for mail delivery.  There are a variety of postal address formats defined around the world. The ISO21090-codedString may be used to provide a coded representation of the contents of strings in an Address.
 type Address struct {
-	Id         *string  `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
-	Use        *string  `json:"use,omitempty" bson:"use,omitempty"`                // home | work | temp | old | billing - purpose of this address
-	Type       *string  `json:"type,omitempty" bson:"type,omitempty"`              // postal | physical | both
-	Text       *string  `json:"text,omitempty" bson:"text,omitempty"`              // Text representation of the address
-	Line       []string `json:"line,omitempty" bson:"line,omitempty"`              // Street name, number, direction & P.O. Box etc.
-	City       *string  `json:"city,omitempty" bson:"city,omitempty"`              // Name of city, town etc.
-	District   *string  `json:"district,omitempty" bson:"district,omitempty"`      // District name (aka county)
-	State      *string  `json:"state,omitempty" bson:"state,omitempty"`            // Sub-unit of country (abbreviations ok)
-	PostalCode *string  `json:"postalCode,omitempty" bson:"postal_code,omitempty"` // Postal code for area
-	Country    *string  `json:"country,omitempty" bson:"country,omitempty"`        // Country (e.g. may be ISO 3166 2 or 3 letter code)
-	Period     *Period  `json:"period,omitempty" bson:"period,omitempty"`          // Time period when address was/is in use
+	Id                *string      `json:"id,omitempty" bson:"id,omitempty"`                           // Unique id for inter-element referencing
+	Extension         []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`             // Additional content defined by implementations
+	Use               *AddressUse  `json:"use,omitempty" bson:"use,omitempty"`                         // home | work | temp | old | billing - purpose of this address
+	UseElement        *Element     `json:"_use,omitempty" bson:"use_element,omitempty"`                // Extensions for use
+	Type              *AddressType `json:"type,omitempty" bson:"type,omitempty"`                       // postal | physical | both
+	TypeElement       *Element     `json:"_type,omitempty" bson:"type_element,omitempty"`              // Extensions for type
+	Text              *string      `json:"text,omitempty" bson:"text,omitempty"`                       // Text representation of the address
+	TextElement       *Element     `json:"_text,omitempty" bson:"text_element,omitempty"`              // Extensions for text
+	Line              []string     `json:"line,omitempty" bson:"line,omitempty"`                       // Street name, number, direction & P.O. Box etc.
+	LineElement       []*Element   `json:"_line,omitempty" bson:"line_element,omitempty"`              // Extensions for line
+	City              *string      `json:"city,omitempty" bson:"city,omitempty"`                       // Name of city, town etc.
+	CityElement       *Element     `json:"_city,omitempty" bson:"city_element,omitempty"`              // Extensions for city
+	District          *string      `json:"district,omitempty" bson:"district,omitempty"`               // District name (aka county)
+	DistrictElement   *Element     `json:"_district,omitempty" bson:"district_element,omitempty"`      // Extensions for district
+	State             *string      `json:"state,omitempty" bson:"state,omitempty"`                     // Sub-unit of country (abbreviations ok)
+	StateElement      *Element     `json:"_state,omitempty" bson:"state_element,omitempty"`            // Extensions for state
+	PostalCode        *string      `json:"postalCode,omitempty" bson:"postal_code,omitempty"`          // Postal code for area
+	PostalCodeElement *Element     `json:"_postalCode,omitempty" bson:"postal_code_element,omitempty"` // Extensions for postalCode
+	Country           *string      `json:"country,omitempty" bson:"country,omitempty"`                 // Country (e.g. may be ISO 3166 2 or 3 letter code)
+	CountryElement    *Element     `json:"_country,omitempty" bson:"country_element,omitempty"`        // Extensions for country
+	Period            *Period      `json:"period,omitempty" bson:"period,omitempty"`                   // Time period when address was/is in use
 }
 
 func (r *Address) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", *r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
+	for i, item := range r.LineElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LineElement[%d]: %w", i, err)
+		}
+	}
+	if r.CityElement != nil {
+		if err := r.CityElement.Validate(); err != nil {
+			return fmt.Errorf("CityElement: %w", err)
+		}
+	}
+	if r.DistrictElement != nil {
+		if err := r.DistrictElement.Validate(); err != nil {
+			return fmt.Errorf("DistrictElement: %w", err)
+		}
+	}
+	if r.StateElement != nil {
+		if err := r.StateElement.Validate(); err != nil {
+			return fmt.Errorf("StateElement: %w", err)
+		}
+	}
+	if r.PostalCodeElement != nil {
+		if err := r.PostalCodeElement.Validate(); err != nil {
+			return fmt.Errorf("PostalCodeElement: %w", err)
+		}
+	}
+	if r.CountryElement != nil {
+		if err := r.CountryElement.Validate(); err != nil {
+			return fmt.Errorf("CountryElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -27,3 +98,125 @@ func (r *Address) Validate() error {
 	}
 	return nil
 }
+
+func (r *Address) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", *r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	for i, item := range r.LineElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.line[%d]", path, i), issues)
+	}
+	if r.CityElement != nil {
+		r.CityElement.validateAll(path+".city", issues)
+	}
+	if r.DistrictElement != nil {
+		r.DistrictElement.validateAll(path+".district", issues)
+	}
+	if r.StateElement != nil {
+		r.StateElement.validateAll(path+".state", issues)
+	}
+	if r.PostalCodeElement != nil {
+		r.PostalCodeElement.validateAll(path+".postalCode", issues)
+	}
+	if r.CountryElement != nil {
+		r.CountryElement.validateAll(path+".country", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+func (r *Address) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Address", &issues)
+	checkInvariants(r, "Address", &issues)
+	return issues
+}
+
+var addressInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Address) invariants() []invariant {
+	return addressInvariants
+}
+
+func (r Address) MarshalJSON() ([]byte, error) {
+	type alias Address
+	out := struct {
+		alias
+		Line        []*string  `json:"line,omitempty"`
+		LineElement []*Element `json:"_line,omitempty"`
+	}{alias: alias(r)}
+	out.Line, out.LineElement = alignPrimitiveArray(r.Line, r.LineElement)
+	return json.Marshal(out)
+}
+
+func (r *Address) UnmarshalJSON(data []byte) error {
+	type alias Address
+	aux := struct {
+		*alias
+		Line []*string `json:"line,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Line, err = splitPrimitiveArray(aux.Line, r.LineElement); err != nil {
+		return fmt.Errorf("line: %w", err)
+	}
+	return nil
+}
+
+func (r Address) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Address) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Address) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Address) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var addressElementMetadata = []ElementMetadata{
+	{Path: "Address.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Address.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Address.use", Name: "use", Field: 2, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Address.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/address-use", Description: "The use of an address (home / work / etc.)."}, Summary: true, Modifier: true},
+	{Path: "Address.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Address.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/address-type", Description: "The type of an address (physical / postal)."}, Summary: true},
+	{Path: "Address.text", Name: "text", Field: 6, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Address.line", Name: "line", Field: 8, Min: 0, Max: "*", Types: []string{"string"}, Summary: true},
+	{Path: "Address.city", Name: "city", Field: 10, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Address.district", Name: "district", Field: 12, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Address.state", Name: "state", Field: 14, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Address.postalCode", Name: "postalCode", Field: 16, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Address.country", Name: "country", Field: 18, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Address.period", Name: "period", Field: 20, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+}
+
+func (r *Address) ElementMetadata() []ElementMetadata {
+	return addressElementMetadata
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,11 +12,16 @@ type AdministrableProductDefinition struct {
 	Id                    *string                                               `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
 	Meta                  *Meta                                                 `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
 	ImplicitRules         *string                                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
 	Language              *string                                               `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement       *Element                                              `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
 	Text                  *Narrative                                            `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage                                     `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Contained             []Resource                                            `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension             []Extension                                           `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension     []Extension                                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
 	Identifier            []Identifier                                          `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // An identifier for the administrable product instance
-	Status                string                                                `json:"status" bson:"status"`                                                     // draft | active | retired | unknown
+	Status                PublicationStatus                                     `json:"status" bson:"status"`                                                     // draft | active | retired | unknown
+	StatusElement         *Element                                              `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
 	FormOf                []Reference                                           `json:"formOf,omitempty" bson:"form_of,omitempty"`                                // References a product from which one or more of the constituent parts of that product can be prepared and used as described by this administrable product
 	AdministrableDoseForm *CodeableConcept                                      `json:"administrableDoseForm,omitempty" bson:"administrable_dose_form,omitempty"` // The dose form of the final product after necessary reconstitution or processing
 	UnitOfPresentation    *CodeableConcept                                      `json:"unitOfPresentation,omitempty" bson:"unit_of_presentation,omitempty"`       // The presentation type in which this item is given to a patient. e.g. for a spray - 'puff'
@@ -23,11 +29,18 @@ type AdministrableProductDefinition struct {
 	Ingredient            []CodeableConcept                                     `json:"ingredient,omitempty" bson:"ingredient,omitempty"`                         // The ingredients of this administrable medicinal product. This is only needed if the ingredients are not specified either using ManufacturedItemDefinition, or using incoming references from the Ingredient resource
 	Device                *Reference                                            `json:"device,omitempty" bson:"device,omitempty"`                                 // A device that is integral to the medicinal product, in effect being considered as an "ingredient" of the medicinal product
 	Description           *string                                               `json:"description,omitempty" bson:"description,omitempty"`                       // A general description of the product, when in its final form, suitable for administration e.g. effervescent blue liquid, to be swallowed
+	DescriptionElement    *Element                                              `json:"_description,omitempty" bson:"description_element,omitempty"`              // Extensions for description
 	Code                  []Coding                                              `json:"code,omitempty" bson:"code,omitempty"`                                     // A code that this product is known by, within some formal terminology. May be a PhPID
 	Property              []AdministrableProductDefinitionProperty              `json:"property,omitempty" bson:"property,omitempty"`                             // Characteristics e.g. a product's onset of action
 	RouteOfAdministration []AdministrableProductDefinitionRouteOfAdministration `json:"routeOfAdministration" bson:"route_of_administration"`                     // The path by which the product is taken into or makes contact with the body
 }
 
+var administrableProductDefinitionFormOfTargets = []string{"MedicinalProductDefinition"}
+
+var administrableProductDefinitionProducedFromTargets = []string{"ManufacturedItemDefinition"}
+
+var administrableProductDefinitionDeviceTargets = []string{"DeviceDefinition"}
+
 func (r *AdministrableProductDefinition) Validate() error {
 	if r.ResourceType != "AdministrableProductDefinition" {
 		return fmt.Errorf("invalid resourceType: expected 'AdministrableProductDefinition', got '%s'", r.ResourceType)
@@ -37,20 +50,60 @@ func (r *AdministrableProductDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	for i, item := range r.FormOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, administrableProductDefinitionFormOfTargets); target != "" {
+			return fmt.Errorf("field 'FormOf[%d]' cannot refer to %s, expected one of %v", i, target, administrableProductDefinitionFormOfTargets)
+		}
+	}
 	for i, item := range r.FormOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("FormOf[%d]: %w", i, err)
@@ -66,6 +119,11 @@ func (r *AdministrableProductDefinition) Validate() error {
 			return fmt.Errorf("UnitOfPresentation: %w", err)
 		}
 	}
+	for i, item := range r.ProducedFrom {
+		if target := invalidReferenceTarget(item.Reference, item.Type, administrableProductDefinitionProducedFromTargets); target != "" {
+			return fmt.Errorf("field 'ProducedFrom[%d]' cannot refer to %s, expected one of %v", i, target, administrableProductDefinitionProducedFromTargets)
+		}
+	}
 	for i, item := range r.ProducedFrom {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ProducedFrom[%d]: %w", i, err)
@@ -76,11 +134,21 @@ func (r *AdministrableProductDefinition) Validate() error {
 			return fmt.Errorf("Ingredient[%d]: %w", i, err)
 		}
 	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, administrableProductDefinitionDeviceTargets); target != "" {
+			return fmt.Errorf("field 'Device' cannot refer to %s, expected one of %v", target, administrableProductDefinitionDeviceTargets)
+		}
+	}
 	if r.Device != nil {
 		if err := r.Device.Validate(); err != nil {
 			return fmt.Errorf("Device: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Code {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Code[%d]: %w", i, err)
@@ -99,24 +167,256 @@ func (r *AdministrableProductDefinition) Validate() error {
 			return fmt.Errorf("RouteOfAdministration[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "AdministrableProductDefinition")
+}
+
+func (r *AdministrableProductDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "AdministrableProductDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'AdministrableProductDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.FormOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, administrableProductDefinitionFormOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.formOf[%d]", path, i), fmt.Sprintf("field 'FormOf[%d]' cannot refer to %s, expected one of %v", i, target, administrableProductDefinitionFormOfTargets))
+		}
+	}
+	for i, item := range r.FormOf {
+		item.validateAll(fmt.Sprintf("%s.formOf[%d]", path, i), issues)
+	}
+	if r.AdministrableDoseForm != nil {
+		r.AdministrableDoseForm.validateAll(path+".administrableDoseForm", issues)
+	}
+	if r.UnitOfPresentation != nil {
+		r.UnitOfPresentation.validateAll(path+".unitOfPresentation", issues)
+	}
+	for i, item := range r.ProducedFrom {
+		if target := invalidReferenceTarget(item.Reference, item.Type, administrableProductDefinitionProducedFromTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.producedFrom[%d]", path, i), fmt.Sprintf("field 'ProducedFrom[%d]' cannot refer to %s, expected one of %v", i, target, administrableProductDefinitionProducedFromTargets))
+		}
+	}
+	for i, item := range r.ProducedFrom {
+		item.validateAll(fmt.Sprintf("%s.producedFrom[%d]", path, i), issues)
+	}
+	for i, item := range r.Ingredient {
+		item.validateAll(fmt.Sprintf("%s.ingredient[%d]", path, i), issues)
+	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, administrableProductDefinitionDeviceTargets); target != "" {
+			issues.add("value", path+".device", fmt.Sprintf("field 'Device' cannot refer to %s, expected one of %v", target, administrableProductDefinitionDeviceTargets))
+		}
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	if len(r.RouteOfAdministration) < 1 {
+		issues.add("required", path+".routeOfAdministration", "field 'RouteOfAdministration' must have at least 1 elements")
+	}
+	for i, item := range r.RouteOfAdministration {
+		item.validateAll(fmt.Sprintf("%s.routeOfAdministration[%d]", path, i), issues)
+	}
+}
+
+func (r *AdministrableProductDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("AdministrableProductDefinition", &issues)
+	checkInvariants(r, "AdministrableProductDefinition", &issues)
+	return issues
+}
+
+var administrableProductDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *AdministrableProductDefinition) invariants() []invariant {
+	return administrableProductDefinitionInvariants
+}
+
+func (r *AdministrableProductDefinition) UnmarshalJSON(data []byte) error {
+	type alias AdministrableProductDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r AdministrableProductDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdministrableProductDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdministrableProductDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdministrableProductDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var administrableProductDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *AdministrableProductDefinition) elementTypes() map[string]string {
+	return administrableProductDefinitionElementTypes
+}
+
+var administrableProductDefinitionElementMetadata = []ElementMetadata{
+	{Path: "AdministrableProductDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "AdministrableProductDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "AdministrableProductDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "AdministrableProductDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AdministrableProductDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "AdministrableProductDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "AdministrableProductDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "AdministrableProductDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdministrableProductDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdministrableProductDefinition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "AdministrableProductDefinition.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AdministrableProductDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "AdministrableProductDefinition.formOf", Name: "formOf", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition"}},
+	{Path: "AdministrableProductDefinition.administrableDoseForm", Name: "administrableDoseForm", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.unitOfPresentation", Name: "unitOfPresentation", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.producedFrom", Name: "producedFrom", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition"}},
+	{Path: "AdministrableProductDefinition.ingredient", Name: "ingredient", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.device", Name: "device", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DeviceDefinition"}},
+	{Path: "AdministrableProductDefinition.description", Name: "description", Field: 20, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "AdministrableProductDefinition.code", Name: "code", Field: 22, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "AdministrableProductDefinition.property", Name: "property", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration", Name: "routeOfAdministration", Field: 24, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *AdministrableProductDefinition) ElementMetadata() []ElementMetadata {
+	return administrableProductDefinitionElementMetadata
+}
+
+func (r *AdministrableProductDefinition) GetResourceType() string {
+	return "AdministrableProductDefinition"
+}
+
+func (r *AdministrableProductDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *AdministrableProductDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *AdministrableProductDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *AdministrableProductDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *AdministrableProductDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *AdministrableProductDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *AdministrableProductDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*AdministrableProductDefinition)(nil)
+
 type AdministrableProductDefinitionProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                                       // A code expressing the type of characteristic
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // A value for the characteristic
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // A value for the characteristic
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // A value for the characteristic
-	ValueDate            *string          `json:"valueDate,omitempty" bson:"value_date,omitempty"`                        // A value for the characteristic
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // A value for the characteristic
-	ValueMarkdown        *string          `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                // A value for the characteristic
-	ValueAttachment      *Attachment      `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`            // A value for the characteristic
-	ValueReference       *Reference       `json:"valueReference,omitempty" bson:"value_reference,omitempty"`              // A value for the characteristic
-	Status               *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`                               // The status of characteristic e.g. assigned or pending
+	Id                *string                                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                            `json:"type" bson:"type"`                                                // A code expressing the type of characteristic
+	Value             AdministrableProductDefinitionPropertyValue `json:"-" bson:"value,omitempty"`                                        // A value for the characteristic
+	ValueElement      *Element                                    `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Status            *CodeableConcept                            `json:"status,omitempty" bson:"status,omitempty"`                        // The status of characteristic e.g. assigned or pending
+
+	valueVariants []string // JSON properties of AdministrableProductDefinition.property.value[x] when more than one was decoded
 }
 
 func (r *AdministrableProductDefinitionProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -125,41 +425,319 @@ func (r *AdministrableProductDefinitionProperty) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.Status != nil {
+		if err := r.Status.Validate(); err != nil {
+			return fmt.Errorf("Status: %w", err)
 		}
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	return nil
+}
+
+func (r *AdministrableProductDefinitionProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
 	if r.Status != nil {
-		if err := r.Status.Validate(); err != nil {
-			return fmt.Errorf("Status: %w", err)
+		r.Status.validateAll(path+".status", issues)
+	}
+}
+
+var administrableProductDefinitionPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdministrableProductDefinitionProperty) invariants() []invariant {
+	return administrableProductDefinitionPropertyInvariants
+}
+
+func (r AdministrableProductDefinitionProperty) MarshalJSON() ([]byte, error) {
+	type alias AdministrableProductDefinitionProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AdministrableProductDefinitionProperty) UnmarshalJSON(data []byte) error {
+	type alias AdministrableProductDefinitionProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", administrableProductDefinitionPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r AdministrableProductDefinitionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdministrableProductDefinitionProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdministrableProductDefinitionProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdministrableProductDefinitionProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AdministrableProductDefinitionProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(administrableProductDefinitionPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var administrableProductDefinitionPropertyElementMetadata = []ElementMetadata{
+	{Path: "AdministrableProductDefinition.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdministrableProductDefinition.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdministrableProductDefinition.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdministrableProductDefinition.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.property.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Quantity", "Range", "date", "boolean", "markdown", "Attachment", "Reference"}},
+	{Path: "AdministrableProductDefinition.property.status", Name: "status", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *AdministrableProductDefinitionProperty) ElementMetadata() []ElementMetadata {
+	return administrableProductDefinitionPropertyElementMetadata
+}
+
+// AdministrableProductDefinitionPropertyValue is the type of AdministrableProductDefinition.property.value[x]. It is implemented by the AdministrableProductDefinitionPropertyValue* variant types.
+type AdministrableProductDefinitionPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAdministrableProductDefinitionPropertyValue()
+}
+
+// AdministrableProductDefinitionPropertyValueCodeableConcept is the CodeableConcept variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v AdministrableProductDefinitionPropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v AdministrableProductDefinitionPropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v AdministrableProductDefinitionPropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v AdministrableProductDefinitionPropertyValueCodeableConcept) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueQuantity is the Quantity variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueQuantity struct {
+	Quantity
+}
+
+func (v AdministrableProductDefinitionPropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v AdministrableProductDefinitionPropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v AdministrableProductDefinitionPropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v AdministrableProductDefinitionPropertyValueQuantity) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueRange is the Range variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueRange struct {
+	Range
+}
+
+func (v AdministrableProductDefinitionPropertyValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v AdministrableProductDefinitionPropertyValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v AdministrableProductDefinitionPropertyValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v AdministrableProductDefinitionPropertyValueRange) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueDate is the date variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueDate struct {
+	Date
+}
+
+func (v AdministrableProductDefinitionPropertyValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v AdministrableProductDefinitionPropertyValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v AdministrableProductDefinitionPropertyValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v AdministrableProductDefinitionPropertyValueDate) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueBoolean is the boolean variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueBoolean bool
+
+func (v AdministrableProductDefinitionPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v AdministrableProductDefinitionPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v AdministrableProductDefinitionPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v AdministrableProductDefinitionPropertyValueBoolean) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueMarkdown is the markdown variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueMarkdown string
+
+func (v AdministrableProductDefinitionPropertyValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v AdministrableProductDefinitionPropertyValueMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v AdministrableProductDefinitionPropertyValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v AdministrableProductDefinitionPropertyValueMarkdown) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueAttachment is the Attachment variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueAttachment struct {
+	Attachment
+}
+
+func (v AdministrableProductDefinitionPropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v AdministrableProductDefinitionPropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v AdministrableProductDefinitionPropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v AdministrableProductDefinitionPropertyValueAttachment) isAdministrableProductDefinitionPropertyValue() {
+}
+
+// AdministrableProductDefinitionPropertyValueReference is the Reference variant of AdministrableProductDefinitionPropertyValue.
+type AdministrableProductDefinitionPropertyValueReference struct {
+	Reference
+}
+
+func (v AdministrableProductDefinitionPropertyValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v AdministrableProductDefinitionPropertyValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v AdministrableProductDefinitionPropertyValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v AdministrableProductDefinitionPropertyValueReference) isAdministrableProductDefinitionPropertyValue() {
+}
+
+var administrableProductDefinitionPropertyValueVariants = []AdministrableProductDefinitionPropertyValue{
+	AdministrableProductDefinitionPropertyValueCodeableConcept{},
+	AdministrableProductDefinitionPropertyValueQuantity{},
+	AdministrableProductDefinitionPropertyValueRange{},
+	AdministrableProductDefinitionPropertyValueDate{},
+	AdministrableProductDefinitionPropertyValueBoolean(false),
+	AdministrableProductDefinitionPropertyValueMarkdown(""),
+	AdministrableProductDefinitionPropertyValueAttachment{},
+	AdministrableProductDefinitionPropertyValueReference{},
+}
+
 type AdministrableProductDefinitionRouteOfAdministration struct {
 	Id                        *string                                                            `json:"id,omitempty" bson:"id,omitempty"`                                                   // Unique id for inter-element referencing
+	Extension                 []Extension                                                        `json:"extension,omitempty" bson:"extension,omitempty"`                                     // Additional content defined by implementations
+	ModifierExtension         []Extension                                                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                    // Extensions that cannot be ignored even if unrecognized
 	Code                      *CodeableConcept                                                   `json:"code" bson:"code"`                                                                   // Coded expression for the route
 	FirstDose                 *Quantity                                                          `json:"firstDose,omitempty" bson:"first_dose,omitempty"`                                    // The first dose (dose quantity) administered can be specified for the product
 	MaxSingleDose             *Quantity                                                          `json:"maxSingleDose,omitempty" bson:"max_single_dose,omitempty"`                           // The maximum single dose that can be administered
@@ -170,6 +748,16 @@ type AdministrableProductDefinitionRouteOfAdministration struct {
 }
 
 func (r *AdministrableProductDefinitionRouteOfAdministration) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -211,13 +799,99 @@ func (r *AdministrableProductDefinitionRouteOfAdministration) Validate() error {
 	return nil
 }
 
+func (r *AdministrableProductDefinitionRouteOfAdministration) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.FirstDose != nil {
+		r.FirstDose.validateAll(path+".firstDose", issues)
+	}
+	if r.MaxSingleDose != nil {
+		r.MaxSingleDose.validateAll(path+".maxSingleDose", issues)
+	}
+	if r.MaxDosePerDay != nil {
+		r.MaxDosePerDay.validateAll(path+".maxDosePerDay", issues)
+	}
+	if r.MaxDosePerTreatmentPeriod != nil {
+		r.MaxDosePerTreatmentPeriod.validateAll(path+".maxDosePerTreatmentPeriod", issues)
+	}
+	if r.MaxTreatmentPeriod != nil {
+		r.MaxTreatmentPeriod.validateAll(path+".maxTreatmentPeriod", issues)
+	}
+	for i, item := range r.TargetSpecies {
+		item.validateAll(fmt.Sprintf("%s.targetSpecies[%d]", path, i), issues)
+	}
+}
+
+var administrableProductDefinitionRouteOfAdministrationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministration) invariants() []invariant {
+	return administrableProductDefinitionRouteOfAdministrationInvariants
+}
+
+func (r AdministrableProductDefinitionRouteOfAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdministrableProductDefinitionRouteOfAdministration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministration) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var administrableProductDefinitionRouteOfAdministrationElementMetadata = []ElementMetadata{
+	{Path: "AdministrableProductDefinition.routeOfAdministration.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.firstDose", Name: "firstDose", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.maxSingleDose", Name: "maxSingleDose", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.maxDosePerDay", Name: "maxDosePerDay", Field: 6, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.maxDosePerTreatmentPeriod", Name: "maxDosePerTreatmentPeriod", Field: 7, Min: 0, Max: "1", Types: []string{"Ratio"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.maxTreatmentPeriod", Name: "maxTreatmentPeriod", Field: 8, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies", Name: "targetSpecies", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministration) ElementMetadata() []ElementMetadata {
+	return administrableProductDefinitionRouteOfAdministrationElementMetadata
+}
+
 type AdministrableProductDefinitionRouteOfAdministrationTargetSpecies struct {
-	Id               *string                                                                            `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Code             *CodeableConcept                                                                   `json:"code" bson:"code"`                                              // Coded expression for the species
-	WithdrawalPeriod []AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod `json:"withdrawalPeriod,omitempty" bson:"withdrawal_period,omitempty"` // A species specific time during which consumption of animal product is not appropriate
+	Id                *string                                                                            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                                                        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                                                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept                                                                   `json:"code" bson:"code"`                                                // Coded expression for the species
+	WithdrawalPeriod  []AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod `json:"withdrawalPeriod,omitempty" bson:"withdrawal_period,omitempty"`   // A species specific time during which consumption of animal product is not appropriate
 }
 
 func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -234,14 +908,81 @@ func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) Valid
 	return nil
 }
 
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.WithdrawalPeriod {
+		item.validateAll(fmt.Sprintf("%s.withdrawalPeriod[%d]", path, i), issues)
+	}
+}
+
+var administrableProductDefinitionRouteOfAdministrationTargetSpeciesInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) invariants() []invariant {
+	return administrableProductDefinitionRouteOfAdministrationTargetSpeciesInvariants
+}
+
+func (r AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var administrableProductDefinitionRouteOfAdministrationTargetSpeciesElementMetadata = []ElementMetadata{
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod", Name: "withdrawalPeriod", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpecies) ElementMetadata() []ElementMetadata {
+	return administrableProductDefinitionRouteOfAdministrationTargetSpeciesElementMetadata
+}
+
 type AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                        // Unique id for inter-element referencing
-	Tissue                *CodeableConcept `json:"tissue" bson:"tissue"`                                                    // The type of tissue for which the withdrawal period applies, e.g. meat, milk
-	Value                 *Quantity        `json:"value" bson:"value"`                                                      // A value for the time
-	SupportingInformation *string          `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"` // Extra information about the withdrawal period
+	Id                           *string          `json:"id,omitempty" bson:"id,omitempty"`                                                 // Unique id for inter-element referencing
+	Extension                    []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                                   // Additional content defined by implementations
+	ModifierExtension            []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                  // Extensions that cannot be ignored even if unrecognized
+	Tissue                       *CodeableConcept `json:"tissue" bson:"tissue"`                                                             // The type of tissue for which the withdrawal period applies, e.g. meat, milk
+	Value                        *Quantity        `json:"value" bson:"value"`                                                               // A value for the time
+	SupportingInformation        *string          `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`          // Extra information about the withdrawal period
+	SupportingInformationElement *Element         `json:"_supportingInformation,omitempty" bson:"supporting_information_element,omitempty"` // Extensions for supportingInformation
 }
 
 func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Tissue == nil {
 		return fmt.Errorf("field 'Tissue' is required")
 	}
@@ -258,5 +999,71 @@ func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdra
 			return fmt.Errorf("Value: %w", err)
 		}
 	}
+	if r.SupportingInformationElement != nil {
+		if err := r.SupportingInformationElement.Validate(); err != nil {
+			return fmt.Errorf("SupportingInformationElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Tissue == nil {
+		issues.add("required", path+".tissue", "field 'Tissue' is required")
+	}
+	if r.Tissue != nil {
+		r.Tissue.validateAll(path+".tissue", issues)
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value", issues)
+	}
+	if r.SupportingInformationElement != nil {
+		r.SupportingInformationElement.validateAll(path+".supportingInformation", issues)
+	}
+}
+
+var administrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriodInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) invariants() []invariant {
+	return administrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriodInvariants
+}
+
+func (r AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var administrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriodElementMetadata = []ElementMetadata{
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.tissue", Name: "tissue", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.value", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity"}},
+	{Path: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.withdrawalPeriod.supportingInformation", Name: "supportingInformation", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod) ElementMetadata() []ElementMetadata {
+	return administrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriodElementMetadata
+}
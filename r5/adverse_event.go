@@ -2,45 +2,65 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An event (i.e. any change to current patient status) that may be related to unintended effects on a patient or research participant. The unintended effects may require additional monitoring, treatment, hospitalization, or may result in death. The AdverseEvent resource also extends to potential or avoided events that could have had such effects. There are two major domains where the AdverseEvent resource is expected to be used. One is in clinical care reported adverse events and the other is in reporting adverse events in clinical  research trial management.  Adverse events can be reported by healthcare providers, patients, caregivers or by medical products manufacturers.  Given the differences between these two concepts, we recommend consulting the domain specific implementation guides when implementing the AdverseEvent Resource. The implementation guides include specific extensions, value sets and constraints.
 type AdverseEvent struct {
-	ResourceType            string                      `json:"resourceType" bson:"resource_type"`                                             // Type of resource
-	Id                      *string                     `json:"id,omitempty" bson:"id,omitempty"`                                              // Logical id of this artifact
-	Meta                    *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                                          // Metadata about the resource
-	ImplicitRules           *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                       // A set of rules under which this content was created
-	Language                *string                     `json:"language,omitempty" bson:"language,omitempty"`                                  // Language of the resource content
-	Text                    *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                                          // Text summary of the resource, for human interpretation
-	Contained               []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`                                // Contained, inline Resources
-	Identifier              []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                              // Business identifier for the event
-	Status                  string                      `json:"status" bson:"status"`                                                          // in-progress | completed | entered-in-error | unknown
-	Actuality               string                      `json:"actuality" bson:"actuality"`                                                    // actual | potential
-	Category                []CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`                                  // wrong-patient | procedure-mishap | medication-mishap | device | unsafe-physical-environment | hospital-aquired-infection | wrong-body-site
-	Code                    *CodeableConcept            `json:"code,omitempty" bson:"code,omitempty"`                                          // Event or incident that occurred or was averted
-	Subject                 *Reference                  `json:"subject" bson:"subject"`                                                        // Subject impacted by event
-	Encounter               *Reference                  `json:"encounter,omitempty" bson:"encounter,omitempty"`                                // The Encounter associated with the start of the AdverseEvent
-	EffectDateTime          *string                     `json:"effectDateTime,omitempty" bson:"effect_date_time,omitempty"`                    // When the effect of the AdverseEvent occurred
-	EffectPeriod            *Period                     `json:"effectPeriod,omitempty" bson:"effect_period,omitempty"`                         // When the effect of the AdverseEvent occurred
-	Detected                *string                     `json:"detected,omitempty" bson:"detected,omitempty"`                                  // When the event was detected
-	RecordedDate            *string                     `json:"recordedDate,omitempty" bson:"recorded_date,omitempty"`                         // When the event was recorded
-	ResultingEffect         []CodeableReference         `json:"resultingEffect,omitempty" bson:"resulting_effect,omitempty"`                   // Effect on the subject due to this event
-	Location                *Reference                  `json:"location,omitempty" bson:"location,omitempty"`                                  // Location where adverse event occurred
-	Seriousness             *CodeableConcept            `json:"seriousness,omitempty" bson:"seriousness,omitempty"`                            // Seriousness or gravity of the event
-	Outcome                 []CodeableConcept           `json:"outcome,omitempty" bson:"outcome,omitempty"`                                    // Type of outcome from the adverse event
-	Recorder                *Reference                  `json:"recorder,omitempty" bson:"recorder,omitempty"`                                  // Who recorded the adverse event
-	Participant             []AdverseEventParticipant   `json:"participant,omitempty" bson:"participant,omitempty"`                            // Who was involved in the adverse event or the potential adverse event and what they did
-	Study                   []Reference                 `json:"study,omitempty" bson:"study,omitempty"`                                        // Research study that the subject is enrolled in
-	ExpectedInResearchStudy *bool                       `json:"expectedInResearchStudy,omitempty" bson:"expected_in_research_study,omitempty"` // Considered likely or probable or anticipated in the research study
-	SuspectEntity           []AdverseEventSuspectEntity `json:"suspectEntity,omitempty" bson:"suspect_entity,omitempty"`                       // The suspected agent causing the adverse event
-	ContributingFactor      []CodeableReference         `json:"contributingFactor,omitempty" bson:"contributing_factor,omitempty"`             // Contributing factors suspected to have increased the probability or severity of the adverse event
-	PreventiveAction        []CodeableReference         `json:"preventiveAction,omitempty" bson:"preventive_action,omitempty"`                 // Preventive actions that contributed to avoiding the adverse event
-	MitigatingAction        []CodeableReference         `json:"mitigatingAction,omitempty" bson:"mitigating_action,omitempty"`                 // Ameliorating actions taken after the adverse event occurred in order to reduce the extent of harm
-	SupportingInfo          []CodeableReference         `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`                     // Subject medical history or document relevant to this adverse event
-	Note                    []Annotation                `json:"note,omitempty" bson:"note,omitempty"`                                          // Comment on adverse event
+	ResourceType                   string                      `json:"resourceType" bson:"resource_type"`                                                      // Type of resource
+	Id                             *string                     `json:"id,omitempty" bson:"id,omitempty"`                                                       // Logical id of this artifact
+	Meta                           *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                                                   // Metadata about the resource
+	ImplicitRules                  *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                // A set of rules under which this content was created
+	ImplicitRulesElement           *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                       // Extensions for implicitRules
+	Language                       *string                     `json:"language,omitempty" bson:"language,omitempty"`                                           // Language of the resource content
+	LanguageElement                *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`                                  // Extensions for language
+	Text                           *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                                                   // Text summary of the resource, for human interpretation
+	Contained                      []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                                         // Contained, inline Resources
+	Extension                      []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                                         // Additional content defined by implementations
+	ModifierExtension              []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                        // Extensions that cannot be ignored
+	Identifier                     []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                                       // Business identifier for the event
+	Status                         DeviceAlertStatusCodes      `json:"status" bson:"status"`                                                                   // in-progress | completed | entered-in-error | unknown
+	StatusElement                  *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                                      // Extensions for status
+	Actuality                      AdverseEventActuality       `json:"actuality" bson:"actuality"`                                                             // actual | potential
+	ActualityElement               *Element                    `json:"_actuality,omitempty" bson:"actuality_element,omitempty"`                                // Extensions for actuality
+	Category                       []CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`                                           // wrong-patient | procedure-mishap | medication-mishap | device | unsafe-physical-environment | hospital-aquired-infection | wrong-body-site
+	Code                           *CodeableConcept            `json:"code,omitempty" bson:"code,omitempty"`                                                   // Event or incident that occurred or was averted
+	Subject                        *Reference                  `json:"subject" bson:"subject"`                                                                 // Subject impacted by event
+	Encounter                      *Reference                  `json:"encounter,omitempty" bson:"encounter,omitempty"`                                         // The Encounter associated with the start of the AdverseEvent
+	Effect                         AdverseEventEffect          `json:"-" bson:"effect,omitempty"`                                                              // When the effect of the AdverseEvent occurred
+	EffectElement                  *Element                    `json:"-" bson:"effect_element,omitempty"`                                                      // Extensions for effect[x]
+	Detected                       *DateTime                   `json:"detected,omitempty" bson:"detected,omitempty"`                                           // When the event was detected
+	DetectedElement                *Element                    `json:"_detected,omitempty" bson:"detected_element,omitempty"`                                  // Extensions for detected
+	RecordedDate                   *DateTime                   `json:"recordedDate,omitempty" bson:"recorded_date,omitempty"`                                  // When the event was recorded
+	RecordedDateElement            *Element                    `json:"_recordedDate,omitempty" bson:"recorded_date_element,omitempty"`                         // Extensions for recordedDate
+	ResultingEffect                []CodeableReference         `json:"resultingEffect,omitempty" bson:"resulting_effect,omitempty"`                            // Effect on the subject due to this event
+	Location                       *Reference                  `json:"location,omitempty" bson:"location,omitempty"`                                           // Location where adverse event occurred
+	Seriousness                    *CodeableConcept            `json:"seriousness,omitempty" bson:"seriousness,omitempty"`                                     // Seriousness or gravity of the event
+	Outcome                        []CodeableConcept           `json:"outcome,omitempty" bson:"outcome,omitempty"`                                             // Type of outcome from the adverse event
+	Recorder                       *Reference                  `json:"recorder,omitempty" bson:"recorder,omitempty"`                                           // Who recorded the adverse event
+	Participant                    []AdverseEventParticipant   `json:"participant,omitempty" bson:"participant,omitempty"`                                     // Who was involved in the adverse event or the potential adverse event and what they did
+	Study                          []Reference                 `json:"study,omitempty" bson:"study,omitempty"`                                                 // Research study that the subject is enrolled in
+	ExpectedInResearchStudy        *bool                       `json:"expectedInResearchStudy,omitempty" bson:"expected_in_research_study,omitempty"`          // Considered likely or probable or anticipated in the research study
+	ExpectedInResearchStudyElement *Element                    `json:"_expectedInResearchStudy,omitempty" bson:"expected_in_research_study_element,omitempty"` // Extensions for expectedInResearchStudy
+	SuspectEntity                  []AdverseEventSuspectEntity `json:"suspectEntity,omitempty" bson:"suspect_entity,omitempty"`                                // The suspected agent causing the adverse event
+	ContributingFactor             []CodeableReference         `json:"contributingFactor,omitempty" bson:"contributing_factor,omitempty"`                      // Contributing factors suspected to have increased the probability or severity of the adverse event
+	PreventiveAction               []CodeableReference         `json:"preventiveAction,omitempty" bson:"preventive_action,omitempty"`                          // Preventive actions that contributed to avoiding the adverse event
+	MitigatingAction               []CodeableReference         `json:"mitigatingAction,omitempty" bson:"mitigating_action,omitempty"`                          // Ameliorating actions taken after the adverse event occurred in order to reduce the extent of harm
+	SupportingInfo                 []CodeableReference         `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`                              // Subject medical history or document relevant to this adverse event
+	Note                           []Annotation                `json:"note,omitempty" bson:"note,omitempty"`                                                   // Comment on adverse event
+
+	effectVariants []string // JSON properties of AdverseEvent.effect[x] when more than one was decoded
 }
 
+var adverseEventSubjectTargets = []string{"Group", "Patient", "Practitioner", "RelatedPerson"}
+
+var adverseEventLocationTargets = []string{"Location"}
+
+var adverseEventRecorderTargets = []string{"Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var adverseEventStudyTargets = []string{"ResearchStudy"}
+
 func (r *AdverseEvent) Validate() error {
 	if r.ResourceType != "AdverseEvent" {
 		return fmt.Errorf("invalid resourceType: expected 'AdverseEvent', got '%s'", r.ResourceType)
@@ -50,23 +70,66 @@ func (r *AdverseEvent) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Actuality == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Actuality == "" {
 		return fmt.Errorf("field 'Actuality' is required")
 	}
+	if !r.Actuality.IsValid() {
+		return fmt.Errorf("field 'Actuality' has invalid code '%s'", r.Actuality)
+	}
+	if r.ActualityElement != nil {
+		if err := r.ActualityElement.Validate(); err != nil {
+			return fmt.Errorf("ActualityElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -80,6 +143,11 @@ func (r *AdverseEvent) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, adverseEventSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, adverseEventSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -90,9 +158,37 @@ func (r *AdverseEvent) Validate() error {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.EffectPeriod != nil {
-		if err := r.EffectPeriod.Validate(); err != nil {
-			return fmt.Errorf("EffectPeriod: %w", err)
+	if len(r.effectVariants) > 1 {
+		return fmt.Errorf("field 'Effect' must have a single type, got %v", r.effectVariants)
+	}
+	if r.Effect != nil {
+		if err := r.Effect.Validate(); err != nil {
+			return fmt.Errorf("Effect: %w", err)
+		}
+	}
+	if r.EffectElement != nil {
+		if err := r.EffectElement.Validate(); err != nil {
+			return fmt.Errorf("EffectElement: %w", err)
+		}
+	}
+	if r.Detected != nil {
+		if err := r.Detected.Validate(); err != nil {
+			return fmt.Errorf("Detected: %w", err)
+		}
+	}
+	if r.DetectedElement != nil {
+		if err := r.DetectedElement.Validate(); err != nil {
+			return fmt.Errorf("DetectedElement: %w", err)
+		}
+	}
+	if r.RecordedDate != nil {
+		if err := r.RecordedDate.Validate(); err != nil {
+			return fmt.Errorf("RecordedDate: %w", err)
+		}
+	}
+	if r.RecordedDateElement != nil {
+		if err := r.RecordedDateElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedDateElement: %w", err)
 		}
 	}
 	for i, item := range r.ResultingEffect {
@@ -100,6 +196,11 @@ func (r *AdverseEvent) Validate() error {
 			return fmt.Errorf("ResultingEffect[%d]: %w", i, err)
 		}
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, adverseEventLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, adverseEventLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
@@ -115,6 +216,11 @@ func (r *AdverseEvent) Validate() error {
 			return fmt.Errorf("Outcome[%d]: %w", i, err)
 		}
 	}
+	if r.Recorder != nil {
+		if target := invalidReferenceTarget(r.Recorder.Reference, r.Recorder.Type, adverseEventRecorderTargets); target != "" {
+			return fmt.Errorf("field 'Recorder' cannot refer to %s, expected one of %v", target, adverseEventRecorderTargets)
+		}
+	}
 	if r.Recorder != nil {
 		if err := r.Recorder.Validate(); err != nil {
 			return fmt.Errorf("Recorder: %w", err)
@@ -125,11 +231,21 @@ func (r *AdverseEvent) Validate() error {
 			return fmt.Errorf("Participant[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Study {
+		if target := invalidReferenceTarget(item.Reference, item.Type, adverseEventStudyTargets); target != "" {
+			return fmt.Errorf("field 'Study[%d]' cannot refer to %s, expected one of %v", i, target, adverseEventStudyTargets)
+		}
+	}
 	for i, item := range r.Study {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Study[%d]: %w", i, err)
 		}
 	}
+	if r.ExpectedInResearchStudyElement != nil {
+		if err := r.ExpectedInResearchStudyElement.Validate(); err != nil {
+			return fmt.Errorf("ExpectedInResearchStudyElement: %w", err)
+		}
+	}
 	for i, item := range r.SuspectEntity {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("SuspectEntity[%d]: %w", i, err)
@@ -160,16 +276,417 @@ func (r *AdverseEvent) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "AdverseEvent")
+}
+
+func (r *AdverseEvent) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "AdverseEvent" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'AdverseEvent', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Actuality == "" {
+		issues.add("required", path+".actuality", "field 'Actuality' is required")
+	}
+	if r.Actuality != "" && !r.Actuality.IsValid() {
+		issues.add("code-invalid", path+".actuality", fmt.Sprintf("field 'Actuality' has invalid code '%s'", r.Actuality))
+	}
+	if r.ActualityElement != nil {
+		r.ActualityElement.validateAll(path+".actuality", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, adverseEventSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, adverseEventSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.effectVariants) > 1 {
+		issues.add("structure", path+".effect", fmt.Sprintf("field 'Effect' must have a single type, got %v", r.effectVariants))
+	}
+	if r.Effect != nil {
+		r.Effect.validateAll(path+".effect.ofType("+r.Effect.FHIRType()+")", issues)
+	}
+	if r.EffectElement != nil {
+		r.EffectElement.validateAll(path+".effect", issues)
+	}
+	if r.Detected != nil {
+		if err := r.Detected.Validate(); err != nil {
+			issues.add("value", path+".detected", err.Error())
+		}
+	}
+	if r.DetectedElement != nil {
+		r.DetectedElement.validateAll(path+".detected", issues)
+	}
+	if r.RecordedDate != nil {
+		if err := r.RecordedDate.Validate(); err != nil {
+			issues.add("value", path+".recordedDate", err.Error())
+		}
+	}
+	if r.RecordedDateElement != nil {
+		r.RecordedDateElement.validateAll(path+".recordedDate", issues)
+	}
+	for i, item := range r.ResultingEffect {
+		item.validateAll(fmt.Sprintf("%s.resultingEffect[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, adverseEventLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, adverseEventLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	if r.Seriousness != nil {
+		r.Seriousness.validateAll(path+".seriousness", issues)
+	}
+	for i, item := range r.Outcome {
+		item.validateAll(fmt.Sprintf("%s.outcome[%d]", path, i), issues)
+	}
+	if r.Recorder != nil {
+		if target := invalidReferenceTarget(r.Recorder.Reference, r.Recorder.Type, adverseEventRecorderTargets); target != "" {
+			issues.add("value", path+".recorder", fmt.Sprintf("field 'Recorder' cannot refer to %s, expected one of %v", target, adverseEventRecorderTargets))
+		}
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	for i, item := range r.Study {
+		if target := invalidReferenceTarget(item.Reference, item.Type, adverseEventStudyTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.study[%d]", path, i), fmt.Sprintf("field 'Study[%d]' cannot refer to %s, expected one of %v", i, target, adverseEventStudyTargets))
+		}
+	}
+	for i, item := range r.Study {
+		item.validateAll(fmt.Sprintf("%s.study[%d]", path, i), issues)
+	}
+	if r.ExpectedInResearchStudyElement != nil {
+		r.ExpectedInResearchStudyElement.validateAll(path+".expectedInResearchStudy", issues)
+	}
+	for i, item := range r.SuspectEntity {
+		item.validateAll(fmt.Sprintf("%s.suspectEntity[%d]", path, i), issues)
+	}
+	for i, item := range r.ContributingFactor {
+		item.validateAll(fmt.Sprintf("%s.contributingFactor[%d]", path, i), issues)
+	}
+	for i, item := range r.PreventiveAction {
+		item.validateAll(fmt.Sprintf("%s.preventiveAction[%d]", path, i), issues)
+	}
+	for i, item := range r.MitigatingAction {
+		item.validateAll(fmt.Sprintf("%s.mitigatingAction[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *AdverseEvent) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("AdverseEvent", &issues)
+	checkInvariants(r, "AdverseEvent", &issues)
+	return issues
+}
+
+var adverseEventInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *AdverseEvent) invariants() []invariant {
+	return adverseEventInvariants
+}
+
+func (r AdverseEvent) MarshalJSON() ([]byte, error) {
+	type alias AdverseEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "effect", r.Effect, r.EffectElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AdverseEvent) UnmarshalJSON(data []byte) error {
+	type alias AdverseEvent
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Effect, element, r.effectVariants, err = unmarshalChoice(fields, "effect", adverseEventEffectVariants); err != nil {
+		return err
+	}
+	r.EffectElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EffectElement); err != nil {
+			return fmt.Errorf("_effect: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r AdverseEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdverseEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdverseEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdverseEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AdverseEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Effect":
+		return "effect", choiceValues(adverseEventEffectVariants)
+	}
+	return "", nil
+}
+
+var adverseEventElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *AdverseEvent) elementTypes() map[string]string {
+	return adverseEventElementTypes
+}
+
+var adverseEventElementMetadata = []ElementMetadata{
+	{Path: "AdverseEvent.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "AdverseEvent.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "AdverseEvent.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "AdverseEvent.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AdverseEvent.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "AdverseEvent.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "AdverseEvent.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "AdverseEvent.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdverseEvent.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdverseEvent.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "AdverseEvent.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AdverseEvent.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/devicealert-status"}},
+	{Path: "AdverseEvent.actuality", Name: "actuality", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AdverseEvent.actuality", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-actuality"}},
+	{Path: "AdverseEvent.category", Name: "category", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.code", Name: "code", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.subject", Name: "subject", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "AdverseEvent.encounter", Name: "encounter", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "AdverseEvent.effect[x]", Name: "effect", Field: 20, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "AdverseEvent.detected", Name: "detected", Field: 22, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "AdverseEvent.recordedDate", Name: "recordedDate", Field: 24, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "AdverseEvent.resultingEffect", Name: "resultingEffect", Field: 26, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "AdverseEvent.location", Name: "location", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "AdverseEvent.seriousness", Name: "seriousness", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.outcome", Name: "outcome", Field: 29, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.recorder", Name: "recorder", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "AdverseEvent.participant", Name: "participant", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "AdverseEvent.study", Name: "study", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ResearchStudy"}},
+	{Path: "AdverseEvent.expectedInResearchStudy", Name: "expectedInResearchStudy", Field: 33, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "AdverseEvent.suspectEntity", Name: "suspectEntity", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "AdverseEvent.contributingFactor", Name: "contributingFactor", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "AdverseEvent.preventiveAction", Name: "preventiveAction", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "AdverseEvent.mitigatingAction", Name: "mitigatingAction", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "AdverseEvent.supportingInfo", Name: "supportingInfo", Field: 39, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "AdverseEvent.note", Name: "note", Field: 40, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *AdverseEvent) ElementMetadata() []ElementMetadata {
+	return adverseEventElementMetadata
+}
+
+// AdverseEventEffect is the type of AdverseEvent.effect[x]. It is implemented by the AdverseEventEffect* variant types.
+type AdverseEventEffect interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAdverseEventEffect()
+}
+
+// AdverseEventEffectDateTime is the dateTime variant of AdverseEventEffect.
+type AdverseEventEffectDateTime struct {
+	DateTime
+}
+
+func (v AdverseEventEffectDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v AdverseEventEffectDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v AdverseEventEffectDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v AdverseEventEffectDateTime) isAdverseEventEffect() {}
+
+// AdverseEventEffectPeriod is the Period variant of AdverseEventEffect.
+type AdverseEventEffectPeriod struct {
+	Period
+}
+
+func (v AdverseEventEffectPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v AdverseEventEffectPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v AdverseEventEffectPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v AdverseEventEffectPeriod) isAdverseEventEffect() {}
+
+var adverseEventEffectVariants = []AdverseEventEffect{
+	AdverseEventEffectDateTime{},
+	AdverseEventEffectPeriod{},
+}
+
+func (r *AdverseEvent) GetResourceType() string {
+	return "AdverseEvent"
+}
+
+func (r *AdverseEvent) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *AdverseEvent) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *AdverseEvent) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *AdverseEvent) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *AdverseEvent) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *AdverseEvent) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *AdverseEvent) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*AdverseEvent)(nil)
+
 type AdverseEventParticipant struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Type of involvement
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Who was involved in the adverse event or the potential adverse event
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of involvement
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Who was involved in the adverse event or the potential adverse event
 }
 
 func (r *AdverseEventParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -186,15 +703,83 @@ func (r *AdverseEventParticipant) Validate() error {
 	return nil
 }
 
+func (r *AdverseEventParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var adverseEventParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdverseEventParticipant) invariants() []invariant {
+	return adverseEventParticipantInvariants
+}
+
+func (r AdverseEventParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdverseEventParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdverseEventParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdverseEventParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var adverseEventParticipantElementMetadata = []ElementMetadata{
+	{Path: "AdverseEvent.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdverseEvent.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdverseEvent.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdverseEvent.participant.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.participant.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *AdverseEventParticipant) ElementMetadata() []ElementMetadata {
+	return adverseEventParticipantElementMetadata
+}
+
 type AdverseEventSuspectEntity struct {
-	Id                 *string                             `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
-	Instance           *CodeableReference                  `json:"instance" bson:"instance"`                                           // Refers to the specific entity that caused the adverse event
-	Causality          *AdverseEventSuspectEntityCausality `json:"causality,omitempty" bson:"causality,omitempty"`                     // Information on the possible cause of the event
-	OccurrenceDateTime *string                             `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // When the suspect entity occurred
-	OccurrencePeriod   *Period                             `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // When the suspect entity occurred
+	Id                *string                             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Instance          *CodeableReference                  `json:"instance" bson:"instance"`                                        // Refers to the specific entity that caused the adverse event
+	Causality         *AdverseEventSuspectEntityCausality `json:"causality,omitempty" bson:"causality,omitempty"`                  // Information on the possible cause of the event
+	Occurrence        AdverseEventSuspectEntityOccurrence `json:"-" bson:"occurrence,omitempty"`                                   // When the suspect entity occurred
+	OccurrenceElement *Element                            `json:"-" bson:"occurrence_element,omitempty"`                           // Extensions for occurrence[x]
+
+	occurrenceVariants []string // JSON properties of AdverseEvent.suspectEntity.occurrence[x] when more than one was decoded
 }
 
 func (r *AdverseEventSuspectEntity) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Instance == nil {
 		return fmt.Errorf("field 'Instance' is required")
 	}
@@ -208,22 +793,208 @@ func (r *AdverseEventSuspectEntity) Validate() error {
 			return fmt.Errorf("Causality: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *AdverseEventSuspectEntity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Instance == nil {
+		issues.add("required", path+".instance", "field 'Instance' is required")
+	}
+	if r.Instance != nil {
+		r.Instance.validateAll(path+".instance", issues)
+	}
+	if r.Causality != nil {
+		r.Causality.validateAll(path+".causality", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+}
+
+var adverseEventSuspectEntityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdverseEventSuspectEntity) invariants() []invariant {
+	return adverseEventSuspectEntityInvariants
+}
+
+func (r AdverseEventSuspectEntity) MarshalJSON() ([]byte, error) {
+	type alias AdverseEventSuspectEntity
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AdverseEventSuspectEntity) UnmarshalJSON(data []byte) error {
+	type alias AdverseEventSuspectEntity
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", adverseEventSuspectEntityOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r AdverseEventSuspectEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdverseEventSuspectEntity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdverseEventSuspectEntity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdverseEventSuspectEntity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AdverseEventSuspectEntity) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(adverseEventSuspectEntityOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var adverseEventSuspectEntityElementMetadata = []ElementMetadata{
+	{Path: "AdverseEvent.suspectEntity.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdverseEvent.suspectEntity.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdverseEvent.suspectEntity.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdverseEvent.suspectEntity.instance", Name: "instance", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "AdverseEvent.suspectEntity.causality", Name: "causality", Field: 4, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "AdverseEvent.suspectEntity.occurrence[x]", Name: "occurrence", Field: 5, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+}
+
+func (r *AdverseEventSuspectEntity) ElementMetadata() []ElementMetadata {
+	return adverseEventSuspectEntityElementMetadata
+}
+
+// AdverseEventSuspectEntityOccurrence is the type of AdverseEvent.suspectEntity.occurrence[x]. It is implemented by the AdverseEventSuspectEntityOccurrence* variant types.
+type AdverseEventSuspectEntityOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAdverseEventSuspectEntityOccurrence()
+}
+
+// AdverseEventSuspectEntityOccurrenceDateTime is the dateTime variant of AdverseEventSuspectEntityOccurrence.
+type AdverseEventSuspectEntityOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v AdverseEventSuspectEntityOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v AdverseEventSuspectEntityOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v AdverseEventSuspectEntityOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v AdverseEventSuspectEntityOccurrenceDateTime) isAdverseEventSuspectEntityOccurrence() {}
+
+// AdverseEventSuspectEntityOccurrencePeriod is the Period variant of AdverseEventSuspectEntityOccurrence.
+type AdverseEventSuspectEntityOccurrencePeriod struct {
+	Period
+}
+
+func (v AdverseEventSuspectEntityOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v AdverseEventSuspectEntityOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v AdverseEventSuspectEntityOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v AdverseEventSuspectEntityOccurrencePeriod) isAdverseEventSuspectEntityOccurrence() {}
+
+var adverseEventSuspectEntityOccurrenceVariants = []AdverseEventSuspectEntityOccurrence{
+	AdverseEventSuspectEntityOccurrenceDateTime{},
+	AdverseEventSuspectEntityOccurrencePeriod{},
+}
+
 type AdverseEventSuspectEntityCausality struct {
 	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
 	AssessmentMethod  *CodeableConcept `json:"assessmentMethod,omitempty" bson:"assessment_method,omitempty"`   // Method of evaluating the relatedness of the suspected entity to the event
 	EntityRelatedness *CodeableConcept `json:"entityRelatedness,omitempty" bson:"entity_relatedness,omitempty"` // Result of the assessment regarding the relatedness of the suspected entity to the event
 	Author            *Reference       `json:"author,omitempty" bson:"author,omitempty"`                        // Author of the information on the possible cause of the event
 }
 
 func (r *AdverseEventSuspectEntityCausality) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.AssessmentMethod != nil {
 		if err := r.AssessmentMethod.Validate(); err != nil {
 			return fmt.Errorf("AssessmentMethod: %w", err)
@@ -241,3 +1012,58 @@ func (r *AdverseEventSuspectEntityCausality) Validate() error {
 	}
 	return nil
 }
+
+func (r *AdverseEventSuspectEntityCausality) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.AssessmentMethod != nil {
+		r.AssessmentMethod.validateAll(path+".assessmentMethod", issues)
+	}
+	if r.EntityRelatedness != nil {
+		r.EntityRelatedness.validateAll(path+".entityRelatedness", issues)
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+}
+
+var adverseEventSuspectEntityCausalityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AdverseEventSuspectEntityCausality) invariants() []invariant {
+	return adverseEventSuspectEntityCausalityInvariants
+}
+
+func (r AdverseEventSuspectEntityCausality) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AdverseEventSuspectEntityCausality) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AdverseEventSuspectEntityCausality) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AdverseEventSuspectEntityCausality) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var adverseEventSuspectEntityCausalityElementMetadata = []ElementMetadata{
+	{Path: "AdverseEvent.suspectEntity.causality.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AdverseEvent.suspectEntity.causality.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AdverseEvent.suspectEntity.causality.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AdverseEvent.suspectEntity.causality.assessmentMethod", Name: "assessmentMethod", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.suspectEntity.causality.entityRelatedness", Name: "entityRelatedness", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AdverseEvent.suspectEntity.causality.author", Name: "author", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *AdverseEventSuspectEntityCausality) ElementMetadata() []ElementMetadata {
+	return adverseEventSuspectEntityCausalityElementMetadata
+}
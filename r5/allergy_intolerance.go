@@ -2,40 +2,52 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Risk of harmful or undesirable, physiological response which is unique to an individual and associated with exposure to a substance.
 type AllergyIntolerance struct {
-	ResourceType           string                       `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                      `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                      `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage            `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Identifier             []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // External ids for this item
-	ClinicalStatus         *CodeableConcept             `json:"clinicalStatus,omitempty" bson:"clinical_status,omitempty"`                  // active | inactive | resolved
-	VerificationStatus     *CodeableConcept             `json:"verificationStatus,omitempty" bson:"verification_status,omitempty"`          // unconfirmed | presumed | confirmed | refuted | entered-in-error
-	Type                   *CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                                       // allergy | intolerance - Underlying mechanism (if known)
-	Category               []string                     `json:"category,omitempty" bson:"category,omitempty"`                               // food | medication | environment | biologic
-	Criticality            *string                      `json:"criticality,omitempty" bson:"criticality,omitempty"`                         // low | high | unable-to-assess
-	Code                   *CodeableConcept             `json:"code,omitempty" bson:"code,omitempty"`                                       // Code that identifies the allergy or intolerance
-	Patient                *Reference                   `json:"patient" bson:"patient"`                                                     // Who the allergy or intolerance is for
-	Encounter              *Reference                   `json:"encounter,omitempty" bson:"encounter,omitempty"`                             // Encounter when the allergy or intolerance was asserted
-	OnsetDateTime          *string                      `json:"onsetDateTime,omitempty" bson:"onset_date_time,omitempty"`                   // When allergy or intolerance was identified
-	OnsetAge               *Age                         `json:"onsetAge,omitempty" bson:"onset_age,omitempty"`                              // When allergy or intolerance was identified
-	OnsetPeriod            *Period                      `json:"onsetPeriod,omitempty" bson:"onset_period,omitempty"`                        // When allergy or intolerance was identified
-	OnsetRange             *Range                       `json:"onsetRange,omitempty" bson:"onset_range,omitempty"`                          // When allergy or intolerance was identified
-	OnsetString            *string                      `json:"onsetString,omitempty" bson:"onset_string,omitempty"`                        // When allergy or intolerance was identified
-	RecordedDate           *string                      `json:"recordedDate,omitempty" bson:"recorded_date,omitempty"`                      // Date allergy or intolerance was first recorded
-	Recorder               *Reference                   `json:"recorder,omitempty" bson:"recorder,omitempty"`                               // Who recorded the sensitivity
-	Asserter               *Reference                   `json:"asserter,omitempty" bson:"asserter,omitempty"`                               // Source of the information about the allergy
-	LastReactionOccurrence *string                      `json:"lastReactionOccurrence,omitempty" bson:"last_reaction_occurrence,omitempty"` // Date(/time) of last known occurrence of a reaction
-	Note                   []Annotation                 `json:"note,omitempty" bson:"note,omitempty"`                                       // Additional text not captured in other fields
-	Reaction               []AllergyIntoleranceReaction `json:"reaction,omitempty" bson:"reaction,omitempty"`                               // Adverse Reaction Events linked to exposure to substance
+	ResourceType                  string                         `json:"resourceType" bson:"resource_type"`                                                   // Type of resource
+	Id                            *string                        `json:"id,omitempty" bson:"id,omitempty"`                                                    // Logical id of this artifact
+	Meta                          *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                                // Metadata about the resource
+	ImplicitRules                 *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                             // A set of rules under which this content was created
+	ImplicitRulesElement          *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                    // Extensions for implicitRules
+	Language                      *string                        `json:"language,omitempty" bson:"language,omitempty"`                                        // Language of the resource content
+	LanguageElement               *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`                               // Extensions for language
+	Text                          *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                                // Text summary of the resource, for human interpretation
+	Contained                     []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                                      // Contained, inline Resources
+	Extension                     []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                                      // Additional content defined by implementations
+	ModifierExtension             []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                     // Extensions that cannot be ignored
+	Identifier                    []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                                    // External ids for this item
+	ClinicalStatus                *CodeableConcept               `json:"clinicalStatus,omitempty" bson:"clinical_status,omitempty"`                           // active | inactive | resolved
+	VerificationStatus            *CodeableConcept               `json:"verificationStatus,omitempty" bson:"verification_status,omitempty"`                   // unconfirmed | presumed | confirmed | refuted | entered-in-error
+	Type                          *CodeableConcept               `json:"type,omitempty" bson:"type,omitempty"`                                                // allergy | intolerance - Underlying mechanism (if known)
+	Category                      []AllergyIntoleranceCategory   `json:"category,omitempty" bson:"category,omitempty"`                                        // food | medication | environment | biologic
+	CategoryElement               []*Element                     `json:"_category,omitempty" bson:"category_element,omitempty"`                               // Extensions for category
+	Criticality                   *AllergyIntoleranceCriticality `json:"criticality,omitempty" bson:"criticality,omitempty"`                                  // low | high | unable-to-assess
+	CriticalityElement            *Element                       `json:"_criticality,omitempty" bson:"criticality_element,omitempty"`                         // Extensions for criticality
+	Code                          *CodeableConcept               `json:"code,omitempty" bson:"code,omitempty"`                                                // Code that identifies the allergy or intolerance
+	Patient                       *Reference                     `json:"patient" bson:"patient"`                                                              // Who the allergy or intolerance is for
+	Encounter                     *Reference                     `json:"encounter,omitempty" bson:"encounter,omitempty"`                                      // Encounter when the allergy or intolerance was asserted
+	Onset                         AllergyIntoleranceOnset        `json:"-" bson:"onset,omitempty"`                                                            // When allergy or intolerance was identified
+	OnsetElement                  *Element                       `json:"-" bson:"onset_element,omitempty"`                                                    // Extensions for onset[x]
+	RecordedDate                  *DateTime                      `json:"recordedDate,omitempty" bson:"recorded_date,omitempty"`                               // Date allergy or intolerance was first recorded
+	RecordedDateElement           *Element                       `json:"_recordedDate,omitempty" bson:"recorded_date_element,omitempty"`                      // Extensions for recordedDate
+	Recorder                      *Reference                     `json:"recorder,omitempty" bson:"recorder,omitempty"`                                        // Who recorded the sensitivity
+	Asserter                      *Reference                     `json:"asserter,omitempty" bson:"asserter,omitempty"`                                        // Source of the information about the allergy
+	LastReactionOccurrence        *DateTime                      `json:"lastReactionOccurrence,omitempty" bson:"last_reaction_occurrence,omitempty"`          // Date(/time) of last known occurrence of a reaction
+	LastReactionOccurrenceElement *Element                       `json:"_lastReactionOccurrence,omitempty" bson:"last_reaction_occurrence_element,omitempty"` // Extensions for lastReactionOccurrence
+	Note                          []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                                                // Additional text not captured in other fields
+	Reaction                      []AllergyIntoleranceReaction   `json:"reaction,omitempty" bson:"reaction,omitempty"`                                        // Adverse Reaction Events linked to exposure to substance
+
+	onsetVariants []string // JSON properties of AllergyIntolerance.onset[x] when more than one was decoded
 }
 
+var allergyIntolerancePatientTargets = []string{"Patient"}
+
+var allergyIntoleranceAsserterTargets = []string{"Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *AllergyIntolerance) Validate() error {
 	if r.ResourceType != "AllergyIntolerance" {
 		return fmt.Errorf("invalid resourceType: expected 'AllergyIntolerance', got '%s'", r.ResourceType)
@@ -45,11 +57,39 @@ func (r *AllergyIntolerance) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -70,6 +110,27 @@ func (r *AllergyIntolerance) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	for i, item := range r.Category {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Category[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.CategoryElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("CategoryElement[%d]: %w", i, err)
+		}
+	}
+	if r.Criticality != nil && !r.Criticality.IsValid() {
+		return fmt.Errorf("field 'Criticality' has invalid code '%s'", *r.Criticality)
+	}
+	if r.CriticalityElement != nil {
+		if err := r.CriticalityElement.Validate(); err != nil {
+			return fmt.Errorf("CriticalityElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
@@ -78,6 +139,11 @@ func (r *AllergyIntolerance) Validate() error {
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, allergyIntolerancePatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, allergyIntolerancePatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
@@ -88,19 +154,27 @@ func (r *AllergyIntolerance) Validate() error {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OnsetAge != nil {
-		if err := r.OnsetAge.Validate(); err != nil {
-			return fmt.Errorf("OnsetAge: %w", err)
+	if len(r.onsetVariants) > 1 {
+		return fmt.Errorf("field 'Onset' must have a single type, got %v", r.onsetVariants)
+	}
+	if r.Onset != nil {
+		if err := r.Onset.Validate(); err != nil {
+			return fmt.Errorf("Onset: %w", err)
+		}
+	}
+	if r.OnsetElement != nil {
+		if err := r.OnsetElement.Validate(); err != nil {
+			return fmt.Errorf("OnsetElement: %w", err)
 		}
 	}
-	if r.OnsetPeriod != nil {
-		if err := r.OnsetPeriod.Validate(); err != nil {
-			return fmt.Errorf("OnsetPeriod: %w", err)
+	if r.RecordedDate != nil {
+		if err := r.RecordedDate.Validate(); err != nil {
+			return fmt.Errorf("RecordedDate: %w", err)
 		}
 	}
-	if r.OnsetRange != nil {
-		if err := r.OnsetRange.Validate(); err != nil {
-			return fmt.Errorf("OnsetRange: %w", err)
+	if r.RecordedDateElement != nil {
+		if err := r.RecordedDateElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedDateElement: %w", err)
 		}
 	}
 	if r.Recorder != nil {
@@ -108,11 +182,26 @@ func (r *AllergyIntolerance) Validate() error {
 			return fmt.Errorf("Recorder: %w", err)
 		}
 	}
+	if r.Asserter != nil {
+		if target := invalidReferenceTarget(r.Asserter.Reference, r.Asserter.Type, allergyIntoleranceAsserterTargets); target != "" {
+			return fmt.Errorf("field 'Asserter' cannot refer to %s, expected one of %v", target, allergyIntoleranceAsserterTargets)
+		}
+	}
 	if r.Asserter != nil {
 		if err := r.Asserter.Validate(); err != nil {
 			return fmt.Errorf("Asserter: %w", err)
 		}
 	}
+	if r.LastReactionOccurrence != nil {
+		if err := r.LastReactionOccurrence.Validate(); err != nil {
+			return fmt.Errorf("LastReactionOccurrence: %w", err)
+		}
+	}
+	if r.LastReactionOccurrenceElement != nil {
+		if err := r.LastReactionOccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("LastReactionOccurrenceElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -123,21 +212,445 @@ func (r *AllergyIntolerance) Validate() error {
 			return fmt.Errorf("Reaction[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "AllergyIntolerance")
+}
+
+func (r *AllergyIntolerance) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "AllergyIntolerance" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'AllergyIntolerance', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ClinicalStatus != nil {
+		r.ClinicalStatus.validateAll(path+".clinicalStatus", issues)
+	}
+	if r.VerificationStatus != nil {
+		r.VerificationStatus.validateAll(path+".verificationStatus", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Category {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.category[%d]", path, i), fmt.Sprintf("field 'Category[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.CategoryElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Criticality != nil && !r.Criticality.IsValid() {
+		issues.add("code-invalid", path+".criticality", fmt.Sprintf("field 'Criticality' has invalid code '%s'", *r.Criticality))
+	}
+	if r.CriticalityElement != nil {
+		r.CriticalityElement.validateAll(path+".criticality", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, allergyIntolerancePatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, allergyIntolerancePatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.onsetVariants) > 1 {
+		issues.add("structure", path+".onset", fmt.Sprintf("field 'Onset' must have a single type, got %v", r.onsetVariants))
+	}
+	if r.Onset != nil {
+		r.Onset.validateAll(path+".onset.ofType("+r.Onset.FHIRType()+")", issues)
+	}
+	if r.OnsetElement != nil {
+		r.OnsetElement.validateAll(path+".onset", issues)
+	}
+	if r.RecordedDate != nil {
+		if err := r.RecordedDate.Validate(); err != nil {
+			issues.add("value", path+".recordedDate", err.Error())
+		}
+	}
+	if r.RecordedDateElement != nil {
+		r.RecordedDateElement.validateAll(path+".recordedDate", issues)
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	if r.Asserter != nil {
+		if target := invalidReferenceTarget(r.Asserter.Reference, r.Asserter.Type, allergyIntoleranceAsserterTargets); target != "" {
+			issues.add("value", path+".asserter", fmt.Sprintf("field 'Asserter' cannot refer to %s, expected one of %v", target, allergyIntoleranceAsserterTargets))
+		}
+	}
+	if r.Asserter != nil {
+		r.Asserter.validateAll(path+".asserter", issues)
+	}
+	if r.LastReactionOccurrence != nil {
+		if err := r.LastReactionOccurrence.Validate(); err != nil {
+			issues.add("value", path+".lastReactionOccurrence", err.Error())
+		}
+	}
+	if r.LastReactionOccurrenceElement != nil {
+		r.LastReactionOccurrenceElement.validateAll(path+".lastReactionOccurrence", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Reaction {
+		item.validateAll(fmt.Sprintf("%s.reaction[%d]", path, i), issues)
+	}
+}
+
+func (r *AllergyIntolerance) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("AllergyIntolerance", &issues)
+	checkInvariants(r, "AllergyIntolerance", &issues)
+	return issues
+}
+
+var allergyIntoleranceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *AllergyIntolerance) invariants() []invariant {
+	return allergyIntoleranceInvariants
+}
+
+func (r AllergyIntolerance) MarshalJSON() ([]byte, error) {
+	type alias AllergyIntolerance
+	out := struct {
+		alias
+		Category        []*AllergyIntoleranceCategory `json:"category,omitempty"`
+		CategoryElement []*Element                    `json:"_category,omitempty"`
+	}{alias: alias(r)}
+	out.Category, out.CategoryElement = alignPrimitiveArray(r.Category, r.CategoryElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "onset", r.Onset, r.OnsetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AllergyIntolerance) UnmarshalJSON(data []byte) error {
+	type alias AllergyIntolerance
+	aux := struct {
+		*alias
+		Contained []json.RawMessage             `json:"contained,omitempty"`
+		Category  []*AllergyIntoleranceCategory `json:"category,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Category, err = splitPrimitiveArray(aux.Category, r.CategoryElement); err != nil {
+		return fmt.Errorf("category: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Onset, element, r.onsetVariants, err = unmarshalChoice(fields, "onset", allergyIntoleranceOnsetVariants); err != nil {
+		return err
+	}
+	r.OnsetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OnsetElement); err != nil {
+			return fmt.Errorf("_onset: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r AllergyIntolerance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AllergyIntolerance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AllergyIntolerance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AllergyIntolerance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AllergyIntolerance) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Onset":
+		return "onset", choiceValues(allergyIntoleranceOnsetVariants)
+	}
+	return "", nil
+}
+
+var allergyIntoleranceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *AllergyIntolerance) elementTypes() map[string]string {
+	return allergyIntoleranceElementTypes
+}
+
+var allergyIntoleranceElementMetadata = []ElementMetadata{
+	{Path: "AllergyIntolerance.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "AllergyIntolerance.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "AllergyIntolerance.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "AllergyIntolerance.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AllergyIntolerance.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "AllergyIntolerance.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "AllergyIntolerance.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "AllergyIntolerance.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AllergyIntolerance.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AllergyIntolerance.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "AllergyIntolerance.clinicalStatus", Name: "clinicalStatus", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AllergyIntolerance.verificationStatus", Name: "verificationStatus", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AllergyIntolerance.type", Name: "type", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AllergyIntolerance.category", Name: "category", Field: 15, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AllergyIntolerance.category", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/allergy-intolerance-category"}},
+	{Path: "AllergyIntolerance.criticality", Name: "criticality", Field: 17, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AllergyIntolerance.criticality", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/allergy-intolerance-criticality"}},
+	{Path: "AllergyIntolerance.code", Name: "code", Field: 19, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AllergyIntolerance.patient", Name: "patient", Field: 20, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "AllergyIntolerance.encounter", Name: "encounter", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "AllergyIntolerance.onset[x]", Name: "onset", Field: 22, Min: 0, Max: "1", Types: []string{"dateTime", "Age", "Period", "Range", "string"}},
+	{Path: "AllergyIntolerance.recordedDate", Name: "recordedDate", Field: 24, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "AllergyIntolerance.recorder", Name: "recorder", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "AllergyIntolerance.asserter", Name: "asserter", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "AllergyIntolerance.lastReactionOccurrence", Name: "lastReactionOccurrence", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "AllergyIntolerance.note", Name: "note", Field: 30, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "AllergyIntolerance.reaction", Name: "reaction", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *AllergyIntolerance) ElementMetadata() []ElementMetadata {
+	return allergyIntoleranceElementMetadata
+}
+
+// AllergyIntoleranceOnset is the type of AllergyIntolerance.onset[x]. It is implemented by the AllergyIntoleranceOnset* variant types.
+type AllergyIntoleranceOnset interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAllergyIntoleranceOnset()
+}
+
+// AllergyIntoleranceOnsetDateTime is the dateTime variant of AllergyIntoleranceOnset.
+type AllergyIntoleranceOnsetDateTime struct {
+	DateTime
+}
+
+func (v AllergyIntoleranceOnsetDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v AllergyIntoleranceOnsetDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v AllergyIntoleranceOnsetDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v AllergyIntoleranceOnsetDateTime) isAllergyIntoleranceOnset() {}
+
+// AllergyIntoleranceOnsetAge is the Age variant of AllergyIntoleranceOnset.
+type AllergyIntoleranceOnsetAge struct {
+	Age
+}
+
+func (v AllergyIntoleranceOnsetAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v AllergyIntoleranceOnsetAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v AllergyIntoleranceOnsetAge) FHIRType() string {
+	return "Age"
+}
+
+func (v AllergyIntoleranceOnsetAge) isAllergyIntoleranceOnset() {}
+
+// AllergyIntoleranceOnsetPeriod is the Period variant of AllergyIntoleranceOnset.
+type AllergyIntoleranceOnsetPeriod struct {
+	Period
+}
+
+func (v AllergyIntoleranceOnsetPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v AllergyIntoleranceOnsetPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v AllergyIntoleranceOnsetPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v AllergyIntoleranceOnsetPeriod) isAllergyIntoleranceOnset() {}
+
+// AllergyIntoleranceOnsetRange is the Range variant of AllergyIntoleranceOnset.
+type AllergyIntoleranceOnsetRange struct {
+	Range
+}
+
+func (v AllergyIntoleranceOnsetRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v AllergyIntoleranceOnsetRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v AllergyIntoleranceOnsetRange) FHIRType() string {
+	return "Range"
+}
+
+func (v AllergyIntoleranceOnsetRange) isAllergyIntoleranceOnset() {}
+
+// AllergyIntoleranceOnsetString is the string variant of AllergyIntoleranceOnset.
+type AllergyIntoleranceOnsetString string
+
+func (v AllergyIntoleranceOnsetString) Validate() error {
 	return nil
 }
 
+func (v AllergyIntoleranceOnsetString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AllergyIntoleranceOnsetString) FHIRType() string {
+	return "string"
+}
+
+func (v AllergyIntoleranceOnsetString) isAllergyIntoleranceOnset() {}
+
+var allergyIntoleranceOnsetVariants = []AllergyIntoleranceOnset{
+	AllergyIntoleranceOnsetDateTime{},
+	AllergyIntoleranceOnsetAge{},
+	AllergyIntoleranceOnsetPeriod{},
+	AllergyIntoleranceOnsetRange{},
+	AllergyIntoleranceOnsetString(""),
+}
+
+func (r *AllergyIntolerance) GetResourceType() string {
+	return "AllergyIntolerance"
+}
+
+func (r *AllergyIntolerance) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *AllergyIntolerance) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *AllergyIntolerance) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *AllergyIntolerance) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *AllergyIntolerance) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *AllergyIntolerance) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *AllergyIntolerance) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*AllergyIntolerance)(nil)
+
 type AllergyIntoleranceReaction struct {
-	Id            *string             `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Substance     *CodeableConcept    `json:"substance,omitempty" bson:"substance,omitempty"`          // Specific substance or pharmaceutical product considered to be responsible for event
-	Manifestation []CodeableReference `json:"manifestation" bson:"manifestation"`                      // Clinical symptoms/signs associated with the Event
-	Description   *string             `json:"description,omitempty" bson:"description,omitempty"`      // Description of the event as a whole
-	Onset         *string             `json:"onset,omitempty" bson:"onset,omitempty"`                  // Date(/time) when manifestations showed
-	Severity      *string             `json:"severity,omitempty" bson:"severity,omitempty"`            // mild | moderate | severe (of event as a whole)
-	ExposureRoute *CodeableConcept    `json:"exposureRoute,omitempty" bson:"exposure_route,omitempty"` // How the subject was exposed to the substance
-	Note          []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                    // Text about event not captured in other fields
+	Id                 *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Substance          *CodeableConcept            `json:"substance,omitempty" bson:"substance,omitempty"`                  // Specific substance or pharmaceutical product considered to be responsible for event
+	Manifestation      []CodeableReference         `json:"manifestation" bson:"manifestation"`                              // Clinical symptoms/signs associated with the Event
+	Description        *string                     `json:"description,omitempty" bson:"description,omitempty"`              // Description of the event as a whole
+	DescriptionElement *Element                    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Onset              *DateTime                   `json:"onset,omitempty" bson:"onset,omitempty"`                          // Date(/time) when manifestations showed
+	OnsetElement       *Element                    `json:"_onset,omitempty" bson:"onset_element,omitempty"`                 // Extensions for onset
+	Severity           *AllergyIntoleranceSeverity `json:"severity,omitempty" bson:"severity,omitempty"`                    // mild | moderate | severe (of event as a whole)
+	SeverityElement    *Element                    `json:"_severity,omitempty" bson:"severity_element,omitempty"`           // Extensions for severity
+	ExposureRoute      *CodeableConcept            `json:"exposureRoute,omitempty" bson:"exposure_route,omitempty"`         // How the subject was exposed to the substance
+	Note               []Annotation                `json:"note,omitempty" bson:"note,omitempty"`                            // Text about event not captured in other fields
 }
 
 func (r *AllergyIntoleranceReaction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Substance != nil {
 		if err := r.Substance.Validate(); err != nil {
 			return fmt.Errorf("Substance: %w", err)
@@ -151,6 +664,29 @@ func (r *AllergyIntoleranceReaction) Validate() error {
 			return fmt.Errorf("Manifestation[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Onset != nil {
+		if err := r.Onset.Validate(); err != nil {
+			return fmt.Errorf("Onset: %w", err)
+		}
+	}
+	if r.OnsetElement != nil {
+		if err := r.OnsetElement.Validate(); err != nil {
+			return fmt.Errorf("OnsetElement: %w", err)
+		}
+	}
+	if r.Severity != nil && !r.Severity.IsValid() {
+		return fmt.Errorf("field 'Severity' has invalid code '%s'", *r.Severity)
+	}
+	if r.SeverityElement != nil {
+		if err := r.SeverityElement.Validate(); err != nil {
+			return fmt.Errorf("SeverityElement: %w", err)
+		}
+	}
 	if r.ExposureRoute != nil {
 		if err := r.ExposureRoute.Validate(); err != nil {
 			return fmt.Errorf("ExposureRoute: %w", err)
@@ -163,3 +699,85 @@ func (r *AllergyIntoleranceReaction) Validate() error {
 	}
 	return nil
 }
+
+func (r *AllergyIntoleranceReaction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Substance != nil {
+		r.Substance.validateAll(path+".substance", issues)
+	}
+	if len(r.Manifestation) < 1 {
+		issues.add("required", path+".manifestation", "field 'Manifestation' must have at least 1 elements")
+	}
+	for i, item := range r.Manifestation {
+		item.validateAll(fmt.Sprintf("%s.manifestation[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Onset != nil {
+		if err := r.Onset.Validate(); err != nil {
+			issues.add("value", path+".onset", err.Error())
+		}
+	}
+	if r.OnsetElement != nil {
+		r.OnsetElement.validateAll(path+".onset", issues)
+	}
+	if r.Severity != nil && !r.Severity.IsValid() {
+		issues.add("code-invalid", path+".severity", fmt.Sprintf("field 'Severity' has invalid code '%s'", *r.Severity))
+	}
+	if r.SeverityElement != nil {
+		r.SeverityElement.validateAll(path+".severity", issues)
+	}
+	if r.ExposureRoute != nil {
+		r.ExposureRoute.validateAll(path+".exposureRoute", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+var allergyIntoleranceReactionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AllergyIntoleranceReaction) invariants() []invariant {
+	return allergyIntoleranceReactionInvariants
+}
+
+func (r AllergyIntoleranceReaction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AllergyIntoleranceReaction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AllergyIntoleranceReaction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AllergyIntoleranceReaction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var allergyIntoleranceReactionElementMetadata = []ElementMetadata{
+	{Path: "AllergyIntolerance.reaction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AllergyIntolerance.reaction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AllergyIntolerance.reaction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AllergyIntolerance.reaction.substance", Name: "substance", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AllergyIntolerance.reaction.manifestation", Name: "manifestation", Field: 4, Min: 1, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "AllergyIntolerance.reaction.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AllergyIntolerance.reaction.onset", Name: "onset", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "AllergyIntolerance.reaction.severity", Name: "severity", Field: 9, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AllergyIntolerance.reaction.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/reaction-event-severity"}},
+	{Path: "AllergyIntolerance.reaction.exposureRoute", Name: "exposureRoute", Field: 11, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AllergyIntolerance.reaction.note", Name: "note", Field: 12, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *AllergyIntoleranceReaction) ElementMetadata() []ElementMetadata {
+	return allergyIntoleranceReactionElementMetadata
+}
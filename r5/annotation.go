@@ -1,27 +1,239 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Annotation Type: A  text note which also  contains information about who made the statement and when.
 type Annotation struct {
-	Id              *string    `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	AuthorReference *Reference `json:"authorReference,omitempty" bson:"author_reference,omitempty"` // Individual responsible for the annotation
-	AuthorString    *string    `json:"authorString,omitempty" bson:"author_string,omitempty"`       // Individual responsible for the annotation
-	Time            *string    `json:"time,omitempty" bson:"time,omitempty"`                        // When the annotation was made
-	Text            string     `json:"text" bson:"text"`                                            // The annotation  - text content (as markdown)
+	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension     []Extension      `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Author        AnnotationAuthor `json:"-" bson:"author,omitempty"`                      // Individual responsible for the annotation
+	AuthorElement *Element         `json:"-" bson:"author_element,omitempty"`              // Extensions for author[x]
+	Time          *DateTime        `json:"time,omitempty" bson:"time,omitempty"`           // When the annotation was made
+	TimeElement   *Element         `json:"_time,omitempty" bson:"time_element,omitempty"`  // Extensions for time
+	Text          string           `json:"text" bson:"text"`                               // The annotation  - text content (as markdown)
+	TextElement   *Element         `json:"_text,omitempty" bson:"text_element,omitempty"`  // Extensions for text
+
+	authorVariants []string // JSON properties of Annotation.author[x] when more than one was decoded
 }
 
 func (r *Annotation) Validate() error {
-	if r.AuthorReference != nil {
-		if err := r.AuthorReference.Validate(); err != nil {
-			return fmt.Errorf("AuthorReference: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if len(r.authorVariants) > 1 {
+		return fmt.Errorf("field 'Author' must have a single type, got %v", r.authorVariants)
+	}
+	if r.Author != nil {
+		if err := r.Author.Validate(); err != nil {
+			return fmt.Errorf("Author: %w", err)
+		}
+	}
+	if r.AuthorElement != nil {
+		if err := r.AuthorElement.Validate(); err != nil {
+			return fmt.Errorf("AuthorElement: %w", err)
+		}
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			return fmt.Errorf("Time: %w", err)
+		}
+	}
+	if r.TimeElement != nil {
+		if err := r.TimeElement.Validate(); err != nil {
+			return fmt.Errorf("TimeElement: %w", err)
 		}
 	}
 	var emptyString string
 	if r.Text == emptyString {
 		return fmt.Errorf("field 'Text' is required")
 	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Annotation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if len(r.authorVariants) > 1 {
+		issues.add("structure", path+".author", fmt.Sprintf("field 'Author' must have a single type, got %v", r.authorVariants))
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author.ofType("+r.Author.FHIRType()+")", issues)
+	}
+	if r.AuthorElement != nil {
+		r.AuthorElement.validateAll(path+".author", issues)
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			issues.add("value", path+".time", err.Error())
+		}
+	}
+	if r.TimeElement != nil {
+		r.TimeElement.validateAll(path+".time", issues)
+	}
+	var emptyString string
+	if r.Text == emptyString {
+		issues.add("required", path+".text", "field 'Text' is required")
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+func (r *Annotation) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Annotation", &issues)
+	checkInvariants(r, "Annotation", &issues)
+	return issues
+}
+
+var annotationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Annotation) invariants() []invariant {
+	return annotationInvariants
+}
+
+func (r Annotation) MarshalJSON() ([]byte, error) {
+	type alias Annotation
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "author", r.Author, r.AuthorElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Annotation) UnmarshalJSON(data []byte) error {
+	type alias Annotation
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Author, element, r.authorVariants, err = unmarshalChoice(fields, "author", annotationAuthorVariants); err != nil {
+		return err
+	}
+	r.AuthorElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AuthorElement); err != nil {
+			return fmt.Errorf("_author: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r Annotation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Annotation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Annotation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Annotation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Annotation) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Author":
+		return "author", choiceValues(annotationAuthorVariants)
+	}
+	return "", nil
+}
+
+var annotationElementTypes = map[string]string{
+	"text": "markdown",
+}
+
+func (r *Annotation) elementTypes() map[string]string {
+	return annotationElementTypes
+}
+
+var annotationElementMetadata = []ElementMetadata{
+	{Path: "Annotation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Annotation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Annotation.author[x]", Name: "author", Field: 2, Min: 0, Max: "1", Types: []string{"Reference", "string"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Organization"}, Summary: true},
+	{Path: "Annotation.time", Name: "time", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}, Summary: true},
+	{Path: "Annotation.text", Name: "text", Field: 6, Min: 1, Max: "1", Types: []string{"markdown"}, Summary: true},
+}
+
+func (r *Annotation) ElementMetadata() []ElementMetadata {
+	return annotationElementMetadata
+}
+
+// AnnotationAuthor is the type of Annotation.author[x]. It is implemented by the AnnotationAuthor* variant types.
+type AnnotationAuthor interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAnnotationAuthor()
+}
+
+// AnnotationAuthorReference is the Reference variant of AnnotationAuthor.
+type AnnotationAuthorReference struct {
+	Reference
+}
+
+func (v AnnotationAuthorReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v AnnotationAuthorReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v AnnotationAuthorReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v AnnotationAuthorReference) isAnnotationAuthor() {}
+
+// AnnotationAuthorString is the string variant of AnnotationAuthor.
+type AnnotationAuthorString string
+
+func (v AnnotationAuthorString) Validate() error {
+	return nil
+}
+
+func (v AnnotationAuthorString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AnnotationAuthorString) FHIRType() string {
+	return "string"
+}
+
+func (v AnnotationAuthorString) isAnnotationAuthor() {}
+
+var annotationAuthorVariants = []AnnotationAuthor{
+	AnnotationAuthorReference{},
+	AnnotationAuthorString(""),
+}
@@ -1,9 +1,443 @@
 package models
 
-// The apply operation applies a PlanDefinition to a given subject or group of subjects, instantiating applicable actions and returning the results as bundles of request resources.
-type Apply struct {
+import "fmt"
+
+// ApplyIn holds the input parameters of the $apply operation. The apply operation applies a PlanDefinition to a given subject or group of subjects, instantiating applicable actions and returning the results as bundles of request resources.
+type ApplyIn struct {
+	// The plan definition to be applied
+	PlanDefinition *PlanDefinition
+	// A canonical url for a plan definition
+	Canonical *string
+	// Canonical URL of the PlanDefinition when invoked at the resource type level
+	Url *string
+	// Version of the PlanDefinition when invoked at the resource type level
+	Version *string
+	// The subject(s) that is/are the target of the plan definition to be applied
+	Subject []string
+	// The encounter in context, if any
+	Encounter *string
+	// The practitioner applying the plan definition
+	Practitioner *string
+	// The organization applying the plan definition
+	Organization *string
+	// The type of user initiating the request
+	UserType *string
+	// Preferred language of the person using the system
+	UserLanguage *CodeableConcept
+	// The task the system user is performing
+	UserTaskContext *CodeableConcept
+	// The current setting of the request (inpatient, outpatient, etc.)
+	Setting *CodeableConcept
+	// Additional detail about the setting of the request, if any
+	SettingContext *CodeableConcept
+	// Any input parameters defined in libraries referenced by the PlanDefinition
+	Parameters *Parameters
+	// If true, or not provided, data may be retrieved from the server the operation is invoked on
+	UseServerData *bool
+	// Data to be made available to the PlanDefinition evaluation
+	Data *Bundle
+	// Data to be made available to the PlanDefinition evaluation, organized as prefetch response bundles
+	PrefetchData []ApplyInPrefetchData
+	// An endpoint to use to access data referenced by retrieve operations in libraries referenced by the PlanDefinition
+	DataEndpoint *Endpoint
+	// An endpoint to use to access content referenced by the PlanDefinition
+	ContentEndpoint *Endpoint
+	// An endpoint to use to access terminology referenced by the PlanDefinition
+	TerminologyEndpoint *Endpoint
+}
+
+func (p *ApplyIn) Validate() error {
+	for i := range p.PrefetchData {
+		if err := p.PrefetchData[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'prefetchData'[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *ApplyIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ApplyIn) FromParameters(params *Parameters) error {
+	*p = ApplyIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ApplyIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.PlanDefinition != nil {
+		params = append(params, ParametersParameter{Name: "planDefinition", Resource: p.PlanDefinition})
+	}
+	if p.Canonical != nil {
+		params = append(params, ParametersParameter{Name: "canonical", Value: ParametersParameterValueCanonical(*p.Canonical)})
+	}
+	if p.Url != nil {
+		params = append(params, ParametersParameter{Name: "url", Value: ParametersParameterValueUri(*p.Url)})
+	}
+	if p.Version != nil {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueString(*p.Version)})
+	}
+	for _, v := range p.Subject {
+		params = append(params, ParametersParameter{Name: "subject", Value: ParametersParameterValueString(v)})
+	}
+	if p.Encounter != nil {
+		params = append(params, ParametersParameter{Name: "encounter", Value: ParametersParameterValueString(*p.Encounter)})
+	}
+	if p.Practitioner != nil {
+		params = append(params, ParametersParameter{Name: "practitioner", Value: ParametersParameterValueString(*p.Practitioner)})
+	}
+	if p.Organization != nil {
+		params = append(params, ParametersParameter{Name: "organization", Value: ParametersParameterValueString(*p.Organization)})
+	}
+	if p.UserType != nil {
+		params = append(params, ParametersParameter{Name: "userType", Value: ParametersParameterValueString(*p.UserType)})
+	}
+	if p.UserLanguage != nil {
+		params = append(params, ParametersParameter{Name: "userLanguage", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.UserLanguage}})
+	}
+	if p.UserTaskContext != nil {
+		params = append(params, ParametersParameter{Name: "userTaskContext", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.UserTaskContext}})
+	}
+	if p.Setting != nil {
+		params = append(params, ParametersParameter{Name: "setting", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.Setting}})
+	}
+	if p.SettingContext != nil {
+		params = append(params, ParametersParameter{Name: "settingContext", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.SettingContext}})
+	}
+	if p.Parameters != nil {
+		params = append(params, ParametersParameter{Name: "parameters", Resource: p.Parameters})
+	}
+	if p.UseServerData != nil {
+		params = append(params, ParametersParameter{Name: "useServerData", Value: ParametersParameterValueBoolean(*p.UseServerData)})
+	}
+	if p.Data != nil {
+		params = append(params, ParametersParameter{Name: "data", Resource: p.Data})
+	}
+	for _, v := range p.PrefetchData {
+		params = append(params, ParametersParameter{Name: "prefetchData", Part: v.parameters()})
+	}
+	if p.DataEndpoint != nil {
+		params = append(params, ParametersParameter{Name: "dataEndpoint", Resource: p.DataEndpoint})
+	}
+	if p.ContentEndpoint != nil {
+		params = append(params, ParametersParameter{Name: "contentEndpoint", Resource: p.ContentEndpoint})
+	}
+	if p.TerminologyEndpoint != nil {
+		params = append(params, ParametersParameter{Name: "terminologyEndpoint", Resource: p.TerminologyEndpoint})
+	}
+	return params
+}
+
+func (p *ApplyIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "planDefinition":
+			if p.PlanDefinition != nil {
+				return fmt.Errorf("parameter 'planDefinition' must occur at most once")
+			}
+			v, err := parameterResource[*PlanDefinition](param.Name, param.Resource, "PlanDefinition")
+			if err != nil {
+				return err
+			}
+			p.PlanDefinition = v
+		case "canonical":
+			if p.Canonical != nil {
+				return fmt.Errorf("parameter 'canonical' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Canonical = &value
+		case "url":
+			if p.Url != nil {
+				return fmt.Errorf("parameter 'url' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Url = &value
+		case "version":
+			if p.Version != nil {
+				return fmt.Errorf("parameter 'version' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Version = &value
+		case "subject":
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Subject = append(p.Subject, string(v))
+		case "encounter":
+			if p.Encounter != nil {
+				return fmt.Errorf("parameter 'encounter' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Encounter = &value
+		case "practitioner":
+			if p.Practitioner != nil {
+				return fmt.Errorf("parameter 'practitioner' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Practitioner = &value
+		case "organization":
+			if p.Organization != nil {
+				return fmt.Errorf("parameter 'organization' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Organization = &value
+		case "userType":
+			if p.UserType != nil {
+				return fmt.Errorf("parameter 'userType' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.UserType = &value
+		case "userLanguage":
+			if p.UserLanguage != nil {
+				return fmt.Errorf("parameter 'userLanguage' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.UserLanguage = &v.CodeableConcept
+		case "userTaskContext":
+			if p.UserTaskContext != nil {
+				return fmt.Errorf("parameter 'userTaskContext' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.UserTaskContext = &v.CodeableConcept
+		case "setting":
+			if p.Setting != nil {
+				return fmt.Errorf("parameter 'setting' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Setting = &v.CodeableConcept
+		case "settingContext":
+			if p.SettingContext != nil {
+				return fmt.Errorf("parameter 'settingContext' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.SettingContext = &v.CodeableConcept
+		case "parameters":
+			if p.Parameters != nil {
+				return fmt.Errorf("parameter 'parameters' must occur at most once")
+			}
+			v, err := parameterResource[*Parameters](param.Name, param.Resource, "Parameters")
+			if err != nil {
+				return err
+			}
+			p.Parameters = v
+		case "useServerData":
+			if p.UseServerData != nil {
+				return fmt.Errorf("parameter 'useServerData' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.UseServerData = &value
+		case "data":
+			if p.Data != nil {
+				return fmt.Errorf("parameter 'data' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Data = v
+		case "prefetchData":
+			var v ApplyInPrefetchData
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'prefetchData': %w", err)
+			}
+			p.PrefetchData = append(p.PrefetchData, v)
+		case "dataEndpoint":
+			if p.DataEndpoint != nil {
+				return fmt.Errorf("parameter 'dataEndpoint' must occur at most once")
+			}
+			v, err := parameterResource[*Endpoint](param.Name, param.Resource, "Endpoint")
+			if err != nil {
+				return err
+			}
+			p.DataEndpoint = v
+		case "contentEndpoint":
+			if p.ContentEndpoint != nil {
+				return fmt.Errorf("parameter 'contentEndpoint' must occur at most once")
+			}
+			v, err := parameterResource[*Endpoint](param.Name, param.Resource, "Endpoint")
+			if err != nil {
+				return err
+			}
+			p.ContentEndpoint = v
+		case "terminologyEndpoint":
+			if p.TerminologyEndpoint != nil {
+				return fmt.Errorf("parameter 'terminologyEndpoint' must occur at most once")
+			}
+			v, err := parameterResource[*Endpoint](param.Name, param.Resource, "Endpoint")
+			if err != nil {
+				return err
+			}
+			p.TerminologyEndpoint = v
+		}
+	}
+	return nil
+}
+
+// ApplyInPrefetchData holds the parts of the prefetchData parameter.
+type ApplyInPrefetchData struct {
+	// The key of the prefetch item
+	Key *string
+	// The data requirement describing the prefetch item
+	Descriptor *DataRequirement
+	// The prefetch data as a Bundle
+	Data *Bundle
+}
+
+func (p *ApplyInPrefetchData) Validate() error {
+	return nil
+}
+
+func (p *ApplyInPrefetchData) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Key != nil {
+		params = append(params, ParametersParameter{Name: "key", Value: ParametersParameterValueString(*p.Key)})
+	}
+	if p.Descriptor != nil {
+		params = append(params, ParametersParameter{Name: "descriptor", Value: ParametersParameterValueDataRequirement{DataRequirement: *p.Descriptor}})
+	}
+	if p.Data != nil {
+		params = append(params, ParametersParameter{Name: "data", Resource: p.Data})
+	}
+	return params
+}
+
+func (p *ApplyInPrefetchData) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "key":
+			if p.Key != nil {
+				return fmt.Errorf("parameter 'key' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Key = &value
+		case "descriptor":
+			if p.Descriptor != nil {
+				return fmt.Errorf("parameter 'descriptor' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueDataRequirement](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Descriptor = &v.DataRequirement
+		case "data":
+			if p.Data != nil {
+				return fmt.Errorf("parameter 'data' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Data = v
+		}
+	}
+	return nil
+}
+
+// ApplyOut holds the output parameters of the $apply operation.
+type ApplyOut struct {
+	// A Bundle containing a RequestOrchestration and the resources it refers to
+	Return *Bundle
+}
+
+func (p *ApplyOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *ApplyOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ApplyOut) FromParameters(params *Parameters) error {
+	*p = ApplyOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ApplyOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Apply) Validate() error {
+func (p *ApplyOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
@@ -2,52 +2,76 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A booking of a healthcare event among patient(s), practitioner(s), related person(s) and/or device(s) for a specific date/time. This may result in one or more Encounter(s).
 type Appointment struct {
-	ResourceType           string                          `json:"resourceType" bson:"resource_type"`                                         // Type of resource
-	Id                     *string                         `json:"id,omitempty" bson:"id,omitempty"`                                          // Logical id of this artifact
-	Meta                   *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                      // Metadata about the resource
-	ImplicitRules          *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                   // A set of rules under which this content was created
-	Language               *string                         `json:"language,omitempty" bson:"language,omitempty"`                              // Language of the resource content
-	Text                   *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                      // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage               `json:"contained,omitempty" bson:"contained,omitempty"`                            // Contained, inline Resources
-	Identifier             []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                          // External Ids for this item
-	Status                 string                          `json:"status" bson:"status"`                                                      // proposed | pending | booked | arrived | fulfilled | cancelled | noshow | entered-in-error | checked-in | waitlist
-	CancellationReason     *CodeableConcept                `json:"cancellationReason,omitempty" bson:"cancellation_reason,omitempty"`         // The coded reason for the appointment being cancelled
-	Class                  []CodeableConcept               `json:"class,omitempty" bson:"class,omitempty"`                                    // Classification when becoming an encounter
-	ServiceCategory        []CodeableConcept               `json:"serviceCategory,omitempty" bson:"service_category,omitempty"`               // A broad categorization of the service that is to be performed during this appointment
-	ServiceType            []CodeableReference             `json:"serviceType,omitempty" bson:"service_type,omitempty"`                       // The specific service that is to be performed during this appointment
-	Specialty              []CodeableConcept               `json:"specialty,omitempty" bson:"specialty,omitempty"`                            // The specialty of a practitioner that would be required to perform the service requested in this appointment
-	AppointmentType        *CodeableConcept                `json:"appointmentType,omitempty" bson:"appointment_type,omitempty"`               // The style of appointment or patient that has been booked in the slot (not service type)
-	Reason                 []CodeableReference             `json:"reason,omitempty" bson:"reason,omitempty"`                                  // Reason this appointment is scheduled
-	Priority               *CodeableConcept                `json:"priority,omitempty" bson:"priority,omitempty"`                              // Used to make informed decisions if needing to re-prioritize
-	Description            *string                         `json:"description,omitempty" bson:"description,omitempty"`                        // Shown on a subject line in a meeting request, or appointment list
-	Replaces               []Reference                     `json:"replaces,omitempty" bson:"replaces,omitempty"`                              // Appointment replaced by this Appointment
-	VirtualService         []VirtualServiceDetail          `json:"virtualService,omitempty" bson:"virtual_service,omitempty"`                 // Connection details of a virtual service (e.g. conference call)
-	SupportingInformation  []Reference                     `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`   // Additional information to support the appointment
-	PreviousAppointment    *Reference                      `json:"previousAppointment,omitempty" bson:"previous_appointment,omitempty"`       // The previous appointment in a series
-	OriginatingAppointment *Reference                      `json:"originatingAppointment,omitempty" bson:"originating_appointment,omitempty"` // The originating appointment in a recurring set of appointments
-	Start                  *string                         `json:"start,omitempty" bson:"start,omitempty"`                                    // When appointment is to take place
-	End                    *string                         `json:"end,omitempty" bson:"end,omitempty"`                                        // When appointment is to conclude
-	MinutesDuration        *int                            `json:"minutesDuration,omitempty" bson:"minutes_duration,omitempty"`               // Can be less than start/end (e.g. estimate)
-	RequestedPeriod        []Period                        `json:"requestedPeriod,omitempty" bson:"requested_period,omitempty"`               // Potential date/time interval(s) requested to allocate the appointment within
-	Slot                   []Reference                     `json:"slot,omitempty" bson:"slot,omitempty"`                                      // The slots that this appointment is filling
-	Account                []Reference                     `json:"account,omitempty" bson:"account,omitempty"`                                // The set of accounts that may be used for billing for this Appointment
-	Created                *string                         `json:"created,omitempty" bson:"created,omitempty"`                                // The date that this appointment was initially created
-	CancellationDate       *string                         `json:"cancellationDate,omitempty" bson:"cancellation_date,omitempty"`             // When the appointment was cancelled
-	Note                   []Annotation                    `json:"note,omitempty" bson:"note,omitempty"`                                      // Additional comments
-	PatientInstruction     []CodeableReference             `json:"patientInstruction,omitempty" bson:"patient_instruction,omitempty"`         // Detailed information and instructions for the patient
-	BasedOn                []Reference                     `json:"basedOn,omitempty" bson:"based_on,omitempty"`                               // The request this appointment is allocated to assess
-	Subject                *Reference                      `json:"subject,omitempty" bson:"subject,omitempty"`                                // The patient or group associated with the appointment
-	Participant            []AppointmentParticipant        `json:"participant" bson:"participant"`                                            // Participants involved in appointment
-	RecurrenceId           *int                            `json:"recurrenceId,omitempty" bson:"recurrence_id,omitempty"`                     // The sequence number in the recurrence
-	OccurrenceChanged      *bool                           `json:"occurrenceChanged,omitempty" bson:"occurrence_changed,omitempty"`           // Indicates that this appointment varies from a recurrence pattern
-	RecurrenceTemplate     []AppointmentRecurrenceTemplate `json:"recurrenceTemplate,omitempty" bson:"recurrence_template,omitempty"`         // Details of the recurrence pattern/template used to generate occurrences
+	ResourceType             string                          `json:"resourceType" bson:"resource_type"`                                         // Type of resource
+	Id                       *string                         `json:"id,omitempty" bson:"id,omitempty"`                                          // Logical id of this artifact
+	Meta                     *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                      // Metadata about the resource
+	ImplicitRules            *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                   // A set of rules under which this content was created
+	ImplicitRulesElement     *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`          // Extensions for implicitRules
+	Language                 *string                         `json:"language,omitempty" bson:"language,omitempty"`                              // Language of the resource content
+	LanguageElement          *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`                     // Extensions for language
+	Text                     *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                      // Text summary of the resource, for human interpretation
+	Contained                []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                            // Contained, inline Resources
+	Extension                []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored
+	Identifier               []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                          // External Ids for this item
+	Status                   AppointmentStatus               `json:"status" bson:"status"`                                                      // proposed | pending | booked | arrived | fulfilled | cancelled | noshow | entered-in-error | checked-in | waitlist
+	StatusElement            *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                         // Extensions for status
+	CancellationReason       *CodeableConcept                `json:"cancellationReason,omitempty" bson:"cancellation_reason,omitempty"`         // The coded reason for the appointment being cancelled
+	Class                    []CodeableConcept               `json:"class,omitempty" bson:"class,omitempty"`                                    // Classification when becoming an encounter
+	ServiceCategory          []CodeableConcept               `json:"serviceCategory,omitempty" bson:"service_category,omitempty"`               // A broad categorization of the service that is to be performed during this appointment
+	ServiceType              []CodeableReference             `json:"serviceType,omitempty" bson:"service_type,omitempty"`                       // The specific service that is to be performed during this appointment
+	Specialty                []CodeableConcept               `json:"specialty,omitempty" bson:"specialty,omitempty"`                            // The specialty of a practitioner that would be required to perform the service requested in this appointment
+	AppointmentType          *CodeableConcept                `json:"appointmentType,omitempty" bson:"appointment_type,omitempty"`               // The style of appointment or patient that has been booked in the slot (not service type)
+	Reason                   []CodeableReference             `json:"reason,omitempty" bson:"reason,omitempty"`                                  // Reason this appointment is scheduled
+	Priority                 *CodeableConcept                `json:"priority,omitempty" bson:"priority,omitempty"`                              // Used to make informed decisions if needing to re-prioritize
+	Description              *string                         `json:"description,omitempty" bson:"description,omitempty"`                        // Shown on a subject line in a meeting request, or appointment list
+	DescriptionElement       *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`               // Extensions for description
+	Replaces                 []Reference                     `json:"replaces,omitempty" bson:"replaces,omitempty"`                              // Appointment replaced by this Appointment
+	VirtualService           []VirtualServiceDetail          `json:"virtualService,omitempty" bson:"virtual_service,omitempty"`                 // Connection details of a virtual service (e.g. conference call)
+	SupportingInformation    []Reference                     `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`   // Additional information to support the appointment
+	PreviousAppointment      *Reference                      `json:"previousAppointment,omitempty" bson:"previous_appointment,omitempty"`       // The previous appointment in a series
+	OriginatingAppointment   *Reference                      `json:"originatingAppointment,omitempty" bson:"originating_appointment,omitempty"` // The originating appointment in a recurring set of appointments
+	Start                    *Instant                        `json:"start,omitempty" bson:"start,omitempty"`                                    // When appointment is to take place
+	StartElement             *Element                        `json:"_start,omitempty" bson:"start_element,omitempty"`                           // Extensions for start
+	End                      *Instant                        `json:"end,omitempty" bson:"end,omitempty"`                                        // When appointment is to conclude
+	EndElement               *Element                        `json:"_end,omitempty" bson:"end_element,omitempty"`                               // Extensions for end
+	MinutesDuration          *int                            `json:"minutesDuration,omitempty" bson:"minutes_duration,omitempty"`               // Can be less than start/end (e.g. estimate)
+	MinutesDurationElement   *Element                        `json:"_minutesDuration,omitempty" bson:"minutes_duration_element,omitempty"`      // Extensions for minutesDuration
+	RequestedPeriod          []Period                        `json:"requestedPeriod,omitempty" bson:"requested_period,omitempty"`               // Potential date/time interval(s) requested to allocate the appointment within
+	Slot                     []Reference                     `json:"slot,omitempty" bson:"slot,omitempty"`                                      // The slots that this appointment is filling
+	Account                  []Reference                     `json:"account,omitempty" bson:"account,omitempty"`                                // The set of accounts that may be used for billing for this Appointment
+	Created                  *DateTime                       `json:"created,omitempty" bson:"created,omitempty"`                                // The date that this appointment was initially created
+	CreatedElement           *Element                        `json:"_created,omitempty" bson:"created_element,omitempty"`                       // Extensions for created
+	CancellationDate         *DateTime                       `json:"cancellationDate,omitempty" bson:"cancellation_date,omitempty"`             // When the appointment was cancelled
+	CancellationDateElement  *Element                        `json:"_cancellationDate,omitempty" bson:"cancellation_date_element,omitempty"`    // Extensions for cancellationDate
+	Note                     []Annotation                    `json:"note,omitempty" bson:"note,omitempty"`                                      // Additional comments
+	PatientInstruction       []CodeableReference             `json:"patientInstruction,omitempty" bson:"patient_instruction,omitempty"`         // Detailed information and instructions for the patient
+	BasedOn                  []Reference                     `json:"basedOn,omitempty" bson:"based_on,omitempty"`                               // The request this appointment is allocated to assess
+	Subject                  *Reference                      `json:"subject,omitempty" bson:"subject,omitempty"`                                // The patient or group associated with the appointment
+	Participant              []AppointmentParticipant        `json:"participant" bson:"participant"`                                            // Participants involved in appointment
+	RecurrenceId             *int                            `json:"recurrenceId,omitempty" bson:"recurrence_id,omitempty"`                     // The sequence number in the recurrence
+	RecurrenceIdElement      *Element                        `json:"_recurrenceId,omitempty" bson:"recurrence_id_element,omitempty"`            // Extensions for recurrenceId
+	OccurrenceChanged        *bool                           `json:"occurrenceChanged,omitempty" bson:"occurrence_changed,omitempty"`           // Indicates that this appointment varies from a recurrence pattern
+	OccurrenceChangedElement *Element                        `json:"_occurrenceChanged,omitempty" bson:"occurrence_changed_element,omitempty"`  // Extensions for occurrenceChanged
+	RecurrenceTemplate       []AppointmentRecurrenceTemplate `json:"recurrenceTemplate,omitempty" bson:"recurrence_template,omitempty"`         // Details of the recurrence pattern/template used to generate occurrences
 }
 
+var appointmentPreviousAppointmentTargets = []string{"Appointment"}
+
+var appointmentOriginatingAppointmentTargets = []string{"Appointment"}
+
+var appointmentSlotTargets = []string{"Slot"}
+
+var appointmentBasedOnTargets = []string{"CarePlan", "DeviceRequest", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest", "VisionPrescription"}
+
+var appointmentSubjectTargets = []string{"Group", "Patient"}
+
 func (r *Appointment) Validate() error {
 	if r.ResourceType != "Appointment" {
 		return fmt.Errorf("invalid resourceType: expected 'Appointment', got '%s'", r.ResourceType)
@@ -57,20 +81,55 @@ func (r *Appointment) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.CancellationReason != nil {
 		if err := r.CancellationReason.Validate(); err != nil {
 			return fmt.Errorf("CancellationReason: %w", err)
@@ -111,6 +170,11 @@ func (r *Appointment) Validate() error {
 			return fmt.Errorf("Priority: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Replaces {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Replaces[%d]: %w", i, err)
@@ -126,21 +190,61 @@ func (r *Appointment) Validate() error {
 			return fmt.Errorf("SupportingInformation[%d]: %w", i, err)
 		}
 	}
+	if r.PreviousAppointment != nil {
+		if target := invalidReferenceTarget(r.PreviousAppointment.Reference, r.PreviousAppointment.Type, appointmentPreviousAppointmentTargets); target != "" {
+			return fmt.Errorf("field 'PreviousAppointment' cannot refer to %s, expected one of %v", target, appointmentPreviousAppointmentTargets)
+		}
+	}
 	if r.PreviousAppointment != nil {
 		if err := r.PreviousAppointment.Validate(); err != nil {
 			return fmt.Errorf("PreviousAppointment: %w", err)
 		}
 	}
+	if r.OriginatingAppointment != nil {
+		if target := invalidReferenceTarget(r.OriginatingAppointment.Reference, r.OriginatingAppointment.Type, appointmentOriginatingAppointmentTargets); target != "" {
+			return fmt.Errorf("field 'OriginatingAppointment' cannot refer to %s, expected one of %v", target, appointmentOriginatingAppointmentTargets)
+		}
+	}
 	if r.OriginatingAppointment != nil {
 		if err := r.OriginatingAppointment.Validate(); err != nil {
 			return fmt.Errorf("OriginatingAppointment: %w", err)
 		}
 	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			return fmt.Errorf("Start: %w", err)
+		}
+	}
+	if r.StartElement != nil {
+		if err := r.StartElement.Validate(); err != nil {
+			return fmt.Errorf("StartElement: %w", err)
+		}
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			return fmt.Errorf("End: %w", err)
+		}
+	}
+	if r.EndElement != nil {
+		if err := r.EndElement.Validate(); err != nil {
+			return fmt.Errorf("EndElement: %w", err)
+		}
+	}
+	if r.MinutesDurationElement != nil {
+		if err := r.MinutesDurationElement.Validate(); err != nil {
+			return fmt.Errorf("MinutesDurationElement: %w", err)
+		}
+	}
 	for i, item := range r.RequestedPeriod {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RequestedPeriod[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Slot {
+		if target := invalidReferenceTarget(item.Reference, item.Type, appointmentSlotTargets); target != "" {
+			return fmt.Errorf("field 'Slot[%d]' cannot refer to %s, expected one of %v", i, target, appointmentSlotTargets)
+		}
+	}
 	for i, item := range r.Slot {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Slot[%d]: %w", i, err)
@@ -151,6 +255,26 @@ func (r *Appointment) Validate() error {
 			return fmt.Errorf("Account[%d]: %w", i, err)
 		}
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.CancellationDate != nil {
+		if err := r.CancellationDate.Validate(); err != nil {
+			return fmt.Errorf("CancellationDate: %w", err)
+		}
+	}
+	if r.CancellationDateElement != nil {
+		if err := r.CancellationDateElement.Validate(); err != nil {
+			return fmt.Errorf("CancellationDateElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -161,11 +285,21 @@ func (r *Appointment) Validate() error {
 			return fmt.Errorf("PatientInstruction[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, appointmentBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, appointmentBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, appointmentSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, appointmentSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -179,36 +313,385 @@ func (r *Appointment) Validate() error {
 			return fmt.Errorf("Participant[%d]: %w", i, err)
 		}
 	}
+	if r.RecurrenceIdElement != nil {
+		if err := r.RecurrenceIdElement.Validate(); err != nil {
+			return fmt.Errorf("RecurrenceIdElement: %w", err)
+		}
+	}
+	if r.OccurrenceChangedElement != nil {
+		if err := r.OccurrenceChangedElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceChangedElement: %w", err)
+		}
+	}
 	for i, item := range r.RecurrenceTemplate {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RecurrenceTemplate[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "Appointment")
 }
 
-type AppointmentRecurrenceTemplateYearlyTemplate struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"`  // Unique id for inter-element referencing
-	YearInterval int     `json:"yearInterval" bson:"year_interval"` // Recurs every nth year
+func (r *Appointment) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Appointment" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Appointment', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.CancellationReason != nil {
+		r.CancellationReason.validateAll(path+".cancellationReason", issues)
+	}
+	for i, item := range r.Class {
+		item.validateAll(fmt.Sprintf("%s.class[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceCategory {
+		item.validateAll(fmt.Sprintf("%s.serviceCategory[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceType {
+		item.validateAll(fmt.Sprintf("%s.serviceType[%d]", path, i), issues)
+	}
+	for i, item := range r.Specialty {
+		item.validateAll(fmt.Sprintf("%s.specialty[%d]", path, i), issues)
+	}
+	if r.AppointmentType != nil {
+		r.AppointmentType.validateAll(path+".appointmentType", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Replaces {
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	for i, item := range r.VirtualService {
+		item.validateAll(fmt.Sprintf("%s.virtualService[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInformation {
+		item.validateAll(fmt.Sprintf("%s.supportingInformation[%d]", path, i), issues)
+	}
+	if r.PreviousAppointment != nil {
+		if target := invalidReferenceTarget(r.PreviousAppointment.Reference, r.PreviousAppointment.Type, appointmentPreviousAppointmentTargets); target != "" {
+			issues.add("value", path+".previousAppointment", fmt.Sprintf("field 'PreviousAppointment' cannot refer to %s, expected one of %v", target, appointmentPreviousAppointmentTargets))
+		}
+	}
+	if r.PreviousAppointment != nil {
+		r.PreviousAppointment.validateAll(path+".previousAppointment", issues)
+	}
+	if r.OriginatingAppointment != nil {
+		if target := invalidReferenceTarget(r.OriginatingAppointment.Reference, r.OriginatingAppointment.Type, appointmentOriginatingAppointmentTargets); target != "" {
+			issues.add("value", path+".originatingAppointment", fmt.Sprintf("field 'OriginatingAppointment' cannot refer to %s, expected one of %v", target, appointmentOriginatingAppointmentTargets))
+		}
+	}
+	if r.OriginatingAppointment != nil {
+		r.OriginatingAppointment.validateAll(path+".originatingAppointment", issues)
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			issues.add("value", path+".start", err.Error())
+		}
+	}
+	if r.StartElement != nil {
+		r.StartElement.validateAll(path+".start", issues)
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			issues.add("value", path+".end", err.Error())
+		}
+	}
+	if r.EndElement != nil {
+		r.EndElement.validateAll(path+".end", issues)
+	}
+	if r.MinutesDurationElement != nil {
+		r.MinutesDurationElement.validateAll(path+".minutesDuration", issues)
+	}
+	for i, item := range r.RequestedPeriod {
+		item.validateAll(fmt.Sprintf("%s.requestedPeriod[%d]", path, i), issues)
+	}
+	for i, item := range r.Slot {
+		if target := invalidReferenceTarget(item.Reference, item.Type, appointmentSlotTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.slot[%d]", path, i), fmt.Sprintf("field 'Slot[%d]' cannot refer to %s, expected one of %v", i, target, appointmentSlotTargets))
+		}
+	}
+	for i, item := range r.Slot {
+		item.validateAll(fmt.Sprintf("%s.slot[%d]", path, i), issues)
+	}
+	for i, item := range r.Account {
+		item.validateAll(fmt.Sprintf("%s.account[%d]", path, i), issues)
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.CancellationDate != nil {
+		if err := r.CancellationDate.Validate(); err != nil {
+			issues.add("value", path+".cancellationDate", err.Error())
+		}
+	}
+	if r.CancellationDateElement != nil {
+		r.CancellationDateElement.validateAll(path+".cancellationDate", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.PatientInstruction {
+		item.validateAll(fmt.Sprintf("%s.patientInstruction[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, appointmentBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, appointmentBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, appointmentSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, appointmentSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if len(r.Participant) < 1 {
+		issues.add("required", path+".participant", "field 'Participant' must have at least 1 elements")
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	if r.RecurrenceIdElement != nil {
+		r.RecurrenceIdElement.validateAll(path+".recurrenceId", issues)
+	}
+	if r.OccurrenceChangedElement != nil {
+		r.OccurrenceChangedElement.validateAll(path+".occurrenceChanged", issues)
+	}
+	for i, item := range r.RecurrenceTemplate {
+		item.validateAll(fmt.Sprintf("%s.recurrenceTemplate[%d]", path, i), issues)
+	}
 }
 
-func (r *AppointmentRecurrenceTemplateYearlyTemplate) Validate() error {
-	if r.YearInterval == 0 {
-		return fmt.Errorf("field 'YearInterval' is required")
+func (r *Appointment) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Appointment", &issues)
+	checkInvariants(r, "Appointment", &issues)
+	return issues
+}
+
+var appointmentInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Appointment) invariants() []invariant {
+	return appointmentInvariants
+}
+
+func (r *Appointment) UnmarshalJSON(data []byte) error {
+	type alias Appointment
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r Appointment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Appointment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Appointment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Appointment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentElementTypes = map[string]string{
+	"id":              "id",
+	"implicitRules":   "uri",
+	"language":        "code",
+	"minutesDuration": "positiveInt",
+	"recurrenceId":    "positiveInt",
+}
+
+func (r *Appointment) elementTypes() map[string]string {
+	return appointmentElementTypes
+}
+
+var appointmentElementMetadata = []ElementMetadata{
+	{Path: "Appointment.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Appointment.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Appointment.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Appointment.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Appointment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Appointment.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Appointment.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Appointment.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Appointment.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Appointment.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Appointment.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Appointment.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/appointmentstatus"}},
+	{Path: "Appointment.cancellationReason", Name: "cancellationReason", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.class", Name: "class", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.serviceCategory", Name: "serviceCategory", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.serviceType", Name: "serviceType", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Appointment.specialty", Name: "specialty", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.appointmentType", Name: "appointmentType", Field: 19, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.reason", Name: "reason", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Appointment.priority", Name: "priority", Field: 21, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.description", Name: "description", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Appointment.replaces", Name: "replaces", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Appointment.virtualService", Name: "virtualService", Field: 25, Min: 0, Max: "*", Types: []string{"VirtualServiceDetail"}},
+	{Path: "Appointment.supportingInformation", Name: "supportingInformation", Field: 26, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Appointment.previousAppointment", Name: "previousAppointment", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment"}},
+	{Path: "Appointment.originatingAppointment", Name: "originatingAppointment", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment"}},
+	{Path: "Appointment.start", Name: "start", Field: 29, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Appointment.end", Name: "end", Field: 31, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Appointment.minutesDuration", Name: "minutesDuration", Field: 33, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Appointment.requestedPeriod", Name: "requestedPeriod", Field: 35, Min: 0, Max: "*", Types: []string{"Period"}},
+	{Path: "Appointment.slot", Name: "slot", Field: 36, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Slot"}},
+	{Path: "Appointment.account", Name: "account", Field: 37, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Appointment.created", Name: "created", Field: 38, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Appointment.cancellationDate", Name: "cancellationDate", Field: 40, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Appointment.note", Name: "note", Field: 42, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Appointment.patientInstruction", Name: "patientInstruction", Field: 43, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Appointment.basedOn", Name: "basedOn", Field: 44, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Appointment.subject", Name: "subject", Field: 45, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Appointment.participant", Name: "participant", Field: 46, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Appointment.recurrenceId", Name: "recurrenceId", Field: 47, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Appointment.occurrenceChanged", Name: "occurrenceChanged", Field: 49, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate", Name: "recurrenceTemplate", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Appointment) ElementMetadata() []ElementMetadata {
+	return appointmentElementMetadata
+}
+
+func (r *Appointment) GetResourceType() string {
+	return "Appointment"
+}
+
+func (r *Appointment) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Appointment) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Appointment) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Appointment) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Appointment) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Appointment) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Appointment) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Appointment)(nil)
+
 type AppointmentParticipant struct {
-	Id       *string           `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Type     []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`         // Role of participant in the appointment
-	Period   *Period           `json:"period,omitempty" bson:"period,omitempty"`     // Participation period of the actor
-	Actor    *Reference        `json:"actor,omitempty" bson:"actor,omitempty"`       // The individual, device, location, or service participating in the appointment
-	Required *bool             `json:"required,omitempty" bson:"required,omitempty"` // The participant is required to attend (optional when false)
-	Status   string            `json:"status" bson:"status"`                         // accepted | declined | tentative | needs-action
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              []CodeableConcept   `json:"type,omitempty" bson:"type,omitempty"`                            // Role of participant in the appointment
+	Period            *Period             `json:"period,omitempty" bson:"period,omitempty"`                        // Participation period of the actor
+	Actor             *Reference          `json:"actor,omitempty" bson:"actor,omitempty"`                          // The individual, device, location, or service participating in the appointment
+	Required          *bool               `json:"required,omitempty" bson:"required,omitempty"`                    // The participant is required to attend (optional when false)
+	RequiredElement   *Element            `json:"_required,omitempty" bson:"required_element,omitempty"`           // Extensions for required
+	Status            ParticipationStatus `json:"status" bson:"status"`                                            // accepted | declined | tentative | needs-action
+	StatusElement     *Element            `json:"_status,omitempty" bson:"status_element,omitempty"`               // Extensions for status
 }
 
+var appointmentParticipantActorTargets = []string{"CareTeam", "Device", "Group", "HealthcareService", "Location", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *AppointmentParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
@@ -219,33 +702,141 @@ func (r *AppointmentParticipant) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, appointmentParticipantActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, appointmentParticipantActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.RequiredElement != nil {
+		if err := r.RequiredElement.Validate(); err != nil {
+			return fmt.Errorf("RequiredElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *AppointmentParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, appointmentParticipantActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, appointmentParticipantActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+	if r.RequiredElement != nil {
+		r.RequiredElement.validateAll(path+".required", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+}
+
+var appointmentParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AppointmentParticipant) invariants() []invariant {
+	return appointmentParticipantInvariants
+}
+
+func (r AppointmentParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AppointmentParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AppointmentParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AppointmentParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentParticipantElementMetadata = []ElementMetadata{
+	{Path: "Appointment.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Appointment.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Appointment.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Appointment.participant.type", Name: "type", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.participant.period", Name: "period", Field: 4, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Appointment.participant.actor", Name: "actor", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Appointment.participant.required", Name: "required", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.participant.status", Name: "status", Field: 8, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Appointment.participant.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/participationstatus"}},
+}
+
+func (r *AppointmentParticipant) ElementMetadata() []ElementMetadata {
+	return appointmentParticipantElementMetadata
+}
+
 type AppointmentRecurrenceTemplate struct {
-	Id                    *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	Timezone              *CodeableConcept                              `json:"timezone,omitempty" bson:"timezone,omitempty"`                             // The timezone of the occurrences
-	RecurrenceType        *CodeableConcept                              `json:"recurrenceType" bson:"recurrence_type"`                                    // The frequency of the recurrence
-	LastOccurrenceDate    *string                                       `json:"lastOccurrenceDate,omitempty" bson:"last_occurrence_date,omitempty"`       // The date when the recurrence should end
-	OccurrenceCount       *int                                          `json:"occurrenceCount,omitempty" bson:"occurrence_count,omitempty"`              // The number of planned occurrences
-	OccurrenceDate        []string                                      `json:"occurrenceDate,omitempty" bson:"occurrence_date,omitempty"`                // Specific dates for a recurring set of appointments (no template)
-	WeeklyTemplate        *AppointmentRecurrenceTemplateWeeklyTemplate  `json:"weeklyTemplate,omitempty" bson:"weekly_template,omitempty"`                // Information about weekly recurring appointments
-	MonthlyTemplate       *AppointmentRecurrenceTemplateMonthlyTemplate `json:"monthlyTemplate,omitempty" bson:"monthly_template,omitempty"`              // Information about monthly recurring appointments
-	YearlyTemplate        *AppointmentRecurrenceTemplateYearlyTemplate  `json:"yearlyTemplate,omitempty" bson:"yearly_template,omitempty"`                // Information about yearly recurring appointments
-	ExcludingDate         []string                                      `json:"excludingDate,omitempty" bson:"excluding_date,omitempty"`                  // Any dates that should be excluded from the series
-	ExcludingRecurrenceId []int                                         `json:"excludingRecurrenceId,omitempty" bson:"excluding_recurrence_id,omitempty"` // Any recurrence IDs that should be excluded from the recurrence
+	Id                           *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                                  // Unique id for inter-element referencing
+	Extension                    []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                                    // Additional content defined by implementations
+	ModifierExtension            []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                   // Extensions that cannot be ignored even if unrecognized
+	Timezone                     *CodeableConcept                              `json:"timezone,omitempty" bson:"timezone,omitempty"`                                      // The timezone of the occurrences
+	RecurrenceType               *CodeableConcept                              `json:"recurrenceType" bson:"recurrence_type"`                                             // The frequency of the recurrence
+	LastOccurrenceDate           *Date                                         `json:"lastOccurrenceDate,omitempty" bson:"last_occurrence_date,omitempty"`                // The date when the recurrence should end
+	LastOccurrenceDateElement    *Element                                      `json:"_lastOccurrenceDate,omitempty" bson:"last_occurrence_date_element,omitempty"`       // Extensions for lastOccurrenceDate
+	OccurrenceCount              *int                                          `json:"occurrenceCount,omitempty" bson:"occurrence_count,omitempty"`                       // The number of planned occurrences
+	OccurrenceCountElement       *Element                                      `json:"_occurrenceCount,omitempty" bson:"occurrence_count_element,omitempty"`              // Extensions for occurrenceCount
+	OccurrenceDate               []Date                                        `json:"occurrenceDate,omitempty" bson:"occurrence_date,omitempty"`                         // Specific dates for a recurring set of appointments (no template)
+	OccurrenceDateElement        []*Element                                    `json:"_occurrenceDate,omitempty" bson:"occurrence_date_element,omitempty"`                // Extensions for occurrenceDate
+	WeeklyTemplate               *AppointmentRecurrenceTemplateWeeklyTemplate  `json:"weeklyTemplate,omitempty" bson:"weekly_template,omitempty"`                         // Information about weekly recurring appointments
+	MonthlyTemplate              *AppointmentRecurrenceTemplateMonthlyTemplate `json:"monthlyTemplate,omitempty" bson:"monthly_template,omitempty"`                       // Information about monthly recurring appointments
+	YearlyTemplate               *AppointmentRecurrenceTemplateYearlyTemplate  `json:"yearlyTemplate,omitempty" bson:"yearly_template,omitempty"`                         // Information about yearly recurring appointments
+	ExcludingDate                []Date                                        `json:"excludingDate,omitempty" bson:"excluding_date,omitempty"`                           // Any dates that should be excluded from the series
+	ExcludingDateElement         []*Element                                    `json:"_excludingDate,omitempty" bson:"excluding_date_element,omitempty"`                  // Extensions for excludingDate
+	ExcludingRecurrenceId        []int                                         `json:"excludingRecurrenceId,omitempty" bson:"excluding_recurrence_id,omitempty"`          // Any recurrence IDs that should be excluded from the recurrence
+	ExcludingRecurrenceIdElement []*Element                                    `json:"_excludingRecurrenceId,omitempty" bson:"excluding_recurrence_id_element,omitempty"` // Extensions for excludingRecurrenceId
 }
 
 func (r *AppointmentRecurrenceTemplate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Timezone != nil {
 		if err := r.Timezone.Validate(); err != nil {
 			return fmt.Errorf("Timezone: %w", err)
@@ -259,6 +850,34 @@ func (r *AppointmentRecurrenceTemplate) Validate() error {
 			return fmt.Errorf("RecurrenceType: %w", err)
 		}
 	}
+	if r.LastOccurrenceDate != nil {
+		if err := r.LastOccurrenceDate.Validate(); err != nil {
+			return fmt.Errorf("LastOccurrenceDate: %w", err)
+		}
+	}
+	if r.LastOccurrenceDateElement != nil {
+		if err := r.LastOccurrenceDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastOccurrenceDateElement: %w", err)
+		}
+	}
+	if r.OccurrenceCountElement != nil {
+		if err := r.OccurrenceCountElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceCountElement: %w", err)
+		}
+	}
+	for i, item := range r.OccurrenceDate {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceDate[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.OccurrenceDateElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceDateElement[%d]: %w", i, err)
+		}
+	}
 	if r.WeeklyTemplate != nil {
 		if err := r.WeeklyTemplate.Validate(); err != nil {
 			return fmt.Errorf("WeeklyTemplate: %w", err)
@@ -274,34 +893,377 @@ func (r *AppointmentRecurrenceTemplate) Validate() error {
 			return fmt.Errorf("YearlyTemplate: %w", err)
 		}
 	}
+	for i, item := range r.ExcludingDate {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ExcludingDate[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ExcludingDateElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ExcludingDateElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ExcludingRecurrenceIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ExcludingRecurrenceIdElement[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
+func (r *AppointmentRecurrenceTemplate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Timezone != nil {
+		r.Timezone.validateAll(path+".timezone", issues)
+	}
+	if r.RecurrenceType == nil {
+		issues.add("required", path+".recurrenceType", "field 'RecurrenceType' is required")
+	}
+	if r.RecurrenceType != nil {
+		r.RecurrenceType.validateAll(path+".recurrenceType", issues)
+	}
+	if r.LastOccurrenceDate != nil {
+		if err := r.LastOccurrenceDate.Validate(); err != nil {
+			issues.add("value", path+".lastOccurrenceDate", err.Error())
+		}
+	}
+	if r.LastOccurrenceDateElement != nil {
+		r.LastOccurrenceDateElement.validateAll(path+".lastOccurrenceDate", issues)
+	}
+	if r.OccurrenceCountElement != nil {
+		r.OccurrenceCountElement.validateAll(path+".occurrenceCount", issues)
+	}
+	for i, item := range r.OccurrenceDate {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.occurrenceDate[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.OccurrenceDateElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.occurrenceDate[%d]", path, i), issues)
+	}
+	if r.WeeklyTemplate != nil {
+		r.WeeklyTemplate.validateAll(path+".weeklyTemplate", issues)
+	}
+	if r.MonthlyTemplate != nil {
+		r.MonthlyTemplate.validateAll(path+".monthlyTemplate", issues)
+	}
+	if r.YearlyTemplate != nil {
+		r.YearlyTemplate.validateAll(path+".yearlyTemplate", issues)
+	}
+	for i, item := range r.ExcludingDate {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.excludingDate[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.ExcludingDateElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.excludingDate[%d]", path, i), issues)
+	}
+	for i, item := range r.ExcludingRecurrenceIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.excludingRecurrenceId[%d]", path, i), issues)
+	}
+}
+
+var appointmentRecurrenceTemplateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AppointmentRecurrenceTemplate) invariants() []invariant {
+	return appointmentRecurrenceTemplateInvariants
+}
+
+func (r AppointmentRecurrenceTemplate) MarshalJSON() ([]byte, error) {
+	type alias AppointmentRecurrenceTemplate
+	out := struct {
+		alias
+		OccurrenceDate               []*Date    `json:"occurrenceDate,omitempty"`
+		OccurrenceDateElement        []*Element `json:"_occurrenceDate,omitempty"`
+		ExcludingDate                []*Date    `json:"excludingDate,omitempty"`
+		ExcludingDateElement         []*Element `json:"_excludingDate,omitempty"`
+		ExcludingRecurrenceId        []*int     `json:"excludingRecurrenceId,omitempty"`
+		ExcludingRecurrenceIdElement []*Element `json:"_excludingRecurrenceId,omitempty"`
+	}{alias: alias(r)}
+	out.OccurrenceDate, out.OccurrenceDateElement = alignPrimitiveArray(r.OccurrenceDate, r.OccurrenceDateElement)
+	out.ExcludingDate, out.ExcludingDateElement = alignPrimitiveArray(r.ExcludingDate, r.ExcludingDateElement)
+	out.ExcludingRecurrenceId, out.ExcludingRecurrenceIdElement = alignPrimitiveArray(r.ExcludingRecurrenceId, r.ExcludingRecurrenceIdElement)
+	return json.Marshal(out)
+}
+
+func (r *AppointmentRecurrenceTemplate) UnmarshalJSON(data []byte) error {
+	type alias AppointmentRecurrenceTemplate
+	aux := struct {
+		*alias
+		OccurrenceDate        []*Date `json:"occurrenceDate,omitempty"`
+		ExcludingDate         []*Date `json:"excludingDate,omitempty"`
+		ExcludingRecurrenceId []*int  `json:"excludingRecurrenceId,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.OccurrenceDate, err = splitPrimitiveArray(aux.OccurrenceDate, r.OccurrenceDateElement); err != nil {
+		return fmt.Errorf("occurrenceDate: %w", err)
+	}
+	if r.ExcludingDate, err = splitPrimitiveArray(aux.ExcludingDate, r.ExcludingDateElement); err != nil {
+		return fmt.Errorf("excludingDate: %w", err)
+	}
+	if r.ExcludingRecurrenceId, err = splitPrimitiveArray(aux.ExcludingRecurrenceId, r.ExcludingRecurrenceIdElement); err != nil {
+		return fmt.Errorf("excludingRecurrenceId: %w", err)
+	}
+	return nil
+}
+
+func (r AppointmentRecurrenceTemplate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AppointmentRecurrenceTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AppointmentRecurrenceTemplate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AppointmentRecurrenceTemplate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentRecurrenceTemplateElementTypes = map[string]string{
+	"occurrenceCount":       "positiveInt",
+	"excludingRecurrenceId": "positiveInt",
+}
+
+func (r *AppointmentRecurrenceTemplate) elementTypes() map[string]string {
+	return appointmentRecurrenceTemplateElementTypes
+}
+
+var appointmentRecurrenceTemplateElementMetadata = []ElementMetadata{
+	{Path: "Appointment.recurrenceTemplate.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Appointment.recurrenceTemplate.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Appointment.recurrenceTemplate.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Appointment.recurrenceTemplate.timezone", Name: "timezone", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.recurrenceTemplate.recurrenceType", Name: "recurrenceType", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Appointment.recurrenceTemplate.lastOccurrenceDate", Name: "lastOccurrenceDate", Field: 5, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Appointment.recurrenceTemplate.occurrenceCount", Name: "occurrenceCount", Field: 7, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Appointment.recurrenceTemplate.occurrenceDate", Name: "occurrenceDate", Field: 9, Min: 0, Max: "*", Types: []string{"date"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate", Name: "weeklyTemplate", Field: 11, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate", Name: "monthlyTemplate", Field: 12, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Appointment.recurrenceTemplate.yearlyTemplate", Name: "yearlyTemplate", Field: 13, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Appointment.recurrenceTemplate.excludingDate", Name: "excludingDate", Field: 14, Min: 0, Max: "*", Types: []string{"date"}},
+	{Path: "Appointment.recurrenceTemplate.excludingRecurrenceId", Name: "excludingRecurrenceId", Field: 16, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+}
+
+func (r *AppointmentRecurrenceTemplate) ElementMetadata() []ElementMetadata {
+	return appointmentRecurrenceTemplateElementMetadata
+}
+
 type AppointmentRecurrenceTemplateWeeklyTemplate struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Monday       *bool   `json:"monday,omitempty" bson:"monday,omitempty"`              // Recurs on Mondays
-	Tuesday      *bool   `json:"tuesday,omitempty" bson:"tuesday,omitempty"`            // Recurs on Tuesday
-	Wednesday    *bool   `json:"wednesday,omitempty" bson:"wednesday,omitempty"`        // Recurs on Wednesday
-	Thursday     *bool   `json:"thursday,omitempty" bson:"thursday,omitempty"`          // Recurs on Thursday
-	Friday       *bool   `json:"friday,omitempty" bson:"friday,omitempty"`              // Recurs on Friday
-	Saturday     *bool   `json:"saturday,omitempty" bson:"saturday,omitempty"`          // Recurs on Saturday
-	Sunday       *bool   `json:"sunday,omitempty" bson:"sunday,omitempty"`              // Recurs on Sunday
-	WeekInterval *int    `json:"weekInterval,omitempty" bson:"week_interval,omitempty"` // Recurs every nth week
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Monday              *bool       `json:"monday,omitempty" bson:"monday,omitempty"`                        // Recurs on Mondays
+	MondayElement       *Element    `json:"_monday,omitempty" bson:"monday_element,omitempty"`               // Extensions for monday
+	Tuesday             *bool       `json:"tuesday,omitempty" bson:"tuesday,omitempty"`                      // Recurs on Tuesday
+	TuesdayElement      *Element    `json:"_tuesday,omitempty" bson:"tuesday_element,omitempty"`             // Extensions for tuesday
+	Wednesday           *bool       `json:"wednesday,omitempty" bson:"wednesday,omitempty"`                  // Recurs on Wednesday
+	WednesdayElement    *Element    `json:"_wednesday,omitempty" bson:"wednesday_element,omitempty"`         // Extensions for wednesday
+	Thursday            *bool       `json:"thursday,omitempty" bson:"thursday,omitempty"`                    // Recurs on Thursday
+	ThursdayElement     *Element    `json:"_thursday,omitempty" bson:"thursday_element,omitempty"`           // Extensions for thursday
+	Friday              *bool       `json:"friday,omitempty" bson:"friday,omitempty"`                        // Recurs on Friday
+	FridayElement       *Element    `json:"_friday,omitempty" bson:"friday_element,omitempty"`               // Extensions for friday
+	Saturday            *bool       `json:"saturday,omitempty" bson:"saturday,omitempty"`                    // Recurs on Saturday
+	SaturdayElement     *Element    `json:"_saturday,omitempty" bson:"saturday_element,omitempty"`           // Extensions for saturday
+	Sunday              *bool       `json:"sunday,omitempty" bson:"sunday,omitempty"`                        // Recurs on Sunday
+	SundayElement       *Element    `json:"_sunday,omitempty" bson:"sunday_element,omitempty"`               // Extensions for sunday
+	WeekInterval        *int        `json:"weekInterval,omitempty" bson:"week_interval,omitempty"`           // Recurs every nth week
+	WeekIntervalElement *Element    `json:"_weekInterval,omitempty" bson:"week_interval_element,omitempty"`  // Extensions for weekInterval
 }
 
 func (r *AppointmentRecurrenceTemplateWeeklyTemplate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.MondayElement != nil {
+		if err := r.MondayElement.Validate(); err != nil {
+			return fmt.Errorf("MondayElement: %w", err)
+		}
+	}
+	if r.TuesdayElement != nil {
+		if err := r.TuesdayElement.Validate(); err != nil {
+			return fmt.Errorf("TuesdayElement: %w", err)
+		}
+	}
+	if r.WednesdayElement != nil {
+		if err := r.WednesdayElement.Validate(); err != nil {
+			return fmt.Errorf("WednesdayElement: %w", err)
+		}
+	}
+	if r.ThursdayElement != nil {
+		if err := r.ThursdayElement.Validate(); err != nil {
+			return fmt.Errorf("ThursdayElement: %w", err)
+		}
+	}
+	if r.FridayElement != nil {
+		if err := r.FridayElement.Validate(); err != nil {
+			return fmt.Errorf("FridayElement: %w", err)
+		}
+	}
+	if r.SaturdayElement != nil {
+		if err := r.SaturdayElement.Validate(); err != nil {
+			return fmt.Errorf("SaturdayElement: %w", err)
+		}
+	}
+	if r.SundayElement != nil {
+		if err := r.SundayElement.Validate(); err != nil {
+			return fmt.Errorf("SundayElement: %w", err)
+		}
+	}
+	if r.WeekIntervalElement != nil {
+		if err := r.WeekIntervalElement.Validate(); err != nil {
+			return fmt.Errorf("WeekIntervalElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *AppointmentRecurrenceTemplateWeeklyTemplate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.MondayElement != nil {
+		r.MondayElement.validateAll(path+".monday", issues)
+	}
+	if r.TuesdayElement != nil {
+		r.TuesdayElement.validateAll(path+".tuesday", issues)
+	}
+	if r.WednesdayElement != nil {
+		r.WednesdayElement.validateAll(path+".wednesday", issues)
+	}
+	if r.ThursdayElement != nil {
+		r.ThursdayElement.validateAll(path+".thursday", issues)
+	}
+	if r.FridayElement != nil {
+		r.FridayElement.validateAll(path+".friday", issues)
+	}
+	if r.SaturdayElement != nil {
+		r.SaturdayElement.validateAll(path+".saturday", issues)
+	}
+	if r.SundayElement != nil {
+		r.SundayElement.validateAll(path+".sunday", issues)
+	}
+	if r.WeekIntervalElement != nil {
+		r.WeekIntervalElement.validateAll(path+".weekInterval", issues)
+	}
+}
+
+var appointmentRecurrenceTemplateWeeklyTemplateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AppointmentRecurrenceTemplateWeeklyTemplate) invariants() []invariant {
+	return appointmentRecurrenceTemplateWeeklyTemplateInvariants
+}
+
+func (r AppointmentRecurrenceTemplateWeeklyTemplate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AppointmentRecurrenceTemplateWeeklyTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AppointmentRecurrenceTemplateWeeklyTemplate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AppointmentRecurrenceTemplateWeeklyTemplate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentRecurrenceTemplateWeeklyTemplateElementTypes = map[string]string{
+	"weekInterval": "positiveInt",
+}
+
+func (r *AppointmentRecurrenceTemplateWeeklyTemplate) elementTypes() map[string]string {
+	return appointmentRecurrenceTemplateWeeklyTemplateElementTypes
+}
+
+var appointmentRecurrenceTemplateWeeklyTemplateElementMetadata = []ElementMetadata{
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.monday", Name: "monday", Field: 3, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.tuesday", Name: "tuesday", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.wednesday", Name: "wednesday", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.thursday", Name: "thursday", Field: 9, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.friday", Name: "friday", Field: 11, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.saturday", Name: "saturday", Field: 13, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.sunday", Name: "sunday", Field: 15, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Appointment.recurrenceTemplate.weeklyTemplate.weekInterval", Name: "weekInterval", Field: 17, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *AppointmentRecurrenceTemplateWeeklyTemplate) ElementMetadata() []ElementMetadata {
+	return appointmentRecurrenceTemplateWeeklyTemplateElementMetadata
+}
+
 type AppointmentRecurrenceTemplateMonthlyTemplate struct {
-	Id             *string `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	DayOfMonth     *int    `json:"dayOfMonth,omitempty" bson:"day_of_month,omitempty"`          // Recurs on a specific day of the month
-	NthWeekOfMonth *Coding `json:"nthWeekOfMonth,omitempty" bson:"nth_week_of_month,omitempty"` // Indicates which week of the month the appointment should occur
-	DayOfWeek      *Coding `json:"dayOfWeek,omitempty" bson:"day_of_week,omitempty"`            // Indicates which day of the week the appointment should occur
-	MonthInterval  int     `json:"monthInterval" bson:"month_interval"`                         // Recurs every nth month
+	Id                   *string     `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored even if unrecognized
+	DayOfMonth           *int        `json:"dayOfMonth,omitempty" bson:"day_of_month,omitempty"`               // Recurs on a specific day of the month
+	DayOfMonthElement    *Element    `json:"_dayOfMonth,omitempty" bson:"day_of_month_element,omitempty"`      // Extensions for dayOfMonth
+	NthWeekOfMonth       *Coding     `json:"nthWeekOfMonth,omitempty" bson:"nth_week_of_month,omitempty"`      // Indicates which week of the month the appointment should occur
+	DayOfWeek            *Coding     `json:"dayOfWeek,omitempty" bson:"day_of_week,omitempty"`                 // Indicates which day of the week the appointment should occur
+	MonthInterval        int         `json:"monthInterval" bson:"month_interval"`                              // Recurs every nth month
+	MonthIntervalElement *Element    `json:"_monthInterval,omitempty" bson:"month_interval_element,omitempty"` // Extensions for monthInterval
 }
 
 func (r *AppointmentRecurrenceTemplateMonthlyTemplate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DayOfMonthElement != nil {
+		if err := r.DayOfMonthElement.Validate(); err != nil {
+			return fmt.Errorf("DayOfMonthElement: %w", err)
+		}
+	}
 	if r.NthWeekOfMonth != nil {
 		if err := r.NthWeekOfMonth.Validate(); err != nil {
 			return fmt.Errorf("NthWeekOfMonth: %w", err)
@@ -315,5 +1277,169 @@ func (r *AppointmentRecurrenceTemplateMonthlyTemplate) Validate() error {
 	if r.MonthInterval == 0 {
 		return fmt.Errorf("field 'MonthInterval' is required")
 	}
+	if r.MonthIntervalElement != nil {
+		if err := r.MonthIntervalElement.Validate(); err != nil {
+			return fmt.Errorf("MonthIntervalElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *AppointmentRecurrenceTemplateMonthlyTemplate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DayOfMonthElement != nil {
+		r.DayOfMonthElement.validateAll(path+".dayOfMonth", issues)
+	}
+	if r.NthWeekOfMonth != nil {
+		r.NthWeekOfMonth.validateAll(path+".nthWeekOfMonth", issues)
+	}
+	if r.DayOfWeek != nil {
+		r.DayOfWeek.validateAll(path+".dayOfWeek", issues)
+	}
+	if r.MonthInterval == 0 {
+		issues.add("required", path+".monthInterval", "field 'MonthInterval' is required")
+	}
+	if r.MonthIntervalElement != nil {
+		r.MonthIntervalElement.validateAll(path+".monthInterval", issues)
+	}
+}
+
+var appointmentRecurrenceTemplateMonthlyTemplateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AppointmentRecurrenceTemplateMonthlyTemplate) invariants() []invariant {
+	return appointmentRecurrenceTemplateMonthlyTemplateInvariants
+}
+
+func (r AppointmentRecurrenceTemplateMonthlyTemplate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AppointmentRecurrenceTemplateMonthlyTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AppointmentRecurrenceTemplateMonthlyTemplate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AppointmentRecurrenceTemplateMonthlyTemplate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentRecurrenceTemplateMonthlyTemplateElementTypes = map[string]string{
+	"dayOfMonth":    "positiveInt",
+	"monthInterval": "positiveInt",
+}
+
+func (r *AppointmentRecurrenceTemplateMonthlyTemplate) elementTypes() map[string]string {
+	return appointmentRecurrenceTemplateMonthlyTemplateElementTypes
+}
+
+var appointmentRecurrenceTemplateMonthlyTemplateElementMetadata = []ElementMetadata{
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.dayOfMonth", Name: "dayOfMonth", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.nthWeekOfMonth", Name: "nthWeekOfMonth", Field: 5, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.dayOfWeek", Name: "dayOfWeek", Field: 6, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "Appointment.recurrenceTemplate.monthlyTemplate.monthInterval", Name: "monthInterval", Field: 7, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *AppointmentRecurrenceTemplateMonthlyTemplate) ElementMetadata() []ElementMetadata {
+	return appointmentRecurrenceTemplateMonthlyTemplateElementMetadata
+}
+
+type AppointmentRecurrenceTemplateYearlyTemplate struct {
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	YearInterval        int         `json:"yearInterval" bson:"year_interval"`                               // Recurs every nth year
+	YearIntervalElement *Element    `json:"_yearInterval,omitempty" bson:"year_interval_element,omitempty"`  // Extensions for yearInterval
+}
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.YearInterval == 0 {
+		return fmt.Errorf("field 'YearInterval' is required")
+	}
+	if r.YearIntervalElement != nil {
+		if err := r.YearIntervalElement.Validate(); err != nil {
+			return fmt.Errorf("YearIntervalElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.YearInterval == 0 {
+		issues.add("required", path+".yearInterval", "field 'YearInterval' is required")
+	}
+	if r.YearIntervalElement != nil {
+		r.YearIntervalElement.validateAll(path+".yearInterval", issues)
+	}
+}
+
+var appointmentRecurrenceTemplateYearlyTemplateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) invariants() []invariant {
+	return appointmentRecurrenceTemplateYearlyTemplateInvariants
+}
+
+func (r AppointmentRecurrenceTemplateYearlyTemplate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AppointmentRecurrenceTemplateYearlyTemplate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentRecurrenceTemplateYearlyTemplateElementTypes = map[string]string{
+	"yearInterval": "positiveInt",
+}
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) elementTypes() map[string]string {
+	return appointmentRecurrenceTemplateYearlyTemplateElementTypes
+}
+
+var appointmentRecurrenceTemplateYearlyTemplateElementMetadata = []ElementMetadata{
+	{Path: "Appointment.recurrenceTemplate.yearlyTemplate.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Appointment.recurrenceTemplate.yearlyTemplate.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Appointment.recurrenceTemplate.yearlyTemplate.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Appointment.recurrenceTemplate.yearlyTemplate.yearInterval", Name: "yearInterval", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *AppointmentRecurrenceTemplateYearlyTemplate) ElementMetadata() []ElementMetadata {
+	return appointmentRecurrenceTemplateYearlyTemplateElementMetadata
+}
@@ -2,32 +2,49 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A reply to an appointment request for a patient and/or practitioner(s), such as a confirmation or rejection.
 type AppointmentResponse struct {
-	ResourceType      string            `json:"resourceType" bson:"resource_type"`                            // Type of resource
-	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                             // Logical id of this artifact
-	Meta              *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                         // Metadata about the resource
-	ImplicitRules     *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`      // A set of rules under which this content was created
-	Language          *string           `json:"language,omitempty" bson:"language,omitempty"`                 // Language of the resource content
-	Text              *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                         // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`               // Contained, inline Resources
-	Identifier        []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`             // External Ids for this item
-	Appointment       *Reference        `json:"appointment" bson:"appointment"`                               // Appointment this response relates to
-	ProposedNewTime   *bool             `json:"proposedNewTime,omitempty" bson:"proposed_new_time,omitempty"` // Indicator for a counter proposal
-	Start             *string           `json:"start,omitempty" bson:"start,omitempty"`                       // Time from appointment, or requested new start time
-	End               *string           `json:"end,omitempty" bson:"end,omitempty"`                           // Time from appointment, or requested new end time
-	ParticipantType   []CodeableConcept `json:"participantType,omitempty" bson:"participant_type,omitempty"`  // Role of participant in the appointment
-	Actor             *Reference        `json:"actor,omitempty" bson:"actor,omitempty"`                       // Person(s), Location, HealthcareService, or Device
-	ParticipantStatus string            `json:"participantStatus" bson:"participant_status"`                  // accepted | declined | tentative | needs-action | entered-in-error
-	Comment           *string           `json:"comment,omitempty" bson:"comment,omitempty"`                   // Additional comments
-	Recurring         *bool             `json:"recurring,omitempty" bson:"recurring,omitempty"`               // This response is for all occurrences in a recurring request
-	OccurrenceDate    *string           `json:"occurrenceDate,omitempty" bson:"occurrence_date,omitempty"`    // Original date within a recurring request
-	RecurrenceId      *int              `json:"recurrenceId,omitempty" bson:"recurrence_id,omitempty"`        // The recurrence ID of the specific recurring request
+	ResourceType             string                    `json:"resourceType" bson:"resource_type"`                                        // Type of resource
+	Id                       *string                   `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
+	Meta                     *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
+	ImplicitRules            *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement     *Element                  `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
+	Language                 *string                   `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement          *Element                  `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
+	Text                     *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
+	Contained                []Resource                `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension                []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
+	Identifier               []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // External Ids for this item
+	Appointment              *Reference                `json:"appointment" bson:"appointment"`                                           // Appointment this response relates to
+	ProposedNewTime          *bool                     `json:"proposedNewTime,omitempty" bson:"proposed_new_time,omitempty"`             // Indicator for a counter proposal
+	ProposedNewTimeElement   *Element                  `json:"_proposedNewTime,omitempty" bson:"proposed_new_time_element,omitempty"`    // Extensions for proposedNewTime
+	Start                    *Instant                  `json:"start,omitempty" bson:"start,omitempty"`                                   // Time from appointment, or requested new start time
+	StartElement             *Element                  `json:"_start,omitempty" bson:"start_element,omitempty"`                          // Extensions for start
+	End                      *Instant                  `json:"end,omitempty" bson:"end,omitempty"`                                       // Time from appointment, or requested new end time
+	EndElement               *Element                  `json:"_end,omitempty" bson:"end_element,omitempty"`                              // Extensions for end
+	ParticipantType          []CodeableConcept         `json:"participantType,omitempty" bson:"participant_type,omitempty"`              // Role of participant in the appointment
+	Actor                    *Reference                `json:"actor,omitempty" bson:"actor,omitempty"`                                   // Person(s), Location, HealthcareService, or Device
+	ParticipantStatus        AppointmentResponseStatus `json:"participantStatus" bson:"participant_status"`                              // accepted | declined | tentative | needs-action | entered-in-error
+	ParticipantStatusElement *Element                  `json:"_participantStatus,omitempty" bson:"participant_status_element,omitempty"` // Extensions for participantStatus
+	Comment                  *string                   `json:"comment,omitempty" bson:"comment,omitempty"`                               // Additional comments
+	CommentElement           *Element                  `json:"_comment,omitempty" bson:"comment_element,omitempty"`                      // Extensions for comment
+	Recurring                *bool                     `json:"recurring,omitempty" bson:"recurring,omitempty"`                           // This response is for all occurrences in a recurring request
+	RecurringElement         *Element                  `json:"_recurring,omitempty" bson:"recurring_element,omitempty"`                  // Extensions for recurring
+	OccurrenceDate           *Date                     `json:"occurrenceDate,omitempty" bson:"occurrence_date,omitempty"`                // Original date within a recurring request
+	OccurrenceDateElement    *Element                  `json:"_occurrenceDate,omitempty" bson:"occurrence_date_element,omitempty"`       // Extensions for occurrenceDate
+	RecurrenceId             *int                      `json:"recurrenceId,omitempty" bson:"recurrence_id,omitempty"`                    // The recurrence ID of the specific recurring request
+	RecurrenceIdElement      *Element                  `json:"_recurrenceId,omitempty" bson:"recurrence_id_element,omitempty"`           // Extensions for recurrenceId
 }
 
+var appointmentResponseAppointmentTargets = []string{"Appointment"}
+
+var appointmentResponseActorTargets = []string{"Device", "Group", "HealthcareService", "Location", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *AppointmentResponse) Validate() error {
 	if r.ResourceType != "AppointmentResponse" {
 		return fmt.Errorf("invalid resourceType: expected 'AppointmentResponse', got '%s'", r.ResourceType)
@@ -37,11 +54,39 @@ func (r *AppointmentResponse) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -50,24 +95,326 @@ func (r *AppointmentResponse) Validate() error {
 	if r.Appointment == nil {
 		return fmt.Errorf("field 'Appointment' is required")
 	}
+	if r.Appointment != nil {
+		if target := invalidReferenceTarget(r.Appointment.Reference, r.Appointment.Type, appointmentResponseAppointmentTargets); target != "" {
+			return fmt.Errorf("field 'Appointment' cannot refer to %s, expected one of %v", target, appointmentResponseAppointmentTargets)
+		}
+	}
 	if r.Appointment != nil {
 		if err := r.Appointment.Validate(); err != nil {
 			return fmt.Errorf("Appointment: %w", err)
 		}
 	}
+	if r.ProposedNewTimeElement != nil {
+		if err := r.ProposedNewTimeElement.Validate(); err != nil {
+			return fmt.Errorf("ProposedNewTimeElement: %w", err)
+		}
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			return fmt.Errorf("Start: %w", err)
+		}
+	}
+	if r.StartElement != nil {
+		if err := r.StartElement.Validate(); err != nil {
+			return fmt.Errorf("StartElement: %w", err)
+		}
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			return fmt.Errorf("End: %w", err)
+		}
+	}
+	if r.EndElement != nil {
+		if err := r.EndElement.Validate(); err != nil {
+			return fmt.Errorf("EndElement: %w", err)
+		}
+	}
 	for i, item := range r.ParticipantType {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ParticipantType[%d]: %w", i, err)
 		}
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, appointmentResponseActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, appointmentResponseActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
 		}
 	}
-	var emptyString string
-	if r.ParticipantStatus == emptyString {
+	if r.ParticipantStatus == "" {
 		return fmt.Errorf("field 'ParticipantStatus' is required")
 	}
+	if !r.ParticipantStatus.IsValid() {
+		return fmt.Errorf("field 'ParticipantStatus' has invalid code '%s'", r.ParticipantStatus)
+	}
+	if r.ParticipantStatusElement != nil {
+		if err := r.ParticipantStatusElement.Validate(); err != nil {
+			return fmt.Errorf("ParticipantStatusElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	if r.RecurringElement != nil {
+		if err := r.RecurringElement.Validate(); err != nil {
+			return fmt.Errorf("RecurringElement: %w", err)
+		}
+	}
+	if r.OccurrenceDate != nil {
+		if err := r.OccurrenceDate.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceDate: %w", err)
+		}
+	}
+	if r.OccurrenceDateElement != nil {
+		if err := r.OccurrenceDateElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceDateElement: %w", err)
+		}
+	}
+	if r.RecurrenceIdElement != nil {
+		if err := r.RecurrenceIdElement.Validate(); err != nil {
+			return fmt.Errorf("RecurrenceIdElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "AppointmentResponse")
+}
+
+func (r *AppointmentResponse) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "AppointmentResponse" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'AppointmentResponse', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Appointment == nil {
+		issues.add("required", path+".appointment", "field 'Appointment' is required")
+	}
+	if r.Appointment != nil {
+		if target := invalidReferenceTarget(r.Appointment.Reference, r.Appointment.Type, appointmentResponseAppointmentTargets); target != "" {
+			issues.add("value", path+".appointment", fmt.Sprintf("field 'Appointment' cannot refer to %s, expected one of %v", target, appointmentResponseAppointmentTargets))
+		}
+	}
+	if r.Appointment != nil {
+		r.Appointment.validateAll(path+".appointment", issues)
+	}
+	if r.ProposedNewTimeElement != nil {
+		r.ProposedNewTimeElement.validateAll(path+".proposedNewTime", issues)
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			issues.add("value", path+".start", err.Error())
+		}
+	}
+	if r.StartElement != nil {
+		r.StartElement.validateAll(path+".start", issues)
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			issues.add("value", path+".end", err.Error())
+		}
+	}
+	if r.EndElement != nil {
+		r.EndElement.validateAll(path+".end", issues)
+	}
+	for i, item := range r.ParticipantType {
+		item.validateAll(fmt.Sprintf("%s.participantType[%d]", path, i), issues)
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, appointmentResponseActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, appointmentResponseActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+	if r.ParticipantStatus == "" {
+		issues.add("required", path+".participantStatus", "field 'ParticipantStatus' is required")
+	}
+	if r.ParticipantStatus != "" && !r.ParticipantStatus.IsValid() {
+		issues.add("code-invalid", path+".participantStatus", fmt.Sprintf("field 'ParticipantStatus' has invalid code '%s'", r.ParticipantStatus))
+	}
+	if r.ParticipantStatusElement != nil {
+		r.ParticipantStatusElement.validateAll(path+".participantStatus", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.RecurringElement != nil {
+		r.RecurringElement.validateAll(path+".recurring", issues)
+	}
+	if r.OccurrenceDate != nil {
+		if err := r.OccurrenceDate.Validate(); err != nil {
+			issues.add("value", path+".occurrenceDate", err.Error())
+		}
+	}
+	if r.OccurrenceDateElement != nil {
+		r.OccurrenceDateElement.validateAll(path+".occurrenceDate", issues)
+	}
+	if r.RecurrenceIdElement != nil {
+		r.RecurrenceIdElement.validateAll(path+".recurrenceId", issues)
+	}
+}
+
+func (r *AppointmentResponse) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("AppointmentResponse", &issues)
+	checkInvariants(r, "AppointmentResponse", &issues)
+	return issues
+}
+
+var appointmentResponseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *AppointmentResponse) invariants() []invariant {
+	return appointmentResponseInvariants
+}
+
+func (r *AppointmentResponse) UnmarshalJSON(data []byte) error {
+	type alias AppointmentResponse
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r AppointmentResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AppointmentResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AppointmentResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AppointmentResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var appointmentResponseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"comment":       "markdown",
+	"recurrenceId":  "positiveInt",
+}
+
+func (r *AppointmentResponse) elementTypes() map[string]string {
+	return appointmentResponseElementTypes
+}
+
+var appointmentResponseElementMetadata = []ElementMetadata{
+	{Path: "AppointmentResponse.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "AppointmentResponse.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "AppointmentResponse.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "AppointmentResponse.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AppointmentResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "AppointmentResponse.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "AppointmentResponse.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "AppointmentResponse.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AppointmentResponse.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AppointmentResponse.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "AppointmentResponse.appointment", Name: "appointment", Field: 12, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment"}},
+	{Path: "AppointmentResponse.proposedNewTime", Name: "proposedNewTime", Field: 13, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "AppointmentResponse.start", Name: "start", Field: 15, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "AppointmentResponse.end", Name: "end", Field: 17, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "AppointmentResponse.participantType", Name: "participantType", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AppointmentResponse.actor", Name: "actor", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "AppointmentResponse.participantStatus", Name: "participantStatus", Field: 21, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AppointmentResponse.participantStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/appointmentresponse-status"}},
+	{Path: "AppointmentResponse.comment", Name: "comment", Field: 23, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "AppointmentResponse.recurring", Name: "recurring", Field: 25, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "AppointmentResponse.occurrenceDate", Name: "occurrenceDate", Field: 27, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "AppointmentResponse.recurrenceId", Name: "recurrenceId", Field: 29, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *AppointmentResponse) ElementMetadata() []ElementMetadata {
+	return appointmentResponseElementMetadata
+}
+
+func (r *AppointmentResponse) GetResourceType() string {
+	return "AppointmentResponse"
+}
+
+func (r *AppointmentResponse) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *AppointmentResponse) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *AppointmentResponse) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *AppointmentResponse) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *AppointmentResponse) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *AppointmentResponse) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *AppointmentResponse) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*AppointmentResponse)(nil)
@@ -2,32 +2,46 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This Resource provides one or more comments, classifiers or ratings about a Resource and supports attribution and rights management metadata for the added content.
 type ArtifactAssessment struct {
-	ResourceType      string                        `json:"resourceType" bson:"resource_type"`                          // Type of resource
-	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                           // Logical id of this artifact
-	Meta              *Meta                         `json:"meta,omitempty" bson:"meta,omitempty"`                       // Metadata about the resource
-	ImplicitRules     *string                       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`    // A set of rules under which this content was created
-	Language          *string                       `json:"language,omitempty" bson:"language,omitempty"`               // Language of the resource content
-	Text              *Narrative                    `json:"text,omitempty" bson:"text,omitempty"`                       // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage             `json:"contained,omitempty" bson:"contained,omitempty"`             // Contained, inline Resources
-	Identifier        []Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`           // Additional identifier for the artifact assessment
-	Title             *string                       `json:"title,omitempty" bson:"title,omitempty"`                     // A label for use in displaying and selecting the artifact assessment
-	CiteAs            *string                       `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                  // How to cite the comment or rating
-	ArtifactReference *Reference                    `json:"artifactReference" bson:"artifact_reference"`                // The artifact assessed, commented upon or rated
-	ArtifactCanonical *string                       `json:"artifactCanonical" bson:"artifact_canonical"`                // The artifact assessed, commented upon or rated
-	ArtifactUri       *string                       `json:"artifactUri" bson:"artifact_uri"`                            // The artifact assessed, commented upon or rated
-	RelatesTo         []ArtifactAssessmentRelatesTo `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`            // Relationship to other Resources
-	Date              *string                       `json:"date,omitempty" bson:"date,omitempty"`                       // Date last changed
-	Copyright         *string                       `json:"copyright,omitempty" bson:"copyright,omitempty"`             // Notice about intellectual property ownership, can include restrictions on use
-	ApprovalDate      *string                       `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`      // When the artifact assessment was approved by publisher
-	LastReviewDate    *string                       `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"` // When the artifact assessment was last reviewed by the publisher
-	Content           []ArtifactAssessmentContent   `json:"content,omitempty" bson:"content,omitempty"`                 // Comment, classifier, or rating content
-	WorkflowStatus    *string                       `json:"workflowStatus,omitempty" bson:"workflow_status,omitempty"`  // submitted | triaged | waiting-for-input | resolved-no-change | resolved-change-required | deferred | duplicate | applied | published | entered-in-error
-	Disposition       *string                       `json:"disposition,omitempty" bson:"disposition,omitempty"`         // unresolved | not-persuasive | persuasive | persuasive-with-modification | not-persuasive-with-modification
+	ResourceType          string                            `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                    *string                           `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                  *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules         *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language              *string                           `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement       *Element                          `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                  *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained             []Resource                        `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension             []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension     []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Identifier            []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the artifact assessment
+	Title                 *string                           `json:"title,omitempty" bson:"title,omitempty"`                              // A label for use in displaying and selecting the artifact assessment
+	TitleElement          *Element                          `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	CiteAs                *string                           `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                           // How to cite the comment or rating
+	CiteAsElement         *Element                          `json:"_citeAs,omitempty" bson:"cite_as_element,omitempty"`                  // Extensions for citeAs
+	Artifact              ArtifactAssessmentArtifact        `json:"-" bson:"artifact,omitempty"`                                         // The artifact assessed, commented upon or rated
+	ArtifactElement       *Element                          `json:"-" bson:"artifact_element,omitempty"`                                 // Extensions for artifact[x]
+	RelatesTo             []ArtifactAssessmentRelatesTo     `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                     // Relationship to other Resources
+	Date                  *DateTime                         `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement           *Element                          `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Copyright             *string                           `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement      *Element                          `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	ApprovalDate          *Date                             `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the artifact assessment was approved by publisher
+	ApprovalDateElement   *Element                          `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate        *Date                             `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the artifact assessment was last reviewed by the publisher
+	LastReviewDateElement *Element                          `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	Content               []ArtifactAssessmentContent       `json:"content,omitempty" bson:"content,omitempty"`                          // Comment, classifier, or rating content
+	WorkflowStatus        *ArtifactAssessmentWorkflowStatus `json:"workflowStatus,omitempty" bson:"workflow_status,omitempty"`           // submitted | triaged | waiting-for-input | resolved-no-change | resolved-change-required | deferred | duplicate | applied | published | entered-in-error
+	WorkflowStatusElement *Element                          `json:"_workflowStatus,omitempty" bson:"workflow_status_element,omitempty"`  // Extensions for workflowStatus
+	Disposition           *ArtifactAssessmentDisposition    `json:"disposition,omitempty" bson:"disposition,omitempty"`                  // unresolved | not-persuasive | persuasive | persuasive-with-modification | not-persuasive-with-modification
+	DispositionElement    *Element                          `json:"_disposition,omitempty" bson:"disposition_element,omitempty"`         // Extensions for disposition
+
+	artifactVariants []string // JSON properties of ArtifactAssessment.artifact[x] when more than one was decoded
 }
 
 func (r *ArtifactAssessment) Validate() error {
@@ -39,57 +53,762 @@ func (r *ArtifactAssessment) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.ArtifactReference == nil {
-		return fmt.Errorf("field 'ArtifactReference' is required")
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
 	}
-	if r.ArtifactReference != nil {
-		if err := r.ArtifactReference.Validate(); err != nil {
-			return fmt.Errorf("ArtifactReference: %w", err)
+	if r.CiteAsElement != nil {
+		if err := r.CiteAsElement.Validate(); err != nil {
+			return fmt.Errorf("CiteAsElement: %w", err)
 		}
 	}
-	if r.ArtifactCanonical == nil {
-		return fmt.Errorf("field 'ArtifactCanonical' is required")
+	if len(r.artifactVariants) > 1 {
+		return fmt.Errorf("field 'Artifact' must have a single type, got %v", r.artifactVariants)
 	}
-	if r.ArtifactUri == nil {
-		return fmt.Errorf("field 'ArtifactUri' is required")
+	if r.Artifact == nil {
+		return fmt.Errorf("field 'Artifact' is required")
+	}
+	if r.Artifact != nil {
+		if err := r.Artifact.Validate(); err != nil {
+			return fmt.Errorf("Artifact: %w", err)
+		}
+	}
+	if r.ArtifactElement != nil {
+		if err := r.ArtifactElement.Validate(); err != nil {
+			return fmt.Errorf("ArtifactElement: %w", err)
+		}
 	}
 	for i, item := range r.RelatesTo {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	for i, item := range r.Content {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Content[%d]: %w", i, err)
 		}
 	}
+	if r.WorkflowStatus != nil && !r.WorkflowStatus.IsValid() {
+		return fmt.Errorf("field 'WorkflowStatus' has invalid code '%s'", *r.WorkflowStatus)
+	}
+	if r.WorkflowStatusElement != nil {
+		if err := r.WorkflowStatusElement.Validate(); err != nil {
+			return fmt.Errorf("WorkflowStatusElement: %w", err)
+		}
+	}
+	if r.Disposition != nil && !r.Disposition.IsValid() {
+		return fmt.Errorf("field 'Disposition' has invalid code '%s'", *r.Disposition)
+	}
+	if r.DispositionElement != nil {
+		if err := r.DispositionElement.Validate(); err != nil {
+			return fmt.Errorf("DispositionElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "ArtifactAssessment")
+}
+
+func (r *ArtifactAssessment) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ArtifactAssessment" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ArtifactAssessment', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.CiteAsElement != nil {
+		r.CiteAsElement.validateAll(path+".citeAs", issues)
+	}
+	if len(r.artifactVariants) > 1 {
+		issues.add("structure", path+".artifact", fmt.Sprintf("field 'Artifact' must have a single type, got %v", r.artifactVariants))
+	}
+	if r.Artifact == nil {
+		issues.add("required", path+".artifact", "field 'Artifact' is required")
+	}
+	if r.Artifact != nil {
+		r.Artifact.validateAll(path+".artifact.ofType("+r.Artifact.FHIRType()+")", issues)
+	}
+	if r.ArtifactElement != nil {
+		r.ArtifactElement.validateAll(path+".artifact", issues)
+	}
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	for i, item := range r.Content {
+		item.validateAll(fmt.Sprintf("%s.content[%d]", path, i), issues)
+	}
+	if r.WorkflowStatus != nil && !r.WorkflowStatus.IsValid() {
+		issues.add("code-invalid", path+".workflowStatus", fmt.Sprintf("field 'WorkflowStatus' has invalid code '%s'", *r.WorkflowStatus))
+	}
+	if r.WorkflowStatusElement != nil {
+		r.WorkflowStatusElement.validateAll(path+".workflowStatus", issues)
+	}
+	if r.Disposition != nil && !r.Disposition.IsValid() {
+		issues.add("code-invalid", path+".disposition", fmt.Sprintf("field 'Disposition' has invalid code '%s'", *r.Disposition))
+	}
+	if r.DispositionElement != nil {
+		r.DispositionElement.validateAll(path+".disposition", issues)
+	}
+}
+
+func (r *ArtifactAssessment) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ArtifactAssessment", &issues)
+	checkInvariants(r, "ArtifactAssessment", &issues)
+	return issues
+}
+
+var artifactAssessmentInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ArtifactAssessment) invariants() []invariant {
+	return artifactAssessmentInvariants
+}
+
+func (r ArtifactAssessment) MarshalJSON() ([]byte, error) {
+	type alias ArtifactAssessment
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "artifact", r.Artifact, r.ArtifactElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ArtifactAssessment) UnmarshalJSON(data []byte) error {
+	type alias ArtifactAssessment
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Artifact, element, r.artifactVariants, err = unmarshalChoice(fields, "artifact", artifactAssessmentArtifactVariants); err != nil {
+		return err
+	}
+	r.ArtifactElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ArtifactElement); err != nil {
+			return fmt.Errorf("_artifact: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ArtifactAssessment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ArtifactAssessment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ArtifactAssessment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ArtifactAssessment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ArtifactAssessment) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Artifact":
+		return "artifact", choiceValues(artifactAssessmentArtifactVariants)
+	}
+	return "", nil
+}
+
+var artifactAssessmentElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"citeAs":        "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *ArtifactAssessment) elementTypes() map[string]string {
+	return artifactAssessmentElementTypes
+}
+
+var artifactAssessmentElementMetadata = []ElementMetadata{
+	{Path: "ArtifactAssessment.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ArtifactAssessment.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ArtifactAssessment.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ArtifactAssessment.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ArtifactAssessment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ArtifactAssessment.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ArtifactAssessment.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ArtifactAssessment.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ArtifactAssessment.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ArtifactAssessment.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ArtifactAssessment.title", Name: "title", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ArtifactAssessment.citeAs", Name: "citeAs", Field: 14, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ArtifactAssessment.artifact[x]", Name: "artifact", Field: 16, Min: 1, Max: "1", Types: []string{"Reference", "canonical", "uri"}},
+	{Path: "ArtifactAssessment.relatesTo", Name: "relatesTo", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ArtifactAssessment.date", Name: "date", Field: 19, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ArtifactAssessment.copyright", Name: "copyright", Field: 21, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ArtifactAssessment.approvalDate", Name: "approvalDate", Field: 23, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ArtifactAssessment.lastReviewDate", Name: "lastReviewDate", Field: 25, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ArtifactAssessment.content", Name: "content", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ArtifactAssessment.workflowStatus", Name: "workflowStatus", Field: 28, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ArtifactAssessment.workflowStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/artifactassessment-workflow-status"}},
+	{Path: "ArtifactAssessment.disposition", Name: "disposition", Field: 30, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ArtifactAssessment.disposition", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/artifactassessment-disposition"}},
+}
+
+func (r *ArtifactAssessment) ElementMetadata() []ElementMetadata {
+	return artifactAssessmentElementMetadata
+}
+
+// ArtifactAssessmentArtifact is the type of ArtifactAssessment.artifact[x]. It is implemented by the ArtifactAssessmentArtifact* variant types.
+type ArtifactAssessmentArtifact interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isArtifactAssessmentArtifact()
+}
+
+// ArtifactAssessmentArtifactReference is the Reference variant of ArtifactAssessmentArtifact.
+type ArtifactAssessmentArtifactReference struct {
+	Reference
+}
+
+func (v ArtifactAssessmentArtifactReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ArtifactAssessmentArtifactReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ArtifactAssessmentArtifactReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ArtifactAssessmentArtifactReference) isArtifactAssessmentArtifact() {}
+
+// ArtifactAssessmentArtifactCanonical is the canonical variant of ArtifactAssessmentArtifact.
+type ArtifactAssessmentArtifactCanonical string
+
+func (v ArtifactAssessmentArtifactCanonical) Validate() error {
+	return nil
+}
+
+func (v ArtifactAssessmentArtifactCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ArtifactAssessmentArtifactCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ArtifactAssessmentArtifactCanonical) isArtifactAssessmentArtifact() {}
+
+// ArtifactAssessmentArtifactUri is the uri variant of ArtifactAssessmentArtifact.
+type ArtifactAssessmentArtifactUri string
+
+func (v ArtifactAssessmentArtifactUri) Validate() error {
+	return nil
+}
+
+func (v ArtifactAssessmentArtifactUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ArtifactAssessmentArtifactUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ArtifactAssessmentArtifactUri) isArtifactAssessmentArtifact() {}
+
+var artifactAssessmentArtifactVariants = []ArtifactAssessmentArtifact{
+	ArtifactAssessmentArtifactReference{},
+	ArtifactAssessmentArtifactCanonical(""),
+	ArtifactAssessmentArtifactUri(""),
+}
+
+func (r *ArtifactAssessment) GetResourceType() string {
+	return "ArtifactAssessment"
+}
+
+func (r *ArtifactAssessment) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ArtifactAssessment) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ArtifactAssessment) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ArtifactAssessment) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ArtifactAssessment) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ArtifactAssessment) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ArtifactAssessment) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ArtifactAssessment)(nil)
+
+type ArtifactAssessmentRelatesTo struct {
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                  `json:"type" bson:"type"`                                                // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
+	Target            ArtifactAssessmentRelatesToTarget `json:"-" bson:"target,omitempty"`                                       // The artifact that is related to this ArtifactAssessment
+	TargetElement     *Element                          `json:"-" bson:"target_element,omitempty"`                               // Extensions for target[x]
+
+	targetVariants []string // JSON properties of ArtifactAssessment.relatesTo.target[x] when more than one was decoded
+}
+
+func (r *ArtifactAssessmentRelatesTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.targetVariants) > 1 {
+		return fmt.Errorf("field 'Target' must have a single type, got %v", r.targetVariants)
+	}
+	if r.Target == nil {
+		return fmt.Errorf("field 'Target' is required")
+	}
+	if r.Target != nil {
+		if err := r.Target.Validate(); err != nil {
+			return fmt.Errorf("Target: %w", err)
+		}
+	}
+	if r.TargetElement != nil {
+		if err := r.TargetElement.Validate(); err != nil {
+			return fmt.Errorf("TargetElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ArtifactAssessmentRelatesTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.targetVariants) > 1 {
+		issues.add("structure", path+".target", fmt.Sprintf("field 'Target' must have a single type, got %v", r.targetVariants))
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target.ofType("+r.Target.FHIRType()+")", issues)
+	}
+	if r.TargetElement != nil {
+		r.TargetElement.validateAll(path+".target", issues)
+	}
+}
+
+var artifactAssessmentRelatesToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ArtifactAssessmentRelatesTo) invariants() []invariant {
+	return artifactAssessmentRelatesToInvariants
+}
+
+func (r ArtifactAssessmentRelatesTo) MarshalJSON() ([]byte, error) {
+	type alias ArtifactAssessmentRelatesTo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "target", r.Target, r.TargetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ArtifactAssessmentRelatesTo) UnmarshalJSON(data []byte) error {
+	type alias ArtifactAssessmentRelatesTo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Target, element, r.targetVariants, err = unmarshalChoice(fields, "target", artifactAssessmentRelatesToTargetVariants); err != nil {
+		return err
+	}
+	r.TargetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetElement); err != nil {
+			return fmt.Errorf("_target: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r ArtifactAssessmentRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ArtifactAssessmentRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ArtifactAssessmentRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ArtifactAssessmentRelatesTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ArtifactAssessmentRelatesTo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Target":
+		return "target", choiceValues(artifactAssessmentRelatesToTargetVariants)
+	}
+	return "", nil
+}
+
+var artifactAssessmentRelatesToElementMetadata = []ElementMetadata{
+	{Path: "ArtifactAssessment.relatesTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ArtifactAssessment.relatesTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ArtifactAssessment.relatesTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ArtifactAssessment.relatesTo.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ArtifactAssessment.relatesTo.target[x]", Name: "target", Field: 4, Min: 1, Max: "1", Types: []string{"uri", "Attachment", "canonical", "Reference", "markdown"}},
+}
+
+func (r *ArtifactAssessmentRelatesTo) ElementMetadata() []ElementMetadata {
+	return artifactAssessmentRelatesToElementMetadata
+}
+
+// ArtifactAssessmentRelatesToTarget is the type of ArtifactAssessment.relatesTo.target[x]. It is implemented by the ArtifactAssessmentRelatesToTarget* variant types.
+type ArtifactAssessmentRelatesToTarget interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isArtifactAssessmentRelatesToTarget()
+}
+
+// ArtifactAssessmentRelatesToTargetUri is the uri variant of ArtifactAssessmentRelatesToTarget.
+type ArtifactAssessmentRelatesToTargetUri string
+
+func (v ArtifactAssessmentRelatesToTargetUri) Validate() error {
+	return nil
+}
+
+func (v ArtifactAssessmentRelatesToTargetUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ArtifactAssessmentRelatesToTargetUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ArtifactAssessmentRelatesToTargetUri) isArtifactAssessmentRelatesToTarget() {}
+
+// ArtifactAssessmentRelatesToTargetAttachment is the Attachment variant of ArtifactAssessmentRelatesToTarget.
+type ArtifactAssessmentRelatesToTargetAttachment struct {
+	Attachment
+}
+
+func (v ArtifactAssessmentRelatesToTargetAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ArtifactAssessmentRelatesToTargetAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ArtifactAssessmentRelatesToTargetAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ArtifactAssessmentRelatesToTargetAttachment) isArtifactAssessmentRelatesToTarget() {}
+
+// ArtifactAssessmentRelatesToTargetCanonical is the canonical variant of ArtifactAssessmentRelatesToTarget.
+type ArtifactAssessmentRelatesToTargetCanonical string
+
+func (v ArtifactAssessmentRelatesToTargetCanonical) Validate() error {
+	return nil
+}
+
+func (v ArtifactAssessmentRelatesToTargetCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ArtifactAssessmentRelatesToTargetCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ArtifactAssessmentRelatesToTargetCanonical) isArtifactAssessmentRelatesToTarget() {}
+
+// ArtifactAssessmentRelatesToTargetReference is the Reference variant of ArtifactAssessmentRelatesToTarget.
+type ArtifactAssessmentRelatesToTargetReference struct {
+	Reference
+}
+
+func (v ArtifactAssessmentRelatesToTargetReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ArtifactAssessmentRelatesToTargetReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ArtifactAssessmentRelatesToTargetReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ArtifactAssessmentRelatesToTargetReference) isArtifactAssessmentRelatesToTarget() {}
+
+// ArtifactAssessmentRelatesToTargetMarkdown is the markdown variant of ArtifactAssessmentRelatesToTarget.
+type ArtifactAssessmentRelatesToTargetMarkdown string
+
+func (v ArtifactAssessmentRelatesToTargetMarkdown) Validate() error {
+	return nil
+}
+
+func (v ArtifactAssessmentRelatesToTargetMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ArtifactAssessmentRelatesToTargetMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ArtifactAssessmentRelatesToTargetMarkdown) isArtifactAssessmentRelatesToTarget() {}
+
+var artifactAssessmentRelatesToTargetVariants = []ArtifactAssessmentRelatesToTarget{
+	ArtifactAssessmentRelatesToTargetUri(""),
+	ArtifactAssessmentRelatesToTargetAttachment{},
+	ArtifactAssessmentRelatesToTargetCanonical(""),
+	ArtifactAssessmentRelatesToTargetReference{},
+	ArtifactAssessmentRelatesToTargetMarkdown(""),
+}
+
 type ArtifactAssessmentContent struct {
-	Id          *string                       `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Summary     *string                       `json:"summary,omitempty" bson:"summary,omitempty"`           // Brief summary of the content
-	Type        *CodeableConcept              `json:"type,omitempty" bson:"type,omitempty"`                 // What type of content
-	Classifier  []CodeableConcept             `json:"classifier,omitempty" bson:"classifier,omitempty"`     // Rating, classifier, or assessment
-	Quantity    *Quantity                     `json:"quantity,omitempty" bson:"quantity,omitempty"`         // Quantitative rating
-	Author      []Reference                   `json:"author,omitempty" bson:"author,omitempty"`             // Who authored the content
-	Path        []string                      `json:"path,omitempty" bson:"path,omitempty"`                 // What the comment is directed to
-	RelatesTo   []ArtifactAssessmentRelatesTo `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`      // Relationship to other Resources
-	FreeToShare *bool                         `json:"freeToShare,omitempty" bson:"free_to_share,omitempty"` // Acceptable to publicly share the content
-	Component   []ArtifactAssessmentContent   `json:"component,omitempty" bson:"component,omitempty"`       // Comment, classifier, or rating content
+	Id                 *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Summary            *string                       `json:"summary,omitempty" bson:"summary,omitempty"`                      // Brief summary of the content
+	SummaryElement     *Element                      `json:"_summary,omitempty" bson:"summary_element,omitempty"`             // Extensions for summary
+	Type               *CodeableConcept              `json:"type,omitempty" bson:"type,omitempty"`                            // What type of content
+	Classifier         []CodeableConcept             `json:"classifier,omitempty" bson:"classifier,omitempty"`                // Rating, classifier, or assessment
+	Quantity           *Quantity                     `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // Quantitative rating
+	Author             []Reference                   `json:"author,omitempty" bson:"author,omitempty"`                        // Who authored the content
+	Path               []string                      `json:"path,omitempty" bson:"path,omitempty"`                            // What the comment is directed to
+	PathElement        []*Element                    `json:"_path,omitempty" bson:"path_element,omitempty"`                   // Extensions for path
+	RelatesTo          []ArtifactAssessmentRelatesTo `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                 // Relationship to other Resources
+	FreeToShare        *bool                         `json:"freeToShare,omitempty" bson:"free_to_share,omitempty"`            // Acceptable to publicly share the content
+	FreeToShareElement *Element                      `json:"_freeToShare,omitempty" bson:"free_to_share_element,omitempty"`   // Extensions for freeToShare
+	Component          []ArtifactAssessmentContent   `json:"component,omitempty" bson:"component,omitempty"`                  // Comment, classifier, or rating content
 }
 
 func (r *ArtifactAssessmentContent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SummaryElement != nil {
+		if err := r.SummaryElement.Validate(); err != nil {
+			return fmt.Errorf("SummaryElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -110,11 +829,24 @@ func (r *ArtifactAssessmentContent) Validate() error {
 			return fmt.Errorf("Author[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.PathElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PathElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.RelatesTo {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
+	if r.FreeToShareElement != nil {
+		if err := r.FreeToShareElement.Validate(); err != nil {
+			return fmt.Errorf("FreeToShareElement: %w", err)
+		}
+	}
 	for i, item := range r.Component {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Component[%d]: %w", i, err)
@@ -123,49 +855,120 @@ func (r *ArtifactAssessmentContent) Validate() error {
 	return nil
 }
 
-type ArtifactAssessmentRelatesTo struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type" bson:"type"`                          // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
-	TargetUri        *string          `json:"targetUri" bson:"target_uri"`               // The artifact that is related to this ArtifactAssessment
-	TargetAttachment *Attachment      `json:"targetAttachment" bson:"target_attachment"` // The artifact that is related to this ArtifactAssessment
-	TargetCanonical  *string          `json:"targetCanonical" bson:"target_canonical"`   // The artifact that is related to this ArtifactAssessment
-	TargetReference  *Reference       `json:"targetReference" bson:"target_reference"`   // The artifact that is related to this ArtifactAssessment
-	TargetMarkdown   *string          `json:"targetMarkdown" bson:"target_markdown"`     // The artifact that is related to this ArtifactAssessment
-}
-
-func (r *ArtifactAssessmentRelatesTo) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *ArtifactAssessmentContent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SummaryElement != nil {
+		r.SummaryElement.validateAll(path+".summary", issues)
 	}
 	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.TargetUri == nil {
-		return fmt.Errorf("field 'TargetUri' is required")
+	for i, item := range r.Classifier {
+		item.validateAll(fmt.Sprintf("%s.classifier[%d]", path, i), issues)
 	}
-	if r.TargetAttachment == nil {
-		return fmt.Errorf("field 'TargetAttachment' is required")
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
 	}
-	if r.TargetAttachment != nil {
-		if err := r.TargetAttachment.Validate(); err != nil {
-			return fmt.Errorf("TargetAttachment: %w", err)
+	for i, item := range r.PathElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.path[%d]", path, i), issues)
 	}
-	if r.TargetCanonical == nil {
-		return fmt.Errorf("field 'TargetCanonical' is required")
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
 	}
-	if r.TargetReference == nil {
-		return fmt.Errorf("field 'TargetReference' is required")
+	if r.FreeToShareElement != nil {
+		r.FreeToShareElement.validateAll(path+".freeToShare", issues)
 	}
-	if r.TargetReference != nil {
-		if err := r.TargetReference.Validate(); err != nil {
-			return fmt.Errorf("TargetReference: %w", err)
-		}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+var artifactAssessmentContentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ArtifactAssessmentContent) invariants() []invariant {
+	return artifactAssessmentContentInvariants
+}
+
+func (r ArtifactAssessmentContent) MarshalJSON() ([]byte, error) {
+	type alias ArtifactAssessmentContent
+	out := struct {
+		alias
+		Path        []*string  `json:"path,omitempty"`
+		PathElement []*Element `json:"_path,omitempty"`
+	}{alias: alias(r)}
+	out.Path, out.PathElement = alignPrimitiveArray(r.Path, r.PathElement)
+	return json.Marshal(out)
+}
+
+func (r *ArtifactAssessmentContent) UnmarshalJSON(data []byte) error {
+	type alias ArtifactAssessmentContent
+	aux := struct {
+		*alias
+		Path []*string `json:"path,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.TargetMarkdown == nil {
-		return fmt.Errorf("field 'TargetMarkdown' is required")
+	var err error
+	if r.Path, err = splitPrimitiveArray(aux.Path, r.PathElement); err != nil {
+		return fmt.Errorf("path: %w", err)
 	}
 	return nil
 }
+
+func (r ArtifactAssessmentContent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ArtifactAssessmentContent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ArtifactAssessmentContent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ArtifactAssessmentContent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var artifactAssessmentContentElementTypes = map[string]string{
+	"summary": "markdown",
+	"path":    "uri",
+}
+
+func (r *ArtifactAssessmentContent) elementTypes() map[string]string {
+	return artifactAssessmentContentElementTypes
+}
+
+var artifactAssessmentContentElementMetadata = []ElementMetadata{
+	{Path: "ArtifactAssessment.content.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ArtifactAssessment.content.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ArtifactAssessment.content.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ArtifactAssessment.content.summary", Name: "summary", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ArtifactAssessment.content.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ArtifactAssessment.content.classifier", Name: "classifier", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ArtifactAssessment.content.quantity", Name: "quantity", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ArtifactAssessment.content.author", Name: "author", Field: 8, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ArtifactAssessment.content.path", Name: "path", Field: 9, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "ArtifactAssessment.content.relatesTo", Name: "relatesTo", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ArtifactAssessment.content.freeToShare", Name: "freeToShare", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ArtifactAssessment.content.component", Name: "component", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ArtifactAssessmentContent) ElementMetadata() []ElementMetadata {
+	return artifactAssessmentContentElementMetadata
+}
@@ -1,23 +1,247 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Attachment Type: For referring to data content defined in other formats.
 type Attachment struct {
-	Id          *string  `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	ContentType *string  `json:"contentType,omitempty" bson:"content_type,omitempty"` // Mime type of the content, with charset etc.
-	Language    *string  `json:"language,omitempty" bson:"language,omitempty"`        // Human language of the content (BCP-47)
-	Data        *string  `json:"data,omitempty" bson:"data,omitempty"`                // Data inline, base64ed
-	Url         *string  `json:"url,omitempty" bson:"url,omitempty"`                  // Uri where the data can be found
-	Size        *int64   `json:"size,omitempty" bson:"size,omitempty"`                // Number of bytes of content (if url provided)
-	Hash        *string  `json:"hash,omitempty" bson:"hash,omitempty"`                // Hash of the data (sha-1, base64ed)
-	Title       *string  `json:"title,omitempty" bson:"title,omitempty"`              // Label to display in place of the data
-	Creation    *string  `json:"creation,omitempty" bson:"creation,omitempty"`        // Date attachment was first created
-	Height      *int     `json:"height,omitempty" bson:"height,omitempty"`            // Height of the image in pixels (photo/video)
-	Width       *int     `json:"width,omitempty" bson:"width,omitempty"`              // Width of the image in pixels (photo/video)
-	Frames      *int     `json:"frames,omitempty" bson:"frames,omitempty"`            // Number of frames if > 1 (photo)
-	Duration    *float64 `json:"duration,omitempty" bson:"duration,omitempty"`        // Length in seconds (audio / video)
-	Pages       *int     `json:"pages,omitempty" bson:"pages,omitempty"`              // Number of printed pages
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`               // Additional content defined by implementations
+	ContentType        *string     `json:"contentType,omitempty" bson:"content_type,omitempty"`          // Mime type of the content, with charset etc.
+	ContentTypeElement *Element    `json:"_contentType,omitempty" bson:"content_type_element,omitempty"` // Extensions for contentType
+	Language           *string     `json:"language,omitempty" bson:"language,omitempty"`                 // Human language of the content (BCP-47)
+	LanguageElement    *Element    `json:"_language,omitempty" bson:"language_element,omitempty"`        // Extensions for language
+	Data               *string     `json:"data,omitempty" bson:"data,omitempty"`                         // Data inline, base64ed
+	DataElement        *Element    `json:"_data,omitempty" bson:"data_element,omitempty"`                // Extensions for data
+	Url                *string     `json:"url,omitempty" bson:"url,omitempty"`                           // Uri where the data can be found
+	UrlElement         *Element    `json:"_url,omitempty" bson:"url_element,omitempty"`                  // Extensions for url
+	Size               *int64      `json:"size,omitempty" bson:"size,omitempty"`                         // Number of bytes of content (if url provided)
+	SizeElement        *Element    `json:"_size,omitempty" bson:"size_element,omitempty"`                // Extensions for size
+	Hash               *string     `json:"hash,omitempty" bson:"hash,omitempty"`                         // Hash of the data (sha-1, base64ed)
+	HashElement        *Element    `json:"_hash,omitempty" bson:"hash_element,omitempty"`                // Extensions for hash
+	Title              *string     `json:"title,omitempty" bson:"title,omitempty"`                       // Label to display in place of the data
+	TitleElement       *Element    `json:"_title,omitempty" bson:"title_element,omitempty"`              // Extensions for title
+	Creation           *DateTime   `json:"creation,omitempty" bson:"creation,omitempty"`                 // Date attachment was first created
+	CreationElement    *Element    `json:"_creation,omitempty" bson:"creation_element,omitempty"`        // Extensions for creation
+	Height             *int        `json:"height,omitempty" bson:"height,omitempty"`                     // Height of the image in pixels (photo/video)
+	HeightElement      *Element    `json:"_height,omitempty" bson:"height_element,omitempty"`            // Extensions for height
+	Width              *int        `json:"width,omitempty" bson:"width,omitempty"`                       // Width of the image in pixels (photo/video)
+	WidthElement       *Element    `json:"_width,omitempty" bson:"width_element,omitempty"`              // Extensions for width
+	Frames             *int        `json:"frames,omitempty" bson:"frames,omitempty"`                     // Number of frames if > 1 (photo)
+	FramesElement      *Element    `json:"_frames,omitempty" bson:"frames_element,omitempty"`            // Extensions for frames
+	Duration           *Decimal    `json:"duration,omitempty" bson:"duration,omitempty"`                 // Length in seconds (audio / video)
+	DurationElement    *Element    `json:"_duration,omitempty" bson:"duration_element,omitempty"`        // Extensions for duration
+	Pages              *int        `json:"pages,omitempty" bson:"pages,omitempty"`                       // Number of printed pages
+	PagesElement       *Element    `json:"_pages,omitempty" bson:"pages_element,omitempty"`              // Extensions for pages
 }
 
 func (r *Attachment) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.ContentTypeElement != nil {
+		if err := r.ContentTypeElement.Validate(); err != nil {
+			return fmt.Errorf("ContentTypeElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
+	if r.DataElement != nil {
+		if err := r.DataElement.Validate(); err != nil {
+			return fmt.Errorf("DataElement: %w", err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
+	if r.SizeElement != nil {
+		if err := r.SizeElement.Validate(); err != nil {
+			return fmt.Errorf("SizeElement: %w", err)
+		}
+	}
+	if r.HashElement != nil {
+		if err := r.HashElement.Validate(); err != nil {
+			return fmt.Errorf("HashElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Creation != nil {
+		if err := r.Creation.Validate(); err != nil {
+			return fmt.Errorf("Creation: %w", err)
+		}
+	}
+	if r.CreationElement != nil {
+		if err := r.CreationElement.Validate(); err != nil {
+			return fmt.Errorf("CreationElement: %w", err)
+		}
+	}
+	if r.HeightElement != nil {
+		if err := r.HeightElement.Validate(); err != nil {
+			return fmt.Errorf("HeightElement: %w", err)
+		}
+	}
+	if r.WidthElement != nil {
+		if err := r.WidthElement.Validate(); err != nil {
+			return fmt.Errorf("WidthElement: %w", err)
+		}
+	}
+	if r.FramesElement != nil {
+		if err := r.FramesElement.Validate(); err != nil {
+			return fmt.Errorf("FramesElement: %w", err)
+		}
+	}
+	if r.Duration != nil {
+		if err := r.Duration.Validate(); err != nil {
+			return fmt.Errorf("Duration: %w", err)
+		}
+	}
+	if r.DurationElement != nil {
+		if err := r.DurationElement.Validate(); err != nil {
+			return fmt.Errorf("DurationElement: %w", err)
+		}
+	}
+	if r.PagesElement != nil {
+		if err := r.PagesElement.Validate(); err != nil {
+			return fmt.Errorf("PagesElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Attachment) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.ContentTypeElement != nil {
+		r.ContentTypeElement.validateAll(path+".contentType", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.DataElement != nil {
+		r.DataElement.validateAll(path+".data", issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.SizeElement != nil {
+		r.SizeElement.validateAll(path+".size", issues)
+	}
+	if r.HashElement != nil {
+		r.HashElement.validateAll(path+".hash", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Creation != nil {
+		if err := r.Creation.Validate(); err != nil {
+			issues.add("value", path+".creation", err.Error())
+		}
+	}
+	if r.CreationElement != nil {
+		r.CreationElement.validateAll(path+".creation", issues)
+	}
+	if r.HeightElement != nil {
+		r.HeightElement.validateAll(path+".height", issues)
+	}
+	if r.WidthElement != nil {
+		r.WidthElement.validateAll(path+".width", issues)
+	}
+	if r.FramesElement != nil {
+		r.FramesElement.validateAll(path+".frames", issues)
+	}
+	if r.Duration != nil {
+		if err := r.Duration.Validate(); err != nil {
+			issues.add("value", path+".duration", err.Error())
+		}
+	}
+	if r.DurationElement != nil {
+		r.DurationElement.validateAll(path+".duration", issues)
+	}
+	if r.PagesElement != nil {
+		r.PagesElement.validateAll(path+".pages", issues)
+	}
+}
+
+func (r *Attachment) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Attachment", &issues)
+	checkInvariants(r, "Attachment", &issues)
+	return issues
+}
+
+var attachmentInvariants = []invariant{
+	{key: "att-1", severity: "error", human: "If the Attachment has data, it SHALL have a contentType", expression: "data.empty() or contentType.exists()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Attachment) invariants() []invariant {
+	return attachmentInvariants
+}
+
+func (r Attachment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Attachment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Attachment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Attachment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var attachmentElementTypes = map[string]string{
+	"contentType": "code",
+	"language":    "code",
+	"data":        "base64Binary",
+	"url":         "url",
+	"hash":        "base64Binary",
+	"height":      "positiveInt",
+	"width":       "positiveInt",
+	"frames":      "positiveInt",
+	"pages":       "positiveInt",
+}
+
+func (r *Attachment) elementTypes() map[string]string {
+	return attachmentElementTypes
+}
+
+var attachmentElementMetadata = []ElementMetadata{
+	{Path: "Attachment.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Attachment.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Attachment.contentType", Name: "contentType", Field: 2, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Attachment.contentType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/mimetypes", Description: "BCP 13 (RFCs 2045, 2046, 2047, 4288, 4289 and 2049)"}, Summary: true},
+	{Path: "Attachment.language", Name: "language", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Attachment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language."}, Summary: true},
+	{Path: "Attachment.data", Name: "data", Field: 6, Min: 0, Max: "1", Types: []string{"base64Binary"}},
+	{Path: "Attachment.url", Name: "url", Field: 8, Min: 0, Max: "1", Types: []string{"url"}, Summary: true},
+	{Path: "Attachment.size", Name: "size", Field: 10, Min: 0, Max: "1", Types: []string{"integer64"}, Summary: true},
+	{Path: "Attachment.hash", Name: "hash", Field: 12, Min: 0, Max: "1", Types: []string{"base64Binary"}, Summary: true},
+	{Path: "Attachment.title", Name: "title", Field: 14, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Attachment.creation", Name: "creation", Field: 16, Min: 0, Max: "1", Types: []string{"dateTime"}, Summary: true},
+	{Path: "Attachment.height", Name: "height", Field: 18, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Attachment.width", Name: "width", Field: 20, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Attachment.frames", Name: "frames", Field: 22, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Attachment.duration", Name: "duration", Field: 24, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Attachment.pages", Name: "pages", Field: 26, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *Attachment) ElementMetadata() []ElementMetadata {
+	return attachmentElementMetadata
+}
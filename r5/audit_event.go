@@ -2,35 +2,49 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A record of an event relevant for purposes such as operations, privacy, security, maintenance, and performance analysis.
 type AuditEvent struct {
-	ResourceType     string             `json:"resourceType" bson:"resource_type"`                              // Type of resource
-	Id               *string            `json:"id,omitempty" bson:"id,omitempty"`                               // Logical id of this artifact
-	Meta             *Meta              `json:"meta,omitempty" bson:"meta,omitempty"`                           // Metadata about the resource
-	ImplicitRules    *string            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`        // A set of rules under which this content was created
-	Language         *string            `json:"language,omitempty" bson:"language,omitempty"`                   // Language of the resource content
-	Text             *Narrative         `json:"text,omitempty" bson:"text,omitempty"`                           // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage  `json:"contained,omitempty" bson:"contained,omitempty"`                 // Contained, inline Resources
-	Type             *CodeableConcept   `json:"type" bson:"type"`                                               // High level categorization of audit event
-	Subtype          []CodeableConcept  `json:"subtype,omitempty" bson:"subtype,omitempty"`                     // Specific type of event
-	Action           *string            `json:"action,omitempty" bson:"action,omitempty"`                       // Type of action performed during the event
-	Severity         *string            `json:"severity,omitempty" bson:"severity,omitempty"`                   // emergency | alert | critical | error | warning | notice | informational | debug
-	OccurredPeriod   *Period            `json:"occurredPeriod,omitempty" bson:"occurred_period,omitempty"`      // When the activity occurred
-	OccurredDateTime *string            `json:"occurredDateTime,omitempty" bson:"occurred_date_time,omitempty"` // When the activity occurred
-	Recorded         string             `json:"recorded" bson:"recorded"`                                       // Time when the event was recorded
-	Outcome          *AuditEventOutcome `json:"outcome,omitempty" bson:"outcome,omitempty"`                     // Whether the event succeeded or failed
-	Authorization    []CodeableConcept  `json:"authorization,omitempty" bson:"authorization,omitempty"`         // Authorization related to the event
-	BasedOn          []Reference        `json:"basedOn,omitempty" bson:"based_on,omitempty"`                    // Workflow authorization within which this event occurred
-	Patient          *Reference         `json:"patient,omitempty" bson:"patient,omitempty"`                     // The patient is the subject of the data used/created/updated/deleted during the activity
-	Encounter        *Reference         `json:"encounter,omitempty" bson:"encounter,omitempty"`                 // Encounter within which this event occurred or which the event is tightly associated
-	Agent            []AuditEventAgent  `json:"agent" bson:"agent"`                                             // Actor involved in the event
-	Source           *AuditEventSource  `json:"source" bson:"source"`                                           // Audit Event Reporter
-	Entity           []AuditEventEntity `json:"entity,omitempty" bson:"entity,omitempty"`                       // Data or objects used
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Type                 *CodeableConcept    `json:"type" bson:"type"`                                                 // High level categorization of audit event
+	Subtype              []CodeableConcept   `json:"subtype,omitempty" bson:"subtype,omitempty"`                       // Specific type of event
+	Action               *string             `json:"action,omitempty" bson:"action,omitempty"`                         // Type of action performed during the event
+	ActionElement        *Element            `json:"_action,omitempty" bson:"action_element,omitempty"`                // Extensions for action
+	Severity             *AuditEventSeverity `json:"severity,omitempty" bson:"severity,omitempty"`                     // emergency | alert | critical | error | warning | notice | informational | debug
+	SeverityElement      *Element            `json:"_severity,omitempty" bson:"severity_element,omitempty"`            // Extensions for severity
+	Occurred             AuditEventOccurred  `json:"-" bson:"occurred,omitempty"`                                      // When the activity occurred
+	OccurredElement      *Element            `json:"-" bson:"occurred_element,omitempty"`                              // Extensions for occurred[x]
+	Recorded             *Instant            `json:"recorded" bson:"recorded"`                                         // Time when the event was recorded
+	RecordedElement      *Element            `json:"_recorded,omitempty" bson:"recorded_element,omitempty"`            // Extensions for recorded
+	Outcome              *AuditEventOutcome  `json:"outcome,omitempty" bson:"outcome,omitempty"`                       // Whether the event succeeded or failed
+	Authorization        []CodeableConcept   `json:"authorization,omitempty" bson:"authorization,omitempty"`           // Authorization related to the event
+	BasedOn              []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Workflow authorization within which this event occurred
+	Patient              *Reference          `json:"patient,omitempty" bson:"patient,omitempty"`                       // The patient is the subject of the data used/created/updated/deleted during the activity
+	Encounter            *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter within which this event occurred or which the event is tightly associated
+	Agent                []AuditEventAgent   `json:"agent" bson:"agent"`                                               // Actor involved in the event
+	Source               *AuditEventSource   `json:"source" bson:"source"`                                             // Audit Event Reporter
+	Entity               []AuditEventEntity  `json:"entity,omitempty" bson:"entity,omitempty"`                         // Data or objects used
+
+	occurredVariants []string // JSON properties of AuditEvent.occurred[x] when more than one was decoded
 }
 
+var auditEventPatientTargets = []string{"Patient"}
+
+var auditEventEncounterTargets = []string{"Encounter"}
+
 func (r *AuditEvent) Validate() error {
 	if r.ResourceType != "AuditEvent" {
 		return fmt.Errorf("invalid resourceType: expected 'AuditEvent', got '%s'", r.ResourceType)
@@ -40,11 +54,39 @@ func (r *AuditEvent) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -58,15 +100,45 @@ func (r *AuditEvent) Validate() error {
 			return fmt.Errorf("Subtype[%d]: %w", i, err)
 		}
 	}
-	if r.OccurredPeriod != nil {
-		if err := r.OccurredPeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurredPeriod: %w", err)
+	if r.ActionElement != nil {
+		if err := r.ActionElement.Validate(); err != nil {
+			return fmt.Errorf("ActionElement: %w", err)
+		}
+	}
+	if r.Severity != nil && !r.Severity.IsValid() {
+		return fmt.Errorf("field 'Severity' has invalid code '%s'", *r.Severity)
+	}
+	if r.SeverityElement != nil {
+		if err := r.SeverityElement.Validate(); err != nil {
+			return fmt.Errorf("SeverityElement: %w", err)
+		}
+	}
+	if len(r.occurredVariants) > 1 {
+		return fmt.Errorf("field 'Occurred' must have a single type, got %v", r.occurredVariants)
+	}
+	if r.Occurred != nil {
+		if err := r.Occurred.Validate(); err != nil {
+			return fmt.Errorf("Occurred: %w", err)
+		}
+	}
+	if r.OccurredElement != nil {
+		if err := r.OccurredElement.Validate(); err != nil {
+			return fmt.Errorf("OccurredElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Recorded == emptyString {
+	if r.Recorded == nil {
 		return fmt.Errorf("field 'Recorded' is required")
 	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			return fmt.Errorf("Recorded: %w", err)
+		}
+	}
+	if r.RecordedElement != nil {
+		if err := r.RecordedElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedElement: %w", err)
+		}
+	}
 	if r.Outcome != nil {
 		if err := r.Outcome.Validate(); err != nil {
 			return fmt.Errorf("Outcome: %w", err)
@@ -82,11 +154,21 @@ func (r *AuditEvent) Validate() error {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, auditEventPatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, auditEventPatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, auditEventEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, auditEventEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -113,16 +195,363 @@ func (r *AuditEvent) Validate() error {
 			return fmt.Errorf("Entity[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "AuditEvent")
+}
+
+func (r *AuditEvent) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "AuditEvent" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'AuditEvent', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Subtype {
+		item.validateAll(fmt.Sprintf("%s.subtype[%d]", path, i), issues)
+	}
+	if r.ActionElement != nil {
+		r.ActionElement.validateAll(path+".action", issues)
+	}
+	if r.Severity != nil && !r.Severity.IsValid() {
+		issues.add("code-invalid", path+".severity", fmt.Sprintf("field 'Severity' has invalid code '%s'", *r.Severity))
+	}
+	if r.SeverityElement != nil {
+		r.SeverityElement.validateAll(path+".severity", issues)
+	}
+	if len(r.occurredVariants) > 1 {
+		issues.add("structure", path+".occurred", fmt.Sprintf("field 'Occurred' must have a single type, got %v", r.occurredVariants))
+	}
+	if r.Occurred != nil {
+		r.Occurred.validateAll(path+".occurred.ofType("+r.Occurred.FHIRType()+")", issues)
+	}
+	if r.OccurredElement != nil {
+		r.OccurredElement.validateAll(path+".occurred", issues)
+	}
+	if r.Recorded == nil {
+		issues.add("required", path+".recorded", "field 'Recorded' is required")
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			issues.add("value", path+".recorded", err.Error())
+		}
+	}
+	if r.RecordedElement != nil {
+		r.RecordedElement.validateAll(path+".recorded", issues)
+	}
+	if r.Outcome != nil {
+		r.Outcome.validateAll(path+".outcome", issues)
+	}
+	for i, item := range r.Authorization {
+		item.validateAll(fmt.Sprintf("%s.authorization[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, auditEventPatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, auditEventPatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, auditEventEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, auditEventEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.Agent) < 1 {
+		issues.add("required", path+".agent", "field 'Agent' must have at least 1 elements")
+	}
+	for i, item := range r.Agent {
+		item.validateAll(fmt.Sprintf("%s.agent[%d]", path, i), issues)
+	}
+	if r.Source == nil {
+		issues.add("required", path+".source", "field 'Source' is required")
+	}
+	if r.Source != nil {
+		r.Source.validateAll(path+".source", issues)
+	}
+	for i, item := range r.Entity {
+		item.validateAll(fmt.Sprintf("%s.entity[%d]", path, i), issues)
+	}
+}
+
+func (r *AuditEvent) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("AuditEvent", &issues)
+	checkInvariants(r, "AuditEvent", &issues)
+	return issues
+}
+
+var auditEventInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *AuditEvent) invariants() []invariant {
+	return auditEventInvariants
+}
+
+func (r AuditEvent) MarshalJSON() ([]byte, error) {
+	type alias AuditEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurred", r.Occurred, r.OccurredElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AuditEvent) UnmarshalJSON(data []byte) error {
+	type alias AuditEvent
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurred, element, r.occurredVariants, err = unmarshalChoice(fields, "occurred", auditEventOccurredVariants); err != nil {
+		return err
+	}
+	r.OccurredElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurredElement); err != nil {
+			return fmt.Errorf("_occurred: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r AuditEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AuditEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AuditEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AuditEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AuditEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurred":
+		return "occurred", choiceValues(auditEventOccurredVariants)
+	}
+	return "", nil
+}
+
+var auditEventElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"action":        "code",
+}
+
+func (r *AuditEvent) elementTypes() map[string]string {
+	return auditEventElementTypes
+}
+
+var auditEventElementMetadata = []ElementMetadata{
+	{Path: "AuditEvent.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "AuditEvent.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "AuditEvent.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AuditEvent.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "AuditEvent.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "AuditEvent.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "AuditEvent.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AuditEvent.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.type", Name: "type", Field: 11, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.subtype", Name: "subtype", Field: 12, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.action", Name: "action", Field: 13, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "AuditEvent.severity", Name: "severity", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "AuditEvent.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/audit-event-severity"}},
+	{Path: "AuditEvent.occurred[x]", Name: "occurred", Field: 17, Min: 0, Max: "1", Types: []string{"Period", "dateTime"}},
+	{Path: "AuditEvent.recorded", Name: "recorded", Field: 19, Min: 1, Max: "1", Types: []string{"instant"}},
+	{Path: "AuditEvent.outcome", Name: "outcome", Field: 21, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "AuditEvent.authorization", Name: "authorization", Field: 22, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.basedOn", Name: "basedOn", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "AuditEvent.patient", Name: "patient", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "AuditEvent.encounter", Name: "encounter", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "AuditEvent.agent", Name: "agent", Field: 26, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "AuditEvent.source", Name: "source", Field: 27, Min: 1, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "AuditEvent.entity", Name: "entity", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *AuditEvent) ElementMetadata() []ElementMetadata {
+	return auditEventElementMetadata
+}
+
+// AuditEventOccurred is the type of AuditEvent.occurred[x]. It is implemented by the AuditEventOccurred* variant types.
+type AuditEventOccurred interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAuditEventOccurred()
+}
+
+// AuditEventOccurredPeriod is the Period variant of AuditEventOccurred.
+type AuditEventOccurredPeriod struct {
+	Period
+}
+
+func (v AuditEventOccurredPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v AuditEventOccurredPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v AuditEventOccurredPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v AuditEventOccurredPeriod) isAuditEventOccurred() {}
+
+// AuditEventOccurredDateTime is the dateTime variant of AuditEventOccurred.
+type AuditEventOccurredDateTime struct {
+	DateTime
+}
+
+func (v AuditEventOccurredDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v AuditEventOccurredDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v AuditEventOccurredDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v AuditEventOccurredDateTime) isAuditEventOccurred() {}
+
+var auditEventOccurredVariants = []AuditEventOccurred{
+	AuditEventOccurredPeriod{},
+	AuditEventOccurredDateTime{},
+}
+
+func (r *AuditEvent) GetResourceType() string {
+	return "AuditEvent"
+}
+
+func (r *AuditEvent) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *AuditEvent) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *AuditEvent) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *AuditEvent) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *AuditEvent) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *AuditEvent) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *AuditEvent) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*AuditEvent)(nil)
+
 type AuditEventOutcome struct {
-	Id     *string           `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Code   *Coding           `json:"code" bson:"code"`                         // Whether the event succeeded or failed
-	Detail []CodeableConcept `json:"detail,omitempty" bson:"detail,omitempty"` // Additional outcome detail
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *Coding           `json:"code" bson:"code"`                                                // Whether the event succeeded or failed
+	Detail            []CodeableConcept `json:"detail,omitempty" bson:"detail,omitempty"`                        // Additional outcome detail
 }
 
 func (r *AuditEventOutcome) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -139,21 +568,92 @@ func (r *AuditEventOutcome) Validate() error {
 	return nil
 }
 
+func (r *AuditEventOutcome) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var auditEventOutcomeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AuditEventOutcome) invariants() []invariant {
+	return auditEventOutcomeInvariants
+}
+
+func (r AuditEventOutcome) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AuditEventOutcome) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AuditEventOutcome) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AuditEventOutcome) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var auditEventOutcomeElementMetadata = []ElementMetadata{
+	{Path: "AuditEvent.outcome.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AuditEvent.outcome.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AuditEvent.outcome.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.outcome.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "AuditEvent.outcome.detail", Name: "detail", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *AuditEventOutcome) ElementMetadata() []ElementMetadata {
+	return auditEventOutcomeElementMetadata
+}
+
 type AuditEventAgent struct {
-	Id               *string           `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Type             *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                          // How agent participated
-	Role             []CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                          // Agent role in the event
-	Who              *Reference        `json:"who" bson:"who"`                                                // Identifier of who
-	Requestor        *bool             `json:"requestor,omitempty" bson:"requestor,omitempty"`                // Whether user is initiator
-	Location         *Reference        `json:"location,omitempty" bson:"location,omitempty"`                  // The agent location when the event occurred
-	Policy           []string          `json:"policy,omitempty" bson:"policy,omitempty"`                      // Policy that authorized the agent participation in the event
-	NetworkReference *Reference        `json:"networkReference,omitempty" bson:"network_reference,omitempty"` // This agent network location for the activity
-	NetworkUri       *string           `json:"networkUri,omitempty" bson:"network_uri,omitempty"`             // This agent network location for the activity
-	NetworkString    *string           `json:"networkString,omitempty" bson:"network_string,omitempty"`       // This agent network location for the activity
-	Authorization    []CodeableConcept `json:"authorization,omitempty" bson:"authorization,omitempty"`        // Allowable authorization for this agent
+	Id                *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                            // How agent participated
+	Role              []CodeableConcept      `json:"role,omitempty" bson:"role,omitempty"`                            // Agent role in the event
+	Who               *Reference             `json:"who" bson:"who"`                                                  // Identifier of who
+	Requestor         *bool                  `json:"requestor,omitempty" bson:"requestor,omitempty"`                  // Whether user is initiator
+	RequestorElement  *Element               `json:"_requestor,omitempty" bson:"requestor_element,omitempty"`         // Extensions for requestor
+	Location          *Reference             `json:"location,omitempty" bson:"location,omitempty"`                    // The agent location when the event occurred
+	Policy            []string               `json:"policy,omitempty" bson:"policy,omitempty"`                        // Policy that authorized the agent participation in the event
+	PolicyElement     []*Element             `json:"_policy,omitempty" bson:"policy_element,omitempty"`               // Extensions for policy
+	Network           AuditEventAgentNetwork `json:"-" bson:"network,omitempty"`                                      // This agent network location for the activity
+	NetworkElement    *Element               `json:"-" bson:"network_element,omitempty"`                              // Extensions for network[x]
+	Authorization     []CodeableConcept      `json:"authorization,omitempty" bson:"authorization,omitempty"`          // Allowable authorization for this agent
+
+	networkVariants []string // JSON properties of AuditEvent.agent.network[x] when more than one was decoded
 }
 
+var auditEventAgentWhoTargets = []string{"CareTeam", "Device", "DeviceDefinition", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *AuditEventAgent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -167,84 +667,435 @@ func (r *AuditEventAgent) Validate() error {
 	if r.Who == nil {
 		return fmt.Errorf("field 'Who' is required")
 	}
+	if r.Who != nil {
+		if target := invalidReferenceTarget(r.Who.Reference, r.Who.Type, auditEventAgentWhoTargets); target != "" {
+			return fmt.Errorf("field 'Who' cannot refer to %s, expected one of %v", target, auditEventAgentWhoTargets)
+		}
+	}
 	if r.Who != nil {
 		if err := r.Who.Validate(); err != nil {
 			return fmt.Errorf("Who: %w", err)
 		}
 	}
+	if r.RequestorElement != nil {
+		if err := r.RequestorElement.Validate(); err != nil {
+			return fmt.Errorf("RequestorElement: %w", err)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
 		}
 	}
-	if r.NetworkReference != nil {
-		if err := r.NetworkReference.Validate(); err != nil {
-			return fmt.Errorf("NetworkReference: %w", err)
+	for i, item := range r.PolicyElement {
+		if item == nil {
+			continue
 		}
-	}
-	for i, item := range r.Authorization {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Authorization[%d]: %w", i, err)
+			return fmt.Errorf("PolicyElement[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type AuditEventSource struct {
-	Id       *string           `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Site     *Reference        `json:"site,omitempty" bson:"site,omitempty"` // Logical source location within the enterprise
-	Observer *Reference        `json:"observer" bson:"observer"`             // The identity of source detecting the event
-	Type     []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"` // The type of source where event originated
-}
-
-func (r *AuditEventSource) Validate() error {
-	if r.Site != nil {
-		if err := r.Site.Validate(); err != nil {
-			return fmt.Errorf("Site: %w", err)
-		}
+	if len(r.networkVariants) > 1 {
+		return fmt.Errorf("field 'Network' must have a single type, got %v", r.networkVariants)
 	}
-	if r.Observer == nil {
-		return fmt.Errorf("field 'Observer' is required")
+	if r.Network != nil {
+		if err := r.Network.Validate(); err != nil {
+			return fmt.Errorf("Network: %w", err)
+		}
 	}
-	if r.Observer != nil {
-		if err := r.Observer.Validate(); err != nil {
-			return fmt.Errorf("Observer: %w", err)
+	if r.NetworkElement != nil {
+		if err := r.NetworkElement.Validate(); err != nil {
+			return fmt.Errorf("NetworkElement: %w", err)
 		}
 	}
-	for i, item := range r.Type {
+	for i, item := range r.Authorization {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
+			return fmt.Errorf("Authorization[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type AuditEventEntity struct {
-	Id            *string                  `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	What          *Reference               `json:"what,omitempty" bson:"what,omitempty"`                    // Specific instance of resource
-	Role          *CodeableConcept         `json:"role,omitempty" bson:"role,omitempty"`                    // What role the entity played
-	SecurityLabel []CodeableConcept        `json:"securityLabel,omitempty" bson:"security_label,omitempty"` // Security labels on the entity
-	Description   *string                  `json:"description,omitempty" bson:"description,omitempty"`      // Descriptive text
-	Query         *string                  `json:"query,omitempty" bson:"query,omitempty"`                  // Query parameters
-	Detail        []AuditEventEntityDetail `json:"detail,omitempty" bson:"detail,omitempty"`                // Additional Information about the entity
-	Agent         []AuditEventAgent        `json:"agent,omitempty" bson:"agent,omitempty"`                  // Entity is attributed to this agent
-}
-
-func (r *AuditEventEntity) Validate() error {
-	if r.What != nil {
-		if err := r.What.Validate(); err != nil {
-			return fmt.Errorf("What: %w", err)
-		}
+func (r *AuditEventAgent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	for i, item := range r.SecurityLabel {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SecurityLabel[%d]: %w", i, err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Role {
+		item.validateAll(fmt.Sprintf("%s.role[%d]", path, i), issues)
+	}
+	if r.Who == nil {
+		issues.add("required", path+".who", "field 'Who' is required")
+	}
+	if r.Who != nil {
+		if target := invalidReferenceTarget(r.Who.Reference, r.Who.Type, auditEventAgentWhoTargets); target != "" {
+			issues.add("value", path+".who", fmt.Sprintf("field 'Who' cannot refer to %s, expected one of %v", target, auditEventAgentWhoTargets))
+		}
+	}
+	if r.Who != nil {
+		r.Who.validateAll(path+".who", issues)
+	}
+	if r.RequestorElement != nil {
+		r.RequestorElement.validateAll(path+".requestor", issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.PolicyElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.policy[%d]", path, i), issues)
+	}
+	if len(r.networkVariants) > 1 {
+		issues.add("structure", path+".network", fmt.Sprintf("field 'Network' must have a single type, got %v", r.networkVariants))
+	}
+	if r.Network != nil {
+		r.Network.validateAll(path+".network.ofType("+r.Network.FHIRType()+")", issues)
+	}
+	if r.NetworkElement != nil {
+		r.NetworkElement.validateAll(path+".network", issues)
+	}
+	for i, item := range r.Authorization {
+		item.validateAll(fmt.Sprintf("%s.authorization[%d]", path, i), issues)
+	}
+}
+
+var auditEventAgentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AuditEventAgent) invariants() []invariant {
+	return auditEventAgentInvariants
+}
+
+func (r AuditEventAgent) MarshalJSON() ([]byte, error) {
+	type alias AuditEventAgent
+	out := struct {
+		alias
+		Policy        []*string  `json:"policy,omitempty"`
+		PolicyElement []*Element `json:"_policy,omitempty"`
+	}{alias: alias(r)}
+	out.Policy, out.PolicyElement = alignPrimitiveArray(r.Policy, r.PolicyElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "network", r.Network, r.NetworkElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AuditEventAgent) UnmarshalJSON(data []byte) error {
+	type alias AuditEventAgent
+	aux := struct {
+		*alias
+		Policy []*string `json:"policy,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Policy, err = splitPrimitiveArray(aux.Policy, r.PolicyElement); err != nil {
+		return fmt.Errorf("policy: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Network, element, r.networkVariants, err = unmarshalChoice(fields, "network", auditEventAgentNetworkVariants); err != nil {
+		return err
+	}
+	r.NetworkElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.NetworkElement); err != nil {
+			return fmt.Errorf("_network: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r AuditEventAgent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AuditEventAgent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AuditEventAgent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AuditEventAgent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AuditEventAgent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Network":
+		return "network", choiceValues(auditEventAgentNetworkVariants)
+	}
+	return "", nil
+}
+
+var auditEventAgentElementTypes = map[string]string{
+	"policy": "uri",
+}
+
+func (r *AuditEventAgent) elementTypes() map[string]string {
+	return auditEventAgentElementTypes
+}
+
+var auditEventAgentElementMetadata = []ElementMetadata{
+	{Path: "AuditEvent.agent.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AuditEvent.agent.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AuditEvent.agent.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.agent.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.agent.role", Name: "role", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.agent.who", Name: "who", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "AuditEvent.agent.requestor", Name: "requestor", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "AuditEvent.agent.location", Name: "location", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "AuditEvent.agent.policy", Name: "policy", Field: 9, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "AuditEvent.agent.network[x]", Name: "network", Field: 11, Min: 0, Max: "1", Types: []string{"Reference", "uri", "string"}},
+	{Path: "AuditEvent.agent.authorization", Name: "authorization", Field: 13, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *AuditEventAgent) ElementMetadata() []ElementMetadata {
+	return auditEventAgentElementMetadata
+}
+
+// AuditEventAgentNetwork is the type of AuditEvent.agent.network[x]. It is implemented by the AuditEventAgentNetwork* variant types.
+type AuditEventAgentNetwork interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAuditEventAgentNetwork()
+}
+
+// AuditEventAgentNetworkReference is the Reference variant of AuditEventAgentNetwork.
+type AuditEventAgentNetworkReference struct {
+	Reference
+}
+
+func (v AuditEventAgentNetworkReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v AuditEventAgentNetworkReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v AuditEventAgentNetworkReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v AuditEventAgentNetworkReference) isAuditEventAgentNetwork() {}
+
+// AuditEventAgentNetworkUri is the uri variant of AuditEventAgentNetwork.
+type AuditEventAgentNetworkUri string
+
+func (v AuditEventAgentNetworkUri) Validate() error {
+	return nil
+}
+
+func (v AuditEventAgentNetworkUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AuditEventAgentNetworkUri) FHIRType() string {
+	return "uri"
+}
+
+func (v AuditEventAgentNetworkUri) isAuditEventAgentNetwork() {}
+
+// AuditEventAgentNetworkString is the string variant of AuditEventAgentNetwork.
+type AuditEventAgentNetworkString string
+
+func (v AuditEventAgentNetworkString) Validate() error {
+	return nil
+}
+
+func (v AuditEventAgentNetworkString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AuditEventAgentNetworkString) FHIRType() string {
+	return "string"
+}
+
+func (v AuditEventAgentNetworkString) isAuditEventAgentNetwork() {}
+
+var auditEventAgentNetworkVariants = []AuditEventAgentNetwork{
+	AuditEventAgentNetworkReference{},
+	AuditEventAgentNetworkUri(""),
+	AuditEventAgentNetworkString(""),
+}
+
+type AuditEventSource struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Site              *Reference        `json:"site,omitempty" bson:"site,omitempty"`                            // Logical source location within the enterprise
+	Observer          *Reference        `json:"observer" bson:"observer"`                                        // The identity of source detecting the event
+	Type              []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // The type of source where event originated
+}
+
+var auditEventSourceObserverTargets = []string{"CareTeam", "Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+func (r *AuditEventSource) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Site != nil {
+		if err := r.Site.Validate(); err != nil {
+			return fmt.Errorf("Site: %w", err)
+		}
+	}
+	if r.Observer == nil {
+		return fmt.Errorf("field 'Observer' is required")
+	}
+	if r.Observer != nil {
+		if target := invalidReferenceTarget(r.Observer.Reference, r.Observer.Type, auditEventSourceObserverTargets); target != "" {
+			return fmt.Errorf("field 'Observer' cannot refer to %s, expected one of %v", target, auditEventSourceObserverTargets)
+		}
+	}
+	if r.Observer != nil {
+		if err := r.Observer.Validate(); err != nil {
+			return fmt.Errorf("Observer: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *AuditEventSource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Site != nil {
+		r.Site.validateAll(path+".site", issues)
+	}
+	if r.Observer == nil {
+		issues.add("required", path+".observer", "field 'Observer' is required")
+	}
+	if r.Observer != nil {
+		if target := invalidReferenceTarget(r.Observer.Reference, r.Observer.Type, auditEventSourceObserverTargets); target != "" {
+			issues.add("value", path+".observer", fmt.Sprintf("field 'Observer' cannot refer to %s, expected one of %v", target, auditEventSourceObserverTargets))
+		}
+	}
+	if r.Observer != nil {
+		r.Observer.validateAll(path+".observer", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+}
+
+var auditEventSourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AuditEventSource) invariants() []invariant {
+	return auditEventSourceInvariants
+}
+
+func (r AuditEventSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AuditEventSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AuditEventSource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AuditEventSource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var auditEventSourceElementMetadata = []ElementMetadata{
+	{Path: "AuditEvent.source.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AuditEvent.source.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AuditEvent.source.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.source.site", Name: "site", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "AuditEvent.source.observer", Name: "observer", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "AuditEvent.source.type", Name: "type", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *AuditEventSource) ElementMetadata() []ElementMetadata {
+	return auditEventSourceElementMetadata
+}
+
+type AuditEventEntity struct {
+	Id                 *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	What               *Reference               `json:"what,omitempty" bson:"what,omitempty"`                            // Specific instance of resource
+	Role               *CodeableConcept         `json:"role,omitempty" bson:"role,omitempty"`                            // What role the entity played
+	SecurityLabel      []CodeableConcept        `json:"securityLabel,omitempty" bson:"security_label,omitempty"`         // Security labels on the entity
+	Description        *string                  `json:"description,omitempty" bson:"description,omitempty"`              // Descriptive text
+	DescriptionElement *Element                 `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Query              *string                  `json:"query,omitempty" bson:"query,omitempty"`                          // Query parameters
+	QueryElement       *Element                 `json:"_query,omitempty" bson:"query_element,omitempty"`                 // Extensions for query
+	Detail             []AuditEventEntityDetail `json:"detail,omitempty" bson:"detail,omitempty"`                        // Additional Information about the entity
+	Agent              []AuditEventAgent        `json:"agent,omitempty" bson:"agent,omitempty"`                          // Entity is attributed to this agent
+}
+
+func (r *AuditEventEntity) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.What != nil {
+		if err := r.What.Validate(); err != nil {
+			return fmt.Errorf("What: %w", err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	for i, item := range r.SecurityLabel {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SecurityLabel[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.QueryElement != nil {
+		if err := r.QueryElement.Validate(); err != nil {
+			return fmt.Errorf("QueryElement: %w", err)
+		}
 	}
 	for i, item := range r.Detail {
 		if err := item.Validate(); err != nil {
@@ -259,23 +1110,107 @@ func (r *AuditEventEntity) Validate() error {
 	return nil
 }
 
+func (r *AuditEventEntity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.What != nil {
+		r.What.validateAll(path+".what", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	for i, item := range r.SecurityLabel {
+		item.validateAll(fmt.Sprintf("%s.securityLabel[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.QueryElement != nil {
+		r.QueryElement.validateAll(path+".query", issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+	for i, item := range r.Agent {
+		item.validateAll(fmt.Sprintf("%s.agent[%d]", path, i), issues)
+	}
+}
+
+var auditEventEntityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AuditEventEntity) invariants() []invariant {
+	return auditEventEntityInvariants
+}
+
+func (r AuditEventEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AuditEventEntity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AuditEventEntity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AuditEventEntity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var auditEventEntityElementTypes = map[string]string{
+	"query": "base64Binary",
+}
+
+func (r *AuditEventEntity) elementTypes() map[string]string {
+	return auditEventEntityElementTypes
+}
+
+var auditEventEntityElementMetadata = []ElementMetadata{
+	{Path: "AuditEvent.entity.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AuditEvent.entity.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AuditEvent.entity.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.entity.what", Name: "what", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "AuditEvent.entity.role", Name: "role", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.entity.securityLabel", Name: "securityLabel", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.entity.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AuditEvent.entity.query", Name: "query", Field: 8, Min: 0, Max: "1", Types: []string{"base64Binary"}},
+	{Path: "AuditEvent.entity.detail", Name: "detail", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "AuditEvent.entity.agent", Name: "agent", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *AuditEventEntity) ElementMetadata() []ElementMetadata {
+	return auditEventEntityElementMetadata
+}
+
 type AuditEventEntityDetail struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                   // The name of the extra detail property
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // Property value
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // Property value
-	ValueString          *string          `json:"valueString" bson:"value_string"`                    // Property value
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // Property value
-	ValueInteger         *int             `json:"valueInteger" bson:"value_integer"`                  // Property value
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // Property value
-	ValueRatio           *Ratio           `json:"valueRatio" bson:"value_ratio"`                      // Property value
-	ValueTime            *string          `json:"valueTime" bson:"value_time"`                        // Property value
-	ValueDateTime        *string          `json:"valueDateTime" bson:"value_date_time"`               // Property value
-	ValuePeriod          *Period          `json:"valuePeriod" bson:"value_period"`                    // Property value
-	ValueBase64Binary    *string          `json:"valueBase64Binary" bson:"value_base64_binary"`       // Property value
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept            `json:"type" bson:"type"`                                                // The name of the extra detail property
+	Value             AuditEventEntityDetailValue `json:"-" bson:"value,omitempty"`                                        // Property value
+	ValueElement      *Element                    `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of AuditEvent.entity.detail.value[x] when more than one was decoded
 }
 
 func (r *AuditEventEntityDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -284,63 +1219,352 @@ func (r *AuditEventEntityDetail) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	return nil
+}
+
+func (r *AuditEventEntityDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueRatio == nil {
-		return fmt.Errorf("field 'ValueRatio' is required")
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
-		}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+}
+
+var auditEventEntityDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AuditEventEntityDetail) invariants() []invariant {
+	return auditEventEntityDetailInvariants
+}
+
+func (r AuditEventEntityDetail) MarshalJSON() ([]byte, error) {
+	type alias AuditEventEntityDetail
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *AuditEventEntityDetail) UnmarshalJSON(data []byte) error {
+	type alias AuditEventEntityDetail
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", auditEventEntityDetailValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
-	if r.ValueBase64Binary == nil {
-		return fmt.Errorf("field 'ValueBase64Binary' is required")
+	return nil
+}
+
+func (r AuditEventEntityDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AuditEventEntityDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AuditEventEntityDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AuditEventEntityDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *AuditEventEntityDetail) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(auditEventEntityDetailValueVariants)
+	}
+	return "", nil
+}
+
+var auditEventEntityDetailElementMetadata = []ElementMetadata{
+	{Path: "AuditEvent.entity.detail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "AuditEvent.entity.detail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "AuditEvent.entity.detail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "AuditEvent.entity.detail.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "AuditEvent.entity.detail.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Ratio", "time", "dateTime", "Period", "base64Binary"}},
+}
+
+func (r *AuditEventEntityDetail) ElementMetadata() []ElementMetadata {
+	return auditEventEntityDetailElementMetadata
+}
+
+// AuditEventEntityDetailValue is the type of AuditEvent.entity.detail.value[x]. It is implemented by the AuditEventEntityDetailValue* variant types.
+type AuditEventEntityDetailValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isAuditEventEntityDetailValue()
+}
+
+// AuditEventEntityDetailValueQuantity is the Quantity variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueQuantity struct {
+	Quantity
+}
+
+func (v AuditEventEntityDetailValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v AuditEventEntityDetailValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v AuditEventEntityDetailValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v AuditEventEntityDetailValueQuantity) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueCodeableConcept is the CodeableConcept variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v AuditEventEntityDetailValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v AuditEventEntityDetailValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v AuditEventEntityDetailValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v AuditEventEntityDetailValueCodeableConcept) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueString is the string variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueString string
+
+func (v AuditEventEntityDetailValueString) Validate() error {
+	return nil
+}
+
+func (v AuditEventEntityDetailValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AuditEventEntityDetailValueString) FHIRType() string {
+	return "string"
+}
+
+func (v AuditEventEntityDetailValueString) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueBoolean is the boolean variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueBoolean bool
+
+func (v AuditEventEntityDetailValueBoolean) Validate() error {
+	return nil
+}
+
+func (v AuditEventEntityDetailValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AuditEventEntityDetailValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v AuditEventEntityDetailValueBoolean) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueInteger is the integer variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueInteger int
+
+func (v AuditEventEntityDetailValueInteger) Validate() error {
+	return nil
+}
+
+func (v AuditEventEntityDetailValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AuditEventEntityDetailValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v AuditEventEntityDetailValueInteger) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueRange is the Range variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueRange struct {
+	Range
+}
+
+func (v AuditEventEntityDetailValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v AuditEventEntityDetailValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v AuditEventEntityDetailValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v AuditEventEntityDetailValueRange) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueRatio is the Ratio variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueRatio struct {
+	Ratio
+}
+
+func (v AuditEventEntityDetailValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v AuditEventEntityDetailValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v AuditEventEntityDetailValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v AuditEventEntityDetailValueRatio) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueTime is the time variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueTime struct {
+	Time
+}
+
+func (v AuditEventEntityDetailValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v AuditEventEntityDetailValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v AuditEventEntityDetailValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v AuditEventEntityDetailValueTime) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueDateTime is the dateTime variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueDateTime struct {
+	DateTime
+}
+
+func (v AuditEventEntityDetailValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v AuditEventEntityDetailValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
+}
+
+func (v AuditEventEntityDetailValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v AuditEventEntityDetailValueDateTime) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValuePeriod is the Period variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValuePeriod struct {
+	Period
+}
+
+func (v AuditEventEntityDetailValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v AuditEventEntityDetailValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v AuditEventEntityDetailValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v AuditEventEntityDetailValuePeriod) isAuditEventEntityDetailValue() {}
+
+// AuditEventEntityDetailValueBase64Binary is the base64Binary variant of AuditEventEntityDetailValue.
+type AuditEventEntityDetailValueBase64Binary string
+
+func (v AuditEventEntityDetailValueBase64Binary) Validate() error {
 	return nil
 }
+
+func (v AuditEventEntityDetailValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v AuditEventEntityDetailValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v AuditEventEntityDetailValueBase64Binary) isAuditEventEntityDetailValue() {}
+
+var auditEventEntityDetailValueVariants = []AuditEventEntityDetailValue{
+	AuditEventEntityDetailValueQuantity{},
+	AuditEventEntityDetailValueCodeableConcept{},
+	AuditEventEntityDetailValueString(""),
+	AuditEventEntityDetailValueBoolean(false),
+	AuditEventEntityDetailValueInteger(0),
+	AuditEventEntityDetailValueRange{},
+	AuditEventEntityDetailValueRatio{},
+	AuditEventEntityDetailValueTime{},
+	AuditEventEntityDetailValueDateTime{},
+	AuditEventEntityDetailValuePeriod{},
+	AuditEventEntityDetailValueBase64Binary(""),
+}
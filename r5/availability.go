@@ -1,18 +1,26 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Availability Type: Availability data for an {item}, declaring what days/times are available, and any exceptions. The exceptions could be textual only, e.g. Public holidays, or could be time period specific and indicate a specific years dates.
 type Availability struct {
 	Id               *string                        `json:"id,omitempty" bson:"id,omitempty"`                               // Unique id for inter-element referencing
+	Extension        []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                 // Additional content defined by implementations
 	Period           *Period                        `json:"period,omitempty" bson:"period,omitempty"`                       // When the availability applies
 	AvailableTime    []AvailabilityAvailableTime    `json:"availableTime,omitempty" bson:"available_time,omitempty"`        // Times the {item} is available
 	NotAvailableTime []AvailabilityNotAvailableTime `json:"notAvailableTime,omitempty" bson:"not_available_time,omitempty"` // Not available during this time due to provided reason
 }
 
 func (r *Availability) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -31,25 +39,244 @@ func (r *Availability) Validate() error {
 	return nil
 }
 
+func (r *Availability) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.AvailableTime {
+		item.validateAll(fmt.Sprintf("%s.availableTime[%d]", path, i), issues)
+	}
+	for i, item := range r.NotAvailableTime {
+		item.validateAll(fmt.Sprintf("%s.notAvailableTime[%d]", path, i), issues)
+	}
+}
+
+func (r *Availability) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Availability", &issues)
+	checkInvariants(r, "Availability", &issues)
+	return issues
+}
+
+var availabilityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Availability) invariants() []invariant {
+	return availabilityInvariants
+}
+
+func (r Availability) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Availability) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Availability) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Availability) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var availabilityElementMetadata = []ElementMetadata{
+	{Path: "Availability.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Availability.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Availability.period", Name: "period", Field: 2, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+	{Path: "Availability.availableTime", Name: "availableTime", Field: 3, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "Availability.notAvailableTime", Name: "notAvailableTime", Field: 4, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+}
+
+func (r *Availability) ElementMetadata() []ElementMetadata {
+	return availabilityElementMetadata
+}
+
 type AvailabilityAvailableTime struct {
-	Id                 *string  `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
-	DaysOfWeek         []string `json:"daysOfWeek,omitempty" bson:"days_of_week,omitempty"`                 // mon | tue | wed | thu | fri | sat | sun
-	AllDay             *bool    `json:"allDay,omitempty" bson:"all_day,omitempty"`                          // Always available? i.e. 24 hour service
-	AvailableStartTime *string  `json:"availableStartTime,omitempty" bson:"available_start_time,omitempty"` // Opening time of day (ignored if allDay = true)
-	AvailableEndTime   *string  `json:"availableEndTime,omitempty" bson:"available_end_time,omitempty"`     // Closing time of day (ignored if allDay = true)
+	Id                        *string      `json:"id,omitempty" bson:"id,omitempty"`                                            // Unique id for inter-element referencing
+	Extension                 []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`                              // Additional content defined by implementations
+	DaysOfWeek                []DaysOfWeek `json:"daysOfWeek,omitempty" bson:"days_of_week,omitempty"`                          // mon | tue | wed | thu | fri | sat | sun
+	DaysOfWeekElement         []*Element   `json:"_daysOfWeek,omitempty" bson:"days_of_week_element,omitempty"`                 // Extensions for daysOfWeek
+	AllDay                    *bool        `json:"allDay,omitempty" bson:"all_day,omitempty"`                                   // Always available? i.e. 24 hour service
+	AllDayElement             *Element     `json:"_allDay,omitempty" bson:"all_day_element,omitempty"`                          // Extensions for allDay
+	AvailableStartTime        *Time        `json:"availableStartTime,omitempty" bson:"available_start_time,omitempty"`          // Opening time of day (ignored if allDay = true)
+	AvailableStartTimeElement *Element     `json:"_availableStartTime,omitempty" bson:"available_start_time_element,omitempty"` // Extensions for availableStartTime
+	AvailableEndTime          *Time        `json:"availableEndTime,omitempty" bson:"available_end_time,omitempty"`              // Closing time of day (ignored if allDay = true)
+	AvailableEndTimeElement   *Element     `json:"_availableEndTime,omitempty" bson:"available_end_time_element,omitempty"`     // Extensions for availableEndTime
 }
 
 func (r *AvailabilityAvailableTime) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DaysOfWeek {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'DaysOfWeek[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.DaysOfWeekElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DaysOfWeekElement[%d]: %w", i, err)
+		}
+	}
+	if r.AllDayElement != nil {
+		if err := r.AllDayElement.Validate(); err != nil {
+			return fmt.Errorf("AllDayElement: %w", err)
+		}
+	}
+	if r.AvailableStartTime != nil {
+		if err := r.AvailableStartTime.Validate(); err != nil {
+			return fmt.Errorf("AvailableStartTime: %w", err)
+		}
+	}
+	if r.AvailableStartTimeElement != nil {
+		if err := r.AvailableStartTimeElement.Validate(); err != nil {
+			return fmt.Errorf("AvailableStartTimeElement: %w", err)
+		}
+	}
+	if r.AvailableEndTime != nil {
+		if err := r.AvailableEndTime.Validate(); err != nil {
+			return fmt.Errorf("AvailableEndTime: %w", err)
+		}
+	}
+	if r.AvailableEndTimeElement != nil {
+		if err := r.AvailableEndTimeElement.Validate(); err != nil {
+			return fmt.Errorf("AvailableEndTimeElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *AvailabilityAvailableTime) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.DaysOfWeek {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.daysOfWeek[%d]", path, i), fmt.Sprintf("field 'DaysOfWeek[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.DaysOfWeekElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.daysOfWeek[%d]", path, i), issues)
+	}
+	if r.AllDayElement != nil {
+		r.AllDayElement.validateAll(path+".allDay", issues)
+	}
+	if r.AvailableStartTime != nil {
+		if err := r.AvailableStartTime.Validate(); err != nil {
+			issues.add("value", path+".availableStartTime", err.Error())
+		}
+	}
+	if r.AvailableStartTimeElement != nil {
+		r.AvailableStartTimeElement.validateAll(path+".availableStartTime", issues)
+	}
+	if r.AvailableEndTime != nil {
+		if err := r.AvailableEndTime.Validate(); err != nil {
+			issues.add("value", path+".availableEndTime", err.Error())
+		}
+	}
+	if r.AvailableEndTimeElement != nil {
+		r.AvailableEndTimeElement.validateAll(path+".availableEndTime", issues)
+	}
+}
+
+var availabilityAvailableTimeInvariants = []invariant{
+	{key: "av-1", severity: "error", human: "Cannot include start/end times when selecting all day availability.", expression: "allDay.exists().not() or (allDay implies availableStartTime.exists().not() and availableEndTime.exists().not())"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AvailabilityAvailableTime) invariants() []invariant {
+	return availabilityAvailableTimeInvariants
+}
+
+func (r AvailabilityAvailableTime) MarshalJSON() ([]byte, error) {
+	type alias AvailabilityAvailableTime
+	out := struct {
+		alias
+		DaysOfWeek        []*DaysOfWeek `json:"daysOfWeek,omitempty"`
+		DaysOfWeekElement []*Element    `json:"_daysOfWeek,omitempty"`
+	}{alias: alias(r)}
+	out.DaysOfWeek, out.DaysOfWeekElement = alignPrimitiveArray(r.DaysOfWeek, r.DaysOfWeekElement)
+	return json.Marshal(out)
+}
+
+func (r *AvailabilityAvailableTime) UnmarshalJSON(data []byte) error {
+	type alias AvailabilityAvailableTime
+	aux := struct {
+		*alias
+		DaysOfWeek []*DaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.DaysOfWeek, err = splitPrimitiveArray(aux.DaysOfWeek, r.DaysOfWeekElement); err != nil {
+		return fmt.Errorf("daysOfWeek: %w", err)
+	}
+	return nil
+}
+
+func (r AvailabilityAvailableTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AvailabilityAvailableTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AvailabilityAvailableTime) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AvailabilityAvailableTime) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var availabilityAvailableTimeElementMetadata = []ElementMetadata{
+	{Path: "Availability.availableTime.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Availability.availableTime.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Availability.availableTime.daysOfWeek", Name: "daysOfWeek", Field: 2, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Availability.availableTime.daysOfWeek", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/days-of-week", Description: "The purpose for which an extended contact detail should be used."}, Summary: true},
+	{Path: "Availability.availableTime.allDay", Name: "allDay", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "Availability.availableTime.availableStartTime", Name: "availableStartTime", Field: 6, Min: 0, Max: "1", Types: []string{"time"}, Summary: true},
+	{Path: "Availability.availableTime.availableEndTime", Name: "availableEndTime", Field: 8, Min: 0, Max: "1", Types: []string{"time"}, Summary: true},
+}
+
+func (r *AvailabilityAvailableTime) ElementMetadata() []ElementMetadata {
+	return availabilityAvailableTimeElementMetadata
+}
+
 type AvailabilityNotAvailableTime struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Reason presented to the user explaining why time not available
-	During      *Period `json:"during,omitempty" bson:"during,omitempty"`           // Service not available during this period
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`              // Additional content defined by implementations
+	Description        *string     `json:"description,omitempty" bson:"description,omitempty"`          // Reason presented to the user explaining why time not available
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"` // Extensions for description
+	During             *Period     `json:"during,omitempty" bson:"during,omitempty"`                    // Service not available during this period
 }
 
 func (r *AvailabilityNotAvailableTime) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.During != nil {
 		if err := r.During.Validate(); err != nil {
 			return fmt.Errorf("During: %w", err)
@@ -57,3 +284,50 @@ func (r *AvailabilityNotAvailableTime) Validate() error {
 	}
 	return nil
 }
+
+func (r *AvailabilityNotAvailableTime) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.During != nil {
+		r.During.validateAll(path+".during", issues)
+	}
+}
+
+var availabilityNotAvailableTimeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *AvailabilityNotAvailableTime) invariants() []invariant {
+	return availabilityNotAvailableTimeInvariants
+}
+
+func (r AvailabilityNotAvailableTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *AvailabilityNotAvailableTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r AvailabilityNotAvailableTime) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *AvailabilityNotAvailableTime) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var availabilityNotAvailableTimeElementMetadata = []ElementMetadata{
+	{Path: "Availability.notAvailableTime.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Availability.notAvailableTime.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Availability.notAvailableTime.description", Name: "description", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Availability.notAvailableTime.during", Name: "during", Field: 4, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+}
+
+func (r *AvailabilityNotAvailableTime) ElementMetadata() []ElementMetadata {
+	return availabilityNotAvailableTimeElementMetadata
+}
@@ -1,10 +1,77 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // BackboneElement Type: Base definition for all elements that are defined inside a resource - but not those in a data type.
 type BackboneElement struct {
-	Id *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
 }
 
 func (r *BackboneElement) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *BackboneElement) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+}
+
+func (r *BackboneElement) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("BackboneElement", &issues)
+	checkInvariants(r, "BackboneElement", &issues)
+	return issues
+}
+
+var backboneElementInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BackboneElement) invariants() []invariant {
+	return backboneElementInvariants
+}
+
+func (r BackboneElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BackboneElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BackboneElement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BackboneElement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var backboneElementElementMetadata = []ElementMetadata{
+	{Path: "BackboneElement.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BackboneElement.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BackboneElement.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+}
+
+func (r *BackboneElement) ElementMetadata() []ElementMetadata {
+	return backboneElementElementMetadata
+}
@@ -1,10 +1,77 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // BackboneType Type: Base definition for the few data types that are allowed to carry modifier extensions.
 type BackboneType struct {
-	Id *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
 }
 
 func (r *BackboneType) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *BackboneType) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+}
+
+func (r *BackboneType) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("BackboneType", &issues)
+	checkInvariants(r, "BackboneType", &issues)
+	return issues
+}
+
+var backboneTypeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BackboneType) invariants() []invariant {
+	return backboneTypeInvariants
+}
+
+func (r BackboneType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BackboneType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BackboneType) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BackboneType) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var backboneTypeElementMetadata = []ElementMetadata{
+	{Path: "BackboneType.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BackboneType.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BackboneType.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+}
+
+func (r *BackboneType) ElementMetadata() []ElementMetadata {
+	return backboneTypeElementMetadata
+}
@@ -1,5 +1,9 @@
 package models
 
+import (
+	"encoding/xml"
+)
+
 // Base Type: Base definition for all types defined in FHIR type system.
 type Base struct {
 }
@@ -7,3 +11,35 @@ type Base struct {
 func (r *Base) Validate() error {
 	return nil
 }
+
+func (r *Base) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (r *Base) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Base", &issues)
+	checkInvariants(r, "Base", &issues)
+	return issues
+}
+
+func (r Base) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Base) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Base) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Base) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var baseElementMetadata = []ElementMetadata{}
+
+func (r *Base) ElementMetadata() []ElementMetadata {
+	return baseElementMetadata
+}
@@ -2,25 +2,33 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Basic is used for handling concepts not yet defined in FHIR, narrative-only resources that don't map to an existing resource, and custom resources not appropriate for inclusion in the FHIR specification.
 type Basic struct {
-	ResourceType  string            `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string           `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Business identifier
-	Code          *CodeableConcept  `json:"code" bson:"code"`                                        // Kind of Resource
-	Subject       *Reference        `json:"subject,omitempty" bson:"subject,omitempty"`              // Identifies the focus of this resource
-	Created       *string           `json:"created,omitempty" bson:"created,omitempty"`              // When created
-	Author        *Reference        `json:"author,omitempty" bson:"author,omitempty"`                // Who created
+	ResourceType         string           `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta            `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element         `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string          `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element         `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative       `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource       `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier     `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier
+	Code                 *CodeableConcept `json:"code" bson:"code"`                                                 // Kind of Resource
+	Subject              *Reference       `json:"subject,omitempty" bson:"subject,omitempty"`                       // Identifies the focus of this resource
+	Created              *DateTime        `json:"created,omitempty" bson:"created,omitempty"`                       // When created
+	CreatedElement       *Element         `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Author               *Reference       `json:"author,omitempty" bson:"author,omitempty"`                         // Who created
 }
 
+var basicAuthorTargets = []string{"CareTeam", "Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Basic) Validate() error {
 	if r.ResourceType != "Basic" {
 		return fmt.Errorf("invalid resourceType: expected 'Basic', got '%s'", r.ResourceType)
@@ -30,11 +38,39 @@ func (r *Basic) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -53,10 +89,209 @@ func (r *Basic) Validate() error {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, basicAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author' cannot refer to %s, expected one of %v", target, basicAuthorTargets)
+		}
+	}
 	if r.Author != nil {
 		if err := r.Author.Validate(); err != nil {
 			return fmt.Errorf("Author: %w", err)
 		}
 	}
+	return validateInvariants(r, "Basic")
+}
+
+func (r *Basic) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Basic" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Basic', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, basicAuthorTargets); target != "" {
+			issues.add("value", path+".author", fmt.Sprintf("field 'Author' cannot refer to %s, expected one of %v", target, basicAuthorTargets))
+		}
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+}
+
+func (r *Basic) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Basic", &issues)
+	checkInvariants(r, "Basic", &issues)
+	return issues
+}
+
+var basicInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Basic) invariants() []invariant {
+	return basicInvariants
+}
+
+func (r *Basic) UnmarshalJSON(data []byte) error {
+	type alias Basic
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r Basic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Basic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Basic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Basic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var basicElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Basic) elementTypes() map[string]string {
+	return basicElementTypes
+}
+
+var basicElementMetadata = []ElementMetadata{
+	{Path: "Basic.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Basic.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Basic.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Basic.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Basic.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Basic.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Basic.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Basic.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Basic.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Basic.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Basic.code", Name: "code", Field: 12, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Basic.subject", Name: "subject", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Basic.created", Name: "created", Field: 14, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Basic.author", Name: "author", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *Basic) ElementMetadata() []ElementMetadata {
+	return basicElementMetadata
+}
+
+func (r *Basic) GetResourceType() string {
+	return "Basic"
+}
+
+func (r *Basic) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Basic) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Basic) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Basic) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Basic) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Basic) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Basic) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Basic)(nil)
@@ -1,19 +1,24 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // A resource that represents the data of a single raw artifact as digital content accessible in its native format.  A Binary resource can contain any content, whether text, image, pdf, zip archive, etc.
 type Binary struct {
-	ResourceType    string     `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id              *string    `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta            *Meta      `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules   *string    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language        *string    `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	ContentType     string     `json:"contentType" bson:"content_type"`                             // MimeType of the binary content
-	SecurityContext *Reference `json:"securityContext,omitempty" bson:"security_context,omitempty"` // Identifies another resource to use as proxy when enforcing access control
-	Data            *string    `json:"data,omitempty" bson:"data,omitempty"`                        // The actual content
+	ResourceType         string     `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string    `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta      `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string    `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element   `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	ContentType          string     `json:"contentType" bson:"content_type"`                                  // MimeType of the binary content
+	ContentTypeElement   *Element   `json:"_contentType,omitempty" bson:"content_type_element,omitempty"`     // Extensions for contentType
+	SecurityContext      *Reference `json:"securityContext,omitempty" bson:"security_context,omitempty"`      // Identifies another resource to use as proxy when enforcing access control
+	Data                 *string    `json:"data,omitempty" bson:"data,omitempty"`                             // The actual content
+	DataElement          *Element   `json:"_data,omitempty" bson:"data_element,omitempty"`                    // Extensions for data
 }
 
 func (r *Binary) Validate() error {
@@ -25,14 +30,140 @@ func (r *Binary) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.ContentType == emptyString {
 		return fmt.Errorf("field 'ContentType' is required")
 	}
+	if r.ContentTypeElement != nil {
+		if err := r.ContentTypeElement.Validate(); err != nil {
+			return fmt.Errorf("ContentTypeElement: %w", err)
+		}
+	}
 	if r.SecurityContext != nil {
 		if err := r.SecurityContext.Validate(); err != nil {
 			return fmt.Errorf("SecurityContext: %w", err)
 		}
 	}
-	return nil
+	if r.DataElement != nil {
+		if err := r.DataElement.Validate(); err != nil {
+			return fmt.Errorf("DataElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "Binary")
+}
+
+func (r *Binary) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Binary" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Binary', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	var emptyString string
+	if r.ContentType == emptyString {
+		issues.add("required", path+".contentType", "field 'ContentType' is required")
+	}
+	if r.ContentTypeElement != nil {
+		r.ContentTypeElement.validateAll(path+".contentType", issues)
+	}
+	if r.SecurityContext != nil {
+		r.SecurityContext.validateAll(path+".securityContext", issues)
+	}
+	if r.DataElement != nil {
+		r.DataElement.validateAll(path+".data", issues)
+	}
+}
+
+func (r *Binary) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Binary", &issues)
+	checkInvariants(r, "Binary", &issues)
+	return issues
+}
+
+func (r Binary) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Binary) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Binary) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Binary) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var binaryElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"contentType":   "code",
+	"data":          "base64Binary",
+}
+
+func (r *Binary) elementTypes() map[string]string {
+	return binaryElementTypes
+}
+
+var binaryElementMetadata = []ElementMetadata{
+	{Path: "Binary.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Binary.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Binary.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Binary.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Binary.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Binary.contentType", Name: "contentType", Field: 7, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "Binary.securityContext", Name: "securityContext", Field: 9, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Binary.data", Name: "data", Field: 10, Min: 0, Max: "1", Types: []string{"base64Binary"}},
+}
+
+func (r *Binary) ElementMetadata() []ElementMetadata {
+	return binaryElementMetadata
+}
+
+func (r *Binary) GetResourceType() string {
+	return "Binary"
+}
+
+func (r *Binary) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
 }
+
+func (r *Binary) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Binary) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Binary) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+var _ Resource = (*Binary)(nil)
@@ -0,0 +1,610 @@
+package models
+
+var bindingDefinitions = []BindingDefinition{
+	{Path: "Account.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Account.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/account-status"},
+	{Path: "ActivityDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ActivityDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ActivityDefinition.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"},
+	{Path: "ActivityDefinition.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "ActivityDefinition.participant.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"},
+	{Path: "ActorDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ActorDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ActorDefinition.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/actordefinition-actor-type"},
+	{Path: "AdministrableProductDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "AdministrableProductDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "AdverseEvent.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "AdverseEvent.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/devicealert-status"},
+	{Path: "AdverseEvent.actuality", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-actuality"},
+	{Path: "AllergyIntolerance.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "AllergyIntolerance.category", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/allergy-intolerance-category"},
+	{Path: "AllergyIntolerance.criticality", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/allergy-intolerance-criticality"},
+	{Path: "AllergyIntolerance.reaction.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/reaction-event-severity"},
+	{Path: "Appointment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Appointment.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/appointmentstatus"},
+	{Path: "Appointment.participant.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/participationstatus"},
+	{Path: "AppointmentResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "AppointmentResponse.participantStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/appointmentresponse-status"},
+	{Path: "ArtifactAssessment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ArtifactAssessment.workflowStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/artifactassessment-workflow-status"},
+	{Path: "ArtifactAssessment.disposition", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/artifactassessment-disposition"},
+	{Path: "AuditEvent.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "AuditEvent.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/audit-event-severity"},
+	{Path: "Basic.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Binary.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "BiologicallyDerivedProduct.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "BodyStructure.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Bundle.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Bundle.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/bundle-type"},
+	{Path: "Bundle.entry.search.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-entry-mode"},
+	{Path: "Bundle.entry.request.method", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/http-verb"},
+	{Path: "CapabilityStatement.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CapabilityStatement.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "CapabilityStatement.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/capability-statement-kind"},
+	{Path: "CapabilityStatement.rest.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/restful-capability-mode"},
+	{Path: "CapabilityStatement.rest.resource.interaction.code", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/interaction-trigger"},
+	{Path: "CapabilityStatement.rest.resource.versioning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/versioning-policy"},
+	{Path: "CapabilityStatement.rest.resource.conditionalRead", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conditional-read-status"},
+	{Path: "CapabilityStatement.rest.resource.conditionalDelete", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conditional-delete-status"},
+	{Path: "CapabilityStatement.rest.resource.referencePolicy", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/reference-handling-policy"},
+	{Path: "CapabilityStatement.rest.resource.searchParam.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-param-type"},
+	{Path: "CapabilityStatement.messaging.supportedMessage.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/event-capability-mode"},
+	{Path: "CapabilityStatement.document.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/document-mode"},
+	{Path: "CarePlan.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CarePlan.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"},
+	{Path: "CareTeam.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CareTeam.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-team-status"},
+	{Path: "Claim.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Claim.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "Claim.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-use"},
+	{Path: "ClaimResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ClaimResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "ClaimResponse.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-use"},
+	{Path: "ClaimResponse.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-outcome"},
+	{Path: "ClinicalUseDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ClinicalUseDefinition.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/clinical-use-definition-type"},
+	{Path: "CodeSystem.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CodeSystem.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "CodeSystem.hierarchyMeaning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/codesystem-hierarchy-meaning"},
+	{Path: "CodeSystem.content", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/codesystem-content-mode"},
+	{Path: "CodeSystem.filter.operator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/filter-operator"},
+	{Path: "CodeSystem.property.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-property-type"},
+	{Path: "Communication.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Communication.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-status"},
+	{Path: "Communication.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "CommunicationRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CommunicationRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"},
+	{Path: "CommunicationRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"},
+	{Path: "CommunicationRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "CompartmentDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CompartmentDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "CompartmentDefinition.code", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/compartment-type"},
+	{Path: "Composition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Composition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/composition-status"},
+	{Path: "ConceptMap.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ConceptMap.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ConceptMap.property.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-property-type"},
+	{Path: "ConceptMap.additionalAttribute.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-attribute-type"},
+	{Path: "ConceptMap.group.element.target.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/concept-map-relationship"},
+	{Path: "ConceptMap.group.unmapped.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-unmapped-mode"},
+	{Path: "ConceptMap.group.unmapped.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/concept-map-relationship"},
+	{Path: "Condition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Consent.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Consent.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/consent-state-codes"},
+	{Path: "Consent.decision", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/consent-provision-type"},
+	{Path: "Consent.provision.data.meaning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/consent-data-meaning"},
+	{Path: "Contract.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Contract.contentDefinition.publicationStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/contract-publicationstatus"},
+	{Path: "CoverageEligibilityRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CoverageEligibilityRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "CoverageEligibilityRequest.purpose", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/eligibilityrequest-purpose"},
+	{Path: "CoverageEligibilityResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "CoverageEligibilityResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "CoverageEligibilityResponse.purpose", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/eligibilityrequest-purpose"},
+	{Path: "CoverageEligibilityResponse.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-outcome"},
+	{Path: "DetectedIssue.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DetectedIssue.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/detectedissue-status"},
+	{Path: "Device.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Device.udiCarrier.entryType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/udi-entry-type"},
+	{Path: "Device.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/deviceassociation-status"},
+	{Path: "DeviceAlert.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DeviceAlert.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/devicealert-status"},
+	{Path: "DeviceAssociation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DeviceAssociation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/deviceassociation-status"},
+	{Path: "DeviceDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DeviceDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "DeviceDefinition.regulatoryIdentifier.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/devicedefinition-regulatory-identifier-type"},
+	{Path: "DeviceDefinition.correctiveAction.scope", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/device-correctiveactionscope"},
+	{Path: "DeviceMetric.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DeviceMetric.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/metric-status"},
+	{Path: "DeviceMetric.operationalStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/metric-operational-status"},
+	{Path: "DeviceMetric.calibration.state", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/metric-calibration-state"},
+	{Path: "DeviceRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DeviceRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"},
+	{Path: "DeviceRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"},
+	{Path: "DeviceRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "DiagnosticReport.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DiagnosticReport.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/diagnostic-report-status"},
+	{Path: "DocumentReference.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "DocumentReference.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/document-reference-status", Description: "The status of the document reference."},
+	{Path: "DocumentReference.docStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/composition-status", Description: "Status of the underlying document."},
+	{Path: "DocumentReference.modality", Strength: "extensible", ValueSet: "http://dicom.nema.org/medical/dicom/current/output/chtml/part16/sect_CID_33.html", Description: "Type of acquired data in the instance."},
+	{Path: "DocumentReference.type", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/doc-typecodes", Description: "Precise type of clinical document."},
+	{Path: "DocumentReference.category", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/referenced-item-category", Description: "High-level kind of document at a macro level."},
+	{Path: "DocumentReference.facilityType", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/c80-facilitycodes", Description: "XDS Facility Type."},
+	{Path: "DocumentReference.practiceSetting", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/c80-practice-codes", Description: "Additional details about where the content was created (e.g. clinical specialty)."},
+	{Path: "DocumentReference.attester.mode", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/composition-attestation-mode", Description: "The way in which a person authenticated a document."},
+	{Path: "DocumentReference.relatesTo.code", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/document-relationship-type", Description: "The type of relationship between the documents."},
+	{Path: "DocumentReference.securityLabel", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/security-label-example", Description: "Example Security Labels from the Healthcare Privacy and Security Classification System."},
+	{Path: "DocumentReference.content.profile.value[x]", Strength: "preferred", ValueSet: "http://terminology.hl7.org/ValueSet/v3-HL7FormatCodes", Description: "Document Format Codes."},
+	{Path: "Encounter.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Encounter.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/encounter-status"},
+	{Path: "Encounter.location.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/encounter-location-status"},
+	{Path: "Endpoint.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Endpoint.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/endpoint-status"},
+	{Path: "EnrollmentRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "EnrollmentRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "EnrollmentResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "EnrollmentResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "EnrollmentResponse.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/enrollment-outcome"},
+	{Path: "EpisodeOfCare.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "EpisodeOfCare.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/episode-of-care-status"},
+	{Path: "EpisodeOfCare.statusHistory.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/episode-of-care-status"},
+	{Path: "EventDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "EventDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Evidence.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Evidence.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Evidence.variableDefinition.variableRole", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/variable-role"},
+	{Path: "EvidenceVariable.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "EvidenceVariable.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ExampleScenario.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ExampleScenario.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ExampleScenario.actor.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/actordefinition-actor-type"},
+	{Path: "ExplanationOfBenefit.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ExplanationOfBenefit.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "ExplanationOfBenefit.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-use"},
+	{Path: "ExplanationOfBenefit.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-outcome"},
+	{Path: "FamilyMemberHistory.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "FamilyMemberHistory.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/history-status"},
+	{Path: "Flag.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Flag.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/flag-status"},
+	{Path: "Goal.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Goal.lifecycleStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/goal-status"},
+	{Path: "Goal.acceptance.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/goal-accept-status"},
+	{Path: "Group.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Group.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Group.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/group-type"},
+	{Path: "Group.membership", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/group-membership-basis"},
+	{Path: "Group.combinationMethod", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/group-characteristic-combination"},
+	{Path: "GuidanceResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "GuidanceResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/guidance-response-status"},
+	{Path: "HealthcareService.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ImagingSelection.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ImagingSelection.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingselection-status"},
+	{Path: "ImagingSelection.instance.imageRegion2D.regionType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingselection-2dgraphictype"},
+	{Path: "ImagingSelection.imageRegion3D.regionType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingselection-3dgraphictype"},
+	{Path: "ImagingStudy.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ImagingStudy.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingstudy-status"},
+	{Path: "Immunization.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Immunization.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/immunization-status"},
+	{Path: "ImplementationGuide.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ImplementationGuide.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ImplementationGuide.definition.page.generation", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/guide-page-generation"},
+	{Path: "Ingredient.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Ingredient.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Ingredient.manufacturer.role", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/ingredient-manufacturer-role"},
+	{Path: "InsurancePlan.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "InsuranceProduct.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "InsuranceProduct.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Invoice.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Invoice.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/invoice-status"},
+	{Path: "Library.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Library.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "List.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "List.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/list-status"},
+	{Path: "List.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/list-mode"},
+	{Path: "Location.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Location.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/location-status"},
+	{Path: "Location.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/location-mode"},
+	{Path: "ManufacturedItemDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ManufacturedItemDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Measure.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Measure.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "MeasureReport.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MeasureReport.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/measure-report-status"},
+	{Path: "MeasureReport.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/measure-report-type"},
+	{Path: "MeasureReport.dataUpdateType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/submit-data-update-type"},
+	{Path: "Medication.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Medication.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medication-status"},
+	{Path: "MedicationAdministration.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MedicationAdministration.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medication-admin-status"},
+	{Path: "MedicationDispense.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MedicationDispense.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medicationdispense-status"},
+	{Path: "MedicationRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MedicationRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medicationrequest-status"},
+	{Path: "MedicationRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medicationrequest-intent"},
+	{Path: "MedicationRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "MedicationStatement.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MedicationStatement.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medication-statement-status"},
+	{Path: "MedicinalProductDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MessageDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MessageDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "MessageDefinition.category", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/message-significance-category"},
+	{Path: "MessageDefinition.responseRequired", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/messageheader-response-request"},
+	{Path: "MessageHeader.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "MessageHeader.response.code", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/response-code"},
+	{Path: "NamingSystem.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "NamingSystem.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "NamingSystem.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/namingsystem-type"},
+	{Path: "NamingSystem.uniqueId.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/namingsystem-identifier-type"},
+	{Path: "NutritionIntake.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "NutritionIntake.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-status"},
+	{Path: "NutritionOrder.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "NutritionOrder.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"},
+	{Path: "NutritionOrder.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"},
+	{Path: "NutritionOrder.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "NutritionProduct.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "NutritionProduct.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/nutritionproduct-status"},
+	{Path: "Observation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Observation.triggeredBy.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-triggeredbytype", Description: "The type of TriggeredBy Observation."},
+	{Path: "Observation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status", Description: "Codes providing the status of an observation."},
+	{Path: "Observation.category", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/observation-category", Description: "Codes for high level observation categories."},
+	{Path: "Observation.code", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-codes-observationorboth", Description: "Codes identifying names of simple observations."},
+	{Path: "Observation.dataAbsentReason", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/data-absent-reason", Description: "Codes specifying why the result (`Observation.value[x]`) is missing."},
+	{Path: "Observation.interpretation", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation", Description: "Codes identifying interpretations of observations."},
+	{Path: "Observation.bodySite", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/body-site", Description: "SNOMED CT Body site concepts"},
+	{Path: "Observation.method", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-methods", Description: "Methods for simple observations."},
+	{Path: "Observation.referenceRange.normalValue", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-referencerange-normalvalue", Description: "Codes identifying the normal value of the observation."},
+	{Path: "Observation.referenceRange.type", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/referencerange-meaning", Description: "Code for the meaning of a reference range."},
+	{Path: "Observation.referenceRange.appliesTo", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/referencerange-appliesto", Description: "Codes identifying the population the reference range applies to."},
+	{Path: "Observation.component.code", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-codes-observationorboth", Description: "Codes identifying names of simple observations."},
+	{Path: "Observation.component.dataAbsentReason", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/data-absent-reason", Description: "Codes specifying why the result (`Observation.value[x]`) is missing."},
+	{Path: "Observation.component.interpretation", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation", Description: "Codes identifying interpretations of observations."},
+	{Path: "ObservationDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ObservationDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ObservationDefinition.permittedDataType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/permitted-data-type"},
+	{Path: "ObservationDefinition.qualifiedValue.sexParameterForClinicalUse", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"},
+	{Path: "ObservationDefinition.qualifiedValue.rangeCategory", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-range-category"},
+	{Path: "ObservationDefinition.component.permittedDataType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/permitted-data-type"},
+	{Path: "OperationDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "OperationDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "OperationDefinition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-kind"},
+	{Path: "OperationDefinition.synchronicity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/synchronicity-control"},
+	{Path: "OperationDefinition.parameter.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-parameter-use"},
+	{Path: "OperationDefinition.parameter.scope", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-parameter-scope"},
+	{Path: "OperationDefinition.parameter.searchType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-param-type"},
+	{Path: "OperationDefinition.parameter.binding.strength", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/binding-strength"},
+	{Path: "OperationOutcome.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "OperationOutcome.issue.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/issue-severity"},
+	{Path: "Organization.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "OrganizationAffiliation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "PackagedProductDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Parameters.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Patient.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Patient.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender", Description: "The gender of a person used for administrative purposes."},
+	{Path: "Patient.maritalStatus", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/marital-status", Description: "The domestic partnership status of a person."},
+	{Path: "Patient.contact.relationship", Strength: "preferred", ValueSet: "http://terminology.hl7.org/ValueSet/v3-PersonalRelationshipRoleType", Description: "The nature of the personal relationship between the patient and the contact person."},
+	{Path: "Patient.contact.role", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/relatedperson-relationshiptype", Description: "The nature of the personal relationship between the patient and the contact person."},
+	{Path: "Patient.contact.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender", Description: "The gender of a person used for administrative purposes."},
+	{Path: "Patient.communication.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Patient.link.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/link-type", Description: "The type of link between this patient resource and another Patient resource, or Patient/RelatedPerson when using the `seealso` code"},
+	{Path: "PaymentNotice.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "PaymentNotice.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "PaymentReconciliation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "PaymentReconciliation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "PaymentReconciliation.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/payment-outcome"},
+	{Path: "PaymentReconciliation.processNote.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/note-type"},
+	{Path: "Person.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Person.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"},
+	{Path: "Person.link.assurance", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/identity-assuranceLevel"},
+	{Path: "PlanDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "PlanDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "PlanDefinition.actor.option.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"},
+	{Path: "PlanDefinition.action.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "PlanDefinition.action.condition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-condition-kind"},
+	{Path: "PlanDefinition.action.relatedAction.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"},
+	{Path: "PlanDefinition.action.relatedAction.endRelationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"},
+	{Path: "PlanDefinition.action.participant.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"},
+	{Path: "PlanDefinition.action.applicabilityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-applicability-behavior"},
+	{Path: "PlanDefinition.action.groupingBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-grouping-behavior"},
+	{Path: "PlanDefinition.action.selectionBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-selection-behavior"},
+	{Path: "PlanDefinition.action.requiredBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-required-behavior"},
+	{Path: "PlanDefinition.action.precheckBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-precheck-behavior"},
+	{Path: "PlanDefinition.action.cardinalityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-cardinality-behavior"},
+	{Path: "Practitioner.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Practitioner.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"},
+	{Path: "PractitionerRole.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Procedure.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Procedure.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-status"},
+	{Path: "Provenance.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Provenance.entity.role", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/provenance-entity-role"},
+	{Path: "Questionnaire.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Questionnaire.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Questionnaire.item.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/item-type-useable"},
+	{Path: "Questionnaire.item.enableWhen.operator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-enable-operator"},
+	{Path: "Questionnaire.item.enableBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-applicability-behavior"},
+	{Path: "Questionnaire.item.disabledDisplay", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-disabled-display"},
+	{Path: "Questionnaire.item.answerConstraint", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-answer-constraint"},
+	{Path: "QuestionnaireResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "QuestionnaireResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-answers-status"},
+	{Path: "RegulatedAuthorization.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "RelatedPerson.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "RelatedPerson.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"},
+	{Path: "RequestOrchestration.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "RequestOrchestration.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"},
+	{Path: "RequestOrchestration.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-intent"},
+	{Path: "RequestOrchestration.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "RequestOrchestration.action.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "RequestOrchestration.action.condition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-condition-kind"},
+	{Path: "RequestOrchestration.action.relatedAction.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"},
+	{Path: "RequestOrchestration.action.relatedAction.endRelationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"},
+	{Path: "RequestOrchestration.action.participant.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"},
+	{Path: "RequestOrchestration.action.applicabilityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-applicability-behavior"},
+	{Path: "RequestOrchestration.action.groupingBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-grouping-behavior"},
+	{Path: "RequestOrchestration.action.selectionBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-selection-behavior"},
+	{Path: "RequestOrchestration.action.requiredBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-required-behavior"},
+	{Path: "RequestOrchestration.action.precheckBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-precheck-behavior"},
+	{Path: "RequestOrchestration.action.cardinalityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-cardinality-behavior"},
+	{Path: "Requirements.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Requirements.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "Requirements.statement.conformance", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conformance-expectation"},
+	{Path: "ResearchStudy.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ResearchStudy.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ResearchSubject.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ResearchSubject.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "RiskAssessment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "RiskAssessment.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status"},
+	{Path: "Schedule.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "SearchParameter.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "SearchParameter.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "SearchParameter.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-param-type"},
+	{Path: "SearchParameter.processingMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-processingmode"},
+	{Path: "SearchParameter.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-comparator"},
+	{Path: "SearchParameter.modifier", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-modifier-all-codes"},
+	{Path: "ServiceRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ServiceRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"},
+	{Path: "ServiceRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"},
+	{Path: "ServiceRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "Slot.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Slot.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/slotstatus"},
+	{Path: "Specimen.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Specimen.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/specimen-status"},
+	{Path: "Specimen.combined", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/specimen-combined"},
+	{Path: "SpecimenDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "SpecimenDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "SpecimenDefinition.typeTested.preference", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/specimen-contained-preference"},
+	{Path: "StructureDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "StructureDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "StructureDefinition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/structure-definition-kind"},
+	{Path: "StructureDefinition.context.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/extension-context-type"},
+	{Path: "StructureMap.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "StructureMap.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "StructureMap.structure.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-model-mode"},
+	{Path: "StructureMap.group.typeMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-group-type-mode"},
+	{Path: "StructureMap.group.input.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-input-mode"},
+	{Path: "StructureMap.group.rule.source.listMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-source-list-mode"},
+	{Path: "StructureMap.group.rule.target.listMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-target-list-mode"},
+	{Path: "StructureMap.group.rule.target.transform", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-transform"},
+	{Path: "Subscription.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Subscription.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-status"},
+	{Path: "Subscription.filterBy.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-comparator"},
+	{Path: "Subscription.filterBy.modifier", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-modifier-all-codes"},
+	{Path: "Subscription.content", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-payload-content"},
+	{Path: "SubscriptionStatus.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "SubscriptionStatus.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-status"},
+	{Path: "SubscriptionStatus.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-notification-type"},
+	{Path: "SubscriptionTopic.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "SubscriptionTopic.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.resultForCreate", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscriptiontopic-cr-behavior"},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.resultForDelete", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscriptiontopic-cr-behavior"},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-comparator"},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.modifier", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-modifier-all-codes"},
+	{Path: "Substance.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Substance.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/substance-status"},
+	{Path: "SubstanceDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Task.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "Task.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/task-status"},
+	{Path: "Task.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/task-intent"},
+	{Path: "Task.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "TerminologyCapabilities.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "TerminologyCapabilities.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "TerminologyCapabilities.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/capability-statement-kind"},
+	{Path: "TerminologyCapabilities.codeSystem.content", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/codesystem-content-mode"},
+	{Path: "TerminologyCapabilities.supplements.globals", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/global-langpack-support"},
+	{Path: "TerminologyCapabilities.codeSearch", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/code-search-support"},
+	{Path: "ValueSet.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "ValueSet.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"},
+	{Path: "ValueSet.compose.include.filter.op", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/filter-operator"},
+	{Path: "VisionPrescription.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"},
+	{Path: "VisionPrescription.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"},
+	{Path: "VisionPrescription.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"},
+	{Path: "VisionPrescription.lensSpecification.eye", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/vision-eye-codes"},
+	{Path: "VisionPrescription.lensSpecification.prism.base", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/vision-base-codes"},
+}
+
+// bindingIndex maps each resource type to the positions of its bindings
+// in bindingDefinitions.
+var bindingIndex = map[string][]int{
+	"Account":                        {0, 1},
+	"ActivityDefinition":             {2, 3, 4, 5, 6},
+	"ActorDefinition":                {7, 8, 9},
+	"AdministrableProductDefinition": {10, 11},
+	"AdverseEvent":                   {12, 13, 14},
+	"AllergyIntolerance":             {15, 16, 17, 18},
+	"Appointment":                    {19, 20, 21},
+	"AppointmentResponse":            {22, 23},
+	"ArtifactAssessment":             {24, 25, 26},
+	"AuditEvent":                     {27, 28},
+	"Basic":                          {29},
+	"Binary":                         {30},
+	"BiologicallyDerivedProduct":     {31},
+	"BodyStructure":                  {32},
+	"Bundle":                         {33, 34, 35, 36},
+	"CapabilityStatement":            {37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48},
+	"CarePlan":                       {49, 50},
+	"CareTeam":                       {51, 52},
+	"Claim":                          {53, 54, 55},
+	"ClaimResponse":                  {56, 57, 58, 59},
+	"ClinicalUseDefinition":          {60, 61},
+	"CodeSystem":                     {62, 63, 64, 65, 66, 67},
+	"Communication":                  {68, 69, 70},
+	"CommunicationRequest":           {71, 72, 73, 74},
+	"CompartmentDefinition":          {75, 76, 77},
+	"Composition":                    {78, 79},
+	"ConceptMap":                     {80, 81, 82, 83, 84, 85, 86},
+	"Condition":                      {87},
+	"Consent":                        {88, 89, 90, 91},
+	"Contract":                       {92, 93},
+	"CoverageEligibilityRequest":     {94, 95, 96},
+	"CoverageEligibilityResponse":    {97, 98, 99, 100},
+	"DetectedIssue":                  {101, 102},
+	"Device":                         {103, 104, 105},
+	"DeviceAlert":                    {106, 107},
+	"DeviceAssociation":              {108, 109},
+	"DeviceDefinition":               {110, 111, 112, 113},
+	"DeviceMetric":                   {114, 115, 116, 117},
+	"DeviceRequest":                  {118, 119, 120, 121},
+	"DiagnosticReport":               {122, 123},
+	"DocumentReference":              {124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135},
+	"Encounter":                      {136, 137, 138},
+	"Endpoint":                       {139, 140},
+	"EnrollmentRequest":              {141, 142},
+	"EnrollmentResponse":             {143, 144, 145},
+	"EpisodeOfCare":                  {146, 147, 148},
+	"EventDefinition":                {149, 150},
+	"Evidence":                       {151, 152, 153},
+	"EvidenceVariable":               {154, 155},
+	"ExampleScenario":                {156, 157, 158},
+	"ExplanationOfBenefit":           {159, 160, 161, 162},
+	"FamilyMemberHistory":            {163, 164},
+	"Flag":                           {165, 166},
+	"Goal":                           {167, 168, 169},
+	"Group":                          {170, 171, 172, 173, 174},
+	"GuidanceResponse":               {175, 176},
+	"HealthcareService":              {177},
+	"ImagingSelection":               {178, 179, 180, 181},
+	"ImagingStudy":                   {182, 183},
+	"Immunization":                   {184, 185},
+	"ImplementationGuide":            {186, 187, 188},
+	"Ingredient":                     {189, 190, 191},
+	"InsurancePlan":                  {192},
+	"InsuranceProduct":               {193, 194},
+	"Invoice":                        {195, 196},
+	"Library":                        {197, 198},
+	"List":                           {199, 200, 201},
+	"Location":                       {202, 203, 204},
+	"ManufacturedItemDefinition":     {205, 206},
+	"Measure":                        {207, 208},
+	"MeasureReport":                  {209, 210, 211, 212},
+	"Medication":                     {213, 214},
+	"MedicationAdministration":       {215, 216},
+	"MedicationDispense":             {217, 218},
+	"MedicationRequest":              {219, 220, 221, 222},
+	"MedicationStatement":            {223, 224},
+	"MedicinalProductDefinition":     {225},
+	"MessageDefinition":              {226, 227, 228, 229},
+	"MessageHeader":                  {230, 231},
+	"NamingSystem":                   {232, 233, 234, 235},
+	"NutritionIntake":                {236, 237},
+	"NutritionOrder":                 {238, 239, 240, 241},
+	"NutritionProduct":               {242, 243},
+	"Observation":                    {244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258},
+	"ObservationDefinition":          {259, 260, 261, 262, 263, 264},
+	"OperationDefinition":            {265, 266, 267, 268, 269, 270, 271, 272},
+	"OperationOutcome":               {273, 274},
+	"Organization":                   {275},
+	"OrganizationAffiliation":        {276},
+	"PackagedProductDefinition":      {277},
+	"Parameters":                     {278},
+	"Patient":                        {279, 280, 281, 282, 283, 284, 285, 286},
+	"PaymentNotice":                  {287, 288},
+	"PaymentReconciliation":          {289, 290, 291, 292},
+	"Person":                         {293, 294, 295},
+	"PlanDefinition":                 {296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309},
+	"Practitioner":                   {310, 311},
+	"PractitionerRole":               {312},
+	"Procedure":                      {313, 314},
+	"Provenance":                     {315, 316},
+	"Questionnaire":                  {317, 318, 319, 320, 321, 322, 323},
+	"QuestionnaireResponse":          {324, 325},
+	"RegulatedAuthorization":         {326},
+	"RelatedPerson":                  {327, 328},
+	"RequestOrchestration":           {329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 339, 340, 341, 342, 343},
+	"Requirements":                   {344, 345, 346},
+	"ResearchStudy":                  {347, 348},
+	"ResearchSubject":                {349, 350},
+	"RiskAssessment":                 {351, 352},
+	"Schedule":                       {353},
+	"SearchParameter":                {354, 355, 356, 357, 358, 359},
+	"ServiceRequest":                 {360, 361, 362, 363},
+	"Slot":                           {364, 365},
+	"Specimen":                       {366, 367, 368},
+	"SpecimenDefinition":             {369, 370, 371},
+	"StructureDefinition":            {372, 373, 374, 375},
+	"StructureMap":                   {376, 377, 378, 379, 380, 381, 382, 383},
+	"Subscription":                   {384, 385, 386, 387, 388},
+	"SubscriptionStatus":             {389, 390, 391},
+	"SubscriptionTopic":              {392, 393, 394, 395, 396, 397},
+	"Substance":                      {398, 399},
+	"SubstanceDefinition":            {400},
+	"Task":                           {401, 402, 403, 404},
+	"TerminologyCapabilities":        {405, 406, 407, 408, 409, 410},
+	"ValueSet":                       {411, 412, 413},
+	"VisionPrescription":             {414, 415, 416, 417, 418},
+}
+
+// BindingsFor returns the bindings of the code, Coding and CodeableConcept
+// elements of a resource type.
+func BindingsFor(resourceType string) []*BindingDefinition {
+	positions := bindingIndex[resourceType]
+	bindings := make([]*BindingDefinition, len(positions))
+	for i, pos := range positions {
+		bindings[i] = &bindingDefinitions[pos]
+	}
+	return bindings
+}
+
+// BoundValue is an item of a coded element of a resource together with
+// the binding of its element.
+type BoundValue struct {
+	Binding *BindingDefinition
+	// Path is the location of the item, such as Observation.category[1].
+	Path string
+	// Type is the type of the item: code, Coding or CodeableConcept.
+	Type string
+	// Codings are the codes of the item. The code of a code element is
+	// given as a Coding without a system.
+	Codings []Coding
+	// Text is the text of a CodeableConcept.
+	Text string
+}
+
+// BoundValues returns the items of the bound coded elements of res, element
+// by element in the order of BindingsFor. Only the elements of the
+// resource's own definition are included, not those of the data types it
+// uses, and the variants of other types of choice elements are left out.
+func BoundValues(res Resource) []BoundValue {
+	var values []BoundValue
+	for _, binding := range BindingsFor(res.GetResourceType()) {
+		for _, item := range bindingLocations(res, binding) {
+			v := BoundValue{Binding: binding, Path: item.path}
+			switch value := item.value.Interface().(type) {
+			case Coding:
+				v.Type, v.Codings = "Coding", []Coding{value}
+			case CodeableConcept:
+				v.Type, v.Codings = "CodeableConcept", value.Coding
+				if value.Text != nil {
+					v.Text = *value.Text
+				}
+			default:
+				code, ok := boundCode(item)
+				if !ok {
+					continue
+				}
+				v.Type, v.Codings = "code", []Coding{{Code: &code}}
+			}
+			values = append(values, v)
+		}
+	}
+	return values
+}
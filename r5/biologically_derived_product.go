@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,9 +12,13 @@ type BiologicallyDerivedProduct struct {
 	Id                      *string                               `json:"id,omitempty" bson:"id,omitempty"`                                             // Logical id of this artifact
 	Meta                    *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                                         // Metadata about the resource
 	ImplicitRules           *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                      // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`             // Extensions for implicitRules
 	Language                *string                               `json:"language,omitempty" bson:"language,omitempty"`                                 // Language of the resource content
+	LanguageElement         *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`                        // Extensions for language
 	Text                    *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                                         // Text summary of the resource, for human interpretation
-	Contained               []json.RawMessage                     `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
+	Contained               []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
+	Extension               []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension       []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored
 	ProductCategory         []CodeableConcept                     `json:"productCategory,omitempty" bson:"product_category,omitempty"`                  // A category or classification of the product
 	ProductCode             *CodeableConcept                      `json:"productCode,omitempty" bson:"product_code,omitempty"`                          // A code that identifies the kind of this biologically derived product
 	Parent                  []Reference                           `json:"parent,omitempty" bson:"parent,omitempty"`                                     // The parent biologically-derived product
@@ -22,13 +27,21 @@ type BiologicallyDerivedProduct struct {
 	BiologicalSourceEvent   *Identifier                           `json:"biologicalSourceEvent,omitempty" bson:"biological_source_event,omitempty"`     // An identifier that supports traceability to the event during which material in this product from one or more biological entities was obtained or pooled
 	ProcessingFacility      []Reference                           `json:"processingFacility,omitempty" bson:"processing_facility,omitempty"`            // Processing facilities responsible for the labeling and distribution of this biologically derived product
 	Division                *string                               `json:"division,omitempty" bson:"division,omitempty"`                                 // A unique identifier for an aliquot of a product
+	DivisionElement         *Element                              `json:"_division,omitempty" bson:"division_element,omitempty"`                        // Extensions for division
 	ProductStatus           *Coding                               `json:"productStatus,omitempty" bson:"product_status,omitempty"`                      // available | unavailable | processed | applied | discarded
-	ExpirationDate          *string                               `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`                    // Date, and where relevant time, of expiration
+	ExpirationDate          *DateTime                             `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`                    // Date, and where relevant time, of expiration
+	ExpirationDateElement   *Element                              `json:"_expirationDate,omitempty" bson:"expiration_date_element,omitempty"`           // Extensions for expirationDate
 	Collection              *BiologicallyDerivedProductCollection `json:"collection,omitempty" bson:"collection,omitempty"`                             // How this product was collected
 	StorageTempRequirements *Range                                `json:"storageTempRequirements,omitempty" bson:"storage_temp_requirements,omitempty"` // Product storage temperature requirements
 	Property                []BiologicallyDerivedProductProperty  `json:"property,omitempty" bson:"property,omitempty"`                                 // A property that is specific to this BiologicallyDerviedProduct instance
 }
 
+var biologicallyDerivedProductParentTargets = []string{"BiologicallyDerivedProduct"}
+
+var biologicallyDerivedProductRequestTargets = []string{"ServiceRequest"}
+
+var biologicallyDerivedProductProcessingFacilityTargets = []string{"Organization"}
+
 func (r *BiologicallyDerivedProduct) Validate() error {
 	if r.ResourceType != "BiologicallyDerivedProduct" {
 		return fmt.Errorf("invalid resourceType: expected 'BiologicallyDerivedProduct', got '%s'", r.ResourceType)
@@ -38,11 +51,39 @@ func (r *BiologicallyDerivedProduct) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.ProductCategory {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ProductCategory[%d]: %w", i, err)
@@ -53,11 +94,21 @@ func (r *BiologicallyDerivedProduct) Validate() error {
 			return fmt.Errorf("ProductCode: %w", err)
 		}
 	}
+	for i, item := range r.Parent {
+		if target := invalidReferenceTarget(item.Reference, item.Type, biologicallyDerivedProductParentTargets); target != "" {
+			return fmt.Errorf("field 'Parent[%d]' cannot refer to %s, expected one of %v", i, target, biologicallyDerivedProductParentTargets)
+		}
+	}
 	for i, item := range r.Parent {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Parent[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Request {
+		if target := invalidReferenceTarget(item.Reference, item.Type, biologicallyDerivedProductRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request[%d]' cannot refer to %s, expected one of %v", i, target, biologicallyDerivedProductRequestTargets)
+		}
+	}
 	for i, item := range r.Request {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Request[%d]: %w", i, err)
@@ -73,16 +124,36 @@ func (r *BiologicallyDerivedProduct) Validate() error {
 			return fmt.Errorf("BiologicalSourceEvent: %w", err)
 		}
 	}
+	for i, item := range r.ProcessingFacility {
+		if target := invalidReferenceTarget(item.Reference, item.Type, biologicallyDerivedProductProcessingFacilityTargets); target != "" {
+			return fmt.Errorf("field 'ProcessingFacility[%d]' cannot refer to %s, expected one of %v", i, target, biologicallyDerivedProductProcessingFacilityTargets)
+		}
+	}
 	for i, item := range r.ProcessingFacility {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ProcessingFacility[%d]: %w", i, err)
 		}
 	}
+	if r.DivisionElement != nil {
+		if err := r.DivisionElement.Validate(); err != nil {
+			return fmt.Errorf("DivisionElement: %w", err)
+		}
+	}
 	if r.ProductStatus != nil {
 		if err := r.ProductStatus.Validate(); err != nil {
 			return fmt.Errorf("ProductStatus: %w", err)
 		}
 	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDate: %w", err)
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		if err := r.ExpirationDateElement.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDateElement: %w", err)
+		}
+	}
 	if r.Collection != nil {
 		if err := r.Collection.Validate(); err != nil {
 			return fmt.Errorf("Collection: %w", err)
@@ -98,121 +169,311 @@ func (r *BiologicallyDerivedProduct) Validate() error {
 			return fmt.Errorf("Property[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type BiologicallyDerivedProductProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                   // Code that specifies the property
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // Property values
-	ValueInteger         *int             `json:"valueInteger" bson:"value_integer"`                  // Property values
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // Property values
-	ValuePeriod          *Period          `json:"valuePeriod" bson:"value_period"`                    // Property values
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // Property values
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // Property values
-	ValueRatio           *Ratio           `json:"valueRatio" bson:"value_ratio"`                      // Property values
-	ValueString          *string          `json:"valueString" bson:"value_string"`                    // Property values
-	ValueAttachment      *Attachment      `json:"valueAttachment" bson:"value_attachment"`            // Property values
+	return validateInvariants(r, "BiologicallyDerivedProduct")
 }
 
-func (r *BiologicallyDerivedProductProperty) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *BiologicallyDerivedProduct) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "BiologicallyDerivedProduct" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'BiologicallyDerivedProduct', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
+	for i, item := range r.ProductCategory {
+		item.validateAll(fmt.Sprintf("%s.productCategory[%d]", path, i), issues)
+	}
+	if r.ProductCode != nil {
+		r.ProductCode.validateAll(path+".productCode", issues)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	for i, item := range r.Parent {
+		if target := invalidReferenceTarget(item.Reference, item.Type, biologicallyDerivedProductParentTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.parent[%d]", path, i), fmt.Sprintf("field 'Parent[%d]' cannot refer to %s, expected one of %v", i, target, biologicallyDerivedProductParentTargets))
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	for i, item := range r.Parent {
+		item.validateAll(fmt.Sprintf("%s.parent[%d]", path, i), issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	for i, item := range r.Request {
+		if target := invalidReferenceTarget(item.Reference, item.Type, biologicallyDerivedProductRequestTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.request[%d]", path, i), fmt.Sprintf("field 'Request[%d]' cannot refer to %s, expected one of %v", i, target, biologicallyDerivedProductRequestTargets))
 		}
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	for i, item := range r.Request {
+		item.validateAll(fmt.Sprintf("%s.request[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.BiologicalSourceEvent != nil {
+		r.BiologicalSourceEvent.validateAll(path+".biologicalSourceEvent", issues)
+	}
+	for i, item := range r.ProcessingFacility {
+		if target := invalidReferenceTarget(item.Reference, item.Type, biologicallyDerivedProductProcessingFacilityTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.processingFacility[%d]", path, i), fmt.Sprintf("field 'ProcessingFacility[%d]' cannot refer to %s, expected one of %v", i, target, biologicallyDerivedProductProcessingFacilityTargets))
 		}
 	}
-	if r.ValueRatio == nil {
-		return fmt.Errorf("field 'ValueRatio' is required")
+	for i, item := range r.ProcessingFacility {
+		item.validateAll(fmt.Sprintf("%s.processingFacility[%d]", path, i), issues)
+	}
+	if r.DivisionElement != nil {
+		r.DivisionElement.validateAll(path+".division", issues)
+	}
+	if r.ProductStatus != nil {
+		r.ProductStatus.validateAll(path+".productStatus", issues)
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			issues.add("value", path+".expirationDate", err.Error())
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.ExpirationDateElement != nil {
+		r.ExpirationDateElement.validateAll(path+".expirationDate", issues)
+	}
+	if r.Collection != nil {
+		r.Collection.validateAll(path+".collection", issues)
+	}
+	if r.StorageTempRequirements != nil {
+		r.StorageTempRequirements.validateAll(path+".storageTempRequirements", issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+}
+
+func (r *BiologicallyDerivedProduct) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("BiologicallyDerivedProduct", &issues)
+	checkInvariants(r, "BiologicallyDerivedProduct", &issues)
+	return issues
+}
+
+var biologicallyDerivedProductInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *BiologicallyDerivedProduct) invariants() []invariant {
+	return biologicallyDerivedProductInvariants
+}
+
+func (r *BiologicallyDerivedProduct) UnmarshalJSON(data []byte) error {
+	type alias BiologicallyDerivedProduct
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r BiologicallyDerivedProduct) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BiologicallyDerivedProduct) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BiologicallyDerivedProduct) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BiologicallyDerivedProduct) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var biologicallyDerivedProductElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *BiologicallyDerivedProduct) elementTypes() map[string]string {
+	return biologicallyDerivedProductElementTypes
+}
+
+var biologicallyDerivedProductElementMetadata = []ElementMetadata{
+	{Path: "BiologicallyDerivedProduct.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "BiologicallyDerivedProduct.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "BiologicallyDerivedProduct.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "BiologicallyDerivedProduct.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "BiologicallyDerivedProduct.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "BiologicallyDerivedProduct.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "BiologicallyDerivedProduct.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "BiologicallyDerivedProduct.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BiologicallyDerivedProduct.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BiologicallyDerivedProduct.productCategory", Name: "productCategory", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "BiologicallyDerivedProduct.productCode", Name: "productCode", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "BiologicallyDerivedProduct.parent", Name: "parent", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct"}},
+	{Path: "BiologicallyDerivedProduct.request", Name: "request", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "BiologicallyDerivedProduct.identifier", Name: "identifier", Field: 15, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "BiologicallyDerivedProduct.biologicalSourceEvent", Name: "biologicalSourceEvent", Field: 16, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "BiologicallyDerivedProduct.processingFacility", Name: "processingFacility", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "BiologicallyDerivedProduct.division", Name: "division", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BiologicallyDerivedProduct.productStatus", Name: "productStatus", Field: 20, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "BiologicallyDerivedProduct.expirationDate", Name: "expirationDate", Field: 21, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "BiologicallyDerivedProduct.collection", Name: "collection", Field: 23, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "BiologicallyDerivedProduct.storageTempRequirements", Name: "storageTempRequirements", Field: 24, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "BiologicallyDerivedProduct.property", Name: "property", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *BiologicallyDerivedProduct) ElementMetadata() []ElementMetadata {
+	return biologicallyDerivedProductElementMetadata
+}
+
+func (r *BiologicallyDerivedProduct) GetResourceType() string {
+	return "BiologicallyDerivedProduct"
+}
+
+func (r *BiologicallyDerivedProduct) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *BiologicallyDerivedProduct) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *BiologicallyDerivedProduct) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *BiologicallyDerivedProduct) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *BiologicallyDerivedProduct) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *BiologicallyDerivedProduct) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *BiologicallyDerivedProduct) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*BiologicallyDerivedProduct)(nil)
+
 type BiologicallyDerivedProductCollection struct {
-	Id                 *string    `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
-	Collector          *Reference `json:"collector,omitempty" bson:"collector,omitempty"`                    // Individual performing the collection
-	SourcePatient      *Reference `json:"sourcePatient,omitempty" bson:"source_patient,omitempty"`           // The patient who underwent the medical procedure to collect the product
-	SourceOrganization *Reference `json:"sourceOrganization,omitempty" bson:"source_organization,omitempty"` // The organization that facilitated the collection
-	CollectedDateTime  *string    `json:"collectedDateTime,omitempty" bson:"collected_date_time,omitempty"`  // Time of product collection
-	CollectedPeriod    *Period    `json:"collectedPeriod,omitempty" bson:"collected_period,omitempty"`       // Time of product collection
-	Procedure          *Reference `json:"procedure,omitempty" bson:"procedure,omitempty"`                    // The procedure involved in the collection
+	Id                 *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
+	Extension          []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension  []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored even if unrecognized
+	Collector          *Reference                                    `json:"collector,omitempty" bson:"collector,omitempty"`                    // Individual performing the collection
+	SourcePatient      *Reference                                    `json:"sourcePatient,omitempty" bson:"source_patient,omitempty"`           // The patient who underwent the medical procedure to collect the product
+	SourceOrganization *Reference                                    `json:"sourceOrganization,omitempty" bson:"source_organization,omitempty"` // The organization that facilitated the collection
+	Collected          BiologicallyDerivedProductCollectionCollected `json:"-" bson:"collected,omitempty"`                                      // Time of product collection
+	CollectedElement   *Element                                      `json:"-" bson:"collected_element,omitempty"`                              // Extensions for collected[x]
+	Procedure          *Reference                                    `json:"procedure,omitempty" bson:"procedure,omitempty"`                    // The procedure involved in the collection
+
+	collectedVariants []string // JSON properties of BiologicallyDerivedProduct.collection.collected[x] when more than one was decoded
 }
 
+var biologicallyDerivedProductCollectionCollectorTargets = []string{"Practitioner", "PractitionerRole"}
+
+var biologicallyDerivedProductCollectionSourcePatientTargets = []string{"Patient"}
+
+var biologicallyDerivedProductCollectionSourceOrganizationTargets = []string{"Organization"}
+
+var biologicallyDerivedProductCollectionProcedureTargets = []string{"Procedure"}
+
 func (r *BiologicallyDerivedProductCollection) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Collector != nil {
+		if target := invalidReferenceTarget(r.Collector.Reference, r.Collector.Type, biologicallyDerivedProductCollectionCollectorTargets); target != "" {
+			return fmt.Errorf("field 'Collector' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionCollectorTargets)
+		}
+	}
 	if r.Collector != nil {
 		if err := r.Collector.Validate(); err != nil {
 			return fmt.Errorf("Collector: %w", err)
 		}
 	}
+	if r.SourcePatient != nil {
+		if target := invalidReferenceTarget(r.SourcePatient.Reference, r.SourcePatient.Type, biologicallyDerivedProductCollectionSourcePatientTargets); target != "" {
+			return fmt.Errorf("field 'SourcePatient' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionSourcePatientTargets)
+		}
+	}
 	if r.SourcePatient != nil {
 		if err := r.SourcePatient.Validate(); err != nil {
 			return fmt.Errorf("SourcePatient: %w", err)
 		}
 	}
+	if r.SourceOrganization != nil {
+		if target := invalidReferenceTarget(r.SourceOrganization.Reference, r.SourceOrganization.Type, biologicallyDerivedProductCollectionSourceOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'SourceOrganization' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionSourceOrganizationTargets)
+		}
+	}
 	if r.SourceOrganization != nil {
 		if err := r.SourceOrganization.Validate(); err != nil {
 			return fmt.Errorf("SourceOrganization: %w", err)
 		}
 	}
-	if r.CollectedPeriod != nil {
-		if err := r.CollectedPeriod.Validate(); err != nil {
-			return fmt.Errorf("CollectedPeriod: %w", err)
+	if len(r.collectedVariants) > 1 {
+		return fmt.Errorf("field 'Collected' must have a single type, got %v", r.collectedVariants)
+	}
+	if r.Collected != nil {
+		if err := r.Collected.Validate(); err != nil {
+			return fmt.Errorf("Collected: %w", err)
+		}
+	}
+	if r.CollectedElement != nil {
+		if err := r.CollectedElement.Validate(); err != nil {
+			return fmt.Errorf("CollectedElement: %w", err)
+		}
+	}
+	if r.Procedure != nil {
+		if target := invalidReferenceTarget(r.Procedure.Reference, r.Procedure.Type, biologicallyDerivedProductCollectionProcedureTargets); target != "" {
+			return fmt.Errorf("field 'Procedure' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionProcedureTargets)
 		}
 	}
 	if r.Procedure != nil {
@@ -222,3 +483,542 @@ func (r *BiologicallyDerivedProductCollection) Validate() error {
 	}
 	return nil
 }
+
+func (r *BiologicallyDerivedProductCollection) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Collector != nil {
+		if target := invalidReferenceTarget(r.Collector.Reference, r.Collector.Type, biologicallyDerivedProductCollectionCollectorTargets); target != "" {
+			issues.add("value", path+".collector", fmt.Sprintf("field 'Collector' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionCollectorTargets))
+		}
+	}
+	if r.Collector != nil {
+		r.Collector.validateAll(path+".collector", issues)
+	}
+	if r.SourcePatient != nil {
+		if target := invalidReferenceTarget(r.SourcePatient.Reference, r.SourcePatient.Type, biologicallyDerivedProductCollectionSourcePatientTargets); target != "" {
+			issues.add("value", path+".sourcePatient", fmt.Sprintf("field 'SourcePatient' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionSourcePatientTargets))
+		}
+	}
+	if r.SourcePatient != nil {
+		r.SourcePatient.validateAll(path+".sourcePatient", issues)
+	}
+	if r.SourceOrganization != nil {
+		if target := invalidReferenceTarget(r.SourceOrganization.Reference, r.SourceOrganization.Type, biologicallyDerivedProductCollectionSourceOrganizationTargets); target != "" {
+			issues.add("value", path+".sourceOrganization", fmt.Sprintf("field 'SourceOrganization' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionSourceOrganizationTargets))
+		}
+	}
+	if r.SourceOrganization != nil {
+		r.SourceOrganization.validateAll(path+".sourceOrganization", issues)
+	}
+	if len(r.collectedVariants) > 1 {
+		issues.add("structure", path+".collected", fmt.Sprintf("field 'Collected' must have a single type, got %v", r.collectedVariants))
+	}
+	if r.Collected != nil {
+		r.Collected.validateAll(path+".collected.ofType("+r.Collected.FHIRType()+")", issues)
+	}
+	if r.CollectedElement != nil {
+		r.CollectedElement.validateAll(path+".collected", issues)
+	}
+	if r.Procedure != nil {
+		if target := invalidReferenceTarget(r.Procedure.Reference, r.Procedure.Type, biologicallyDerivedProductCollectionProcedureTargets); target != "" {
+			issues.add("value", path+".procedure", fmt.Sprintf("field 'Procedure' cannot refer to %s, expected one of %v", target, biologicallyDerivedProductCollectionProcedureTargets))
+		}
+	}
+	if r.Procedure != nil {
+		r.Procedure.validateAll(path+".procedure", issues)
+	}
+}
+
+var biologicallyDerivedProductCollectionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BiologicallyDerivedProductCollection) invariants() []invariant {
+	return biologicallyDerivedProductCollectionInvariants
+}
+
+func (r BiologicallyDerivedProductCollection) MarshalJSON() ([]byte, error) {
+	type alias BiologicallyDerivedProductCollection
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "collected", r.Collected, r.CollectedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *BiologicallyDerivedProductCollection) UnmarshalJSON(data []byte) error {
+	type alias BiologicallyDerivedProductCollection
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Collected, element, r.collectedVariants, err = unmarshalChoice(fields, "collected", biologicallyDerivedProductCollectionCollectedVariants); err != nil {
+		return err
+	}
+	r.CollectedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.CollectedElement); err != nil {
+			return fmt.Errorf("_collected: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r BiologicallyDerivedProductCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BiologicallyDerivedProductCollection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BiologicallyDerivedProductCollection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BiologicallyDerivedProductCollection) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *BiologicallyDerivedProductCollection) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Collected":
+		return "collected", choiceValues(biologicallyDerivedProductCollectionCollectedVariants)
+	}
+	return "", nil
+}
+
+var biologicallyDerivedProductCollectionElementMetadata = []ElementMetadata{
+	{Path: "BiologicallyDerivedProduct.collection.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BiologicallyDerivedProduct.collection.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BiologicallyDerivedProduct.collection.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BiologicallyDerivedProduct.collection.collector", Name: "collector", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "BiologicallyDerivedProduct.collection.sourcePatient", Name: "sourcePatient", Field: 4, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "BiologicallyDerivedProduct.collection.sourceOrganization", Name: "sourceOrganization", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "BiologicallyDerivedProduct.collection.collected[x]", Name: "collected", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "BiologicallyDerivedProduct.collection.procedure", Name: "procedure", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Procedure"}},
+}
+
+func (r *BiologicallyDerivedProductCollection) ElementMetadata() []ElementMetadata {
+	return biologicallyDerivedProductCollectionElementMetadata
+}
+
+// BiologicallyDerivedProductCollectionCollected is the type of BiologicallyDerivedProduct.collection.collected[x]. It is implemented by the BiologicallyDerivedProductCollectionCollected* variant types.
+type BiologicallyDerivedProductCollectionCollected interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isBiologicallyDerivedProductCollectionCollected()
+}
+
+// BiologicallyDerivedProductCollectionCollectedDateTime is the dateTime variant of BiologicallyDerivedProductCollectionCollected.
+type BiologicallyDerivedProductCollectionCollectedDateTime struct {
+	DateTime
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedDateTime) isBiologicallyDerivedProductCollectionCollected() {
+}
+
+// BiologicallyDerivedProductCollectionCollectedPeriod is the Period variant of BiologicallyDerivedProductCollectionCollected.
+type BiologicallyDerivedProductCollectionCollectedPeriod struct {
+	Period
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v BiologicallyDerivedProductCollectionCollectedPeriod) isBiologicallyDerivedProductCollectionCollected() {
+}
+
+var biologicallyDerivedProductCollectionCollectedVariants = []BiologicallyDerivedProductCollectionCollected{
+	BiologicallyDerivedProductCollectionCollectedDateTime{},
+	BiologicallyDerivedProductCollectionCollectedPeriod{},
+}
+
+type BiologicallyDerivedProductProperty struct {
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                        `json:"type" bson:"type"`                                                // Code that specifies the property
+	Value             BiologicallyDerivedProductPropertyValue `json:"-" bson:"value,omitempty"`                                        // Property values
+	ValueElement      *Element                                `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of BiologicallyDerivedProduct.property.value[x] when more than one was decoded
+}
+
+func (r *BiologicallyDerivedProductProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *BiologicallyDerivedProductProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var biologicallyDerivedProductPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BiologicallyDerivedProductProperty) invariants() []invariant {
+	return biologicallyDerivedProductPropertyInvariants
+}
+
+func (r BiologicallyDerivedProductProperty) MarshalJSON() ([]byte, error) {
+	type alias BiologicallyDerivedProductProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *BiologicallyDerivedProductProperty) UnmarshalJSON(data []byte) error {
+	type alias BiologicallyDerivedProductProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", biologicallyDerivedProductPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r BiologicallyDerivedProductProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BiologicallyDerivedProductProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BiologicallyDerivedProductProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BiologicallyDerivedProductProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *BiologicallyDerivedProductProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(biologicallyDerivedProductPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var biologicallyDerivedProductPropertyElementMetadata = []ElementMetadata{
+	{Path: "BiologicallyDerivedProduct.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BiologicallyDerivedProduct.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BiologicallyDerivedProduct.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BiologicallyDerivedProduct.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "BiologicallyDerivedProduct.property.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"boolean", "integer", "CodeableConcept", "Period", "Quantity", "Range", "Ratio", "string", "Attachment"}},
+}
+
+func (r *BiologicallyDerivedProductProperty) ElementMetadata() []ElementMetadata {
+	return biologicallyDerivedProductPropertyElementMetadata
+}
+
+// BiologicallyDerivedProductPropertyValue is the type of BiologicallyDerivedProduct.property.value[x]. It is implemented by the BiologicallyDerivedProductPropertyValue* variant types.
+type BiologicallyDerivedProductPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isBiologicallyDerivedProductPropertyValue()
+}
+
+// BiologicallyDerivedProductPropertyValueBoolean is the boolean variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueBoolean bool
+
+func (v BiologicallyDerivedProductPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v BiologicallyDerivedProductPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v BiologicallyDerivedProductPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v BiologicallyDerivedProductPropertyValueBoolean) isBiologicallyDerivedProductPropertyValue() {}
+
+// BiologicallyDerivedProductPropertyValueInteger is the integer variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueInteger int
+
+func (v BiologicallyDerivedProductPropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v BiologicallyDerivedProductPropertyValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v BiologicallyDerivedProductPropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v BiologicallyDerivedProductPropertyValueInteger) isBiologicallyDerivedProductPropertyValue() {}
+
+// BiologicallyDerivedProductPropertyValueCodeableConcept is the CodeableConcept variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v BiologicallyDerivedProductPropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v BiologicallyDerivedProductPropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductPropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v BiologicallyDerivedProductPropertyValueCodeableConcept) isBiologicallyDerivedProductPropertyValue() {
+}
+
+// BiologicallyDerivedProductPropertyValuePeriod is the Period variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValuePeriod struct {
+	Period
+}
+
+func (v BiologicallyDerivedProductPropertyValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v BiologicallyDerivedProductPropertyValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductPropertyValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v BiologicallyDerivedProductPropertyValuePeriod) isBiologicallyDerivedProductPropertyValue() {}
+
+// BiologicallyDerivedProductPropertyValueQuantity is the Quantity variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueQuantity struct {
+	Quantity
+}
+
+func (v BiologicallyDerivedProductPropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v BiologicallyDerivedProductPropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductPropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v BiologicallyDerivedProductPropertyValueQuantity) isBiologicallyDerivedProductPropertyValue() {
+}
+
+// BiologicallyDerivedProductPropertyValueRange is the Range variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueRange struct {
+	Range
+}
+
+func (v BiologicallyDerivedProductPropertyValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v BiologicallyDerivedProductPropertyValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductPropertyValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v BiologicallyDerivedProductPropertyValueRange) isBiologicallyDerivedProductPropertyValue() {}
+
+// BiologicallyDerivedProductPropertyValueRatio is the Ratio variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueRatio struct {
+	Ratio
+}
+
+func (v BiologicallyDerivedProductPropertyValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v BiologicallyDerivedProductPropertyValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductPropertyValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v BiologicallyDerivedProductPropertyValueRatio) isBiologicallyDerivedProductPropertyValue() {}
+
+// BiologicallyDerivedProductPropertyValueString is the string variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueString string
+
+func (v BiologicallyDerivedProductPropertyValueString) Validate() error {
+	return nil
+}
+
+func (v BiologicallyDerivedProductPropertyValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v BiologicallyDerivedProductPropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v BiologicallyDerivedProductPropertyValueString) isBiologicallyDerivedProductPropertyValue() {}
+
+// BiologicallyDerivedProductPropertyValueAttachment is the Attachment variant of BiologicallyDerivedProductPropertyValue.
+type BiologicallyDerivedProductPropertyValueAttachment struct {
+	Attachment
+}
+
+func (v BiologicallyDerivedProductPropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v BiologicallyDerivedProductPropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v BiologicallyDerivedProductPropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v BiologicallyDerivedProductPropertyValueAttachment) isBiologicallyDerivedProductPropertyValue() {
+}
+
+var biologicallyDerivedProductPropertyValueVariants = []BiologicallyDerivedProductPropertyValue{
+	BiologicallyDerivedProductPropertyValueBoolean(false),
+	BiologicallyDerivedProductPropertyValueInteger(0),
+	BiologicallyDerivedProductPropertyValueCodeableConcept{},
+	BiologicallyDerivedProductPropertyValuePeriod{},
+	BiologicallyDerivedProductPropertyValueQuantity{},
+	BiologicallyDerivedProductPropertyValueRange{},
+	BiologicallyDerivedProductPropertyValueRatio{},
+	BiologicallyDerivedProductPropertyValueString(""),
+	BiologicallyDerivedProductPropertyValueAttachment{},
+}
@@ -2,27 +2,36 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Record details about an anatomical structure.  This resource may be used when a coded concept does not provide the necessary detail needed for the use case.
 type BodyStructure struct {
-	ResourceType      string                           `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string                          `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage                `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Identifier        []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Bodystructure identifier
-	Active            *bool                            `json:"active,omitempty" bson:"active,omitempty"`                        // Whether this record is in active use
-	IncludedStructure []BodyStructureIncludedStructure `json:"includedStructure" bson:"included_structure"`                     // Included anatomic location(s)
-	ExcludedStructure []BodyStructureIncludedStructure `json:"excludedStructure,omitempty" bson:"excluded_structure,omitempty"` // Excluded anatomic locations(s)
-	Description       *string                          `json:"description,omitempty" bson:"description,omitempty"`              // Text description
-	Image             []Attachment                     `json:"image,omitempty" bson:"image,omitempty"`                          // Attached images
-	Patient           *Reference                       `json:"patient" bson:"patient"`                                          // Who this is about
+	ResourceType         string                           `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                          `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                         `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                          `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                         `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                       `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Bodystructure identifier
+	Active               *bool                            `json:"active,omitempty" bson:"active,omitempty"`                         // Whether this record is in active use
+	ActiveElement        *Element                         `json:"_active,omitempty" bson:"active_element,omitempty"`                // Extensions for active
+	IncludedStructure    []BodyStructureIncludedStructure `json:"includedStructure" bson:"included_structure"`                      // Included anatomic location(s)
+	ExcludedStructure    []BodyStructureIncludedStructure `json:"excludedStructure,omitempty" bson:"excluded_structure,omitempty"`  // Excluded anatomic locations(s)
+	Description          *string                          `json:"description,omitempty" bson:"description,omitempty"`               // Text description
+	DescriptionElement   *Element                         `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Image                []Attachment                     `json:"image,omitempty" bson:"image,omitempty"`                           // Attached images
+	Patient              *Reference                       `json:"patient" bson:"patient"`                                           // Who this is about
 }
 
+var bodyStructurePatientTargets = []string{"Patient"}
+
 func (r *BodyStructure) Validate() error {
 	if r.ResourceType != "BodyStructure" {
 		return fmt.Errorf("invalid resourceType: expected 'BodyStructure', got '%s'", r.ResourceType)
@@ -32,16 +41,49 @@ func (r *BodyStructure) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	if len(r.IncludedStructure) < 1 {
 		return fmt.Errorf("field 'IncludedStructure' must have at least 1 elements")
 	}
@@ -55,6 +97,11 @@ func (r *BodyStructure) Validate() error {
 			return fmt.Errorf("ExcludedStructure[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Image {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Image[%d]: %w", i, err)
@@ -63,16 +110,214 @@ func (r *BodyStructure) Validate() error {
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, bodyStructurePatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, bodyStructurePatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
 		}
 	}
+	return validateInvariants(r, "BodyStructure")
+}
+
+func (r *BodyStructure) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "BodyStructure" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'BodyStructure', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	if len(r.IncludedStructure) < 1 {
+		issues.add("required", path+".includedStructure", "field 'IncludedStructure' must have at least 1 elements")
+	}
+	for i, item := range r.IncludedStructure {
+		item.validateAll(fmt.Sprintf("%s.includedStructure[%d]", path, i), issues)
+	}
+	for i, item := range r.ExcludedStructure {
+		item.validateAll(fmt.Sprintf("%s.excludedStructure[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Image {
+		item.validateAll(fmt.Sprintf("%s.image[%d]", path, i), issues)
+	}
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, bodyStructurePatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, bodyStructurePatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+}
+
+func (r *BodyStructure) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("BodyStructure", &issues)
+	checkInvariants(r, "BodyStructure", &issues)
+	return issues
+}
+
+var bodyStructureInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *BodyStructure) invariants() []invariant {
+	return bodyStructureInvariants
+}
+
+func (r *BodyStructure) UnmarshalJSON(data []byte) error {
+	type alias BodyStructure
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r BodyStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BodyStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BodyStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BodyStructure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bodyStructureElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *BodyStructure) elementTypes() map[string]string {
+	return bodyStructureElementTypes
+}
+
+var bodyStructureElementMetadata = []ElementMetadata{
+	{Path: "BodyStructure.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "BodyStructure.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "BodyStructure.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "BodyStructure.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "BodyStructure.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "BodyStructure.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "BodyStructure.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "BodyStructure.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BodyStructure.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BodyStructure.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "BodyStructure.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "BodyStructure.includedStructure", Name: "includedStructure", Field: 14, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "BodyStructure.excludedStructure", Name: "excludedStructure", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "BodyStructure.description", Name: "description", Field: 16, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "BodyStructure.image", Name: "image", Field: 18, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "BodyStructure.patient", Name: "patient", Field: 19, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+}
+
+func (r *BodyStructure) ElementMetadata() []ElementMetadata {
+	return bodyStructureElementMetadata
+}
+
+func (r *BodyStructure) GetResourceType() string {
+	return "BodyStructure"
+}
+
+func (r *BodyStructure) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *BodyStructure) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *BodyStructure) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *BodyStructure) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *BodyStructure) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *BodyStructure) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *BodyStructure) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*BodyStructure)(nil)
+
 type BodyStructureIncludedStructure struct {
 	Id                      *string                                                 `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension               []Extension                                             `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension       []Extension                                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
 	Structure               *CodeableConcept                                        `json:"structure" bson:"structure"`                                                   // Code that represents the included structure
 	Laterality              *CodeableConcept                                        `json:"laterality,omitempty" bson:"laterality,omitempty"`                             // Code that represents the included structure laterality
 	BodyLandmarkOrientation []BodyStructureIncludedStructureBodyLandmarkOrientation `json:"bodyLandmarkOrientation,omitempty" bson:"body_landmark_orientation,omitempty"` // Landmark relative location
@@ -83,6 +328,16 @@ type BodyStructureIncludedStructure struct {
 }
 
 func (r *BodyStructureIncludedStructure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Structure == nil {
 		return fmt.Errorf("field 'Structure' is required")
 	}
@@ -124,8 +379,84 @@ func (r *BodyStructureIncludedStructure) Validate() error {
 	return nil
 }
 
+func (r *BodyStructureIncludedStructure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Structure == nil {
+		issues.add("required", path+".structure", "field 'Structure' is required")
+	}
+	if r.Structure != nil {
+		r.Structure.validateAll(path+".structure", issues)
+	}
+	if r.Laterality != nil {
+		r.Laterality.validateAll(path+".laterality", issues)
+	}
+	for i, item := range r.BodyLandmarkOrientation {
+		item.validateAll(fmt.Sprintf("%s.bodyLandmarkOrientation[%d]", path, i), issues)
+	}
+	for i, item := range r.SpatialReference {
+		item.validateAll(fmt.Sprintf("%s.spatialReference[%d]", path, i), issues)
+	}
+	for i, item := range r.Image {
+		item.validateAll(fmt.Sprintf("%s.image[%d]", path, i), issues)
+	}
+	for i, item := range r.Qualifier {
+		item.validateAll(fmt.Sprintf("%s.qualifier[%d]", path, i), issues)
+	}
+	if r.Morphology != nil {
+		r.Morphology.validateAll(path+".morphology", issues)
+	}
+}
+
+var bodyStructureIncludedStructureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BodyStructureIncludedStructure) invariants() []invariant {
+	return bodyStructureIncludedStructureInvariants
+}
+
+func (r BodyStructureIncludedStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BodyStructureIncludedStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BodyStructureIncludedStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BodyStructureIncludedStructure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bodyStructureIncludedStructureElementMetadata = []ElementMetadata{
+	{Path: "BodyStructure.includedStructure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BodyStructure.includedStructure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BodyStructure.includedStructure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BodyStructure.includedStructure.structure", Name: "structure", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "BodyStructure.includedStructure.laterality", Name: "laterality", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation", Name: "bodyLandmarkOrientation", Field: 5, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "BodyStructure.includedStructure.spatialReference", Name: "spatialReference", Field: 6, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "BodyStructure.includedStructure.image", Name: "image", Field: 7, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "BodyStructure.includedStructure.qualifier", Name: "qualifier", Field: 8, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "BodyStructure.includedStructure.morphology", Name: "morphology", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *BodyStructureIncludedStructure) ElementMetadata() []ElementMetadata {
+	return bodyStructureIncludedStructureElementMetadata
+}
+
 type BodyStructureIncludedStructureBodyLandmarkOrientation struct {
 	Id                   *string                                                                     `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension            []Extension                                                                 `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension    []Extension                                                                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
 	LandmarkDescription  []CodeableConcept                                                           `json:"landmarkDescription,omitempty" bson:"landmark_description,omitempty"`    // Explanation of landmark
 	ClockFacePosition    []CodeableConcept                                                           `json:"clockFacePosition,omitempty" bson:"clock_face_position,omitempty"`       // Clockface orientation
 	DistanceFromLandmark []BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark `json:"distanceFromLandmark,omitempty" bson:"distance_from_landmark,omitempty"` // Landmark relative location
@@ -133,6 +464,16 @@ type BodyStructureIncludedStructureBodyLandmarkOrientation struct {
 }
 
 func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.LandmarkDescription {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("LandmarkDescription[%d]: %w", i, err)
@@ -156,13 +497,84 @@ func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) Validate() error
 	return nil
 }
 
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.LandmarkDescription {
+		item.validateAll(fmt.Sprintf("%s.landmarkDescription[%d]", path, i), issues)
+	}
+	for i, item := range r.ClockFacePosition {
+		item.validateAll(fmt.Sprintf("%s.clockFacePosition[%d]", path, i), issues)
+	}
+	for i, item := range r.DistanceFromLandmark {
+		item.validateAll(fmt.Sprintf("%s.distanceFromLandmark[%d]", path, i), issues)
+	}
+	for i, item := range r.SurfaceOrientation {
+		item.validateAll(fmt.Sprintf("%s.surfaceOrientation[%d]", path, i), issues)
+	}
+}
+
+var bodyStructureIncludedStructureBodyLandmarkOrientationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) invariants() []invariant {
+	return bodyStructureIncludedStructureBodyLandmarkOrientationInvariants
+}
+
+func (r BodyStructureIncludedStructureBodyLandmarkOrientation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BodyStructureIncludedStructureBodyLandmarkOrientation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bodyStructureIncludedStructureBodyLandmarkOrientationElementMetadata = []ElementMetadata{
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.landmarkDescription", Name: "landmarkDescription", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.clockFacePosition", Name: "clockFacePosition", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.distanceFromLandmark", Name: "distanceFromLandmark", Field: 5, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.surfaceOrientation", Name: "surfaceOrientation", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientation) ElementMetadata() []ElementMetadata {
+	return bodyStructureIncludedStructureBodyLandmarkOrientationElementMetadata
+}
+
 type BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark struct {
-	Id     *string             `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Device []CodeableReference `json:"device,omitempty" bson:"device,omitempty"` // Measurement device
-	Value  []Quantity          `json:"value,omitempty" bson:"value,omitempty"`   // Measured distance from body landmark
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Device            []CodeableReference `json:"device,omitempty" bson:"device,omitempty"`                        // Measurement device
+	Value             []Quantity          `json:"value,omitempty" bson:"value,omitempty"`                          // Measured distance from body landmark
 }
 
 func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Device {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Device[%d]: %w", i, err)
@@ -175,3 +587,54 @@ func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandma
 	}
 	return nil
 }
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Device {
+		item.validateAll(fmt.Sprintf("%s.device[%d]", path, i), issues)
+	}
+	for i, item := range r.Value {
+		item.validateAll(fmt.Sprintf("%s.value[%d]", path, i), issues)
+	}
+}
+
+var bodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmarkInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) invariants() []invariant {
+	return bodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmarkInvariants
+}
+
+func (r BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmarkElementMetadata = []ElementMetadata{
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.distanceFromLandmark.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.distanceFromLandmark.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.distanceFromLandmark.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.distanceFromLandmark.device", Name: "device", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "BodyStructure.includedStructure.bodyLandmarkOrientation.distanceFromLandmark.value", Name: "value", Field: 4, Min: 0, Max: "*", Types: []string{"Quantity"}},
+}
+
+func (r *BodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmark) ElementMetadata() []ElementMetadata {
+	return bodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmarkElementMetadata
+}
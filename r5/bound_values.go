@@ -0,0 +1,27 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+)
+
+// bindingLocations returns the items of the element of binding in res, a
+// pointer to a resource struct, with their locations.
+func bindingLocations(res any, binding *BindingDefinition) []locatedValue {
+	nodes := objectNode(res)
+	if len(nodes) != 1 || !nodes[0].object.IsValid() {
+		return nil
+	}
+	resourceType, _, _ := strings.Cut(binding.Path, ".")
+	return profileLocations(locatedValue{value: nodes[0].object, path: resourceType}, resourceType, binding.Path)
+}
+
+// boundCode returns the code of item when it is the value of a code
+// element, or of the code variant of a choice element.
+func boundCode(item locatedValue) (string, bool) {
+	if item.fhirType != "" && item.fhirType != "code" || item.value.Kind() != reflect.String {
+		return "", false
+	}
+	code, ok := primitiveString(item.value)
+	return code, ok && strings.TrimSpace(code) != ""
+}
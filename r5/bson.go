@@ -0,0 +1,546 @@
+package models
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// BSON element types, from the BSON specification.
+const (
+	bsonDouble   = 0x01
+	bsonString   = 0x02
+	bsonDocument = 0x03
+	bsonArray    = 0x04
+	bsonBinary   = 0x05
+	bsonUndef    = 0x06
+	bsonObjectID = 0x07
+	bsonBoolean  = 0x08
+	bsonDateTime = 0x09
+	bsonNull     = 0x0A
+	bsonRegex    = 0x0B
+	bsonPointer  = 0x0C
+	bsonCode     = 0x0D
+	bsonSymbol   = 0x0E
+	bsonScope    = 0x0F
+	bsonInt32    = 0x10
+	bsonStamp    = 0x11
+	bsonInt64    = 0x12
+	bsonDecimal  = 0x13
+	bsonMaxKey   = 0x7F
+	bsonMinKey   = 0xFF
+)
+
+// bsonField is a field of a generated struct as its BSON document holds it,
+// under the name of its bson tag.
+type bsonField struct {
+	key          string
+	index        int
+	omitEmpty    bool
+	resourceType bool          // the ResourceType of a resource, never left empty
+	variants     []choiceValue // a choice element, written as key_<type>
+}
+
+// bsonStruct holds the fields of a generated struct type, and the field and
+// choice variant each document key decodes into.
+type bsonStruct struct {
+	fields []bsonField
+	keys   map[string]bsonKey
+}
+
+type bsonKey struct {
+	field   int
+	variant choiceValue
+}
+
+var bsonStructCache sync.Map
+
+func bsonStructOf(t reflect.Type) *bsonStruct {
+	if cached, ok := bsonStructCache.Load(t); ok {
+		return cached.(*bsonStruct)
+	}
+	var choices choiceStruct
+	if c, ok := reflect.New(t).Interface().(choiceStruct); ok {
+		choices = c
+	}
+	s := &bsonStruct{keys: make(map[string]bsonKey)}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key, opts, _ := strings.Cut(sf.Tag.Get("bson"), ",")
+		if !sf.IsExported() || key == "" || key == "-" {
+			continue
+		}
+		field := bsonField{key: key, index: i, omitEmpty: opts == "omitempty", resourceType: sf.Name == "ResourceType"}
+		if sf.Type.Kind() == reflect.Interface && sf.Type.Implements(choiceValueType) {
+			if choices == nil {
+				continue
+			}
+			if _, field.variants = choices.choiceVariants(sf.Name); field.variants == nil {
+				continue
+			}
+			for _, variant := range field.variants {
+				s.keys[choiceBSONKey(key, variant.FHIRType())] = bsonKey{field: len(s.fields), variant: variant}
+			}
+		} else {
+			s.keys[key] = bsonKey{field: len(s.fields)}
+		}
+		s.fields = append(s.fields, field)
+	}
+	bsonStructCache.Store(t, s)
+	return s
+}
+
+// choiceBSONKey returns the key of a choice element holding a value of the
+// given FHIR type: value + CodeableConcept gives "value_codeable_concept".
+func choiceBSONKey(key, fhirType string) string {
+	var b strings.Builder
+	b.WriteString(key)
+	b.WriteByte('_')
+	for i, r := range fhirType {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// marshalBSON encodes the generated struct v points to as a BSON document.
+// Decimals and dates are written as strings to keep their precision, choice
+// elements under the key of the type they hold and resources as documents
+// carrying their resource_type.
+func marshalBSON(v any) ([]byte, error) {
+	return appendBSONDocument(nil, reflect.ValueOf(v).Elem())
+}
+
+func appendBSONDocument(dst []byte, v reflect.Value) ([]byte, error) {
+	start := len(dst)
+	dst = append(dst, 0, 0, 0, 0)
+	var err error
+	for _, f := range bsonStructOf(v.Type()).fields {
+		fv := v.Field(f.index)
+		switch {
+		case f.variants != nil:
+			if fv.IsNil() {
+				continue
+			}
+			key := choiceBSONKey(f.key, fv.Interface().(choiceValue).FHIRType())
+			dst, err = appendBSONValue(dst, key, fv.Elem())
+		case f.resourceType:
+			dst = appendBSONString(appendBSONKey(dst, bsonString, f.key), resourceTypeOf(v))
+		case f.omitEmpty && isEmptyBSONValue(fv):
+			continue
+		default:
+			dst, err = appendBSONValue(dst, f.key, fv)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	dst = append(dst, 0)
+	binary.LittleEndian.PutUint32(dst[start:], uint32(len(dst)-start))
+	return dst, nil
+}
+
+// appendBSONValue appends the element key holding v.
+func appendBSONValue(dst []byte, key string, v reflect.Value) ([]byte, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return appendBSONKey(dst, bsonNull, key), nil
+		}
+		v = v.Elem()
+	}
+	if isPrimitiveStruct(v.Type()) {
+		return appendBSONString(appendBSONKey(dst, bsonString, key), v.Interface().(fmt.Stringer).String()), nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return appendBSONString(appendBSONKey(dst, bsonString, key), v.String()), nil
+	case reflect.Bool:
+		b := byte(0)
+		if v.Bool() {
+			b = 1
+		}
+		return append(appendBSONKey(dst, bsonBoolean, key), b), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := v.Int()
+		if v.Kind() != reflect.Int64 && n >= math.MinInt32 && n <= math.MaxInt32 {
+			return binary.LittleEndian.AppendUint32(appendBSONKey(dst, bsonInt32, key), uint32(int32(n))), nil
+		}
+		return binary.LittleEndian.AppendUint64(appendBSONKey(dst, bsonInt64, key), uint64(n)), nil
+	case reflect.Float32, reflect.Float64:
+		return binary.LittleEndian.AppendUint64(appendBSONKey(dst, bsonDouble, key), math.Float64bits(v.Float())), nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return appendBSONKey(dst, bsonNull, key), nil
+		}
+		dst = appendBSONKey(dst, bsonArray, key)
+		start := len(dst)
+		dst = append(dst, 0, 0, 0, 0)
+		var err error
+		for i := 0; i < v.Len(); i++ {
+			if dst, err = appendBSONValue(dst, strconv.Itoa(i), v.Index(i)); err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", key, i, err)
+			}
+		}
+		dst = append(dst, 0)
+		binary.LittleEndian.PutUint32(dst[start:], uint32(len(dst)-start))
+		return dst, nil
+	case reflect.Struct:
+		if v.NumField() == 1 && v.Type().Field(0).Anonymous {
+			// a choice variant embedding the type it holds
+			return appendBSONValue(dst, key, v.Field(0))
+		}
+		out, err := appendBSONDocument(appendBSONKey(dst, bsonDocument, key), v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("%s: cannot encode %s as BSON", key, v.Type())
+}
+
+func appendBSONKey(dst []byte, kind byte, key string) []byte {
+	dst = append(dst, kind)
+	dst = append(dst, key...)
+	return append(dst, 0)
+}
+
+func appendBSONString(dst []byte, s string) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(s)+1))
+	dst = append(dst, s...)
+	return append(dst, 0)
+}
+
+// isEmptyBSONValue reports whether a field tagged omitempty is left out:
+// nil pointers and slices, empty strings and slices, false, zero numbers
+// and zero decimals and dates.
+func isEmptyBSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Struct:
+		return isPrimitiveStruct(v.Type()) && v.IsZero()
+	}
+	return false
+}
+
+// bsonElement is an element of a BSON document: its type, key and the
+// bytes of its value.
+type bsonElement struct {
+	kind  byte
+	key   string
+	value []byte
+}
+
+// unmarshalBSON decodes a BSON document written by marshalBSON into the
+// generated struct v points to. Keys without a field, such as the _id
+// MongoDB adds, are ignored.
+func unmarshalBSON(data []byte, v any) error {
+	return readBSONDocument(data, reflect.ValueOf(v).Elem(), "")
+}
+
+func readBSONDocument(data []byte, v reflect.Value, path string) error {
+	elements, err := bsonElements(data)
+	if err != nil {
+		return bsonError(path, err)
+	}
+	s := bsonStructOf(v.Type())
+	for _, el := range elements {
+		k, ok := s.keys[el.key]
+		if !ok {
+			continue
+		}
+		f := s.fields[k.field]
+		fv := v.Field(f.index)
+		elPath := childPath(path, el.key)
+		if k.variant == nil {
+			if err := readBSONValue(el, fv, elPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if !fv.IsNil() || el.kind == bsonNull {
+			// a second variant of the same choice; the first is kept
+			continue
+		}
+		item := reflect.New(reflect.TypeOf(k.variant)).Elem()
+		if err := readBSONValue(el, item, elPath); err != nil {
+			return err
+		}
+		fv.Set(item)
+	}
+	return nil
+}
+
+// readBSONValue decodes the value of the element el into v.
+func readBSONValue(el bsonElement, v reflect.Value, path string) error {
+	if el.kind == bsonNull || el.kind == bsonUndef {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	switch {
+	case v.Kind() == reflect.Pointer:
+		target := reflect.New(v.Type().Elem())
+		if err := readBSONValue(el, target.Elem(), path); err != nil {
+			return err
+		}
+		v.Set(target)
+		return nil
+	case v.Kind() == reflect.Interface:
+		return readBSONResource(el, v, path)
+	case isPrimitiveStruct(v.Type()):
+		s, err := bsonLexical(el)
+		if err != nil {
+			return bsonError(path, err)
+		}
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if err := setPrimitive(v, s); err != nil {
+			return bsonError(path, err)
+		}
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if el.kind != bsonString {
+			return bsonError(path, fmt.Errorf("cannot decode BSON type 0x%02x into a string", el.kind))
+		}
+		s, err := bsonStringValue(el.value)
+		if err != nil {
+			return bsonError(path, err)
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		if el.kind != bsonBoolean {
+			return bsonError(path, fmt.Errorf("cannot decode BSON type 0x%02x into a boolean", el.kind))
+		}
+		v.SetBool(el.value[0] != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := bsonInteger(el)
+		if err != nil {
+			return bsonError(path, err)
+		}
+		if v.OverflowInt(n) {
+			return bsonError(path, fmt.Errorf("integer %d overflows %s", n, v.Type()))
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		switch el.kind {
+		case bsonDouble:
+			v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(el.value)))
+		default:
+			n, err := bsonInteger(el)
+			if err != nil {
+				return bsonError(path, err)
+			}
+			v.SetFloat(float64(n))
+		}
+	case reflect.Slice:
+		if el.kind != bsonArray {
+			return bsonError(path, fmt.Errorf("cannot decode BSON type 0x%02x into a list", el.kind))
+		}
+		items, err := bsonElements(el.value)
+		if err != nil {
+			return bsonError(path, err)
+		}
+		list := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := readBSONValue(item, list.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(list)
+	case reflect.Struct:
+		if v.NumField() == 1 && v.Type().Field(0).Anonymous {
+			return readBSONValue(el, v.Field(0), path)
+		}
+		if el.kind != bsonDocument {
+			return bsonError(path, fmt.Errorf("cannot decode BSON type 0x%02x into %s", el.kind, v.Type()))
+		}
+		return readBSONDocument(el.value, v, path)
+	default:
+		return bsonError(path, fmt.Errorf("cannot decode BSON into %s", v.Type()))
+	}
+	return nil
+}
+
+// readBSONResource decodes a resource document into a new value of the
+// type named by its resource_type, through the resource registry.
+func readBSONResource(el bsonElement, v reflect.Value, path string) error {
+	if el.kind != bsonDocument {
+		return bsonError(path, fmt.Errorf("cannot decode BSON type 0x%02x into a resource", el.kind))
+	}
+	elements, err := bsonElements(el.value)
+	if err != nil {
+		return bsonError(path, err)
+	}
+	var resourceType string
+	for _, child := range elements {
+		if child.key == "resource_type" && child.kind == bsonString {
+			resourceType, _ = bsonStringValue(child.value)
+		}
+	}
+	if resourceType == "" {
+		return bsonError(path, errors.New("resource without resource_type"))
+	}
+	if newResourceValue == nil {
+		return bsonError(path, errors.New("no resource registry"))
+	}
+	res, ok := newResourceValue(resourceType)
+	if !ok {
+		return bsonError(path, fmt.Errorf("unknown resource type %q", resourceType))
+	}
+	rv := reflect.ValueOf(res)
+	if !rv.Type().AssignableTo(v.Type()) {
+		return bsonError(path, fmt.Errorf("%s cannot be held in %s", resourceType, v.Type()))
+	}
+	if err := readBSONDocument(el.value, rv.Elem(), path); err != nil {
+		return err
+	}
+	v.Set(rv)
+	return nil
+}
+
+// bsonLexical returns the lexical form of a decimal or date held in el, which
+// marshalBSON writes as a string. Decimals written as numbers by other
+// tools are read too.
+func bsonLexical(el bsonElement) (string, error) {
+	switch el.kind {
+	case bsonString:
+		return bsonStringValue(el.value)
+	case bsonDouble:
+		return strconv.FormatFloat(math.Float64frombits(binary.LittleEndian.Uint64(el.value)), 'f', -1, 64), nil
+	case bsonInt32, bsonInt64:
+		n, err := bsonInteger(el)
+		return strconv.FormatInt(n, 10), err
+	}
+	return "", fmt.Errorf("cannot decode BSON type 0x%02x into a primitive", el.kind)
+}
+
+func bsonInteger(el bsonElement) (int64, error) {
+	switch el.kind {
+	case bsonInt32:
+		return int64(int32(binary.LittleEndian.Uint32(el.value))), nil
+	case bsonInt64:
+		return int64(binary.LittleEndian.Uint64(el.value)), nil
+	case bsonDouble:
+		f := math.Float64frombits(binary.LittleEndian.Uint64(el.value))
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, fmt.Errorf("%v is not an integer", f)
+		}
+		return int64(f), nil
+	}
+	return 0, fmt.Errorf("cannot decode BSON type 0x%02x into an integer", el.kind)
+}
+
+func bsonStringValue(value []byte) (string, error) {
+	if len(value) < 5 || value[len(value)-1] != 0 {
+		return "", errors.New("malformed BSON string")
+	}
+	return string(value[4 : len(value)-1]), nil
+}
+
+// bsonElements splits a BSON document, or array, into its elements.
+func bsonElements(doc []byte) ([]bsonElement, error) {
+	if len(doc) < 5 || int(binary.LittleEndian.Uint32(doc)) != len(doc) || doc[len(doc)-1] != 0 {
+		return nil, errors.New("malformed BSON document")
+	}
+	var elements []bsonElement
+	rest := doc[4 : len(doc)-1]
+	for len(rest) > 0 {
+		kind := rest[0]
+		end := 1
+		for end < len(rest) && rest[end] != 0 {
+			end++
+		}
+		if end == len(rest) {
+			return nil, errors.New("malformed BSON key")
+		}
+		key := string(rest[1:end])
+		rest = rest[end+1:]
+		size, err := bsonValueSize(kind, rest)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		elements = append(elements, bsonElement{kind: kind, key: key, value: rest[:size]})
+		rest = rest[size:]
+	}
+	return elements, nil
+}
+
+// bsonValueSize returns the length of the value of type kind at the start
+// of data.
+func bsonValueSize(kind byte, data []byte) (int, error) {
+	var size int
+	switch kind {
+	case bsonNull, bsonUndef, bsonMinKey, bsonMaxKey:
+		size = 0
+	case bsonBoolean:
+		size = 1
+	case bsonInt32:
+		size = 4
+	case bsonDouble, bsonDateTime, bsonStamp, bsonInt64:
+		size = 8
+	case bsonObjectID:
+		size = 12
+	case bsonDecimal:
+		size = 16
+	case bsonString, bsonCode, bsonSymbol, bsonBinary, bsonPointer:
+		if len(data) < 4 {
+			return 0, errors.New("truncated BSON value")
+		}
+		size = 4 + int(binary.LittleEndian.Uint32(data))
+		switch kind {
+		case bsonBinary:
+			size++
+		case bsonPointer:
+			size += 12
+		}
+	case bsonDocument, bsonArray, bsonScope:
+		if len(data) < 4 {
+			return 0, errors.New("truncated BSON value")
+		}
+		size = int(binary.LittleEndian.Uint32(data))
+	case bsonRegex:
+		for n := 0; n < 2; n++ {
+			end := size
+			for end < len(data) && data[end] != 0 {
+				end++
+			}
+			if end == len(data) {
+				return 0, errors.New("truncated BSON value")
+			}
+			size = end + 1
+		}
+	default:
+		return 0, fmt.Errorf("unknown BSON type 0x%02x", kind)
+	}
+	if size < 0 || size > len(data) {
+		return 0, errors.New("truncated BSON value")
+	}
+	return size, nil
+}
+
+func bsonError(path string, err error) error {
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %w", path, err)
+}
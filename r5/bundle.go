@@ -2,24 +2,30 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A container for a collection of resources.
 type Bundle struct {
-	ResourceType  string          `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string         `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta           `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string         `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Identifier    *Identifier     `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Persistent identifier for the bundle
-	Type          string          `json:"type" bson:"type"`                                        // document | message | transaction | transaction-response | batch | batch-response | history | searchset | collection | subscription-notification
-	Timestamp     *string         `json:"timestamp,omitempty" bson:"timestamp,omitempty"`          // When the bundle was assembled
-	Total         *int            `json:"total,omitempty" bson:"total,omitempty"`                  // Total matches across all pages
-	Link          []BundleLink    `json:"link,omitempty" bson:"link,omitempty"`                    // Links related to this Bundle
-	Entry         []BundleEntry   `json:"entry,omitempty" bson:"entry,omitempty"`                  // Entry in the bundle - will have a resource or information
-	Signature     *Signature      `json:"signature,omitempty" bson:"signature,omitempty"`          // Digital Signature (deprecated: use Provenance Signatures)
-	Issues        json.RawMessage `json:"issues,omitempty" bson:"issues,omitempty"`                // OperationOutcome with issues about the Bundle
+	ResourceType         string        `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string       `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta         `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element      `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string       `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element      `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Identifier           *Identifier   `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Persistent identifier for the bundle
+	Type                 BundleType    `json:"type" bson:"type"`                                                 // document | message | transaction | transaction-response | batch | batch-response | history | searchset | collection | subscription-notification
+	TypeElement          *Element      `json:"_type,omitempty" bson:"type_element,omitempty"`                    // Extensions for type
+	Timestamp            *Instant      `json:"timestamp,omitempty" bson:"timestamp,omitempty"`                   // When the bundle was assembled
+	TimestampElement     *Element      `json:"_timestamp,omitempty" bson:"timestamp_element,omitempty"`          // Extensions for timestamp
+	Total                *int          `json:"total,omitempty" bson:"total,omitempty"`                           // Total matches across all pages
+	TotalElement         *Element      `json:"_total,omitempty" bson:"total_element,omitempty"`                  // Extensions for total
+	Link                 []BundleLink  `json:"link,omitempty" bson:"link,omitempty"`                             // Links related to this Bundle
+	Entry                []BundleEntry `json:"entry,omitempty" bson:"entry,omitempty"`                           // Entry in the bundle - will have a resource or information
+	Signature            *Signature    `json:"signature,omitempty" bson:"signature,omitempty"`                   // Digital Signature (deprecated: use Provenance Signatures)
+	Issues               Resource      `json:"issues,omitempty" bson:"issues,omitempty"`                         // OperationOutcome with issues about the Bundle
 }
 
 func (r *Bundle) Validate() error {
@@ -31,15 +37,47 @@ func (r *Bundle) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Type == emptyString {
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.Timestamp != nil {
+		if err := r.Timestamp.Validate(); err != nil {
+			return fmt.Errorf("Timestamp: %w", err)
+		}
+	}
+	if r.TimestampElement != nil {
+		if err := r.TimestampElement.Validate(); err != nil {
+			return fmt.Errorf("TimestampElement: %w", err)
+		}
+	}
+	if r.TotalElement != nil {
+		if err := r.TotalElement.Validate(); err != nil {
+			return fmt.Errorf("TotalElement: %w", err)
+		}
+	}
 	for i, item := range r.Link {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Link[%d]: %w", i, err)
@@ -55,42 +93,333 @@ func (r *Bundle) Validate() error {
 			return fmt.Errorf("Signature: %w", err)
 		}
 	}
+	if r.Issues != nil {
+		if err := r.Issues.Validate(); err != nil {
+			return fmt.Errorf("Issues: %w", err)
+		}
+	}
+	return validateInvariants(r, "Bundle")
+}
+
+func (r *Bundle) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Bundle" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Bundle', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Timestamp != nil {
+		if err := r.Timestamp.Validate(); err != nil {
+			issues.add("value", path+".timestamp", err.Error())
+		}
+	}
+	if r.TimestampElement != nil {
+		r.TimestampElement.validateAll(path+".timestamp", issues)
+	}
+	if r.TotalElement != nil {
+		r.TotalElement.validateAll(path+".total", issues)
+	}
+	for i, item := range r.Link {
+		item.validateAll(fmt.Sprintf("%s.link[%d]", path, i), issues)
+	}
+	for i, item := range r.Entry {
+		item.validateAll(fmt.Sprintf("%s.entry[%d]", path, i), issues)
+	}
+	if r.Signature != nil {
+		r.Signature.validateAll(path+".signature", issues)
+	}
+	if r.Issues != nil {
+		issues.addNested(path+".issues", r.Issues.ValidateAll())
+	}
+}
+
+func (r *Bundle) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Bundle", &issues)
+	checkInvariants(r, "Bundle", &issues)
+	return issues
+}
+
+var bundleInvariants = []invariant{
+	{key: "bdl-1", severity: "error", human: "total only when a search or history", expression: "total.empty() or (type = 'searchset') or (type = 'history')"},
+	{key: "bdl-2", severity: "error", human: "entry.search only when a search", expression: "(type = 'searchset') or entry.search.empty()"},
+	{key: "bdl-7", severity: "error", human: "FullUrl must be unique in a bundle, or else entries with the same fullUrl must have different meta.versionId (except in history bundles)", expression: "(type = 'history') or entry.where(fullUrl.exists()).select(fullUrl&iif(resource.meta.versionId.exists(), resource.meta.versionId, '')).isDistinct()"},
+	{key: "bdl-9", severity: "error", human: "A document must have an identifier with a system and a value", expression: "type = 'document' implies (identifier.system.exists() and identifier.value.exists())"},
+	{key: "bdl-10", severity: "error", human: "A document must have a date", expression: "type = 'document' implies (timestamp.hasValue())"},
+	{key: "bdl-11", severity: "error", human: "A document must have a Composition as the first resource", expression: "type = 'document' implies entry.first().resource.is(Composition)"},
+	{key: "bdl-12", severity: "error", human: "A message must have a MessageHeader as the first resource", expression: "type = 'message' implies entry.first().resource.is(MessageHeader)"},
+	{key: "bdl-13", severity: "error", human: "A subscription-notification must have a SubscriptionStatus as the first resource", expression: "type = 'subscription-notification' implies entry.first().resource.is(SubscriptionStatus)"},
+	{key: "bdl-14", severity: "error", human: "entry.request.method PATCH not allowed for history", expression: "type = 'history' implies entry.request.method != 'PATCH'"},
+	{key: "bdl-15", severity: "error", human: "Bundle resources where type is not transaction, transaction-response, batch, or batch-response or when the request is a POST SHALL have Bundle.entry.fullUrl populated", expression: "type='transaction' or type='transaction-response' or type='batch' or type='batch-response' or entry.all(fullUrl.exists() or request.method='POST')"},
+	{key: "bdl-16", severity: "error", human: "Issue.severity for all issues within the OperationOutcome must be either 'information' or 'warning'.", expression: "issues.exists() implies (issues.issue.severity = 'information' or issues.issue.severity = 'warning')"},
+	{key: "bdl-17", severity: "error", human: "Use and meaning of issues for documents has not been validated because the content will not be rendered in the document.", expression: "type = 'document' implies issues.empty()"},
+	{key: "bdl-18", severity: "error", human: "Self link is required for searchsets.", expression: "type = 'searchset' implies link.where(relation = 'self' and url.exists()).exists()"},
+	{key: "bdl-3a", severity: "error", human: "For collections of type document, message, searchset or collection, all entries must contain resources, and not have request or response elements", expression: "type in ('document' | 'message' | 'searchset' | 'collection') implies entry.all(resource.exists() and request.empty() and response.empty())"},
+	{key: "bdl-3b", severity: "error", human: "For collections of type history, all entries must contain request or response elements, and resources if the method is POST, PUT or PATCH", expression: "type = 'history' implies entry.all(request.exists() and response.exists() and ((request.method in ('POST' | 'PATCH' | 'PUT')) = resource.exists()))"},
+	{key: "bdl-3c", severity: "error", human: "For collections of type transaction or batch, all entries must contain request elements, and resources if the method is POST, PUT or PATCH", expression: "type in ('transaction' | 'batch') implies entry.all(request.method.exists() and ((request.method in ('POST' | 'PATCH' | 'PUT')) = resource.exists()))"},
+	{key: "bdl-3d", severity: "error", human: "For collections of type transaction-response or batch-response, all entries must contain response elements", expression: "type in ('transaction-response' | 'batch-response') implies entry.all(response.exists())"},
+}
+
+func (r *Bundle) invariants() []invariant {
+	return bundleInvariants
+}
+
+func (r *Bundle) UnmarshalJSON(data []byte) error {
+	type alias Bundle
+	aux := struct {
+		*alias
+		Issues json.RawMessage `json:"issues,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Issues, err = unmarshalOptionalResource(aux.Issues); err != nil {
+		return fmt.Errorf("issues: %w", err)
+	}
 	return nil
 }
 
+func (r Bundle) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Bundle) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Bundle) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Bundle) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bundleElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"total":         "unsignedInt",
+}
+
+func (r *Bundle) elementTypes() map[string]string {
+	return bundleElementTypes
+}
+
+var bundleElementMetadata = []ElementMetadata{
+	{Path: "Bundle.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Bundle.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Bundle.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Bundle.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Bundle.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Bundle.identifier", Name: "identifier", Field: 7, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Bundle.type", Name: "type", Field: 8, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Bundle.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/bundle-type"}},
+	{Path: "Bundle.timestamp", Name: "timestamp", Field: 10, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Bundle.total", Name: "total", Field: 12, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Bundle.link", Name: "link", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Bundle.entry", Name: "entry", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Bundle.signature", Name: "signature", Field: 16, Min: 0, Max: "1", Types: []string{"Signature"}},
+	{Path: "Bundle.issues", Name: "issues", Field: 17, Min: 0, Max: "1", Types: []string{"Resource"}},
+}
+
+func (r *Bundle) ElementMetadata() []ElementMetadata {
+	return bundleElementMetadata
+}
+
+func (r *Bundle) GetResourceType() string {
+	return "Bundle"
+}
+
+func (r *Bundle) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Bundle) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Bundle) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Bundle) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+var _ Resource = (*Bundle)(nil)
+
 type BundleLink struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Relation string  `json:"relation" bson:"relation"`         // See http://www.iana.org/assignments/link-relations/link-relations.xhtml#link-relations-1
-	Url      string  `json:"url" bson:"url"`                   // Reference details for the link
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Relation          string      `json:"relation" bson:"relation"`                                        // See http://www.iana.org/assignments/link-relations/link-relations.xhtml#link-relations-1
+	RelationElement   *Element    `json:"_relation,omitempty" bson:"relation_element,omitempty"`           // Extensions for relation
+	Url               string      `json:"url" bson:"url"`                                                  // Reference details for the link
+	UrlElement        *Element    `json:"_url,omitempty" bson:"url_element,omitempty"`                     // Extensions for url
 }
 
 func (r *BundleLink) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Relation == emptyString {
 		return fmt.Errorf("field 'Relation' is required")
 	}
+	if r.RelationElement != nil {
+		if err := r.RelationElement.Validate(); err != nil {
+			return fmt.Errorf("RelationElement: %w", err)
+		}
+	}
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *BundleLink) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Relation == emptyString {
+		issues.add("required", path+".relation", "field 'Relation' is required")
+	}
+	if r.RelationElement != nil {
+		r.RelationElement.validateAll(path+".relation", issues)
+	}
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+}
+
+var bundleLinkInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BundleLink) invariants() []invariant {
+	return bundleLinkInvariants
+}
+
+func (r BundleLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BundleLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BundleLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BundleLink) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bundleLinkElementTypes = map[string]string{
+	"relation": "code",
+	"url":      "uri",
+}
+
+func (r *BundleLink) elementTypes() map[string]string {
+	return bundleLinkElementTypes
+}
+
+var bundleLinkElementMetadata = []ElementMetadata{
+	{Path: "Bundle.link.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.link.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Bundle.link.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Bundle.link.relation", Name: "relation", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "Bundle.link.url", Name: "url", Field: 5, Min: 1, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *BundleLink) ElementMetadata() []ElementMetadata {
+	return bundleLinkElementMetadata
+}
+
 type BundleEntry struct {
-	Id       *string              `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Link     []BundleLink         `json:"link,omitempty" bson:"link,omitempty"`         // Links related to this entry
-	FullUrl  *string              `json:"fullUrl,omitempty" bson:"full_url,omitempty"`  // URI for resource (e.g. the absolute URL server address, URI for UUID/OID, etc.)
-	Resource json.RawMessage      `json:"resource,omitempty" bson:"resource,omitempty"` // A resource in the bundle
-	Search   *BundleEntrySearch   `json:"search,omitempty" bson:"search,omitempty"`     // Search related information
-	Request  *BundleEntryRequest  `json:"request,omitempty" bson:"request,omitempty"`   // Additional execution information (transaction/batch/history)
-	Response *BundleEntryResponse `json:"response,omitempty" bson:"response,omitempty"` // Results of execution (transaction/batch/history)
+	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Link              []BundleLink         `json:"link,omitempty" bson:"link,omitempty"`                            // Links related to this entry
+	FullUrl           *string              `json:"fullUrl,omitempty" bson:"full_url,omitempty"`                     // URI for resource (e.g. the absolute URL server address, URI for UUID/OID, etc.)
+	FullUrlElement    *Element             `json:"_fullUrl,omitempty" bson:"full_url_element,omitempty"`            // Extensions for fullUrl
+	Resource          Resource             `json:"resource,omitempty" bson:"resource,omitempty"`                    // A resource in the bundle
+	Search            *BundleEntrySearch   `json:"search,omitempty" bson:"search,omitempty"`                        // Search related information
+	Request           *BundleEntryRequest  `json:"request,omitempty" bson:"request,omitempty"`                      // Additional execution information (transaction/batch/history)
+	Response          *BundleEntryResponse `json:"response,omitempty" bson:"response,omitempty"`                    // Results of execution (transaction/batch/history)
 }
 
 func (r *BundleEntry) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Link {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Link[%d]: %w", i, err)
 		}
 	}
+	if r.FullUrlElement != nil {
+		if err := r.FullUrlElement.Validate(); err != nil {
+			return fmt.Errorf("FullUrlElement: %w", err)
+		}
+	}
+	if r.Resource != nil {
+		if err := r.Resource.Validate(); err != nil {
+			return fmt.Errorf("Resource: %w", err)
+		}
+	}
 	if r.Search != nil {
 		if err := r.Search.Validate(); err != nil {
 			return fmt.Errorf("Search: %w", err)
@@ -109,50 +438,522 @@ func (r *BundleEntry) Validate() error {
 	return nil
 }
 
+func (r *BundleEntry) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Link {
+		item.validateAll(fmt.Sprintf("%s.link[%d]", path, i), issues)
+	}
+	if r.FullUrlElement != nil {
+		r.FullUrlElement.validateAll(path+".fullUrl", issues)
+	}
+	if r.Resource != nil {
+		issues.addNested(path+".resource", r.Resource.ValidateAll())
+	}
+	if r.Search != nil {
+		r.Search.validateAll(path+".search", issues)
+	}
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
+	}
+	if r.Response != nil {
+		r.Response.validateAll(path+".response", issues)
+	}
+}
+
+var bundleEntryInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "bdl-5", severity: "error", human: "must be a resource unless there's a request or response", expression: "resource.exists() or request.exists() or response.exists()"},
+	{key: "bdl-8", severity: "error", human: "fullUrl cannot be a version specific reference", expression: "fullUrl.exists() implies fullUrl.contains('/_history/').not()"},
+}
+
+func (r *BundleEntry) invariants() []invariant {
+	return bundleEntryInvariants
+}
+
+func (r *BundleEntry) UnmarshalJSON(data []byte) error {
+	type alias BundleEntry
+	aux := struct {
+		*alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Resource, err = unmarshalOptionalResource(aux.Resource); err != nil {
+		return fmt.Errorf("resource: %w", err)
+	}
+	return nil
+}
+
+func (r BundleEntry) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BundleEntry) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BundleEntry) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BundleEntry) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bundleEntryElementTypes = map[string]string{
+	"fullUrl": "uri",
+}
+
+func (r *BundleEntry) elementTypes() map[string]string {
+	return bundleEntryElementTypes
+}
+
+var bundleEntryElementMetadata = []ElementMetadata{
+	{Path: "Bundle.entry.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Bundle.entry.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Bundle.entry.link", Name: "link", Field: 3, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Bundle.entry.fullUrl", Name: "fullUrl", Field: 4, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Bundle.entry.resource", Name: "resource", Field: 6, Min: 0, Max: "1", Types: []string{"Resource"}},
+	{Path: "Bundle.entry.search", Name: "search", Field: 7, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Bundle.entry.request", Name: "request", Field: 8, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Bundle.entry.response", Name: "response", Field: 9, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *BundleEntry) ElementMetadata() []ElementMetadata {
+	return bundleEntryElementMetadata
+}
+
 type BundleEntrySearch struct {
-	Id    *string  `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Mode  *string  `json:"mode,omitempty" bson:"mode,omitempty"`   // match | include - why this is in the result set
-	Score *float64 `json:"score,omitempty" bson:"score,omitempty"` // Search ranking (between 0 and 1)
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode              *SearchEntryMode `json:"mode,omitempty" bson:"mode,omitempty"`                            // match | include - why this is in the result set
+	ModeElement       *Element         `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Score             *Decimal         `json:"score,omitempty" bson:"score,omitempty"`                          // Search ranking (between 0 and 1)
+	ScoreElement      *Element         `json:"_score,omitempty" bson:"score_element,omitempty"`                 // Extensions for score
 }
 
 func (r *BundleEntrySearch) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Mode != nil && !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", *r.Mode)
+	}
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
+	}
+	if r.Score != nil {
+		if err := r.Score.Validate(); err != nil {
+			return fmt.Errorf("Score: %w", err)
+		}
+	}
+	if r.ScoreElement != nil {
+		if err := r.ScoreElement.Validate(); err != nil {
+			return fmt.Errorf("ScoreElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *BundleEntrySearch) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode != nil && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", *r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	if r.Score != nil {
+		if err := r.Score.Validate(); err != nil {
+			issues.add("value", path+".score", err.Error())
+		}
+	}
+	if r.ScoreElement != nil {
+		r.ScoreElement.validateAll(path+".score", issues)
+	}
+}
+
+var bundleEntrySearchInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BundleEntrySearch) invariants() []invariant {
+	return bundleEntrySearchInvariants
+}
+
+func (r BundleEntrySearch) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BundleEntrySearch) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BundleEntrySearch) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BundleEntrySearch) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bundleEntrySearchElementMetadata = []ElementMetadata{
+	{Path: "Bundle.entry.search.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.search.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Bundle.entry.search.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Bundle.entry.search.mode", Name: "mode", Field: 3, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Bundle.entry.search.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-entry-mode"}},
+	{Path: "Bundle.entry.search.score", Name: "score", Field: 5, Min: 0, Max: "1", Types: []string{"decimal"}},
+}
+
+func (r *BundleEntrySearch) ElementMetadata() []ElementMetadata {
+	return bundleEntrySearchElementMetadata
+}
+
 type BundleEntryRequest struct {
-	Id              *string `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
-	Method          string  `json:"method" bson:"method"`                                         // GET | HEAD | POST | PUT | DELETE | PATCH
-	Url             string  `json:"url" bson:"url"`                                               // URL for HTTP equivalent of this entry
-	IfNoneMatch     *string `json:"ifNoneMatch,omitempty" bson:"if_none_match,omitempty"`         // For managing cache validation
-	IfModifiedSince *string `json:"ifModifiedSince,omitempty" bson:"if_modified_since,omitempty"` // For managing cache currency
-	IfMatch         *string `json:"ifMatch,omitempty" bson:"if_match,omitempty"`                  // For managing update contention
-	IfNoneExist     *string `json:"ifNoneExist,omitempty" bson:"if_none_exist,omitempty"`         // For conditional creates
+	Id                     *string     `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension              []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension      []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	Method                 HTTPVerb    `json:"method" bson:"method"`                                                  // GET | HEAD | POST | PUT | DELETE | PATCH
+	MethodElement          *Element    `json:"_method,omitempty" bson:"method_element,omitempty"`                     // Extensions for method
+	Url                    string      `json:"url" bson:"url"`                                                        // URL for HTTP equivalent of this entry
+	UrlElement             *Element    `json:"_url,omitempty" bson:"url_element,omitempty"`                           // Extensions for url
+	IfNoneMatch            *string     `json:"ifNoneMatch,omitempty" bson:"if_none_match,omitempty"`                  // For managing cache validation
+	IfNoneMatchElement     *Element    `json:"_ifNoneMatch,omitempty" bson:"if_none_match_element,omitempty"`         // Extensions for ifNoneMatch
+	IfModifiedSince        *Instant    `json:"ifModifiedSince,omitempty" bson:"if_modified_since,omitempty"`          // For managing cache currency
+	IfModifiedSinceElement *Element    `json:"_ifModifiedSince,omitempty" bson:"if_modified_since_element,omitempty"` // Extensions for ifModifiedSince
+	IfMatch                *string     `json:"ifMatch,omitempty" bson:"if_match,omitempty"`                           // For managing update contention
+	IfMatchElement         *Element    `json:"_ifMatch,omitempty" bson:"if_match_element,omitempty"`                  // Extensions for ifMatch
+	IfNoneExist            *string     `json:"ifNoneExist,omitempty" bson:"if_none_exist,omitempty"`                  // For conditional creates
+	IfNoneExistElement     *Element    `json:"_ifNoneExist,omitempty" bson:"if_none_exist_element,omitempty"`         // Extensions for ifNoneExist
 }
 
 func (r *BundleEntryRequest) Validate() error {
-	var emptyString string
-	if r.Method == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Method == "" {
 		return fmt.Errorf("field 'Method' is required")
 	}
+	if !r.Method.IsValid() {
+		return fmt.Errorf("field 'Method' has invalid code '%s'", r.Method)
+	}
+	if r.MethodElement != nil {
+		if err := r.MethodElement.Validate(); err != nil {
+			return fmt.Errorf("MethodElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
+	if r.IfNoneMatchElement != nil {
+		if err := r.IfNoneMatchElement.Validate(); err != nil {
+			return fmt.Errorf("IfNoneMatchElement: %w", err)
+		}
+	}
+	if r.IfModifiedSince != nil {
+		if err := r.IfModifiedSince.Validate(); err != nil {
+			return fmt.Errorf("IfModifiedSince: %w", err)
+		}
+	}
+	if r.IfModifiedSinceElement != nil {
+		if err := r.IfModifiedSinceElement.Validate(); err != nil {
+			return fmt.Errorf("IfModifiedSinceElement: %w", err)
+		}
+	}
+	if r.IfMatchElement != nil {
+		if err := r.IfMatchElement.Validate(); err != nil {
+			return fmt.Errorf("IfMatchElement: %w", err)
+		}
+	}
+	if r.IfNoneExistElement != nil {
+		if err := r.IfNoneExistElement.Validate(); err != nil {
+			return fmt.Errorf("IfNoneExistElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *BundleEntryRequest) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Method == "" {
+		issues.add("required", path+".method", "field 'Method' is required")
+	}
+	if r.Method != "" && !r.Method.IsValid() {
+		issues.add("code-invalid", path+".method", fmt.Sprintf("field 'Method' has invalid code '%s'", r.Method))
+	}
+	if r.MethodElement != nil {
+		r.MethodElement.validateAll(path+".method", issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.IfNoneMatchElement != nil {
+		r.IfNoneMatchElement.validateAll(path+".ifNoneMatch", issues)
+	}
+	if r.IfModifiedSince != nil {
+		if err := r.IfModifiedSince.Validate(); err != nil {
+			issues.add("value", path+".ifModifiedSince", err.Error())
+		}
+	}
+	if r.IfModifiedSinceElement != nil {
+		r.IfModifiedSinceElement.validateAll(path+".ifModifiedSince", issues)
+	}
+	if r.IfMatchElement != nil {
+		r.IfMatchElement.validateAll(path+".ifMatch", issues)
+	}
+	if r.IfNoneExistElement != nil {
+		r.IfNoneExistElement.validateAll(path+".ifNoneExist", issues)
+	}
+}
+
+var bundleEntryRequestInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BundleEntryRequest) invariants() []invariant {
+	return bundleEntryRequestInvariants
+}
+
+func (r BundleEntryRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BundleEntryRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BundleEntryRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BundleEntryRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bundleEntryRequestElementTypes = map[string]string{
+	"url": "uri",
+}
+
+func (r *BundleEntryRequest) elementTypes() map[string]string {
+	return bundleEntryRequestElementTypes
+}
+
+var bundleEntryRequestElementMetadata = []ElementMetadata{
+	{Path: "Bundle.entry.request.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.request.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Bundle.entry.request.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Bundle.entry.request.method", Name: "method", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Bundle.entry.request.method", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/http-verb"}},
+	{Path: "Bundle.entry.request.url", Name: "url", Field: 5, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "Bundle.entry.request.ifNoneMatch", Name: "ifNoneMatch", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.request.ifModifiedSince", Name: "ifModifiedSince", Field: 9, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Bundle.entry.request.ifMatch", Name: "ifMatch", Field: 11, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.request.ifNoneExist", Name: "ifNoneExist", Field: 13, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *BundleEntryRequest) ElementMetadata() []ElementMetadata {
+	return bundleEntryRequestElementMetadata
+}
+
 type BundleEntryResponse struct {
-	Id           *string         `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Status       string          `json:"status" bson:"status"`                                  // Status response code (text optional)
-	Location     *string         `json:"location,omitempty" bson:"location,omitempty"`          // The location (if the operation returns a location)
-	Etag         *string         `json:"etag,omitempty" bson:"etag,omitempty"`                  // The Etag for the resource (if relevant)
-	LastModified *string         `json:"lastModified,omitempty" bson:"last_modified,omitempty"` // Server's date time modified
-	Outcome      json.RawMessage `json:"outcome,omitempty" bson:"outcome,omitempty"`            // OperationOutcome with hints and warnings (for batch/transaction)
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Status              string      `json:"status" bson:"status"`                                            // Status response code (text optional)
+	StatusElement       *Element    `json:"_status,omitempty" bson:"status_element,omitempty"`               // Extensions for status
+	Location            *string     `json:"location,omitempty" bson:"location,omitempty"`                    // The location (if the operation returns a location)
+	LocationElement     *Element    `json:"_location,omitempty" bson:"location_element,omitempty"`           // Extensions for location
+	Etag                *string     `json:"etag,omitempty" bson:"etag,omitempty"`                            // The Etag for the resource (if relevant)
+	EtagElement         *Element    `json:"_etag,omitempty" bson:"etag_element,omitempty"`                   // Extensions for etag
+	LastModified        *Instant    `json:"lastModified,omitempty" bson:"last_modified,omitempty"`           // Server's date time modified
+	LastModifiedElement *Element    `json:"_lastModified,omitempty" bson:"last_modified_element,omitempty"`  // Extensions for lastModified
+	Outcome             Resource    `json:"outcome,omitempty" bson:"outcome,omitempty"`                      // OperationOutcome with hints and warnings (for batch/transaction)
 }
 
 func (r *BundleEntryResponse) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Status == emptyString {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.LocationElement != nil {
+		if err := r.LocationElement.Validate(); err != nil {
+			return fmt.Errorf("LocationElement: %w", err)
+		}
+	}
+	if r.EtagElement != nil {
+		if err := r.EtagElement.Validate(); err != nil {
+			return fmt.Errorf("EtagElement: %w", err)
+		}
+	}
+	if r.LastModified != nil {
+		if err := r.LastModified.Validate(); err != nil {
+			return fmt.Errorf("LastModified: %w", err)
+		}
+	}
+	if r.LastModifiedElement != nil {
+		if err := r.LastModifiedElement.Validate(); err != nil {
+			return fmt.Errorf("LastModifiedElement: %w", err)
+		}
+	}
+	if r.Outcome != nil {
+		if err := r.Outcome.Validate(); err != nil {
+			return fmt.Errorf("Outcome: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *BundleEntryResponse) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Status == emptyString {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.LocationElement != nil {
+		r.LocationElement.validateAll(path+".location", issues)
+	}
+	if r.EtagElement != nil {
+		r.EtagElement.validateAll(path+".etag", issues)
+	}
+	if r.LastModified != nil {
+		if err := r.LastModified.Validate(); err != nil {
+			issues.add("value", path+".lastModified", err.Error())
+		}
+	}
+	if r.LastModifiedElement != nil {
+		r.LastModifiedElement.validateAll(path+".lastModified", issues)
+	}
+	if r.Outcome != nil {
+		issues.addNested(path+".outcome", r.Outcome.ValidateAll())
+	}
+}
+
+var bundleEntryResponseInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *BundleEntryResponse) invariants() []invariant {
+	return bundleEntryResponseInvariants
+}
+
+func (r *BundleEntryResponse) UnmarshalJSON(data []byte) error {
+	type alias BundleEntryResponse
+	aux := struct {
+		*alias
+		Outcome json.RawMessage `json:"outcome,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Outcome, err = unmarshalOptionalResource(aux.Outcome); err != nil {
+		return fmt.Errorf("outcome: %w", err)
+	}
 	return nil
 }
+
+func (r BundleEntryResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *BundleEntryResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r BundleEntryResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *BundleEntryResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var bundleEntryResponseElementTypes = map[string]string{
+	"location": "uri",
+}
+
+func (r *BundleEntryResponse) elementTypes() map[string]string {
+	return bundleEntryResponseElementTypes
+}
+
+var bundleEntryResponseElementMetadata = []ElementMetadata{
+	{Path: "Bundle.entry.response.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.response.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Bundle.entry.response.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Bundle.entry.response.status", Name: "status", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.response.location", Name: "location", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Bundle.entry.response.etag", Name: "etag", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Bundle.entry.response.lastModified", Name: "lastModified", Field: 9, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Bundle.entry.response.outcome", Name: "outcome", Field: 11, Min: 0, Max: "1", Types: []string{"Resource"}},
+}
+
+func (r *BundleEntryResponse) ElementMetadata() []ElementMetadata {
+	return bundleEntryResponseElementMetadata
+}
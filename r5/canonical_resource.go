@@ -2,36 +2,55 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Common Interface declaration for conformance and knowledge artifact resources.
 type CanonicalResource struct {
-	ResourceType           string            `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string           `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string           `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string           `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this {{title}}, represented as an absolute URI (globally unique)
-	Identifier             []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the {{title}}
-	Version                *string           `json:"version,omitempty" bson:"version,omitempty"`                                 // Canonical version of the {{title}}
-	VersionAlgorithmString *string           `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding           `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string           `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this {{title}} (computer friendly)
-	Title                  *string           `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this {{title}} (human friendly)
-	Status                 string            `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool             `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string           `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string           `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail   `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string           `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the {{title}}
-	UseContext             []UsageContext    `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the {{title}} (if applicable)
-	Purpose                *string           `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this {{title}} is defined
-	Copyright              *string           `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string           `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
+	ResourceType            string                            `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                           `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                           `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                          `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                        `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     *string                           `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this {{title}}, represented as an absolute URI (globally unique)
+	UrlElement              *Element                          `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the {{title}}
+	Version                 *string                           `json:"version,omitempty" bson:"version,omitempty"`                         // Canonical version of the {{title}}
+	VersionElement          *Element                          `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        CanonicalResourceVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                          `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    *string                           `json:"name,omitempty" bson:"name,omitempty"`                               // Name for this {{title}} (computer friendly)
+	NameElement             *Element                          `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                           `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this {{title}} (human friendly)
+	TitleElement            *Element                          `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus                 `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                          `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                             `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                          `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                         `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                          `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                           `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                          `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                   `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                           `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the {{title}}
+	DescriptionElement      *Element                          `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                    `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                 `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the {{title}} (if applicable)
+	Purpose                 *string                           `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this {{title}} is defined
+	PurposeElement          *Element                          `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                           `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                          `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                           `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                          `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+
+	versionAlgorithmVariants []string // JSON properties of CanonicalResource.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *CanonicalResource) Validate() error {
@@ -43,30 +62,118 @@ func (r *CanonicalResource) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -77,5 +184,292 @@ func (r *CanonicalResource) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "CanonicalResource")
+}
+
+func (r *CanonicalResource) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CanonicalResource" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CanonicalResource', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+}
+
+func (r *CanonicalResource) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CanonicalResource", &issues)
+	checkInvariants(r, "CanonicalResource", &issues)
+	return issues
+}
+
+func (r CanonicalResource) MarshalJSON() ([]byte, error) {
+	type alias CanonicalResource
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CanonicalResource) UnmarshalJSON(data []byte) error {
+	type alias CanonicalResource
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", canonicalResourceVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r CanonicalResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CanonicalResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CanonicalResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CanonicalResource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CanonicalResource) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(canonicalResourceVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var canonicalResourceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *CanonicalResource) elementTypes() map[string]string {
+	return canonicalResourceElementTypes
+}
+
+var canonicalResourceElementMetadata = []ElementMetadata{
+	{Path: "CanonicalResource.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CanonicalResource.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CanonicalResource.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CanonicalResource.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CanonicalResource.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CanonicalResource.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CanonicalResource.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CanonicalResource.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CanonicalResource.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CanonicalResource.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "CanonicalResource.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CanonicalResource.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CanonicalResource.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "CanonicalResource.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CanonicalResource.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CanonicalResource.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CanonicalResource.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "CanonicalResource.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CanonicalResource.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CanonicalResource.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CanonicalResource.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CanonicalResource.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CanonicalResource.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "CanonicalResource.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CanonicalResource.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CanonicalResource.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CanonicalResource.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *CanonicalResource) ElementMetadata() []ElementMetadata {
+	return canonicalResourceElementMetadata
+}
+
+// CanonicalResourceVersionAlgorithm is the type of CanonicalResource.versionAlgorithm[x]. It is implemented by the CanonicalResourceVersionAlgorithm* variant types.
+type CanonicalResourceVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCanonicalResourceVersionAlgorithm()
+}
+
+// CanonicalResourceVersionAlgorithmString is the string variant of CanonicalResourceVersionAlgorithm.
+type CanonicalResourceVersionAlgorithmString string
+
+func (v CanonicalResourceVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v CanonicalResourceVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CanonicalResourceVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v CanonicalResourceVersionAlgorithmString) isCanonicalResourceVersionAlgorithm() {}
+
+// CanonicalResourceVersionAlgorithmCoding is the Coding variant of CanonicalResourceVersionAlgorithm.
+type CanonicalResourceVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v CanonicalResourceVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v CanonicalResourceVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v CanonicalResourceVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v CanonicalResourceVersionAlgorithmCoding) isCanonicalResourceVersionAlgorithm() {}
+
+var canonicalResourceVersionAlgorithmVariants = []CanonicalResourceVersionAlgorithm{
+	CanonicalResourceVersionAlgorithmString(""),
+	CanonicalResourceVersionAlgorithmCoding{},
+}
@@ -2,50 +2,78 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A Capability Statement documents a set of capabilities (behaviors) of a FHIR Server or Client for a particular version of FHIR that may be used as a statement of actual server functionality or a statement of required or desired server implementation.
 type CapabilityStatement struct {
-	ResourceType           string                             `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                            `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                            `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage                  `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                            `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this capability statement, represented as a URI (globally unique)
-	Identifier             []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the CapabilityStatement (business identifier)
-	Version                *string                            `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the capability statement
-	VersionAlgorithmString *string                            `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                            `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                            `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this capability statement (computer friendly)
-	Title                  *string                            `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this capability statement (human friendly)
-	Status                 string                             `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                              `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   string                             `json:"date" bson:"date"`                                                           // Date last changed
-	Publisher              *string                            `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                    `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                            `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the capability statement
-	UseContext             []UsageContext                     `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	ActorDefinition        []string                           `json:"actorDefinition,omitempty" bson:"actor_definition,omitempty"`                // ActorDefinitions the CapabilityStatement supports
-	Jurisdiction           []CodeableConcept                  `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the capability statement (if applicable)
-	Purpose                *string                            `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this capability statement is defined
-	Copyright              *string                            `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                            `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Kind                   string                             `json:"kind" bson:"kind"`                                                           // instance | capability | requirements
-	Instantiates           []string                           `json:"instantiates,omitempty" bson:"instantiates,omitempty"`                       // Canonical URL of another capability statement this implements
-	Imports                []string                           `json:"imports,omitempty" bson:"imports,omitempty"`                                 // Canonical URL of another capability statement this adds to
-	Software               *CapabilityStatementSoftware       `json:"software,omitempty" bson:"software,omitempty"`                               // Software that is covered by this capability statement
-	Implementation         *CapabilityStatementImplementation `json:"implementation,omitempty" bson:"implementation,omitempty"`                   // If this describes a specific instance
-	FhirVersion            string                             `json:"fhirVersion" bson:"fhir_version"`                                            // FHIR Version the system supports
-	Format                 []string                           `json:"format" bson:"format"`                                                       // formats supported (xml | json | ttl | mime type)
-	PatchFormat            []string                           `json:"patchFormat,omitempty" bson:"patch_format,omitempty"`                        // Patch formats supported (Mime types for FHIR and JSON And XML Patch)
-	AcceptLanguage         []string                           `json:"acceptLanguage,omitempty" bson:"accept_language,omitempty"`                  // Languages supported
-	ImplementationGuide    []string                           `json:"implementationGuide,omitempty" bson:"implementation_guide,omitempty"`        // Implementation guides supported
-	Rest                   []CapabilityStatementRest          `json:"rest,omitempty" bson:"rest,omitempty"`                                       // If the endpoint is a RESTful one
-	Messaging              []CapabilityStatementMessaging     `json:"messaging,omitempty" bson:"messaging,omitempty"`                             // If messaging is supported
-	Document               []CapabilityStatementDocument      `json:"document,omitempty" bson:"document,omitempty"`                               // Document definition
+	ResourceType               string                              `json:"resourceType" bson:"resource_type"`                                            // Type of resource
+	Id                         *string                             `json:"id,omitempty" bson:"id,omitempty"`                                             // Logical id of this artifact
+	Meta                       *Meta                               `json:"meta,omitempty" bson:"meta,omitempty"`                                         // Metadata about the resource
+	ImplicitRules              *string                             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                      // A set of rules under which this content was created
+	ImplicitRulesElement       *Element                            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`             // Extensions for implicitRules
+	Language                   *string                             `json:"language,omitempty" bson:"language,omitempty"`                                 // Language of the resource content
+	LanguageElement            *Element                            `json:"_language,omitempty" bson:"language_element,omitempty"`                        // Extensions for language
+	Text                       *Narrative                          `json:"text,omitempty" bson:"text,omitempty"`                                         // Text summary of the resource, for human interpretation
+	Contained                  []Resource                          `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
+	Extension                  []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored
+	Url                        *string                             `json:"url,omitempty" bson:"url,omitempty"`                                           // Canonical identifier for this capability statement, represented as a URI (globally unique)
+	UrlElement                 *Element                            `json:"_url,omitempty" bson:"url_element,omitempty"`                                  // Extensions for url
+	Identifier                 []Identifier                        `json:"identifier,omitempty" bson:"identifier,omitempty"`                             // Additional identifier for the CapabilityStatement (business identifier)
+	Version                    *string                             `json:"version,omitempty" bson:"version,omitempty"`                                   // Business version of the capability statement
+	VersionElement             *Element                            `json:"_version,omitempty" bson:"version_element,omitempty"`                          // Extensions for version
+	VersionAlgorithm           CapabilityStatementVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                         // How to compare versions
+	VersionAlgorithmElement    *Element                            `json:"-" bson:"version_algorithm_element,omitempty"`                                 // Extensions for versionAlgorithm[x]
+	Name                       *string                             `json:"name,omitempty" bson:"name,omitempty"`                                         // Name for this capability statement (computer friendly)
+	NameElement                *Element                            `json:"_name,omitempty" bson:"name_element,omitempty"`                                // Extensions for name
+	Title                      *string                             `json:"title,omitempty" bson:"title,omitempty"`                                       // Name for this capability statement (human friendly)
+	TitleElement               *Element                            `json:"_title,omitempty" bson:"title_element,omitempty"`                              // Extensions for title
+	Status                     PublicationStatus                   `json:"status" bson:"status"`                                                         // draft | active | retired | unknown
+	StatusElement              *Element                            `json:"_status,omitempty" bson:"status_element,omitempty"`                            // Extensions for status
+	Experimental               *bool                               `json:"experimental,omitempty" bson:"experimental,omitempty"`                         // For testing only - never for real usage
+	ExperimentalElement        *Element                            `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                // Extensions for experimental
+	Date                       *DateTime                           `json:"date" bson:"date"`                                                             // Date last changed
+	DateElement                *Element                            `json:"_date,omitempty" bson:"date_element,omitempty"`                                // Extensions for date
+	Publisher                  *string                             `json:"publisher,omitempty" bson:"publisher,omitempty"`                               // Name of the publisher/steward (organization or individual)
+	PublisherElement           *Element                            `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                      // Extensions for publisher
+	Contact                    []ContactDetail                     `json:"contact,omitempty" bson:"contact,omitempty"`                                   // Contact details for the publisher
+	Description                *string                             `json:"description,omitempty" bson:"description,omitempty"`                           // Natural language description of the capability statement
+	DescriptionElement         *Element                            `json:"_description,omitempty" bson:"description_element,omitempty"`                  // Extensions for description
+	UseContext                 []UsageContext                      `json:"useContext,omitempty" bson:"use_context,omitempty"`                            // The context that the content is intended to support
+	ActorDefinition            []string                            `json:"actorDefinition,omitempty" bson:"actor_definition,omitempty"`                  // ActorDefinitions the CapabilityStatement supports
+	ActorDefinitionElement     []*Element                          `json:"_actorDefinition,omitempty" bson:"actor_definition_element,omitempty"`         // Extensions for actorDefinition
+	Jurisdiction               []CodeableConcept                   `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                         // Jurisdiction of the authority that maintains the capability statement (if applicable)
+	Purpose                    *string                             `json:"purpose,omitempty" bson:"purpose,omitempty"`                                   // Why this capability statement is defined
+	PurposeElement             *Element                            `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                          // Extensions for purpose
+	Copyright                  *string                             `json:"copyright,omitempty" bson:"copyright,omitempty"`                               // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement           *Element                            `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                      // Extensions for copyright
+	CopyrightLabel             *string                             `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                    // Copyright holder and year(s)
+	CopyrightLabelElement      *Element                            `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`           // Extensions for copyrightLabel
+	Kind                       CapabilityStatementKind             `json:"kind" bson:"kind"`                                                             // instance | capability | requirements
+	KindElement                *Element                            `json:"_kind,omitempty" bson:"kind_element,omitempty"`                                // Extensions for kind
+	Instantiates               []string                            `json:"instantiates,omitempty" bson:"instantiates,omitempty"`                         // Canonical URL of another capability statement this implements
+	InstantiatesElement        []*Element                          `json:"_instantiates,omitempty" bson:"instantiates_element,omitempty"`                // Extensions for instantiates
+	Imports                    []string                            `json:"imports,omitempty" bson:"imports,omitempty"`                                   // Canonical URL of another capability statement this adds to
+	ImportsElement             []*Element                          `json:"_imports,omitempty" bson:"imports_element,omitempty"`                          // Extensions for imports
+	Software                   *CapabilityStatementSoftware        `json:"software,omitempty" bson:"software,omitempty"`                                 // Software that is covered by this capability statement
+	Implementation             *CapabilityStatementImplementation  `json:"implementation,omitempty" bson:"implementation,omitempty"`                     // If this describes a specific instance
+	FhirVersion                string                              `json:"fhirVersion" bson:"fhir_version"`                                              // FHIR Version the system supports
+	FhirVersionElement         *Element                            `json:"_fhirVersion,omitempty" bson:"fhir_version_element,omitempty"`                 // Extensions for fhirVersion
+	Format                     []string                            `json:"format" bson:"format"`                                                         // formats supported (xml | json | ttl | mime type)
+	FormatElement              []*Element                          `json:"_format,omitempty" bson:"format_element,omitempty"`                            // Extensions for format
+	PatchFormat                []string                            `json:"patchFormat,omitempty" bson:"patch_format,omitempty"`                          // Patch formats supported (Mime types for FHIR and JSON And XML Patch)
+	PatchFormatElement         []*Element                          `json:"_patchFormat,omitempty" bson:"patch_format_element,omitempty"`                 // Extensions for patchFormat
+	AcceptLanguage             []string                            `json:"acceptLanguage,omitempty" bson:"accept_language,omitempty"`                    // Languages supported
+	AcceptLanguageElement      []*Element                          `json:"_acceptLanguage,omitempty" bson:"accept_language_element,omitempty"`           // Extensions for acceptLanguage
+	ImplementationGuide        []string                            `json:"implementationGuide,omitempty" bson:"implementation_guide,omitempty"`          // Implementation guides supported
+	ImplementationGuideElement []*Element                          `json:"_implementationGuide,omitempty" bson:"implementation_guide_element,omitempty"` // Extensions for implementationGuide
+	Rest                       []CapabilityStatementRest           `json:"rest,omitempty" bson:"rest,omitempty"`                                         // If the endpoint is a RESTful one
+	Messaging                  []CapabilityStatementMessaging      `json:"messaging,omitempty" bson:"messaging,omitempty"`                               // If messaging is supported
+	Document                   []CapabilityStatementDocument       `json:"document,omitempty" bson:"document,omitempty"`                                 // Document definition
+
+	versionAlgorithmVariants []string // JSON properties of CapabilityStatement.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *CapabilityStatement) Validate() error {
@@ -57,46 +85,181 @@ func (r *CapabilityStatement) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Date == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date == nil {
 		return fmt.Errorf("field 'Date' is required")
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ActorDefinitionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ActorDefinitionElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Jurisdiction {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
-	if r.Kind == emptyString {
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.Kind == "" {
 		return fmt.Errorf("field 'Kind' is required")
 	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	for i, item := range r.InstantiatesElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InstantiatesElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ImportsElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ImportsElement[%d]: %w", i, err)
+		}
+	}
 	if r.Software != nil {
 		if err := r.Software.Validate(); err != nil {
 			return fmt.Errorf("Software: %w", err)
@@ -107,12 +270,50 @@ func (r *CapabilityStatement) Validate() error {
 			return fmt.Errorf("Implementation: %w", err)
 		}
 	}
+	var emptyString string
 	if r.FhirVersion == emptyString {
 		return fmt.Errorf("field 'FhirVersion' is required")
 	}
+	if r.FhirVersionElement != nil {
+		if err := r.FhirVersionElement.Validate(); err != nil {
+			return fmt.Errorf("FhirVersionElement: %w", err)
+		}
+	}
 	if len(r.Format) < 1 {
 		return fmt.Errorf("field 'Format' must have at least 1 elements")
 	}
+	for i, item := range r.FormatElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("FormatElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.PatchFormatElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PatchFormatElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.AcceptLanguageElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AcceptLanguageElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ImplementationGuideElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ImplementationGuideElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Rest {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Rest[%d]: %w", i, err)
@@ -128,263 +329,1999 @@ func (r *CapabilityStatement) Validate() error {
 			return fmt.Errorf("Document[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type CapabilityStatementDocument struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Mode          string  `json:"mode" bson:"mode"`                                       // producer | consumer
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Description of document support
-	Profile       string  `json:"profile" bson:"profile"`                                 // Constraint on the resources used in the document
+	return validateInvariants(r, "CapabilityStatement")
 }
 
-func (r *CapabilityStatementDocument) Validate() error {
-	var emptyString string
-	if r.Mode == emptyString {
-		return fmt.Errorf("field 'Mode' is required")
+func (r *CapabilityStatement) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CapabilityStatement" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CapabilityStatement', got '%s'", r.ResourceType))
 	}
-	if r.Profile == emptyString {
-		return fmt.Errorf("field 'Profile' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	return nil
-}
-
-type CapabilityStatementSoftware struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Name        string  `json:"name" bson:"name"`                                    // A name the software is known by
-	Version     *string `json:"version,omitempty" bson:"version,omitempty"`          // Version covered by this statement
-	ReleaseDate *string `json:"releaseDate,omitempty" bson:"release_date,omitempty"` // Date this version was released
-}
-
-func (r *CapabilityStatementSoftware) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	return nil
-}
-
-type CapabilityStatementImplementation struct {
-	Id          *string    `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Description string     `json:"description" bson:"description"`                 // Describes this specific instance
-	Url         *string    `json:"url,omitempty" bson:"url,omitempty"`             // Base URL for the installation
-	Custodian   *Reference `json:"custodian,omitempty" bson:"custodian,omitempty"` // Organization that manages the data
-}
-
-func (r *CapabilityStatementImplementation) Validate() error {
-	var emptyString string
-	if r.Description == emptyString {
-		return fmt.Errorf("field 'Description' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.Custodian != nil {
-		if err := r.Custodian.Validate(); err != nil {
-			return fmt.Errorf("Custodian: %w", err)
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type CapabilityStatementRest struct {
-	Id            *string                                      `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Mode          string                                       `json:"mode" bson:"mode"`                                       // client | server
-	Documentation *string                                      `json:"documentation,omitempty" bson:"documentation,omitempty"` // General description of implementation
-	Security      *CapabilityStatementRestSecurity             `json:"security,omitempty" bson:"security,omitempty"`           // Information about security of implementation
-	Resource      []CapabilityStatementRestResource            `json:"resource,omitempty" bson:"resource,omitempty"`           // Resource served on the REST interface
-	Interaction   []CapabilityStatementRestInteraction         `json:"interaction,omitempty" bson:"interaction,omitempty"`     // What interactions are supported?
-	SearchParam   []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty" bson:"search_param,omitempty"`    // Search parameters for searching all resources
-	Operation     []CapabilityStatementRestResourceOperation   `json:"operation,omitempty" bson:"operation,omitempty"`         // Definition of a system level operation
-	Compartment   []string                                     `json:"compartment,omitempty" bson:"compartment,omitempty"`     // Compartments served/used by system
-}
-
-func (r *CapabilityStatementRest) Validate() error {
-	var emptyString string
-	if r.Mode == emptyString {
-		return fmt.Errorf("field 'Mode' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Security != nil {
-		if err := r.Security.Validate(); err != nil {
-			return fmt.Errorf("Security: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	for i, item := range r.Resource {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Resource[%d]: %w", i, err)
-		}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	for i, item := range r.Interaction {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Interaction[%d]: %w", i, err)
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	for i, item := range r.SearchParam {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SearchParam[%d]: %w", i, err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.ActorDefinitionElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.actorDefinition[%d]", path, i), issues)
 	}
-	for i, item := range r.Operation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Operation[%d]: %w", i, err)
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	for i, item := range r.InstantiatesElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.instantiates[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type CapabilityStatementRestSecurity struct {
-	Id          *string           `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Cors        *bool             `json:"cors,omitempty" bson:"cors,omitempty"`               // Adds CORS Headers (http://enable-cors.org/)
-	Service     []CodeableConcept `json:"service,omitempty" bson:"service,omitempty"`         // OAuth | SMART-on-FHIR | NTLM | Basic | Kerberos | Certificates
-	Description *string           `json:"description,omitempty" bson:"description,omitempty"` // General description of how security works
-}
-
-func (r *CapabilityStatementRestSecurity) Validate() error {
-	for i, item := range r.Service {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Service[%d]: %w", i, err)
+	for i, item := range r.ImportsElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.imports[%d]", path, i), issues)
+	}
+	if r.Software != nil {
+		r.Software.validateAll(path+".software", issues)
+	}
+	if r.Implementation != nil {
+		r.Implementation.validateAll(path+".implementation", issues)
 	}
-	return nil
-}
-
-type CapabilityStatementRestResource struct {
-	Id                *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Type              string                                       `json:"type" bson:"type"`                                                // A resource type that is supported
-	Definition        *string                                      `json:"definition,omitempty" bson:"definition,omitempty"`                // The definition for an additional resource
-	Profile           *string                                      `json:"profile,omitempty" bson:"profile,omitempty"`                      // System-wide profile
-	SupportedProfile  []string                                     `json:"supportedProfile,omitempty" bson:"supported_profile,omitempty"`   // Use-case specific profiles
-	Documentation     *string                                      `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Additional information about the use of the resource type
-	Interaction       []CapabilityStatementRestResourceInteraction `json:"interaction,omitempty" bson:"interaction,omitempty"`              // What interactions are supported?
-	Versioning        *string                                      `json:"versioning,omitempty" bson:"versioning,omitempty"`                // no-version | versioned | versioned-update
-	ReadHistory       *bool                                        `json:"readHistory,omitempty" bson:"read_history,omitempty"`             // Whether vRead can return past versions
-	UpdateCreate      *bool                                        `json:"updateCreate,omitempty" bson:"update_create,omitempty"`           // If update can commit to a new identity
-	ConditionalCreate *bool                                        `json:"conditionalCreate,omitempty" bson:"conditional_create,omitempty"` // If allows/uses conditional create
-	ConditionalRead   *string                                      `json:"conditionalRead,omitempty" bson:"conditional_read,omitempty"`     // not-supported | modified-since | not-match | full-support
-	ConditionalUpdate *bool                                        `json:"conditionalUpdate,omitempty" bson:"conditional_update,omitempty"` // If allows/uses conditional update
-	ConditionalPatch  *bool                                        `json:"conditionalPatch,omitempty" bson:"conditional_patch,omitempty"`   // If allows/uses conditional patch
-	ConditionalDelete *string                                      `json:"conditionalDelete,omitempty" bson:"conditional_delete,omitempty"` // not-supported | single | multiple - how conditional delete is supported
-	ReferencePolicy   []string                                     `json:"referencePolicy,omitempty" bson:"reference_policy,omitempty"`     // literal | logical | resolves | enforced | local
-	SearchInclude     []string                                     `json:"searchInclude,omitempty" bson:"search_include,omitempty"`         // _include values supported by the server
-	SearchRevInclude  []string                                     `json:"searchRevInclude,omitempty" bson:"search_rev_include,omitempty"`  // _revinclude values supported by the server
-	SearchParam       []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty" bson:"search_param,omitempty"`             // Search parameters supported by implementation
-	Operation         []CapabilityStatementRestResourceOperation   `json:"operation,omitempty" bson:"operation,omitempty"`                  // Definition of a resource operation
-}
-
-func (r *CapabilityStatementRestResource) Validate() error {
 	var emptyString string
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+	if r.FhirVersion == emptyString {
+		issues.add("required", path+".fhirVersion", "field 'FhirVersion' is required")
 	}
-	for i, item := range r.Interaction {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Interaction[%d]: %w", i, err)
+	if r.FhirVersionElement != nil {
+		r.FhirVersionElement.validateAll(path+".fhirVersion", issues)
+	}
+	if len(r.Format) < 1 {
+		issues.add("required", path+".format", "field 'Format' must have at least 1 elements")
+	}
+	for i, item := range r.FormatElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.format[%d]", path, i), issues)
 	}
-	for i, item := range r.SearchParam {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SearchParam[%d]: %w", i, err)
+	for i, item := range r.PatchFormatElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.patchFormat[%d]", path, i), issues)
 	}
-	for i, item := range r.Operation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Operation[%d]: %w", i, err)
+	for i, item := range r.AcceptLanguageElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.acceptLanguage[%d]", path, i), issues)
+	}
+	for i, item := range r.ImplementationGuideElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.implementationGuide[%d]", path, i), issues)
+	}
+	for i, item := range r.Rest {
+		item.validateAll(fmt.Sprintf("%s.rest[%d]", path, i), issues)
+	}
+	for i, item := range r.Messaging {
+		item.validateAll(fmt.Sprintf("%s.messaging[%d]", path, i), issues)
+	}
+	for i, item := range r.Document {
+		item.validateAll(fmt.Sprintf("%s.document[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type CapabilityStatementRestResourceInteraction struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Code          string  `json:"code" bson:"code"`                                       // read | vread | update | update-conditional | patch | patch-conditional | delete | delete-conditional-single | delete-conditional-multiple | delete-history | delete-history-version | history-instance | history-type | create | create-conditional | search-type
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Anything special about interaction behavior
+func (r *CapabilityStatement) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CapabilityStatement", &issues)
+	checkInvariants(r, "CapabilityStatement", &issues)
+	return issues
 }
 
-func (r *CapabilityStatementRestResourceInteraction) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
-	}
-	return nil
+var capabilityStatementInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type CapabilityStatementRestResourceOperation struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          string  `json:"name" bson:"name"`                                       // Name by which the operation/query is invoked
-	Definition    string  `json:"definition" bson:"definition"`                           // The defined operation/query
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Specific details about operation behavior
+func (r *CapabilityStatement) invariants() []invariant {
+	return capabilityStatementInvariants
 }
 
-func (r *CapabilityStatementRestResourceOperation) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+func (r CapabilityStatement) MarshalJSON() ([]byte, error) {
+	type alias CapabilityStatement
+	out := struct {
+		alias
+		ActorDefinition            []*string  `json:"actorDefinition,omitempty"`
+		ActorDefinitionElement     []*Element `json:"_actorDefinition,omitempty"`
+		Instantiates               []*string  `json:"instantiates,omitempty"`
+		InstantiatesElement        []*Element `json:"_instantiates,omitempty"`
+		Imports                    []*string  `json:"imports,omitempty"`
+		ImportsElement             []*Element `json:"_imports,omitempty"`
+		Format                     []*string  `json:"format"`
+		FormatElement              []*Element `json:"_format,omitempty"`
+		PatchFormat                []*string  `json:"patchFormat,omitempty"`
+		PatchFormatElement         []*Element `json:"_patchFormat,omitempty"`
+		AcceptLanguage             []*string  `json:"acceptLanguage,omitempty"`
+		AcceptLanguageElement      []*Element `json:"_acceptLanguage,omitempty"`
+		ImplementationGuide        []*string  `json:"implementationGuide,omitempty"`
+		ImplementationGuideElement []*Element `json:"_implementationGuide,omitempty"`
+	}{alias: alias(r)}
+	out.ActorDefinition, out.ActorDefinitionElement = alignPrimitiveArray(r.ActorDefinition, r.ActorDefinitionElement)
+	out.Instantiates, out.InstantiatesElement = alignPrimitiveArray(r.Instantiates, r.InstantiatesElement)
+	out.Imports, out.ImportsElement = alignPrimitiveArray(r.Imports, r.ImportsElement)
+	out.Format, out.FormatElement = alignPrimitiveArray(r.Format, r.FormatElement)
+	out.PatchFormat, out.PatchFormatElement = alignPrimitiveArray(r.PatchFormat, r.PatchFormatElement)
+	out.AcceptLanguage, out.AcceptLanguageElement = alignPrimitiveArray(r.AcceptLanguage, r.AcceptLanguageElement)
+	out.ImplementationGuide, out.ImplementationGuideElement = alignPrimitiveArray(r.ImplementationGuide, r.ImplementationGuideElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.Definition == emptyString {
-		return fmt.Errorf("field 'Definition' is required")
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-type CapabilityStatementMessaging struct {
-	Id               *string                                        `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Endpoint         []CapabilityStatementMessagingEndpoint         `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                  // Where messages should be sent
-	ReliableCache    *int                                           `json:"reliableCache,omitempty" bson:"reliable_cache,omitempty"`       // Reliable Message Cache Length (min)
-	Documentation    *string                                        `json:"documentation,omitempty" bson:"documentation,omitempty"`        // Messaging interface behavior details
-	SupportedMessage []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty" bson:"supported_message,omitempty"` // Messages supported by this system
+	return data, nil
 }
 
-func (r *CapabilityStatementMessaging) Validate() error {
-	for i, item := range r.Endpoint {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Endpoint[%d]: %w", i, err)
+func (r *CapabilityStatement) UnmarshalJSON(data []byte) error {
+	type alias CapabilityStatement
+	aux := struct {
+		*alias
+		Contained           []json.RawMessage `json:"contained,omitempty"`
+		ActorDefinition     []*string         `json:"actorDefinition,omitempty"`
+		Instantiates        []*string         `json:"instantiates,omitempty"`
+		Imports             []*string         `json:"imports,omitempty"`
+		Format              []*string         `json:"format"`
+		PatchFormat         []*string         `json:"patchFormat,omitempty"`
+		AcceptLanguage      []*string         `json:"acceptLanguage,omitempty"`
+		ImplementationGuide []*string         `json:"implementationGuide,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	for i, item := range r.SupportedMessage {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SupportedMessage[%d]: %w", i, err)
+	if r.ActorDefinition, err = splitPrimitiveArray(aux.ActorDefinition, r.ActorDefinitionElement); err != nil {
+		return fmt.Errorf("actorDefinition: %w", err)
+	}
+	if r.Instantiates, err = splitPrimitiveArray(aux.Instantiates, r.InstantiatesElement); err != nil {
+		return fmt.Errorf("instantiates: %w", err)
+	}
+	if r.Imports, err = splitPrimitiveArray(aux.Imports, r.ImportsElement); err != nil {
+		return fmt.Errorf("imports: %w", err)
+	}
+	if r.Format, err = splitPrimitiveArray(aux.Format, r.FormatElement); err != nil {
+		return fmt.Errorf("format: %w", err)
+	}
+	if r.PatchFormat, err = splitPrimitiveArray(aux.PatchFormat, r.PatchFormatElement); err != nil {
+		return fmt.Errorf("patchFormat: %w", err)
+	}
+	if r.AcceptLanguage, err = splitPrimitiveArray(aux.AcceptLanguage, r.AcceptLanguageElement); err != nil {
+		return fmt.Errorf("acceptLanguage: %w", err)
+	}
+	if r.ImplementationGuide, err = splitPrimitiveArray(aux.ImplementationGuide, r.ImplementationGuideElement); err != nil {
+		return fmt.Errorf("implementationGuide: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", capabilityStatementVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type CapabilityStatementRestResourceSearchParam struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          string  `json:"name" bson:"name"`                                       // Name for parameter in search url
-	Definition    *string `json:"definition,omitempty" bson:"definition,omitempty"`       // Source of definition for parameter
-	Type          string  `json:"type" bson:"type"`                                       // number | date | string | token | reference | composite | quantity | uri | special | resource
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Server-specific usage
+func (r CapabilityStatement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *CapabilityStatementRestResourceSearchParam) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
+func (r *CapabilityStatement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CapabilityStatement) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(capabilityStatementVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var capabilityStatementElementTypes = map[string]string{
+	"id":                  "id",
+	"implicitRules":       "uri",
+	"language":            "code",
+	"url":                 "uri",
+	"description":         "markdown",
+	"actorDefinition":     "canonical",
+	"purpose":             "markdown",
+	"copyright":           "markdown",
+	"instantiates":        "canonical",
+	"imports":             "canonical",
+	"fhirVersion":         "code",
+	"format":              "code",
+	"patchFormat":         "code",
+	"acceptLanguage":      "code",
+	"implementationGuide": "canonical",
+}
+
+func (r *CapabilityStatement) elementTypes() map[string]string {
+	return capabilityStatementElementTypes
+}
+
+var capabilityStatementElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CapabilityStatement.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CapabilityStatement.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CapabilityStatement.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CapabilityStatement.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CapabilityStatement.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "CapabilityStatement.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CapabilityStatement.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "CapabilityStatement.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "CapabilityStatement.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.date", Name: "date", Field: 26, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CapabilityStatement.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CapabilityStatement.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "CapabilityStatement.actorDefinition", Name: "actorDefinition", Field: 34, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.jurisdiction", Name: "jurisdiction", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CapabilityStatement.purpose", Name: "purpose", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.copyright", Name: "copyright", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.copyrightLabel", Name: "copyrightLabel", Field: 41, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.kind", Name: "kind", Field: 43, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/capability-statement-kind"}},
+	{Path: "CapabilityStatement.instantiates", Name: "instantiates", Field: 45, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.imports", Name: "imports", Field: 47, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.software", Name: "software", Field: 49, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.implementation", Name: "implementation", Field: 50, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.fhirVersion", Name: "fhirVersion", Field: 51, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CapabilityStatement.format", Name: "format", Field: 53, Min: 1, Max: "*", Types: []string{"code"}},
+	{Path: "CapabilityStatement.patchFormat", Name: "patchFormat", Field: 55, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "CapabilityStatement.acceptLanguage", Name: "acceptLanguage", Field: 57, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "CapabilityStatement.implementationGuide", Name: "implementationGuide", Field: 59, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.rest", Name: "rest", Field: 61, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.messaging", Name: "messaging", Field: 62, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.document", Name: "document", Field: 63, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CapabilityStatement) ElementMetadata() []ElementMetadata {
+	return capabilityStatementElementMetadata
+}
+
+// CapabilityStatementVersionAlgorithm is the type of CapabilityStatement.versionAlgorithm[x]. It is implemented by the CapabilityStatementVersionAlgorithm* variant types.
+type CapabilityStatementVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCapabilityStatementVersionAlgorithm()
+}
+
+// CapabilityStatementVersionAlgorithmString is the string variant of CapabilityStatementVersionAlgorithm.
+type CapabilityStatementVersionAlgorithmString string
+
+func (v CapabilityStatementVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v CapabilityStatementVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v CapabilityStatementVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v CapabilityStatementVersionAlgorithmString) isCapabilityStatementVersionAlgorithm() {}
+
+// CapabilityStatementVersionAlgorithmCoding is the Coding variant of CapabilityStatementVersionAlgorithm.
+type CapabilityStatementVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v CapabilityStatementVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v CapabilityStatementVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v CapabilityStatementVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v CapabilityStatementVersionAlgorithmCoding) isCapabilityStatementVersionAlgorithm() {}
+
+var capabilityStatementVersionAlgorithmVariants = []CapabilityStatementVersionAlgorithm{
+	CapabilityStatementVersionAlgorithmString(""),
+	CapabilityStatementVersionAlgorithmCoding{},
+}
+
+func (r *CapabilityStatement) GetResourceType() string {
+	return "CapabilityStatement"
+}
+
+func (r *CapabilityStatement) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CapabilityStatement) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CapabilityStatement) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CapabilityStatement) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CapabilityStatement) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CapabilityStatement) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CapabilityStatement) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CapabilityStatement)(nil)
+
+type CapabilityStatementSoftware struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name               string      `json:"name" bson:"name"`                                                // A name the software is known by
+	NameElement        *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Version            *string     `json:"version,omitempty" bson:"version,omitempty"`                      // Version covered by this statement
+	VersionElement     *Element    `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
+	ReleaseDate        *DateTime   `json:"releaseDate,omitempty" bson:"release_date,omitempty"`             // Date this version was released
+	ReleaseDateElement *Element    `json:"_releaseDate,omitempty" bson:"release_date_element,omitempty"`    // Extensions for releaseDate
+}
+
+func (r *CapabilityStatementSoftware) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if r.ReleaseDate != nil {
+		if err := r.ReleaseDate.Validate(); err != nil {
+			return fmt.Errorf("ReleaseDate: %w", err)
+		}
+	}
+	if r.ReleaseDateElement != nil {
+		if err := r.ReleaseDateElement.Validate(); err != nil {
+			return fmt.Errorf("ReleaseDateElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementSoftware) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if r.ReleaseDate != nil {
+		if err := r.ReleaseDate.Validate(); err != nil {
+			issues.add("value", path+".releaseDate", err.Error())
+		}
+	}
+	if r.ReleaseDateElement != nil {
+		r.ReleaseDateElement.validateAll(path+".releaseDate", issues)
+	}
+}
+
+var capabilityStatementSoftwareInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementSoftware) invariants() []invariant {
+	return capabilityStatementSoftwareInvariants
+}
+
+func (r CapabilityStatementSoftware) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementSoftware) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementSoftware) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementSoftware) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementSoftwareElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.software.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.software.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.software.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.software.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.software.version", Name: "version", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.software.releaseDate", Name: "releaseDate", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime"}},
+}
+
+func (r *CapabilityStatementSoftware) ElementMetadata() []ElementMetadata {
+	return capabilityStatementSoftwareElementMetadata
+}
+
+type CapabilityStatementImplementation struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Description        string      `json:"description" bson:"description"`                                  // Describes this specific instance
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Url                *string     `json:"url,omitempty" bson:"url,omitempty"`                              // Base URL for the installation
+	UrlElement         *Element    `json:"_url,omitempty" bson:"url_element,omitempty"`                     // Extensions for url
+	Custodian          *Reference  `json:"custodian,omitempty" bson:"custodian,omitempty"`                  // Organization that manages the data
+}
+
+func (r *CapabilityStatementImplementation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Description == emptyString {
+		return fmt.Errorf("field 'Description' is required")
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
+	if r.Custodian != nil {
+		if err := r.Custodian.Validate(); err != nil {
+			return fmt.Errorf("Custodian: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementImplementation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Description == emptyString {
+		issues.add("required", path+".description", "field 'Description' is required")
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.Custodian != nil {
+		r.Custodian.validateAll(path+".custodian", issues)
+	}
+}
+
+var capabilityStatementImplementationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementImplementation) invariants() []invariant {
+	return capabilityStatementImplementationInvariants
+}
+
+func (r CapabilityStatementImplementation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementImplementation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementImplementation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementImplementation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementImplementationElementTypes = map[string]string{
+	"description": "markdown",
+	"url":         "url",
+}
+
+func (r *CapabilityStatementImplementation) elementTypes() map[string]string {
+	return capabilityStatementImplementationElementTypes
+}
+
+var capabilityStatementImplementationElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.implementation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.implementation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.implementation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.implementation.description", Name: "description", Field: 3, Min: 1, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.implementation.url", Name: "url", Field: 5, Min: 0, Max: "1", Types: []string{"url"}},
+	{Path: "CapabilityStatement.implementation.custodian", Name: "custodian", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *CapabilityStatementImplementation) ElementMetadata() []ElementMetadata {
+	return capabilityStatementImplementationElementMetadata
+}
+
+type CapabilityStatementRest struct {
+	Id                   *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode                 RestfulCapabilityMode                        `json:"mode" bson:"mode"`                                                // client | server
+	ModeElement          *Element                                     `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Documentation        *string                                      `json:"documentation,omitempty" bson:"documentation,omitempty"`          // General description of implementation
+	DocumentationElement *Element                                     `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+	Security             *CapabilityStatementRestSecurity             `json:"security,omitempty" bson:"security,omitempty"`                    // Information about security of implementation
+	Resource             []CapabilityStatementRestResource            `json:"resource,omitempty" bson:"resource,omitempty"`                    // Resource served on the REST interface
+	Interaction          []CapabilityStatementRestInteraction         `json:"interaction,omitempty" bson:"interaction,omitempty"`              // What interactions are supported?
+	SearchParam          []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty" bson:"search_param,omitempty"`             // Search parameters for searching all resources
+	Operation            []CapabilityStatementRestResourceOperation   `json:"operation,omitempty" bson:"operation,omitempty"`                  // Definition of a system level operation
+	Compartment          []string                                     `json:"compartment,omitempty" bson:"compartment,omitempty"`              // Compartments served/used by system
+	CompartmentElement   []*Element                                   `json:"_compartment,omitempty" bson:"compartment_element,omitempty"`     // Extensions for compartment
+}
+
+func (r *CapabilityStatementRest) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Mode == "" {
+		return fmt.Errorf("field 'Mode' is required")
+	}
+	if !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", r.Mode)
+	}
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	if r.Security != nil {
+		if err := r.Security.Validate(); err != nil {
+			return fmt.Errorf("Security: %w", err)
+		}
+	}
+	for i, item := range r.Resource {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Resource[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Interaction {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Interaction[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SearchParam {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SearchParam[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Operation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Operation[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.CompartmentElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("CompartmentElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementRest) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode == "" {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != "" && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	if r.Security != nil {
+		r.Security.validateAll(path+".security", issues)
+	}
+	for i, item := range r.Resource {
+		item.validateAll(fmt.Sprintf("%s.resource[%d]", path, i), issues)
+	}
+	for i, item := range r.Interaction {
+		item.validateAll(fmt.Sprintf("%s.interaction[%d]", path, i), issues)
+	}
+	for i, item := range r.SearchParam {
+		item.validateAll(fmt.Sprintf("%s.searchParam[%d]", path, i), issues)
+	}
+	for i, item := range r.Operation {
+		item.validateAll(fmt.Sprintf("%s.operation[%d]", path, i), issues)
+	}
+	for i, item := range r.CompartmentElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.compartment[%d]", path, i), issues)
+	}
+}
+
+var capabilityStatementRestInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRest) invariants() []invariant {
+	return capabilityStatementRestInvariants
+}
+
+func (r CapabilityStatementRest) MarshalJSON() ([]byte, error) {
+	type alias CapabilityStatementRest
+	out := struct {
+		alias
+		Compartment        []*string  `json:"compartment,omitempty"`
+		CompartmentElement []*Element `json:"_compartment,omitempty"`
+	}{alias: alias(r)}
+	out.Compartment, out.CompartmentElement = alignPrimitiveArray(r.Compartment, r.CompartmentElement)
+	return json.Marshal(out)
+}
+
+func (r *CapabilityStatementRest) UnmarshalJSON(data []byte) error {
+	type alias CapabilityStatementRest
+	aux := struct {
+		*alias
+		Compartment []*string `json:"compartment,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Compartment, err = splitPrimitiveArray(aux.Compartment, r.CompartmentElement); err != nil {
+		return fmt.Errorf("compartment: %w", err)
+	}
+	return nil
+}
+
+func (r CapabilityStatementRest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestElementTypes = map[string]string{
+	"documentation": "markdown",
+	"compartment":   "canonical",
+}
+
+func (r *CapabilityStatementRest) elementTypes() map[string]string {
+	return capabilityStatementRestElementTypes
+}
+
+var capabilityStatementRestElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.mode", Name: "mode", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/restful-capability-mode"}},
+	{Path: "CapabilityStatement.rest.documentation", Name: "documentation", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.rest.security", Name: "security", Field: 7, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.resource", Name: "resource", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.interaction", Name: "interaction", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.searchParam", Name: "searchParam", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.operation", Name: "operation", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.compartment", Name: "compartment", Field: 12, Min: 0, Max: "*", Types: []string{"canonical"}},
+}
+
+func (r *CapabilityStatementRest) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestElementMetadata
+}
+
+type CapabilityStatementRestSecurity struct {
+	Id                 *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Cors               *bool             `json:"cors,omitempty" bson:"cors,omitempty"`                            // Adds CORS Headers (http://enable-cors.org/)
+	CorsElement        *Element          `json:"_cors,omitempty" bson:"cors_element,omitempty"`                   // Extensions for cors
+	Service            []CodeableConcept `json:"service,omitempty" bson:"service,omitempty"`                      // OAuth | SMART-on-FHIR | NTLM | Basic | Kerberos | Certificates
+	Description        *string           `json:"description,omitempty" bson:"description,omitempty"`              // General description of how security works
+	DescriptionElement *Element          `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+}
+
+func (r *CapabilityStatementRestSecurity) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.CorsElement != nil {
+		if err := r.CorsElement.Validate(); err != nil {
+			return fmt.Errorf("CorsElement: %w", err)
+		}
+	}
+	for i, item := range r.Service {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Service[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementRestSecurity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.CorsElement != nil {
+		r.CorsElement.validateAll(path+".cors", issues)
+	}
+	for i, item := range r.Service {
+		item.validateAll(fmt.Sprintf("%s.service[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+}
+
+var capabilityStatementRestSecurityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRestSecurity) invariants() []invariant {
+	return capabilityStatementRestSecurityInvariants
+}
+
+func (r CapabilityStatementRestSecurity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRestSecurity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRestSecurity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRestSecurity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestSecurityElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *CapabilityStatementRestSecurity) elementTypes() map[string]string {
+	return capabilityStatementRestSecurityElementTypes
+}
+
+var capabilityStatementRestSecurityElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.security.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.security.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.security.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.security.cors", Name: "cors", Field: 3, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.rest.security.service", Name: "service", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CapabilityStatement.rest.security.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *CapabilityStatementRestSecurity) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestSecurityElementMetadata
+}
+
+type CapabilityStatementRestResource struct {
+	Id                       *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension                []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	Type                     string                                       `json:"type" bson:"type"`                                                         // A resource type that is supported
+	TypeElement              *Element                                     `json:"_type,omitempty" bson:"type_element,omitempty"`                            // Extensions for type
+	Definition               *string                                      `json:"definition,omitempty" bson:"definition,omitempty"`                         // The definition for an additional resource
+	DefinitionElement        *Element                                     `json:"_definition,omitempty" bson:"definition_element,omitempty"`                // Extensions for definition
+	Profile                  *string                                      `json:"profile,omitempty" bson:"profile,omitempty"`                               // System-wide profile
+	ProfileElement           *Element                                     `json:"_profile,omitempty" bson:"profile_element,omitempty"`                      // Extensions for profile
+	SupportedProfile         []string                                     `json:"supportedProfile,omitempty" bson:"supported_profile,omitempty"`            // Use-case specific profiles
+	SupportedProfileElement  []*Element                                   `json:"_supportedProfile,omitempty" bson:"supported_profile_element,omitempty"`   // Extensions for supportedProfile
+	Documentation            *string                                      `json:"documentation,omitempty" bson:"documentation,omitempty"`                   // Additional information about the use of the resource type
+	DocumentationElement     *Element                                     `json:"_documentation,omitempty" bson:"documentation_element,omitempty"`          // Extensions for documentation
+	Interaction              []CapabilityStatementRestResourceInteraction `json:"interaction,omitempty" bson:"interaction,omitempty"`                       // What interactions are supported?
+	Versioning               *ResourceVersionPolicy                       `json:"versioning,omitempty" bson:"versioning,omitempty"`                         // no-version | versioned | versioned-update
+	VersioningElement        *Element                                     `json:"_versioning,omitempty" bson:"versioning_element,omitempty"`                // Extensions for versioning
+	ReadHistory              *bool                                        `json:"readHistory,omitempty" bson:"read_history,omitempty"`                      // Whether vRead can return past versions
+	ReadHistoryElement       *Element                                     `json:"_readHistory,omitempty" bson:"read_history_element,omitempty"`             // Extensions for readHistory
+	UpdateCreate             *bool                                        `json:"updateCreate,omitempty" bson:"update_create,omitempty"`                    // If update can commit to a new identity
+	UpdateCreateElement      *Element                                     `json:"_updateCreate,omitempty" bson:"update_create_element,omitempty"`           // Extensions for updateCreate
+	ConditionalCreate        *bool                                        `json:"conditionalCreate,omitempty" bson:"conditional_create,omitempty"`          // If allows/uses conditional create
+	ConditionalCreateElement *Element                                     `json:"_conditionalCreate,omitempty" bson:"conditional_create_element,omitempty"` // Extensions for conditionalCreate
+	ConditionalRead          *ConditionalReadStatus                       `json:"conditionalRead,omitempty" bson:"conditional_read,omitempty"`              // not-supported | modified-since | not-match | full-support
+	ConditionalReadElement   *Element                                     `json:"_conditionalRead,omitempty" bson:"conditional_read_element,omitempty"`     // Extensions for conditionalRead
+	ConditionalUpdate        *bool                                        `json:"conditionalUpdate,omitempty" bson:"conditional_update,omitempty"`          // If allows/uses conditional update
+	ConditionalUpdateElement *Element                                     `json:"_conditionalUpdate,omitempty" bson:"conditional_update_element,omitempty"` // Extensions for conditionalUpdate
+	ConditionalPatch         *bool                                        `json:"conditionalPatch,omitempty" bson:"conditional_patch,omitempty"`            // If allows/uses conditional patch
+	ConditionalPatchElement  *Element                                     `json:"_conditionalPatch,omitempty" bson:"conditional_patch_element,omitempty"`   // Extensions for conditionalPatch
+	ConditionalDelete        *ConditionalDeleteStatus                     `json:"conditionalDelete,omitempty" bson:"conditional_delete,omitempty"`          // not-supported | single | multiple - how conditional delete is supported
+	ConditionalDeleteElement *Element                                     `json:"_conditionalDelete,omitempty" bson:"conditional_delete_element,omitempty"` // Extensions for conditionalDelete
+	ReferencePolicy          []ReferenceHandlingPolicy                    `json:"referencePolicy,omitempty" bson:"reference_policy,omitempty"`              // literal | logical | resolves | enforced | local
+	ReferencePolicyElement   []*Element                                   `json:"_referencePolicy,omitempty" bson:"reference_policy_element,omitempty"`     // Extensions for referencePolicy
+	SearchInclude            []string                                     `json:"searchInclude,omitempty" bson:"search_include,omitempty"`                  // _include values supported by the server
+	SearchIncludeElement     []*Element                                   `json:"_searchInclude,omitempty" bson:"search_include_element,omitempty"`         // Extensions for searchInclude
+	SearchRevInclude         []string                                     `json:"searchRevInclude,omitempty" bson:"search_rev_include,omitempty"`           // _revinclude values supported by the server
+	SearchRevIncludeElement  []*Element                                   `json:"_searchRevInclude,omitempty" bson:"search_rev_include_element,omitempty"`  // Extensions for searchRevInclude
+	SearchParam              []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty" bson:"search_param,omitempty"`                      // Search parameters supported by implementation
+	Operation                []CapabilityStatementRestResourceOperation   `json:"operation,omitempty" bson:"operation,omitempty"`                           // Definition of a resource operation
+}
+
+func (r *CapabilityStatementRestResource) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Type == emptyString {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	if r.ProfileElement != nil {
+		if err := r.ProfileElement.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement: %w", err)
+		}
+	}
+	for i, item := range r.SupportedProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SupportedProfileElement[%d]: %w", i, err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	for i, item := range r.Interaction {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Interaction[%d]: %w", i, err)
+		}
+	}
+	if r.Versioning != nil && !r.Versioning.IsValid() {
+		return fmt.Errorf("field 'Versioning' has invalid code '%s'", *r.Versioning)
+	}
+	if r.VersioningElement != nil {
+		if err := r.VersioningElement.Validate(); err != nil {
+			return fmt.Errorf("VersioningElement: %w", err)
+		}
+	}
+	if r.ReadHistoryElement != nil {
+		if err := r.ReadHistoryElement.Validate(); err != nil {
+			return fmt.Errorf("ReadHistoryElement: %w", err)
+		}
+	}
+	if r.UpdateCreateElement != nil {
+		if err := r.UpdateCreateElement.Validate(); err != nil {
+			return fmt.Errorf("UpdateCreateElement: %w", err)
+		}
+	}
+	if r.ConditionalCreateElement != nil {
+		if err := r.ConditionalCreateElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionalCreateElement: %w", err)
+		}
+	}
+	if r.ConditionalRead != nil && !r.ConditionalRead.IsValid() {
+		return fmt.Errorf("field 'ConditionalRead' has invalid code '%s'", *r.ConditionalRead)
+	}
+	if r.ConditionalReadElement != nil {
+		if err := r.ConditionalReadElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionalReadElement: %w", err)
+		}
+	}
+	if r.ConditionalUpdateElement != nil {
+		if err := r.ConditionalUpdateElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionalUpdateElement: %w", err)
+		}
+	}
+	if r.ConditionalPatchElement != nil {
+		if err := r.ConditionalPatchElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionalPatchElement: %w", err)
+		}
+	}
+	if r.ConditionalDelete != nil && !r.ConditionalDelete.IsValid() {
+		return fmt.Errorf("field 'ConditionalDelete' has invalid code '%s'", *r.ConditionalDelete)
+	}
+	if r.ConditionalDeleteElement != nil {
+		if err := r.ConditionalDeleteElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionalDeleteElement: %w", err)
+		}
+	}
+	for i, item := range r.ReferencePolicy {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'ReferencePolicy[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ReferencePolicyElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReferencePolicyElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SearchIncludeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SearchIncludeElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SearchRevIncludeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SearchRevIncludeElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SearchParam {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SearchParam[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Operation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Operation[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementRestResource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
 	if r.Type == emptyString {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.ProfileElement != nil {
+		r.ProfileElement.validateAll(path+".profile", issues)
+	}
+	for i, item := range r.SupportedProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.supportedProfile[%d]", path, i), issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	for i, item := range r.Interaction {
+		item.validateAll(fmt.Sprintf("%s.interaction[%d]", path, i), issues)
+	}
+	if r.Versioning != nil && !r.Versioning.IsValid() {
+		issues.add("code-invalid", path+".versioning", fmt.Sprintf("field 'Versioning' has invalid code '%s'", *r.Versioning))
+	}
+	if r.VersioningElement != nil {
+		r.VersioningElement.validateAll(path+".versioning", issues)
+	}
+	if r.ReadHistoryElement != nil {
+		r.ReadHistoryElement.validateAll(path+".readHistory", issues)
+	}
+	if r.UpdateCreateElement != nil {
+		r.UpdateCreateElement.validateAll(path+".updateCreate", issues)
+	}
+	if r.ConditionalCreateElement != nil {
+		r.ConditionalCreateElement.validateAll(path+".conditionalCreate", issues)
+	}
+	if r.ConditionalRead != nil && !r.ConditionalRead.IsValid() {
+		issues.add("code-invalid", path+".conditionalRead", fmt.Sprintf("field 'ConditionalRead' has invalid code '%s'", *r.ConditionalRead))
+	}
+	if r.ConditionalReadElement != nil {
+		r.ConditionalReadElement.validateAll(path+".conditionalRead", issues)
+	}
+	if r.ConditionalUpdateElement != nil {
+		r.ConditionalUpdateElement.validateAll(path+".conditionalUpdate", issues)
+	}
+	if r.ConditionalPatchElement != nil {
+		r.ConditionalPatchElement.validateAll(path+".conditionalPatch", issues)
+	}
+	if r.ConditionalDelete != nil && !r.ConditionalDelete.IsValid() {
+		issues.add("code-invalid", path+".conditionalDelete", fmt.Sprintf("field 'ConditionalDelete' has invalid code '%s'", *r.ConditionalDelete))
+	}
+	if r.ConditionalDeleteElement != nil {
+		r.ConditionalDeleteElement.validateAll(path+".conditionalDelete", issues)
+	}
+	for i, item := range r.ReferencePolicy {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.referencePolicy[%d]", path, i), fmt.Sprintf("field 'ReferencePolicy[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ReferencePolicyElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.referencePolicy[%d]", path, i), issues)
+	}
+	for i, item := range r.SearchIncludeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.searchInclude[%d]", path, i), issues)
+	}
+	for i, item := range r.SearchRevIncludeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.searchRevInclude[%d]", path, i), issues)
+	}
+	for i, item := range r.SearchParam {
+		item.validateAll(fmt.Sprintf("%s.searchParam[%d]", path, i), issues)
+	}
+	for i, item := range r.Operation {
+		item.validateAll(fmt.Sprintf("%s.operation[%d]", path, i), issues)
+	}
+}
+
+var capabilityStatementRestResourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRestResource) invariants() []invariant {
+	return capabilityStatementRestResourceInvariants
+}
+
+func (r CapabilityStatementRestResource) MarshalJSON() ([]byte, error) {
+	type alias CapabilityStatementRestResource
+	out := struct {
+		alias
+		SupportedProfile        []*string                  `json:"supportedProfile,omitempty"`
+		SupportedProfileElement []*Element                 `json:"_supportedProfile,omitempty"`
+		ReferencePolicy         []*ReferenceHandlingPolicy `json:"referencePolicy,omitempty"`
+		ReferencePolicyElement  []*Element                 `json:"_referencePolicy,omitempty"`
+		SearchInclude           []*string                  `json:"searchInclude,omitempty"`
+		SearchIncludeElement    []*Element                 `json:"_searchInclude,omitempty"`
+		SearchRevInclude        []*string                  `json:"searchRevInclude,omitempty"`
+		SearchRevIncludeElement []*Element                 `json:"_searchRevInclude,omitempty"`
+	}{alias: alias(r)}
+	out.SupportedProfile, out.SupportedProfileElement = alignPrimitiveArray(r.SupportedProfile, r.SupportedProfileElement)
+	out.ReferencePolicy, out.ReferencePolicyElement = alignPrimitiveArray(r.ReferencePolicy, r.ReferencePolicyElement)
+	out.SearchInclude, out.SearchIncludeElement = alignPrimitiveArray(r.SearchInclude, r.SearchIncludeElement)
+	out.SearchRevInclude, out.SearchRevIncludeElement = alignPrimitiveArray(r.SearchRevInclude, r.SearchRevIncludeElement)
+	return json.Marshal(out)
+}
+
+func (r *CapabilityStatementRestResource) UnmarshalJSON(data []byte) error {
+	type alias CapabilityStatementRestResource
+	aux := struct {
+		*alias
+		SupportedProfile []*string                  `json:"supportedProfile,omitempty"`
+		ReferencePolicy  []*ReferenceHandlingPolicy `json:"referencePolicy,omitempty"`
+		SearchInclude    []*string                  `json:"searchInclude,omitempty"`
+		SearchRevInclude []*string                  `json:"searchRevInclude,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.SupportedProfile, err = splitPrimitiveArray(aux.SupportedProfile, r.SupportedProfileElement); err != nil {
+		return fmt.Errorf("supportedProfile: %w", err)
+	}
+	if r.ReferencePolicy, err = splitPrimitiveArray(aux.ReferencePolicy, r.ReferencePolicyElement); err != nil {
+		return fmt.Errorf("referencePolicy: %w", err)
+	}
+	if r.SearchInclude, err = splitPrimitiveArray(aux.SearchInclude, r.SearchIncludeElement); err != nil {
+		return fmt.Errorf("searchInclude: %w", err)
+	}
+	if r.SearchRevInclude, err = splitPrimitiveArray(aux.SearchRevInclude, r.SearchRevIncludeElement); err != nil {
+		return fmt.Errorf("searchRevInclude: %w", err)
+	}
+	return nil
+}
+
+func (r CapabilityStatementRestResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRestResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRestResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRestResource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestResourceElementTypes = map[string]string{
+	"type":             "uri",
+	"definition":       "canonical",
+	"profile":          "canonical",
+	"supportedProfile": "canonical",
+	"documentation":    "markdown",
+}
+
+func (r *CapabilityStatementRestResource) elementTypes() map[string]string {
+	return capabilityStatementRestResourceElementTypes
+}
+
+var capabilityStatementRestResourceElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.resource.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.resource.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.resource.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "CapabilityStatement.rest.resource.definition", Name: "definition", Field: 5, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.rest.resource.profile", Name: "profile", Field: 7, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.rest.resource.supportedProfile", Name: "supportedProfile", Field: 9, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.rest.resource.documentation", Name: "documentation", Field: 11, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.rest.resource.interaction", Name: "interaction", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.resource.versioning", Name: "versioning", Field: 14, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.resource.versioning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/versioning-policy"}},
+	{Path: "CapabilityStatement.rest.resource.readHistory", Name: "readHistory", Field: 16, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.rest.resource.updateCreate", Name: "updateCreate", Field: 18, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.rest.resource.conditionalCreate", Name: "conditionalCreate", Field: 20, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.rest.resource.conditionalRead", Name: "conditionalRead", Field: 22, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.resource.conditionalRead", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conditional-read-status"}},
+	{Path: "CapabilityStatement.rest.resource.conditionalUpdate", Name: "conditionalUpdate", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.rest.resource.conditionalPatch", Name: "conditionalPatch", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CapabilityStatement.rest.resource.conditionalDelete", Name: "conditionalDelete", Field: 28, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.resource.conditionalDelete", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conditional-delete-status"}},
+	{Path: "CapabilityStatement.rest.resource.referencePolicy", Name: "referencePolicy", Field: 30, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.resource.referencePolicy", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/reference-handling-policy"}},
+	{Path: "CapabilityStatement.rest.resource.searchInclude", Name: "searchInclude", Field: 32, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.searchRevInclude", Name: "searchRevInclude", Field: 34, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.searchParam", Name: "searchParam", Field: 36, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.rest.resource.operation", Name: "operation", Field: 37, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CapabilityStatementRestResource) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestResourceElementMetadata
+}
+
+type CapabilityStatementRestResourceInteraction struct {
+	Id                   *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code                 InteractionTrigger `json:"code" bson:"code"`                                                // read | vread | update | update-conditional | patch | patch-conditional | delete | delete-conditional-single | delete-conditional-multiple | delete-history | delete-history-version | history-instance | history-type | create | create-conditional | search-type
+	CodeElement          *Element           `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Documentation        *string            `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Anything special about interaction behavior
+	DocumentationElement *Element           `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *CapabilityStatementRestResourceInteraction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == "" {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if !r.Code.IsValid() {
+		return fmt.Errorf("field 'Code' has invalid code '%s'", r.Code)
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementRestResourceInteraction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == "" {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != "" && !r.Code.IsValid() {
+		issues.add("code-invalid", path+".code", fmt.Sprintf("field 'Code' has invalid code '%s'", r.Code))
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var capabilityStatementRestResourceInteractionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRestResourceInteraction) invariants() []invariant {
+	return capabilityStatementRestResourceInteractionInvariants
+}
+
+func (r CapabilityStatementRestResourceInteraction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRestResourceInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRestResourceInteraction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRestResourceInteraction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestResourceInteractionElementTypes = map[string]string{
+	"documentation": "markdown",
+}
+
+func (r *CapabilityStatementRestResourceInteraction) elementTypes() map[string]string {
+	return capabilityStatementRestResourceInteractionElementTypes
+}
+
+var capabilityStatementRestResourceInteractionElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.resource.interaction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.interaction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.resource.interaction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.resource.interaction.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.resource.interaction.code", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/interaction-trigger"}},
+	{Path: "CapabilityStatement.rest.resource.interaction.documentation", Name: "documentation", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *CapabilityStatementRestResourceInteraction) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestResourceInteractionElementMetadata
+}
+
+type CapabilityStatementRestResourceSearchParam struct {
+	Id                   *string         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name                 string          `json:"name" bson:"name"`                                                // Name for parameter in search url
+	NameElement          *Element        `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Definition           *string         `json:"definition,omitempty" bson:"definition,omitempty"`                // Source of definition for parameter
+	DefinitionElement    *Element        `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+	Type                 SearchParamType `json:"type" bson:"type"`                                                // number | date | string | token | reference | composite | quantity | uri | special | resource
+	TypeElement          *Element        `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Documentation        *string         `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Server-specific usage
+	DocumentationElement *Element        `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var capabilityStatementRestResourceSearchParamInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) invariants() []invariant {
+	return capabilityStatementRestResourceSearchParamInvariants
+}
+
+func (r CapabilityStatementRestResourceSearchParam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRestResourceSearchParam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestResourceSearchParamElementTypes = map[string]string{
+	"definition":    "canonical",
+	"documentation": "markdown",
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) elementTypes() map[string]string {
+	return capabilityStatementRestResourceSearchParamElementTypes
+}
+
+var capabilityStatementRestResourceSearchParamElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.resource.searchParam.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.searchParam.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.resource.searchParam.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.resource.searchParam.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.searchParam.definition", Name: "definition", Field: 5, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.rest.resource.searchParam.type", Name: "type", Field: 7, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.rest.resource.searchParam.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-param-type"}},
+	{Path: "CapabilityStatement.rest.resource.searchParam.documentation", Name: "documentation", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *CapabilityStatementRestResourceSearchParam) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestResourceSearchParamElementMetadata
+}
+
+type CapabilityStatementRestResourceOperation struct {
+	Id                   *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name                 string      `json:"name" bson:"name"`                                                // Name by which the operation/query is invoked
+	NameElement          *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Definition           string      `json:"definition" bson:"definition"`                                    // The defined operation/query
+	DefinitionElement    *Element    `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+	Documentation        *string     `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Specific details about operation behavior
+	DocumentationElement *Element    `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *CapabilityStatementRestResourceOperation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Definition == emptyString {
+		return fmt.Errorf("field 'Definition' is required")
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *CapabilityStatementRestResourceOperation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Definition == emptyString {
+		issues.add("required", path+".definition", "field 'Definition' is required")
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var capabilityStatementRestResourceOperationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRestResourceOperation) invariants() []invariant {
+	return capabilityStatementRestResourceOperationInvariants
+}
+
+func (r CapabilityStatementRestResourceOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRestResourceOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRestResourceOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRestResourceOperation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestResourceOperationElementTypes = map[string]string{
+	"definition":    "canonical",
+	"documentation": "markdown",
+}
+
+func (r *CapabilityStatementRestResourceOperation) elementTypes() map[string]string {
+	return capabilityStatementRestResourceOperationElementTypes
+}
+
+var capabilityStatementRestResourceOperationElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.resource.operation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.operation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.resource.operation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.resource.operation.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.resource.operation.definition", Name: "definition", Field: 5, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "CapabilityStatement.rest.resource.operation.documentation", Name: "documentation", Field: 7, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *CapabilityStatementRestResourceOperation) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestResourceOperationElementMetadata
+}
+
 type CapabilityStatementRestInteraction struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Code          string  `json:"code" bson:"code"`                                       // transaction | batch | search-system | history-system
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Anything special about interaction behavior
+	Id                   *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code                 string      `json:"code" bson:"code"`                                                // transaction | batch | search-system | history-system
+	CodeElement          *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Documentation        *string     `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Anything special about interaction behavior
+	DocumentationElement *Element    `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
 }
 
 func (r *CapabilityStatementRestInteraction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Code == emptyString {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementRestInteraction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var capabilityStatementRestInteractionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementRestInteraction) invariants() []invariant {
+	return capabilityStatementRestInteractionInvariants
+}
+
+func (r CapabilityStatementRestInteraction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementRestInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementRestInteraction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementRestInteraction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementRestInteractionElementTypes = map[string]string{
+	"code":          "code",
+	"documentation": "markdown",
+}
+
+func (r *CapabilityStatementRestInteraction) elementTypes() map[string]string {
+	return capabilityStatementRestInteractionElementTypes
+}
+
+var capabilityStatementRestInteractionElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.rest.interaction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.rest.interaction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.rest.interaction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.rest.interaction.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CapabilityStatement.rest.interaction.documentation", Name: "documentation", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *CapabilityStatementRestInteraction) ElementMetadata() []ElementMetadata {
+	return capabilityStatementRestInteractionElementMetadata
+}
+
+type CapabilityStatementMessaging struct {
+	Id                   *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension                                    `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored even if unrecognized
+	Endpoint             []CapabilityStatementMessagingEndpoint         `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                     // Where messages should be sent
+	ReliableCache        *int                                           `json:"reliableCache,omitempty" bson:"reliable_cache,omitempty"`          // Reliable Message Cache Length (min)
+	ReliableCacheElement *Element                                       `json:"_reliableCache,omitempty" bson:"reliable_cache_element,omitempty"` // Extensions for reliableCache
+	Documentation        *string                                        `json:"documentation,omitempty" bson:"documentation,omitempty"`           // Messaging interface behavior details
+	DocumentationElement *Element                                       `json:"_documentation,omitempty" bson:"documentation_element,omitempty"`  // Extensions for documentation
+	SupportedMessage     []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty" bson:"supported_message,omitempty"`    // Messages supported by this system
+}
+
+func (r *CapabilityStatementMessaging) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Endpoint {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Endpoint[%d]: %w", i, err)
+		}
+	}
+	if r.ReliableCacheElement != nil {
+		if err := r.ReliableCacheElement.Validate(); err != nil {
+			return fmt.Errorf("ReliableCacheElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	for i, item := range r.SupportedMessage {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SupportedMessage[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
+func (r *CapabilityStatementMessaging) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+	if r.ReliableCacheElement != nil {
+		r.ReliableCacheElement.validateAll(path+".reliableCache", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	for i, item := range r.SupportedMessage {
+		item.validateAll(fmt.Sprintf("%s.supportedMessage[%d]", path, i), issues)
+	}
+}
+
+var capabilityStatementMessagingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementMessaging) invariants() []invariant {
+	return capabilityStatementMessagingInvariants
+}
+
+func (r CapabilityStatementMessaging) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementMessaging) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementMessaging) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementMessaging) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementMessagingElementTypes = map[string]string{
+	"reliableCache": "unsignedInt",
+	"documentation": "markdown",
+}
+
+func (r *CapabilityStatementMessaging) elementTypes() map[string]string {
+	return capabilityStatementMessagingElementTypes
+}
+
+var capabilityStatementMessagingElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.messaging.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.messaging.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.messaging.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.messaging.endpoint", Name: "endpoint", Field: 3, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CapabilityStatement.messaging.reliableCache", Name: "reliableCache", Field: 4, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "CapabilityStatement.messaging.documentation", Name: "documentation", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.messaging.supportedMessage", Name: "supportedMessage", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CapabilityStatementMessaging) ElementMetadata() []ElementMetadata {
+	return capabilityStatementMessagingElementMetadata
+}
+
 type CapabilityStatementMessagingEndpoint struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Protocol *Coding `json:"protocol" bson:"protocol"`         // http | ftp | mllp +
-	Address  string  `json:"address" bson:"address"`           // Network address or identifier of the end-point
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Protocol          *Coding     `json:"protocol" bson:"protocol"`                                        // http | ftp | mllp +
+	Address           string      `json:"address" bson:"address"`                                          // Network address or identifier of the end-point
+	AddressElement    *Element    `json:"_address,omitempty" bson:"address_element,omitempty"`             // Extensions for address
 }
 
 func (r *CapabilityStatementMessagingEndpoint) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Protocol == nil {
 		return fmt.Errorf("field 'Protocol' is required")
 	}
@@ -397,22 +2334,314 @@ func (r *CapabilityStatementMessagingEndpoint) Validate() error {
 	if r.Address == emptyString {
 		return fmt.Errorf("field 'Address' is required")
 	}
+	if r.AddressElement != nil {
+		if err := r.AddressElement.Validate(); err != nil {
+			return fmt.Errorf("AddressElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *CapabilityStatementMessagingEndpoint) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Protocol == nil {
+		issues.add("required", path+".protocol", "field 'Protocol' is required")
+	}
+	if r.Protocol != nil {
+		r.Protocol.validateAll(path+".protocol", issues)
+	}
+	var emptyString string
+	if r.Address == emptyString {
+		issues.add("required", path+".address", "field 'Address' is required")
+	}
+	if r.AddressElement != nil {
+		r.AddressElement.validateAll(path+".address", issues)
+	}
+}
+
+var capabilityStatementMessagingEndpointInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementMessagingEndpoint) invariants() []invariant {
+	return capabilityStatementMessagingEndpointInvariants
+}
+
+func (r CapabilityStatementMessagingEndpoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementMessagingEndpoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementMessagingEndpoint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementMessagingEndpoint) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementMessagingEndpointElementTypes = map[string]string{
+	"address": "url",
+}
+
+func (r *CapabilityStatementMessagingEndpoint) elementTypes() map[string]string {
+	return capabilityStatementMessagingEndpointElementTypes
+}
+
+var capabilityStatementMessagingEndpointElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.messaging.endpoint.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.messaging.endpoint.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.messaging.endpoint.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.messaging.endpoint.protocol", Name: "protocol", Field: 3, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "CapabilityStatement.messaging.endpoint.address", Name: "address", Field: 4, Min: 1, Max: "1", Types: []string{"url"}},
+}
+
+func (r *CapabilityStatementMessagingEndpoint) ElementMetadata() []ElementMetadata {
+	return capabilityStatementMessagingEndpointElementMetadata
+}
+
 type CapabilityStatementMessagingSupportedMessage struct {
-	Id         *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Mode       string  `json:"mode" bson:"mode"`                 // sender | receiver
-	Definition string  `json:"definition" bson:"definition"`     // Message supported by this system
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode              EventCapabilityMode `json:"mode" bson:"mode"`                                                // sender | receiver
+	ModeElement       *Element            `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Definition        string              `json:"definition" bson:"definition"`                                    // Message supported by this system
+	DefinitionElement *Element            `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
 }
 
 func (r *CapabilityStatementMessagingSupportedMessage) Validate() error {
-	var emptyString string
-	if r.Mode == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Mode == "" {
 		return fmt.Errorf("field 'Mode' is required")
 	}
+	if !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", r.Mode)
+	}
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Definition == emptyString {
 		return fmt.Errorf("field 'Definition' is required")
 	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CapabilityStatementMessagingSupportedMessage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode == "" {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != "" && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	var emptyString string
+	if r.Definition == emptyString {
+		issues.add("required", path+".definition", "field 'Definition' is required")
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+}
+
+var capabilityStatementMessagingSupportedMessageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementMessagingSupportedMessage) invariants() []invariant {
+	return capabilityStatementMessagingSupportedMessageInvariants
+}
+
+func (r CapabilityStatementMessagingSupportedMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementMessagingSupportedMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementMessagingSupportedMessage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementMessagingSupportedMessage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementMessagingSupportedMessageElementTypes = map[string]string{
+	"definition": "canonical",
+}
+
+func (r *CapabilityStatementMessagingSupportedMessage) elementTypes() map[string]string {
+	return capabilityStatementMessagingSupportedMessageElementTypes
+}
+
+var capabilityStatementMessagingSupportedMessageElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.messaging.supportedMessage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.messaging.supportedMessage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.messaging.supportedMessage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.messaging.supportedMessage.mode", Name: "mode", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.messaging.supportedMessage.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/event-capability-mode"}},
+	{Path: "CapabilityStatement.messaging.supportedMessage.definition", Name: "definition", Field: 5, Min: 1, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *CapabilityStatementMessagingSupportedMessage) ElementMetadata() []ElementMetadata {
+	return capabilityStatementMessagingSupportedMessageElementMetadata
+}
+
+type CapabilityStatementDocument struct {
+	Id                   *string      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode                 DocumentMode `json:"mode" bson:"mode"`                                                // producer | consumer
+	ModeElement          *Element     `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Documentation        *string      `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Description of document support
+	DocumentationElement *Element     `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+	Profile              string       `json:"profile" bson:"profile"`                                          // Constraint on the resources used in the document
+	ProfileElement       *Element     `json:"_profile,omitempty" bson:"profile_element,omitempty"`             // Extensions for profile
+}
+
+func (r *CapabilityStatementDocument) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Mode == "" {
+		return fmt.Errorf("field 'Mode' is required")
+	}
+	if !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", r.Mode)
+	}
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	var emptyString string
+	if r.Profile == emptyString {
+		return fmt.Errorf("field 'Profile' is required")
+	}
+	if r.ProfileElement != nil {
+		if err := r.ProfileElement.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *CapabilityStatementDocument) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode == "" {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != "" && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	var emptyString string
+	if r.Profile == emptyString {
+		issues.add("required", path+".profile", "field 'Profile' is required")
+	}
+	if r.ProfileElement != nil {
+		r.ProfileElement.validateAll(path+".profile", issues)
+	}
+}
+
+var capabilityStatementDocumentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CapabilityStatementDocument) invariants() []invariant {
+	return capabilityStatementDocumentInvariants
+}
+
+func (r CapabilityStatementDocument) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CapabilityStatementDocument) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CapabilityStatementDocument) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CapabilityStatementDocument) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var capabilityStatementDocumentElementTypes = map[string]string{
+	"documentation": "markdown",
+	"profile":       "canonical",
+}
+
+func (r *CapabilityStatementDocument) elementTypes() map[string]string {
+	return capabilityStatementDocumentElementTypes
+}
+
+var capabilityStatementDocumentElementMetadata = []ElementMetadata{
+	{Path: "CapabilityStatement.document.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CapabilityStatement.document.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CapabilityStatement.document.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CapabilityStatement.document.mode", Name: "mode", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CapabilityStatement.document.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/document-mode"}},
+	{Path: "CapabilityStatement.document.documentation", Name: "documentation", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CapabilityStatement.document.profile", Name: "profile", Field: 7, Min: 1, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *CapabilityStatementDocument) ElementMetadata() []ElementMetadata {
+	return capabilityStatementDocumentElementMetadata
+}
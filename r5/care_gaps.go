@@ -1,9 +1,63 @@
 package models
 
-// The care-gaps operation is used to determine gaps-in-care based on the results of quality measures
-type CareGaps struct {
+// CareGapsIn holds the input parameters of the $CareGaps operation. The care-gaps operation is used to determine gaps-in-care based on the results of quality measures
+type CareGapsIn struct {
 }
 
-func (r *CareGaps) Validate() error {
+func (p *CareGapsIn) Validate() error {
+	return nil
+}
+
+func (p *CareGapsIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *CareGapsIn) FromParameters(params *Parameters) error {
+	*p = CareGapsIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *CareGapsIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *CareGapsIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// CareGapsOut holds the output parameters of the $CareGaps operation.
+type CareGapsOut struct {
+}
+
+func (p *CareGapsOut) Validate() error {
+	return nil
+}
+
+func (p *CareGapsOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *CareGapsOut) FromParameters(params *Parameters) error {
+	*p = CareGapsOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *CareGapsOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *CareGapsOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
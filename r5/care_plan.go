@@ -2,41 +2,67 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Describes the intention of how one or more practitioners intend to deliver care for a particular patient, group or community for a period of time, possibly limited to care for a specific condition or set of conditions.
 type CarePlan struct {
-	ResourceType   string              `json:"resourceType" bson:"resource_type"`                         // Type of resource
-	Id             *string             `json:"id,omitempty" bson:"id,omitempty"`                          // Logical id of this artifact
-	Meta           *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                      // Metadata about the resource
-	ImplicitRules  *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`   // A set of rules under which this content was created
-	Language       *string             `json:"language,omitempty" bson:"language,omitempty"`              // Language of the resource content
-	Text           *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                      // Text summary of the resource, for human interpretation
-	Contained      []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`            // Contained, inline Resources
-	Identifier     []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`          // External Ids for this plan
-	BasedOn        []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`               // Fulfills plan, proposal or order
-	Replaces       []Reference         `json:"replaces,omitempty" bson:"replaces,omitempty"`              // CarePlan replaced by this CarePlan
-	PartOf         []Reference         `json:"partOf,omitempty" bson:"part_of,omitempty"`                 // Part of referenced CarePlan
-	Status         string              `json:"status" bson:"status"`                                      // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
-	Intent         string              `json:"intent" bson:"intent"`                                      // proposal | plan | order | option | directive
-	Category       []CodeableConcept   `json:"category,omitempty" bson:"category,omitempty"`              // Type of plan
-	Title          *string             `json:"title,omitempty" bson:"title,omitempty"`                    // Human-friendly name for the care plan
-	Description    *string             `json:"description,omitempty" bson:"description,omitempty"`        // Summary of nature of plan
-	Subject        *Reference          `json:"subject" bson:"subject"`                                    // Who the care plan is for
-	Encounter      *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`            // The Encounter during which this CarePlan was created
-	Period         *Period             `json:"period,omitempty" bson:"period,omitempty"`                  // Time period plan covers
-	Created        *string             `json:"created,omitempty" bson:"created,omitempty"`                // Date record was first recorded
-	Custodian      *Reference          `json:"custodian,omitempty" bson:"custodian,omitempty"`            // Who is the designated responsible party
-	Contributor    []Reference         `json:"contributor,omitempty" bson:"contributor,omitempty"`        // Who provided the content of the care plan
-	CareTeam       []Reference         `json:"careTeam,omitempty" bson:"care_team,omitempty"`             // Who's involved in plan?
-	Addresses      []CodeableReference `json:"addresses,omitempty" bson:"addresses,omitempty"`            // Health issues this plan addresses
-	SupportingInfo []Reference         `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"` // Information considered as part of plan
-	Goal           []Reference         `json:"goal,omitempty" bson:"goal,omitempty"`                      // Desired outcome of plan
-	Activity       []CarePlanActivity  `json:"activity,omitempty" bson:"activity,omitempty"`              // Action to occur or has occurred as part of plan
-	Note           []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                      // Comments about the plan
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External Ids for this plan
+	BasedOn              []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Fulfills plan, proposal or order
+	Replaces             []Reference         `json:"replaces,omitempty" bson:"replaces,omitempty"`                     // CarePlan replaced by this CarePlan
+	PartOf               []Reference         `json:"partOf,omitempty" bson:"part_of,omitempty"`                        // Part of referenced CarePlan
+	Status               RequestStatus       `json:"status" bson:"status"`                                             // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
+	StatusElement        *Element            `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Intent               string              `json:"intent" bson:"intent"`                                             // proposal | plan | order | option | directive
+	IntentElement        *Element            `json:"_intent,omitempty" bson:"intent_element,omitempty"`                // Extensions for intent
+	Category             []CodeableConcept   `json:"category,omitempty" bson:"category,omitempty"`                     // Type of plan
+	Title                *string             `json:"title,omitempty" bson:"title,omitempty"`                           // Human-friendly name for the care plan
+	TitleElement         *Element            `json:"_title,omitempty" bson:"title_element,omitempty"`                  // Extensions for title
+	Description          *string             `json:"description,omitempty" bson:"description,omitempty"`               // Summary of nature of plan
+	DescriptionElement   *Element            `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Subject              *Reference          `json:"subject" bson:"subject"`                                           // Who the care plan is for
+	Encounter            *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // The Encounter during which this CarePlan was created
+	Period               *Period             `json:"period,omitempty" bson:"period,omitempty"`                         // Time period plan covers
+	Created              *DateTime           `json:"created,omitempty" bson:"created,omitempty"`                       // Date record was first recorded
+	CreatedElement       *Element            `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Custodian            *Reference          `json:"custodian,omitempty" bson:"custodian,omitempty"`                   // Who is the designated responsible party
+	Contributor          []Reference         `json:"contributor,omitempty" bson:"contributor,omitempty"`               // Who provided the content of the care plan
+	CareTeam             []Reference         `json:"careTeam,omitempty" bson:"care_team,omitempty"`                    // Who's involved in plan?
+	Addresses            []CodeableReference `json:"addresses,omitempty" bson:"addresses,omitempty"`                   // Health issues this plan addresses
+	SupportingInfo       []Reference         `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`        // Information considered as part of plan
+	Goal                 []Reference         `json:"goal,omitempty" bson:"goal,omitempty"`                             // Desired outcome of plan
+	Activity             []CarePlanActivity  `json:"activity,omitempty" bson:"activity,omitempty"`                     // Action to occur or has occurred as part of plan
+	Note                 []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                             // Comments about the plan
 }
 
+var carePlanBasedOnTargets = []string{"CarePlan", "NutritionOrder", "RequestOrchestration", "ServiceRequest"}
+
+var carePlanReplacesTargets = []string{"CarePlan"}
+
+var carePlanPartOfTargets = []string{"CarePlan"}
+
+var carePlanSubjectTargets = []string{"Group", "Patient"}
+
+var carePlanEncounterTargets = []string{"Encounter"}
+
+var carePlanCustodianTargets = []string{"CareTeam", "Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var carePlanCareTeamTargets = []string{"CareTeam"}
+
+var carePlanGoalTargets = []string{"Goal"}
+
 func (r *CarePlan) Validate() error {
 	if r.ResourceType != "CarePlan" {
 		return fmt.Errorf("invalid resourceType: expected 'CarePlan', got '%s'", r.ResourceType)
@@ -46,51 +72,127 @@ func (r *CarePlan) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, carePlanBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanReplacesTargets); target != "" {
+			return fmt.Errorf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, carePlanReplacesTargets)
+		}
+	}
 	for i, item := range r.Replaces {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Replaces[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, carePlanPartOfTargets)
+		}
+	}
 	for i, item := range r.PartOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Intent == emptyString {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, carePlanSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, carePlanSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, carePlanEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, carePlanEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -101,6 +203,21 @@ func (r *CarePlan) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Custodian != nil {
+		if target := invalidReferenceTarget(r.Custodian.Reference, r.Custodian.Type, carePlanCustodianTargets); target != "" {
+			return fmt.Errorf("field 'Custodian' cannot refer to %s, expected one of %v", target, carePlanCustodianTargets)
+		}
+	}
 	if r.Custodian != nil {
 		if err := r.Custodian.Validate(); err != nil {
 			return fmt.Errorf("Custodian: %w", err)
@@ -111,6 +228,11 @@ func (r *CarePlan) Validate() error {
 			return fmt.Errorf("Contributor[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.CareTeam {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanCareTeamTargets); target != "" {
+			return fmt.Errorf("field 'CareTeam[%d]' cannot refer to %s, expected one of %v", i, target, carePlanCareTeamTargets)
+		}
+	}
 	for i, item := range r.CareTeam {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("CareTeam[%d]: %w", i, err)
@@ -126,6 +248,11 @@ func (r *CarePlan) Validate() error {
 			return fmt.Errorf("SupportingInfo[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Goal {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanGoalTargets); target != "" {
+			return fmt.Errorf("field 'Goal[%d]' cannot refer to %s, expected one of %v", i, target, carePlanGoalTargets)
+		}
+	}
 	for i, item := range r.Goal {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Goal[%d]: %w", i, err)
@@ -141,17 +268,325 @@ func (r *CarePlan) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "CarePlan")
+}
+
+func (r *CarePlan) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CarePlan" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CarePlan', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, carePlanBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanReplacesTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.replaces[%d]", path, i), fmt.Sprintf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, carePlanReplacesTargets))
+		}
+	}
+	for i, item := range r.Replaces {
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanPartOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.partOf[%d]", path, i), fmt.Sprintf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, carePlanPartOfTargets))
+		}
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	var emptyString string
+	if r.Intent == emptyString {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, carePlanSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, carePlanSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, carePlanEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, carePlanEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Custodian != nil {
+		if target := invalidReferenceTarget(r.Custodian.Reference, r.Custodian.Type, carePlanCustodianTargets); target != "" {
+			issues.add("value", path+".custodian", fmt.Sprintf("field 'Custodian' cannot refer to %s, expected one of %v", target, carePlanCustodianTargets))
+		}
+	}
+	if r.Custodian != nil {
+		r.Custodian.validateAll(path+".custodian", issues)
+	}
+	for i, item := range r.Contributor {
+		item.validateAll(fmt.Sprintf("%s.contributor[%d]", path, i), issues)
+	}
+	for i, item := range r.CareTeam {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanCareTeamTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.careTeam[%d]", path, i), fmt.Sprintf("field 'CareTeam[%d]' cannot refer to %s, expected one of %v", i, target, carePlanCareTeamTargets))
+		}
+	}
+	for i, item := range r.CareTeam {
+		item.validateAll(fmt.Sprintf("%s.careTeam[%d]", path, i), issues)
+	}
+	for i, item := range r.Addresses {
+		item.validateAll(fmt.Sprintf("%s.addresses[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+	for i, item := range r.Goal {
+		if target := invalidReferenceTarget(item.Reference, item.Type, carePlanGoalTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.goal[%d]", path, i), fmt.Sprintf("field 'Goal[%d]' cannot refer to %s, expected one of %v", i, target, carePlanGoalTargets))
+		}
+	}
+	for i, item := range r.Goal {
+		item.validateAll(fmt.Sprintf("%s.goal[%d]", path, i), issues)
+	}
+	for i, item := range r.Activity {
+		item.validateAll(fmt.Sprintf("%s.activity[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *CarePlan) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CarePlan", &issues)
+	checkInvariants(r, "CarePlan", &issues)
+	return issues
+}
+
+var carePlanInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *CarePlan) invariants() []invariant {
+	return carePlanInvariants
+}
+
+func (r *CarePlan) UnmarshalJSON(data []byte) error {
+	type alias CarePlan
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r CarePlan) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CarePlan) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CarePlan) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CarePlan) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var carePlanElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"intent":        "code",
+}
+
+func (r *CarePlan) elementTypes() map[string]string {
+	return carePlanElementTypes
+}
+
+var carePlanElementMetadata = []ElementMetadata{
+	{Path: "CarePlan.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CarePlan.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CarePlan.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CarePlan.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CarePlan.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CarePlan.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CarePlan.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CarePlan.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CarePlan.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CarePlan.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CarePlan.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "CarePlan.replaces", Name: "replaces", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan"}},
+	{Path: "CarePlan.partOf", Name: "partOf", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan"}},
+	{Path: "CarePlan.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CarePlan.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"}},
+	{Path: "CarePlan.intent", Name: "intent", Field: 17, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CarePlan.category", Name: "category", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CarePlan.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CarePlan.description", Name: "description", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CarePlan.subject", Name: "subject", Field: 24, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "CarePlan.encounter", Name: "encounter", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "CarePlan.period", Name: "period", Field: 26, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "CarePlan.created", Name: "created", Field: 27, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CarePlan.custodian", Name: "custodian", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "CarePlan.contributor", Name: "contributor", Field: 30, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "CarePlan.careTeam", Name: "careTeam", Field: 31, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam"}},
+	{Path: "CarePlan.addresses", Name: "addresses", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "CarePlan.supportingInfo", Name: "supportingInfo", Field: 33, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "CarePlan.goal", Name: "goal", Field: 34, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Goal"}},
+	{Path: "CarePlan.activity", Name: "activity", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CarePlan.note", Name: "note", Field: 36, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *CarePlan) ElementMetadata() []ElementMetadata {
+	return carePlanElementMetadata
+}
+
+func (r *CarePlan) GetResourceType() string {
+	return "CarePlan"
+}
+
+func (r *CarePlan) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CarePlan) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CarePlan) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CarePlan) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CarePlan) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CarePlan) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CarePlan) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CarePlan)(nil)
+
 type CarePlanActivity struct {
 	Id                       *string             `json:"id,omitempty" bson:"id,omitempty"`                                               // Unique id for inter-element referencing
+	Extension                []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                                 // Additional content defined by implementations
+	ModifierExtension        []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                // Extensions that cannot be ignored even if unrecognized
 	PerformedActivity        []CodeableReference `json:"performedActivity,omitempty" bson:"performed_activity,omitempty"`                // Activities that are completed or in progress (concept, or Appointment, Encounter, Procedure, etc.)
 	Progress                 []Annotation        `json:"progress,omitempty" bson:"progress,omitempty"`                                   // Comments about the activity status/progress
 	PlannedActivityReference *Reference          `json:"plannedActivityReference,omitempty" bson:"planned_activity_reference,omitempty"` // Activity that is intended to be part of the care plan
 }
 
+var carePlanActivityPlannedActivityReferenceTargets = []string{"Appointment", "CommunicationRequest", "DeviceRequest", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest", "Task", "VisionPrescription"}
+
 func (r *CarePlanActivity) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.PerformedActivity {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PerformedActivity[%d]: %w", i, err)
@@ -162,6 +597,11 @@ func (r *CarePlanActivity) Validate() error {
 			return fmt.Errorf("Progress[%d]: %w", i, err)
 		}
 	}
+	if r.PlannedActivityReference != nil {
+		if target := invalidReferenceTarget(r.PlannedActivityReference.Reference, r.PlannedActivityReference.Type, carePlanActivityPlannedActivityReferenceTargets); target != "" {
+			return fmt.Errorf("field 'PlannedActivityReference' cannot refer to %s, expected one of %v", target, carePlanActivityPlannedActivityReferenceTargets)
+		}
+	}
 	if r.PlannedActivityReference != nil {
 		if err := r.PlannedActivityReference.Validate(); err != nil {
 			return fmt.Errorf("PlannedActivityReference: %w", err)
@@ -169,3 +609,63 @@ func (r *CarePlanActivity) Validate() error {
 	}
 	return nil
 }
+
+func (r *CarePlanActivity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.PerformedActivity {
+		item.validateAll(fmt.Sprintf("%s.performedActivity[%d]", path, i), issues)
+	}
+	for i, item := range r.Progress {
+		item.validateAll(fmt.Sprintf("%s.progress[%d]", path, i), issues)
+	}
+	if r.PlannedActivityReference != nil {
+		if target := invalidReferenceTarget(r.PlannedActivityReference.Reference, r.PlannedActivityReference.Type, carePlanActivityPlannedActivityReferenceTargets); target != "" {
+			issues.add("value", path+".plannedActivityReference", fmt.Sprintf("field 'PlannedActivityReference' cannot refer to %s, expected one of %v", target, carePlanActivityPlannedActivityReferenceTargets))
+		}
+	}
+	if r.PlannedActivityReference != nil {
+		r.PlannedActivityReference.validateAll(path+".plannedActivityReference", issues)
+	}
+}
+
+var carePlanActivityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CarePlanActivity) invariants() []invariant {
+	return carePlanActivityInvariants
+}
+
+func (r CarePlanActivity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CarePlanActivity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CarePlanActivity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CarePlanActivity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var carePlanActivityElementMetadata = []ElementMetadata{
+	{Path: "CarePlan.activity.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CarePlan.activity.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CarePlan.activity.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CarePlan.activity.performedActivity", Name: "performedActivity", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "CarePlan.activity.progress", Name: "progress", Field: 4, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "CarePlan.activity.plannedActivityReference", Name: "plannedActivityReference", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+}
+
+func (r *CarePlanActivity) ElementMetadata() []ElementMetadata {
+	return carePlanActivityElementMetadata
+}
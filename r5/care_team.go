@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,13 +12,19 @@ type CareTeam struct {
 	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
 	Meta                 *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
 	ImplicitRules        *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
+	ImplicitRulesElement *Element              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`      // Extensions for implicitRules
 	Language             *string               `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
+	LanguageElement      *Element              `json:"_language,omitempty" bson:"language_element,omitempty"`                 // Extensions for language
 	Text                 *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Contained            []Resource            `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Extension            []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored
 	Identifier           []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // External Ids for this team
-	Status               *string               `json:"status,omitempty" bson:"status,omitempty"`                              // proposed | active | suspended | inactive | entered-in-error
+	Status               *CareTeamStatus       `json:"status,omitempty" bson:"status,omitempty"`                              // proposed | active | suspended | inactive | entered-in-error
+	StatusElement        *Element              `json:"_status,omitempty" bson:"status_element,omitempty"`                     // Extensions for status
 	Category             []CodeableConcept     `json:"category,omitempty" bson:"category,omitempty"`                          // Type of team
 	Name                 *string               `json:"name,omitempty" bson:"name,omitempty"`                                  // Name of the team, such as crisis assessment team
+	NameElement          *Element              `json:"_name,omitempty" bson:"name_element,omitempty"`                         // Extensions for name
 	Subject              *Reference            `json:"subject,omitempty" bson:"subject,omitempty"`                            // Who care team is for
 	Period               *Period               `json:"period,omitempty" bson:"period,omitempty"`                              // Time period team covers
 	Participant          []CareTeamParticipant `json:"participant,omitempty" bson:"participant,omitempty"`                    // Members of the team
@@ -27,6 +34,8 @@ type CareTeam struct {
 	Note                 []Annotation          `json:"note,omitempty" bson:"note,omitempty"`                                  // Comments made about the CareTeam
 }
 
+var careTeamSubjectTargets = []string{"Group", "Patient"}
+
 func (r *CareTeam) Validate() error {
 	if r.ResourceType != "CareTeam" {
 		return fmt.Errorf("invalid resourceType: expected 'CareTeam', got '%s'", r.ResourceType)
@@ -36,21 +45,67 @@ func (r *CareTeam) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, careTeamSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, careTeamSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -86,25 +141,248 @@ func (r *CareTeam) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "CareTeam")
+}
+
+func (r *CareTeam) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CareTeam" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CareTeam', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, careTeamSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, careTeamSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.ManagingOrganization {
+		item.validateAll(fmt.Sprintf("%s.managingOrganization[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *CareTeam) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CareTeam", &issues)
+	checkInvariants(r, "CareTeam", &issues)
+	return issues
+}
+
+var careTeamInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *CareTeam) invariants() []invariant {
+	return careTeamInvariants
+}
+
+func (r *CareTeam) UnmarshalJSON(data []byte) error {
+	type alias CareTeam
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r CareTeam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CareTeam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CareTeam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CareTeam) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var careTeamElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *CareTeam) elementTypes() map[string]string {
+	return careTeamElementTypes
+}
+
+var careTeamElementMetadata = []ElementMetadata{
+	{Path: "CareTeam.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CareTeam.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CareTeam.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CareTeam.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CareTeam.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CareTeam.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CareTeam.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CareTeam.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CareTeam.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CareTeam.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CareTeam.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CareTeam.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-team-status"}},
+	{Path: "CareTeam.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CareTeam.name", Name: "name", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CareTeam.subject", Name: "subject", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "CareTeam.period", Name: "period", Field: 18, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "CareTeam.participant", Name: "participant", Field: 19, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CareTeam.reason", Name: "reason", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "CareTeam.managingOrganization", Name: "managingOrganization", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "CareTeam.telecom", Name: "telecom", Field: 22, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "CareTeam.note", Name: "note", Field: 23, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *CareTeam) ElementMetadata() []ElementMetadata {
+	return careTeamElementMetadata
+}
+
+func (r *CareTeam) GetResourceType() string {
+	return "CareTeam"
+}
+
+func (r *CareTeam) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CareTeam) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CareTeam) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CareTeam) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CareTeam) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CareTeam) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CareTeam) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CareTeam)(nil)
+
 type CareTeamParticipant struct {
-	Id              *string          `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	Role            *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                        // Type of involvement
-	Member          *Reference       `json:"member,omitempty" bson:"member,omitempty"`                    // Who is involved
-	OnBehalfOf      *Reference       `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"`          // Entity that the participant is acting as a proxy of, or an agent of, or in the interest of, or as a representative of
-	EffectivePeriod *Period          `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"` // When the member is generally available within this care team
-	EffectiveTiming *Timing          `json:"effectiveTiming,omitempty" bson:"effective_timing,omitempty"` // When the member is generally available within this care team
-	SupportingInfo  []Reference      `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`   // Basis for the member's participation
+	Id                *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Role              *CodeableConcept             `json:"role,omitempty" bson:"role,omitempty"`                            // Type of involvement
+	Member            *Reference                   `json:"member,omitempty" bson:"member,omitempty"`                        // Who is involved
+	OnBehalfOf        *Reference                   `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"`              // Entity that the participant is acting as a proxy of, or an agent of, or in the interest of, or as a representative of
+	Effective         CareTeamParticipantEffective `json:"-" bson:"effective,omitempty"`                                    // When the member is generally available within this care team
+	SupportingInfo    []Reference                  `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`       // Basis for the member's participation
+
+	effectiveVariants []string // JSON properties of CareTeam.participant.effective[x] when more than one was decoded
 }
 
+var careTeamParticipantMemberTargets = []string{"CareTeam", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *CareTeamParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Role != nil {
 		if err := r.Role.Validate(); err != nil {
 			return fmt.Errorf("Role: %w", err)
 		}
 	}
+	if r.Member != nil {
+		if target := invalidReferenceTarget(r.Member.Reference, r.Member.Type, careTeamParticipantMemberTargets); target != "" {
+			return fmt.Errorf("field 'Member' cannot refer to %s, expected one of %v", target, careTeamParticipantMemberTargets)
+		}
+	}
 	if r.Member != nil {
 		if err := r.Member.Validate(); err != nil {
 			return fmt.Errorf("Member: %w", err)
@@ -115,14 +393,12 @@ func (r *CareTeamParticipant) Validate() error {
 			return fmt.Errorf("OnBehalfOf: %w", err)
 		}
 	}
-	if r.EffectivePeriod != nil {
-		if err := r.EffectivePeriod.Validate(); err != nil {
-			return fmt.Errorf("EffectivePeriod: %w", err)
-		}
+	if len(r.effectiveVariants) > 1 {
+		return fmt.Errorf("field 'Effective' must have a single type, got %v", r.effectiveVariants)
 	}
-	if r.EffectiveTiming != nil {
-		if err := r.EffectiveTiming.Validate(); err != nil {
-			return fmt.Errorf("EffectiveTiming: %w", err)
+	if r.Effective != nil {
+		if err := r.Effective.Validate(); err != nil {
+			return fmt.Errorf("Effective: %w", err)
 		}
 	}
 	for i, item := range r.SupportingInfo {
@@ -132,3 +408,167 @@ func (r *CareTeamParticipant) Validate() error {
 	}
 	return nil
 }
+
+func (r *CareTeamParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Member != nil {
+		if target := invalidReferenceTarget(r.Member.Reference, r.Member.Type, careTeamParticipantMemberTargets); target != "" {
+			issues.add("value", path+".member", fmt.Sprintf("field 'Member' cannot refer to %s, expected one of %v", target, careTeamParticipantMemberTargets))
+		}
+	}
+	if r.Member != nil {
+		r.Member.validateAll(path+".member", issues)
+	}
+	if r.OnBehalfOf != nil {
+		r.OnBehalfOf.validateAll(path+".onBehalfOf", issues)
+	}
+	if len(r.effectiveVariants) > 1 {
+		issues.add("structure", path+".effective", fmt.Sprintf("field 'Effective' must have a single type, got %v", r.effectiveVariants))
+	}
+	if r.Effective != nil {
+		r.Effective.validateAll(path+".effective.ofType("+r.Effective.FHIRType()+")", issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+}
+
+var careTeamParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CareTeamParticipant) invariants() []invariant {
+	return careTeamParticipantInvariants
+}
+
+func (r CareTeamParticipant) MarshalJSON() ([]byte, error) {
+	type alias CareTeamParticipant
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "effective", r.Effective, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CareTeamParticipant) UnmarshalJSON(data []byte) error {
+	type alias CareTeamParticipant
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Effective, _, r.effectiveVariants, err = unmarshalChoice(fields, "effective", careTeamParticipantEffectiveVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r CareTeamParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CareTeamParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CareTeamParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CareTeamParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CareTeamParticipant) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Effective":
+		return "effective", choiceValues(careTeamParticipantEffectiveVariants)
+	}
+	return "", nil
+}
+
+var careTeamParticipantElementMetadata = []ElementMetadata{
+	{Path: "CareTeam.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CareTeam.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CareTeam.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CareTeam.participant.role", Name: "role", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CareTeam.participant.member", Name: "member", Field: 4, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "CareTeam.participant.onBehalfOf", Name: "onBehalfOf", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "CareTeam.participant.effective[x]", Name: "effective", Field: 6, Min: 0, Max: "1", Types: []string{"Period", "Timing"}},
+	{Path: "CareTeam.participant.supportingInfo", Name: "supportingInfo", Field: 7, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *CareTeamParticipant) ElementMetadata() []ElementMetadata {
+	return careTeamParticipantElementMetadata
+}
+
+// CareTeamParticipantEffective is the type of CareTeam.participant.effective[x]. It is implemented by the CareTeamParticipantEffective* variant types.
+type CareTeamParticipantEffective interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCareTeamParticipantEffective()
+}
+
+// CareTeamParticipantEffectivePeriod is the Period variant of CareTeamParticipantEffective.
+type CareTeamParticipantEffectivePeriod struct {
+	Period
+}
+
+func (v CareTeamParticipantEffectivePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v CareTeamParticipantEffectivePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v CareTeamParticipantEffectivePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v CareTeamParticipantEffectivePeriod) isCareTeamParticipantEffective() {}
+
+// CareTeamParticipantEffectiveTiming is the Timing variant of CareTeamParticipantEffective.
+type CareTeamParticipantEffectiveTiming struct {
+	Timing
+}
+
+func (v CareTeamParticipantEffectiveTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v CareTeamParticipantEffectiveTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v CareTeamParticipantEffectiveTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v CareTeamParticipantEffectiveTiming) isCareTeamParticipantEffective() {}
+
+var careTeamParticipantEffectiveVariants = []CareTeamParticipantEffective{
+	CareTeamParticipantEffectivePeriod{},
+	CareTeamParticipantEffectiveTiming{},
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// choiceValue is implemented by every variant of a generated choice type,
+// such as ObservationValueQuantity for Observation.value[x].
+type choiceValue interface {
+	FHIRType() string
+}
+
+// choiceProperty returns the JSON property name of a choice element holding
+// a value of the given FHIR type: value + Quantity gives "valueQuantity".
+func choiceProperty(name, fhirType string) string {
+	if fhirType == "" {
+		return name
+	}
+	return name + strings.ToUpper(fhirType[:1]) + fhirType[1:]
+}
+
+// marshalChoice adds the property for the choice element name[x], and the
+// "_" companion of a primitive variant, to the encoded JSON object data.
+// element is only written when it holds a non-nil pointer. A variant holding
+// its zero value next to an element only carries extensions, as decoded from
+// a document with "_name<Type>" alone, so its value is left out.
+func marshalChoice(data []byte, name string, value choiceValue, element any) ([]byte, error) {
+	if value == nil {
+		return data, nil
+	}
+	property := choiceProperty(name, value.FHIRType())
+	if rv := reflect.ValueOf(element); element == nil || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return appendJSONProperty(data, property, value)
+	}
+	var err error
+	if !reflect.ValueOf(value).IsZero() {
+		if data, err = appendJSONProperty(data, property, value); err != nil {
+			return nil, err
+		}
+	}
+	return appendJSONProperty(data, "_"+property, element)
+}
+
+// appendJSONProperty inserts "name": value before the closing brace of the
+// encoded JSON object data.
+func appendJSONProperty(data []byte, name string, value any) ([]byte, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	key, _ := json.Marshal(name)
+
+	end := bytes.LastIndexByte(data, '}')
+	if end < 0 {
+		return nil, fmt.Errorf("%s: encoded value is not a JSON object", name)
+	}
+	out := make([]byte, 0, len(data)+len(key)+len(encoded)+2)
+	out = append(out, data[:end]...)
+	if len(bytes.TrimSpace(data[1:end])) > 0 {
+		out = append(out, ',')
+	}
+	out = append(out, key...)
+	out = append(out, ':')
+	out = append(out, encoded...)
+	return append(out, data[end:]...), nil
+}
+
+// unmarshalChoice decodes the choice element name[x] from the properties of
+// a JSON object. variants holds the zero value of every allowed type in
+// declaration order. The raw "_" companion of the decoded variant is
+// returned as well. A variant present only through its companion, which
+// carries extensions but no value, is returned as its zero value so that the
+// type, and with it the property name, is kept. When the object holds more
+// than one variant, the first is kept and the property names of all of them
+// are returned so that Validate can report the conflict.
+func unmarshalChoice[T choiceValue](fields map[string]json.RawMessage, name string, variants []T) (T, json.RawMessage, []string, error) {
+	var (
+		value   T
+		element json.RawMessage
+		found   []string
+	)
+	for _, variant := range variants {
+		property := choiceProperty(name, variant.FHIRType())
+		raw, hasValue := fields[property]
+		companion, hasElement := fields["_"+property]
+		if !hasValue && !hasElement {
+			continue
+		}
+		found = append(found, property)
+		if len(found) > 1 {
+			continue
+		}
+		ptr := reflect.New(reflect.TypeOf(variant))
+		if hasValue {
+			if err := json.Unmarshal(raw, ptr.Interface()); err != nil {
+				return value, nil, nil, fmt.Errorf("%s: %w", property, err)
+			}
+		}
+		value = ptr.Elem().Interface().(T)
+		element = companion
+	}
+	if len(found) < 2 {
+		found = nil
+	}
+	return value, element, found, nil
+}
@@ -2,53 +2,72 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A provider issued list of professional services and products which have been provided, or are to be provided, to a patient which is sent to an insurer for reimbursement.
 type Claim struct {
-	ResourceType          string                `json:"resourceType" bson:"resource_type"`                                        // Type of resource
-	Id                    *string               `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
-	Meta                  *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
-	ImplicitRules         *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
-	Language              *string               `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
-	Text                  *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
-	Identifier            []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Business Identifier for claim
-	TraceNumber           []Identifier          `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                      // Number for tracking
-	Status                string                `json:"status" bson:"status"`                                                     // active | cancelled | draft | entered-in-error
-	StatusReason          *string               `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                    // Reason for status change
-	Type                  *CodeableConcept      `json:"type" bson:"type"`                                                         // Category or discipline
-	SubType               *CodeableConcept      `json:"subType,omitempty" bson:"sub_type,omitempty"`                              // More granular claim type
-	Use                   string                `json:"use" bson:"use"`                                                           // claim | preauthorization | predetermination
-	Subject               *Reference            `json:"subject" bson:"subject"`                                                   // The recipient(s) of the products and services
-	BillablePeriod        *Period               `json:"billablePeriod,omitempty" bson:"billable_period,omitempty"`                // Relevant time frame for the claim
-	Created               string                `json:"created" bson:"created"`                                                   // Resource creation date
-	Enterer               *Reference            `json:"enterer,omitempty" bson:"enterer,omitempty"`                               // Author of the claim
-	Insurer               *Reference            `json:"insurer,omitempty" bson:"insurer,omitempty"`                               // Target
-	Provider              *Reference            `json:"provider,omitempty" bson:"provider,omitempty"`                             // Party responsible for the claim
-	Priority              *CodeableConcept      `json:"priority,omitempty" bson:"priority,omitempty"`                             // Desired processing urgency
-	FundsReserve          *CodeableConcept      `json:"fundsReserve,omitempty" bson:"funds_reserve,omitempty"`                    // For whom to reserve funds
-	Related               []ClaimRelated        `json:"related,omitempty" bson:"related,omitempty"`                               // Prior or corollary claims
-	Prescription          *Reference            `json:"prescription,omitempty" bson:"prescription,omitempty"`                     // Prescription authorizing services and products
-	OriginalPrescription  *Reference            `json:"originalPrescription,omitempty" bson:"original_prescription,omitempty"`    // Original prescription if superseded by fulfiller
-	Payee                 *ClaimPayee           `json:"payee,omitempty" bson:"payee,omitempty"`                                   // Recipient of benefits payable
-	Referral              *Reference            `json:"referral,omitempty" bson:"referral,omitempty"`                             // Treatment referral
-	Encounter             []Reference           `json:"encounter,omitempty" bson:"encounter,omitempty"`                           // Encounters associated with the listed treatments
-	Facility              *Reference            `json:"facility,omitempty" bson:"facility,omitempty"`                             // Servicing facility
-	DiagnosisRelatedGroup *CodeableConcept      `json:"diagnosisRelatedGroup,omitempty" bson:"diagnosis_related_group,omitempty"` // Package billing code
-	Event                 []ClaimEvent          `json:"event,omitempty" bson:"event,omitempty"`                                   // Event information
-	CareTeam              []ClaimCareTeam       `json:"careTeam,omitempty" bson:"care_team,omitempty"`                            // Members of the care team
-	SupportingInfo        []ClaimSupportingInfo `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`                // Supporting information
-	Diagnosis             []ClaimDiagnosis      `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                           // Pertinent diagnosis information
-	Procedure             []ClaimProcedure      `json:"procedure,omitempty" bson:"procedure,omitempty"`                           // Clinical procedures performed
-	Insurance             []ClaimInsurance      `json:"insurance,omitempty" bson:"insurance,omitempty"`                           // Patient insurance information
-	Accident              *ClaimAccident        `json:"accident,omitempty" bson:"accident,omitempty"`                             // Details of the event
-	PatientPaid           *Money                `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                      // Paid by the patient
-	Item                  []ClaimItem           `json:"item,omitempty" bson:"item,omitempty"`                                     // Product or service provided
-	Total                 *Money                `json:"total,omitempty" bson:"total,omitempty"`                                   // Total claim cost
+	ResourceType          string                     `json:"resourceType" bson:"resource_type"`                                        // Type of resource
+	Id                    *string                    `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
+	Meta                  *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
+	ImplicitRules         *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
+	Language              *string                    `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement       *Element                   `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
+	Text                  *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
+	Contained             []Resource                 `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension             []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension     []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
+	Identifier            []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Business Identifier for claim
+	TraceNumber           []Identifier               `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                      // Number for tracking
+	Status                ExplanationOfBenefitStatus `json:"status" bson:"status"`                                                     // active | cancelled | draft | entered-in-error
+	StatusElement         *Element                   `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
+	StatusReason          *string                    `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                    // Reason for status change
+	StatusReasonElement   *Element                   `json:"_statusReason,omitempty" bson:"status_reason_element,omitempty"`           // Extensions for statusReason
+	Type                  *CodeableConcept           `json:"type" bson:"type"`                                                         // Category or discipline
+	SubType               *CodeableConcept           `json:"subType,omitempty" bson:"sub_type,omitempty"`                              // More granular claim type
+	Use                   Use                        `json:"use" bson:"use"`                                                           // claim | preauthorization | predetermination
+	UseElement            *Element                   `json:"_use,omitempty" bson:"use_element,omitempty"`                              // Extensions for use
+	Subject               *Reference                 `json:"subject" bson:"subject"`                                                   // The recipient(s) of the products and services
+	BillablePeriod        *Period                    `json:"billablePeriod,omitempty" bson:"billable_period,omitempty"`                // Relevant time frame for the claim
+	Created               *DateTime                  `json:"created" bson:"created"`                                                   // Resource creation date
+	CreatedElement        *Element                   `json:"_created,omitempty" bson:"created_element,omitempty"`                      // Extensions for created
+	Enterer               *Reference                 `json:"enterer,omitempty" bson:"enterer,omitempty"`                               // Author of the claim
+	Insurer               *Reference                 `json:"insurer,omitempty" bson:"insurer,omitempty"`                               // Target
+	Provider              *Reference                 `json:"provider,omitempty" bson:"provider,omitempty"`                             // Party responsible for the claim
+	Priority              *CodeableConcept           `json:"priority,omitempty" bson:"priority,omitempty"`                             // Desired processing urgency
+	FundsReserve          *CodeableConcept           `json:"fundsReserve,omitempty" bson:"funds_reserve,omitempty"`                    // For whom to reserve funds
+	Related               []ClaimRelated             `json:"related,omitempty" bson:"related,omitempty"`                               // Prior or corollary claims
+	Prescription          *Reference                 `json:"prescription,omitempty" bson:"prescription,omitempty"`                     // Prescription authorizing services and products
+	OriginalPrescription  *Reference                 `json:"originalPrescription,omitempty" bson:"original_prescription,omitempty"`    // Original prescription if superseded by fulfiller
+	Payee                 *ClaimPayee                `json:"payee,omitempty" bson:"payee,omitempty"`                                   // Recipient of benefits payable
+	Referral              *Reference                 `json:"referral,omitempty" bson:"referral,omitempty"`                             // Treatment referral
+	Encounter             []Reference                `json:"encounter,omitempty" bson:"encounter,omitempty"`                           // Encounters associated with the listed treatments
+	Facility              *Reference                 `json:"facility,omitempty" bson:"facility,omitempty"`                             // Servicing facility
+	DiagnosisRelatedGroup *CodeableConcept           `json:"diagnosisRelatedGroup,omitempty" bson:"diagnosis_related_group,omitempty"` // Package billing code
+	Event                 []ClaimEvent               `json:"event,omitempty" bson:"event,omitempty"`                                   // Event information
+	CareTeam              []ClaimCareTeam            `json:"careTeam,omitempty" bson:"care_team,omitempty"`                            // Members of the care team
+	SupportingInfo        []ClaimSupportingInfo      `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`                // Supporting information
+	Diagnosis             []ClaimDiagnosis           `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                           // Pertinent diagnosis information
+	Procedure             []ClaimProcedure           `json:"procedure,omitempty" bson:"procedure,omitempty"`                           // Clinical procedures performed
+	Insurance             []ClaimInsurance           `json:"insurance,omitempty" bson:"insurance,omitempty"`                           // Patient insurance information
+	Accident              *ClaimAccident             `json:"accident,omitempty" bson:"accident,omitempty"`                             // Details of the event
+	PatientPaid           *Money                     `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                      // Paid by the patient
+	Item                  []ClaimItem                `json:"item,omitempty" bson:"item,omitempty"`                                     // Product or service provided
+	Total                 *Money                     `json:"total,omitempty" bson:"total,omitempty"`                                   // Total claim cost
 }
 
+var claimSubjectTargets = []string{"Group", "Patient"}
+
+var claimEntererTargets = []string{"Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var claimInsurerTargets = []string{"Organization"}
+
+var claimProviderTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var claimFacilityTargets = []string{"Location", "Organization"}
+
 func (r *Claim) Validate() error {
 	if r.ResourceType != "Claim" {
 		return fmt.Errorf("invalid resourceType: expected 'Claim', got '%s'", r.ResourceType)
@@ -58,11 +77,39 @@ func (r *Claim) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -73,10 +120,22 @@ func (r *Claim) Validate() error {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.StatusReasonElement != nil {
+		if err := r.StatusReasonElement.Validate(); err != nil {
+			return fmt.Errorf("StatusReasonElement: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -90,12 +149,25 @@ func (r *Claim) Validate() error {
 			return fmt.Errorf("SubType: %w", err)
 		}
 	}
-	if r.Use == emptyString {
+	if r.Use == "" {
 		return fmt.Errorf("field 'Use' is required")
 	}
+	if !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, claimSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -106,19 +178,44 @@ func (r *Claim) Validate() error {
 			return fmt.Errorf("BillablePeriod: %w", err)
 		}
 	}
-	if r.Created == emptyString {
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Enterer != nil {
+		if target := invalidReferenceTarget(r.Enterer.Reference, r.Enterer.Type, claimEntererTargets); target != "" {
+			return fmt.Errorf("field 'Enterer' cannot refer to %s, expected one of %v", target, claimEntererTargets)
+		}
+	}
 	if r.Enterer != nil {
 		if err := r.Enterer.Validate(); err != nil {
 			return fmt.Errorf("Enterer: %w", err)
 		}
 	}
+	if r.Insurer != nil {
+		if target := invalidReferenceTarget(r.Insurer.Reference, r.Insurer.Type, claimInsurerTargets); target != "" {
+			return fmt.Errorf("field 'Insurer' cannot refer to %s, expected one of %v", target, claimInsurerTargets)
+		}
+	}
 	if r.Insurer != nil {
 		if err := r.Insurer.Validate(); err != nil {
 			return fmt.Errorf("Insurer: %w", err)
 		}
 	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, claimProviderTargets); target != "" {
+			return fmt.Errorf("field 'Provider' cannot refer to %s, expected one of %v", target, claimProviderTargets)
+		}
+	}
 	if r.Provider != nil {
 		if err := r.Provider.Validate(); err != nil {
 			return fmt.Errorf("Provider: %w", err)
@@ -164,6 +261,11 @@ func (r *Claim) Validate() error {
 			return fmt.Errorf("Encounter[%d]: %w", i, err)
 		}
 	}
+	if r.Facility != nil {
+		if target := invalidReferenceTarget(r.Facility.Reference, r.Facility.Type, claimFacilityTargets); target != "" {
+			return fmt.Errorf("field 'Facility' cannot refer to %s, expected one of %v", target, claimFacilityTargets)
+		}
+	}
 	if r.Facility != nil {
 		if err := r.Facility.Validate(); err != nil {
 			return fmt.Errorf("Facility: %w", err)
@@ -224,293 +326,3943 @@ func (r *Claim) Validate() error {
 			return fmt.Errorf("Total: %w", err)
 		}
 	}
-	return nil
-}
-
-type ClaimAccident struct {
-	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Date              string           `json:"date" bson:"date"`                                                // When the incident occurred
-	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // The nature of the accident
-	LocationAddress   *Address         `json:"locationAddress,omitempty" bson:"location_address,omitempty"`     // Where the event occurred
-	LocationReference *Reference       `json:"locationReference,omitempty" bson:"location_reference,omitempty"` // Where the event occurred
+	return validateInvariants(r, "Claim")
 }
 
-func (r *ClaimAccident) Validate() error {
-	var emptyString string
-	if r.Date == emptyString {
-		return fmt.Errorf("field 'Date' is required")
+func (r *Claim) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Claim" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Claim', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.LocationAddress != nil {
-		if err := r.LocationAddress.Validate(); err != nil {
-			return fmt.Errorf("LocationAddress: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.LocationReference != nil {
-		if err := r.LocationReference.Validate(); err != nil {
-			return fmt.Errorf("LocationReference: %w", err)
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type ClaimItem struct {
-	Id                      *string             `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	Sequence                int                 `json:"sequence" bson:"sequence"`                                                     // Item instance identifier
-	TraceNumber             []Identifier        `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
-	Subject                 *Reference          `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
-	CareTeamSequence        []int               `json:"careTeamSequence,omitempty" bson:"care_team_sequence,omitempty"`               // Applicable careTeam members
-	DiagnosisSequence       []int               `json:"diagnosisSequence,omitempty" bson:"diagnosis_sequence,omitempty"`              // Applicable diagnoses
-	ProcedureSequence       []int               `json:"procedureSequence,omitempty" bson:"procedure_sequence,omitempty"`              // Applicable procedures
-	InformationSequence     []int               `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
-	Revenue                 *CodeableConcept    `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
-	Category                *CodeableConcept    `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
-	ProductOrService        *CodeableConcept    `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
-	ProductOrServiceEnd     *CodeableConcept    `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
-	Request                 []Reference         `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
-	Modifier                []CodeableConcept   `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Product or service billing modifiers
-	ProgramCode             []CodeableConcept   `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
-	ServicedDate            *string             `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`                        // Date or dates of service or product delivery
-	ServicedPeriod          *Period             `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"`                    // Date or dates of service or product delivery
-	LocationCodeableConcept *CodeableConcept    `json:"locationCodeableConcept,omitempty" bson:"location_codeable_concept,omitempty"` // Place of service or where product was supplied
-	LocationAddress         *Address            `json:"locationAddress,omitempty" bson:"location_address,omitempty"`                  // Place of service or where product was supplied
-	LocationReference       *Reference          `json:"locationReference,omitempty" bson:"location_reference,omitempty"`              // Place of service or where product was supplied
-	PatientPaid             *Money              `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                          // Paid by the patient
-	Quantity                *Quantity           `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
-	UnitPrice               *Money              `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
-	Factor                  *float64            `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
-	Tax                     *Money              `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
-	Net                     *Money              `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
-	Udi                     []Reference         `json:"udi,omitempty" bson:"udi,omitempty"`                                           // Unique device identifier
-	BodySite                []ClaimItemBodySite `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
-	Encounter               []Reference         `json:"encounter,omitempty" bson:"encounter,omitempty"`                               // Encounters associated with the listed treatments
-	Detail                  []ClaimItemDetail   `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Product or service provided
-}
-
-func (r *ClaimItem) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
 	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReasonElement != nil {
+		r.StatusReasonElement.validateAll(path+".statusReason", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.SubType != nil {
+		r.SubType.validateAll(path+".subType", issues)
+	}
+	if r.Use == "" {
+		issues.add("required", path+".use", "field 'Use' is required")
+	}
+	if r.Use != "" && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
 	}
 	if r.Subject != nil {
-		if err := r.Subject.Validate(); err != nil {
-			return fmt.Errorf("Subject: %w", err)
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, claimSubjectTargets))
 		}
 	}
-	if r.Revenue != nil {
-		if err := r.Revenue.Validate(); err != nil {
-			return fmt.Errorf("Revenue: %w", err)
-		}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	if r.BillablePeriod != nil {
+		r.BillablePeriod.validateAll(path+".billablePeriod", issues)
 	}
-	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
-		}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
 	}
-	if r.ProductOrServiceEnd != nil {
-		if err := r.ProductOrServiceEnd.Validate(); err != nil {
-			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
 		}
 	}
-	for i, item := range r.Request {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Request[%d]: %w", i, err)
-		}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
 	}
-	for i, item := range r.Modifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
+	if r.Enterer != nil {
+		if target := invalidReferenceTarget(r.Enterer.Reference, r.Enterer.Type, claimEntererTargets); target != "" {
+			issues.add("value", path+".enterer", fmt.Sprintf("field 'Enterer' cannot refer to %s, expected one of %v", target, claimEntererTargets))
 		}
 	}
-	for i, item := range r.ProgramCode {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
-		}
+	if r.Enterer != nil {
+		r.Enterer.validateAll(path+".enterer", issues)
 	}
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
+	if r.Insurer != nil {
+		if target := invalidReferenceTarget(r.Insurer.Reference, r.Insurer.Type, claimInsurerTargets); target != "" {
+			issues.add("value", path+".insurer", fmt.Sprintf("field 'Insurer' cannot refer to %s, expected one of %v", target, claimInsurerTargets))
 		}
 	}
-	if r.LocationCodeableConcept != nil {
-		if err := r.LocationCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("LocationCodeableConcept: %w", err)
-		}
+	if r.Insurer != nil {
+		r.Insurer.validateAll(path+".insurer", issues)
 	}
-	if r.LocationAddress != nil {
-		if err := r.LocationAddress.Validate(); err != nil {
-			return fmt.Errorf("LocationAddress: %w", err)
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, claimProviderTargets); target != "" {
+			issues.add("value", path+".provider", fmt.Sprintf("field 'Provider' cannot refer to %s, expected one of %v", target, claimProviderTargets))
 		}
 	}
-	if r.LocationReference != nil {
-		if err := r.LocationReference.Validate(); err != nil {
-			return fmt.Errorf("LocationReference: %w", err)
-		}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
 	}
-	if r.PatientPaid != nil {
-		if err := r.PatientPaid.Validate(); err != nil {
-			return fmt.Errorf("PatientPaid: %w", err)
-		}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+	if r.FundsReserve != nil {
+		r.FundsReserve.validateAll(path+".fundsReserve", issues)
 	}
-	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
-		}
+	for i, item := range r.Related {
+		item.validateAll(fmt.Sprintf("%s.related[%d]", path, i), issues)
 	}
-	if r.Tax != nil {
-		if err := r.Tax.Validate(); err != nil {
-			return fmt.Errorf("Tax: %w", err)
-		}
+	if r.Prescription != nil {
+		r.Prescription.validateAll(path+".prescription", issues)
 	}
-	if r.Net != nil {
-		if err := r.Net.Validate(); err != nil {
-			return fmt.Errorf("Net: %w", err)
-		}
+	if r.OriginalPrescription != nil {
+		r.OriginalPrescription.validateAll(path+".originalPrescription", issues)
 	}
-	for i, item := range r.Udi {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Udi[%d]: %w", i, err)
-		}
+	if r.Payee != nil {
+		r.Payee.validateAll(path+".payee", issues)
 	}
-	for i, item := range r.BodySite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("BodySite[%d]: %w", i, err)
-		}
+	if r.Referral != nil {
+		r.Referral.validateAll(path+".referral", issues)
 	}
 	for i, item := range r.Encounter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Encounter[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.encounter[%d]", path, i), issues)
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
+	if r.Facility != nil {
+		if target := invalidReferenceTarget(r.Facility.Reference, r.Facility.Type, claimFacilityTargets); target != "" {
+			issues.add("value", path+".facility", fmt.Sprintf("field 'Facility' cannot refer to %s, expected one of %v", target, claimFacilityTargets))
 		}
 	}
-	return nil
-}
-
-type ClaimPayee struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Type  *CodeableConcept `json:"type" bson:"type"`                       // Category of recipient
-	Party *Reference       `json:"party,omitempty" bson:"party,omitempty"` // Recipient reference
-}
-
-func (r *ClaimPayee) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Facility != nil {
+		r.Facility.validateAll(path+".facility", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.DiagnosisRelatedGroup != nil {
+		r.DiagnosisRelatedGroup.validateAll(path+".diagnosisRelatedGroup", issues)
 	}
-	if r.Party != nil {
-		if err := r.Party.Validate(); err != nil {
-			return fmt.Errorf("Party: %w", err)
-		}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ClaimCareTeam struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Sequence  int              `json:"sequence" bson:"sequence"`                       // Order of care team
-	Provider  *Reference       `json:"provider" bson:"provider"`                       // Practitioner or organization
-	Role      *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`           // Function within the team
-	Specialty *CodeableConcept `json:"specialty,omitempty" bson:"specialty,omitempty"` // Practitioner or provider specialization
-}
-
-func (r *ClaimCareTeam) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+	for i, item := range r.CareTeam {
+		item.validateAll(fmt.Sprintf("%s.careTeam[%d]", path, i), issues)
 	}
-	if r.Provider == nil {
-		return fmt.Errorf("field 'Provider' is required")
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
 	}
-	if r.Provider != nil {
-		if err := r.Provider.Validate(); err != nil {
-			return fmt.Errorf("Provider: %w", err)
-		}
+	for i, item := range r.Diagnosis {
+		item.validateAll(fmt.Sprintf("%s.diagnosis[%d]", path, i), issues)
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
 	}
-	if r.Specialty != nil {
-		if err := r.Specialty.Validate(); err != nil {
-			return fmt.Errorf("Specialty: %w", err)
-		}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	if r.Accident != nil {
+		r.Accident.validateAll(path+".accident", issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
+	}
+	if r.Total != nil {
+		r.Total.validateAll(path+".total", issues)
 	}
-	return nil
 }
 
-type ClaimItemBodySite struct {
-	Id      *string             `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	Site    []CodeableReference `json:"site" bson:"site"`                            // Location
-	SubSite []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"` // Sub-location
+func (r *Claim) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Claim", &issues)
+	checkInvariants(r, "Claim", &issues)
+	return issues
 }
 
-func (r *ClaimItemBodySite) Validate() error {
-	if len(r.Site) < 1 {
-		return fmt.Errorf("field 'Site' must have at least 1 elements")
-	}
-	for i, item := range r.Site {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Site[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.SubSite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubSite[%d]: %w", i, err)
-		}
+var claimInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Claim) invariants() []invariant {
+	return claimInvariants
+}
+
+func (r *Claim) UnmarshalJSON(data []byte) error {
+	type alias Claim
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
-type ClaimItemDetail struct {
-	Id                  *string                    `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	Sequence            int                        `json:"sequence" bson:"sequence"`                                              // Item instance identifier
-	TraceNumber         []Identifier               `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
-	Revenue             *CodeableConcept           `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
-	Category            *CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
-	ProductOrService    *CodeableConcept           `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
-	ProductOrServiceEnd *CodeableConcept           `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
+func (r Claim) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Claim) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Claim) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Claim) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Claim) elementTypes() map[string]string {
+	return claimElementTypes
+}
+
+var claimElementMetadata = []ElementMetadata{
+	{Path: "Claim.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Claim.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Claim.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Claim.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Claim.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Claim.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Claim.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Claim.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Claim.traceNumber", Name: "traceNumber", Field: 12, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Claim.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Claim.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "Claim.statusReason", Name: "statusReason", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.type", Name: "type", Field: 17, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.subType", Name: "subType", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.use", Name: "use", Field: 19, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Claim.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-use"}},
+	{Path: "Claim.subject", Name: "subject", Field: 21, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Claim.billablePeriod", Name: "billablePeriod", Field: 22, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Claim.created", Name: "created", Field: 23, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Claim.enterer", Name: "enterer", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Claim.insurer", Name: "insurer", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Claim.provider", Name: "provider", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "Claim.priority", Name: "priority", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.fundsReserve", Name: "fundsReserve", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.related", Name: "related", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.prescription", Name: "prescription", Field: 31, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Claim.originalPrescription", Name: "originalPrescription", Field: 32, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Claim.payee", Name: "payee", Field: 33, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Claim.referral", Name: "referral", Field: 34, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Claim.encounter", Name: "encounter", Field: 35, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Claim.facility", Name: "facility", Field: 36, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Claim.diagnosisRelatedGroup", Name: "diagnosisRelatedGroup", Field: 37, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.event", Name: "event", Field: 38, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.careTeam", Name: "careTeam", Field: 39, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.supportingInfo", Name: "supportingInfo", Field: 40, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.diagnosis", Name: "diagnosis", Field: 41, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.procedure", Name: "procedure", Field: 42, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.insurance", Name: "insurance", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.accident", Name: "accident", Field: 44, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Claim.patientPaid", Name: "patientPaid", Field: 45, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item", Name: "item", Field: 46, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.total", Name: "total", Field: 47, Min: 0, Max: "1", Types: []string{"Money"}},
+}
+
+func (r *Claim) ElementMetadata() []ElementMetadata {
+	return claimElementMetadata
+}
+
+func (r *Claim) GetResourceType() string {
+	return "Claim"
+}
+
+func (r *Claim) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Claim) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Claim) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Claim) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Claim) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Claim) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Claim) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Claim)(nil)
+
+type ClaimRelated struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Claim             *Reference       `json:"claim,omitempty" bson:"claim,omitempty"`                          // Reference to the related claim
+	Relationship      *CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"`            // How the reference claim is related
+	Reference         *Identifier      `json:"reference,omitempty" bson:"reference,omitempty"`                  // File or case reference
+}
+
+func (r *ClaimRelated) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Claim != nil {
+		if err := r.Claim.Validate(); err != nil {
+			return fmt.Errorf("Claim: %w", err)
+		}
+	}
+	if r.Relationship != nil {
+		if err := r.Relationship.Validate(); err != nil {
+			return fmt.Errorf("Relationship: %w", err)
+		}
+	}
+	if r.Reference != nil {
+		if err := r.Reference.Validate(); err != nil {
+			return fmt.Errorf("Reference: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimRelated) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Claim != nil {
+		r.Claim.validateAll(path+".claim", issues)
+	}
+	if r.Relationship != nil {
+		r.Relationship.validateAll(path+".relationship", issues)
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+}
+
+var claimRelatedInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimRelated) invariants() []invariant {
+	return claimRelatedInvariants
+}
+
+func (r ClaimRelated) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimRelated) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimRelated) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimRelated) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimRelatedElementMetadata = []ElementMetadata{
+	{Path: "Claim.related.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.related.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.related.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.related.claim", Name: "claim", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Claim.related.relationship", Name: "relationship", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.related.reference", Name: "reference", Field: 5, Min: 0, Max: "1", Types: []string{"Identifier"}},
+}
+
+func (r *ClaimRelated) ElementMetadata() []ElementMetadata {
+	return claimRelatedElementMetadata
+}
+
+type ClaimPayee struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Category of recipient
+	Party             *Reference       `json:"party,omitempty" bson:"party,omitempty"`                          // Recipient reference
+}
+
+var claimPayeePartyTargets = []string{"Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+func (r *ClaimPayee) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, claimPayeePartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, claimPayeePartyTargets)
+		}
+	}
+	if r.Party != nil {
+		if err := r.Party.Validate(); err != nil {
+			return fmt.Errorf("Party: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimPayee) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, claimPayeePartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, claimPayeePartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+}
+
+var claimPayeeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimPayee) invariants() []invariant {
+	return claimPayeeInvariants
+}
+
+func (r ClaimPayee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimPayee) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimPayee) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimPayee) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimPayeeElementMetadata = []ElementMetadata{
+	{Path: "Claim.payee.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.payee.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.payee.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.payee.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.payee.party", Name: "party", Field: 4, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *ClaimPayee) ElementMetadata() []ElementMetadata {
+	return claimPayeeElementMetadata
+}
+
+type ClaimEvent struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Specific event
+	When              ClaimEventWhen   `json:"-" bson:"when,omitempty"`                                         // Occurance date or period
+	WhenElement       *Element         `json:"-" bson:"when_element,omitempty"`                                 // Extensions for when[x]
+
+	whenVariants []string // JSON properties of Claim.event.when[x] when more than one was decoded
+}
+
+func (r *ClaimEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.whenVariants) > 1 {
+		return fmt.Errorf("field 'When' must have a single type, got %v", r.whenVariants)
+	}
+	if r.When == nil {
+		return fmt.Errorf("field 'When' is required")
+	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
+		}
+	}
+	if r.WhenElement != nil {
+		if err := r.WhenElement.Validate(); err != nil {
+			return fmt.Errorf("WhenElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.whenVariants) > 1 {
+		issues.add("structure", path+".when", fmt.Sprintf("field 'When' must have a single type, got %v", r.whenVariants))
+	}
+	if r.When == nil {
+		issues.add("required", path+".when", "field 'When' is required")
+	}
+	if r.When != nil {
+		r.When.validateAll(path+".when.ofType("+r.When.FHIRType()+")", issues)
+	}
+	if r.WhenElement != nil {
+		r.WhenElement.validateAll(path+".when", issues)
+	}
+}
+
+var claimEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimEvent) invariants() []invariant {
+	return claimEventInvariants
+}
+
+func (r ClaimEvent) MarshalJSON() ([]byte, error) {
+	type alias ClaimEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "when", r.When, r.WhenElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimEvent) UnmarshalJSON(data []byte) error {
+	type alias ClaimEvent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.When, element, r.whenVariants, err = unmarshalChoice(fields, "when", claimEventWhenVariants); err != nil {
+		return err
+	}
+	r.WhenElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.WhenElement); err != nil {
+			return fmt.Errorf("_when: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ClaimEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "When":
+		return "when", choiceValues(claimEventWhenVariants)
+	}
+	return "", nil
+}
+
+var claimEventElementMetadata = []ElementMetadata{
+	{Path: "Claim.event.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.event.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.event.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.event.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.event.when[x]", Name: "when", Field: 4, Min: 1, Max: "1", Types: []string{"dateTime", "Period"}},
+}
+
+func (r *ClaimEvent) ElementMetadata() []ElementMetadata {
+	return claimEventElementMetadata
+}
+
+// ClaimEventWhen is the type of Claim.event.when[x]. It is implemented by the ClaimEventWhen* variant types.
+type ClaimEventWhen interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimEventWhen()
+}
+
+// ClaimEventWhenDateTime is the dateTime variant of ClaimEventWhen.
+type ClaimEventWhenDateTime struct {
+	DateTime
+}
+
+func (v ClaimEventWhenDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ClaimEventWhenDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimEventWhenDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ClaimEventWhenDateTime) isClaimEventWhen() {}
+
+// ClaimEventWhenPeriod is the Period variant of ClaimEventWhen.
+type ClaimEventWhenPeriod struct {
+	Period
+}
+
+func (v ClaimEventWhenPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimEventWhenPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimEventWhenPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimEventWhenPeriod) isClaimEventWhen() {}
+
+var claimEventWhenVariants = []ClaimEventWhen{
+	ClaimEventWhenDateTime{},
+	ClaimEventWhenPeriod{},
+}
+
+type ClaimCareTeam struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int              `json:"sequence" bson:"sequence"`                                        // Order of care team
+	SequenceElement   *Element         `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Provider          *Reference       `json:"provider" bson:"provider"`                                        // Practitioner or organization
+	Role              *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // Function within the team
+	Specialty         *CodeableConcept `json:"specialty,omitempty" bson:"specialty,omitempty"`                  // Practitioner or provider specialization
+}
+
+var claimCareTeamProviderTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+func (r *ClaimCareTeam) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.Provider == nil {
+		return fmt.Errorf("field 'Provider' is required")
+	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, claimCareTeamProviderTargets); target != "" {
+			return fmt.Errorf("field 'Provider' cannot refer to %s, expected one of %v", target, claimCareTeamProviderTargets)
+		}
+	}
+	if r.Provider != nil {
+		if err := r.Provider.Validate(); err != nil {
+			return fmt.Errorf("Provider: %w", err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	if r.Specialty != nil {
+		if err := r.Specialty.Validate(); err != nil {
+			return fmt.Errorf("Specialty: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimCareTeam) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Provider == nil {
+		issues.add("required", path+".provider", "field 'Provider' is required")
+	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, claimCareTeamProviderTargets); target != "" {
+			issues.add("value", path+".provider", fmt.Sprintf("field 'Provider' cannot refer to %s, expected one of %v", target, claimCareTeamProviderTargets))
+		}
+	}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Specialty != nil {
+		r.Specialty.validateAll(path+".specialty", issues)
+	}
+}
+
+var claimCareTeamInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimCareTeam) invariants() []invariant {
+	return claimCareTeamInvariants
+}
+
+func (r ClaimCareTeam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimCareTeam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimCareTeam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimCareTeam) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimCareTeamElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimCareTeam) elementTypes() map[string]string {
+	return claimCareTeamElementTypes
+}
+
+var claimCareTeamElementMetadata = []ElementMetadata{
+	{Path: "Claim.careTeam.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.careTeam.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.careTeam.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.careTeam.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.careTeam.provider", Name: "provider", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "Claim.careTeam.role", Name: "role", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.careTeam.specialty", Name: "specialty", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimCareTeam) ElementMetadata() []ElementMetadata {
+	return claimCareTeamElementMetadata
+}
+
+type ClaimSupportingInfo struct {
+	Id                *string                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                       `json:"sequence" bson:"sequence"`                                        // Information instance identifier
+	SequenceElement   *Element                  `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Category          *CodeableConcept          `json:"category" bson:"category"`                                        // Classification of the supplied information
+	SubCategory       *CodeableConcept          `json:"subCategory,omitempty" bson:"sub_category,omitempty"`             // Finer-grained classification of the supplied information
+	Code              *CodeableConcept          `json:"code,omitempty" bson:"code,omitempty"`                            // Type of information
+	Timing            ClaimSupportingInfoTiming `json:"-" bson:"timing,omitempty"`                                       // When it occurred
+	TimingElement     *Element                  `json:"-" bson:"timing_element,omitempty"`                               // Extensions for timing[x]
+	Value             ClaimSupportingInfoValue  `json:"-" bson:"value,omitempty"`                                        // Data to be provided
+	ValueElement      *Element                  `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Reason            *CodeableConcept          `json:"reason,omitempty" bson:"reason,omitempty"`                        // Explanation for the information
+
+	timingVariants []string // JSON properties of Claim.supportingInfo.timing[x] when more than one was decoded
+	valueVariants  []string // JSON properties of Claim.supportingInfo.value[x] when more than one was decoded
+}
+
+func (r *ClaimSupportingInfo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.SubCategory != nil {
+		if err := r.SubCategory.Validate(); err != nil {
+			return fmt.Errorf("SubCategory: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
+	}
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
+		}
+	}
+	if r.TimingElement != nil {
+		if err := r.TimingElement.Validate(); err != nil {
+			return fmt.Errorf("TimingElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.Reason != nil {
+		if err := r.Reason.Validate(); err != nil {
+			return fmt.Errorf("Reason: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimSupportingInfo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.SubCategory != nil {
+		r.SubCategory.validateAll(path+".subCategory", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if r.TimingElement != nil {
+		r.TimingElement.validateAll(path+".timing", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+}
+
+var claimSupportingInfoInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimSupportingInfo) invariants() []invariant {
+	return claimSupportingInfoInvariants
+}
+
+func (r ClaimSupportingInfo) MarshalJSON() ([]byte, error) {
+	type alias ClaimSupportingInfo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, r.TimingElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimSupportingInfo) UnmarshalJSON(data []byte) error {
+	type alias ClaimSupportingInfo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Timing, element, r.timingVariants, err = unmarshalChoice(fields, "timing", claimSupportingInfoTimingVariants); err != nil {
+		return err
+	}
+	r.TimingElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TimingElement); err != nil {
+			return fmt.Errorf("_timing: %w", err)
+		}
+	}
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", claimSupportingInfoValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ClaimSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimSupportingInfo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimSupportingInfo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Timing":
+		return "timing", choiceValues(claimSupportingInfoTimingVariants)
+	case "Value":
+		return "value", choiceValues(claimSupportingInfoValueVariants)
+	}
+	return "", nil
+}
+
+var claimSupportingInfoElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimSupportingInfo) elementTypes() map[string]string {
+	return claimSupportingInfoElementTypes
+}
+
+var claimSupportingInfoElementMetadata = []ElementMetadata{
+	{Path: "Claim.supportingInfo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.supportingInfo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.supportingInfo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.supportingInfo.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.supportingInfo.category", Name: "category", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.supportingInfo.subCategory", Name: "subCategory", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.supportingInfo.code", Name: "code", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.supportingInfo.timing[x]", Name: "timing", Field: 8, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "Claim.supportingInfo.value[x]", Name: "value", Field: 10, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}},
+	{Path: "Claim.supportingInfo.reason", Name: "reason", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimSupportingInfo) ElementMetadata() []ElementMetadata {
+	return claimSupportingInfoElementMetadata
+}
+
+// ClaimSupportingInfoTiming is the type of Claim.supportingInfo.timing[x]. It is implemented by the ClaimSupportingInfoTiming* variant types.
+type ClaimSupportingInfoTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimSupportingInfoTiming()
+}
+
+// ClaimSupportingInfoTimingDateTime is the dateTime variant of ClaimSupportingInfoTiming.
+type ClaimSupportingInfoTimingDateTime struct {
+	DateTime
+}
+
+func (v ClaimSupportingInfoTimingDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ClaimSupportingInfoTimingDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimSupportingInfoTimingDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ClaimSupportingInfoTimingDateTime) isClaimSupportingInfoTiming() {}
+
+// ClaimSupportingInfoTimingPeriod is the Period variant of ClaimSupportingInfoTiming.
+type ClaimSupportingInfoTimingPeriod struct {
+	Period
+}
+
+func (v ClaimSupportingInfoTimingPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimSupportingInfoTimingPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoTimingPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimSupportingInfoTimingPeriod) isClaimSupportingInfoTiming() {}
+
+// ClaimSupportingInfoTimingTiming is the Timing variant of ClaimSupportingInfoTiming.
+type ClaimSupportingInfoTimingTiming struct {
+	Timing
+}
+
+func (v ClaimSupportingInfoTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ClaimSupportingInfoTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ClaimSupportingInfoTimingTiming) isClaimSupportingInfoTiming() {}
+
+var claimSupportingInfoTimingVariants = []ClaimSupportingInfoTiming{
+	ClaimSupportingInfoTimingDateTime{},
+	ClaimSupportingInfoTimingPeriod{},
+	ClaimSupportingInfoTimingTiming{},
+}
+
+// ClaimSupportingInfoValue is the type of Claim.supportingInfo.value[x]. It is implemented by the ClaimSupportingInfoValue* variant types.
+type ClaimSupportingInfoValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimSupportingInfoValue()
+}
+
+// ClaimSupportingInfoValueBase64Binary is the base64Binary variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueBase64Binary string
+
+func (v ClaimSupportingInfoValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ClaimSupportingInfoValueBase64Binary) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueBoolean is the boolean variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueBoolean bool
+
+func (v ClaimSupportingInfoValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ClaimSupportingInfoValueBoolean) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueCanonical is the canonical variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueCanonical string
+
+func (v ClaimSupportingInfoValueCanonical) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ClaimSupportingInfoValueCanonical) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueCode is the code variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueCode string
+
+func (v ClaimSupportingInfoValueCode) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ClaimSupportingInfoValueCode) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDate is the date variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDate struct {
+	Date
+}
+
+func (v ClaimSupportingInfoValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ClaimSupportingInfoValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimSupportingInfoValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ClaimSupportingInfoValueDate) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDateTime is the dateTime variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDateTime struct {
+	DateTime
+}
+
+func (v ClaimSupportingInfoValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ClaimSupportingInfoValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimSupportingInfoValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ClaimSupportingInfoValueDateTime) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDecimal is the decimal variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDecimal struct {
+	Decimal
+}
+
+func (v ClaimSupportingInfoValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ClaimSupportingInfoValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimSupportingInfoValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ClaimSupportingInfoValueDecimal) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueId is the id variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueId string
+
+func (v ClaimSupportingInfoValueId) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ClaimSupportingInfoValueId) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueInstant is the instant variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueInstant struct {
+	Instant
+}
+
+func (v ClaimSupportingInfoValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ClaimSupportingInfoValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimSupportingInfoValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ClaimSupportingInfoValueInstant) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueInteger is the integer variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueInteger int
+
+func (v ClaimSupportingInfoValueInteger) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ClaimSupportingInfoValueInteger) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueInteger64 is the integer64 variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueInteger64 int64
+
+func (v ClaimSupportingInfoValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ClaimSupportingInfoValueInteger64) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueMarkdown is the markdown variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueMarkdown string
+
+func (v ClaimSupportingInfoValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ClaimSupportingInfoValueMarkdown) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueOid is the oid variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueOid string
+
+func (v ClaimSupportingInfoValueOid) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ClaimSupportingInfoValueOid) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValuePositiveInt is the positiveInt variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValuePositiveInt int
+
+func (v ClaimSupportingInfoValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ClaimSupportingInfoValuePositiveInt) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueString is the string variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueString string
+
+func (v ClaimSupportingInfoValueString) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ClaimSupportingInfoValueString) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueTime is the time variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueTime struct {
+	Time
+}
+
+func (v ClaimSupportingInfoValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ClaimSupportingInfoValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimSupportingInfoValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ClaimSupportingInfoValueTime) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueUnsignedInt is the unsignedInt variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueUnsignedInt int
+
+func (v ClaimSupportingInfoValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ClaimSupportingInfoValueUnsignedInt) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueUri is the uri variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueUri string
+
+func (v ClaimSupportingInfoValueUri) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ClaimSupportingInfoValueUri) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueUrl is the url variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueUrl string
+
+func (v ClaimSupportingInfoValueUrl) Validate() error {
+	return nil
+}
+
+func (v ClaimSupportingInfoValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimSupportingInfoValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ClaimSupportingInfoValueUrl) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueUuid is the uuid variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueUuid struct {
+	uuid
+}
+
+func (v ClaimSupportingInfoValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ClaimSupportingInfoValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ClaimSupportingInfoValueUuid) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueAddress is the Address variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueAddress struct {
+	Address
+}
+
+func (v ClaimSupportingInfoValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ClaimSupportingInfoValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ClaimSupportingInfoValueAddress) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueAge is the Age variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueAge struct {
+	Age
+}
+
+func (v ClaimSupportingInfoValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ClaimSupportingInfoValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ClaimSupportingInfoValueAge) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueAnnotation is the Annotation variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueAnnotation struct {
+	Annotation
+}
+
+func (v ClaimSupportingInfoValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ClaimSupportingInfoValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ClaimSupportingInfoValueAnnotation) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueAttachment is the Attachment variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueAttachment struct {
+	Attachment
+}
+
+func (v ClaimSupportingInfoValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ClaimSupportingInfoValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ClaimSupportingInfoValueAttachment) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueCodeableConcept is the CodeableConcept variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClaimSupportingInfoValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClaimSupportingInfoValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClaimSupportingInfoValueCodeableConcept) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueCodeableReference is the CodeableReference variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ClaimSupportingInfoValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ClaimSupportingInfoValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ClaimSupportingInfoValueCodeableReference) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueCoding is the Coding variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueCoding struct {
+	Coding
+}
+
+func (v ClaimSupportingInfoValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ClaimSupportingInfoValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ClaimSupportingInfoValueCoding) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueContactPoint is the ContactPoint variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ClaimSupportingInfoValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ClaimSupportingInfoValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ClaimSupportingInfoValueContactPoint) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueCount is the Count variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueCount struct {
+	Count
+}
+
+func (v ClaimSupportingInfoValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ClaimSupportingInfoValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ClaimSupportingInfoValueCount) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDistance is the Distance variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDistance struct {
+	Distance
+}
+
+func (v ClaimSupportingInfoValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ClaimSupportingInfoValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ClaimSupportingInfoValueDistance) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDuration is the Duration variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDuration struct {
+	Duration
+}
+
+func (v ClaimSupportingInfoValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ClaimSupportingInfoValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ClaimSupportingInfoValueDuration) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueHumanName is the HumanName variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueHumanName struct {
+	HumanName
+}
+
+func (v ClaimSupportingInfoValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ClaimSupportingInfoValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ClaimSupportingInfoValueHumanName) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueIdentifier is the Identifier variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueIdentifier struct {
+	Identifier
+}
+
+func (v ClaimSupportingInfoValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ClaimSupportingInfoValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ClaimSupportingInfoValueIdentifier) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueMoney is the Money variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueMoney struct {
+	Money
+}
+
+func (v ClaimSupportingInfoValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ClaimSupportingInfoValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ClaimSupportingInfoValueMoney) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValuePeriod is the Period variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValuePeriod struct {
+	Period
+}
+
+func (v ClaimSupportingInfoValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimSupportingInfoValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimSupportingInfoValuePeriod) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueQuantity is the Quantity variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueQuantity struct {
+	Quantity
+}
+
+func (v ClaimSupportingInfoValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ClaimSupportingInfoValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ClaimSupportingInfoValueQuantity) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueRange is the Range variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueRange struct {
+	Range
+}
+
+func (v ClaimSupportingInfoValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ClaimSupportingInfoValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ClaimSupportingInfoValueRange) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueRatio is the Ratio variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueRatio struct {
+	Ratio
+}
+
+func (v ClaimSupportingInfoValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ClaimSupportingInfoValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ClaimSupportingInfoValueRatio) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueRatioRange is the RatioRange variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueRatioRange struct {
+	RatioRange
+}
+
+func (v ClaimSupportingInfoValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ClaimSupportingInfoValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ClaimSupportingInfoValueRatioRange) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueReference is the Reference variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueReference struct {
+	Reference
+}
+
+func (v ClaimSupportingInfoValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimSupportingInfoValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimSupportingInfoValueReference) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueSampledData is the SampledData variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueSampledData struct {
+	SampledData
+}
+
+func (v ClaimSupportingInfoValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ClaimSupportingInfoValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ClaimSupportingInfoValueSampledData) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueSignature is the Signature variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueSignature struct {
+	Signature
+}
+
+func (v ClaimSupportingInfoValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ClaimSupportingInfoValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ClaimSupportingInfoValueSignature) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueTiming is the Timing variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueTiming struct {
+	Timing
+}
+
+func (v ClaimSupportingInfoValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ClaimSupportingInfoValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ClaimSupportingInfoValueTiming) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueContactDetail is the ContactDetail variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ClaimSupportingInfoValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ClaimSupportingInfoValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ClaimSupportingInfoValueContactDetail) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDataRequirement is the DataRequirement variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ClaimSupportingInfoValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ClaimSupportingInfoValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ClaimSupportingInfoValueDataRequirement) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueExpression is the Expression variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueExpression struct {
+	Expression
+}
+
+func (v ClaimSupportingInfoValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ClaimSupportingInfoValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ClaimSupportingInfoValueExpression) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueParameterDefinition is the ParameterDefinition variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ClaimSupportingInfoValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ClaimSupportingInfoValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ClaimSupportingInfoValueParameterDefinition) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueRelatedArtifact is the RelatedArtifact variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ClaimSupportingInfoValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ClaimSupportingInfoValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ClaimSupportingInfoValueRelatedArtifact) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueTriggerDefinition is the TriggerDefinition variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ClaimSupportingInfoValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ClaimSupportingInfoValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ClaimSupportingInfoValueTriggerDefinition) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueUsageContext is the UsageContext variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueUsageContext struct {
+	UsageContext
+}
+
+func (v ClaimSupportingInfoValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ClaimSupportingInfoValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ClaimSupportingInfoValueUsageContext) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueAvailability is the Availability variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueAvailability struct {
+	Availability
+}
+
+func (v ClaimSupportingInfoValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ClaimSupportingInfoValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ClaimSupportingInfoValueAvailability) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueExtendedContactDetail is the ExtendedContactDetail variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ClaimSupportingInfoValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ClaimSupportingInfoValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ClaimSupportingInfoValueExtendedContactDetail) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueVirtualServiceDetail is the VirtualServiceDetail variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ClaimSupportingInfoValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ClaimSupportingInfoValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ClaimSupportingInfoValueVirtualServiceDetail) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueDosage is the Dosage variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueDosage struct {
+	Dosage
+}
+
+func (v ClaimSupportingInfoValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ClaimSupportingInfoValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ClaimSupportingInfoValueDosage) isClaimSupportingInfoValue() {}
+
+// ClaimSupportingInfoValueMeta is the Meta variant of ClaimSupportingInfoValue.
+type ClaimSupportingInfoValueMeta struct {
+	Meta
+}
+
+func (v ClaimSupportingInfoValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ClaimSupportingInfoValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ClaimSupportingInfoValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ClaimSupportingInfoValueMeta) isClaimSupportingInfoValue() {}
+
+var claimSupportingInfoValueVariants = []ClaimSupportingInfoValue{
+	ClaimSupportingInfoValueBase64Binary(""),
+	ClaimSupportingInfoValueBoolean(false),
+	ClaimSupportingInfoValueCanonical(""),
+	ClaimSupportingInfoValueCode(""),
+	ClaimSupportingInfoValueDate{},
+	ClaimSupportingInfoValueDateTime{},
+	ClaimSupportingInfoValueDecimal{},
+	ClaimSupportingInfoValueId(""),
+	ClaimSupportingInfoValueInstant{},
+	ClaimSupportingInfoValueInteger(0),
+	ClaimSupportingInfoValueInteger64(0),
+	ClaimSupportingInfoValueMarkdown(""),
+	ClaimSupportingInfoValueOid(""),
+	ClaimSupportingInfoValuePositiveInt(0),
+	ClaimSupportingInfoValueString(""),
+	ClaimSupportingInfoValueTime{},
+	ClaimSupportingInfoValueUnsignedInt(0),
+	ClaimSupportingInfoValueUri(""),
+	ClaimSupportingInfoValueUrl(""),
+	ClaimSupportingInfoValueUuid{},
+	ClaimSupportingInfoValueAddress{},
+	ClaimSupportingInfoValueAge{},
+	ClaimSupportingInfoValueAnnotation{},
+	ClaimSupportingInfoValueAttachment{},
+	ClaimSupportingInfoValueCodeableConcept{},
+	ClaimSupportingInfoValueCodeableReference{},
+	ClaimSupportingInfoValueCoding{},
+	ClaimSupportingInfoValueContactPoint{},
+	ClaimSupportingInfoValueCount{},
+	ClaimSupportingInfoValueDistance{},
+	ClaimSupportingInfoValueDuration{},
+	ClaimSupportingInfoValueHumanName{},
+	ClaimSupportingInfoValueIdentifier{},
+	ClaimSupportingInfoValueMoney{},
+	ClaimSupportingInfoValuePeriod{},
+	ClaimSupportingInfoValueQuantity{},
+	ClaimSupportingInfoValueRange{},
+	ClaimSupportingInfoValueRatio{},
+	ClaimSupportingInfoValueRatioRange{},
+	ClaimSupportingInfoValueReference{},
+	ClaimSupportingInfoValueSampledData{},
+	ClaimSupportingInfoValueSignature{},
+	ClaimSupportingInfoValueTiming{},
+	ClaimSupportingInfoValueContactDetail{},
+	ClaimSupportingInfoValueDataRequirement{},
+	ClaimSupportingInfoValueExpression{},
+	ClaimSupportingInfoValueParameterDefinition{},
+	ClaimSupportingInfoValueRelatedArtifact{},
+	ClaimSupportingInfoValueTriggerDefinition{},
+	ClaimSupportingInfoValueUsageContext{},
+	ClaimSupportingInfoValueAvailability{},
+	ClaimSupportingInfoValueExtendedContactDetail{},
+	ClaimSupportingInfoValueVirtualServiceDetail{},
+	ClaimSupportingInfoValueDosage{},
+	ClaimSupportingInfoValueMeta{},
+}
+
+type ClaimDiagnosis struct {
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                     `json:"sequence" bson:"sequence"`                                        // Diagnosis instance identifier
+	SequenceElement   *Element                `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Diagnosis         ClaimDiagnosisDiagnosis `json:"-" bson:"diagnosis,omitempty"`                                    // Nature of illness or problem
+	Type              []CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                            // Timing or nature of the diagnosis
+	OnAdmission       *CodeableConcept        `json:"onAdmission,omitempty" bson:"on_admission,omitempty"`             // Present on admission
+
+	diagnosisVariants []string // JSON properties of Claim.diagnosis.diagnosis[x] when more than one was decoded
+}
+
+func (r *ClaimDiagnosis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if len(r.diagnosisVariants) > 1 {
+		return fmt.Errorf("field 'Diagnosis' must have a single type, got %v", r.diagnosisVariants)
+	}
+	if r.Diagnosis == nil {
+		return fmt.Errorf("field 'Diagnosis' is required")
+	}
+	if r.Diagnosis != nil {
+		if err := r.Diagnosis.Validate(); err != nil {
+			return fmt.Errorf("Diagnosis: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	if r.OnAdmission != nil {
+		if err := r.OnAdmission.Validate(); err != nil {
+			return fmt.Errorf("OnAdmission: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimDiagnosis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if len(r.diagnosisVariants) > 1 {
+		issues.add("structure", path+".diagnosis", fmt.Sprintf("field 'Diagnosis' must have a single type, got %v", r.diagnosisVariants))
+	}
+	if r.Diagnosis == nil {
+		issues.add("required", path+".diagnosis", "field 'Diagnosis' is required")
+	}
+	if r.Diagnosis != nil {
+		r.Diagnosis.validateAll(path+".diagnosis.ofType("+r.Diagnosis.FHIRType()+")", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.OnAdmission != nil {
+		r.OnAdmission.validateAll(path+".onAdmission", issues)
+	}
+}
+
+var claimDiagnosisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimDiagnosis) invariants() []invariant {
+	return claimDiagnosisInvariants
+}
+
+func (r ClaimDiagnosis) MarshalJSON() ([]byte, error) {
+	type alias ClaimDiagnosis
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "diagnosis", r.Diagnosis, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimDiagnosis) UnmarshalJSON(data []byte) error {
+	type alias ClaimDiagnosis
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Diagnosis, _, r.diagnosisVariants, err = unmarshalChoice(fields, "diagnosis", claimDiagnosisDiagnosisVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ClaimDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimDiagnosis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimDiagnosis) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Diagnosis":
+		return "diagnosis", choiceValues(claimDiagnosisDiagnosisVariants)
+	}
+	return "", nil
+}
+
+var claimDiagnosisElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimDiagnosis) elementTypes() map[string]string {
+	return claimDiagnosisElementTypes
+}
+
+var claimDiagnosisElementMetadata = []ElementMetadata{
+	{Path: "Claim.diagnosis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.diagnosis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.diagnosis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.diagnosis.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.diagnosis.diagnosis[x]", Name: "diagnosis", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Claim.diagnosis.type", Name: "type", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.diagnosis.onAdmission", Name: "onAdmission", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimDiagnosis) ElementMetadata() []ElementMetadata {
+	return claimDiagnosisElementMetadata
+}
+
+// ClaimDiagnosisDiagnosis is the type of Claim.diagnosis.diagnosis[x]. It is implemented by the ClaimDiagnosisDiagnosis* variant types.
+type ClaimDiagnosisDiagnosis interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimDiagnosisDiagnosis()
+}
+
+// ClaimDiagnosisDiagnosisCodeableConcept is the CodeableConcept variant of ClaimDiagnosisDiagnosis.
+type ClaimDiagnosisDiagnosisCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClaimDiagnosisDiagnosisCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClaimDiagnosisDiagnosisCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClaimDiagnosisDiagnosisCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClaimDiagnosisDiagnosisCodeableConcept) isClaimDiagnosisDiagnosis() {}
+
+// ClaimDiagnosisDiagnosisReference is the Reference variant of ClaimDiagnosisDiagnosis.
+type ClaimDiagnosisDiagnosisReference struct {
+	Reference
+}
+
+func (v ClaimDiagnosisDiagnosisReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimDiagnosisDiagnosisReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimDiagnosisDiagnosisReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimDiagnosisDiagnosisReference) isClaimDiagnosisDiagnosis() {}
+
+var claimDiagnosisDiagnosisVariants = []ClaimDiagnosisDiagnosis{
+	ClaimDiagnosisDiagnosisCodeableConcept{},
+	ClaimDiagnosisDiagnosisReference{},
+}
+
+type ClaimProcedure struct {
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                     `json:"sequence" bson:"sequence"`                                        // Procedure instance identifier
+	SequenceElement   *Element                `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Type              []CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                            // Category of Procedure
+	Date              *DateTime               `json:"date,omitempty" bson:"date,omitempty"`                            // When the procedure was performed
+	DateElement       *Element                `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Procedure         ClaimProcedureProcedure `json:"-" bson:"procedure,omitempty"`                                    // Specific clinical procedure
+	Udi               []Reference             `json:"udi,omitempty" bson:"udi,omitempty"`                              // Unique device identifier
+
+	procedureVariants []string // JSON properties of Claim.procedure.procedure[x] when more than one was decoded
+}
+
+var claimProcedureUdiTargets = []string{"Device"}
+
+func (r *ClaimProcedure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if len(r.procedureVariants) > 1 {
+		return fmt.Errorf("field 'Procedure' must have a single type, got %v", r.procedureVariants)
+	}
+	if r.Procedure == nil {
+		return fmt.Errorf("field 'Procedure' is required")
+	}
+	if r.Procedure != nil {
+		if err := r.Procedure.Validate(); err != nil {
+			return fmt.Errorf("Procedure: %w", err)
+		}
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimProcedureUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimProcedureUdiTargets)
+		}
+	}
+	for i, item := range r.Udi {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Udi[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimProcedure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if len(r.procedureVariants) > 1 {
+		issues.add("structure", path+".procedure", fmt.Sprintf("field 'Procedure' must have a single type, got %v", r.procedureVariants))
+	}
+	if r.Procedure == nil {
+		issues.add("required", path+".procedure", "field 'Procedure' is required")
+	}
+	if r.Procedure != nil {
+		r.Procedure.validateAll(path+".procedure.ofType("+r.Procedure.FHIRType()+")", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimProcedureUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimProcedureUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+}
+
+var claimProcedureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimProcedure) invariants() []invariant {
+	return claimProcedureInvariants
+}
+
+func (r ClaimProcedure) MarshalJSON() ([]byte, error) {
+	type alias ClaimProcedure
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "procedure", r.Procedure, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimProcedure) UnmarshalJSON(data []byte) error {
+	type alias ClaimProcedure
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Procedure, _, r.procedureVariants, err = unmarshalChoice(fields, "procedure", claimProcedureProcedureVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ClaimProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimProcedure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimProcedure) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Procedure":
+		return "procedure", choiceValues(claimProcedureProcedureVariants)
+	}
+	return "", nil
+}
+
+var claimProcedureElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimProcedure) elementTypes() map[string]string {
+	return claimProcedureElementTypes
+}
+
+var claimProcedureElementMetadata = []ElementMetadata{
+	{Path: "Claim.procedure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.procedure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.procedure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.procedure.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.procedure.type", Name: "type", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.procedure.date", Name: "date", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Claim.procedure.procedure[x]", Name: "procedure", Field: 8, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Claim.procedure.udi", Name: "udi", Field: 9, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+}
+
+func (r *ClaimProcedure) ElementMetadata() []ElementMetadata {
+	return claimProcedureElementMetadata
+}
+
+// ClaimProcedureProcedure is the type of Claim.procedure.procedure[x]. It is implemented by the ClaimProcedureProcedure* variant types.
+type ClaimProcedureProcedure interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimProcedureProcedure()
+}
+
+// ClaimProcedureProcedureCodeableConcept is the CodeableConcept variant of ClaimProcedureProcedure.
+type ClaimProcedureProcedureCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClaimProcedureProcedureCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClaimProcedureProcedureCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClaimProcedureProcedureCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClaimProcedureProcedureCodeableConcept) isClaimProcedureProcedure() {}
+
+// ClaimProcedureProcedureReference is the Reference variant of ClaimProcedureProcedure.
+type ClaimProcedureProcedureReference struct {
+	Reference
+}
+
+func (v ClaimProcedureProcedureReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimProcedureProcedureReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimProcedureProcedureReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimProcedureProcedureReference) isClaimProcedureProcedure() {}
+
+var claimProcedureProcedureVariants = []ClaimProcedureProcedure{
+	ClaimProcedureProcedureCodeableConcept{},
+	ClaimProcedureProcedureReference{},
+}
+
+type ClaimInsurance struct {
+	Id                         *string     `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	Sequence                   int         `json:"sequence" bson:"sequence"`                                                     // Insurance instance identifier
+	SequenceElement            *Element    `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                        // Extensions for sequence
+	Focal                      bool        `json:"focal" bson:"focal"`                                                           // Coverage to be used for adjudication
+	FocalElement               *Element    `json:"_focal,omitempty" bson:"focal_element,omitempty"`                              // Extensions for focal
+	Identifier                 *Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`                             // Pre-assigned Claim number
+	Coverage                   *Reference  `json:"coverage" bson:"coverage"`                                                     // Insurance information
+	BusinessArrangement        *string     `json:"businessArrangement,omitempty" bson:"business_arrangement,omitempty"`          // Additional provider contract number
+	BusinessArrangementElement *Element    `json:"_businessArrangement,omitempty" bson:"business_arrangement_element,omitempty"` // Extensions for businessArrangement
+	PreAuthRef                 []string    `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`                           // Prior authorization reference number
+	PreAuthRefElement          []*Element  `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`                  // Extensions for preAuthRef
+	ClaimResponse              *Reference  `json:"claimResponse,omitempty" bson:"claim_response,omitempty"`                      // Adjudication results
+}
+
+func (r *ClaimInsurance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.FocalElement != nil {
+		if err := r.FocalElement.Validate(); err != nil {
+			return fmt.Errorf("FocalElement: %w", err)
+		}
+	}
+	if r.Identifier != nil {
+		if err := r.Identifier.Validate(); err != nil {
+			return fmt.Errorf("Identifier: %w", err)
+		}
+	}
+	if r.Coverage == nil {
+		return fmt.Errorf("field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		if err := r.Coverage.Validate(); err != nil {
+			return fmt.Errorf("Coverage: %w", err)
+		}
+	}
+	if r.BusinessArrangementElement != nil {
+		if err := r.BusinessArrangementElement.Validate(); err != nil {
+			return fmt.Errorf("BusinessArrangementElement: %w", err)
+		}
+	}
+	for i, item := range r.PreAuthRefElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement[%d]: %w", i, err)
+		}
+	}
+	if r.ClaimResponse != nil {
+		if err := r.ClaimResponse.Validate(); err != nil {
+			return fmt.Errorf("ClaimResponse: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimInsurance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.FocalElement != nil {
+		r.FocalElement.validateAll(path+".focal", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Coverage == nil {
+		issues.add("required", path+".coverage", "field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+	if r.BusinessArrangementElement != nil {
+		r.BusinessArrangementElement.validateAll(path+".businessArrangement", issues)
+	}
+	for i, item := range r.PreAuthRefElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.preAuthRef[%d]", path, i), issues)
+	}
+	if r.ClaimResponse != nil {
+		r.ClaimResponse.validateAll(path+".claimResponse", issues)
+	}
+}
+
+var claimInsuranceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimInsurance) invariants() []invariant {
+	return claimInsuranceInvariants
+}
+
+func (r ClaimInsurance) MarshalJSON() ([]byte, error) {
+	type alias ClaimInsurance
+	out := struct {
+		alias
+		PreAuthRef        []*string  `json:"preAuthRef,omitempty"`
+		PreAuthRefElement []*Element `json:"_preAuthRef,omitempty"`
+	}{alias: alias(r)}
+	out.PreAuthRef, out.PreAuthRefElement = alignPrimitiveArray(r.PreAuthRef, r.PreAuthRefElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimInsurance) UnmarshalJSON(data []byte) error {
+	type alias ClaimInsurance
+	aux := struct {
+		*alias
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.PreAuthRef, err = splitPrimitiveArray(aux.PreAuthRef, r.PreAuthRefElement); err != nil {
+		return fmt.Errorf("preAuthRef: %w", err)
+	}
+	return nil
+}
+
+func (r ClaimInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimInsurance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimInsuranceElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimInsurance) elementTypes() map[string]string {
+	return claimInsuranceElementTypes
+}
+
+var claimInsuranceElementMetadata = []ElementMetadata{
+	{Path: "Claim.insurance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.insurance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.insurance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.insurance.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.insurance.focal", Name: "focal", Field: 5, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "Claim.insurance.identifier", Name: "identifier", Field: 7, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Claim.insurance.coverage", Name: "coverage", Field: 8, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "Claim.insurance.businessArrangement", Name: "businessArrangement", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.insurance.preAuthRef", Name: "preAuthRef", Field: 11, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Claim.insurance.claimResponse", Name: "claimResponse", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ClaimInsurance) ElementMetadata() []ElementMetadata {
+	return claimInsuranceElementMetadata
+}
+
+type ClaimAccident struct {
+	Id                *string               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Date              *Date                 `json:"date" bson:"date"`                                                // When the incident occurred
+	DateElement       *Element              `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Type              *CodeableConcept      `json:"type,omitempty" bson:"type,omitempty"`                            // The nature of the accident
+	Location          ClaimAccidentLocation `json:"-" bson:"location,omitempty"`                                     // Where the event occurred
+
+	locationVariants []string // JSON properties of Claim.accident.location[x] when more than one was decoded
+}
+
+func (r *ClaimAccident) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Date == nil {
+		return fmt.Errorf("field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.locationVariants) > 1 {
+		return fmt.Errorf("field 'Location' must have a single type, got %v", r.locationVariants)
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimAccident) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.locationVariants) > 1 {
+		issues.add("structure", path+".location", fmt.Sprintf("field 'Location' must have a single type, got %v", r.locationVariants))
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location.ofType("+r.Location.FHIRType()+")", issues)
+	}
+}
+
+var claimAccidentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimAccident) invariants() []invariant {
+	return claimAccidentInvariants
+}
+
+func (r ClaimAccident) MarshalJSON() ([]byte, error) {
+	type alias ClaimAccident
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "location", r.Location, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimAccident) UnmarshalJSON(data []byte) error {
+	type alias ClaimAccident
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Location, _, r.locationVariants, err = unmarshalChoice(fields, "location", claimAccidentLocationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ClaimAccident) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimAccident) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimAccident) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimAccident) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimAccident) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Location":
+		return "location", choiceValues(claimAccidentLocationVariants)
+	}
+	return "", nil
+}
+
+var claimAccidentElementMetadata = []ElementMetadata{
+	{Path: "Claim.accident.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.accident.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.accident.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.accident.date", Name: "date", Field: 3, Min: 1, Max: "1", Types: []string{"date"}},
+	{Path: "Claim.accident.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.accident.location[x]", Name: "location", Field: 6, Min: 0, Max: "1", Types: []string{"Address", "Reference"}},
+}
+
+func (r *ClaimAccident) ElementMetadata() []ElementMetadata {
+	return claimAccidentElementMetadata
+}
+
+// ClaimAccidentLocation is the type of Claim.accident.location[x]. It is implemented by the ClaimAccidentLocation* variant types.
+type ClaimAccidentLocation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimAccidentLocation()
+}
+
+// ClaimAccidentLocationAddress is the Address variant of ClaimAccidentLocation.
+type ClaimAccidentLocationAddress struct {
+	Address
+}
+
+func (v ClaimAccidentLocationAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ClaimAccidentLocationAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ClaimAccidentLocationAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ClaimAccidentLocationAddress) isClaimAccidentLocation() {}
+
+// ClaimAccidentLocationReference is the Reference variant of ClaimAccidentLocation.
+type ClaimAccidentLocationReference struct {
+	Reference
+}
+
+func (v ClaimAccidentLocationReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimAccidentLocationReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimAccidentLocationReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimAccidentLocationReference) isClaimAccidentLocation() {}
+
+var claimAccidentLocationVariants = []ClaimAccidentLocation{
+	ClaimAccidentLocationAddress{},
+	ClaimAccidentLocationReference{},
+}
+
+type ClaimItem struct {
+	Id                         *string             `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	Sequence                   int                 `json:"sequence" bson:"sequence"`                                                     // Item instance identifier
+	SequenceElement            *Element            `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                        // Extensions for sequence
+	TraceNumber                []Identifier        `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
+	Subject                    *Reference          `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
+	CareTeamSequence           []int               `json:"careTeamSequence,omitempty" bson:"care_team_sequence,omitempty"`               // Applicable careTeam members
+	CareTeamSequenceElement    []*Element          `json:"_careTeamSequence,omitempty" bson:"care_team_sequence_element,omitempty"`      // Extensions for careTeamSequence
+	DiagnosisSequence          []int               `json:"diagnosisSequence,omitempty" bson:"diagnosis_sequence,omitempty"`              // Applicable diagnoses
+	DiagnosisSequenceElement   []*Element          `json:"_diagnosisSequence,omitempty" bson:"diagnosis_sequence_element,omitempty"`     // Extensions for diagnosisSequence
+	ProcedureSequence          []int               `json:"procedureSequence,omitempty" bson:"procedure_sequence,omitempty"`              // Applicable procedures
+	ProcedureSequenceElement   []*Element          `json:"_procedureSequence,omitempty" bson:"procedure_sequence_element,omitempty"`     // Extensions for procedureSequence
+	InformationSequence        []int               `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
+	InformationSequenceElement []*Element          `json:"_informationSequence,omitempty" bson:"information_sequence_element,omitempty"` // Extensions for informationSequence
+	Revenue                    *CodeableConcept    `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
+	Category                   *CodeableConcept    `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
+	ProductOrService           *CodeableConcept    `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
+	ProductOrServiceEnd        *CodeableConcept    `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
+	Request                    []Reference         `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
+	Modifier                   []CodeableConcept   `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Product or service billing modifiers
+	ProgramCode                []CodeableConcept   `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
+	Serviced                   ClaimItemServiced   `json:"-" bson:"serviced,omitempty"`                                                  // Date or dates of service or product delivery
+	ServicedElement            *Element            `json:"-" bson:"serviced_element,omitempty"`                                          // Extensions for serviced[x]
+	Location                   ClaimItemLocation   `json:"-" bson:"location,omitempty"`                                                  // Place of service or where product was supplied
+	PatientPaid                *Money              `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                          // Paid by the patient
+	Quantity                   *Quantity           `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
+	UnitPrice                  *Money              `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
+	Factor                     *Decimal            `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
+	FactorElement              *Element            `json:"_factor,omitempty" bson:"factor_element,omitempty"`                            // Extensions for factor
+	Tax                        *Money              `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
+	Net                        *Money              `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
+	Udi                        []Reference         `json:"udi,omitempty" bson:"udi,omitempty"`                                           // Unique device identifier
+	BodySite                   []ClaimItemBodySite `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
+	Encounter                  []Reference         `json:"encounter,omitempty" bson:"encounter,omitempty"`                               // Encounters associated with the listed treatments
+	Detail                     []ClaimItemDetail   `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Product or service provided
+
+	servicedVariants []string // JSON properties of Claim.item.serviced[x] when more than one was decoded
+	locationVariants []string // JSON properties of Claim.item.location[x] when more than one was decoded
+}
+
+var claimItemSubjectTargets = []string{"Group", "Patient"}
+
+var claimItemUdiTargets = []string{"Device"}
+
+var claimItemEncounterTargets = []string{"Encounter"}
+
+func (r *ClaimItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimItemSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, claimItemSubjectTargets)
+		}
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	for i, item := range r.CareTeamSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("CareTeamSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DiagnosisSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DiagnosisSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProcedureSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProcedureSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InformationSequenceElement[%d]: %w", i, err)
+		}
+	}
+	if r.Revenue != nil {
+		if err := r.Revenue.Validate(); err != nil {
+			return fmt.Errorf("Revenue: %w", err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	if r.ProductOrServiceEnd != nil {
+		if err := r.ProductOrServiceEnd.Validate(); err != nil {
+			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+		}
+	}
+	for i, item := range r.Request {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Request[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProgramCode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+		}
+	}
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
+	}
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
+		}
+	}
+	if len(r.locationVariants) > 1 {
+		return fmt.Errorf("field 'Location' must have a single type, got %v", r.locationVariants)
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
+	if r.PatientPaid != nil {
+		if err := r.PatientPaid.Validate(); err != nil {
+			return fmt.Errorf("PatientPaid: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.Tax != nil {
+		if err := r.Tax.Validate(); err != nil {
+			return fmt.Errorf("Tax: %w", err)
+		}
+	}
+	if r.Net != nil {
+		if err := r.Net.Validate(); err != nil {
+			return fmt.Errorf("Net: %w", err)
+		}
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimItemUdiTargets)
+		}
+	}
+	for i, item := range r.Udi {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Udi[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.BodySite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BodySite[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Encounter {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter[%d]' cannot refer to %s, expected one of %v", i, target, claimItemEncounterTargets)
+		}
+	}
+	for i, item := range r.Encounter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Encounter[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Detail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimItemSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, claimItemSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.CareTeamSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.careTeamSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.DiagnosisSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.diagnosisSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.ProcedureSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.procedureSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.informationSequence[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Request {
+		item.validateAll(fmt.Sprintf("%s.request[%d]", path, i), issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if len(r.locationVariants) > 1 {
+		issues.add("structure", path+".location", fmt.Sprintf("field 'Location' must have a single type, got %v", r.locationVariants))
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location.ofType("+r.Location.FHIRType()+")", issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimItemUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	for i, item := range r.Encounter {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemEncounterTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.encounter[%d]", path, i), fmt.Sprintf("field 'Encounter[%d]' cannot refer to %s, expected one of %v", i, target, claimItemEncounterTargets))
+		}
+	}
+	for i, item := range r.Encounter {
+		item.validateAll(fmt.Sprintf("%s.encounter[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var claimItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimItem) invariants() []invariant {
+	return claimItemInvariants
+}
+
+func (r ClaimItem) MarshalJSON() ([]byte, error) {
+	type alias ClaimItem
+	out := struct {
+		alias
+		CareTeamSequence           []*int     `json:"careTeamSequence,omitempty"`
+		CareTeamSequenceElement    []*Element `json:"_careTeamSequence,omitempty"`
+		DiagnosisSequence          []*int     `json:"diagnosisSequence,omitempty"`
+		DiagnosisSequenceElement   []*Element `json:"_diagnosisSequence,omitempty"`
+		ProcedureSequence          []*int     `json:"procedureSequence,omitempty"`
+		ProcedureSequenceElement   []*Element `json:"_procedureSequence,omitempty"`
+		InformationSequence        []*int     `json:"informationSequence,omitempty"`
+		InformationSequenceElement []*Element `json:"_informationSequence,omitempty"`
+	}{alias: alias(r)}
+	out.CareTeamSequence, out.CareTeamSequenceElement = alignPrimitiveArray(r.CareTeamSequence, r.CareTeamSequenceElement)
+	out.DiagnosisSequence, out.DiagnosisSequenceElement = alignPrimitiveArray(r.DiagnosisSequence, r.DiagnosisSequenceElement)
+	out.ProcedureSequence, out.ProcedureSequenceElement = alignPrimitiveArray(r.ProcedureSequence, r.ProcedureSequenceElement)
+	out.InformationSequence, out.InformationSequenceElement = alignPrimitiveArray(r.InformationSequence, r.InformationSequenceElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "location", r.Location, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimItem) UnmarshalJSON(data []byte) error {
+	type alias ClaimItem
+	aux := struct {
+		*alias
+		CareTeamSequence    []*int `json:"careTeamSequence,omitempty"`
+		DiagnosisSequence   []*int `json:"diagnosisSequence,omitempty"`
+		ProcedureSequence   []*int `json:"procedureSequence,omitempty"`
+		InformationSequence []*int `json:"informationSequence,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.CareTeamSequence, err = splitPrimitiveArray(aux.CareTeamSequence, r.CareTeamSequenceElement); err != nil {
+		return fmt.Errorf("careTeamSequence: %w", err)
+	}
+	if r.DiagnosisSequence, err = splitPrimitiveArray(aux.DiagnosisSequence, r.DiagnosisSequenceElement); err != nil {
+		return fmt.Errorf("diagnosisSequence: %w", err)
+	}
+	if r.ProcedureSequence, err = splitPrimitiveArray(aux.ProcedureSequence, r.ProcedureSequenceElement); err != nil {
+		return fmt.Errorf("procedureSequence: %w", err)
+	}
+	if r.InformationSequence, err = splitPrimitiveArray(aux.InformationSequence, r.InformationSequenceElement); err != nil {
+		return fmt.Errorf("informationSequence: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", claimItemServicedVariants); err != nil {
+		return err
+	}
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
+		}
+	}
+	if r.Location, _, r.locationVariants, err = unmarshalChoice(fields, "location", claimItemLocationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ClaimItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(claimItemServicedVariants)
+	case "Location":
+		return "location", choiceValues(claimItemLocationVariants)
+	}
+	return "", nil
+}
+
+var claimItemElementTypes = map[string]string{
+	"sequence":            "positiveInt",
+	"careTeamSequence":    "positiveInt",
+	"diagnosisSequence":   "positiveInt",
+	"procedureSequence":   "positiveInt",
+	"informationSequence": "positiveInt",
+}
+
+func (r *ClaimItem) elementTypes() map[string]string {
+	return claimItemElementTypes
+}
+
+var claimItemElementMetadata = []ElementMetadata{
+	{Path: "Claim.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.item.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Claim.item.subject", Name: "subject", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Claim.item.careTeamSequence", Name: "careTeamSequence", Field: 7, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.diagnosisSequence", Name: "diagnosisSequence", Field: 9, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.procedureSequence", Name: "procedureSequence", Field: 11, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.informationSequence", Name: "informationSequence", Field: 13, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.revenue", Name: "revenue", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.category", Name: "category", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.productOrService", Name: "productOrService", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.productOrServiceEnd", Name: "productOrServiceEnd", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.request", Name: "request", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Claim.item.modifier", Name: "modifier", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.programCode", Name: "programCode", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.serviced[x]", Name: "serviced", Field: 22, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "Claim.item.location[x]", Name: "location", Field: 24, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Address", "Reference"}},
+	{Path: "Claim.item.patientPaid", Name: "patientPaid", Field: 25, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.quantity", Name: "quantity", Field: 26, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Claim.item.unitPrice", Name: "unitPrice", Field: 27, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.factor", Name: "factor", Field: 28, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Claim.item.tax", Name: "tax", Field: 30, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.net", Name: "net", Field: 31, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.udi", Name: "udi", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "Claim.item.bodySite", Name: "bodySite", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Claim.item.encounter", Name: "encounter", Field: 34, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Claim.item.detail", Name: "detail", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimItem) ElementMetadata() []ElementMetadata {
+	return claimItemElementMetadata
+}
+
+// ClaimItemServiced is the type of Claim.item.serviced[x]. It is implemented by the ClaimItemServiced* variant types.
+type ClaimItemServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimItemServiced()
+}
+
+// ClaimItemServicedDate is the date variant of ClaimItemServiced.
+type ClaimItemServicedDate struct {
+	Date
+}
+
+func (v ClaimItemServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ClaimItemServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimItemServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v ClaimItemServicedDate) isClaimItemServiced() {}
+
+// ClaimItemServicedPeriod is the Period variant of ClaimItemServiced.
+type ClaimItemServicedPeriod struct {
+	Period
+}
+
+func (v ClaimItemServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimItemServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimItemServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimItemServicedPeriod) isClaimItemServiced() {}
+
+var claimItemServicedVariants = []ClaimItemServiced{
+	ClaimItemServicedDate{},
+	ClaimItemServicedPeriod{},
+}
+
+// ClaimItemLocation is the type of Claim.item.location[x]. It is implemented by the ClaimItemLocation* variant types.
+type ClaimItemLocation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimItemLocation()
+}
+
+// ClaimItemLocationCodeableConcept is the CodeableConcept variant of ClaimItemLocation.
+type ClaimItemLocationCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClaimItemLocationCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClaimItemLocationCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClaimItemLocationCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClaimItemLocationCodeableConcept) isClaimItemLocation() {}
+
+// ClaimItemLocationAddress is the Address variant of ClaimItemLocation.
+type ClaimItemLocationAddress struct {
+	Address
+}
+
+func (v ClaimItemLocationAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ClaimItemLocationAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ClaimItemLocationAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ClaimItemLocationAddress) isClaimItemLocation() {}
+
+// ClaimItemLocationReference is the Reference variant of ClaimItemLocation.
+type ClaimItemLocationReference struct {
+	Reference
+}
+
+func (v ClaimItemLocationReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimItemLocationReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimItemLocationReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimItemLocationReference) isClaimItemLocation() {}
+
+var claimItemLocationVariants = []ClaimItemLocation{
+	ClaimItemLocationCodeableConcept{},
+	ClaimItemLocationAddress{},
+	ClaimItemLocationReference{},
+}
+
+type ClaimItemBodySite struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Site              []CodeableReference `json:"site" bson:"site"`                                                // Location
+	SubSite           []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"`                     // Sub-location
+}
+
+func (r *ClaimItemBodySite) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.Site) < 1 {
+		return fmt.Errorf("field 'Site' must have at least 1 elements")
+	}
+	for i, item := range r.Site {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Site[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubSite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubSite[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimItemBodySite) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Site) < 1 {
+		issues.add("required", path+".site", "field 'Site' must have at least 1 elements")
+	}
+	for i, item := range r.Site {
+		item.validateAll(fmt.Sprintf("%s.site[%d]", path, i), issues)
+	}
+	for i, item := range r.SubSite {
+		item.validateAll(fmt.Sprintf("%s.subSite[%d]", path, i), issues)
+	}
+}
+
+var claimItemBodySiteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimItemBodySite) invariants() []invariant {
+	return claimItemBodySiteInvariants
+}
+
+func (r ClaimItemBodySite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimItemBodySite) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimItemBodySite) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimItemBodySite) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimItemBodySiteElementMetadata = []ElementMetadata{
+	{Path: "Claim.item.bodySite.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.item.bodySite.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.item.bodySite.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.item.bodySite.site", Name: "site", Field: 3, Min: 1, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Claim.item.bodySite.subSite", Name: "subSite", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimItemBodySite) ElementMetadata() []ElementMetadata {
+	return claimItemBodySiteElementMetadata
+}
+
+type ClaimItemDetail struct {
+	Id                  *string                    `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	Sequence            int                        `json:"sequence" bson:"sequence"`                                              // Item instance identifier
+	SequenceElement     *Element                   `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                 // Extensions for sequence
+	TraceNumber         []Identifier               `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
+	Revenue             *CodeableConcept           `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
+	Category            *CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
+	ProductOrService    *CodeableConcept           `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
+	ProductOrServiceEnd *CodeableConcept           `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
 	Modifier            []CodeableConcept          `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
 	ProgramCode         []CodeableConcept          `json:"programCode,omitempty" bson:"program_code,omitempty"`                   // Program the product or service is provided under
 	PatientPaid         *Money                     `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
 	Quantity            *Quantity                  `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
 	UnitPrice           *Money                     `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                   `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	Factor              *Decimal                   `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                   `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
 	Tax                 *Money                     `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
 	Net                 *Money                     `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
 	Udi                 []Reference                `json:"udi,omitempty" bson:"udi,omitempty"`                                    // Unique device identifier
 	SubDetail           []ClaimItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                       // Product or service provided
 }
 
+var claimItemDetailUdiTargets = []string{"Device"}
+
 func (r *ClaimItemDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Sequence == 0 {
 		return fmt.Errorf("field 'Sequence' is required")
 	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
@@ -561,6 +4313,16 @@ func (r *ClaimItemDetail) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -571,22 +4333,158 @@ func (r *ClaimItemDetail) Validate() error {
 			return fmt.Errorf("Net: %w", err)
 		}
 	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemDetailUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimItemDetailUdiTargets)
+		}
+	}
 	for i, item := range r.Udi {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Udi[%d]: %w", i, err)
 		}
 	}
 	for i, item := range r.SubDetail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubDetail[%d]: %w", i, err)
-		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubDetail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimItemDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemDetailUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimItemDetailUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+	for i, item := range r.SubDetail {
+		item.validateAll(fmt.Sprintf("%s.subDetail[%d]", path, i), issues)
 	}
-	return nil
+}
+
+var claimItemDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimItemDetail) invariants() []invariant {
+	return claimItemDetailInvariants
+}
+
+func (r ClaimItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimItemDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimItemDetailElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimItemDetail) elementTypes() map[string]string {
+	return claimItemDetailElementTypes
+}
+
+var claimItemDetailElementMetadata = []ElementMetadata{
+	{Path: "Claim.item.detail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.item.detail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.item.detail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.item.detail.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.detail.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Claim.item.detail.revenue", Name: "revenue", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.category", Name: "category", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.productOrService", Name: "productOrService", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.modifier", Name: "modifier", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.programCode", Name: "programCode", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.patientPaid", Name: "patientPaid", Field: 12, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.quantity", Name: "quantity", Field: 13, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Claim.item.detail.unitPrice", Name: "unitPrice", Field: 14, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.factor", Name: "factor", Field: 15, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Claim.item.detail.tax", Name: "tax", Field: 17, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.net", Name: "net", Field: 18, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.udi", Name: "udi", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "Claim.item.detail.subDetail", Name: "subDetail", Field: 20, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimItemDetail) ElementMetadata() []ElementMetadata {
+	return claimItemDetailElementMetadata
 }
 
 type ClaimItemDetailSubDetail struct {
 	Id                  *string           `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
 	Sequence            int               `json:"sequence" bson:"sequence"`                                              // Item instance identifier
+	SequenceElement     *Element          `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                 // Extensions for sequence
 	TraceNumber         []Identifier      `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
 	Revenue             *CodeableConcept  `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
 	Category            *CodeableConcept  `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
@@ -597,16 +4495,34 @@ type ClaimItemDetailSubDetail struct {
 	PatientPaid         *Money            `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
 	Quantity            *Quantity         `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
 	UnitPrice           *Money            `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64          `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	Factor              *Decimal          `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element          `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
 	Tax                 *Money            `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
 	Net                 *Money            `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
 	Udi                 []Reference       `json:"udi,omitempty" bson:"udi,omitempty"`                                    // Unique device identifier
 }
 
+var claimItemDetailSubDetailUdiTargets = []string{"Device"}
+
 func (r *ClaimItemDetailSubDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Sequence == 0 {
 		return fmt.Errorf("field 'Sequence' is required")
 	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
@@ -657,6 +4573,16 @@ func (r *ClaimItemDetailSubDetail) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -668,473 +4594,138 @@ func (r *ClaimItemDetailSubDetail) Validate() error {
 		}
 	}
 	for i, item := range r.Udi {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Udi[%d]: %w", i, err)
-		}
-	}
-	return nil
-}
-
-type ClaimRelated struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Claim        *Reference       `json:"claim,omitempty" bson:"claim,omitempty"`               // Reference to the related claim
-	Relationship *CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"` // How the reference claim is related
-	Reference    *Identifier      `json:"reference,omitempty" bson:"reference,omitempty"`       // File or case reference
-}
-
-func (r *ClaimRelated) Validate() error {
-	if r.Claim != nil {
-		if err := r.Claim.Validate(); err != nil {
-			return fmt.Errorf("Claim: %w", err)
-		}
-	}
-	if r.Relationship != nil {
-		if err := r.Relationship.Validate(); err != nil {
-			return fmt.Errorf("Relationship: %w", err)
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemDetailSubDetailUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimItemDetailSubDetailUdiTargets)
 		}
 	}
-	if r.Reference != nil {
-		if err := r.Reference.Validate(); err != nil {
-			return fmt.Errorf("Reference: %w", err)
+	for i, item := range r.Udi {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Udi[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type ClaimEvent struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Type         *CodeableConcept `json:"type" bson:"type"`                   // Specific event
-	WhenDateTime *string          `json:"whenDateTime" bson:"when_date_time"` // Occurance date or period
-	WhenPeriod   *Period          `json:"whenPeriod" bson:"when_period"`      // Occurance date or period
-}
-
-func (r *ClaimEvent) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.WhenDateTime == nil {
-		return fmt.Errorf("field 'WhenDateTime' is required")
-	}
-	if r.WhenPeriod == nil {
-		return fmt.Errorf("field 'WhenPeriod' is required")
+func (r *ClaimItemDetailSubDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.WhenPeriod != nil {
-		if err := r.WhenPeriod.Validate(); err != nil {
-			return fmt.Errorf("WhenPeriod: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ClaimSupportingInfo struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
-	Sequence                   int                    `json:"sequence" bson:"sequence"`                                                            // Information instance identifier
-	Category                   *CodeableConcept       `json:"category" bson:"category"`                                                            // Classification of the supplied information
-	SubCategory                *CodeableConcept       `json:"subCategory,omitempty" bson:"sub_category,omitempty"`                                 // Finer-grained classification of the supplied information
-	Code                       *CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                                                // Type of information
-	TimingDateTime             *string                `json:"timingDateTime,omitempty" bson:"timing_date_time,omitempty"`                          // When it occurred
-	TimingPeriod               *Period                `json:"timingPeriod,omitempty" bson:"timing_period,omitempty"`                               // When it occurred
-	TimingTiming               *Timing                `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`                               // When it occurred
-	ValueBase64Binary          *string                `json:"valueBase64Binary,omitempty" bson:"value_base64_binary,omitempty"`                    // Data to be provided
-	ValueBoolean               *bool                  `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                               // Data to be provided
-	ValueCanonical             *string                `json:"valueCanonical,omitempty" bson:"value_canonical,omitempty"`                           // Data to be provided
-	ValueCode                  *string                `json:"valueCode,omitempty" bson:"value_code,omitempty"`                                     // Data to be provided
-	ValueDate                  *string                `json:"valueDate,omitempty" bson:"value_date,omitempty"`                                     // Data to be provided
-	ValueDateTime              *string                `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                            // Data to be provided
-	ValueDecimal               *float64               `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`                               // Data to be provided
-	ValueId                    *string                `json:"valueId,omitempty" bson:"value_id,omitempty"`                                         // Data to be provided
-	ValueInstant               *string                `json:"valueInstant,omitempty" bson:"value_instant,omitempty"`                               // Data to be provided
-	ValueInteger               *int                   `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                               // Data to be provided
-	ValueInteger64             *int64                 `json:"valueInteger64,omitempty" bson:"value_integer64,omitempty"`                           // Data to be provided
-	ValueMarkdown              *string                `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                             // Data to be provided
-	ValueOid                   *string                `json:"valueOid,omitempty" bson:"value_oid,omitempty"`                                       // Data to be provided
-	ValuePositiveInt           *int                   `json:"valuePositiveInt,omitempty" bson:"value_positive_int,omitempty"`                      // Data to be provided
-	ValueString                *string                `json:"valueString,omitempty" bson:"value_string,omitempty"`                                 // Data to be provided
-	ValueTime                  *string                `json:"valueTime,omitempty" bson:"value_time,omitempty"`                                     // Data to be provided
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt,omitempty" bson:"value_unsigned_int,omitempty"`                      // Data to be provided
-	ValueUri                   *string                `json:"valueUri,omitempty" bson:"value_uri,omitempty"`                                       // Data to be provided
-	ValueUrl                   *string                `json:"valueUrl,omitempty" bson:"value_url,omitempty"`                                       // Data to be provided
-	ValueUuid                  *uuid                  `json:"valueUuid,omitempty" bson:"value_uuid,omitempty"`                                     // Data to be provided
-	ValueAddress               *Address               `json:"valueAddress,omitempty" bson:"value_address,omitempty"`                               // Data to be provided
-	ValueAge                   *Age                   `json:"valueAge,omitempty" bson:"value_age,omitempty"`                                       // Data to be provided
-	ValueAnnotation            *Annotation            `json:"valueAnnotation,omitempty" bson:"value_annotation,omitempty"`                         // Data to be provided
-	ValueAttachment            *Attachment            `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`                         // Data to be provided
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`              // Data to be provided
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference,omitempty" bson:"value_codeable_reference,omitempty"`          // Data to be provided
-	ValueCoding                *Coding                `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`                                 // Data to be provided
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint,omitempty" bson:"value_contact_point,omitempty"`                    // Data to be provided
-	ValueCount                 *Count                 `json:"valueCount,omitempty" bson:"value_count,omitempty"`                                   // Data to be provided
-	ValueDistance              *Distance              `json:"valueDistance,omitempty" bson:"value_distance,omitempty"`                             // Data to be provided
-	ValueDuration              *Duration              `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`                             // Data to be provided
-	ValueHumanName             *HumanName             `json:"valueHumanName,omitempty" bson:"value_human_name,omitempty"`                          // Data to be provided
-	ValueIdentifier            *Identifier            `json:"valueIdentifier,omitempty" bson:"value_identifier,omitempty"`                         // Data to be provided
-	ValueMoney                 *Money                 `json:"valueMoney,omitempty" bson:"value_money,omitempty"`                                   // Data to be provided
-	ValuePeriod                *Period                `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                                 // Data to be provided
-	ValueQuantity              *Quantity              `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                             // Data to be provided
-	ValueRange                 *Range                 `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                   // Data to be provided
-	ValueRatio                 *Ratio                 `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                                   // Data to be provided
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange,omitempty" bson:"value_ratio_range,omitempty"`                        // Data to be provided
-	ValueReference             *Reference             `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                           // Data to be provided
-	ValueSampledData           *SampledData           `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`                      // Data to be provided
-	ValueSignature             *Signature             `json:"valueSignature,omitempty" bson:"value_signature,omitempty"`                           // Data to be provided
-	ValueTiming                *Timing                `json:"valueTiming,omitempty" bson:"value_timing,omitempty"`                                 // Data to be provided
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail,omitempty" bson:"value_contact_detail,omitempty"`                  // Data to be provided
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement,omitempty" bson:"value_data_requirement,omitempty"`              // Data to be provided
-	ValueExpression            *Expression            `json:"valueExpression,omitempty" bson:"value_expression,omitempty"`                         // Data to be provided
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition,omitempty" bson:"value_parameter_definition,omitempty"`      // Data to be provided
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact,omitempty" bson:"value_related_artifact,omitempty"`              // Data to be provided
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition,omitempty" bson:"value_trigger_definition,omitempty"`          // Data to be provided
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext,omitempty" bson:"value_usage_context,omitempty"`                    // Data to be provided
-	ValueAvailability          *Availability          `json:"valueAvailability,omitempty" bson:"value_availability,omitempty"`                     // Data to be provided
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail,omitempty" bson:"value_extended_contact_detail,omitempty"` // Data to be provided
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail,omitempty" bson:"value_virtual_service_detail,omitempty"`   // Data to be provided
-	ValueDosage                *Dosage                `json:"valueDosage,omitempty" bson:"value_dosage,omitempty"`                                 // Data to be provided
-	ValueMeta                  *Meta                  `json:"valueMeta,omitempty" bson:"value_meta,omitempty"`                                     // Data to be provided
-	Reason                     *CodeableConcept       `json:"reason,omitempty" bson:"reason,omitempty"`                                            // Explanation for the information
-}
-
-func (r *ClaimSupportingInfo) Validate() error {
 	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
-	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
-	}
-	if r.SubCategory != nil {
-		if err := r.SubCategory.Validate(); err != nil {
-			return fmt.Errorf("SubCategory: %w", err)
-		}
-	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
-	if r.TimingPeriod != nil {
-		if err := r.TimingPeriod.Validate(); err != nil {
-			return fmt.Errorf("TimingPeriod: %w", err)
-		}
-	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
-		}
-	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
-	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
-	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
-	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
-	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
-	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
-	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
-		}
-	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
-		}
-	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
-		}
-	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
-		}
-	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
-		}
-	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
-		}
-	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
-		}
-	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
-	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
-	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
-	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
-	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
-		}
-	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
-		}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
-		}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
-		}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
 		}
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, claimItemDetailSubDetailUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, claimItemDetailSubDetailUdiTargets))
 		}
 	}
-	if r.Reason != nil {
-		if err := r.Reason.Validate(); err != nil {
-			return fmt.Errorf("Reason: %w", err)
-		}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ClaimDiagnosis struct {
-	Id                       *string           `json:"id,omitempty" bson:"id,omitempty"`                           // Unique id for inter-element referencing
-	Sequence                 int               `json:"sequence" bson:"sequence"`                                   // Diagnosis instance identifier
-	DiagnosisCodeableConcept *CodeableConcept  `json:"diagnosisCodeableConcept" bson:"diagnosis_codeable_concept"` // Nature of illness or problem
-	DiagnosisReference       *Reference        `json:"diagnosisReference" bson:"diagnosis_reference"`              // Nature of illness or problem
-	Type                     []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                       // Timing or nature of the diagnosis
-	OnAdmission              *CodeableConcept  `json:"onAdmission,omitempty" bson:"on_admission,omitempty"`        // Present on admission
+var claimItemDetailSubDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ClaimDiagnosis) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
-	if r.DiagnosisCodeableConcept == nil {
-		return fmt.Errorf("field 'DiagnosisCodeableConcept' is required")
-	}
-	if r.DiagnosisCodeableConcept != nil {
-		if err := r.DiagnosisCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DiagnosisCodeableConcept: %w", err)
-		}
-	}
-	if r.DiagnosisReference == nil {
-		return fmt.Errorf("field 'DiagnosisReference' is required")
-	}
-	if r.DiagnosisReference != nil {
-		if err := r.DiagnosisReference.Validate(); err != nil {
-			return fmt.Errorf("DiagnosisReference: %w", err)
-		}
-	}
-	for i, item := range r.Type {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
-		}
-	}
-	if r.OnAdmission != nil {
-		if err := r.OnAdmission.Validate(); err != nil {
-			return fmt.Errorf("OnAdmission: %w", err)
-		}
-	}
-	return nil
+func (r *ClaimItemDetailSubDetail) invariants() []invariant {
+	return claimItemDetailSubDetailInvariants
 }
 
-type ClaimProcedure struct {
-	Id                       *string           `json:"id,omitempty" bson:"id,omitempty"`                           // Unique id for inter-element referencing
-	Sequence                 int               `json:"sequence" bson:"sequence"`                                   // Procedure instance identifier
-	Type                     []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                       // Category of Procedure
-	Date                     *string           `json:"date,omitempty" bson:"date,omitempty"`                       // When the procedure was performed
-	ProcedureCodeableConcept *CodeableConcept  `json:"procedureCodeableConcept" bson:"procedure_codeable_concept"` // Specific clinical procedure
-	ProcedureReference       *Reference        `json:"procedureReference" bson:"procedure_reference"`              // Specific clinical procedure
-	Udi                      []Reference       `json:"udi,omitempty" bson:"udi,omitempty"`                         // Unique device identifier
+func (r ClaimItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ClaimProcedure) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
-	for i, item := range r.Type {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
-		}
-	}
-	if r.ProcedureCodeableConcept == nil {
-		return fmt.Errorf("field 'ProcedureCodeableConcept' is required")
-	}
-	if r.ProcedureCodeableConcept != nil {
-		if err := r.ProcedureCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ProcedureCodeableConcept: %w", err)
-		}
-	}
-	if r.ProcedureReference == nil {
-		return fmt.Errorf("field 'ProcedureReference' is required")
-	}
-	if r.ProcedureReference != nil {
-		if err := r.ProcedureReference.Validate(); err != nil {
-			return fmt.Errorf("ProcedureReference: %w", err)
-		}
-	}
-	for i, item := range r.Udi {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Udi[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *ClaimItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type ClaimInsurance struct {
-	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Sequence            int         `json:"sequence" bson:"sequence"`                                            // Insurance instance identifier
-	Focal               bool        `json:"focal" bson:"focal"`                                                  // Coverage to be used for adjudication
-	Identifier          *Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Pre-assigned Claim number
-	Coverage            *Reference  `json:"coverage" bson:"coverage"`                                            // Insurance information
-	BusinessArrangement *string     `json:"businessArrangement,omitempty" bson:"business_arrangement,omitempty"` // Additional provider contract number
-	PreAuthRef          []string    `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`                  // Prior authorization reference number
-	ClaimResponse       *Reference  `json:"claimResponse,omitempty" bson:"claim_response,omitempty"`             // Adjudication results
+func (r ClaimItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *ClaimInsurance) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
-	if r.Identifier != nil {
-		if err := r.Identifier.Validate(); err != nil {
-			return fmt.Errorf("Identifier: %w", err)
-		}
-	}
-	if r.Coverage == nil {
-		return fmt.Errorf("field 'Coverage' is required")
-	}
-	if r.Coverage != nil {
-		if err := r.Coverage.Validate(); err != nil {
-			return fmt.Errorf("Coverage: %w", err)
-		}
-	}
-	if r.ClaimResponse != nil {
-		if err := r.ClaimResponse.Validate(); err != nil {
-			return fmt.Errorf("ClaimResponse: %w", err)
-		}
-	}
-	return nil
+func (r *ClaimItemDetailSubDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimItemDetailSubDetailElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimItemDetailSubDetail) elementTypes() map[string]string {
+	return claimItemDetailSubDetailElementTypes
+}
+
+var claimItemDetailSubDetailElementMetadata = []ElementMetadata{
+	{Path: "Claim.item.detail.subDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Claim.item.detail.subDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Claim.item.detail.subDetail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Claim.item.detail.subDetail.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Claim.item.detail.subDetail.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Claim.item.detail.subDetail.revenue", Name: "revenue", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.subDetail.category", Name: "category", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.subDetail.productOrService", Name: "productOrService", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.subDetail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.subDetail.modifier", Name: "modifier", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.subDetail.programCode", Name: "programCode", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Claim.item.detail.subDetail.patientPaid", Name: "patientPaid", Field: 12, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.subDetail.quantity", Name: "quantity", Field: 13, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Claim.item.detail.subDetail.unitPrice", Name: "unitPrice", Field: 14, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.subDetail.factor", Name: "factor", Field: 15, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Claim.item.detail.subDetail.tax", Name: "tax", Field: 17, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.subDetail.net", Name: "net", Field: 18, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Claim.item.detail.subDetail.udi", Name: "udi", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+}
+
+func (r *ClaimItemDetailSubDetail) ElementMetadata() []ElementMetadata {
+	return claimItemDetailSubDetailElementMetadata
 }
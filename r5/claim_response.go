@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,25 +12,36 @@ type ClaimResponse struct {
 	Id                    *string                         `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
 	Meta                  *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
 	ImplicitRules         *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
 	Language              *string                         `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement       *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
 	Text                  *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage               `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Contained             []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension             []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension     []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
 	Identifier            []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Business Identifier for a claim response
 	TraceNumber           []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                      // Number for tracking
-	Status                string                          `json:"status" bson:"status"`                                                     // active | cancelled | draft | entered-in-error
+	Status                ExplanationOfBenefitStatus      `json:"status" bson:"status"`                                                     // active | cancelled | draft | entered-in-error
+	StatusElement         *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
 	StatusReason          *string                         `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                    // Reason for status change
+	StatusReasonElement   *Element                        `json:"_statusReason,omitempty" bson:"status_reason_element,omitempty"`           // Extensions for statusReason
 	Type                  *CodeableConcept                `json:"type" bson:"type"`                                                         // More granular claim type
 	SubType               *CodeableConcept                `json:"subType,omitempty" bson:"sub_type,omitempty"`                              // More granular claim type
-	Use                   string                          `json:"use" bson:"use"`                                                           // claim | preauthorization | predetermination
+	Use                   Use                             `json:"use" bson:"use"`                                                           // claim | preauthorization | predetermination
+	UseElement            *Element                        `json:"_use,omitempty" bson:"use_element,omitempty"`                              // Extensions for use
 	Subject               *Reference                      `json:"subject" bson:"subject"`                                                   // The recipient(s) of the products and services
-	Created               string                          `json:"created" bson:"created"`                                                   // Response creation date
+	Created               *DateTime                       `json:"created" bson:"created"`                                                   // Response creation date
+	CreatedElement        *Element                        `json:"_created,omitempty" bson:"created_element,omitempty"`                      // Extensions for created
 	Insurer               *Reference                      `json:"insurer,omitempty" bson:"insurer,omitempty"`                               // Party responsible for reimbursement
 	Requestor             *Reference                      `json:"requestor,omitempty" bson:"requestor,omitempty"`                           // Party responsible for the claim
 	Request               *Reference                      `json:"request,omitempty" bson:"request,omitempty"`                               // Id of resource triggering adjudication
-	Outcome               string                          `json:"outcome" bson:"outcome"`                                                   // queued | complete | error | partial
+	Outcome               ClaimProcessingCodes            `json:"outcome" bson:"outcome"`                                                   // queued | complete | error | partial
+	OutcomeElement        *Element                        `json:"_outcome,omitempty" bson:"outcome_element,omitempty"`                      // Extensions for outcome
 	Decision              *CodeableConcept                `json:"decision,omitempty" bson:"decision,omitempty"`                             // Result of the adjudication
 	Disposition           *string                         `json:"disposition,omitempty" bson:"disposition,omitempty"`                       // Disposition Message
+	DispositionElement    *Element                        `json:"_disposition,omitempty" bson:"disposition_element,omitempty"`              // Extensions for disposition
 	PreAuthRef            *string                         `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`                       // Preauthorization reference
+	PreAuthRefElement     *Element                        `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`              // Extensions for preAuthRef
 	PreAuthPeriod         *Period                         `json:"preAuthPeriod,omitempty" bson:"pre_auth_period,omitempty"`                 // Preauthorization reference effective period
 	Event                 []ClaimResponseEvent            `json:"event,omitempty" bson:"event,omitempty"`                                   // Event information
 	PayeeType             *CodeableConcept                `json:"payeeType,omitempty" bson:"payee_type,omitempty"`                          // Party to be paid any benefits payable
@@ -50,6 +62,14 @@ type ClaimResponse struct {
 	Error                 []ClaimResponseError            `json:"error,omitempty" bson:"error,omitempty"`                                   // Processing errors
 }
 
+var claimResponseSubjectTargets = []string{"Group", "Patient"}
+
+var claimResponseInsurerTargets = []string{"Organization"}
+
+var claimResponseRequestorTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var claimResponseRequestTargets = []string{"Claim"}
+
 func (r *ClaimResponse) Validate() error {
 	if r.ResourceType != "ClaimResponse" {
 		return fmt.Errorf("invalid resourceType: expected 'ClaimResponse', got '%s'", r.ResourceType)
@@ -59,11 +79,39 @@ func (r *ClaimResponse) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -74,10 +122,22 @@ func (r *ClaimResponse) Validate() error {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.StatusReasonElement != nil {
+		if err := r.StatusReasonElement.Validate(); err != nil {
+			return fmt.Errorf("StatusReasonElement: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -91,43 +151,99 @@ func (r *ClaimResponse) Validate() error {
 			return fmt.Errorf("SubType: %w", err)
 		}
 	}
-	if r.Use == emptyString {
+	if r.Use == "" {
 		return fmt.Errorf("field 'Use' is required")
 	}
+	if !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimResponseSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, claimResponseSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
-	if r.Created == emptyString {
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Insurer != nil {
+		if target := invalidReferenceTarget(r.Insurer.Reference, r.Insurer.Type, claimResponseInsurerTargets); target != "" {
+			return fmt.Errorf("field 'Insurer' cannot refer to %s, expected one of %v", target, claimResponseInsurerTargets)
+		}
+	}
 	if r.Insurer != nil {
 		if err := r.Insurer.Validate(); err != nil {
 			return fmt.Errorf("Insurer: %w", err)
 		}
 	}
+	if r.Requestor != nil {
+		if target := invalidReferenceTarget(r.Requestor.Reference, r.Requestor.Type, claimResponseRequestorTargets); target != "" {
+			return fmt.Errorf("field 'Requestor' cannot refer to %s, expected one of %v", target, claimResponseRequestorTargets)
+		}
+	}
 	if r.Requestor != nil {
 		if err := r.Requestor.Validate(); err != nil {
 			return fmt.Errorf("Requestor: %w", err)
 		}
 	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, claimResponseRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request' cannot refer to %s, expected one of %v", target, claimResponseRequestTargets)
+		}
+	}
 	if r.Request != nil {
 		if err := r.Request.Validate(); err != nil {
 			return fmt.Errorf("Request: %w", err)
 		}
 	}
-	if r.Outcome == emptyString {
+	if r.Outcome == "" {
 		return fmt.Errorf("field 'Outcome' is required")
 	}
+	if !r.Outcome.IsValid() {
+		return fmt.Errorf("field 'Outcome' has invalid code '%s'", r.Outcome)
+	}
+	if r.OutcomeElement != nil {
+		if err := r.OutcomeElement.Validate(); err != nil {
+			return fmt.Errorf("OutcomeElement: %w", err)
+		}
+	}
 	if r.Decision != nil {
 		if err := r.Decision.Validate(); err != nil {
 			return fmt.Errorf("Decision: %w", err)
 		}
 	}
+	if r.DispositionElement != nil {
+		if err := r.DispositionElement.Validate(); err != nil {
+			return fmt.Errorf("DispositionElement: %w", err)
+		}
+	}
+	if r.PreAuthRefElement != nil {
+		if err := r.PreAuthRefElement.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement: %w", err)
+		}
+	}
 	if r.PreAuthPeriod != nil {
 		if err := r.PreAuthPeriod.Validate(); err != nil {
 			return fmt.Errorf("PreAuthPeriod: %w", err)
@@ -218,323 +334,3440 @@ func (r *ClaimResponse) Validate() error {
 			return fmt.Errorf("Error[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ClaimResponseProcessNote struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Class    *CodeableConcept `json:"class,omitempty" bson:"class,omitempty"`       // Business kind of note
-	Number   *int             `json:"number,omitempty" bson:"number,omitempty"`     // Note instance identifier
-	Type     *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`         // Note purpose
-	Text     string           `json:"text" bson:"text"`                             // Note explanatory text
-	Language *CodeableConcept `json:"language,omitempty" bson:"language,omitempty"` // Language of the text
+	return validateInvariants(r, "ClaimResponse")
 }
 
-func (r *ClaimResponseProcessNote) Validate() error {
-	if r.Class != nil {
-		if err := r.Class.Validate(); err != nil {
-			return fmt.Errorf("Class: %w", err)
-		}
+func (r *ClaimResponse) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ClaimResponse" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ClaimResponse', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	var emptyString string
-	if r.Text == emptyString {
-		return fmt.Errorf("field 'Text' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.Language != nil {
-		if err := r.Language.Validate(); err != nil {
-			return fmt.Errorf("Language: %w", err)
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type ClaimResponseInsurance struct {
-	Id                  *string    `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Sequence            int        `json:"sequence" bson:"sequence"`                                            // Insurance instance identifier
-	Focal               bool       `json:"focal" bson:"focal"`                                                  // Coverage to be used for adjudication
-	Coverage            *Reference `json:"coverage" bson:"coverage"`                                            // Insurance information
-	BusinessArrangement *string    `json:"businessArrangement,omitempty" bson:"business_arrangement,omitempty"` // Additional provider contract number
-	ClaimResponse       *Reference `json:"claimResponse,omitempty" bson:"claim_response,omitempty"`             // Adjudication results
-}
-
-func (r *ClaimResponseInsurance) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Coverage == nil {
-		return fmt.Errorf("field 'Coverage' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Coverage != nil {
-		if err := r.Coverage.Validate(); err != nil {
-			return fmt.Errorf("Coverage: %w", err)
-		}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.ClaimResponse != nil {
-		if err := r.ClaimResponse.Validate(); err != nil {
-			return fmt.Errorf("ClaimResponse: %w", err)
-		}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ClaimResponseEvent struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Type         *CodeableConcept `json:"type" bson:"type"`                   // Specific event
-	WhenDateTime *string          `json:"whenDateTime" bson:"when_date_time"` // Occurance date or period
-	WhenPeriod   *Period          `json:"whenPeriod" bson:"when_period"`      // Occurance date or period
-}
-
-func (r *ClaimResponseEvent) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	if r.WhenDateTime == nil {
-		return fmt.Errorf("field 'WhenDateTime' is required")
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.WhenPeriod == nil {
-		return fmt.Errorf("field 'WhenPeriod' is required")
+	if r.StatusReasonElement != nil {
+		r.StatusReasonElement.validateAll(path+".statusReason", issues)
 	}
-	if r.WhenPeriod != nil {
-		if err := r.WhenPeriod.Validate(); err != nil {
-			return fmt.Errorf("WhenPeriod: %w", err)
-		}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	return nil
-}
-
-type ClaimResponseItem struct {
-	Id                  *string                         `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	ItemSequence        int                             `json:"itemSequence" bson:"item_sequence"`                                   // Claim item instance identifier
-	TraceNumber         []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                 // Number for tracking
-	InformationSequence []int                           `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"` // Applicable exception and supporting information
-	NoteNumber          []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                   // Applicable note numbers
-	ReviewOutcome       *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`             // Adjudication results
-	Adjudication        []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                // Adjudication details
-	Detail              []ClaimResponseItemDetail       `json:"detail,omitempty" bson:"detail,omitempty"`                            // Adjudication for claim details
-}
-
-func (r *ClaimResponseItem) Validate() error {
-	if r.ItemSequence == 0 {
-		return fmt.Errorf("field 'ItemSequence' is required")
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
-		}
+	if r.SubType != nil {
+		r.SubType.validateAll(path+".subType", issues)
 	}
-	if r.ReviewOutcome != nil {
-		if err := r.ReviewOutcome.Validate(); err != nil {
-			return fmt.Errorf("ReviewOutcome: %w", err)
-		}
+	if r.Use == "" {
+		issues.add("required", path+".use", "field 'Use' is required")
 	}
-	for i, item := range r.Adjudication {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Adjudication[%d]: %w", i, err)
-		}
+	if r.Use != "" && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", r.Use))
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
-		}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
 	}
-	return nil
-}
-
-type ClaimResponseItemAdjudication struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Category     *CodeableConcept `json:"category" bson:"category"`                              // Type of adjudication information
-	Reason       *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`              // Explanation of adjudication outcome
-	Amount       *Money           `json:"amount,omitempty" bson:"amount,omitempty"`              // Monetary amount
-	Quantity     *Quantity        `json:"quantity,omitempty" bson:"quantity,omitempty"`          // Non-monetary value
-	DecisionDate *string          `json:"decisionDate,omitempty" bson:"decision_date,omitempty"` // When was adjudication performed
-}
-
-func (r *ClaimResponseItemAdjudication) Validate() error {
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimResponseSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, claimResponseSubjectTargets))
 		}
 	}
-	if r.Reason != nil {
-		if err := r.Reason.Validate(); err != nil {
-			return fmt.Errorf("Reason: %w", err)
-		}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
 	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
 		}
 	}
-	return nil
-}
-
-type ClaimResponseAddItem struct {
-	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	ItemSequence            []int                           `json:"itemSequence,omitempty" bson:"item_sequence,omitempty"`                        // Item sequence number
-	DetailSequence          []int                           `json:"detailSequence,omitempty" bson:"detail_sequence,omitempty"`                    // Detail sequence number
-	SubdetailSequence       []int                           `json:"subdetailSequence,omitempty" bson:"subdetail_sequence,omitempty"`              // Subdetail sequence number
-	TraceNumber             []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
-	Subject                 *Reference                      `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
-	InformationSequence     []int                           `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
-	Provider                []Reference                     `json:"provider,omitempty" bson:"provider,omitempty"`                                 // Authorized providers
-	Revenue                 *CodeableConcept                `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
-	Category                *CodeableConcept                `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
-	ProductOrService        *CodeableConcept                `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
-	ProductOrServiceEnd     *CodeableConcept                `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
-	Request                 []Reference                     `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
-	Modifier                []CodeableConcept               `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Service/Product billing modifiers
-	ProgramCode             []CodeableConcept               `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
-	ServicedDate            *string                         `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`                        // Date or dates of service or product delivery
-	ServicedPeriod          *Period                         `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"`                    // Date or dates of service or product delivery
-	LocationCodeableConcept *CodeableConcept                `json:"locationCodeableConcept,omitempty" bson:"location_codeable_concept,omitempty"` // Place of service or where product was supplied
-	LocationAddress         *Address                        `json:"locationAddress,omitempty" bson:"location_address,omitempty"`                  // Place of service or where product was supplied
-	LocationReference       *Reference                      `json:"locationReference,omitempty" bson:"location_reference,omitempty"`              // Place of service or where product was supplied
-	Quantity                *Quantity                       `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
-	UnitPrice               *Money                          `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
-	Factor                  *float64                        `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
-	Tax                     *Money                          `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
-	Net                     *Money                          `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
-	BodySite                []ClaimResponseAddItemBodySite  `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
-	NoteNumber              []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
-	ReviewOutcome           *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Added items adjudication results
-	Adjudication            []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Added items adjudication
-	Detail                  []ClaimResponseAddItemDetail    `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Insurer added line details
-}
-
-func (r *ClaimResponseAddItem) Validate() error {
-	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
-		}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
 	}
-	if r.Subject != nil {
-		if err := r.Subject.Validate(); err != nil {
-			return fmt.Errorf("Subject: %w", err)
+	if r.Insurer != nil {
+		if target := invalidReferenceTarget(r.Insurer.Reference, r.Insurer.Type, claimResponseInsurerTargets); target != "" {
+			issues.add("value", path+".insurer", fmt.Sprintf("field 'Insurer' cannot refer to %s, expected one of %v", target, claimResponseInsurerTargets))
 		}
 	}
-	for i, item := range r.Provider {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Provider[%d]: %w", i, err)
-		}
+	if r.Insurer != nil {
+		r.Insurer.validateAll(path+".insurer", issues)
 	}
-	if r.Revenue != nil {
-		if err := r.Revenue.Validate(); err != nil {
-			return fmt.Errorf("Revenue: %w", err)
+	if r.Requestor != nil {
+		if target := invalidReferenceTarget(r.Requestor.Reference, r.Requestor.Type, claimResponseRequestorTargets); target != "" {
+			issues.add("value", path+".requestor", fmt.Sprintf("field 'Requestor' cannot refer to %s, expected one of %v", target, claimResponseRequestorTargets))
 		}
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	if r.Requestor != nil {
+		r.Requestor.validateAll(path+".requestor", issues)
 	}
-	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, claimResponseRequestTargets); target != "" {
+			issues.add("value", path+".request", fmt.Sprintf("field 'Request' cannot refer to %s, expected one of %v", target, claimResponseRequestTargets))
 		}
 	}
-	if r.ProductOrServiceEnd != nil {
-		if err := r.ProductOrServiceEnd.Validate(); err != nil {
-			return fmt.Errorf("ProductOrServiceEnd: %w", err)
-		}
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
 	}
-	for i, item := range r.Request {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Request[%d]: %w", i, err)
-		}
+	if r.Outcome == "" {
+		issues.add("required", path+".outcome", "field 'Outcome' is required")
 	}
-	for i, item := range r.Modifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
-		}
+	if r.Outcome != "" && !r.Outcome.IsValid() {
+		issues.add("code-invalid", path+".outcome", fmt.Sprintf("field 'Outcome' has invalid code '%s'", r.Outcome))
 	}
-	for i, item := range r.ProgramCode {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
-		}
+	if r.OutcomeElement != nil {
+		r.OutcomeElement.validateAll(path+".outcome", issues)
 	}
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
-		}
+	if r.Decision != nil {
+		r.Decision.validateAll(path+".decision", issues)
 	}
-	if r.LocationCodeableConcept != nil {
-		if err := r.LocationCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("LocationCodeableConcept: %w", err)
-		}
+	if r.DispositionElement != nil {
+		r.DispositionElement.validateAll(path+".disposition", issues)
 	}
-	if r.LocationAddress != nil {
-		if err := r.LocationAddress.Validate(); err != nil {
-			return fmt.Errorf("LocationAddress: %w", err)
-		}
+	if r.PreAuthRefElement != nil {
+		r.PreAuthRefElement.validateAll(path+".preAuthRef", issues)
 	}
-	if r.LocationReference != nil {
-		if err := r.LocationReference.Validate(); err != nil {
-			return fmt.Errorf("LocationReference: %w", err)
-		}
+	if r.PreAuthPeriod != nil {
+		r.PreAuthPeriod.validateAll(path+".preAuthPeriod", issues)
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
 	}
-	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
-		}
+	if r.PayeeType != nil {
+		r.PayeeType.validateAll(path+".payeeType", issues)
 	}
-	if r.Tax != nil {
-		if err := r.Tax.Validate(); err != nil {
-			return fmt.Errorf("Tax: %w", err)
-		}
+	for i, item := range r.Encounter {
+		item.validateAll(fmt.Sprintf("%s.encounter[%d]", path, i), issues)
 	}
-	if r.Net != nil {
-		if err := r.Net.Validate(); err != nil {
-			return fmt.Errorf("Net: %w", err)
-		}
+	if r.DiagnosisRelatedGroup != nil {
+		r.DiagnosisRelatedGroup.validateAll(path+".diagnosisRelatedGroup", issues)
 	}
-	for i, item := range r.BodySite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("BodySite[%d]: %w", i, err)
-		}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
 	}
-	if r.ReviewOutcome != nil {
-		if err := r.ReviewOutcome.Validate(); err != nil {
-			return fmt.Errorf("ReviewOutcome: %w", err)
-		}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
+	}
+	for i, item := range r.AddItem {
+		item.validateAll(fmt.Sprintf("%s.addItem[%d]", path, i), issues)
 	}
 	for i, item := range r.Adjudication {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Adjudication[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
+	for i, item := range r.Total {
+		item.validateAll(fmt.Sprintf("%s.total[%d]", path, i), issues)
+	}
+	if r.Payment != nil {
+		r.Payment.validateAll(path+".payment", issues)
+	}
+	if r.FundsReserve != nil {
+		r.FundsReserve.validateAll(path+".fundsReserve", issues)
+	}
+	if r.FormCode != nil {
+		r.FormCode.validateAll(path+".formCode", issues)
+	}
+	if r.Form != nil {
+		r.Form.validateAll(path+".form", issues)
+	}
+	for i, item := range r.ProcessNote {
+		item.validateAll(fmt.Sprintf("%s.processNote[%d]", path, i), issues)
+	}
+	for i, item := range r.CommunicationRequest {
+		item.validateAll(fmt.Sprintf("%s.communicationRequest[%d]", path, i), issues)
+	}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	for i, item := range r.Error {
+		item.validateAll(fmt.Sprintf("%s.error[%d]", path, i), issues)
+	}
+}
+
+func (r *ClaimResponse) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ClaimResponse", &issues)
+	checkInvariants(r, "ClaimResponse", &issues)
+	return issues
+}
+
+var claimResponseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ClaimResponse) invariants() []invariant {
+	return claimResponseInvariants
+}
+
+func (r *ClaimResponse) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponse
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	return nil
+}
+
+func (r ClaimResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *ClaimResponse) elementTypes() map[string]string {
+	return claimResponseElementTypes
+}
+
+var claimResponseElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ClaimResponse.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ClaimResponse.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ClaimResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ClaimResponse.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ClaimResponse.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ClaimResponse.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.traceNumber", Name: "traceNumber", Field: 12, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ClaimResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "ClaimResponse.statusReason", Name: "statusReason", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.type", Name: "type", Field: 17, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.subType", Name: "subType", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.use", Name: "use", Field: 19, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ClaimResponse.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-use"}},
+	{Path: "ClaimResponse.subject", Name: "subject", Field: 21, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ClaimResponse.created", Name: "created", Field: 22, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ClaimResponse.insurer", Name: "insurer", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "ClaimResponse.requestor", Name: "requestor", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "ClaimResponse.request", Name: "request", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Claim"}},
+	{Path: "ClaimResponse.outcome", Name: "outcome", Field: 27, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ClaimResponse.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-outcome"}},
+	{Path: "ClaimResponse.decision", Name: "decision", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.disposition", Name: "disposition", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.preAuthRef", Name: "preAuthRef", Field: 32, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.preAuthPeriod", Name: "preAuthPeriod", Field: 34, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ClaimResponse.event", Name: "event", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.payeeType", Name: "payeeType", Field: 36, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.encounter", Name: "encounter", Field: 37, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ClaimResponse.diagnosisRelatedGroup", Name: "diagnosisRelatedGroup", Field: 38, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.supportingInfo", Name: "supportingInfo", Field: 39, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.item", Name: "item", Field: 40, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem", Name: "addItem", Field: 41, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.adjudication", Name: "adjudication", Field: 42, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.total", Name: "total", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.payment", Name: "payment", Field: 44, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.fundsReserve", Name: "fundsReserve", Field: 45, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.formCode", Name: "formCode", Field: 46, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.form", Name: "form", Field: 47, Min: 0, Max: "1", Types: []string{"Attachment"}},
+	{Path: "ClaimResponse.processNote", Name: "processNote", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.communicationRequest", Name: "communicationRequest", Field: 49, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ClaimResponse.insurance", Name: "insurance", Field: 50, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.error", Name: "error", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponse) ElementMetadata() []ElementMetadata {
+	return claimResponseElementMetadata
+}
+
+func (r *ClaimResponse) GetResourceType() string {
+	return "ClaimResponse"
+}
+
+func (r *ClaimResponse) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ClaimResponse) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ClaimResponse) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ClaimResponse) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ClaimResponse) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ClaimResponse) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ClaimResponse) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ClaimResponse)(nil)
+
+type ClaimResponseEvent struct {
+	Id                *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept       `json:"type" bson:"type"`                                                // Specific event
+	When              ClaimResponseEventWhen `json:"-" bson:"when,omitempty"`                                         // Occurance date or period
+	WhenElement       *Element               `json:"-" bson:"when_element,omitempty"`                                 // Extensions for when[x]
+
+	whenVariants []string // JSON properties of ClaimResponse.event.when[x] when more than one was decoded
+}
+
+func (r *ClaimResponseEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.whenVariants) > 1 {
+		return fmt.Errorf("field 'When' must have a single type, got %v", r.whenVariants)
+	}
+	if r.When == nil {
+		return fmt.Errorf("field 'When' is required")
+	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
+		}
+	}
+	if r.WhenElement != nil {
+		if err := r.WhenElement.Validate(); err != nil {
+			return fmt.Errorf("WhenElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.whenVariants) > 1 {
+		issues.add("structure", path+".when", fmt.Sprintf("field 'When' must have a single type, got %v", r.whenVariants))
+	}
+	if r.When == nil {
+		issues.add("required", path+".when", "field 'When' is required")
+	}
+	if r.When != nil {
+		r.When.validateAll(path+".when.ofType("+r.When.FHIRType()+")", issues)
+	}
+	if r.WhenElement != nil {
+		r.WhenElement.validateAll(path+".when", issues)
+	}
+}
+
+var claimResponseEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseEvent) invariants() []invariant {
+	return claimResponseEventInvariants
+}
+
+func (r ClaimResponseEvent) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "when", r.When, r.WhenElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimResponseEvent) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseEvent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.When, element, r.whenVariants, err = unmarshalChoice(fields, "when", claimResponseEventWhenVariants); err != nil {
+		return err
+	}
+	r.WhenElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.WhenElement); err != nil {
+			return fmt.Errorf("_when: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ClaimResponseEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimResponseEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "When":
+		return "when", choiceValues(claimResponseEventWhenVariants)
+	}
+	return "", nil
+}
+
+var claimResponseEventElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.event.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.event.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.event.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.event.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.event.when[x]", Name: "when", Field: 4, Min: 1, Max: "1", Types: []string{"dateTime", "Period"}},
+}
+
+func (r *ClaimResponseEvent) ElementMetadata() []ElementMetadata {
+	return claimResponseEventElementMetadata
+}
+
+// ClaimResponseEventWhen is the type of ClaimResponse.event.when[x]. It is implemented by the ClaimResponseEventWhen* variant types.
+type ClaimResponseEventWhen interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimResponseEventWhen()
+}
+
+// ClaimResponseEventWhenDateTime is the dateTime variant of ClaimResponseEventWhen.
+type ClaimResponseEventWhenDateTime struct {
+	DateTime
+}
+
+func (v ClaimResponseEventWhenDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ClaimResponseEventWhenDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseEventWhenDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ClaimResponseEventWhenDateTime) isClaimResponseEventWhen() {}
+
+// ClaimResponseEventWhenPeriod is the Period variant of ClaimResponseEventWhen.
+type ClaimResponseEventWhenPeriod struct {
+	Period
+}
+
+func (v ClaimResponseEventWhenPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimResponseEventWhenPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimResponseEventWhenPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimResponseEventWhenPeriod) isClaimResponseEventWhen() {}
+
+var claimResponseEventWhenVariants = []ClaimResponseEventWhen{
+	ClaimResponseEventWhenDateTime{},
+	ClaimResponseEventWhenPeriod{},
+}
+
+type ClaimResponseSupportingInfo struct {
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                               `json:"sequence" bson:"sequence"`                                        // Information instance identifier
+	SequenceElement   *Element                          `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Category          *CodeableConcept                  `json:"category" bson:"category"`                                        // Classification of the supplied information
+	Code              *CodeableConcept                  `json:"code,omitempty" bson:"code,omitempty"`                            // Type of information
+	Timing            ClaimResponseSupportingInfoTiming `json:"-" bson:"timing,omitempty"`                                       // When it occurred
+	TimingElement     *Element                          `json:"-" bson:"timing_element,omitempty"`                               // Extensions for timing[x]
+	Value             ClaimResponseSupportingInfoValue  `json:"-" bson:"value,omitempty"`                                        // Data to be provided
+	ValueElement      *Element                          `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Reason            *CodeableConcept                  `json:"reason,omitempty" bson:"reason,omitempty"`                        // Explanation for the information
+
+	timingVariants []string // JSON properties of ClaimResponse.supportingInfo.timing[x] when more than one was decoded
+	valueVariants  []string // JSON properties of ClaimResponse.supportingInfo.value[x] when more than one was decoded
+}
+
+func (r *ClaimResponseSupportingInfo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
+	}
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
+		}
+	}
+	if r.TimingElement != nil {
+		if err := r.TimingElement.Validate(); err != nil {
+			return fmt.Errorf("TimingElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.Reason != nil {
+		if err := r.Reason.Validate(); err != nil {
+			return fmt.Errorf("Reason: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseSupportingInfo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if r.TimingElement != nil {
+		r.TimingElement.validateAll(path+".timing", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+}
+
+var claimResponseSupportingInfoInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseSupportingInfo) invariants() []invariant {
+	return claimResponseSupportingInfoInvariants
+}
+
+func (r ClaimResponseSupportingInfo) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseSupportingInfo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, r.TimingElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimResponseSupportingInfo) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseSupportingInfo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Timing, element, r.timingVariants, err = unmarshalChoice(fields, "timing", claimResponseSupportingInfoTimingVariants); err != nil {
+		return err
+	}
+	r.TimingElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TimingElement); err != nil {
+			return fmt.Errorf("_timing: %w", err)
+		}
+	}
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", claimResponseSupportingInfoValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ClaimResponseSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseSupportingInfo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimResponseSupportingInfo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Timing":
+		return "timing", choiceValues(claimResponseSupportingInfoTimingVariants)
+	case "Value":
+		return "value", choiceValues(claimResponseSupportingInfoValueVariants)
+	}
+	return "", nil
+}
+
+var claimResponseSupportingInfoElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimResponseSupportingInfo) elementTypes() map[string]string {
+	return claimResponseSupportingInfoElementTypes
+}
+
+var claimResponseSupportingInfoElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.supportingInfo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.supportingInfo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.supportingInfo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.supportingInfo.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.supportingInfo.category", Name: "category", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.supportingInfo.code", Name: "code", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.supportingInfo.timing[x]", Name: "timing", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "ClaimResponse.supportingInfo.value[x]", Name: "value", Field: 9, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}},
+	{Path: "ClaimResponse.supportingInfo.reason", Name: "reason", Field: 11, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimResponseSupportingInfo) ElementMetadata() []ElementMetadata {
+	return claimResponseSupportingInfoElementMetadata
+}
+
+// ClaimResponseSupportingInfoTiming is the type of ClaimResponse.supportingInfo.timing[x]. It is implemented by the ClaimResponseSupportingInfoTiming* variant types.
+type ClaimResponseSupportingInfoTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimResponseSupportingInfoTiming()
+}
+
+// ClaimResponseSupportingInfoTimingDateTime is the dateTime variant of ClaimResponseSupportingInfoTiming.
+type ClaimResponseSupportingInfoTimingDateTime struct {
+	DateTime
+}
+
+func (v ClaimResponseSupportingInfoTimingDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ClaimResponseSupportingInfoTimingDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseSupportingInfoTimingDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ClaimResponseSupportingInfoTimingDateTime) isClaimResponseSupportingInfoTiming() {}
+
+// ClaimResponseSupportingInfoTimingPeriod is the Period variant of ClaimResponseSupportingInfoTiming.
+type ClaimResponseSupportingInfoTimingPeriod struct {
+	Period
+}
+
+func (v ClaimResponseSupportingInfoTimingPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimResponseSupportingInfoTimingPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoTimingPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimResponseSupportingInfoTimingPeriod) isClaimResponseSupportingInfoTiming() {}
+
+// ClaimResponseSupportingInfoTimingTiming is the Timing variant of ClaimResponseSupportingInfoTiming.
+type ClaimResponseSupportingInfoTimingTiming struct {
+	Timing
+}
+
+func (v ClaimResponseSupportingInfoTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ClaimResponseSupportingInfoTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ClaimResponseSupportingInfoTimingTiming) isClaimResponseSupportingInfoTiming() {}
+
+var claimResponseSupportingInfoTimingVariants = []ClaimResponseSupportingInfoTiming{
+	ClaimResponseSupportingInfoTimingDateTime{},
+	ClaimResponseSupportingInfoTimingPeriod{},
+	ClaimResponseSupportingInfoTimingTiming{},
+}
+
+// ClaimResponseSupportingInfoValue is the type of ClaimResponse.supportingInfo.value[x]. It is implemented by the ClaimResponseSupportingInfoValue* variant types.
+type ClaimResponseSupportingInfoValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimResponseSupportingInfoValue()
+}
+
+// ClaimResponseSupportingInfoValueBase64Binary is the base64Binary variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueBase64Binary string
+
+func (v ClaimResponseSupportingInfoValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueBase64Binary) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClaimResponseSupportingInfoValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ClaimResponseSupportingInfoValueBase64Binary) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueBoolean is the boolean variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueBoolean bool
+
+func (v ClaimResponseSupportingInfoValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ClaimResponseSupportingInfoValueBoolean) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueCanonical is the canonical variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueCanonical string
+
+func (v ClaimResponseSupportingInfoValueCanonical) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClaimResponseSupportingInfoValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ClaimResponseSupportingInfoValueCanonical) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueCode is the code variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueCode string
+
+func (v ClaimResponseSupportingInfoValueCode) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ClaimResponseSupportingInfoValueCode) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDate is the date variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDate struct {
+	Date
+}
+
+func (v ClaimResponseSupportingInfoValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseSupportingInfoValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ClaimResponseSupportingInfoValueDate) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDateTime is the dateTime variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDateTime struct {
+	DateTime
+}
+
+func (v ClaimResponseSupportingInfoValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseSupportingInfoValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ClaimResponseSupportingInfoValueDateTime) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDecimal is the decimal variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDecimal struct {
+	Decimal
+}
+
+func (v ClaimResponseSupportingInfoValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseSupportingInfoValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ClaimResponseSupportingInfoValueDecimal) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueId is the id variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueId string
+
+func (v ClaimResponseSupportingInfoValueId) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ClaimResponseSupportingInfoValueId) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueInstant is the instant variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueInstant struct {
+	Instant
+}
+
+func (v ClaimResponseSupportingInfoValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseSupportingInfoValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ClaimResponseSupportingInfoValueInstant) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueInteger is the integer variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueInteger int
+
+func (v ClaimResponseSupportingInfoValueInteger) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ClaimResponseSupportingInfoValueInteger) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueInteger64 is the integer64 variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueInteger64 int64
+
+func (v ClaimResponseSupportingInfoValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueInteger64) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClaimResponseSupportingInfoValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ClaimResponseSupportingInfoValueInteger64) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueMarkdown is the markdown variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueMarkdown string
+
+func (v ClaimResponseSupportingInfoValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClaimResponseSupportingInfoValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ClaimResponseSupportingInfoValueMarkdown) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueOid is the oid variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueOid string
+
+func (v ClaimResponseSupportingInfoValueOid) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ClaimResponseSupportingInfoValueOid) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValuePositiveInt is the positiveInt variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValuePositiveInt int
+
+func (v ClaimResponseSupportingInfoValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValuePositiveInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClaimResponseSupportingInfoValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ClaimResponseSupportingInfoValuePositiveInt) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueString is the string variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueString string
+
+func (v ClaimResponseSupportingInfoValueString) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ClaimResponseSupportingInfoValueString) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueTime is the time variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueTime struct {
+	Time
+}
+
+func (v ClaimResponseSupportingInfoValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseSupportingInfoValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ClaimResponseSupportingInfoValueTime) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueUnsignedInt is the unsignedInt variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueUnsignedInt int
+
+func (v ClaimResponseSupportingInfoValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClaimResponseSupportingInfoValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ClaimResponseSupportingInfoValueUnsignedInt) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueUri is the uri variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueUri string
+
+func (v ClaimResponseSupportingInfoValueUri) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ClaimResponseSupportingInfoValueUri) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueUrl is the url variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueUrl string
+
+func (v ClaimResponseSupportingInfoValueUrl) Validate() error {
+	return nil
+}
+
+func (v ClaimResponseSupportingInfoValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ClaimResponseSupportingInfoValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ClaimResponseSupportingInfoValueUrl) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueUuid is the uuid variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueUuid struct {
+	uuid
+}
+
+func (v ClaimResponseSupportingInfoValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ClaimResponseSupportingInfoValueUuid) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueAddress is the Address variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueAddress struct {
+	Address
+}
+
+func (v ClaimResponseSupportingInfoValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ClaimResponseSupportingInfoValueAddress) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueAge is the Age variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueAge struct {
+	Age
+}
+
+func (v ClaimResponseSupportingInfoValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ClaimResponseSupportingInfoValueAge) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueAnnotation is the Annotation variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueAnnotation struct {
+	Annotation
+}
+
+func (v ClaimResponseSupportingInfoValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ClaimResponseSupportingInfoValueAnnotation) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueAttachment is the Attachment variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueAttachment struct {
+	Attachment
+}
+
+func (v ClaimResponseSupportingInfoValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ClaimResponseSupportingInfoValueAttachment) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueCodeableConcept is the CodeableConcept variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableConcept) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueCodeableReference is the CodeableReference variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ClaimResponseSupportingInfoValueCodeableReference) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueCoding is the Coding variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueCoding struct {
+	Coding
+}
+
+func (v ClaimResponseSupportingInfoValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ClaimResponseSupportingInfoValueCoding) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueContactPoint is the ContactPoint variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ClaimResponseSupportingInfoValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ClaimResponseSupportingInfoValueContactPoint) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueCount is the Count variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueCount struct {
+	Count
+}
+
+func (v ClaimResponseSupportingInfoValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ClaimResponseSupportingInfoValueCount) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDistance is the Distance variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDistance struct {
+	Distance
+}
+
+func (v ClaimResponseSupportingInfoValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ClaimResponseSupportingInfoValueDistance) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDuration is the Duration variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDuration struct {
+	Duration
+}
+
+func (v ClaimResponseSupportingInfoValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ClaimResponseSupportingInfoValueDuration) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueHumanName is the HumanName variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueHumanName struct {
+	HumanName
+}
+
+func (v ClaimResponseSupportingInfoValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ClaimResponseSupportingInfoValueHumanName) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueIdentifier is the Identifier variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueIdentifier struct {
+	Identifier
+}
+
+func (v ClaimResponseSupportingInfoValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ClaimResponseSupportingInfoValueIdentifier) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueMoney is the Money variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueMoney struct {
+	Money
+}
+
+func (v ClaimResponseSupportingInfoValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ClaimResponseSupportingInfoValueMoney) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValuePeriod is the Period variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValuePeriod struct {
+	Period
+}
+
+func (v ClaimResponseSupportingInfoValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimResponseSupportingInfoValuePeriod) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueQuantity is the Quantity variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueQuantity struct {
+	Quantity
+}
+
+func (v ClaimResponseSupportingInfoValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ClaimResponseSupportingInfoValueQuantity) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueRange is the Range variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueRange struct {
+	Range
+}
+
+func (v ClaimResponseSupportingInfoValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ClaimResponseSupportingInfoValueRange) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueRatio is the Ratio variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueRatio struct {
+	Ratio
+}
+
+func (v ClaimResponseSupportingInfoValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ClaimResponseSupportingInfoValueRatio) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueRatioRange is the RatioRange variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueRatioRange struct {
+	RatioRange
+}
+
+func (v ClaimResponseSupportingInfoValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ClaimResponseSupportingInfoValueRatioRange) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueReference is the Reference variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueReference struct {
+	Reference
+}
+
+func (v ClaimResponseSupportingInfoValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimResponseSupportingInfoValueReference) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueSampledData is the SampledData variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueSampledData struct {
+	SampledData
+}
+
+func (v ClaimResponseSupportingInfoValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ClaimResponseSupportingInfoValueSampledData) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueSignature is the Signature variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueSignature struct {
+	Signature
+}
+
+func (v ClaimResponseSupportingInfoValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ClaimResponseSupportingInfoValueSignature) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueTiming is the Timing variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueTiming struct {
+	Timing
+}
+
+func (v ClaimResponseSupportingInfoValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ClaimResponseSupportingInfoValueTiming) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueContactDetail is the ContactDetail variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ClaimResponseSupportingInfoValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ClaimResponseSupportingInfoValueContactDetail) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDataRequirement is the DataRequirement variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ClaimResponseSupportingInfoValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ClaimResponseSupportingInfoValueDataRequirement) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueExpression is the Expression variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueExpression struct {
+	Expression
+}
+
+func (v ClaimResponseSupportingInfoValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ClaimResponseSupportingInfoValueExpression) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueParameterDefinition is the ParameterDefinition variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ClaimResponseSupportingInfoValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ClaimResponseSupportingInfoValueParameterDefinition) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueRelatedArtifact is the RelatedArtifact variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ClaimResponseSupportingInfoValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ClaimResponseSupportingInfoValueRelatedArtifact) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueTriggerDefinition is the TriggerDefinition variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ClaimResponseSupportingInfoValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ClaimResponseSupportingInfoValueTriggerDefinition) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueUsageContext is the UsageContext variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueUsageContext struct {
+	UsageContext
+}
+
+func (v ClaimResponseSupportingInfoValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ClaimResponseSupportingInfoValueUsageContext) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueAvailability is the Availability variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueAvailability struct {
+	Availability
+}
+
+func (v ClaimResponseSupportingInfoValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ClaimResponseSupportingInfoValueAvailability) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueExtendedContactDetail is the ExtendedContactDetail variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ClaimResponseSupportingInfoValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ClaimResponseSupportingInfoValueExtendedContactDetail) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueVirtualServiceDetail is the VirtualServiceDetail variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ClaimResponseSupportingInfoValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ClaimResponseSupportingInfoValueVirtualServiceDetail) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueDosage is the Dosage variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueDosage struct {
+	Dosage
+}
+
+func (v ClaimResponseSupportingInfoValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ClaimResponseSupportingInfoValueDosage) isClaimResponseSupportingInfoValue() {}
+
+// ClaimResponseSupportingInfoValueMeta is the Meta variant of ClaimResponseSupportingInfoValue.
+type ClaimResponseSupportingInfoValueMeta struct {
+	Meta
+}
+
+func (v ClaimResponseSupportingInfoValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ClaimResponseSupportingInfoValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ClaimResponseSupportingInfoValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ClaimResponseSupportingInfoValueMeta) isClaimResponseSupportingInfoValue() {}
+
+var claimResponseSupportingInfoValueVariants = []ClaimResponseSupportingInfoValue{
+	ClaimResponseSupportingInfoValueBase64Binary(""),
+	ClaimResponseSupportingInfoValueBoolean(false),
+	ClaimResponseSupportingInfoValueCanonical(""),
+	ClaimResponseSupportingInfoValueCode(""),
+	ClaimResponseSupportingInfoValueDate{},
+	ClaimResponseSupportingInfoValueDateTime{},
+	ClaimResponseSupportingInfoValueDecimal{},
+	ClaimResponseSupportingInfoValueId(""),
+	ClaimResponseSupportingInfoValueInstant{},
+	ClaimResponseSupportingInfoValueInteger(0),
+	ClaimResponseSupportingInfoValueInteger64(0),
+	ClaimResponseSupportingInfoValueMarkdown(""),
+	ClaimResponseSupportingInfoValueOid(""),
+	ClaimResponseSupportingInfoValuePositiveInt(0),
+	ClaimResponseSupportingInfoValueString(""),
+	ClaimResponseSupportingInfoValueTime{},
+	ClaimResponseSupportingInfoValueUnsignedInt(0),
+	ClaimResponseSupportingInfoValueUri(""),
+	ClaimResponseSupportingInfoValueUrl(""),
+	ClaimResponseSupportingInfoValueUuid{},
+	ClaimResponseSupportingInfoValueAddress{},
+	ClaimResponseSupportingInfoValueAge{},
+	ClaimResponseSupportingInfoValueAnnotation{},
+	ClaimResponseSupportingInfoValueAttachment{},
+	ClaimResponseSupportingInfoValueCodeableConcept{},
+	ClaimResponseSupportingInfoValueCodeableReference{},
+	ClaimResponseSupportingInfoValueCoding{},
+	ClaimResponseSupportingInfoValueContactPoint{},
+	ClaimResponseSupportingInfoValueCount{},
+	ClaimResponseSupportingInfoValueDistance{},
+	ClaimResponseSupportingInfoValueDuration{},
+	ClaimResponseSupportingInfoValueHumanName{},
+	ClaimResponseSupportingInfoValueIdentifier{},
+	ClaimResponseSupportingInfoValueMoney{},
+	ClaimResponseSupportingInfoValuePeriod{},
+	ClaimResponseSupportingInfoValueQuantity{},
+	ClaimResponseSupportingInfoValueRange{},
+	ClaimResponseSupportingInfoValueRatio{},
+	ClaimResponseSupportingInfoValueRatioRange{},
+	ClaimResponseSupportingInfoValueReference{},
+	ClaimResponseSupportingInfoValueSampledData{},
+	ClaimResponseSupportingInfoValueSignature{},
+	ClaimResponseSupportingInfoValueTiming{},
+	ClaimResponseSupportingInfoValueContactDetail{},
+	ClaimResponseSupportingInfoValueDataRequirement{},
+	ClaimResponseSupportingInfoValueExpression{},
+	ClaimResponseSupportingInfoValueParameterDefinition{},
+	ClaimResponseSupportingInfoValueRelatedArtifact{},
+	ClaimResponseSupportingInfoValueTriggerDefinition{},
+	ClaimResponseSupportingInfoValueUsageContext{},
+	ClaimResponseSupportingInfoValueAvailability{},
+	ClaimResponseSupportingInfoValueExtendedContactDetail{},
+	ClaimResponseSupportingInfoValueVirtualServiceDetail{},
+	ClaimResponseSupportingInfoValueDosage{},
+	ClaimResponseSupportingInfoValueMeta{},
+}
+
+type ClaimResponseItem struct {
+	Id                         *string                         `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	ItemSequence               int                             `json:"itemSequence" bson:"item_sequence"`                                            // Claim item instance identifier
+	ItemSequenceElement        *Element                        `json:"_itemSequence,omitempty" bson:"item_sequence_element,omitempty"`               // Extensions for itemSequence
+	TraceNumber                []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
+	InformationSequence        []int                           `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
+	InformationSequenceElement []*Element                      `json:"_informationSequence,omitempty" bson:"information_sequence_element,omitempty"` // Extensions for informationSequence
+	NoteNumber                 []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
+	NoteNumberElement          []*Element                      `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`                   // Extensions for noteNumber
+	ReviewOutcome              *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Adjudication results
+	Adjudication               []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Adjudication details
+	Detail                     []ClaimResponseItemDetail       `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Adjudication for claim details
+}
+
+func (r *ClaimResponseItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.ItemSequence == 0 {
+		return fmt.Errorf("field 'ItemSequence' is required")
+	}
+	if r.ItemSequenceElement != nil {
+		if err := r.ItemSequenceElement.Validate(); err != nil {
+			return fmt.Errorf("ItemSequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InformationSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.ReviewOutcome != nil {
+		if err := r.ReviewOutcome.Validate(); err != nil {
+			return fmt.Errorf("ReviewOutcome: %w", err)
+		}
+	}
+	for i, item := range r.Adjudication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Detail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ItemSequence == 0 {
+		issues.add("required", path+".itemSequence", "field 'ItemSequence' is required")
+	}
+	if r.ItemSequenceElement != nil {
+		r.ItemSequenceElement.validateAll(path+".itemSequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.informationSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var claimResponseItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseItem) invariants() []invariant {
+	return claimResponseItemInvariants
+}
+
+func (r ClaimResponseItem) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseItem
+	out := struct {
+		alias
+		InformationSequence        []*int     `json:"informationSequence,omitempty"`
+		InformationSequenceElement []*Element `json:"_informationSequence,omitempty"`
+		NoteNumber                 []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement          []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.InformationSequence, out.InformationSequenceElement = alignPrimitiveArray(r.InformationSequence, r.InformationSequenceElement)
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimResponseItem) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseItem
+	aux := struct {
+		*alias
+		InformationSequence []*int `json:"informationSequence,omitempty"`
+		NoteNumber          []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.InformationSequence, err = splitPrimitiveArray(aux.InformationSequence, r.InformationSequenceElement); err != nil {
+		return fmt.Errorf("informationSequence: %w", err)
+	}
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ClaimResponseItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseItemElementTypes = map[string]string{
+	"itemSequence":        "positiveInt",
+	"informationSequence": "positiveInt",
+	"noteNumber":          "positiveInt",
+}
+
+func (r *ClaimResponseItem) elementTypes() map[string]string {
+	return claimResponseItemElementTypes
+}
+
+var claimResponseItemElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.item.itemSequence", Name: "itemSequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.item.informationSequence", Name: "informationSequence", Field: 6, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.noteNumber", Name: "noteNumber", Field: 8, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.reviewOutcome", Name: "reviewOutcome", Field: 10, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.item.adjudication", Name: "adjudication", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.item.detail", Name: "detail", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponseItem) ElementMetadata() []ElementMetadata {
+	return claimResponseItemElementMetadata
+}
+
+type ClaimResponseItemReviewOutcome struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Decision          *CodeableConcept  `json:"decision,omitempty" bson:"decision,omitempty"`                    // Result of the adjudication
+	Reason            []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // Reason for result of the adjudication
+	PreAuthRef        *string           `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`              // Preauthorization reference
+	PreAuthRefElement *Element          `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`     // Extensions for preAuthRef
+	PreAuthPeriod     *Period           `json:"preAuthPeriod,omitempty" bson:"pre_auth_period,omitempty"`        // Preauthorization reference effective period
+}
+
+func (r *ClaimResponseItemReviewOutcome) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Decision != nil {
+		if err := r.Decision.Validate(); err != nil {
+			return fmt.Errorf("Decision: %w", err)
+		}
+	}
+	for i, item := range r.Reason {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Reason[%d]: %w", i, err)
+		}
+	}
+	if r.PreAuthRefElement != nil {
+		if err := r.PreAuthRefElement.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement: %w", err)
+		}
+	}
+	if r.PreAuthPeriod != nil {
+		if err := r.PreAuthPeriod.Validate(); err != nil {
+			return fmt.Errorf("PreAuthPeriod: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseItemReviewOutcome) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Decision != nil {
+		r.Decision.validateAll(path+".decision", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.PreAuthRefElement != nil {
+		r.PreAuthRefElement.validateAll(path+".preAuthRef", issues)
+	}
+	if r.PreAuthPeriod != nil {
+		r.PreAuthPeriod.validateAll(path+".preAuthPeriod", issues)
+	}
+}
+
+var claimResponseItemReviewOutcomeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseItemReviewOutcome) invariants() []invariant {
+	return claimResponseItemReviewOutcomeInvariants
+}
+
+func (r ClaimResponseItemReviewOutcome) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseItemReviewOutcome) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseItemReviewOutcome) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseItemReviewOutcome) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseItemReviewOutcomeElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.item.reviewOutcome.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.item.reviewOutcome.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.item.reviewOutcome.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.item.reviewOutcome.decision", Name: "decision", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.item.reviewOutcome.reason", Name: "reason", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.item.reviewOutcome.preAuthRef", Name: "preAuthRef", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.item.reviewOutcome.preAuthPeriod", Name: "preAuthPeriod", Field: 7, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *ClaimResponseItemReviewOutcome) ElementMetadata() []ElementMetadata {
+	return claimResponseItemReviewOutcomeElementMetadata
+}
+
+type ClaimResponseItemAdjudication struct {
+	Id                  *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category            *CodeableConcept `json:"category" bson:"category"`                                        // Type of adjudication information
+	Reason              *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // Explanation of adjudication outcome
+	Amount              *Money           `json:"amount,omitempty" bson:"amount,omitempty"`                        // Monetary amount
+	Quantity            *Quantity        `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // Non-monetary value
+	DecisionDate        *DateTime        `json:"decisionDate,omitempty" bson:"decision_date,omitempty"`           // When was adjudication performed
+	DecisionDateElement *Element         `json:"_decisionDate,omitempty" bson:"decision_date_element,omitempty"`  // Extensions for decisionDate
+}
+
+func (r *ClaimResponseItemAdjudication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Reason != nil {
+		if err := r.Reason.Validate(); err != nil {
+			return fmt.Errorf("Reason: %w", err)
+		}
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.DecisionDate != nil {
+		if err := r.DecisionDate.Validate(); err != nil {
+			return fmt.Errorf("DecisionDate: %w", err)
+		}
+	}
+	if r.DecisionDateElement != nil {
+		if err := r.DecisionDateElement.Validate(); err != nil {
+			return fmt.Errorf("DecisionDateElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseItemAdjudication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.DecisionDate != nil {
+		if err := r.DecisionDate.Validate(); err != nil {
+			issues.add("value", path+".decisionDate", err.Error())
+		}
+	}
+	if r.DecisionDateElement != nil {
+		r.DecisionDateElement.validateAll(path+".decisionDate", issues)
+	}
+}
+
+var claimResponseItemAdjudicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseItemAdjudication) invariants() []invariant {
+	return claimResponseItemAdjudicationInvariants
+}
+
+func (r ClaimResponseItemAdjudication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseItemAdjudication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseItemAdjudication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseItemAdjudication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseItemAdjudicationElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.item.adjudication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.item.adjudication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.item.adjudication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.item.adjudication.category", Name: "category", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.item.adjudication.reason", Name: "reason", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.item.adjudication.amount", Name: "amount", Field: 5, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.item.adjudication.quantity", Name: "quantity", Field: 6, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ClaimResponse.item.adjudication.decisionDate", Name: "decisionDate", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime"}},
+}
+
+func (r *ClaimResponseItemAdjudication) ElementMetadata() []ElementMetadata {
+	return claimResponseItemAdjudicationElementMetadata
+}
+
+type ClaimResponseItemDetail struct {
+	Id                    *string                            `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
+	Extension             []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension     []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored even if unrecognized
+	DetailSequence        int                                `json:"detailSequence" bson:"detail_sequence"`                              // Claim detail instance identifier
+	DetailSequenceElement *Element                           `json:"_detailSequence,omitempty" bson:"detail_sequence_element,omitempty"` // Extensions for detailSequence
+	TraceNumber           []Identifier                       `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                // Number for tracking
+	NoteNumber            []int                              `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                  // Applicable note numbers
+	NoteNumberElement     []*Element                         `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`         // Extensions for noteNumber
+	ReviewOutcome         *ClaimResponseItemReviewOutcome    `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`            // Detail level adjudication results
+	Adjudication          []ClaimResponseItemAdjudication    `json:"adjudication,omitempty" bson:"adjudication,omitempty"`               // Detail level adjudication details
+	SubDetail             []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                    // Adjudication for claim sub-details
+}
+
+func (r *ClaimResponseItemDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DetailSequence == 0 {
+		return fmt.Errorf("field 'DetailSequence' is required")
+	}
+	if r.DetailSequenceElement != nil {
+		if err := r.DetailSequenceElement.Validate(); err != nil {
+			return fmt.Errorf("DetailSequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.ReviewOutcome != nil {
+		if err := r.ReviewOutcome.Validate(); err != nil {
+			return fmt.Errorf("ReviewOutcome: %w", err)
+		}
+	}
+	for i, item := range r.Adjudication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubDetail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubDetail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseItemDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DetailSequence == 0 {
+		issues.add("required", path+".detailSequence", "field 'DetailSequence' is required")
+	}
+	if r.DetailSequenceElement != nil {
+		r.DetailSequenceElement.validateAll(path+".detailSequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.SubDetail {
+		item.validateAll(fmt.Sprintf("%s.subDetail[%d]", path, i), issues)
+	}
+}
+
+var claimResponseItemDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseItemDetail) invariants() []invariant {
+	return claimResponseItemDetailInvariants
+}
+
+func (r ClaimResponseItemDetail) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseItemDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimResponseItemDetail) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseItemDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ClaimResponseItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseItemDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseItemDetailElementTypes = map[string]string{
+	"detailSequence": "positiveInt",
+	"noteNumber":     "positiveInt",
+}
+
+func (r *ClaimResponseItemDetail) elementTypes() map[string]string {
+	return claimResponseItemDetailElementTypes
+}
+
+var claimResponseItemDetailElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.item.detail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.item.detail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.item.detail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.item.detail.detailSequence", Name: "detailSequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.detail.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.item.detail.noteNumber", Name: "noteNumber", Field: 6, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.detail.reviewOutcome", Name: "reviewOutcome", Field: 8, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.item.detail.adjudication", Name: "adjudication", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.item.detail.subDetail", Name: "subDetail", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponseItemDetail) ElementMetadata() []ElementMetadata {
+	return claimResponseItemDetailElementMetadata
+}
+
+type ClaimResponseItemDetailSubDetail struct {
+	Id                       *string                         `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
+	Extension                []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored even if unrecognized
+	SubDetailSequence        int                             `json:"subDetailSequence" bson:"sub_detail_sequence"`                              // Claim sub-detail instance identifier
+	SubDetailSequenceElement *Element                        `json:"_subDetailSequence,omitempty" bson:"sub_detail_sequence_element,omitempty"` // Extensions for subDetailSequence
+	TraceNumber              []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                       // Number for tracking
+	NoteNumber               []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                         // Applicable note numbers
+	NoteNumberElement        []*Element                      `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`                // Extensions for noteNumber
+	ReviewOutcome            *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                   // Subdetail level adjudication results
+	Adjudication             []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                      // Subdetail level adjudication details
+}
+
+func (r *ClaimResponseItemDetailSubDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SubDetailSequence == 0 {
+		return fmt.Errorf("field 'SubDetailSequence' is required")
+	}
+	if r.SubDetailSequenceElement != nil {
+		if err := r.SubDetailSequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SubDetailSequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.ReviewOutcome != nil {
+		if err := r.ReviewOutcome.Validate(); err != nil {
+			return fmt.Errorf("ReviewOutcome: %w", err)
+		}
+	}
+	for i, item := range r.Adjudication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseItemDetailSubDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SubDetailSequence == 0 {
+		issues.add("required", path+".subDetailSequence", "field 'SubDetailSequence' is required")
+	}
+	if r.SubDetailSequenceElement != nil {
+		r.SubDetailSequenceElement.validateAll(path+".subDetailSequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+}
+
+var claimResponseItemDetailSubDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseItemDetailSubDetail) invariants() []invariant {
+	return claimResponseItemDetailSubDetailInvariants
+}
+
+func (r ClaimResponseItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseItemDetailSubDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseItemDetailSubDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ClaimResponseItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseItemDetailSubDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseItemDetailSubDetailElementTypes = map[string]string{
+	"subDetailSequence": "positiveInt",
+	"noteNumber":        "positiveInt",
+}
+
+func (r *ClaimResponseItemDetailSubDetail) elementTypes() map[string]string {
+	return claimResponseItemDetailSubDetailElementTypes
+}
+
+var claimResponseItemDetailSubDetailElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.item.detail.subDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.item.detail.subDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.item.detail.subDetail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.item.detail.subDetail.subDetailSequence", Name: "subDetailSequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.detail.subDetail.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.item.detail.subDetail.noteNumber", Name: "noteNumber", Field: 6, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.item.detail.subDetail.reviewOutcome", Name: "reviewOutcome", Field: 8, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.item.detail.subDetail.adjudication", Name: "adjudication", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponseItemDetailSubDetail) ElementMetadata() []ElementMetadata {
+	return claimResponseItemDetailSubDetailElementMetadata
+}
+
+type ClaimResponseAddItem struct {
+	Id                         *string                         `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	ItemSequence               []int                           `json:"itemSequence,omitempty" bson:"item_sequence,omitempty"`                        // Item sequence number
+	ItemSequenceElement        []*Element                      `json:"_itemSequence,omitempty" bson:"item_sequence_element,omitempty"`               // Extensions for itemSequence
+	DetailSequence             []int                           `json:"detailSequence,omitempty" bson:"detail_sequence,omitempty"`                    // Detail sequence number
+	DetailSequenceElement      []*Element                      `json:"_detailSequence,omitempty" bson:"detail_sequence_element,omitempty"`           // Extensions for detailSequence
+	SubdetailSequence          []int                           `json:"subdetailSequence,omitempty" bson:"subdetail_sequence,omitempty"`              // Subdetail sequence number
+	SubdetailSequenceElement   []*Element                      `json:"_subdetailSequence,omitempty" bson:"subdetail_sequence_element,omitempty"`     // Extensions for subdetailSequence
+	TraceNumber                []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
+	Subject                    *Reference                      `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
+	InformationSequence        []int                           `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
+	InformationSequenceElement []*Element                      `json:"_informationSequence,omitempty" bson:"information_sequence_element,omitempty"` // Extensions for informationSequence
+	Provider                   []Reference                     `json:"provider,omitempty" bson:"provider,omitempty"`                                 // Authorized providers
+	Revenue                    *CodeableConcept                `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
+	Category                   *CodeableConcept                `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
+	ProductOrService           *CodeableConcept                `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
+	ProductOrServiceEnd        *CodeableConcept                `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
+	Request                    []Reference                     `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
+	Modifier                   []CodeableConcept               `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Service/Product billing modifiers
+	ProgramCode                []CodeableConcept               `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
+	Serviced                   ClaimResponseAddItemServiced    `json:"-" bson:"serviced,omitempty"`                                                  // Date or dates of service or product delivery
+	ServicedElement            *Element                        `json:"-" bson:"serviced_element,omitempty"`                                          // Extensions for serviced[x]
+	Location                   ClaimResponseAddItemLocation    `json:"-" bson:"location,omitempty"`                                                  // Place of service or where product was supplied
+	Quantity                   *Quantity                       `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
+	UnitPrice                  *Money                          `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
+	Factor                     *Decimal                        `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
+	FactorElement              *Element                        `json:"_factor,omitempty" bson:"factor_element,omitempty"`                            // Extensions for factor
+	Tax                        *Money                          `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
+	Net                        *Money                          `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
+	BodySite                   []ClaimResponseAddItemBodySite  `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
+	NoteNumber                 []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
+	NoteNumberElement          []*Element                      `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`                   // Extensions for noteNumber
+	ReviewOutcome              *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Added items adjudication results
+	Adjudication               []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Added items adjudication
+	Detail                     []ClaimResponseAddItemDetail    `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Insurer added line details
+
+	servicedVariants []string // JSON properties of ClaimResponse.addItem.serviced[x] when more than one was decoded
+	locationVariants []string // JSON properties of ClaimResponse.addItem.location[x] when more than one was decoded
+}
+
+var claimResponseAddItemSubjectTargets = []string{"Group", "Patient"}
+
+func (r *ClaimResponseAddItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ItemSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ItemSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DetailSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubdetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubdetailSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimResponseAddItemSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, claimResponseAddItemSubjectTargets)
+		}
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InformationSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Provider {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Provider[%d]: %w", i, err)
+		}
+	}
+	if r.Revenue != nil {
+		if err := r.Revenue.Validate(); err != nil {
+			return fmt.Errorf("Revenue: %w", err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	if r.ProductOrServiceEnd != nil {
+		if err := r.ProductOrServiceEnd.Validate(); err != nil {
+			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+		}
+	}
+	for i, item := range r.Request {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Request[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProgramCode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+		}
+	}
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
+	}
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
+		}
+	}
+	if len(r.locationVariants) > 1 {
+		return fmt.Errorf("field 'Location' must have a single type, got %v", r.locationVariants)
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.Tax != nil {
+		if err := r.Tax.Validate(); err != nil {
+			return fmt.Errorf("Tax: %w", err)
+		}
+	}
+	if r.Net != nil {
+		if err := r.Net.Validate(); err != nil {
+			return fmt.Errorf("Net: %w", err)
+		}
+	}
+	for i, item := range r.BodySite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BodySite[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.ReviewOutcome != nil {
+		if err := r.ReviewOutcome.Validate(); err != nil {
+			return fmt.Errorf("ReviewOutcome: %w", err)
+		}
+	}
+	for i, item := range r.Adjudication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Detail[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
+func (r *ClaimResponseAddItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.ItemSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.itemSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.DetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.detailSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.SubdetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.subdetailSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, claimResponseAddItemSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, claimResponseAddItemSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.informationSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.Provider {
+		item.validateAll(fmt.Sprintf("%s.provider[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Request {
+		item.validateAll(fmt.Sprintf("%s.request[%d]", path, i), issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if len(r.locationVariants) > 1 {
+		issues.add("structure", path+".location", fmt.Sprintf("field 'Location' must have a single type, got %v", r.locationVariants))
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location.ofType("+r.Location.FHIRType()+")", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var claimResponseAddItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseAddItem) invariants() []invariant {
+	return claimResponseAddItemInvariants
+}
+
+func (r ClaimResponseAddItem) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseAddItem
+	out := struct {
+		alias
+		ItemSequence               []*int     `json:"itemSequence,omitempty"`
+		ItemSequenceElement        []*Element `json:"_itemSequence,omitempty"`
+		DetailSequence             []*int     `json:"detailSequence,omitempty"`
+		DetailSequenceElement      []*Element `json:"_detailSequence,omitempty"`
+		SubdetailSequence          []*int     `json:"subdetailSequence,omitempty"`
+		SubdetailSequenceElement   []*Element `json:"_subdetailSequence,omitempty"`
+		InformationSequence        []*int     `json:"informationSequence,omitempty"`
+		InformationSequenceElement []*Element `json:"_informationSequence,omitempty"`
+		NoteNumber                 []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement          []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.ItemSequence, out.ItemSequenceElement = alignPrimitiveArray(r.ItemSequence, r.ItemSequenceElement)
+	out.DetailSequence, out.DetailSequenceElement = alignPrimitiveArray(r.DetailSequence, r.DetailSequenceElement)
+	out.SubdetailSequence, out.SubdetailSequenceElement = alignPrimitiveArray(r.SubdetailSequence, r.SubdetailSequenceElement)
+	out.InformationSequence, out.InformationSequenceElement = alignPrimitiveArray(r.InformationSequence, r.InformationSequenceElement)
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "location", r.Location, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClaimResponseAddItem) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseAddItem
+	aux := struct {
+		*alias
+		ItemSequence        []*int `json:"itemSequence,omitempty"`
+		DetailSequence      []*int `json:"detailSequence,omitempty"`
+		SubdetailSequence   []*int `json:"subdetailSequence,omitempty"`
+		InformationSequence []*int `json:"informationSequence,omitempty"`
+		NoteNumber          []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.ItemSequence, err = splitPrimitiveArray(aux.ItemSequence, r.ItemSequenceElement); err != nil {
+		return fmt.Errorf("itemSequence: %w", err)
+	}
+	if r.DetailSequence, err = splitPrimitiveArray(aux.DetailSequence, r.DetailSequenceElement); err != nil {
+		return fmt.Errorf("detailSequence: %w", err)
+	}
+	if r.SubdetailSequence, err = splitPrimitiveArray(aux.SubdetailSequence, r.SubdetailSequenceElement); err != nil {
+		return fmt.Errorf("subdetailSequence: %w", err)
+	}
+	if r.InformationSequence, err = splitPrimitiveArray(aux.InformationSequence, r.InformationSequenceElement); err != nil {
+		return fmt.Errorf("informationSequence: %w", err)
+	}
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", claimResponseAddItemServicedVariants); err != nil {
+		return err
+	}
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
+		}
+	}
+	if r.Location, _, r.locationVariants, err = unmarshalChoice(fields, "location", claimResponseAddItemLocationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ClaimResponseAddItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseAddItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseAddItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseAddItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClaimResponseAddItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(claimResponseAddItemServicedVariants)
+	case "Location":
+		return "location", choiceValues(claimResponseAddItemLocationVariants)
+	}
+	return "", nil
+}
+
+var claimResponseAddItemElementTypes = map[string]string{
+	"itemSequence":        "positiveInt",
+	"detailSequence":      "positiveInt",
+	"subdetailSequence":   "positiveInt",
+	"informationSequence": "positiveInt",
+	"noteNumber":          "positiveInt",
+}
+
+func (r *ClaimResponseAddItem) elementTypes() map[string]string {
+	return claimResponseAddItemElementTypes
+}
+
+var claimResponseAddItemElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.addItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.addItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.addItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.addItem.itemSequence", Name: "itemSequence", Field: 3, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.detailSequence", Name: "detailSequence", Field: 5, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.subdetailSequence", Name: "subdetailSequence", Field: 7, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.traceNumber", Name: "traceNumber", Field: 9, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.addItem.subject", Name: "subject", Field: 10, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ClaimResponse.addItem.informationSequence", Name: "informationSequence", Field: 11, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.provider", Name: "provider", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ClaimResponse.addItem.revenue", Name: "revenue", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.category", Name: "category", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.productOrService", Name: "productOrService", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.productOrServiceEnd", Name: "productOrServiceEnd", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.request", Name: "request", Field: 18, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ClaimResponse.addItem.modifier", Name: "modifier", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.programCode", Name: "programCode", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.serviced[x]", Name: "serviced", Field: 21, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "ClaimResponse.addItem.location[x]", Name: "location", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Address", "Reference"}},
+	{Path: "ClaimResponse.addItem.quantity", Name: "quantity", Field: 24, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ClaimResponse.addItem.unitPrice", Name: "unitPrice", Field: 25, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.factor", Name: "factor", Field: 26, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ClaimResponse.addItem.tax", Name: "tax", Field: 28, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.net", Name: "net", Field: 29, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.bodySite", Name: "bodySite", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem.noteNumber", Name: "noteNumber", Field: 31, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.reviewOutcome", Name: "reviewOutcome", Field: 33, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem.adjudication", Name: "adjudication", Field: 34, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem.detail", Name: "detail", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponseAddItem) ElementMetadata() []ElementMetadata {
+	return claimResponseAddItemElementMetadata
+}
+
+// ClaimResponseAddItemServiced is the type of ClaimResponse.addItem.serviced[x]. It is implemented by the ClaimResponseAddItemServiced* variant types.
+type ClaimResponseAddItemServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimResponseAddItemServiced()
+}
+
+// ClaimResponseAddItemServicedDate is the date variant of ClaimResponseAddItemServiced.
+type ClaimResponseAddItemServicedDate struct {
+	Date
+}
+
+func (v ClaimResponseAddItemServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ClaimResponseAddItemServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ClaimResponseAddItemServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v ClaimResponseAddItemServicedDate) isClaimResponseAddItemServiced() {}
+
+// ClaimResponseAddItemServicedPeriod is the Period variant of ClaimResponseAddItemServiced.
+type ClaimResponseAddItemServicedPeriod struct {
+	Period
+}
+
+func (v ClaimResponseAddItemServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ClaimResponseAddItemServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ClaimResponseAddItemServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ClaimResponseAddItemServicedPeriod) isClaimResponseAddItemServiced() {}
+
+var claimResponseAddItemServicedVariants = []ClaimResponseAddItemServiced{
+	ClaimResponseAddItemServicedDate{},
+	ClaimResponseAddItemServicedPeriod{},
+}
+
+// ClaimResponseAddItemLocation is the type of ClaimResponse.addItem.location[x]. It is implemented by the ClaimResponseAddItemLocation* variant types.
+type ClaimResponseAddItemLocation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClaimResponseAddItemLocation()
+}
+
+// ClaimResponseAddItemLocationCodeableConcept is the CodeableConcept variant of ClaimResponseAddItemLocation.
+type ClaimResponseAddItemLocationCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClaimResponseAddItemLocationCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClaimResponseAddItemLocationCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClaimResponseAddItemLocationCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClaimResponseAddItemLocationCodeableConcept) isClaimResponseAddItemLocation() {}
+
+// ClaimResponseAddItemLocationAddress is the Address variant of ClaimResponseAddItemLocation.
+type ClaimResponseAddItemLocationAddress struct {
+	Address
+}
+
+func (v ClaimResponseAddItemLocationAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ClaimResponseAddItemLocationAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ClaimResponseAddItemLocationAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ClaimResponseAddItemLocationAddress) isClaimResponseAddItemLocation() {}
+
+// ClaimResponseAddItemLocationReference is the Reference variant of ClaimResponseAddItemLocation.
+type ClaimResponseAddItemLocationReference struct {
+	Reference
+}
+
+func (v ClaimResponseAddItemLocationReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClaimResponseAddItemLocationReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClaimResponseAddItemLocationReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClaimResponseAddItemLocationReference) isClaimResponseAddItemLocation() {}
+
+var claimResponseAddItemLocationVariants = []ClaimResponseAddItemLocation{
+	ClaimResponseAddItemLocationCodeableConcept{},
+	ClaimResponseAddItemLocationAddress{},
+	ClaimResponseAddItemLocationReference{},
+}
+
+type ClaimResponseAddItemBodySite struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Site              []CodeableReference `json:"site" bson:"site"`                                                // Location
+	SubSite           []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"`                     // Sub-location
+}
+
+func (r *ClaimResponseAddItemBodySite) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.Site) < 1 {
+		return fmt.Errorf("field 'Site' must have at least 1 elements")
+	}
+	for i, item := range r.Site {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Site[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubSite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubSite[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClaimResponseAddItemBodySite) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Site) < 1 {
+		issues.add("required", path+".site", "field 'Site' must have at least 1 elements")
+	}
+	for i, item := range r.Site {
+		item.validateAll(fmt.Sprintf("%s.site[%d]", path, i), issues)
+	}
+	for i, item := range r.SubSite {
+		item.validateAll(fmt.Sprintf("%s.subSite[%d]", path, i), issues)
+	}
+}
+
+var claimResponseAddItemBodySiteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseAddItemBodySite) invariants() []invariant {
+	return claimResponseAddItemBodySiteInvariants
+}
+
+func (r ClaimResponseAddItemBodySite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseAddItemBodySite) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseAddItemBodySite) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseAddItemBodySite) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseAddItemBodySiteElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.addItem.bodySite.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.addItem.bodySite.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.addItem.bodySite.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.addItem.bodySite.site", Name: "site", Field: 3, Min: 1, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ClaimResponse.addItem.bodySite.subSite", Name: "subSite", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimResponseAddItemBodySite) ElementMetadata() []ElementMetadata {
+	return claimResponseAddItemBodySiteElementMetadata
+}
+
 type ClaimResponseAddItemDetail struct {
 	Id                  *string                               `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
 	TraceNumber         []Identifier                          `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
 	Revenue             *CodeableConcept                      `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
 	ProductOrService    *CodeableConcept                      `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
@@ -542,16 +3775,28 @@ type ClaimResponseAddItemDetail struct {
 	Modifier            []CodeableConcept                     `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
 	Quantity            *Quantity                             `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
 	UnitPrice           *Money                                `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                              `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	Factor              *Decimal                              `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                              `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
 	Tax                 *Money                                `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
 	Net                 *Money                                `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
 	NoteNumber          []int                                 `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
+	NoteNumberElement   []*Element                            `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`            // Extensions for noteNumber
 	ReviewOutcome       *ClaimResponseItemReviewOutcome       `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Added items detail level adjudication results
 	Adjudication        []ClaimResponseItemAdjudication       `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Added items detail adjudication
 	SubDetail           []ClaimResponseAddItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                       // Insurer added line items
 }
 
 func (r *ClaimResponseAddItemDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
@@ -587,6 +3832,16 @@ func (r *ClaimResponseAddItemDetail) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -597,6 +3852,14 @@ func (r *ClaimResponseAddItemDetail) Validate() error {
 			return fmt.Errorf("Net: %w", err)
 		}
 	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
 	if r.ReviewOutcome != nil {
 		if err := r.ReviewOutcome.Validate(); err != nil {
 			return fmt.Errorf("ReviewOutcome: %w", err)
@@ -615,8 +3878,152 @@ func (r *ClaimResponseAddItemDetail) Validate() error {
 	return nil
 }
 
+func (r *ClaimResponseAddItemDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.SubDetail {
+		item.validateAll(fmt.Sprintf("%s.subDetail[%d]", path, i), issues)
+	}
+}
+
+var claimResponseAddItemDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseAddItemDetail) invariants() []invariant {
+	return claimResponseAddItemDetailInvariants
+}
+
+func (r ClaimResponseAddItemDetail) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseAddItemDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimResponseAddItemDetail) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseAddItemDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ClaimResponseAddItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseAddItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseAddItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseAddItemDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseAddItemDetailElementTypes = map[string]string{
+	"noteNumber": "positiveInt",
+}
+
+func (r *ClaimResponseAddItemDetail) elementTypes() map[string]string {
+	return claimResponseAddItemDetailElementTypes
+}
+
+var claimResponseAddItemDetailElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.addItem.detail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.addItem.detail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.addItem.detail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.addItem.detail.traceNumber", Name: "traceNumber", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.addItem.detail.revenue", Name: "revenue", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.productOrService", Name: "productOrService", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.modifier", Name: "modifier", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.quantity", Name: "quantity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ClaimResponse.addItem.detail.unitPrice", Name: "unitPrice", Field: 9, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.detail.factor", Name: "factor", Field: 10, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ClaimResponse.addItem.detail.tax", Name: "tax", Field: 12, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.detail.net", Name: "net", Field: 13, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.detail.noteNumber", Name: "noteNumber", Field: 14, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.detail.reviewOutcome", Name: "reviewOutcome", Field: 16, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem.detail.adjudication", Name: "adjudication", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail", Name: "subDetail", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponseAddItemDetail) ElementMetadata() []ElementMetadata {
+	return claimResponseAddItemDetailElementMetadata
+}
+
 type ClaimResponseAddItemDetailSubDetail struct {
 	Id                  *string                         `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
 	TraceNumber         []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
 	Revenue             *CodeableConcept                `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
 	ProductOrService    *CodeableConcept                `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
@@ -624,15 +4031,27 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	Modifier            []CodeableConcept               `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
 	Quantity            *Quantity                       `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
 	UnitPrice           *Money                          `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                        `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	Factor              *Decimal                        `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                        `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
 	Tax                 *Money                          `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
 	Net                 *Money                          `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
 	NoteNumber          []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
+	NoteNumberElement   []*Element                      `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`            // Extensions for noteNumber
 	ReviewOutcome       *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Added items subdetail level adjudication results
 	Adjudication        []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Added items subdetail adjudication
 }
 
 func (r *ClaimResponseAddItemDetailSubDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
@@ -668,6 +4087,16 @@ func (r *ClaimResponseAddItemDetailSubDetail) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -678,6 +4107,14 @@ func (r *ClaimResponseAddItemDetailSubDetail) Validate() error {
 			return fmt.Errorf("Net: %w", err)
 		}
 	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
 	if r.ReviewOutcome != nil {
 		if err := r.ReviewOutcome.Validate(); err != nil {
 			return fmt.Errorf("ReviewOutcome: %w", err)
@@ -691,166 +4128,289 @@ func (r *ClaimResponseAddItemDetailSubDetail) Validate() error {
 	return nil
 }
 
-type ClaimResponsePayment struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type" bson:"type"`                                              // Partial or complete payment
-	Adjustment       *Money           `json:"adjustment,omitempty" bson:"adjustment,omitempty"`              // Payment adjustment for non-claim issues
-	AdjustmentReason *CodeableConcept `json:"adjustmentReason,omitempty" bson:"adjustment_reason,omitempty"` // Explanation for the adjustment
-	Date             *string          `json:"date,omitempty" bson:"date,omitempty"`                          // Expected date of payment
-	Amount           *Money           `json:"amount" bson:"amount"`                                          // Payable amount after adjustment
-	Identifier       *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`              // Business identifier for the payment
-}
-
-func (r *ClaimResponsePayment) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *ClaimResponseAddItemDetailSubDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Adjustment != nil {
-		if err := r.Adjustment.Validate(); err != nil {
-			return fmt.Errorf("Adjustment: %w", err)
-		}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
 	}
-	if r.AdjustmentReason != nil {
-		if err := r.AdjustmentReason.Validate(); err != nil {
-			return fmt.Errorf("AdjustmentReason: %w", err)
-		}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
 	}
-	if r.Amount == nil {
-		return fmt.Errorf("field 'Amount' is required")
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
 	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
 		}
 	}
-	if r.Identifier != nil {
-		if err := r.Identifier.Validate(); err != nil {
-			return fmt.Errorf("Identifier: %w", err)
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ClaimResponseError struct {
-	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
-	ItemSequence      *int             `json:"itemSequence,omitempty" bson:"item_sequence,omitempty"`            // Item sequence number
-	DetailSequence    *int             `json:"detailSequence,omitempty" bson:"detail_sequence,omitempty"`        // Detail sequence number
-	SubDetailSequence *int             `json:"subDetailSequence,omitempty" bson:"sub_detail_sequence,omitempty"` // Subdetail sequence number
-	Code              *CodeableConcept `json:"code" bson:"code"`                                                 // Error code detailing processing issues
-	Expression        []string         `json:"expression,omitempty" bson:"expression,omitempty"`                 // FHIRPath of element(s) related to issue
+var claimResponseAddItemDetailSubDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ClaimResponseError) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
-	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+func (r *ClaimResponseAddItemDetailSubDetail) invariants() []invariant {
+	return claimResponseAddItemDetailSubDetailInvariants
+}
+
+func (r ClaimResponseAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseAddItemDetailSubDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseAddItemDetailSubDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
 	}
 	return nil
 }
 
-type ClaimResponseItemReviewOutcome struct {
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Decision      *CodeableConcept  `json:"decision,omitempty" bson:"decision,omitempty"`             // Result of the adjudication
-	Reason        []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                 // Reason for result of the adjudication
-	PreAuthRef    *string           `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`       // Preauthorization reference
-	PreAuthPeriod *Period           `json:"preAuthPeriod,omitempty" bson:"pre_auth_period,omitempty"` // Preauthorization reference effective period
+func (r ClaimResponseAddItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ClaimResponseItemReviewOutcome) Validate() error {
-	if r.Decision != nil {
-		if err := r.Decision.Validate(); err != nil {
-			return fmt.Errorf("Decision: %w", err)
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseAddItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseAddItemDetailSubDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseAddItemDetailSubDetailElementTypes = map[string]string{
+	"noteNumber": "positiveInt",
+}
+
+func (r *ClaimResponseAddItemDetailSubDetail) elementTypes() map[string]string {
+	return claimResponseAddItemDetailSubDetailElementTypes
+}
+
+var claimResponseAddItemDetailSubDetailElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.addItem.detail.subDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.addItem.detail.subDetail.traceNumber", Name: "traceNumber", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.revenue", Name: "revenue", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.productOrService", Name: "productOrService", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.modifier", Name: "modifier", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.quantity", Name: "quantity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.unitPrice", Name: "unitPrice", Field: 9, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.factor", Name: "factor", Field: 10, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.tax", Name: "tax", Field: 12, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.net", Name: "net", Field: 13, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.noteNumber", Name: "noteNumber", Field: 14, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.reviewOutcome", Name: "reviewOutcome", Field: 16, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClaimResponse.addItem.detail.subDetail.adjudication", Name: "adjudication", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClaimResponseAddItemDetailSubDetail) ElementMetadata() []ElementMetadata {
+	return claimResponseAddItemDetailSubDetailElementMetadata
+}
+
+type ClaimResponseTotal struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category          *CodeableConcept `json:"category" bson:"category"`                                        // Type of adjudication information
+	Amount            *Money           `json:"amount" bson:"amount"`                                            // Financial total for the category
+}
+
+func (r *ClaimResponseTotal) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Reason {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Reason[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.PreAuthPeriod != nil {
-		if err := r.PreAuthPeriod.Validate(); err != nil {
-			return fmt.Errorf("PreAuthPeriod: %w", err)
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Amount == nil {
+		return fmt.Errorf("field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
 		}
 	}
 	return nil
 }
 
-type ClaimResponseItemDetailSubDetail struct {
-	Id                *string                         `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	SubDetailSequence int                             `json:"subDetailSequence" bson:"sub_detail_sequence"`            // Claim sub-detail instance identifier
-	TraceNumber       []Identifier                    `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`     // Number for tracking
-	NoteNumber        []int                           `json:"noteNumber,omitempty" bson:"note_number,omitempty"`       // Applicable note numbers
-	ReviewOutcome     *ClaimResponseItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"` // Subdetail level adjudication results
-	Adjudication      []ClaimResponseItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`    // Subdetail level adjudication details
-}
-
-func (r *ClaimResponseItemDetailSubDetail) Validate() error {
-	if r.SubDetailSequence == 0 {
-		return fmt.Errorf("field 'SubDetailSequence' is required")
+func (r *ClaimResponseTotal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ReviewOutcome != nil {
-		if err := r.ReviewOutcome.Validate(); err != nil {
-			return fmt.Errorf("ReviewOutcome: %w", err)
-		}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
 	}
-	for i, item := range r.Adjudication {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Adjudication[%d]: %w", i, err)
-		}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
 	}
-	return nil
 }
 
-type ClaimResponseAddItemBodySite struct {
-	Id      *string             `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	Site    []CodeableReference `json:"site" bson:"site"`                            // Location
-	SubSite []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"` // Sub-location
+var claimResponseTotalInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ClaimResponseAddItemBodySite) Validate() error {
-	if len(r.Site) < 1 {
-		return fmt.Errorf("field 'Site' must have at least 1 elements")
+func (r *ClaimResponseTotal) invariants() []invariant {
+	return claimResponseTotalInvariants
+}
+
+func (r ClaimResponseTotal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseTotal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseTotal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseTotal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseTotalElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.total.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.total.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.total.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.total.category", Name: "category", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.total.amount", Name: "amount", Field: 4, Min: 1, Max: "1", Types: []string{"Money"}},
+}
+
+func (r *ClaimResponseTotal) ElementMetadata() []ElementMetadata {
+	return claimResponseTotalElementMetadata
+}
+
+type ClaimResponsePayment struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Partial or complete payment
+	Adjustment        *Money           `json:"adjustment,omitempty" bson:"adjustment,omitempty"`                // Payment adjustment for non-claim issues
+	AdjustmentReason  *CodeableConcept `json:"adjustmentReason,omitempty" bson:"adjustment_reason,omitempty"`   // Explanation for the adjustment
+	Date              *Date            `json:"date,omitempty" bson:"date,omitempty"`                            // Expected date of payment
+	DateElement       *Element         `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Amount            *Money           `json:"amount" bson:"amount"`                                            // Payable amount after adjustment
+	Identifier        *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Business identifier for the payment
+}
+
+func (r *ClaimResponsePayment) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	for i, item := range r.Site {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Site[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Adjustment != nil {
+		if err := r.Adjustment.Validate(); err != nil {
+			return fmt.Errorf("Adjustment: %w", err)
 		}
 	}
-	for i, item := range r.SubSite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubSite[%d]: %w", i, err)
+	if r.AdjustmentReason != nil {
+		if err := r.AdjustmentReason.Validate(); err != nil {
+			return fmt.Errorf("AdjustmentReason: %w", err)
 		}
 	}
-	return nil
-}
-
-type ClaimResponseTotal struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Category *CodeableConcept `json:"category" bson:"category"`         // Type of adjudication information
-	Amount   *Money           `json:"amount" bson:"amount"`             // Financial total for the category
-}
-
-func (r *ClaimResponseTotal) Validate() error {
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
 		}
 	}
 	if r.Amount == nil {
@@ -861,323 +4421,522 @@ func (r *ClaimResponseTotal) Validate() error {
 			return fmt.Errorf("Amount: %w", err)
 		}
 	}
+	if r.Identifier != nil {
+		if err := r.Identifier.Validate(); err != nil {
+			return fmt.Errorf("Identifier: %w", err)
+		}
+	}
 	return nil
 }
 
-type ClaimResponseSupportingInfo struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
-	Sequence                   int                    `json:"sequence" bson:"sequence"`                                                            // Information instance identifier
-	Category                   *CodeableConcept       `json:"category" bson:"category"`                                                            // Classification of the supplied information
-	Code                       *CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                                                // Type of information
-	TimingDateTime             *string                `json:"timingDateTime,omitempty" bson:"timing_date_time,omitempty"`                          // When it occurred
-	TimingPeriod               *Period                `json:"timingPeriod,omitempty" bson:"timing_period,omitempty"`                               // When it occurred
-	TimingTiming               *Timing                `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`                               // When it occurred
-	ValueBase64Binary          *string                `json:"valueBase64Binary,omitempty" bson:"value_base64_binary,omitempty"`                    // Data to be provided
-	ValueBoolean               *bool                  `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                               // Data to be provided
-	ValueCanonical             *string                `json:"valueCanonical,omitempty" bson:"value_canonical,omitempty"`                           // Data to be provided
-	ValueCode                  *string                `json:"valueCode,omitempty" bson:"value_code,omitempty"`                                     // Data to be provided
-	ValueDate                  *string                `json:"valueDate,omitempty" bson:"value_date,omitempty"`                                     // Data to be provided
-	ValueDateTime              *string                `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                            // Data to be provided
-	ValueDecimal               *float64               `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`                               // Data to be provided
-	ValueId                    *string                `json:"valueId,omitempty" bson:"value_id,omitempty"`                                         // Data to be provided
-	ValueInstant               *string                `json:"valueInstant,omitempty" bson:"value_instant,omitempty"`                               // Data to be provided
-	ValueInteger               *int                   `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                               // Data to be provided
-	ValueInteger64             *int64                 `json:"valueInteger64,omitempty" bson:"value_integer64,omitempty"`                           // Data to be provided
-	ValueMarkdown              *string                `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                             // Data to be provided
-	ValueOid                   *string                `json:"valueOid,omitempty" bson:"value_oid,omitempty"`                                       // Data to be provided
-	ValuePositiveInt           *int                   `json:"valuePositiveInt,omitempty" bson:"value_positive_int,omitempty"`                      // Data to be provided
-	ValueString                *string                `json:"valueString,omitempty" bson:"value_string,omitempty"`                                 // Data to be provided
-	ValueTime                  *string                `json:"valueTime,omitempty" bson:"value_time,omitempty"`                                     // Data to be provided
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt,omitempty" bson:"value_unsigned_int,omitempty"`                      // Data to be provided
-	ValueUri                   *string                `json:"valueUri,omitempty" bson:"value_uri,omitempty"`                                       // Data to be provided
-	ValueUrl                   *string                `json:"valueUrl,omitempty" bson:"value_url,omitempty"`                                       // Data to be provided
-	ValueUuid                  *uuid                  `json:"valueUuid,omitempty" bson:"value_uuid,omitempty"`                                     // Data to be provided
-	ValueAddress               *Address               `json:"valueAddress,omitempty" bson:"value_address,omitempty"`                               // Data to be provided
-	ValueAge                   *Age                   `json:"valueAge,omitempty" bson:"value_age,omitempty"`                                       // Data to be provided
-	ValueAnnotation            *Annotation            `json:"valueAnnotation,omitempty" bson:"value_annotation,omitempty"`                         // Data to be provided
-	ValueAttachment            *Attachment            `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`                         // Data to be provided
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`              // Data to be provided
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference,omitempty" bson:"value_codeable_reference,omitempty"`          // Data to be provided
-	ValueCoding                *Coding                `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`                                 // Data to be provided
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint,omitempty" bson:"value_contact_point,omitempty"`                    // Data to be provided
-	ValueCount                 *Count                 `json:"valueCount,omitempty" bson:"value_count,omitempty"`                                   // Data to be provided
-	ValueDistance              *Distance              `json:"valueDistance,omitempty" bson:"value_distance,omitempty"`                             // Data to be provided
-	ValueDuration              *Duration              `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`                             // Data to be provided
-	ValueHumanName             *HumanName             `json:"valueHumanName,omitempty" bson:"value_human_name,omitempty"`                          // Data to be provided
-	ValueIdentifier            *Identifier            `json:"valueIdentifier,omitempty" bson:"value_identifier,omitempty"`                         // Data to be provided
-	ValueMoney                 *Money                 `json:"valueMoney,omitempty" bson:"value_money,omitempty"`                                   // Data to be provided
-	ValuePeriod                *Period                `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                                 // Data to be provided
-	ValueQuantity              *Quantity              `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                             // Data to be provided
-	ValueRange                 *Range                 `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                   // Data to be provided
-	ValueRatio                 *Ratio                 `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                                   // Data to be provided
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange,omitempty" bson:"value_ratio_range,omitempty"`                        // Data to be provided
-	ValueReference             *Reference             `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                           // Data to be provided
-	ValueSampledData           *SampledData           `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`                      // Data to be provided
-	ValueSignature             *Signature             `json:"valueSignature,omitempty" bson:"value_signature,omitempty"`                           // Data to be provided
-	ValueTiming                *Timing                `json:"valueTiming,omitempty" bson:"value_timing,omitempty"`                                 // Data to be provided
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail,omitempty" bson:"value_contact_detail,omitempty"`                  // Data to be provided
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement,omitempty" bson:"value_data_requirement,omitempty"`              // Data to be provided
-	ValueExpression            *Expression            `json:"valueExpression,omitempty" bson:"value_expression,omitempty"`                         // Data to be provided
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition,omitempty" bson:"value_parameter_definition,omitempty"`      // Data to be provided
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact,omitempty" bson:"value_related_artifact,omitempty"`              // Data to be provided
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition,omitempty" bson:"value_trigger_definition,omitempty"`          // Data to be provided
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext,omitempty" bson:"value_usage_context,omitempty"`                    // Data to be provided
-	ValueAvailability          *Availability          `json:"valueAvailability,omitempty" bson:"value_availability,omitempty"`                     // Data to be provided
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail,omitempty" bson:"value_extended_contact_detail,omitempty"` // Data to be provided
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail,omitempty" bson:"value_virtual_service_detail,omitempty"`   // Data to be provided
-	ValueDosage                *Dosage                `json:"valueDosage,omitempty" bson:"value_dosage,omitempty"`                                 // Data to be provided
-	ValueMeta                  *Meta                  `json:"valueMeta,omitempty" bson:"value_meta,omitempty"`                                     // Data to be provided
-	Reason                     *CodeableConcept       `json:"reason,omitempty" bson:"reason,omitempty"`                                            // Explanation for the information
-}
-
-func (r *ClaimResponseSupportingInfo) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+func (r *ClaimResponsePayment) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.TimingPeriod != nil {
-		if err := r.TimingPeriod.Validate(); err != nil {
-			return fmt.Errorf("TimingPeriod: %w", err)
-		}
+	if r.Adjustment != nil {
+		r.Adjustment.validateAll(path+".adjustment", issues)
 	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
-		}
+	if r.AdjustmentReason != nil {
+		r.AdjustmentReason.validateAll(path+".adjustmentReason", issues)
 	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
 	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+}
+
+var claimResponsePaymentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponsePayment) invariants() []invariant {
+	return claimResponsePaymentInvariants
+}
+
+func (r ClaimResponsePayment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponsePayment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponsePayment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponsePayment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponsePaymentElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.payment.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.payment.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.payment.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.payment.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.payment.adjustment", Name: "adjustment", Field: 4, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.payment.adjustmentReason", Name: "adjustmentReason", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.payment.date", Name: "date", Field: 6, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ClaimResponse.payment.amount", Name: "amount", Field: 8, Min: 1, Max: "1", Types: []string{"Money"}},
+	{Path: "ClaimResponse.payment.identifier", Name: "identifier", Field: 9, Min: 0, Max: "1", Types: []string{"Identifier"}},
+}
+
+func (r *ClaimResponsePayment) ElementMetadata() []ElementMetadata {
+	return claimResponsePaymentElementMetadata
+}
+
+type ClaimResponseProcessNote struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Class             *CodeableConcept `json:"class,omitempty" bson:"class,omitempty"`                          // Business kind of note
+	Number            *int             `json:"number,omitempty" bson:"number,omitempty"`                        // Note instance identifier
+	NumberElement     *Element         `json:"_number,omitempty" bson:"number_element,omitempty"`               // Extensions for number
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Note purpose
+	Text              string           `json:"text" bson:"text"`                                                // Note explanatory text
+	TextElement       *Element         `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+	Language          *CodeableConcept `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the text
+}
+
+func (r *ClaimResponseProcessNote) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.Class != nil {
+		if err := r.Class.Validate(); err != nil {
+			return fmt.Errorf("Class: %w", err)
 		}
 	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
 		}
 	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
-		}
+	var emptyString string
+	if r.Text == emptyString {
+		return fmt.Errorf("field 'Text' is required")
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
 		}
 	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
+	if r.Language != nil {
+		if err := r.Language.Validate(); err != nil {
+			return fmt.Errorf("Language: %w", err)
 		}
 	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
-		}
+	return nil
+}
+
+func (r *ClaimResponseProcessNote) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
+	if r.Class != nil {
+		r.Class.validateAll(path+".class", issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
-		}
+	var emptyString string
+	if r.Text == emptyString {
+		issues.add("required", path+".text", "field 'Text' is required")
 	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
+}
+
+var claimResponseProcessNoteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseProcessNote) invariants() []invariant {
+	return claimResponseProcessNoteInvariants
+}
+
+func (r ClaimResponseProcessNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseProcessNote) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseProcessNote) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseProcessNote) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseProcessNoteElementTypes = map[string]string{
+	"number": "positiveInt",
+	"text":   "markdown",
+}
+
+func (r *ClaimResponseProcessNote) elementTypes() map[string]string {
+	return claimResponseProcessNoteElementTypes
+}
+
+var claimResponseProcessNoteElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.processNote.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.processNote.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.processNote.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.processNote.class", Name: "class", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.processNote.number", Name: "number", Field: 4, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.processNote.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.processNote.text", Name: "text", Field: 7, Min: 1, Max: "1", Types: []string{"markdown"}},
+	{Path: "ClaimResponse.processNote.language", Name: "language", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClaimResponseProcessNote) ElementMetadata() []ElementMetadata {
+	return claimResponseProcessNoteElementMetadata
+}
+
+type ClaimResponseInsurance struct {
+	Id                         *string     `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	Sequence                   int         `json:"sequence" bson:"sequence"`                                                     // Insurance instance identifier
+	SequenceElement            *Element    `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                        // Extensions for sequence
+	Focal                      bool        `json:"focal" bson:"focal"`                                                           // Coverage to be used for adjudication
+	FocalElement               *Element    `json:"_focal,omitempty" bson:"focal_element,omitempty"`                              // Extensions for focal
+	Coverage                   *Reference  `json:"coverage" bson:"coverage"`                                                     // Insurance information
+	BusinessArrangement        *string     `json:"businessArrangement,omitempty" bson:"business_arrangement,omitempty"`          // Additional provider contract number
+	BusinessArrangementElement *Element    `json:"_businessArrangement,omitempty" bson:"business_arrangement_element,omitempty"` // Extensions for businessArrangement
+	ClaimResponse              *Reference  `json:"claimResponse,omitempty" bson:"claim_response,omitempty"`                      // Adjudication results
+}
+
+func (r *ClaimResponseInsurance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
 		}
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
+	if r.FocalElement != nil {
+		if err := r.FocalElement.Validate(); err != nil {
+			return fmt.Errorf("FocalElement: %w", err)
 		}
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	if r.Coverage == nil {
+		return fmt.Errorf("field 'Coverage' is required")
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
+	if r.Coverage != nil {
+		if err := r.Coverage.Validate(); err != nil {
+			return fmt.Errorf("Coverage: %w", err)
 		}
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
+	if r.BusinessArrangementElement != nil {
+		if err := r.BusinessArrangementElement.Validate(); err != nil {
+			return fmt.Errorf("BusinessArrangementElement: %w", err)
 		}
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
+	if r.ClaimResponse != nil {
+		if err := r.ClaimResponse.Validate(); err != nil {
+			return fmt.Errorf("ClaimResponse: %w", err)
 		}
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
+	return nil
+}
+
+func (r *ClaimResponseInsurance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.FocalElement != nil {
+		r.FocalElement.validateAll(path+".focal", issues)
+	}
+	if r.Coverage == nil {
+		issues.add("required", path+".coverage", "field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+	if r.BusinessArrangementElement != nil {
+		r.BusinessArrangementElement.validateAll(path+".businessArrangement", issues)
+	}
+	if r.ClaimResponse != nil {
+		r.ClaimResponse.validateAll(path+".claimResponse", issues)
+	}
+}
+
+var claimResponseInsuranceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseInsurance) invariants() []invariant {
+	return claimResponseInsuranceInvariants
+}
+
+func (r ClaimResponseInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseInsurance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseInsuranceElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ClaimResponseInsurance) elementTypes() map[string]string {
+	return claimResponseInsuranceElementTypes
+}
+
+var claimResponseInsuranceElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.insurance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.insurance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.insurance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.insurance.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.insurance.focal", Name: "focal", Field: 5, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "ClaimResponse.insurance.coverage", Name: "coverage", Field: 7, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "ClaimResponse.insurance.businessArrangement", Name: "businessArrangement", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.insurance.claimResponse", Name: "claimResponse", Field: 10, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ClaimResponseInsurance) ElementMetadata() []ElementMetadata {
+	return claimResponseInsuranceElementMetadata
+}
+
+type ClaimResponseError struct {
+	Id                       *string          `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
+	Extension                []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored even if unrecognized
+	ItemSequence             *int             `json:"itemSequence,omitempty" bson:"item_sequence,omitempty"`                     // Item sequence number
+	ItemSequenceElement      *Element         `json:"_itemSequence,omitempty" bson:"item_sequence_element,omitempty"`            // Extensions for itemSequence
+	DetailSequence           *int             `json:"detailSequence,omitempty" bson:"detail_sequence,omitempty"`                 // Detail sequence number
+	DetailSequenceElement    *Element         `json:"_detailSequence,omitempty" bson:"detail_sequence_element,omitempty"`        // Extensions for detailSequence
+	SubDetailSequence        *int             `json:"subDetailSequence,omitempty" bson:"sub_detail_sequence,omitempty"`          // Subdetail sequence number
+	SubDetailSequenceElement *Element         `json:"_subDetailSequence,omitempty" bson:"sub_detail_sequence_element,omitempty"` // Extensions for subDetailSequence
+	Code                     *CodeableConcept `json:"code" bson:"code"`                                                          // Error code detailing processing issues
+	Expression               []string         `json:"expression,omitempty" bson:"expression,omitempty"`                          // FHIRPath of element(s) related to issue
+	ExpressionElement        []*Element       `json:"_expression,omitempty" bson:"expression_element,omitempty"`                 // Extensions for expression
+}
+
+func (r *ClaimResponseError) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
+	if r.ItemSequenceElement != nil {
+		if err := r.ItemSequenceElement.Validate(); err != nil {
+			return fmt.Errorf("ItemSequenceElement: %w", err)
 		}
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
+	if r.DetailSequenceElement != nil {
+		if err := r.DetailSequenceElement.Validate(); err != nil {
+			return fmt.Errorf("DetailSequenceElement: %w", err)
 		}
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
+	if r.SubDetailSequenceElement != nil {
+		if err := r.SubDetailSequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SubDetailSequenceElement: %w", err)
 		}
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.Reason != nil {
-		if err := r.Reason.Validate(); err != nil {
-			return fmt.Errorf("Reason: %w", err)
+	for i, item := range r.ExpressionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type ClaimResponseItemDetail struct {
-	Id             *string                            `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	DetailSequence int                                `json:"detailSequence" bson:"detail_sequence"`                   // Claim detail instance identifier
-	TraceNumber    []Identifier                       `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`     // Number for tracking
-	NoteNumber     []int                              `json:"noteNumber,omitempty" bson:"note_number,omitempty"`       // Applicable note numbers
-	ReviewOutcome  *ClaimResponseItemReviewOutcome    `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"` // Detail level adjudication results
-	Adjudication   []ClaimResponseItemAdjudication    `json:"adjudication,omitempty" bson:"adjudication,omitempty"`    // Detail level adjudication details
-	SubDetail      []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`         // Adjudication for claim sub-details
-}
-
-func (r *ClaimResponseItemDetail) Validate() error {
-	if r.DetailSequence == 0 {
-		return fmt.Errorf("field 'DetailSequence' is required")
+func (r *ClaimResponseError) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ReviewOutcome != nil {
-		if err := r.ReviewOutcome.Validate(); err != nil {
-			return fmt.Errorf("ReviewOutcome: %w", err)
-		}
+	if r.ItemSequenceElement != nil {
+		r.ItemSequenceElement.validateAll(path+".itemSequence", issues)
 	}
-	for i, item := range r.Adjudication {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Adjudication[%d]: %w", i, err)
-		}
+	if r.DetailSequenceElement != nil {
+		r.DetailSequenceElement.validateAll(path+".detailSequence", issues)
 	}
-	for i, item := range r.SubDetail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubDetail[%d]: %w", i, err)
+	if r.SubDetailSequenceElement != nil {
+		r.SubDetailSequenceElement.validateAll(path+".subDetailSequence", issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.ExpressionElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.expression[%d]", path, i), issues)
+	}
+}
+
+var claimResponseErrorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClaimResponseError) invariants() []invariant {
+	return claimResponseErrorInvariants
+}
+
+func (r ClaimResponseError) MarshalJSON() ([]byte, error) {
+	type alias ClaimResponseError
+	out := struct {
+		alias
+		Expression        []*string  `json:"expression,omitempty"`
+		ExpressionElement []*Element `json:"_expression,omitempty"`
+	}{alias: alias(r)}
+	out.Expression, out.ExpressionElement = alignPrimitiveArray(r.Expression, r.ExpressionElement)
+	return json.Marshal(out)
+}
+
+func (r *ClaimResponseError) UnmarshalJSON(data []byte) error {
+	type alias ClaimResponseError
+	aux := struct {
+		*alias
+		Expression []*string `json:"expression,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Expression, err = splitPrimitiveArray(aux.Expression, r.ExpressionElement); err != nil {
+		return fmt.Errorf("expression: %w", err)
 	}
 	return nil
 }
+
+func (r ClaimResponseError) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClaimResponseError) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClaimResponseError) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClaimResponseError) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var claimResponseErrorElementTypes = map[string]string{
+	"itemSequence":      "positiveInt",
+	"detailSequence":    "positiveInt",
+	"subDetailSequence": "positiveInt",
+}
+
+func (r *ClaimResponseError) elementTypes() map[string]string {
+	return claimResponseErrorElementTypes
+}
+
+var claimResponseErrorElementMetadata = []ElementMetadata{
+	{Path: "ClaimResponse.error.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClaimResponse.error.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClaimResponse.error.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClaimResponse.error.itemSequence", Name: "itemSequence", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.error.detailSequence", Name: "detailSequence", Field: 5, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.error.subDetailSequence", Name: "subDetailSequence", Field: 7, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ClaimResponse.error.code", Name: "code", Field: 9, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClaimResponse.error.expression", Name: "expression", Field: 10, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *ClaimResponseError) ElementMetadata() []ElementMetadata {
+	return claimResponseErrorElementMetadata
+}
@@ -2,30 +2,37 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A single issue - either an indication, contraindication, interaction, undesirable effect or warning for a medicinal product, medication, device or procedure.
 type ClinicalUseDefinition struct {
-	ResourceType      string                                  `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta                                   `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string                                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string                                 `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative                              `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage                       `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Identifier        []Identifier                            `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Business identifier for this issue
-	Type              string                                  `json:"type" bson:"type"`                                                // indication | contraindication | interaction | undesirable-effect | warning
-	Category          []CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                    // A categorisation of the issue, primarily for dividing warnings into subject heading areas such as "Pregnancy", "Overdose"
-	Subject           []CodeableReference                     `json:"subject" bson:"subject"`                                          // The medication, product, substance, device, procedure etc. for which this is an indication, contraindication, interaction, undesirable effect, or warning
-	Status            *CodeableConcept                        `json:"status,omitempty" bson:"status,omitempty"`                        // Whether this is a current issue or one that has been retired etc
-	UndesirableEffect *ClinicalUseDefinitionUndesirableEffect `json:"undesirableEffect,omitempty" bson:"undesirable_effect,omitempty"` // A possible negative outcome from the use of this treatment
-	Indication        *ClinicalUseDefinitionIndication        `json:"indication,omitempty" bson:"indication,omitempty"`                // Specifics for when this is an indication
-	Contraindication  *ClinicalUseDefinitionContraindication  `json:"contraindication,omitempty" bson:"contraindication,omitempty"`    // Specifics for when this is a contraindication
-	Interaction       *ClinicalUseDefinitionInteraction       `json:"interaction,omitempty" bson:"interaction,omitempty"`              // Specifics for when this is an interaction
-	Population        []Reference                             `json:"population,omitempty" bson:"population,omitempty"`                // The population group to which this applies
-	Library           []string                                `json:"library,omitempty" bson:"library,omitempty"`                      // Logic used by the clinical use definition
-	Warning           *ClinicalUseDefinitionWarning           `json:"warning,omitempty" bson:"warning,omitempty"`                      // Critical environmental, health or physical risks or hazards. For example 'Do not operate heavy machinery', 'May cause drowsiness'
+	ResourceType         string                                  `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                                   `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                                 `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                                `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                              `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                              `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                            `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier for this issue
+	Type                 ClinicalUseDefinitionType               `json:"type" bson:"type"`                                                 // indication | contraindication | interaction | undesirable-effect | warning
+	TypeElement          *Element                                `json:"_type,omitempty" bson:"type_element,omitempty"`                    // Extensions for type
+	Category             []CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                     // A categorisation of the issue, primarily for dividing warnings into subject heading areas such as "Pregnancy", "Overdose"
+	Subject              []CodeableReference                     `json:"subject" bson:"subject"`                                           // The medication, product, substance, device, procedure etc. for which this is an indication, contraindication, interaction, undesirable effect, or warning
+	Status               *CodeableConcept                        `json:"status,omitempty" bson:"status,omitempty"`                         // Whether this is a current issue or one that has been retired etc
+	UndesirableEffect    *ClinicalUseDefinitionUndesirableEffect `json:"undesirableEffect,omitempty" bson:"undesirable_effect,omitempty"`  // A possible negative outcome from the use of this treatment
+	Indication           *ClinicalUseDefinitionIndication        `json:"indication,omitempty" bson:"indication,omitempty"`                 // Specifics for when this is an indication
+	Contraindication     *ClinicalUseDefinitionContraindication  `json:"contraindication,omitempty" bson:"contraindication,omitempty"`     // Specifics for when this is a contraindication
+	Interaction          *ClinicalUseDefinitionInteraction       `json:"interaction,omitempty" bson:"interaction,omitempty"`               // Specifics for when this is an interaction
+	Population           []Reference                             `json:"population,omitempty" bson:"population,omitempty"`                 // The population group to which this applies
+	Library              []string                                `json:"library,omitempty" bson:"library,omitempty"`                       // Logic used by the clinical use definition
+	LibraryElement       []*Element                              `json:"_library,omitempty" bson:"library_element,omitempty"`              // Extensions for library
+	Warning              *ClinicalUseDefinitionWarning           `json:"warning,omitempty" bson:"warning,omitempty"`                       // Critical environmental, health or physical risks or hazards. For example 'Do not operate heavy machinery', 'May cause drowsiness'
 }
 
 func (r *ClinicalUseDefinition) Validate() error {
@@ -37,20 +44,55 @@ func (r *ClinicalUseDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Type == emptyString {
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -94,190 +136,254 @@ func (r *ClinicalUseDefinition) Validate() error {
 			return fmt.Errorf("Population[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LibraryElement[%d]: %w", i, err)
+		}
+	}
 	if r.Warning != nil {
 		if err := r.Warning.Validate(); err != nil {
 			return fmt.Errorf("Warning: %w", err)
 		}
 	}
-	return nil
-}
-
-type ClinicalUseDefinitionIndication struct {
-	Id                      *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	DiseaseSymptomProcedure *CodeableReference                            `json:"diseaseSymptomProcedure,omitempty" bson:"disease_symptom_procedure,omitempty"` // The situation that is being documented as an indication for this item
-	DiseaseStatus           *CodeableReference                            `json:"diseaseStatus,omitempty" bson:"disease_status,omitempty"`                      // The status of the disease or symptom for the indication
-	Comorbidity             []CodeableReference                           `json:"comorbidity,omitempty" bson:"comorbidity,omitempty"`                           // A comorbidity or coinfection as part of the indication
-	IntendedEffect          []CodeableReference                           `json:"intendedEffect,omitempty" bson:"intended_effect,omitempty"`                    // The intended effect, aim or strategy to be achieved
-	DurationRange           *Range                                        `json:"durationRange,omitempty" bson:"duration_range,omitempty"`                      // Timing or duration information
-	DurationString          *string                                       `json:"durationString,omitempty" bson:"duration_string,omitempty"`                    // Timing or duration information
-	UndesirableEffect       []ClinicalUseDefinitionUndesirableEffect      `json:"undesirableEffect,omitempty" bson:"undesirable_effect,omitempty"`              // An unwanted side effect or negative outcome of the subject of this resource when being used for this indication
-	Applicability           *Expression                                   `json:"applicability,omitempty" bson:"applicability,omitempty"`                       // An expression that returns true or false, indicating whether the indication is applicable or not, after having applied its other elements
-	OtherTherapy            []ClinicalUseDefinitionIndicationOtherTherapy `json:"otherTherapy,omitempty" bson:"other_therapy,omitempty"`                        // Information about use of the product in relation to other therapies described as part of the contraindication
+	return validateInvariants(r, "ClinicalUseDefinition")
 }
 
-func (r *ClinicalUseDefinitionIndication) Validate() error {
-	if r.DiseaseSymptomProcedure != nil {
-		if err := r.DiseaseSymptomProcedure.Validate(); err != nil {
-			return fmt.Errorf("DiseaseSymptomProcedure: %w", err)
-		}
+func (r *ClinicalUseDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ClinicalUseDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ClinicalUseDefinition', got '%s'", r.ResourceType))
 	}
-	if r.DiseaseStatus != nil {
-		if err := r.DiseaseStatus.Validate(); err != nil {
-			return fmt.Errorf("DiseaseStatus: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	for i, item := range r.Comorbidity {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Comorbidity[%d]: %w", i, err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	for i, item := range r.IntendedEffect {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("IntendedEffect[%d]: %w", i, err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.DurationRange != nil {
-		if err := r.DurationRange.Validate(); err != nil {
-			return fmt.Errorf("DurationRange: %w", err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	for i, item := range r.UndesirableEffect {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("UndesirableEffect[%d]: %w", i, err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.Applicability != nil {
-		if err := r.Applicability.Validate(); err != nil {
-			return fmt.Errorf("Applicability: %w", err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.OtherTherapy {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("OtherTherapy[%d]: %w", i, err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ClinicalUseDefinitionIndicationOtherTherapy struct {
-	Id               *string            `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	RelationshipType *CodeableConcept   `json:"relationshipType" bson:"relationship_type"` // The type of relationship between the product indication/contraindication and another therapy
-	Treatment        *CodeableReference `json:"treatment" bson:"treatment"`                // Reference to a specific medication, substance etc. as part of an indication or contraindication
-}
-
-func (r *ClinicalUseDefinitionIndicationOtherTherapy) Validate() error {
-	if r.RelationshipType == nil {
-		return fmt.Errorf("field 'RelationshipType' is required")
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.RelationshipType != nil {
-		if err := r.RelationshipType.Validate(); err != nil {
-			return fmt.Errorf("RelationshipType: %w", err)
-		}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	if r.Treatment == nil {
-		return fmt.Errorf("field 'Treatment' is required")
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
 	}
-	if r.Treatment != nil {
-		if err := r.Treatment.Validate(); err != nil {
-			return fmt.Errorf("Treatment: %w", err)
-		}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
 	}
-	return nil
-}
-
-type ClinicalUseDefinitionInteraction struct {
-	Id          *string                                       `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Interactant []ClinicalUseDefinitionInteractionInteractant `json:"interactant,omitempty" bson:"interactant,omitempty"` // The specific medication, product, food etc. or laboratory test that interacts
-	Type        *CodeableConcept                              `json:"type,omitempty" bson:"type,omitempty"`               // The type of the interaction e.g. drug-drug interaction, drug-lab test interaction
-	Effect      *CodeableReference                            `json:"effect,omitempty" bson:"effect,omitempty"`           // The effect of the interaction, for example "reduced gastric absorption of primary medication"
-	Incidence   *CodeableConcept                              `json:"incidence,omitempty" bson:"incidence,omitempty"`     // The incidence of the interaction, e.g. theoretical, observed
-	Management  []CodeableConcept                             `json:"management,omitempty" bson:"management,omitempty"`   // Actions for managing the interaction
-	Severity    *CodeableConcept                              `json:"severity,omitempty" bson:"severity,omitempty"`       // The severity of the interaction
-}
-
-func (r *ClinicalUseDefinitionInteraction) Validate() error {
-	for i, item := range r.Interactant {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Interactant[%d]: %w", i, err)
-		}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if len(r.Subject) < 1 {
+		issues.add("required", path+".subject", "field 'Subject' must have at least 1 elements")
 	}
-	if r.Effect != nil {
-		if err := r.Effect.Validate(); err != nil {
-			return fmt.Errorf("Effect: %w", err)
-		}
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
 	}
-	if r.Incidence != nil {
-		if err := r.Incidence.Validate(); err != nil {
-			return fmt.Errorf("Incidence: %w", err)
-		}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
 	}
-	for i, item := range r.Management {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Management[%d]: %w", i, err)
-		}
+	if r.UndesirableEffect != nil {
+		r.UndesirableEffect.validateAll(path+".undesirableEffect", issues)
 	}
-	if r.Severity != nil {
-		if err := r.Severity.Validate(); err != nil {
-			return fmt.Errorf("Severity: %w", err)
+	if r.Indication != nil {
+		r.Indication.validateAll(path+".indication", issues)
+	}
+	if r.Contraindication != nil {
+		r.Contraindication.validateAll(path+".contraindication", issues)
+	}
+	if r.Interaction != nil {
+		r.Interaction.validateAll(path+".interaction", issues)
+	}
+	for i, item := range r.Population {
+		item.validateAll(fmt.Sprintf("%s.population[%d]", path, i), issues)
+	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.library[%d]", path, i), issues)
+	}
+	if r.Warning != nil {
+		r.Warning.validateAll(path+".warning", issues)
 	}
-	return nil
 }
 
-type ClinicalUseDefinitionInteractionInteractant struct {
-	Id                  *string          `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	ItemReference       *Reference       `json:"itemReference" bson:"item_reference"`              // The specific medication, product, food etc. or laboratory test that interacts
-	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept" bson:"item_codeable_concept"` // The specific medication, product, food etc. or laboratory test that interacts
-	Route               *CodeableConcept `json:"route,omitempty" bson:"route,omitempty"`           // The route by which the item is administered to cause the interaction
+func (r *ClinicalUseDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ClinicalUseDefinition", &issues)
+	checkInvariants(r, "ClinicalUseDefinition", &issues)
+	return issues
 }
 
-func (r *ClinicalUseDefinitionInteractionInteractant) Validate() error {
-	if r.ItemReference == nil {
-		return fmt.Errorf("field 'ItemReference' is required")
-	}
-	if r.ItemReference != nil {
-		if err := r.ItemReference.Validate(); err != nil {
-			return fmt.Errorf("ItemReference: %w", err)
-		}
-	}
-	if r.ItemCodeableConcept == nil {
-		return fmt.Errorf("field 'ItemCodeableConcept' is required")
+var clinicalUseDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ClinicalUseDefinition) invariants() []invariant {
+	return clinicalUseDefinitionInvariants
+}
+
+func (r ClinicalUseDefinition) MarshalJSON() ([]byte, error) {
+	type alias ClinicalUseDefinition
+	out := struct {
+		alias
+		Library        []*string  `json:"library,omitempty"`
+		LibraryElement []*Element `json:"_library,omitempty"`
+	}{alias: alias(r)}
+	out.Library, out.LibraryElement = alignPrimitiveArray(r.Library, r.LibraryElement)
+	return json.Marshal(out)
+}
+
+func (r *ClinicalUseDefinition) UnmarshalJSON(data []byte) error {
+	type alias ClinicalUseDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Library   []*string         `json:"library,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ItemCodeableConcept != nil {
-		if err := r.ItemCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ItemCodeableConcept: %w", err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.Route != nil {
-		if err := r.Route.Validate(); err != nil {
-			return fmt.Errorf("Route: %w", err)
-		}
+	if r.Library, err = splitPrimitiveArray(aux.Library, r.LibraryElement); err != nil {
+		return fmt.Errorf("library: %w", err)
 	}
 	return nil
 }
 
-type ClinicalUseDefinitionWarning struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Description *string          `json:"description,omitempty" bson:"description,omitempty"` // A textual definition of this warning, with formatting
-	Code        *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`               // A coded or unformatted textual definition of this warning
+func (r ClinicalUseDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ClinicalUseDefinitionWarning) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+func (r *ClinicalUseDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var clinicalUseDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"library":       "canonical",
+}
+
+func (r *ClinicalUseDefinition) elementTypes() map[string]string {
+	return clinicalUseDefinitionElementTypes
+}
+
+var clinicalUseDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ClinicalUseDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ClinicalUseDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ClinicalUseDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ClinicalUseDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ClinicalUseDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ClinicalUseDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ClinicalUseDefinition.type", Name: "type", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ClinicalUseDefinition.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/clinical-use-definition-type"}},
+	{Path: "ClinicalUseDefinition.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.subject", Name: "subject", Field: 15, Min: 1, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.status", Name: "status", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.undesirableEffect", Name: "undesirableEffect", Field: 17, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.indication", Name: "indication", Field: 18, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.contraindication", Name: "contraindication", Field: 19, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.interaction", Name: "interaction", Field: 20, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.population", Name: "population", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ClinicalUseDefinition.library", Name: "library", Field: 22, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ClinicalUseDefinition.warning", Name: "warning", Field: 24, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClinicalUseDefinition) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionElementMetadata
+}
+
+func (r *ClinicalUseDefinition) GetResourceType() string {
+	return "ClinicalUseDefinition"
+}
+
+func (r *ClinicalUseDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	return nil
+	return *r.Id
+}
+
+func (r *ClinicalUseDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ClinicalUseDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ClinicalUseDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
 }
 
+func (r *ClinicalUseDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ClinicalUseDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ClinicalUseDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ClinicalUseDefinition)(nil)
+
 type ClinicalUseDefinitionUndesirableEffect struct {
 	Id                     *string            `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
+	Extension              []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                             // Additional content defined by implementations
+	ModifierExtension      []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`            // Extensions that cannot be ignored even if unrecognized
 	SymptomConditionEffect *CodeableReference `json:"symptomConditionEffect,omitempty" bson:"symptom_condition_effect,omitempty"` // The situation in which the undesirable effect may manifest
 	Classification         *CodeableConcept   `json:"classification,omitempty" bson:"classification,omitempty"`                   // High level classification of the effect
 	FrequencyOfOccurrence  *CodeableConcept   `json:"frequencyOfOccurrence,omitempty" bson:"frequency_of_occurrence,omitempty"`   // How often the effect is seen
@@ -285,6 +391,16 @@ type ClinicalUseDefinitionUndesirableEffect struct {
 }
 
 func (r *ClinicalUseDefinitionUndesirableEffect) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.SymptomConditionEffect != nil {
 		if err := r.SymptomConditionEffect.Validate(); err != nil {
 			return fmt.Errorf("SymptomConditionEffect: %w", err)
@@ -308,18 +424,93 @@ func (r *ClinicalUseDefinitionUndesirableEffect) Validate() error {
 	return nil
 }
 
-type ClinicalUseDefinitionContraindication struct {
+func (r *ClinicalUseDefinitionUndesirableEffect) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SymptomConditionEffect != nil {
+		r.SymptomConditionEffect.validateAll(path+".symptomConditionEffect", issues)
+	}
+	if r.Classification != nil {
+		r.Classification.validateAll(path+".classification", issues)
+	}
+	if r.FrequencyOfOccurrence != nil {
+		r.FrequencyOfOccurrence.validateAll(path+".frequencyOfOccurrence", issues)
+	}
+	for i, item := range r.Management {
+		item.validateAll(fmt.Sprintf("%s.management[%d]", path, i), issues)
+	}
+}
+
+var clinicalUseDefinitionUndesirableEffectInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionUndesirableEffect) invariants() []invariant {
+	return clinicalUseDefinitionUndesirableEffectInvariants
+}
+
+func (r ClinicalUseDefinitionUndesirableEffect) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionUndesirableEffect) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionUndesirableEffect) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionUndesirableEffect) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var clinicalUseDefinitionUndesirableEffectElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.undesirableEffect.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.undesirableEffect.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.undesirableEffect.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.undesirableEffect.symptomConditionEffect", Name: "symptomConditionEffect", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.undesirableEffect.classification", Name: "classification", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.undesirableEffect.frequencyOfOccurrence", Name: "frequencyOfOccurrence", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.undesirableEffect.management", Name: "management", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClinicalUseDefinitionUndesirableEffect) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionUndesirableEffectElementMetadata
+}
+
+type ClinicalUseDefinitionIndication struct {
 	Id                      *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	DiseaseSymptomProcedure *CodeableReference                            `json:"diseaseSymptomProcedure,omitempty" bson:"disease_symptom_procedure,omitempty"` // The situation that is being documented as contraindicating against this item
-	DiseaseStatus           *CodeableReference                            `json:"diseaseStatus,omitempty" bson:"disease_status,omitempty"`                      // The status of the disease or symptom for the contraindication
-	Comorbidity             []CodeableReference                           `json:"comorbidity,omitempty" bson:"comorbidity,omitempty"`                           // A comorbidity (concurrent condition) or coinfection
-	Indication              []ClinicalUseDefinitionIndication             `json:"indication,omitempty" bson:"indication,omitempty"`                             // The indication which this is a contraindication for
+	Extension               []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension       []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	DiseaseSymptomProcedure *CodeableReference                            `json:"diseaseSymptomProcedure,omitempty" bson:"disease_symptom_procedure,omitempty"` // The situation that is being documented as an indication for this item
+	DiseaseStatus           *CodeableReference                            `json:"diseaseStatus,omitempty" bson:"disease_status,omitempty"`                      // The status of the disease or symptom for the indication
+	Comorbidity             []CodeableReference                           `json:"comorbidity,omitempty" bson:"comorbidity,omitempty"`                           // A comorbidity or coinfection as part of the indication
+	IntendedEffect          []CodeableReference                           `json:"intendedEffect,omitempty" bson:"intended_effect,omitempty"`                    // The intended effect, aim or strategy to be achieved
+	Duration                ClinicalUseDefinitionIndicationDuration       `json:"-" bson:"duration,omitempty"`                                                  // Timing or duration information
+	DurationElement         *Element                                      `json:"-" bson:"duration_element,omitempty"`                                          // Extensions for duration[x]
+	UndesirableEffect       []ClinicalUseDefinitionUndesirableEffect      `json:"undesirableEffect,omitempty" bson:"undesirable_effect,omitempty"`              // An unwanted side effect or negative outcome of the subject of this resource when being used for this indication
 	Applicability           *Expression                                   `json:"applicability,omitempty" bson:"applicability,omitempty"`                       // An expression that returns true or false, indicating whether the indication is applicable or not, after having applied its other elements
-	Management              []CodeableConcept                             `json:"management,omitempty" bson:"management,omitempty"`                             // Actions for managing the contraindication
 	OtherTherapy            []ClinicalUseDefinitionIndicationOtherTherapy `json:"otherTherapy,omitempty" bson:"other_therapy,omitempty"`                        // Information about use of the product in relation to other therapies described as part of the contraindication
+
+	durationVariants []string // JSON properties of ClinicalUseDefinition.indication.duration[x] when more than one was decoded
 }
 
-func (r *ClinicalUseDefinitionContraindication) Validate() error {
+func (r *ClinicalUseDefinitionIndication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.DiseaseSymptomProcedure != nil {
 		if err := r.DiseaseSymptomProcedure.Validate(); err != nil {
 			return fmt.Errorf("DiseaseSymptomProcedure: %w", err)
@@ -335,9 +526,27 @@ func (r *ClinicalUseDefinitionContraindication) Validate() error {
 			return fmt.Errorf("Comorbidity[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Indication {
+	for i, item := range r.IntendedEffect {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Indication[%d]: %w", i, err)
+			return fmt.Errorf("IntendedEffect[%d]: %w", i, err)
+		}
+	}
+	if len(r.durationVariants) > 1 {
+		return fmt.Errorf("field 'Duration' must have a single type, got %v", r.durationVariants)
+	}
+	if r.Duration != nil {
+		if err := r.Duration.Validate(); err != nil {
+			return fmt.Errorf("Duration: %w", err)
+		}
+	}
+	if r.DurationElement != nil {
+		if err := r.DurationElement.Validate(); err != nil {
+			return fmt.Errorf("DurationElement: %w", err)
+		}
+	}
+	for i, item := range r.UndesirableEffect {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("UndesirableEffect[%d]: %w", i, err)
 		}
 	}
 	if r.Applicability != nil {
@@ -345,9 +554,339 @@ func (r *ClinicalUseDefinitionContraindication) Validate() error {
 			return fmt.Errorf("Applicability: %w", err)
 		}
 	}
-	for i, item := range r.Management {
+	for i, item := range r.OtherTherapy {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Management[%d]: %w", i, err)
+			return fmt.Errorf("OtherTherapy[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ClinicalUseDefinitionIndication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DiseaseSymptomProcedure != nil {
+		r.DiseaseSymptomProcedure.validateAll(path+".diseaseSymptomProcedure", issues)
+	}
+	if r.DiseaseStatus != nil {
+		r.DiseaseStatus.validateAll(path+".diseaseStatus", issues)
+	}
+	for i, item := range r.Comorbidity {
+		item.validateAll(fmt.Sprintf("%s.comorbidity[%d]", path, i), issues)
+	}
+	for i, item := range r.IntendedEffect {
+		item.validateAll(fmt.Sprintf("%s.intendedEffect[%d]", path, i), issues)
+	}
+	if len(r.durationVariants) > 1 {
+		issues.add("structure", path+".duration", fmt.Sprintf("field 'Duration' must have a single type, got %v", r.durationVariants))
+	}
+	if r.Duration != nil {
+		r.Duration.validateAll(path+".duration.ofType("+r.Duration.FHIRType()+")", issues)
+	}
+	if r.DurationElement != nil {
+		r.DurationElement.validateAll(path+".duration", issues)
+	}
+	for i, item := range r.UndesirableEffect {
+		item.validateAll(fmt.Sprintf("%s.undesirableEffect[%d]", path, i), issues)
+	}
+	if r.Applicability != nil {
+		r.Applicability.validateAll(path+".applicability", issues)
+	}
+	for i, item := range r.OtherTherapy {
+		item.validateAll(fmt.Sprintf("%s.otherTherapy[%d]", path, i), issues)
+	}
+}
+
+var clinicalUseDefinitionIndicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionIndication) invariants() []invariant {
+	return clinicalUseDefinitionIndicationInvariants
+}
+
+func (r ClinicalUseDefinitionIndication) MarshalJSON() ([]byte, error) {
+	type alias ClinicalUseDefinitionIndication
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "duration", r.Duration, r.DurationElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClinicalUseDefinitionIndication) UnmarshalJSON(data []byte) error {
+	type alias ClinicalUseDefinitionIndication
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Duration, element, r.durationVariants, err = unmarshalChoice(fields, "duration", clinicalUseDefinitionIndicationDurationVariants); err != nil {
+		return err
+	}
+	r.DurationElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DurationElement); err != nil {
+			return fmt.Errorf("_duration: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ClinicalUseDefinitionIndication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionIndication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionIndication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionIndication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClinicalUseDefinitionIndication) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Duration":
+		return "duration", choiceValues(clinicalUseDefinitionIndicationDurationVariants)
+	}
+	return "", nil
+}
+
+var clinicalUseDefinitionIndicationElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.indication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.indication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.indication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.indication.diseaseSymptomProcedure", Name: "diseaseSymptomProcedure", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.indication.diseaseStatus", Name: "diseaseStatus", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.indication.comorbidity", Name: "comorbidity", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.indication.intendedEffect", Name: "intendedEffect", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.indication.duration[x]", Name: "duration", Field: 7, Min: 0, Max: "1", Types: []string{"Range", "string"}},
+	{Path: "ClinicalUseDefinition.indication.undesirableEffect", Name: "undesirableEffect", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.indication.applicability", Name: "applicability", Field: 10, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "ClinicalUseDefinition.indication.otherTherapy", Name: "otherTherapy", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClinicalUseDefinitionIndication) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionIndicationElementMetadata
+}
+
+// ClinicalUseDefinitionIndicationDuration is the type of ClinicalUseDefinition.indication.duration[x]. It is implemented by the ClinicalUseDefinitionIndicationDuration* variant types.
+type ClinicalUseDefinitionIndicationDuration interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClinicalUseDefinitionIndicationDuration()
+}
+
+// ClinicalUseDefinitionIndicationDurationRange is the Range variant of ClinicalUseDefinitionIndicationDuration.
+type ClinicalUseDefinitionIndicationDurationRange struct {
+	Range
+}
+
+func (v ClinicalUseDefinitionIndicationDurationRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ClinicalUseDefinitionIndicationDurationRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ClinicalUseDefinitionIndicationDurationRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ClinicalUseDefinitionIndicationDurationRange) isClinicalUseDefinitionIndicationDuration() {}
+
+// ClinicalUseDefinitionIndicationDurationString is the string variant of ClinicalUseDefinitionIndicationDuration.
+type ClinicalUseDefinitionIndicationDurationString string
+
+func (v ClinicalUseDefinitionIndicationDurationString) Validate() error {
+	return nil
+}
+
+func (v ClinicalUseDefinitionIndicationDurationString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ClinicalUseDefinitionIndicationDurationString) FHIRType() string {
+	return "string"
+}
+
+func (v ClinicalUseDefinitionIndicationDurationString) isClinicalUseDefinitionIndicationDuration() {}
+
+var clinicalUseDefinitionIndicationDurationVariants = []ClinicalUseDefinitionIndicationDuration{
+	ClinicalUseDefinitionIndicationDurationRange{},
+	ClinicalUseDefinitionIndicationDurationString(""),
+}
+
+type ClinicalUseDefinitionIndicationOtherTherapy struct {
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	RelationshipType  *CodeableConcept   `json:"relationshipType" bson:"relationship_type"`                       // The type of relationship between the product indication/contraindication and another therapy
+	Treatment         *CodeableReference `json:"treatment" bson:"treatment"`                                      // Reference to a specific medication, substance etc. as part of an indication or contraindication
+}
+
+func (r *ClinicalUseDefinitionIndicationOtherTherapy) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.RelationshipType == nil {
+		return fmt.Errorf("field 'RelationshipType' is required")
+	}
+	if r.RelationshipType != nil {
+		if err := r.RelationshipType.Validate(); err != nil {
+			return fmt.Errorf("RelationshipType: %w", err)
+		}
+	}
+	if r.Treatment == nil {
+		return fmt.Errorf("field 'Treatment' is required")
+	}
+	if r.Treatment != nil {
+		if err := r.Treatment.Validate(); err != nil {
+			return fmt.Errorf("Treatment: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClinicalUseDefinitionIndicationOtherTherapy) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.RelationshipType == nil {
+		issues.add("required", path+".relationshipType", "field 'RelationshipType' is required")
+	}
+	if r.RelationshipType != nil {
+		r.RelationshipType.validateAll(path+".relationshipType", issues)
+	}
+	if r.Treatment == nil {
+		issues.add("required", path+".treatment", "field 'Treatment' is required")
+	}
+	if r.Treatment != nil {
+		r.Treatment.validateAll(path+".treatment", issues)
+	}
+}
+
+var clinicalUseDefinitionIndicationOtherTherapyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionIndicationOtherTherapy) invariants() []invariant {
+	return clinicalUseDefinitionIndicationOtherTherapyInvariants
+}
+
+func (r ClinicalUseDefinitionIndicationOtherTherapy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionIndicationOtherTherapy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionIndicationOtherTherapy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionIndicationOtherTherapy) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var clinicalUseDefinitionIndicationOtherTherapyElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.indication.otherTherapy.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.indication.otherTherapy.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.indication.otherTherapy.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.indication.otherTherapy.relationshipType", Name: "relationshipType", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.indication.otherTherapy.treatment", Name: "treatment", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+}
+
+func (r *ClinicalUseDefinitionIndicationOtherTherapy) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionIndicationOtherTherapyElementMetadata
+}
+
+type ClinicalUseDefinitionContraindication struct {
+	Id                      *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension               []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension       []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	DiseaseSymptomProcedure *CodeableReference                            `json:"diseaseSymptomProcedure,omitempty" bson:"disease_symptom_procedure,omitempty"` // The situation that is being documented as contraindicating against this item
+	DiseaseStatus           *CodeableReference                            `json:"diseaseStatus,omitempty" bson:"disease_status,omitempty"`                      // The status of the disease or symptom for the contraindication
+	Comorbidity             []CodeableReference                           `json:"comorbidity,omitempty" bson:"comorbidity,omitempty"`                           // A comorbidity (concurrent condition) or coinfection
+	Indication              []ClinicalUseDefinitionIndication             `json:"indication,omitempty" bson:"indication,omitempty"`                             // The indication which this is a contraindication for
+	Applicability           *Expression                                   `json:"applicability,omitempty" bson:"applicability,omitempty"`                       // An expression that returns true or false, indicating whether the indication is applicable or not, after having applied its other elements
+	Management              []CodeableConcept                             `json:"management,omitempty" bson:"management,omitempty"`                             // Actions for managing the contraindication
+	OtherTherapy            []ClinicalUseDefinitionIndicationOtherTherapy `json:"otherTherapy,omitempty" bson:"other_therapy,omitempty"`                        // Information about use of the product in relation to other therapies described as part of the contraindication
+}
+
+func (r *ClinicalUseDefinitionContraindication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DiseaseSymptomProcedure != nil {
+		if err := r.DiseaseSymptomProcedure.Validate(); err != nil {
+			return fmt.Errorf("DiseaseSymptomProcedure: %w", err)
+		}
+	}
+	if r.DiseaseStatus != nil {
+		if err := r.DiseaseStatus.Validate(); err != nil {
+			return fmt.Errorf("DiseaseStatus: %w", err)
+		}
+	}
+	for i, item := range r.Comorbidity {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Comorbidity[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Indication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Indication[%d]: %w", i, err)
+		}
+	}
+	if r.Applicability != nil {
+		if err := r.Applicability.Validate(); err != nil {
+			return fmt.Errorf("Applicability: %w", err)
+		}
+	}
+	for i, item := range r.Management {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Management[%d]: %w", i, err)
 		}
 	}
 	for i, item := range r.OtherTherapy {
@@ -357,3 +896,481 @@ func (r *ClinicalUseDefinitionContraindication) Validate() error {
 	}
 	return nil
 }
+
+func (r *ClinicalUseDefinitionContraindication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DiseaseSymptomProcedure != nil {
+		r.DiseaseSymptomProcedure.validateAll(path+".diseaseSymptomProcedure", issues)
+	}
+	if r.DiseaseStatus != nil {
+		r.DiseaseStatus.validateAll(path+".diseaseStatus", issues)
+	}
+	for i, item := range r.Comorbidity {
+		item.validateAll(fmt.Sprintf("%s.comorbidity[%d]", path, i), issues)
+	}
+	for i, item := range r.Indication {
+		item.validateAll(fmt.Sprintf("%s.indication[%d]", path, i), issues)
+	}
+	if r.Applicability != nil {
+		r.Applicability.validateAll(path+".applicability", issues)
+	}
+	for i, item := range r.Management {
+		item.validateAll(fmt.Sprintf("%s.management[%d]", path, i), issues)
+	}
+	for i, item := range r.OtherTherapy {
+		item.validateAll(fmt.Sprintf("%s.otherTherapy[%d]", path, i), issues)
+	}
+}
+
+var clinicalUseDefinitionContraindicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionContraindication) invariants() []invariant {
+	return clinicalUseDefinitionContraindicationInvariants
+}
+
+func (r ClinicalUseDefinitionContraindication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionContraindication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionContraindication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionContraindication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var clinicalUseDefinitionContraindicationElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.contraindication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.contraindication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.contraindication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.contraindication.diseaseSymptomProcedure", Name: "diseaseSymptomProcedure", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.contraindication.diseaseStatus", Name: "diseaseStatus", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.contraindication.comorbidity", Name: "comorbidity", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.contraindication.indication", Name: "indication", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.contraindication.applicability", Name: "applicability", Field: 7, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "ClinicalUseDefinition.contraindication.management", Name: "management", Field: 8, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.contraindication.otherTherapy", Name: "otherTherapy", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ClinicalUseDefinitionContraindication) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionContraindicationElementMetadata
+}
+
+type ClinicalUseDefinitionInteraction struct {
+	Id                *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Interactant       []ClinicalUseDefinitionInteractionInteractant `json:"interactant,omitempty" bson:"interactant,omitempty"`              // The specific medication, product, food etc. or laboratory test that interacts
+	Type              *CodeableConcept                              `json:"type,omitempty" bson:"type,omitempty"`                            // The type of the interaction e.g. drug-drug interaction, drug-lab test interaction
+	Effect            *CodeableReference                            `json:"effect,omitempty" bson:"effect,omitempty"`                        // The effect of the interaction, for example "reduced gastric absorption of primary medication"
+	Incidence         *CodeableConcept                              `json:"incidence,omitempty" bson:"incidence,omitempty"`                  // The incidence of the interaction, e.g. theoretical, observed
+	Management        []CodeableConcept                             `json:"management,omitempty" bson:"management,omitempty"`                // Actions for managing the interaction
+	Severity          *CodeableConcept                              `json:"severity,omitempty" bson:"severity,omitempty"`                    // The severity of the interaction
+}
+
+func (r *ClinicalUseDefinitionInteraction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Interactant {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Interactant[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Effect != nil {
+		if err := r.Effect.Validate(); err != nil {
+			return fmt.Errorf("Effect: %w", err)
+		}
+	}
+	if r.Incidence != nil {
+		if err := r.Incidence.Validate(); err != nil {
+			return fmt.Errorf("Incidence: %w", err)
+		}
+	}
+	for i, item := range r.Management {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Management[%d]: %w", i, err)
+		}
+	}
+	if r.Severity != nil {
+		if err := r.Severity.Validate(); err != nil {
+			return fmt.Errorf("Severity: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClinicalUseDefinitionInteraction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Interactant {
+		item.validateAll(fmt.Sprintf("%s.interactant[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Effect != nil {
+		r.Effect.validateAll(path+".effect", issues)
+	}
+	if r.Incidence != nil {
+		r.Incidence.validateAll(path+".incidence", issues)
+	}
+	for i, item := range r.Management {
+		item.validateAll(fmt.Sprintf("%s.management[%d]", path, i), issues)
+	}
+	if r.Severity != nil {
+		r.Severity.validateAll(path+".severity", issues)
+	}
+}
+
+var clinicalUseDefinitionInteractionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionInteraction) invariants() []invariant {
+	return clinicalUseDefinitionInteractionInvariants
+}
+
+func (r ClinicalUseDefinitionInteraction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionInteraction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionInteraction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionInteraction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var clinicalUseDefinitionInteractionElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.interaction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.interaction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.interaction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.interaction.interactant", Name: "interactant", Field: 3, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ClinicalUseDefinition.interaction.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.interaction.effect", Name: "effect", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ClinicalUseDefinition.interaction.incidence", Name: "incidence", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.interaction.management", Name: "management", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.interaction.severity", Name: "severity", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClinicalUseDefinitionInteraction) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionInteractionElementMetadata
+}
+
+type ClinicalUseDefinitionInteractionInteractant struct {
+	Id                *string                                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Item              ClinicalUseDefinitionInteractionInteractantItem `json:"-" bson:"item,omitempty"`                                         // The specific medication, product, food etc. or laboratory test that interacts
+	Route             *CodeableConcept                                `json:"route,omitempty" bson:"route,omitempty"`                          // The route by which the item is administered to cause the interaction
+
+	itemVariants []string // JSON properties of ClinicalUseDefinition.interaction.interactant.item[x] when more than one was decoded
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.itemVariants) > 1 {
+		return fmt.Errorf("field 'Item' must have a single type, got %v", r.itemVariants)
+	}
+	if r.Item == nil {
+		return fmt.Errorf("field 'Item' is required")
+	}
+	if r.Item != nil {
+		if err := r.Item.Validate(); err != nil {
+			return fmt.Errorf("Item: %w", err)
+		}
+	}
+	if r.Route != nil {
+		if err := r.Route.Validate(); err != nil {
+			return fmt.Errorf("Route: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.itemVariants) > 1 {
+		issues.add("structure", path+".item", fmt.Sprintf("field 'Item' must have a single type, got %v", r.itemVariants))
+	}
+	if r.Item == nil {
+		issues.add("required", path+".item", "field 'Item' is required")
+	}
+	if r.Item != nil {
+		r.Item.validateAll(path+".item.ofType("+r.Item.FHIRType()+")", issues)
+	}
+	if r.Route != nil {
+		r.Route.validateAll(path+".route", issues)
+	}
+}
+
+var clinicalUseDefinitionInteractionInteractantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) invariants() []invariant {
+	return clinicalUseDefinitionInteractionInteractantInvariants
+}
+
+func (r ClinicalUseDefinitionInteractionInteractant) MarshalJSON() ([]byte, error) {
+	type alias ClinicalUseDefinitionInteractionInteractant
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "item", r.Item, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) UnmarshalJSON(data []byte) error {
+	type alias ClinicalUseDefinitionInteractionInteractant
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Item, _, r.itemVariants, err = unmarshalChoice(fields, "item", clinicalUseDefinitionInteractionInteractantItemVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ClinicalUseDefinitionInteractionInteractant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionInteractionInteractant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Item":
+		return "item", choiceValues(clinicalUseDefinitionInteractionInteractantItemVariants)
+	}
+	return "", nil
+}
+
+var clinicalUseDefinitionInteractionInteractantElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.interaction.interactant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.interaction.interactant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.interaction.interactant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.interaction.interactant.item[x]", Name: "item", Field: 3, Min: 1, Max: "1", Types: []string{"Reference", "CodeableConcept"}},
+	{Path: "ClinicalUseDefinition.interaction.interactant.route", Name: "route", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClinicalUseDefinitionInteractionInteractant) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionInteractionInteractantElementMetadata
+}
+
+// ClinicalUseDefinitionInteractionInteractantItem is the type of ClinicalUseDefinition.interaction.interactant.item[x]. It is implemented by the ClinicalUseDefinitionInteractionInteractantItem* variant types.
+type ClinicalUseDefinitionInteractionInteractantItem interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isClinicalUseDefinitionInteractionInteractantItem()
+}
+
+// ClinicalUseDefinitionInteractionInteractantItemReference is the Reference variant of ClinicalUseDefinitionInteractionInteractantItem.
+type ClinicalUseDefinitionInteractionInteractantItemReference struct {
+	Reference
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemReference) isClinicalUseDefinitionInteractionInteractantItem() {
+}
+
+// ClinicalUseDefinitionInteractionInteractantItemCodeableConcept is the CodeableConcept variant of ClinicalUseDefinitionInteractionInteractantItem.
+type ClinicalUseDefinitionInteractionInteractantItemCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ClinicalUseDefinitionInteractionInteractantItemCodeableConcept) isClinicalUseDefinitionInteractionInteractantItem() {
+}
+
+var clinicalUseDefinitionInteractionInteractantItemVariants = []ClinicalUseDefinitionInteractionInteractantItem{
+	ClinicalUseDefinitionInteractionInteractantItemReference{},
+	ClinicalUseDefinitionInteractionInteractantItemCodeableConcept{},
+}
+
+type ClinicalUseDefinitionWarning struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`              // A textual definition of this warning, with formatting
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Code               *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // A coded or unformatted textual definition of this warning
+}
+
+func (r *ClinicalUseDefinitionWarning) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ClinicalUseDefinitionWarning) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+}
+
+var clinicalUseDefinitionWarningInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ClinicalUseDefinitionWarning) invariants() []invariant {
+	return clinicalUseDefinitionWarningInvariants
+}
+
+func (r ClinicalUseDefinitionWarning) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ClinicalUseDefinitionWarning) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ClinicalUseDefinitionWarning) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ClinicalUseDefinitionWarning) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var clinicalUseDefinitionWarningElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ClinicalUseDefinitionWarning) elementTypes() map[string]string {
+	return clinicalUseDefinitionWarningElementTypes
+}
+
+var clinicalUseDefinitionWarningElementMetadata = []ElementMetadata{
+	{Path: "ClinicalUseDefinition.warning.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ClinicalUseDefinition.warning.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ClinicalUseDefinition.warning.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ClinicalUseDefinition.warning.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ClinicalUseDefinition.warning.code", Name: "code", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ClinicalUseDefinitionWarning) ElementMetadata() []ElementMetadata {
+	return clinicalUseDefinitionWarningElementMetadata
+}
@@ -2,56 +2,85 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The CodeSystem resource is used to declare the existence of and describe a code system or code system supplement and its key properties, and optionally define a part or all of its content.
 type CodeSystem struct {
-	ResourceType           string               `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string              `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string              `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage    `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string              `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this code system, represented as a URI (globally unique) (Coding.system)
-	Identifier             []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the code system (business identifier)
-	Version                *string              `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the code system (Coding.version)
-	VersionAlgorithmString *string              `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding              `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string              `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this code system (computer friendly)
-	Title                  *string              `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this code system (human friendly)
-	Status                 string               `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string              `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string              `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail      `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string              `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the code system
-	UseContext             []UsageContext       `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept    `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the code system (if applicable)
-	Purpose                *string              `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this code system is defined
-	Copyright              *string              `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string              `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string              `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the CodeSystem was approved by publisher
-	LastReviewDate         *string              `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the CodeSystem was last reviewed by the publisher
-	EffectivePeriod        *Period              `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the CodeSystem is expected to be used
-	Topic                  []CodeableConcept    `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail      `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the CodeSystem
-	Editor                 []ContactDetail      `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the CodeSystem
-	Reviewer               []ContactDetail      `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the CodeSystem
-	Endorser               []ContactDetail      `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the CodeSystem
-	RelatedArtifact        []RelatedArtifact    `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
-	CaseSensitive          *bool                `json:"caseSensitive,omitempty" bson:"case_sensitive,omitempty"`                    // If code comparison is case sensitive
-	ValueSet               *string              `json:"valueSet,omitempty" bson:"value_set,omitempty"`                              // Canonical reference to the value set with entire code system
-	HierarchyMeaning       *string              `json:"hierarchyMeaning,omitempty" bson:"hierarchy_meaning,omitempty"`              // grouped-by | is-a | part-of | classified-with
-	Compositional          *bool                `json:"compositional,omitempty" bson:"compositional,omitempty"`                     // If code system defines a compositional grammar
-	VersionNeeded          *bool                `json:"versionNeeded,omitempty" bson:"version_needed,omitempty"`                    // If definitions are not stable
-	Content                string               `json:"content" bson:"content"`                                                     // not-present | example | fragment | complete | supplement
-	Supplements            *string              `json:"supplements,omitempty" bson:"supplements,omitempty"`                         // Canonical URL of Code System this adds designations and properties to
-	Count                  *int                 `json:"count,omitempty" bson:"count,omitempty"`                                     // Total concepts in the code system
-	Filter                 []CodeSystemFilter   `json:"filter,omitempty" bson:"filter,omitempty"`                                   // Filter that can be used in a value set
-	Property               []CodeSystemProperty `json:"property,omitempty" bson:"property,omitempty"`                               // Additional information supplied about each concept
-	Concept                []CodeSystemConcept  `json:"concept,omitempty" bson:"concept,omitempty"`                                 // Concepts in the code system
+	ResourceType            string                      `json:"resourceType" bson:"resource_type"`                                      // Type of resource
+	Id                      *string                     `json:"id,omitempty" bson:"id,omitempty"`                                       // Logical id of this artifact
+	Meta                    *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                                   // Metadata about the resource
+	ImplicitRules           *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`       // Extensions for implicitRules
+	Language                *string                     `json:"language,omitempty" bson:"language,omitempty"`                           // Language of the resource content
+	LanguageElement         *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`                  // Extensions for language
+	Text                    *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                                   // Text summary of the resource, for human interpretation
+	Contained               []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                         // Contained, inline Resources
+	Extension               []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored
+	Url                     *string                     `json:"url,omitempty" bson:"url,omitempty"`                                     // Canonical identifier for this code system, represented as a URI (globally unique) (Coding.system)
+	UrlElement              *Element                    `json:"_url,omitempty" bson:"url_element,omitempty"`                            // Extensions for url
+	Identifier              []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // Additional identifier for the code system (business identifier)
+	Version                 *string                     `json:"version,omitempty" bson:"version,omitempty"`                             // Business version of the code system (Coding.version)
+	VersionElement          *Element                    `json:"_version,omitempty" bson:"version_element,omitempty"`                    // Extensions for version
+	VersionAlgorithm        CodeSystemVersionAlgorithm  `json:"-" bson:"version_algorithm,omitempty"`                                   // How to compare versions
+	VersionAlgorithmElement *Element                    `json:"-" bson:"version_algorithm_element,omitempty"`                           // Extensions for versionAlgorithm[x]
+	Name                    *string                     `json:"name,omitempty" bson:"name,omitempty"`                                   // Name for this code system (computer friendly)
+	NameElement             *Element                    `json:"_name,omitempty" bson:"name_element,omitempty"`                          // Extensions for name
+	Title                   *string                     `json:"title,omitempty" bson:"title,omitempty"`                                 // Name for this code system (human friendly)
+	TitleElement            *Element                    `json:"_title,omitempty" bson:"title_element,omitempty"`                        // Extensions for title
+	Status                  PublicationStatus           `json:"status" bson:"status"`                                                   // draft | active | retired | unknown
+	StatusElement           *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                      // Extensions for status
+	Experimental            *bool                       `json:"experimental,omitempty" bson:"experimental,omitempty"`                   // For testing only - never for real usage
+	ExperimentalElement     *Element                    `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`          // Extensions for experimental
+	Date                    *DateTime                   `json:"date,omitempty" bson:"date,omitempty"`                                   // Date last changed
+	DateElement             *Element                    `json:"_date,omitempty" bson:"date_element,omitempty"`                          // Extensions for date
+	Publisher               *string                     `json:"publisher,omitempty" bson:"publisher,omitempty"`                         // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                    `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                // Extensions for publisher
+	Contact                 []ContactDetail             `json:"contact,omitempty" bson:"contact,omitempty"`                             // Contact details for the publisher
+	Description             *string                     `json:"description,omitempty" bson:"description,omitempty"`                     // Natural language description of the code system
+	DescriptionElement      *Element                    `json:"_description,omitempty" bson:"description_element,omitempty"`            // Extensions for description
+	UseContext              []UsageContext              `json:"useContext,omitempty" bson:"use_context,omitempty"`                      // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept           `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                   // Jurisdiction of the authority that maintains the code system (if applicable)
+	Purpose                 *string                     `json:"purpose,omitempty" bson:"purpose,omitempty"`                             // Why this code system is defined
+	PurposeElement          *Element                    `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                    // Extensions for purpose
+	Copyright               *string                     `json:"copyright,omitempty" bson:"copyright,omitempty"`                         // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                    `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                // Extensions for copyright
+	CopyrightLabel          *string                     `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`              // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                    `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`     // Extensions for copyrightLabel
+	ApprovalDate            *Date                       `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                  // When the CodeSystem was approved by publisher
+	ApprovalDateElement     *Element                    `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`         // Extensions for approvalDate
+	LastReviewDate          *Date                       `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`             // When the CodeSystem was last reviewed by the publisher
+	LastReviewDateElement   *Element                    `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`    // Extensions for lastReviewDate
+	EffectivePeriod         *Period                     `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`            // When the CodeSystem is expected to be used
+	Topic                   []CodeableConcept           `json:"topic,omitempty" bson:"topic,omitempty"`                                 // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail             `json:"author,omitempty" bson:"author,omitempty"`                               // Who authored the CodeSystem
+	Editor                  []ContactDetail             `json:"editor,omitempty" bson:"editor,omitempty"`                               // Who edited the CodeSystem
+	Reviewer                []ContactDetail             `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                           // Who reviewed the CodeSystem
+	Endorser                []ContactDetail             `json:"endorser,omitempty" bson:"endorser,omitempty"`                           // Who endorsed the CodeSystem
+	RelatedArtifact         []RelatedArtifact           `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`            // Additional documentation, citations, etc
+	CaseSensitive           *bool                       `json:"caseSensitive,omitempty" bson:"case_sensitive,omitempty"`                // If code comparison is case sensitive
+	CaseSensitiveElement    *Element                    `json:"_caseSensitive,omitempty" bson:"case_sensitive_element,omitempty"`       // Extensions for caseSensitive
+	ValueSet                *string                     `json:"valueSet,omitempty" bson:"value_set,omitempty"`                          // Canonical reference to the value set with entire code system
+	ValueSetElement         *Element                    `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`                 // Extensions for valueSet
+	HierarchyMeaning        *CodeSystemHierarchyMeaning `json:"hierarchyMeaning,omitempty" bson:"hierarchy_meaning,omitempty"`          // grouped-by | is-a | part-of | classified-with
+	HierarchyMeaningElement *Element                    `json:"_hierarchyMeaning,omitempty" bson:"hierarchy_meaning_element,omitempty"` // Extensions for hierarchyMeaning
+	Compositional           *bool                       `json:"compositional,omitempty" bson:"compositional,omitempty"`                 // If code system defines a compositional grammar
+	CompositionalElement    *Element                    `json:"_compositional,omitempty" bson:"compositional_element,omitempty"`        // Extensions for compositional
+	VersionNeeded           *bool                       `json:"versionNeeded,omitempty" bson:"version_needed,omitempty"`                // If definitions are not stable
+	VersionNeededElement    *Element                    `json:"_versionNeeded,omitempty" bson:"version_needed_element,omitempty"`       // Extensions for versionNeeded
+	Content                 CodeSystemContentMode       `json:"content" bson:"content"`                                                 // not-present | example | fragment | complete | supplement
+	ContentElement          *Element                    `json:"_content,omitempty" bson:"content_element,omitempty"`                    // Extensions for content
+	Supplements             *string                     `json:"supplements,omitempty" bson:"supplements,omitempty"`                     // Canonical URL of Code System this adds designations and properties to
+	SupplementsElement      *Element                    `json:"_supplements,omitempty" bson:"supplements_element,omitempty"`            // Extensions for supplements
+	Count                   *int                        `json:"count,omitempty" bson:"count,omitempty"`                                 // Total concepts in the code system
+	CountElement            *Element                    `json:"_count,omitempty" bson:"count_element,omitempty"`                        // Extensions for count
+	Filter                  []CodeSystemFilter          `json:"filter,omitempty" bson:"filter,omitempty"`                               // Filter that can be used in a value set
+	Property                []CodeSystemProperty        `json:"property,omitempty" bson:"property,omitempty"`                           // Additional information supplied about each concept
+	Concept                 []CodeSystemConcept         `json:"concept,omitempty" bson:"concept,omitempty"`                             // Concepts in the code system
+
+	versionAlgorithmVariants []string // JSON properties of CodeSystem.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *CodeSystem) Validate() error {
@@ -63,30 +92,118 @@ func (r *CodeSystem) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -97,6 +214,41 @@ func (r *CodeSystem) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -132,9 +284,55 @@ func (r *CodeSystem) Validate() error {
 			return fmt.Errorf("RelatedArtifact[%d]: %w", i, err)
 		}
 	}
-	if r.Content == emptyString {
+	if r.CaseSensitiveElement != nil {
+		if err := r.CaseSensitiveElement.Validate(); err != nil {
+			return fmt.Errorf("CaseSensitiveElement: %w", err)
+		}
+	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	if r.HierarchyMeaning != nil && !r.HierarchyMeaning.IsValid() {
+		return fmt.Errorf("field 'HierarchyMeaning' has invalid code '%s'", *r.HierarchyMeaning)
+	}
+	if r.HierarchyMeaningElement != nil {
+		if err := r.HierarchyMeaningElement.Validate(); err != nil {
+			return fmt.Errorf("HierarchyMeaningElement: %w", err)
+		}
+	}
+	if r.CompositionalElement != nil {
+		if err := r.CompositionalElement.Validate(); err != nil {
+			return fmt.Errorf("CompositionalElement: %w", err)
+		}
+	}
+	if r.VersionNeededElement != nil {
+		if err := r.VersionNeededElement.Validate(); err != nil {
+			return fmt.Errorf("VersionNeededElement: %w", err)
+		}
+	}
+	if r.Content == "" {
 		return fmt.Errorf("field 'Content' is required")
 	}
+	if !r.Content.IsValid() {
+		return fmt.Errorf("field 'Content' has invalid code '%s'", r.Content)
+	}
+	if r.ContentElement != nil {
+		if err := r.ContentElement.Validate(); err != nil {
+			return fmt.Errorf("ContentElement: %w", err)
+		}
+	}
+	if r.SupplementsElement != nil {
+		if err := r.SupplementsElement.Validate(); err != nil {
+			return fmt.Errorf("SupplementsElement: %w", err)
+		}
+	}
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
+		}
+	}
 	for i, item := range r.Filter {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Filter[%d]: %w", i, err)
@@ -150,151 +348,1320 @@ func (r *CodeSystem) Validate() error {
 			return fmt.Errorf("Concept[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type CodeSystemFilter struct {
-	Id          *string  `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code        string   `json:"code" bson:"code"`                                   // Code that identifies the filter
-	Description *string  `json:"description,omitempty" bson:"description,omitempty"` // How or why the filter is used
-	Operator    []string `json:"operator" bson:"operator"`                           // = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
-	Value       string   `json:"value" bson:"value"`                                 // What to use for the value
+	return validateInvariants(r, "CodeSystem")
 }
 
-func (r *CodeSystemFilter) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+func (r *CodeSystem) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CodeSystem" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CodeSystem', got '%s'", r.ResourceType))
 	}
-	if len(r.Operator) < 1 {
-		return fmt.Errorf("field 'Operator' must have at least 1 elements")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.Value == emptyString {
-		return fmt.Errorf("field 'Value' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	return nil
-}
-
-type CodeSystemProperty struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code        string  `json:"code" bson:"code"`                                   // Identifies the property on the concepts, and when referred to in operations
-	Uri         *string `json:"uri,omitempty" bson:"uri,omitempty"`                 // Formal identifier for the property
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Why the property is defined, and/or what it conveys
-	Type        string  `json:"type" bson:"type"`                                   // code | Coding | string | integer | boolean | dateTime | decimal
-}
-
-func (r *CodeSystemProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	return nil
-}
-
-type CodeSystemConcept struct {
-	Id          *string                        `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code        string                         `json:"code" bson:"code"`                                   // Code that identifies concept
-	Display     *string                        `json:"display,omitempty" bson:"display,omitempty"`         // Text to display to the user
-	Definition  *string                        `json:"definition,omitempty" bson:"definition,omitempty"`   // Formal definition
-	Designation []CodeSystemConceptDesignation `json:"designation,omitempty" bson:"designation,omitempty"` // Additional representations for the concept
-	Property    []CodeSystemConceptProperty    `json:"property,omitempty" bson:"property,omitempty"`       // Property value for the concept
-	Concept     []CodeSystemConcept            `json:"concept,omitempty" bson:"concept,omitempty"`         // Child Concepts (is-a/contains/categorizes)
-}
-
-func (r *CodeSystemConcept) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	for i, item := range r.Designation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Designation[%d]: %w", i, err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	for i, item := range r.Property {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Property[%d]: %w", i, err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	for i, item := range r.Concept {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Concept[%d]: %w", i, err)
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
 		}
 	}
-	return nil
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	if r.CaseSensitiveElement != nil {
+		r.CaseSensitiveElement.validateAll(path+".caseSensitive", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	if r.HierarchyMeaning != nil && !r.HierarchyMeaning.IsValid() {
+		issues.add("code-invalid", path+".hierarchyMeaning", fmt.Sprintf("field 'HierarchyMeaning' has invalid code '%s'", *r.HierarchyMeaning))
+	}
+	if r.HierarchyMeaningElement != nil {
+		r.HierarchyMeaningElement.validateAll(path+".hierarchyMeaning", issues)
+	}
+	if r.CompositionalElement != nil {
+		r.CompositionalElement.validateAll(path+".compositional", issues)
+	}
+	if r.VersionNeededElement != nil {
+		r.VersionNeededElement.validateAll(path+".versionNeeded", issues)
+	}
+	if r.Content == "" {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != "" && !r.Content.IsValid() {
+		issues.add("code-invalid", path+".content", fmt.Sprintf("field 'Content' has invalid code '%s'", r.Content))
+	}
+	if r.ContentElement != nil {
+		r.ContentElement.validateAll(path+".content", issues)
+	}
+	if r.SupplementsElement != nil {
+		r.SupplementsElement.validateAll(path+".supplements", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+	for i, item := range r.Filter {
+		item.validateAll(fmt.Sprintf("%s.filter[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Concept {
+		item.validateAll(fmt.Sprintf("%s.concept[%d]", path, i), issues)
+	}
 }
 
-type CodeSystemConceptDesignation struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Language      *string  `json:"language,omitempty" bson:"language,omitempty"`            // Human language of the designation
-	Use           *Coding  `json:"use,omitempty" bson:"use,omitempty"`                      // Details how this designation would be used
-	AdditionalUse []Coding `json:"additionalUse,omitempty" bson:"additional_use,omitempty"` // Additional ways how this designation would be used
-	Value         string   `json:"value" bson:"value"`                                      // The text value for this designation
+func (r *CodeSystem) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CodeSystem", &issues)
+	checkInvariants(r, "CodeSystem", &issues)
+	return issues
 }
 
-func (r *CodeSystemConceptDesignation) Validate() error {
-	if r.Use != nil {
-		if err := r.Use.Validate(); err != nil {
-			return fmt.Errorf("Use: %w", err)
-		}
-	}
-	for i, item := range r.AdditionalUse {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AdditionalUse[%d]: %w", i, err)
-		}
-	}
-	var emptyString string
-	if r.Value == emptyString {
-		return fmt.Errorf("field 'Value' is required")
-	}
-	return nil
+var codeSystemInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type CodeSystemConceptProperty struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Code          string   `json:"code" bson:"code"`                     // Reference to CodeSystem.property.code or a FHIR defined concept-property
-	ValueCode     *string  `json:"valueCode" bson:"value_code"`          // Value of the property for this concept
-	ValueCoding   *Coding  `json:"valueCoding" bson:"value_coding"`      // Value of the property for this concept
-	ValueString   *string  `json:"valueString" bson:"value_string"`      // Value of the property for this concept
-	ValueInteger  *int     `json:"valueInteger" bson:"value_integer"`    // Value of the property for this concept
-	ValueBoolean  *bool    `json:"valueBoolean" bson:"value_boolean"`    // Value of the property for this concept
-	ValueDateTime *string  `json:"valueDateTime" bson:"value_date_time"` // Value of the property for this concept
-	ValueDecimal  *float64 `json:"valueDecimal" bson:"value_decimal"`    // Value of the property for this concept
+func (r *CodeSystem) invariants() []invariant {
+	return codeSystemInvariants
 }
 
-func (r *CodeSystemConceptProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+func (r CodeSystem) MarshalJSON() ([]byte, error) {
+	type alias CodeSystem
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	return data, nil
+}
+
+func (r *CodeSystem) UnmarshalJSON(data []byte) error {
+	type alias CodeSystem
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
-	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", codeSystemVersionAlgorithmVariants); err != nil {
+		return err
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	return nil
+}
+
+func (r CodeSystem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeSystem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeSystem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeSystem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CodeSystem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(codeSystemVersionAlgorithmVariants)
 	}
+	return "", nil
+}
+
+var codeSystemElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"valueSet":      "canonical",
+	"supplements":   "canonical",
+	"count":         "unsignedInt",
+}
+
+func (r *CodeSystem) elementTypes() map[string]string {
+	return codeSystemElementTypes
+}
+
+var codeSystemElementMetadata = []ElementMetadata{
+	{Path: "CodeSystem.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CodeSystem.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CodeSystem.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CodeSystem.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CodeSystem.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CodeSystem.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CodeSystem.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeSystem.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "CodeSystem.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CodeSystem.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "CodeSystem.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CodeSystem.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "CodeSystem.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CodeSystem.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CodeSystem.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CodeSystem.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CodeSystem.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "CodeSystem.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CodeSystem.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CodeSystem.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CodeSystem.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.approvalDate", Name: "approvalDate", Field: 41, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "CodeSystem.lastReviewDate", Name: "lastReviewDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "CodeSystem.effectivePeriod", Name: "effectivePeriod", Field: 45, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "CodeSystem.topic", Name: "topic", Field: 46, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CodeSystem.author", Name: "author", Field: 47, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CodeSystem.editor", Name: "editor", Field: 48, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CodeSystem.reviewer", Name: "reviewer", Field: 49, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CodeSystem.endorser", Name: "endorser", Field: 50, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CodeSystem.relatedArtifact", Name: "relatedArtifact", Field: 51, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "CodeSystem.caseSensitive", Name: "caseSensitive", Field: 52, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CodeSystem.valueSet", Name: "valueSet", Field: 54, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "CodeSystem.hierarchyMeaning", Name: "hierarchyMeaning", Field: 56, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CodeSystem.hierarchyMeaning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/codesystem-hierarchy-meaning"}},
+	{Path: "CodeSystem.compositional", Name: "compositional", Field: 58, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CodeSystem.versionNeeded", Name: "versionNeeded", Field: 60, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CodeSystem.content", Name: "content", Field: 62, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CodeSystem.content", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/codesystem-content-mode"}},
+	{Path: "CodeSystem.supplements", Name: "supplements", Field: 64, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "CodeSystem.count", Name: "count", Field: 66, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "CodeSystem.filter", Name: "filter", Field: 68, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CodeSystem.property", Name: "property", Field: 69, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CodeSystem.concept", Name: "concept", Field: 70, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CodeSystem) ElementMetadata() []ElementMetadata {
+	return codeSystemElementMetadata
+}
+
+// CodeSystemVersionAlgorithm is the type of CodeSystem.versionAlgorithm[x]. It is implemented by the CodeSystemVersionAlgorithm* variant types.
+type CodeSystemVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCodeSystemVersionAlgorithm()
+}
+
+// CodeSystemVersionAlgorithmString is the string variant of CodeSystemVersionAlgorithm.
+type CodeSystemVersionAlgorithmString string
+
+func (v CodeSystemVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v CodeSystemVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CodeSystemVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v CodeSystemVersionAlgorithmString) isCodeSystemVersionAlgorithm() {}
+
+// CodeSystemVersionAlgorithmCoding is the Coding variant of CodeSystemVersionAlgorithm.
+type CodeSystemVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v CodeSystemVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v CodeSystemVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v CodeSystemVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v CodeSystemVersionAlgorithmCoding) isCodeSystemVersionAlgorithm() {}
+
+var codeSystemVersionAlgorithmVariants = []CodeSystemVersionAlgorithm{
+	CodeSystemVersionAlgorithmString(""),
+	CodeSystemVersionAlgorithmCoding{},
+}
+
+func (r *CodeSystem) GetResourceType() string {
+	return "CodeSystem"
+}
+
+func (r *CodeSystem) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CodeSystem) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CodeSystem) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CodeSystem) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CodeSystem) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CodeSystem) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CodeSystem) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CodeSystem)(nil)
+
+type CodeSystemFilter struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code               string           `json:"code" bson:"code"`                                                // Code that identifies the filter
+	CodeElement        *Element         `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`              // How or why the filter is used
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Operator           []FilterOperator `json:"operator" bson:"operator"`                                        // = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
+	OperatorElement    []*Element       `json:"_operator,omitempty" bson:"operator_element,omitempty"`           // Extensions for operator
+	Value              string           `json:"value" bson:"value"`                                              // What to use for the value
+	ValueElement       *Element         `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *CodeSystemFilter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if len(r.Operator) < 1 {
+		return fmt.Errorf("field 'Operator' must have at least 1 elements")
+	}
+	for i, item := range r.Operator {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Operator[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.OperatorElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OperatorElement[%d]: %w", i, err)
+		}
+	}
+	if r.Value == emptyString {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CodeSystemFilter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if len(r.Operator) < 1 {
+		issues.add("required", path+".operator", "field 'Operator' must have at least 1 elements")
+	}
+	for i, item := range r.Operator {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.operator[%d]", path, i), fmt.Sprintf("field 'Operator[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.OperatorElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.operator[%d]", path, i), issues)
+	}
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var codeSystemFilterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeSystemFilter) invariants() []invariant {
+	return codeSystemFilterInvariants
+}
+
+func (r CodeSystemFilter) MarshalJSON() ([]byte, error) {
+	type alias CodeSystemFilter
+	out := struct {
+		alias
+		Operator        []*FilterOperator `json:"operator"`
+		OperatorElement []*Element        `json:"_operator,omitempty"`
+	}{alias: alias(r)}
+	out.Operator, out.OperatorElement = alignPrimitiveArray(r.Operator, r.OperatorElement)
+	return json.Marshal(out)
+}
+
+func (r *CodeSystemFilter) UnmarshalJSON(data []byte) error {
+	type alias CodeSystemFilter
+	aux := struct {
+		*alias
+		Operator []*FilterOperator `json:"operator"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Operator, err = splitPrimitiveArray(aux.Operator, r.OperatorElement); err != nil {
+		return fmt.Errorf("operator: %w", err)
+	}
+	return nil
+}
+
+func (r CodeSystemFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeSystemFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeSystemFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeSystemFilter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codeSystemFilterElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *CodeSystemFilter) elementTypes() map[string]string {
+	return codeSystemFilterElementTypes
+}
+
+var codeSystemFilterElementMetadata = []ElementMetadata{
+	{Path: "CodeSystem.filter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.filter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeSystem.filter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.filter.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CodeSystem.filter.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.filter.operator", Name: "operator", Field: 7, Min: 1, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CodeSystem.filter.operator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/filter-operator"}},
+	{Path: "CodeSystem.filter.value", Name: "value", Field: 9, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *CodeSystemFilter) ElementMetadata() []ElementMetadata {
+	return codeSystemFilterElementMetadata
+}
+
+type CodeSystemProperty struct {
+	Id                 *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code               string                 `json:"code" bson:"code"`                                                // Identifies the property on the concepts, and when referred to in operations
+	CodeElement        *Element               `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Uri                *string                `json:"uri,omitempty" bson:"uri,omitempty"`                              // Formal identifier for the property
+	UriElement         *Element               `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+	Description        *string                `json:"description,omitempty" bson:"description,omitempty"`              // Why the property is defined, and/or what it conveys
+	DescriptionElement *Element               `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Type               ConceptMapPropertyType `json:"type" bson:"type"`                                                // code | Coding | string | integer | boolean | dateTime | decimal
+	TypeElement        *Element               `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+}
+
+func (r *CodeSystemProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CodeSystemProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+}
+
+var codeSystemPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeSystemProperty) invariants() []invariant {
+	return codeSystemPropertyInvariants
+}
+
+func (r CodeSystemProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeSystemProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeSystemProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeSystemProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codeSystemPropertyElementTypes = map[string]string{
+	"code": "code",
+	"uri":  "uri",
+}
+
+func (r *CodeSystemProperty) elementTypes() map[string]string {
+	return codeSystemPropertyElementTypes
+}
+
+var codeSystemPropertyElementMetadata = []ElementMetadata{
+	{Path: "CodeSystem.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeSystem.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.property.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CodeSystem.property.uri", Name: "uri", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "CodeSystem.property.description", Name: "description", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.property.type", Name: "type", Field: 9, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CodeSystem.property.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-property-type"}},
+}
+
+func (r *CodeSystemProperty) ElementMetadata() []ElementMetadata {
+	return codeSystemPropertyElementMetadata
+}
+
+type CodeSystemConcept struct {
+	Id                *string                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string                         `json:"code" bson:"code"`                                                // Code that identifies concept
+	CodeElement       *Element                       `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Display           *string                        `json:"display,omitempty" bson:"display,omitempty"`                      // Text to display to the user
+	DisplayElement    *Element                       `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	Definition        *string                        `json:"definition,omitempty" bson:"definition,omitempty"`                // Formal definition
+	DefinitionElement *Element                       `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+	Designation       []CodeSystemConceptDesignation `json:"designation,omitempty" bson:"designation,omitempty"`              // Additional representations for the concept
+	Property          []CodeSystemConceptProperty    `json:"property,omitempty" bson:"property,omitempty"`                    // Property value for the concept
+	Concept           []CodeSystemConcept            `json:"concept,omitempty" bson:"concept,omitempty"`                      // Child Concepts (is-a/contains/categorizes)
+}
+
+func (r *CodeSystemConcept) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	for i, item := range r.Designation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Designation[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Property {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Property[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Concept {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Concept[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CodeSystemConcept) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	for i, item := range r.Designation {
+		item.validateAll(fmt.Sprintf("%s.designation[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Concept {
+		item.validateAll(fmt.Sprintf("%s.concept[%d]", path, i), issues)
+	}
+}
+
+var codeSystemConceptInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeSystemConcept) invariants() []invariant {
+	return codeSystemConceptInvariants
+}
+
+func (r CodeSystemConcept) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeSystemConcept) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeSystemConcept) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeSystemConcept) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codeSystemConceptElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *CodeSystemConcept) elementTypes() map[string]string {
+	return codeSystemConceptElementTypes
+}
+
+var codeSystemConceptElementMetadata = []ElementMetadata{
+	{Path: "CodeSystem.concept.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.concept.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeSystem.concept.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.concept.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CodeSystem.concept.display", Name: "display", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.concept.definition", Name: "definition", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.concept.designation", Name: "designation", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CodeSystem.concept.property", Name: "property", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CodeSystem.concept.concept", Name: "concept", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CodeSystemConcept) ElementMetadata() []ElementMetadata {
+	return codeSystemConceptElementMetadata
+}
+
+type CodeSystemConceptDesignation struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Language          *string     `json:"language,omitempty" bson:"language,omitempty"`                    // Human language of the designation
+	LanguageElement   *Element    `json:"_language,omitempty" bson:"language_element,omitempty"`           // Extensions for language
+	Use               *Coding     `json:"use,omitempty" bson:"use,omitempty"`                              // Details how this designation would be used
+	AdditionalUse     []Coding    `json:"additionalUse,omitempty" bson:"additional_use,omitempty"`         // Additional ways how this designation would be used
+	Value             string      `json:"value" bson:"value"`                                              // The text value for this designation
+	ValueElement      *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *CodeSystemConceptDesignation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
+	if r.Use != nil {
+		if err := r.Use.Validate(); err != nil {
+			return fmt.Errorf("Use: %w", err)
+		}
+	}
+	for i, item := range r.AdditionalUse {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AdditionalUse[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CodeSystemConceptDesignation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Use != nil {
+		r.Use.validateAll(path+".use", issues)
+	}
+	for i, item := range r.AdditionalUse {
+		item.validateAll(fmt.Sprintf("%s.additionalUse[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var codeSystemConceptDesignationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeSystemConceptDesignation) invariants() []invariant {
+	return codeSystemConceptDesignationInvariants
+}
+
+func (r CodeSystemConceptDesignation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeSystemConceptDesignation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeSystemConceptDesignation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeSystemConceptDesignation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codeSystemConceptDesignationElementTypes = map[string]string{
+	"language": "code",
+}
+
+func (r *CodeSystemConceptDesignation) elementTypes() map[string]string {
+	return codeSystemConceptDesignationElementTypes
+}
+
+var codeSystemConceptDesignationElementMetadata = []ElementMetadata{
+	{Path: "CodeSystem.concept.designation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.concept.designation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeSystem.concept.designation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.concept.designation.language", Name: "language", Field: 3, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "CodeSystem.concept.designation.use", Name: "use", Field: 5, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "CodeSystem.concept.designation.additionalUse", Name: "additionalUse", Field: 6, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "CodeSystem.concept.designation.value", Name: "value", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *CodeSystemConceptDesignation) ElementMetadata() []ElementMetadata {
+	return codeSystemConceptDesignationElementMetadata
+}
+
+type CodeSystemConceptProperty struct {
+	Id                *string                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string                         `json:"code" bson:"code"`                                                // Reference to CodeSystem.property.code or a FHIR defined concept-property
+	CodeElement       *Element                       `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Value             CodeSystemConceptPropertyValue `json:"-" bson:"value,omitempty"`                                        // Value of the property for this concept
+	ValueElement      *Element                       `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of CodeSystem.concept.property.value[x] when more than one was decoded
+}
+
+func (r *CodeSystemConceptProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CodeSystemConceptProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var codeSystemConceptPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeSystemConceptProperty) invariants() []invariant {
+	return codeSystemConceptPropertyInvariants
+}
+
+func (r CodeSystemConceptProperty) MarshalJSON() ([]byte, error) {
+	type alias CodeSystemConceptProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CodeSystemConceptProperty) UnmarshalJSON(data []byte) error {
+	type alias CodeSystemConceptProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", codeSystemConceptPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r CodeSystemConceptProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeSystemConceptProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeSystemConceptProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeSystemConceptProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CodeSystemConceptProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(codeSystemConceptPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var codeSystemConceptPropertyElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *CodeSystemConceptProperty) elementTypes() map[string]string {
+	return codeSystemConceptPropertyElementTypes
+}
+
+var codeSystemConceptPropertyElementMetadata = []ElementMetadata{
+	{Path: "CodeSystem.concept.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeSystem.concept.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeSystem.concept.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CodeSystem.concept.property.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CodeSystem.concept.property.value[x]", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"code", "Coding", "string", "integer", "boolean", "dateTime", "decimal"}},
+}
+
+func (r *CodeSystemConceptProperty) ElementMetadata() []ElementMetadata {
+	return codeSystemConceptPropertyElementMetadata
+}
+
+// CodeSystemConceptPropertyValue is the type of CodeSystem.concept.property.value[x]. It is implemented by the CodeSystemConceptPropertyValue* variant types.
+type CodeSystemConceptPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCodeSystemConceptPropertyValue()
+}
+
+// CodeSystemConceptPropertyValueCode is the code variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueCode string
+
+func (v CodeSystemConceptPropertyValueCode) Validate() error {
+	return nil
+}
+
+func (v CodeSystemConceptPropertyValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CodeSystemConceptPropertyValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v CodeSystemConceptPropertyValueCode) isCodeSystemConceptPropertyValue() {}
+
+// CodeSystemConceptPropertyValueCoding is the Coding variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueCoding struct {
+	Coding
+}
+
+func (v CodeSystemConceptPropertyValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v CodeSystemConceptPropertyValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v CodeSystemConceptPropertyValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v CodeSystemConceptPropertyValueCoding) isCodeSystemConceptPropertyValue() {}
+
+// CodeSystemConceptPropertyValueString is the string variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueString string
+
+func (v CodeSystemConceptPropertyValueString) Validate() error {
+	return nil
+}
+
+func (v CodeSystemConceptPropertyValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CodeSystemConceptPropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v CodeSystemConceptPropertyValueString) isCodeSystemConceptPropertyValue() {}
+
+// CodeSystemConceptPropertyValueInteger is the integer variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueInteger int
+
+func (v CodeSystemConceptPropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v CodeSystemConceptPropertyValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CodeSystemConceptPropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v CodeSystemConceptPropertyValueInteger) isCodeSystemConceptPropertyValue() {}
+
+// CodeSystemConceptPropertyValueBoolean is the boolean variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueBoolean bool
+
+func (v CodeSystemConceptPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v CodeSystemConceptPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CodeSystemConceptPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v CodeSystemConceptPropertyValueBoolean) isCodeSystemConceptPropertyValue() {}
+
+// CodeSystemConceptPropertyValueDateTime is the dateTime variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueDateTime struct {
+	DateTime
+}
+
+func (v CodeSystemConceptPropertyValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v CodeSystemConceptPropertyValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CodeSystemConceptPropertyValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v CodeSystemConceptPropertyValueDateTime) isCodeSystemConceptPropertyValue() {}
+
+// CodeSystemConceptPropertyValueDecimal is the decimal variant of CodeSystemConceptPropertyValue.
+type CodeSystemConceptPropertyValueDecimal struct {
+	Decimal
+}
+
+func (v CodeSystemConceptPropertyValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v CodeSystemConceptPropertyValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CodeSystemConceptPropertyValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v CodeSystemConceptPropertyValueDecimal) isCodeSystemConceptPropertyValue() {}
+
+var codeSystemConceptPropertyValueVariants = []CodeSystemConceptPropertyValue{
+	CodeSystemConceptPropertyValueCode(""),
+	CodeSystemConceptPropertyValueCoding{},
+	CodeSystemConceptPropertyValueString(""),
+	CodeSystemConceptPropertyValueInteger(0),
+	CodeSystemConceptPropertyValueBoolean(false),
+	CodeSystemConceptPropertyValueDateTime{},
+	CodeSystemConceptPropertyValueDecimal{},
+}
@@ -1,21 +1,88 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // CodeableConcept Type: A concept that may be defined by a formal reference to a terminology or ontology or may be provided by text.
 type CodeableConcept struct {
-	Id     *string  `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Coding []Coding `json:"coding,omitempty" bson:"coding,omitempty"` // Code defined by a terminology system
-	Text   *string  `json:"text,omitempty" bson:"text,omitempty"`     // Plain text representation of the concept
+	Id          *string     `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension   []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Coding      []Coding    `json:"coding,omitempty" bson:"coding,omitempty"`       // Code defined by a terminology system
+	Text        *string     `json:"text,omitempty" bson:"text,omitempty"`           // Plain text representation of the concept
+	TextElement *Element    `json:"_text,omitempty" bson:"text_element,omitempty"`  // Extensions for text
 }
 
 func (r *CodeableConcept) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Coding {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Coding[%d]: %w", i, err)
 		}
 	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *CodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.Coding {
+		item.validateAll(fmt.Sprintf("%s.coding[%d]", path, i), issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+func (r *CodeableConcept) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CodeableConcept", &issues)
+	checkInvariants(r, "CodeableConcept", &issues)
+	return issues
+}
+
+var codeableConceptInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeableConcept) invariants() []invariant {
+	return codeableConceptInvariants
+}
+
+func (r CodeableConcept) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeableConcept) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeableConcept) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeableConcept) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codeableConceptElementMetadata = []ElementMetadata{
+	{Path: "CodeableConcept.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeableConcept.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeableConcept.coding", Name: "coding", Field: 2, Min: 0, Max: "*", Types: []string{"Coding"}, Summary: true},
+	{Path: "CodeableConcept.text", Name: "text", Field: 3, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *CodeableConcept) ElementMetadata() []ElementMetadata {
+	return codeableConceptElementMetadata
+}
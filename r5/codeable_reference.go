@@ -1,17 +1,24 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // CodeableReference Type: A reference to a resource (by instance), or instead, a reference to a concept defined in a terminology or ontology (by class).
 type CodeableReference struct {
 	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension      `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
 	Concept   *CodeableConcept `json:"concept,omitempty" bson:"concept,omitempty"`     // Reference to a concept (by class)
 	Reference *Reference       `json:"reference,omitempty" bson:"reference,omitempty"` // Reference to a resource (by instance)
 }
 
 func (r *CodeableReference) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Concept != nil {
 		if err := r.Concept.Validate(); err != nil {
 			return fmt.Errorf("Concept: %w", err)
@@ -24,3 +31,57 @@ func (r *CodeableReference) Validate() error {
 	}
 	return nil
 }
+
+func (r *CodeableReference) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Concept != nil {
+		r.Concept.validateAll(path+".concept", issues)
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+}
+
+func (r *CodeableReference) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CodeableReference", &issues)
+	checkInvariants(r, "CodeableReference", &issues)
+	return issues
+}
+
+var codeableReferenceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CodeableReference) invariants() []invariant {
+	return codeableReferenceInvariants
+}
+
+func (r CodeableReference) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CodeableReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CodeableReference) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CodeableReference) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codeableReferenceElementMetadata = []ElementMetadata{
+	{Path: "CodeableReference.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CodeableReference.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CodeableReference.concept", Name: "concept", Field: 2, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Summary: true},
+	{Path: "CodeableReference.reference", Name: "reference", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}, Summary: true},
+}
+
+func (r *CodeableReference) ElementMetadata() []ElementMetadata {
+	return codeableReferenceElementMetadata
+}
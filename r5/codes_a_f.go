@@ -11,6 +11,19 @@ const (
 	AccountStatusUnknown        AccountStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the AccountStatus codes.
+func (c AccountStatus) IsValid() bool {
+	switch c {
+	case AccountStatusActive,
+		AccountStatusInactive,
+		AccountStatusEnteredInError,
+		AccountStatusOnHold,
+		AccountStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // ActionApplicabilityBehavior represents codes from http://hl7.org/fhir/ValueSet/action-applicability-behavior
 type ActionApplicabilityBehavior string
 
@@ -19,6 +32,16 @@ const (
 	ActionApplicabilityBehaviorAny ActionApplicabilityBehavior = "any"
 )
 
+// IsValid reports whether c is one of the ActionApplicabilityBehavior codes.
+func (c ActionApplicabilityBehavior) IsValid() bool {
+	switch c {
+	case ActionApplicabilityBehaviorAll,
+		ActionApplicabilityBehaviorAny:
+		return true
+	}
+	return false
+}
+
 // ActionCardinalityBehavior represents codes from http://hl7.org/fhir/ValueSet/action-cardinality-behavior
 type ActionCardinalityBehavior string
 
@@ -27,6 +50,16 @@ const (
 	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
+// IsValid reports whether c is one of the ActionCardinalityBehavior codes.
+func (c ActionCardinalityBehavior) IsValid() bool {
+	switch c {
+	case ActionCardinalityBehaviorSingle,
+		ActionCardinalityBehaviorMultiple:
+		return true
+	}
+	return false
+}
+
 // ActionConditionKind represents codes from http://hl7.org/fhir/ValueSet/action-condition-kind
 type ActionConditionKind string
 
@@ -36,6 +69,17 @@ const (
 	ActionConditionKindStop          ActionConditionKind = "stop"
 )
 
+// IsValid reports whether c is one of the ActionConditionKind codes.
+func (c ActionConditionKind) IsValid() bool {
+	switch c {
+	case ActionConditionKindApplicability,
+		ActionConditionKindStart,
+		ActionConditionKindStop:
+		return true
+	}
+	return false
+}
+
 // ActionGroupingBehavior represents codes from http://hl7.org/fhir/ValueSet/action-grouping-behavior
 type ActionGroupingBehavior string
 
@@ -45,6 +89,17 @@ const (
 	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
+// IsValid reports whether c is one of the ActionGroupingBehavior codes.
+func (c ActionGroupingBehavior) IsValid() bool {
+	switch c {
+	case ActionGroupingBehaviorVisualGroup,
+		ActionGroupingBehaviorLogicalGroup,
+		ActionGroupingBehaviorSentenceGroup:
+		return true
+	}
+	return false
+}
+
 // ActionParticipantType represents codes from http://hl7.org/fhir/ValueSet/action-participant-type
 type ActionParticipantType string
 
@@ -61,6 +116,24 @@ const (
 	ActionParticipantTypeRelatedperson     ActionParticipantType = "relatedperson"
 )
 
+// IsValid reports whether c is one of the ActionParticipantType codes.
+func (c ActionParticipantType) IsValid() bool {
+	switch c {
+	case ActionParticipantTypeCareteam,
+		ActionParticipantTypeDevice,
+		ActionParticipantTypeGroup,
+		ActionParticipantTypeHealthcareservice,
+		ActionParticipantTypeLocation,
+		ActionParticipantTypeOrganization,
+		ActionParticipantTypePatient,
+		ActionParticipantTypePractitioner,
+		ActionParticipantTypePractitionerrole,
+		ActionParticipantTypeRelatedperson:
+		return true
+	}
+	return false
+}
+
 // ActionPrecheckBehavior represents codes from http://hl7.org/fhir/ValueSet/action-precheck-behavior
 type ActionPrecheckBehavior string
 
@@ -69,6 +142,16 @@ const (
 	ActionPrecheckBehaviorNo  ActionPrecheckBehavior = "no"
 )
 
+// IsValid reports whether c is one of the ActionPrecheckBehavior codes.
+func (c ActionPrecheckBehavior) IsValid() bool {
+	switch c {
+	case ActionPrecheckBehaviorYes,
+		ActionPrecheckBehaviorNo:
+		return true
+	}
+	return false
+}
+
 // ActionRelationshipType represents codes from http://hl7.org/fhir/ValueSet/action-relationship-type
 type ActionRelationshipType string
 
@@ -84,6 +167,23 @@ const (
 	ActionRelationshipTypeAfterEnd            ActionRelationshipType = "after-end"
 )
 
+// IsValid reports whether c is one of the ActionRelationshipType codes.
+func (c ActionRelationshipType) IsValid() bool {
+	switch c {
+	case ActionRelationshipTypeBefore,
+		ActionRelationshipTypeBeforeStart,
+		ActionRelationshipTypeBeforeEnd,
+		ActionRelationshipTypeConcurrent,
+		ActionRelationshipTypeConcurrentWithStart,
+		ActionRelationshipTypeConcurrentWithEnd,
+		ActionRelationshipTypeAfter,
+		ActionRelationshipTypeAfterStart,
+		ActionRelationshipTypeAfterEnd:
+		return true
+	}
+	return false
+}
+
 // ActionRequiredBehavior represents codes from http://hl7.org/fhir/ValueSet/action-required-behavior
 type ActionRequiredBehavior string
 
@@ -93,6 +193,17 @@ const (
 	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
+// IsValid reports whether c is one of the ActionRequiredBehavior codes.
+func (c ActionRequiredBehavior) IsValid() bool {
+	switch c {
+	case ActionRequiredBehaviorMust,
+		ActionRequiredBehaviorCould,
+		ActionRequiredBehaviorMustUnlessDocumented:
+		return true
+	}
+	return false
+}
+
 // ActionSelectionBehavior represents codes from http://hl7.org/fhir/ValueSet/action-selection-behavior
 type ActionSelectionBehavior string
 
@@ -105,6 +216,20 @@ const (
 	ActionSelectionBehaviorOneOrMore  ActionSelectionBehavior = "one-or-more"
 )
 
+// IsValid reports whether c is one of the ActionSelectionBehavior codes.
+func (c ActionSelectionBehavior) IsValid() bool {
+	switch c {
+	case ActionSelectionBehaviorAny,
+		ActionSelectionBehaviorAll,
+		ActionSelectionBehaviorAllOrNone,
+		ActionSelectionBehaviorExactlyOne,
+		ActionSelectionBehaviorAtMostOne,
+		ActionSelectionBehaviorOneOrMore:
+		return true
+	}
+	return false
+}
+
 // ActorDefinitionActorType represents codes from http://hl7.org/fhir/ValueSet/actordefinition-actor-type
 type ActorDefinitionActorType string
 
@@ -115,6 +240,18 @@ const (
 	ActorDefinitionActorTypeOther      ActorDefinitionActorType = "other"
 )
 
+// IsValid reports whether c is one of the ActorDefinitionActorType codes.
+func (c ActorDefinitionActorType) IsValid() bool {
+	switch c {
+	case ActorDefinitionActorTypePerson,
+		ActorDefinitionActorTypeSystem,
+		ActorDefinitionActorTypeCollective,
+		ActorDefinitionActorTypeOther:
+		return true
+	}
+	return false
+}
+
 // AdditionalBindingPurposeVS represents codes from http://hl7.org/fhir/ValueSet/additional-binding-purpose
 type AdditionalBindingPurposeVS string
 
@@ -134,6 +271,27 @@ const (
 	AdditionalBindingPurposeVSComponent         AdditionalBindingPurposeVS = "component"
 )
 
+// IsValid reports whether c is one of the AdditionalBindingPurposeVS codes.
+func (c AdditionalBindingPurposeVS) IsValid() bool {
+	switch c {
+	case AdditionalBindingPurposeVSMaximum,
+		AdditionalBindingPurposeVSMinimum,
+		AdditionalBindingPurposeVSRequired,
+		AdditionalBindingPurposeVSExtensible,
+		AdditionalBindingPurposeVSCandidate,
+		AdditionalBindingPurposeVSCurrent,
+		AdditionalBindingPurposeVSCurrentExtensible,
+		AdditionalBindingPurposeVSBestPractice,
+		AdditionalBindingPurposeVSOpen,
+		AdditionalBindingPurposeVSPreferred,
+		AdditionalBindingPurposeVSUi,
+		AdditionalBindingPurposeVSStarter,
+		AdditionalBindingPurposeVSComponent:
+		return true
+	}
+	return false
+}
+
 // AddressType represents codes from http://hl7.org/fhir/ValueSet/address-type
 type AddressType string
 
@@ -143,6 +301,17 @@ const (
 	AddressTypeBoth     AddressType = "both"
 )
 
+// IsValid reports whether c is one of the AddressType codes.
+func (c AddressType) IsValid() bool {
+	switch c {
+	case AddressTypePostal,
+		AddressTypePhysical,
+		AddressTypeBoth:
+		return true
+	}
+	return false
+}
+
 // AddressUse represents codes from http://hl7.org/fhir/ValueSet/address-use
 type AddressUse string
 
@@ -154,6 +323,19 @@ const (
 	AddressUseBilling AddressUse = "billing"
 )
 
+// IsValid reports whether c is one of the AddressUse codes.
+func (c AddressUse) IsValid() bool {
+	switch c {
+	case AddressUseHome,
+		AddressUseWork,
+		AddressUseTemp,
+		AddressUseOld,
+		AddressUseBilling:
+		return true
+	}
+	return false
+}
+
 // AdministrativeGender represents codes from http://hl7.org/fhir/ValueSet/administrative-gender
 type AdministrativeGender string
 
@@ -164,6 +346,18 @@ const (
 	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
+// IsValid reports whether c is one of the AdministrativeGender codes.
+func (c AdministrativeGender) IsValid() bool {
+	switch c {
+	case AdministrativeGenderMale,
+		AdministrativeGenderFemale,
+		AdministrativeGenderOther,
+		AdministrativeGenderUnknown:
+		return true
+	}
+	return false
+}
+
 // AdverseEventActuality represents codes from http://hl7.org/fhir/ValueSet/adverse-event-actuality
 type AdverseEventActuality string
 
@@ -172,6 +366,16 @@ const (
 	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
+// IsValid reports whether c is one of the AdverseEventActuality codes.
+func (c AdverseEventActuality) IsValid() bool {
+	switch c {
+	case AdverseEventActualityActual,
+		AdverseEventActualityPotential:
+		return true
+	}
+	return false
+}
+
 // AdverseEventStatus represents codes from http://hl7.org/fhir/ValueSet/adverse-event-status
 type AdverseEventStatus string
 
@@ -186,6 +390,22 @@ const (
 	AdverseEventStatusUnknown        AdverseEventStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the AdverseEventStatus codes.
+func (c AdverseEventStatus) IsValid() bool {
+	switch c {
+	case AdverseEventStatusPreparation,
+		AdverseEventStatusInProgress,
+		AdverseEventStatusNotDone,
+		AdverseEventStatusOnHold,
+		AdverseEventStatusStopped,
+		AdverseEventStatusCompleted,
+		AdverseEventStatusEnteredInError,
+		AdverseEventStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // AggregationMode represents codes from http://hl7.org/fhir/ValueSet/resource-aggregation-mode
 type AggregationMode string
 
@@ -195,6 +415,17 @@ const (
 	AggregationModeBundled    AggregationMode = "bundled"
 )
 
+// IsValid reports whether c is one of the AggregationMode codes.
+func (c AggregationMode) IsValid() bool {
+	switch c {
+	case AggregationModeContained,
+		AggregationModeReferenced,
+		AggregationModeBundled:
+		return true
+	}
+	return false
+}
+
 // AllergyIntoleranceCategory represents codes from http://hl7.org/fhir/ValueSet/allergy-intolerance-category
 type AllergyIntoleranceCategory string
 
@@ -205,6 +436,18 @@ const (
 	AllergyIntoleranceCategoryBiologic    AllergyIntoleranceCategory = "biologic"
 )
 
+// IsValid reports whether c is one of the AllergyIntoleranceCategory codes.
+func (c AllergyIntoleranceCategory) IsValid() bool {
+	switch c {
+	case AllergyIntoleranceCategoryFood,
+		AllergyIntoleranceCategoryMedication,
+		AllergyIntoleranceCategoryEnvironment,
+		AllergyIntoleranceCategoryBiologic:
+		return true
+	}
+	return false
+}
+
 // AllergyIntoleranceCriticality represents codes from http://hl7.org/fhir/ValueSet/allergy-intolerance-criticality
 type AllergyIntoleranceCriticality string
 
@@ -214,6 +457,17 @@ const (
 	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
+// IsValid reports whether c is one of the AllergyIntoleranceCriticality codes.
+func (c AllergyIntoleranceCriticality) IsValid() bool {
+	switch c {
+	case AllergyIntoleranceCriticalityLow,
+		AllergyIntoleranceCriticalityHigh,
+		AllergyIntoleranceCriticalityUnableToAssess:
+		return true
+	}
+	return false
+}
+
 // AllergyIntoleranceSeverity represents codes from http://hl7.org/fhir/ValueSet/reaction-event-severity
 type AllergyIntoleranceSeverity string
 
@@ -223,6 +477,17 @@ const (
 	AllergyIntoleranceSeveritySevere   AllergyIntoleranceSeverity = "severe"
 )
 
+// IsValid reports whether c is one of the AllergyIntoleranceSeverity codes.
+func (c AllergyIntoleranceSeverity) IsValid() bool {
+	switch c {
+	case AllergyIntoleranceSeverityMild,
+		AllergyIntoleranceSeverityModerate,
+		AllergyIntoleranceSeveritySevere:
+		return true
+	}
+	return false
+}
+
 // AppointmentResponseStatus represents codes from http://hl7.org/fhir/ValueSet/appointmentresponse-status
 type AppointmentResponseStatus string
 
@@ -243,6 +508,28 @@ const (
 	AppointmentResponseStatusWaitlist       AppointmentResponseStatus = "waitlist"
 )
 
+// IsValid reports whether c is one of the AppointmentResponseStatus codes.
+func (c AppointmentResponseStatus) IsValid() bool {
+	switch c {
+	case AppointmentResponseStatusAccepted,
+		AppointmentResponseStatusDeclined,
+		AppointmentResponseStatusTentative,
+		AppointmentResponseStatusNeedsAction,
+		AppointmentResponseStatusProposed,
+		AppointmentResponseStatusPending,
+		AppointmentResponseStatusBooked,
+		AppointmentResponseStatusArrived,
+		AppointmentResponseStatusFulfilled,
+		AppointmentResponseStatusCancelled,
+		AppointmentResponseStatusNoshow,
+		AppointmentResponseStatusEnteredInError,
+		AppointmentResponseStatusCheckedIn,
+		AppointmentResponseStatusWaitlist:
+		return true
+	}
+	return false
+}
+
 // AppointmentStatus represents codes from http://hl7.org/fhir/ValueSet/appointmentstatus
 type AppointmentStatus string
 
@@ -259,6 +546,24 @@ const (
 	AppointmentStatusWaitlist       AppointmentStatus = "waitlist"
 )
 
+// IsValid reports whether c is one of the AppointmentStatus codes.
+func (c AppointmentStatus) IsValid() bool {
+	switch c {
+	case AppointmentStatusProposed,
+		AppointmentStatusPending,
+		AppointmentStatusBooked,
+		AppointmentStatusArrived,
+		AppointmentStatusFulfilled,
+		AppointmentStatusCancelled,
+		AppointmentStatusNoshow,
+		AppointmentStatusEnteredInError,
+		AppointmentStatusCheckedIn,
+		AppointmentStatusWaitlist:
+		return true
+	}
+	return false
+}
+
 // ArtifactAssessmentDisposition represents codes from http://hl7.org/fhir/ValueSet/artifactassessment-disposition
 type ArtifactAssessmentDisposition string
 
@@ -270,6 +575,19 @@ const (
 	ArtifactAssessmentDispositionNotPersuasiveWithModification ArtifactAssessmentDisposition = "not-persuasive-with-modification"
 )
 
+// IsValid reports whether c is one of the ArtifactAssessmentDisposition codes.
+func (c ArtifactAssessmentDisposition) IsValid() bool {
+	switch c {
+	case ArtifactAssessmentDispositionUnresolved,
+		ArtifactAssessmentDispositionNotPersuasive,
+		ArtifactAssessmentDispositionPersuasive,
+		ArtifactAssessmentDispositionPersuasiveWithModification,
+		ArtifactAssessmentDispositionNotPersuasiveWithModification:
+		return true
+	}
+	return false
+}
+
 // ArtifactAssessmentWorkflowStatus represents codes from http://hl7.org/fhir/ValueSet/artifactassessment-workflow-status
 type ArtifactAssessmentWorkflowStatus string
 
@@ -286,6 +604,24 @@ const (
 	ArtifactAssessmentWorkflowStatusEnteredInError         ArtifactAssessmentWorkflowStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the ArtifactAssessmentWorkflowStatus codes.
+func (c ArtifactAssessmentWorkflowStatus) IsValid() bool {
+	switch c {
+	case ArtifactAssessmentWorkflowStatusSubmitted,
+		ArtifactAssessmentWorkflowStatusTriaged,
+		ArtifactAssessmentWorkflowStatusWaitingForInput,
+		ArtifactAssessmentWorkflowStatusResolvedNoChange,
+		ArtifactAssessmentWorkflowStatusResolvedChangeRequired,
+		ArtifactAssessmentWorkflowStatusDeferred,
+		ArtifactAssessmentWorkflowStatusDuplicate,
+		ArtifactAssessmentWorkflowStatusApplied,
+		ArtifactAssessmentWorkflowStatusPublished,
+		ArtifactAssessmentWorkflowStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // AuditEventAction represents codes from http://hl7.org/fhir/ValueSet/audit-event-action
 type AuditEventAction string
 
@@ -297,6 +633,19 @@ const (
 	AuditEventActionE AuditEventAction = "E"
 )
 
+// IsValid reports whether c is one of the AuditEventAction codes.
+func (c AuditEventAction) IsValid() bool {
+	switch c {
+	case AuditEventActionC,
+		AuditEventActionR,
+		AuditEventActionU,
+		AuditEventActionD,
+		AuditEventActionE:
+		return true
+	}
+	return false
+}
+
 // AuditEventSeverity represents codes from http://hl7.org/fhir/ValueSet/audit-event-severity
 type AuditEventSeverity string
 
@@ -311,6 +660,22 @@ const (
 	AuditEventSeverityDebug         AuditEventSeverity = "debug"
 )
 
+// IsValid reports whether c is one of the AuditEventSeverity codes.
+func (c AuditEventSeverity) IsValid() bool {
+	switch c {
+	case AuditEventSeverityEmergency,
+		AuditEventSeverityAlert,
+		AuditEventSeverityCritical,
+		AuditEventSeverityError,
+		AuditEventSeverityWarning,
+		AuditEventSeverityNotice,
+		AuditEventSeverityInformational,
+		AuditEventSeverityDebug:
+		return true
+	}
+	return false
+}
+
 // BindingStrength represents codes from http://hl7.org/fhir/ValueSet/binding-strength
 type BindingStrength string
 
@@ -322,6 +687,19 @@ const (
 	BindingStrengthDescriptive BindingStrength = "descriptive"
 )
 
+// IsValid reports whether c is one of the BindingStrength codes.
+func (c BindingStrength) IsValid() bool {
+	switch c {
+	case BindingStrengthRequired,
+		BindingStrengthExtensible,
+		BindingStrengthPreferred,
+		BindingStrengthExample,
+		BindingStrengthDescriptive:
+		return true
+	}
+	return false
+}
+
 // BundleType represents codes from http://hl7.org/fhir/ValueSet/bundle-type
 type BundleType string
 
@@ -338,6 +716,24 @@ const (
 	BundleTypeSubscriptionNotification BundleType = "subscription-notification"
 )
 
+// IsValid reports whether c is one of the BundleType codes.
+func (c BundleType) IsValid() bool {
+	switch c {
+	case BundleTypeDocument,
+		BundleTypeMessage,
+		BundleTypeTransaction,
+		BundleTypeTransactionResponse,
+		BundleTypeBatch,
+		BundleTypeBatchResponse,
+		BundleTypeHistory,
+		BundleTypeSearchset,
+		BundleTypeCollection,
+		BundleTypeSubscriptionNotification:
+		return true
+	}
+	return false
+}
+
 // CapabilityStatementKind represents codes from http://hl7.org/fhir/ValueSet/capability-statement-kind
 type CapabilityStatementKind string
 
@@ -347,6 +743,17 @@ const (
 	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
+// IsValid reports whether c is one of the CapabilityStatementKind codes.
+func (c CapabilityStatementKind) IsValid() bool {
+	switch c {
+	case CapabilityStatementKindInstance,
+		CapabilityStatementKindCapability,
+		CapabilityStatementKindRequirements:
+		return true
+	}
+	return false
+}
+
 // CarePlanIntent represents codes from http://hl7.org/fhir/ValueSet/care-plan-intent
 type CarePlanIntent string
 
@@ -364,6 +771,25 @@ const (
 	CarePlanIntentOption        CarePlanIntent = "option"
 )
 
+// IsValid reports whether c is one of the CarePlanIntent codes.
+func (c CarePlanIntent) IsValid() bool {
+	switch c {
+	case CarePlanIntentProposal,
+		CarePlanIntentSolicitOffer,
+		CarePlanIntentOfferResponse,
+		CarePlanIntentPlan,
+		CarePlanIntentDirective,
+		CarePlanIntentOrder,
+		CarePlanIntentOriginalOrder,
+		CarePlanIntentReflexOrder,
+		CarePlanIntentFillerOrder,
+		CarePlanIntentInstanceOrder,
+		CarePlanIntentOption:
+		return true
+	}
+	return false
+}
+
 // CareTeamStatus represents codes from http://hl7.org/fhir/ValueSet/care-team-status
 type CareTeamStatus string
 
@@ -375,6 +801,19 @@ const (
 	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the CareTeamStatus codes.
+func (c CareTeamStatus) IsValid() bool {
+	switch c {
+	case CareTeamStatusProposed,
+		CareTeamStatusActive,
+		CareTeamStatusSuspended,
+		CareTeamStatusInactive,
+		CareTeamStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // ClaimProcessingCodes represents codes from http://hl7.org/fhir/ValueSet/claim-outcome
 type ClaimProcessingCodes string
 
@@ -385,6 +824,18 @@ const (
 	ClaimProcessingCodesPartial  ClaimProcessingCodes = "partial"
 )
 
+// IsValid reports whether c is one of the ClaimProcessingCodes codes.
+func (c ClaimProcessingCodes) IsValid() bool {
+	switch c {
+	case ClaimProcessingCodesQueued,
+		ClaimProcessingCodesComplete,
+		ClaimProcessingCodesError,
+		ClaimProcessingCodesPartial:
+		return true
+	}
+	return false
+}
+
 // ClinicalUseDefinitionType represents codes from http://hl7.org/fhir/ValueSet/clinical-use-definition-type
 type ClinicalUseDefinitionType string
 
@@ -396,6 +847,19 @@ const (
 	ClinicalUseDefinitionTypeWarning           ClinicalUseDefinitionType = "warning"
 )
 
+// IsValid reports whether c is one of the ClinicalUseDefinitionType codes.
+func (c ClinicalUseDefinitionType) IsValid() bool {
+	switch c {
+	case ClinicalUseDefinitionTypeIndication,
+		ClinicalUseDefinitionTypeContraindication,
+		ClinicalUseDefinitionTypeInteraction,
+		ClinicalUseDefinitionTypeUndesirableEffect,
+		ClinicalUseDefinitionTypeWarning:
+		return true
+	}
+	return false
+}
+
 // CodeSearchSupport represents codes from http://hl7.org/fhir/ValueSet/code-search-support
 type CodeSearchSupport string
 
@@ -405,6 +869,17 @@ const (
 	CodeSearchSupportInComposeOrExpansion CodeSearchSupport = "in-compose-or-expansion"
 )
 
+// IsValid reports whether c is one of the CodeSearchSupport codes.
+func (c CodeSearchSupport) IsValid() bool {
+	switch c {
+	case CodeSearchSupportInCompose,
+		CodeSearchSupportInExpansion,
+		CodeSearchSupportInComposeOrExpansion:
+		return true
+	}
+	return false
+}
+
 // CodeSystemContentMode represents codes from http://hl7.org/fhir/ValueSet/codesystem-content-mode
 type CodeSystemContentMode string
 
@@ -416,6 +891,19 @@ const (
 	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
+// IsValid reports whether c is one of the CodeSystemContentMode codes.
+func (c CodeSystemContentMode) IsValid() bool {
+	switch c {
+	case CodeSystemContentModeNotPresent,
+		CodeSystemContentModeExample,
+		CodeSystemContentModeFragment,
+		CodeSystemContentModeComplete,
+		CodeSystemContentModeSupplement:
+		return true
+	}
+	return false
+}
+
 // CodeSystemHierarchyMeaning represents codes from http://hl7.org/fhir/ValueSet/codesystem-hierarchy-meaning
 type CodeSystemHierarchyMeaning string
 
@@ -426,6 +914,18 @@ const (
 	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
+// IsValid reports whether c is one of the CodeSystemHierarchyMeaning codes.
+func (c CodeSystemHierarchyMeaning) IsValid() bool {
+	switch c {
+	case CodeSystemHierarchyMeaningGroupedBy,
+		CodeSystemHierarchyMeaningIsA,
+		CodeSystemHierarchyMeaningPartOf,
+		CodeSystemHierarchyMeaningClassifiedWith:
+		return true
+	}
+	return false
+}
+
 // ColorCodesOrRGB represents codes from http://hl7.org/fhir/ValueSet/color-codes
 type ColorCodesOrRGB string
 
@@ -580,6 +1080,162 @@ const (
 	ColorCodesOrRGBYellowgreen          ColorCodesOrRGB = "yellowgreen"
 )
 
+// IsValid reports whether c is one of the ColorCodesOrRGB codes.
+func (c ColorCodesOrRGB) IsValid() bool {
+	switch c {
+	case ColorCodesOrRGBAliceblue,
+		ColorCodesOrRGBAntiquewhite,
+		ColorCodesOrRGBAqua,
+		ColorCodesOrRGBAquamarine,
+		ColorCodesOrRGBAzure,
+		ColorCodesOrRGBBeige,
+		ColorCodesOrRGBBisque,
+		ColorCodesOrRGBBlack,
+		ColorCodesOrRGBBlanchedalmond,
+		ColorCodesOrRGBBlue,
+		ColorCodesOrRGBBlueviolet,
+		ColorCodesOrRGBBrown,
+		ColorCodesOrRGBBurlywood,
+		ColorCodesOrRGBCadetblue,
+		ColorCodesOrRGBChartreuse,
+		ColorCodesOrRGBChocolate,
+		ColorCodesOrRGBCoral,
+		ColorCodesOrRGBCornflowerblue,
+		ColorCodesOrRGBCornsilk,
+		ColorCodesOrRGBCrimson,
+		ColorCodesOrRGBCyan,
+		ColorCodesOrRGBDarkblue,
+		ColorCodesOrRGBDarkcyan,
+		ColorCodesOrRGBDarkgoldenrod,
+		ColorCodesOrRGBDarkgray,
+		ColorCodesOrRGBDarkgreen,
+		ColorCodesOrRGBDarkgrey,
+		ColorCodesOrRGBDarkkhaki,
+		ColorCodesOrRGBDarkmagenta,
+		ColorCodesOrRGBDarkolivegreen,
+		ColorCodesOrRGBDarkorange,
+		ColorCodesOrRGBDarkorchid,
+		ColorCodesOrRGBDarkred,
+		ColorCodesOrRGBDarksalmon,
+		ColorCodesOrRGBDarkseagreen,
+		ColorCodesOrRGBDarkslateblue,
+		ColorCodesOrRGBDarkslategray,
+		ColorCodesOrRGBDarkslategrey,
+		ColorCodesOrRGBDarkturquoise,
+		ColorCodesOrRGBDarkviolet,
+		ColorCodesOrRGBDeeppink,
+		ColorCodesOrRGBDeepskyblue,
+		ColorCodesOrRGBDimgray,
+		ColorCodesOrRGBDimgrey,
+		ColorCodesOrRGBDodgerblue,
+		ColorCodesOrRGBFirebrick,
+		ColorCodesOrRGBFloralwhite,
+		ColorCodesOrRGBForestgreen,
+		ColorCodesOrRGBFuchsia,
+		ColorCodesOrRGBGainsboro,
+		ColorCodesOrRGBGhostwhite,
+		ColorCodesOrRGBGold,
+		ColorCodesOrRGBGoldenrod,
+		ColorCodesOrRGBGray,
+		ColorCodesOrRGBGreen,
+		ColorCodesOrRGBGreenyellow,
+		ColorCodesOrRGBGrey,
+		ColorCodesOrRGBHoneydew,
+		ColorCodesOrRGBHotpink,
+		ColorCodesOrRGBIndianred,
+		ColorCodesOrRGBIndigo,
+		ColorCodesOrRGBIvory,
+		ColorCodesOrRGBKhaki,
+		ColorCodesOrRGBLavender,
+		ColorCodesOrRGBLavenderblush,
+		ColorCodesOrRGBLawngreen,
+		ColorCodesOrRGBLemonchiffon,
+		ColorCodesOrRGBLightblue,
+		ColorCodesOrRGBLightcoral,
+		ColorCodesOrRGBLightcyan,
+		ColorCodesOrRGBLightgoldenrodyellow,
+		ColorCodesOrRGBLightgray,
+		ColorCodesOrRGBLightgreen,
+		ColorCodesOrRGBLightgrey,
+		ColorCodesOrRGBLightpink,
+		ColorCodesOrRGBLightsalmon,
+		ColorCodesOrRGBLightseagreen,
+		ColorCodesOrRGBLightskyblue,
+		ColorCodesOrRGBLightslategray,
+		ColorCodesOrRGBLightslategrey,
+		ColorCodesOrRGBLightsteelblue,
+		ColorCodesOrRGBLightyellow,
+		ColorCodesOrRGBLime,
+		ColorCodesOrRGBLimegreen,
+		ColorCodesOrRGBLinen,
+		ColorCodesOrRGBMagenta,
+		ColorCodesOrRGBMaroon,
+		ColorCodesOrRGBMediumaquamarine,
+		ColorCodesOrRGBMediumblue,
+		ColorCodesOrRGBMediumorchid,
+		ColorCodesOrRGBMediumpurple,
+		ColorCodesOrRGBMediumseagreen,
+		ColorCodesOrRGBMediumslateblue,
+		ColorCodesOrRGBMediumspringgreen,
+		ColorCodesOrRGBMediumturquoise,
+		ColorCodesOrRGBMediumvioletred,
+		ColorCodesOrRGBMidnightblue,
+		ColorCodesOrRGBMintcream,
+		ColorCodesOrRGBMistyrose,
+		ColorCodesOrRGBMoccasin,
+		ColorCodesOrRGBNavajowhite,
+		ColorCodesOrRGBNavy,
+		ColorCodesOrRGBOldlace,
+		ColorCodesOrRGBOlive,
+		ColorCodesOrRGBOlivedrab,
+		ColorCodesOrRGBOrange,
+		ColorCodesOrRGBOrangered,
+		ColorCodesOrRGBOrchid,
+		ColorCodesOrRGBPalegoldenrod,
+		ColorCodesOrRGBPalegreen,
+		ColorCodesOrRGBPaleturquoise,
+		ColorCodesOrRGBPalevioletred,
+		ColorCodesOrRGBPapayawhip,
+		ColorCodesOrRGBPeachpuff,
+		ColorCodesOrRGBPeru,
+		ColorCodesOrRGBPink,
+		ColorCodesOrRGBPlum,
+		ColorCodesOrRGBPowderblue,
+		ColorCodesOrRGBPurple,
+		ColorCodesOrRGBRebeccapurple,
+		ColorCodesOrRGBRed,
+		ColorCodesOrRGBRosybrown,
+		ColorCodesOrRGBRoyalblue,
+		ColorCodesOrRGBSaddlebrown,
+		ColorCodesOrRGBSalmon,
+		ColorCodesOrRGBSandybrown,
+		ColorCodesOrRGBSeagreen,
+		ColorCodesOrRGBSeashell,
+		ColorCodesOrRGBSienna,
+		ColorCodesOrRGBSilver,
+		ColorCodesOrRGBSkyblue,
+		ColorCodesOrRGBSlateblue,
+		ColorCodesOrRGBSlategray,
+		ColorCodesOrRGBSlategrey,
+		ColorCodesOrRGBSnow,
+		ColorCodesOrRGBSpringgreen,
+		ColorCodesOrRGBSteelblue,
+		ColorCodesOrRGBTan,
+		ColorCodesOrRGBTeal,
+		ColorCodesOrRGBThistle,
+		ColorCodesOrRGBTomato,
+		ColorCodesOrRGBTurquoise,
+		ColorCodesOrRGBViolet,
+		ColorCodesOrRGBWheat,
+		ColorCodesOrRGBWhite,
+		ColorCodesOrRGBWhitesmoke,
+		ColorCodesOrRGBYellow,
+		ColorCodesOrRGBYellowgreen:
+		return true
+	}
+	return false
+}
+
 // ComparisonOperationVS represents codes from http://hl7.org/fhir/ValueSet/comparison-operation
 type ComparisonOperationVS string
 
@@ -597,6 +1253,25 @@ const (
 	ComparisonOperationVSAp  ComparisonOperationVS = "ap"
 )
 
+// IsValid reports whether c is one of the ComparisonOperationVS codes.
+func (c ComparisonOperationVS) IsValid() bool {
+	switch c {
+	case ComparisonOperationVSEq,
+		ComparisonOperationVSNe,
+		ComparisonOperationVSIn,
+		ComparisonOperationVSNin,
+		ComparisonOperationVSGt,
+		ComparisonOperationVSLt,
+		ComparisonOperationVSGe,
+		ComparisonOperationVSLe,
+		ComparisonOperationVSSa,
+		ComparisonOperationVSEb,
+		ComparisonOperationVSAp:
+		return true
+	}
+	return false
+}
+
 // CompartmentType represents codes from http://hl7.org/fhir/ValueSet/compartment-type
 type CompartmentType string
 
@@ -609,6 +1284,20 @@ const (
 	CompartmentTypeEpisodeOfCare CompartmentType = "EpisodeOfCare"
 )
 
+// IsValid reports whether c is one of the CompartmentType codes.
+func (c CompartmentType) IsValid() bool {
+	switch c {
+	case CompartmentTypePatient,
+		CompartmentTypeEncounter,
+		CompartmentTypeRelatedPerson,
+		CompartmentTypePractitioner,
+		CompartmentTypeDevice,
+		CompartmentTypeEpisodeOfCare:
+		return true
+	}
+	return false
+}
+
 // CompositionStatus represents codes from http://hl7.org/fhir/ValueSet/composition-status
 type CompositionStatus string
 
@@ -626,6 +1315,25 @@ const (
 	CompositionStatusUnknown        CompositionStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the CompositionStatus codes.
+func (c CompositionStatus) IsValid() bool {
+	switch c {
+	case CompositionStatusRegistered,
+		CompositionStatusPartial,
+		CompositionStatusPreliminary,
+		CompositionStatusFinal,
+		CompositionStatusAmended,
+		CompositionStatusCorrected,
+		CompositionStatusAppended,
+		CompositionStatusCancelled,
+		CompositionStatusEnteredInError,
+		CompositionStatusDeprecated,
+		CompositionStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // ConceptMapAttributeType represents codes from http://hl7.org/fhir/ValueSet/conceptmap-attribute-type
 type ConceptMapAttributeType string
 
@@ -637,6 +1345,19 @@ const (
 	ConceptMapAttributeTypeQuantity ConceptMapAttributeType = "Quantity"
 )
 
+// IsValid reports whether c is one of the ConceptMapAttributeType codes.
+func (c ConceptMapAttributeType) IsValid() bool {
+	switch c {
+	case ConceptMapAttributeTypeCode,
+		ConceptMapAttributeTypeCoding,
+		ConceptMapAttributeTypeString,
+		ConceptMapAttributeTypeBoolean,
+		ConceptMapAttributeTypeQuantity:
+		return true
+	}
+	return false
+}
+
 // ConceptMapGroupUnmappedMode represents codes from http://hl7.org/fhir/ValueSet/conceptmap-unmapped-mode
 type ConceptMapGroupUnmappedMode string
 
@@ -646,6 +1367,17 @@ const (
 	ConceptMapGroupUnmappedModeOtherMap      ConceptMapGroupUnmappedMode = "other-map"
 )
 
+// IsValid reports whether c is one of the ConceptMapGroupUnmappedMode codes.
+func (c ConceptMapGroupUnmappedMode) IsValid() bool {
+	switch c {
+	case ConceptMapGroupUnmappedModeUseSourceCode,
+		ConceptMapGroupUnmappedModeFixed,
+		ConceptMapGroupUnmappedModeOtherMap:
+		return true
+	}
+	return false
+}
+
 // ConceptMapPropertyType represents codes from http://hl7.org/fhir/ValueSet/conceptmap-property-type
 type ConceptMapPropertyType string
 
@@ -659,6 +1391,21 @@ const (
 	ConceptMapPropertyTypeCode     ConceptMapPropertyType = "code"
 )
 
+// IsValid reports whether c is one of the ConceptMapPropertyType codes.
+func (c ConceptMapPropertyType) IsValid() bool {
+	switch c {
+	case ConceptMapPropertyTypeCoding,
+		ConceptMapPropertyTypeString,
+		ConceptMapPropertyTypeInteger,
+		ConceptMapPropertyTypeBoolean,
+		ConceptMapPropertyTypeDateTime,
+		ConceptMapPropertyTypeDecimal,
+		ConceptMapPropertyTypeCode:
+		return true
+	}
+	return false
+}
+
 // ConceptMapRelationship represents codes from http://hl7.org/fhir/ValueSet/concept-map-relationship
 type ConceptMapRelationship string
 
@@ -670,6 +1417,19 @@ const (
 	ConceptMapRelationshipNotRelatedTo               ConceptMapRelationship = "not-related-to"
 )
 
+// IsValid reports whether c is one of the ConceptMapRelationship codes.
+func (c ConceptMapRelationship) IsValid() bool {
+	switch c {
+	case ConceptMapRelationshipRelatedTo,
+		ConceptMapRelationshipEquivalent,
+		ConceptMapRelationshipSourceIsNarrowerThanTarget,
+		ConceptMapRelationshipSourceIsBroaderThanTarget,
+		ConceptMapRelationshipNotRelatedTo:
+		return true
+	}
+	return false
+}
+
 // ConditionalDeleteStatus represents codes from http://hl7.org/fhir/ValueSet/conditional-delete-status
 type ConditionalDeleteStatus string
 
@@ -679,6 +1439,17 @@ const (
 	ConditionalDeleteStatusMultiple     ConditionalDeleteStatus = "multiple"
 )
 
+// IsValid reports whether c is one of the ConditionalDeleteStatus codes.
+func (c ConditionalDeleteStatus) IsValid() bool {
+	switch c {
+	case ConditionalDeleteStatusNotSupported,
+		ConditionalDeleteStatusSingle,
+		ConditionalDeleteStatusMultiple:
+		return true
+	}
+	return false
+}
+
 // ConditionalReadStatus represents codes from http://hl7.org/fhir/ValueSet/conditional-read-status
 type ConditionalReadStatus string
 
@@ -689,6 +1460,18 @@ const (
 	ConditionalReadStatusFullSupport   ConditionalReadStatus = "full-support"
 )
 
+// IsValid reports whether c is one of the ConditionalReadStatus codes.
+func (c ConditionalReadStatus) IsValid() bool {
+	switch c {
+	case ConditionalReadStatusNotSupported,
+		ConditionalReadStatusModifiedSince,
+		ConditionalReadStatusNotMatch,
+		ConditionalReadStatusFullSupport:
+		return true
+	}
+	return false
+}
+
 // ConformanceExpectation represents codes from http://hl7.org/fhir/ValueSet/conformance-expectation
 type ConformanceExpectation string
 
@@ -700,6 +1483,19 @@ const (
 	ConformanceExpectationSHALLNOT  ConformanceExpectation = "SHALL-NOT"
 )
 
+// IsValid reports whether c is one of the ConformanceExpectation codes.
+func (c ConformanceExpectation) IsValid() bool {
+	switch c {
+	case ConformanceExpectationSHALL,
+		ConformanceExpectationSHOULD,
+		ConformanceExpectationMAY,
+		ConformanceExpectationSHOULDNOT,
+		ConformanceExpectationSHALLNOT:
+		return true
+	}
+	return false
+}
+
 // ConsentDataMeaning represents codes from http://hl7.org/fhir/ValueSet/consent-data-meaning
 type ConsentDataMeaning string
 
@@ -710,6 +1506,18 @@ const (
 	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
+// IsValid reports whether c is one of the ConsentDataMeaning codes.
+func (c ConsentDataMeaning) IsValid() bool {
+	switch c {
+	case ConsentDataMeaningInstance,
+		ConsentDataMeaningRelated,
+		ConsentDataMeaningDependents,
+		ConsentDataMeaningAuthoredby:
+		return true
+	}
+	return false
+}
+
 // ConsentProvisionType represents codes from http://hl7.org/fhir/ValueSet/consent-provision-type
 type ConsentProvisionType string
 
@@ -718,6 +1526,16 @@ const (
 	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
+// IsValid reports whether c is one of the ConsentProvisionType codes.
+func (c ConsentProvisionType) IsValid() bool {
+	switch c {
+	case ConsentProvisionTypeDeny,
+		ConsentProvisionTypePermit:
+		return true
+	}
+	return false
+}
+
 // ConsentState represents codes from http://hl7.org/fhir/ValueSet/consent-state-codes
 type ConsentState string
 
@@ -730,6 +1548,20 @@ const (
 	ConsentStateUnknown        ConsentState = "unknown"
 )
 
+// IsValid reports whether c is one of the ConsentState codes.
+func (c ConsentState) IsValid() bool {
+	switch c {
+	case ConsentStateDraft,
+		ConsentStateActive,
+		ConsentStateInactive,
+		ConsentStateNotDone,
+		ConsentStateEnteredInError,
+		ConsentStateUnknown:
+		return true
+	}
+	return false
+}
+
 // ConstraintSeverity represents codes from http://hl7.org/fhir/ValueSet/constraint-severity
 type ConstraintSeverity string
 
@@ -738,6 +1570,16 @@ const (
 	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
+// IsValid reports whether c is one of the ConstraintSeverity codes.
+func (c ConstraintSeverity) IsValid() bool {
+	switch c {
+	case ConstraintSeverityError,
+		ConstraintSeverityWarning:
+		return true
+	}
+	return false
+}
+
 // ContactPointSystem represents codes from http://hl7.org/fhir/ValueSet/contact-point-system
 type ContactPointSystem string
 
@@ -751,6 +1593,21 @@ const (
 	ContactPointSystemOther ContactPointSystem = "other"
 )
 
+// IsValid reports whether c is one of the ContactPointSystem codes.
+func (c ContactPointSystem) IsValid() bool {
+	switch c {
+	case ContactPointSystemPhone,
+		ContactPointSystemFax,
+		ContactPointSystemEmail,
+		ContactPointSystemPager,
+		ContactPointSystemUrl,
+		ContactPointSystemSms,
+		ContactPointSystemOther:
+		return true
+	}
+	return false
+}
+
 // ContactPointUse represents codes from http://hl7.org/fhir/ValueSet/contact-point-use
 type ContactPointUse string
 
@@ -762,6 +1619,19 @@ const (
 	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
+// IsValid reports whether c is one of the ContactPointUse codes.
+func (c ContactPointUse) IsValid() bool {
+	switch c {
+	case ContactPointUseHome,
+		ContactPointUseWork,
+		ContactPointUseTemp,
+		ContactPointUseOld,
+		ContactPointUseMobile:
+		return true
+	}
+	return false
+}
+
 // ContractResourcePublicationStatusCodes represents codes from http://hl7.org/fhir/ValueSet/contract-publicationstatus
 type ContractResourcePublicationStatusCodes string
 
@@ -783,6 +1653,29 @@ const (
 	ContractResourcePublicationStatusCodesTerminated     ContractResourcePublicationStatusCodes = "terminated"
 )
 
+// IsValid reports whether c is one of the ContractResourcePublicationStatusCodes codes.
+func (c ContractResourcePublicationStatusCodes) IsValid() bool {
+	switch c {
+	case ContractResourcePublicationStatusCodesAmended,
+		ContractResourcePublicationStatusCodesAppended,
+		ContractResourcePublicationStatusCodesCancelled,
+		ContractResourcePublicationStatusCodesDisputed,
+		ContractResourcePublicationStatusCodesEnteredInError,
+		ContractResourcePublicationStatusCodesExecutable,
+		ContractResourcePublicationStatusCodesExecuted,
+		ContractResourcePublicationStatusCodesNegotiable,
+		ContractResourcePublicationStatusCodesOffered,
+		ContractResourcePublicationStatusCodesPolicy,
+		ContractResourcePublicationStatusCodesRejected,
+		ContractResourcePublicationStatusCodesRenewed,
+		ContractResourcePublicationStatusCodesRevoked,
+		ContractResourcePublicationStatusCodesResolved,
+		ContractResourcePublicationStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
 // ContractResourceStatusCodes represents codes from http://hl7.org/fhir/ValueSet/contract-status
 type ContractResourceStatusCodes string
 
@@ -804,6 +1697,29 @@ const (
 	ContractResourceStatusCodesTerminated     ContractResourceStatusCodes = "terminated"
 )
 
+// IsValid reports whether c is one of the ContractResourceStatusCodes codes.
+func (c ContractResourceStatusCodes) IsValid() bool {
+	switch c {
+	case ContractResourceStatusCodesAmended,
+		ContractResourceStatusCodesAppended,
+		ContractResourceStatusCodesCancelled,
+		ContractResourceStatusCodesDisputed,
+		ContractResourceStatusCodesEnteredInError,
+		ContractResourceStatusCodesExecutable,
+		ContractResourceStatusCodesExecuted,
+		ContractResourceStatusCodesNegotiable,
+		ContractResourceStatusCodesOffered,
+		ContractResourceStatusCodesPolicy,
+		ContractResourceStatusCodesRejected,
+		ContractResourceStatusCodesRenewed,
+		ContractResourceStatusCodesRevoked,
+		ContractResourceStatusCodesResolved,
+		ContractResourceStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
 // CriteriaNotExistsBehavior represents codes from http://hl7.org/fhir/ValueSet/subscriptiontopic-cr-behavior
 type CriteriaNotExistsBehavior string
 
@@ -812,6 +1728,16 @@ const (
 	CriteriaNotExistsBehaviorTestFails  CriteriaNotExistsBehavior = "test-fails"
 )
 
+// IsValid reports whether c is one of the CriteriaNotExistsBehavior codes.
+func (c CriteriaNotExistsBehavior) IsValid() bool {
+	switch c {
+	case CriteriaNotExistsBehaviorTestPasses,
+		CriteriaNotExistsBehaviorTestFails:
+		return true
+	}
+	return false
+}
+
 // DaysOfWeek represents codes from http://hl7.org/fhir/ValueSet/days-of-week
 type DaysOfWeek string
 
@@ -825,6 +1751,21 @@ const (
 	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
+// IsValid reports whether c is one of the DaysOfWeek codes.
+func (c DaysOfWeek) IsValid() bool {
+	switch c {
+	case DaysOfWeekMon,
+		DaysOfWeekTue,
+		DaysOfWeekWed,
+		DaysOfWeekThu,
+		DaysOfWeekFri,
+		DaysOfWeekSat,
+		DaysOfWeekSun:
+		return true
+	}
+	return false
+}
+
 // DetectedIssueStatus represents codes from http://hl7.org/fhir/ValueSet/detectedissue-status
 type DetectedIssueStatus string
 
@@ -844,6 +1785,27 @@ const (
 	DetectedIssueStatusProcessingError   DetectedIssueStatus = "processing-error"
 )
 
+// IsValid reports whether c is one of the DetectedIssueStatus codes.
+func (c DetectedIssueStatus) IsValid() bool {
+	switch c {
+	case DetectedIssueStatusRegistered,
+		DetectedIssueStatusSpecimenInProcess,
+		DetectedIssueStatusPreliminary,
+		DetectedIssueStatusFinal,
+		DetectedIssueStatusAmended,
+		DetectedIssueStatusCorrected,
+		DetectedIssueStatusAppended,
+		DetectedIssueStatusCancelled,
+		DetectedIssueStatusEnteredInError,
+		DetectedIssueStatusUnknown,
+		DetectedIssueStatusCannotBeObtained,
+		DetectedIssueStatusMitigated,
+		DetectedIssueStatusProcessingError:
+		return true
+	}
+	return false
+}
+
 // DeviceAlertAnnunciationCodes represents codes from http://hl7.org/fhir/ValueSet/devicealert-annunciation
 type DeviceAlertAnnunciationCodes string
 
@@ -852,6 +1814,16 @@ const (
 	DeviceAlertAnnunciationCodesRemote DeviceAlertAnnunciationCodes = "remote"
 )
 
+// IsValid reports whether c is one of the DeviceAlertAnnunciationCodes codes.
+func (c DeviceAlertAnnunciationCodes) IsValid() bool {
+	switch c {
+	case DeviceAlertAnnunciationCodesLocal,
+		DeviceAlertAnnunciationCodesRemote:
+		return true
+	}
+	return false
+}
+
 // DeviceAlertStatusCodes represents codes from http://hl7.org/fhir/ValueSet/devicealert-status
 type DeviceAlertStatusCodes string
 
@@ -862,6 +1834,18 @@ const (
 	DeviceAlertStatusCodesUnknown        DeviceAlertStatusCodes = "unknown"
 )
 
+// IsValid reports whether c is one of the DeviceAlertStatusCodes codes.
+func (c DeviceAlertStatusCodes) IsValid() bool {
+	switch c {
+	case DeviceAlertStatusCodesInProgress,
+		DeviceAlertStatusCodesCompleted,
+		DeviceAlertStatusCodesEnteredInError,
+		DeviceAlertStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
 // DeviceAssociationCodes represents codes from http://hl7.org/fhir/ValueSet/deviceassociation-status
 type DeviceAssociationCodes string
 
@@ -872,6 +1856,18 @@ const (
 	DeviceAssociationCodesUnknown        DeviceAssociationCodes = "unknown"
 )
 
+// IsValid reports whether c is one of the DeviceAssociationCodes codes.
+func (c DeviceAssociationCodes) IsValid() bool {
+	switch c {
+	case DeviceAssociationCodesActive,
+		DeviceAssociationCodesInactive,
+		DeviceAssociationCodesEnteredInError,
+		DeviceAssociationCodesUnknown:
+		return true
+	}
+	return false
+}
+
 // DeviceCorrectiveActionScope represents codes from http://hl7.org/fhir/ValueSet/device-correctiveactionscope
 type DeviceCorrectiveActionScope string
 
@@ -881,6 +1877,17 @@ const (
 	DeviceCorrectiveActionScopeSerialNumbers DeviceCorrectiveActionScope = "serial-numbers"
 )
 
+// IsValid reports whether c is one of the DeviceCorrectiveActionScope codes.
+func (c DeviceCorrectiveActionScope) IsValid() bool {
+	switch c {
+	case DeviceCorrectiveActionScopeModel,
+		DeviceCorrectiveActionScopeLotNumbers,
+		DeviceCorrectiveActionScopeSerialNumbers:
+		return true
+	}
+	return false
+}
+
 // DeviceDefinitionRegulatoryIdentifierType represents codes from http://hl7.org/fhir/ValueSet/devicedefinition-regulatory-identifier-type
 type DeviceDefinitionRegulatoryIdentifierType string
 
@@ -890,6 +1897,17 @@ const (
 	DeviceDefinitionRegulatoryIdentifierTypeLicense DeviceDefinitionRegulatoryIdentifierType = "license"
 )
 
+// IsValid reports whether c is one of the DeviceDefinitionRegulatoryIdentifierType codes.
+func (c DeviceDefinitionRegulatoryIdentifierType) IsValid() bool {
+	switch c {
+	case DeviceDefinitionRegulatoryIdentifierTypeBasic,
+		DeviceDefinitionRegulatoryIdentifierTypeMaster,
+		DeviceDefinitionRegulatoryIdentifierTypeLicense:
+		return true
+	}
+	return false
+}
+
 // DeviceMetricCalibrationState represents codes from http://hl7.org/fhir/ValueSet/metric-calibration-state
 type DeviceMetricCalibrationState string
 
@@ -900,6 +1918,18 @@ const (
 	DeviceMetricCalibrationStateUnspecified         DeviceMetricCalibrationState = "unspecified"
 )
 
+// IsValid reports whether c is one of the DeviceMetricCalibrationState codes.
+func (c DeviceMetricCalibrationState) IsValid() bool {
+	switch c {
+	case DeviceMetricCalibrationStateNotCalibrated,
+		DeviceMetricCalibrationStateCalibrationRequired,
+		DeviceMetricCalibrationStateCalibrated,
+		DeviceMetricCalibrationStateUnspecified:
+		return true
+	}
+	return false
+}
+
 // DeviceMetricOperationalStatus represents codes from http://hl7.org/fhir/ValueSet/metric-operational-status
 type DeviceMetricOperationalStatus string
 
@@ -910,6 +1940,18 @@ const (
 	DeviceMetricOperationalStatusUnknown DeviceMetricOperationalStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the DeviceMetricOperationalStatus codes.
+func (c DeviceMetricOperationalStatus) IsValid() bool {
+	switch c {
+	case DeviceMetricOperationalStatusOn,
+		DeviceMetricOperationalStatusOff,
+		DeviceMetricOperationalStatusStandby,
+		DeviceMetricOperationalStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // DeviceMetricStatus represents codes from http://hl7.org/fhir/ValueSet/metric-status
 type DeviceMetricStatus string
 
@@ -920,6 +1962,18 @@ const (
 	DeviceMetricStatusUnknown        DeviceMetricStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the DeviceMetricStatus codes.
+func (c DeviceMetricStatus) IsValid() bool {
+	switch c {
+	case DeviceMetricStatusActive,
+		DeviceMetricStatusInactive,
+		DeviceMetricStatusEnteredInError,
+		DeviceMetricStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // DiagnosticReportStatus represents codes from http://hl7.org/fhir/ValueSet/diagnostic-report-status
 type DiagnosticReportStatus string
 
@@ -937,6 +1991,25 @@ const (
 	DiagnosticReportStatusUnknown        DiagnosticReportStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the DiagnosticReportStatus codes.
+func (c DiagnosticReportStatus) IsValid() bool {
+	switch c {
+	case DiagnosticReportStatusRegistered,
+		DiagnosticReportStatusPartial,
+		DiagnosticReportStatusPreliminary,
+		DiagnosticReportStatusModified,
+		DiagnosticReportStatusFinal,
+		DiagnosticReportStatusAmended,
+		DiagnosticReportStatusCorrected,
+		DiagnosticReportStatusAppended,
+		DiagnosticReportStatusCancelled,
+		DiagnosticReportStatusEnteredInError,
+		DiagnosticReportStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // DiscriminatorType represents codes from http://hl7.org/fhir/ValueSet/discriminator-type
 type DiscriminatorType string
 
@@ -949,6 +2022,20 @@ const (
 	DiscriminatorTypePosition DiscriminatorType = "position"
 )
 
+// IsValid reports whether c is one of the DiscriminatorType codes.
+func (c DiscriminatorType) IsValid() bool {
+	switch c {
+	case DiscriminatorTypeValue,
+		DiscriminatorTypeExists,
+		DiscriminatorTypePattern,
+		DiscriminatorTypeType,
+		DiscriminatorTypeProfile,
+		DiscriminatorTypePosition:
+		return true
+	}
+	return false
+}
+
 // DocumentMode represents codes from http://hl7.org/fhir/ValueSet/document-mode
 type DocumentMode string
 
@@ -957,6 +2044,16 @@ const (
 	DocumentModeConsumer DocumentMode = "consumer"
 )
 
+// IsValid reports whether c is one of the DocumentMode codes.
+func (c DocumentMode) IsValid() bool {
+	switch c {
+	case DocumentModeProducer,
+		DocumentModeConsumer:
+		return true
+	}
+	return false
+}
+
 // DocumentReferenceStatus represents codes from http://hl7.org/fhir/ValueSet/document-reference-status
 type DocumentReferenceStatus string
 
@@ -966,6 +2063,17 @@ const (
 	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the DocumentReferenceStatus codes.
+func (c DocumentReferenceStatus) IsValid() bool {
+	switch c {
+	case DocumentReferenceStatusCurrent,
+		DocumentReferenceStatusSuperseded,
+		DocumentReferenceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // DoseLimitScopeVS represents codes from http://hl7.org/fhir/ValueSet/dose-limit-scope
 type DoseLimitScopeVS string
 
@@ -976,6 +2084,18 @@ const (
 	DoseLimitScopeVSLifetime       DoseLimitScopeVS = "lifetime"
 )
 
+// IsValid reports whether c is one of the DoseLimitScopeVS codes.
+func (c DoseLimitScopeVS) IsValid() bool {
+	switch c {
+	case DoseLimitScopeVSDosage,
+		DoseLimitScopeVSPeriod,
+		DoseLimitScopeVSAdministration,
+		DoseLimitScopeVSLifetime:
+		return true
+	}
+	return false
+}
+
 // EligibilityOutcome represents codes from http://hl7.org/fhir/ValueSet/eligibility-outcome
 type EligibilityOutcome string
 
@@ -986,6 +2106,18 @@ const (
 	EligibilityOutcomePartial  EligibilityOutcome = "partial"
 )
 
+// IsValid reports whether c is one of the EligibilityOutcome codes.
+func (c EligibilityOutcome) IsValid() bool {
+	switch c {
+	case EligibilityOutcomeQueued,
+		EligibilityOutcomeComplete,
+		EligibilityOutcomeError,
+		EligibilityOutcomePartial:
+		return true
+	}
+	return false
+}
+
 // EligibilityRequestPurpose represents codes from http://hl7.org/fhir/ValueSet/eligibilityrequest-purpose
 type EligibilityRequestPurpose string
 
@@ -996,6 +2128,18 @@ const (
 	EligibilityRequestPurposeValidation       EligibilityRequestPurpose = "validation"
 )
 
+// IsValid reports whether c is one of the EligibilityRequestPurpose codes.
+func (c EligibilityRequestPurpose) IsValid() bool {
+	switch c {
+	case EligibilityRequestPurposeAuthRequirements,
+		EligibilityRequestPurposeBenefits,
+		EligibilityRequestPurposeDiscovery,
+		EligibilityRequestPurposeValidation:
+		return true
+	}
+	return false
+}
+
 // EligibilityResponsePurpose represents codes from http://hl7.org/fhir/ValueSet/eligibilityresponse-purpose
 type EligibilityResponsePurpose string
 
@@ -1006,6 +2150,18 @@ const (
 	EligibilityResponsePurposeValidation       EligibilityResponsePurpose = "validation"
 )
 
+// IsValid reports whether c is one of the EligibilityResponsePurpose codes.
+func (c EligibilityResponsePurpose) IsValid() bool {
+	switch c {
+	case EligibilityResponsePurposeAuthRequirements,
+		EligibilityResponsePurposeBenefits,
+		EligibilityResponsePurposeDiscovery,
+		EligibilityResponsePurposeValidation:
+		return true
+	}
+	return false
+}
+
 // EnableWhenBehavior represents codes from http://hl7.org/fhir/ValueSet/questionnaire-enable-behavior
 type EnableWhenBehavior string
 
@@ -1014,6 +2170,16 @@ const (
 	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
+// IsValid reports whether c is one of the EnableWhenBehavior codes.
+func (c EnableWhenBehavior) IsValid() bool {
+	switch c {
+	case EnableWhenBehaviorAll,
+		EnableWhenBehaviorAny:
+		return true
+	}
+	return false
+}
+
 // EncounterLocationStatus represents codes from http://hl7.org/fhir/ValueSet/encounter-location-status
 type EncounterLocationStatus string
 
@@ -1024,6 +2190,18 @@ const (
 	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
+// IsValid reports whether c is one of the EncounterLocationStatus codes.
+func (c EncounterLocationStatus) IsValid() bool {
+	switch c {
+	case EncounterLocationStatusPlanned,
+		EncounterLocationStatusActive,
+		EncounterLocationStatusReserved,
+		EncounterLocationStatusCompleted:
+		return true
+	}
+	return false
+}
+
 // EncounterStatus represents codes from http://hl7.org/fhir/ValueSet/encounter-status
 type EncounterStatus string
 
@@ -1039,6 +2217,23 @@ const (
 	EncounterStatusUnknown        EncounterStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the EncounterStatus codes.
+func (c EncounterStatus) IsValid() bool {
+	switch c {
+	case EncounterStatusPlanned,
+		EncounterStatusInProgress,
+		EncounterStatusOnHold,
+		EncounterStatusDischarged,
+		EncounterStatusCompleted,
+		EncounterStatusCancelled,
+		EncounterStatusDiscontinued,
+		EncounterStatusEnteredInError,
+		EncounterStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // EndpointStatus represents codes from http://hl7.org/fhir/ValueSet/endpoint-status
 type EndpointStatus string
 
@@ -1051,6 +2246,20 @@ const (
 	EndpointStatusEnteredInError EndpointStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the EndpointStatus codes.
+func (c EndpointStatus) IsValid() bool {
+	switch c {
+	case EndpointStatusActive,
+		EndpointStatusLimited,
+		EndpointStatusSuspended,
+		EndpointStatusError,
+		EndpointStatusOff,
+		EndpointStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // EnrollmentOutcome represents codes from http://hl7.org/fhir/ValueSet/enrollment-outcome
 type EnrollmentOutcome string
 
@@ -1061,6 +2270,18 @@ const (
 	EnrollmentOutcomePartial  EnrollmentOutcome = "partial"
 )
 
+// IsValid reports whether c is one of the EnrollmentOutcome codes.
+func (c EnrollmentOutcome) IsValid() bool {
+	switch c {
+	case EnrollmentOutcomeQueued,
+		EnrollmentOutcomeComplete,
+		EnrollmentOutcomeError,
+		EnrollmentOutcomePartial:
+		return true
+	}
+	return false
+}
+
 // EpisodeOfCareStatus represents codes from http://hl7.org/fhir/ValueSet/episode-of-care-status
 type EpisodeOfCareStatus string
 
@@ -1074,6 +2295,21 @@ const (
 	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the EpisodeOfCareStatus codes.
+func (c EpisodeOfCareStatus) IsValid() bool {
+	switch c {
+	case EpisodeOfCareStatusPlanned,
+		EpisodeOfCareStatusWaitlist,
+		EpisodeOfCareStatusActive,
+		EpisodeOfCareStatusOnhold,
+		EpisodeOfCareStatusFinished,
+		EpisodeOfCareStatusCancelled,
+		EpisodeOfCareStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // EventCapabilityMode represents codes from http://hl7.org/fhir/ValueSet/event-capability-mode
 type EventCapabilityMode string
 
@@ -1082,6 +2318,16 @@ const (
 	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
+// IsValid reports whether c is one of the EventCapabilityMode codes.
+func (c EventCapabilityMode) IsValid() bool {
+	switch c {
+	case EventCapabilityModeSender,
+		EventCapabilityModeReceiver:
+		return true
+	}
+	return false
+}
+
 // EventStatus represents codes from http://hl7.org/fhir/ValueSet/event-status
 type EventStatus string
 
@@ -1096,6 +2342,22 @@ const (
 	EventStatusUnknown        EventStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the EventStatus codes.
+func (c EventStatus) IsValid() bool {
+	switch c {
+	case EventStatusPreparation,
+		EventStatusInProgress,
+		EventStatusNotDone,
+		EventStatusOnHold,
+		EventStatusStopped,
+		EventStatusCompleted,
+		EventStatusEnteredInError,
+		EventStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // EventTiming represents codes from http://hl7.org/fhir/ValueSet/event-timing
 type EventTiming string
 
@@ -1129,6 +2391,41 @@ const (
 	EventTimingPCV       EventTiming = "PCV"
 )
 
+// IsValid reports whether c is one of the EventTiming codes.
+func (c EventTiming) IsValid() bool {
+	switch c {
+	case EventTimingMORN,
+		EventTimingMORNEarly,
+		EventTimingMORNLate,
+		EventTimingNOON,
+		EventTimingAFT,
+		EventTimingAFTEarly,
+		EventTimingAFTLate,
+		EventTimingEVE,
+		EventTimingEVEEarly,
+		EventTimingEVELate,
+		EventTimingNIGHT,
+		EventTimingPHS,
+		EventTimingIMD,
+		EventTimingHS,
+		EventTimingWAKE,
+		EventTimingC,
+		EventTimingCM,
+		EventTimingCD,
+		EventTimingCV,
+		EventTimingAC,
+		EventTimingACM,
+		EventTimingACD,
+		EventTimingACV,
+		EventTimingPC,
+		EventTimingPCM,
+		EventTimingPCD,
+		EventTimingPCV:
+		return true
+	}
+	return false
+}
+
 // EvidenceVariableRole represents codes from http://hl7.org/fhir/ValueSet/variable-role
 type EvidenceVariableRole string
 
@@ -1139,6 +2436,18 @@ const (
 	EvidenceVariableRoleCovariate  EvidenceVariableRole = "covariate"
 )
 
+// IsValid reports whether c is one of the EvidenceVariableRole codes.
+func (c EvidenceVariableRole) IsValid() bool {
+	switch c {
+	case EvidenceVariableRolePopulation,
+		EvidenceVariableRoleExposure,
+		EvidenceVariableRoleOutcome,
+		EvidenceVariableRoleCovariate:
+		return true
+	}
+	return false
+}
+
 // ExplanationOfBenefitStatus represents codes from http://hl7.org/fhir/ValueSet/explanationofbenefit-status
 type ExplanationOfBenefitStatus string
 
@@ -1149,6 +2458,18 @@ const (
 	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the ExplanationOfBenefitStatus codes.
+func (c ExplanationOfBenefitStatus) IsValid() bool {
+	switch c {
+	case ExplanationOfBenefitStatusActive,
+		ExplanationOfBenefitStatusCancelled,
+		ExplanationOfBenefitStatusDraft,
+		ExplanationOfBenefitStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // ExtensionContextType represents codes from http://hl7.org/fhir/ValueSet/extension-context-type
 type ExtensionContextType string
 
@@ -1158,6 +2479,17 @@ const (
 	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
+// IsValid reports whether c is one of the ExtensionContextType codes.
+func (c ExtensionContextType) IsValid() bool {
+	switch c {
+	case ExtensionContextTypeFhirpath,
+		ExtensionContextTypeElement,
+		ExtensionContextTypeExtension:
+		return true
+	}
+	return false
+}
+
 // FHIRDeviceStatus represents codes from http://hl7.org/fhir/ValueSet/device-status
 type FHIRDeviceStatus string
 
@@ -1168,6 +2500,18 @@ const (
 	FHIRDeviceStatusUnknown        FHIRDeviceStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the FHIRDeviceStatus codes.
+func (c FHIRDeviceStatus) IsValid() bool {
+	switch c {
+	case FHIRDeviceStatusActive,
+		FHIRDeviceStatusInactive,
+		FHIRDeviceStatusEnteredInError,
+		FHIRDeviceStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // FHIRSubstanceStatus represents codes from http://hl7.org/fhir/ValueSet/substance-status
 type FHIRSubstanceStatus string
 
@@ -1177,6 +2521,17 @@ const (
 	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the FHIRSubstanceStatus codes.
+func (c FHIRSubstanceStatus) IsValid() bool {
+	switch c {
+	case FHIRSubstanceStatusActive,
+		FHIRSubstanceStatusInactive,
+		FHIRSubstanceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // FHIRTypes represents codes from http://hl7.org/fhir/ValueSet/fhir-types
 type FHIRTypes string
 
@@ -1386,6 +2741,217 @@ const (
 	FHIRTypesParameters                     FHIRTypes = "Parameters"
 )
 
+// IsValid reports whether c is one of the FHIRTypes codes.
+func (c FHIRTypes) IsValid() bool {
+	switch c {
+	case FHIRTypesBase,
+		FHIRTypesElement,
+		FHIRTypesBackboneElement,
+		FHIRTypesDataType,
+		FHIRTypesAddress,
+		FHIRTypesAnnotation,
+		FHIRTypesAttachment,
+		FHIRTypesAvailability,
+		FHIRTypesBackboneType,
+		FHIRTypesDosage,
+		FHIRTypesDosageCondition,
+		FHIRTypesDosageDetails,
+		FHIRTypesDosageSafety,
+		FHIRTypesElementDefinition,
+		FHIRTypesMarketingStatus,
+		FHIRTypesProductShelfLife,
+		FHIRTypesRelativeTime,
+		FHIRTypesTiming,
+		FHIRTypesCodeableConcept,
+		FHIRTypesCodeableReference,
+		FHIRTypesCoding,
+		FHIRTypesContactDetail,
+		FHIRTypesContactPoint,
+		FHIRTypesDataRequirement,
+		FHIRTypesExpression,
+		FHIRTypesExtendedContactDetail,
+		FHIRTypesExtension,
+		FHIRTypesHumanName,
+		FHIRTypesIdentifier,
+		FHIRTypesMeta,
+		FHIRTypesMonetaryComponent,
+		FHIRTypesMoney,
+		FHIRTypesNarrative,
+		FHIRTypesParameterDefinition,
+		FHIRTypesPeriod,
+		FHIRTypesPrimitiveType,
+		FHIRTypesBase64Binary,
+		FHIRTypesBoolean,
+		FHIRTypesDate,
+		FHIRTypesDateTime,
+		FHIRTypesDecimal,
+		FHIRTypesInstant,
+		FHIRTypesInteger,
+		FHIRTypesPositiveInt,
+		FHIRTypesUnsignedInt,
+		FHIRTypesInteger64,
+		FHIRTypesString,
+		FHIRTypesCode,
+		FHIRTypesId,
+		FHIRTypesMarkdown,
+		FHIRTypesTime,
+		FHIRTypesUri,
+		FHIRTypesCanonical,
+		FHIRTypesOid,
+		FHIRTypesUrl,
+		FHIRTypesUuid,
+		FHIRTypesQuantity,
+		FHIRTypesAge,
+		FHIRTypesCount,
+		FHIRTypesDistance,
+		FHIRTypesDuration,
+		FHIRTypesRange,
+		FHIRTypesRatio,
+		FHIRTypesRatioRange,
+		FHIRTypesReference,
+		FHIRTypesRelatedArtifact,
+		FHIRTypesSampledData,
+		FHIRTypesSignature,
+		FHIRTypesTriggerDefinition,
+		FHIRTypesUsageContext,
+		FHIRTypesVirtualServiceDetail,
+		FHIRTypesXhtml,
+		FHIRTypesResource,
+		FHIRTypesBinary,
+		FHIRTypesBundle,
+		FHIRTypesDomainResource,
+		FHIRTypesAccount,
+		FHIRTypesActivityDefinition,
+		FHIRTypesActorDefinition,
+		FHIRTypesAdministrableProductDefinition,
+		FHIRTypesAdverseEvent,
+		FHIRTypesAllergyIntolerance,
+		FHIRTypesAppointment,
+		FHIRTypesAppointmentResponse,
+		FHIRTypesArtifactAssessment,
+		FHIRTypesAuditEvent,
+		FHIRTypesBasic,
+		FHIRTypesBiologicallyDerivedProduct,
+		FHIRTypesBodyStructure,
+		FHIRTypesCanonicalResource,
+		FHIRTypesCapabilityStatement,
+		FHIRTypesCarePlan,
+		FHIRTypesCareTeam,
+		FHIRTypesClaim,
+		FHIRTypesClaimResponse,
+		FHIRTypesClinicalUseDefinition,
+		FHIRTypesCodeSystem,
+		FHIRTypesCommunication,
+		FHIRTypesCommunicationRequest,
+		FHIRTypesCompartmentDefinition,
+		FHIRTypesComposition,
+		FHIRTypesConceptMap,
+		FHIRTypesCondition,
+		FHIRTypesConsent,
+		FHIRTypesContract,
+		FHIRTypesCoverage,
+		FHIRTypesCoverageEligibilityRequest,
+		FHIRTypesCoverageEligibilityResponse,
+		FHIRTypesDetectedIssue,
+		FHIRTypesDevice,
+		FHIRTypesDeviceAlert,
+		FHIRTypesDeviceAssociation,
+		FHIRTypesDeviceDefinition,
+		FHIRTypesDeviceMetric,
+		FHIRTypesDeviceRequest,
+		FHIRTypesDiagnosticReport,
+		FHIRTypesDocumentReference,
+		FHIRTypesEncounter,
+		FHIRTypesEndpoint,
+		FHIRTypesEnrollmentRequest,
+		FHIRTypesEnrollmentResponse,
+		FHIRTypesEpisodeOfCare,
+		FHIRTypesEventDefinition,
+		FHIRTypesEvidence,
+		FHIRTypesEvidenceVariable,
+		FHIRTypesExampleScenario,
+		FHIRTypesExplanationOfBenefit,
+		FHIRTypesFamilyMemberHistory,
+		FHIRTypesFlag,
+		FHIRTypesGoal,
+		FHIRTypesGroup,
+		FHIRTypesGuidanceResponse,
+		FHIRTypesHealthcareService,
+		FHIRTypesImagingSelection,
+		FHIRTypesImagingStudy,
+		FHIRTypesImmunization,
+		FHIRTypesImplementationGuide,
+		FHIRTypesIngredient,
+		FHIRTypesInsurancePlan,
+		FHIRTypesInsuranceProduct,
+		FHIRTypesInvoice,
+		FHIRTypesLibrary,
+		FHIRTypesList,
+		FHIRTypesLocation,
+		FHIRTypesManufacturedItemDefinition,
+		FHIRTypesMeasure,
+		FHIRTypesMeasureReport,
+		FHIRTypesMedication,
+		FHIRTypesMedicationAdministration,
+		FHIRTypesMedicationDispense,
+		FHIRTypesMedicationRequest,
+		FHIRTypesMedicationStatement,
+		FHIRTypesMedicinalProductDefinition,
+		FHIRTypesMessageDefinition,
+		FHIRTypesMessageHeader,
+		FHIRTypesMetadataResource,
+		FHIRTypesNamingSystem,
+		FHIRTypesNutritionIntake,
+		FHIRTypesNutritionOrder,
+		FHIRTypesNutritionProduct,
+		FHIRTypesObservation,
+		FHIRTypesObservationDefinition,
+		FHIRTypesOperationDefinition,
+		FHIRTypesOperationOutcome,
+		FHIRTypesOrganization,
+		FHIRTypesOrganizationAffiliation,
+		FHIRTypesPackagedProductDefinition,
+		FHIRTypesPatient,
+		FHIRTypesPaymentNotice,
+		FHIRTypesPaymentReconciliation,
+		FHIRTypesPerson,
+		FHIRTypesPlanDefinition,
+		FHIRTypesPractitioner,
+		FHIRTypesPractitionerRole,
+		FHIRTypesProcedure,
+		FHIRTypesProvenance,
+		FHIRTypesQuestionnaire,
+		FHIRTypesQuestionnaireResponse,
+		FHIRTypesRegulatedAuthorization,
+		FHIRTypesRelatedPerson,
+		FHIRTypesRequestOrchestration,
+		FHIRTypesRequirements,
+		FHIRTypesResearchStudy,
+		FHIRTypesResearchSubject,
+		FHIRTypesRiskAssessment,
+		FHIRTypesSchedule,
+		FHIRTypesSearchParameter,
+		FHIRTypesServiceRequest,
+		FHIRTypesSlot,
+		FHIRTypesSpecimen,
+		FHIRTypesSpecimenDefinition,
+		FHIRTypesStructureDefinition,
+		FHIRTypesStructureMap,
+		FHIRTypesSubscription,
+		FHIRTypesSubscriptionStatus,
+		FHIRTypesSubscriptionTopic,
+		FHIRTypesSubstance,
+		FHIRTypesSubstanceDefinition,
+		FHIRTypesTask,
+		FHIRTypesTerminologyCapabilities,
+		FHIRTypesValueSet,
+		FHIRTypesVisionPrescription,
+		FHIRTypesParameters:
+		return true
+	}
+	return false
+}
+
 // FHIRVersion represents codes from http://hl7.org/fhir/ValueSet/FHIR-version
 type FHIRVersion string
 
@@ -1454,6 +3020,76 @@ const (
 	FHIRVersionCode600Ballot3    FHIRVersion = "6.0.0-ballot3"
 )
 
+// IsValid reports whether c is one of the FHIRVersion codes.
+func (c FHIRVersion) IsValid() bool {
+	switch c {
+	case FHIRVersionCode001,
+		FHIRVersionCode005,
+		FHIRVersionCode006,
+		FHIRVersionCode011,
+		FHIRVersionCode00,
+		FHIRVersionCode0080,
+		FHIRVersionCode0081,
+		FHIRVersionCode0082,
+		FHIRVersionCode04,
+		FHIRVersionCode040,
+		FHIRVersionCode05,
+		FHIRVersionCode050,
+		FHIRVersionCode10,
+		FHIRVersionCode100,
+		FHIRVersionCode101,
+		FHIRVersionCode102,
+		FHIRVersionCode11,
+		FHIRVersionCode110,
+		FHIRVersionCode14,
+		FHIRVersionCode140,
+		FHIRVersionCode16,
+		FHIRVersionCode160,
+		FHIRVersionCode18,
+		FHIRVersionCode180,
+		FHIRVersionCode30,
+		FHIRVersionCode300,
+		FHIRVersionCode301,
+		FHIRVersionCode302,
+		FHIRVersionCode33,
+		FHIRVersionCode330,
+		FHIRVersionCode35,
+		FHIRVersionCode350,
+		FHIRVersionCode40,
+		FHIRVersionCode400,
+		FHIRVersionCode401,
+		FHIRVersionCode41,
+		FHIRVersionCode410,
+		FHIRVersionCode42,
+		FHIRVersionCode420,
+		FHIRVersionCode43,
+		FHIRVersionCode430,
+		FHIRVersionCode430Cibuild,
+		FHIRVersionCode430Snapshot1,
+		FHIRVersionCode44,
+		FHIRVersionCode440,
+		FHIRVersionCode45,
+		FHIRVersionCode450,
+		FHIRVersionCode46,
+		FHIRVersionCode460,
+		FHIRVersionCode50,
+		FHIRVersionCode500,
+		FHIRVersionCode500Cibuild,
+		FHIRVersionCode500Snapshot1,
+		FHIRVersionCode500Snapshot2,
+		FHIRVersionCode500Ballot,
+		FHIRVersionCode500Snapshot3,
+		FHIRVersionCode500DraftFinal,
+		FHIRVersionCode60,
+		FHIRVersionCode600,
+		FHIRVersionCode600Ballo1,
+		FHIRVersionCode600Ballot2,
+		FHIRVersionCode600Ballot3:
+		return true
+	}
+	return false
+}
+
 // FamilyHistoryStatus represents codes from http://hl7.org/fhir/ValueSet/history-status
 type FamilyHistoryStatus string
 
@@ -1464,6 +3100,18 @@ const (
 	FamilyHistoryStatusHealthUnknown  FamilyHistoryStatus = "health-unknown"
 )
 
+// IsValid reports whether c is one of the FamilyHistoryStatus codes.
+func (c FamilyHistoryStatus) IsValid() bool {
+	switch c {
+	case FamilyHistoryStatusPartial,
+		FamilyHistoryStatusCompleted,
+		FamilyHistoryStatusEnteredInError,
+		FamilyHistoryStatusHealthUnknown:
+		return true
+	}
+	return false
+}
+
 // FilterOperator represents codes from http://hl7.org/fhir/ValueSet/filter-operator
 type FilterOperator string
 
@@ -1481,6 +3129,25 @@ const (
 	FilterOperatorExists         FilterOperator = "exists"
 )
 
+// IsValid reports whether c is one of the FilterOperator codes.
+func (c FilterOperator) IsValid() bool {
+	switch c {
+	case FilterOperatorCode,
+		FilterOperatorIsA,
+		FilterOperatorDescendentOf,
+		FilterOperatorIsNotA,
+		FilterOperatorRegex,
+		FilterOperatorIn,
+		FilterOperatorNotIn,
+		FilterOperatorGeneralizes,
+		FilterOperatorChildOf,
+		FilterOperatorDescendentLeaf,
+		FilterOperatorExists:
+		return true
+	}
+	return false
+}
+
 // FinancialResourceStatusCodes represents codes from http://hl7.org/fhir/ValueSet/fm-status
 type FinancialResourceStatusCodes string
 
@@ -1491,6 +3158,18 @@ const (
 	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the FinancialResourceStatusCodes codes.
+func (c FinancialResourceStatusCodes) IsValid() bool {
+	switch c {
+	case FinancialResourceStatusCodesActive,
+		FinancialResourceStatusCodesCancelled,
+		FinancialResourceStatusCodesDraft,
+		FinancialResourceStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
 // FlagStatus represents codes from http://hl7.org/fhir/ValueSet/flag-status
 type FlagStatus string
 
@@ -1499,3 +3178,14 @@ const (
 	FlagStatusInactive       FlagStatus = "inactive"
 	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
+
+// IsValid reports whether c is one of the FlagStatus codes.
+func (c FlagStatus) IsValid() bool {
+	switch c {
+	case FlagStatusActive,
+		FlagStatusInactive,
+		FlagStatusEnteredInError:
+		return true
+	}
+	return false
+}
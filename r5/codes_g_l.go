@@ -9,6 +9,17 @@ const (
 	GlobalLangPackSupportVSImplicit     GlobalLangPackSupportVS = "implicit"
 )
 
+// IsValid reports whether c is one of the GlobalLangPackSupportVS codes.
+func (c GlobalLangPackSupportVS) IsValid() bool {
+	switch c {
+	case GlobalLangPackSupportVSNotSupported,
+		GlobalLangPackSupportVSExplicit,
+		GlobalLangPackSupportVSImplicit:
+		return true
+	}
+	return false
+}
+
 // GoalAcceptStatus represents codes from http://hl7.org/fhir/ValueSet/goal-accept-status
 type GoalAcceptStatus string
 
@@ -18,6 +29,17 @@ const (
 	GoalAcceptStatusPending  GoalAcceptStatus = "pending"
 )
 
+// IsValid reports whether c is one of the GoalAcceptStatus codes.
+func (c GoalAcceptStatus) IsValid() bool {
+	switch c {
+	case GoalAcceptStatusAgree,
+		GoalAcceptStatusDisagree,
+		GoalAcceptStatusPending:
+		return true
+	}
+	return false
+}
+
 // GoalLifecycleStatus represents codes from http://hl7.org/fhir/ValueSet/goal-status
 type GoalLifecycleStatus string
 
@@ -33,6 +55,23 @@ const (
 	GoalLifecycleStatusRejected       GoalLifecycleStatus = "rejected"
 )
 
+// IsValid reports whether c is one of the GoalLifecycleStatus codes.
+func (c GoalLifecycleStatus) IsValid() bool {
+	switch c {
+	case GoalLifecycleStatusProposed,
+		GoalLifecycleStatusPlanned,
+		GoalLifecycleStatusAccepted,
+		GoalLifecycleStatusActive,
+		GoalLifecycleStatusOnHold,
+		GoalLifecycleStatusCompleted,
+		GoalLifecycleStatusCancelled,
+		GoalLifecycleStatusEnteredInError,
+		GoalLifecycleStatusRejected:
+		return true
+	}
+	return false
+}
+
 // GroupCharacteristicCombination represents codes from http://hl7.org/fhir/ValueSet/group-characteristic-combination
 type GroupCharacteristicCombination string
 
@@ -47,6 +86,22 @@ const (
 	GroupCharacteristicCombinationDataset      GroupCharacteristicCombination = "dataset"
 )
 
+// IsValid reports whether c is one of the GroupCharacteristicCombination codes.
+func (c GroupCharacteristicCombination) IsValid() bool {
+	switch c {
+	case GroupCharacteristicCombinationAllOf,
+		GroupCharacteristicCombinationAnyOf,
+		GroupCharacteristicCombinationAtLeast,
+		GroupCharacteristicCombinationAtMost,
+		GroupCharacteristicCombinationExceptSubset,
+		GroupCharacteristicCombinationStatistical,
+		GroupCharacteristicCombinationNetEffect,
+		GroupCharacteristicCombinationDataset:
+		return true
+	}
+	return false
+}
+
 // GroupMembershipBasis represents codes from http://hl7.org/fhir/ValueSet/group-membership-basis
 type GroupMembershipBasis string
 
@@ -56,6 +111,17 @@ const (
 	GroupMembershipBasisEnumerated   GroupMembershipBasis = "enumerated"
 )
 
+// IsValid reports whether c is one of the GroupMembershipBasis codes.
+func (c GroupMembershipBasis) IsValid() bool {
+	switch c {
+	case GroupMembershipBasisDefinitional,
+		GroupMembershipBasisConceptual,
+		GroupMembershipBasisEnumerated:
+		return true
+	}
+	return false
+}
+
 // GroupType represents codes from http://hl7.org/fhir/ValueSet/group-type
 type GroupType string
 
@@ -78,6 +144,30 @@ const (
 	GroupTypeNutritionProduct           GroupType = "nutritionProduct"
 )
 
+// IsValid reports whether c is one of the GroupType codes.
+func (c GroupType) IsValid() bool {
+	switch c {
+	case GroupTypePerson,
+		GroupTypeAnimal,
+		GroupTypePractitioner,
+		GroupTypeDevice,
+		GroupTypeCareteam,
+		GroupTypeHealthcareservice,
+		GroupTypeLocation,
+		GroupTypeOrganization,
+		GroupTypeRelatedperson,
+		GroupTypeSpecimen,
+		GroupTypeMedication,
+		GroupTypeMedicinalproductdefinition,
+		GroupTypeSubstance,
+		GroupTypeSubstancedefinition,
+		GroupTypeBiologicallyDerivedProduct,
+		GroupTypeNutritionProduct:
+		return true
+	}
+	return false
+}
+
 // GuidanceResponseStatus represents codes from http://hl7.org/fhir/ValueSet/guidance-response-status
 type GuidanceResponseStatus string
 
@@ -90,6 +180,20 @@ const (
 	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the GuidanceResponseStatus codes.
+func (c GuidanceResponseStatus) IsValid() bool {
+	switch c {
+	case GuidanceResponseStatusSuccess,
+		GuidanceResponseStatusDataRequested,
+		GuidanceResponseStatusDataRequired,
+		GuidanceResponseStatusInProgress,
+		GuidanceResponseStatusFailure,
+		GuidanceResponseStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // GuidePageGeneration represents codes from http://hl7.org/fhir/ValueSet/guide-page-generation
 type GuidePageGeneration string
 
@@ -100,6 +204,18 @@ const (
 	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
+// IsValid reports whether c is one of the GuidePageGeneration codes.
+func (c GuidePageGeneration) IsValid() bool {
+	switch c {
+	case GuidePageGenerationHtml,
+		GuidePageGenerationMarkdown,
+		GuidePageGenerationXml,
+		GuidePageGenerationGenerated:
+		return true
+	}
+	return false
+}
+
 // HTTPVerb represents codes from http://hl7.org/fhir/ValueSet/http-verb
 type HTTPVerb string
 
@@ -112,6 +228,20 @@ const (
 	HTTPVerbPATCH  HTTPVerb = "PATCH"
 )
 
+// IsValid reports whether c is one of the HTTPVerb codes.
+func (c HTTPVerb) IsValid() bool {
+	switch c {
+	case HTTPVerbGET,
+		HTTPVerbHEAD,
+		HTTPVerbPOST,
+		HTTPVerbPUT,
+		HTTPVerbDELETE,
+		HTTPVerbPATCH:
+		return true
+	}
+	return false
+}
+
 // IdentifierUse represents codes from http://hl7.org/fhir/ValueSet/identifier-use
 type IdentifierUse string
 
@@ -123,6 +253,19 @@ const (
 	IdentifierUseOld       IdentifierUse = "old"
 )
 
+// IsValid reports whether c is one of the IdentifierUse codes.
+func (c IdentifierUse) IsValid() bool {
+	switch c {
+	case IdentifierUseUsual,
+		IdentifierUseOfficial,
+		IdentifierUseTemp,
+		IdentifierUseSecondary,
+		IdentifierUseOld:
+		return true
+	}
+	return false
+}
+
 // IdentityAssuranceLevel represents codes from http://hl7.org/fhir/ValueSet/identity-assuranceLevel
 type IdentityAssuranceLevel string
 
@@ -133,6 +276,18 @@ const (
 	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
+// IsValid reports whether c is one of the IdentityAssuranceLevel codes.
+func (c IdentityAssuranceLevel) IsValid() bool {
+	switch c {
+	case IdentityAssuranceLevelLevel1,
+		IdentityAssuranceLevelLevel2,
+		IdentityAssuranceLevelLevel3,
+		IdentityAssuranceLevelLevel4:
+		return true
+	}
+	return false
+}
+
 // ImagingSelection2DGraphicType represents codes from http://hl7.org/fhir/ValueSet/imagingselection-2dgraphictype
 type ImagingSelection2DGraphicType string
 
@@ -144,6 +299,19 @@ const (
 	ImagingSelection2DGraphicTypeEllipse    ImagingSelection2DGraphicType = "ellipse"
 )
 
+// IsValid reports whether c is one of the ImagingSelection2DGraphicType codes.
+func (c ImagingSelection2DGraphicType) IsValid() bool {
+	switch c {
+	case ImagingSelection2DGraphicTypePoint,
+		ImagingSelection2DGraphicTypePolyline,
+		ImagingSelection2DGraphicTypeMultipoint,
+		ImagingSelection2DGraphicTypeCircle,
+		ImagingSelection2DGraphicTypeEllipse:
+		return true
+	}
+	return false
+}
+
 // ImagingSelection3DGraphicType represents codes from http://hl7.org/fhir/ValueSet/imagingselection-3dgraphictype
 type ImagingSelection3DGraphicType string
 
@@ -156,6 +324,20 @@ const (
 	ImagingSelection3DGraphicTypeEllipsoid  ImagingSelection3DGraphicType = "ellipsoid"
 )
 
+// IsValid reports whether c is one of the ImagingSelection3DGraphicType codes.
+func (c ImagingSelection3DGraphicType) IsValid() bool {
+	switch c {
+	case ImagingSelection3DGraphicTypePoint,
+		ImagingSelection3DGraphicTypeMultipoint,
+		ImagingSelection3DGraphicTypePolyline,
+		ImagingSelection3DGraphicTypePolygon,
+		ImagingSelection3DGraphicTypeEllipse,
+		ImagingSelection3DGraphicTypeEllipsoid:
+		return true
+	}
+	return false
+}
+
 // ImagingSelectionStatus represents codes from http://hl7.org/fhir/ValueSet/imagingselection-status
 type ImagingSelectionStatus string
 
@@ -166,6 +348,18 @@ const (
 	ImagingSelectionStatusUnknown        ImagingSelectionStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the ImagingSelectionStatus codes.
+func (c ImagingSelectionStatus) IsValid() bool {
+	switch c {
+	case ImagingSelectionStatusAvailable,
+		ImagingSelectionStatusEnteredInError,
+		ImagingSelectionStatusInactive,
+		ImagingSelectionStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // ImagingStudyStatus represents codes from http://hl7.org/fhir/ValueSet/imagingstudy-status
 type ImagingStudyStatus string
 
@@ -178,6 +372,20 @@ const (
 	ImagingStudyStatusInactive       ImagingStudyStatus = "inactive"
 )
 
+// IsValid reports whether c is one of the ImagingStudyStatus codes.
+func (c ImagingStudyStatus) IsValid() bool {
+	switch c {
+	case ImagingStudyStatusRegistered,
+		ImagingStudyStatusAvailable,
+		ImagingStudyStatusCancelled,
+		ImagingStudyStatusEnteredInError,
+		ImagingStudyStatusUnknown,
+		ImagingStudyStatusInactive:
+		return true
+	}
+	return false
+}
+
 // ImmunizationStatusCodes represents codes from http://hl7.org/fhir/ValueSet/immunization-status
 type ImmunizationStatusCodes string
 
@@ -192,6 +400,22 @@ const (
 	ImmunizationStatusCodesUnknown        ImmunizationStatusCodes = "unknown"
 )
 
+// IsValid reports whether c is one of the ImmunizationStatusCodes codes.
+func (c ImmunizationStatusCodes) IsValid() bool {
+	switch c {
+	case ImmunizationStatusCodesPreparation,
+		ImmunizationStatusCodesInProgress,
+		ImmunizationStatusCodesNotDone,
+		ImmunizationStatusCodesOnHold,
+		ImmunizationStatusCodesStopped,
+		ImmunizationStatusCodesCompleted,
+		ImmunizationStatusCodesEnteredInError,
+		ImmunizationStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
 // IngredientManufacturerRole represents codes from http://hl7.org/fhir/ValueSet/ingredient-manufacturer-role
 type IngredientManufacturerRole string
 
@@ -201,6 +425,17 @@ const (
 	IngredientManufacturerRoleActual   IngredientManufacturerRole = "actual"
 )
 
+// IsValid reports whether c is one of the IngredientManufacturerRole codes.
+func (c IngredientManufacturerRole) IsValid() bool {
+	switch c {
+	case IngredientManufacturerRoleAllowed,
+		IngredientManufacturerRolePossible,
+		IngredientManufacturerRoleActual:
+		return true
+	}
+	return false
+}
+
 // InteractionTrigger represents codes from http://hl7.org/fhir/ValueSet/interaction-trigger
 type InteractionTrigger string
 
@@ -232,6 +467,39 @@ const (
 	InteractionTriggerOperation                 InteractionTrigger = "operation"
 )
 
+// IsValid reports whether c is one of the InteractionTrigger codes.
+func (c InteractionTrigger) IsValid() bool {
+	switch c {
+	case InteractionTriggerRead,
+		InteractionTriggerVread,
+		InteractionTriggerUpdate,
+		InteractionTriggerUpdateConditional,
+		InteractionTriggerPatch,
+		InteractionTriggerPatchConditional,
+		InteractionTriggerDelete,
+		InteractionTriggerDeleteConditionalSingle,
+		InteractionTriggerDeleteConditionalMultiple,
+		InteractionTriggerDeleteHistory,
+		InteractionTriggerDeleteHistoryVersion,
+		InteractionTriggerHistory,
+		InteractionTriggerHistoryInstance,
+		InteractionTriggerHistoryType,
+		InteractionTriggerHistorySystem,
+		InteractionTriggerCreate,
+		InteractionTriggerCreateConditional,
+		InteractionTriggerSearch,
+		InteractionTriggerSearchType,
+		InteractionTriggerSearchSystem,
+		InteractionTriggerSearchCompartment,
+		InteractionTriggerCapabilities,
+		InteractionTriggerTransaction,
+		InteractionTriggerBatch,
+		InteractionTriggerOperation:
+		return true
+	}
+	return false
+}
+
 // InvoiceStatus represents codes from http://hl7.org/fhir/ValueSet/invoice-status
 type InvoiceStatus string
 
@@ -243,6 +511,19 @@ const (
 	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the InvoiceStatus codes.
+func (c InvoiceStatus) IsValid() bool {
+	switch c {
+	case InvoiceStatusDraft,
+		InvoiceStatusIssued,
+		InvoiceStatusBalanced,
+		InvoiceStatusCancelled,
+		InvoiceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // IssueSeverity represents codes from http://hl7.org/fhir/ValueSet/issue-severity
 type IssueSeverity string
 
@@ -254,6 +535,19 @@ const (
 	IssueSeveritySuccess     IssueSeverity = "success"
 )
 
+// IsValid reports whether c is one of the IssueSeverity codes.
+func (c IssueSeverity) IsValid() bool {
+	switch c {
+	case IssueSeverityFatal,
+		IssueSeverityError,
+		IssueSeverityWarning,
+		IssueSeverityInformation,
+		IssueSeveritySuccess:
+		return true
+	}
+	return false
+}
+
 // IssueType represents codes from http://hl7.org/fhir/ValueSet/issue-type
 type IssueType string
 
@@ -293,6 +587,47 @@ const (
 	IssueTypeSuccess         IssueType = "success"
 )
 
+// IsValid reports whether c is one of the IssueType codes.
+func (c IssueType) IsValid() bool {
+	switch c {
+	case IssueTypeInvalid,
+		IssueTypeStructure,
+		IssueTypeRequired,
+		IssueTypeValue,
+		IssueTypeInvariant,
+		IssueTypeSecurity,
+		IssueTypeLogin,
+		IssueTypeUnknown,
+		IssueTypeExpired,
+		IssueTypeForbidden,
+		IssueTypeSuppressed,
+		IssueTypeProcessing,
+		IssueTypeNotSupported,
+		IssueTypeDuplicate,
+		IssueTypeMultipleMatches,
+		IssueTypeNotFound,
+		IssueTypeDeleted,
+		IssueTypeTooLong,
+		IssueTypeCodeInvalid,
+		IssueTypeExtension,
+		IssueTypeTooCostly,
+		IssueTypeBusinessRule,
+		IssueTypeConflict,
+		IssueTypeLimitedFilter,
+		IssueTypeTransient,
+		IssueTypeLockError,
+		IssueTypeNoStore,
+		IssueTypeException,
+		IssueTypeTimeout,
+		IssueTypeIncomplete,
+		IssueTypeThrottled,
+		IssueTypeInformational,
+		IssueTypeSuccess:
+		return true
+	}
+	return false
+}
+
 // Kind represents codes from http://hl7.org/fhir/ValueSet/coverage-kind
 type Kind string
 
@@ -302,6 +637,17 @@ const (
 	KindOther     Kind = "other"
 )
 
+// IsValid reports whether c is one of the Kind codes.
+func (c Kind) IsValid() bool {
+	switch c {
+	case KindInsurance,
+		KindSelfPay,
+		KindOther:
+		return true
+	}
+	return false
+}
+
 // LinkRelationTypes represents codes from http://hl7.org/fhir/ValueSet/iana-link-relations
 type LinkRelationTypes string
 
@@ -428,6 +774,134 @@ const (
 	LinkRelationTypesWorkingCopyOf          LinkRelationTypes = "working-copy-of"
 )
 
+// IsValid reports whether c is one of the LinkRelationTypes codes.
+func (c LinkRelationTypes) IsValid() bool {
+	switch c {
+	case LinkRelationTypesAbout,
+		LinkRelationTypesAcl,
+		LinkRelationTypesAlternate,
+		LinkRelationTypesAmphtml,
+		LinkRelationTypesAppendix,
+		LinkRelationTypesAppleTouchIcon,
+		LinkRelationTypesAppleTouchStartupImage,
+		LinkRelationTypesArchives,
+		LinkRelationTypesAuthor,
+		LinkRelationTypesBlockedBy,
+		LinkRelationTypesBookmark,
+		LinkRelationTypesCanonical,
+		LinkRelationTypesChapter,
+		LinkRelationTypesCiteAs,
+		LinkRelationTypesCollection,
+		LinkRelationTypesContents,
+		LinkRelationTypesConvertedFrom,
+		LinkRelationTypesCopyright,
+		LinkRelationTypesCreateForm,
+		LinkRelationTypesCurrent,
+		LinkRelationTypesDescribedby,
+		LinkRelationTypesDescribes,
+		LinkRelationTypesDisclosure,
+		LinkRelationTypesDnsPrefetch,
+		LinkRelationTypesDuplicate,
+		LinkRelationTypesEdit,
+		LinkRelationTypesEditForm,
+		LinkRelationTypesEditMedia,
+		LinkRelationTypesEnclosure,
+		LinkRelationTypesExternal,
+		LinkRelationTypesFirst,
+		LinkRelationTypesGlossary,
+		LinkRelationTypesHelp,
+		LinkRelationTypesHosts,
+		LinkRelationTypesHub,
+		LinkRelationTypesIcon,
+		LinkRelationTypesIndex,
+		LinkRelationTypesIntervalAfter,
+		LinkRelationTypesIntervalBefore,
+		LinkRelationTypesIntervalContains,
+		LinkRelationTypesIntervalDisjoint,
+		LinkRelationTypesIntervalDuring,
+		LinkRelationTypesIntervalEquals,
+		LinkRelationTypesIntervalFinishedBy,
+		LinkRelationTypesIntervalFinishes,
+		LinkRelationTypesIntervalIn,
+		LinkRelationTypesIntervalMeets,
+		LinkRelationTypesIntervalMetBy,
+		LinkRelationTypesIntervalOverlappedBy,
+		LinkRelationTypesIntervalOverlaps,
+		LinkRelationTypesIntervalStartedBy,
+		LinkRelationTypesIntervalStarts,
+		LinkRelationTypesItem,
+		LinkRelationTypesLast,
+		LinkRelationTypesLatestVersion,
+		LinkRelationTypesLicense,
+		LinkRelationTypesLinkset,
+		LinkRelationTypesLrdd,
+		LinkRelationTypesManifest,
+		LinkRelationTypesMaskIcon,
+		LinkRelationTypesMediaFeed,
+		LinkRelationTypesMemento,
+		LinkRelationTypesMicropub,
+		LinkRelationTypesModulepreload,
+		LinkRelationTypesMonitor,
+		LinkRelationTypesMonitorGroup,
+		LinkRelationTypesNext,
+		LinkRelationTypesNextArchive,
+		LinkRelationTypesNofollow,
+		LinkRelationTypesNoopener,
+		LinkRelationTypesNoreferrer,
+		LinkRelationTypesOpener,
+		LinkRelationTypesOpenid2LocalId,
+		LinkRelationTypesOpenid2Provider,
+		LinkRelationTypesOriginal,
+		LinkRelationTypesP3Pv1,
+		LinkRelationTypesPayment,
+		LinkRelationTypesPingback,
+		LinkRelationTypesPreconnect,
+		LinkRelationTypesPredecessorVersion,
+		LinkRelationTypesPrefetch,
+		LinkRelationTypesPreload,
+		LinkRelationTypesPrerender,
+		LinkRelationTypesPrev,
+		LinkRelationTypesPreview,
+		LinkRelationTypesPrevious,
+		LinkRelationTypesPrevArchive,
+		LinkRelationTypesPrivacyPolicy,
+		LinkRelationTypesProfile,
+		LinkRelationTypesPublication,
+		LinkRelationTypesRelated,
+		LinkRelationTypesRestconf,
+		LinkRelationTypesReplies,
+		LinkRelationTypesRuleinput,
+		LinkRelationTypesSearch,
+		LinkRelationTypesSection,
+		LinkRelationTypesSelf,
+		LinkRelationTypesService,
+		LinkRelationTypesServiceDesc,
+		LinkRelationTypesServiceDoc,
+		LinkRelationTypesServiceMeta,
+		LinkRelationTypesSponsored,
+		LinkRelationTypesStart,
+		LinkRelationTypesStatus,
+		LinkRelationTypesStylesheet,
+		LinkRelationTypesSubsection,
+		LinkRelationTypesSuccessorVersion,
+		LinkRelationTypesSunset,
+		LinkRelationTypesTag,
+		LinkRelationTypesTermsOfService,
+		LinkRelationTypesTimegate,
+		LinkRelationTypesTimemap,
+		LinkRelationTypesType,
+		LinkRelationTypesUgc,
+		LinkRelationTypesUp,
+		LinkRelationTypesVersionHistory,
+		LinkRelationTypesVia,
+		LinkRelationTypesWebmention,
+		LinkRelationTypesWorkingCopy,
+		LinkRelationTypesWorkingCopyOf:
+		return true
+	}
+	return false
+}
+
 // LinkType represents codes from http://hl7.org/fhir/ValueSet/link-type
 type LinkType string
 
@@ -438,6 +912,18 @@ const (
 	LinkTypeSeealso    LinkType = "seealso"
 )
 
+// IsValid reports whether c is one of the LinkType codes.
+func (c LinkType) IsValid() bool {
+	switch c {
+	case LinkTypeReplacedBy,
+		LinkTypeReplaces,
+		LinkTypeRefer,
+		LinkTypeSeealso:
+		return true
+	}
+	return false
+}
+
 // ListMode represents codes from http://hl7.org/fhir/ValueSet/list-mode
 type ListMode string
 
@@ -447,6 +933,17 @@ const (
 	ListModeChanges  ListMode = "changes"
 )
 
+// IsValid reports whether c is one of the ListMode codes.
+func (c ListMode) IsValid() bool {
+	switch c {
+	case ListModeWorking,
+		ListModeSnapshot,
+		ListModeChanges:
+		return true
+	}
+	return false
+}
+
 // ListStatus represents codes from http://hl7.org/fhir/ValueSet/list-status
 type ListStatus string
 
@@ -456,6 +953,17 @@ const (
 	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the ListStatus codes.
+func (c ListStatus) IsValid() bool {
+	switch c {
+	case ListStatusCurrent,
+		ListStatusRetired,
+		ListStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // LocationMode represents codes from http://hl7.org/fhir/ValueSet/location-mode
 type LocationMode string
 
@@ -464,6 +972,16 @@ const (
 	LocationModeKind     LocationMode = "kind"
 )
 
+// IsValid reports whether c is one of the LocationMode codes.
+func (c LocationMode) IsValid() bool {
+	switch c {
+	case LocationModeInstance,
+		LocationModeKind:
+		return true
+	}
+	return false
+}
+
 // LocationStatus represents codes from http://hl7.org/fhir/ValueSet/location-status
 type LocationStatus string
 
@@ -472,3 +990,14 @@ const (
 	LocationStatusSuspended LocationStatus = "suspended"
 	LocationStatusInactive  LocationStatus = "inactive"
 )
+
+// IsValid reports whether c is one of the LocationStatus codes.
+func (c LocationStatus) IsValid() bool {
+	switch c {
+	case LocationStatusActive,
+		LocationStatusSuspended,
+		LocationStatusInactive:
+		return true
+	}
+	return false
+}
@@ -9,6 +9,17 @@ const (
 	MeasureReportStatusError    MeasureReportStatus = "error"
 )
 
+// IsValid reports whether c is one of the MeasureReportStatus codes.
+func (c MeasureReportStatus) IsValid() bool {
+	switch c {
+	case MeasureReportStatusComplete,
+		MeasureReportStatusPending,
+		MeasureReportStatusError:
+		return true
+	}
+	return false
+}
+
 // MeasureReportType represents codes from http://hl7.org/fhir/ValueSet/measure-report-type
 type MeasureReportType string
 
@@ -19,6 +30,18 @@ const (
 	MeasureReportTypeDataExchange MeasureReportType = "data-exchange"
 )
 
+// IsValid reports whether c is one of the MeasureReportType codes.
+func (c MeasureReportType) IsValid() bool {
+	switch c {
+	case MeasureReportTypeIndividual,
+		MeasureReportTypeSubjectList,
+		MeasureReportTypeSummary,
+		MeasureReportTypeDataExchange:
+		return true
+	}
+	return false
+}
+
 // MedicationAdministrationStatusCodes represents codes from http://hl7.org/fhir/ValueSet/medication-admin-status
 type MedicationAdministrationStatusCodes string
 
@@ -32,6 +55,21 @@ const (
 	MedicationAdministrationStatusCodesUnknown        MedicationAdministrationStatusCodes = "unknown"
 )
 
+// IsValid reports whether c is one of the MedicationAdministrationStatusCodes codes.
+func (c MedicationAdministrationStatusCodes) IsValid() bool {
+	switch c {
+	case MedicationAdministrationStatusCodesInProgress,
+		MedicationAdministrationStatusCodesNotDone,
+		MedicationAdministrationStatusCodesOnHold,
+		MedicationAdministrationStatusCodesCompleted,
+		MedicationAdministrationStatusCodesEnteredInError,
+		MedicationAdministrationStatusCodesStopped,
+		MedicationAdministrationStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
 // MedicationDispenseStatusCodes represents codes from http://hl7.org/fhir/ValueSet/medicationdispense-status
 type MedicationDispenseStatusCodes string
 
@@ -47,6 +85,23 @@ const (
 	MedicationDispenseStatusCodesUnknown        MedicationDispenseStatusCodes = "unknown"
 )
 
+// IsValid reports whether c is one of the MedicationDispenseStatusCodes codes.
+func (c MedicationDispenseStatusCodes) IsValid() bool {
+	switch c {
+	case MedicationDispenseStatusCodesPreparation,
+		MedicationDispenseStatusCodesInProgress,
+		MedicationDispenseStatusCodesCancelled,
+		MedicationDispenseStatusCodesOnHold,
+		MedicationDispenseStatusCodesCompleted,
+		MedicationDispenseStatusCodesEnteredInError,
+		MedicationDispenseStatusCodesUnfulfilled,
+		MedicationDispenseStatusCodesDeclined,
+		MedicationDispenseStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
 // MedicationRequestIntent represents codes from http://hl7.org/fhir/ValueSet/medicationrequest-intent
 type MedicationRequestIntent string
 
@@ -61,6 +116,22 @@ const (
 	MedicationRequestIntentOption        MedicationRequestIntent = "option"
 )
 
+// IsValid reports whether c is one of the MedicationRequestIntent codes.
+func (c MedicationRequestIntent) IsValid() bool {
+	switch c {
+	case MedicationRequestIntentProposal,
+		MedicationRequestIntentPlan,
+		MedicationRequestIntentOrder,
+		MedicationRequestIntentOriginalOrder,
+		MedicationRequestIntentReflexOrder,
+		MedicationRequestIntentFillerOrder,
+		MedicationRequestIntentInstanceOrder,
+		MedicationRequestIntentOption:
+		return true
+	}
+	return false
+}
+
 // MedicationStatementStatusCodes represents codes from http://hl7.org/fhir/ValueSet/medication-statement-status
 type MedicationStatementStatusCodes string
 
@@ -70,6 +141,17 @@ const (
 	MedicationStatementStatusCodesDraft          MedicationStatementStatusCodes = "draft"
 )
 
+// IsValid reports whether c is one of the MedicationStatementStatusCodes codes.
+func (c MedicationStatementStatusCodes) IsValid() bool {
+	switch c {
+	case MedicationStatementStatusCodesRecorded,
+		MedicationStatementStatusCodesEnteredInError,
+		MedicationStatementStatusCodesDraft:
+		return true
+	}
+	return false
+}
+
 // MedicationStatusCodes represents codes from http://hl7.org/fhir/ValueSet/medication-status
 type MedicationStatusCodes string
 
@@ -79,6 +161,17 @@ const (
 	MedicationStatusCodesEnteredInError MedicationStatusCodes = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the MedicationStatusCodes codes.
+func (c MedicationStatusCodes) IsValid() bool {
+	switch c {
+	case MedicationStatusCodesActive,
+		MedicationStatusCodesInactive,
+		MedicationStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
 // MedicationrequestStatus represents codes from http://hl7.org/fhir/ValueSet/medicationrequest-status
 type MedicationrequestStatus string
 
@@ -94,6 +187,23 @@ const (
 	MedicationrequestStatusUnknown        MedicationrequestStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the MedicationrequestStatus codes.
+func (c MedicationrequestStatus) IsValid() bool {
+	switch c {
+	case MedicationrequestStatusActive,
+		MedicationrequestStatusOnHold,
+		MedicationrequestStatusEnded,
+		MedicationrequestStatusStopped,
+		MedicationrequestStatusCompleted,
+		MedicationrequestStatusCancelled,
+		MedicationrequestStatusEnteredInError,
+		MedicationrequestStatusDraft,
+		MedicationrequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // MessageSignificanceCategory represents codes from http://hl7.org/fhir/ValueSet/message-significance-category
 type MessageSignificanceCategory string
 
@@ -103,6 +213,17 @@ const (
 	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
+// IsValid reports whether c is one of the MessageSignificanceCategory codes.
+func (c MessageSignificanceCategory) IsValid() bool {
+	switch c {
+	case MessageSignificanceCategoryConsequence,
+		MessageSignificanceCategoryCurrency,
+		MessageSignificanceCategoryNotification:
+		return true
+	}
+	return false
+}
+
 // MessageheaderResponseRequest represents codes from http://hl7.org/fhir/ValueSet/messageheader-response-request
 type MessageheaderResponseRequest string
 
@@ -113,6 +234,18 @@ const (
 	MessageheaderResponseRequestOnSuccess MessageheaderResponseRequest = "on-success"
 )
 
+// IsValid reports whether c is one of the MessageheaderResponseRequest codes.
+func (c MessageheaderResponseRequest) IsValid() bool {
+	switch c {
+	case MessageheaderResponseRequestAlways,
+		MessageheaderResponseRequestOnError,
+		MessageheaderResponseRequestNever,
+		MessageheaderResponseRequestOnSuccess:
+		return true
+	}
+	return false
+}
+
 // NameUse represents codes from http://hl7.org/fhir/ValueSet/name-use
 type NameUse string
 
@@ -126,6 +259,21 @@ const (
 	NameUseMaiden    NameUse = "maiden"
 )
 
+// IsValid reports whether c is one of the NameUse codes.
+func (c NameUse) IsValid() bool {
+	switch c {
+	case NameUseUsual,
+		NameUseOfficial,
+		NameUseTemp,
+		NameUseNickname,
+		NameUseAnonymous,
+		NameUseOld,
+		NameUseMaiden:
+		return true
+	}
+	return false
+}
+
 // NamingSystemIdentifierType represents codes from http://hl7.org/fhir/ValueSet/namingsystem-identifier-type
 type NamingSystemIdentifierType string
 
@@ -138,6 +286,20 @@ const (
 	NamingSystemIdentifierTypeOther        NamingSystemIdentifierType = "other"
 )
 
+// IsValid reports whether c is one of the NamingSystemIdentifierType codes.
+func (c NamingSystemIdentifierType) IsValid() bool {
+	switch c {
+	case NamingSystemIdentifierTypeOid,
+		NamingSystemIdentifierTypeUuid,
+		NamingSystemIdentifierTypeUri,
+		NamingSystemIdentifierTypeIriStem,
+		NamingSystemIdentifierTypeV2csmnemonic,
+		NamingSystemIdentifierTypeOther:
+		return true
+	}
+	return false
+}
+
 // NamingSystemType represents codes from http://hl7.org/fhir/ValueSet/namingsystem-type
 type NamingSystemType string
 
@@ -147,6 +309,17 @@ const (
 	NamingSystemTypeRoot       NamingSystemType = "root"
 )
 
+// IsValid reports whether c is one of the NamingSystemType codes.
+func (c NamingSystemType) IsValid() bool {
+	switch c {
+	case NamingSystemTypeCodesystem,
+		NamingSystemTypeIdentifier,
+		NamingSystemTypeRoot:
+		return true
+	}
+	return false
+}
+
 // NarrativeStatus represents codes from http://hl7.org/fhir/ValueSet/narrative-status
 type NarrativeStatus string
 
@@ -157,6 +330,18 @@ const (
 	NarrativeStatusEmpty      NarrativeStatus = "empty"
 )
 
+// IsValid reports whether c is one of the NarrativeStatus codes.
+func (c NarrativeStatus) IsValid() bool {
+	switch c {
+	case NarrativeStatusGenerated,
+		NarrativeStatusExtensions,
+		NarrativeStatusAdditional,
+		NarrativeStatusEmpty:
+		return true
+	}
+	return false
+}
+
 // NoteType represents codes from http://hl7.org/fhir/ValueSet/note-type
 type NoteType string
 
@@ -166,6 +351,17 @@ const (
 	NoteTypePrintoper NoteType = "printoper"
 )
 
+// IsValid reports whether c is one of the NoteType codes.
+func (c NoteType) IsValid() bool {
+	switch c {
+	case NoteTypeDisplay,
+		NoteTypePrint,
+		NoteTypePrintoper:
+		return true
+	}
+	return false
+}
+
 // NutritionProductStatus represents codes from http://hl7.org/fhir/ValueSet/nutritionproduct-status
 type NutritionProductStatus string
 
@@ -175,6 +371,17 @@ const (
 	NutritionProductStatusEnteredInError NutritionProductStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the NutritionProductStatus codes.
+func (c NutritionProductStatus) IsValid() bool {
+	switch c {
+	case NutritionProductStatusActive,
+		NutritionProductStatusInactive,
+		NutritionProductStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // ObservationDataType represents codes from http://hl7.org/fhir/ValueSet/permitted-data-type
 type ObservationDataType string
 
@@ -192,6 +399,25 @@ const (
 	ObservationDataTypePeriod          ObservationDataType = "Period"
 )
 
+// IsValid reports whether c is one of the ObservationDataType codes.
+func (c ObservationDataType) IsValid() bool {
+	switch c {
+	case ObservationDataTypeQuantity,
+		ObservationDataTypeCodeableConcept,
+		ObservationDataTypeString,
+		ObservationDataTypeBoolean,
+		ObservationDataTypeInteger,
+		ObservationDataTypeRange,
+		ObservationDataTypeRatio,
+		ObservationDataTypeSampledData,
+		ObservationDataTypeTime,
+		ObservationDataTypeDateTime,
+		ObservationDataTypePeriod:
+		return true
+	}
+	return false
+}
+
 // ObservationRangeCategory represents codes from http://hl7.org/fhir/ValueSet/observation-range-category
 type ObservationRangeCategory string
 
@@ -201,6 +427,17 @@ const (
 	ObservationRangeCategoryAbsolute  ObservationRangeCategory = "absolute"
 )
 
+// IsValid reports whether c is one of the ObservationRangeCategory codes.
+func (c ObservationRangeCategory) IsValid() bool {
+	switch c {
+	case ObservationRangeCategoryReference,
+		ObservationRangeCategoryCritical,
+		ObservationRangeCategoryAbsolute:
+		return true
+	}
+	return false
+}
+
 // ObservationStatus represents codes from http://hl7.org/fhir/ValueSet/observation-status
 type ObservationStatus string
 
@@ -218,6 +455,25 @@ const (
 	ObservationStatusCannotBeObtained  ObservationStatus = "cannot-be-obtained"
 )
 
+// IsValid reports whether c is one of the ObservationStatus codes.
+func (c ObservationStatus) IsValid() bool {
+	switch c {
+	case ObservationStatusRegistered,
+		ObservationStatusSpecimenInProcess,
+		ObservationStatusPreliminary,
+		ObservationStatusFinal,
+		ObservationStatusAmended,
+		ObservationStatusCorrected,
+		ObservationStatusAppended,
+		ObservationStatusCancelled,
+		ObservationStatusEnteredInError,
+		ObservationStatusUnknown,
+		ObservationStatusCannotBeObtained:
+		return true
+	}
+	return false
+}
+
 // OperationKind represents codes from http://hl7.org/fhir/ValueSet/operation-kind
 type OperationKind string
 
@@ -226,6 +482,16 @@ const (
 	OperationKindQuery     OperationKind = "query"
 )
 
+// IsValid reports whether c is one of the OperationKind codes.
+func (c OperationKind) IsValid() bool {
+	switch c {
+	case OperationKindOperation,
+		OperationKindQuery:
+		return true
+	}
+	return false
+}
+
 // OperationParameterScope represents codes from http://hl7.org/fhir/ValueSet/operation-parameter-scope
 type OperationParameterScope string
 
@@ -235,6 +501,17 @@ const (
 	OperationParameterScopeSystem   OperationParameterScope = "system"
 )
 
+// IsValid reports whether c is one of the OperationParameterScope codes.
+func (c OperationParameterScope) IsValid() bool {
+	switch c {
+	case OperationParameterScopeInstance,
+		OperationParameterScopeType,
+		OperationParameterScopeSystem:
+		return true
+	}
+	return false
+}
+
 // OperationParameterUse represents codes from http://hl7.org/fhir/ValueSet/operation-parameter-use
 type OperationParameterUse string
 
@@ -243,6 +520,16 @@ const (
 	OperationParameterUseOut OperationParameterUse = "out"
 )
 
+// IsValid reports whether c is one of the OperationParameterUse codes.
+func (c OperationParameterUse) IsValid() bool {
+	switch c {
+	case OperationParameterUseIn,
+		OperationParameterUseOut:
+		return true
+	}
+	return false
+}
+
 // OperationSynchronicityControl represents codes from http://hl7.org/fhir/ValueSet/synchronicity-control
 type OperationSynchronicityControl string
 
@@ -252,6 +539,17 @@ const (
 	OperationSynchronicityControlEither       OperationSynchronicityControl = "either"
 )
 
+// IsValid reports whether c is one of the OperationSynchronicityControl codes.
+func (c OperationSynchronicityControl) IsValid() bool {
+	switch c {
+	case OperationSynchronicityControlSynchronous,
+		OperationSynchronicityControlAsynchronous,
+		OperationSynchronicityControlEither:
+		return true
+	}
+	return false
+}
+
 // ParticipationStatus represents codes from http://hl7.org/fhir/ValueSet/participationstatus
 type ParticipationStatus string
 
@@ -262,6 +560,18 @@ const (
 	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
+// IsValid reports whether c is one of the ParticipationStatus codes.
+func (c ParticipationStatus) IsValid() bool {
+	switch c {
+	case ParticipationStatusAccepted,
+		ParticipationStatusDeclined,
+		ParticipationStatusTentative,
+		ParticipationStatusNeedsAction:
+		return true
+	}
+	return false
+}
+
 // PatchMimeTypes represents codes from http://hl7.org/fhir/ValueSet/patchmimetypes
 type PatchMimeTypes string
 
@@ -273,6 +583,19 @@ const (
 	PatchMimeTypesApplicationxmlPatchxml   PatchMimeTypes = "application/xml-patch+xml"
 )
 
+// IsValid reports whether c is one of the PatchMimeTypes codes.
+func (c PatchMimeTypes) IsValid() bool {
+	switch c {
+	case PatchMimeTypesApplicationfhirxml,
+		PatchMimeTypesApplicationfhirjson,
+		PatchMimeTypesApplicationfhirturtle,
+		PatchMimeTypesApplicationjsonPatchjson,
+		PatchMimeTypesApplicationxmlPatchxml:
+		return true
+	}
+	return false
+}
+
 // PaymentOutcome represents codes from http://hl7.org/fhir/ValueSet/payment-outcome
 type PaymentOutcome string
 
@@ -283,6 +606,18 @@ const (
 	PaymentOutcomePartial  PaymentOutcome = "partial"
 )
 
+// IsValid reports whether c is one of the PaymentOutcome codes.
+func (c PaymentOutcome) IsValid() bool {
+	switch c {
+	case PaymentOutcomeQueued,
+		PaymentOutcomeComplete,
+		PaymentOutcomeError,
+		PaymentOutcomePartial:
+		return true
+	}
+	return false
+}
+
 // PriceComponentType represents codes from http://hl7.org/fhir/ValueSet/price-component-type
 type PriceComponentType string
 
@@ -294,6 +629,19 @@ const (
 	PriceComponentTypeInformational PriceComponentType = "informational"
 )
 
+// IsValid reports whether c is one of the PriceComponentType codes.
+func (c PriceComponentType) IsValid() bool {
+	switch c {
+	case PriceComponentTypeBase,
+		PriceComponentTypeSurcharge,
+		PriceComponentTypeDiscount,
+		PriceComponentTypeTax,
+		PriceComponentTypeInformational:
+		return true
+	}
+	return false
+}
+
 // PropertyRepresentation represents codes from http://hl7.org/fhir/ValueSet/property-representation
 type PropertyRepresentation string
 
@@ -305,6 +653,19 @@ const (
 	PropertyRepresentationXhtml    PropertyRepresentation = "xhtml"
 )
 
+// IsValid reports whether c is one of the PropertyRepresentation codes.
+func (c PropertyRepresentation) IsValid() bool {
+	switch c {
+	case PropertyRepresentationXmlAttr,
+		PropertyRepresentationXmlText,
+		PropertyRepresentationTypeAttr,
+		PropertyRepresentationCdaText,
+		PropertyRepresentationXhtml:
+		return true
+	}
+	return false
+}
+
 // PropertyType represents codes from http://hl7.org/fhir/ValueSet/concept-property-type
 type PropertyType string
 
@@ -318,6 +679,21 @@ const (
 	PropertyTypeDecimal  PropertyType = "decimal"
 )
 
+// IsValid reports whether c is one of the PropertyType codes.
+func (c PropertyType) IsValid() bool {
+	switch c {
+	case PropertyTypeCode,
+		PropertyTypeCoding,
+		PropertyTypeString,
+		PropertyTypeInteger,
+		PropertyTypeBoolean,
+		PropertyTypeDateTime,
+		PropertyTypeDecimal:
+		return true
+	}
+	return false
+}
+
 // ProvenanceEntityRole represents codes from http://hl7.org/fhir/ValueSet/provenance-entity-role
 type ProvenanceEntityRole string
 
@@ -329,6 +705,19 @@ const (
 	ProvenanceEntityRoleRemoval      ProvenanceEntityRole = "removal"
 )
 
+// IsValid reports whether c is one of the ProvenanceEntityRole codes.
+func (c ProvenanceEntityRole) IsValid() bool {
+	switch c {
+	case ProvenanceEntityRoleRevision,
+		ProvenanceEntityRoleQuotation,
+		ProvenanceEntityRoleSource,
+		ProvenanceEntityRoleInstantiates,
+		ProvenanceEntityRoleRemoval:
+		return true
+	}
+	return false
+}
+
 // PublicationStatus represents codes from http://hl7.org/fhir/ValueSet/publication-status
 type PublicationStatus string
 
@@ -339,6 +728,18 @@ const (
 	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the PublicationStatus codes.
+func (c PublicationStatus) IsValid() bool {
+	switch c {
+	case PublicationStatusDraft,
+		PublicationStatusActive,
+		PublicationStatusRetired,
+		PublicationStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // QuantityComparator represents codes from http://hl7.org/fhir/ValueSet/quantity-comparator
 type QuantityComparator string
 
@@ -350,6 +751,19 @@ const (
 	QuantityComparatorAd     QuantityComparator = "ad"
 )
 
+// IsValid reports whether c is one of the QuantityComparator codes.
+func (c QuantityComparator) IsValid() bool {
+	switch c {
+	case QuantityComparatorCode,
+		QuantityComparatorCode_2,
+		QuantityComparatorCode_3,
+		QuantityComparatorCode_4,
+		QuantityComparatorAd:
+		return true
+	}
+	return false
+}
+
 // QuestionnaireAnswerConstraint represents codes from http://hl7.org/fhir/ValueSet/questionnaire-answer-constraint
 type QuestionnaireAnswerConstraint string
 
@@ -359,6 +773,17 @@ const (
 	QuestionnaireAnswerConstraintOptionsOrString QuestionnaireAnswerConstraint = "optionsOrString"
 )
 
+// IsValid reports whether c is one of the QuestionnaireAnswerConstraint codes.
+func (c QuestionnaireAnswerConstraint) IsValid() bool {
+	switch c {
+	case QuestionnaireAnswerConstraintOptionsOnly,
+		QuestionnaireAnswerConstraintOptionsOrType,
+		QuestionnaireAnswerConstraintOptionsOrString:
+		return true
+	}
+	return false
+}
+
 // QuestionnaireItemDisabledDisplay represents codes from http://hl7.org/fhir/ValueSet/questionnaire-disabled-display
 type QuestionnaireItemDisabledDisplay string
 
@@ -367,6 +792,16 @@ const (
 	QuestionnaireItemDisabledDisplayProtected QuestionnaireItemDisabledDisplay = "protected"
 )
 
+// IsValid reports whether c is one of the QuestionnaireItemDisabledDisplay codes.
+func (c QuestionnaireItemDisabledDisplay) IsValid() bool {
+	switch c {
+	case QuestionnaireItemDisabledDisplayHidden,
+		QuestionnaireItemDisabledDisplayProtected:
+		return true
+	}
+	return false
+}
+
 // QuestionnaireItemOperator represents codes from http://hl7.org/fhir/ValueSet/questionnaire-enable-operator
 type QuestionnaireItemOperator string
 
@@ -380,6 +815,21 @@ const (
 	QuestionnaireItemOperatorCode_6 QuestionnaireItemOperator = "<="
 )
 
+// IsValid reports whether c is one of the QuestionnaireItemOperator codes.
+func (c QuestionnaireItemOperator) IsValid() bool {
+	switch c {
+	case QuestionnaireItemOperatorExists,
+		QuestionnaireItemOperatorCode,
+		QuestionnaireItemOperatorCode_2,
+		QuestionnaireItemOperatorCode_3,
+		QuestionnaireItemOperatorCode_4,
+		QuestionnaireItemOperatorCode_5,
+		QuestionnaireItemOperatorCode_6:
+		return true
+	}
+	return false
+}
+
 // QuestionnaireItemTypeUsable represents codes from http://hl7.org/fhir/ValueSet/item-type-useable
 type QuestionnaireItemTypeUsable string
 
@@ -402,6 +852,30 @@ const (
 	QuestionnaireItemTypeUsableQuantity   QuestionnaireItemTypeUsable = "quantity"
 )
 
+// IsValid reports whether c is one of the QuestionnaireItemTypeUsable codes.
+func (c QuestionnaireItemTypeUsable) IsValid() bool {
+	switch c {
+	case QuestionnaireItemTypeUsableGroup,
+		QuestionnaireItemTypeUsableDisplay,
+		QuestionnaireItemTypeUsableQuestion,
+		QuestionnaireItemTypeUsableBoolean,
+		QuestionnaireItemTypeUsableDecimal,
+		QuestionnaireItemTypeUsableInteger,
+		QuestionnaireItemTypeUsableDate,
+		QuestionnaireItemTypeUsableDateTime,
+		QuestionnaireItemTypeUsableTime,
+		QuestionnaireItemTypeUsableString,
+		QuestionnaireItemTypeUsableText,
+		QuestionnaireItemTypeUsableUrl,
+		QuestionnaireItemTypeUsableCoding,
+		QuestionnaireItemTypeUsableAttachment,
+		QuestionnaireItemTypeUsableReference,
+		QuestionnaireItemTypeUsableQuantity:
+		return true
+	}
+	return false
+}
+
 // QuestionnaireResponseStatus represents codes from http://hl7.org/fhir/ValueSet/questionnaire-answers-status
 type QuestionnaireResponseStatus string
 
@@ -413,6 +887,19 @@ const (
 	QuestionnaireResponseStatusStopped        QuestionnaireResponseStatus = "stopped"
 )
 
+// IsValid reports whether c is one of the QuestionnaireResponseStatus codes.
+func (c QuestionnaireResponseStatus) IsValid() bool {
+	switch c {
+	case QuestionnaireResponseStatusInProgress,
+		QuestionnaireResponseStatusCompleted,
+		QuestionnaireResponseStatusAmended,
+		QuestionnaireResponseStatusEnteredInError,
+		QuestionnaireResponseStatusStopped:
+		return true
+	}
+	return false
+}
+
 // ReferenceHandlingPolicy represents codes from http://hl7.org/fhir/ValueSet/reference-handling-policy
 type ReferenceHandlingPolicy string
 
@@ -424,6 +911,19 @@ const (
 	ReferenceHandlingPolicyLocal    ReferenceHandlingPolicy = "local"
 )
 
+// IsValid reports whether c is one of the ReferenceHandlingPolicy codes.
+func (c ReferenceHandlingPolicy) IsValid() bool {
+	switch c {
+	case ReferenceHandlingPolicyLiteral,
+		ReferenceHandlingPolicyLogical,
+		ReferenceHandlingPolicyResolves,
+		ReferenceHandlingPolicyEnforced,
+		ReferenceHandlingPolicyLocal:
+		return true
+	}
+	return false
+}
+
 // ReferenceVersionRules represents codes from http://hl7.org/fhir/ValueSet/reference-version-rules
 type ReferenceVersionRules string
 
@@ -433,6 +933,17 @@ const (
 	ReferenceVersionRulesSpecific    ReferenceVersionRules = "specific"
 )
 
+// IsValid reports whether c is one of the ReferenceVersionRules codes.
+func (c ReferenceVersionRules) IsValid() bool {
+	switch c {
+	case ReferenceVersionRulesEither,
+		ReferenceVersionRulesIndependent,
+		ReferenceVersionRulesSpecific:
+		return true
+	}
+	return false
+}
+
 // RelatedArtifactType represents codes from http://hl7.org/fhir/ValueSet/related-artifact-type
 type RelatedArtifactType string
 
@@ -448,6 +959,23 @@ const (
 	RelatedArtifactTypePartOf        RelatedArtifactType = "part-of"
 )
 
+// IsValid reports whether c is one of the RelatedArtifactType codes.
+func (c RelatedArtifactType) IsValid() bool {
+	switch c {
+	case RelatedArtifactTypeDocumentation,
+		RelatedArtifactTypeJustification,
+		RelatedArtifactTypeCitation,
+		RelatedArtifactTypePredecessor,
+		RelatedArtifactTypeSuccessor,
+		RelatedArtifactTypeDerivedFrom,
+		RelatedArtifactTypeDependsOn,
+		RelatedArtifactTypeComposedOf,
+		RelatedArtifactTypePartOf:
+		return true
+	}
+	return false
+}
+
 // RequestIntent represents codes from http://hl7.org/fhir/ValueSet/request-intent
 type RequestIntent string
 
@@ -465,6 +993,25 @@ const (
 	RequestIntentOption        RequestIntent = "option"
 )
 
+// IsValid reports whether c is one of the RequestIntent codes.
+func (c RequestIntent) IsValid() bool {
+	switch c {
+	case RequestIntentProposal,
+		RequestIntentSolicitOffer,
+		RequestIntentOfferResponse,
+		RequestIntentPlan,
+		RequestIntentDirective,
+		RequestIntentOrder,
+		RequestIntentOriginalOrder,
+		RequestIntentReflexOrder,
+		RequestIntentFillerOrder,
+		RequestIntentInstanceOrder,
+		RequestIntentOption:
+		return true
+	}
+	return false
+}
+
 // RequestPriority represents codes from http://hl7.org/fhir/ValueSet/request-priority
 type RequestPriority string
 
@@ -475,6 +1022,18 @@ const (
 	RequestPriorityStat    RequestPriority = "stat"
 )
 
+// IsValid reports whether c is one of the RequestPriority codes.
+func (c RequestPriority) IsValid() bool {
+	switch c {
+	case RequestPriorityRoutine,
+		RequestPriorityUrgent,
+		RequestPriorityAsap,
+		RequestPriorityStat:
+		return true
+	}
+	return false
+}
+
 // RequestResourceTypes represents codes from http://hl7.org/fhir/ValueSet/request-resource-types
 type RequestResourceTypes string
 
@@ -684,6 +1243,217 @@ const (
 	RequestResourceTypesParameters                     RequestResourceTypes = "Parameters"
 )
 
+// IsValid reports whether c is one of the RequestResourceTypes codes.
+func (c RequestResourceTypes) IsValid() bool {
+	switch c {
+	case RequestResourceTypesBase,
+		RequestResourceTypesElement,
+		RequestResourceTypesBackboneElement,
+		RequestResourceTypesDataType,
+		RequestResourceTypesAddress,
+		RequestResourceTypesAnnotation,
+		RequestResourceTypesAttachment,
+		RequestResourceTypesAvailability,
+		RequestResourceTypesBackboneType,
+		RequestResourceTypesDosage,
+		RequestResourceTypesDosageCondition,
+		RequestResourceTypesDosageDetails,
+		RequestResourceTypesDosageSafety,
+		RequestResourceTypesElementDefinition,
+		RequestResourceTypesMarketingStatus,
+		RequestResourceTypesProductShelfLife,
+		RequestResourceTypesRelativeTime,
+		RequestResourceTypesTiming,
+		RequestResourceTypesCodeableConcept,
+		RequestResourceTypesCodeableReference,
+		RequestResourceTypesCoding,
+		RequestResourceTypesContactDetail,
+		RequestResourceTypesContactPoint,
+		RequestResourceTypesDataRequirement,
+		RequestResourceTypesExpression,
+		RequestResourceTypesExtendedContactDetail,
+		RequestResourceTypesExtension,
+		RequestResourceTypesHumanName,
+		RequestResourceTypesIdentifier,
+		RequestResourceTypesMeta,
+		RequestResourceTypesMonetaryComponent,
+		RequestResourceTypesMoney,
+		RequestResourceTypesNarrative,
+		RequestResourceTypesParameterDefinition,
+		RequestResourceTypesPeriod,
+		RequestResourceTypesPrimitiveType,
+		RequestResourceTypesBase64Binary,
+		RequestResourceTypesBoolean,
+		RequestResourceTypesDate,
+		RequestResourceTypesDateTime,
+		RequestResourceTypesDecimal,
+		RequestResourceTypesInstant,
+		RequestResourceTypesInteger,
+		RequestResourceTypesPositiveInt,
+		RequestResourceTypesUnsignedInt,
+		RequestResourceTypesInteger64,
+		RequestResourceTypesString,
+		RequestResourceTypesCode,
+		RequestResourceTypesId,
+		RequestResourceTypesMarkdown,
+		RequestResourceTypesTime,
+		RequestResourceTypesUri,
+		RequestResourceTypesCanonical,
+		RequestResourceTypesOid,
+		RequestResourceTypesUrl,
+		RequestResourceTypesUuid,
+		RequestResourceTypesQuantity,
+		RequestResourceTypesAge,
+		RequestResourceTypesCount,
+		RequestResourceTypesDistance,
+		RequestResourceTypesDuration,
+		RequestResourceTypesRange,
+		RequestResourceTypesRatio,
+		RequestResourceTypesRatioRange,
+		RequestResourceTypesReference,
+		RequestResourceTypesRelatedArtifact,
+		RequestResourceTypesSampledData,
+		RequestResourceTypesSignature,
+		RequestResourceTypesTriggerDefinition,
+		RequestResourceTypesUsageContext,
+		RequestResourceTypesVirtualServiceDetail,
+		RequestResourceTypesXhtml,
+		RequestResourceTypesResource,
+		RequestResourceTypesBinary,
+		RequestResourceTypesBundle,
+		RequestResourceTypesDomainResource,
+		RequestResourceTypesAccount,
+		RequestResourceTypesActivityDefinition,
+		RequestResourceTypesActorDefinition,
+		RequestResourceTypesAdministrableProductDefinition,
+		RequestResourceTypesAdverseEvent,
+		RequestResourceTypesAllergyIntolerance,
+		RequestResourceTypesAppointment,
+		RequestResourceTypesAppointmentResponse,
+		RequestResourceTypesArtifactAssessment,
+		RequestResourceTypesAuditEvent,
+		RequestResourceTypesBasic,
+		RequestResourceTypesBiologicallyDerivedProduct,
+		RequestResourceTypesBodyStructure,
+		RequestResourceTypesCanonicalResource,
+		RequestResourceTypesCapabilityStatement,
+		RequestResourceTypesCarePlan,
+		RequestResourceTypesCareTeam,
+		RequestResourceTypesClaim,
+		RequestResourceTypesClaimResponse,
+		RequestResourceTypesClinicalUseDefinition,
+		RequestResourceTypesCodeSystem,
+		RequestResourceTypesCommunication,
+		RequestResourceTypesCommunicationRequest,
+		RequestResourceTypesCompartmentDefinition,
+		RequestResourceTypesComposition,
+		RequestResourceTypesConceptMap,
+		RequestResourceTypesCondition,
+		RequestResourceTypesConsent,
+		RequestResourceTypesContract,
+		RequestResourceTypesCoverage,
+		RequestResourceTypesCoverageEligibilityRequest,
+		RequestResourceTypesCoverageEligibilityResponse,
+		RequestResourceTypesDetectedIssue,
+		RequestResourceTypesDevice,
+		RequestResourceTypesDeviceAlert,
+		RequestResourceTypesDeviceAssociation,
+		RequestResourceTypesDeviceDefinition,
+		RequestResourceTypesDeviceMetric,
+		RequestResourceTypesDeviceRequest,
+		RequestResourceTypesDiagnosticReport,
+		RequestResourceTypesDocumentReference,
+		RequestResourceTypesEncounter,
+		RequestResourceTypesEndpoint,
+		RequestResourceTypesEnrollmentRequest,
+		RequestResourceTypesEnrollmentResponse,
+		RequestResourceTypesEpisodeOfCare,
+		RequestResourceTypesEventDefinition,
+		RequestResourceTypesEvidence,
+		RequestResourceTypesEvidenceVariable,
+		RequestResourceTypesExampleScenario,
+		RequestResourceTypesExplanationOfBenefit,
+		RequestResourceTypesFamilyMemberHistory,
+		RequestResourceTypesFlag,
+		RequestResourceTypesGoal,
+		RequestResourceTypesGroup,
+		RequestResourceTypesGuidanceResponse,
+		RequestResourceTypesHealthcareService,
+		RequestResourceTypesImagingSelection,
+		RequestResourceTypesImagingStudy,
+		RequestResourceTypesImmunization,
+		RequestResourceTypesImplementationGuide,
+		RequestResourceTypesIngredient,
+		RequestResourceTypesInsurancePlan,
+		RequestResourceTypesInsuranceProduct,
+		RequestResourceTypesInvoice,
+		RequestResourceTypesLibrary,
+		RequestResourceTypesList,
+		RequestResourceTypesLocation,
+		RequestResourceTypesManufacturedItemDefinition,
+		RequestResourceTypesMeasure,
+		RequestResourceTypesMeasureReport,
+		RequestResourceTypesMedication,
+		RequestResourceTypesMedicationAdministration,
+		RequestResourceTypesMedicationDispense,
+		RequestResourceTypesMedicationRequest,
+		RequestResourceTypesMedicationStatement,
+		RequestResourceTypesMedicinalProductDefinition,
+		RequestResourceTypesMessageDefinition,
+		RequestResourceTypesMessageHeader,
+		RequestResourceTypesMetadataResource,
+		RequestResourceTypesNamingSystem,
+		RequestResourceTypesNutritionIntake,
+		RequestResourceTypesNutritionOrder,
+		RequestResourceTypesNutritionProduct,
+		RequestResourceTypesObservation,
+		RequestResourceTypesObservationDefinition,
+		RequestResourceTypesOperationDefinition,
+		RequestResourceTypesOperationOutcome,
+		RequestResourceTypesOrganization,
+		RequestResourceTypesOrganizationAffiliation,
+		RequestResourceTypesPackagedProductDefinition,
+		RequestResourceTypesPatient,
+		RequestResourceTypesPaymentNotice,
+		RequestResourceTypesPaymentReconciliation,
+		RequestResourceTypesPerson,
+		RequestResourceTypesPlanDefinition,
+		RequestResourceTypesPractitioner,
+		RequestResourceTypesPractitionerRole,
+		RequestResourceTypesProcedure,
+		RequestResourceTypesProvenance,
+		RequestResourceTypesQuestionnaire,
+		RequestResourceTypesQuestionnaireResponse,
+		RequestResourceTypesRegulatedAuthorization,
+		RequestResourceTypesRelatedPerson,
+		RequestResourceTypesRequestOrchestration,
+		RequestResourceTypesRequirements,
+		RequestResourceTypesResearchStudy,
+		RequestResourceTypesResearchSubject,
+		RequestResourceTypesRiskAssessment,
+		RequestResourceTypesSchedule,
+		RequestResourceTypesSearchParameter,
+		RequestResourceTypesServiceRequest,
+		RequestResourceTypesSlot,
+		RequestResourceTypesSpecimen,
+		RequestResourceTypesSpecimenDefinition,
+		RequestResourceTypesStructureDefinition,
+		RequestResourceTypesStructureMap,
+		RequestResourceTypesSubscription,
+		RequestResourceTypesSubscriptionStatus,
+		RequestResourceTypesSubscriptionTopic,
+		RequestResourceTypesSubstance,
+		RequestResourceTypesSubstanceDefinition,
+		RequestResourceTypesTask,
+		RequestResourceTypesTerminologyCapabilities,
+		RequestResourceTypesValueSet,
+		RequestResourceTypesVisionPrescription,
+		RequestResourceTypesParameters:
+		return true
+	}
+	return false
+}
+
 // RequestStatus represents codes from http://hl7.org/fhir/ValueSet/request-status
 type RequestStatus string
 
@@ -698,6 +1468,22 @@ const (
 	RequestStatusUnknown        RequestStatus = "unknown"
 )
 
+// IsValid reports whether c is one of the RequestStatus codes.
+func (c RequestStatus) IsValid() bool {
+	switch c {
+	case RequestStatusDraft,
+		RequestStatusActive,
+		RequestStatusOnHold,
+		RequestStatusEnteredInError,
+		RequestStatusEnded,
+		RequestStatusCompleted,
+		RequestStatusRevoked,
+		RequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
 // ResourceType represents codes from http://hl7.org/fhir/ValueSet/resource-types
 type ResourceType string
 
@@ -907,6 +1693,217 @@ const (
 	ResourceTypeParameters                     ResourceType = "Parameters"
 )
 
+// IsValid reports whether c is one of the ResourceType codes.
+func (c ResourceType) IsValid() bool {
+	switch c {
+	case ResourceTypeBase,
+		ResourceTypeElement,
+		ResourceTypeBackboneElement,
+		ResourceTypeDataType,
+		ResourceTypeAddress,
+		ResourceTypeAnnotation,
+		ResourceTypeAttachment,
+		ResourceTypeAvailability,
+		ResourceTypeBackboneType,
+		ResourceTypeDosage,
+		ResourceTypeDosageCondition,
+		ResourceTypeDosageDetails,
+		ResourceTypeDosageSafety,
+		ResourceTypeElementDefinition,
+		ResourceTypeMarketingStatus,
+		ResourceTypeProductShelfLife,
+		ResourceTypeRelativeTime,
+		ResourceTypeTiming,
+		ResourceTypeCodeableConcept,
+		ResourceTypeCodeableReference,
+		ResourceTypeCoding,
+		ResourceTypeContactDetail,
+		ResourceTypeContactPoint,
+		ResourceTypeDataRequirement,
+		ResourceTypeExpression,
+		ResourceTypeExtendedContactDetail,
+		ResourceTypeExtension,
+		ResourceTypeHumanName,
+		ResourceTypeIdentifier,
+		ResourceTypeMeta,
+		ResourceTypeMonetaryComponent,
+		ResourceTypeMoney,
+		ResourceTypeNarrative,
+		ResourceTypeParameterDefinition,
+		ResourceTypePeriod,
+		ResourceTypePrimitiveType,
+		ResourceTypeBase64Binary,
+		ResourceTypeBoolean,
+		ResourceTypeDate,
+		ResourceTypeDateTime,
+		ResourceTypeDecimal,
+		ResourceTypeInstant,
+		ResourceTypeInteger,
+		ResourceTypePositiveInt,
+		ResourceTypeUnsignedInt,
+		ResourceTypeInteger64,
+		ResourceTypeString,
+		ResourceTypeCode,
+		ResourceTypeId,
+		ResourceTypeMarkdown,
+		ResourceTypeTime,
+		ResourceTypeUri,
+		ResourceTypeCanonical,
+		ResourceTypeOid,
+		ResourceTypeUrl,
+		ResourceTypeUuid,
+		ResourceTypeQuantity,
+		ResourceTypeAge,
+		ResourceTypeCount,
+		ResourceTypeDistance,
+		ResourceTypeDuration,
+		ResourceTypeRange,
+		ResourceTypeRatio,
+		ResourceTypeRatioRange,
+		ResourceTypeReference,
+		ResourceTypeRelatedArtifact,
+		ResourceTypeSampledData,
+		ResourceTypeSignature,
+		ResourceTypeTriggerDefinition,
+		ResourceTypeUsageContext,
+		ResourceTypeVirtualServiceDetail,
+		ResourceTypeXhtml,
+		ResourceTypeResource,
+		ResourceTypeBinary,
+		ResourceTypeBundle,
+		ResourceTypeDomainResource,
+		ResourceTypeAccount,
+		ResourceTypeActivityDefinition,
+		ResourceTypeActorDefinition,
+		ResourceTypeAdministrableProductDefinition,
+		ResourceTypeAdverseEvent,
+		ResourceTypeAllergyIntolerance,
+		ResourceTypeAppointment,
+		ResourceTypeAppointmentResponse,
+		ResourceTypeArtifactAssessment,
+		ResourceTypeAuditEvent,
+		ResourceTypeBasic,
+		ResourceTypeBiologicallyDerivedProduct,
+		ResourceTypeBodyStructure,
+		ResourceTypeCanonicalResource,
+		ResourceTypeCapabilityStatement,
+		ResourceTypeCarePlan,
+		ResourceTypeCareTeam,
+		ResourceTypeClaim,
+		ResourceTypeClaimResponse,
+		ResourceTypeClinicalUseDefinition,
+		ResourceTypeCodeSystem,
+		ResourceTypeCommunication,
+		ResourceTypeCommunicationRequest,
+		ResourceTypeCompartmentDefinition,
+		ResourceTypeComposition,
+		ResourceTypeConceptMap,
+		ResourceTypeCondition,
+		ResourceTypeConsent,
+		ResourceTypeContract,
+		ResourceTypeCoverage,
+		ResourceTypeCoverageEligibilityRequest,
+		ResourceTypeCoverageEligibilityResponse,
+		ResourceTypeDetectedIssue,
+		ResourceTypeDevice,
+		ResourceTypeDeviceAlert,
+		ResourceTypeDeviceAssociation,
+		ResourceTypeDeviceDefinition,
+		ResourceTypeDeviceMetric,
+		ResourceTypeDeviceRequest,
+		ResourceTypeDiagnosticReport,
+		ResourceTypeDocumentReference,
+		ResourceTypeEncounter,
+		ResourceTypeEndpoint,
+		ResourceTypeEnrollmentRequest,
+		ResourceTypeEnrollmentResponse,
+		ResourceTypeEpisodeOfCare,
+		ResourceTypeEventDefinition,
+		ResourceTypeEvidence,
+		ResourceTypeEvidenceVariable,
+		ResourceTypeExampleScenario,
+		ResourceTypeExplanationOfBenefit,
+		ResourceTypeFamilyMemberHistory,
+		ResourceTypeFlag,
+		ResourceTypeGoal,
+		ResourceTypeGroup,
+		ResourceTypeGuidanceResponse,
+		ResourceTypeHealthcareService,
+		ResourceTypeImagingSelection,
+		ResourceTypeImagingStudy,
+		ResourceTypeImmunization,
+		ResourceTypeImplementationGuide,
+		ResourceTypeIngredient,
+		ResourceTypeInsurancePlan,
+		ResourceTypeInsuranceProduct,
+		ResourceTypeInvoice,
+		ResourceTypeLibrary,
+		ResourceTypeList,
+		ResourceTypeLocation,
+		ResourceTypeManufacturedItemDefinition,
+		ResourceTypeMeasure,
+		ResourceTypeMeasureReport,
+		ResourceTypeMedication,
+		ResourceTypeMedicationAdministration,
+		ResourceTypeMedicationDispense,
+		ResourceTypeMedicationRequest,
+		ResourceTypeMedicationStatement,
+		ResourceTypeMedicinalProductDefinition,
+		ResourceTypeMessageDefinition,
+		ResourceTypeMessageHeader,
+		ResourceTypeMetadataResource,
+		ResourceTypeNamingSystem,
+		ResourceTypeNutritionIntake,
+		ResourceTypeNutritionOrder,
+		ResourceTypeNutritionProduct,
+		ResourceTypeObservation,
+		ResourceTypeObservationDefinition,
+		ResourceTypeOperationDefinition,
+		ResourceTypeOperationOutcome,
+		ResourceTypeOrganization,
+		ResourceTypeOrganizationAffiliation,
+		ResourceTypePackagedProductDefinition,
+		ResourceTypePatient,
+		ResourceTypePaymentNotice,
+		ResourceTypePaymentReconciliation,
+		ResourceTypePerson,
+		ResourceTypePlanDefinition,
+		ResourceTypePractitioner,
+		ResourceTypePractitionerRole,
+		ResourceTypeProcedure,
+		ResourceTypeProvenance,
+		ResourceTypeQuestionnaire,
+		ResourceTypeQuestionnaireResponse,
+		ResourceTypeRegulatedAuthorization,
+		ResourceTypeRelatedPerson,
+		ResourceTypeRequestOrchestration,
+		ResourceTypeRequirements,
+		ResourceTypeResearchStudy,
+		ResourceTypeResearchSubject,
+		ResourceTypeRiskAssessment,
+		ResourceTypeSchedule,
+		ResourceTypeSearchParameter,
+		ResourceTypeServiceRequest,
+		ResourceTypeSlot,
+		ResourceTypeSpecimen,
+		ResourceTypeSpecimenDefinition,
+		ResourceTypeStructureDefinition,
+		ResourceTypeStructureMap,
+		ResourceTypeSubscription,
+		ResourceTypeSubscriptionStatus,
+		ResourceTypeSubscriptionTopic,
+		ResourceTypeSubstance,
+		ResourceTypeSubstanceDefinition,
+		ResourceTypeTask,
+		ResourceTypeTerminologyCapabilities,
+		ResourceTypeValueSet,
+		ResourceTypeVisionPrescription,
+		ResourceTypeParameters:
+		return true
+	}
+	return false
+}
+
 // ResourceVersionPolicy represents codes from http://hl7.org/fhir/ValueSet/versioning-policy
 type ResourceVersionPolicy string
 
@@ -916,6 +1913,17 @@ const (
 	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
+// IsValid reports whether c is one of the ResourceVersionPolicy codes.
+func (c ResourceVersionPolicy) IsValid() bool {
+	switch c {
+	case ResourceVersionPolicyNoVersion,
+		ResourceVersionPolicyVersioned,
+		ResourceVersionPolicyVersionedUpdate:
+		return true
+	}
+	return false
+}
+
 // ResponseType represents codes from http://hl7.org/fhir/ValueSet/response-code
 type ResponseType string
 
@@ -925,6 +1933,17 @@ const (
 	ResponseTypeFatalError     ResponseType = "fatal-error"
 )
 
+// IsValid reports whether c is one of the ResponseType codes.
+func (c ResponseType) IsValid() bool {
+	switch c {
+	case ResponseTypeOk,
+		ResponseTypeTransientError,
+		ResponseTypeFatalError:
+		return true
+	}
+	return false
+}
+
 // RestfulCapabilityMode represents codes from http://hl7.org/fhir/ValueSet/restful-capability-mode
 type RestfulCapabilityMode string
 
@@ -932,3 +1951,13 @@ const (
 	RestfulCapabilityModeClient RestfulCapabilityMode = "client"
 	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
+
+// IsValid reports whether c is one of the RestfulCapabilityMode codes.
+func (c RestfulCapabilityMode) IsValid() bool {
+	switch c {
+	case RestfulCapabilityModeClient,
+		RestfulCapabilityModeServer:
+		return true
+	}
+	return false
+}
@@ -352,6 +352,360 @@ const (
 	SPDXLicenseZPL21                          SPDXLicense = "ZPL-2.1"
 )
 
+// IsValid reports whether c is one of the SPDXLicense codes.
+func (c SPDXLicense) IsValid() bool {
+	switch c {
+	case SPDXLicenseNotOpenSource,
+		SPDXLicenseCode0BSD,
+		SPDXLicenseAAL,
+		SPDXLicenseAbstyles,
+		SPDXLicenseAdobe2006,
+		SPDXLicenseAdobeGlyph,
+		SPDXLicenseADSL,
+		SPDXLicenseAFL11,
+		SPDXLicenseAFL12,
+		SPDXLicenseAFL20,
+		SPDXLicenseAFL21,
+		SPDXLicenseAFL30,
+		SPDXLicenseAfmparse,
+		SPDXLicenseAGPL10Only,
+		SPDXLicenseAGPL10OrLater,
+		SPDXLicenseAGPL30Only,
+		SPDXLicenseAGPL30OrLater,
+		SPDXLicenseAladdin,
+		SPDXLicenseAMDPLPA,
+		SPDXLicenseAML,
+		SPDXLicenseAMPAS,
+		SPDXLicenseANTLRPD,
+		SPDXLicenseApache10,
+		SPDXLicenseApache11,
+		SPDXLicenseApache20,
+		SPDXLicenseAPAFML,
+		SPDXLicenseAPL10,
+		SPDXLicenseAPSL10,
+		SPDXLicenseAPSL11,
+		SPDXLicenseAPSL12,
+		SPDXLicenseAPSL20,
+		SPDXLicenseArtistic10Cl8,
+		SPDXLicenseArtistic10Perl,
+		SPDXLicenseArtistic10,
+		SPDXLicenseArtistic20,
+		SPDXLicenseBahyph,
+		SPDXLicenseBarr,
+		SPDXLicenseBeerware,
+		SPDXLicenseBitTorrent10,
+		SPDXLicenseBitTorrent11,
+		SPDXLicenseBorceux,
+		SPDXLicenseBSD1Clause,
+		SPDXLicenseBSD2ClauseFreeBSD,
+		SPDXLicenseBSD2ClauseNetBSD,
+		SPDXLicenseBSD2ClausePatent,
+		SPDXLicenseBSD2Clause,
+		SPDXLicenseBSD3ClauseAttribution,
+		SPDXLicenseBSD3ClauseClear,
+		SPDXLicenseBSD3ClauseLBNL,
+		SPDXLicenseBSD3ClauseNoNuclearLicense2014,
+		SPDXLicenseBSD3ClauseNoNuclearLicense,
+		SPDXLicenseBSD3ClauseNoNuclearWarranty,
+		SPDXLicenseBSD3Clause,
+		SPDXLicenseBSD4ClauseUC,
+		SPDXLicenseBSD4Clause,
+		SPDXLicenseBSDProtection,
+		SPDXLicenseBSDSourceCode,
+		SPDXLicenseBSL10,
+		SPDXLicenseBzip2105,
+		SPDXLicenseBzip2106,
+		SPDXLicenseCaldera,
+		SPDXLicenseCATOSL11,
+		SPDXLicenseCCBY10,
+		SPDXLicenseCCBY20,
+		SPDXLicenseCCBY25,
+		SPDXLicenseCCBY30,
+		SPDXLicenseCCBY40,
+		SPDXLicenseCCBYNC10,
+		SPDXLicenseCCBYNC20,
+		SPDXLicenseCCBYNC25,
+		SPDXLicenseCCBYNC30,
+		SPDXLicenseCCBYNC40,
+		SPDXLicenseCCBYNCND10,
+		SPDXLicenseCCBYNCND20,
+		SPDXLicenseCCBYNCND25,
+		SPDXLicenseCCBYNCND30,
+		SPDXLicenseCCBYNCND40,
+		SPDXLicenseCCBYNCSA10,
+		SPDXLicenseCCBYNCSA20,
+		SPDXLicenseCCBYNCSA25,
+		SPDXLicenseCCBYNCSA30,
+		SPDXLicenseCCBYNCSA40,
+		SPDXLicenseCCBYND10,
+		SPDXLicenseCCBYND20,
+		SPDXLicenseCCBYND25,
+		SPDXLicenseCCBYND30,
+		SPDXLicenseCCBYND40,
+		SPDXLicenseCCBYSA10,
+		SPDXLicenseCCBYSA20,
+		SPDXLicenseCCBYSA25,
+		SPDXLicenseCCBYSA30,
+		SPDXLicenseCCBYSA40,
+		SPDXLicenseCC010,
+		SPDXLicenseCDDL10,
+		SPDXLicenseCDDL11,
+		SPDXLicenseCDLAPermissive10,
+		SPDXLicenseCDLASharing10,
+		SPDXLicenseCECILL10,
+		SPDXLicenseCECILL11,
+		SPDXLicenseCECILL20,
+		SPDXLicenseCECILL21,
+		SPDXLicenseCECILLB,
+		SPDXLicenseCECILLC,
+		SPDXLicenseClArtistic,
+		SPDXLicenseCNRIJython,
+		SPDXLicenseCNRIPythonGPLCompatible,
+		SPDXLicenseCNRIPython,
+		SPDXLicenseCondor11,
+		SPDXLicenseCPAL10,
+		SPDXLicenseCPL10,
+		SPDXLicenseCPOL102,
+		SPDXLicenseCrossword,
+		SPDXLicenseCrystalStacker,
+		SPDXLicenseCUAOPL10,
+		SPDXLicenseCube,
+		SPDXLicenseCurl,
+		SPDXLicenseDFSL10,
+		SPDXLicenseDiffmark,
+		SPDXLicenseDOC,
+		SPDXLicenseDotseqn,
+		SPDXLicenseDSDP,
+		SPDXLicenseDvipdfm,
+		SPDXLicenseECL10,
+		SPDXLicenseECL20,
+		SPDXLicenseEFL10,
+		SPDXLicenseEFL20,
+		SPDXLicenseEGenix,
+		SPDXLicenseEntessa,
+		SPDXLicenseEPL10,
+		SPDXLicenseEPL20,
+		SPDXLicenseErlPL11,
+		SPDXLicenseEUDatagrid,
+		SPDXLicenseEUPL10,
+		SPDXLicenseEUPL11,
+		SPDXLicenseEUPL12,
+		SPDXLicenseEurosym,
+		SPDXLicenseFair,
+		SPDXLicenseFrameworx10,
+		SPDXLicenseFreeImage,
+		SPDXLicenseFSFAP,
+		SPDXLicenseFSFUL,
+		SPDXLicenseFSFULLR,
+		SPDXLicenseFTL,
+		SPDXLicenseGFDL11Only,
+		SPDXLicenseGFDL11OrLater,
+		SPDXLicenseGFDL12Only,
+		SPDXLicenseGFDL12OrLater,
+		SPDXLicenseGFDL13Only,
+		SPDXLicenseGFDL13OrLater,
+		SPDXLicenseGiftware,
+		SPDXLicenseGL2PS,
+		SPDXLicenseGlide,
+		SPDXLicenseGlulxe,
+		SPDXLicenseGnuplot,
+		SPDXLicenseGPL10Only,
+		SPDXLicenseGPL10OrLater,
+		SPDXLicenseGPL20Only,
+		SPDXLicenseGPL20OrLater,
+		SPDXLicenseGPL30Only,
+		SPDXLicenseGPL30OrLater,
+		SPDXLicenseGSOAP13b,
+		SPDXLicenseHaskellReport,
+		SPDXLicenseHPND,
+		SPDXLicenseIBMPibs,
+		SPDXLicenseICU,
+		SPDXLicenseIJG,
+		SPDXLicenseImageMagick,
+		SPDXLicenseIMatix,
+		SPDXLicenseImlib2,
+		SPDXLicenseInfoZIP,
+		SPDXLicenseIntelACPI,
+		SPDXLicenseIntel,
+		SPDXLicenseInterbase10,
+		SPDXLicenseIPA,
+		SPDXLicenseIPL10,
+		SPDXLicenseISC,
+		SPDXLicenseJasPer20,
+		SPDXLicenseJSON,
+		SPDXLicenseLAL12,
+		SPDXLicenseLAL13,
+		SPDXLicenseLatex2e,
+		SPDXLicenseLeptonica,
+		SPDXLicenseLGPL20Only,
+		SPDXLicenseLGPL20OrLater,
+		SPDXLicenseLGPL21Only,
+		SPDXLicenseLGPL21OrLater,
+		SPDXLicenseLGPL30Only,
+		SPDXLicenseLGPL30OrLater,
+		SPDXLicenseLGPLLR,
+		SPDXLicenseLibpng,
+		SPDXLicenseLibtiff,
+		SPDXLicenseLiLiQP11,
+		SPDXLicenseLiLiQR11,
+		SPDXLicenseLiLiQRplus11,
+		SPDXLicenseLinuxOpenIB,
+		SPDXLicenseLPL10,
+		SPDXLicenseLPL102,
+		SPDXLicenseLPPL10,
+		SPDXLicenseLPPL11,
+		SPDXLicenseLPPL12,
+		SPDXLicenseLPPL13a,
+		SPDXLicenseLPPL13c,
+		SPDXLicenseMakeIndex,
+		SPDXLicenseMirOS,
+		SPDXLicenseMIT0,
+		SPDXLicenseMITAdvertising,
+		SPDXLicenseMITCMU,
+		SPDXLicenseMITEnna,
+		SPDXLicenseMITFeh,
+		SPDXLicenseMIT,
+		SPDXLicenseMITNFA,
+		SPDXLicenseMotosoto,
+		SPDXLicenseMpich2,
+		SPDXLicenseMPL10,
+		SPDXLicenseMPL11,
+		SPDXLicenseMPL20NoCopyleftException,
+		SPDXLicenseMPL20,
+		SPDXLicenseMSPL,
+		SPDXLicenseMSRL,
+		SPDXLicenseMTLL,
+		SPDXLicenseMultics,
+		SPDXLicenseMup,
+		SPDXLicenseNASA13,
+		SPDXLicenseNaumen,
+		SPDXLicenseNBPL10,
+		SPDXLicenseNCSA,
+		SPDXLicenseNetSNMP,
+		SPDXLicenseNetCDF,
+		SPDXLicenseNewsletr,
+		SPDXLicenseNGPL,
+		SPDXLicenseNLOD10,
+		SPDXLicenseNLPL,
+		SPDXLicenseNokia,
+		SPDXLicenseNOSL,
+		SPDXLicenseNoweb,
+		SPDXLicenseNPL10,
+		SPDXLicenseNPL11,
+		SPDXLicenseNPOSL30,
+		SPDXLicenseNRL,
+		SPDXLicenseNTP,
+		SPDXLicenseOCCTPL,
+		SPDXLicenseOCLC20,
+		SPDXLicenseODbL10,
+		SPDXLicenseOFL10,
+		SPDXLicenseOFL11,
+		SPDXLicenseOGTSL,
+		SPDXLicenseOLDAP11,
+		SPDXLicenseOLDAP12,
+		SPDXLicenseOLDAP13,
+		SPDXLicenseOLDAP14,
+		SPDXLicenseOLDAP201,
+		SPDXLicenseOLDAP20,
+		SPDXLicenseOLDAP21,
+		SPDXLicenseOLDAP221,
+		SPDXLicenseOLDAP222,
+		SPDXLicenseOLDAP22,
+		SPDXLicenseOLDAP23,
+		SPDXLicenseOLDAP24,
+		SPDXLicenseOLDAP25,
+		SPDXLicenseOLDAP26,
+		SPDXLicenseOLDAP27,
+		SPDXLicenseOLDAP28,
+		SPDXLicenseOML,
+		SPDXLicenseOpenSSL,
+		SPDXLicenseOPL10,
+		SPDXLicenseOSETPL21,
+		SPDXLicenseOSL10,
+		SPDXLicenseOSL11,
+		SPDXLicenseOSL20,
+		SPDXLicenseOSL21,
+		SPDXLicenseOSL30,
+		SPDXLicensePDDL10,
+		SPDXLicensePHP30,
+		SPDXLicensePHP301,
+		SPDXLicensePlexus,
+		SPDXLicensePostgreSQL,
+		SPDXLicensePsfrag,
+		SPDXLicensePsutils,
+		SPDXLicensePython20,
+		SPDXLicenseQhull,
+		SPDXLicenseQPL10,
+		SPDXLicenseRdisc,
+		SPDXLicenseRHeCos11,
+		SPDXLicenseRPL11,
+		SPDXLicenseRPL15,
+		SPDXLicenseRPSL10,
+		SPDXLicenseRSAMD,
+		SPDXLicenseRSCPL,
+		SPDXLicenseRuby,
+		SPDXLicenseSAXPD,
+		SPDXLicenseSaxpath,
+		SPDXLicenseSCEA,
+		SPDXLicenseSendmail,
+		SPDXLicenseSGIB10,
+		SPDXLicenseSGIB11,
+		SPDXLicenseSGIB20,
+		SPDXLicenseSimPL20,
+		SPDXLicenseSISSL12,
+		SPDXLicenseSISSL,
+		SPDXLicenseSleepycat,
+		SPDXLicenseSMLNJ,
+		SPDXLicenseSMPPL,
+		SPDXLicenseSNIA,
+		SPDXLicenseSpencer86,
+		SPDXLicenseSpencer94,
+		SPDXLicenseSpencer99,
+		SPDXLicenseSPL10,
+		SPDXLicenseSugarCRM113,
+		SPDXLicenseSWL,
+		SPDXLicenseTCL,
+		SPDXLicenseTCPWrappers,
+		SPDXLicenseTMate,
+		SPDXLicenseTORQUE11,
+		SPDXLicenseTOSL,
+		SPDXLicenseUnicodeDFS2015,
+		SPDXLicenseUnicodeDFS2016,
+		SPDXLicenseUnicodeTOU,
+		SPDXLicenseUnlicense,
+		SPDXLicenseUPL10,
+		SPDXLicenseVim,
+		SPDXLicenseVOSTROM,
+		SPDXLicenseVSL10,
+		SPDXLicenseW3C19980720,
+		SPDXLicenseW3C20150513,
+		SPDXLicenseW3C,
+		SPDXLicenseWatcom10,
+		SPDXLicenseWsuipa,
+		SPDXLicenseWTFPL,
+		SPDXLicenseX11,
+		SPDXLicenseXerox,
+		SPDXLicenseXFree8611,
+		SPDXLicenseXinetd,
+		SPDXLicenseXnet,
+		SPDXLicenseXpp,
+		SPDXLicenseXSkat,
+		SPDXLicenseYPL10,
+		SPDXLicenseYPL11,
+		SPDXLicenseZed,
+		SPDXLicenseZend20,
+		SPDXLicenseZimbra13,
+		SPDXLicenseZimbra14,
+		SPDXLicenseZlibAcknowledgement,
+		SPDXLicenseZlib,
+		SPDXLicenseZPL11,
+		SPDXLicenseZPL20,
+		SPDXLicenseZPL21:
+		return true
+	}
+	return false
+}
+
 // SearchComparator represents codes from http://hl7.org/fhir/ValueSet/search-comparator
 type SearchComparator string
 
@@ -367,6 +721,23 @@ const (
 	SearchComparatorAp SearchComparator = "ap"
 )
 
+// IsValid reports whether c is one of the SearchComparator codes.
+func (c SearchComparator) IsValid() bool {
+	switch c {
+	case SearchComparatorEq,
+		SearchComparatorNe,
+		SearchComparatorGt,
+		SearchComparatorLt,
+		SearchComparatorGe,
+		SearchComparatorLe,
+		SearchComparatorSa,
+		SearchComparatorEb,
+		SearchComparatorAp:
+		return true
+	}
+	return false
+}
+
 // SearchEntryMode represents codes from http://hl7.org/fhir/ValueSet/search-entry-mode
 type SearchEntryMode string
 
@@ -376,6 +747,17 @@ const (
 	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
+// IsValid reports whether c is one of the SearchEntryMode codes.
+func (c SearchEntryMode) IsValid() bool {
+	switch c {
+	case SearchEntryModeMatch,
+		SearchEntryModeInclude,
+		SearchEntryModeOutcome:
+		return true
+	}
+	return false
+}
+
 // SearchModifierAllCodes represents codes from http://hl7.org/fhir/ValueSet/search-modifier-all-codes
 type SearchModifierAllCodes string
 
@@ -397,6 +779,29 @@ const (
 	SearchModifierAllCodesIterate      SearchModifierAllCodes = "iterate"
 )
 
+// IsValid reports whether c is one of the SearchModifierAllCodes codes.
+func (c SearchModifierAllCodes) IsValid() bool {
+	switch c {
+	case SearchModifierAllCodesMissing,
+		SearchModifierAllCodesExact,
+		SearchModifierAllCodesContains,
+		SearchModifierAllCodesNot,
+		SearchModifierAllCodesText,
+		SearchModifierAllCodesIn,
+		SearchModifierAllCodesNotIn,
+		SearchModifierAllCodesBelow,
+		SearchModifierAllCodesAbove,
+		SearchModifierAllCodesType,
+		SearchModifierAllCodesIdentifier,
+		SearchModifierAllCodesOfType,
+		SearchModifierAllCodesCodeText,
+		SearchModifierAllCodesTextAdvanced,
+		SearchModifierAllCodesIterate:
+		return true
+	}
+	return false
+}
+
 // SearchModifierCode represents codes from http://hl7.org/fhir/ValueSet/search-modifier-code
 type SearchModifierCode string
 
@@ -418,6 +823,29 @@ const (
 	SearchModifierCodeIterate      SearchModifierCode = "iterate"
 )
 
+// IsValid reports whether c is one of the SearchModifierCode codes.
+func (c SearchModifierCode) IsValid() bool {
+	switch c {
+	case SearchModifierCodeMissing,
+		SearchModifierCodeExact,
+		SearchModifierCodeContains,
+		SearchModifierCodeNot,
+		SearchModifierCodeText,
+		SearchModifierCodeIn,
+		SearchModifierCodeNotIn,
+		SearchModifierCodeBelow,
+		SearchModifierCodeAbove,
+		SearchModifierCodeType,
+		SearchModifierCodeIdentifier,
+		SearchModifierCodeOfType,
+		SearchModifierCodeCodeText,
+		SearchModifierCodeTextAdvanced,
+		SearchModifierCodeIterate:
+		return true
+	}
+	return false
+}
+
 // SearchParamType represents codes from http://hl7.org/fhir/ValueSet/search-param-type
 type SearchParamType string
 
@@ -434,6 +862,24 @@ const (
 	SearchParamTypeResource  SearchParamType = "resource"
 )
 
+// IsValid reports whether c is one of the SearchParamType codes.
+func (c SearchParamType) IsValid() bool {
+	switch c {
+	case SearchParamTypeNumber,
+		SearchParamTypeDate,
+		SearchParamTypeString,
+		SearchParamTypeToken,
+		SearchParamTypeReference,
+		SearchParamTypeComposite,
+		SearchParamTypeQuantity,
+		SearchParamTypeUri,
+		SearchParamTypeSpecial,
+		SearchParamTypeResource:
+		return true
+	}
+	return false
+}
+
 // SearchProcessingModeType represents codes from http://hl7.org/fhir/ValueSet/search-processingmode
 type SearchProcessingModeType string
 
@@ -443,6 +889,17 @@ const (
 	SearchProcessingModeTypeOther    SearchProcessingModeType = "other"
 )
 
+// IsValid reports whether c is one of the SearchProcessingModeType codes.
+func (c SearchProcessingModeType) IsValid() bool {
+	switch c {
+	case SearchProcessingModeTypeNormal,
+		SearchProcessingModeTypePhonetic,
+		SearchProcessingModeTypeOther:
+		return true
+	}
+	return false
+}
+
 // SlicingRules represents codes from http://hl7.org/fhir/ValueSet/resource-slicing-rules
 type SlicingRules string
 
@@ -452,6 +909,17 @@ const (
 	SlicingRulesOpenAtEnd SlicingRules = "openAtEnd"
 )
 
+// IsValid reports whether c is one of the SlicingRules codes.
+func (c SlicingRules) IsValid() bool {
+	switch c {
+	case SlicingRulesClosed,
+		SlicingRulesOpen,
+		SlicingRulesOpenAtEnd:
+		return true
+	}
+	return false
+}
+
 // SlotStatus represents codes from http://hl7.org/fhir/ValueSet/slotstatus
 type SlotStatus string
 
@@ -463,6 +931,19 @@ const (
 	SlotStatusEnteredInError  SlotStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the SlotStatus codes.
+func (c SlotStatus) IsValid() bool {
+	switch c {
+	case SlotStatusBusy,
+		SlotStatusFree,
+		SlotStatusBusyUnavailable,
+		SlotStatusBusyTentative,
+		SlotStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // SortDirection represents codes from http://hl7.org/fhir/ValueSet/sort-direction
 type SortDirection string
 
@@ -471,6 +952,16 @@ const (
 	SortDirectionDescending SortDirection = "descending"
 )
 
+// IsValid reports whether c is one of the SortDirection codes.
+func (c SortDirection) IsValid() bool {
+	switch c {
+	case SortDirectionAscending,
+		SortDirectionDescending:
+		return true
+	}
+	return false
+}
+
 // SpecimenCombined represents codes from http://hl7.org/fhir/ValueSet/specimen-combined
 type SpecimenCombined string
 
@@ -479,6 +970,16 @@ const (
 	SpecimenCombinedPooled  SpecimenCombined = "pooled"
 )
 
+// IsValid reports whether c is one of the SpecimenCombined codes.
+func (c SpecimenCombined) IsValid() bool {
+	switch c {
+	case SpecimenCombinedGrouped,
+		SpecimenCombinedPooled:
+		return true
+	}
+	return false
+}
+
 // SpecimenContainedPreference represents codes from http://hl7.org/fhir/ValueSet/specimen-contained-preference
 type SpecimenContainedPreference string
 
@@ -487,6 +988,16 @@ const (
 	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
+// IsValid reports whether c is one of the SpecimenContainedPreference codes.
+func (c SpecimenContainedPreference) IsValid() bool {
+	switch c {
+	case SpecimenContainedPreferencePreferred,
+		SpecimenContainedPreferenceAlternate:
+		return true
+	}
+	return false
+}
+
 // SpecimenStatus represents codes from http://hl7.org/fhir/ValueSet/specimen-status
 type SpecimenStatus string
 
@@ -497,6 +1008,18 @@ const (
 	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the SpecimenStatus codes.
+func (c SpecimenStatus) IsValid() bool {
+	switch c {
+	case SpecimenStatusAvailable,
+		SpecimenStatusUnavailable,
+		SpecimenStatusUnsatisfactory,
+		SpecimenStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // StructureDefinitionKind represents codes from http://hl7.org/fhir/ValueSet/structure-definition-kind
 type StructureDefinitionKind string
 
@@ -507,6 +1030,18 @@ const (
 	StructureDefinitionKindLogical       StructureDefinitionKind = "logical"
 )
 
+// IsValid reports whether c is one of the StructureDefinitionKind codes.
+func (c StructureDefinitionKind) IsValid() bool {
+	switch c {
+	case StructureDefinitionKindPrimitiveType,
+		StructureDefinitionKindComplexType,
+		StructureDefinitionKindResource,
+		StructureDefinitionKindLogical:
+		return true
+	}
+	return false
+}
+
 // StructureMapGroupTypeMode represents codes from http://hl7.org/fhir/ValueSet/map-group-type-mode
 type StructureMapGroupTypeMode string
 
@@ -515,6 +1050,16 @@ const (
 	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
+// IsValid reports whether c is one of the StructureMapGroupTypeMode codes.
+func (c StructureMapGroupTypeMode) IsValid() bool {
+	switch c {
+	case StructureMapGroupTypeModeTypes,
+		StructureMapGroupTypeModeTypeAndTypes:
+		return true
+	}
+	return false
+}
+
 // StructureMapInputMode represents codes from http://hl7.org/fhir/ValueSet/map-input-mode
 type StructureMapInputMode string
 
@@ -523,6 +1068,16 @@ const (
 	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
+// IsValid reports whether c is one of the StructureMapInputMode codes.
+func (c StructureMapInputMode) IsValid() bool {
+	switch c {
+	case StructureMapInputModeSource,
+		StructureMapInputModeTarget:
+		return true
+	}
+	return false
+}
+
 // StructureMapModelMode represents codes from http://hl7.org/fhir/ValueSet/map-model-mode
 type StructureMapModelMode string
 
@@ -533,6 +1088,18 @@ const (
 	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
+// IsValid reports whether c is one of the StructureMapModelMode codes.
+func (c StructureMapModelMode) IsValid() bool {
+	switch c {
+	case StructureMapModelModeSource,
+		StructureMapModelModeQueried,
+		StructureMapModelModeTarget,
+		StructureMapModelModeProduced:
+		return true
+	}
+	return false
+}
+
 // StructureMapSourceListMode represents codes from http://hl7.org/fhir/ValueSet/map-source-list-mode
 type StructureMapSourceListMode string
 
@@ -544,6 +1111,19 @@ const (
 	StructureMapSourceListModeOnlyOne  StructureMapSourceListMode = "only_one"
 )
 
+// IsValid reports whether c is one of the StructureMapSourceListMode codes.
+func (c StructureMapSourceListMode) IsValid() bool {
+	switch c {
+	case StructureMapSourceListModeFirst,
+		StructureMapSourceListModeNotFirst,
+		StructureMapSourceListModeLast,
+		StructureMapSourceListModeNotLast,
+		StructureMapSourceListModeOnlyOne:
+		return true
+	}
+	return false
+}
+
 // StructureMapTargetListMode represents codes from http://hl7.org/fhir/ValueSet/map-target-list-mode
 type StructureMapTargetListMode string
 
@@ -554,6 +1134,18 @@ const (
 	StructureMapTargetListModeSingle StructureMapTargetListMode = "single"
 )
 
+// IsValid reports whether c is one of the StructureMapTargetListMode codes.
+func (c StructureMapTargetListMode) IsValid() bool {
+	switch c {
+	case StructureMapTargetListModeFirst,
+		StructureMapTargetListModeShare,
+		StructureMapTargetListModeLast,
+		StructureMapTargetListModeSingle:
+		return true
+	}
+	return false
+}
+
 // StructureMapTransform represents codes from http://hl7.org/fhir/ValueSet/map-transform
 type StructureMapTransform string
 
@@ -577,6 +1169,31 @@ const (
 	StructureMapTransformCp        StructureMapTransform = "cp"
 )
 
+// IsValid reports whether c is one of the StructureMapTransform codes.
+func (c StructureMapTransform) IsValid() bool {
+	switch c {
+	case StructureMapTransformCreate,
+		StructureMapTransformCopy,
+		StructureMapTransformTruncate,
+		StructureMapTransformEscape,
+		StructureMapTransformCast,
+		StructureMapTransformAppend,
+		StructureMapTransformTranslate,
+		StructureMapTransformReference,
+		StructureMapTransformDateOp,
+		StructureMapTransformUuid,
+		StructureMapTransformPointer,
+		StructureMapTransformEvaluate,
+		StructureMapTransformCc,
+		StructureMapTransformC,
+		StructureMapTransformQty,
+		StructureMapTransformId,
+		StructureMapTransformCp:
+		return true
+	}
+	return false
+}
+
 // SubmitDataUpdateType represents codes from http://hl7.org/fhir/ValueSet/submit-data-update-type
 type SubmitDataUpdateType string
 
@@ -585,6 +1202,16 @@ const (
 	SubmitDataUpdateTypeSnapshot    SubmitDataUpdateType = "snapshot"
 )
 
+// IsValid reports whether c is one of the SubmitDataUpdateType codes.
+func (c SubmitDataUpdateType) IsValid() bool {
+	switch c {
+	case SubmitDataUpdateTypeIncremental,
+		SubmitDataUpdateTypeSnapshot:
+		return true
+	}
+	return false
+}
+
 // SubscriptionNotificationType represents codes from http://hl7.org/fhir/ValueSet/subscription-notification-type
 type SubscriptionNotificationType string
 
@@ -596,6 +1223,19 @@ const (
 	SubscriptionNotificationTypeQueryEvent        SubscriptionNotificationType = "query-event"
 )
 
+// IsValid reports whether c is one of the SubscriptionNotificationType codes.
+func (c SubscriptionNotificationType) IsValid() bool {
+	switch c {
+	case SubscriptionNotificationTypeHandshake,
+		SubscriptionNotificationTypeHeartbeat,
+		SubscriptionNotificationTypeEventNotification,
+		SubscriptionNotificationTypeQueryStatus,
+		SubscriptionNotificationTypeQueryEvent:
+		return true
+	}
+	return false
+}
+
 // SubscriptionPayloadContent represents codes from http://hl7.org/fhir/ValueSet/subscription-payload-content
 type SubscriptionPayloadContent string
 
@@ -605,6 +1245,17 @@ const (
 	SubscriptionPayloadContentFullResource SubscriptionPayloadContent = "full-resource"
 )
 
+// IsValid reports whether c is one of the SubscriptionPayloadContent codes.
+func (c SubscriptionPayloadContent) IsValid() bool {
+	switch c {
+	case SubscriptionPayloadContentEmpty,
+		SubscriptionPayloadContentIdOnly,
+		SubscriptionPayloadContentFullResource:
+		return true
+	}
+	return false
+}
+
 // SubscriptionStatusCodes represents codes from http://hl7.org/fhir/ValueSet/subscription-status
 type SubscriptionStatusCodes string
 
@@ -616,6 +1267,19 @@ const (
 	SubscriptionStatusCodesEnteredInError SubscriptionStatusCodes = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the SubscriptionStatusCodes codes.
+func (c SubscriptionStatusCodes) IsValid() bool {
+	switch c {
+	case SubscriptionStatusCodesRequested,
+		SubscriptionStatusCodesActive,
+		SubscriptionStatusCodesError,
+		SubscriptionStatusCodesOff,
+		SubscriptionStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
 // SupplementedMimeTypes represents codes from http://hl7.org/fhir/ValueSet/supplemented-mimetypes
 type SupplementedMimeTypes string
 
@@ -625,6 +1289,17 @@ const (
 	SupplementedMimeTypesTtl  SupplementedMimeTypes = "ttl"
 )
 
+// IsValid reports whether c is one of the SupplementedMimeTypes codes.
+func (c SupplementedMimeTypes) IsValid() bool {
+	switch c {
+	case SupplementedMimeTypesXml,
+		SupplementedMimeTypesJson,
+		SupplementedMimeTypesTtl:
+		return true
+	}
+	return false
+}
+
 // SystemRestfulInteraction represents codes from http://hl7.org/fhir/ValueSet/system-restful-interaction
 type SystemRestfulInteraction string
 
@@ -656,6 +1331,39 @@ const (
 	SystemRestfulInteractionOperation                 SystemRestfulInteraction = "operation"
 )
 
+// IsValid reports whether c is one of the SystemRestfulInteraction codes.
+func (c SystemRestfulInteraction) IsValid() bool {
+	switch c {
+	case SystemRestfulInteractionRead,
+		SystemRestfulInteractionVread,
+		SystemRestfulInteractionUpdate,
+		SystemRestfulInteractionUpdateConditional,
+		SystemRestfulInteractionPatch,
+		SystemRestfulInteractionPatchConditional,
+		SystemRestfulInteractionDelete,
+		SystemRestfulInteractionDeleteConditionalSingle,
+		SystemRestfulInteractionDeleteConditionalMultiple,
+		SystemRestfulInteractionDeleteHistory,
+		SystemRestfulInteractionDeleteHistoryVersion,
+		SystemRestfulInteractionHistory,
+		SystemRestfulInteractionHistoryInstance,
+		SystemRestfulInteractionHistoryType,
+		SystemRestfulInteractionHistorySystem,
+		SystemRestfulInteractionCreate,
+		SystemRestfulInteractionCreateConditional,
+		SystemRestfulInteractionSearch,
+		SystemRestfulInteractionSearchType,
+		SystemRestfulInteractionSearchSystem,
+		SystemRestfulInteractionSearchCompartment,
+		SystemRestfulInteractionCapabilities,
+		SystemRestfulInteractionTransaction,
+		SystemRestfulInteractionBatch,
+		SystemRestfulInteractionOperation:
+		return true
+	}
+	return false
+}
+
 // TaskIntent represents codes from http://hl7.org/fhir/ValueSet/task-intent
 type TaskIntent string
 
@@ -674,6 +1382,26 @@ const (
 	TaskIntentOption        TaskIntent = "option"
 )
 
+// IsValid reports whether c is one of the TaskIntent codes.
+func (c TaskIntent) IsValid() bool {
+	switch c {
+	case TaskIntentUnknown,
+		TaskIntentProposal,
+		TaskIntentSolicitOffer,
+		TaskIntentOfferResponse,
+		TaskIntentPlan,
+		TaskIntentDirective,
+		TaskIntentOrder,
+		TaskIntentOriginalOrder,
+		TaskIntentReflexOrder,
+		TaskIntentFillerOrder,
+		TaskIntentInstanceOrder,
+		TaskIntentOption:
+		return true
+	}
+	return false
+}
+
 // TaskStatus represents codes from http://hl7.org/fhir/ValueSet/task-status
 type TaskStatus string
 
@@ -692,6 +1420,26 @@ const (
 	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
+// IsValid reports whether c is one of the TaskStatus codes.
+func (c TaskStatus) IsValid() bool {
+	switch c {
+	case TaskStatusDraft,
+		TaskStatusRequested,
+		TaskStatusReceived,
+		TaskStatusAccepted,
+		TaskStatusRejected,
+		TaskStatusReady,
+		TaskStatusCancelled,
+		TaskStatusInProgress,
+		TaskStatusOnHold,
+		TaskStatusFailed,
+		TaskStatusCompleted,
+		TaskStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
 // TriggerType represents codes from http://hl7.org/fhir/ValueSet/trigger-type
 type TriggerType string
 
@@ -707,6 +1455,23 @@ const (
 	TriggerTypeSubscriptionTopic TriggerType = "subscription-topic"
 )
 
+// IsValid reports whether c is one of the TriggerType codes.
+func (c TriggerType) IsValid() bool {
+	switch c {
+	case TriggerTypeNamedEvent,
+		TriggerTypePeriodic,
+		TriggerTypeDataChanged,
+		TriggerTypeDataAdded,
+		TriggerTypeDataModified,
+		TriggerTypeDataRemoved,
+		TriggerTypeDataAccessed,
+		TriggerTypeDataAccessEnded,
+		TriggerTypeSubscriptionTopic:
+		return true
+	}
+	return false
+}
+
 // TriggeredBytype represents codes from http://hl7.org/fhir/ValueSet/observation-triggeredbytype
 type TriggeredBytype string
 
@@ -716,6 +1481,17 @@ const (
 	TriggeredBytypeReRun  TriggeredBytype = "re-run"
 )
 
+// IsValid reports whether c is one of the TriggeredBytype codes.
+func (c TriggeredBytype) IsValid() bool {
+	switch c {
+	case TriggeredBytypeReflex,
+		TriggeredBytypeRepeat,
+		TriggeredBytypeReRun:
+		return true
+	}
+	return false
+}
+
 // TypeDerivationRule represents codes from http://hl7.org/fhir/ValueSet/type-derivation-rule
 type TypeDerivationRule string
 
@@ -724,6 +1500,16 @@ const (
 	TypeDerivationRuleConstraint     TypeDerivationRule = "constraint"
 )
 
+// IsValid reports whether c is one of the TypeDerivationRule codes.
+func (c TypeDerivationRule) IsValid() bool {
+	switch c {
+	case TypeDerivationRuleSpecialization,
+		TypeDerivationRuleConstraint:
+		return true
+	}
+	return false
+}
+
 // TypeRestfulInteraction represents codes from http://hl7.org/fhir/ValueSet/type-restful-interaction
 type TypeRestfulInteraction string
 
@@ -755,6 +1541,39 @@ const (
 	TypeRestfulInteractionOperation                 TypeRestfulInteraction = "operation"
 )
 
+// IsValid reports whether c is one of the TypeRestfulInteraction codes.
+func (c TypeRestfulInteraction) IsValid() bool {
+	switch c {
+	case TypeRestfulInteractionRead,
+		TypeRestfulInteractionVread,
+		TypeRestfulInteractionUpdate,
+		TypeRestfulInteractionUpdateConditional,
+		TypeRestfulInteractionPatch,
+		TypeRestfulInteractionPatchConditional,
+		TypeRestfulInteractionDelete,
+		TypeRestfulInteractionDeleteConditionalSingle,
+		TypeRestfulInteractionDeleteConditionalMultiple,
+		TypeRestfulInteractionDeleteHistory,
+		TypeRestfulInteractionDeleteHistoryVersion,
+		TypeRestfulInteractionHistory,
+		TypeRestfulInteractionHistoryInstance,
+		TypeRestfulInteractionHistoryType,
+		TypeRestfulInteractionHistorySystem,
+		TypeRestfulInteractionCreate,
+		TypeRestfulInteractionCreateConditional,
+		TypeRestfulInteractionSearch,
+		TypeRestfulInteractionSearchType,
+		TypeRestfulInteractionSearchSystem,
+		TypeRestfulInteractionSearchCompartment,
+		TypeRestfulInteractionCapabilities,
+		TypeRestfulInteractionTransaction,
+		TypeRestfulInteractionBatch,
+		TypeRestfulInteractionOperation:
+		return true
+	}
+	return false
+}
+
 // UDIEntryType represents codes from http://hl7.org/fhir/ValueSet/udi-entry-type
 type UDIEntryType string
 
@@ -768,6 +1587,21 @@ const (
 	UDIEntryTypeUnknown                UDIEntryType = "unknown"
 )
 
+// IsValid reports whether c is one of the UDIEntryType codes.
+func (c UDIEntryType) IsValid() bool {
+	switch c {
+	case UDIEntryTypeBarcode,
+		UDIEntryTypeRfid,
+		UDIEntryTypeManual,
+		UDIEntryTypeCard,
+		UDIEntryTypeSelfReported,
+		UDIEntryTypeElectronicTransmission,
+		UDIEntryTypeUnknown:
+		return true
+	}
+	return false
+}
+
 // UnitsOfTime represents codes from http://hl7.org/fhir/ValueSet/units-of-time
 type UnitsOfTime string
 
@@ -781,6 +1615,21 @@ const (
 	UnitsOfTimeA   UnitsOfTime = "a"
 )
 
+// IsValid reports whether c is one of the UnitsOfTime codes.
+func (c UnitsOfTime) IsValid() bool {
+	switch c {
+	case UnitsOfTimeS,
+		UnitsOfTimeMin,
+		UnitsOfTimeH,
+		UnitsOfTimeD,
+		UnitsOfTimeWk,
+		UnitsOfTimeMo,
+		UnitsOfTimeA:
+		return true
+	}
+	return false
+}
+
 // Use represents codes from http://hl7.org/fhir/ValueSet/claim-use
 type Use string
 
@@ -790,6 +1639,17 @@ const (
 	UsePredetermination Use = "predetermination"
 )
 
+// IsValid reports whether c is one of the Use codes.
+func (c Use) IsValid() bool {
+	switch c {
+	case UseClaim,
+		UsePreauthorization,
+		UsePredetermination:
+		return true
+	}
+	return false
+}
+
 // ValueFilterComparator represents codes from http://hl7.org/fhir/ValueSet/value-filter-comparator
 type ValueFilterComparator string
 
@@ -805,6 +1665,23 @@ const (
 	ValueFilterComparatorAp ValueFilterComparator = "ap"
 )
 
+// IsValid reports whether c is one of the ValueFilterComparator codes.
+func (c ValueFilterComparator) IsValid() bool {
+	switch c {
+	case ValueFilterComparatorEq,
+		ValueFilterComparatorNe,
+		ValueFilterComparatorGt,
+		ValueFilterComparatorLt,
+		ValueFilterComparatorGe,
+		ValueFilterComparatorLe,
+		ValueFilterComparatorSa,
+		ValueFilterComparatorEb,
+		ValueFilterComparatorAp:
+		return true
+	}
+	return false
+}
+
 // VersionIndependentResourceTypesAll represents codes from http://hl7.org/fhir/ValueSet/version-independent-all-resource-types
 type VersionIndependentResourceTypesAll string
 
@@ -852,6 +1729,55 @@ const (
 	VersionIndependentResourceTypesAllSubstanceSpecification            VersionIndependentResourceTypesAll = "SubstanceSpecification"
 )
 
+// IsValid reports whether c is one of the VersionIndependentResourceTypesAll codes.
+func (c VersionIndependentResourceTypesAll) IsValid() bool {
+	switch c {
+	case VersionIndependentResourceTypesAllBodySite,
+		VersionIndependentResourceTypesAllCatalogEntry,
+		VersionIndependentResourceTypesAllConformance,
+		VersionIndependentResourceTypesAllDataElement,
+		VersionIndependentResourceTypesAllDeviceComponent,
+		VersionIndependentResourceTypesAllDeviceUseRequest,
+		VersionIndependentResourceTypesAllDeviceUseStatement,
+		VersionIndependentResourceTypesAllDiagnosticOrder,
+		VersionIndependentResourceTypesAllDocumentManifest,
+		VersionIndependentResourceTypesAllEffectEvidenceSynthesis,
+		VersionIndependentResourceTypesAllEligibilityRequest,
+		VersionIndependentResourceTypesAllEligibilityResponse,
+		VersionIndependentResourceTypesAllExpansionProfile,
+		VersionIndependentResourceTypesAllImagingManifest,
+		VersionIndependentResourceTypesAllImagingObjectSelection,
+		VersionIndependentResourceTypesAllMedia,
+		VersionIndependentResourceTypesAllMedicationOrder,
+		VersionIndependentResourceTypesAllMedicationUsage,
+		VersionIndependentResourceTypesAllMedicinalProduct,
+		VersionIndependentResourceTypesAllMedicinalProductAuthorization,
+		VersionIndependentResourceTypesAllMedicinalProductContraindication,
+		VersionIndependentResourceTypesAllMedicinalProductIndication,
+		VersionIndependentResourceTypesAllMedicinalProductIngredient,
+		VersionIndependentResourceTypesAllMedicinalProductInteraction,
+		VersionIndependentResourceTypesAllMedicinalProductManufactured,
+		VersionIndependentResourceTypesAllMedicinalProductPackaged,
+		VersionIndependentResourceTypesAllMedicinalProductPharmaceutical,
+		VersionIndependentResourceTypesAllMedicinalProductUndesirableEffect,
+		VersionIndependentResourceTypesAllOrder,
+		VersionIndependentResourceTypesAllOrderResponse,
+		VersionIndependentResourceTypesAllProcedureRequest,
+		VersionIndependentResourceTypesAllProcessRequest,
+		VersionIndependentResourceTypesAllProcessResponse,
+		VersionIndependentResourceTypesAllReferralRequest,
+		VersionIndependentResourceTypesAllRequestGroup,
+		VersionIndependentResourceTypesAllResearchDefinition,
+		VersionIndependentResourceTypesAllResearchElementDefinition,
+		VersionIndependentResourceTypesAllRiskEvidenceSynthesis,
+		VersionIndependentResourceTypesAllSequence,
+		VersionIndependentResourceTypesAllServiceDefinition,
+		VersionIndependentResourceTypesAllSubstanceSpecification:
+		return true
+	}
+	return false
+}
+
 // VisionBase represents codes from http://hl7.org/fhir/ValueSet/vision-base-codes
 type VisionBase string
 
@@ -862,6 +1788,18 @@ const (
 	VisionBaseOut  VisionBase = "out"
 )
 
+// IsValid reports whether c is one of the VisionBase codes.
+func (c VisionBase) IsValid() bool {
+	switch c {
+	case VisionBaseUp,
+		VisionBaseDown,
+		VisionBaseIn,
+		VisionBaseOut:
+		return true
+	}
+	return false
+}
+
 // VisionEyes represents codes from http://hl7.org/fhir/ValueSet/vision-eye-codes
 type VisionEyes string
 
@@ -870,6 +1808,16 @@ const (
 	VisionEyesLeft  VisionEyes = "left"
 )
 
+// IsValid reports whether c is one of the VisionEyes codes.
+func (c VisionEyes) IsValid() bool {
+	switch c {
+	case VisionEyesRight,
+		VisionEyesLeft:
+		return true
+	}
+	return false
+}
+
 // WeekOfMonth represents codes from http://hl7.org/fhir/ValueSet/week-of-month
 type WeekOfMonth string
 
@@ -880,3 +1828,16 @@ const (
 	WeekOfMonthFourth WeekOfMonth = "fourth"
 	WeekOfMonthLast   WeekOfMonth = "last"
 )
+
+// IsValid reports whether c is one of the WeekOfMonth codes.
+func (c WeekOfMonth) IsValid() bool {
+	switch c {
+	case WeekOfMonthFirst,
+		WeekOfMonthSecond,
+		WeekOfMonthThird,
+		WeekOfMonthFourth,
+		WeekOfMonthLast:
+		return true
+	}
+	return false
+}
@@ -1,15 +1,132 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Coding Type: A reference to a code defined by a terminology system.
 type Coding struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	System       *string `json:"system,omitempty" bson:"system,omitempty"`              // Identity of the terminology system
-	Version      *string `json:"version,omitempty" bson:"version,omitempty"`            // Version of the system - if relevant
-	Code         *string `json:"code,omitempty" bson:"code,omitempty"`                  // Symbol in syntax defined by the system
-	Display      *string `json:"display,omitempty" bson:"display,omitempty"`            // Representation defined by the system
-	UserSelected *bool   `json:"userSelected,omitempty" bson:"user_selected,omitempty"` // If this coding was chosen directly by the user
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                               // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                 // Additional content defined by implementations
+	System              *string     `json:"system,omitempty" bson:"system,omitempty"`                       // Identity of the terminology system
+	SystemElement       *Element    `json:"_system,omitempty" bson:"system_element,omitempty"`              // Extensions for system
+	Version             *string     `json:"version,omitempty" bson:"version,omitempty"`                     // Version of the system - if relevant
+	VersionElement      *Element    `json:"_version,omitempty" bson:"version_element,omitempty"`            // Extensions for version
+	Code                *string     `json:"code,omitempty" bson:"code,omitempty"`                           // Symbol in syntax defined by the system
+	CodeElement         *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                  // Extensions for code
+	Display             *string     `json:"display,omitempty" bson:"display,omitempty"`                     // Representation defined by the system
+	DisplayElement      *Element    `json:"_display,omitempty" bson:"display_element,omitempty"`            // Extensions for display
+	UserSelected        *bool       `json:"userSelected,omitempty" bson:"user_selected,omitempty"`          // If this coding was chosen directly by the user
+	UserSelectedElement *Element    `json:"_userSelected,omitempty" bson:"user_selected_element,omitempty"` // Extensions for userSelected
 }
 
 func (r *Coding) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	if r.UserSelectedElement != nil {
+		if err := r.UserSelectedElement.Validate(); err != nil {
+			return fmt.Errorf("UserSelectedElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Coding) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	if r.UserSelectedElement != nil {
+		r.UserSelectedElement.validateAll(path+".userSelected", issues)
+	}
+}
+
+func (r *Coding) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Coding", &issues)
+	checkInvariants(r, "Coding", &issues)
+	return issues
+}
+
+var codingInvariants = []invariant{
+	{key: "cod-1", severity: "warning", human: "A Coding SHOULD NOT have a display unless a code is also present.  Computation on Coding.display alone is generally unsafe.  Consider using CodeableConcept.text", expression: "code.exists().not() implies display.exists().not()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Coding) invariants() []invariant {
+	return codingInvariants
+}
+
+func (r Coding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Coding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Coding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Coding) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var codingElementTypes = map[string]string{
+	"system": "uri",
+	"code":   "code",
+}
+
+func (r *Coding) elementTypes() map[string]string {
+	return codingElementTypes
+}
+
+var codingElementMetadata = []ElementMetadata{
+	{Path: "Coding.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Coding.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Coding.system", Name: "system", Field: 2, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "Coding.version", Name: "version", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Coding.code", Name: "code", Field: 6, Min: 0, Max: "1", Types: []string{"code"}, Summary: true},
+	{Path: "Coding.display", Name: "display", Field: 8, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Coding.userSelected", Name: "userSelected", Field: 10, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+}
+
+func (r *Coding) ElementMetadata() []ElementMetadata {
+	return codingElementMetadata
+}
@@ -1,9 +1,63 @@
 package models
 
-// The collect-data operation is used to collect the data-of-interest for the given measure. Note that the use of the [X-Provenance header data](provenance.html#header) with data that establishes provenance being submitted/collected **SHOULD** be supported.  This provides the capability for associating the provider with the data submitted through the $collect-data transaction. If the X-Provenance header is used it should be consistent with the 'reporter' element in the DEQM Data Exchange MeasureReport Profile.
-type CollectData struct {
+// CollectDataIn holds the input parameters of the $CollectData operation. The collect-data operation is used to collect the data-of-interest for the given measure. Note that the use of the [X-Provenance header data](provenance.html#header) with data that establishes provenance being submitted/collected **SHOULD** be supported.  This provides the capability for associating the provider with the data submitted through the $collect-data transaction. If the X-Provenance header is used it should be consistent with the 'reporter' element in the DEQM Data Exchange MeasureReport Profile.
+type CollectDataIn struct {
 }
 
-func (r *CollectData) Validate() error {
+func (p *CollectDataIn) Validate() error {
+	return nil
+}
+
+func (p *CollectDataIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *CollectDataIn) FromParameters(params *Parameters) error {
+	*p = CollectDataIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *CollectDataIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *CollectDataIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// CollectDataOut holds the output parameters of the $CollectData operation.
+type CollectDataOut struct {
+}
+
+func (p *CollectDataOut) Validate() error {
+	return nil
+}
+
+func (p *CollectDataOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *CollectDataOut) FromParameters(params *Parameters) error {
+	*p = CollectDataOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *CollectDataOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *CollectDataOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
@@ -2,40 +2,61 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A clinical or business level record of information being transmitted or shared; e.g. an alert that was sent to a responsible provider, a public health agency communication to a provider/reporter in response to a case report for a reportable condition.
 type Communication struct {
-	ResourceType  string                 `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage      `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Unique identifier
-	BasedOn       []Reference            `json:"basedOn,omitempty" bson:"based_on,omitempty"`             // Request fulfilled by this communication
-	PartOf        []Reference            `json:"partOf,omitempty" bson:"part_of,omitempty"`               // Part of referenced event (e.g. Communication, Procedure)
-	InResponseTo  []Reference            `json:"inResponseTo,omitempty" bson:"in_response_to,omitempty"`  // Reply to
-	Status        string                 `json:"status" bson:"status"`                                    // preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
-	StatusReason  *CodeableConcept       `json:"statusReason,omitempty" bson:"status_reason,omitempty"`   // Reason for current status
-	Category      []CodeableConcept      `json:"category,omitempty" bson:"category,omitempty"`            // Message category
-	Priority      *string                `json:"priority,omitempty" bson:"priority,omitempty"`            // routine | urgent | asap | stat
-	Medium        []CodeableConcept      `json:"medium,omitempty" bson:"medium,omitempty"`                // A channel of communication
-	Subject       *Reference             `json:"subject,omitempty" bson:"subject,omitempty"`              // Focus of message
-	Topic         *CodeableConcept       `json:"topic,omitempty" bson:"topic,omitempty"`                  // Description of the purpose/content
-	About         []Reference            `json:"about,omitempty" bson:"about,omitempty"`                  // Resources that pertain to this communication
-	Encounter     *Reference             `json:"encounter,omitempty" bson:"encounter,omitempty"`          // The Encounter during which this Communication was created
-	Sent          *string                `json:"sent,omitempty" bson:"sent,omitempty"`                    // When sent
-	Received      *string                `json:"received,omitempty" bson:"received,omitempty"`            // When received
-	Recipient     []Reference            `json:"recipient,omitempty" bson:"recipient,omitempty"`          // Who the information is shared with
-	Sender        *Reference             `json:"sender,omitempty" bson:"sender,omitempty"`                // Who shares the information
-	Reason        []CodeableReference    `json:"reason,omitempty" bson:"reason,omitempty"`                // Indication for message
-	Payload       []CommunicationPayload `json:"payload,omitempty" bson:"payload,omitempty"`              // Message payload
-	Note          []Annotation           `json:"note,omitempty" bson:"note,omitempty"`                    // Comments made about the communication
+	ResourceType         string                 `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element               `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource             `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Unique identifier
+	BasedOn              []Reference            `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Request fulfilled by this communication
+	PartOf               []Reference            `json:"partOf,omitempty" bson:"part_of,omitempty"`                        // Part of referenced event (e.g. Communication, Procedure)
+	InResponseTo         []Reference            `json:"inResponseTo,omitempty" bson:"in_response_to,omitempty"`           // Reply to
+	Status               AdverseEventStatus     `json:"status" bson:"status"`                                             // preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
+	StatusElement        *Element               `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	StatusReason         *CodeableConcept       `json:"statusReason,omitempty" bson:"status_reason,omitempty"`            // Reason for current status
+	Category             []CodeableConcept      `json:"category,omitempty" bson:"category,omitempty"`                     // Message category
+	Priority             *RequestPriority       `json:"priority,omitempty" bson:"priority,omitempty"`                     // routine | urgent | asap | stat
+	PriorityElement      *Element               `json:"_priority,omitempty" bson:"priority_element,omitempty"`            // Extensions for priority
+	Medium               []CodeableConcept      `json:"medium,omitempty" bson:"medium,omitempty"`                         // A channel of communication
+	Subject              *Reference             `json:"subject,omitempty" bson:"subject,omitempty"`                       // Focus of message
+	Topic                *CodeableConcept       `json:"topic,omitempty" bson:"topic,omitempty"`                           // Description of the purpose/content
+	About                []Reference            `json:"about,omitempty" bson:"about,omitempty"`                           // Resources that pertain to this communication
+	Encounter            *Reference             `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // The Encounter during which this Communication was created
+	Sent                 *DateTime              `json:"sent,omitempty" bson:"sent,omitempty"`                             // When sent
+	SentElement          *Element               `json:"_sent,omitempty" bson:"sent_element,omitempty"`                    // Extensions for sent
+	Received             *DateTime              `json:"received,omitempty" bson:"received,omitempty"`                     // When received
+	ReceivedElement      *Element               `json:"_received,omitempty" bson:"received_element,omitempty"`            // Extensions for received
+	Recipient            []Reference            `json:"recipient,omitempty" bson:"recipient,omitempty"`                   // Who the information is shared with
+	Sender               *Reference             `json:"sender,omitempty" bson:"sender,omitempty"`                         // Who shares the information
+	Reason               []CodeableReference    `json:"reason,omitempty" bson:"reason,omitempty"`                         // Indication for message
+	Payload              []CommunicationPayload `json:"payload,omitempty" bson:"payload,omitempty"`                       // Message payload
+	Note                 []Annotation           `json:"note,omitempty" bson:"note,omitempty"`                             // Comments made about the communication
 }
 
+var communicationBasedOnTargets = []string{"CarePlan", "CommunicationRequest", "DeviceRequest", "MedicationRequest", "NutritionOrder", "ServiceRequest", "Task", "VisionPrescription"}
+
+var communicationInResponseToTargets = []string{"Communication"}
+
+var communicationSubjectTargets = []string{"Group", "Patient"}
+
+var communicationEncounterTargets = []string{"Encounter"}
+
+var communicationRecipientTargets = []string{"CareTeam", "Device", "Endpoint", "Group", "HealthcareService", "Location", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var communicationSenderTargets = []string{"CareTeam", "Device", "Endpoint", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Communication) Validate() error {
 	if r.ResourceType != "Communication" {
 		return fmt.Errorf("invalid resourceType: expected 'Communication', got '%s'", r.ResourceType)
@@ -45,16 +66,49 @@ func (r *Communication) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, communicationBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
@@ -65,15 +119,27 @@ func (r *Communication) Validate() error {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.InResponseTo {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationInResponseToTargets); target != "" {
+			return fmt.Errorf("field 'InResponseTo[%d]' cannot refer to %s, expected one of %v", i, target, communicationInResponseToTargets)
+		}
+	}
 	for i, item := range r.InResponseTo {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("InResponseTo[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.StatusReason != nil {
 		if err := r.StatusReason.Validate(); err != nil {
 			return fmt.Errorf("StatusReason: %w", err)
@@ -84,11 +150,24 @@ func (r *Communication) Validate() error {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
 	for i, item := range r.Medium {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Medium[%d]: %w", i, err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, communicationSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, communicationSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -104,16 +183,51 @@ func (r *Communication) Validate() error {
 			return fmt.Errorf("About[%d]: %w", i, err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, communicationEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, communicationEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.Sent != nil {
+		if err := r.Sent.Validate(); err != nil {
+			return fmt.Errorf("Sent: %w", err)
+		}
+	}
+	if r.SentElement != nil {
+		if err := r.SentElement.Validate(); err != nil {
+			return fmt.Errorf("SentElement: %w", err)
+		}
+	}
+	if r.Received != nil {
+		if err := r.Received.Validate(); err != nil {
+			return fmt.Errorf("Received: %w", err)
+		}
+	}
+	if r.ReceivedElement != nil {
+		if err := r.ReceivedElement.Validate(); err != nil {
+			return fmt.Errorf("ReceivedElement: %w", err)
+		}
+	}
+	for i, item := range r.Recipient {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRecipientTargets); target != "" {
+			return fmt.Errorf("field 'Recipient[%d]' cannot refer to %s, expected one of %v", i, target, communicationRecipientTargets)
+		}
+	}
 	for i, item := range r.Recipient {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Recipient[%d]: %w", i, err)
 		}
 	}
+	if r.Sender != nil {
+		if target := invalidReferenceTarget(r.Sender.Reference, r.Sender.Type, communicationSenderTargets); target != "" {
+			return fmt.Errorf("field 'Sender' cannot refer to %s, expected one of %v", target, communicationSenderTargets)
+		}
+	}
 	if r.Sender != nil {
 		if err := r.Sender.Validate(); err != nil {
 			return fmt.Errorf("Sender: %w", err)
@@ -134,40 +248,485 @@ func (r *Communication) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Communication")
+}
+
+func (r *Communication) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Communication" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Communication', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, communicationBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	for i, item := range r.InResponseTo {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationInResponseToTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.inResponseTo[%d]", path, i), fmt.Sprintf("field 'InResponseTo[%d]' cannot refer to %s, expected one of %v", i, target, communicationInResponseToTargets))
+		}
+	}
+	for i, item := range r.InResponseTo {
+		item.validateAll(fmt.Sprintf("%s.inResponseTo[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReason != nil {
+		r.StatusReason.validateAll(path+".statusReason", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	for i, item := range r.Medium {
+		item.validateAll(fmt.Sprintf("%s.medium[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, communicationSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, communicationSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Topic != nil {
+		r.Topic.validateAll(path+".topic", issues)
+	}
+	for i, item := range r.About {
+		item.validateAll(fmt.Sprintf("%s.about[%d]", path, i), issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, communicationEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, communicationEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Sent != nil {
+		if err := r.Sent.Validate(); err != nil {
+			issues.add("value", path+".sent", err.Error())
+		}
+	}
+	if r.SentElement != nil {
+		r.SentElement.validateAll(path+".sent", issues)
+	}
+	if r.Received != nil {
+		if err := r.Received.Validate(); err != nil {
+			issues.add("value", path+".received", err.Error())
+		}
+	}
+	if r.ReceivedElement != nil {
+		r.ReceivedElement.validateAll(path+".received", issues)
+	}
+	for i, item := range r.Recipient {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRecipientTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.recipient[%d]", path, i), fmt.Sprintf("field 'Recipient[%d]' cannot refer to %s, expected one of %v", i, target, communicationRecipientTargets))
+		}
+	}
+	for i, item := range r.Recipient {
+		item.validateAll(fmt.Sprintf("%s.recipient[%d]", path, i), issues)
+	}
+	if r.Sender != nil {
+		if target := invalidReferenceTarget(r.Sender.Reference, r.Sender.Type, communicationSenderTargets); target != "" {
+			issues.add("value", path+".sender", fmt.Sprintf("field 'Sender' cannot refer to %s, expected one of %v", target, communicationSenderTargets))
+		}
+	}
+	if r.Sender != nil {
+		r.Sender.validateAll(path+".sender", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Payload {
+		item.validateAll(fmt.Sprintf("%s.payload[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *Communication) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Communication", &issues)
+	checkInvariants(r, "Communication", &issues)
+	return issues
+}
+
+var communicationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Communication) invariants() []invariant {
+	return communicationInvariants
+}
+
+func (r *Communication) UnmarshalJSON(data []byte) error {
+	type alias Communication
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Communication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Communication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Communication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Communication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var communicationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Communication) elementTypes() map[string]string {
+	return communicationElementTypes
+}
+
+var communicationElementMetadata = []ElementMetadata{
+	{Path: "Communication.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Communication.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Communication.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Communication.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Communication.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Communication.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Communication.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Communication.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Communication.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Communication.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Communication.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Communication.partOf", Name: "partOf", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Communication.inResponseTo", Name: "inResponseTo", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Communication"}},
+	{Path: "Communication.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Communication.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-status"}},
+	{Path: "Communication.statusReason", Name: "statusReason", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Communication.category", Name: "category", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Communication.priority", Name: "priority", Field: 19, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Communication.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "Communication.medium", Name: "medium", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Communication.subject", Name: "subject", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Communication.topic", Name: "topic", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Communication.about", Name: "about", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Communication.encounter", Name: "encounter", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Communication.sent", Name: "sent", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Communication.received", Name: "received", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Communication.recipient", Name: "recipient", Field: 30, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Communication.sender", Name: "sender", Field: 31, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Communication.reason", Name: "reason", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Communication.payload", Name: "payload", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Communication.note", Name: "note", Field: 34, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *Communication) ElementMetadata() []ElementMetadata {
+	return communicationElementMetadata
+}
+
+func (r *Communication) GetResourceType() string {
+	return "Communication"
+}
+
+func (r *Communication) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Communication) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Communication) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Communication) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Communication) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Communication) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Communication) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Communication)(nil)
+
 type CommunicationPayload struct {
-	Id                     *string          `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	ContentAttachment      *Attachment      `json:"contentAttachment" bson:"content_attachment"`            // Message part content
-	ContentReference       *Reference       `json:"contentReference" bson:"content_reference"`              // Message part content
-	ContentCodeableConcept *CodeableConcept `json:"contentCodeableConcept" bson:"content_codeable_concept"` // Message part content
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Content           CommunicationPayloadContent `json:"-" bson:"content,omitempty"`                                      // Message part content
+
+	contentVariants []string // JSON properties of Communication.payload.content[x] when more than one was decoded
 }
 
 func (r *CommunicationPayload) Validate() error {
-	if r.ContentAttachment == nil {
-		return fmt.Errorf("field 'ContentAttachment' is required")
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.ContentAttachment != nil {
-		if err := r.ContentAttachment.Validate(); err != nil {
-			return fmt.Errorf("ContentAttachment: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ContentReference == nil {
-		return fmt.Errorf("field 'ContentReference' is required")
+	if len(r.contentVariants) > 1 {
+		return fmt.Errorf("field 'Content' must have a single type, got %v", r.contentVariants)
+	}
+	if r.Content == nil {
+		return fmt.Errorf("field 'Content' is required")
 	}
-	if r.ContentReference != nil {
-		if err := r.ContentReference.Validate(); err != nil {
-			return fmt.Errorf("ContentReference: %w", err)
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
 		}
 	}
-	if r.ContentCodeableConcept == nil {
-		return fmt.Errorf("field 'ContentCodeableConcept' is required")
+	return nil
+}
+
+func (r *CommunicationPayload) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ContentCodeableConcept != nil {
-		if err := r.ContentCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ContentCodeableConcept: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.contentVariants) > 1 {
+		issues.add("structure", path+".content", fmt.Sprintf("field 'Content' must have a single type, got %v", r.contentVariants))
+	}
+	if r.Content == nil {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content.ofType("+r.Content.FHIRType()+")", issues)
+	}
+}
+
+var communicationPayloadInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CommunicationPayload) invariants() []invariant {
+	return communicationPayloadInvariants
+}
+
+func (r CommunicationPayload) MarshalJSON() ([]byte, error) {
+	type alias CommunicationPayload
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "content", r.Content, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CommunicationPayload) UnmarshalJSON(data []byte) error {
+	type alias CommunicationPayload
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Content, _, r.contentVariants, err = unmarshalChoice(fields, "content", communicationPayloadContentVariants); err != nil {
+		return err
 	}
 	return nil
 }
+
+func (r CommunicationPayload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CommunicationPayload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CommunicationPayload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CommunicationPayload) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CommunicationPayload) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Content":
+		return "content", choiceValues(communicationPayloadContentVariants)
+	}
+	return "", nil
+}
+
+var communicationPayloadElementMetadata = []ElementMetadata{
+	{Path: "Communication.payload.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Communication.payload.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Communication.payload.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Communication.payload.content[x]", Name: "content", Field: 3, Min: 1, Max: "1", Types: []string{"Attachment", "Reference", "CodeableConcept"}},
+}
+
+func (r *CommunicationPayload) ElementMetadata() []ElementMetadata {
+	return communicationPayloadElementMetadata
+}
+
+// CommunicationPayloadContent is the type of Communication.payload.content[x]. It is implemented by the CommunicationPayloadContent* variant types.
+type CommunicationPayloadContent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCommunicationPayloadContent()
+}
+
+// CommunicationPayloadContentAttachment is the Attachment variant of CommunicationPayloadContent.
+type CommunicationPayloadContentAttachment struct {
+	Attachment
+}
+
+func (v CommunicationPayloadContentAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v CommunicationPayloadContentAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v CommunicationPayloadContentAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v CommunicationPayloadContentAttachment) isCommunicationPayloadContent() {}
+
+// CommunicationPayloadContentReference is the Reference variant of CommunicationPayloadContent.
+type CommunicationPayloadContentReference struct {
+	Reference
+}
+
+func (v CommunicationPayloadContentReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v CommunicationPayloadContentReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v CommunicationPayloadContentReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v CommunicationPayloadContentReference) isCommunicationPayloadContent() {}
+
+// CommunicationPayloadContentCodeableConcept is the CodeableConcept variant of CommunicationPayloadContent.
+type CommunicationPayloadContentCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v CommunicationPayloadContentCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v CommunicationPayloadContentCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v CommunicationPayloadContentCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v CommunicationPayloadContentCodeableConcept) isCommunicationPayloadContent() {}
+
+var communicationPayloadContentVariants = []CommunicationPayloadContent{
+	CommunicationPayloadContentAttachment{},
+	CommunicationPayloadContentReference{},
+	CommunicationPayloadContentCodeableConcept{},
+}
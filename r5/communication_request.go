@@ -2,43 +2,67 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A request to convey information from a sender to a recipient.
 type CommunicationRequest struct {
-	ResourceType        string                        `json:"resourceType" bson:"resource_type"`                                   // Type of resource
-	Id                  *string                       `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
-	Meta                *Meta                         `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
-	ImplicitRules       *string                       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
-	Language            *string                       `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
-	Text                *Narrative                    `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
-	Contained           []json.RawMessage             `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
-	Identifier          []Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Unique identifier
-	BasedOn             []Reference                   `json:"basedOn,omitempty" bson:"based_on,omitempty"`                         // Fulfills plan or proposal
-	Replaces            []Reference                   `json:"replaces,omitempty" bson:"replaces,omitempty"`                        // Request(s) replaced by this request
-	GroupIdentifier     *Identifier                   `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`         // Composite request this is part of
-	Status              string                        `json:"status" bson:"status"`                                                // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
-	StatusReason        *CodeableConcept              `json:"statusReason,omitempty" bson:"status_reason,omitempty"`               // Reason for current status
-	Intent              string                        `json:"intent" bson:"intent"`                                                // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Category            []CodeableConcept             `json:"category,omitempty" bson:"category,omitempty"`                        // Message category
-	Priority            *string                       `json:"priority,omitempty" bson:"priority,omitempty"`                        // routine | urgent | asap | stat
-	DoNotPerform        *bool                         `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`              // True if request is prohibiting action
-	Medium              []CodeableConcept             `json:"medium,omitempty" bson:"medium,omitempty"`                            // A channel of communication
-	Subject             *Reference                    `json:"subject,omitempty" bson:"subject,omitempty"`                          // Focus of message
-	About               []Reference                   `json:"about,omitempty" bson:"about,omitempty"`                              // Resources that pertain to this communication request
-	Encounter           *Reference                    `json:"encounter,omitempty" bson:"encounter,omitempty"`                      // The Encounter during which this CommunicationRequest was created
-	Payload             []CommunicationRequestPayload `json:"payload,omitempty" bson:"payload,omitempty"`                          // Message payload
-	OccurrenceDateTime  *string                       `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"`  // When scheduled
-	OccurrencePeriod    *Period                       `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`       // When scheduled
-	AuthoredOn          *string                       `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                   // When request transitioned to being actionable
-	Requester           *Reference                    `json:"requester,omitempty" bson:"requester,omitempty"`                      // Who asks for the information to be shared
-	Recipient           []Reference                   `json:"recipient,omitempty" bson:"recipient,omitempty"`                      // Who to share the information with
-	InformationProvider []Reference                   `json:"informationProvider,omitempty" bson:"information_provider,omitempty"` // Who should share the information
-	Reason              []CodeableReference           `json:"reason,omitempty" bson:"reason,omitempty"`                            // Why is communication needed?
-	Note                []Annotation                  `json:"note,omitempty" bson:"note,omitempty"`                                // Comments made about communication request
+	ResourceType         string                         `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                   *string                        `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                 *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules        *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language             *string                        `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement      *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                 *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained            []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension            []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension    []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Identifier           []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Unique identifier
+	BasedOn              []Reference                    `json:"basedOn,omitempty" bson:"based_on,omitempty"`                         // Fulfills plan or proposal
+	Replaces             []Reference                    `json:"replaces,omitempty" bson:"replaces,omitempty"`                        // Request(s) replaced by this request
+	GroupIdentifier      *Identifier                    `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`         // Composite request this is part of
+	Status               RequestStatus                  `json:"status" bson:"status"`                                                // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
+	StatusElement        *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	StatusReason         *CodeableConcept               `json:"statusReason,omitempty" bson:"status_reason,omitempty"`               // Reason for current status
+	Intent               CarePlanIntent                 `json:"intent" bson:"intent"`                                                // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement        *Element                       `json:"_intent,omitempty" bson:"intent_element,omitempty"`                   // Extensions for intent
+	Category             []CodeableConcept              `json:"category,omitempty" bson:"category,omitempty"`                        // Message category
+	Priority             *RequestPriority               `json:"priority,omitempty" bson:"priority,omitempty"`                        // routine | urgent | asap | stat
+	PriorityElement      *Element                       `json:"_priority,omitempty" bson:"priority_element,omitempty"`               // Extensions for priority
+	DoNotPerform         *bool                          `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`              // True if request is prohibiting action
+	DoNotPerformElement  *Element                       `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`     // Extensions for doNotPerform
+	Medium               []CodeableConcept              `json:"medium,omitempty" bson:"medium,omitempty"`                            // A channel of communication
+	Subject              *Reference                     `json:"subject,omitempty" bson:"subject,omitempty"`                          // Focus of message
+	About                []Reference                    `json:"about,omitempty" bson:"about,omitempty"`                              // Resources that pertain to this communication request
+	Encounter            *Reference                     `json:"encounter,omitempty" bson:"encounter,omitempty"`                      // The Encounter during which this CommunicationRequest was created
+	Payload              []CommunicationRequestPayload  `json:"payload,omitempty" bson:"payload,omitempty"`                          // Message payload
+	Occurrence           CommunicationRequestOccurrence `json:"-" bson:"occurrence,omitempty"`                                       // When scheduled
+	OccurrenceElement    *Element                       `json:"-" bson:"occurrence_element,omitempty"`                               // Extensions for occurrence[x]
+	AuthoredOn           *DateTime                      `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                   // When request transitioned to being actionable
+	AuthoredOnElement    *Element                       `json:"_authoredOn,omitempty" bson:"authored_on_element,omitempty"`          // Extensions for authoredOn
+	Requester            *Reference                     `json:"requester,omitempty" bson:"requester,omitempty"`                      // Who asks for the information to be shared
+	Recipient            []Reference                    `json:"recipient,omitempty" bson:"recipient,omitempty"`                      // Who to share the information with
+	InformationProvider  []Reference                    `json:"informationProvider,omitempty" bson:"information_provider,omitempty"` // Who should share the information
+	Reason               []CodeableReference            `json:"reason,omitempty" bson:"reason,omitempty"`                            // Why is communication needed?
+	Note                 []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                                // Comments made about communication request
+
+	occurrenceVariants []string // JSON properties of CommunicationRequest.occurrence[x] when more than one was decoded
 }
 
+var communicationRequestReplacesTargets = []string{"CommunicationRequest"}
+
+var communicationRequestSubjectTargets = []string{"Group", "Patient"}
+
+var communicationRequestEncounterTargets = []string{"Encounter"}
+
+var communicationRequestRequesterTargets = []string{"Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var communicationRequestRecipientTargets = []string{"CareTeam", "Device", "Endpoint", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var communicationRequestInformationProviderTargets = []string{"Device", "Endpoint", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *CommunicationRequest) Validate() error {
 	if r.ResourceType != "CommunicationRequest" {
 		return fmt.Errorf("invalid resourceType: expected 'CommunicationRequest', got '%s'", r.ResourceType)
@@ -48,11 +72,39 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -63,6 +115,11 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRequestReplacesTargets); target != "" {
+			return fmt.Errorf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, communicationRequestReplacesTargets)
+		}
+	}
 	for i, item := range r.Replaces {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Replaces[%d]: %w", i, err)
@@ -73,28 +130,61 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.StatusReason != nil {
 		if err := r.StatusReason.Validate(); err != nil {
 			return fmt.Errorf("StatusReason: %w", err)
 		}
 	}
-	if r.Intent == emptyString {
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
+		}
+	}
 	for i, item := range r.Medium {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Medium[%d]: %w", i, err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, communicationRequestSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, communicationRequestSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -105,6 +195,11 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("About[%d]: %w", i, err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, communicationRequestEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, communicationRequestEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -115,9 +210,32 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("Payload[%d]: %w", i, err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
+	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOn: %w", err)
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		if err := r.AuthoredOnElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOnElement: %w", err)
+		}
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, communicationRequestRequesterTargets); target != "" {
+			return fmt.Errorf("field 'Requester' cannot refer to %s, expected one of %v", target, communicationRequestRequesterTargets)
 		}
 	}
 	if r.Requester != nil {
@@ -125,11 +243,21 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("Requester: %w", err)
 		}
 	}
+	for i, item := range r.Recipient {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRequestRecipientTargets); target != "" {
+			return fmt.Errorf("field 'Recipient[%d]' cannot refer to %s, expected one of %v", i, target, communicationRequestRecipientTargets)
+		}
+	}
 	for i, item := range r.Recipient {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Recipient[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.InformationProvider {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRequestInformationProviderTargets); target != "" {
+			return fmt.Errorf("field 'InformationProvider[%d]' cannot refer to %s, expected one of %v", i, target, communicationRequestInformationProviderTargets)
+		}
+	}
 	for i, item := range r.InformationProvider {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("InformationProvider[%d]: %w", i, err)
@@ -145,40 +273,591 @@ func (r *CommunicationRequest) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "CommunicationRequest")
+}
+
+func (r *CommunicationRequest) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CommunicationRequest" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CommunicationRequest', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRequestReplacesTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.replaces[%d]", path, i), fmt.Sprintf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, communicationRequestReplacesTargets))
+		}
+	}
+	for i, item := range r.Replaces {
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReason != nil {
+		r.StatusReason.validateAll(path+".statusReason", issues)
+	}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
+	}
+	for i, item := range r.Medium {
+		item.validateAll(fmt.Sprintf("%s.medium[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, communicationRequestSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, communicationRequestSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.About {
+		item.validateAll(fmt.Sprintf("%s.about[%d]", path, i), issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, communicationRequestEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, communicationRequestEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	for i, item := range r.Payload {
+		item.validateAll(fmt.Sprintf("%s.payload[%d]", path, i), issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			issues.add("value", path+".authoredOn", err.Error())
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		r.AuthoredOnElement.validateAll(path+".authoredOn", issues)
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, communicationRequestRequesterTargets); target != "" {
+			issues.add("value", path+".requester", fmt.Sprintf("field 'Requester' cannot refer to %s, expected one of %v", target, communicationRequestRequesterTargets))
+		}
+	}
+	if r.Requester != nil {
+		r.Requester.validateAll(path+".requester", issues)
+	}
+	for i, item := range r.Recipient {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRequestRecipientTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.recipient[%d]", path, i), fmt.Sprintf("field 'Recipient[%d]' cannot refer to %s, expected one of %v", i, target, communicationRequestRecipientTargets))
+		}
+	}
+	for i, item := range r.Recipient {
+		item.validateAll(fmt.Sprintf("%s.recipient[%d]", path, i), issues)
+	}
+	for i, item := range r.InformationProvider {
+		if target := invalidReferenceTarget(item.Reference, item.Type, communicationRequestInformationProviderTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.informationProvider[%d]", path, i), fmt.Sprintf("field 'InformationProvider[%d]' cannot refer to %s, expected one of %v", i, target, communicationRequestInformationProviderTargets))
+		}
+	}
+	for i, item := range r.InformationProvider {
+		item.validateAll(fmt.Sprintf("%s.informationProvider[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *CommunicationRequest) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CommunicationRequest", &issues)
+	checkInvariants(r, "CommunicationRequest", &issues)
+	return issues
+}
+
+var communicationRequestInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *CommunicationRequest) invariants() []invariant {
+	return communicationRequestInvariants
+}
+
+func (r CommunicationRequest) MarshalJSON() ([]byte, error) {
+	type alias CommunicationRequest
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CommunicationRequest) UnmarshalJSON(data []byte) error {
+	type alias CommunicationRequest
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", communicationRequestOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r CommunicationRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CommunicationRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CommunicationRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CommunicationRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CommunicationRequest) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(communicationRequestOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var communicationRequestElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *CommunicationRequest) elementTypes() map[string]string {
+	return communicationRequestElementTypes
+}
+
+var communicationRequestElementMetadata = []ElementMetadata{
+	{Path: "CommunicationRequest.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CommunicationRequest.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CommunicationRequest.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CommunicationRequest.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CommunicationRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CommunicationRequest.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CommunicationRequest.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CommunicationRequest.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CommunicationRequest.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CommunicationRequest.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CommunicationRequest.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "CommunicationRequest.replaces", Name: "replaces", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CommunicationRequest"}},
+	{Path: "CommunicationRequest.groupIdentifier", Name: "groupIdentifier", Field: 14, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "CommunicationRequest.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CommunicationRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"}},
+	{Path: "CommunicationRequest.statusReason", Name: "statusReason", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CommunicationRequest.intent", Name: "intent", Field: 18, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CommunicationRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"}},
+	{Path: "CommunicationRequest.category", Name: "category", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CommunicationRequest.priority", Name: "priority", Field: 21, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CommunicationRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "CommunicationRequest.doNotPerform", Name: "doNotPerform", Field: 23, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CommunicationRequest.medium", Name: "medium", Field: 25, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CommunicationRequest.subject", Name: "subject", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "CommunicationRequest.about", Name: "about", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "CommunicationRequest.encounter", Name: "encounter", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "CommunicationRequest.payload", Name: "payload", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CommunicationRequest.occurrence[x]", Name: "occurrence", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "CommunicationRequest.authoredOn", Name: "authoredOn", Field: 32, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CommunicationRequest.requester", Name: "requester", Field: 34, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "CommunicationRequest.recipient", Name: "recipient", Field: 35, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "CommunicationRequest.informationProvider", Name: "informationProvider", Field: 36, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "CommunicationRequest.reason", Name: "reason", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "CommunicationRequest.note", Name: "note", Field: 38, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *CommunicationRequest) ElementMetadata() []ElementMetadata {
+	return communicationRequestElementMetadata
+}
+
+// CommunicationRequestOccurrence is the type of CommunicationRequest.occurrence[x]. It is implemented by the CommunicationRequestOccurrence* variant types.
+type CommunicationRequestOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCommunicationRequestOccurrence()
+}
+
+// CommunicationRequestOccurrenceDateTime is the dateTime variant of CommunicationRequestOccurrence.
+type CommunicationRequestOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v CommunicationRequestOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v CommunicationRequestOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CommunicationRequestOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v CommunicationRequestOccurrenceDateTime) isCommunicationRequestOccurrence() {}
+
+// CommunicationRequestOccurrencePeriod is the Period variant of CommunicationRequestOccurrence.
+type CommunicationRequestOccurrencePeriod struct {
+	Period
+}
+
+func (v CommunicationRequestOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v CommunicationRequestOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v CommunicationRequestOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v CommunicationRequestOccurrencePeriod) isCommunicationRequestOccurrence() {}
+
+var communicationRequestOccurrenceVariants = []CommunicationRequestOccurrence{
+	CommunicationRequestOccurrenceDateTime{},
+	CommunicationRequestOccurrencePeriod{},
+}
+
+func (r *CommunicationRequest) GetResourceType() string {
+	return "CommunicationRequest"
+}
+
+func (r *CommunicationRequest) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CommunicationRequest) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CommunicationRequest) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CommunicationRequest) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CommunicationRequest) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CommunicationRequest) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CommunicationRequest) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CommunicationRequest)(nil)
+
 type CommunicationRequestPayload struct {
-	Id                     *string          `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	ContentAttachment      *Attachment      `json:"contentAttachment" bson:"content_attachment"`            // Message part content
-	ContentReference       *Reference       `json:"contentReference" bson:"content_reference"`              // Message part content
-	ContentCodeableConcept *CodeableConcept `json:"contentCodeableConcept" bson:"content_codeable_concept"` // Message part content
+	Id                *string                            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Content           CommunicationRequestPayloadContent `json:"-" bson:"content,omitempty"`                                      // Message part content
+
+	contentVariants []string // JSON properties of CommunicationRequest.payload.content[x] when more than one was decoded
 }
 
 func (r *CommunicationRequestPayload) Validate() error {
-	if r.ContentAttachment == nil {
-		return fmt.Errorf("field 'ContentAttachment' is required")
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.ContentAttachment != nil {
-		if err := r.ContentAttachment.Validate(); err != nil {
-			return fmt.Errorf("ContentAttachment: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ContentReference == nil {
-		return fmt.Errorf("field 'ContentReference' is required")
+	if len(r.contentVariants) > 1 {
+		return fmt.Errorf("field 'Content' must have a single type, got %v", r.contentVariants)
+	}
+	if r.Content == nil {
+		return fmt.Errorf("field 'Content' is required")
 	}
-	if r.ContentReference != nil {
-		if err := r.ContentReference.Validate(); err != nil {
-			return fmt.Errorf("ContentReference: %w", err)
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
 		}
 	}
-	if r.ContentCodeableConcept == nil {
-		return fmt.Errorf("field 'ContentCodeableConcept' is required")
+	return nil
+}
+
+func (r *CommunicationRequestPayload) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ContentCodeableConcept != nil {
-		if err := r.ContentCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ContentCodeableConcept: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.contentVariants) > 1 {
+		issues.add("structure", path+".content", fmt.Sprintf("field 'Content' must have a single type, got %v", r.contentVariants))
+	}
+	if r.Content == nil {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content.ofType("+r.Content.FHIRType()+")", issues)
+	}
+}
+
+var communicationRequestPayloadInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CommunicationRequestPayload) invariants() []invariant {
+	return communicationRequestPayloadInvariants
+}
+
+func (r CommunicationRequestPayload) MarshalJSON() ([]byte, error) {
+	type alias CommunicationRequestPayload
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "content", r.Content, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CommunicationRequestPayload) UnmarshalJSON(data []byte) error {
+	type alias CommunicationRequestPayload
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Content, _, r.contentVariants, err = unmarshalChoice(fields, "content", communicationRequestPayloadContentVariants); err != nil {
+		return err
 	}
 	return nil
 }
+
+func (r CommunicationRequestPayload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CommunicationRequestPayload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CommunicationRequestPayload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CommunicationRequestPayload) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CommunicationRequestPayload) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Content":
+		return "content", choiceValues(communicationRequestPayloadContentVariants)
+	}
+	return "", nil
+}
+
+var communicationRequestPayloadElementMetadata = []ElementMetadata{
+	{Path: "CommunicationRequest.payload.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CommunicationRequest.payload.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CommunicationRequest.payload.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CommunicationRequest.payload.content[x]", Name: "content", Field: 3, Min: 1, Max: "1", Types: []string{"Attachment", "Reference", "CodeableConcept"}},
+}
+
+func (r *CommunicationRequestPayload) ElementMetadata() []ElementMetadata {
+	return communicationRequestPayloadElementMetadata
+}
+
+// CommunicationRequestPayloadContent is the type of CommunicationRequest.payload.content[x]. It is implemented by the CommunicationRequestPayloadContent* variant types.
+type CommunicationRequestPayloadContent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCommunicationRequestPayloadContent()
+}
+
+// CommunicationRequestPayloadContentAttachment is the Attachment variant of CommunicationRequestPayloadContent.
+type CommunicationRequestPayloadContentAttachment struct {
+	Attachment
+}
+
+func (v CommunicationRequestPayloadContentAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v CommunicationRequestPayloadContentAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v CommunicationRequestPayloadContentAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v CommunicationRequestPayloadContentAttachment) isCommunicationRequestPayloadContent() {}
+
+// CommunicationRequestPayloadContentReference is the Reference variant of CommunicationRequestPayloadContent.
+type CommunicationRequestPayloadContentReference struct {
+	Reference
+}
+
+func (v CommunicationRequestPayloadContentReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v CommunicationRequestPayloadContentReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v CommunicationRequestPayloadContentReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v CommunicationRequestPayloadContentReference) isCommunicationRequestPayloadContent() {}
+
+// CommunicationRequestPayloadContentCodeableConcept is the CodeableConcept variant of CommunicationRequestPayloadContent.
+type CommunicationRequestPayloadContentCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v CommunicationRequestPayloadContentCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v CommunicationRequestPayloadContentCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v CommunicationRequestPayloadContentCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v CommunicationRequestPayloadContentCodeableConcept) isCommunicationRequestPayloadContent() {}
+
+var communicationRequestPayloadContentVariants = []CommunicationRequestPayloadContent{
+	CommunicationRequestPayloadContentAttachment{},
+	CommunicationRequestPayloadContentReference{},
+	CommunicationRequestPayloadContentCodeableConcept{},
+}
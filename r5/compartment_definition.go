@@ -2,35 +2,54 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A compartment definition that defines how resources are accessed on a server.
 type CompartmentDefinition struct {
-	ResourceType           string                          `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                         `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                         `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage               `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    string                          `json:"url" bson:"url"`                                                             // Canonical identifier for this compartment definition, represented as a URI (globally unique)
-	Version                *string                         `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the compartment definition
-	VersionAlgorithmString *string                         `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                         `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                          `json:"name" bson:"name"`                                                           // Name for this compartment definition (computer friendly)
-	Title                  *string                         `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this compartment definition (human friendly)
-	Status                 string                          `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                         `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                         `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the compartment definition
-	UseContext             []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Purpose                *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this compartment definition is defined
-	Code                   string                          `json:"code" bson:"code"`                                                           // Patient | Encounter | RelatedPerson | Practitioner | Device | EpisodeOfCare
-	Search                 bool                            `json:"search" bson:"search"`                                                       // Whether the search syntax is supported
-	Resource               []CompartmentDefinitionResource `json:"resource,omitempty" bson:"resource,omitempty"`                               // How a resource is related to the compartment
+	ResourceType            string                                `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                      *string                               `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                    *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules           *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language                *string                               `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement         *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                    *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained               []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension               []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension       []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Url                     string                                `json:"url" bson:"url"`                                                   // Canonical identifier for this compartment definition, represented as a URI (globally unique)
+	UrlElement              *Element                              `json:"_url,omitempty" bson:"url_element,omitempty"`                      // Extensions for url
+	Version                 *string                               `json:"version,omitempty" bson:"version,omitempty"`                       // Business version of the compartment definition
+	VersionElement          *Element                              `json:"_version,omitempty" bson:"version_element,omitempty"`              // Extensions for version
+	VersionAlgorithm        CompartmentDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                             // How to compare versions
+	VersionAlgorithmElement *Element                              `json:"-" bson:"version_algorithm_element,omitempty"`                     // Extensions for versionAlgorithm[x]
+	Name                    string                                `json:"name" bson:"name"`                                                 // Name for this compartment definition (computer friendly)
+	NameElement             *Element                              `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Title                   *string                               `json:"title,omitempty" bson:"title,omitempty"`                           // Name for this compartment definition (human friendly)
+	TitleElement            *Element                              `json:"_title,omitempty" bson:"title_element,omitempty"`                  // Extensions for title
+	Status                  PublicationStatus                     `json:"status" bson:"status"`                                             // draft | active | retired | unknown
+	StatusElement           *Element                              `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Experimental            *bool                                 `json:"experimental,omitempty" bson:"experimental,omitempty"`             // For testing only - never for real usage
+	ExperimentalElement     *Element                              `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`    // Extensions for experimental
+	Date                    *DateTime                             `json:"date,omitempty" bson:"date,omitempty"`                             // Date last changed
+	DateElement             *Element                              `json:"_date,omitempty" bson:"date_element,omitempty"`                    // Extensions for date
+	Publisher               *string                               `json:"publisher,omitempty" bson:"publisher,omitempty"`                   // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                              `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`          // Extensions for publisher
+	Contact                 []ContactDetail                       `json:"contact,omitempty" bson:"contact,omitempty"`                       // Contact details for the publisher
+	Description             *string                               `json:"description,omitempty" bson:"description,omitempty"`               // Natural language description of the compartment definition
+	DescriptionElement      *Element                              `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	UseContext              []UsageContext                        `json:"useContext,omitempty" bson:"use_context,omitempty"`                // The context that the content is intended to support
+	Purpose                 *string                               `json:"purpose,omitempty" bson:"purpose,omitempty"`                       // Why this compartment definition is defined
+	PurposeElement          *Element                              `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`              // Extensions for purpose
+	Code                    CompartmentType                       `json:"code" bson:"code"`                                                 // Patient | Encounter | RelatedPerson | Practitioner | Device | EpisodeOfCare
+	CodeElement             *Element                              `json:"_code,omitempty" bson:"code_element,omitempty"`                    // Extensions for code
+	Search                  bool                                  `json:"search" bson:"search"`                                             // Whether the search syntax is supported
+	SearchElement           *Element                              `json:"_search,omitempty" bson:"search_element,omitempty"`                // Extensions for search
+	Resource                []CompartmentDefinitionResource       `json:"resource,omitempty" bson:"resource,omitempty"`                     // How a resource is related to the compartment
+
+	versionAlgorithmVariants []string // JSON properties of CompartmentDefinition.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *CompartmentDefinition) Validate() error {
@@ -42,59 +61,642 @@ func (r *CompartmentDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
 		}
 	}
-	if r.Code == emptyString {
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.Code == "" {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if !r.Code.IsValid() {
+		return fmt.Errorf("field 'Code' has invalid code '%s'", r.Code)
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.SearchElement != nil {
+		if err := r.SearchElement.Validate(); err != nil {
+			return fmt.Errorf("SearchElement: %w", err)
+		}
+	}
 	for i, item := range r.Resource {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Resource[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "CompartmentDefinition")
+}
+
+func (r *CompartmentDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CompartmentDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CompartmentDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.Code == "" {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != "" && !r.Code.IsValid() {
+		issues.add("code-invalid", path+".code", fmt.Sprintf("field 'Code' has invalid code '%s'", r.Code))
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.SearchElement != nil {
+		r.SearchElement.validateAll(path+".search", issues)
+	}
+	for i, item := range r.Resource {
+		item.validateAll(fmt.Sprintf("%s.resource[%d]", path, i), issues)
+	}
+}
+
+func (r *CompartmentDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CompartmentDefinition", &issues)
+	checkInvariants(r, "CompartmentDefinition", &issues)
+	return issues
+}
+
+var compartmentDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *CompartmentDefinition) invariants() []invariant {
+	return compartmentDefinitionInvariants
+}
+
+func (r CompartmentDefinition) MarshalJSON() ([]byte, error) {
+	type alias CompartmentDefinition
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CompartmentDefinition) UnmarshalJSON(data []byte) error {
+	type alias CompartmentDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", compartmentDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r CompartmentDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompartmentDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompartmentDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompartmentDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CompartmentDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(compartmentDefinitionVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var compartmentDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+}
+
+func (r *CompartmentDefinition) elementTypes() map[string]string {
+	return compartmentDefinitionElementTypes
+}
+
+var compartmentDefinitionElementMetadata = []ElementMetadata{
+	{Path: "CompartmentDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CompartmentDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CompartmentDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CompartmentDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CompartmentDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CompartmentDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CompartmentDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CompartmentDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CompartmentDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CompartmentDefinition.url", Name: "url", Field: 11, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "CompartmentDefinition.version", Name: "version", Field: 13, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CompartmentDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 15, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "CompartmentDefinition.name", Name: "name", Field: 17, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "CompartmentDefinition.title", Name: "title", Field: 19, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CompartmentDefinition.status", Name: "status", Field: 21, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CompartmentDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "CompartmentDefinition.experimental", Name: "experimental", Field: 23, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CompartmentDefinition.date", Name: "date", Field: 25, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CompartmentDefinition.publisher", Name: "publisher", Field: 27, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CompartmentDefinition.contact", Name: "contact", Field: 29, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "CompartmentDefinition.description", Name: "description", Field: 30, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CompartmentDefinition.useContext", Name: "useContext", Field: 32, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "CompartmentDefinition.purpose", Name: "purpose", Field: 33, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CompartmentDefinition.code", Name: "code", Field: 35, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CompartmentDefinition.code", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/compartment-type"}},
+	{Path: "CompartmentDefinition.search", Name: "search", Field: 37, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "CompartmentDefinition.resource", Name: "resource", Field: 39, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CompartmentDefinition) ElementMetadata() []ElementMetadata {
+	return compartmentDefinitionElementMetadata
+}
+
+// CompartmentDefinitionVersionAlgorithm is the type of CompartmentDefinition.versionAlgorithm[x]. It is implemented by the CompartmentDefinitionVersionAlgorithm* variant types.
+type CompartmentDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCompartmentDefinitionVersionAlgorithm()
+}
+
+// CompartmentDefinitionVersionAlgorithmString is the string variant of CompartmentDefinitionVersionAlgorithm.
+type CompartmentDefinitionVersionAlgorithmString string
+
+func (v CompartmentDefinitionVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v CompartmentDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v CompartmentDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v CompartmentDefinitionVersionAlgorithmString) isCompartmentDefinitionVersionAlgorithm() {}
+
+// CompartmentDefinitionVersionAlgorithmCoding is the Coding variant of CompartmentDefinitionVersionAlgorithm.
+type CompartmentDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v CompartmentDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v CompartmentDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v CompartmentDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v CompartmentDefinitionVersionAlgorithmCoding) isCompartmentDefinitionVersionAlgorithm() {}
+
+var compartmentDefinitionVersionAlgorithmVariants = []CompartmentDefinitionVersionAlgorithm{
+	CompartmentDefinitionVersionAlgorithmString(""),
+	CompartmentDefinitionVersionAlgorithmCoding{},
+}
+
+func (r *CompartmentDefinition) GetResourceType() string {
+	return "CompartmentDefinition"
+}
+
+func (r *CompartmentDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CompartmentDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CompartmentDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CompartmentDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CompartmentDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CompartmentDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CompartmentDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CompartmentDefinition)(nil)
+
 type CompartmentDefinitionResource struct {
-	Id         *string  `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
-	Code       string   `json:"code" bson:"code"`                                  // Name of resource type
-	Param      []string `json:"param,omitempty" bson:"param,omitempty"`            // Search Parameter Name, or chained parameters
-	StartParam *string  `json:"startParam,omitempty" bson:"start_param,omitempty"` // Search Param for interpreting $everything.start
-	EndParam   *string  `json:"endParam,omitempty" bson:"end_param,omitempty"`     // Search Param for interpreting $everything.end
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string      `json:"code" bson:"code"`                                                // Name of resource type
+	CodeElement       *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Param             []string    `json:"param,omitempty" bson:"param,omitempty"`                          // Search Parameter Name, or chained parameters
+	ParamElement      []*Element  `json:"_param,omitempty" bson:"param_element,omitempty"`                 // Extensions for param
+	StartParam        *string     `json:"startParam,omitempty" bson:"start_param,omitempty"`               // Search Param for interpreting $everything.start
+	StartParamElement *Element    `json:"_startParam,omitempty" bson:"start_param_element,omitempty"`      // Extensions for startParam
+	EndParam          *string     `json:"endParam,omitempty" bson:"end_param,omitempty"`                   // Search Param for interpreting $everything.end
+	EndParamElement   *Element    `json:"_endParam,omitempty" bson:"end_param_element,omitempty"`          // Extensions for endParam
 }
 
 func (r *CompartmentDefinitionResource) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Code == emptyString {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	for i, item := range r.ParamElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ParamElement[%d]: %w", i, err)
+		}
+	}
+	if r.StartParamElement != nil {
+		if err := r.StartParamElement.Validate(); err != nil {
+			return fmt.Errorf("StartParamElement: %w", err)
+		}
+	}
+	if r.EndParamElement != nil {
+		if err := r.EndParamElement.Validate(); err != nil {
+			return fmt.Errorf("EndParamElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CompartmentDefinitionResource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	for i, item := range r.ParamElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.param[%d]", path, i), issues)
+	}
+	if r.StartParamElement != nil {
+		r.StartParamElement.validateAll(path+".startParam", issues)
+	}
+	if r.EndParamElement != nil {
+		r.EndParamElement.validateAll(path+".endParam", issues)
+	}
+}
+
+var compartmentDefinitionResourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CompartmentDefinitionResource) invariants() []invariant {
+	return compartmentDefinitionResourceInvariants
+}
+
+func (r CompartmentDefinitionResource) MarshalJSON() ([]byte, error) {
+	type alias CompartmentDefinitionResource
+	out := struct {
+		alias
+		Param        []*string  `json:"param,omitempty"`
+		ParamElement []*Element `json:"_param,omitempty"`
+	}{alias: alias(r)}
+	out.Param, out.ParamElement = alignPrimitiveArray(r.Param, r.ParamElement)
+	return json.Marshal(out)
+}
+
+func (r *CompartmentDefinitionResource) UnmarshalJSON(data []byte) error {
+	type alias CompartmentDefinitionResource
+	aux := struct {
+		*alias
+		Param []*string `json:"param,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Param, err = splitPrimitiveArray(aux.Param, r.ParamElement); err != nil {
+		return fmt.Errorf("param: %w", err)
+	}
 	return nil
 }
+
+func (r CompartmentDefinitionResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompartmentDefinitionResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompartmentDefinitionResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompartmentDefinitionResource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var compartmentDefinitionResourceElementTypes = map[string]string{
+	"code":       "code",
+	"startParam": "uri",
+	"endParam":   "uri",
+}
+
+func (r *CompartmentDefinitionResource) elementTypes() map[string]string {
+	return compartmentDefinitionResourceElementTypes
+}
+
+var compartmentDefinitionResourceElementMetadata = []ElementMetadata{
+	{Path: "CompartmentDefinition.resource.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CompartmentDefinition.resource.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CompartmentDefinition.resource.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CompartmentDefinition.resource.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "CompartmentDefinition.resource.param", Name: "param", Field: 5, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "CompartmentDefinition.resource.startParam", Name: "startParam", Field: 7, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "CompartmentDefinition.resource.endParam", Name: "endParam", Field: 9, Min: 0, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *CompartmentDefinitionResource) ElementMetadata() []ElementMetadata {
+	return compartmentDefinitionResourceElementMetadata
+}
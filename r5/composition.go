@@ -2,42 +2,63 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A set of healthcare-related information that is assembled together into a single logical package that provides a single coherent statement of meaning, establishes its own context and has traceability to the author who is making the statement. A Composition defines the structure and narrative content necessary for a document. However, a Composition alone does not constitute a document. Rather, the Composition must be the first entry in a Bundle where Bundle.type=document, and any other resources referenced from Composition must be included as subsequent entries in the Bundle (for example Patient, Practitioner, Encounter, etc.).
 type Composition struct {
-	ResourceType  string                   `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                  `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                  `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage        `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Url           *string                  `json:"url,omitempty" bson:"url,omitempty"`                      // Canonical identifier for this Composition, represented as a URI (globally unique)
-	Identifier    []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Version-independent identifier for the Composition
-	Version       *string                  `json:"version,omitempty" bson:"version,omitempty"`              // An explicitly assigned identifier of a variation of the content in the Composition
-	Consent       []Reference              `json:"consent,omitempty" bson:"consent,omitempty"`              // Represents informed consents and medico-legal transactions
-	BasedOn       []Reference              `json:"basedOn,omitempty" bson:"based_on,omitempty"`             // Fulfills plan, proposal or order
-	Status        string                   `json:"status" bson:"status"`                                    // registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | deprecated | unknown
-	Type          *CodeableConcept         `json:"type" bson:"type"`                                        // Kind of composition (LOINC if possible)
-	Category      []CodeableConcept        `json:"category,omitempty" bson:"category,omitempty"`            // Categorization of Composition
-	Subject       []Reference              `json:"subject,omitempty" bson:"subject,omitempty"`              // Who and/or what the composition is about
-	Encounter     *Reference               `json:"encounter,omitempty" bson:"encounter,omitempty"`          // Context of the Composition
-	Date          string                   `json:"date" bson:"date"`                                        // Composition editing time
-	UseContext    []UsageContext           `json:"useContext,omitempty" bson:"use_context,omitempty"`       // The context that the content is intended to support
-	Author        []Reference              `json:"author,omitempty" bson:"author,omitempty"`                // Who and/or what authored the composition
-	Participant   []CompositionParticipant `json:"participant,omitempty" bson:"participant,omitempty"`      // Identifies supporting entities, including parents, relatives, caregivers, insurance policyholders, guarantors, and others related in some way to the patient
-	Name          *string                  `json:"name,omitempty" bson:"name,omitempty"`                    // Name for this Composition (computer friendly)
-	Title         *string                  `json:"title,omitempty" bson:"title,omitempty"`                  // Human Readable name/title
-	Note          []Annotation             `json:"note,omitempty" bson:"note,omitempty"`                    // For any additional notes
-	Attester      []CompositionAttester    `json:"attester,omitempty" bson:"attester,omitempty"`            // Attests to accuracy of composition
-	Custodian     *Reference               `json:"custodian,omitempty" bson:"custodian,omitempty"`          // Organization which maintains the composition
-	RelatesTo     []CompositionRelatesTo   `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`         // Relationships to other compositions/documents
-	Event         []CompositionEvent       `json:"event,omitempty" bson:"event,omitempty"`                  // The clinical service(s) being documented
-	Section       []CompositionSection     `json:"section,omitempty" bson:"section,omitempty"`              // Composition is broken into sections
+	ResourceType         string                   `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                  `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                 `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                  `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                 `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource               `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Url                  *string                  `json:"url,omitempty" bson:"url,omitempty"`                               // Canonical identifier for this Composition, represented as a URI (globally unique)
+	UrlElement           *Element                 `json:"_url,omitempty" bson:"url_element,omitempty"`                      // Extensions for url
+	Identifier           []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Version-independent identifier for the Composition
+	Version              *string                  `json:"version,omitempty" bson:"version,omitempty"`                       // An explicitly assigned identifier of a variation of the content in the Composition
+	VersionElement       *Element                 `json:"_version,omitempty" bson:"version_element,omitempty"`              // Extensions for version
+	Consent              []Reference              `json:"consent,omitempty" bson:"consent,omitempty"`                       // Represents informed consents and medico-legal transactions
+	BasedOn              []Reference              `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Fulfills plan, proposal or order
+	Status               CompositionStatus        `json:"status" bson:"status"`                                             // registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | deprecated | unknown
+	StatusElement        *Element                 `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Type                 *CodeableConcept         `json:"type" bson:"type"`                                                 // Kind of composition (LOINC if possible)
+	Category             []CodeableConcept        `json:"category,omitempty" bson:"category,omitempty"`                     // Categorization of Composition
+	Subject              []Reference              `json:"subject,omitempty" bson:"subject,omitempty"`                       // Who and/or what the composition is about
+	Encounter            *Reference               `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Context of the Composition
+	Date                 *DateTime                `json:"date" bson:"date"`                                                 // Composition editing time
+	DateElement          *Element                 `json:"_date,omitempty" bson:"date_element,omitempty"`                    // Extensions for date
+	UseContext           []UsageContext           `json:"useContext,omitempty" bson:"use_context,omitempty"`                // The context that the content is intended to support
+	Author               []Reference              `json:"author,omitempty" bson:"author,omitempty"`                         // Who and/or what authored the composition
+	Participant          []CompositionParticipant `json:"participant,omitempty" bson:"participant,omitempty"`               // Identifies supporting entities, including parents, relatives, caregivers, insurance policyholders, guarantors, and others related in some way to the patient
+	Name                 *string                  `json:"name,omitempty" bson:"name,omitempty"`                             // Name for this Composition (computer friendly)
+	NameElement          *Element                 `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Title                *string                  `json:"title,omitempty" bson:"title,omitempty"`                           // Human Readable name/title
+	TitleElement         *Element                 `json:"_title,omitempty" bson:"title_element,omitempty"`                  // Extensions for title
+	Note                 []Annotation             `json:"note,omitempty" bson:"note,omitempty"`                             // For any additional notes
+	Attester             []CompositionAttester    `json:"attester,omitempty" bson:"attester,omitempty"`                     // Attests to accuracy of composition
+	Custodian            *Reference               `json:"custodian,omitempty" bson:"custodian,omitempty"`                   // Organization which maintains the composition
+	RelatesTo            []CompositionRelatesTo   `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                  // Relationships to other compositions/documents
+	Event                []CompositionEvent       `json:"event,omitempty" bson:"event,omitempty"`                           // The clinical service(s) being documented
+	Section              []CompositionSection     `json:"section,omitempty" bson:"section,omitempty"`                       // Composition is broken into sections
 }
 
+var compositionConsentTargets = []string{"Consent"}
+
+var compositionBasedOnTargets = []string{"Appointment", "AppointmentResponse", "CarePlan", "Claim", "CommunicationRequest", "CoverageEligibilityRequest", "DeviceRequest", "EnrollmentRequest", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest", "Task", "VisionPrescription"}
+
+var compositionEncounterTargets = []string{"Encounter"}
+
+var compositionAuthorTargets = []string{"Practitioner", "PractitionerRole", "CareTeam", "Device", "Patient", "RelatedPerson", "Organization"}
+
+var compositionCustodianTargets = []string{"Organization", "Patient", "Group"}
+
 func (r *Composition) Validate() error {
 	if r.ResourceType != "Composition" {
 		return fmt.Errorf("invalid resourceType: expected 'Composition', got '%s'", r.ResourceType)
@@ -47,30 +68,85 @@ func (r *Composition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	for i, item := range r.Consent {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionConsentTargets); target != "" {
+			return fmt.Errorf("field 'Consent[%d]' cannot refer to %s, expected one of %v", i, target, compositionConsentTargets)
+		}
+	}
 	for i, item := range r.Consent {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Consent[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, compositionBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -89,19 +165,39 @@ func (r *Composition) Validate() error {
 			return fmt.Errorf("Subject[%d]: %w", i, err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, compositionEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, compositionEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.Date == emptyString {
+	if r.Date == nil {
 		return fmt.Errorf("field 'Date' is required")
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Author {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author[%d]' cannot refer to %s, expected one of %v", i, target, compositionAuthorTargets)
+		}
+	}
 	for i, item := range r.Author {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Author[%d]: %w", i, err)
@@ -112,6 +208,16 @@ func (r *Composition) Validate() error {
 			return fmt.Errorf("Participant[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -122,6 +228,11 @@ func (r *Composition) Validate() error {
 			return fmt.Errorf("Attester[%d]: %w", i, err)
 		}
 	}
+	if r.Custodian != nil {
+		if target := invalidReferenceTarget(r.Custodian.Reference, r.Custodian.Type, compositionCustodianTargets); target != "" {
+			return fmt.Errorf("field 'Custodian' cannot refer to %s, expected one of %v", target, compositionCustodianTargets)
+		}
+	}
 	if r.Custodian != nil {
 		if err := r.Custodian.Validate(); err != nil {
 			return fmt.Errorf("Custodian: %w", err)
@@ -142,148 +253,314 @@ func (r *Composition) Validate() error {
 			return fmt.Errorf("Section[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type CompositionRelatesTo struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type" bson:"type"`                          // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
-	TargetUri        *string          `json:"targetUri" bson:"target_uri"`               // The artifact that is related to this Composition
-	TargetAttachment *Attachment      `json:"targetAttachment" bson:"target_attachment"` // The artifact that is related to this Composition
-	TargetCanonical  *string          `json:"targetCanonical" bson:"target_canonical"`   // The artifact that is related to this Composition
-	TargetReference  *Reference       `json:"targetReference" bson:"target_reference"`   // The artifact that is related to this Composition
-	TargetMarkdown   *string          `json:"targetMarkdown" bson:"target_markdown"`     // The artifact that is related to this Composition
+	return validateInvariants(r, "Composition")
 }
 
-func (r *CompositionRelatesTo) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *Composition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Composition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Composition', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.TargetUri == nil {
-		return fmt.Errorf("field 'TargetUri' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.TargetAttachment == nil {
-		return fmt.Errorf("field 'TargetAttachment' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.TargetAttachment != nil {
-		if err := r.TargetAttachment.Validate(); err != nil {
-			return fmt.Errorf("TargetAttachment: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.TargetCanonical == nil {
-		return fmt.Errorf("field 'TargetCanonical' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.TargetReference == nil {
-		return fmt.Errorf("field 'TargetReference' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.TargetReference != nil {
-		if err := r.TargetReference.Validate(); err != nil {
-			return fmt.Errorf("TargetReference: %w", err)
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	for i, item := range r.Consent {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionConsentTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.consent[%d]", path, i), fmt.Sprintf("field 'Consent[%d]' cannot refer to %s, expected one of %v", i, target, compositionConsentTargets))
 		}
 	}
-	if r.TargetMarkdown == nil {
-		return fmt.Errorf("field 'TargetMarkdown' is required")
+	for i, item := range r.Consent {
+		item.validateAll(fmt.Sprintf("%s.consent[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type CompositionEvent struct {
-	Id     *string             `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Period *Period             `json:"period,omitempty" bson:"period,omitempty"` // The period covered by the documentation
-	Detail []CodeableReference `json:"detail,omitempty" bson:"detail,omitempty"` // The event(s) being documented, as code(s), reference(s), or both
-}
-
-func (r *CompositionEvent) Validate() error {
-	if r.Period != nil {
-		if err := r.Period.Validate(); err != nil {
-			return fmt.Errorf("Period: %w", err)
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, compositionBasedOnTargets))
 		}
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, compositionEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, compositionEncounterTargets))
 		}
 	}
-	return nil
-}
-
-type CompositionSection struct {
-	Id          *string              `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Title       *string              `json:"title,omitempty" bson:"title,omitempty"`              // Label for section (e.g. for ToC)
-	Code        *CodeableConcept     `json:"code,omitempty" bson:"code,omitempty"`                // Classification of section (recommended)
-	Author      []Reference          `json:"author,omitempty" bson:"author,omitempty"`            // Who and/or what authored the section, when the section is authored by someone other than the composition.author
-	Focus       *Reference           `json:"focus,omitempty" bson:"focus,omitempty"`              // Who/what the section is about, when it is not about the subject of composition
-	Text        *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                // Text summary of the section, for human interpretation
-	Note        []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                // Information about the section contents that is not represented by any of the section entries
-	OrderedBy   *CodeableConcept     `json:"orderedBy,omitempty" bson:"ordered_by,omitempty"`     // Order of section entries
-	Entry       []Reference          `json:"entry,omitempty" bson:"entry,omitempty"`              // A reference to data that supports this section
-	EmptyReason *CodeableConcept     `json:"emptyReason,omitempty" bson:"empty_reason,omitempty"` // Why the section is empty
-	Section     []CompositionSection `json:"section,omitempty" bson:"section,omitempty"`          // Nested Section
-}
-
-func (r *CompositionSection) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
 	for i, item := range r.Author {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Author[%d]: %w", i, err)
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionAuthorTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.author[%d]", path, i), fmt.Sprintf("field 'Author[%d]' cannot refer to %s, expected one of %v", i, target, compositionAuthorTargets))
 		}
 	}
-	if r.Focus != nil {
-		if err := r.Focus.Validate(); err != nil {
-			return fmt.Errorf("Focus: %w", err)
-		}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
 	}
-	if r.Text != nil {
-		if err := r.Text.Validate(); err != nil {
-			return fmt.Errorf("Text: %w", err)
-		}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
 	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
 	}
-	if r.OrderedBy != nil {
-		if err := r.OrderedBy.Validate(); err != nil {
-			return fmt.Errorf("OrderedBy: %w", err)
-		}
+	for i, item := range r.Attester {
+		item.validateAll(fmt.Sprintf("%s.attester[%d]", path, i), issues)
 	}
-	for i, item := range r.Entry {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Entry[%d]: %w", i, err)
+	if r.Custodian != nil {
+		if target := invalidReferenceTarget(r.Custodian.Reference, r.Custodian.Type, compositionCustodianTargets); target != "" {
+			issues.add("value", path+".custodian", fmt.Sprintf("field 'Custodian' cannot refer to %s, expected one of %v", target, compositionCustodianTargets))
 		}
 	}
-	if r.EmptyReason != nil {
-		if err := r.EmptyReason.Validate(); err != nil {
-			return fmt.Errorf("EmptyReason: %w", err)
-		}
+	if r.Custodian != nil {
+		r.Custodian.validateAll(path+".custodian", issues)
+	}
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
+	}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
 	}
 	for i, item := range r.Section {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Section[%d]: %w", i, err)
+		item.validateAll(fmt.Sprintf("%s.section[%d]", path, i), issues)
+	}
+}
+
+func (r *Composition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Composition", &issues)
+	checkInvariants(r, "Composition", &issues)
+	return issues
+}
+
+var compositionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Composition) invariants() []invariant {
+	return compositionInvariants
+}
+
+func (r *Composition) UnmarshalJSON(data []byte) error {
+	type alias Composition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r Composition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Composition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Composition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Composition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var compositionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+}
+
+func (r *Composition) elementTypes() map[string]string {
+	return compositionElementTypes
+}
+
+var compositionElementMetadata = []ElementMetadata{
+	{Path: "Composition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Composition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Composition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Composition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Composition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Composition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Composition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Composition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Composition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Composition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Composition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Composition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.consent", Name: "consent", Field: 16, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Consent"}},
+	{Path: "Composition.basedOn", Name: "basedOn", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Composition.status", Name: "status", Field: 18, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Composition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/composition-status"}},
+	{Path: "Composition.type", Name: "type", Field: 20, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.category", Name: "category", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.subject", Name: "subject", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Composition.encounter", Name: "encounter", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Composition.date", Name: "date", Field: 24, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Composition.useContext", Name: "useContext", Field: 26, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Composition.author", Name: "author", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Composition.participant", Name: "participant", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Composition.name", Name: "name", Field: 29, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.title", Name: "title", Field: 31, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.note", Name: "note", Field: 33, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Composition.attester", Name: "attester", Field: 34, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Composition.custodian", Name: "custodian", Field: 35, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Group"}},
+	{Path: "Composition.relatesTo", Name: "relatesTo", Field: 36, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Composition.event", Name: "event", Field: 37, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Composition.section", Name: "section", Field: 38, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Composition) ElementMetadata() []ElementMetadata {
+	return compositionElementMetadata
+}
+
+func (r *Composition) GetResourceType() string {
+	return "Composition"
+}
+
+func (r *Composition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Composition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Composition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Composition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Composition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Composition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Composition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Composition)(nil)
+
 type CompositionParticipant struct {
-	Id       *string           `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type     []CodeableConcept `json:"type" bson:"type"`                 // AUT | AUTHEN | CST | LA | RCT | SBJ
-	Function []CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`
-	Time     *Period           `json:"time,omitempty" bson:"time,omitempty"` // Time period of participation
-	Party    *Reference        `json:"party" bson:"party"`                   // Who the participant is
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              []CodeableConcept `json:"type" bson:"type"`                                                // AUT | AUTHEN | CST | LA | RCT | SBJ
+	Function          []CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`
+	Time              *Period           `json:"time,omitempty" bson:"time,omitempty"` // Time period of participation
+	Party             *Reference        `json:"party" bson:"party"`                   // Who the participant is
 }
 
+var compositionParticipantPartyTargets = []string{"Practitioner", "PractitionerRole", "Patient", "RelatedPerson", "Device", "Organization"}
+
 func (r *CompositionParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if len(r.Type) < 1 {
 		return fmt.Errorf("field 'Type' must have at least 1 elements")
 	}
@@ -305,6 +582,11 @@ func (r *CompositionParticipant) Validate() error {
 	if r.Party == nil {
 		return fmt.Errorf("field 'Party' is required")
 	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, compositionParticipantPartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, compositionParticipantPartyTargets)
+		}
+	}
 	if r.Party != nil {
 		if err := r.Party.Validate(); err != nil {
 			return fmt.Errorf("Party: %w", err)
@@ -313,14 +595,99 @@ func (r *CompositionParticipant) Validate() error {
 	return nil
 }
 
+func (r *CompositionParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Type) < 1 {
+		issues.add("required", path+".type", "field 'Type' must have at least 1 elements")
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.Function {
+		item.validateAll(fmt.Sprintf("%s.function[%d]", path, i), issues)
+	}
+	if r.Time != nil {
+		r.Time.validateAll(path+".time", issues)
+	}
+	if r.Party == nil {
+		issues.add("required", path+".party", "field 'Party' is required")
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, compositionParticipantPartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, compositionParticipantPartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+}
+
+var compositionParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CompositionParticipant) invariants() []invariant {
+	return compositionParticipantInvariants
+}
+
+func (r CompositionParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompositionParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompositionParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompositionParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var compositionParticipantElementMetadata = []ElementMetadata{
+	{Path: "Composition.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Composition.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Composition.participant.type", Name: "type", Field: 3, Min: 1, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.participant.function", Name: "function", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.participant.time", Name: "time", Field: 5, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Composition.participant.party", Name: "party", Field: 6, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization"}},
+}
+
+func (r *CompositionParticipant) ElementMetadata() []ElementMetadata {
+	return compositionParticipantElementMetadata
+}
+
 type CompositionAttester struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Mode  *CodeableConcept `json:"mode" bson:"mode"`                       // personal | professional | legal | official
-	Time  *string          `json:"time,omitempty" bson:"time,omitempty"`   // When the composition was attested
-	Party *Reference       `json:"party,omitempty" bson:"party,omitempty"` // Who attested the composition
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode              *CodeableConcept `json:"mode" bson:"mode"`                                                // personal | professional | legal | official
+	Time              *DateTime        `json:"time,omitempty" bson:"time,omitempty"`                            // When the composition was attested
+	TimeElement       *Element         `json:"_time,omitempty" bson:"time_element,omitempty"`                   // Extensions for time
+	Party             *Reference       `json:"party,omitempty" bson:"party,omitempty"`                          // Who attested the composition
 }
 
+var compositionAttesterPartyTargets = []string{"Patient", "RelatedPerson", "Practitioner", "PractitionerRole", "Organization", "Group"}
+
 func (r *CompositionAttester) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Mode == nil {
 		return fmt.Errorf("field 'Mode' is required")
 	}
@@ -329,10 +696,615 @@ func (r *CompositionAttester) Validate() error {
 			return fmt.Errorf("Mode: %w", err)
 		}
 	}
-	if r.Party != nil {
-		if err := r.Party.Validate(); err != nil {
-			return fmt.Errorf("Party: %w", err)
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			return fmt.Errorf("Time: %w", err)
 		}
 	}
-	return nil
+	if r.TimeElement != nil {
+		if err := r.TimeElement.Validate(); err != nil {
+			return fmt.Errorf("TimeElement: %w", err)
+		}
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, compositionAttesterPartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, compositionAttesterPartyTargets)
+		}
+	}
+	if r.Party != nil {
+		if err := r.Party.Validate(); err != nil {
+			return fmt.Errorf("Party: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CompositionAttester) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode == nil {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != nil {
+		r.Mode.validateAll(path+".mode", issues)
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			issues.add("value", path+".time", err.Error())
+		}
+	}
+	if r.TimeElement != nil {
+		r.TimeElement.validateAll(path+".time", issues)
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, compositionAttesterPartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, compositionAttesterPartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+}
+
+var compositionAttesterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CompositionAttester) invariants() []invariant {
+	return compositionAttesterInvariants
+}
+
+func (r CompositionAttester) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompositionAttester) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompositionAttester) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompositionAttester) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var compositionAttesterElementMetadata = []ElementMetadata{
+	{Path: "Composition.attester.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.attester.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Composition.attester.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Composition.attester.mode", Name: "mode", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.attester.time", Name: "time", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Composition.attester.party", Name: "party", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Group"}},
+}
+
+func (r *CompositionAttester) ElementMetadata() []ElementMetadata {
+	return compositionAttesterElementMetadata
+}
+
+type CompositionRelatesTo struct {
+	Id                *string                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept           `json:"type" bson:"type"`                                                // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
+	Target            CompositionRelatesToTarget `json:"-" bson:"target,omitempty"`                                       // The artifact that is related to this Composition
+	TargetElement     *Element                   `json:"-" bson:"target_element,omitempty"`                               // Extensions for target[x]
+
+	targetVariants []string // JSON properties of Composition.relatesTo.target[x] when more than one was decoded
+}
+
+func (r *CompositionRelatesTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.targetVariants) > 1 {
+		return fmt.Errorf("field 'Target' must have a single type, got %v", r.targetVariants)
+	}
+	if r.Target == nil {
+		return fmt.Errorf("field 'Target' is required")
+	}
+	if r.Target != nil {
+		if err := r.Target.Validate(); err != nil {
+			return fmt.Errorf("Target: %w", err)
+		}
+	}
+	if r.TargetElement != nil {
+		if err := r.TargetElement.Validate(); err != nil {
+			return fmt.Errorf("TargetElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CompositionRelatesTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.targetVariants) > 1 {
+		issues.add("structure", path+".target", fmt.Sprintf("field 'Target' must have a single type, got %v", r.targetVariants))
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target.ofType("+r.Target.FHIRType()+")", issues)
+	}
+	if r.TargetElement != nil {
+		r.TargetElement.validateAll(path+".target", issues)
+	}
+}
+
+var compositionRelatesToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CompositionRelatesTo) invariants() []invariant {
+	return compositionRelatesToInvariants
+}
+
+func (r CompositionRelatesTo) MarshalJSON() ([]byte, error) {
+	type alias CompositionRelatesTo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "target", r.Target, r.TargetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CompositionRelatesTo) UnmarshalJSON(data []byte) error {
+	type alias CompositionRelatesTo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Target, element, r.targetVariants, err = unmarshalChoice(fields, "target", compositionRelatesToTargetVariants); err != nil {
+		return err
+	}
+	r.TargetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetElement); err != nil {
+			return fmt.Errorf("_target: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r CompositionRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompositionRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompositionRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompositionRelatesTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CompositionRelatesTo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Target":
+		return "target", choiceValues(compositionRelatesToTargetVariants)
+	}
+	return "", nil
+}
+
+var compositionRelatesToElementMetadata = []ElementMetadata{
+	{Path: "Composition.relatesTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.relatesTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Composition.relatesTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Composition.relatesTo.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.relatesTo.target[x]", Name: "target", Field: 4, Min: 1, Max: "1", Types: []string{"uri", "Attachment", "canonical", "Reference", "markdown"}},
+}
+
+func (r *CompositionRelatesTo) ElementMetadata() []ElementMetadata {
+	return compositionRelatesToElementMetadata
+}
+
+// CompositionRelatesToTarget is the type of Composition.relatesTo.target[x]. It is implemented by the CompositionRelatesToTarget* variant types.
+type CompositionRelatesToTarget interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCompositionRelatesToTarget()
+}
+
+// CompositionRelatesToTargetUri is the uri variant of CompositionRelatesToTarget.
+type CompositionRelatesToTargetUri string
+
+func (v CompositionRelatesToTargetUri) Validate() error {
+	return nil
+}
+
+func (v CompositionRelatesToTargetUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CompositionRelatesToTargetUri) FHIRType() string {
+	return "uri"
+}
+
+func (v CompositionRelatesToTargetUri) isCompositionRelatesToTarget() {}
+
+// CompositionRelatesToTargetAttachment is the Attachment variant of CompositionRelatesToTarget.
+type CompositionRelatesToTargetAttachment struct {
+	Attachment
+}
+
+func (v CompositionRelatesToTargetAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v CompositionRelatesToTargetAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v CompositionRelatesToTargetAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v CompositionRelatesToTargetAttachment) isCompositionRelatesToTarget() {}
+
+// CompositionRelatesToTargetCanonical is the canonical variant of CompositionRelatesToTarget.
+type CompositionRelatesToTargetCanonical string
+
+func (v CompositionRelatesToTargetCanonical) Validate() error {
+	return nil
+}
+
+func (v CompositionRelatesToTargetCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CompositionRelatesToTargetCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v CompositionRelatesToTargetCanonical) isCompositionRelatesToTarget() {}
+
+// CompositionRelatesToTargetReference is the Reference variant of CompositionRelatesToTarget.
+type CompositionRelatesToTargetReference struct {
+	Reference
+}
+
+func (v CompositionRelatesToTargetReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v CompositionRelatesToTargetReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v CompositionRelatesToTargetReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v CompositionRelatesToTargetReference) isCompositionRelatesToTarget() {}
+
+// CompositionRelatesToTargetMarkdown is the markdown variant of CompositionRelatesToTarget.
+type CompositionRelatesToTargetMarkdown string
+
+func (v CompositionRelatesToTargetMarkdown) Validate() error {
+	return nil
+}
+
+func (v CompositionRelatesToTargetMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v CompositionRelatesToTargetMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v CompositionRelatesToTargetMarkdown) isCompositionRelatesToTarget() {}
+
+var compositionRelatesToTargetVariants = []CompositionRelatesToTarget{
+	CompositionRelatesToTargetUri(""),
+	CompositionRelatesToTargetAttachment{},
+	CompositionRelatesToTargetCanonical(""),
+	CompositionRelatesToTargetReference{},
+	CompositionRelatesToTargetMarkdown(""),
+}
+
+type CompositionEvent struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Period            *Period             `json:"period,omitempty" bson:"period,omitempty"`                        // The period covered by the documentation
+	Detail            []CodeableReference `json:"detail,omitempty" bson:"detail,omitempty"`                        // The event(s) being documented, as code(s), reference(s), or both
+}
+
+func (r *CompositionEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Detail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CompositionEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var compositionEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CompositionEvent) invariants() []invariant {
+	return compositionEventInvariants
+}
+
+func (r CompositionEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompositionEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompositionEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompositionEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var compositionEventElementMetadata = []ElementMetadata{
+	{Path: "Composition.event.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.event.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Composition.event.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Composition.event.period", Name: "period", Field: 3, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Composition.event.detail", Name: "detail", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+}
+
+func (r *CompositionEvent) ElementMetadata() []ElementMetadata {
+	return compositionEventElementMetadata
+}
+
+type CompositionSection struct {
+	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title             *string              `json:"title,omitempty" bson:"title,omitempty"`                          // Label for section (e.g. for ToC)
+	TitleElement      *Element             `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Code              *CodeableConcept     `json:"code,omitempty" bson:"code,omitempty"`                            // Classification of section (recommended)
+	Author            []Reference          `json:"author,omitempty" bson:"author,omitempty"`                        // Who and/or what authored the section, when the section is authored by someone other than the composition.author
+	Focus             *Reference           `json:"focus,omitempty" bson:"focus,omitempty"`                          // Who/what the section is about, when it is not about the subject of composition
+	Text              *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the section, for human interpretation
+	Note              []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                            // Information about the section contents that is not represented by any of the section entries
+	OrderedBy         *CodeableConcept     `json:"orderedBy,omitempty" bson:"ordered_by,omitempty"`                 // Order of section entries
+	Entry             []Reference          `json:"entry,omitempty" bson:"entry,omitempty"`                          // A reference to data that supports this section
+	EmptyReason       *CodeableConcept     `json:"emptyReason,omitempty" bson:"empty_reason,omitempty"`             // Why the section is empty
+	Section           []CompositionSection `json:"section,omitempty" bson:"section,omitempty"`                      // Nested Section
+}
+
+var compositionSectionAuthorTargets = []string{"Practitioner", "PractitionerRole", "CareTeam", "Device", "Patient", "RelatedPerson", "Organization"}
+
+func (r *CompositionSection) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	for i, item := range r.Author {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionSectionAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author[%d]' cannot refer to %s, expected one of %v", i, target, compositionSectionAuthorTargets)
+		}
+	}
+	for i, item := range r.Author {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Author[%d]: %w", i, err)
+		}
+	}
+	if r.Focus != nil {
+		if err := r.Focus.Validate(); err != nil {
+			return fmt.Errorf("Focus: %w", err)
+		}
+	}
+	if r.Text != nil {
+		if err := r.Text.Validate(); err != nil {
+			return fmt.Errorf("Text: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	if r.OrderedBy != nil {
+		if err := r.OrderedBy.Validate(); err != nil {
+			return fmt.Errorf("OrderedBy: %w", err)
+		}
+	}
+	for i, item := range r.Entry {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Entry[%d]: %w", i, err)
+		}
+	}
+	if r.EmptyReason != nil {
+		if err := r.EmptyReason.Validate(); err != nil {
+			return fmt.Errorf("EmptyReason: %w", err)
+		}
+	}
+	for i, item := range r.Section {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Section[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CompositionSection) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.Author {
+		if target := invalidReferenceTarget(item.Reference, item.Type, compositionSectionAuthorTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.author[%d]", path, i), fmt.Sprintf("field 'Author[%d]' cannot refer to %s, expected one of %v", i, target, compositionSectionAuthorTargets))
+		}
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	if r.Focus != nil {
+		r.Focus.validateAll(path+".focus", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.OrderedBy != nil {
+		r.OrderedBy.validateAll(path+".orderedBy", issues)
+	}
+	for i, item := range r.Entry {
+		item.validateAll(fmt.Sprintf("%s.entry[%d]", path, i), issues)
+	}
+	if r.EmptyReason != nil {
+		r.EmptyReason.validateAll(path+".emptyReason", issues)
+	}
+	for i, item := range r.Section {
+		item.validateAll(fmt.Sprintf("%s.section[%d]", path, i), issues)
+	}
+}
+
+var compositionSectionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "cmp-1", severity: "error", human: "A section must contain at least one of text, entries, or sub-sections", expression: "text.exists() or entry.exists() or section.exists()"},
+	{key: "cmp-2", severity: "error", human: "A section can only have an emptyReason if no entries are included", expression: "emptyReason.empty() or entry.empty()"},
+	{key: "cmp-3", severity: "warning", human: "If attester exists then the Composition either needs a text or a section", expression: "%resource.attester.exists() implies(text.exists() or section.exists())"},
+}
+
+func (r *CompositionSection) invariants() []invariant {
+	return compositionSectionInvariants
+}
+
+func (r CompositionSection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CompositionSection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CompositionSection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CompositionSection) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var compositionSectionElementMetadata = []ElementMetadata{
+	{Path: "Composition.section.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.section.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Composition.section.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Composition.section.title", Name: "title", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Composition.section.code", Name: "code", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.section.author", Name: "author", Field: 6, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Composition.section.focus", Name: "focus", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}},
+	{Path: "Composition.section.text", Name: "text", Field: 8, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Composition.section.note", Name: "note", Field: 9, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Composition.section.orderedBy", Name: "orderedBy", Field: 10, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.section.entry", Name: "entry", Field: 11, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}},
+	{Path: "Composition.section.emptyReason", Name: "emptyReason", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Composition.section.section", Name: "section", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CompositionSection) ElementMetadata() []ElementMetadata {
+	return compositionSectionElementMetadata
 }
@@ -2,52 +2,75 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A statement of relationships from one set of concepts to one or more other concepts - either concepts in code systems, or data element/data element concepts, or classes in class models.
 type ConceptMap struct {
-	ResourceType           string                          `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                         `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                         `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage               `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                         `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this concept map, represented as a URI (globally unique)
-	Identifier             []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the concept map
-	Version                *string                         `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the concept map
-	VersionAlgorithmString *string                         `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                         `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                         `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this concept map (computer friendly)
-	Title                  *string                         `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this concept map (human friendly)
-	Status                 string                          `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                         `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                         `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the concept map
-	UseContext             []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept               `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the concept map (if applicable)
-	Purpose                *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this concept map is defined
-	Copyright              *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string                         `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the ConceptMap was approved by publisher
-	LastReviewDate         *string                         `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the ConceptMap was last reviewed by the publisher
-	EffectivePeriod        *Period                         `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the ConceptMap is expected to be used
-	Topic                  []CodeableConcept               `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail                 `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the ConceptMap
-	Editor                 []ContactDetail                 `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the ConceptMap
-	Reviewer               []ContactDetail                 `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the ConceptMap
-	Endorser               []ContactDetail                 `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the ConceptMap
-	RelatedArtifact        []RelatedArtifact               `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
-	Property               []ConceptMapProperty            `json:"property,omitempty" bson:"property,omitempty"`                               // Additional properties of the mapping
-	AdditionalAttribute    []ConceptMapAdditionalAttribute `json:"additionalAttribute,omitempty" bson:"additional_attribute,omitempty"`        // Definition of an additional attribute to act as a data source or target
-	SourceScopeUri         *string                         `json:"sourceScopeUri,omitempty" bson:"source_scope_uri,omitempty"`                 // The source value set that contains the concepts that are being mapped
-	SourceScopeCanonical   *string                         `json:"sourceScopeCanonical,omitempty" bson:"source_scope_canonical,omitempty"`     // The source value set that contains the concepts that are being mapped
-	TargetScopeUri         *string                         `json:"targetScopeUri,omitempty" bson:"target_scope_uri,omitempty"`                 // The target value set which provides context for the mappings
-	TargetScopeCanonical   *string                         `json:"targetScopeCanonical,omitempty" bson:"target_scope_canonical,omitempty"`     // The target value set which provides context for the mappings
-	Group                  []ConceptMapGroup               `json:"group,omitempty" bson:"group,omitempty"`                                     // Same source and target systems
+	ResourceType            string                          `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                         `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                         `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this concept map, represented as a URI (globally unique)
+	UrlElement              *Element                        `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the concept map
+	Version                 *string                         `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the concept map
+	VersionElement          *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        ConceptMapVersionAlgorithm      `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                        `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                         `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this concept map (computer friendly)
+	NameElement             *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                         `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this concept map (human friendly)
+	TitleElement            *Element                        `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Status                  PublicationStatus               `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                        `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Date                    *DateTime                       `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                        `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                        `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                         `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the concept map
+	DescriptionElement      *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept               `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the concept map (if applicable)
+	Purpose                 *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this concept map is defined
+	PurposeElement          *Element                        `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                        `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                           `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the ConceptMap was approved by publisher
+	ApprovalDateElement     *Element                        `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                           `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the ConceptMap was last reviewed by the publisher
+	LastReviewDateElement   *Element                        `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                         `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the ConceptMap is expected to be used
+	Topic                   []CodeableConcept               `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail                 `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the ConceptMap
+	Editor                  []ContactDetail                 `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the ConceptMap
+	Reviewer                []ContactDetail                 `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the ConceptMap
+	Endorser                []ContactDetail                 `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the ConceptMap
+	RelatedArtifact         []RelatedArtifact               `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations, etc
+	Property                []ConceptMapProperty            `json:"property,omitempty" bson:"property,omitempty"`                        // Additional properties of the mapping
+	AdditionalAttribute     []ConceptMapAdditionalAttribute `json:"additionalAttribute,omitempty" bson:"additional_attribute,omitempty"` // Definition of an additional attribute to act as a data source or target
+	SourceScope             ConceptMapSourceScope           `json:"-" bson:"source_scope,omitempty"`                                     // The source value set that contains the concepts that are being mapped
+	SourceScopeElement      *Element                        `json:"-" bson:"source_scope_element,omitempty"`                             // Extensions for sourceScope[x]
+	TargetScope             ConceptMapTargetScope           `json:"-" bson:"target_scope,omitempty"`                                     // The target value set which provides context for the mappings
+	TargetScopeElement      *Element                        `json:"-" bson:"target_scope_element,omitempty"`                             // Extensions for targetScope[x]
+	Group                   []ConceptMapGroup               `json:"group,omitempty" bson:"group,omitempty"`                              // Same source and target systems
+
+	versionAlgorithmVariants []string // JSON properties of ConceptMap.versionAlgorithm[x] when more than one was decoded
+	sourceScopeVariants      []string // JSON properties of ConceptMap.sourceScope[x] when more than one was decoded
+	targetScopeVariants      []string // JSON properties of ConceptMap.targetScope[x] when more than one was decoded
 }
 
 func (r *ConceptMap) Validate() error {
@@ -59,30 +82,118 @@ func (r *ConceptMap) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -93,6 +204,41 @@ func (r *ConceptMap) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -138,222 +284,2038 @@ func (r *ConceptMap) Validate() error {
 			return fmt.Errorf("AdditionalAttribute[%d]: %w", i, err)
 		}
 	}
+	if len(r.sourceScopeVariants) > 1 {
+		return fmt.Errorf("field 'SourceScope' must have a single type, got %v", r.sourceScopeVariants)
+	}
+	if r.SourceScope != nil {
+		if err := r.SourceScope.Validate(); err != nil {
+			return fmt.Errorf("SourceScope: %w", err)
+		}
+	}
+	if r.SourceScopeElement != nil {
+		if err := r.SourceScopeElement.Validate(); err != nil {
+			return fmt.Errorf("SourceScopeElement: %w", err)
+		}
+	}
+	if len(r.targetScopeVariants) > 1 {
+		return fmt.Errorf("field 'TargetScope' must have a single type, got %v", r.targetScopeVariants)
+	}
+	if r.TargetScope != nil {
+		if err := r.TargetScope.Validate(); err != nil {
+			return fmt.Errorf("TargetScope: %w", err)
+		}
+	}
+	if r.TargetScopeElement != nil {
+		if err := r.TargetScopeElement.Validate(); err != nil {
+			return fmt.Errorf("TargetScopeElement: %w", err)
+		}
+	}
 	for i, item := range r.Group {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Group[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ConceptMapProperty struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code        string  `json:"code" bson:"code"`                                   // Identifies the property on the mappings, and when referred to in the $translate operation
-	Uri         *string `json:"uri,omitempty" bson:"uri,omitempty"`                 // Formal identifier for the property
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Why the property is defined, and/or what it conveys
-	Type        string  `json:"type" bson:"type"`                                   // Coding | string | integer | boolean | dateTime | decimal | code
-	System      *string `json:"system,omitempty" bson:"system,omitempty"`           // The CodeSystem from which code values come
+	return validateInvariants(r, "ConceptMap")
 }
 
-func (r *ConceptMapProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+func (r *ConceptMap) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ConceptMap" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ConceptMap', got '%s'", r.ResourceType))
 	}
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.AdditionalAttribute {
+		item.validateAll(fmt.Sprintf("%s.additionalAttribute[%d]", path, i), issues)
+	}
+	if len(r.sourceScopeVariants) > 1 {
+		issues.add("structure", path+".sourceScope", fmt.Sprintf("field 'SourceScope' must have a single type, got %v", r.sourceScopeVariants))
+	}
+	if r.SourceScope != nil {
+		r.SourceScope.validateAll(path+".sourceScope.ofType("+r.SourceScope.FHIRType()+")", issues)
+	}
+	if r.SourceScopeElement != nil {
+		r.SourceScopeElement.validateAll(path+".sourceScope", issues)
+	}
+	if len(r.targetScopeVariants) > 1 {
+		issues.add("structure", path+".targetScope", fmt.Sprintf("field 'TargetScope' must have a single type, got %v", r.targetScopeVariants))
+	}
+	if r.TargetScope != nil {
+		r.TargetScope.validateAll(path+".targetScope.ofType("+r.TargetScope.FHIRType()+")", issues)
+	}
+	if r.TargetScopeElement != nil {
+		r.TargetScopeElement.validateAll(path+".targetScope", issues)
+	}
+	for i, item := range r.Group {
+		item.validateAll(fmt.Sprintf("%s.group[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ConceptMapAdditionalAttribute struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code        string  `json:"code" bson:"code"`                                   // Identifies this additional attribute through this resource
-	Uri         *string `json:"uri,omitempty" bson:"uri,omitempty"`                 // Formal identifier for the data element referred to in this attribute
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Why the additional attribute is defined, and/or what the data element it refers to is
-	Type        string  `json:"type" bson:"type"`                                   // code | Coding | string | boolean | Quantity
+func (r *ConceptMap) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ConceptMap", &issues)
+	checkInvariants(r, "ConceptMap", &issues)
+	return issues
 }
 
-func (r *ConceptMapAdditionalAttribute) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
-	}
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	return nil
+var conceptMapInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type ConceptMapGroup struct {
-	Id       *string                  `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Source   *string                  `json:"source,omitempty" bson:"source,omitempty"`     // Source system where concepts to be mapped are defined
-	Target   *string                  `json:"target,omitempty" bson:"target,omitempty"`     // Target system that the concepts are to be mapped to
-	Element  []ConceptMapGroupElement `json:"element" bson:"element"`                       // Mappings for a concept from the source set
-	Unmapped *ConceptMapGroupUnmapped `json:"unmapped,omitempty" bson:"unmapped,omitempty"` // What to do when there is no mapping target for the source concept and ConceptMap.group.element.noMap is not true
+func (r *ConceptMap) invariants() []invariant {
+	return conceptMapInvariants
 }
 
-func (r *ConceptMapGroup) Validate() error {
-	if len(r.Element) < 1 {
-		return fmt.Errorf("field 'Element' must have at least 1 elements")
+func (r ConceptMap) MarshalJSON() ([]byte, error) {
+	type alias ConceptMap
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	for i, item := range r.Element {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Element[%d]: %w", i, err)
-		}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	if r.Unmapped != nil {
-		if err := r.Unmapped.Validate(); err != nil {
-			return fmt.Errorf("Unmapped: %w", err)
-		}
+	if data, err = marshalChoice(data, "sourceScope", r.SourceScope, r.SourceScopeElement); err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-type ConceptMapGroupElementTargetDependsOn struct {
-	Id            *string   `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Attribute     string    `json:"attribute" bson:"attribute"`                              // A reference to a mapping attribute defined in ConceptMap.additionalAttribute
-	ValueCode     *string   `json:"valueCode,omitempty" bson:"value_code,omitempty"`         // Value of the referenced data element
-	ValueCoding   *Coding   `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`     // Value of the referenced data element
-	ValueString   *string   `json:"valueString,omitempty" bson:"value_string,omitempty"`     // Value of the referenced data element
-	ValueBoolean  *bool     `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`   // Value of the referenced data element
-	ValueQuantity *Quantity `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"` // Value of the referenced data element
-	ValueSet      *string   `json:"valueSet,omitempty" bson:"value_set,omitempty"`           // The mapping depends on a data element with a value from this value set
+	if data, err = marshalChoice(data, "targetScope", r.TargetScope, r.TargetScopeElement); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
 
-func (r *ConceptMapGroupElementTargetDependsOn) Validate() error {
-	var emptyString string
-	if r.Attribute == emptyString {
-		return fmt.Errorf("field 'Attribute' is required")
+func (r *ConceptMap) UnmarshalJSON(data []byte) error {
+	type alias ConceptMap
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", conceptMapVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.SourceScope, element, r.sourceScopeVariants, err = unmarshalChoice(fields, "sourceScope", conceptMapSourceScopeVariants); err != nil {
+		return err
+	}
+	r.SourceScopeElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.SourceScopeElement); err != nil {
+			return fmt.Errorf("_sourceScope: %w", err)
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.TargetScope, element, r.targetScopeVariants, err = unmarshalChoice(fields, "targetScope", conceptMapTargetScopeVariants); err != nil {
+		return err
+	}
+	r.TargetScopeElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetScopeElement); err != nil {
+			return fmt.Errorf("_targetScope: %w", err)
 		}
 	}
 	return nil
 }
 
-type ConceptMapGroupUnmapped struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Mode         string  `json:"mode" bson:"mode"`                                     // use-source-code | fixed | other-map
-	Code         *string `json:"code,omitempty" bson:"code,omitempty"`                 // Fixed code when mode = fixed
-	Display      *string `json:"display,omitempty" bson:"display,omitempty"`           // Display for the code
-	Comment      *string `json:"comment,omitempty" bson:"comment,omitempty"`           // Comments related to the choice of how to handle unmapped elements
-	ValueSet     *string `json:"valueSet,omitempty" bson:"value_set,omitempty"`        // Fixed code set when mode = fixed
-	Relationship *string `json:"relationship,omitempty" bson:"relationship,omitempty"` // related-to | equivalent | source-is-narrower-than-target | source-is-broader-than-target | not-related-to
-	OtherMap     *string `json:"otherMap,omitempty" bson:"other_map,omitempty"`        // canonical reference to an additional ConceptMap to use for mapping if the source concept is unmapped
+func (r ConceptMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ConceptMapGroupUnmapped) Validate() error {
-	var emptyString string
-	if r.Mode == emptyString {
-		return fmt.Errorf("field 'Mode' is required")
+func (r *ConceptMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMap) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMap) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ConceptMap) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(conceptMapVersionAlgorithmVariants)
+	case "SourceScope":
+		return "sourceScope", choiceValues(conceptMapSourceScopeVariants)
+	case "TargetScope":
+		return "targetScope", choiceValues(conceptMapTargetScopeVariants)
 	}
+	return "", nil
+}
+
+var conceptMapElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *ConceptMap) elementTypes() map[string]string {
+	return conceptMapElementTypes
+}
+
+var conceptMapElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ConceptMap.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ConceptMap.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ConceptMap.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ConceptMap.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ConceptMap.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ConceptMap.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ConceptMap.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ConceptMap.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ConceptMap.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ConceptMap.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ConceptMap.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ConceptMap.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ConceptMap.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ConceptMap.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ConceptMap.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ConceptMap.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ConceptMap.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.approvalDate", Name: "approvalDate", Field: 41, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ConceptMap.lastReviewDate", Name: "lastReviewDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ConceptMap.effectivePeriod", Name: "effectivePeriod", Field: 45, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ConceptMap.topic", Name: "topic", Field: 46, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ConceptMap.author", Name: "author", Field: 47, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ConceptMap.editor", Name: "editor", Field: 48, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ConceptMap.reviewer", Name: "reviewer", Field: 49, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ConceptMap.endorser", Name: "endorser", Field: 50, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ConceptMap.relatedArtifact", Name: "relatedArtifact", Field: 51, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "ConceptMap.property", Name: "property", Field: 52, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ConceptMap.additionalAttribute", Name: "additionalAttribute", Field: 53, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ConceptMap.sourceScope[x]", Name: "sourceScope", Field: 54, Min: 0, Max: "1", Types: []string{"uri", "canonical"}},
+	{Path: "ConceptMap.targetScope[x]", Name: "targetScope", Field: 56, Min: 0, Max: "1", Types: []string{"uri", "canonical"}},
+	{Path: "ConceptMap.group", Name: "group", Field: 58, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ConceptMap) ElementMetadata() []ElementMetadata {
+	return conceptMapElementMetadata
+}
+
+// ConceptMapVersionAlgorithm is the type of ConceptMap.versionAlgorithm[x]. It is implemented by the ConceptMapVersionAlgorithm* variant types.
+type ConceptMapVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConceptMapVersionAlgorithm()
+}
+
+// ConceptMapVersionAlgorithmString is the string variant of ConceptMapVersionAlgorithm.
+type ConceptMapVersionAlgorithmString string
+
+func (v ConceptMapVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type ConceptMapGroupElement struct {
-	Id       *string                        `json:"id,omitempty" bson:"id,omitempty"`              // Unique id for inter-element referencing
-	Code     *string                        `json:"code,omitempty" bson:"code,omitempty"`          // Identifies element being mapped
-	Display  *string                        `json:"display,omitempty" bson:"display,omitempty"`    // Display for the code
-	ValueSet *string                        `json:"valueSet,omitempty" bson:"value_set,omitempty"` // Identifies the set of concepts being mapped
-	NoMap    *bool                          `json:"noMap,omitempty" bson:"no_map,omitempty"`       // No mapping to a target concept for this source concept
-	Comment  *string                        `json:"comment,omitempty" bson:"comment,omitempty"`    // Comments related to the mapping of the source element
-	Target   []ConceptMapGroupElementTarget `json:"target,omitempty" bson:"target,omitempty"`      // Concept in target system for element
+func (v ConceptMapVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConceptMapVersionAlgorithmString) FHIRType() string {
+	return "string"
 }
 
-func (r *ConceptMapGroupElement) Validate() error {
-	for i, item := range r.Target {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Target[%d]: %w", i, err)
-		}
-	}
+func (v ConceptMapVersionAlgorithmString) isConceptMapVersionAlgorithm() {}
+
+// ConceptMapVersionAlgorithmCoding is the Coding variant of ConceptMapVersionAlgorithm.
+type ConceptMapVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ConceptMapVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ConceptMapVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ConceptMapVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ConceptMapVersionAlgorithmCoding) isConceptMapVersionAlgorithm() {}
+
+var conceptMapVersionAlgorithmVariants = []ConceptMapVersionAlgorithm{
+	ConceptMapVersionAlgorithmString(""),
+	ConceptMapVersionAlgorithmCoding{},
+}
+
+// ConceptMapSourceScope is the type of ConceptMap.sourceScope[x]. It is implemented by the ConceptMapSourceScope* variant types.
+type ConceptMapSourceScope interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConceptMapSourceScope()
+}
+
+// ConceptMapSourceScopeUri is the uri variant of ConceptMapSourceScope.
+type ConceptMapSourceScopeUri string
+
+func (v ConceptMapSourceScopeUri) Validate() error {
 	return nil
 }
 
-type ConceptMapGroupElementTarget struct {
-	Id           *string                                 `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
-	Code         *string                                 `json:"code,omitempty" bson:"code,omitempty"`            // Code that identifies the target element
-	Display      *string                                 `json:"display,omitempty" bson:"display,omitempty"`      // Display for the code
-	ValueSet     *string                                 `json:"valueSet,omitempty" bson:"value_set,omitempty"`   // Identifies the set of target concepts
-	Relationship string                                  `json:"relationship" bson:"relationship"`                // related-to | equivalent | source-is-narrower-than-target | source-is-broader-than-target | not-related-to
-	Comment      *string                                 `json:"comment,omitempty" bson:"comment,omitempty"`      // Comments related to the mapping to the target element
-	Property     []ConceptMapGroupElementTargetProperty  `json:"property,omitempty" bson:"property,omitempty"`    // Property value for the source -> target mapping
-	DependsOn    []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty" bson:"depends_on,omitempty"` // Other properties required for this mapping
-	Product      []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty" bson:"product,omitempty"`      // Other data elements that this mapping also produces
+func (v ConceptMapSourceScopeUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConceptMapSourceScopeUri) FHIRType() string {
+	return "uri"
 }
 
-func (r *ConceptMapGroupElementTarget) Validate() error {
-	var emptyString string
-	if r.Relationship == emptyString {
-		return fmt.Errorf("field 'Relationship' is required")
-	}
-	for i, item := range r.Property {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Property[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.DependsOn {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("DependsOn[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.Product {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Product[%d]: %w", i, err)
-		}
-	}
+func (v ConceptMapSourceScopeUri) isConceptMapSourceScope() {}
+
+// ConceptMapSourceScopeCanonical is the canonical variant of ConceptMapSourceScope.
+type ConceptMapSourceScopeCanonical string
+
+func (v ConceptMapSourceScopeCanonical) Validate() error {
 	return nil
 }
 
-type ConceptMapGroupElementTargetProperty struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Code          string   `json:"code" bson:"code"`                     // Reference to ConceptMap.property.code
-	ValueCoding   *Coding  `json:"valueCoding" bson:"value_coding"`      // Value of the property for this concept
-	ValueString   *string  `json:"valueString" bson:"value_string"`      // Value of the property for this concept
-	ValueInteger  *int     `json:"valueInteger" bson:"value_integer"`    // Value of the property for this concept
-	ValueBoolean  *bool    `json:"valueBoolean" bson:"value_boolean"`    // Value of the property for this concept
-	ValueDateTime *string  `json:"valueDateTime" bson:"value_date_time"` // Value of the property for this concept
-	ValueDecimal  *float64 `json:"valueDecimal" bson:"value_decimal"`    // Value of the property for this concept
-	ValueCode     *string  `json:"valueCode" bson:"value_code"`          // Value of the property for this concept
+func (v ConceptMapSourceScopeCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConceptMapSourceScopeCanonical) FHIRType() string {
+	return "canonical"
 }
 
-func (r *ConceptMapGroupElementTargetProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+func (v ConceptMapSourceScopeCanonical) isConceptMapSourceScope() {}
+
+var conceptMapSourceScopeVariants = []ConceptMapSourceScope{
+	ConceptMapSourceScopeUri(""),
+	ConceptMapSourceScopeCanonical(""),
+}
+
+// ConceptMapTargetScope is the type of ConceptMap.targetScope[x]. It is implemented by the ConceptMapTargetScope* variant types.
+type ConceptMapTargetScope interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConceptMapTargetScope()
+}
+
+// ConceptMapTargetScopeUri is the uri variant of ConceptMapTargetScope.
+type ConceptMapTargetScopeUri string
+
+func (v ConceptMapTargetScopeUri) Validate() error {
+	return nil
+}
+
+func (v ConceptMapTargetScopeUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConceptMapTargetScopeUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ConceptMapTargetScopeUri) isConceptMapTargetScope() {}
+
+// ConceptMapTargetScopeCanonical is the canonical variant of ConceptMapTargetScope.
+type ConceptMapTargetScopeCanonical string
+
+func (v ConceptMapTargetScopeCanonical) Validate() error {
+	return nil
+}
+
+func (v ConceptMapTargetScopeCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConceptMapTargetScopeCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ConceptMapTargetScopeCanonical) isConceptMapTargetScope() {}
+
+var conceptMapTargetScopeVariants = []ConceptMapTargetScope{
+	ConceptMapTargetScopeUri(""),
+	ConceptMapTargetScopeCanonical(""),
+}
+
+func (r *ConceptMap) GetResourceType() string {
+	return "ConceptMap"
+}
+
+func (r *ConceptMap) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ConceptMap) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ConceptMap) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ConceptMap) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ConceptMap) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ConceptMap) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ConceptMap) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ConceptMap)(nil)
+
+type ConceptMapProperty struct {
+	Id                 *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code               string                 `json:"code" bson:"code"`                                                // Identifies the property on the mappings, and when referred to in the $translate operation
+	CodeElement        *Element               `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Uri                *string                `json:"uri,omitempty" bson:"uri,omitempty"`                              // Formal identifier for the property
+	UriElement         *Element               `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+	Description        *string                `json:"description,omitempty" bson:"description,omitempty"`              // Why the property is defined, and/or what it conveys
+	DescriptionElement *Element               `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Type               ConceptMapPropertyType `json:"type" bson:"type"`                                                // Coding | string | integer | boolean | dateTime | decimal | code
+	TypeElement        *Element               `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	System             *string                `json:"system,omitempty" bson:"system,omitempty"`                        // The CodeSystem from which code values come
+	SystemElement      *Element               `json:"_system,omitempty" bson:"system_element,omitempty"`               // Extensions for system
+}
+
+func (r *ConceptMapProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+}
+
+var conceptMapPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapProperty) invariants() []invariant {
+	return conceptMapPropertyInvariants
+}
+
+func (r ConceptMapProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conceptMapPropertyElementTypes = map[string]string{
+	"code":   "code",
+	"uri":    "uri",
+	"system": "canonical",
+}
+
+func (r *ConceptMapProperty) elementTypes() map[string]string {
+	return conceptMapPropertyElementTypes
+}
+
+var conceptMapPropertyElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.property.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.property.uri", Name: "uri", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ConceptMap.property.description", Name: "description", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.property.type", Name: "type", Field: 9, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.property.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-property-type"}},
+	{Path: "ConceptMap.property.system", Name: "system", Field: 11, Min: 0, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *ConceptMapProperty) ElementMetadata() []ElementMetadata {
+	return conceptMapPropertyElementMetadata
+}
+
+type ConceptMapAdditionalAttribute struct {
+	Id                 *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code               string                  `json:"code" bson:"code"`                                                // Identifies this additional attribute through this resource
+	CodeElement        *Element                `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Uri                *string                 `json:"uri,omitempty" bson:"uri,omitempty"`                              // Formal identifier for the data element referred to in this attribute
+	UriElement         *Element                `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+	Description        *string                 `json:"description,omitempty" bson:"description,omitempty"`              // Why the additional attribute is defined, and/or what the data element it refers to is
+	DescriptionElement *Element                `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Type               ConceptMapAttributeType `json:"type" bson:"type"`                                                // code | Coding | string | boolean | Quantity
+	TypeElement        *Element                `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+}
+
+func (r *ConceptMapAdditionalAttribute) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapAdditionalAttribute) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+}
+
+var conceptMapAdditionalAttributeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapAdditionalAttribute) invariants() []invariant {
+	return conceptMapAdditionalAttributeInvariants
+}
+
+func (r ConceptMapAdditionalAttribute) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapAdditionalAttribute) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapAdditionalAttribute) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapAdditionalAttribute) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conceptMapAdditionalAttributeElementTypes = map[string]string{
+	"code": "code",
+	"uri":  "uri",
+}
+
+func (r *ConceptMapAdditionalAttribute) elementTypes() map[string]string {
+	return conceptMapAdditionalAttributeElementTypes
+}
+
+var conceptMapAdditionalAttributeElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.additionalAttribute.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.additionalAttribute.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.additionalAttribute.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.additionalAttribute.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.additionalAttribute.uri", Name: "uri", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ConceptMap.additionalAttribute.description", Name: "description", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.additionalAttribute.type", Name: "type", Field: 9, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.additionalAttribute.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-attribute-type"}},
+}
+
+func (r *ConceptMapAdditionalAttribute) ElementMetadata() []ElementMetadata {
+	return conceptMapAdditionalAttributeElementMetadata
+}
+
+type ConceptMapGroup struct {
+	Id                *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Source            *string                  `json:"source,omitempty" bson:"source,omitempty"`                        // Source system where concepts to be mapped are defined
+	SourceElement     *Element                 `json:"_source,omitempty" bson:"source_element,omitempty"`               // Extensions for source
+	Target            *string                  `json:"target,omitempty" bson:"target,omitempty"`                        // Target system that the concepts are to be mapped to
+	TargetElement     *Element                 `json:"_target,omitempty" bson:"target_element,omitempty"`               // Extensions for target
+	Element           []ConceptMapGroupElement `json:"element" bson:"element"`                                          // Mappings for a concept from the source set
+	Unmapped          *ConceptMapGroupUnmapped `json:"unmapped,omitempty" bson:"unmapped,omitempty"`                    // What to do when there is no mapping target for the source concept and ConceptMap.group.element.noMap is not true
+}
+
+func (r *ConceptMapGroup) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SourceElement != nil {
+		if err := r.SourceElement.Validate(); err != nil {
+			return fmt.Errorf("SourceElement: %w", err)
+		}
+	}
+	if r.TargetElement != nil {
+		if err := r.TargetElement.Validate(); err != nil {
+			return fmt.Errorf("TargetElement: %w", err)
+		}
+	}
+	if len(r.Element) < 1 {
+		return fmt.Errorf("field 'Element' must have at least 1 elements")
+	}
+	for i, item := range r.Element {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Element[%d]: %w", i, err)
+		}
+	}
+	if r.Unmapped != nil {
+		if err := r.Unmapped.Validate(); err != nil {
+			return fmt.Errorf("Unmapped: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapGroup) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SourceElement != nil {
+		r.SourceElement.validateAll(path+".source", issues)
+	}
+	if r.TargetElement != nil {
+		r.TargetElement.validateAll(path+".target", issues)
+	}
+	if len(r.Element) < 1 {
+		issues.add("required", path+".element", "field 'Element' must have at least 1 elements")
+	}
+	for i, item := range r.Element {
+		item.validateAll(fmt.Sprintf("%s.element[%d]", path, i), issues)
+	}
+	if r.Unmapped != nil {
+		r.Unmapped.validateAll(path+".unmapped", issues)
+	}
+}
+
+var conceptMapGroupInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapGroup) invariants() []invariant {
+	return conceptMapGroupInvariants
+}
+
+func (r ConceptMapGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapGroup) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conceptMapGroupElementTypes = map[string]string{
+	"source": "canonical",
+	"target": "canonical",
+}
+
+func (r *ConceptMapGroup) elementTypes() map[string]string {
+	return conceptMapGroupElementTypes
+}
+
+var conceptMapGroupElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.group.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.group.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.group.source", Name: "source", Field: 3, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ConceptMap.group.target", Name: "target", Field: 5, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ConceptMap.group.element", Name: "element", Field: 7, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ConceptMap.group.unmapped", Name: "unmapped", Field: 8, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *ConceptMapGroup) ElementMetadata() []ElementMetadata {
+	return conceptMapGroupElementMetadata
+}
+
+type ConceptMapGroupElement struct {
+	Id                *string                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *string                        `json:"code,omitempty" bson:"code,omitempty"`                            // Identifies element being mapped
+	CodeElement       *Element                       `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Display           *string                        `json:"display,omitempty" bson:"display,omitempty"`                      // Display for the code
+	DisplayElement    *Element                       `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	ValueSet          *string                        `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // Identifies the set of concepts being mapped
+	ValueSetElement   *Element                       `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	NoMap             *bool                          `json:"noMap,omitempty" bson:"no_map,omitempty"`                         // No mapping to a target concept for this source concept
+	NoMapElement      *Element                       `json:"_noMap,omitempty" bson:"no_map_element,omitempty"`                // Extensions for noMap
+	Comment           *string                        `json:"comment,omitempty" bson:"comment,omitempty"`                      // Comments related to the mapping of the source element
+	CommentElement    *Element                       `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
+	Target            []ConceptMapGroupElementTarget `json:"target,omitempty" bson:"target,omitempty"`                        // Concept in target system for element
+}
+
+func (r *ConceptMapGroupElement) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	if r.NoMapElement != nil {
+		if err := r.NoMapElement.Validate(); err != nil {
+			return fmt.Errorf("NoMapElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	for i, item := range r.Target {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Target[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapGroupElement) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	if r.NoMapElement != nil {
+		r.NoMapElement.validateAll(path+".noMap", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	for i, item := range r.Target {
+		item.validateAll(fmt.Sprintf("%s.target[%d]", path, i), issues)
+	}
+}
+
+var conceptMapGroupElementInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapGroupElement) invariants() []invariant {
+	return conceptMapGroupElementInvariants
+}
+
+func (r ConceptMapGroupElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapGroupElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapGroupElement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapGroupElement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conceptMapGroupElementElementTypes = map[string]string{
+	"code":     "code",
+	"valueSet": "canonical",
+}
+
+func (r *ConceptMapGroupElement) elementTypes() map[string]string {
+	return conceptMapGroupElementElementTypes
+}
+
+var conceptMapGroupElementElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.group.element.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.group.element.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.group.element.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.group.element.display", Name: "display", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.valueSet", Name: "valueSet", Field: 7, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ConceptMap.group.element.noMap", Name: "noMap", Field: 9, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ConceptMap.group.element.comment", Name: "comment", Field: 11, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.target", Name: "target", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ConceptMapGroupElement) ElementMetadata() []ElementMetadata {
+	return conceptMapGroupElementElementMetadata
+}
+
+type ConceptMapGroupElementTarget struct {
+	Id                  *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code                *string                                 `json:"code,omitempty" bson:"code,omitempty"`                            // Code that identifies the target element
+	CodeElement         *Element                                `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Display             *string                                 `json:"display,omitempty" bson:"display,omitempty"`                      // Display for the code
+	DisplayElement      *Element                                `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	ValueSet            *string                                 `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // Identifies the set of target concepts
+	ValueSetElement     *Element                                `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	Relationship        ConceptMapRelationship                  `json:"relationship" bson:"relationship"`                                // related-to | equivalent | source-is-narrower-than-target | source-is-broader-than-target | not-related-to
+	RelationshipElement *Element                                `json:"_relationship,omitempty" bson:"relationship_element,omitempty"`   // Extensions for relationship
+	Comment             *string                                 `json:"comment,omitempty" bson:"comment,omitempty"`                      // Comments related to the mapping to the target element
+	CommentElement      *Element                                `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
+	Property            []ConceptMapGroupElementTargetProperty  `json:"property,omitempty" bson:"property,omitempty"`                    // Property value for the source -> target mapping
+	DependsOn           []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty" bson:"depends_on,omitempty"`                 // Other properties required for this mapping
+	Product             []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty" bson:"product,omitempty"`                      // Other data elements that this mapping also produces
+}
+
+func (r *ConceptMapGroupElementTarget) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	if r.Relationship == "" {
+		return fmt.Errorf("field 'Relationship' is required")
+	}
+	if !r.Relationship.IsValid() {
+		return fmt.Errorf("field 'Relationship' has invalid code '%s'", r.Relationship)
+	}
+	if r.RelationshipElement != nil {
+		if err := r.RelationshipElement.Validate(); err != nil {
+			return fmt.Errorf("RelationshipElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	for i, item := range r.Property {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Property[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DependsOn {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DependsOn[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Product {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Product[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapGroupElementTarget) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	if r.Relationship == "" {
+		issues.add("required", path+".relationship", "field 'Relationship' is required")
+	}
+	if r.Relationship != "" && !r.Relationship.IsValid() {
+		issues.add("code-invalid", path+".relationship", fmt.Sprintf("field 'Relationship' has invalid code '%s'", r.Relationship))
+	}
+	if r.RelationshipElement != nil {
+		r.RelationshipElement.validateAll(path+".relationship", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.DependsOn {
+		item.validateAll(fmt.Sprintf("%s.dependsOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Product {
+		item.validateAll(fmt.Sprintf("%s.product[%d]", path, i), issues)
+	}
+}
+
+var conceptMapGroupElementTargetInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapGroupElementTarget) invariants() []invariant {
+	return conceptMapGroupElementTargetInvariants
+}
+
+func (r ConceptMapGroupElementTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapGroupElementTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapGroupElementTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapGroupElementTarget) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conceptMapGroupElementTargetElementTypes = map[string]string{
+	"code":     "code",
+	"valueSet": "canonical",
+}
+
+func (r *ConceptMapGroupElementTarget) elementTypes() map[string]string {
+	return conceptMapGroupElementTargetElementTypes
+}
+
+var conceptMapGroupElementTargetElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.group.element.target.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.target.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.group.element.target.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.group.element.target.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.group.element.target.display", Name: "display", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.target.valueSet", Name: "valueSet", Field: 7, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ConceptMap.group.element.target.relationship", Name: "relationship", Field: 9, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.group.element.target.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/concept-map-relationship"}},
+	{Path: "ConceptMap.group.element.target.comment", Name: "comment", Field: 11, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.target.property", Name: "property", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ConceptMap.group.element.target.dependsOn", Name: "dependsOn", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ConceptMap.group.element.target.product", Name: "product", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ConceptMapGroupElementTarget) ElementMetadata() []ElementMetadata {
+	return conceptMapGroupElementTargetElementMetadata
+}
+
+type ConceptMapGroupElementTargetProperty struct {
+	Id                *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string                                    `json:"code" bson:"code"`                                                // Reference to ConceptMap.property.code
+	CodeElement       *Element                                  `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Value             ConceptMapGroupElementTargetPropertyValue `json:"-" bson:"value,omitempty"`                                        // Value of the property for this concept
+	ValueElement      *Element                                  `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of ConceptMap.group.element.target.property.value[x] when more than one was decoded
+}
+
+func (r *ConceptMapGroupElementTargetProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapGroupElementTargetProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var conceptMapGroupElementTargetPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapGroupElementTargetProperty) invariants() []invariant {
+	return conceptMapGroupElementTargetPropertyInvariants
+}
+
+func (r ConceptMapGroupElementTargetProperty) MarshalJSON() ([]byte, error) {
+	type alias ConceptMapGroupElementTargetProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ConceptMapGroupElementTargetProperty) UnmarshalJSON(data []byte) error {
+	type alias ConceptMapGroupElementTargetProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", conceptMapGroupElementTargetPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ConceptMapGroupElementTargetProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapGroupElementTargetProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapGroupElementTargetProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapGroupElementTargetProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ConceptMapGroupElementTargetProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(conceptMapGroupElementTargetPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var conceptMapGroupElementTargetPropertyElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *ConceptMapGroupElementTargetProperty) elementTypes() map[string]string {
+	return conceptMapGroupElementTargetPropertyElementTypes
+}
+
+var conceptMapGroupElementTargetPropertyElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.group.element.target.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.target.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.group.element.target.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.group.element.target.property.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.group.element.target.property.value[x]", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"Coding", "string", "integer", "boolean", "dateTime", "decimal", "code"}},
+}
+
+func (r *ConceptMapGroupElementTargetProperty) ElementMetadata() []ElementMetadata {
+	return conceptMapGroupElementTargetPropertyElementMetadata
+}
+
+// ConceptMapGroupElementTargetPropertyValue is the type of ConceptMap.group.element.target.property.value[x]. It is implemented by the ConceptMapGroupElementTargetPropertyValue* variant types.
+type ConceptMapGroupElementTargetPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConceptMapGroupElementTargetPropertyValue()
+}
+
+// ConceptMapGroupElementTargetPropertyValueCoding is the Coding variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueCoding struct {
+	Coding
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCoding) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+// ConceptMapGroupElementTargetPropertyValueString is the string variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueString string
+
+func (v ConceptMapGroupElementTargetPropertyValueString) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueString) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+// ConceptMapGroupElementTargetPropertyValueInteger is the integer variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueInteger int
+
+func (v ConceptMapGroupElementTargetPropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueInteger) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+// ConceptMapGroupElementTargetPropertyValueBoolean is the boolean variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueBoolean bool
+
+func (v ConceptMapGroupElementTargetPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueBoolean) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+// ConceptMapGroupElementTargetPropertyValueDateTime is the dateTime variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueDateTime struct {
+	DateTime
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDateTime) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+// ConceptMapGroupElementTargetPropertyValueDecimal is the decimal variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueDecimal struct {
+	Decimal
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueDecimal) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+// ConceptMapGroupElementTargetPropertyValueCode is the code variant of ConceptMapGroupElementTargetPropertyValue.
+type ConceptMapGroupElementTargetPropertyValueCode string
+
+func (v ConceptMapGroupElementTargetPropertyValueCode) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCode) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ConceptMapGroupElementTargetPropertyValueCode) isConceptMapGroupElementTargetPropertyValue() {
+}
+
+var conceptMapGroupElementTargetPropertyValueVariants = []ConceptMapGroupElementTargetPropertyValue{
+	ConceptMapGroupElementTargetPropertyValueCoding{},
+	ConceptMapGroupElementTargetPropertyValueString(""),
+	ConceptMapGroupElementTargetPropertyValueInteger(0),
+	ConceptMapGroupElementTargetPropertyValueBoolean(false),
+	ConceptMapGroupElementTargetPropertyValueDateTime{},
+	ConceptMapGroupElementTargetPropertyValueDecimal{},
+	ConceptMapGroupElementTargetPropertyValueCode(""),
+}
+
+type ConceptMapGroupElementTargetDependsOn struct {
+	Id                *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Attribute         string                                     `json:"attribute" bson:"attribute"`                                      // A reference to a mapping attribute defined in ConceptMap.additionalAttribute
+	AttributeElement  *Element                                   `json:"_attribute,omitempty" bson:"attribute_element,omitempty"`         // Extensions for attribute
+	Value             ConceptMapGroupElementTargetDependsOnValue `json:"-" bson:"value,omitempty"`                                        // Value of the referenced data element
+	ValueElement      *Element                                   `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	ValueSet          *string                                    `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // The mapping depends on a data element with a value from this value set
+	ValueSetElement   *Element                                   `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+
+	valueVariants []string // JSON properties of ConceptMap.group.element.target.dependsOn.value[x] when more than one was decoded
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Attribute == emptyString {
+		return fmt.Errorf("field 'Attribute' is required")
+	}
+	if r.AttributeElement != nil {
+		if err := r.AttributeElement.Validate(); err != nil {
+			return fmt.Errorf("AttributeElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Attribute == emptyString {
+		issues.add("required", path+".attribute", "field 'Attribute' is required")
+	}
+	if r.AttributeElement != nil {
+		r.AttributeElement.validateAll(path+".attribute", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+}
+
+var conceptMapGroupElementTargetDependsOnInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) invariants() []invariant {
+	return conceptMapGroupElementTargetDependsOnInvariants
+}
+
+func (r ConceptMapGroupElementTargetDependsOn) MarshalJSON() ([]byte, error) {
+	type alias ConceptMapGroupElementTargetDependsOn
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalJSON(data []byte) error {
+	type alias ConceptMapGroupElementTargetDependsOn
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", conceptMapGroupElementTargetDependsOnValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ConceptMapGroupElementTargetDependsOn) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapGroupElementTargetDependsOn) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(conceptMapGroupElementTargetDependsOnValueVariants)
+	}
+	return "", nil
+}
+
+var conceptMapGroupElementTargetDependsOnElementTypes = map[string]string{
+	"attribute": "code",
+	"valueSet":  "canonical",
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) elementTypes() map[string]string {
+	return conceptMapGroupElementTargetDependsOnElementTypes
+}
+
+var conceptMapGroupElementTargetDependsOnElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.group.element.target.dependsOn.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.element.target.dependsOn.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.group.element.target.dependsOn.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.group.element.target.dependsOn.attribute", Name: "attribute", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.group.element.target.dependsOn.value[x]", Name: "value", Field: 5, Min: 0, Max: "1", Types: []string{"code", "Coding", "string", "boolean", "Quantity"}},
+	{Path: "ConceptMap.group.element.target.dependsOn.valueSet", Name: "valueSet", Field: 7, Min: 0, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *ConceptMapGroupElementTargetDependsOn) ElementMetadata() []ElementMetadata {
+	return conceptMapGroupElementTargetDependsOnElementMetadata
+}
+
+// ConceptMapGroupElementTargetDependsOnValue is the type of ConceptMap.group.element.target.dependsOn.value[x]. It is implemented by the ConceptMapGroupElementTargetDependsOnValue* variant types.
+type ConceptMapGroupElementTargetDependsOnValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConceptMapGroupElementTargetDependsOnValue()
+}
+
+// ConceptMapGroupElementTargetDependsOnValueCode is the code variant of ConceptMapGroupElementTargetDependsOnValue.
+type ConceptMapGroupElementTargetDependsOnValueCode string
+
+func (v ConceptMapGroupElementTargetDependsOnValueCode) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCode) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCode) isConceptMapGroupElementTargetDependsOnValue() {
+}
+
+// ConceptMapGroupElementTargetDependsOnValueCoding is the Coding variant of ConceptMapGroupElementTargetDependsOnValue.
+type ConceptMapGroupElementTargetDependsOnValueCoding struct {
+	Coding
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueCoding) isConceptMapGroupElementTargetDependsOnValue() {
+}
+
+// ConceptMapGroupElementTargetDependsOnValueString is the string variant of ConceptMapGroupElementTargetDependsOnValue.
+type ConceptMapGroupElementTargetDependsOnValueString string
+
+func (v ConceptMapGroupElementTargetDependsOnValueString) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueString) isConceptMapGroupElementTargetDependsOnValue() {
+}
+
+// ConceptMapGroupElementTargetDependsOnValueBoolean is the boolean variant of ConceptMapGroupElementTargetDependsOnValue.
+type ConceptMapGroupElementTargetDependsOnValueBoolean bool
+
+func (v ConceptMapGroupElementTargetDependsOnValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueBoolean) isConceptMapGroupElementTargetDependsOnValue() {
+}
+
+// ConceptMapGroupElementTargetDependsOnValueQuantity is the Quantity variant of ConceptMapGroupElementTargetDependsOnValue.
+type ConceptMapGroupElementTargetDependsOnValueQuantity struct {
+	Quantity
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ConceptMapGroupElementTargetDependsOnValueQuantity) isConceptMapGroupElementTargetDependsOnValue() {
+}
+
+var conceptMapGroupElementTargetDependsOnValueVariants = []ConceptMapGroupElementTargetDependsOnValue{
+	ConceptMapGroupElementTargetDependsOnValueCode(""),
+	ConceptMapGroupElementTargetDependsOnValueCoding{},
+	ConceptMapGroupElementTargetDependsOnValueString(""),
+	ConceptMapGroupElementTargetDependsOnValueBoolean(false),
+	ConceptMapGroupElementTargetDependsOnValueQuantity{},
+}
+
+type ConceptMapGroupUnmapped struct {
+	Id                  *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode                ConceptMapGroupUnmappedMode `json:"mode" bson:"mode"`                                                // use-source-code | fixed | other-map
+	ModeElement         *Element                    `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Code                *string                     `json:"code,omitempty" bson:"code,omitempty"`                            // Fixed code when mode = fixed
+	CodeElement         *Element                    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Display             *string                     `json:"display,omitempty" bson:"display,omitempty"`                      // Display for the code
+	DisplayElement      *Element                    `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	Comment             *string                     `json:"comment,omitempty" bson:"comment,omitempty"`                      // Comments related to the choice of how to handle unmapped elements
+	CommentElement      *Element                    `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
+	ValueSet            *string                     `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // Fixed code set when mode = fixed
+	ValueSetElement     *Element                    `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	Relationship        *ConceptMapRelationship     `json:"relationship,omitempty" bson:"relationship,omitempty"`            // related-to | equivalent | source-is-narrower-than-target | source-is-broader-than-target | not-related-to
+	RelationshipElement *Element                    `json:"_relationship,omitempty" bson:"relationship_element,omitempty"`   // Extensions for relationship
+	OtherMap            *string                     `json:"otherMap,omitempty" bson:"other_map,omitempty"`                   // canonical reference to an additional ConceptMap to use for mapping if the source concept is unmapped
+	OtherMapElement     *Element                    `json:"_otherMap,omitempty" bson:"other_map_element,omitempty"`          // Extensions for otherMap
+}
+
+func (r *ConceptMapGroupUnmapped) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Mode == "" {
+		return fmt.Errorf("field 'Mode' is required")
+	}
+	if !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", r.Mode)
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	if r.Relationship != nil && !r.Relationship.IsValid() {
+		return fmt.Errorf("field 'Relationship' has invalid code '%s'", *r.Relationship)
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	if r.RelationshipElement != nil {
+		if err := r.RelationshipElement.Validate(); err != nil {
+			return fmt.Errorf("RelationshipElement: %w", err)
+		}
 	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
+	if r.OtherMapElement != nil {
+		if err := r.OtherMapElement.Validate(); err != nil {
+			return fmt.Errorf("OtherMapElement: %w", err)
+		}
 	}
 	return nil
 }
+
+func (r *ConceptMapGroupUnmapped) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode == "" {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != "" && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	if r.Relationship != nil && !r.Relationship.IsValid() {
+		issues.add("code-invalid", path+".relationship", fmt.Sprintf("field 'Relationship' has invalid code '%s'", *r.Relationship))
+	}
+	if r.RelationshipElement != nil {
+		r.RelationshipElement.validateAll(path+".relationship", issues)
+	}
+	if r.OtherMapElement != nil {
+		r.OtherMapElement.validateAll(path+".otherMap", issues)
+	}
+}
+
+var conceptMapGroupUnmappedInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConceptMapGroupUnmapped) invariants() []invariant {
+	return conceptMapGroupUnmappedInvariants
+}
+
+func (r ConceptMapGroupUnmapped) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConceptMapGroupUnmapped) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConceptMapGroupUnmapped) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConceptMapGroupUnmapped) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conceptMapGroupUnmappedElementTypes = map[string]string{
+	"code":     "code",
+	"valueSet": "canonical",
+	"otherMap": "canonical",
+}
+
+func (r *ConceptMapGroupUnmapped) elementTypes() map[string]string {
+	return conceptMapGroupUnmappedElementTypes
+}
+
+var conceptMapGroupUnmappedElementMetadata = []ElementMetadata{
+	{Path: "ConceptMap.group.unmapped.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.unmapped.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ConceptMap.group.unmapped.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ConceptMap.group.unmapped.mode", Name: "mode", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.group.unmapped.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conceptmap-unmapped-mode"}},
+	{Path: "ConceptMap.group.unmapped.code", Name: "code", Field: 5, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ConceptMap.group.unmapped.display", Name: "display", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.unmapped.comment", Name: "comment", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ConceptMap.group.unmapped.valueSet", Name: "valueSet", Field: 11, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ConceptMap.group.unmapped.relationship", Name: "relationship", Field: 13, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ConceptMap.group.unmapped.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/concept-map-relationship"}},
+	{Path: "ConceptMap.group.unmapped.otherMap", Name: "otherMap", Field: 15, Min: 0, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *ConceptMapGroupUnmapped) ElementMetadata() []ElementMetadata {
+	return conceptMapGroupUnmappedElementMetadata
+}
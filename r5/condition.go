@@ -2,46 +2,55 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A clinical condition, problem, diagnosis, or other event, situation, issue, or clinical concept that has risen to a level of concern.
 type Condition struct {
-	ResourceType       string              `json:"resourceType" bson:"resource_type"`                                 // Type of resource
-	Id                 *string             `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
-	Meta               *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
-	ImplicitRules      *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
-	Language           *string             `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
-	Text               *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
-	Identifier         []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // External Ids for this condition
-	ClinicalStatus     *CodeableConcept    `json:"clinicalStatus" bson:"clinical_status"`                             // active | recurrence | relapse | inactive | remission | resolved | unknown
-	VerificationStatus *CodeableConcept    `json:"verificationStatus,omitempty" bson:"verification_status,omitempty"` // unconfirmed | provisional | differential | confirmed | refuted | entered-in-error
-	Category           []CodeableConcept   `json:"category,omitempty" bson:"category,omitempty"`                      // problem-list-item | encounter-diagnosis
-	Severity           *CodeableConcept    `json:"severity,omitempty" bson:"severity,omitempty"`                      // Subjective severity of condition
-	Code               *CodeableConcept    `json:"code,omitempty" bson:"code,omitempty"`                              // Identification of the condition, problem or diagnosis
-	BodySite           []CodeableConcept   `json:"bodySite,omitempty" bson:"body_site,omitempty"`                     // Anatomical location, if relevant
-	BodyStructure      *Reference          `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`           // Anatomical body structure
-	Subject            *Reference          `json:"subject" bson:"subject"`                                            // Who has the condition?
-	Encounter          *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // The Encounter during which this Condition was created
-	OnsetDateTime      *string             `json:"onsetDateTime,omitempty" bson:"onset_date_time,omitempty"`          // Estimated or actual date,  date-time, or age
-	OnsetAge           *Age                `json:"onsetAge,omitempty" bson:"onset_age,omitempty"`                     // Estimated or actual date,  date-time, or age
-	OnsetPeriod        *Period             `json:"onsetPeriod,omitempty" bson:"onset_period,omitempty"`               // Estimated or actual date,  date-time, or age
-	OnsetRange         *Range              `json:"onsetRange,omitempty" bson:"onset_range,omitempty"`                 // Estimated or actual date,  date-time, or age
-	OnsetString        *string             `json:"onsetString,omitempty" bson:"onset_string,omitempty"`               // Estimated or actual date,  date-time, or age
-	AbatementDateTime  *string             `json:"abatementDateTime,omitempty" bson:"abatement_date_time,omitempty"`  // When in resolution/remission
-	AbatementAge       *Age                `json:"abatementAge,omitempty" bson:"abatement_age,omitempty"`             // When in resolution/remission
-	AbatementPeriod    *Period             `json:"abatementPeriod,omitempty" bson:"abatement_period,omitempty"`       // When in resolution/remission
-	AbatementRange     *Range              `json:"abatementRange,omitempty" bson:"abatement_range,omitempty"`         // When in resolution/remission
-	AbatementString    *string             `json:"abatementString,omitempty" bson:"abatement_string,omitempty"`       // When in resolution/remission
-	RecordedDate       *string             `json:"recordedDate,omitempty" bson:"recorded_date,omitempty"`             // Date condition was first recorded
-	Recorder           *Reference          `json:"recorder,omitempty" bson:"recorder,omitempty"`                      // Who recorded the condition
-	Asserter           *Reference          `json:"asserter,omitempty" bson:"asserter,omitempty"`                      // Person or device that asserts this condition
-	Stage              []ConditionStage    `json:"stage,omitempty" bson:"stage,omitempty"`                            // Stage/grade, usually assessed formally
-	Evidence           []CodeableReference `json:"evidence,omitempty" bson:"evidence,omitempty"`                      // Supporting evidence for the condition
-	Note               []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                              // Additional information about the Condition
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                 // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`  // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`             // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored
+	Identifier           []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // External Ids for this condition
+	ClinicalStatus       *CodeableConcept    `json:"clinicalStatus" bson:"clinical_status"`                             // active | recurrence | relapse | inactive | remission | resolved | unknown
+	VerificationStatus   *CodeableConcept    `json:"verificationStatus,omitempty" bson:"verification_status,omitempty"` // unconfirmed | provisional | differential | confirmed | refuted | entered-in-error
+	Category             []CodeableConcept   `json:"category,omitempty" bson:"category,omitempty"`                      // problem-list-item | encounter-diagnosis
+	Severity             *CodeableConcept    `json:"severity,omitempty" bson:"severity,omitempty"`                      // Subjective severity of condition
+	Code                 *CodeableConcept    `json:"code,omitempty" bson:"code,omitempty"`                              // Identification of the condition, problem or diagnosis
+	BodySite             []CodeableConcept   `json:"bodySite,omitempty" bson:"body_site,omitempty"`                     // Anatomical location, if relevant
+	BodyStructure        *Reference          `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`           // Anatomical body structure
+	Subject              *Reference          `json:"subject" bson:"subject"`                                            // Who has the condition?
+	Encounter            *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // The Encounter during which this Condition was created
+	Onset                ConditionOnset      `json:"-" bson:"onset,omitempty"`                                          // Estimated or actual date,  date-time, or age
+	OnsetElement         *Element            `json:"-" bson:"onset_element,omitempty"`                                  // Extensions for onset[x]
+	Abatement            ConditionAbatement  `json:"-" bson:"abatement,omitempty"`                                      // When in resolution/remission
+	AbatementElement     *Element            `json:"-" bson:"abatement_element,omitempty"`                              // Extensions for abatement[x]
+	RecordedDate         *DateTime           `json:"recordedDate,omitempty" bson:"recorded_date,omitempty"`             // Date condition was first recorded
+	RecordedDateElement  *Element            `json:"_recordedDate,omitempty" bson:"recorded_date_element,omitempty"`    // Extensions for recordedDate
+	Recorder             *Reference          `json:"recorder,omitempty" bson:"recorder,omitempty"`                      // Who recorded the condition
+	Asserter             *Reference          `json:"asserter,omitempty" bson:"asserter,omitempty"`                      // Person or device that asserts this condition
+	Stage                []ConditionStage    `json:"stage,omitempty" bson:"stage,omitempty"`                            // Stage/grade, usually assessed formally
+	Evidence             []CodeableReference `json:"evidence,omitempty" bson:"evidence,omitempty"`                      // Supporting evidence for the condition
+	Note                 []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                              // Additional information about the Condition
+
+	onsetVariants     []string // JSON properties of Condition.onset[x] when more than one was decoded
+	abatementVariants []string // JSON properties of Condition.abatement[x] when more than one was decoded
 }
 
+var conditionSubjectTargets = []string{"Group", "Patient"}
+
+var conditionEncounterTargets = []string{"Encounter"}
+
+var conditionAsserterTargets = []string{"Device", "Group", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Condition) Validate() error {
 	if r.ResourceType != "Condition" {
 		return fmt.Errorf("invalid resourceType: expected 'Condition', got '%s'", r.ResourceType)
@@ -51,11 +60,39 @@ func (r *Condition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -102,44 +139,60 @@ func (r *Condition) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, conditionSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, conditionSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, conditionEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, conditionEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OnsetAge != nil {
-		if err := r.OnsetAge.Validate(); err != nil {
-			return fmt.Errorf("OnsetAge: %w", err)
+	if len(r.onsetVariants) > 1 {
+		return fmt.Errorf("field 'Onset' must have a single type, got %v", r.onsetVariants)
+	}
+	if r.Onset != nil {
+		if err := r.Onset.Validate(); err != nil {
+			return fmt.Errorf("Onset: %w", err)
 		}
 	}
-	if r.OnsetPeriod != nil {
-		if err := r.OnsetPeriod.Validate(); err != nil {
-			return fmt.Errorf("OnsetPeriod: %w", err)
+	if r.OnsetElement != nil {
+		if err := r.OnsetElement.Validate(); err != nil {
+			return fmt.Errorf("OnsetElement: %w", err)
 		}
 	}
-	if r.OnsetRange != nil {
-		if err := r.OnsetRange.Validate(); err != nil {
-			return fmt.Errorf("OnsetRange: %w", err)
+	if len(r.abatementVariants) > 1 {
+		return fmt.Errorf("field 'Abatement' must have a single type, got %v", r.abatementVariants)
+	}
+	if r.Abatement != nil {
+		if err := r.Abatement.Validate(); err != nil {
+			return fmt.Errorf("Abatement: %w", err)
 		}
 	}
-	if r.AbatementAge != nil {
-		if err := r.AbatementAge.Validate(); err != nil {
-			return fmt.Errorf("AbatementAge: %w", err)
+	if r.AbatementElement != nil {
+		if err := r.AbatementElement.Validate(); err != nil {
+			return fmt.Errorf("AbatementElement: %w", err)
 		}
 	}
-	if r.AbatementPeriod != nil {
-		if err := r.AbatementPeriod.Validate(); err != nil {
-			return fmt.Errorf("AbatementPeriod: %w", err)
+	if r.RecordedDate != nil {
+		if err := r.RecordedDate.Validate(); err != nil {
+			return fmt.Errorf("RecordedDate: %w", err)
 		}
 	}
-	if r.AbatementRange != nil {
-		if err := r.AbatementRange.Validate(); err != nil {
-			return fmt.Errorf("AbatementRange: %w", err)
+	if r.RecordedDateElement != nil {
+		if err := r.RecordedDateElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedDateElement: %w", err)
 		}
 	}
 	if r.Recorder != nil {
@@ -147,6 +200,11 @@ func (r *Condition) Validate() error {
 			return fmt.Errorf("Recorder: %w", err)
 		}
 	}
+	if r.Asserter != nil {
+		if target := invalidReferenceTarget(r.Asserter.Reference, r.Asserter.Type, conditionAsserterTargets); target != "" {
+			return fmt.Errorf("field 'Asserter' cannot refer to %s, expected one of %v", target, conditionAsserterTargets)
+		}
+	}
 	if r.Asserter != nil {
 		if err := r.Asserter.Validate(); err != nil {
 			return fmt.Errorf("Asserter: %w", err)
@@ -167,17 +225,560 @@ func (r *Condition) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Condition")
+}
+
+func (r *Condition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Condition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Condition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ClinicalStatus == nil {
+		issues.add("required", path+".clinicalStatus", "field 'ClinicalStatus' is required")
+	}
+	if r.ClinicalStatus != nil {
+		r.ClinicalStatus.validateAll(path+".clinicalStatus", issues)
+	}
+	if r.VerificationStatus != nil {
+		r.VerificationStatus.validateAll(path+".verificationStatus", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Severity != nil {
+		r.Severity.validateAll(path+".severity", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	if r.BodyStructure != nil {
+		r.BodyStructure.validateAll(path+".bodyStructure", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, conditionSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, conditionSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, conditionEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, conditionEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.onsetVariants) > 1 {
+		issues.add("structure", path+".onset", fmt.Sprintf("field 'Onset' must have a single type, got %v", r.onsetVariants))
+	}
+	if r.Onset != nil {
+		r.Onset.validateAll(path+".onset.ofType("+r.Onset.FHIRType()+")", issues)
+	}
+	if r.OnsetElement != nil {
+		r.OnsetElement.validateAll(path+".onset", issues)
+	}
+	if len(r.abatementVariants) > 1 {
+		issues.add("structure", path+".abatement", fmt.Sprintf("field 'Abatement' must have a single type, got %v", r.abatementVariants))
+	}
+	if r.Abatement != nil {
+		r.Abatement.validateAll(path+".abatement.ofType("+r.Abatement.FHIRType()+")", issues)
+	}
+	if r.AbatementElement != nil {
+		r.AbatementElement.validateAll(path+".abatement", issues)
+	}
+	if r.RecordedDate != nil {
+		if err := r.RecordedDate.Validate(); err != nil {
+			issues.add("value", path+".recordedDate", err.Error())
+		}
+	}
+	if r.RecordedDateElement != nil {
+		r.RecordedDateElement.validateAll(path+".recordedDate", issues)
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	if r.Asserter != nil {
+		if target := invalidReferenceTarget(r.Asserter.Reference, r.Asserter.Type, conditionAsserterTargets); target != "" {
+			issues.add("value", path+".asserter", fmt.Sprintf("field 'Asserter' cannot refer to %s, expected one of %v", target, conditionAsserterTargets))
+		}
+	}
+	if r.Asserter != nil {
+		r.Asserter.validateAll(path+".asserter", issues)
+	}
+	for i, item := range r.Stage {
+		item.validateAll(fmt.Sprintf("%s.stage[%d]", path, i), issues)
+	}
+	for i, item := range r.Evidence {
+		item.validateAll(fmt.Sprintf("%s.evidence[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *Condition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Condition", &issues)
+	checkInvariants(r, "Condition", &issues)
+	return issues
+}
+
+var conditionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Condition) invariants() []invariant {
+	return conditionInvariants
+}
+
+func (r Condition) MarshalJSON() ([]byte, error) {
+	type alias Condition
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "onset", r.Onset, r.OnsetElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "abatement", r.Abatement, r.AbatementElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Condition) UnmarshalJSON(data []byte) error {
+	type alias Condition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Onset, element, r.onsetVariants, err = unmarshalChoice(fields, "onset", conditionOnsetVariants); err != nil {
+		return err
+	}
+	r.OnsetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OnsetElement); err != nil {
+			return fmt.Errorf("_onset: %w", err)
+		}
+	}
+	if r.Abatement, element, r.abatementVariants, err = unmarshalChoice(fields, "abatement", conditionAbatementVariants); err != nil {
+		return err
+	}
+	r.AbatementElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AbatementElement); err != nil {
+			return fmt.Errorf("_abatement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r Condition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Condition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Condition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Condition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Condition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Onset":
+		return "onset", choiceValues(conditionOnsetVariants)
+	case "Abatement":
+		return "abatement", choiceValues(conditionAbatementVariants)
+	}
+	return "", nil
+}
+
+var conditionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Condition) elementTypes() map[string]string {
+	return conditionElementTypes
+}
+
+var conditionElementMetadata = []ElementMetadata{
+	{Path: "Condition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Condition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Condition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Condition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Condition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Condition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Condition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Condition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Condition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Condition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Condition.clinicalStatus", Name: "clinicalStatus", Field: 12, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.verificationStatus", Name: "verificationStatus", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.severity", Name: "severity", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.code", Name: "code", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.bodySite", Name: "bodySite", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.bodyStructure", Name: "bodyStructure", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Condition.subject", Name: "subject", Field: 19, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Condition.encounter", Name: "encounter", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Condition.onset[x]", Name: "onset", Field: 21, Min: 0, Max: "1", Types: []string{"dateTime", "Age", "Period", "Range", "string"}},
+	{Path: "Condition.abatement[x]", Name: "abatement", Field: 23, Min: 0, Max: "1", Types: []string{"dateTime", "Age", "Period", "Range", "string"}},
+	{Path: "Condition.recordedDate", Name: "recordedDate", Field: 25, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Condition.recorder", Name: "recorder", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Condition.asserter", Name: "asserter", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Condition.stage", Name: "stage", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Condition.evidence", Name: "evidence", Field: 30, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Condition.note", Name: "note", Field: 31, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *Condition) ElementMetadata() []ElementMetadata {
+	return conditionElementMetadata
+}
+
+// ConditionOnset is the type of Condition.onset[x]. It is implemented by the ConditionOnset* variant types.
+type ConditionOnset interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConditionOnset()
+}
+
+// ConditionOnsetDateTime is the dateTime variant of ConditionOnset.
+type ConditionOnsetDateTime struct {
+	DateTime
+}
+
+func (v ConditionOnsetDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ConditionOnsetDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ConditionOnsetDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ConditionOnsetDateTime) isConditionOnset() {}
+
+// ConditionOnsetAge is the Age variant of ConditionOnset.
+type ConditionOnsetAge struct {
+	Age
+}
+
+func (v ConditionOnsetAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ConditionOnsetAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ConditionOnsetAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ConditionOnsetAge) isConditionOnset() {}
+
+// ConditionOnsetPeriod is the Period variant of ConditionOnset.
+type ConditionOnsetPeriod struct {
+	Period
+}
+
+func (v ConditionOnsetPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ConditionOnsetPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ConditionOnsetPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ConditionOnsetPeriod) isConditionOnset() {}
+
+// ConditionOnsetRange is the Range variant of ConditionOnset.
+type ConditionOnsetRange struct {
+	Range
+}
+
+func (v ConditionOnsetRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ConditionOnsetRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ConditionOnsetRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ConditionOnsetRange) isConditionOnset() {}
+
+// ConditionOnsetString is the string variant of ConditionOnset.
+type ConditionOnsetString string
+
+func (v ConditionOnsetString) Validate() error {
 	return nil
 }
 
+func (v ConditionOnsetString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConditionOnsetString) FHIRType() string {
+	return "string"
+}
+
+func (v ConditionOnsetString) isConditionOnset() {}
+
+var conditionOnsetVariants = []ConditionOnset{
+	ConditionOnsetDateTime{},
+	ConditionOnsetAge{},
+	ConditionOnsetPeriod{},
+	ConditionOnsetRange{},
+	ConditionOnsetString(""),
+}
+
+// ConditionAbatement is the type of Condition.abatement[x]. It is implemented by the ConditionAbatement* variant types.
+type ConditionAbatement interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isConditionAbatement()
+}
+
+// ConditionAbatementDateTime is the dateTime variant of ConditionAbatement.
+type ConditionAbatementDateTime struct {
+	DateTime
+}
+
+func (v ConditionAbatementDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ConditionAbatementDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ConditionAbatementDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ConditionAbatementDateTime) isConditionAbatement() {}
+
+// ConditionAbatementAge is the Age variant of ConditionAbatement.
+type ConditionAbatementAge struct {
+	Age
+}
+
+func (v ConditionAbatementAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ConditionAbatementAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ConditionAbatementAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ConditionAbatementAge) isConditionAbatement() {}
+
+// ConditionAbatementPeriod is the Period variant of ConditionAbatement.
+type ConditionAbatementPeriod struct {
+	Period
+}
+
+func (v ConditionAbatementPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ConditionAbatementPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ConditionAbatementPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ConditionAbatementPeriod) isConditionAbatement() {}
+
+// ConditionAbatementRange is the Range variant of ConditionAbatement.
+type ConditionAbatementRange struct {
+	Range
+}
+
+func (v ConditionAbatementRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ConditionAbatementRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ConditionAbatementRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ConditionAbatementRange) isConditionAbatement() {}
+
+// ConditionAbatementString is the string variant of ConditionAbatement.
+type ConditionAbatementString string
+
+func (v ConditionAbatementString) Validate() error {
+	return nil
+}
+
+func (v ConditionAbatementString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ConditionAbatementString) FHIRType() string {
+	return "string"
+}
+
+func (v ConditionAbatementString) isConditionAbatement() {}
+
+var conditionAbatementVariants = []ConditionAbatement{
+	ConditionAbatementDateTime{},
+	ConditionAbatementAge{},
+	ConditionAbatementPeriod{},
+	ConditionAbatementRange{},
+	ConditionAbatementString(""),
+}
+
+func (r *Condition) GetResourceType() string {
+	return "Condition"
+}
+
+func (r *Condition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Condition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Condition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Condition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Condition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Condition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Condition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Condition)(nil)
+
 type ConditionStage struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Summary    *CodeableConcept `json:"summary,omitempty" bson:"summary,omitempty"`       // Simple summary (disease specific)
-	Assessment []Reference      `json:"assessment,omitempty" bson:"assessment,omitempty"` // Formal record of assessment
-	Type       *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`             // Kind of staging
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Summary           *CodeableConcept `json:"summary,omitempty" bson:"summary,omitempty"`                      // Simple summary (disease specific)
+	Assessment        []Reference      `json:"assessment,omitempty" bson:"assessment,omitempty"`                // Formal record of assessment
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Kind of staging
 }
 
 func (r *ConditionStage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Summary != nil {
 		if err := r.Summary.Validate(); err != nil {
 			return fmt.Errorf("Summary: %w", err)
@@ -195,3 +796,58 @@ func (r *ConditionStage) Validate() error {
 	}
 	return nil
 }
+
+func (r *ConditionStage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Summary != nil {
+		r.Summary.validateAll(path+".summary", issues)
+	}
+	for i, item := range r.Assessment {
+		item.validateAll(fmt.Sprintf("%s.assessment[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+}
+
+var conditionStageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConditionStage) invariants() []invariant {
+	return conditionStageInvariants
+}
+
+func (r ConditionStage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConditionStage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConditionStage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConditionStage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var conditionStageElementMetadata = []ElementMetadata{
+	{Path: "Condition.stage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Condition.stage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Condition.stage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Condition.stage.summary", Name: "summary", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Condition.stage.assessment", Name: "assessment", Field: 4, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Condition.stage.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ConditionStage) ElementMetadata() []ElementMetadata {
+	return conditionStageElementMetadata
+}
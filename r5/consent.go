@@ -2,38 +2,56 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A record of a healthcare consumer’s  choices  or choices made on their behalf by a third party, which permits or denies identified recipient(s) or recipient role(s) to perform one or more actions within a given policy context, for specific purposes and periods of time.
 type Consent struct {
-	ResourceType     string                `json:"resourceType" bson:"resource_type"`                             // Type of resource
-	Id               *string               `json:"id,omitempty" bson:"id,omitempty"`                              // Logical id of this artifact
-	Meta             *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                          // Metadata about the resource
-	ImplicitRules    *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`       // A set of rules under which this content was created
-	Language         *string               `json:"language,omitempty" bson:"language,omitempty"`                  // Language of the resource content
-	Text             *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                          // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                // Contained, inline Resources
-	Identifier       []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`              // Identifier for this record (external references)
-	Status           string                `json:"status" bson:"status"`                                          // draft | active | inactive | not-done | entered-in-error | unknown
-	Category         []CodeableConcept     `json:"category,omitempty" bson:"category,omitempty"`                  // Classification of the consent statement - for indexing/retrieval
-	Subject          *Reference            `json:"subject,omitempty" bson:"subject,omitempty"`                    // Who the consent applies to
-	Date             *string               `json:"date,omitempty" bson:"date,omitempty"`                          // Fully executed date of the consent
-	Period           *Period               `json:"period,omitempty" bson:"period,omitempty"`                      // Effective period for this Consent
-	Grantor          []Reference           `json:"grantor,omitempty" bson:"grantor,omitempty"`                    // Who is granting rights according to the policy and rules
-	Grantee          []Reference           `json:"grantee,omitempty" bson:"grantee,omitempty"`                    // Who is agreeing to the policy and rules
-	Manager          []Reference           `json:"manager,omitempty" bson:"manager,omitempty"`                    // Consent workflow management
-	Controller       []Reference           `json:"controller,omitempty" bson:"controller,omitempty"`              // Consent Enforcer
-	SourceAttachment []Attachment          `json:"sourceAttachment,omitempty" bson:"source_attachment,omitempty"` // Source from which this consent is taken
-	SourceReference  []Reference           `json:"sourceReference,omitempty" bson:"source_reference,omitempty"`   // Source from which this consent is taken
-	RegulatoryBasis  []CodeableConcept     `json:"regulatoryBasis,omitempty" bson:"regulatory_basis,omitempty"`   // Regulations establishing base Consent
-	PolicyBasis      *ConsentPolicyBasis   `json:"policyBasis,omitempty" bson:"policy_basis,omitempty"`           // Computable version of the backing policy
-	PolicyText       []Reference           `json:"policyText,omitempty" bson:"policy_text,omitempty"`             // Human Readable Policy
-	Verification     []ConsentVerification `json:"verification,omitempty" bson:"verification,omitempty"`          // Consent Verified by patient or family
-	Decision         *string               `json:"decision,omitempty" bson:"decision,omitempty"`                  // deny | permit
-	Provision        []ConsentProvision    `json:"provision,omitempty" bson:"provision,omitempty"`                // Constraints to the base Consent.policyRule/Consent.policy
+	ResourceType         string                `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string               `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element              `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource            `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Identifier for this record (external references)
+	Status               ConsentState          `json:"status" bson:"status"`                                             // draft | active | inactive | not-done | entered-in-error | unknown
+	StatusElement        *Element              `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Category             []CodeableConcept     `json:"category,omitempty" bson:"category,omitempty"`                     // Classification of the consent statement - for indexing/retrieval
+	Subject              *Reference            `json:"subject,omitempty" bson:"subject,omitempty"`                       // Who the consent applies to
+	Date                 *Date                 `json:"date,omitempty" bson:"date,omitempty"`                             // Fully executed date of the consent
+	DateElement          *Element              `json:"_date,omitempty" bson:"date_element,omitempty"`                    // Extensions for date
+	Period               *Period               `json:"period,omitempty" bson:"period,omitempty"`                         // Effective period for this Consent
+	Grantor              []Reference           `json:"grantor,omitempty" bson:"grantor,omitempty"`                       // Who is granting rights according to the policy and rules
+	Grantee              []Reference           `json:"grantee,omitempty" bson:"grantee,omitempty"`                       // Who is agreeing to the policy and rules
+	Manager              []Reference           `json:"manager,omitempty" bson:"manager,omitempty"`                       // Consent workflow management
+	Controller           []Reference           `json:"controller,omitempty" bson:"controller,omitempty"`                 // Consent Enforcer
+	SourceAttachment     []Attachment          `json:"sourceAttachment,omitempty" bson:"source_attachment,omitempty"`    // Source from which this consent is taken
+	SourceReference      []Reference           `json:"sourceReference,omitempty" bson:"source_reference,omitempty"`      // Source from which this consent is taken
+	RegulatoryBasis      []CodeableConcept     `json:"regulatoryBasis,omitempty" bson:"regulatory_basis,omitempty"`      // Regulations establishing base Consent
+	PolicyBasis          *ConsentPolicyBasis   `json:"policyBasis,omitempty" bson:"policy_basis,omitempty"`              // Computable version of the backing policy
+	PolicyText           []Reference           `json:"policyText,omitempty" bson:"policy_text,omitempty"`                // Human Readable Policy
+	Verification         []ConsentVerification `json:"verification,omitempty" bson:"verification,omitempty"`             // Consent Verified by patient or family
+	Decision             *ConsentProvisionType `json:"decision,omitempty" bson:"decision,omitempty"`                     // deny | permit
+	DecisionElement      *Element              `json:"_decision,omitempty" bson:"decision_element,omitempty"`            // Extensions for decision
+	Provision            []ConsentProvision    `json:"provision,omitempty" bson:"provision,omitempty"`                   // Constraints to the base Consent.policyRule/Consent.policy
 }
 
+var consentSubjectTargets = []string{"Group", "Patient", "Practitioner", "ResearchSubject"}
+
+var consentGranteeTargets = []string{"CareTeam", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var consentManagerTargets = []string{"HealthcareService", "Organization", "Patient", "Practitioner"}
+
+var consentControllerTargets = []string{"HealthcareService", "Organization", "Patient", "Practitioner"}
+
+var consentSourceReferenceTargets = []string{"Consent", "Contract", "DocumentReference", "QuestionnaireResponse"}
+
 func (r *Consent) Validate() error {
 	if r.ResourceType != "Consent" {
 		return fmt.Errorf("invalid resourceType: expected 'Consent', got '%s'", r.ResourceType)
@@ -43,30 +61,80 @@ func (r *Consent) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, consentSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, consentSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -77,16 +145,31 @@ func (r *Consent) Validate() error {
 			return fmt.Errorf("Grantor[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Grantee {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentGranteeTargets); target != "" {
+			return fmt.Errorf("field 'Grantee[%d]' cannot refer to %s, expected one of %v", i, target, consentGranteeTargets)
+		}
+	}
 	for i, item := range r.Grantee {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Grantee[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Manager {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentManagerTargets); target != "" {
+			return fmt.Errorf("field 'Manager[%d]' cannot refer to %s, expected one of %v", i, target, consentManagerTargets)
+		}
+	}
 	for i, item := range r.Manager {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Manager[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Controller {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentControllerTargets); target != "" {
+			return fmt.Errorf("field 'Controller[%d]' cannot refer to %s, expected one of %v", i, target, consentControllerTargets)
+		}
+	}
 	for i, item := range r.Controller {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Controller[%d]: %w", i, err)
@@ -97,6 +180,11 @@ func (r *Consent) Validate() error {
 			return fmt.Errorf("SourceAttachment[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.SourceReference {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentSourceReferenceTargets); target != "" {
+			return fmt.Errorf("field 'SourceReference[%d]' cannot refer to %s, expected one of %v", i, target, consentSourceReferenceTargets)
+		}
+	}
 	for i, item := range r.SourceReference {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("SourceReference[%d]: %w", i, err)
@@ -122,24 +210,405 @@ func (r *Consent) Validate() error {
 			return fmt.Errorf("Verification[%d]: %w", i, err)
 		}
 	}
+	if r.Decision != nil && !r.Decision.IsValid() {
+		return fmt.Errorf("field 'Decision' has invalid code '%s'", *r.Decision)
+	}
+	if r.DecisionElement != nil {
+		if err := r.DecisionElement.Validate(); err != nil {
+			return fmt.Errorf("DecisionElement: %w", err)
+		}
+	}
 	for i, item := range r.Provision {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Provision[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Consent")
+}
+
+func (r *Consent) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Consent" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Consent', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, consentSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, consentSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Grantor {
+		item.validateAll(fmt.Sprintf("%s.grantor[%d]", path, i), issues)
+	}
+	for i, item := range r.Grantee {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentGranteeTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.grantee[%d]", path, i), fmt.Sprintf("field 'Grantee[%d]' cannot refer to %s, expected one of %v", i, target, consentGranteeTargets))
+		}
+	}
+	for i, item := range r.Grantee {
+		item.validateAll(fmt.Sprintf("%s.grantee[%d]", path, i), issues)
+	}
+	for i, item := range r.Manager {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentManagerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.manager[%d]", path, i), fmt.Sprintf("field 'Manager[%d]' cannot refer to %s, expected one of %v", i, target, consentManagerTargets))
+		}
+	}
+	for i, item := range r.Manager {
+		item.validateAll(fmt.Sprintf("%s.manager[%d]", path, i), issues)
+	}
+	for i, item := range r.Controller {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentControllerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.controller[%d]", path, i), fmt.Sprintf("field 'Controller[%d]' cannot refer to %s, expected one of %v", i, target, consentControllerTargets))
+		}
+	}
+	for i, item := range r.Controller {
+		item.validateAll(fmt.Sprintf("%s.controller[%d]", path, i), issues)
+	}
+	for i, item := range r.SourceAttachment {
+		item.validateAll(fmt.Sprintf("%s.sourceAttachment[%d]", path, i), issues)
+	}
+	for i, item := range r.SourceReference {
+		if target := invalidReferenceTarget(item.Reference, item.Type, consentSourceReferenceTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.sourceReference[%d]", path, i), fmt.Sprintf("field 'SourceReference[%d]' cannot refer to %s, expected one of %v", i, target, consentSourceReferenceTargets))
+		}
+	}
+	for i, item := range r.SourceReference {
+		item.validateAll(fmt.Sprintf("%s.sourceReference[%d]", path, i), issues)
+	}
+	for i, item := range r.RegulatoryBasis {
+		item.validateAll(fmt.Sprintf("%s.regulatoryBasis[%d]", path, i), issues)
+	}
+	if r.PolicyBasis != nil {
+		r.PolicyBasis.validateAll(path+".policyBasis", issues)
+	}
+	for i, item := range r.PolicyText {
+		item.validateAll(fmt.Sprintf("%s.policyText[%d]", path, i), issues)
+	}
+	for i, item := range r.Verification {
+		item.validateAll(fmt.Sprintf("%s.verification[%d]", path, i), issues)
+	}
+	if r.Decision != nil && !r.Decision.IsValid() {
+		issues.add("code-invalid", path+".decision", fmt.Sprintf("field 'Decision' has invalid code '%s'", *r.Decision))
+	}
+	if r.DecisionElement != nil {
+		r.DecisionElement.validateAll(path+".decision", issues)
+	}
+	for i, item := range r.Provision {
+		item.validateAll(fmt.Sprintf("%s.provision[%d]", path, i), issues)
+	}
+}
+
+func (r *Consent) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Consent", &issues)
+	checkInvariants(r, "Consent", &issues)
+	return issues
+}
+
+var consentInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Consent) invariants() []invariant {
+	return consentInvariants
+}
+
+func (r *Consent) UnmarshalJSON(data []byte) error {
+	type alias Consent
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	return nil
+}
+
+func (r Consent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Consent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Consent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Consent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var consentElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Consent) elementTypes() map[string]string {
+	return consentElementTypes
+}
+
+var consentElementMetadata = []ElementMetadata{
+	{Path: "Consent.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Consent.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Consent.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Consent.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Consent.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Consent.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Consent.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Consent.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Consent.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Consent.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Consent.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Consent.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/consent-state-codes"}},
+	{Path: "Consent.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Consent.subject", Name: "subject", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/ResearchSubject"}},
+	{Path: "Consent.date", Name: "date", Field: 16, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Consent.period", Name: "period", Field: 18, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Consent.grantor", Name: "grantor", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Consent.grantee", Name: "grantee", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Consent.manager", Name: "manager", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner"}},
+	{Path: "Consent.controller", Name: "controller", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner"}},
+	{Path: "Consent.sourceAttachment", Name: "sourceAttachment", Field: 23, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "Consent.sourceReference", Name: "sourceReference", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse"}},
+	{Path: "Consent.regulatoryBasis", Name: "regulatoryBasis", Field: 25, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Consent.policyBasis", Name: "policyBasis", Field: 26, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Consent.policyText", Name: "policyText", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Consent.verification", Name: "verification", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Consent.decision", Name: "decision", Field: 29, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Consent.decision", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/consent-provision-type"}},
+	{Path: "Consent.provision", Name: "provision", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Consent) ElementMetadata() []ElementMetadata {
+	return consentElementMetadata
+}
+
+func (r *Consent) GetResourceType() string {
+	return "Consent"
+}
+
+func (r *Consent) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Consent) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Consent) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Consent) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Consent) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Consent) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Consent) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Consent)(nil)
+
+type ConsentPolicyBasis struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         *Reference  `json:"reference,omitempty" bson:"reference,omitempty"`                  // Reference backing policy resource
+	Uri               *string     `json:"uri,omitempty" bson:"uri,omitempty"`                              // URI to a computable backing policy
+	UriElement        *Element    `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+}
+
+func (r *ConsentPolicyBasis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Reference != nil {
+		if err := r.Reference.Validate(); err != nil {
+			return fmt.Errorf("Reference: %w", err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *ConsentPolicyBasis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+}
+
+var consentPolicyBasisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConsentPolicyBasis) invariants() []invariant {
+	return consentPolicyBasisInvariants
+}
+
+func (r ConsentPolicyBasis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConsentPolicyBasis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConsentPolicyBasis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConsentPolicyBasis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var consentPolicyBasisElementTypes = map[string]string{
+	"uri": "uri",
+}
+
+func (r *ConsentPolicyBasis) elementTypes() map[string]string {
+	return consentPolicyBasisElementTypes
+}
+
+var consentPolicyBasisElementMetadata = []ElementMetadata{
+	{Path: "Consent.policyBasis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Consent.policyBasis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Consent.policyBasis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Consent.policyBasis.reference", Name: "reference", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Consent.policyBasis.uri", Name: "uri", Field: 4, Min: 0, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *ConsentPolicyBasis) ElementMetadata() []ElementMetadata {
+	return consentPolicyBasisElementMetadata
+}
+
 type ConsentVerification struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Verified     bool             `json:"verified" bson:"verified"`                              // Has been verified
-	Type         *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                  // Business case of verification
-	VerifiedBy   *Reference       `json:"verifiedBy,omitempty" bson:"verified_by,omitempty"`     // Person conducting verification
-	VerifiedWith *Reference       `json:"verifiedWith,omitempty" bson:"verified_with,omitempty"` // Person who verified
-	Date         []string         `json:"date,omitempty" bson:"date,omitempty"`                  // When consent verified
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Verified          bool             `json:"verified" bson:"verified"`                                        // Has been verified
+	VerifiedElement   *Element         `json:"_verified,omitempty" bson:"verified_element,omitempty"`           // Extensions for verified
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Business case of verification
+	VerifiedBy        *Reference       `json:"verifiedBy,omitempty" bson:"verified_by,omitempty"`               // Person conducting verification
+	VerifiedWith      *Reference       `json:"verifiedWith,omitempty" bson:"verified_with,omitempty"`           // Person who verified
+	Date              []DateTime       `json:"date,omitempty" bson:"date,omitempty"`                            // When consent verified
+	DateElement       []*Element       `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
 }
 
 func (r *ConsentVerification) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.VerifiedElement != nil {
+		if err := r.VerifiedElement.Validate(); err != nil {
+			return fmt.Errorf("VerifiedElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -155,26 +624,149 @@ func (r *ConsentVerification) Validate() error {
 			return fmt.Errorf("VerifiedWith: %w", err)
 		}
 	}
+	for i, item := range r.Date {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Date[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DateElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DateElement[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
+func (r *ConsentVerification) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.VerifiedElement != nil {
+		r.VerifiedElement.validateAll(path+".verified", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.VerifiedBy != nil {
+		r.VerifiedBy.validateAll(path+".verifiedBy", issues)
+	}
+	if r.VerifiedWith != nil {
+		r.VerifiedWith.validateAll(path+".verifiedWith", issues)
+	}
+	for i, item := range r.Date {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.date[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.DateElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.date[%d]", path, i), issues)
+	}
+}
+
+var consentVerificationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConsentVerification) invariants() []invariant {
+	return consentVerificationInvariants
+}
+
+func (r ConsentVerification) MarshalJSON() ([]byte, error) {
+	type alias ConsentVerification
+	out := struct {
+		alias
+		Date        []*DateTime `json:"date,omitempty"`
+		DateElement []*Element  `json:"_date,omitempty"`
+	}{alias: alias(r)}
+	out.Date, out.DateElement = alignPrimitiveArray(r.Date, r.DateElement)
+	return json.Marshal(out)
+}
+
+func (r *ConsentVerification) UnmarshalJSON(data []byte) error {
+	type alias ConsentVerification
+	aux := struct {
+		*alias
+		Date []*DateTime `json:"date,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Date, err = splitPrimitiveArray(aux.Date, r.DateElement); err != nil {
+		return fmt.Errorf("date: %w", err)
+	}
+	return nil
+}
+
+func (r ConsentVerification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConsentVerification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConsentVerification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConsentVerification) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var consentVerificationElementMetadata = []ElementMetadata{
+	{Path: "Consent.verification.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Consent.verification.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Consent.verification.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Consent.verification.verified", Name: "verified", Field: 3, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "Consent.verification.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Consent.verification.verifiedBy", Name: "verifiedBy", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Consent.verification.verifiedWith", Name: "verifiedWith", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Consent.verification.date", Name: "date", Field: 8, Min: 0, Max: "*", Types: []string{"dateTime"}},
+}
+
+func (r *ConsentVerification) ElementMetadata() []ElementMetadata {
+	return consentVerificationElementMetadata
+}
+
 type ConsentProvision struct {
-	Id            *string                 `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Period        *Period                 `json:"period,omitempty" bson:"period,omitempty"`                // Timeframe for this provision
-	Actor         []ConsentProvisionActor `json:"actor,omitempty" bson:"actor,omitempty"`                  // Who|what controlled by this provision (or group, by role)
-	Action        []CodeableConcept       `json:"action,omitempty" bson:"action,omitempty"`                // Actions controlled by this provision
-	SecurityLabel []Coding                `json:"securityLabel,omitempty" bson:"security_label,omitempty"` // Security Labels that define affected resources
-	Purpose       []Coding                `json:"purpose,omitempty" bson:"purpose,omitempty"`              // Context of activities covered by this provision
-	DocumentType  []Coding                `json:"documentType,omitempty" bson:"document_type,omitempty"`   // e.g. Resource Type, Profile, CDA, etc
-	ResourceType  []Coding                `json:"resourceType,omitempty" bson:"resource_type,omitempty"`   // e.g. Resource Type, Profile, etc
-	Code          []CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                    // e.g. LOINC or SNOMED CT code, etc. in the content
-	DataPeriod    *Period                 `json:"dataPeriod,omitempty" bson:"data_period,omitempty"`       // Timeframe for data controlled by this provision
-	Data          []ConsentProvisionData  `json:"data,omitempty" bson:"data,omitempty"`                    // Data controlled by this provision
-	Expression    *Expression             `json:"expression,omitempty" bson:"expression,omitempty"`        // A computable expression of the consent
-	Provision     []ConsentProvision      `json:"provision,omitempty" bson:"provision,omitempty"`          // Nested Exception Provisions
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Period            *Period                 `json:"period,omitempty" bson:"period,omitempty"`                        // Timeframe for this provision
+	Actor             []ConsentProvisionActor `json:"actor,omitempty" bson:"actor,omitempty"`                          // Who|what controlled by this provision (or group, by role)
+	Action            []CodeableConcept       `json:"action,omitempty" bson:"action,omitempty"`                        // Actions controlled by this provision
+	SecurityLabel     []Coding                `json:"securityLabel,omitempty" bson:"security_label,omitempty"`         // Security Labels that define affected resources
+	Purpose           []Coding                `json:"purpose,omitempty" bson:"purpose,omitempty"`                      // Context of activities covered by this provision
+	DocumentType      []Coding                `json:"documentType,omitempty" bson:"document_type,omitempty"`           // e.g. Resource Type, Profile, CDA, etc
+	ResourceType      []Coding                `json:"resourceType,omitempty" bson:"resource_type,omitempty"`           // e.g. Resource Type, Profile, etc
+	Code              []CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                            // e.g. LOINC or SNOMED CT code, etc. in the content
+	DataPeriod        *Period                 `json:"dataPeriod,omitempty" bson:"data_period,omitempty"`               // Timeframe for data controlled by this provision
+	Data              []ConsentProvisionData  `json:"data,omitempty" bson:"data,omitempty"`                            // Data controlled by this provision
+	Expression        *Expression             `json:"expression,omitempty" bson:"expression,omitempty"`                // A computable expression of the consent
+	Provision         []ConsentProvision      `json:"provision,omitempty" bson:"provision,omitempty"`                  // Nested Exception Provisions
 }
 
 func (r *ConsentProvision) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -238,13 +830,116 @@ func (r *ConsentProvision) Validate() error {
 	return nil
 }
 
+func (r *ConsentProvision) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Actor {
+		item.validateAll(fmt.Sprintf("%s.actor[%d]", path, i), issues)
+	}
+	for i, item := range r.Action {
+		item.validateAll(fmt.Sprintf("%s.action[%d]", path, i), issues)
+	}
+	for i, item := range r.SecurityLabel {
+		item.validateAll(fmt.Sprintf("%s.securityLabel[%d]", path, i), issues)
+	}
+	for i, item := range r.Purpose {
+		item.validateAll(fmt.Sprintf("%s.purpose[%d]", path, i), issues)
+	}
+	for i, item := range r.DocumentType {
+		item.validateAll(fmt.Sprintf("%s.documentType[%d]", path, i), issues)
+	}
+	for i, item := range r.ResourceType {
+		item.validateAll(fmt.Sprintf("%s.resourceType[%d]", path, i), issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	if r.DataPeriod != nil {
+		r.DataPeriod.validateAll(path+".dataPeriod", issues)
+	}
+	for i, item := range r.Data {
+		item.validateAll(fmt.Sprintf("%s.data[%d]", path, i), issues)
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+	for i, item := range r.Provision {
+		item.validateAll(fmt.Sprintf("%s.provision[%d]", path, i), issues)
+	}
+}
+
+var consentProvisionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConsentProvision) invariants() []invariant {
+	return consentProvisionInvariants
+}
+
+func (r ConsentProvision) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConsentProvision) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConsentProvision) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConsentProvision) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var consentProvisionElementMetadata = []ElementMetadata{
+	{Path: "Consent.provision.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Consent.provision.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Consent.provision.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Consent.provision.period", Name: "period", Field: 3, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Consent.provision.actor", Name: "actor", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Consent.provision.action", Name: "action", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Consent.provision.securityLabel", Name: "securityLabel", Field: 6, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Consent.provision.purpose", Name: "purpose", Field: 7, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Consent.provision.documentType", Name: "documentType", Field: 8, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Consent.provision.resourceType", Name: "resourceType", Field: 9, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Consent.provision.code", Name: "code", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Consent.provision.dataPeriod", Name: "dataPeriod", Field: 11, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Consent.provision.data", Name: "data", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Consent.provision.expression", Name: "expression", Field: 13, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "Consent.provision.provision", Name: "provision", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ConsentProvision) ElementMetadata() []ElementMetadata {
+	return consentProvisionElementMetadata
+}
+
 type ConsentProvisionActor struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Role      *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`           // How the actor is involved
-	Reference *Reference       `json:"reference,omitempty" bson:"reference,omitempty"` // Resource for the actor (or group, by role)
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Role              *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // How the actor is involved
+	Reference         *Reference       `json:"reference,omitempty" bson:"reference,omitempty"`                  // Resource for the actor (or group, by role)
 }
 
 func (r *ConsentProvisionActor) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Role != nil {
 		if err := r.Role.Validate(); err != nil {
 			return fmt.Errorf("Role: %w", err)
@@ -258,17 +953,88 @@ func (r *ConsentProvisionActor) Validate() error {
 	return nil
 }
 
+func (r *ConsentProvisionActor) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+}
+
+var consentProvisionActorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConsentProvisionActor) invariants() []invariant {
+	return consentProvisionActorInvariants
+}
+
+func (r ConsentProvisionActor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConsentProvisionActor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConsentProvisionActor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConsentProvisionActor) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var consentProvisionActorElementMetadata = []ElementMetadata{
+	{Path: "Consent.provision.actor.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Consent.provision.actor.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Consent.provision.actor.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Consent.provision.actor.role", Name: "role", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Consent.provision.actor.reference", Name: "reference", Field: 4, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ConsentProvisionActor) ElementMetadata() []ElementMetadata {
+	return consentProvisionActorElementMetadata
+}
+
 type ConsentProvisionData struct {
-	Id        *string    `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Meaning   string     `json:"meaning" bson:"meaning"`           // instance | related | dependents | authoredby
-	Reference *Reference `json:"reference" bson:"reference"`       // The actual data reference
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Meaning           ConsentDataMeaning `json:"meaning" bson:"meaning"`                                          // instance | related | dependents | authoredby
+	MeaningElement    *Element           `json:"_meaning,omitempty" bson:"meaning_element,omitempty"`             // Extensions for meaning
+	Reference         *Reference         `json:"reference" bson:"reference"`                                      // The actual data reference
 }
 
 func (r *ConsentProvisionData) Validate() error {
-	var emptyString string
-	if r.Meaning == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Meaning == "" {
 		return fmt.Errorf("field 'Meaning' is required")
 	}
+	if !r.Meaning.IsValid() {
+		return fmt.Errorf("field 'Meaning' has invalid code '%s'", r.Meaning)
+	}
+	if r.MeaningElement != nil {
+		if err := r.MeaningElement.Validate(); err != nil {
+			return fmt.Errorf("MeaningElement: %w", err)
+		}
+	}
 	if r.Reference == nil {
 		return fmt.Errorf("field 'Reference' is required")
 	}
@@ -280,17 +1046,62 @@ func (r *ConsentProvisionData) Validate() error {
 	return nil
 }
 
-type ConsentPolicyBasis struct {
-	Id        *string    `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Reference *Reference `json:"reference,omitempty" bson:"reference,omitempty"` // Reference backing policy resource
-	Uri       *string    `json:"uri,omitempty" bson:"uri,omitempty"`             // URI to a computable backing policy
-}
-
-func (r *ConsentPolicyBasis) Validate() error {
+func (r *ConsentProvisionData) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Meaning == "" {
+		issues.add("required", path+".meaning", "field 'Meaning' is required")
+	}
+	if r.Meaning != "" && !r.Meaning.IsValid() {
+		issues.add("code-invalid", path+".meaning", fmt.Sprintf("field 'Meaning' has invalid code '%s'", r.Meaning))
+	}
+	if r.MeaningElement != nil {
+		r.MeaningElement.validateAll(path+".meaning", issues)
+	}
+	if r.Reference == nil {
+		issues.add("required", path+".reference", "field 'Reference' is required")
+	}
 	if r.Reference != nil {
-		if err := r.Reference.Validate(); err != nil {
-			return fmt.Errorf("Reference: %w", err)
-		}
+		r.Reference.validateAll(path+".reference", issues)
 	}
-	return nil
+}
+
+var consentProvisionDataInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ConsentProvisionData) invariants() []invariant {
+	return consentProvisionDataInvariants
+}
+
+func (r ConsentProvisionData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ConsentProvisionData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ConsentProvisionData) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ConsentProvisionData) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var consentProvisionDataElementMetadata = []ElementMetadata{
+	{Path: "Consent.provision.data.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Consent.provision.data.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Consent.provision.data.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Consent.provision.data.meaning", Name: "meaning", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Consent.provision.data.meaning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/consent-data-meaning"}},
+	{Path: "Consent.provision.data.reference", Name: "reference", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ConsentProvisionData) ElementMetadata() []ElementMetadata {
+	return consentProvisionDataElementMetadata
 }
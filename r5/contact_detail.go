@@ -1,17 +1,30 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // ContactDetail Type: Specifies contact information for a person or organization.
 type ContactDetail struct {
-	Id      *string        `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Name    *string        `json:"name,omitempty" bson:"name,omitempty"`       // Name of an individual to contact
-	Telecom []ContactPoint `json:"telecom,omitempty" bson:"telecom,omitempty"` // Contact details for individual or organization
+	Id          *string        `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension   []Extension    `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Name        *string        `json:"name,omitempty" bson:"name,omitempty"`           // Name of an individual to contact
+	NameElement *Element       `json:"_name,omitempty" bson:"name_element,omitempty"`  // Extensions for name
+	Telecom     []ContactPoint `json:"telecom,omitempty" bson:"telecom,omitempty"`     // Contact details for individual or organization
 }
 
 func (r *ContactDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	for i, item := range r.Telecom {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Telecom[%d]: %w", i, err)
@@ -19,3 +32,57 @@ func (r *ContactDetail) Validate() error {
 	}
 	return nil
 }
+
+func (r *ContactDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+}
+
+func (r *ContactDetail) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ContactDetail", &issues)
+	checkInvariants(r, "ContactDetail", &issues)
+	return issues
+}
+
+var contactDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContactDetail) invariants() []invariant {
+	return contactDetailInvariants
+}
+
+func (r ContactDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContactDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContactDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContactDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contactDetailElementMetadata = []ElementMetadata{
+	{Path: "ContactDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ContactDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ContactDetail.name", Name: "name", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ContactDetail.telecom", Name: "telecom", Field: 4, Min: 0, Max: "*", Types: []string{"ContactPoint"}, Summary: true},
+}
+
+func (r *ContactDetail) ElementMetadata() []ElementMetadata {
+	return contactDetailElementMetadata
+}
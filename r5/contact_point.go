@@ -1,20 +1,57 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // ContactPoint Type: Details for all kinds of technology mediated contact points for a person or organization, including telephone, email, etc.
 type ContactPoint struct {
-	Id     *string `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	System *string `json:"system,omitempty" bson:"system,omitempty"` // phone | fax | email | pager | url | sms | other
-	Value  *string `json:"value,omitempty" bson:"value,omitempty"`   // The actual contact point details
-	Use    *string `json:"use,omitempty" bson:"use,omitempty"`       // home | work | temp | old | mobile - purpose of this contact point
-	Rank   *int    `json:"rank,omitempty" bson:"rank,omitempty"`     // Specify preferred order of use (1 = highest)
-	Period *Period `json:"period,omitempty" bson:"period,omitempty"` // Time period when the contact point was/is in use
+	Id            *string             `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
+	Extension     []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`    // Additional content defined by implementations
+	System        *ContactPointSystem `json:"system,omitempty" bson:"system,omitempty"`          // phone | fax | email | pager | url | sms | other
+	SystemElement *Element            `json:"_system,omitempty" bson:"system_element,omitempty"` // Extensions for system
+	Value         *string             `json:"value,omitempty" bson:"value,omitempty"`            // The actual contact point details
+	ValueElement  *Element            `json:"_value,omitempty" bson:"value_element,omitempty"`   // Extensions for value
+	Use           *ContactPointUse    `json:"use,omitempty" bson:"use,omitempty"`                // home | work | temp | old | mobile - purpose of this contact point
+	UseElement    *Element            `json:"_use,omitempty" bson:"use_element,omitempty"`       // Extensions for use
+	Rank          *int                `json:"rank,omitempty" bson:"rank,omitempty"`              // Specify preferred order of use (1 = highest)
+	RankElement   *Element            `json:"_rank,omitempty" bson:"rank_element,omitempty"`     // Extensions for rank
+	Period        *Period             `json:"period,omitempty" bson:"period,omitempty"`          // Time period when the contact point was/is in use
 }
 
 func (r *ContactPoint) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.System != nil && !r.System.IsValid() {
+		return fmt.Errorf("field 'System' has invalid code '%s'", *r.System)
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", *r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
+	if r.RankElement != nil {
+		if err := r.RankElement.Validate(); err != nil {
+			return fmt.Errorf("RankElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -22,3 +59,84 @@ func (r *ContactPoint) Validate() error {
 	}
 	return nil
 }
+
+func (r *ContactPoint) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.System != nil && !r.System.IsValid() {
+		issues.add("code-invalid", path+".system", fmt.Sprintf("field 'System' has invalid code '%s'", *r.System))
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", *r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	if r.RankElement != nil {
+		r.RankElement.validateAll(path+".rank", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+func (r *ContactPoint) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ContactPoint", &issues)
+	checkInvariants(r, "ContactPoint", &issues)
+	return issues
+}
+
+var contactPointInvariants = []invariant{
+	{key: "cpt-2", severity: "error", human: "A system is required if a value is provided.", expression: "value.empty() or system.exists()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContactPoint) invariants() []invariant {
+	return contactPointInvariants
+}
+
+func (r ContactPoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContactPoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContactPoint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContactPoint) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contactPointElementTypes = map[string]string{
+	"rank": "positiveInt",
+}
+
+func (r *ContactPoint) elementTypes() map[string]string {
+	return contactPointElementTypes
+}
+
+var contactPointElementMetadata = []ElementMetadata{
+	{Path: "ContactPoint.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ContactPoint.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ContactPoint.system", Name: "system", Field: 2, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ContactPoint.system", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/contact-point-system", Description: "Telecommunications form for contact point."}, Summary: true},
+	{Path: "ContactPoint.value", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ContactPoint.use", Name: "use", Field: 6, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ContactPoint.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/contact-point-use", Description: "Use of contact point."}, Summary: true, Modifier: true},
+	{Path: "ContactPoint.rank", Name: "rank", Field: 8, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "ContactPoint.period", Name: "period", Field: 10, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+}
+
+func (r *ContactPoint) ElementMetadata() []ElementMetadata {
+	return contactPointElementMetadata
+}
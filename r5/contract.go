@@ -2,55 +2,74 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Legally enforceable, formally recorded unilateral or bilateral directive i.e., a policy or agreement.
 type Contract struct {
-	ResourceType             string                     `json:"resourceType" bson:"resource_type"`                                              // Type of resource
-	Id                       *string                    `json:"id,omitempty" bson:"id,omitempty"`                                               // Logical id of this artifact
-	Meta                     *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                                           // Metadata about the resource
-	ImplicitRules            *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                        // A set of rules under which this content was created
-	Language                 *string                    `json:"language,omitempty" bson:"language,omitempty"`                                   // Language of the resource content
-	Text                     *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                                           // Text summary of the resource, for human interpretation
-	Contained                []json.RawMessage          `json:"contained,omitempty" bson:"contained,omitempty"`                                 // Contained, inline Resources
-	Identifier               []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                               // Contract number
-	Url                      *string                    `json:"url,omitempty" bson:"url,omitempty"`                                             // Basal definition
-	Version                  *string                    `json:"version,omitempty" bson:"version,omitempty"`                                     // Business edition
-	Status                   *string                    `json:"status,omitempty" bson:"status,omitempty"`                                       // amended | appended | cancelled | disputed | entered-in-error | executable +
-	LegalState               *CodeableConcept           `json:"legalState,omitempty" bson:"legal_state,omitempty"`                              // Negotiation status
-	InstantiatesCanonical    *Reference                 `json:"instantiatesCanonical,omitempty" bson:"instantiates_canonical,omitempty"`        // Source Contract Definition
-	InstantiatesUri          *string                    `json:"instantiatesUri,omitempty" bson:"instantiates_uri,omitempty"`                    // External Contract Definition
-	ContentDerivative        *CodeableConcept           `json:"contentDerivative,omitempty" bson:"content_derivative,omitempty"`                // Content derived from the basal information
-	Issued                   *string                    `json:"issued,omitempty" bson:"issued,omitempty"`                                       // When this Contract was issued
-	Applies                  *Period                    `json:"applies,omitempty" bson:"applies,omitempty"`                                     // Effective time
-	ExpirationType           *CodeableConcept           `json:"expirationType,omitempty" bson:"expiration_type,omitempty"`                      // Contract cessation cause
-	Subject                  []Reference                `json:"subject,omitempty" bson:"subject,omitempty"`                                     // Contract Target Entity
-	Authority                []Reference                `json:"authority,omitempty" bson:"authority,omitempty"`                                 // Authority under which this Contract has standing
-	Domain                   []Reference                `json:"domain,omitempty" bson:"domain,omitempty"`                                       // A sphere of control governed by an authoritative jurisdiction, organization, or person
-	Site                     []Reference                `json:"site,omitempty" bson:"site,omitempty"`                                           // Specific Location
-	Name                     *string                    `json:"name,omitempty" bson:"name,omitempty"`                                           // Computer friendly designation
-	Title                    *string                    `json:"title,omitempty" bson:"title,omitempty"`                                         // Human Friendly name
-	Subtitle                 *string                    `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                                   // Subordinate Friendly name
-	Alias                    []string                   `json:"alias,omitempty" bson:"alias,omitempty"`                                         // Acronym or short name
-	Author                   *Reference                 `json:"author,omitempty" bson:"author,omitempty"`                                       // Source of Contract
-	Scope                    *CodeableConcept           `json:"scope,omitempty" bson:"scope,omitempty"`                                         // Range of Legal Concerns
-	TopicCodeableConcept     *CodeableConcept           `json:"topicCodeableConcept,omitempty" bson:"topic_codeable_concept,omitempty"`         // Focus of contract interest
-	TopicReference           *Reference                 `json:"topicReference,omitempty" bson:"topic_reference,omitempty"`                      // Focus of contract interest
-	Type                     *CodeableConcept           `json:"type,omitempty" bson:"type,omitempty"`                                           // Legal instrument category
-	SubType                  []CodeableConcept          `json:"subType,omitempty" bson:"sub_type,omitempty"`                                    // Subtype within the context of type
-	ContentDefinition        *ContractContentDefinition `json:"contentDefinition,omitempty" bson:"content_definition,omitempty"`                // Contract precursor content
-	Term                     []ContractTerm             `json:"term,omitempty" bson:"term,omitempty"`                                           // Contract Term List
-	SupportingInfo           []Reference                `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`                      // Extra Information
-	RelevantHistory          []Reference                `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`                    // Key event in Contract History
-	Signer                   []ContractSigner           `json:"signer,omitempty" bson:"signer,omitempty"`                                       // Contract Signatory
-	Friendly                 []ContractFriendly         `json:"friendly,omitempty" bson:"friendly,omitempty"`                                   // Contract Friendly Language
-	Legal                    []ContractLegal            `json:"legal,omitempty" bson:"legal,omitempty"`                                         // Contract Legal Language
-	Rule                     []ContractRule             `json:"rule,omitempty" bson:"rule,omitempty"`                                           // Computable Contract Language
-	LegallyBindingAttachment *Attachment                `json:"legallyBindingAttachment,omitempty" bson:"legally_binding_attachment,omitempty"` // Binding Contract
-	LegallyBindingReference  *Reference                 `json:"legallyBindingReference,omitempty" bson:"legally_binding_reference,omitempty"`   // Binding Contract
+	ResourceType           string                     `json:"resourceType" bson:"resource_type"`                                       // Type of resource
+	Id                     *string                    `json:"id,omitempty" bson:"id,omitempty"`                                        // Logical id of this artifact
+	Meta                   *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                                    // Metadata about the resource
+	ImplicitRules          *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                 // A set of rules under which this content was created
+	ImplicitRulesElement   *Element                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`        // Extensions for implicitRules
+	Language               *string                    `json:"language,omitempty" bson:"language,omitempty"`                            // Language of the resource content
+	LanguageElement        *Element                   `json:"_language,omitempty" bson:"language_element,omitempty"`                   // Extensions for language
+	Text                   *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                                    // Text summary of the resource, for human interpretation
+	Contained              []Resource                 `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Extension              []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension      []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored
+	Identifier             []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                        // Contract number
+	Url                    *string                    `json:"url,omitempty" bson:"url,omitempty"`                                      // Basal definition
+	UrlElement             *Element                   `json:"_url,omitempty" bson:"url_element,omitempty"`                             // Extensions for url
+	Version                *string                    `json:"version,omitempty" bson:"version,omitempty"`                              // Business edition
+	VersionElement         *Element                   `json:"_version,omitempty" bson:"version_element,omitempty"`                     // Extensions for version
+	Status                 *string                    `json:"status,omitempty" bson:"status,omitempty"`                                // amended | appended | cancelled | disputed | entered-in-error | executable +
+	StatusElement          *Element                   `json:"_status,omitempty" bson:"status_element,omitempty"`                       // Extensions for status
+	LegalState             *CodeableConcept           `json:"legalState,omitempty" bson:"legal_state,omitempty"`                       // Negotiation status
+	InstantiatesCanonical  *Reference                 `json:"instantiatesCanonical,omitempty" bson:"instantiates_canonical,omitempty"` // Source Contract Definition
+	InstantiatesUri        *string                    `json:"instantiatesUri,omitempty" bson:"instantiates_uri,omitempty"`             // External Contract Definition
+	InstantiatesUriElement *Element                   `json:"_instantiatesUri,omitempty" bson:"instantiates_uri_element,omitempty"`    // Extensions for instantiatesUri
+	ContentDerivative      *CodeableConcept           `json:"contentDerivative,omitempty" bson:"content_derivative,omitempty"`         // Content derived from the basal information
+	Issued                 *DateTime                  `json:"issued,omitempty" bson:"issued,omitempty"`                                // When this Contract was issued
+	IssuedElement          *Element                   `json:"_issued,omitempty" bson:"issued_element,omitempty"`                       // Extensions for issued
+	Applies                *Period                    `json:"applies,omitempty" bson:"applies,omitempty"`                              // Effective time
+	ExpirationType         *CodeableConcept           `json:"expirationType,omitempty" bson:"expiration_type,omitempty"`               // Contract cessation cause
+	Subject                []Reference                `json:"subject,omitempty" bson:"subject,omitempty"`                              // Contract Target Entity
+	Authority              []Reference                `json:"authority,omitempty" bson:"authority,omitempty"`                          // Authority under which this Contract has standing
+	Domain                 []Reference                `json:"domain,omitempty" bson:"domain,omitempty"`                                // A sphere of control governed by an authoritative jurisdiction, organization, or person
+	Site                   []Reference                `json:"site,omitempty" bson:"site,omitempty"`                                    // Specific Location
+	Name                   *string                    `json:"name,omitempty" bson:"name,omitempty"`                                    // Computer friendly designation
+	NameElement            *Element                   `json:"_name,omitempty" bson:"name_element,omitempty"`                           // Extensions for name
+	Title                  *string                    `json:"title,omitempty" bson:"title,omitempty"`                                  // Human Friendly name
+	TitleElement           *Element                   `json:"_title,omitempty" bson:"title_element,omitempty"`                         // Extensions for title
+	Subtitle               *string                    `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                            // Subordinate Friendly name
+	SubtitleElement        *Element                   `json:"_subtitle,omitempty" bson:"subtitle_element,omitempty"`                   // Extensions for subtitle
+	Alias                  []string                   `json:"alias,omitempty" bson:"alias,omitempty"`                                  // Acronym or short name
+	AliasElement           []*Element                 `json:"_alias,omitempty" bson:"alias_element,omitempty"`                         // Extensions for alias
+	Author                 *Reference                 `json:"author,omitempty" bson:"author,omitempty"`                                // Source of Contract
+	Scope                  *CodeableConcept           `json:"scope,omitempty" bson:"scope,omitempty"`                                  // Range of Legal Concerns
+	Topic                  ContractTopic              `json:"-" bson:"topic,omitempty"`                                                // Focus of contract interest
+	Type                   *CodeableConcept           `json:"type,omitempty" bson:"type,omitempty"`                                    // Legal instrument category
+	SubType                []CodeableConcept          `json:"subType,omitempty" bson:"sub_type,omitempty"`                             // Subtype within the context of type
+	ContentDefinition      *ContractContentDefinition `json:"contentDefinition,omitempty" bson:"content_definition,omitempty"`         // Contract precursor content
+	Term                   []ContractTerm             `json:"term,omitempty" bson:"term,omitempty"`                                    // Contract Term List
+	SupportingInfo         []Reference                `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`               // Extra Information
+	RelevantHistory        []Reference                `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`             // Key event in Contract History
+	Signer                 []ContractSigner           `json:"signer,omitempty" bson:"signer,omitempty"`                                // Contract Signatory
+	Friendly               []ContractFriendly         `json:"friendly,omitempty" bson:"friendly,omitempty"`                            // Contract Friendly Language
+	Legal                  []ContractLegal            `json:"legal,omitempty" bson:"legal,omitempty"`                                  // Contract Legal Language
+	Rule                   []ContractRule             `json:"rule,omitempty" bson:"rule,omitempty"`                                    // Computable Contract Language
+	LegallyBinding         ContractLegallyBinding     `json:"-" bson:"legally_binding,omitempty"`                                      // Binding Contract
+
+	topicVariants          []string // JSON properties of Contract.topic[x] when more than one was decoded
+	legallyBindingVariants []string // JSON properties of Contract.legallyBinding[x] when more than one was decoded
 }
 
+var contractAuthorityTargets = []string{"Organization"}
+
+var contractDomainTargets = []string{"Location"}
+
 func (r *Contract) Validate() error {
 	if r.ResourceType != "Contract" {
 		return fmt.Errorf("invalid resourceType: expected 'Contract', got '%s'", r.ResourceType)
@@ -60,16 +79,59 @@ func (r *Contract) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.LegalState != nil {
 		if err := r.LegalState.Validate(); err != nil {
 			return fmt.Errorf("LegalState: %w", err)
@@ -80,11 +142,26 @@ func (r *Contract) Validate() error {
 			return fmt.Errorf("InstantiatesCanonical: %w", err)
 		}
 	}
+	if r.InstantiatesUriElement != nil {
+		if err := r.InstantiatesUriElement.Validate(); err != nil {
+			return fmt.Errorf("InstantiatesUriElement: %w", err)
+		}
+	}
 	if r.ContentDerivative != nil {
 		if err := r.ContentDerivative.Validate(); err != nil {
 			return fmt.Errorf("ContentDerivative: %w", err)
 		}
 	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			return fmt.Errorf("Issued: %w", err)
+		}
+	}
+	if r.IssuedElement != nil {
+		if err := r.IssuedElement.Validate(); err != nil {
+			return fmt.Errorf("IssuedElement: %w", err)
+		}
+	}
 	if r.Applies != nil {
 		if err := r.Applies.Validate(); err != nil {
 			return fmt.Errorf("Applies: %w", err)
@@ -100,11 +177,21 @@ func (r *Contract) Validate() error {
 			return fmt.Errorf("Subject[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Authority {
+		if target := invalidReferenceTarget(item.Reference, item.Type, contractAuthorityTargets); target != "" {
+			return fmt.Errorf("field 'Authority[%d]' cannot refer to %s, expected one of %v", i, target, contractAuthorityTargets)
+		}
+	}
 	for i, item := range r.Authority {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Authority[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Domain {
+		if target := invalidReferenceTarget(item.Reference, item.Type, contractDomainTargets); target != "" {
+			return fmt.Errorf("field 'Domain[%d]' cannot refer to %s, expected one of %v", i, target, contractDomainTargets)
+		}
+	}
 	for i, item := range r.Domain {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Domain[%d]: %w", i, err)
@@ -115,6 +202,29 @@ func (r *Contract) Validate() error {
 			return fmt.Errorf("Site[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.SubtitleElement != nil {
+		if err := r.SubtitleElement.Validate(); err != nil {
+			return fmt.Errorf("SubtitleElement: %w", err)
+		}
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AliasElement[%d]: %w", i, err)
+		}
+	}
 	if r.Author != nil {
 		if err := r.Author.Validate(); err != nil {
 			return fmt.Errorf("Author: %w", err)
@@ -125,14 +235,12 @@ func (r *Contract) Validate() error {
 			return fmt.Errorf("Scope: %w", err)
 		}
 	}
-	if r.TopicCodeableConcept != nil {
-		if err := r.TopicCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("TopicCodeableConcept: %w", err)
-		}
+	if len(r.topicVariants) > 1 {
+		return fmt.Errorf("field 'Topic' must have a single type, got %v", r.topicVariants)
 	}
-	if r.TopicReference != nil {
-		if err := r.TopicReference.Validate(); err != nil {
-			return fmt.Errorf("TopicReference: %w", err)
+	if r.Topic != nil {
+		if err := r.Topic.Validate(); err != nil {
+			return fmt.Errorf("Topic: %w", err)
 		}
 	}
 	if r.Type != nil {
@@ -185,450 +293,501 @@ func (r *Contract) Validate() error {
 			return fmt.Errorf("Rule[%d]: %w", i, err)
 		}
 	}
-	if r.LegallyBindingAttachment != nil {
-		if err := r.LegallyBindingAttachment.Validate(); err != nil {
-			return fmt.Errorf("LegallyBindingAttachment: %w", err)
-		}
-	}
-	if r.LegallyBindingReference != nil {
-		if err := r.LegallyBindingReference.Validate(); err != nil {
-			return fmt.Errorf("LegallyBindingReference: %w", err)
-		}
-	}
-	return nil
-}
-
-type ContractTermAssetContext struct {
-	Id        *string           `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Reference *Reference        `json:"reference,omitempty" bson:"reference,omitempty"` // Creator,custodian or owner
-	Code      []CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`           // Codeable asset context
-	Text      *string           `json:"text,omitempty" bson:"text,omitempty"`           // Context description
-}
-
-func (r *ContractTermAssetContext) Validate() error {
-	if r.Reference != nil {
-		if err := r.Reference.Validate(); err != nil {
-			return fmt.Errorf("Reference: %w", err)
-		}
+	if len(r.legallyBindingVariants) > 1 {
+		return fmt.Errorf("field 'LegallyBinding' must have a single type, got %v", r.legallyBindingVariants)
 	}
-	for i, item := range r.Code {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Code[%d]: %w", i, err)
+	if r.LegallyBinding != nil {
+		if err := r.LegallyBinding.Validate(); err != nil {
+			return fmt.Errorf("LegallyBinding: %w", err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "Contract")
 }
 
-type ContractTermActionSubject struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Reference []Reference      `json:"reference" bson:"reference"`           // Entity of the action
-	Role      *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"` // Role type of the agent
-}
-
-func (r *ContractTermActionSubject) Validate() error {
-	if len(r.Reference) < 1 {
-		return fmt.Errorf("field 'Reference' must have at least 1 elements")
+func (r *Contract) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Contract" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Contract', got '%s'", r.ResourceType))
 	}
-	for i, item := range r.Reference {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Reference[%d]: %w", i, err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	return nil
-}
-
-type ContractContentDefinition struct {
-	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	Type              *CodeableConcept `json:"type" bson:"type"`                                            // Content structure and use
-	SubType           *CodeableConcept `json:"subType,omitempty" bson:"sub_type,omitempty"`                 // Detailed Content Type Definition
-	Publisher         *Reference       `json:"publisher,omitempty" bson:"publisher,omitempty"`              // Publisher Entity
-	PublicationDate   *string          `json:"publicationDate,omitempty" bson:"publication_date,omitempty"` // When published
-	PublicationStatus string           `json:"publicationStatus" bson:"publication_status"`                 // amended | appended | cancelled | disputed | entered-in-error | executable +
-	Copyright         *string          `json:"copyright,omitempty" bson:"copyright,omitempty"`              // Publication Ownership
-}
-
-func (r *ContractContentDefinition) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.SubType != nil {
-		if err := r.SubType.Validate(); err != nil {
-			return fmt.Errorf("SubType: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.Publisher != nil {
-		if err := r.Publisher.Validate(); err != nil {
-			return fmt.Errorf("Publisher: %w", err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	var emptyString string
-	if r.PublicationStatus == emptyString {
-		return fmt.Errorf("field 'PublicationStatus' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ContractTermSecurityLabel struct {
-	Id             *string  `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Number         []int    `json:"number,omitempty" bson:"number,omitempty"`     // Link to Security Labels
-	Classification *Coding  `json:"classification" bson:"classification"`         // Confidentiality Protection
-	Category       []Coding `json:"category,omitempty" bson:"category,omitempty"` // Applicable Policy
-	Control        []Coding `json:"control,omitempty" bson:"control,omitempty"`   // Handling Instructions
-}
-
-func (r *ContractTermSecurityLabel) Validate() error {
-	if r.Classification == nil {
-		return fmt.Errorf("field 'Classification' is required")
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.Classification != nil {
-		if err := r.Classification.Validate(); err != nil {
-			return fmt.Errorf("Classification: %w", err)
-		}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	for i, item := range r.Category {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Category[%d]: %w", i, err)
-		}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
-	for i, item := range r.Control {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Control[%d]: %w", i, err)
-		}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	return nil
-}
-
-type ContractTermOfferAnswer struct {
-	Id              *string     `json:"id,omitempty" bson:"id,omitempty"`        // Unique id for inter-element referencing
-	ValueBoolean    *bool       `json:"valueBoolean" bson:"value_boolean"`       // The actual answer response
-	ValueDecimal    *float64    `json:"valueDecimal" bson:"value_decimal"`       // The actual answer response
-	ValueInteger    *int        `json:"valueInteger" bson:"value_integer"`       // The actual answer response
-	ValueDate       *string     `json:"valueDate" bson:"value_date"`             // The actual answer response
-	ValueDateTime   *string     `json:"valueDateTime" bson:"value_date_time"`    // The actual answer response
-	ValueTime       *string     `json:"valueTime" bson:"value_time"`             // The actual answer response
-	ValueString     *string     `json:"valueString" bson:"value_string"`         // The actual answer response
-	ValueUri        *string     `json:"valueUri" bson:"value_uri"`               // The actual answer response
-	ValueAttachment *Attachment `json:"valueAttachment" bson:"value_attachment"` // The actual answer response
-	ValueCoding     *Coding     `json:"valueCoding" bson:"value_coding"`         // The actual answer response
-	ValueQuantity   *Quantity   `json:"valueQuantity" bson:"value_quantity"`     // The actual answer response
-	ValueReference  *Reference  `json:"valueReference" bson:"value_reference"`   // The actual answer response
-}
-
-func (r *ContractTermOfferAnswer) Validate() error {
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.LegalState != nil {
+		r.LegalState.validateAll(path+".legalState", issues)
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	if r.InstantiatesCanonical != nil {
+		r.InstantiatesCanonical.validateAll(path+".instantiatesCanonical", issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.InstantiatesUriElement != nil {
+		r.InstantiatesUriElement.validateAll(path+".instantiatesUri", issues)
 	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
+	if r.ContentDerivative != nil {
+		r.ContentDerivative.validateAll(path+".contentDerivative", issues)
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			issues.add("value", path+".issued", err.Error())
+		}
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	if r.IssuedElement != nil {
+		r.IssuedElement.validateAll(path+".issued", issues)
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.Applies != nil {
+		r.Applies.validateAll(path+".applies", issues)
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	if r.ExpirationType != nil {
+		r.ExpirationType.validateAll(path+".expirationType", issues)
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	for i, item := range r.Authority {
+		if target := invalidReferenceTarget(item.Reference, item.Type, contractAuthorityTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.authority[%d]", path, i), fmt.Sprintf("field 'Authority[%d]' cannot refer to %s, expected one of %v", i, target, contractAuthorityTargets))
 		}
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	for i, item := range r.Authority {
+		item.validateAll(fmt.Sprintf("%s.authority[%d]", path, i), issues)
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	for i, item := range r.Domain {
+		if target := invalidReferenceTarget(item.Reference, item.Type, contractDomainTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.domain[%d]", path, i), fmt.Sprintf("field 'Domain[%d]' cannot refer to %s, expected one of %v", i, target, contractDomainTargets))
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
-	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	for i, item := range r.Domain {
+		item.validateAll(fmt.Sprintf("%s.domain[%d]", path, i), issues)
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	for i, item := range r.Site {
+		item.validateAll(fmt.Sprintf("%s.site[%d]", path, i), issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	return nil
-}
-
-type ContractTermAsset struct {
-	Id                  *string                       `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
-	Scope               *CodeableConcept              `json:"scope,omitempty" bson:"scope,omitempty"`                               // Range of asset
-	Type                []CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                                 // Asset category
-	TypeReference       []Reference                   `json:"typeReference,omitempty" bson:"type_reference,omitempty"`              // Associated entities
-	Subtype             []CodeableConcept             `json:"subtype,omitempty" bson:"subtype,omitempty"`                           // Asset sub-category
-	Relationship        *Coding                       `json:"relationship,omitempty" bson:"relationship,omitempty"`                 // Kinship of the asset
-	Context             []ContractTermAssetContext    `json:"context,omitempty" bson:"context,omitempty"`                           // Circumstance of the asset
-	Condition           *string                       `json:"condition,omitempty" bson:"condition,omitempty"`                       // Quality desctiption of asset
-	PeriodType          []CodeableConcept             `json:"periodType,omitempty" bson:"period_type,omitempty"`                    // Asset availability types
-	Period              []Period                      `json:"period,omitempty" bson:"period,omitempty"`                             // Time period of the asset
-	UsePeriod           []Period                      `json:"usePeriod,omitempty" bson:"use_period,omitempty"`                      // Time period
-	Text                *string                       `json:"text,omitempty" bson:"text,omitempty"`                                 // Asset clause or question text
-	LinkId              []string                      `json:"linkId,omitempty" bson:"link_id,omitempty"`                            // Pointer to asset text
-	Answer              []ContractTermOfferAnswer     `json:"answer,omitempty" bson:"answer,omitempty"`                             // Response to assets
-	SecurityLabelNumber []int                         `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"` // Asset restriction numbers
-	ValuedItem          []ContractTermAssetValuedItem `json:"valuedItem,omitempty" bson:"valued_item,omitempty"`                    // Contract Valued Item List
-}
-
-func (r *ContractTermAsset) Validate() error {
-	if r.Scope != nil {
-		if err := r.Scope.Validate(); err != nil {
-			return fmt.Errorf("Scope: %w", err)
-		}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	for i, item := range r.Type {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
-		}
+	if r.SubtitleElement != nil {
+		r.SubtitleElement.validateAll(path+".subtitle", issues)
 	}
-	for i, item := range r.TypeReference {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TypeReference[%d]: %w", i, err)
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.alias[%d]", path, i), issues)
 	}
-	for i, item := range r.Subtype {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Subtype[%d]: %w", i, err)
-		}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
 	}
-	if r.Relationship != nil {
-		if err := r.Relationship.Validate(); err != nil {
-			return fmt.Errorf("Relationship: %w", err)
-		}
+	if r.Scope != nil {
+		r.Scope.validateAll(path+".scope", issues)
 	}
-	for i, item := range r.Context {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Context[%d]: %w", i, err)
-		}
+	if len(r.topicVariants) > 1 {
+		issues.add("structure", path+".topic", fmt.Sprintf("field 'Topic' must have a single type, got %v", r.topicVariants))
 	}
-	for i, item := range r.PeriodType {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("PeriodType[%d]: %w", i, err)
-		}
+	if r.Topic != nil {
+		r.Topic.validateAll(path+".topic.ofType("+r.Topic.FHIRType()+")", issues)
 	}
-	for i, item := range r.Period {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Period[%d]: %w", i, err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	for i, item := range r.UsePeriod {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("UsePeriod[%d]: %w", i, err)
-		}
+	for i, item := range r.SubType {
+		item.validateAll(fmt.Sprintf("%s.subType[%d]", path, i), issues)
 	}
-	for i, item := range r.Answer {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Answer[%d]: %w", i, err)
-		}
+	if r.ContentDefinition != nil {
+		r.ContentDefinition.validateAll(path+".contentDefinition", issues)
 	}
-	for i, item := range r.ValuedItem {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ValuedItem[%d]: %w", i, err)
-		}
+	for i, item := range r.Term {
+		item.validateAll(fmt.Sprintf("%s.term[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ContractTermAssetValuedItem struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	EntityCodeableConcept *CodeableConcept `json:"entityCodeableConcept,omitempty" bson:"entity_codeable_concept,omitempty"` // Contract Valued Item Type
-	EntityReference       *Reference       `json:"entityReference,omitempty" bson:"entity_reference,omitempty"`              // Contract Valued Item Type
-	Identifier            *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Contract Valued Item Number
-	EffectiveTime         *string          `json:"effectiveTime,omitempty" bson:"effective_time,omitempty"`                  // Contract Valued Item Effective Tiem
-	Quantity              *Quantity        `json:"quantity,omitempty" bson:"quantity,omitempty"`                             // Count of Contract Valued Items
-	UnitPrice             *Money           `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                          // Contract Valued Item fee, charge, or cost
-	Factor                *float64         `json:"factor,omitempty" bson:"factor,omitempty"`                                 // Contract Valued Item Price Scaling Factor
-	Points                *float64         `json:"points,omitempty" bson:"points,omitempty"`                                 // Contract Valued Item Difficulty Scaling Factor
-	Net                   *Money           `json:"net,omitempty" bson:"net,omitempty"`                                       // Total Contract Valued Item Value
-	Payment               *string          `json:"payment,omitempty" bson:"payment,omitempty"`                               // Terms of valuation
-	PaymentDate           *string          `json:"paymentDate,omitempty" bson:"payment_date,omitempty"`                      // When payment is due
-	Responsible           *Reference       `json:"responsible,omitempty" bson:"responsible,omitempty"`                       // Who will make payment
-	Recipient             *Reference       `json:"recipient,omitempty" bson:"recipient,omitempty"`                           // Who will receive payment
-	LinkId                []string         `json:"linkId,omitempty" bson:"link_id,omitempty"`                                // Pointer to specific item
-	SecurityLabelNumber   []int            `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"`     // Security Labels that define affected terms
-}
-
-func (r *ContractTermAssetValuedItem) Validate() error {
-	if r.EntityCodeableConcept != nil {
-		if err := r.EntityCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("EntityCodeableConcept: %w", err)
-		}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
 	}
-	if r.EntityReference != nil {
-		if err := r.EntityReference.Validate(); err != nil {
-			return fmt.Errorf("EntityReference: %w", err)
-		}
+	for i, item := range r.RelevantHistory {
+		item.validateAll(fmt.Sprintf("%s.relevantHistory[%d]", path, i), issues)
 	}
-	if r.Identifier != nil {
-		if err := r.Identifier.Validate(); err != nil {
-			return fmt.Errorf("Identifier: %w", err)
-		}
+	for i, item := range r.Signer {
+		item.validateAll(fmt.Sprintf("%s.signer[%d]", path, i), issues)
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+	for i, item := range r.Friendly {
+		item.validateAll(fmt.Sprintf("%s.friendly[%d]", path, i), issues)
 	}
-	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
-		}
+	for i, item := range r.Legal {
+		item.validateAll(fmt.Sprintf("%s.legal[%d]", path, i), issues)
 	}
-	if r.Net != nil {
-		if err := r.Net.Validate(); err != nil {
-			return fmt.Errorf("Net: %w", err)
-		}
+	for i, item := range r.Rule {
+		item.validateAll(fmt.Sprintf("%s.rule[%d]", path, i), issues)
 	}
-	if r.Responsible != nil {
-		if err := r.Responsible.Validate(); err != nil {
-			return fmt.Errorf("Responsible: %w", err)
-		}
+	if len(r.legallyBindingVariants) > 1 {
+		issues.add("structure", path+".legallyBinding", fmt.Sprintf("field 'LegallyBinding' must have a single type, got %v", r.legallyBindingVariants))
 	}
-	if r.Recipient != nil {
-		if err := r.Recipient.Validate(); err != nil {
-			return fmt.Errorf("Recipient: %w", err)
-		}
+	if r.LegallyBinding != nil {
+		r.LegallyBinding.validateAll(path+".legallyBinding.ofType("+r.LegallyBinding.FHIRType()+")", issues)
 	}
-	return nil
 }
 
-type ContractFriendly struct {
-	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	ContentAttachment *Attachment `json:"contentAttachment" bson:"content_attachment"` // Easily comprehended representation of this Contract
-	ContentReference  *Reference  `json:"contentReference" bson:"content_reference"`   // Easily comprehended representation of this Contract
+func (r *Contract) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Contract", &issues)
+	checkInvariants(r, "Contract", &issues)
+	return issues
 }
 
-func (r *ContractFriendly) Validate() error {
-	if r.ContentAttachment == nil {
-		return fmt.Errorf("field 'ContentAttachment' is required")
-	}
-	if r.ContentAttachment != nil {
-		if err := r.ContentAttachment.Validate(); err != nil {
-			return fmt.Errorf("ContentAttachment: %w", err)
-		}
-	}
-	if r.ContentReference == nil {
-		return fmt.Errorf("field 'ContentReference' is required")
-	}
-	if r.ContentReference != nil {
-		if err := r.ContentReference.Validate(); err != nil {
-			return fmt.Errorf("ContentReference: %w", err)
-		}
-	}
-	return nil
+var contractInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type ContractRule struct {
-	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	ContentAttachment *Attachment `json:"contentAttachment" bson:"content_attachment"` // Computable Contract Rules
-	ContentReference  *Reference  `json:"contentReference" bson:"content_reference"`   // Computable Contract Rules
+func (r *Contract) invariants() []invariant {
+	return contractInvariants
 }
 
-func (r *ContractRule) Validate() error {
-	if r.ContentAttachment == nil {
-		return fmt.Errorf("field 'ContentAttachment' is required")
-	}
-	if r.ContentAttachment != nil {
-		if err := r.ContentAttachment.Validate(); err != nil {
-			return fmt.Errorf("ContentAttachment: %w", err)
-		}
-	}
-	if r.ContentReference == nil {
-		return fmt.Errorf("field 'ContentReference' is required")
-	}
-	if r.ContentReference != nil {
-		if err := r.ContentReference.Validate(); err != nil {
-			return fmt.Errorf("ContentReference: %w", err)
-		}
+func (r Contract) MarshalJSON() ([]byte, error) {
+	type alias Contract
+	out := struct {
+		alias
+		Alias        []*string  `json:"alias,omitempty"`
+		AliasElement []*Element `json:"_alias,omitempty"`
+	}{alias: alias(r)}
+	out.Alias, out.AliasElement = alignPrimitiveArray(r.Alias, r.AliasElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "topic", r.Topic, nil); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "legallyBinding", r.LegallyBinding, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Contract) UnmarshalJSON(data []byte) error {
+	type alias Contract
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Alias     []*string         `json:"alias,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Alias, err = splitPrimitiveArray(aux.Alias, r.AliasElement); err != nil {
+		return fmt.Errorf("alias: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Topic, _, r.topicVariants, err = unmarshalChoice(fields, "topic", contractTopicVariants); err != nil {
+		return err
+	}
+	if r.LegallyBinding, _, r.legallyBindingVariants, err = unmarshalChoice(fields, "legallyBinding", contractLegallyBindingVariants); err != nil {
+		return err
 	}
 	return nil
 }
 
-type ContractTermOfferParty struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Reference []Reference      `json:"reference" bson:"reference"`       // Referenced entity
-	Role      *CodeableConcept `json:"role" bson:"role"`                 // Participant engagement type
+func (r Contract) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ContractTermOfferParty) Validate() error {
-	if len(r.Reference) < 1 {
-		return fmt.Errorf("field 'Reference' must have at least 1 elements")
-	}
-	for i, item := range r.Reference {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Reference[%d]: %w", i, err)
-		}
-	}
-	if r.Role == nil {
-		return fmt.Errorf("field 'Role' is required")
+func (r *Contract) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Contract) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Contract) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Contract) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Topic":
+		return "topic", choiceValues(contractTopicVariants)
+	case "LegallyBinding":
+		return "legallyBinding", choiceValues(contractLegallyBindingVariants)
+	}
+	return "", nil
+}
+
+var contractElementTypes = map[string]string{
+	"id":              "id",
+	"implicitRules":   "uri",
+	"language":        "code",
+	"url":             "uri",
+	"status":          "code",
+	"instantiatesUri": "uri",
+}
+
+func (r *Contract) elementTypes() map[string]string {
+	return contractElementTypes
+}
+
+var contractElementMetadata = []ElementMetadata{
+	{Path: "Contract.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Contract.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Contract.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Contract.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Contract.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Contract.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Contract.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Contract.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Contract.url", Name: "url", Field: 12, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Contract.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.status", Name: "status", Field: 16, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "Contract.legalState", Name: "legalState", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.instantiatesCanonical", Name: "instantiatesCanonical", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.instantiatesUri", Name: "instantiatesUri", Field: 20, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Contract.contentDerivative", Name: "contentDerivative", Field: 22, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.issued", Name: "issued", Field: 23, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Contract.applies", Name: "applies", Field: 25, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Contract.expirationType", Name: "expirationType", Field: 26, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.subject", Name: "subject", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Contract.authority", Name: "authority", Field: 28, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Contract.domain", Name: "domain", Field: 29, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Contract.site", Name: "site", Field: 30, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.name", Name: "name", Field: 31, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.title", Name: "title", Field: 33, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.subtitle", Name: "subtitle", Field: 35, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.alias", Name: "alias", Field: 37, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.author", Name: "author", Field: 39, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.scope", Name: "scope", Field: 40, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.topic[x]", Name: "topic", Field: 41, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Contract.type", Name: "type", Field: 42, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.subType", Name: "subType", Field: 43, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.contentDefinition", Name: "contentDefinition", Field: 44, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term", Name: "term", Field: 45, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.supportingInfo", Name: "supportingInfo", Field: 46, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.relevantHistory", Name: "relevantHistory", Field: 47, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.signer", Name: "signer", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.friendly", Name: "friendly", Field: 49, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.legal", Name: "legal", Field: 50, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.rule", Name: "rule", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.legallyBinding[x]", Name: "legallyBinding", Field: 52, Min: 0, Max: "1", Types: []string{"Attachment", "Reference"}},
+}
+
+func (r *Contract) ElementMetadata() []ElementMetadata {
+	return contractElementMetadata
+}
+
+// ContractTopic is the type of Contract.topic[x]. It is implemented by the ContractTopic* variant types.
+type ContractTopic interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractTopic()
+}
+
+// ContractTopicCodeableConcept is the CodeableConcept variant of ContractTopic.
+type ContractTopicCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ContractTopicCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ContractTopicCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ContractTopicCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ContractTopicCodeableConcept) isContractTopic() {}
+
+// ContractTopicReference is the Reference variant of ContractTopic.
+type ContractTopicReference struct {
+	Reference
+}
+
+func (v ContractTopicReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractTopicReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractTopicReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractTopicReference) isContractTopic() {}
+
+var contractTopicVariants = []ContractTopic{
+	ContractTopicCodeableConcept{},
+	ContractTopicReference{},
+}
+
+// ContractLegallyBinding is the type of Contract.legallyBinding[x]. It is implemented by the ContractLegallyBinding* variant types.
+type ContractLegallyBinding interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractLegallyBinding()
+}
+
+// ContractLegallyBindingAttachment is the Attachment variant of ContractLegallyBinding.
+type ContractLegallyBindingAttachment struct {
+	Attachment
+}
+
+func (v ContractLegallyBindingAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ContractLegallyBindingAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ContractLegallyBindingAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ContractLegallyBindingAttachment) isContractLegallyBinding() {}
+
+// ContractLegallyBindingReference is the Reference variant of ContractLegallyBinding.
+type ContractLegallyBindingReference struct {
+	Reference
+}
+
+func (v ContractLegallyBindingReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractLegallyBindingReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractLegallyBindingReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractLegallyBindingReference) isContractLegallyBinding() {}
+
+var contractLegallyBindingVariants = []ContractLegallyBinding{
+	ContractLegallyBindingAttachment{},
+	ContractLegallyBindingReference{},
+}
+
+func (r *Contract) GetResourceType() string {
+	return "Contract"
+}
+
+func (r *Contract) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *Contract) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
 }
 
-type ContractTermAction struct {
-	Id                  *string                     `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
-	DoNotPerform        *bool                       `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`               // True if the term prohibits the  action
-	Type                *CodeableConcept            `json:"type" bson:"type"`                                                     // Type or form of the action
-	Subject             []ContractTermActionSubject `json:"subject,omitempty" bson:"subject,omitempty"`                           // Entity of the action
-	Intent              *CodeableConcept            `json:"intent" bson:"intent"`                                                 // Purpose for the Contract Term Action
-	LinkId              []string                    `json:"linkId,omitempty" bson:"link_id,omitempty"`                            // Pointer to specific item
-	Status              *CodeableConcept            `json:"status" bson:"status"`                                                 // State of the action
-	Context             *Reference                  `json:"context,omitempty" bson:"context,omitempty"`                           // Episode associated with action
-	ContextLinkId       []string                    `json:"contextLinkId,omitempty" bson:"context_link_id,omitempty"`             // Pointer to specific item
-	OccurrenceDateTime  *string                     `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"`   // When action happens
-	OccurrencePeriod    *Period                     `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`        // When action happens
-	OccurrenceTiming    *Timing                     `json:"occurrenceTiming,omitempty" bson:"occurrence_timing,omitempty"`        // When action happens
-	Requester           []Reference                 `json:"requester,omitempty" bson:"requester,omitempty"`                       // Who asked for action
-	RequesterLinkId     []string                    `json:"requesterLinkId,omitempty" bson:"requester_link_id,omitempty"`         // Pointer to specific item
-	PerformerType       []CodeableConcept           `json:"performerType,omitempty" bson:"performer_type,omitempty"`              // Kind of service performer
-	PerformerRole       *CodeableConcept            `json:"performerRole,omitempty" bson:"performer_role,omitempty"`              // Competency of the performer
-	Performer           *Reference                  `json:"performer,omitempty" bson:"performer,omitempty"`                       // Actor that wil execute (or not) the action
-	PerformerLinkId     []string                    `json:"performerLinkId,omitempty" bson:"performer_link_id,omitempty"`         // Pointer to specific item
-	Reason              []CodeableReference         `json:"reason,omitempty" bson:"reason,omitempty"`                             // Why is action (not) needed?
-	ReasonLinkId        []string                    `json:"reasonLinkId,omitempty" bson:"reason_link_id,omitempty"`               // Pointer to specific item
-	Note                []Annotation                `json:"note,omitempty" bson:"note,omitempty"`                                 // Comments about the action
-	SecurityLabelNumber []int                       `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"` // Action restriction numbers
+func (r *Contract) GetMeta() *Meta {
+	return r.Meta
 }
 
-func (r *ContractTermAction) Validate() error {
+func (r *Contract) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Contract) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Contract) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Contract) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Contract)(nil)
+
+type ContractContentDefinition struct {
+	Id                       *string                                `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension                []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	Type                     *CodeableConcept                       `json:"type" bson:"type"`                                                         // Content structure and use
+	SubType                  *CodeableConcept                       `json:"subType,omitempty" bson:"sub_type,omitempty"`                              // Detailed Content Type Definition
+	Publisher                *Reference                             `json:"publisher,omitempty" bson:"publisher,omitempty"`                           // Publisher Entity
+	PublicationDate          *DateTime                              `json:"publicationDate,omitempty" bson:"publication_date,omitempty"`              // When published
+	PublicationDateElement   *Element                               `json:"_publicationDate,omitempty" bson:"publication_date_element,omitempty"`     // Extensions for publicationDate
+	PublicationStatus        ContractResourcePublicationStatusCodes `json:"publicationStatus" bson:"publication_status"`                              // amended | appended | cancelled | disputed | entered-in-error | executable +
+	PublicationStatusElement *Element                               `json:"_publicationStatus,omitempty" bson:"publication_status_element,omitempty"` // Extensions for publicationStatus
+	Copyright                *string                                `json:"copyright,omitempty" bson:"copyright,omitempty"`                           // Publication Ownership
+	CopyrightElement         *Element                               `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                  // Extensions for copyright
+}
+
+func (r *ContractContentDefinition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -637,111 +796,193 @@ func (r *ContractTermAction) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	for i, item := range r.Subject {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Subject[%d]: %w", i, err)
+	if r.SubType != nil {
+		if err := r.SubType.Validate(); err != nil {
+			return fmt.Errorf("SubType: %w", err)
 		}
 	}
-	if r.Intent == nil {
-		return fmt.Errorf("field 'Intent' is required")
-	}
-	if r.Intent != nil {
-		if err := r.Intent.Validate(); err != nil {
-			return fmt.Errorf("Intent: %w", err)
+	if r.Publisher != nil {
+		if err := r.Publisher.Validate(); err != nil {
+			return fmt.Errorf("Publisher: %w", err)
 		}
 	}
-	if r.Status == nil {
-		return fmt.Errorf("field 'Status' is required")
-	}
-	if r.Status != nil {
-		if err := r.Status.Validate(); err != nil {
-			return fmt.Errorf("Status: %w", err)
+	if r.PublicationDate != nil {
+		if err := r.PublicationDate.Validate(); err != nil {
+			return fmt.Errorf("PublicationDate: %w", err)
 		}
 	}
-	if r.Context != nil {
-		if err := r.Context.Validate(); err != nil {
-			return fmt.Errorf("Context: %w", err)
+	if r.PublicationDateElement != nil {
+		if err := r.PublicationDateElement.Validate(); err != nil {
+			return fmt.Errorf("PublicationDateElement: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
-		}
+	if r.PublicationStatus == "" {
+		return fmt.Errorf("field 'PublicationStatus' is required")
 	}
-	if r.OccurrenceTiming != nil {
-		if err := r.OccurrenceTiming.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceTiming: %w", err)
-		}
+	if !r.PublicationStatus.IsValid() {
+		return fmt.Errorf("field 'PublicationStatus' has invalid code '%s'", r.PublicationStatus)
 	}
-	for i, item := range r.Requester {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Requester[%d]: %w", i, err)
+	if r.PublicationStatusElement != nil {
+		if err := r.PublicationStatusElement.Validate(); err != nil {
+			return fmt.Errorf("PublicationStatusElement: %w", err)
 		}
 	}
-	for i, item := range r.PerformerType {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("PerformerType[%d]: %w", i, err)
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
 		}
 	}
-	if r.PerformerRole != nil {
-		if err := r.PerformerRole.Validate(); err != nil {
-			return fmt.Errorf("PerformerRole: %w", err)
-		}
+	return nil
+}
+
+func (r *ContractContentDefinition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Performer != nil {
-		if err := r.Performer.Validate(); err != nil {
-			return fmt.Errorf("Performer: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	for i, item := range r.Reason {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Reason[%d]: %w", i, err)
-		}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.SubType != nil {
+		r.SubType.validateAll(path+".subType", issues)
+	}
+	if r.Publisher != nil {
+		r.Publisher.validateAll(path+".publisher", issues)
+	}
+	if r.PublicationDate != nil {
+		if err := r.PublicationDate.Validate(); err != nil {
+			issues.add("value", path+".publicationDate", err.Error())
 		}
 	}
-	return nil
+	if r.PublicationDateElement != nil {
+		r.PublicationDateElement.validateAll(path+".publicationDate", issues)
+	}
+	if r.PublicationStatus == "" {
+		issues.add("required", path+".publicationStatus", "field 'PublicationStatus' is required")
+	}
+	if r.PublicationStatus != "" && !r.PublicationStatus.IsValid() {
+		issues.add("code-invalid", path+".publicationStatus", fmt.Sprintf("field 'PublicationStatus' has invalid code '%s'", r.PublicationStatus))
+	}
+	if r.PublicationStatusElement != nil {
+		r.PublicationStatusElement.validateAll(path+".publicationStatus", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+}
+
+var contractContentDefinitionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractContentDefinition) invariants() []invariant {
+	return contractContentDefinitionInvariants
+}
+
+func (r ContractContentDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractContentDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractContentDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractContentDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractContentDefinitionElementTypes = map[string]string{
+	"copyright": "markdown",
+}
+
+func (r *ContractContentDefinition) elementTypes() map[string]string {
+	return contractContentDefinitionElementTypes
+}
+
+var contractContentDefinitionElementMetadata = []ElementMetadata{
+	{Path: "Contract.contentDefinition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.contentDefinition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.contentDefinition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.contentDefinition.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.contentDefinition.subType", Name: "subType", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.contentDefinition.publisher", Name: "publisher", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.contentDefinition.publicationDate", Name: "publicationDate", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Contract.contentDefinition.publicationStatus", Name: "publicationStatus", Field: 8, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Contract.contentDefinition.publicationStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/contract-publicationstatus"}},
+	{Path: "Contract.contentDefinition.copyright", Name: "copyright", Field: 10, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ContractContentDefinition) ElementMetadata() []ElementMetadata {
+	return contractContentDefinitionElementMetadata
 }
 
 type ContractTerm struct {
-	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Identifier           *Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // Contract Term Number
-	Issued               *string                     `json:"issued,omitempty" bson:"issued,omitempty"`                               // Contract Term Issue Date Time
-	Applies              *Period                     `json:"applies,omitempty" bson:"applies,omitempty"`                             // Contract Term Effective Time
-	TopicCodeableConcept *CodeableConcept            `json:"topicCodeableConcept,omitempty" bson:"topic_codeable_concept,omitempty"` // Term Concern
-	TopicReference       *Reference                  `json:"topicReference,omitempty" bson:"topic_reference,omitempty"`              // Term Concern
-	Type                 *CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                                   // Contract Term Type or Form
-	SubType              *CodeableConcept            `json:"subType,omitempty" bson:"sub_type,omitempty"`                            // Contract Term Type specific classification
-	Text                 *string                     `json:"text,omitempty" bson:"text,omitempty"`                                   // Term Statement
-	SecurityLabel        []ContractTermSecurityLabel `json:"securityLabel,omitempty" bson:"security_label,omitempty"`                // Protection for the Term
-	Offer                *ContractTermOffer          `json:"offer" bson:"offer"`                                                     // Context of the Contract term
-	Asset                []ContractTermAsset         `json:"asset,omitempty" bson:"asset,omitempty"`                                 // Contract Term Asset List
-	Action               []ContractTermAction        `json:"action,omitempty" bson:"action,omitempty"`                               // Entity being ascribed responsibility
-	Group                []ContractTerm              `json:"group,omitempty" bson:"group,omitempty"`                                 // Nested Contract Term Group
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        *Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Contract Term Number
+	Issued            *DateTime                   `json:"issued,omitempty" bson:"issued,omitempty"`                        // Contract Term Issue Date Time
+	IssuedElement     *Element                    `json:"_issued,omitempty" bson:"issued_element,omitempty"`               // Extensions for issued
+	Applies           *Period                     `json:"applies,omitempty" bson:"applies,omitempty"`                      // Contract Term Effective Time
+	Topic             ContractTermTopic           `json:"-" bson:"topic,omitempty"`                                        // Term Concern
+	Type              *CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                            // Contract Term Type or Form
+	SubType           *CodeableConcept            `json:"subType,omitempty" bson:"sub_type,omitempty"`                     // Contract Term Type specific classification
+	Text              *string                     `json:"text,omitempty" bson:"text,omitempty"`                            // Term Statement
+	TextElement       *Element                    `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+	SecurityLabel     []ContractTermSecurityLabel `json:"securityLabel,omitempty" bson:"security_label,omitempty"`         // Protection for the Term
+	Offer             *ContractTermOffer          `json:"offer" bson:"offer"`                                              // Context of the Contract term
+	Asset             []ContractTermAsset         `json:"asset,omitempty" bson:"asset,omitempty"`                          // Contract Term Asset List
+	Action            []ContractTermAction        `json:"action,omitempty" bson:"action,omitempty"`                        // Entity being ascribed responsibility
+	Group             []ContractTerm              `json:"group,omitempty" bson:"group,omitempty"`                          // Nested Contract Term Group
+
+	topicVariants []string // JSON properties of Contract.term.topic[x] when more than one was decoded
 }
 
 func (r *ContractTerm) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			return fmt.Errorf("Issued: %w", err)
+		}
+	}
+	if r.IssuedElement != nil {
+		if err := r.IssuedElement.Validate(); err != nil {
+			return fmt.Errorf("IssuedElement: %w", err)
+		}
+	}
 	if r.Applies != nil {
 		if err := r.Applies.Validate(); err != nil {
 			return fmt.Errorf("Applies: %w", err)
 		}
 	}
-	if r.TopicCodeableConcept != nil {
-		if err := r.TopicCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("TopicCodeableConcept: %w", err)
-		}
+	if len(r.topicVariants) > 1 {
+		return fmt.Errorf("field 'Topic' must have a single type, got %v", r.topicVariants)
 	}
-	if r.TopicReference != nil {
-		if err := r.TopicReference.Validate(); err != nil {
-			return fmt.Errorf("TopicReference: %w", err)
+	if r.Topic != nil {
+		if err := r.Topic.Validate(); err != nil {
+			return fmt.Errorf("Topic: %w", err)
 		}
 	}
 	if r.Type != nil {
@@ -754,6 +995,11 @@ func (r *ContractTerm) Validate() error {
 			return fmt.Errorf("SubType: %w", err)
 		}
 	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	for i, item := range r.SecurityLabel {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("SecurityLabel[%d]: %w", i, err)
@@ -785,78 +1031,2443 @@ func (r *ContractTerm) Validate() error {
 	return nil
 }
 
-type ContractTermOffer struct {
-	Id                  *string                   `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
-	Identifier          []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Offer business ID
-	Party               []ContractTermOfferParty  `json:"party,omitempty" bson:"party,omitempty"`                               // Offer Recipient
-	Topic               *Reference                `json:"topic,omitempty" bson:"topic,omitempty"`                               // Negotiable offer asset
-	Type                *CodeableConcept          `json:"type,omitempty" bson:"type,omitempty"`                                 // Contract Offer Type or Form
-	Decision            *CodeableConcept          `json:"decision,omitempty" bson:"decision,omitempty"`                         // Accepting party choice
-	DecisionMode        []CodeableConcept         `json:"decisionMode,omitempty" bson:"decision_mode,omitempty"`                // How decision is conveyed
-	Answer              []ContractTermOfferAnswer `json:"answer,omitempty" bson:"answer,omitempty"`                             // Response to offer text
-	Text                *string                   `json:"text,omitempty" bson:"text,omitempty"`                                 // Human readable offer text
-	LinkId              []string                  `json:"linkId,omitempty" bson:"link_id,omitempty"`                            // Pointer to text
-	SecurityLabelNumber []int                     `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"` // Offer restriction numbers
-}
-
-func (r *ContractTermOffer) Validate() error {
-	for i, item := range r.Identifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Identifier[%d]: %w", i, err)
-		}
+func (r *ContractTerm) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.Party {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Party[%d]: %w", i, err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Topic != nil {
-		if err := r.Topic.Validate(); err != nil {
-			return fmt.Errorf("Topic: %w", err)
-		}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			issues.add("value", path+".issued", err.Error())
 		}
 	}
-	if r.Decision != nil {
-		if err := r.Decision.Validate(); err != nil {
-			return fmt.Errorf("Decision: %w", err)
-		}
+	if r.IssuedElement != nil {
+		r.IssuedElement.validateAll(path+".issued", issues)
 	}
-	for i, item := range r.DecisionMode {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("DecisionMode[%d]: %w", i, err)
-		}
+	if r.Applies != nil {
+		r.Applies.validateAll(path+".applies", issues)
 	}
-	for i, item := range r.Answer {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Answer[%d]: %w", i, err)
-		}
+	if len(r.topicVariants) > 1 {
+		issues.add("structure", path+".topic", fmt.Sprintf("field 'Topic' must have a single type, got %v", r.topicVariants))
 	}
-	return nil
-}
-
-type ContractSigner struct {
-	Id        *string     `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type      *Coding     `json:"type" bson:"type"`                 // Contract Signatory Role
-	Party     *Reference  `json:"party" bson:"party"`               // Contract Signatory Party
-	Signature []Signature `json:"signature" bson:"signature"`       // Contract Documentation Signature
-}
-
-func (r *ContractSigner) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Topic != nil {
+		r.Topic.validateAll(path+".topic.ofType("+r.Topic.FHIRType()+")", issues)
 	}
 	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.SubType != nil {
+		r.SubType.validateAll(path+".subType", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	for i, item := range r.SecurityLabel {
+		item.validateAll(fmt.Sprintf("%s.securityLabel[%d]", path, i), issues)
+	}
+	if r.Offer == nil {
+		issues.add("required", path+".offer", "field 'Offer' is required")
+	}
+	if r.Offer != nil {
+		r.Offer.validateAll(path+".offer", issues)
+	}
+	for i, item := range r.Asset {
+		item.validateAll(fmt.Sprintf("%s.asset[%d]", path, i), issues)
+	}
+	for i, item := range r.Action {
+		item.validateAll(fmt.Sprintf("%s.action[%d]", path, i), issues)
+	}
+	for i, item := range r.Group {
+		item.validateAll(fmt.Sprintf("%s.group[%d]", path, i), issues)
+	}
+}
+
+var contractTermInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTerm) invariants() []invariant {
+	return contractTermInvariants
+}
+
+func (r ContractTerm) MarshalJSON() ([]byte, error) {
+	type alias ContractTerm
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "topic", r.Topic, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractTerm) UnmarshalJSON(data []byte) error {
+	type alias ContractTerm
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Topic, _, r.topicVariants, err = unmarshalChoice(fields, "topic", contractTermTopicVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ContractTerm) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTerm) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTerm) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTerm) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractTerm) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Topic":
+		return "topic", choiceValues(contractTermTopicVariants)
+	}
+	return "", nil
+}
+
+var contractTermElementTypes = map[string]string{
+	"text": "markdown",
+}
+
+func (r *ContractTerm) elementTypes() map[string]string {
+	return contractTermElementTypes
+}
+
+var contractTermElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.identifier", Name: "identifier", Field: 3, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Contract.term.issued", Name: "issued", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Contract.term.applies", Name: "applies", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Contract.term.topic[x]", Name: "topic", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Contract.term.type", Name: "type", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.subType", Name: "subType", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.text", Name: "text", Field: 10, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Contract.term.securityLabel", Name: "securityLabel", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.offer", Name: "offer", Field: 13, Min: 1, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.asset", Name: "asset", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.action", Name: "action", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.group", Name: "group", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ContractTerm) ElementMetadata() []ElementMetadata {
+	return contractTermElementMetadata
+}
+
+// ContractTermTopic is the type of Contract.term.topic[x]. It is implemented by the ContractTermTopic* variant types.
+type ContractTermTopic interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractTermTopic()
+}
+
+// ContractTermTopicCodeableConcept is the CodeableConcept variant of ContractTermTopic.
+type ContractTermTopicCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ContractTermTopicCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ContractTermTopicCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ContractTermTopicCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ContractTermTopicCodeableConcept) isContractTermTopic() {}
+
+// ContractTermTopicReference is the Reference variant of ContractTermTopic.
+type ContractTermTopicReference struct {
+	Reference
+}
+
+func (v ContractTermTopicReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractTermTopicReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractTermTopicReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractTermTopicReference) isContractTermTopic() {}
+
+var contractTermTopicVariants = []ContractTermTopic{
+	ContractTermTopicCodeableConcept{},
+	ContractTermTopicReference{},
+}
+
+type ContractTermSecurityLabel struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Number            []int       `json:"number,omitempty" bson:"number,omitempty"`                        // Link to Security Labels
+	NumberElement     []*Element  `json:"_number,omitempty" bson:"number_element,omitempty"`               // Extensions for number
+	Classification    *Coding     `json:"classification" bson:"classification"`                            // Confidentiality Protection
+	Category          []Coding    `json:"category,omitempty" bson:"category,omitempty"`                    // Applicable Policy
+	Control           []Coding    `json:"control,omitempty" bson:"control,omitempty"`                      // Handling Instructions
+}
+
+func (r *ContractTermSecurityLabel) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.Classification == nil {
+		return fmt.Errorf("field 'Classification' is required")
+	}
+	if r.Classification != nil {
+		if err := r.Classification.Validate(); err != nil {
+			return fmt.Errorf("Classification: %w", err)
+		}
+	}
+	for i, item := range r.Category {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Category[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Control {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Control[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermSecurityLabel) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.NumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.number[%d]", path, i), issues)
+	}
+	if r.Classification == nil {
+		issues.add("required", path+".classification", "field 'Classification' is required")
+	}
+	if r.Classification != nil {
+		r.Classification.validateAll(path+".classification", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	for i, item := range r.Control {
+		item.validateAll(fmt.Sprintf("%s.control[%d]", path, i), issues)
+	}
+}
+
+var contractTermSecurityLabelInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermSecurityLabel) invariants() []invariant {
+	return contractTermSecurityLabelInvariants
+}
+
+func (r ContractTermSecurityLabel) MarshalJSON() ([]byte, error) {
+	type alias ContractTermSecurityLabel
+	out := struct {
+		alias
+		Number        []*int     `json:"number,omitempty"`
+		NumberElement []*Element `json:"_number,omitempty"`
+	}{alias: alias(r)}
+	out.Number, out.NumberElement = alignPrimitiveArray(r.Number, r.NumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ContractTermSecurityLabel) UnmarshalJSON(data []byte) error {
+	type alias ContractTermSecurityLabel
+	aux := struct {
+		*alias
+		Number []*int `json:"number,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Number, err = splitPrimitiveArray(aux.Number, r.NumberElement); err != nil {
+		return fmt.Errorf("number: %w", err)
+	}
+	return nil
+}
+
+func (r ContractTermSecurityLabel) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermSecurityLabel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermSecurityLabel) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermSecurityLabel) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractTermSecurityLabelElementTypes = map[string]string{
+	"number": "unsignedInt",
+}
+
+func (r *ContractTermSecurityLabel) elementTypes() map[string]string {
+	return contractTermSecurityLabelElementTypes
+}
+
+var contractTermSecurityLabelElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.securityLabel.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.securityLabel.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.securityLabel.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.securityLabel.number", Name: "number", Field: 3, Min: 0, Max: "*", Types: []string{"unsignedInt"}},
+	{Path: "Contract.term.securityLabel.classification", Name: "classification", Field: 5, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "Contract.term.securityLabel.category", Name: "category", Field: 6, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Contract.term.securityLabel.control", Name: "control", Field: 7, Min: 0, Max: "*", Types: []string{"Coding"}},
+}
+
+func (r *ContractTermSecurityLabel) ElementMetadata() []ElementMetadata {
+	return contractTermSecurityLabelElementMetadata
+}
+
+type ContractTermOffer struct {
+	Id                         *string                   `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                  []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension          []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	Identifier                 []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                              // Offer business ID
+	Party                      []ContractTermOfferParty  `json:"party,omitempty" bson:"party,omitempty"`                                        // Offer Recipient
+	Topic                      *Reference                `json:"topic,omitempty" bson:"topic,omitempty"`                                        // Negotiable offer asset
+	Type                       *CodeableConcept          `json:"type,omitempty" bson:"type,omitempty"`                                          // Contract Offer Type or Form
+	Decision                   *CodeableConcept          `json:"decision,omitempty" bson:"decision,omitempty"`                                  // Accepting party choice
+	DecisionMode               []CodeableConcept         `json:"decisionMode,omitempty" bson:"decision_mode,omitempty"`                         // How decision is conveyed
+	Answer                     []ContractTermOfferAnswer `json:"answer,omitempty" bson:"answer,omitempty"`                                      // Response to offer text
+	Text                       *string                   `json:"text,omitempty" bson:"text,omitempty"`                                          // Human readable offer text
+	TextElement                *Element                  `json:"_text,omitempty" bson:"text_element,omitempty"`                                 // Extensions for text
+	LinkId                     []string                  `json:"linkId,omitempty" bson:"link_id,omitempty"`                                     // Pointer to text
+	LinkIdElement              []*Element                `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                            // Extensions for linkId
+	SecurityLabelNumber        []int                     `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"`          // Offer restriction numbers
+	SecurityLabelNumberElement []*Element                `json:"_securityLabelNumber,omitempty" bson:"security_label_number_element,omitempty"` // Extensions for securityLabelNumber
+}
+
+func (r *ContractTermOffer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Identifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Identifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Party {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Party[%d]: %w", i, err)
+		}
+	}
+	if r.Topic != nil {
+		if err := r.Topic.Validate(); err != nil {
+			return fmt.Errorf("Topic: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Decision != nil {
+		if err := r.Decision.Validate(); err != nil {
+			return fmt.Errorf("Decision: %w", err)
+		}
+	}
+	for i, item := range r.DecisionMode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DecisionMode[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Answer {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Answer[%d]: %w", i, err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SecurityLabelNumberElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermOffer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.Party {
+		item.validateAll(fmt.Sprintf("%s.party[%d]", path, i), issues)
+	}
+	if r.Topic != nil {
+		r.Topic.validateAll(path+".topic", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Decision != nil {
+		r.Decision.validateAll(path+".decision", issues)
+	}
+	for i, item := range r.DecisionMode {
+		item.validateAll(fmt.Sprintf("%s.decisionMode[%d]", path, i), issues)
+	}
+	for i, item := range r.Answer {
+		item.validateAll(fmt.Sprintf("%s.answer[%d]", path, i), issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.linkId[%d]", path, i), issues)
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.securityLabelNumber[%d]", path, i), issues)
+	}
+}
+
+var contractTermOfferInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermOffer) invariants() []invariant {
+	return contractTermOfferInvariants
+}
+
+func (r ContractTermOffer) MarshalJSON() ([]byte, error) {
+	type alias ContractTermOffer
+	out := struct {
+		alias
+		LinkId                     []*string  `json:"linkId,omitempty"`
+		LinkIdElement              []*Element `json:"_linkId,omitempty"`
+		SecurityLabelNumber        []*int     `json:"securityLabelNumber,omitempty"`
+		SecurityLabelNumberElement []*Element `json:"_securityLabelNumber,omitempty"`
+	}{alias: alias(r)}
+	out.LinkId, out.LinkIdElement = alignPrimitiveArray(r.LinkId, r.LinkIdElement)
+	out.SecurityLabelNumber, out.SecurityLabelNumberElement = alignPrimitiveArray(r.SecurityLabelNumber, r.SecurityLabelNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ContractTermOffer) UnmarshalJSON(data []byte) error {
+	type alias ContractTermOffer
+	aux := struct {
+		*alias
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.LinkId, err = splitPrimitiveArray(aux.LinkId, r.LinkIdElement); err != nil {
+		return fmt.Errorf("linkId: %w", err)
+	}
+	if r.SecurityLabelNumber, err = splitPrimitiveArray(aux.SecurityLabelNumber, r.SecurityLabelNumberElement); err != nil {
+		return fmt.Errorf("securityLabelNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ContractTermOffer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermOffer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermOffer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermOffer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractTermOfferElementTypes = map[string]string{
+	"securityLabelNumber": "unsignedInt",
+}
+
+func (r *ContractTermOffer) elementTypes() map[string]string {
+	return contractTermOfferElementTypes
+}
+
+var contractTermOfferElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.offer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.offer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.offer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.offer.identifier", Name: "identifier", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Contract.term.offer.party", Name: "party", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.offer.topic", Name: "topic", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.term.offer.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.offer.decision", Name: "decision", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.offer.decisionMode", Name: "decisionMode", Field: 8, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.offer.answer", Name: "answer", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.offer.text", Name: "text", Field: 10, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.offer.linkId", Name: "linkId", Field: 12, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.offer.securityLabelNumber", Name: "securityLabelNumber", Field: 14, Min: 0, Max: "*", Types: []string{"unsignedInt"}},
+}
+
+func (r *ContractTermOffer) ElementMetadata() []ElementMetadata {
+	return contractTermOfferElementMetadata
+}
+
+type ContractTermOfferParty struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         []Reference      `json:"reference" bson:"reference"`                                      // Referenced entity
+	Role              *CodeableConcept `json:"role" bson:"role"`                                                // Participant engagement type
+}
+
+func (r *ContractTermOfferParty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.Reference) < 1 {
+		return fmt.Errorf("field 'Reference' must have at least 1 elements")
+	}
+	for i, item := range r.Reference {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Reference[%d]: %w", i, err)
+		}
+	}
+	if r.Role == nil {
+		return fmt.Errorf("field 'Role' is required")
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermOfferParty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Reference) < 1 {
+		issues.add("required", path+".reference", "field 'Reference' must have at least 1 elements")
+	}
+	for i, item := range r.Reference {
+		item.validateAll(fmt.Sprintf("%s.reference[%d]", path, i), issues)
+	}
+	if r.Role == nil {
+		issues.add("required", path+".role", "field 'Role' is required")
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+}
+
+var contractTermOfferPartyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermOfferParty) invariants() []invariant {
+	return contractTermOfferPartyInvariants
+}
+
+func (r ContractTermOfferParty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermOfferParty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermOfferParty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermOfferParty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractTermOfferPartyElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.offer.party.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.offer.party.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.offer.party.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.offer.party.reference", Name: "reference", Field: 3, Min: 1, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.term.offer.party.role", Name: "role", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ContractTermOfferParty) ElementMetadata() []ElementMetadata {
+	return contractTermOfferPartyElementMetadata
+}
+
+type ContractTermOfferAnswer struct {
+	Id                *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             ContractTermOfferAnswerValue `json:"-" bson:"value,omitempty"`                                        // The actual answer response
+	ValueElement      *Element                     `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Contract.term.offer.answer.value[x] when more than one was decoded
+}
+
+func (r *ContractTermOfferAnswer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermOfferAnswer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var contractTermOfferAnswerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermOfferAnswer) invariants() []invariant {
+	return contractTermOfferAnswerInvariants
+}
+
+func (r ContractTermOfferAnswer) MarshalJSON() ([]byte, error) {
+	type alias ContractTermOfferAnswer
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractTermOfferAnswer) UnmarshalJSON(data []byte) error {
+	type alias ContractTermOfferAnswer
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", contractTermOfferAnswerValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ContractTermOfferAnswer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermOfferAnswer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermOfferAnswer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermOfferAnswer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractTermOfferAnswer) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(contractTermOfferAnswerValueVariants)
+	}
+	return "", nil
+}
+
+var contractTermOfferAnswerElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.offer.answer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.offer.answer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.offer.answer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.offer.answer.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"boolean", "decimal", "integer", "date", "dateTime", "time", "string", "uri", "Attachment", "Coding", "Quantity", "Reference"}},
+}
+
+func (r *ContractTermOfferAnswer) ElementMetadata() []ElementMetadata {
+	return contractTermOfferAnswerElementMetadata
+}
+
+// ContractTermOfferAnswerValue is the type of Contract.term.offer.answer.value[x]. It is implemented by the ContractTermOfferAnswerValue* variant types.
+type ContractTermOfferAnswerValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractTermOfferAnswerValue()
+}
+
+// ContractTermOfferAnswerValueBoolean is the boolean variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueBoolean bool
+
+func (v ContractTermOfferAnswerValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ContractTermOfferAnswerValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ContractTermOfferAnswerValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ContractTermOfferAnswerValueBoolean) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueDecimal is the decimal variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueDecimal struct {
+	Decimal
+}
+
+func (v ContractTermOfferAnswerValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ContractTermOfferAnswerValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ContractTermOfferAnswerValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ContractTermOfferAnswerValueDecimal) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueInteger is the integer variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueInteger int
+
+func (v ContractTermOfferAnswerValueInteger) Validate() error {
+	return nil
+}
+
+func (v ContractTermOfferAnswerValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ContractTermOfferAnswerValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ContractTermOfferAnswerValueInteger) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueDate is the date variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueDate struct {
+	Date
+}
+
+func (v ContractTermOfferAnswerValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ContractTermOfferAnswerValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ContractTermOfferAnswerValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ContractTermOfferAnswerValueDate) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueDateTime is the dateTime variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueDateTime struct {
+	DateTime
+}
+
+func (v ContractTermOfferAnswerValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ContractTermOfferAnswerValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ContractTermOfferAnswerValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ContractTermOfferAnswerValueDateTime) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueTime is the time variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueTime struct {
+	Time
+}
+
+func (v ContractTermOfferAnswerValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ContractTermOfferAnswerValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ContractTermOfferAnswerValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ContractTermOfferAnswerValueTime) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueString is the string variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueString string
+
+func (v ContractTermOfferAnswerValueString) Validate() error {
+	return nil
+}
+
+func (v ContractTermOfferAnswerValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ContractTermOfferAnswerValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ContractTermOfferAnswerValueString) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueUri is the uri variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueUri string
+
+func (v ContractTermOfferAnswerValueUri) Validate() error {
+	return nil
+}
+
+func (v ContractTermOfferAnswerValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ContractTermOfferAnswerValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ContractTermOfferAnswerValueUri) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueAttachment is the Attachment variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueAttachment struct {
+	Attachment
+}
+
+func (v ContractTermOfferAnswerValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ContractTermOfferAnswerValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ContractTermOfferAnswerValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ContractTermOfferAnswerValueAttachment) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueCoding is the Coding variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueCoding struct {
+	Coding
+}
+
+func (v ContractTermOfferAnswerValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ContractTermOfferAnswerValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ContractTermOfferAnswerValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ContractTermOfferAnswerValueCoding) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueQuantity is the Quantity variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueQuantity struct {
+	Quantity
+}
+
+func (v ContractTermOfferAnswerValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ContractTermOfferAnswerValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ContractTermOfferAnswerValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ContractTermOfferAnswerValueQuantity) isContractTermOfferAnswerValue() {}
+
+// ContractTermOfferAnswerValueReference is the Reference variant of ContractTermOfferAnswerValue.
+type ContractTermOfferAnswerValueReference struct {
+	Reference
+}
+
+func (v ContractTermOfferAnswerValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractTermOfferAnswerValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractTermOfferAnswerValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractTermOfferAnswerValueReference) isContractTermOfferAnswerValue() {}
+
+var contractTermOfferAnswerValueVariants = []ContractTermOfferAnswerValue{
+	ContractTermOfferAnswerValueBoolean(false),
+	ContractTermOfferAnswerValueDecimal{},
+	ContractTermOfferAnswerValueInteger(0),
+	ContractTermOfferAnswerValueDate{},
+	ContractTermOfferAnswerValueDateTime{},
+	ContractTermOfferAnswerValueTime{},
+	ContractTermOfferAnswerValueString(""),
+	ContractTermOfferAnswerValueUri(""),
+	ContractTermOfferAnswerValueAttachment{},
+	ContractTermOfferAnswerValueCoding{},
+	ContractTermOfferAnswerValueQuantity{},
+	ContractTermOfferAnswerValueReference{},
+}
+
+type ContractTermAsset struct {
+	Id                         *string                       `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                  []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension          []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	Scope                      *CodeableConcept              `json:"scope,omitempty" bson:"scope,omitempty"`                                        // Range of asset
+	Type                       []CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                                          // Asset category
+	TypeReference              []Reference                   `json:"typeReference,omitempty" bson:"type_reference,omitempty"`                       // Associated entities
+	Subtype                    []CodeableConcept             `json:"subtype,omitempty" bson:"subtype,omitempty"`                                    // Asset sub-category
+	Relationship               *Coding                       `json:"relationship,omitempty" bson:"relationship,omitempty"`                          // Kinship of the asset
+	Context                    []ContractTermAssetContext    `json:"context,omitempty" bson:"context,omitempty"`                                    // Circumstance of the asset
+	Condition                  *string                       `json:"condition,omitempty" bson:"condition,omitempty"`                                // Quality desctiption of asset
+	ConditionElement           *Element                      `json:"_condition,omitempty" bson:"condition_element,omitempty"`                       // Extensions for condition
+	PeriodType                 []CodeableConcept             `json:"periodType,omitempty" bson:"period_type,omitempty"`                             // Asset availability types
+	Period                     []Period                      `json:"period,omitempty" bson:"period,omitempty"`                                      // Time period of the asset
+	UsePeriod                  []Period                      `json:"usePeriod,omitempty" bson:"use_period,omitempty"`                               // Time period
+	Text                       *string                       `json:"text,omitempty" bson:"text,omitempty"`                                          // Asset clause or question text
+	TextElement                *Element                      `json:"_text,omitempty" bson:"text_element,omitempty"`                                 // Extensions for text
+	LinkId                     []string                      `json:"linkId,omitempty" bson:"link_id,omitempty"`                                     // Pointer to asset text
+	LinkIdElement              []*Element                    `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                            // Extensions for linkId
+	Answer                     []ContractTermOfferAnswer     `json:"answer,omitempty" bson:"answer,omitempty"`                                      // Response to assets
+	SecurityLabelNumber        []int                         `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"`          // Asset restriction numbers
+	SecurityLabelNumberElement []*Element                    `json:"_securityLabelNumber,omitempty" bson:"security_label_number_element,omitempty"` // Extensions for securityLabelNumber
+	ValuedItem                 []ContractTermAssetValuedItem `json:"valuedItem,omitempty" bson:"valued_item,omitempty"`                             // Contract Valued Item List
+}
+
+func (r *ContractTermAsset) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Scope != nil {
+		if err := r.Scope.Validate(); err != nil {
+			return fmt.Errorf("Scope: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TypeReference {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TypeReference[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Subtype {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Subtype[%d]: %w", i, err)
+		}
+	}
+	if r.Relationship != nil {
+		if err := r.Relationship.Validate(); err != nil {
+			return fmt.Errorf("Relationship: %w", err)
+		}
+	}
+	for i, item := range r.Context {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Context[%d]: %w", i, err)
+		}
+	}
+	if r.ConditionElement != nil {
+		if err := r.ConditionElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionElement: %w", err)
+		}
+	}
+	for i, item := range r.PeriodType {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PeriodType[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Period {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Period[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.UsePeriod {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("UsePeriod[%d]: %w", i, err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Answer {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Answer[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SecurityLabelNumberElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ValuedItem {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ValuedItem[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermAsset) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Scope != nil {
+		r.Scope.validateAll(path+".scope", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.TypeReference {
+		item.validateAll(fmt.Sprintf("%s.typeReference[%d]", path, i), issues)
+	}
+	for i, item := range r.Subtype {
+		item.validateAll(fmt.Sprintf("%s.subtype[%d]", path, i), issues)
+	}
+	if r.Relationship != nil {
+		r.Relationship.validateAll(path+".relationship", issues)
+	}
+	for i, item := range r.Context {
+		item.validateAll(fmt.Sprintf("%s.context[%d]", path, i), issues)
+	}
+	if r.ConditionElement != nil {
+		r.ConditionElement.validateAll(path+".condition", issues)
+	}
+	for i, item := range r.PeriodType {
+		item.validateAll(fmt.Sprintf("%s.periodType[%d]", path, i), issues)
+	}
+	for i, item := range r.Period {
+		item.validateAll(fmt.Sprintf("%s.period[%d]", path, i), issues)
+	}
+	for i, item := range r.UsePeriod {
+		item.validateAll(fmt.Sprintf("%s.usePeriod[%d]", path, i), issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.linkId[%d]", path, i), issues)
+	}
+	for i, item := range r.Answer {
+		item.validateAll(fmt.Sprintf("%s.answer[%d]", path, i), issues)
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.securityLabelNumber[%d]", path, i), issues)
+	}
+	for i, item := range r.ValuedItem {
+		item.validateAll(fmt.Sprintf("%s.valuedItem[%d]", path, i), issues)
+	}
+}
+
+var contractTermAssetInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermAsset) invariants() []invariant {
+	return contractTermAssetInvariants
+}
+
+func (r ContractTermAsset) MarshalJSON() ([]byte, error) {
+	type alias ContractTermAsset
+	out := struct {
+		alias
+		LinkId                     []*string  `json:"linkId,omitempty"`
+		LinkIdElement              []*Element `json:"_linkId,omitempty"`
+		SecurityLabelNumber        []*int     `json:"securityLabelNumber,omitempty"`
+		SecurityLabelNumberElement []*Element `json:"_securityLabelNumber,omitempty"`
+	}{alias: alias(r)}
+	out.LinkId, out.LinkIdElement = alignPrimitiveArray(r.LinkId, r.LinkIdElement)
+	out.SecurityLabelNumber, out.SecurityLabelNumberElement = alignPrimitiveArray(r.SecurityLabelNumber, r.SecurityLabelNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ContractTermAsset) UnmarshalJSON(data []byte) error {
+	type alias ContractTermAsset
+	aux := struct {
+		*alias
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.LinkId, err = splitPrimitiveArray(aux.LinkId, r.LinkIdElement); err != nil {
+		return fmt.Errorf("linkId: %w", err)
+	}
+	if r.SecurityLabelNumber, err = splitPrimitiveArray(aux.SecurityLabelNumber, r.SecurityLabelNumberElement); err != nil {
+		return fmt.Errorf("securityLabelNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ContractTermAsset) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermAsset) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermAsset) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermAsset) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractTermAssetElementTypes = map[string]string{
+	"securityLabelNumber": "unsignedInt",
+}
+
+func (r *ContractTermAsset) elementTypes() map[string]string {
+	return contractTermAssetElementTypes
+}
+
+var contractTermAssetElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.asset.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.asset.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.asset.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.asset.scope", Name: "scope", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.asset.type", Name: "type", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.asset.typeReference", Name: "typeReference", Field: 5, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.term.asset.subtype", Name: "subtype", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.asset.relationship", Name: "relationship", Field: 7, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "Contract.term.asset.context", Name: "context", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.asset.condition", Name: "condition", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.asset.periodType", Name: "periodType", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.asset.period", Name: "period", Field: 12, Min: 0, Max: "*", Types: []string{"Period"}},
+	{Path: "Contract.term.asset.usePeriod", Name: "usePeriod", Field: 13, Min: 0, Max: "*", Types: []string{"Period"}},
+	{Path: "Contract.term.asset.text", Name: "text", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.asset.linkId", Name: "linkId", Field: 16, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.asset.answer", Name: "answer", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.asset.securityLabelNumber", Name: "securityLabelNumber", Field: 19, Min: 0, Max: "*", Types: []string{"unsignedInt"}},
+	{Path: "Contract.term.asset.valuedItem", Name: "valuedItem", Field: 21, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ContractTermAsset) ElementMetadata() []ElementMetadata {
+	return contractTermAssetElementMetadata
+}
+
+type ContractTermAssetContext struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         *Reference        `json:"reference,omitempty" bson:"reference,omitempty"`                  // Creator,custodian or owner
+	Code              []CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // Codeable asset context
+	Text              *string           `json:"text,omitempty" bson:"text,omitempty"`                            // Context description
+	TextElement       *Element          `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+}
+
+func (r *ContractTermAssetContext) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Reference != nil {
+		if err := r.Reference.Validate(); err != nil {
+			return fmt.Errorf("Reference: %w", err)
+		}
+	}
+	for i, item := range r.Code {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Code[%d]: %w", i, err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermAssetContext) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+var contractTermAssetContextInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermAssetContext) invariants() []invariant {
+	return contractTermAssetContextInvariants
+}
+
+func (r ContractTermAssetContext) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermAssetContext) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermAssetContext) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermAssetContext) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractTermAssetContextElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.asset.context.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.asset.context.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.asset.context.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.asset.context.reference", Name: "reference", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.term.asset.context.code", Name: "code", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.asset.context.text", Name: "text", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ContractTermAssetContext) ElementMetadata() []ElementMetadata {
+	return contractTermAssetContextElementMetadata
+}
+
+type ContractTermAssetValuedItem struct {
+	Id                         *string                           `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                  []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension          []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	Entity                     ContractTermAssetValuedItemEntity `json:"-" bson:"entity,omitempty"`                                                     // Contract Valued Item Type
+	Identifier                 *Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                              // Contract Valued Item Number
+	EffectiveTime              *DateTime                         `json:"effectiveTime,omitempty" bson:"effective_time,omitempty"`                       // Contract Valued Item Effective Tiem
+	EffectiveTimeElement       *Element                          `json:"_effectiveTime,omitempty" bson:"effective_time_element,omitempty"`              // Extensions for effectiveTime
+	Quantity                   *Quantity                         `json:"quantity,omitempty" bson:"quantity,omitempty"`                                  // Count of Contract Valued Items
+	UnitPrice                  *Money                            `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                               // Contract Valued Item fee, charge, or cost
+	Factor                     *Decimal                          `json:"factor,omitempty" bson:"factor,omitempty"`                                      // Contract Valued Item Price Scaling Factor
+	FactorElement              *Element                          `json:"_factor,omitempty" bson:"factor_element,omitempty"`                             // Extensions for factor
+	Points                     *Decimal                          `json:"points,omitempty" bson:"points,omitempty"`                                      // Contract Valued Item Difficulty Scaling Factor
+	PointsElement              *Element                          `json:"_points,omitempty" bson:"points_element,omitempty"`                             // Extensions for points
+	Net                        *Money                            `json:"net,omitempty" bson:"net,omitempty"`                                            // Total Contract Valued Item Value
+	Payment                    *string                           `json:"payment,omitempty" bson:"payment,omitempty"`                                    // Terms of valuation
+	PaymentElement             *Element                          `json:"_payment,omitempty" bson:"payment_element,omitempty"`                           // Extensions for payment
+	PaymentDate                *DateTime                         `json:"paymentDate,omitempty" bson:"payment_date,omitempty"`                           // When payment is due
+	PaymentDateElement         *Element                          `json:"_paymentDate,omitempty" bson:"payment_date_element,omitempty"`                  // Extensions for paymentDate
+	Responsible                *Reference                        `json:"responsible,omitempty" bson:"responsible,omitempty"`                            // Who will make payment
+	Recipient                  *Reference                        `json:"recipient,omitempty" bson:"recipient,omitempty"`                                // Who will receive payment
+	LinkId                     []string                          `json:"linkId,omitempty" bson:"link_id,omitempty"`                                     // Pointer to specific item
+	LinkIdElement              []*Element                        `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                            // Extensions for linkId
+	SecurityLabelNumber        []int                             `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"`          // Security Labels that define affected terms
+	SecurityLabelNumberElement []*Element                        `json:"_securityLabelNumber,omitempty" bson:"security_label_number_element,omitempty"` // Extensions for securityLabelNumber
+
+	entityVariants []string // JSON properties of Contract.term.asset.valuedItem.entity[x] when more than one was decoded
+}
+
+func (r *ContractTermAssetValuedItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.entityVariants) > 1 {
+		return fmt.Errorf("field 'Entity' must have a single type, got %v", r.entityVariants)
+	}
+	if r.Entity != nil {
+		if err := r.Entity.Validate(); err != nil {
+			return fmt.Errorf("Entity: %w", err)
+		}
+	}
+	if r.Identifier != nil {
+		if err := r.Identifier.Validate(); err != nil {
+			return fmt.Errorf("Identifier: %w", err)
+		}
+	}
+	if r.EffectiveTime != nil {
+		if err := r.EffectiveTime.Validate(); err != nil {
+			return fmt.Errorf("EffectiveTime: %w", err)
+		}
+	}
+	if r.EffectiveTimeElement != nil {
+		if err := r.EffectiveTimeElement.Validate(); err != nil {
+			return fmt.Errorf("EffectiveTimeElement: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.Points != nil {
+		if err := r.Points.Validate(); err != nil {
+			return fmt.Errorf("Points: %w", err)
+		}
+	}
+	if r.PointsElement != nil {
+		if err := r.PointsElement.Validate(); err != nil {
+			return fmt.Errorf("PointsElement: %w", err)
+		}
+	}
+	if r.Net != nil {
+		if err := r.Net.Validate(); err != nil {
+			return fmt.Errorf("Net: %w", err)
+		}
+	}
+	if r.PaymentElement != nil {
+		if err := r.PaymentElement.Validate(); err != nil {
+			return fmt.Errorf("PaymentElement: %w", err)
+		}
+	}
+	if r.PaymentDate != nil {
+		if err := r.PaymentDate.Validate(); err != nil {
+			return fmt.Errorf("PaymentDate: %w", err)
+		}
+	}
+	if r.PaymentDateElement != nil {
+		if err := r.PaymentDateElement.Validate(); err != nil {
+			return fmt.Errorf("PaymentDateElement: %w", err)
+		}
+	}
+	if r.Responsible != nil {
+		if err := r.Responsible.Validate(); err != nil {
+			return fmt.Errorf("Responsible: %w", err)
+		}
+	}
+	if r.Recipient != nil {
+		if err := r.Recipient.Validate(); err != nil {
+			return fmt.Errorf("Recipient: %w", err)
+		}
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SecurityLabelNumberElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermAssetValuedItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.entityVariants) > 1 {
+		issues.add("structure", path+".entity", fmt.Sprintf("field 'Entity' must have a single type, got %v", r.entityVariants))
+	}
+	if r.Entity != nil {
+		r.Entity.validateAll(path+".entity.ofType("+r.Entity.FHIRType()+")", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.EffectiveTime != nil {
+		if err := r.EffectiveTime.Validate(); err != nil {
+			issues.add("value", path+".effectiveTime", err.Error())
+		}
+	}
+	if r.EffectiveTimeElement != nil {
+		r.EffectiveTimeElement.validateAll(path+".effectiveTime", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Points != nil {
+		if err := r.Points.Validate(); err != nil {
+			issues.add("value", path+".points", err.Error())
+		}
+	}
+	if r.PointsElement != nil {
+		r.PointsElement.validateAll(path+".points", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	if r.PaymentElement != nil {
+		r.PaymentElement.validateAll(path+".payment", issues)
+	}
+	if r.PaymentDate != nil {
+		if err := r.PaymentDate.Validate(); err != nil {
+			issues.add("value", path+".paymentDate", err.Error())
+		}
+	}
+	if r.PaymentDateElement != nil {
+		r.PaymentDateElement.validateAll(path+".paymentDate", issues)
+	}
+	if r.Responsible != nil {
+		r.Responsible.validateAll(path+".responsible", issues)
+	}
+	if r.Recipient != nil {
+		r.Recipient.validateAll(path+".recipient", issues)
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.linkId[%d]", path, i), issues)
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.securityLabelNumber[%d]", path, i), issues)
+	}
+}
+
+var contractTermAssetValuedItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermAssetValuedItem) invariants() []invariant {
+	return contractTermAssetValuedItemInvariants
+}
+
+func (r ContractTermAssetValuedItem) MarshalJSON() ([]byte, error) {
+	type alias ContractTermAssetValuedItem
+	out := struct {
+		alias
+		LinkId                     []*string  `json:"linkId,omitempty"`
+		LinkIdElement              []*Element `json:"_linkId,omitempty"`
+		SecurityLabelNumber        []*int     `json:"securityLabelNumber,omitempty"`
+		SecurityLabelNumberElement []*Element `json:"_securityLabelNumber,omitempty"`
+	}{alias: alias(r)}
+	out.LinkId, out.LinkIdElement = alignPrimitiveArray(r.LinkId, r.LinkIdElement)
+	out.SecurityLabelNumber, out.SecurityLabelNumberElement = alignPrimitiveArray(r.SecurityLabelNumber, r.SecurityLabelNumberElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "entity", r.Entity, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractTermAssetValuedItem) UnmarshalJSON(data []byte) error {
+	type alias ContractTermAssetValuedItem
+	aux := struct {
+		*alias
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.LinkId, err = splitPrimitiveArray(aux.LinkId, r.LinkIdElement); err != nil {
+		return fmt.Errorf("linkId: %w", err)
+	}
+	if r.SecurityLabelNumber, err = splitPrimitiveArray(aux.SecurityLabelNumber, r.SecurityLabelNumberElement); err != nil {
+		return fmt.Errorf("securityLabelNumber: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Entity, _, r.entityVariants, err = unmarshalChoice(fields, "entity", contractTermAssetValuedItemEntityVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ContractTermAssetValuedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermAssetValuedItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermAssetValuedItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermAssetValuedItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractTermAssetValuedItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Entity":
+		return "entity", choiceValues(contractTermAssetValuedItemEntityVariants)
+	}
+	return "", nil
+}
+
+var contractTermAssetValuedItemElementTypes = map[string]string{
+	"securityLabelNumber": "unsignedInt",
+}
+
+func (r *ContractTermAssetValuedItem) elementTypes() map[string]string {
+	return contractTermAssetValuedItemElementTypes
+}
+
+var contractTermAssetValuedItemElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.asset.valuedItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.asset.valuedItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.asset.valuedItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.asset.valuedItem.entity[x]", Name: "entity", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Contract.term.asset.valuedItem.identifier", Name: "identifier", Field: 4, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Contract.term.asset.valuedItem.effectiveTime", Name: "effectiveTime", Field: 5, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Contract.term.asset.valuedItem.quantity", Name: "quantity", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Contract.term.asset.valuedItem.unitPrice", Name: "unitPrice", Field: 8, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Contract.term.asset.valuedItem.factor", Name: "factor", Field: 9, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Contract.term.asset.valuedItem.points", Name: "points", Field: 11, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Contract.term.asset.valuedItem.net", Name: "net", Field: 13, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Contract.term.asset.valuedItem.payment", Name: "payment", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.asset.valuedItem.paymentDate", Name: "paymentDate", Field: 16, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Contract.term.asset.valuedItem.responsible", Name: "responsible", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.term.asset.valuedItem.recipient", Name: "recipient", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.term.asset.valuedItem.linkId", Name: "linkId", Field: 20, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.asset.valuedItem.securityLabelNumber", Name: "securityLabelNumber", Field: 22, Min: 0, Max: "*", Types: []string{"unsignedInt"}},
+}
+
+func (r *ContractTermAssetValuedItem) ElementMetadata() []ElementMetadata {
+	return contractTermAssetValuedItemElementMetadata
+}
+
+// ContractTermAssetValuedItemEntity is the type of Contract.term.asset.valuedItem.entity[x]. It is implemented by the ContractTermAssetValuedItemEntity* variant types.
+type ContractTermAssetValuedItemEntity interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractTermAssetValuedItemEntity()
+}
+
+// ContractTermAssetValuedItemEntityCodeableConcept is the CodeableConcept variant of ContractTermAssetValuedItemEntity.
+type ContractTermAssetValuedItemEntityCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ContractTermAssetValuedItemEntityCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ContractTermAssetValuedItemEntityCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ContractTermAssetValuedItemEntityCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ContractTermAssetValuedItemEntityCodeableConcept) isContractTermAssetValuedItemEntity() {}
+
+// ContractTermAssetValuedItemEntityReference is the Reference variant of ContractTermAssetValuedItemEntity.
+type ContractTermAssetValuedItemEntityReference struct {
+	Reference
+}
+
+func (v ContractTermAssetValuedItemEntityReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractTermAssetValuedItemEntityReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractTermAssetValuedItemEntityReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractTermAssetValuedItemEntityReference) isContractTermAssetValuedItemEntity() {}
+
+var contractTermAssetValuedItemEntityVariants = []ContractTermAssetValuedItemEntity{
+	ContractTermAssetValuedItemEntityCodeableConcept{},
+	ContractTermAssetValuedItemEntityReference{},
+}
+
+type ContractTermAction struct {
+	Id                         *string                      `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                  []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension          []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	DoNotPerform               *bool                        `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`                        // True if the term prohibits the  action
+	DoNotPerformElement        *Element                     `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`               // Extensions for doNotPerform
+	Type                       *CodeableConcept             `json:"type" bson:"type"`                                                              // Type or form of the action
+	Subject                    []ContractTermActionSubject  `json:"subject,omitempty" bson:"subject,omitempty"`                                    // Entity of the action
+	Intent                     *CodeableConcept             `json:"intent" bson:"intent"`                                                          // Purpose for the Contract Term Action
+	LinkId                     []string                     `json:"linkId,omitempty" bson:"link_id,omitempty"`                                     // Pointer to specific item
+	LinkIdElement              []*Element                   `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                            // Extensions for linkId
+	Status                     *CodeableConcept             `json:"status" bson:"status"`                                                          // State of the action
+	Context                    *Reference                   `json:"context,omitempty" bson:"context,omitempty"`                                    // Episode associated with action
+	ContextLinkId              []string                     `json:"contextLinkId,omitempty" bson:"context_link_id,omitempty"`                      // Pointer to specific item
+	ContextLinkIdElement       []*Element                   `json:"_contextLinkId,omitempty" bson:"context_link_id_element,omitempty"`             // Extensions for contextLinkId
+	Occurrence                 ContractTermActionOccurrence `json:"-" bson:"occurrence,omitempty"`                                                 // When action happens
+	OccurrenceElement          *Element                     `json:"-" bson:"occurrence_element,omitempty"`                                         // Extensions for occurrence[x]
+	Requester                  []Reference                  `json:"requester,omitempty" bson:"requester,omitempty"`                                // Who asked for action
+	RequesterLinkId            []string                     `json:"requesterLinkId,omitempty" bson:"requester_link_id,omitempty"`                  // Pointer to specific item
+	RequesterLinkIdElement     []*Element                   `json:"_requesterLinkId,omitempty" bson:"requester_link_id_element,omitempty"`         // Extensions for requesterLinkId
+	PerformerType              []CodeableConcept            `json:"performerType,omitempty" bson:"performer_type,omitempty"`                       // Kind of service performer
+	PerformerRole              *CodeableConcept             `json:"performerRole,omitempty" bson:"performer_role,omitempty"`                       // Competency of the performer
+	Performer                  *Reference                   `json:"performer,omitempty" bson:"performer,omitempty"`                                // Actor that wil execute (or not) the action
+	PerformerLinkId            []string                     `json:"performerLinkId,omitempty" bson:"performer_link_id,omitempty"`                  // Pointer to specific item
+	PerformerLinkIdElement     []*Element                   `json:"_performerLinkId,omitempty" bson:"performer_link_id_element,omitempty"`         // Extensions for performerLinkId
+	Reason                     []CodeableReference          `json:"reason,omitempty" bson:"reason,omitempty"`                                      // Why is action (not) needed?
+	ReasonLinkId               []string                     `json:"reasonLinkId,omitempty" bson:"reason_link_id,omitempty"`                        // Pointer to specific item
+	ReasonLinkIdElement        []*Element                   `json:"_reasonLinkId,omitempty" bson:"reason_link_id_element,omitempty"`               // Extensions for reasonLinkId
+	Note                       []Annotation                 `json:"note,omitempty" bson:"note,omitempty"`                                          // Comments about the action
+	SecurityLabelNumber        []int                        `json:"securityLabelNumber,omitempty" bson:"security_label_number,omitempty"`          // Action restriction numbers
+	SecurityLabelNumberElement []*Element                   `json:"_securityLabelNumber,omitempty" bson:"security_label_number_element,omitempty"` // Extensions for securityLabelNumber
+
+	occurrenceVariants []string // JSON properties of Contract.term.action.occurrence[x] when more than one was decoded
+}
+
+func (r *ContractTermAction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	for i, item := range r.Subject {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Subject[%d]: %w", i, err)
+		}
+	}
+	if r.Intent == nil {
+		return fmt.Errorf("field 'Intent' is required")
+	}
+	if r.Intent != nil {
+		if err := r.Intent.Validate(); err != nil {
+			return fmt.Errorf("Intent: %w", err)
+		}
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement[%d]: %w", i, err)
+		}
+	}
+	if r.Status == nil {
+		return fmt.Errorf("field 'Status' is required")
+	}
+	if r.Status != nil {
+		if err := r.Status.Validate(); err != nil {
+			return fmt.Errorf("Status: %w", err)
+		}
+	}
+	if r.Context != nil {
+		if err := r.Context.Validate(); err != nil {
+			return fmt.Errorf("Context: %w", err)
+		}
+	}
+	for i, item := range r.ContextLinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ContextLinkIdElement[%d]: %w", i, err)
+		}
+	}
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
+	}
+	for i, item := range r.Requester {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Requester[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.RequesterLinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RequesterLinkIdElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.PerformerType {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PerformerType[%d]: %w", i, err)
+		}
+	}
+	if r.PerformerRole != nil {
+		if err := r.PerformerRole.Validate(); err != nil {
+			return fmt.Errorf("PerformerRole: %w", err)
+		}
+	}
+	if r.Performer != nil {
+		if err := r.Performer.Validate(); err != nil {
+			return fmt.Errorf("Performer: %w", err)
+		}
+	}
+	for i, item := range r.PerformerLinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PerformerLinkIdElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Reason {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Reason[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ReasonLinkIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReasonLinkIdElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SecurityLabelNumberElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermAction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
+	}
+	if r.Intent == nil {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != nil {
+		r.Intent.validateAll(path+".intent", issues)
+	}
+	for i, item := range r.LinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.linkId[%d]", path, i), issues)
+	}
+	if r.Status == nil {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.Context != nil {
+		r.Context.validateAll(path+".context", issues)
+	}
+	for i, item := range r.ContextLinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.contextLinkId[%d]", path, i), issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	for i, item := range r.Requester {
+		item.validateAll(fmt.Sprintf("%s.requester[%d]", path, i), issues)
+	}
+	for i, item := range r.RequesterLinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.requesterLinkId[%d]", path, i), issues)
+	}
+	for i, item := range r.PerformerType {
+		item.validateAll(fmt.Sprintf("%s.performerType[%d]", path, i), issues)
+	}
+	if r.PerformerRole != nil {
+		r.PerformerRole.validateAll(path+".performerRole", issues)
+	}
+	if r.Performer != nil {
+		r.Performer.validateAll(path+".performer", issues)
+	}
+	for i, item := range r.PerformerLinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.performerLinkId[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.ReasonLinkIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.reasonLinkId[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.SecurityLabelNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.securityLabelNumber[%d]", path, i), issues)
+	}
+}
+
+var contractTermActionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermAction) invariants() []invariant {
+	return contractTermActionInvariants
+}
+
+func (r ContractTermAction) MarshalJSON() ([]byte, error) {
+	type alias ContractTermAction
+	out := struct {
+		alias
+		LinkId                     []*string  `json:"linkId,omitempty"`
+		LinkIdElement              []*Element `json:"_linkId,omitempty"`
+		ContextLinkId              []*string  `json:"contextLinkId,omitempty"`
+		ContextLinkIdElement       []*Element `json:"_contextLinkId,omitempty"`
+		RequesterLinkId            []*string  `json:"requesterLinkId,omitempty"`
+		RequesterLinkIdElement     []*Element `json:"_requesterLinkId,omitempty"`
+		PerformerLinkId            []*string  `json:"performerLinkId,omitempty"`
+		PerformerLinkIdElement     []*Element `json:"_performerLinkId,omitempty"`
+		ReasonLinkId               []*string  `json:"reasonLinkId,omitempty"`
+		ReasonLinkIdElement        []*Element `json:"_reasonLinkId,omitempty"`
+		SecurityLabelNumber        []*int     `json:"securityLabelNumber,omitempty"`
+		SecurityLabelNumberElement []*Element `json:"_securityLabelNumber,omitempty"`
+	}{alias: alias(r)}
+	out.LinkId, out.LinkIdElement = alignPrimitiveArray(r.LinkId, r.LinkIdElement)
+	out.ContextLinkId, out.ContextLinkIdElement = alignPrimitiveArray(r.ContextLinkId, r.ContextLinkIdElement)
+	out.RequesterLinkId, out.RequesterLinkIdElement = alignPrimitiveArray(r.RequesterLinkId, r.RequesterLinkIdElement)
+	out.PerformerLinkId, out.PerformerLinkIdElement = alignPrimitiveArray(r.PerformerLinkId, r.PerformerLinkIdElement)
+	out.ReasonLinkId, out.ReasonLinkIdElement = alignPrimitiveArray(r.ReasonLinkId, r.ReasonLinkIdElement)
+	out.SecurityLabelNumber, out.SecurityLabelNumberElement = alignPrimitiveArray(r.SecurityLabelNumber, r.SecurityLabelNumberElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractTermAction) UnmarshalJSON(data []byte) error {
+	type alias ContractTermAction
+	aux := struct {
+		*alias
+		LinkId              []*string `json:"linkId,omitempty"`
+		ContextLinkId       []*string `json:"contextLinkId,omitempty"`
+		RequesterLinkId     []*string `json:"requesterLinkId,omitempty"`
+		PerformerLinkId     []*string `json:"performerLinkId,omitempty"`
+		ReasonLinkId        []*string `json:"reasonLinkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.LinkId, err = splitPrimitiveArray(aux.LinkId, r.LinkIdElement); err != nil {
+		return fmt.Errorf("linkId: %w", err)
+	}
+	if r.ContextLinkId, err = splitPrimitiveArray(aux.ContextLinkId, r.ContextLinkIdElement); err != nil {
+		return fmt.Errorf("contextLinkId: %w", err)
+	}
+	if r.RequesterLinkId, err = splitPrimitiveArray(aux.RequesterLinkId, r.RequesterLinkIdElement); err != nil {
+		return fmt.Errorf("requesterLinkId: %w", err)
+	}
+	if r.PerformerLinkId, err = splitPrimitiveArray(aux.PerformerLinkId, r.PerformerLinkIdElement); err != nil {
+		return fmt.Errorf("performerLinkId: %w", err)
+	}
+	if r.ReasonLinkId, err = splitPrimitiveArray(aux.ReasonLinkId, r.ReasonLinkIdElement); err != nil {
+		return fmt.Errorf("reasonLinkId: %w", err)
+	}
+	if r.SecurityLabelNumber, err = splitPrimitiveArray(aux.SecurityLabelNumber, r.SecurityLabelNumberElement); err != nil {
+		return fmt.Errorf("securityLabelNumber: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", contractTermActionOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ContractTermAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermAction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractTermAction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(contractTermActionOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var contractTermActionElementTypes = map[string]string{
+	"securityLabelNumber": "unsignedInt",
+}
+
+func (r *ContractTermAction) elementTypes() map[string]string {
+	return contractTermActionElementTypes
+}
+
+var contractTermActionElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.action.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.action.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.action.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.action.doNotPerform", Name: "doNotPerform", Field: 3, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Contract.term.action.type", Name: "type", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.action.subject", Name: "subject", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Contract.term.action.intent", Name: "intent", Field: 7, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.action.linkId", Name: "linkId", Field: 8, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.action.status", Name: "status", Field: 10, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.action.context", Name: "context", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.term.action.contextLinkId", Name: "contextLinkId", Field: 12, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.action.occurrence[x]", Name: "occurrence", Field: 14, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "Contract.term.action.requester", Name: "requester", Field: 16, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.term.action.requesterLinkId", Name: "requesterLinkId", Field: 17, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.action.performerType", Name: "performerType", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.action.performerRole", Name: "performerRole", Field: 20, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Contract.term.action.performer", Name: "performer", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Contract.term.action.performerLinkId", Name: "performerLinkId", Field: 22, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.action.reason", Name: "reason", Field: 24, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Contract.term.action.reasonLinkId", Name: "reasonLinkId", Field: 25, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Contract.term.action.note", Name: "note", Field: 27, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Contract.term.action.securityLabelNumber", Name: "securityLabelNumber", Field: 28, Min: 0, Max: "*", Types: []string{"unsignedInt"}},
+}
+
+func (r *ContractTermAction) ElementMetadata() []ElementMetadata {
+	return contractTermActionElementMetadata
+}
+
+// ContractTermActionOccurrence is the type of Contract.term.action.occurrence[x]. It is implemented by the ContractTermActionOccurrence* variant types.
+type ContractTermActionOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractTermActionOccurrence()
+}
+
+// ContractTermActionOccurrenceDateTime is the dateTime variant of ContractTermActionOccurrence.
+type ContractTermActionOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v ContractTermActionOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ContractTermActionOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ContractTermActionOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ContractTermActionOccurrenceDateTime) isContractTermActionOccurrence() {}
+
+// ContractTermActionOccurrencePeriod is the Period variant of ContractTermActionOccurrence.
+type ContractTermActionOccurrencePeriod struct {
+	Period
+}
+
+func (v ContractTermActionOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ContractTermActionOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ContractTermActionOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ContractTermActionOccurrencePeriod) isContractTermActionOccurrence() {}
+
+// ContractTermActionOccurrenceTiming is the Timing variant of ContractTermActionOccurrence.
+type ContractTermActionOccurrenceTiming struct {
+	Timing
+}
+
+func (v ContractTermActionOccurrenceTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ContractTermActionOccurrenceTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ContractTermActionOccurrenceTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ContractTermActionOccurrenceTiming) isContractTermActionOccurrence() {}
+
+var contractTermActionOccurrenceVariants = []ContractTermActionOccurrence{
+	ContractTermActionOccurrenceDateTime{},
+	ContractTermActionOccurrencePeriod{},
+	ContractTermActionOccurrenceTiming{},
+}
+
+type ContractTermActionSubject struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         []Reference      `json:"reference" bson:"reference"`                                      // Entity of the action
+	Role              *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // Role type of the agent
+}
+
+func (r *ContractTermActionSubject) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.Reference) < 1 {
+		return fmt.Errorf("field 'Reference' must have at least 1 elements")
+	}
+	for i, item := range r.Reference {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Reference[%d]: %w", i, err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractTermActionSubject) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Reference) < 1 {
+		issues.add("required", path+".reference", "field 'Reference' must have at least 1 elements")
+	}
+	for i, item := range r.Reference {
+		item.validateAll(fmt.Sprintf("%s.reference[%d]", path, i), issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+}
+
+var contractTermActionSubjectInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractTermActionSubject) invariants() []invariant {
+	return contractTermActionSubjectInvariants
+}
+
+func (r ContractTermActionSubject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractTermActionSubject) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractTermActionSubject) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractTermActionSubject) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractTermActionSubjectElementMetadata = []ElementMetadata{
+	{Path: "Contract.term.action.subject.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.term.action.subject.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.term.action.subject.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.term.action.subject.reference", Name: "reference", Field: 3, Min: 1, Max: "*", Types: []string{"Reference"}},
+	{Path: "Contract.term.action.subject.role", Name: "role", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ContractTermActionSubject) ElementMetadata() []ElementMetadata {
+	return contractTermActionSubjectElementMetadata
+}
+
+type ContractSigner struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *Coding     `json:"type" bson:"type"`                                                // Contract Signatory Role
+	Party             *Reference  `json:"party" bson:"party"`                                              // Contract Signatory Party
+	Signature         []Signature `json:"signature" bson:"signature"`                                      // Contract Documentation Signature
+}
+
+var contractSignerPartyTargets = []string{"Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+func (r *ContractSigner) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
 	if r.Party == nil {
 		return fmt.Errorf("field 'Party' is required")
 	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, contractSignerPartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, contractSignerPartyTargets)
+		}
+	}
 	if r.Party != nil {
 		if err := r.Party.Validate(); err != nil {
 			return fmt.Errorf("Party: %w", err)
@@ -873,28 +3484,611 @@ func (r *ContractSigner) Validate() error {
 	return nil
 }
 
+func (r *ContractSigner) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Party == nil {
+		issues.add("required", path+".party", "field 'Party' is required")
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, contractSignerPartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, contractSignerPartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+	if len(r.Signature) < 1 {
+		issues.add("required", path+".signature", "field 'Signature' must have at least 1 elements")
+	}
+	for i, item := range r.Signature {
+		item.validateAll(fmt.Sprintf("%s.signature[%d]", path, i), issues)
+	}
+}
+
+var contractSignerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractSigner) invariants() []invariant {
+	return contractSignerInvariants
+}
+
+func (r ContractSigner) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractSigner) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractSigner) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractSigner) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var contractSignerElementMetadata = []ElementMetadata{
+	{Path: "Contract.signer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.signer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.signer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.signer.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "Contract.signer.party", Name: "party", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Contract.signer.signature", Name: "signature", Field: 5, Min: 1, Max: "*", Types: []string{"Signature"}},
+}
+
+func (r *ContractSigner) ElementMetadata() []ElementMetadata {
+	return contractSignerElementMetadata
+}
+
+type ContractFriendly struct {
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Content           ContractFriendlyContent `json:"-" bson:"content,omitempty"`                                      // Easily comprehended representation of this Contract
+
+	contentVariants []string // JSON properties of Contract.friendly.content[x] when more than one was decoded
+}
+
+func (r *ContractFriendly) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.contentVariants) > 1 {
+		return fmt.Errorf("field 'Content' must have a single type, got %v", r.contentVariants)
+	}
+	if r.Content == nil {
+		return fmt.Errorf("field 'Content' is required")
+	}
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractFriendly) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.contentVariants) > 1 {
+		issues.add("structure", path+".content", fmt.Sprintf("field 'Content' must have a single type, got %v", r.contentVariants))
+	}
+	if r.Content == nil {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content.ofType("+r.Content.FHIRType()+")", issues)
+	}
+}
+
+var contractFriendlyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractFriendly) invariants() []invariant {
+	return contractFriendlyInvariants
+}
+
+func (r ContractFriendly) MarshalJSON() ([]byte, error) {
+	type alias ContractFriendly
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "content", r.Content, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractFriendly) UnmarshalJSON(data []byte) error {
+	type alias ContractFriendly
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Content, _, r.contentVariants, err = unmarshalChoice(fields, "content", contractFriendlyContentVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ContractFriendly) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractFriendly) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractFriendly) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractFriendly) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractFriendly) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Content":
+		return "content", choiceValues(contractFriendlyContentVariants)
+	}
+	return "", nil
+}
+
+var contractFriendlyElementMetadata = []ElementMetadata{
+	{Path: "Contract.friendly.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.friendly.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.friendly.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.friendly.content[x]", Name: "content", Field: 3, Min: 1, Max: "1", Types: []string{"Attachment", "Reference"}},
+}
+
+func (r *ContractFriendly) ElementMetadata() []ElementMetadata {
+	return contractFriendlyElementMetadata
+}
+
+// ContractFriendlyContent is the type of Contract.friendly.content[x]. It is implemented by the ContractFriendlyContent* variant types.
+type ContractFriendlyContent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractFriendlyContent()
+}
+
+// ContractFriendlyContentAttachment is the Attachment variant of ContractFriendlyContent.
+type ContractFriendlyContentAttachment struct {
+	Attachment
+}
+
+func (v ContractFriendlyContentAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ContractFriendlyContentAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ContractFriendlyContentAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ContractFriendlyContentAttachment) isContractFriendlyContent() {}
+
+// ContractFriendlyContentReference is the Reference variant of ContractFriendlyContent.
+type ContractFriendlyContentReference struct {
+	Reference
+}
+
+func (v ContractFriendlyContentReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractFriendlyContentReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractFriendlyContentReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractFriendlyContentReference) isContractFriendlyContent() {}
+
+var contractFriendlyContentVariants = []ContractFriendlyContent{
+	ContractFriendlyContentAttachment{},
+	ContractFriendlyContentReference{},
+}
+
 type ContractLegal struct {
-	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	ContentAttachment *Attachment `json:"contentAttachment" bson:"content_attachment"` // Contract Legal Text
-	ContentReference  *Reference  `json:"contentReference" bson:"content_reference"`   // Contract Legal Text
+	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Content           ContractLegalContent `json:"-" bson:"content,omitempty"`                                      // Contract Legal Text
+
+	contentVariants []string // JSON properties of Contract.legal.content[x] when more than one was decoded
 }
 
 func (r *ContractLegal) Validate() error {
-	if r.ContentAttachment == nil {
-		return fmt.Errorf("field 'ContentAttachment' is required")
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.ContentAttachment != nil {
-		if err := r.ContentAttachment.Validate(); err != nil {
-			return fmt.Errorf("ContentAttachment: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.contentVariants) > 1 {
+		return fmt.Errorf("field 'Content' must have a single type, got %v", r.contentVariants)
+	}
+	if r.Content == nil {
+		return fmt.Errorf("field 'Content' is required")
+	}
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ContractLegal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.contentVariants) > 1 {
+		issues.add("structure", path+".content", fmt.Sprintf("field 'Content' must have a single type, got %v", r.contentVariants))
+	}
+	if r.Content == nil {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content.ofType("+r.Content.FHIRType()+")", issues)
+	}
+}
+
+var contractLegalInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractLegal) invariants() []invariant {
+	return contractLegalInvariants
+}
+
+func (r ContractLegal) MarshalJSON() ([]byte, error) {
+	type alias ContractLegal
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "content", r.Content, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractLegal) UnmarshalJSON(data []byte) error {
+	type alias ContractLegal
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Content, _, r.contentVariants, err = unmarshalChoice(fields, "content", contractLegalContentVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ContractLegal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractLegal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractLegal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractLegal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractLegal) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Content":
+		return "content", choiceValues(contractLegalContentVariants)
+	}
+	return "", nil
+}
+
+var contractLegalElementMetadata = []ElementMetadata{
+	{Path: "Contract.legal.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.legal.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.legal.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.legal.content[x]", Name: "content", Field: 3, Min: 1, Max: "1", Types: []string{"Attachment", "Reference"}},
+}
+
+func (r *ContractLegal) ElementMetadata() []ElementMetadata {
+	return contractLegalElementMetadata
+}
+
+// ContractLegalContent is the type of Contract.legal.content[x]. It is implemented by the ContractLegalContent* variant types.
+type ContractLegalContent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractLegalContent()
+}
+
+// ContractLegalContentAttachment is the Attachment variant of ContractLegalContent.
+type ContractLegalContentAttachment struct {
+	Attachment
+}
+
+func (v ContractLegalContentAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ContractLegalContentAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ContractLegalContentAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ContractLegalContentAttachment) isContractLegalContent() {}
+
+// ContractLegalContentReference is the Reference variant of ContractLegalContent.
+type ContractLegalContentReference struct {
+	Reference
+}
+
+func (v ContractLegalContentReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractLegalContentReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractLegalContentReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractLegalContentReference) isContractLegalContent() {}
+
+var contractLegalContentVariants = []ContractLegalContent{
+	ContractLegalContentAttachment{},
+	ContractLegalContentReference{},
+}
+
+type ContractRule struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Content           ContractRuleContent `json:"-" bson:"content,omitempty"`                                      // Computable Contract Rules
+
+	contentVariants []string // JSON properties of Contract.rule.content[x] when more than one was decoded
+}
+
+func (r *ContractRule) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ContentReference == nil {
-		return fmt.Errorf("field 'ContentReference' is required")
+	if len(r.contentVariants) > 1 {
+		return fmt.Errorf("field 'Content' must have a single type, got %v", r.contentVariants)
+	}
+	if r.Content == nil {
+		return fmt.Errorf("field 'Content' is required")
 	}
-	if r.ContentReference != nil {
-		if err := r.ContentReference.Validate(); err != nil {
-			return fmt.Errorf("ContentReference: %w", err)
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r *ContractRule) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.contentVariants) > 1 {
+		issues.add("structure", path+".content", fmt.Sprintf("field 'Content' must have a single type, got %v", r.contentVariants))
+	}
+	if r.Content == nil {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content.ofType("+r.Content.FHIRType()+")", issues)
+	}
+}
+
+var contractRuleInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ContractRule) invariants() []invariant {
+	return contractRuleInvariants
+}
+
+func (r ContractRule) MarshalJSON() ([]byte, error) {
+	type alias ContractRule
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "content", r.Content, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ContractRule) UnmarshalJSON(data []byte) error {
+	type alias ContractRule
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Content, _, r.contentVariants, err = unmarshalChoice(fields, "content", contractRuleContentVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ContractRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ContractRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ContractRule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ContractRule) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ContractRule) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Content":
+		return "content", choiceValues(contractRuleContentVariants)
+	}
+	return "", nil
+}
+
+var contractRuleElementMetadata = []ElementMetadata{
+	{Path: "Contract.rule.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Contract.rule.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Contract.rule.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Contract.rule.content[x]", Name: "content", Field: 3, Min: 1, Max: "1", Types: []string{"Attachment", "Reference"}},
+}
+
+func (r *ContractRule) ElementMetadata() []ElementMetadata {
+	return contractRuleElementMetadata
+}
+
+// ContractRuleContent is the type of Contract.rule.content[x]. It is implemented by the ContractRuleContent* variant types.
+type ContractRuleContent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isContractRuleContent()
+}
+
+// ContractRuleContentAttachment is the Attachment variant of ContractRuleContent.
+type ContractRuleContentAttachment struct {
+	Attachment
+}
+
+func (v ContractRuleContentAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ContractRuleContentAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ContractRuleContentAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ContractRuleContentAttachment) isContractRuleContent() {}
+
+// ContractRuleContentReference is the Reference variant of ContractRuleContent.
+type ContractRuleContentReference struct {
+	Reference
+}
+
+func (v ContractRuleContentReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ContractRuleContentReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ContractRuleContentReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ContractRuleContentReference) isContractRuleContent() {}
+
+var contractRuleContentVariants = []ContractRuleContent{
+	ContractRuleContentAttachment{},
+	ContractRuleContentReference{},
+}
@@ -1,9 +1,109 @@
 package models
 
-// This operation takes a resource in one form, and returns to in another form. Both the 'resource' and 'return' parameters are a single resource. The primary use of this operation is to convert between formats (e.g. (XML -> JSON or vice versa)
-type Convert struct {
+import "fmt"
+
+// ConvertIn holds the input parameters of the $convert operation. This operation takes a resource in one form, and returns to in another form. Both the 'resource' and 'return' parameters are a single resource. The primary use of this operation is to convert between formats (e.g. (XML -> JSON or vice versa)
+type ConvertIn struct {
+	// The input to convert
+	Input Resource
+}
+
+func (p *ConvertIn) Validate() error {
+	if p.Input == nil {
+		return fmt.Errorf("parameter 'input' is required")
+	}
+	return nil
+}
+
+func (p *ConvertIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ConvertIn) FromParameters(params *Parameters) error {
+	*p = ConvertIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ConvertIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Input != nil {
+		params = append(params, ParametersParameter{Name: "input", Resource: p.Input})
+	}
+	return params
+}
+
+func (p *ConvertIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "input":
+			if p.Input != nil {
+				return fmt.Errorf("parameter 'input' must occur at most once")
+			}
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.Input = v
+		}
+	}
+	return nil
+}
+
+// ConvertOut holds the output parameters of the $convert operation.
+type ConvertOut struct {
+	// The converted resource
+	Output Resource
+}
+
+func (p *ConvertOut) Validate() error {
+	if p.Output == nil {
+		return fmt.Errorf("parameter 'output' is required")
+	}
+	return nil
+}
+
+func (p *ConvertOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ConvertOut) FromParameters(params *Parameters) error {
+	*p = ConvertOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ConvertOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Output != nil {
+		params = append(params, ParametersParameter{Name: "output", Resource: p.Output})
+	}
+	return params
 }
 
-func (r *Convert) Validate() error {
+func (p *ConvertOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "output":
+			if p.Output != nil {
+				return fmt.Errorf("parameter 'output' must occur at most once")
+			}
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.Output = v
+		}
+	}
 	return nil
 }
@@ -1,15 +1,150 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Count Type: A measured amount (or an amount that can potentially be measured). Note that measured amounts include amounts that are not precisely quantified, including amounts involving arbitrary units and floating currencies.
 type Count struct {
-	Id         *string  `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Value      *float64 `json:"value,omitempty" bson:"value,omitempty"`           // Numerical value (with implicit precision)
-	Comparator *string  `json:"comparator,omitempty" bson:"comparator,omitempty"` // < | <= | >= | > | ad - how to understand the value
-	Unit       *string  `json:"unit,omitempty" bson:"unit,omitempty"`             // Unit representation
-	System     *string  `json:"system,omitempty" bson:"system,omitempty"`         // System that defines coded unit form
-	Code       *string  `json:"code,omitempty" bson:"code,omitempty"`             // Coded form of the unit
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`            // Additional content defined by implementations
+	Value             *Decimal            `json:"value,omitempty" bson:"value,omitempty"`                    // Numerical value (with implicit precision)
+	ValueElement      *Element            `json:"_value,omitempty" bson:"value_element,omitempty"`           // Extensions for value
+	Comparator        *QuantityComparator `json:"comparator,omitempty" bson:"comparator,omitempty"`          // < | <= | >= | > | ad - how to understand the value
+	ComparatorElement *Element            `json:"_comparator,omitempty" bson:"comparator_element,omitempty"` // Extensions for comparator
+	Unit              *string             `json:"unit,omitempty" bson:"unit,omitempty"`                      // Unit representation
+	UnitElement       *Element            `json:"_unit,omitempty" bson:"unit_element,omitempty"`             // Extensions for unit
+	System            *string             `json:"system,omitempty" bson:"system,omitempty"`                  // System that defines coded unit form
+	SystemElement     *Element            `json:"_system,omitempty" bson:"system_element,omitempty"`         // Extensions for system
+	Code              *string             `json:"code,omitempty" bson:"code,omitempty"`                      // Coded form of the unit
+	CodeElement       *Element            `json:"_code,omitempty" bson:"code_element,omitempty"`             // Extensions for code
 }
 
 func (r *Count) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		return fmt.Errorf("field 'Comparator' has invalid code '%s'", *r.Comparator)
+	}
+	if r.ComparatorElement != nil {
+		if err := r.ComparatorElement.Validate(); err != nil {
+			return fmt.Errorf("ComparatorElement: %w", err)
+		}
+	}
+	if r.UnitElement != nil {
+		if err := r.UnitElement.Validate(); err != nil {
+			return fmt.Errorf("UnitElement: %w", err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Count) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			issues.add("value", path+".value", err.Error())
+		}
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		issues.add("code-invalid", path+".comparator", fmt.Sprintf("field 'Comparator' has invalid code '%s'", *r.Comparator))
+	}
+	if r.ComparatorElement != nil {
+		r.ComparatorElement.validateAll(path+".comparator", issues)
+	}
+	if r.UnitElement != nil {
+		r.UnitElement.validateAll(path+".unit", issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+}
+
+func (r *Count) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Count", &issues)
+	checkInvariants(r, "Count", &issues)
+	return issues
+}
+
+var countInvariants = []invariant{
+	{key: "cnt-3", severity: "error", human: "There SHALL be a code with a value of \"1\" if there is a value. If system is present, it SHALL be UCUM.  If present, the value SHALL be a whole number.", expression: "(code.exists() or value.empty()) and (system.empty() or system = %ucum) and (code.empty() or code = '1') and (value.empty() or value.hasValue().not() or value.toString().contains('.').not()) and (value.empty() or value.hasValue().not() or value >= 0)"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "qty-3", severity: "error", human: "If a code for the unit is present, the system SHALL also be present", expression: "code.empty() or system.exists()"},
+	{key: "qty-4", severity: "warning", human: "Processing UCUM codes with annotations ({..}) can be misleading.", expression: "code.exists() implies code.matches('\\\\{.*?\\\\}').not()"},
+}
+
+func (r *Count) invariants() []invariant {
+	return countInvariants
+}
+
+func (r Count) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Count) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Count) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Count) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var countElementTypes = map[string]string{
+	"system": "uri",
+	"code":   "code",
+}
+
+func (r *Count) elementTypes() map[string]string {
+	return countElementTypes
+}
+
+var countElementMetadata = []ElementMetadata{
+	{Path: "Count.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Count.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Count.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Count.comparator", Name: "comparator", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Count.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/quantity-comparator", Description: "How the Quantity should be understood and represented."}, Summary: true, Modifier: true},
+	{Path: "Count.unit", Name: "unit", Field: 6, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Count.system", Name: "system", Field: 8, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "Count.code", Name: "code", Field: 10, Min: 0, Max: "1", Types: []string{"code"}, Summary: true},
+}
+
+func (r *Count) ElementMetadata() []ElementMetadata {
+	return countElementMetadata
+}
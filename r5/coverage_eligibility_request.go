@@ -2,37 +2,56 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The CoverageEligibilityRequest provides patient and insurance coverage information to an insurer for them to respond, in the form of an CoverageEligibilityResponse, with information regarding whether the stated coverage is valid and in-force and optionally to provide the insurance details of the policy.
 type CoverageEligibilityRequest struct {
-	ResourceType   string                                     `json:"resourceType" bson:"resource_type"`                         // Type of resource
-	Id             *string                                    `json:"id,omitempty" bson:"id,omitempty"`                          // Logical id of this artifact
-	Meta           *Meta                                      `json:"meta,omitempty" bson:"meta,omitempty"`                      // Metadata about the resource
-	ImplicitRules  *string                                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`   // A set of rules under which this content was created
-	Language       *string                                    `json:"language,omitempty" bson:"language,omitempty"`              // Language of the resource content
-	Text           *Narrative                                 `json:"text,omitempty" bson:"text,omitempty"`                      // Text summary of the resource, for human interpretation
-	Contained      []json.RawMessage                          `json:"contained,omitempty" bson:"contained,omitempty"`            // Contained, inline Resources
-	Identifier     []Identifier                               `json:"identifier,omitempty" bson:"identifier,omitempty"`          // Business Identifier for coverage eligiblity request
-	Status         string                                     `json:"status" bson:"status"`                                      // active | cancelled | draft | entered-in-error
-	StatusReason   *string                                    `json:"statusReason,omitempty" bson:"status_reason,omitempty"`     // Reason for status change
-	Priority       *CodeableConcept                           `json:"priority,omitempty" bson:"priority,omitempty"`              // Desired processing priority
-	Purpose        []string                                   `json:"purpose" bson:"purpose"`                                    // auth-requirements | benefits | discovery | validation
-	Patient        *Reference                                 `json:"patient" bson:"patient"`                                    // Intended recipient of products and services
-	Event          []CoverageEligibilityRequestEvent          `json:"event,omitempty" bson:"event,omitempty"`                    // Event information
-	ServicedDate   *string                                    `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`     // Estimated date or dates of service
-	ServicedPeriod *Period                                    `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"` // Estimated date or dates of service
-	Created        string                                     `json:"created" bson:"created"`                                    // Creation date
-	Enterer        *Reference                                 `json:"enterer,omitempty" bson:"enterer,omitempty"`                // Author
-	Provider       *Reference                                 `json:"provider,omitempty" bson:"provider,omitempty"`              // Party responsible for the request
-	Insurer        *Reference                                 `json:"insurer" bson:"insurer"`                                    // Coverage issuer
-	Facility       *Reference                                 `json:"facility,omitempty" bson:"facility,omitempty"`              // Servicing facility
-	SupportingInfo []CoverageEligibilityRequestSupportingInfo `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"` // Supporting information
-	Insurance      []CoverageEligibilityRequestInsurance      `json:"insurance,omitempty" bson:"insurance,omitempty"`            // Patient insurance information
-	Item           []CoverageEligibilityRequestItem           `json:"item,omitempty" bson:"item,omitempty"`                      // Item to be evaluated for eligibiity
+	ResourceType         string                                     `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                                      `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                                    `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                                   `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                                 `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                                 `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                               `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier for coverage eligiblity request
+	Status               ExplanationOfBenefitStatus                 `json:"status" bson:"status"`                                             // active | cancelled | draft | entered-in-error
+	StatusElement        *Element                                   `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	StatusReason         *string                                    `json:"statusReason,omitempty" bson:"status_reason,omitempty"`            // Reason for status change
+	StatusReasonElement  *Element                                   `json:"_statusReason,omitempty" bson:"status_reason_element,omitempty"`   // Extensions for statusReason
+	Priority             *CodeableConcept                           `json:"priority,omitempty" bson:"priority,omitempty"`                     // Desired processing priority
+	Purpose              []EligibilityRequestPurpose                `json:"purpose" bson:"purpose"`                                           // auth-requirements | benefits | discovery | validation
+	PurposeElement       []*Element                                 `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`              // Extensions for purpose
+	Patient              *Reference                                 `json:"patient" bson:"patient"`                                           // Intended recipient of products and services
+	Event                []CoverageEligibilityRequestEvent          `json:"event,omitempty" bson:"event,omitempty"`                           // Event information
+	Serviced             CoverageEligibilityRequestServiced         `json:"-" bson:"serviced,omitempty"`                                      // Estimated date or dates of service
+	ServicedElement      *Element                                   `json:"-" bson:"serviced_element,omitempty"`                              // Extensions for serviced[x]
+	Created              *DateTime                                  `json:"created" bson:"created"`                                           // Creation date
+	CreatedElement       *Element                                   `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Enterer              *Reference                                 `json:"enterer,omitempty" bson:"enterer,omitempty"`                       // Author
+	Provider             *Reference                                 `json:"provider,omitempty" bson:"provider,omitempty"`                     // Party responsible for the request
+	Insurer              *Reference                                 `json:"insurer" bson:"insurer"`                                           // Coverage issuer
+	Facility             *Reference                                 `json:"facility,omitempty" bson:"facility,omitempty"`                     // Servicing facility
+	SupportingInfo       []CoverageEligibilityRequestSupportingInfo `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`        // Supporting information
+	Insurance            []CoverageEligibilityRequestInsurance      `json:"insurance,omitempty" bson:"insurance,omitempty"`                   // Patient insurance information
+	Item                 []CoverageEligibilityRequestItem           `json:"item,omitempty" bson:"item,omitempty"`                             // Item to be evaluated for eligibiity
+
+	servicedVariants []string // JSON properties of CoverageEligibilityRequest.serviced[x] when more than one was decoded
 }
 
+var coverageEligibilityRequestPatientTargets = []string{"Patient"}
+
+var coverageEligibilityRequestEntererTargets = []string{"Practitioner", "PractitionerRole"}
+
+var coverageEligibilityRequestProviderTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var coverageEligibilityRequestFacilityTargets = []string{"Location"}
+
 func (r *CoverageEligibilityRequest) Validate() error {
 	if r.ResourceType != "CoverageEligibilityRequest" {
 		return fmt.Errorf("invalid resourceType: expected 'CoverageEligibilityRequest', got '%s'", r.ResourceType)
@@ -42,20 +61,60 @@ func (r *CoverageEligibilityRequest) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.StatusReasonElement != nil {
+		if err := r.StatusReasonElement.Validate(); err != nil {
+			return fmt.Errorf("StatusReasonElement: %w", err)
+		}
+	}
 	if r.Priority != nil {
 		if err := r.Priority.Validate(); err != nil {
 			return fmt.Errorf("Priority: %w", err)
@@ -64,9 +123,27 @@ func (r *CoverageEligibilityRequest) Validate() error {
 	if len(r.Purpose) < 1 {
 		return fmt.Errorf("field 'Purpose' must have at least 1 elements")
 	}
+	for i, item := range r.Purpose {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Purpose[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.PurposeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement[%d]: %w", i, err)
+		}
+	}
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, coverageEligibilityRequestPatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestPatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
@@ -77,19 +154,47 @@ func (r *CoverageEligibilityRequest) Validate() error {
 			return fmt.Errorf("Event[%d]: %w", i, err)
 		}
 	}
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
+	}
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
 		}
 	}
-	if r.Created == emptyString {
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Enterer != nil {
+		if target := invalidReferenceTarget(r.Enterer.Reference, r.Enterer.Type, coverageEligibilityRequestEntererTargets); target != "" {
+			return fmt.Errorf("field 'Enterer' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestEntererTargets)
+		}
+	}
 	if r.Enterer != nil {
 		if err := r.Enterer.Validate(); err != nil {
 			return fmt.Errorf("Enterer: %w", err)
 		}
 	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, coverageEligibilityRequestProviderTargets); target != "" {
+			return fmt.Errorf("field 'Provider' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestProviderTargets)
+		}
+	}
 	if r.Provider != nil {
 		if err := r.Provider.Validate(); err != nil {
 			return fmt.Errorf("Provider: %w", err)
@@ -103,6 +208,11 @@ func (r *CoverageEligibilityRequest) Validate() error {
 			return fmt.Errorf("Insurer: %w", err)
 		}
 	}
+	if r.Facility != nil {
+		if target := invalidReferenceTarget(r.Facility.Reference, r.Facility.Type, coverageEligibilityRequestFacilityTargets); target != "" {
+			return fmt.Errorf("field 'Facility' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestFacilityTargets)
+		}
+	}
 	if r.Facility != nil {
 		if err := r.Facility.Validate(); err != nil {
 			return fmt.Errorf("Facility: %w", err)
@@ -123,119 +233,401 @@ func (r *CoverageEligibilityRequest) Validate() error {
 			return fmt.Errorf("Item[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type CoverageEligibilityRequestInsurance struct {
-	Id                  *string    `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Focal               *bool      `json:"focal,omitempty" bson:"focal,omitempty"`                              // Applicable coverage
-	Coverage            *Reference `json:"coverage" bson:"coverage"`                                            // Insurance information
-	BusinessArrangement *string    `json:"businessArrangement,omitempty" bson:"business_arrangement,omitempty"` // Additional provider contract number
+	return validateInvariants(r, "CoverageEligibilityRequest")
 }
 
-func (r *CoverageEligibilityRequestInsurance) Validate() error {
-	if r.Coverage == nil {
-		return fmt.Errorf("field 'Coverage' is required")
+func (r *CoverageEligibilityRequest) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CoverageEligibilityRequest" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CoverageEligibilityRequest', got '%s'", r.ResourceType))
 	}
-	if r.Coverage != nil {
-		if err := r.Coverage.Validate(); err != nil {
-			return fmt.Errorf("Coverage: %w", err)
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type CoverageEligibilityRequestItem struct {
-	Id                     *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
-	SupportingInfoSequence []int                                     `json:"supportingInfoSequence,omitempty" bson:"supporting_info_sequence,omitempty"` // Applicable exception or supporting information
-	Category               *CodeableConcept                          `json:"category,omitempty" bson:"category,omitempty"`                               // Benefit classification
-	ProductOrService       *CodeableConcept                          `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`             // Billing, service, product, or drug code
-	Modifier               []CodeableConcept                         `json:"modifier,omitempty" bson:"modifier,omitempty"`                               // Product or service billing modifiers
-	Provider               *Reference                                `json:"provider,omitempty" bson:"provider,omitempty"`                               // Perfoming practitioner
-	Quantity               *Quantity                                 `json:"quantity,omitempty" bson:"quantity,omitempty"`                               // Count of products or services
-	UnitPrice              *Money                                    `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                            // Fee, charge or cost per item
-	Facility               *Reference                                `json:"facility,omitempty" bson:"facility,omitempty"`                               // Servicing facility
-	Diagnosis              []CoverageEligibilityRequestItemDiagnosis `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                             // Applicable diagnosis
-	Detail                 []Reference                               `json:"detail,omitempty" bson:"detail,omitempty"`                                   // Product or service details
-}
-
-func (r *CoverageEligibilityRequestItem) Validate() error {
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReasonElement != nil {
+		r.StatusReasonElement.validateAll(path+".statusReason", issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+	if len(r.Purpose) < 1 {
+		issues.add("required", path+".purpose", "field 'Purpose' must have at least 1 elements")
+	}
+	for i, item := range r.Purpose {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.purpose[%d]", path, i), fmt.Sprintf("field 'Purpose[%d]' has invalid code '%s'", i, item))
 		}
 	}
-	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
+	for i, item := range r.PurposeElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.purpose[%d]", path, i), issues)
 	}
-	for i, item := range r.Modifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, coverageEligibilityRequestPatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestPatientTargets))
 		}
 	}
-	if r.Provider != nil {
-		if err := r.Provider.Validate(); err != nil {
-			return fmt.Errorf("Provider: %w", err)
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
 		}
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Enterer != nil {
+		if target := invalidReferenceTarget(r.Enterer.Reference, r.Enterer.Type, coverageEligibilityRequestEntererTargets); target != "" {
+			issues.add("value", path+".enterer", fmt.Sprintf("field 'Enterer' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestEntererTargets))
 		}
 	}
-	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
+	if r.Enterer != nil {
+		r.Enterer.validateAll(path+".enterer", issues)
+	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, coverageEligibilityRequestProviderTargets); target != "" {
+			issues.add("value", path+".provider", fmt.Sprintf("field 'Provider' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestProviderTargets))
 		}
 	}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
+	}
+	if r.Insurer == nil {
+		issues.add("required", path+".insurer", "field 'Insurer' is required")
+	}
+	if r.Insurer != nil {
+		r.Insurer.validateAll(path+".insurer", issues)
+	}
 	if r.Facility != nil {
-		if err := r.Facility.Validate(); err != nil {
-			return fmt.Errorf("Facility: %w", err)
+		if target := invalidReferenceTarget(r.Facility.Reference, r.Facility.Type, coverageEligibilityRequestFacilityTargets); target != "" {
+			issues.add("value", path+".facility", fmt.Sprintf("field 'Facility' cannot refer to %s, expected one of %v", target, coverageEligibilityRequestFacilityTargets))
 		}
 	}
-	for i, item := range r.Diagnosis {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Diagnosis[%d]: %w", i, err)
-		}
+	if r.Facility != nil {
+		r.Facility.validateAll(path+".facility", issues)
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
-		}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type CoverageEligibilityRequestItemDiagnosis struct {
-	Id                       *string          `json:"id,omitempty" bson:"id,omitempty"`                                               // Unique id for inter-element referencing
-	DiagnosisCodeableConcept *CodeableConcept `json:"diagnosisCodeableConcept,omitempty" bson:"diagnosis_codeable_concept,omitempty"` // Nature of illness or problem
-	DiagnosisReference       *Reference       `json:"diagnosisReference,omitempty" bson:"diagnosis_reference,omitempty"`              // Nature of illness or problem
+func (r *CoverageEligibilityRequest) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CoverageEligibilityRequest", &issues)
+	checkInvariants(r, "CoverageEligibilityRequest", &issues)
+	return issues
 }
 
-func (r *CoverageEligibilityRequestItemDiagnosis) Validate() error {
-	if r.DiagnosisCodeableConcept != nil {
-		if err := r.DiagnosisCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DiagnosisCodeableConcept: %w", err)
+var coverageEligibilityRequestInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *CoverageEligibilityRequest) invariants() []invariant {
+	return coverageEligibilityRequestInvariants
+}
+
+func (r CoverageEligibilityRequest) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityRequest
+	out := struct {
+		alias
+		Purpose        []*EligibilityRequestPurpose `json:"purpose"`
+		PurposeElement []*Element                   `json:"_purpose,omitempty"`
+	}{alias: alias(r)}
+	out.Purpose, out.PurposeElement = alignPrimitiveArray(r.Purpose, r.PurposeElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CoverageEligibilityRequest) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityRequest
+	aux := struct {
+		*alias
+		Contained []json.RawMessage            `json:"contained,omitempty"`
+		Purpose   []*EligibilityRequestPurpose `json:"purpose"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Purpose, err = splitPrimitiveArray(aux.Purpose, r.PurposeElement); err != nil {
+		return fmt.Errorf("purpose: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", coverageEligibilityRequestServicedVariants); err != nil {
+		return err
 	}
-	if r.DiagnosisReference != nil {
-		if err := r.DiagnosisReference.Validate(); err != nil {
-			return fmt.Errorf("DiagnosisReference: %w", err)
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r CoverageEligibilityRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CoverageEligibilityRequest) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(coverageEligibilityRequestServicedVariants)
+	}
+	return "", nil
+}
+
+var coverageEligibilityRequestElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *CoverageEligibilityRequest) elementTypes() map[string]string {
+	return coverageEligibilityRequestElementTypes
+}
+
+var coverageEligibilityRequestElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityRequest.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CoverageEligibilityRequest.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CoverageEligibilityRequest.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CoverageEligibilityRequest.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CoverageEligibilityRequest.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CoverageEligibilityRequest.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityRequest.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CoverageEligibilityRequest.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "CoverageEligibilityRequest.statusReason", Name: "statusReason", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityRequest.priority", Name: "priority", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityRequest.purpose", Name: "purpose", Field: 17, Min: 1, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityRequest.purpose", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/eligibilityrequest-purpose"}},
+	{Path: "CoverageEligibilityRequest.patient", Name: "patient", Field: 19, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "CoverageEligibilityRequest.event", Name: "event", Field: 20, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityRequest.serviced[x]", Name: "serviced", Field: 21, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "CoverageEligibilityRequest.created", Name: "created", Field: 23, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CoverageEligibilityRequest.enterer", Name: "enterer", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "CoverageEligibilityRequest.provider", Name: "provider", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "CoverageEligibilityRequest.insurer", Name: "insurer", Field: 27, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityRequest.facility", Name: "facility", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "CoverageEligibilityRequest.supportingInfo", Name: "supportingInfo", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityRequest.insurance", Name: "insurance", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityRequest.item", Name: "item", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CoverageEligibilityRequest) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityRequestElementMetadata
+}
+
+// CoverageEligibilityRequestServiced is the type of CoverageEligibilityRequest.serviced[x]. It is implemented by the CoverageEligibilityRequestServiced* variant types.
+type CoverageEligibilityRequestServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityRequestServiced()
+}
+
+// CoverageEligibilityRequestServicedDate is the date variant of CoverageEligibilityRequestServiced.
+type CoverageEligibilityRequestServicedDate struct {
+	Date
+}
+
+func (v CoverageEligibilityRequestServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v CoverageEligibilityRequestServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CoverageEligibilityRequestServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v CoverageEligibilityRequestServicedDate) isCoverageEligibilityRequestServiced() {}
+
+// CoverageEligibilityRequestServicedPeriod is the Period variant of CoverageEligibilityRequestServiced.
+type CoverageEligibilityRequestServicedPeriod struct {
+	Period
+}
+
+func (v CoverageEligibilityRequestServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v CoverageEligibilityRequestServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityRequestServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v CoverageEligibilityRequestServicedPeriod) isCoverageEligibilityRequestServiced() {}
+
+var coverageEligibilityRequestServicedVariants = []CoverageEligibilityRequestServiced{
+	CoverageEligibilityRequestServicedDate{},
+	CoverageEligibilityRequestServicedPeriod{},
+}
+
+func (r *CoverageEligibilityRequest) GetResourceType() string {
+	return "CoverageEligibilityRequest"
+}
+
+func (r *CoverageEligibilityRequest) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CoverageEligibilityRequest) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CoverageEligibilityRequest) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CoverageEligibilityRequest) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CoverageEligibilityRequest) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CoverageEligibilityRequest) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CoverageEligibilityRequest) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CoverageEligibilityRequest)(nil)
+
 type CoverageEligibilityRequestEvent struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Type         *CodeableConcept `json:"type" bson:"type"`                   // Specific event
-	WhenDateTime *string          `json:"whenDateTime" bson:"when_date_time"` // Occurance date or period
-	WhenPeriod   *Period          `json:"whenPeriod" bson:"when_period"`      // Occurance date or period
+	Id                *string                             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                    `json:"type" bson:"type"`                                                // Specific event
+	When              CoverageEligibilityRequestEventWhen `json:"-" bson:"when,omitempty"`                                         // Occurance date or period
+	WhenElement       *Element                            `json:"-" bson:"when_element,omitempty"`                                 // Extensions for when[x]
+
+	whenVariants []string // JSON properties of CoverageEligibilityRequest.event.when[x] when more than one was decoded
 }
 
 func (r *CoverageEligibilityRequestEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -244,38 +636,783 @@ func (r *CoverageEligibilityRequestEvent) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.WhenDateTime == nil {
-		return fmt.Errorf("field 'WhenDateTime' is required")
+	if len(r.whenVariants) > 1 {
+		return fmt.Errorf("field 'When' must have a single type, got %v", r.whenVariants)
 	}
-	if r.WhenPeriod == nil {
-		return fmt.Errorf("field 'WhenPeriod' is required")
+	if r.When == nil {
+		return fmt.Errorf("field 'When' is required")
 	}
-	if r.WhenPeriod != nil {
-		if err := r.WhenPeriod.Validate(); err != nil {
-			return fmt.Errorf("WhenPeriod: %w", err)
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
+		}
+	}
+	if r.WhenElement != nil {
+		if err := r.WhenElement.Validate(); err != nil {
+			return fmt.Errorf("WhenElement: %w", err)
 		}
 	}
 	return nil
 }
 
-type CoverageEligibilityRequestSupportingInfo struct {
-	Id           *string    `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Sequence     int        `json:"sequence" bson:"sequence"`                               // Information instance identifier
-	Information  *Reference `json:"information" bson:"information"`                         // Data to be provided
-	AppliesToAll *bool      `json:"appliesToAll,omitempty" bson:"applies_to_all,omitempty"` // Applies to all items
+func (r *CoverageEligibilityRequestEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.whenVariants) > 1 {
+		issues.add("structure", path+".when", fmt.Sprintf("field 'When' must have a single type, got %v", r.whenVariants))
+	}
+	if r.When == nil {
+		issues.add("required", path+".when", "field 'When' is required")
+	}
+	if r.When != nil {
+		r.When.validateAll(path+".when.ofType("+r.When.FHIRType()+")", issues)
+	}
+	if r.WhenElement != nil {
+		r.WhenElement.validateAll(path+".when", issues)
+	}
 }
 
-func (r *CoverageEligibilityRequestSupportingInfo) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+var coverageEligibilityRequestEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityRequestEvent) invariants() []invariant {
+	return coverageEligibilityRequestEventInvariants
+}
+
+func (r CoverageEligibilityRequestEvent) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityRequestEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.Information == nil {
-		return fmt.Errorf("field 'Information' is required")
+	if data, err = marshalChoice(data, "when", r.When, r.WhenElement); err != nil {
+		return nil, err
 	}
-	if r.Information != nil {
-		if err := r.Information.Validate(); err != nil {
-			return fmt.Errorf("Information: %w", err)
+	return data, nil
+}
+
+func (r *CoverageEligibilityRequestEvent) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityRequestEvent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.When, element, r.whenVariants, err = unmarshalChoice(fields, "when", coverageEligibilityRequestEventWhenVariants); err != nil {
+		return err
+	}
+	r.WhenElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.WhenElement); err != nil {
+			return fmt.Errorf("_when: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r CoverageEligibilityRequestEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityRequestEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityRequestEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityRequestEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CoverageEligibilityRequestEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "When":
+		return "when", choiceValues(coverageEligibilityRequestEventWhenVariants)
+	}
+	return "", nil
+}
+
+var coverageEligibilityRequestEventElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityRequest.event.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityRequest.event.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityRequest.event.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.event.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityRequest.event.when[x]", Name: "when", Field: 4, Min: 1, Max: "1", Types: []string{"dateTime", "Period"}},
+}
+
+func (r *CoverageEligibilityRequestEvent) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityRequestEventElementMetadata
+}
+
+// CoverageEligibilityRequestEventWhen is the type of CoverageEligibilityRequest.event.when[x]. It is implemented by the CoverageEligibilityRequestEventWhen* variant types.
+type CoverageEligibilityRequestEventWhen interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityRequestEventWhen()
+}
+
+// CoverageEligibilityRequestEventWhenDateTime is the dateTime variant of CoverageEligibilityRequestEventWhen.
+type CoverageEligibilityRequestEventWhenDateTime struct {
+	DateTime
+}
+
+func (v CoverageEligibilityRequestEventWhenDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v CoverageEligibilityRequestEventWhenDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CoverageEligibilityRequestEventWhenDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v CoverageEligibilityRequestEventWhenDateTime) isCoverageEligibilityRequestEventWhen() {}
+
+// CoverageEligibilityRequestEventWhenPeriod is the Period variant of CoverageEligibilityRequestEventWhen.
+type CoverageEligibilityRequestEventWhenPeriod struct {
+	Period
+}
+
+func (v CoverageEligibilityRequestEventWhenPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v CoverageEligibilityRequestEventWhenPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityRequestEventWhenPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v CoverageEligibilityRequestEventWhenPeriod) isCoverageEligibilityRequestEventWhen() {}
+
+var coverageEligibilityRequestEventWhenVariants = []CoverageEligibilityRequestEventWhen{
+	CoverageEligibilityRequestEventWhenDateTime{},
+	CoverageEligibilityRequestEventWhenPeriod{},
+}
+
+type CoverageEligibilityRequestSupportingInfo struct {
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence            int         `json:"sequence" bson:"sequence"`                                        // Information instance identifier
+	SequenceElement     *Element    `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Information         *Reference  `json:"information" bson:"information"`                                  // Data to be provided
+	AppliesToAll        *bool       `json:"appliesToAll,omitempty" bson:"applies_to_all,omitempty"`          // Applies to all items
+	AppliesToAllElement *Element    `json:"_appliesToAll,omitempty" bson:"applies_to_all_element,omitempty"` // Extensions for appliesToAll
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.Information == nil {
+		return fmt.Errorf("field 'Information' is required")
+	}
+	if r.Information != nil {
+		if err := r.Information.Validate(); err != nil {
+			return fmt.Errorf("Information: %w", err)
+		}
+	}
+	if r.AppliesToAllElement != nil {
+		if err := r.AppliesToAllElement.Validate(); err != nil {
+			return fmt.Errorf("AppliesToAllElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Information == nil {
+		issues.add("required", path+".information", "field 'Information' is required")
+	}
+	if r.Information != nil {
+		r.Information.validateAll(path+".information", issues)
+	}
+	if r.AppliesToAllElement != nil {
+		r.AppliesToAllElement.validateAll(path+".appliesToAll", issues)
+	}
+}
+
+var coverageEligibilityRequestSupportingInfoInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) invariants() []invariant {
+	return coverageEligibilityRequestSupportingInfoInvariants
+}
+
+func (r CoverageEligibilityRequestSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityRequestSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var coverageEligibilityRequestSupportingInfoElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) elementTypes() map[string]string {
+	return coverageEligibilityRequestSupportingInfoElementTypes
+}
+
+var coverageEligibilityRequestSupportingInfoElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityRequest.supportingInfo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityRequest.supportingInfo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityRequest.supportingInfo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.supportingInfo.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "CoverageEligibilityRequest.supportingInfo.information", Name: "information", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityRequest.supportingInfo.appliesToAll", Name: "appliesToAll", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *CoverageEligibilityRequestSupportingInfo) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityRequestSupportingInfoElementMetadata
+}
+
+type CoverageEligibilityRequestInsurance struct {
+	Id                         *string     `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	Focal                      *bool       `json:"focal,omitempty" bson:"focal,omitempty"`                                       // Applicable coverage
+	FocalElement               *Element    `json:"_focal,omitempty" bson:"focal_element,omitempty"`                              // Extensions for focal
+	Coverage                   *Reference  `json:"coverage" bson:"coverage"`                                                     // Insurance information
+	BusinessArrangement        *string     `json:"businessArrangement,omitempty" bson:"business_arrangement,omitempty"`          // Additional provider contract number
+	BusinessArrangementElement *Element    `json:"_businessArrangement,omitempty" bson:"business_arrangement_element,omitempty"` // Extensions for businessArrangement
+}
+
+func (r *CoverageEligibilityRequestInsurance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.FocalElement != nil {
+		if err := r.FocalElement.Validate(); err != nil {
+			return fmt.Errorf("FocalElement: %w", err)
+		}
+	}
+	if r.Coverage == nil {
+		return fmt.Errorf("field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		if err := r.Coverage.Validate(); err != nil {
+			return fmt.Errorf("Coverage: %w", err)
+		}
+	}
+	if r.BusinessArrangementElement != nil {
+		if err := r.BusinessArrangementElement.Validate(); err != nil {
+			return fmt.Errorf("BusinessArrangementElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityRequestInsurance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.FocalElement != nil {
+		r.FocalElement.validateAll(path+".focal", issues)
+	}
+	if r.Coverage == nil {
+		issues.add("required", path+".coverage", "field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+	if r.BusinessArrangementElement != nil {
+		r.BusinessArrangementElement.validateAll(path+".businessArrangement", issues)
+	}
+}
+
+var coverageEligibilityRequestInsuranceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityRequestInsurance) invariants() []invariant {
+	return coverageEligibilityRequestInsuranceInvariants
+}
+
+func (r CoverageEligibilityRequestInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityRequestInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityRequestInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityRequestInsurance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var coverageEligibilityRequestInsuranceElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityRequest.insurance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityRequest.insurance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityRequest.insurance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.insurance.focal", Name: "focal", Field: 3, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CoverageEligibilityRequest.insurance.coverage", Name: "coverage", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityRequest.insurance.businessArrangement", Name: "businessArrangement", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *CoverageEligibilityRequestInsurance) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityRequestInsuranceElementMetadata
+}
+
+type CoverageEligibilityRequestItem struct {
+	Id                            *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
+	Extension                     []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                                      // Additional content defined by implementations
+	ModifierExtension             []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                     // Extensions that cannot be ignored even if unrecognized
+	SupportingInfoSequence        []int                                     `json:"supportingInfoSequence,omitempty" bson:"supporting_info_sequence,omitempty"`          // Applicable exception or supporting information
+	SupportingInfoSequenceElement []*Element                                `json:"_supportingInfoSequence,omitempty" bson:"supporting_info_sequence_element,omitempty"` // Extensions for supportingInfoSequence
+	Category                      *CodeableConcept                          `json:"category,omitempty" bson:"category,omitempty"`                                        // Benefit classification
+	ProductOrService              *CodeableConcept                          `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`                      // Billing, service, product, or drug code
+	Modifier                      []CodeableConcept                         `json:"modifier,omitempty" bson:"modifier,omitempty"`                                        // Product or service billing modifiers
+	Provider                      *Reference                                `json:"provider,omitempty" bson:"provider,omitempty"`                                        // Perfoming practitioner
+	Quantity                      *Quantity                                 `json:"quantity,omitempty" bson:"quantity,omitempty"`                                        // Count of products or services
+	UnitPrice                     *Money                                    `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                                     // Fee, charge or cost per item
+	Facility                      *Reference                                `json:"facility,omitempty" bson:"facility,omitempty"`                                        // Servicing facility
+	Diagnosis                     []CoverageEligibilityRequestItemDiagnosis `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                                      // Applicable diagnosis
+	Detail                        []Reference                               `json:"detail,omitempty" bson:"detail,omitempty"`                                            // Product or service details
+}
+
+func (r *CoverageEligibilityRequestItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SupportingInfoSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SupportingInfoSequenceElement[%d]: %w", i, err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	if r.Provider != nil {
+		if err := r.Provider.Validate(); err != nil {
+			return fmt.Errorf("Provider: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Facility != nil {
+		if err := r.Facility.Validate(); err != nil {
+			return fmt.Errorf("Facility: %w", err)
+		}
+	}
+	for i, item := range r.Diagnosis {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Diagnosis[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Detail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityRequestItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfoSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.supportingInfoSequence[%d]", path, i), issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Facility != nil {
+		r.Facility.validateAll(path+".facility", issues)
+	}
+	for i, item := range r.Diagnosis {
+		item.validateAll(fmt.Sprintf("%s.diagnosis[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var coverageEligibilityRequestItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityRequestItem) invariants() []invariant {
+	return coverageEligibilityRequestItemInvariants
+}
+
+func (r CoverageEligibilityRequestItem) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityRequestItem
+	out := struct {
+		alias
+		SupportingInfoSequence        []*int     `json:"supportingInfoSequence,omitempty"`
+		SupportingInfoSequenceElement []*Element `json:"_supportingInfoSequence,omitempty"`
+	}{alias: alias(r)}
+	out.SupportingInfoSequence, out.SupportingInfoSequenceElement = alignPrimitiveArray(r.SupportingInfoSequence, r.SupportingInfoSequenceElement)
+	return json.Marshal(out)
+}
+
+func (r *CoverageEligibilityRequestItem) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityRequestItem
+	aux := struct {
+		*alias
+		SupportingInfoSequence []*int `json:"supportingInfoSequence,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.SupportingInfoSequence, err = splitPrimitiveArray(aux.SupportingInfoSequence, r.SupportingInfoSequenceElement); err != nil {
+		return fmt.Errorf("supportingInfoSequence: %w", err)
+	}
+	return nil
+}
+
+func (r CoverageEligibilityRequestItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityRequestItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityRequestItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityRequestItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var coverageEligibilityRequestItemElementTypes = map[string]string{
+	"supportingInfoSequence": "positiveInt",
+}
+
+func (r *CoverageEligibilityRequestItem) elementTypes() map[string]string {
+	return coverageEligibilityRequestItemElementTypes
+}
+
+var coverageEligibilityRequestItemElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityRequest.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityRequest.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityRequest.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.item.supportingInfoSequence", Name: "supportingInfoSequence", Field: 3, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "CoverageEligibilityRequest.item.category", Name: "category", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityRequest.item.productOrService", Name: "productOrService", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityRequest.item.modifier", Name: "modifier", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityRequest.item.provider", Name: "provider", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityRequest.item.quantity", Name: "quantity", Field: 9, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "CoverageEligibilityRequest.item.unitPrice", Name: "unitPrice", Field: 10, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "CoverageEligibilityRequest.item.facility", Name: "facility", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityRequest.item.diagnosis", Name: "diagnosis", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityRequest.item.detail", Name: "detail", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *CoverageEligibilityRequestItem) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityRequestItemElementMetadata
+}
+
+type CoverageEligibilityRequestItemDiagnosis struct {
+	Id                *string                                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Diagnosis         CoverageEligibilityRequestItemDiagnosisDiagnosis `json:"-" bson:"diagnosis,omitempty"`                                    // Nature of illness or problem
+
+	diagnosisVariants []string // JSON properties of CoverageEligibilityRequest.item.diagnosis.diagnosis[x] when more than one was decoded
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.diagnosisVariants) > 1 {
+		return fmt.Errorf("field 'Diagnosis' must have a single type, got %v", r.diagnosisVariants)
+	}
+	if r.Diagnosis != nil {
+		if err := r.Diagnosis.Validate(); err != nil {
+			return fmt.Errorf("Diagnosis: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.diagnosisVariants) > 1 {
+		issues.add("structure", path+".diagnosis", fmt.Sprintf("field 'Diagnosis' must have a single type, got %v", r.diagnosisVariants))
+	}
+	if r.Diagnosis != nil {
+		r.Diagnosis.validateAll(path+".diagnosis.ofType("+r.Diagnosis.FHIRType()+")", issues)
+	}
+}
+
+var coverageEligibilityRequestItemDiagnosisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) invariants() []invariant {
+	return coverageEligibilityRequestItemDiagnosisInvariants
+}
+
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityRequestItemDiagnosis
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "diagnosis", r.Diagnosis, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityRequestItemDiagnosis
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Diagnosis, _, r.diagnosisVariants, err = unmarshalChoice(fields, "diagnosis", coverageEligibilityRequestItemDiagnosisDiagnosisVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityRequestItemDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Diagnosis":
+		return "diagnosis", choiceValues(coverageEligibilityRequestItemDiagnosisDiagnosisVariants)
+	}
+	return "", nil
+}
+
+var coverageEligibilityRequestItemDiagnosisElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityRequest.item.diagnosis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityRequest.item.diagnosis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityRequest.item.diagnosis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityRequest.item.diagnosis.diagnosis[x]", Name: "diagnosis", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+}
+
+func (r *CoverageEligibilityRequestItemDiagnosis) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityRequestItemDiagnosisElementMetadata
+}
+
+// CoverageEligibilityRequestItemDiagnosisDiagnosis is the type of CoverageEligibilityRequest.item.diagnosis.diagnosis[x]. It is implemented by the CoverageEligibilityRequestItemDiagnosisDiagnosis* variant types.
+type CoverageEligibilityRequestItemDiagnosisDiagnosis interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityRequestItemDiagnosisDiagnosis()
+}
+
+// CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept is the CodeableConcept variant of CoverageEligibilityRequestItemDiagnosisDiagnosis.
+type CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept) isCoverageEligibilityRequestItemDiagnosisDiagnosis() {
+}
+
+// CoverageEligibilityRequestItemDiagnosisDiagnosisReference is the Reference variant of CoverageEligibilityRequestItemDiagnosisDiagnosis.
+type CoverageEligibilityRequestItemDiagnosisDiagnosisReference struct {
+	Reference
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v CoverageEligibilityRequestItemDiagnosisDiagnosisReference) isCoverageEligibilityRequestItemDiagnosisDiagnosis() {
+}
+
+var coverageEligibilityRequestItemDiagnosisDiagnosisVariants = []CoverageEligibilityRequestItemDiagnosisDiagnosis{
+	CoverageEligibilityRequestItemDiagnosisDiagnosisCodeableConcept{},
+	CoverageEligibilityRequestItemDiagnosisDiagnosisReference{},
+}
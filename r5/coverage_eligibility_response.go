@@ -2,38 +2,60 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource provides eligibility and plan details from the processing of an CoverageEligibilityRequest resource.
 type CoverageEligibilityResponse struct {
-	ResourceType   string                                 `json:"resourceType" bson:"resource_type"`                         // Type of resource
-	Id             *string                                `json:"id,omitempty" bson:"id,omitempty"`                          // Logical id of this artifact
-	Meta           *Meta                                  `json:"meta,omitempty" bson:"meta,omitempty"`                      // Metadata about the resource
-	ImplicitRules  *string                                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`   // A set of rules under which this content was created
-	Language       *string                                `json:"language,omitempty" bson:"language,omitempty"`              // Language of the resource content
-	Text           *Narrative                             `json:"text,omitempty" bson:"text,omitempty"`                      // Text summary of the resource, for human interpretation
-	Contained      []json.RawMessage                      `json:"contained,omitempty" bson:"contained,omitempty"`            // Contained, inline Resources
-	Identifier     []Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`          // Business Identifier for coverage eligiblity request
-	Status         string                                 `json:"status" bson:"status"`                                      // active | cancelled | draft | entered-in-error
-	StatusReason   *string                                `json:"statusReason,omitempty" bson:"status_reason,omitempty"`     // Reason for status change
-	Purpose        []string                               `json:"purpose" bson:"purpose"`                                    // auth-requirements | benefits | discovery | validation
-	Patient        *Reference                             `json:"patient" bson:"patient"`                                    // Intended recipient of products and services
-	Event          []CoverageEligibilityResponseEvent     `json:"event,omitempty" bson:"event,omitempty"`                    // Event information
-	ServicedDate   *string                                `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`     // Estimated date or dates of service
-	ServicedPeriod *Period                                `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"` // Estimated date or dates of service
-	Created        string                                 `json:"created" bson:"created"`                                    // Response creation date
-	Requestor      *Reference                             `json:"requestor,omitempty" bson:"requestor,omitempty"`            // Party responsible for the request
-	Request        *Reference                             `json:"request,omitempty" bson:"request,omitempty"`                // Eligibility request reference
-	Outcome        string                                 `json:"outcome" bson:"outcome"`                                    // queued | complete | error | partial
-	Disposition    *string                                `json:"disposition,omitempty" bson:"disposition,omitempty"`        // Disposition Message
-	Insurer        *Reference                             `json:"insurer" bson:"insurer"`                                    // Coverage issuer
-	Insurance      []CoverageEligibilityResponseInsurance `json:"insurance,omitempty" bson:"insurance,omitempty"`            // Patient insurance information
-	PreAuthRef     *string                                `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`        // Preauthorization reference
-	Form           *CodeableConcept                       `json:"form,omitempty" bson:"form,omitempty"`                      // Printed form identifier
-	Error          []CoverageEligibilityResponseError     `json:"error,omitempty" bson:"error,omitempty"`                    // Processing errors
+	ResourceType         string                                 `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                                `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                                  `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                                `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                               `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                             `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                             `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier for coverage eligiblity request
+	Status               ExplanationOfBenefitStatus             `json:"status" bson:"status"`                                             // active | cancelled | draft | entered-in-error
+	StatusElement        *Element                               `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	StatusReason         *string                                `json:"statusReason,omitempty" bson:"status_reason,omitempty"`            // Reason for status change
+	StatusReasonElement  *Element                               `json:"_statusReason,omitempty" bson:"status_reason_element,omitempty"`   // Extensions for statusReason
+	Purpose              []EligibilityRequestPurpose            `json:"purpose" bson:"purpose"`                                           // auth-requirements | benefits | discovery | validation
+	PurposeElement       []*Element                             `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`              // Extensions for purpose
+	Patient              *Reference                             `json:"patient" bson:"patient"`                                           // Intended recipient of products and services
+	Event                []CoverageEligibilityResponseEvent     `json:"event,omitempty" bson:"event,omitempty"`                           // Event information
+	Serviced             CoverageEligibilityResponseServiced    `json:"-" bson:"serviced,omitempty"`                                      // Estimated date or dates of service
+	ServicedElement      *Element                               `json:"-" bson:"serviced_element,omitempty"`                              // Extensions for serviced[x]
+	Created              *DateTime                              `json:"created" bson:"created"`                                           // Response creation date
+	CreatedElement       *Element                               `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Requestor            *Reference                             `json:"requestor,omitempty" bson:"requestor,omitempty"`                   // Party responsible for the request
+	Request              *Reference                             `json:"request,omitempty" bson:"request,omitempty"`                       // Eligibility request reference
+	Outcome              ClaimProcessingCodes                   `json:"outcome" bson:"outcome"`                                           // queued | complete | error | partial
+	OutcomeElement       *Element                               `json:"_outcome,omitempty" bson:"outcome_element,omitempty"`              // Extensions for outcome
+	Disposition          *string                                `json:"disposition,omitempty" bson:"disposition,omitempty"`               // Disposition Message
+	DispositionElement   *Element                               `json:"_disposition,omitempty" bson:"disposition_element,omitempty"`      // Extensions for disposition
+	Insurer              *Reference                             `json:"insurer" bson:"insurer"`                                           // Coverage issuer
+	Insurance            []CoverageEligibilityResponseInsurance `json:"insurance,omitempty" bson:"insurance,omitempty"`                   // Patient insurance information
+	PreAuthRef           *string                                `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`               // Preauthorization reference
+	PreAuthRefElement    *Element                               `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`      // Extensions for preAuthRef
+	Form                 *CodeableConcept                       `json:"form,omitempty" bson:"form,omitempty"`                             // Printed form identifier
+	Error                []CoverageEligibilityResponseError     `json:"error,omitempty" bson:"error,omitempty"`                           // Processing errors
+
+	servicedVariants []string // JSON properties of CoverageEligibilityResponse.serviced[x] when more than one was decoded
 }
 
+var coverageEligibilityResponsePatientTargets = []string{"Patient"}
+
+var coverageEligibilityResponseRequestorTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var coverageEligibilityResponseRequestTargets = []string{"CoverageEligibilityRequest"}
+
+var coverageEligibilityResponseInsurerTargets = []string{"Organization"}
+
 func (r *CoverageEligibilityResponse) Validate() error {
 	if r.ResourceType != "CoverageEligibilityResponse" {
 		return fmt.Errorf("invalid resourceType: expected 'CoverageEligibilityResponse', got '%s'", r.ResourceType)
@@ -43,26 +65,84 @@ func (r *CoverageEligibilityResponse) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.StatusReasonElement != nil {
+		if err := r.StatusReasonElement.Validate(); err != nil {
+			return fmt.Errorf("StatusReasonElement: %w", err)
+		}
+	}
 	if len(r.Purpose) < 1 {
 		return fmt.Errorf("field 'Purpose' must have at least 1 elements")
 	}
+	for i, item := range r.Purpose {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Purpose[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.PurposeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement[%d]: %w", i, err)
+		}
+	}
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, coverageEligibilityResponsePatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, coverageEligibilityResponsePatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
@@ -73,30 +153,76 @@ func (r *CoverageEligibilityResponse) Validate() error {
 			return fmt.Errorf("Event[%d]: %w", i, err)
 		}
 	}
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
+	}
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
 		}
 	}
-	if r.Created == emptyString {
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Requestor != nil {
+		if target := invalidReferenceTarget(r.Requestor.Reference, r.Requestor.Type, coverageEligibilityResponseRequestorTargets); target != "" {
+			return fmt.Errorf("field 'Requestor' cannot refer to %s, expected one of %v", target, coverageEligibilityResponseRequestorTargets)
+		}
+	}
 	if r.Requestor != nil {
 		if err := r.Requestor.Validate(); err != nil {
 			return fmt.Errorf("Requestor: %w", err)
 		}
 	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, coverageEligibilityResponseRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request' cannot refer to %s, expected one of %v", target, coverageEligibilityResponseRequestTargets)
+		}
+	}
 	if r.Request != nil {
 		if err := r.Request.Validate(); err != nil {
 			return fmt.Errorf("Request: %w", err)
 		}
 	}
-	if r.Outcome == emptyString {
+	if r.Outcome == "" {
 		return fmt.Errorf("field 'Outcome' is required")
 	}
+	if !r.Outcome.IsValid() {
+		return fmt.Errorf("field 'Outcome' has invalid code '%s'", r.Outcome)
+	}
+	if r.OutcomeElement != nil {
+		if err := r.OutcomeElement.Validate(); err != nil {
+			return fmt.Errorf("OutcomeElement: %w", err)
+		}
+	}
+	if r.DispositionElement != nil {
+		if err := r.DispositionElement.Validate(); err != nil {
+			return fmt.Errorf("DispositionElement: %w", err)
+		}
+	}
 	if r.Insurer == nil {
 		return fmt.Errorf("field 'Insurer' is required")
 	}
+	if r.Insurer != nil {
+		if target := invalidReferenceTarget(r.Insurer.Reference, r.Insurer.Type, coverageEligibilityResponseInsurerTargets); target != "" {
+			return fmt.Errorf("field 'Insurer' cannot refer to %s, expected one of %v", target, coverageEligibilityResponseInsurerTargets)
+		}
+	}
 	if r.Insurer != nil {
 		if err := r.Insurer.Validate(); err != nil {
 			return fmt.Errorf("Insurer: %w", err)
@@ -107,6 +233,11 @@ func (r *CoverageEligibilityResponse) Validate() error {
 			return fmt.Errorf("Insurance[%d]: %w", i, err)
 		}
 	}
+	if r.PreAuthRefElement != nil {
+		if err := r.PreAuthRefElement.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement: %w", err)
+		}
+	}
 	if r.Form != nil {
 		if err := r.Form.Validate(); err != nil {
 			return fmt.Errorf("Form: %w", err)
@@ -117,148 +248,411 @@ func (r *CoverageEligibilityResponse) Validate() error {
 			return fmt.Errorf("Error[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type CoverageEligibilityResponseEvent struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Type         *CodeableConcept `json:"type" bson:"type"`                   // Specific event
-	WhenDateTime *string          `json:"whenDateTime" bson:"when_date_time"` // Occurance date or period
-	WhenPeriod   *Period          `json:"whenPeriod" bson:"when_period"`      // Occurance date or period
+	return validateInvariants(r, "CoverageEligibilityResponse")
 }
 
-func (r *CoverageEligibilityResponseEvent) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *CoverageEligibilityResponse) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "CoverageEligibilityResponse" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'CoverageEligibilityResponse', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.WhenDateTime == nil {
-		return fmt.Errorf("field 'WhenDateTime' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.WhenPeriod == nil {
-		return fmt.Errorf("field 'WhenPeriod' is required")
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.WhenPeriod != nil {
-		if err := r.WhenPeriod.Validate(); err != nil {
-			return fmt.Errorf("WhenPeriod: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type CoverageEligibilityResponseInsurance struct {
-	Id            *string                                    `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Coverage      *Reference                                 `json:"coverage" bson:"coverage"`                                // Insurance information
-	Inforce       *bool                                      `json:"inforce,omitempty" bson:"inforce,omitempty"`              // Coverage inforce indicator
-	BenefitPeriod *Period                                    `json:"benefitPeriod,omitempty" bson:"benefit_period,omitempty"` // When the benefits are applicable
-	Item          []CoverageEligibilityResponseInsuranceItem `json:"item,omitempty" bson:"item,omitempty"`                    // Benefits and authorization details
-}
-
-func (r *CoverageEligibilityResponseInsurance) Validate() error {
-	if r.Coverage == nil {
-		return fmt.Errorf("field 'Coverage' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Coverage != nil {
-		if err := r.Coverage.Validate(); err != nil {
-			return fmt.Errorf("Coverage: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.BenefitPeriod != nil {
-		if err := r.BenefitPeriod.Validate(); err != nil {
-			return fmt.Errorf("BenefitPeriod: %w", err)
-		}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	for i, item := range r.Item {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Item[%d]: %w", i, err)
-		}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	return nil
-}
-
-type CoverageEligibilityResponseInsuranceItem struct {
-	Id                      *string                                           `json:"id,omitempty" bson:"id,omitempty"`                                            // Unique id for inter-element referencing
-	Category                *CodeableConcept                                  `json:"category,omitempty" bson:"category,omitempty"`                                // Benefit classification
-	ProductOrService        *CodeableConcept                                  `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`              // Billing, service, product, or drug code
-	Modifier                []CodeableConcept                                 `json:"modifier,omitempty" bson:"modifier,omitempty"`                                // Product or service billing modifiers
-	Provider                *Reference                                        `json:"provider,omitempty" bson:"provider,omitempty"`                                // Performing practitioner
-	Excluded                *bool                                             `json:"excluded,omitempty" bson:"excluded,omitempty"`                                // Excluded from the plan
-	Name                    *string                                           `json:"name,omitempty" bson:"name,omitempty"`                                        // Short name for the benefit
-	Description             *string                                           `json:"description,omitempty" bson:"description,omitempty"`                          // Description of the benefit or services covered
-	Network                 *CodeableConcept                                  `json:"network,omitempty" bson:"network,omitempty"`                                  // In or out of network
-	Unit                    *CodeableConcept                                  `json:"unit,omitempty" bson:"unit,omitempty"`                                        // Individual or family
-	Term                    *CodeableConcept                                  `json:"term,omitempty" bson:"term,omitempty"`                                        // Annual or lifetime
-	Benefit                 []CoverageEligibilityResponseInsuranceItemBenefit `json:"benefit,omitempty" bson:"benefit,omitempty"`                                  // Benefit Summary
-	AuthorizationRequired   *bool                                             `json:"authorizationRequired,omitempty" bson:"authorization_required,omitempty"`     // Authorization required flag
-	AuthorizationSupporting []CodeableConcept                                 `json:"authorizationSupporting,omitempty" bson:"authorization_supporting,omitempty"` // Type of required supporting materials
-	AuthorizationUrl        *string                                           `json:"authorizationUrl,omitempty" bson:"authorization_url,omitempty"`               // Preauthorization requirements endpoint
-}
-
-func (r *CoverageEligibilityResponseInsuranceItem) Validate() error {
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReasonElement != nil {
+		r.StatusReasonElement.validateAll(path+".statusReason", issues)
+	}
+	if len(r.Purpose) < 1 {
+		issues.add("required", path+".purpose", "field 'Purpose' must have at least 1 elements")
+	}
+	for i, item := range r.Purpose {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.purpose[%d]", path, i), fmt.Sprintf("field 'Purpose[%d]' has invalid code '%s'", i, item))
 		}
 	}
-	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
+	for i, item := range r.PurposeElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.purpose[%d]", path, i), issues)
 	}
-	for i, item := range r.Modifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, coverageEligibilityResponsePatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, coverageEligibilityResponsePatientTargets))
 		}
 	}
-	if r.Provider != nil {
-		if err := r.Provider.Validate(); err != nil {
-			return fmt.Errorf("Provider: %w", err)
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
 		}
 	}
-	if r.Network != nil {
-		if err := r.Network.Validate(); err != nil {
-			return fmt.Errorf("Network: %w", err)
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Requestor != nil {
+		if target := invalidReferenceTarget(r.Requestor.Reference, r.Requestor.Type, coverageEligibilityResponseRequestorTargets); target != "" {
+			issues.add("value", path+".requestor", fmt.Sprintf("field 'Requestor' cannot refer to %s, expected one of %v", target, coverageEligibilityResponseRequestorTargets))
 		}
 	}
-	if r.Unit != nil {
-		if err := r.Unit.Validate(); err != nil {
-			return fmt.Errorf("Unit: %w", err)
+	if r.Requestor != nil {
+		r.Requestor.validateAll(path+".requestor", issues)
+	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, coverageEligibilityResponseRequestTargets); target != "" {
+			issues.add("value", path+".request", fmt.Sprintf("field 'Request' cannot refer to %s, expected one of %v", target, coverageEligibilityResponseRequestTargets))
 		}
 	}
-	if r.Term != nil {
-		if err := r.Term.Validate(); err != nil {
-			return fmt.Errorf("Term: %w", err)
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
+	}
+	if r.Outcome == "" {
+		issues.add("required", path+".outcome", "field 'Outcome' is required")
+	}
+	if r.Outcome != "" && !r.Outcome.IsValid() {
+		issues.add("code-invalid", path+".outcome", fmt.Sprintf("field 'Outcome' has invalid code '%s'", r.Outcome))
+	}
+	if r.OutcomeElement != nil {
+		r.OutcomeElement.validateAll(path+".outcome", issues)
+	}
+	if r.DispositionElement != nil {
+		r.DispositionElement.validateAll(path+".disposition", issues)
+	}
+	if r.Insurer == nil {
+		issues.add("required", path+".insurer", "field 'Insurer' is required")
+	}
+	if r.Insurer != nil {
+		if target := invalidReferenceTarget(r.Insurer.Reference, r.Insurer.Type, coverageEligibilityResponseInsurerTargets); target != "" {
+			issues.add("value", path+".insurer", fmt.Sprintf("field 'Insurer' cannot refer to %s, expected one of %v", target, coverageEligibilityResponseInsurerTargets))
 		}
 	}
-	for i, item := range r.Benefit {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Benefit[%d]: %w", i, err)
+	if r.Insurer != nil {
+		r.Insurer.validateAll(path+".insurer", issues)
+	}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	if r.PreAuthRefElement != nil {
+		r.PreAuthRefElement.validateAll(path+".preAuthRef", issues)
+	}
+	if r.Form != nil {
+		r.Form.validateAll(path+".form", issues)
+	}
+	for i, item := range r.Error {
+		item.validateAll(fmt.Sprintf("%s.error[%d]", path, i), issues)
+	}
+}
+
+func (r *CoverageEligibilityResponse) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("CoverageEligibilityResponse", &issues)
+	checkInvariants(r, "CoverageEligibilityResponse", &issues)
+	return issues
+}
+
+var coverageEligibilityResponseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *CoverageEligibilityResponse) invariants() []invariant {
+	return coverageEligibilityResponseInvariants
+}
+
+func (r CoverageEligibilityResponse) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityResponse
+	out := struct {
+		alias
+		Purpose        []*EligibilityRequestPurpose `json:"purpose"`
+		PurposeElement []*Element                   `json:"_purpose,omitempty"`
+	}{alias: alias(r)}
+	out.Purpose, out.PurposeElement = alignPrimitiveArray(r.Purpose, r.PurposeElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CoverageEligibilityResponse) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityResponse
+	aux := struct {
+		*alias
+		Contained []json.RawMessage            `json:"contained,omitempty"`
+		Purpose   []*EligibilityRequestPurpose `json:"purpose"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	for i, item := range r.AuthorizationSupporting {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AuthorizationSupporting[%d]: %w", i, err)
+	if r.Purpose, err = splitPrimitiveArray(aux.Purpose, r.PurposeElement); err != nil {
+		return fmt.Errorf("purpose: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", coverageEligibilityResponseServicedVariants); err != nil {
+		return err
+	}
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
 		}
 	}
 	return nil
 }
 
-type CoverageEligibilityResponseInsuranceItemBenefit struct {
-	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
-	Type               *CodeableConcept `json:"type" bson:"type"`                                                   // Benefit classification
-	AllowedUnsignedInt *int             `json:"allowedUnsignedInt,omitempty" bson:"allowed_unsigned_int,omitempty"` // Benefits allowed
-	AllowedString      *string          `json:"allowedString,omitempty" bson:"allowed_string,omitempty"`            // Benefits allowed
-	AllowedMoney       *Money           `json:"allowedMoney,omitempty" bson:"allowed_money,omitempty"`              // Benefits allowed
-	UsedUnsignedInt    *int             `json:"usedUnsignedInt,omitempty" bson:"used_unsigned_int,omitempty"`       // Benefits used
-	UsedString         *string          `json:"usedString,omitempty" bson:"used_string,omitempty"`                  // Benefits used
-	UsedMoney          *Money           `json:"usedMoney,omitempty" bson:"used_money,omitempty"`                    // Benefits used
+func (r CoverageEligibilityResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *CoverageEligibilityResponseInsuranceItemBenefit) Validate() error {
+func (r *CoverageEligibilityResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CoverageEligibilityResponse) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(coverageEligibilityResponseServicedVariants)
+	}
+	return "", nil
+}
+
+var coverageEligibilityResponseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *CoverageEligibilityResponse) elementTypes() map[string]string {
+	return coverageEligibilityResponseElementTypes
+}
+
+var coverageEligibilityResponseElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityResponse.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "CoverageEligibilityResponse.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "CoverageEligibilityResponse.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "CoverageEligibilityResponse.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "CoverageEligibilityResponse.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "CoverageEligibilityResponse.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityResponse.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "CoverageEligibilityResponse.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "CoverageEligibilityResponse.statusReason", Name: "statusReason", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.purpose", Name: "purpose", Field: 16, Min: 1, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityResponse.purpose", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/eligibilityrequest-purpose"}},
+	{Path: "CoverageEligibilityResponse.patient", Name: "patient", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "CoverageEligibilityResponse.event", Name: "event", Field: 19, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityResponse.serviced[x]", Name: "serviced", Field: 20, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "CoverageEligibilityResponse.created", Name: "created", Field: 22, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "CoverageEligibilityResponse.requestor", Name: "requestor", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "CoverageEligibilityResponse.request", Name: "request", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest"}},
+	{Path: "CoverageEligibilityResponse.outcome", Name: "outcome", Field: 26, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "CoverageEligibilityResponse.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-outcome"}},
+	{Path: "CoverageEligibilityResponse.disposition", Name: "disposition", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.insurer", Name: "insurer", Field: 30, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "CoverageEligibilityResponse.insurance", Name: "insurance", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityResponse.preAuthRef", Name: "preAuthRef", Field: 32, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.form", Name: "form", Field: 34, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.error", Name: "error", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CoverageEligibilityResponse) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityResponseElementMetadata
+}
+
+// CoverageEligibilityResponseServiced is the type of CoverageEligibilityResponse.serviced[x]. It is implemented by the CoverageEligibilityResponseServiced* variant types.
+type CoverageEligibilityResponseServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityResponseServiced()
+}
+
+// CoverageEligibilityResponseServicedDate is the date variant of CoverageEligibilityResponseServiced.
+type CoverageEligibilityResponseServicedDate struct {
+	Date
+}
+
+func (v CoverageEligibilityResponseServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v CoverageEligibilityResponseServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CoverageEligibilityResponseServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v CoverageEligibilityResponseServicedDate) isCoverageEligibilityResponseServiced() {}
+
+// CoverageEligibilityResponseServicedPeriod is the Period variant of CoverageEligibilityResponseServiced.
+type CoverageEligibilityResponseServicedPeriod struct {
+	Period
+}
+
+func (v CoverageEligibilityResponseServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v CoverageEligibilityResponseServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityResponseServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v CoverageEligibilityResponseServicedPeriod) isCoverageEligibilityResponseServiced() {}
+
+var coverageEligibilityResponseServicedVariants = []CoverageEligibilityResponseServiced{
+	CoverageEligibilityResponseServicedDate{},
+	CoverageEligibilityResponseServicedPeriod{},
+}
+
+func (r *CoverageEligibilityResponse) GetResourceType() string {
+	return "CoverageEligibilityResponse"
+}
+
+func (r *CoverageEligibilityResponse) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *CoverageEligibilityResponse) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *CoverageEligibilityResponse) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *CoverageEligibilityResponse) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *CoverageEligibilityResponse) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *CoverageEligibilityResponse) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *CoverageEligibilityResponse) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*CoverageEligibilityResponse)(nil)
+
+type CoverageEligibilityResponseEvent struct {
+	Id                *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                     `json:"type" bson:"type"`                                                // Specific event
+	When              CoverageEligibilityResponseEventWhen `json:"-" bson:"when,omitempty"`                                         // Occurance date or period
+	WhenElement       *Element                             `json:"-" bson:"when_element,omitempty"`                                 // Extensions for when[x]
+
+	whenVariants []string // JSON properties of CoverageEligibilityResponse.event.when[x] when more than one was decoded
+}
+
+func (r *CoverageEligibilityResponseEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -267,26 +661,868 @@ func (r *CoverageEligibilityResponseInsuranceItemBenefit) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.AllowedMoney != nil {
-		if err := r.AllowedMoney.Validate(); err != nil {
-			return fmt.Errorf("AllowedMoney: %w", err)
+	if len(r.whenVariants) > 1 {
+		return fmt.Errorf("field 'When' must have a single type, got %v", r.whenVariants)
+	}
+	if r.When == nil {
+		return fmt.Errorf("field 'When' is required")
+	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
 		}
 	}
-	if r.UsedMoney != nil {
-		if err := r.UsedMoney.Validate(); err != nil {
-			return fmt.Errorf("UsedMoney: %w", err)
+	if r.WhenElement != nil {
+		if err := r.WhenElement.Validate(); err != nil {
+			return fmt.Errorf("WhenElement: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r *CoverageEligibilityResponseEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.whenVariants) > 1 {
+		issues.add("structure", path+".when", fmt.Sprintf("field 'When' must have a single type, got %v", r.whenVariants))
+	}
+	if r.When == nil {
+		issues.add("required", path+".when", "field 'When' is required")
+	}
+	if r.When != nil {
+		r.When.validateAll(path+".when.ofType("+r.When.FHIRType()+")", issues)
+	}
+	if r.WhenElement != nil {
+		r.WhenElement.validateAll(path+".when", issues)
+	}
+}
+
+var coverageEligibilityResponseEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityResponseEvent) invariants() []invariant {
+	return coverageEligibilityResponseEventInvariants
+}
+
+func (r CoverageEligibilityResponseEvent) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityResponseEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "when", r.When, r.WhenElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CoverageEligibilityResponseEvent) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityResponseEvent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.When, element, r.whenVariants, err = unmarshalChoice(fields, "when", coverageEligibilityResponseEventWhenVariants); err != nil {
+		return err
+	}
+	r.WhenElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.WhenElement); err != nil {
+			return fmt.Errorf("_when: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r CoverageEligibilityResponseEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityResponseEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityResponseEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityResponseEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CoverageEligibilityResponseEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "When":
+		return "when", choiceValues(coverageEligibilityResponseEventWhenVariants)
+	}
+	return "", nil
+}
+
+var coverageEligibilityResponseEventElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityResponse.event.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.event.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityResponse.event.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.event.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.event.when[x]", Name: "when", Field: 4, Min: 1, Max: "1", Types: []string{"dateTime", "Period"}},
+}
+
+func (r *CoverageEligibilityResponseEvent) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityResponseEventElementMetadata
+}
+
+// CoverageEligibilityResponseEventWhen is the type of CoverageEligibilityResponse.event.when[x]. It is implemented by the CoverageEligibilityResponseEventWhen* variant types.
+type CoverageEligibilityResponseEventWhen interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityResponseEventWhen()
+}
+
+// CoverageEligibilityResponseEventWhenDateTime is the dateTime variant of CoverageEligibilityResponseEventWhen.
+type CoverageEligibilityResponseEventWhenDateTime struct {
+	DateTime
+}
+
+func (v CoverageEligibilityResponseEventWhenDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v CoverageEligibilityResponseEventWhenDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v CoverageEligibilityResponseEventWhenDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v CoverageEligibilityResponseEventWhenDateTime) isCoverageEligibilityResponseEventWhen() {}
+
+// CoverageEligibilityResponseEventWhenPeriod is the Period variant of CoverageEligibilityResponseEventWhen.
+type CoverageEligibilityResponseEventWhenPeriod struct {
+	Period
+}
+
+func (v CoverageEligibilityResponseEventWhenPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v CoverageEligibilityResponseEventWhenPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityResponseEventWhenPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v CoverageEligibilityResponseEventWhenPeriod) isCoverageEligibilityResponseEventWhen() {}
+
+var coverageEligibilityResponseEventWhenVariants = []CoverageEligibilityResponseEventWhen{
+	CoverageEligibilityResponseEventWhenDateTime{},
+	CoverageEligibilityResponseEventWhenPeriod{},
+}
+
+type CoverageEligibilityResponseInsurance struct {
+	Id                *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Coverage          *Reference                                 `json:"coverage" bson:"coverage"`                                        // Insurance information
+	Inforce           *bool                                      `json:"inforce,omitempty" bson:"inforce,omitempty"`                      // Coverage inforce indicator
+	InforceElement    *Element                                   `json:"_inforce,omitempty" bson:"inforce_element,omitempty"`             // Extensions for inforce
+	BenefitPeriod     *Period                                    `json:"benefitPeriod,omitempty" bson:"benefit_period,omitempty"`         // When the benefits are applicable
+	Item              []CoverageEligibilityResponseInsuranceItem `json:"item,omitempty" bson:"item,omitempty"`                            // Benefits and authorization details
+}
+
+func (r *CoverageEligibilityResponseInsurance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Coverage == nil {
+		return fmt.Errorf("field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		if err := r.Coverage.Validate(); err != nil {
+			return fmt.Errorf("Coverage: %w", err)
+		}
+	}
+	if r.InforceElement != nil {
+		if err := r.InforceElement.Validate(); err != nil {
+			return fmt.Errorf("InforceElement: %w", err)
+		}
+	}
+	if r.BenefitPeriod != nil {
+		if err := r.BenefitPeriod.Validate(); err != nil {
+			return fmt.Errorf("BenefitPeriod: %w", err)
+		}
+	}
+	for i, item := range r.Item {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Item[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityResponseInsurance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Coverage == nil {
+		issues.add("required", path+".coverage", "field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+	if r.InforceElement != nil {
+		r.InforceElement.validateAll(path+".inforce", issues)
+	}
+	if r.BenefitPeriod != nil {
+		r.BenefitPeriod.validateAll(path+".benefitPeriod", issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
+	}
+}
+
+var coverageEligibilityResponseInsuranceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityResponseInsurance) invariants() []invariant {
+	return coverageEligibilityResponseInsuranceInvariants
+}
+
+func (r CoverageEligibilityResponseInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityResponseInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityResponseInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityResponseInsurance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var coverageEligibilityResponseInsuranceElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityResponse.insurance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.insurance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityResponse.insurance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.insurance.coverage", Name: "coverage", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityResponse.insurance.inforce", Name: "inforce", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CoverageEligibilityResponse.insurance.benefitPeriod", Name: "benefitPeriod", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "CoverageEligibilityResponse.insurance.item", Name: "item", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *CoverageEligibilityResponseInsurance) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityResponseInsuranceElementMetadata
+}
+
+type CoverageEligibilityResponseInsuranceItem struct {
+	Id                           *string                                           `json:"id,omitempty" bson:"id,omitempty"`                                                 // Unique id for inter-element referencing
+	Extension                    []Extension                                       `json:"extension,omitempty" bson:"extension,omitempty"`                                   // Additional content defined by implementations
+	ModifierExtension            []Extension                                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                  // Extensions that cannot be ignored even if unrecognized
+	Category                     *CodeableConcept                                  `json:"category,omitempty" bson:"category,omitempty"`                                     // Benefit classification
+	ProductOrService             *CodeableConcept                                  `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`                   // Billing, service, product, or drug code
+	Modifier                     []CodeableConcept                                 `json:"modifier,omitempty" bson:"modifier,omitempty"`                                     // Product or service billing modifiers
+	Provider                     *Reference                                        `json:"provider,omitempty" bson:"provider,omitempty"`                                     // Performing practitioner
+	Excluded                     *bool                                             `json:"excluded,omitempty" bson:"excluded,omitempty"`                                     // Excluded from the plan
+	ExcludedElement              *Element                                          `json:"_excluded,omitempty" bson:"excluded_element,omitempty"`                            // Extensions for excluded
+	Name                         *string                                           `json:"name,omitempty" bson:"name,omitempty"`                                             // Short name for the benefit
+	NameElement                  *Element                                          `json:"_name,omitempty" bson:"name_element,omitempty"`                                    // Extensions for name
+	Description                  *string                                           `json:"description,omitempty" bson:"description,omitempty"`                               // Description of the benefit or services covered
+	DescriptionElement           *Element                                          `json:"_description,omitempty" bson:"description_element,omitempty"`                      // Extensions for description
+	Network                      *CodeableConcept                                  `json:"network,omitempty" bson:"network,omitempty"`                                       // In or out of network
+	Unit                         *CodeableConcept                                  `json:"unit,omitempty" bson:"unit,omitempty"`                                             // Individual or family
+	Term                         *CodeableConcept                                  `json:"term,omitempty" bson:"term,omitempty"`                                             // Annual or lifetime
+	Benefit                      []CoverageEligibilityResponseInsuranceItemBenefit `json:"benefit,omitempty" bson:"benefit,omitempty"`                                       // Benefit Summary
+	AuthorizationRequired        *bool                                             `json:"authorizationRequired,omitempty" bson:"authorization_required,omitempty"`          // Authorization required flag
+	AuthorizationRequiredElement *Element                                          `json:"_authorizationRequired,omitempty" bson:"authorization_required_element,omitempty"` // Extensions for authorizationRequired
+	AuthorizationSupporting      []CodeableConcept                                 `json:"authorizationSupporting,omitempty" bson:"authorization_supporting,omitempty"`      // Type of required supporting materials
+	AuthorizationUrl             *string                                           `json:"authorizationUrl,omitempty" bson:"authorization_url,omitempty"`                    // Preauthorization requirements endpoint
+	AuthorizationUrlElement      *Element                                          `json:"_authorizationUrl,omitempty" bson:"authorization_url_element,omitempty"`           // Extensions for authorizationUrl
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	if r.Provider != nil {
+		if err := r.Provider.Validate(); err != nil {
+			return fmt.Errorf("Provider: %w", err)
+		}
+	}
+	if r.ExcludedElement != nil {
+		if err := r.ExcludedElement.Validate(); err != nil {
+			return fmt.Errorf("ExcludedElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Network != nil {
+		if err := r.Network.Validate(); err != nil {
+			return fmt.Errorf("Network: %w", err)
+		}
+	}
+	if r.Unit != nil {
+		if err := r.Unit.Validate(); err != nil {
+			return fmt.Errorf("Unit: %w", err)
+		}
+	}
+	if r.Term != nil {
+		if err := r.Term.Validate(); err != nil {
+			return fmt.Errorf("Term: %w", err)
+		}
+	}
+	for i, item := range r.Benefit {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Benefit[%d]: %w", i, err)
+		}
+	}
+	if r.AuthorizationRequiredElement != nil {
+		if err := r.AuthorizationRequiredElement.Validate(); err != nil {
+			return fmt.Errorf("AuthorizationRequiredElement: %w", err)
+		}
+	}
+	for i, item := range r.AuthorizationSupporting {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AuthorizationSupporting[%d]: %w", i, err)
+		}
+	}
+	if r.AuthorizationUrlElement != nil {
+		if err := r.AuthorizationUrlElement.Validate(); err != nil {
+			return fmt.Errorf("AuthorizationUrlElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
+	}
+	if r.ExcludedElement != nil {
+		r.ExcludedElement.validateAll(path+".excluded", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Network != nil {
+		r.Network.validateAll(path+".network", issues)
+	}
+	if r.Unit != nil {
+		r.Unit.validateAll(path+".unit", issues)
+	}
+	if r.Term != nil {
+		r.Term.validateAll(path+".term", issues)
+	}
+	for i, item := range r.Benefit {
+		item.validateAll(fmt.Sprintf("%s.benefit[%d]", path, i), issues)
+	}
+	if r.AuthorizationRequiredElement != nil {
+		r.AuthorizationRequiredElement.validateAll(path+".authorizationRequired", issues)
+	}
+	for i, item := range r.AuthorizationSupporting {
+		item.validateAll(fmt.Sprintf("%s.authorizationSupporting[%d]", path, i), issues)
+	}
+	if r.AuthorizationUrlElement != nil {
+		r.AuthorizationUrlElement.validateAll(path+".authorizationUrl", issues)
+	}
+}
+
+var coverageEligibilityResponseInsuranceItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) invariants() []invariant {
+	return coverageEligibilityResponseInsuranceItemInvariants
+}
+
+func (r CoverageEligibilityResponseInsuranceItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityResponseInsuranceItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var coverageEligibilityResponseInsuranceItemElementTypes = map[string]string{
+	"description":      "markdown",
+	"authorizationUrl": "uri",
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) elementTypes() map[string]string {
+	return coverageEligibilityResponseInsuranceItemElementTypes
+}
+
+var coverageEligibilityResponseInsuranceItemElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityResponse.insurance.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.insurance.item.category", Name: "category", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.productOrService", Name: "productOrService", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.modifier", Name: "modifier", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.provider", Name: "provider", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.excluded", Name: "excluded", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.name", Name: "name", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.description", Name: "description", Field: 11, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.network", Name: "network", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.unit", Name: "unit", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.term", Name: "term", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit", Name: "benefit", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.authorizationRequired", Name: "authorizationRequired", Field: 17, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.authorizationSupporting", Name: "authorizationSupporting", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.authorizationUrl", Name: "authorizationUrl", Field: 20, Min: 0, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *CoverageEligibilityResponseInsuranceItem) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityResponseInsuranceItemElementMetadata
+}
+
+type CoverageEligibilityResponseInsuranceItemBenefit struct {
+	Id                *string                                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                                       `json:"type" bson:"type"`                                                // Benefit classification
+	Allowed           CoverageEligibilityResponseInsuranceItemBenefitAllowed `json:"-" bson:"allowed,omitempty"`                                      // Benefits allowed
+	AllowedElement    *Element                                               `json:"-" bson:"allowed_element,omitempty"`                              // Extensions for allowed[x]
+	Used              CoverageEligibilityResponseInsuranceItemBenefitUsed    `json:"-" bson:"used,omitempty"`                                         // Benefits used
+	UsedElement       *Element                                               `json:"-" bson:"used_element,omitempty"`                                 // Extensions for used[x]
+
+	allowedVariants []string // JSON properties of CoverageEligibilityResponse.insurance.item.benefit.allowed[x] when more than one was decoded
+	usedVariants    []string // JSON properties of CoverageEligibilityResponse.insurance.item.benefit.used[x] when more than one was decoded
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.allowedVariants) > 1 {
+		return fmt.Errorf("field 'Allowed' must have a single type, got %v", r.allowedVariants)
+	}
+	if r.Allowed != nil {
+		if err := r.Allowed.Validate(); err != nil {
+			return fmt.Errorf("Allowed: %w", err)
+		}
+	}
+	if r.AllowedElement != nil {
+		if err := r.AllowedElement.Validate(); err != nil {
+			return fmt.Errorf("AllowedElement: %w", err)
+		}
+	}
+	if len(r.usedVariants) > 1 {
+		return fmt.Errorf("field 'Used' must have a single type, got %v", r.usedVariants)
+	}
+	if r.Used != nil {
+		if err := r.Used.Validate(); err != nil {
+			return fmt.Errorf("Used: %w", err)
+		}
+	}
+	if r.UsedElement != nil {
+		if err := r.UsedElement.Validate(); err != nil {
+			return fmt.Errorf("UsedElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.allowedVariants) > 1 {
+		issues.add("structure", path+".allowed", fmt.Sprintf("field 'Allowed' must have a single type, got %v", r.allowedVariants))
+	}
+	if r.Allowed != nil {
+		r.Allowed.validateAll(path+".allowed.ofType("+r.Allowed.FHIRType()+")", issues)
+	}
+	if r.AllowedElement != nil {
+		r.AllowedElement.validateAll(path+".allowed", issues)
+	}
+	if len(r.usedVariants) > 1 {
+		issues.add("structure", path+".used", fmt.Sprintf("field 'Used' must have a single type, got %v", r.usedVariants))
+	}
+	if r.Used != nil {
+		r.Used.validateAll(path+".used.ofType("+r.Used.FHIRType()+")", issues)
+	}
+	if r.UsedElement != nil {
+		r.UsedElement.validateAll(path+".used", issues)
+	}
+}
+
+var coverageEligibilityResponseInsuranceItemBenefitInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) invariants() []invariant {
+	return coverageEligibilityResponseInsuranceItemBenefitInvariants
+}
+
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityResponseInsuranceItemBenefit
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "allowed", r.Allowed, r.AllowedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "used", r.Used, r.UsedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityResponseInsuranceItemBenefit
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Allowed, element, r.allowedVariants, err = unmarshalChoice(fields, "allowed", coverageEligibilityResponseInsuranceItemBenefitAllowedVariants); err != nil {
+		return err
+	}
+	r.AllowedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AllowedElement); err != nil {
+			return fmt.Errorf("_allowed: %w", err)
+		}
+	}
+	if r.Used, element, r.usedVariants, err = unmarshalChoice(fields, "used", coverageEligibilityResponseInsuranceItemBenefitUsedVariants); err != nil {
+		return err
+	}
+	r.UsedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.UsedElement); err != nil {
+			return fmt.Errorf("_used: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityResponseInsuranceItemBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Allowed":
+		return "allowed", choiceValues(coverageEligibilityResponseInsuranceItemBenefitAllowedVariants)
+	case "Used":
+		return "used", choiceValues(coverageEligibilityResponseInsuranceItemBenefitUsedVariants)
+	}
+	return "", nil
+}
+
+var coverageEligibilityResponseInsuranceItemBenefitElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit.allowed[x]", Name: "allowed", Field: 4, Min: 0, Max: "1", Types: []string{"unsignedInt", "string", "Money"}},
+	{Path: "CoverageEligibilityResponse.insurance.item.benefit.used[x]", Name: "used", Field: 6, Min: 0, Max: "1", Types: []string{"unsignedInt", "string", "Money"}},
+}
+
+func (r *CoverageEligibilityResponseInsuranceItemBenefit) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityResponseInsuranceItemBenefitElementMetadata
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitAllowed is the type of CoverageEligibilityResponse.insurance.item.benefit.allowed[x]. It is implemented by the CoverageEligibilityResponseInsuranceItemBenefitAllowed* variant types.
+type CoverageEligibilityResponseInsuranceItemBenefitAllowed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityResponseInsuranceItemBenefitAllowed()
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt is the unsignedInt variant of CoverageEligibilityResponseInsuranceItemBenefitAllowed.
+type CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt int
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt) isCoverageEligibilityResponseInsuranceItemBenefitAllowed() {
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitAllowedString is the string variant of CoverageEligibilityResponseInsuranceItemBenefitAllowed.
+type CoverageEligibilityResponseInsuranceItemBenefitAllowedString string
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedString) Validate() error {
+	return nil
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedString) FHIRType() string {
+	return "string"
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedString) isCoverageEligibilityResponseInsuranceItemBenefitAllowed() {
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney is the Money variant of CoverageEligibilityResponseInsuranceItemBenefitAllowed.
+type CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney struct {
+	Money
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney) isCoverageEligibilityResponseInsuranceItemBenefitAllowed() {
+}
+
+var coverageEligibilityResponseInsuranceItemBenefitAllowedVariants = []CoverageEligibilityResponseInsuranceItemBenefitAllowed{
+	CoverageEligibilityResponseInsuranceItemBenefitAllowedUnsignedInt(0),
+	CoverageEligibilityResponseInsuranceItemBenefitAllowedString(""),
+	CoverageEligibilityResponseInsuranceItemBenefitAllowedMoney{},
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitUsed is the type of CoverageEligibilityResponse.insurance.item.benefit.used[x]. It is implemented by the CoverageEligibilityResponseInsuranceItemBenefitUsed* variant types.
+type CoverageEligibilityResponseInsuranceItemBenefitUsed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isCoverageEligibilityResponseInsuranceItemBenefitUsed()
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt is the unsignedInt variant of CoverageEligibilityResponseInsuranceItemBenefitUsed.
+type CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt int
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt) isCoverageEligibilityResponseInsuranceItemBenefitUsed() {
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitUsedString is the string variant of CoverageEligibilityResponseInsuranceItemBenefitUsed.
+type CoverageEligibilityResponseInsuranceItemBenefitUsedString string
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedString) Validate() error {
+	return nil
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedString) FHIRType() string {
+	return "string"
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedString) isCoverageEligibilityResponseInsuranceItemBenefitUsed() {
+}
+
+// CoverageEligibilityResponseInsuranceItemBenefitUsedMoney is the Money variant of CoverageEligibilityResponseInsuranceItemBenefitUsed.
+type CoverageEligibilityResponseInsuranceItemBenefitUsedMoney struct {
+	Money
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v CoverageEligibilityResponseInsuranceItemBenefitUsedMoney) isCoverageEligibilityResponseInsuranceItemBenefitUsed() {
+}
+
+var coverageEligibilityResponseInsuranceItemBenefitUsedVariants = []CoverageEligibilityResponseInsuranceItemBenefitUsed{
+	CoverageEligibilityResponseInsuranceItemBenefitUsedUnsignedInt(0),
+	CoverageEligibilityResponseInsuranceItemBenefitUsedString(""),
+	CoverageEligibilityResponseInsuranceItemBenefitUsedMoney{},
+}
+
 type CoverageEligibilityResponseError struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Code       *CodeableConcept `json:"code" bson:"code"`                                 // Error code detailing processing issues
-	Expression []string         `json:"expression,omitempty" bson:"expression,omitempty"` // FHIRPath of element(s) related to issue
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code" bson:"code"`                                                // Error code detailing processing issues
+	Expression        []string         `json:"expression,omitempty" bson:"expression,omitempty"`                // FHIRPath of element(s) related to issue
+	ExpressionElement []*Element       `json:"_expression,omitempty" bson:"expression_element,omitempty"`       // Extensions for expression
 }
 
 func (r *CoverageEligibilityResponseError) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -295,5 +1531,97 @@ func (r *CoverageEligibilityResponseError) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	for i, item := range r.ExpressionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *CoverageEligibilityResponseError) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.ExpressionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.expression[%d]", path, i), issues)
+	}
+}
+
+var coverageEligibilityResponseErrorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *CoverageEligibilityResponseError) invariants() []invariant {
+	return coverageEligibilityResponseErrorInvariants
+}
+
+func (r CoverageEligibilityResponseError) MarshalJSON() ([]byte, error) {
+	type alias CoverageEligibilityResponseError
+	out := struct {
+		alias
+		Expression        []*string  `json:"expression,omitempty"`
+		ExpressionElement []*Element `json:"_expression,omitempty"`
+	}{alias: alias(r)}
+	out.Expression, out.ExpressionElement = alignPrimitiveArray(r.Expression, r.ExpressionElement)
+	return json.Marshal(out)
+}
+
+func (r *CoverageEligibilityResponseError) UnmarshalJSON(data []byte) error {
+	type alias CoverageEligibilityResponseError
+	aux := struct {
+		*alias
+		Expression []*string `json:"expression,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Expression, err = splitPrimitiveArray(aux.Expression, r.ExpressionElement); err != nil {
+		return fmt.Errorf("expression: %w", err)
+	}
 	return nil
 }
+
+func (r CoverageEligibilityResponseError) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *CoverageEligibilityResponseError) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r CoverageEligibilityResponseError) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *CoverageEligibilityResponseError) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var coverageEligibilityResponseErrorElementMetadata = []ElementMetadata{
+	{Path: "CoverageEligibilityResponse.error.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "CoverageEligibilityResponse.error.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "CoverageEligibilityResponse.error.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "CoverageEligibilityResponse.error.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "CoverageEligibilityResponse.error.expression", Name: "expression", Field: 4, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *CoverageEligibilityResponseError) ElementMetadata() []ElementMetadata {
+	return coverageEligibilityResponseErrorElementMetadata
+}
@@ -1,9 +1,63 @@
 package models
 
-// Returns the most current version of the canonical resource with the specified url available on the server.  It optionally also allows filtering to only expose the most current version with a particular status or set of statuses.  Note that 'current' is determined by comparing version values using the specified versionAlgorithm, NOT by looking at lastUpdated.
-type CurrentCanonical struct {
+// CurrentCanonicalIn holds the input parameters of the $CurrentCanonical operation. Returns the most current version of the canonical resource with the specified url available on the server.  It optionally also allows filtering to only expose the most current version with a particular status or set of statuses.  Note that 'current' is determined by comparing version values using the specified versionAlgorithm, NOT by looking at lastUpdated.
+type CurrentCanonicalIn struct {
 }
 
-func (r *CurrentCanonical) Validate() error {
+func (p *CurrentCanonicalIn) Validate() error {
+	return nil
+}
+
+func (p *CurrentCanonicalIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *CurrentCanonicalIn) FromParameters(params *Parameters) error {
+	*p = CurrentCanonicalIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *CurrentCanonicalIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *CurrentCanonicalIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// CurrentCanonicalOut holds the output parameters of the $CurrentCanonical operation.
+type CurrentCanonicalOut struct {
+}
+
+func (p *CurrentCanonicalOut) Validate() error {
+	return nil
+}
+
+func (p *CurrentCanonicalOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *CurrentCanonicalOut) FromParameters(params *Parameters) error {
+	*p = CurrentCanonicalOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *CurrentCanonicalOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *CurrentCanonicalOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
@@ -1,37 +1,71 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // DataRequirement Type: Describes a required data item for evaluation in terms of the type of data, and optional code or date-based filters of the data.
 type DataRequirement struct {
-	Id                     *string                      `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
-	Type                   string                       `json:"type" bson:"type"`                                                           // The type of the required data
-	Profile                []string                     `json:"profile,omitempty" bson:"profile,omitempty"`                                 // The profile of the required data
-	SubjectCodeableConcept *CodeableConcept             `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"` // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
-	SubjectReference       *Reference                   `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`              // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
-	MustSupport            []string                     `json:"mustSupport,omitempty" bson:"must_support,omitempty"`                        // Indicates specific structure elements that are referenced by the knowledge module
-	CodeFilter             []DataRequirementCodeFilter  `json:"codeFilter,omitempty" bson:"code_filter,omitempty"`                          // What codes are expected
-	DateFilter             []DataRequirementDateFilter  `json:"dateFilter,omitempty" bson:"date_filter,omitempty"`                          // What dates/date ranges are expected
-	ValueFilter            []DataRequirementValueFilter `json:"valueFilter,omitempty" bson:"value_filter,omitempty"`                        // What values are expected
-	Limit                  *int                         `json:"limit,omitempty" bson:"limit,omitempty"`                                     // Number of results
-	Sort                   []DataRequirementSort        `json:"sort,omitempty" bson:"sort,omitempty"`                                       // Order of the results
+	Id                 *string                      `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
+	Extension          []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`               // Additional content defined by implementations
+	Type               FHIRTypes                    `json:"type" bson:"type"`                                             // The type of the required data
+	TypeElement        *Element                     `json:"_type,omitempty" bson:"type_element,omitempty"`                // Extensions for type
+	Profile            []string                     `json:"profile,omitempty" bson:"profile,omitempty"`                   // The profile of the required data
+	ProfileElement     []*Element                   `json:"_profile,omitempty" bson:"profile_element,omitempty"`          // Extensions for profile
+	Subject            DataRequirementSubject       `json:"-" bson:"subject,omitempty"`                                   // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
+	MustSupport        []string                     `json:"mustSupport,omitempty" bson:"must_support,omitempty"`          // Indicates specific structure elements that are referenced by the knowledge module
+	MustSupportElement []*Element                   `json:"_mustSupport,omitempty" bson:"must_support_element,omitempty"` // Extensions for mustSupport
+	CodeFilter         []DataRequirementCodeFilter  `json:"codeFilter,omitempty" bson:"code_filter,omitempty"`            // What codes are expected
+	DateFilter         []DataRequirementDateFilter  `json:"dateFilter,omitempty" bson:"date_filter,omitempty"`            // What dates/date ranges are expected
+	ValueFilter        []DataRequirementValueFilter `json:"valueFilter,omitempty" bson:"value_filter,omitempty"`          // What values are expected
+	Limit              *int                         `json:"limit,omitempty" bson:"limit,omitempty"`                       // Number of results
+	LimitElement       *Element                     `json:"_limit,omitempty" bson:"limit_element,omitempty"`              // Extensions for limit
+	Sort               []DataRequirementSort        `json:"sort,omitempty" bson:"sort,omitempty"`                         // Order of the results
+
+	subjectVariants []string // JSON properties of DataRequirement.subject[x] when more than one was decoded
 }
 
 func (r *DataRequirement) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement[%d]: %w", i, err)
+		}
+	}
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	for i, item := range r.MustSupportElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("MustSupportElement[%d]: %w", i, err)
 		}
 	}
 	for i, item := range r.CodeFilter {
@@ -49,6 +83,11 @@ func (r *DataRequirement) Validate() error {
 			return fmt.Errorf("ValueFilter[%d]: %w", i, err)
 		}
 	}
+	if r.LimitElement != nil {
+		if err := r.LimitElement.Validate(); err != nil {
+			return fmt.Errorf("LimitElement: %w", err)
+		}
+	}
 	for i, item := range r.Sort {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Sort[%d]: %w", i, err)
@@ -57,83 +96,881 @@ func (r *DataRequirement) Validate() error {
 	return nil
 }
 
+func (r *DataRequirement) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profile[%d]", path, i), issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	for i, item := range r.MustSupportElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.mustSupport[%d]", path, i), issues)
+	}
+	for i, item := range r.CodeFilter {
+		item.validateAll(fmt.Sprintf("%s.codeFilter[%d]", path, i), issues)
+	}
+	for i, item := range r.DateFilter {
+		item.validateAll(fmt.Sprintf("%s.dateFilter[%d]", path, i), issues)
+	}
+	for i, item := range r.ValueFilter {
+		item.validateAll(fmt.Sprintf("%s.valueFilter[%d]", path, i), issues)
+	}
+	if r.LimitElement != nil {
+		r.LimitElement.validateAll(path+".limit", issues)
+	}
+	for i, item := range r.Sort {
+		item.validateAll(fmt.Sprintf("%s.sort[%d]", path, i), issues)
+	}
+}
+
+func (r *DataRequirement) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DataRequirement", &issues)
+	checkInvariants(r, "DataRequirement", &issues)
+	return issues
+}
+
+var dataRequirementInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DataRequirement) invariants() []invariant {
+	return dataRequirementInvariants
+}
+
+func (r DataRequirement) MarshalJSON() ([]byte, error) {
+	type alias DataRequirement
+	out := struct {
+		alias
+		Profile            []*string  `json:"profile,omitempty"`
+		ProfileElement     []*Element `json:"_profile,omitempty"`
+		MustSupport        []*string  `json:"mustSupport,omitempty"`
+		MustSupportElement []*Element `json:"_mustSupport,omitempty"`
+	}{alias: alias(r)}
+	out.Profile, out.ProfileElement = alignPrimitiveArray(r.Profile, r.ProfileElement)
+	out.MustSupport, out.MustSupportElement = alignPrimitiveArray(r.MustSupport, r.MustSupportElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DataRequirement) UnmarshalJSON(data []byte) error {
+	type alias DataRequirement
+	aux := struct {
+		*alias
+		Profile     []*string `json:"profile,omitempty"`
+		MustSupport []*string `json:"mustSupport,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Profile, err = splitPrimitiveArray(aux.Profile, r.ProfileElement); err != nil {
+		return fmt.Errorf("profile: %w", err)
+	}
+	if r.MustSupport, err = splitPrimitiveArray(aux.MustSupport, r.MustSupportElement); err != nil {
+		return fmt.Errorf("mustSupport: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Subject, _, r.subjectVariants, err = unmarshalChoice(fields, "subject", dataRequirementSubjectVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r DataRequirement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DataRequirement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DataRequirement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DataRequirement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DataRequirement) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Subject":
+		return "subject", choiceValues(dataRequirementSubjectVariants)
+	}
+	return "", nil
+}
+
+var dataRequirementElementTypes = map[string]string{
+	"profile": "canonical",
+	"limit":   "positiveInt",
+}
+
+func (r *DataRequirement) elementTypes() map[string]string {
+	return dataRequirementElementTypes
+}
+
+var dataRequirementElementMetadata = []ElementMetadata{
+	{Path: "DataRequirement.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DataRequirement.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DataRequirement.type", Name: "type", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DataRequirement.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/fhir-types", Description: "List of FHIR types (resources, data types)."}, Summary: true},
+	{Path: "DataRequirement.profile", Name: "profile", Field: 4, Min: 0, Max: "*", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition"}, Summary: true},
+	{Path: "DataRequirement.subject[x]", Name: "subject", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group"}, Binding: &BindingDefinition{Path: "DataRequirement.subject[x]", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/participant-resource-types", Description: "The possible types of subjects for a data requirement (E.g., Patient, Practitioner, Organization, Location, etc.)."}, Summary: true},
+	{Path: "DataRequirement.mustSupport", Name: "mustSupport", Field: 7, Min: 0, Max: "*", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.codeFilter", Name: "codeFilter", Field: 9, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "DataRequirement.dateFilter", Name: "dateFilter", Field: 10, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "DataRequirement.valueFilter", Name: "valueFilter", Field: 11, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "DataRequirement.limit", Name: "limit", Field: 12, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "DataRequirement.sort", Name: "sort", Field: 14, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+}
+
+func (r *DataRequirement) ElementMetadata() []ElementMetadata {
+	return dataRequirementElementMetadata
+}
+
+// DataRequirementSubject is the type of DataRequirement.subject[x]. It is implemented by the DataRequirementSubject* variant types.
+type DataRequirementSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDataRequirementSubject()
+}
+
+// DataRequirementSubjectCodeableConcept is the CodeableConcept variant of DataRequirementSubject.
+type DataRequirementSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DataRequirementSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DataRequirementSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DataRequirementSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DataRequirementSubjectCodeableConcept) isDataRequirementSubject() {}
+
+// DataRequirementSubjectReference is the Reference variant of DataRequirementSubject.
+type DataRequirementSubjectReference struct {
+	Reference
+}
+
+func (v DataRequirementSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v DataRequirementSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v DataRequirementSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v DataRequirementSubjectReference) isDataRequirementSubject() {}
+
+var dataRequirementSubjectVariants = []DataRequirementSubject{
+	DataRequirementSubjectCodeableConcept{},
+	DataRequirementSubjectReference{},
+}
+
+type DataRequirementCodeFilter struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`               // Additional content defined by implementations
+	Path               *string     `json:"path,omitempty" bson:"path,omitempty"`                         // A code-valued attribute to filter on
+	PathElement        *Element    `json:"_path,omitempty" bson:"path_element,omitempty"`                // Extensions for path
+	SearchParam        *string     `json:"searchParam,omitempty" bson:"search_param,omitempty"`          // A coded (token) parameter to search on
+	SearchParamElement *Element    `json:"_searchParam,omitempty" bson:"search_param_element,omitempty"` // Extensions for searchParam
+	ValueSet           *string     `json:"valueSet,omitempty" bson:"value_set,omitempty"`                // ValueSet for the filter
+	ValueSetElement    *Element    `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`       // Extensions for valueSet
+	Code               []Coding    `json:"code,omitempty" bson:"code,omitempty"`                         // What code is expected
+}
+
+func (r *DataRequirementCodeFilter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	if r.SearchParamElement != nil {
+		if err := r.SearchParamElement.Validate(); err != nil {
+			return fmt.Errorf("SearchParamElement: %w", err)
+		}
+	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	for i, item := range r.Code {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Code[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *DataRequirementCodeFilter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.SearchParamElement != nil {
+		r.SearchParamElement.validateAll(path+".searchParam", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+}
+
+var dataRequirementCodeFilterInvariants = []invariant{
+	{key: "drq-1", severity: "error", human: "Either a path or a searchParam must be provided, but not both", expression: "path.exists() xor searchParam.exists()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DataRequirementCodeFilter) invariants() []invariant {
+	return dataRequirementCodeFilterInvariants
+}
+
+func (r DataRequirementCodeFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DataRequirementCodeFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DataRequirementCodeFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DataRequirementCodeFilter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dataRequirementCodeFilterElementTypes = map[string]string{
+	"valueSet": "canonical",
+}
+
+func (r *DataRequirementCodeFilter) elementTypes() map[string]string {
+	return dataRequirementCodeFilterElementTypes
+}
+
+var dataRequirementCodeFilterElementMetadata = []ElementMetadata{
+	{Path: "DataRequirement.codeFilter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DataRequirement.codeFilter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DataRequirement.codeFilter.path", Name: "path", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.codeFilter.searchParam", Name: "searchParam", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.codeFilter.valueSet", Name: "valueSet", Field: 6, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ValueSet"}, Summary: true},
+	{Path: "DataRequirement.codeFilter.code", Name: "code", Field: 8, Min: 0, Max: "*", Types: []string{"Coding"}, Summary: true},
+}
+
+func (r *DataRequirementCodeFilter) ElementMetadata() []ElementMetadata {
+	return dataRequirementCodeFilterElementMetadata
+}
+
 type DataRequirementDateFilter struct {
-	Id            *string   `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Path          *string   `json:"path,omitempty" bson:"path,omitempty"`                     // A date-valued attribute to filter on
-	SearchParam   *string   `json:"searchParam,omitempty" bson:"search_param,omitempty"`      // A date valued parameter to search on
-	ValueDateTime *string   `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"` // The value of the filter, as a Period, DateTime, or Duration value
-	ValuePeriod   *Period   `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`      // The value of the filter, as a Period, DateTime, or Duration value
-	ValueDuration *Duration `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`  // The value of the filter, as a Period, DateTime, or Duration value
+	Id                 *string                        `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
+	Extension          []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`               // Additional content defined by implementations
+	Path               *string                        `json:"path,omitempty" bson:"path,omitempty"`                         // A date-valued attribute to filter on
+	PathElement        *Element                       `json:"_path,omitempty" bson:"path_element,omitempty"`                // Extensions for path
+	SearchParam        *string                        `json:"searchParam,omitempty" bson:"search_param,omitempty"`          // A date valued parameter to search on
+	SearchParamElement *Element                       `json:"_searchParam,omitempty" bson:"search_param_element,omitempty"` // Extensions for searchParam
+	Value              DataRequirementDateFilterValue `json:"-" bson:"value,omitempty"`                                     // The value of the filter, as a Period, DateTime, or Duration value
+	ValueElement       *Element                       `json:"-" bson:"value_element,omitempty"`                             // Extensions for value[x]
+
+	valueVariants []string // JSON properties of DataRequirement.dateFilter.value[x] when more than one was decoded
 }
 
 func (r *DataRequirementDateFilter) Validate() error {
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	if r.SearchParamElement != nil {
+		if err := r.SearchParamElement.Validate(); err != nil {
+			return fmt.Errorf("SearchParamElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r *DataRequirementDateFilter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.SearchParamElement != nil {
+		r.SearchParamElement.validateAll(path+".searchParam", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var dataRequirementDateFilterInvariants = []invariant{
+	{key: "drq-2", severity: "error", human: "Either a path or a searchParam must be provided, but not both", expression: "path.exists() xor searchParam.exists()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DataRequirementDateFilter) invariants() []invariant {
+	return dataRequirementDateFilterInvariants
+}
+
+func (r DataRequirementDateFilter) MarshalJSON() ([]byte, error) {
+	type alias DataRequirementDateFilter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DataRequirementDateFilter) UnmarshalJSON(data []byte) error {
+	type alias DataRequirementDateFilter
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", dataRequirementDateFilterValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DataRequirementDateFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DataRequirementDateFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DataRequirementDateFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DataRequirementDateFilter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DataRequirementDateFilter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(dataRequirementDateFilterValueVariants)
+	}
+	return "", nil
+}
+
+var dataRequirementDateFilterElementMetadata = []ElementMetadata{
+	{Path: "DataRequirement.dateFilter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DataRequirement.dateFilter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DataRequirement.dateFilter.path", Name: "path", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.dateFilter.searchParam", Name: "searchParam", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.dateFilter.value[x]", Name: "value", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Duration"}, Summary: true},
+}
+
+func (r *DataRequirementDateFilter) ElementMetadata() []ElementMetadata {
+	return dataRequirementDateFilterElementMetadata
+}
+
+// DataRequirementDateFilterValue is the type of DataRequirement.dateFilter.value[x]. It is implemented by the DataRequirementDateFilterValue* variant types.
+type DataRequirementDateFilterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDataRequirementDateFilterValue()
+}
+
+// DataRequirementDateFilterValueDateTime is the dateTime variant of DataRequirementDateFilterValue.
+type DataRequirementDateFilterValueDateTime struct {
+	DateTime
+}
+
+func (v DataRequirementDateFilterValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DataRequirementDateFilterValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v DataRequirementDateFilterValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DataRequirementDateFilterValueDateTime) isDataRequirementDateFilterValue() {}
+
+// DataRequirementDateFilterValuePeriod is the Period variant of DataRequirementDateFilterValue.
+type DataRequirementDateFilterValuePeriod struct {
+	Period
+}
+
+func (v DataRequirementDateFilterValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DataRequirementDateFilterValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DataRequirementDateFilterValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DataRequirementDateFilterValuePeriod) isDataRequirementDateFilterValue() {}
+
+// DataRequirementDateFilterValueDuration is the Duration variant of DataRequirementDateFilterValue.
+type DataRequirementDateFilterValueDuration struct {
+	Duration
+}
+
+func (v DataRequirementDateFilterValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v DataRequirementDateFilterValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v DataRequirementDateFilterValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v DataRequirementDateFilterValueDuration) isDataRequirementDateFilterValue() {}
+
+var dataRequirementDateFilterValueVariants = []DataRequirementDateFilterValue{
+	DataRequirementDateFilterValueDateTime{},
+	DataRequirementDateFilterValuePeriod{},
+	DataRequirementDateFilterValueDuration{},
+}
+
 type DataRequirementValueFilter struct {
-	Id            *string   `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Path          *string   `json:"path,omitempty" bson:"path,omitempty"`                     // An attribute to filter on
-	SearchParam   *string   `json:"searchParam,omitempty" bson:"search_param,omitempty"`      // A parameter to search on
-	Comparator    *string   `json:"comparator,omitempty" bson:"comparator,omitempty"`         // eq | gt | lt | ge | le | sa | eb
-	ValueDateTime *string   `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"` // The value of the filter, as a Period, DateTime, or Duration value
-	ValuePeriod   *Period   `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`      // The value of the filter, as a Period, DateTime, or Duration value
-	ValueDuration *Duration `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`  // The value of the filter, as a Period, DateTime, or Duration value
+	Id                 *string                         `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
+	Extension          []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`               // Additional content defined by implementations
+	Path               *string                         `json:"path,omitempty" bson:"path,omitempty"`                         // An attribute to filter on
+	PathElement        *Element                        `json:"_path,omitempty" bson:"path_element,omitempty"`                // Extensions for path
+	SearchParam        *string                         `json:"searchParam,omitempty" bson:"search_param,omitempty"`          // A parameter to search on
+	SearchParamElement *Element                        `json:"_searchParam,omitempty" bson:"search_param_element,omitempty"` // Extensions for searchParam
+	Comparator         *ValueFilterComparator          `json:"comparator,omitempty" bson:"comparator,omitempty"`             // eq | gt | lt | ge | le | sa | eb
+	ComparatorElement  *Element                        `json:"_comparator,omitempty" bson:"comparator_element,omitempty"`    // Extensions for comparator
+	Value              DataRequirementValueFilterValue `json:"-" bson:"value,omitempty"`                                     // The value of the filter, as a Period, DateTime, or Duration value
+	ValueElement       *Element                        `json:"-" bson:"value_element,omitempty"`                             // Extensions for value[x]
+
+	valueVariants []string // JSON properties of DataRequirement.valueFilter.value[x] when more than one was decoded
 }
 
 func (r *DataRequirementValueFilter) Validate() error {
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
 		}
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
+	if r.SearchParamElement != nil {
+		if err := r.SearchParamElement.Validate(); err != nil {
+			return fmt.Errorf("SearchParamElement: %w", err)
+		}
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		return fmt.Errorf("field 'Comparator' has invalid code '%s'", *r.Comparator)
+	}
+	if r.ComparatorElement != nil {
+		if err := r.ComparatorElement.Validate(); err != nil {
+			return fmt.Errorf("ComparatorElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DataRequirementValueFilter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.SearchParamElement != nil {
+		r.SearchParamElement.validateAll(path+".searchParam", issues)
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		issues.add("code-invalid", path+".comparator", fmt.Sprintf("field 'Comparator' has invalid code '%s'", *r.Comparator))
+	}
+	if r.ComparatorElement != nil {
+		r.ComparatorElement.validateAll(path+".comparator", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var dataRequirementValueFilterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DataRequirementValueFilter) invariants() []invariant {
+	return dataRequirementValueFilterInvariants
+}
+
+func (r DataRequirementValueFilter) MarshalJSON() ([]byte, error) {
+	type alias DataRequirementValueFilter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DataRequirementValueFilter) UnmarshalJSON(data []byte) error {
+	type alias DataRequirementValueFilter
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", dataRequirementValueFilterValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r DataRequirementValueFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DataRequirementValueFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DataRequirementValueFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DataRequirementValueFilter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DataRequirementValueFilter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(dataRequirementValueFilterValueVariants)
+	}
+	return "", nil
+}
+
+var dataRequirementValueFilterElementMetadata = []ElementMetadata{
+	{Path: "DataRequirement.valueFilter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DataRequirement.valueFilter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DataRequirement.valueFilter.path", Name: "path", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.valueFilter.searchParam", Name: "searchParam", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.valueFilter.comparator", Name: "comparator", Field: 6, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DataRequirement.valueFilter.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/value-filter-comparator", Description: "Possible comparators for the valueFilter element."}, Summary: true},
+	{Path: "DataRequirement.valueFilter.value[x]", Name: "value", Field: 8, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Duration"}, Summary: true},
+}
+
+func (r *DataRequirementValueFilter) ElementMetadata() []ElementMetadata {
+	return dataRequirementValueFilterElementMetadata
+}
+
+// DataRequirementValueFilterValue is the type of DataRequirement.valueFilter.value[x]. It is implemented by the DataRequirementValueFilterValue* variant types.
+type DataRequirementValueFilterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDataRequirementValueFilterValue()
+}
+
+// DataRequirementValueFilterValueDateTime is the dateTime variant of DataRequirementValueFilterValue.
+type DataRequirementValueFilterValueDateTime struct {
+	DateTime
+}
+
+func (v DataRequirementValueFilterValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DataRequirementValueFilterValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v DataRequirementValueFilterValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DataRequirementValueFilterValueDateTime) isDataRequirementValueFilterValue() {}
+
+// DataRequirementValueFilterValuePeriod is the Period variant of DataRequirementValueFilterValue.
+type DataRequirementValueFilterValuePeriod struct {
+	Period
+}
+
+func (v DataRequirementValueFilterValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DataRequirementValueFilterValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DataRequirementValueFilterValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DataRequirementValueFilterValuePeriod) isDataRequirementValueFilterValue() {}
+
+// DataRequirementValueFilterValueDuration is the Duration variant of DataRequirementValueFilterValue.
+type DataRequirementValueFilterValueDuration struct {
+	Duration
+}
+
+func (v DataRequirementValueFilterValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v DataRequirementValueFilterValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v DataRequirementValueFilterValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v DataRequirementValueFilterValueDuration) isDataRequirementValueFilterValue() {}
+
+var dataRequirementValueFilterValueVariants = []DataRequirementValueFilterValue{
+	DataRequirementValueFilterValueDateTime{},
+	DataRequirementValueFilterValuePeriod{},
+	DataRequirementValueFilterValueDuration{},
+}
+
 type DataRequirementSort struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Path      string  `json:"path" bson:"path"`                 // The name of the attribute to perform the sort
-	Direction string  `json:"direction" bson:"direction"`       // ascending | descending
+	Id               *string       `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
+	Extension        []Extension   `json:"extension,omitempty" bson:"extension,omitempty"`          // Additional content defined by implementations
+	Path             string        `json:"path" bson:"path"`                                        // The name of the attribute to perform the sort
+	PathElement      *Element      `json:"_path,omitempty" bson:"path_element,omitempty"`           // Extensions for path
+	Direction        SortDirection `json:"direction" bson:"direction"`                              // ascending | descending
+	DirectionElement *Element      `json:"_direction,omitempty" bson:"direction_element,omitempty"` // Extensions for direction
 }
 
 func (r *DataRequirementSort) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Path == emptyString {
 		return fmt.Errorf("field 'Path' is required")
 	}
-	if r.Direction == emptyString {
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	if r.Direction == "" {
 		return fmt.Errorf("field 'Direction' is required")
 	}
+	if !r.Direction.IsValid() {
+		return fmt.Errorf("field 'Direction' has invalid code '%s'", r.Direction)
+	}
+	if r.DirectionElement != nil {
+		if err := r.DirectionElement.Validate(); err != nil {
+			return fmt.Errorf("DirectionElement: %w", err)
+		}
+	}
 	return nil
 }
 
-type DataRequirementCodeFilter struct {
-	Id          *string  `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Path        *string  `json:"path,omitempty" bson:"path,omitempty"`                // A code-valued attribute to filter on
-	SearchParam *string  `json:"searchParam,omitempty" bson:"search_param,omitempty"` // A coded (token) parameter to search on
-	ValueSet    *string  `json:"valueSet,omitempty" bson:"value_set,omitempty"`       // ValueSet for the filter
-	Code        []Coding `json:"code,omitempty" bson:"code,omitempty"`                // What code is expected
+func (r *DataRequirementSort) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		issues.add("required", path+".path", "field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.Direction == "" {
+		issues.add("required", path+".direction", "field 'Direction' is required")
+	}
+	if r.Direction != "" && !r.Direction.IsValid() {
+		issues.add("code-invalid", path+".direction", fmt.Sprintf("field 'Direction' has invalid code '%s'", r.Direction))
+	}
+	if r.DirectionElement != nil {
+		r.DirectionElement.validateAll(path+".direction", issues)
+	}
 }
 
-func (r *DataRequirementCodeFilter) Validate() error {
-	for i, item := range r.Code {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Code[%d]: %w", i, err)
-		}
-	}
-	return nil
+var dataRequirementSortInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DataRequirementSort) invariants() []invariant {
+	return dataRequirementSortInvariants
+}
+
+func (r DataRequirementSort) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DataRequirementSort) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DataRequirementSort) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DataRequirementSort) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dataRequirementSortElementMetadata = []ElementMetadata{
+	{Path: "DataRequirement.sort.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DataRequirement.sort.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DataRequirement.sort.path", Name: "path", Field: 2, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DataRequirement.sort.direction", Name: "direction", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DataRequirement.sort.direction", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/sort-direction", Description: "The possible sort directions, ascending or descending."}, Summary: true},
+}
+
+func (r *DataRequirementSort) ElementMetadata() []ElementMetadata {
+	return dataRequirementSortElementMetadata
 }
@@ -1,9 +1,63 @@
 package models
 
-// The data-requirements operation aggregates and returns the parameters and data requirements for the plan definition and all its dependencies as a single module definition library
-type DataRequirements struct {
+// DataRequirementsIn holds the input parameters of the $DataRequirements operation. The data-requirements operation aggregates and returns the parameters and data requirements for the plan definition and all its dependencies as a single module definition library
+type DataRequirementsIn struct {
 }
 
-func (r *DataRequirements) Validate() error {
+func (p *DataRequirementsIn) Validate() error {
+	return nil
+}
+
+func (p *DataRequirementsIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *DataRequirementsIn) FromParameters(params *Parameters) error {
+	*p = DataRequirementsIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *DataRequirementsIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *DataRequirementsIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// DataRequirementsOut holds the output parameters of the $DataRequirements operation.
+type DataRequirementsOut struct {
+}
+
+func (p *DataRequirementsOut) Validate() error {
+	return nil
+}
+
+func (p *DataRequirementsOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *DataRequirementsOut) FromParameters(params *Parameters) error {
+	*p = DataRequirementsOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *DataRequirementsOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *DataRequirementsOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
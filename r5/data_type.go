@@ -1,10 +1,67 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // DataType Type: The base class for all re-useable types defined as part of the FHIR Specification.
 type DataType struct {
-	Id *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
 }
 
 func (r *DataType) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *DataType) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *DataType) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DataType", &issues)
+	checkInvariants(r, "DataType", &issues)
+	return issues
+}
+
+var dataTypeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DataType) invariants() []invariant {
+	return dataTypeInvariants
+}
+
+func (r DataType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DataType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DataType) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DataType) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dataTypeElementMetadata = []ElementMetadata{
+	{Path: "DataType.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DataType.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+}
+
+func (r *DataType) ElementMetadata() []ElementMetadata {
+	return dataTypeElementMetadata
+}
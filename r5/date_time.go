@@ -1,11 +1,142 @@
 package models
 
-// dateTime Type: A date, date-time or partial date (e.g. just year or year + month).  If hours and minutes are specified, a UTC offset SHALL be populated. The format is a union of the schema types gYear, gYearMonth, date and dateTime. Seconds must be provided due to schema type constraints but may be zero-filled and may be ignored.                 Dates SHALL be valid dates.
-type dateTime struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *string `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for dateTime
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var dateTimePattern = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00)?)?)?)?)?$`)
+
+// partialDateTimePattern also accepts the hours and minutes of FHIRPath
+// dateTime literals such as @2015-02-04T14.
+var partialDateTimePattern = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3])(:[0-5][0-9](:([0-5][0-9]|60)(\.[0-9]{1,9})?)?)?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00)?)?)?)?)?$`)
+
+// DateTime is a FHIR dateTime: a date with optional time and timezone offset,
+// e.g. "2015", "2015-02-07" or "2015-02-07T13:28:17.239+02:00". The original
+// text is kept, so precision and offset survive a round trip.
+type DateTime struct {
+	literal string
+}
+
+// ParseDateTime parses and validates a FHIR dateTime literal.
+func ParseDateTime(s string) (DateTime, error) {
+	dt := DateTime{literal: s}
+	if err := dt.check(); err != nil {
+		return DateTime{}, err
+	}
+	return dt, nil
+}
+
+// NewDateTime returns t at the given precision. Values with a time part carry
+// the offset of t's location.
+func NewDateTime(t time.Time, precision TemporalPrecision) DateTime {
+	switch precision {
+	case PrecisionYear, PrecisionMonth, PrecisionDay:
+		return DateTime{literal: NewDate(t, precision).String()}
+	case PrecisionSecond:
+		return DateTime{literal: t.Format("2006-01-02T15:04:05Z07:00")}
+	default:
+		return DateTime{literal: t.Format("2006-01-02T15:04:05") + formatFraction(t) + t.Format("Z07:00")}
+	}
+}
+
+func (dt DateTime) String() string {
+	return dt.literal
+}
+
+// IsZero reports whether dt holds no value.
+func (dt DateTime) IsZero() bool {
+	return dt.literal == ""
+}
+
+// Validate checks dt against the regex of the dateTime type and rejects
+// dates that do not exist.
+func (dt DateTime) Validate() error {
+	if dt.IsZero() {
+		return nil
+	}
+	return dt.check()
+}
+
+// Precision returns the precision dt was written with.
+func (dt DateTime) Precision() TemporalPrecision {
+	t, err := dt.parse()
+	if err != nil {
+		return 0
+	}
+	return t.precision
+}
+
+// Location returns the timezone offset dt was written with. ok is false when
+// the literal has no offset.
+func (dt DateTime) Location() (loc *time.Location, ok bool) {
+	t, err := dt.parse()
+	if err != nil || !t.hasOffset() {
+		return nil, false
+	}
+	return t.location, true
+}
+
+// Time returns the first instant covered by dt. Values without an offset are
+// interpreted in UTC.
+func (dt DateTime) Time() (time.Time, error) {
+	t, err := dt.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.start(), nil
+}
+
+// Range returns the half-open interval [start, end) covered by dt, e.g. the
+// whole month for "2015-02".
+func (dt DateTime) Range() (time.Time, time.Time, error) {
+	t, err := dt.parse()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return t.start(), t.end(), nil
+}
+
+// Compare compares dt and other with FHIR semantics. The result is -1, 0 or
+// +1; ok is false when the values have different precisions and overlap, so
+// that their order cannot be determined.
+func (dt DateTime) Compare(other DateTime) (result int, ok bool) {
+	a, err := dt.parse()
+	if err != nil {
+		return 0, false
+	}
+	b, err := other.parse()
+	if err != nil {
+		return 0, false
+	}
+	return compareRanges(a.start(), a.end(), b.start(), b.end())
+}
+
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	return marshalTemporal(dt.literal)
+}
+
+func (dt *DateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalTemporal(data, &dt.literal)
+}
+
+// check parses dt, rejecting the partial times only FHIRPath allows.
+func (dt DateTime) check() error {
+	if !dateTimePattern.MatchString(dt.literal) {
+		return fmt.Errorf("invalid dateTime %q", dt.literal)
+	}
+	_, err := dt.parse()
+	return err
 }
 
-func (r *dateTime) Validate() error {
-	return nil
+func (dt DateTime) parse() (temporal, error) {
+	if !partialDateTimePattern.MatchString(dt.literal) {
+		return temporal{}, fmt.Errorf("invalid dateTime %q", dt.literal)
+	}
+	t, err := parseTemporal(dt.literal)
+	if err != nil {
+		return temporal{}, fmt.Errorf("invalid dateTime %q: %w", dt.literal, err)
+	}
+	return t, nil
 }
@@ -0,0 +1,252 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// decimalPattern is the lexical form of a JSON number, which is also the
+// form FHIR uses for decimal values.
+var decimalPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// Decimal is a FHIR decimal. It keeps the literal text it was parsed from so
+// that precision survives a round trip ("1.50" stays "1.50"), and offers exact
+// arithmetic and comparison on top of math/big. The zero value is 0.
+type Decimal struct {
+	literal string
+}
+
+// ParseDecimal parses a decimal literal such as "1.50", "-0.001" or "1e3".
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{literal: s}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a valid decimal.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromInt returns the decimal value of i.
+func NewDecimalFromInt(i int64) Decimal {
+	return Decimal{literal: strconv.FormatInt(i, 10)}
+}
+
+// NewDecimalFromFloat returns the shortest decimal that converts back to f.
+// Floats carry no precision information, so prefer ParseDecimal when the
+// literal is known.
+func NewDecimalFromFloat(f float64) Decimal {
+	return Decimal{literal: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+// String returns the decimal literal with its original precision.
+func (d Decimal) String() string {
+	if d.literal == "" {
+		return "0"
+	}
+	return d.literal
+}
+
+// Validate checks that d holds a well-formed decimal literal.
+func (d Decimal) Validate() error {
+	if d.literal != "" && !decimalPattern.MatchString(d.literal) {
+		return fmt.Errorf("invalid decimal %q", d.literal)
+	}
+	return nil
+}
+
+// IsZero reports whether d is numerically zero.
+func (d Decimal) IsZero() bool {
+	unscaled, _ := d.parts()
+	return unscaled.Sign() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	unscaled, _ := d.parts()
+	return unscaled.Sign()
+}
+
+// Precision returns the number of digits after the decimal point, which FHIR
+// treats as significant: 1.50 has precision 2.
+func (d Decimal) Precision() int {
+	_, scale := d.parts()
+	if scale < 0 {
+		return 0
+	}
+	return scale
+}
+
+// Float64 returns the nearest float64 value of d.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Rat returns d as an exact rational number.
+func (d Decimal) Rat() *big.Rat {
+	unscaled, scale := d.parts()
+	r := new(big.Rat).SetInt(unscaled)
+	if scale > 0 {
+		return r.Quo(r, new(big.Rat).SetInt(pow10(scale)))
+	}
+	if scale < 0 {
+		return r.Mul(r, new(big.Rat).SetInt(pow10(-scale)))
+	}
+	return r
+}
+
+// Cmp compares d and other numerically, ignoring precision, and returns -1, 0
+// or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := alignDecimals(d, other)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and other have the same numeric value. Use
+// String to compare precision as well.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Add returns d + other with the precision of the more precise operand.
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return formatDecimal(a.Add(a, b), scale)
+}
+
+// Sub returns d - other with the precision of the more precise operand.
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := alignDecimals(d, other)
+	return formatDecimal(a.Sub(a, b), scale)
+}
+
+// Mul returns d * other. The precision of the result is the sum of the
+// precisions of the operands.
+func (d Decimal) Mul(other Decimal) Decimal {
+	a, as := d.parts()
+	b, bs := other.parts()
+	return formatDecimal(a.Mul(a, b), as+bs)
+}
+
+// Quo returns d / other rounded half away from zero to precision digits after
+// the decimal point.
+func (d Decimal) Quo(other Decimal, precision int) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, fmt.Errorf("decimal division by zero")
+	}
+	q := new(big.Rat).Quo(d.Rat(), other.Rat())
+	q.Mul(q, new(big.Rat).SetInt(pow10(precision)))
+
+	num, den := q.Num(), q.Denom()
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return formatDecimal(quo, precision), nil
+}
+
+// Neg returns -d with the same precision.
+func (d Decimal) Neg() Decimal {
+	unscaled, scale := d.parts()
+	return formatDecimal(unscaled.Neg(unscaled), scale)
+}
+
+// Abs returns |d| with the same precision.
+func (d Decimal) Abs() Decimal {
+	unscaled, scale := d.parts()
+	return formatDecimal(unscaled.Abs(unscaled), scale)
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number, keeping its literal text. A number
+// encoded as a JSON string is accepted as well.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	literal := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &literal); err != nil {
+			return err
+		}
+	}
+	parsed, err := ParseDecimal(literal)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// parts returns the unscaled integer and the number of digits after the
+// decimal point, so that d = unscaled * 10^-scale. Scale is negative for
+// literals like "1e3" whose exponent exceeds their fraction digits.
+func (d Decimal) parts() (*big.Int, int) {
+	s := d.String()
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, _ = strconv.Atoi(s[i+1:])
+		s = s[:i]
+	}
+	scale := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = len(s) - i - 1
+		s = s[:i] + s[i+1:]
+	}
+	unscaled, _ := new(big.Int).SetString(s, 10)
+	if unscaled == nil {
+		unscaled = new(big.Int)
+	}
+	return unscaled, scale - exp
+}
+
+func alignDecimals(x, y Decimal) (*big.Int, *big.Int, int) {
+	a, as := x.parts()
+	b, bs := y.parts()
+	scale := max(as, bs, 0)
+	a.Mul(a, pow10(scale-as))
+	b.Mul(b, pow10(scale-bs))
+	return a, b, scale
+}
+
+func formatDecimal(unscaled *big.Int, scale int) Decimal {
+	if scale < 0 {
+		unscaled = new(big.Int).Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	digits := new(big.Int).Abs(unscaled).String()
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if unscaled.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return Decimal{literal: digits}
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -2,40 +2,53 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Indicates an actual or potential clinical issue with or between one or more active or proposed clinical actions for a patient; e.g. Drug-drug interaction, Ineffective treatment frequency, Procedure-condition conflict, gaps in care, etc.
 type DetectedIssue struct {
-	ResourceType       string                    `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                   `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                   `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage         `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier         []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Business identifier for detected issue
-	Status             string                    `json:"status" bson:"status"`                                               // preliminary | final | entered-in-error | unknown | mitigated | processing-error
-	Category           []CodeableConcept         `json:"category,omitempty" bson:"category,omitempty"`                       // High level categorization of detected issue
-	Code               *CodeableConcept          `json:"code,omitempty" bson:"code,omitempty"`                               // Specific type of detected issue, e.g. drug-drug, duplicate therapy, etc
-	Severity           *CodeableConcept          `json:"severity,omitempty" bson:"severity,omitempty"`                       // high | moderate | low
-	Subject            *Reference                `json:"subject,omitempty" bson:"subject,omitempty"`                         // Associated subject
-	Encounter          *Reference                `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Encounter the detected issue is part of
-	IdentifiedDateTime *string                   `json:"identifiedDateTime,omitempty" bson:"identified_date_time,omitempty"` // When detected issue occurred/is occurring
-	IdentifiedPeriod   *Period                   `json:"identifiedPeriod,omitempty" bson:"identified_period,omitempty"`      // When detected issue occurred/is occurring
-	IdentifiedTiming   *Timing                   `json:"identifiedTiming,omitempty" bson:"identified_timing,omitempty"`      // When detected issue occurred/is occurring
-	Author             *Reference                `json:"author,omitempty" bson:"author,omitempty"`                           // The provider or device that identified the issue
-	Implicated         []Reference               `json:"implicated,omitempty" bson:"implicated,omitempty"`                   // Problem resource
-	Evidence           []DetectedIssueEvidence   `json:"evidence,omitempty" bson:"evidence,omitempty"`                       // Supporting evidence
-	Detail             *string                   `json:"detail,omitempty" bson:"detail,omitempty"`                           // Description and context
-	Reference          *string                   `json:"reference,omitempty" bson:"reference,omitempty"`                     // Authority for issue
-	QualityOfEvidence  *CodeableConcept          `json:"qualityOfEvidence,omitempty" bson:"quality_of_evidence,omitempty"`   // The quality of the evidence supporting the detected issue
-	ExpectedOnsetType  *CodeableConcept          `json:"expectedOnsetType,omitempty" bson:"expected_onset_type,omitempty"`   // Time frame of clinical effect
-	MedicationClass    []CodeableConcept         `json:"medicationClass,omitempty" bson:"medication_class,omitempty"`        // What medication class
-	ManagementCode     *CodeableConcept          `json:"managementCode,omitempty" bson:"management_code,omitempty"`          // Importance of taking action on the issue
-	Mitigation         []DetectedIssueMitigation `json:"mitigation,omitempty" bson:"mitigation,omitempty"`                   // Step taken to address
+	ResourceType         string                    `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                   `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                  `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                   `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                  `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier for detected issue
+	Status               DetectedIssueStatus       `json:"status" bson:"status"`                                             // preliminary | final | entered-in-error | unknown | mitigated | processing-error
+	StatusElement        *Element                  `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Category             []CodeableConcept         `json:"category,omitempty" bson:"category,omitempty"`                     // High level categorization of detected issue
+	Code                 *CodeableConcept          `json:"code,omitempty" bson:"code,omitempty"`                             // Specific type of detected issue, e.g. drug-drug, duplicate therapy, etc
+	Severity             *CodeableConcept          `json:"severity,omitempty" bson:"severity,omitempty"`                     // high | moderate | low
+	Subject              *Reference                `json:"subject,omitempty" bson:"subject,omitempty"`                       // Associated subject
+	Encounter            *Reference                `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter the detected issue is part of
+	Identified           DetectedIssueIdentified   `json:"-" bson:"identified,omitempty"`                                    // When detected issue occurred/is occurring
+	IdentifiedElement    *Element                  `json:"-" bson:"identified_element,omitempty"`                            // Extensions for identified[x]
+	Author               *Reference                `json:"author,omitempty" bson:"author,omitempty"`                         // The provider or device that identified the issue
+	Implicated           []Reference               `json:"implicated,omitempty" bson:"implicated,omitempty"`                 // Problem resource
+	Evidence             []DetectedIssueEvidence   `json:"evidence,omitempty" bson:"evidence,omitempty"`                     // Supporting evidence
+	Detail               *string                   `json:"detail,omitempty" bson:"detail,omitempty"`                         // Description and context
+	DetailElement        *Element                  `json:"_detail,omitempty" bson:"detail_element,omitempty"`                // Extensions for detail
+	Reference            *string                   `json:"reference,omitempty" bson:"reference,omitempty"`                   // Authority for issue
+	ReferenceElement     *Element                  `json:"_reference,omitempty" bson:"reference_element,omitempty"`          // Extensions for reference
+	QualityOfEvidence    *CodeableConcept          `json:"qualityOfEvidence,omitempty" bson:"quality_of_evidence,omitempty"` // The quality of the evidence supporting the detected issue
+	ExpectedOnsetType    *CodeableConcept          `json:"expectedOnsetType,omitempty" bson:"expected_onset_type,omitempty"` // Time frame of clinical effect
+	MedicationClass      []CodeableConcept         `json:"medicationClass,omitempty" bson:"medication_class,omitempty"`      // What medication class
+	ManagementCode       *CodeableConcept          `json:"managementCode,omitempty" bson:"management_code,omitempty"`        // Importance of taking action on the issue
+	Mitigation           []DetectedIssueMitigation `json:"mitigation,omitempty" bson:"mitigation,omitempty"`                 // Step taken to address
+
+	identifiedVariants []string // JSON properties of DetectedIssue.identified[x] when more than one was decoded
 }
 
+var detectedIssueSubjectTargets = []string{"BiologicallyDerivedProduct", "Device", "Group", "Location", "Medication", "NutritionProduct", "Organization", "Patient", "Practitioner", "Procedure", "Substance"}
+
+var detectedIssueAuthorTargets = []string{"Device", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *DetectedIssue) Validate() error {
 	if r.ResourceType != "DetectedIssue" {
 		return fmt.Errorf("invalid resourceType: expected 'DetectedIssue', got '%s'", r.ResourceType)
@@ -45,20 +58,55 @@ func (r *DetectedIssue) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -74,6 +122,11 @@ func (r *DetectedIssue) Validate() error {
 			return fmt.Errorf("Severity: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, detectedIssueSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, detectedIssueSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -84,14 +137,22 @@ func (r *DetectedIssue) Validate() error {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.IdentifiedPeriod != nil {
-		if err := r.IdentifiedPeriod.Validate(); err != nil {
-			return fmt.Errorf("IdentifiedPeriod: %w", err)
+	if len(r.identifiedVariants) > 1 {
+		return fmt.Errorf("field 'Identified' must have a single type, got %v", r.identifiedVariants)
+	}
+	if r.Identified != nil {
+		if err := r.Identified.Validate(); err != nil {
+			return fmt.Errorf("Identified: %w", err)
 		}
 	}
-	if r.IdentifiedTiming != nil {
-		if err := r.IdentifiedTiming.Validate(); err != nil {
-			return fmt.Errorf("IdentifiedTiming: %w", err)
+	if r.IdentifiedElement != nil {
+		if err := r.IdentifiedElement.Validate(); err != nil {
+			return fmt.Errorf("IdentifiedElement: %w", err)
+		}
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, detectedIssueAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author' cannot refer to %s, expected one of %v", target, detectedIssueAuthorTargets)
 		}
 	}
 	if r.Author != nil {
@@ -109,6 +170,16 @@ func (r *DetectedIssue) Validate() error {
 			return fmt.Errorf("Evidence[%d]: %w", i, err)
 		}
 	}
+	if r.DetailElement != nil {
+		if err := r.DetailElement.Validate(); err != nil {
+			return fmt.Errorf("DetailElement: %w", err)
+		}
+	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
 	if r.QualityOfEvidence != nil {
 		if err := r.QualityOfEvidence.Validate(); err != nil {
 			return fmt.Errorf("QualityOfEvidence: %w", err)
@@ -134,18 +205,472 @@ func (r *DetectedIssue) Validate() error {
 			return fmt.Errorf("Mitigation[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "DetectedIssue")
+}
+
+func (r *DetectedIssue) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DetectedIssue" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DetectedIssue', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Severity != nil {
+		r.Severity.validateAll(path+".severity", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, detectedIssueSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, detectedIssueSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.identifiedVariants) > 1 {
+		issues.add("structure", path+".identified", fmt.Sprintf("field 'Identified' must have a single type, got %v", r.identifiedVariants))
+	}
+	if r.Identified != nil {
+		r.Identified.validateAll(path+".identified.ofType("+r.Identified.FHIRType()+")", issues)
+	}
+	if r.IdentifiedElement != nil {
+		r.IdentifiedElement.validateAll(path+".identified", issues)
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, detectedIssueAuthorTargets); target != "" {
+			issues.add("value", path+".author", fmt.Sprintf("field 'Author' cannot refer to %s, expected one of %v", target, detectedIssueAuthorTargets))
+		}
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+	for i, item := range r.Implicated {
+		item.validateAll(fmt.Sprintf("%s.implicated[%d]", path, i), issues)
+	}
+	for i, item := range r.Evidence {
+		item.validateAll(fmt.Sprintf("%s.evidence[%d]", path, i), issues)
+	}
+	if r.DetailElement != nil {
+		r.DetailElement.validateAll(path+".detail", issues)
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+	if r.QualityOfEvidence != nil {
+		r.QualityOfEvidence.validateAll(path+".qualityOfEvidence", issues)
+	}
+	if r.ExpectedOnsetType != nil {
+		r.ExpectedOnsetType.validateAll(path+".expectedOnsetType", issues)
+	}
+	for i, item := range r.MedicationClass {
+		item.validateAll(fmt.Sprintf("%s.medicationClass[%d]", path, i), issues)
+	}
+	if r.ManagementCode != nil {
+		r.ManagementCode.validateAll(path+".managementCode", issues)
+	}
+	for i, item := range r.Mitigation {
+		item.validateAll(fmt.Sprintf("%s.mitigation[%d]", path, i), issues)
+	}
+}
+
+func (r *DetectedIssue) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DetectedIssue", &issues)
+	checkInvariants(r, "DetectedIssue", &issues)
+	return issues
+}
+
+var detectedIssueInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DetectedIssue) invariants() []invariant {
+	return detectedIssueInvariants
+}
+
+func (r DetectedIssue) MarshalJSON() ([]byte, error) {
+	type alias DetectedIssue
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "identified", r.Identified, r.IdentifiedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DetectedIssue) UnmarshalJSON(data []byte) error {
+	type alias DetectedIssue
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Identified, element, r.identifiedVariants, err = unmarshalChoice(fields, "identified", detectedIssueIdentifiedVariants); err != nil {
+		return err
+	}
+	r.IdentifiedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.IdentifiedElement); err != nil {
+			return fmt.Errorf("_identified: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r DetectedIssue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DetectedIssue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DetectedIssue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DetectedIssue) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DetectedIssue) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Identified":
+		return "identified", choiceValues(detectedIssueIdentifiedVariants)
+	}
+	return "", nil
+}
+
+var detectedIssueElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"detail":        "markdown",
+	"reference":     "uri",
+}
+
+func (r *DetectedIssue) elementTypes() map[string]string {
+	return detectedIssueElementTypes
+}
+
+var detectedIssueElementMetadata = []ElementMetadata{
+	{Path: "DetectedIssue.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DetectedIssue.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DetectedIssue.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DetectedIssue.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DetectedIssue.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DetectedIssue.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DetectedIssue.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DetectedIssue.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DetectedIssue.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DetectedIssue.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DetectedIssue.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DetectedIssue.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/detectedissue-status"}},
+	{Path: "DetectedIssue.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.code", Name: "code", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.severity", Name: "severity", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.subject", Name: "subject", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Substance"}},
+	{Path: "DetectedIssue.encounter", Name: "encounter", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "DetectedIssue.identified[x]", Name: "identified", Field: 19, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "DetectedIssue.author", Name: "author", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "DetectedIssue.implicated", Name: "implicated", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "DetectedIssue.evidence", Name: "evidence", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DetectedIssue.detail", Name: "detail", Field: 24, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "DetectedIssue.reference", Name: "reference", Field: 26, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "DetectedIssue.qualityOfEvidence", Name: "qualityOfEvidence", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.expectedOnsetType", Name: "expectedOnsetType", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.medicationClass", Name: "medicationClass", Field: 30, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.managementCode", Name: "managementCode", Field: 31, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.mitigation", Name: "mitigation", Field: 32, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *DetectedIssue) ElementMetadata() []ElementMetadata {
+	return detectedIssueElementMetadata
+}
+
+// DetectedIssueIdentified is the type of DetectedIssue.identified[x]. It is implemented by the DetectedIssueIdentified* variant types.
+type DetectedIssueIdentified interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDetectedIssueIdentified()
+}
+
+// DetectedIssueIdentifiedDateTime is the dateTime variant of DetectedIssueIdentified.
+type DetectedIssueIdentifiedDateTime struct {
+	DateTime
+}
+
+func (v DetectedIssueIdentifiedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DetectedIssueIdentifiedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v DetectedIssueIdentifiedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DetectedIssueIdentifiedDateTime) isDetectedIssueIdentified() {}
+
+// DetectedIssueIdentifiedPeriod is the Period variant of DetectedIssueIdentified.
+type DetectedIssueIdentifiedPeriod struct {
+	Period
+}
+
+func (v DetectedIssueIdentifiedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DetectedIssueIdentifiedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DetectedIssueIdentifiedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DetectedIssueIdentifiedPeriod) isDetectedIssueIdentified() {}
+
+// DetectedIssueIdentifiedTiming is the Timing variant of DetectedIssueIdentified.
+type DetectedIssueIdentifiedTiming struct {
+	Timing
+}
+
+func (v DetectedIssueIdentifiedTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v DetectedIssueIdentifiedTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v DetectedIssueIdentifiedTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v DetectedIssueIdentifiedTiming) isDetectedIssueIdentified() {}
+
+var detectedIssueIdentifiedVariants = []DetectedIssueIdentified{
+	DetectedIssueIdentifiedDateTime{},
+	DetectedIssueIdentifiedPeriod{},
+	DetectedIssueIdentifiedTiming{},
+}
+
+func (r *DetectedIssue) GetResourceType() string {
+	return "DetectedIssue"
+}
+
+func (r *DetectedIssue) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DetectedIssue) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DetectedIssue) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DetectedIssue) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DetectedIssue) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DetectedIssue) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DetectedIssue) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DetectedIssue)(nil)
+
+type DetectedIssueEvidence struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              []CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // Manifestation
+	Detail            []Reference       `json:"detail,omitempty" bson:"detail,omitempty"`                        // Supporting information
+}
+
+func (r *DetectedIssueEvidence) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Code {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Code[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Detail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *DetectedIssueEvidence) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var detectedIssueEvidenceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DetectedIssueEvidence) invariants() []invariant {
+	return detectedIssueEvidenceInvariants
+}
+
+func (r DetectedIssueEvidence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DetectedIssueEvidence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DetectedIssueEvidence) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DetectedIssueEvidence) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var detectedIssueEvidenceElementMetadata = []ElementMetadata{
+	{Path: "DetectedIssue.evidence.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DetectedIssue.evidence.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DetectedIssue.evidence.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DetectedIssue.evidence.code", Name: "code", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.evidence.detail", Name: "detail", Field: 4, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *DetectedIssueEvidence) ElementMetadata() []ElementMetadata {
+	return detectedIssueEvidenceElementMetadata
+}
+
 type DetectedIssueMitigation struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Action *CodeableConcept `json:"action" bson:"action"`                     // What mitigation?
-	Date   *string          `json:"date,omitempty" bson:"date,omitempty"`     // Date committed
-	Author *Reference       `json:"author,omitempty" bson:"author,omitempty"` // Who is committing?
-	Note   []Annotation     `json:"note,omitempty" bson:"note,omitempty"`     // Additional notes about the mitigation
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Action            *CodeableConcept `json:"action" bson:"action"`                                            // What mitigation?
+	Date              *DateTime        `json:"date,omitempty" bson:"date,omitempty"`                            // Date committed
+	DateElement       *Element         `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Author            *Reference       `json:"author,omitempty" bson:"author,omitempty"`                        // Who is committing?
+	Note              []Annotation     `json:"note,omitempty" bson:"note,omitempty"`                            // Additional notes about the mitigation
 }
 
 func (r *DetectedIssueMitigation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Action == nil {
 		return fmt.Errorf("field 'Action' is required")
 	}
@@ -154,6 +679,16 @@ func (r *DetectedIssueMitigation) Validate() error {
 			return fmt.Errorf("Action: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	if r.Author != nil {
 		if err := r.Author.Validate(); err != nil {
 			return fmt.Errorf("Author: %w", err)
@@ -167,22 +702,69 @@ func (r *DetectedIssueMitigation) Validate() error {
 	return nil
 }
 
-type DetectedIssueEvidence struct {
-	Id     *string           `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Code   []CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`     // Manifestation
-	Detail []Reference       `json:"detail,omitempty" bson:"detail,omitempty"` // Supporting information
-}
-
-func (r *DetectedIssueEvidence) Validate() error {
-	for i, item := range r.Code {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Code[%d]: %w", i, err)
-		}
+func (r *DetectedIssueMitigation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Action == nil {
+		issues.add("required", path+".action", "field 'Action' is required")
+	}
+	if r.Action != nil {
+		r.Action.validateAll(path+".action", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	return nil
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+var detectedIssueMitigationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DetectedIssueMitigation) invariants() []invariant {
+	return detectedIssueMitigationInvariants
+}
+
+func (r DetectedIssueMitigation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DetectedIssueMitigation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DetectedIssueMitigation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DetectedIssueMitigation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var detectedIssueMitigationElementMetadata = []ElementMetadata{
+	{Path: "DetectedIssue.mitigation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DetectedIssue.mitigation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DetectedIssue.mitigation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DetectedIssue.mitigation.action", Name: "action", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DetectedIssue.mitigation.date", Name: "date", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "DetectedIssue.mitigation.author", Name: "author", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "DetectedIssue.mitigation.note", Name: "note", Field: 7, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *DetectedIssueMitigation) ElementMetadata() []ElementMetadata {
+	return detectedIssueMitigationElementMetadata
 }
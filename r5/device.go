@@ -2,45 +2,63 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource describes the properties (regulated, has real time clock, etc.), administrative (manufacturer name, model number, serial number, firmware, etc.), and type (knee replacement, blood pressure cuff, MRI, etc.) of a physical unit (these values do not change much within a given module, for example the serial number, manufacturer name, and model number). An actual unit may consist of several modules in a distinct hierarchy and these are represented by multiple Device resources and bound through the 'parent' element.
 type Device struct {
-	ResourceType          string                `json:"resourceType" bson:"resource_type"`                                        // Type of resource
-	Id                    *string               `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
-	Meta                  *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
-	ImplicitRules         *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
-	Language              *string               `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
-	Text                  *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
-	Identifier            []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Instance identifier
-	Definition            *string               `json:"definition,omitempty" bson:"definition,omitempty"`                         // The reference to the definition for the device
-	UdiCarrier            []DeviceUdiCarrier    `json:"udiCarrier,omitempty" bson:"udi_carrier,omitempty"`                        // Unique Device Identifier (UDI) value
-	Status                *string               `json:"status,omitempty" bson:"status,omitempty"`                                 // active | inactive | entered-in-error | unknown
-	AvailabilityStatus    *CodeableConcept      `json:"availabilityStatus,omitempty" bson:"availability_status,omitempty"`        // lost | damaged | destroyed | available
-	BiologicalSourceEvent *Identifier           `json:"biologicalSourceEvent,omitempty" bson:"biological_source_event,omitempty"` // A production identifier of the donation, collection, or pooling event from which biological material in this device was derived
-	Manufacturer          *string               `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                     // Name of device manufacturer
-	ManufactureDate       *string               `json:"manufactureDate,omitempty" bson:"manufacture_date,omitempty"`              // A production identifier that indicates the date when the device was made
-	ExpirationDate        *string               `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`                // A production identifier that indicates the date and time of expiry of this device (if applicable)
-	LotNumber             *string               `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`                          // A production identifier that indicates the Lot number of manufacture
-	SerialNumber          *string               `json:"serialNumber,omitempty" bson:"serial_number,omitempty"`                    // A production identifier that indicates the Serial number assigned by the manufacturer
-	Name                  []DeviceName          `json:"name,omitempty" bson:"name,omitempty"`                                     // The name or names of the device as known to the manufacturer and/or patient
-	ModelNumber           *string               `json:"modelNumber,omitempty" bson:"model_number,omitempty"`                      // The manufacturer's model number for the device
-	PartNumber            *string               `json:"partNumber,omitempty" bson:"part_number,omitempty"`                        // The part number or catalog number of the device
-	Category              []CodeableConcept     `json:"category,omitempty" bson:"category,omitempty"`                             // Indicates a high-level grouping of the device
-	Type                  []CodeableConcept     `json:"type,omitempty" bson:"type,omitempty"`                                     // The kind or type of device
-	DeviceVersion         []DeviceDeviceVersion `json:"deviceVersion,omitempty" bson:"device_version,omitempty"`                  // The actual design of the device or software version running on the device
-	ConformsTo            []DeviceConformsTo    `json:"conformsTo,omitempty" bson:"conforms_to,omitempty"`                        // Identifies the standards, specifications, or formal guidances for the capabilities supported by the device
-	Property              []DeviceProperty      `json:"property,omitempty" bson:"property,omitempty"`                             // Inherent, essentially fixed, characteristics of the device.  e.g., time properties, size, material, etc.
-	Additive              []DeviceAdditive      `json:"additive,omitempty" bson:"additive,omitempty"`                             // Material added to a container device
-	Contact               []ContactPoint        `json:"contact,omitempty" bson:"contact,omitempty"`                               // Details for human/organization for support
-	Location              *Reference            `json:"location,omitempty" bson:"location,omitempty"`                             // Where the device is found
-	Note                  []Annotation          `json:"note,omitempty" bson:"note,omitempty"`                                     // Device notes and comments
-	Safety                []CodeableConcept     `json:"safety,omitempty" bson:"safety,omitempty"`                                 // Safety Characteristics of Device
-	Parent                *Reference            `json:"parent,omitempty" bson:"parent,omitempty"`                                 // The higher level or encompassing device that this device is a logical part of
+	ResourceType           string                  `json:"resourceType" bson:"resource_type"`                                        // Type of resource
+	Id                     *string                 `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
+	Meta                   *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
+	ImplicitRules          *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement   *Element                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
+	Language               *string                 `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement        *Element                `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
+	Text                   *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
+	Contained              []Resource              `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension              []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension      []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
+	Identifier             []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Instance identifier
+	Definition             *string                 `json:"definition,omitempty" bson:"definition,omitempty"`                         // The reference to the definition for the device
+	DefinitionElement      *Element                `json:"_definition,omitempty" bson:"definition_element,omitempty"`                // Extensions for definition
+	UdiCarrier             []DeviceUdiCarrier      `json:"udiCarrier,omitempty" bson:"udi_carrier,omitempty"`                        // Unique Device Identifier (UDI) value
+	Status                 *DeviceAssociationCodes `json:"status,omitempty" bson:"status,omitempty"`                                 // active | inactive | entered-in-error | unknown
+	StatusElement          *Element                `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
+	AvailabilityStatus     *CodeableConcept        `json:"availabilityStatus,omitempty" bson:"availability_status,omitempty"`        // lost | damaged | destroyed | available
+	BiologicalSourceEvent  *Identifier             `json:"biologicalSourceEvent,omitempty" bson:"biological_source_event,omitempty"` // A production identifier of the donation, collection, or pooling event from which biological material in this device was derived
+	Manufacturer           *string                 `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                     // Name of device manufacturer
+	ManufacturerElement    *Element                `json:"_manufacturer,omitempty" bson:"manufacturer_element,omitempty"`            // Extensions for manufacturer
+	ManufactureDate        *DateTime               `json:"manufactureDate,omitempty" bson:"manufacture_date,omitempty"`              // A production identifier that indicates the date when the device was made
+	ManufactureDateElement *Element                `json:"_manufactureDate,omitempty" bson:"manufacture_date_element,omitempty"`     // Extensions for manufactureDate
+	ExpirationDate         *DateTime               `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`                // A production identifier that indicates the date and time of expiry of this device (if applicable)
+	ExpirationDateElement  *Element                `json:"_expirationDate,omitempty" bson:"expiration_date_element,omitempty"`       // Extensions for expirationDate
+	LotNumber              *string                 `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`                          // A production identifier that indicates the Lot number of manufacture
+	LotNumberElement       *Element                `json:"_lotNumber,omitempty" bson:"lot_number_element,omitempty"`                 // Extensions for lotNumber
+	SerialNumber           *string                 `json:"serialNumber,omitempty" bson:"serial_number,omitempty"`                    // A production identifier that indicates the Serial number assigned by the manufacturer
+	SerialNumberElement    *Element                `json:"_serialNumber,omitempty" bson:"serial_number_element,omitempty"`           // Extensions for serialNumber
+	Name                   []DeviceName            `json:"name,omitempty" bson:"name,omitempty"`                                     // The name or names of the device as known to the manufacturer and/or patient
+	ModelNumber            *string                 `json:"modelNumber,omitempty" bson:"model_number,omitempty"`                      // The manufacturer's model number for the device
+	ModelNumberElement     *Element                `json:"_modelNumber,omitempty" bson:"model_number_element,omitempty"`             // Extensions for modelNumber
+	PartNumber             *string                 `json:"partNumber,omitempty" bson:"part_number,omitempty"`                        // The part number or catalog number of the device
+	PartNumberElement      *Element                `json:"_partNumber,omitempty" bson:"part_number_element,omitempty"`               // Extensions for partNumber
+	Category               []CodeableConcept       `json:"category,omitempty" bson:"category,omitempty"`                             // Indicates a high-level grouping of the device
+	Type                   []CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                                     // The kind or type of device
+	DeviceVersion          []DeviceDeviceVersion   `json:"deviceVersion,omitempty" bson:"device_version,omitempty"`                  // The actual design of the device or software version running on the device
+	ConformsTo             []DeviceConformsTo      `json:"conformsTo,omitempty" bson:"conforms_to,omitempty"`                        // Identifies the standards, specifications, or formal guidances for the capabilities supported by the device
+	Property               []DeviceProperty        `json:"property,omitempty" bson:"property,omitempty"`                             // Inherent, essentially fixed, characteristics of the device.  e.g., time properties, size, material, etc.
+	Additive               []DeviceAdditive        `json:"additive,omitempty" bson:"additive,omitempty"`                             // Material added to a container device
+	Contact                []ContactPoint          `json:"contact,omitempty" bson:"contact,omitempty"`                               // Details for human/organization for support
+	Location               *Reference              `json:"location,omitempty" bson:"location,omitempty"`                             // Where the device is found
+	Note                   []Annotation            `json:"note,omitempty" bson:"note,omitempty"`                                     // Device notes and comments
+	Safety                 []CodeableConcept       `json:"safety,omitempty" bson:"safety,omitempty"`                                 // Safety Characteristics of Device
+	Parent                 *Reference              `json:"parent,omitempty" bson:"parent,omitempty"`                                 // The higher level or encompassing device that this device is a logical part of
 }
 
+var deviceLocationTargets = []string{"Location"}
+
+var deviceParentTargets = []string{"Device"}
+
 func (r *Device) Validate() error {
 	if r.ResourceType != "Device" {
 		return fmt.Errorf("invalid resourceType: expected 'Device', got '%s'", r.ResourceType)
@@ -50,21 +68,62 @@ func (r *Device) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
 	for i, item := range r.UdiCarrier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UdiCarrier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.AvailabilityStatus != nil {
 		if err := r.AvailabilityStatus.Validate(); err != nil {
 			return fmt.Errorf("AvailabilityStatus: %w", err)
@@ -75,11 +134,56 @@ func (r *Device) Validate() error {
 			return fmt.Errorf("BiologicalSourceEvent: %w", err)
 		}
 	}
+	if r.ManufacturerElement != nil {
+		if err := r.ManufacturerElement.Validate(); err != nil {
+			return fmt.Errorf("ManufacturerElement: %w", err)
+		}
+	}
+	if r.ManufactureDate != nil {
+		if err := r.ManufactureDate.Validate(); err != nil {
+			return fmt.Errorf("ManufactureDate: %w", err)
+		}
+	}
+	if r.ManufactureDateElement != nil {
+		if err := r.ManufactureDateElement.Validate(); err != nil {
+			return fmt.Errorf("ManufactureDateElement: %w", err)
+		}
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDate: %w", err)
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		if err := r.ExpirationDateElement.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDateElement: %w", err)
+		}
+	}
+	if r.LotNumberElement != nil {
+		if err := r.LotNumberElement.Validate(); err != nil {
+			return fmt.Errorf("LotNumberElement: %w", err)
+		}
+	}
+	if r.SerialNumberElement != nil {
+		if err := r.SerialNumberElement.Validate(); err != nil {
+			return fmt.Errorf("SerialNumberElement: %w", err)
+		}
+	}
 	for i, item := range r.Name {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Name[%d]: %w", i, err)
 		}
 	}
+	if r.ModelNumberElement != nil {
+		if err := r.ModelNumberElement.Validate(); err != nil {
+			return fmt.Errorf("ModelNumberElement: %w", err)
+		}
+	}
+	if r.PartNumberElement != nil {
+		if err := r.PartNumberElement.Validate(); err != nil {
+			return fmt.Errorf("PartNumberElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -115,6 +219,11 @@ func (r *Device) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, deviceLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, deviceLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
@@ -130,48 +239,496 @@ func (r *Device) Validate() error {
 			return fmt.Errorf("Safety[%d]: %w", i, err)
 		}
 	}
+	if r.Parent != nil {
+		if target := invalidReferenceTarget(r.Parent.Reference, r.Parent.Type, deviceParentTargets); target != "" {
+			return fmt.Errorf("field 'Parent' cannot refer to %s, expected one of %v", target, deviceParentTargets)
+		}
+	}
 	if r.Parent != nil {
 		if err := r.Parent.Validate(); err != nil {
 			return fmt.Errorf("Parent: %w", err)
 		}
 	}
+	return validateInvariants(r, "Device")
+}
+
+func (r *Device) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Device" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Device', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	for i, item := range r.UdiCarrier {
+		item.validateAll(fmt.Sprintf("%s.udiCarrier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.AvailabilityStatus != nil {
+		r.AvailabilityStatus.validateAll(path+".availabilityStatus", issues)
+	}
+	if r.BiologicalSourceEvent != nil {
+		r.BiologicalSourceEvent.validateAll(path+".biologicalSourceEvent", issues)
+	}
+	if r.ManufacturerElement != nil {
+		r.ManufacturerElement.validateAll(path+".manufacturer", issues)
+	}
+	if r.ManufactureDate != nil {
+		if err := r.ManufactureDate.Validate(); err != nil {
+			issues.add("value", path+".manufactureDate", err.Error())
+		}
+	}
+	if r.ManufactureDateElement != nil {
+		r.ManufactureDateElement.validateAll(path+".manufactureDate", issues)
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			issues.add("value", path+".expirationDate", err.Error())
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		r.ExpirationDateElement.validateAll(path+".expirationDate", issues)
+	}
+	if r.LotNumberElement != nil {
+		r.LotNumberElement.validateAll(path+".lotNumber", issues)
+	}
+	if r.SerialNumberElement != nil {
+		r.SerialNumberElement.validateAll(path+".serialNumber", issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	if r.ModelNumberElement != nil {
+		r.ModelNumberElement.validateAll(path+".modelNumber", issues)
+	}
+	if r.PartNumberElement != nil {
+		r.PartNumberElement.validateAll(path+".partNumber", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.DeviceVersion {
+		item.validateAll(fmt.Sprintf("%s.deviceVersion[%d]", path, i), issues)
+	}
+	for i, item := range r.ConformsTo {
+		item.validateAll(fmt.Sprintf("%s.conformsTo[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Additive {
+		item.validateAll(fmt.Sprintf("%s.additive[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, deviceLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, deviceLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Safety {
+		item.validateAll(fmt.Sprintf("%s.safety[%d]", path, i), issues)
+	}
+	if r.Parent != nil {
+		if target := invalidReferenceTarget(r.Parent.Reference, r.Parent.Type, deviceParentTargets); target != "" {
+			issues.add("value", path+".parent", fmt.Sprintf("field 'Parent' cannot refer to %s, expected one of %v", target, deviceParentTargets))
+		}
+	}
+	if r.Parent != nil {
+		r.Parent.validateAll(path+".parent", issues)
+	}
+}
+
+func (r *Device) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Device", &issues)
+	checkInvariants(r, "Device", &issues)
+	return issues
+}
+
+var deviceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Device) invariants() []invariant {
+	return deviceInvariants
+}
+
+func (r *Device) UnmarshalJSON(data []byte) error {
+	type alias Device
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Device) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Device) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Device) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Device) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"definition":    "canonical",
+}
+
+func (r *Device) elementTypes() map[string]string {
+	return deviceElementTypes
+}
+
+var deviceElementMetadata = []ElementMetadata{
+	{Path: "Device.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Device.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Device.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Device.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Device.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Device.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Device.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Device.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Device.definition", Name: "definition", Field: 12, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Device.udiCarrier", Name: "udiCarrier", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Device.status", Name: "status", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Device.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/deviceassociation-status"}},
+	{Path: "Device.availabilityStatus", Name: "availabilityStatus", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Device.biologicalSourceEvent", Name: "biologicalSourceEvent", Field: 18, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Device.manufacturer", Name: "manufacturer", Field: 19, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.manufactureDate", Name: "manufactureDate", Field: 21, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Device.expirationDate", Name: "expirationDate", Field: 23, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Device.lotNumber", Name: "lotNumber", Field: 25, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.serialNumber", Name: "serialNumber", Field: 27, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.name", Name: "name", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Device.modelNumber", Name: "modelNumber", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.partNumber", Name: "partNumber", Field: 32, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.category", Name: "category", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Device.type", Name: "type", Field: 35, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Device.deviceVersion", Name: "deviceVersion", Field: 36, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Device.conformsTo", Name: "conformsTo", Field: 37, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Device.property", Name: "property", Field: 38, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Device.additive", Name: "additive", Field: 39, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Device.contact", Name: "contact", Field: 40, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "Device.location", Name: "location", Field: 41, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Device.note", Name: "note", Field: 42, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Device.safety", Name: "safety", Field: 43, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Device.parent", Name: "parent", Field: 44, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+}
+
+func (r *Device) ElementMetadata() []ElementMetadata {
+	return deviceElementMetadata
+}
+
+func (r *Device) GetResourceType() string {
+	return "Device"
+}
+
+func (r *Device) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Device) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Device) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Device) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Device) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Device) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Device) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Device)(nil)
+
 type DeviceUdiCarrier struct {
-	Id                     *string `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
-	DeviceIdentifier       string  `json:"deviceIdentifier" bson:"device_identifier"`                                  // Mandatory fixed portion of UDI
-	DeviceIdentifierSystem *string `json:"deviceIdentifierSystem,omitempty" bson:"device_identifier_system,omitempty"` // The namespace for the device identifier value
-	Issuer                 string  `json:"issuer" bson:"issuer"`                                                       // UDI Issuing Organization
-	Jurisdiction           *string `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Regional UDI authority
-	CarrierAIDC            *string `json:"carrierAIDC,omitempty" bson:"carrier_a_i_d_c,omitempty"`                     // UDI Machine Readable value
-	CarrierHRF             *string `json:"carrierHRF,omitempty" bson:"carrier_h_r_f,omitempty"`                        // UDI Human Readable value
-	EntryType              *string `json:"entryType,omitempty" bson:"entry_type,omitempty"`                            // barcode | rfid | manual | card | self-reported | electronic-transmission | unknown
+	Id                            *string       `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
+	Extension                     []Extension   `json:"extension,omitempty" bson:"extension,omitempty"`                                      // Additional content defined by implementations
+	ModifierExtension             []Extension   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                     // Extensions that cannot be ignored even if unrecognized
+	DeviceIdentifier              string        `json:"deviceIdentifier" bson:"device_identifier"`                                           // Mandatory fixed portion of UDI
+	DeviceIdentifierElement       *Element      `json:"_deviceIdentifier,omitempty" bson:"device_identifier_element,omitempty"`              // Extensions for deviceIdentifier
+	DeviceIdentifierSystem        *string       `json:"deviceIdentifierSystem,omitempty" bson:"device_identifier_system,omitempty"`          // The namespace for the device identifier value
+	DeviceIdentifierSystemElement *Element      `json:"_deviceIdentifierSystem,omitempty" bson:"device_identifier_system_element,omitempty"` // Extensions for deviceIdentifierSystem
+	Issuer                        string        `json:"issuer" bson:"issuer"`                                                                // UDI Issuing Organization
+	IssuerElement                 *Element      `json:"_issuer,omitempty" bson:"issuer_element,omitempty"`                                   // Extensions for issuer
+	Jurisdiction                  *string       `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                // Regional UDI authority
+	JurisdictionElement           *Element      `json:"_jurisdiction,omitempty" bson:"jurisdiction_element,omitempty"`                       // Extensions for jurisdiction
+	CarrierAIDC                   *string       `json:"carrierAIDC,omitempty" bson:"carrier_a_i_d_c,omitempty"`                              // UDI Machine Readable value
+	CarrierAIDCElement            *Element      `json:"_carrierAIDC,omitempty" bson:"carrier_a_i_d_c_element,omitempty"`                     // Extensions for carrierAIDC
+	CarrierHRF                    *string       `json:"carrierHRF,omitempty" bson:"carrier_h_r_f,omitempty"`                                 // UDI Human Readable value
+	CarrierHRFElement             *Element      `json:"_carrierHRF,omitempty" bson:"carrier_h_r_f_element,omitempty"`                        // Extensions for carrierHRF
+	EntryType                     *UDIEntryType `json:"entryType,omitempty" bson:"entry_type,omitempty"`                                     // barcode | rfid | manual | card | self-reported | electronic-transmission | unknown
+	EntryTypeElement              *Element      `json:"_entryType,omitempty" bson:"entry_type_element,omitempty"`                            // Extensions for entryType
 }
 
 func (r *DeviceUdiCarrier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.DeviceIdentifier == emptyString {
 		return fmt.Errorf("field 'DeviceIdentifier' is required")
 	}
+	if r.DeviceIdentifierElement != nil {
+		if err := r.DeviceIdentifierElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceIdentifierElement: %w", err)
+		}
+	}
+	if r.DeviceIdentifierSystemElement != nil {
+		if err := r.DeviceIdentifierSystemElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceIdentifierSystemElement: %w", err)
+		}
+	}
 	if r.Issuer == emptyString {
 		return fmt.Errorf("field 'Issuer' is required")
 	}
+	if r.IssuerElement != nil {
+		if err := r.IssuerElement.Validate(); err != nil {
+			return fmt.Errorf("IssuerElement: %w", err)
+		}
+	}
+	if r.JurisdictionElement != nil {
+		if err := r.JurisdictionElement.Validate(); err != nil {
+			return fmt.Errorf("JurisdictionElement: %w", err)
+		}
+	}
+	if r.CarrierAIDCElement != nil {
+		if err := r.CarrierAIDCElement.Validate(); err != nil {
+			return fmt.Errorf("CarrierAIDCElement: %w", err)
+		}
+	}
+	if r.CarrierHRFElement != nil {
+		if err := r.CarrierHRFElement.Validate(); err != nil {
+			return fmt.Errorf("CarrierHRFElement: %w", err)
+		}
+	}
+	if r.EntryType != nil && !r.EntryType.IsValid() {
+		return fmt.Errorf("field 'EntryType' has invalid code '%s'", *r.EntryType)
+	}
+	if r.EntryTypeElement != nil {
+		if err := r.EntryTypeElement.Validate(); err != nil {
+			return fmt.Errorf("EntryTypeElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *DeviceUdiCarrier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.DeviceIdentifier == emptyString {
+		issues.add("required", path+".deviceIdentifier", "field 'DeviceIdentifier' is required")
+	}
+	if r.DeviceIdentifierElement != nil {
+		r.DeviceIdentifierElement.validateAll(path+".deviceIdentifier", issues)
+	}
+	if r.DeviceIdentifierSystemElement != nil {
+		r.DeviceIdentifierSystemElement.validateAll(path+".deviceIdentifierSystem", issues)
+	}
+	if r.Issuer == emptyString {
+		issues.add("required", path+".issuer", "field 'Issuer' is required")
+	}
+	if r.IssuerElement != nil {
+		r.IssuerElement.validateAll(path+".issuer", issues)
+	}
+	if r.JurisdictionElement != nil {
+		r.JurisdictionElement.validateAll(path+".jurisdiction", issues)
+	}
+	if r.CarrierAIDCElement != nil {
+		r.CarrierAIDCElement.validateAll(path+".carrierAIDC", issues)
+	}
+	if r.CarrierHRFElement != nil {
+		r.CarrierHRFElement.validateAll(path+".carrierHRF", issues)
+	}
+	if r.EntryType != nil && !r.EntryType.IsValid() {
+		issues.add("code-invalid", path+".entryType", fmt.Sprintf("field 'EntryType' has invalid code '%s'", *r.EntryType))
+	}
+	if r.EntryTypeElement != nil {
+		r.EntryTypeElement.validateAll(path+".entryType", issues)
+	}
+}
+
+var deviceUdiCarrierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceUdiCarrier) invariants() []invariant {
+	return deviceUdiCarrierInvariants
+}
+
+func (r DeviceUdiCarrier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceUdiCarrier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceUdiCarrier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceUdiCarrier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceUdiCarrierElementTypes = map[string]string{
+	"deviceIdentifierSystem": "uri",
+	"issuer":                 "uri",
+	"jurisdiction":           "uri",
+	"carrierAIDC":            "base64Binary",
+}
+
+func (r *DeviceUdiCarrier) elementTypes() map[string]string {
+	return deviceUdiCarrierElementTypes
+}
+
+var deviceUdiCarrierElementMetadata = []ElementMetadata{
+	{Path: "Device.udiCarrier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.udiCarrier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.udiCarrier.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.udiCarrier.deviceIdentifier", Name: "deviceIdentifier", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Device.udiCarrier.deviceIdentifierSystem", Name: "deviceIdentifierSystem", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Device.udiCarrier.issuer", Name: "issuer", Field: 7, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "Device.udiCarrier.jurisdiction", Name: "jurisdiction", Field: 9, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Device.udiCarrier.carrierAIDC", Name: "carrierAIDC", Field: 11, Min: 0, Max: "1", Types: []string{"base64Binary"}},
+	{Path: "Device.udiCarrier.carrierHRF", Name: "carrierHRF", Field: 13, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.udiCarrier.entryType", Name: "entryType", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Device.udiCarrier.entryType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/udi-entry-type"}},
+}
+
+func (r *DeviceUdiCarrier) ElementMetadata() []ElementMetadata {
+	return deviceUdiCarrierElementMetadata
+}
+
 type DeviceName struct {
-	Id      *string          `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Value   string           `json:"value" bson:"value"`                         // The term that names the device
-	Type    *CodeableConcept `json:"type" bson:"type"`                           // registered-name | user-friendly-name | patient-reported-name
-	Display *bool            `json:"display,omitempty" bson:"display,omitempty"` // The preferred device name
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             string           `json:"value" bson:"value"`                                              // The term that names the device
+	ValueElement      *Element         `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // registered-name | user-friendly-name | patient-reported-name
+	Display           *bool            `json:"display,omitempty" bson:"display,omitempty"`                      // The preferred device name
+	DisplayElement    *Element         `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
 }
 
 func (r *DeviceName) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Value == emptyString {
 		return fmt.Errorf("field 'Value' is required")
 	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -180,18 +737,99 @@ func (r *DeviceName) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *DeviceName) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+}
+
+var deviceNameInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceName) invariants() []invariant {
+	return deviceNameInvariants
+}
+
+func (r DeviceName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceName) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceNameElementMetadata = []ElementMetadata{
+	{Path: "Device.name.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.name.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.name.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.name.value", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Device.name.type", Name: "type", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Device.name.display", Name: "display", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *DeviceName) ElementMetadata() []ElementMetadata {
+	return deviceNameElementMetadata
+}
+
 type DeviceDeviceVersion struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Type        *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                // The type of the device version, e.g. manufacturer, approved, internal
-	Component   *Identifier      `json:"component,omitempty" bson:"component,omitempty"`      // The hardware or software module of the device to which the version applies
-	InstallDate *string          `json:"installDate,omitempty" bson:"install_date,omitempty"` // The date the version was installed on the device
-	Value       string           `json:"value" bson:"value"`                                  // The version text
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type               *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // The type of the device version, e.g. manufacturer, approved, internal
+	Component          *Identifier      `json:"component,omitempty" bson:"component,omitempty"`                  // The hardware or software module of the device to which the version applies
+	InstallDate        *DateTime        `json:"installDate,omitempty" bson:"install_date,omitempty"`             // The date the version was installed on the device
+	InstallDateElement *Element         `json:"_installDate,omitempty" bson:"install_date_element,omitempty"`    // Extensions for installDate
+	Value              string           `json:"value" bson:"value"`                                              // The version text
+	ValueElement       *Element         `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
 }
 
 func (r *DeviceDeviceVersion) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -202,21 +840,117 @@ func (r *DeviceDeviceVersion) Validate() error {
 			return fmt.Errorf("Component: %w", err)
 		}
 	}
+	if r.InstallDate != nil {
+		if err := r.InstallDate.Validate(); err != nil {
+			return fmt.Errorf("InstallDate: %w", err)
+		}
+	}
+	if r.InstallDateElement != nil {
+		if err := r.InstallDateElement.Validate(); err != nil {
+			return fmt.Errorf("InstallDateElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.Value == emptyString {
 		return fmt.Errorf("field 'Value' is required")
 	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *DeviceDeviceVersion) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Component != nil {
+		r.Component.validateAll(path+".component", issues)
+	}
+	if r.InstallDate != nil {
+		if err := r.InstallDate.Validate(); err != nil {
+			issues.add("value", path+".installDate", err.Error())
+		}
+	}
+	if r.InstallDateElement != nil {
+		r.InstallDateElement.validateAll(path+".installDate", issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var deviceDeviceVersionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDeviceVersion) invariants() []invariant {
+	return deviceDeviceVersionInvariants
+}
+
+func (r DeviceDeviceVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDeviceVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDeviceVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDeviceVersion) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDeviceVersionElementMetadata = []ElementMetadata{
+	{Path: "Device.deviceVersion.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.deviceVersion.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.deviceVersion.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.deviceVersion.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Device.deviceVersion.component", Name: "component", Field: 4, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Device.deviceVersion.installDate", Name: "installDate", Field: 5, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Device.deviceVersion.value", Name: "value", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *DeviceDeviceVersion) ElementMetadata() []ElementMetadata {
+	return deviceDeviceVersionElementMetadata
+}
+
 type DeviceConformsTo struct {
-	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Category      *CodeableConcept `json:"category,omitempty" bson:"category,omitempty"` // Describes the common type of the standard, specification, or formal guidance.  communication | performance | measurement
-	Specification *CodeableConcept `json:"specification" bson:"specification"`           // Identifies the standard, specification, or formal guidance that the device adheres to
-	Version       *string          `json:"version,omitempty" bson:"version,omitempty"`   // Specific form or variant of the standard
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category          *CodeableConcept `json:"category,omitempty" bson:"category,omitempty"`                    // Describes the common type of the standard, specification, or formal guidance.  communication | performance | measurement
+	Specification     *CodeableConcept `json:"specification" bson:"specification"`                              // Identifies the standard, specification, or formal guidance that the device adheres to
+	Version           *string          `json:"version,omitempty" bson:"version,omitempty"`                      // Specific form or variant of the standard
+	VersionElement    *Element         `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
 }
 
 func (r *DeviceConformsTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Category != nil {
 		if err := r.Category.Validate(); err != nil {
 			return fmt.Errorf("Category: %w", err)
@@ -230,22 +964,94 @@ func (r *DeviceConformsTo) Validate() error {
 			return fmt.Errorf("Specification: %w", err)
 		}
 	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *DeviceConformsTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Specification == nil {
+		issues.add("required", path+".specification", "field 'Specification' is required")
+	}
+	if r.Specification != nil {
+		r.Specification.validateAll(path+".specification", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+}
+
+var deviceConformsToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceConformsTo) invariants() []invariant {
+	return deviceConformsToInvariants
+}
+
+func (r DeviceConformsTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceConformsTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceConformsTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceConformsTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceConformsToElementMetadata = []ElementMetadata{
+	{Path: "Device.conformsTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.conformsTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.conformsTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.conformsTo.category", Name: "category", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Device.conformsTo.specification", Name: "specification", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Device.conformsTo.version", Name: "version", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *DeviceConformsTo) ElementMetadata() []ElementMetadata {
+	return deviceConformsToElementMetadata
+}
+
 type DeviceProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                   // Code that specifies the property being represented
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // Value of the property
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // Value of the property
-	ValueString          *string          `json:"valueString" bson:"value_string"`                    // Value of the property
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // Value of the property
-	ValueInteger         *int             `json:"valueInteger" bson:"value_integer"`                  // Value of the property
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // Value of the property
-	ValueAttachment      *Attachment      `json:"valueAttachment" bson:"value_attachment"`            // Value of the property
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept    `json:"type" bson:"type"`                                                // Code that specifies the property being represented
+	Value             DevicePropertyValue `json:"-" bson:"value,omitempty"`                                        // Value of the property
+	ValueElement      *Element            `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Device.property.value[x] when more than one was decoded
 }
 
 func (r *DeviceProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -254,57 +1060,295 @@ func (r *DeviceProperty) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	return nil
+}
+
+func (r *DeviceProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var devicePropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceProperty) invariants() []invariant {
+	return devicePropertyInvariants
+}
+
+func (r DeviceProperty) MarshalJSON() ([]byte, error) {
+	type alias DeviceProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceProperty) UnmarshalJSON(data []byte) error {
+	type alias DeviceProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", devicePropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r DeviceProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(devicePropertyValueVariants)
+	}
+	return "", nil
+}
+
+var devicePropertyElementMetadata = []ElementMetadata{
+	{Path: "Device.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Device.property.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Attachment"}},
+}
+
+func (r *DeviceProperty) ElementMetadata() []ElementMetadata {
+	return devicePropertyElementMetadata
+}
+
+// DevicePropertyValue is the type of Device.property.value[x]. It is implemented by the DevicePropertyValue* variant types.
+type DevicePropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDevicePropertyValue()
+}
+
+// DevicePropertyValueQuantity is the Quantity variant of DevicePropertyValue.
+type DevicePropertyValueQuantity struct {
+	Quantity
+}
+
+func (v DevicePropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DevicePropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DevicePropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DevicePropertyValueQuantity) isDevicePropertyValue() {}
+
+// DevicePropertyValueCodeableConcept is the CodeableConcept variant of DevicePropertyValue.
+type DevicePropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DevicePropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DevicePropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DevicePropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DevicePropertyValueCodeableConcept) isDevicePropertyValue() {}
+
+// DevicePropertyValueString is the string variant of DevicePropertyValue.
+type DevicePropertyValueString string
+
+func (v DevicePropertyValueString) Validate() error {
+	return nil
+}
+
+func (v DevicePropertyValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DevicePropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v DevicePropertyValueString) isDevicePropertyValue() {}
+
+// DevicePropertyValueBoolean is the boolean variant of DevicePropertyValue.
+type DevicePropertyValueBoolean bool
+
+func (v DevicePropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v DevicePropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DevicePropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v DevicePropertyValueBoolean) isDevicePropertyValue() {}
+
+// DevicePropertyValueInteger is the integer variant of DevicePropertyValue.
+type DevicePropertyValueInteger int
+
+func (v DevicePropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v DevicePropertyValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DevicePropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v DevicePropertyValueInteger) isDevicePropertyValue() {}
+
+// DevicePropertyValueRange is the Range variant of DevicePropertyValue.
+type DevicePropertyValueRange struct {
+	Range
+}
+
+func (v DevicePropertyValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v DevicePropertyValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v DevicePropertyValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v DevicePropertyValueRange) isDevicePropertyValue() {}
+
+// DevicePropertyValueAttachment is the Attachment variant of DevicePropertyValue.
+type DevicePropertyValueAttachment struct {
+	Attachment
+}
+
+func (v DevicePropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v DevicePropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v DevicePropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v DevicePropertyValueAttachment) isDevicePropertyValue() {}
+
+var devicePropertyValueVariants = []DevicePropertyValue{
+	DevicePropertyValueQuantity{},
+	DevicePropertyValueCodeableConcept{},
+	DevicePropertyValueString(""),
+	DevicePropertyValueBoolean(false),
+	DevicePropertyValueInteger(0),
+	DevicePropertyValueRange{},
+	DevicePropertyValueAttachment{},
+}
+
 type DeviceAdditive struct {
-	Id       *string            `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Type     *CodeableReference `json:"type" bson:"type"`                             // The additive substance
-	Quantity *Quantity          `json:"quantity,omitempty" bson:"quantity,omitempty"` // Quantity of additive substance within container
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableReference `json:"type" bson:"type"`                                                // The additive substance
+	Quantity          *Quantity          `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // Quantity of additive substance within container
 }
 
 func (r *DeviceAdditive) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -320,3 +1364,57 @@ func (r *DeviceAdditive) Validate() error {
 	}
 	return nil
 }
+
+func (r *DeviceAdditive) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+}
+
+var deviceAdditiveInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceAdditive) invariants() []invariant {
+	return deviceAdditiveInvariants
+}
+
+func (r DeviceAdditive) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceAdditive) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceAdditive) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceAdditive) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceAdditiveElementMetadata = []ElementMetadata{
+	{Path: "Device.additive.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Device.additive.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Device.additive.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Device.additive.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Device.additive.quantity", Name: "quantity", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *DeviceAdditive) ElementMetadata() []ElementMetadata {
+	return deviceAdditiveElementMetadata
+}
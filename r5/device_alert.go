@@ -2,39 +2,62 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Describes a physiological or technical alert condition report originated by a device.  The DeviceAlert resource is derived from the ISO/IEEE 11073-10201 Domain Information Model standard, but is more widely applicable.
 type DeviceAlert struct {
-	ResourceType       string                   `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                  `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                  `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage        `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier         []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Business identifier for this device alert
-	Procedure          []Reference              `json:"procedure,omitempty" bson:"procedure,omitempty"`                     // Procedure during which the alert occurred
-	Status             string                   `json:"status" bson:"status"`                                               // in-progress | completed | entered-in-error | unknown
-	Category           []CodeableConcept        `json:"category,omitempty" bson:"category,omitempty"`                       // High level categorization of device alert
-	Type               *CodeableConcept         `json:"type,omitempty" bson:"type,omitempty"`                               // physiological | technical
-	Priority           *CodeableConcept         `json:"priority,omitempty" bson:"priority,omitempty"`                       // high | medium | low | info
-	Code               *CodeableConcept         `json:"code" bson:"code"`                                                   // The meaning of the alert
-	Subject            *Reference               `json:"subject" bson:"subject"`                                             // Who or what the alert is about
-	Encounter          *Reference               `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Encounter during which the alert condition occurred
-	Presence           bool                     `json:"presence" bson:"presence"`                                           // Whether the alert condition is currently active
-	OccurrenceDateTime *string                  `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // When the alert condition occurred/is occurring
-	OccurrencePeriod   *Period                  `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // When the alert condition occurred/is occurring
-	Device             *Reference               `json:"device,omitempty" bson:"device,omitempty"`                           // The Device (or DeviceMetric) that detected the alert condition
-	Acknowledged       *bool                    `json:"acknowledged,omitempty" bson:"acknowledged,omitempty"`               // Whether the alert condition has been acknowledged
-	AcknowledgedBy     *Reference               `json:"acknowledgedBy,omitempty" bson:"acknowledged_by,omitempty"`          // Who acknowledged the alert condition
-	Location           *Reference               `json:"location,omitempty" bson:"location,omitempty"`                       // Location of the subject when the alert was raised
-	DerivedFrom        []DeviceAlertDerivedFrom `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                // The value causing the alert condition
-	Label              *string                  `json:"label,omitempty" bson:"label,omitempty"`                             // Text to be displayed for the alert condition
-	Signal             []DeviceAlertSignal      `json:"signal,omitempty" bson:"signal,omitempty"`                           // Annunciation or notification of the alert condition
+	ResourceType         string                   `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                  `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                 `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                  `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                 `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource               `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier for this device alert
+	Procedure            []Reference              `json:"procedure,omitempty" bson:"procedure,omitempty"`                   // Procedure during which the alert occurred
+	Status               DeviceAlertStatusCodes   `json:"status" bson:"status"`                                             // in-progress | completed | entered-in-error | unknown
+	StatusElement        *Element                 `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Category             []CodeableConcept        `json:"category,omitempty" bson:"category,omitempty"`                     // High level categorization of device alert
+	Type                 *CodeableConcept         `json:"type,omitempty" bson:"type,omitempty"`                             // physiological | technical
+	Priority             *CodeableConcept         `json:"priority,omitempty" bson:"priority,omitempty"`                     // high | medium | low | info
+	Code                 *CodeableConcept         `json:"code" bson:"code"`                                                 // The meaning of the alert
+	Subject              *Reference               `json:"subject" bson:"subject"`                                           // Who or what the alert is about
+	Encounter            *Reference               `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter during which the alert condition occurred
+	Presence             bool                     `json:"presence" bson:"presence"`                                         // Whether the alert condition is currently active
+	PresenceElement      *Element                 `json:"_presence,omitempty" bson:"presence_element,omitempty"`            // Extensions for presence
+	Occurrence           DeviceAlertOccurrence    `json:"-" bson:"occurrence,omitempty"`                                    // When the alert condition occurred/is occurring
+	OccurrenceElement    *Element                 `json:"-" bson:"occurrence_element,omitempty"`                            // Extensions for occurrence[x]
+	Device               *Reference               `json:"device,omitempty" bson:"device,omitempty"`                         // The Device (or DeviceMetric) that detected the alert condition
+	Acknowledged         *bool                    `json:"acknowledged,omitempty" bson:"acknowledged,omitempty"`             // Whether the alert condition has been acknowledged
+	AcknowledgedElement  *Element                 `json:"_acknowledged,omitempty" bson:"acknowledged_element,omitempty"`    // Extensions for acknowledged
+	AcknowledgedBy       *Reference               `json:"acknowledgedBy,omitempty" bson:"acknowledged_by,omitempty"`        // Who acknowledged the alert condition
+	Location             *Reference               `json:"location,omitempty" bson:"location,omitempty"`                     // Location of the subject when the alert was raised
+	DerivedFrom          []DeviceAlertDerivedFrom `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`              // The value causing the alert condition
+	Label                *string                  `json:"label,omitempty" bson:"label,omitempty"`                           // Text to be displayed for the alert condition
+	LabelElement         *Element                 `json:"_label,omitempty" bson:"label_element,omitempty"`                  // Extensions for label
+	Signal               []DeviceAlertSignal      `json:"signal,omitempty" bson:"signal,omitempty"`                         // Annunciation or notification of the alert condition
+
+	occurrenceVariants []string // JSON properties of DeviceAlert.occurrence[x] when more than one was decoded
 }
 
+var deviceAlertProcedureTargets = []string{"Procedure"}
+
+var deviceAlertSubjectTargets = []string{"BiologicallyDerivedProduct", "Device", "Group", "Location", "Medication", "NutritionProduct", "Patient", "Specimen"}
+
+var deviceAlertEncounterTargets = []string{"Encounter"}
+
+var deviceAlertDeviceTargets = []string{"Device", "DeviceMetric"}
+
+var deviceAlertAcknowledgedByTargets = []string{"Device", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var deviceAlertLocationTargets = []string{"Location"}
+
 func (r *DeviceAlert) Validate() error {
 	if r.ResourceType != "DeviceAlert" {
 		return fmt.Errorf("invalid resourceType: expected 'DeviceAlert', got '%s'", r.ResourceType)
@@ -44,25 +67,65 @@ func (r *DeviceAlert) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Procedure {
+		if target := invalidReferenceTarget(item.Reference, item.Type, deviceAlertProcedureTargets); target != "" {
+			return fmt.Errorf("field 'Procedure[%d]' cannot refer to %s, expected one of %v", i, target, deviceAlertProcedureTargets)
+		}
+	}
 	for i, item := range r.Procedure {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Procedure[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -89,19 +152,47 @@ func (r *DeviceAlert) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, deviceAlertSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, deviceAlertSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, deviceAlertEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, deviceAlertEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if r.PresenceElement != nil {
+		if err := r.PresenceElement.Validate(); err != nil {
+			return fmt.Errorf("PresenceElement: %w", err)
+		}
+	}
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
+	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, deviceAlertDeviceTargets); target != "" {
+			return fmt.Errorf("field 'Device' cannot refer to %s, expected one of %v", target, deviceAlertDeviceTargets)
 		}
 	}
 	if r.Device != nil {
@@ -109,11 +200,26 @@ func (r *DeviceAlert) Validate() error {
 			return fmt.Errorf("Device: %w", err)
 		}
 	}
+	if r.AcknowledgedElement != nil {
+		if err := r.AcknowledgedElement.Validate(); err != nil {
+			return fmt.Errorf("AcknowledgedElement: %w", err)
+		}
+	}
+	if r.AcknowledgedBy != nil {
+		if target := invalidReferenceTarget(r.AcknowledgedBy.Reference, r.AcknowledgedBy.Type, deviceAlertAcknowledgedByTargets); target != "" {
+			return fmt.Errorf("field 'AcknowledgedBy' cannot refer to %s, expected one of %v", target, deviceAlertAcknowledgedByTargets)
+		}
+	}
 	if r.AcknowledgedBy != nil {
 		if err := r.AcknowledgedBy.Validate(); err != nil {
 			return fmt.Errorf("AcknowledgedBy: %w", err)
 		}
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, deviceAlertLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, deviceAlertLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
@@ -124,25 +230,411 @@ func (r *DeviceAlert) Validate() error {
 			return fmt.Errorf("DerivedFrom[%d]: %w", i, err)
 		}
 	}
+	if r.LabelElement != nil {
+		if err := r.LabelElement.Validate(); err != nil {
+			return fmt.Errorf("LabelElement: %w", err)
+		}
+	}
 	for i, item := range r.Signal {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Signal[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "DeviceAlert")
+}
+
+func (r *DeviceAlert) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DeviceAlert" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DeviceAlert', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.Procedure {
+		if target := invalidReferenceTarget(item.Reference, item.Type, deviceAlertProcedureTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.procedure[%d]", path, i), fmt.Sprintf("field 'Procedure[%d]' cannot refer to %s, expected one of %v", i, target, deviceAlertProcedureTargets))
+		}
+	}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, deviceAlertSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, deviceAlertSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, deviceAlertEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, deviceAlertEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.PresenceElement != nil {
+		r.PresenceElement.validateAll(path+".presence", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, deviceAlertDeviceTargets); target != "" {
+			issues.add("value", path+".device", fmt.Sprintf("field 'Device' cannot refer to %s, expected one of %v", target, deviceAlertDeviceTargets))
+		}
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device", issues)
+	}
+	if r.AcknowledgedElement != nil {
+		r.AcknowledgedElement.validateAll(path+".acknowledged", issues)
+	}
+	if r.AcknowledgedBy != nil {
+		if target := invalidReferenceTarget(r.AcknowledgedBy.Reference, r.AcknowledgedBy.Type, deviceAlertAcknowledgedByTargets); target != "" {
+			issues.add("value", path+".acknowledgedBy", fmt.Sprintf("field 'AcknowledgedBy' cannot refer to %s, expected one of %v", target, deviceAlertAcknowledgedByTargets))
+		}
+	}
+	if r.AcknowledgedBy != nil {
+		r.AcknowledgedBy.validateAll(path+".acknowledgedBy", issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, deviceAlertLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, deviceAlertLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.DerivedFrom {
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
+	}
+	if r.LabelElement != nil {
+		r.LabelElement.validateAll(path+".label", issues)
+	}
+	for i, item := range r.Signal {
+		item.validateAll(fmt.Sprintf("%s.signal[%d]", path, i), issues)
+	}
+}
+
+func (r *DeviceAlert) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DeviceAlert", &issues)
+	checkInvariants(r, "DeviceAlert", &issues)
+	return issues
+}
+
+var deviceAlertInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DeviceAlert) invariants() []invariant {
+	return deviceAlertInvariants
+}
+
+func (r DeviceAlert) MarshalJSON() ([]byte, error) {
+	type alias DeviceAlert
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceAlert) UnmarshalJSON(data []byte) error {
+	type alias DeviceAlert
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", deviceAlertOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r DeviceAlert) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceAlert) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceAlert) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceAlert) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceAlert) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(deviceAlertOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var deviceAlertElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *DeviceAlert) elementTypes() map[string]string {
+	return deviceAlertElementTypes
+}
+
+var deviceAlertElementMetadata = []ElementMetadata{
+	{Path: "DeviceAlert.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DeviceAlert.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DeviceAlert.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DeviceAlert.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceAlert.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DeviceAlert.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DeviceAlert.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DeviceAlert.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceAlert.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceAlert.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DeviceAlert.procedure", Name: "procedure", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Procedure"}},
+	{Path: "DeviceAlert.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceAlert.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/devicealert-status"}},
+	{Path: "DeviceAlert.category", Name: "category", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.type", Name: "type", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.priority", Name: "priority", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.code", Name: "code", Field: 18, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.subject", Name: "subject", Field: 19, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Specimen"}},
+	{Path: "DeviceAlert.encounter", Name: "encounter", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "DeviceAlert.presence", Name: "presence", Field: 21, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceAlert.occurrence[x]", Name: "occurrence", Field: 23, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "DeviceAlert.device", Name: "device", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceMetric"}},
+	{Path: "DeviceAlert.acknowledged", Name: "acknowledged", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceAlert.acknowledgedBy", Name: "acknowledgedBy", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "DeviceAlert.location", Name: "location", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "DeviceAlert.derivedFrom", Name: "derivedFrom", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceAlert.label", Name: "label", Field: 31, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceAlert.signal", Name: "signal", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *DeviceAlert) ElementMetadata() []ElementMetadata {
+	return deviceAlertElementMetadata
+}
+
+// DeviceAlertOccurrence is the type of DeviceAlert.occurrence[x]. It is implemented by the DeviceAlertOccurrence* variant types.
+type DeviceAlertOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceAlertOccurrence()
+}
+
+// DeviceAlertOccurrenceDateTime is the dateTime variant of DeviceAlertOccurrence.
+type DeviceAlertOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v DeviceAlertOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DeviceAlertOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v DeviceAlertOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DeviceAlertOccurrenceDateTime) isDeviceAlertOccurrence() {}
+
+// DeviceAlertOccurrencePeriod is the Period variant of DeviceAlertOccurrence.
+type DeviceAlertOccurrencePeriod struct {
+	Period
+}
+
+func (v DeviceAlertOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DeviceAlertOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DeviceAlertOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DeviceAlertOccurrencePeriod) isDeviceAlertOccurrence() {}
+
+var deviceAlertOccurrenceVariants = []DeviceAlertOccurrence{
+	DeviceAlertOccurrenceDateTime{},
+	DeviceAlertOccurrencePeriod{},
+}
+
+func (r *DeviceAlert) GetResourceType() string {
+	return "DeviceAlert"
+}
+
+func (r *DeviceAlert) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DeviceAlert) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DeviceAlert) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DeviceAlert) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DeviceAlert) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DeviceAlert) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DeviceAlert) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DeviceAlert)(nil)
+
 type DeviceAlertDerivedFrom struct {
-	Id          *string    `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Observation *Reference `json:"observation" bson:"observation"`                 // The Observation having a value causing the alert condition
-	Component   *Coding    `json:"component,omitempty" bson:"component,omitempty"` // The Observation.component having a value causing the alert condition
-	Limit       *Range     `json:"limit,omitempty" bson:"limit,omitempty"`         // The boundaries beyond which a value was detected to cause the alert condition
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Observation       *Reference  `json:"observation" bson:"observation"`                                  // The Observation having a value causing the alert condition
+	Component         *Coding     `json:"component,omitempty" bson:"component,omitempty"`                  // The Observation.component having a value causing the alert condition
+	Limit             *Range      `json:"limit,omitempty" bson:"limit,omitempty"`                          // The boundaries beyond which a value was detected to cause the alert condition
 }
 
+var deviceAlertDerivedFromObservationTargets = []string{"Observation"}
+
 func (r *DeviceAlertDerivedFrom) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Observation == nil {
 		return fmt.Errorf("field 'Observation' is required")
 	}
+	if r.Observation != nil {
+		if target := invalidReferenceTarget(r.Observation.Reference, r.Observation.Type, deviceAlertDerivedFromObservationTargets); target != "" {
+			return fmt.Errorf("field 'Observation' cannot refer to %s, expected one of %v", target, deviceAlertDerivedFromObservationTargets)
+		}
+	}
 	if r.Observation != nil {
 		if err := r.Observation.Validate(); err != nil {
 			return fmt.Errorf("Observation: %w", err)
@@ -161,17 +653,92 @@ func (r *DeviceAlertDerivedFrom) Validate() error {
 	return nil
 }
 
+func (r *DeviceAlertDerivedFrom) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Observation == nil {
+		issues.add("required", path+".observation", "field 'Observation' is required")
+	}
+	if r.Observation != nil {
+		if target := invalidReferenceTarget(r.Observation.Reference, r.Observation.Type, deviceAlertDerivedFromObservationTargets); target != "" {
+			issues.add("value", path+".observation", fmt.Sprintf("field 'Observation' cannot refer to %s, expected one of %v", target, deviceAlertDerivedFromObservationTargets))
+		}
+	}
+	if r.Observation != nil {
+		r.Observation.validateAll(path+".observation", issues)
+	}
+	if r.Component != nil {
+		r.Component.validateAll(path+".component", issues)
+	}
+	if r.Limit != nil {
+		r.Limit.validateAll(path+".limit", issues)
+	}
+}
+
+var deviceAlertDerivedFromInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceAlertDerivedFrom) invariants() []invariant {
+	return deviceAlertDerivedFromInvariants
+}
+
+func (r DeviceAlertDerivedFrom) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceAlertDerivedFrom) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceAlertDerivedFrom) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceAlertDerivedFrom) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceAlertDerivedFromElementMetadata = []ElementMetadata{
+	{Path: "DeviceAlert.derivedFrom.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceAlert.derivedFrom.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceAlert.derivedFrom.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceAlert.derivedFrom.observation", Name: "observation", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Observation"}},
+	{Path: "DeviceAlert.derivedFrom.component", Name: "component", Field: 4, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "DeviceAlert.derivedFrom.limit", Name: "limit", Field: 5, Min: 0, Max: "1", Types: []string{"Range"}},
+}
+
+func (r *DeviceAlertDerivedFrom) ElementMetadata() []ElementMetadata {
+	return deviceAlertDerivedFromElementMetadata
+}
+
 type DeviceAlertSignal struct {
-	Id              *string            `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	ActivationState *CodeableConcept   `json:"activationState" bson:"activation_state"`                // on | off | paused
-	Presence        *CodeableConcept   `json:"presence,omitempty" bson:"presence,omitempty"`           // on | latched | off | ack
-	Annunciator     *CodeableReference `json:"annunciator,omitempty" bson:"annunciator,omitempty"`     // Where the signal is being annunciated
-	Manifestation   *CodeableConcept   `json:"manifestation,omitempty" bson:"manifestation,omitempty"` // How the signal is being annunciated
-	Type            []CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                   // Characteristics of the signal manifestation
-	Indication      *Period            `json:"indication,omitempty" bson:"indication,omitempty"`       // When the signal was being annunciated
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	ActivationState   *CodeableConcept   `json:"activationState" bson:"activation_state"`                         // on | off | paused
+	Presence          *CodeableConcept   `json:"presence,omitempty" bson:"presence,omitempty"`                    // on | latched | off | ack
+	Annunciator       *CodeableReference `json:"annunciator,omitempty" bson:"annunciator,omitempty"`              // Where the signal is being annunciated
+	Manifestation     *CodeableConcept   `json:"manifestation,omitempty" bson:"manifestation,omitempty"`          // How the signal is being annunciated
+	Type              []CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                            // Characteristics of the signal manifestation
+	Indication        *Period            `json:"indication,omitempty" bson:"indication,omitempty"`                // When the signal was being annunciated
 }
 
 func (r *DeviceAlertSignal) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.ActivationState == nil {
 		return fmt.Errorf("field 'ActivationState' is required")
 	}
@@ -207,3 +774,73 @@ func (r *DeviceAlertSignal) Validate() error {
 	}
 	return nil
 }
+
+func (r *DeviceAlertSignal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ActivationState == nil {
+		issues.add("required", path+".activationState", "field 'ActivationState' is required")
+	}
+	if r.ActivationState != nil {
+		r.ActivationState.validateAll(path+".activationState", issues)
+	}
+	if r.Presence != nil {
+		r.Presence.validateAll(path+".presence", issues)
+	}
+	if r.Annunciator != nil {
+		r.Annunciator.validateAll(path+".annunciator", issues)
+	}
+	if r.Manifestation != nil {
+		r.Manifestation.validateAll(path+".manifestation", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.Indication != nil {
+		r.Indication.validateAll(path+".indication", issues)
+	}
+}
+
+var deviceAlertSignalInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceAlertSignal) invariants() []invariant {
+	return deviceAlertSignalInvariants
+}
+
+func (r DeviceAlertSignal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceAlertSignal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceAlertSignal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceAlertSignal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceAlertSignalElementMetadata = []ElementMetadata{
+	{Path: "DeviceAlert.signal.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceAlert.signal.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceAlert.signal.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceAlert.signal.activationState", Name: "activationState", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.signal.presence", Name: "presence", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.signal.annunciator", Name: "annunciator", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "DeviceAlert.signal.manifestation", Name: "manifestation", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.signal.type", Name: "type", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAlert.signal.indication", Name: "indication", Field: 8, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *DeviceAlertSignal) ElementMetadata() []ElementMetadata {
+	return deviceAlertSignalElementMetadata
+}
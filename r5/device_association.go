@@ -2,30 +2,42 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A record of association of a device.
 type DeviceAssociation struct {
-	ResourceType      string            `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string           `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Identifier        []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Instance identifier
-	Device            *Reference        `json:"device" bson:"device"`                                            // Reference to the device that is being associated
-	Relationship      []CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"`            // Describes the relationship between the device and subject
-	Status            string            `json:"status" bson:"status"`                                            // active | inactive | entered-in-error | unknown
-	StatusReason      []CodeableConcept `json:"statusReason,omitempty" bson:"status_reason,omitempty"`           // The reasons given for the current association status
-	AssociationStatus *CodeableConcept  `json:"associationStatus,omitempty" bson:"association_status,omitempty"` // State of the device’s association
-	Subject           *Reference        `json:"subject,omitempty" bson:"subject,omitempty"`                      // The entity(ies) that the device is on or associated with
-	Focus             *Reference        `json:"focus,omitempty" bson:"focus,omitempty"`                          // The target of the association
-	BodyStructure     *Reference        `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`         // Current anatomical location of the device in/on subject
-	Period            *Period           `json:"period,omitempty" bson:"period,omitempty"`                        // Begin and end dates and times for the device association
+	ResourceType         string                 `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element               `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource             `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Instance identifier
+	Device               *Reference             `json:"device" bson:"device"`                                             // Reference to the device that is being associated
+	Relationship         []CodeableConcept      `json:"relationship,omitempty" bson:"relationship,omitempty"`             // Describes the relationship between the device and subject
+	Status               DeviceAssociationCodes `json:"status" bson:"status"`                                             // active | inactive | entered-in-error | unknown
+	StatusElement        *Element               `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	StatusReason         []CodeableConcept      `json:"statusReason,omitempty" bson:"status_reason,omitempty"`            // The reasons given for the current association status
+	AssociationStatus    *CodeableConcept       `json:"associationStatus,omitempty" bson:"association_status,omitempty"`  // State of the device’s association
+	Subject              *Reference             `json:"subject,omitempty" bson:"subject,omitempty"`                       // The entity(ies) that the device is on or associated with
+	Focus                *Reference             `json:"focus,omitempty" bson:"focus,omitempty"`                           // The target of the association
+	BodyStructure        *Reference             `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`          // Current anatomical location of the device in/on subject
+	Period               *Period                `json:"period,omitempty" bson:"period,omitempty"`                         // Begin and end dates and times for the device association
 }
 
+var deviceAssociationDeviceTargets = []string{"Device"}
+
+var deviceAssociationSubjectTargets = []string{"BiologicallyDerivedProduct", "CareTeam", "Device", "Group", "Location", "Medication", "NutritionProduct", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson", "Specimen", "Substance"}
+
+var deviceAssociationFocusTargets = []string{"BiologicallyDerivedProduct", "CareTeam", "Device", "Group", "Location", "Medication", "NutritionProduct", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson", "Specimen", "Substance"}
+
 func (r *DeviceAssociation) Validate() error {
 	if r.ResourceType != "DeviceAssociation" {
 		return fmt.Errorf("invalid resourceType: expected 'DeviceAssociation', got '%s'", r.ResourceType)
@@ -35,11 +47,39 @@ func (r *DeviceAssociation) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -48,6 +88,11 @@ func (r *DeviceAssociation) Validate() error {
 	if r.Device == nil {
 		return fmt.Errorf("field 'Device' is required")
 	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, deviceAssociationDeviceTargets); target != "" {
+			return fmt.Errorf("field 'Device' cannot refer to %s, expected one of %v", target, deviceAssociationDeviceTargets)
+		}
+	}
 	if r.Device != nil {
 		if err := r.Device.Validate(); err != nil {
 			return fmt.Errorf("Device: %w", err)
@@ -58,10 +103,17 @@ func (r *DeviceAssociation) Validate() error {
 			return fmt.Errorf("Relationship[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusReason[%d]: %w", i, err)
@@ -72,11 +124,21 @@ func (r *DeviceAssociation) Validate() error {
 			return fmt.Errorf("AssociationStatus: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, deviceAssociationSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, deviceAssociationSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Focus != nil {
+		if target := invalidReferenceTarget(r.Focus.Reference, r.Focus.Type, deviceAssociationFocusTargets); target != "" {
+			return fmt.Errorf("field 'Focus' cannot refer to %s, expected one of %v", target, deviceAssociationFocusTargets)
+		}
+	}
 	if r.Focus != nil {
 		if err := r.Focus.Validate(); err != nil {
 			return fmt.Errorf("Focus: %w", err)
@@ -92,5 +154,220 @@ func (r *DeviceAssociation) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	return validateInvariants(r, "DeviceAssociation")
+}
+
+func (r *DeviceAssociation) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DeviceAssociation" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DeviceAssociation', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Device == nil {
+		issues.add("required", path+".device", "field 'Device' is required")
+	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, deviceAssociationDeviceTargets); target != "" {
+			issues.add("value", path+".device", fmt.Sprintf("field 'Device' cannot refer to %s, expected one of %v", target, deviceAssociationDeviceTargets))
+		}
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device", issues)
+	}
+	for i, item := range r.Relationship {
+		item.validateAll(fmt.Sprintf("%s.relationship[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.StatusReason {
+		item.validateAll(fmt.Sprintf("%s.statusReason[%d]", path, i), issues)
+	}
+	if r.AssociationStatus != nil {
+		r.AssociationStatus.validateAll(path+".associationStatus", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, deviceAssociationSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, deviceAssociationSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Focus != nil {
+		if target := invalidReferenceTarget(r.Focus.Reference, r.Focus.Type, deviceAssociationFocusTargets); target != "" {
+			issues.add("value", path+".focus", fmt.Sprintf("field 'Focus' cannot refer to %s, expected one of %v", target, deviceAssociationFocusTargets))
+		}
+	}
+	if r.Focus != nil {
+		r.Focus.validateAll(path+".focus", issues)
+	}
+	if r.BodyStructure != nil {
+		r.BodyStructure.validateAll(path+".bodyStructure", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+func (r *DeviceAssociation) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DeviceAssociation", &issues)
+	checkInvariants(r, "DeviceAssociation", &issues)
+	return issues
+}
+
+var deviceAssociationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DeviceAssociation) invariants() []invariant {
+	return deviceAssociationInvariants
+}
+
+func (r *DeviceAssociation) UnmarshalJSON(data []byte) error {
+	type alias DeviceAssociation
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r DeviceAssociation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceAssociation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceAssociation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceAssociation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceAssociationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *DeviceAssociation) elementTypes() map[string]string {
+	return deviceAssociationElementTypes
+}
+
+var deviceAssociationElementMetadata = []ElementMetadata{
+	{Path: "DeviceAssociation.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DeviceAssociation.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DeviceAssociation.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DeviceAssociation.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceAssociation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DeviceAssociation.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DeviceAssociation.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DeviceAssociation.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceAssociation.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceAssociation.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DeviceAssociation.device", Name: "device", Field: 12, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "DeviceAssociation.relationship", Name: "relationship", Field: 13, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAssociation.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceAssociation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/deviceassociation-status"}},
+	{Path: "DeviceAssociation.statusReason", Name: "statusReason", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAssociation.associationStatus", Name: "associationStatus", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceAssociation.subject", Name: "subject", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/Substance"}},
+	{Path: "DeviceAssociation.focus", Name: "focus", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/Substance"}},
+	{Path: "DeviceAssociation.bodyStructure", Name: "bodyStructure", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "DeviceAssociation.period", Name: "period", Field: 21, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *DeviceAssociation) ElementMetadata() []ElementMetadata {
+	return deviceAssociationElementMetadata
+}
+
+func (r *DeviceAssociation) GetResourceType() string {
+	return "DeviceAssociation"
+}
+
+func (r *DeviceAssociation) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DeviceAssociation) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DeviceAssociation) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DeviceAssociation) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DeviceAssociation) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DeviceAssociation) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DeviceAssociation) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DeviceAssociation)(nil)
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,33 +12,53 @@ type DeviceDefinition struct {
 	Id                        *string                                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Logical id of this artifact
 	Meta                      *Meta                                  `json:"meta,omitempty" bson:"meta,omitempty"`                                                // Metadata about the resource
 	ImplicitRules             *string                                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                             // A set of rules under which this content was created
+	ImplicitRulesElement      *Element                               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                    // Extensions for implicitRules
 	Language                  *string                                `json:"language,omitempty" bson:"language,omitempty"`                                        // Language of the resource content
+	LanguageElement           *Element                               `json:"_language,omitempty" bson:"language_element,omitempty"`                               // Extensions for language
 	Text                      *Narrative                             `json:"text,omitempty" bson:"text,omitempty"`                                                // Text summary of the resource, for human interpretation
-	Contained                 []json.RawMessage                      `json:"contained,omitempty" bson:"contained,omitempty"`                                      // Contained, inline Resources
+	Contained                 []Resource                             `json:"contained,omitempty" bson:"contained,omitempty"`                                      // Contained, inline Resources
+	Extension                 []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                                      // Additional content defined by implementations
+	ModifierExtension         []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                     // Extensions that cannot be ignored
 	Url                       *string                                `json:"url,omitempty" bson:"url,omitempty"`                                                  // Canonical identifier for this DeviceDefinition, represented as an absolute URI (globally unique)
+	UrlElement                *Element                               `json:"_url,omitempty" bson:"url_element,omitempty"`                                         // Extensions for url
 	Identifier                []Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`                                    // Additional identifier for the DeviceDefinition
 	Version                   *string                                `json:"version,omitempty" bson:"version,omitempty"`                                          // Business version of the DeviceDefinition
-	VersionAlgorithmString    *string                                `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"`          // How to compare versions
-	VersionAlgorithmCoding    *Coding                                `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"`          // How to compare versions
+	VersionElement            *Element                               `json:"_version,omitempty" bson:"version_element,omitempty"`                                 // Extensions for version
+	VersionAlgorithm          DeviceDefinitionVersionAlgorithm       `json:"-" bson:"version_algorithm,omitempty"`                                                // How to compare versions
+	VersionAlgorithmElement   *Element                               `json:"-" bson:"version_algorithm_element,omitempty"`                                        // Extensions for versionAlgorithm[x]
 	Name                      *string                                `json:"name,omitempty" bson:"name,omitempty"`                                                // Name for this DeviceDefinition (computer friendly)
+	NameElement               *Element                               `json:"_name,omitempty" bson:"name_element,omitempty"`                                       // Extensions for name
 	Title                     *string                                `json:"title,omitempty" bson:"title,omitempty"`                                              // Name for this DeviceDefinition (human friendly)
-	Status                    string                                 `json:"status" bson:"status"`                                                                // draft | active | retired | unknown
+	TitleElement              *Element                               `json:"_title,omitempty" bson:"title_element,omitempty"`                                     // Extensions for title
+	Status                    PublicationStatus                      `json:"status" bson:"status"`                                                                // draft | active | retired | unknown
+	StatusElement             *Element                               `json:"_status,omitempty" bson:"status_element,omitempty"`                                   // Extensions for status
 	Experimental              *bool                                  `json:"experimental,omitempty" bson:"experimental,omitempty"`                                // For testing only - never for real usage
-	Date                      *string                                `json:"date,omitempty" bson:"date,omitempty"`                                                // Date last changed
+	ExperimentalElement       *Element                               `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                       // Extensions for experimental
+	Date                      *DateTime                              `json:"date,omitempty" bson:"date,omitempty"`                                                // Date last changed
+	DateElement               *Element                               `json:"_date,omitempty" bson:"date_element,omitempty"`                                       // Extensions for date
 	Publisher                 *string                                `json:"publisher,omitempty" bson:"publisher,omitempty"`                                      // Name of the publisher/steward (organization or individual)
+	PublisherElement          *Element                               `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                             // Extensions for publisher
 	Contact                   []ContactDetail                        `json:"contact,omitempty" bson:"contact,omitempty"`                                          // Contact details for the publisher
 	Description               *string                                `json:"description,omitempty" bson:"description,omitempty"`                                  // Natural language description of the DeviceDefinition
+	DescriptionElement        *Element                               `json:"_description,omitempty" bson:"description_element,omitempty"`                         // Extensions for description
 	UseContext                []UsageContext                         `json:"useContext,omitempty" bson:"use_context,omitempty"`                                   // The context that the content is intended to support
 	Jurisdiction              []CodeableConcept                      `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                // Jurisdiction of the authority that maintains the DeviceDefinition (if applicable)
 	Purpose                   *string                                `json:"purpose,omitempty" bson:"purpose,omitempty"`                                          // Why this DeviceDefinition is defined
+	PurposeElement            *Element                               `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                                 // Extensions for purpose
 	Copyright                 *string                                `json:"copyright,omitempty" bson:"copyright,omitempty"`                                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement          *Element                               `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                             // Extensions for copyright
 	CopyrightLabel            *string                                `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                           // Copyright holder and year(s)
-	ApprovalDate              *string                                `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                               // When DeviceDefinition was approved by publisher
-	LastReviewDate            *string                                `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                          // Date on which the asset content was last reviewed by the publisher
+	CopyrightLabelElement     *Element                               `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`                  // Extensions for copyrightLabel
+	ApprovalDate              *Date                                  `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                               // When DeviceDefinition was approved by publisher
+	ApprovalDateElement       *Element                               `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`                      // Extensions for approvalDate
+	LastReviewDate            *Date                                  `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                          // Date on which the asset content was last reviewed by the publisher
+	LastReviewDateElement     *Element                               `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`                 // Extensions for lastReviewDate
 	EffectivePeriod           *Period                                `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                         // The effective date range for the DeviceDefinition
 	PartNumber                *string                                `json:"partNumber,omitempty" bson:"part_number,omitempty"`                                   // The part number or catalog number of the device
+	PartNumberElement         *Element                               `json:"_partNumber,omitempty" bson:"part_number_element,omitempty"`                          // Extensions for partNumber
 	Manufacturer              *Reference                             `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                                // Name of device manufacturer
 	ModelNumber               *string                                `json:"modelNumber,omitempty" bson:"model_number,omitempty"`                                 // The catalog or model number for the device for example as defined by the manufacturer
+	ModelNumberElement        *Element                               `json:"_modelNumber,omitempty" bson:"model_number_element,omitempty"`                        // Extensions for modelNumber
 	UdiDeviceIdentifier       []DeviceDefinitionUdiDeviceIdentifier  `json:"udiDeviceIdentifier,omitempty" bson:"udi_device_identifier,omitempty"`                // Unique Device Identifier (UDI) Barcode string
 	RegulatoryIdentifier      []DeviceDefinitionRegulatoryIdentifier `json:"regulatoryIdentifier,omitempty" bson:"regulatory_identifier,omitempty"`               // Regulatory identifier(s) associated with this device
 	DeviceName                []DeviceDefinitionDeviceName           `json:"deviceName,omitempty" bson:"device_name,omitempty"`                                   // The name or names of the device as given by the manufacturer
@@ -49,6 +70,7 @@ type DeviceDefinition struct {
 	Safety                    []CodeableConcept                      `json:"safety,omitempty" bson:"safety,omitempty"`                                            // Safety characteristics of the device
 	ShelfLifeStorage          []ProductShelfLife                     `json:"shelfLifeStorage,omitempty" bson:"shelf_life_storage,omitempty"`                      // Shelf Life and storage information
 	OutputLanguage            []string                               `json:"outputLanguage,omitempty" bson:"output_language,omitempty"`                           // Language code for the human-readable text strings produced by the device (all supported)
+	OutputLanguageElement     []*Element                             `json:"_outputLanguage,omitempty" bson:"output_language_element,omitempty"`                  // Extensions for outputLanguage
 	Property                  []DeviceDefinitionProperty             `json:"property,omitempty" bson:"property,omitempty"`                                        // Inherent, essentially fixed, characteristics of this kind of device, e.g., time properties, size, etc
 	Link                      []DeviceDefinitionLink                 `json:"link,omitempty" bson:"link,omitempty"`                                                // An associated device, attached to, used with, communicating with or linking a previous or new device model to the focal device
 	Note                      []Annotation                           `json:"note,omitempty" bson:"note,omitempty"`                                                // Device notes and comments
@@ -57,8 +79,12 @@ type DeviceDefinition struct {
 	Guideline                 *DeviceDefinitionGuideline             `json:"guideline,omitempty" bson:"guideline,omitempty"`                                      // Information aimed at providing directions for the usage of this model of device
 	CorrectiveAction          *DeviceDefinitionCorrectiveAction      `json:"correctiveAction,omitempty" bson:"corrective_action,omitempty"`                       // Tracking of latest field safety corrective action
 	ChargeItem                []DeviceDefinitionChargeItem           `json:"chargeItem,omitempty" bson:"charge_item,omitempty"`                                   // Billing code or reference associated with the device
+
+	versionAlgorithmVariants []string // JSON properties of DeviceDefinition.versionAlgorithm[x] when more than one was decoded
 }
 
+var deviceDefinitionManufacturerTargets = []string{"Organization"}
+
 func (r *DeviceDefinition) Validate() error {
 	if r.ResourceType != "DeviceDefinition" {
 		return fmt.Errorf("invalid resourceType: expected 'DeviceDefinition', got '%s'", r.ResourceType)
@@ -68,30 +94,118 @@ func (r *DeviceDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -102,16 +216,66 @@ func (r *DeviceDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
 		}
 	}
+	if r.PartNumberElement != nil {
+		if err := r.PartNumberElement.Validate(); err != nil {
+			return fmt.Errorf("PartNumberElement: %w", err)
+		}
+	}
+	if r.Manufacturer != nil {
+		if target := invalidReferenceTarget(r.Manufacturer.Reference, r.Manufacturer.Type, deviceDefinitionManufacturerTargets); target != "" {
+			return fmt.Errorf("field 'Manufacturer' cannot refer to %s, expected one of %v", target, deviceDefinitionManufacturerTargets)
+		}
+	}
 	if r.Manufacturer != nil {
 		if err := r.Manufacturer.Validate(); err != nil {
 			return fmt.Errorf("Manufacturer: %w", err)
 		}
 	}
+	if r.ModelNumberElement != nil {
+		if err := r.ModelNumberElement.Validate(); err != nil {
+			return fmt.Errorf("ModelNumberElement: %w", err)
+		}
+	}
 	for i, item := range r.UdiDeviceIdentifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UdiDeviceIdentifier[%d]: %w", i, err)
@@ -162,6 +326,14 @@ func (r *DeviceDefinition) Validate() error {
 			return fmt.Errorf("ShelfLifeStorage[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.OutputLanguageElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OutputLanguageElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Property {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Property[%d]: %w", i, err)
@@ -202,213 +374,2361 @@ func (r *DeviceDefinition) Validate() error {
 			return fmt.Errorf("ChargeItem[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type DeviceDefinitionDeviceVersion struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Type      *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`           // The type of the device version, e.g. manufacturer, approved, internal
-	Component *Identifier      `json:"component,omitempty" bson:"component,omitempty"` // The hardware or software module of the device to which the version applies
-	Value     string           `json:"value" bson:"value"`                             // The version text
+	return validateInvariants(r, "DeviceDefinition")
 }
 
-func (r *DeviceDefinitionDeviceVersion) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+func (r *DeviceDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DeviceDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DeviceDefinition', got '%s'", r.ResourceType))
 	}
-	if r.Component != nil {
-		if err := r.Component.Validate(); err != nil {
-			return fmt.Errorf("Component: %w", err)
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	var emptyString string
-	if r.Value == emptyString {
-		return fmt.Errorf("field 'Value' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type DeviceDefinitionUdiDeviceIdentifier struct {
-	Id                     *string                                                 `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
-	DeviceIdentifier       string                                                  `json:"deviceIdentifier" bson:"device_identifier"`                                  // The identifier that is to be associated with every Device that references this DeviceDefintiion for the issuer and jurisdiction provided in the DeviceDefinition.udiDeviceIdentifier
-	Issuer                 string                                                  `json:"issuer" bson:"issuer"`                                                       // The organization that assigns the identifier algorithm
-	Jurisdiction           string                                                  `json:"jurisdiction" bson:"jurisdiction"`                                           // The jurisdiction to which the deviceIdentifier applies
-	MarketDistribution     []DeviceDefinitionUdiDeviceIdentifierMarketDistribution `json:"marketDistribution,omitempty" bson:"market_distribution,omitempty"`          // Indicates whether and when the device is available on the market
-	DeviceIdentifierSystem *string                                                 `json:"deviceIdentifierSystem,omitempty" bson:"device_identifier_system,omitempty"` // The namespace for the device identifier value
-}
-
-func (r *DeviceDefinitionUdiDeviceIdentifier) Validate() error {
-	var emptyString string
-	if r.DeviceIdentifier == emptyString {
-		return fmt.Errorf("field 'DeviceIdentifier' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Issuer == emptyString {
-		return fmt.Errorf("field 'Issuer' is required")
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	if r.Jurisdiction == emptyString {
-		return fmt.Errorf("field 'Jurisdiction' is required")
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	for i, item := range r.MarketDistribution {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("MarketDistribution[%d]: %w", i, err)
-		}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
-	return nil
-}
-
-type DeviceDefinitionUdiDeviceIdentifierMarketDistribution struct {
-	Id              *string `json:"id,omitempty" bson:"id,omitempty"`        // Unique id for inter-element referencing
-	MarketPeriod    *Period `json:"marketPeriod" bson:"market_period"`       // Begin and end dates for the commercial distribution of the device
-	SubJurisdiction string  `json:"subJurisdiction" bson:"sub_jurisdiction"` // National state or territory where the device is commercialized
-}
-
-func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) Validate() error {
-	if r.MarketPeriod == nil {
-		return fmt.Errorf("field 'MarketPeriod' is required")
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
 	}
-	if r.MarketPeriod != nil {
-		if err := r.MarketPeriod.Validate(); err != nil {
-			return fmt.Errorf("MarketPeriod: %w", err)
-		}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
 	}
-	var emptyString string
-	if r.SubJurisdiction == emptyString {
-		return fmt.Errorf("field 'SubJurisdiction' is required")
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
 	}
-	return nil
-}
-
-type DeviceDefinitionRegulatoryIdentifier struct {
-	Id               *string `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Type             string  `json:"type" bson:"type"`                                              // basic | master | license
-	Identifier       string  `json:"identifier" bson:"identifier"`                                  // The identifier itself
-	Issuer           string  `json:"issuer" bson:"issuer"`                                          // The organization that issued this identifier
-	Jurisdiction     string  `json:"jurisdiction" bson:"jurisdiction"`                              // Relevant jurisdiction governing the identifier
-	IdentifierSystem *string `json:"identifierSystem,omitempty" bson:"identifier_system,omitempty"` // The namespace for the device identifier value
-}
-
-func (r *DeviceDefinitionRegulatoryIdentifier) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	if r.Identifier == emptyString {
-		return fmt.Errorf("field 'Identifier' is required")
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	if r.Issuer == emptyString {
-		return fmt.Errorf("field 'Issuer' is required")
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	if r.Jurisdiction == emptyString {
-		return fmt.Errorf("field 'Jurisdiction' is required")
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	return nil
-}
-
-type DeviceDefinitionConformsTo struct {
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Category      *CodeableConcept  `json:"category,omitempty" bson:"category,omitempty"` // Describes the common type of the standard, specification, or formal guidance
-	Specification *CodeableConcept  `json:"specification" bson:"specification"`           // Identifies the standard, specification, or formal guidance that the device adheres to the Device Specification type
-	Version       []string          `json:"version,omitempty" bson:"version,omitempty"`   // The specific form or variant of the standard, specification or formal guidance
-	Source        []RelatedArtifact `json:"source,omitempty" bson:"source,omitempty"`     // Standard, regulation, certification, or guidance website, document, or other publication, or similar, supporting the conformance
-}
-
-func (r *DeviceDefinitionConformsTo) Validate() error {
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.Specification == nil {
-		return fmt.Errorf("field 'Specification' is required")
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
 	}
-	if r.Specification != nil {
-		if err := r.Specification.Validate(); err != nil {
-			return fmt.Errorf("Specification: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	for i, item := range r.Source {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Source[%d]: %w", i, err)
-		}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	return nil
-}
-
-type DeviceDefinitionPackaging struct {
-	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
-	Identifier          *Identifier                            `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Business identifier of the packaged medication
-	Type                *CodeableConcept                       `json:"type,omitempty" bson:"type,omitempty"`                                 // A code that defines the specific type of packaging
-	Count               *int                                   `json:"count,omitempty" bson:"count,omitempty"`                               // The number of items contained in the package (devices or sub-packages)
-	Distributor         []DeviceDefinitionPackagingDistributor `json:"distributor,omitempty" bson:"distributor,omitempty"`                   // An organization that distributes the packaged device
-	UdiDeviceIdentifier []DeviceDefinitionUdiDeviceIdentifier  `json:"udiDeviceIdentifier,omitempty" bson:"udi_device_identifier,omitempty"` // Unique Device Identifier (UDI) Barcode string on the packaging
-	Packaging           []DeviceDefinitionPackaging            `json:"packaging,omitempty" bson:"packaging,omitempty"`                       // Allows packages within packages
-}
-
-func (r *DeviceDefinitionPackaging) Validate() error {
-	if r.Identifier != nil {
-		if err := r.Identifier.Validate(); err != nil {
-			return fmt.Errorf("Identifier: %w", err)
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
 		}
 	}
-	for i, item := range r.Distributor {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Distributor[%d]: %w", i, err)
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	if r.PartNumberElement != nil {
+		r.PartNumberElement.validateAll(path+".partNumber", issues)
+	}
+	if r.Manufacturer != nil {
+		if target := invalidReferenceTarget(r.Manufacturer.Reference, r.Manufacturer.Type, deviceDefinitionManufacturerTargets); target != "" {
+			issues.add("value", path+".manufacturer", fmt.Sprintf("field 'Manufacturer' cannot refer to %s, expected one of %v", target, deviceDefinitionManufacturerTargets))
 		}
 	}
+	if r.Manufacturer != nil {
+		r.Manufacturer.validateAll(path+".manufacturer", issues)
+	}
+	if r.ModelNumberElement != nil {
+		r.ModelNumberElement.validateAll(path+".modelNumber", issues)
+	}
 	for i, item := range r.UdiDeviceIdentifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("UdiDeviceIdentifier[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.udiDeviceIdentifier[%d]", path, i), issues)
+	}
+	for i, item := range r.RegulatoryIdentifier {
+		item.validateAll(fmt.Sprintf("%s.regulatoryIdentifier[%d]", path, i), issues)
+	}
+	for i, item := range r.DeviceName {
+		item.validateAll(fmt.Sprintf("%s.deviceName[%d]", path, i), issues)
+	}
+	for i, item := range r.Classification {
+		item.validateAll(fmt.Sprintf("%s.classification[%d]", path, i), issues)
+	}
+	for i, item := range r.ConformsTo {
+		item.validateAll(fmt.Sprintf("%s.conformsTo[%d]", path, i), issues)
+	}
+	for i, item := range r.HasPart {
+		item.validateAll(fmt.Sprintf("%s.hasPart[%d]", path, i), issues)
 	}
 	for i, item := range r.Packaging {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Packaging[%d]: %w", i, err)
+		item.validateAll(fmt.Sprintf("%s.packaging[%d]", path, i), issues)
+	}
+	for i, item := range r.DeviceVersion {
+		item.validateAll(fmt.Sprintf("%s.deviceVersion[%d]", path, i), issues)
+	}
+	for i, item := range r.Safety {
+		item.validateAll(fmt.Sprintf("%s.safety[%d]", path, i), issues)
+	}
+	for i, item := range r.ShelfLifeStorage {
+		item.validateAll(fmt.Sprintf("%s.shelfLifeStorage[%d]", path, i), issues)
+	}
+	for i, item := range r.OutputLanguageElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.outputLanguage[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Link {
+		item.validateAll(fmt.Sprintf("%s.link[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Material {
+		item.validateAll(fmt.Sprintf("%s.material[%d]", path, i), issues)
+	}
+	for i, item := range r.ProductionIdentifierInUDI {
+		item.validateAll(fmt.Sprintf("%s.productionIdentifierInUDI[%d]", path, i), issues)
+	}
+	if r.Guideline != nil {
+		r.Guideline.validateAll(path+".guideline", issues)
+	}
+	if r.CorrectiveAction != nil {
+		r.CorrectiveAction.validateAll(path+".correctiveAction", issues)
+	}
+	for i, item := range r.ChargeItem {
+		item.validateAll(fmt.Sprintf("%s.chargeItem[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type DeviceDefinitionMaterial struct {
-	Id                  *string          `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Substance           *CodeableConcept `json:"substance" bson:"substance"`                                          // A relevant substance that the device contains, may contain, or is made of
-	Alternate           *bool            `json:"alternate,omitempty" bson:"alternate,omitempty"`                      // Indicates an alternative material of the device
-	AllergenicIndicator *bool            `json:"allergenicIndicator,omitempty" bson:"allergenic_indicator,omitempty"` // Whether the substance is a known or suspected allergen
+func (r *DeviceDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DeviceDefinition", &issues)
+	checkInvariants(r, "DeviceDefinition", &issues)
+	return issues
 }
 
-func (r *DeviceDefinitionMaterial) Validate() error {
-	if r.Substance == nil {
-		return fmt.Errorf("field 'Substance' is required")
-	}
-	if r.Substance != nil {
-		if err := r.Substance.Validate(); err != nil {
-			return fmt.Errorf("Substance: %w", err)
-		}
-	}
-	return nil
+var deviceDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type DeviceDefinitionGuideline struct {
-	Id               *string           `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	UseContext       []UsageContext    `json:"useContext,omitempty" bson:"use_context,omitempty"`             // The circumstances that form the setting for using the device
-	UsageInstruction *string           `json:"usageInstruction,omitempty" bson:"usage_instruction,omitempty"` // Detailed written and visual directions for the user on how to use the device
-	RelatedArtifact  []RelatedArtifact `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`   // A source of information or reference for this guideline
-	Indication       []CodeableConcept `json:"indication,omitempty" bson:"indication,omitempty"`              // A clinical condition for which the device was designed to be used
-	Contraindication []CodeableConcept `json:"contraindication,omitempty" bson:"contraindication,omitempty"`  // A specific situation when a device should not be used because it may cause harm
-	Warning          []CodeableConcept `json:"warning,omitempty" bson:"warning,omitempty"`                    // Specific hazard alert information that a user needs to know before using the device
-	IntendedUse      *string           `json:"intendedUse,omitempty" bson:"intended_use,omitempty"`           // A description of the general purpose or medical use of the device or its function
+func (r *DeviceDefinition) invariants() []invariant {
+	return deviceDefinitionInvariants
 }
 
-func (r *DeviceDefinitionGuideline) Validate() error {
-	for i, item := range r.UseContext {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("UseContext[%d]: %w", i, err)
+func (r DeviceDefinition) MarshalJSON() ([]byte, error) {
+	type alias DeviceDefinition
+	out := struct {
+		alias
+		OutputLanguage        []*string  `json:"outputLanguage,omitempty"`
+		OutputLanguageElement []*Element `json:"_outputLanguage,omitempty"`
+	}{alias: alias(r)}
+	out.OutputLanguage, out.OutputLanguageElement = alignPrimitiveArray(r.OutputLanguage, r.OutputLanguageElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceDefinition) UnmarshalJSON(data []byte) error {
+	type alias DeviceDefinition
+	aux := struct {
+		*alias
+		Contained      []json.RawMessage `json:"contained,omitempty"`
+		OutputLanguage []*string         `json:"outputLanguage,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.OutputLanguage, err = splitPrimitiveArray(aux.OutputLanguage, r.OutputLanguageElement); err != nil {
+		return fmt.Errorf("outputLanguage: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", deviceDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DeviceDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(deviceDefinitionVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var deviceDefinitionElementTypes = map[string]string{
+	"id":             "id",
+	"implicitRules":  "uri",
+	"language":       "code",
+	"url":            "uri",
+	"description":    "markdown",
+	"purpose":        "markdown",
+	"copyright":      "markdown",
+	"outputLanguage": "code",
+}
+
+func (r *DeviceDefinition) elementTypes() map[string]string {
+	return deviceDefinitionElementTypes
+}
+
+var deviceDefinitionElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DeviceDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DeviceDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DeviceDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DeviceDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DeviceDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "DeviceDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DeviceDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "DeviceDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "DeviceDefinition.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceDefinition.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "DeviceDefinition.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "DeviceDefinition.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "DeviceDefinition.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "DeviceDefinition.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "DeviceDefinition.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "DeviceDefinition.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.approvalDate", Name: "approvalDate", Field: 41, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "DeviceDefinition.lastReviewDate", Name: "lastReviewDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "DeviceDefinition.effectivePeriod", Name: "effectivePeriod", Field: 45, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "DeviceDefinition.partNumber", Name: "partNumber", Field: 46, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.manufacturer", Name: "manufacturer", Field: 48, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "DeviceDefinition.modelNumber", Name: "modelNumber", Field: 49, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier", Name: "udiDeviceIdentifier", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier", Name: "regulatoryIdentifier", Field: 52, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.deviceName", Name: "deviceName", Field: 53, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.classification", Name: "classification", Field: 54, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.conformsTo", Name: "conformsTo", Field: 55, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.hasPart", Name: "hasPart", Field: 56, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.packaging", Name: "packaging", Field: 57, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.deviceVersion", Name: "deviceVersion", Field: 58, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.safety", Name: "safety", Field: 59, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.shelfLifeStorage", Name: "shelfLifeStorage", Field: 60, Min: 0, Max: "*", Types: []string{"ProductShelfLife"}},
+	{Path: "DeviceDefinition.outputLanguage", Name: "outputLanguage", Field: 61, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "DeviceDefinition.property", Name: "property", Field: 63, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.link", Name: "link", Field: 64, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.note", Name: "note", Field: 65, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "DeviceDefinition.material", Name: "material", Field: 66, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.productionIdentifierInUDI", Name: "productionIdentifierInUDI", Field: 67, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.guideline", Name: "guideline", Field: 68, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.correctiveAction", Name: "correctiveAction", Field: 69, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.chargeItem", Name: "chargeItem", Field: 70, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *DeviceDefinition) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionElementMetadata
+}
+
+// DeviceDefinitionVersionAlgorithm is the type of DeviceDefinition.versionAlgorithm[x]. It is implemented by the DeviceDefinitionVersionAlgorithm* variant types.
+type DeviceDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceDefinitionVersionAlgorithm()
+}
+
+// DeviceDefinitionVersionAlgorithmString is the string variant of DeviceDefinitionVersionAlgorithm.
+type DeviceDefinitionVersionAlgorithmString string
+
+func (v DeviceDefinitionVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v DeviceDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DeviceDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v DeviceDefinitionVersionAlgorithmString) isDeviceDefinitionVersionAlgorithm() {}
+
+// DeviceDefinitionVersionAlgorithmCoding is the Coding variant of DeviceDefinitionVersionAlgorithm.
+type DeviceDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v DeviceDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v DeviceDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v DeviceDefinitionVersionAlgorithmCoding) isDeviceDefinitionVersionAlgorithm() {}
+
+var deviceDefinitionVersionAlgorithmVariants = []DeviceDefinitionVersionAlgorithm{
+	DeviceDefinitionVersionAlgorithmString(""),
+	DeviceDefinitionVersionAlgorithmCoding{},
+}
+
+func (r *DeviceDefinition) GetResourceType() string {
+	return "DeviceDefinition"
+}
+
+func (r *DeviceDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DeviceDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DeviceDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DeviceDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DeviceDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DeviceDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DeviceDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DeviceDefinition)(nil)
+
+type DeviceDefinitionUdiDeviceIdentifier struct {
+	Id                            *string                                                 `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
+	Extension                     []Extension                                             `json:"extension,omitempty" bson:"extension,omitempty"`                                      // Additional content defined by implementations
+	ModifierExtension             []Extension                                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                     // Extensions that cannot be ignored even if unrecognized
+	DeviceIdentifier              string                                                  `json:"deviceIdentifier" bson:"device_identifier"`                                           // The identifier that is to be associated with every Device that references this DeviceDefintiion for the issuer and jurisdiction provided in the DeviceDefinition.udiDeviceIdentifier
+	DeviceIdentifierElement       *Element                                                `json:"_deviceIdentifier,omitempty" bson:"device_identifier_element,omitempty"`              // Extensions for deviceIdentifier
+	Issuer                        string                                                  `json:"issuer" bson:"issuer"`                                                                // The organization that assigns the identifier algorithm
+	IssuerElement                 *Element                                                `json:"_issuer,omitempty" bson:"issuer_element,omitempty"`                                   // Extensions for issuer
+	Jurisdiction                  string                                                  `json:"jurisdiction" bson:"jurisdiction"`                                                    // The jurisdiction to which the deviceIdentifier applies
+	JurisdictionElement           *Element                                                `json:"_jurisdiction,omitempty" bson:"jurisdiction_element,omitempty"`                       // Extensions for jurisdiction
+	MarketDistribution            []DeviceDefinitionUdiDeviceIdentifierMarketDistribution `json:"marketDistribution,omitempty" bson:"market_distribution,omitempty"`                   // Indicates whether and when the device is available on the market
+	DeviceIdentifierSystem        *string                                                 `json:"deviceIdentifierSystem,omitempty" bson:"device_identifier_system,omitempty"`          // The namespace for the device identifier value
+	DeviceIdentifierSystemElement *Element                                                `json:"_deviceIdentifierSystem,omitempty" bson:"device_identifier_system_element,omitempty"` // Extensions for deviceIdentifierSystem
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.DeviceIdentifier == emptyString {
+		return fmt.Errorf("field 'DeviceIdentifier' is required")
+	}
+	if r.DeviceIdentifierElement != nil {
+		if err := r.DeviceIdentifierElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceIdentifierElement: %w", err)
+		}
+	}
+	if r.Issuer == emptyString {
+		return fmt.Errorf("field 'Issuer' is required")
+	}
+	if r.IssuerElement != nil {
+		if err := r.IssuerElement.Validate(); err != nil {
+			return fmt.Errorf("IssuerElement: %w", err)
+		}
+	}
+	if r.Jurisdiction == emptyString {
+		return fmt.Errorf("field 'Jurisdiction' is required")
+	}
+	if r.JurisdictionElement != nil {
+		if err := r.JurisdictionElement.Validate(); err != nil {
+			return fmt.Errorf("JurisdictionElement: %w", err)
+		}
+	}
+	for i, item := range r.MarketDistribution {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("MarketDistribution[%d]: %w", i, err)
+		}
+	}
+	if r.DeviceIdentifierSystemElement != nil {
+		if err := r.DeviceIdentifierSystemElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceIdentifierSystemElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.DeviceIdentifier == emptyString {
+		issues.add("required", path+".deviceIdentifier", "field 'DeviceIdentifier' is required")
+	}
+	if r.DeviceIdentifierElement != nil {
+		r.DeviceIdentifierElement.validateAll(path+".deviceIdentifier", issues)
+	}
+	if r.Issuer == emptyString {
+		issues.add("required", path+".issuer", "field 'Issuer' is required")
+	}
+	if r.IssuerElement != nil {
+		r.IssuerElement.validateAll(path+".issuer", issues)
+	}
+	if r.Jurisdiction == emptyString {
+		issues.add("required", path+".jurisdiction", "field 'Jurisdiction' is required")
+	}
+	if r.JurisdictionElement != nil {
+		r.JurisdictionElement.validateAll(path+".jurisdiction", issues)
+	}
+	for i, item := range r.MarketDistribution {
+		item.validateAll(fmt.Sprintf("%s.marketDistribution[%d]", path, i), issues)
+	}
+	if r.DeviceIdentifierSystemElement != nil {
+		r.DeviceIdentifierSystemElement.validateAll(path+".deviceIdentifierSystem", issues)
+	}
+}
+
+var deviceDefinitionUdiDeviceIdentifierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) invariants() []invariant {
+	return deviceDefinitionUdiDeviceIdentifierInvariants
+}
+
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionUdiDeviceIdentifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionUdiDeviceIdentifierElementTypes = map[string]string{
+	"issuer":                 "uri",
+	"jurisdiction":           "uri",
+	"deviceIdentifierSystem": "uri",
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) elementTypes() map[string]string {
+	return deviceDefinitionUdiDeviceIdentifierElementTypes
+}
+
+var deviceDefinitionUdiDeviceIdentifierElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.udiDeviceIdentifier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.deviceIdentifier", Name: "deviceIdentifier", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.issuer", Name: "issuer", Field: 5, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.jurisdiction", Name: "jurisdiction", Field: 7, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.marketDistribution", Name: "marketDistribution", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.deviceIdentifierSystem", Name: "deviceIdentifierSystem", Field: 10, Min: 0, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifier) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionUdiDeviceIdentifierElementMetadata
+}
+
+type DeviceDefinitionUdiDeviceIdentifierMarketDistribution struct {
+	Id                     *string     `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	MarketPeriod           *Period     `json:"marketPeriod" bson:"market_period"`                                    // Begin and end dates for the commercial distribution of the device
+	SubJurisdiction        string      `json:"subJurisdiction" bson:"sub_jurisdiction"`                              // National state or territory where the device is commercialized
+	SubJurisdictionElement *Element    `json:"_subJurisdiction,omitempty" bson:"sub_jurisdiction_element,omitempty"` // Extensions for subJurisdiction
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.MarketPeriod == nil {
+		return fmt.Errorf("field 'MarketPeriod' is required")
+	}
+	if r.MarketPeriod != nil {
+		if err := r.MarketPeriod.Validate(); err != nil {
+			return fmt.Errorf("MarketPeriod: %w", err)
+		}
+	}
+	var emptyString string
+	if r.SubJurisdiction == emptyString {
+		return fmt.Errorf("field 'SubJurisdiction' is required")
+	}
+	if r.SubJurisdictionElement != nil {
+		if err := r.SubJurisdictionElement.Validate(); err != nil {
+			return fmt.Errorf("SubJurisdictionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.MarketPeriod == nil {
+		issues.add("required", path+".marketPeriod", "field 'MarketPeriod' is required")
+	}
+	if r.MarketPeriod != nil {
+		r.MarketPeriod.validateAll(path+".marketPeriod", issues)
+	}
+	var emptyString string
+	if r.SubJurisdiction == emptyString {
+		issues.add("required", path+".subJurisdiction", "field 'SubJurisdiction' is required")
+	}
+	if r.SubJurisdictionElement != nil {
+		r.SubJurisdictionElement.validateAll(path+".subJurisdiction", issues)
+	}
+}
+
+var deviceDefinitionUdiDeviceIdentifierMarketDistributionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) invariants() []invariant {
+	return deviceDefinitionUdiDeviceIdentifierMarketDistributionInvariants
+}
+
+func (r DeviceDefinitionUdiDeviceIdentifierMarketDistribution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionUdiDeviceIdentifierMarketDistribution) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionUdiDeviceIdentifierMarketDistributionElementTypes = map[string]string{
+	"subJurisdiction": "uri",
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) elementTypes() map[string]string {
+	return deviceDefinitionUdiDeviceIdentifierMarketDistributionElementTypes
+}
+
+var deviceDefinitionUdiDeviceIdentifierMarketDistributionElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.udiDeviceIdentifier.marketDistribution.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.marketDistribution.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.marketDistribution.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.marketDistribution.marketPeriod", Name: "marketPeriod", Field: 3, Min: 1, Max: "1", Types: []string{"Period"}},
+	{Path: "DeviceDefinition.udiDeviceIdentifier.marketDistribution.subJurisdiction", Name: "subJurisdiction", Field: 4, Min: 1, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *DeviceDefinitionUdiDeviceIdentifierMarketDistribution) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionUdiDeviceIdentifierMarketDistributionElementMetadata
+}
+
+type DeviceDefinitionRegulatoryIdentifier struct {
+	Id                      *string                                  `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension                              `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Type                    DeviceDefinitionRegulatoryIdentifierType `json:"type" bson:"type"`                                                       // basic | master | license
+	TypeElement             *Element                                 `json:"_type,omitempty" bson:"type_element,omitempty"`                          // Extensions for type
+	Identifier              string                                   `json:"identifier" bson:"identifier"`                                           // The identifier itself
+	IdentifierElement       *Element                                 `json:"_identifier,omitempty" bson:"identifier_element,omitempty"`              // Extensions for identifier
+	Issuer                  string                                   `json:"issuer" bson:"issuer"`                                                   // The organization that issued this identifier
+	IssuerElement           *Element                                 `json:"_issuer,omitempty" bson:"issuer_element,omitempty"`                      // Extensions for issuer
+	Jurisdiction            string                                   `json:"jurisdiction" bson:"jurisdiction"`                                       // Relevant jurisdiction governing the identifier
+	JurisdictionElement     *Element                                 `json:"_jurisdiction,omitempty" bson:"jurisdiction_element,omitempty"`          // Extensions for jurisdiction
+	IdentifierSystem        *string                                  `json:"identifierSystem,omitempty" bson:"identifier_system,omitempty"`          // The namespace for the device identifier value
+	IdentifierSystemElement *Element                                 `json:"_identifierSystem,omitempty" bson:"identifier_system_element,omitempty"` // Extensions for identifierSystem
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	var emptyString string
+	if r.Identifier == emptyString {
+		return fmt.Errorf("field 'Identifier' is required")
+	}
+	if r.IdentifierElement != nil {
+		if err := r.IdentifierElement.Validate(); err != nil {
+			return fmt.Errorf("IdentifierElement: %w", err)
+		}
+	}
+	if r.Issuer == emptyString {
+		return fmt.Errorf("field 'Issuer' is required")
+	}
+	if r.IssuerElement != nil {
+		if err := r.IssuerElement.Validate(); err != nil {
+			return fmt.Errorf("IssuerElement: %w", err)
+		}
+	}
+	if r.Jurisdiction == emptyString {
+		return fmt.Errorf("field 'Jurisdiction' is required")
+	}
+	if r.JurisdictionElement != nil {
+		if err := r.JurisdictionElement.Validate(); err != nil {
+			return fmt.Errorf("JurisdictionElement: %w", err)
+		}
+	}
+	if r.IdentifierSystemElement != nil {
+		if err := r.IdentifierSystemElement.Validate(); err != nil {
+			return fmt.Errorf("IdentifierSystemElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	var emptyString string
+	if r.Identifier == emptyString {
+		issues.add("required", path+".identifier", "field 'Identifier' is required")
+	}
+	if r.IdentifierElement != nil {
+		r.IdentifierElement.validateAll(path+".identifier", issues)
+	}
+	if r.Issuer == emptyString {
+		issues.add("required", path+".issuer", "field 'Issuer' is required")
+	}
+	if r.IssuerElement != nil {
+		r.IssuerElement.validateAll(path+".issuer", issues)
+	}
+	if r.Jurisdiction == emptyString {
+		issues.add("required", path+".jurisdiction", "field 'Jurisdiction' is required")
+	}
+	if r.JurisdictionElement != nil {
+		r.JurisdictionElement.validateAll(path+".jurisdiction", issues)
+	}
+	if r.IdentifierSystemElement != nil {
+		r.IdentifierSystemElement.validateAll(path+".identifierSystem", issues)
+	}
+}
+
+var deviceDefinitionRegulatoryIdentifierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) invariants() []invariant {
+	return deviceDefinitionRegulatoryIdentifierInvariants
+}
+
+func (r DeviceDefinitionRegulatoryIdentifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionRegulatoryIdentifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionRegulatoryIdentifierElementTypes = map[string]string{
+	"issuer":           "uri",
+	"jurisdiction":     "uri",
+	"identifierSystem": "uri",
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) elementTypes() map[string]string {
+	return deviceDefinitionRegulatoryIdentifierElementTypes
+}
+
+var deviceDefinitionRegulatoryIdentifierElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.regulatoryIdentifier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.regulatoryIdentifier.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceDefinition.regulatoryIdentifier.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/devicedefinition-regulatory-identifier-type"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier.identifier", Name: "identifier", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier.issuer", Name: "issuer", Field: 7, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier.jurisdiction", Name: "jurisdiction", Field: 9, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "DeviceDefinition.regulatoryIdentifier.identifierSystem", Name: "identifierSystem", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *DeviceDefinitionRegulatoryIdentifier) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionRegulatoryIdentifierElementMetadata
+}
+
+type DeviceDefinitionDeviceName struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string           `json:"name" bson:"name"`                                                // A name that is used to refer to the device
+	NameElement       *Element         `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // registered-name | user-friendly-name | patient-reported-name
+}
+
+func (r *DeviceDefinitionDeviceName) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionDeviceName) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+}
+
+var deviceDefinitionDeviceNameInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionDeviceName) invariants() []invariant {
+	return deviceDefinitionDeviceNameInvariants
+}
+
+func (r DeviceDefinitionDeviceName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionDeviceName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionDeviceName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionDeviceName) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionDeviceNameElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.deviceName.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.deviceName.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.deviceName.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.deviceName.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.deviceName.type", Name: "type", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *DeviceDefinitionDeviceName) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionDeviceNameElementMetadata
+}
+
+type DeviceDefinitionClassification struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept  `json:"type" bson:"type"`                                                // A classification or risk class of the device model
+	Justification     []RelatedArtifact `json:"justification,omitempty" bson:"justification,omitempty"`          // Further information qualifying this classification of the device model
+}
+
+func (r *DeviceDefinitionClassification) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	for i, item := range r.Justification {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Justification[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionClassification) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Justification {
+		item.validateAll(fmt.Sprintf("%s.justification[%d]", path, i), issues)
+	}
+}
+
+var deviceDefinitionClassificationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionClassification) invariants() []invariant {
+	return deviceDefinitionClassificationInvariants
+}
+
+func (r DeviceDefinitionClassification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionClassification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionClassification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionClassification) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionClassificationElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.classification.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.classification.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.classification.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.classification.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.classification.justification", Name: "justification", Field: 4, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+}
+
+func (r *DeviceDefinitionClassification) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionClassificationElementMetadata
+}
+
+type DeviceDefinitionConformsTo struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category          *CodeableConcept  `json:"category,omitempty" bson:"category,omitempty"`                    // Describes the common type of the standard, specification, or formal guidance
+	Specification     *CodeableConcept  `json:"specification" bson:"specification"`                              // Identifies the standard, specification, or formal guidance that the device adheres to the Device Specification type
+	Version           []string          `json:"version,omitempty" bson:"version,omitempty"`                      // The specific form or variant of the standard, specification or formal guidance
+	VersionElement    []*Element        `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
+	Source            []RelatedArtifact `json:"source,omitempty" bson:"source,omitempty"`                        // Standard, regulation, certification, or guidance website, document, or other publication, or similar, supporting the conformance
+}
+
+func (r *DeviceDefinitionConformsTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Specification == nil {
+		return fmt.Errorf("field 'Specification' is required")
+	}
+	if r.Specification != nil {
+		if err := r.Specification.Validate(); err != nil {
+			return fmt.Errorf("Specification: %w", err)
+		}
+	}
+	for i, item := range r.VersionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("VersionElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Source {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Source[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionConformsTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Specification == nil {
+		issues.add("required", path+".specification", "field 'Specification' is required")
+	}
+	if r.Specification != nil {
+		r.Specification.validateAll(path+".specification", issues)
+	}
+	for i, item := range r.VersionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.version[%d]", path, i), issues)
+	}
+	for i, item := range r.Source {
+		item.validateAll(fmt.Sprintf("%s.source[%d]", path, i), issues)
+	}
+}
+
+var deviceDefinitionConformsToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionConformsTo) invariants() []invariant {
+	return deviceDefinitionConformsToInvariants
+}
+
+func (r DeviceDefinitionConformsTo) MarshalJSON() ([]byte, error) {
+	type alias DeviceDefinitionConformsTo
+	out := struct {
+		alias
+		Version        []*string  `json:"version,omitempty"`
+		VersionElement []*Element `json:"_version,omitempty"`
+	}{alias: alias(r)}
+	out.Version, out.VersionElement = alignPrimitiveArray(r.Version, r.VersionElement)
+	return json.Marshal(out)
+}
+
+func (r *DeviceDefinitionConformsTo) UnmarshalJSON(data []byte) error {
+	type alias DeviceDefinitionConformsTo
+	aux := struct {
+		*alias
+		Version []*string `json:"version,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Version, err = splitPrimitiveArray(aux.Version, r.VersionElement); err != nil {
+		return fmt.Errorf("version: %w", err)
+	}
+	return nil
+}
+
+func (r DeviceDefinitionConformsTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionConformsTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionConformsTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionConformsTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionConformsToElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.conformsTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.conformsTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.conformsTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.conformsTo.category", Name: "category", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.conformsTo.specification", Name: "specification", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.conformsTo.version", Name: "version", Field: 5, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "DeviceDefinition.conformsTo.source", Name: "source", Field: 7, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+}
+
+func (r *DeviceDefinitionConformsTo) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionConformsToElementMetadata
+}
+
+type DeviceDefinitionHasPart struct {
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Definition        DeviceDefinitionHasPartDefinition `json:"-" bson:"definition,omitempty"`                                   // Reference to the part
+	DefinitionElement *Element                          `json:"-" bson:"definition_element,omitempty"`                           // Extensions for definition[x]
+	Count             *int                              `json:"count,omitempty" bson:"count,omitempty"`                          // Number of occurrences of the part
+	CountElement      *Element                          `json:"_count,omitempty" bson:"count_element,omitempty"`                 // Extensions for count
+
+	definitionVariants []string // JSON properties of DeviceDefinition.hasPart.definition[x] when more than one was decoded
+}
+
+func (r *DeviceDefinitionHasPart) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.definitionVariants) > 1 {
+		return fmt.Errorf("field 'Definition' must have a single type, got %v", r.definitionVariants)
+	}
+	if r.Definition == nil {
+		return fmt.Errorf("field 'Definition' is required")
+	}
+	if r.Definition != nil {
+		if err := r.Definition.Validate(); err != nil {
+			return fmt.Errorf("Definition: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionHasPart) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.definitionVariants) > 1 {
+		issues.add("structure", path+".definition", fmt.Sprintf("field 'Definition' must have a single type, got %v", r.definitionVariants))
+	}
+	if r.Definition == nil {
+		issues.add("required", path+".definition", "field 'Definition' is required")
+	}
+	if r.Definition != nil {
+		r.Definition.validateAll(path+".definition.ofType("+r.Definition.FHIRType()+")", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+}
+
+var deviceDefinitionHasPartInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionHasPart) invariants() []invariant {
+	return deviceDefinitionHasPartInvariants
+}
+
+func (r DeviceDefinitionHasPart) MarshalJSON() ([]byte, error) {
+	type alias DeviceDefinitionHasPart
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceDefinitionHasPart) UnmarshalJSON(data []byte) error {
+	type alias DeviceDefinitionHasPart
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Definition, element, r.definitionVariants, err = unmarshalChoice(fields, "definition", deviceDefinitionHasPartDefinitionVariants); err != nil {
+		return err
+	}
+	r.DefinitionElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DefinitionElement); err != nil {
+			return fmt.Errorf("_definition: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DeviceDefinitionHasPart) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionHasPart) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionHasPart) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionHasPart) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceDefinitionHasPart) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Definition":
+		return "definition", choiceValues(deviceDefinitionHasPartDefinitionVariants)
+	}
+	return "", nil
+}
+
+var deviceDefinitionHasPartElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.hasPart.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.hasPart.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.hasPart.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.hasPart.definition[x]", Name: "definition", Field: 3, Min: 1, Max: "1", Types: []string{"canonical", "CodeableConcept"}},
+	{Path: "DeviceDefinition.hasPart.count", Name: "count", Field: 5, Min: 0, Max: "1", Types: []string{"integer"}},
+}
+
+func (r *DeviceDefinitionHasPart) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionHasPartElementMetadata
+}
+
+// DeviceDefinitionHasPartDefinition is the type of DeviceDefinition.hasPart.definition[x]. It is implemented by the DeviceDefinitionHasPartDefinition* variant types.
+type DeviceDefinitionHasPartDefinition interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceDefinitionHasPartDefinition()
+}
+
+// DeviceDefinitionHasPartDefinitionCanonical is the canonical variant of DeviceDefinitionHasPartDefinition.
+type DeviceDefinitionHasPartDefinitionCanonical string
+
+func (v DeviceDefinitionHasPartDefinitionCanonical) Validate() error {
+	return nil
+}
+
+func (v DeviceDefinitionHasPartDefinitionCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v DeviceDefinitionHasPartDefinitionCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v DeviceDefinitionHasPartDefinitionCanonical) isDeviceDefinitionHasPartDefinition() {}
+
+// DeviceDefinitionHasPartDefinitionCodeableConcept is the CodeableConcept variant of DeviceDefinitionHasPartDefinition.
+type DeviceDefinitionHasPartDefinitionCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DeviceDefinitionHasPartDefinitionCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DeviceDefinitionHasPartDefinitionCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionHasPartDefinitionCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DeviceDefinitionHasPartDefinitionCodeableConcept) isDeviceDefinitionHasPartDefinition() {}
+
+var deviceDefinitionHasPartDefinitionVariants = []DeviceDefinitionHasPartDefinition{
+	DeviceDefinitionHasPartDefinitionCanonical(""),
+	DeviceDefinitionHasPartDefinitionCodeableConcept{},
+}
+
+type DeviceDefinitionPackaging struct {
+	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension           []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension   []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	Identifier          *Identifier                            `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Business identifier of the packaged medication
+	Type                *CodeableConcept                       `json:"type,omitempty" bson:"type,omitempty"`                                 // A code that defines the specific type of packaging
+	Count               *int                                   `json:"count,omitempty" bson:"count,omitempty"`                               // The number of items contained in the package (devices or sub-packages)
+	CountElement        *Element                               `json:"_count,omitempty" bson:"count_element,omitempty"`                      // Extensions for count
+	Distributor         []DeviceDefinitionPackagingDistributor `json:"distributor,omitempty" bson:"distributor,omitempty"`                   // An organization that distributes the packaged device
+	UdiDeviceIdentifier []DeviceDefinitionUdiDeviceIdentifier  `json:"udiDeviceIdentifier,omitempty" bson:"udi_device_identifier,omitempty"` // Unique Device Identifier (UDI) Barcode string on the packaging
+	Packaging           []DeviceDefinitionPackaging            `json:"packaging,omitempty" bson:"packaging,omitempty"`                       // Allows packages within packages
+}
+
+func (r *DeviceDefinitionPackaging) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Identifier != nil {
+		if err := r.Identifier.Validate(); err != nil {
+			return fmt.Errorf("Identifier: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
+		}
+	}
+	for i, item := range r.Distributor {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Distributor[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.UdiDeviceIdentifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("UdiDeviceIdentifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Packaging {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Packaging[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionPackaging) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+	for i, item := range r.Distributor {
+		item.validateAll(fmt.Sprintf("%s.distributor[%d]", path, i), issues)
+	}
+	for i, item := range r.UdiDeviceIdentifier {
+		item.validateAll(fmt.Sprintf("%s.udiDeviceIdentifier[%d]", path, i), issues)
+	}
+	for i, item := range r.Packaging {
+		item.validateAll(fmt.Sprintf("%s.packaging[%d]", path, i), issues)
+	}
+}
+
+var deviceDefinitionPackagingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionPackaging) invariants() []invariant {
+	return deviceDefinitionPackagingInvariants
+}
+
+func (r DeviceDefinitionPackaging) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionPackaging) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionPackaging) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionPackaging) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionPackagingElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.packaging.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.packaging.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.packaging.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.packaging.identifier", Name: "identifier", Field: 3, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "DeviceDefinition.packaging.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.packaging.count", Name: "count", Field: 5, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "DeviceDefinition.packaging.distributor", Name: "distributor", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.packaging.udiDeviceIdentifier", Name: "udiDeviceIdentifier", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceDefinition.packaging.packaging", Name: "packaging", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *DeviceDefinitionPackaging) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionPackagingElementMetadata
+}
+
+type DeviceDefinitionPackagingDistributor struct {
+	Id                    *string     `json:"id,omitempty" bson:"id,omitempty"`                                        // Unique id for inter-element referencing
+	Extension             []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension     []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored even if unrecognized
+	Name                  *string     `json:"name,omitempty" bson:"name,omitempty"`                                    // Distributor's human-readable name
+	NameElement           *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                           // Extensions for name
+	OrganizationReference []Reference `json:"organizationReference,omitempty" bson:"organization_reference,omitempty"` // Distributor as an Organization resource
+}
+
+func (r *DeviceDefinitionPackagingDistributor) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	for i, item := range r.OrganizationReference {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OrganizationReference[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionPackagingDistributor) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	for i, item := range r.OrganizationReference {
+		item.validateAll(fmt.Sprintf("%s.organizationReference[%d]", path, i), issues)
+	}
+}
+
+var deviceDefinitionPackagingDistributorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionPackagingDistributor) invariants() []invariant {
+	return deviceDefinitionPackagingDistributorInvariants
+}
+
+func (r DeviceDefinitionPackagingDistributor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionPackagingDistributor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionPackagingDistributor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionPackagingDistributor) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionPackagingDistributorElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.packaging.distributor.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.packaging.distributor.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.packaging.distributor.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.packaging.distributor.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.packaging.distributor.organizationReference", Name: "organizationReference", Field: 5, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *DeviceDefinitionPackagingDistributor) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionPackagingDistributorElementMetadata
+}
+
+type DeviceDefinitionDeviceVersion struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // The type of the device version, e.g. manufacturer, approved, internal
+	Component         *Identifier      `json:"component,omitempty" bson:"component,omitempty"`                  // The hardware or software module of the device to which the version applies
+	Value             string           `json:"value" bson:"value"`                                              // The version text
+	ValueElement      *Element         `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *DeviceDefinitionDeviceVersion) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Component != nil {
+		if err := r.Component.Validate(); err != nil {
+			return fmt.Errorf("Component: %w", err)
+		}
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionDeviceVersion) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Component != nil {
+		r.Component.validateAll(path+".component", issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var deviceDefinitionDeviceVersionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionDeviceVersion) invariants() []invariant {
+	return deviceDefinitionDeviceVersionInvariants
+}
+
+func (r DeviceDefinitionDeviceVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionDeviceVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionDeviceVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionDeviceVersion) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionDeviceVersionElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.deviceVersion.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.deviceVersion.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.deviceVersion.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.deviceVersion.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.deviceVersion.component", Name: "component", Field: 4, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "DeviceDefinition.deviceVersion.value", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *DeviceDefinitionDeviceVersion) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionDeviceVersionElementMetadata
+}
+
+type DeviceDefinitionProperty struct {
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept              `json:"type" bson:"type"`                                                // Code that specifies the property being represented
+	Value             DeviceDefinitionPropertyValue `json:"-" bson:"value,omitempty"`                                        // Value of the property
+	ValueElement      *Element                      `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of DeviceDefinition.property.value[x] when more than one was decoded
+}
+
+func (r *DeviceDefinitionProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var deviceDefinitionPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionProperty) invariants() []invariant {
+	return deviceDefinitionPropertyInvariants
+}
+
+func (r DeviceDefinitionProperty) MarshalJSON() ([]byte, error) {
+	type alias DeviceDefinitionProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceDefinitionProperty) UnmarshalJSON(data []byte) error {
+	type alias DeviceDefinitionProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", deviceDefinitionPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DeviceDefinitionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceDefinitionProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(deviceDefinitionPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var deviceDefinitionPropertyElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.property.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Attachment"}},
+}
+
+func (r *DeviceDefinitionProperty) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionPropertyElementMetadata
+}
+
+// DeviceDefinitionPropertyValue is the type of DeviceDefinition.property.value[x]. It is implemented by the DeviceDefinitionPropertyValue* variant types.
+type DeviceDefinitionPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceDefinitionPropertyValue()
+}
+
+// DeviceDefinitionPropertyValueQuantity is the Quantity variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueQuantity struct {
+	Quantity
+}
+
+func (v DeviceDefinitionPropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DeviceDefinitionPropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionPropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DeviceDefinitionPropertyValueQuantity) isDeviceDefinitionPropertyValue() {}
+
+// DeviceDefinitionPropertyValueCodeableConcept is the CodeableConcept variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DeviceDefinitionPropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DeviceDefinitionPropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionPropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DeviceDefinitionPropertyValueCodeableConcept) isDeviceDefinitionPropertyValue() {}
+
+// DeviceDefinitionPropertyValueString is the string variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueString string
+
+func (v DeviceDefinitionPropertyValueString) Validate() error {
+	return nil
+}
+
+func (v DeviceDefinitionPropertyValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DeviceDefinitionPropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v DeviceDefinitionPropertyValueString) isDeviceDefinitionPropertyValue() {}
+
+// DeviceDefinitionPropertyValueBoolean is the boolean variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueBoolean bool
+
+func (v DeviceDefinitionPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v DeviceDefinitionPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DeviceDefinitionPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v DeviceDefinitionPropertyValueBoolean) isDeviceDefinitionPropertyValue() {}
+
+// DeviceDefinitionPropertyValueInteger is the integer variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueInteger int
+
+func (v DeviceDefinitionPropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v DeviceDefinitionPropertyValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DeviceDefinitionPropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v DeviceDefinitionPropertyValueInteger) isDeviceDefinitionPropertyValue() {}
+
+// DeviceDefinitionPropertyValueRange is the Range variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueRange struct {
+	Range
+}
+
+func (v DeviceDefinitionPropertyValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v DeviceDefinitionPropertyValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionPropertyValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v DeviceDefinitionPropertyValueRange) isDeviceDefinitionPropertyValue() {}
+
+// DeviceDefinitionPropertyValueAttachment is the Attachment variant of DeviceDefinitionPropertyValue.
+type DeviceDefinitionPropertyValueAttachment struct {
+	Attachment
+}
+
+func (v DeviceDefinitionPropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v DeviceDefinitionPropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionPropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v DeviceDefinitionPropertyValueAttachment) isDeviceDefinitionPropertyValue() {}
+
+var deviceDefinitionPropertyValueVariants = []DeviceDefinitionPropertyValue{
+	DeviceDefinitionPropertyValueQuantity{},
+	DeviceDefinitionPropertyValueCodeableConcept{},
+	DeviceDefinitionPropertyValueString(""),
+	DeviceDefinitionPropertyValueBoolean(false),
+	DeviceDefinitionPropertyValueInteger(0),
+	DeviceDefinitionPropertyValueRange{},
+	DeviceDefinitionPropertyValueAttachment{},
+}
+
+type DeviceDefinitionLink struct {
+	Id                   *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Relation             *Coding                           `json:"relation" bson:"relation"`                                        // The type indicates the relationship of the related device to the device instance
+	RelatedDevice        DeviceDefinitionLinkRelatedDevice `json:"-" bson:"related_device,omitempty"`                               // A reference to the linked device
+	RelatedDeviceElement *Element                          `json:"-" bson:"related_device_element,omitempty"`                       // Extensions for relatedDevice[x]
+
+	relatedDeviceVariants []string // JSON properties of DeviceDefinition.link.relatedDevice[x] when more than one was decoded
+}
+
+func (r *DeviceDefinitionLink) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Relation == nil {
+		return fmt.Errorf("field 'Relation' is required")
+	}
+	if r.Relation != nil {
+		if err := r.Relation.Validate(); err != nil {
+			return fmt.Errorf("Relation: %w", err)
+		}
+	}
+	if len(r.relatedDeviceVariants) > 1 {
+		return fmt.Errorf("field 'RelatedDevice' must have a single type, got %v", r.relatedDeviceVariants)
+	}
+	if r.RelatedDevice == nil {
+		return fmt.Errorf("field 'RelatedDevice' is required")
+	}
+	if r.RelatedDevice != nil {
+		if err := r.RelatedDevice.Validate(); err != nil {
+			return fmt.Errorf("RelatedDevice: %w", err)
+		}
+	}
+	if r.RelatedDeviceElement != nil {
+		if err := r.RelatedDeviceElement.Validate(); err != nil {
+			return fmt.Errorf("RelatedDeviceElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionLink) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Relation == nil {
+		issues.add("required", path+".relation", "field 'Relation' is required")
+	}
+	if r.Relation != nil {
+		r.Relation.validateAll(path+".relation", issues)
+	}
+	if len(r.relatedDeviceVariants) > 1 {
+		issues.add("structure", path+".relatedDevice", fmt.Sprintf("field 'RelatedDevice' must have a single type, got %v", r.relatedDeviceVariants))
+	}
+	if r.RelatedDevice == nil {
+		issues.add("required", path+".relatedDevice", "field 'RelatedDevice' is required")
+	}
+	if r.RelatedDevice != nil {
+		r.RelatedDevice.validateAll(path+".relatedDevice.ofType("+r.RelatedDevice.FHIRType()+")", issues)
+	}
+	if r.RelatedDeviceElement != nil {
+		r.RelatedDeviceElement.validateAll(path+".relatedDevice", issues)
+	}
+}
+
+var deviceDefinitionLinkInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionLink) invariants() []invariant {
+	return deviceDefinitionLinkInvariants
+}
+
+func (r DeviceDefinitionLink) MarshalJSON() ([]byte, error) {
+	type alias DeviceDefinitionLink
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "relatedDevice", r.RelatedDevice, r.RelatedDeviceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceDefinitionLink) UnmarshalJSON(data []byte) error {
+	type alias DeviceDefinitionLink
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.RelatedDevice, element, r.relatedDeviceVariants, err = unmarshalChoice(fields, "relatedDevice", deviceDefinitionLinkRelatedDeviceVariants); err != nil {
+		return err
+	}
+	r.RelatedDeviceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.RelatedDeviceElement); err != nil {
+			return fmt.Errorf("_relatedDevice: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DeviceDefinitionLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionLink) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceDefinitionLink) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "RelatedDevice":
+		return "relatedDevice", choiceValues(deviceDefinitionLinkRelatedDeviceVariants)
+	}
+	return "", nil
+}
+
+var deviceDefinitionLinkElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.link.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.link.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.link.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.link.relation", Name: "relation", Field: 3, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "DeviceDefinition.link.relatedDevice[x]", Name: "relatedDevice", Field: 4, Min: 1, Max: "1", Types: []string{"canonical", "CodeableConcept"}},
+}
+
+func (r *DeviceDefinitionLink) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionLinkElementMetadata
+}
+
+// DeviceDefinitionLinkRelatedDevice is the type of DeviceDefinition.link.relatedDevice[x]. It is implemented by the DeviceDefinitionLinkRelatedDevice* variant types.
+type DeviceDefinitionLinkRelatedDevice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceDefinitionLinkRelatedDevice()
+}
+
+// DeviceDefinitionLinkRelatedDeviceCanonical is the canonical variant of DeviceDefinitionLinkRelatedDevice.
+type DeviceDefinitionLinkRelatedDeviceCanonical string
+
+func (v DeviceDefinitionLinkRelatedDeviceCanonical) Validate() error {
+	return nil
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCanonical) isDeviceDefinitionLinkRelatedDevice() {}
+
+// DeviceDefinitionLinkRelatedDeviceCodeableConcept is the CodeableConcept variant of DeviceDefinitionLinkRelatedDevice.
+type DeviceDefinitionLinkRelatedDeviceCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DeviceDefinitionLinkRelatedDeviceCodeableConcept) isDeviceDefinitionLinkRelatedDevice() {}
+
+var deviceDefinitionLinkRelatedDeviceVariants = []DeviceDefinitionLinkRelatedDevice{
+	DeviceDefinitionLinkRelatedDeviceCanonical(""),
+	DeviceDefinitionLinkRelatedDeviceCodeableConcept{},
+}
+
+type DeviceDefinitionMaterial struct {
+	Id                         *string          `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	Substance                  *CodeableConcept `json:"substance" bson:"substance"`                                                   // A relevant substance that the device contains, may contain, or is made of
+	Alternate                  *bool            `json:"alternate,omitempty" bson:"alternate,omitempty"`                               // Indicates an alternative material of the device
+	AlternateElement           *Element         `json:"_alternate,omitempty" bson:"alternate_element,omitempty"`                      // Extensions for alternate
+	AllergenicIndicator        *bool            `json:"allergenicIndicator,omitempty" bson:"allergenic_indicator,omitempty"`          // Whether the substance is a known or suspected allergen
+	AllergenicIndicatorElement *Element         `json:"_allergenicIndicator,omitempty" bson:"allergenic_indicator_element,omitempty"` // Extensions for allergenicIndicator
+}
+
+func (r *DeviceDefinitionMaterial) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Substance == nil {
+		return fmt.Errorf("field 'Substance' is required")
+	}
+	if r.Substance != nil {
+		if err := r.Substance.Validate(); err != nil {
+			return fmt.Errorf("Substance: %w", err)
+		}
+	}
+	if r.AlternateElement != nil {
+		if err := r.AlternateElement.Validate(); err != nil {
+			return fmt.Errorf("AlternateElement: %w", err)
+		}
+	}
+	if r.AllergenicIndicatorElement != nil {
+		if err := r.AllergenicIndicatorElement.Validate(); err != nil {
+			return fmt.Errorf("AllergenicIndicatorElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DeviceDefinitionMaterial) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Substance == nil {
+		issues.add("required", path+".substance", "field 'Substance' is required")
+	}
+	if r.Substance != nil {
+		r.Substance.validateAll(path+".substance", issues)
+	}
+	if r.AlternateElement != nil {
+		r.AlternateElement.validateAll(path+".alternate", issues)
+	}
+	if r.AllergenicIndicatorElement != nil {
+		r.AllergenicIndicatorElement.validateAll(path+".allergenicIndicator", issues)
+	}
+}
+
+var deviceDefinitionMaterialInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionMaterial) invariants() []invariant {
+	return deviceDefinitionMaterialInvariants
+}
+
+func (r DeviceDefinitionMaterial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionMaterial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionMaterial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionMaterial) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionMaterialElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.material.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.material.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.material.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.material.substance", Name: "substance", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.material.alternate", Name: "alternate", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceDefinition.material.allergenicIndicator", Name: "allergenicIndicator", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *DeviceDefinitionMaterial) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionMaterialElementMetadata
+}
+
+type DeviceDefinitionGuideline struct {
+	Id                      *string           `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	UseContext              []UsageContext    `json:"useContext,omitempty" bson:"use_context,omitempty"`                      // The circumstances that form the setting for using the device
+	UsageInstruction        *string           `json:"usageInstruction,omitempty" bson:"usage_instruction,omitempty"`          // Detailed written and visual directions for the user on how to use the device
+	UsageInstructionElement *Element          `json:"_usageInstruction,omitempty" bson:"usage_instruction_element,omitempty"` // Extensions for usageInstruction
+	RelatedArtifact         []RelatedArtifact `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`            // A source of information or reference for this guideline
+	Indication              []CodeableConcept `json:"indication,omitempty" bson:"indication,omitempty"`                       // A clinical condition for which the device was designed to be used
+	Contraindication        []CodeableConcept `json:"contraindication,omitempty" bson:"contraindication,omitempty"`           // A specific situation when a device should not be used because it may cause harm
+	Warning                 []CodeableConcept `json:"warning,omitempty" bson:"warning,omitempty"`                             // Specific hazard alert information that a user needs to know before using the device
+	IntendedUse             *string           `json:"intendedUse,omitempty" bson:"intended_use,omitempty"`                    // A description of the general purpose or medical use of the device or its function
+	IntendedUseElement      *Element          `json:"_intendedUse,omitempty" bson:"intended_use_element,omitempty"`           // Extensions for intendedUse
+}
+
+func (r *DeviceDefinitionGuideline) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.UseContext {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("UseContext[%d]: %w", i, err)
+		}
+	}
+	if r.UsageInstructionElement != nil {
+		if err := r.UsageInstructionElement.Validate(); err != nil {
+			return fmt.Errorf("UsageInstructionElement: %w", err)
 		}
 	}
 	for i, item := range r.RelatedArtifact {
@@ -431,147 +2751,221 @@ func (r *DeviceDefinitionGuideline) Validate() error {
 			return fmt.Errorf("Warning[%d]: %w", i, err)
 		}
 	}
+	if r.IntendedUseElement != nil {
+		if err := r.IntendedUseElement.Validate(); err != nil {
+			return fmt.Errorf("IntendedUseElement: %w", err)
+		}
+	}
 	return nil
 }
 
-type DeviceDefinitionCorrectiveAction struct {
-	Id     *string `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Recall bool    `json:"recall" bson:"recall"`                   // Whether the corrective action was a recall
-	Scope  *string `json:"scope,omitempty" bson:"scope,omitempty"` // model | lot-numbers | serial-numbers
-	Period *Period `json:"period" bson:"period"`                   // Start and end dates of the  corrective action
-}
-
-func (r *DeviceDefinitionCorrectiveAction) Validate() error {
-	if r.Period == nil {
-		return fmt.Errorf("field 'Period' is required")
+func (r *DeviceDefinitionGuideline) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Period != nil {
-		if err := r.Period.Validate(); err != nil {
-			return fmt.Errorf("Period: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	if r.UsageInstructionElement != nil {
+		r.UsageInstructionElement.validateAll(path+".usageInstruction", issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	for i, item := range r.Indication {
+		item.validateAll(fmt.Sprintf("%s.indication[%d]", path, i), issues)
+	}
+	for i, item := range r.Contraindication {
+		item.validateAll(fmt.Sprintf("%s.contraindication[%d]", path, i), issues)
+	}
+	for i, item := range r.Warning {
+		item.validateAll(fmt.Sprintf("%s.warning[%d]", path, i), issues)
+	}
+	if r.IntendedUseElement != nil {
+		r.IntendedUseElement.validateAll(path+".intendedUse", issues)
 	}
-	return nil
 }
 
-type DeviceDefinitionClassification struct {
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Type          *CodeableConcept  `json:"type" bson:"type"`                                       // A classification or risk class of the device model
-	Justification []RelatedArtifact `json:"justification,omitempty" bson:"justification,omitempty"` // Further information qualifying this classification of the device model
+var deviceDefinitionGuidelineInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *DeviceDefinitionClassification) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	for i, item := range r.Justification {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Justification[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *DeviceDefinitionGuideline) invariants() []invariant {
+	return deviceDefinitionGuidelineInvariants
 }
 
-type DeviceDefinitionHasPart struct {
-	Id                        *string          `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
-	DefinitionCanonical       *string          `json:"definitionCanonical" bson:"definition_canonical"`              // Reference to the part
-	DefinitionCodeableConcept *CodeableConcept `json:"definitionCodeableConcept" bson:"definition_codeable_concept"` // Reference to the part
-	Count                     *int             `json:"count,omitempty" bson:"count,omitempty"`                       // Number of occurrences of the part
+func (r DeviceDefinitionGuideline) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *DeviceDefinitionHasPart) Validate() error {
-	if r.DefinitionCanonical == nil {
-		return fmt.Errorf("field 'DefinitionCanonical' is required")
-	}
-	if r.DefinitionCodeableConcept == nil {
-		return fmt.Errorf("field 'DefinitionCodeableConcept' is required")
-	}
-	if r.DefinitionCodeableConcept != nil {
-		if err := r.DefinitionCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DefinitionCodeableConcept: %w", err)
-		}
-	}
-	return nil
+func (r *DeviceDefinitionGuideline) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type DeviceDefinitionProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                   // Code that specifies the property being represented
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // Value of the property
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // Value of the property
-	ValueString          *string          `json:"valueString" bson:"value_string"`                    // Value of the property
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // Value of the property
-	ValueInteger         *int             `json:"valueInteger" bson:"value_integer"`                  // Value of the property
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // Value of the property
-	ValueAttachment      *Attachment      `json:"valueAttachment" bson:"value_attachment"`            // Value of the property
+func (r DeviceDefinitionGuideline) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *DeviceDefinitionProperty) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *DeviceDefinitionGuideline) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionGuidelineElementTypes = map[string]string{
+	"usageInstruction": "markdown",
+}
+
+func (r *DeviceDefinitionGuideline) elementTypes() map[string]string {
+	return deviceDefinitionGuidelineElementTypes
+}
+
+var deviceDefinitionGuidelineElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.guideline.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.guideline.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.guideline.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.guideline.useContext", Name: "useContext", Field: 3, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "DeviceDefinition.guideline.usageInstruction", Name: "usageInstruction", Field: 4, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "DeviceDefinition.guideline.relatedArtifact", Name: "relatedArtifact", Field: 6, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "DeviceDefinition.guideline.indication", Name: "indication", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.guideline.contraindication", Name: "contraindication", Field: 8, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.guideline.warning", Name: "warning", Field: 9, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceDefinition.guideline.intendedUse", Name: "intendedUse", Field: 10, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *DeviceDefinitionGuideline) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionGuidelineElementMetadata
+}
+
+type DeviceDefinitionCorrectiveAction struct {
+	Id                *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Recall            bool                         `json:"recall" bson:"recall"`                                            // Whether the corrective action was a recall
+	RecallElement     *Element                     `json:"_recall,omitempty" bson:"recall_element,omitempty"`               // Extensions for recall
+	Scope             *DeviceCorrectiveActionScope `json:"scope,omitempty" bson:"scope,omitempty"`                          // model | lot-numbers | serial-numbers
+	ScopeElement      *Element                     `json:"_scope,omitempty" bson:"scope_element,omitempty"`                 // Extensions for scope
+	Period            *Period                      `json:"period" bson:"period"`                                            // Start and end dates of the  corrective action
+}
+
+func (r *DeviceDefinitionCorrectiveAction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.RecallElement != nil {
+		if err := r.RecallElement.Validate(); err != nil {
+			return fmt.Errorf("RecallElement: %w", err)
+		}
+	}
+	if r.Scope != nil && !r.Scope.IsValid() {
+		return fmt.Errorf("field 'Scope' has invalid code '%s'", *r.Scope)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.ScopeElement != nil {
+		if err := r.ScopeElement.Validate(); err != nil {
+			return fmt.Errorf("ScopeElement: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if r.Period == nil {
+		return fmt.Errorf("field 'Period' is required")
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	return nil
+}
+
+func (r *DeviceDefinitionCorrectiveAction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.RecallElement != nil {
+		r.RecallElement.validateAll(path+".recall", issues)
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.Scope != nil && !r.Scope.IsValid() {
+		issues.add("code-invalid", path+".scope", fmt.Sprintf("field 'Scope' has invalid code '%s'", *r.Scope))
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.ScopeElement != nil {
+		r.ScopeElement.validateAll(path+".scope", issues)
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	if r.Period == nil {
+		issues.add("required", path+".period", "field 'Period' is required")
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
 	}
-	return nil
+}
+
+var deviceDefinitionCorrectiveActionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceDefinitionCorrectiveAction) invariants() []invariant {
+	return deviceDefinitionCorrectiveActionInvariants
+}
+
+func (r DeviceDefinitionCorrectiveAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceDefinitionCorrectiveAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionCorrectiveAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionCorrectiveAction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionCorrectiveActionElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.correctiveAction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.correctiveAction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.correctiveAction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.correctiveAction.recall", Name: "recall", Field: 3, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceDefinition.correctiveAction.scope", Name: "scope", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceDefinition.correctiveAction.scope", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/device-correctiveactionscope"}},
+	{Path: "DeviceDefinition.correctiveAction.period", Name: "period", Field: 7, Min: 1, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *DeviceDefinitionCorrectiveAction) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionCorrectiveActionElementMetadata
 }
 
 type DeviceDefinitionChargeItem struct {
-	Id              *string            `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	ChargeItemCode  *CodeableReference `json:"chargeItemCode" bson:"charge_item_code"`                      // The code or reference for the charge item
-	Count           *Quantity          `json:"count" bson:"count"`                                          // Coefficient applicable to the billing code
-	EffectivePeriod *Period            `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"` // A specific time period in which this charge item applies
-	UseContext      []UsageContext     `json:"useContext,omitempty" bson:"use_context,omitempty"`           // The context to which this charge item applies
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	ChargeItemCode    *CodeableReference `json:"chargeItemCode" bson:"charge_item_code"`                          // The code or reference for the charge item
+	Count             *Quantity          `json:"count" bson:"count"`                                              // Coefficient applicable to the billing code
+	EffectivePeriod   *Period            `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`     // A specific time period in which this charge item applies
+	UseContext        []UsageContext     `json:"useContext,omitempty" bson:"use_context,omitempty"`               // The context to which this charge item applies
 }
 
 func (r *DeviceDefinitionChargeItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.ChargeItemCode == nil {
 		return fmt.Errorf("field 'ChargeItemCode' is required")
 	}
@@ -601,69 +2995,67 @@ func (r *DeviceDefinitionChargeItem) Validate() error {
 	return nil
 }
 
-type DeviceDefinitionLink struct {
-	Id                           *string          `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Relation                     *Coding          `json:"relation" bson:"relation"`                                            // The type indicates the relationship of the related device to the device instance
-	RelatedDeviceCanonical       *string          `json:"relatedDeviceCanonical" bson:"related_device_canonical"`              // A reference to the linked device
-	RelatedDeviceCodeableConcept *CodeableConcept `json:"relatedDeviceCodeableConcept" bson:"related_device_codeable_concept"` // A reference to the linked device
-}
-
-func (r *DeviceDefinitionLink) Validate() error {
-	if r.Relation == nil {
-		return fmt.Errorf("field 'Relation' is required")
+func (r *DeviceDefinitionChargeItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Relation != nil {
-		if err := r.Relation.Validate(); err != nil {
-			return fmt.Errorf("Relation: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.RelatedDeviceCanonical == nil {
-		return fmt.Errorf("field 'RelatedDeviceCanonical' is required")
+	if r.ChargeItemCode == nil {
+		issues.add("required", path+".chargeItemCode", "field 'ChargeItemCode' is required")
 	}
-	if r.RelatedDeviceCodeableConcept == nil {
-		return fmt.Errorf("field 'RelatedDeviceCodeableConcept' is required")
+	if r.ChargeItemCode != nil {
+		r.ChargeItemCode.validateAll(path+".chargeItemCode", issues)
 	}
-	if r.RelatedDeviceCodeableConcept != nil {
-		if err := r.RelatedDeviceCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("RelatedDeviceCodeableConcept: %w", err)
-		}
+	if r.Count == nil {
+		issues.add("required", path+".count", "field 'Count' is required")
+	}
+	if r.Count != nil {
+		r.Count.validateAll(path+".count", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type DeviceDefinitionDeviceName struct {
-	Id   *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Name string           `json:"name" bson:"name"`                 // A name that is used to refer to the device
-	Type *CodeableConcept `json:"type" bson:"type"`                 // registered-name | user-friendly-name | patient-reported-name
+var deviceDefinitionChargeItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *DeviceDefinitionDeviceName) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	return nil
+func (r *DeviceDefinitionChargeItem) invariants() []invariant {
+	return deviceDefinitionChargeItemInvariants
 }
 
-type DeviceDefinitionPackagingDistributor struct {
-	Id                    *string     `json:"id,omitempty" bson:"id,omitempty"`                                        // Unique id for inter-element referencing
-	Name                  *string     `json:"name,omitempty" bson:"name,omitempty"`                                    // Distributor's human-readable name
-	OrganizationReference []Reference `json:"organizationReference,omitempty" bson:"organization_reference,omitempty"` // Distributor as an Organization resource
+func (r DeviceDefinitionChargeItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *DeviceDefinitionPackagingDistributor) Validate() error {
-	for i, item := range r.OrganizationReference {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("OrganizationReference[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *DeviceDefinitionChargeItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceDefinitionChargeItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceDefinitionChargeItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceDefinitionChargeItemElementMetadata = []ElementMetadata{
+	{Path: "DeviceDefinition.chargeItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceDefinition.chargeItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceDefinition.chargeItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceDefinition.chargeItem.chargeItemCode", Name: "chargeItemCode", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "DeviceDefinition.chargeItem.count", Name: "count", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity"}},
+	{Path: "DeviceDefinition.chargeItem.effectivePeriod", Name: "effectivePeriod", Field: 5, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "DeviceDefinition.chargeItem.useContext", Name: "useContext", Field: 6, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+}
+
+func (r *DeviceDefinitionChargeItem) ElementMetadata() []ElementMetadata {
+	return deviceDefinitionChargeItemElementMetadata
 }
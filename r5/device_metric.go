@@ -2,31 +2,41 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Describes a measurement, calculation or setting capability of a device. The DeviceMetric resource is derived from the ISO/IEEE 11073-10201 Domain Information Model standard, but is more widely applicable.
 type DeviceMetric struct {
-	ResourceType         string                    `json:"resourceType" bson:"resource_type"`                                     // Type of resource
-	Id                   *string                   `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
-	Meta                 *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
-	ImplicitRules        *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
-	Language             *string                   `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
-	Text                 *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage         `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
-	Identifier           []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // Instance identifier
-	Status               string                    `json:"status" bson:"status"`                                                  // active | inactive | entered-in-error | unknown
-	OperationalStatus    *string                   `json:"operationalStatus,omitempty" bson:"operational_status,omitempty"`       // on | off | standby | unknown
-	Category             *CodeableConcept          `json:"category" bson:"category"`                                              // The kind of metric represented
-	Type                 *CodeableConcept          `json:"type" bson:"type"`                                                      // Identity of metric, for example Heart Rate or PEEP Setting
-	Device               *Reference                `json:"device" bson:"device"`                                                  // The device to which this DeviceMetric applies
-	Unit                 *CodeableConcept          `json:"unit,omitempty" bson:"unit,omitempty"`                                  // Unit of Measure for the Metric
-	Color                *string                   `json:"color,omitempty" bson:"color,omitempty"`                                // Color name (from CSS4) or #RRGGBB code
-	MeasurementFrequency *Quantity                 `json:"measurementFrequency,omitempty" bson:"measurement_frequency,omitempty"` // Indicates how often the metric is taken or recorded
-	Availability         *CodeableConcept          `json:"availability,omitempty" bson:"availability,omitempty"`                  // The continuity of the metric (e.g., measurement)
-	Calibration          []DeviceMetricCalibration `json:"calibration,omitempty" bson:"calibration,omitempty"`                    // Describes the calibrations that have been performed or that are required to be performed
+	ResourceType             string                         `json:"resourceType" bson:"resource_type"`                                        // Type of resource
+	Id                       *string                        `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
+	Meta                     *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
+	ImplicitRules            *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement     *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
+	Language                 *string                        `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement          *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
+	Text                     *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
+	Contained                []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension                []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
+	Identifier               []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Instance identifier
+	Status                   DeviceMetricStatus             `json:"status" bson:"status"`                                                     // active | inactive | entered-in-error | unknown
+	StatusElement            *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
+	OperationalStatus        *DeviceMetricOperationalStatus `json:"operationalStatus,omitempty" bson:"operational_status,omitempty"`          // on | off | standby | unknown
+	OperationalStatusElement *Element                       `json:"_operationalStatus,omitempty" bson:"operational_status_element,omitempty"` // Extensions for operationalStatus
+	Category                 *CodeableConcept               `json:"category" bson:"category"`                                                 // The kind of metric represented
+	Type                     *CodeableConcept               `json:"type" bson:"type"`                                                         // Identity of metric, for example Heart Rate or PEEP Setting
+	Device                   *Reference                     `json:"device" bson:"device"`                                                     // The device to which this DeviceMetric applies
+	Unit                     *CodeableConcept               `json:"unit,omitempty" bson:"unit,omitempty"`                                     // Unit of Measure for the Metric
+	Color                    *string                        `json:"color,omitempty" bson:"color,omitempty"`                                   // Color name (from CSS4) or #RRGGBB code
+	ColorElement             *Element                       `json:"_color,omitempty" bson:"color_element,omitempty"`                          // Extensions for color
+	MeasurementFrequency     *Quantity                      `json:"measurementFrequency,omitempty" bson:"measurement_frequency,omitempty"`    // Indicates how often the metric is taken or recorded
+	Availability             *CodeableConcept               `json:"availability,omitempty" bson:"availability,omitempty"`                     // The continuity of the metric (e.g., measurement)
+	Calibration              []DeviceMetricCalibration      `json:"calibration,omitempty" bson:"calibration,omitempty"`                       // Describes the calibrations that have been performed or that are required to be performed
 }
 
+var deviceMetricDeviceTargets = []string{"Device"}
+
 func (r *DeviceMetric) Validate() error {
 	if r.ResourceType != "DeviceMetric" {
 		return fmt.Errorf("invalid resourceType: expected 'DeviceMetric', got '%s'", r.ResourceType)
@@ -36,20 +46,63 @@ func (r *DeviceMetric) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.OperationalStatus != nil && !r.OperationalStatus.IsValid() {
+		return fmt.Errorf("field 'OperationalStatus' has invalid code '%s'", *r.OperationalStatus)
+	}
+	if r.OperationalStatusElement != nil {
+		if err := r.OperationalStatusElement.Validate(); err != nil {
+			return fmt.Errorf("OperationalStatusElement: %w", err)
+		}
+	}
 	if r.Category == nil {
 		return fmt.Errorf("field 'Category' is required")
 	}
@@ -69,6 +122,11 @@ func (r *DeviceMetric) Validate() error {
 	if r.Device == nil {
 		return fmt.Errorf("field 'Device' is required")
 	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, deviceMetricDeviceTargets); target != "" {
+			return fmt.Errorf("field 'Device' cannot refer to %s, expected one of %v", target, deviceMetricDeviceTargets)
+		}
+	}
 	if r.Device != nil {
 		if err := r.Device.Validate(); err != nil {
 			return fmt.Errorf("Device: %w", err)
@@ -79,6 +137,11 @@ func (r *DeviceMetric) Validate() error {
 			return fmt.Errorf("Unit: %w", err)
 		}
 	}
+	if r.ColorElement != nil {
+		if err := r.ColorElement.Validate(); err != nil {
+			return fmt.Errorf("ColorElement: %w", err)
+		}
+	}
 	if r.MeasurementFrequency != nil {
 		if err := r.MeasurementFrequency.Validate(); err != nil {
 			return fmt.Errorf("MeasurementFrequency: %w", err)
@@ -94,21 +157,335 @@ func (r *DeviceMetric) Validate() error {
 			return fmt.Errorf("Calibration[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "DeviceMetric")
+}
+
+func (r *DeviceMetric) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DeviceMetric" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DeviceMetric', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.OperationalStatus != nil && !r.OperationalStatus.IsValid() {
+		issues.add("code-invalid", path+".operationalStatus", fmt.Sprintf("field 'OperationalStatus' has invalid code '%s'", *r.OperationalStatus))
+	}
+	if r.OperationalStatusElement != nil {
+		r.OperationalStatusElement.validateAll(path+".operationalStatus", issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Device == nil {
+		issues.add("required", path+".device", "field 'Device' is required")
+	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, deviceMetricDeviceTargets); target != "" {
+			issues.add("value", path+".device", fmt.Sprintf("field 'Device' cannot refer to %s, expected one of %v", target, deviceMetricDeviceTargets))
+		}
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device", issues)
+	}
+	if r.Unit != nil {
+		r.Unit.validateAll(path+".unit", issues)
+	}
+	if r.ColorElement != nil {
+		r.ColorElement.validateAll(path+".color", issues)
+	}
+	if r.MeasurementFrequency != nil {
+		r.MeasurementFrequency.validateAll(path+".measurementFrequency", issues)
+	}
+	if r.Availability != nil {
+		r.Availability.validateAll(path+".availability", issues)
+	}
+	for i, item := range r.Calibration {
+		item.validateAll(fmt.Sprintf("%s.calibration[%d]", path, i), issues)
+	}
+}
+
+func (r *DeviceMetric) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DeviceMetric", &issues)
+	checkInvariants(r, "DeviceMetric", &issues)
+	return issues
+}
+
+var deviceMetricInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DeviceMetric) invariants() []invariant {
+	return deviceMetricInvariants
+}
+
+func (r *DeviceMetric) UnmarshalJSON(data []byte) error {
+	type alias DeviceMetric
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r DeviceMetric) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceMetric) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceMetric) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceMetric) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceMetricElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"color":         "code",
+}
+
+func (r *DeviceMetric) elementTypes() map[string]string {
+	return deviceMetricElementTypes
+}
+
+var deviceMetricElementMetadata = []ElementMetadata{
+	{Path: "DeviceMetric.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DeviceMetric.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DeviceMetric.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DeviceMetric.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceMetric.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DeviceMetric.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DeviceMetric.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DeviceMetric.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceMetric.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceMetric.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DeviceMetric.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceMetric.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/metric-status"}},
+	{Path: "DeviceMetric.operationalStatus", Name: "operationalStatus", Field: 14, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceMetric.operationalStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/metric-operational-status"}},
+	{Path: "DeviceMetric.category", Name: "category", Field: 16, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceMetric.type", Name: "type", Field: 17, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceMetric.device", Name: "device", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "DeviceMetric.unit", Name: "unit", Field: 19, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceMetric.color", Name: "color", Field: 20, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "DeviceMetric.measurementFrequency", Name: "measurementFrequency", Field: 22, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "DeviceMetric.availability", Name: "availability", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceMetric.calibration", Name: "calibration", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *DeviceMetric) ElementMetadata() []ElementMetadata {
+	return deviceMetricElementMetadata
+}
+
+func (r *DeviceMetric) GetResourceType() string {
+	return "DeviceMetric"
+}
+
+func (r *DeviceMetric) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DeviceMetric) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DeviceMetric) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DeviceMetric) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DeviceMetric) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DeviceMetric) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DeviceMetric) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DeviceMetric)(nil)
+
 type DeviceMetricCalibration struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Type  *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`   // The method of calibration
-	State *string          `json:"state,omitempty" bson:"state,omitempty"` // not-calibrated | calibration-required | calibrated | unspecified
-	Time  *string          `json:"time,omitempty" bson:"time,omitempty"`   // Describes the time last calibration has been performed
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept              `json:"type,omitempty" bson:"type,omitempty"`                            // The method of calibration
+	State             *DeviceMetricCalibrationState `json:"state,omitempty" bson:"state,omitempty"`                          // not-calibrated | calibration-required | calibrated | unspecified
+	StateElement      *Element                      `json:"_state,omitempty" bson:"state_element,omitempty"`                 // Extensions for state
+	Time              *Instant                      `json:"time,omitempty" bson:"time,omitempty"`                            // Describes the time last calibration has been performed
+	TimeElement       *Element                      `json:"_time,omitempty" bson:"time_element,omitempty"`                   // Extensions for time
 }
 
 func (r *DeviceMetricCalibration) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.State != nil && !r.State.IsValid() {
+		return fmt.Errorf("field 'State' has invalid code '%s'", *r.State)
+	}
+	if r.StateElement != nil {
+		if err := r.StateElement.Validate(); err != nil {
+			return fmt.Errorf("StateElement: %w", err)
+		}
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			return fmt.Errorf("Time: %w", err)
+		}
+	}
+	if r.TimeElement != nil {
+		if err := r.TimeElement.Validate(); err != nil {
+			return fmt.Errorf("TimeElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *DeviceMetricCalibration) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.State != nil && !r.State.IsValid() {
+		issues.add("code-invalid", path+".state", fmt.Sprintf("field 'State' has invalid code '%s'", *r.State))
+	}
+	if r.StateElement != nil {
+		r.StateElement.validateAll(path+".state", issues)
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			issues.add("value", path+".time", err.Error())
+		}
+	}
+	if r.TimeElement != nil {
+		r.TimeElement.validateAll(path+".time", issues)
+	}
+}
+
+var deviceMetricCalibrationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceMetricCalibration) invariants() []invariant {
+	return deviceMetricCalibrationInvariants
+}
+
+func (r DeviceMetricCalibration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceMetricCalibration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceMetricCalibration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceMetricCalibration) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var deviceMetricCalibrationElementMetadata = []ElementMetadata{
+	{Path: "DeviceMetric.calibration.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceMetric.calibration.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceMetric.calibration.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceMetric.calibration.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceMetric.calibration.state", Name: "state", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceMetric.calibration.state", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/metric-calibration-state"}},
+	{Path: "DeviceMetric.calibration.time", Name: "time", Field: 6, Min: 0, Max: "1", Types: []string{"instant"}},
+}
+
+func (r *DeviceMetricCalibration) ElementMetadata() []ElementMetadata {
+	return deviceMetricCalibrationElementMetadata
+}
@@ -2,49 +2,70 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Represents a request a device to be provided to a specific patient. The device may be an implantable device to be subsequently implanted, or an external assistive device, such as a walker, to be delivered and subsequently be used.
 type DeviceRequest struct {
-	ResourceType           string                   `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                     *string                  `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta                   *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules          *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language               *string                  `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text                   *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage        `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier             []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // External Request identifier
-	BasedOn                []Reference              `json:"basedOn,omitempty" bson:"based_on,omitempty"`                        // What request fulfills
-	Replaces               []Reference              `json:"replaces,omitempty" bson:"replaces,omitempty"`                       // What request replaces
-	GroupIdentifier        *Identifier              `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`        // Identifier of composite request
-	Status                 *string                  `json:"status,omitempty" bson:"status,omitempty"`                           // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
-	Intent                 string                   `json:"intent" bson:"intent"`                                               // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Priority               *string                  `json:"priority,omitempty" bson:"priority,omitempty"`                       // routine | urgent | asap | stat
-	DoNotPerform           *bool                    `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`             // True if the request is to stop or not to start using the device
-	ProductCodeableConcept *CodeableConcept         `json:"productCodeableConcept" bson:"product_codeable_concept"`             // Device requested
-	ProductReference       *Reference               `json:"productReference" bson:"product_reference"`                          // Device requested
-	ProductCanonical       *string                  `json:"productCanonical" bson:"product_canonical"`                          // Device requested
-	Quantity               *int                     `json:"quantity,omitempty" bson:"quantity,omitempty"`                       // Quantity of devices to supply
-	Parameter              []DeviceRequestParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                     // Device details
-	Subject                *Reference               `json:"subject" bson:"subject"`                                             // Focus of request
-	Encounter              *Reference               `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Encounter motivating request
-	OccurrenceDateTime     *string                  `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // Desired time or schedule for use
-	OccurrencePeriod       *Period                  `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // Desired time or schedule for use
-	OccurrenceTiming       *Timing                  `json:"occurrenceTiming,omitempty" bson:"occurrence_timing,omitempty"`      // Desired time or schedule for use
-	AuthoredOn             *string                  `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                  // When recorded
-	Requester              *Reference               `json:"requester,omitempty" bson:"requester,omitempty"`                     // Who/what submitted the device request
-	Performer              *CodeableReference       `json:"performer,omitempty" bson:"performer,omitempty"`                     // Requested Filler
-	Location               []CodeableReference      `json:"location,omitempty" bson:"location,omitempty"`                       // Requested location
-	Reason                 []CodeableReference      `json:"reason,omitempty" bson:"reason,omitempty"`                           // Coded/Linked Reason for request
-	AsNeeded               *bool                    `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                      // PRN status of request
-	AsNeededFor            *CodeableConcept         `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`               // Device usage reason
-	Insurance              []Reference              `json:"insurance,omitempty" bson:"insurance,omitempty"`                     // Associated insurance coverage
-	SupportingInfo         []Reference              `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`          // Additional clinical information
-	Note                   []Annotation             `json:"note,omitempty" bson:"note,omitempty"`                               // Notes or comments
-	RelevantHistory        []Reference              `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`        // Request provenance
+	ResourceType         string                   `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                  `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                 `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                  `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                 `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource               `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External Request identifier
+	BasedOn              []Reference              `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // What request fulfills
+	Replaces             []Reference              `json:"replaces,omitempty" bson:"replaces,omitempty"`                     // What request replaces
+	GroupIdentifier      *Identifier              `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`      // Identifier of composite request
+	Status               *RequestStatus           `json:"status,omitempty" bson:"status,omitempty"`                         // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
+	StatusElement        *Element                 `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Intent               CarePlanIntent           `json:"intent" bson:"intent"`                                             // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement        *Element                 `json:"_intent,omitempty" bson:"intent_element,omitempty"`                // Extensions for intent
+	Priority             *RequestPriority         `json:"priority,omitempty" bson:"priority,omitempty"`                     // routine | urgent | asap | stat
+	PriorityElement      *Element                 `json:"_priority,omitempty" bson:"priority_element,omitempty"`            // Extensions for priority
+	DoNotPerform         *bool                    `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`           // True if the request is to stop or not to start using the device
+	DoNotPerformElement  *Element                 `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`  // Extensions for doNotPerform
+	Product              DeviceRequestProduct     `json:"-" bson:"product,omitempty"`                                       // Device requested
+	ProductElement       *Element                 `json:"-" bson:"product_element,omitempty"`                               // Extensions for product[x]
+	Quantity             *int                     `json:"quantity,omitempty" bson:"quantity,omitempty"`                     // Quantity of devices to supply
+	QuantityElement      *Element                 `json:"_quantity,omitempty" bson:"quantity_element,omitempty"`            // Extensions for quantity
+	Parameter            []DeviceRequestParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                   // Device details
+	Subject              *Reference               `json:"subject" bson:"subject"`                                           // Focus of request
+	Encounter            *Reference               `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter motivating request
+	Occurrence           DeviceRequestOccurrence  `json:"-" bson:"occurrence,omitempty"`                                    // Desired time or schedule for use
+	OccurrenceElement    *Element                 `json:"-" bson:"occurrence_element,omitempty"`                            // Extensions for occurrence[x]
+	AuthoredOn           *DateTime                `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                // When recorded
+	AuthoredOnElement    *Element                 `json:"_authoredOn,omitempty" bson:"authored_on_element,omitempty"`       // Extensions for authoredOn
+	Requester            *Reference               `json:"requester,omitempty" bson:"requester,omitempty"`                   // Who/what submitted the device request
+	Performer            *CodeableReference       `json:"performer,omitempty" bson:"performer,omitempty"`                   // Requested Filler
+	Location             []CodeableReference      `json:"location,omitempty" bson:"location,omitempty"`                     // Requested location
+	Reason               []CodeableReference      `json:"reason,omitempty" bson:"reason,omitempty"`                         // Coded/Linked Reason for request
+	AsNeeded             *bool                    `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                    // PRN status of request
+	AsNeededElement      *Element                 `json:"_asNeeded,omitempty" bson:"as_needed_element,omitempty"`           // Extensions for asNeeded
+	AsNeededFor          *CodeableConcept         `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`             // Device usage reason
+	Insurance            []Reference              `json:"insurance,omitempty" bson:"insurance,omitempty"`                   // Associated insurance coverage
+	SupportingInfo       []Reference              `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`        // Additional clinical information
+	Note                 []Annotation             `json:"note,omitempty" bson:"note,omitempty"`                             // Notes or comments
+	RelevantHistory      []Reference              `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`      // Request provenance
+
+	productVariants    []string // JSON properties of DeviceRequest.product[x] when more than one was decoded
+	occurrenceVariants []string // JSON properties of DeviceRequest.occurrence[x] when more than one was decoded
 }
 
+var deviceRequestReplacesTargets = []string{"DeviceRequest"}
+
+var deviceRequestSubjectTargets = []string{"Device", "Group", "Location", "Patient"}
+
+var deviceRequestEncounterTargets = []string{"Encounter"}
+
+var deviceRequestRequesterTargets = []string{"CareTeam", "Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *DeviceRequest) Validate() error {
 	if r.ResourceType != "DeviceRequest" {
 		return fmt.Errorf("invalid resourceType: expected 'DeviceRequest', got '%s'", r.ResourceType)
@@ -54,11 +75,39 @@ func (r *DeviceRequest) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -69,6 +118,11 @@ func (r *DeviceRequest) Validate() error {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, deviceRequestReplacesTargets); target != "" {
+			return fmt.Errorf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, deviceRequestReplacesTargets)
+		}
+	}
 	for i, item := range r.Replaces {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Replaces[%d]: %w", i, err)
@@ -79,28 +133,58 @@ func (r *DeviceRequest) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Intent == emptyString {
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
-	if r.ProductCodeableConcept == nil {
-		return fmt.Errorf("field 'ProductCodeableConcept' is required")
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
 	}
-	if r.ProductCodeableConcept != nil {
-		if err := r.ProductCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ProductCodeableConcept: %w", err)
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
 		}
 	}
-	if r.ProductReference == nil {
-		return fmt.Errorf("field 'ProductReference' is required")
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
 	}
-	if r.ProductReference != nil {
-		if err := r.ProductReference.Validate(); err != nil {
-			return fmt.Errorf("ProductReference: %w", err)
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
 		}
 	}
-	if r.ProductCanonical == nil {
-		return fmt.Errorf("field 'ProductCanonical' is required")
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
+		}
+	}
+	if len(r.productVariants) > 1 {
+		return fmt.Errorf("field 'Product' must have a single type, got %v", r.productVariants)
+	}
+	if r.Product == nil {
+		return fmt.Errorf("field 'Product' is required")
+	}
+	if r.Product != nil {
+		if err := r.Product.Validate(); err != nil {
+			return fmt.Errorf("Product: %w", err)
+		}
+	}
+	if r.ProductElement != nil {
+		if err := r.ProductElement.Validate(); err != nil {
+			return fmt.Errorf("ProductElement: %w", err)
+		}
+	}
+	if r.QuantityElement != nil {
+		if err := r.QuantityElement.Validate(); err != nil {
+			return fmt.Errorf("QuantityElement: %w", err)
+		}
 	}
 	for i, item := range r.Parameter {
 		if err := item.Validate(); err != nil {
@@ -110,24 +194,52 @@ func (r *DeviceRequest) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, deviceRequestSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, deviceRequestSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, deviceRequestEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, deviceRequestEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
 		}
 	}
-	if r.OccurrenceTiming != nil {
-		if err := r.OccurrenceTiming.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceTiming: %w", err)
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOn: %w", err)
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		if err := r.AuthoredOnElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOnElement: %w", err)
+		}
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, deviceRequestRequesterTargets); target != "" {
+			return fmt.Errorf("field 'Requester' cannot refer to %s, expected one of %v", target, deviceRequestRequesterTargets)
 		}
 	}
 	if r.Requester != nil {
@@ -150,6 +262,11 @@ func (r *DeviceRequest) Validate() error {
 			return fmt.Errorf("Reason[%d]: %w", i, err)
 		}
 	}
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
+		}
+	}
 	if r.AsNeededFor != nil {
 		if err := r.AsNeededFor.Validate(); err != nil {
 			return fmt.Errorf("AsNeededFor: %w", err)
@@ -175,38 +292,739 @@ func (r *DeviceRequest) Validate() error {
 			return fmt.Errorf("RelevantHistory[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "DeviceRequest")
+}
+
+func (r *DeviceRequest) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DeviceRequest" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DeviceRequest', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, deviceRequestReplacesTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.replaces[%d]", path, i), fmt.Sprintf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, deviceRequestReplacesTargets))
+		}
+	}
+	for i, item := range r.Replaces {
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
+	}
+	if len(r.productVariants) > 1 {
+		issues.add("structure", path+".product", fmt.Sprintf("field 'Product' must have a single type, got %v", r.productVariants))
+	}
+	if r.Product == nil {
+		issues.add("required", path+".product", "field 'Product' is required")
+	}
+	if r.Product != nil {
+		r.Product.validateAll(path+".product.ofType("+r.Product.FHIRType()+")", issues)
+	}
+	if r.ProductElement != nil {
+		r.ProductElement.validateAll(path+".product", issues)
+	}
+	if r.QuantityElement != nil {
+		r.QuantityElement.validateAll(path+".quantity", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, deviceRequestSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, deviceRequestSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, deviceRequestEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, deviceRequestEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			issues.add("value", path+".authoredOn", err.Error())
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		r.AuthoredOnElement.validateAll(path+".authoredOn", issues)
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, deviceRequestRequesterTargets); target != "" {
+			issues.add("value", path+".requester", fmt.Sprintf("field 'Requester' cannot refer to %s, expected one of %v", target, deviceRequestRequesterTargets))
+		}
+	}
+	if r.Requester != nil {
+		r.Requester.validateAll(path+".requester", issues)
+	}
+	if r.Performer != nil {
+		r.Performer.validateAll(path+".performer", issues)
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
+	if r.AsNeededFor != nil {
+		r.AsNeededFor.validateAll(path+".asNeededFor", issues)
+	}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.RelevantHistory {
+		item.validateAll(fmt.Sprintf("%s.relevantHistory[%d]", path, i), issues)
+	}
+}
+
+func (r *DeviceRequest) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DeviceRequest", &issues)
+	checkInvariants(r, "DeviceRequest", &issues)
+	return issues
+}
+
+var deviceRequestInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DeviceRequest) invariants() []invariant {
+	return deviceRequestInvariants
+}
+
+func (r DeviceRequest) MarshalJSON() ([]byte, error) {
+	type alias DeviceRequest
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "product", r.Product, r.ProductElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceRequest) UnmarshalJSON(data []byte) error {
+	type alias DeviceRequest
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Product, element, r.productVariants, err = unmarshalChoice(fields, "product", deviceRequestProductVariants); err != nil {
+		return err
+	}
+	r.ProductElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ProductElement); err != nil {
+			return fmt.Errorf("_product: %w", err)
+		}
+	}
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", deviceRequestOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DeviceRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceRequest) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Product":
+		return "product", choiceValues(deviceRequestProductVariants)
+	case "Occurrence":
+		return "occurrence", choiceValues(deviceRequestOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var deviceRequestElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *DeviceRequest) elementTypes() map[string]string {
+	return deviceRequestElementTypes
+}
+
+var deviceRequestElementMetadata = []ElementMetadata{
+	{Path: "DeviceRequest.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DeviceRequest.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DeviceRequest.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DeviceRequest.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DeviceRequest.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DeviceRequest.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DeviceRequest.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceRequest.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceRequest.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DeviceRequest.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "DeviceRequest.replaces", Name: "replaces", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DeviceRequest"}},
+	{Path: "DeviceRequest.groupIdentifier", Name: "groupIdentifier", Field: 14, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "DeviceRequest.status", Name: "status", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"}},
+	{Path: "DeviceRequest.intent", Name: "intent", Field: 17, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"}},
+	{Path: "DeviceRequest.priority", Name: "priority", Field: 19, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DeviceRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "DeviceRequest.doNotPerform", Name: "doNotPerform", Field: 21, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceRequest.product[x]", Name: "product", Field: 23, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "DeviceRequest.quantity", Name: "quantity", Field: 25, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "DeviceRequest.parameter", Name: "parameter", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DeviceRequest.subject", Name: "subject", Field: 28, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "DeviceRequest.encounter", Name: "encounter", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "DeviceRequest.occurrence[x]", Name: "occurrence", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "DeviceRequest.authoredOn", Name: "authoredOn", Field: 32, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "DeviceRequest.requester", Name: "requester", Field: 34, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "DeviceRequest.performer", Name: "performer", Field: 35, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "DeviceRequest.location", Name: "location", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "DeviceRequest.reason", Name: "reason", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "DeviceRequest.asNeeded", Name: "asNeeded", Field: 38, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "DeviceRequest.asNeededFor", Name: "asNeededFor", Field: 40, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceRequest.insurance", Name: "insurance", Field: 41, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/Coverage"}},
+	{Path: "DeviceRequest.supportingInfo", Name: "supportingInfo", Field: 42, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "DeviceRequest.note", Name: "note", Field: 43, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "DeviceRequest.relevantHistory", Name: "relevantHistory", Field: 44, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *DeviceRequest) ElementMetadata() []ElementMetadata {
+	return deviceRequestElementMetadata
+}
+
+// DeviceRequestProduct is the type of DeviceRequest.product[x]. It is implemented by the DeviceRequestProduct* variant types.
+type DeviceRequestProduct interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceRequestProduct()
+}
+
+// DeviceRequestProductCodeableConcept is the CodeableConcept variant of DeviceRequestProduct.
+type DeviceRequestProductCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DeviceRequestProductCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DeviceRequestProductCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DeviceRequestProductCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DeviceRequestProductCodeableConcept) isDeviceRequestProduct() {}
+
+// DeviceRequestProductReference is the Reference variant of DeviceRequestProduct.
+type DeviceRequestProductReference struct {
+	Reference
+}
+
+func (v DeviceRequestProductReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v DeviceRequestProductReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v DeviceRequestProductReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v DeviceRequestProductReference) isDeviceRequestProduct() {}
+
+// DeviceRequestProductCanonical is the canonical variant of DeviceRequestProduct.
+type DeviceRequestProductCanonical string
+
+func (v DeviceRequestProductCanonical) Validate() error {
 	return nil
 }
 
+func (v DeviceRequestProductCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DeviceRequestProductCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v DeviceRequestProductCanonical) isDeviceRequestProduct() {}
+
+var deviceRequestProductVariants = []DeviceRequestProduct{
+	DeviceRequestProductCodeableConcept{},
+	DeviceRequestProductReference{},
+	DeviceRequestProductCanonical(""),
+}
+
+// DeviceRequestOccurrence is the type of DeviceRequest.occurrence[x]. It is implemented by the DeviceRequestOccurrence* variant types.
+type DeviceRequestOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceRequestOccurrence()
+}
+
+// DeviceRequestOccurrenceDateTime is the dateTime variant of DeviceRequestOccurrence.
+type DeviceRequestOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v DeviceRequestOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DeviceRequestOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v DeviceRequestOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DeviceRequestOccurrenceDateTime) isDeviceRequestOccurrence() {}
+
+// DeviceRequestOccurrencePeriod is the Period variant of DeviceRequestOccurrence.
+type DeviceRequestOccurrencePeriod struct {
+	Period
+}
+
+func (v DeviceRequestOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DeviceRequestOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DeviceRequestOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DeviceRequestOccurrencePeriod) isDeviceRequestOccurrence() {}
+
+// DeviceRequestOccurrenceTiming is the Timing variant of DeviceRequestOccurrence.
+type DeviceRequestOccurrenceTiming struct {
+	Timing
+}
+
+func (v DeviceRequestOccurrenceTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v DeviceRequestOccurrenceTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v DeviceRequestOccurrenceTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v DeviceRequestOccurrenceTiming) isDeviceRequestOccurrence() {}
+
+var deviceRequestOccurrenceVariants = []DeviceRequestOccurrence{
+	DeviceRequestOccurrenceDateTime{},
+	DeviceRequestOccurrencePeriod{},
+	DeviceRequestOccurrenceTiming{},
+}
+
+func (r *DeviceRequest) GetResourceType() string {
+	return "DeviceRequest"
+}
+
+func (r *DeviceRequest) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DeviceRequest) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DeviceRequest) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DeviceRequest) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DeviceRequest) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DeviceRequest) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DeviceRequest) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DeviceRequest)(nil)
+
 type DeviceRequestParameter struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Code                 *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                                   // Device detail
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // Value of detail
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // Value of detail
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // Value of detail
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // Value of detail
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept            `json:"code,omitempty" bson:"code,omitempty"`                            // Device detail
+	Value             DeviceRequestParameterValue `json:"-" bson:"value,omitempty"`                                        // Value of detail
+	ValueElement      *Element                    `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of DeviceRequest.parameter.value[x] when more than one was decoded
 }
 
 func (r *DeviceRequestParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	return nil
+}
+
+func (r *DeviceRequestParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var deviceRequestParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DeviceRequestParameter) invariants() []invariant {
+	return deviceRequestParameterInvariants
+}
+
+func (r DeviceRequestParameter) MarshalJSON() ([]byte, error) {
+	type alias DeviceRequestParameter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DeviceRequestParameter) UnmarshalJSON(data []byte) error {
+	type alias DeviceRequestParameter
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", deviceRequestParameterValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r DeviceRequestParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DeviceRequestParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DeviceRequestParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DeviceRequestParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DeviceRequestParameter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(deviceRequestParameterValueVariants)
+	}
+	return "", nil
+}
+
+var deviceRequestParameterElementMetadata = []ElementMetadata{
+	{Path: "DeviceRequest.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DeviceRequest.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DeviceRequest.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DeviceRequest.parameter.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DeviceRequest.parameter.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Quantity", "Range", "boolean"}},
+}
+
+func (r *DeviceRequestParameter) ElementMetadata() []ElementMetadata {
+	return deviceRequestParameterElementMetadata
+}
+
+// DeviceRequestParameterValue is the type of DeviceRequest.parameter.value[x]. It is implemented by the DeviceRequestParameterValue* variant types.
+type DeviceRequestParameterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDeviceRequestParameterValue()
+}
+
+// DeviceRequestParameterValueCodeableConcept is the CodeableConcept variant of DeviceRequestParameterValue.
+type DeviceRequestParameterValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DeviceRequestParameterValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DeviceRequestParameterValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DeviceRequestParameterValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DeviceRequestParameterValueCodeableConcept) isDeviceRequestParameterValue() {}
+
+// DeviceRequestParameterValueQuantity is the Quantity variant of DeviceRequestParameterValue.
+type DeviceRequestParameterValueQuantity struct {
+	Quantity
+}
+
+func (v DeviceRequestParameterValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DeviceRequestParameterValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DeviceRequestParameterValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DeviceRequestParameterValueQuantity) isDeviceRequestParameterValue() {}
+
+// DeviceRequestParameterValueRange is the Range variant of DeviceRequestParameterValue.
+type DeviceRequestParameterValueRange struct {
+	Range
+}
+
+func (v DeviceRequestParameterValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v DeviceRequestParameterValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v DeviceRequestParameterValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v DeviceRequestParameterValueRange) isDeviceRequestParameterValue() {}
+
+// DeviceRequestParameterValueBoolean is the boolean variant of DeviceRequestParameterValue.
+type DeviceRequestParameterValueBoolean bool
+
+func (v DeviceRequestParameterValueBoolean) Validate() error {
+	return nil
+}
+
+func (v DeviceRequestParameterValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DeviceRequestParameterValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v DeviceRequestParameterValueBoolean) isDeviceRequestParameterValue() {}
+
+var deviceRequestParameterValueVariants = []DeviceRequestParameterValue{
+	DeviceRequestParameterValueCodeableConcept{},
+	DeviceRequestParameterValueQuantity{},
+	DeviceRequestParameterValueRange{},
+	DeviceRequestParameterValueBoolean(false),
+}
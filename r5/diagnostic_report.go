@@ -2,47 +2,75 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The findings and interpretation of diagnostic tests performed on patients, groups of patients, products, substances, devices, and locations, and/or specimens derived from these. The report includes clinical context such as requesting provider information, and some mix of atomic results, images, textual and coded interpretations, and formatted representation of diagnostic reports. The report also includes non-clinical context such as batch analysis and stability reporting of products and substances.
 type DiagnosticReport struct {
-	ResourceType       string                           `json:"resourceType" bson:"resource_type"`                                 // Type of resource
-	Id                 *string                          `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
-	Meta               *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
-	ImplicitRules      *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
-	Language           *string                          `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
-	Text               *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage                `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
-	Identifier         []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // Business identifier for report
-	BasedOn            []Reference                      `json:"basedOn,omitempty" bson:"based_on,omitempty"`                       // What was requested
-	Status             string                           `json:"status" bson:"status"`                                              // registered | partial | preliminary | modified | final | amended | corrected | appended | cancelled | entered-in-error | unknown
-	Category           []CodeableConcept                `json:"category,omitempty" bson:"category,omitempty"`                      // Service category
-	Code               *CodeableConcept                 `json:"code" bson:"code"`                                                  // Name/Code for this diagnostic report
-	Subject            *Reference                       `json:"subject,omitempty" bson:"subject,omitempty"`                        // The subject of the report - usually, but not always, the patient
-	RelatesTo          []RelatedArtifact                `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                   // Related DiagnosticReports
-	Encounter          *Reference                       `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // Encounter associated with the DiagnosticReport
-	EffectiveDateTime  *string                          `json:"effectiveDateTime,omitempty" bson:"effective_date_time,omitempty"`  // Clinically relevant time/time-period for the results that are included in the report
-	EffectivePeriod    *Period                          `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`       // Clinically relevant time/time-period for the results that are included in the report
-	Issued             *string                          `json:"issued,omitempty" bson:"issued,omitempty"`                          // DateTime this version was made
-	Procedure          []Reference                      `json:"procedure,omitempty" bson:"procedure,omitempty"`                    // The performed procedure(s) from which the report was produced
-	Performer          []Reference                      `json:"performer,omitempty" bson:"performer,omitempty"`                    // Responsible Diagnostic Service
-	ResultsInterpreter []Reference                      `json:"resultsInterpreter,omitempty" bson:"results_interpreter,omitempty"` // Who analyzed and reported the conclusions and interpretations
-	Specimen           []Reference                      `json:"specimen,omitempty" bson:"specimen,omitempty"`                      // Specimens this report is based on
-	Result             []Reference                      `json:"result,omitempty" bson:"result,omitempty"`                          // Observations
-	Note               []Annotation                     `json:"note,omitempty" bson:"note,omitempty"`                              // Comments about the diagnostic report
-	Study              []Reference                      `json:"study,omitempty" bson:"study,omitempty"`                            // Reference to full details of an analysis associated with the diagnostic report
-	SupportingInfo     []DiagnosticReportSupportingInfo `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`         // Additional information supporting the diagnostic report
-	Media              []DiagnosticReportMedia          `json:"media,omitempty" bson:"media,omitempty"`                            // Key images or data associated with this report
-	Composition        *Reference                       `json:"composition,omitempty" bson:"composition,omitempty"`                // Reference to a Composition resource for the DiagnosticReport structure
-	Conclusion         *string                          `json:"conclusion,omitempty" bson:"conclusion,omitempty"`                  // Clinical conclusion (interpretation) of test results
-	ConclusionCode     []CodeableReference              `json:"conclusionCode,omitempty" bson:"conclusion_code,omitempty"`         // Codes and/or references for the clinical conclusion of test results
-	Recomendation      []CodeableReference              `json:"recomendation,omitempty" bson:"recomendation,omitempty"`            // Recommendations based on findings and interpretations
-	PresentedForm      []Attachment                     `json:"presentedForm,omitempty" bson:"presented_form,omitempty"`           // Entire report as issued
-	Communication      []Reference                      `json:"communication,omitempty" bson:"communication,omitempty"`            // Communication initiated during the reporting process
-	Comparison         *Reference                       `json:"comparison,omitempty" bson:"comparison,omitempty"`                  // Prior data and findings for comparison
+	ResourceType         string                           `json:"resourceType" bson:"resource_type"`                                 // Type of resource
+	Id                   *string                          `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
+	Meta                 *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
+	ImplicitRules        *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
+	ImplicitRulesElement *Element                         `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`  // Extensions for implicitRules
+	Language             *string                          `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
+	LanguageElement      *Element                         `json:"_language,omitempty" bson:"language_element,omitempty"`             // Extensions for language
+	Text                 *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
+	Contained            []Resource                       `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
+	Extension            []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension    []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored
+	Identifier           []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // Business identifier for report
+	BasedOn              []Reference                      `json:"basedOn,omitempty" bson:"based_on,omitempty"`                       // What was requested
+	Status               DiagnosticReportStatus           `json:"status" bson:"status"`                                              // registered | partial | preliminary | modified | final | amended | corrected | appended | cancelled | entered-in-error | unknown
+	StatusElement        *Element                         `json:"_status,omitempty" bson:"status_element,omitempty"`                 // Extensions for status
+	Category             []CodeableConcept                `json:"category,omitempty" bson:"category,omitempty"`                      // Service category
+	Code                 *CodeableConcept                 `json:"code" bson:"code"`                                                  // Name/Code for this diagnostic report
+	Subject              *Reference                       `json:"subject,omitempty" bson:"subject,omitempty"`                        // The subject of the report - usually, but not always, the patient
+	RelatesTo            []RelatedArtifact                `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                   // Related DiagnosticReports
+	Encounter            *Reference                       `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // Encounter associated with the DiagnosticReport
+	Effective            DiagnosticReportEffective        `json:"-" bson:"effective,omitempty"`                                      // Clinically relevant time/time-period for the results that are included in the report
+	EffectiveElement     *Element                         `json:"-" bson:"effective_element,omitempty"`                              // Extensions for effective[x]
+	Issued               *DateTime                        `json:"issued,omitempty" bson:"issued,omitempty"`                          // DateTime this version was made
+	IssuedElement        *Element                         `json:"_issued,omitempty" bson:"issued_element,omitempty"`                 // Extensions for issued
+	Procedure            []Reference                      `json:"procedure,omitempty" bson:"procedure,omitempty"`                    // The performed procedure(s) from which the report was produced
+	Performer            []Reference                      `json:"performer,omitempty" bson:"performer,omitempty"`                    // Responsible Diagnostic Service
+	ResultsInterpreter   []Reference                      `json:"resultsInterpreter,omitempty" bson:"results_interpreter,omitempty"` // Who analyzed and reported the conclusions and interpretations
+	Specimen             []Reference                      `json:"specimen,omitempty" bson:"specimen,omitempty"`                      // Specimens this report is based on
+	Result               []Reference                      `json:"result,omitempty" bson:"result,omitempty"`                          // Observations
+	Note                 []Annotation                     `json:"note,omitempty" bson:"note,omitempty"`                              // Comments about the diagnostic report
+	Study                []Reference                      `json:"study,omitempty" bson:"study,omitempty"`                            // Reference to full details of an analysis associated with the diagnostic report
+	SupportingInfo       []DiagnosticReportSupportingInfo `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`         // Additional information supporting the diagnostic report
+	Media                []DiagnosticReportMedia          `json:"media,omitempty" bson:"media,omitempty"`                            // Key images or data associated with this report
+	Composition          *Reference                       `json:"composition,omitempty" bson:"composition,omitempty"`                // Reference to a Composition resource for the DiagnosticReport structure
+	Conclusion           *string                          `json:"conclusion,omitempty" bson:"conclusion,omitempty"`                  // Clinical conclusion (interpretation) of test results
+	ConclusionElement    *Element                         `json:"_conclusion,omitempty" bson:"conclusion_element,omitempty"`         // Extensions for conclusion
+	ConclusionCode       []CodeableReference              `json:"conclusionCode,omitempty" bson:"conclusion_code,omitempty"`         // Codes and/or references for the clinical conclusion of test results
+	Recomendation        []CodeableReference              `json:"recomendation,omitempty" bson:"recomendation,omitempty"`            // Recommendations based on findings and interpretations
+	PresentedForm        []Attachment                     `json:"presentedForm,omitempty" bson:"presented_form,omitempty"`           // Entire report as issued
+	Communication        []Reference                      `json:"communication,omitempty" bson:"communication,omitempty"`            // Communication initiated during the reporting process
+	Comparison           *Reference                       `json:"comparison,omitempty" bson:"comparison,omitempty"`                  // Prior data and findings for comparison
+
+	effectiveVariants []string // JSON properties of DiagnosticReport.effective[x] when more than one was decoded
 }
 
+var diagnosticReportBasedOnTargets = []string{"CarePlan", "MedicationRequest", "NutritionOrder", "ServiceRequest"}
+
+var diagnosticReportSubjectTargets = []string{"BiologicallyDerivedProduct", "Device", "Group", "Location", "Medication", "Organization", "Patient", "Practitioner", "Substance"}
+
+var diagnosticReportEncounterTargets = []string{"Encounter"}
+
+var diagnosticReportProcedureTargets = []string{"Procedure"}
+
+var diagnosticReportPerformerTargets = []string{"CareTeam", "Device", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var diagnosticReportResultsInterpreterTargets = []string{"CareTeam", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var diagnosticReportSpecimenTargets = []string{"Specimen"}
+
+var diagnosticReportResultTargets = []string{"Observation"}
+
+var diagnosticReportStudyTargets = []string{"ImagingStudy"}
+
 func (r *DiagnosticReport) Validate() error {
 	if r.ResourceType != "DiagnosticReport" {
 		return fmt.Errorf("invalid resourceType: expected 'DiagnosticReport', got '%s'", r.ResourceType)
@@ -52,25 +80,65 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -84,6 +152,11 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, diagnosticReportSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, diagnosticReportSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -94,14 +167,42 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, diagnosticReportEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, diagnosticReportEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.EffectivePeriod != nil {
-		if err := r.EffectivePeriod.Validate(); err != nil {
-			return fmt.Errorf("EffectivePeriod: %w", err)
+	if len(r.effectiveVariants) > 1 {
+		return fmt.Errorf("field 'Effective' must have a single type, got %v", r.effectiveVariants)
+	}
+	if r.Effective != nil {
+		if err := r.Effective.Validate(); err != nil {
+			return fmt.Errorf("Effective: %w", err)
+		}
+	}
+	if r.EffectiveElement != nil {
+		if err := r.EffectiveElement.Validate(); err != nil {
+			return fmt.Errorf("EffectiveElement: %w", err)
+		}
+	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			return fmt.Errorf("Issued: %w", err)
+		}
+	}
+	if r.IssuedElement != nil {
+		if err := r.IssuedElement.Validate(); err != nil {
+			return fmt.Errorf("IssuedElement: %w", err)
+		}
+	}
+	for i, item := range r.Procedure {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportProcedureTargets); target != "" {
+			return fmt.Errorf("field 'Procedure[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportProcedureTargets)
 		}
 	}
 	for i, item := range r.Procedure {
@@ -109,21 +210,41 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("Procedure[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportPerformerTargets); target != "" {
+			return fmt.Errorf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportPerformerTargets)
+		}
+	}
 	for i, item := range r.Performer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ResultsInterpreter {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportResultsInterpreterTargets); target != "" {
+			return fmt.Errorf("field 'ResultsInterpreter[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportResultsInterpreterTargets)
+		}
+	}
 	for i, item := range r.ResultsInterpreter {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ResultsInterpreter[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Specimen {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportSpecimenTargets); target != "" {
+			return fmt.Errorf("field 'Specimen[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportSpecimenTargets)
+		}
+	}
 	for i, item := range r.Specimen {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Specimen[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Result {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportResultTargets); target != "" {
+			return fmt.Errorf("field 'Result[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportResultTargets)
+		}
+	}
 	for i, item := range r.Result {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Result[%d]: %w", i, err)
@@ -134,6 +255,11 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Study {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportStudyTargets); target != "" {
+			return fmt.Errorf("field 'Study[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportStudyTargets)
+		}
+	}
 	for i, item := range r.Study {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Study[%d]: %w", i, err)
@@ -154,6 +280,11 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("Composition: %w", err)
 		}
 	}
+	if r.ConclusionElement != nil {
+		if err := r.ConclusionElement.Validate(); err != nil {
+			return fmt.Errorf("ConclusionElement: %w", err)
+		}
+	}
 	for i, item := range r.ConclusionCode {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ConclusionCode[%d]: %w", i, err)
@@ -179,16 +310,440 @@ func (r *DiagnosticReport) Validate() error {
 			return fmt.Errorf("Comparison: %w", err)
 		}
 	}
+	return validateInvariants(r, "DiagnosticReport")
+}
+
+func (r *DiagnosticReport) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DiagnosticReport" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DiagnosticReport', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, diagnosticReportSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, diagnosticReportSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, diagnosticReportEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, diagnosticReportEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.effectiveVariants) > 1 {
+		issues.add("structure", path+".effective", fmt.Sprintf("field 'Effective' must have a single type, got %v", r.effectiveVariants))
+	}
+	if r.Effective != nil {
+		r.Effective.validateAll(path+".effective.ofType("+r.Effective.FHIRType()+")", issues)
+	}
+	if r.EffectiveElement != nil {
+		r.EffectiveElement.validateAll(path+".effective", issues)
+	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			issues.add("value", path+".issued", err.Error())
+		}
+	}
+	if r.IssuedElement != nil {
+		r.IssuedElement.validateAll(path+".issued", issues)
+	}
+	for i, item := range r.Procedure {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportProcedureTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.procedure[%d]", path, i), fmt.Sprintf("field 'Procedure[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportProcedureTargets))
+		}
+	}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
+	}
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportPerformerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.performer[%d]", path, i), fmt.Sprintf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportPerformerTargets))
+		}
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.ResultsInterpreter {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportResultsInterpreterTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.resultsInterpreter[%d]", path, i), fmt.Sprintf("field 'ResultsInterpreter[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportResultsInterpreterTargets))
+		}
+	}
+	for i, item := range r.ResultsInterpreter {
+		item.validateAll(fmt.Sprintf("%s.resultsInterpreter[%d]", path, i), issues)
+	}
+	for i, item := range r.Specimen {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportSpecimenTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.specimen[%d]", path, i), fmt.Sprintf("field 'Specimen[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportSpecimenTargets))
+		}
+	}
+	for i, item := range r.Specimen {
+		item.validateAll(fmt.Sprintf("%s.specimen[%d]", path, i), issues)
+	}
+	for i, item := range r.Result {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportResultTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.result[%d]", path, i), fmt.Sprintf("field 'Result[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportResultTargets))
+		}
+	}
+	for i, item := range r.Result {
+		item.validateAll(fmt.Sprintf("%s.result[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Study {
+		if target := invalidReferenceTarget(item.Reference, item.Type, diagnosticReportStudyTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.study[%d]", path, i), fmt.Sprintf("field 'Study[%d]' cannot refer to %s, expected one of %v", i, target, diagnosticReportStudyTargets))
+		}
+	}
+	for i, item := range r.Study {
+		item.validateAll(fmt.Sprintf("%s.study[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+	for i, item := range r.Media {
+		item.validateAll(fmt.Sprintf("%s.media[%d]", path, i), issues)
+	}
+	if r.Composition != nil {
+		r.Composition.validateAll(path+".composition", issues)
+	}
+	if r.ConclusionElement != nil {
+		r.ConclusionElement.validateAll(path+".conclusion", issues)
+	}
+	for i, item := range r.ConclusionCode {
+		item.validateAll(fmt.Sprintf("%s.conclusionCode[%d]", path, i), issues)
+	}
+	for i, item := range r.Recomendation {
+		item.validateAll(fmt.Sprintf("%s.recomendation[%d]", path, i), issues)
+	}
+	for i, item := range r.PresentedForm {
+		item.validateAll(fmt.Sprintf("%s.presentedForm[%d]", path, i), issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+	if r.Comparison != nil {
+		r.Comparison.validateAll(path+".comparison", issues)
+	}
+}
+
+func (r *DiagnosticReport) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DiagnosticReport", &issues)
+	checkInvariants(r, "DiagnosticReport", &issues)
+	return issues
+}
+
+var diagnosticReportInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DiagnosticReport) invariants() []invariant {
+	return diagnosticReportInvariants
+}
+
+func (r DiagnosticReport) MarshalJSON() ([]byte, error) {
+	type alias DiagnosticReport
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "effective", r.Effective, r.EffectiveElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DiagnosticReport) UnmarshalJSON(data []byte) error {
+	type alias DiagnosticReport
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Effective, element, r.effectiveVariants, err = unmarshalChoice(fields, "effective", diagnosticReportEffectiveVariants); err != nil {
+		return err
+	}
+	r.EffectiveElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EffectiveElement); err != nil {
+			return fmt.Errorf("_effective: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r DiagnosticReport) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DiagnosticReport) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DiagnosticReport) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DiagnosticReport) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DiagnosticReport) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Effective":
+		return "effective", choiceValues(diagnosticReportEffectiveVariants)
+	}
+	return "", nil
+}
+
+var diagnosticReportElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"conclusion":    "markdown",
+}
+
+func (r *DiagnosticReport) elementTypes() map[string]string {
+	return diagnosticReportElementTypes
+}
+
+var diagnosticReportElementMetadata = []ElementMetadata{
+	{Path: "DiagnosticReport.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "DiagnosticReport.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DiagnosticReport.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DiagnosticReport.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DiagnosticReport.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DiagnosticReport.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DiagnosticReport.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DiagnosticReport.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DiagnosticReport.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DiagnosticReport.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "DiagnosticReport.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "DiagnosticReport.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DiagnosticReport.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/diagnostic-report-status"}},
+	{Path: "DiagnosticReport.category", Name: "category", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "DiagnosticReport.code", Name: "code", Field: 16, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DiagnosticReport.subject", Name: "subject", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/Substance"}},
+	{Path: "DiagnosticReport.relatesTo", Name: "relatesTo", Field: 18, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "DiagnosticReport.encounter", Name: "encounter", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "DiagnosticReport.effective[x]", Name: "effective", Field: 20, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "DiagnosticReport.issued", Name: "issued", Field: 22, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "DiagnosticReport.procedure", Name: "procedure", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Procedure"}},
+	{Path: "DiagnosticReport.performer", Name: "performer", Field: 25, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "DiagnosticReport.resultsInterpreter", Name: "resultsInterpreter", Field: 26, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "DiagnosticReport.specimen", Name: "specimen", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Specimen"}},
+	{Path: "DiagnosticReport.result", Name: "result", Field: 28, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Observation"}},
+	{Path: "DiagnosticReport.note", Name: "note", Field: 29, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "DiagnosticReport.study", Name: "study", Field: 30, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ImagingStudy"}},
+	{Path: "DiagnosticReport.supportingInfo", Name: "supportingInfo", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DiagnosticReport.media", Name: "media", Field: 32, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DiagnosticReport.composition", Name: "composition", Field: 33, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "DiagnosticReport.conclusion", Name: "conclusion", Field: 34, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "DiagnosticReport.conclusionCode", Name: "conclusionCode", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "DiagnosticReport.recomendation", Name: "recomendation", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "DiagnosticReport.presentedForm", Name: "presentedForm", Field: 38, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "DiagnosticReport.communication", Name: "communication", Field: 39, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "DiagnosticReport.comparison", Name: "comparison", Field: 40, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *DiagnosticReport) ElementMetadata() []ElementMetadata {
+	return diagnosticReportElementMetadata
+}
+
+// DiagnosticReportEffective is the type of DiagnosticReport.effective[x]. It is implemented by the DiagnosticReportEffective* variant types.
+type DiagnosticReportEffective interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDiagnosticReportEffective()
+}
+
+// DiagnosticReportEffectiveDateTime is the dateTime variant of DiagnosticReportEffective.
+type DiagnosticReportEffectiveDateTime struct {
+	DateTime
+}
+
+func (v DiagnosticReportEffectiveDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DiagnosticReportEffectiveDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v DiagnosticReportEffectiveDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DiagnosticReportEffectiveDateTime) isDiagnosticReportEffective() {}
+
+// DiagnosticReportEffectivePeriod is the Period variant of DiagnosticReportEffective.
+type DiagnosticReportEffectivePeriod struct {
+	Period
+}
+
+func (v DiagnosticReportEffectivePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DiagnosticReportEffectivePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DiagnosticReportEffectivePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DiagnosticReportEffectivePeriod) isDiagnosticReportEffective() {}
+
+var diagnosticReportEffectiveVariants = []DiagnosticReportEffective{
+	DiagnosticReportEffectiveDateTime{},
+	DiagnosticReportEffectivePeriod{},
+}
+
+func (r *DiagnosticReport) GetResourceType() string {
+	return "DiagnosticReport"
+}
+
+func (r *DiagnosticReport) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DiagnosticReport) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DiagnosticReport) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DiagnosticReport) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DiagnosticReport) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DiagnosticReport) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DiagnosticReport) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DiagnosticReport)(nil)
+
 type DiagnosticReportSupportingInfo struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type      *CodeableConcept `json:"type" bson:"type"`                 // Supporting information role code
-	Reference *Reference       `json:"reference" bson:"reference"`       // Supporting information reference
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Supporting information role code
+	Reference         *Reference       `json:"reference" bson:"reference"`                                      // Supporting information reference
 }
 
 func (r *DiagnosticReportSupportingInfo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -208,16 +763,98 @@ func (r *DiagnosticReportSupportingInfo) Validate() error {
 	return nil
 }
 
+func (r *DiagnosticReportSupportingInfo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Reference == nil {
+		issues.add("required", path+".reference", "field 'Reference' is required")
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+}
+
+var diagnosticReportSupportingInfoInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DiagnosticReportSupportingInfo) invariants() []invariant {
+	return diagnosticReportSupportingInfoInvariants
+}
+
+func (r DiagnosticReportSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DiagnosticReportSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DiagnosticReportSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DiagnosticReportSupportingInfo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var diagnosticReportSupportingInfoElementMetadata = []ElementMetadata{
+	{Path: "DiagnosticReport.supportingInfo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DiagnosticReport.supportingInfo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DiagnosticReport.supportingInfo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DiagnosticReport.supportingInfo.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "DiagnosticReport.supportingInfo.reference", Name: "reference", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *DiagnosticReportSupportingInfo) ElementMetadata() []ElementMetadata {
+	return diagnosticReportSupportingInfoElementMetadata
+}
+
 type DiagnosticReportMedia struct {
-	Id      *string    `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Comment *string    `json:"comment,omitempty" bson:"comment,omitempty"` // Comment about the image or data (e.g. explanation)
-	Link    *Reference `json:"link" bson:"link"`                           // Reference to the image or data source
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Comment           *string     `json:"comment,omitempty" bson:"comment,omitempty"`                      // Comment about the image or data (e.g. explanation)
+	CommentElement    *Element    `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
+	Link              *Reference  `json:"link" bson:"link"`                                                // Reference to the image or data source
 }
 
+var diagnosticReportMediaLinkTargets = []string{"DocumentReference"}
+
 func (r *DiagnosticReportMedia) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
 	if r.Link == nil {
 		return fmt.Errorf("field 'Link' is required")
 	}
+	if r.Link != nil {
+		if target := invalidReferenceTarget(r.Link.Reference, r.Link.Type, diagnosticReportMediaLinkTargets); target != "" {
+			return fmt.Errorf("field 'Link' cannot refer to %s, expected one of %v", target, diagnosticReportMediaLinkTargets)
+		}
+	}
 	if r.Link != nil {
 		if err := r.Link.Validate(); err != nil {
 			return fmt.Errorf("Link: %w", err)
@@ -225,3 +862,62 @@ func (r *DiagnosticReportMedia) Validate() error {
 	}
 	return nil
 }
+
+func (r *DiagnosticReportMedia) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.Link == nil {
+		issues.add("required", path+".link", "field 'Link' is required")
+	}
+	if r.Link != nil {
+		if target := invalidReferenceTarget(r.Link.Reference, r.Link.Type, diagnosticReportMediaLinkTargets); target != "" {
+			issues.add("value", path+".link", fmt.Sprintf("field 'Link' cannot refer to %s, expected one of %v", target, diagnosticReportMediaLinkTargets))
+		}
+	}
+	if r.Link != nil {
+		r.Link.validateAll(path+".link", issues)
+	}
+}
+
+var diagnosticReportMediaInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DiagnosticReportMedia) invariants() []invariant {
+	return diagnosticReportMediaInvariants
+}
+
+func (r DiagnosticReportMedia) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DiagnosticReportMedia) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DiagnosticReportMedia) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DiagnosticReportMedia) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var diagnosticReportMediaElementMetadata = []ElementMetadata{
+	{Path: "DiagnosticReport.media.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DiagnosticReport.media.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DiagnosticReport.media.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DiagnosticReport.media.comment", Name: "comment", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DiagnosticReport.media.link", Name: "link", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DocumentReference"}},
+}
+
+func (r *DiagnosticReportMedia) ElementMetadata() []ElementMetadata {
+	return diagnosticReportMediaElementMetadata
+}
@@ -1,9 +1,137 @@
 package models
 
-// A client can ask a server to generate a fully bundled document from a composition resource. The server takes the composition resource, locates all the referenced resources and other additional resources as configured or requested and either returns a full document bundle, or returns an error. If some of the resources are located on other servers, it is at the discretion of the  server whether to retrieve them or return an error. If the correct version of the document  that would be generated already exists, then the server can return the existing one.
-type Document struct {
+import "fmt"
+
+// DocumentIn holds the input parameters of the $document operation. A client can ask a server to generate a fully bundled document from a composition resource. The server takes the composition resource, locates all the referenced resources and other additional resources as configured or requested and either returns a full document bundle, or returns an error. If some of the resources are located on other servers, it is at the discretion of the  server whether to retrieve them or return an error. If the correct version of the document  that would be generated already exists, then the server can return the existing one.
+type DocumentIn struct {
+	// Identifies the composition to use
+	Id *string
+	// Whether to store the document at the bundle end-point (/Bundle) or not once it is generated
+	Persist *bool
+	// Canonical reference to a GraphDefinition
+	Graph *string
+}
+
+func (p *DocumentIn) Validate() error {
+	return nil
+}
+
+func (p *DocumentIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *DocumentIn) FromParameters(params *Parameters) error {
+	*p = DocumentIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *DocumentIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Id != nil {
+		params = append(params, ParametersParameter{Name: "id", Value: ParametersParameterValueUri(*p.Id)})
+	}
+	if p.Persist != nil {
+		params = append(params, ParametersParameter{Name: "persist", Value: ParametersParameterValueBoolean(*p.Persist)})
+	}
+	if p.Graph != nil {
+		params = append(params, ParametersParameter{Name: "graph", Value: ParametersParameterValueUri(*p.Graph)})
+	}
+	return params
+}
+
+func (p *DocumentIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "id":
+			if p.Id != nil {
+				return fmt.Errorf("parameter 'id' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Id = &value
+		case "persist":
+			if p.Persist != nil {
+				return fmt.Errorf("parameter 'persist' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.Persist = &value
+		case "graph":
+			if p.Graph != nil {
+				return fmt.Errorf("parameter 'graph' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Graph = &value
+		}
+	}
+	return nil
+}
+
+// DocumentOut holds the output parameters of the $document operation.
+type DocumentOut struct {
+	// The generated document bundle
+	Return *Bundle
+}
+
+func (p *DocumentOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *DocumentOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *DocumentOut) FromParameters(params *Parameters) error {
+	*p = DocumentOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *DocumentOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Document) Validate() error {
+func (p *DocumentOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
@@ -2,44 +2,64 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A reference to a document of any kind for any purpose. While the term “document” implies a more narrow focus, for this resource this “document” encompasses *any* serialized object with a mime-type, it includes formal patient-centric documents (CDA), clinical notes, scanned paper, non-patient specific documents like policy text, as well as a photo, video, or audio recording acquired or used in healthcare.  The DocumentReference resource provides metadata about the document so that the document can be discovered and managed.  The actual content may be inline base64 encoded data or provided by direct reference.
 type DocumentReference struct {
-	ResourceType    string                       `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id              *string                      `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta            *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules   *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language        *string                      `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	Text            *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                        // Text summary of the resource, for human interpretation
-	Contained       []json.RawMessage            `json:"contained,omitempty" bson:"contained,omitempty"`              // Contained, inline Resources
-	Identifier      []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`            // Business identifiers for the document
-	Version         *string                      `json:"version,omitempty" bson:"version,omitempty"`                  // An explicitly assigned identifier of a variation of the content in the DocumentReference
-	BasedOn         []Reference                  `json:"basedOn,omitempty" bson:"based_on,omitempty"`                 // Procedure that caused this media to be created
-	Status          string                       `json:"status" bson:"status"`                                        // current | superseded | entered-in-error
-	DocStatus       *string                      `json:"docStatus,omitempty" bson:"doc_status,omitempty"`             // registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | deprecated | unknown
-	Modality        []CodeableConcept            `json:"modality,omitempty" bson:"modality,omitempty"`                // Imaging modality used
-	Type            *CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                        // Kind of document (LOINC if possible)
-	Category        []CodeableConcept            `json:"category,omitempty" bson:"category,omitempty"`                // Categorization of document
-	Subject         *Reference                   `json:"subject,omitempty" bson:"subject,omitempty"`                  // Who/what is the subject of the document
-	Context         []Reference                  `json:"context,omitempty" bson:"context,omitempty"`                  // Encounter the document reference is part of
-	Event           []CodeableReference          `json:"event,omitempty" bson:"event,omitempty"`                      // Main clinical acts documented
-	Related         []Reference                  `json:"related,omitempty" bson:"related,omitempty"`                  // Related identifiers or resources associated with the document reference
-	BodyStructure   []CodeableReference          `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`     // Body structure included
-	FacilityType    *CodeableConcept             `json:"facilityType,omitempty" bson:"facility_type,omitempty"`       // Kind of facility where patient was seen
-	PracticeSetting *CodeableConcept             `json:"practiceSetting,omitempty" bson:"practice_setting,omitempty"` // Additional details about where the content was created (e.g. clinical specialty)
-	Period          *Period                      `json:"period,omitempty" bson:"period,omitempty"`                    // Time of service that is being documented
-	Date            *string                      `json:"date,omitempty" bson:"date,omitempty"`                        // When this document reference was created
-	Author          []Reference                  `json:"author,omitempty" bson:"author,omitempty"`                    // Who and/or what authored the document
-	Attester        []DocumentReferenceAttester  `json:"attester,omitempty" bson:"attester,omitempty"`                // Attests to accuracy of the document
-	Custodian       *Reference                   `json:"custodian,omitempty" bson:"custodian,omitempty"`              // Organization which maintains the document
-	RelatesTo       []DocumentReferenceRelatesTo `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`             // Relationships to other documents
-	Description     *string                      `json:"description,omitempty" bson:"description,omitempty"`          // Human-readable description
-	SecurityLabel   []CodeableConcept            `json:"securityLabel,omitempty" bson:"security_label,omitempty"`     // Document security-tags
-	Content         []DocumentReferenceContent   `json:"content" bson:"content"`                                      // Document referenced
+	ResourceType         string                       `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                      `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                      `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifiers for the document
+	Version              *string                      `json:"version,omitempty" bson:"version,omitempty"`                       // An explicitly assigned identifier of a variation of the content in the DocumentReference
+	VersionElement       *Element                     `json:"_version,omitempty" bson:"version_element,omitempty"`              // Extensions for version
+	BasedOn              []Reference                  `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Procedure that caused this media to be created
+	Status               DocumentReferenceStatus      `json:"status" bson:"status"`                                             // current | superseded | entered-in-error
+	StatusElement        *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	DocStatus            *CompositionStatus           `json:"docStatus,omitempty" bson:"doc_status,omitempty"`                  // registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | deprecated | unknown
+	DocStatusElement     *Element                     `json:"_docStatus,omitempty" bson:"doc_status_element,omitempty"`         // Extensions for docStatus
+	Modality             []CodeableConcept            `json:"modality,omitempty" bson:"modality,omitempty"`                     // Imaging modality used
+	Type                 *CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                             // Kind of document (LOINC if possible)
+	Category             []CodeableConcept            `json:"category,omitempty" bson:"category,omitempty"`                     // Categorization of document
+	Subject              *Reference                   `json:"subject,omitempty" bson:"subject,omitempty"`                       // Who/what is the subject of the document
+	Context              []Reference                  `json:"context,omitempty" bson:"context,omitempty"`                       // Encounter the document reference is part of
+	Event                []CodeableReference          `json:"event,omitempty" bson:"event,omitempty"`                           // Main clinical acts documented
+	Related              []Reference                  `json:"related,omitempty" bson:"related,omitempty"`                       // Related identifiers or resources associated with the document reference
+	BodyStructure        []CodeableReference          `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`          // Body structure included
+	FacilityType         *CodeableConcept             `json:"facilityType,omitempty" bson:"facility_type,omitempty"`            // Kind of facility where patient was seen
+	PracticeSetting      *CodeableConcept             `json:"practiceSetting,omitempty" bson:"practice_setting,omitempty"`      // Additional details about where the content was created (e.g. clinical specialty)
+	Period               *Period                      `json:"period,omitempty" bson:"period,omitempty"`                         // Time of service that is being documented
+	Date                 *DateTime                    `json:"date,omitempty" bson:"date,omitempty"`                             // When this document reference was created
+	DateElement          *Element                     `json:"_date,omitempty" bson:"date_element,omitempty"`                    // Extensions for date
+	Author               []Reference                  `json:"author,omitempty" bson:"author,omitempty"`                         // Who and/or what authored the document
+	Attester             []DocumentReferenceAttester  `json:"attester,omitempty" bson:"attester,omitempty"`                     // Attests to accuracy of the document
+	Custodian            *Reference                   `json:"custodian,omitempty" bson:"custodian,omitempty"`                   // Organization which maintains the document
+	RelatesTo            []DocumentReferenceRelatesTo `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                  // Relationships to other documents
+	Description          *string                      `json:"description,omitempty" bson:"description,omitempty"`               // Human-readable description
+	DescriptionElement   *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	SecurityLabel        []CodeableConcept            `json:"securityLabel,omitempty" bson:"security_label,omitempty"`          // Document security-tags
+	Content              []DocumentReferenceContent   `json:"content" bson:"content"`                                           // Document referenced
 }
 
+var documentReferenceBasedOnTargets = []string{"Appointment", "AppointmentResponse", "CarePlan", "Claim", "CommunicationRequest", "Contract", "CoverageEligibilityRequest", "DeviceRequest", "EnrollmentRequest", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest", "VisionPrescription"}
+
+var documentReferenceContextTargets = []string{"Appointment", "Encounter", "EpisodeOfCare"}
+
+var documentReferenceBodyStructureTargets = []string{"BodyStructure"}
+
+var documentReferenceAuthorTargets = []string{"Practitioner", "PractitionerRole", "Organization", "Device", "Patient", "RelatedPerson", "CareTeam", "Group"}
+
+var documentReferenceCustodianTargets = []string{"Organization"}
+
 func (r *DocumentReference) Validate() error {
 	if r.ResourceType != "DocumentReference" {
 		return fmt.Errorf("invalid resourceType: expected 'DocumentReference', got '%s'", r.ResourceType)
@@ -49,25 +69,78 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, documentReferenceBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.DocStatus != nil && !r.DocStatus.IsValid() {
+		return fmt.Errorf("field 'DocStatus' has invalid code '%s'", *r.DocStatus)
+	}
+	if r.DocStatusElement != nil {
+		if err := r.DocStatusElement.Validate(); err != nil {
+			return fmt.Errorf("DocStatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Modality {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Modality[%d]: %w", i, err)
@@ -88,6 +161,11 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	for i, item := range r.Context {
+		if target := invalidReferenceTarget(item.Reference, item.Type, documentReferenceContextTargets); target != "" {
+			return fmt.Errorf("field 'Context[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceContextTargets)
+		}
+	}
 	for i, item := range r.Context {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Context[%d]: %w", i, err)
@@ -103,6 +181,13 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("Related[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BodyStructure {
+		if item.Reference != nil {
+			if target := invalidReferenceTarget(item.Reference.Reference, item.Reference.Type, documentReferenceBodyStructureTargets); target != "" {
+				return fmt.Errorf("field 'BodyStructure[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceBodyStructureTargets)
+			}
+		}
+	}
 	for i, item := range r.BodyStructure {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BodyStructure[%d]: %w", i, err)
@@ -123,6 +208,21 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	for i, item := range r.Author {
+		if target := invalidReferenceTarget(item.Reference, item.Type, documentReferenceAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceAuthorTargets)
+		}
+	}
 	for i, item := range r.Author {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Author[%d]: %w", i, err)
@@ -133,6 +233,11 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("Attester[%d]: %w", i, err)
 		}
 	}
+	if r.Custodian != nil {
+		if target := invalidReferenceTarget(r.Custodian.Reference, r.Custodian.Type, documentReferenceCustodianTargets); target != "" {
+			return fmt.Errorf("field 'Custodian' cannot refer to %s, expected one of %v", target, documentReferenceCustodianTargets)
+		}
+	}
 	if r.Custodian != nil {
 		if err := r.Custodian.Validate(); err != nil {
 			return fmt.Errorf("Custodian: %w", err)
@@ -143,6 +248,11 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.SecurityLabel {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("SecurityLabel[%d]: %w", i, err)
@@ -156,17 +266,325 @@ func (r *DocumentReference) Validate() error {
 			return fmt.Errorf("Content[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "DocumentReference")
+}
+
+func (r *DocumentReference) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "DocumentReference" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'DocumentReference', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, documentReferenceBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.DocStatus != nil && !r.DocStatus.IsValid() {
+		issues.add("code-invalid", path+".docStatus", fmt.Sprintf("field 'DocStatus' has invalid code '%s'", *r.DocStatus))
+	}
+	if r.DocStatusElement != nil {
+		r.DocStatusElement.validateAll(path+".docStatus", issues)
+	}
+	for i, item := range r.Modality {
+		item.validateAll(fmt.Sprintf("%s.modality[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.Context {
+		if target := invalidReferenceTarget(item.Reference, item.Type, documentReferenceContextTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.context[%d]", path, i), fmt.Sprintf("field 'Context[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceContextTargets))
+		}
+	}
+	for i, item := range r.Context {
+		item.validateAll(fmt.Sprintf("%s.context[%d]", path, i), issues)
+	}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
+	}
+	for i, item := range r.Related {
+		item.validateAll(fmt.Sprintf("%s.related[%d]", path, i), issues)
+	}
+	for i, item := range r.BodyStructure {
+		if item.Reference != nil {
+			if target := invalidReferenceTarget(item.Reference.Reference, item.Reference.Type, documentReferenceBodyStructureTargets); target != "" {
+				issues.add("value", fmt.Sprintf("%s.bodyStructure[%d]", path, i), fmt.Sprintf("field 'BodyStructure[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceBodyStructureTargets))
+			}
+		}
+	}
+	for i, item := range r.BodyStructure {
+		item.validateAll(fmt.Sprintf("%s.bodyStructure[%d]", path, i), issues)
+	}
+	if r.FacilityType != nil {
+		r.FacilityType.validateAll(path+".facilityType", issues)
+	}
+	if r.PracticeSetting != nil {
+		r.PracticeSetting.validateAll(path+".practiceSetting", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	for i, item := range r.Author {
+		if target := invalidReferenceTarget(item.Reference, item.Type, documentReferenceAuthorTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.author[%d]", path, i), fmt.Sprintf("field 'Author[%d]' cannot refer to %s, expected one of %v", i, target, documentReferenceAuthorTargets))
+		}
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Attester {
+		item.validateAll(fmt.Sprintf("%s.attester[%d]", path, i), issues)
+	}
+	if r.Custodian != nil {
+		if target := invalidReferenceTarget(r.Custodian.Reference, r.Custodian.Type, documentReferenceCustodianTargets); target != "" {
+			issues.add("value", path+".custodian", fmt.Sprintf("field 'Custodian' cannot refer to %s, expected one of %v", target, documentReferenceCustodianTargets))
+		}
+	}
+	if r.Custodian != nil {
+		r.Custodian.validateAll(path+".custodian", issues)
+	}
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.SecurityLabel {
+		item.validateAll(fmt.Sprintf("%s.securityLabel[%d]", path, i), issues)
+	}
+	if len(r.Content) < 1 {
+		issues.add("required", path+".content", "field 'Content' must have at least 1 elements")
+	}
+	for i, item := range r.Content {
+		item.validateAll(fmt.Sprintf("%s.content[%d]", path, i), issues)
+	}
+}
+
+func (r *DocumentReference) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DocumentReference", &issues)
+	checkInvariants(r, "DocumentReference", &issues)
+	return issues
+}
+
+var documentReferenceInvariants = []invariant{
+	{key: "docRef-1", severity: "warning", human: "facilityType SHALL only be present if context is not an encounter", expression: "facilityType.empty() or context.where(resolve() is Encounter).empty()"},
+	{key: "docRef-2", severity: "warning", human: "practiceSetting SHALL only be present if context is not present", expression: "practiceSetting.empty() or context.where(resolve() is Encounter).empty()"},
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *DocumentReference) invariants() []invariant {
+	return documentReferenceInvariants
+}
+
+func (r *DocumentReference) UnmarshalJSON(data []byte) error {
+	type alias DocumentReference
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r DocumentReference) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DocumentReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DocumentReference) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DocumentReference) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var documentReferenceElementTypes = map[string]string{
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *DocumentReference) elementTypes() map[string]string {
+	return documentReferenceElementTypes
+}
+
+var documentReferenceElementMetadata = []ElementMetadata{
+	{Path: "DocumentReference.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DocumentReference.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "DocumentReference.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DocumentReference.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "DocumentReference.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "DocumentReference.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "DocumentReference.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DocumentReference.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}, Summary: true},
+	{Path: "DocumentReference.version", Name: "version", Field: 12, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "DocumentReference.basedOn", Name: "basedOn", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "DocumentReference.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DocumentReference.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/document-reference-status", Description: "The status of the document reference."}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.docStatus", Name: "docStatus", Field: 17, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DocumentReference.docStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/composition-status", Description: "Status of the underlying document."}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.modality", Name: "modality", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.modality", Strength: "extensible", ValueSet: "http://dicom.nema.org/medical/dicom/current/output/chtml/part16/sect_CID_33.html", Description: "Type of acquired data in the instance."}, Summary: true},
+	{Path: "DocumentReference.type", Name: "type", Field: 20, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.type", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/doc-typecodes", Description: "Precise type of clinical document."}, Summary: true},
+	{Path: "DocumentReference.category", Name: "category", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.category", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/referenced-item-category", Description: "High-level kind of document at a macro level."}, Summary: true},
+	{Path: "DocumentReference.subject", Name: "subject", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Summary: true},
+	{Path: "DocumentReference.context", Name: "context", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare"}, Summary: true},
+	{Path: "DocumentReference.event", Name: "event", Field: 24, Min: 0, Max: "*", Types: []string{"CodeableReference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Binding: &BindingDefinition{Path: "DocumentReference.event", Strength: "example", ValueSet: "http://terminology.hl7.org/ValueSet/v3-ActCode", Description: "This list of codes represents the main clinical acts being documented."}},
+	{Path: "DocumentReference.related", Name: "related", Field: 25, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}},
+	{Path: "DocumentReference.bodyStructure", Name: "bodyStructure", Field: 26, Min: 0, Max: "*", Types: []string{"CodeableReference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BodyStructure"}, Binding: &BindingDefinition{Path: "DocumentReference.bodyStructure", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/body-site", Description: "SNOMED CT Body Structures"}, Summary: true},
+	{Path: "DocumentReference.facilityType", Name: "facilityType", Field: 27, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.facilityType", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/c80-facilitycodes", Description: "XDS Facility Type."}},
+	{Path: "DocumentReference.practiceSetting", Name: "practiceSetting", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.practiceSetting", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/c80-practice-codes", Description: "Additional details about where the content was created (e.g. clinical specialty)."}},
+	{Path: "DocumentReference.period", Name: "period", Field: 29, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+	{Path: "DocumentReference.date", Name: "date", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime"}, Summary: true},
+	{Path: "DocumentReference.author", Name: "author", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Group"}, Summary: true},
+	{Path: "DocumentReference.attester", Name: "attester", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "DocumentReference.custodian", Name: "custodian", Field: 34, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "DocumentReference.relatesTo", Name: "relatesTo", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}, Summary: true},
+	{Path: "DocumentReference.description", Name: "description", Field: 36, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "DocumentReference.securityLabel", Name: "securityLabel", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.securityLabel", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/security-label-example", Description: "Example Security Labels from the Healthcare Privacy and Security Classification System."}, Summary: true},
+	{Path: "DocumentReference.content", Name: "content", Field: 39, Min: 1, Max: "*", Types: []string{"BackboneElement"}, Summary: true},
+}
+
+func (r *DocumentReference) ElementMetadata() []ElementMetadata {
+	return documentReferenceElementMetadata
+}
+
+func (r *DocumentReference) GetResourceType() string {
+	return "DocumentReference"
+}
+
+func (r *DocumentReference) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *DocumentReference) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *DocumentReference) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *DocumentReference) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *DocumentReference) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *DocumentReference) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *DocumentReference) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*DocumentReference)(nil)
+
 type DocumentReferenceAttester struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Mode  *CodeableConcept `json:"mode" bson:"mode"`                       // personal | professional | legal | official
-	Time  *string          `json:"time,omitempty" bson:"time,omitempty"`   // When the document was attested
-	Party *Reference       `json:"party,omitempty" bson:"party,omitempty"` // Who attested the document
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Mode              *CodeableConcept `json:"mode" bson:"mode"`                                                // personal | professional | legal | official
+	Time              *DateTime        `json:"time,omitempty" bson:"time,omitempty"`                            // When the document was attested
+	TimeElement       *Element         `json:"_time,omitempty" bson:"time_element,omitempty"`                   // Extensions for time
+	Party             *Reference       `json:"party,omitempty" bson:"party,omitempty"`                          // Who attested the document
 }
 
+var documentReferenceAttesterPartyTargets = []string{"Patient", "RelatedPerson", "Practitioner", "PractitionerRole", "Organization", "Group"}
+
 func (r *DocumentReferenceAttester) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Mode == nil {
 		return fmt.Errorf("field 'Mode' is required")
 	}
@@ -175,6 +593,21 @@ func (r *DocumentReferenceAttester) Validate() error {
 			return fmt.Errorf("Mode: %w", err)
 		}
 	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			return fmt.Errorf("Time: %w", err)
+		}
+	}
+	if r.TimeElement != nil {
+		if err := r.TimeElement.Validate(); err != nil {
+			return fmt.Errorf("TimeElement: %w", err)
+		}
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, documentReferenceAttesterPartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, documentReferenceAttesterPartyTargets)
+		}
+	}
 	if r.Party != nil {
 		if err := r.Party.Validate(); err != nil {
 			return fmt.Errorf("Party: %w", err)
@@ -183,13 +616,95 @@ func (r *DocumentReferenceAttester) Validate() error {
 	return nil
 }
 
+func (r *DocumentReferenceAttester) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Mode == nil {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != nil {
+		r.Mode.validateAll(path+".mode", issues)
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			issues.add("value", path+".time", err.Error())
+		}
+	}
+	if r.TimeElement != nil {
+		r.TimeElement.validateAll(path+".time", issues)
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, documentReferenceAttesterPartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, documentReferenceAttesterPartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+}
+
+var documentReferenceAttesterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DocumentReferenceAttester) invariants() []invariant {
+	return documentReferenceAttesterInvariants
+}
+
+func (r DocumentReferenceAttester) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DocumentReferenceAttester) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DocumentReferenceAttester) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DocumentReferenceAttester) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var documentReferenceAttesterElementMetadata = []ElementMetadata{
+	{Path: "DocumentReference.attester.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DocumentReference.attester.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DocumentReference.attester.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.attester.mode", Name: "mode", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.attester.mode", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/composition-attestation-mode", Description: "The way in which a person authenticated a document."}},
+	{Path: "DocumentReference.attester.time", Name: "time", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "DocumentReference.attester.party", Name: "party", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Group"}},
+}
+
+func (r *DocumentReferenceAttester) ElementMetadata() []ElementMetadata {
+	return documentReferenceAttesterElementMetadata
+}
+
 type DocumentReferenceRelatesTo struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Code   *CodeableConcept `json:"code" bson:"code"`                 // The relationship type with another document
-	Target *Reference       `json:"target" bson:"target"`             // Target of the relationship
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code" bson:"code"`                                                // The relationship type with another document
+	Target            *Reference       `json:"target" bson:"target"`                                            // Target of the relationship
 }
 
+var documentReferenceRelatesToTargetTargets = []string{"DocumentReference"}
+
 func (r *DocumentReferenceRelatesTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -201,6 +716,11 @@ func (r *DocumentReferenceRelatesTo) Validate() error {
 	if r.Target == nil {
 		return fmt.Errorf("field 'Target' is required")
 	}
+	if r.Target != nil {
+		if target := invalidReferenceTarget(r.Target.Reference, r.Target.Type, documentReferenceRelatesToTargetTargets); target != "" {
+			return fmt.Errorf("field 'Target' cannot refer to %s, expected one of %v", target, documentReferenceRelatesToTargetTargets)
+		}
+	}
 	if r.Target != nil {
 		if err := r.Target.Validate(); err != nil {
 			return fmt.Errorf("Target: %w", err)
@@ -209,13 +729,87 @@ func (r *DocumentReferenceRelatesTo) Validate() error {
 	return nil
 }
 
+func (r *DocumentReferenceRelatesTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		if target := invalidReferenceTarget(r.Target.Reference, r.Target.Type, documentReferenceRelatesToTargetTargets); target != "" {
+			issues.add("value", path+".target", fmt.Sprintf("field 'Target' cannot refer to %s, expected one of %v", target, documentReferenceRelatesToTargetTargets))
+		}
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target", issues)
+	}
+}
+
+var documentReferenceRelatesToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DocumentReferenceRelatesTo) invariants() []invariant {
+	return documentReferenceRelatesToInvariants
+}
+
+func (r DocumentReferenceRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DocumentReferenceRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DocumentReferenceRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DocumentReferenceRelatesTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var documentReferenceRelatesToElementMetadata = []ElementMetadata{
+	{Path: "DocumentReference.relatesTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DocumentReference.relatesTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DocumentReference.relatesTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.relatesTo.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DocumentReference.relatesTo.code", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/document-relationship-type", Description: "The type of relationship between the documents."}, Summary: true},
+	{Path: "DocumentReference.relatesTo.target", Name: "target", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DocumentReference"}, Summary: true},
+}
+
+func (r *DocumentReferenceRelatesTo) ElementMetadata() []ElementMetadata {
+	return documentReferenceRelatesToElementMetadata
+}
+
 type DocumentReferenceContent struct {
-	Id         *string                           `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Attachment *Attachment                       `json:"attachment" bson:"attachment"`               // Where to access the document
-	Profile    []DocumentReferenceContentProfile `json:"profile,omitempty" bson:"profile,omitempty"` // Content profile rules for the document
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Attachment        *Attachment                       `json:"attachment" bson:"attachment"`                                    // Where to access the document
+	Profile           []DocumentReferenceContentProfile `json:"profile,omitempty" bson:"profile,omitempty"`                      // Content profile rules for the document
 }
 
 func (r *DocumentReferenceContent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Attachment == nil {
 		return fmt.Errorf("field 'Attachment' is required")
 	}
@@ -232,27 +826,265 @@ func (r *DocumentReferenceContent) Validate() error {
 	return nil
 }
 
+func (r *DocumentReferenceContent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Attachment == nil {
+		issues.add("required", path+".attachment", "field 'Attachment' is required")
+	}
+	if r.Attachment != nil {
+		r.Attachment.validateAll(path+".attachment", issues)
+	}
+	for i, item := range r.Profile {
+		item.validateAll(fmt.Sprintf("%s.profile[%d]", path, i), issues)
+	}
+}
+
+var documentReferenceContentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DocumentReferenceContent) invariants() []invariant {
+	return documentReferenceContentInvariants
+}
+
+func (r DocumentReferenceContent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DocumentReferenceContent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DocumentReferenceContent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DocumentReferenceContent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var documentReferenceContentElementMetadata = []ElementMetadata{
+	{Path: "DocumentReference.content.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DocumentReference.content.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DocumentReference.content.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.content.attachment", Name: "attachment", Field: 3, Min: 1, Max: "1", Types: []string{"Attachment"}, Summary: true},
+	{Path: "DocumentReference.content.profile", Name: "profile", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}, Summary: true},
+}
+
+func (r *DocumentReferenceContent) ElementMetadata() []ElementMetadata {
+	return documentReferenceContentElementMetadata
+}
+
 type DocumentReferenceContentProfile struct {
-	Id             *string `json:"id,omitempty" bson:"id,omitempty"`      // Unique id for inter-element referencing
-	ValueCoding    *Coding `json:"valueCoding" bson:"value_coding"`       // Code|uri|canonical
-	ValueUri       *string `json:"valueUri" bson:"value_uri"`             // Code|uri|canonical
-	ValueCanonical *string `json:"valueCanonical" bson:"value_canonical"` // Code|uri|canonical
+	Id                *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             DocumentReferenceContentProfileValue `json:"-" bson:"value,omitempty"`                                        // Code|uri|canonical
+	ValueElement      *Element                             `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of DocumentReference.content.profile.value[x] when more than one was decoded
 }
 
 func (r *DocumentReferenceContentProfile) Validate() error {
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DocumentReferenceContentProfile) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var documentReferenceContentProfileInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DocumentReferenceContentProfile) invariants() []invariant {
+	return documentReferenceContentProfileInvariants
+}
+
+func (r DocumentReferenceContentProfile) MarshalJSON() ([]byte, error) {
+	type alias DocumentReferenceContentProfile
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DocumentReferenceContentProfile) UnmarshalJSON(data []byte) error {
+	type alias DocumentReferenceContentProfile
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", documentReferenceContentProfileValueVariants); err != nil {
+		return err
 	}
-	if r.ValueCanonical == nil {
-		return fmt.Errorf("field 'ValueCanonical' is required")
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
 	}
 	return nil
 }
+
+func (r DocumentReferenceContentProfile) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DocumentReferenceContentProfile) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DocumentReferenceContentProfile) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DocumentReferenceContentProfile) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DocumentReferenceContentProfile) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(documentReferenceContentProfileValueVariants)
+	}
+	return "", nil
+}
+
+var documentReferenceContentProfileElementMetadata = []ElementMetadata{
+	{Path: "DocumentReference.content.profile.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DocumentReference.content.profile.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DocumentReference.content.profile.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DocumentReference.content.profile.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"Coding", "uri", "canonical"}, Binding: &BindingDefinition{Path: "DocumentReference.content.profile.value[x]", Strength: "preferred", ValueSet: "http://terminology.hl7.org/ValueSet/v3-HL7FormatCodes", Description: "Document Format Codes."}, Summary: true},
+}
+
+func (r *DocumentReferenceContentProfile) ElementMetadata() []ElementMetadata {
+	return documentReferenceContentProfileElementMetadata
+}
+
+// DocumentReferenceContentProfileValue is the type of DocumentReference.content.profile.value[x]. It is implemented by the DocumentReferenceContentProfileValue* variant types.
+type DocumentReferenceContentProfileValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDocumentReferenceContentProfileValue()
+}
+
+// DocumentReferenceContentProfileValueCoding is the Coding variant of DocumentReferenceContentProfileValue.
+type DocumentReferenceContentProfileValueCoding struct {
+	Coding
+}
+
+func (v DocumentReferenceContentProfileValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v DocumentReferenceContentProfileValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v DocumentReferenceContentProfileValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v DocumentReferenceContentProfileValueCoding) isDocumentReferenceContentProfileValue() {}
+
+// DocumentReferenceContentProfileValueUri is the uri variant of DocumentReferenceContentProfileValue.
+type DocumentReferenceContentProfileValueUri string
+
+func (v DocumentReferenceContentProfileValueUri) Validate() error {
+	return nil
+}
+
+func (v DocumentReferenceContentProfileValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DocumentReferenceContentProfileValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v DocumentReferenceContentProfileValueUri) isDocumentReferenceContentProfileValue() {}
+
+// DocumentReferenceContentProfileValueCanonical is the canonical variant of DocumentReferenceContentProfileValue.
+type DocumentReferenceContentProfileValueCanonical string
+
+func (v DocumentReferenceContentProfileValueCanonical) Validate() error {
+	return nil
+}
+
+func (v DocumentReferenceContentProfileValueCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v DocumentReferenceContentProfileValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v DocumentReferenceContentProfileValueCanonical) isDocumentReferenceContentProfileValue() {}
+
+var documentReferenceContentProfileValueVariants = []DocumentReferenceContentProfileValue{
+	DocumentReferenceContentProfileValueCoding{},
+	DocumentReferenceContentProfileValueUri(""),
+	DocumentReferenceContentProfileValueCanonical(""),
+}
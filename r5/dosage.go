@@ -1,42 +1,74 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Dosage Type: Indicates how the medication is/was taken or should be taken by the patient.
 type Dosage struct {
-	Id                    *string             `json:"id,omitempty" bson:"id,omitempty"`                                        // Unique id for inter-element referencing
-	Condition             []DosageCondition   `json:"condition,omitempty" bson:"condition,omitempty"`                          // Whether the dosage applies (e.g. as a whole) (any can be true)
-	Text                  *string             `json:"text,omitempty" bson:"text,omitempty"`                                    // Free text dosage instructions e.g. SIG
-	AdditionalInstruction []CodeableConcept   `json:"additionalInstruction,omitempty" bson:"additional_instruction,omitempty"` // Supplemental instruction or warnings to the patient - e.g. "with meals", "may cause drowsiness"
-	PatientInstruction    *string             `json:"patientInstruction,omitempty" bson:"patient_instruction,omitempty"`       // Patient or consumer oriented instructions
-	Timing                *Timing             `json:"timing,omitempty" bson:"timing,omitempty"`                                // When medication should be administered
-	AsNeeded              *bool               `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                           // Take "as needed"
-	AsNeededFor           []CodeableConcept   `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`                    // Take "as needed" (for x)
-	Site                  *CodeableConcept    `json:"site,omitempty" bson:"site,omitempty"`                                    // Body site to administer to
-	Route                 *CodeableConcept    `json:"route,omitempty" bson:"route,omitempty"`                                  // How drug should enter body
-	Method                *CodeableConcept    `json:"method,omitempty" bson:"method,omitempty"`                                // Technique for administering medication
-	DoseAndRate           []DosageDoseAndRate `json:"doseAndRate,omitempty" bson:"dose_and_rate,omitempty"`                    // Amount of medication administered, to be administered or typical amount to be administered
-	Safety                *DosageSafety       `json:"safety,omitempty" bson:"safety,omitempty"`                                // Safety Information about the this dosage instructions
+	Id                        *string             `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
+	Extension                 []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                             // Additional content defined by implementations
+	ModifierExtension         []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`            // Extensions that cannot be ignored even if unrecognized
+	Condition                 []DosageCondition   `json:"condition,omitempty" bson:"condition,omitempty"`                             // Whether the dosage applies (e.g. as a whole) (any can be true)
+	Text                      *string             `json:"text,omitempty" bson:"text,omitempty"`                                       // Free text dosage instructions e.g. SIG
+	TextElement               *Element            `json:"_text,omitempty" bson:"text_element,omitempty"`                              // Extensions for text
+	AdditionalInstruction     []CodeableConcept   `json:"additionalInstruction,omitempty" bson:"additional_instruction,omitempty"`    // Supplemental instruction or warnings to the patient - e.g. "with meals", "may cause drowsiness"
+	PatientInstruction        *string             `json:"patientInstruction,omitempty" bson:"patient_instruction,omitempty"`          // Patient or consumer oriented instructions
+	PatientInstructionElement *Element            `json:"_patientInstruction,omitempty" bson:"patient_instruction_element,omitempty"` // Extensions for patientInstruction
+	Timing                    *Timing             `json:"timing,omitempty" bson:"timing,omitempty"`                                   // When medication should be administered
+	AsNeeded                  *bool               `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                              // Take "as needed"
+	AsNeededElement           *Element            `json:"_asNeeded,omitempty" bson:"as_needed_element,omitempty"`                     // Extensions for asNeeded
+	AsNeededFor               []CodeableConcept   `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`                       // Take "as needed" (for x)
+	Site                      *CodeableConcept    `json:"site,omitempty" bson:"site,omitempty"`                                       // Body site to administer to
+	Route                     *CodeableConcept    `json:"route,omitempty" bson:"route,omitempty"`                                     // How drug should enter body
+	Method                    *CodeableConcept    `json:"method,omitempty" bson:"method,omitempty"`                                   // Technique for administering medication
+	DoseAndRate               []DosageDoseAndRate `json:"doseAndRate,omitempty" bson:"dose_and_rate,omitempty"`                       // Amount of medication administered, to be administered or typical amount to be administered
+	Safety                    *DosageSafety       `json:"safety,omitempty" bson:"safety,omitempty"`                                   // Safety Information about the this dosage instructions
 }
 
 func (r *Dosage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Condition {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Condition[%d]: %w", i, err)
 		}
 	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	for i, item := range r.AdditionalInstruction {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("AdditionalInstruction[%d]: %w", i, err)
 		}
 	}
+	if r.PatientInstructionElement != nil {
+		if err := r.PatientInstructionElement.Validate(); err != nil {
+			return fmt.Errorf("PatientInstructionElement: %w", err)
+		}
+	}
 	if r.Timing != nil {
 		if err := r.Timing.Validate(); err != nil {
 			return fmt.Errorf("Timing: %w", err)
 		}
 	}
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
+		}
+	}
 	for i, item := range r.AsNeededFor {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("AsNeededFor[%d]: %w", i, err)
@@ -70,58 +102,411 @@ func (r *Dosage) Validate() error {
 	return nil
 }
 
+func (r *Dosage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	for i, item := range r.AdditionalInstruction {
+		item.validateAll(fmt.Sprintf("%s.additionalInstruction[%d]", path, i), issues)
+	}
+	if r.PatientInstructionElement != nil {
+		r.PatientInstructionElement.validateAll(path+".patientInstruction", issues)
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing", issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
+	for i, item := range r.AsNeededFor {
+		item.validateAll(fmt.Sprintf("%s.asNeededFor[%d]", path, i), issues)
+	}
+	if r.Site != nil {
+		r.Site.validateAll(path+".site", issues)
+	}
+	if r.Route != nil {
+		r.Route.validateAll(path+".route", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	for i, item := range r.DoseAndRate {
+		item.validateAll(fmt.Sprintf("%s.doseAndRate[%d]", path, i), issues)
+	}
+	if r.Safety != nil {
+		r.Safety.validateAll(path+".safety", issues)
+	}
+}
+
+func (r *Dosage) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Dosage", &issues)
+	checkInvariants(r, "Dosage", &issues)
+	return issues
+}
+
+var dosageInvariants = []invariant{
+	{key: "dos-1", severity: "error", human: "AsNeededFor can only be set if AsNeeded is empty or true", expression: "asNeededFor.empty() or asNeeded.empty() or asNeeded"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Dosage) invariants() []invariant {
+	return dosageInvariants
+}
+
+func (r Dosage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Dosage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Dosage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Dosage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dosageElementMetadata = []ElementMetadata{
+	{Path: "Dosage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Dosage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Dosage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Dosage.condition", Name: "condition", Field: 3, Min: 0, Max: "*", Types: []string{"DosageCondition"}, Summary: true},
+	{Path: "Dosage.text", Name: "text", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Dosage.additionalInstruction", Name: "additionalInstruction", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Dosage.additionalInstruction", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/additional-instruction-codes", Description: "A coded concept identifying additional instructions such as \"take with water\" or \"avoid operating heavy machinery\"."}, Summary: true},
+	{Path: "Dosage.patientInstruction", Name: "patientInstruction", Field: 7, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Dosage.timing", Name: "timing", Field: 9, Min: 0, Max: "1", Types: []string{"Timing"}, Summary: true},
+	{Path: "Dosage.asNeeded", Name: "asNeeded", Field: 10, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "Dosage.asNeededFor", Name: "asNeededFor", Field: 12, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Dosage.asNeededFor", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/medication-as-needed-reason", Description: "A coded concept identifying the precondition that should be met or evaluated prior to consuming or administering a medication dose.  For example \"pain\", \"30 minutes prior to sexual intercourse\", \"on flare-up\" etc."}, Summary: true},
+	{Path: "Dosage.site", Name: "site", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Dosage.site", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/approach-site-codes", Description: "A coded concept describing the site location the medicine enters into or onto the body."}, Summary: true},
+	{Path: "Dosage.route", Name: "route", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Dosage.route", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/route-codes", Description: "A coded concept describing the route or physiological path of administration of a therapeutic agent into or onto the body of a subject."}, Summary: true},
+	{Path: "Dosage.method", Name: "method", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Dosage.method", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/administration-method-codes", Description: "A coded concept describing the technique by which the medicine is administered."}, Summary: true},
+	{Path: "Dosage.doseAndRate", Name: "doseAndRate", Field: 16, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "Dosage.safety", Name: "safety", Field: 17, Min: 0, Max: "1", Types: []string{"DosageSafety"}, Summary: true},
+}
+
+func (r *Dosage) ElementMetadata() []ElementMetadata {
+	return dosageElementMetadata
+}
+
 type DosageDoseAndRate struct {
-	Id             *string          `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Type           *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                      // The kind of dose or rate specified
-	DoseRange      *Range           `json:"doseRange,omitempty" bson:"dose_range,omitempty"`           // Amount of medication per dose
-	DoseQuantity   *Quantity        `json:"doseQuantity,omitempty" bson:"dose_quantity,omitempty"`     // Amount of medication per dose
-	DoseExpression *Expression      `json:"doseExpression,omitempty" bson:"dose_expression,omitempty"` // Amount of medication per dose
-	RateRatio      *Ratio           `json:"rateRatio,omitempty" bson:"rate_ratio,omitempty"`           // Amount of medication per unit of time
-	RateRange      *Range           `json:"rateRange,omitempty" bson:"rate_range,omitempty"`           // Amount of medication per unit of time
-	RateQuantity   *Quantity        `json:"rateQuantity,omitempty" bson:"rate_quantity,omitempty"`     // Amount of medication per unit of time
-	RateExpression *Expression      `json:"rateExpression,omitempty" bson:"rate_expression,omitempty"` // Amount of medication per unit of time
+	Id        *string               `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension           `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Type      *CodeableConcept      `json:"type,omitempty" bson:"type,omitempty"`           // The kind of dose or rate specified
+	Dose      DosageDoseAndRateDose `json:"-" bson:"dose,omitempty"`                        // Amount of medication per dose
+	Rate      DosageDoseAndRateRate `json:"-" bson:"rate,omitempty"`                        // Amount of medication per unit of time
+
+	doseVariants []string // JSON properties of Dosage.doseAndRate.dose[x] when more than one was decoded
+	rateVariants []string // JSON properties of Dosage.doseAndRate.rate[x] when more than one was decoded
 }
 
 func (r *DosageDoseAndRate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.DoseRange != nil {
-		if err := r.DoseRange.Validate(); err != nil {
-			return fmt.Errorf("DoseRange: %w", err)
-		}
+	if len(r.doseVariants) > 1 {
+		return fmt.Errorf("field 'Dose' must have a single type, got %v", r.doseVariants)
 	}
-	if r.DoseQuantity != nil {
-		if err := r.DoseQuantity.Validate(); err != nil {
-			return fmt.Errorf("DoseQuantity: %w", err)
+	if r.Dose != nil {
+		if err := r.Dose.Validate(); err != nil {
+			return fmt.Errorf("Dose: %w", err)
 		}
 	}
-	if r.DoseExpression != nil {
-		if err := r.DoseExpression.Validate(); err != nil {
-			return fmt.Errorf("DoseExpression: %w", err)
-		}
+	if len(r.rateVariants) > 1 {
+		return fmt.Errorf("field 'Rate' must have a single type, got %v", r.rateVariants)
 	}
-	if r.RateRatio != nil {
-		if err := r.RateRatio.Validate(); err != nil {
-			return fmt.Errorf("RateRatio: %w", err)
+	if r.Rate != nil {
+		if err := r.Rate.Validate(); err != nil {
+			return fmt.Errorf("Rate: %w", err)
 		}
 	}
-	if r.RateRange != nil {
-		if err := r.RateRange.Validate(); err != nil {
-			return fmt.Errorf("RateRange: %w", err)
-		}
+	return nil
+}
+
+func (r *DosageDoseAndRate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.RateQuantity != nil {
-		if err := r.RateQuantity.Validate(); err != nil {
-			return fmt.Errorf("RateQuantity: %w", err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.RateExpression != nil {
-		if err := r.RateExpression.Validate(); err != nil {
-			return fmt.Errorf("RateExpression: %w", err)
-		}
+	if len(r.doseVariants) > 1 {
+		issues.add("structure", path+".dose", fmt.Sprintf("field 'Dose' must have a single type, got %v", r.doseVariants))
+	}
+	if r.Dose != nil {
+		r.Dose.validateAll(path+".dose.ofType("+r.Dose.FHIRType()+")", issues)
+	}
+	if len(r.rateVariants) > 1 {
+		issues.add("structure", path+".rate", fmt.Sprintf("field 'Rate' must have a single type, got %v", r.rateVariants))
+	}
+	if r.Rate != nil {
+		r.Rate.validateAll(path+".rate.ofType("+r.Rate.FHIRType()+")", issues)
+	}
+}
+
+var dosageDoseAndRateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DosageDoseAndRate) invariants() []invariant {
+	return dosageDoseAndRateInvariants
+}
+
+func (r DosageDoseAndRate) MarshalJSON() ([]byte, error) {
+	type alias DosageDoseAndRate
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "dose", r.Dose, nil); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "rate", r.Rate, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DosageDoseAndRate) UnmarshalJSON(data []byte) error {
+	type alias DosageDoseAndRate
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Dose, _, r.doseVariants, err = unmarshalChoice(fields, "dose", dosageDoseAndRateDoseVariants); err != nil {
+		return err
+	}
+	if r.Rate, _, r.rateVariants, err = unmarshalChoice(fields, "rate", dosageDoseAndRateRateVariants); err != nil {
+		return err
 	}
 	return nil
 }
+
+func (r DosageDoseAndRate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DosageDoseAndRate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DosageDoseAndRate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DosageDoseAndRate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DosageDoseAndRate) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Dose":
+		return "dose", choiceValues(dosageDoseAndRateDoseVariants)
+	case "Rate":
+		return "rate", choiceValues(dosageDoseAndRateRateVariants)
+	}
+	return "", nil
+}
+
+var dosageDoseAndRateElementMetadata = []ElementMetadata{
+	{Path: "Dosage.doseAndRate.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Dosage.doseAndRate.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Dosage.doseAndRate.type", Name: "type", Field: 2, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Dosage.doseAndRate.type", Strength: "example", ValueSet: "http://terminology.hl7.org/ValueSet/dose-rate-type", Description: "The kind of dose or rate specified."}, Summary: true},
+	{Path: "Dosage.doseAndRate.dose[x]", Name: "dose", Field: 3, Min: 0, Max: "1", Types: []string{"Range", "Quantity", "Expression"}, Summary: true},
+	{Path: "Dosage.doseAndRate.rate[x]", Name: "rate", Field: 4, Min: 0, Max: "1", Types: []string{"Ratio", "Range", "Quantity", "Expression"}, Summary: true},
+}
+
+func (r *DosageDoseAndRate) ElementMetadata() []ElementMetadata {
+	return dosageDoseAndRateElementMetadata
+}
+
+// DosageDoseAndRateDose is the type of Dosage.doseAndRate.dose[x]. It is implemented by the DosageDoseAndRateDose* variant types.
+type DosageDoseAndRateDose interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDosageDoseAndRateDose()
+}
+
+// DosageDoseAndRateDoseRange is the Range variant of DosageDoseAndRateDose.
+type DosageDoseAndRateDoseRange struct {
+	Range
+}
+
+func (v DosageDoseAndRateDoseRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v DosageDoseAndRateDoseRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateDoseRange) FHIRType() string {
+	return "Range"
+}
+
+func (v DosageDoseAndRateDoseRange) isDosageDoseAndRateDose() {}
+
+// DosageDoseAndRateDoseQuantity is the Quantity variant of DosageDoseAndRateDose.
+type DosageDoseAndRateDoseQuantity struct {
+	Quantity
+}
+
+func (v DosageDoseAndRateDoseQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DosageDoseAndRateDoseQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateDoseQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DosageDoseAndRateDoseQuantity) isDosageDoseAndRateDose() {}
+
+// DosageDoseAndRateDoseExpression is the Expression variant of DosageDoseAndRateDose.
+type DosageDoseAndRateDoseExpression struct {
+	Expression
+}
+
+func (v DosageDoseAndRateDoseExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v DosageDoseAndRateDoseExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateDoseExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v DosageDoseAndRateDoseExpression) isDosageDoseAndRateDose() {}
+
+var dosageDoseAndRateDoseVariants = []DosageDoseAndRateDose{
+	DosageDoseAndRateDoseRange{},
+	DosageDoseAndRateDoseQuantity{},
+	DosageDoseAndRateDoseExpression{},
+}
+
+// DosageDoseAndRateRate is the type of Dosage.doseAndRate.rate[x]. It is implemented by the DosageDoseAndRateRate* variant types.
+type DosageDoseAndRateRate interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDosageDoseAndRateRate()
+}
+
+// DosageDoseAndRateRateRatio is the Ratio variant of DosageDoseAndRateRate.
+type DosageDoseAndRateRateRatio struct {
+	Ratio
+}
+
+func (v DosageDoseAndRateRateRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v DosageDoseAndRateRateRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateRateRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v DosageDoseAndRateRateRatio) isDosageDoseAndRateRate() {}
+
+// DosageDoseAndRateRateRange is the Range variant of DosageDoseAndRateRate.
+type DosageDoseAndRateRateRange struct {
+	Range
+}
+
+func (v DosageDoseAndRateRateRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v DosageDoseAndRateRateRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateRateRange) FHIRType() string {
+	return "Range"
+}
+
+func (v DosageDoseAndRateRateRange) isDosageDoseAndRateRate() {}
+
+// DosageDoseAndRateRateQuantity is the Quantity variant of DosageDoseAndRateRate.
+type DosageDoseAndRateRateQuantity struct {
+	Quantity
+}
+
+func (v DosageDoseAndRateRateQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DosageDoseAndRateRateQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateRateQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DosageDoseAndRateRateQuantity) isDosageDoseAndRateRate() {}
+
+// DosageDoseAndRateRateExpression is the Expression variant of DosageDoseAndRateRate.
+type DosageDoseAndRateRateExpression struct {
+	Expression
+}
+
+func (v DosageDoseAndRateRateExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v DosageDoseAndRateRateExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v DosageDoseAndRateRateExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v DosageDoseAndRateRateExpression) isDosageDoseAndRateRate() {}
+
+var dosageDoseAndRateRateVariants = []DosageDoseAndRateRate{
+	DosageDoseAndRateRateRatio{},
+	DosageDoseAndRateRateRange{},
+	DosageDoseAndRateRateQuantity{},
+	DosageDoseAndRateRateExpression{},
+}
@@ -1,74 +1,39 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // DosageCondition Type: DosageCondition expresses a time or time period as relative to the time of an event defined in data types other than dateTime.
 type DosageCondition struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
-	Code                       *CodeableConcept       `json:"code" bson:"code"`                                                                    // The specific event occurrence or resource context used as a base point (reference point) in time
-	Details                    *CodeableConcept       `json:"details,omitempty" bson:"details,omitempty"`                                          // Additional details about the event - depends on the code
-	Operation                  *string                `json:"operation,omitempty" bson:"operation,omitempty"`                                      // eq | ne | in | nin | gt | lt | ge | le | sa | eb | ap
-	ValueBase64Binary          *string                `json:"valueBase64Binary,omitempty" bson:"value_base64_binary,omitempty"`                    // The value for this critera
-	ValueBoolean               *bool                  `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                               // The value for this critera
-	ValueCanonical             *string                `json:"valueCanonical,omitempty" bson:"value_canonical,omitempty"`                           // The value for this critera
-	ValueCode                  *string                `json:"valueCode,omitempty" bson:"value_code,omitempty"`                                     // The value for this critera
-	ValueDate                  *string                `json:"valueDate,omitempty" bson:"value_date,omitempty"`                                     // The value for this critera
-	ValueDateTime              *string                `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                            // The value for this critera
-	ValueDecimal               *float64               `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`                               // The value for this critera
-	ValueId                    *string                `json:"valueId,omitempty" bson:"value_id,omitempty"`                                         // The value for this critera
-	ValueInstant               *string                `json:"valueInstant,omitempty" bson:"value_instant,omitempty"`                               // The value for this critera
-	ValueInteger               *int                   `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                               // The value for this critera
-	ValueInteger64             *int64                 `json:"valueInteger64,omitempty" bson:"value_integer64,omitempty"`                           // The value for this critera
-	ValueMarkdown              *string                `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                             // The value for this critera
-	ValueOid                   *string                `json:"valueOid,omitempty" bson:"value_oid,omitempty"`                                       // The value for this critera
-	ValuePositiveInt           *int                   `json:"valuePositiveInt,omitempty" bson:"value_positive_int,omitempty"`                      // The value for this critera
-	ValueString                *string                `json:"valueString,omitempty" bson:"value_string,omitempty"`                                 // The value for this critera
-	ValueTime                  *string                `json:"valueTime,omitempty" bson:"value_time,omitempty"`                                     // The value for this critera
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt,omitempty" bson:"value_unsigned_int,omitempty"`                      // The value for this critera
-	ValueUri                   *string                `json:"valueUri,omitempty" bson:"value_uri,omitempty"`                                       // The value for this critera
-	ValueUrl                   *string                `json:"valueUrl,omitempty" bson:"value_url,omitempty"`                                       // The value for this critera
-	ValueUuid                  *uuid                  `json:"valueUuid,omitempty" bson:"value_uuid,omitempty"`                                     // The value for this critera
-	ValueAddress               *Address               `json:"valueAddress,omitempty" bson:"value_address,omitempty"`                               // The value for this critera
-	ValueAge                   *Age                   `json:"valueAge,omitempty" bson:"value_age,omitempty"`                                       // The value for this critera
-	ValueAnnotation            *Annotation            `json:"valueAnnotation,omitempty" bson:"value_annotation,omitempty"`                         // The value for this critera
-	ValueAttachment            *Attachment            `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`                         // The value for this critera
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`              // The value for this critera
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference,omitempty" bson:"value_codeable_reference,omitempty"`          // The value for this critera
-	ValueCoding                *Coding                `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`                                 // The value for this critera
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint,omitempty" bson:"value_contact_point,omitempty"`                    // The value for this critera
-	ValueCount                 *Count                 `json:"valueCount,omitempty" bson:"value_count,omitempty"`                                   // The value for this critera
-	ValueDistance              *Distance              `json:"valueDistance,omitempty" bson:"value_distance,omitempty"`                             // The value for this critera
-	ValueDuration              *Duration              `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`                             // The value for this critera
-	ValueHumanName             *HumanName             `json:"valueHumanName,omitempty" bson:"value_human_name,omitempty"`                          // The value for this critera
-	ValueIdentifier            *Identifier            `json:"valueIdentifier,omitempty" bson:"value_identifier,omitempty"`                         // The value for this critera
-	ValueMoney                 *Money                 `json:"valueMoney,omitempty" bson:"value_money,omitempty"`                                   // The value for this critera
-	ValuePeriod                *Period                `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                                 // The value for this critera
-	ValueQuantity              *Quantity              `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                             // The value for this critera
-	ValueRange                 *Range                 `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                   // The value for this critera
-	ValueRatio                 *Ratio                 `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                                   // The value for this critera
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange,omitempty" bson:"value_ratio_range,omitempty"`                        // The value for this critera
-	ValueReference             *Reference             `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                           // The value for this critera
-	ValueSampledData           *SampledData           `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`                      // The value for this critera
-	ValueSignature             *Signature             `json:"valueSignature,omitempty" bson:"value_signature,omitempty"`                           // The value for this critera
-	ValueTiming                *Timing                `json:"valueTiming,omitempty" bson:"value_timing,omitempty"`                                 // The value for this critera
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail,omitempty" bson:"value_contact_detail,omitempty"`                  // The value for this critera
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement,omitempty" bson:"value_data_requirement,omitempty"`              // The value for this critera
-	ValueExpression            *Expression            `json:"valueExpression,omitempty" bson:"value_expression,omitempty"`                         // The value for this critera
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition,omitempty" bson:"value_parameter_definition,omitempty"`      // The value for this critera
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact,omitempty" bson:"value_related_artifact,omitempty"`              // The value for this critera
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition,omitempty" bson:"value_trigger_definition,omitempty"`          // The value for this critera
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext,omitempty" bson:"value_usage_context,omitempty"`                    // The value for this critera
-	ValueAvailability          *Availability          `json:"valueAvailability,omitempty" bson:"value_availability,omitempty"`                     // The value for this critera
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail,omitempty" bson:"value_extended_contact_detail,omitempty"` // The value for this critera
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail,omitempty" bson:"value_virtual_service_detail,omitempty"`   // The value for this critera
-	ValueDosage                *Dosage                `json:"valueDosage,omitempty" bson:"value_dosage,omitempty"`                                 // The value for this critera
-	ValueMeta                  *Meta                  `json:"valueMeta,omitempty" bson:"value_meta,omitempty"`                                     // The value for this critera
-	Text                       *string                `json:"text,omitempty" bson:"text,omitempty"`                                                // Free-text description
+	Id                *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept       `json:"code" bson:"code"`                                                // The specific event occurrence or resource context used as a base point (reference point) in time
+	Details           *CodeableConcept       `json:"details,omitempty" bson:"details,omitempty"`                      // Additional details about the event - depends on the code
+	Operation         *ComparisonOperationVS `json:"operation,omitempty" bson:"operation,omitempty"`                  // eq | ne | in | nin | gt | lt | ge | le | sa | eb | ap
+	OperationElement  *Element               `json:"_operation,omitempty" bson:"operation_element,omitempty"`         // Extensions for operation
+	Value             DosageConditionValue   `json:"-" bson:"value,omitempty"`                                        // The value for this critera
+	ValueElement      *Element               `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Text              *string                `json:"text,omitempty" bson:"text,omitempty"`                            // Free-text description
+	TextElement       *Element               `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+
+	valueVariants []string // JSON properties of DosageCondition.value[x] when more than one was decoded
 }
 
 func (r *DosageCondition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -82,185 +47,1227 @@ func (r *DosageCondition) Validate() error {
 			return fmt.Errorf("Details: %w", err)
 		}
 	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
-	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
-	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
-	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
-	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
-	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
-	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
-		}
+	if r.Operation != nil && !r.Operation.IsValid() {
+		return fmt.Errorf("field 'Operation' has invalid code '%s'", *r.Operation)
 	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
+	if r.OperationElement != nil {
+		if err := r.OperationElement.Validate(); err != nil {
+			return fmt.Errorf("OperationElement: %w", err)
 		}
 	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
 		}
 	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
-		}
+	return nil
+}
+
+func (r *DosageCondition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.Details != nil {
+		r.Details.validateAll(path+".details", issues)
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
-		}
+	if r.Operation != nil && !r.Operation.IsValid() {
+		issues.add("code-invalid", path+".operation", fmt.Sprintf("field 'Operation' has invalid code '%s'", *r.Operation))
 	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+	if r.OperationElement != nil {
+		r.OperationElement.validateAll(path+".operation", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+}
+
+func (r *DosageCondition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DosageCondition", &issues)
+	checkInvariants(r, "DosageCondition", &issues)
+	return issues
+}
+
+var dosageConditionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DosageCondition) invariants() []invariant {
+	return dosageConditionInvariants
+}
+
+func (r DosageCondition) MarshalJSON() ([]byte, error) {
+	type alias DosageCondition
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	return data, nil
+}
+
+func (r *DosageCondition) UnmarshalJSON(data []byte) error {
+	type alias DosageCondition
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
-		}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", dosageConditionValueVariants); err != nil {
+		return err
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
-		}
+	return nil
+}
+
+func (r DosageCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DosageCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DosageCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DosageCondition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DosageCondition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(dosageConditionValueVariants)
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
-		}
+	return "", nil
+}
+
+var dosageConditionElementMetadata = []ElementMetadata{
+	{Path: "DosageCondition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DosageCondition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DosageCondition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DosageCondition.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DosageCondition.code", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/dosage-condition-code", Description: "The specific event occurrence or resource context used as a base point (reference point) in time."}, Summary: true},
+	{Path: "DosageCondition.details", Name: "details", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "DosageCondition.details", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/dosage-condition-details", Description: "Additional details about the event - depends on the code."}, Summary: true},
+	{Path: "DosageCondition.operation", Name: "operation", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DosageCondition.operation", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/comparison-operation", Description: "How the criteria value is tested."}, Summary: true},
+	{Path: "DosageCondition.value[x]", Name: "value", Field: 7, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}, Summary: true},
+	{Path: "DosageCondition.text", Name: "text", Field: 9, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *DosageCondition) ElementMetadata() []ElementMetadata {
+	return dosageConditionElementMetadata
+}
+
+// DosageConditionValue is the type of DosageCondition.value[x]. It is implemented by the DosageConditionValue* variant types.
+type DosageConditionValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDosageConditionValue()
+}
+
+// DosageConditionValueBase64Binary is the base64Binary variant of DosageConditionValue.
+type DosageConditionValueBase64Binary string
+
+func (v DosageConditionValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v DosageConditionValueBase64Binary) isDosageConditionValue() {}
+
+// DosageConditionValueBoolean is the boolean variant of DosageConditionValue.
+type DosageConditionValueBoolean bool
+
+func (v DosageConditionValueBoolean) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v DosageConditionValueBoolean) isDosageConditionValue() {}
+
+// DosageConditionValueCanonical is the canonical variant of DosageConditionValue.
+type DosageConditionValueCanonical string
+
+func (v DosageConditionValueCanonical) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v DosageConditionValueCanonical) isDosageConditionValue() {}
+
+// DosageConditionValueCode is the code variant of DosageConditionValue.
+type DosageConditionValueCode string
+
+func (v DosageConditionValueCode) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v DosageConditionValueCode) isDosageConditionValue() {}
+
+// DosageConditionValueDate is the date variant of DosageConditionValue.
+type DosageConditionValueDate struct {
+	Date
+}
+
+func (v DosageConditionValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v DosageConditionValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
+}
+
+func (v DosageConditionValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v DosageConditionValueDate) isDosageConditionValue() {}
+
+// DosageConditionValueDateTime is the dateTime variant of DosageConditionValue.
+type DosageConditionValueDateTime struct {
+	DateTime
+}
+
+func (v DosageConditionValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v DosageConditionValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+}
+
+func (v DosageConditionValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v DosageConditionValueDateTime) isDosageConditionValue() {}
+
+// DosageConditionValueDecimal is the decimal variant of DosageConditionValue.
+type DosageConditionValueDecimal struct {
+	Decimal
+}
+
+func (v DosageConditionValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v DosageConditionValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+}
+
+func (v DosageConditionValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v DosageConditionValueDecimal) isDosageConditionValue() {}
+
+// DosageConditionValueId is the id variant of DosageConditionValue.
+type DosageConditionValueId string
+
+func (v DosageConditionValueId) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueId) FHIRType() string {
+	return "id"
+}
+
+func (v DosageConditionValueId) isDosageConditionValue() {}
+
+// DosageConditionValueInstant is the instant variant of DosageConditionValue.
+type DosageConditionValueInstant struct {
+	Instant
+}
+
+func (v DosageConditionValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v DosageConditionValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
-		}
+}
+
+func (v DosageConditionValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v DosageConditionValueInstant) isDosageConditionValue() {}
+
+// DosageConditionValueInteger is the integer variant of DosageConditionValue.
+type DosageConditionValueInteger int
+
+func (v DosageConditionValueInteger) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v DosageConditionValueInteger) isDosageConditionValue() {}
+
+// DosageConditionValueInteger64 is the integer64 variant of DosageConditionValue.
+type DosageConditionValueInteger64 int64
+
+func (v DosageConditionValueInteger64) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v DosageConditionValueInteger64) isDosageConditionValue() {}
+
+// DosageConditionValueMarkdown is the markdown variant of DosageConditionValue.
+type DosageConditionValueMarkdown string
+
+func (v DosageConditionValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v DosageConditionValueMarkdown) isDosageConditionValue() {}
+
+// DosageConditionValueOid is the oid variant of DosageConditionValue.
+type DosageConditionValueOid string
+
+func (v DosageConditionValueOid) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v DosageConditionValueOid) isDosageConditionValue() {}
+
+// DosageConditionValuePositiveInt is the positiveInt variant of DosageConditionValue.
+type DosageConditionValuePositiveInt int
+
+func (v DosageConditionValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v DosageConditionValuePositiveInt) isDosageConditionValue() {}
+
+// DosageConditionValueString is the string variant of DosageConditionValue.
+type DosageConditionValueString string
+
+func (v DosageConditionValueString) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueString) FHIRType() string {
+	return "string"
+}
+
+func (v DosageConditionValueString) isDosageConditionValue() {}
+
+// DosageConditionValueTime is the time variant of DosageConditionValue.
+type DosageConditionValueTime struct {
+	Time
+}
+
+func (v DosageConditionValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v DosageConditionValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
+}
+
+func (v DosageConditionValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v DosageConditionValueTime) isDosageConditionValue() {}
+
+// DosageConditionValueUnsignedInt is the unsignedInt variant of DosageConditionValue.
+type DosageConditionValueUnsignedInt int
+
+func (v DosageConditionValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v DosageConditionValueUnsignedInt) isDosageConditionValue() {}
+
+// DosageConditionValueUri is the uri variant of DosageConditionValue.
+type DosageConditionValueUri string
+
+func (v DosageConditionValueUri) Validate() error {
+	return nil
+}
+
+func (v DosageConditionValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v DosageConditionValueUri) isDosageConditionValue() {}
+
+// DosageConditionValueUrl is the url variant of DosageConditionValue.
+type DosageConditionValueUrl string
+
+func (v DosageConditionValueUrl) Validate() error {
 	return nil
 }
+
+func (v DosageConditionValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageConditionValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v DosageConditionValueUrl) isDosageConditionValue() {}
+
+// DosageConditionValueUuid is the uuid variant of DosageConditionValue.
+type DosageConditionValueUuid struct {
+	uuid
+}
+
+func (v DosageConditionValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v DosageConditionValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v DosageConditionValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v DosageConditionValueUuid) isDosageConditionValue() {}
+
+// DosageConditionValueAddress is the Address variant of DosageConditionValue.
+type DosageConditionValueAddress struct {
+	Address
+}
+
+func (v DosageConditionValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v DosageConditionValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v DosageConditionValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v DosageConditionValueAddress) isDosageConditionValue() {}
+
+// DosageConditionValueAge is the Age variant of DosageConditionValue.
+type DosageConditionValueAge struct {
+	Age
+}
+
+func (v DosageConditionValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v DosageConditionValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v DosageConditionValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v DosageConditionValueAge) isDosageConditionValue() {}
+
+// DosageConditionValueAnnotation is the Annotation variant of DosageConditionValue.
+type DosageConditionValueAnnotation struct {
+	Annotation
+}
+
+func (v DosageConditionValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v DosageConditionValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v DosageConditionValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v DosageConditionValueAnnotation) isDosageConditionValue() {}
+
+// DosageConditionValueAttachment is the Attachment variant of DosageConditionValue.
+type DosageConditionValueAttachment struct {
+	Attachment
+}
+
+func (v DosageConditionValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v DosageConditionValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v DosageConditionValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v DosageConditionValueAttachment) isDosageConditionValue() {}
+
+// DosageConditionValueCodeableConcept is the CodeableConcept variant of DosageConditionValue.
+type DosageConditionValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v DosageConditionValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v DosageConditionValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v DosageConditionValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v DosageConditionValueCodeableConcept) isDosageConditionValue() {}
+
+// DosageConditionValueCodeableReference is the CodeableReference variant of DosageConditionValue.
+type DosageConditionValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v DosageConditionValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v DosageConditionValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v DosageConditionValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v DosageConditionValueCodeableReference) isDosageConditionValue() {}
+
+// DosageConditionValueCoding is the Coding variant of DosageConditionValue.
+type DosageConditionValueCoding struct {
+	Coding
+}
+
+func (v DosageConditionValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v DosageConditionValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v DosageConditionValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v DosageConditionValueCoding) isDosageConditionValue() {}
+
+// DosageConditionValueContactPoint is the ContactPoint variant of DosageConditionValue.
+type DosageConditionValueContactPoint struct {
+	ContactPoint
+}
+
+func (v DosageConditionValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v DosageConditionValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v DosageConditionValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v DosageConditionValueContactPoint) isDosageConditionValue() {}
+
+// DosageConditionValueCount is the Count variant of DosageConditionValue.
+type DosageConditionValueCount struct {
+	Count
+}
+
+func (v DosageConditionValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v DosageConditionValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v DosageConditionValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v DosageConditionValueCount) isDosageConditionValue() {}
+
+// DosageConditionValueDistance is the Distance variant of DosageConditionValue.
+type DosageConditionValueDistance struct {
+	Distance
+}
+
+func (v DosageConditionValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v DosageConditionValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v DosageConditionValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v DosageConditionValueDistance) isDosageConditionValue() {}
+
+// DosageConditionValueDuration is the Duration variant of DosageConditionValue.
+type DosageConditionValueDuration struct {
+	Duration
+}
+
+func (v DosageConditionValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v DosageConditionValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v DosageConditionValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v DosageConditionValueDuration) isDosageConditionValue() {}
+
+// DosageConditionValueHumanName is the HumanName variant of DosageConditionValue.
+type DosageConditionValueHumanName struct {
+	HumanName
+}
+
+func (v DosageConditionValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v DosageConditionValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v DosageConditionValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v DosageConditionValueHumanName) isDosageConditionValue() {}
+
+// DosageConditionValueIdentifier is the Identifier variant of DosageConditionValue.
+type DosageConditionValueIdentifier struct {
+	Identifier
+}
+
+func (v DosageConditionValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v DosageConditionValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v DosageConditionValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v DosageConditionValueIdentifier) isDosageConditionValue() {}
+
+// DosageConditionValueMoney is the Money variant of DosageConditionValue.
+type DosageConditionValueMoney struct {
+	Money
+}
+
+func (v DosageConditionValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v DosageConditionValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v DosageConditionValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v DosageConditionValueMoney) isDosageConditionValue() {}
+
+// DosageConditionValuePeriod is the Period variant of DosageConditionValue.
+type DosageConditionValuePeriod struct {
+	Period
+}
+
+func (v DosageConditionValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v DosageConditionValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v DosageConditionValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v DosageConditionValuePeriod) isDosageConditionValue() {}
+
+// DosageConditionValueQuantity is the Quantity variant of DosageConditionValue.
+type DosageConditionValueQuantity struct {
+	Quantity
+}
+
+func (v DosageConditionValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DosageConditionValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DosageConditionValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DosageConditionValueQuantity) isDosageConditionValue() {}
+
+// DosageConditionValueRange is the Range variant of DosageConditionValue.
+type DosageConditionValueRange struct {
+	Range
+}
+
+func (v DosageConditionValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v DosageConditionValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v DosageConditionValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v DosageConditionValueRange) isDosageConditionValue() {}
+
+// DosageConditionValueRatio is the Ratio variant of DosageConditionValue.
+type DosageConditionValueRatio struct {
+	Ratio
+}
+
+func (v DosageConditionValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v DosageConditionValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v DosageConditionValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v DosageConditionValueRatio) isDosageConditionValue() {}
+
+// DosageConditionValueRatioRange is the RatioRange variant of DosageConditionValue.
+type DosageConditionValueRatioRange struct {
+	RatioRange
+}
+
+func (v DosageConditionValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v DosageConditionValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v DosageConditionValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v DosageConditionValueRatioRange) isDosageConditionValue() {}
+
+// DosageConditionValueReference is the Reference variant of DosageConditionValue.
+type DosageConditionValueReference struct {
+	Reference
+}
+
+func (v DosageConditionValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v DosageConditionValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v DosageConditionValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v DosageConditionValueReference) isDosageConditionValue() {}
+
+// DosageConditionValueSampledData is the SampledData variant of DosageConditionValue.
+type DosageConditionValueSampledData struct {
+	SampledData
+}
+
+func (v DosageConditionValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v DosageConditionValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v DosageConditionValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v DosageConditionValueSampledData) isDosageConditionValue() {}
+
+// DosageConditionValueSignature is the Signature variant of DosageConditionValue.
+type DosageConditionValueSignature struct {
+	Signature
+}
+
+func (v DosageConditionValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v DosageConditionValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v DosageConditionValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v DosageConditionValueSignature) isDosageConditionValue() {}
+
+// DosageConditionValueTiming is the Timing variant of DosageConditionValue.
+type DosageConditionValueTiming struct {
+	Timing
+}
+
+func (v DosageConditionValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v DosageConditionValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v DosageConditionValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v DosageConditionValueTiming) isDosageConditionValue() {}
+
+// DosageConditionValueContactDetail is the ContactDetail variant of DosageConditionValue.
+type DosageConditionValueContactDetail struct {
+	ContactDetail
+}
+
+func (v DosageConditionValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v DosageConditionValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v DosageConditionValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v DosageConditionValueContactDetail) isDosageConditionValue() {}
+
+// DosageConditionValueDataRequirement is the DataRequirement variant of DosageConditionValue.
+type DosageConditionValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v DosageConditionValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v DosageConditionValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v DosageConditionValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v DosageConditionValueDataRequirement) isDosageConditionValue() {}
+
+// DosageConditionValueExpression is the Expression variant of DosageConditionValue.
+type DosageConditionValueExpression struct {
+	Expression
+}
+
+func (v DosageConditionValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v DosageConditionValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v DosageConditionValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v DosageConditionValueExpression) isDosageConditionValue() {}
+
+// DosageConditionValueParameterDefinition is the ParameterDefinition variant of DosageConditionValue.
+type DosageConditionValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v DosageConditionValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v DosageConditionValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v DosageConditionValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v DosageConditionValueParameterDefinition) isDosageConditionValue() {}
+
+// DosageConditionValueRelatedArtifact is the RelatedArtifact variant of DosageConditionValue.
+type DosageConditionValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v DosageConditionValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v DosageConditionValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v DosageConditionValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v DosageConditionValueRelatedArtifact) isDosageConditionValue() {}
+
+// DosageConditionValueTriggerDefinition is the TriggerDefinition variant of DosageConditionValue.
+type DosageConditionValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v DosageConditionValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v DosageConditionValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v DosageConditionValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v DosageConditionValueTriggerDefinition) isDosageConditionValue() {}
+
+// DosageConditionValueUsageContext is the UsageContext variant of DosageConditionValue.
+type DosageConditionValueUsageContext struct {
+	UsageContext
+}
+
+func (v DosageConditionValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v DosageConditionValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v DosageConditionValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v DosageConditionValueUsageContext) isDosageConditionValue() {}
+
+// DosageConditionValueAvailability is the Availability variant of DosageConditionValue.
+type DosageConditionValueAvailability struct {
+	Availability
+}
+
+func (v DosageConditionValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v DosageConditionValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v DosageConditionValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v DosageConditionValueAvailability) isDosageConditionValue() {}
+
+// DosageConditionValueExtendedContactDetail is the ExtendedContactDetail variant of DosageConditionValue.
+type DosageConditionValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v DosageConditionValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v DosageConditionValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v DosageConditionValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v DosageConditionValueExtendedContactDetail) isDosageConditionValue() {}
+
+// DosageConditionValueVirtualServiceDetail is the VirtualServiceDetail variant of DosageConditionValue.
+type DosageConditionValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v DosageConditionValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v DosageConditionValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v DosageConditionValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v DosageConditionValueVirtualServiceDetail) isDosageConditionValue() {}
+
+// DosageConditionValueDosage is the Dosage variant of DosageConditionValue.
+type DosageConditionValueDosage struct {
+	Dosage
+}
+
+func (v DosageConditionValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v DosageConditionValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v DosageConditionValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v DosageConditionValueDosage) isDosageConditionValue() {}
+
+// DosageConditionValueMeta is the Meta variant of DosageConditionValue.
+type DosageConditionValueMeta struct {
+	Meta
+}
+
+func (v DosageConditionValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v DosageConditionValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v DosageConditionValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v DosageConditionValueMeta) isDosageConditionValue() {}
+
+var dosageConditionValueVariants = []DosageConditionValue{
+	DosageConditionValueBase64Binary(""),
+	DosageConditionValueBoolean(false),
+	DosageConditionValueCanonical(""),
+	DosageConditionValueCode(""),
+	DosageConditionValueDate{},
+	DosageConditionValueDateTime{},
+	DosageConditionValueDecimal{},
+	DosageConditionValueId(""),
+	DosageConditionValueInstant{},
+	DosageConditionValueInteger(0),
+	DosageConditionValueInteger64(0),
+	DosageConditionValueMarkdown(""),
+	DosageConditionValueOid(""),
+	DosageConditionValuePositiveInt(0),
+	DosageConditionValueString(""),
+	DosageConditionValueTime{},
+	DosageConditionValueUnsignedInt(0),
+	DosageConditionValueUri(""),
+	DosageConditionValueUrl(""),
+	DosageConditionValueUuid{},
+	DosageConditionValueAddress{},
+	DosageConditionValueAge{},
+	DosageConditionValueAnnotation{},
+	DosageConditionValueAttachment{},
+	DosageConditionValueCodeableConcept{},
+	DosageConditionValueCodeableReference{},
+	DosageConditionValueCoding{},
+	DosageConditionValueContactPoint{},
+	DosageConditionValueCount{},
+	DosageConditionValueDistance{},
+	DosageConditionValueDuration{},
+	DosageConditionValueHumanName{},
+	DosageConditionValueIdentifier{},
+	DosageConditionValueMoney{},
+	DosageConditionValuePeriod{},
+	DosageConditionValueQuantity{},
+	DosageConditionValueRange{},
+	DosageConditionValueRatio{},
+	DosageConditionValueRatioRange{},
+	DosageConditionValueReference{},
+	DosageConditionValueSampledData{},
+	DosageConditionValueSignature{},
+	DosageConditionValueTiming{},
+	DosageConditionValueContactDetail{},
+	DosageConditionValueDataRequirement{},
+	DosageConditionValueExpression{},
+	DosageConditionValueParameterDefinition{},
+	DosageConditionValueRelatedArtifact{},
+	DosageConditionValueTriggerDefinition{},
+	DosageConditionValueUsageContext{},
+	DosageConditionValueAvailability{},
+	DosageConditionValueExtendedContactDetail{},
+	DosageConditionValueVirtualServiceDetail{},
+	DosageConditionValueDosage{},
+	DosageConditionValueMeta{},
+}
@@ -1,19 +1,38 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // DosageDetails Type: Indicates how the medication is/was taken or should be taken by the patient.
 type DosageDetails struct {
-	Id                  *string             `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	RenderedInstruction *string             `json:"renderedInstruction,omitempty" bson:"rendered_instruction,omitempty"` // Full representation of the dosage instructions
-	Simple              *Dosage             `json:"simple,omitempty" bson:"simple,omitempty"`                            // Dosage details if it is a simple dose
-	Step                []DosageDetailsStep `json:"step,omitempty" bson:"step,omitempty"`                                // One step in a sequence of steps that comprise the dosage course
-	Safety              *DosageSafety       `json:"safety,omitempty" bson:"safety,omitempty"`                            // Safety Information about the combined dose course
+	Id                         *string             `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	RenderedInstruction        *string             `json:"renderedInstruction,omitempty" bson:"rendered_instruction,omitempty"`          // Full representation of the dosage instructions
+	RenderedInstructionElement *Element            `json:"_renderedInstruction,omitempty" bson:"rendered_instruction_element,omitempty"` // Extensions for renderedInstruction
+	Simple                     *Dosage             `json:"simple,omitempty" bson:"simple,omitempty"`                                     // Dosage details if it is a simple dose
+	Step                       []DosageDetailsStep `json:"step,omitempty" bson:"step,omitempty"`                                         // One step in a sequence of steps that comprise the dosage course
+	Safety                     *DosageSafety       `json:"safety,omitempty" bson:"safety,omitempty"`                                     // Safety Information about the combined dose course
 }
 
 func (r *DosageDetails) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.RenderedInstructionElement != nil {
+		if err := r.RenderedInstructionElement.Validate(); err != nil {
+			return fmt.Errorf("RenderedInstructionElement: %w", err)
+		}
+	}
 	if r.Simple != nil {
 		if err := r.Simple.Validate(); err != nil {
 			return fmt.Errorf("Simple: %w", err)
@@ -32,16 +51,98 @@ func (r *DosageDetails) Validate() error {
 	return nil
 }
 
+func (r *DosageDetails) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.RenderedInstructionElement != nil {
+		r.RenderedInstructionElement.validateAll(path+".renderedInstruction", issues)
+	}
+	if r.Simple != nil {
+		r.Simple.validateAll(path+".simple", issues)
+	}
+	for i, item := range r.Step {
+		item.validateAll(fmt.Sprintf("%s.step[%d]", path, i), issues)
+	}
+	if r.Safety != nil {
+		r.Safety.validateAll(path+".safety", issues)
+	}
+}
+
+func (r *DosageDetails) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DosageDetails", &issues)
+	checkInvariants(r, "DosageDetails", &issues)
+	return issues
+}
+
+var dosageDetailsInvariants = []invariant{
+	{key: "dosdet-1", severity: "error", human: "Can only have a simple dose, or one or more steps in a sequence", expression: "simple.empty() or step.empty()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DosageDetails) invariants() []invariant {
+	return dosageDetailsInvariants
+}
+
+func (r DosageDetails) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DosageDetails) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DosageDetails) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DosageDetails) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dosageDetailsElementTypes = map[string]string{
+	"renderedInstruction": "markdown",
+}
+
+func (r *DosageDetails) elementTypes() map[string]string {
+	return dosageDetailsElementTypes
+}
+
+var dosageDetailsElementMetadata = []ElementMetadata{
+	{Path: "DosageDetails.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DosageDetails.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DosageDetails.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DosageDetails.renderedInstruction", Name: "renderedInstruction", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "DosageDetails.simple", Name: "simple", Field: 5, Min: 0, Max: "1", Types: []string{"Dosage"}, Summary: true},
+	{Path: "DosageDetails.step", Name: "step", Field: 6, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "DosageDetails.safety", Name: "safety", Field: 7, Min: 0, Max: "1", Types: []string{"DosageSafety"}, Summary: true},
+}
+
+func (r *DosageDetails) ElementMetadata() []ElementMetadata {
+	return dosageDetailsElementMetadata
+}
+
 type DosageDetailsStep struct {
-	Id        *string       `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Start     *RelativeTime `json:"start,omitempty" bson:"start,omitempty"`   // When the step starts
-	End       *RelativeTime `json:"end,omitempty" bson:"end,omitempty"`       // When the step ends
-	Count     *int          `json:"count,omitempty" bson:"count,omitempty"`   // How many times to do this step (if not 1)
-	Component []Dosage      `json:"component" bson:"component"`               // A dosage details that is part of this step
-	Safety    *DosageSafety `json:"safety,omitempty" bson:"safety,omitempty"` // Safety Information about this step of the dose course
+	Id           *string       `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
+	Extension    []Extension   `json:"extension,omitempty" bson:"extension,omitempty"`  // Additional content defined by implementations
+	Start        *RelativeTime `json:"start,omitempty" bson:"start,omitempty"`          // When the step starts
+	End          *RelativeTime `json:"end,omitempty" bson:"end,omitempty"`              // When the step ends
+	Count        *int          `json:"count,omitempty" bson:"count,omitempty"`          // How many times to do this step (if not 1)
+	CountElement *Element      `json:"_count,omitempty" bson:"count_element,omitempty"` // Extensions for count
+	Component    []Dosage      `json:"component" bson:"component"`                      // A dosage details that is part of this step
+	Safety       *DosageSafety `json:"safety,omitempty" bson:"safety,omitempty"`        // Safety Information about this step of the dose course
 }
 
 func (r *DosageDetailsStep) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Start != nil {
 		if err := r.Start.Validate(); err != nil {
 			return fmt.Errorf("Start: %w", err)
@@ -52,6 +153,11 @@ func (r *DosageDetailsStep) Validate() error {
 			return fmt.Errorf("End: %w", err)
 		}
 	}
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
+		}
+	}
 	if len(r.Component) < 1 {
 		return fmt.Errorf("field 'Component' must have at least 1 elements")
 	}
@@ -67,3 +173,65 @@ func (r *DosageDetailsStep) Validate() error {
 	}
 	return nil
 }
+
+func (r *DosageDetailsStep) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Start != nil {
+		r.Start.validateAll(path+".start", issues)
+	}
+	if r.End != nil {
+		r.End.validateAll(path+".end", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+	if len(r.Component) < 1 {
+		issues.add("required", path+".component", "field 'Component' must have at least 1 elements")
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+	if r.Safety != nil {
+		r.Safety.validateAll(path+".safety", issues)
+	}
+}
+
+var dosageDetailsStepInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DosageDetailsStep) invariants() []invariant {
+	return dosageDetailsStepInvariants
+}
+
+func (r DosageDetailsStep) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DosageDetailsStep) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DosageDetailsStep) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DosageDetailsStep) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dosageDetailsStepElementMetadata = []ElementMetadata{
+	{Path: "DosageDetails.step.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DosageDetails.step.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DosageDetails.step.start", Name: "start", Field: 2, Min: 0, Max: "1", Types: []string{"RelativeTime"}, Summary: true},
+	{Path: "DosageDetails.step.end", Name: "end", Field: 3, Min: 0, Max: "1", Types: []string{"RelativeTime"}, Summary: true},
+	{Path: "DosageDetails.step.count", Name: "count", Field: 4, Min: 0, Max: "1", Types: []string{"integer"}, Summary: true},
+	{Path: "DosageDetails.step.component", Name: "component", Field: 6, Min: 1, Max: "*", Types: []string{"Dosage"}, Summary: true},
+	{Path: "DosageDetails.step.safety", Name: "safety", Field: 7, Min: 0, Max: "1", Types: []string{"DosageSafety"}, Summary: true},
+}
+
+func (r *DosageDetailsStep) ElementMetadata() []ElementMetadata {
+	return dosageDetailsStepElementMetadata
+}
@@ -1,63 +1,345 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // DosageSafety Type: Safety Details about the usage of the medication.
 type DosageSafety struct {
-	Id         *string                 `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
-	DoseLimit  []DosageSafetyDoseLimit `json:"doseLimit,omitempty" bson:"dose_limit,omitempty"`   // A dose limit for safe use of the medication
-	IfExceeded *string                 `json:"ifExceeded,omitempty" bson:"if_exceeded,omitempty"` // What to do if the instructions lead to exceeding the dose limits
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	DoseLimit         []DosageSafetyDoseLimit `json:"doseLimit,omitempty" bson:"dose_limit,omitempty"`                 // A dose limit for safe use of the medication
+	IfExceeded        *string                 `json:"ifExceeded,omitempty" bson:"if_exceeded,omitempty"`               // What to do if the instructions lead to exceeding the dose limits
+	IfExceededElement *Element                `json:"_ifExceeded,omitempty" bson:"if_exceeded_element,omitempty"`      // Extensions for ifExceeded
 }
 
 func (r *DosageSafety) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.DoseLimit {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("DoseLimit[%d]: %w", i, err)
 		}
 	}
+	if r.IfExceededElement != nil {
+		if err := r.IfExceededElement.Validate(); err != nil {
+			return fmt.Errorf("IfExceededElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *DosageSafety) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.DoseLimit {
+		item.validateAll(fmt.Sprintf("%s.doseLimit[%d]", path, i), issues)
+	}
+	if r.IfExceededElement != nil {
+		r.IfExceededElement.validateAll(path+".ifExceeded", issues)
+	}
+}
+
+func (r *DosageSafety) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("DosageSafety", &issues)
+	checkInvariants(r, "DosageSafety", &issues)
+	return issues
+}
+
+var dosageSafetyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DosageSafety) invariants() []invariant {
+	return dosageSafetyInvariants
+}
+
+func (r DosageSafety) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DosageSafety) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DosageSafety) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DosageSafety) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var dosageSafetyElementMetadata = []ElementMetadata{
+	{Path: "DosageSafety.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DosageSafety.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DosageSafety.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "DosageSafety.doseLimit", Name: "doseLimit", Field: 3, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "DosageSafety.ifExceeded", Name: "ifExceeded", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *DosageSafety) ElementMetadata() []ElementMetadata {
+	return dosageSafetyElementMetadata
+}
+
 type DosageSafetyDoseLimit struct {
-	Id              *string     `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	ValueInteger    *int        `json:"valueInteger" bson:"value_integer"`        // Quantity that is safe to use
-	ValueQuantity   *Quantity   `json:"valueQuantity" bson:"value_quantity"`      // Quantity that is safe to use
-	ValueExpression *Expression `json:"valueExpression" bson:"value_expression"`  // Quantity that is safe to use
-	Scope           string      `json:"scope" bson:"scope"`                       // dosage | period | administration | lifetime - The scope of the dose limitation
-	Period          *Duration   `json:"period,omitempty" bson:"period,omitempty"` // The period over which the quantity is safe to use (if scope = period)
-	Text            *string     `json:"text,omitempty" bson:"text,omitempty"`     // Additional notes about the dose limit
+	Id           *string                    `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
+	Extension    []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`  // Additional content defined by implementations
+	Value        DosageSafetyDoseLimitValue `json:"-" bson:"value,omitempty"`                        // Quantity that is safe to use
+	ValueElement *Element                   `json:"-" bson:"value_element,omitempty"`                // Extensions for value[x]
+	Scope        DoseLimitScopeVS           `json:"scope" bson:"scope"`                              // dosage | period | administration | lifetime - The scope of the dose limitation
+	ScopeElement *Element                   `json:"_scope,omitempty" bson:"scope_element,omitempty"` // Extensions for scope
+	Period       *Duration                  `json:"period,omitempty" bson:"period,omitempty"`        // The period over which the quantity is safe to use (if scope = period)
+	Text         *string                    `json:"text,omitempty" bson:"text,omitempty"`            // Additional notes about the dose limit
+	TextElement  *Element                   `json:"_text,omitempty" bson:"text_element,omitempty"`   // Extensions for text
+
+	valueVariants []string // JSON properties of DosageSafety.doseLimit.value[x] when more than one was decoded
 }
 
 func (r *DosageSafetyDoseLimit) Validate() error {
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueExpression == nil {
-		return fmt.Errorf("field 'ValueExpression' is required")
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Scope == emptyString {
+	if r.Scope == "" {
 		return fmt.Errorf("field 'Scope' is required")
 	}
+	if !r.Scope.IsValid() {
+		return fmt.Errorf("field 'Scope' has invalid code '%s'", r.Scope)
+	}
+	if r.ScopeElement != nil {
+		if err := r.ScopeElement.Validate(); err != nil {
+			return fmt.Errorf("ScopeElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *DosageSafetyDoseLimit) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Scope == "" {
+		issues.add("required", path+".scope", "field 'Scope' is required")
+	}
+	if r.Scope != "" && !r.Scope.IsValid() {
+		issues.add("code-invalid", path+".scope", fmt.Sprintf("field 'Scope' has invalid code '%s'", r.Scope))
+	}
+	if r.ScopeElement != nil {
+		r.ScopeElement.validateAll(path+".scope", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+var dosageSafetyDoseLimitInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *DosageSafetyDoseLimit) invariants() []invariant {
+	return dosageSafetyDoseLimitInvariants
+}
+
+func (r DosageSafetyDoseLimit) MarshalJSON() ([]byte, error) {
+	type alias DosageSafetyDoseLimit
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *DosageSafetyDoseLimit) UnmarshalJSON(data []byte) error {
+	type alias DosageSafetyDoseLimit
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", dosageSafetyDoseLimitValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r DosageSafetyDoseLimit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *DosageSafetyDoseLimit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r DosageSafetyDoseLimit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *DosageSafetyDoseLimit) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *DosageSafetyDoseLimit) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(dosageSafetyDoseLimitValueVariants)
+	}
+	return "", nil
+}
+
+var dosageSafetyDoseLimitElementMetadata = []ElementMetadata{
+	{Path: "DosageSafety.doseLimit.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "DosageSafety.doseLimit.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "DosageSafety.doseLimit.value[x]", Name: "value", Field: 2, Min: 1, Max: "1", Types: []string{"integer", "Quantity", "Expression"}, Summary: true},
+	{Path: "DosageSafety.doseLimit.scope", Name: "scope", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "DosageSafety.doseLimit.scope", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/dose-limit-scope", Description: "Definition."}, Summary: true},
+	{Path: "DosageSafety.doseLimit.period", Name: "period", Field: 6, Min: 0, Max: "1", Types: []string{"Duration"}, Summary: true},
+	{Path: "DosageSafety.doseLimit.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *DosageSafetyDoseLimit) ElementMetadata() []ElementMetadata {
+	return dosageSafetyDoseLimitElementMetadata
+}
+
+// DosageSafetyDoseLimitValue is the type of DosageSafety.doseLimit.value[x]. It is implemented by the DosageSafetyDoseLimitValue* variant types.
+type DosageSafetyDoseLimitValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isDosageSafetyDoseLimitValue()
+}
+
+// DosageSafetyDoseLimitValueInteger is the integer variant of DosageSafetyDoseLimitValue.
+type DosageSafetyDoseLimitValueInteger int
+
+func (v DosageSafetyDoseLimitValueInteger) Validate() error {
+	return nil
+}
+
+func (v DosageSafetyDoseLimitValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v DosageSafetyDoseLimitValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v DosageSafetyDoseLimitValueInteger) isDosageSafetyDoseLimitValue() {}
+
+// DosageSafetyDoseLimitValueQuantity is the Quantity variant of DosageSafetyDoseLimitValue.
+type DosageSafetyDoseLimitValueQuantity struct {
+	Quantity
+}
+
+func (v DosageSafetyDoseLimitValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v DosageSafetyDoseLimitValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v DosageSafetyDoseLimitValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v DosageSafetyDoseLimitValueQuantity) isDosageSafetyDoseLimitValue() {}
+
+// DosageSafetyDoseLimitValueExpression is the Expression variant of DosageSafetyDoseLimitValue.
+type DosageSafetyDoseLimitValueExpression struct {
+	Expression
+}
+
+func (v DosageSafetyDoseLimitValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v DosageSafetyDoseLimitValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v DosageSafetyDoseLimitValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v DosageSafetyDoseLimitValueExpression) isDosageSafetyDoseLimitValue() {}
+
+var dosageSafetyDoseLimitValueVariants = []DosageSafetyDoseLimitValue{
+	DosageSafetyDoseLimitValueInteger(0),
+	DosageSafetyDoseLimitValueQuantity{},
+	DosageSafetyDoseLimitValueExpression{},
+}
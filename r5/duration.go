@@ -1,15 +1,150 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Duration Type: A length of time.
 type Duration struct {
-	Id         *string  `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Value      *float64 `json:"value,omitempty" bson:"value,omitempty"`           // Numerical value (with implicit precision)
-	Comparator *string  `json:"comparator,omitempty" bson:"comparator,omitempty"` // < | <= | >= | > | ad - how to understand the value
-	Unit       *string  `json:"unit,omitempty" bson:"unit,omitempty"`             // Unit representation
-	System     *string  `json:"system,omitempty" bson:"system,omitempty"`         // System that defines coded unit form
-	Code       *string  `json:"code,omitempty" bson:"code,omitempty"`             // Coded form of the unit
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`            // Additional content defined by implementations
+	Value             *Decimal            `json:"value,omitempty" bson:"value,omitempty"`                    // Numerical value (with implicit precision)
+	ValueElement      *Element            `json:"_value,omitempty" bson:"value_element,omitempty"`           // Extensions for value
+	Comparator        *QuantityComparator `json:"comparator,omitempty" bson:"comparator,omitempty"`          // < | <= | >= | > | ad - how to understand the value
+	ComparatorElement *Element            `json:"_comparator,omitempty" bson:"comparator_element,omitempty"` // Extensions for comparator
+	Unit              *string             `json:"unit,omitempty" bson:"unit,omitempty"`                      // Unit representation
+	UnitElement       *Element            `json:"_unit,omitempty" bson:"unit_element,omitempty"`             // Extensions for unit
+	System            *string             `json:"system,omitempty" bson:"system,omitempty"`                  // System that defines coded unit form
+	SystemElement     *Element            `json:"_system,omitempty" bson:"system_element,omitempty"`         // Extensions for system
+	Code              *string             `json:"code,omitempty" bson:"code,omitempty"`                      // Coded form of the unit
+	CodeElement       *Element            `json:"_code,omitempty" bson:"code_element,omitempty"`             // Extensions for code
 }
 
 func (r *Duration) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		return fmt.Errorf("field 'Comparator' has invalid code '%s'", *r.Comparator)
+	}
+	if r.ComparatorElement != nil {
+		if err := r.ComparatorElement.Validate(); err != nil {
+			return fmt.Errorf("ComparatorElement: %w", err)
+		}
+	}
+	if r.UnitElement != nil {
+		if err := r.UnitElement.Validate(); err != nil {
+			return fmt.Errorf("UnitElement: %w", err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Duration) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			issues.add("value", path+".value", err.Error())
+		}
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		issues.add("code-invalid", path+".comparator", fmt.Sprintf("field 'Comparator' has invalid code '%s'", *r.Comparator))
+	}
+	if r.ComparatorElement != nil {
+		r.ComparatorElement.validateAll(path+".comparator", issues)
+	}
+	if r.UnitElement != nil {
+		r.UnitElement.validateAll(path+".unit", issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+}
+
+func (r *Duration) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Duration", &issues)
+	checkInvariants(r, "Duration", &issues)
+	return issues
+}
+
+var durationInvariants = []invariant{
+	{key: "drt-1", severity: "error", human: "There SHALL be a code if there is a value and it SHALL be an expression of time.  If system is present, it SHALL be UCUM.", expression: "code.exists() implies ((system = %ucum) and value.exists())"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "qty-3", severity: "error", human: "If a code for the unit is present, the system SHALL also be present", expression: "code.empty() or system.exists()"},
+	{key: "qty-4", severity: "warning", human: "Processing UCUM codes with annotations ({..}) can be misleading.", expression: "code.exists() implies code.matches('\\\\{.*?\\\\}').not()"},
+}
+
+func (r *Duration) invariants() []invariant {
+	return durationInvariants
+}
+
+func (r Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Duration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Duration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Duration) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var durationElementTypes = map[string]string{
+	"system": "uri",
+	"code":   "code",
+}
+
+func (r *Duration) elementTypes() map[string]string {
+	return durationElementTypes
+}
+
+var durationElementMetadata = []ElementMetadata{
+	{Path: "Duration.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Duration.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Duration.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Duration.comparator", Name: "comparator", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Duration.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/quantity-comparator", Description: "How the Quantity should be understood and represented."}, Summary: true, Modifier: true},
+	{Path: "Duration.unit", Name: "unit", Field: 6, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Duration.system", Name: "system", Field: 8, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "Duration.code", Name: "code", Field: 10, Min: 0, Max: "1", Types: []string{"code"}, Summary: true},
+}
+
+func (r *Duration) ElementMetadata() []ElementMetadata {
+	return durationElementMetadata
+}
@@ -1,10 +1,68 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Element Type: Base definition for all elements in a resource.
 type Element struct {
-	Id *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
 }
 
 func (r *Element) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *Element) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *Element) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Element", &issues)
+	checkInvariants(r, "Element", &issues)
+	return issues
+}
+
+var elementInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())", context: "extension"},
+}
+
+func (r *Element) invariants() []invariant {
+	return elementInvariants
+}
+
+func (r Element) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Element) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Element) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Element) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementElementMetadata = []ElementMetadata{
+	{Path: "Element.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Element.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+}
+
+func (r *Element) ElementMetadata() []ElementMetadata {
+	return elementElementMetadata
+}
@@ -1,1405 +1,6674 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // ElementDefinition Type: Captures constraints on each element within the resource, profile, or extension.
 type ElementDefinition struct {
-	Id                                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                                                   // Unique id for inter-element referencing
-	Path                              string                        `json:"path" bson:"path"`                                                                                   // Path of the element in the hierarchy of elements
-	Representation                    []string                      `json:"representation,omitempty" bson:"representation,omitempty"`                                           // xmlAttr | xmlText | typeAttr | cdaText | xhtml
-	SliceName                         *string                       `json:"sliceName,omitempty" bson:"slice_name,omitempty"`                                                    // Name for this particular element (in a set of slices)
-	SliceIsConstraining               *bool                         `json:"sliceIsConstraining,omitempty" bson:"slice_is_constraining,omitempty"`                               // If this slice definition constrains an inherited slice definition (or not)
-	Label                             *string                       `json:"label,omitempty" bson:"label,omitempty"`                                                             // String to display with or prompt for element
-	Code                              []Coding                      `json:"code,omitempty" bson:"code,omitempty"`                                                               // Corresponding codes in terminologies
-	Slicing                           *ElementDefinitionSlicing     `json:"slicing,omitempty" bson:"slicing,omitempty"`                                                         // This element is sliced - slices follow
-	Short                             *string                       `json:"short,omitempty" bson:"short,omitempty"`                                                             // Concise definition for space-constrained presentation
-	Definition                        *string                       `json:"definition,omitempty" bson:"definition,omitempty"`                                                   // Full formal definition as narrative text
-	Comment                           *string                       `json:"comment,omitempty" bson:"comment,omitempty"`                                                         // Comments about the use of this element
-	Requirements                      *string                       `json:"requirements,omitempty" bson:"requirements,omitempty"`                                               // Requirements satisfied by this element/structure and its constraints
-	Alias                             []string                      `json:"alias,omitempty" bson:"alias,omitempty"`                                                             // Other names
-	Min                               *int                          `json:"min,omitempty" bson:"min,omitempty"`                                                                 // Minimum Cardinality
-	Max                               *string                       `json:"max,omitempty" bson:"max,omitempty"`                                                                 // Maximum Cardinality (a number or *)
-	Base                              *ElementDefinitionBase        `json:"base,omitempty" bson:"base,omitempty"`                                                               // Base definition information for tools
-	ContentReference                  *string                       `json:"contentReference,omitempty" bson:"content_reference,omitempty"`                                      // Reference to definition of content for the element
-	Type                              []ElementDefinitionType       `json:"type,omitempty" bson:"type,omitempty"`                                                               // Data type and Profile for this element
-	DefaultValueBase64Binary          *string                       `json:"defaultValueBase64Binary,omitempty" bson:"default_value_base64_binary,omitempty"`                    // Specified value if missing from instance
-	DefaultValueBoolean               *bool                         `json:"defaultValueBoolean,omitempty" bson:"default_value_boolean,omitempty"`                               // Specified value if missing from instance
-	DefaultValueCanonical             *string                       `json:"defaultValueCanonical,omitempty" bson:"default_value_canonical,omitempty"`                           // Specified value if missing from instance
-	DefaultValueCode                  *string                       `json:"defaultValueCode,omitempty" bson:"default_value_code,omitempty"`                                     // Specified value if missing from instance
-	DefaultValueDate                  *string                       `json:"defaultValueDate,omitempty" bson:"default_value_date,omitempty"`                                     // Specified value if missing from instance
-	DefaultValueDateTime              *string                       `json:"defaultValueDateTime,omitempty" bson:"default_value_date_time,omitempty"`                            // Specified value if missing from instance
-	DefaultValueDecimal               *float64                      `json:"defaultValueDecimal,omitempty" bson:"default_value_decimal,omitempty"`                               // Specified value if missing from instance
-	DefaultValueId                    *string                       `json:"defaultValueId,omitempty" bson:"default_value_id,omitempty"`                                         // Specified value if missing from instance
-	DefaultValueInstant               *string                       `json:"defaultValueInstant,omitempty" bson:"default_value_instant,omitempty"`                               // Specified value if missing from instance
-	DefaultValueInteger               *int                          `json:"defaultValueInteger,omitempty" bson:"default_value_integer,omitempty"`                               // Specified value if missing from instance
-	DefaultValueInteger64             *int64                        `json:"defaultValueInteger64,omitempty" bson:"default_value_integer64,omitempty"`                           // Specified value if missing from instance
-	DefaultValueMarkdown              *string                       `json:"defaultValueMarkdown,omitempty" bson:"default_value_markdown,omitempty"`                             // Specified value if missing from instance
-	DefaultValueOid                   *string                       `json:"defaultValueOid,omitempty" bson:"default_value_oid,omitempty"`                                       // Specified value if missing from instance
-	DefaultValuePositiveInt           *int                          `json:"defaultValuePositiveInt,omitempty" bson:"default_value_positive_int,omitempty"`                      // Specified value if missing from instance
-	DefaultValueString                *string                       `json:"defaultValueString,omitempty" bson:"default_value_string,omitempty"`                                 // Specified value if missing from instance
-	DefaultValueTime                  *string                       `json:"defaultValueTime,omitempty" bson:"default_value_time,omitempty"`                                     // Specified value if missing from instance
-	DefaultValueUnsignedInt           *int                          `json:"defaultValueUnsignedInt,omitempty" bson:"default_value_unsigned_int,omitempty"`                      // Specified value if missing from instance
-	DefaultValueUri                   *string                       `json:"defaultValueUri,omitempty" bson:"default_value_uri,omitempty"`                                       // Specified value if missing from instance
-	DefaultValueUrl                   *string                       `json:"defaultValueUrl,omitempty" bson:"default_value_url,omitempty"`                                       // Specified value if missing from instance
-	DefaultValueUuid                  *uuid                         `json:"defaultValueUuid,omitempty" bson:"default_value_uuid,omitempty"`                                     // Specified value if missing from instance
-	DefaultValueAddress               *Address                      `json:"defaultValueAddress,omitempty" bson:"default_value_address,omitempty"`                               // Specified value if missing from instance
-	DefaultValueAge                   *Age                          `json:"defaultValueAge,omitempty" bson:"default_value_age,omitempty"`                                       // Specified value if missing from instance
-	DefaultValueAnnotation            *Annotation                   `json:"defaultValueAnnotation,omitempty" bson:"default_value_annotation,omitempty"`                         // Specified value if missing from instance
-	DefaultValueAttachment            *Attachment                   `json:"defaultValueAttachment,omitempty" bson:"default_value_attachment,omitempty"`                         // Specified value if missing from instance
-	DefaultValueCodeableConcept       *CodeableConcept              `json:"defaultValueCodeableConcept,omitempty" bson:"default_value_codeable_concept,omitempty"`              // Specified value if missing from instance
-	DefaultValueCodeableReference     *CodeableReference            `json:"defaultValueCodeableReference,omitempty" bson:"default_value_codeable_reference,omitempty"`          // Specified value if missing from instance
-	DefaultValueCoding                *Coding                       `json:"defaultValueCoding,omitempty" bson:"default_value_coding,omitempty"`                                 // Specified value if missing from instance
-	DefaultValueContactPoint          *ContactPoint                 `json:"defaultValueContactPoint,omitempty" bson:"default_value_contact_point,omitempty"`                    // Specified value if missing from instance
-	DefaultValueCount                 *Count                        `json:"defaultValueCount,omitempty" bson:"default_value_count,omitempty"`                                   // Specified value if missing from instance
-	DefaultValueDistance              *Distance                     `json:"defaultValueDistance,omitempty" bson:"default_value_distance,omitempty"`                             // Specified value if missing from instance
-	DefaultValueDuration              *Duration                     `json:"defaultValueDuration,omitempty" bson:"default_value_duration,omitempty"`                             // Specified value if missing from instance
-	DefaultValueHumanName             *HumanName                    `json:"defaultValueHumanName,omitempty" bson:"default_value_human_name,omitempty"`                          // Specified value if missing from instance
-	DefaultValueIdentifier            *Identifier                   `json:"defaultValueIdentifier,omitempty" bson:"default_value_identifier,omitempty"`                         // Specified value if missing from instance
-	DefaultValueMoney                 *Money                        `json:"defaultValueMoney,omitempty" bson:"default_value_money,omitempty"`                                   // Specified value if missing from instance
-	DefaultValuePeriod                *Period                       `json:"defaultValuePeriod,omitempty" bson:"default_value_period,omitempty"`                                 // Specified value if missing from instance
-	DefaultValueQuantity              *Quantity                     `json:"defaultValueQuantity,omitempty" bson:"default_value_quantity,omitempty"`                             // Specified value if missing from instance
-	DefaultValueRange                 *Range                        `json:"defaultValueRange,omitempty" bson:"default_value_range,omitempty"`                                   // Specified value if missing from instance
-	DefaultValueRatio                 *Ratio                        `json:"defaultValueRatio,omitempty" bson:"default_value_ratio,omitempty"`                                   // Specified value if missing from instance
-	DefaultValueRatioRange            *RatioRange                   `json:"defaultValueRatioRange,omitempty" bson:"default_value_ratio_range,omitempty"`                        // Specified value if missing from instance
-	DefaultValueReference             *Reference                    `json:"defaultValueReference,omitempty" bson:"default_value_reference,omitempty"`                           // Specified value if missing from instance
-	DefaultValueSampledData           *SampledData                  `json:"defaultValueSampledData,omitempty" bson:"default_value_sampled_data,omitempty"`                      // Specified value if missing from instance
-	DefaultValueSignature             *Signature                    `json:"defaultValueSignature,omitempty" bson:"default_value_signature,omitempty"`                           // Specified value if missing from instance
-	DefaultValueTiming                *Timing                       `json:"defaultValueTiming,omitempty" bson:"default_value_timing,omitempty"`                                 // Specified value if missing from instance
-	DefaultValueContactDetail         *ContactDetail                `json:"defaultValueContactDetail,omitempty" bson:"default_value_contact_detail,omitempty"`                  // Specified value if missing from instance
-	DefaultValueDataRequirement       *DataRequirement              `json:"defaultValueDataRequirement,omitempty" bson:"default_value_data_requirement,omitempty"`              // Specified value if missing from instance
-	DefaultValueExpression            *Expression                   `json:"defaultValueExpression,omitempty" bson:"default_value_expression,omitempty"`                         // Specified value if missing from instance
-	DefaultValueParameterDefinition   *ParameterDefinition          `json:"defaultValueParameterDefinition,omitempty" bson:"default_value_parameter_definition,omitempty"`      // Specified value if missing from instance
-	DefaultValueRelatedArtifact       *RelatedArtifact              `json:"defaultValueRelatedArtifact,omitempty" bson:"default_value_related_artifact,omitempty"`              // Specified value if missing from instance
-	DefaultValueTriggerDefinition     *TriggerDefinition            `json:"defaultValueTriggerDefinition,omitempty" bson:"default_value_trigger_definition,omitempty"`          // Specified value if missing from instance
-	DefaultValueUsageContext          *UsageContext                 `json:"defaultValueUsageContext,omitempty" bson:"default_value_usage_context,omitempty"`                    // Specified value if missing from instance
-	DefaultValueAvailability          *Availability                 `json:"defaultValueAvailability,omitempty" bson:"default_value_availability,omitempty"`                     // Specified value if missing from instance
-	DefaultValueExtendedContactDetail *ExtendedContactDetail        `json:"defaultValueExtendedContactDetail,omitempty" bson:"default_value_extended_contact_detail,omitempty"` // Specified value if missing from instance
-	DefaultValueVirtualServiceDetail  *VirtualServiceDetail         `json:"defaultValueVirtualServiceDetail,omitempty" bson:"default_value_virtual_service_detail,omitempty"`   // Specified value if missing from instance
-	DefaultValueDosage                *Dosage                       `json:"defaultValueDosage,omitempty" bson:"default_value_dosage,omitempty"`                                 // Specified value if missing from instance
-	DefaultValueMeta                  *Meta                         `json:"defaultValueMeta,omitempty" bson:"default_value_meta,omitempty"`                                     // Specified value if missing from instance
-	MeaningWhenMissing                *string                       `json:"meaningWhenMissing,omitempty" bson:"meaning_when_missing,omitempty"`                                 // Implicit meaning when this element is missing
-	OrderMeaning                      *string                       `json:"orderMeaning,omitempty" bson:"order_meaning,omitempty"`                                              // What the order of the elements means
-	FixedBase64Binary                 *string                       `json:"fixedBase64Binary,omitempty" bson:"fixed_base64_binary,omitempty"`                                   // Value must be exactly this
-	FixedBoolean                      *bool                         `json:"fixedBoolean,omitempty" bson:"fixed_boolean,omitempty"`                                              // Value must be exactly this
-	FixedCanonical                    *string                       `json:"fixedCanonical,omitempty" bson:"fixed_canonical,omitempty"`                                          // Value must be exactly this
-	FixedCode                         *string                       `json:"fixedCode,omitempty" bson:"fixed_code,omitempty"`                                                    // Value must be exactly this
-	FixedDate                         *string                       `json:"fixedDate,omitempty" bson:"fixed_date,omitempty"`                                                    // Value must be exactly this
-	FixedDateTime                     *string                       `json:"fixedDateTime,omitempty" bson:"fixed_date_time,omitempty"`                                           // Value must be exactly this
-	FixedDecimal                      *float64                      `json:"fixedDecimal,omitempty" bson:"fixed_decimal,omitempty"`                                              // Value must be exactly this
-	FixedId                           *string                       `json:"fixedId,omitempty" bson:"fixed_id,omitempty"`                                                        // Value must be exactly this
-	FixedInstant                      *string                       `json:"fixedInstant,omitempty" bson:"fixed_instant,omitempty"`                                              // Value must be exactly this
-	FixedInteger                      *int                          `json:"fixedInteger,omitempty" bson:"fixed_integer,omitempty"`                                              // Value must be exactly this
-	FixedInteger64                    *int64                        `json:"fixedInteger64,omitempty" bson:"fixed_integer64,omitempty"`                                          // Value must be exactly this
-	FixedMarkdown                     *string                       `json:"fixedMarkdown,omitempty" bson:"fixed_markdown,omitempty"`                                            // Value must be exactly this
-	FixedOid                          *string                       `json:"fixedOid,omitempty" bson:"fixed_oid,omitempty"`                                                      // Value must be exactly this
-	FixedPositiveInt                  *int                          `json:"fixedPositiveInt,omitempty" bson:"fixed_positive_int,omitempty"`                                     // Value must be exactly this
-	FixedString                       *string                       `json:"fixedString,omitempty" bson:"fixed_string,omitempty"`                                                // Value must be exactly this
-	FixedTime                         *string                       `json:"fixedTime,omitempty" bson:"fixed_time,omitempty"`                                                    // Value must be exactly this
-	FixedUnsignedInt                  *int                          `json:"fixedUnsignedInt,omitempty" bson:"fixed_unsigned_int,omitempty"`                                     // Value must be exactly this
-	FixedUri                          *string                       `json:"fixedUri,omitempty" bson:"fixed_uri,omitempty"`                                                      // Value must be exactly this
-	FixedUrl                          *string                       `json:"fixedUrl,omitempty" bson:"fixed_url,omitempty"`                                                      // Value must be exactly this
-	FixedUuid                         *uuid                         `json:"fixedUuid,omitempty" bson:"fixed_uuid,omitempty"`                                                    // Value must be exactly this
-	FixedAddress                      *Address                      `json:"fixedAddress,omitempty" bson:"fixed_address,omitempty"`                                              // Value must be exactly this
-	FixedAge                          *Age                          `json:"fixedAge,omitempty" bson:"fixed_age,omitempty"`                                                      // Value must be exactly this
-	FixedAnnotation                   *Annotation                   `json:"fixedAnnotation,omitempty" bson:"fixed_annotation,omitempty"`                                        // Value must be exactly this
-	FixedAttachment                   *Attachment                   `json:"fixedAttachment,omitempty" bson:"fixed_attachment,omitempty"`                                        // Value must be exactly this
-	FixedCodeableConcept              *CodeableConcept              `json:"fixedCodeableConcept,omitempty" bson:"fixed_codeable_concept,omitempty"`                             // Value must be exactly this
-	FixedCodeableReference            *CodeableReference            `json:"fixedCodeableReference,omitempty" bson:"fixed_codeable_reference,omitempty"`                         // Value must be exactly this
-	FixedCoding                       *Coding                       `json:"fixedCoding,omitempty" bson:"fixed_coding,omitempty"`                                                // Value must be exactly this
-	FixedContactPoint                 *ContactPoint                 `json:"fixedContactPoint,omitempty" bson:"fixed_contact_point,omitempty"`                                   // Value must be exactly this
-	FixedCount                        *Count                        `json:"fixedCount,omitempty" bson:"fixed_count,omitempty"`                                                  // Value must be exactly this
-	FixedDistance                     *Distance                     `json:"fixedDistance,omitempty" bson:"fixed_distance,omitempty"`                                            // Value must be exactly this
-	FixedDuration                     *Duration                     `json:"fixedDuration,omitempty" bson:"fixed_duration,omitempty"`                                            // Value must be exactly this
-	FixedHumanName                    *HumanName                    `json:"fixedHumanName,omitempty" bson:"fixed_human_name,omitempty"`                                         // Value must be exactly this
-	FixedIdentifier                   *Identifier                   `json:"fixedIdentifier,omitempty" bson:"fixed_identifier,omitempty"`                                        // Value must be exactly this
-	FixedMoney                        *Money                        `json:"fixedMoney,omitempty" bson:"fixed_money,omitempty"`                                                  // Value must be exactly this
-	FixedPeriod                       *Period                       `json:"fixedPeriod,omitempty" bson:"fixed_period,omitempty"`                                                // Value must be exactly this
-	FixedQuantity                     *Quantity                     `json:"fixedQuantity,omitempty" bson:"fixed_quantity,omitempty"`                                            // Value must be exactly this
-	FixedRange                        *Range                        `json:"fixedRange,omitempty" bson:"fixed_range,omitempty"`                                                  // Value must be exactly this
-	FixedRatio                        *Ratio                        `json:"fixedRatio,omitempty" bson:"fixed_ratio,omitempty"`                                                  // Value must be exactly this
-	FixedRatioRange                   *RatioRange                   `json:"fixedRatioRange,omitempty" bson:"fixed_ratio_range,omitempty"`                                       // Value must be exactly this
-	FixedReference                    *Reference                    `json:"fixedReference,omitempty" bson:"fixed_reference,omitempty"`                                          // Value must be exactly this
-	FixedSampledData                  *SampledData                  `json:"fixedSampledData,omitempty" bson:"fixed_sampled_data,omitempty"`                                     // Value must be exactly this
-	FixedSignature                    *Signature                    `json:"fixedSignature,omitempty" bson:"fixed_signature,omitempty"`                                          // Value must be exactly this
-	FixedTiming                       *Timing                       `json:"fixedTiming,omitempty" bson:"fixed_timing,omitempty"`                                                // Value must be exactly this
-	FixedContactDetail                *ContactDetail                `json:"fixedContactDetail,omitempty" bson:"fixed_contact_detail,omitempty"`                                 // Value must be exactly this
-	FixedDataRequirement              *DataRequirement              `json:"fixedDataRequirement,omitempty" bson:"fixed_data_requirement,omitempty"`                             // Value must be exactly this
-	FixedExpression                   *Expression                   `json:"fixedExpression,omitempty" bson:"fixed_expression,omitempty"`                                        // Value must be exactly this
-	FixedParameterDefinition          *ParameterDefinition          `json:"fixedParameterDefinition,omitempty" bson:"fixed_parameter_definition,omitempty"`                     // Value must be exactly this
-	FixedRelatedArtifact              *RelatedArtifact              `json:"fixedRelatedArtifact,omitempty" bson:"fixed_related_artifact,omitempty"`                             // Value must be exactly this
-	FixedTriggerDefinition            *TriggerDefinition            `json:"fixedTriggerDefinition,omitempty" bson:"fixed_trigger_definition,omitempty"`                         // Value must be exactly this
-	FixedUsageContext                 *UsageContext                 `json:"fixedUsageContext,omitempty" bson:"fixed_usage_context,omitempty"`                                   // Value must be exactly this
-	FixedAvailability                 *Availability                 `json:"fixedAvailability,omitempty" bson:"fixed_availability,omitempty"`                                    // Value must be exactly this
-	FixedExtendedContactDetail        *ExtendedContactDetail        `json:"fixedExtendedContactDetail,omitempty" bson:"fixed_extended_contact_detail,omitempty"`                // Value must be exactly this
-	FixedVirtualServiceDetail         *VirtualServiceDetail         `json:"fixedVirtualServiceDetail,omitempty" bson:"fixed_virtual_service_detail,omitempty"`                  // Value must be exactly this
-	FixedDosage                       *Dosage                       `json:"fixedDosage,omitempty" bson:"fixed_dosage,omitempty"`                                                // Value must be exactly this
-	FixedMeta                         *Meta                         `json:"fixedMeta,omitempty" bson:"fixed_meta,omitempty"`                                                    // Value must be exactly this
-	PatternBase64Binary               *string                       `json:"patternBase64Binary,omitempty" bson:"pattern_base64_binary,omitempty"`                               // Value must have at least these property values
-	PatternBoolean                    *bool                         `json:"patternBoolean,omitempty" bson:"pattern_boolean,omitempty"`                                          // Value must have at least these property values
-	PatternCanonical                  *string                       `json:"patternCanonical,omitempty" bson:"pattern_canonical,omitempty"`                                      // Value must have at least these property values
-	PatternCode                       *string                       `json:"patternCode,omitempty" bson:"pattern_code,omitempty"`                                                // Value must have at least these property values
-	PatternDate                       *string                       `json:"patternDate,omitempty" bson:"pattern_date,omitempty"`                                                // Value must have at least these property values
-	PatternDateTime                   *string                       `json:"patternDateTime,omitempty" bson:"pattern_date_time,omitempty"`                                       // Value must have at least these property values
-	PatternDecimal                    *float64                      `json:"patternDecimal,omitempty" bson:"pattern_decimal,omitempty"`                                          // Value must have at least these property values
-	PatternId                         *string                       `json:"patternId,omitempty" bson:"pattern_id,omitempty"`                                                    // Value must have at least these property values
-	PatternInstant                    *string                       `json:"patternInstant,omitempty" bson:"pattern_instant,omitempty"`                                          // Value must have at least these property values
-	PatternInteger                    *int                          `json:"patternInteger,omitempty" bson:"pattern_integer,omitempty"`                                          // Value must have at least these property values
-	PatternInteger64                  *int64                        `json:"patternInteger64,omitempty" bson:"pattern_integer64,omitempty"`                                      // Value must have at least these property values
-	PatternMarkdown                   *string                       `json:"patternMarkdown,omitempty" bson:"pattern_markdown,omitempty"`                                        // Value must have at least these property values
-	PatternOid                        *string                       `json:"patternOid,omitempty" bson:"pattern_oid,omitempty"`                                                  // Value must have at least these property values
-	PatternPositiveInt                *int                          `json:"patternPositiveInt,omitempty" bson:"pattern_positive_int,omitempty"`                                 // Value must have at least these property values
-	PatternString                     *string                       `json:"patternString,omitempty" bson:"pattern_string,omitempty"`                                            // Value must have at least these property values
-	PatternTime                       *string                       `json:"patternTime,omitempty" bson:"pattern_time,omitempty"`                                                // Value must have at least these property values
-	PatternUnsignedInt                *int                          `json:"patternUnsignedInt,omitempty" bson:"pattern_unsigned_int,omitempty"`                                 // Value must have at least these property values
-	PatternUri                        *string                       `json:"patternUri,omitempty" bson:"pattern_uri,omitempty"`                                                  // Value must have at least these property values
-	PatternUrl                        *string                       `json:"patternUrl,omitempty" bson:"pattern_url,omitempty"`                                                  // Value must have at least these property values
-	PatternUuid                       *uuid                         `json:"patternUuid,omitempty" bson:"pattern_uuid,omitempty"`                                                // Value must have at least these property values
-	PatternAddress                    *Address                      `json:"patternAddress,omitempty" bson:"pattern_address,omitempty"`                                          // Value must have at least these property values
-	PatternAge                        *Age                          `json:"patternAge,omitempty" bson:"pattern_age,omitempty"`                                                  // Value must have at least these property values
-	PatternAnnotation                 *Annotation                   `json:"patternAnnotation,omitempty" bson:"pattern_annotation,omitempty"`                                    // Value must have at least these property values
-	PatternAttachment                 *Attachment                   `json:"patternAttachment,omitempty" bson:"pattern_attachment,omitempty"`                                    // Value must have at least these property values
-	PatternCodeableConcept            *CodeableConcept              `json:"patternCodeableConcept,omitempty" bson:"pattern_codeable_concept,omitempty"`                         // Value must have at least these property values
-	PatternCodeableReference          *CodeableReference            `json:"patternCodeableReference,omitempty" bson:"pattern_codeable_reference,omitempty"`                     // Value must have at least these property values
-	PatternCoding                     *Coding                       `json:"patternCoding,omitempty" bson:"pattern_coding,omitempty"`                                            // Value must have at least these property values
-	PatternContactPoint               *ContactPoint                 `json:"patternContactPoint,omitempty" bson:"pattern_contact_point,omitempty"`                               // Value must have at least these property values
-	PatternCount                      *Count                        `json:"patternCount,omitempty" bson:"pattern_count,omitempty"`                                              // Value must have at least these property values
-	PatternDistance                   *Distance                     `json:"patternDistance,omitempty" bson:"pattern_distance,omitempty"`                                        // Value must have at least these property values
-	PatternDuration                   *Duration                     `json:"patternDuration,omitempty" bson:"pattern_duration,omitempty"`                                        // Value must have at least these property values
-	PatternHumanName                  *HumanName                    `json:"patternHumanName,omitempty" bson:"pattern_human_name,omitempty"`                                     // Value must have at least these property values
-	PatternIdentifier                 *Identifier                   `json:"patternIdentifier,omitempty" bson:"pattern_identifier,omitempty"`                                    // Value must have at least these property values
-	PatternMoney                      *Money                        `json:"patternMoney,omitempty" bson:"pattern_money,omitempty"`                                              // Value must have at least these property values
-	PatternPeriod                     *Period                       `json:"patternPeriod,omitempty" bson:"pattern_period,omitempty"`                                            // Value must have at least these property values
-	PatternQuantity                   *Quantity                     `json:"patternQuantity,omitempty" bson:"pattern_quantity,omitempty"`                                        // Value must have at least these property values
-	PatternRange                      *Range                        `json:"patternRange,omitempty" bson:"pattern_range,omitempty"`                                              // Value must have at least these property values
-	PatternRatio                      *Ratio                        `json:"patternRatio,omitempty" bson:"pattern_ratio,omitempty"`                                              // Value must have at least these property values
-	PatternRatioRange                 *RatioRange                   `json:"patternRatioRange,omitempty" bson:"pattern_ratio_range,omitempty"`                                   // Value must have at least these property values
-	PatternReference                  *Reference                    `json:"patternReference,omitempty" bson:"pattern_reference,omitempty"`                                      // Value must have at least these property values
-	PatternSampledData                *SampledData                  `json:"patternSampledData,omitempty" bson:"pattern_sampled_data,omitempty"`                                 // Value must have at least these property values
-	PatternSignature                  *Signature                    `json:"patternSignature,omitempty" bson:"pattern_signature,omitempty"`                                      // Value must have at least these property values
-	PatternTiming                     *Timing                       `json:"patternTiming,omitempty" bson:"pattern_timing,omitempty"`                                            // Value must have at least these property values
-	PatternContactDetail              *ContactDetail                `json:"patternContactDetail,omitempty" bson:"pattern_contact_detail,omitempty"`                             // Value must have at least these property values
-	PatternDataRequirement            *DataRequirement              `json:"patternDataRequirement,omitempty" bson:"pattern_data_requirement,omitempty"`                         // Value must have at least these property values
-	PatternExpression                 *Expression                   `json:"patternExpression,omitempty" bson:"pattern_expression,omitempty"`                                    // Value must have at least these property values
-	PatternParameterDefinition        *ParameterDefinition          `json:"patternParameterDefinition,omitempty" bson:"pattern_parameter_definition,omitempty"`                 // Value must have at least these property values
-	PatternRelatedArtifact            *RelatedArtifact              `json:"patternRelatedArtifact,omitempty" bson:"pattern_related_artifact,omitempty"`                         // Value must have at least these property values
-	PatternTriggerDefinition          *TriggerDefinition            `json:"patternTriggerDefinition,omitempty" bson:"pattern_trigger_definition,omitempty"`                     // Value must have at least these property values
-	PatternUsageContext               *UsageContext                 `json:"patternUsageContext,omitempty" bson:"pattern_usage_context,omitempty"`                               // Value must have at least these property values
-	PatternAvailability               *Availability                 `json:"patternAvailability,omitempty" bson:"pattern_availability,omitempty"`                                // Value must have at least these property values
-	PatternExtendedContactDetail      *ExtendedContactDetail        `json:"patternExtendedContactDetail,omitempty" bson:"pattern_extended_contact_detail,omitempty"`            // Value must have at least these property values
-	PatternVirtualServiceDetail       *VirtualServiceDetail         `json:"patternVirtualServiceDetail,omitempty" bson:"pattern_virtual_service_detail,omitempty"`              // Value must have at least these property values
-	PatternDosage                     *Dosage                       `json:"patternDosage,omitempty" bson:"pattern_dosage,omitempty"`                                            // Value must have at least these property values
-	PatternMeta                       *Meta                         `json:"patternMeta,omitempty" bson:"pattern_meta,omitempty"`                                                // Value must have at least these property values
-	Example                           []ElementDefinitionExample    `json:"example,omitempty" bson:"example,omitempty"`                                                         // Example value (as defined for type)
-	MinValueDate                      *string                       `json:"minValueDate,omitempty" bson:"min_value_date,omitempty"`                                             // Minimum Allowed Value (for some types)
-	MinValueDateTime                  *string                       `json:"minValueDateTime,omitempty" bson:"min_value_date_time,omitempty"`                                    // Minimum Allowed Value (for some types)
-	MinValueInstant                   *string                       `json:"minValueInstant,omitempty" bson:"min_value_instant,omitempty"`                                       // Minimum Allowed Value (for some types)
-	MinValueTime                      *string                       `json:"minValueTime,omitempty" bson:"min_value_time,omitempty"`                                             // Minimum Allowed Value (for some types)
-	MinValueDecimal                   *float64                      `json:"minValueDecimal,omitempty" bson:"min_value_decimal,omitempty"`                                       // Minimum Allowed Value (for some types)
-	MinValueInteger                   *int                          `json:"minValueInteger,omitempty" bson:"min_value_integer,omitempty"`                                       // Minimum Allowed Value (for some types)
-	MinValueInteger64                 *int64                        `json:"minValueInteger64,omitempty" bson:"min_value_integer64,omitempty"`                                   // Minimum Allowed Value (for some types)
-	MinValuePositiveInt               *int                          `json:"minValuePositiveInt,omitempty" bson:"min_value_positive_int,omitempty"`                              // Minimum Allowed Value (for some types)
-	MinValueUnsignedInt               *int                          `json:"minValueUnsignedInt,omitempty" bson:"min_value_unsigned_int,omitempty"`                              // Minimum Allowed Value (for some types)
-	MinValueQuantity                  *Quantity                     `json:"minValueQuantity,omitempty" bson:"min_value_quantity,omitempty"`                                     // Minimum Allowed Value (for some types)
-	MaxValueDate                      *string                       `json:"maxValueDate,omitempty" bson:"max_value_date,omitempty"`                                             // Maximum Allowed Value (for some types)
-	MaxValueDateTime                  *string                       `json:"maxValueDateTime,omitempty" bson:"max_value_date_time,omitempty"`                                    // Maximum Allowed Value (for some types)
-	MaxValueInstant                   *string                       `json:"maxValueInstant,omitempty" bson:"max_value_instant,omitempty"`                                       // Maximum Allowed Value (for some types)
-	MaxValueTime                      *string                       `json:"maxValueTime,omitempty" bson:"max_value_time,omitempty"`                                             // Maximum Allowed Value (for some types)
-	MaxValueDecimal                   *float64                      `json:"maxValueDecimal,omitempty" bson:"max_value_decimal,omitempty"`                                       // Maximum Allowed Value (for some types)
-	MaxValueInteger                   *int                          `json:"maxValueInteger,omitempty" bson:"max_value_integer,omitempty"`                                       // Maximum Allowed Value (for some types)
-	MaxValueInteger64                 *int64                        `json:"maxValueInteger64,omitempty" bson:"max_value_integer64,omitempty"`                                   // Maximum Allowed Value (for some types)
-	MaxValuePositiveInt               *int                          `json:"maxValuePositiveInt,omitempty" bson:"max_value_positive_int,omitempty"`                              // Maximum Allowed Value (for some types)
-	MaxValueUnsignedInt               *int                          `json:"maxValueUnsignedInt,omitempty" bson:"max_value_unsigned_int,omitempty"`                              // Maximum Allowed Value (for some types)
-	MaxValueQuantity                  *Quantity                     `json:"maxValueQuantity,omitempty" bson:"max_value_quantity,omitempty"`                                     // Maximum Allowed Value (for some types)
-	MaxLength                         *int                          `json:"maxLength,omitempty" bson:"max_length,omitempty"`                                                    // Max length for string type data
-	Condition                         []string                      `json:"condition,omitempty" bson:"condition,omitempty"`                                                     // Reference to invariant about presence
-	Constraint                        []ElementDefinitionConstraint `json:"constraint,omitempty" bson:"constraint,omitempty"`                                                   // Condition that must evaluate to true
-	MustHaveValue                     *bool                         `json:"mustHaveValue,omitempty" bson:"must_have_value,omitempty"`                                           // For primitives, that a value must be present - not replaced by an extension
-	ValueAlternatives                 []string                      `json:"valueAlternatives,omitempty" bson:"value_alternatives,omitempty"`                                    // Extensions that are allowed to replace a primitive value
-	MustSupport                       *bool                         `json:"mustSupport,omitempty" bson:"must_support,omitempty"`                                                // If the element must be supported (discouraged - see obligations)
-	IsModifier                        *bool                         `json:"isModifier,omitempty" bson:"is_modifier,omitempty"`                                                  // If this modifies the meaning of other elements
-	IsModifierReason                  *string                       `json:"isModifierReason,omitempty" bson:"is_modifier_reason,omitempty"`                                     // Reason that this element is marked as a modifier
-	IsSummary                         *bool                         `json:"isSummary,omitempty" bson:"is_summary,omitempty"`                                                    // Include when _summary = true?
-	Binding                           *ElementDefinitionBinding     `json:"binding,omitempty" bson:"binding,omitempty"`                                                         // ValueSet details if this is coded
-	Mapping                           []ElementDefinitionMapping    `json:"mapping,omitempty" bson:"mapping,omitempty"`                                                         // Map element to another set of definitions
+	Id                         *string                       `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                  []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension          []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	Path                       string                        `json:"path" bson:"path"`                                                              // Path of the element in the hierarchy of elements
+	PathElement                *Element                      `json:"_path,omitempty" bson:"path_element,omitempty"`                                 // Extensions for path
+	Representation             []PropertyRepresentation      `json:"representation,omitempty" bson:"representation,omitempty"`                      // xmlAttr | xmlText | typeAttr | cdaText | xhtml
+	RepresentationElement      []*Element                    `json:"_representation,omitempty" bson:"representation_element,omitempty"`             // Extensions for representation
+	SliceName                  *string                       `json:"sliceName,omitempty" bson:"slice_name,omitempty"`                               // Name for this particular element (in a set of slices)
+	SliceNameElement           *Element                      `json:"_sliceName,omitempty" bson:"slice_name_element,omitempty"`                      // Extensions for sliceName
+	SliceIsConstraining        *bool                         `json:"sliceIsConstraining,omitempty" bson:"slice_is_constraining,omitempty"`          // If this slice definition constrains an inherited slice definition (or not)
+	SliceIsConstrainingElement *Element                      `json:"_sliceIsConstraining,omitempty" bson:"slice_is_constraining_element,omitempty"` // Extensions for sliceIsConstraining
+	Label                      *string                       `json:"label,omitempty" bson:"label,omitempty"`                                        // String to display with or prompt for element
+	LabelElement               *Element                      `json:"_label,omitempty" bson:"label_element,omitempty"`                               // Extensions for label
+	Code                       []Coding                      `json:"code,omitempty" bson:"code,omitempty"`                                          // Corresponding codes in terminologies
+	Slicing                    *ElementDefinitionSlicing     `json:"slicing,omitempty" bson:"slicing,omitempty"`                                    // This element is sliced - slices follow
+	Short                      *string                       `json:"short,omitempty" bson:"short,omitempty"`                                        // Concise definition for space-constrained presentation
+	ShortElement               *Element                      `json:"_short,omitempty" bson:"short_element,omitempty"`                               // Extensions for short
+	Definition                 *string                       `json:"definition,omitempty" bson:"definition,omitempty"`                              // Full formal definition as narrative text
+	DefinitionElement          *Element                      `json:"_definition,omitempty" bson:"definition_element,omitempty"`                     // Extensions for definition
+	Comment                    *string                       `json:"comment,omitempty" bson:"comment,omitempty"`                                    // Comments about the use of this element
+	CommentElement             *Element                      `json:"_comment,omitempty" bson:"comment_element,omitempty"`                           // Extensions for comment
+	Requirements               *string                       `json:"requirements,omitempty" bson:"requirements,omitempty"`                          // Requirements satisfied by this element/structure and its constraints
+	RequirementsElement        *Element                      `json:"_requirements,omitempty" bson:"requirements_element,omitempty"`                 // Extensions for requirements
+	Alias                      []string                      `json:"alias,omitempty" bson:"alias,omitempty"`                                        // Other names
+	AliasElement               []*Element                    `json:"_alias,omitempty" bson:"alias_element,omitempty"`                               // Extensions for alias
+	Min                        *int                          `json:"min,omitempty" bson:"min,omitempty"`                                            // Minimum Cardinality
+	MinElement                 *Element                      `json:"_min,omitempty" bson:"min_element,omitempty"`                                   // Extensions for min
+	Max                        *string                       `json:"max,omitempty" bson:"max,omitempty"`                                            // Maximum Cardinality (a number or *)
+	MaxElement                 *Element                      `json:"_max,omitempty" bson:"max_element,omitempty"`                                   // Extensions for max
+	Base                       *ElementDefinitionBase        `json:"base,omitempty" bson:"base,omitempty"`                                          // Base definition information for tools
+	ContentReference           *string                       `json:"contentReference,omitempty" bson:"content_reference,omitempty"`                 // Reference to definition of content for the element
+	ContentReferenceElement    *Element                      `json:"_contentReference,omitempty" bson:"content_reference_element,omitempty"`        // Extensions for contentReference
+	Type                       []ElementDefinitionType       `json:"type,omitempty" bson:"type,omitempty"`                                          // Data type and Profile for this element
+	DefaultValue               ElementDefinitionDefaultValue `json:"-" bson:"default_value,omitempty"`                                              // Specified value if missing from instance
+	DefaultValueElement        *Element                      `json:"-" bson:"default_value_element,omitempty"`                                      // Extensions for defaultValue[x]
+	MeaningWhenMissing         *string                       `json:"meaningWhenMissing,omitempty" bson:"meaning_when_missing,omitempty"`            // Implicit meaning when this element is missing
+	MeaningWhenMissingElement  *Element                      `json:"_meaningWhenMissing,omitempty" bson:"meaning_when_missing_element,omitempty"`   // Extensions for meaningWhenMissing
+	OrderMeaning               *string                       `json:"orderMeaning,omitempty" bson:"order_meaning,omitempty"`                         // What the order of the elements means
+	OrderMeaningElement        *Element                      `json:"_orderMeaning,omitempty" bson:"order_meaning_element,omitempty"`                // Extensions for orderMeaning
+	Fixed                      ElementDefinitionFixed        `json:"-" bson:"fixed,omitempty"`                                                      // Value must be exactly this
+	FixedElement               *Element                      `json:"-" bson:"fixed_element,omitempty"`                                              // Extensions for fixed[x]
+	Pattern                    ElementDefinitionPattern      `json:"-" bson:"pattern,omitempty"`                                                    // Value must have at least these property values
+	PatternElement             *Element                      `json:"-" bson:"pattern_element,omitempty"`                                            // Extensions for pattern[x]
+	Example                    []ElementDefinitionExample    `json:"example,omitempty" bson:"example,omitempty"`                                    // Example value (as defined for type)
+	MinValue                   ElementDefinitionMinValue     `json:"-" bson:"min_value,omitempty"`                                                  // Minimum Allowed Value (for some types)
+	MinValueElement            *Element                      `json:"-" bson:"min_value_element,omitempty"`                                          // Extensions for minValue[x]
+	MaxValue                   ElementDefinitionMaxValue     `json:"-" bson:"max_value,omitempty"`                                                  // Maximum Allowed Value (for some types)
+	MaxValueElement            *Element                      `json:"-" bson:"max_value_element,omitempty"`                                          // Extensions for maxValue[x]
+	MaxLength                  *int                          `json:"maxLength,omitempty" bson:"max_length,omitempty"`                               // Max length for string type data
+	MaxLengthElement           *Element                      `json:"_maxLength,omitempty" bson:"max_length_element,omitempty"`                      // Extensions for maxLength
+	Condition                  []string                      `json:"condition,omitempty" bson:"condition,omitempty"`                                // Reference to invariant about presence
+	ConditionElement           []*Element                    `json:"_condition,omitempty" bson:"condition_element,omitempty"`                       // Extensions for condition
+	Constraint                 []ElementDefinitionConstraint `json:"constraint,omitempty" bson:"constraint,omitempty"`                              // Condition that must evaluate to true
+	MustHaveValue              *bool                         `json:"mustHaveValue,omitempty" bson:"must_have_value,omitempty"`                      // For primitives, that a value must be present - not replaced by an extension
+	MustHaveValueElement       *Element                      `json:"_mustHaveValue,omitempty" bson:"must_have_value_element,omitempty"`             // Extensions for mustHaveValue
+	ValueAlternatives          []string                      `json:"valueAlternatives,omitempty" bson:"value_alternatives,omitempty"`               // Extensions that are allowed to replace a primitive value
+	ValueAlternativesElement   []*Element                    `json:"_valueAlternatives,omitempty" bson:"value_alternatives_element,omitempty"`      // Extensions for valueAlternatives
+	MustSupport                *bool                         `json:"mustSupport,omitempty" bson:"must_support,omitempty"`                           // If the element must be supported (discouraged - see obligations)
+	MustSupportElement         *Element                      `json:"_mustSupport,omitempty" bson:"must_support_element,omitempty"`                  // Extensions for mustSupport
+	IsModifier                 *bool                         `json:"isModifier,omitempty" bson:"is_modifier,omitempty"`                             // If this modifies the meaning of other elements
+	IsModifierElement          *Element                      `json:"_isModifier,omitempty" bson:"is_modifier_element,omitempty"`                    // Extensions for isModifier
+	IsModifierReason           *string                       `json:"isModifierReason,omitempty" bson:"is_modifier_reason,omitempty"`                // Reason that this element is marked as a modifier
+	IsModifierReasonElement    *Element                      `json:"_isModifierReason,omitempty" bson:"is_modifier_reason_element,omitempty"`       // Extensions for isModifierReason
+	IsSummary                  *bool                         `json:"isSummary,omitempty" bson:"is_summary,omitempty"`                               // Include when _summary = true?
+	IsSummaryElement           *Element                      `json:"_isSummary,omitempty" bson:"is_summary_element,omitempty"`                      // Extensions for isSummary
+	Binding                    *ElementDefinitionBinding     `json:"binding,omitempty" bson:"binding,omitempty"`                                    // ValueSet details if this is coded
+	Mapping                    []ElementDefinitionMapping    `json:"mapping,omitempty" bson:"mapping,omitempty"`                                    // Map element to another set of definitions
+
+	defaultValueVariants []string // JSON properties of ElementDefinition.defaultValue[x] when more than one was decoded
+	fixedVariants        []string // JSON properties of ElementDefinition.fixed[x] when more than one was decoded
+	patternVariants      []string // JSON properties of ElementDefinition.pattern[x] when more than one was decoded
+	minValueVariants     []string // JSON properties of ElementDefinition.minValue[x] when more than one was decoded
+	maxValueVariants     []string // JSON properties of ElementDefinition.maxValue[x] when more than one was decoded
 }
 
 func (r *ElementDefinition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Path == emptyString {
 		return fmt.Errorf("field 'Path' is required")
 	}
-	for i, item := range r.Code {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Code[%d]: %w", i, err)
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
 		}
 	}
-	if r.Slicing != nil {
-		if err := r.Slicing.Validate(); err != nil {
-			return fmt.Errorf("Slicing: %w", err)
+	for i, item := range r.Representation {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Representation[%d]' has invalid code '%s'", i, item)
 		}
 	}
-	if r.Base != nil {
-		if err := r.Base.Validate(); err != nil {
-			return fmt.Errorf("Base: %w", err)
+	for i, item := range r.RepresentationElement {
+		if item == nil {
+			continue
 		}
-	}
-	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
-		}
-	}
-	if r.DefaultValueUuid != nil {
-		if err := r.DefaultValueUuid.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueUuid: %w", err)
+			return fmt.Errorf("RepresentationElement[%d]: %w", i, err)
 		}
 	}
-	if r.DefaultValueAddress != nil {
-		if err := r.DefaultValueAddress.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueAddress: %w", err)
+	if r.SliceNameElement != nil {
+		if err := r.SliceNameElement.Validate(); err != nil {
+			return fmt.Errorf("SliceNameElement: %w", err)
 		}
 	}
-	if r.DefaultValueAge != nil {
-		if err := r.DefaultValueAge.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueAge: %w", err)
+	if r.SliceIsConstrainingElement != nil {
+		if err := r.SliceIsConstrainingElement.Validate(); err != nil {
+			return fmt.Errorf("SliceIsConstrainingElement: %w", err)
 		}
 	}
-	if r.DefaultValueAnnotation != nil {
-		if err := r.DefaultValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueAnnotation: %w", err)
+	if r.LabelElement != nil {
+		if err := r.LabelElement.Validate(); err != nil {
+			return fmt.Errorf("LabelElement: %w", err)
 		}
 	}
-	if r.DefaultValueAttachment != nil {
-		if err := r.DefaultValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueAttachment: %w", err)
+	for i, item := range r.Code {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Code[%d]: %w", i, err)
 		}
 	}
-	if r.DefaultValueCodeableConcept != nil {
-		if err := r.DefaultValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueCodeableConcept: %w", err)
+	if r.Slicing != nil {
+		if err := r.Slicing.Validate(); err != nil {
+			return fmt.Errorf("Slicing: %w", err)
 		}
 	}
-	if r.DefaultValueCodeableReference != nil {
-		if err := r.DefaultValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueCodeableReference: %w", err)
+	if r.ShortElement != nil {
+		if err := r.ShortElement.Validate(); err != nil {
+			return fmt.Errorf("ShortElement: %w", err)
 		}
 	}
-	if r.DefaultValueCoding != nil {
-		if err := r.DefaultValueCoding.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueCoding: %w", err)
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
 		}
 	}
-	if r.DefaultValueContactPoint != nil {
-		if err := r.DefaultValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueContactPoint: %w", err)
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
 		}
 	}
-	if r.DefaultValueCount != nil {
-		if err := r.DefaultValueCount.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueCount: %w", err)
+	if r.RequirementsElement != nil {
+		if err := r.RequirementsElement.Validate(); err != nil {
+			return fmt.Errorf("RequirementsElement: %w", err)
 		}
 	}
-	if r.DefaultValueDistance != nil {
-		if err := r.DefaultValueDistance.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueDistance: %w", err)
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
 		}
-	}
-	if r.DefaultValueDuration != nil {
-		if err := r.DefaultValueDuration.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueDuration: %w", err)
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AliasElement[%d]: %w", i, err)
 		}
 	}
-	if r.DefaultValueHumanName != nil {
-		if err := r.DefaultValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueHumanName: %w", err)
+	if r.MinElement != nil {
+		if err := r.MinElement.Validate(); err != nil {
+			return fmt.Errorf("MinElement: %w", err)
 		}
 	}
-	if r.DefaultValueIdentifier != nil {
-		if err := r.DefaultValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueIdentifier: %w", err)
+	if r.MaxElement != nil {
+		if err := r.MaxElement.Validate(); err != nil {
+			return fmt.Errorf("MaxElement: %w", err)
 		}
 	}
-	if r.DefaultValueMoney != nil {
-		if err := r.DefaultValueMoney.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueMoney: %w", err)
+	if r.Base != nil {
+		if err := r.Base.Validate(); err != nil {
+			return fmt.Errorf("Base: %w", err)
 		}
 	}
-	if r.DefaultValuePeriod != nil {
-		if err := r.DefaultValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("DefaultValuePeriod: %w", err)
+	if r.ContentReferenceElement != nil {
+		if err := r.ContentReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ContentReferenceElement: %w", err)
 		}
 	}
-	if r.DefaultValueQuantity != nil {
-		if err := r.DefaultValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueQuantity: %w", err)
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
 		}
 	}
-	if r.DefaultValueRange != nil {
-		if err := r.DefaultValueRange.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueRange: %w", err)
-		}
+	if len(r.defaultValueVariants) > 1 {
+		return fmt.Errorf("field 'DefaultValue' must have a single type, got %v", r.defaultValueVariants)
 	}
-	if r.DefaultValueRatio != nil {
-		if err := r.DefaultValueRatio.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueRatio: %w", err)
+	if r.DefaultValue != nil {
+		if err := r.DefaultValue.Validate(); err != nil {
+			return fmt.Errorf("DefaultValue: %w", err)
 		}
 	}
-	if r.DefaultValueRatioRange != nil {
-		if err := r.DefaultValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueRatioRange: %w", err)
+	if r.DefaultValueElement != nil {
+		if err := r.DefaultValueElement.Validate(); err != nil {
+			return fmt.Errorf("DefaultValueElement: %w", err)
 		}
 	}
-	if r.DefaultValueReference != nil {
-		if err := r.DefaultValueReference.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueReference: %w", err)
+	if r.MeaningWhenMissingElement != nil {
+		if err := r.MeaningWhenMissingElement.Validate(); err != nil {
+			return fmt.Errorf("MeaningWhenMissingElement: %w", err)
 		}
 	}
-	if r.DefaultValueSampledData != nil {
-		if err := r.DefaultValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueSampledData: %w", err)
+	if r.OrderMeaningElement != nil {
+		if err := r.OrderMeaningElement.Validate(); err != nil {
+			return fmt.Errorf("OrderMeaningElement: %w", err)
 		}
 	}
-	if r.DefaultValueSignature != nil {
-		if err := r.DefaultValueSignature.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueSignature: %w", err)
-		}
+	if len(r.fixedVariants) > 1 {
+		return fmt.Errorf("field 'Fixed' must have a single type, got %v", r.fixedVariants)
 	}
-	if r.DefaultValueTiming != nil {
-		if err := r.DefaultValueTiming.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueTiming: %w", err)
+	if r.Fixed != nil {
+		if err := r.Fixed.Validate(); err != nil {
+			return fmt.Errorf("Fixed: %w", err)
 		}
 	}
-	if r.DefaultValueContactDetail != nil {
-		if err := r.DefaultValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueContactDetail: %w", err)
+	if r.FixedElement != nil {
+		if err := r.FixedElement.Validate(); err != nil {
+			return fmt.Errorf("FixedElement: %w", err)
 		}
 	}
-	if r.DefaultValueDataRequirement != nil {
-		if err := r.DefaultValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueDataRequirement: %w", err)
-		}
+	if len(r.patternVariants) > 1 {
+		return fmt.Errorf("field 'Pattern' must have a single type, got %v", r.patternVariants)
 	}
-	if r.DefaultValueExpression != nil {
-		if err := r.DefaultValueExpression.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueExpression: %w", err)
+	if r.Pattern != nil {
+		if err := r.Pattern.Validate(); err != nil {
+			return fmt.Errorf("Pattern: %w", err)
 		}
 	}
-	if r.DefaultValueParameterDefinition != nil {
-		if err := r.DefaultValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueParameterDefinition: %w", err)
+	if r.PatternElement != nil {
+		if err := r.PatternElement.Validate(); err != nil {
+			return fmt.Errorf("PatternElement: %w", err)
 		}
 	}
-	if r.DefaultValueRelatedArtifact != nil {
-		if err := r.DefaultValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueRelatedArtifact: %w", err)
+	for i, item := range r.Example {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Example[%d]: %w", i, err)
 		}
 	}
-	if r.DefaultValueTriggerDefinition != nil {
-		if err := r.DefaultValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueTriggerDefinition: %w", err)
-		}
+	if len(r.minValueVariants) > 1 {
+		return fmt.Errorf("field 'MinValue' must have a single type, got %v", r.minValueVariants)
 	}
-	if r.DefaultValueUsageContext != nil {
-		if err := r.DefaultValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueUsageContext: %w", err)
+	if r.MinValue != nil {
+		if err := r.MinValue.Validate(); err != nil {
+			return fmt.Errorf("MinValue: %w", err)
 		}
 	}
-	if r.DefaultValueAvailability != nil {
-		if err := r.DefaultValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueAvailability: %w", err)
+	if r.MinValueElement != nil {
+		if err := r.MinValueElement.Validate(); err != nil {
+			return fmt.Errorf("MinValueElement: %w", err)
 		}
 	}
-	if r.DefaultValueExtendedContactDetail != nil {
-		if err := r.DefaultValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueExtendedContactDetail: %w", err)
-		}
+	if len(r.maxValueVariants) > 1 {
+		return fmt.Errorf("field 'MaxValue' must have a single type, got %v", r.maxValueVariants)
 	}
-	if r.DefaultValueVirtualServiceDetail != nil {
-		if err := r.DefaultValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueVirtualServiceDetail: %w", err)
+	if r.MaxValue != nil {
+		if err := r.MaxValue.Validate(); err != nil {
+			return fmt.Errorf("MaxValue: %w", err)
 		}
 	}
-	if r.DefaultValueDosage != nil {
-		if err := r.DefaultValueDosage.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueDosage: %w", err)
+	if r.MaxValueElement != nil {
+		if err := r.MaxValueElement.Validate(); err != nil {
+			return fmt.Errorf("MaxValueElement: %w", err)
 		}
 	}
-	if r.DefaultValueMeta != nil {
-		if err := r.DefaultValueMeta.Validate(); err != nil {
-			return fmt.Errorf("DefaultValueMeta: %w", err)
+	if r.MaxLengthElement != nil {
+		if err := r.MaxLengthElement.Validate(); err != nil {
+			return fmt.Errorf("MaxLengthElement: %w", err)
 		}
 	}
-	if r.FixedUuid != nil {
-		if err := r.FixedUuid.Validate(); err != nil {
-			return fmt.Errorf("FixedUuid: %w", err)
+	for i, item := range r.ConditionElement {
+		if item == nil {
+			continue
 		}
-	}
-	if r.FixedAddress != nil {
-		if err := r.FixedAddress.Validate(); err != nil {
-			return fmt.Errorf("FixedAddress: %w", err)
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ConditionElement[%d]: %w", i, err)
 		}
 	}
-	if r.FixedAge != nil {
-		if err := r.FixedAge.Validate(); err != nil {
-			return fmt.Errorf("FixedAge: %w", err)
+	for i, item := range r.Constraint {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Constraint[%d]: %w", i, err)
 		}
 	}
-	if r.FixedAnnotation != nil {
-		if err := r.FixedAnnotation.Validate(); err != nil {
-			return fmt.Errorf("FixedAnnotation: %w", err)
+	if r.MustHaveValueElement != nil {
+		if err := r.MustHaveValueElement.Validate(); err != nil {
+			return fmt.Errorf("MustHaveValueElement: %w", err)
 		}
 	}
-	if r.FixedAttachment != nil {
-		if err := r.FixedAttachment.Validate(); err != nil {
-			return fmt.Errorf("FixedAttachment: %w", err)
+	for i, item := range r.ValueAlternativesElement {
+		if item == nil {
+			continue
 		}
-	}
-	if r.FixedCodeableConcept != nil {
-		if err := r.FixedCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("FixedCodeableConcept: %w", err)
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ValueAlternativesElement[%d]: %w", i, err)
 		}
 	}
-	if r.FixedCodeableReference != nil {
-		if err := r.FixedCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("FixedCodeableReference: %w", err)
+	if r.MustSupportElement != nil {
+		if err := r.MustSupportElement.Validate(); err != nil {
+			return fmt.Errorf("MustSupportElement: %w", err)
 		}
 	}
-	if r.FixedCoding != nil {
-		if err := r.FixedCoding.Validate(); err != nil {
-			return fmt.Errorf("FixedCoding: %w", err)
+	if r.IsModifierElement != nil {
+		if err := r.IsModifierElement.Validate(); err != nil {
+			return fmt.Errorf("IsModifierElement: %w", err)
 		}
 	}
-	if r.FixedContactPoint != nil {
-		if err := r.FixedContactPoint.Validate(); err != nil {
-			return fmt.Errorf("FixedContactPoint: %w", err)
+	if r.IsModifierReasonElement != nil {
+		if err := r.IsModifierReasonElement.Validate(); err != nil {
+			return fmt.Errorf("IsModifierReasonElement: %w", err)
 		}
 	}
-	if r.FixedCount != nil {
-		if err := r.FixedCount.Validate(); err != nil {
-			return fmt.Errorf("FixedCount: %w", err)
+	if r.IsSummaryElement != nil {
+		if err := r.IsSummaryElement.Validate(); err != nil {
+			return fmt.Errorf("IsSummaryElement: %w", err)
 		}
 	}
-	if r.FixedDistance != nil {
-		if err := r.FixedDistance.Validate(); err != nil {
-			return fmt.Errorf("FixedDistance: %w", err)
+	if r.Binding != nil {
+		if err := r.Binding.Validate(); err != nil {
+			return fmt.Errorf("Binding: %w", err)
 		}
 	}
-	if r.FixedDuration != nil {
-		if err := r.FixedDuration.Validate(); err != nil {
-			return fmt.Errorf("FixedDuration: %w", err)
+	for i, item := range r.Mapping {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Mapping[%d]: %w", i, err)
 		}
 	}
-	if r.FixedHumanName != nil {
-		if err := r.FixedHumanName.Validate(); err != nil {
-			return fmt.Errorf("FixedHumanName: %w", err)
-		}
+	return nil
+}
+
+func (r *ElementDefinition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.FixedIdentifier != nil {
-		if err := r.FixedIdentifier.Validate(); err != nil {
-			return fmt.Errorf("FixedIdentifier: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.FixedMoney != nil {
-		if err := r.FixedMoney.Validate(); err != nil {
-			return fmt.Errorf("FixedMoney: %w", err)
-		}
+	var emptyString string
+	if r.Path == emptyString {
+		issues.add("required", path+".path", "field 'Path' is required")
 	}
-	if r.FixedPeriod != nil {
-		if err := r.FixedPeriod.Validate(); err != nil {
-			return fmt.Errorf("FixedPeriod: %w", err)
-		}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
 	}
-	if r.FixedQuantity != nil {
-		if err := r.FixedQuantity.Validate(); err != nil {
-			return fmt.Errorf("FixedQuantity: %w", err)
+	for i, item := range r.Representation {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.representation[%d]", path, i), fmt.Sprintf("field 'Representation[%d]' has invalid code '%s'", i, item))
 		}
 	}
-	if r.FixedRange != nil {
-		if err := r.FixedRange.Validate(); err != nil {
-			return fmt.Errorf("FixedRange: %w", err)
+	for i, item := range r.RepresentationElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.representation[%d]", path, i), issues)
 	}
-	if r.FixedRatio != nil {
-		if err := r.FixedRatio.Validate(); err != nil {
-			return fmt.Errorf("FixedRatio: %w", err)
-		}
+	if r.SliceNameElement != nil {
+		r.SliceNameElement.validateAll(path+".sliceName", issues)
 	}
-	if r.FixedRatioRange != nil {
-		if err := r.FixedRatioRange.Validate(); err != nil {
-			return fmt.Errorf("FixedRatioRange: %w", err)
-		}
+	if r.SliceIsConstrainingElement != nil {
+		r.SliceIsConstrainingElement.validateAll(path+".sliceIsConstraining", issues)
 	}
-	if r.FixedReference != nil {
-		if err := r.FixedReference.Validate(); err != nil {
-			return fmt.Errorf("FixedReference: %w", err)
-		}
+	if r.LabelElement != nil {
+		r.LabelElement.validateAll(path+".label", issues)
 	}
-	if r.FixedSampledData != nil {
-		if err := r.FixedSampledData.Validate(); err != nil {
-			return fmt.Errorf("FixedSampledData: %w", err)
-		}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
 	}
-	if r.FixedSignature != nil {
-		if err := r.FixedSignature.Validate(); err != nil {
-			return fmt.Errorf("FixedSignature: %w", err)
-		}
+	if r.Slicing != nil {
+		r.Slicing.validateAll(path+".slicing", issues)
 	}
-	if r.FixedTiming != nil {
-		if err := r.FixedTiming.Validate(); err != nil {
-			return fmt.Errorf("FixedTiming: %w", err)
-		}
+	if r.ShortElement != nil {
+		r.ShortElement.validateAll(path+".short", issues)
 	}
-	if r.FixedContactDetail != nil {
-		if err := r.FixedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("FixedContactDetail: %w", err)
-		}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
 	}
-	if r.FixedDataRequirement != nil {
-		if err := r.FixedDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("FixedDataRequirement: %w", err)
-		}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
 	}
-	if r.FixedExpression != nil {
-		if err := r.FixedExpression.Validate(); err != nil {
-			return fmt.Errorf("FixedExpression: %w", err)
-		}
+	if r.RequirementsElement != nil {
+		r.RequirementsElement.validateAll(path+".requirements", issues)
 	}
-	if r.FixedParameterDefinition != nil {
-		if err := r.FixedParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("FixedParameterDefinition: %w", err)
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.alias[%d]", path, i), issues)
 	}
-	if r.FixedRelatedArtifact != nil {
-		if err := r.FixedRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("FixedRelatedArtifact: %w", err)
-		}
+	if r.MinElement != nil {
+		r.MinElement.validateAll(path+".min", issues)
 	}
-	if r.FixedTriggerDefinition != nil {
-		if err := r.FixedTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("FixedTriggerDefinition: %w", err)
-		}
+	if r.MaxElement != nil {
+		r.MaxElement.validateAll(path+".max", issues)
 	}
-	if r.FixedUsageContext != nil {
-		if err := r.FixedUsageContext.Validate(); err != nil {
-			return fmt.Errorf("FixedUsageContext: %w", err)
-		}
+	if r.Base != nil {
+		r.Base.validateAll(path+".base", issues)
 	}
-	if r.FixedAvailability != nil {
-		if err := r.FixedAvailability.Validate(); err != nil {
-			return fmt.Errorf("FixedAvailability: %w", err)
-		}
+	if r.ContentReferenceElement != nil {
+		r.ContentReferenceElement.validateAll(path+".contentReference", issues)
 	}
-	if r.FixedExtendedContactDetail != nil {
-		if err := r.FixedExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("FixedExtendedContactDetail: %w", err)
-		}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
 	}
-	if r.FixedVirtualServiceDetail != nil {
-		if err := r.FixedVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("FixedVirtualServiceDetail: %w", err)
-		}
+	if len(r.defaultValueVariants) > 1 {
+		issues.add("structure", path+".defaultValue", fmt.Sprintf("field 'DefaultValue' must have a single type, got %v", r.defaultValueVariants))
 	}
-	if r.FixedDosage != nil {
-		if err := r.FixedDosage.Validate(); err != nil {
-			return fmt.Errorf("FixedDosage: %w", err)
-		}
+	if r.DefaultValue != nil {
+		r.DefaultValue.validateAll(path+".defaultValue.ofType("+r.DefaultValue.FHIRType()+")", issues)
 	}
-	if r.FixedMeta != nil {
-		if err := r.FixedMeta.Validate(); err != nil {
-			return fmt.Errorf("FixedMeta: %w", err)
-		}
+	if r.DefaultValueElement != nil {
+		r.DefaultValueElement.validateAll(path+".defaultValue", issues)
 	}
-	if r.PatternUuid != nil {
-		if err := r.PatternUuid.Validate(); err != nil {
-			return fmt.Errorf("PatternUuid: %w", err)
-		}
+	if r.MeaningWhenMissingElement != nil {
+		r.MeaningWhenMissingElement.validateAll(path+".meaningWhenMissing", issues)
 	}
-	if r.PatternAddress != nil {
-		if err := r.PatternAddress.Validate(); err != nil {
-			return fmt.Errorf("PatternAddress: %w", err)
-		}
+	if r.OrderMeaningElement != nil {
+		r.OrderMeaningElement.validateAll(path+".orderMeaning", issues)
 	}
-	if r.PatternAge != nil {
-		if err := r.PatternAge.Validate(); err != nil {
-			return fmt.Errorf("PatternAge: %w", err)
-		}
+	if len(r.fixedVariants) > 1 {
+		issues.add("structure", path+".fixed", fmt.Sprintf("field 'Fixed' must have a single type, got %v", r.fixedVariants))
 	}
-	if r.PatternAnnotation != nil {
-		if err := r.PatternAnnotation.Validate(); err != nil {
-			return fmt.Errorf("PatternAnnotation: %w", err)
-		}
+	if r.Fixed != nil {
+		r.Fixed.validateAll(path+".fixed.ofType("+r.Fixed.FHIRType()+")", issues)
 	}
-	if r.PatternAttachment != nil {
-		if err := r.PatternAttachment.Validate(); err != nil {
-			return fmt.Errorf("PatternAttachment: %w", err)
-		}
+	if r.FixedElement != nil {
+		r.FixedElement.validateAll(path+".fixed", issues)
 	}
-	if r.PatternCodeableConcept != nil {
-		if err := r.PatternCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("PatternCodeableConcept: %w", err)
-		}
+	if len(r.patternVariants) > 1 {
+		issues.add("structure", path+".pattern", fmt.Sprintf("field 'Pattern' must have a single type, got %v", r.patternVariants))
 	}
-	if r.PatternCodeableReference != nil {
-		if err := r.PatternCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("PatternCodeableReference: %w", err)
-		}
+	if r.Pattern != nil {
+		r.Pattern.validateAll(path+".pattern.ofType("+r.Pattern.FHIRType()+")", issues)
 	}
-	if r.PatternCoding != nil {
-		if err := r.PatternCoding.Validate(); err != nil {
-			return fmt.Errorf("PatternCoding: %w", err)
-		}
+	if r.PatternElement != nil {
+		r.PatternElement.validateAll(path+".pattern", issues)
 	}
-	if r.PatternContactPoint != nil {
-		if err := r.PatternContactPoint.Validate(); err != nil {
-			return fmt.Errorf("PatternContactPoint: %w", err)
-		}
+	for i, item := range r.Example {
+		item.validateAll(fmt.Sprintf("%s.example[%d]", path, i), issues)
 	}
-	if r.PatternCount != nil {
-		if err := r.PatternCount.Validate(); err != nil {
-			return fmt.Errorf("PatternCount: %w", err)
-		}
+	if len(r.minValueVariants) > 1 {
+		issues.add("structure", path+".minValue", fmt.Sprintf("field 'MinValue' must have a single type, got %v", r.minValueVariants))
 	}
-	if r.PatternDistance != nil {
-		if err := r.PatternDistance.Validate(); err != nil {
-			return fmt.Errorf("PatternDistance: %w", err)
-		}
+	if r.MinValue != nil {
+		r.MinValue.validateAll(path+".minValue.ofType("+r.MinValue.FHIRType()+")", issues)
 	}
-	if r.PatternDuration != nil {
-		if err := r.PatternDuration.Validate(); err != nil {
-			return fmt.Errorf("PatternDuration: %w", err)
-		}
+	if r.MinValueElement != nil {
+		r.MinValueElement.validateAll(path+".minValue", issues)
 	}
-	if r.PatternHumanName != nil {
-		if err := r.PatternHumanName.Validate(); err != nil {
-			return fmt.Errorf("PatternHumanName: %w", err)
-		}
+	if len(r.maxValueVariants) > 1 {
+		issues.add("structure", path+".maxValue", fmt.Sprintf("field 'MaxValue' must have a single type, got %v", r.maxValueVariants))
 	}
-	if r.PatternIdentifier != nil {
-		if err := r.PatternIdentifier.Validate(); err != nil {
-			return fmt.Errorf("PatternIdentifier: %w", err)
-		}
+	if r.MaxValue != nil {
+		r.MaxValue.validateAll(path+".maxValue.ofType("+r.MaxValue.FHIRType()+")", issues)
 	}
-	if r.PatternMoney != nil {
-		if err := r.PatternMoney.Validate(); err != nil {
-			return fmt.Errorf("PatternMoney: %w", err)
-		}
+	if r.MaxValueElement != nil {
+		r.MaxValueElement.validateAll(path+".maxValue", issues)
 	}
-	if r.PatternPeriod != nil {
-		if err := r.PatternPeriod.Validate(); err != nil {
-			return fmt.Errorf("PatternPeriod: %w", err)
-		}
+	if r.MaxLengthElement != nil {
+		r.MaxLengthElement.validateAll(path+".maxLength", issues)
 	}
-	if r.PatternQuantity != nil {
-		if err := r.PatternQuantity.Validate(); err != nil {
-			return fmt.Errorf("PatternQuantity: %w", err)
+	for i, item := range r.ConditionElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
 	}
-	if r.PatternRange != nil {
-		if err := r.PatternRange.Validate(); err != nil {
-			return fmt.Errorf("PatternRange: %w", err)
-		}
+	for i, item := range r.Constraint {
+		item.validateAll(fmt.Sprintf("%s.constraint[%d]", path, i), issues)
 	}
-	if r.PatternRatio != nil {
-		if err := r.PatternRatio.Validate(); err != nil {
-			return fmt.Errorf("PatternRatio: %w", err)
-		}
+	if r.MustHaveValueElement != nil {
+		r.MustHaveValueElement.validateAll(path+".mustHaveValue", issues)
 	}
-	if r.PatternRatioRange != nil {
-		if err := r.PatternRatioRange.Validate(); err != nil {
-			return fmt.Errorf("PatternRatioRange: %w", err)
+	for i, item := range r.ValueAlternativesElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.valueAlternatives[%d]", path, i), issues)
 	}
-	if r.PatternReference != nil {
-		if err := r.PatternReference.Validate(); err != nil {
-			return fmt.Errorf("PatternReference: %w", err)
-		}
+	if r.MustSupportElement != nil {
+		r.MustSupportElement.validateAll(path+".mustSupport", issues)
 	}
-	if r.PatternSampledData != nil {
-		if err := r.PatternSampledData.Validate(); err != nil {
-			return fmt.Errorf("PatternSampledData: %w", err)
-		}
+	if r.IsModifierElement != nil {
+		r.IsModifierElement.validateAll(path+".isModifier", issues)
 	}
-	if r.PatternSignature != nil {
-		if err := r.PatternSignature.Validate(); err != nil {
-			return fmt.Errorf("PatternSignature: %w", err)
-		}
+	if r.IsModifierReasonElement != nil {
+		r.IsModifierReasonElement.validateAll(path+".isModifierReason", issues)
 	}
-	if r.PatternTiming != nil {
-		if err := r.PatternTiming.Validate(); err != nil {
-			return fmt.Errorf("PatternTiming: %w", err)
-		}
+	if r.IsSummaryElement != nil {
+		r.IsSummaryElement.validateAll(path+".isSummary", issues)
 	}
-	if r.PatternContactDetail != nil {
-		if err := r.PatternContactDetail.Validate(); err != nil {
-			return fmt.Errorf("PatternContactDetail: %w", err)
-		}
+	if r.Binding != nil {
+		r.Binding.validateAll(path+".binding", issues)
 	}
-	if r.PatternDataRequirement != nil {
-		if err := r.PatternDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("PatternDataRequirement: %w", err)
-		}
+	for i, item := range r.Mapping {
+		item.validateAll(fmt.Sprintf("%s.mapping[%d]", path, i), issues)
 	}
-	if r.PatternExpression != nil {
-		if err := r.PatternExpression.Validate(); err != nil {
-			return fmt.Errorf("PatternExpression: %w", err)
-		}
+}
+
+func (r *ElementDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ElementDefinition", &issues)
+	checkInvariants(r, "ElementDefinition", &issues)
+	return issues
+}
+
+var elementDefinitionInvariants = []invariant{
+	{key: "eld-2", severity: "error", human: "Min <= Max", expression: "min.empty() or max.empty() or (max = '*') or iif(max != '*', min <= max.toInteger())"},
+	{key: "eld-5", severity: "error", human: "if the element definition has a contentReference, it cannot have type, defaultValue, fixed, pattern, example, minValue, maxValue, maxLength, or binding", expression: "contentReference.empty() or (type.empty() and defaultValue.empty() and fixed.empty() and pattern.empty() and example.empty() and minValue.empty() and maxValue.empty() and maxLength.empty() and binding.empty())"},
+	{key: "eld-6", severity: "error", human: "Fixed value may only be specified if there is one type", expression: "fixed.empty() or (type.count()  <= 1)"},
+	{key: "eld-7", severity: "error", human: "Pattern may only be specified if there is one type", expression: "pattern.empty() or (type.count() <= 1)"},
+	{key: "eld-8", severity: "error", human: "Pattern and fixed are mutually exclusive", expression: "pattern.empty() or fixed.empty()"},
+	{key: "eld-11", severity: "error", human: "Binding can only be present for coded elements, string, and uri if using FHIR-defined types", expression: "binding.empty() or type.code.empty() or type.code.contains(':') or type.select((code = 'code') or (code = 'Coding') or (code='CodeableConcept') or (code = 'Quantity') or (code = 'string') or (code = 'uri') or (code = 'Duration')).exists()"},
+	{key: "eld-13", severity: "error", human: "Types must be unique by code", expression: "type.select(code).isDistinct()"},
+	{key: "eld-14", severity: "error", human: "Constraints must be unique by key", expression: "constraint.select(key).isDistinct()"},
+	{key: "eld-15", severity: "error", human: "default value and meaningWhenMissing are mutually exclusive", expression: "defaultValue.empty() or meaningWhenMissing.empty()"},
+	{key: "eld-16", severity: "error", human: "sliceName must be composed of proper tokens separated by \"/\"", expression: "sliceName.empty() or sliceName.matches('^[a-zA-Z0-9\\\\/\\\\-_\\\\[\\\\]\\\\@]+$')"},
+	{key: "eld-18", severity: "error", human: "Must have a modifier reason if isModifier = true", expression: "(isModifier.exists() and isModifier) implies isModifierReason.exists()"},
+	{key: "eld-19", severity: "error", human: "Element path SHALL be expressed as a set of '.'-separated components with each component restricted to a maximum of 64 characters and with some limits on the allowed choice of characters", expression: "path.matches('^[^\\\\s\\\\.,:;\\\\\\'\"\\\\/|?!@#$%&*()\\\\[\\\\]{}]{1,64}(\\\\.[^\\\\s\\\\.,:;\\\\\\'\"\\\\/|?!@#$%&*()\\\\[\\\\]{}]{1,64}(\\\\[x\\\\])?(\\\\:[^\\\\s\\\\.]+)?)*$')"},
+	{key: "eld-20", severity: "warning", human: "The first component of the path should be UpperCamelCase.  Additional components (following a '.') should be lowerCamelCase.  If this syntax is not adhered to, code generation tools may be broken. Logical models may be less concerned about this implication.", expression: "path.matches('^[A-Za-z][A-Za-z0-9]{0,63}(\\\\.[a-z][A-Za-z0-9]{0,63}(\\\\[x])?)*$')"},
+	{key: "eld-22", severity: "error", human: "sliceIsConstraining can only appear if slicename is present", expression: "sliceIsConstraining.exists() implies sliceName.exists()"},
+	{key: "eld-24", severity: "warning", human: "pattern[x] should be used rather than fixed[x]", expression: "fixed.exists().not()"},
+	{key: "eld-25", severity: "warning", human: "Order has no meaning (and cannot be asserted to have meaning), so enforcing rules on order is improper", expression: "orderMeaning.empty() implies slicing.where(rules='openAtEnd' or ordered).exists().not()"},
+	{key: "eld-27", severity: "warning", human: "Mappings SHOULD be unique by key", expression: "mapping.select(identity).isDistinct()"},
+	{key: "eld-28", severity: "error", human: "Can't have valueAlternatives if mustHaveValue is true", expression: "mustHaveValue.value implies valueAlternatives.empty()"},
+	{key: "eld-33", severity: "warning", human: "If there's more than one bindable type, it's usually an error for there to be a binding", expression: "type.where(code in 'code' | 'Coding' | 'CodeableConcept' | 'CodeableReference' | 'uri' | 'string' | 'Quantity').count() > 1 implies binding.empty()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "eld-3", severity: "error", human: "Max SHALL be a number or \"*\"", expression: "empty() or ($this = '*') or (toInteger() >= 0)", context: "max"},
+}
+
+func (r *ElementDefinition) invariants() []invariant {
+	return elementDefinitionInvariants
+}
+
+func (r ElementDefinition) MarshalJSON() ([]byte, error) {
+	type alias ElementDefinition
+	out := struct {
+		alias
+		Representation           []*PropertyRepresentation `json:"representation,omitempty"`
+		RepresentationElement    []*Element                `json:"_representation,omitempty"`
+		Alias                    []*string                 `json:"alias,omitempty"`
+		AliasElement             []*Element                `json:"_alias,omitempty"`
+		Condition                []*string                 `json:"condition,omitempty"`
+		ConditionElement         []*Element                `json:"_condition,omitempty"`
+		ValueAlternatives        []*string                 `json:"valueAlternatives,omitempty"`
+		ValueAlternativesElement []*Element                `json:"_valueAlternatives,omitempty"`
+	}{alias: alias(r)}
+	out.Representation, out.RepresentationElement = alignPrimitiveArray(r.Representation, r.RepresentationElement)
+	out.Alias, out.AliasElement = alignPrimitiveArray(r.Alias, r.AliasElement)
+	out.Condition, out.ConditionElement = alignPrimitiveArray(r.Condition, r.ConditionElement)
+	out.ValueAlternatives, out.ValueAlternativesElement = alignPrimitiveArray(r.ValueAlternatives, r.ValueAlternativesElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "defaultValue", r.DefaultValue, r.DefaultValueElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "fixed", r.Fixed, r.FixedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "pattern", r.Pattern, r.PatternElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "minValue", r.MinValue, r.MinValueElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "maxValue", r.MaxValue, r.MaxValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ElementDefinition) UnmarshalJSON(data []byte) error {
+	type alias ElementDefinition
+	aux := struct {
+		*alias
+		Representation    []*PropertyRepresentation `json:"representation,omitempty"`
+		Alias             []*string                 `json:"alias,omitempty"`
+		Condition         []*string                 `json:"condition,omitempty"`
+		ValueAlternatives []*string                 `json:"valueAlternatives,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.PatternParameterDefinition != nil {
-		if err := r.PatternParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("PatternParameterDefinition: %w", err)
-		}
+	var err error
+	if r.Representation, err = splitPrimitiveArray(aux.Representation, r.RepresentationElement); err != nil {
+		return fmt.Errorf("representation: %w", err)
 	}
-	if r.PatternRelatedArtifact != nil {
-		if err := r.PatternRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("PatternRelatedArtifact: %w", err)
-		}
+	if r.Alias, err = splitPrimitiveArray(aux.Alias, r.AliasElement); err != nil {
+		return fmt.Errorf("alias: %w", err)
 	}
-	if r.PatternTriggerDefinition != nil {
-		if err := r.PatternTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("PatternTriggerDefinition: %w", err)
-		}
+	if r.Condition, err = splitPrimitiveArray(aux.Condition, r.ConditionElement); err != nil {
+		return fmt.Errorf("condition: %w", err)
 	}
-	if r.PatternUsageContext != nil {
-		if err := r.PatternUsageContext.Validate(); err != nil {
-			return fmt.Errorf("PatternUsageContext: %w", err)
-		}
+	if r.ValueAlternatives, err = splitPrimitiveArray(aux.ValueAlternatives, r.ValueAlternativesElement); err != nil {
+		return fmt.Errorf("valueAlternatives: %w", err)
 	}
-	if r.PatternAvailability != nil {
-		if err := r.PatternAvailability.Validate(); err != nil {
-			return fmt.Errorf("PatternAvailability: %w", err)
-		}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.PatternExtendedContactDetail != nil {
-		if err := r.PatternExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("PatternExtendedContactDetail: %w", err)
-		}
+	var element json.RawMessage
+	if r.DefaultValue, element, r.defaultValueVariants, err = unmarshalChoice(fields, "defaultValue", elementDefinitionDefaultValueVariants); err != nil {
+		return err
 	}
-	if r.PatternVirtualServiceDetail != nil {
-		if err := r.PatternVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("PatternVirtualServiceDetail: %w", err)
+	r.DefaultValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DefaultValueElement); err != nil {
+			return fmt.Errorf("_defaultValue: %w", err)
 		}
 	}
-	if r.PatternDosage != nil {
-		if err := r.PatternDosage.Validate(); err != nil {
-			return fmt.Errorf("PatternDosage: %w", err)
-		}
+	if r.Fixed, element, r.fixedVariants, err = unmarshalChoice(fields, "fixed", elementDefinitionFixedVariants); err != nil {
+		return err
 	}
-	if r.PatternMeta != nil {
-		if err := r.PatternMeta.Validate(); err != nil {
-			return fmt.Errorf("PatternMeta: %w", err)
+	r.FixedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.FixedElement); err != nil {
+			return fmt.Errorf("_fixed: %w", err)
 		}
 	}
-	for i, item := range r.Example {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Example[%d]: %w", i, err)
-		}
+	if r.Pattern, element, r.patternVariants, err = unmarshalChoice(fields, "pattern", elementDefinitionPatternVariants); err != nil {
+		return err
 	}
-	if r.MinValueQuantity != nil {
-		if err := r.MinValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("MinValueQuantity: %w", err)
+	r.PatternElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.PatternElement); err != nil {
+			return fmt.Errorf("_pattern: %w", err)
 		}
 	}
-	if r.MaxValueQuantity != nil {
-		if err := r.MaxValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("MaxValueQuantity: %w", err)
-		}
+	if r.MinValue, element, r.minValueVariants, err = unmarshalChoice(fields, "minValue", elementDefinitionMinValueVariants); err != nil {
+		return err
 	}
-	for i, item := range r.Constraint {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Constraint[%d]: %w", i, err)
+	r.MinValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.MinValueElement); err != nil {
+			return fmt.Errorf("_minValue: %w", err)
 		}
 	}
-	if r.Binding != nil {
-		if err := r.Binding.Validate(); err != nil {
-			return fmt.Errorf("Binding: %w", err)
-		}
+	if r.MaxValue, element, r.maxValueVariants, err = unmarshalChoice(fields, "maxValue", elementDefinitionMaxValueVariants); err != nil {
+		return err
 	}
-	for i, item := range r.Mapping {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Mapping[%d]: %w", i, err)
+	r.MaxValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.MaxValueElement); err != nil {
+			return fmt.Errorf("_maxValue: %w", err)
 		}
 	}
 	return nil
 }
 
-type ElementDefinitionSlicing struct {
-	Id            *string                                 `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Discriminator []ElementDefinitionSlicingDiscriminator `json:"discriminator,omitempty" bson:"discriminator,omitempty"` // Element values that are used to distinguish the slices
-	Description   *string                                 `json:"description,omitempty" bson:"description,omitempty"`     // Text description of how slicing works (or not)
-	Ordered       *bool                                   `json:"ordered,omitempty" bson:"ordered,omitempty"`             // If elements must be in same order as slices
-	Rules         string                                  `json:"rules" bson:"rules"`                                     // closed | open | openAtEnd
+func (r ElementDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ElementDefinitionSlicing) Validate() error {
-	for i, item := range r.Discriminator {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Discriminator[%d]: %w", i, err)
-		}
-	}
-	var emptyString string
-	if r.Rules == emptyString {
-		return fmt.Errorf("field 'Rules' is required")
-	}
-	return nil
+func (r *ElementDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type ElementDefinitionBase struct {
-	Id   *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Path string  `json:"path" bson:"path"`                 // Path that identifies the base element
-	Min  int     `json:"min" bson:"min"`                   // Min cardinality of the base element
-	Max  string  `json:"max" bson:"max"`                   // Max cardinality of the base element
+func (r ElementDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *ElementDefinitionBase) Validate() error {
-	var emptyString string
-	if r.Path == emptyString {
-		return fmt.Errorf("field 'Path' is required")
-	}
-	if r.Min == 0 {
-		return fmt.Errorf("field 'Min' is required")
-	}
-	if r.Max == emptyString {
-		return fmt.Errorf("field 'Max' is required")
-	}
-	return nil
+func (r *ElementDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
 }
 
-type ElementDefinitionConstraint struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Key          string  `json:"key" bson:"key"`                                       // Target of 'condition' reference above
-	Requirements *string `json:"requirements,omitempty" bson:"requirements,omitempty"` // Why this constraint is necessary or appropriate
-	Severity     string  `json:"severity" bson:"severity"`                             // error | warning
-	Suppress     *bool   `json:"suppress,omitempty" bson:"suppress,omitempty"`         // Suppress warning or hint in profile
-	Human        string  `json:"human" bson:"human"`                                   // Human description of constraint
-	Expression   *string `json:"expression,omitempty" bson:"expression,omitempty"`     // FHIRPath expression of constraint
-	Source       *string `json:"source,omitempty" bson:"source,omitempty"`             // Reference to original source of constraint
+func (r *ElementDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "DefaultValue":
+		return "defaultValue", choiceValues(elementDefinitionDefaultValueVariants)
+	case "Fixed":
+		return "fixed", choiceValues(elementDefinitionFixedVariants)
+	case "Pattern":
+		return "pattern", choiceValues(elementDefinitionPatternVariants)
+	case "MinValue":
+		return "minValue", choiceValues(elementDefinitionMinValueVariants)
+	case "MaxValue":
+		return "maxValue", choiceValues(elementDefinitionMaxValueVariants)
+	}
+	return "", nil
 }
 
-func (r *ElementDefinitionConstraint) Validate() error {
-	var emptyString string
-	if r.Key == emptyString {
-		return fmt.Errorf("field 'Key' is required")
-	}
-	if r.Severity == emptyString {
-		return fmt.Errorf("field 'Severity' is required")
-	}
-	if r.Human == emptyString {
-		return fmt.Errorf("field 'Human' is required")
-	}
+var elementDefinitionElementTypes = map[string]string{
+	"definition":         "markdown",
+	"comment":            "markdown",
+	"requirements":       "markdown",
+	"min":                "unsignedInt",
+	"contentReference":   "uri",
+	"meaningWhenMissing": "markdown",
+	"condition":          "id",
+	"valueAlternatives":  "canonical",
+}
+
+func (r *ElementDefinition) elementTypes() map[string]string {
+	return elementDefinitionElementTypes
+}
+
+var elementDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ElementDefinition.path", Name: "path", Field: 3, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.representation", Name: "representation", Field: 5, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.representation", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/property-representation", Description: "How a property is represented when serialized."}, Summary: true},
+	{Path: "ElementDefinition.sliceName", Name: "sliceName", Field: 7, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.sliceIsConstraining", Name: "sliceIsConstraining", Field: 9, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.label", Name: "label", Field: 11, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.code", Name: "code", Field: 13, Min: 0, Max: "*", Types: []string{"Coding"}, Binding: &BindingDefinition{Path: "ElementDefinition.code", Strength: "example", ValueSet: "http://loinc.org/vs", Description: "Codes that indicate the meaning of a data element."}, Summary: true},
+	{Path: "ElementDefinition.slicing", Name: "slicing", Field: 14, Min: 0, Max: "1", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.short", Name: "short", Field: 15, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.definition", Name: "definition", Field: 17, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.comment", Name: "comment", Field: 19, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.requirements", Name: "requirements", Field: 21, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.alias", Name: "alias", Field: 23, Min: 0, Max: "*", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.min", Name: "min", Field: 25, Min: 0, Max: "1", Types: []string{"unsignedInt"}, Summary: true},
+	{Path: "ElementDefinition.max", Name: "max", Field: 27, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.base", Name: "base", Field: 29, Min: 0, Max: "1", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.contentReference", Name: "contentReference", Field: 30, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "ElementDefinition.type", Name: "type", Field: 32, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.defaultValue[x]", Name: "defaultValue", Field: 33, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}, Summary: true},
+	{Path: "ElementDefinition.meaningWhenMissing", Name: "meaningWhenMissing", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.orderMeaning", Name: "orderMeaning", Field: 37, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.fixed[x]", Name: "fixed", Field: 39, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}, Summary: true},
+	{Path: "ElementDefinition.pattern[x]", Name: "pattern", Field: 41, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}, Summary: true},
+	{Path: "ElementDefinition.example", Name: "example", Field: 43, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.minValue[x]", Name: "minValue", Field: 44, Min: 0, Max: "1", Types: []string{"date", "dateTime", "instant", "time", "decimal", "integer", "integer64", "positiveInt", "unsignedInt", "Quantity"}, Summary: true},
+	{Path: "ElementDefinition.maxValue[x]", Name: "maxValue", Field: 46, Min: 0, Max: "1", Types: []string{"date", "dateTime", "instant", "time", "decimal", "integer", "integer64", "positiveInt", "unsignedInt", "Quantity"}, Summary: true},
+	{Path: "ElementDefinition.maxLength", Name: "maxLength", Field: 48, Min: 0, Max: "1", Types: []string{"integer"}, Summary: true},
+	{Path: "ElementDefinition.condition", Name: "condition", Field: 50, Min: 0, Max: "*", Types: []string{"id"}, Summary: true},
+	{Path: "ElementDefinition.constraint", Name: "constraint", Field: 52, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.mustHaveValue", Name: "mustHaveValue", Field: 53, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.valueAlternatives", Name: "valueAlternatives", Field: 55, Min: 0, Max: "*", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition"}, Summary: true},
+	{Path: "ElementDefinition.mustSupport", Name: "mustSupport", Field: 57, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.isModifier", Name: "isModifier", Field: 59, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.isModifierReason", Name: "isModifierReason", Field: 61, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.isSummary", Name: "isSummary", Field: 63, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.binding", Name: "binding", Field: 65, Min: 0, Max: "1", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.mapping", Name: "mapping", Field: 66, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+}
+
+func (r *ElementDefinition) ElementMetadata() []ElementMetadata {
+	return elementDefinitionElementMetadata
+}
+
+// ElementDefinitionDefaultValue is the type of ElementDefinition.defaultValue[x]. It is implemented by the ElementDefinitionDefaultValue* variant types.
+type ElementDefinitionDefaultValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isElementDefinitionDefaultValue()
+}
+
+// ElementDefinitionDefaultValueBase64Binary is the base64Binary variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueBase64Binary string
+
+func (v ElementDefinitionDefaultValueBase64Binary) Validate() error {
 	return nil
 }
 
-type ElementDefinitionBinding struct {
-	Id          *string                              `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Strength    string                               `json:"strength" bson:"strength"`                           // required | extensible | preferred | example | descriptive
-	Description *string                              `json:"description,omitempty" bson:"description,omitempty"` // Guidance on the codes to be used
-	ValueSet    *string                              `json:"valueSet,omitempty" bson:"value_set,omitempty"`      // Source of value set
-	Additional  []ElementDefinitionBindingAdditional `json:"additional,omitempty" bson:"additional,omitempty"`   // Additional Bindings - more rules about the binding
+func (v ElementDefinitionDefaultValueBase64Binary) validateAll(path string, issues *ValidationIssues) {
 }
 
-func (r *ElementDefinitionBinding) Validate() error {
-	var emptyString string
-	if r.Strength == emptyString {
-		return fmt.Errorf("field 'Strength' is required")
-	}
-	for i, item := range r.Additional {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Additional[%d]: %w", i, err)
-		}
-	}
+func (v ElementDefinitionDefaultValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ElementDefinitionDefaultValueBase64Binary) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueBoolean is the boolean variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueBoolean bool
+
+func (v ElementDefinitionDefaultValueBoolean) Validate() error {
 	return nil
 }
 
-type ElementDefinitionBindingAdditional struct {
-	Id            *string        `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Key           *string        `json:"key,omitempty" bson:"key,omitempty"`                     // Unique identifier so additional bindings to be matched across profiles
-	Purpose       string         `json:"purpose" bson:"purpose"`                                 // maximum | minimum | required | extensible | candidate | current | current-extensible | best-practice | preferred | ui | starter | component
-	ValueSet      string         `json:"valueSet" bson:"value_set"`                              // The value set for the additional binding
-	Documentation *string        `json:"documentation,omitempty" bson:"documentation,omitempty"` // Documentation of the purpose of use of the binding
-	ShortDoco     *string        `json:"shortDoco,omitempty" bson:"short_doco,omitempty"`        // Concise documentation - for summary tables
-	Usage         []UsageContext `json:"usage,omitempty" bson:"usage,omitempty"`                 // Qualifies the usage - jurisdiction, gender, workflow status etc.
-	Any           *bool          `json:"any,omitempty" bson:"any,omitempty"`                     // Whether binding can applies to all repeats, or just one
+func (v ElementDefinitionDefaultValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueBoolean) FHIRType() string {
+	return "boolean"
 }
 
-func (r *ElementDefinitionBindingAdditional) Validate() error {
-	var emptyString string
-	if r.Purpose == emptyString {
-		return fmt.Errorf("field 'Purpose' is required")
-	}
-	if r.ValueSet == emptyString {
-		return fmt.Errorf("field 'ValueSet' is required")
-	}
-	for i, item := range r.Usage {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Usage[%d]: %w", i, err)
-		}
-	}
+func (v ElementDefinitionDefaultValueBoolean) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueCanonical is the canonical variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueCanonical string
+
+func (v ElementDefinitionDefaultValueCanonical) Validate() error {
 	return nil
 }
 
-type ElementDefinitionSlicingDiscriminator struct {
-	Id   *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type string  `json:"type" bson:"type"`                 // value | exists | type | profile | position
-	Path string  `json:"path" bson:"path"`                 // Path to element value
+func (v ElementDefinitionDefaultValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueCanonical) FHIRType() string {
+	return "canonical"
 }
 
-func (r *ElementDefinitionSlicingDiscriminator) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Path == emptyString {
-		return fmt.Errorf("field 'Path' is required")
-	}
+func (v ElementDefinitionDefaultValueCanonical) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueCode is the code variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueCode string
+
+func (v ElementDefinitionDefaultValueCode) Validate() error {
 	return nil
 }
 
-type ElementDefinitionType struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Code          string   `json:"code" bson:"code"`                                        // Data type or Resource (reference to definition)
-	Profile       []string `json:"profile,omitempty" bson:"profile,omitempty"`              // Profiles (StructureDefinition or IG) - one must apply
-	TargetProfile []string `json:"targetProfile,omitempty" bson:"target_profile,omitempty"` // Profile (StructureDefinition or IG) on the Reference/canonical target - one must apply
-	Aggregation   []string `json:"aggregation,omitempty" bson:"aggregation,omitempty"`      // contained | referenced | bundled - how aggregated
-	Versioning    *string  `json:"versioning,omitempty" bson:"versioning,omitempty"`        // either | independent | specific
+func (v ElementDefinitionDefaultValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueCode) FHIRType() string {
+	return "code"
 }
 
-func (r *ElementDefinitionType) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+func (v ElementDefinitionDefaultValueCode) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDate is the date variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDate struct {
+	Date
+}
+
+func (v ElementDefinitionDefaultValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	return nil
 }
 
-type ElementDefinitionExample struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Label                      string                 `json:"label" bson:"label"`                                              // Describes the purpose of this example
-	ValueBase64Binary          *string                `json:"valueBase64Binary" bson:"value_base64_binary"`                    // Value of Example (one of allowed types)
-	ValueBoolean               *bool                  `json:"valueBoolean" bson:"value_boolean"`                               // Value of Example (one of allowed types)
-	ValueCanonical             *string                `json:"valueCanonical" bson:"value_canonical"`                           // Value of Example (one of allowed types)
-	ValueCode                  *string                `json:"valueCode" bson:"value_code"`                                     // Value of Example (one of allowed types)
-	ValueDate                  *string                `json:"valueDate" bson:"value_date"`                                     // Value of Example (one of allowed types)
-	ValueDateTime              *string                `json:"valueDateTime" bson:"value_date_time"`                            // Value of Example (one of allowed types)
-	ValueDecimal               *float64               `json:"valueDecimal" bson:"value_decimal"`                               // Value of Example (one of allowed types)
-	ValueId                    *string                `json:"valueId" bson:"value_id"`                                         // Value of Example (one of allowed types)
-	ValueInstant               *string                `json:"valueInstant" bson:"value_instant"`                               // Value of Example (one of allowed types)
-	ValueInteger               *int                   `json:"valueInteger" bson:"value_integer"`                               // Value of Example (one of allowed types)
-	ValueInteger64             *int64                 `json:"valueInteger64" bson:"value_integer64"`                           // Value of Example (one of allowed types)
-	ValueMarkdown              *string                `json:"valueMarkdown" bson:"value_markdown"`                             // Value of Example (one of allowed types)
-	ValueOid                   *string                `json:"valueOid" bson:"value_oid"`                                       // Value of Example (one of allowed types)
-	ValuePositiveInt           *int                   `json:"valuePositiveInt" bson:"value_positive_int"`                      // Value of Example (one of allowed types)
-	ValueString                *string                `json:"valueString" bson:"value_string"`                                 // Value of Example (one of allowed types)
-	ValueTime                  *string                `json:"valueTime" bson:"value_time"`                                     // Value of Example (one of allowed types)
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt" bson:"value_unsigned_int"`                      // Value of Example (one of allowed types)
-	ValueUri                   *string                `json:"valueUri" bson:"value_uri"`                                       // Value of Example (one of allowed types)
-	ValueUrl                   *string                `json:"valueUrl" bson:"value_url"`                                       // Value of Example (one of allowed types)
-	ValueUuid                  *uuid                  `json:"valueUuid" bson:"value_uuid"`                                     // Value of Example (one of allowed types)
-	ValueAddress               *Address               `json:"valueAddress" bson:"value_address"`                               // Value of Example (one of allowed types)
-	ValueAge                   *Age                   `json:"valueAge" bson:"value_age"`                                       // Value of Example (one of allowed types)
-	ValueAnnotation            *Annotation            `json:"valueAnnotation" bson:"value_annotation"`                         // Value of Example (one of allowed types)
-	ValueAttachment            *Attachment            `json:"valueAttachment" bson:"value_attachment"`                         // Value of Example (one of allowed types)
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept" bson:"value_codeable_concept"`              // Value of Example (one of allowed types)
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference" bson:"value_codeable_reference"`          // Value of Example (one of allowed types)
-	ValueCoding                *Coding                `json:"valueCoding" bson:"value_coding"`                                 // Value of Example (one of allowed types)
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint" bson:"value_contact_point"`                    // Value of Example (one of allowed types)
-	ValueCount                 *Count                 `json:"valueCount" bson:"value_count"`                                   // Value of Example (one of allowed types)
-	ValueDistance              *Distance              `json:"valueDistance" bson:"value_distance"`                             // Value of Example (one of allowed types)
-	ValueDuration              *Duration              `json:"valueDuration" bson:"value_duration"`                             // Value of Example (one of allowed types)
-	ValueHumanName             *HumanName             `json:"valueHumanName" bson:"value_human_name"`                          // Value of Example (one of allowed types)
-	ValueIdentifier            *Identifier            `json:"valueIdentifier" bson:"value_identifier"`                         // Value of Example (one of allowed types)
-	ValueMoney                 *Money                 `json:"valueMoney" bson:"value_money"`                                   // Value of Example (one of allowed types)
-	ValuePeriod                *Period                `json:"valuePeriod" bson:"value_period"`                                 // Value of Example (one of allowed types)
-	ValueQuantity              *Quantity              `json:"valueQuantity" bson:"value_quantity"`                             // Value of Example (one of allowed types)
-	ValueRange                 *Range                 `json:"valueRange" bson:"value_range"`                                   // Value of Example (one of allowed types)
-	ValueRatio                 *Ratio                 `json:"valueRatio" bson:"value_ratio"`                                   // Value of Example (one of allowed types)
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange" bson:"value_ratio_range"`                        // Value of Example (one of allowed types)
-	ValueReference             *Reference             `json:"valueReference" bson:"value_reference"`                           // Value of Example (one of allowed types)
-	ValueSampledData           *SampledData           `json:"valueSampledData" bson:"value_sampled_data"`                      // Value of Example (one of allowed types)
-	ValueSignature             *Signature             `json:"valueSignature" bson:"value_signature"`                           // Value of Example (one of allowed types)
-	ValueTiming                *Timing                `json:"valueTiming" bson:"value_timing"`                                 // Value of Example (one of allowed types)
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail" bson:"value_contact_detail"`                  // Value of Example (one of allowed types)
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement" bson:"value_data_requirement"`              // Value of Example (one of allowed types)
-	ValueExpression            *Expression            `json:"valueExpression" bson:"value_expression"`                         // Value of Example (one of allowed types)
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition" bson:"value_parameter_definition"`      // Value of Example (one of allowed types)
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact" bson:"value_related_artifact"`              // Value of Example (one of allowed types)
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition" bson:"value_trigger_definition"`          // Value of Example (one of allowed types)
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext" bson:"value_usage_context"`                    // Value of Example (one of allowed types)
-	ValueAvailability          *Availability          `json:"valueAvailability" bson:"value_availability"`                     // Value of Example (one of allowed types)
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail" bson:"value_extended_contact_detail"` // Value of Example (one of allowed types)
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail" bson:"value_virtual_service_detail"`   // Value of Example (one of allowed types)
-	ValueDosage                *Dosage                `json:"valueDosage" bson:"value_dosage"`                                 // Value of Example (one of allowed types)
-	ValueMeta                  *Meta                  `json:"valueMeta" bson:"value_meta"`                                     // Value of Example (one of allowed types)
+func (v ElementDefinitionDefaultValueDate) FHIRType() string {
+	return "date"
 }
 
-func (r *ElementDefinitionExample) Validate() error {
-	var emptyString string
-	if r.Label == emptyString {
-		return fmt.Errorf("field 'Label' is required")
+func (v ElementDefinitionDefaultValueDate) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDateTime is the dateTime variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDateTime struct {
+	DateTime
+}
+
+func (v ElementDefinitionDefaultValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueBase64Binary == nil {
-		return fmt.Errorf("field 'ValueBase64Binary' is required")
-	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+}
+
+func (v ElementDefinitionDefaultValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ElementDefinitionDefaultValueDateTime) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDecimal is the decimal variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDecimal struct {
+	Decimal
+}
+
+func (v ElementDefinitionDefaultValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueCanonical == nil {
-		return fmt.Errorf("field 'ValueCanonical' is required")
+}
+
+func (v ElementDefinitionDefaultValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ElementDefinitionDefaultValueDecimal) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueId is the id variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueId string
+
+func (v ElementDefinitionDefaultValueId) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ElementDefinitionDefaultValueId) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueInstant is the instant variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueInstant struct {
+	Instant
+}
+
+func (v ElementDefinitionDefaultValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ElementDefinitionDefaultValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
+}
+
+func (v ElementDefinitionDefaultValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ElementDefinitionDefaultValueInstant) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueInteger is the integer variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueInteger int
+
+func (v ElementDefinitionDefaultValueInteger) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ElementDefinitionDefaultValueInteger) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueInteger64 is the integer64 variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueInteger64 int64
+
+func (v ElementDefinitionDefaultValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ElementDefinitionDefaultValueInteger64) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueMarkdown is the markdown variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueMarkdown string
+
+func (v ElementDefinitionDefaultValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ElementDefinitionDefaultValueMarkdown) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueOid is the oid variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueOid string
+
+func (v ElementDefinitionDefaultValueOid) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ElementDefinitionDefaultValueOid) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValuePositiveInt is the positiveInt variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValuePositiveInt int
+
+func (v ElementDefinitionDefaultValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValuePositiveInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ElementDefinitionDefaultValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ElementDefinitionDefaultValuePositiveInt) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueString is the string variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueString string
+
+func (v ElementDefinitionDefaultValueString) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ElementDefinitionDefaultValueString) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueTime is the time variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueTime struct {
+	Time
+}
+
+func (v ElementDefinitionDefaultValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ElementDefinitionDefaultValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
+}
+
+func (v ElementDefinitionDefaultValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ElementDefinitionDefaultValueTime) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueUnsignedInt is the unsignedInt variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueUnsignedInt int
+
+func (v ElementDefinitionDefaultValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ElementDefinitionDefaultValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ElementDefinitionDefaultValueUnsignedInt) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueUri is the uri variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueUri string
+
+func (v ElementDefinitionDefaultValueUri) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ElementDefinitionDefaultValueUri) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueUrl is the url variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueUrl string
+
+func (v ElementDefinitionDefaultValueUrl) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionDefaultValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionDefaultValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ElementDefinitionDefaultValueUrl) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueUuid is the uuid variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueUuid struct {
+	uuid
+}
+
+func (v ElementDefinitionDefaultValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ElementDefinitionDefaultValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ElementDefinitionDefaultValueUuid) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueAddress is the Address variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueAddress struct {
+	Address
+}
+
+func (v ElementDefinitionDefaultValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ElementDefinitionDefaultValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ElementDefinitionDefaultValueAddress) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueAge is the Age variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueAge struct {
+	Age
+}
+
+func (v ElementDefinitionDefaultValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ElementDefinitionDefaultValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ElementDefinitionDefaultValueAge) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueAnnotation is the Annotation variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueAnnotation struct {
+	Annotation
+}
+
+func (v ElementDefinitionDefaultValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ElementDefinitionDefaultValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ElementDefinitionDefaultValueAnnotation) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueAttachment is the Attachment variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueAttachment struct {
+	Attachment
+}
+
+func (v ElementDefinitionDefaultValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ElementDefinitionDefaultValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ElementDefinitionDefaultValueAttachment) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueCodeableConcept is the CodeableConcept variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ElementDefinitionDefaultValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ElementDefinitionDefaultValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ElementDefinitionDefaultValueCodeableConcept) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueCodeableReference is the CodeableReference variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ElementDefinitionDefaultValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ElementDefinitionDefaultValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ElementDefinitionDefaultValueCodeableReference) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueCoding is the Coding variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueCoding struct {
+	Coding
+}
+
+func (v ElementDefinitionDefaultValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ElementDefinitionDefaultValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ElementDefinitionDefaultValueCoding) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueContactPoint is the ContactPoint variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ElementDefinitionDefaultValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ElementDefinitionDefaultValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ElementDefinitionDefaultValueContactPoint) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueCount is the Count variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueCount struct {
+	Count
+}
+
+func (v ElementDefinitionDefaultValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ElementDefinitionDefaultValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ElementDefinitionDefaultValueCount) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDistance is the Distance variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDistance struct {
+	Distance
+}
+
+func (v ElementDefinitionDefaultValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ElementDefinitionDefaultValueDistance) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDuration is the Duration variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDuration struct {
+	Duration
+}
+
+func (v ElementDefinitionDefaultValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ElementDefinitionDefaultValueDuration) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueHumanName is the HumanName variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueHumanName struct {
+	HumanName
+}
+
+func (v ElementDefinitionDefaultValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ElementDefinitionDefaultValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ElementDefinitionDefaultValueHumanName) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueIdentifier is the Identifier variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueIdentifier struct {
+	Identifier
+}
+
+func (v ElementDefinitionDefaultValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ElementDefinitionDefaultValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ElementDefinitionDefaultValueIdentifier) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueMoney is the Money variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueMoney struct {
+	Money
+}
+
+func (v ElementDefinitionDefaultValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ElementDefinitionDefaultValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ElementDefinitionDefaultValueMoney) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValuePeriod is the Period variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValuePeriod struct {
+	Period
+}
+
+func (v ElementDefinitionDefaultValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ElementDefinitionDefaultValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ElementDefinitionDefaultValuePeriod) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueQuantity is the Quantity variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueQuantity struct {
+	Quantity
+}
+
+func (v ElementDefinitionDefaultValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ElementDefinitionDefaultValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ElementDefinitionDefaultValueQuantity) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueRange is the Range variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueRange struct {
+	Range
+}
+
+func (v ElementDefinitionDefaultValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ElementDefinitionDefaultValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ElementDefinitionDefaultValueRange) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueRatio is the Ratio variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueRatio struct {
+	Ratio
+}
+
+func (v ElementDefinitionDefaultValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ElementDefinitionDefaultValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ElementDefinitionDefaultValueRatio) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueRatioRange is the RatioRange variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueRatioRange struct {
+	RatioRange
+}
+
+func (v ElementDefinitionDefaultValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ElementDefinitionDefaultValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ElementDefinitionDefaultValueRatioRange) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueReference is the Reference variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueReference struct {
+	Reference
+}
+
+func (v ElementDefinitionDefaultValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ElementDefinitionDefaultValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ElementDefinitionDefaultValueReference) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueSampledData is the SampledData variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueSampledData struct {
+	SampledData
+}
+
+func (v ElementDefinitionDefaultValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ElementDefinitionDefaultValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ElementDefinitionDefaultValueSampledData) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueSignature is the Signature variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueSignature struct {
+	Signature
+}
+
+func (v ElementDefinitionDefaultValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ElementDefinitionDefaultValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ElementDefinitionDefaultValueSignature) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueTiming is the Timing variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueTiming struct {
+	Timing
+}
+
+func (v ElementDefinitionDefaultValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ElementDefinitionDefaultValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ElementDefinitionDefaultValueTiming) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueContactDetail is the ContactDetail variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ElementDefinitionDefaultValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ElementDefinitionDefaultValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ElementDefinitionDefaultValueContactDetail) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDataRequirement is the DataRequirement variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ElementDefinitionDefaultValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ElementDefinitionDefaultValueDataRequirement) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueExpression is the Expression variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueExpression struct {
+	Expression
+}
+
+func (v ElementDefinitionDefaultValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ElementDefinitionDefaultValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ElementDefinitionDefaultValueExpression) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueParameterDefinition is the ParameterDefinition variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ElementDefinitionDefaultValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ElementDefinitionDefaultValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ElementDefinitionDefaultValueParameterDefinition) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueRelatedArtifact is the RelatedArtifact variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ElementDefinitionDefaultValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ElementDefinitionDefaultValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ElementDefinitionDefaultValueRelatedArtifact) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueTriggerDefinition is the TriggerDefinition variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ElementDefinitionDefaultValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ElementDefinitionDefaultValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ElementDefinitionDefaultValueTriggerDefinition) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueUsageContext is the UsageContext variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueUsageContext struct {
+	UsageContext
+}
+
+func (v ElementDefinitionDefaultValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ElementDefinitionDefaultValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ElementDefinitionDefaultValueUsageContext) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueAvailability is the Availability variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueAvailability struct {
+	Availability
+}
+
+func (v ElementDefinitionDefaultValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ElementDefinitionDefaultValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ElementDefinitionDefaultValueAvailability) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueExtendedContactDetail is the ExtendedContactDetail variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ElementDefinitionDefaultValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ElementDefinitionDefaultValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ElementDefinitionDefaultValueExtendedContactDetail) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueVirtualServiceDetail is the VirtualServiceDetail variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ElementDefinitionDefaultValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ElementDefinitionDefaultValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ElementDefinitionDefaultValueVirtualServiceDetail) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueDosage is the Dosage variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueDosage struct {
+	Dosage
+}
+
+func (v ElementDefinitionDefaultValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ElementDefinitionDefaultValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ElementDefinitionDefaultValueDosage) isElementDefinitionDefaultValue() {}
+
+// ElementDefinitionDefaultValueMeta is the Meta variant of ElementDefinitionDefaultValue.
+type ElementDefinitionDefaultValueMeta struct {
+	Meta
+}
+
+func (v ElementDefinitionDefaultValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ElementDefinitionDefaultValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ElementDefinitionDefaultValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ElementDefinitionDefaultValueMeta) isElementDefinitionDefaultValue() {}
+
+var elementDefinitionDefaultValueVariants = []ElementDefinitionDefaultValue{
+	ElementDefinitionDefaultValueBase64Binary(""),
+	ElementDefinitionDefaultValueBoolean(false),
+	ElementDefinitionDefaultValueCanonical(""),
+	ElementDefinitionDefaultValueCode(""),
+	ElementDefinitionDefaultValueDate{},
+	ElementDefinitionDefaultValueDateTime{},
+	ElementDefinitionDefaultValueDecimal{},
+	ElementDefinitionDefaultValueId(""),
+	ElementDefinitionDefaultValueInstant{},
+	ElementDefinitionDefaultValueInteger(0),
+	ElementDefinitionDefaultValueInteger64(0),
+	ElementDefinitionDefaultValueMarkdown(""),
+	ElementDefinitionDefaultValueOid(""),
+	ElementDefinitionDefaultValuePositiveInt(0),
+	ElementDefinitionDefaultValueString(""),
+	ElementDefinitionDefaultValueTime{},
+	ElementDefinitionDefaultValueUnsignedInt(0),
+	ElementDefinitionDefaultValueUri(""),
+	ElementDefinitionDefaultValueUrl(""),
+	ElementDefinitionDefaultValueUuid{},
+	ElementDefinitionDefaultValueAddress{},
+	ElementDefinitionDefaultValueAge{},
+	ElementDefinitionDefaultValueAnnotation{},
+	ElementDefinitionDefaultValueAttachment{},
+	ElementDefinitionDefaultValueCodeableConcept{},
+	ElementDefinitionDefaultValueCodeableReference{},
+	ElementDefinitionDefaultValueCoding{},
+	ElementDefinitionDefaultValueContactPoint{},
+	ElementDefinitionDefaultValueCount{},
+	ElementDefinitionDefaultValueDistance{},
+	ElementDefinitionDefaultValueDuration{},
+	ElementDefinitionDefaultValueHumanName{},
+	ElementDefinitionDefaultValueIdentifier{},
+	ElementDefinitionDefaultValueMoney{},
+	ElementDefinitionDefaultValuePeriod{},
+	ElementDefinitionDefaultValueQuantity{},
+	ElementDefinitionDefaultValueRange{},
+	ElementDefinitionDefaultValueRatio{},
+	ElementDefinitionDefaultValueRatioRange{},
+	ElementDefinitionDefaultValueReference{},
+	ElementDefinitionDefaultValueSampledData{},
+	ElementDefinitionDefaultValueSignature{},
+	ElementDefinitionDefaultValueTiming{},
+	ElementDefinitionDefaultValueContactDetail{},
+	ElementDefinitionDefaultValueDataRequirement{},
+	ElementDefinitionDefaultValueExpression{},
+	ElementDefinitionDefaultValueParameterDefinition{},
+	ElementDefinitionDefaultValueRelatedArtifact{},
+	ElementDefinitionDefaultValueTriggerDefinition{},
+	ElementDefinitionDefaultValueUsageContext{},
+	ElementDefinitionDefaultValueAvailability{},
+	ElementDefinitionDefaultValueExtendedContactDetail{},
+	ElementDefinitionDefaultValueVirtualServiceDetail{},
+	ElementDefinitionDefaultValueDosage{},
+	ElementDefinitionDefaultValueMeta{},
+}
+
+// ElementDefinitionFixed is the type of ElementDefinition.fixed[x]. It is implemented by the ElementDefinitionFixed* variant types.
+type ElementDefinitionFixed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isElementDefinitionFixed()
+}
+
+// ElementDefinitionFixedBase64Binary is the base64Binary variant of ElementDefinitionFixed.
+type ElementDefinitionFixedBase64Binary string
+
+func (v ElementDefinitionFixedBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ElementDefinitionFixedBase64Binary) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedBoolean is the boolean variant of ElementDefinitionFixed.
+type ElementDefinitionFixedBoolean bool
+
+func (v ElementDefinitionFixedBoolean) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ElementDefinitionFixedBoolean) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedCanonical is the canonical variant of ElementDefinitionFixed.
+type ElementDefinitionFixedCanonical string
+
+func (v ElementDefinitionFixedCanonical) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ElementDefinitionFixedCanonical) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedCode is the code variant of ElementDefinitionFixed.
+type ElementDefinitionFixedCode string
+
+func (v ElementDefinitionFixedCode) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedCode) FHIRType() string {
+	return "code"
+}
+
+func (v ElementDefinitionFixedCode) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDate is the date variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDate struct {
+	Date
+}
+
+func (v ElementDefinitionFixedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ElementDefinitionFixedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionFixedDate) FHIRType() string {
+	return "date"
+}
+
+func (v ElementDefinitionFixedDate) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDateTime is the dateTime variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDateTime struct {
+	DateTime
+}
+
+func (v ElementDefinitionFixedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ElementDefinitionFixedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionFixedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ElementDefinitionFixedDateTime) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDecimal is the decimal variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDecimal struct {
+	Decimal
+}
+
+func (v ElementDefinitionFixedDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ElementDefinitionFixedDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionFixedDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ElementDefinitionFixedDecimal) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedId is the id variant of ElementDefinitionFixed.
+type ElementDefinitionFixedId string
+
+func (v ElementDefinitionFixedId) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedId) FHIRType() string {
+	return "id"
+}
+
+func (v ElementDefinitionFixedId) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedInstant is the instant variant of ElementDefinitionFixed.
+type ElementDefinitionFixedInstant struct {
+	Instant
+}
+
+func (v ElementDefinitionFixedInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ElementDefinitionFixedInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionFixedInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ElementDefinitionFixedInstant) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedInteger is the integer variant of ElementDefinitionFixed.
+type ElementDefinitionFixedInteger int
+
+func (v ElementDefinitionFixedInteger) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ElementDefinitionFixedInteger) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedInteger64 is the integer64 variant of ElementDefinitionFixed.
+type ElementDefinitionFixedInteger64 int64
+
+func (v ElementDefinitionFixedInteger64) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ElementDefinitionFixedInteger64) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedMarkdown is the markdown variant of ElementDefinitionFixed.
+type ElementDefinitionFixedMarkdown string
+
+func (v ElementDefinitionFixedMarkdown) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ElementDefinitionFixedMarkdown) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedOid is the oid variant of ElementDefinitionFixed.
+type ElementDefinitionFixedOid string
+
+func (v ElementDefinitionFixedOid) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ElementDefinitionFixedOid) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedPositiveInt is the positiveInt variant of ElementDefinitionFixed.
+type ElementDefinitionFixedPositiveInt int
+
+func (v ElementDefinitionFixedPositiveInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedPositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedPositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ElementDefinitionFixedPositiveInt) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedString is the string variant of ElementDefinitionFixed.
+type ElementDefinitionFixedString string
+
+func (v ElementDefinitionFixedString) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedString) FHIRType() string {
+	return "string"
+}
+
+func (v ElementDefinitionFixedString) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedTime is the time variant of ElementDefinitionFixed.
+type ElementDefinitionFixedTime struct {
+	Time
+}
+
+func (v ElementDefinitionFixedTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ElementDefinitionFixedTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionFixedTime) FHIRType() string {
+	return "time"
+}
+
+func (v ElementDefinitionFixedTime) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedUnsignedInt is the unsignedInt variant of ElementDefinitionFixed.
+type ElementDefinitionFixedUnsignedInt int
+
+func (v ElementDefinitionFixedUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ElementDefinitionFixedUnsignedInt) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedUri is the uri variant of ElementDefinitionFixed.
+type ElementDefinitionFixedUri string
+
+func (v ElementDefinitionFixedUri) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ElementDefinitionFixedUri) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedUrl is the url variant of ElementDefinitionFixed.
+type ElementDefinitionFixedUrl string
+
+func (v ElementDefinitionFixedUrl) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionFixedUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionFixedUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ElementDefinitionFixedUrl) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedUuid is the uuid variant of ElementDefinitionFixed.
+type ElementDefinitionFixedUuid struct {
+	uuid
+}
+
+func (v ElementDefinitionFixedUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ElementDefinitionFixedUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ElementDefinitionFixedUuid) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedAddress is the Address variant of ElementDefinitionFixed.
+type ElementDefinitionFixedAddress struct {
+	Address
+}
+
+func (v ElementDefinitionFixedAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ElementDefinitionFixedAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ElementDefinitionFixedAddress) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedAge is the Age variant of ElementDefinitionFixed.
+type ElementDefinitionFixedAge struct {
+	Age
+}
+
+func (v ElementDefinitionFixedAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ElementDefinitionFixedAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ElementDefinitionFixedAge) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedAnnotation is the Annotation variant of ElementDefinitionFixed.
+type ElementDefinitionFixedAnnotation struct {
+	Annotation
+}
+
+func (v ElementDefinitionFixedAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ElementDefinitionFixedAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ElementDefinitionFixedAnnotation) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedAttachment is the Attachment variant of ElementDefinitionFixed.
+type ElementDefinitionFixedAttachment struct {
+	Attachment
+}
+
+func (v ElementDefinitionFixedAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ElementDefinitionFixedAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ElementDefinitionFixedAttachment) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedCodeableConcept is the CodeableConcept variant of ElementDefinitionFixed.
+type ElementDefinitionFixedCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ElementDefinitionFixedCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ElementDefinitionFixedCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ElementDefinitionFixedCodeableConcept) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedCodeableReference is the CodeableReference variant of ElementDefinitionFixed.
+type ElementDefinitionFixedCodeableReference struct {
+	CodeableReference
+}
+
+func (v ElementDefinitionFixedCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ElementDefinitionFixedCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ElementDefinitionFixedCodeableReference) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedCoding is the Coding variant of ElementDefinitionFixed.
+type ElementDefinitionFixedCoding struct {
+	Coding
+}
+
+func (v ElementDefinitionFixedCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ElementDefinitionFixedCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ElementDefinitionFixedCoding) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedContactPoint is the ContactPoint variant of ElementDefinitionFixed.
+type ElementDefinitionFixedContactPoint struct {
+	ContactPoint
+}
+
+func (v ElementDefinitionFixedContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ElementDefinitionFixedContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ElementDefinitionFixedContactPoint) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedCount is the Count variant of ElementDefinitionFixed.
+type ElementDefinitionFixedCount struct {
+	Count
+}
+
+func (v ElementDefinitionFixedCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ElementDefinitionFixedCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ElementDefinitionFixedCount) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDistance is the Distance variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDistance struct {
+	Distance
+}
+
+func (v ElementDefinitionFixedDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ElementDefinitionFixedDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ElementDefinitionFixedDistance) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDuration is the Duration variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDuration struct {
+	Duration
+}
+
+func (v ElementDefinitionFixedDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ElementDefinitionFixedDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ElementDefinitionFixedDuration) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedHumanName is the HumanName variant of ElementDefinitionFixed.
+type ElementDefinitionFixedHumanName struct {
+	HumanName
+}
+
+func (v ElementDefinitionFixedHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ElementDefinitionFixedHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ElementDefinitionFixedHumanName) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedIdentifier is the Identifier variant of ElementDefinitionFixed.
+type ElementDefinitionFixedIdentifier struct {
+	Identifier
+}
+
+func (v ElementDefinitionFixedIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ElementDefinitionFixedIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ElementDefinitionFixedIdentifier) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedMoney is the Money variant of ElementDefinitionFixed.
+type ElementDefinitionFixedMoney struct {
+	Money
+}
+
+func (v ElementDefinitionFixedMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ElementDefinitionFixedMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ElementDefinitionFixedMoney) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedPeriod is the Period variant of ElementDefinitionFixed.
+type ElementDefinitionFixedPeriod struct {
+	Period
+}
+
+func (v ElementDefinitionFixedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ElementDefinitionFixedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ElementDefinitionFixedPeriod) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedQuantity is the Quantity variant of ElementDefinitionFixed.
+type ElementDefinitionFixedQuantity struct {
+	Quantity
+}
+
+func (v ElementDefinitionFixedQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ElementDefinitionFixedQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ElementDefinitionFixedQuantity) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedRange is the Range variant of ElementDefinitionFixed.
+type ElementDefinitionFixedRange struct {
+	Range
+}
+
+func (v ElementDefinitionFixedRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ElementDefinitionFixedRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ElementDefinitionFixedRange) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedRatio is the Ratio variant of ElementDefinitionFixed.
+type ElementDefinitionFixedRatio struct {
+	Ratio
+}
+
+func (v ElementDefinitionFixedRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ElementDefinitionFixedRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ElementDefinitionFixedRatio) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedRatioRange is the RatioRange variant of ElementDefinitionFixed.
+type ElementDefinitionFixedRatioRange struct {
+	RatioRange
+}
+
+func (v ElementDefinitionFixedRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ElementDefinitionFixedRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ElementDefinitionFixedRatioRange) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedReference is the Reference variant of ElementDefinitionFixed.
+type ElementDefinitionFixedReference struct {
+	Reference
+}
+
+func (v ElementDefinitionFixedReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ElementDefinitionFixedReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ElementDefinitionFixedReference) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedSampledData is the SampledData variant of ElementDefinitionFixed.
+type ElementDefinitionFixedSampledData struct {
+	SampledData
+}
+
+func (v ElementDefinitionFixedSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ElementDefinitionFixedSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ElementDefinitionFixedSampledData) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedSignature is the Signature variant of ElementDefinitionFixed.
+type ElementDefinitionFixedSignature struct {
+	Signature
+}
+
+func (v ElementDefinitionFixedSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ElementDefinitionFixedSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ElementDefinitionFixedSignature) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedTiming is the Timing variant of ElementDefinitionFixed.
+type ElementDefinitionFixedTiming struct {
+	Timing
+}
+
+func (v ElementDefinitionFixedTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ElementDefinitionFixedTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ElementDefinitionFixedTiming) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedContactDetail is the ContactDetail variant of ElementDefinitionFixed.
+type ElementDefinitionFixedContactDetail struct {
+	ContactDetail
+}
+
+func (v ElementDefinitionFixedContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ElementDefinitionFixedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ElementDefinitionFixedContactDetail) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDataRequirement is the DataRequirement variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDataRequirement struct {
+	DataRequirement
+}
+
+func (v ElementDefinitionFixedDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ElementDefinitionFixedDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ElementDefinitionFixedDataRequirement) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedExpression is the Expression variant of ElementDefinitionFixed.
+type ElementDefinitionFixedExpression struct {
+	Expression
+}
+
+func (v ElementDefinitionFixedExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ElementDefinitionFixedExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ElementDefinitionFixedExpression) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedParameterDefinition is the ParameterDefinition variant of ElementDefinitionFixed.
+type ElementDefinitionFixedParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ElementDefinitionFixedParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ElementDefinitionFixedParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ElementDefinitionFixedParameterDefinition) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedRelatedArtifact is the RelatedArtifact variant of ElementDefinitionFixed.
+type ElementDefinitionFixedRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ElementDefinitionFixedRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ElementDefinitionFixedRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ElementDefinitionFixedRelatedArtifact) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedTriggerDefinition is the TriggerDefinition variant of ElementDefinitionFixed.
+type ElementDefinitionFixedTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ElementDefinitionFixedTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ElementDefinitionFixedTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ElementDefinitionFixedTriggerDefinition) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedUsageContext is the UsageContext variant of ElementDefinitionFixed.
+type ElementDefinitionFixedUsageContext struct {
+	UsageContext
+}
+
+func (v ElementDefinitionFixedUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ElementDefinitionFixedUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ElementDefinitionFixedUsageContext) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedAvailability is the Availability variant of ElementDefinitionFixed.
+type ElementDefinitionFixedAvailability struct {
+	Availability
+}
+
+func (v ElementDefinitionFixedAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ElementDefinitionFixedAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ElementDefinitionFixedAvailability) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedExtendedContactDetail is the ExtendedContactDetail variant of ElementDefinitionFixed.
+type ElementDefinitionFixedExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ElementDefinitionFixedExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ElementDefinitionFixedExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ElementDefinitionFixedExtendedContactDetail) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedVirtualServiceDetail is the VirtualServiceDetail variant of ElementDefinitionFixed.
+type ElementDefinitionFixedVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ElementDefinitionFixedVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ElementDefinitionFixedVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ElementDefinitionFixedVirtualServiceDetail) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedDosage is the Dosage variant of ElementDefinitionFixed.
+type ElementDefinitionFixedDosage struct {
+	Dosage
+}
+
+func (v ElementDefinitionFixedDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ElementDefinitionFixedDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ElementDefinitionFixedDosage) isElementDefinitionFixed() {}
+
+// ElementDefinitionFixedMeta is the Meta variant of ElementDefinitionFixed.
+type ElementDefinitionFixedMeta struct {
+	Meta
+}
+
+func (v ElementDefinitionFixedMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ElementDefinitionFixedMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ElementDefinitionFixedMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ElementDefinitionFixedMeta) isElementDefinitionFixed() {}
+
+var elementDefinitionFixedVariants = []ElementDefinitionFixed{
+	ElementDefinitionFixedBase64Binary(""),
+	ElementDefinitionFixedBoolean(false),
+	ElementDefinitionFixedCanonical(""),
+	ElementDefinitionFixedCode(""),
+	ElementDefinitionFixedDate{},
+	ElementDefinitionFixedDateTime{},
+	ElementDefinitionFixedDecimal{},
+	ElementDefinitionFixedId(""),
+	ElementDefinitionFixedInstant{},
+	ElementDefinitionFixedInteger(0),
+	ElementDefinitionFixedInteger64(0),
+	ElementDefinitionFixedMarkdown(""),
+	ElementDefinitionFixedOid(""),
+	ElementDefinitionFixedPositiveInt(0),
+	ElementDefinitionFixedString(""),
+	ElementDefinitionFixedTime{},
+	ElementDefinitionFixedUnsignedInt(0),
+	ElementDefinitionFixedUri(""),
+	ElementDefinitionFixedUrl(""),
+	ElementDefinitionFixedUuid{},
+	ElementDefinitionFixedAddress{},
+	ElementDefinitionFixedAge{},
+	ElementDefinitionFixedAnnotation{},
+	ElementDefinitionFixedAttachment{},
+	ElementDefinitionFixedCodeableConcept{},
+	ElementDefinitionFixedCodeableReference{},
+	ElementDefinitionFixedCoding{},
+	ElementDefinitionFixedContactPoint{},
+	ElementDefinitionFixedCount{},
+	ElementDefinitionFixedDistance{},
+	ElementDefinitionFixedDuration{},
+	ElementDefinitionFixedHumanName{},
+	ElementDefinitionFixedIdentifier{},
+	ElementDefinitionFixedMoney{},
+	ElementDefinitionFixedPeriod{},
+	ElementDefinitionFixedQuantity{},
+	ElementDefinitionFixedRange{},
+	ElementDefinitionFixedRatio{},
+	ElementDefinitionFixedRatioRange{},
+	ElementDefinitionFixedReference{},
+	ElementDefinitionFixedSampledData{},
+	ElementDefinitionFixedSignature{},
+	ElementDefinitionFixedTiming{},
+	ElementDefinitionFixedContactDetail{},
+	ElementDefinitionFixedDataRequirement{},
+	ElementDefinitionFixedExpression{},
+	ElementDefinitionFixedParameterDefinition{},
+	ElementDefinitionFixedRelatedArtifact{},
+	ElementDefinitionFixedTriggerDefinition{},
+	ElementDefinitionFixedUsageContext{},
+	ElementDefinitionFixedAvailability{},
+	ElementDefinitionFixedExtendedContactDetail{},
+	ElementDefinitionFixedVirtualServiceDetail{},
+	ElementDefinitionFixedDosage{},
+	ElementDefinitionFixedMeta{},
+}
+
+// ElementDefinitionPattern is the type of ElementDefinition.pattern[x]. It is implemented by the ElementDefinitionPattern* variant types.
+type ElementDefinitionPattern interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isElementDefinitionPattern()
+}
+
+// ElementDefinitionPatternBase64Binary is the base64Binary variant of ElementDefinitionPattern.
+type ElementDefinitionPatternBase64Binary string
+
+func (v ElementDefinitionPatternBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ElementDefinitionPatternBase64Binary) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternBoolean is the boolean variant of ElementDefinitionPattern.
+type ElementDefinitionPatternBoolean bool
+
+func (v ElementDefinitionPatternBoolean) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ElementDefinitionPatternBoolean) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternCanonical is the canonical variant of ElementDefinitionPattern.
+type ElementDefinitionPatternCanonical string
+
+func (v ElementDefinitionPatternCanonical) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ElementDefinitionPatternCanonical) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternCode is the code variant of ElementDefinitionPattern.
+type ElementDefinitionPatternCode string
+
+func (v ElementDefinitionPatternCode) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternCode) FHIRType() string {
+	return "code"
+}
+
+func (v ElementDefinitionPatternCode) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDate is the date variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDate struct {
+	Date
+}
+
+func (v ElementDefinitionPatternDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ElementDefinitionPatternDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionPatternDate) FHIRType() string {
+	return "date"
+}
+
+func (v ElementDefinitionPatternDate) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDateTime is the dateTime variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDateTime struct {
+	DateTime
+}
+
+func (v ElementDefinitionPatternDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ElementDefinitionPatternDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionPatternDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ElementDefinitionPatternDateTime) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDecimal is the decimal variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDecimal struct {
+	Decimal
+}
+
+func (v ElementDefinitionPatternDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ElementDefinitionPatternDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionPatternDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ElementDefinitionPatternDecimal) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternId is the id variant of ElementDefinitionPattern.
+type ElementDefinitionPatternId string
+
+func (v ElementDefinitionPatternId) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternId) FHIRType() string {
+	return "id"
+}
+
+func (v ElementDefinitionPatternId) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternInstant is the instant variant of ElementDefinitionPattern.
+type ElementDefinitionPatternInstant struct {
+	Instant
+}
+
+func (v ElementDefinitionPatternInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ElementDefinitionPatternInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionPatternInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ElementDefinitionPatternInstant) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternInteger is the integer variant of ElementDefinitionPattern.
+type ElementDefinitionPatternInteger int
+
+func (v ElementDefinitionPatternInteger) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ElementDefinitionPatternInteger) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternInteger64 is the integer64 variant of ElementDefinitionPattern.
+type ElementDefinitionPatternInteger64 int64
+
+func (v ElementDefinitionPatternInteger64) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ElementDefinitionPatternInteger64) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternMarkdown is the markdown variant of ElementDefinitionPattern.
+type ElementDefinitionPatternMarkdown string
+
+func (v ElementDefinitionPatternMarkdown) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ElementDefinitionPatternMarkdown) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternOid is the oid variant of ElementDefinitionPattern.
+type ElementDefinitionPatternOid string
+
+func (v ElementDefinitionPatternOid) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ElementDefinitionPatternOid) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternPositiveInt is the positiveInt variant of ElementDefinitionPattern.
+type ElementDefinitionPatternPositiveInt int
+
+func (v ElementDefinitionPatternPositiveInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternPositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternPositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ElementDefinitionPatternPositiveInt) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternString is the string variant of ElementDefinitionPattern.
+type ElementDefinitionPatternString string
+
+func (v ElementDefinitionPatternString) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternString) FHIRType() string {
+	return "string"
+}
+
+func (v ElementDefinitionPatternString) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternTime is the time variant of ElementDefinitionPattern.
+type ElementDefinitionPatternTime struct {
+	Time
+}
+
+func (v ElementDefinitionPatternTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ElementDefinitionPatternTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionPatternTime) FHIRType() string {
+	return "time"
+}
+
+func (v ElementDefinitionPatternTime) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternUnsignedInt is the unsignedInt variant of ElementDefinitionPattern.
+type ElementDefinitionPatternUnsignedInt int
+
+func (v ElementDefinitionPatternUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ElementDefinitionPatternUnsignedInt) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternUri is the uri variant of ElementDefinitionPattern.
+type ElementDefinitionPatternUri string
+
+func (v ElementDefinitionPatternUri) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ElementDefinitionPatternUri) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternUrl is the url variant of ElementDefinitionPattern.
+type ElementDefinitionPatternUrl string
+
+func (v ElementDefinitionPatternUrl) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionPatternUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionPatternUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ElementDefinitionPatternUrl) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternUuid is the uuid variant of ElementDefinitionPattern.
+type ElementDefinitionPatternUuid struct {
+	uuid
+}
+
+func (v ElementDefinitionPatternUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ElementDefinitionPatternUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ElementDefinitionPatternUuid) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternAddress is the Address variant of ElementDefinitionPattern.
+type ElementDefinitionPatternAddress struct {
+	Address
+}
+
+func (v ElementDefinitionPatternAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ElementDefinitionPatternAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ElementDefinitionPatternAddress) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternAge is the Age variant of ElementDefinitionPattern.
+type ElementDefinitionPatternAge struct {
+	Age
+}
+
+func (v ElementDefinitionPatternAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ElementDefinitionPatternAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ElementDefinitionPatternAge) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternAnnotation is the Annotation variant of ElementDefinitionPattern.
+type ElementDefinitionPatternAnnotation struct {
+	Annotation
+}
+
+func (v ElementDefinitionPatternAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ElementDefinitionPatternAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ElementDefinitionPatternAnnotation) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternAttachment is the Attachment variant of ElementDefinitionPattern.
+type ElementDefinitionPatternAttachment struct {
+	Attachment
+}
+
+func (v ElementDefinitionPatternAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ElementDefinitionPatternAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ElementDefinitionPatternAttachment) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternCodeableConcept is the CodeableConcept variant of ElementDefinitionPattern.
+type ElementDefinitionPatternCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ElementDefinitionPatternCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ElementDefinitionPatternCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ElementDefinitionPatternCodeableConcept) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternCodeableReference is the CodeableReference variant of ElementDefinitionPattern.
+type ElementDefinitionPatternCodeableReference struct {
+	CodeableReference
+}
+
+func (v ElementDefinitionPatternCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ElementDefinitionPatternCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ElementDefinitionPatternCodeableReference) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternCoding is the Coding variant of ElementDefinitionPattern.
+type ElementDefinitionPatternCoding struct {
+	Coding
+}
+
+func (v ElementDefinitionPatternCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ElementDefinitionPatternCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ElementDefinitionPatternCoding) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternContactPoint is the ContactPoint variant of ElementDefinitionPattern.
+type ElementDefinitionPatternContactPoint struct {
+	ContactPoint
+}
+
+func (v ElementDefinitionPatternContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ElementDefinitionPatternContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ElementDefinitionPatternContactPoint) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternCount is the Count variant of ElementDefinitionPattern.
+type ElementDefinitionPatternCount struct {
+	Count
+}
+
+func (v ElementDefinitionPatternCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ElementDefinitionPatternCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ElementDefinitionPatternCount) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDistance is the Distance variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDistance struct {
+	Distance
+}
+
+func (v ElementDefinitionPatternDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ElementDefinitionPatternDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ElementDefinitionPatternDistance) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDuration is the Duration variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDuration struct {
+	Duration
+}
+
+func (v ElementDefinitionPatternDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ElementDefinitionPatternDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ElementDefinitionPatternDuration) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternHumanName is the HumanName variant of ElementDefinitionPattern.
+type ElementDefinitionPatternHumanName struct {
+	HumanName
+}
+
+func (v ElementDefinitionPatternHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ElementDefinitionPatternHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ElementDefinitionPatternHumanName) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternIdentifier is the Identifier variant of ElementDefinitionPattern.
+type ElementDefinitionPatternIdentifier struct {
+	Identifier
+}
+
+func (v ElementDefinitionPatternIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ElementDefinitionPatternIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ElementDefinitionPatternIdentifier) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternMoney is the Money variant of ElementDefinitionPattern.
+type ElementDefinitionPatternMoney struct {
+	Money
+}
+
+func (v ElementDefinitionPatternMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ElementDefinitionPatternMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ElementDefinitionPatternMoney) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternPeriod is the Period variant of ElementDefinitionPattern.
+type ElementDefinitionPatternPeriod struct {
+	Period
+}
+
+func (v ElementDefinitionPatternPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ElementDefinitionPatternPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ElementDefinitionPatternPeriod) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternQuantity is the Quantity variant of ElementDefinitionPattern.
+type ElementDefinitionPatternQuantity struct {
+	Quantity
+}
+
+func (v ElementDefinitionPatternQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ElementDefinitionPatternQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ElementDefinitionPatternQuantity) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternRange is the Range variant of ElementDefinitionPattern.
+type ElementDefinitionPatternRange struct {
+	Range
+}
+
+func (v ElementDefinitionPatternRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ElementDefinitionPatternRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ElementDefinitionPatternRange) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternRatio is the Ratio variant of ElementDefinitionPattern.
+type ElementDefinitionPatternRatio struct {
+	Ratio
+}
+
+func (v ElementDefinitionPatternRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ElementDefinitionPatternRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ElementDefinitionPatternRatio) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternRatioRange is the RatioRange variant of ElementDefinitionPattern.
+type ElementDefinitionPatternRatioRange struct {
+	RatioRange
+}
+
+func (v ElementDefinitionPatternRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ElementDefinitionPatternRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ElementDefinitionPatternRatioRange) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternReference is the Reference variant of ElementDefinitionPattern.
+type ElementDefinitionPatternReference struct {
+	Reference
+}
+
+func (v ElementDefinitionPatternReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ElementDefinitionPatternReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ElementDefinitionPatternReference) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternSampledData is the SampledData variant of ElementDefinitionPattern.
+type ElementDefinitionPatternSampledData struct {
+	SampledData
+}
+
+func (v ElementDefinitionPatternSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ElementDefinitionPatternSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ElementDefinitionPatternSampledData) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternSignature is the Signature variant of ElementDefinitionPattern.
+type ElementDefinitionPatternSignature struct {
+	Signature
+}
+
+func (v ElementDefinitionPatternSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ElementDefinitionPatternSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ElementDefinitionPatternSignature) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternTiming is the Timing variant of ElementDefinitionPattern.
+type ElementDefinitionPatternTiming struct {
+	Timing
+}
+
+func (v ElementDefinitionPatternTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ElementDefinitionPatternTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ElementDefinitionPatternTiming) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternContactDetail is the ContactDetail variant of ElementDefinitionPattern.
+type ElementDefinitionPatternContactDetail struct {
+	ContactDetail
+}
+
+func (v ElementDefinitionPatternContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ElementDefinitionPatternContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ElementDefinitionPatternContactDetail) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDataRequirement is the DataRequirement variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDataRequirement struct {
+	DataRequirement
+}
+
+func (v ElementDefinitionPatternDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ElementDefinitionPatternDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ElementDefinitionPatternDataRequirement) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternExpression is the Expression variant of ElementDefinitionPattern.
+type ElementDefinitionPatternExpression struct {
+	Expression
+}
+
+func (v ElementDefinitionPatternExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ElementDefinitionPatternExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ElementDefinitionPatternExpression) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternParameterDefinition is the ParameterDefinition variant of ElementDefinitionPattern.
+type ElementDefinitionPatternParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ElementDefinitionPatternParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ElementDefinitionPatternParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ElementDefinitionPatternParameterDefinition) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternRelatedArtifact is the RelatedArtifact variant of ElementDefinitionPattern.
+type ElementDefinitionPatternRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ElementDefinitionPatternRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ElementDefinitionPatternRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ElementDefinitionPatternRelatedArtifact) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternTriggerDefinition is the TriggerDefinition variant of ElementDefinitionPattern.
+type ElementDefinitionPatternTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ElementDefinitionPatternTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ElementDefinitionPatternTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ElementDefinitionPatternTriggerDefinition) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternUsageContext is the UsageContext variant of ElementDefinitionPattern.
+type ElementDefinitionPatternUsageContext struct {
+	UsageContext
+}
+
+func (v ElementDefinitionPatternUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ElementDefinitionPatternUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ElementDefinitionPatternUsageContext) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternAvailability is the Availability variant of ElementDefinitionPattern.
+type ElementDefinitionPatternAvailability struct {
+	Availability
+}
+
+func (v ElementDefinitionPatternAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ElementDefinitionPatternAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ElementDefinitionPatternAvailability) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternExtendedContactDetail is the ExtendedContactDetail variant of ElementDefinitionPattern.
+type ElementDefinitionPatternExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ElementDefinitionPatternExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ElementDefinitionPatternExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ElementDefinitionPatternExtendedContactDetail) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternVirtualServiceDetail is the VirtualServiceDetail variant of ElementDefinitionPattern.
+type ElementDefinitionPatternVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ElementDefinitionPatternVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ElementDefinitionPatternVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ElementDefinitionPatternVirtualServiceDetail) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternDosage is the Dosage variant of ElementDefinitionPattern.
+type ElementDefinitionPatternDosage struct {
+	Dosage
+}
+
+func (v ElementDefinitionPatternDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ElementDefinitionPatternDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ElementDefinitionPatternDosage) isElementDefinitionPattern() {}
+
+// ElementDefinitionPatternMeta is the Meta variant of ElementDefinitionPattern.
+type ElementDefinitionPatternMeta struct {
+	Meta
+}
+
+func (v ElementDefinitionPatternMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ElementDefinitionPatternMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ElementDefinitionPatternMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ElementDefinitionPatternMeta) isElementDefinitionPattern() {}
+
+var elementDefinitionPatternVariants = []ElementDefinitionPattern{
+	ElementDefinitionPatternBase64Binary(""),
+	ElementDefinitionPatternBoolean(false),
+	ElementDefinitionPatternCanonical(""),
+	ElementDefinitionPatternCode(""),
+	ElementDefinitionPatternDate{},
+	ElementDefinitionPatternDateTime{},
+	ElementDefinitionPatternDecimal{},
+	ElementDefinitionPatternId(""),
+	ElementDefinitionPatternInstant{},
+	ElementDefinitionPatternInteger(0),
+	ElementDefinitionPatternInteger64(0),
+	ElementDefinitionPatternMarkdown(""),
+	ElementDefinitionPatternOid(""),
+	ElementDefinitionPatternPositiveInt(0),
+	ElementDefinitionPatternString(""),
+	ElementDefinitionPatternTime{},
+	ElementDefinitionPatternUnsignedInt(0),
+	ElementDefinitionPatternUri(""),
+	ElementDefinitionPatternUrl(""),
+	ElementDefinitionPatternUuid{},
+	ElementDefinitionPatternAddress{},
+	ElementDefinitionPatternAge{},
+	ElementDefinitionPatternAnnotation{},
+	ElementDefinitionPatternAttachment{},
+	ElementDefinitionPatternCodeableConcept{},
+	ElementDefinitionPatternCodeableReference{},
+	ElementDefinitionPatternCoding{},
+	ElementDefinitionPatternContactPoint{},
+	ElementDefinitionPatternCount{},
+	ElementDefinitionPatternDistance{},
+	ElementDefinitionPatternDuration{},
+	ElementDefinitionPatternHumanName{},
+	ElementDefinitionPatternIdentifier{},
+	ElementDefinitionPatternMoney{},
+	ElementDefinitionPatternPeriod{},
+	ElementDefinitionPatternQuantity{},
+	ElementDefinitionPatternRange{},
+	ElementDefinitionPatternRatio{},
+	ElementDefinitionPatternRatioRange{},
+	ElementDefinitionPatternReference{},
+	ElementDefinitionPatternSampledData{},
+	ElementDefinitionPatternSignature{},
+	ElementDefinitionPatternTiming{},
+	ElementDefinitionPatternContactDetail{},
+	ElementDefinitionPatternDataRequirement{},
+	ElementDefinitionPatternExpression{},
+	ElementDefinitionPatternParameterDefinition{},
+	ElementDefinitionPatternRelatedArtifact{},
+	ElementDefinitionPatternTriggerDefinition{},
+	ElementDefinitionPatternUsageContext{},
+	ElementDefinitionPatternAvailability{},
+	ElementDefinitionPatternExtendedContactDetail{},
+	ElementDefinitionPatternVirtualServiceDetail{},
+	ElementDefinitionPatternDosage{},
+	ElementDefinitionPatternMeta{},
+}
+
+// ElementDefinitionMinValue is the type of ElementDefinition.minValue[x]. It is implemented by the ElementDefinitionMinValue* variant types.
+type ElementDefinitionMinValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isElementDefinitionMinValue()
+}
+
+// ElementDefinitionMinValueDate is the date variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueDate struct {
+	Date
+}
+
+func (v ElementDefinitionMinValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ElementDefinitionMinValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMinValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ElementDefinitionMinValueDate) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueDateTime is the dateTime variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueDateTime struct {
+	DateTime
+}
+
+func (v ElementDefinitionMinValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ElementDefinitionMinValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMinValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ElementDefinitionMinValueDateTime) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueInstant is the instant variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueInstant struct {
+	Instant
+}
+
+func (v ElementDefinitionMinValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ElementDefinitionMinValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMinValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ElementDefinitionMinValueInstant) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueTime is the time variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueTime struct {
+	Time
+}
+
+func (v ElementDefinitionMinValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ElementDefinitionMinValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMinValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ElementDefinitionMinValueTime) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueDecimal is the decimal variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueDecimal struct {
+	Decimal
+}
+
+func (v ElementDefinitionMinValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ElementDefinitionMinValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMinValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ElementDefinitionMinValueDecimal) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueInteger is the integer variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueInteger int
+
+func (v ElementDefinitionMinValueInteger) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMinValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMinValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ElementDefinitionMinValueInteger) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueInteger64 is the integer64 variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueInteger64 int64
+
+func (v ElementDefinitionMinValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMinValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMinValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ElementDefinitionMinValueInteger64) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValuePositiveInt is the positiveInt variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValuePositiveInt int
+
+func (v ElementDefinitionMinValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMinValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMinValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ElementDefinitionMinValuePositiveInt) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueUnsignedInt is the unsignedInt variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueUnsignedInt int
+
+func (v ElementDefinitionMinValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMinValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMinValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ElementDefinitionMinValueUnsignedInt) isElementDefinitionMinValue() {}
+
+// ElementDefinitionMinValueQuantity is the Quantity variant of ElementDefinitionMinValue.
+type ElementDefinitionMinValueQuantity struct {
+	Quantity
+}
+
+func (v ElementDefinitionMinValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ElementDefinitionMinValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ElementDefinitionMinValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ElementDefinitionMinValueQuantity) isElementDefinitionMinValue() {}
+
+var elementDefinitionMinValueVariants = []ElementDefinitionMinValue{
+	ElementDefinitionMinValueDate{},
+	ElementDefinitionMinValueDateTime{},
+	ElementDefinitionMinValueInstant{},
+	ElementDefinitionMinValueTime{},
+	ElementDefinitionMinValueDecimal{},
+	ElementDefinitionMinValueInteger(0),
+	ElementDefinitionMinValueInteger64(0),
+	ElementDefinitionMinValuePositiveInt(0),
+	ElementDefinitionMinValueUnsignedInt(0),
+	ElementDefinitionMinValueQuantity{},
+}
+
+// ElementDefinitionMaxValue is the type of ElementDefinition.maxValue[x]. It is implemented by the ElementDefinitionMaxValue* variant types.
+type ElementDefinitionMaxValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isElementDefinitionMaxValue()
+}
+
+// ElementDefinitionMaxValueDate is the date variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueDate struct {
+	Date
+}
+
+func (v ElementDefinitionMaxValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ElementDefinitionMaxValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMaxValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ElementDefinitionMaxValueDate) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueDateTime is the dateTime variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueDateTime struct {
+	DateTime
+}
+
+func (v ElementDefinitionMaxValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ElementDefinitionMaxValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMaxValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ElementDefinitionMaxValueDateTime) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueInstant is the instant variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueInstant struct {
+	Instant
+}
+
+func (v ElementDefinitionMaxValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ElementDefinitionMaxValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMaxValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ElementDefinitionMaxValueInstant) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueTime is the time variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueTime struct {
+	Time
+}
+
+func (v ElementDefinitionMaxValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ElementDefinitionMaxValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMaxValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ElementDefinitionMaxValueTime) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueDecimal is the decimal variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueDecimal struct {
+	Decimal
+}
+
+func (v ElementDefinitionMaxValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ElementDefinitionMaxValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionMaxValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ElementDefinitionMaxValueDecimal) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueInteger is the integer variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueInteger int
+
+func (v ElementDefinitionMaxValueInteger) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMaxValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMaxValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ElementDefinitionMaxValueInteger) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueInteger64 is the integer64 variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueInteger64 int64
+
+func (v ElementDefinitionMaxValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMaxValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMaxValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ElementDefinitionMaxValueInteger64) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValuePositiveInt is the positiveInt variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValuePositiveInt int
+
+func (v ElementDefinitionMaxValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMaxValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMaxValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ElementDefinitionMaxValuePositiveInt) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueUnsignedInt is the unsignedInt variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueUnsignedInt int
+
+func (v ElementDefinitionMaxValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionMaxValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionMaxValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ElementDefinitionMaxValueUnsignedInt) isElementDefinitionMaxValue() {}
+
+// ElementDefinitionMaxValueQuantity is the Quantity variant of ElementDefinitionMaxValue.
+type ElementDefinitionMaxValueQuantity struct {
+	Quantity
+}
+
+func (v ElementDefinitionMaxValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ElementDefinitionMaxValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ElementDefinitionMaxValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ElementDefinitionMaxValueQuantity) isElementDefinitionMaxValue() {}
+
+var elementDefinitionMaxValueVariants = []ElementDefinitionMaxValue{
+	ElementDefinitionMaxValueDate{},
+	ElementDefinitionMaxValueDateTime{},
+	ElementDefinitionMaxValueInstant{},
+	ElementDefinitionMaxValueTime{},
+	ElementDefinitionMaxValueDecimal{},
+	ElementDefinitionMaxValueInteger(0),
+	ElementDefinitionMaxValueInteger64(0),
+	ElementDefinitionMaxValuePositiveInt(0),
+	ElementDefinitionMaxValueUnsignedInt(0),
+	ElementDefinitionMaxValueQuantity{},
+}
+
+type ElementDefinitionSlicing struct {
+	Id                 *string                                 `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
+	Extension          []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`              // Additional content defined by implementations
+	Discriminator      []ElementDefinitionSlicingDiscriminator `json:"discriminator,omitempty" bson:"discriminator,omitempty"`      // Element values that are used to distinguish the slices
+	Description        *string                                 `json:"description,omitempty" bson:"description,omitempty"`          // Text description of how slicing works (or not)
+	DescriptionElement *Element                                `json:"_description,omitempty" bson:"description_element,omitempty"` // Extensions for description
+	Ordered            *bool                                   `json:"ordered,omitempty" bson:"ordered,omitempty"`                  // If elements must be in same order as slices
+	OrderedElement     *Element                                `json:"_ordered,omitempty" bson:"ordered_element,omitempty"`         // Extensions for ordered
+	Rules              SlicingRules                            `json:"rules" bson:"rules"`                                          // closed | open | openAtEnd
+	RulesElement       *Element                                `json:"_rules,omitempty" bson:"rules_element,omitempty"`             // Extensions for rules
+}
+
+func (r *ElementDefinitionSlicing) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Discriminator {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Discriminator[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.OrderedElement != nil {
+		if err := r.OrderedElement.Validate(); err != nil {
+			return fmt.Errorf("OrderedElement: %w", err)
+		}
+	}
+	if r.Rules == "" {
+		return fmt.Errorf("field 'Rules' is required")
+	}
+	if !r.Rules.IsValid() {
+		return fmt.Errorf("field 'Rules' has invalid code '%s'", r.Rules)
+	}
+	if r.RulesElement != nil {
+		if err := r.RulesElement.Validate(); err != nil {
+			return fmt.Errorf("RulesElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ElementDefinitionSlicing) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.Discriminator {
+		item.validateAll(fmt.Sprintf("%s.discriminator[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.OrderedElement != nil {
+		r.OrderedElement.validateAll(path+".ordered", issues)
+	}
+	if r.Rules == "" {
+		issues.add("required", path+".rules", "field 'Rules' is required")
+	}
+	if r.Rules != "" && !r.Rules.IsValid() {
+		issues.add("code-invalid", path+".rules", fmt.Sprintf("field 'Rules' has invalid code '%s'", r.Rules))
+	}
+	if r.RulesElement != nil {
+		r.RulesElement.validateAll(path+".rules", issues)
+	}
+}
+
+var elementDefinitionSlicingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionSlicing) invariants() []invariant {
+	return elementDefinitionSlicingInvariants
+}
+
+func (r ElementDefinitionSlicing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionSlicing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionSlicing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionSlicing) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionSlicingElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.slicing.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.slicing.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.slicing.discriminator", Name: "discriminator", Field: 2, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+	{Path: "ElementDefinition.slicing.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.slicing.ordered", Name: "ordered", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.slicing.rules", Name: "rules", Field: 7, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.slicing.rules", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/resource-slicing-rules", Description: "How slices are interpreted when evaluating an instance."}, Summary: true},
+}
+
+func (r *ElementDefinitionSlicing) ElementMetadata() []ElementMetadata {
+	return elementDefinitionSlicingElementMetadata
+}
+
+type ElementDefinitionSlicingDiscriminator struct {
+	Id          *string           `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension   []Extension       `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Type        DiscriminatorType `json:"type" bson:"type"`                               // value | exists | type | profile | position
+	TypeElement *Element          `json:"_type,omitempty" bson:"type_element,omitempty"`  // Extensions for type
+	Path        string            `json:"path" bson:"path"`                               // Path to element value
+	PathElement *Element          `json:"_path,omitempty" bson:"path_element,omitempty"`  // Extensions for path
+}
+
+func (r *ElementDefinitionSlicingDiscriminator) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		return fmt.Errorf("field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ElementDefinitionSlicingDiscriminator) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		issues.add("required", path+".path", "field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+}
+
+var elementDefinitionSlicingDiscriminatorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionSlicingDiscriminator) invariants() []invariant {
+	return elementDefinitionSlicingDiscriminatorInvariants
+}
+
+func (r ElementDefinitionSlicingDiscriminator) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionSlicingDiscriminator) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionSlicingDiscriminator) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionSlicingDiscriminator) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionSlicingDiscriminatorElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.slicing.discriminator.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.slicing.discriminator.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.slicing.discriminator.type", Name: "type", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.slicing.discriminator.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/discriminator-type", Description: "How an element value is interpreted when discrimination is evaluated."}, Summary: true},
+	{Path: "ElementDefinition.slicing.discriminator.path", Name: "path", Field: 4, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *ElementDefinitionSlicingDiscriminator) ElementMetadata() []ElementMetadata {
+	return elementDefinitionSlicingDiscriminatorElementMetadata
+}
+
+type ElementDefinitionBase struct {
+	Id          *string     `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension   []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Path        string      `json:"path" bson:"path"`                               // Path that identifies the base element
+	PathElement *Element    `json:"_path,omitempty" bson:"path_element,omitempty"`  // Extensions for path
+	Min         int         `json:"min" bson:"min"`                                 // Min cardinality of the base element
+	MinElement  *Element    `json:"_min,omitempty" bson:"min_element,omitempty"`    // Extensions for min
+	Max         string      `json:"max" bson:"max"`                                 // Max cardinality of the base element
+	MaxElement  *Element    `json:"_max,omitempty" bson:"max_element,omitempty"`    // Extensions for max
+}
+
+func (r *ElementDefinitionBase) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		return fmt.Errorf("field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	if r.Min == 0 {
+		return fmt.Errorf("field 'Min' is required")
+	}
+	if r.MinElement != nil {
+		if err := r.MinElement.Validate(); err != nil {
+			return fmt.Errorf("MinElement: %w", err)
+		}
+	}
+	if r.Max == emptyString {
+		return fmt.Errorf("field 'Max' is required")
+	}
+	if r.MaxElement != nil {
+		if err := r.MaxElement.Validate(); err != nil {
+			return fmt.Errorf("MaxElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ElementDefinitionBase) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Path == emptyString {
+		issues.add("required", path+".path", "field 'Path' is required")
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.Min == 0 {
+		issues.add("required", path+".min", "field 'Min' is required")
+	}
+	if r.MinElement != nil {
+		r.MinElement.validateAll(path+".min", issues)
+	}
+	if r.Max == emptyString {
+		issues.add("required", path+".max", "field 'Max' is required")
+	}
+	if r.MaxElement != nil {
+		r.MaxElement.validateAll(path+".max", issues)
+	}
+}
+
+var elementDefinitionBaseInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionBase) invariants() []invariant {
+	return elementDefinitionBaseInvariants
+}
+
+func (r ElementDefinitionBase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionBase) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionBase) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionBase) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionBaseElementTypes = map[string]string{
+	"min": "unsignedInt",
+}
+
+func (r *ElementDefinitionBase) elementTypes() map[string]string {
+	return elementDefinitionBaseElementTypes
+}
+
+var elementDefinitionBaseElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.base.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.base.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.base.path", Name: "path", Field: 2, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.base.min", Name: "min", Field: 4, Min: 1, Max: "1", Types: []string{"unsignedInt"}, Summary: true},
+	{Path: "ElementDefinition.base.max", Name: "max", Field: 6, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *ElementDefinitionBase) ElementMetadata() []ElementMetadata {
+	return elementDefinitionBaseElementMetadata
+}
+
+type ElementDefinitionType struct {
+	Id                   *string                `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	Code                 string                 `json:"code" bson:"code"`                                                 // Data type or Resource (reference to definition)
+	CodeElement          *Element               `json:"_code,omitempty" bson:"code_element,omitempty"`                    // Extensions for code
+	Profile              []string               `json:"profile,omitempty" bson:"profile,omitempty"`                       // Profiles (StructureDefinition or IG) - one must apply
+	ProfileElement       []*Element             `json:"_profile,omitempty" bson:"profile_element,omitempty"`              // Extensions for profile
+	TargetProfile        []string               `json:"targetProfile,omitempty" bson:"target_profile,omitempty"`          // Profile (StructureDefinition or IG) on the Reference/canonical target - one must apply
+	TargetProfileElement []*Element             `json:"_targetProfile,omitempty" bson:"target_profile_element,omitempty"` // Extensions for targetProfile
+	Aggregation          []AggregationMode      `json:"aggregation,omitempty" bson:"aggregation,omitempty"`               // contained | referenced | bundled - how aggregated
+	AggregationElement   []*Element             `json:"_aggregation,omitempty" bson:"aggregation_element,omitempty"`      // Extensions for aggregation
+	Versioning           *ReferenceVersionRules `json:"versioning,omitempty" bson:"versioning,omitempty"`                 // either | independent | specific
+	VersioningElement    *Element               `json:"_versioning,omitempty" bson:"versioning_element,omitempty"`        // Extensions for versioning
+}
+
+func (r *ElementDefinitionType) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TargetProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TargetProfileElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Aggregation {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Aggregation[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.AggregationElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AggregationElement[%d]: %w", i, err)
+		}
+	}
+	if r.Versioning != nil && !r.Versioning.IsValid() {
+		return fmt.Errorf("field 'Versioning' has invalid code '%s'", *r.Versioning)
+	}
+	if r.VersioningElement != nil {
+		if err := r.VersioningElement.Validate(); err != nil {
+			return fmt.Errorf("VersioningElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ElementDefinitionType) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profile[%d]", path, i), issues)
+	}
+	for i, item := range r.TargetProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.targetProfile[%d]", path, i), issues)
+	}
+	for i, item := range r.Aggregation {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.aggregation[%d]", path, i), fmt.Sprintf("field 'Aggregation[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.AggregationElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.aggregation[%d]", path, i), issues)
+	}
+	if r.Versioning != nil && !r.Versioning.IsValid() {
+		issues.add("code-invalid", path+".versioning", fmt.Sprintf("field 'Versioning' has invalid code '%s'", *r.Versioning))
+	}
+	if r.VersioningElement != nil {
+		r.VersioningElement.validateAll(path+".versioning", issues)
+	}
+}
+
+var elementDefinitionTypeInvariants = []invariant{
+	{key: "eld-4", severity: "error", human: "Aggregation may only be specified if one of the allowed types for the element is a reference", expression: "aggregation.empty() or (code = 'Reference') or (code = 'canonical') or (code = 'CodeableReference')"},
+	{key: "eld-17", severity: "error", human: "targetProfile is only allowed if the type is Reference or canonical", expression: "(code='Reference' or code = 'canonical' or code = 'CodeableReference') or targetProfile.empty()"},
+	{key: "eld-29", severity: "warning", human: "profiles SHOULD be unique", expression: "profile.isDistinct()"},
+	{key: "eld-30", severity: "warning", human: "targetProfiles SHOULD be unique", expression: "targetProfile.isDistinct()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionType) invariants() []invariant {
+	return elementDefinitionTypeInvariants
+}
+
+func (r ElementDefinitionType) MarshalJSON() ([]byte, error) {
+	type alias ElementDefinitionType
+	out := struct {
+		alias
+		Profile              []*string          `json:"profile,omitempty"`
+		ProfileElement       []*Element         `json:"_profile,omitempty"`
+		TargetProfile        []*string          `json:"targetProfile,omitempty"`
+		TargetProfileElement []*Element         `json:"_targetProfile,omitempty"`
+		Aggregation          []*AggregationMode `json:"aggregation,omitempty"`
+		AggregationElement   []*Element         `json:"_aggregation,omitempty"`
+	}{alias: alias(r)}
+	out.Profile, out.ProfileElement = alignPrimitiveArray(r.Profile, r.ProfileElement)
+	out.TargetProfile, out.TargetProfileElement = alignPrimitiveArray(r.TargetProfile, r.TargetProfileElement)
+	out.Aggregation, out.AggregationElement = alignPrimitiveArray(r.Aggregation, r.AggregationElement)
+	return json.Marshal(out)
+}
+
+func (r *ElementDefinitionType) UnmarshalJSON(data []byte) error {
+	type alias ElementDefinitionType
+	aux := struct {
+		*alias
+		Profile       []*string          `json:"profile,omitempty"`
+		TargetProfile []*string          `json:"targetProfile,omitempty"`
+		Aggregation   []*AggregationMode `json:"aggregation,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Profile, err = splitPrimitiveArray(aux.Profile, r.ProfileElement); err != nil {
+		return fmt.Errorf("profile: %w", err)
+	}
+	if r.TargetProfile, err = splitPrimitiveArray(aux.TargetProfile, r.TargetProfileElement); err != nil {
+		return fmt.Errorf("targetProfile: %w", err)
+	}
+	if r.Aggregation, err = splitPrimitiveArray(aux.Aggregation, r.AggregationElement); err != nil {
+		return fmt.Errorf("aggregation: %w", err)
+	}
+	return nil
+}
+
+func (r ElementDefinitionType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionType) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionType) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionTypeElementTypes = map[string]string{
+	"code":          "uri",
+	"profile":       "canonical",
+	"targetProfile": "canonical",
+}
+
+func (r *ElementDefinitionType) elementTypes() map[string]string {
+	return elementDefinitionTypeElementTypes
+}
+
+var elementDefinitionTypeElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.type.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.type.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.type.code", Name: "code", Field: 2, Min: 1, Max: "1", Types: []string{"uri"}, Binding: &BindingDefinition{Path: "ElementDefinition.type.code", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/elementdefinition-types", Description: "List of all Types for ElementDefinition.type.code."}, Summary: true},
+	{Path: "ElementDefinition.type.profile", Name: "profile", Field: 4, Min: 0, Max: "*", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide"}, Summary: true},
+	{Path: "ElementDefinition.type.targetProfile", Name: "targetProfile", Field: 6, Min: 0, Max: "*", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide"}, Summary: true},
+	{Path: "ElementDefinition.type.aggregation", Name: "aggregation", Field: 8, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.type.aggregation", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/resource-aggregation-mode", Description: "How resource references can be aggregated."}, Summary: true},
+	{Path: "ElementDefinition.type.versioning", Name: "versioning", Field: 10, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.type.versioning", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/reference-version-rules", Description: "Whether a reference needs to be version specific or version independent, or whether either can be used."}, Summary: true},
+}
+
+func (r *ElementDefinitionType) ElementMetadata() []ElementMetadata {
+	return elementDefinitionTypeElementMetadata
+}
+
+type ElementDefinitionExample struct {
+	Id           *string                       `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
+	Extension    []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`  // Additional content defined by implementations
+	Label        string                        `json:"label" bson:"label"`                              // Describes the purpose of this example
+	LabelElement *Element                      `json:"_label,omitempty" bson:"label_element,omitempty"` // Extensions for label
+	Value        ElementDefinitionExampleValue `json:"-" bson:"value,omitempty"`                        // Value of Example (one of allowed types)
+	ValueElement *Element                      `json:"-" bson:"value_element,omitempty"`                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of ElementDefinition.example.value[x] when more than one was decoded
+}
+
+func (r *ElementDefinitionExample) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Label == emptyString {
+		return fmt.Errorf("field 'Label' is required")
+	}
+	if r.LabelElement != nil {
+		if err := r.LabelElement.Validate(); err != nil {
+			return fmt.Errorf("LabelElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ElementDefinitionExample) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Label == emptyString {
+		issues.add("required", path+".label", "field 'Label' is required")
+	}
+	if r.LabelElement != nil {
+		r.LabelElement.validateAll(path+".label", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var elementDefinitionExampleInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionExample) invariants() []invariant {
+	return elementDefinitionExampleInvariants
+}
+
+func (r ElementDefinitionExample) MarshalJSON() ([]byte, error) {
+	type alias ElementDefinitionExample
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ElementDefinitionExample) UnmarshalJSON(data []byte) error {
+	type alias ElementDefinitionExample
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", elementDefinitionExampleValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ElementDefinitionExample) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionExample) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionExample) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionExample) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ElementDefinitionExample) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(elementDefinitionExampleValueVariants)
+	}
+	return "", nil
+}
+
+var elementDefinitionExampleElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.example.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.example.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.example.label", Name: "label", Field: 2, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.example.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}, Summary: true},
+}
+
+func (r *ElementDefinitionExample) ElementMetadata() []ElementMetadata {
+	return elementDefinitionExampleElementMetadata
+}
+
+// ElementDefinitionExampleValue is the type of ElementDefinition.example.value[x]. It is implemented by the ElementDefinitionExampleValue* variant types.
+type ElementDefinitionExampleValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isElementDefinitionExampleValue()
+}
+
+// ElementDefinitionExampleValueBase64Binary is the base64Binary variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueBase64Binary string
+
+func (v ElementDefinitionExampleValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueBase64Binary) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ElementDefinitionExampleValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ElementDefinitionExampleValueBase64Binary) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueBoolean is the boolean variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueBoolean bool
+
+func (v ElementDefinitionExampleValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ElementDefinitionExampleValueBoolean) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueCanonical is the canonical variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueCanonical string
+
+func (v ElementDefinitionExampleValueCanonical) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ElementDefinitionExampleValueCanonical) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueCode is the code variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueCode string
+
+func (v ElementDefinitionExampleValueCode) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ElementDefinitionExampleValueCode) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDate is the date variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDate struct {
+	Date
+}
+
+func (v ElementDefinitionExampleValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ElementDefinitionExampleValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionExampleValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ElementDefinitionExampleValueDate) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDateTime is the dateTime variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDateTime struct {
+	DateTime
+}
+
+func (v ElementDefinitionExampleValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ElementDefinitionExampleValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionExampleValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ElementDefinitionExampleValueDateTime) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDecimal is the decimal variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDecimal struct {
+	Decimal
+}
+
+func (v ElementDefinitionExampleValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ElementDefinitionExampleValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionExampleValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ElementDefinitionExampleValueDecimal) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueId is the id variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueId string
+
+func (v ElementDefinitionExampleValueId) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ElementDefinitionExampleValueId) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueInstant is the instant variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueInstant struct {
+	Instant
+}
+
+func (v ElementDefinitionExampleValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ElementDefinitionExampleValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionExampleValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ElementDefinitionExampleValueInstant) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueInteger is the integer variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueInteger int
+
+func (v ElementDefinitionExampleValueInteger) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ElementDefinitionExampleValueInteger) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueInteger64 is the integer64 variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueInteger64 int64
+
+func (v ElementDefinitionExampleValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ElementDefinitionExampleValueInteger64) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueMarkdown is the markdown variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueMarkdown string
+
+func (v ElementDefinitionExampleValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ElementDefinitionExampleValueMarkdown) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueOid is the oid variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueOid string
+
+func (v ElementDefinitionExampleValueOid) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ElementDefinitionExampleValueOid) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValuePositiveInt is the positiveInt variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValuePositiveInt int
+
+func (v ElementDefinitionExampleValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValuePositiveInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ElementDefinitionExampleValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ElementDefinitionExampleValuePositiveInt) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueString is the string variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueString string
+
+func (v ElementDefinitionExampleValueString) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ElementDefinitionExampleValueString) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueTime is the time variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueTime struct {
+	Time
+}
+
+func (v ElementDefinitionExampleValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ElementDefinitionExampleValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ElementDefinitionExampleValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ElementDefinitionExampleValueTime) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueUnsignedInt is the unsignedInt variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueUnsignedInt int
+
+func (v ElementDefinitionExampleValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ElementDefinitionExampleValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ElementDefinitionExampleValueUnsignedInt) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueUri is the uri variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueUri string
+
+func (v ElementDefinitionExampleValueUri) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ElementDefinitionExampleValueUri) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueUrl is the url variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueUrl string
+
+func (v ElementDefinitionExampleValueUrl) Validate() error {
+	return nil
+}
+
+func (v ElementDefinitionExampleValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ElementDefinitionExampleValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ElementDefinitionExampleValueUrl) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueUuid is the uuid variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueUuid struct {
+	uuid
+}
+
+func (v ElementDefinitionExampleValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ElementDefinitionExampleValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ElementDefinitionExampleValueUuid) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueAddress is the Address variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueAddress struct {
+	Address
+}
+
+func (v ElementDefinitionExampleValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ElementDefinitionExampleValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ElementDefinitionExampleValueAddress) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueAge is the Age variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueAge struct {
+	Age
+}
+
+func (v ElementDefinitionExampleValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ElementDefinitionExampleValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ElementDefinitionExampleValueAge) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueAnnotation is the Annotation variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueAnnotation struct {
+	Annotation
+}
+
+func (v ElementDefinitionExampleValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ElementDefinitionExampleValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ElementDefinitionExampleValueAnnotation) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueAttachment is the Attachment variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueAttachment struct {
+	Attachment
+}
+
+func (v ElementDefinitionExampleValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ElementDefinitionExampleValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ElementDefinitionExampleValueAttachment) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueCodeableConcept is the CodeableConcept variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ElementDefinitionExampleValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ElementDefinitionExampleValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ElementDefinitionExampleValueCodeableConcept) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueCodeableReference is the CodeableReference variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ElementDefinitionExampleValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ElementDefinitionExampleValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ElementDefinitionExampleValueCodeableReference) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueCoding is the Coding variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueCoding struct {
+	Coding
+}
+
+func (v ElementDefinitionExampleValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ElementDefinitionExampleValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ElementDefinitionExampleValueCoding) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueContactPoint is the ContactPoint variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ElementDefinitionExampleValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ElementDefinitionExampleValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ElementDefinitionExampleValueContactPoint) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueCount is the Count variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueCount struct {
+	Count
+}
+
+func (v ElementDefinitionExampleValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ElementDefinitionExampleValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ElementDefinitionExampleValueCount) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDistance is the Distance variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDistance struct {
+	Distance
+}
+
+func (v ElementDefinitionExampleValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ElementDefinitionExampleValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ElementDefinitionExampleValueDistance) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDuration is the Duration variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDuration struct {
+	Duration
+}
+
+func (v ElementDefinitionExampleValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ElementDefinitionExampleValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ElementDefinitionExampleValueDuration) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueHumanName is the HumanName variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueHumanName struct {
+	HumanName
+}
+
+func (v ElementDefinitionExampleValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ElementDefinitionExampleValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ElementDefinitionExampleValueHumanName) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueIdentifier is the Identifier variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueIdentifier struct {
+	Identifier
+}
+
+func (v ElementDefinitionExampleValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ElementDefinitionExampleValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ElementDefinitionExampleValueIdentifier) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueMoney is the Money variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueMoney struct {
+	Money
+}
+
+func (v ElementDefinitionExampleValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ElementDefinitionExampleValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ElementDefinitionExampleValueMoney) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValuePeriod is the Period variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValuePeriod struct {
+	Period
+}
+
+func (v ElementDefinitionExampleValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ElementDefinitionExampleValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ElementDefinitionExampleValuePeriod) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueQuantity is the Quantity variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueQuantity struct {
+	Quantity
+}
+
+func (v ElementDefinitionExampleValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ElementDefinitionExampleValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ElementDefinitionExampleValueQuantity) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueRange is the Range variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueRange struct {
+	Range
+}
+
+func (v ElementDefinitionExampleValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ElementDefinitionExampleValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ElementDefinitionExampleValueRange) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueRatio is the Ratio variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueRatio struct {
+	Ratio
+}
+
+func (v ElementDefinitionExampleValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ElementDefinitionExampleValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ElementDefinitionExampleValueRatio) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueRatioRange is the RatioRange variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueRatioRange struct {
+	RatioRange
+}
+
+func (v ElementDefinitionExampleValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ElementDefinitionExampleValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ElementDefinitionExampleValueRatioRange) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueReference is the Reference variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueReference struct {
+	Reference
+}
+
+func (v ElementDefinitionExampleValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ElementDefinitionExampleValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ElementDefinitionExampleValueReference) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueSampledData is the SampledData variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueSampledData struct {
+	SampledData
+}
+
+func (v ElementDefinitionExampleValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ElementDefinitionExampleValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ElementDefinitionExampleValueSampledData) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueSignature is the Signature variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueSignature struct {
+	Signature
+}
+
+func (v ElementDefinitionExampleValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ElementDefinitionExampleValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ElementDefinitionExampleValueSignature) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueTiming is the Timing variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueTiming struct {
+	Timing
+}
+
+func (v ElementDefinitionExampleValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ElementDefinitionExampleValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ElementDefinitionExampleValueTiming) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueContactDetail is the ContactDetail variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ElementDefinitionExampleValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ElementDefinitionExampleValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ElementDefinitionExampleValueContactDetail) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDataRequirement is the DataRequirement variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ElementDefinitionExampleValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ElementDefinitionExampleValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ElementDefinitionExampleValueDataRequirement) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueExpression is the Expression variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueExpression struct {
+	Expression
+}
+
+func (v ElementDefinitionExampleValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ElementDefinitionExampleValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ElementDefinitionExampleValueExpression) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueParameterDefinition is the ParameterDefinition variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ElementDefinitionExampleValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ElementDefinitionExampleValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ElementDefinitionExampleValueParameterDefinition) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueRelatedArtifact is the RelatedArtifact variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ElementDefinitionExampleValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ElementDefinitionExampleValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ElementDefinitionExampleValueRelatedArtifact) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueTriggerDefinition is the TriggerDefinition variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ElementDefinitionExampleValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ElementDefinitionExampleValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ElementDefinitionExampleValueTriggerDefinition) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueUsageContext is the UsageContext variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueUsageContext struct {
+	UsageContext
+}
+
+func (v ElementDefinitionExampleValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ElementDefinitionExampleValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ElementDefinitionExampleValueUsageContext) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueAvailability is the Availability variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueAvailability struct {
+	Availability
+}
+
+func (v ElementDefinitionExampleValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ElementDefinitionExampleValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ElementDefinitionExampleValueAvailability) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueExtendedContactDetail is the ExtendedContactDetail variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ElementDefinitionExampleValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ElementDefinitionExampleValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ElementDefinitionExampleValueExtendedContactDetail) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueVirtualServiceDetail is the VirtualServiceDetail variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ElementDefinitionExampleValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ElementDefinitionExampleValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ElementDefinitionExampleValueVirtualServiceDetail) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueDosage is the Dosage variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueDosage struct {
+	Dosage
+}
+
+func (v ElementDefinitionExampleValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ElementDefinitionExampleValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ElementDefinitionExampleValueDosage) isElementDefinitionExampleValue() {}
+
+// ElementDefinitionExampleValueMeta is the Meta variant of ElementDefinitionExampleValue.
+type ElementDefinitionExampleValueMeta struct {
+	Meta
+}
+
+func (v ElementDefinitionExampleValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ElementDefinitionExampleValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ElementDefinitionExampleValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ElementDefinitionExampleValueMeta) isElementDefinitionExampleValue() {}
+
+var elementDefinitionExampleValueVariants = []ElementDefinitionExampleValue{
+	ElementDefinitionExampleValueBase64Binary(""),
+	ElementDefinitionExampleValueBoolean(false),
+	ElementDefinitionExampleValueCanonical(""),
+	ElementDefinitionExampleValueCode(""),
+	ElementDefinitionExampleValueDate{},
+	ElementDefinitionExampleValueDateTime{},
+	ElementDefinitionExampleValueDecimal{},
+	ElementDefinitionExampleValueId(""),
+	ElementDefinitionExampleValueInstant{},
+	ElementDefinitionExampleValueInteger(0),
+	ElementDefinitionExampleValueInteger64(0),
+	ElementDefinitionExampleValueMarkdown(""),
+	ElementDefinitionExampleValueOid(""),
+	ElementDefinitionExampleValuePositiveInt(0),
+	ElementDefinitionExampleValueString(""),
+	ElementDefinitionExampleValueTime{},
+	ElementDefinitionExampleValueUnsignedInt(0),
+	ElementDefinitionExampleValueUri(""),
+	ElementDefinitionExampleValueUrl(""),
+	ElementDefinitionExampleValueUuid{},
+	ElementDefinitionExampleValueAddress{},
+	ElementDefinitionExampleValueAge{},
+	ElementDefinitionExampleValueAnnotation{},
+	ElementDefinitionExampleValueAttachment{},
+	ElementDefinitionExampleValueCodeableConcept{},
+	ElementDefinitionExampleValueCodeableReference{},
+	ElementDefinitionExampleValueCoding{},
+	ElementDefinitionExampleValueContactPoint{},
+	ElementDefinitionExampleValueCount{},
+	ElementDefinitionExampleValueDistance{},
+	ElementDefinitionExampleValueDuration{},
+	ElementDefinitionExampleValueHumanName{},
+	ElementDefinitionExampleValueIdentifier{},
+	ElementDefinitionExampleValueMoney{},
+	ElementDefinitionExampleValuePeriod{},
+	ElementDefinitionExampleValueQuantity{},
+	ElementDefinitionExampleValueRange{},
+	ElementDefinitionExampleValueRatio{},
+	ElementDefinitionExampleValueRatioRange{},
+	ElementDefinitionExampleValueReference{},
+	ElementDefinitionExampleValueSampledData{},
+	ElementDefinitionExampleValueSignature{},
+	ElementDefinitionExampleValueTiming{},
+	ElementDefinitionExampleValueContactDetail{},
+	ElementDefinitionExampleValueDataRequirement{},
+	ElementDefinitionExampleValueExpression{},
+	ElementDefinitionExampleValueParameterDefinition{},
+	ElementDefinitionExampleValueRelatedArtifact{},
+	ElementDefinitionExampleValueTriggerDefinition{},
+	ElementDefinitionExampleValueUsageContext{},
+	ElementDefinitionExampleValueAvailability{},
+	ElementDefinitionExampleValueExtendedContactDetail{},
+	ElementDefinitionExampleValueVirtualServiceDetail{},
+	ElementDefinitionExampleValueDosage{},
+	ElementDefinitionExampleValueMeta{},
+}
+
+type ElementDefinitionConstraint struct {
+	Id                  *string            `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
+	Extension           []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                // Additional content defined by implementations
+	Key                 string             `json:"key" bson:"key"`                                                // Target of 'condition' reference above
+	KeyElement          *Element           `json:"_key,omitempty" bson:"key_element,omitempty"`                   // Extensions for key
+	Requirements        *string            `json:"requirements,omitempty" bson:"requirements,omitempty"`          // Why this constraint is necessary or appropriate
+	RequirementsElement *Element           `json:"_requirements,omitempty" bson:"requirements_element,omitempty"` // Extensions for requirements
+	Severity            ConstraintSeverity `json:"severity" bson:"severity"`                                      // error | warning
+	SeverityElement     *Element           `json:"_severity,omitempty" bson:"severity_element,omitempty"`         // Extensions for severity
+	Suppress            *bool              `json:"suppress,omitempty" bson:"suppress,omitempty"`                  // Suppress warning or hint in profile
+	SuppressElement     *Element           `json:"_suppress,omitempty" bson:"suppress_element,omitempty"`         // Extensions for suppress
+	Human               string             `json:"human" bson:"human"`                                            // Human description of constraint
+	HumanElement        *Element           `json:"_human,omitempty" bson:"human_element,omitempty"`               // Extensions for human
+	Expression          *string            `json:"expression,omitempty" bson:"expression,omitempty"`              // FHIRPath expression of constraint
+	ExpressionElement   *Element           `json:"_expression,omitempty" bson:"expression_element,omitempty"`     // Extensions for expression
+	Source              *string            `json:"source,omitempty" bson:"source,omitempty"`                      // Reference to original source of constraint
+	SourceElement       *Element           `json:"_source,omitempty" bson:"source_element,omitempty"`             // Extensions for source
+}
+
+func (r *ElementDefinitionConstraint) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	var emptyString string
+	if r.Key == emptyString {
+		return fmt.Errorf("field 'Key' is required")
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
 	}
-	if r.ValueId == nil {
-		return fmt.Errorf("field 'ValueId' is required")
+	if r.RequirementsElement != nil {
+		if err := r.RequirementsElement.Validate(); err != nil {
+			return fmt.Errorf("RequirementsElement: %w", err)
+		}
 	}
-	if r.ValueInstant == nil {
-		return fmt.Errorf("field 'ValueInstant' is required")
+	if r.Severity == "" {
+		return fmt.Errorf("field 'Severity' is required")
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if !r.Severity.IsValid() {
+		return fmt.Errorf("field 'Severity' has invalid code '%s'", r.Severity)
 	}
-	if r.ValueInteger64 == nil {
-		return fmt.Errorf("field 'ValueInteger64' is required")
+	if r.SeverityElement != nil {
+		if err := r.SeverityElement.Validate(); err != nil {
+			return fmt.Errorf("SeverityElement: %w", err)
+		}
 	}
-	if r.ValueMarkdown == nil {
-		return fmt.Errorf("field 'ValueMarkdown' is required")
+	if r.SuppressElement != nil {
+		if err := r.SuppressElement.Validate(); err != nil {
+			return fmt.Errorf("SuppressElement: %w", err)
+		}
 	}
-	if r.ValueOid == nil {
-		return fmt.Errorf("field 'ValueOid' is required")
+	if r.Human == emptyString {
+		return fmt.Errorf("field 'Human' is required")
 	}
-	if r.ValuePositiveInt == nil {
-		return fmt.Errorf("field 'ValuePositiveInt' is required")
+	if r.HumanElement != nil {
+		if err := r.HumanElement.Validate(); err != nil {
+			return fmt.Errorf("HumanElement: %w", err)
+		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.ExpressionElement != nil {
+		if err := r.ExpressionElement.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement: %w", err)
+		}
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	if r.SourceElement != nil {
+		if err := r.SourceElement.Validate(); err != nil {
+			return fmt.Errorf("SourceElement: %w", err)
+		}
 	}
-	if r.ValueUnsignedInt == nil {
-		return fmt.Errorf("field 'ValueUnsignedInt' is required")
+	return nil
+}
+
+func (r *ElementDefinitionConstraint) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	var emptyString string
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
 	}
-	if r.ValueUrl == nil {
-		return fmt.Errorf("field 'ValueUrl' is required")
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
 	}
-	if r.ValueUuid == nil {
-		return fmt.Errorf("field 'ValueUuid' is required")
+	if r.RequirementsElement != nil {
+		r.RequirementsElement.validateAll(path+".requirements", issues)
 	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
+	if r.Severity == "" {
+		issues.add("required", path+".severity", "field 'Severity' is required")
 	}
-	if r.ValueAddress == nil {
-		return fmt.Errorf("field 'ValueAddress' is required")
+	if r.Severity != "" && !r.Severity.IsValid() {
+		issues.add("code-invalid", path+".severity", fmt.Sprintf("field 'Severity' has invalid code '%s'", r.Severity))
 	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
+	if r.SeverityElement != nil {
+		r.SeverityElement.validateAll(path+".severity", issues)
 	}
-	if r.ValueAge == nil {
-		return fmt.Errorf("field 'ValueAge' is required")
+	if r.SuppressElement != nil {
+		r.SuppressElement.validateAll(path+".suppress", issues)
 	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
+	if r.Human == emptyString {
+		issues.add("required", path+".human", "field 'Human' is required")
 	}
-	if r.ValueAnnotation == nil {
-		return fmt.Errorf("field 'ValueAnnotation' is required")
+	if r.HumanElement != nil {
+		r.HumanElement.validateAll(path+".human", issues)
 	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
+	if r.ExpressionElement != nil {
+		r.ExpressionElement.validateAll(path+".expression", issues)
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	if r.SourceElement != nil {
+		r.SourceElement.validateAll(path+".source", issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+}
+
+var elementDefinitionConstraintInvariants = []invariant{
+	{key: "eld-21", severity: "warning", human: "Constraints should have an expression or else validators will not be able to enforce them", expression: "expression.exists()"},
+	{key: "eld-26", severity: "error", human: "Errors cannot be suppressed", expression: "(severity = 'error') implies suppress.empty()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionConstraint) invariants() []invariant {
+	return elementDefinitionConstraintInvariants
+}
+
+func (r ElementDefinitionConstraint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionConstraint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionConstraint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionConstraint) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionConstraintElementTypes = map[string]string{
+	"key":          "id",
+	"requirements": "markdown",
+	"source":       "canonical",
+}
+
+func (r *ElementDefinitionConstraint) elementTypes() map[string]string {
+	return elementDefinitionConstraintElementTypes
+}
+
+var elementDefinitionConstraintElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.constraint.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.constraint.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.constraint.key", Name: "key", Field: 2, Min: 1, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ElementDefinition.constraint.requirements", Name: "requirements", Field: 4, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.constraint.severity", Name: "severity", Field: 6, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.constraint.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/constraint-severity", Description: "SHALL applications comply with this constraint?"}, Summary: true},
+	{Path: "ElementDefinition.constraint.suppress", Name: "suppress", Field: 8, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "ElementDefinition.constraint.human", Name: "human", Field: 10, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.constraint.expression", Name: "expression", Field: 12, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.constraint.source", Name: "source", Field: 14, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition"}, Summary: true},
+}
+
+func (r *ElementDefinitionConstraint) ElementMetadata() []ElementMetadata {
+	return elementDefinitionConstraintElementMetadata
+}
+
+type ElementDefinitionBinding struct {
+	Id                 *string                              `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
+	Extension          []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`              // Additional content defined by implementations
+	Strength           BindingStrength                      `json:"strength" bson:"strength"`                                    // required | extensible | preferred | example | descriptive
+	StrengthElement    *Element                             `json:"_strength,omitempty" bson:"strength_element,omitempty"`       // Extensions for strength
+	Description        *string                              `json:"description,omitempty" bson:"description,omitempty"`          // Guidance on the codes to be used
+	DescriptionElement *Element                             `json:"_description,omitempty" bson:"description_element,omitempty"` // Extensions for description
+	ValueSet           *string                              `json:"valueSet,omitempty" bson:"value_set,omitempty"`               // Source of value set
+	ValueSetElement    *Element                             `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`      // Extensions for valueSet
+	Additional         []ElementDefinitionBindingAdditional `json:"additional,omitempty" bson:"additional,omitempty"`            // Additional Bindings - more rules about the binding
+}
+
+func (r *ElementDefinitionBinding) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if r.Strength == "" {
+		return fmt.Errorf("field 'Strength' is required")
 	}
-	if r.ValueCodeableReference == nil {
-		return fmt.Errorf("field 'ValueCodeableReference' is required")
+	if !r.Strength.IsValid() {
+		return fmt.Errorf("field 'Strength' has invalid code '%s'", r.Strength)
 	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
+	if r.StrengthElement != nil {
+		if err := r.StrengthElement.Validate(); err != nil {
+			return fmt.Errorf("StrengthElement: %w", err)
 		}
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
-	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
 		}
 	}
-	if r.ValueContactPoint == nil {
-		return fmt.Errorf("field 'ValueContactPoint' is required")
-	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
 		}
 	}
-	if r.ValueCount == nil {
-		return fmt.Errorf("field 'ValueCount' is required")
-	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
+	for i, item := range r.Additional {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Additional[%d]: %w", i, err)
 		}
 	}
-	if r.ValueDistance == nil {
-		return fmt.Errorf("field 'ValueDistance' is required")
+	return nil
+}
+
+func (r *ElementDefinitionBinding) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
-		}
+	if r.Strength == "" {
+		issues.add("required", path+".strength", "field 'Strength' is required")
 	}
-	if r.ValueDuration == nil {
-		return fmt.Errorf("field 'ValueDuration' is required")
+	if r.Strength != "" && !r.Strength.IsValid() {
+		issues.add("code-invalid", path+".strength", fmt.Sprintf("field 'Strength' has invalid code '%s'", r.Strength))
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
-		}
+	if r.StrengthElement != nil {
+		r.StrengthElement.validateAll(path+".strength", issues)
 	}
-	if r.ValueHumanName == nil {
-		return fmt.Errorf("field 'ValueHumanName' is required")
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
-		}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
 	}
-	if r.ValueIdentifier == nil {
-		return fmt.Errorf("field 'ValueIdentifier' is required")
+	for i, item := range r.Additional {
+		item.validateAll(fmt.Sprintf("%s.additional[%d]", path, i), issues)
 	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
+}
+
+var elementDefinitionBindingInvariants = []invariant{
+	{key: "eld-12", severity: "error", human: "ValueSet SHALL start with http:// or https:// or urn: or #", expression: "valueSet.exists() implies (valueSet.startsWith('http:') or valueSet.startsWith('https') or valueSet.startsWith('urn:') or valueSet.startsWith('#'))"},
+	{key: "eld-23", severity: "error", human: "binding SHALL have either description or valueSet", expression: "description.exists() or valueSet.exists()"},
+	{key: "eld-32", severity: "error", human: "If the strength is 'descriptive' no value set can ve provided", expression: "strength = 'descriptive' implies valueSet.empty()"},
+	{key: "eld-34", severity: "warning", human: "if there's a required binding with no usage, it should be the base binding", expression: "strength != 'required' implies additional.where(purpose = 'required' and usage.empty()).empty()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionBinding) invariants() []invariant {
+	return elementDefinitionBindingInvariants
+}
+
+func (r ElementDefinitionBinding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionBinding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionBinding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionBinding) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionBindingElementTypes = map[string]string{
+	"description": "markdown",
+	"valueSet":    "canonical",
+}
+
+func (r *ElementDefinitionBinding) elementTypes() map[string]string {
+	return elementDefinitionBindingElementTypes
+}
+
+var elementDefinitionBindingElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.binding.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.binding.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.binding.strength", Name: "strength", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.binding.strength", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/binding-strength", Description: "Indication of the degree of conformance expectations associated with a binding."}, Summary: true},
+	{Path: "ElementDefinition.binding.description", Name: "description", Field: 4, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.binding.valueSet", Name: "valueSet", Field: 6, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ValueSet"}, Summary: true},
+	{Path: "ElementDefinition.binding.additional", Name: "additional", Field: 8, Min: 0, Max: "*", Types: []string{"Element"}, Summary: true},
+}
+
+func (r *ElementDefinitionBinding) ElementMetadata() []ElementMetadata {
+	return elementDefinitionBindingElementMetadata
+}
+
+type ElementDefinitionBindingAdditional struct {
+	Id                   *string                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	Key                  *string                    `json:"key,omitempty" bson:"key,omitempty"`                              // Unique identifier so additional bindings to be matched across profiles
+	KeyElement           *Element                   `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
+	Purpose              AdditionalBindingPurposeVS `json:"purpose" bson:"purpose"`                                          // maximum | minimum | required | extensible | candidate | current | current-extensible | best-practice | preferred | ui | starter | component
+	PurposeElement       *Element                   `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`             // Extensions for purpose
+	ValueSet             string                     `json:"valueSet" bson:"value_set"`                                       // The value set for the additional binding
+	ValueSetElement      *Element                   `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	Documentation        *string                    `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Documentation of the purpose of use of the binding
+	DocumentationElement *Element                   `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+	ShortDoco            *string                    `json:"shortDoco,omitempty" bson:"short_doco,omitempty"`                 // Concise documentation - for summary tables
+	ShortDocoElement     *Element                   `json:"_shortDoco,omitempty" bson:"short_doco_element,omitempty"`        // Extensions for shortDoco
+	Usage                []UsageContext             `json:"usage,omitempty" bson:"usage,omitempty"`                          // Qualifies the usage - jurisdiction, gender, workflow status etc.
+	Any                  *bool                      `json:"any,omitempty" bson:"any,omitempty"`                              // Whether binding can applies to all repeats, or just one
+	AnyElement           *Element                   `json:"_any,omitempty" bson:"any_element,omitempty"`                     // Extensions for any
+}
+
+func (r *ElementDefinitionBindingAdditional) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueMoney == nil {
-		return fmt.Errorf("field 'ValueMoney' is required")
-	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
 		}
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
-	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
+	if r.Purpose == "" {
+		return fmt.Errorf("field 'Purpose' is required")
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if !r.Purpose.IsValid() {
+		return fmt.Errorf("field 'Purpose' has invalid code '%s'", r.Purpose)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
 		}
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	var emptyString string
+	if r.ValueSet == emptyString {
+		return fmt.Errorf("field 'ValueSet' is required")
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
 		}
 	}
-	if r.ValueRatio == nil {
-		return fmt.Errorf("field 'ValueRatio' is required")
-	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
 		}
 	}
-	if r.ValueRatioRange == nil {
-		return fmt.Errorf("field 'ValueRatioRange' is required")
-	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
+	if r.ShortDocoElement != nil {
+		if err := r.ShortDocoElement.Validate(); err != nil {
+			return fmt.Errorf("ShortDocoElement: %w", err)
 		}
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
-	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	for i, item := range r.Usage {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Usage[%d]: %w", i, err)
 		}
 	}
-	if r.ValueSampledData == nil {
-		return fmt.Errorf("field 'ValueSampledData' is required")
-	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
+	if r.AnyElement != nil {
+		if err := r.AnyElement.Validate(); err != nil {
+			return fmt.Errorf("AnyElement: %w", err)
 		}
 	}
-	if r.ValueSignature == nil {
-		return fmt.Errorf("field 'ValueSignature' is required")
+	return nil
+}
+
+func (r *ElementDefinitionBindingAdditional) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
 	}
-	if r.ValueTiming == nil {
-		return fmt.Errorf("field 'ValueTiming' is required")
+	if r.Purpose == "" {
+		issues.add("required", path+".purpose", "field 'Purpose' is required")
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.Purpose != "" && !r.Purpose.IsValid() {
+		issues.add("code-invalid", path+".purpose", fmt.Sprintf("field 'Purpose' has invalid code '%s'", r.Purpose))
 	}
-	if r.ValueContactDetail == nil {
-		return fmt.Errorf("field 'ValueContactDetail' is required")
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+	var emptyString string
+	if r.ValueSet == emptyString {
+		issues.add("required", path+".valueSet", "field 'ValueSet' is required")
 	}
-	if r.ValueDataRequirement == nil {
-		return fmt.Errorf("field 'ValueDataRequirement' is required")
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
 	}
-	if r.ValueExpression == nil {
-		return fmt.Errorf("field 'ValueExpression' is required")
+	if r.ShortDocoElement != nil {
+		r.ShortDocoElement.validateAll(path+".shortDoco", issues)
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	for i, item := range r.Usage {
+		item.validateAll(fmt.Sprintf("%s.usage[%d]", path, i), issues)
 	}
-	if r.ValueParameterDefinition == nil {
-		return fmt.Errorf("field 'ValueParameterDefinition' is required")
+	if r.AnyElement != nil {
+		r.AnyElement.validateAll(path+".any", issues)
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
+}
+
+var elementDefinitionBindingAdditionalInvariants = []invariant{
+	{key: "eld-31", severity: "warning", human: "additionalBindings should have a key", expression: "key.exists()"},
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ElementDefinitionBindingAdditional) invariants() []invariant {
+	return elementDefinitionBindingAdditionalInvariants
+}
+
+func (r ElementDefinitionBindingAdditional) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionBindingAdditional) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionBindingAdditional) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionBindingAdditional) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionBindingAdditionalElementTypes = map[string]string{
+	"key":           "id",
+	"valueSet":      "canonical",
+	"documentation": "markdown",
+}
+
+func (r *ElementDefinitionBindingAdditional) elementTypes() map[string]string {
+	return elementDefinitionBindingAdditionalElementTypes
+}
+
+var elementDefinitionBindingAdditionalElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.binding.additional.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.binding.additional.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.binding.additional.key", Name: "key", Field: 2, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ElementDefinition.binding.additional.purpose", Name: "purpose", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.binding.additional.purpose", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/additional-binding-purpose", Description: "The use of an additional binding."}, Summary: true},
+	{Path: "ElementDefinition.binding.additional.valueSet", Name: "valueSet", Field: 6, Min: 1, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ValueSet"}, Summary: true},
+	{Path: "ElementDefinition.binding.additional.documentation", Name: "documentation", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "ElementDefinition.binding.additional.shortDoco", Name: "shortDoco", Field: 10, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.binding.additional.usage", Name: "usage", Field: 12, Min: 0, Max: "*", Types: []string{"UsageContext"}, Summary: true},
+	{Path: "ElementDefinition.binding.additional.any", Name: "any", Field: 13, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+}
+
+func (r *ElementDefinitionBindingAdditional) ElementMetadata() []ElementMetadata {
+	return elementDefinitionBindingAdditionalElementMetadata
+}
+
+type ElementDefinitionMapping struct {
+	Id              *string     `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
+	Extension       []Extension `json:"extension,omitempty" bson:"extension,omitempty"`        // Additional content defined by implementations
+	Identity        string      `json:"identity" bson:"identity"`                              // Reference to mapping declaration
+	IdentityElement *Element    `json:"_identity,omitempty" bson:"identity_element,omitempty"` // Extensions for identity
+	Language        *string     `json:"language,omitempty" bson:"language,omitempty"`          // Computable language of mapping
+	LanguageElement *Element    `json:"_language,omitempty" bson:"language_element,omitempty"` // Extensions for language
+	Map             string      `json:"map" bson:"map"`                                        // Details of the mapping
+	MapElement      *Element    `json:"_map,omitempty" bson:"map_element,omitempty"`           // Extensions for map
+	Comment         *string     `json:"comment,omitempty" bson:"comment,omitempty"`            // Comments about the mapping or its use
+	CommentElement  *Element    `json:"_comment,omitempty" bson:"comment_element,omitempty"`   // Extensions for comment
+}
+
+func (r *ElementDefinitionMapping) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueRelatedArtifact == nil {
-		return fmt.Errorf("field 'ValueRelatedArtifact' is required")
+	var emptyString string
+	if r.Identity == emptyString {
+		return fmt.Errorf("field 'Identity' is required")
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
+	if r.IdentityElement != nil {
+		if err := r.IdentityElement.Validate(); err != nil {
+			return fmt.Errorf("IdentityElement: %w", err)
 		}
 	}
-	if r.ValueTriggerDefinition == nil {
-		return fmt.Errorf("field 'ValueTriggerDefinition' is required")
-	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
 		}
 	}
-	if r.ValueUsageContext == nil {
-		return fmt.Errorf("field 'ValueUsageContext' is required")
+	if r.Map == emptyString {
+		return fmt.Errorf("field 'Map' is required")
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
+	if r.MapElement != nil {
+		if err := r.MapElement.Validate(); err != nil {
+			return fmt.Errorf("MapElement: %w", err)
 		}
 	}
-	if r.ValueAvailability == nil {
-		return fmt.Errorf("field 'ValueAvailability' is required")
-	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
 		}
 	}
-	if r.ValueExtendedContactDetail == nil {
-		return fmt.Errorf("field 'ValueExtendedContactDetail' is required")
-	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
+	return nil
+}
+
+func (r *ElementDefinitionMapping) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueVirtualServiceDetail == nil {
-		return fmt.Errorf("field 'ValueVirtualServiceDetail' is required")
+	var emptyString string
+	if r.Identity == emptyString {
+		issues.add("required", path+".identity", "field 'Identity' is required")
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+	if r.IdentityElement != nil {
+		r.IdentityElement.validateAll(path+".identity", issues)
 	}
-	if r.ValueDosage == nil {
-		return fmt.Errorf("field 'ValueDosage' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+	if r.Map == emptyString {
+		issues.add("required", path+".map", "field 'Map' is required")
 	}
-	if r.ValueMeta == nil {
-		return fmt.Errorf("field 'ValueMeta' is required")
+	if r.MapElement != nil {
+		r.MapElement.validateAll(path+".map", issues)
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
-		}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
 	}
-	return nil
 }
 
-type ElementDefinitionMapping struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Identity string  `json:"identity" bson:"identity"`                     // Reference to mapping declaration
-	Language *string `json:"language,omitempty" bson:"language,omitempty"` // Computable language of mapping
-	Map      string  `json:"map" bson:"map"`                               // Details of the mapping
-	Comment  *string `json:"comment,omitempty" bson:"comment,omitempty"`   // Comments about the mapping or its use
+var elementDefinitionMappingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ElementDefinitionMapping) Validate() error {
-	var emptyString string
-	if r.Identity == emptyString {
-		return fmt.Errorf("field 'Identity' is required")
-	}
-	if r.Map == emptyString {
-		return fmt.Errorf("field 'Map' is required")
-	}
-	return nil
+func (r *ElementDefinitionMapping) invariants() []invariant {
+	return elementDefinitionMappingInvariants
+}
+
+func (r ElementDefinitionMapping) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ElementDefinitionMapping) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ElementDefinitionMapping) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ElementDefinitionMapping) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var elementDefinitionMappingElementTypes = map[string]string{
+	"identity": "id",
+	"language": "code",
+	"comment":  "markdown",
+}
+
+func (r *ElementDefinitionMapping) elementTypes() map[string]string {
+	return elementDefinitionMappingElementTypes
+}
+
+var elementDefinitionMappingElementMetadata = []ElementMetadata{
+	{Path: "ElementDefinition.mapping.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ElementDefinition.mapping.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ElementDefinition.mapping.identity", Name: "identity", Field: 2, Min: 1, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ElementDefinition.mapping.language", Name: "language", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ElementDefinition.mapping.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/mimetypes", Description: "BCP 13 (RFCs 2045, 2046, 2047, 4288, 4289 and 2049)"}, Summary: true},
+	{Path: "ElementDefinition.mapping.map", Name: "map", Field: 6, Min: 1, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ElementDefinition.mapping.comment", Name: "comment", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+}
+
+func (r *ElementDefinitionMapping) ElementMetadata() []ElementMetadata {
+	return elementDefinitionMappingElementMetadata
 }
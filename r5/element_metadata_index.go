@@ -0,0 +1,691 @@
+package models
+
+func init() {
+	elementMetadataTables = [][]ElementMetadata{
+		accountBalanceElementMetadata,
+		accountCoverageElementMetadata,
+		accountDiagnosisElementMetadata,
+		accountElementMetadata,
+		accountGuarantorElementMetadata,
+		accountProcedureElementMetadata,
+		activityDefinitionDynamicValueElementMetadata,
+		activityDefinitionElementMetadata,
+		activityDefinitionParticipantElementMetadata,
+		actorDefinitionElementMetadata,
+		addressElementMetadata,
+		administrableProductDefinitionElementMetadata,
+		administrableProductDefinitionPropertyElementMetadata,
+		administrableProductDefinitionRouteOfAdministrationElementMetadata,
+		administrableProductDefinitionRouteOfAdministrationTargetSpeciesElementMetadata,
+		administrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriodElementMetadata,
+		adverseEventElementMetadata,
+		adverseEventParticipantElementMetadata,
+		adverseEventSuspectEntityCausalityElementMetadata,
+		adverseEventSuspectEntityElementMetadata,
+		ageElementMetadata,
+		allergyIntoleranceElementMetadata,
+		allergyIntoleranceReactionElementMetadata,
+		annotationElementMetadata,
+		appointmentElementMetadata,
+		appointmentParticipantElementMetadata,
+		appointmentRecurrenceTemplateElementMetadata,
+		appointmentRecurrenceTemplateMonthlyTemplateElementMetadata,
+		appointmentRecurrenceTemplateWeeklyTemplateElementMetadata,
+		appointmentRecurrenceTemplateYearlyTemplateElementMetadata,
+		appointmentResponseElementMetadata,
+		artifactAssessmentContentElementMetadata,
+		artifactAssessmentElementMetadata,
+		artifactAssessmentRelatesToElementMetadata,
+		attachmentElementMetadata,
+		auditEventAgentElementMetadata,
+		auditEventElementMetadata,
+		auditEventEntityDetailElementMetadata,
+		auditEventEntityElementMetadata,
+		auditEventOutcomeElementMetadata,
+		auditEventSourceElementMetadata,
+		availabilityAvailableTimeElementMetadata,
+		availabilityElementMetadata,
+		availabilityNotAvailableTimeElementMetadata,
+		backboneElementElementMetadata,
+		backboneTypeElementMetadata,
+		baseElementMetadata,
+		basicElementMetadata,
+		binaryElementMetadata,
+		biologicallyDerivedProductCollectionElementMetadata,
+		biologicallyDerivedProductElementMetadata,
+		biologicallyDerivedProductPropertyElementMetadata,
+		bodyStructureElementMetadata,
+		bodyStructureIncludedStructureBodyLandmarkOrientationDistanceFromLandmarkElementMetadata,
+		bodyStructureIncludedStructureBodyLandmarkOrientationElementMetadata,
+		bodyStructureIncludedStructureElementMetadata,
+		bundleElementMetadata,
+		bundleEntryElementMetadata,
+		bundleEntryRequestElementMetadata,
+		bundleEntryResponseElementMetadata,
+		bundleEntrySearchElementMetadata,
+		bundleLinkElementMetadata,
+		canonicalResourceElementMetadata,
+		capabilityStatementDocumentElementMetadata,
+		capabilityStatementElementMetadata,
+		capabilityStatementImplementationElementMetadata,
+		capabilityStatementMessagingElementMetadata,
+		capabilityStatementMessagingEndpointElementMetadata,
+		capabilityStatementMessagingSupportedMessageElementMetadata,
+		capabilityStatementRestElementMetadata,
+		capabilityStatementRestInteractionElementMetadata,
+		capabilityStatementRestResourceElementMetadata,
+		capabilityStatementRestResourceInteractionElementMetadata,
+		capabilityStatementRestResourceOperationElementMetadata,
+		capabilityStatementRestResourceSearchParamElementMetadata,
+		capabilityStatementRestSecurityElementMetadata,
+		capabilityStatementSoftwareElementMetadata,
+		carePlanActivityElementMetadata,
+		carePlanElementMetadata,
+		careTeamElementMetadata,
+		careTeamParticipantElementMetadata,
+		claimAccidentElementMetadata,
+		claimCareTeamElementMetadata,
+		claimDiagnosisElementMetadata,
+		claimElementMetadata,
+		claimEventElementMetadata,
+		claimInsuranceElementMetadata,
+		claimItemBodySiteElementMetadata,
+		claimItemDetailElementMetadata,
+		claimItemDetailSubDetailElementMetadata,
+		claimItemElementMetadata,
+		claimPayeeElementMetadata,
+		claimProcedureElementMetadata,
+		claimRelatedElementMetadata,
+		claimResponseAddItemBodySiteElementMetadata,
+		claimResponseAddItemDetailElementMetadata,
+		claimResponseAddItemDetailSubDetailElementMetadata,
+		claimResponseAddItemElementMetadata,
+		claimResponseElementMetadata,
+		claimResponseErrorElementMetadata,
+		claimResponseEventElementMetadata,
+		claimResponseInsuranceElementMetadata,
+		claimResponseItemAdjudicationElementMetadata,
+		claimResponseItemDetailElementMetadata,
+		claimResponseItemDetailSubDetailElementMetadata,
+		claimResponseItemElementMetadata,
+		claimResponseItemReviewOutcomeElementMetadata,
+		claimResponsePaymentElementMetadata,
+		claimResponseProcessNoteElementMetadata,
+		claimResponseSupportingInfoElementMetadata,
+		claimResponseTotalElementMetadata,
+		claimSupportingInfoElementMetadata,
+		clinicalUseDefinitionContraindicationElementMetadata,
+		clinicalUseDefinitionElementMetadata,
+		clinicalUseDefinitionIndicationElementMetadata,
+		clinicalUseDefinitionIndicationOtherTherapyElementMetadata,
+		clinicalUseDefinitionInteractionElementMetadata,
+		clinicalUseDefinitionInteractionInteractantElementMetadata,
+		clinicalUseDefinitionUndesirableEffectElementMetadata,
+		clinicalUseDefinitionWarningElementMetadata,
+		codeSystemConceptDesignationElementMetadata,
+		codeSystemConceptElementMetadata,
+		codeSystemConceptPropertyElementMetadata,
+		codeSystemElementMetadata,
+		codeSystemFilterElementMetadata,
+		codeSystemPropertyElementMetadata,
+		codeableConceptElementMetadata,
+		codeableReferenceElementMetadata,
+		codingElementMetadata,
+		communicationElementMetadata,
+		communicationPayloadElementMetadata,
+		communicationRequestElementMetadata,
+		communicationRequestPayloadElementMetadata,
+		compartmentDefinitionElementMetadata,
+		compartmentDefinitionResourceElementMetadata,
+		compositionAttesterElementMetadata,
+		compositionElementMetadata,
+		compositionEventElementMetadata,
+		compositionParticipantElementMetadata,
+		compositionRelatesToElementMetadata,
+		compositionSectionElementMetadata,
+		conceptMapAdditionalAttributeElementMetadata,
+		conceptMapElementMetadata,
+		conceptMapGroupElementElementMetadata,
+		conceptMapGroupElementMetadata,
+		conceptMapGroupElementTargetDependsOnElementMetadata,
+		conceptMapGroupElementTargetElementMetadata,
+		conceptMapGroupElementTargetPropertyElementMetadata,
+		conceptMapGroupUnmappedElementMetadata,
+		conceptMapPropertyElementMetadata,
+		conditionElementMetadata,
+		conditionStageElementMetadata,
+		consentElementMetadata,
+		consentPolicyBasisElementMetadata,
+		consentProvisionActorElementMetadata,
+		consentProvisionDataElementMetadata,
+		consentProvisionElementMetadata,
+		consentVerificationElementMetadata,
+		contactDetailElementMetadata,
+		contactPointElementMetadata,
+		contractContentDefinitionElementMetadata,
+		contractElementMetadata,
+		contractFriendlyElementMetadata,
+		contractLegalElementMetadata,
+		contractRuleElementMetadata,
+		contractSignerElementMetadata,
+		contractTermActionElementMetadata,
+		contractTermActionSubjectElementMetadata,
+		contractTermAssetContextElementMetadata,
+		contractTermAssetElementMetadata,
+		contractTermAssetValuedItemElementMetadata,
+		contractTermElementMetadata,
+		contractTermOfferAnswerElementMetadata,
+		contractTermOfferElementMetadata,
+		contractTermOfferPartyElementMetadata,
+		contractTermSecurityLabelElementMetadata,
+		countElementMetadata,
+		coverageEligibilityRequestElementMetadata,
+		coverageEligibilityRequestEventElementMetadata,
+		coverageEligibilityRequestInsuranceElementMetadata,
+		coverageEligibilityRequestItemDiagnosisElementMetadata,
+		coverageEligibilityRequestItemElementMetadata,
+		coverageEligibilityRequestSupportingInfoElementMetadata,
+		coverageEligibilityResponseElementMetadata,
+		coverageEligibilityResponseErrorElementMetadata,
+		coverageEligibilityResponseEventElementMetadata,
+		coverageEligibilityResponseInsuranceElementMetadata,
+		coverageEligibilityResponseInsuranceItemBenefitElementMetadata,
+		coverageEligibilityResponseInsuranceItemElementMetadata,
+		dataRequirementCodeFilterElementMetadata,
+		dataRequirementDateFilterElementMetadata,
+		dataRequirementElementMetadata,
+		dataRequirementSortElementMetadata,
+		dataRequirementValueFilterElementMetadata,
+		dataTypeElementMetadata,
+		detectedIssueElementMetadata,
+		detectedIssueEvidenceElementMetadata,
+		detectedIssueMitigationElementMetadata,
+		deviceAdditiveElementMetadata,
+		deviceAlertDerivedFromElementMetadata,
+		deviceAlertElementMetadata,
+		deviceAlertSignalElementMetadata,
+		deviceAssociationElementMetadata,
+		deviceConformsToElementMetadata,
+		deviceDefinitionChargeItemElementMetadata,
+		deviceDefinitionClassificationElementMetadata,
+		deviceDefinitionConformsToElementMetadata,
+		deviceDefinitionCorrectiveActionElementMetadata,
+		deviceDefinitionDeviceNameElementMetadata,
+		deviceDefinitionDeviceVersionElementMetadata,
+		deviceDefinitionElementMetadata,
+		deviceDefinitionGuidelineElementMetadata,
+		deviceDefinitionHasPartElementMetadata,
+		deviceDefinitionLinkElementMetadata,
+		deviceDefinitionMaterialElementMetadata,
+		deviceDefinitionPackagingDistributorElementMetadata,
+		deviceDefinitionPackagingElementMetadata,
+		deviceDefinitionPropertyElementMetadata,
+		deviceDefinitionRegulatoryIdentifierElementMetadata,
+		deviceDefinitionUdiDeviceIdentifierElementMetadata,
+		deviceDefinitionUdiDeviceIdentifierMarketDistributionElementMetadata,
+		deviceDeviceVersionElementMetadata,
+		deviceElementMetadata,
+		deviceMetricCalibrationElementMetadata,
+		deviceMetricElementMetadata,
+		deviceNameElementMetadata,
+		devicePropertyElementMetadata,
+		deviceRequestElementMetadata,
+		deviceRequestParameterElementMetadata,
+		deviceUdiCarrierElementMetadata,
+		diagnosticReportElementMetadata,
+		diagnosticReportMediaElementMetadata,
+		diagnosticReportSupportingInfoElementMetadata,
+		distanceElementMetadata,
+		documentReferenceAttesterElementMetadata,
+		documentReferenceContentElementMetadata,
+		documentReferenceContentProfileElementMetadata,
+		documentReferenceElementMetadata,
+		documentReferenceRelatesToElementMetadata,
+		dosageConditionElementMetadata,
+		dosageDetailsElementMetadata,
+		dosageDetailsStepElementMetadata,
+		dosageDoseAndRateElementMetadata,
+		dosageElementMetadata,
+		dosageSafetyDoseLimitElementMetadata,
+		dosageSafetyElementMetadata,
+		durationElementMetadata,
+		elementDefinitionBaseElementMetadata,
+		elementDefinitionBindingAdditionalElementMetadata,
+		elementDefinitionBindingElementMetadata,
+		elementDefinitionConstraintElementMetadata,
+		elementDefinitionElementMetadata,
+		elementDefinitionExampleElementMetadata,
+		elementDefinitionMappingElementMetadata,
+		elementDefinitionSlicingDiscriminatorElementMetadata,
+		elementDefinitionSlicingElementMetadata,
+		elementDefinitionTypeElementMetadata,
+		elementElementMetadata,
+		encounterAdmissionElementMetadata,
+		encounterBusinessStatusElementMetadata,
+		encounterDiagnosisElementMetadata,
+		encounterElementMetadata,
+		encounterLocationElementMetadata,
+		encounterParticipantElementMetadata,
+		encounterReasonElementMetadata,
+		endpointElementMetadata,
+		endpointPayloadElementMetadata,
+		enrollmentRequestElementMetadata,
+		enrollmentResponseElementMetadata,
+		episodeOfCareDiagnosisElementMetadata,
+		episodeOfCareElementMetadata,
+		episodeOfCareReasonElementMetadata,
+		episodeOfCareStatusHistoryElementMetadata,
+		eventDefinitionElementMetadata,
+		evidenceCertaintyElementMetadata,
+		evidenceElementMetadata,
+		evidenceRelatesToElementMetadata,
+		evidenceStatisticAttributeEstimateElementMetadata,
+		evidenceStatisticElementMetadata,
+		evidenceStatisticModelCharacteristicElementMetadata,
+		evidenceStatisticModelCharacteristicVariableElementMetadata,
+		evidenceStatisticSampleSizeElementMetadata,
+		evidenceVariableCategoryElementMetadata,
+		evidenceVariableConstraintElementMetadata,
+		evidenceVariableDataStorageElementMetadata,
+		evidenceVariableDefinitionElementMetadata,
+		evidenceVariableDefinitionModifierElementMetadata,
+		evidenceVariableElementMetadata,
+		evidenceVariableRelatesToElementMetadata,
+		exampleScenarioActorElementMetadata,
+		exampleScenarioElementMetadata,
+		exampleScenarioInstanceContainedInstanceElementMetadata,
+		exampleScenarioInstanceElementMetadata,
+		exampleScenarioInstanceVersionElementMetadata,
+		exampleScenarioProcessElementMetadata,
+		exampleScenarioProcessStepAlternativeElementMetadata,
+		exampleScenarioProcessStepElementMetadata,
+		exampleScenarioProcessStepOperationElementMetadata,
+		explanationOfBenefitAccidentElementMetadata,
+		explanationOfBenefitAddItemBodySiteElementMetadata,
+		explanationOfBenefitAddItemDetailElementMetadata,
+		explanationOfBenefitAddItemDetailSubDetailElementMetadata,
+		explanationOfBenefitAddItemElementMetadata,
+		explanationOfBenefitBenefitBalanceElementMetadata,
+		explanationOfBenefitBenefitBalanceFinancialElementMetadata,
+		explanationOfBenefitCareTeamElementMetadata,
+		explanationOfBenefitDiagnosisElementMetadata,
+		explanationOfBenefitElementMetadata,
+		explanationOfBenefitEventElementMetadata,
+		explanationOfBenefitInsuranceElementMetadata,
+		explanationOfBenefitItemAdjudicationElementMetadata,
+		explanationOfBenefitItemBodySiteElementMetadata,
+		explanationOfBenefitItemDetailElementMetadata,
+		explanationOfBenefitItemDetailSubDetailElementMetadata,
+		explanationOfBenefitItemElementMetadata,
+		explanationOfBenefitItemReviewOutcomeElementMetadata,
+		explanationOfBenefitPayeeElementMetadata,
+		explanationOfBenefitPaymentElementMetadata,
+		explanationOfBenefitProcedureElementMetadata,
+		explanationOfBenefitProcessNoteElementMetadata,
+		explanationOfBenefitRelatedElementMetadata,
+		explanationOfBenefitSupportingInfoElementMetadata,
+		explanationOfBenefitTotalElementMetadata,
+		expressionElementMetadata,
+		extendedContactDetailElementMetadata,
+		extensionElementMetadata,
+		fHIRBooleanElementMetadata,
+		fHIRDecimalElementMetadata,
+		fHIRInteger64ElementMetadata,
+		fHIRIntegerElementMetadata,
+		fHIRStringElementMetadata,
+		familyMemberHistoryConditionElementMetadata,
+		familyMemberHistoryElementMetadata,
+		familyMemberHistoryProcedureElementMetadata,
+		flagElementMetadata,
+		goalAcceptanceElementMetadata,
+		goalElementMetadata,
+		goalTargetElementMetadata,
+		groupCharacteristicElementMetadata,
+		groupElementMetadata,
+		groupMemberElementMetadata,
+		guidanceResponseElementMetadata,
+		healthcareServiceElementMetadata,
+		healthcareServiceEligibilityElementMetadata,
+		humanNameElementMetadata,
+		identifierElementMetadata,
+		imagingSelectionElementMetadata,
+		imagingSelectionImageRegion3DElementMetadata,
+		imagingSelectionInstanceElementMetadata,
+		imagingSelectionInstanceImageRegion2DElementMetadata,
+		imagingSelectionPerformerElementMetadata,
+		imagingStudyElementMetadata,
+		imagingStudySeriesElementMetadata,
+		imagingStudySeriesInstanceElementMetadata,
+		imagingStudySeriesPerformerElementMetadata,
+		immunizationElementMetadata,
+		immunizationPerformerElementMetadata,
+		immunizationProgramEligibilityElementMetadata,
+		immunizationProtocolAppliedElementMetadata,
+		immunizationReactionElementMetadata,
+		implementationGuideDefinitionElementMetadata,
+		implementationGuideDefinitionGroupingElementMetadata,
+		implementationGuideDefinitionPageElementMetadata,
+		implementationGuideDefinitionParameterElementMetadata,
+		implementationGuideDefinitionResourceElementMetadata,
+		implementationGuideDefinitionTemplateElementMetadata,
+		implementationGuideDependsOnElementMetadata,
+		implementationGuideElementMetadata,
+		implementationGuideGlobalElementMetadata,
+		implementationGuideManifestElementMetadata,
+		implementationGuideManifestPageElementMetadata,
+		implementationGuideManifestResourceElementMetadata,
+		ingredientElementMetadata,
+		ingredientManufacturerElementMetadata,
+		ingredientSubstanceElementMetadata,
+		ingredientSubstanceStrengthElementMetadata,
+		ingredientSubstanceStrengthReferenceStrengthElementMetadata,
+		insurancePlanElementMetadata,
+		insurancePlanGeneralCostElementMetadata,
+		insurancePlanSpecificCostBenefitCostElementMetadata,
+		insurancePlanSpecificCostBenefitElementMetadata,
+		insurancePlanSpecificCostElementMetadata,
+		insuranceProductCoverageBenefitElementMetadata,
+		insuranceProductCoverageBenefitLimitElementMetadata,
+		insuranceProductCoverageElementMetadata,
+		insuranceProductElementMetadata,
+		insuranceProductRelatedElementMetadata,
+		invoiceElementMetadata,
+		invoiceLineItemElementMetadata,
+		invoiceParticipantElementMetadata,
+		libraryElementMetadata,
+		listElementMetadata,
+		listEntryElementMetadata,
+		locationElementMetadata,
+		locationPositionElementMetadata,
+		manufacturedItemDefinitionComponentConstituentElementMetadata,
+		manufacturedItemDefinitionComponentElementMetadata,
+		manufacturedItemDefinitionElementMetadata,
+		manufacturedItemDefinitionPropertyElementMetadata,
+		marketingStatusElementMetadata,
+		measureElementMetadata,
+		measureGroupComponentElementMetadata,
+		measureGroupElementMetadata,
+		measureGroupPopulationElementMetadata,
+		measureGroupStratifierComponentElementMetadata,
+		measureGroupStratifierElementMetadata,
+		measureReportElementMetadata,
+		measureReportGroupElementMetadata,
+		measureReportGroupPopulationElementMetadata,
+		measureReportGroupStratifierElementMetadata,
+		measureReportGroupStratifierStratumComponentElementMetadata,
+		measureReportGroupStratifierStratumElementMetadata,
+		measureReportGroupStratifierStratumPopulationElementMetadata,
+		measureSupplementalDataElementMetadata,
+		measureTermElementMetadata,
+		medicationAdministrationDosageElementMetadata,
+		medicationAdministrationElementMetadata,
+		medicationAdministrationPerformerElementMetadata,
+		medicationDispenseElementMetadata,
+		medicationDispensePerformerElementMetadata,
+		medicationDispenseSubstitutionElementMetadata,
+		medicationElementMetadata,
+		medicationIngredientElementMetadata,
+		medicationInstanceElementMetadata,
+		medicationPackageSizeElementMetadata,
+		medicationRequestDispenseRequestElementMetadata,
+		medicationRequestDispenseRequestInitialFillElementMetadata,
+		medicationRequestElementMetadata,
+		medicationRequestSubstitutionElementMetadata,
+		medicationStatementAdherenceElementMetadata,
+		medicationStatementElementMetadata,
+		medicinalProductDefinitionCharacteristicElementMetadata,
+		medicinalProductDefinitionContactElementMetadata,
+		medicinalProductDefinitionCrossReferenceElementMetadata,
+		medicinalProductDefinitionElementMetadata,
+		medicinalProductDefinitionNameElementMetadata,
+		medicinalProductDefinitionNamePartElementMetadata,
+		medicinalProductDefinitionNameUsageElementMetadata,
+		medicinalProductDefinitionOperationElementMetadata,
+		messageDefinitionAllowedResponseElementMetadata,
+		messageDefinitionElementMetadata,
+		messageDefinitionFocusElementMetadata,
+		messageHeaderDestinationElementMetadata,
+		messageHeaderElementMetadata,
+		messageHeaderResponseElementMetadata,
+		messageHeaderSourceElementMetadata,
+		metaElementMetadata,
+		metadataResourceElementMetadata,
+		monetaryComponentElementMetadata,
+		moneyElementMetadata,
+		moneyQuantityElementMetadata,
+		namingSystemElementMetadata,
+		namingSystemUniqueIdElementMetadata,
+		narrativeElementMetadata,
+		nutritionIntakeElementMetadata,
+		nutritionIntakeNutritionItemConsumedItemElementMetadata,
+		nutritionIntakeNutritionItemConsumedItemTotalIntakeElementMetadata,
+		nutritionIntakeNutritionItemElementMetadata,
+		nutritionIntakeNutritionItemNotConsumedItemElementMetadata,
+		nutritionIntakePerformerElementMetadata,
+		nutritionOrderAdditiveElementMetadata,
+		nutritionOrderElementMetadata,
+		nutritionOrderEnteralFormulaAdministrationElementMetadata,
+		nutritionOrderEnteralFormulaAdministrationScheduleElementMetadata,
+		nutritionOrderEnteralFormulaElementMetadata,
+		nutritionOrderOralDietElementMetadata,
+		nutritionOrderOralDietNutrientElementMetadata,
+		nutritionOrderOralDietScheduleElementMetadata,
+		nutritionOrderOralDietTextureElementMetadata,
+		nutritionOrderSupplementElementMetadata,
+		nutritionOrderSupplementScheduleElementMetadata,
+		nutritionProductCharacteristicElementMetadata,
+		nutritionProductElementMetadata,
+		nutritionProductIngredientElementMetadata,
+		nutritionProductInstanceElementMetadata,
+		nutritionProductNutrientElementMetadata,
+		observationComponentElementMetadata,
+		observationDefinitionComponentElementMetadata,
+		observationDefinitionElementMetadata,
+		observationDefinitionQualifiedValueElementMetadata,
+		observationElementMetadata,
+		observationReferenceRangeElementMetadata,
+		observationTriggeredByElementMetadata,
+		operationDefinitionElementMetadata,
+		operationDefinitionOverloadElementMetadata,
+		operationDefinitionParameterBindingElementMetadata,
+		operationDefinitionParameterElementMetadata,
+		operationDefinitionParameterReferencedFromElementMetadata,
+		operationOutcomeElementMetadata,
+		operationOutcomeIssueElementMetadata,
+		organizationAffiliationElementMetadata,
+		organizationElementMetadata,
+		organizationQualificationElementMetadata,
+		packagedProductDefinitionElementMetadata,
+		packagedProductDefinitionLegalStatusOfSupplyElementMetadata,
+		packagedProductDefinitionPackagingContainedItemElementMetadata,
+		packagedProductDefinitionPackagingElementMetadata,
+		packagedProductDefinitionPackagingPropertyElementMetadata,
+		parameterDefinitionElementMetadata,
+		parametersElementMetadata,
+		parametersParameterElementMetadata,
+		patientCommunicationElementMetadata,
+		patientContactElementMetadata,
+		patientElementMetadata,
+		patientLinkElementMetadata,
+		paymentNoticeElementMetadata,
+		paymentReconciliationAllocationElementMetadata,
+		paymentReconciliationElementMetadata,
+		paymentReconciliationProcessNoteElementMetadata,
+		periodElementMetadata,
+		personCommunicationElementMetadata,
+		personElementMetadata,
+		personLinkElementMetadata,
+		planDefinitionActionConditionElementMetadata,
+		planDefinitionActionDynamicValueElementMetadata,
+		planDefinitionActionElementMetadata,
+		planDefinitionActionInputElementMetadata,
+		planDefinitionActionOutputElementMetadata,
+		planDefinitionActionParticipantElementMetadata,
+		planDefinitionActionRelatedActionElementMetadata,
+		planDefinitionActorElementMetadata,
+		planDefinitionActorOptionElementMetadata,
+		planDefinitionElementMetadata,
+		planDefinitionGoalElementMetadata,
+		planDefinitionGoalTargetElementMetadata,
+		practitionerCommunicationElementMetadata,
+		practitionerElementMetadata,
+		practitionerQualificationElementMetadata,
+		practitionerRoleElementMetadata,
+		primitiveTypeElementMetadata,
+		procedureElementMetadata,
+		procedureFocalDeviceElementMetadata,
+		procedurePerformerElementMetadata,
+		productShelfLifeElementMetadata,
+		provenanceAgentElementMetadata,
+		provenanceElementMetadata,
+		provenanceEntityElementMetadata,
+		quantityElementMetadata,
+		questionnaireElementMetadata,
+		questionnaireItemAnswerOptionElementMetadata,
+		questionnaireItemElementMetadata,
+		questionnaireItemEnableWhenElementMetadata,
+		questionnaireItemInitialElementMetadata,
+		questionnaireResponseElementMetadata,
+		questionnaireResponseItemAnswerElementMetadata,
+		questionnaireResponseItemElementMetadata,
+		rangeElementMetadata,
+		ratioElementMetadata,
+		ratioRangeElementMetadata,
+		referenceElementMetadata,
+		regulatedAuthorizationCaseElementMetadata,
+		regulatedAuthorizationElementMetadata,
+		relatedArtifactElementMetadata,
+		relatedPersonCommunicationElementMetadata,
+		relatedPersonElementMetadata,
+		relativeTimeElementMetadata,
+		requestOrchestrationActionConditionElementMetadata,
+		requestOrchestrationActionDynamicValueElementMetadata,
+		requestOrchestrationActionElementMetadata,
+		requestOrchestrationActionInputElementMetadata,
+		requestOrchestrationActionOutputElementMetadata,
+		requestOrchestrationActionParticipantElementMetadata,
+		requestOrchestrationActionRelatedActionElementMetadata,
+		requestOrchestrationElementMetadata,
+		requirementsActorElementMetadata,
+		requirementsElementMetadata,
+		requirementsImportsElementMetadata,
+		requirementsStatementDerivedFromElementMetadata,
+		requirementsStatementElementMetadata,
+		requirementsStatementPartOfElementMetadata,
+		researchStudyAssociatedPartyElementMetadata,
+		researchStudyComparisonGroupElementMetadata,
+		researchStudyElementMetadata,
+		researchStudyLabelElementMetadata,
+		researchStudyObjectiveElementMetadata,
+		researchStudyObjectiveOutcomeMeasureElementMetadata,
+		researchStudyObjectiveOutcomeMeasureEventHandlingElementMetadata,
+		researchStudyProgressStatusElementMetadata,
+		researchStudyRecruitmentElementMetadata,
+		researchStudyRelatesToElementMetadata,
+		researchSubjectElementMetadata,
+		researchSubjectSubjectMilestoneElementMetadata,
+		researchSubjectSubjectStateElementMetadata,
+		riskAssessmentElementMetadata,
+		riskAssessmentPredictionElementMetadata,
+		sampledDataElementMetadata,
+		scheduleElementMetadata,
+		searchParameterComponentElementMetadata,
+		searchParameterElementMetadata,
+		serviceRequestElementMetadata,
+		serviceRequestOrderDetailElementMetadata,
+		serviceRequestOrderDetailParameterElementMetadata,
+		serviceRequestPatientInstructionElementMetadata,
+		signatureElementMetadata,
+		simpleQuantityElementMetadata,
+		slotElementMetadata,
+		specimenCollectionElementMetadata,
+		specimenContainerElementMetadata,
+		specimenDefinitionElementMetadata,
+		specimenDefinitionTypeTestedContainerAdditiveElementMetadata,
+		specimenDefinitionTypeTestedContainerElementMetadata,
+		specimenDefinitionTypeTestedElementMetadata,
+		specimenDefinitionTypeTestedHandlingElementMetadata,
+		specimenElementMetadata,
+		specimenFeatureElementMetadata,
+		specimenProcessingElementMetadata,
+		structureDefinitionContextElementMetadata,
+		structureDefinitionDifferentialElementMetadata,
+		structureDefinitionElementMetadata,
+		structureDefinitionMappingElementMetadata,
+		structureDefinitionSnapshotElementMetadata,
+		structureMapConstElementMetadata,
+		structureMapElementMetadata,
+		structureMapGroupElementMetadata,
+		structureMapGroupInputElementMetadata,
+		structureMapGroupRuleDependentElementMetadata,
+		structureMapGroupRuleElementMetadata,
+		structureMapGroupRuleSourceElementMetadata,
+		structureMapGroupRuleTargetElementMetadata,
+		structureMapGroupRuleTargetParameterElementMetadata,
+		structureMapStructureElementMetadata,
+		subscriptionElementMetadata,
+		subscriptionFilterByElementMetadata,
+		subscriptionParameterElementMetadata,
+		subscriptionStatusElementMetadata,
+		subscriptionStatusNotificationEventAuthorizationHintElementMetadata,
+		subscriptionStatusNotificationEventElementMetadata,
+		subscriptionStatusNotificationEventRelatedQueryElementMetadata,
+		subscriptionTopicElementMetadata,
+		subscriptionTopicTriggerCanFilterByElementMetadata,
+		subscriptionTopicTriggerElementMetadata,
+		subscriptionTopicTriggerNotificationShapeElementMetadata,
+		subscriptionTopicTriggerNotificationShapeRelatedQueryElementMetadata,
+		subscriptionTopicTriggerQueryCriteriaElementMetadata,
+		substanceDefinitionCharacterizationElementMetadata,
+		substanceDefinitionCodeElementMetadata,
+		substanceDefinitionElementMetadata,
+		substanceDefinitionMoietyElementMetadata,
+		substanceDefinitionMolecularWeightElementMetadata,
+		substanceDefinitionNameElementMetadata,
+		substanceDefinitionNameOfficialElementMetadata,
+		substanceDefinitionPropertyElementMetadata,
+		substanceDefinitionRelationshipElementMetadata,
+		substanceDefinitionSourceMaterialElementMetadata,
+		substanceDefinitionStructureElementMetadata,
+		substanceDefinitionStructureRepresentationElementMetadata,
+		substanceElementMetadata,
+		taskElementMetadata,
+		taskFocusElementMetadata,
+		taskInputElementMetadata,
+		taskOutputElementMetadata,
+		taskPerformerElementMetadata,
+		taskRestrictionElementMetadata,
+		terminologyCapabilitiesCodeSystemElementMetadata,
+		terminologyCapabilitiesCodeSystemVersionElementMetadata,
+		terminologyCapabilitiesCodeSystemVersionFilterElementMetadata,
+		terminologyCapabilitiesElementMetadata,
+		terminologyCapabilitiesExpansionElementMetadata,
+		terminologyCapabilitiesExpansionParameterElementMetadata,
+		terminologyCapabilitiesImplementationElementMetadata,
+		terminologyCapabilitiesSoftwareElementMetadata,
+		terminologyCapabilitiesSupplementsElementMetadata,
+		terminologyCapabilitiesTranslationElementMetadata,
+		terminologyCapabilitiesValidateCodeElementMetadata,
+		timingElementMetadata,
+		timingRepeatElementMetadata,
+		triggerDefinitionElementMetadata,
+		usageContextElementMetadata,
+		uuidElementMetadata,
+		valueSetComposeElementMetadata,
+		valueSetComposeIncludeConceptDesignationElementMetadata,
+		valueSetComposeIncludeConceptElementMetadata,
+		valueSetComposeIncludeElementMetadata,
+		valueSetComposeIncludeFilterElementMetadata,
+		valueSetElementMetadata,
+		valueSetExpansionContainsElementMetadata,
+		valueSetExpansionContainsPropertyElementMetadata,
+		valueSetExpansionContainsPropertySubPropertyElementMetadata,
+		valueSetExpansionElementMetadata,
+		valueSetExpansionParameterElementMetadata,
+		valueSetExpansionPropertyElementMetadata,
+		virtualServiceDetailElementMetadata,
+		visionPrescriptionElementMetadata,
+		visionPrescriptionLensSpecificationElementMetadata,
+		visionPrescriptionLensSpecificationPrismElementMetadata,
+	}
+}
@@ -2,49 +2,73 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An interaction between healthcare provider(s), and/or patient(s) for the purpose of providing healthcare service(s) or assessing the health status of patient(s).
 type Encounter struct {
-	ResourceType       string                    `json:"resourceType" bson:"resource_type"`                                 // Type of resource
-	Id                 *string                   `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
-	Meta               *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
-	ImplicitRules      *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
-	Language           *string                   `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
-	Text               *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage         `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
-	Identifier         []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // Identifier(s) by which this encounter is known
-	Status             string                    `json:"status" bson:"status"`                                              // planned | in-progress | on-hold | discharged | completed | cancelled | discontinued | entered-in-error | unknown
-	BusinessStatus     []EncounterBusinessStatus `json:"businessStatus,omitempty" bson:"business_status,omitempty"`         // A granular, workflows specific set of statuses that apply to the encounter
-	Class              []CodeableConcept         `json:"class,omitempty" bson:"class,omitempty"`                            // Classification of patient encounter context - e.g. Inpatient, outpatient
-	Priority           *CodeableConcept          `json:"priority,omitempty" bson:"priority,omitempty"`                      // Indicates the urgency of the encounter
-	Type               []CodeableConcept         `json:"type,omitempty" bson:"type,omitempty"`                              // Specific type of encounter (e.g. e-mail consultation, surgical day-care, ...)
-	ServiceType        []CodeableReference       `json:"serviceType,omitempty" bson:"service_type,omitempty"`               // Specific type of service
-	Subject            *Reference                `json:"subject,omitempty" bson:"subject,omitempty"`                        // The patient or group related to this encounter
-	SubjectStatus      *CodeableConcept          `json:"subjectStatus,omitempty" bson:"subject_status,omitempty"`           // The current status of the subject in relation to the Encounter
-	EpisodeOfCare      []Reference               `json:"episodeOfCare,omitempty" bson:"episode_of_care,omitempty"`          // Episode(s) of care that this encounter should be recorded against
-	BasedOn            []Reference               `json:"basedOn,omitempty" bson:"based_on,omitempty"`                       // The request that initiated this encounter
-	CareTeam           []Reference               `json:"careTeam,omitempty" bson:"care_team,omitempty"`                     // The group(s) that are allocated to participate in this encounter
-	PartOf             *Reference                `json:"partOf,omitempty" bson:"part_of,omitempty"`                         // Another Encounter this encounter is part of
-	ServiceProvider    *Reference                `json:"serviceProvider,omitempty" bson:"service_provider,omitempty"`       // The organization (facility) responsible for this encounter
-	Participant        []EncounterParticipant    `json:"participant,omitempty" bson:"participant,omitempty"`                // List of participants involved in the encounter
-	Appointment        []Reference               `json:"appointment,omitempty" bson:"appointment,omitempty"`                // The appointment that scheduled this encounter
-	VirtualService     []VirtualServiceDetail    `json:"virtualService,omitempty" bson:"virtual_service,omitempty"`         // Connection details of a virtual service (e.g. conference call)
-	ActualPeriod       *Period                   `json:"actualPeriod,omitempty" bson:"actual_period,omitempty"`             // The actual start and end time of the encounter
-	PlannedStartDate   *string                   `json:"plannedStartDate,omitempty" bson:"planned_start_date,omitempty"`    // The planned start date/time (or admission date) of the encounter
-	PlannedEndDate     *string                   `json:"plannedEndDate,omitempty" bson:"planned_end_date,omitempty"`        // The planned end date/time (or discharge date) of the encounter
-	Length             *Duration                 `json:"length,omitempty" bson:"length,omitempty"`                          // Actual quantity of time the encounter lasted (less time absent)
-	Reason             []EncounterReason         `json:"reason,omitempty" bson:"reason,omitempty"`                          // The list of medical reasons that are expected to be addressed during the episode of care
-	Diagnosis          []EncounterDiagnosis      `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                    // The list of diagnosis relevant to this encounter
-	Account            []Reference               `json:"account,omitempty" bson:"account,omitempty"`                        // The set of accounts that may be used for billing for this Encounter
-	DietPreference     []CodeableConcept         `json:"dietPreference,omitempty" bson:"diet_preference,omitempty"`         // Diet preferences reported by the patient
-	SpecialArrangement []CodeableConcept         `json:"specialArrangement,omitempty" bson:"special_arrangement,omitempty"` // Wheelchair, translator, stretcher, etc
-	SpecialCourtesy    []CodeableConcept         `json:"specialCourtesy,omitempty" bson:"special_courtesy,omitempty"`       // Special courtesies (VIP, board member)
-	Admission          *EncounterAdmission       `json:"admission,omitempty" bson:"admission,omitempty"`                    // Details about the admission to a healthcare service
-	Location           []EncounterLocation       `json:"location,omitempty" bson:"location,omitempty"`                      // List of locations where the patient has been
+	ResourceType            string                    `json:"resourceType" bson:"resource_type"`                                       // Type of resource
+	Id                      *string                   `json:"id,omitempty" bson:"id,omitempty"`                                        // Logical id of this artifact
+	Meta                    *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                                    // Metadata about the resource
+	ImplicitRules           *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                 // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                  `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`        // Extensions for implicitRules
+	Language                *string                   `json:"language,omitempty" bson:"language,omitempty"`                            // Language of the resource content
+	LanguageElement         *Element                  `json:"_language,omitempty" bson:"language_element,omitempty"`                   // Extensions for language
+	Text                    *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                                    // Text summary of the resource, for human interpretation
+	Contained               []Resource                `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Extension               []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension       []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored
+	Identifier              []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                        // Identifier(s) by which this encounter is known
+	Status                  EncounterStatus           `json:"status" bson:"status"`                                                    // planned | in-progress | on-hold | discharged | completed | cancelled | discontinued | entered-in-error | unknown
+	StatusElement           *Element                  `json:"_status,omitempty" bson:"status_element,omitempty"`                       // Extensions for status
+	BusinessStatus          []EncounterBusinessStatus `json:"businessStatus,omitempty" bson:"business_status,omitempty"`               // A granular, workflows specific set of statuses that apply to the encounter
+	Class                   []CodeableConcept         `json:"class,omitempty" bson:"class,omitempty"`                                  // Classification of patient encounter context - e.g. Inpatient, outpatient
+	Priority                *CodeableConcept          `json:"priority,omitempty" bson:"priority,omitempty"`                            // Indicates the urgency of the encounter
+	Type                    []CodeableConcept         `json:"type,omitempty" bson:"type,omitempty"`                                    // Specific type of encounter (e.g. e-mail consultation, surgical day-care, ...)
+	ServiceType             []CodeableReference       `json:"serviceType,omitempty" bson:"service_type,omitempty"`                     // Specific type of service
+	Subject                 *Reference                `json:"subject,omitempty" bson:"subject,omitempty"`                              // The patient or group related to this encounter
+	SubjectStatus           *CodeableConcept          `json:"subjectStatus,omitempty" bson:"subject_status,omitempty"`                 // The current status of the subject in relation to the Encounter
+	EpisodeOfCare           []Reference               `json:"episodeOfCare,omitempty" bson:"episode_of_care,omitempty"`                // Episode(s) of care that this encounter should be recorded against
+	BasedOn                 []Reference               `json:"basedOn,omitempty" bson:"based_on,omitempty"`                             // The request that initiated this encounter
+	CareTeam                []Reference               `json:"careTeam,omitempty" bson:"care_team,omitempty"`                           // The group(s) that are allocated to participate in this encounter
+	PartOf                  *Reference                `json:"partOf,omitempty" bson:"part_of,omitempty"`                               // Another Encounter this encounter is part of
+	ServiceProvider         *Reference                `json:"serviceProvider,omitempty" bson:"service_provider,omitempty"`             // The organization (facility) responsible for this encounter
+	Participant             []EncounterParticipant    `json:"participant,omitempty" bson:"participant,omitempty"`                      // List of participants involved in the encounter
+	Appointment             []Reference               `json:"appointment,omitempty" bson:"appointment,omitempty"`                      // The appointment that scheduled this encounter
+	VirtualService          []VirtualServiceDetail    `json:"virtualService,omitempty" bson:"virtual_service,omitempty"`               // Connection details of a virtual service (e.g. conference call)
+	ActualPeriod            *Period                   `json:"actualPeriod,omitempty" bson:"actual_period,omitempty"`                   // The actual start and end time of the encounter
+	PlannedStartDate        *DateTime                 `json:"plannedStartDate,omitempty" bson:"planned_start_date,omitempty"`          // The planned start date/time (or admission date) of the encounter
+	PlannedStartDateElement *Element                  `json:"_plannedStartDate,omitempty" bson:"planned_start_date_element,omitempty"` // Extensions for plannedStartDate
+	PlannedEndDate          *DateTime                 `json:"plannedEndDate,omitempty" bson:"planned_end_date,omitempty"`              // The planned end date/time (or discharge date) of the encounter
+	PlannedEndDateElement   *Element                  `json:"_plannedEndDate,omitempty" bson:"planned_end_date_element,omitempty"`     // Extensions for plannedEndDate
+	Length                  *Duration                 `json:"length,omitempty" bson:"length,omitempty"`                                // Actual quantity of time the encounter lasted (less time absent)
+	Reason                  []EncounterReason         `json:"reason,omitempty" bson:"reason,omitempty"`                                // The list of medical reasons that are expected to be addressed during the episode of care
+	Diagnosis               []EncounterDiagnosis      `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                          // The list of diagnosis relevant to this encounter
+	Account                 []Reference               `json:"account,omitempty" bson:"account,omitempty"`                              // The set of accounts that may be used for billing for this Encounter
+	DietPreference          []CodeableConcept         `json:"dietPreference,omitempty" bson:"diet_preference,omitempty"`               // Diet preferences reported by the patient
+	SpecialArrangement      []CodeableConcept         `json:"specialArrangement,omitempty" bson:"special_arrangement,omitempty"`       // Wheelchair, translator, stretcher, etc
+	SpecialCourtesy         []CodeableConcept         `json:"specialCourtesy,omitempty" bson:"special_courtesy,omitempty"`             // Special courtesies (VIP, board member)
+	Admission               *EncounterAdmission       `json:"admission,omitempty" bson:"admission,omitempty"`                          // Details about the admission to a healthcare service
+	Location                []EncounterLocation       `json:"location,omitempty" bson:"location,omitempty"`                            // List of locations where the patient has been
 }
 
+var encounterSubjectTargets = []string{"Group", "Patient"}
+
+var encounterEpisodeOfCareTargets = []string{"EpisodeOfCare"}
+
+var encounterBasedOnTargets = []string{"CarePlan", "DeviceRequest", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest", "VisionPrescription"}
+
+var encounterCareTeamTargets = []string{"CareTeam"}
+
+var encounterPartOfTargets = []string{"Encounter"}
+
+var encounterServiceProviderTargets = []string{"Organization"}
+
+var encounterAppointmentTargets = []string{"Appointment"}
+
+var encounterAccountTargets = []string{"Account"}
+
 func (r *Encounter) Validate() error {
 	if r.ResourceType != "Encounter" {
 		return fmt.Errorf("invalid resourceType: expected 'Encounter', got '%s'", r.ResourceType)
@@ -54,20 +78,55 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.BusinessStatus {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BusinessStatus[%d]: %w", i, err)
@@ -93,6 +152,11 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("ServiceType[%d]: %w", i, err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, encounterSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, encounterSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -103,26 +167,51 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("SubjectStatus: %w", err)
 		}
 	}
+	for i, item := range r.EpisodeOfCare {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterEpisodeOfCareTargets); target != "" {
+			return fmt.Errorf("field 'EpisodeOfCare[%d]' cannot refer to %s, expected one of %v", i, target, encounterEpisodeOfCareTargets)
+		}
+	}
 	for i, item := range r.EpisodeOfCare {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("EpisodeOfCare[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, encounterBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.CareTeam {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterCareTeamTargets); target != "" {
+			return fmt.Errorf("field 'CareTeam[%d]' cannot refer to %s, expected one of %v", i, target, encounterCareTeamTargets)
+		}
+	}
 	for i, item := range r.CareTeam {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("CareTeam[%d]: %w", i, err)
 		}
 	}
+	if r.PartOf != nil {
+		if target := invalidReferenceTarget(r.PartOf.Reference, r.PartOf.Type, encounterPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf' cannot refer to %s, expected one of %v", target, encounterPartOfTargets)
+		}
+	}
 	if r.PartOf != nil {
 		if err := r.PartOf.Validate(); err != nil {
 			return fmt.Errorf("PartOf: %w", err)
 		}
 	}
+	if r.ServiceProvider != nil {
+		if target := invalidReferenceTarget(r.ServiceProvider.Reference, r.ServiceProvider.Type, encounterServiceProviderTargets); target != "" {
+			return fmt.Errorf("field 'ServiceProvider' cannot refer to %s, expected one of %v", target, encounterServiceProviderTargets)
+		}
+	}
 	if r.ServiceProvider != nil {
 		if err := r.ServiceProvider.Validate(); err != nil {
 			return fmt.Errorf("ServiceProvider: %w", err)
@@ -133,6 +222,11 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("Participant[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Appointment {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterAppointmentTargets); target != "" {
+			return fmt.Errorf("field 'Appointment[%d]' cannot refer to %s, expected one of %v", i, target, encounterAppointmentTargets)
+		}
+	}
 	for i, item := range r.Appointment {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Appointment[%d]: %w", i, err)
@@ -148,6 +242,26 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("ActualPeriod: %w", err)
 		}
 	}
+	if r.PlannedStartDate != nil {
+		if err := r.PlannedStartDate.Validate(); err != nil {
+			return fmt.Errorf("PlannedStartDate: %w", err)
+		}
+	}
+	if r.PlannedStartDateElement != nil {
+		if err := r.PlannedStartDateElement.Validate(); err != nil {
+			return fmt.Errorf("PlannedStartDateElement: %w", err)
+		}
+	}
+	if r.PlannedEndDate != nil {
+		if err := r.PlannedEndDate.Validate(); err != nil {
+			return fmt.Errorf("PlannedEndDate: %w", err)
+		}
+	}
+	if r.PlannedEndDateElement != nil {
+		if err := r.PlannedEndDateElement.Validate(); err != nil {
+			return fmt.Errorf("PlannedEndDateElement: %w", err)
+		}
+	}
 	if r.Length != nil {
 		if err := r.Length.Validate(); err != nil {
 			return fmt.Errorf("Length: %w", err)
@@ -163,6 +277,11 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("Diagnosis[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Account {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterAccountTargets); target != "" {
+			return fmt.Errorf("field 'Account[%d]' cannot refer to %s, expected one of %v", i, target, encounterAccountTargets)
+		}
+	}
 	for i, item := range r.Account {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Account[%d]: %w", i, err)
@@ -193,17 +312,464 @@ func (r *Encounter) Validate() error {
 			return fmt.Errorf("Location[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Encounter")
+}
+
+func (r *Encounter) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Encounter" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Encounter', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.BusinessStatus {
+		item.validateAll(fmt.Sprintf("%s.businessStatus[%d]", path, i), issues)
+	}
+	for i, item := range r.Class {
+		item.validateAll(fmt.Sprintf("%s.class[%d]", path, i), issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceType {
+		item.validateAll(fmt.Sprintf("%s.serviceType[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, encounterSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, encounterSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.SubjectStatus != nil {
+		r.SubjectStatus.validateAll(path+".subjectStatus", issues)
+	}
+	for i, item := range r.EpisodeOfCare {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterEpisodeOfCareTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.episodeOfCare[%d]", path, i), fmt.Sprintf("field 'EpisodeOfCare[%d]' cannot refer to %s, expected one of %v", i, target, encounterEpisodeOfCareTargets))
+		}
+	}
+	for i, item := range r.EpisodeOfCare {
+		item.validateAll(fmt.Sprintf("%s.episodeOfCare[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, encounterBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.CareTeam {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterCareTeamTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.careTeam[%d]", path, i), fmt.Sprintf("field 'CareTeam[%d]' cannot refer to %s, expected one of %v", i, target, encounterCareTeamTargets))
+		}
+	}
+	for i, item := range r.CareTeam {
+		item.validateAll(fmt.Sprintf("%s.careTeam[%d]", path, i), issues)
+	}
+	if r.PartOf != nil {
+		if target := invalidReferenceTarget(r.PartOf.Reference, r.PartOf.Type, encounterPartOfTargets); target != "" {
+			issues.add("value", path+".partOf", fmt.Sprintf("field 'PartOf' cannot refer to %s, expected one of %v", target, encounterPartOfTargets))
+		}
+	}
+	if r.PartOf != nil {
+		r.PartOf.validateAll(path+".partOf", issues)
+	}
+	if r.ServiceProvider != nil {
+		if target := invalidReferenceTarget(r.ServiceProvider.Reference, r.ServiceProvider.Type, encounterServiceProviderTargets); target != "" {
+			issues.add("value", path+".serviceProvider", fmt.Sprintf("field 'ServiceProvider' cannot refer to %s, expected one of %v", target, encounterServiceProviderTargets))
+		}
+	}
+	if r.ServiceProvider != nil {
+		r.ServiceProvider.validateAll(path+".serviceProvider", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	for i, item := range r.Appointment {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterAppointmentTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.appointment[%d]", path, i), fmt.Sprintf("field 'Appointment[%d]' cannot refer to %s, expected one of %v", i, target, encounterAppointmentTargets))
+		}
+	}
+	for i, item := range r.Appointment {
+		item.validateAll(fmt.Sprintf("%s.appointment[%d]", path, i), issues)
+	}
+	for i, item := range r.VirtualService {
+		item.validateAll(fmt.Sprintf("%s.virtualService[%d]", path, i), issues)
+	}
+	if r.ActualPeriod != nil {
+		r.ActualPeriod.validateAll(path+".actualPeriod", issues)
+	}
+	if r.PlannedStartDate != nil {
+		if err := r.PlannedStartDate.Validate(); err != nil {
+			issues.add("value", path+".plannedStartDate", err.Error())
+		}
+	}
+	if r.PlannedStartDateElement != nil {
+		r.PlannedStartDateElement.validateAll(path+".plannedStartDate", issues)
+	}
+	if r.PlannedEndDate != nil {
+		if err := r.PlannedEndDate.Validate(); err != nil {
+			issues.add("value", path+".plannedEndDate", err.Error())
+		}
+	}
+	if r.PlannedEndDateElement != nil {
+		r.PlannedEndDateElement.validateAll(path+".plannedEndDate", issues)
+	}
+	if r.Length != nil {
+		r.Length.validateAll(path+".length", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Diagnosis {
+		item.validateAll(fmt.Sprintf("%s.diagnosis[%d]", path, i), issues)
+	}
+	for i, item := range r.Account {
+		if target := invalidReferenceTarget(item.Reference, item.Type, encounterAccountTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.account[%d]", path, i), fmt.Sprintf("field 'Account[%d]' cannot refer to %s, expected one of %v", i, target, encounterAccountTargets))
+		}
+	}
+	for i, item := range r.Account {
+		item.validateAll(fmt.Sprintf("%s.account[%d]", path, i), issues)
+	}
+	for i, item := range r.DietPreference {
+		item.validateAll(fmt.Sprintf("%s.dietPreference[%d]", path, i), issues)
+	}
+	for i, item := range r.SpecialArrangement {
+		item.validateAll(fmt.Sprintf("%s.specialArrangement[%d]", path, i), issues)
+	}
+	for i, item := range r.SpecialCourtesy {
+		item.validateAll(fmt.Sprintf("%s.specialCourtesy[%d]", path, i), issues)
+	}
+	if r.Admission != nil {
+		r.Admission.validateAll(path+".admission", issues)
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+}
+
+func (r *Encounter) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Encounter", &issues)
+	checkInvariants(r, "Encounter", &issues)
+	return issues
+}
+
+var encounterInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Encounter) invariants() []invariant {
+	return encounterInvariants
+}
+
+func (r *Encounter) UnmarshalJSON(data []byte) error {
+	type alias Encounter
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	return nil
+}
+
+func (r Encounter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Encounter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Encounter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Encounter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Encounter) elementTypes() map[string]string {
+	return encounterElementTypes
+}
+
+var encounterElementMetadata = []ElementMetadata{
+	{Path: "Encounter.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Encounter.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Encounter.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Encounter.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Encounter.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Encounter.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Encounter.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Encounter.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Encounter.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Encounter.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/encounter-status"}},
+	{Path: "Encounter.businessStatus", Name: "businessStatus", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Encounter.class", Name: "class", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.priority", Name: "priority", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.type", Name: "type", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.serviceType", Name: "serviceType", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Encounter.subject", Name: "subject", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Encounter.subjectStatus", Name: "subjectStatus", Field: 20, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.episodeOfCare", Name: "episodeOfCare", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/EpisodeOfCare"}},
+	{Path: "Encounter.basedOn", Name: "basedOn", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Encounter.careTeam", Name: "careTeam", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam"}},
+	{Path: "Encounter.partOf", Name: "partOf", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Encounter.serviceProvider", Name: "serviceProvider", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Encounter.participant", Name: "participant", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Encounter.appointment", Name: "appointment", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment"}},
+	{Path: "Encounter.virtualService", Name: "virtualService", Field: 28, Min: 0, Max: "*", Types: []string{"VirtualServiceDetail"}},
+	{Path: "Encounter.actualPeriod", Name: "actualPeriod", Field: 29, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Encounter.plannedStartDate", Name: "plannedStartDate", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Encounter.plannedEndDate", Name: "plannedEndDate", Field: 32, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Encounter.length", Name: "length", Field: 34, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "Encounter.reason", Name: "reason", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Encounter.diagnosis", Name: "diagnosis", Field: 36, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Encounter.account", Name: "account", Field: 37, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account"}},
+	{Path: "Encounter.dietPreference", Name: "dietPreference", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.specialArrangement", Name: "specialArrangement", Field: 39, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.specialCourtesy", Name: "specialCourtesy", Field: 40, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.admission", Name: "admission", Field: 41, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Encounter.location", Name: "location", Field: 42, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Encounter) ElementMetadata() []ElementMetadata {
+	return encounterElementMetadata
+}
+
+func (r *Encounter) GetResourceType() string {
+	return "Encounter"
+}
+
+func (r *Encounter) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Encounter) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Encounter) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Encounter) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Encounter) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Encounter) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Encounter) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Encounter)(nil)
+
+type EncounterBusinessStatus struct {
+	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored even if unrecognized
+	Code                 *CodeableConcept `json:"code" bson:"code"`                                                 // The current business status
+	Type                 *Coding          `json:"type,omitempty" bson:"type,omitempty"`                             // The kind of workflow the status is tracking
+	EffectiveDate        *DateTime        `json:"effectiveDate,omitempty" bson:"effective_date,omitempty"`          // When the encounter entered this business status
+	EffectiveDateElement *Element         `json:"_effectiveDate,omitempty" bson:"effective_date_element,omitempty"` // Extensions for effectiveDate
+}
+
+func (r *EncounterBusinessStatus) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.EffectiveDate != nil {
+		if err := r.EffectiveDate.Validate(); err != nil {
+			return fmt.Errorf("EffectiveDate: %w", err)
+		}
+	}
+	if r.EffectiveDateElement != nil {
+		if err := r.EffectiveDateElement.Validate(); err != nil {
+			return fmt.Errorf("EffectiveDateElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *EncounterBusinessStatus) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.EffectiveDate != nil {
+		if err := r.EffectiveDate.Validate(); err != nil {
+			issues.add("value", path+".effectiveDate", err.Error())
+		}
+	}
+	if r.EffectiveDateElement != nil {
+		r.EffectiveDateElement.validateAll(path+".effectiveDate", issues)
+	}
+}
+
+var encounterBusinessStatusInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EncounterBusinessStatus) invariants() []invariant {
+	return encounterBusinessStatusInvariants
+}
+
+func (r EncounterBusinessStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EncounterBusinessStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EncounterBusinessStatus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EncounterBusinessStatus) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterBusinessStatusElementMetadata = []ElementMetadata{
+	{Path: "Encounter.businessStatus.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Encounter.businessStatus.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.businessStatus.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.businessStatus.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.businessStatus.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "Encounter.businessStatus.effectiveDate", Name: "effectiveDate", Field: 5, Min: 0, Max: "1", Types: []string{"dateTime"}},
+}
+
+func (r *EncounterBusinessStatus) ElementMetadata() []ElementMetadata {
+	return encounterBusinessStatusElementMetadata
+}
+
 type EncounterParticipant struct {
-	Id     *string           `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Type   []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`     // Role of participant in encounter
-	Period *Period           `json:"period,omitempty" bson:"period,omitempty"` // Period of time during the encounter that the participant participated
-	Actor  *Reference        `json:"actor,omitempty" bson:"actor,omitempty"`   // The individual, device, or service participating in the encounter
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Role of participant in encounter
+	Period            *Period           `json:"period,omitempty" bson:"period,omitempty"`                        // Period of time during the encounter that the participant participated
+	Actor             *Reference        `json:"actor,omitempty" bson:"actor,omitempty"`                          // The individual, device, or service participating in the encounter
 }
 
+var encounterParticipantActorTargets = []string{"Device", "Group", "HealthcareService", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *EncounterParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
@@ -214,6 +780,11 @@ func (r *EncounterParticipant) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, encounterParticipantActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, encounterParticipantActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -222,13 +793,85 @@ func (r *EncounterParticipant) Validate() error {
 	return nil
 }
 
+func (r *EncounterParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, encounterParticipantActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, encounterParticipantActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var encounterParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EncounterParticipant) invariants() []invariant {
+	return encounterParticipantInvariants
+}
+
+func (r EncounterParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EncounterParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EncounterParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EncounterParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterParticipantElementMetadata = []ElementMetadata{
+	{Path: "Encounter.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Encounter.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.participant.type", Name: "type", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.participant.period", Name: "period", Field: 4, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Encounter.participant.actor", Name: "actor", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *EncounterParticipant) ElementMetadata() []ElementMetadata {
+	return encounterParticipantElementMetadata
+}
+
 type EncounterReason struct {
-	Id    *string             `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Use   []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`     // What the reason value should be used for/as
-	Value []CodeableReference `json:"value,omitempty" bson:"value,omitempty"` // Reason the encounter takes place (core or reference)
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Use               []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`                              // What the reason value should be used for/as
+	Value             []CodeableReference `json:"value,omitempty" bson:"value,omitempty"`                          // Reason the encounter takes place (core or reference)
 }
 
 func (r *EncounterReason) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Use {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Use[%d]: %w", i, err)
@@ -242,13 +885,76 @@ func (r *EncounterReason) Validate() error {
 	return nil
 }
 
+func (r *EncounterReason) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Use {
+		item.validateAll(fmt.Sprintf("%s.use[%d]", path, i), issues)
+	}
+	for i, item := range r.Value {
+		item.validateAll(fmt.Sprintf("%s.value[%d]", path, i), issues)
+	}
+}
+
+var encounterReasonInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EncounterReason) invariants() []invariant {
+	return encounterReasonInvariants
+}
+
+func (r EncounterReason) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EncounterReason) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EncounterReason) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EncounterReason) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterReasonElementMetadata = []ElementMetadata{
+	{Path: "Encounter.reason.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Encounter.reason.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.reason.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.reason.use", Name: "use", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.reason.value", Name: "value", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+}
+
+func (r *EncounterReason) ElementMetadata() []ElementMetadata {
+	return encounterReasonElementMetadata
+}
+
 type EncounterDiagnosis struct {
-	Id        *string             `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Condition []CodeableReference `json:"condition,omitempty" bson:"condition,omitempty"` // The diagnosis relevant to the encounter
-	Use       []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`             // Role that this diagnosis has within the encounter (e.g. admission, billing, discharge …)
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Condition         []CodeableReference `json:"condition,omitempty" bson:"condition,omitempty"`                  // The diagnosis relevant to the encounter
+	Use               []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`                              // Role that this diagnosis has within the encounter (e.g. admission, billing, discharge …)
 }
 
 func (r *EncounterDiagnosis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Condition {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Condition[%d]: %w", i, err)
@@ -262,8 +968,61 @@ func (r *EncounterDiagnosis) Validate() error {
 	return nil
 }
 
+func (r *EncounterDiagnosis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Use {
+		item.validateAll(fmt.Sprintf("%s.use[%d]", path, i), issues)
+	}
+}
+
+var encounterDiagnosisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EncounterDiagnosis) invariants() []invariant {
+	return encounterDiagnosisInvariants
+}
+
+func (r EncounterDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EncounterDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EncounterDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EncounterDiagnosis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterDiagnosisElementMetadata = []ElementMetadata{
+	{Path: "Encounter.diagnosis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Encounter.diagnosis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.diagnosis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.diagnosis.condition", Name: "condition", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Encounter.diagnosis.use", Name: "use", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *EncounterDiagnosis) ElementMetadata() []ElementMetadata {
+	return encounterDiagnosisElementMetadata
+}
+
 type EncounterAdmission struct {
 	Id                     *string          `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
+	Extension              []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                             // Additional content defined by implementations
+	ModifierExtension      []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`            // Extensions that cannot be ignored even if unrecognized
 	PreAdmissionIdentifier *Identifier      `json:"preAdmissionIdentifier,omitempty" bson:"pre_admission_identifier,omitempty"` // Pre-admission identifier
 	Origin                 *Reference       `json:"origin,omitempty" bson:"origin,omitempty"`                                   // The location/organization from which the patient came before admission
 	AdmitSource            *CodeableConcept `json:"admitSource,omitempty" bson:"admit_source,omitempty"`                        // From where patient was admitted (physician referral, transfer)
@@ -273,6 +1032,16 @@ type EncounterAdmission struct {
 }
 
 func (r *EncounterAdmission) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.PreAdmissionIdentifier != nil {
 		if err := r.PreAdmissionIdentifier.Validate(); err != nil {
 			return fmt.Errorf("PreAdmissionIdentifier: %w", err)
@@ -306,23 +1075,118 @@ func (r *EncounterAdmission) Validate() error {
 	return nil
 }
 
+func (r *EncounterAdmission) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.PreAdmissionIdentifier != nil {
+		r.PreAdmissionIdentifier.validateAll(path+".preAdmissionIdentifier", issues)
+	}
+	if r.Origin != nil {
+		r.Origin.validateAll(path+".origin", issues)
+	}
+	if r.AdmitSource != nil {
+		r.AdmitSource.validateAll(path+".admitSource", issues)
+	}
+	if r.ReAdmission != nil {
+		r.ReAdmission.validateAll(path+".reAdmission", issues)
+	}
+	if r.Destination != nil {
+		r.Destination.validateAll(path+".destination", issues)
+	}
+	if r.DischargeDisposition != nil {
+		r.DischargeDisposition.validateAll(path+".dischargeDisposition", issues)
+	}
+}
+
+var encounterAdmissionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EncounterAdmission) invariants() []invariant {
+	return encounterAdmissionInvariants
+}
+
+func (r EncounterAdmission) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EncounterAdmission) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EncounterAdmission) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EncounterAdmission) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterAdmissionElementMetadata = []ElementMetadata{
+	{Path: "Encounter.admission.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Encounter.admission.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.admission.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.admission.preAdmissionIdentifier", Name: "preAdmissionIdentifier", Field: 3, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Encounter.admission.origin", Name: "origin", Field: 4, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Encounter.admission.admitSource", Name: "admitSource", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.admission.reAdmission", Name: "reAdmission", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.admission.destination", Name: "destination", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Encounter.admission.dischargeDisposition", Name: "dischargeDisposition", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *EncounterAdmission) ElementMetadata() []ElementMetadata {
+	return encounterAdmissionElementMetadata
+}
+
 type EncounterLocation struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Location *Reference       `json:"location" bson:"location"`                 // Location the encounter takes place
-	Status   *string          `json:"status,omitempty" bson:"status,omitempty"` // planned | active | reserved | completed
-	Form     *CodeableConcept `json:"form,omitempty" bson:"form,omitempty"`     // The physical type of the location (usually the level in the location hierarchy - bed, room, ward, virtual etc.)
-	Period   *Period          `json:"period,omitempty" bson:"period,omitempty"` // Time period during which the patient was present at the location
+	Id                *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Location          *Reference               `json:"location" bson:"location"`                                        // Location the encounter takes place
+	Status            *EncounterLocationStatus `json:"status,omitempty" bson:"status,omitempty"`                        // planned | active | reserved | completed
+	StatusElement     *Element                 `json:"_status,omitempty" bson:"status_element,omitempty"`               // Extensions for status
+	Form              *CodeableConcept         `json:"form,omitempty" bson:"form,omitempty"`                            // The physical type of the location (usually the level in the location hierarchy - bed, room, ward, virtual etc.)
+	Period            *Period                  `json:"period,omitempty" bson:"period,omitempty"`                        // Time period during which the patient was present at the location
 }
 
+var encounterLocationLocationTargets = []string{"Location"}
+
 func (r *EncounterLocation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Location == nil {
 		return fmt.Errorf("field 'Location' is required")
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, encounterLocationLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, encounterLocationLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Form != nil {
 		if err := r.Form.Validate(); err != nil {
 			return fmt.Errorf("Form: %w", err)
@@ -336,26 +1200,72 @@ func (r *EncounterLocation) Validate() error {
 	return nil
 }
 
-type EncounterBusinessStatus struct {
-	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Code          *CodeableConcept `json:"code" bson:"code"`                                        // The current business status
-	Type          *Coding          `json:"type,omitempty" bson:"type,omitempty"`                    // The kind of workflow the status is tracking
-	EffectiveDate *string          `json:"effectiveDate,omitempty" bson:"effective_date,omitempty"` // When the encounter entered this business status
-}
-
-func (r *EncounterBusinessStatus) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
+func (r *EncounterLocation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.Location == nil {
+		issues.add("required", path+".location", "field 'Location' is required")
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, encounterLocationLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, encounterLocationLocationTargets))
 		}
 	}
-	return nil
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Form != nil {
+		r.Form.validateAll(path+".form", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var encounterLocationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EncounterLocation) invariants() []invariant {
+	return encounterLocationInvariants
+}
+
+func (r EncounterLocation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EncounterLocation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EncounterLocation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EncounterLocation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var encounterLocationElementMetadata = []ElementMetadata{
+	{Path: "Encounter.location.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Encounter.location.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Encounter.location.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Encounter.location.location", Name: "location", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Encounter.location.status", Name: "status", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Encounter.location.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/encounter-location-status"}},
+	{Path: "Encounter.location.form", Name: "form", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Encounter.location.period", Name: "period", Field: 7, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *EncounterLocation) ElementMetadata() []ElementMetadata {
+	return encounterLocationElementMetadata
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,14 +12,21 @@ type Endpoint struct {
 	Id                   *string           `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
 	Meta                 *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
 	ImplicitRules        *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
+	ImplicitRulesElement *Element          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`      // Extensions for implicitRules
 	Language             *string           `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
+	LanguageElement      *Element          `json:"_language,omitempty" bson:"language_element,omitempty"`                 // Extensions for language
 	Text                 *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Contained            []Resource        `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Extension            []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored
 	Identifier           []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // Identifies this endpoint across multiple systems
-	Status               string            `json:"status" bson:"status"`                                                  // active | limited | suspended | error | off | entered-in-error
+	Status               EndpointStatus    `json:"status" bson:"status"`                                                  // active | limited | suspended | error | off | entered-in-error
+	StatusElement        *Element          `json:"_status,omitempty" bson:"status_element,omitempty"`                     // Extensions for status
 	ConnectionType       []CodeableConcept `json:"connectionType" bson:"connection_type"`                                 // Protocol/Profile/Standard to be used with this endpoint connection
 	Name                 *string           `json:"name,omitempty" bson:"name,omitempty"`                                  // A name that this endpoint can be identified by
+	NameElement          *Element          `json:"_name,omitempty" bson:"name_element,omitempty"`                         // Extensions for name
 	Description          *string           `json:"description,omitempty" bson:"description,omitempty"`                    // Additional details about the endpoint that could be displayed as further information to identify the description beyond its name
+	DescriptionElement   *Element          `json:"_description,omitempty" bson:"description_element,omitempty"`           // Extensions for description
 	EnvironmentType      []CodeableConcept `json:"environmentType,omitempty" bson:"environment_type,omitempty"`           // The type of environment(s) exposed at this endpoint
 	ManagingOrganization *Reference        `json:"managingOrganization,omitempty" bson:"managing_organization,omitempty"` // Organization that manages this endpoint (might not be the organization that exposes the endpoint)
 	Contact              []ContactPoint    `json:"contact,omitempty" bson:"contact,omitempty"`                            // Contact details for source (e.g. troubleshooting)
@@ -26,9 +34,13 @@ type Endpoint struct {
 	Availability         *Availability     `json:"availability,omitempty" bson:"availability,omitempty"`                  // Times the endpoint is expected to be available (including exceptions)
 	Payload              []EndpointPayload `json:"payload,omitempty" bson:"payload,omitempty"`                            // Set of payloads that are provided by this endpoint
 	Address              string            `json:"address" bson:"address"`                                                // The technical base address for connecting to this endpoint
+	AddressElement       *Element          `json:"_address,omitempty" bson:"address_element,omitempty"`                   // Extensions for address
 	Header               []string          `json:"header,omitempty" bson:"header,omitempty"`                              // Usage depends on the channel type
+	HeaderElement        []*Element        `json:"_header,omitempty" bson:"header_element,omitempty"`                     // Extensions for header
 }
 
+var endpointManagingOrganizationTargets = []string{"Organization"}
+
 func (r *Endpoint) Validate() error {
 	if r.ResourceType != "Endpoint" {
 		return fmt.Errorf("invalid resourceType: expected 'Endpoint', got '%s'", r.ResourceType)
@@ -38,20 +50,55 @@ func (r *Endpoint) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if len(r.ConnectionType) < 1 {
 		return fmt.Errorf("field 'ConnectionType' must have at least 1 elements")
 	}
@@ -60,11 +107,26 @@ func (r *Endpoint) Validate() error {
 			return fmt.Errorf("ConnectionType[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.EnvironmentType {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("EnvironmentType[%d]: %w", i, err)
 		}
 	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, endpointManagingOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, endpointManagingOrganizationTargets)
+		}
+	}
 	if r.ManagingOrganization != nil {
 		if err := r.ManagingOrganization.Validate(); err != nil {
 			return fmt.Errorf("ManagingOrganization: %w", err)
@@ -90,25 +152,438 @@ func (r *Endpoint) Validate() error {
 			return fmt.Errorf("Payload[%d]: %w", i, err)
 		}
 	}
+	var emptyString string
 	if r.Address == emptyString {
 		return fmt.Errorf("field 'Address' is required")
 	}
+	if r.AddressElement != nil {
+		if err := r.AddressElement.Validate(); err != nil {
+			return fmt.Errorf("AddressElement: %w", err)
+		}
+	}
+	for i, item := range r.HeaderElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("HeaderElement[%d]: %w", i, err)
+		}
+	}
+	return validateInvariants(r, "Endpoint")
+}
+
+func (r *Endpoint) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Endpoint" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Endpoint', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if len(r.ConnectionType) < 1 {
+		issues.add("required", path+".connectionType", "field 'ConnectionType' must have at least 1 elements")
+	}
+	for i, item := range r.ConnectionType {
+		item.validateAll(fmt.Sprintf("%s.connectionType[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.EnvironmentType {
+		item.validateAll(fmt.Sprintf("%s.environmentType[%d]", path, i), issues)
+	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, endpointManagingOrganizationTargets); target != "" {
+			issues.add("value", path+".managingOrganization", fmt.Sprintf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, endpointManagingOrganizationTargets))
+		}
+	}
+	if r.ManagingOrganization != nil {
+		r.ManagingOrganization.validateAll(path+".managingOrganization", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Availability != nil {
+		r.Availability.validateAll(path+".availability", issues)
+	}
+	for i, item := range r.Payload {
+		item.validateAll(fmt.Sprintf("%s.payload[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Address == emptyString {
+		issues.add("required", path+".address", "field 'Address' is required")
+	}
+	if r.AddressElement != nil {
+		r.AddressElement.validateAll(path+".address", issues)
+	}
+	for i, item := range r.HeaderElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.header[%d]", path, i), issues)
+	}
+}
+
+func (r *Endpoint) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Endpoint", &issues)
+	checkInvariants(r, "Endpoint", &issues)
+	return issues
+}
+
+var endpointInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Endpoint) invariants() []invariant {
+	return endpointInvariants
+}
+
+func (r Endpoint) MarshalJSON() ([]byte, error) {
+	type alias Endpoint
+	out := struct {
+		alias
+		Header        []*string  `json:"header,omitempty"`
+		HeaderElement []*Element `json:"_header,omitempty"`
+	}{alias: alias(r)}
+	out.Header, out.HeaderElement = alignPrimitiveArray(r.Header, r.HeaderElement)
+	return json.Marshal(out)
+}
+
+func (r *Endpoint) UnmarshalJSON(data []byte) error {
+	type alias Endpoint
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Header    []*string         `json:"header,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Header, err = splitPrimitiveArray(aux.Header, r.HeaderElement); err != nil {
+		return fmt.Errorf("header: %w", err)
+	}
 	return nil
 }
 
+func (r Endpoint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Endpoint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Endpoint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Endpoint) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var endpointElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"address":       "url",
+}
+
+func (r *Endpoint) elementTypes() map[string]string {
+	return endpointElementTypes
+}
+
+var endpointElementMetadata = []ElementMetadata{
+	{Path: "Endpoint.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Endpoint.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Endpoint.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Endpoint.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Endpoint.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Endpoint.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Endpoint.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Endpoint.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Endpoint.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Endpoint.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Endpoint.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Endpoint.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/endpoint-status"}},
+	{Path: "Endpoint.connectionType", Name: "connectionType", Field: 14, Min: 1, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Endpoint.name", Name: "name", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Endpoint.description", Name: "description", Field: 17, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Endpoint.environmentType", Name: "environmentType", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Endpoint.managingOrganization", Name: "managingOrganization", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Endpoint.contact", Name: "contact", Field: 21, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "Endpoint.period", Name: "period", Field: 22, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Endpoint.availability", Name: "availability", Field: 23, Min: 0, Max: "1", Types: []string{"Availability"}},
+	{Path: "Endpoint.payload", Name: "payload", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Endpoint.address", Name: "address", Field: 25, Min: 1, Max: "1", Types: []string{"url"}},
+	{Path: "Endpoint.header", Name: "header", Field: 27, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *Endpoint) ElementMetadata() []ElementMetadata {
+	return endpointElementMetadata
+}
+
+func (r *Endpoint) GetResourceType() string {
+	return "Endpoint"
+}
+
+func (r *Endpoint) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Endpoint) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Endpoint) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Endpoint) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Endpoint) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Endpoint) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Endpoint) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Endpoint)(nil)
+
 type EndpointPayload struct {
-	Id               *string           `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Type             []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                          // The type of content that may be used at this endpoint (e.g. XDS Discharge summaries)
-	MimeType         []string          `json:"mimeType,omitempty" bson:"mime_type,omitempty"`                 // Mimetype to send. If not specified, the content could be anything (including no payload, if the connectionType defined this)
-	ProfileCanonical []string          `json:"profileCanonical,omitempty" bson:"profile_canonical,omitempty"` // The profile that is expected at this endpoint
-	ProfileUri       []string          `json:"profileUri,omitempty" bson:"profile_uri,omitempty"`             // The non-fhir based profile that is expected at this endpoint
+	Id                      *string           `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Type                    []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                                   // The type of content that may be used at this endpoint (e.g. XDS Discharge summaries)
+	MimeType                []string          `json:"mimeType,omitempty" bson:"mime_type,omitempty"`                          // Mimetype to send. If not specified, the content could be anything (including no payload, if the connectionType defined this)
+	MimeTypeElement         []*Element        `json:"_mimeType,omitempty" bson:"mime_type_element,omitempty"`                 // Extensions for mimeType
+	ProfileCanonical        []string          `json:"profileCanonical,omitempty" bson:"profile_canonical,omitempty"`          // The profile that is expected at this endpoint
+	ProfileCanonicalElement []*Element        `json:"_profileCanonical,omitempty" bson:"profile_canonical_element,omitempty"` // Extensions for profileCanonical
+	ProfileUri              []string          `json:"profileUri,omitempty" bson:"profile_uri,omitempty"`                      // The non-fhir based profile that is expected at this endpoint
+	ProfileUriElement       []*Element        `json:"_profileUri,omitempty" bson:"profile_uri_element,omitempty"`             // Extensions for profileUri
 }
 
 func (r *EndpointPayload) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.MimeTypeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("MimeTypeElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProfileCanonicalElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileCanonicalElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProfileUriElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileUriElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EndpointPayload) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.MimeTypeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.mimeType[%d]", path, i), issues)
+	}
+	for i, item := range r.ProfileCanonicalElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profileCanonical[%d]", path, i), issues)
+	}
+	for i, item := range r.ProfileUriElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profileUri[%d]", path, i), issues)
+	}
+}
+
+var endpointPayloadInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EndpointPayload) invariants() []invariant {
+	return endpointPayloadInvariants
+}
+
+func (r EndpointPayload) MarshalJSON() ([]byte, error) {
+	type alias EndpointPayload
+	out := struct {
+		alias
+		MimeType                []*string  `json:"mimeType,omitempty"`
+		MimeTypeElement         []*Element `json:"_mimeType,omitempty"`
+		ProfileCanonical        []*string  `json:"profileCanonical,omitempty"`
+		ProfileCanonicalElement []*Element `json:"_profileCanonical,omitempty"`
+		ProfileUri              []*string  `json:"profileUri,omitempty"`
+		ProfileUriElement       []*Element `json:"_profileUri,omitempty"`
+	}{alias: alias(r)}
+	out.MimeType, out.MimeTypeElement = alignPrimitiveArray(r.MimeType, r.MimeTypeElement)
+	out.ProfileCanonical, out.ProfileCanonicalElement = alignPrimitiveArray(r.ProfileCanonical, r.ProfileCanonicalElement)
+	out.ProfileUri, out.ProfileUriElement = alignPrimitiveArray(r.ProfileUri, r.ProfileUriElement)
+	return json.Marshal(out)
+}
+
+func (r *EndpointPayload) UnmarshalJSON(data []byte) error {
+	type alias EndpointPayload
+	aux := struct {
+		*alias
+		MimeType         []*string `json:"mimeType,omitempty"`
+		ProfileCanonical []*string `json:"profileCanonical,omitempty"`
+		ProfileUri       []*string `json:"profileUri,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.MimeType, err = splitPrimitiveArray(aux.MimeType, r.MimeTypeElement); err != nil {
+		return fmt.Errorf("mimeType: %w", err)
+	}
+	if r.ProfileCanonical, err = splitPrimitiveArray(aux.ProfileCanonical, r.ProfileCanonicalElement); err != nil {
+		return fmt.Errorf("profileCanonical: %w", err)
+	}
+	if r.ProfileUri, err = splitPrimitiveArray(aux.ProfileUri, r.ProfileUriElement); err != nil {
+		return fmt.Errorf("profileUri: %w", err)
+	}
 	return nil
 }
+
+func (r EndpointPayload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EndpointPayload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EndpointPayload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EndpointPayload) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var endpointPayloadElementTypes = map[string]string{
+	"mimeType":         "code",
+	"profileCanonical": "canonical",
+	"profileUri":       "uri",
+}
+
+func (r *EndpointPayload) elementTypes() map[string]string {
+	return endpointPayloadElementTypes
+}
+
+var endpointPayloadElementMetadata = []ElementMetadata{
+	{Path: "Endpoint.payload.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Endpoint.payload.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Endpoint.payload.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Endpoint.payload.type", Name: "type", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Endpoint.payload.mimeType", Name: "mimeType", Field: 4, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "Endpoint.payload.profileCanonical", Name: "profileCanonical", Field: 6, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "Endpoint.payload.profileUri", Name: "profileUri", Field: 8, Min: 0, Max: "*", Types: []string{"uri"}},
+}
+
+func (r *EndpointPayload) ElementMetadata() []ElementMetadata {
+	return endpointPayloadElementMetadata
+}
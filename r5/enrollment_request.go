@@ -2,27 +2,36 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource provides the insurance enrollment details to the insurer regarding a specified coverage.
 type EnrollmentRequest struct {
-	ResourceType  string            `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string           `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Business Identifier
-	Status        *string           `json:"status,omitempty" bson:"status,omitempty"`                // active | cancelled | draft | entered-in-error
-	Created       *string           `json:"created,omitempty" bson:"created,omitempty"`              // Creation date
-	Insurer       *Reference        `json:"insurer,omitempty" bson:"insurer,omitempty"`              // Target
-	Provider      *Reference        `json:"provider,omitempty" bson:"provider,omitempty"`            // Responsible practitioner
-	Candidate     *Reference        `json:"candidate,omitempty" bson:"candidate,omitempty"`          // The subject(s)to be enrolled
-	Coverage      *Reference        `json:"coverage,omitempty" bson:"coverage,omitempty"`            // Insurance information
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier
+	Status               *ExplanationOfBenefitStatus `json:"status,omitempty" bson:"status,omitempty"`                         // active | cancelled | draft | entered-in-error
+	StatusElement        *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Created              *DateTime                   `json:"created,omitempty" bson:"created,omitempty"`                       // Creation date
+	CreatedElement       *Element                    `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Insurer              *Reference                  `json:"insurer,omitempty" bson:"insurer,omitempty"`                       // Target
+	Provider             *Reference                  `json:"provider,omitempty" bson:"provider,omitempty"`                     // Responsible practitioner
+	Candidate            *Reference                  `json:"candidate,omitempty" bson:"candidate,omitempty"`                   // The subject(s)to be enrolled
+	Coverage             *Reference                  `json:"coverage,omitempty" bson:"coverage,omitempty"`                     // Insurance information
 }
 
+var enrollmentRequestCandidateTargets = []string{"Group", "Patient"}
+
 func (r *EnrollmentRequest) Validate() error {
 	if r.ResourceType != "EnrollmentRequest" {
 		return fmt.Errorf("invalid resourceType: expected 'EnrollmentRequest', got '%s'", r.ResourceType)
@@ -32,16 +41,62 @@ func (r *EnrollmentRequest) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
 	if r.Insurer != nil {
 		if err := r.Insurer.Validate(); err != nil {
 			return fmt.Errorf("Insurer: %w", err)
@@ -52,6 +107,11 @@ func (r *EnrollmentRequest) Validate() error {
 			return fmt.Errorf("Provider: %w", err)
 		}
 	}
+	if r.Candidate != nil {
+		if target := invalidReferenceTarget(r.Candidate.Reference, r.Candidate.Type, enrollmentRequestCandidateTargets); target != "" {
+			return fmt.Errorf("field 'Candidate' cannot refer to %s, expected one of %v", target, enrollmentRequestCandidateTargets)
+		}
+	}
 	if r.Candidate != nil {
 		if err := r.Candidate.Validate(); err != nil {
 			return fmt.Errorf("Candidate: %w", err)
@@ -62,5 +122,197 @@ func (r *EnrollmentRequest) Validate() error {
 			return fmt.Errorf("Coverage: %w", err)
 		}
 	}
+	return validateInvariants(r, "EnrollmentRequest")
+}
+
+func (r *EnrollmentRequest) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "EnrollmentRequest" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'EnrollmentRequest', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Insurer != nil {
+		r.Insurer.validateAll(path+".insurer", issues)
+	}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
+	}
+	if r.Candidate != nil {
+		if target := invalidReferenceTarget(r.Candidate.Reference, r.Candidate.Type, enrollmentRequestCandidateTargets); target != "" {
+			issues.add("value", path+".candidate", fmt.Sprintf("field 'Candidate' cannot refer to %s, expected one of %v", target, enrollmentRequestCandidateTargets))
+		}
+	}
+	if r.Candidate != nil {
+		r.Candidate.validateAll(path+".candidate", issues)
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+}
+
+func (r *EnrollmentRequest) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("EnrollmentRequest", &issues)
+	checkInvariants(r, "EnrollmentRequest", &issues)
+	return issues
+}
+
+var enrollmentRequestInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *EnrollmentRequest) invariants() []invariant {
+	return enrollmentRequestInvariants
+}
+
+func (r *EnrollmentRequest) UnmarshalJSON(data []byte) error {
+	type alias EnrollmentRequest
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r EnrollmentRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EnrollmentRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EnrollmentRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EnrollmentRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var enrollmentRequestElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *EnrollmentRequest) elementTypes() map[string]string {
+	return enrollmentRequestElementTypes
+}
+
+var enrollmentRequestElementMetadata = []ElementMetadata{
+	{Path: "EnrollmentRequest.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "EnrollmentRequest.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "EnrollmentRequest.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "EnrollmentRequest.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EnrollmentRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "EnrollmentRequest.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "EnrollmentRequest.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "EnrollmentRequest.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EnrollmentRequest.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EnrollmentRequest.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "EnrollmentRequest.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EnrollmentRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "EnrollmentRequest.created", Name: "created", Field: 14, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "EnrollmentRequest.insurer", Name: "insurer", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EnrollmentRequest.provider", Name: "provider", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EnrollmentRequest.candidate", Name: "candidate", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "EnrollmentRequest.coverage", Name: "coverage", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *EnrollmentRequest) ElementMetadata() []ElementMetadata {
+	return enrollmentRequestElementMetadata
+}
+
+func (r *EnrollmentRequest) GetResourceType() string {
+	return "EnrollmentRequest"
+}
+
+func (r *EnrollmentRequest) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *EnrollmentRequest) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *EnrollmentRequest) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *EnrollmentRequest) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *EnrollmentRequest) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *EnrollmentRequest) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *EnrollmentRequest) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*EnrollmentRequest)(nil)
@@ -2,29 +2,40 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource provides enrollment and plan details from the processing of an EnrollmentRequest resource.
 type EnrollmentResponse struct {
-	ResourceType    string            `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id              *string           `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta            *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules   *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language        *string           `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	Text            *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                        // Text summary of the resource, for human interpretation
-	Contained       []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`              // Contained, inline Resources
-	Identifier      []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`            // Business Identifier
-	Status          *string           `json:"status,omitempty" bson:"status,omitempty"`                    // active | cancelled | draft | entered-in-error
-	Request         *Reference        `json:"request,omitempty" bson:"request,omitempty"`                  // Claim reference
-	Outcome         *string           `json:"outcome,omitempty" bson:"outcome,omitempty"`                  // queued | complete | error | partial
-	Disposition     *string           `json:"disposition,omitempty" bson:"disposition,omitempty"`          // Disposition Message
-	Created         *string           `json:"created,omitempty" bson:"created,omitempty"`                  // Creation date
-	Organization    *Reference        `json:"organization,omitempty" bson:"organization,omitempty"`        // Insurer
-	RequestProvider *Reference        `json:"requestProvider,omitempty" bson:"request_provider,omitempty"` // Responsible practitioner
-	Candidate       *Reference        `json:"candidate,omitempty" bson:"candidate,omitempty"`              // The subject(s)to be enrolled
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier
+	Status               *ExplanationOfBenefitStatus `json:"status,omitempty" bson:"status,omitempty"`                         // active | cancelled | draft | entered-in-error
+	StatusElement        *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Request              *Reference                  `json:"request,omitempty" bson:"request,omitempty"`                       // Claim reference
+	Outcome              *EnrollmentOutcome          `json:"outcome,omitempty" bson:"outcome,omitempty"`                       // queued | complete | error | partial
+	OutcomeElement       *Element                    `json:"_outcome,omitempty" bson:"outcome_element,omitempty"`              // Extensions for outcome
+	Disposition          *string                     `json:"disposition,omitempty" bson:"disposition,omitempty"`               // Disposition Message
+	DispositionElement   *Element                    `json:"_disposition,omitempty" bson:"disposition_element,omitempty"`      // Extensions for disposition
+	Created              *DateTime                   `json:"created,omitempty" bson:"created,omitempty"`                       // Creation date
+	CreatedElement       *Element                    `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Organization         *Reference                  `json:"organization,omitempty" bson:"organization,omitempty"`             // Insurer
+	RequestProvider      *Reference                  `json:"requestProvider,omitempty" bson:"request_provider,omitempty"`      // Responsible practitioner
+	Candidate            *Reference                  `json:"candidate,omitempty" bson:"candidate,omitempty"`                   // The subject(s)to be enrolled
 }
 
+var enrollmentResponseRequestTargets = []string{"EnrollmentRequest"}
+
 func (r *EnrollmentResponse) Validate() error {
 	if r.ResourceType != "EnrollmentResponse" {
 		return fmt.Errorf("invalid resourceType: expected 'EnrollmentResponse', got '%s'", r.ResourceType)
@@ -34,21 +45,85 @@ func (r *EnrollmentResponse) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, enrollmentResponseRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request' cannot refer to %s, expected one of %v", target, enrollmentResponseRequestTargets)
+		}
+	}
 	if r.Request != nil {
 		if err := r.Request.Validate(); err != nil {
 			return fmt.Errorf("Request: %w", err)
 		}
 	}
+	if r.Outcome != nil && !r.Outcome.IsValid() {
+		return fmt.Errorf("field 'Outcome' has invalid code '%s'", *r.Outcome)
+	}
+	if r.OutcomeElement != nil {
+		if err := r.OutcomeElement.Validate(); err != nil {
+			return fmt.Errorf("OutcomeElement: %w", err)
+		}
+	}
+	if r.DispositionElement != nil {
+		if err := r.DispositionElement.Validate(); err != nil {
+			return fmt.Errorf("DispositionElement: %w", err)
+		}
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
 	if r.Organization != nil {
 		if err := r.Organization.Validate(); err != nil {
 			return fmt.Errorf("Organization: %w", err)
@@ -64,5 +139,208 @@ func (r *EnrollmentResponse) Validate() error {
 			return fmt.Errorf("Candidate: %w", err)
 		}
 	}
+	return validateInvariants(r, "EnrollmentResponse")
+}
+
+func (r *EnrollmentResponse) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "EnrollmentResponse" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'EnrollmentResponse', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, enrollmentResponseRequestTargets); target != "" {
+			issues.add("value", path+".request", fmt.Sprintf("field 'Request' cannot refer to %s, expected one of %v", target, enrollmentResponseRequestTargets))
+		}
+	}
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
+	}
+	if r.Outcome != nil && !r.Outcome.IsValid() {
+		issues.add("code-invalid", path+".outcome", fmt.Sprintf("field 'Outcome' has invalid code '%s'", *r.Outcome))
+	}
+	if r.OutcomeElement != nil {
+		r.OutcomeElement.validateAll(path+".outcome", issues)
+	}
+	if r.DispositionElement != nil {
+		r.DispositionElement.validateAll(path+".disposition", issues)
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Organization != nil {
+		r.Organization.validateAll(path+".organization", issues)
+	}
+	if r.RequestProvider != nil {
+		r.RequestProvider.validateAll(path+".requestProvider", issues)
+	}
+	if r.Candidate != nil {
+		r.Candidate.validateAll(path+".candidate", issues)
+	}
+}
+
+func (r *EnrollmentResponse) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("EnrollmentResponse", &issues)
+	checkInvariants(r, "EnrollmentResponse", &issues)
+	return issues
+}
+
+var enrollmentResponseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *EnrollmentResponse) invariants() []invariant {
+	return enrollmentResponseInvariants
+}
+
+func (r *EnrollmentResponse) UnmarshalJSON(data []byte) error {
+	type alias EnrollmentResponse
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r EnrollmentResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EnrollmentResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EnrollmentResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EnrollmentResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var enrollmentResponseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *EnrollmentResponse) elementTypes() map[string]string {
+	return enrollmentResponseElementTypes
+}
+
+var enrollmentResponseElementMetadata = []ElementMetadata{
+	{Path: "EnrollmentResponse.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "EnrollmentResponse.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "EnrollmentResponse.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "EnrollmentResponse.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EnrollmentResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "EnrollmentResponse.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "EnrollmentResponse.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "EnrollmentResponse.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EnrollmentResponse.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EnrollmentResponse.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "EnrollmentResponse.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EnrollmentResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "EnrollmentResponse.request", Name: "request", Field: 14, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/EnrollmentRequest"}},
+	{Path: "EnrollmentResponse.outcome", Name: "outcome", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EnrollmentResponse.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/enrollment-outcome"}},
+	{Path: "EnrollmentResponse.disposition", Name: "disposition", Field: 17, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EnrollmentResponse.created", Name: "created", Field: 19, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "EnrollmentResponse.organization", Name: "organization", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EnrollmentResponse.requestProvider", Name: "requestProvider", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EnrollmentResponse.candidate", Name: "candidate", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *EnrollmentResponse) ElementMetadata() []ElementMetadata {
+	return enrollmentResponseElementMetadata
+}
+
+func (r *EnrollmentResponse) GetResourceType() string {
+	return "EnrollmentResponse"
+}
+
+func (r *EnrollmentResponse) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *EnrollmentResponse) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *EnrollmentResponse) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *EnrollmentResponse) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *EnrollmentResponse) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *EnrollmentResponse) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *EnrollmentResponse) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*EnrollmentResponse)(nil)
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,11 +12,16 @@ type EpisodeOfCare struct {
 	Id                   *string                      `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
 	Meta                 *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
 	ImplicitRules        *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
+	ImplicitRulesElement *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`      // Extensions for implicitRules
 	Language             *string                      `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
+	LanguageElement      *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`                 // Extensions for language
 	Text                 *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage            `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Contained            []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Extension            []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored
 	Identifier           []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // Business Identifier(s) relevant for this EpisodeOfCare
-	Status               string                       `json:"status" bson:"status"`                                                  // planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	Status               EpisodeOfCareStatus          `json:"status" bson:"status"`                                                  // planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	StatusElement        *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                     // Extensions for status
 	StatusHistory        []EpisodeOfCareStatusHistory `json:"statusHistory,omitempty" bson:"status_history,omitempty"`               // Past list of status codes (the current status may be included to cover the start date of the status)
 	Type                 []CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                                  // Type/class  - e.g. specialist referral, disease management
 	Reason               []EpisodeOfCareReason        `json:"reason,omitempty" bson:"reason,omitempty"`                              // The list of medical reasons that are expected to be addressed during the episode of care
@@ -29,6 +35,12 @@ type EpisodeOfCare struct {
 	Account              []Reference                  `json:"account,omitempty" bson:"account,omitempty"`                            // The set of accounts that may be used for billing for this EpisodeOfCare
 }
 
+var episodeOfCareSubjectTargets = []string{"Group", "Patient"}
+
+var episodeOfCareManagingOrganizationTargets = []string{"Organization"}
+
+var episodeOfCareReferralRequestTargets = []string{"ServiceRequest"}
+
 func (r *EpisodeOfCare) Validate() error {
 	if r.ResourceType != "EpisodeOfCare" {
 		return fmt.Errorf("invalid resourceType: expected 'EpisodeOfCare', got '%s'", r.ResourceType)
@@ -38,20 +50,55 @@ func (r *EpisodeOfCare) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusHistory {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusHistory[%d]: %w", i, err)
@@ -75,11 +122,21 @@ func (r *EpisodeOfCare) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, episodeOfCareSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, episodeOfCareSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, episodeOfCareManagingOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, episodeOfCareManagingOrganizationTargets)
+		}
+	}
 	if r.ManagingOrganization != nil {
 		if err := r.ManagingOrganization.Validate(); err != nil {
 			return fmt.Errorf("ManagingOrganization: %w", err)
@@ -90,6 +147,11 @@ func (r *EpisodeOfCare) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	for i, item := range r.ReferralRequest {
+		if target := invalidReferenceTarget(item.Reference, item.Type, episodeOfCareReferralRequestTargets); target != "" {
+			return fmt.Errorf("field 'ReferralRequest[%d]' cannot refer to %s, expected one of %v", i, target, episodeOfCareReferralRequestTargets)
+		}
+	}
 	for i, item := range r.ReferralRequest {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ReferralRequest[%d]: %w", i, err)
@@ -110,40 +172,267 @@ func (r *EpisodeOfCare) Validate() error {
 			return fmt.Errorf("Account[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "EpisodeOfCare")
 }
 
-type EpisodeOfCareDiagnosis struct {
-	Id        *string             `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Condition []CodeableReference `json:"condition,omitempty" bson:"condition,omitempty"` // The medical condition that was addressed during the episode of care
-	Use       []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`             // Role that this diagnosis has within the episode of care (e.g. admission, billing, discharge …)
+func (r *EpisodeOfCare) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "EpisodeOfCare" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'EpisodeOfCare', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.StatusHistory {
+		item.validateAll(fmt.Sprintf("%s.statusHistory[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Diagnosis {
+		item.validateAll(fmt.Sprintf("%s.diagnosis[%d]", path, i), issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, episodeOfCareSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, episodeOfCareSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, episodeOfCareManagingOrganizationTargets); target != "" {
+			issues.add("value", path+".managingOrganization", fmt.Sprintf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, episodeOfCareManagingOrganizationTargets))
+		}
+	}
+	if r.ManagingOrganization != nil {
+		r.ManagingOrganization.validateAll(path+".managingOrganization", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.ReferralRequest {
+		if target := invalidReferenceTarget(item.Reference, item.Type, episodeOfCareReferralRequestTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.referralRequest[%d]", path, i), fmt.Sprintf("field 'ReferralRequest[%d]' cannot refer to %s, expected one of %v", i, target, episodeOfCareReferralRequestTargets))
+		}
+	}
+	for i, item := range r.ReferralRequest {
+		item.validateAll(fmt.Sprintf("%s.referralRequest[%d]", path, i), issues)
+	}
+	if r.CareManager != nil {
+		r.CareManager.validateAll(path+".careManager", issues)
+	}
+	for i, item := range r.CareTeam {
+		item.validateAll(fmt.Sprintf("%s.careTeam[%d]", path, i), issues)
+	}
+	for i, item := range r.Account {
+		item.validateAll(fmt.Sprintf("%s.account[%d]", path, i), issues)
+	}
 }
 
-func (r *EpisodeOfCareDiagnosis) Validate() error {
-	for i, item := range r.Condition {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Condition[%d]: %w", i, err)
-		}
+func (r *EpisodeOfCare) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("EpisodeOfCare", &issues)
+	checkInvariants(r, "EpisodeOfCare", &issues)
+	return issues
+}
+
+var episodeOfCareInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *EpisodeOfCare) invariants() []invariant {
+	return episodeOfCareInvariants
+}
+
+func (r *EpisodeOfCare) UnmarshalJSON(data []byte) error {
+	type alias EpisodeOfCare
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	for i, item := range r.Use {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Use[%d]: %w", i, err)
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r EpisodeOfCare) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EpisodeOfCare) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EpisodeOfCare) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EpisodeOfCare) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var episodeOfCareElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *EpisodeOfCare) elementTypes() map[string]string {
+	return episodeOfCareElementTypes
+}
+
+var episodeOfCareElementMetadata = []ElementMetadata{
+	{Path: "EpisodeOfCare.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "EpisodeOfCare.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "EpisodeOfCare.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "EpisodeOfCare.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EpisodeOfCare.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "EpisodeOfCare.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "EpisodeOfCare.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "EpisodeOfCare.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EpisodeOfCare.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EpisodeOfCare.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "EpisodeOfCare.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EpisodeOfCare.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/episode-of-care-status"}},
+	{Path: "EpisodeOfCare.statusHistory", Name: "statusHistory", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EpisodeOfCare.type", Name: "type", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "EpisodeOfCare.reason", Name: "reason", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EpisodeOfCare.diagnosis", Name: "diagnosis", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EpisodeOfCare.subject", Name: "subject", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "EpisodeOfCare.managingOrganization", Name: "managingOrganization", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "EpisodeOfCare.period", Name: "period", Field: 20, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "EpisodeOfCare.referralRequest", Name: "referralRequest", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "EpisodeOfCare.careManager", Name: "careManager", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EpisodeOfCare.careTeam", Name: "careTeam", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "EpisodeOfCare.account", Name: "account", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *EpisodeOfCare) ElementMetadata() []ElementMetadata {
+	return episodeOfCareElementMetadata
+}
+
+func (r *EpisodeOfCare) GetResourceType() string {
+	return "EpisodeOfCare"
+}
+
+func (r *EpisodeOfCare) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *EpisodeOfCare) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *EpisodeOfCare) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *EpisodeOfCare) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *EpisodeOfCare) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *EpisodeOfCare) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *EpisodeOfCare) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*EpisodeOfCare)(nil)
+
 type EpisodeOfCareStatusHistory struct {
-	Id     *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Status string  `json:"status" bson:"status"`             // planned | waitlist | active | onhold | finished | cancelled | entered-in-error
-	Period *Period `json:"period" bson:"period"`             // Duration the EpisodeOfCare was in the specified status
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Status            EpisodeOfCareStatus `json:"status" bson:"status"`                                            // planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	StatusElement     *Element            `json:"_status,omitempty" bson:"status_element,omitempty"`               // Extensions for status
+	Period            *Period             `json:"period" bson:"period"`                                            // Duration the EpisodeOfCare was in the specified status
 }
 
 func (r *EpisodeOfCareStatusHistory) Validate() error {
-	var emptyString string
-	if r.Status == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Period == nil {
 		return fmt.Errorf("field 'Period' is required")
 	}
@@ -155,13 +444,85 @@ func (r *EpisodeOfCareStatusHistory) Validate() error {
 	return nil
 }
 
+func (r *EpisodeOfCareStatusHistory) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Period == nil {
+		issues.add("required", path+".period", "field 'Period' is required")
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var episodeOfCareStatusHistoryInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EpisodeOfCareStatusHistory) invariants() []invariant {
+	return episodeOfCareStatusHistoryInvariants
+}
+
+func (r EpisodeOfCareStatusHistory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EpisodeOfCareStatusHistory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EpisodeOfCareStatusHistory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EpisodeOfCareStatusHistory) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var episodeOfCareStatusHistoryElementMetadata = []ElementMetadata{
+	{Path: "EpisodeOfCare.statusHistory.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EpisodeOfCare.statusHistory.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EpisodeOfCare.statusHistory.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EpisodeOfCare.statusHistory.status", Name: "status", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EpisodeOfCare.statusHistory.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/episode-of-care-status"}},
+	{Path: "EpisodeOfCare.statusHistory.period", Name: "period", Field: 5, Min: 1, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *EpisodeOfCareStatusHistory) ElementMetadata() []ElementMetadata {
+	return episodeOfCareStatusHistoryElementMetadata
+}
+
 type EpisodeOfCareReason struct {
-	Id    *string             `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Use   []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`     // What the reason value should be used for/as
-	Value []CodeableReference `json:"value,omitempty" bson:"value,omitempty"` // Medical reason to be addressed
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Use               []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`                              // What the reason value should be used for/as
+	Value             []CodeableReference `json:"value,omitempty" bson:"value,omitempty"`                          // Medical reason to be addressed
 }
 
 func (r *EpisodeOfCareReason) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Use {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Use[%d]: %w", i, err)
@@ -174,3 +535,137 @@ func (r *EpisodeOfCareReason) Validate() error {
 	}
 	return nil
 }
+
+func (r *EpisodeOfCareReason) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Use {
+		item.validateAll(fmt.Sprintf("%s.use[%d]", path, i), issues)
+	}
+	for i, item := range r.Value {
+		item.validateAll(fmt.Sprintf("%s.value[%d]", path, i), issues)
+	}
+}
+
+var episodeOfCareReasonInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EpisodeOfCareReason) invariants() []invariant {
+	return episodeOfCareReasonInvariants
+}
+
+func (r EpisodeOfCareReason) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EpisodeOfCareReason) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EpisodeOfCareReason) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EpisodeOfCareReason) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var episodeOfCareReasonElementMetadata = []ElementMetadata{
+	{Path: "EpisodeOfCare.reason.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EpisodeOfCare.reason.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EpisodeOfCare.reason.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EpisodeOfCare.reason.use", Name: "use", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "EpisodeOfCare.reason.value", Name: "value", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+}
+
+func (r *EpisodeOfCareReason) ElementMetadata() []ElementMetadata {
+	return episodeOfCareReasonElementMetadata
+}
+
+type EpisodeOfCareDiagnosis struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Condition         []CodeableReference `json:"condition,omitempty" bson:"condition,omitempty"`                  // The medical condition that was addressed during the episode of care
+	Use               []CodeableConcept   `json:"use,omitempty" bson:"use,omitempty"`                              // Role that this diagnosis has within the episode of care (e.g. admission, billing, discharge …)
+}
+
+func (r *EpisodeOfCareDiagnosis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Condition {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Condition[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Use {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Use[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EpisodeOfCareDiagnosis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Use {
+		item.validateAll(fmt.Sprintf("%s.use[%d]", path, i), issues)
+	}
+}
+
+var episodeOfCareDiagnosisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EpisodeOfCareDiagnosis) invariants() []invariant {
+	return episodeOfCareDiagnosisInvariants
+}
+
+func (r EpisodeOfCareDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EpisodeOfCareDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EpisodeOfCareDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EpisodeOfCareDiagnosis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var episodeOfCareDiagnosisElementMetadata = []ElementMetadata{
+	{Path: "EpisodeOfCare.diagnosis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EpisodeOfCare.diagnosis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EpisodeOfCare.diagnosis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EpisodeOfCare.diagnosis.condition", Name: "condition", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "EpisodeOfCare.diagnosis.use", Name: "use", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *EpisodeOfCareDiagnosis) ElementMetadata() []ElementMetadata {
+	return episodeOfCareDiagnosisElementMetadata
+}
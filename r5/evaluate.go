@@ -1,9 +1,63 @@
 package models
 
-// The evaluate operation processes the given Measure(s) to produce the corresponding MeasureReport(s). This operation expects that Measure resources used have a computable representation. The value of title elements in the resulting [MeasureReport](clinicalreasoning-quality-reporting.html#measure-report) should be copied from the corresponding elements on the Measure.
-type Evaluate struct {
+// EvaluateIn holds the input parameters of the $Evaluate operation. The evaluate operation processes the given Measure(s) to produce the corresponding MeasureReport(s). This operation expects that Measure resources used have a computable representation. The value of title elements in the resulting [MeasureReport](clinicalreasoning-quality-reporting.html#measure-report) should be copied from the corresponding elements on the Measure.
+type EvaluateIn struct {
 }
 
-func (r *Evaluate) Validate() error {
+func (p *EvaluateIn) Validate() error {
+	return nil
+}
+
+func (p *EvaluateIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EvaluateIn) FromParameters(params *Parameters) error {
+	*p = EvaluateIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EvaluateIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *EvaluateIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// EvaluateOut holds the output parameters of the $Evaluate operation.
+type EvaluateOut struct {
+}
+
+func (p *EvaluateOut) Validate() error {
+	return nil
+}
+
+func (p *EvaluateOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EvaluateOut) FromParameters(params *Parameters) error {
+	*p = EvaluateOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EvaluateOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *EvaluateOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
@@ -1,9 +1,63 @@
 package models
 
-// The evaluate-measure operation is used to calculate an eMeasure and obtain the results
-type EvaluateMeasure struct {
+// EvaluateMeasureIn holds the input parameters of the $EvaluateMeasure operation. The evaluate-measure operation is used to calculate an eMeasure and obtain the results
+type EvaluateMeasureIn struct {
 }
 
-func (r *EvaluateMeasure) Validate() error {
+func (p *EvaluateMeasureIn) Validate() error {
+	return nil
+}
+
+func (p *EvaluateMeasureIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EvaluateMeasureIn) FromParameters(params *Parameters) error {
+	*p = EvaluateMeasureIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EvaluateMeasureIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *EvaluateMeasureIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// EvaluateMeasureOut holds the output parameters of the $EvaluateMeasure operation.
+type EvaluateMeasureOut struct {
+}
+
+func (p *EvaluateMeasureOut) Validate() error {
+	return nil
+}
+
+func (p *EvaluateMeasureOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EvaluateMeasureOut) FromParameters(params *Parameters) error {
+	*p = EvaluateMeasureOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EvaluateMeasureOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *EvaluateMeasureOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
@@ -2,50 +2,73 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The EventDefinition resource provides a reusable description of when a particular event can occur.
 type EventDefinition struct {
-	ResourceType           string              `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string             `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string             `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string             `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this event definition, represented as a URI (globally unique)
-	Identifier             []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the event definition
-	Version                *string             `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the event definition
-	VersionAlgorithmString *string             `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding             `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string             `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this event definition (computer friendly)
-	Title                  *string             `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this event definition (human friendly)
-	Subtitle               *string             `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                               // Subordinate title of the event definition
-	Status                 string              `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool               `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	SubjectCodeableConcept *CodeableConcept    `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"` // Type of individual the event definition is focused on
-	SubjectReference       *Reference          `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`              // Type of individual the event definition is focused on
-	Date                   *string             `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string             `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail     `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string             `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the event definition
-	UseContext             []UsageContext      `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept   `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the event definition (if applicable)
-	Purpose                *string             `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this event definition is defined
-	Usage                  *string             `json:"usage,omitempty" bson:"usage,omitempty"`                                     // Describes the clinical usage of the event definition
-	Copyright              *string             `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string             `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string             `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the event definition was approved by publisher
-	LastReviewDate         *string             `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the event definition was last reviewed by the publisher
-	EffectivePeriod        *Period             `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the event definition is expected to be used
-	Topic                  []CodeableConcept   `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail     `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the content
-	Editor                 []ContactDetail     `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the content
-	Reviewer               []ContactDetail     `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the content
-	Endorser               []ContactDetail     `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the content
-	RelatedArtifact        []RelatedArtifact   `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
-	Trigger                []TriggerDefinition `json:"trigger" bson:"trigger"`                                                     // "when" the event occurs (multiple = 'or')
+	ResourceType            string                          `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                         `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                         `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this event definition, represented as a URI (globally unique)
+	UrlElement              *Element                        `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the event definition
+	Version                 *string                         `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the event definition
+	VersionElement          *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        EventDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                        `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                         `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this event definition (computer friendly)
+	NameElement             *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                         `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this event definition (human friendly)
+	TitleElement            *Element                        `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Subtitle                *string                         `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                        // Subordinate title of the event definition
+	SubtitleElement         *Element                        `json:"_subtitle,omitempty" bson:"subtitle_element,omitempty"`               // Extensions for subtitle
+	Status                  PublicationStatus               `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                        `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Subject                 EventDefinitionSubject          `json:"-" bson:"subject,omitempty"`                                          // Type of individual the event definition is focused on
+	Date                    *DateTime                       `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                        `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                        `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                         `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the event definition
+	DescriptionElement      *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept               `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the event definition (if applicable)
+	Purpose                 *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this event definition is defined
+	PurposeElement          *Element                        `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Usage                   *string                         `json:"usage,omitempty" bson:"usage,omitempty"`                              // Describes the clinical usage of the event definition
+	UsageElement            *Element                        `json:"_usage,omitempty" bson:"usage_element,omitempty"`                     // Extensions for usage
+	Copyright               *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                        `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                           `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the event definition was approved by publisher
+	ApprovalDateElement     *Element                        `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                           `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the event definition was last reviewed by the publisher
+	LastReviewDateElement   *Element                        `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                         `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the event definition is expected to be used
+	Topic                   []CodeableConcept               `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail                 `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the content
+	Editor                  []ContactDetail                 `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the content
+	Reviewer                []ContactDetail                 `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the content
+	Endorser                []ContactDetail                 `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the content
+	RelatedArtifact         []RelatedArtifact               `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations, etc
+	Trigger                 []TriggerDefinition             `json:"trigger" bson:"trigger"`                                              // "when" the event occurs (multiple = 'or')
+
+	versionAlgorithmVariants []string // JSON properties of EventDefinition.versionAlgorithm[x] when more than one was decoded
+	subjectVariants          []string // JSON properties of EventDefinition.subject[x] when more than one was decoded
 }
 
 func (r *EventDefinition) Validate() error {
@@ -57,33 +80,119 @@ func (r *EventDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.SubtitleElement != nil {
+		if err := r.SubtitleElement.Validate(); err != nil {
+			return fmt.Errorf("SubtitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -91,6 +200,11 @@ func (r *EventDefinition) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -101,6 +215,46 @@ func (r *EventDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -144,5 +298,458 @@ func (r *EventDefinition) Validate() error {
 			return fmt.Errorf("Trigger[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "EventDefinition")
+}
+
+func (r *EventDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "EventDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'EventDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.SubtitleElement != nil {
+		r.SubtitleElement.validateAll(path+".subtitle", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	if len(r.Trigger) < 1 {
+		issues.add("required", path+".trigger", "field 'Trigger' must have at least 1 elements")
+	}
+	for i, item := range r.Trigger {
+		item.validateAll(fmt.Sprintf("%s.trigger[%d]", path, i), issues)
+	}
+}
+
+func (r *EventDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("EventDefinition", &issues)
+	checkInvariants(r, "EventDefinition", &issues)
+	return issues
+}
+
+var eventDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *EventDefinition) invariants() []invariant {
+	return eventDefinitionInvariants
+}
+
+func (r EventDefinition) MarshalJSON() ([]byte, error) {
+	type alias EventDefinition
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EventDefinition) UnmarshalJSON(data []byte) error {
+	type alias EventDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", eventDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.Subject, _, r.subjectVariants, err = unmarshalChoice(fields, "subject", eventDefinitionSubjectVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r EventDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EventDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EventDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EventDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EventDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(eventDefinitionVersionAlgorithmVariants)
+	case "Subject":
+		return "subject", choiceValues(eventDefinitionSubjectVariants)
+	}
+	return "", nil
+}
+
+var eventDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"usage":         "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *EventDefinition) elementTypes() map[string]string {
+	return eventDefinitionElementTypes
+}
+
+var eventDefinitionElementMetadata = []ElementMetadata{
+	{Path: "EventDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "EventDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "EventDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "EventDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EventDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "EventDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "EventDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "EventDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EventDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EventDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "EventDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "EventDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EventDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "EventDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EventDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EventDefinition.subtitle", Name: "subtitle", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EventDefinition.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EventDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "EventDefinition.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "EventDefinition.subject[x]", Name: "subject", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "EventDefinition.date", Name: "date", Field: 29, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "EventDefinition.publisher", Name: "publisher", Field: 31, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EventDefinition.contact", Name: "contact", Field: 33, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EventDefinition.description", Name: "description", Field: 34, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EventDefinition.useContext", Name: "useContext", Field: 36, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "EventDefinition.jurisdiction", Name: "jurisdiction", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "EventDefinition.purpose", Name: "purpose", Field: 38, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EventDefinition.usage", Name: "usage", Field: 40, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EventDefinition.copyright", Name: "copyright", Field: 42, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EventDefinition.copyrightLabel", Name: "copyrightLabel", Field: 44, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EventDefinition.approvalDate", Name: "approvalDate", Field: 46, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "EventDefinition.lastReviewDate", Name: "lastReviewDate", Field: 48, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "EventDefinition.effectivePeriod", Name: "effectivePeriod", Field: 50, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "EventDefinition.topic", Name: "topic", Field: 51, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "EventDefinition.author", Name: "author", Field: 52, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EventDefinition.editor", Name: "editor", Field: 53, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EventDefinition.reviewer", Name: "reviewer", Field: 54, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EventDefinition.endorser", Name: "endorser", Field: 55, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EventDefinition.relatedArtifact", Name: "relatedArtifact", Field: 56, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "EventDefinition.trigger", Name: "trigger", Field: 57, Min: 1, Max: "*", Types: []string{"TriggerDefinition"}},
+}
+
+func (r *EventDefinition) ElementMetadata() []ElementMetadata {
+	return eventDefinitionElementMetadata
+}
+
+// EventDefinitionVersionAlgorithm is the type of EventDefinition.versionAlgorithm[x]. It is implemented by the EventDefinitionVersionAlgorithm* variant types.
+type EventDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEventDefinitionVersionAlgorithm()
+}
+
+// EventDefinitionVersionAlgorithmString is the string variant of EventDefinitionVersionAlgorithm.
+type EventDefinitionVersionAlgorithmString string
+
+func (v EventDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v EventDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EventDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v EventDefinitionVersionAlgorithmString) isEventDefinitionVersionAlgorithm() {}
+
+// EventDefinitionVersionAlgorithmCoding is the Coding variant of EventDefinitionVersionAlgorithm.
+type EventDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v EventDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v EventDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v EventDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v EventDefinitionVersionAlgorithmCoding) isEventDefinitionVersionAlgorithm() {}
+
+var eventDefinitionVersionAlgorithmVariants = []EventDefinitionVersionAlgorithm{
+	EventDefinitionVersionAlgorithmString(""),
+	EventDefinitionVersionAlgorithmCoding{},
+}
+
+// EventDefinitionSubject is the type of EventDefinition.subject[x]. It is implemented by the EventDefinitionSubject* variant types.
+type EventDefinitionSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEventDefinitionSubject()
+}
+
+// EventDefinitionSubjectCodeableConcept is the CodeableConcept variant of EventDefinitionSubject.
+type EventDefinitionSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v EventDefinitionSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v EventDefinitionSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v EventDefinitionSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v EventDefinitionSubjectCodeableConcept) isEventDefinitionSubject() {}
+
+// EventDefinitionSubjectReference is the Reference variant of EventDefinitionSubject.
+type EventDefinitionSubjectReference struct {
+	Reference
+}
+
+func (v EventDefinitionSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v EventDefinitionSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v EventDefinitionSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v EventDefinitionSubjectReference) isEventDefinitionSubject() {}
+
+var eventDefinitionSubjectVariants = []EventDefinitionSubject{
+	EventDefinitionSubjectCodeableConcept{},
+	EventDefinitionSubjectReference{},
+}
+
+func (r *EventDefinition) GetResourceType() string {
+	return "EventDefinition"
+}
+
+func (r *EventDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *EventDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *EventDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *EventDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *EventDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *EventDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *EventDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*EventDefinition)(nil)
@@ -1,9 +1,63 @@
 package models
 
-// This operation is used to search for and return notifications that have been previously triggered by a topic-based Subscription.
-type Events struct {
+// EventsIn holds the input parameters of the $Events operation. This operation is used to search for and return notifications that have been previously triggered by a topic-based Subscription.
+type EventsIn struct {
 }
 
-func (r *Events) Validate() error {
+func (p *EventsIn) Validate() error {
+	return nil
+}
+
+func (p *EventsIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EventsIn) FromParameters(params *Parameters) error {
+	*p = EventsIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EventsIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *EventsIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// EventsOut holds the output parameters of the $Events operation.
+type EventsOut struct {
+}
+
+func (p *EventsOut) Validate() error {
+	return nil
+}
+
+func (p *EventsOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EventsOut) FromParameters(params *Parameters) error {
+	*p = EventsOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EventsOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *EventsOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
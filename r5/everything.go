@@ -1,9 +1,121 @@
 package models
 
-// This operation is used to return all the information related to one or more products described in the resource or context on which this operation is invoked. The response is a bundle of type "searchset". At a minimum, the product resource(s) itself is returned, along with any other resources that the server has that are related to the products(s), and that are available for the given user. This is typically the marketing authorizations, ingredients, packages, therapeutic indications and so on. The server also returns whatever resources are needed to support the records - e.g. linked organizations, document references etc.
-type Everything struct {
+import "fmt"
+
+// EverythingIn holds the input parameters of the $everything operation. This operation is used to return all the information related to one or more products described in the resource or context on which this operation is invoked. The response is a bundle of type "searchset". At a minimum, the product resource(s) itself is returned, along with any other resources that the server has that are related to the products(s), and that are available for the given user. This is typically the marketing authorizations, ingredients, packages, therapeutic indications and so on. The server also returns whatever resources are needed to support the records - e.g. linked organizations, document references etc.
+type EverythingIn struct {
+	// Resources updated after this period will be included in the response
+	Since *Instant
+	// See discussion below on the utility of paging through the results of the $everything operation
+	Count *int
+}
+
+func (p *EverythingIn) Validate() error {
+	return nil
+}
+
+func (p *EverythingIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EverythingIn) FromParameters(params *Parameters) error {
+	*p = EverythingIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EverythingIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Since != nil {
+		params = append(params, ParametersParameter{Name: "_since", Value: ParametersParameterValueInstant{Instant: *p.Since}})
+	}
+	if p.Count != nil {
+		params = append(params, ParametersParameter{Name: "_count", Value: ParametersParameterValueInteger(*p.Count)})
+	}
+	return params
+}
+
+func (p *EverythingIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "_since":
+			if p.Since != nil {
+				return fmt.Errorf("parameter '_since' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueInstant](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Since = &v.Instant
+		case "_count":
+			if p.Count != nil {
+				return fmt.Errorf("parameter '_count' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueInteger](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := int(v)
+			p.Count = &value
+		}
+	}
+	return nil
+}
+
+// EverythingOut holds the output parameters of the $everything operation.
+type EverythingOut struct {
+	// The bundle type is "searchset"
+	Return *Bundle
+}
+
+func (p *EverythingOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *EverythingOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *EverythingOut) FromParameters(params *Parameters) error {
+	*p = EverythingOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *EverythingOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Everything) Validate() error {
+func (p *EverythingOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
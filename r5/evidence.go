@@ -2,51 +2,74 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The Evidence Resource provides a machine-interpretable expression of an evidence concept including the evidence variables (e.g., population, exposures/interventions, comparators, outcomes, measured variables, confounding variables), the statistics, and the certainty of this evidence.
 type Evidence struct {
-	ResourceType           string                       `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                      `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                      `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage            `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                      `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this evidence, represented as a globally unique URI
-	Identifier             []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the summary
-	Version                *string                      `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of this summary
-	VersionAlgorithmString *string                      `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                      `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                      `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this summary (machine friendly)
-	Title                  *string                      `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this summary (human friendly)
-	CiteAs                 *string                      `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                                  // Display of how to cite this Evidence
-	Status                 string                       `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                        `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                      `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	ApprovalDate           *string                      `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the summary was approved by publisher
-	LastReviewDate         *string                      `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the summary was last reviewed by the publisher
-	Author                 []ContactDetail              `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the content
-	Publisher              *string                      `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail              `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Recorder               []ContactDetail              `json:"recorder,omitempty" bson:"recorder,omitempty"`                               // Who entered the data for the evidence
-	Editor                 []ContactDetail              `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the content
-	Reviewer               []ContactDetail              `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the content
-	Endorser               []ContactDetail              `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the content
-	UseContext             []UsageContext               `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Purpose                *string                      `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this Evidence is defined
-	Copyright              *string                      `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Intellectual property ownership, may include restrictions on use
-	CopyrightLabel         *string                      `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	RelatesTo              []EvidenceRelatesTo          `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                            // Relationships to other Resources
-	Description            *string                      `json:"description,omitempty" bson:"description,omitempty"`                         // Description of the particular summary
-	Assertion              *string                      `json:"assertion,omitempty" bson:"assertion,omitempty"`                             // Declarative description of the Evidence
-	Note                   []Annotation                 `json:"note,omitempty" bson:"note,omitempty"`                                       // Footnotes and/or explanatory notes
-	VariableDefinition     []EvidenceVariableDefinition `json:"variableDefinition,omitempty" bson:"variable_definition,omitempty"`          // Description, classification, and definition of a single variable
-	SynthesisType          []CodeableConcept            `json:"synthesisType,omitempty" bson:"synthesis_type,omitempty"`                    // The design of the synthesis (combination of studies) that produced this evidence
-	StudyDesign            []CodeableConcept            `json:"studyDesign,omitempty" bson:"study_design,omitempty"`                        // The design of the study that produced this evidence
-	Statistic              []EvidenceStatistic          `json:"statistic,omitempty" bson:"statistic,omitempty"`                             // Values and parameters for a single statistic
-	Certainty              []EvidenceCertainty          `json:"certainty,omitempty" bson:"certainty,omitempty"`                             // Certainty or quality of the evidence
+	ResourceType            string                       `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                      `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                      `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                      `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this evidence, represented as a globally unique URI
+	UrlElement              *Element                     `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the summary
+	Version                 *string                      `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of this summary
+	VersionElement          *Element                     `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        EvidenceVersionAlgorithm     `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                     `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                      `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this summary (machine friendly)
+	NameElement             *Element                     `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                      `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this summary (human friendly)
+	TitleElement            *Element                     `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	CiteAs                  *string                      `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                           // Display of how to cite this Evidence
+	CiteAsElement           *Element                     `json:"_citeAs,omitempty" bson:"cite_as_element,omitempty"`                  // Extensions for citeAs
+	Status                  PublicationStatus            `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                        `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                     `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Date                    *DateTime                    `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                     `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	ApprovalDate            *Date                        `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the summary was approved by publisher
+	ApprovalDateElement     *Element                     `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                        `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the summary was last reviewed by the publisher
+	LastReviewDateElement   *Element                     `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	Author                  []ContactDetail              `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the content
+	Publisher               *string                      `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                     `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail              `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Recorder                []ContactDetail              `json:"recorder,omitempty" bson:"recorder,omitempty"`                        // Who entered the data for the evidence
+	Editor                  []ContactDetail              `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the content
+	Reviewer                []ContactDetail              `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the content
+	Endorser                []ContactDetail              `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the content
+	UseContext              []UsageContext               `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Purpose                 *string                      `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this Evidence is defined
+	PurposeElement          *Element                     `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                      `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Intellectual property ownership, may include restrictions on use
+	CopyrightElement        *Element                     `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                      `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                     `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	RelatesTo               []EvidenceRelatesTo          `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                     // Relationships to other Resources
+	Description             *string                      `json:"description,omitempty" bson:"description,omitempty"`                  // Description of the particular summary
+	DescriptionElement      *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	Assertion               *string                      `json:"assertion,omitempty" bson:"assertion,omitempty"`                      // Declarative description of the Evidence
+	AssertionElement        *Element                     `json:"_assertion,omitempty" bson:"assertion_element,omitempty"`             // Extensions for assertion
+	Note                    []Annotation                 `json:"note,omitempty" bson:"note,omitempty"`                                // Footnotes and/or explanatory notes
+	VariableDefinition      []EvidenceVariableDefinition `json:"variableDefinition,omitempty" bson:"variable_definition,omitempty"`   // Description, classification, and definition of a single variable
+	SynthesisType           []CodeableConcept            `json:"synthesisType,omitempty" bson:"synthesis_type,omitempty"`             // The design of the synthesis (combination of studies) that produced this evidence
+	StudyDesign             []CodeableConcept            `json:"studyDesign,omitempty" bson:"study_design,omitempty"`                 // The design of the study that produced this evidence
+	Statistic               []EvidenceStatistic          `json:"statistic,omitempty" bson:"statistic,omitempty"`                      // Values and parameters for a single statistic
+	Certainty               []EvidenceCertainty          `json:"certainty,omitempty" bson:"certainty,omitempty"`                      // Certainty or quality of the evidence
+
+	versionAlgorithmVariants []string // JSON properties of Evidence.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *Evidence) Validate() error {
@@ -58,30 +81,138 @@ func (r *Evidence) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.CiteAsElement != nil {
+		if err := r.CiteAsElement.Validate(); err != nil {
+			return fmt.Errorf("CiteAsElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	for i, item := range r.Author {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Author[%d]: %w", i, err)
 		}
 	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
@@ -112,11 +243,36 @@ func (r *Evidence) Validate() error {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
 	for i, item := range r.RelatesTo {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.AssertionElement != nil {
+		if err := r.AssertionElement.Validate(); err != nil {
+			return fmt.Errorf("AssertionElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -147,317 +303,1708 @@ func (r *Evidence) Validate() error {
 			return fmt.Errorf("Certainty[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type EvidenceVariableDefinition struct {
-	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
-	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`                // A text description or summary of the variable
-	Note               []Annotation     `json:"note,omitempty" bson:"note,omitempty"`                              // Footnotes and/or explanatory notes
-	VariableRole       string           `json:"variableRole" bson:"variable_role"`                                 // population | exposure | outcome | covariate
-	RoleSubtype        *CodeableConcept `json:"roleSubtype,omitempty" bson:"role_subtype,omitempty"`               // Sub-classification of the role of the variable
-	ComparatorCategory *string          `json:"comparatorCategory,omitempty" bson:"comparator_category,omitempty"` // The reference value used for comparison
-	Observed           *Reference       `json:"observed,omitempty" bson:"observed,omitempty"`                      // Definition of the actual variable related to the statistic(s)
-	Intended           *Reference       `json:"intended,omitempty" bson:"intended,omitempty"`                      // Definition of the intended variable related to the Evidence
-	DirectnessMatch    *CodeableConcept `json:"directnessMatch,omitempty" bson:"directness_match,omitempty"`       // low | moderate | high | exact
+	return validateInvariants(r, "Evidence")
 }
 
-func (r *EvidenceVariableDefinition) Validate() error {
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
-		}
+func (r *Evidence) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Evidence" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Evidence', got '%s'", r.ResourceType))
 	}
-	var emptyString string
-	if r.VariableRole == emptyString {
-		return fmt.Errorf("field 'VariableRole' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.RoleSubtype != nil {
-		if err := r.RoleSubtype.Validate(); err != nil {
-			return fmt.Errorf("RoleSubtype: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.Observed != nil {
-		if err := r.Observed.Validate(); err != nil {
-			return fmt.Errorf("Observed: %w", err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.Intended != nil {
-		if err := r.Intended.Validate(); err != nil {
-			return fmt.Errorf("Intended: %w", err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.DirectnessMatch != nil {
-		if err := r.DirectnessMatch.Validate(); err != nil {
-			return fmt.Errorf("DirectnessMatch: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type EvidenceStatistic struct {
-	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Description         *string                                `json:"description,omitempty" bson:"description,omitempty"`                  // A natural language summary of the statistic
-	Note                []Annotation                           `json:"note,omitempty" bson:"note,omitempty"`                                // Footnotes and/or explanatory notes
-	StatisticType       *CodeableConcept                       `json:"statisticType,omitempty" bson:"statistic_type,omitempty"`             // Type of statistic, e.g., relative risk
-	Category            *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                        // Associated category for categorical variable
-	Quantity            *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                        // Statistic value
-	NumberOfEvents      *int                                   `json:"numberOfEvents,omitempty" bson:"number_of_events,omitempty"`          // The number of events associated with the statistic
-	NumberAffected      *int                                   `json:"numberAffected,omitempty" bson:"number_affected,omitempty"`           // The number of participants affected
-	SampleSize          *EvidenceStatisticSampleSize           `json:"sampleSize,omitempty" bson:"sample_size,omitempty"`                   // Count of participants in the study sample
-	AttributeEstimate   []EvidenceStatisticAttributeEstimate   `json:"attributeEstimate,omitempty" bson:"attribute_estimate,omitempty"`     // An attribute of the Statistic
-	ModelCharacteristic []EvidenceStatisticModelCharacteristic `json:"modelCharacteristic,omitempty" bson:"model_characteristic,omitempty"` // An aspect of the statistical model
-}
-
-func (r *EvidenceStatistic) Validate() error {
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.StatisticType != nil {
-		if err := r.StatisticType.Validate(); err != nil {
-			return fmt.Errorf("StatisticType: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.SampleSize != nil {
-		if err := r.SampleSize.Validate(); err != nil {
-			return fmt.Errorf("SampleSize: %w", err)
-		}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
-	for i, item := range r.AttributeEstimate {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AttributeEstimate[%d]: %w", i, err)
-		}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
 	}
-	for i, item := range r.ModelCharacteristic {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ModelCharacteristic[%d]: %w", i, err)
-		}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
 	}
-	return nil
-}
-
-type EvidenceStatisticSampleSize struct {
-	Id                   *string      `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Description          *string      `json:"description,omitempty" bson:"description,omitempty"`                     // Textual description of sample size for statistic
-	Note                 []Annotation `json:"note,omitempty" bson:"note,omitempty"`                                   // Footnote or explanatory note about the sample size
-	NumberOfStudies      *int         `json:"numberOfStudies,omitempty" bson:"number_of_studies,omitempty"`           // Number of contributing studies
-	NumberOfParticipants *int         `json:"numberOfParticipants,omitempty" bson:"number_of_participants,omitempty"` // Total number of participants
-	KnownDataCount       *int         `json:"knownDataCount,omitempty" bson:"known_data_count,omitempty"`             // Number of participants with known results for measured variables
-	NumberAnalyzed       *int         `json:"numberAnalyzed,omitempty" bson:"number_analyzed,omitempty"`              // Total number of participants who were analayzed
-}
-
-func (r *EvidenceStatisticSampleSize) Validate() error {
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
-		}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
 	}
-	return nil
-}
-
-type EvidenceStatisticAttributeEstimate struct {
-	Id                *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Description       *string                              `json:"description,omitempty" bson:"description,omitempty"`              // Textual description of the attribute estimate
-	Note              []Annotation                         `json:"note,omitempty" bson:"note,omitempty"`                            // Footnote or explanatory note about the estimate
-	Type              *CodeableConcept                     `json:"type,omitempty" bson:"type,omitempty"`                            // The type of attribute estimate, e.g., confidence interval or p value
-	Quantity          *Quantity                            `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // The singular quantity of the attribute estimate, for attribute estimates represented as single values, which may include a unit of measure
-	Level             *float64                             `json:"level,omitempty" bson:"level,omitempty"`                          // Level of confidence interval, e.g., 0.95 for 95% confidence interval
-	Range             *Range                               `json:"range,omitempty" bson:"range,omitempty"`                          // Lower and upper bound values of the attribute estimate
-	AttributeEstimate []EvidenceStatisticAttributeEstimate `json:"attributeEstimate,omitempty" bson:"attribute_estimate,omitempty"` // A nested attribute estimate; which is the attribute estimate of an attribute estimate
-}
-
-func (r *EvidenceStatisticAttributeEstimate) Validate() error {
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
-		}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+	if r.CiteAsElement != nil {
+		r.CiteAsElement.validateAll(path+".citeAs", issues)
 	}
-	if r.Range != nil {
-		if err := r.Range.Validate(); err != nil {
-			return fmt.Errorf("Range: %w", err)
-		}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	for i, item := range r.AttributeEstimate {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AttributeEstimate[%d]: %w", i, err)
-		}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	return nil
-}
-
-type EvidenceStatisticModelCharacteristic struct {
-	Id                   *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Code                 *CodeableConcept                               `json:"code" bson:"code"`                                                       // Model specification
-	ValueQuantity        *Quantity                                      `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // The specific value (when paired with code)
-	ValueRange           *Range                                         `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // The specific value (when paired with code)
-	ValueCodeableConcept *CodeableConcept                               `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // The specific value (when paired with code)
-	Intended             *bool                                          `json:"intended,omitempty" bson:"intended,omitempty"`                           // The plan for analysis
-	Applied              *bool                                          `json:"applied,omitempty" bson:"applied,omitempty"`                             // This model characteristic is part of the analysis that was applied, whether or not the analysis followed the plan
-	Variable             []EvidenceStatisticModelCharacteristicVariable `json:"variable,omitempty" bson:"variable,omitempty"`                           // A variable adjusted for in the adjusted analysis
-	Attribute            []EvidenceStatisticAttributeEstimate           `json:"attribute,omitempty" bson:"attribute,omitempty"`                         // An attribute of the model characteristic
-}
-
-func (r *EvidenceStatisticModelCharacteristic) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	for i, item := range r.Variable {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Variable[%d]: %w", i, err)
-		}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
 	}
-	for i, item := range r.Attribute {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Attribute[%d]: %w", i, err)
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
 		}
 	}
-	return nil
-}
-
-type EvidenceStatisticModelCharacteristicVariable struct {
-	Id                 *string           `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	VariableDefinition *Reference        `json:"variableDefinition" bson:"variable_definition"`           // Description and definition of the variable
-	Handling           *CodeableConcept  `json:"handling,omitempty" bson:"handling,omitempty"`            // boolean | continuous | dichotomous | ordinal | polychotomous | time-to-event | not-specified
-	ValueCategory      []CodeableConcept `json:"valueCategory,omitempty" bson:"value_category,omitempty"` // Qualitative label used for grouping values of a dichotomous, ordinal, or polychotomous variable
-	ValueQuantity      []Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"` // Quantitative label used for grouping values of a dichotomous, ordinal, or polychotomous variable
-	ValueRange         []Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`       // Range of quantitative labels used for grouping values of a dichotomous, ordinal, or polychotomous variable
-}
-
-func (r *EvidenceStatisticModelCharacteristicVariable) Validate() error {
-	if r.VariableDefinition == nil {
-		return fmt.Errorf("field 'VariableDefinition' is required")
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
 	}
-	if r.VariableDefinition != nil {
-		if err := r.VariableDefinition.Validate(); err != nil {
-			return fmt.Errorf("VariableDefinition: %w", err)
-		}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
 	}
-	if r.Handling != nil {
-		if err := r.Handling.Validate(); err != nil {
-			return fmt.Errorf("Handling: %w", err)
-		}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
 	}
-	for i, item := range r.ValueCategory {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ValueCategory[%d]: %w", i, err)
-		}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
 	}
-	for i, item := range r.ValueQuantity {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity[%d]: %w", i, err)
-		}
+	for i, item := range r.Recorder {
+		item.validateAll(fmt.Sprintf("%s.recorder[%d]", path, i), issues)
 	}
-	for i, item := range r.ValueRange {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ValueRange[%d]: %w", i, err)
-		}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type EvidenceRelatesTo struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type" bson:"type"`                          // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
-	TargetUri        *string          `json:"targetUri" bson:"target_uri"`               // The artifact that is related to this Evidence
-	TargetAttachment *Attachment      `json:"targetAttachment" bson:"target_attachment"` // The artifact that is related to this Evidence
-	TargetCanonical  *string          `json:"targetCanonical" bson:"target_canonical"`   // The artifact that is related to this Evidence
-	TargetReference  *Reference       `json:"targetReference" bson:"target_reference"`   // The artifact that is related to this Evidence
-	TargetMarkdown   *string          `json:"targetMarkdown" bson:"target_markdown"`     // The artifact that is related to this Evidence
-}
-
-func (r *EvidenceRelatesTo) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
 	}
-	if r.TargetUri == nil {
-		return fmt.Errorf("field 'TargetUri' is required")
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
 	}
-	if r.TargetAttachment == nil {
-		return fmt.Errorf("field 'TargetAttachment' is required")
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
 	}
-	if r.TargetAttachment != nil {
-		if err := r.TargetAttachment.Validate(); err != nil {
-			return fmt.Errorf("TargetAttachment: %w", err)
-		}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
 	}
-	if r.TargetCanonical == nil {
-		return fmt.Errorf("field 'TargetCanonical' is required")
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
 	}
-	if r.TargetReference == nil {
-		return fmt.Errorf("field 'TargetReference' is required")
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
 	}
-	if r.TargetReference != nil {
-		if err := r.TargetReference.Validate(); err != nil {
-			return fmt.Errorf("TargetReference: %w", err)
-		}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.TargetMarkdown == nil {
-		return fmt.Errorf("field 'TargetMarkdown' is required")
+	if r.AssertionElement != nil {
+		r.AssertionElement.validateAll(path+".assertion", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.VariableDefinition {
+		item.validateAll(fmt.Sprintf("%s.variableDefinition[%d]", path, i), issues)
+	}
+	for i, item := range r.SynthesisType {
+		item.validateAll(fmt.Sprintf("%s.synthesisType[%d]", path, i), issues)
+	}
+	for i, item := range r.StudyDesign {
+		item.validateAll(fmt.Sprintf("%s.studyDesign[%d]", path, i), issues)
+	}
+	for i, item := range r.Statistic {
+		item.validateAll(fmt.Sprintf("%s.statistic[%d]", path, i), issues)
+	}
+	for i, item := range r.Certainty {
+		item.validateAll(fmt.Sprintf("%s.certainty[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type EvidenceCertainty struct {
-	Id           *string             `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Description  *string             `json:"description,omitempty" bson:"description,omitempty"`   // Textual description of certainty
-	Note         []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                 // Footnotes and/or explanatory notes
-	Type         *CodeableConcept    `json:"type,omitempty" bson:"type,omitempty"`                 // Aspect of certainty being rated
-	Rating       *CodeableConcept    `json:"rating,omitempty" bson:"rating,omitempty"`             // Assessment or judgement of the aspect
-	Rater        []string            `json:"rater,omitempty" bson:"rater,omitempty"`               // Individual or group who did the rating
-	Subcomponent []EvidenceCertainty `json:"subcomponent,omitempty" bson:"subcomponent,omitempty"` // A domain or subdomain of certainty
+func (r *Evidence) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Evidence", &issues)
+	checkInvariants(r, "Evidence", &issues)
+	return issues
 }
 
-func (r *EvidenceCertainty) Validate() error {
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
+var evidenceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Evidence) invariants() []invariant {
+	return evidenceInvariants
+}
+
+func (r Evidence) MarshalJSON() ([]byte, error) {
+	type alias Evidence
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Evidence) UnmarshalJSON(data []byte) error {
+	type alias Evidence
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", evidenceVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r Evidence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Evidence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Evidence) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Evidence) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Evidence) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(evidenceVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var evidenceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"citeAs":        "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"description":   "markdown",
+	"assertion":     "markdown",
+}
+
+func (r *Evidence) elementTypes() map[string]string {
+	return evidenceElementTypes
+}
+
+var evidenceElementMetadata = []ElementMetadata{
+	{Path: "Evidence.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Evidence.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Evidence.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Evidence.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Evidence.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Evidence.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Evidence.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Evidence.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Evidence.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Evidence.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "Evidence.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.citeAs", Name: "citeAs", Field: 22, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Evidence.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Evidence.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Evidence.date", Name: "date", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Evidence.approvalDate", Name: "approvalDate", Field: 30, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Evidence.lastReviewDate", Name: "lastReviewDate", Field: 32, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Evidence.author", Name: "author", Field: 34, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Evidence.publisher", Name: "publisher", Field: 35, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.contact", Name: "contact", Field: 37, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Evidence.recorder", Name: "recorder", Field: 38, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Evidence.editor", Name: "editor", Field: 39, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Evidence.reviewer", Name: "reviewer", Field: 40, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Evidence.endorser", Name: "endorser", Field: 41, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Evidence.useContext", Name: "useContext", Field: 42, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Evidence.purpose", Name: "purpose", Field: 43, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.copyright", Name: "copyright", Field: 45, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.copyrightLabel", Name: "copyrightLabel", Field: 47, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.relatesTo", Name: "relatesTo", Field: 49, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Evidence.description", Name: "description", Field: 50, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.assertion", Name: "assertion", Field: 52, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.note", Name: "note", Field: 54, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Evidence.variableDefinition", Name: "variableDefinition", Field: 55, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Evidence.synthesisType", Name: "synthesisType", Field: 56, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.studyDesign", Name: "studyDesign", Field: 57, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic", Name: "statistic", Field: 58, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Evidence.certainty", Name: "certainty", Field: 59, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Evidence) ElementMetadata() []ElementMetadata {
+	return evidenceElementMetadata
+}
+
+// EvidenceVersionAlgorithm is the type of Evidence.versionAlgorithm[x]. It is implemented by the EvidenceVersionAlgorithm* variant types.
+type EvidenceVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceVersionAlgorithm()
+}
+
+// EvidenceVersionAlgorithmString is the string variant of EvidenceVersionAlgorithm.
+type EvidenceVersionAlgorithmString string
+
+func (v EvidenceVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v EvidenceVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v EvidenceVersionAlgorithmString) isEvidenceVersionAlgorithm() {}
+
+// EvidenceVersionAlgorithmCoding is the Coding variant of EvidenceVersionAlgorithm.
+type EvidenceVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v EvidenceVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v EvidenceVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v EvidenceVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v EvidenceVersionAlgorithmCoding) isEvidenceVersionAlgorithm() {}
+
+var evidenceVersionAlgorithmVariants = []EvidenceVersionAlgorithm{
+	EvidenceVersionAlgorithmString(""),
+	EvidenceVersionAlgorithmCoding{},
+}
+
+func (r *Evidence) GetResourceType() string {
+	return "Evidence"
+}
+
+func (r *Evidence) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Evidence) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Evidence) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Evidence) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Evidence) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Evidence) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Evidence) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Evidence)(nil)
+
+type EvidenceRelatesTo struct {
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept        `json:"type" bson:"type"`                                                // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
+	Target            EvidenceRelatesToTarget `json:"-" bson:"target,omitempty"`                                       // The artifact that is related to this Evidence
+	TargetElement     *Element                `json:"-" bson:"target_element,omitempty"`                               // Extensions for target[x]
+
+	targetVariants []string // JSON properties of Evidence.relatesTo.target[x] when more than one was decoded
+}
+
+func (r *EvidenceRelatesTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.targetVariants) > 1 {
+		return fmt.Errorf("field 'Target' must have a single type, got %v", r.targetVariants)
+	}
+	if r.Target == nil {
+		return fmt.Errorf("field 'Target' is required")
+	}
+	if r.Target != nil {
+		if err := r.Target.Validate(); err != nil {
+			return fmt.Errorf("Target: %w", err)
+		}
+	}
+	if r.TargetElement != nil {
+		if err := r.TargetElement.Validate(); err != nil {
+			return fmt.Errorf("TargetElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceRelatesTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.targetVariants) > 1 {
+		issues.add("structure", path+".target", fmt.Sprintf("field 'Target' must have a single type, got %v", r.targetVariants))
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target.ofType("+r.Target.FHIRType()+")", issues)
+	}
+	if r.TargetElement != nil {
+		r.TargetElement.validateAll(path+".target", issues)
+	}
+}
+
+var evidenceRelatesToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceRelatesTo) invariants() []invariant {
+	return evidenceRelatesToInvariants
+}
+
+func (r EvidenceRelatesTo) MarshalJSON() ([]byte, error) {
+	type alias EvidenceRelatesTo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "target", r.Target, r.TargetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EvidenceRelatesTo) UnmarshalJSON(data []byte) error {
+	type alias EvidenceRelatesTo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Target, element, r.targetVariants, err = unmarshalChoice(fields, "target", evidenceRelatesToTargetVariants); err != nil {
+		return err
+	}
+	r.TargetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetElement); err != nil {
+			return fmt.Errorf("_target: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r EvidenceRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceRelatesTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EvidenceRelatesTo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Target":
+		return "target", choiceValues(evidenceRelatesToTargetVariants)
+	}
+	return "", nil
+}
+
+var evidenceRelatesToElementMetadata = []ElementMetadata{
+	{Path: "Evidence.relatesTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.relatesTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.relatesTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.relatesTo.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.relatesTo.target[x]", Name: "target", Field: 4, Min: 1, Max: "1", Types: []string{"uri", "Attachment", "canonical", "Reference", "markdown"}},
+}
+
+func (r *EvidenceRelatesTo) ElementMetadata() []ElementMetadata {
+	return evidenceRelatesToElementMetadata
+}
+
+// EvidenceRelatesToTarget is the type of Evidence.relatesTo.target[x]. It is implemented by the EvidenceRelatesToTarget* variant types.
+type EvidenceRelatesToTarget interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceRelatesToTarget()
+}
+
+// EvidenceRelatesToTargetUri is the uri variant of EvidenceRelatesToTarget.
+type EvidenceRelatesToTargetUri string
+
+func (v EvidenceRelatesToTargetUri) Validate() error {
+	return nil
+}
+
+func (v EvidenceRelatesToTargetUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceRelatesToTargetUri) FHIRType() string {
+	return "uri"
+}
+
+func (v EvidenceRelatesToTargetUri) isEvidenceRelatesToTarget() {}
+
+// EvidenceRelatesToTargetAttachment is the Attachment variant of EvidenceRelatesToTarget.
+type EvidenceRelatesToTargetAttachment struct {
+	Attachment
+}
+
+func (v EvidenceRelatesToTargetAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v EvidenceRelatesToTargetAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v EvidenceRelatesToTargetAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v EvidenceRelatesToTargetAttachment) isEvidenceRelatesToTarget() {}
+
+// EvidenceRelatesToTargetCanonical is the canonical variant of EvidenceRelatesToTarget.
+type EvidenceRelatesToTargetCanonical string
+
+func (v EvidenceRelatesToTargetCanonical) Validate() error {
+	return nil
+}
+
+func (v EvidenceRelatesToTargetCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceRelatesToTargetCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v EvidenceRelatesToTargetCanonical) isEvidenceRelatesToTarget() {}
+
+// EvidenceRelatesToTargetReference is the Reference variant of EvidenceRelatesToTarget.
+type EvidenceRelatesToTargetReference struct {
+	Reference
+}
+
+func (v EvidenceRelatesToTargetReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v EvidenceRelatesToTargetReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v EvidenceRelatesToTargetReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v EvidenceRelatesToTargetReference) isEvidenceRelatesToTarget() {}
+
+// EvidenceRelatesToTargetMarkdown is the markdown variant of EvidenceRelatesToTarget.
+type EvidenceRelatesToTargetMarkdown string
+
+func (v EvidenceRelatesToTargetMarkdown) Validate() error {
+	return nil
+}
+
+func (v EvidenceRelatesToTargetMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceRelatesToTargetMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v EvidenceRelatesToTargetMarkdown) isEvidenceRelatesToTarget() {}
+
+var evidenceRelatesToTargetVariants = []EvidenceRelatesToTarget{
+	EvidenceRelatesToTargetUri(""),
+	EvidenceRelatesToTargetAttachment{},
+	EvidenceRelatesToTargetCanonical(""),
+	EvidenceRelatesToTargetReference{},
+	EvidenceRelatesToTargetMarkdown(""),
+}
+
+type EvidenceVariableDefinition struct {
+	Id                        *string              `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
+	Extension                 []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                             // Additional content defined by implementations
+	ModifierExtension         []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`            // Extensions that cannot be ignored even if unrecognized
+	Description               *string              `json:"description,omitempty" bson:"description,omitempty"`                         // A text description or summary of the variable
+	DescriptionElement        *Element             `json:"_description,omitempty" bson:"description_element,omitempty"`                // Extensions for description
+	Note                      []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                                       // Footnotes and/or explanatory notes
+	VariableRole              EvidenceVariableRole `json:"variableRole" bson:"variable_role"`                                          // population | exposure | outcome | covariate
+	VariableRoleElement       *Element             `json:"_variableRole,omitempty" bson:"variable_role_element,omitempty"`             // Extensions for variableRole
+	RoleSubtype               *CodeableConcept     `json:"roleSubtype,omitempty" bson:"role_subtype,omitempty"`                        // Sub-classification of the role of the variable
+	ComparatorCategory        *string              `json:"comparatorCategory,omitempty" bson:"comparator_category,omitempty"`          // The reference value used for comparison
+	ComparatorCategoryElement *Element             `json:"_comparatorCategory,omitempty" bson:"comparator_category_element,omitempty"` // Extensions for comparatorCategory
+	Observed                  *Reference           `json:"observed,omitempty" bson:"observed,omitempty"`                               // Definition of the actual variable related to the statistic(s)
+	Intended                  *Reference           `json:"intended,omitempty" bson:"intended,omitempty"`                               // Definition of the intended variable related to the Evidence
+	DirectnessMatch           *CodeableConcept     `json:"directnessMatch,omitempty" bson:"directness_match,omitempty"`                // low | moderate | high | exact
+}
+
+func (r *EvidenceVariableDefinition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	if r.VariableRole == "" {
+		return fmt.Errorf("field 'VariableRole' is required")
+	}
+	if !r.VariableRole.IsValid() {
+		return fmt.Errorf("field 'VariableRole' has invalid code '%s'", r.VariableRole)
+	}
+	if r.VariableRoleElement != nil {
+		if err := r.VariableRoleElement.Validate(); err != nil {
+			return fmt.Errorf("VariableRoleElement: %w", err)
+		}
+	}
+	if r.RoleSubtype != nil {
+		if err := r.RoleSubtype.Validate(); err != nil {
+			return fmt.Errorf("RoleSubtype: %w", err)
+		}
+	}
+	if r.ComparatorCategoryElement != nil {
+		if err := r.ComparatorCategoryElement.Validate(); err != nil {
+			return fmt.Errorf("ComparatorCategoryElement: %w", err)
+		}
+	}
+	if r.Observed != nil {
+		if err := r.Observed.Validate(); err != nil {
+			return fmt.Errorf("Observed: %w", err)
+		}
+	}
+	if r.Intended != nil {
+		if err := r.Intended.Validate(); err != nil {
+			return fmt.Errorf("Intended: %w", err)
+		}
+	}
+	if r.DirectnessMatch != nil {
+		if err := r.DirectnessMatch.Validate(); err != nil {
+			return fmt.Errorf("DirectnessMatch: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceVariableDefinition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.VariableRole == "" {
+		issues.add("required", path+".variableRole", "field 'VariableRole' is required")
+	}
+	if r.VariableRole != "" && !r.VariableRole.IsValid() {
+		issues.add("code-invalid", path+".variableRole", fmt.Sprintf("field 'VariableRole' has invalid code '%s'", r.VariableRole))
+	}
+	if r.VariableRoleElement != nil {
+		r.VariableRoleElement.validateAll(path+".variableRole", issues)
+	}
+	if r.RoleSubtype != nil {
+		r.RoleSubtype.validateAll(path+".roleSubtype", issues)
+	}
+	if r.ComparatorCategoryElement != nil {
+		r.ComparatorCategoryElement.validateAll(path+".comparatorCategory", issues)
+	}
+	if r.Observed != nil {
+		r.Observed.validateAll(path+".observed", issues)
+	}
+	if r.Intended != nil {
+		r.Intended.validateAll(path+".intended", issues)
+	}
+	if r.DirectnessMatch != nil {
+		r.DirectnessMatch.validateAll(path+".directnessMatch", issues)
+	}
+}
+
+var evidenceVariableDefinitionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceVariableDefinition) invariants() []invariant {
+	return evidenceVariableDefinitionInvariants
+}
+
+func (r EvidenceVariableDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceVariableDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariableDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariableDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceVariableDefinitionElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *EvidenceVariableDefinition) elementTypes() map[string]string {
+	return evidenceVariableDefinitionElementTypes
+}
+
+var evidenceVariableDefinitionElementMetadata = []ElementMetadata{
+	{Path: "Evidence.variableDefinition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.variableDefinition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.variableDefinition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.variableDefinition.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.variableDefinition.note", Name: "note", Field: 5, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Evidence.variableDefinition.variableRole", Name: "variableRole", Field: 6, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Evidence.variableDefinition.variableRole", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/variable-role"}},
+	{Path: "Evidence.variableDefinition.roleSubtype", Name: "roleSubtype", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.variableDefinition.comparatorCategory", Name: "comparatorCategory", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.variableDefinition.observed", Name: "observed", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Evidence.variableDefinition.intended", Name: "intended", Field: 12, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Evidence.variableDefinition.directnessMatch", Name: "directnessMatch", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *EvidenceVariableDefinition) ElementMetadata() []ElementMetadata {
+	return evidenceVariableDefinitionElementMetadata
+}
+
+type EvidenceStatistic struct {
+	Id                    *string                                `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
+	Extension             []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension     []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored even if unrecognized
+	Description           *string                                `json:"description,omitempty" bson:"description,omitempty"`                  // A natural language summary of the statistic
+	DescriptionElement    *Element                               `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	Note                  []Annotation                           `json:"note,omitempty" bson:"note,omitempty"`                                // Footnotes and/or explanatory notes
+	StatisticType         *CodeableConcept                       `json:"statisticType,omitempty" bson:"statistic_type,omitempty"`             // Type of statistic, e.g., relative risk
+	Category              *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                        // Associated category for categorical variable
+	Quantity              *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                        // Statistic value
+	NumberOfEvents        *int                                   `json:"numberOfEvents,omitempty" bson:"number_of_events,omitempty"`          // The number of events associated with the statistic
+	NumberOfEventsElement *Element                               `json:"_numberOfEvents,omitempty" bson:"number_of_events_element,omitempty"` // Extensions for numberOfEvents
+	NumberAffected        *int                                   `json:"numberAffected,omitempty" bson:"number_affected,omitempty"`           // The number of participants affected
+	NumberAffectedElement *Element                               `json:"_numberAffected,omitempty" bson:"number_affected_element,omitempty"`  // Extensions for numberAffected
+	SampleSize            *EvidenceStatisticSampleSize           `json:"sampleSize,omitempty" bson:"sample_size,omitempty"`                   // Count of participants in the study sample
+	AttributeEstimate     []EvidenceStatisticAttributeEstimate   `json:"attributeEstimate,omitempty" bson:"attribute_estimate,omitempty"`     // An attribute of the Statistic
+	ModelCharacteristic   []EvidenceStatisticModelCharacteristic `json:"modelCharacteristic,omitempty" bson:"model_characteristic,omitempty"` // An aspect of the statistical model
+}
+
+func (r *EvidenceStatistic) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	if r.StatisticType != nil {
+		if err := r.StatisticType.Validate(); err != nil {
+			return fmt.Errorf("StatisticType: %w", err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.NumberOfEventsElement != nil {
+		if err := r.NumberOfEventsElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfEventsElement: %w", err)
+		}
+	}
+	if r.NumberAffectedElement != nil {
+		if err := r.NumberAffectedElement.Validate(); err != nil {
+			return fmt.Errorf("NumberAffectedElement: %w", err)
+		}
+	}
+	if r.SampleSize != nil {
+		if err := r.SampleSize.Validate(); err != nil {
+			return fmt.Errorf("SampleSize: %w", err)
+		}
+	}
+	for i, item := range r.AttributeEstimate {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AttributeEstimate[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModelCharacteristic {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModelCharacteristic[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceStatistic) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.StatisticType != nil {
+		r.StatisticType.validateAll(path+".statisticType", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.NumberOfEventsElement != nil {
+		r.NumberOfEventsElement.validateAll(path+".numberOfEvents", issues)
+	}
+	if r.NumberAffectedElement != nil {
+		r.NumberAffectedElement.validateAll(path+".numberAffected", issues)
+	}
+	if r.SampleSize != nil {
+		r.SampleSize.validateAll(path+".sampleSize", issues)
+	}
+	for i, item := range r.AttributeEstimate {
+		item.validateAll(fmt.Sprintf("%s.attributeEstimate[%d]", path, i), issues)
+	}
+	for i, item := range r.ModelCharacteristic {
+		item.validateAll(fmt.Sprintf("%s.modelCharacteristic[%d]", path, i), issues)
+	}
+}
+
+var evidenceStatisticInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceStatistic) invariants() []invariant {
+	return evidenceStatisticInvariants
+}
+
+func (r EvidenceStatistic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceStatistic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceStatistic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceStatistic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceStatisticElementTypes = map[string]string{
+	"description":    "markdown",
+	"numberOfEvents": "unsignedInt",
+	"numberAffected": "unsignedInt",
+}
+
+func (r *EvidenceStatistic) elementTypes() map[string]string {
+	return evidenceStatisticElementTypes
+}
+
+var evidenceStatisticElementMetadata = []ElementMetadata{
+	{Path: "Evidence.statistic.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.statistic.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.statistic.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.statistic.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.statistic.note", Name: "note", Field: 5, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Evidence.statistic.statisticType", Name: "statisticType", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic.category", Name: "category", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic.quantity", Name: "quantity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Evidence.statistic.numberOfEvents", Name: "numberOfEvents", Field: 9, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Evidence.statistic.numberAffected", Name: "numberAffected", Field: 11, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Evidence.statistic.sampleSize", Name: "sampleSize", Field: 13, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Evidence.statistic.attributeEstimate", Name: "attributeEstimate", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Evidence.statistic.modelCharacteristic", Name: "modelCharacteristic", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *EvidenceStatistic) ElementMetadata() []ElementMetadata {
+	return evidenceStatisticElementMetadata
+}
+
+type EvidenceStatisticSampleSize struct {
+	Id                          *string      `json:"id,omitempty" bson:"id,omitempty"`                                                // Unique id for inter-element referencing
+	Extension                   []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`                                  // Additional content defined by implementations
+	ModifierExtension           []Extension  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                 // Extensions that cannot be ignored even if unrecognized
+	Description                 *string      `json:"description,omitempty" bson:"description,omitempty"`                              // Textual description of sample size for statistic
+	DescriptionElement          *Element     `json:"_description,omitempty" bson:"description_element,omitempty"`                     // Extensions for description
+	Note                        []Annotation `json:"note,omitempty" bson:"note,omitempty"`                                            // Footnote or explanatory note about the sample size
+	NumberOfStudies             *int         `json:"numberOfStudies,omitempty" bson:"number_of_studies,omitempty"`                    // Number of contributing studies
+	NumberOfStudiesElement      *Element     `json:"_numberOfStudies,omitempty" bson:"number_of_studies_element,omitempty"`           // Extensions for numberOfStudies
+	NumberOfParticipants        *int         `json:"numberOfParticipants,omitempty" bson:"number_of_participants,omitempty"`          // Total number of participants
+	NumberOfParticipantsElement *Element     `json:"_numberOfParticipants,omitempty" bson:"number_of_participants_element,omitempty"` // Extensions for numberOfParticipants
+	KnownDataCount              *int         `json:"knownDataCount,omitempty" bson:"known_data_count,omitempty"`                      // Number of participants with known results for measured variables
+	KnownDataCountElement       *Element     `json:"_knownDataCount,omitempty" bson:"known_data_count_element,omitempty"`             // Extensions for knownDataCount
+	NumberAnalyzed              *int         `json:"numberAnalyzed,omitempty" bson:"number_analyzed,omitempty"`                       // Total number of participants who were analayzed
+	NumberAnalyzedElement       *Element     `json:"_numberAnalyzed,omitempty" bson:"number_analyzed_element,omitempty"`              // Extensions for numberAnalyzed
+}
+
+func (r *EvidenceStatisticSampleSize) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	if r.NumberOfStudiesElement != nil {
+		if err := r.NumberOfStudiesElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfStudiesElement: %w", err)
+		}
+	}
+	if r.NumberOfParticipantsElement != nil {
+		if err := r.NumberOfParticipantsElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfParticipantsElement: %w", err)
+		}
+	}
+	if r.KnownDataCountElement != nil {
+		if err := r.KnownDataCountElement.Validate(); err != nil {
+			return fmt.Errorf("KnownDataCountElement: %w", err)
+		}
+	}
+	if r.NumberAnalyzedElement != nil {
+		if err := r.NumberAnalyzedElement.Validate(); err != nil {
+			return fmt.Errorf("NumberAnalyzedElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceStatisticSampleSize) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.NumberOfStudiesElement != nil {
+		r.NumberOfStudiesElement.validateAll(path+".numberOfStudies", issues)
+	}
+	if r.NumberOfParticipantsElement != nil {
+		r.NumberOfParticipantsElement.validateAll(path+".numberOfParticipants", issues)
+	}
+	if r.KnownDataCountElement != nil {
+		r.KnownDataCountElement.validateAll(path+".knownDataCount", issues)
+	}
+	if r.NumberAnalyzedElement != nil {
+		r.NumberAnalyzedElement.validateAll(path+".numberAnalyzed", issues)
+	}
+}
+
+var evidenceStatisticSampleSizeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceStatisticSampleSize) invariants() []invariant {
+	return evidenceStatisticSampleSizeInvariants
+}
+
+func (r EvidenceStatisticSampleSize) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceStatisticSampleSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceStatisticSampleSize) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceStatisticSampleSize) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceStatisticSampleSizeElementTypes = map[string]string{
+	"description":          "markdown",
+	"numberOfStudies":      "unsignedInt",
+	"numberOfParticipants": "unsignedInt",
+	"knownDataCount":       "unsignedInt",
+	"numberAnalyzed":       "unsignedInt",
+}
+
+func (r *EvidenceStatisticSampleSize) elementTypes() map[string]string {
+	return evidenceStatisticSampleSizeElementTypes
+}
+
+var evidenceStatisticSampleSizeElementMetadata = []ElementMetadata{
+	{Path: "Evidence.statistic.sampleSize.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.statistic.sampleSize.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.statistic.sampleSize.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.statistic.sampleSize.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.statistic.sampleSize.note", Name: "note", Field: 5, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Evidence.statistic.sampleSize.numberOfStudies", Name: "numberOfStudies", Field: 6, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Evidence.statistic.sampleSize.numberOfParticipants", Name: "numberOfParticipants", Field: 8, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Evidence.statistic.sampleSize.knownDataCount", Name: "knownDataCount", Field: 10, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Evidence.statistic.sampleSize.numberAnalyzed", Name: "numberAnalyzed", Field: 12, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+}
+
+func (r *EvidenceStatisticSampleSize) ElementMetadata() []ElementMetadata {
+	return evidenceStatisticSampleSizeElementMetadata
+}
+
+type EvidenceStatisticAttributeEstimate struct {
+	Id                 *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Description        *string                              `json:"description,omitempty" bson:"description,omitempty"`              // Textual description of the attribute estimate
+	DescriptionElement *Element                             `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Note               []Annotation                         `json:"note,omitempty" bson:"note,omitempty"`                            // Footnote or explanatory note about the estimate
+	Type               *CodeableConcept                     `json:"type,omitempty" bson:"type,omitempty"`                            // The type of attribute estimate, e.g., confidence interval or p value
+	Quantity           *Quantity                            `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // The singular quantity of the attribute estimate, for attribute estimates represented as single values, which may include a unit of measure
+	Level              *Decimal                             `json:"level,omitempty" bson:"level,omitempty"`                          // Level of confidence interval, e.g., 0.95 for 95% confidence interval
+	LevelElement       *Element                             `json:"_level,omitempty" bson:"level_element,omitempty"`                 // Extensions for level
+	Range              *Range                               `json:"range,omitempty" bson:"range,omitempty"`                          // Lower and upper bound values of the attribute estimate
+	AttributeEstimate  []EvidenceStatisticAttributeEstimate `json:"attributeEstimate,omitempty" bson:"attribute_estimate,omitempty"` // A nested attribute estimate; which is the attribute estimate of an attribute estimate
+}
+
+func (r *EvidenceStatisticAttributeEstimate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.Level != nil {
+		if err := r.Level.Validate(); err != nil {
+			return fmt.Errorf("Level: %w", err)
+		}
+	}
+	if r.LevelElement != nil {
+		if err := r.LevelElement.Validate(); err != nil {
+			return fmt.Errorf("LevelElement: %w", err)
+		}
+	}
+	if r.Range != nil {
+		if err := r.Range.Validate(); err != nil {
+			return fmt.Errorf("Range: %w", err)
+		}
+	}
+	for i, item := range r.AttributeEstimate {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AttributeEstimate[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceStatisticAttributeEstimate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.Level != nil {
+		if err := r.Level.Validate(); err != nil {
+			issues.add("value", path+".level", err.Error())
+		}
+	}
+	if r.LevelElement != nil {
+		r.LevelElement.validateAll(path+".level", issues)
+	}
+	if r.Range != nil {
+		r.Range.validateAll(path+".range", issues)
+	}
+	for i, item := range r.AttributeEstimate {
+		item.validateAll(fmt.Sprintf("%s.attributeEstimate[%d]", path, i), issues)
+	}
+}
+
+var evidenceStatisticAttributeEstimateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceStatisticAttributeEstimate) invariants() []invariant {
+	return evidenceStatisticAttributeEstimateInvariants
+}
+
+func (r EvidenceStatisticAttributeEstimate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceStatisticAttributeEstimate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceStatisticAttributeEstimate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceStatisticAttributeEstimate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceStatisticAttributeEstimateElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *EvidenceStatisticAttributeEstimate) elementTypes() map[string]string {
+	return evidenceStatisticAttributeEstimateElementTypes
+}
+
+var evidenceStatisticAttributeEstimateElementMetadata = []ElementMetadata{
+	{Path: "Evidence.statistic.attributeEstimate.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.statistic.attributeEstimate.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.statistic.attributeEstimate.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.statistic.attributeEstimate.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.statistic.attributeEstimate.note", Name: "note", Field: 5, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Evidence.statistic.attributeEstimate.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic.attributeEstimate.quantity", Name: "quantity", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Evidence.statistic.attributeEstimate.level", Name: "level", Field: 8, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "Evidence.statistic.attributeEstimate.range", Name: "range", Field: 10, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "Evidence.statistic.attributeEstimate.attributeEstimate", Name: "attributeEstimate", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *EvidenceStatisticAttributeEstimate) ElementMetadata() []ElementMetadata {
+	return evidenceStatisticAttributeEstimateElementMetadata
+}
+
+type EvidenceStatisticModelCharacteristic struct {
+	Id                *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept                               `json:"code" bson:"code"`                                                // Model specification
+	Value             EvidenceStatisticModelCharacteristicValue      `json:"-" bson:"value,omitempty"`                                        // The specific value (when paired with code)
+	Intended          *bool                                          `json:"intended,omitempty" bson:"intended,omitempty"`                    // The plan for analysis
+	IntendedElement   *Element                                       `json:"_intended,omitempty" bson:"intended_element,omitempty"`           // Extensions for intended
+	Applied           *bool                                          `json:"applied,omitempty" bson:"applied,omitempty"`                      // This model characteristic is part of the analysis that was applied, whether or not the analysis followed the plan
+	AppliedElement    *Element                                       `json:"_applied,omitempty" bson:"applied_element,omitempty"`             // Extensions for applied
+	Variable          []EvidenceStatisticModelCharacteristicVariable `json:"variable,omitempty" bson:"variable,omitempty"`                    // A variable adjusted for in the adjusted analysis
+	Attribute         []EvidenceStatisticAttributeEstimate           `json:"attribute,omitempty" bson:"attribute,omitempty"`                  // An attribute of the model characteristic
+
+	valueVariants []string // JSON properties of Evidence.statistic.modelCharacteristic.value[x] when more than one was decoded
+}
+
+func (r *EvidenceStatisticModelCharacteristic) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.IntendedElement != nil {
+		if err := r.IntendedElement.Validate(); err != nil {
+			return fmt.Errorf("IntendedElement: %w", err)
+		}
+	}
+	if r.AppliedElement != nil {
+		if err := r.AppliedElement.Validate(); err != nil {
+			return fmt.Errorf("AppliedElement: %w", err)
+		}
+	}
+	for i, item := range r.Variable {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Variable[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Attribute {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Attribute[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceStatisticModelCharacteristic) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.IntendedElement != nil {
+		r.IntendedElement.validateAll(path+".intended", issues)
+	}
+	if r.AppliedElement != nil {
+		r.AppliedElement.validateAll(path+".applied", issues)
+	}
+	for i, item := range r.Variable {
+		item.validateAll(fmt.Sprintf("%s.variable[%d]", path, i), issues)
+	}
+	for i, item := range r.Attribute {
+		item.validateAll(fmt.Sprintf("%s.attribute[%d]", path, i), issues)
+	}
+}
+
+var evidenceStatisticModelCharacteristicInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceStatisticModelCharacteristic) invariants() []invariant {
+	return evidenceStatisticModelCharacteristicInvariants
+}
+
+func (r EvidenceStatisticModelCharacteristic) MarshalJSON() ([]byte, error) {
+	type alias EvidenceStatisticModelCharacteristic
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EvidenceStatisticModelCharacteristic) UnmarshalJSON(data []byte) error {
+	type alias EvidenceStatisticModelCharacteristic
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Value, _, r.valueVariants, err = unmarshalChoice(fields, "value", evidenceStatisticModelCharacteristicValueVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r EvidenceStatisticModelCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceStatisticModelCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceStatisticModelCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceStatisticModelCharacteristic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EvidenceStatisticModelCharacteristic) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(evidenceStatisticModelCharacteristicValueVariants)
+	}
+	return "", nil
+}
+
+var evidenceStatisticModelCharacteristicElementMetadata = []ElementMetadata{
+	{Path: "Evidence.statistic.modelCharacteristic.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.statistic.modelCharacteristic.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.statistic.modelCharacteristic.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.statistic.modelCharacteristic.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic.modelCharacteristic.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity", "Range", "CodeableConcept"}},
+	{Path: "Evidence.statistic.modelCharacteristic.intended", Name: "intended", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Evidence.statistic.modelCharacteristic.applied", Name: "applied", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable", Name: "variable", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Evidence.statistic.modelCharacteristic.attribute", Name: "attribute", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *EvidenceStatisticModelCharacteristic) ElementMetadata() []ElementMetadata {
+	return evidenceStatisticModelCharacteristicElementMetadata
+}
+
+// EvidenceStatisticModelCharacteristicValue is the type of Evidence.statistic.modelCharacteristic.value[x]. It is implemented by the EvidenceStatisticModelCharacteristicValue* variant types.
+type EvidenceStatisticModelCharacteristicValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceStatisticModelCharacteristicValue()
+}
+
+// EvidenceStatisticModelCharacteristicValueQuantity is the Quantity variant of EvidenceStatisticModelCharacteristicValue.
+type EvidenceStatisticModelCharacteristicValueQuantity struct {
+	Quantity
+}
+
+func (v EvidenceStatisticModelCharacteristicValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v EvidenceStatisticModelCharacteristicValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v EvidenceStatisticModelCharacteristicValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v EvidenceStatisticModelCharacteristicValueQuantity) isEvidenceStatisticModelCharacteristicValue() {
+}
+
+// EvidenceStatisticModelCharacteristicValueRange is the Range variant of EvidenceStatisticModelCharacteristicValue.
+type EvidenceStatisticModelCharacteristicValueRange struct {
+	Range
+}
+
+func (v EvidenceStatisticModelCharacteristicValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v EvidenceStatisticModelCharacteristicValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v EvidenceStatisticModelCharacteristicValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v EvidenceStatisticModelCharacteristicValueRange) isEvidenceStatisticModelCharacteristicValue() {
+}
+
+// EvidenceStatisticModelCharacteristicValueCodeableConcept is the CodeableConcept variant of EvidenceStatisticModelCharacteristicValue.
+type EvidenceStatisticModelCharacteristicValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v EvidenceStatisticModelCharacteristicValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v EvidenceStatisticModelCharacteristicValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v EvidenceStatisticModelCharacteristicValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v EvidenceStatisticModelCharacteristicValueCodeableConcept) isEvidenceStatisticModelCharacteristicValue() {
+}
+
+var evidenceStatisticModelCharacteristicValueVariants = []EvidenceStatisticModelCharacteristicValue{
+	EvidenceStatisticModelCharacteristicValueQuantity{},
+	EvidenceStatisticModelCharacteristicValueRange{},
+	EvidenceStatisticModelCharacteristicValueCodeableConcept{},
+}
+
+type EvidenceStatisticModelCharacteristicVariable struct {
+	Id                 *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	VariableDefinition *Reference        `json:"variableDefinition" bson:"variable_definition"`                   // Description and definition of the variable
+	Handling           *CodeableConcept  `json:"handling,omitempty" bson:"handling,omitempty"`                    // boolean | continuous | dichotomous | ordinal | polychotomous | time-to-event | not-specified
+	ValueCategory      []CodeableConcept `json:"valueCategory,omitempty" bson:"value_category,omitempty"`         // Qualitative label used for grouping values of a dichotomous, ordinal, or polychotomous variable
+	ValueQuantity      []Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`         // Quantitative label used for grouping values of a dichotomous, ordinal, or polychotomous variable
+	ValueRange         []Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`               // Range of quantitative labels used for grouping values of a dichotomous, ordinal, or polychotomous variable
+}
+
+func (r *EvidenceStatisticModelCharacteristicVariable) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.VariableDefinition == nil {
+		return fmt.Errorf("field 'VariableDefinition' is required")
+	}
+	if r.VariableDefinition != nil {
+		if err := r.VariableDefinition.Validate(); err != nil {
+			return fmt.Errorf("VariableDefinition: %w", err)
+		}
+	}
+	if r.Handling != nil {
+		if err := r.Handling.Validate(); err != nil {
+			return fmt.Errorf("Handling: %w", err)
+		}
+	}
+	for i, item := range r.ValueCategory {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ValueCategory[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ValueQuantity {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ValueQuantity[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ValueRange {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ValueRange[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceStatisticModelCharacteristicVariable) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.VariableDefinition == nil {
+		issues.add("required", path+".variableDefinition", "field 'VariableDefinition' is required")
+	}
+	if r.VariableDefinition != nil {
+		r.VariableDefinition.validateAll(path+".variableDefinition", issues)
+	}
+	if r.Handling != nil {
+		r.Handling.validateAll(path+".handling", issues)
+	}
+	for i, item := range r.ValueCategory {
+		item.validateAll(fmt.Sprintf("%s.valueCategory[%d]", path, i), issues)
+	}
+	for i, item := range r.ValueQuantity {
+		item.validateAll(fmt.Sprintf("%s.valueQuantity[%d]", path, i), issues)
+	}
+	for i, item := range r.ValueRange {
+		item.validateAll(fmt.Sprintf("%s.valueRange[%d]", path, i), issues)
+	}
+}
+
+var evidenceStatisticModelCharacteristicVariableInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceStatisticModelCharacteristicVariable) invariants() []invariant {
+	return evidenceStatisticModelCharacteristicVariableInvariants
+}
+
+func (r EvidenceStatisticModelCharacteristicVariable) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceStatisticModelCharacteristicVariable) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceStatisticModelCharacteristicVariable) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceStatisticModelCharacteristicVariable) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceStatisticModelCharacteristicVariableElementMetadata = []ElementMetadata{
+	{Path: "Evidence.statistic.modelCharacteristic.variable.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.variableDefinition", Name: "variableDefinition", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.handling", Name: "handling", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.valueCategory", Name: "valueCategory", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.valueQuantity", Name: "valueQuantity", Field: 6, Min: 0, Max: "*", Types: []string{"Quantity"}},
+	{Path: "Evidence.statistic.modelCharacteristic.variable.valueRange", Name: "valueRange", Field: 7, Min: 0, Max: "*", Types: []string{"Range"}},
+}
+
+func (r *EvidenceStatisticModelCharacteristicVariable) ElementMetadata() []ElementMetadata {
+	return evidenceStatisticModelCharacteristicVariableElementMetadata
+}
+
+type EvidenceCertainty struct {
+	Id                 *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Description        *string             `json:"description,omitempty" bson:"description,omitempty"`              // Textual description of certainty
+	DescriptionElement *Element            `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Note               []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                            // Footnotes and/or explanatory notes
+	Type               *CodeableConcept    `json:"type,omitempty" bson:"type,omitempty"`                            // Aspect of certainty being rated
+	Rating             *CodeableConcept    `json:"rating,omitempty" bson:"rating,omitempty"`                        // Assessment or judgement of the aspect
+	Rater              []string            `json:"rater,omitempty" bson:"rater,omitempty"`                          // Individual or group who did the rating
+	RaterElement       []*Element          `json:"_rater,omitempty" bson:"rater_element,omitempty"`                 // Extensions for rater
+	Subcomponent       []EvidenceCertainty `json:"subcomponent,omitempty" bson:"subcomponent,omitempty"`            // A domain or subdomain of certainty
+}
+
+func (r *EvidenceCertainty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
 	if r.Type != nil {
@@ -470,6 +2017,14 @@ func (r *EvidenceCertainty) Validate() error {
 			return fmt.Errorf("Rating: %w", err)
 		}
 	}
+	for i, item := range r.RaterElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RaterElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Subcomponent {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Subcomponent[%d]: %w", i, err)
@@ -477,3 +2032,108 @@ func (r *EvidenceCertainty) Validate() error {
 	}
 	return nil
 }
+
+func (r *EvidenceCertainty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Rating != nil {
+		r.Rating.validateAll(path+".rating", issues)
+	}
+	for i, item := range r.RaterElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.rater[%d]", path, i), issues)
+	}
+	for i, item := range r.Subcomponent {
+		item.validateAll(fmt.Sprintf("%s.subcomponent[%d]", path, i), issues)
+	}
+}
+
+var evidenceCertaintyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceCertainty) invariants() []invariant {
+	return evidenceCertaintyInvariants
+}
+
+func (r EvidenceCertainty) MarshalJSON() ([]byte, error) {
+	type alias EvidenceCertainty
+	out := struct {
+		alias
+		Rater        []*string  `json:"rater,omitempty"`
+		RaterElement []*Element `json:"_rater,omitempty"`
+	}{alias: alias(r)}
+	out.Rater, out.RaterElement = alignPrimitiveArray(r.Rater, r.RaterElement)
+	return json.Marshal(out)
+}
+
+func (r *EvidenceCertainty) UnmarshalJSON(data []byte) error {
+	type alias EvidenceCertainty
+	aux := struct {
+		*alias
+		Rater []*string `json:"rater,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Rater, err = splitPrimitiveArray(aux.Rater, r.RaterElement); err != nil {
+		return fmt.Errorf("rater: %w", err)
+	}
+	return nil
+}
+
+func (r EvidenceCertainty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceCertainty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceCertainty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceCertainty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceCertaintyElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *EvidenceCertainty) elementTypes() map[string]string {
+	return evidenceCertaintyElementTypes
+}
+
+var evidenceCertaintyElementMetadata = []ElementMetadata{
+	{Path: "Evidence.certainty.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Evidence.certainty.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Evidence.certainty.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Evidence.certainty.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Evidence.certainty.note", Name: "note", Field: 5, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Evidence.certainty.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.certainty.rating", Name: "rating", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Evidence.certainty.rater", Name: "rater", Field: 8, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Evidence.certainty.subcomponent", Name: "subcomponent", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *EvidenceCertainty) ElementMetadata() []ElementMetadata {
+	return evidenceCertaintyElementMetadata
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,39 +12,60 @@ type EvidenceVariable struct {
 	Id                       *string                              `json:"id,omitempty" bson:"id,omitempty"`                                               // Logical id of this artifact
 	Meta                     *Meta                                `json:"meta,omitempty" bson:"meta,omitempty"`                                           // Metadata about the resource
 	ImplicitRules            *string                              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                        // A set of rules under which this content was created
+	ImplicitRulesElement     *Element                             `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`               // Extensions for implicitRules
 	Language                 *string                              `json:"language,omitempty" bson:"language,omitempty"`                                   // Language of the resource content
+	LanguageElement          *Element                             `json:"_language,omitempty" bson:"language_element,omitempty"`                          // Extensions for language
 	Text                     *Narrative                           `json:"text,omitempty" bson:"text,omitempty"`                                           // Text summary of the resource, for human interpretation
-	Contained                []json.RawMessage                    `json:"contained,omitempty" bson:"contained,omitempty"`                                 // Contained, inline Resources
+	Contained                []Resource                           `json:"contained,omitempty" bson:"contained,omitempty"`                                 // Contained, inline Resources
+	Extension                []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                                 // Additional content defined by implementations
+	ModifierExtension        []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                // Extensions that cannot be ignored
 	Url                      *string                              `json:"url,omitempty" bson:"url,omitempty"`                                             // Canonical identifier for this evidence variable, represented as a URI (globally unique)
+	UrlElement               *Element                             `json:"_url,omitempty" bson:"url_element,omitempty"`                                    // Extensions for url
 	Identifier               []Identifier                         `json:"identifier,omitempty" bson:"identifier,omitempty"`                               // Additional identifier for the evidence variable
 	Version                  *string                              `json:"version,omitempty" bson:"version,omitempty"`                                     // Business version of the evidence variable
-	VersionAlgorithmString   *string                              `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"`     // How to compare versions
-	VersionAlgorithmCoding   *Coding                              `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"`     // How to compare versions
+	VersionElement           *Element                             `json:"_version,omitempty" bson:"version_element,omitempty"`                            // Extensions for version
+	VersionAlgorithm         EvidenceVariableVersionAlgorithm     `json:"-" bson:"version_algorithm,omitempty"`                                           // How to compare versions
+	VersionAlgorithmElement  *Element                             `json:"-" bson:"version_algorithm_element,omitempty"`                                   // Extensions for versionAlgorithm[x]
 	Name                     *string                              `json:"name,omitempty" bson:"name,omitempty"`                                           // Name for this evidence variable (computer friendly)
+	NameElement              *Element                             `json:"_name,omitempty" bson:"name_element,omitempty"`                                  // Extensions for name
 	Title                    *string                              `json:"title,omitempty" bson:"title,omitempty"`                                         // Name for this evidence variable (human friendly)
+	TitleElement             *Element                             `json:"_title,omitempty" bson:"title_element,omitempty"`                                // Extensions for title
 	ShortTitle               *string                              `json:"shortTitle,omitempty" bson:"short_title,omitempty"`                              // Title for use in informal contexts
+	ShortTitleElement        *Element                             `json:"_shortTitle,omitempty" bson:"short_title_element,omitempty"`                     // Extensions for shortTitle
 	CiteAs                   *string                              `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                                      // Display of how to cite this EvidenceVariable
-	Status                   string                               `json:"status" bson:"status"`                                                           // draft | active | retired | unknown
+	CiteAsElement            *Element                             `json:"_citeAs,omitempty" bson:"cite_as_element,omitempty"`                             // Extensions for citeAs
+	Status                   PublicationStatus                    `json:"status" bson:"status"`                                                           // draft | active | retired | unknown
+	StatusElement            *Element                             `json:"_status,omitempty" bson:"status_element,omitempty"`                              // Extensions for status
 	Experimental             *bool                                `json:"experimental,omitempty" bson:"experimental,omitempty"`                           // For testing only - never for real usage
-	Date                     *string                              `json:"date,omitempty" bson:"date,omitempty"`                                           // Date last changed
+	ExperimentalElement      *Element                             `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                  // Extensions for experimental
+	Date                     *DateTime                            `json:"date,omitempty" bson:"date,omitempty"`                                           // Date last changed
+	DateElement              *Element                             `json:"_date,omitempty" bson:"date_element,omitempty"`                                  // Extensions for date
 	Author                   []ContactDetail                      `json:"author,omitempty" bson:"author,omitempty"`                                       // Who authored the content
 	Publisher                *string                              `json:"publisher,omitempty" bson:"publisher,omitempty"`                                 // Name of the publisher/steward (organization or individual)
+	PublisherElement         *Element                             `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                        // Extensions for publisher
 	Contact                  []ContactDetail                      `json:"contact,omitempty" bson:"contact,omitempty"`                                     // Contact details for the publisher
 	Recorder                 []ContactDetail                      `json:"recorder,omitempty" bson:"recorder,omitempty"`                                   // Who entered the data for the evidence variable
 	Editor                   []ContactDetail                      `json:"editor,omitempty" bson:"editor,omitempty"`                                       // Who edited the content
 	Reviewer                 []ContactDetail                      `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                                   // Who reviewed the content
 	Endorser                 []ContactDetail                      `json:"endorser,omitempty" bson:"endorser,omitempty"`                                   // Who endorsed the content
 	Description              *string                              `json:"description,omitempty" bson:"description,omitempty"`                             // Natural language description of the evidence variable
+	DescriptionElement       *Element                             `json:"_description,omitempty" bson:"description_element,omitempty"`                    // Extensions for description
 	Note                     []Annotation                         `json:"note,omitempty" bson:"note,omitempty"`                                           // Used for footnotes or explanatory notes
 	UseContext               []UsageContext                       `json:"useContext,omitempty" bson:"use_context,omitempty"`                              // The context that the content is intended to support
 	Purpose                  *string                              `json:"purpose,omitempty" bson:"purpose,omitempty"`                                     // Why this EvidenceVariable is defined
+	PurposeElement           *Element                             `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                            // Extensions for purpose
 	Copyright                *string                              `json:"copyright,omitempty" bson:"copyright,omitempty"`                                 // Intellectual property ownership, may include restrictions on use
+	CopyrightElement         *Element                             `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                        // Extensions for copyright
 	CopyrightLabel           *string                              `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                      // Copyright holder and year(s)
-	ApprovalDate             *string                              `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                          // When the resource was approved by publisher
-	LastReviewDate           *string                              `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                     // When the resource was last reviewed by the publisher
+	CopyrightLabelElement    *Element                             `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`             // Extensions for copyrightLabel
+	ApprovalDate             *Date                                `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                          // When the resource was approved by publisher
+	ApprovalDateElement      *Element                             `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`                 // Extensions for approvalDate
+	LastReviewDate           *Date                                `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                     // When the resource was last reviewed by the publisher
+	LastReviewDateElement    *Element                             `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`            // Extensions for lastReviewDate
 	EffectivePeriod          *Period                              `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                    // When the resource is expected to be used
 	RelatesTo                []EvidenceVariableRelatesTo          `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                                // Relationships to other Resources
 	Actual                   *bool                                `json:"actual,omitempty" bson:"actual,omitempty"`                                       // Actual or conceptual
+	ActualElement            *Element                             `json:"_actual,omitempty" bson:"actual_element,omitempty"`                              // Extensions for actual
 	Definition               *CodeableReference                   `json:"definition,omitempty" bson:"definition,omitempty"`                               // The meaning of the evidence variable
 	DefinitionModifier       []EvidenceVariableDefinitionModifier `json:"definitionModifier,omitempty" bson:"definition_modifier,omitempty"`              // Further specification of the definition
 	Handling                 *CodeableConcept                     `json:"handling,omitempty" bson:"handling,omitempty"`                                   // boolean | continuous | dichotomous | ordinal | polychotomous | time-to-event | not-specified
@@ -56,6 +78,8 @@ type EvidenceVariable struct {
 	Constraint               []EvidenceVariableConstraint         `json:"constraint,omitempty" bson:"constraint,omitempty"`                               // Limit on acceptability of data value
 	MissingDataMeaning       []CodeableConcept                    `json:"missingDataMeaning,omitempty" bson:"missing_data_meaning,omitempty"`             // How missing data can be interpreted
 	UnacceptableDataHandling []CodeableConcept                    `json:"unacceptableDataHandling,omitempty" bson:"unacceptable_data_handling,omitempty"` // How erroneous data is processed
+
+	versionAlgorithmVariants []string // JSON properties of EvidenceVariable.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *EvidenceVariable) Validate() error {
@@ -67,30 +91,123 @@ func (r *EvidenceVariable) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.ShortTitleElement != nil {
+		if err := r.ShortTitleElement.Validate(); err != nil {
+			return fmt.Errorf("ShortTitleElement: %w", err)
+		}
+	}
+	if r.CiteAsElement != nil {
+		if err := r.CiteAsElement.Validate(); err != nil {
+			return fmt.Errorf("CiteAsElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	for i, item := range r.Author {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Author[%d]: %w", i, err)
 		}
 	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
@@ -116,6 +233,11 @@ func (r *EvidenceVariable) Validate() error {
 			return fmt.Errorf("Endorser[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -126,6 +248,41 @@ func (r *EvidenceVariable) Validate() error {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -136,6 +293,11 @@ func (r *EvidenceVariable) Validate() error {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
+	if r.ActualElement != nil {
+		if err := r.ActualElement.Validate(); err != nil {
+			return fmt.Errorf("ActualElement: %w", err)
+		}
+	}
 	if r.Definition != nil {
 		if err := r.Definition.Validate(); err != nil {
 			return fmt.Errorf("Definition: %w", err)
@@ -196,250 +358,1596 @@ func (r *EvidenceVariable) Validate() error {
 			return fmt.Errorf("UnacceptableDataHandling[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "EvidenceVariable")
 }
 
-type EvidenceVariableRelatesTo struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type" bson:"type"`                          // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
-	TargetUri        *string          `json:"targetUri" bson:"target_uri"`               // The artifact that is related to this EvidenceVariable
-	TargetAttachment *Attachment      `json:"targetAttachment" bson:"target_attachment"` // The artifact that is related to this EvidenceVariable
-	TargetCanonical  *string          `json:"targetCanonical" bson:"target_canonical"`   // The artifact that is related to this EvidenceVariable
-	TargetReference  *Reference       `json:"targetReference" bson:"target_reference"`   // The artifact that is related to this EvidenceVariable
-	TargetMarkdown   *string          `json:"targetMarkdown" bson:"target_markdown"`     // The artifact that is related to this EvidenceVariable
-}
-
-func (r *EvidenceVariableRelatesTo) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *EvidenceVariable) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "EvidenceVariable" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'EvidenceVariable', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.TargetUri == nil {
-		return fmt.Errorf("field 'TargetUri' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.TargetAttachment == nil {
-		return fmt.Errorf("field 'TargetAttachment' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.TargetAttachment != nil {
-		if err := r.TargetAttachment.Validate(); err != nil {
-			return fmt.Errorf("TargetAttachment: %w", err)
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.TargetCanonical == nil {
-		return fmt.Errorf("field 'TargetCanonical' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.TargetReference == nil {
-		return fmt.Errorf("field 'TargetReference' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.TargetReference != nil {
-		if err := r.TargetReference.Validate(); err != nil {
-			return fmt.Errorf("TargetReference: %w", err)
-		}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	if r.TargetMarkdown == nil {
-		return fmt.Errorf("field 'TargetMarkdown' is required")
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type EvidenceVariableDefinitionModifier struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code                 *CodeableConcept `json:"code" bson:"code"`                                   // Attribute of the definition
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // Specification of the definition attribute
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // Specification of the definition attribute
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // Specification of the definition attribute
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // Specification of the definition attribute
-	ValuePeriod          *Period          `json:"valuePeriod" bson:"value_period"`                    // Specification of the definition attribute
-	ValueRelativeTime    *RelativeTime    `json:"valueRelativeTime" bson:"value_relative_time"`       // Specification of the definition attribute
-	ValueReference       *Reference       `json:"valueReference" bson:"value_reference"`              // Specification of the definition attribute
-	ValueExpression      *Expression      `json:"valueExpression" bson:"value_expression"`            // Specification of the definition attribute
-	ValueUri             *string          `json:"valueUri" bson:"value_uri"`                          // Specification of the definition attribute
-}
-
-func (r *EvidenceVariableDefinitionModifier) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.ShortTitleElement != nil {
+		r.ShortTitleElement.validateAll(path+".shortTitle", issues)
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.CiteAsElement != nil {
+		r.CiteAsElement.validateAll(path+".citeAs", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.ValueRelativeTime == nil {
-		return fmt.Errorf("field 'ValueRelativeTime' is required")
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
 	}
-	if r.ValueRelativeTime != nil {
-		if err := r.ValueRelativeTime.Validate(); err != nil {
-			return fmt.Errorf("ValueRelativeTime: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.Recorder {
+		item.validateAll(fmt.Sprintf("%s.recorder[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	if r.ValueExpression == nil {
-		return fmt.Errorf("field 'ValueExpression' is required")
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
 		}
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
+	}
+	if r.ActualElement != nil {
+		r.ActualElement.validateAll(path+".actual", issues)
+	}
+	if r.Definition != nil {
+		r.Definition.validateAll(path+".definition", issues)
+	}
+	for i, item := range r.DefinitionModifier {
+		item.validateAll(fmt.Sprintf("%s.definitionModifier[%d]", path, i), issues)
+	}
+	if r.Handling != nil {
+		r.Handling.validateAll(path+".handling", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Conditional != nil {
+		r.Conditional.validateAll(path+".conditional", issues)
+	}
+	for i, item := range r.Classifier {
+		item.validateAll(fmt.Sprintf("%s.classifier[%d]", path, i), issues)
+	}
+	for i, item := range r.DataStorage {
+		item.validateAll(fmt.Sprintf("%s.dataStorage[%d]", path, i), issues)
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Constraint {
+		item.validateAll(fmt.Sprintf("%s.constraint[%d]", path, i), issues)
+	}
+	for i, item := range r.MissingDataMeaning {
+		item.validateAll(fmt.Sprintf("%s.missingDataMeaning[%d]", path, i), issues)
+	}
+	for i, item := range r.UnacceptableDataHandling {
+		item.validateAll(fmt.Sprintf("%s.unacceptableDataHandling[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type EvidenceVariableCategory struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Name                 *string          `json:"name,omitempty" bson:"name,omitempty"`                                   // Description of the grouping
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // Definition of the grouping
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // Definition of the grouping
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // Definition of the grouping
-	ValueReference       *Reference       `json:"valueReference,omitempty" bson:"value_reference,omitempty"`              // Definition of the grouping
+func (r *EvidenceVariable) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("EvidenceVariable", &issues)
+	checkInvariants(r, "EvidenceVariable", &issues)
+	return issues
 }
 
-func (r *EvidenceVariableCategory) Validate() error {
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+var evidenceVariableInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *EvidenceVariable) invariants() []invariant {
+	return evidenceVariableInvariants
+}
+
+func (r EvidenceVariable) MarshalJSON() ([]byte, error) {
+	type alias EvidenceVariable
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EvidenceVariable) UnmarshalJSON(data []byte) error {
+	type alias EvidenceVariable
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", evidenceVariableVersionAlgorithmVariants); err != nil {
+		return err
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type EvidenceVariableDataStorage struct {
-	Id        *string                       `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Datatype  *CodeableConcept              `json:"datatype,omitempty" bson:"datatype,omitempty"`   // Type of data used to express value of the variable
-	Path      *string                       `json:"path,omitempty" bson:"path,omitempty"`           // Where to find the data element in the dataset
-	Delimiter *string                       `json:"delimiter,omitempty" bson:"delimiter,omitempty"` // Character(s) separating values in a string-based list
-	Component []EvidenceVariableDataStorage `json:"component,omitempty" bson:"component,omitempty"`
+func (r EvidenceVariable) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *EvidenceVariableDataStorage) Validate() error {
-	if r.Datatype != nil {
-		if err := r.Datatype.Validate(); err != nil {
-			return fmt.Errorf("Datatype: %w", err)
-		}
-	}
-	for i, item := range r.Component {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Component[%d]: %w", i, err)
-		}
+func (r *EvidenceVariable) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariable) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariable) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EvidenceVariable) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(evidenceVariableVersionAlgorithmVariants)
 	}
+	return "", nil
+}
+
+var evidenceVariableElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"citeAs":        "markdown",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *EvidenceVariable) elementTypes() map[string]string {
+	return evidenceVariableElementTypes
+}
+
+var evidenceVariableElementMetadata = []ElementMetadata{
+	{Path: "EvidenceVariable.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "EvidenceVariable.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "EvidenceVariable.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EvidenceVariable.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "EvidenceVariable.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "EvidenceVariable.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "EvidenceVariable.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EvidenceVariable.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "EvidenceVariable.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "EvidenceVariable.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "EvidenceVariable.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.shortTitle", Name: "shortTitle", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.citeAs", Name: "citeAs", Field: 24, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EvidenceVariable.status", Name: "status", Field: 26, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "EvidenceVariable.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "EvidenceVariable.experimental", Name: "experimental", Field: 28, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "EvidenceVariable.date", Name: "date", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "EvidenceVariable.author", Name: "author", Field: 32, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EvidenceVariable.publisher", Name: "publisher", Field: 33, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.contact", Name: "contact", Field: 35, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EvidenceVariable.recorder", Name: "recorder", Field: 36, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EvidenceVariable.editor", Name: "editor", Field: 37, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EvidenceVariable.reviewer", Name: "reviewer", Field: 38, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EvidenceVariable.endorser", Name: "endorser", Field: 39, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "EvidenceVariable.description", Name: "description", Field: 40, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EvidenceVariable.note", Name: "note", Field: 42, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "EvidenceVariable.useContext", Name: "useContext", Field: 43, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "EvidenceVariable.purpose", Name: "purpose", Field: 44, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EvidenceVariable.copyright", Name: "copyright", Field: 46, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "EvidenceVariable.copyrightLabel", Name: "copyrightLabel", Field: 48, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.approvalDate", Name: "approvalDate", Field: 50, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "EvidenceVariable.lastReviewDate", Name: "lastReviewDate", Field: 52, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "EvidenceVariable.effectivePeriod", Name: "effectivePeriod", Field: 54, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "EvidenceVariable.relatesTo", Name: "relatesTo", Field: 55, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EvidenceVariable.actual", Name: "actual", Field: 56, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "EvidenceVariable.definition", Name: "definition", Field: 58, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "EvidenceVariable.definitionModifier", Name: "definitionModifier", Field: 59, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EvidenceVariable.handling", Name: "handling", Field: 60, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.category", Name: "category", Field: 61, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EvidenceVariable.conditional", Name: "conditional", Field: 62, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "EvidenceVariable.classifier", Name: "classifier", Field: 63, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.dataStorage", Name: "dataStorage", Field: 64, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EvidenceVariable.timing", Name: "timing", Field: 65, Min: 0, Max: "1", Types: []string{"RelativeTime"}},
+	{Path: "EvidenceVariable.period", Name: "period", Field: 66, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "EvidenceVariable.constraint", Name: "constraint", Field: 67, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "EvidenceVariable.missingDataMeaning", Name: "missingDataMeaning", Field: 68, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.unacceptableDataHandling", Name: "unacceptableDataHandling", Field: 69, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *EvidenceVariable) ElementMetadata() []ElementMetadata {
+	return evidenceVariableElementMetadata
+}
+
+// EvidenceVariableVersionAlgorithm is the type of EvidenceVariable.versionAlgorithm[x]. It is implemented by the EvidenceVariableVersionAlgorithm* variant types.
+type EvidenceVariableVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceVariableVersionAlgorithm()
+}
+
+// EvidenceVariableVersionAlgorithmString is the string variant of EvidenceVariableVersionAlgorithm.
+type EvidenceVariableVersionAlgorithmString string
+
+func (v EvidenceVariableVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type EvidenceVariableConstraint struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
-	Conditional           *CodeableConcept `json:"conditional,omitempty" bson:"conditional,omitempty"`                        // Condition determining whether this constraint applies
-	MinimumQuantity       *Quantity        `json:"minimumQuantity,omitempty" bson:"minimum_quantity,omitempty"`               // The lowest permissible value of the variable
-	MaximumQuantity       *Quantity        `json:"maximumQuantity,omitempty" bson:"maximum_quantity,omitempty"`               // The highest permissible value of the variable
-	EarliestDateTime      *string          `json:"earliestDateTime,omitempty" bson:"earliest_date_time,omitempty"`            // The earliest permissible value of the variable
-	LatestDateTime        *string          `json:"latestDateTime,omitempty" bson:"latest_date_time,omitempty"`                // The latest permissible value of the variable
-	MinimumStringLength   *int             `json:"minimumStringLength,omitempty" bson:"minimum_string_length,omitempty"`      // The lowest number of characters allowed for a value of the variable
-	MaximumStringLength   *int             `json:"maximumStringLength,omitempty" bson:"maximum_string_length,omitempty"`      // The highest number of characters allowed for a value of the variable
-	Code                  *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                                      // Rule for acceptable data values
-	Expression            *Expression      `json:"expression,omitempty" bson:"expression,omitempty"`                          // Rule for acceptable data values, as an Expression
-	ExpectedValueSet      *Reference       `json:"expectedValueSet,omitempty" bson:"expected_value_set,omitempty"`            // List of anticipated values used to express value of the variable
-	ExpectedUnitsValueSet *Reference       `json:"expectedUnitsValueSet,omitempty" bson:"expected_units_value_set,omitempty"` // List of anticipated values used to express units for the value of the variable
-	AnyValueAllowed       *bool            `json:"anyValueAllowed,omitempty" bson:"any_value_allowed,omitempty"`              // Permissibility of unanticipated value used to express value of the variable
+func (v EvidenceVariableVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceVariableVersionAlgorithmString) FHIRType() string {
+	return "string"
 }
 
-func (r *EvidenceVariableConstraint) Validate() error {
-	if r.Conditional != nil {
-		if err := r.Conditional.Validate(); err != nil {
-			return fmt.Errorf("Conditional: %w", err)
-		}
+func (v EvidenceVariableVersionAlgorithmString) isEvidenceVariableVersionAlgorithm() {}
+
+// EvidenceVariableVersionAlgorithmCoding is the Coding variant of EvidenceVariableVersionAlgorithm.
+type EvidenceVariableVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v EvidenceVariableVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v EvidenceVariableVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v EvidenceVariableVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v EvidenceVariableVersionAlgorithmCoding) isEvidenceVariableVersionAlgorithm() {}
+
+var evidenceVariableVersionAlgorithmVariants = []EvidenceVariableVersionAlgorithm{
+	EvidenceVariableVersionAlgorithmString(""),
+	EvidenceVariableVersionAlgorithmCoding{},
+}
+
+func (r *EvidenceVariable) GetResourceType() string {
+	return "EvidenceVariable"
+}
+
+func (r *EvidenceVariable) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.MinimumQuantity != nil {
-		if err := r.MinimumQuantity.Validate(); err != nil {
-			return fmt.Errorf("MinimumQuantity: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *EvidenceVariable) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	if r.MaximumQuantity != nil {
-		if err := r.MaximumQuantity.Validate(); err != nil {
-			return fmt.Errorf("MaximumQuantity: %w", err)
+	r.Id = &id
+}
+
+func (r *EvidenceVariable) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *EvidenceVariable) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *EvidenceVariable) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *EvidenceVariable) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *EvidenceVariable) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*EvidenceVariable)(nil)
+
+type EvidenceVariableRelatesTo struct {
+	Id                *string                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                `json:"type" bson:"type"`                                                // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
+	Target            EvidenceVariableRelatesToTarget `json:"-" bson:"target,omitempty"`                                       // The artifact that is related to this EvidenceVariable
+	TargetElement     *Element                        `json:"-" bson:"target_element,omitempty"`                               // Extensions for target[x]
+
+	targetVariants []string // JSON properties of EvidenceVariable.relatesTo.target[x] when more than one was decoded
+}
+
+func (r *EvidenceVariableRelatesTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.Expression != nil {
-		if err := r.Expression.Validate(); err != nil {
-			return fmt.Errorf("Expression: %w", err)
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ExpectedValueSet != nil {
-		if err := r.ExpectedValueSet.Validate(); err != nil {
-			return fmt.Errorf("ExpectedValueSet: %w", err)
+	if len(r.targetVariants) > 1 {
+		return fmt.Errorf("field 'Target' must have a single type, got %v", r.targetVariants)
+	}
+	if r.Target == nil {
+		return fmt.Errorf("field 'Target' is required")
+	}
+	if r.Target != nil {
+		if err := r.Target.Validate(); err != nil {
+			return fmt.Errorf("Target: %w", err)
 		}
 	}
-	if r.ExpectedUnitsValueSet != nil {
-		if err := r.ExpectedUnitsValueSet.Validate(); err != nil {
-			return fmt.Errorf("ExpectedUnitsValueSet: %w", err)
+	if r.TargetElement != nil {
+		if err := r.TargetElement.Validate(); err != nil {
+			return fmt.Errorf("TargetElement: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r *EvidenceVariableRelatesTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.targetVariants) > 1 {
+		issues.add("structure", path+".target", fmt.Sprintf("field 'Target' must have a single type, got %v", r.targetVariants))
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target.ofType("+r.Target.FHIRType()+")", issues)
+	}
+	if r.TargetElement != nil {
+		r.TargetElement.validateAll(path+".target", issues)
+	}
+}
+
+var evidenceVariableRelatesToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceVariableRelatesTo) invariants() []invariant {
+	return evidenceVariableRelatesToInvariants
+}
+
+func (r EvidenceVariableRelatesTo) MarshalJSON() ([]byte, error) {
+	type alias EvidenceVariableRelatesTo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "target", r.Target, r.TargetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EvidenceVariableRelatesTo) UnmarshalJSON(data []byte) error {
+	type alias EvidenceVariableRelatesTo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Target, element, r.targetVariants, err = unmarshalChoice(fields, "target", evidenceVariableRelatesToTargetVariants); err != nil {
+		return err
+	}
+	r.TargetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetElement); err != nil {
+			return fmt.Errorf("_target: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r EvidenceVariableRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceVariableRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariableRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariableRelatesTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EvidenceVariableRelatesTo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Target":
+		return "target", choiceValues(evidenceVariableRelatesToTargetVariants)
+	}
+	return "", nil
+}
+
+var evidenceVariableRelatesToElementMetadata = []ElementMetadata{
+	{Path: "EvidenceVariable.relatesTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.relatesTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EvidenceVariable.relatesTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.relatesTo.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.relatesTo.target[x]", Name: "target", Field: 4, Min: 1, Max: "1", Types: []string{"uri", "Attachment", "canonical", "Reference", "markdown"}},
+}
+
+func (r *EvidenceVariableRelatesTo) ElementMetadata() []ElementMetadata {
+	return evidenceVariableRelatesToElementMetadata
+}
+
+// EvidenceVariableRelatesToTarget is the type of EvidenceVariable.relatesTo.target[x]. It is implemented by the EvidenceVariableRelatesToTarget* variant types.
+type EvidenceVariableRelatesToTarget interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceVariableRelatesToTarget()
+}
+
+// EvidenceVariableRelatesToTargetUri is the uri variant of EvidenceVariableRelatesToTarget.
+type EvidenceVariableRelatesToTargetUri string
+
+func (v EvidenceVariableRelatesToTargetUri) Validate() error {
+	return nil
+}
+
+func (v EvidenceVariableRelatesToTargetUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceVariableRelatesToTargetUri) FHIRType() string {
+	return "uri"
+}
+
+func (v EvidenceVariableRelatesToTargetUri) isEvidenceVariableRelatesToTarget() {}
+
+// EvidenceVariableRelatesToTargetAttachment is the Attachment variant of EvidenceVariableRelatesToTarget.
+type EvidenceVariableRelatesToTargetAttachment struct {
+	Attachment
+}
+
+func (v EvidenceVariableRelatesToTargetAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v EvidenceVariableRelatesToTargetAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v EvidenceVariableRelatesToTargetAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v EvidenceVariableRelatesToTargetAttachment) isEvidenceVariableRelatesToTarget() {}
+
+// EvidenceVariableRelatesToTargetCanonical is the canonical variant of EvidenceVariableRelatesToTarget.
+type EvidenceVariableRelatesToTargetCanonical string
+
+func (v EvidenceVariableRelatesToTargetCanonical) Validate() error {
+	return nil
+}
+
+func (v EvidenceVariableRelatesToTargetCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v EvidenceVariableRelatesToTargetCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v EvidenceVariableRelatesToTargetCanonical) isEvidenceVariableRelatesToTarget() {}
+
+// EvidenceVariableRelatesToTargetReference is the Reference variant of EvidenceVariableRelatesToTarget.
+type EvidenceVariableRelatesToTargetReference struct {
+	Reference
+}
+
+func (v EvidenceVariableRelatesToTargetReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v EvidenceVariableRelatesToTargetReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v EvidenceVariableRelatesToTargetReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v EvidenceVariableRelatesToTargetReference) isEvidenceVariableRelatesToTarget() {}
+
+// EvidenceVariableRelatesToTargetMarkdown is the markdown variant of EvidenceVariableRelatesToTarget.
+type EvidenceVariableRelatesToTargetMarkdown string
+
+func (v EvidenceVariableRelatesToTargetMarkdown) Validate() error {
+	return nil
+}
+
+func (v EvidenceVariableRelatesToTargetMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v EvidenceVariableRelatesToTargetMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v EvidenceVariableRelatesToTargetMarkdown) isEvidenceVariableRelatesToTarget() {}
+
+var evidenceVariableRelatesToTargetVariants = []EvidenceVariableRelatesToTarget{
+	EvidenceVariableRelatesToTargetUri(""),
+	EvidenceVariableRelatesToTargetAttachment{},
+	EvidenceVariableRelatesToTargetCanonical(""),
+	EvidenceVariableRelatesToTargetReference{},
+	EvidenceVariableRelatesToTargetMarkdown(""),
+}
+
+type EvidenceVariableDefinitionModifier struct {
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept                        `json:"code" bson:"code"`                                                // Attribute of the definition
+	Value             EvidenceVariableDefinitionModifierValue `json:"-" bson:"value,omitempty"`                                        // Specification of the definition attribute
+	ValueElement      *Element                                `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of EvidenceVariable.definitionModifier.value[x] when more than one was decoded
+}
+
+func (r *EvidenceVariableDefinitionModifier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceVariableDefinitionModifier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var evidenceVariableDefinitionModifierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceVariableDefinitionModifier) invariants() []invariant {
+	return evidenceVariableDefinitionModifierInvariants
+}
+
+func (r EvidenceVariableDefinitionModifier) MarshalJSON() ([]byte, error) {
+	type alias EvidenceVariableDefinitionModifier
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EvidenceVariableDefinitionModifier) UnmarshalJSON(data []byte) error {
+	type alias EvidenceVariableDefinitionModifier
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", evidenceVariableDefinitionModifierValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r EvidenceVariableDefinitionModifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceVariableDefinitionModifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariableDefinitionModifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariableDefinitionModifier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EvidenceVariableDefinitionModifier) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(evidenceVariableDefinitionModifierValueVariants)
+	}
+	return "", nil
+}
+
+var evidenceVariableDefinitionModifierElementMetadata = []ElementMetadata{
+	{Path: "EvidenceVariable.definitionModifier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.definitionModifier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EvidenceVariable.definitionModifier.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.definitionModifier.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.definitionModifier.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept", "boolean", "Quantity", "Range", "Period", "RelativeTime", "Reference", "Expression", "uri"}},
+}
+
+func (r *EvidenceVariableDefinitionModifier) ElementMetadata() []ElementMetadata {
+	return evidenceVariableDefinitionModifierElementMetadata
+}
+
+// EvidenceVariableDefinitionModifierValue is the type of EvidenceVariable.definitionModifier.value[x]. It is implemented by the EvidenceVariableDefinitionModifierValue* variant types.
+type EvidenceVariableDefinitionModifierValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceVariableDefinitionModifierValue()
+}
+
+// EvidenceVariableDefinitionModifierValueCodeableConcept is the CodeableConcept variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v EvidenceVariableDefinitionModifierValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v EvidenceVariableDefinitionModifierValueCodeableConcept) isEvidenceVariableDefinitionModifierValue() {
+}
+
+// EvidenceVariableDefinitionModifierValueBoolean is the boolean variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueBoolean bool
+
+func (v EvidenceVariableDefinitionModifierValueBoolean) Validate() error {
+	return nil
+}
+
+func (v EvidenceVariableDefinitionModifierValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v EvidenceVariableDefinitionModifierValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v EvidenceVariableDefinitionModifierValueBoolean) isEvidenceVariableDefinitionModifierValue() {}
+
+// EvidenceVariableDefinitionModifierValueQuantity is the Quantity variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueQuantity struct {
+	Quantity
+}
+
+func (v EvidenceVariableDefinitionModifierValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v EvidenceVariableDefinitionModifierValueQuantity) isEvidenceVariableDefinitionModifierValue() {
+}
+
+// EvidenceVariableDefinitionModifierValueRange is the Range variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueRange struct {
+	Range
+}
+
+func (v EvidenceVariableDefinitionModifierValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v EvidenceVariableDefinitionModifierValueRange) isEvidenceVariableDefinitionModifierValue() {}
+
+// EvidenceVariableDefinitionModifierValuePeriod is the Period variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValuePeriod struct {
+	Period
+}
+
+func (v EvidenceVariableDefinitionModifierValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v EvidenceVariableDefinitionModifierValuePeriod) isEvidenceVariableDefinitionModifierValue() {}
+
+// EvidenceVariableDefinitionModifierValueRelativeTime is the RelativeTime variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueRelativeTime struct {
+	RelativeTime
+}
+
+func (v EvidenceVariableDefinitionModifierValueRelativeTime) Validate() error {
+	return v.RelativeTime.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValueRelativeTime) validateAll(path string, issues *ValidationIssues) {
+	v.RelativeTime.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValueRelativeTime) FHIRType() string {
+	return "RelativeTime"
+}
+
+func (v EvidenceVariableDefinitionModifierValueRelativeTime) isEvidenceVariableDefinitionModifierValue() {
+}
+
+// EvidenceVariableDefinitionModifierValueReference is the Reference variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueReference struct {
+	Reference
+}
+
+func (v EvidenceVariableDefinitionModifierValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v EvidenceVariableDefinitionModifierValueReference) isEvidenceVariableDefinitionModifierValue() {
+}
+
+// EvidenceVariableDefinitionModifierValueExpression is the Expression variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueExpression struct {
+	Expression
+}
+
+func (v EvidenceVariableDefinitionModifierValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v EvidenceVariableDefinitionModifierValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v EvidenceVariableDefinitionModifierValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v EvidenceVariableDefinitionModifierValueExpression) isEvidenceVariableDefinitionModifierValue() {
+}
+
+// EvidenceVariableDefinitionModifierValueUri is the uri variant of EvidenceVariableDefinitionModifierValue.
+type EvidenceVariableDefinitionModifierValueUri string
+
+func (v EvidenceVariableDefinitionModifierValueUri) Validate() error {
+	return nil
+}
+
+func (v EvidenceVariableDefinitionModifierValueUri) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v EvidenceVariableDefinitionModifierValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v EvidenceVariableDefinitionModifierValueUri) isEvidenceVariableDefinitionModifierValue() {}
+
+var evidenceVariableDefinitionModifierValueVariants = []EvidenceVariableDefinitionModifierValue{
+	EvidenceVariableDefinitionModifierValueCodeableConcept{},
+	EvidenceVariableDefinitionModifierValueBoolean(false),
+	EvidenceVariableDefinitionModifierValueQuantity{},
+	EvidenceVariableDefinitionModifierValueRange{},
+	EvidenceVariableDefinitionModifierValuePeriod{},
+	EvidenceVariableDefinitionModifierValueRelativeTime{},
+	EvidenceVariableDefinitionModifierValueReference{},
+	EvidenceVariableDefinitionModifierValueExpression{},
+	EvidenceVariableDefinitionModifierValueUri(""),
+}
+
+type EvidenceVariableCategory struct {
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              *string                       `json:"name,omitempty" bson:"name,omitempty"`                            // Description of the grouping
+	NameElement       *Element                      `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Value             EvidenceVariableCategoryValue `json:"-" bson:"value,omitempty"`                                        // Definition of the grouping
+
+	valueVariants []string // JSON properties of EvidenceVariable.category.value[x] when more than one was decoded
+}
+
+func (r *EvidenceVariableCategory) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceVariableCategory) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+}
+
+var evidenceVariableCategoryInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceVariableCategory) invariants() []invariant {
+	return evidenceVariableCategoryInvariants
+}
+
+func (r EvidenceVariableCategory) MarshalJSON() ([]byte, error) {
+	type alias EvidenceVariableCategory
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EvidenceVariableCategory) UnmarshalJSON(data []byte) error {
+	type alias EvidenceVariableCategory
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Value, _, r.valueVariants, err = unmarshalChoice(fields, "value", evidenceVariableCategoryValueVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r EvidenceVariableCategory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceVariableCategory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariableCategory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariableCategory) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *EvidenceVariableCategory) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(evidenceVariableCategoryValueVariants)
+	}
+	return "", nil
+}
+
+var evidenceVariableCategoryElementMetadata = []ElementMetadata{
+	{Path: "EvidenceVariable.category.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.category.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EvidenceVariable.category.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.category.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.category.value[x]", Name: "value", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Quantity", "Range", "Reference"}},
+}
+
+func (r *EvidenceVariableCategory) ElementMetadata() []ElementMetadata {
+	return evidenceVariableCategoryElementMetadata
+}
+
+// EvidenceVariableCategoryValue is the type of EvidenceVariable.category.value[x]. It is implemented by the EvidenceVariableCategoryValue* variant types.
+type EvidenceVariableCategoryValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isEvidenceVariableCategoryValue()
+}
+
+// EvidenceVariableCategoryValueCodeableConcept is the CodeableConcept variant of EvidenceVariableCategoryValue.
+type EvidenceVariableCategoryValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v EvidenceVariableCategoryValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v EvidenceVariableCategoryValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v EvidenceVariableCategoryValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v EvidenceVariableCategoryValueCodeableConcept) isEvidenceVariableCategoryValue() {}
+
+// EvidenceVariableCategoryValueQuantity is the Quantity variant of EvidenceVariableCategoryValue.
+type EvidenceVariableCategoryValueQuantity struct {
+	Quantity
+}
+
+func (v EvidenceVariableCategoryValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v EvidenceVariableCategoryValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v EvidenceVariableCategoryValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v EvidenceVariableCategoryValueQuantity) isEvidenceVariableCategoryValue() {}
+
+// EvidenceVariableCategoryValueRange is the Range variant of EvidenceVariableCategoryValue.
+type EvidenceVariableCategoryValueRange struct {
+	Range
+}
+
+func (v EvidenceVariableCategoryValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v EvidenceVariableCategoryValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v EvidenceVariableCategoryValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v EvidenceVariableCategoryValueRange) isEvidenceVariableCategoryValue() {}
+
+// EvidenceVariableCategoryValueReference is the Reference variant of EvidenceVariableCategoryValue.
+type EvidenceVariableCategoryValueReference struct {
+	Reference
+}
+
+func (v EvidenceVariableCategoryValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v EvidenceVariableCategoryValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v EvidenceVariableCategoryValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v EvidenceVariableCategoryValueReference) isEvidenceVariableCategoryValue() {}
+
+var evidenceVariableCategoryValueVariants = []EvidenceVariableCategoryValue{
+	EvidenceVariableCategoryValueCodeableConcept{},
+	EvidenceVariableCategoryValueQuantity{},
+	EvidenceVariableCategoryValueRange{},
+	EvidenceVariableCategoryValueReference{},
+}
+
+type EvidenceVariableDataStorage struct {
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Datatype          *CodeableConcept              `json:"datatype,omitempty" bson:"datatype,omitempty"`                    // Type of data used to express value of the variable
+	Path              *string                       `json:"path,omitempty" bson:"path,omitempty"`                            // Where to find the data element in the dataset
+	PathElement       *Element                      `json:"_path,omitempty" bson:"path_element,omitempty"`                   // Extensions for path
+	Delimiter         *string                       `json:"delimiter,omitempty" bson:"delimiter,omitempty"`                  // Character(s) separating values in a string-based list
+	DelimiterElement  *Element                      `json:"_delimiter,omitempty" bson:"delimiter_element,omitempty"`         // Extensions for delimiter
+	Component         []EvidenceVariableDataStorage `json:"component,omitempty" bson:"component,omitempty"`
+}
+
+func (r *EvidenceVariableDataStorage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Datatype != nil {
+		if err := r.Datatype.Validate(); err != nil {
+			return fmt.Errorf("Datatype: %w", err)
+		}
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
+	if r.DelimiterElement != nil {
+		if err := r.DelimiterElement.Validate(); err != nil {
+			return fmt.Errorf("DelimiterElement: %w", err)
+		}
+	}
+	for i, item := range r.Component {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Component[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceVariableDataStorage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Datatype != nil {
+		r.Datatype.validateAll(path+".datatype", issues)
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.DelimiterElement != nil {
+		r.DelimiterElement.validateAll(path+".delimiter", issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+var evidenceVariableDataStorageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceVariableDataStorage) invariants() []invariant {
+	return evidenceVariableDataStorageInvariants
+}
+
+func (r EvidenceVariableDataStorage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceVariableDataStorage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariableDataStorage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariableDataStorage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceVariableDataStorageElementMetadata = []ElementMetadata{
+	{Path: "EvidenceVariable.dataStorage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.dataStorage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EvidenceVariable.dataStorage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.dataStorage.datatype", Name: "datatype", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.dataStorage.path", Name: "path", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.dataStorage.delimiter", Name: "delimiter", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.dataStorage.component", Name: "component", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *EvidenceVariableDataStorage) ElementMetadata() []ElementMetadata {
+	return evidenceVariableDataStorageElementMetadata
+}
+
+type EvidenceVariableConstraint struct {
+	Id                         *string          `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                  []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension          []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	Conditional                *CodeableConcept `json:"conditional,omitempty" bson:"conditional,omitempty"`                            // Condition determining whether this constraint applies
+	MinimumQuantity            *Quantity        `json:"minimumQuantity,omitempty" bson:"minimum_quantity,omitempty"`                   // The lowest permissible value of the variable
+	MaximumQuantity            *Quantity        `json:"maximumQuantity,omitempty" bson:"maximum_quantity,omitempty"`                   // The highest permissible value of the variable
+	EarliestDateTime           *DateTime        `json:"earliestDateTime,omitempty" bson:"earliest_date_time,omitempty"`                // The earliest permissible value of the variable
+	EarliestDateTimeElement    *Element         `json:"_earliestDateTime,omitempty" bson:"earliest_date_time_element,omitempty"`       // Extensions for earliestDateTime
+	LatestDateTime             *DateTime        `json:"latestDateTime,omitempty" bson:"latest_date_time,omitempty"`                    // The latest permissible value of the variable
+	LatestDateTimeElement      *Element         `json:"_latestDateTime,omitempty" bson:"latest_date_time_element,omitempty"`           // Extensions for latestDateTime
+	MinimumStringLength        *int             `json:"minimumStringLength,omitempty" bson:"minimum_string_length,omitempty"`          // The lowest number of characters allowed for a value of the variable
+	MinimumStringLengthElement *Element         `json:"_minimumStringLength,omitempty" bson:"minimum_string_length_element,omitempty"` // Extensions for minimumStringLength
+	MaximumStringLength        *int             `json:"maximumStringLength,omitempty" bson:"maximum_string_length,omitempty"`          // The highest number of characters allowed for a value of the variable
+	MaximumStringLengthElement *Element         `json:"_maximumStringLength,omitempty" bson:"maximum_string_length_element,omitempty"` // Extensions for maximumStringLength
+	Code                       *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                                          // Rule for acceptable data values
+	Expression                 *Expression      `json:"expression,omitempty" bson:"expression,omitempty"`                              // Rule for acceptable data values, as an Expression
+	ExpectedValueSet           *Reference       `json:"expectedValueSet,omitempty" bson:"expected_value_set,omitempty"`                // List of anticipated values used to express value of the variable
+	ExpectedUnitsValueSet      *Reference       `json:"expectedUnitsValueSet,omitempty" bson:"expected_units_value_set,omitempty"`     // List of anticipated values used to express units for the value of the variable
+	AnyValueAllowed            *bool            `json:"anyValueAllowed,omitempty" bson:"any_value_allowed,omitempty"`                  // Permissibility of unanticipated value used to express value of the variable
+	AnyValueAllowedElement     *Element         `json:"_anyValueAllowed,omitempty" bson:"any_value_allowed_element,omitempty"`         // Extensions for anyValueAllowed
+}
+
+func (r *EvidenceVariableConstraint) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Conditional != nil {
+		if err := r.Conditional.Validate(); err != nil {
+			return fmt.Errorf("Conditional: %w", err)
+		}
+	}
+	if r.MinimumQuantity != nil {
+		if err := r.MinimumQuantity.Validate(); err != nil {
+			return fmt.Errorf("MinimumQuantity: %w", err)
+		}
+	}
+	if r.MaximumQuantity != nil {
+		if err := r.MaximumQuantity.Validate(); err != nil {
+			return fmt.Errorf("MaximumQuantity: %w", err)
+		}
+	}
+	if r.EarliestDateTime != nil {
+		if err := r.EarliestDateTime.Validate(); err != nil {
+			return fmt.Errorf("EarliestDateTime: %w", err)
+		}
+	}
+	if r.EarliestDateTimeElement != nil {
+		if err := r.EarliestDateTimeElement.Validate(); err != nil {
+			return fmt.Errorf("EarliestDateTimeElement: %w", err)
+		}
+	}
+	if r.LatestDateTime != nil {
+		if err := r.LatestDateTime.Validate(); err != nil {
+			return fmt.Errorf("LatestDateTime: %w", err)
+		}
+	}
+	if r.LatestDateTimeElement != nil {
+		if err := r.LatestDateTimeElement.Validate(); err != nil {
+			return fmt.Errorf("LatestDateTimeElement: %w", err)
+		}
+	}
+	if r.MinimumStringLengthElement != nil {
+		if err := r.MinimumStringLengthElement.Validate(); err != nil {
+			return fmt.Errorf("MinimumStringLengthElement: %w", err)
+		}
+	}
+	if r.MaximumStringLengthElement != nil {
+		if err := r.MaximumStringLengthElement.Validate(); err != nil {
+			return fmt.Errorf("MaximumStringLengthElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.Expression != nil {
+		if err := r.Expression.Validate(); err != nil {
+			return fmt.Errorf("Expression: %w", err)
+		}
+	}
+	if r.ExpectedValueSet != nil {
+		if err := r.ExpectedValueSet.Validate(); err != nil {
+			return fmt.Errorf("ExpectedValueSet: %w", err)
+		}
+	}
+	if r.ExpectedUnitsValueSet != nil {
+		if err := r.ExpectedUnitsValueSet.Validate(); err != nil {
+			return fmt.Errorf("ExpectedUnitsValueSet: %w", err)
+		}
+	}
+	if r.AnyValueAllowedElement != nil {
+		if err := r.AnyValueAllowedElement.Validate(); err != nil {
+			return fmt.Errorf("AnyValueAllowedElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EvidenceVariableConstraint) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Conditional != nil {
+		r.Conditional.validateAll(path+".conditional", issues)
+	}
+	if r.MinimumQuantity != nil {
+		r.MinimumQuantity.validateAll(path+".minimumQuantity", issues)
+	}
+	if r.MaximumQuantity != nil {
+		r.MaximumQuantity.validateAll(path+".maximumQuantity", issues)
+	}
+	if r.EarliestDateTime != nil {
+		if err := r.EarliestDateTime.Validate(); err != nil {
+			issues.add("value", path+".earliestDateTime", err.Error())
+		}
+	}
+	if r.EarliestDateTimeElement != nil {
+		r.EarliestDateTimeElement.validateAll(path+".earliestDateTime", issues)
+	}
+	if r.LatestDateTime != nil {
+		if err := r.LatestDateTime.Validate(); err != nil {
+			issues.add("value", path+".latestDateTime", err.Error())
+		}
+	}
+	if r.LatestDateTimeElement != nil {
+		r.LatestDateTimeElement.validateAll(path+".latestDateTime", issues)
+	}
+	if r.MinimumStringLengthElement != nil {
+		r.MinimumStringLengthElement.validateAll(path+".minimumStringLength", issues)
+	}
+	if r.MaximumStringLengthElement != nil {
+		r.MaximumStringLengthElement.validateAll(path+".maximumStringLength", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+	if r.ExpectedValueSet != nil {
+		r.ExpectedValueSet.validateAll(path+".expectedValueSet", issues)
+	}
+	if r.ExpectedUnitsValueSet != nil {
+		r.ExpectedUnitsValueSet.validateAll(path+".expectedUnitsValueSet", issues)
+	}
+	if r.AnyValueAllowedElement != nil {
+		r.AnyValueAllowedElement.validateAll(path+".anyValueAllowed", issues)
+	}
+}
+
+var evidenceVariableConstraintInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *EvidenceVariableConstraint) invariants() []invariant {
+	return evidenceVariableConstraintInvariants
+}
+
+func (r EvidenceVariableConstraint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *EvidenceVariableConstraint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r EvidenceVariableConstraint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *EvidenceVariableConstraint) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var evidenceVariableConstraintElementTypes = map[string]string{
+	"minimumStringLength": "unsignedInt",
+	"maximumStringLength": "positiveInt",
+}
+
+func (r *EvidenceVariableConstraint) elementTypes() map[string]string {
+	return evidenceVariableConstraintElementTypes
+}
+
+var evidenceVariableConstraintElementMetadata = []ElementMetadata{
+	{Path: "EvidenceVariable.constraint.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "EvidenceVariable.constraint.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "EvidenceVariable.constraint.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "EvidenceVariable.constraint.conditional", Name: "conditional", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.constraint.minimumQuantity", Name: "minimumQuantity", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "EvidenceVariable.constraint.maximumQuantity", Name: "maximumQuantity", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "EvidenceVariable.constraint.earliestDateTime", Name: "earliestDateTime", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "EvidenceVariable.constraint.latestDateTime", Name: "latestDateTime", Field: 8, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "EvidenceVariable.constraint.minimumStringLength", Name: "minimumStringLength", Field: 10, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "EvidenceVariable.constraint.maximumStringLength", Name: "maximumStringLength", Field: 12, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "EvidenceVariable.constraint.code", Name: "code", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "EvidenceVariable.constraint.expression", Name: "expression", Field: 15, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "EvidenceVariable.constraint.expectedValueSet", Name: "expectedValueSet", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EvidenceVariable.constraint.expectedUnitsValueSet", Name: "expectedUnitsValueSet", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "EvidenceVariable.constraint.anyValueAllowed", Name: "anyValueAllowed", Field: 18, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *EvidenceVariableConstraint) ElementMetadata() []ElementMetadata {
+	return evidenceVariableConstraintElementMetadata
+}
@@ -2,39 +2,58 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A walkthrough of a workflow showing the interaction between systems and the instances shared, possibly including the evolution of instances over time.
 type ExampleScenario struct {
-	ResourceType           string                    `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                   `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                   `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage         `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                   `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this example scenario, represented as a URI (globally unique)
-	Identifier             []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the example scenario
-	Version                *string                   `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the example scenario
-	VersionAlgorithmString *string                   `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                   `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                   `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this example scenario (computer friendly)
-	Title                  *string                   `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this example scenario (human friendly)
-	Status                 string                    `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                     `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                   `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                   `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail           `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                   `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the ExampleScenario
-	UseContext             []UsageContext            `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept         `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the example scenario (if applicable)
-	Purpose                *string                   `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // The purpose of the example, e.g. to illustrate a scenario
-	Copyright              *string                   `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                   `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Actor                  []ExampleScenarioActor    `json:"actor,omitempty" bson:"actor,omitempty"`                                     // Individual involved in exchange
-	Instance               []ExampleScenarioInstance `json:"instance,omitempty" bson:"instance,omitempty"`                               // Data used in the scenario
-	Process                []ExampleScenarioProcess  `json:"process,omitempty" bson:"process,omitempty"`                                 // Major process within scenario
+	ResourceType            string                          `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                         `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     *string                         `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this example scenario, represented as a URI (globally unique)
+	UrlElement              *Element                        `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the example scenario
+	Version                 *string                         `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the example scenario
+	VersionElement          *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        ExampleScenarioVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                        `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    *string                         `json:"name,omitempty" bson:"name,omitempty"`                               // Name for this example scenario (computer friendly)
+	NameElement             *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                         `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this example scenario (human friendly)
+	TitleElement            *Element                        `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus               `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                        `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                       `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                        `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                        `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                         `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the ExampleScenario
+	DescriptionElement      *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept               `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the example scenario (if applicable)
+	Purpose                 *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // The purpose of the example, e.g. to illustrate a scenario
+	PurposeElement          *Element                        `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                        `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	Actor                   []ExampleScenarioActor          `json:"actor,omitempty" bson:"actor,omitempty"`                             // Individual involved in exchange
+	Instance                []ExampleScenarioInstance       `json:"instance,omitempty" bson:"instance,omitempty"`                       // Data used in the scenario
+	Process                 []ExampleScenarioProcess        `json:"process,omitempty" bson:"process,omitempty"`                         // Major process within scenario
+
+	versionAlgorithmVariants []string // JSON properties of ExampleScenario.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *ExampleScenario) Validate() error {
@@ -46,30 +65,118 @@ func (r *ExampleScenario) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -80,6 +187,21 @@ func (r *ExampleScenario) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
 	for i, item := range r.Actor {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Actor[%d]: %w", i, err)
@@ -95,209 +217,1351 @@ func (r *ExampleScenario) Validate() error {
 			return fmt.Errorf("Process[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ExampleScenarioActor struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Key         string  `json:"key" bson:"key"`                                     // ID or acronym of the actor
-	Type        *string `json:"type,omitempty" bson:"type,omitempty"`               // person | system | collective | other
-	Title       string  `json:"title" bson:"title"`                                 // Label for actor when rendering
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Details about actor
-	Definition  *string `json:"definition,omitempty" bson:"definition,omitempty"`   // Formal definition of actor
+	return validateInvariants(r, "ExampleScenario")
 }
 
-func (r *ExampleScenarioActor) Validate() error {
-	var emptyString string
-	if r.Key == emptyString {
-		return fmt.Errorf("field 'Key' is required")
+func (r *ExampleScenario) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ExampleScenario" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ExampleScenario', got '%s'", r.ResourceType))
 	}
-	if r.Title == emptyString {
-		return fmt.Errorf("field 'Title' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	for i, item := range r.Actor {
+		item.validateAll(fmt.Sprintf("%s.actor[%d]", path, i), issues)
+	}
+	for i, item := range r.Instance {
+		item.validateAll(fmt.Sprintf("%s.instance[%d]", path, i), issues)
+	}
+	for i, item := range r.Process {
+		item.validateAll(fmt.Sprintf("%s.process[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ExampleScenarioInstanceVersion struct {
-	Id          *string    `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Key         string     `json:"key" bson:"key"`                                     // ID or acronym of the version
-	Title       string     `json:"title" bson:"title"`                                 // Label for instance version
-	Description *string    `json:"description,omitempty" bson:"description,omitempty"` // Details about version
-	Content     *Reference `json:"content,omitempty" bson:"content,omitempty"`         // Example instance version data
+func (r *ExampleScenario) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ExampleScenario", &issues)
+	checkInvariants(r, "ExampleScenario", &issues)
+	return issues
 }
 
-func (r *ExampleScenarioInstanceVersion) Validate() error {
-	var emptyString string
-	if r.Key == emptyString {
-		return fmt.Errorf("field 'Key' is required")
+var exampleScenarioInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ExampleScenario) invariants() []invariant {
+	return exampleScenarioInvariants
+}
+
+func (r ExampleScenario) MarshalJSON() ([]byte, error) {
+	type alias ExampleScenario
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.Title == emptyString {
-		return fmt.Errorf("field 'Title' is required")
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	if r.Content != nil {
-		if err := r.Content.Validate(); err != nil {
-			return fmt.Errorf("Content: %w", err)
+	return data, nil
+}
+
+func (r *ExampleScenario) UnmarshalJSON(data []byte) error {
+	type alias ExampleScenario
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", exampleScenarioVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type ExampleScenarioProcessStep struct {
-	Id          *string                                 `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Number      *string                                 `json:"number,omitempty" bson:"number,omitempty"`           // Sequential number of the step
-	Process     *ExampleScenarioProcess                 `json:"process,omitempty" bson:"process,omitempty"`         // Step is nested process
-	Workflow    *string                                 `json:"workflow,omitempty" bson:"workflow,omitempty"`       // Step is nested workflow
-	Operation   *ExampleScenarioProcessStepOperation    `json:"operation,omitempty" bson:"operation,omitempty"`     // Step is simple action
-	Alternative []ExampleScenarioProcessStepAlternative `json:"alternative,omitempty" bson:"alternative,omitempty"` // Alternate non-typical step action
-	Pause       *bool                                   `json:"pause,omitempty" bson:"pause,omitempty"`             // Pause in the flow?
+func (r ExampleScenario) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ExampleScenarioProcessStep) Validate() error {
-	if r.Process != nil {
-		if err := r.Process.Validate(); err != nil {
-			return fmt.Errorf("Process: %w", err)
-		}
-	}
-	if r.Operation != nil {
-		if err := r.Operation.Validate(); err != nil {
-			return fmt.Errorf("Operation: %w", err)
-		}
-	}
-	for i, item := range r.Alternative {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Alternative[%d]: %w", i, err)
-		}
+func (r *ExampleScenario) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenario) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenario) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExampleScenario) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(exampleScenarioVersionAlgorithmVariants)
 	}
+	return "", nil
+}
+
+var exampleScenarioElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *ExampleScenario) elementTypes() map[string]string {
+	return exampleScenarioElementTypes
+}
+
+var exampleScenarioElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ExampleScenario.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ExampleScenario.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExampleScenario.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ExampleScenario.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ExampleScenario.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ExampleScenario.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ExampleScenario.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExampleScenario.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ExampleScenario.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExampleScenario.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ExampleScenario.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ExampleScenario.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ExampleScenario.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ExampleScenario.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ExampleScenario.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExampleScenario.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.actor", Name: "actor", Field: 41, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.instance", Name: "instance", Field: 42, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.process", Name: "process", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExampleScenario) ElementMetadata() []ElementMetadata {
+	return exampleScenarioElementMetadata
+}
+
+// ExampleScenarioVersionAlgorithm is the type of ExampleScenario.versionAlgorithm[x]. It is implemented by the ExampleScenarioVersionAlgorithm* variant types.
+type ExampleScenarioVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExampleScenarioVersionAlgorithm()
+}
+
+// ExampleScenarioVersionAlgorithmString is the string variant of ExampleScenarioVersionAlgorithm.
+type ExampleScenarioVersionAlgorithmString string
+
+func (v ExampleScenarioVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type ExampleScenarioProcessStepAlternative struct {
-	Id          *string                      `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Title       string                       `json:"title" bson:"title"`                                 // Label for alternative
-	Description *string                      `json:"description,omitempty" bson:"description,omitempty"` // Human-readable description of option
-	Step        []ExampleScenarioProcessStep `json:"step,omitempty" bson:"step,omitempty"`               // Alternative action(s)
+func (v ExampleScenarioVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExampleScenarioVersionAlgorithmString) FHIRType() string {
+	return "string"
 }
 
-func (r *ExampleScenarioProcessStepAlternative) Validate() error {
-	var emptyString string
-	if r.Title == emptyString {
-		return fmt.Errorf("field 'Title' is required")
+func (v ExampleScenarioVersionAlgorithmString) isExampleScenarioVersionAlgorithm() {}
+
+// ExampleScenarioVersionAlgorithmCoding is the Coding variant of ExampleScenarioVersionAlgorithm.
+type ExampleScenarioVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ExampleScenarioVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ExampleScenarioVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ExampleScenarioVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ExampleScenarioVersionAlgorithmCoding) isExampleScenarioVersionAlgorithm() {}
+
+var exampleScenarioVersionAlgorithmVariants = []ExampleScenarioVersionAlgorithm{
+	ExampleScenarioVersionAlgorithmString(""),
+	ExampleScenarioVersionAlgorithmCoding{},
+}
+
+func (r *ExampleScenario) GetResourceType() string {
+	return "ExampleScenario"
+}
+
+func (r *ExampleScenario) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	for i, item := range r.Step {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Step[%d]: %w", i, err)
-		}
+	return *r.Id
+}
+
+func (r *ExampleScenario) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
 }
 
-type ExampleScenarioInstance struct {
-	Id                        *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                                 // Unique id for inter-element referencing
-	Key                       string                                     `json:"key" bson:"key"`                                                                   // ID or acronym of the instance
-	StructureType             *Coding                                    `json:"structureType" bson:"structure_type"`                                              // Data structure for example
-	StructureVersion          *string                                    `json:"structureVersion,omitempty" bson:"structure_version,omitempty"`                    // E.g. 4.0.1
-	StructureProfileCanonical *string                                    `json:"structureProfileCanonical,omitempty" bson:"structure_profile_canonical,omitempty"` // Rules instance adheres to
-	StructureProfileUri       *string                                    `json:"structureProfileUri,omitempty" bson:"structure_profile_uri,omitempty"`             // Rules instance adheres to
-	Title                     string                                     `json:"title" bson:"title"`                                                               // Label for instance
-	Description               *string                                    `json:"description,omitempty" bson:"description,omitempty"`                               // Human-friendly description of the instance
-	Content                   *Reference                                 `json:"content,omitempty" bson:"content,omitempty"`                                       // Example instance data
-	Version                   []ExampleScenarioInstanceVersion           `json:"version,omitempty" bson:"version,omitempty"`                                       // Snapshot of instance that changes
-	ContainedInstance         []ExampleScenarioInstanceContainedInstance `json:"containedInstance,omitempty" bson:"contained_instance,omitempty"`                  // Resources contained in the instance
+func (r *ExampleScenario) GetMeta() *Meta {
+	return r.Meta
 }
 
-func (r *ExampleScenarioInstance) Validate() error {
+func (r *ExampleScenario) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ExampleScenario) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ExampleScenario) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ExampleScenario) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ExampleScenario)(nil)
+
+type ExampleScenarioActor struct {
+	Id                 *string                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Key                string                    `json:"key" bson:"key"`                                                  // ID or acronym of the actor
+	KeyElement         *Element                  `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
+	Type               *ActorDefinitionActorType `json:"type,omitempty" bson:"type,omitempty"`                            // person | system | collective | other
+	TypeElement        *Element                  `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Title              string                    `json:"title" bson:"title"`                                              // Label for actor when rendering
+	TitleElement       *Element                  `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Description        *string                   `json:"description,omitempty" bson:"description,omitempty"`              // Details about actor
+	DescriptionElement *Element                  `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Definition         *string                   `json:"definition,omitempty" bson:"definition,omitempty"`                // Formal definition of actor
+	DefinitionElement  *Element                  `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+}
+
+func (r *ExampleScenarioActor) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Key == emptyString {
 		return fmt.Errorf("field 'Key' is required")
 	}
-	if r.StructureType == nil {
-		return fmt.Errorf("field 'StructureType' is required")
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
 	}
-	if r.StructureType != nil {
-		if err := r.StructureType.Validate(); err != nil {
-			return fmt.Errorf("StructureType: %w", err)
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
 		}
 	}
 	if r.Title == emptyString {
 		return fmt.Errorf("field 'Title' is required")
 	}
-	if r.Content != nil {
-		if err := r.Content.Validate(); err != nil {
-			return fmt.Errorf("Content: %w", err)
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
 		}
 	}
-	for i, item := range r.Version {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Version[%d]: %w", i, err)
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
 		}
 	}
-	for i, item := range r.ContainedInstance {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ContainedInstance[%d]: %w", i, err)
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
 		}
 	}
 	return nil
 }
 
-type ExampleScenarioInstanceContainedInstance struct {
-	Id                *string `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	InstanceReference string  `json:"instanceReference" bson:"instance_reference"`                   // Key of contained instance
-	VersionReference  *string `json:"versionReference,omitempty" bson:"version_reference,omitempty"` // Key of contained instance version
-}
-
-func (r *ExampleScenarioInstanceContainedInstance) Validate() error {
+func (r *ExampleScenarioActor) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
 	var emptyString string
-	if r.InstanceReference == emptyString {
-		return fmt.Errorf("field 'InstanceReference' is required")
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
 	}
-	return nil
 }
 
-type ExampleScenarioProcess struct {
-	Id             *string                      `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Title          string                       `json:"title" bson:"title"`                                        // Label for procss
-	Description    *string                      `json:"description,omitempty" bson:"description,omitempty"`        // Human-friendly description of the process
-	PreConditions  *string                      `json:"preConditions,omitempty" bson:"pre_conditions,omitempty"`   // Status before process starts
-	PostConditions *string                      `json:"postConditions,omitempty" bson:"post_conditions,omitempty"` // Status after successful completion
-	Step           []ExampleScenarioProcessStep `json:"step,omitempty" bson:"step,omitempty"`                      // Event within of the process
+var exampleScenarioActorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ExampleScenarioProcess) Validate() error {
-	var emptyString string
-	if r.Title == emptyString {
-		return fmt.Errorf("field 'Title' is required")
-	}
-	for i, item := range r.Step {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Step[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *ExampleScenarioActor) invariants() []invariant {
+	return exampleScenarioActorInvariants
 }
 
-type ExampleScenarioProcessStepOperation struct {
-	Id              *string                                   `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	Type            *Coding                                   `json:"type,omitempty" bson:"type,omitempty"`                        // Kind of action
-	Title           string                                    `json:"title" bson:"title"`                                          // Label for step
-	Initiator       *string                                   `json:"initiator,omitempty" bson:"initiator,omitempty"`              // Who starts the operation
-	Receiver        *string                                   `json:"receiver,omitempty" bson:"receiver,omitempty"`                // Who receives the operation
-	Description     *string                                   `json:"description,omitempty" bson:"description,omitempty"`          // Human-friendly description of the operation
-	InitiatorActive *bool                                     `json:"initiatorActive,omitempty" bson:"initiator_active,omitempty"` // Initiator stays active?
-	ReceiverActive  *bool                                     `json:"receiverActive,omitempty" bson:"receiver_active,omitempty"`   // Receiver stays active?
-	Request         *ExampleScenarioInstanceContainedInstance `json:"request,omitempty" bson:"request,omitempty"`                  // Instance transmitted on invocation
-	Response        *ExampleScenarioInstanceContainedInstance `json:"response,omitempty" bson:"response,omitempty"`                // Instance transmitted on invocation response
+func (r ExampleScenarioActor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ExampleScenarioProcessStepOperation) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+func (r *ExampleScenarioActor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioActor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioActor) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioActorElementTypes = map[string]string{
+	"description": "markdown",
+	"definition":  "canonical",
+}
+
+func (r *ExampleScenarioActor) elementTypes() map[string]string {
+	return exampleScenarioActorElementTypes
+}
+
+var exampleScenarioActorElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.actor.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.actor.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.actor.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.actor.key", Name: "key", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.actor.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExampleScenario.actor.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/actordefinition-actor-type"}},
+	{Path: "ExampleScenario.actor.title", Name: "title", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.actor.description", Name: "description", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.actor.definition", Name: "definition", Field: 11, Min: 0, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *ExampleScenarioActor) ElementMetadata() []ElementMetadata {
+	return exampleScenarioActorElementMetadata
+}
+
+type ExampleScenarioInstance struct {
+	Id                      *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Key                     string                                     `json:"key" bson:"key"`                                                         // ID or acronym of the instance
+	KeyElement              *Element                                   `json:"_key,omitempty" bson:"key_element,omitempty"`                            // Extensions for key
+	StructureType           *Coding                                    `json:"structureType" bson:"structure_type"`                                    // Data structure for example
+	StructureVersion        *string                                    `json:"structureVersion,omitempty" bson:"structure_version,omitempty"`          // E.g. 4.0.1
+	StructureVersionElement *Element                                   `json:"_structureVersion,omitempty" bson:"structure_version_element,omitempty"` // Extensions for structureVersion
+	StructureProfile        ExampleScenarioInstanceStructureProfile    `json:"-" bson:"structure_profile,omitempty"`                                   // Rules instance adheres to
+	StructureProfileElement *Element                                   `json:"-" bson:"structure_profile_element,omitempty"`                           // Extensions for structureProfile[x]
+	Title                   string                                     `json:"title" bson:"title"`                                                     // Label for instance
+	TitleElement            *Element                                   `json:"_title,omitempty" bson:"title_element,omitempty"`                        // Extensions for title
+	Description             *string                                    `json:"description,omitempty" bson:"description,omitempty"`                     // Human-friendly description of the instance
+	DescriptionElement      *Element                                   `json:"_description,omitempty" bson:"description_element,omitempty"`            // Extensions for description
+	Content                 *Reference                                 `json:"content,omitempty" bson:"content,omitempty"`                             // Example instance data
+	Version                 []ExampleScenarioInstanceVersion           `json:"version,omitempty" bson:"version,omitempty"`                             // Snapshot of instance that changes
+	ContainedInstance       []ExampleScenarioInstanceContainedInstance `json:"containedInstance,omitempty" bson:"contained_instance,omitempty"`        // Resources contained in the instance
+
+	structureProfileVariants []string // JSON properties of ExampleScenario.instance.structureProfile[x] when more than one was decoded
+}
+
+func (r *ExampleScenarioInstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		return fmt.Errorf("field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
+	}
+	if r.StructureType == nil {
+		return fmt.Errorf("field 'StructureType' is required")
+	}
+	if r.StructureType != nil {
+		if err := r.StructureType.Validate(); err != nil {
+			return fmt.Errorf("StructureType: %w", err)
+		}
+	}
+	if r.StructureVersionElement != nil {
+		if err := r.StructureVersionElement.Validate(); err != nil {
+			return fmt.Errorf("StructureVersionElement: %w", err)
+		}
+	}
+	if len(r.structureProfileVariants) > 1 {
+		return fmt.Errorf("field 'StructureProfile' must have a single type, got %v", r.structureProfileVariants)
+	}
+	if r.StructureProfile != nil {
+		if err := r.StructureProfile.Validate(); err != nil {
+			return fmt.Errorf("StructureProfile: %w", err)
+		}
+	}
+	if r.StructureProfileElement != nil {
+		if err := r.StructureProfileElement.Validate(); err != nil {
+			return fmt.Errorf("StructureProfileElement: %w", err)
+		}
+	}
+	if r.Title == emptyString {
+		return fmt.Errorf("field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
+		}
+	}
+	for i, item := range r.Version {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Version[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ContainedInstance {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ContainedInstance[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExampleScenarioInstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+	if r.StructureType == nil {
+		issues.add("required", path+".structureType", "field 'StructureType' is required")
+	}
+	if r.StructureType != nil {
+		r.StructureType.validateAll(path+".structureType", issues)
+	}
+	if r.StructureVersionElement != nil {
+		r.StructureVersionElement.validateAll(path+".structureVersion", issues)
+	}
+	if len(r.structureProfileVariants) > 1 {
+		issues.add("structure", path+".structureProfile", fmt.Sprintf("field 'StructureProfile' must have a single type, got %v", r.structureProfileVariants))
+	}
+	if r.StructureProfile != nil {
+		r.StructureProfile.validateAll(path+".structureProfile.ofType("+r.StructureProfile.FHIRType()+")", issues)
+	}
+	if r.StructureProfileElement != nil {
+		r.StructureProfileElement.validateAll(path+".structureProfile", issues)
+	}
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content", issues)
+	}
+	for i, item := range r.Version {
+		item.validateAll(fmt.Sprintf("%s.version[%d]", path, i), issues)
+	}
+	for i, item := range r.ContainedInstance {
+		item.validateAll(fmt.Sprintf("%s.containedInstance[%d]", path, i), issues)
+	}
+}
+
+var exampleScenarioInstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioInstance) invariants() []invariant {
+	return exampleScenarioInstanceInvariants
+}
+
+func (r ExampleScenarioInstance) MarshalJSON() ([]byte, error) {
+	type alias ExampleScenarioInstance
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "structureProfile", r.StructureProfile, r.StructureProfileElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExampleScenarioInstance) UnmarshalJSON(data []byte) error {
+	type alias ExampleScenarioInstance
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.StructureProfile, element, r.structureProfileVariants, err = unmarshalChoice(fields, "structureProfile", exampleScenarioInstanceStructureProfileVariants); err != nil {
+		return err
+	}
+	r.StructureProfileElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.StructureProfileElement); err != nil {
+			return fmt.Errorf("_structureProfile: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ExampleScenarioInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioInstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExampleScenarioInstance) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "StructureProfile":
+		return "structureProfile", choiceValues(exampleScenarioInstanceStructureProfileVariants)
+	}
+	return "", nil
+}
+
+var exampleScenarioInstanceElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ExampleScenarioInstance) elementTypes() map[string]string {
+	return exampleScenarioInstanceElementTypes
+}
+
+var exampleScenarioInstanceElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.instance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.instance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.instance.key", Name: "key", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.structureType", Name: "structureType", Field: 5, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "ExampleScenario.instance.structureVersion", Name: "structureVersion", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.structureProfile[x]", Name: "structureProfile", Field: 8, Min: 0, Max: "1", Types: []string{"canonical", "uri"}},
+	{Path: "ExampleScenario.instance.title", Name: "title", Field: 10, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.description", Name: "description", Field: 12, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.instance.content", Name: "content", Field: 14, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExampleScenario.instance.version", Name: "version", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.instance.containedInstance", Name: "containedInstance", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExampleScenarioInstance) ElementMetadata() []ElementMetadata {
+	return exampleScenarioInstanceElementMetadata
+}
+
+// ExampleScenarioInstanceStructureProfile is the type of ExampleScenario.instance.structureProfile[x]. It is implemented by the ExampleScenarioInstanceStructureProfile* variant types.
+type ExampleScenarioInstanceStructureProfile interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExampleScenarioInstanceStructureProfile()
+}
+
+// ExampleScenarioInstanceStructureProfileCanonical is the canonical variant of ExampleScenarioInstanceStructureProfile.
+type ExampleScenarioInstanceStructureProfileCanonical string
+
+func (v ExampleScenarioInstanceStructureProfileCanonical) Validate() error {
+	return nil
+}
+
+func (v ExampleScenarioInstanceStructureProfileCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExampleScenarioInstanceStructureProfileCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ExampleScenarioInstanceStructureProfileCanonical) isExampleScenarioInstanceStructureProfile() {
+}
+
+// ExampleScenarioInstanceStructureProfileUri is the uri variant of ExampleScenarioInstanceStructureProfile.
+type ExampleScenarioInstanceStructureProfileUri string
+
+func (v ExampleScenarioInstanceStructureProfileUri) Validate() error {
+	return nil
+}
+
+func (v ExampleScenarioInstanceStructureProfileUri) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExampleScenarioInstanceStructureProfileUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ExampleScenarioInstanceStructureProfileUri) isExampleScenarioInstanceStructureProfile() {}
+
+var exampleScenarioInstanceStructureProfileVariants = []ExampleScenarioInstanceStructureProfile{
+	ExampleScenarioInstanceStructureProfileCanonical(""),
+	ExampleScenarioInstanceStructureProfileUri(""),
+}
+
+type ExampleScenarioInstanceVersion struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Key                string      `json:"key" bson:"key"`                                                  // ID or acronym of the version
+	KeyElement         *Element    `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
+	Title              string      `json:"title" bson:"title"`                                              // Label for instance version
+	TitleElement       *Element    `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Description        *string     `json:"description,omitempty" bson:"description,omitempty"`              // Details about version
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Content            *Reference  `json:"content,omitempty" bson:"content,omitempty"`                      // Example instance version data
+}
+
+func (r *ExampleScenarioInstanceVersion) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		return fmt.Errorf("field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
+	}
+	if r.Title == emptyString {
+		return fmt.Errorf("field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Content != nil {
+		if err := r.Content.Validate(); err != nil {
+			return fmt.Errorf("Content: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExampleScenarioInstanceVersion) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Content != nil {
+		r.Content.validateAll(path+".content", issues)
+	}
+}
+
+var exampleScenarioInstanceVersionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioInstanceVersion) invariants() []invariant {
+	return exampleScenarioInstanceVersionInvariants
+}
+
+func (r ExampleScenarioInstanceVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioInstanceVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioInstanceVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioInstanceVersion) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioInstanceVersionElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ExampleScenarioInstanceVersion) elementTypes() map[string]string {
+	return exampleScenarioInstanceVersionElementTypes
+}
+
+var exampleScenarioInstanceVersionElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.instance.version.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.version.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.instance.version.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.instance.version.key", Name: "key", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.version.title", Name: "title", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.version.description", Name: "description", Field: 7, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.instance.version.content", Name: "content", Field: 9, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ExampleScenarioInstanceVersion) ElementMetadata() []ElementMetadata {
+	return exampleScenarioInstanceVersionElementMetadata
+}
+
+type ExampleScenarioInstanceContainedInstance struct {
+	Id                       *string     `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension                []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	InstanceReference        string      `json:"instanceReference" bson:"instance_reference"`                              // Key of contained instance
+	InstanceReferenceElement *Element    `json:"_instanceReference,omitempty" bson:"instance_reference_element,omitempty"` // Extensions for instanceReference
+	VersionReference         *string     `json:"versionReference,omitempty" bson:"version_reference,omitempty"`            // Key of contained instance version
+	VersionReferenceElement  *Element    `json:"_versionReference,omitempty" bson:"version_reference_element,omitempty"`   // Extensions for versionReference
+}
+
+func (r *ExampleScenarioInstanceContainedInstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.InstanceReference == emptyString {
+		return fmt.Errorf("field 'InstanceReference' is required")
+	}
+	if r.InstanceReferenceElement != nil {
+		if err := r.InstanceReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("InstanceReferenceElement: %w", err)
+		}
+	}
+	if r.VersionReferenceElement != nil {
+		if err := r.VersionReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("VersionReferenceElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExampleScenarioInstanceContainedInstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.InstanceReference == emptyString {
+		issues.add("required", path+".instanceReference", "field 'InstanceReference' is required")
+	}
+	if r.InstanceReferenceElement != nil {
+		r.InstanceReferenceElement.validateAll(path+".instanceReference", issues)
+	}
+	if r.VersionReferenceElement != nil {
+		r.VersionReferenceElement.validateAll(path+".versionReference", issues)
+	}
+}
+
+var exampleScenarioInstanceContainedInstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioInstanceContainedInstance) invariants() []invariant {
+	return exampleScenarioInstanceContainedInstanceInvariants
+}
+
+func (r ExampleScenarioInstanceContainedInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioInstanceContainedInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioInstanceContainedInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioInstanceContainedInstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioInstanceContainedInstanceElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.instance.containedInstance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.containedInstance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.instance.containedInstance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.instance.containedInstance.instanceReference", Name: "instanceReference", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.instance.containedInstance.versionReference", Name: "versionReference", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ExampleScenarioInstanceContainedInstance) ElementMetadata() []ElementMetadata {
+	return exampleScenarioInstanceContainedInstanceElementMetadata
+}
+
+type ExampleScenarioProcess struct {
+	Id                    *string                      `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
+	Extension             []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension     []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored even if unrecognized
+	Title                 string                       `json:"title" bson:"title"`                                                 // Label for procss
+	TitleElement          *Element                     `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Description           *string                      `json:"description,omitempty" bson:"description,omitempty"`                 // Human-friendly description of the process
+	DescriptionElement    *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	PreConditions         *string                      `json:"preConditions,omitempty" bson:"pre_conditions,omitempty"`            // Status before process starts
+	PreConditionsElement  *Element                     `json:"_preConditions,omitempty" bson:"pre_conditions_element,omitempty"`   // Extensions for preConditions
+	PostConditions        *string                      `json:"postConditions,omitempty" bson:"post_conditions,omitempty"`          // Status after successful completion
+	PostConditionsElement *Element                     `json:"_postConditions,omitempty" bson:"post_conditions_element,omitempty"` // Extensions for postConditions
+	Step                  []ExampleScenarioProcessStep `json:"step,omitempty" bson:"step,omitempty"`                               // Event within of the process
+}
+
+func (r *ExampleScenarioProcess) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Title == emptyString {
+		return fmt.Errorf("field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.PreConditionsElement != nil {
+		if err := r.PreConditionsElement.Validate(); err != nil {
+			return fmt.Errorf("PreConditionsElement: %w", err)
+		}
+	}
+	if r.PostConditionsElement != nil {
+		if err := r.PostConditionsElement.Validate(); err != nil {
+			return fmt.Errorf("PostConditionsElement: %w", err)
+		}
+	}
+	for i, item := range r.Step {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Step[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExampleScenarioProcess) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.PreConditionsElement != nil {
+		r.PreConditionsElement.validateAll(path+".preConditions", issues)
+	}
+	if r.PostConditionsElement != nil {
+		r.PostConditionsElement.validateAll(path+".postConditions", issues)
+	}
+	for i, item := range r.Step {
+		item.validateAll(fmt.Sprintf("%s.step[%d]", path, i), issues)
+	}
+}
+
+var exampleScenarioProcessInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioProcess) invariants() []invariant {
+	return exampleScenarioProcessInvariants
+}
+
+func (r ExampleScenarioProcess) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioProcess) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioProcess) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioProcess) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioProcessElementTypes = map[string]string{
+	"description":    "markdown",
+	"preConditions":  "markdown",
+	"postConditions": "markdown",
+}
+
+func (r *ExampleScenarioProcess) elementTypes() map[string]string {
+	return exampleScenarioProcessElementTypes
+}
+
+var exampleScenarioProcessElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.process.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.process.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.process.title", Name: "title", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.process.preConditions", Name: "preConditions", Field: 7, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.process.postConditions", Name: "postConditions", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.process.step", Name: "step", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExampleScenarioProcess) ElementMetadata() []ElementMetadata {
+	return exampleScenarioProcessElementMetadata
+}
+
+type ExampleScenarioProcessStep struct {
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Number            *string                                 `json:"number,omitempty" bson:"number,omitempty"`                        // Sequential number of the step
+	NumberElement     *Element                                `json:"_number,omitempty" bson:"number_element,omitempty"`               // Extensions for number
+	Process           *ExampleScenarioProcess                 `json:"process,omitempty" bson:"process,omitempty"`                      // Step is nested process
+	Workflow          *string                                 `json:"workflow,omitempty" bson:"workflow,omitempty"`                    // Step is nested workflow
+	WorkflowElement   *Element                                `json:"_workflow,omitempty" bson:"workflow_element,omitempty"`           // Extensions for workflow
+	Operation         *ExampleScenarioProcessStepOperation    `json:"operation,omitempty" bson:"operation,omitempty"`                  // Step is simple action
+	Alternative       []ExampleScenarioProcessStepAlternative `json:"alternative,omitempty" bson:"alternative,omitempty"`              // Alternate non-typical step action
+	Pause             *bool                                   `json:"pause,omitempty" bson:"pause,omitempty"`                          // Pause in the flow?
+	PauseElement      *Element                                `json:"_pause,omitempty" bson:"pause_element,omitempty"`                 // Extensions for pause
+}
+
+func (r *ExampleScenarioProcessStep) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
+		}
+	}
+	if r.Process != nil {
+		if err := r.Process.Validate(); err != nil {
+			return fmt.Errorf("Process: %w", err)
+		}
+	}
+	if r.WorkflowElement != nil {
+		if err := r.WorkflowElement.Validate(); err != nil {
+			return fmt.Errorf("WorkflowElement: %w", err)
+		}
+	}
+	if r.Operation != nil {
+		if err := r.Operation.Validate(); err != nil {
+			return fmt.Errorf("Operation: %w", err)
+		}
+	}
+	for i, item := range r.Alternative {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Alternative[%d]: %w", i, err)
+		}
+	}
+	if r.PauseElement != nil {
+		if err := r.PauseElement.Validate(); err != nil {
+			return fmt.Errorf("PauseElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExampleScenarioProcessStep) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
+	}
+	if r.Process != nil {
+		r.Process.validateAll(path+".process", issues)
+	}
+	if r.WorkflowElement != nil {
+		r.WorkflowElement.validateAll(path+".workflow", issues)
+	}
+	if r.Operation != nil {
+		r.Operation.validateAll(path+".operation", issues)
+	}
+	for i, item := range r.Alternative {
+		item.validateAll(fmt.Sprintf("%s.alternative[%d]", path, i), issues)
+	}
+	if r.PauseElement != nil {
+		r.PauseElement.validateAll(path+".pause", issues)
+	}
+}
+
+var exampleScenarioProcessStepInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioProcessStep) invariants() []invariant {
+	return exampleScenarioProcessStepInvariants
+}
+
+func (r ExampleScenarioProcessStep) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioProcessStep) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioProcessStep) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioProcessStep) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioProcessStepElementTypes = map[string]string{
+	"workflow": "canonical",
+}
+
+func (r *ExampleScenarioProcessStep) elementTypes() map[string]string {
+	return exampleScenarioProcessStepElementTypes
+}
+
+var exampleScenarioProcessStepElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.process.step.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.process.step.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.process.step.number", Name: "number", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.process", Name: "process", Field: 5, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.process.step.workflow", Name: "workflow", Field: 6, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ExampleScenario.process.step.operation", Name: "operation", Field: 8, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.process.step.alternative", Name: "alternative", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.process.step.pause", Name: "pause", Field: 10, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *ExampleScenarioProcessStep) ElementMetadata() []ElementMetadata {
+	return exampleScenarioProcessStepElementMetadata
+}
+
+type ExampleScenarioProcessStepOperation struct {
+	Id                     *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	Type                   *Coding                                   `json:"type,omitempty" bson:"type,omitempty"`                                 // Kind of action
+	Title                  string                                    `json:"title" bson:"title"`                                                   // Label for step
+	TitleElement           *Element                                  `json:"_title,omitempty" bson:"title_element,omitempty"`                      // Extensions for title
+	Initiator              *string                                   `json:"initiator,omitempty" bson:"initiator,omitempty"`                       // Who starts the operation
+	InitiatorElement       *Element                                  `json:"_initiator,omitempty" bson:"initiator_element,omitempty"`              // Extensions for initiator
+	Receiver               *string                                   `json:"receiver,omitempty" bson:"receiver,omitempty"`                         // Who receives the operation
+	ReceiverElement        *Element                                  `json:"_receiver,omitempty" bson:"receiver_element,omitempty"`                // Extensions for receiver
+	Description            *string                                   `json:"description,omitempty" bson:"description,omitempty"`                   // Human-friendly description of the operation
+	DescriptionElement     *Element                                  `json:"_description,omitempty" bson:"description_element,omitempty"`          // Extensions for description
+	InitiatorActive        *bool                                     `json:"initiatorActive,omitempty" bson:"initiator_active,omitempty"`          // Initiator stays active?
+	InitiatorActiveElement *Element                                  `json:"_initiatorActive,omitempty" bson:"initiator_active_element,omitempty"` // Extensions for initiatorActive
+	ReceiverActive         *bool                                     `json:"receiverActive,omitempty" bson:"receiver_active,omitempty"`            // Receiver stays active?
+	ReceiverActiveElement  *Element                                  `json:"_receiverActive,omitempty" bson:"receiver_active_element,omitempty"`   // Extensions for receiverActive
+	Request                *ExampleScenarioInstanceContainedInstance `json:"request,omitempty" bson:"request,omitempty"`                           // Instance transmitted on invocation
+	Response               *ExampleScenarioInstanceContainedInstance `json:"response,omitempty" bson:"response,omitempty"`                         // Instance transmitted on invocation response
+}
+
+func (r *ExampleScenarioProcessStepOperation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
 	}
 	var emptyString string
 	if r.Title == emptyString {
 		return fmt.Errorf("field 'Title' is required")
 	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.InitiatorElement != nil {
+		if err := r.InitiatorElement.Validate(); err != nil {
+			return fmt.Errorf("InitiatorElement: %w", err)
+		}
+	}
+	if r.ReceiverElement != nil {
+		if err := r.ReceiverElement.Validate(); err != nil {
+			return fmt.Errorf("ReceiverElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.InitiatorActiveElement != nil {
+		if err := r.InitiatorActiveElement.Validate(); err != nil {
+			return fmt.Errorf("InitiatorActiveElement: %w", err)
+		}
+	}
+	if r.ReceiverActiveElement != nil {
+		if err := r.ReceiverActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ReceiverActiveElement: %w", err)
+		}
+	}
 	if r.Request != nil {
 		if err := r.Request.Validate(); err != nil {
 			return fmt.Errorf("Request: %w", err)
@@ -310,3 +1574,205 @@ func (r *ExampleScenarioProcessStepOperation) Validate() error {
 	}
 	return nil
 }
+
+func (r *ExampleScenarioProcessStepOperation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	var emptyString string
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.InitiatorElement != nil {
+		r.InitiatorElement.validateAll(path+".initiator", issues)
+	}
+	if r.ReceiverElement != nil {
+		r.ReceiverElement.validateAll(path+".receiver", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.InitiatorActiveElement != nil {
+		r.InitiatorActiveElement.validateAll(path+".initiatorActive", issues)
+	}
+	if r.ReceiverActiveElement != nil {
+		r.ReceiverActiveElement.validateAll(path+".receiverActive", issues)
+	}
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
+	}
+	if r.Response != nil {
+		r.Response.validateAll(path+".response", issues)
+	}
+}
+
+var exampleScenarioProcessStepOperationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioProcessStepOperation) invariants() []invariant {
+	return exampleScenarioProcessStepOperationInvariants
+}
+
+func (r ExampleScenarioProcessStepOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioProcessStepOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioProcessStepOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioProcessStepOperation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioProcessStepOperationElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ExampleScenarioProcessStepOperation) elementTypes() map[string]string {
+	return exampleScenarioProcessStepOperationElementTypes
+}
+
+var exampleScenarioProcessStepOperationElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.process.step.operation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.operation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.process.step.operation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.process.step.operation.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "ExampleScenario.process.step.operation.title", Name: "title", Field: 4, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.operation.initiator", Name: "initiator", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.operation.receiver", Name: "receiver", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.operation.description", Name: "description", Field: 10, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.process.step.operation.initiatorActive", Name: "initiatorActive", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ExampleScenario.process.step.operation.receiverActive", Name: "receiverActive", Field: 14, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ExampleScenario.process.step.operation.request", Name: "request", Field: 16, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExampleScenario.process.step.operation.response", Name: "response", Field: 17, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExampleScenarioProcessStepOperation) ElementMetadata() []ElementMetadata {
+	return exampleScenarioProcessStepOperationElementMetadata
+}
+
+type ExampleScenarioProcessStepAlternative struct {
+	Id                 *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title              string                       `json:"title" bson:"title"`                                              // Label for alternative
+	TitleElement       *Element                     `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Description        *string                      `json:"description,omitempty" bson:"description,omitempty"`              // Human-readable description of option
+	DescriptionElement *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Step               []ExampleScenarioProcessStep `json:"step,omitempty" bson:"step,omitempty"`                            // Alternative action(s)
+}
+
+func (r *ExampleScenarioProcessStepAlternative) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Title == emptyString {
+		return fmt.Errorf("field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Step {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Step[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExampleScenarioProcessStepAlternative) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Step {
+		item.validateAll(fmt.Sprintf("%s.step[%d]", path, i), issues)
+	}
+}
+
+var exampleScenarioProcessStepAlternativeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExampleScenarioProcessStepAlternative) invariants() []invariant {
+	return exampleScenarioProcessStepAlternativeInvariants
+}
+
+func (r ExampleScenarioProcessStepAlternative) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExampleScenarioProcessStepAlternative) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExampleScenarioProcessStepAlternative) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExampleScenarioProcessStepAlternative) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var exampleScenarioProcessStepAlternativeElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ExampleScenarioProcessStepAlternative) elementTypes() map[string]string {
+	return exampleScenarioProcessStepAlternativeElementTypes
+}
+
+var exampleScenarioProcessStepAlternativeElementMetadata = []ElementMetadata{
+	{Path: "ExampleScenario.process.step.alternative.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.alternative.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExampleScenario.process.step.alternative.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExampleScenario.process.step.alternative.title", Name: "title", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ExampleScenario.process.step.alternative.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExampleScenario.process.step.alternative.step", Name: "step", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExampleScenarioProcessStepAlternative) ElementMetadata() []ElementMetadata {
+	return exampleScenarioProcessStepAlternativeElementMetadata
+}
@@ -1,9 +1,418 @@
 package models
 
-// The definition of a value set is used to create a simple collection of codes suitable for use for data entry or validation.   If the operation is not called at the instance level, one of the in parameters url, context or valueSet must be provided.  An expanded value set will be returned, or an OperationOutcome with an error message.
-type Expand struct {
+import "fmt"
+
+// ExpandIn holds the input parameters of the $expand operation. The definition of a value set is used to create a simple collection of codes suitable for use for data entry or validation.   If the operation is not called at the instance level, one of the in parameters url, context or valueSet must be provided.  An expanded value set will be returned, or an OperationOutcome with an error message.
+type ExpandIn struct {
+	// A canonical reference to a value set. The server must know the value set (e.g. it is defined explicitly in the server's value sets, or it is defined implicitly by some code system known to the server
+	Url *string
+	// The value set is provided directly as part of the request
+	ValueSet *ValueSet
+	// The identifier that is used to identify a specific version of the value set to be used when generating the expansion
+	ValueSetVersion *string
+	// The context of the value set, so that the server can resolve this to a value set to expand
+	Context *string
+	// If a context is provided, a context direction may also be provided. Valid values are: incoming and outgoing
+	ContextDirection *string
+	// A text filter that is applied to restrict the codes that are returned
+	Filter *string
+	// The date for which the expansion should be generated
+	Date *DateTime
+	// Paging support - where to start if a subset is desired (default = 0)
+	Offset *int
+	// Paging support - how many codes should be provided in a partial page view
+	Count *int
+	// Controls whether concept designations are to be included or excluded in value set expansions
+	IncludeDesignations *bool
+	// A token that specifies a system+code that is either a use or a language
+	Designation []string
+	// Controls whether the value set definition is included or excluded in value set expansions
+	IncludeDefinition *bool
+	// Controls whether inactive concepts are included or excluded in value set expansions
+	ActiveOnly *bool
+	// The supplement must be used when performing an expansion
+	UseSupplement []string
+	// Controls whether or not the value set expansion may nest codes or not
+	ExcludeNested *bool
+	// Controls whether or not the value set expansion is assembled for a user interface use or not
+	ExcludeNotForUI *bool
+	// Controls whether or not the value set expansion includes post coordinated codes
+	ExcludePostCoordinated *bool
+	// Specifies the language to be used for description in the expansions
+	DisplayLanguage *string
+	// A request to return a particular property in the expansion
+	Property []string
+	// Code system, or a particular version of a code system to be excluded from the value set expansion
+	ExcludeSystem []string
+	// Specifies a version to use for a system, if the value set does not specify which one to use
+	SystemVersion []string
+	// Edge Case: Specifies a version to use for a system. If a value set specifies a different version, an error is returned
+	CheckSystemVersion []string
+	// Edge Case: Specifies a version to use for a system. This parameter overrides any specified version in the value set
+	ForceSystemVersion []string
+	// Resources that are used in the expansion, such as a code system or value set not known to the server
+	TxResource []Resource
+}
+
+func (p *ExpandIn) Validate() error {
+	return nil
+}
+
+func (p *ExpandIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ExpandIn) FromParameters(params *Parameters) error {
+	*p = ExpandIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ExpandIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Url != nil {
+		params = append(params, ParametersParameter{Name: "url", Value: ParametersParameterValueUri(*p.Url)})
+	}
+	if p.ValueSet != nil {
+		params = append(params, ParametersParameter{Name: "valueSet", Resource: p.ValueSet})
+	}
+	if p.ValueSetVersion != nil {
+		params = append(params, ParametersParameter{Name: "valueSetVersion", Value: ParametersParameterValueString(*p.ValueSetVersion)})
+	}
+	if p.Context != nil {
+		params = append(params, ParametersParameter{Name: "context", Value: ParametersParameterValueUri(*p.Context)})
+	}
+	if p.ContextDirection != nil {
+		params = append(params, ParametersParameter{Name: "contextDirection", Value: ParametersParameterValueCode(*p.ContextDirection)})
+	}
+	if p.Filter != nil {
+		params = append(params, ParametersParameter{Name: "filter", Value: ParametersParameterValueString(*p.Filter)})
+	}
+	if p.Date != nil {
+		params = append(params, ParametersParameter{Name: "date", Value: ParametersParameterValueDateTime{DateTime: *p.Date}})
+	}
+	if p.Offset != nil {
+		params = append(params, ParametersParameter{Name: "offset", Value: ParametersParameterValueInteger(*p.Offset)})
+	}
+	if p.Count != nil {
+		params = append(params, ParametersParameter{Name: "count", Value: ParametersParameterValueInteger(*p.Count)})
+	}
+	if p.IncludeDesignations != nil {
+		params = append(params, ParametersParameter{Name: "includeDesignations", Value: ParametersParameterValueBoolean(*p.IncludeDesignations)})
+	}
+	for _, v := range p.Designation {
+		params = append(params, ParametersParameter{Name: "designation", Value: ParametersParameterValueString(v)})
+	}
+	if p.IncludeDefinition != nil {
+		params = append(params, ParametersParameter{Name: "includeDefinition", Value: ParametersParameterValueBoolean(*p.IncludeDefinition)})
+	}
+	if p.ActiveOnly != nil {
+		params = append(params, ParametersParameter{Name: "activeOnly", Value: ParametersParameterValueBoolean(*p.ActiveOnly)})
+	}
+	for _, v := range p.UseSupplement {
+		params = append(params, ParametersParameter{Name: "useSupplement", Value: ParametersParameterValueCanonical(v)})
+	}
+	if p.ExcludeNested != nil {
+		params = append(params, ParametersParameter{Name: "excludeNested", Value: ParametersParameterValueBoolean(*p.ExcludeNested)})
+	}
+	if p.ExcludeNotForUI != nil {
+		params = append(params, ParametersParameter{Name: "excludeNotForUI", Value: ParametersParameterValueBoolean(*p.ExcludeNotForUI)})
+	}
+	if p.ExcludePostCoordinated != nil {
+		params = append(params, ParametersParameter{Name: "excludePostCoordinated", Value: ParametersParameterValueBoolean(*p.ExcludePostCoordinated)})
+	}
+	if p.DisplayLanguage != nil {
+		params = append(params, ParametersParameter{Name: "displayLanguage", Value: ParametersParameterValueCode(*p.DisplayLanguage)})
+	}
+	for _, v := range p.Property {
+		params = append(params, ParametersParameter{Name: "property", Value: ParametersParameterValueString(v)})
+	}
+	for _, v := range p.ExcludeSystem {
+		params = append(params, ParametersParameter{Name: "exclude-system", Value: ParametersParameterValueCanonical(v)})
+	}
+	for _, v := range p.SystemVersion {
+		params = append(params, ParametersParameter{Name: "system-version", Value: ParametersParameterValueCanonical(v)})
+	}
+	for _, v := range p.CheckSystemVersion {
+		params = append(params, ParametersParameter{Name: "check-system-version", Value: ParametersParameterValueCanonical(v)})
+	}
+	for _, v := range p.ForceSystemVersion {
+		params = append(params, ParametersParameter{Name: "force-system-version", Value: ParametersParameterValueCanonical(v)})
+	}
+	for _, v := range p.TxResource {
+		params = append(params, ParametersParameter{Name: "tx-resource", Resource: v})
+	}
+	return params
+}
+
+func (p *ExpandIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "url":
+			if p.Url != nil {
+				return fmt.Errorf("parameter 'url' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Url = &value
+		case "valueSet":
+			if p.ValueSet != nil {
+				return fmt.Errorf("parameter 'valueSet' must occur at most once")
+			}
+			v, err := parameterResource[*ValueSet](param.Name, param.Resource, "ValueSet")
+			if err != nil {
+				return err
+			}
+			p.ValueSet = v
+		case "valueSetVersion":
+			if p.ValueSetVersion != nil {
+				return fmt.Errorf("parameter 'valueSetVersion' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.ValueSetVersion = &value
+		case "context":
+			if p.Context != nil {
+				return fmt.Errorf("parameter 'context' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Context = &value
+		case "contextDirection":
+			if p.ContextDirection != nil {
+				return fmt.Errorf("parameter 'contextDirection' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.ContextDirection = &value
+		case "filter":
+			if p.Filter != nil {
+				return fmt.Errorf("parameter 'filter' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Filter = &value
+		case "date":
+			if p.Date != nil {
+				return fmt.Errorf("parameter 'date' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueDateTime](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Date = &v.DateTime
+		case "offset":
+			if p.Offset != nil {
+				return fmt.Errorf("parameter 'offset' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueInteger](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := int(v)
+			p.Offset = &value
+		case "count":
+			if p.Count != nil {
+				return fmt.Errorf("parameter 'count' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueInteger](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := int(v)
+			p.Count = &value
+		case "includeDesignations":
+			if p.IncludeDesignations != nil {
+				return fmt.Errorf("parameter 'includeDesignations' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.IncludeDesignations = &value
+		case "designation":
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Designation = append(p.Designation, string(v))
+		case "includeDefinition":
+			if p.IncludeDefinition != nil {
+				return fmt.Errorf("parameter 'includeDefinition' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.IncludeDefinition = &value
+		case "activeOnly":
+			if p.ActiveOnly != nil {
+				return fmt.Errorf("parameter 'activeOnly' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.ActiveOnly = &value
+		case "useSupplement":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.UseSupplement = append(p.UseSupplement, string(v))
+		case "excludeNested":
+			if p.ExcludeNested != nil {
+				return fmt.Errorf("parameter 'excludeNested' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.ExcludeNested = &value
+		case "excludeNotForUI":
+			if p.ExcludeNotForUI != nil {
+				return fmt.Errorf("parameter 'excludeNotForUI' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.ExcludeNotForUI = &value
+		case "excludePostCoordinated":
+			if p.ExcludePostCoordinated != nil {
+				return fmt.Errorf("parameter 'excludePostCoordinated' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.ExcludePostCoordinated = &value
+		case "displayLanguage":
+			if p.DisplayLanguage != nil {
+				return fmt.Errorf("parameter 'displayLanguage' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.DisplayLanguage = &value
+		case "property":
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Property = append(p.Property, string(v))
+		case "exclude-system":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.ExcludeSystem = append(p.ExcludeSystem, string(v))
+		case "system-version":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.SystemVersion = append(p.SystemVersion, string(v))
+		case "check-system-version":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.CheckSystemVersion = append(p.CheckSystemVersion, string(v))
+		case "force-system-version":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.ForceSystemVersion = append(p.ForceSystemVersion, string(v))
+		case "tx-resource":
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.TxResource = append(p.TxResource, v)
+		}
+	}
+	return nil
+}
+
+// ExpandOut holds the output parameters of the $expand operation.
+type ExpandOut struct {
+	// The result of the expansion
+	Return *ValueSet
+}
+
+func (p *ExpandOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *ExpandOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ExpandOut) FromParameters(params *Parameters) error {
+	*p = ExpandOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ExpandOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Expand) Validate() error {
+func (p *ExpandOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*ValueSet](param.Name, param.Resource, "ValueSet")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
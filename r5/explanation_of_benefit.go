@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,19 +12,27 @@ type ExplanationOfBenefit struct {
 	Id                    *string                                `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
 	Meta                  *Meta                                  `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
 	ImplicitRules         *string                                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
 	Language              *string                                `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement       *Element                               `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
 	Text                  *Narrative                             `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage                      `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Contained             []Resource                             `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension             []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension     []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
 	Identifier            []Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // Business Identifier for the resource
 	TraceNumber           []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                      // Number for tracking
-	Status                string                                 `json:"status" bson:"status"`                                                     // active | cancelled | draft | entered-in-error
+	Status                ExplanationOfBenefitStatus             `json:"status" bson:"status"`                                                     // active | cancelled | draft | entered-in-error
+	StatusElement         *Element                               `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
 	StatusReason          *string                                `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                    // Reason for status change
+	StatusReasonElement   *Element                               `json:"_statusReason,omitempty" bson:"status_reason_element,omitempty"`           // Extensions for statusReason
 	Type                  *CodeableConcept                       `json:"type" bson:"type"`                                                         // Category or discipline
 	SubType               *CodeableConcept                       `json:"subType,omitempty" bson:"sub_type,omitempty"`                              // More granular claim type
-	Use                   string                                 `json:"use" bson:"use"`                                                           // claim | preauthorization | predetermination
+	Use                   Use                                    `json:"use" bson:"use"`                                                           // claim | preauthorization | predetermination
+	UseElement            *Element                               `json:"_use,omitempty" bson:"use_element,omitempty"`                              // Extensions for use
 	Subject               *Reference                             `json:"subject" bson:"subject"`                                                   // The recipient(s) of the products and services
 	BillablePeriod        *Period                                `json:"billablePeriod,omitempty" bson:"billable_period,omitempty"`                // Relevant time frame for the claim
-	Created               string                                 `json:"created" bson:"created"`                                                   // Response creation date
+	Created               *DateTime                              `json:"created" bson:"created"`                                                   // Response creation date
+	CreatedElement        *Element                               `json:"_created,omitempty" bson:"created_element,omitempty"`                      // Extensions for created
 	Enterer               *Reference                             `json:"enterer,omitempty" bson:"enterer,omitempty"`                               // Author of the claim
 	Insurer               *Reference                             `json:"insurer,omitempty" bson:"insurer,omitempty"`                               // Party responsible for reimbursement
 	Provider              *Reference                             `json:"provider,omitempty" bson:"provider,omitempty"`                             // Party responsible for the claim
@@ -40,10 +49,13 @@ type ExplanationOfBenefit struct {
 	Facility              *Reference                             `json:"facility,omitempty" bson:"facility,omitempty"`                             // Servicing Facility
 	Claim                 *Reference                             `json:"claim,omitempty" bson:"claim,omitempty"`                                   // Claim reference
 	ClaimResponse         *Reference                             `json:"claimResponse,omitempty" bson:"claim_response,omitempty"`                  // Claim response reference
-	Outcome               string                                 `json:"outcome" bson:"outcome"`                                                   // queued | complete | error | partial
+	Outcome               ClaimProcessingCodes                   `json:"outcome" bson:"outcome"`                                                   // queued | complete | error | partial
+	OutcomeElement        *Element                               `json:"_outcome,omitempty" bson:"outcome_element,omitempty"`                      // Extensions for outcome
 	Decision              *CodeableConcept                       `json:"decision,omitempty" bson:"decision,omitempty"`                             // Result of the adjudication
 	Disposition           *string                                `json:"disposition,omitempty" bson:"disposition,omitempty"`                       // Disposition Message
+	DispositionElement    *Element                               `json:"_disposition,omitempty" bson:"disposition_element,omitempty"`              // Extensions for disposition
 	PreAuthRef            []string                               `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`                       // Preauthorization reference
+	PreAuthRefElement     []*Element                             `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`              // Extensions for preAuthRef
 	PreAuthRefPeriod      []Period                               `json:"preAuthRefPeriod,omitempty" bson:"pre_auth_ref_period,omitempty"`          // Preauthorization in-effect period
 	DiagnosisRelatedGroup *CodeableConcept                       `json:"diagnosisRelatedGroup,omitempty" bson:"diagnosis_related_group,omitempty"` // Package billing code
 	CareTeam              []ExplanationOfBenefitCareTeam         `json:"careTeam,omitempty" bson:"care_team,omitempty"`                            // Care Team members
@@ -51,6 +63,7 @@ type ExplanationOfBenefit struct {
 	Diagnosis             []ExplanationOfBenefitDiagnosis        `json:"diagnosis,omitempty" bson:"diagnosis,omitempty"`                           // Pertinent diagnosis information
 	Procedure             []ExplanationOfBenefitProcedure        `json:"procedure,omitempty" bson:"procedure,omitempty"`                           // Clinical procedures performed
 	Precedence            *int                                   `json:"precedence,omitempty" bson:"precedence,omitempty"`                         // Precedence (primary, secondary, etc.)
+	PrecedenceElement     *Element                               `json:"_precedence,omitempty" bson:"precedence_element,omitempty"`                // Extensions for precedence
 	Insurance             []ExplanationOfBenefitInsurance        `json:"insurance,omitempty" bson:"insurance,omitempty"`                           // Patient insurance information
 	Accident              *ExplanationOfBenefitAccident          `json:"accident,omitempty" bson:"accident,omitempty"`                             // Details of the event
 	PatientPaid           *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                      // Paid by the patient
@@ -66,6 +79,16 @@ type ExplanationOfBenefit struct {
 	BenefitBalance        []ExplanationOfBenefitBenefitBalance   `json:"benefitBalance,omitempty" bson:"benefit_balance,omitempty"`                // Balance by Benefit Category
 }
 
+var explanationOfBenefitSubjectTargets = []string{"Group", "Patient"}
+
+var explanationOfBenefitEntererTargets = []string{"Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var explanationOfBenefitProviderTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var explanationOfBenefitFacilityTargets = []string{"Location", "Organization"}
+
+var explanationOfBenefitClaimTargets = []string{"Claim"}
+
 func (r *ExplanationOfBenefit) Validate() error {
 	if r.ResourceType != "ExplanationOfBenefit" {
 		return fmt.Errorf("invalid resourceType: expected 'ExplanationOfBenefit', got '%s'", r.ResourceType)
@@ -75,11 +98,39 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -90,10 +141,22 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.StatusReasonElement != nil {
+		if err := r.StatusReasonElement.Validate(); err != nil {
+			return fmt.Errorf("StatusReasonElement: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -107,12 +170,25 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("SubType: %w", err)
 		}
 	}
-	if r.Use == emptyString {
+	if r.Use == "" {
 		return fmt.Errorf("field 'Use' is required")
 	}
+	if !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, explanationOfBenefitSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, explanationOfBenefitSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -123,9 +199,24 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("BillablePeriod: %w", err)
 		}
 	}
-	if r.Created == emptyString {
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
+	if r.Enterer != nil {
+		if target := invalidReferenceTarget(r.Enterer.Reference, r.Enterer.Type, explanationOfBenefitEntererTargets); target != "" {
+			return fmt.Errorf("field 'Enterer' cannot refer to %s, expected one of %v", target, explanationOfBenefitEntererTargets)
+		}
+	}
 	if r.Enterer != nil {
 		if err := r.Enterer.Validate(); err != nil {
 			return fmt.Errorf("Enterer: %w", err)
@@ -136,6 +227,11 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("Insurer: %w", err)
 		}
 	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, explanationOfBenefitProviderTargets); target != "" {
+			return fmt.Errorf("field 'Provider' cannot refer to %s, expected one of %v", target, explanationOfBenefitProviderTargets)
+		}
+	}
 	if r.Provider != nil {
 		if err := r.Provider.Validate(); err != nil {
 			return fmt.Errorf("Provider: %w", err)
@@ -191,11 +287,21 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("Encounter[%d]: %w", i, err)
 		}
 	}
+	if r.Facility != nil {
+		if target := invalidReferenceTarget(r.Facility.Reference, r.Facility.Type, explanationOfBenefitFacilityTargets); target != "" {
+			return fmt.Errorf("field 'Facility' cannot refer to %s, expected one of %v", target, explanationOfBenefitFacilityTargets)
+		}
+	}
 	if r.Facility != nil {
 		if err := r.Facility.Validate(); err != nil {
 			return fmt.Errorf("Facility: %w", err)
 		}
 	}
+	if r.Claim != nil {
+		if target := invalidReferenceTarget(r.Claim.Reference, r.Claim.Type, explanationOfBenefitClaimTargets); target != "" {
+			return fmt.Errorf("field 'Claim' cannot refer to %s, expected one of %v", target, explanationOfBenefitClaimTargets)
+		}
+	}
 	if r.Claim != nil {
 		if err := r.Claim.Validate(); err != nil {
 			return fmt.Errorf("Claim: %w", err)
@@ -206,14 +312,35 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("ClaimResponse: %w", err)
 		}
 	}
-	if r.Outcome == emptyString {
+	if r.Outcome == "" {
 		return fmt.Errorf("field 'Outcome' is required")
 	}
+	if !r.Outcome.IsValid() {
+		return fmt.Errorf("field 'Outcome' has invalid code '%s'", r.Outcome)
+	}
+	if r.OutcomeElement != nil {
+		if err := r.OutcomeElement.Validate(); err != nil {
+			return fmt.Errorf("OutcomeElement: %w", err)
+		}
+	}
 	if r.Decision != nil {
 		if err := r.Decision.Validate(); err != nil {
 			return fmt.Errorf("Decision: %w", err)
 		}
 	}
+	if r.DispositionElement != nil {
+		if err := r.DispositionElement.Validate(); err != nil {
+			return fmt.Errorf("DispositionElement: %w", err)
+		}
+	}
+	for i, item := range r.PreAuthRefElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.PreAuthRefPeriod {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PreAuthRefPeriod[%d]: %w", i, err)
@@ -244,6 +371,11 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("Procedure[%d]: %w", i, err)
 		}
 	}
+	if r.PrecedenceElement != nil {
+		if err := r.PrecedenceElement.Validate(); err != nil {
+			return fmt.Errorf("PrecedenceElement: %w", err)
+		}
+	}
 	for i, item := range r.Insurance {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Insurance[%d]: %w", i, err)
@@ -309,431 +441,4690 @@ func (r *ExplanationOfBenefit) Validate() error {
 			return fmt.Errorf("BenefitBalance[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ExplanationOfBenefitEvent struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Type         *CodeableConcept `json:"type" bson:"type"`                   // Specific event
-	WhenDateTime *string          `json:"whenDateTime" bson:"when_date_time"` // Occurance date or period
-	WhenPeriod   *Period          `json:"whenPeriod" bson:"when_period"`      // Occurance date or period
+	return validateInvariants(r, "ExplanationOfBenefit")
 }
 
-func (r *ExplanationOfBenefitEvent) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *ExplanationOfBenefit) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ExplanationOfBenefit" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ExplanationOfBenefit', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.WhenDateTime == nil {
-		return fmt.Errorf("field 'WhenDateTime' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.WhenPeriod == nil {
-		return fmt.Errorf("field 'WhenPeriod' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.WhenPeriod != nil {
-		if err := r.WhenPeriod.Validate(); err != nil {
-			return fmt.Errorf("WhenPeriod: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	return nil
-}
-
-type ExplanationOfBenefitSupportingInfo struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
-	Sequence                   int                    `json:"sequence" bson:"sequence"`                                                            // Information instance identifier
-	Category                   *CodeableConcept       `json:"category" bson:"category"`                                                            // Classification of the supplied information
-	Code                       *CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                                                // Type of information
-	TimingDateTime             *string                `json:"timingDateTime,omitempty" bson:"timing_date_time,omitempty"`                          // When it occurred
-	TimingPeriod               *Period                `json:"timingPeriod,omitempty" bson:"timing_period,omitempty"`                               // When it occurred
-	TimingTiming               *Timing                `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`                               // When it occurred
-	ValueBase64Binary          *string                `json:"valueBase64Binary,omitempty" bson:"value_base64_binary,omitempty"`                    // Data to be provided
-	ValueBoolean               *bool                  `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                               // Data to be provided
-	ValueCanonical             *string                `json:"valueCanonical,omitempty" bson:"value_canonical,omitempty"`                           // Data to be provided
-	ValueCode                  *string                `json:"valueCode,omitempty" bson:"value_code,omitempty"`                                     // Data to be provided
-	ValueDate                  *string                `json:"valueDate,omitempty" bson:"value_date,omitempty"`                                     // Data to be provided
-	ValueDateTime              *string                `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                            // Data to be provided
-	ValueDecimal               *float64               `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`                               // Data to be provided
-	ValueId                    *string                `json:"valueId,omitempty" bson:"value_id,omitempty"`                                         // Data to be provided
-	ValueInstant               *string                `json:"valueInstant,omitempty" bson:"value_instant,omitempty"`                               // Data to be provided
-	ValueInteger               *int                   `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                               // Data to be provided
-	ValueInteger64             *int64                 `json:"valueInteger64,omitempty" bson:"value_integer64,omitempty"`                           // Data to be provided
-	ValueMarkdown              *string                `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                             // Data to be provided
-	ValueOid                   *string                `json:"valueOid,omitempty" bson:"value_oid,omitempty"`                                       // Data to be provided
-	ValuePositiveInt           *int                   `json:"valuePositiveInt,omitempty" bson:"value_positive_int,omitempty"`                      // Data to be provided
-	ValueString                *string                `json:"valueString,omitempty" bson:"value_string,omitempty"`                                 // Data to be provided
-	ValueTime                  *string                `json:"valueTime,omitempty" bson:"value_time,omitempty"`                                     // Data to be provided
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt,omitempty" bson:"value_unsigned_int,omitempty"`                      // Data to be provided
-	ValueUri                   *string                `json:"valueUri,omitempty" bson:"value_uri,omitempty"`                                       // Data to be provided
-	ValueUrl                   *string                `json:"valueUrl,omitempty" bson:"value_url,omitempty"`                                       // Data to be provided
-	ValueUuid                  *uuid                  `json:"valueUuid,omitempty" bson:"value_uuid,omitempty"`                                     // Data to be provided
-	ValueAddress               *Address               `json:"valueAddress,omitempty" bson:"value_address,omitempty"`                               // Data to be provided
-	ValueAge                   *Age                   `json:"valueAge,omitempty" bson:"value_age,omitempty"`                                       // Data to be provided
-	ValueAnnotation            *Annotation            `json:"valueAnnotation,omitempty" bson:"value_annotation,omitempty"`                         // Data to be provided
-	ValueAttachment            *Attachment            `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`                         // Data to be provided
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`              // Data to be provided
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference,omitempty" bson:"value_codeable_reference,omitempty"`          // Data to be provided
-	ValueCoding                *Coding                `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`                                 // Data to be provided
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint,omitempty" bson:"value_contact_point,omitempty"`                    // Data to be provided
-	ValueCount                 *Count                 `json:"valueCount,omitempty" bson:"value_count,omitempty"`                                   // Data to be provided
-	ValueDistance              *Distance              `json:"valueDistance,omitempty" bson:"value_distance,omitempty"`                             // Data to be provided
-	ValueDuration              *Duration              `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`                             // Data to be provided
-	ValueHumanName             *HumanName             `json:"valueHumanName,omitempty" bson:"value_human_name,omitempty"`                          // Data to be provided
-	ValueIdentifier            *Identifier            `json:"valueIdentifier,omitempty" bson:"value_identifier,omitempty"`                         // Data to be provided
-	ValueMoney                 *Money                 `json:"valueMoney,omitempty" bson:"value_money,omitempty"`                                   // Data to be provided
-	ValuePeriod                *Period                `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                                 // Data to be provided
-	ValueQuantity              *Quantity              `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                             // Data to be provided
-	ValueRange                 *Range                 `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                   // Data to be provided
-	ValueRatio                 *Ratio                 `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                                   // Data to be provided
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange,omitempty" bson:"value_ratio_range,omitempty"`                        // Data to be provided
-	ValueReference             *Reference             `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                           // Data to be provided
-	ValueSampledData           *SampledData           `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`                      // Data to be provided
-	ValueSignature             *Signature             `json:"valueSignature,omitempty" bson:"value_signature,omitempty"`                           // Data to be provided
-	ValueTiming                *Timing                `json:"valueTiming,omitempty" bson:"value_timing,omitempty"`                                 // Data to be provided
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail,omitempty" bson:"value_contact_detail,omitempty"`                  // Data to be provided
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement,omitempty" bson:"value_data_requirement,omitempty"`              // Data to be provided
-	ValueExpression            *Expression            `json:"valueExpression,omitempty" bson:"value_expression,omitempty"`                         // Data to be provided
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition,omitempty" bson:"value_parameter_definition,omitempty"`      // Data to be provided
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact,omitempty" bson:"value_related_artifact,omitempty"`              // Data to be provided
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition,omitempty" bson:"value_trigger_definition,omitempty"`          // Data to be provided
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext,omitempty" bson:"value_usage_context,omitempty"`                    // Data to be provided
-	ValueAvailability          *Availability          `json:"valueAvailability,omitempty" bson:"value_availability,omitempty"`                     // Data to be provided
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail,omitempty" bson:"value_extended_contact_detail,omitempty"` // Data to be provided
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail,omitempty" bson:"value_virtual_service_detail,omitempty"`   // Data to be provided
-	ValueDosage                *Dosage                `json:"valueDosage,omitempty" bson:"value_dosage,omitempty"`                                 // Data to be provided
-	ValueMeta                  *Meta                  `json:"valueMeta,omitempty" bson:"value_meta,omitempty"`                                     // Data to be provided
-	Reason                     *Coding                `json:"reason,omitempty" bson:"reason,omitempty"`                                            // Explanation for the information
-}
-
-func (r *ExplanationOfBenefitSupportingInfo) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
 	}
-	if r.TimingPeriod != nil {
-		if err := r.TimingPeriod.Validate(); err != nil {
-			return fmt.Errorf("TimingPeriod: %w", err)
-		}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
-		}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
+	if r.StatusReasonElement != nil {
+		r.StatusReasonElement.validateAll(path+".statusReason", issues)
 	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
+	if r.SubType != nil {
+		r.SubType.validateAll(path+".subType", issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if r.Use == "" {
+		issues.add("required", path+".use", "field 'Use' is required")
 	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
+	if r.Use != "" && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", r.Use))
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
-		}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
 	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
-		}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
 	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, explanationOfBenefitSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, explanationOfBenefitSubjectTargets))
 		}
 	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
-		}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
-		}
+	if r.BillablePeriod != nil {
+		r.BillablePeriod.validateAll(path+".billablePeriod", issues)
 	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
-		}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
 	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
 		}
 	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	if r.Enterer != nil {
+		if target := invalidReferenceTarget(r.Enterer.Reference, r.Enterer.Type, explanationOfBenefitEntererTargets); target != "" {
+			issues.add("value", path+".enterer", fmt.Sprintf("field 'Enterer' cannot refer to %s, expected one of %v", target, explanationOfBenefitEntererTargets))
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Enterer != nil {
+		r.Enterer.validateAll(path+".enterer", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.Insurer != nil {
+		r.Insurer.validateAll(path+".insurer", issues)
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, explanationOfBenefitProviderTargets); target != "" {
+			issues.add("value", path+".provider", fmt.Sprintf("field 'Provider' cannot refer to %s, expected one of %v", target, explanationOfBenefitProviderTargets))
 		}
 	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	if r.FundsReserveRequested != nil {
+		r.FundsReserveRequested.validateAll(path+".fundsReserveRequested", issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	if r.FundsReserve != nil {
+		r.FundsReserve.validateAll(path+".fundsReserve", issues)
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	for i, item := range r.Related {
+		item.validateAll(fmt.Sprintf("%s.related[%d]", path, i), issues)
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+	if r.Prescription != nil {
+		r.Prescription.validateAll(path+".prescription", issues)
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	if r.OriginalPrescription != nil {
+		r.OriginalPrescription.validateAll(path+".originalPrescription", issues)
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
-		}
+	if r.Payee != nil {
+		r.Payee.validateAll(path+".payee", issues)
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	if r.Referral != nil {
+		r.Referral.validateAll(path+".referral", issues)
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
-		}
+	for i, item := range r.Encounter {
+		item.validateAll(fmt.Sprintf("%s.encounter[%d]", path, i), issues)
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
+	if r.Facility != nil {
+		if target := invalidReferenceTarget(r.Facility.Reference, r.Facility.Type, explanationOfBenefitFacilityTargets); target != "" {
+			issues.add("value", path+".facility", fmt.Sprintf("field 'Facility' cannot refer to %s, expected one of %v", target, explanationOfBenefitFacilityTargets))
 		}
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
-		}
+	if r.Facility != nil {
+		r.Facility.validateAll(path+".facility", issues)
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
+	if r.Claim != nil {
+		if target := invalidReferenceTarget(r.Claim.Reference, r.Claim.Type, explanationOfBenefitClaimTargets); target != "" {
+			issues.add("value", path+".claim", fmt.Sprintf("field 'Claim' cannot refer to %s, expected one of %v", target, explanationOfBenefitClaimTargets))
 		}
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+	if r.Claim != nil {
+		r.Claim.validateAll(path+".claim", issues)
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+	if r.ClaimResponse != nil {
+		r.ClaimResponse.validateAll(path+".claimResponse", issues)
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
-		}
+	if r.Outcome == "" {
+		issues.add("required", path+".outcome", "field 'Outcome' is required")
 	}
-	if r.Reason != nil {
-		if err := r.Reason.Validate(); err != nil {
-			return fmt.Errorf("Reason: %w", err)
-		}
+	if r.Outcome != "" && !r.Outcome.IsValid() {
+		issues.add("code-invalid", path+".outcome", fmt.Sprintf("field 'Outcome' has invalid code '%s'", r.Outcome))
 	}
-	return nil
-}
-
-type ExplanationOfBenefitAccident struct {
-	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Date              *string          `json:"date,omitempty" bson:"date,omitempty"`                            // When the incident occurred
-	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // The nature of the accident
-	LocationAddress   *Address         `json:"locationAddress,omitempty" bson:"location_address,omitempty"`     // Where the event occurred
-	LocationReference *Reference       `json:"locationReference,omitempty" bson:"location_reference,omitempty"` // Where the event occurred
-}
-
-func (r *ExplanationOfBenefitAccident) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.OutcomeElement != nil {
+		r.OutcomeElement.validateAll(path+".outcome", issues)
 	}
-	if r.LocationAddress != nil {
-		if err := r.LocationAddress.Validate(); err != nil {
-			return fmt.Errorf("LocationAddress: %w", err)
-		}
+	if r.Decision != nil {
+		r.Decision.validateAll(path+".decision", issues)
 	}
-	if r.LocationReference != nil {
-		if err := r.LocationReference.Validate(); err != nil {
-			return fmt.Errorf("LocationReference: %w", err)
+	if r.DispositionElement != nil {
+		r.DispositionElement.validateAll(path+".disposition", issues)
+	}
+	for i, item := range r.PreAuthRefElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.preAuthRef[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ExplanationOfBenefitItemDetailSubDetail struct {
-	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	Sequence            int                                    `json:"sequence" bson:"sequence"`                                              // Product or service provided
-	TraceNumber         []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
-	Revenue             *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
-	Category            *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
-	ProductOrService    *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
-	ProductOrServiceEnd *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
-	Modifier            []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
-	ProgramCode         []CodeableConcept                      `json:"programCode,omitempty" bson:"program_code,omitempty"`                   // Program the product or service is provided under
-	PatientPaid         *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
-	Quantity            *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
-	UnitPrice           *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                               `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
-	Tax                 *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
-	Net                 *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
-	Udi                 []Reference                            `json:"udi,omitempty" bson:"udi,omitempty"`                                    // Unique device identifier
-	NoteNumber          []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
-	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Subdetail level adjudication results
-	Adjudication        []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Subdetail level adjudication details
-}
-
-func (r *ExplanationOfBenefitItemDetailSubDetail) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+	for i, item := range r.PreAuthRefPeriod {
+		item.validateAll(fmt.Sprintf("%s.preAuthRefPeriod[%d]", path, i), issues)
 	}
-	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
-		}
+	if r.DiagnosisRelatedGroup != nil {
+		r.DiagnosisRelatedGroup.validateAll(path+".diagnosisRelatedGroup", issues)
 	}
-	if r.Revenue != nil {
-		if err := r.Revenue.Validate(); err != nil {
-			return fmt.Errorf("Revenue: %w", err)
-		}
+	for i, item := range r.CareTeam {
+		item.validateAll(fmt.Sprintf("%s.careTeam[%d]", path, i), issues)
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
 	}
-	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
-		}
+	for i, item := range r.Diagnosis {
+		item.validateAll(fmt.Sprintf("%s.diagnosis[%d]", path, i), issues)
 	}
-	if r.ProductOrServiceEnd != nil {
-		if err := r.ProductOrServiceEnd.Validate(); err != nil {
-			return fmt.Errorf("ProductOrServiceEnd: %w", err)
-		}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
 	}
-	for i, item := range r.Modifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
-		}
+	if r.PrecedenceElement != nil {
+		r.PrecedenceElement.validateAll(path+".precedence", issues)
 	}
-	for i, item := range r.ProgramCode {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
-		}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	if r.Accident != nil {
+		r.Accident.validateAll(path+".accident", issues)
 	}
 	if r.PatientPaid != nil {
-		if err := r.PatientPaid.Validate(); err != nil {
-			return fmt.Errorf("PatientPaid: %w", err)
-		}
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
 	}
-	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
-		}
+	for i, item := range r.AddItem {
+		item.validateAll(fmt.Sprintf("%s.addItem[%d]", path, i), issues)
 	}
-	if r.Tax != nil {
-		if err := r.Tax.Validate(); err != nil {
-			return fmt.Errorf("Tax: %w", err)
-		}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
 	}
-	if r.Net != nil {
-		if err := r.Net.Validate(); err != nil {
-			return fmt.Errorf("Net: %w", err)
+	for i, item := range r.Total {
+		item.validateAll(fmt.Sprintf("%s.total[%d]", path, i), issues)
+	}
+	if r.Payment != nil {
+		r.Payment.validateAll(path+".payment", issues)
+	}
+	if r.FormCode != nil {
+		r.FormCode.validateAll(path+".formCode", issues)
+	}
+	if r.Form != nil {
+		r.Form.validateAll(path+".form", issues)
+	}
+	for i, item := range r.ProcessNote {
+		item.validateAll(fmt.Sprintf("%s.processNote[%d]", path, i), issues)
+	}
+	if r.BenefitPeriod != nil {
+		r.BenefitPeriod.validateAll(path+".benefitPeriod", issues)
+	}
+	for i, item := range r.BenefitBalance {
+		item.validateAll(fmt.Sprintf("%s.benefitBalance[%d]", path, i), issues)
+	}
+}
+
+func (r *ExplanationOfBenefit) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ExplanationOfBenefit", &issues)
+	checkInvariants(r, "ExplanationOfBenefit", &issues)
+	return issues
+}
+
+var explanationOfBenefitInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ExplanationOfBenefit) invariants() []invariant {
+	return explanationOfBenefitInvariants
+}
+
+func (r ExplanationOfBenefit) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefit
+	out := struct {
+		alias
+		PreAuthRef        []*string  `json:"preAuthRef,omitempty"`
+		PreAuthRefElement []*Element `json:"_preAuthRef,omitempty"`
+	}{alias: alias(r)}
+	out.PreAuthRef, out.PreAuthRefElement = alignPrimitiveArray(r.PreAuthRef, r.PreAuthRefElement)
+	return json.Marshal(out)
+}
+
+func (r *ExplanationOfBenefit) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefit
+	aux := struct {
+		*alias
+		Contained  []json.RawMessage `json:"contained,omitempty"`
+		PreAuthRef []*string         `json:"preAuthRef,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.PreAuthRef, err = splitPrimitiveArray(aux.PreAuthRef, r.PreAuthRefElement); err != nil {
+		return fmt.Errorf("preAuthRef: %w", err)
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefit) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"precedence":    "positiveInt",
+}
+
+func (r *ExplanationOfBenefit) elementTypes() map[string]string {
+	return explanationOfBenefitElementTypes
+}
+
+var explanationOfBenefitElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ExplanationOfBenefit.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ExplanationOfBenefit.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExplanationOfBenefit.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ExplanationOfBenefit.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ExplanationOfBenefit.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ExplanationOfBenefit.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.traceNumber", Name: "traceNumber", Field: 12, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExplanationOfBenefit.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "ExplanationOfBenefit.statusReason", Name: "statusReason", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.type", Name: "type", Field: 17, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.subType", Name: "subType", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.use", Name: "use", Field: 19, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExplanationOfBenefit.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-use"}},
+	{Path: "ExplanationOfBenefit.subject", Name: "subject", Field: 21, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ExplanationOfBenefit.billablePeriod", Name: "billablePeriod", Field: 22, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ExplanationOfBenefit.created", Name: "created", Field: 23, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ExplanationOfBenefit.enterer", Name: "enterer", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "ExplanationOfBenefit.insurer", Name: "insurer", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.provider", Name: "provider", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "ExplanationOfBenefit.priority", Name: "priority", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.fundsReserveRequested", Name: "fundsReserveRequested", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.fundsReserve", Name: "fundsReserve", Field: 30, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.related", Name: "related", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.prescription", Name: "prescription", Field: 32, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.originalPrescription", Name: "originalPrescription", Field: 33, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.event", Name: "event", Field: 34, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.payee", Name: "payee", Field: 35, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.referral", Name: "referral", Field: 36, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.encounter", Name: "encounter", Field: 37, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.facility", Name: "facility", Field: 38, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "ExplanationOfBenefit.claim", Name: "claim", Field: 39, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Claim"}},
+	{Path: "ExplanationOfBenefit.claimResponse", Name: "claimResponse", Field: 40, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.outcome", Name: "outcome", Field: 41, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ExplanationOfBenefit.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/claim-outcome"}},
+	{Path: "ExplanationOfBenefit.decision", Name: "decision", Field: 43, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.disposition", Name: "disposition", Field: 44, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.preAuthRef", Name: "preAuthRef", Field: 46, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.preAuthRefPeriod", Name: "preAuthRefPeriod", Field: 48, Min: 0, Max: "*", Types: []string{"Period"}},
+	{Path: "ExplanationOfBenefit.diagnosisRelatedGroup", Name: "diagnosisRelatedGroup", Field: 49, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.careTeam", Name: "careTeam", Field: 50, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.supportingInfo", Name: "supportingInfo", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.diagnosis", Name: "diagnosis", Field: 52, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.procedure", Name: "procedure", Field: 53, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.precedence", Name: "precedence", Field: 54, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.insurance", Name: "insurance", Field: 56, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.accident", Name: "accident", Field: 57, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.patientPaid", Name: "patientPaid", Field: 58, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item", Name: "item", Field: 59, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem", Name: "addItem", Field: 60, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.adjudication", Name: "adjudication", Field: 61, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.total", Name: "total", Field: 62, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.payment", Name: "payment", Field: 63, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.formCode", Name: "formCode", Field: 64, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.form", Name: "form", Field: 65, Min: 0, Max: "1", Types: []string{"Attachment"}},
+	{Path: "ExplanationOfBenefit.processNote", Name: "processNote", Field: 66, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.benefitPeriod", Name: "benefitPeriod", Field: 67, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ExplanationOfBenefit.benefitBalance", Name: "benefitBalance", Field: 68, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefit) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitElementMetadata
+}
+
+func (r *ExplanationOfBenefit) GetResourceType() string {
+	return "ExplanationOfBenefit"
+}
+
+func (r *ExplanationOfBenefit) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ExplanationOfBenefit) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ExplanationOfBenefit) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ExplanationOfBenefit) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ExplanationOfBenefit) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ExplanationOfBenefit) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ExplanationOfBenefit) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ExplanationOfBenefit)(nil)
+
+type ExplanationOfBenefitRelated struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Claim             *Reference       `json:"claim,omitempty" bson:"claim,omitempty"`                          // Reference to the related claim
+	Relationship      *CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"`            // How the reference claim is related
+	Reference         *Identifier      `json:"reference,omitempty" bson:"reference,omitempty"`                  // File or case reference
+}
+
+func (r *ExplanationOfBenefitRelated) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Claim != nil {
+		if err := r.Claim.Validate(); err != nil {
+			return fmt.Errorf("Claim: %w", err)
+		}
+	}
+	if r.Relationship != nil {
+		if err := r.Relationship.Validate(); err != nil {
+			return fmt.Errorf("Relationship: %w", err)
+		}
+	}
+	if r.Reference != nil {
+		if err := r.Reference.Validate(); err != nil {
+			return fmt.Errorf("Reference: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitRelated) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Claim != nil {
+		r.Claim.validateAll(path+".claim", issues)
+	}
+	if r.Relationship != nil {
+		r.Relationship.validateAll(path+".relationship", issues)
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+}
+
+var explanationOfBenefitRelatedInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitRelated) invariants() []invariant {
+	return explanationOfBenefitRelatedInvariants
+}
+
+func (r ExplanationOfBenefitRelated) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitRelated) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitRelated) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitRelated) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitRelatedElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.related.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.related.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.related.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.related.claim", Name: "claim", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.related.relationship", Name: "relationship", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.related.reference", Name: "reference", Field: 5, Min: 0, Max: "1", Types: []string{"Identifier"}},
+}
+
+func (r *ExplanationOfBenefitRelated) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitRelatedElementMetadata
+}
+
+type ExplanationOfBenefitEvent struct {
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept              `json:"type" bson:"type"`                                                // Specific event
+	When              ExplanationOfBenefitEventWhen `json:"-" bson:"when,omitempty"`                                         // Occurance date or period
+	WhenElement       *Element                      `json:"-" bson:"when_element,omitempty"`                                 // Extensions for when[x]
+
+	whenVariants []string // JSON properties of ExplanationOfBenefit.event.when[x] when more than one was decoded
+}
+
+func (r *ExplanationOfBenefitEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.whenVariants) > 1 {
+		return fmt.Errorf("field 'When' must have a single type, got %v", r.whenVariants)
+	}
+	if r.When == nil {
+		return fmt.Errorf("field 'When' is required")
+	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
+		}
+	}
+	if r.WhenElement != nil {
+		if err := r.WhenElement.Validate(); err != nil {
+			return fmt.Errorf("WhenElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.whenVariants) > 1 {
+		issues.add("structure", path+".when", fmt.Sprintf("field 'When' must have a single type, got %v", r.whenVariants))
+	}
+	if r.When == nil {
+		issues.add("required", path+".when", "field 'When' is required")
+	}
+	if r.When != nil {
+		r.When.validateAll(path+".when.ofType("+r.When.FHIRType()+")", issues)
+	}
+	if r.WhenElement != nil {
+		r.WhenElement.validateAll(path+".when", issues)
+	}
+}
+
+var explanationOfBenefitEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitEvent) invariants() []invariant {
+	return explanationOfBenefitEventInvariants
+}
+
+func (r ExplanationOfBenefitEvent) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitEvent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "when", r.When, r.WhenElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitEvent) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitEvent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.When, element, r.whenVariants, err = unmarshalChoice(fields, "when", explanationOfBenefitEventWhenVariants); err != nil {
+		return err
+	}
+	r.WhenElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.WhenElement); err != nil {
+			return fmt.Errorf("_when: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitEvent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "When":
+		return "when", choiceValues(explanationOfBenefitEventWhenVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitEventElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.event.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.event.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.event.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.event.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.event.when[x]", Name: "when", Field: 4, Min: 1, Max: "1", Types: []string{"dateTime", "Period"}},
+}
+
+func (r *ExplanationOfBenefitEvent) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitEventElementMetadata
+}
+
+// ExplanationOfBenefitEventWhen is the type of ExplanationOfBenefit.event.when[x]. It is implemented by the ExplanationOfBenefitEventWhen* variant types.
+type ExplanationOfBenefitEventWhen interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitEventWhen()
+}
+
+// ExplanationOfBenefitEventWhenDateTime is the dateTime variant of ExplanationOfBenefitEventWhen.
+type ExplanationOfBenefitEventWhenDateTime struct {
+	DateTime
+}
+
+func (v ExplanationOfBenefitEventWhenDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ExplanationOfBenefitEventWhenDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitEventWhenDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ExplanationOfBenefitEventWhenDateTime) isExplanationOfBenefitEventWhen() {}
+
+// ExplanationOfBenefitEventWhenPeriod is the Period variant of ExplanationOfBenefitEventWhen.
+type ExplanationOfBenefitEventWhenPeriod struct {
+	Period
+}
+
+func (v ExplanationOfBenefitEventWhenPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ExplanationOfBenefitEventWhenPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitEventWhenPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ExplanationOfBenefitEventWhenPeriod) isExplanationOfBenefitEventWhen() {}
+
+var explanationOfBenefitEventWhenVariants = []ExplanationOfBenefitEventWhen{
+	ExplanationOfBenefitEventWhenDateTime{},
+	ExplanationOfBenefitEventWhenPeriod{},
+}
+
+type ExplanationOfBenefitPayee struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Category of recipient
+	Party             *Reference       `json:"party,omitempty" bson:"party,omitempty"`                          // Recipient reference
+}
+
+var explanationOfBenefitPayeePartyTargets = []string{"Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+func (r *ExplanationOfBenefitPayee) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, explanationOfBenefitPayeePartyTargets); target != "" {
+			return fmt.Errorf("field 'Party' cannot refer to %s, expected one of %v", target, explanationOfBenefitPayeePartyTargets)
+		}
+	}
+	if r.Party != nil {
+		if err := r.Party.Validate(); err != nil {
+			return fmt.Errorf("Party: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitPayee) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Party != nil {
+		if target := invalidReferenceTarget(r.Party.Reference, r.Party.Type, explanationOfBenefitPayeePartyTargets); target != "" {
+			issues.add("value", path+".party", fmt.Sprintf("field 'Party' cannot refer to %s, expected one of %v", target, explanationOfBenefitPayeePartyTargets))
+		}
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+}
+
+var explanationOfBenefitPayeeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitPayee) invariants() []invariant {
+	return explanationOfBenefitPayeeInvariants
+}
+
+func (r ExplanationOfBenefitPayee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitPayee) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitPayee) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitPayee) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitPayeeElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.payee.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.payee.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.payee.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.payee.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.payee.party", Name: "party", Field: 4, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *ExplanationOfBenefitPayee) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitPayeeElementMetadata
+}
+
+type ExplanationOfBenefitCareTeam struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int              `json:"sequence" bson:"sequence"`                                        // Order of care team
+	SequenceElement   *Element         `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Provider          *Reference       `json:"provider" bson:"provider"`                                        // Practitioner or organization
+	Role              *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // Function within the team
+	Specialty         *CodeableConcept `json:"specialty,omitempty" bson:"specialty,omitempty"`                  // Practitioner or provider specialization
+}
+
+var explanationOfBenefitCareTeamProviderTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+func (r *ExplanationOfBenefitCareTeam) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.Provider == nil {
+		return fmt.Errorf("field 'Provider' is required")
+	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, explanationOfBenefitCareTeamProviderTargets); target != "" {
+			return fmt.Errorf("field 'Provider' cannot refer to %s, expected one of %v", target, explanationOfBenefitCareTeamProviderTargets)
+		}
+	}
+	if r.Provider != nil {
+		if err := r.Provider.Validate(); err != nil {
+			return fmt.Errorf("Provider: %w", err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	if r.Specialty != nil {
+		if err := r.Specialty.Validate(); err != nil {
+			return fmt.Errorf("Specialty: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitCareTeam) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Provider == nil {
+		issues.add("required", path+".provider", "field 'Provider' is required")
+	}
+	if r.Provider != nil {
+		if target := invalidReferenceTarget(r.Provider.Reference, r.Provider.Type, explanationOfBenefitCareTeamProviderTargets); target != "" {
+			issues.add("value", path+".provider", fmt.Sprintf("field 'Provider' cannot refer to %s, expected one of %v", target, explanationOfBenefitCareTeamProviderTargets))
+		}
+	}
+	if r.Provider != nil {
+		r.Provider.validateAll(path+".provider", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Specialty != nil {
+		r.Specialty.validateAll(path+".specialty", issues)
+	}
+}
+
+var explanationOfBenefitCareTeamInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitCareTeam) invariants() []invariant {
+	return explanationOfBenefitCareTeamInvariants
+}
+
+func (r ExplanationOfBenefitCareTeam) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitCareTeam) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitCareTeam) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitCareTeam) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitCareTeamElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitCareTeam) elementTypes() map[string]string {
+	return explanationOfBenefitCareTeamElementTypes
+}
+
+var explanationOfBenefitCareTeamElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.careTeam.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.careTeam.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.careTeam.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.careTeam.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.careTeam.provider", Name: "provider", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "ExplanationOfBenefit.careTeam.role", Name: "role", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.careTeam.specialty", Name: "specialty", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ExplanationOfBenefitCareTeam) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitCareTeamElementMetadata
+}
+
+type ExplanationOfBenefitSupportingInfo struct {
+	Id                *string                                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                                      `json:"sequence" bson:"sequence"`                                        // Information instance identifier
+	SequenceElement   *Element                                 `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Category          *CodeableConcept                         `json:"category" bson:"category"`                                        // Classification of the supplied information
+	Code              *CodeableConcept                         `json:"code,omitempty" bson:"code,omitempty"`                            // Type of information
+	Timing            ExplanationOfBenefitSupportingInfoTiming `json:"-" bson:"timing,omitempty"`                                       // When it occurred
+	TimingElement     *Element                                 `json:"-" bson:"timing_element,omitempty"`                               // Extensions for timing[x]
+	Value             ExplanationOfBenefitSupportingInfoValue  `json:"-" bson:"value,omitempty"`                                        // Data to be provided
+	ValueElement      *Element                                 `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Reason            *Coding                                  `json:"reason,omitempty" bson:"reason,omitempty"`                        // Explanation for the information
+
+	timingVariants []string // JSON properties of ExplanationOfBenefit.supportingInfo.timing[x] when more than one was decoded
+	valueVariants  []string // JSON properties of ExplanationOfBenefit.supportingInfo.value[x] when more than one was decoded
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
+	}
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
+		}
+	}
+	if r.TimingElement != nil {
+		if err := r.TimingElement.Validate(); err != nil {
+			return fmt.Errorf("TimingElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.Reason != nil {
+		if err := r.Reason.Validate(); err != nil {
+			return fmt.Errorf("Reason: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if r.TimingElement != nil {
+		r.TimingElement.validateAll(path+".timing", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+}
+
+var explanationOfBenefitSupportingInfoInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) invariants() []invariant {
+	return explanationOfBenefitSupportingInfoInvariants
+}
+
+func (r ExplanationOfBenefitSupportingInfo) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitSupportingInfo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, r.TimingElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitSupportingInfo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Timing, element, r.timingVariants, err = unmarshalChoice(fields, "timing", explanationOfBenefitSupportingInfoTimingVariants); err != nil {
+		return err
+	}
+	r.TimingElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TimingElement); err != nil {
+			return fmt.Errorf("_timing: %w", err)
+		}
+	}
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", explanationOfBenefitSupportingInfoValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitSupportingInfo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Timing":
+		return "timing", choiceValues(explanationOfBenefitSupportingInfoTimingVariants)
+	case "Value":
+		return "value", choiceValues(explanationOfBenefitSupportingInfoValueVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitSupportingInfoElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) elementTypes() map[string]string {
+	return explanationOfBenefitSupportingInfoElementTypes
+}
+
+var explanationOfBenefitSupportingInfoElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.supportingInfo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.supportingInfo.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.category", Name: "category", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.code", Name: "code", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.timing[x]", Name: "timing", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.value[x]", Name: "value", Field: 9, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}},
+	{Path: "ExplanationOfBenefit.supportingInfo.reason", Name: "reason", Field: 11, Min: 0, Max: "1", Types: []string{"Coding"}},
+}
+
+func (r *ExplanationOfBenefitSupportingInfo) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitSupportingInfoElementMetadata
+}
+
+// ExplanationOfBenefitSupportingInfoTiming is the type of ExplanationOfBenefit.supportingInfo.timing[x]. It is implemented by the ExplanationOfBenefitSupportingInfoTiming* variant types.
+type ExplanationOfBenefitSupportingInfoTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitSupportingInfoTiming()
+}
+
+// ExplanationOfBenefitSupportingInfoTimingDateTime is the dateTime variant of ExplanationOfBenefitSupportingInfoTiming.
+type ExplanationOfBenefitSupportingInfoTimingDateTime struct {
+	DateTime
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingDateTime) isExplanationOfBenefitSupportingInfoTiming() {
+}
+
+// ExplanationOfBenefitSupportingInfoTimingPeriod is the Period variant of ExplanationOfBenefitSupportingInfoTiming.
+type ExplanationOfBenefitSupportingInfoTimingPeriod struct {
+	Period
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingPeriod) isExplanationOfBenefitSupportingInfoTiming() {
+}
+
+// ExplanationOfBenefitSupportingInfoTimingTiming is the Timing variant of ExplanationOfBenefitSupportingInfoTiming.
+type ExplanationOfBenefitSupportingInfoTimingTiming struct {
+	Timing
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ExplanationOfBenefitSupportingInfoTimingTiming) isExplanationOfBenefitSupportingInfoTiming() {
+}
+
+var explanationOfBenefitSupportingInfoTimingVariants = []ExplanationOfBenefitSupportingInfoTiming{
+	ExplanationOfBenefitSupportingInfoTimingDateTime{},
+	ExplanationOfBenefitSupportingInfoTimingPeriod{},
+	ExplanationOfBenefitSupportingInfoTimingTiming{},
+}
+
+// ExplanationOfBenefitSupportingInfoValue is the type of ExplanationOfBenefit.supportingInfo.value[x]. It is implemented by the ExplanationOfBenefitSupportingInfoValue* variant types.
+type ExplanationOfBenefitSupportingInfoValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitSupportingInfoValue()
+}
+
+// ExplanationOfBenefitSupportingInfoValueBase64Binary is the base64Binary variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueBase64Binary string
+
+func (v ExplanationOfBenefitSupportingInfoValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueBase64Binary) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueBase64Binary) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueBoolean is the boolean variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueBoolean bool
+
+func (v ExplanationOfBenefitSupportingInfoValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueBoolean) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueCanonical is the canonical variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueCanonical string
+
+func (v ExplanationOfBenefitSupportingInfoValueCanonical) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCanonical) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueCode is the code variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueCode string
+
+func (v ExplanationOfBenefitSupportingInfoValueCode) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCode) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCode) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueDate is the date variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDate struct {
+	Date
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDate) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueDateTime is the dateTime variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDateTime struct {
+	DateTime
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDateTime) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueDecimal is the decimal variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDecimal struct {
+	Decimal
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDecimal) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueId is the id variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueId string
+
+func (v ExplanationOfBenefitSupportingInfoValueId) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueId) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueId) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueInstant is the instant variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueInstant struct {
+	Instant
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInstant) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueInteger is the integer variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueInteger int
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueInteger64 is the integer64 variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueInteger64 int64
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger64) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueInteger64) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueMarkdown is the markdown variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueMarkdown string
+
+func (v ExplanationOfBenefitSupportingInfoValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMarkdown) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueOid is the oid variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueOid string
+
+func (v ExplanationOfBenefitSupportingInfoValueOid) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueOid) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueOid) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValuePositiveInt is the positiveInt variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValuePositiveInt int
+
+func (v ExplanationOfBenefitSupportingInfoValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePositiveInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePositiveInt) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueString is the string variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueString string
+
+func (v ExplanationOfBenefitSupportingInfoValueString) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueString) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueTime is the time variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueTime struct {
+	Time
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTime) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueUnsignedInt is the unsignedInt variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueUnsignedInt int
+
+func (v ExplanationOfBenefitSupportingInfoValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUnsignedInt) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueUri is the uri variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueUri string
+
+func (v ExplanationOfBenefitSupportingInfoValueUri) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUri) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUri) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueUrl is the url variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueUrl string
+
+func (v ExplanationOfBenefitSupportingInfoValueUrl) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUrl) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUrl) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueUuid is the uuid variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueUuid struct {
+	uuid
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUuid) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueAddress is the Address variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueAddress struct {
+	Address
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAddress) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueAge is the Age variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueAge struct {
+	Age
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAge) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueAnnotation is the Annotation variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueAnnotation struct {
+	Annotation
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAnnotation) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueAttachment is the Attachment variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueAttachment struct {
+	Attachment
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAttachment) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueCodeableConcept is the CodeableConcept variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableConcept) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueCodeableReference is the CodeableReference variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCodeableReference) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueCoding is the Coding variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueCoding struct {
+	Coding
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCoding) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueContactPoint is the ContactPoint variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactPoint) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueCount is the Count variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueCount struct {
+	Count
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueCount) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueDistance is the Distance variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDistance struct {
+	Distance
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDistance) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueDuration is the Duration variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDuration struct {
+	Duration
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDuration) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueHumanName is the HumanName variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueHumanName struct {
+	HumanName
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueHumanName) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueIdentifier is the Identifier variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueIdentifier struct {
+	Identifier
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueIdentifier) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueMoney is the Money variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueMoney struct {
+	Money
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMoney) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValuePeriod is the Period variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValuePeriod struct {
+	Period
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValuePeriod) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueQuantity is the Quantity variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueQuantity struct {
+	Quantity
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueQuantity) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueRange is the Range variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueRange struct {
+	Range
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRange) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueRatio is the Ratio variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueRatio struct {
+	Ratio
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatio) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueRatioRange is the RatioRange variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueRatioRange struct {
+	RatioRange
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRatioRange) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueReference is the Reference variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueReference struct {
+	Reference
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueReference) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueSampledData is the SampledData variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueSampledData struct {
+	SampledData
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSampledData) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueSignature is the Signature variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueSignature struct {
+	Signature
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueSignature) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueTiming is the Timing variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueTiming struct {
+	Timing
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTiming) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueContactDetail is the ContactDetail variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueContactDetail) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueDataRequirement is the DataRequirement variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDataRequirement) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueExpression is the Expression variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueExpression struct {
+	Expression
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExpression) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueParameterDefinition is the ParameterDefinition variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueParameterDefinition) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueRelatedArtifact is the RelatedArtifact variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueRelatedArtifact) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueTriggerDefinition is the TriggerDefinition variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueTriggerDefinition) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueUsageContext is the UsageContext variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueUsageContext struct {
+	UsageContext
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueUsageContext) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueAvailability is the Availability variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueAvailability struct {
+	Availability
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueAvailability) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueExtendedContactDetail is the ExtendedContactDetail variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueExtendedContactDetail) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail is the VirtualServiceDetail variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail) isExplanationOfBenefitSupportingInfoValue() {
+}
+
+// ExplanationOfBenefitSupportingInfoValueDosage is the Dosage variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueDosage struct {
+	Dosage
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueDosage) isExplanationOfBenefitSupportingInfoValue() {}
+
+// ExplanationOfBenefitSupportingInfoValueMeta is the Meta variant of ExplanationOfBenefitSupportingInfoValue.
+type ExplanationOfBenefitSupportingInfoValueMeta struct {
+	Meta
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ExplanationOfBenefitSupportingInfoValueMeta) isExplanationOfBenefitSupportingInfoValue() {}
+
+var explanationOfBenefitSupportingInfoValueVariants = []ExplanationOfBenefitSupportingInfoValue{
+	ExplanationOfBenefitSupportingInfoValueBase64Binary(""),
+	ExplanationOfBenefitSupportingInfoValueBoolean(false),
+	ExplanationOfBenefitSupportingInfoValueCanonical(""),
+	ExplanationOfBenefitSupportingInfoValueCode(""),
+	ExplanationOfBenefitSupportingInfoValueDate{},
+	ExplanationOfBenefitSupportingInfoValueDateTime{},
+	ExplanationOfBenefitSupportingInfoValueDecimal{},
+	ExplanationOfBenefitSupportingInfoValueId(""),
+	ExplanationOfBenefitSupportingInfoValueInstant{},
+	ExplanationOfBenefitSupportingInfoValueInteger(0),
+	ExplanationOfBenefitSupportingInfoValueInteger64(0),
+	ExplanationOfBenefitSupportingInfoValueMarkdown(""),
+	ExplanationOfBenefitSupportingInfoValueOid(""),
+	ExplanationOfBenefitSupportingInfoValuePositiveInt(0),
+	ExplanationOfBenefitSupportingInfoValueString(""),
+	ExplanationOfBenefitSupportingInfoValueTime{},
+	ExplanationOfBenefitSupportingInfoValueUnsignedInt(0),
+	ExplanationOfBenefitSupportingInfoValueUri(""),
+	ExplanationOfBenefitSupportingInfoValueUrl(""),
+	ExplanationOfBenefitSupportingInfoValueUuid{},
+	ExplanationOfBenefitSupportingInfoValueAddress{},
+	ExplanationOfBenefitSupportingInfoValueAge{},
+	ExplanationOfBenefitSupportingInfoValueAnnotation{},
+	ExplanationOfBenefitSupportingInfoValueAttachment{},
+	ExplanationOfBenefitSupportingInfoValueCodeableConcept{},
+	ExplanationOfBenefitSupportingInfoValueCodeableReference{},
+	ExplanationOfBenefitSupportingInfoValueCoding{},
+	ExplanationOfBenefitSupportingInfoValueContactPoint{},
+	ExplanationOfBenefitSupportingInfoValueCount{},
+	ExplanationOfBenefitSupportingInfoValueDistance{},
+	ExplanationOfBenefitSupportingInfoValueDuration{},
+	ExplanationOfBenefitSupportingInfoValueHumanName{},
+	ExplanationOfBenefitSupportingInfoValueIdentifier{},
+	ExplanationOfBenefitSupportingInfoValueMoney{},
+	ExplanationOfBenefitSupportingInfoValuePeriod{},
+	ExplanationOfBenefitSupportingInfoValueQuantity{},
+	ExplanationOfBenefitSupportingInfoValueRange{},
+	ExplanationOfBenefitSupportingInfoValueRatio{},
+	ExplanationOfBenefitSupportingInfoValueRatioRange{},
+	ExplanationOfBenefitSupportingInfoValueReference{},
+	ExplanationOfBenefitSupportingInfoValueSampledData{},
+	ExplanationOfBenefitSupportingInfoValueSignature{},
+	ExplanationOfBenefitSupportingInfoValueTiming{},
+	ExplanationOfBenefitSupportingInfoValueContactDetail{},
+	ExplanationOfBenefitSupportingInfoValueDataRequirement{},
+	ExplanationOfBenefitSupportingInfoValueExpression{},
+	ExplanationOfBenefitSupportingInfoValueParameterDefinition{},
+	ExplanationOfBenefitSupportingInfoValueRelatedArtifact{},
+	ExplanationOfBenefitSupportingInfoValueTriggerDefinition{},
+	ExplanationOfBenefitSupportingInfoValueUsageContext{},
+	ExplanationOfBenefitSupportingInfoValueAvailability{},
+	ExplanationOfBenefitSupportingInfoValueExtendedContactDetail{},
+	ExplanationOfBenefitSupportingInfoValueVirtualServiceDetail{},
+	ExplanationOfBenefitSupportingInfoValueDosage{},
+	ExplanationOfBenefitSupportingInfoValueMeta{},
+}
+
+type ExplanationOfBenefitDiagnosis struct {
+	Id                *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                                    `json:"sequence" bson:"sequence"`                                        // Diagnosis instance identifier
+	SequenceElement   *Element                               `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Diagnosis         ExplanationOfBenefitDiagnosisDiagnosis `json:"-" bson:"diagnosis,omitempty"`                                    // Nature of illness or problem
+	Type              []CodeableConcept                      `json:"type,omitempty" bson:"type,omitempty"`                            // Timing or nature of the diagnosis
+	OnAdmission       *CodeableConcept                       `json:"onAdmission,omitempty" bson:"on_admission,omitempty"`             // Present on admission
+
+	diagnosisVariants []string // JSON properties of ExplanationOfBenefit.diagnosis.diagnosis[x] when more than one was decoded
+}
+
+func (r *ExplanationOfBenefitDiagnosis) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if len(r.diagnosisVariants) > 1 {
+		return fmt.Errorf("field 'Diagnosis' must have a single type, got %v", r.diagnosisVariants)
+	}
+	if r.Diagnosis == nil {
+		return fmt.Errorf("field 'Diagnosis' is required")
+	}
+	if r.Diagnosis != nil {
+		if err := r.Diagnosis.Validate(); err != nil {
+			return fmt.Errorf("Diagnosis: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	if r.OnAdmission != nil {
+		if err := r.OnAdmission.Validate(); err != nil {
+			return fmt.Errorf("OnAdmission: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitDiagnosis) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if len(r.diagnosisVariants) > 1 {
+		issues.add("structure", path+".diagnosis", fmt.Sprintf("field 'Diagnosis' must have a single type, got %v", r.diagnosisVariants))
+	}
+	if r.Diagnosis == nil {
+		issues.add("required", path+".diagnosis", "field 'Diagnosis' is required")
+	}
+	if r.Diagnosis != nil {
+		r.Diagnosis.validateAll(path+".diagnosis.ofType("+r.Diagnosis.FHIRType()+")", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.OnAdmission != nil {
+		r.OnAdmission.validateAll(path+".onAdmission", issues)
+	}
+}
+
+var explanationOfBenefitDiagnosisInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitDiagnosis) invariants() []invariant {
+	return explanationOfBenefitDiagnosisInvariants
+}
+
+func (r ExplanationOfBenefitDiagnosis) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitDiagnosis
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "diagnosis", r.Diagnosis, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitDiagnosis
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Diagnosis, _, r.diagnosisVariants, err = unmarshalChoice(fields, "diagnosis", explanationOfBenefitDiagnosisDiagnosisVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitDiagnosis) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitDiagnosis) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitDiagnosis) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Diagnosis":
+		return "diagnosis", choiceValues(explanationOfBenefitDiagnosisDiagnosisVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitDiagnosisElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitDiagnosis) elementTypes() map[string]string {
+	return explanationOfBenefitDiagnosisElementTypes
+}
+
+var explanationOfBenefitDiagnosisElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.diagnosis.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.diagnosis.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.diagnosis.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.diagnosis.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.diagnosis.diagnosis[x]", Name: "diagnosis", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "ExplanationOfBenefit.diagnosis.type", Name: "type", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.diagnosis.onAdmission", Name: "onAdmission", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ExplanationOfBenefitDiagnosis) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitDiagnosisElementMetadata
+}
+
+// ExplanationOfBenefitDiagnosisDiagnosis is the type of ExplanationOfBenefit.diagnosis.diagnosis[x]. It is implemented by the ExplanationOfBenefitDiagnosisDiagnosis* variant types.
+type ExplanationOfBenefitDiagnosisDiagnosis interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitDiagnosisDiagnosis()
+}
+
+// ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept is the CodeableConcept variant of ExplanationOfBenefitDiagnosisDiagnosis.
+type ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept) isExplanationOfBenefitDiagnosisDiagnosis() {
+}
+
+// ExplanationOfBenefitDiagnosisDiagnosisReference is the Reference variant of ExplanationOfBenefitDiagnosisDiagnosis.
+type ExplanationOfBenefitDiagnosisDiagnosisReference struct {
+	Reference
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExplanationOfBenefitDiagnosisDiagnosisReference) isExplanationOfBenefitDiagnosisDiagnosis() {}
+
+var explanationOfBenefitDiagnosisDiagnosisVariants = []ExplanationOfBenefitDiagnosisDiagnosis{
+	ExplanationOfBenefitDiagnosisDiagnosisCodeableConcept{},
+	ExplanationOfBenefitDiagnosisDiagnosisReference{},
+}
+
+type ExplanationOfBenefitProcedure struct {
+	Id                *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          int                                    `json:"sequence" bson:"sequence"`                                        // Procedure instance identifier
+	SequenceElement   *Element                               `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Type              []CodeableConcept                      `json:"type,omitempty" bson:"type,omitempty"`                            // Category of Procedure
+	Date              *DateTime                              `json:"date,omitempty" bson:"date,omitempty"`                            // When the procedure was performed
+	DateElement       *Element                               `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Procedure         ExplanationOfBenefitProcedureProcedure `json:"-" bson:"procedure,omitempty"`                                    // Specific clinical procedure
+	Udi               []Reference                            `json:"udi,omitempty" bson:"udi,omitempty"`                              // Unique device identifier
+
+	procedureVariants []string // JSON properties of ExplanationOfBenefit.procedure.procedure[x] when more than one was decoded
+}
+
+var explanationOfBenefitProcedureUdiTargets = []string{"Device"}
+
+func (r *ExplanationOfBenefitProcedure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if len(r.procedureVariants) > 1 {
+		return fmt.Errorf("field 'Procedure' must have a single type, got %v", r.procedureVariants)
+	}
+	if r.Procedure == nil {
+		return fmt.Errorf("field 'Procedure' is required")
+	}
+	if r.Procedure != nil {
+		if err := r.Procedure.Validate(); err != nil {
+			return fmt.Errorf("Procedure: %w", err)
+		}
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitProcedureUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitProcedureUdiTargets)
+		}
+	}
+	for i, item := range r.Udi {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Udi[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitProcedure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if len(r.procedureVariants) > 1 {
+		issues.add("structure", path+".procedure", fmt.Sprintf("field 'Procedure' must have a single type, got %v", r.procedureVariants))
+	}
+	if r.Procedure == nil {
+		issues.add("required", path+".procedure", "field 'Procedure' is required")
+	}
+	if r.Procedure != nil {
+		r.Procedure.validateAll(path+".procedure.ofType("+r.Procedure.FHIRType()+")", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitProcedureUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitProcedureUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+}
+
+var explanationOfBenefitProcedureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitProcedure) invariants() []invariant {
+	return explanationOfBenefitProcedureInvariants
+}
+
+func (r ExplanationOfBenefitProcedure) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitProcedure
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "procedure", r.Procedure, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitProcedure) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitProcedure
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Procedure, _, r.procedureVariants, err = unmarshalChoice(fields, "procedure", explanationOfBenefitProcedureProcedureVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitProcedure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitProcedure) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Procedure":
+		return "procedure", choiceValues(explanationOfBenefitProcedureProcedureVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitProcedureElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitProcedure) elementTypes() map[string]string {
+	return explanationOfBenefitProcedureElementTypes
+}
+
+var explanationOfBenefitProcedureElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.procedure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.procedure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.procedure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.procedure.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.procedure.type", Name: "type", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.procedure.date", Name: "date", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ExplanationOfBenefit.procedure.procedure[x]", Name: "procedure", Field: 8, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "ExplanationOfBenefit.procedure.udi", Name: "udi", Field: 9, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+}
+
+func (r *ExplanationOfBenefitProcedure) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitProcedureElementMetadata
+}
+
+// ExplanationOfBenefitProcedureProcedure is the type of ExplanationOfBenefit.procedure.procedure[x]. It is implemented by the ExplanationOfBenefitProcedureProcedure* variant types.
+type ExplanationOfBenefitProcedureProcedure interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitProcedureProcedure()
+}
+
+// ExplanationOfBenefitProcedureProcedureCodeableConcept is the CodeableConcept variant of ExplanationOfBenefitProcedureProcedure.
+type ExplanationOfBenefitProcedureProcedureCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ExplanationOfBenefitProcedureProcedureCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ExplanationOfBenefitProcedureProcedureCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitProcedureProcedureCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ExplanationOfBenefitProcedureProcedureCodeableConcept) isExplanationOfBenefitProcedureProcedure() {
+}
+
+// ExplanationOfBenefitProcedureProcedureReference is the Reference variant of ExplanationOfBenefitProcedureProcedure.
+type ExplanationOfBenefitProcedureProcedureReference struct {
+	Reference
+}
+
+func (v ExplanationOfBenefitProcedureProcedureReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ExplanationOfBenefitProcedureProcedureReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitProcedureProcedureReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExplanationOfBenefitProcedureProcedureReference) isExplanationOfBenefitProcedureProcedure() {}
+
+var explanationOfBenefitProcedureProcedureVariants = []ExplanationOfBenefitProcedureProcedure{
+	ExplanationOfBenefitProcedureProcedureCodeableConcept{},
+	ExplanationOfBenefitProcedureProcedureReference{},
+}
+
+type ExplanationOfBenefitInsurance struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Focal             bool        `json:"focal" bson:"focal"`                                              // Coverage to be used for adjudication
+	FocalElement      *Element    `json:"_focal,omitempty" bson:"focal_element,omitempty"`                 // Extensions for focal
+	Coverage          *Reference  `json:"coverage" bson:"coverage"`                                        // Insurance information
+	PreAuthRef        []string    `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`              // Prior authorization reference number
+	PreAuthRefElement []*Element  `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`     // Extensions for preAuthRef
+}
+
+func (r *ExplanationOfBenefitInsurance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.FocalElement != nil {
+		if err := r.FocalElement.Validate(); err != nil {
+			return fmt.Errorf("FocalElement: %w", err)
+		}
+	}
+	if r.Coverage == nil {
+		return fmt.Errorf("field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		if err := r.Coverage.Validate(); err != nil {
+			return fmt.Errorf("Coverage: %w", err)
+		}
+	}
+	for i, item := range r.PreAuthRefElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitInsurance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.FocalElement != nil {
+		r.FocalElement.validateAll(path+".focal", issues)
+	}
+	if r.Coverage == nil {
+		issues.add("required", path+".coverage", "field 'Coverage' is required")
+	}
+	if r.Coverage != nil {
+		r.Coverage.validateAll(path+".coverage", issues)
+	}
+	for i, item := range r.PreAuthRefElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.preAuthRef[%d]", path, i), issues)
+	}
+}
+
+var explanationOfBenefitInsuranceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitInsurance) invariants() []invariant {
+	return explanationOfBenefitInsuranceInvariants
+}
+
+func (r ExplanationOfBenefitInsurance) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitInsurance
+	out := struct {
+		alias
+		PreAuthRef        []*string  `json:"preAuthRef,omitempty"`
+		PreAuthRefElement []*Element `json:"_preAuthRef,omitempty"`
+	}{alias: alias(r)}
+	out.PreAuthRef, out.PreAuthRefElement = alignPrimitiveArray(r.PreAuthRef, r.PreAuthRefElement)
+	return json.Marshal(out)
+}
+
+func (r *ExplanationOfBenefitInsurance) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitInsurance
+	aux := struct {
+		*alias
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.PreAuthRef, err = splitPrimitiveArray(aux.PreAuthRef, r.PreAuthRefElement); err != nil {
+		return fmt.Errorf("preAuthRef: %w", err)
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitInsurance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitInsurance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitInsurance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitInsurance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitInsuranceElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.insurance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.insurance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.insurance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.insurance.focal", Name: "focal", Field: 3, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "ExplanationOfBenefit.insurance.coverage", Name: "coverage", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Coverage"}},
+	{Path: "ExplanationOfBenefit.insurance.preAuthRef", Name: "preAuthRef", Field: 6, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *ExplanationOfBenefitInsurance) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitInsuranceElementMetadata
+}
+
+type ExplanationOfBenefitAccident struct {
+	Id                *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Date              *Date                                `json:"date,omitempty" bson:"date,omitempty"`                            // When the incident occurred
+	DateElement       *Element                             `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Type              *CodeableConcept                     `json:"type,omitempty" bson:"type,omitempty"`                            // The nature of the accident
+	Location          ExplanationOfBenefitAccidentLocation `json:"-" bson:"location,omitempty"`                                     // Where the event occurred
+
+	locationVariants []string // JSON properties of ExplanationOfBenefit.accident.location[x] when more than one was decoded
+}
+
+func (r *ExplanationOfBenefitAccident) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.locationVariants) > 1 {
+		return fmt.Errorf("field 'Location' must have a single type, got %v", r.locationVariants)
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitAccident) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.locationVariants) > 1 {
+		issues.add("structure", path+".location", fmt.Sprintf("field 'Location' must have a single type, got %v", r.locationVariants))
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location.ofType("+r.Location.FHIRType()+")", issues)
+	}
+}
+
+var explanationOfBenefitAccidentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitAccident) invariants() []invariant {
+	return explanationOfBenefitAccidentInvariants
+}
+
+func (r ExplanationOfBenefitAccident) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitAccident
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "location", r.Location, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitAccident) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitAccident
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Location, _, r.locationVariants, err = unmarshalChoice(fields, "location", explanationOfBenefitAccidentLocationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitAccident) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitAccident) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitAccident) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitAccident) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitAccident) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Location":
+		return "location", choiceValues(explanationOfBenefitAccidentLocationVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitAccidentElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.accident.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.accident.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.accident.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.accident.date", Name: "date", Field: 3, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ExplanationOfBenefit.accident.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.accident.location[x]", Name: "location", Field: 6, Min: 0, Max: "1", Types: []string{"Address", "Reference"}},
+}
+
+func (r *ExplanationOfBenefitAccident) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitAccidentElementMetadata
+}
+
+// ExplanationOfBenefitAccidentLocation is the type of ExplanationOfBenefit.accident.location[x]. It is implemented by the ExplanationOfBenefitAccidentLocation* variant types.
+type ExplanationOfBenefitAccidentLocation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitAccidentLocation()
+}
+
+// ExplanationOfBenefitAccidentLocationAddress is the Address variant of ExplanationOfBenefitAccidentLocation.
+type ExplanationOfBenefitAccidentLocationAddress struct {
+	Address
+}
+
+func (v ExplanationOfBenefitAccidentLocationAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ExplanationOfBenefitAccidentLocationAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitAccidentLocationAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ExplanationOfBenefitAccidentLocationAddress) isExplanationOfBenefitAccidentLocation() {}
+
+// ExplanationOfBenefitAccidentLocationReference is the Reference variant of ExplanationOfBenefitAccidentLocation.
+type ExplanationOfBenefitAccidentLocationReference struct {
+	Reference
+}
+
+func (v ExplanationOfBenefitAccidentLocationReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ExplanationOfBenefitAccidentLocationReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitAccidentLocationReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExplanationOfBenefitAccidentLocationReference) isExplanationOfBenefitAccidentLocation() {}
+
+var explanationOfBenefitAccidentLocationVariants = []ExplanationOfBenefitAccidentLocation{
+	ExplanationOfBenefitAccidentLocationAddress{},
+	ExplanationOfBenefitAccidentLocationReference{},
+}
+
+type ExplanationOfBenefitItem struct {
+	Id                         *string                                `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	Sequence                   int                                    `json:"sequence" bson:"sequence"`                                                     // Item instance identifier
+	SequenceElement            *Element                               `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                        // Extensions for sequence
+	CareTeamSequence           []int                                  `json:"careTeamSequence,omitempty" bson:"care_team_sequence,omitempty"`               // Applicable care team members
+	CareTeamSequenceElement    []*Element                             `json:"_careTeamSequence,omitempty" bson:"care_team_sequence_element,omitempty"`      // Extensions for careTeamSequence
+	DiagnosisSequence          []int                                  `json:"diagnosisSequence,omitempty" bson:"diagnosis_sequence,omitempty"`              // Applicable diagnoses
+	DiagnosisSequenceElement   []*Element                             `json:"_diagnosisSequence,omitempty" bson:"diagnosis_sequence_element,omitempty"`     // Extensions for diagnosisSequence
+	ProcedureSequence          []int                                  `json:"procedureSequence,omitempty" bson:"procedure_sequence,omitempty"`              // Applicable procedures
+	ProcedureSequenceElement   []*Element                             `json:"_procedureSequence,omitempty" bson:"procedure_sequence_element,omitempty"`     // Extensions for procedureSequence
+	InformationSequence        []int                                  `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
+	InformationSequenceElement []*Element                             `json:"_informationSequence,omitempty" bson:"information_sequence_element,omitempty"` // Extensions for informationSequence
+	TraceNumber                []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
+	Subject                    *Reference                             `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
+	Revenue                    *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
+	Category                   *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
+	ProductOrService           *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
+	ProductOrServiceEnd        *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
+	Request                    []Reference                            `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
+	Modifier                   []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Product or service billing modifiers
+	ProgramCode                []CodeableConcept                      `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
+	Serviced                   ExplanationOfBenefitItemServiced       `json:"-" bson:"serviced,omitempty"`                                                  // Date or dates of service or product delivery
+	ServicedElement            *Element                               `json:"-" bson:"serviced_element,omitempty"`                                          // Extensions for serviced[x]
+	Location                   ExplanationOfBenefitItemLocation       `json:"-" bson:"location,omitempty"`                                                  // Place of service or where product was supplied
+	PatientPaid                *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                          // Paid by the patient
+	Quantity                   *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
+	UnitPrice                  *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
+	Factor                     *Decimal                               `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
+	FactorElement              *Element                               `json:"_factor,omitempty" bson:"factor_element,omitempty"`                            // Extensions for factor
+	Tax                        *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
+	Net                        *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
+	Udi                        []Reference                            `json:"udi,omitempty" bson:"udi,omitempty"`                                           // Unique device identifier
+	BodySite                   []ExplanationOfBenefitItemBodySite     `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
+	Encounter                  []Reference                            `json:"encounter,omitempty" bson:"encounter,omitempty"`                               // Encounters associated with the listed treatments
+	NoteNumber                 []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
+	NoteNumberElement          []*Element                             `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`                   // Extensions for noteNumber
+	ReviewOutcome              *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Adjudication results
+	Adjudication               []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Adjudication details
+	Detail                     []ExplanationOfBenefitItemDetail       `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Additional items
+
+	servicedVariants []string // JSON properties of ExplanationOfBenefit.item.serviced[x] when more than one was decoded
+	locationVariants []string // JSON properties of ExplanationOfBenefit.item.location[x] when more than one was decoded
+}
+
+var explanationOfBenefitItemSubjectTargets = []string{"Group", "Patient"}
+
+var explanationOfBenefitItemUdiTargets = []string{"Device"}
+
+var explanationOfBenefitItemEncounterTargets = []string{"Encounter"}
+
+func (r *ExplanationOfBenefitItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.CareTeamSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("CareTeamSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DiagnosisSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DiagnosisSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProcedureSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProcedureSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InformationSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, explanationOfBenefitItemSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, explanationOfBenefitItemSubjectTargets)
+		}
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.Revenue != nil {
+		if err := r.Revenue.Validate(); err != nil {
+			return fmt.Errorf("Revenue: %w", err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	if r.ProductOrServiceEnd != nil {
+		if err := r.ProductOrServiceEnd.Validate(); err != nil {
+			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+		}
+	}
+	for i, item := range r.Request {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Request[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProgramCode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+		}
+	}
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
+	}
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
+		}
+	}
+	if len(r.locationVariants) > 1 {
+		return fmt.Errorf("field 'Location' must have a single type, got %v", r.locationVariants)
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
+	if r.PatientPaid != nil {
+		if err := r.PatientPaid.Validate(); err != nil {
+			return fmt.Errorf("PatientPaid: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.Tax != nil {
+		if err := r.Tax.Validate(); err != nil {
+			return fmt.Errorf("Tax: %w", err)
+		}
+	}
+	if r.Net != nil {
+		if err := r.Net.Validate(); err != nil {
+			return fmt.Errorf("Net: %w", err)
+		}
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemUdiTargets)
+		}
+	}
+	for i, item := range r.Udi {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Udi[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.BodySite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BodySite[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Encounter {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemEncounterTargets)
+		}
+	}
+	for i, item := range r.Encounter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Encounter[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.ReviewOutcome != nil {
+		if err := r.ReviewOutcome.Validate(); err != nil {
+			return fmt.Errorf("ReviewOutcome: %w", err)
+		}
+	}
+	for i, item := range r.Adjudication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Detail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Detail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.CareTeamSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.careTeamSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.DiagnosisSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.diagnosisSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.ProcedureSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.procedureSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.informationSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, explanationOfBenefitItemSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, explanationOfBenefitItemSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Request {
+		item.validateAll(fmt.Sprintf("%s.request[%d]", path, i), issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if len(r.locationVariants) > 1 {
+		issues.add("structure", path+".location", fmt.Sprintf("field 'Location' must have a single type, got %v", r.locationVariants))
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location.ofType("+r.Location.FHIRType()+")", issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	for i, item := range r.Encounter {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemEncounterTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.encounter[%d]", path, i), fmt.Sprintf("field 'Encounter[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemEncounterTargets))
+		}
+	}
+	for i, item := range r.Encounter {
+		item.validateAll(fmt.Sprintf("%s.encounter[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
+	}
+}
+
+var explanationOfBenefitItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitItem) invariants() []invariant {
+	return explanationOfBenefitItemInvariants
+}
+
+func (r ExplanationOfBenefitItem) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitItem
+	out := struct {
+		alias
+		CareTeamSequence           []*int     `json:"careTeamSequence,omitempty"`
+		CareTeamSequenceElement    []*Element `json:"_careTeamSequence,omitempty"`
+		DiagnosisSequence          []*int     `json:"diagnosisSequence,omitempty"`
+		DiagnosisSequenceElement   []*Element `json:"_diagnosisSequence,omitempty"`
+		ProcedureSequence          []*int     `json:"procedureSequence,omitempty"`
+		ProcedureSequenceElement   []*Element `json:"_procedureSequence,omitempty"`
+		InformationSequence        []*int     `json:"informationSequence,omitempty"`
+		InformationSequenceElement []*Element `json:"_informationSequence,omitempty"`
+		NoteNumber                 []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement          []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.CareTeamSequence, out.CareTeamSequenceElement = alignPrimitiveArray(r.CareTeamSequence, r.CareTeamSequenceElement)
+	out.DiagnosisSequence, out.DiagnosisSequenceElement = alignPrimitiveArray(r.DiagnosisSequence, r.DiagnosisSequenceElement)
+	out.ProcedureSequence, out.ProcedureSequenceElement = alignPrimitiveArray(r.ProcedureSequence, r.ProcedureSequenceElement)
+	out.InformationSequence, out.InformationSequenceElement = alignPrimitiveArray(r.InformationSequence, r.InformationSequenceElement)
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "location", r.Location, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitItem) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitItem
+	aux := struct {
+		*alias
+		CareTeamSequence    []*int `json:"careTeamSequence,omitempty"`
+		DiagnosisSequence   []*int `json:"diagnosisSequence,omitempty"`
+		ProcedureSequence   []*int `json:"procedureSequence,omitempty"`
+		InformationSequence []*int `json:"informationSequence,omitempty"`
+		NoteNumber          []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.CareTeamSequence, err = splitPrimitiveArray(aux.CareTeamSequence, r.CareTeamSequenceElement); err != nil {
+		return fmt.Errorf("careTeamSequence: %w", err)
+	}
+	if r.DiagnosisSequence, err = splitPrimitiveArray(aux.DiagnosisSequence, r.DiagnosisSequenceElement); err != nil {
+		return fmt.Errorf("diagnosisSequence: %w", err)
+	}
+	if r.ProcedureSequence, err = splitPrimitiveArray(aux.ProcedureSequence, r.ProcedureSequenceElement); err != nil {
+		return fmt.Errorf("procedureSequence: %w", err)
+	}
+	if r.InformationSequence, err = splitPrimitiveArray(aux.InformationSequence, r.InformationSequenceElement); err != nil {
+		return fmt.Errorf("informationSequence: %w", err)
+	}
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", explanationOfBenefitItemServicedVariants); err != nil {
+		return err
+	}
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
+		}
+	}
+	if r.Location, _, r.locationVariants, err = unmarshalChoice(fields, "location", explanationOfBenefitItemLocationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(explanationOfBenefitItemServicedVariants)
+	case "Location":
+		return "location", choiceValues(explanationOfBenefitItemLocationVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitItemElementTypes = map[string]string{
+	"sequence":            "positiveInt",
+	"careTeamSequence":    "positiveInt",
+	"diagnosisSequence":   "positiveInt",
+	"procedureSequence":   "positiveInt",
+	"informationSequence": "positiveInt",
+	"noteNumber":          "positiveInt",
+}
+
+func (r *ExplanationOfBenefitItem) elementTypes() map[string]string {
+	return explanationOfBenefitItemElementTypes
+}
+
+var explanationOfBenefitItemElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.item.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.careTeamSequence", Name: "careTeamSequence", Field: 5, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.diagnosisSequence", Name: "diagnosisSequence", Field: 7, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.procedureSequence", Name: "procedureSequence", Field: 9, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.informationSequence", Name: "informationSequence", Field: 11, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.traceNumber", Name: "traceNumber", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.item.subject", Name: "subject", Field: 14, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ExplanationOfBenefit.item.revenue", Name: "revenue", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.category", Name: "category", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.productOrService", Name: "productOrService", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.productOrServiceEnd", Name: "productOrServiceEnd", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.request", Name: "request", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.item.modifier", Name: "modifier", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.programCode", Name: "programCode", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.serviced[x]", Name: "serviced", Field: 22, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "ExplanationOfBenefit.item.location[x]", Name: "location", Field: 24, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Address", "Reference"}},
+	{Path: "ExplanationOfBenefit.item.patientPaid", Name: "patientPaid", Field: 25, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.quantity", Name: "quantity", Field: 26, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.item.unitPrice", Name: "unitPrice", Field: 27, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.factor", Name: "factor", Field: 28, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ExplanationOfBenefit.item.tax", Name: "tax", Field: 30, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.net", Name: "net", Field: 31, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.udi", Name: "udi", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "ExplanationOfBenefit.item.bodySite", Name: "bodySite", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.item.encounter", Name: "encounter", Field: 34, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "ExplanationOfBenefit.item.noteNumber", Name: "noteNumber", Field: 35, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome", Name: "reviewOutcome", Field: 37, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.item.adjudication", Name: "adjudication", Field: 38, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.item.detail", Name: "detail", Field: 39, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefitItem) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitItemElementMetadata
+}
+
+// ExplanationOfBenefitItemServiced is the type of ExplanationOfBenefit.item.serviced[x]. It is implemented by the ExplanationOfBenefitItemServiced* variant types.
+type ExplanationOfBenefitItemServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitItemServiced()
+}
+
+// ExplanationOfBenefitItemServicedDate is the date variant of ExplanationOfBenefitItemServiced.
+type ExplanationOfBenefitItemServicedDate struct {
+	Date
+}
+
+func (v ExplanationOfBenefitItemServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ExplanationOfBenefitItemServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ExplanationOfBenefitItemServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v ExplanationOfBenefitItemServicedDate) isExplanationOfBenefitItemServiced() {}
+
+// ExplanationOfBenefitItemServicedPeriod is the Period variant of ExplanationOfBenefitItemServiced.
+type ExplanationOfBenefitItemServicedPeriod struct {
+	Period
+}
+
+func (v ExplanationOfBenefitItemServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ExplanationOfBenefitItemServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitItemServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ExplanationOfBenefitItemServicedPeriod) isExplanationOfBenefitItemServiced() {}
+
+var explanationOfBenefitItemServicedVariants = []ExplanationOfBenefitItemServiced{
+	ExplanationOfBenefitItemServicedDate{},
+	ExplanationOfBenefitItemServicedPeriod{},
+}
+
+// ExplanationOfBenefitItemLocation is the type of ExplanationOfBenefit.item.location[x]. It is implemented by the ExplanationOfBenefitItemLocation* variant types.
+type ExplanationOfBenefitItemLocation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitItemLocation()
+}
+
+// ExplanationOfBenefitItemLocationCodeableConcept is the CodeableConcept variant of ExplanationOfBenefitItemLocation.
+type ExplanationOfBenefitItemLocationCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ExplanationOfBenefitItemLocationCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ExplanationOfBenefitItemLocationCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitItemLocationCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ExplanationOfBenefitItemLocationCodeableConcept) isExplanationOfBenefitItemLocation() {}
+
+// ExplanationOfBenefitItemLocationAddress is the Address variant of ExplanationOfBenefitItemLocation.
+type ExplanationOfBenefitItemLocationAddress struct {
+	Address
+}
+
+func (v ExplanationOfBenefitItemLocationAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ExplanationOfBenefitItemLocationAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitItemLocationAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ExplanationOfBenefitItemLocationAddress) isExplanationOfBenefitItemLocation() {}
+
+// ExplanationOfBenefitItemLocationReference is the Reference variant of ExplanationOfBenefitItemLocation.
+type ExplanationOfBenefitItemLocationReference struct {
+	Reference
+}
+
+func (v ExplanationOfBenefitItemLocationReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ExplanationOfBenefitItemLocationReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitItemLocationReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExplanationOfBenefitItemLocationReference) isExplanationOfBenefitItemLocation() {}
+
+var explanationOfBenefitItemLocationVariants = []ExplanationOfBenefitItemLocation{
+	ExplanationOfBenefitItemLocationCodeableConcept{},
+	ExplanationOfBenefitItemLocationAddress{},
+	ExplanationOfBenefitItemLocationReference{},
+}
+
+type ExplanationOfBenefitItemBodySite struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Site              []CodeableReference `json:"site" bson:"site"`                                                // Location
+	SubSite           []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"`                     // Sub-location
+}
+
+func (r *ExplanationOfBenefitItemBodySite) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.Site) < 1 {
+		return fmt.Errorf("field 'Site' must have at least 1 elements")
+	}
+	for i, item := range r.Site {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Site[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubSite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubSite[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitItemBodySite) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Site) < 1 {
+		issues.add("required", path+".site", "field 'Site' must have at least 1 elements")
+	}
+	for i, item := range r.Site {
+		item.validateAll(fmt.Sprintf("%s.site[%d]", path, i), issues)
+	}
+	for i, item := range r.SubSite {
+		item.validateAll(fmt.Sprintf("%s.subSite[%d]", path, i), issues)
+	}
+}
+
+var explanationOfBenefitItemBodySiteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitItemBodySite) invariants() []invariant {
+	return explanationOfBenefitItemBodySiteInvariants
+}
+
+func (r ExplanationOfBenefitItemBodySite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitItemBodySite) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitItemBodySite) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitItemBodySite) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitItemBodySiteElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.item.bodySite.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.bodySite.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.item.bodySite.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.item.bodySite.site", Name: "site", Field: 3, Min: 1, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ExplanationOfBenefit.item.bodySite.subSite", Name: "subSite", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ExplanationOfBenefitItemBodySite) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitItemBodySiteElementMetadata
+}
+
+type ExplanationOfBenefitItemReviewOutcome struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Decision          *CodeableConcept  `json:"decision,omitempty" bson:"decision,omitempty"`                    // Result of the adjudication
+	Reason            []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // Reason for result of the adjudication
+	PreAuthRef        *string           `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`              // Preauthorization reference
+	PreAuthRefElement *Element          `json:"_preAuthRef,omitempty" bson:"pre_auth_ref_element,omitempty"`     // Extensions for preAuthRef
+	PreAuthPeriod     *Period           `json:"preAuthPeriod,omitempty" bson:"pre_auth_period,omitempty"`        // Preauthorization reference effective period
+}
+
+func (r *ExplanationOfBenefitItemReviewOutcome) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Decision != nil {
+		if err := r.Decision.Validate(); err != nil {
+			return fmt.Errorf("Decision: %w", err)
+		}
+	}
+	for i, item := range r.Reason {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Reason[%d]: %w", i, err)
+		}
+	}
+	if r.PreAuthRefElement != nil {
+		if err := r.PreAuthRefElement.Validate(); err != nil {
+			return fmt.Errorf("PreAuthRefElement: %w", err)
+		}
+	}
+	if r.PreAuthPeriod != nil {
+		if err := r.PreAuthPeriod.Validate(); err != nil {
+			return fmt.Errorf("PreAuthPeriod: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitItemReviewOutcome) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Decision != nil {
+		r.Decision.validateAll(path+".decision", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.PreAuthRefElement != nil {
+		r.PreAuthRefElement.validateAll(path+".preAuthRef", issues)
+	}
+	if r.PreAuthPeriod != nil {
+		r.PreAuthPeriod.validateAll(path+".preAuthPeriod", issues)
+	}
+}
+
+var explanationOfBenefitItemReviewOutcomeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitItemReviewOutcome) invariants() []invariant {
+	return explanationOfBenefitItemReviewOutcomeInvariants
+}
+
+func (r ExplanationOfBenefitItemReviewOutcome) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitItemReviewOutcome) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitItemReviewOutcome) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitItemReviewOutcome) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitItemReviewOutcomeElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.decision", Name: "decision", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.reason", Name: "reason", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.preAuthRef", Name: "preAuthRef", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.reviewOutcome.preAuthPeriod", Name: "preAuthPeriod", Field: 7, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *ExplanationOfBenefitItemReviewOutcome) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitItemReviewOutcomeElementMetadata
+}
+
+type ExplanationOfBenefitItemAdjudication struct {
+	Id                  *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category            *CodeableConcept `json:"category" bson:"category"`                                        // Type of adjudication information
+	Reason              *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // Explanation of adjudication outcome
+	Amount              *Money           `json:"amount,omitempty" bson:"amount,omitempty"`                        // Monetary amount
+	Quantity            *Quantity        `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // Non-monitary value
+	DecisionDate        *DateTime        `json:"decisionDate,omitempty" bson:"decision_date,omitempty"`           // When was adjudication performed
+	DecisionDateElement *Element         `json:"_decisionDate,omitempty" bson:"decision_date_element,omitempty"`  // Extensions for decisionDate
+}
+
+func (r *ExplanationOfBenefitItemAdjudication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Reason != nil {
+		if err := r.Reason.Validate(); err != nil {
+			return fmt.Errorf("Reason: %w", err)
+		}
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.DecisionDate != nil {
+		if err := r.DecisionDate.Validate(); err != nil {
+			return fmt.Errorf("DecisionDate: %w", err)
+		}
+	}
+	if r.DecisionDateElement != nil {
+		if err := r.DecisionDateElement.Validate(); err != nil {
+			return fmt.Errorf("DecisionDateElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitItemAdjudication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.DecisionDate != nil {
+		if err := r.DecisionDate.Validate(); err != nil {
+			issues.add("value", path+".decisionDate", err.Error())
+		}
+	}
+	if r.DecisionDateElement != nil {
+		r.DecisionDateElement.validateAll(path+".decisionDate", issues)
+	}
+}
+
+var explanationOfBenefitItemAdjudicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitItemAdjudication) invariants() []invariant {
+	return explanationOfBenefitItemAdjudicationInvariants
+}
+
+func (r ExplanationOfBenefitItemAdjudication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitItemAdjudication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitItemAdjudication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitItemAdjudication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitItemAdjudicationElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.item.adjudication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.adjudication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.item.adjudication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.item.adjudication.category", Name: "category", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.adjudication.reason", Name: "reason", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.adjudication.amount", Name: "amount", Field: 5, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.adjudication.quantity", Name: "quantity", Field: 6, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.item.adjudication.decisionDate", Name: "decisionDate", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime"}},
+}
+
+func (r *ExplanationOfBenefitItemAdjudication) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitItemAdjudicationElementMetadata
+}
+
+type ExplanationOfBenefitItemDetail struct {
+	Id                  *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	Sequence            int                                       `json:"sequence" bson:"sequence"`                                              // Product or service provided
+	SequenceElement     *Element                                  `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                 // Extensions for sequence
+	TraceNumber         []Identifier                              `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
+	Revenue             *CodeableConcept                          `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
+	Category            *CodeableConcept                          `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
+	ProductOrService    *CodeableConcept                          `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
+	ProductOrServiceEnd *CodeableConcept                          `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
+	Modifier            []CodeableConcept                         `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
+	ProgramCode         []CodeableConcept                         `json:"programCode,omitempty" bson:"program_code,omitempty"`                   // Program the product or service is provided under
+	PatientPaid         *Money                                    `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
+	Quantity            *Quantity                                 `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
+	UnitPrice           *Money                                    `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
+	Factor              *Decimal                                  `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                                  `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
+	Tax                 *Money                                    `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
+	Net                 *Money                                    `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
+	Udi                 []Reference                               `json:"udi,omitempty" bson:"udi,omitempty"`                                    // Unique device identifier
+	NoteNumber          []int                                     `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
+	NoteNumberElement   []*Element                                `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`            // Extensions for noteNumber
+	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome    `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Detail level adjudication results
+	Adjudication        []ExplanationOfBenefitItemAdjudication    `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Detail level adjudication details
+	SubDetail           []ExplanationOfBenefitItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                       // Additional items
+}
+
+var explanationOfBenefitItemDetailUdiTargets = []string{"Device"}
+
+func (r *ExplanationOfBenefitItemDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	if r.Revenue != nil {
+		if err := r.Revenue.Validate(); err != nil {
+			return fmt.Errorf("Revenue: %w", err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	if r.ProductOrServiceEnd != nil {
+		if err := r.ProductOrServiceEnd.Validate(); err != nil {
+			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProgramCode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+		}
+	}
+	if r.PatientPaid != nil {
+		if err := r.PatientPaid.Validate(); err != nil {
+			return fmt.Errorf("PatientPaid: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.Tax != nil {
+		if err := r.Tax.Validate(); err != nil {
+			return fmt.Errorf("Tax: %w", err)
+		}
+	}
+	if r.Net != nil {
+		if err := r.Net.Validate(); err != nil {
+			return fmt.Errorf("Net: %w", err)
+		}
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemDetailUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemDetailUdiTargets)
+		}
+	}
+	for i, item := range r.Udi {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Udi[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	if r.ReviewOutcome != nil {
+		if err := r.ReviewOutcome.Validate(); err != nil {
+			return fmt.Errorf("ReviewOutcome: %w", err)
+		}
+	}
+	for i, item := range r.Adjudication {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubDetail {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubDetail[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ExplanationOfBenefitItemDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemDetailUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemDetailUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.SubDetail {
+		item.validateAll(fmt.Sprintf("%s.subDetail[%d]", path, i), issues)
+	}
+}
+
+var explanationOfBenefitItemDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitItemDetail) invariants() []invariant {
+	return explanationOfBenefitItemDetailInvariants
+}
+
+func (r ExplanationOfBenefitItemDetail) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitItemDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ExplanationOfBenefitItemDetail) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitItemDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitItemDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitItemDetailElementTypes = map[string]string{
+	"sequence":   "positiveInt",
+	"noteNumber": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitItemDetail) elementTypes() map[string]string {
+	return explanationOfBenefitItemDetailElementTypes
+}
+
+var explanationOfBenefitItemDetailElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.item.detail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.detail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.item.detail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.item.detail.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.detail.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.item.detail.revenue", Name: "revenue", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.category", Name: "category", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.productOrService", Name: "productOrService", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.modifier", Name: "modifier", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.programCode", Name: "programCode", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.patientPaid", Name: "patientPaid", Field: 12, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.quantity", Name: "quantity", Field: 13, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.item.detail.unitPrice", Name: "unitPrice", Field: 14, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.factor", Name: "factor", Field: 15, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ExplanationOfBenefit.item.detail.tax", Name: "tax", Field: 17, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.net", Name: "net", Field: 18, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.udi", Name: "udi", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "ExplanationOfBenefit.item.detail.noteNumber", Name: "noteNumber", Field: 20, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.detail.reviewOutcome", Name: "reviewOutcome", Field: 22, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.item.detail.adjudication", Name: "adjudication", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail", Name: "subDetail", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefitItemDetail) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitItemDetailElementMetadata
+}
+
+type ExplanationOfBenefitItemDetailSubDetail struct {
+	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	Sequence            int                                    `json:"sequence" bson:"sequence"`                                              // Product or service provided
+	SequenceElement     *Element                               `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`                 // Extensions for sequence
+	TraceNumber         []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
+	Revenue             *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
+	Category            *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
+	ProductOrService    *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
+	ProductOrServiceEnd *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
+	Modifier            []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
+	ProgramCode         []CodeableConcept                      `json:"programCode,omitempty" bson:"program_code,omitempty"`                   // Program the product or service is provided under
+	PatientPaid         *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
+	Quantity            *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
+	UnitPrice           *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
+	Factor              *Decimal                               `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                               `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
+	Tax                 *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
+	Net                 *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
+	Udi                 []Reference                            `json:"udi,omitempty" bson:"udi,omitempty"`                                    // Unique device identifier
+	NoteNumber          []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
+	NoteNumberElement   []*Element                             `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`            // Extensions for noteNumber
+	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Subdetail level adjudication results
+	Adjudication        []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Subdetail level adjudication details
+}
+
+var explanationOfBenefitItemDetailSubDetailUdiTargets = []string{"Device"}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	for i, item := range r.TraceNumber {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	if r.Revenue != nil {
+		if err := r.Revenue.Validate(); err != nil {
+			return fmt.Errorf("Revenue: %w", err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.ProductOrService != nil {
+		if err := r.ProductOrService.Validate(); err != nil {
+			return fmt.Errorf("ProductOrService: %w", err)
+		}
+	}
+	if r.ProductOrServiceEnd != nil {
+		if err := r.ProductOrServiceEnd.Validate(); err != nil {
+			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Modifier[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ProgramCode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+		}
+	}
+	if r.PatientPaid != nil {
+		if err := r.PatientPaid.Validate(); err != nil {
+			return fmt.Errorf("PatientPaid: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if r.UnitPrice != nil {
+		if err := r.UnitPrice.Validate(); err != nil {
+			return fmt.Errorf("UnitPrice: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.Tax != nil {
+		if err := r.Tax.Validate(); err != nil {
+			return fmt.Errorf("Tax: %w", err)
+		}
+	}
+	if r.Net != nil {
+		if err := r.Net.Validate(); err != nil {
+			return fmt.Errorf("Net: %w", err)
+		}
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemDetailSubDetailUdiTargets); target != "" {
+			return fmt.Errorf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemDetailSubDetailUdiTargets)
 		}
 	}
 	for i, item := range r.Udi {
@@ -741,6 +5132,14 @@ func (r *ExplanationOfBenefitItemDetailSubDetail) Validate() error {
 			return fmt.Errorf("Udi[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
 	if r.ReviewOutcome != nil {
 		if err := r.ReviewOutcome.Validate(); err != nil {
 			return fmt.Errorf("ReviewOutcome: %w", err)
@@ -754,29 +5153,279 @@ func (r *ExplanationOfBenefitItemDetailSubDetail) Validate() error {
 	return nil
 }
 
-type ExplanationOfBenefitAddItemDetail struct {
-	Id                  *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	TraceNumber         []Identifier                                 `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
-	Revenue             *CodeableConcept                             `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
-	ProductOrService    *CodeableConcept                             `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
-	ProductOrServiceEnd *CodeableConcept                             `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
-	Modifier            []CodeableConcept                            `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
-	PatientPaid         *Money                                       `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
-	Quantity            *Quantity                                    `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
-	UnitPrice           *Money                                       `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                                     `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
-	Tax                 *Money                                       `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
-	Net                 *Money                                       `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
-	NoteNumber          []int                                        `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
-	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome       `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Additem detail level adjudication results
-	Adjudication        []ExplanationOfBenefitItemAdjudication       `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Added items adjudication
-	SubDetail           []ExplanationOfBenefitAddItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                       // Insurer added line items
+func (r *ExplanationOfBenefitItemDetailSubDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Sequence == 0 {
+		issues.add("required", path+".sequence", "field 'Sequence' is required")
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.Udi {
+		if target := invalidReferenceTarget(item.Reference, item.Type, explanationOfBenefitItemDetailSubDetailUdiTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.udi[%d]", path, i), fmt.Sprintf("field 'Udi[%d]' cannot refer to %s, expected one of %v", i, target, explanationOfBenefitItemDetailSubDetailUdiTargets))
+		}
+	}
+	for i, item := range r.Udi {
+		item.validateAll(fmt.Sprintf("%s.udi[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
 }
 
-func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
+var explanationOfBenefitItemDetailSubDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) invariants() []invariant {
+	return explanationOfBenefitItemDetailSubDetailInvariants
+}
+
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitItemDetailSubDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitItemDetailSubDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	return nil
+}
+
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitItemDetailSubDetailElementTypes = map[string]string{
+	"sequence":   "positiveInt",
+	"noteNumber": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) elementTypes() map[string]string {
+	return explanationOfBenefitItemDetailSubDetailElementTypes
+}
+
+var explanationOfBenefitItemDetailSubDetailElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.sequence", Name: "sequence", Field: 3, Min: 1, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.traceNumber", Name: "traceNumber", Field: 5, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.revenue", Name: "revenue", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.category", Name: "category", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.productOrService", Name: "productOrService", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.modifier", Name: "modifier", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.programCode", Name: "programCode", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.patientPaid", Name: "patientPaid", Field: 12, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.quantity", Name: "quantity", Field: 13, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.unitPrice", Name: "unitPrice", Field: 14, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.factor", Name: "factor", Field: 15, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.tax", Name: "tax", Field: 17, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.net", Name: "net", Field: 18, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.udi", Name: "udi", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.noteNumber", Name: "noteNumber", Field: 20, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.reviewOutcome", Name: "reviewOutcome", Field: 22, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.item.detail.subDetail.adjudication", Name: "adjudication", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefitItemDetailSubDetail) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitItemDetailSubDetailElementMetadata
+}
+
+type ExplanationOfBenefitAddItem struct {
+	Id                         *string                                `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
+	Extension                  []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored even if unrecognized
+	ItemSequence               []int                                  `json:"itemSequence,omitempty" bson:"item_sequence,omitempty"`                        // Item sequence number
+	ItemSequenceElement        []*Element                             `json:"_itemSequence,omitempty" bson:"item_sequence_element,omitempty"`               // Extensions for itemSequence
+	DetailSequence             []int                                  `json:"detailSequence,omitempty" bson:"detail_sequence,omitempty"`                    // Detail sequence number
+	DetailSequenceElement      []*Element                             `json:"_detailSequence,omitempty" bson:"detail_sequence_element,omitempty"`           // Extensions for detailSequence
+	SubDetailSequence          []int                                  `json:"subDetailSequence,omitempty" bson:"sub_detail_sequence,omitempty"`             // Subdetail sequence number
+	SubDetailSequenceElement   []*Element                             `json:"_subDetailSequence,omitempty" bson:"sub_detail_sequence_element,omitempty"`    // Extensions for subDetailSequence
+	TraceNumber                []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
+	Subject                    *Reference                             `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
+	InformationSequence        []int                                  `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
+	InformationSequenceElement []*Element                             `json:"_informationSequence,omitempty" bson:"information_sequence_element,omitempty"` // Extensions for informationSequence
+	Provider                   []Reference                            `json:"provider,omitempty" bson:"provider,omitempty"`                                 // Authorized providers
+	Revenue                    *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
+	Category                   *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
+	ProductOrService           *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
+	ProductOrServiceEnd        *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
+	Request                    []Reference                            `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
+	Modifier                   []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Service/Product billing modifiers
+	ProgramCode                []CodeableConcept                      `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
+	Serviced                   ExplanationOfBenefitAddItemServiced    `json:"-" bson:"serviced,omitempty"`                                                  // Date or dates of service or product delivery
+	ServicedElement            *Element                               `json:"-" bson:"serviced_element,omitempty"`                                          // Extensions for serviced[x]
+	Location                   ExplanationOfBenefitAddItemLocation    `json:"-" bson:"location,omitempty"`                                                  // Place of service or where product was supplied
+	PatientPaid                *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                          // Paid by the patient
+	Quantity                   *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
+	UnitPrice                  *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
+	Factor                     *Decimal                               `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
+	FactorElement              *Element                               `json:"_factor,omitempty" bson:"factor_element,omitempty"`                            // Extensions for factor
+	Tax                        *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
+	Net                        *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
+	BodySite                   []ExplanationOfBenefitAddItemBodySite  `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
+	NoteNumber                 []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
+	NoteNumberElement          []*Element                             `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`                   // Extensions for noteNumber
+	ReviewOutcome              *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Additem level adjudication results
+	Adjudication               []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Added items adjudication
+	Detail                     []ExplanationOfBenefitAddItemDetail    `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Insurer added line items
+
+	servicedVariants []string // JSON properties of ExplanationOfBenefit.addItem.serviced[x] when more than one was decoded
+	locationVariants []string // JSON properties of ExplanationOfBenefit.addItem.location[x] when more than one was decoded
+}
+
+var explanationOfBenefitAddItemSubjectTargets = []string{"Group", "Patient"}
+
+func (r *ExplanationOfBenefitAddItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ItemSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ItemSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DetailSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SubDetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubDetailSequenceElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, explanationOfBenefitAddItemSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, explanationOfBenefitAddItemSubjectTargets)
+		}
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InformationSequenceElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Provider {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Provider[%d]: %w", i, err)
 		}
 	}
 	if r.Revenue != nil {
@@ -784,6 +5433,11 @@ func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
 			return fmt.Errorf("Revenue: %w", err)
 		}
 	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
 	if r.ProductOrService != nil {
 		if err := r.ProductOrService.Validate(); err != nil {
 			return fmt.Errorf("ProductOrService: %w", err)
@@ -794,11 +5448,42 @@ func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
 			return fmt.Errorf("ProductOrServiceEnd: %w", err)
 		}
 	}
+	for i, item := range r.Request {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Request[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Modifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Modifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ProgramCode {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+		}
+	}
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
+	}
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
+		}
+	}
+	if len(r.locationVariants) > 1 {
+		return fmt.Errorf("field 'Location' must have a single type, got %v", r.locationVariants)
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
 	if r.PatientPaid != nil {
 		if err := r.PatientPaid.Validate(); err != nil {
 			return fmt.Errorf("PatientPaid: %w", err)
@@ -814,6 +5499,16 @@ func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -824,6 +5519,19 @@ func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
 			return fmt.Errorf("Net: %w", err)
 		}
 	}
+	for i, item := range r.BodySite {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BodySite[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
 	if r.ReviewOutcome != nil {
 		if err := r.ReviewOutcome.Validate(); err != nil {
 			return fmt.Errorf("ReviewOutcome: %w", err)
@@ -834,243 +5542,446 @@ func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
 			return fmt.Errorf("Adjudication[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.SubDetail {
+	for i, item := range r.Detail {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubDetail[%d]: %w", i, err)
+			return fmt.Errorf("Detail[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type ExplanationOfBenefitAddItemDetailSubDetail struct {
-	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	TraceNumber         []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
-	Revenue             *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
-	ProductOrService    *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
-	ProductOrServiceEnd *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
-	Modifier            []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
-	PatientPaid         *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
-	Quantity            *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
-	UnitPrice           *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                               `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
-	Tax                 *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
-	Net                 *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
-	NoteNumber          []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
-	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Additem subdetail level adjudication results
-	Adjudication        []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Added items adjudication
-}
-
-func (r *ExplanationOfBenefitAddItemDetailSubDetail) Validate() error {
+func (r *ExplanationOfBenefitAddItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.ItemSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.itemSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.DetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.detailSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.SubDetailSequenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.subDetailSequence[%d]", path, i), issues)
+	}
 	for i, item := range r.TraceNumber {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, explanationOfBenefitAddItemSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, explanationOfBenefitAddItemSubjectTargets))
 		}
 	}
-	if r.Revenue != nil {
-		if err := r.Revenue.Validate(); err != nil {
-			return fmt.Errorf("Revenue: %w", err)
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.InformationSequenceElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.informationSequence[%d]", path, i), issues)
+	}
+	for i, item := range r.Provider {
+		item.validateAll(fmt.Sprintf("%s.provider[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
 	}
 	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
-		}
+		r.ProductOrService.validateAll(path+".productOrService", issues)
 	}
 	if r.ProductOrServiceEnd != nil {
-		if err := r.ProductOrServiceEnd.Validate(); err != nil {
-			return fmt.Errorf("ProductOrServiceEnd: %w", err)
-		}
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Request {
+		item.validateAll(fmt.Sprintf("%s.request[%d]", path, i), issues)
 	}
 	for i, item := range r.Modifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramCode {
+		item.validateAll(fmt.Sprintf("%s.programCode[%d]", path, i), issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if len(r.locationVariants) > 1 {
+		issues.add("structure", path+".location", fmt.Sprintf("field 'Location' must have a single type, got %v", r.locationVariants))
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location.ofType("+r.Location.FHIRType()+")", issues)
 	}
 	if r.PatientPaid != nil {
-		if err := r.PatientPaid.Validate(); err != nil {
-			return fmt.Errorf("PatientPaid: %w", err)
-		}
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
 	}
 	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+		r.Quantity.validateAll(path+".quantity", issues)
 	}
 	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
 		}
 	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
 	if r.Tax != nil {
-		if err := r.Tax.Validate(); err != nil {
-			return fmt.Errorf("Tax: %w", err)
-		}
+		r.Tax.validateAll(path+".tax", issues)
 	}
 	if r.Net != nil {
-		if err := r.Net.Validate(); err != nil {
-			return fmt.Errorf("Net: %w", err)
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
 	}
 	if r.ReviewOutcome != nil {
-		if err := r.ReviewOutcome.Validate(); err != nil {
-			return fmt.Errorf("ReviewOutcome: %w", err)
-		}
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
 	}
 	for i, item := range r.Adjudication {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Adjudication[%d]: %w", i, err)
-		}
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.Detail {
+		item.validateAll(fmt.Sprintf("%s.detail[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ExplanationOfBenefitBenefitBalanceFinancial struct {
-	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
-	Type               *CodeableConcept `json:"type" bson:"type"`                                                   // Benefit classification
-	AllowedUnsignedInt *int             `json:"allowedUnsignedInt,omitempty" bson:"allowed_unsigned_int,omitempty"` // Benefits allowed
-	AllowedString      *string          `json:"allowedString,omitempty" bson:"allowed_string,omitempty"`            // Benefits allowed
-	AllowedMoney       *Money           `json:"allowedMoney,omitempty" bson:"allowed_money,omitempty"`              // Benefits allowed
-	UsedUnsignedInt    *int             `json:"usedUnsignedInt,omitempty" bson:"used_unsigned_int,omitempty"`       // Benefits used
-	UsedMoney          *Money           `json:"usedMoney,omitempty" bson:"used_money,omitempty"`                    // Benefits used
+var explanationOfBenefitAddItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ExplanationOfBenefitBenefitBalanceFinancial) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *ExplanationOfBenefitAddItem) invariants() []invariant {
+	return explanationOfBenefitAddItemInvariants
+}
+
+func (r ExplanationOfBenefitAddItem) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitAddItem
+	out := struct {
+		alias
+		ItemSequence               []*int     `json:"itemSequence,omitempty"`
+		ItemSequenceElement        []*Element `json:"_itemSequence,omitempty"`
+		DetailSequence             []*int     `json:"detailSequence,omitempty"`
+		DetailSequenceElement      []*Element `json:"_detailSequence,omitempty"`
+		SubDetailSequence          []*int     `json:"subDetailSequence,omitempty"`
+		SubDetailSequenceElement   []*Element `json:"_subDetailSequence,omitempty"`
+		InformationSequence        []*int     `json:"informationSequence,omitempty"`
+		InformationSequenceElement []*Element `json:"_informationSequence,omitempty"`
+		NoteNumber                 []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement          []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.ItemSequence, out.ItemSequenceElement = alignPrimitiveArray(r.ItemSequence, r.ItemSequenceElement)
+	out.DetailSequence, out.DetailSequenceElement = alignPrimitiveArray(r.DetailSequence, r.DetailSequenceElement)
+	out.SubDetailSequence, out.SubDetailSequenceElement = alignPrimitiveArray(r.SubDetailSequence, r.SubDetailSequenceElement)
+	out.InformationSequence, out.InformationSequenceElement = alignPrimitiveArray(r.InformationSequence, r.InformationSequenceElement)
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "location", r.Location, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitAddItem) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitAddItem
+	aux := struct {
+		*alias
+		ItemSequence        []*int `json:"itemSequence,omitempty"`
+		DetailSequence      []*int `json:"detailSequence,omitempty"`
+		SubDetailSequence   []*int `json:"subDetailSequence,omitempty"`
+		InformationSequence []*int `json:"informationSequence,omitempty"`
+		NoteNumber          []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	var err error
+	if r.ItemSequence, err = splitPrimitiveArray(aux.ItemSequence, r.ItemSequenceElement); err != nil {
+		return fmt.Errorf("itemSequence: %w", err)
 	}
-	if r.AllowedMoney != nil {
-		if err := r.AllowedMoney.Validate(); err != nil {
-			return fmt.Errorf("AllowedMoney: %w", err)
-		}
+	if r.DetailSequence, err = splitPrimitiveArray(aux.DetailSequence, r.DetailSequenceElement); err != nil {
+		return fmt.Errorf("detailSequence: %w", err)
+	}
+	if r.SubDetailSequence, err = splitPrimitiveArray(aux.SubDetailSequence, r.SubDetailSequenceElement); err != nil {
+		return fmt.Errorf("subDetailSequence: %w", err)
+	}
+	if r.InformationSequence, err = splitPrimitiveArray(aux.InformationSequence, r.InformationSequenceElement); err != nil {
+		return fmt.Errorf("informationSequence: %w", err)
+	}
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
 	}
-	if r.UsedMoney != nil {
-		if err := r.UsedMoney.Validate(); err != nil {
-			return fmt.Errorf("UsedMoney: %w", err)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", explanationOfBenefitAddItemServicedVariants); err != nil {
+		return err
+	}
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
 		}
 	}
+	if r.Location, _, r.locationVariants, err = unmarshalChoice(fields, "location", explanationOfBenefitAddItemLocationVariants); err != nil {
+		return err
+	}
 	return nil
 }
 
-type ExplanationOfBenefitPayee struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Type  *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`   // Category of recipient
-	Party *Reference       `json:"party,omitempty" bson:"party,omitempty"` // Recipient reference
+func (r ExplanationOfBenefitAddItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ExplanationOfBenefitPayee) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.Party != nil {
-		if err := r.Party.Validate(); err != nil {
-			return fmt.Errorf("Party: %w", err)
-		}
-	}
-	return nil
+func (r *ExplanationOfBenefitAddItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type ExplanationOfBenefitCareTeam struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Sequence  int              `json:"sequence" bson:"sequence"`                       // Order of care team
-	Provider  *Reference       `json:"provider" bson:"provider"`                       // Practitioner or organization
-	Role      *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`           // Function within the team
-	Specialty *CodeableConcept `json:"specialty,omitempty" bson:"specialty,omitempty"` // Practitioner or provider specialization
+func (r ExplanationOfBenefitAddItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *ExplanationOfBenefitCareTeam) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
-	if r.Provider == nil {
-		return fmt.Errorf("field 'Provider' is required")
-	}
-	if r.Provider != nil {
-		if err := r.Provider.Validate(); err != nil {
-			return fmt.Errorf("Provider: %w", err)
-		}
-	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
-	}
-	if r.Specialty != nil {
-		if err := r.Specialty.Validate(); err != nil {
-			return fmt.Errorf("Specialty: %w", err)
-		}
-	}
-	return nil
+func (r *ExplanationOfBenefitAddItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
 }
 
-type ExplanationOfBenefitInsurance struct {
-	Id         *string    `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Focal      bool       `json:"focal" bson:"focal"`                                 // Coverage to be used for adjudication
-	Coverage   *Reference `json:"coverage" bson:"coverage"`                           // Insurance information
-	PreAuthRef []string   `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"` // Prior authorization reference number
+func (r *ExplanationOfBenefitAddItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(explanationOfBenefitAddItemServicedVariants)
+	case "Location":
+		return "location", choiceValues(explanationOfBenefitAddItemLocationVariants)
+	}
+	return "", nil
 }
 
-func (r *ExplanationOfBenefitInsurance) Validate() error {
-	if r.Coverage == nil {
-		return fmt.Errorf("field 'Coverage' is required")
-	}
-	if r.Coverage != nil {
-		if err := r.Coverage.Validate(); err != nil {
-			return fmt.Errorf("Coverage: %w", err)
-		}
+var explanationOfBenefitAddItemElementTypes = map[string]string{
+	"itemSequence":        "positiveInt",
+	"detailSequence":      "positiveInt",
+	"subDetailSequence":   "positiveInt",
+	"informationSequence": "positiveInt",
+	"noteNumber":          "positiveInt",
+}
+
+func (r *ExplanationOfBenefitAddItem) elementTypes() map[string]string {
+	return explanationOfBenefitAddItemElementTypes
+}
+
+var explanationOfBenefitAddItemElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.addItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.addItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.addItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.addItem.itemSequence", Name: "itemSequence", Field: 3, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.detailSequence", Name: "detailSequence", Field: 5, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.subDetailSequence", Name: "subDetailSequence", Field: 7, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.traceNumber", Name: "traceNumber", Field: 9, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.addItem.subject", Name: "subject", Field: 10, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ExplanationOfBenefit.addItem.informationSequence", Name: "informationSequence", Field: 11, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.provider", Name: "provider", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.addItem.revenue", Name: "revenue", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.category", Name: "category", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.productOrService", Name: "productOrService", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.productOrServiceEnd", Name: "productOrServiceEnd", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.request", Name: "request", Field: 18, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ExplanationOfBenefit.addItem.modifier", Name: "modifier", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.programCode", Name: "programCode", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.serviced[x]", Name: "serviced", Field: 21, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "ExplanationOfBenefit.addItem.location[x]", Name: "location", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Address", "Reference"}},
+	{Path: "ExplanationOfBenefit.addItem.patientPaid", Name: "patientPaid", Field: 24, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.quantity", Name: "quantity", Field: 25, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.addItem.unitPrice", Name: "unitPrice", Field: 26, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.factor", Name: "factor", Field: 27, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ExplanationOfBenefit.addItem.tax", Name: "tax", Field: 29, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.net", Name: "net", Field: 30, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.bodySite", Name: "bodySite", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem.noteNumber", Name: "noteNumber", Field: 32, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.reviewOutcome", Name: "reviewOutcome", Field: 34, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem.adjudication", Name: "adjudication", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem.detail", Name: "detail", Field: 36, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefitAddItem) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitAddItemElementMetadata
+}
+
+// ExplanationOfBenefitAddItemServiced is the type of ExplanationOfBenefit.addItem.serviced[x]. It is implemented by the ExplanationOfBenefitAddItemServiced* variant types.
+type ExplanationOfBenefitAddItemServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitAddItemServiced()
+}
+
+// ExplanationOfBenefitAddItemServicedDate is the date variant of ExplanationOfBenefitAddItemServiced.
+type ExplanationOfBenefitAddItemServicedDate struct {
+	Date
+}
+
+func (v ExplanationOfBenefitAddItemServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ExplanationOfBenefitAddItemServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	return nil
 }
 
-type ExplanationOfBenefitItemAdjudication struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Category     *CodeableConcept `json:"category" bson:"category"`                              // Type of adjudication information
-	Reason       *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`              // Explanation of adjudication outcome
-	Amount       *Money           `json:"amount,omitempty" bson:"amount,omitempty"`              // Monetary amount
-	Quantity     *Quantity        `json:"quantity,omitempty" bson:"quantity,omitempty"`          // Non-monitary value
-	DecisionDate *string          `json:"decisionDate,omitempty" bson:"decision_date,omitempty"` // When was adjudication performed
+func (v ExplanationOfBenefitAddItemServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v ExplanationOfBenefitAddItemServicedDate) isExplanationOfBenefitAddItemServiced() {}
+
+// ExplanationOfBenefitAddItemServicedPeriod is the Period variant of ExplanationOfBenefitAddItemServiced.
+type ExplanationOfBenefitAddItemServicedPeriod struct {
+	Period
+}
+
+func (v ExplanationOfBenefitAddItemServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ExplanationOfBenefitAddItemServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitAddItemServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ExplanationOfBenefitAddItemServicedPeriod) isExplanationOfBenefitAddItemServiced() {}
+
+var explanationOfBenefitAddItemServicedVariants = []ExplanationOfBenefitAddItemServiced{
+	ExplanationOfBenefitAddItemServicedDate{},
+	ExplanationOfBenefitAddItemServicedPeriod{},
+}
+
+// ExplanationOfBenefitAddItemLocation is the type of ExplanationOfBenefit.addItem.location[x]. It is implemented by the ExplanationOfBenefitAddItemLocation* variant types.
+type ExplanationOfBenefitAddItemLocation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitAddItemLocation()
+}
+
+// ExplanationOfBenefitAddItemLocationCodeableConcept is the CodeableConcept variant of ExplanationOfBenefitAddItemLocation.
+type ExplanationOfBenefitAddItemLocationCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ExplanationOfBenefitAddItemLocationCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ExplanationOfBenefitAddItemLocationCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitAddItemLocationCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ExplanationOfBenefitAddItemLocationCodeableConcept) isExplanationOfBenefitAddItemLocation() {}
+
+// ExplanationOfBenefitAddItemLocationAddress is the Address variant of ExplanationOfBenefitAddItemLocation.
+type ExplanationOfBenefitAddItemLocationAddress struct {
+	Address
+}
+
+func (v ExplanationOfBenefitAddItemLocationAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ExplanationOfBenefitAddItemLocationAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitAddItemLocationAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ExplanationOfBenefitAddItemLocationAddress) isExplanationOfBenefitAddItemLocation() {}
+
+// ExplanationOfBenefitAddItemLocationReference is the Reference variant of ExplanationOfBenefitAddItemLocation.
+type ExplanationOfBenefitAddItemLocationReference struct {
+	Reference
+}
+
+func (v ExplanationOfBenefitAddItemLocationReference) Validate() error {
+	return v.Reference.Validate()
 }
 
-func (r *ExplanationOfBenefitItemAdjudication) Validate() error {
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
-	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
-	}
-	if r.Reason != nil {
-		if err := r.Reason.Validate(); err != nil {
-			return fmt.Errorf("Reason: %w", err)
-		}
-	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
-	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
-	}
-	return nil
+func (v ExplanationOfBenefitAddItemLocationReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitAddItemLocationReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExplanationOfBenefitAddItemLocationReference) isExplanationOfBenefitAddItemLocation() {}
+
+var explanationOfBenefitAddItemLocationVariants = []ExplanationOfBenefitAddItemLocation{
+	ExplanationOfBenefitAddItemLocationCodeableConcept{},
+	ExplanationOfBenefitAddItemLocationAddress{},
+	ExplanationOfBenefitAddItemLocationReference{},
 }
 
 type ExplanationOfBenefitAddItemBodySite struct {
-	Id      *string             `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	Site    []CodeableReference `json:"site" bson:"site"`                            // Location
-	SubSite []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"` // Sub-location
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Site              []CodeableReference `json:"site" bson:"site"`                                                // Location
+	SubSite           []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"`                     // Sub-location
 }
 
 func (r *ExplanationOfBenefitAddItemBodySite) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if len(r.Site) < 1 {
 		return fmt.Errorf("field 'Site' must have at least 1 elements")
 	}
@@ -1087,135 +5998,104 @@ func (r *ExplanationOfBenefitAddItemBodySite) Validate() error {
 	return nil
 }
 
-type ExplanationOfBenefitTotal struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Category *CodeableConcept `json:"category" bson:"category"`         // Type of adjudication information
-	Amount   *Money           `json:"amount" bson:"amount"`             // Financial total for the category
-}
-
-func (r *ExplanationOfBenefitTotal) Validate() error {
-	if r.Category == nil {
-		return fmt.Errorf("field 'Category' is required")
+func (r *ExplanationOfBenefitAddItemBodySite) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Amount == nil {
-		return fmt.Errorf("field 'Amount' is required")
+	if len(r.Site) < 1 {
+		issues.add("required", path+".site", "field 'Site' must have at least 1 elements")
 	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
+	for i, item := range r.Site {
+		item.validateAll(fmt.Sprintf("%s.site[%d]", path, i), issues)
+	}
+	for i, item := range r.SubSite {
+		item.validateAll(fmt.Sprintf("%s.subSite[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ExplanationOfBenefitProcedure struct {
-	Id                       *string           `json:"id,omitempty" bson:"id,omitempty"`                           // Unique id for inter-element referencing
-	Sequence                 int               `json:"sequence" bson:"sequence"`                                   // Procedure instance identifier
-	Type                     []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                       // Category of Procedure
-	Date                     *string           `json:"date,omitempty" bson:"date,omitempty"`                       // When the procedure was performed
-	ProcedureCodeableConcept *CodeableConcept  `json:"procedureCodeableConcept" bson:"procedure_codeable_concept"` // Specific clinical procedure
-	ProcedureReference       *Reference        `json:"procedureReference" bson:"procedure_reference"`              // Specific clinical procedure
-	Udi                      []Reference       `json:"udi,omitempty" bson:"udi,omitempty"`                         // Unique device identifier
+var explanationOfBenefitAddItemBodySiteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ExplanationOfBenefitProcedure) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
-	for i, item := range r.Type {
+func (r *ExplanationOfBenefitAddItemBodySite) invariants() []invariant {
+	return explanationOfBenefitAddItemBodySiteInvariants
+}
+
+func (r ExplanationOfBenefitAddItemBodySite) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitAddItemBodySite) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitAddItemBodySite) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitAddItemBodySite) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitAddItemBodySiteElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.addItem.bodySite.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.addItem.bodySite.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.addItem.bodySite.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.addItem.bodySite.site", Name: "site", Field: 3, Min: 1, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ExplanationOfBenefit.addItem.bodySite.subSite", Name: "subSite", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ExplanationOfBenefitAddItemBodySite) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitAddItemBodySiteElementMetadata
+}
+
+type ExplanationOfBenefitAddItemDetail struct {
+	Id                  *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	TraceNumber         []Identifier                                 `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
+	Revenue             *CodeableConcept                             `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
+	ProductOrService    *CodeableConcept                             `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
+	ProductOrServiceEnd *CodeableConcept                             `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
+	Modifier            []CodeableConcept                            `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
+	PatientPaid         *Money                                       `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
+	Quantity            *Quantity                                    `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
+	UnitPrice           *Money                                       `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
+	Factor              *Decimal                                     `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                                     `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
+	Tax                 *Money                                       `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
+	Net                 *Money                                       `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
+	NoteNumber          []int                                        `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
+	NoteNumberElement   []*Element                                   `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`            // Extensions for noteNumber
+	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome       `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Additem detail level adjudication results
+	Adjudication        []ExplanationOfBenefitItemAdjudication       `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Added items adjudication
+	SubDetail           []ExplanationOfBenefitAddItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                       // Insurer added line items
+}
+
+func (r *ExplanationOfBenefitAddItemDetail) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
-		}
-	}
-	if r.ProcedureCodeableConcept == nil {
-		return fmt.Errorf("field 'ProcedureCodeableConcept' is required")
-	}
-	if r.ProcedureCodeableConcept != nil {
-		if err := r.ProcedureCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ProcedureCodeableConcept: %w", err)
-		}
-	}
-	if r.ProcedureReference == nil {
-		return fmt.Errorf("field 'ProcedureReference' is required")
-	}
-	if r.ProcedureReference != nil {
-		if err := r.ProcedureReference.Validate(); err != nil {
-			return fmt.Errorf("ProcedureReference: %w", err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Udi {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Udi[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ExplanationOfBenefitItem struct {
-	Id                      *string                                `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	Sequence                int                                    `json:"sequence" bson:"sequence"`                                                     // Item instance identifier
-	CareTeamSequence        []int                                  `json:"careTeamSequence,omitempty" bson:"care_team_sequence,omitempty"`               // Applicable care team members
-	DiagnosisSequence       []int                                  `json:"diagnosisSequence,omitempty" bson:"diagnosis_sequence,omitempty"`              // Applicable diagnoses
-	ProcedureSequence       []int                                  `json:"procedureSequence,omitempty" bson:"procedure_sequence,omitempty"`              // Applicable procedures
-	InformationSequence     []int                                  `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
-	TraceNumber             []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
-	Subject                 *Reference                             `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
-	Revenue                 *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
-	Category                *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
-	ProductOrService        *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
-	ProductOrServiceEnd     *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
-	Request                 []Reference                            `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
-	Modifier                []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Product or service billing modifiers
-	ProgramCode             []CodeableConcept                      `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
-	ServicedDate            *string                                `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`                        // Date or dates of service or product delivery
-	ServicedPeriod          *Period                                `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"`                    // Date or dates of service or product delivery
-	LocationCodeableConcept *CodeableConcept                       `json:"locationCodeableConcept,omitempty" bson:"location_codeable_concept,omitempty"` // Place of service or where product was supplied
-	LocationAddress         *Address                               `json:"locationAddress,omitempty" bson:"location_address,omitempty"`                  // Place of service or where product was supplied
-	LocationReference       *Reference                             `json:"locationReference,omitempty" bson:"location_reference,omitempty"`              // Place of service or where product was supplied
-	PatientPaid             *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                          // Paid by the patient
-	Quantity                *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
-	UnitPrice               *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
-	Factor                  *float64                               `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
-	Tax                     *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
-	Net                     *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
-	Udi                     []Reference                            `json:"udi,omitempty" bson:"udi,omitempty"`                                           // Unique device identifier
-	BodySite                []ExplanationOfBenefitItemBodySite     `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
-	Encounter               []Reference                            `json:"encounter,omitempty" bson:"encounter,omitempty"`                               // Encounters associated with the listed treatments
-	NoteNumber              []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
-	ReviewOutcome           *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Adjudication results
-	Adjudication            []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Adjudication details
-	Detail                  []ExplanationOfBenefitItemDetail       `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Additional items
-}
-
-func (r *ExplanationOfBenefitItem) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
-	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
 		}
 	}
-	if r.Subject != nil {
-		if err := r.Subject.Validate(); err != nil {
-			return fmt.Errorf("Subject: %w", err)
-		}
-	}
 	if r.Revenue != nil {
 		if err := r.Revenue.Validate(); err != nil {
 			return fmt.Errorf("Revenue: %w", err)
 		}
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
-	}
 	if r.ProductOrService != nil {
 		if err := r.ProductOrService.Validate(); err != nil {
 			return fmt.Errorf("ProductOrService: %w", err)
@@ -1226,41 +6106,11 @@ func (r *ExplanationOfBenefitItem) Validate() error {
 			return fmt.Errorf("ProductOrServiceEnd: %w", err)
 		}
 	}
-	for i, item := range r.Request {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Request[%d]: %w", i, err)
-		}
-	}
 	for i, item := range r.Modifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Modifier[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.ProgramCode {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
-		}
-	}
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
-		}
-	}
-	if r.LocationCodeableConcept != nil {
-		if err := r.LocationCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("LocationCodeableConcept: %w", err)
-		}
-	}
-	if r.LocationAddress != nil {
-		if err := r.LocationAddress.Validate(); err != nil {
-			return fmt.Errorf("LocationAddress: %w", err)
-		}
-	}
-	if r.LocationReference != nil {
-		if err := r.LocationReference.Validate(); err != nil {
-			return fmt.Errorf("LocationReference: %w", err)
-		}
-	}
 	if r.PatientPaid != nil {
 		if err := r.PatientPaid.Validate(); err != nil {
 			return fmt.Errorf("PatientPaid: %w", err)
@@ -1276,6 +6126,16 @@ func (r *ExplanationOfBenefitItem) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -1286,19 +6146,12 @@ func (r *ExplanationOfBenefitItem) Validate() error {
 			return fmt.Errorf("Net: %w", err)
 		}
 	}
-	for i, item := range r.Udi {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Udi[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.BodySite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("BodySite[%d]: %w", i, err)
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
 		}
-	}
-	for i, item := range r.Encounter {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Encounter[%d]: %w", i, err)
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
 		}
 	}
 	if r.ReviewOutcome != nil {
@@ -1311,90 +6164,192 @@ func (r *ExplanationOfBenefitItem) Validate() error {
 			return fmt.Errorf("Adjudication[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Detail {
+	for i, item := range r.SubDetail {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
+			return fmt.Errorf("SubDetail[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type ExplanationOfBenefitItemBodySite struct {
-	Id      *string             `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	Site    []CodeableReference `json:"site" bson:"site"`                            // Location
-	SubSite []CodeableConcept   `json:"subSite,omitempty" bson:"sub_site,omitempty"` // Sub-location
-}
-
-func (r *ExplanationOfBenefitItemBodySite) Validate() error {
-	if len(r.Site) < 1 {
-		return fmt.Errorf("field 'Site' must have at least 1 elements")
+func (r *ExplanationOfBenefitAddItemDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.Site {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Site[%d]: %w", i, err)
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
 		}
 	}
-	for i, item := range r.SubSite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubSite[%d]: %w", i, err)
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+	for i, item := range r.SubDetail {
+		item.validateAll(fmt.Sprintf("%s.subDetail[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ExplanationOfBenefitItemReviewOutcome struct {
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Decision      *CodeableConcept  `json:"decision,omitempty" bson:"decision,omitempty"`             // Result of the adjudication
-	Reason        []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                 // Reason for result of the adjudication
-	PreAuthRef    *string           `json:"preAuthRef,omitempty" bson:"pre_auth_ref,omitempty"`       // Preauthorization reference
-	PreAuthPeriod *Period           `json:"preAuthPeriod,omitempty" bson:"pre_auth_period,omitempty"` // Preauthorization reference effective period
+var explanationOfBenefitAddItemDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ExplanationOfBenefitItemReviewOutcome) Validate() error {
-	if r.Decision != nil {
-		if err := r.Decision.Validate(); err != nil {
-			return fmt.Errorf("Decision: %w", err)
-		}
-	}
-	for i, item := range r.Reason {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Reason[%d]: %w", i, err)
-		}
-	}
-	if r.PreAuthPeriod != nil {
-		if err := r.PreAuthPeriod.Validate(); err != nil {
-			return fmt.Errorf("PreAuthPeriod: %w", err)
-		}
+func (r *ExplanationOfBenefitAddItemDetail) invariants() []invariant {
+	return explanationOfBenefitAddItemDetailInvariants
+}
+
+func (r ExplanationOfBenefitAddItemDetail) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitAddItemDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitAddItemDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
 	}
 	return nil
 }
 
-type ExplanationOfBenefitItemDetail struct {
-	Id                  *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	Sequence            int                                       `json:"sequence" bson:"sequence"`                                              // Product or service provided
-	TraceNumber         []Identifier                              `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
-	Revenue             *CodeableConcept                          `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
-	Category            *CodeableConcept                          `json:"category,omitempty" bson:"category,omitempty"`                          // Benefit classification
-	ProductOrService    *CodeableConcept                          `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
-	ProductOrServiceEnd *CodeableConcept                          `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
-	Modifier            []CodeableConcept                         `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
-	ProgramCode         []CodeableConcept                         `json:"programCode,omitempty" bson:"program_code,omitempty"`                   // Program the product or service is provided under
-	PatientPaid         *Money                                    `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
-	Quantity            *Quantity                                 `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
-	UnitPrice           *Money                                    `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
-	Factor              *float64                                  `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
-	Tax                 *Money                                    `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
-	Net                 *Money                                    `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
-	Udi                 []Reference                               `json:"udi,omitempty" bson:"udi,omitempty"`                                    // Unique device identifier
-	NoteNumber          []int                                     `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
-	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome    `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Detail level adjudication results
-	Adjudication        []ExplanationOfBenefitItemAdjudication    `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Detail level adjudication details
-	SubDetail           []ExplanationOfBenefitItemDetailSubDetail `json:"subDetail,omitempty" bson:"sub_detail,omitempty"`                       // Additional items
+func (r ExplanationOfBenefitAddItemDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitAddItemDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitAddItemDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitAddItemDetailElementTypes = map[string]string{
+	"noteNumber": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitAddItemDetail) elementTypes() map[string]string {
+	return explanationOfBenefitAddItemDetailElementTypes
+}
+
+var explanationOfBenefitAddItemDetailElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.addItem.detail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.addItem.detail.traceNumber", Name: "traceNumber", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.revenue", Name: "revenue", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.productOrService", Name: "productOrService", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.modifier", Name: "modifier", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.patientPaid", Name: "patientPaid", Field: 8, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.quantity", Name: "quantity", Field: 9, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.unitPrice", Name: "unitPrice", Field: 10, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.factor", Name: "factor", Field: 11, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.tax", Name: "tax", Field: 13, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.net", Name: "net", Field: 14, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.noteNumber", Name: "noteNumber", Field: 15, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.reviewOutcome", Name: "reviewOutcome", Field: 17, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.adjudication", Name: "adjudication", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail", Name: "subDetail", Field: 19, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
 }
 
-func (r *ExplanationOfBenefitItemDetail) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+func (r *ExplanationOfBenefitAddItemDetail) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitAddItemDetailElementMetadata
+}
+
+type ExplanationOfBenefitAddItemDetailSubDetail struct {
+	Id                  *string                                `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension           []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension   []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	TraceNumber         []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                   // Number for tracking
+	Revenue             *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                            // Revenue or cost center code
+	ProductOrService    *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`        // Billing, service, product, or drug code
+	ProductOrServiceEnd *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"` // End of a range of codes
+	Modifier            []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                          // Service/Product billing modifiers
+	PatientPaid         *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                   // Paid by the patient
+	Quantity            *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                          // Count of products or services
+	UnitPrice           *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                       // Fee, charge or cost per item
+	Factor              *Decimal                               `json:"factor,omitempty" bson:"factor,omitempty"`                              // Price scaling factor
+	FactorElement       *Element                               `json:"_factor,omitempty" bson:"factor_element,omitempty"`                     // Extensions for factor
+	Tax                 *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                    // Total tax
+	Net                 *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                    // Total item cost
+	NoteNumber          []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                     // Applicable note numbers
+	NoteNumberElement   []*Element                             `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`            // Extensions for noteNumber
+	ReviewOutcome       *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`               // Additem subdetail level adjudication results
+	Adjudication        []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                  // Added items adjudication
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
 	}
 	for i, item := range r.TraceNumber {
 		if err := item.Validate(); err != nil {
@@ -1406,11 +6361,6 @@ func (r *ExplanationOfBenefitItemDetail) Validate() error {
 			return fmt.Errorf("Revenue: %w", err)
 		}
 	}
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
-	}
 	if r.ProductOrService != nil {
 		if err := r.ProductOrService.Validate(); err != nil {
 			return fmt.Errorf("ProductOrService: %w", err)
@@ -1426,11 +6376,6 @@ func (r *ExplanationOfBenefitItemDetail) Validate() error {
 			return fmt.Errorf("Modifier[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.ProgramCode {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
-		}
-	}
 	if r.PatientPaid != nil {
 		if err := r.PatientPaid.Validate(); err != nil {
 			return fmt.Errorf("PatientPaid: %w", err)
@@ -1446,6 +6391,16 @@ func (r *ExplanationOfBenefitItemDetail) Validate() error {
 			return fmt.Errorf("UnitPrice: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Tax != nil {
 		if err := r.Tax.Validate(); err != nil {
 			return fmt.Errorf("Tax: %w", err)
@@ -1456,9 +6411,12 @@ func (r *ExplanationOfBenefitItemDetail) Validate() error {
 			return fmt.Errorf("Net: %w", err)
 		}
 	}
-	for i, item := range r.Udi {
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Udi[%d]: %w", i, err)
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
 		}
 	}
 	if r.ReviewOutcome != nil {
@@ -1471,219 +6429,532 @@ func (r *ExplanationOfBenefitItemDetail) Validate() error {
 			return fmt.Errorf("Adjudication[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.SubDetail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubDetail[%d]: %w", i, err)
+	return nil
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.TraceNumber {
+		item.validateAll(fmt.Sprintf("%s.traceNumber[%d]", path, i), issues)
+	}
+	if r.Revenue != nil {
+		r.Revenue.validateAll(path+".revenue", issues)
+	}
+	if r.ProductOrService != nil {
+		r.ProductOrService.validateAll(path+".productOrService", issues)
+	}
+	if r.ProductOrServiceEnd != nil {
+		r.ProductOrServiceEnd.validateAll(path+".productOrServiceEnd", issues)
+	}
+	for i, item := range r.Modifier {
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	if r.PatientPaid != nil {
+		r.PatientPaid.validateAll(path+".patientPaid", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.UnitPrice != nil {
+		r.UnitPrice.validateAll(path+".unitPrice", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Tax != nil {
+		r.Tax.validateAll(path+".tax", issues)
+	}
+	if r.Net != nil {
+		r.Net.validateAll(path+".net", issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+	if r.ReviewOutcome != nil {
+		r.ReviewOutcome.validateAll(path+".reviewOutcome", issues)
+	}
+	for i, item := range r.Adjudication {
+		item.validateAll(fmt.Sprintf("%s.adjudication[%d]", path, i), issues)
+	}
+}
+
+var explanationOfBenefitAddItemDetailSubDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) invariants() []invariant {
+	return explanationOfBenefitAddItemDetailSubDetailInvariants
+}
+
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitAddItemDetailSubDetail
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	return json.Marshal(out)
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitAddItemDetailSubDetail
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
 	}
 	return nil
 }
 
-type ExplanationOfBenefitAddItem struct {
-	Id                      *string                                `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	ItemSequence            []int                                  `json:"itemSequence,omitempty" bson:"item_sequence,omitempty"`                        // Item sequence number
-	DetailSequence          []int                                  `json:"detailSequence,omitempty" bson:"detail_sequence,omitempty"`                    // Detail sequence number
-	SubDetailSequence       []int                                  `json:"subDetailSequence,omitempty" bson:"sub_detail_sequence,omitempty"`             // Subdetail sequence number
-	TraceNumber             []Identifier                           `json:"traceNumber,omitempty" bson:"trace_number,omitempty"`                          // Number for tracking
-	Subject                 *Reference                             `json:"subject,omitempty" bson:"subject,omitempty"`                                   // The recipient of the products and services
-	InformationSequence     []int                                  `json:"informationSequence,omitempty" bson:"information_sequence,omitempty"`          // Applicable exception and supporting information
-	Provider                []Reference                            `json:"provider,omitempty" bson:"provider,omitempty"`                                 // Authorized providers
-	Revenue                 *CodeableConcept                       `json:"revenue,omitempty" bson:"revenue,omitempty"`                                   // Revenue or cost center code
-	Category                *CodeableConcept                       `json:"category,omitempty" bson:"category,omitempty"`                                 // Benefit classification
-	ProductOrService        *CodeableConcept                       `json:"productOrService,omitempty" bson:"product_or_service,omitempty"`               // Billing, service, product, or drug code
-	ProductOrServiceEnd     *CodeableConcept                       `json:"productOrServiceEnd,omitempty" bson:"product_or_service_end,omitempty"`        // End of a range of codes
-	Request                 []Reference                            `json:"request,omitempty" bson:"request,omitempty"`                                   // Request or Referral for Service
-	Modifier                []CodeableConcept                      `json:"modifier,omitempty" bson:"modifier,omitempty"`                                 // Service/Product billing modifiers
-	ProgramCode             []CodeableConcept                      `json:"programCode,omitempty" bson:"program_code,omitempty"`                          // Program the product or service is provided under
-	ServicedDate            *string                                `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`                        // Date or dates of service or product delivery
-	ServicedPeriod          *Period                                `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"`                    // Date or dates of service or product delivery
-	LocationCodeableConcept *CodeableConcept                       `json:"locationCodeableConcept,omitempty" bson:"location_codeable_concept,omitempty"` // Place of service or where product was supplied
-	LocationAddress         *Address                               `json:"locationAddress,omitempty" bson:"location_address,omitempty"`                  // Place of service or where product was supplied
-	LocationReference       *Reference                             `json:"locationReference,omitempty" bson:"location_reference,omitempty"`              // Place of service or where product was supplied
-	PatientPaid             *Money                                 `json:"patientPaid,omitempty" bson:"patient_paid,omitempty"`                          // Paid by the patient
-	Quantity                *Quantity                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                                 // Count of products or services
-	UnitPrice               *Money                                 `json:"unitPrice,omitempty" bson:"unit_price,omitempty"`                              // Fee, charge or cost per item
-	Factor                  *float64                               `json:"factor,omitempty" bson:"factor,omitempty"`                                     // Price scaling factor
-	Tax                     *Money                                 `json:"tax,omitempty" bson:"tax,omitempty"`                                           // Total tax
-	Net                     *Money                                 `json:"net,omitempty" bson:"net,omitempty"`                                           // Total item cost
-	BodySite                []ExplanationOfBenefitAddItemBodySite  `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                // Anatomical location
-	NoteNumber              []int                                  `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                            // Applicable note numbers
-	ReviewOutcome           *ExplanationOfBenefitItemReviewOutcome `json:"reviewOutcome,omitempty" bson:"review_outcome,omitempty"`                      // Additem level adjudication results
-	Adjudication            []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty" bson:"adjudication,omitempty"`                         // Added items adjudication
-	Detail                  []ExplanationOfBenefitAddItemDetail    `json:"detail,omitempty" bson:"detail,omitempty"`                                     // Insurer added line items
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ExplanationOfBenefitAddItem) Validate() error {
-	for i, item := range r.TraceNumber {
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitAddItemDetailSubDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitAddItemDetailSubDetailElementTypes = map[string]string{
+	"noteNumber": "positiveInt",
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) elementTypes() map[string]string {
+	return explanationOfBenefitAddItemDetailSubDetailElementTypes
+}
+
+var explanationOfBenefitAddItemDetailSubDetailElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.traceNumber", Name: "traceNumber", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.revenue", Name: "revenue", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.productOrService", Name: "productOrService", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.productOrServiceEnd", Name: "productOrServiceEnd", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.modifier", Name: "modifier", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.patientPaid", Name: "patientPaid", Field: 8, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.quantity", Name: "quantity", Field: 9, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.unitPrice", Name: "unitPrice", Field: 10, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.factor", Name: "factor", Field: 11, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.tax", Name: "tax", Field: 13, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.net", Name: "net", Field: 14, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.noteNumber", Name: "noteNumber", Field: 15, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.reviewOutcome", Name: "reviewOutcome", Field: 17, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ExplanationOfBenefit.addItem.detail.subDetail.adjudication", Name: "adjudication", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefitAddItemDetailSubDetail) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitAddItemDetailSubDetailElementMetadata
+}
+
+type ExplanationOfBenefitTotal struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category          *CodeableConcept `json:"category" bson:"category"`                                        // Type of adjudication information
+	Amount            *Money           `json:"amount" bson:"amount"`                                            // Financial total for the category
+}
+
+func (r *ExplanationOfBenefitTotal) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("TraceNumber[%d]: %w", i, err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.Subject != nil {
-		if err := r.Subject.Validate(); err != nil {
-			return fmt.Errorf("Subject: %w", err)
-		}
-	}
-	for i, item := range r.Provider {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Provider[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.Revenue != nil {
-		if err := r.Revenue.Validate(); err != nil {
-			return fmt.Errorf("Revenue: %w", err)
-		}
+	if r.Category == nil {
+		return fmt.Errorf("field 'Category' is required")
 	}
 	if r.Category != nil {
 		if err := r.Category.Validate(); err != nil {
 			return fmt.Errorf("Category: %w", err)
 		}
 	}
-	if r.ProductOrService != nil {
-		if err := r.ProductOrService.Validate(); err != nil {
-			return fmt.Errorf("ProductOrService: %w", err)
-		}
+	if r.Amount == nil {
+		return fmt.Errorf("field 'Amount' is required")
 	}
-	if r.ProductOrServiceEnd != nil {
-		if err := r.ProductOrServiceEnd.Validate(); err != nil {
-			return fmt.Errorf("ProductOrServiceEnd: %w", err)
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
 		}
 	}
-	for i, item := range r.Request {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Request[%d]: %w", i, err)
-		}
+	return nil
+}
+
+func (r *ExplanationOfBenefitTotal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.Modifier {
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+var explanationOfBenefitTotalInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitTotal) invariants() []invariant {
+	return explanationOfBenefitTotalInvariants
+}
+
+func (r ExplanationOfBenefitTotal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitTotal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitTotal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitTotal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitTotalElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.total.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.total.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.total.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.total.category", Name: "category", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.total.amount", Name: "amount", Field: 4, Min: 1, Max: "1", Types: []string{"Money"}},
+}
+
+func (r *ExplanationOfBenefitTotal) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitTotalElementMetadata
+}
+
+type ExplanationOfBenefitPayment struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Partial or complete payment
+	Adjustment        *Money           `json:"adjustment,omitempty" bson:"adjustment,omitempty"`                // Payment adjustment for non-claim issues
+	AdjustmentReason  *CodeableConcept `json:"adjustmentReason,omitempty" bson:"adjustment_reason,omitempty"`   // Explanation for the variance
+	Date              *Date            `json:"date,omitempty" bson:"date,omitempty"`                            // Expected date of payment
+	DateElement       *Element         `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Amount            *Money           `json:"amount,omitempty" bson:"amount,omitempty"`                        // Payable amount after adjustment
+	Identifier        *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Business identifier for the payment
+}
+
+func (r *ExplanationOfBenefitPayment) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Modifier[%d]: %w", i, err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.ProgramCode {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ProgramCode[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.LocationCodeableConcept != nil {
-		if err := r.LocationCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("LocationCodeableConcept: %w", err)
+	if r.Adjustment != nil {
+		if err := r.Adjustment.Validate(); err != nil {
+			return fmt.Errorf("Adjustment: %w", err)
 		}
 	}
-	if r.LocationAddress != nil {
-		if err := r.LocationAddress.Validate(); err != nil {
-			return fmt.Errorf("LocationAddress: %w", err)
+	if r.AdjustmentReason != nil {
+		if err := r.AdjustmentReason.Validate(); err != nil {
+			return fmt.Errorf("AdjustmentReason: %w", err)
 		}
 	}
-	if r.LocationReference != nil {
-		if err := r.LocationReference.Validate(); err != nil {
-			return fmt.Errorf("LocationReference: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
 		}
 	}
-	if r.PatientPaid != nil {
-		if err := r.PatientPaid.Validate(); err != nil {
-			return fmt.Errorf("PatientPaid: %w", err)
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
 		}
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
 		}
 	}
-	if r.UnitPrice != nil {
-		if err := r.UnitPrice.Validate(); err != nil {
-			return fmt.Errorf("UnitPrice: %w", err)
+	if r.Identifier != nil {
+		if err := r.Identifier.Validate(); err != nil {
+			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	if r.Tax != nil {
-		if err := r.Tax.Validate(); err != nil {
-			return fmt.Errorf("Tax: %w", err)
-		}
+	return nil
+}
+
+func (r *ExplanationOfBenefitPayment) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Net != nil {
-		if err := r.Net.Validate(); err != nil {
-			return fmt.Errorf("Net: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	for i, item := range r.BodySite {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("BodySite[%d]: %w", i, err)
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Adjustment != nil {
+		r.Adjustment.validateAll(path+".adjustment", issues)
+	}
+	if r.AdjustmentReason != nil {
+		r.AdjustmentReason.validateAll(path+".adjustmentReason", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.ReviewOutcome != nil {
-		if err := r.ReviewOutcome.Validate(); err != nil {
-			return fmt.Errorf("ReviewOutcome: %w", err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+}
+
+var explanationOfBenefitPaymentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitPayment) invariants() []invariant {
+	return explanationOfBenefitPaymentInvariants
+}
+
+func (r ExplanationOfBenefitPayment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitPayment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitPayment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitPayment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitPaymentElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.payment.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.payment.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.payment.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.payment.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.payment.adjustment", Name: "adjustment", Field: 4, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.payment.adjustmentReason", Name: "adjustmentReason", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.payment.date", Name: "date", Field: 6, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ExplanationOfBenefit.payment.amount", Name: "amount", Field: 8, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "ExplanationOfBenefit.payment.identifier", Name: "identifier", Field: 9, Min: 0, Max: "1", Types: []string{"Identifier"}},
+}
+
+func (r *ExplanationOfBenefitPayment) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitPaymentElementMetadata
+}
+
+type ExplanationOfBenefitProcessNote struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Class             *CodeableConcept `json:"class,omitempty" bson:"class,omitempty"`                          // Business kind of note
+	Number            *int             `json:"number,omitempty" bson:"number,omitempty"`                        // Note instance identifier
+	NumberElement     *Element         `json:"_number,omitempty" bson:"number_element,omitempty"`               // Extensions for number
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Note purpose
+	Text              *string          `json:"text,omitempty" bson:"text,omitempty"`                            // Note explanatory text
+	TextElement       *Element         `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+	Language          *CodeableConcept `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the text
+}
+
+func (r *ExplanationOfBenefitProcessNote) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Adjudication {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Adjudication[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Detail {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Detail[%d]: %w", i, err)
+	if r.Class != nil {
+		if err := r.Class.Validate(); err != nil {
+			return fmt.Errorf("Class: %w", err)
+		}
+	}
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
 		}
 	}
-	return nil
-}
-
-type ExplanationOfBenefitPayment struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                          // Partial or complete payment
-	Adjustment       *Money           `json:"adjustment,omitempty" bson:"adjustment,omitempty"`              // Payment adjustment for non-claim issues
-	AdjustmentReason *CodeableConcept `json:"adjustmentReason,omitempty" bson:"adjustment_reason,omitempty"` // Explanation for the variance
-	Date             *string          `json:"date,omitempty" bson:"date,omitempty"`                          // Expected date of payment
-	Amount           *Money           `json:"amount,omitempty" bson:"amount,omitempty"`                      // Payable amount after adjustment
-	Identifier       *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`              // Business identifier for the payment
-}
-
-func (r *ExplanationOfBenefitPayment) Validate() error {
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.Adjustment != nil {
-		if err := r.Adjustment.Validate(); err != nil {
-			return fmt.Errorf("Adjustment: %w", err)
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
 		}
 	}
-	if r.AdjustmentReason != nil {
-		if err := r.AdjustmentReason.Validate(); err != nil {
-			return fmt.Errorf("AdjustmentReason: %w", err)
+	if r.Language != nil {
+		if err := r.Language.Validate(); err != nil {
+			return fmt.Errorf("Language: %w", err)
 		}
 	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
+	return nil
+}
+
+func (r *ExplanationOfBenefitProcessNote) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Identifier != nil {
-		if err := r.Identifier.Validate(); err != nil {
-			return fmt.Errorf("Identifier: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	return nil
+	if r.Class != nil {
+		r.Class.validateAll(path+".class", issues)
+	}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
+	}
+}
+
+var explanationOfBenefitProcessNoteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitProcessNote) invariants() []invariant {
+	return explanationOfBenefitProcessNoteInvariants
+}
+
+func (r ExplanationOfBenefitProcessNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitProcessNote) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitProcessNote) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitProcessNote) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitProcessNoteElementTypes = map[string]string{
+	"number": "positiveInt",
+	"text":   "markdown",
+}
+
+func (r *ExplanationOfBenefitProcessNote) elementTypes() map[string]string {
+	return explanationOfBenefitProcessNoteElementTypes
+}
+
+var explanationOfBenefitProcessNoteElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.processNote.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.processNote.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.processNote.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.processNote.class", Name: "class", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.processNote.number", Name: "number", Field: 4, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "ExplanationOfBenefit.processNote.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.processNote.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ExplanationOfBenefit.processNote.language", Name: "language", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ExplanationOfBenefitProcessNote) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitProcessNoteElementMetadata
 }
 
 type ExplanationOfBenefitBenefitBalance struct {
-	Id          *string                                       `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Category    *CodeableConcept                              `json:"category" bson:"category"`                           // Benefit classification
-	Excluded    *bool                                         `json:"excluded,omitempty" bson:"excluded,omitempty"`       // Excluded from the plan
-	Name        *string                                       `json:"name,omitempty" bson:"name,omitempty"`               // Short name for the benefit
-	Description *string                                       `json:"description,omitempty" bson:"description,omitempty"` // Description of the benefit or services covered
-	Network     *CodeableConcept                              `json:"network,omitempty" bson:"network,omitempty"`         // In or out of network
-	Unit        *CodeableConcept                              `json:"unit,omitempty" bson:"unit,omitempty"`               // Individual or family
-	Term        *CodeableConcept                              `json:"term,omitempty" bson:"term,omitempty"`               // Annual or lifetime
-	Financial   []ExplanationOfBenefitBenefitBalanceFinancial `json:"financial,omitempty" bson:"financial,omitempty"`     // Benefit Summary
+	Id                 *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category           *CodeableConcept                              `json:"category" bson:"category"`                                        // Benefit classification
+	Excluded           *bool                                         `json:"excluded,omitempty" bson:"excluded,omitempty"`                    // Excluded from the plan
+	ExcludedElement    *Element                                      `json:"_excluded,omitempty" bson:"excluded_element,omitempty"`           // Extensions for excluded
+	Name               *string                                       `json:"name,omitempty" bson:"name,omitempty"`                            // Short name for the benefit
+	NameElement        *Element                                      `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Description        *string                                       `json:"description,omitempty" bson:"description,omitempty"`              // Description of the benefit or services covered
+	DescriptionElement *Element                                      `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Network            *CodeableConcept                              `json:"network,omitempty" bson:"network,omitempty"`                      // In or out of network
+	Unit               *CodeableConcept                              `json:"unit,omitempty" bson:"unit,omitempty"`                            // Individual or family
+	Term               *CodeableConcept                              `json:"term,omitempty" bson:"term,omitempty"`                            // Annual or lifetime
+	Financial          []ExplanationOfBenefitBenefitBalanceFinancial `json:"financial,omitempty" bson:"financial,omitempty"`                  // Benefit Summary
 }
 
 func (r *ExplanationOfBenefitBenefitBalance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Category == nil {
 		return fmt.Errorf("field 'Category' is required")
 	}
@@ -1692,6 +6963,21 @@ func (r *ExplanationOfBenefitBenefitBalance) Validate() error {
 			return fmt.Errorf("Category: %w", err)
 		}
 	}
+	if r.ExcludedElement != nil {
+		if err := r.ExcludedElement.Validate(); err != nil {
+			return fmt.Errorf("ExcludedElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.Network != nil {
 		if err := r.Network.Validate(); err != nil {
 			return fmt.Errorf("Network: %w", err)
@@ -1715,98 +7001,393 @@ func (r *ExplanationOfBenefitBenefitBalance) Validate() error {
 	return nil
 }
 
-type ExplanationOfBenefitRelated struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Claim        *Reference       `json:"claim,omitempty" bson:"claim,omitempty"`               // Reference to the related claim
-	Relationship *CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"` // How the reference claim is related
-	Reference    *Identifier      `json:"reference,omitempty" bson:"reference,omitempty"`       // File or case reference
-}
-
-func (r *ExplanationOfBenefitRelated) Validate() error {
-	if r.Claim != nil {
-		if err := r.Claim.Validate(); err != nil {
-			return fmt.Errorf("Claim: %w", err)
-		}
+func (r *ExplanationOfBenefitBenefitBalance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Relationship != nil {
-		if err := r.Relationship.Validate(); err != nil {
-			return fmt.Errorf("Relationship: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Reference != nil {
-		if err := r.Reference.Validate(); err != nil {
-			return fmt.Errorf("Reference: %w", err)
-		}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.ExcludedElement != nil {
+		r.ExcludedElement.validateAll(path+".excluded", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Network != nil {
+		r.Network.validateAll(path+".network", issues)
+	}
+	if r.Unit != nil {
+		r.Unit.validateAll(path+".unit", issues)
+	}
+	if r.Term != nil {
+		r.Term.validateAll(path+".term", issues)
+	}
+	for i, item := range r.Financial {
+		item.validateAll(fmt.Sprintf("%s.financial[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type ExplanationOfBenefitDiagnosis struct {
-	Id                       *string           `json:"id,omitempty" bson:"id,omitempty"`                           // Unique id for inter-element referencing
-	Sequence                 int               `json:"sequence" bson:"sequence"`                                   // Diagnosis instance identifier
-	DiagnosisCodeableConcept *CodeableConcept  `json:"diagnosisCodeableConcept" bson:"diagnosis_codeable_concept"` // Nature of illness or problem
-	DiagnosisReference       *Reference        `json:"diagnosisReference" bson:"diagnosis_reference"`              // Nature of illness or problem
-	Type                     []CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                       // Timing or nature of the diagnosis
-	OnAdmission              *CodeableConcept  `json:"onAdmission,omitempty" bson:"on_admission,omitempty"`        // Present on admission
+var explanationOfBenefitBenefitBalanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *ExplanationOfBenefitDiagnosis) Validate() error {
-	if r.Sequence == 0 {
-		return fmt.Errorf("field 'Sequence' is required")
+func (r *ExplanationOfBenefitBenefitBalance) invariants() []invariant {
+	return explanationOfBenefitBenefitBalanceInvariants
+}
+
+func (r ExplanationOfBenefitBenefitBalance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitBenefitBalance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitBenefitBalance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitBenefitBalance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var explanationOfBenefitBenefitBalanceElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.benefitBalance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.benefitBalance.category", Name: "category", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.excluded", Name: "excluded", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.name", Name: "name", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.network", Name: "network", Field: 10, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.unit", Name: "unit", Field: 11, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.term", Name: "term", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.financial", Name: "financial", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ExplanationOfBenefitBenefitBalance) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitBenefitBalanceElementMetadata
+}
+
+type ExplanationOfBenefitBenefitBalanceFinancial struct {
+	Id                *string                                            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                                   `json:"type" bson:"type"`                                                // Benefit classification
+	Allowed           ExplanationOfBenefitBenefitBalanceFinancialAllowed `json:"-" bson:"allowed,omitempty"`                                      // Benefits allowed
+	AllowedElement    *Element                                           `json:"-" bson:"allowed_element,omitempty"`                              // Extensions for allowed[x]
+	Used              ExplanationOfBenefitBenefitBalanceFinancialUsed    `json:"-" bson:"used,omitempty"`                                         // Benefits used
+	UsedElement       *Element                                           `json:"-" bson:"used_element,omitempty"`                                 // Extensions for used[x]
+
+	allowedVariants []string // JSON properties of ExplanationOfBenefit.benefitBalance.financial.allowed[x] when more than one was decoded
+	usedVariants    []string // JSON properties of ExplanationOfBenefit.benefitBalance.financial.used[x] when more than one was decoded
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
 	}
-	if r.DiagnosisCodeableConcept == nil {
-		return fmt.Errorf("field 'DiagnosisCodeableConcept' is required")
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
 	}
-	if r.DiagnosisCodeableConcept != nil {
-		if err := r.DiagnosisCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DiagnosisCodeableConcept: %w", err)
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.DiagnosisReference == nil {
-		return fmt.Errorf("field 'DiagnosisReference' is required")
+	if len(r.allowedVariants) > 1 {
+		return fmt.Errorf("field 'Allowed' must have a single type, got %v", r.allowedVariants)
 	}
-	if r.DiagnosisReference != nil {
-		if err := r.DiagnosisReference.Validate(); err != nil {
-			return fmt.Errorf("DiagnosisReference: %w", err)
+	if r.Allowed != nil {
+		if err := r.Allowed.Validate(); err != nil {
+			return fmt.Errorf("Allowed: %w", err)
 		}
 	}
-	for i, item := range r.Type {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
+	if r.AllowedElement != nil {
+		if err := r.AllowedElement.Validate(); err != nil {
+			return fmt.Errorf("AllowedElement: %w", err)
 		}
 	}
-	if r.OnAdmission != nil {
-		if err := r.OnAdmission.Validate(); err != nil {
-			return fmt.Errorf("OnAdmission: %w", err)
+	if len(r.usedVariants) > 1 {
+		return fmt.Errorf("field 'Used' must have a single type, got %v", r.usedVariants)
+	}
+	if r.Used != nil {
+		if err := r.Used.Validate(); err != nil {
+			return fmt.Errorf("Used: %w", err)
+		}
+	}
+	if r.UsedElement != nil {
+		if err := r.UsedElement.Validate(); err != nil {
+			return fmt.Errorf("UsedElement: %w", err)
 		}
 	}
 	return nil
 }
 
-type ExplanationOfBenefitProcessNote struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Class    *CodeableConcept `json:"class,omitempty" bson:"class,omitempty"`       // Business kind of note
-	Number   *int             `json:"number,omitempty" bson:"number,omitempty"`     // Note instance identifier
-	Type     *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`         // Note purpose
-	Text     *string          `json:"text,omitempty" bson:"text,omitempty"`         // Note explanatory text
-	Language *CodeableConcept `json:"language,omitempty" bson:"language,omitempty"` // Language of the text
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.allowedVariants) > 1 {
+		issues.add("structure", path+".allowed", fmt.Sprintf("field 'Allowed' must have a single type, got %v", r.allowedVariants))
+	}
+	if r.Allowed != nil {
+		r.Allowed.validateAll(path+".allowed.ofType("+r.Allowed.FHIRType()+")", issues)
+	}
+	if r.AllowedElement != nil {
+		r.AllowedElement.validateAll(path+".allowed", issues)
+	}
+	if len(r.usedVariants) > 1 {
+		issues.add("structure", path+".used", fmt.Sprintf("field 'Used' must have a single type, got %v", r.usedVariants))
+	}
+	if r.Used != nil {
+		r.Used.validateAll(path+".used.ofType("+r.Used.FHIRType()+")", issues)
+	}
+	if r.UsedElement != nil {
+		r.UsedElement.validateAll(path+".used", issues)
+	}
 }
 
-func (r *ExplanationOfBenefitProcessNote) Validate() error {
-	if r.Class != nil {
-		if err := r.Class.Validate(); err != nil {
-			return fmt.Errorf("Class: %w", err)
-		}
+var explanationOfBenefitBenefitBalanceFinancialInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) invariants() []invariant {
+	return explanationOfBenefitBenefitBalanceFinancialInvariants
+}
+
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalJSON() ([]byte, error) {
+	type alias ExplanationOfBenefitBenefitBalanceFinancial
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "allowed", r.Allowed, r.AllowedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "used", r.Used, r.UsedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalJSON(data []byte) error {
+	type alias ExplanationOfBenefitBenefitBalanceFinancial
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Allowed, element, r.allowedVariants, err = unmarshalChoice(fields, "allowed", explanationOfBenefitBenefitBalanceFinancialAllowedVariants); err != nil {
+		return err
+	}
+	r.AllowedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AllowedElement); err != nil {
+			return fmt.Errorf("_allowed: %w", err)
 		}
 	}
-	if r.Language != nil {
-		if err := r.Language.Validate(); err != nil {
-			return fmt.Errorf("Language: %w", err)
+	if r.Used, element, r.usedVariants, err = unmarshalChoice(fields, "used", explanationOfBenefitBenefitBalanceFinancialUsedVariants); err != nil {
+		return err
+	}
+	r.UsedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.UsedElement); err != nil {
+			return fmt.Errorf("_used: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExplanationOfBenefitBenefitBalanceFinancial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Allowed":
+		return "allowed", choiceValues(explanationOfBenefitBenefitBalanceFinancialAllowedVariants)
+	case "Used":
+		return "used", choiceValues(explanationOfBenefitBenefitBalanceFinancialUsedVariants)
+	}
+	return "", nil
+}
+
+var explanationOfBenefitBenefitBalanceFinancialElementMetadata = []ElementMetadata{
+	{Path: "ExplanationOfBenefit.benefitBalance.financial.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.financial.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.financial.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ExplanationOfBenefit.benefitBalance.financial.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.financial.allowed[x]", Name: "allowed", Field: 4, Min: 0, Max: "1", Types: []string{"unsignedInt", "string", "Money"}},
+	{Path: "ExplanationOfBenefit.benefitBalance.financial.used[x]", Name: "used", Field: 6, Min: 0, Max: "1", Types: []string{"unsignedInt", "Money"}},
+}
+
+func (r *ExplanationOfBenefitBenefitBalanceFinancial) ElementMetadata() []ElementMetadata {
+	return explanationOfBenefitBenefitBalanceFinancialElementMetadata
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialAllowed is the type of ExplanationOfBenefit.benefitBalance.financial.allowed[x]. It is implemented by the ExplanationOfBenefitBenefitBalanceFinancialAllowed* variant types.
+type ExplanationOfBenefitBenefitBalanceFinancialAllowed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitBenefitBalanceFinancialAllowed()
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt is the unsignedInt variant of ExplanationOfBenefitBenefitBalanceFinancialAllowed.
+type ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt int
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt) isExplanationOfBenefitBenefitBalanceFinancialAllowed() {
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialAllowedString is the string variant of ExplanationOfBenefitBenefitBalanceFinancialAllowed.
+type ExplanationOfBenefitBenefitBalanceFinancialAllowedString string
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedString) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedString) FHIRType() string {
+	return "string"
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedString) isExplanationOfBenefitBenefitBalanceFinancialAllowed() {
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney is the Money variant of ExplanationOfBenefitBenefitBalanceFinancialAllowed.
+type ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney struct {
+	Money
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney) isExplanationOfBenefitBenefitBalanceFinancialAllowed() {
+}
+
+var explanationOfBenefitBenefitBalanceFinancialAllowedVariants = []ExplanationOfBenefitBenefitBalanceFinancialAllowed{
+	ExplanationOfBenefitBenefitBalanceFinancialAllowedUnsignedInt(0),
+	ExplanationOfBenefitBenefitBalanceFinancialAllowedString(""),
+	ExplanationOfBenefitBenefitBalanceFinancialAllowedMoney{},
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialUsed is the type of ExplanationOfBenefit.benefitBalance.financial.used[x]. It is implemented by the ExplanationOfBenefitBenefitBalanceFinancialUsed* variant types.
+type ExplanationOfBenefitBenefitBalanceFinancialUsed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExplanationOfBenefitBenefitBalanceFinancialUsed()
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt is the unsignedInt variant of ExplanationOfBenefitBenefitBalanceFinancialUsed.
+type ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt int
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt) isExplanationOfBenefitBenefitBalanceFinancialUsed() {
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancialUsedMoney is the Money variant of ExplanationOfBenefitBenefitBalanceFinancialUsed.
+type ExplanationOfBenefitBenefitBalanceFinancialUsedMoney struct {
+	Money
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ExplanationOfBenefitBenefitBalanceFinancialUsedMoney) isExplanationOfBenefitBenefitBalanceFinancialUsed() {
+}
+
+var explanationOfBenefitBenefitBalanceFinancialUsedVariants = []ExplanationOfBenefitBenefitBalanceFinancialUsed{
+	ExplanationOfBenefitBenefitBalanceFinancialUsedUnsignedInt(0),
+	ExplanationOfBenefitBenefitBalanceFinancialUsedMoney{},
+}
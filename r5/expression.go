@@ -1,15 +1,134 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Expression Type: A expression that is evaluated in a specified context and returns a value. The context of use of the expression must specify the context in which the expression is evaluated, and how the result of the expression is used.
 type Expression struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Natural language description of the condition
-	Name        *string `json:"name,omitempty" bson:"name,omitempty"`               // Short name assigned to expression for reuse
-	Language    *string `json:"language,omitempty" bson:"language,omitempty"`       // text/cql | text/fhirpath | application/x-fhir-query | etc.
-	Expression  *string `json:"expression,omitempty" bson:"expression,omitempty"`   // Expression in specified language
-	Reference   *string `json:"reference,omitempty" bson:"reference,omitempty"`     // Where the expression is found
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`              // Additional content defined by implementations
+	Description        *string     `json:"description,omitempty" bson:"description,omitempty"`          // Natural language description of the condition
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"` // Extensions for description
+	Name               *string     `json:"name,omitempty" bson:"name,omitempty"`                        // Short name assigned to expression for reuse
+	NameElement        *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`               // Extensions for name
+	Language           *string     `json:"language,omitempty" bson:"language,omitempty"`                // text/cql | text/fhirpath | application/x-fhir-query | etc.
+	LanguageElement    *Element    `json:"_language,omitempty" bson:"language_element,omitempty"`       // Extensions for language
+	Expression         *string     `json:"expression,omitempty" bson:"expression,omitempty"`            // Expression in specified language
+	ExpressionElement  *Element    `json:"_expression,omitempty" bson:"expression_element,omitempty"`   // Extensions for expression
+	Reference          *string     `json:"reference,omitempty" bson:"reference,omitempty"`              // Where the expression is found
+	ReferenceElement   *Element    `json:"_reference,omitempty" bson:"reference_element,omitempty"`     // Extensions for reference
 }
 
 func (r *Expression) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
+	if r.ExpressionElement != nil {
+		if err := r.ExpressionElement.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement: %w", err)
+		}
+	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Expression) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.ExpressionElement != nil {
+		r.ExpressionElement.validateAll(path+".expression", issues)
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+}
+
+func (r *Expression) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Expression", &issues)
+	checkInvariants(r, "Expression", &issues)
+	return issues
+}
+
+var expressionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "exp-1", severity: "error", human: "An expression or a reference must be provided", expression: "expression.exists() or reference.exists()"},
+	{key: "exp-2", severity: "error", human: "The name must be a valid variable name in most computer languages", expression: "name.hasValue() implies name.matches('[A-Za-z][A-Za-z0-9\\\\_]{0,63}')"},
+}
+
+func (r *Expression) invariants() []invariant {
+	return expressionInvariants
+}
+
+func (r Expression) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Expression) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Expression) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Expression) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var expressionElementTypes = map[string]string{
+	"name":      "code",
+	"language":  "code",
+	"reference": "uri",
+}
+
+func (r *Expression) elementTypes() map[string]string {
+	return expressionElementTypes
+}
+
+var expressionElementMetadata = []ElementMetadata{
+	{Path: "Expression.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Expression.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Expression.description", Name: "description", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Expression.name", Name: "name", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Summary: true},
+	{Path: "Expression.language", Name: "language", Field: 6, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Expression.language", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/expression-language", Description: "The media type of the expression language."}, Summary: true},
+	{Path: "Expression.expression", Name: "expression", Field: 8, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Expression.reference", Name: "reference", Field: 10, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+}
+
+func (r *Expression) ElementMetadata() []ElementMetadata {
+	return expressionElementMetadata
+}
@@ -1,12 +1,14 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // ExtendedContactDetail Type: Specifies contact information for a specific purpose over a period of time, might be handled/monitored by a specific named person or organization.
 type ExtendedContactDetail struct {
 	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
+	Extension    []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`       // Additional content defined by implementations
 	Purpose      *CodeableConcept `json:"purpose,omitempty" bson:"purpose,omitempty"`           // The type of contact
 	Name         []HumanName      `json:"name,omitempty" bson:"name,omitempty"`                 // Name of an individual to contact
 	Telecom      []ContactPoint   `json:"telecom,omitempty" bson:"telecom,omitempty"`           // Contact details (e.g.phone/fax/url)
@@ -15,7 +17,14 @@ type ExtendedContactDetail struct {
 	Period       *Period          `json:"period,omitempty" bson:"period,omitempty"`             // Period that this contact was valid for usage
 }
 
+var extendedContactDetailOrganizationTargets = []string{"Organization"}
+
 func (r *ExtendedContactDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Purpose != nil {
 		if err := r.Purpose.Validate(); err != nil {
 			return fmt.Errorf("Purpose: %w", err)
@@ -36,6 +45,11 @@ func (r *ExtendedContactDetail) Validate() error {
 			return fmt.Errorf("Address: %w", err)
 		}
 	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, extendedContactDetailOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'Organization' cannot refer to %s, expected one of %v", target, extendedContactDetailOrganizationTargets)
+		}
+	}
 	if r.Organization != nil {
 		if err := r.Organization.Validate(); err != nil {
 			return fmt.Errorf("Organization: %w", err)
@@ -48,3 +62,78 @@ func (r *ExtendedContactDetail) Validate() error {
 	}
 	return nil
 }
+
+func (r *ExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Purpose != nil {
+		r.Purpose.validateAll(path+".purpose", issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	if r.Address != nil {
+		r.Address.validateAll(path+".address", issues)
+	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, extendedContactDetailOrganizationTargets); target != "" {
+			issues.add("value", path+".organization", fmt.Sprintf("field 'Organization' cannot refer to %s, expected one of %v", target, extendedContactDetailOrganizationTargets))
+		}
+	}
+	if r.Organization != nil {
+		r.Organization.validateAll(path+".organization", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+func (r *ExtendedContactDetail) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ExtendedContactDetail", &issues)
+	checkInvariants(r, "ExtendedContactDetail", &issues)
+	return issues
+}
+
+var extendedContactDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ExtendedContactDetail) invariants() []invariant {
+	return extendedContactDetailInvariants
+}
+
+func (r ExtendedContactDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ExtendedContactDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ExtendedContactDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ExtendedContactDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var extendedContactDetailElementMetadata = []ElementMetadata{
+	{Path: "ExtendedContactDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ExtendedContactDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ExtendedContactDetail.purpose", Name: "purpose", Field: 2, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "ExtendedContactDetail.purpose", Strength: "preferred", ValueSet: "http://terminology.hl7.org/ValueSet/contactentity-type", Description: "The purpose for which an extended contact detail should be used."}, Summary: true},
+	{Path: "ExtendedContactDetail.name", Name: "name", Field: 3, Min: 0, Max: "*", Types: []string{"HumanName"}, Summary: true},
+	{Path: "ExtendedContactDetail.telecom", Name: "telecom", Field: 4, Min: 0, Max: "*", Types: []string{"ContactPoint"}, Summary: true},
+	{Path: "ExtendedContactDetail.address", Name: "address", Field: 5, Min: 0, Max: "1", Types: []string{"Address"}, Summary: true},
+	{Path: "ExtendedContactDetail.organization", Name: "organization", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}, Summary: true},
+	{Path: "ExtendedContactDetail.period", Name: "period", Field: 7, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+}
+
+func (r *ExtendedContactDetail) ElementMetadata() []ElementMetadata {
+	return extendedContactDetailElementMetadata
+}
@@ -1,254 +1,1228 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Extension Type: Optional Extension Element - found in all resources.
 type Extension struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
-	Url                        string                 `json:"url" bson:"url"`                                                                      // identifies the meaning of the extension
-	ValueBase64Binary          *string                `json:"valueBase64Binary,omitempty" bson:"value_base64_binary,omitempty"`                    // Value of extension
-	ValueBoolean               *bool                  `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                               // Value of extension
-	ValueCanonical             *string                `json:"valueCanonical,omitempty" bson:"value_canonical,omitempty"`                           // Value of extension
-	ValueCode                  *string                `json:"valueCode,omitempty" bson:"value_code,omitempty"`                                     // Value of extension
-	ValueDate                  *string                `json:"valueDate,omitempty" bson:"value_date,omitempty"`                                     // Value of extension
-	ValueDateTime              *string                `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                            // Value of extension
-	ValueDecimal               *float64               `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`                               // Value of extension
-	ValueId                    *string                `json:"valueId,omitempty" bson:"value_id,omitempty"`                                         // Value of extension
-	ValueInstant               *string                `json:"valueInstant,omitempty" bson:"value_instant,omitempty"`                               // Value of extension
-	ValueInteger               *int                   `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                               // Value of extension
-	ValueInteger64             *int64                 `json:"valueInteger64,omitempty" bson:"value_integer64,omitempty"`                           // Value of extension
-	ValueMarkdown              *string                `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                             // Value of extension
-	ValueOid                   *string                `json:"valueOid,omitempty" bson:"value_oid,omitempty"`                                       // Value of extension
-	ValuePositiveInt           *int                   `json:"valuePositiveInt,omitempty" bson:"value_positive_int,omitempty"`                      // Value of extension
-	ValueString                *string                `json:"valueString,omitempty" bson:"value_string,omitempty"`                                 // Value of extension
-	ValueTime                  *string                `json:"valueTime,omitempty" bson:"value_time,omitempty"`                                     // Value of extension
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt,omitempty" bson:"value_unsigned_int,omitempty"`                      // Value of extension
-	ValueUri                   *string                `json:"valueUri,omitempty" bson:"value_uri,omitempty"`                                       // Value of extension
-	ValueUrl                   *string                `json:"valueUrl,omitempty" bson:"value_url,omitempty"`                                       // Value of extension
-	ValueUuid                  *uuid                  `json:"valueUuid,omitempty" bson:"value_uuid,omitempty"`                                     // Value of extension
-	ValueAddress               *Address               `json:"valueAddress,omitempty" bson:"value_address,omitempty"`                               // Value of extension
-	ValueAge                   *Age                   `json:"valueAge,omitempty" bson:"value_age,omitempty"`                                       // Value of extension
-	ValueAnnotation            *Annotation            `json:"valueAnnotation,omitempty" bson:"value_annotation,omitempty"`                         // Value of extension
-	ValueAttachment            *Attachment            `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`                         // Value of extension
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`              // Value of extension
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference,omitempty" bson:"value_codeable_reference,omitempty"`          // Value of extension
-	ValueCoding                *Coding                `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`                                 // Value of extension
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint,omitempty" bson:"value_contact_point,omitempty"`                    // Value of extension
-	ValueCount                 *Count                 `json:"valueCount,omitempty" bson:"value_count,omitempty"`                                   // Value of extension
-	ValueDistance              *Distance              `json:"valueDistance,omitempty" bson:"value_distance,omitempty"`                             // Value of extension
-	ValueDuration              *Duration              `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`                             // Value of extension
-	ValueHumanName             *HumanName             `json:"valueHumanName,omitempty" bson:"value_human_name,omitempty"`                          // Value of extension
-	ValueIdentifier            *Identifier            `json:"valueIdentifier,omitempty" bson:"value_identifier,omitempty"`                         // Value of extension
-	ValueMoney                 *Money                 `json:"valueMoney,omitempty" bson:"value_money,omitempty"`                                   // Value of extension
-	ValuePeriod                *Period                `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                                 // Value of extension
-	ValueQuantity              *Quantity              `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                             // Value of extension
-	ValueRange                 *Range                 `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                   // Value of extension
-	ValueRatio                 *Ratio                 `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                                   // Value of extension
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange,omitempty" bson:"value_ratio_range,omitempty"`                        // Value of extension
-	ValueReference             *Reference             `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                           // Value of extension
-	ValueSampledData           *SampledData           `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`                      // Value of extension
-	ValueSignature             *Signature             `json:"valueSignature,omitempty" bson:"value_signature,omitempty"`                           // Value of extension
-	ValueTiming                *Timing                `json:"valueTiming,omitempty" bson:"value_timing,omitempty"`                                 // Value of extension
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail,omitempty" bson:"value_contact_detail,omitempty"`                  // Value of extension
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement,omitempty" bson:"value_data_requirement,omitempty"`              // Value of extension
-	ValueExpression            *Expression            `json:"valueExpression,omitempty" bson:"value_expression,omitempty"`                         // Value of extension
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition,omitempty" bson:"value_parameter_definition,omitempty"`      // Value of extension
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact,omitempty" bson:"value_related_artifact,omitempty"`              // Value of extension
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition,omitempty" bson:"value_trigger_definition,omitempty"`          // Value of extension
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext,omitempty" bson:"value_usage_context,omitempty"`                    // Value of extension
-	ValueAvailability          *Availability          `json:"valueAvailability,omitempty" bson:"value_availability,omitempty"`                     // Value of extension
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail,omitempty" bson:"value_extended_contact_detail,omitempty"` // Value of extension
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail,omitempty" bson:"value_virtual_service_detail,omitempty"`   // Value of extension
-	ValueDosage                *Dosage                `json:"valueDosage,omitempty" bson:"value_dosage,omitempty"`                                 // Value of extension
-	ValueMeta                  *Meta                  `json:"valueMeta,omitempty" bson:"value_meta,omitempty"`                                     // Value of extension
+	Id           *string        `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension    []Extension    `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Url          string         `json:"url" bson:"url"`                                 // identifies the meaning of the extension
+	Value        ExtensionValue `json:"-" bson:"value,omitempty"`                       // Value of extension
+	ValueElement *Element       `json:"-" bson:"value_element,omitempty"`               // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Extension.value[x] when more than one was decoded
 }
 
 func (r *Extension) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
-	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
-	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
-	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
-	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
-	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
-	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
-		}
-	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
-		}
-	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
-		}
-	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
-		}
-	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
-		}
-	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
-		}
-	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
-		}
-	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
-	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
-	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+	return nil
+}
+
+func (r *Extension) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+}
+
+func (r *Extension) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Extension", &issues)
+	checkInvariants(r, "Extension", &issues)
+	return issues
+}
+
+var extensionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "ext-1", severity: "error", human: "Must have either extensions or value[x], not both", expression: "extension.exists() != value.exists()"},
+}
+
+func (r *Extension) invariants() []invariant {
+	return extensionInvariants
+}
+
+func (r Extension) MarshalJSON() ([]byte, error) {
+	type alias Extension
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	return data, nil
+}
+
+func (r *Extension) UnmarshalJSON(data []byte) error {
+	type alias Extension
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
-		}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", extensionValueVariants); err != nil {
+		return err
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
-		}
+	return nil
+}
+
+func (r Extension) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Extension) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Extension) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Extension) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Extension) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(extensionValueVariants)
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
-		}
+	return "", nil
+}
+
+var extensionElementTypes = map[string]string{
+	"url": "uri",
+}
+
+func (r *Extension) elementTypes() map[string]string {
+	return extensionElementTypes
+}
+
+var extensionElementMetadata = []ElementMetadata{
+	{Path: "Extension.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Extension.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Extension.url", Name: "url", Field: 2, Min: 1, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "Extension.value[x]", Name: "value", Field: 3, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}, Summary: true},
+}
+
+func (r *Extension) ElementMetadata() []ElementMetadata {
+	return extensionElementMetadata
+}
+
+// ExtensionValue is the type of Extension.value[x]. It is implemented by the ExtensionValue* variant types.
+type ExtensionValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isExtensionValue()
+}
+
+// ExtensionValueBase64Binary is the base64Binary variant of ExtensionValue.
+type ExtensionValueBase64Binary string
+
+func (v ExtensionValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ExtensionValueBase64Binary) isExtensionValue() {}
+
+// ExtensionValueBoolean is the boolean variant of ExtensionValue.
+type ExtensionValueBoolean bool
+
+func (v ExtensionValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ExtensionValueBoolean) isExtensionValue() {}
+
+// ExtensionValueCanonical is the canonical variant of ExtensionValue.
+type ExtensionValueCanonical string
+
+func (v ExtensionValueCanonical) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ExtensionValueCanonical) isExtensionValue() {}
+
+// ExtensionValueCode is the code variant of ExtensionValue.
+type ExtensionValueCode string
+
+func (v ExtensionValueCode) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ExtensionValueCode) isExtensionValue() {}
+
+// ExtensionValueDate is the date variant of ExtensionValue.
+type ExtensionValueDate struct {
+	Date
+}
+
+func (v ExtensionValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ExtensionValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
+}
+
+func (v ExtensionValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ExtensionValueDate) isExtensionValue() {}
+
+// ExtensionValueDateTime is the dateTime variant of ExtensionValue.
+type ExtensionValueDateTime struct {
+	DateTime
+}
+
+func (v ExtensionValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ExtensionValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+}
+
+func (v ExtensionValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ExtensionValueDateTime) isExtensionValue() {}
+
+// ExtensionValueDecimal is the decimal variant of ExtensionValue.
+type ExtensionValueDecimal struct {
+	Decimal
+}
+
+func (v ExtensionValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ExtensionValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+}
+
+func (v ExtensionValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ExtensionValueDecimal) isExtensionValue() {}
+
+// ExtensionValueId is the id variant of ExtensionValue.
+type ExtensionValueId string
+
+func (v ExtensionValueId) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ExtensionValueId) isExtensionValue() {}
+
+// ExtensionValueInstant is the instant variant of ExtensionValue.
+type ExtensionValueInstant struct {
+	Instant
+}
+
+func (v ExtensionValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ExtensionValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
-		}
+}
+
+func (v ExtensionValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ExtensionValueInstant) isExtensionValue() {}
+
+// ExtensionValueInteger is the integer variant of ExtensionValue.
+type ExtensionValueInteger int
+
+func (v ExtensionValueInteger) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ExtensionValueInteger) isExtensionValue() {}
+
+// ExtensionValueInteger64 is the integer64 variant of ExtensionValue.
+type ExtensionValueInteger64 int64
+
+func (v ExtensionValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ExtensionValueInteger64) isExtensionValue() {}
+
+// ExtensionValueMarkdown is the markdown variant of ExtensionValue.
+type ExtensionValueMarkdown string
+
+func (v ExtensionValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ExtensionValueMarkdown) isExtensionValue() {}
+
+// ExtensionValueOid is the oid variant of ExtensionValue.
+type ExtensionValueOid string
+
+func (v ExtensionValueOid) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ExtensionValueOid) isExtensionValue() {}
+
+// ExtensionValuePositiveInt is the positiveInt variant of ExtensionValue.
+type ExtensionValuePositiveInt int
+
+func (v ExtensionValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ExtensionValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ExtensionValuePositiveInt) isExtensionValue() {}
+
+// ExtensionValueString is the string variant of ExtensionValue.
+type ExtensionValueString string
+
+func (v ExtensionValueString) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ExtensionValueString) isExtensionValue() {}
+
+// ExtensionValueTime is the time variant of ExtensionValue.
+type ExtensionValueTime struct {
+	Time
+}
+
+func (v ExtensionValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ExtensionValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
+}
+
+func (v ExtensionValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ExtensionValueTime) isExtensionValue() {}
+
+// ExtensionValueUnsignedInt is the unsignedInt variant of ExtensionValue.
+type ExtensionValueUnsignedInt int
+
+func (v ExtensionValueUnsignedInt) Validate() error {
 	return nil
 }
+
+func (v ExtensionValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ExtensionValueUnsignedInt) isExtensionValue() {}
+
+// ExtensionValueUri is the uri variant of ExtensionValue.
+type ExtensionValueUri string
+
+func (v ExtensionValueUri) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ExtensionValueUri) isExtensionValue() {}
+
+// ExtensionValueUrl is the url variant of ExtensionValue.
+type ExtensionValueUrl string
+
+func (v ExtensionValueUrl) Validate() error {
+	return nil
+}
+
+func (v ExtensionValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ExtensionValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ExtensionValueUrl) isExtensionValue() {}
+
+// ExtensionValueUuid is the uuid variant of ExtensionValue.
+type ExtensionValueUuid struct {
+	uuid
+}
+
+func (v ExtensionValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ExtensionValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ExtensionValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ExtensionValueUuid) isExtensionValue() {}
+
+// ExtensionValueAddress is the Address variant of ExtensionValue.
+type ExtensionValueAddress struct {
+	Address
+}
+
+func (v ExtensionValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ExtensionValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ExtensionValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ExtensionValueAddress) isExtensionValue() {}
+
+// ExtensionValueAge is the Age variant of ExtensionValue.
+type ExtensionValueAge struct {
+	Age
+}
+
+func (v ExtensionValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ExtensionValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ExtensionValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ExtensionValueAge) isExtensionValue() {}
+
+// ExtensionValueAnnotation is the Annotation variant of ExtensionValue.
+type ExtensionValueAnnotation struct {
+	Annotation
+}
+
+func (v ExtensionValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ExtensionValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ExtensionValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ExtensionValueAnnotation) isExtensionValue() {}
+
+// ExtensionValueAttachment is the Attachment variant of ExtensionValue.
+type ExtensionValueAttachment struct {
+	Attachment
+}
+
+func (v ExtensionValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ExtensionValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ExtensionValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ExtensionValueAttachment) isExtensionValue() {}
+
+// ExtensionValueCodeableConcept is the CodeableConcept variant of ExtensionValue.
+type ExtensionValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ExtensionValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ExtensionValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ExtensionValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ExtensionValueCodeableConcept) isExtensionValue() {}
+
+// ExtensionValueCodeableReference is the CodeableReference variant of ExtensionValue.
+type ExtensionValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ExtensionValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ExtensionValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ExtensionValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ExtensionValueCodeableReference) isExtensionValue() {}
+
+// ExtensionValueCoding is the Coding variant of ExtensionValue.
+type ExtensionValueCoding struct {
+	Coding
+}
+
+func (v ExtensionValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ExtensionValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ExtensionValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ExtensionValueCoding) isExtensionValue() {}
+
+// ExtensionValueContactPoint is the ContactPoint variant of ExtensionValue.
+type ExtensionValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ExtensionValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ExtensionValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ExtensionValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ExtensionValueContactPoint) isExtensionValue() {}
+
+// ExtensionValueCount is the Count variant of ExtensionValue.
+type ExtensionValueCount struct {
+	Count
+}
+
+func (v ExtensionValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ExtensionValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ExtensionValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ExtensionValueCount) isExtensionValue() {}
+
+// ExtensionValueDistance is the Distance variant of ExtensionValue.
+type ExtensionValueDistance struct {
+	Distance
+}
+
+func (v ExtensionValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ExtensionValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ExtensionValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ExtensionValueDistance) isExtensionValue() {}
+
+// ExtensionValueDuration is the Duration variant of ExtensionValue.
+type ExtensionValueDuration struct {
+	Duration
+}
+
+func (v ExtensionValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ExtensionValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ExtensionValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ExtensionValueDuration) isExtensionValue() {}
+
+// ExtensionValueHumanName is the HumanName variant of ExtensionValue.
+type ExtensionValueHumanName struct {
+	HumanName
+}
+
+func (v ExtensionValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ExtensionValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ExtensionValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ExtensionValueHumanName) isExtensionValue() {}
+
+// ExtensionValueIdentifier is the Identifier variant of ExtensionValue.
+type ExtensionValueIdentifier struct {
+	Identifier
+}
+
+func (v ExtensionValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ExtensionValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ExtensionValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ExtensionValueIdentifier) isExtensionValue() {}
+
+// ExtensionValueMoney is the Money variant of ExtensionValue.
+type ExtensionValueMoney struct {
+	Money
+}
+
+func (v ExtensionValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ExtensionValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ExtensionValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ExtensionValueMoney) isExtensionValue() {}
+
+// ExtensionValuePeriod is the Period variant of ExtensionValue.
+type ExtensionValuePeriod struct {
+	Period
+}
+
+func (v ExtensionValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ExtensionValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ExtensionValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ExtensionValuePeriod) isExtensionValue() {}
+
+// ExtensionValueQuantity is the Quantity variant of ExtensionValue.
+type ExtensionValueQuantity struct {
+	Quantity
+}
+
+func (v ExtensionValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ExtensionValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ExtensionValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ExtensionValueQuantity) isExtensionValue() {}
+
+// ExtensionValueRange is the Range variant of ExtensionValue.
+type ExtensionValueRange struct {
+	Range
+}
+
+func (v ExtensionValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ExtensionValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ExtensionValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ExtensionValueRange) isExtensionValue() {}
+
+// ExtensionValueRatio is the Ratio variant of ExtensionValue.
+type ExtensionValueRatio struct {
+	Ratio
+}
+
+func (v ExtensionValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ExtensionValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ExtensionValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ExtensionValueRatio) isExtensionValue() {}
+
+// ExtensionValueRatioRange is the RatioRange variant of ExtensionValue.
+type ExtensionValueRatioRange struct {
+	RatioRange
+}
+
+func (v ExtensionValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ExtensionValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ExtensionValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ExtensionValueRatioRange) isExtensionValue() {}
+
+// ExtensionValueReference is the Reference variant of ExtensionValue.
+type ExtensionValueReference struct {
+	Reference
+}
+
+func (v ExtensionValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ExtensionValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ExtensionValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ExtensionValueReference) isExtensionValue() {}
+
+// ExtensionValueSampledData is the SampledData variant of ExtensionValue.
+type ExtensionValueSampledData struct {
+	SampledData
+}
+
+func (v ExtensionValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ExtensionValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ExtensionValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ExtensionValueSampledData) isExtensionValue() {}
+
+// ExtensionValueSignature is the Signature variant of ExtensionValue.
+type ExtensionValueSignature struct {
+	Signature
+}
+
+func (v ExtensionValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ExtensionValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ExtensionValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ExtensionValueSignature) isExtensionValue() {}
+
+// ExtensionValueTiming is the Timing variant of ExtensionValue.
+type ExtensionValueTiming struct {
+	Timing
+}
+
+func (v ExtensionValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ExtensionValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ExtensionValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ExtensionValueTiming) isExtensionValue() {}
+
+// ExtensionValueContactDetail is the ContactDetail variant of ExtensionValue.
+type ExtensionValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ExtensionValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ExtensionValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ExtensionValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ExtensionValueContactDetail) isExtensionValue() {}
+
+// ExtensionValueDataRequirement is the DataRequirement variant of ExtensionValue.
+type ExtensionValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ExtensionValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ExtensionValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ExtensionValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ExtensionValueDataRequirement) isExtensionValue() {}
+
+// ExtensionValueExpression is the Expression variant of ExtensionValue.
+type ExtensionValueExpression struct {
+	Expression
+}
+
+func (v ExtensionValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ExtensionValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ExtensionValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ExtensionValueExpression) isExtensionValue() {}
+
+// ExtensionValueParameterDefinition is the ParameterDefinition variant of ExtensionValue.
+type ExtensionValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ExtensionValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ExtensionValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ExtensionValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ExtensionValueParameterDefinition) isExtensionValue() {}
+
+// ExtensionValueRelatedArtifact is the RelatedArtifact variant of ExtensionValue.
+type ExtensionValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ExtensionValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ExtensionValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ExtensionValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ExtensionValueRelatedArtifact) isExtensionValue() {}
+
+// ExtensionValueTriggerDefinition is the TriggerDefinition variant of ExtensionValue.
+type ExtensionValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ExtensionValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ExtensionValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ExtensionValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ExtensionValueTriggerDefinition) isExtensionValue() {}
+
+// ExtensionValueUsageContext is the UsageContext variant of ExtensionValue.
+type ExtensionValueUsageContext struct {
+	UsageContext
+}
+
+func (v ExtensionValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ExtensionValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ExtensionValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ExtensionValueUsageContext) isExtensionValue() {}
+
+// ExtensionValueAvailability is the Availability variant of ExtensionValue.
+type ExtensionValueAvailability struct {
+	Availability
+}
+
+func (v ExtensionValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ExtensionValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ExtensionValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ExtensionValueAvailability) isExtensionValue() {}
+
+// ExtensionValueExtendedContactDetail is the ExtendedContactDetail variant of ExtensionValue.
+type ExtensionValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ExtensionValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ExtensionValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ExtensionValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ExtensionValueExtendedContactDetail) isExtensionValue() {}
+
+// ExtensionValueVirtualServiceDetail is the VirtualServiceDetail variant of ExtensionValue.
+type ExtensionValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ExtensionValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ExtensionValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ExtensionValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ExtensionValueVirtualServiceDetail) isExtensionValue() {}
+
+// ExtensionValueDosage is the Dosage variant of ExtensionValue.
+type ExtensionValueDosage struct {
+	Dosage
+}
+
+func (v ExtensionValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ExtensionValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ExtensionValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ExtensionValueDosage) isExtensionValue() {}
+
+// ExtensionValueMeta is the Meta variant of ExtensionValue.
+type ExtensionValueMeta struct {
+	Meta
+}
+
+func (v ExtensionValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ExtensionValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ExtensionValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ExtensionValueMeta) isExtensionValue() {}
+
+var extensionValueVariants = []ExtensionValue{
+	ExtensionValueBase64Binary(""),
+	ExtensionValueBoolean(false),
+	ExtensionValueCanonical(""),
+	ExtensionValueCode(""),
+	ExtensionValueDate{},
+	ExtensionValueDateTime{},
+	ExtensionValueDecimal{},
+	ExtensionValueId(""),
+	ExtensionValueInstant{},
+	ExtensionValueInteger(0),
+	ExtensionValueInteger64(0),
+	ExtensionValueMarkdown(""),
+	ExtensionValueOid(""),
+	ExtensionValuePositiveInt(0),
+	ExtensionValueString(""),
+	ExtensionValueTime{},
+	ExtensionValueUnsignedInt(0),
+	ExtensionValueUri(""),
+	ExtensionValueUrl(""),
+	ExtensionValueUuid{},
+	ExtensionValueAddress{},
+	ExtensionValueAge{},
+	ExtensionValueAnnotation{},
+	ExtensionValueAttachment{},
+	ExtensionValueCodeableConcept{},
+	ExtensionValueCodeableReference{},
+	ExtensionValueCoding{},
+	ExtensionValueContactPoint{},
+	ExtensionValueCount{},
+	ExtensionValueDistance{},
+	ExtensionValueDuration{},
+	ExtensionValueHumanName{},
+	ExtensionValueIdentifier{},
+	ExtensionValueMoney{},
+	ExtensionValuePeriod{},
+	ExtensionValueQuantity{},
+	ExtensionValueRange{},
+	ExtensionValueRatio{},
+	ExtensionValueRatioRange{},
+	ExtensionValueReference{},
+	ExtensionValueSampledData{},
+	ExtensionValueSignature{},
+	ExtensionValueTiming{},
+	ExtensionValueContactDetail{},
+	ExtensionValueDataRequirement{},
+	ExtensionValueExpression{},
+	ExtensionValueParameterDefinition{},
+	ExtensionValueRelatedArtifact{},
+	ExtensionValueTriggerDefinition{},
+	ExtensionValueUsageContext{},
+	ExtensionValueAvailability{},
+	ExtensionValueExtendedContactDetail{},
+	ExtensionValueVirtualServiceDetail{},
+	ExtensionValueDosage{},
+	ExtensionValueMeta{},
+}
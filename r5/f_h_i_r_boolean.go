@@ -1,11 +1,61 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // boolean Type: Value of "true" or "false"
 type FHIRBoolean struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *bool   `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for boolean
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // xml:id (or equivalent in JSON)
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Value     *bool       `json:"value,omitempty" bson:"value,omitempty"`         // Primitive value for boolean
 }
 
 func (r *FHIRBoolean) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *FHIRBoolean) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *FHIRBoolean) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("boolean", &issues)
+	checkInvariants(r, "boolean", &issues)
+	return issues
+}
+
+func (r FHIRBoolean) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FHIRBoolean) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FHIRBoolean) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FHIRBoolean) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var fHIRBooleanElementMetadata = []ElementMetadata{
+	{Path: "boolean.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "boolean.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "boolean.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *FHIRBoolean) ElementMetadata() []ElementMetadata {
+	return fHIRBooleanElementMetadata
+}
@@ -1,11 +1,71 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // decimal Type: A rational number with implicit precision
 type FHIRDecimal struct {
-	Id    *string  `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *float64 `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for decimal
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // xml:id (or equivalent in JSON)
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Value     *Decimal    `json:"value,omitempty" bson:"value,omitempty"`         // Primitive value for decimal
 }
 
 func (r *FHIRDecimal) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *FHIRDecimal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			issues.add("value", path+".value", err.Error())
+		}
+	}
+}
+
+func (r *FHIRDecimal) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("decimal", &issues)
+	checkInvariants(r, "decimal", &issues)
+	return issues
+}
+
+func (r FHIRDecimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FHIRDecimal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FHIRDecimal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FHIRDecimal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var fHIRDecimalElementMetadata = []ElementMetadata{
+	{Path: "decimal.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "decimal.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "decimal.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"decimal"}},
+}
+
+func (r *FHIRDecimal) ElementMetadata() []ElementMetadata {
+	return fHIRDecimalElementMetadata
+}
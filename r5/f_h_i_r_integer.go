@@ -1,11 +1,61 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // integer Type: A whole number
 type FHIRInteger struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *int    `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for integer
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // xml:id (or equivalent in JSON)
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Value     *int        `json:"value,omitempty" bson:"value,omitempty"`         // Primitive value for integer
 }
 
 func (r *FHIRInteger) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *FHIRInteger) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *FHIRInteger) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("integer", &issues)
+	checkInvariants(r, "integer", &issues)
+	return issues
+}
+
+func (r FHIRInteger) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FHIRInteger) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FHIRInteger) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FHIRInteger) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var fHIRIntegerElementMetadata = []ElementMetadata{
+	{Path: "integer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "integer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "integer.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"integer"}},
+}
+
+func (r *FHIRInteger) ElementMetadata() []ElementMetadata {
+	return fHIRIntegerElementMetadata
+}
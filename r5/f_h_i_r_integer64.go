@@ -1,11 +1,61 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // integer64 Type: A very large whole number
 type FHIRInteger64 struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *int64  `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for integer64
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // xml:id (or equivalent in JSON)
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Value     *int64      `json:"value,omitempty" bson:"value,omitempty"`         // Primitive value for integer64
 }
 
 func (r *FHIRInteger64) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *FHIRInteger64) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *FHIRInteger64) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("integer64", &issues)
+	checkInvariants(r, "integer64", &issues)
+	return issues
+}
+
+func (r FHIRInteger64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FHIRInteger64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FHIRInteger64) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FHIRInteger64) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var fHIRInteger64ElementMetadata = []ElementMetadata{
+	{Path: "integer64.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "integer64.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "integer64.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"integer64"}},
+}
+
+func (r *FHIRInteger64) ElementMetadata() []ElementMetadata {
+	return fHIRInteger64ElementMetadata
+}
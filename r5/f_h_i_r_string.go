@@ -1,11 +1,81 @@
 package models
 
-// uri Type: String of characters used to identify a name or a resource
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// string Type: A sequence of Unicode characters
 type FHIRString struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *string `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for uri
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // xml:id (or equivalent in JSON)
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Value     *string     `json:"value,omitempty" bson:"value,omitempty"`         // Primitive value for string
 }
 
 func (r *FHIRString) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Value != nil {
+		valStr := ""
+		if *r.Value != emptyString {
+			valStr = fmt.Sprint(*r.Value)
+		}
+		if len(valStr) > 1048576 {
+			return fmt.Errorf("field 'Value' exceeds maxLength 1048576")
+		}
+	}
 	return nil
 }
+
+func (r *FHIRString) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Value != nil {
+		valStr := ""
+		if *r.Value != emptyString {
+			valStr = fmt.Sprint(*r.Value)
+		}
+		if len(valStr) > 1048576 {
+			issues.add("value", path+".value", "field 'Value' exceeds maxLength 1048576")
+		}
+	}
+}
+
+func (r *FHIRString) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("string", &issues)
+	checkInvariants(r, "string", &issues)
+	return issues
+}
+
+func (r FHIRString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FHIRString) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FHIRString) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FHIRString) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var fHIRStringElementMetadata = []ElementMetadata{
+	{Path: "string.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "string.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "string.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *FHIRString) ElementMetadata() []ElementMetadata {
+	return fHIRStringElementMetadata
+}
@@ -2,46 +2,60 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Significant health conditions for a person related to the patient relevant in the context of care for the patient.
 type FamilyMemberHistory struct {
-	ResourceType     string                         `json:"resourceType" bson:"resource_type"`                              // Type of resource
-	Id               *string                        `json:"id,omitempty" bson:"id,omitempty"`                               // Logical id of this artifact
-	Meta             *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                           // Metadata about the resource
-	ImplicitRules    *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`        // A set of rules under which this content was created
-	Language         *string                        `json:"language,omitempty" bson:"language,omitempty"`                   // Language of the resource content
-	Text             *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                           // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                 // Contained, inline Resources
-	Identifier       []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`               // External Id(s) for this record
-	Status           string                         `json:"status" bson:"status"`                                           // partial | completed | entered-in-error | health-unknown
-	DataAbsentReason *CodeableConcept               `json:"dataAbsentReason,omitempty" bson:"data_absent_reason,omitempty"` // subject-unknown | withheld | unable-to-obtain | deferred
-	Patient          *Reference                     `json:"patient" bson:"patient"`                                         // Patient history is about
-	Date             *string                        `json:"date,omitempty" bson:"date,omitempty"`                           // When history was recorded or last updated
-	Recorder         *Reference                     `json:"recorder,omitempty" bson:"recorder,omitempty"`                   // Who recorded the family member history
-	Asserter         *Reference                     `json:"asserter,omitempty" bson:"asserter,omitempty"`                   // Person or device that asserts this family member history
-	Name             *string                        `json:"name,omitempty" bson:"name,omitempty"`                           // The family member described
-	Relationship     *CodeableConcept               `json:"relationship" bson:"relationship"`                               // Relationship to the subject
-	Sex              *CodeableConcept               `json:"sex,omitempty" bson:"sex,omitempty"`                             // male | female | other | unknown
-	BornPeriod       *Period                        `json:"bornPeriod,omitempty" bson:"born_period,omitempty"`              // (approximate) date of birth
-	BornDate         *string                        `json:"bornDate,omitempty" bson:"born_date,omitempty"`                  // (approximate) date of birth
-	BornString       *string                        `json:"bornString,omitempty" bson:"born_string,omitempty"`              // (approximate) date of birth
-	AgeAge           *Age                           `json:"ageAge,omitempty" bson:"age_age,omitempty"`                      // (approximate) age
-	AgeRange         *Range                         `json:"ageRange,omitempty" bson:"age_range,omitempty"`                  // (approximate) age
-	AgeString        *string                        `json:"ageString,omitempty" bson:"age_string,omitempty"`                // (approximate) age
-	EstimatedAge     *bool                          `json:"estimatedAge,omitempty" bson:"estimated_age,omitempty"`          // Age is estimated?
-	DeceasedBoolean  *bool                          `json:"deceasedBoolean,omitempty" bson:"deceased_boolean,omitempty"`    // Dead? How old/when?
-	DeceasedAge      *Age                           `json:"deceasedAge,omitempty" bson:"deceased_age,omitempty"`            // Dead? How old/when?
-	DeceasedRange    *Range                         `json:"deceasedRange,omitempty" bson:"deceased_range,omitempty"`        // Dead? How old/when?
-	DeceasedDate     *string                        `json:"deceasedDate,omitempty" bson:"deceased_date,omitempty"`          // Dead? How old/when?
-	DeceasedString   *string                        `json:"deceasedString,omitempty" bson:"deceased_string,omitempty"`      // Dead? How old/when?
-	Reason           []CodeableReference            `json:"reason,omitempty" bson:"reason,omitempty"`                       // Why was family member history performed?
-	Note             []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                           // General note about related person
-	Condition        []FamilyMemberHistoryCondition `json:"condition,omitempty" bson:"condition,omitempty"`                 // Condition that the related person had
-	Procedure        []FamilyMemberHistoryProcedure `json:"procedure,omitempty" bson:"procedure,omitempty"`                 // Procedures that the related person had
+	ResourceType         string                         `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                        `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                        `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External Id(s) for this record
+	Status               FamilyHistoryStatus            `json:"status" bson:"status"`                                             // partial | completed | entered-in-error | health-unknown
+	StatusElement        *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	DataAbsentReason     *CodeableConcept               `json:"dataAbsentReason,omitempty" bson:"data_absent_reason,omitempty"`   // subject-unknown | withheld | unable-to-obtain | deferred
+	Patient              *Reference                     `json:"patient" bson:"patient"`                                           // Patient history is about
+	Date                 *DateTime                      `json:"date,omitempty" bson:"date,omitempty"`                             // When history was recorded or last updated
+	DateElement          *Element                       `json:"_date,omitempty" bson:"date_element,omitempty"`                    // Extensions for date
+	Recorder             *Reference                     `json:"recorder,omitempty" bson:"recorder,omitempty"`                     // Who recorded the family member history
+	Asserter             *Reference                     `json:"asserter,omitempty" bson:"asserter,omitempty"`                     // Person or device that asserts this family member history
+	Name                 *string                        `json:"name,omitempty" bson:"name,omitempty"`                             // The family member described
+	NameElement          *Element                       `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Relationship         *CodeableConcept               `json:"relationship" bson:"relationship"`                                 // Relationship to the subject
+	Sex                  *CodeableConcept               `json:"sex,omitempty" bson:"sex,omitempty"`                               // male | female | other | unknown
+	Born                 FamilyMemberHistoryBorn        `json:"-" bson:"born,omitempty"`                                          // (approximate) date of birth
+	BornElement          *Element                       `json:"-" bson:"born_element,omitempty"`                                  // Extensions for born[x]
+	Age                  FamilyMemberHistoryAge         `json:"-" bson:"age,omitempty"`                                           // (approximate) age
+	AgeElement           *Element                       `json:"-" bson:"age_element,omitempty"`                                   // Extensions for age[x]
+	EstimatedAge         *bool                          `json:"estimatedAge,omitempty" bson:"estimated_age,omitempty"`            // Age is estimated?
+	EstimatedAgeElement  *Element                       `json:"_estimatedAge,omitempty" bson:"estimated_age_element,omitempty"`   // Extensions for estimatedAge
+	Deceased             FamilyMemberHistoryDeceased    `json:"-" bson:"deceased,omitempty"`                                      // Dead? How old/when?
+	DeceasedElement      *Element                       `json:"-" bson:"deceased_element,omitempty"`                              // Extensions for deceased[x]
+	Reason               []CodeableReference            `json:"reason,omitempty" bson:"reason,omitempty"`                         // Why was family member history performed?
+	Note                 []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                             // General note about related person
+	Condition            []FamilyMemberHistoryCondition `json:"condition,omitempty" bson:"condition,omitempty"`                   // Condition that the related person had
+	Procedure            []FamilyMemberHistoryProcedure `json:"procedure,omitempty" bson:"procedure,omitempty"`                   // Procedures that the related person had
+
+	bornVariants     []string // JSON properties of FamilyMemberHistory.born[x] when more than one was decoded
+	ageVariants      []string // JSON properties of FamilyMemberHistory.age[x] when more than one was decoded
+	deceasedVariants []string // JSON properties of FamilyMemberHistory.deceased[x] when more than one was decoded
 }
 
+var familyMemberHistoryPatientTargets = []string{"Patient"}
+
+var familyMemberHistoryRecorderTargets = []string{"Practitioner", "PractitionerRole", "Patient", "RelatedPerson", "Organization", "CareTeam", "Group"}
+
+var familyMemberHistoryAsserterTargets = []string{"Practitioner", "PractitionerRole", "Patient", "RelatedPerson", "Organization", "CareTeam", "Device", "Group"}
+
 func (r *FamilyMemberHistory) Validate() error {
 	if r.ResourceType != "FamilyMemberHistory" {
 		return fmt.Errorf("invalid resourceType: expected 'FamilyMemberHistory', got '%s'", r.ResourceType)
@@ -51,20 +65,55 @@ func (r *FamilyMemberHistory) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.DataAbsentReason != nil {
 		if err := r.DataAbsentReason.Validate(); err != nil {
 			return fmt.Errorf("DataAbsentReason: %w", err)
@@ -73,21 +122,51 @@ func (r *FamilyMemberHistory) Validate() error {
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, familyMemberHistoryPatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, familyMemberHistoryPatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.Recorder != nil {
+		if target := invalidReferenceTarget(r.Recorder.Reference, r.Recorder.Type, familyMemberHistoryRecorderTargets); target != "" {
+			return fmt.Errorf("field 'Recorder' cannot refer to %s, expected one of %v", target, familyMemberHistoryRecorderTargets)
+		}
+	}
 	if r.Recorder != nil {
 		if err := r.Recorder.Validate(); err != nil {
 			return fmt.Errorf("Recorder: %w", err)
 		}
 	}
+	if r.Asserter != nil {
+		if target := invalidReferenceTarget(r.Asserter.Reference, r.Asserter.Type, familyMemberHistoryAsserterTargets); target != "" {
+			return fmt.Errorf("field 'Asserter' cannot refer to %s, expected one of %v", target, familyMemberHistoryAsserterTargets)
+		}
+	}
 	if r.Asserter != nil {
 		if err := r.Asserter.Validate(); err != nil {
 			return fmt.Errorf("Asserter: %w", err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	if r.Relationship == nil {
 		return fmt.Errorf("field 'Relationship' is required")
 	}
@@ -101,29 +180,48 @@ func (r *FamilyMemberHistory) Validate() error {
 			return fmt.Errorf("Sex: %w", err)
 		}
 	}
-	if r.BornPeriod != nil {
-		if err := r.BornPeriod.Validate(); err != nil {
-			return fmt.Errorf("BornPeriod: %w", err)
+	if len(r.bornVariants) > 1 {
+		return fmt.Errorf("field 'Born' must have a single type, got %v", r.bornVariants)
+	}
+	if r.Born != nil {
+		if err := r.Born.Validate(); err != nil {
+			return fmt.Errorf("Born: %w", err)
 		}
 	}
-	if r.AgeAge != nil {
-		if err := r.AgeAge.Validate(); err != nil {
-			return fmt.Errorf("AgeAge: %w", err)
+	if r.BornElement != nil {
+		if err := r.BornElement.Validate(); err != nil {
+			return fmt.Errorf("BornElement: %w", err)
 		}
 	}
-	if r.AgeRange != nil {
-		if err := r.AgeRange.Validate(); err != nil {
-			return fmt.Errorf("AgeRange: %w", err)
+	if len(r.ageVariants) > 1 {
+		return fmt.Errorf("field 'Age' must have a single type, got %v", r.ageVariants)
+	}
+	if r.Age != nil {
+		if err := r.Age.Validate(); err != nil {
+			return fmt.Errorf("Age: %w", err)
 		}
 	}
-	if r.DeceasedAge != nil {
-		if err := r.DeceasedAge.Validate(); err != nil {
-			return fmt.Errorf("DeceasedAge: %w", err)
+	if r.AgeElement != nil {
+		if err := r.AgeElement.Validate(); err != nil {
+			return fmt.Errorf("AgeElement: %w", err)
 		}
 	}
-	if r.DeceasedRange != nil {
-		if err := r.DeceasedRange.Validate(); err != nil {
-			return fmt.Errorf("DeceasedRange: %w", err)
+	if r.EstimatedAgeElement != nil {
+		if err := r.EstimatedAgeElement.Validate(); err != nil {
+			return fmt.Errorf("EstimatedAgeElement: %w", err)
+		}
+	}
+	if len(r.deceasedVariants) > 1 {
+		return fmt.Errorf("field 'Deceased' must have a single type, got %v", r.deceasedVariants)
+	}
+	if r.Deceased != nil {
+		if err := r.Deceased.Validate(); err != nil {
+			return fmt.Errorf("Deceased: %w", err)
+		}
+	}
+	if r.DeceasedElement != nil {
+		if err := r.DeceasedElement.Validate(); err != nil {
+			return fmt.Errorf("DeceasedElement: %w", err)
 		}
 	}
 	for i, item := range r.Reason {
@@ -146,22 +244,618 @@ func (r *FamilyMemberHistory) Validate() error {
 			return fmt.Errorf("Procedure[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "FamilyMemberHistory")
+}
+
+func (r *FamilyMemberHistory) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "FamilyMemberHistory" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'FamilyMemberHistory', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.DataAbsentReason != nil {
+		r.DataAbsentReason.validateAll(path+".dataAbsentReason", issues)
+	}
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, familyMemberHistoryPatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, familyMemberHistoryPatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Recorder != nil {
+		if target := invalidReferenceTarget(r.Recorder.Reference, r.Recorder.Type, familyMemberHistoryRecorderTargets); target != "" {
+			issues.add("value", path+".recorder", fmt.Sprintf("field 'Recorder' cannot refer to %s, expected one of %v", target, familyMemberHistoryRecorderTargets))
+		}
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	if r.Asserter != nil {
+		if target := invalidReferenceTarget(r.Asserter.Reference, r.Asserter.Type, familyMemberHistoryAsserterTargets); target != "" {
+			issues.add("value", path+".asserter", fmt.Sprintf("field 'Asserter' cannot refer to %s, expected one of %v", target, familyMemberHistoryAsserterTargets))
+		}
+	}
+	if r.Asserter != nil {
+		r.Asserter.validateAll(path+".asserter", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Relationship == nil {
+		issues.add("required", path+".relationship", "field 'Relationship' is required")
+	}
+	if r.Relationship != nil {
+		r.Relationship.validateAll(path+".relationship", issues)
+	}
+	if r.Sex != nil {
+		r.Sex.validateAll(path+".sex", issues)
+	}
+	if len(r.bornVariants) > 1 {
+		issues.add("structure", path+".born", fmt.Sprintf("field 'Born' must have a single type, got %v", r.bornVariants))
+	}
+	if r.Born != nil {
+		r.Born.validateAll(path+".born.ofType("+r.Born.FHIRType()+")", issues)
+	}
+	if r.BornElement != nil {
+		r.BornElement.validateAll(path+".born", issues)
+	}
+	if len(r.ageVariants) > 1 {
+		issues.add("structure", path+".age", fmt.Sprintf("field 'Age' must have a single type, got %v", r.ageVariants))
+	}
+	if r.Age != nil {
+		r.Age.validateAll(path+".age.ofType("+r.Age.FHIRType()+")", issues)
+	}
+	if r.AgeElement != nil {
+		r.AgeElement.validateAll(path+".age", issues)
+	}
+	if r.EstimatedAgeElement != nil {
+		r.EstimatedAgeElement.validateAll(path+".estimatedAge", issues)
+	}
+	if len(r.deceasedVariants) > 1 {
+		issues.add("structure", path+".deceased", fmt.Sprintf("field 'Deceased' must have a single type, got %v", r.deceasedVariants))
+	}
+	if r.Deceased != nil {
+		r.Deceased.validateAll(path+".deceased.ofType("+r.Deceased.FHIRType()+")", issues)
+	}
+	if r.DeceasedElement != nil {
+		r.DeceasedElement.validateAll(path+".deceased", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
+	}
+}
+
+func (r *FamilyMemberHistory) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("FamilyMemberHistory", &issues)
+	checkInvariants(r, "FamilyMemberHistory", &issues)
+	return issues
+}
+
+var familyMemberHistoryInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+	{key: "fhs-1", severity: "error", human: "Can have age[x] or born[x], but not both", expression: "age.empty() or born.empty()"},
+	{key: "fhs-2", severity: "error", human: "Can only have estimatedAge if age[x] is present", expression: "age.exists() or estimatedAge.empty()"},
+	{key: "fhs-3", severity: "error", human: "Can have age[x] or deceased[x], but not both", expression: "age.empty() or deceased.empty()"},
+}
+
+func (r *FamilyMemberHistory) invariants() []invariant {
+	return familyMemberHistoryInvariants
+}
+
+func (r FamilyMemberHistory) MarshalJSON() ([]byte, error) {
+	type alias FamilyMemberHistory
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "born", r.Born, r.BornElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "age", r.Age, r.AgeElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "deceased", r.Deceased, r.DeceasedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *FamilyMemberHistory) UnmarshalJSON(data []byte) error {
+	type alias FamilyMemberHistory
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Born, element, r.bornVariants, err = unmarshalChoice(fields, "born", familyMemberHistoryBornVariants); err != nil {
+		return err
+	}
+	r.BornElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.BornElement); err != nil {
+			return fmt.Errorf("_born: %w", err)
+		}
+	}
+	if r.Age, element, r.ageVariants, err = unmarshalChoice(fields, "age", familyMemberHistoryAgeVariants); err != nil {
+		return err
+	}
+	r.AgeElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AgeElement); err != nil {
+			return fmt.Errorf("_age: %w", err)
+		}
+	}
+	if r.Deceased, element, r.deceasedVariants, err = unmarshalChoice(fields, "deceased", familyMemberHistoryDeceasedVariants); err != nil {
+		return err
+	}
+	r.DeceasedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeceasedElement); err != nil {
+			return fmt.Errorf("_deceased: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r FamilyMemberHistory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FamilyMemberHistory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FamilyMemberHistory) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FamilyMemberHistory) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *FamilyMemberHistory) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Born":
+		return "born", choiceValues(familyMemberHistoryBornVariants)
+	case "Age":
+		return "age", choiceValues(familyMemberHistoryAgeVariants)
+	case "Deceased":
+		return "deceased", choiceValues(familyMemberHistoryDeceasedVariants)
+	}
+	return "", nil
+}
+
+var familyMemberHistoryElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *FamilyMemberHistory) elementTypes() map[string]string {
+	return familyMemberHistoryElementTypes
+}
+
+var familyMemberHistoryElementMetadata = []ElementMetadata{
+	{Path: "FamilyMemberHistory.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "FamilyMemberHistory.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "FamilyMemberHistory.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "FamilyMemberHistory.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "FamilyMemberHistory.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "FamilyMemberHistory.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "FamilyMemberHistory.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "FamilyMemberHistory.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "FamilyMemberHistory.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "FamilyMemberHistory.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "FamilyMemberHistory.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "FamilyMemberHistory.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/history-status"}},
+	{Path: "FamilyMemberHistory.dataAbsentReason", Name: "dataAbsentReason", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.patient", Name: "patient", Field: 15, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "FamilyMemberHistory.date", Name: "date", Field: 16, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "FamilyMemberHistory.recorder", Name: "recorder", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Group"}},
+	{Path: "FamilyMemberHistory.asserter", Name: "asserter", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group"}},
+	{Path: "FamilyMemberHistory.name", Name: "name", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "FamilyMemberHistory.relationship", Name: "relationship", Field: 22, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.sex", Name: "sex", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.born[x]", Name: "born", Field: 24, Min: 0, Max: "1", Types: []string{"Period", "date", "string"}},
+	{Path: "FamilyMemberHistory.age[x]", Name: "age", Field: 26, Min: 0, Max: "1", Types: []string{"Age", "Range", "string"}},
+	{Path: "FamilyMemberHistory.estimatedAge", Name: "estimatedAge", Field: 28, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "FamilyMemberHistory.deceased[x]", Name: "deceased", Field: 30, Min: 0, Max: "1", Types: []string{"boolean", "Age", "Range", "date", "string"}},
+	{Path: "FamilyMemberHistory.reason", Name: "reason", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "FamilyMemberHistory.note", Name: "note", Field: 33, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "FamilyMemberHistory.condition", Name: "condition", Field: 34, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "FamilyMemberHistory.procedure", Name: "procedure", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *FamilyMemberHistory) ElementMetadata() []ElementMetadata {
+	return familyMemberHistoryElementMetadata
+}
+
+// FamilyMemberHistoryBorn is the type of FamilyMemberHistory.born[x]. It is implemented by the FamilyMemberHistoryBorn* variant types.
+type FamilyMemberHistoryBorn interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isFamilyMemberHistoryBorn()
+}
+
+// FamilyMemberHistoryBornPeriod is the Period variant of FamilyMemberHistoryBorn.
+type FamilyMemberHistoryBornPeriod struct {
+	Period
+}
+
+func (v FamilyMemberHistoryBornPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v FamilyMemberHistoryBornPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryBornPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v FamilyMemberHistoryBornPeriod) isFamilyMemberHistoryBorn() {}
+
+// FamilyMemberHistoryBornDate is the date variant of FamilyMemberHistoryBorn.
+type FamilyMemberHistoryBornDate struct {
+	Date
+}
+
+func (v FamilyMemberHistoryBornDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v FamilyMemberHistoryBornDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v FamilyMemberHistoryBornDate) FHIRType() string {
+	return "date"
+}
+
+func (v FamilyMemberHistoryBornDate) isFamilyMemberHistoryBorn() {}
+
+// FamilyMemberHistoryBornString is the string variant of FamilyMemberHistoryBorn.
+type FamilyMemberHistoryBornString string
+
+func (v FamilyMemberHistoryBornString) Validate() error {
+	return nil
+}
+
+func (v FamilyMemberHistoryBornString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v FamilyMemberHistoryBornString) FHIRType() string {
+	return "string"
+}
+
+func (v FamilyMemberHistoryBornString) isFamilyMemberHistoryBorn() {}
+
+var familyMemberHistoryBornVariants = []FamilyMemberHistoryBorn{
+	FamilyMemberHistoryBornPeriod{},
+	FamilyMemberHistoryBornDate{},
+	FamilyMemberHistoryBornString(""),
+}
+
+// FamilyMemberHistoryAge is the type of FamilyMemberHistory.age[x]. It is implemented by the FamilyMemberHistoryAge* variant types.
+type FamilyMemberHistoryAge interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isFamilyMemberHistoryAge()
+}
+
+// FamilyMemberHistoryAgeAge is the Age variant of FamilyMemberHistoryAge.
+type FamilyMemberHistoryAgeAge struct {
+	Age
+}
+
+func (v FamilyMemberHistoryAgeAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v FamilyMemberHistoryAgeAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryAgeAge) FHIRType() string {
+	return "Age"
+}
+
+func (v FamilyMemberHistoryAgeAge) isFamilyMemberHistoryAge() {}
+
+// FamilyMemberHistoryAgeRange is the Range variant of FamilyMemberHistoryAge.
+type FamilyMemberHistoryAgeRange struct {
+	Range
+}
+
+func (v FamilyMemberHistoryAgeRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v FamilyMemberHistoryAgeRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryAgeRange) FHIRType() string {
+	return "Range"
+}
+
+func (v FamilyMemberHistoryAgeRange) isFamilyMemberHistoryAge() {}
+
+// FamilyMemberHistoryAgeString is the string variant of FamilyMemberHistoryAge.
+type FamilyMemberHistoryAgeString string
+
+func (v FamilyMemberHistoryAgeString) Validate() error {
+	return nil
+}
+
+func (v FamilyMemberHistoryAgeString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v FamilyMemberHistoryAgeString) FHIRType() string {
+	return "string"
+}
+
+func (v FamilyMemberHistoryAgeString) isFamilyMemberHistoryAge() {}
+
+var familyMemberHistoryAgeVariants = []FamilyMemberHistoryAge{
+	FamilyMemberHistoryAgeAge{},
+	FamilyMemberHistoryAgeRange{},
+	FamilyMemberHistoryAgeString(""),
+}
+
+// FamilyMemberHistoryDeceased is the type of FamilyMemberHistory.deceased[x]. It is implemented by the FamilyMemberHistoryDeceased* variant types.
+type FamilyMemberHistoryDeceased interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isFamilyMemberHistoryDeceased()
+}
+
+// FamilyMemberHistoryDeceasedBoolean is the boolean variant of FamilyMemberHistoryDeceased.
+type FamilyMemberHistoryDeceasedBoolean bool
+
+func (v FamilyMemberHistoryDeceasedBoolean) Validate() error {
+	return nil
+}
+
+func (v FamilyMemberHistoryDeceasedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v FamilyMemberHistoryDeceasedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v FamilyMemberHistoryDeceasedBoolean) isFamilyMemberHistoryDeceased() {}
+
+// FamilyMemberHistoryDeceasedAge is the Age variant of FamilyMemberHistoryDeceased.
+type FamilyMemberHistoryDeceasedAge struct {
+	Age
+}
+
+func (v FamilyMemberHistoryDeceasedAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v FamilyMemberHistoryDeceasedAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryDeceasedAge) FHIRType() string {
+	return "Age"
+}
+
+func (v FamilyMemberHistoryDeceasedAge) isFamilyMemberHistoryDeceased() {}
+
+// FamilyMemberHistoryDeceasedRange is the Range variant of FamilyMemberHistoryDeceased.
+type FamilyMemberHistoryDeceasedRange struct {
+	Range
+}
+
+func (v FamilyMemberHistoryDeceasedRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v FamilyMemberHistoryDeceasedRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryDeceasedRange) FHIRType() string {
+	return "Range"
+}
+
+func (v FamilyMemberHistoryDeceasedRange) isFamilyMemberHistoryDeceased() {}
+
+// FamilyMemberHistoryDeceasedDate is the date variant of FamilyMemberHistoryDeceased.
+type FamilyMemberHistoryDeceasedDate struct {
+	Date
+}
+
+func (v FamilyMemberHistoryDeceasedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v FamilyMemberHistoryDeceasedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v FamilyMemberHistoryDeceasedDate) FHIRType() string {
+	return "date"
+}
+
+func (v FamilyMemberHistoryDeceasedDate) isFamilyMemberHistoryDeceased() {}
+
+// FamilyMemberHistoryDeceasedString is the string variant of FamilyMemberHistoryDeceased.
+type FamilyMemberHistoryDeceasedString string
+
+func (v FamilyMemberHistoryDeceasedString) Validate() error {
 	return nil
 }
 
+func (v FamilyMemberHistoryDeceasedString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v FamilyMemberHistoryDeceasedString) FHIRType() string {
+	return "string"
+}
+
+func (v FamilyMemberHistoryDeceasedString) isFamilyMemberHistoryDeceased() {}
+
+var familyMemberHistoryDeceasedVariants = []FamilyMemberHistoryDeceased{
+	FamilyMemberHistoryDeceasedBoolean(false),
+	FamilyMemberHistoryDeceasedAge{},
+	FamilyMemberHistoryDeceasedRange{},
+	FamilyMemberHistoryDeceasedDate{},
+	FamilyMemberHistoryDeceasedString(""),
+}
+
+func (r *FamilyMemberHistory) GetResourceType() string {
+	return "FamilyMemberHistory"
+}
+
+func (r *FamilyMemberHistory) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *FamilyMemberHistory) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *FamilyMemberHistory) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *FamilyMemberHistory) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *FamilyMemberHistory) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *FamilyMemberHistory) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *FamilyMemberHistory) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*FamilyMemberHistory)(nil)
+
 type FamilyMemberHistoryCondition struct {
-	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
-	Code               *CodeableConcept `json:"code" bson:"code"`                                                   // Condition, allergy, or intolerance suffered by relation
-	Outcome            *CodeableConcept `json:"outcome,omitempty" bson:"outcome,omitempty"`                         // deceased | permanent disability | etc
-	ContributedToDeath *bool            `json:"contributedToDeath,omitempty" bson:"contributed_to_death,omitempty"` // Whether the condition contributed to the cause of death
-	OnsetAge           *Age             `json:"onsetAge,omitempty" bson:"onset_age,omitempty"`                      // When condition first manifested
-	OnsetRange         *Range           `json:"onsetRange,omitempty" bson:"onset_range,omitempty"`                  // When condition first manifested
-	OnsetPeriod        *Period          `json:"onsetPeriod,omitempty" bson:"onset_period,omitempty"`                // When condition first manifested
-	OnsetString        *string          `json:"onsetString,omitempty" bson:"onset_string,omitempty"`                // When condition first manifested
-	Note               []Annotation     `json:"note,omitempty" bson:"note,omitempty"`                               // Extra information about condition
+	Id                        *string                           `json:"id,omitempty" bson:"id,omitempty"`                                            // Unique id for inter-element referencing
+	Extension                 []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                              // Additional content defined by implementations
+	ModifierExtension         []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`             // Extensions that cannot be ignored even if unrecognized
+	Code                      *CodeableConcept                  `json:"code" bson:"code"`                                                            // Condition, allergy, or intolerance suffered by relation
+	Outcome                   *CodeableConcept                  `json:"outcome,omitempty" bson:"outcome,omitempty"`                                  // deceased | permanent disability | etc
+	ContributedToDeath        *bool                             `json:"contributedToDeath,omitempty" bson:"contributed_to_death,omitempty"`          // Whether the condition contributed to the cause of death
+	ContributedToDeathElement *Element                          `json:"_contributedToDeath,omitempty" bson:"contributed_to_death_element,omitempty"` // Extensions for contributedToDeath
+	Onset                     FamilyMemberHistoryConditionOnset `json:"-" bson:"onset,omitempty"`                                                    // When condition first manifested
+	OnsetElement              *Element                          `json:"-" bson:"onset_element,omitempty"`                                            // Extensions for onset[x]
+	Note                      []Annotation                      `json:"note,omitempty" bson:"note,omitempty"`                                        // Extra information about condition
+
+	onsetVariants []string // JSON properties of FamilyMemberHistory.condition.onset[x] when more than one was decoded
 }
 
 func (r *FamilyMemberHistoryCondition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -175,19 +869,22 @@ func (r *FamilyMemberHistoryCondition) Validate() error {
 			return fmt.Errorf("Outcome: %w", err)
 		}
 	}
-	if r.OnsetAge != nil {
-		if err := r.OnsetAge.Validate(); err != nil {
-			return fmt.Errorf("OnsetAge: %w", err)
+	if r.ContributedToDeathElement != nil {
+		if err := r.ContributedToDeathElement.Validate(); err != nil {
+			return fmt.Errorf("ContributedToDeathElement: %w", err)
 		}
 	}
-	if r.OnsetRange != nil {
-		if err := r.OnsetRange.Validate(); err != nil {
-			return fmt.Errorf("OnsetRange: %w", err)
+	if len(r.onsetVariants) > 1 {
+		return fmt.Errorf("field 'Onset' must have a single type, got %v", r.onsetVariants)
+	}
+	if r.Onset != nil {
+		if err := r.Onset.Validate(); err != nil {
+			return fmt.Errorf("Onset: %w", err)
 		}
 	}
-	if r.OnsetPeriod != nil {
-		if err := r.OnsetPeriod.Validate(); err != nil {
-			return fmt.Errorf("OnsetPeriod: %w", err)
+	if r.OnsetElement != nil {
+		if err := r.OnsetElement.Validate(); err != nil {
+			return fmt.Errorf("OnsetElement: %w", err)
 		}
 	}
 	for i, item := range r.Note {
@@ -198,20 +895,240 @@ func (r *FamilyMemberHistoryCondition) Validate() error {
 	return nil
 }
 
+func (r *FamilyMemberHistoryCondition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Outcome != nil {
+		r.Outcome.validateAll(path+".outcome", issues)
+	}
+	if r.ContributedToDeathElement != nil {
+		r.ContributedToDeathElement.validateAll(path+".contributedToDeath", issues)
+	}
+	if len(r.onsetVariants) > 1 {
+		issues.add("structure", path+".onset", fmt.Sprintf("field 'Onset' must have a single type, got %v", r.onsetVariants))
+	}
+	if r.Onset != nil {
+		r.Onset.validateAll(path+".onset.ofType("+r.Onset.FHIRType()+")", issues)
+	}
+	if r.OnsetElement != nil {
+		r.OnsetElement.validateAll(path+".onset", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+var familyMemberHistoryConditionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *FamilyMemberHistoryCondition) invariants() []invariant {
+	return familyMemberHistoryConditionInvariants
+}
+
+func (r FamilyMemberHistoryCondition) MarshalJSON() ([]byte, error) {
+	type alias FamilyMemberHistoryCondition
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "onset", r.Onset, r.OnsetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *FamilyMemberHistoryCondition) UnmarshalJSON(data []byte) error {
+	type alias FamilyMemberHistoryCondition
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Onset, element, r.onsetVariants, err = unmarshalChoice(fields, "onset", familyMemberHistoryConditionOnsetVariants); err != nil {
+		return err
+	}
+	r.OnsetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OnsetElement); err != nil {
+			return fmt.Errorf("_onset: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r FamilyMemberHistoryCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FamilyMemberHistoryCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FamilyMemberHistoryCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FamilyMemberHistoryCondition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *FamilyMemberHistoryCondition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Onset":
+		return "onset", choiceValues(familyMemberHistoryConditionOnsetVariants)
+	}
+	return "", nil
+}
+
+var familyMemberHistoryConditionElementMetadata = []ElementMetadata{
+	{Path: "FamilyMemberHistory.condition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "FamilyMemberHistory.condition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "FamilyMemberHistory.condition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "FamilyMemberHistory.condition.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.condition.outcome", Name: "outcome", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.condition.contributedToDeath", Name: "contributedToDeath", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "FamilyMemberHistory.condition.onset[x]", Name: "onset", Field: 7, Min: 0, Max: "1", Types: []string{"Age", "Range", "Period", "string"}},
+	{Path: "FamilyMemberHistory.condition.note", Name: "note", Field: 9, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *FamilyMemberHistoryCondition) ElementMetadata() []ElementMetadata {
+	return familyMemberHistoryConditionElementMetadata
+}
+
+// FamilyMemberHistoryConditionOnset is the type of FamilyMemberHistory.condition.onset[x]. It is implemented by the FamilyMemberHistoryConditionOnset* variant types.
+type FamilyMemberHistoryConditionOnset interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isFamilyMemberHistoryConditionOnset()
+}
+
+// FamilyMemberHistoryConditionOnsetAge is the Age variant of FamilyMemberHistoryConditionOnset.
+type FamilyMemberHistoryConditionOnsetAge struct {
+	Age
+}
+
+func (v FamilyMemberHistoryConditionOnsetAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v FamilyMemberHistoryConditionOnsetAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryConditionOnsetAge) FHIRType() string {
+	return "Age"
+}
+
+func (v FamilyMemberHistoryConditionOnsetAge) isFamilyMemberHistoryConditionOnset() {}
+
+// FamilyMemberHistoryConditionOnsetRange is the Range variant of FamilyMemberHistoryConditionOnset.
+type FamilyMemberHistoryConditionOnsetRange struct {
+	Range
+}
+
+func (v FamilyMemberHistoryConditionOnsetRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v FamilyMemberHistoryConditionOnsetRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryConditionOnsetRange) FHIRType() string {
+	return "Range"
+}
+
+func (v FamilyMemberHistoryConditionOnsetRange) isFamilyMemberHistoryConditionOnset() {}
+
+// FamilyMemberHistoryConditionOnsetPeriod is the Period variant of FamilyMemberHistoryConditionOnset.
+type FamilyMemberHistoryConditionOnsetPeriod struct {
+	Period
+}
+
+func (v FamilyMemberHistoryConditionOnsetPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v FamilyMemberHistoryConditionOnsetPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryConditionOnsetPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v FamilyMemberHistoryConditionOnsetPeriod) isFamilyMemberHistoryConditionOnset() {}
+
+// FamilyMemberHistoryConditionOnsetString is the string variant of FamilyMemberHistoryConditionOnset.
+type FamilyMemberHistoryConditionOnsetString string
+
+func (v FamilyMemberHistoryConditionOnsetString) Validate() error {
+	return nil
+}
+
+func (v FamilyMemberHistoryConditionOnsetString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v FamilyMemberHistoryConditionOnsetString) FHIRType() string {
+	return "string"
+}
+
+func (v FamilyMemberHistoryConditionOnsetString) isFamilyMemberHistoryConditionOnset() {}
+
+var familyMemberHistoryConditionOnsetVariants = []FamilyMemberHistoryConditionOnset{
+	FamilyMemberHistoryConditionOnsetAge{},
+	FamilyMemberHistoryConditionOnsetRange{},
+	FamilyMemberHistoryConditionOnsetPeriod{},
+	FamilyMemberHistoryConditionOnsetString(""),
+}
+
 type FamilyMemberHistoryProcedure struct {
-	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
-	Code               *CodeableConcept `json:"code" bson:"code"`                                                   // Procedures performed on the related person
-	Outcome            *CodeableConcept `json:"outcome,omitempty" bson:"outcome,omitempty"`                         // What happened following the procedure
-	ContributedToDeath *bool            `json:"contributedToDeath,omitempty" bson:"contributed_to_death,omitempty"` // Whether the procedure contributed to the cause of death
-	PerformedAge       *Age             `json:"performedAge,omitempty" bson:"performed_age,omitempty"`              // When the procedure was performed
-	PerformedRange     *Range           `json:"performedRange,omitempty" bson:"performed_range,omitempty"`          // When the procedure was performed
-	PerformedPeriod    *Period          `json:"performedPeriod,omitempty" bson:"performed_period,omitempty"`        // When the procedure was performed
-	PerformedString    *string          `json:"performedString,omitempty" bson:"performed_string,omitempty"`        // When the procedure was performed
-	PerformedDateTime  *string          `json:"performedDateTime,omitempty" bson:"performed_date_time,omitempty"`   // When the procedure was performed
-	Note               []Annotation     `json:"note,omitempty" bson:"note,omitempty"`                               // Extra information about the procedure
+	Id                        *string                               `json:"id,omitempty" bson:"id,omitempty"`                                            // Unique id for inter-element referencing
+	Extension                 []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                              // Additional content defined by implementations
+	ModifierExtension         []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`             // Extensions that cannot be ignored even if unrecognized
+	Code                      *CodeableConcept                      `json:"code" bson:"code"`                                                            // Procedures performed on the related person
+	Outcome                   *CodeableConcept                      `json:"outcome,omitempty" bson:"outcome,omitempty"`                                  // What happened following the procedure
+	ContributedToDeath        *bool                                 `json:"contributedToDeath,omitempty" bson:"contributed_to_death,omitempty"`          // Whether the procedure contributed to the cause of death
+	ContributedToDeathElement *Element                              `json:"_contributedToDeath,omitempty" bson:"contributed_to_death_element,omitempty"` // Extensions for contributedToDeath
+	Performed                 FamilyMemberHistoryProcedurePerformed `json:"-" bson:"performed,omitempty"`                                                // When the procedure was performed
+	PerformedElement          *Element                              `json:"-" bson:"performed_element,omitempty"`                                        // Extensions for performed[x]
+	Note                      []Annotation                          `json:"note,omitempty" bson:"note,omitempty"`                                        // Extra information about the procedure
+
+	performedVariants []string // JSON properties of FamilyMemberHistory.procedure.performed[x] when more than one was decoded
 }
 
 func (r *FamilyMemberHistoryProcedure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -225,19 +1142,22 @@ func (r *FamilyMemberHistoryProcedure) Validate() error {
 			return fmt.Errorf("Outcome: %w", err)
 		}
 	}
-	if r.PerformedAge != nil {
-		if err := r.PerformedAge.Validate(); err != nil {
-			return fmt.Errorf("PerformedAge: %w", err)
+	if r.ContributedToDeathElement != nil {
+		if err := r.ContributedToDeathElement.Validate(); err != nil {
+			return fmt.Errorf("ContributedToDeathElement: %w", err)
 		}
 	}
-	if r.PerformedRange != nil {
-		if err := r.PerformedRange.Validate(); err != nil {
-			return fmt.Errorf("PerformedRange: %w", err)
+	if len(r.performedVariants) > 1 {
+		return fmt.Errorf("field 'Performed' must have a single type, got %v", r.performedVariants)
+	}
+	if r.Performed != nil {
+		if err := r.Performed.Validate(); err != nil {
+			return fmt.Errorf("Performed: %w", err)
 		}
 	}
-	if r.PerformedPeriod != nil {
-		if err := r.PerformedPeriod.Validate(); err != nil {
-			return fmt.Errorf("PerformedPeriod: %w", err)
+	if r.PerformedElement != nil {
+		if err := r.PerformedElement.Validate(); err != nil {
+			return fmt.Errorf("PerformedElement: %w", err)
 		}
 	}
 	for i, item := range r.Note {
@@ -247,3 +1167,234 @@ func (r *FamilyMemberHistoryProcedure) Validate() error {
 	}
 	return nil
 }
+
+func (r *FamilyMemberHistoryProcedure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Outcome != nil {
+		r.Outcome.validateAll(path+".outcome", issues)
+	}
+	if r.ContributedToDeathElement != nil {
+		r.ContributedToDeathElement.validateAll(path+".contributedToDeath", issues)
+	}
+	if len(r.performedVariants) > 1 {
+		issues.add("structure", path+".performed", fmt.Sprintf("field 'Performed' must have a single type, got %v", r.performedVariants))
+	}
+	if r.Performed != nil {
+		r.Performed.validateAll(path+".performed.ofType("+r.Performed.FHIRType()+")", issues)
+	}
+	if r.PerformedElement != nil {
+		r.PerformedElement.validateAll(path+".performed", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+var familyMemberHistoryProcedureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *FamilyMemberHistoryProcedure) invariants() []invariant {
+	return familyMemberHistoryProcedureInvariants
+}
+
+func (r FamilyMemberHistoryProcedure) MarshalJSON() ([]byte, error) {
+	type alias FamilyMemberHistoryProcedure
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "performed", r.Performed, r.PerformedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *FamilyMemberHistoryProcedure) UnmarshalJSON(data []byte) error {
+	type alias FamilyMemberHistoryProcedure
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Performed, element, r.performedVariants, err = unmarshalChoice(fields, "performed", familyMemberHistoryProcedurePerformedVariants); err != nil {
+		return err
+	}
+	r.PerformedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.PerformedElement); err != nil {
+			return fmt.Errorf("_performed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r FamilyMemberHistoryProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *FamilyMemberHistoryProcedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r FamilyMemberHistoryProcedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *FamilyMemberHistoryProcedure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *FamilyMemberHistoryProcedure) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Performed":
+		return "performed", choiceValues(familyMemberHistoryProcedurePerformedVariants)
+	}
+	return "", nil
+}
+
+var familyMemberHistoryProcedureElementMetadata = []ElementMetadata{
+	{Path: "FamilyMemberHistory.procedure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "FamilyMemberHistory.procedure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "FamilyMemberHistory.procedure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "FamilyMemberHistory.procedure.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.procedure.outcome", Name: "outcome", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "FamilyMemberHistory.procedure.contributedToDeath", Name: "contributedToDeath", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "FamilyMemberHistory.procedure.performed[x]", Name: "performed", Field: 7, Min: 0, Max: "1", Types: []string{"Age", "Range", "Period", "string", "dateTime"}},
+	{Path: "FamilyMemberHistory.procedure.note", Name: "note", Field: 9, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *FamilyMemberHistoryProcedure) ElementMetadata() []ElementMetadata {
+	return familyMemberHistoryProcedureElementMetadata
+}
+
+// FamilyMemberHistoryProcedurePerformed is the type of FamilyMemberHistory.procedure.performed[x]. It is implemented by the FamilyMemberHistoryProcedurePerformed* variant types.
+type FamilyMemberHistoryProcedurePerformed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isFamilyMemberHistoryProcedurePerformed()
+}
+
+// FamilyMemberHistoryProcedurePerformedAge is the Age variant of FamilyMemberHistoryProcedurePerformed.
+type FamilyMemberHistoryProcedurePerformedAge struct {
+	Age
+}
+
+func (v FamilyMemberHistoryProcedurePerformedAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v FamilyMemberHistoryProcedurePerformedAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryProcedurePerformedAge) FHIRType() string {
+	return "Age"
+}
+
+func (v FamilyMemberHistoryProcedurePerformedAge) isFamilyMemberHistoryProcedurePerformed() {}
+
+// FamilyMemberHistoryProcedurePerformedRange is the Range variant of FamilyMemberHistoryProcedurePerformed.
+type FamilyMemberHistoryProcedurePerformedRange struct {
+	Range
+}
+
+func (v FamilyMemberHistoryProcedurePerformedRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v FamilyMemberHistoryProcedurePerformedRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryProcedurePerformedRange) FHIRType() string {
+	return "Range"
+}
+
+func (v FamilyMemberHistoryProcedurePerformedRange) isFamilyMemberHistoryProcedurePerformed() {}
+
+// FamilyMemberHistoryProcedurePerformedPeriod is the Period variant of FamilyMemberHistoryProcedurePerformed.
+type FamilyMemberHistoryProcedurePerformedPeriod struct {
+	Period
+}
+
+func (v FamilyMemberHistoryProcedurePerformedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v FamilyMemberHistoryProcedurePerformedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v FamilyMemberHistoryProcedurePerformedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v FamilyMemberHistoryProcedurePerformedPeriod) isFamilyMemberHistoryProcedurePerformed() {}
+
+// FamilyMemberHistoryProcedurePerformedString is the string variant of FamilyMemberHistoryProcedurePerformed.
+type FamilyMemberHistoryProcedurePerformedString string
+
+func (v FamilyMemberHistoryProcedurePerformedString) Validate() error {
+	return nil
+}
+
+func (v FamilyMemberHistoryProcedurePerformedString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v FamilyMemberHistoryProcedurePerformedString) FHIRType() string {
+	return "string"
+}
+
+func (v FamilyMemberHistoryProcedurePerformedString) isFamilyMemberHistoryProcedurePerformed() {}
+
+// FamilyMemberHistoryProcedurePerformedDateTime is the dateTime variant of FamilyMemberHistoryProcedurePerformed.
+type FamilyMemberHistoryProcedurePerformedDateTime struct {
+	DateTime
+}
+
+func (v FamilyMemberHistoryProcedurePerformedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v FamilyMemberHistoryProcedurePerformedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v FamilyMemberHistoryProcedurePerformedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v FamilyMemberHistoryProcedurePerformedDateTime) isFamilyMemberHistoryProcedurePerformed() {}
+
+var familyMemberHistoryProcedurePerformedVariants = []FamilyMemberHistoryProcedurePerformed{
+	FamilyMemberHistoryProcedurePerformedAge{},
+	FamilyMemberHistoryProcedurePerformedRange{},
+	FamilyMemberHistoryProcedurePerformedPeriod{},
+	FamilyMemberHistoryProcedurePerformedString(""),
+	FamilyMemberHistoryProcedurePerformedDateTime{},
+}
@@ -0,0 +1,672 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// fhirpathExpr is a compiled FHIRPath expression. Paths start from $this
+// of env, so evaluating a function argument against another item only
+// takes a copy of env with a different $this.
+type fhirpathExpr interface {
+	eval(env *fhirpathEnv) ([]fhirpathNode, error)
+}
+
+// fhirpathCache holds compiled expressions by source text. Invariants are
+// evaluated for every element they apply to, so each is parsed only once.
+var fhirpathCache sync.Map
+
+// compileFHIRPath parses a FHIRPath expression, returning the cached result
+// of an earlier call with the same text.
+func compileFHIRPath(source string) (fhirpathExpr, error) {
+	type compiled struct {
+		expr fhirpathExpr
+		err  error
+	}
+	if c, ok := fhirpathCache.Load(source); ok {
+		return c.(compiled).expr, c.(compiled).err
+	}
+	expr, err := parseFHIRPath(source)
+	fhirpathCache.Store(source, compiled{expr, err})
+	return expr, err
+}
+
+type fhirpathTokenKind int
+
+const (
+	tokenEOF fhirpathTokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenNumber
+	tokenDateTime
+	tokenVariable // %name or $name
+	tokenOperator
+)
+
+type fhirpathToken struct {
+	kind   fhirpathTokenKind
+	text   string
+	quoted bool // identifier written in backticks, never a keyword
+}
+
+// lexFHIRPath splits an expression into tokens.
+func lexFHIRPath(s string) ([]fhirpathToken, error) {
+	var tokens []fhirpathToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case strings.HasPrefix(s[i:], "//"):
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i += end + 4
+		case c == '\'' || c == '`':
+			text, n, err := lexQuoted(s[i:])
+			if err != nil {
+				return nil, err
+			}
+			if c == '\'' {
+				tokens = append(tokens, fhirpathToken{kind: tokenString, text: text})
+			} else {
+				tokens = append(tokens, fhirpathToken{kind: tokenIdentifier, text: text, quoted: true})
+			}
+			i += n
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+				i++
+			}
+			if i+1 < len(s) && s[i] == '.' && s[i+1] >= '0' && s[i+1] <= '9' {
+				i++
+				for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+					i++
+				}
+			}
+			tokens = append(tokens, fhirpathToken{kind: tokenNumber, text: s[start:i]})
+		case c == '@':
+			start := i + 1
+			i++
+			for i < len(s) && strings.IndexByte("0123456789-:.TZ+", s[i]) >= 0 {
+				// A dot not followed by a digit starts a function call, as in
+				// @2015-02-04.is(Date).
+				if s[i] == '.' && (i+1 == len(s) || s[i+1] < '0' || s[i+1] > '9') {
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, fhirpathToken{kind: tokenDateTime, text: s[start:i]})
+		case c == '%' || c == '$':
+			i++
+			if i < len(s) && (s[i] == '\'' || s[i] == '`') {
+				text, n, err := lexQuoted(s[i:])
+				if err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, fhirpathToken{kind: tokenVariable, text: string(c) + text})
+				i += n
+				continue
+			}
+			start := i
+			for i < len(s) && isIdentifierByte(s[i]) {
+				i++
+			}
+			if start == i {
+				return nil, fmt.Errorf("expected a name after %q", c)
+			}
+			tokens = append(tokens, fhirpathToken{kind: tokenVariable, text: string(c) + s[start:i]})
+		case isIdentifierByte(c):
+			start := i
+			for i < len(s) && isIdentifierByte(s[i]) {
+				i++
+			}
+			tokens = append(tokens, fhirpathToken{kind: tokenIdentifier, text: s[start:i]})
+		default:
+			op := string(c)
+			if i+1 < len(s) {
+				switch s[i : i+2] {
+				case "<=", ">=", "!=", "!~":
+					op = s[i : i+2]
+				}
+			}
+			if len(op) == 1 && !strings.Contains("=~<>+-*/&|()[]{},.", op) {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+			tokens = append(tokens, fhirpathToken{kind: tokenOperator, text: op})
+			i += len(op)
+		}
+	}
+	return append(tokens, fhirpathToken{kind: tokenEOF}), nil
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// lexQuoted reads a string or delimited identifier starting at s[0] and
+// returns its unescaped text and length in s.
+func lexQuoted(s string) (string, int, error) {
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if c == quote {
+			return b.String(), i + 1, nil
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(s) {
+			break
+		}
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", 0, fmt.Errorf("invalid unicode escape")
+			}
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid unicode escape %q", s[i+1:i+5])
+			}
+			b.WriteRune(rune(r))
+			i += 4
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated %c", quote)
+}
+
+// fhirpathParser is a recursive descent parser following the operator
+// precedence of the FHIRPath grammar.
+type fhirpathParser struct {
+	tokens []fhirpathToken
+	pos    int
+}
+
+func parseFHIRPath(source string) (fhirpathExpr, error) {
+	tokens, err := lexFHIRPath(source)
+	if err != nil {
+		return nil, fmt.Errorf("fhirpath %q: %w", source, err)
+	}
+	p := &fhirpathParser{tokens: tokens}
+	expr, err := p.expression()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fhirpath %q: %w", source, err)
+	}
+	return expr, nil
+}
+
+func (p *fhirpathParser) peek() fhirpathToken {
+	return p.tokens[p.pos]
+}
+
+func (p *fhirpathParser) next() fhirpathToken {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// isOperator reports whether the next token is one of ops, either a
+// symbol or an unquoted keyword such as "and".
+func (p *fhirpathParser) isOperator(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokenOperator && (t.kind != tokenIdentifier || t.quoted) {
+		return false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *fhirpathParser) expect(op string) error {
+	if !p.isOperator(op) {
+		return fmt.Errorf("expected %q, got %q", op, p.peek().text)
+	}
+	p.next()
+	return nil
+}
+
+// binaryLevels lists the binary operators from the loosest to the tightest
+// binding. The type operators is and as sit between | and +.
+var binaryLevels = [][]string{
+	{"implies"},
+	{"or", "xor"},
+	{"and"},
+	{"in", "contains"},
+	{"=", "~", "!=", "!~"},
+	{"<", "<=", ">", ">="},
+	{"|"},
+	{"is", "as"},
+	{"+", "-", "&"},
+	{"*", "/", "div", "mod"},
+}
+
+func (p *fhirpathParser) expression() (fhirpathExpr, error) {
+	return p.binary(0)
+}
+
+func (p *fhirpathParser) binary(level int) (fhirpathExpr, error) {
+	if level == len(binaryLevels) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator(binaryLevels[level]...) {
+		op := p.next().text
+		if op == "is" || op == "as" {
+			typeName, err := p.typeSpecifier()
+			if err != nil {
+				return nil, err
+			}
+			left = &typeExpr{op: op, operand: left, typeName: typeName}
+			continue
+		}
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *fhirpathParser) unary() (fhirpathExpr, error) {
+	if p.isOperator("+", "-") {
+		op := p.next().text
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "+" {
+			return operand, nil
+		}
+		return &negateExpr{operand: operand}, nil
+	}
+	return p.invocation()
+}
+
+// typeSpecifier reads a possibly qualified type name such as FHIR.Patient.
+func (p *fhirpathParser) typeSpecifier() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier {
+		return "", fmt.Errorf("expected a type name, got %q", t.text)
+	}
+	name := t.text
+	for p.isOperator(".") && p.tokens[p.pos+1].kind == tokenIdentifier {
+		p.next()
+		name += "." + p.next().text
+	}
+	return name, nil
+}
+
+func (p *fhirpathParser) invocation() (fhirpathExpr, error) {
+	expr, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.isOperator("."):
+			p.next()
+			t := p.next()
+			if t.kind != tokenIdentifier {
+				return nil, fmt.Errorf("expected a name after '.', got %q", t.text)
+			}
+			if p.isOperator("(") && !t.quoted {
+				if expr, err = p.call(expr, t.text); err != nil {
+					return nil, err
+				}
+			} else {
+				expr = &memberExpr{target: expr, name: t.text}
+			}
+		case p.isOperator("["):
+			p.next()
+			index, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			expr = &indexExpr{target: expr, index: index}
+		default:
+			return expr, nil
+		}
+	}
+}
+
+// call reads the arguments of the function name invoked on target,
+// rejecting functions the evaluator does not support and wrong numbers of
+// arguments.
+func (p *fhirpathParser) call(target fhirpathExpr, name string) (fhirpathExpr, error) {
+	args, err := p.arguments()
+	if err != nil {
+		return nil, err
+	}
+	fn, ok := fhirpathFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function %s()", name)
+	}
+	if len(args) < fn.minArgs || len(args) > fn.maxArgs {
+		return nil, fmt.Errorf("%s() takes %d to %d arguments, got %d", name, fn.minArgs, fn.maxArgs, len(args))
+	}
+	return &callExpr{target: target, name: name, args: args}, nil
+}
+
+func (p *fhirpathParser) arguments() ([]fhirpathExpr, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []fhirpathExpr
+	if p.isOperator(")") {
+		p.next()
+		return args, nil
+	}
+	for {
+		arg, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.isOperator(",") {
+			p.next()
+			continue
+		}
+		return args, p.expect(")")
+	}
+}
+
+func (p *fhirpathParser) term() (fhirpathExpr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return &literalExpr{node: fhirpathNode{value: t.text}}, nil
+	case tokenNumber:
+		if next := p.peek(); next.kind == tokenString || (next.kind == tokenIdentifier && !next.quoted && calendarUnits[next.text] != "") {
+			return p.quantity(t.text)
+		}
+		if strings.Contains(t.text, ".") {
+			d, err := ParseDecimal(t.text)
+			return &literalExpr{node: fhirpathNode{value: d}}, err
+		}
+		i, err := strconv.ParseInt(t.text, 10, 64)
+		return &literalExpr{node: fhirpathNode{value: i}}, err
+	case tokenDateTime:
+		return parseTemporalLiteral(t.text)
+	case tokenVariable:
+		return &variableExpr{name: t.text}, nil
+	case tokenIdentifier:
+		if !t.quoted {
+			switch {
+			case p.isOperator("("):
+				return p.call(nil, t.text)
+			case t.text == "true" || t.text == "false":
+				return &literalExpr{node: fhirpathNode{value: t.text == "true"}}, nil
+			}
+		}
+		return &memberExpr{name: t.text}, nil
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			expr, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expect(")")
+		case "{":
+			return &literalExpr{empty: true}, p.expect("}")
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+// quantity reads the unit of a quantity literal such as 4 'mg' or of a
+// calendar duration such as 2 weeks.
+func (p *fhirpathParser) quantity(value string) (fhirpathExpr, error) {
+	d, err := ParseDecimal(value)
+	if err != nil {
+		return nil, err
+	}
+	unit := p.next()
+	if unit.kind == tokenIdentifier {
+		unit.text = calendarUnits[unit.text]
+	}
+	return &literalExpr{node: fhirpathNode{value: fhirpathQuantity{value: d, unit: unit.text}}}, nil
+}
+
+// parseTemporalLiteral converts @2024-03-01, @2024-03-01T10:00:00Z or
+// @T10:00 into a literal of the matching runtime type.
+func parseTemporalLiteral(text string) (fhirpathExpr, error) {
+	var (
+		value any
+		err   error
+	)
+	switch {
+	case strings.HasPrefix(text, "T"):
+		value, err = parsePartialTime(text[1:])
+	case strings.Contains(text, "T"):
+		value, err = parsePartialDateTime(strings.TrimSuffix(text, "T"))
+	default:
+		value, err = ParseDate(text)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &literalExpr{node: fhirpathNode{value: value}}, nil
+}
+
+// parsePartialTime parses a FHIRPath time, which unlike a FHIR time may
+// stop at hours or minutes.
+func parsePartialTime(s string) (Time, error) {
+	t := Time{literal: s}
+	_, err := t.parse()
+	return t, err
+}
+
+// parsePartialDateTime parses a FHIRPath dateTime, which unlike a FHIR
+// dateTime may stop at hours or minutes.
+func parsePartialDateTime(s string) (DateTime, error) {
+	dt := DateTime{literal: s}
+	_, err := dt.parse()
+	return dt, err
+}
+
+type literalExpr struct {
+	node  fhirpathNode
+	empty bool
+}
+
+func (e *literalExpr) eval(*fhirpathEnv) ([]fhirpathNode, error) {
+	if e.empty {
+		return nil, nil
+	}
+	return []fhirpathNode{e.node}, nil
+}
+
+// variableExpr is an environment variable such as %resource or one of the
+// iteration variables $this, $index and $total.
+type variableExpr struct {
+	name string
+}
+
+func (e *variableExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	switch e.name {
+	case "$this":
+		return env.this, nil
+	case "$index":
+		return []fhirpathNode{{value: int64(env.index)}}, nil
+	case "$total":
+		return env.total, nil
+	case "%context":
+		return env.context, nil
+	}
+	value, ok := env.variable(strings.TrimPrefix(e.name, "%"))
+	if !ok || !strings.HasPrefix(e.name, "%") {
+		return nil, fmt.Errorf("undefined variable %s", e.name)
+	}
+	return value, nil
+}
+
+// memberExpr navigates to the children called name of every item of its
+// target. Without a target it starts from $this, where a leading type name
+// such as Observation in Observation.value selects $this itself.
+type memberExpr struct {
+	target fhirpathExpr
+	name   string
+}
+
+func (e *memberExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	input := env.this
+	if e.target == nil {
+		if len(e.name) > 0 && unicode.IsUpper(rune(e.name[0])) {
+			var typed []fhirpathNode
+			for _, n := range input {
+				if n.is(e.name) {
+					typed = append(typed, n)
+				}
+			}
+			if len(typed) > 0 {
+				return typed, nil
+			}
+		}
+	} else {
+		var err error
+		if input, err = e.target.eval(env); err != nil {
+			return nil, err
+		}
+	}
+	var result []fhirpathNode
+	for _, n := range input {
+		result = append(result, n.child(e.name)...)
+	}
+	return result, nil
+}
+
+type indexExpr struct {
+	target, index fhirpathExpr
+}
+
+func (e *indexExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	items, err := e.target.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	index, err := e.index.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	i, ok, err := singletonInteger(index)
+	if err != nil || !ok || i < 0 || i >= int64(len(items)) {
+		return nil, err
+	}
+	return items[i : i+1], nil
+}
+
+type negateExpr struct {
+	operand fhirpathExpr
+}
+
+func (e *negateExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	items, err := e.operand.eval(env)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	if len(items) > 1 {
+		return nil, fmt.Errorf("unary minus on a collection of %d items", len(items))
+	}
+	switch v := items[0].value.(type) {
+	case int64:
+		return []fhirpathNode{{value: -v}}, nil
+	case Decimal:
+		return []fhirpathNode{{value: v.Neg()}}, nil
+	case fhirpathQuantity:
+		v.value = v.value.Neg()
+		return []fhirpathNode{{value: v}}, nil
+	}
+	return nil, fmt.Errorf("unary minus on %s", items[0].typeName())
+}
+
+// typeExpr is the is or as operator.
+type typeExpr struct {
+	op       string
+	operand  fhirpathExpr
+	typeName string
+}
+
+func (e *typeExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	items, err := e.operand.eval(env)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	if len(items) > 1 {
+		return nil, fmt.Errorf("%s %s on a collection of %d items", e.op, e.typeName, len(items))
+	}
+	if e.op == "is" {
+		return boolResult(items[0].is(e.typeName)), nil
+	}
+	if items[0].is(e.typeName) {
+		return items, nil
+	}
+	return nil, nil
+}
+
+// callExpr invokes a function on the result of its target, or on $this
+// when the call starts a path. The parser has checked the function exists
+// and takes that many arguments.
+type callExpr struct {
+	target fhirpathExpr
+	name   string
+	args   []fhirpathExpr
+}
+
+func (e *callExpr) eval(env *fhirpathEnv) ([]fhirpathNode, error) {
+	fn := fhirpathFunctions[e.name]
+	input := env.this
+	if e.target != nil {
+		var err error
+		if input, err = e.target.eval(env); err != nil {
+			return nil, err
+		}
+	}
+	// defineVariable adds to the scope of the path; the variables other
+	// functions' arguments define stay within those arguments
+	scope := env
+	if e.name != "defineVariable" {
+		scope = env.nested()
+	}
+	result, err := fn.eval(scope, input, e.args)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", e.name, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"reflect"
+
+	"github.com/gruzdev-dev/fhir/fhirpath/hook"
+)
+
+// init registers the FHIRPath engine with the fhirpath package under the
+// import path of this package.
+func init() {
+	hook.Register(reflect.TypeOf(fhirpathHookExpression{}).PkgPath(), func(source string) (hook.Expression, error) {
+		e, err := compileFHIRPathExpression(source)
+		if err != nil {
+			return nil, err
+		}
+		return fhirpathHookExpression{e}, nil
+	})
+}
+
+// fhirpathHookExpression is a compiled expression as the fhirpath package
+// evaluates it.
+type fhirpathHookExpression struct {
+	expr *fhirpathExpression
+}
+
+func (e fhirpathHookExpression) String() string {
+	return e.expr.String()
+}
+
+func (e fhirpathHookExpression) Evaluate(input any, ctx hook.Context) ([]any, error) {
+	return e.expr.evaluate(input, fhirpathContext{
+		Resource:     ctx.Resource,
+		RootResource: ctx.RootResource,
+		Variables:    ctx.Variables,
+		Resolver:     ctx.Resolver,
+		Now:          ctx.Now,
+	})
+}
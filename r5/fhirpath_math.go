@@ -0,0 +1,169 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// mathFunctions returns the math functions of FHIRPath. Functions that
+// are exact on decimals, such as round, keep the precision of Decimal;
+// the transcendental ones go through float64.
+func mathFunctions() map[string]fhirpathFunction {
+	return map[string]fhirpathFunction{
+		"abs": {0, 0, numberFunction(func(n fhirpathNode) (any, bool) {
+			switch v := n.value.(type) {
+			case int64:
+				if v < 0 {
+					return -v, true
+				}
+				return v, true
+			case Decimal:
+				return v.Abs(), true
+			case fhirpathQuantity:
+				v.value = v.value.Abs()
+				return v, true
+			}
+			return nil, false
+		})},
+		"ceiling": {0, 0, numberFunction(func(n fhirpathNode) (any, bool) {
+			return roundDecimal(n, func(r *big.Rat) *big.Int { return ratCeiling(r) })
+		})},
+		"floor": {0, 0, numberFunction(func(n fhirpathNode) (any, bool) {
+			return roundDecimal(n, func(r *big.Rat) *big.Int {
+				return new(big.Int).Neg(ratCeiling(new(big.Rat).Neg(r)))
+			})
+		})},
+		"truncate": {0, 0, numberFunction(func(n fhirpathNode) (any, bool) {
+			return roundDecimal(n, func(r *big.Rat) *big.Int { return new(big.Int).Quo(r.Num(), r.Denom()) })
+		})},
+		"round": {0, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			d, ok, err := singletonNumber(input)
+			if err != nil || !ok {
+				return nil, err
+			}
+			var precision int64
+			if len(args) == 1 {
+				if precision, err = integerArgument(env, args[0]); err != nil {
+					return nil, err
+				}
+				if precision < 0 {
+					return nil, fmt.Errorf("round precision must not be negative, got %d", precision)
+				}
+			}
+			scale := new(big.Rat).SetInt(pow10(int(precision)))
+			r := new(big.Rat).Mul(d.Rat(), scale)
+			half := big.NewRat(1, 2)
+			if r.Sign() < 0 {
+				half.Neg(half)
+			}
+			r.Add(r, half)
+			return []fhirpathNode{{value: formatDecimal(new(big.Int).Quo(r.Num(), r.Denom()), int(precision))}}, nil
+		}},
+		"sqrt": {0, 0, floatFunction(func(x float64) float64 { return math.Sqrt(x) })},
+		"exp":  {0, 0, floatFunction(math.Exp)},
+		"ln":   {0, 0, floatFunction(math.Log)},
+		"log": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			base, ok, err := numberArgument(env, args[0])
+			if err != nil || !ok {
+				return nil, err
+			}
+			return floatFunction(func(x float64) float64 { return math.Log(x) / math.Log(base.Float64()) })(env, input, nil)
+		}},
+		"power": {1, 1, func(env *fhirpathEnv, input []fhirpathNode, args []fhirpathExpr) ([]fhirpathNode, error) {
+			exponent, ok, err := numberArgument(env, args[0])
+			if err != nil || !ok || len(input) == 0 {
+				return nil, err
+			}
+			if i, isInt := input[0].value.(int64); isInt && len(input) == 1 {
+				if e, isInt := exponentInteger(exponent); isInt && e >= 0 {
+					return []fhirpathNode{{value: new(big.Int).Exp(big.NewInt(i), big.NewInt(e), nil).Int64()}}, nil
+				}
+			}
+			return floatFunction(func(x float64) float64 { return math.Pow(x, exponent.Float64()) })(env, input, nil)
+		}},
+	}
+}
+
+// singletonNumber returns the single integer or decimal of a collection.
+func singletonNumber(items []fhirpathNode) (Decimal, bool, error) {
+	switch len(items) {
+	case 0:
+		return Decimal{}, false, nil
+	case 1:
+		if d, ok := decimalOf(items[0].value); ok {
+			return d, true, nil
+		}
+		return Decimal{}, false, fmt.Errorf("expected a number, got %s", items[0].typeName())
+	}
+	return Decimal{}, false, fmt.Errorf("expected a single number, got %d items", len(items))
+}
+
+func numberArgument(env *fhirpathEnv, arg fhirpathExpr) (Decimal, bool, error) {
+	items, err := arg.eval(env)
+	if err != nil {
+		return Decimal{}, false, err
+	}
+	return singletonNumber(items)
+}
+
+func exponentInteger(d Decimal) (int64, bool) {
+	r := d.Rat()
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, false
+	}
+	return r.Num().Int64(), true
+}
+
+// numberFunction applies fn to the single item of the input.
+func numberFunction(fn func(n fhirpathNode) (any, bool)) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+		switch len(input) {
+		case 0:
+			return nil, nil
+		case 1:
+		default:
+			return nil, fmt.Errorf("expected a single number, got %d items", len(input))
+		}
+		v, ok := fn(input[0])
+		if !ok {
+			return nil, fmt.Errorf("expected a number, got %s", input[0].typeName())
+		}
+		return []fhirpathNode{{value: v}}, nil
+	}
+}
+
+// roundDecimal rounds a number to an integer with round.
+func roundDecimal(n fhirpathNode, round func(*big.Rat) *big.Int) (any, bool) {
+	switch v := n.value.(type) {
+	case int64:
+		return v, true
+	case Decimal:
+		return round(v.Rat()).Int64(), true
+	}
+	return nil, false
+}
+
+func ratCeiling(r *big.Rat) *big.Int {
+	q, m := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// floatFunction applies a float64 function to a number, yielding an empty
+// result where it is undefined, as for the square root of -1.
+func floatFunction(fn func(float64) float64) func(*fhirpathEnv, []fhirpathNode, []fhirpathExpr) ([]fhirpathNode, error) {
+	return func(_ *fhirpathEnv, input []fhirpathNode, _ []fhirpathExpr) ([]fhirpathNode, error) {
+		d, ok, err := singletonNumber(input)
+		if err != nil || !ok {
+			return nil, err
+		}
+		x := fn(d.Float64())
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, nil
+		}
+		return []fhirpathNode{{value: NewDecimalFromFloat(x)}}, nil
+	}
+}
@@ -0,0 +1,460 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fhirpathNode is an item of a FHIRPath collection: either a struct of the
+// generated models, navigated by reflection, or a primitive value held as
+// string, bool, int64, Decimal, Date, DateTime, Instant, Time, time.Time
+// (a boundary computed by lowBoundary or highBoundary) or
+// fhirpathQuantity.
+type fhirpathNode struct {
+	value    any
+	object   reflect.Value
+	fhirType string        // declared type of a choice variant, otherwise empty
+	element  reflect.Value // Element with the id and extensions of a primitive
+}
+
+// fhirpathQuantity is a quantity literal such as 4 'mg', or the value of a
+// Quantity element taking part in a comparison.
+type fhirpathQuantity struct {
+	value Decimal
+	unit  string
+}
+
+// fhirpathEnv is the evaluation environment of an expression.
+type fhirpathEnv struct {
+	resource     []fhirpathNode
+	rootResource []fhirpathNode
+	context      []fhirpathNode
+	this         []fhirpathNode
+	index        int
+	total        []fhirpathNode // $total of aggregate
+	variables    map[string][]fhirpathNode
+	defined      *fhirpathScope // variables of defineVariable
+	resolver     func(reference string) (any, error)
+	now          time.Time
+}
+
+// fhirpathScope holds the variables defineVariable adds to a path. They are
+// visible in the rest of the path and the arguments of its later functions,
+// but not outside the argument or operand the path is part of.
+type fhirpathScope struct {
+	parent *fhirpathScope
+	values map[string][]fhirpathNode
+}
+
+func (s *fhirpathScope) lookup(name string) ([]fhirpathNode, bool) {
+	for ; s != nil; s = s.parent {
+		if value, ok := s.values[name]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// nested returns a copy of env whose definitions do not outlive the
+// argument or operand it is used for.
+func (env *fhirpathEnv) nested() *fhirpathEnv {
+	c := *env
+	c.defined = &fhirpathScope{parent: env.defined}
+	return &c
+}
+
+// define adds the variable %name for the rest of the path. Variables cannot
+// be redefined, nor shadow those of the environment.
+func (env *fhirpathEnv) define(name string, value []fhirpathNode) error {
+	if _, exists := env.variable(name); exists {
+		return fmt.Errorf("variable %%%s is already defined", name)
+	}
+	if env.defined == nil {
+		env.defined = &fhirpathScope{}
+	}
+	if env.defined.values == nil {
+		env.defined.values = make(map[string][]fhirpathNode)
+	}
+	env.defined.values[name] = value
+	return nil
+}
+
+// iterate returns a copy of env for evaluating a function argument against
+// item number index of the input.
+func (env *fhirpathEnv) iterate(item fhirpathNode, index int) *fhirpathEnv {
+	c := env.nested()
+	c.this = []fhirpathNode{item}
+	c.index = index
+	return c
+}
+
+// variable returns the value of the environment variable %name. %resource
+// and %rootResource are undefined when a data type is evaluated on its own.
+func (env *fhirpathEnv) variable(name string) ([]fhirpathNode, bool) {
+	str := func(s string) []fhirpathNode {
+		return []fhirpathNode{{value: s}}
+	}
+	switch name {
+	case "context":
+		return env.context, true
+	case "resource":
+		return env.resource, env.resource != nil
+	case "rootResource":
+		return env.rootResource, env.rootResource != nil
+	case "ucum":
+		return str("http://unitsofmeasure.org"), true
+	case "sct":
+		return str("http://snomed.info/sct"), true
+	case "loinc":
+		return str("http://loinc.org"), true
+	}
+	if id, ok := strings.CutPrefix(name, "vs-"); ok {
+		return str("http://hl7.org/fhir/ValueSet/" + id), true
+	}
+	if id, ok := strings.CutPrefix(name, "ext-"); ok {
+		return str("http://hl7.org/fhir/StructureDefinition/" + id), true
+	}
+	if value, ok := env.defined.lookup(name); ok {
+		return value, true
+	}
+	value, ok := env.variables[name]
+	return value, ok
+}
+
+// clock returns the time today() and now() are evaluated at.
+func (env *fhirpathEnv) clock() time.Time {
+	if env.now.IsZero() {
+		return time.Now()
+	}
+	return env.now
+}
+
+// fhirpathField is an element of a generated struct. Primitive elements
+// have the index of the Element field holding their id and extensions, the
+// "_" companion in JSON, or -1.
+type fhirpathField struct {
+	name    string
+	index   int
+	element int
+}
+
+var fhirpathFieldCache sync.Map
+
+// fhirpathFields returns the elements of a generated struct type by their
+// FHIR names. Choice elements, which have no JSON name of their own, are
+// named after the Go field; "_" companions and resourceType are skipped as
+// they are not elements of their own.
+func fhirpathFields(t reflect.Type) []fhirpathField {
+	if cached, ok := fhirpathFieldCache.Load(t); ok {
+		return cached.([]fhirpathField)
+	}
+	var fields []fhirpathField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		switch {
+		case name == "-" && sf.Type.Kind() == reflect.Interface:
+			name = strings.TrimSuffix(sf.Name, "Choice")
+			name = strings.ToLower(name[:1]) + name[1:]
+		case name == "" || name == "-" || name == "resourceType" || strings.HasPrefix(name, "_"):
+			continue
+		}
+		field := fhirpathField{name: name, index: i, element: -1}
+		if companion, ok := t.FieldByName(sf.Name + "Element"); ok && len(companion.Index) == 1 {
+			if tag, _, _ := strings.Cut(companion.Tag.Get("json"), ","); tag == "-" || tag == "_"+name {
+				field.element = companion.Index[0]
+			}
+		}
+		fields = append(fields, field)
+	}
+	fhirpathFieldCache.Store(t, fields)
+	return fields
+}
+
+// derefValue follows pointers and interfaces to the value they hold.
+// Choice variants are unwrapped to the value of their declared type, which
+// is returned as fhirType. Structs are made addressable so that their
+// methods with pointer receivers can be called.
+func derefValue(v reflect.Value) (reflect.Value, string) {
+	fhirType := ""
+	for v.IsValid() {
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}, ""
+			}
+			if c, ok := v.Interface().(choiceValue); ok && v.Kind() == reflect.Interface {
+				fhirType = c.FHIRType()
+				v = v.Elem()
+				if v.Kind() == reflect.Struct && v.NumField() == 1 && v.Type().Field(0).Anonymous {
+					variant := reflect.New(v.Type()).Elem()
+					variant.Set(v)
+					v = variant.Field(0)
+				}
+				continue
+			}
+			v = v.Elem()
+		case reflect.Struct:
+			if !v.CanAddr() {
+				c := reflect.New(v.Type()).Elem()
+				c.Set(v)
+				v = c
+			}
+			return v, fhirType
+		default:
+			return v, fhirType
+		}
+	}
+	return v, fhirType
+}
+
+// fhirpathValues converts the value of a struct field into collection
+// items, dropping absent values.
+func fhirpathValues(v reflect.Value) []fhirpathNode {
+	if v.Kind() == reflect.Slice {
+		var nodes []fhirpathNode
+		for i := 0; i < v.Len(); i++ {
+			if n, ok := fhirpathValue(v.Index(i)); ok {
+				nodes = append(nodes, n)
+			}
+		}
+		return nodes
+	}
+	if n, ok := fhirpathValue(v); ok {
+		return []fhirpathNode{n}
+	}
+	return nil
+}
+
+func fhirpathValue(v reflect.Value) (fhirpathNode, bool) {
+	v, fhirType := derefValue(v)
+	n := fhirpathNode{fhirType: fhirType}
+	switch v.Kind() {
+	case reflect.String:
+		if v.Len() == 0 {
+			return n, false
+		}
+		n.value = v.String()
+	case reflect.Bool:
+		n.value = v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n.value = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n.value = int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		n.value = NewDecimalFromFloat(v.Float())
+	case reflect.Struct:
+		switch x := v.Interface().(type) {
+		case Decimal:
+			if x.literal == "" {
+				return n, false
+			}
+			n.value = x
+		case Date, DateTime, Instant, Time:
+			if v.FieldByName("literal").Len() == 0 {
+				return n, false
+			}
+			n.value = x
+		default:
+			n.object = v
+		}
+	default:
+		return n, false
+	}
+	return n, true
+}
+
+// objectNode wraps a pointer to a generated struct, such as a resource.
+func objectNode(v any) []fhirpathNode {
+	if v == nil {
+		return nil
+	}
+	return fhirpathValues(reflect.ValueOf(v))
+}
+
+// fieldValues returns the items of field f of the struct obj. Items of a
+// primitive element carry their Element, and an item is kept when it has
+// an Element but no value, as for a name given only as an extension.
+func fieldValues(obj reflect.Value, f fhirpathField) []fhirpathNode {
+	v := obj.Field(f.index)
+	if f.element < 0 {
+		return fhirpathValues(v)
+	}
+	e := obj.Field(f.element)
+	if v.Kind() != reflect.Slice {
+		return primitiveItem(v, e)
+	}
+	var nodes []fhirpathNode
+	for i := 0; i < max(v.Len(), e.Len()); i++ {
+		var item, element reflect.Value
+		if i < v.Len() {
+			item = v.Index(i)
+		}
+		if e.Kind() == reflect.Slice && i < e.Len() {
+			element = e.Index(i)
+		}
+		nodes = append(nodes, primitiveItem(item, element)...)
+	}
+	return nodes
+}
+
+func primitiveItem(v, element reflect.Value) []fhirpathNode {
+	n, ok := fhirpathNode{}, false
+	if v.IsValid() {
+		n, ok = fhirpathValue(v)
+	}
+	if element.IsValid() {
+		if e, _ := derefValue(element); e.IsValid() && e.Kind() == reflect.Struct {
+			n.element, ok = e, true
+		}
+	}
+	if !ok {
+		return nil
+	}
+	return []fhirpathNode{n}
+}
+
+// structure returns the struct holding the children of n: the object
+// itself, or the Element of a primitive.
+func (n fhirpathNode) structure() reflect.Value {
+	if n.object.IsValid() {
+		return n.object
+	}
+	return n.element
+}
+
+// child returns the children of n called name.
+func (n fhirpathNode) child(name string) []fhirpathNode {
+	obj := n.structure()
+	if !obj.IsValid() {
+		return nil
+	}
+	for _, f := range fhirpathFields(obj.Type()) {
+		if f.name == name {
+			return fieldValues(obj, f)
+		}
+	}
+	return nil
+}
+
+// children returns every child of n in declaration order.
+func (n fhirpathNode) children() []fhirpathNode {
+	obj := n.structure()
+	if !obj.IsValid() {
+		return nil
+	}
+	var result []fhirpathNode
+	for _, f := range fhirpathFields(obj.Type()) {
+		result = append(result, fieldValues(obj, f)...)
+	}
+	return result
+}
+
+// hasValue reports whether n is a primitive with a value.
+func (n fhirpathNode) hasValue() bool {
+	return !n.object.IsValid() && n.value != nil
+}
+
+// isResource reports whether n is a resource rather than a data type or
+// backbone element.
+func (n fhirpathNode) isResource() bool {
+	if !n.object.IsValid() {
+		return false
+	}
+	_, ok := n.object.Type().FieldByName("ResourceType")
+	return ok
+}
+
+// typeName returns the FHIR type of n, using the Go type name of structs.
+func (n fhirpathNode) typeName() string {
+	if n.fhirType != "" {
+		return n.fhirType
+	}
+	if n.isResource() {
+		// A resource built in code may not have its ResourceType set yet.
+		if resourceType := n.object.FieldByName("ResourceType").String(); resourceType != "" {
+			return resourceType
+		}
+	}
+	if n.object.IsValid() {
+		return n.object.Type().Name()
+	}
+	switch n.value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int64:
+		return "integer"
+	case Decimal:
+		return "decimal"
+	case Date:
+		return "date"
+	case DateTime, time.Time:
+		return "dateTime"
+	case Instant:
+		return "instant"
+	case Time:
+		return "time"
+	case fhirpathQuantity:
+		return "Quantity"
+	}
+	return ""
+}
+
+// is reports whether n is of the type name, which may be qualified with
+// FHIR or System. Strings of undeclared type match every string-based
+// primitive, since the models do not record which one an element uses.
+func (n fhirpathNode) is(name string) bool {
+	name = strings.TrimPrefix(strings.TrimPrefix(name, "FHIR."), "System.")
+	typeName := n.typeName()
+	if typeName == name {
+		return true
+	}
+	if n.object.IsValid() && n.fhirType == "" {
+		switch name {
+		case "Resource":
+			return n.isResource()
+		case "DomainResource":
+			_, hasText := n.object.Type().FieldByName("Text")
+			return n.isResource() && hasText
+		case "Element":
+			return !n.isResource()
+		}
+		return false
+	}
+	if _, ok := n.value.(string); ok && n.fhirType == "" {
+		return systemType(name) == "String"
+	}
+	return systemType(typeName) == name
+}
+
+// systemType maps a FHIR primitive type to the FHIRPath system type it is
+// evaluated as.
+func systemType(fhirType string) string {
+	switch fhirType {
+	case "string", "code", "id", "uri", "url", "canonical", "oid", "uuid", "markdown", "base64Binary", "xhtml", "String":
+		return "String"
+	case "boolean", "Boolean":
+		return "Boolean"
+	case "integer", "unsignedInt", "positiveInt", "integer64", "Integer":
+		return "Integer"
+	case "decimal", "Decimal":
+		return "Decimal"
+	case "date", "Date":
+		return "Date"
+	case "dateTime", "instant", "DateTime":
+		return "DateTime"
+	case "time", "Time":
+		return "Time"
+	case "Quantity":
+		return "Quantity"
+	}
+	return ""
+}
@@ -0,0 +1,375 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// calendarUnits maps the calendar duration keywords of FHIRPath, such as
+// the days of 3 days, to the unit of the quantity they denote.
+var calendarUnits = map[string]string{
+	"year": "year", "years": "year",
+	"month": "month", "months": "month",
+	"week": "week", "weeks": "week",
+	"day": "day", "days": "day",
+	"hour": "hour", "hours": "hour",
+	"minute": "minute", "minutes": "minute",
+	"second": "second", "seconds": "second",
+	"millisecond": "millisecond", "milliseconds": "millisecond",
+}
+
+// ucumDurations maps the UCUM units of definite durations to the calendar
+// unit they are equal to. Years and months have no definite length, so
+// 'a' and 'mo' are not among them.
+var ucumDurations = map[string]string{
+	"wk": "week", "d": "day", "h": "hour", "min": "minute", "s": "second", "ms": "millisecond",
+}
+
+var durationLengths = map[string]time.Duration{
+	"week":        7 * 24 * time.Hour,
+	"day":         24 * time.Hour,
+	"hour":        time.Hour,
+	"minute":      time.Minute,
+	"second":      time.Second,
+	"millisecond": time.Millisecond,
+}
+
+// durationUnit returns the calendar unit of a time-valued quantity unit.
+func durationUnit(unit string) (string, bool) {
+	if u, ok := ucumDurations[unit]; ok {
+		return u, true
+	}
+	u, ok := calendarUnits[unit]
+	return u, ok
+}
+
+// metricAtoms are the UCUM units that take metric prefixes, such as the
+// g of mg.
+var metricAtoms = map[string]bool{
+	"g": true, "m": true, "L": true, "l": true, "mol": true, "eq": true, "Pa": true, "U": true, "[iU]": true,
+}
+
+// metricPrefixes maps the UCUM metric prefixes to their power of ten.
+var metricPrefixes = map[string]int{
+	"k": 3, "h": 2, "da": 1, "d": -1, "c": -2, "m": -3, "u": -6, "n": -9, "p": -12,
+}
+
+// ucumUnit is a UCUM unit as a product of atoms raised to powers, such as
+// g.m-1 for g/m, and the power of ten of their metric prefixes, such as -3
+// for mg. Atoms that take no prefix, such as [lb_av] and h, are kept whole.
+type ucumUnit struct {
+	atoms map[string]int
+	exp   int
+}
+
+// parseUnit reads a UCUM unit of terms joined by . and /, each an atom
+// with an optional exponent, such as mg/dL or kg.m2/s2. The unit 1 has no
+// atoms. Units with parentheses are not supported.
+func parseUnit(unit string) (ucumUnit, bool) {
+	u := ucumUnit{atoms: make(map[string]int)}
+	if unit == "" || strings.ContainsAny(unit, "()") {
+		return u, false
+	}
+	sign := 1
+	for i, rest := 0, unit; rest != ""; i++ {
+		end := strings.IndexAny(rest, "./")
+		if end < 0 {
+			end = len(rest)
+		}
+		term := rest[:end]
+		if term == "" && i > 0 {
+			return u, false
+		}
+		if term != "" && term != "1" {
+			atom, power := splitExponent(term)
+			if base, exp, ok := metricAtom(atom); ok {
+				atom = base
+				u.exp += sign * power * exp
+			}
+			u.atoms[atom] += sign * power
+		}
+		if end == len(rest) {
+			break
+		}
+		sign = 1
+		if rest[end] == '/' {
+			sign = -1
+		}
+		rest = rest[end+1:]
+	}
+	return u, true
+}
+
+// metricAtom splits a metric unit such as mg into the unit without its
+// prefix and the power of ten of the prefix.
+func metricAtom(unit string) (string, int, bool) {
+	if metricAtoms[unit] {
+		return unit, 0, true
+	}
+	for prefix, exp := range metricPrefixes {
+		if atom, ok := strings.CutPrefix(unit, prefix); ok && metricAtoms[atom] {
+			return atom, exp, true
+		}
+	}
+	return "", 0, false
+}
+
+// splitExponent splits a term such as m2 or s-1 into its atom and exponent.
+func splitExponent(term string) (string, int) {
+	i := len(term)
+	for i > 0 && term[i-1] >= '0' && term[i-1] <= '9' {
+		i--
+	}
+	if i > 0 && i < len(term) && term[i-1] == '-' {
+		i--
+	}
+	if i == 0 || i == len(term) {
+		return term, 1
+	}
+	power, err := strconv.Atoi(term[i:])
+	if err != nil || power == 0 {
+		return term, 1
+	}
+	return term[:i], power
+}
+
+// times returns the product of u and other raised to sign, which is -1 for
+// a quotient.
+func (u ucumUnit) times(other ucumUnit, sign int) ucumUnit {
+	result := ucumUnit{atoms: make(map[string]int), exp: u.exp + sign*other.exp}
+	for atom, power := range u.atoms {
+		result.atoms[atom] += power
+	}
+	for atom, power := range other.atoms {
+		result.atoms[atom] += sign * power
+	}
+	for atom, power := range result.atoms {
+		if power == 0 {
+			delete(result.atoms, atom)
+		}
+	}
+	return result
+}
+
+// sameAtoms reports whether u and other differ only in their prefixes.
+func (u ucumUnit) sameAtoms(other ucumUnit) bool {
+	if len(u.atoms) != len(other.atoms) {
+		return false
+	}
+	for atom, power := range u.atoms {
+		if other.atoms[atom] != power {
+			return false
+		}
+	}
+	return true
+}
+
+// String writes the atoms of u without prefixes, in alphabetical order with
+// the positive powers first: g/m, m2 or 1 for a dimensionless unit.
+func (u ucumUnit) String() string {
+	atoms := make([]string, 0, len(u.atoms))
+	for atom := range u.atoms {
+		atoms = append(atoms, atom)
+	}
+	sort.Strings(atoms)
+	var num, den []string
+	for _, atom := range atoms {
+		power := u.atoms[atom]
+		term := atom
+		if power > 1 || power < -1 {
+			term += strconv.Itoa(max(power, -power))
+		}
+		if power > 0 {
+			num = append(num, term)
+		} else {
+			den = append(den, term)
+		}
+	}
+	s := strings.Join(num, ".")
+	if s == "" {
+		s = "1"
+	}
+	for _, term := range den {
+		s += "/" + term
+	}
+	return s
+}
+
+// powerOfTen returns 10^exp as a decimal.
+func powerOfTen(exp int) Decimal {
+	if exp >= 0 {
+		return MustParseDecimal("1" + strings.Repeat("0", exp))
+	}
+	return MustParseDecimal("0." + strings.Repeat("0", -exp-1) + "1")
+}
+
+// commonUnit converts two quantities to the same unit so that they can be
+// compared. Identical units, definite durations such as 1 week and 7 'd',
+// and units differing in metric prefixes such as 4 'g' and 4000 'mg' or
+// 1 'mg/dL' and 10 'mg/L' are convertible.
+func commonUnit(a, b fhirpathQuantity) (fhirpathQuantity, fhirpathQuantity, bool) {
+	if a.unit == b.unit {
+		return a, b, true
+	}
+	ucumA, okA := parseUnit(a.unit)
+	ucumB, okB := parseUnit(b.unit)
+	if okA && okB && ucumA.sameAtoms(ucumB) {
+		a.value = a.value.Mul(powerOfTen(ucumA.exp))
+		b.value = b.value.Mul(powerOfTen(ucumB.exp))
+		a.unit, b.unit = ucumA.String(), ucumB.String()
+		return a, b, true
+	}
+	ua, okA := durationUnit(a.unit)
+	ub, okB := durationUnit(b.unit)
+	if !okA || !okB {
+		return a, b, false
+	}
+	if ua == ub {
+		return a, b, true
+	}
+	la, okA := durationLengths[ua]
+	lb, okB := durationLengths[ub]
+	if !okA || !okB {
+		return a, b, false
+	}
+	a.value = a.value.Mul(NewDecimalFromInt(int64(la / time.Millisecond)))
+	b.value = b.value.Mul(NewDecimalFromInt(int64(lb / time.Millisecond)))
+	a.unit, b.unit = "ms", "ms"
+	return a, b, true
+}
+
+// multiplyQuantities implements * and / on two quantities, whose units
+// multiply or divide: 2.0 'cm' * 2.0 'm' is 0.0400 'm2'.
+func multiplyQuantities(op string, a, b fhirpathQuantity) ([]fhirpathNode, error) {
+	ua, okA := parseUnit(a.unit)
+	ub, okB := parseUnit(b.unit)
+	if !okA || !okB {
+		return nil, fmt.Errorf("cannot %s units '%s' and '%s'", map[string]string{"*": "multiply", "/": "divide"}[op], a.unit, b.unit)
+	}
+	sign := 1
+	if op == "/" {
+		sign = -1
+	}
+	result, err := decimalArithmetic(op, a.value, b.value)
+	if len(result) == 0 || err != nil {
+		return nil, err
+	}
+	unit := ua.times(ub, sign)
+	value := result[0].value.(Decimal).Mul(powerOfTen(unit.exp))
+	unit.exp = 0
+	return []fhirpathNode{{value: fhirpathQuantity{value: value, unit: unit.String()}}}, nil
+}
+
+// addDuration implements the addition of a time-valued quantity to a date,
+// dateTime, instant or time. The result keeps the precision of the value:
+// @2019-03-01 + 25 hours is @2019-03-02. Years, months, weeks and days are
+// added as whole calendar units, ignoring any decimal part.
+func addDuration(v any, q fhirpathQuantity, subtract bool) (any, error) {
+	unit, ok := durationUnit(q.unit)
+	if !ok {
+		return nil, fmt.Errorf("cannot add %s '%s' to a date or time: not a calendar duration", q.value, q.unit)
+	}
+	amount := q.value
+	if subtract {
+		amount = amount.Neg()
+	}
+
+	var (
+		t   temporal
+		err error
+	)
+	switch x := v.(type) {
+	case Date:
+		t, err = x.parse()
+	case DateTime:
+		t, err = x.parse()
+	case Instant:
+		t, err = x.parse()
+	case Time:
+		t, err = x.parse()
+		if unit == "year" || unit == "month" || unit == "week" || unit == "day" {
+			return nil, fmt.Errorf("cannot add %s to a time", unit)
+		}
+	default:
+		return nil, fmt.Errorf("cannot add a quantity to %T", v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := t.start()
+	switch unit {
+	case "year", "month", "week", "day":
+		n := int(truncateDecimal(amount).Int64())
+		switch unit {
+		case "year":
+			result = addMonths(result, 12*n)
+		case "month":
+			result = addMonths(result, n)
+		case "week":
+			result = result.AddDate(0, 0, 7*n)
+		default:
+			result = result.AddDate(0, 0, n)
+		}
+	default:
+		nanos := new(big.Rat).Mul(amount.Rat(), new(big.Rat).SetInt64(int64(durationLengths[unit])))
+		result = result.Add(time.Duration(new(big.Int).Quo(nanos.Num(), nanos.Denom()).Int64()))
+	}
+
+	switch v.(type) {
+	case Date:
+		return NewDate(result, t.precision), nil
+	case Time:
+		return Time{literal: formatClock(result, t.precision, t.fractionDigits)}, nil
+	case Instant:
+		return NewInstant(result), nil
+	}
+	if t.precision <= PrecisionDay {
+		return NewDateTime(result, t.precision), nil
+	}
+	literal := result.Format("2006-01-02T") + formatClock(result, t.precision, t.fractionDigits)
+	if t.hasOffset() {
+		literal += result.Format("Z07:00")
+	}
+	return DateTime{literal: literal}, nil
+}
+
+// addMonths adds months to t, keeping the day within the resulting month
+// as FHIRPath requires: @2019-01-31 + 1 month is @2019-02-28.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	first = first.AddDate(0, months, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
+
+// formatClock formats the time of day of t down to precision, with the
+// given number of fraction digits at PrecisionSubsecond.
+func formatClock(t time.Time, precision TemporalPrecision, digits int) string {
+	switch precision {
+	case PrecisionHour:
+		return t.Format("15")
+	case PrecisionMinute:
+		return t.Format("15:04")
+	}
+	return t.Format("15:04:05") + fixedFraction(t, digits)
+}
+
+// fixedFraction returns the fractional seconds of t with the given number
+// of digits, so that arithmetic keeps the precision of its operand.
+func fixedFraction(t time.Time, digits int) string {
+	if digits == 0 {
+		return ""
+	}
+	return fmt.Sprintf(".%09d", t.Nanosecond())[:digits+1]
+}
+
+// truncateDecimal returns the integer part of d.
+func truncateDecimal(d Decimal) *big.Int {
+	r := d.Rat()
+	return new(big.Int).Quo(r.Num(), r.Denom())
+}
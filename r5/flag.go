@@ -2,29 +2,41 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Prospective warnings of potential issues when providing care to the patient.
 type Flag struct {
-	ResourceType   string            `json:"resourceType" bson:"resource_type"`                         // Type of resource
-	Id             *string           `json:"id,omitempty" bson:"id,omitempty"`                          // Logical id of this artifact
-	Meta           *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                      // Metadata about the resource
-	ImplicitRules  *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`   // A set of rules under which this content was created
-	Language       *string           `json:"language,omitempty" bson:"language,omitempty"`              // Language of the resource content
-	Text           *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                      // Text summary of the resource, for human interpretation
-	Contained      []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`            // Contained, inline Resources
-	Identifier     []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`          // Business identifier
-	Status         *string           `json:"status,omitempty" bson:"status,omitempty"`                  // active | inactive | entered-in-error
-	Category       []CodeableConcept `json:"category,omitempty" bson:"category,omitempty"`              // Clinical, administrative, etc
-	Code           *CodeableConcept  `json:"code" bson:"code"`                                          // Coded or textual message to display to user
-	Subject        *Reference        `json:"subject" bson:"subject"`                                    // Who/What is flag about?
-	Period         *Period           `json:"period,omitempty" bson:"period,omitempty"`                  // Time period when flag is active
-	Encounter      *Reference        `json:"encounter,omitempty" bson:"encounter,omitempty"`            // Alert relevant during encounter
-	Author         *Reference        `json:"author,omitempty" bson:"author,omitempty"`                  // Flag creator
-	SupportingInfo []Reference       `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"` // Extra information to use in context of the flag
+	ResourceType         string            `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string           `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string           `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element          `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource        `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier
+	Status               *FlagStatus       `json:"status,omitempty" bson:"status,omitempty"`                         // active | inactive | entered-in-error
+	StatusElement        *Element          `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Category             []CodeableConcept `json:"category,omitempty" bson:"category,omitempty"`                     // Clinical, administrative, etc
+	Code                 *CodeableConcept  `json:"code" bson:"code"`                                                 // Coded or textual message to display to user
+	Subject              *Reference        `json:"subject" bson:"subject"`                                           // Who/What is flag about?
+	Period               *Period           `json:"period,omitempty" bson:"period,omitempty"`                         // Time period when flag is active
+	Encounter            *Reference        `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Alert relevant during encounter
+	Author               *Reference        `json:"author,omitempty" bson:"author,omitempty"`                         // Flag creator
+	SupportingInfo       []Reference       `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`        // Extra information to use in context of the flag
 }
 
+var flagSubjectTargets = []string{"Group", "Location", "Medication", "Organization", "Patient", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "RelatedPerson"}
+
+var flagEncounterTargets = []string{"Encounter"}
+
+var flagAuthorTargets = []string{"Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Flag) Validate() error {
 	if r.ResourceType != "Flag" {
 		return fmt.Errorf("invalid resourceType: expected 'Flag', got '%s'", r.ResourceType)
@@ -34,16 +46,52 @@ func (r *Flag) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -60,6 +108,11 @@ func (r *Flag) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, flagSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, flagSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -70,11 +123,21 @@ func (r *Flag) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, flagEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, flagEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, flagAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author' cannot refer to %s, expected one of %v", target, flagAuthorTargets)
+		}
+	}
 	if r.Author != nil {
 		if err := r.Author.Validate(); err != nil {
 			return fmt.Errorf("Author: %w", err)
@@ -85,5 +148,216 @@ func (r *Flag) Validate() error {
 			return fmt.Errorf("SupportingInfo[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Flag")
+}
+
+func (r *Flag) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Flag" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Flag', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, flagSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, flagSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, flagEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, flagEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, flagAuthorTargets); target != "" {
+			issues.add("value", path+".author", fmt.Sprintf("field 'Author' cannot refer to %s, expected one of %v", target, flagAuthorTargets))
+		}
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+}
+
+func (r *Flag) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Flag", &issues)
+	checkInvariants(r, "Flag", &issues)
+	return issues
+}
+
+var flagInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Flag) invariants() []invariant {
+	return flagInvariants
+}
+
+func (r *Flag) UnmarshalJSON(data []byte) error {
+	type alias Flag
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r Flag) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Flag) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Flag) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Flag) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var flagElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Flag) elementTypes() map[string]string {
+	return flagElementTypes
+}
+
+var flagElementMetadata = []ElementMetadata{
+	{Path: "Flag.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Flag.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Flag.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Flag.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Flag.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Flag.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Flag.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Flag.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Flag.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Flag.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Flag.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Flag.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/flag-status"}},
+	{Path: "Flag.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Flag.code", Name: "code", Field: 15, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Flag.subject", Name: "subject", Field: 16, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Flag.period", Name: "period", Field: 17, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Flag.encounter", Name: "encounter", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Flag.author", Name: "author", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Flag.supportingInfo", Name: "supportingInfo", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *Flag) ElementMetadata() []ElementMetadata {
+	return flagElementMetadata
+}
+
+func (r *Flag) GetResourceType() string {
+	return "Flag"
+}
+
+func (r *Flag) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Flag) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Flag) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Flag) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Flag) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Flag) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Flag) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Flag)(nil)
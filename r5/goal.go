@@ -2,38 +2,52 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Describes the intended objective(s) for a patient, group, or organizational care.  Examples include a patient's weight loss, restoration of an activity of daily living for a patient, obtaining herd immunity via immunization for a group, meeting a process improvement objective for an organization, etc.
 type Goal struct {
-	ResourceType         string            `json:"resourceType" bson:"resource_type"`                                      // Type of resource
-	Id                   *string           `json:"id,omitempty" bson:"id,omitempty"`                                       // Logical id of this artifact
-	Meta                 *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                                   // Metadata about the resource
-	ImplicitRules        *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                // A set of rules under which this content was created
-	Language             *string           `json:"language,omitempty" bson:"language,omitempty"`                           // Language of the resource content
-	Text                 *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                                   // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`                         // Contained, inline Resources
-	Identifier           []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // External Ids for this goal
-	LifecycleStatus      string            `json:"lifecycleStatus" bson:"lifecycle_status"`                                // proposed | planned | accepted | active | on-hold | completed | cancelled | entered-in-error | rejected
-	AchievementStatus    *CodeableConcept  `json:"achievementStatus,omitempty" bson:"achievement_status,omitempty"`        // in-progress | improving | worsening | no-change | achieved | sustaining | not-achieved | no-progress | not-attainable
-	Category             []CodeableConcept `json:"category,omitempty" bson:"category,omitempty"`                           // E.g. Treatment, dietary, behavioral, etc
-	Continuous           *bool             `json:"continuous,omitempty" bson:"continuous,omitempty"`                       // After meeting the goal, ongoing activity is needed to sustain the goal objective
-	Priority             *CodeableConcept  `json:"priority,omitempty" bson:"priority,omitempty"`                           // high-priority | medium-priority | low-priority
-	Description          *CodeableConcept  `json:"description" bson:"description"`                                         // Code or text describing goal
-	Subject              *Reference        `json:"subject" bson:"subject"`                                                 // Who this goal is intended for
-	StartDate            *string           `json:"startDate,omitempty" bson:"start_date,omitempty"`                        // When goal pursuit begins
-	StartCodeableConcept *CodeableConcept  `json:"startCodeableConcept,omitempty" bson:"start_codeable_concept,omitempty"` // When goal pursuit begins
-	Acceptance           []GoalAcceptance  `json:"acceptance,omitempty" bson:"acceptance,omitempty"`                       // Individual acceptance of goal
-	Target               []GoalTarget      `json:"target,omitempty" bson:"target,omitempty"`                               // Target outcome for the goal
-	StatusDate           *string           `json:"statusDate,omitempty" bson:"status_date,omitempty"`                      // When goal achievment status took effect
-	StatusReason         []CodeableConcept `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                  // Reason for current lifecycle status
-	Recorder             *Reference        `json:"recorder,omitempty" bson:"recorder,omitempty"`                           // Who recorded the goal
-	Source               *Reference        `json:"source,omitempty" bson:"source,omitempty"`                               // Who's responsible for creating Goal?
-	Addresses            []Reference       `json:"addresses,omitempty" bson:"addresses,omitempty"`                         // Issues addressed by this goal
-	Note                 []Annotation      `json:"note,omitempty" bson:"note,omitempty"`                                   // Comments about the goal
+	ResourceType           string              `json:"resourceType" bson:"resource_type"`                                    // Type of resource
+	Id                     *string             `json:"id,omitempty" bson:"id,omitempty"`                                     // Logical id of this artifact
+	Meta                   *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                                 // Metadata about the resource
+	ImplicitRules          *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`              // A set of rules under which this content was created
+	ImplicitRulesElement   *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`     // Extensions for implicitRules
+	Language               *string             `json:"language,omitempty" bson:"language,omitempty"`                         // Language of the resource content
+	LanguageElement        *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`                // Extensions for language
+	Text                   *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                                 // Text summary of the resource, for human interpretation
+	Contained              []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                       // Contained, inline Resources
+	Extension              []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored
+	Identifier             []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // External Ids for this goal
+	LifecycleStatus        GoalLifecycleStatus `json:"lifecycleStatus" bson:"lifecycle_status"`                              // proposed | planned | accepted | active | on-hold | completed | cancelled | entered-in-error | rejected
+	LifecycleStatusElement *Element            `json:"_lifecycleStatus,omitempty" bson:"lifecycle_status_element,omitempty"` // Extensions for lifecycleStatus
+	AchievementStatus      *CodeableConcept    `json:"achievementStatus,omitempty" bson:"achievement_status,omitempty"`      // in-progress | improving | worsening | no-change | achieved | sustaining | not-achieved | no-progress | not-attainable
+	Category               []CodeableConcept   `json:"category,omitempty" bson:"category,omitempty"`                         // E.g. Treatment, dietary, behavioral, etc
+	Continuous             *bool               `json:"continuous,omitempty" bson:"continuous,omitempty"`                     // After meeting the goal, ongoing activity is needed to sustain the goal objective
+	ContinuousElement      *Element            `json:"_continuous,omitempty" bson:"continuous_element,omitempty"`            // Extensions for continuous
+	Priority               *CodeableConcept    `json:"priority,omitempty" bson:"priority,omitempty"`                         // high-priority | medium-priority | low-priority
+	Description            *CodeableConcept    `json:"description" bson:"description"`                                       // Code or text describing goal
+	Subject                *Reference          `json:"subject" bson:"subject"`                                               // Who this goal is intended for
+	Start                  GoalStart           `json:"-" bson:"start,omitempty"`                                             // When goal pursuit begins
+	StartElement           *Element            `json:"-" bson:"start_element,omitempty"`                                     // Extensions for start[x]
+	Acceptance             []GoalAcceptance    `json:"acceptance,omitempty" bson:"acceptance,omitempty"`                     // Individual acceptance of goal
+	Target                 []GoalTarget        `json:"target,omitempty" bson:"target,omitempty"`                             // Target outcome for the goal
+	StatusDate             *Date               `json:"statusDate,omitempty" bson:"status_date,omitempty"`                    // When goal achievment status took effect
+	StatusDateElement      *Element            `json:"_statusDate,omitempty" bson:"status_date_element,omitempty"`           // Extensions for statusDate
+	StatusReason           []CodeableConcept   `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                // Reason for current lifecycle status
+	Recorder               *Reference          `json:"recorder,omitempty" bson:"recorder,omitempty"`                         // Who recorded the goal
+	Source                 *Reference          `json:"source,omitempty" bson:"source,omitempty"`                             // Who's responsible for creating Goal?
+	Addresses              []Reference         `json:"addresses,omitempty" bson:"addresses,omitempty"`                       // Issues addressed by this goal
+	Note                   []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                                 // Comments about the goal
+
+	startVariants []string // JSON properties of Goal.start[x] when more than one was decoded
 }
 
+var goalSubjectTargets = []string{"Group", "Organization", "Patient"}
+
+var goalAddressesTargets = []string{"Condition", "MedicationRequest", "MedicationStatement", "NutritionIntake", "NutritionOrder", "Observation", "Procedure", "RiskAssessment", "ServiceRequest"}
+
 func (r *Goal) Validate() error {
 	if r.ResourceType != "Goal" {
 		return fmt.Errorf("invalid resourceType: expected 'Goal', got '%s'", r.ResourceType)
@@ -43,20 +57,55 @@ func (r *Goal) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.LifecycleStatus == emptyString {
+	if r.LifecycleStatus == "" {
 		return fmt.Errorf("field 'LifecycleStatus' is required")
 	}
+	if !r.LifecycleStatus.IsValid() {
+		return fmt.Errorf("field 'LifecycleStatus' has invalid code '%s'", r.LifecycleStatus)
+	}
+	if r.LifecycleStatusElement != nil {
+		if err := r.LifecycleStatusElement.Validate(); err != nil {
+			return fmt.Errorf("LifecycleStatusElement: %w", err)
+		}
+	}
 	if r.AchievementStatus != nil {
 		if err := r.AchievementStatus.Validate(); err != nil {
 			return fmt.Errorf("AchievementStatus: %w", err)
@@ -67,6 +116,11 @@ func (r *Goal) Validate() error {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.ContinuousElement != nil {
+		if err := r.ContinuousElement.Validate(); err != nil {
+			return fmt.Errorf("ContinuousElement: %w", err)
+		}
+	}
 	if r.Priority != nil {
 		if err := r.Priority.Validate(); err != nil {
 			return fmt.Errorf("Priority: %w", err)
@@ -83,14 +137,27 @@ func (r *Goal) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, goalSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, goalSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
-	if r.StartCodeableConcept != nil {
-		if err := r.StartCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("StartCodeableConcept: %w", err)
+	if len(r.startVariants) > 1 {
+		return fmt.Errorf("field 'Start' must have a single type, got %v", r.startVariants)
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			return fmt.Errorf("Start: %w", err)
+		}
+	}
+	if r.StartElement != nil {
+		if err := r.StartElement.Validate(); err != nil {
+			return fmt.Errorf("StartElement: %w", err)
 		}
 	}
 	for i, item := range r.Acceptance {
@@ -103,6 +170,16 @@ func (r *Goal) Validate() error {
 			return fmt.Errorf("Target[%d]: %w", i, err)
 		}
 	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			return fmt.Errorf("StatusDate: %w", err)
+		}
+	}
+	if r.StatusDateElement != nil {
+		if err := r.StatusDateElement.Validate(); err != nil {
+			return fmt.Errorf("StatusDateElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusReason[%d]: %w", i, err)
@@ -118,6 +195,11 @@ func (r *Goal) Validate() error {
 			return fmt.Errorf("Source: %w", err)
 		}
 	}
+	for i, item := range r.Addresses {
+		if target := invalidReferenceTarget(item.Reference, item.Type, goalAddressesTargets); target != "" {
+			return fmt.Errorf("field 'Addresses[%d]' cannot refer to %s, expected one of %v", i, target, goalAddressesTargets)
+		}
+	}
 	for i, item := range r.Addresses {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Addresses[%d]: %w", i, err)
@@ -128,65 +210,373 @@ func (r *Goal) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "Goal")
 }
 
-type GoalTarget struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	Measure               *CodeableConcept `json:"measure,omitempty" bson:"measure,omitempty"`                               // The parameter whose value is being tracked
-	DetailQuantity        *Quantity        `json:"detailQuantity,omitempty" bson:"detail_quantity,omitempty"`                // The target value to be achieved
-	DetailRange           *Range           `json:"detailRange,omitempty" bson:"detail_range,omitempty"`                      // The target value to be achieved
-	DetailCodeableConcept *CodeableConcept `json:"detailCodeableConcept,omitempty" bson:"detail_codeable_concept,omitempty"` // The target value to be achieved
-	DetailString          *string          `json:"detailString,omitempty" bson:"detail_string,omitempty"`                    // The target value to be achieved
-	DetailBoolean         *bool            `json:"detailBoolean,omitempty" bson:"detail_boolean,omitempty"`                  // The target value to be achieved
-	DetailInteger         *int             `json:"detailInteger,omitempty" bson:"detail_integer,omitempty"`                  // The target value to be achieved
-	DetailRatio           *Ratio           `json:"detailRatio,omitempty" bson:"detail_ratio,omitempty"`                      // The target value to be achieved
-	DueDate               *string          `json:"dueDate,omitempty" bson:"due_date,omitempty"`                              // Reach goal on or before
-	DueDuration           *Duration        `json:"dueDuration,omitempty" bson:"due_duration,omitempty"`                      // Reach goal on or before
-}
-
-func (r *GoalTarget) Validate() error {
-	if r.Measure != nil {
-		if err := r.Measure.Validate(); err != nil {
-			return fmt.Errorf("Measure: %w", err)
+func (r *Goal) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Goal" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Goal', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.LifecycleStatus == "" {
+		issues.add("required", path+".lifecycleStatus", "field 'LifecycleStatus' is required")
+	}
+	if r.LifecycleStatus != "" && !r.LifecycleStatus.IsValid() {
+		issues.add("code-invalid", path+".lifecycleStatus", fmt.Sprintf("field 'LifecycleStatus' has invalid code '%s'", r.LifecycleStatus))
+	}
+	if r.LifecycleStatusElement != nil {
+		r.LifecycleStatusElement.validateAll(path+".lifecycleStatus", issues)
+	}
+	if r.AchievementStatus != nil {
+		r.AchievementStatus.validateAll(path+".achievementStatus", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.ContinuousElement != nil {
+		r.ContinuousElement.validateAll(path+".continuous", issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+	if r.Description == nil {
+		issues.add("required", path+".description", "field 'Description' is required")
+	}
+	if r.Description != nil {
+		r.Description.validateAll(path+".description", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
 	}
-	if r.DetailQuantity != nil {
-		if err := r.DetailQuantity.Validate(); err != nil {
-			return fmt.Errorf("DetailQuantity: %w", err)
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, goalSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, goalSubjectTargets))
 		}
 	}
-	if r.DetailRange != nil {
-		if err := r.DetailRange.Validate(); err != nil {
-			return fmt.Errorf("DetailRange: %w", err)
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if len(r.startVariants) > 1 {
+		issues.add("structure", path+".start", fmt.Sprintf("field 'Start' must have a single type, got %v", r.startVariants))
+	}
+	if r.Start != nil {
+		r.Start.validateAll(path+".start.ofType("+r.Start.FHIRType()+")", issues)
+	}
+	if r.StartElement != nil {
+		r.StartElement.validateAll(path+".start", issues)
+	}
+	for i, item := range r.Acceptance {
+		item.validateAll(fmt.Sprintf("%s.acceptance[%d]", path, i), issues)
+	}
+	for i, item := range r.Target {
+		item.validateAll(fmt.Sprintf("%s.target[%d]", path, i), issues)
+	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			issues.add("value", path+".statusDate", err.Error())
 		}
 	}
-	if r.DetailCodeableConcept != nil {
-		if err := r.DetailCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DetailCodeableConcept: %w", err)
+	if r.StatusDateElement != nil {
+		r.StatusDateElement.validateAll(path+".statusDate", issues)
+	}
+	for i, item := range r.StatusReason {
+		item.validateAll(fmt.Sprintf("%s.statusReason[%d]", path, i), issues)
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	if r.Source != nil {
+		r.Source.validateAll(path+".source", issues)
+	}
+	for i, item := range r.Addresses {
+		if target := invalidReferenceTarget(item.Reference, item.Type, goalAddressesTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.addresses[%d]", path, i), fmt.Sprintf("field 'Addresses[%d]' cannot refer to %s, expected one of %v", i, target, goalAddressesTargets))
 		}
 	}
-	if r.DetailRatio != nil {
-		if err := r.DetailRatio.Validate(); err != nil {
-			return fmt.Errorf("DetailRatio: %w", err)
+	for i, item := range r.Addresses {
+		item.validateAll(fmt.Sprintf("%s.addresses[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *Goal) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Goal", &issues)
+	checkInvariants(r, "Goal", &issues)
+	return issues
+}
+
+var goalInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Goal) invariants() []invariant {
+	return goalInvariants
+}
+
+func (r Goal) MarshalJSON() ([]byte, error) {
+	type alias Goal
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "start", r.Start, r.StartElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Goal) UnmarshalJSON(data []byte) error {
+	type alias Goal
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Start, element, r.startVariants, err = unmarshalChoice(fields, "start", goalStartVariants); err != nil {
+		return err
 	}
-	if r.DueDuration != nil {
-		if err := r.DueDuration.Validate(); err != nil {
-			return fmt.Errorf("DueDuration: %w", err)
+	r.StartElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.StartElement); err != nil {
+			return fmt.Errorf("_start: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r Goal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Goal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Goal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Goal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Goal) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Start":
+		return "start", choiceValues(goalStartVariants)
+	}
+	return "", nil
+}
+
+var goalElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Goal) elementTypes() map[string]string {
+	return goalElementTypes
+}
+
+var goalElementMetadata = []ElementMetadata{
+	{Path: "Goal.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Goal.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Goal.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Goal.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Goal.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Goal.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Goal.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Goal.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Goal.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Goal.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Goal.lifecycleStatus", Name: "lifecycleStatus", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Goal.lifecycleStatus", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/goal-status"}},
+	{Path: "Goal.achievementStatus", Name: "achievementStatus", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Goal.category", Name: "category", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Goal.continuous", Name: "continuous", Field: 16, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Goal.priority", Name: "priority", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Goal.description", Name: "description", Field: 19, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Goal.subject", Name: "subject", Field: 20, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Goal.start[x]", Name: "start", Field: 21, Min: 0, Max: "1", Types: []string{"date", "CodeableConcept"}},
+	{Path: "Goal.acceptance", Name: "acceptance", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Goal.target", Name: "target", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Goal.statusDate", Name: "statusDate", Field: 25, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Goal.statusReason", Name: "statusReason", Field: 27, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Goal.recorder", Name: "recorder", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Goal.source", Name: "source", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Goal.addresses", Name: "addresses", Field: 30, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "Goal.note", Name: "note", Field: 31, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *Goal) ElementMetadata() []ElementMetadata {
+	return goalElementMetadata
+}
+
+// GoalStart is the type of Goal.start[x]. It is implemented by the GoalStart* variant types.
+type GoalStart interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGoalStart()
+}
+
+// GoalStartDate is the date variant of GoalStart.
+type GoalStartDate struct {
+	Date
+}
+
+func (v GoalStartDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v GoalStartDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v GoalStartDate) FHIRType() string {
+	return "date"
+}
+
+func (v GoalStartDate) isGoalStart() {}
+
+// GoalStartCodeableConcept is the CodeableConcept variant of GoalStart.
+type GoalStartCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v GoalStartCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v GoalStartCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v GoalStartCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v GoalStartCodeableConcept) isGoalStart() {}
+
+var goalStartVariants = []GoalStart{
+	GoalStartDate{},
+	GoalStartCodeableConcept{},
+}
+
+func (r *Goal) GetResourceType() string {
+	return "Goal"
+}
+
+func (r *Goal) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Goal) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Goal) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Goal) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Goal) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Goal) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Goal) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Goal)(nil)
+
 type GoalAcceptance struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Participant *Reference       `json:"participant" bson:"participant"`               // Individual or organization whose acceptance is reflected
-	Status      *string          `json:"status,omitempty" bson:"status,omitempty"`     // agree | disagree | pending
-	Priority    *CodeableConcept `json:"priority,omitempty" bson:"priority,omitempty"` // Priority of goal for individual
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Participant       *Reference        `json:"participant" bson:"participant"`                                  // Individual or organization whose acceptance is reflected
+	Status            *GoalAcceptStatus `json:"status,omitempty" bson:"status,omitempty"`                        // agree | disagree | pending
+	StatusElement     *Element          `json:"_status,omitempty" bson:"status_element,omitempty"`               // Extensions for status
+	Priority          *CodeableConcept  `json:"priority,omitempty" bson:"priority,omitempty"`                    // Priority of goal for individual
 }
 
 func (r *GoalAcceptance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Participant == nil {
 		return fmt.Errorf("field 'Participant' is required")
 	}
@@ -195,6 +585,14 @@ func (r *GoalAcceptance) Validate() error {
 			return fmt.Errorf("Participant: %w", err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Priority != nil {
 		if err := r.Priority.Validate(); err != nil {
 			return fmt.Errorf("Priority: %w", err)
@@ -202,3 +600,445 @@ func (r *GoalAcceptance) Validate() error {
 	}
 	return nil
 }
+
+func (r *GoalAcceptance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Participant == nil {
+		issues.add("required", path+".participant", "field 'Participant' is required")
+	}
+	if r.Participant != nil {
+		r.Participant.validateAll(path+".participant", issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+}
+
+var goalAcceptanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *GoalAcceptance) invariants() []invariant {
+	return goalAcceptanceInvariants
+}
+
+func (r GoalAcceptance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *GoalAcceptance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r GoalAcceptance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *GoalAcceptance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var goalAcceptanceElementMetadata = []ElementMetadata{
+	{Path: "Goal.acceptance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Goal.acceptance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Goal.acceptance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Goal.acceptance.participant", Name: "participant", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "Goal.acceptance.status", Name: "status", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Goal.acceptance.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/goal-accept-status"}},
+	{Path: "Goal.acceptance.priority", Name: "priority", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *GoalAcceptance) ElementMetadata() []ElementMetadata {
+	return goalAcceptanceElementMetadata
+}
+
+type GoalTarget struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Measure           *CodeableConcept `json:"measure,omitempty" bson:"measure,omitempty"`                      // The parameter whose value is being tracked
+	Detail            GoalTargetDetail `json:"-" bson:"detail,omitempty"`                                       // The target value to be achieved
+	DetailElement     *Element         `json:"-" bson:"detail_element,omitempty"`                               // Extensions for detail[x]
+	Due               GoalTargetDue    `json:"-" bson:"due,omitempty"`                                          // Reach goal on or before
+	DueElement        *Element         `json:"-" bson:"due_element,omitempty"`                                  // Extensions for due[x]
+
+	detailVariants []string // JSON properties of Goal.target.detail[x] when more than one was decoded
+	dueVariants    []string // JSON properties of Goal.target.due[x] when more than one was decoded
+}
+
+func (r *GoalTarget) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Measure != nil {
+		if err := r.Measure.Validate(); err != nil {
+			return fmt.Errorf("Measure: %w", err)
+		}
+	}
+	if len(r.detailVariants) > 1 {
+		return fmt.Errorf("field 'Detail' must have a single type, got %v", r.detailVariants)
+	}
+	if r.Detail != nil {
+		if err := r.Detail.Validate(); err != nil {
+			return fmt.Errorf("Detail: %w", err)
+		}
+	}
+	if r.DetailElement != nil {
+		if err := r.DetailElement.Validate(); err != nil {
+			return fmt.Errorf("DetailElement: %w", err)
+		}
+	}
+	if len(r.dueVariants) > 1 {
+		return fmt.Errorf("field 'Due' must have a single type, got %v", r.dueVariants)
+	}
+	if r.Due != nil {
+		if err := r.Due.Validate(); err != nil {
+			return fmt.Errorf("Due: %w", err)
+		}
+	}
+	if r.DueElement != nil {
+		if err := r.DueElement.Validate(); err != nil {
+			return fmt.Errorf("DueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *GoalTarget) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Measure != nil {
+		r.Measure.validateAll(path+".measure", issues)
+	}
+	if len(r.detailVariants) > 1 {
+		issues.add("structure", path+".detail", fmt.Sprintf("field 'Detail' must have a single type, got %v", r.detailVariants))
+	}
+	if r.Detail != nil {
+		r.Detail.validateAll(path+".detail.ofType("+r.Detail.FHIRType()+")", issues)
+	}
+	if r.DetailElement != nil {
+		r.DetailElement.validateAll(path+".detail", issues)
+	}
+	if len(r.dueVariants) > 1 {
+		issues.add("structure", path+".due", fmt.Sprintf("field 'Due' must have a single type, got %v", r.dueVariants))
+	}
+	if r.Due != nil {
+		r.Due.validateAll(path+".due.ofType("+r.Due.FHIRType()+")", issues)
+	}
+	if r.DueElement != nil {
+		r.DueElement.validateAll(path+".due", issues)
+	}
+}
+
+var goalTargetInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *GoalTarget) invariants() []invariant {
+	return goalTargetInvariants
+}
+
+func (r GoalTarget) MarshalJSON() ([]byte, error) {
+	type alias GoalTarget
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "detail", r.Detail, r.DetailElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "due", r.Due, r.DueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *GoalTarget) UnmarshalJSON(data []byte) error {
+	type alias GoalTarget
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Detail, element, r.detailVariants, err = unmarshalChoice(fields, "detail", goalTargetDetailVariants); err != nil {
+		return err
+	}
+	r.DetailElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DetailElement); err != nil {
+			return fmt.Errorf("_detail: %w", err)
+		}
+	}
+	if r.Due, element, r.dueVariants, err = unmarshalChoice(fields, "due", goalTargetDueVariants); err != nil {
+		return err
+	}
+	r.DueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DueElement); err != nil {
+			return fmt.Errorf("_due: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r GoalTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *GoalTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r GoalTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *GoalTarget) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *GoalTarget) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Detail":
+		return "detail", choiceValues(goalTargetDetailVariants)
+	case "Due":
+		return "due", choiceValues(goalTargetDueVariants)
+	}
+	return "", nil
+}
+
+var goalTargetElementMetadata = []ElementMetadata{
+	{Path: "Goal.target.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Goal.target.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Goal.target.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Goal.target.measure", Name: "measure", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Goal.target.detail[x]", Name: "detail", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity", "Range", "CodeableConcept", "string", "boolean", "integer", "Ratio"}},
+	{Path: "Goal.target.due[x]", Name: "due", Field: 6, Min: 0, Max: "1", Types: []string{"date", "Duration"}},
+}
+
+func (r *GoalTarget) ElementMetadata() []ElementMetadata {
+	return goalTargetElementMetadata
+}
+
+// GoalTargetDetail is the type of Goal.target.detail[x]. It is implemented by the GoalTargetDetail* variant types.
+type GoalTargetDetail interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGoalTargetDetail()
+}
+
+// GoalTargetDetailQuantity is the Quantity variant of GoalTargetDetail.
+type GoalTargetDetailQuantity struct {
+	Quantity
+}
+
+func (v GoalTargetDetailQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v GoalTargetDetailQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v GoalTargetDetailQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v GoalTargetDetailQuantity) isGoalTargetDetail() {}
+
+// GoalTargetDetailRange is the Range variant of GoalTargetDetail.
+type GoalTargetDetailRange struct {
+	Range
+}
+
+func (v GoalTargetDetailRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v GoalTargetDetailRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v GoalTargetDetailRange) FHIRType() string {
+	return "Range"
+}
+
+func (v GoalTargetDetailRange) isGoalTargetDetail() {}
+
+// GoalTargetDetailCodeableConcept is the CodeableConcept variant of GoalTargetDetail.
+type GoalTargetDetailCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v GoalTargetDetailCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v GoalTargetDetailCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v GoalTargetDetailCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v GoalTargetDetailCodeableConcept) isGoalTargetDetail() {}
+
+// GoalTargetDetailString is the string variant of GoalTargetDetail.
+type GoalTargetDetailString string
+
+func (v GoalTargetDetailString) Validate() error {
+	return nil
+}
+
+func (v GoalTargetDetailString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GoalTargetDetailString) FHIRType() string {
+	return "string"
+}
+
+func (v GoalTargetDetailString) isGoalTargetDetail() {}
+
+// GoalTargetDetailBoolean is the boolean variant of GoalTargetDetail.
+type GoalTargetDetailBoolean bool
+
+func (v GoalTargetDetailBoolean) Validate() error {
+	return nil
+}
+
+func (v GoalTargetDetailBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GoalTargetDetailBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v GoalTargetDetailBoolean) isGoalTargetDetail() {}
+
+// GoalTargetDetailInteger is the integer variant of GoalTargetDetail.
+type GoalTargetDetailInteger int
+
+func (v GoalTargetDetailInteger) Validate() error {
+	return nil
+}
+
+func (v GoalTargetDetailInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GoalTargetDetailInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v GoalTargetDetailInteger) isGoalTargetDetail() {}
+
+// GoalTargetDetailRatio is the Ratio variant of GoalTargetDetail.
+type GoalTargetDetailRatio struct {
+	Ratio
+}
+
+func (v GoalTargetDetailRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v GoalTargetDetailRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v GoalTargetDetailRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v GoalTargetDetailRatio) isGoalTargetDetail() {}
+
+var goalTargetDetailVariants = []GoalTargetDetail{
+	GoalTargetDetailQuantity{},
+	GoalTargetDetailRange{},
+	GoalTargetDetailCodeableConcept{},
+	GoalTargetDetailString(""),
+	GoalTargetDetailBoolean(false),
+	GoalTargetDetailInteger(0),
+	GoalTargetDetailRatio{},
+}
+
+// GoalTargetDue is the type of Goal.target.due[x]. It is implemented by the GoalTargetDue* variant types.
+type GoalTargetDue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGoalTargetDue()
+}
+
+// GoalTargetDueDate is the date variant of GoalTargetDue.
+type GoalTargetDueDate struct {
+	Date
+}
+
+func (v GoalTargetDueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v GoalTargetDueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v GoalTargetDueDate) FHIRType() string {
+	return "date"
+}
+
+func (v GoalTargetDueDate) isGoalTargetDue() {}
+
+// GoalTargetDueDuration is the Duration variant of GoalTargetDue.
+type GoalTargetDueDuration struct {
+	Duration
+}
+
+func (v GoalTargetDueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v GoalTargetDueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v GoalTargetDueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v GoalTargetDueDuration) isGoalTargetDue() {}
+
+var goalTargetDueVariants = []GoalTargetDue{
+	GoalTargetDueDate{},
+	GoalTargetDueDuration{},
+}
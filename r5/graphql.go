@@ -1,9 +1,110 @@
 package models
 
-// Execute a graphql statement on a since resource or against the entire system. See the [Using GraphQL with FHIR](graphql.html) page for further details.  For the purposes of graphQL compatibility, this operation can also be invoked using a POST with the graphQL as the body, or a JSON body (see [graphQL spec](http://graphql.org/) for details)
-type Graphql struct {
+import "fmt"
+
+// GraphqlIn holds the input parameters of the $graphql operation. Execute a graphql statement on a since resource or against the entire system. See the [Using GraphQL with FHIR](graphql.html) page for further details.  For the purposes of graphQL compatibility, this operation can also be invoked using a POST with the graphQL as the body, or a JSON body (see [graphQL spec](http://graphql.org/) for details)
+type GraphqlIn struct {
+	// The query to execute
+	Query *string
+}
+
+func (p *GraphqlIn) Validate() error {
+	if p.Query == nil {
+		return fmt.Errorf("parameter 'query' is required")
+	}
+	return nil
+}
+
+func (p *GraphqlIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *GraphqlIn) FromParameters(params *Parameters) error {
+	*p = GraphqlIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *GraphqlIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Query != nil {
+		params = append(params, ParametersParameter{Name: "query", Value: ParametersParameterValueString(*p.Query)})
+	}
+	return params
+}
+
+func (p *GraphqlIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "query":
+			if p.Query != nil {
+				return fmt.Errorf("parameter 'query' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Query = &value
+		}
+	}
+	return nil
+}
+
+// GraphqlOut holds the output parameters of the $graphql operation.
+type GraphqlOut struct {
+	// The content is always returned as application/json
+	Result *Binary
+}
+
+func (p *GraphqlOut) Validate() error {
+	if p.Result == nil {
+		return fmt.Errorf("parameter 'result' is required")
+	}
+	return nil
+}
+
+func (p *GraphqlOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *GraphqlOut) FromParameters(params *Parameters) error {
+	*p = GraphqlOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *GraphqlOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Result != nil {
+		params = append(params, ParametersParameter{Name: "result", Resource: p.Result})
+	}
+	return params
 }
 
-func (r *Graphql) Validate() error {
+func (p *GraphqlOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "result":
+			if p.Result != nil {
+				return fmt.Errorf("parameter 'result' must occur at most once")
+			}
+			v, err := parameterResource[*Binary](param.Name, param.Resource, "Binary")
+			if err != nil {
+				return err
+			}
+			p.Result = v
+		}
+	}
 	return nil
 }
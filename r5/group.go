@@ -2,46 +2,72 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Represents a defined collection of entities that may be discussed or acted upon collectively but which are not typically expected to act collectively*. These collections are also not typically formally or legally recognized.\r\n\r\n*NOTE: Group may be used to define families or households, which in some circumstances may act collectively or have a degree of legal or formal recognition. This should be considered an exception. When Group is used for types of entities other than Patient or RelatedPerson, the expectation remains that the Group will not act collectively or have formal recognition - use Organization if these behaviors are needed. See more discussion [below](group.html#group-usage)
 type Group struct {
-	ResourceType           string                `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string               `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string               `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string               `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this Group, represented as an absolute URI (globally unique)
-	Identifier             []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business Identifier for this Group
-	Version                *string               `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the Group
-	VersionAlgorithmString *string               `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding               `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string               `json:"name,omitempty" bson:"name,omitempty"`                                       // Label for Group
-	Title                  *string               `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this Group (human friendly)
-	Status                 *string               `json:"status,omitempty" bson:"status,omitempty"`                                   // draft | active | retired | unknown
-	Experimental           *bool                 `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string               `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string               `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail       `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string               `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the group
-	UseContext             []UsageContext        `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Purpose                *string               `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this Group is defined
-	Copyright              *string               `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string               `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Type                   *string               `json:"type,omitempty" bson:"type,omitempty"`                                       // person | animal | practitioner | device | careteam | healthcareservice | location | organization | relatedperson | specimen | medication | medicinalproductdefinition | substance | substancedefinition | biologicallyDerivedProduct | nutritionProduct
-	Membership             string                `json:"membership" bson:"membership"`                                               // definitional | conceptual | enumerated
-	Code                   *CodeableConcept      `json:"code,omitempty" bson:"code,omitempty"`                                       // Use of the Group (and by implication, kind of members)
-	Quantity               *int                  `json:"quantity,omitempty" bson:"quantity,omitempty"`                               // Number of members
-	ManagingEntity         *Reference            `json:"managingEntity,omitempty" bson:"managing_entity,omitempty"`                  // Entity that is the custodian of the Group's definition
-	CombinationMethod      *string               `json:"combinationMethod,omitempty" bson:"combination_method,omitempty"`            // all-of | any-of | at-least | at-most | except-subset
-	CombinationThreshold   *int                  `json:"combinationThreshold,omitempty" bson:"combination_threshold,omitempty"`      // Provides the value of "n" when "at-least" or "at-most" codes are used
-	Characteristic         []GroupCharacteristic `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                   // Include / Exclude group members by Trait
-	Member                 []GroupMember         `json:"member,omitempty" bson:"member,omitempty"`                                   // Who or what is in group
+	ResourceType                string                          `json:"resourceType" bson:"resource_type"`                                              // Type of resource
+	Id                          *string                         `json:"id,omitempty" bson:"id,omitempty"`                                               // Logical id of this artifact
+	Meta                        *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                           // Metadata about the resource
+	ImplicitRules               *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                        // A set of rules under which this content was created
+	ImplicitRulesElement        *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`               // Extensions for implicitRules
+	Language                    *string                         `json:"language,omitempty" bson:"language,omitempty"`                                   // Language of the resource content
+	LanguageElement             *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`                          // Extensions for language
+	Text                        *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                           // Text summary of the resource, for human interpretation
+	Contained                   []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                                 // Contained, inline Resources
+	Extension                   []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                                 // Additional content defined by implementations
+	ModifierExtension           []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                // Extensions that cannot be ignored
+	Url                         *string                         `json:"url,omitempty" bson:"url,omitempty"`                                             // Canonical identifier for this Group, represented as an absolute URI (globally unique)
+	UrlElement                  *Element                        `json:"_url,omitempty" bson:"url_element,omitempty"`                                    // Extensions for url
+	Identifier                  []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                               // Business Identifier for this Group
+	Version                     *string                         `json:"version,omitempty" bson:"version,omitempty"`                                     // Business version of the Group
+	VersionElement              *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`                            // Extensions for version
+	VersionAlgorithm            GroupVersionAlgorithm           `json:"-" bson:"version_algorithm,omitempty"`                                           // How to compare versions
+	VersionAlgorithmElement     *Element                        `json:"-" bson:"version_algorithm_element,omitempty"`                                   // Extensions for versionAlgorithm[x]
+	Name                        *string                         `json:"name,omitempty" bson:"name,omitempty"`                                           // Label for Group
+	NameElement                 *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                                  // Extensions for name
+	Title                       *string                         `json:"title,omitempty" bson:"title,omitempty"`                                         // Name for this Group (human friendly)
+	TitleElement                *Element                        `json:"_title,omitempty" bson:"title_element,omitempty"`                                // Extensions for title
+	Status                      *PublicationStatus              `json:"status,omitempty" bson:"status,omitempty"`                                       // draft | active | retired | unknown
+	StatusElement               *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                              // Extensions for status
+	Experimental                *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`                           // For testing only - never for real usage
+	ExperimentalElement         *Element                        `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                  // Extensions for experimental
+	Date                        *DateTime                       `json:"date,omitempty" bson:"date,omitempty"`                                           // Date last changed
+	DateElement                 *Element                        `json:"_date,omitempty" bson:"date_element,omitempty"`                                  // Extensions for date
+	Publisher                   *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                                 // Name of the publisher/steward (organization or individual)
+	PublisherElement            *Element                        `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                        // Extensions for publisher
+	Contact                     []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                                     // Contact details for the publisher
+	Description                 *string                         `json:"description,omitempty" bson:"description,omitempty"`                             // Natural language description of the group
+	DescriptionElement          *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`                    // Extensions for description
+	UseContext                  []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                              // The context that the content is intended to support
+	Purpose                     *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                                     // Why this Group is defined
+	PurposeElement              *Element                        `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                            // Extensions for purpose
+	Copyright                   *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                                 // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement            *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                        // Extensions for copyright
+	CopyrightLabel              *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                      // Copyright holder and year(s)
+	CopyrightLabelElement       *Element                        `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`             // Extensions for copyrightLabel
+	Type                        *GroupType                      `json:"type,omitempty" bson:"type,omitempty"`                                           // person | animal | practitioner | device | careteam | healthcareservice | location | organization | relatedperson | specimen | medication | medicinalproductdefinition | substance | substancedefinition | biologicallyDerivedProduct | nutritionProduct
+	TypeElement                 *Element                        `json:"_type,omitempty" bson:"type_element,omitempty"`                                  // Extensions for type
+	Membership                  GroupMembershipBasis            `json:"membership" bson:"membership"`                                                   // definitional | conceptual | enumerated
+	MembershipElement           *Element                        `json:"_membership,omitempty" bson:"membership_element,omitempty"`                      // Extensions for membership
+	Code                        *CodeableConcept                `json:"code,omitempty" bson:"code,omitempty"`                                           // Use of the Group (and by implication, kind of members)
+	Quantity                    *int                            `json:"quantity,omitempty" bson:"quantity,omitempty"`                                   // Number of members
+	QuantityElement             *Element                        `json:"_quantity,omitempty" bson:"quantity_element,omitempty"`                          // Extensions for quantity
+	ManagingEntity              *Reference                      `json:"managingEntity,omitempty" bson:"managing_entity,omitempty"`                      // Entity that is the custodian of the Group's definition
+	CombinationMethod           *GroupCharacteristicCombination `json:"combinationMethod,omitempty" bson:"combination_method,omitempty"`                // all-of | any-of | at-least | at-most | except-subset
+	CombinationMethodElement    *Element                        `json:"_combinationMethod,omitempty" bson:"combination_method_element,omitempty"`       // Extensions for combinationMethod
+	CombinationThreshold        *int                            `json:"combinationThreshold,omitempty" bson:"combination_threshold,omitempty"`          // Provides the value of "n" when "at-least" or "at-most" codes are used
+	CombinationThresholdElement *Element                        `json:"_combinationThreshold,omitempty" bson:"combination_threshold_element,omitempty"` // Extensions for combinationThreshold
+	Characteristic              []GroupCharacteristic           `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                       // Include / Exclude group members by Trait
+	Member                      []GroupMember                   `json:"member,omitempty" bson:"member,omitempty"`                                       // Who or what is in group
+
+	versionAlgorithmVariants []string // JSON properties of Group.versionAlgorithm[x] when more than one was decoded
 }
 
+var groupManagingEntityTargets = []string{"Organization", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Group) Validate() error {
 	if r.ResourceType != "Group" {
 		return fmt.Errorf("invalid resourceType: expected 'Group', got '%s'", r.ResourceType)
@@ -51,19 +77,103 @@ func (r *Group) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -71,25 +181,83 @@ func (r *Group) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Membership == emptyString {
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.Membership == "" {
 		return fmt.Errorf("field 'Membership' is required")
 	}
+	if !r.Membership.IsValid() {
+		return fmt.Errorf("field 'Membership' has invalid code '%s'", r.Membership)
+	}
+	if r.MembershipElement != nil {
+		if err := r.MembershipElement.Validate(); err != nil {
+			return fmt.Errorf("MembershipElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.QuantityElement != nil {
+		if err := r.QuantityElement.Validate(); err != nil {
+			return fmt.Errorf("QuantityElement: %w", err)
+		}
+	}
+	if r.ManagingEntity != nil {
+		if target := invalidReferenceTarget(r.ManagingEntity.Reference, r.ManagingEntity.Type, groupManagingEntityTargets); target != "" {
+			return fmt.Errorf("field 'ManagingEntity' cannot refer to %s, expected one of %v", target, groupManagingEntityTargets)
+		}
+	}
 	if r.ManagingEntity != nil {
 		if err := r.ManagingEntity.Validate(); err != nil {
 			return fmt.Errorf("ManagingEntity: %w", err)
 		}
 	}
+	if r.CombinationMethod != nil && !r.CombinationMethod.IsValid() {
+		return fmt.Errorf("field 'CombinationMethod' has invalid code '%s'", *r.CombinationMethod)
+	}
+	if r.CombinationMethodElement != nil {
+		if err := r.CombinationMethodElement.Validate(); err != nil {
+			return fmt.Errorf("CombinationMethodElement: %w", err)
+		}
+	}
+	if r.CombinationThresholdElement != nil {
+		if err := r.CombinationThresholdElement.Validate(); err != nil {
+			return fmt.Errorf("CombinationThresholdElement: %w", err)
+		}
+	}
 	for i, item := range r.Characteristic {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Characteristic[%d]: %w", i, err)
@@ -100,34 +268,419 @@ func (r *Group) Validate() error {
 			return fmt.Errorf("Member[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Group")
+}
+
+func (r *Group) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Group" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Group', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Membership == "" {
+		issues.add("required", path+".membership", "field 'Membership' is required")
+	}
+	if r.Membership != "" && !r.Membership.IsValid() {
+		issues.add("code-invalid", path+".membership", fmt.Sprintf("field 'Membership' has invalid code '%s'", r.Membership))
+	}
+	if r.MembershipElement != nil {
+		r.MembershipElement.validateAll(path+".membership", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.QuantityElement != nil {
+		r.QuantityElement.validateAll(path+".quantity", issues)
+	}
+	if r.ManagingEntity != nil {
+		if target := invalidReferenceTarget(r.ManagingEntity.Reference, r.ManagingEntity.Type, groupManagingEntityTargets); target != "" {
+			issues.add("value", path+".managingEntity", fmt.Sprintf("field 'ManagingEntity' cannot refer to %s, expected one of %v", target, groupManagingEntityTargets))
+		}
+	}
+	if r.ManagingEntity != nil {
+		r.ManagingEntity.validateAll(path+".managingEntity", issues)
+	}
+	if r.CombinationMethod != nil && !r.CombinationMethod.IsValid() {
+		issues.add("code-invalid", path+".combinationMethod", fmt.Sprintf("field 'CombinationMethod' has invalid code '%s'", *r.CombinationMethod))
+	}
+	if r.CombinationMethodElement != nil {
+		r.CombinationMethodElement.validateAll(path+".combinationMethod", issues)
+	}
+	if r.CombinationThresholdElement != nil {
+		r.CombinationThresholdElement.validateAll(path+".combinationThreshold", issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
+	for i, item := range r.Member {
+		item.validateAll(fmt.Sprintf("%s.member[%d]", path, i), issues)
+	}
+}
+
+func (r *Group) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Group", &issues)
+	checkInvariants(r, "Group", &issues)
+	return issues
+}
+
+var groupInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Group) invariants() []invariant {
+	return groupInvariants
+}
+
+func (r Group) MarshalJSON() ([]byte, error) {
+	type alias Group
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Group) UnmarshalJSON(data []byte) error {
+	type alias Group
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", groupVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r Group) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Group) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Group) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Group) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Group) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(groupVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var groupElementTypes = map[string]string{
+	"id":                   "id",
+	"implicitRules":        "uri",
+	"language":             "code",
+	"url":                  "uri",
+	"description":          "markdown",
+	"purpose":              "markdown",
+	"copyright":            "markdown",
+	"quantity":             "unsignedInt",
+	"combinationThreshold": "positiveInt",
+}
+
+func (r *Group) elementTypes() map[string]string {
+	return groupElementTypes
+}
+
+var groupElementMetadata = []ElementMetadata{
+	{Path: "Group.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Group.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Group.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Group.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Group.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Group.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Group.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Group.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Group.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Group.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Group.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Group.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "Group.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.status", Name: "status", Field: 22, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Group.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Group.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Group.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Group.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Group.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Group.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Group.purpose", Name: "purpose", Field: 34, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Group.copyright", Name: "copyright", Field: 36, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Group.copyrightLabel", Name: "copyrightLabel", Field: 38, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.type", Name: "type", Field: 40, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Group.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/group-type"}},
+	{Path: "Group.membership", Name: "membership", Field: 42, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Group.membership", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/group-membership-basis"}},
+	{Path: "Group.code", Name: "code", Field: 44, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Group.quantity", Name: "quantity", Field: 45, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Group.managingEntity", Name: "managingEntity", Field: 47, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Group.combinationMethod", Name: "combinationMethod", Field: 48, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Group.combinationMethod", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/group-characteristic-combination"}},
+	{Path: "Group.combinationThreshold", Name: "combinationThreshold", Field: 50, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Group.characteristic", Name: "characteristic", Field: 52, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Group.member", Name: "member", Field: 53, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Group) ElementMetadata() []ElementMetadata {
+	return groupElementMetadata
+}
+
+// GroupVersionAlgorithm is the type of Group.versionAlgorithm[x]. It is implemented by the GroupVersionAlgorithm* variant types.
+type GroupVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGroupVersionAlgorithm()
+}
+
+// GroupVersionAlgorithmString is the string variant of GroupVersionAlgorithm.
+type GroupVersionAlgorithmString string
+
+func (v GroupVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v GroupVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GroupVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v GroupVersionAlgorithmString) isGroupVersionAlgorithm() {}
+
+// GroupVersionAlgorithmCoding is the Coding variant of GroupVersionAlgorithm.
+type GroupVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v GroupVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v GroupVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v GroupVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v GroupVersionAlgorithmCoding) isGroupVersionAlgorithm() {}
+
+var groupVersionAlgorithmVariants = []GroupVersionAlgorithm{
+	GroupVersionAlgorithmString(""),
+	GroupVersionAlgorithmCoding{},
+}
+
+func (r *Group) GetResourceType() string {
+	return "Group"
+}
+
+func (r *Group) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Group) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Group) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Group) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Group) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Group) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Group) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Group)(nil)
+
 type GroupCharacteristic struct {
-	Id                   *string           `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Code                 *CodeableConcept  `json:"code" bson:"code"`                                                       // Kind of characteristic
-	ValueCodeableConcept *CodeableConcept  `json:"valueCodeableConcept" bson:"value_codeable_concept"`                     // Value held by characteristic
-	ValueBoolean         *bool             `json:"valueBoolean" bson:"value_boolean"`                                      // Value held by characteristic
-	ValueQuantity        *Quantity         `json:"valueQuantity" bson:"value_quantity"`                                    // Value held by characteristic
-	ValueRange           *Range            `json:"valueRange" bson:"value_range"`                                          // Value held by characteristic
-	ValueReference       *Reference        `json:"valueReference" bson:"value_reference"`                                  // Value held by characteristic
-	ValueUri             *string           `json:"valueUri" bson:"value_uri"`                                              // Value held by characteristic
-	ValueExpression      *Expression       `json:"valueExpression" bson:"value_expression"`                                // Value held by characteristic
-	Exclude              bool              `json:"exclude" bson:"exclude"`                                                 // Group includes or excludes
-	Description          *string           `json:"description,omitempty" bson:"description,omitempty"`                     // Natural language description of the characteristic
-	Method               []CodeableConcept `json:"method,omitempty" bson:"method,omitempty"`                               // Method for how the characteristic value was determined
-	Formula              *Expression       `json:"formula,omitempty" bson:"formula,omitempty"`                             // Formal algorithm to derive the value
-	Determiner           *Reference        `json:"determiner,omitempty" bson:"determiner,omitempty"`                       // Who determines the value
-	Offset               *CodeableConcept  `json:"offset,omitempty" bson:"offset,omitempty"`                               // Reference point for comparison
-	InstancesUnsignedInt *int              `json:"instancesUnsignedInt,omitempty" bson:"instances_unsigned_int,omitempty"` // Number of occurrences meeting the characteristic
-	InstancesRange       *Range            `json:"instancesRange,omitempty" bson:"instances_range,omitempty"`              // Number of occurrences meeting the characteristic
-	DurationDuration     *Duration         `json:"durationDuration,omitempty" bson:"duration_duration,omitempty"`          // Length of time in which the characteristic is met
-	DurationRange        *Range            `json:"durationRange,omitempty" bson:"duration_range,omitempty"`                // Length of time in which the characteristic is met
-	Period               *Period           `json:"period,omitempty" bson:"period,omitempty"`                               // Period over which characteristic is tested
-	Timing               []RelativeTime    `json:"timing,omitempty" bson:"timing,omitempty"`                               // Timing in which the characteristic is determined
+	Id                 *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code               *CodeableConcept             `json:"code" bson:"code"`                                                // Kind of characteristic
+	Value              GroupCharacteristicValue     `json:"-" bson:"value,omitempty"`                                        // Value held by characteristic
+	ValueElement       *Element                     `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Exclude            bool                         `json:"exclude" bson:"exclude"`                                          // Group includes or excludes
+	ExcludeElement     *Element                     `json:"_exclude,omitempty" bson:"exclude_element,omitempty"`             // Extensions for exclude
+	Description        *string                      `json:"description,omitempty" bson:"description,omitempty"`              // Natural language description of the characteristic
+	DescriptionElement *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Method             []CodeableConcept            `json:"method,omitempty" bson:"method,omitempty"`                        // Method for how the characteristic value was determined
+	Formula            *Expression                  `json:"formula,omitempty" bson:"formula,omitempty"`                      // Formal algorithm to derive the value
+	Determiner         *Reference                   `json:"determiner,omitempty" bson:"determiner,omitempty"`                // Who determines the value
+	Offset             *CodeableConcept             `json:"offset,omitempty" bson:"offset,omitempty"`                        // Reference point for comparison
+	Instances          GroupCharacteristicInstances `json:"-" bson:"instances,omitempty"`                                    // Number of occurrences meeting the characteristic
+	InstancesElement   *Element                     `json:"-" bson:"instances_element,omitempty"`                            // Extensions for instances[x]
+	Duration           GroupCharacteristicDuration  `json:"-" bson:"duration,omitempty"`                                     // Length of time in which the characteristic is met
+	Period             *Period                      `json:"period,omitempty" bson:"period,omitempty"`                        // Period over which characteristic is tested
+	Timing             []RelativeTime               `json:"timing,omitempty" bson:"timing,omitempty"`                        // Timing in which the characteristic is determined
+
+	valueVariants     []string // JSON properties of Group.characteristic.value[x] when more than one was decoded
+	instancesVariants []string // JSON properties of Group.characteristic.instances[x] when more than one was decoded
+	durationVariants  []string // JSON properties of Group.characteristic.duration[x] when more than one was decoded
 }
 
 func (r *GroupCharacteristic) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -136,50 +689,30 @@ func (r *GroupCharacteristic) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
-	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
-	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
-	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
-	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	if r.ExcludeElement != nil {
+		if err := r.ExcludeElement.Validate(); err != nil {
+			return fmt.Errorf("ExcludeElement: %w", err)
 		}
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
-	}
-	if r.ValueExpression == nil {
-		return fmt.Errorf("field 'ValueExpression' is required")
-	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
 		}
 	}
 	for i, item := range r.Method {
@@ -202,19 +735,25 @@ func (r *GroupCharacteristic) Validate() error {
 			return fmt.Errorf("Offset: %w", err)
 		}
 	}
-	if r.InstancesRange != nil {
-		if err := r.InstancesRange.Validate(); err != nil {
-			return fmt.Errorf("InstancesRange: %w", err)
+	if len(r.instancesVariants) > 1 {
+		return fmt.Errorf("field 'Instances' must have a single type, got %v", r.instancesVariants)
+	}
+	if r.Instances != nil {
+		if err := r.Instances.Validate(); err != nil {
+			return fmt.Errorf("Instances: %w", err)
 		}
 	}
-	if r.DurationDuration != nil {
-		if err := r.DurationDuration.Validate(); err != nil {
-			return fmt.Errorf("DurationDuration: %w", err)
+	if r.InstancesElement != nil {
+		if err := r.InstancesElement.Validate(); err != nil {
+			return fmt.Errorf("InstancesElement: %w", err)
 		}
 	}
-	if r.DurationRange != nil {
-		if err := r.DurationRange.Validate(); err != nil {
-			return fmt.Errorf("DurationRange: %w", err)
+	if len(r.durationVariants) > 1 {
+		return fmt.Errorf("field 'Duration' must have a single type, got %v", r.durationVariants)
+	}
+	if r.Duration != nil {
+		if err := r.Duration.Validate(); err != nil {
+			return fmt.Errorf("Duration: %w", err)
 		}
 	}
 	if r.Period != nil {
@@ -230,18 +769,470 @@ func (r *GroupCharacteristic) Validate() error {
 	return nil
 }
 
+func (r *GroupCharacteristic) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.ExcludeElement != nil {
+		r.ExcludeElement.validateAll(path+".exclude", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Method {
+		item.validateAll(fmt.Sprintf("%s.method[%d]", path, i), issues)
+	}
+	if r.Formula != nil {
+		r.Formula.validateAll(path+".formula", issues)
+	}
+	if r.Determiner != nil {
+		r.Determiner.validateAll(path+".determiner", issues)
+	}
+	if r.Offset != nil {
+		r.Offset.validateAll(path+".offset", issues)
+	}
+	if len(r.instancesVariants) > 1 {
+		issues.add("structure", path+".instances", fmt.Sprintf("field 'Instances' must have a single type, got %v", r.instancesVariants))
+	}
+	if r.Instances != nil {
+		r.Instances.validateAll(path+".instances.ofType("+r.Instances.FHIRType()+")", issues)
+	}
+	if r.InstancesElement != nil {
+		r.InstancesElement.validateAll(path+".instances", issues)
+	}
+	if len(r.durationVariants) > 1 {
+		issues.add("structure", path+".duration", fmt.Sprintf("field 'Duration' must have a single type, got %v", r.durationVariants))
+	}
+	if r.Duration != nil {
+		r.Duration.validateAll(path+".duration.ofType("+r.Duration.FHIRType()+")", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Timing {
+		item.validateAll(fmt.Sprintf("%s.timing[%d]", path, i), issues)
+	}
+}
+
+var groupCharacteristicInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *GroupCharacteristic) invariants() []invariant {
+	return groupCharacteristicInvariants
+}
+
+func (r GroupCharacteristic) MarshalJSON() ([]byte, error) {
+	type alias GroupCharacteristic
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "instances", r.Instances, r.InstancesElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "duration", r.Duration, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *GroupCharacteristic) UnmarshalJSON(data []byte) error {
+	type alias GroupCharacteristic
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", groupCharacteristicValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	if r.Instances, element, r.instancesVariants, err = unmarshalChoice(fields, "instances", groupCharacteristicInstancesVariants); err != nil {
+		return err
+	}
+	r.InstancesElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.InstancesElement); err != nil {
+			return fmt.Errorf("_instances: %w", err)
+		}
+	}
+	if r.Duration, _, r.durationVariants, err = unmarshalChoice(fields, "duration", groupCharacteristicDurationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r GroupCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *GroupCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r GroupCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *GroupCharacteristic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *GroupCharacteristic) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(groupCharacteristicValueVariants)
+	case "Instances":
+		return "instances", choiceValues(groupCharacteristicInstancesVariants)
+	case "Duration":
+		return "duration", choiceValues(groupCharacteristicDurationVariants)
+	}
+	return "", nil
+}
+
+var groupCharacteristicElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *GroupCharacteristic) elementTypes() map[string]string {
+	return groupCharacteristicElementTypes
+}
+
+var groupCharacteristicElementMetadata = []ElementMetadata{
+	{Path: "Group.characteristic.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.characteristic.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Group.characteristic.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Group.characteristic.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Group.characteristic.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept", "boolean", "Quantity", "Range", "Reference", "uri", "Expression"}},
+	{Path: "Group.characteristic.exclude", Name: "exclude", Field: 6, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "Group.characteristic.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Group.characteristic.method", Name: "method", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Group.characteristic.formula", Name: "formula", Field: 11, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "Group.characteristic.determiner", Name: "determiner", Field: 12, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Group.characteristic.offset", Name: "offset", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Group.characteristic.instances[x]", Name: "instances", Field: 14, Min: 0, Max: "1", Types: []string{"unsignedInt", "Range"}},
+	{Path: "Group.characteristic.duration[x]", Name: "duration", Field: 16, Min: 0, Max: "1", Types: []string{"Duration", "Range"}},
+	{Path: "Group.characteristic.period", Name: "period", Field: 17, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Group.characteristic.timing", Name: "timing", Field: 18, Min: 0, Max: "*", Types: []string{"RelativeTime"}},
+}
+
+func (r *GroupCharacteristic) ElementMetadata() []ElementMetadata {
+	return groupCharacteristicElementMetadata
+}
+
+// GroupCharacteristicValue is the type of Group.characteristic.value[x]. It is implemented by the GroupCharacteristicValue* variant types.
+type GroupCharacteristicValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGroupCharacteristicValue()
+}
+
+// GroupCharacteristicValueCodeableConcept is the CodeableConcept variant of GroupCharacteristicValue.
+type GroupCharacteristicValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v GroupCharacteristicValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v GroupCharacteristicValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v GroupCharacteristicValueCodeableConcept) isGroupCharacteristicValue() {}
+
+// GroupCharacteristicValueBoolean is the boolean variant of GroupCharacteristicValue.
+type GroupCharacteristicValueBoolean bool
+
+func (v GroupCharacteristicValueBoolean) Validate() error {
+	return nil
+}
+
+func (v GroupCharacteristicValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GroupCharacteristicValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v GroupCharacteristicValueBoolean) isGroupCharacteristicValue() {}
+
+// GroupCharacteristicValueQuantity is the Quantity variant of GroupCharacteristicValue.
+type GroupCharacteristicValueQuantity struct {
+	Quantity
+}
+
+func (v GroupCharacteristicValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v GroupCharacteristicValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v GroupCharacteristicValueQuantity) isGroupCharacteristicValue() {}
+
+// GroupCharacteristicValueRange is the Range variant of GroupCharacteristicValue.
+type GroupCharacteristicValueRange struct {
+	Range
+}
+
+func (v GroupCharacteristicValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v GroupCharacteristicValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v GroupCharacteristicValueRange) isGroupCharacteristicValue() {}
+
+// GroupCharacteristicValueReference is the Reference variant of GroupCharacteristicValue.
+type GroupCharacteristicValueReference struct {
+	Reference
+}
+
+func (v GroupCharacteristicValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v GroupCharacteristicValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v GroupCharacteristicValueReference) isGroupCharacteristicValue() {}
+
+// GroupCharacteristicValueUri is the uri variant of GroupCharacteristicValue.
+type GroupCharacteristicValueUri string
+
+func (v GroupCharacteristicValueUri) Validate() error {
+	return nil
+}
+
+func (v GroupCharacteristicValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GroupCharacteristicValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v GroupCharacteristicValueUri) isGroupCharacteristicValue() {}
+
+// GroupCharacteristicValueExpression is the Expression variant of GroupCharacteristicValue.
+type GroupCharacteristicValueExpression struct {
+	Expression
+}
+
+func (v GroupCharacteristicValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v GroupCharacteristicValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v GroupCharacteristicValueExpression) isGroupCharacteristicValue() {}
+
+var groupCharacteristicValueVariants = []GroupCharacteristicValue{
+	GroupCharacteristicValueCodeableConcept{},
+	GroupCharacteristicValueBoolean(false),
+	GroupCharacteristicValueQuantity{},
+	GroupCharacteristicValueRange{},
+	GroupCharacteristicValueReference{},
+	GroupCharacteristicValueUri(""),
+	GroupCharacteristicValueExpression{},
+}
+
+// GroupCharacteristicInstances is the type of Group.characteristic.instances[x]. It is implemented by the GroupCharacteristicInstances* variant types.
+type GroupCharacteristicInstances interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGroupCharacteristicInstances()
+}
+
+// GroupCharacteristicInstancesUnsignedInt is the unsignedInt variant of GroupCharacteristicInstances.
+type GroupCharacteristicInstancesUnsignedInt int
+
+func (v GroupCharacteristicInstancesUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v GroupCharacteristicInstancesUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GroupCharacteristicInstancesUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v GroupCharacteristicInstancesUnsignedInt) isGroupCharacteristicInstances() {}
+
+// GroupCharacteristicInstancesRange is the Range variant of GroupCharacteristicInstances.
+type GroupCharacteristicInstancesRange struct {
+	Range
+}
+
+func (v GroupCharacteristicInstancesRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v GroupCharacteristicInstancesRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicInstancesRange) FHIRType() string {
+	return "Range"
+}
+
+func (v GroupCharacteristicInstancesRange) isGroupCharacteristicInstances() {}
+
+var groupCharacteristicInstancesVariants = []GroupCharacteristicInstances{
+	GroupCharacteristicInstancesUnsignedInt(0),
+	GroupCharacteristicInstancesRange{},
+}
+
+// GroupCharacteristicDuration is the type of Group.characteristic.duration[x]. It is implemented by the GroupCharacteristicDuration* variant types.
+type GroupCharacteristicDuration interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGroupCharacteristicDuration()
+}
+
+// GroupCharacteristicDurationDuration is the Duration variant of GroupCharacteristicDuration.
+type GroupCharacteristicDurationDuration struct {
+	Duration
+}
+
+func (v GroupCharacteristicDurationDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v GroupCharacteristicDurationDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicDurationDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v GroupCharacteristicDurationDuration) isGroupCharacteristicDuration() {}
+
+// GroupCharacteristicDurationRange is the Range variant of GroupCharacteristicDuration.
+type GroupCharacteristicDurationRange struct {
+	Range
+}
+
+func (v GroupCharacteristicDurationRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v GroupCharacteristicDurationRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v GroupCharacteristicDurationRange) FHIRType() string {
+	return "Range"
+}
+
+func (v GroupCharacteristicDurationRange) isGroupCharacteristicDuration() {}
+
+var groupCharacteristicDurationVariants = []GroupCharacteristicDuration{
+	GroupCharacteristicDurationDuration{},
+	GroupCharacteristicDurationRange{},
+}
+
 type GroupMember struct {
-	Id          *string           `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Entity      *Reference        `json:"entity" bson:"entity"`                               // Reference to the group member
-	Involvement []CodeableConcept `json:"involvement,omitempty" bson:"involvement,omitempty"` // Code that describes how user is part of the group
-	Period      *Period           `json:"period,omitempty" bson:"period,omitempty"`           // Period member belonged to the group
-	Inactive    *bool             `json:"inactive,omitempty" bson:"inactive,omitempty"`       // If member is no longer in group
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Entity            *Reference        `json:"entity" bson:"entity"`                                            // Reference to the group member
+	Involvement       []CodeableConcept `json:"involvement,omitempty" bson:"involvement,omitempty"`              // Code that describes how user is part of the group
+	Period            *Period           `json:"period,omitempty" bson:"period,omitempty"`                        // Period member belonged to the group
+	Inactive          *bool             `json:"inactive,omitempty" bson:"inactive,omitempty"`                    // If member is no longer in group
+	InactiveElement   *Element          `json:"_inactive,omitempty" bson:"inactive_element,omitempty"`           // Extensions for inactive
 }
 
+var groupMemberEntityTargets = []string{"BiologicallyDerivedProduct", "CareTeam", "Device", "Group", "HealthcareService", "Location", "Medication", "MedicinalProductDefinition", "NutritionProduct", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson", "Specimen", "Substance", "SubstanceDefinition"}
+
 func (r *GroupMember) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Entity == nil {
 		return fmt.Errorf("field 'Entity' is required")
 	}
+	if r.Entity != nil {
+		if target := invalidReferenceTarget(r.Entity.Reference, r.Entity.Type, groupMemberEntityTargets); target != "" {
+			return fmt.Errorf("field 'Entity' cannot refer to %s, expected one of %v", target, groupMemberEntityTargets)
+		}
+	}
 	if r.Entity != nil {
 		if err := r.Entity.Validate(); err != nil {
 			return fmt.Errorf("Entity: %w", err)
@@ -257,5 +1248,77 @@ func (r *GroupMember) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.InactiveElement != nil {
+		if err := r.InactiveElement.Validate(); err != nil {
+			return fmt.Errorf("InactiveElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *GroupMember) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Entity == nil {
+		issues.add("required", path+".entity", "field 'Entity' is required")
+	}
+	if r.Entity != nil {
+		if target := invalidReferenceTarget(r.Entity.Reference, r.Entity.Type, groupMemberEntityTargets); target != "" {
+			issues.add("value", path+".entity", fmt.Sprintf("field 'Entity' cannot refer to %s, expected one of %v", target, groupMemberEntityTargets))
+		}
+	}
+	if r.Entity != nil {
+		r.Entity.validateAll(path+".entity", issues)
+	}
+	for i, item := range r.Involvement {
+		item.validateAll(fmt.Sprintf("%s.involvement[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.InactiveElement != nil {
+		r.InactiveElement.validateAll(path+".inactive", issues)
+	}
+}
+
+var groupMemberInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *GroupMember) invariants() []invariant {
+	return groupMemberInvariants
+}
+
+func (r GroupMember) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *GroupMember) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r GroupMember) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *GroupMember) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var groupMemberElementMetadata = []ElementMetadata{
+	{Path: "Group.member.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Group.member.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Group.member.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Group.member.entity", Name: "entity", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition"}},
+	{Path: "Group.member.involvement", Name: "involvement", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Group.member.period", Name: "period", Field: 5, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Group.member.inactive", Name: "inactive", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *GroupMember) ElementMetadata() []ElementMetadata {
+	return groupMemberElementMetadata
+}
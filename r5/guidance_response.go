@@ -2,37 +2,47 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A guidance response is the formal response to a guidance request, including any output parameters returned by the evaluation, as well as the description of any proposed actions to be taken.
 type GuidanceResponse struct {
-	ResourceType          string              `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                    *string             `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta                  *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules         *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language              *string             `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text                  *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	RequestIdentifier     *Identifier         `json:"requestIdentifier,omitempty" bson:"request_identifier,omitempty"`    // The identifier of the request associated with this response, if any
-	Identifier            []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Business identifier for guidance response
-	ModuleUri             *string             `json:"moduleUri" bson:"module_uri"`                                        // What guidance was requested
-	ModuleCanonical       *string             `json:"moduleCanonical" bson:"module_canonical"`                            // What guidance was requested
-	ModuleCodeableConcept *CodeableConcept    `json:"moduleCodeableConcept" bson:"module_codeable_concept"`               // What guidance was requested
-	Status                string              `json:"status" bson:"status"`                                               // success | data-requested | data-required | in-progress | failure | entered-in-error
-	Subject               *Reference          `json:"subject,omitempty" bson:"subject,omitempty"`                         // Individual service was done for/to
-	Encounter             *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Encounter the guidance response is part of
-	OccurrenceDateTime    *string             `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // When the guidance response was processed
-	Performer             *Reference          `json:"performer,omitempty" bson:"performer,omitempty"`                     // Device returning the guidance
-	Location              *Reference          `json:"location,omitempty" bson:"location,omitempty"`                       // Where guidance response occurred
-	Reason                []CodeableReference `json:"reason,omitempty" bson:"reason,omitempty"`                           // Why guidance is needed
-	Note                  []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                               // Additional notes about the response
-	EvaluationMessage     *Reference          `json:"evaluationMessage,omitempty" bson:"evaluation_message,omitempty"`    // Messages resulting from the evaluation of the artifact or artifacts
-	OutputParameters      *Reference          `json:"outputParameters,omitempty" bson:"output_parameters,omitempty"`      // The output parameters of the evaluation, if any
-	Result                []Reference         `json:"result,omitempty" bson:"result,omitempty"`                           // Proposed actions, if any
-	DataRequirement       []DataRequirement   `json:"dataRequirement,omitempty" bson:"data_requirement,omitempty"`        // Additional required data
+	ResourceType              string                 `json:"resourceType" bson:"resource_type"`                                           // Type of resource
+	Id                        *string                `json:"id,omitempty" bson:"id,omitempty"`                                            // Logical id of this artifact
+	Meta                      *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                                        // Metadata about the resource
+	ImplicitRules             *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                     // A set of rules under which this content was created
+	ImplicitRulesElement      *Element               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`            // Extensions for implicitRules
+	Language                  *string                `json:"language,omitempty" bson:"language,omitempty"`                                // Language of the resource content
+	LanguageElement           *Element               `json:"_language,omitempty" bson:"language_element,omitempty"`                       // Extensions for language
+	Text                      *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                                        // Text summary of the resource, for human interpretation
+	Contained                 []Resource             `json:"contained,omitempty" bson:"contained,omitempty"`                              // Contained, inline Resources
+	Extension                 []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                              // Additional content defined by implementations
+	ModifierExtension         []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`             // Extensions that cannot be ignored
+	RequestIdentifier         *Identifier            `json:"requestIdentifier,omitempty" bson:"request_identifier,omitempty"`             // The identifier of the request associated with this response, if any
+	Identifier                []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                            // Business identifier for guidance response
+	Module                    GuidanceResponseModule `json:"-" bson:"module,omitempty"`                                                   // What guidance was requested
+	ModuleElement             *Element               `json:"-" bson:"module_element,omitempty"`                                           // Extensions for module[x]
+	Status                    GuidanceResponseStatus `json:"status" bson:"status"`                                                        // success | data-requested | data-required | in-progress | failure | entered-in-error
+	StatusElement             *Element               `json:"_status,omitempty" bson:"status_element,omitempty"`                           // Extensions for status
+	Subject                   *Reference             `json:"subject,omitempty" bson:"subject,omitempty"`                                  // Individual service was done for/to
+	Encounter                 *Reference             `json:"encounter,omitempty" bson:"encounter,omitempty"`                              // Encounter the guidance response is part of
+	OccurrenceDateTime        *DateTime              `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"`          // When the guidance response was processed
+	OccurrenceDateTimeElement *Element               `json:"_occurrenceDateTime,omitempty" bson:"occurrence_date_time_element,omitempty"` // Extensions for occurrenceDateTime
+	Performer                 *Reference             `json:"performer,omitempty" bson:"performer,omitempty"`                              // Device returning the guidance
+	Location                  *Reference             `json:"location,omitempty" bson:"location,omitempty"`                                // Where guidance response occurred
+	Reason                    []CodeableReference    `json:"reason,omitempty" bson:"reason,omitempty"`                                    // Why guidance is needed
+	Note                      []Annotation           `json:"note,omitempty" bson:"note,omitempty"`                                        // Additional notes about the response
+	EvaluationMessage         *Reference             `json:"evaluationMessage,omitempty" bson:"evaluation_message,omitempty"`             // Messages resulting from the evaluation of the artifact or artifacts
+	OutputParameters          *Reference             `json:"outputParameters,omitempty" bson:"output_parameters,omitempty"`               // The output parameters of the evaluation, if any
+	Result                    []Reference            `json:"result,omitempty" bson:"result,omitempty"`                                    // Proposed actions, if any
+	DataRequirement           []DataRequirement      `json:"dataRequirement,omitempty" bson:"data_requirement,omitempty"`                 // Additional required data
+
+	moduleVariants []string // JSON properties of GuidanceResponse.module[x] when more than one was decoded
 }
 
+var guidanceResponseSubjectTargets = []string{"Group", "Patient"}
+
 func (r *GuidanceResponse) Validate() error {
 	if r.ResourceType != "GuidanceResponse" {
 		return fmt.Errorf("invalid resourceType: expected 'GuidanceResponse', got '%s'", r.ResourceType)
@@ -42,11 +52,39 @@ func (r *GuidanceResponse) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.RequestIdentifier != nil {
 		if err := r.RequestIdentifier.Validate(); err != nil {
 			return fmt.Errorf("RequestIdentifier: %w", err)
@@ -57,24 +95,38 @@ func (r *GuidanceResponse) Validate() error {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.ModuleUri == nil {
-		return fmt.Errorf("field 'ModuleUri' is required")
+	if len(r.moduleVariants) > 1 {
+		return fmt.Errorf("field 'Module' must have a single type, got %v", r.moduleVariants)
 	}
-	if r.ModuleCanonical == nil {
-		return fmt.Errorf("field 'ModuleCanonical' is required")
+	if r.Module == nil {
+		return fmt.Errorf("field 'Module' is required")
 	}
-	if r.ModuleCodeableConcept == nil {
-		return fmt.Errorf("field 'ModuleCodeableConcept' is required")
+	if r.Module != nil {
+		if err := r.Module.Validate(); err != nil {
+			return fmt.Errorf("Module: %w", err)
+		}
 	}
-	if r.ModuleCodeableConcept != nil {
-		if err := r.ModuleCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ModuleCodeableConcept: %w", err)
+	if r.ModuleElement != nil {
+		if err := r.ModuleElement.Validate(); err != nil {
+			return fmt.Errorf("ModuleElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, guidanceResponseSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, guidanceResponseSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -85,6 +137,16 @@ func (r *GuidanceResponse) Validate() error {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.OccurrenceDateTime != nil {
+		if err := r.OccurrenceDateTime.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceDateTime: %w", err)
+		}
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		if err := r.OccurrenceDateTimeElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceDateTimeElement: %w", err)
+		}
+	}
 	if r.Performer != nil {
 		if err := r.Performer.Validate(); err != nil {
 			return fmt.Errorf("Performer: %w", err)
@@ -125,5 +187,342 @@ func (r *GuidanceResponse) Validate() error {
 			return fmt.Errorf("DataRequirement[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "GuidanceResponse")
+}
+
+func (r *GuidanceResponse) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "GuidanceResponse" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'GuidanceResponse', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.RequestIdentifier != nil {
+		r.RequestIdentifier.validateAll(path+".requestIdentifier", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if len(r.moduleVariants) > 1 {
+		issues.add("structure", path+".module", fmt.Sprintf("field 'Module' must have a single type, got %v", r.moduleVariants))
+	}
+	if r.Module == nil {
+		issues.add("required", path+".module", "field 'Module' is required")
+	}
+	if r.Module != nil {
+		r.Module.validateAll(path+".module.ofType("+r.Module.FHIRType()+")", issues)
+	}
+	if r.ModuleElement != nil {
+		r.ModuleElement.validateAll(path+".module", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, guidanceResponseSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, guidanceResponseSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.OccurrenceDateTime != nil {
+		if err := r.OccurrenceDateTime.Validate(); err != nil {
+			issues.add("value", path+".occurrenceDateTime", err.Error())
+		}
+	}
+	if r.OccurrenceDateTimeElement != nil {
+		r.OccurrenceDateTimeElement.validateAll(path+".occurrenceDateTime", issues)
+	}
+	if r.Performer != nil {
+		r.Performer.validateAll(path+".performer", issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.EvaluationMessage != nil {
+		r.EvaluationMessage.validateAll(path+".evaluationMessage", issues)
+	}
+	if r.OutputParameters != nil {
+		r.OutputParameters.validateAll(path+".outputParameters", issues)
+	}
+	for i, item := range r.Result {
+		item.validateAll(fmt.Sprintf("%s.result[%d]", path, i), issues)
+	}
+	for i, item := range r.DataRequirement {
+		item.validateAll(fmt.Sprintf("%s.dataRequirement[%d]", path, i), issues)
+	}
+}
+
+func (r *GuidanceResponse) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("GuidanceResponse", &issues)
+	checkInvariants(r, "GuidanceResponse", &issues)
+	return issues
+}
+
+var guidanceResponseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *GuidanceResponse) invariants() []invariant {
+	return guidanceResponseInvariants
+}
+
+func (r GuidanceResponse) MarshalJSON() ([]byte, error) {
+	type alias GuidanceResponse
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "module", r.Module, r.ModuleElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *GuidanceResponse) UnmarshalJSON(data []byte) error {
+	type alias GuidanceResponse
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Module, element, r.moduleVariants, err = unmarshalChoice(fields, "module", guidanceResponseModuleVariants); err != nil {
+		return err
+	}
+	r.ModuleElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ModuleElement); err != nil {
+			return fmt.Errorf("_module: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r GuidanceResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *GuidanceResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r GuidanceResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *GuidanceResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *GuidanceResponse) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Module":
+		return "module", choiceValues(guidanceResponseModuleVariants)
+	}
+	return "", nil
+}
+
+var guidanceResponseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *GuidanceResponse) elementTypes() map[string]string {
+	return guidanceResponseElementTypes
+}
+
+var guidanceResponseElementMetadata = []ElementMetadata{
+	{Path: "GuidanceResponse.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "GuidanceResponse.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "GuidanceResponse.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "GuidanceResponse.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "GuidanceResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "GuidanceResponse.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "GuidanceResponse.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "GuidanceResponse.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "GuidanceResponse.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "GuidanceResponse.requestIdentifier", Name: "requestIdentifier", Field: 11, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "GuidanceResponse.identifier", Name: "identifier", Field: 12, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "GuidanceResponse.module[x]", Name: "module", Field: 13, Min: 1, Max: "1", Types: []string{"uri", "canonical", "CodeableConcept"}},
+	{Path: "GuidanceResponse.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "GuidanceResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/guidance-response-status"}},
+	{Path: "GuidanceResponse.subject", Name: "subject", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "GuidanceResponse.encounter", Name: "encounter", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "GuidanceResponse.occurrenceDateTime", Name: "occurrenceDateTime", Field: 19, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "GuidanceResponse.performer", Name: "performer", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "GuidanceResponse.location", Name: "location", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "GuidanceResponse.reason", Name: "reason", Field: 23, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "GuidanceResponse.note", Name: "note", Field: 24, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "GuidanceResponse.evaluationMessage", Name: "evaluationMessage", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "GuidanceResponse.outputParameters", Name: "outputParameters", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "GuidanceResponse.result", Name: "result", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "GuidanceResponse.dataRequirement", Name: "dataRequirement", Field: 28, Min: 0, Max: "*", Types: []string{"DataRequirement"}},
+}
+
+func (r *GuidanceResponse) ElementMetadata() []ElementMetadata {
+	return guidanceResponseElementMetadata
+}
+
+// GuidanceResponseModule is the type of GuidanceResponse.module[x]. It is implemented by the GuidanceResponseModule* variant types.
+type GuidanceResponseModule interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isGuidanceResponseModule()
+}
+
+// GuidanceResponseModuleUri is the uri variant of GuidanceResponseModule.
+type GuidanceResponseModuleUri string
+
+func (v GuidanceResponseModuleUri) Validate() error {
+	return nil
+}
+
+func (v GuidanceResponseModuleUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GuidanceResponseModuleUri) FHIRType() string {
+	return "uri"
+}
+
+func (v GuidanceResponseModuleUri) isGuidanceResponseModule() {}
+
+// GuidanceResponseModuleCanonical is the canonical variant of GuidanceResponseModule.
+type GuidanceResponseModuleCanonical string
+
+func (v GuidanceResponseModuleCanonical) Validate() error {
 	return nil
 }
+
+func (v GuidanceResponseModuleCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v GuidanceResponseModuleCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v GuidanceResponseModuleCanonical) isGuidanceResponseModule() {}
+
+// GuidanceResponseModuleCodeableConcept is the CodeableConcept variant of GuidanceResponseModule.
+type GuidanceResponseModuleCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v GuidanceResponseModuleCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v GuidanceResponseModuleCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v GuidanceResponseModuleCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v GuidanceResponseModuleCodeableConcept) isGuidanceResponseModule() {}
+
+var guidanceResponseModuleVariants = []GuidanceResponseModule{
+	GuidanceResponseModuleUri(""),
+	GuidanceResponseModuleCanonical(""),
+	GuidanceResponseModuleCodeableConcept{},
+}
+
+func (r *GuidanceResponse) GetResourceType() string {
+	return "GuidanceResponse"
+}
+
+func (r *GuidanceResponse) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *GuidanceResponse) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *GuidanceResponse) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *GuidanceResponse) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *GuidanceResponse) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *GuidanceResponse) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *GuidanceResponse) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*GuidanceResponse)(nil)
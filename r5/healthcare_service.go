@@ -2,44 +2,65 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The details of a healthcare service available at a location or in a catalog.  In the case where there is a hierarchy of services (for example, Lab -> Pathology -> Wound Cultures), this can be represented using a set of linked HealthcareServices.
 type HealthcareService struct {
-	ResourceType         string                         `json:"resourceType" bson:"resource_type"`                                      // Type of resource
-	Id                   *string                        `json:"id,omitempty" bson:"id,omitempty"`                                       // Logical id of this artifact
-	Meta                 *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                   // Metadata about the resource
-	ImplicitRules        *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                // A set of rules under which this content was created
-	Language             *string                        `json:"language,omitempty" bson:"language,omitempty"`                           // Language of the resource content
-	Text                 *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                   // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                         // Contained, inline Resources
-	Identifier           []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // External identifiers for this item
-	Active               *bool                          `json:"active,omitempty" bson:"active,omitempty"`                               // Whether this HealthcareService record is in active use
-	ProvidedBy           *Reference                     `json:"providedBy,omitempty" bson:"provided_by,omitempty"`                      // Organization that provides this service
-	OfferedIn            []Reference                    `json:"offeredIn,omitempty" bson:"offered_in,omitempty"`                        // The service within which this service is offered
-	Category             []CodeableConcept              `json:"category,omitempty" bson:"category,omitempty"`                           // Broad category of service being performed or delivered
-	Type                 []CodeableConcept              `json:"type,omitempty" bson:"type,omitempty"`                                   // Type of service that may be delivered or performed
-	Specialty            []CodeableConcept              `json:"specialty,omitempty" bson:"specialty,omitempty"`                         // Specialties handled by the HealthcareService
-	Location             []Reference                    `json:"location,omitempty" bson:"location,omitempty"`                           // Location(s) where service may be provided
-	Name                 *string                        `json:"name,omitempty" bson:"name,omitempty"`                                   // Description of service as presented to a consumer while searching
-	Comment              *string                        `json:"comment,omitempty" bson:"comment,omitempty"`                             // Additional description and/or any specific issues not covered elsewhere
-	ExtraDetails         *string                        `json:"extraDetails,omitempty" bson:"extra_details,omitempty"`                  // Extra details about the service that can't be placed in the other fields
-	Photo                *Attachment                    `json:"photo,omitempty" bson:"photo,omitempty"`                                 // Facilitates quick identification of the service
-	Contact              []ExtendedContactDetail        `json:"contact,omitempty" bson:"contact,omitempty"`                             // Official contact details for the HealthcareService
-	CoverageArea         []Reference                    `json:"coverageArea,omitempty" bson:"coverage_area,omitempty"`                  // Location(s) service is intended for/available to
-	ServiceProvisionCode []CodeableConcept              `json:"serviceProvisionCode,omitempty" bson:"service_provision_code,omitempty"` // Conditions under which service is available/offered
-	Eligibility          []HealthcareServiceEligibility `json:"eligibility,omitempty" bson:"eligibility,omitempty"`                     // Specific eligibility requirements required to use the service
-	Program              []CodeableConcept              `json:"program,omitempty" bson:"program,omitempty"`                             // Programs that this service is applicable to
-	Characteristic       []CodeableConcept              `json:"characteristic,omitempty" bson:"characteristic,omitempty"`               // Collection of characteristics (attributes)
-	Communication        []CodeableConcept              `json:"communication,omitempty" bson:"communication,omitempty"`                 // The language that this service is offered in
-	ReferralMethod       []CodeableConcept              `json:"referralMethod,omitempty" bson:"referral_method,omitempty"`              // Ways that the service accepts referrals
-	ReferralRequired     *bool                          `json:"referralRequired,omitempty" bson:"referral_required,omitempty"`          // A referral is required for access to this service
-	AppointmentRequired  *bool                          `json:"appointmentRequired,omitempty" bson:"appointment_required,omitempty"`    // An appointment is required for access to this service
-	Availability         *Availability                  `json:"availability,omitempty" bson:"availability,omitempty"`                   // Times the healthcare service is available (including exceptions)
-	Endpoint             []Reference                    `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                           // Technical endpoints providing access to electronic services operated for the healthcare service
+	ResourceType               string                         `json:"resourceType" bson:"resource_type"`                                            // Type of resource
+	Id                         *string                        `json:"id,omitempty" bson:"id,omitempty"`                                             // Logical id of this artifact
+	Meta                       *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                         // Metadata about the resource
+	ImplicitRules              *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                      // A set of rules under which this content was created
+	ImplicitRulesElement       *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`             // Extensions for implicitRules
+	Language                   *string                        `json:"language,omitempty" bson:"language,omitempty"`                                 // Language of the resource content
+	LanguageElement            *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`                        // Extensions for language
+	Text                       *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                         // Text summary of the resource, for human interpretation
+	Contained                  []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
+	Extension                  []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored
+	Identifier                 []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                             // External identifiers for this item
+	Active                     *bool                          `json:"active,omitempty" bson:"active,omitempty"`                                     // Whether this HealthcareService record is in active use
+	ActiveElement              *Element                       `json:"_active,omitempty" bson:"active_element,omitempty"`                            // Extensions for active
+	ProvidedBy                 *Reference                     `json:"providedBy,omitempty" bson:"provided_by,omitempty"`                            // Organization that provides this service
+	OfferedIn                  []Reference                    `json:"offeredIn,omitempty" bson:"offered_in,omitempty"`                              // The service within which this service is offered
+	Category                   []CodeableConcept              `json:"category,omitempty" bson:"category,omitempty"`                                 // Broad category of service being performed or delivered
+	Type                       []CodeableConcept              `json:"type,omitempty" bson:"type,omitempty"`                                         // Type of service that may be delivered or performed
+	Specialty                  []CodeableConcept              `json:"specialty,omitempty" bson:"specialty,omitempty"`                               // Specialties handled by the HealthcareService
+	Location                   []Reference                    `json:"location,omitempty" bson:"location,omitempty"`                                 // Location(s) where service may be provided
+	Name                       *string                        `json:"name,omitempty" bson:"name,omitempty"`                                         // Description of service as presented to a consumer while searching
+	NameElement                *Element                       `json:"_name,omitempty" bson:"name_element,omitempty"`                                // Extensions for name
+	Comment                    *string                        `json:"comment,omitempty" bson:"comment,omitempty"`                                   // Additional description and/or any specific issues not covered elsewhere
+	CommentElement             *Element                       `json:"_comment,omitempty" bson:"comment_element,omitempty"`                          // Extensions for comment
+	ExtraDetails               *string                        `json:"extraDetails,omitempty" bson:"extra_details,omitempty"`                        // Extra details about the service that can't be placed in the other fields
+	ExtraDetailsElement        *Element                       `json:"_extraDetails,omitempty" bson:"extra_details_element,omitempty"`               // Extensions for extraDetails
+	Photo                      *Attachment                    `json:"photo,omitempty" bson:"photo,omitempty"`                                       // Facilitates quick identification of the service
+	Contact                    []ExtendedContactDetail        `json:"contact,omitempty" bson:"contact,omitempty"`                                   // Official contact details for the HealthcareService
+	CoverageArea               []Reference                    `json:"coverageArea,omitempty" bson:"coverage_area,omitempty"`                        // Location(s) service is intended for/available to
+	ServiceProvisionCode       []CodeableConcept              `json:"serviceProvisionCode,omitempty" bson:"service_provision_code,omitempty"`       // Conditions under which service is available/offered
+	Eligibility                []HealthcareServiceEligibility `json:"eligibility,omitempty" bson:"eligibility,omitempty"`                           // Specific eligibility requirements required to use the service
+	Program                    []CodeableConcept              `json:"program,omitempty" bson:"program,omitempty"`                                   // Programs that this service is applicable to
+	Characteristic             []CodeableConcept              `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                     // Collection of characteristics (attributes)
+	Communication              []CodeableConcept              `json:"communication,omitempty" bson:"communication,omitempty"`                       // The language that this service is offered in
+	ReferralMethod             []CodeableConcept              `json:"referralMethod,omitempty" bson:"referral_method,omitempty"`                    // Ways that the service accepts referrals
+	ReferralRequired           *bool                          `json:"referralRequired,omitempty" bson:"referral_required,omitempty"`                // A referral is required for access to this service
+	ReferralRequiredElement    *Element                       `json:"_referralRequired,omitempty" bson:"referral_required_element,omitempty"`       // Extensions for referralRequired
+	AppointmentRequired        *bool                          `json:"appointmentRequired,omitempty" bson:"appointment_required,omitempty"`          // An appointment is required for access to this service
+	AppointmentRequiredElement *Element                       `json:"_appointmentRequired,omitempty" bson:"appointment_required_element,omitempty"` // Extensions for appointmentRequired
+	Availability               *Availability                  `json:"availability,omitempty" bson:"availability,omitempty"`                         // Times the healthcare service is available (including exceptions)
+	Endpoint                   []Reference                    `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                                 // Technical endpoints providing access to electronic services operated for the healthcare service
 }
 
+var healthcareServiceProvidedByTargets = []string{"Organization"}
+
+var healthcareServiceOfferedInTargets = []string{"HealthcareService"}
+
+var healthcareServiceLocationTargets = []string{"Location"}
+
+var healthcareServiceCoverageAreaTargets = []string{"Location"}
+
+var healthcareServiceEndpointTargets = []string{"Endpoint"}
+
 func (r *HealthcareService) Validate() error {
 	if r.ResourceType != "HealthcareService" {
 		return fmt.Errorf("invalid resourceType: expected 'HealthcareService', got '%s'", r.ResourceType)
@@ -49,21 +70,64 @@ func (r *HealthcareService) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
+	if r.ProvidedBy != nil {
+		if target := invalidReferenceTarget(r.ProvidedBy.Reference, r.ProvidedBy.Type, healthcareServiceProvidedByTargets); target != "" {
+			return fmt.Errorf("field 'ProvidedBy' cannot refer to %s, expected one of %v", target, healthcareServiceProvidedByTargets)
+		}
+	}
 	if r.ProvidedBy != nil {
 		if err := r.ProvidedBy.Validate(); err != nil {
 			return fmt.Errorf("ProvidedBy: %w", err)
 		}
 	}
+	for i, item := range r.OfferedIn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceOfferedInTargets); target != "" {
+			return fmt.Errorf("field 'OfferedIn[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceOfferedInTargets)
+		}
+	}
 	for i, item := range r.OfferedIn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("OfferedIn[%d]: %w", i, err)
@@ -84,11 +148,31 @@ func (r *HealthcareService) Validate() error {
 			return fmt.Errorf("Specialty[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceLocationTargets)
+		}
+	}
 	for i, item := range r.Location {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Location[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	if r.ExtraDetailsElement != nil {
+		if err := r.ExtraDetailsElement.Validate(); err != nil {
+			return fmt.Errorf("ExtraDetailsElement: %w", err)
+		}
+	}
 	if r.Photo != nil {
 		if err := r.Photo.Validate(); err != nil {
 			return fmt.Errorf("Photo: %w", err)
@@ -99,6 +183,11 @@ func (r *HealthcareService) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.CoverageArea {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceCoverageAreaTargets); target != "" {
+			return fmt.Errorf("field 'CoverageArea[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceCoverageAreaTargets)
+		}
+	}
 	for i, item := range r.CoverageArea {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("CoverageArea[%d]: %w", i, err)
@@ -134,55 +223,354 @@ func (r *HealthcareService) Validate() error {
 			return fmt.Errorf("ReferralMethod[%d]: %w", i, err)
 		}
 	}
+	if r.ReferralRequiredElement != nil {
+		if err := r.ReferralRequiredElement.Validate(); err != nil {
+			return fmt.Errorf("ReferralRequiredElement: %w", err)
+		}
+	}
+	if r.AppointmentRequiredElement != nil {
+		if err := r.AppointmentRequiredElement.Validate(); err != nil {
+			return fmt.Errorf("AppointmentRequiredElement: %w", err)
+		}
+	}
 	if r.Availability != nil {
 		if err := r.Availability.Validate(); err != nil {
 			return fmt.Errorf("Availability: %w", err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "HealthcareService")
+}
+
+func (r *HealthcareService) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "HealthcareService" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'HealthcareService', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	if r.ProvidedBy != nil {
+		if target := invalidReferenceTarget(r.ProvidedBy.Reference, r.ProvidedBy.Type, healthcareServiceProvidedByTargets); target != "" {
+			issues.add("value", path+".providedBy", fmt.Sprintf("field 'ProvidedBy' cannot refer to %s, expected one of %v", target, healthcareServiceProvidedByTargets))
+		}
+	}
+	if r.ProvidedBy != nil {
+		r.ProvidedBy.validateAll(path+".providedBy", issues)
+	}
+	for i, item := range r.OfferedIn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceOfferedInTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.offeredIn[%d]", path, i), fmt.Sprintf("field 'OfferedIn[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceOfferedInTargets))
+		}
+	}
+	for i, item := range r.OfferedIn {
+		item.validateAll(fmt.Sprintf("%s.offeredIn[%d]", path, i), issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.Specialty {
+		item.validateAll(fmt.Sprintf("%s.specialty[%d]", path, i), issues)
+	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceLocationTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.location[%d]", path, i), fmt.Sprintf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceLocationTargets))
+		}
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.ExtraDetailsElement != nil {
+		r.ExtraDetailsElement.validateAll(path+".extraDetails", issues)
+	}
+	if r.Photo != nil {
+		r.Photo.validateAll(path+".photo", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.CoverageArea {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceCoverageAreaTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.coverageArea[%d]", path, i), fmt.Sprintf("field 'CoverageArea[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceCoverageAreaTargets))
+		}
+	}
+	for i, item := range r.CoverageArea {
+		item.validateAll(fmt.Sprintf("%s.coverageArea[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceProvisionCode {
+		item.validateAll(fmt.Sprintf("%s.serviceProvisionCode[%d]", path, i), issues)
+	}
+	for i, item := range r.Eligibility {
+		item.validateAll(fmt.Sprintf("%s.eligibility[%d]", path, i), issues)
+	}
+	for i, item := range r.Program {
+		item.validateAll(fmt.Sprintf("%s.program[%d]", path, i), issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+	for i, item := range r.ReferralMethod {
+		item.validateAll(fmt.Sprintf("%s.referralMethod[%d]", path, i), issues)
+	}
+	if r.ReferralRequiredElement != nil {
+		r.ReferralRequiredElement.validateAll(path+".referralRequired", issues)
+	}
+	if r.AppointmentRequiredElement != nil {
+		r.AppointmentRequiredElement.validateAll(path+".appointmentRequired", issues)
+	}
+	if r.Availability != nil {
+		r.Availability.validateAll(path+".availability", issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, healthcareServiceEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, healthcareServiceEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+}
+
+func (r *HealthcareService) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("HealthcareService", &issues)
+	checkInvariants(r, "HealthcareService", &issues)
+	return issues
+}
+
+var healthcareServiceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *HealthcareService) invariants() []invariant {
+	return healthcareServiceInvariants
+}
+
+func (r *HealthcareService) UnmarshalJSON(data []byte) error {
+	type alias HealthcareService
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r HealthcareService) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *HealthcareService) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r HealthcareService) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *HealthcareService) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var healthcareServiceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"comment":       "markdown",
+	"extraDetails":  "markdown",
+}
+
+func (r *HealthcareService) elementTypes() map[string]string {
+	return healthcareServiceElementTypes
+}
+
+var healthcareServiceElementMetadata = []ElementMetadata{
+	{Path: "HealthcareService.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "HealthcareService.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "HealthcareService.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "HealthcareService.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "HealthcareService.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "HealthcareService.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "HealthcareService.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "HealthcareService.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "HealthcareService.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "HealthcareService.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "HealthcareService.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "HealthcareService.providedBy", Name: "providedBy", Field: 14, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "HealthcareService.offeredIn", Name: "offeredIn", Field: 15, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/HealthcareService"}},
+	{Path: "HealthcareService.category", Name: "category", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.type", Name: "type", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.specialty", Name: "specialty", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.location", Name: "location", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "HealthcareService.name", Name: "name", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "HealthcareService.comment", Name: "comment", Field: 22, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "HealthcareService.extraDetails", Name: "extraDetails", Field: 24, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "HealthcareService.photo", Name: "photo", Field: 26, Min: 0, Max: "1", Types: []string{"Attachment"}},
+	{Path: "HealthcareService.contact", Name: "contact", Field: 27, Min: 0, Max: "*", Types: []string{"ExtendedContactDetail"}},
+	{Path: "HealthcareService.coverageArea", Name: "coverageArea", Field: 28, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "HealthcareService.serviceProvisionCode", Name: "serviceProvisionCode", Field: 29, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.eligibility", Name: "eligibility", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "HealthcareService.program", Name: "program", Field: 31, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.characteristic", Name: "characteristic", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.communication", Name: "communication", Field: 33, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.referralMethod", Name: "referralMethod", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.referralRequired", Name: "referralRequired", Field: 35, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "HealthcareService.appointmentRequired", Name: "appointmentRequired", Field: 37, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "HealthcareService.availability", Name: "availability", Field: 39, Min: 0, Max: "1", Types: []string{"Availability"}},
+	{Path: "HealthcareService.endpoint", Name: "endpoint", Field: 40, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+}
+
+func (r *HealthcareService) ElementMetadata() []ElementMetadata {
+	return healthcareServiceElementMetadata
+}
+
+func (r *HealthcareService) GetResourceType() string {
+	return "HealthcareService"
+}
+
+func (r *HealthcareService) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *HealthcareService) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *HealthcareService) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *HealthcareService) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *HealthcareService) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *HealthcareService) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *HealthcareService) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*HealthcareService)(nil)
+
 type HealthcareServiceEligibility struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Code                 *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                                   // Coded value for the eligibility
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // Value associated with the eligibility code
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // Value associated with the eligibility code
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // Value associated with the eligibility code
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // Value associated with the eligibility code
-	ValueReference       *Reference       `json:"valueReference,omitempty" bson:"value_reference,omitempty"`              // Value associated with the eligibility code
-	Comment              *string          `json:"comment,omitempty" bson:"comment,omitempty"`                             // Describes the eligibility conditions for the service
-	Period               *Period          `json:"period,omitempty" bson:"period,omitempty"`                               // The period this eligibility rule applies
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept                  `json:"code,omitempty" bson:"code,omitempty"`                            // Coded value for the eligibility
+	Value             HealthcareServiceEligibilityValue `json:"-" bson:"value,omitempty"`                                        // Value associated with the eligibility code
+	ValueElement      *Element                          `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Comment           *string                           `json:"comment,omitempty" bson:"comment,omitempty"`                      // Describes the eligibility conditions for the service
+	CommentElement    *Element                          `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
+	Period            *Period                           `json:"period,omitempty" bson:"period,omitempty"`                        // The period this eligibility rule applies
+
+	valueVariants []string // JSON properties of HealthcareService.eligibility.value[x] when more than one was decoded
 }
 
 func (r *HealthcareServiceEligibility) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
 		}
 	}
 	if r.Period != nil {
@@ -192,3 +580,233 @@ func (r *HealthcareServiceEligibility) Validate() error {
 	}
 	return nil
 }
+
+func (r *HealthcareServiceEligibility) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var healthcareServiceEligibilityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *HealthcareServiceEligibility) invariants() []invariant {
+	return healthcareServiceEligibilityInvariants
+}
+
+func (r HealthcareServiceEligibility) MarshalJSON() ([]byte, error) {
+	type alias HealthcareServiceEligibility
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *HealthcareServiceEligibility) UnmarshalJSON(data []byte) error {
+	type alias HealthcareServiceEligibility
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", healthcareServiceEligibilityValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r HealthcareServiceEligibility) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *HealthcareServiceEligibility) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r HealthcareServiceEligibility) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *HealthcareServiceEligibility) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *HealthcareServiceEligibility) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(healthcareServiceEligibilityValueVariants)
+	}
+	return "", nil
+}
+
+var healthcareServiceEligibilityElementTypes = map[string]string{
+	"comment": "markdown",
+}
+
+func (r *HealthcareServiceEligibility) elementTypes() map[string]string {
+	return healthcareServiceEligibilityElementTypes
+}
+
+var healthcareServiceEligibilityElementMetadata = []ElementMetadata{
+	{Path: "HealthcareService.eligibility.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "HealthcareService.eligibility.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "HealthcareService.eligibility.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "HealthcareService.eligibility.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "HealthcareService.eligibility.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "boolean", "Quantity", "Range", "Reference"}},
+	{Path: "HealthcareService.eligibility.comment", Name: "comment", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "HealthcareService.eligibility.period", Name: "period", Field: 8, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *HealthcareServiceEligibility) ElementMetadata() []ElementMetadata {
+	return healthcareServiceEligibilityElementMetadata
+}
+
+// HealthcareServiceEligibilityValue is the type of HealthcareService.eligibility.value[x]. It is implemented by the HealthcareServiceEligibilityValue* variant types.
+type HealthcareServiceEligibilityValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isHealthcareServiceEligibilityValue()
+}
+
+// HealthcareServiceEligibilityValueCodeableConcept is the CodeableConcept variant of HealthcareServiceEligibilityValue.
+type HealthcareServiceEligibilityValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v HealthcareServiceEligibilityValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v HealthcareServiceEligibilityValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v HealthcareServiceEligibilityValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v HealthcareServiceEligibilityValueCodeableConcept) isHealthcareServiceEligibilityValue() {}
+
+// HealthcareServiceEligibilityValueBoolean is the boolean variant of HealthcareServiceEligibilityValue.
+type HealthcareServiceEligibilityValueBoolean bool
+
+func (v HealthcareServiceEligibilityValueBoolean) Validate() error {
+	return nil
+}
+
+func (v HealthcareServiceEligibilityValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v HealthcareServiceEligibilityValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v HealthcareServiceEligibilityValueBoolean) isHealthcareServiceEligibilityValue() {}
+
+// HealthcareServiceEligibilityValueQuantity is the Quantity variant of HealthcareServiceEligibilityValue.
+type HealthcareServiceEligibilityValueQuantity struct {
+	Quantity
+}
+
+func (v HealthcareServiceEligibilityValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v HealthcareServiceEligibilityValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v HealthcareServiceEligibilityValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v HealthcareServiceEligibilityValueQuantity) isHealthcareServiceEligibilityValue() {}
+
+// HealthcareServiceEligibilityValueRange is the Range variant of HealthcareServiceEligibilityValue.
+type HealthcareServiceEligibilityValueRange struct {
+	Range
+}
+
+func (v HealthcareServiceEligibilityValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v HealthcareServiceEligibilityValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v HealthcareServiceEligibilityValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v HealthcareServiceEligibilityValueRange) isHealthcareServiceEligibilityValue() {}
+
+// HealthcareServiceEligibilityValueReference is the Reference variant of HealthcareServiceEligibilityValue.
+type HealthcareServiceEligibilityValueReference struct {
+	Reference
+}
+
+func (v HealthcareServiceEligibilityValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v HealthcareServiceEligibilityValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v HealthcareServiceEligibilityValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v HealthcareServiceEligibilityValueReference) isHealthcareServiceEligibilityValue() {}
+
+var healthcareServiceEligibilityValueVariants = []HealthcareServiceEligibilityValue{
+	HealthcareServiceEligibilityValueCodeableConcept{},
+	HealthcareServiceEligibilityValueBoolean(false),
+	HealthcareServiceEligibilityValueQuantity{},
+	HealthcareServiceEligibilityValueRange{},
+	HealthcareServiceEligibilityValueReference{},
+}
@@ -1,22 +1,78 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // HumanName Type: A name, normally of a human, that can be used for other living entities (e.g. animals but not organizations) that have been assigned names by a human and may need the use of name parts or the need for usage information.
 type HumanName struct {
-	Id     *string  `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Use    *string  `json:"use,omitempty" bson:"use,omitempty"`       // usual | official | temp | nickname | anonymous | old | maiden
-	Text   *string  `json:"text,omitempty" bson:"text,omitempty"`     // Text representation of the full name
-	Family *string  `json:"family,omitempty" bson:"family,omitempty"` // Family name (often called 'Surname')
-	Given  []string `json:"given,omitempty" bson:"given,omitempty"`   // Given names (not always 'first'). Includes middle names
-	Prefix []string `json:"prefix,omitempty" bson:"prefix,omitempty"` // Parts that come before the name
-	Suffix []string `json:"suffix,omitempty" bson:"suffix,omitempty"` // Parts that come after the name
-	Period *Period  `json:"period,omitempty" bson:"period,omitempty"` // Time period when name was/is in use
+	Id            *string     `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
+	Extension     []Extension `json:"extension,omitempty" bson:"extension,omitempty"`    // Additional content defined by implementations
+	Use           *NameUse    `json:"use,omitempty" bson:"use,omitempty"`                // usual | official | temp | nickname | anonymous | old | maiden
+	UseElement    *Element    `json:"_use,omitempty" bson:"use_element,omitempty"`       // Extensions for use
+	Text          *string     `json:"text,omitempty" bson:"text,omitempty"`              // Text representation of the full name
+	TextElement   *Element    `json:"_text,omitempty" bson:"text_element,omitempty"`     // Extensions for text
+	Family        *string     `json:"family,omitempty" bson:"family,omitempty"`          // Family name (often called 'Surname')
+	FamilyElement *Element    `json:"_family,omitempty" bson:"family_element,omitempty"` // Extensions for family
+	Given         []string    `json:"given,omitempty" bson:"given,omitempty"`            // Given names (not always 'first'). Includes middle names
+	GivenElement  []*Element  `json:"_given,omitempty" bson:"given_element,omitempty"`   // Extensions for given
+	Prefix        []string    `json:"prefix,omitempty" bson:"prefix,omitempty"`          // Parts that come before the name
+	PrefixElement []*Element  `json:"_prefix,omitempty" bson:"prefix_element,omitempty"` // Extensions for prefix
+	Suffix        []string    `json:"suffix,omitempty" bson:"suffix,omitempty"`          // Parts that come after the name
+	SuffixElement []*Element  `json:"_suffix,omitempty" bson:"suffix_element,omitempty"` // Extensions for suffix
+	Period        *Period     `json:"period,omitempty" bson:"period,omitempty"`          // Time period when name was/is in use
 }
 
 func (r *HumanName) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", *r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
+	if r.FamilyElement != nil {
+		if err := r.FamilyElement.Validate(); err != nil {
+			return fmt.Errorf("FamilyElement: %w", err)
+		}
+	}
+	for i, item := range r.GivenElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("GivenElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.PrefixElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PrefixElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SuffixElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SuffixElement[%d]: %w", i, err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -24,3 +80,130 @@ func (r *HumanName) Validate() error {
 	}
 	return nil
 }
+
+func (r *HumanName) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", *r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	if r.FamilyElement != nil {
+		r.FamilyElement.validateAll(path+".family", issues)
+	}
+	for i, item := range r.GivenElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.given[%d]", path, i), issues)
+	}
+	for i, item := range r.PrefixElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.prefix[%d]", path, i), issues)
+	}
+	for i, item := range r.SuffixElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.suffix[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+func (r *HumanName) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("HumanName", &issues)
+	checkInvariants(r, "HumanName", &issues)
+	return issues
+}
+
+var humanNameInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *HumanName) invariants() []invariant {
+	return humanNameInvariants
+}
+
+func (r HumanName) MarshalJSON() ([]byte, error) {
+	type alias HumanName
+	out := struct {
+		alias
+		Given         []*string  `json:"given,omitempty"`
+		GivenElement  []*Element `json:"_given,omitempty"`
+		Prefix        []*string  `json:"prefix,omitempty"`
+		PrefixElement []*Element `json:"_prefix,omitempty"`
+		Suffix        []*string  `json:"suffix,omitempty"`
+		SuffixElement []*Element `json:"_suffix,omitempty"`
+	}{alias: alias(r)}
+	out.Given, out.GivenElement = alignPrimitiveArray(r.Given, r.GivenElement)
+	out.Prefix, out.PrefixElement = alignPrimitiveArray(r.Prefix, r.PrefixElement)
+	out.Suffix, out.SuffixElement = alignPrimitiveArray(r.Suffix, r.SuffixElement)
+	return json.Marshal(out)
+}
+
+func (r *HumanName) UnmarshalJSON(data []byte) error {
+	type alias HumanName
+	aux := struct {
+		*alias
+		Given  []*string `json:"given,omitempty"`
+		Prefix []*string `json:"prefix,omitempty"`
+		Suffix []*string `json:"suffix,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Given, err = splitPrimitiveArray(aux.Given, r.GivenElement); err != nil {
+		return fmt.Errorf("given: %w", err)
+	}
+	if r.Prefix, err = splitPrimitiveArray(aux.Prefix, r.PrefixElement); err != nil {
+		return fmt.Errorf("prefix: %w", err)
+	}
+	if r.Suffix, err = splitPrimitiveArray(aux.Suffix, r.SuffixElement); err != nil {
+		return fmt.Errorf("suffix: %w", err)
+	}
+	return nil
+}
+
+func (r HumanName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *HumanName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r HumanName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *HumanName) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var humanNameElementMetadata = []ElementMetadata{
+	{Path: "HumanName.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "HumanName.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "HumanName.use", Name: "use", Field: 2, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "HumanName.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/name-use", Description: "The use of a human name."}, Summary: true, Modifier: true},
+	{Path: "HumanName.text", Name: "text", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "HumanName.family", Name: "family", Field: 6, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "HumanName.given", Name: "given", Field: 8, Min: 0, Max: "*", Types: []string{"string"}, Summary: true},
+	{Path: "HumanName.prefix", Name: "prefix", Field: 10, Min: 0, Max: "*", Types: []string{"string"}, Summary: true},
+	{Path: "HumanName.suffix", Name: "suffix", Field: 12, Min: 0, Max: "*", Types: []string{"string"}, Summary: true},
+	{Path: "HumanName.period", Name: "period", Field: 14, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+}
+
+func (r *HumanName) ElementMetadata() []ElementMetadata {
+	return humanNameElementMetadata
+}
@@ -1,31 +1,66 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // Identifier Type: An identifier - identifies some entity uniquely and unambiguously. Typically this is used for business identifiers.
 type Identifier struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Use      *string          `json:"use,omitempty" bson:"use,omitempty"`           // usual | official | temp | secondary | old (If known)
-	Type     *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`         // Description of identifier
-	System   *string          `json:"system,omitempty" bson:"system,omitempty"`     // The namespace for the identifier value
-	Value    *string          `json:"value,omitempty" bson:"value,omitempty"`       // The value that is unique
-	Period   *Period          `json:"period,omitempty" bson:"period,omitempty"`     // Time period when id is/was valid for use
-	Assigner *Reference       `json:"assigner,omitempty" bson:"assigner,omitempty"` // Organization that issued id (may be just text)
+	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
+	Extension     []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`    // Additional content defined by implementations
+	Use           *IdentifierUse   `json:"use,omitempty" bson:"use,omitempty"`                // usual | official | temp | secondary | old (If known)
+	UseElement    *Element         `json:"_use,omitempty" bson:"use_element,omitempty"`       // Extensions for use
+	Type          *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`              // Description of identifier
+	System        *string          `json:"system,omitempty" bson:"system,omitempty"`          // The namespace for the identifier value
+	SystemElement *Element         `json:"_system,omitempty" bson:"system_element,omitempty"` // Extensions for system
+	Value         *string          `json:"value,omitempty" bson:"value,omitempty"`            // The value that is unique
+	ValueElement  *Element         `json:"_value,omitempty" bson:"value_element,omitempty"`   // Extensions for value
+	Period        *Period          `json:"period,omitempty" bson:"period,omitempty"`          // Time period when id is/was valid for use
+	Assigner      *Reference       `json:"assigner,omitempty" bson:"assigner,omitempty"`      // Organization that issued id (may be just text)
 }
 
+var identifierAssignerTargets = []string{"Organization"}
+
 func (r *Identifier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", *r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Assigner != nil {
+		if target := invalidReferenceTarget(r.Assigner.Reference, r.Assigner.Type, identifierAssignerTargets); target != "" {
+			return fmt.Errorf("field 'Assigner' cannot refer to %s, expected one of %v", target, identifierAssignerTargets)
+		}
+	}
 	if r.Assigner != nil {
 		if err := r.Assigner.Validate(); err != nil {
 			return fmt.Errorf("Assigner: %w", err)
@@ -33,3 +68,90 @@ func (r *Identifier) Validate() error {
 	}
 	return nil
 }
+
+func (r *Identifier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Use != nil && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", *r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Assigner != nil {
+		if target := invalidReferenceTarget(r.Assigner.Reference, r.Assigner.Type, identifierAssignerTargets); target != "" {
+			issues.add("value", path+".assigner", fmt.Sprintf("field 'Assigner' cannot refer to %s, expected one of %v", target, identifierAssignerTargets))
+		}
+	}
+	if r.Assigner != nil {
+		r.Assigner.validateAll(path+".assigner", issues)
+	}
+}
+
+func (r *Identifier) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Identifier", &issues)
+	checkInvariants(r, "Identifier", &issues)
+	return issues
+}
+
+var identifierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "ident-1", severity: "warning", human: "Identifier with no value has limited utility.  If communicating that an identifier value has been suppressed or missing, the value element SHOULD be present with an extension indicating the missing semantic - e.g. data-absent-reason", expression: "value.exists()"},
+}
+
+func (r *Identifier) invariants() []invariant {
+	return identifierInvariants
+}
+
+func (r Identifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Identifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Identifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Identifier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var identifierElementTypes = map[string]string{
+	"system": "uri",
+}
+
+func (r *Identifier) elementTypes() map[string]string {
+	return identifierElementTypes
+}
+
+var identifierElementMetadata = []ElementMetadata{
+	{Path: "Identifier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Identifier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Identifier.use", Name: "use", Field: 2, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Identifier.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/identifier-use", Description: "Identifies the purpose for this identifier, if known ."}, Summary: true, Modifier: true},
+	{Path: "Identifier.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Identifier.type", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/identifier-type", Description: "A coded type for an identifier that can be used to determine which identifier to use for a specific purpose."}, Summary: true},
+	{Path: "Identifier.system", Name: "system", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "Identifier.value", Name: "value", Field: 7, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Identifier.period", Name: "period", Field: 9, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+	{Path: "Identifier.assigner", Name: "assigner", Field: 10, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}, Summary: true},
+}
+
+func (r *Identifier) ElementMetadata() []ElementMetadata {
+	return identifierElementMetadata
+}
@@ -2,39 +2,56 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A selection of DICOM SOP instances within a single Study and Series. This might include additional specifics such as a set of frames or an image region, allowing linkage to an Observation Resource.
 type ImagingSelection struct {
-	ResourceType        string                          `json:"resourceType" bson:"resource_type"`                                     // Type of resource
-	Id                  *string                         `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
-	Meta                *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
-	ImplicitRules       *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
-	Language            *string                         `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
-	Text                *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained           []json.RawMessage               `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
-	Identifier          []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // Business identifier for imaging selection
-	Status              string                          `json:"status" bson:"status"`                                                  // available | entered-in-error | inactive | unknown
-	Category            []CodeableConcept               `json:"category,omitempty" bson:"category,omitempty"`                          // Classifies the imaging selection
-	Modality            *CodeableConcept                `json:"modality,omitempty" bson:"modality,omitempty"`                          // The distinct modality
-	Code                *CodeableConcept                `json:"code" bson:"code"`                                                      // Imaging Selection purpose text or code
-	Subject             *Reference                      `json:"subject,omitempty" bson:"subject,omitempty"`                            // Who or what is the subject of the imaging selection
-	Issued              *string                         `json:"issued,omitempty" bson:"issued,omitempty"`                              // When the imaging selection was created
-	Performer           []ImagingSelectionPerformer     `json:"performer,omitempty" bson:"performer,omitempty"`                        // Who performed imaging selection and what they did
-	BasedOn             []Reference                     `json:"basedOn,omitempty" bson:"based_on,omitempty"`                           // Fulfills plan or order
-	DerivedFrom         *Reference                      `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                   // The imaging study from which the imaging selection is derived
-	StudyUid            *string                         `json:"studyUid,omitempty" bson:"study_uid,omitempty"`                         // DICOM Study Instance UID
-	SeriesUid           *string                         `json:"seriesUid,omitempty" bson:"series_uid,omitempty"`                       // DICOM Series Instance UID
-	SeriesNumber        *int                            `json:"seriesNumber,omitempty" bson:"series_number,omitempty"`                 // Numeric identifier of the selected series
-	FrameOfReferenceUid *string                         `json:"frameOfReferenceUid,omitempty" bson:"frame_of_reference_uid,omitempty"` // The Frame of Reference UID for the selected images
-	BodySite            []CodeableReference             `json:"bodySite,omitempty" bson:"body_site,omitempty"`                         // Selected anatomic structure
-	Focus               []Reference                     `json:"focus,omitempty" bson:"focus,omitempty"`                                // Related resources that are the focus for the imaging selection
-	Endpoint            []Reference                     `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                          // The network services providing access for the subset of the study
-	Instance            []ImagingSelectionInstance      `json:"instance,omitempty" bson:"instance,omitempty"`                          // The selected instances
-	ImageRegion3D       []ImagingSelectionImageRegion3D `json:"imageRegion3D,omitempty" bson:"image_region3_d,omitempty"`              // A 3D region in a DICOM frame of reference
+	ResourceType               string                          `json:"resourceType" bson:"resource_type"`                                              // Type of resource
+	Id                         *string                         `json:"id,omitempty" bson:"id,omitempty"`                                               // Logical id of this artifact
+	Meta                       *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                           // Metadata about the resource
+	ImplicitRules              *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                        // A set of rules under which this content was created
+	ImplicitRulesElement       *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`               // Extensions for implicitRules
+	Language                   *string                         `json:"language,omitempty" bson:"language,omitempty"`                                   // Language of the resource content
+	LanguageElement            *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`                          // Extensions for language
+	Text                       *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                           // Text summary of the resource, for human interpretation
+	Contained                  []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                                 // Contained, inline Resources
+	Extension                  []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                                 // Additional content defined by implementations
+	ModifierExtension          []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                // Extensions that cannot be ignored
+	Identifier                 []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                               // Business identifier for imaging selection
+	Status                     ImagingSelectionStatus          `json:"status" bson:"status"`                                                           // available | entered-in-error | inactive | unknown
+	StatusElement              *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                              // Extensions for status
+	Category                   []CodeableConcept               `json:"category,omitempty" bson:"category,omitempty"`                                   // Classifies the imaging selection
+	Modality                   *CodeableConcept                `json:"modality,omitempty" bson:"modality,omitempty"`                                   // The distinct modality
+	Code                       *CodeableConcept                `json:"code" bson:"code"`                                                               // Imaging Selection purpose text or code
+	Subject                    *Reference                      `json:"subject,omitempty" bson:"subject,omitempty"`                                     // Who or what is the subject of the imaging selection
+	Issued                     *Instant                        `json:"issued,omitempty" bson:"issued,omitempty"`                                       // When the imaging selection was created
+	IssuedElement              *Element                        `json:"_issued,omitempty" bson:"issued_element,omitempty"`                              // Extensions for issued
+	Performer                  []ImagingSelectionPerformer     `json:"performer,omitempty" bson:"performer,omitempty"`                                 // Who performed imaging selection and what they did
+	BasedOn                    []Reference                     `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                    // Fulfills plan or order
+	DerivedFrom                *Reference                      `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                            // The imaging study from which the imaging selection is derived
+	StudyUid                   *string                         `json:"studyUid,omitempty" bson:"study_uid,omitempty"`                                  // DICOM Study Instance UID
+	StudyUidElement            *Element                        `json:"_studyUid,omitempty" bson:"study_uid_element,omitempty"`                         // Extensions for studyUid
+	SeriesUid                  *string                         `json:"seriesUid,omitempty" bson:"series_uid,omitempty"`                                // DICOM Series Instance UID
+	SeriesUidElement           *Element                        `json:"_seriesUid,omitempty" bson:"series_uid_element,omitempty"`                       // Extensions for seriesUid
+	SeriesNumber               *int                            `json:"seriesNumber,omitempty" bson:"series_number,omitempty"`                          // Numeric identifier of the selected series
+	SeriesNumberElement        *Element                        `json:"_seriesNumber,omitempty" bson:"series_number_element,omitempty"`                 // Extensions for seriesNumber
+	FrameOfReferenceUid        *string                         `json:"frameOfReferenceUid,omitempty" bson:"frame_of_reference_uid,omitempty"`          // The Frame of Reference UID for the selected images
+	FrameOfReferenceUidElement *Element                        `json:"_frameOfReferenceUid,omitempty" bson:"frame_of_reference_uid_element,omitempty"` // Extensions for frameOfReferenceUid
+	BodySite                   []CodeableReference             `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                  // Selected anatomic structure
+	Focus                      []Reference                     `json:"focus,omitempty" bson:"focus,omitempty"`                                         // Related resources that are the focus for the imaging selection
+	Endpoint                   []Reference                     `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                                   // The network services providing access for the subset of the study
+	Instance                   []ImagingSelectionInstance      `json:"instance,omitempty" bson:"instance,omitempty"`                                   // The selected instances
+	ImageRegion3D              []ImagingSelectionImageRegion3D `json:"imageRegion3D,omitempty" bson:"image_region3_d,omitempty"`                       // A 3D region in a DICOM frame of reference
 }
 
+var imagingSelectionSubjectTargets = []string{"Device", "Group", "Medication", "Patient", "Practitioner", "Procedure", "Specimen", "Substance"}
+
+var imagingSelectionBasedOnTargets = []string{"Appointment", "CarePlan", "ServiceRequest", "Task"}
+
+var imagingSelectionDerivedFromTargets = []string{"DocumentReference", "ImagingStudy"}
+
 func (r *ImagingSelection) Validate() error {
 	if r.ResourceType != "ImagingSelection" {
 		return fmt.Errorf("invalid resourceType: expected 'ImagingSelection', got '%s'", r.ResourceType)
@@ -44,20 +61,55 @@ func (r *ImagingSelection) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -76,26 +128,71 @@ func (r *ImagingSelection) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, imagingSelectionSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, imagingSelectionSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			return fmt.Errorf("Issued: %w", err)
+		}
+	}
+	if r.IssuedElement != nil {
+		if err := r.IssuedElement.Validate(); err != nil {
+			return fmt.Errorf("IssuedElement: %w", err)
+		}
+	}
 	for i, item := range r.Performer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingSelectionBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, imagingSelectionBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	if r.DerivedFrom != nil {
+		if target := invalidReferenceTarget(r.DerivedFrom.Reference, r.DerivedFrom.Type, imagingSelectionDerivedFromTargets); target != "" {
+			return fmt.Errorf("field 'DerivedFrom' cannot refer to %s, expected one of %v", target, imagingSelectionDerivedFromTargets)
+		}
+	}
 	if r.DerivedFrom != nil {
 		if err := r.DerivedFrom.Validate(); err != nil {
 			return fmt.Errorf("DerivedFrom: %w", err)
 		}
 	}
+	if r.StudyUidElement != nil {
+		if err := r.StudyUidElement.Validate(); err != nil {
+			return fmt.Errorf("StudyUidElement: %w", err)
+		}
+	}
+	if r.SeriesUidElement != nil {
+		if err := r.SeriesUidElement.Validate(); err != nil {
+			return fmt.Errorf("SeriesUidElement: %w", err)
+		}
+	}
+	if r.SeriesNumberElement != nil {
+		if err := r.SeriesNumberElement.Validate(); err != nil {
+			return fmt.Errorf("SeriesNumberElement: %w", err)
+		}
+	}
+	if r.FrameOfReferenceUidElement != nil {
+		if err := r.FrameOfReferenceUidElement.Validate(); err != nil {
+			return fmt.Errorf("FrameOfReferenceUidElement: %w", err)
+		}
+	}
 	for i, item := range r.BodySite {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BodySite[%d]: %w", i, err)
@@ -121,16 +218,288 @@ func (r *ImagingSelection) Validate() error {
 			return fmt.Errorf("ImageRegion3D[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "ImagingSelection")
+}
+
+func (r *ImagingSelection) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ImagingSelection" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ImagingSelection', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Modality != nil {
+		r.Modality.validateAll(path+".modality", issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, imagingSelectionSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, imagingSelectionSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			issues.add("value", path+".issued", err.Error())
+		}
+	}
+	if r.IssuedElement != nil {
+		r.IssuedElement.validateAll(path+".issued", issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingSelectionBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, imagingSelectionBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.DerivedFrom != nil {
+		if target := invalidReferenceTarget(r.DerivedFrom.Reference, r.DerivedFrom.Type, imagingSelectionDerivedFromTargets); target != "" {
+			issues.add("value", path+".derivedFrom", fmt.Sprintf("field 'DerivedFrom' cannot refer to %s, expected one of %v", target, imagingSelectionDerivedFromTargets))
+		}
+	}
+	if r.DerivedFrom != nil {
+		r.DerivedFrom.validateAll(path+".derivedFrom", issues)
+	}
+	if r.StudyUidElement != nil {
+		r.StudyUidElement.validateAll(path+".studyUid", issues)
+	}
+	if r.SeriesUidElement != nil {
+		r.SeriesUidElement.validateAll(path+".seriesUid", issues)
+	}
+	if r.SeriesNumberElement != nil {
+		r.SeriesNumberElement.validateAll(path+".seriesNumber", issues)
+	}
+	if r.FrameOfReferenceUidElement != nil {
+		r.FrameOfReferenceUidElement.validateAll(path+".frameOfReferenceUid", issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+	for i, item := range r.Instance {
+		item.validateAll(fmt.Sprintf("%s.instance[%d]", path, i), issues)
+	}
+	for i, item := range r.ImageRegion3D {
+		item.validateAll(fmt.Sprintf("%s.imageRegion3D[%d]", path, i), issues)
+	}
+}
+
+func (r *ImagingSelection) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ImagingSelection", &issues)
+	checkInvariants(r, "ImagingSelection", &issues)
+	return issues
+}
+
+var imagingSelectionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ImagingSelection) invariants() []invariant {
+	return imagingSelectionInvariants
+}
+
+func (r *ImagingSelection) UnmarshalJSON(data []byte) error {
+	type alias ImagingSelection
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r ImagingSelection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingSelection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingSelection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingSelection) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingSelectionElementTypes = map[string]string{
+	"id":                  "id",
+	"implicitRules":       "uri",
+	"language":            "code",
+	"studyUid":            "id",
+	"seriesUid":           "id",
+	"seriesNumber":        "unsignedInt",
+	"frameOfReferenceUid": "id",
+}
+
+func (r *ImagingSelection) elementTypes() map[string]string {
+	return imagingSelectionElementTypes
+}
+
+var imagingSelectionElementMetadata = []ElementMetadata{
+	{Path: "ImagingSelection.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ImagingSelection.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ImagingSelection.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ImagingSelection.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImagingSelection.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ImagingSelection.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ImagingSelection.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ImagingSelection.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingSelection.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingSelection.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ImagingSelection.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImagingSelection.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingselection-status"}},
+	{Path: "ImagingSelection.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingSelection.modality", Name: "modality", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingSelection.code", Name: "code", Field: 16, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingSelection.subject", Name: "subject", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/Substance"}},
+	{Path: "ImagingSelection.issued", Name: "issued", Field: 18, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "ImagingSelection.performer", Name: "performer", Field: 20, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImagingSelection.basedOn", Name: "basedOn", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Task"}},
+	{Path: "ImagingSelection.derivedFrom", Name: "derivedFrom", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/ImagingStudy"}},
+	{Path: "ImagingSelection.studyUid", Name: "studyUid", Field: 23, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "ImagingSelection.seriesUid", Name: "seriesUid", Field: 25, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "ImagingSelection.seriesNumber", Name: "seriesNumber", Field: 27, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingSelection.frameOfReferenceUid", Name: "frameOfReferenceUid", Field: 29, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "ImagingSelection.bodySite", Name: "bodySite", Field: 31, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ImagingSelection.focus", Name: "focus", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ImagingSelection.endpoint", Name: "endpoint", Field: 33, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ImagingSelection.instance", Name: "instance", Field: 34, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImagingSelection.imageRegion3D", Name: "imageRegion3D", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ImagingSelection) ElementMetadata() []ElementMetadata {
+	return imagingSelectionElementMetadata
+}
+
+func (r *ImagingSelection) GetResourceType() string {
+	return "ImagingSelection"
+}
+
+func (r *ImagingSelection) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ImagingSelection) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ImagingSelection) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ImagingSelection) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ImagingSelection) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ImagingSelection) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ImagingSelection) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ImagingSelection)(nil)
+
 type ImagingSelectionPerformer struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Type of performance
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Who performed the imaging selection
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performance
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Who performed the imaging selection
 }
 
 func (r *ImagingSelectionPerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -147,24 +516,153 @@ func (r *ImagingSelectionPerformer) Validate() error {
 	return nil
 }
 
+func (r *ImagingSelectionPerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var imagingSelectionPerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingSelectionPerformer) invariants() []invariant {
+	return imagingSelectionPerformerInvariants
+}
+
+func (r ImagingSelectionPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingSelectionPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingSelectionPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingSelectionPerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingSelectionPerformerElementMetadata = []ElementMetadata{
+	{Path: "ImagingSelection.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingSelection.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingSelection.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingSelection.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingSelection.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ImagingSelectionPerformer) ElementMetadata() []ElementMetadata {
+	return imagingSelectionPerformerElementMetadata
+}
+
 type ImagingSelectionInstance struct {
-	Id                              *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                                              // Unique id for inter-element referencing
-	Uid                             string                                  `json:"uid" bson:"uid"`                                                                                // DICOM SOP Instance UID
-	Number                          *int                                    `json:"number,omitempty" bson:"number,omitempty"`                                                      // The number of this instance in the series
-	SopClass                        *string                                 `json:"sopClass,omitempty" bson:"sop_class,omitempty"`                                                 // DICOM class type
-	FrameNumber                     []int                                   `json:"frameNumber,omitempty" bson:"frame_number,omitempty"`                                           // Selected frames
-	ReferencedContentItemIdentifier []int                                   `json:"referencedContentItemIdentifier,omitempty" bson:"referenced_content_item_identifier,omitempty"` // Selected content items
-	SegmentNumber                   []int                                   `json:"segmentNumber,omitempty" bson:"segment_number,omitempty"`                                       // Selected segments
-	RegionOfInterest                []int                                   `json:"regionOfInterest,omitempty" bson:"region_of_interest,omitempty"`                                // Selected regions of interest
-	WaveFormChannel                 []int                                   `json:"waveFormChannel,omitempty" bson:"wave_form_channel,omitempty"`                                  // Selected waveform channel
-	ImageRegion2D                   []ImagingSelectionInstanceImageRegion2D `json:"imageRegion2D,omitempty" bson:"image_region2_d,omitempty"`                                      // A 2D region in an image
+	Id                                     *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                                                       // Unique id for inter-element referencing
+	Extension                              []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                                                         // Additional content defined by implementations
+	ModifierExtension                      []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                                        // Extensions that cannot be ignored even if unrecognized
+	Uid                                    string                                  `json:"uid" bson:"uid"`                                                                                         // DICOM SOP Instance UID
+	UidElement                             *Element                                `json:"_uid,omitempty" bson:"uid_element,omitempty"`                                                            // Extensions for uid
+	Number                                 *int                                    `json:"number,omitempty" bson:"number,omitempty"`                                                               // The number of this instance in the series
+	NumberElement                          *Element                                `json:"_number,omitempty" bson:"number_element,omitempty"`                                                      // Extensions for number
+	SopClass                               *string                                 `json:"sopClass,omitempty" bson:"sop_class,omitempty"`                                                          // DICOM class type
+	SopClassElement                        *Element                                `json:"_sopClass,omitempty" bson:"sop_class_element,omitempty"`                                                 // Extensions for sopClass
+	FrameNumber                            []int                                   `json:"frameNumber,omitempty" bson:"frame_number,omitempty"`                                                    // Selected frames
+	FrameNumberElement                     []*Element                              `json:"_frameNumber,omitempty" bson:"frame_number_element,omitempty"`                                           // Extensions for frameNumber
+	ReferencedContentItemIdentifier        []int                                   `json:"referencedContentItemIdentifier,omitempty" bson:"referenced_content_item_identifier,omitempty"`          // Selected content items
+	ReferencedContentItemIdentifierElement []*Element                              `json:"_referencedContentItemIdentifier,omitempty" bson:"referenced_content_item_identifier_element,omitempty"` // Extensions for referencedContentItemIdentifier
+	SegmentNumber                          []int                                   `json:"segmentNumber,omitempty" bson:"segment_number,omitempty"`                                                // Selected segments
+	SegmentNumberElement                   []*Element                              `json:"_segmentNumber,omitempty" bson:"segment_number_element,omitempty"`                                       // Extensions for segmentNumber
+	RegionOfInterest                       []int                                   `json:"regionOfInterest,omitempty" bson:"region_of_interest,omitempty"`                                         // Selected regions of interest
+	RegionOfInterestElement                []*Element                              `json:"_regionOfInterest,omitempty" bson:"region_of_interest_element,omitempty"`                                // Extensions for regionOfInterest
+	WaveFormChannel                        []int                                   `json:"waveFormChannel,omitempty" bson:"wave_form_channel,omitempty"`                                           // Selected waveform channel
+	WaveFormChannelElement                 []*Element                              `json:"_waveFormChannel,omitempty" bson:"wave_form_channel_element,omitempty"`                                  // Extensions for waveFormChannel
+	ImageRegion2D                          []ImagingSelectionInstanceImageRegion2D `json:"imageRegion2D,omitempty" bson:"image_region2_d,omitempty"`                                               // A 2D region in an image
 }
 
 func (r *ImagingSelectionInstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Uid == emptyString {
 		return fmt.Errorf("field 'Uid' is required")
 	}
+	if r.UidElement != nil {
+		if err := r.UidElement.Validate(); err != nil {
+			return fmt.Errorf("UidElement: %w", err)
+		}
+	}
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
+		}
+	}
+	if r.SopClassElement != nil {
+		if err := r.SopClassElement.Validate(); err != nil {
+			return fmt.Errorf("SopClassElement: %w", err)
+		}
+	}
+	for i, item := range r.FrameNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("FrameNumberElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ReferencedContentItemIdentifierElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReferencedContentItemIdentifierElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SegmentNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SegmentNumberElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.RegionOfInterestElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RegionOfInterestElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.WaveFormChannelElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("WaveFormChannelElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.ImageRegion2D {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ImageRegion2D[%d]: %w", i, err)
@@ -173,36 +671,462 @@ func (r *ImagingSelectionInstance) Validate() error {
 	return nil
 }
 
+func (r *ImagingSelectionInstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Uid == emptyString {
+		issues.add("required", path+".uid", "field 'Uid' is required")
+	}
+	if r.UidElement != nil {
+		r.UidElement.validateAll(path+".uid", issues)
+	}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
+	}
+	if r.SopClassElement != nil {
+		r.SopClassElement.validateAll(path+".sopClass", issues)
+	}
+	for i, item := range r.FrameNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.frameNumber[%d]", path, i), issues)
+	}
+	for i, item := range r.ReferencedContentItemIdentifierElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.referencedContentItemIdentifier[%d]", path, i), issues)
+	}
+	for i, item := range r.SegmentNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.segmentNumber[%d]", path, i), issues)
+	}
+	for i, item := range r.RegionOfInterestElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.regionOfInterest[%d]", path, i), issues)
+	}
+	for i, item := range r.WaveFormChannelElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.waveFormChannel[%d]", path, i), issues)
+	}
+	for i, item := range r.ImageRegion2D {
+		item.validateAll(fmt.Sprintf("%s.imageRegion2D[%d]", path, i), issues)
+	}
+}
+
+var imagingSelectionInstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingSelectionInstance) invariants() []invariant {
+	return imagingSelectionInstanceInvariants
+}
+
+func (r ImagingSelectionInstance) MarshalJSON() ([]byte, error) {
+	type alias ImagingSelectionInstance
+	out := struct {
+		alias
+		FrameNumber                            []*int     `json:"frameNumber,omitempty"`
+		FrameNumberElement                     []*Element `json:"_frameNumber,omitempty"`
+		ReferencedContentItemIdentifier        []*int     `json:"referencedContentItemIdentifier,omitempty"`
+		ReferencedContentItemIdentifierElement []*Element `json:"_referencedContentItemIdentifier,omitempty"`
+		SegmentNumber                          []*int     `json:"segmentNumber,omitempty"`
+		SegmentNumberElement                   []*Element `json:"_segmentNumber,omitempty"`
+		RegionOfInterest                       []*int     `json:"regionOfInterest,omitempty"`
+		RegionOfInterestElement                []*Element `json:"_regionOfInterest,omitempty"`
+		WaveFormChannel                        []*int     `json:"waveFormChannel,omitempty"`
+		WaveFormChannelElement                 []*Element `json:"_waveFormChannel,omitempty"`
+	}{alias: alias(r)}
+	out.FrameNumber, out.FrameNumberElement = alignPrimitiveArray(r.FrameNumber, r.FrameNumberElement)
+	out.ReferencedContentItemIdentifier, out.ReferencedContentItemIdentifierElement = alignPrimitiveArray(r.ReferencedContentItemIdentifier, r.ReferencedContentItemIdentifierElement)
+	out.SegmentNumber, out.SegmentNumberElement = alignPrimitiveArray(r.SegmentNumber, r.SegmentNumberElement)
+	out.RegionOfInterest, out.RegionOfInterestElement = alignPrimitiveArray(r.RegionOfInterest, r.RegionOfInterestElement)
+	out.WaveFormChannel, out.WaveFormChannelElement = alignPrimitiveArray(r.WaveFormChannel, r.WaveFormChannelElement)
+	return json.Marshal(out)
+}
+
+func (r *ImagingSelectionInstance) UnmarshalJSON(data []byte) error {
+	type alias ImagingSelectionInstance
+	aux := struct {
+		*alias
+		FrameNumber                     []*int `json:"frameNumber,omitempty"`
+		ReferencedContentItemIdentifier []*int `json:"referencedContentItemIdentifier,omitempty"`
+		SegmentNumber                   []*int `json:"segmentNumber,omitempty"`
+		RegionOfInterest                []*int `json:"regionOfInterest,omitempty"`
+		WaveFormChannel                 []*int `json:"waveFormChannel,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.FrameNumber, err = splitPrimitiveArray(aux.FrameNumber, r.FrameNumberElement); err != nil {
+		return fmt.Errorf("frameNumber: %w", err)
+	}
+	if r.ReferencedContentItemIdentifier, err = splitPrimitiveArray(aux.ReferencedContentItemIdentifier, r.ReferencedContentItemIdentifierElement); err != nil {
+		return fmt.Errorf("referencedContentItemIdentifier: %w", err)
+	}
+	if r.SegmentNumber, err = splitPrimitiveArray(aux.SegmentNumber, r.SegmentNumberElement); err != nil {
+		return fmt.Errorf("segmentNumber: %w", err)
+	}
+	if r.RegionOfInterest, err = splitPrimitiveArray(aux.RegionOfInterest, r.RegionOfInterestElement); err != nil {
+		return fmt.Errorf("regionOfInterest: %w", err)
+	}
+	if r.WaveFormChannel, err = splitPrimitiveArray(aux.WaveFormChannel, r.WaveFormChannelElement); err != nil {
+		return fmt.Errorf("waveFormChannel: %w", err)
+	}
+	return nil
+}
+
+func (r ImagingSelectionInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingSelectionInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingSelectionInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingSelectionInstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingSelectionInstanceElementTypes = map[string]string{
+	"uid":                             "id",
+	"number":                          "unsignedInt",
+	"sopClass":                        "oid",
+	"frameNumber":                     "positiveInt",
+	"referencedContentItemIdentifier": "positiveInt",
+	"segmentNumber":                   "positiveInt",
+	"regionOfInterest":                "positiveInt",
+	"waveFormChannel":                 "positiveInt",
+}
+
+func (r *ImagingSelectionInstance) elementTypes() map[string]string {
+	return imagingSelectionInstanceElementTypes
+}
+
+var imagingSelectionInstanceElementMetadata = []ElementMetadata{
+	{Path: "ImagingSelection.instance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingSelection.instance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingSelection.instance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingSelection.instance.uid", Name: "uid", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "ImagingSelection.instance.number", Name: "number", Field: 5, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingSelection.instance.sopClass", Name: "sopClass", Field: 7, Min: 0, Max: "1", Types: []string{"oid"}},
+	{Path: "ImagingSelection.instance.frameNumber", Name: "frameNumber", Field: 9, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ImagingSelection.instance.referencedContentItemIdentifier", Name: "referencedContentItemIdentifier", Field: 11, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ImagingSelection.instance.segmentNumber", Name: "segmentNumber", Field: 13, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ImagingSelection.instance.regionOfInterest", Name: "regionOfInterest", Field: 15, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ImagingSelection.instance.waveFormChannel", Name: "waveFormChannel", Field: 17, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+	{Path: "ImagingSelection.instance.imageRegion2D", Name: "imageRegion2D", Field: 19, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ImagingSelectionInstance) ElementMetadata() []ElementMetadata {
+	return imagingSelectionInstanceElementMetadata
+}
+
 type ImagingSelectionInstanceImageRegion2D struct {
-	Id         *string   `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	RegionType string    `json:"regionType" bson:"region_type"`    // point | polyline | multipoint | circle | ellipse
-	Coordinate []float64 `json:"coordinate" bson:"coordinate"`     // The coordinates that define the image region
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	RegionType        ImagingSelection2DGraphicType `json:"regionType" bson:"region_type"`                                   // point | polyline | multipoint | circle | ellipse
+	RegionTypeElement *Element                      `json:"_regionType,omitempty" bson:"region_type_element,omitempty"`      // Extensions for regionType
+	Coordinate        []Decimal                     `json:"coordinate" bson:"coordinate"`                                    // The coordinates that define the image region
+	CoordinateElement []*Element                    `json:"_coordinate,omitempty" bson:"coordinate_element,omitempty"`       // Extensions for coordinate
 }
 
 func (r *ImagingSelectionInstanceImageRegion2D) Validate() error {
-	var emptyString string
-	if r.RegionType == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.RegionType == "" {
 		return fmt.Errorf("field 'RegionType' is required")
 	}
+	if !r.RegionType.IsValid() {
+		return fmt.Errorf("field 'RegionType' has invalid code '%s'", r.RegionType)
+	}
+	if r.RegionTypeElement != nil {
+		if err := r.RegionTypeElement.Validate(); err != nil {
+			return fmt.Errorf("RegionTypeElement: %w", err)
+		}
+	}
 	if len(r.Coordinate) < 1 {
 		return fmt.Errorf("field 'Coordinate' must have at least 1 elements")
 	}
+	for i, item := range r.Coordinate {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Coordinate[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.CoordinateElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("CoordinateElement[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
+func (r *ImagingSelectionInstanceImageRegion2D) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.RegionType == "" {
+		issues.add("required", path+".regionType", "field 'RegionType' is required")
+	}
+	if r.RegionType != "" && !r.RegionType.IsValid() {
+		issues.add("code-invalid", path+".regionType", fmt.Sprintf("field 'RegionType' has invalid code '%s'", r.RegionType))
+	}
+	if r.RegionTypeElement != nil {
+		r.RegionTypeElement.validateAll(path+".regionType", issues)
+	}
+	if len(r.Coordinate) < 1 {
+		issues.add("required", path+".coordinate", "field 'Coordinate' must have at least 1 elements")
+	}
+	for i, item := range r.Coordinate {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.coordinate[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.CoordinateElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.coordinate[%d]", path, i), issues)
+	}
+}
+
+var imagingSelectionInstanceImageRegion2DInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingSelectionInstanceImageRegion2D) invariants() []invariant {
+	return imagingSelectionInstanceImageRegion2DInvariants
+}
+
+func (r ImagingSelectionInstanceImageRegion2D) MarshalJSON() ([]byte, error) {
+	type alias ImagingSelectionInstanceImageRegion2D
+	out := struct {
+		alias
+		Coordinate        []*Decimal `json:"coordinate"`
+		CoordinateElement []*Element `json:"_coordinate,omitempty"`
+	}{alias: alias(r)}
+	out.Coordinate, out.CoordinateElement = alignPrimitiveArray(r.Coordinate, r.CoordinateElement)
+	return json.Marshal(out)
+}
+
+func (r *ImagingSelectionInstanceImageRegion2D) UnmarshalJSON(data []byte) error {
+	type alias ImagingSelectionInstanceImageRegion2D
+	aux := struct {
+		*alias
+		Coordinate []*Decimal `json:"coordinate"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Coordinate, err = splitPrimitiveArray(aux.Coordinate, r.CoordinateElement); err != nil {
+		return fmt.Errorf("coordinate: %w", err)
+	}
+	return nil
+}
+
+func (r ImagingSelectionInstanceImageRegion2D) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingSelectionInstanceImageRegion2D) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingSelectionInstanceImageRegion2D) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingSelectionInstanceImageRegion2D) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingSelectionInstanceImageRegion2DElementMetadata = []ElementMetadata{
+	{Path: "ImagingSelection.instance.imageRegion2D.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingSelection.instance.imageRegion2D.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingSelection.instance.imageRegion2D.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingSelection.instance.imageRegion2D.regionType", Name: "regionType", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImagingSelection.instance.imageRegion2D.regionType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingselection-2dgraphictype"}},
+	{Path: "ImagingSelection.instance.imageRegion2D.coordinate", Name: "coordinate", Field: 5, Min: 1, Max: "*", Types: []string{"decimal"}},
+}
+
+func (r *ImagingSelectionInstanceImageRegion2D) ElementMetadata() []ElementMetadata {
+	return imagingSelectionInstanceImageRegion2DElementMetadata
+}
+
 type ImagingSelectionImageRegion3D struct {
-	Id         *string   `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	RegionType string    `json:"regionType" bson:"region_type"`    // point | multipoint | polyline | polygon | ellipse | ellipsoid
-	Coordinate []float64 `json:"coordinate" bson:"coordinate"`     // Specifies the coordinates that define the image region
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	RegionType        ImagingSelection3DGraphicType `json:"regionType" bson:"region_type"`                                   // point | multipoint | polyline | polygon | ellipse | ellipsoid
+	RegionTypeElement *Element                      `json:"_regionType,omitempty" bson:"region_type_element,omitempty"`      // Extensions for regionType
+	Coordinate        []Decimal                     `json:"coordinate" bson:"coordinate"`                                    // Specifies the coordinates that define the image region
+	CoordinateElement []*Element                    `json:"_coordinate,omitempty" bson:"coordinate_element,omitempty"`       // Extensions for coordinate
 }
 
 func (r *ImagingSelectionImageRegion3D) Validate() error {
-	var emptyString string
-	if r.RegionType == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.RegionType == "" {
 		return fmt.Errorf("field 'RegionType' is required")
 	}
+	if !r.RegionType.IsValid() {
+		return fmt.Errorf("field 'RegionType' has invalid code '%s'", r.RegionType)
+	}
+	if r.RegionTypeElement != nil {
+		if err := r.RegionTypeElement.Validate(); err != nil {
+			return fmt.Errorf("RegionTypeElement: %w", err)
+		}
+	}
 	if len(r.Coordinate) < 1 {
 		return fmt.Errorf("field 'Coordinate' must have at least 1 elements")
 	}
+	for i, item := range r.Coordinate {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Coordinate[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.CoordinateElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("CoordinateElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ImagingSelectionImageRegion3D) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.RegionType == "" {
+		issues.add("required", path+".regionType", "field 'RegionType' is required")
+	}
+	if r.RegionType != "" && !r.RegionType.IsValid() {
+		issues.add("code-invalid", path+".regionType", fmt.Sprintf("field 'RegionType' has invalid code '%s'", r.RegionType))
+	}
+	if r.RegionTypeElement != nil {
+		r.RegionTypeElement.validateAll(path+".regionType", issues)
+	}
+	if len(r.Coordinate) < 1 {
+		issues.add("required", path+".coordinate", "field 'Coordinate' must have at least 1 elements")
+	}
+	for i, item := range r.Coordinate {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.coordinate[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.CoordinateElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.coordinate[%d]", path, i), issues)
+	}
+}
+
+var imagingSelectionImageRegion3DInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingSelectionImageRegion3D) invariants() []invariant {
+	return imagingSelectionImageRegion3DInvariants
+}
+
+func (r ImagingSelectionImageRegion3D) MarshalJSON() ([]byte, error) {
+	type alias ImagingSelectionImageRegion3D
+	out := struct {
+		alias
+		Coordinate        []*Decimal `json:"coordinate"`
+		CoordinateElement []*Element `json:"_coordinate,omitempty"`
+	}{alias: alias(r)}
+	out.Coordinate, out.CoordinateElement = alignPrimitiveArray(r.Coordinate, r.CoordinateElement)
+	return json.Marshal(out)
+}
+
+func (r *ImagingSelectionImageRegion3D) UnmarshalJSON(data []byte) error {
+	type alias ImagingSelectionImageRegion3D
+	aux := struct {
+		*alias
+		Coordinate []*Decimal `json:"coordinate"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Coordinate, err = splitPrimitiveArray(aux.Coordinate, r.CoordinateElement); err != nil {
+		return fmt.Errorf("coordinate: %w", err)
+	}
 	return nil
 }
+
+func (r ImagingSelectionImageRegion3D) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingSelectionImageRegion3D) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingSelectionImageRegion3D) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingSelectionImageRegion3D) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingSelectionImageRegion3DElementMetadata = []ElementMetadata{
+	{Path: "ImagingSelection.imageRegion3D.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingSelection.imageRegion3D.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingSelection.imageRegion3D.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingSelection.imageRegion3D.regionType", Name: "regionType", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImagingSelection.imageRegion3D.regionType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingselection-3dgraphictype"}},
+	{Path: "ImagingSelection.imageRegion3D.coordinate", Name: "coordinate", Field: 5, Min: 1, Max: "*", Types: []string{"decimal"}},
+}
+
+func (r *ImagingSelectionImageRegion3D) ElementMetadata() []ElementMetadata {
+	return imagingSelectionImageRegion3DElementMetadata
+}
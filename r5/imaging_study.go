@@ -2,37 +2,59 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Representation of the content produced in a DICOM imaging study. A study comprises a set of series, each of which includes a set of images or other data objects (called Service-Object Pair Instances or SOP Instances) acquired or produced in a common context.
 type ImagingStudy struct {
-	ResourceType      string               `json:"resourceType" bson:"resource_type"`                                // Type of resource
-	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
-	Meta              *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
-	ImplicitRules     *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
-	Language          *string              `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
-	Text              *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage    `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
-	Identifier        []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier for imaging study
-	Status            string               `json:"status" bson:"status"`                                             // registered | available | cancelled | entered-in-error | unknown | inactive
-	Modality          []CodeableConcept    `json:"modality,omitempty" bson:"modality,omitempty"`                     // The distinct values for series' modalities
-	Subject           *Reference           `json:"subject" bson:"subject"`                                           // Who or what is the subject of the study
-	Encounter         *Reference           `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter with which this imaging study is associated
-	Started           *string              `json:"started,omitempty" bson:"started,omitempty"`                       // When the study was started
-	BasedOn           []Reference          `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Fulfills plan or order
-	Procedure         []Reference          `json:"procedure,omitempty" bson:"procedure,omitempty"`                   // Imaging performed procedure(s)
-	Referrer          *Reference           `json:"referrer,omitempty" bson:"referrer,omitempty"`                     // Referring physician
-	Endpoint          []Reference          `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                     // Study access endpoint
-	Location          *Reference           `json:"location,omitempty" bson:"location,omitempty"`                     // Where imaging study occurred
-	Reason            []CodeableReference  `json:"reason,omitempty" bson:"reason,omitempty"`                         // Why was imaging study performed?
-	Note              []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                             // Comments made about the imaging study
-	Description       *string              `json:"description,omitempty" bson:"description,omitempty"`               // Study Description or Classification
-	NumberOfSeries    *int                 `json:"numberOfSeries,omitempty" bson:"number_of_series,omitempty"`       // Number of Study Related Series
-	NumberOfInstances *int                 `json:"numberOfInstances,omitempty" bson:"number_of_instances,omitempty"` // Number of Study Related Instances
-	Series            []ImagingStudySeries `json:"series,omitempty" bson:"series,omitempty"`                         // The set of Series belonging to the study
+	ResourceType             string               `json:"resourceType" bson:"resource_type"`                                         // Type of resource
+	Id                       *string              `json:"id,omitempty" bson:"id,omitempty"`                                          // Logical id of this artifact
+	Meta                     *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                                      // Metadata about the resource
+	ImplicitRules            *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                   // A set of rules under which this content was created
+	ImplicitRulesElement     *Element             `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`          // Extensions for implicitRules
+	Language                 *string              `json:"language,omitempty" bson:"language,omitempty"`                              // Language of the resource content
+	LanguageElement          *Element             `json:"_language,omitempty" bson:"language_element,omitempty"`                     // Extensions for language
+	Text                     *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                                      // Text summary of the resource, for human interpretation
+	Contained                []Resource           `json:"contained,omitempty" bson:"contained,omitempty"`                            // Contained, inline Resources
+	Extension                []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored
+	Identifier               []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                          // Business identifier for imaging study
+	Status                   ImagingStudyStatus   `json:"status" bson:"status"`                                                      // registered | available | cancelled | entered-in-error | unknown | inactive
+	StatusElement            *Element             `json:"_status,omitempty" bson:"status_element,omitempty"`                         // Extensions for status
+	Modality                 []CodeableConcept    `json:"modality,omitempty" bson:"modality,omitempty"`                              // The distinct values for series' modalities
+	Subject                  *Reference           `json:"subject" bson:"subject"`                                                    // Who or what is the subject of the study
+	Encounter                *Reference           `json:"encounter,omitempty" bson:"encounter,omitempty"`                            // Encounter with which this imaging study is associated
+	Started                  *DateTime            `json:"started,omitempty" bson:"started,omitempty"`                                // When the study was started
+	StartedElement           *Element             `json:"_started,omitempty" bson:"started_element,omitempty"`                       // Extensions for started
+	BasedOn                  []Reference          `json:"basedOn,omitempty" bson:"based_on,omitempty"`                               // Fulfills plan or order
+	Procedure                []Reference          `json:"procedure,omitempty" bson:"procedure,omitempty"`                            // Imaging performed procedure(s)
+	Referrer                 *Reference           `json:"referrer,omitempty" bson:"referrer,omitempty"`                              // Referring physician
+	Endpoint                 []Reference          `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                              // Study access endpoint
+	Location                 *Reference           `json:"location,omitempty" bson:"location,omitempty"`                              // Where imaging study occurred
+	Reason                   []CodeableReference  `json:"reason,omitempty" bson:"reason,omitempty"`                                  // Why was imaging study performed?
+	Note                     []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                                      // Comments made about the imaging study
+	Description              *string              `json:"description,omitempty" bson:"description,omitempty"`                        // Study Description or Classification
+	DescriptionElement       *Element             `json:"_description,omitempty" bson:"description_element,omitempty"`               // Extensions for description
+	NumberOfSeries           *int                 `json:"numberOfSeries,omitempty" bson:"number_of_series,omitempty"`                // Number of Study Related Series
+	NumberOfSeriesElement    *Element             `json:"_numberOfSeries,omitempty" bson:"number_of_series_element,omitempty"`       // Extensions for numberOfSeries
+	NumberOfInstances        *int                 `json:"numberOfInstances,omitempty" bson:"number_of_instances,omitempty"`          // Number of Study Related Instances
+	NumberOfInstancesElement *Element             `json:"_numberOfInstances,omitempty" bson:"number_of_instances_element,omitempty"` // Extensions for numberOfInstances
+	Series                   []ImagingStudySeries `json:"series,omitempty" bson:"series,omitempty"`                                  // The set of Series belonging to the study
 }
 
+var imagingStudySubjectTargets = []string{"Device", "Group", "Patient"}
+
+var imagingStudyEncounterTargets = []string{"Encounter"}
+
+var imagingStudyBasedOnTargets = []string{"Appointment", "CarePlan", "ServiceRequest", "Task"}
+
+var imagingStudyProcedureTargets = []string{"Procedure"}
+
+var imagingStudyReferrerTargets = []string{"Practitioner", "PractitionerRole"}
+
+var imagingStudyEndpointTargets = []string{"Endpoint"}
+
 func (r *ImagingStudy) Validate() error {
 	if r.ResourceType != "ImagingStudy" {
 		return fmt.Errorf("invalid resourceType: expected 'ImagingStudy', got '%s'", r.ResourceType)
@@ -42,20 +64,55 @@ func (r *ImagingStudy) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Modality {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Modality[%d]: %w", i, err)
@@ -64,31 +121,71 @@ func (r *ImagingStudy) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, imagingStudySubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, imagingStudySubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, imagingStudyEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, imagingStudyEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.Started != nil {
+		if err := r.Started.Validate(); err != nil {
+			return fmt.Errorf("Started: %w", err)
+		}
+	}
+	if r.StartedElement != nil {
+		if err := r.StartedElement.Validate(); err != nil {
+			return fmt.Errorf("StartedElement: %w", err)
+		}
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudyBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudyBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Procedure {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudyProcedureTargets); target != "" {
+			return fmt.Errorf("field 'Procedure[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudyProcedureTargets)
+		}
+	}
 	for i, item := range r.Procedure {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Procedure[%d]: %w", i, err)
 		}
 	}
+	if r.Referrer != nil {
+		if target := invalidReferenceTarget(r.Referrer.Reference, r.Referrer.Type, imagingStudyReferrerTargets); target != "" {
+			return fmt.Errorf("field 'Referrer' cannot refer to %s, expected one of %v", target, imagingStudyReferrerTargets)
+		}
+	}
 	if r.Referrer != nil {
 		if err := r.Referrer.Validate(); err != nil {
 			return fmt.Errorf("Referrer: %w", err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudyEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudyEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
@@ -109,53 +206,343 @@ func (r *ImagingStudy) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.NumberOfSeriesElement != nil {
+		if err := r.NumberOfSeriesElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfSeriesElement: %w", err)
+		}
+	}
+	if r.NumberOfInstancesElement != nil {
+		if err := r.NumberOfInstancesElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfInstancesElement: %w", err)
+		}
+	}
 	for i, item := range r.Series {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Series[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "ImagingStudy")
 }
 
-type ImagingStudySeriesInstance struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Uid      string  `json:"uid" bson:"uid"`                           // DICOM SOP Instance UID
-	SopClass string  `json:"sopClass" bson:"sop_class"`                // DICOM class type
-	Number   *int    `json:"number,omitempty" bson:"number,omitempty"` // The number of this instance in the series
-	Title    *string `json:"title,omitempty" bson:"title,omitempty"`   // Name or title of the instance
+func (r *ImagingStudy) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ImagingStudy" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ImagingStudy', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Modality {
+		item.validateAll(fmt.Sprintf("%s.modality[%d]", path, i), issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, imagingStudySubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, imagingStudySubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, imagingStudyEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, imagingStudyEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Started != nil {
+		if err := r.Started.Validate(); err != nil {
+			issues.add("value", path+".started", err.Error())
+		}
+	}
+	if r.StartedElement != nil {
+		r.StartedElement.validateAll(path+".started", issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudyBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudyBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Procedure {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudyProcedureTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.procedure[%d]", path, i), fmt.Sprintf("field 'Procedure[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudyProcedureTargets))
+		}
+	}
+	for i, item := range r.Procedure {
+		item.validateAll(fmt.Sprintf("%s.procedure[%d]", path, i), issues)
+	}
+	if r.Referrer != nil {
+		if target := invalidReferenceTarget(r.Referrer.Reference, r.Referrer.Type, imagingStudyReferrerTargets); target != "" {
+			issues.add("value", path+".referrer", fmt.Sprintf("field 'Referrer' cannot refer to %s, expected one of %v", target, imagingStudyReferrerTargets))
+		}
+	}
+	if r.Referrer != nil {
+		r.Referrer.validateAll(path+".referrer", issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudyEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudyEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.NumberOfSeriesElement != nil {
+		r.NumberOfSeriesElement.validateAll(path+".numberOfSeries", issues)
+	}
+	if r.NumberOfInstancesElement != nil {
+		r.NumberOfInstancesElement.validateAll(path+".numberOfInstances", issues)
+	}
+	for i, item := range r.Series {
+		item.validateAll(fmt.Sprintf("%s.series[%d]", path, i), issues)
+	}
 }
 
-func (r *ImagingStudySeriesInstance) Validate() error {
-	var emptyString string
-	if r.Uid == emptyString {
-		return fmt.Errorf("field 'Uid' is required")
+func (r *ImagingStudy) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ImagingStudy", &issues)
+	checkInvariants(r, "ImagingStudy", &issues)
+	return issues
+}
+
+var imagingStudyInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ImagingStudy) invariants() []invariant {
+	return imagingStudyInvariants
+}
+
+func (r *ImagingStudy) UnmarshalJSON(data []byte) error {
+	type alias ImagingStudy
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.SopClass == emptyString {
-		return fmt.Errorf("field 'SopClass' is required")
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r ImagingStudy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingStudy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingStudy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingStudy) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingStudyElementTypes = map[string]string{
+	"id":                "id",
+	"implicitRules":     "uri",
+	"language":          "code",
+	"numberOfSeries":    "unsignedInt",
+	"numberOfInstances": "unsignedInt",
+}
+
+func (r *ImagingStudy) elementTypes() map[string]string {
+	return imagingStudyElementTypes
+}
+
+var imagingStudyElementMetadata = []ElementMetadata{
+	{Path: "ImagingStudy.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ImagingStudy.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ImagingStudy.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ImagingStudy.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImagingStudy.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ImagingStudy.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ImagingStudy.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ImagingStudy.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingStudy.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingStudy.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ImagingStudy.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImagingStudy.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/imagingstudy-status"}},
+	{Path: "ImagingStudy.modality", Name: "modality", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingStudy.subject", Name: "subject", Field: 15, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ImagingStudy.encounter", Name: "encounter", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "ImagingStudy.started", Name: "started", Field: 17, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ImagingStudy.basedOn", Name: "basedOn", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Task"}},
+	{Path: "ImagingStudy.procedure", Name: "procedure", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Procedure"}},
+	{Path: "ImagingStudy.referrer", Name: "referrer", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "ImagingStudy.endpoint", Name: "endpoint", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+	{Path: "ImagingStudy.location", Name: "location", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ImagingStudy.reason", Name: "reason", Field: 24, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ImagingStudy.note", Name: "note", Field: 25, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "ImagingStudy.description", Name: "description", Field: 26, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingStudy.numberOfSeries", Name: "numberOfSeries", Field: 28, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingStudy.numberOfInstances", Name: "numberOfInstances", Field: 30, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingStudy.series", Name: "series", Field: 32, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ImagingStudy) ElementMetadata() []ElementMetadata {
+	return imagingStudyElementMetadata
+}
+
+func (r *ImagingStudy) GetResourceType() string {
+	return "ImagingStudy"
+}
+
+func (r *ImagingStudy) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ImagingStudy) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ImagingStudy) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ImagingStudy) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ImagingStudy) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ImagingStudy) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ImagingStudy) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ImagingStudy)(nil)
+
 type ImagingStudySeries struct {
-	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
-	Uid               string                        `json:"uid" bson:"uid"`                                                   // DICOM Series Instance UID
-	Number            *int                          `json:"number,omitempty" bson:"number,omitempty"`                         // Numeric identifier of this series
-	Modality          *CodeableConcept              `json:"modality" bson:"modality"`                                         // The modality used for this series
-	Description       *string                       `json:"description,omitempty" bson:"description,omitempty"`               // Series Description or Classification
-	NumberOfInstances *int                          `json:"numberOfInstances,omitempty" bson:"number_of_instances,omitempty"` // Number of Series Related Instances
-	Endpoint          []Reference                   `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                     // Series access endpoint
-	BodySite          *CodeableReference            `json:"bodySite,omitempty" bson:"body_site,omitempty"`                    // Body part examined
-	Specimen          []Reference                   `json:"specimen,omitempty" bson:"specimen,omitempty"`                     // Specimen imaged
-	Started           *string                       `json:"started,omitempty" bson:"started,omitempty"`                       // When the series started
-	Performer         []ImagingStudySeriesPerformer `json:"performer,omitempty" bson:"performer,omitempty"`                   // Who performed the series
-	Instance          []ImagingStudySeriesInstance  `json:"instance,omitempty" bson:"instance,omitempty"`                     // A single SOP instance from the series
+	Id                       *string                       `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
+	Extension                []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored even if unrecognized
+	Uid                      string                        `json:"uid" bson:"uid"`                                                            // DICOM Series Instance UID
+	UidElement               *Element                      `json:"_uid,omitempty" bson:"uid_element,omitempty"`                               // Extensions for uid
+	Number                   *int                          `json:"number,omitempty" bson:"number,omitempty"`                                  // Numeric identifier of this series
+	NumberElement            *Element                      `json:"_number,omitempty" bson:"number_element,omitempty"`                         // Extensions for number
+	Modality                 *CodeableConcept              `json:"modality" bson:"modality"`                                                  // The modality used for this series
+	Description              *string                       `json:"description,omitempty" bson:"description,omitempty"`                        // Series Description or Classification
+	DescriptionElement       *Element                      `json:"_description,omitempty" bson:"description_element,omitempty"`               // Extensions for description
+	NumberOfInstances        *int                          `json:"numberOfInstances,omitempty" bson:"number_of_instances,omitempty"`          // Number of Series Related Instances
+	NumberOfInstancesElement *Element                      `json:"_numberOfInstances,omitempty" bson:"number_of_instances_element,omitempty"` // Extensions for numberOfInstances
+	Endpoint                 []Reference                   `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                              // Series access endpoint
+	BodySite                 *CodeableReference            `json:"bodySite,omitempty" bson:"body_site,omitempty"`                             // Body part examined
+	Specimen                 []Reference                   `json:"specimen,omitempty" bson:"specimen,omitempty"`                              // Specimen imaged
+	Started                  *DateTime                     `json:"started,omitempty" bson:"started,omitempty"`                                // When the series started
+	StartedElement           *Element                      `json:"_started,omitempty" bson:"started_element,omitempty"`                       // Extensions for started
+	Performer                []ImagingStudySeriesPerformer `json:"performer,omitempty" bson:"performer,omitempty"`                            // Who performed the series
+	Instance                 []ImagingStudySeriesInstance  `json:"instance,omitempty" bson:"instance,omitempty"`                              // A single SOP instance from the series
 }
 
+var imagingStudySeriesEndpointTargets = []string{"Endpoint"}
+
 func (r *ImagingStudySeries) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Uid == emptyString {
 		return fmt.Errorf("field 'Uid' is required")
 	}
+	if r.UidElement != nil {
+		if err := r.UidElement.Validate(); err != nil {
+			return fmt.Errorf("UidElement: %w", err)
+		}
+	}
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
+		}
+	}
 	if r.Modality == nil {
 		return fmt.Errorf("field 'Modality' is required")
 	}
@@ -164,6 +551,21 @@ func (r *ImagingStudySeries) Validate() error {
 			return fmt.Errorf("Modality: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.NumberOfInstancesElement != nil {
+		if err := r.NumberOfInstancesElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfInstancesElement: %w", err)
+		}
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudySeriesEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudySeriesEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
@@ -179,6 +581,16 @@ func (r *ImagingStudySeries) Validate() error {
 			return fmt.Errorf("Specimen[%d]: %w", i, err)
 		}
 	}
+	if r.Started != nil {
+		if err := r.Started.Validate(); err != nil {
+			return fmt.Errorf("Started: %w", err)
+		}
+	}
+	if r.StartedElement != nil {
+		if err := r.StartedElement.Validate(); err != nil {
+			return fmt.Errorf("StartedElement: %w", err)
+		}
+	}
 	for i, item := range r.Performer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
@@ -192,13 +604,141 @@ func (r *ImagingStudySeries) Validate() error {
 	return nil
 }
 
+func (r *ImagingStudySeries) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Uid == emptyString {
+		issues.add("required", path+".uid", "field 'Uid' is required")
+	}
+	if r.UidElement != nil {
+		r.UidElement.validateAll(path+".uid", issues)
+	}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
+	}
+	if r.Modality == nil {
+		issues.add("required", path+".modality", "field 'Modality' is required")
+	}
+	if r.Modality != nil {
+		r.Modality.validateAll(path+".modality", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.NumberOfInstancesElement != nil {
+		r.NumberOfInstancesElement.validateAll(path+".numberOfInstances", issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, imagingStudySeriesEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, imagingStudySeriesEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+	if r.BodySite != nil {
+		r.BodySite.validateAll(path+".bodySite", issues)
+	}
+	for i, item := range r.Specimen {
+		item.validateAll(fmt.Sprintf("%s.specimen[%d]", path, i), issues)
+	}
+	if r.Started != nil {
+		if err := r.Started.Validate(); err != nil {
+			issues.add("value", path+".started", err.Error())
+		}
+	}
+	if r.StartedElement != nil {
+		r.StartedElement.validateAll(path+".started", issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.Instance {
+		item.validateAll(fmt.Sprintf("%s.instance[%d]", path, i), issues)
+	}
+}
+
+var imagingStudySeriesInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingStudySeries) invariants() []invariant {
+	return imagingStudySeriesInvariants
+}
+
+func (r ImagingStudySeries) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingStudySeries) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingStudySeries) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingStudySeries) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingStudySeriesElementTypes = map[string]string{
+	"uid":               "id",
+	"number":            "unsignedInt",
+	"numberOfInstances": "unsignedInt",
+}
+
+func (r *ImagingStudySeries) elementTypes() map[string]string {
+	return imagingStudySeriesElementTypes
+}
+
+var imagingStudySeriesElementMetadata = []ElementMetadata{
+	{Path: "ImagingStudy.series.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingStudy.series.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingStudy.series.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingStudy.series.uid", Name: "uid", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "ImagingStudy.series.number", Name: "number", Field: 5, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingStudy.series.modality", Name: "modality", Field: 7, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingStudy.series.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingStudy.series.numberOfInstances", Name: "numberOfInstances", Field: 10, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingStudy.series.endpoint", Name: "endpoint", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+	{Path: "ImagingStudy.series.bodySite", Name: "bodySite", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ImagingStudy.series.specimen", Name: "specimen", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ImagingStudy.series.started", Name: "started", Field: 15, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ImagingStudy.series.performer", Name: "performer", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImagingStudy.series.instance", Name: "instance", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ImagingStudySeries) ElementMetadata() []ElementMetadata {
+	return imagingStudySeriesElementMetadata
+}
+
 type ImagingStudySeriesPerformer struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Type of performance
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Who performed imaging study
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performance
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Who performed imaging study
 }
 
+var imagingStudySeriesPerformerActorTargets = []string{"CareTeam", "Device", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *ImagingStudySeriesPerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -207,6 +747,11 @@ func (r *ImagingStudySeriesPerformer) Validate() error {
 	if r.Actor == nil {
 		return fmt.Errorf("field 'Actor' is required")
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, imagingStudySeriesPerformerActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, imagingStudySeriesPerformerActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -214,3 +759,193 @@ func (r *ImagingStudySeriesPerformer) Validate() error {
 	}
 	return nil
 }
+
+func (r *ImagingStudySeriesPerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, imagingStudySeriesPerformerActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, imagingStudySeriesPerformerActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var imagingStudySeriesPerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingStudySeriesPerformer) invariants() []invariant {
+	return imagingStudySeriesPerformerInvariants
+}
+
+func (r ImagingStudySeriesPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingStudySeriesPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingStudySeriesPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingStudySeriesPerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingStudySeriesPerformerElementMetadata = []ElementMetadata{
+	{Path: "ImagingStudy.series.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingStudy.series.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingStudy.series.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingStudy.series.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ImagingStudy.series.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *ImagingStudySeriesPerformer) ElementMetadata() []ElementMetadata {
+	return imagingStudySeriesPerformerElementMetadata
+}
+
+type ImagingStudySeriesInstance struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Uid               string      `json:"uid" bson:"uid"`                                                  // DICOM SOP Instance UID
+	UidElement        *Element    `json:"_uid,omitempty" bson:"uid_element,omitempty"`                     // Extensions for uid
+	SopClass          string      `json:"sopClass" bson:"sop_class"`                                       // DICOM class type
+	SopClassElement   *Element    `json:"_sopClass,omitempty" bson:"sop_class_element,omitempty"`          // Extensions for sopClass
+	Number            *int        `json:"number,omitempty" bson:"number,omitempty"`                        // The number of this instance in the series
+	NumberElement     *Element    `json:"_number,omitempty" bson:"number_element,omitempty"`               // Extensions for number
+	Title             *string     `json:"title,omitempty" bson:"title,omitempty"`                          // Name or title of the instance
+	TitleElement      *Element    `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+}
+
+func (r *ImagingStudySeriesInstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Uid == emptyString {
+		return fmt.Errorf("field 'Uid' is required")
+	}
+	if r.UidElement != nil {
+		if err := r.UidElement.Validate(); err != nil {
+			return fmt.Errorf("UidElement: %w", err)
+		}
+	}
+	if r.SopClass == emptyString {
+		return fmt.Errorf("field 'SopClass' is required")
+	}
+	if r.SopClassElement != nil {
+		if err := r.SopClassElement.Validate(); err != nil {
+			return fmt.Errorf("SopClassElement: %w", err)
+		}
+	}
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImagingStudySeriesInstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Uid == emptyString {
+		issues.add("required", path+".uid", "field 'Uid' is required")
+	}
+	if r.UidElement != nil {
+		r.UidElement.validateAll(path+".uid", issues)
+	}
+	if r.SopClass == emptyString {
+		issues.add("required", path+".sopClass", "field 'SopClass' is required")
+	}
+	if r.SopClassElement != nil {
+		r.SopClassElement.validateAll(path+".sopClass", issues)
+	}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+}
+
+var imagingStudySeriesInstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImagingStudySeriesInstance) invariants() []invariant {
+	return imagingStudySeriesInstanceInvariants
+}
+
+func (r ImagingStudySeriesInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImagingStudySeriesInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImagingStudySeriesInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImagingStudySeriesInstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var imagingStudySeriesInstanceElementTypes = map[string]string{
+	"uid":      "id",
+	"sopClass": "oid",
+	"number":   "unsignedInt",
+}
+
+func (r *ImagingStudySeriesInstance) elementTypes() map[string]string {
+	return imagingStudySeriesInstanceElementTypes
+}
+
+var imagingStudySeriesInstanceElementMetadata = []ElementMetadata{
+	{Path: "ImagingStudy.series.instance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImagingStudy.series.instance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImagingStudy.series.instance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImagingStudy.series.instance.uid", Name: "uid", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "ImagingStudy.series.instance.sopClass", Name: "sopClass", Field: 5, Min: 1, Max: "1", Types: []string{"oid"}},
+	{Path: "ImagingStudy.series.instance.number", Name: "number", Field: 7, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ImagingStudy.series.instance.title", Name: "title", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ImagingStudySeriesInstance) ElementMetadata() []ElementMetadata {
+	return imagingStudySeriesInstanceElementMetadata
+}
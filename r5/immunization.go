@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,24 +12,32 @@ type Immunization struct {
 	Id                    *string                          `json:"id,omitempty" bson:"id,omitempty"`                                        // Logical id of this artifact
 	Meta                  *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                                    // Metadata about the resource
 	ImplicitRules         *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                 // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                         `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`        // Extensions for implicitRules
 	Language              *string                          `json:"language,omitempty" bson:"language,omitempty"`                            // Language of the resource content
+	LanguageElement       *Element                         `json:"_language,omitempty" bson:"language_element,omitempty"`                   // Extensions for language
 	Text                  *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                                    // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage                `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Contained             []Resource                       `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Extension             []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension     []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored
 	Identifier            []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                        // Business identifier
 	BasedOn               []Reference                      `json:"basedOn,omitempty" bson:"based_on,omitempty"`                             // Authority that the immunization event is based on
-	Status                string                           `json:"status" bson:"status"`                                                    // completed | entered-in-error | not-done
+	Status                ImmunizationStatusCodes          `json:"status" bson:"status"`                                                    // completed | entered-in-error | not-done
+	StatusElement         *Element                         `json:"_status,omitempty" bson:"status_element,omitempty"`                       // Extensions for status
 	StatusReason          *CodeableConcept                 `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                   // Reason for current status
 	VaccineCode           *CodeableConcept                 `json:"vaccineCode" bson:"vaccine_code"`                                         // Vaccine administered
 	AdministeredProduct   *CodeableReference               `json:"administeredProduct,omitempty" bson:"administered_product,omitempty"`     // Product that was administered
 	Manufacturer          *CodeableReference               `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                    // Vaccine manufacturer
 	LotNumber             *string                          `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`                         // Vaccine lot number
-	ExpirationDate        *string                          `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`               // Vaccine expiration date
+	LotNumberElement      *Element                         `json:"_lotNumber,omitempty" bson:"lot_number_element,omitempty"`                // Extensions for lotNumber
+	ExpirationDate        *Date                            `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`               // Vaccine expiration date
+	ExpirationDateElement *Element                         `json:"_expirationDate,omitempty" bson:"expiration_date_element,omitempty"`      // Extensions for expirationDate
 	Patient               *Reference                       `json:"patient" bson:"patient"`                                                  // Who was immunized
 	Encounter             *Reference                       `json:"encounter,omitempty" bson:"encounter,omitempty"`                          // Encounter immunization was part of
 	SupportingInformation []Reference                      `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"` // Additional information in support of the immunization
-	OccurrenceDateTime    *string                          `json:"occurrenceDateTime" bson:"occurrence_date_time"`                          // Vaccine administration date
-	OccurrenceString      *string                          `json:"occurrenceString" bson:"occurrence_string"`                               // Vaccine administration date
+	Occurrence            ImmunizationOccurrence           `json:"-" bson:"occurrence,omitempty"`                                           // Vaccine administration date
+	OccurrenceElement     *Element                         `json:"-" bson:"occurrence_element,omitempty"`                                   // Extensions for occurrence[x]
 	PrimarySource         *bool                            `json:"primarySource,omitempty" bson:"primary_source,omitempty"`                 // Indicates context the data was captured in
+	PrimarySourceElement  *Element                         `json:"_primarySource,omitempty" bson:"primary_source_element,omitempty"`        // Extensions for primarySource
 	InformationSource     *CodeableReference               `json:"informationSource,omitempty" bson:"information_source,omitempty"`         // Indicates the source of a  reported record
 	Location              *Reference                       `json:"location,omitempty" bson:"location,omitempty"`                            // The service delivery location
 	Site                  *CodeableConcept                 `json:"site,omitempty" bson:"site,omitempty"`                                    // Body site vaccine  was administered
@@ -38,13 +47,22 @@ type Immunization struct {
 	Note                  []Annotation                     `json:"note,omitempty" bson:"note,omitempty"`                                    // Additional immunization notes
 	Reason                []CodeableReference              `json:"reason,omitempty" bson:"reason,omitempty"`                                // Why immunization occurred
 	IsSubpotent           *bool                            `json:"isSubpotent,omitempty" bson:"is_subpotent,omitempty"`                     // Dose potency
+	IsSubpotentElement    *Element                         `json:"_isSubpotent,omitempty" bson:"is_subpotent_element,omitempty"`            // Extensions for isSubpotent
 	SubpotentReason       []CodeableConcept                `json:"subpotentReason,omitempty" bson:"subpotent_reason,omitempty"`             // Reason for being subpotent
 	ProgramEligibility    []ImmunizationProgramEligibility `json:"programEligibility,omitempty" bson:"program_eligibility,omitempty"`       // Patient eligibility for a specific vaccination program
 	FundingSource         *CodeableConcept                 `json:"fundingSource,omitempty" bson:"funding_source,omitempty"`                 // Funding source for the vaccine
 	Reaction              []ImmunizationReaction           `json:"reaction,omitempty" bson:"reaction,omitempty"`                            // Details of a reaction that followed the immunization
 	ProtocolApplied       []ImmunizationProtocolApplied    `json:"protocolApplied,omitempty" bson:"protocol_applied,omitempty"`             // Protocol followed by the provider
+
+	occurrenceVariants []string // JSON properties of Immunization.occurrence[x] when more than one was decoded
 }
 
+var immunizationPatientTargets = []string{"Patient"}
+
+var immunizationEncounterTargets = []string{"Encounter"}
+
+var immunizationLocationTargets = []string{"Location"}
+
 func (r *Immunization) Validate() error {
 	if r.ResourceType != "Immunization" {
 		return fmt.Errorf("invalid resourceType: expected 'Immunization', got '%s'", r.ResourceType)
@@ -54,11 +72,39 @@ func (r *Immunization) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -69,10 +115,17 @@ func (r *Immunization) Validate() error {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.StatusReason != nil {
 		if err := r.StatusReason.Validate(); err != nil {
 			return fmt.Errorf("StatusReason: %w", err)
@@ -96,14 +149,39 @@ func (r *Immunization) Validate() error {
 			return fmt.Errorf("Manufacturer: %w", err)
 		}
 	}
+	if r.LotNumberElement != nil {
+		if err := r.LotNumberElement.Validate(); err != nil {
+			return fmt.Errorf("LotNumberElement: %w", err)
+		}
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDate: %w", err)
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		if err := r.ExpirationDateElement.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDateElement: %w", err)
+		}
+	}
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, immunizationPatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, immunizationPatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, immunizationEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, immunizationEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -114,17 +192,37 @@ func (r *Immunization) Validate() error {
 			return fmt.Errorf("SupportingInformation[%d]: %w", i, err)
 		}
 	}
-	if r.OccurrenceDateTime == nil {
-		return fmt.Errorf("field 'OccurrenceDateTime' is required")
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence == nil {
+		return fmt.Errorf("field 'Occurrence' is required")
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
 	}
-	if r.OccurrenceString == nil {
-		return fmt.Errorf("field 'OccurrenceString' is required")
+	if r.PrimarySourceElement != nil {
+		if err := r.PrimarySourceElement.Validate(); err != nil {
+			return fmt.Errorf("PrimarySourceElement: %w", err)
+		}
 	}
 	if r.InformationSource != nil {
 		if err := r.InformationSource.Validate(); err != nil {
 			return fmt.Errorf("InformationSource: %w", err)
 		}
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, immunizationLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, immunizationLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
@@ -160,6 +258,11 @@ func (r *Immunization) Validate() error {
 			return fmt.Errorf("Reason[%d]: %w", i, err)
 		}
 	}
+	if r.IsSubpotentElement != nil {
+		if err := r.IsSubpotentElement.Validate(); err != nil {
+			return fmt.Errorf("IsSubpotentElement: %w", err)
+		}
+	}
 	for i, item := range r.SubpotentReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("SubpotentReason[%d]: %w", i, err)
@@ -185,16 +288,421 @@ func (r *Immunization) Validate() error {
 			return fmt.Errorf("ProtocolApplied[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Immunization")
+}
+
+func (r *Immunization) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Immunization" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Immunization', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReason != nil {
+		r.StatusReason.validateAll(path+".statusReason", issues)
+	}
+	if r.VaccineCode == nil {
+		issues.add("required", path+".vaccineCode", "field 'VaccineCode' is required")
+	}
+	if r.VaccineCode != nil {
+		r.VaccineCode.validateAll(path+".vaccineCode", issues)
+	}
+	if r.AdministeredProduct != nil {
+		r.AdministeredProduct.validateAll(path+".administeredProduct", issues)
+	}
+	if r.Manufacturer != nil {
+		r.Manufacturer.validateAll(path+".manufacturer", issues)
+	}
+	if r.LotNumberElement != nil {
+		r.LotNumberElement.validateAll(path+".lotNumber", issues)
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			issues.add("value", path+".expirationDate", err.Error())
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		r.ExpirationDateElement.validateAll(path+".expirationDate", issues)
+	}
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, immunizationPatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, immunizationPatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, immunizationEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, immunizationEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	for i, item := range r.SupportingInformation {
+		item.validateAll(fmt.Sprintf("%s.supportingInformation[%d]", path, i), issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence == nil {
+		issues.add("required", path+".occurrence", "field 'Occurrence' is required")
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.PrimarySourceElement != nil {
+		r.PrimarySourceElement.validateAll(path+".primarySource", issues)
+	}
+	if r.InformationSource != nil {
+		r.InformationSource.validateAll(path+".informationSource", issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, immunizationLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, immunizationLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	if r.Site != nil {
+		r.Site.validateAll(path+".site", issues)
+	}
+	if r.Route != nil {
+		r.Route.validateAll(path+".route", issues)
+	}
+	if r.DoseQuantity != nil {
+		r.DoseQuantity.validateAll(path+".doseQuantity", issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.IsSubpotentElement != nil {
+		r.IsSubpotentElement.validateAll(path+".isSubpotent", issues)
+	}
+	for i, item := range r.SubpotentReason {
+		item.validateAll(fmt.Sprintf("%s.subpotentReason[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgramEligibility {
+		item.validateAll(fmt.Sprintf("%s.programEligibility[%d]", path, i), issues)
+	}
+	if r.FundingSource != nil {
+		r.FundingSource.validateAll(path+".fundingSource", issues)
+	}
+	for i, item := range r.Reaction {
+		item.validateAll(fmt.Sprintf("%s.reaction[%d]", path, i), issues)
+	}
+	for i, item := range r.ProtocolApplied {
+		item.validateAll(fmt.Sprintf("%s.protocolApplied[%d]", path, i), issues)
+	}
+}
+
+func (r *Immunization) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Immunization", &issues)
+	checkInvariants(r, "Immunization", &issues)
+	return issues
+}
+
+var immunizationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Immunization) invariants() []invariant {
+	return immunizationInvariants
+}
+
+func (r Immunization) MarshalJSON() ([]byte, error) {
+	type alias Immunization
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Immunization) UnmarshalJSON(data []byte) error {
+	type alias Immunization
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", immunizationOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r Immunization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Immunization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Immunization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Immunization) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Immunization) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(immunizationOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var immunizationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Immunization) elementTypes() map[string]string {
+	return immunizationElementTypes
+}
+
+var immunizationElementMetadata = []ElementMetadata{
+	{Path: "Immunization.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Immunization.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Immunization.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Immunization.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Immunization.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Immunization.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Immunization.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Immunization.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Immunization.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Immunization.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Immunization.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Immunization.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Immunization.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/immunization-status"}},
+	{Path: "Immunization.statusReason", Name: "statusReason", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.vaccineCode", Name: "vaccineCode", Field: 16, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.administeredProduct", Name: "administeredProduct", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Immunization.manufacturer", Name: "manufacturer", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Immunization.lotNumber", Name: "lotNumber", Field: 19, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Immunization.expirationDate", Name: "expirationDate", Field: 21, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Immunization.patient", Name: "patient", Field: 23, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Immunization.encounter", Name: "encounter", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Immunization.supportingInformation", Name: "supportingInformation", Field: 25, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Immunization.occurrence[x]", Name: "occurrence", Field: 26, Min: 1, Max: "1", Types: []string{"dateTime", "string"}},
+	{Path: "Immunization.primarySource", Name: "primarySource", Field: 28, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Immunization.informationSource", Name: "informationSource", Field: 30, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Immunization.location", Name: "location", Field: 31, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Immunization.site", Name: "site", Field: 32, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.route", Name: "route", Field: 33, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.doseQuantity", Name: "doseQuantity", Field: 34, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Immunization.performer", Name: "performer", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Immunization.note", Name: "note", Field: 36, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Immunization.reason", Name: "reason", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Immunization.isSubpotent", Name: "isSubpotent", Field: 38, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Immunization.subpotentReason", Name: "subpotentReason", Field: 40, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.programEligibility", Name: "programEligibility", Field: 41, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Immunization.fundingSource", Name: "fundingSource", Field: 42, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.reaction", Name: "reaction", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Immunization.protocolApplied", Name: "protocolApplied", Field: 44, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Immunization) ElementMetadata() []ElementMetadata {
+	return immunizationElementMetadata
+}
+
+// ImmunizationOccurrence is the type of Immunization.occurrence[x]. It is implemented by the ImmunizationOccurrence* variant types.
+type ImmunizationOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isImmunizationOccurrence()
+}
+
+// ImmunizationOccurrenceDateTime is the dateTime variant of ImmunizationOccurrence.
+type ImmunizationOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v ImmunizationOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ImmunizationOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ImmunizationOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ImmunizationOccurrenceDateTime) isImmunizationOccurrence() {}
+
+// ImmunizationOccurrenceString is the string variant of ImmunizationOccurrence.
+type ImmunizationOccurrenceString string
+
+func (v ImmunizationOccurrenceString) Validate() error {
+	return nil
+}
+
+func (v ImmunizationOccurrenceString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ImmunizationOccurrenceString) FHIRType() string {
+	return "string"
+}
+
+func (v ImmunizationOccurrenceString) isImmunizationOccurrence() {}
+
+var immunizationOccurrenceVariants = []ImmunizationOccurrence{
+	ImmunizationOccurrenceDateTime{},
+	ImmunizationOccurrenceString(""),
+}
+
+func (r *Immunization) GetResourceType() string {
+	return "Immunization"
+}
+
+func (r *Immunization) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Immunization) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Immunization) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Immunization) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Immunization) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Immunization) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Immunization) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Immunization)(nil)
+
 type ImmunizationPerformer struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Type of performance
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Individual or organization who performed the event
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performance
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Individual or organization who performed the event
 }
 
+var immunizationPerformerActorTargets = []string{"Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *ImmunizationPerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -203,6 +711,11 @@ func (r *ImmunizationPerformer) Validate() error {
 	if r.Actor == nil {
 		return fmt.Errorf("field 'Actor' is required")
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, immunizationPerformerActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, immunizationPerformerActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -211,13 +724,84 @@ func (r *ImmunizationPerformer) Validate() error {
 	return nil
 }
 
+func (r *ImmunizationPerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, immunizationPerformerActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, immunizationPerformerActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var immunizationPerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImmunizationPerformer) invariants() []invariant {
+	return immunizationPerformerInvariants
+}
+
+func (r ImmunizationPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImmunizationPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImmunizationPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImmunizationPerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var immunizationPerformerElementMetadata = []ElementMetadata{
+	{Path: "Immunization.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Immunization.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Immunization.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Immunization.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *ImmunizationPerformer) ElementMetadata() []ElementMetadata {
+	return immunizationPerformerElementMetadata
+}
+
 type ImmunizationProgramEligibility struct {
-	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`    // Unique id for inter-element referencing
-	Program       *CodeableConcept `json:"program" bson:"program"`              // The program that eligibility is declared for
-	ProgramStatus *CodeableConcept `json:"programStatus" bson:"program_status"` // The patient's eligibility status for the program
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Program           *CodeableConcept `json:"program" bson:"program"`                                          // The program that eligibility is declared for
+	ProgramStatus     *CodeableConcept `json:"programStatus" bson:"program_status"`                             // The patient's eligibility status for the program
 }
 
 func (r *ImmunizationProgramEligibility) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Program == nil {
 		return fmt.Errorf("field 'Program' is required")
 	}
@@ -237,32 +821,196 @@ func (r *ImmunizationProgramEligibility) Validate() error {
 	return nil
 }
 
+func (r *ImmunizationProgramEligibility) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Program == nil {
+		issues.add("required", path+".program", "field 'Program' is required")
+	}
+	if r.Program != nil {
+		r.Program.validateAll(path+".program", issues)
+	}
+	if r.ProgramStatus == nil {
+		issues.add("required", path+".programStatus", "field 'ProgramStatus' is required")
+	}
+	if r.ProgramStatus != nil {
+		r.ProgramStatus.validateAll(path+".programStatus", issues)
+	}
+}
+
+var immunizationProgramEligibilityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImmunizationProgramEligibility) invariants() []invariant {
+	return immunizationProgramEligibilityInvariants
+}
+
+func (r ImmunizationProgramEligibility) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImmunizationProgramEligibility) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImmunizationProgramEligibility) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImmunizationProgramEligibility) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var immunizationProgramEligibilityElementMetadata = []ElementMetadata{
+	{Path: "Immunization.programEligibility.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Immunization.programEligibility.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Immunization.programEligibility.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Immunization.programEligibility.program", Name: "program", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.programEligibility.programStatus", Name: "programStatus", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ImmunizationProgramEligibility) ElementMetadata() []ElementMetadata {
+	return immunizationProgramEligibilityElementMetadata
+}
+
 type ImmunizationReaction struct {
-	Id            *string            `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Date          *string            `json:"date,omitempty" bson:"date,omitempty"`                   // When reaction started
-	Manifestation *CodeableReference `json:"manifestation,omitempty" bson:"manifestation,omitempty"` // Additional information on reaction
-	Reported      *bool              `json:"reported,omitempty" bson:"reported,omitempty"`           // Indicates self-reported reaction
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Date              *DateTime          `json:"date,omitempty" bson:"date,omitempty"`                            // When reaction started
+	DateElement       *Element           `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Manifestation     *CodeableReference `json:"manifestation,omitempty" bson:"manifestation,omitempty"`          // Additional information on reaction
+	Reported          *bool              `json:"reported,omitempty" bson:"reported,omitempty"`                    // Indicates self-reported reaction
+	ReportedElement   *Element           `json:"_reported,omitempty" bson:"reported_element,omitempty"`           // Extensions for reported
 }
 
 func (r *ImmunizationReaction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	if r.Manifestation != nil {
 		if err := r.Manifestation.Validate(); err != nil {
 			return fmt.Errorf("Manifestation: %w", err)
 		}
 	}
+	if r.ReportedElement != nil {
+		if err := r.ReportedElement.Validate(); err != nil {
+			return fmt.Errorf("ReportedElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *ImmunizationReaction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Manifestation != nil {
+		r.Manifestation.validateAll(path+".manifestation", issues)
+	}
+	if r.ReportedElement != nil {
+		r.ReportedElement.validateAll(path+".reported", issues)
+	}
+}
+
+var immunizationReactionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImmunizationReaction) invariants() []invariant {
+	return immunizationReactionInvariants
+}
+
+func (r ImmunizationReaction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImmunizationReaction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImmunizationReaction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImmunizationReaction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var immunizationReactionElementMetadata = []ElementMetadata{
+	{Path: "Immunization.reaction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Immunization.reaction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Immunization.reaction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Immunization.reaction.date", Name: "date", Field: 3, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Immunization.reaction.manifestation", Name: "manifestation", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Immunization.reaction.reported", Name: "reported", Field: 6, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *ImmunizationReaction) ElementMetadata() []ElementMetadata {
+	return immunizationReactionElementMetadata
+}
+
 type ImmunizationProtocolApplied struct {
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Series        *string           `json:"series,omitempty" bson:"series,omitempty"`                // Name of vaccine series
-	Authority     *Reference        `json:"authority,omitempty" bson:"authority,omitempty"`          // Who is responsible for publishing the recommendations
-	TargetDisease []CodeableConcept `json:"targetDisease,omitempty" bson:"target_disease,omitempty"` // Vaccine preventable disease being targeted
-	DoseNumber    *CodeableConcept  `json:"doseNumber,omitempty" bson:"dose_number,omitempty"`       // Dose number within series
-	SeriesDoses   *CodeableConcept  `json:"seriesDoses,omitempty" bson:"series_doses,omitempty"`     // Recommended number of doses for immunity
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Series            *string           `json:"series,omitempty" bson:"series,omitempty"`                        // Name of vaccine series
+	SeriesElement     *Element          `json:"_series,omitempty" bson:"series_element,omitempty"`               // Extensions for series
+	Authority         *Reference        `json:"authority,omitempty" bson:"authority,omitempty"`                  // Who is responsible for publishing the recommendations
+	TargetDisease     []CodeableConcept `json:"targetDisease,omitempty" bson:"target_disease,omitempty"`         // Vaccine preventable disease being targeted
+	DoseNumber        *CodeableConcept  `json:"doseNumber,omitempty" bson:"dose_number,omitempty"`               // Dose number within series
+	SeriesDoses       *CodeableConcept  `json:"seriesDoses,omitempty" bson:"series_doses,omitempty"`             // Recommended number of doses for immunity
 }
 
 func (r *ImmunizationProtocolApplied) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SeriesElement != nil {
+		if err := r.SeriesElement.Validate(); err != nil {
+			return fmt.Errorf("SeriesElement: %w", err)
+		}
+	}
 	if r.Authority != nil {
 		if err := r.Authority.Validate(); err != nil {
 			return fmt.Errorf("Authority: %w", err)
@@ -285,3 +1033,66 @@ func (r *ImmunizationProtocolApplied) Validate() error {
 	}
 	return nil
 }
+
+func (r *ImmunizationProtocolApplied) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SeriesElement != nil {
+		r.SeriesElement.validateAll(path+".series", issues)
+	}
+	if r.Authority != nil {
+		r.Authority.validateAll(path+".authority", issues)
+	}
+	for i, item := range r.TargetDisease {
+		item.validateAll(fmt.Sprintf("%s.targetDisease[%d]", path, i), issues)
+	}
+	if r.DoseNumber != nil {
+		r.DoseNumber.validateAll(path+".doseNumber", issues)
+	}
+	if r.SeriesDoses != nil {
+		r.SeriesDoses.validateAll(path+".seriesDoses", issues)
+	}
+}
+
+var immunizationProtocolAppliedInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImmunizationProtocolApplied) invariants() []invariant {
+	return immunizationProtocolAppliedInvariants
+}
+
+func (r ImmunizationProtocolApplied) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImmunizationProtocolApplied) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImmunizationProtocolApplied) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImmunizationProtocolApplied) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var immunizationProtocolAppliedElementMetadata = []ElementMetadata{
+	{Path: "Immunization.protocolApplied.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Immunization.protocolApplied.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Immunization.protocolApplied.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Immunization.protocolApplied.series", Name: "series", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Immunization.protocolApplied.authority", Name: "authority", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Immunization.protocolApplied.targetDisease", Name: "targetDisease", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.protocolApplied.doseNumber", Name: "doseNumber", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Immunization.protocolApplied.seriesDoses", Name: "seriesDoses", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ImmunizationProtocolApplied) ElementMetadata() []ElementMetadata {
+	return immunizationProtocolAppliedElementMetadata
+}
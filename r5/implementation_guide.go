@@ -2,43 +2,65 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A set of rules of how a particular interoperability or standards problem is solved - typically through the use of FHIR resources. This resource is used to gather all the parts of an implementation guide into a logical whole and to publish a computable definition of all the parts.
 type ImplementationGuide struct {
-	ResourceType           string                         `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                        `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                        `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    string                         `json:"url" bson:"url"`                                                             // Canonical identifier for this implementation guide, represented as a URI (globally unique)
-	Identifier             []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the implementation guide (business identifier)
-	Version                *string                        `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the implementation guide
-	VersionAlgorithmString *string                        `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                        `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                         `json:"name" bson:"name"`                                                           // Name for this implementation guide (computer friendly)
-	Title                  *string                        `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this implementation guide (human friendly)
-	Status                 string                         `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                          `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                        `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                        `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                        `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the implementation guide
-	UseContext             []UsageContext                 `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept              `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the implementation guide (if applicable)
-	Purpose                *string                        `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this implementation guide is defined
-	Copyright              *string                        `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                        `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	PackageId              string                         `json:"packageId" bson:"package_id"`                                                // NPM Package name for IG
-	License                *string                        `json:"license,omitempty" bson:"license,omitempty"`                                 // SPDX license code for this IG (or not-open-source)
-	FhirVersion            []string                       `json:"fhirVersion" bson:"fhir_version"`                                            // FHIR Version(s) this Implementation Guide targets
-	DependsOn              []ImplementationGuideDependsOn `json:"dependsOn,omitempty" bson:"depends_on,omitempty"`                            // Another Implementation guide this depends on
-	Global                 []ImplementationGuideGlobal    `json:"global,omitempty" bson:"global,omitempty"`                                   // Profiles that apply globally
-	Definition             *ImplementationGuideDefinition `json:"definition,omitempty" bson:"definition,omitempty"`                           // Information needed to build the IG
-	Manifest               *ImplementationGuideManifest   `json:"manifest,omitempty" bson:"manifest,omitempty"`                               // Information about an assembled IG
+	ResourceType            string                              `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                             `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                               `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                             `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                            `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                          `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                          `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     string                              `json:"url" bson:"url"`                                                     // Canonical identifier for this implementation guide, represented as a URI (globally unique)
+	UrlElement              *Element                            `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                        `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the implementation guide (business identifier)
+	Version                 *string                             `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the implementation guide
+	VersionElement          *Element                            `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        ImplementationGuideVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                            `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    string                              `json:"name" bson:"name"`                                                   // Name for this implementation guide (computer friendly)
+	NameElement             *Element                            `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                             `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this implementation guide (human friendly)
+	TitleElement            *Element                            `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus                   `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                            `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                               `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                            `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                           `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                            `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                             `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                            `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                     `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                             `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the implementation guide
+	DescriptionElement      *Element                            `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                      `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                   `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the implementation guide (if applicable)
+	Purpose                 *string                             `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this implementation guide is defined
+	PurposeElement          *Element                            `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                             `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                            `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                             `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                            `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	PackageId               string                              `json:"packageId" bson:"package_id"`                                        // NPM Package name for IG
+	PackageIdElement        *Element                            `json:"_packageId,omitempty" bson:"package_id_element,omitempty"`           // Extensions for packageId
+	License                 *string                             `json:"license,omitempty" bson:"license,omitempty"`                         // SPDX license code for this IG (or not-open-source)
+	LicenseElement          *Element                            `json:"_license,omitempty" bson:"license_element,omitempty"`                // Extensions for license
+	FhirVersion             []string                            `json:"fhirVersion" bson:"fhir_version"`                                    // FHIR Version(s) this Implementation Guide targets
+	FhirVersionElement      []*Element                          `json:"_fhirVersion,omitempty" bson:"fhir_version_element,omitempty"`       // Extensions for fhirVersion
+	DependsOn               []ImplementationGuideDependsOn      `json:"dependsOn,omitempty" bson:"depends_on,omitempty"`                    // Another Implementation guide this depends on
+	Global                  []ImplementationGuideGlobal         `json:"global,omitempty" bson:"global,omitempty"`                           // Profiles that apply globally
+	Definition              *ImplementationGuideDefinition      `json:"definition,omitempty" bson:"definition,omitempty"`                   // Information needed to build the IG
+	Manifest                *ImplementationGuideManifest        `json:"manifest,omitempty" bson:"manifest,omitempty"`                       // Information about an assembled IG
+
+	versionAlgorithmVariants []string // JSON properties of ImplementationGuide.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *ImplementationGuide) Validate() error {
@@ -50,36 +72,125 @@ func (r *ImplementationGuide) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -90,12 +201,45 @@ func (r *ImplementationGuide) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
 	if r.PackageId == emptyString {
 		return fmt.Errorf("field 'PackageId' is required")
 	}
+	if r.PackageIdElement != nil {
+		if err := r.PackageIdElement.Validate(); err != nil {
+			return fmt.Errorf("PackageIdElement: %w", err)
+		}
+	}
+	if r.LicenseElement != nil {
+		if err := r.LicenseElement.Validate(); err != nil {
+			return fmt.Errorf("LicenseElement: %w", err)
+		}
+	}
 	if len(r.FhirVersion) < 1 {
 		return fmt.Errorf("field 'FhirVersion' must have at least 1 elements")
 	}
+	for i, item := range r.FhirVersionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("FhirVersionElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.DependsOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("DependsOn[%d]: %w", i, err)
@@ -116,244 +260,1996 @@ func (r *ImplementationGuide) Validate() error {
 			return fmt.Errorf("Manifest: %w", err)
 		}
 	}
-	return nil
-}
-
-type ImplementationGuideGlobal struct {
-	Id      *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type    string  `json:"type" bson:"type"`                 // Type this profile applies to
-	Profile string  `json:"profile" bson:"profile"`           // Profile that all resources must conform to
+	return validateInvariants(r, "ImplementationGuide")
 }
 
-func (r *ImplementationGuideGlobal) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+func (r *ImplementationGuide) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ImplementationGuide" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ImplementationGuide', got '%s'", r.ResourceType))
 	}
-	if r.Profile == emptyString {
-		return fmt.Errorf("field 'Profile' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	return nil
-}
-
-type ImplementationGuideDefinition struct {
-	Id        *string                                  `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Grouping  []ImplementationGuideDefinitionGrouping  `json:"grouping,omitempty" bson:"grouping,omitempty"`   // Grouping used to present related resources in the IG
-	Resource  []ImplementationGuideDefinitionResource  `json:"resource,omitempty" bson:"resource,omitempty"`   // Resource in the implementation guide
-	Page      *ImplementationGuideDefinitionPage       `json:"page,omitempty" bson:"page,omitempty"`           // Page/Section in the Guide
-	Parameter []ImplementationGuideDefinitionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"` // Defines how IG is built by tools
-	Template  []ImplementationGuideDefinitionTemplate  `json:"template,omitempty" bson:"template,omitempty"`   // A template for building resources
-}
-
-func (r *ImplementationGuideDefinition) Validate() error {
-	for i, item := range r.Grouping {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Grouping[%d]: %w", i, err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	for i, item := range r.Resource {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Resource[%d]: %w", i, err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.Page != nil {
-		if err := r.Page.Validate(); err != nil {
-			return fmt.Errorf("Page: %w", err)
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	for i, item := range r.Parameter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Parameter[%d]: %w", i, err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	for i, item := range r.Template {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Template[%d]: %w", i, err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.PackageId == emptyString {
+		issues.add("required", path+".packageId", "field 'PackageId' is required")
+	}
+	if r.PackageIdElement != nil {
+		r.PackageIdElement.validateAll(path+".packageId", issues)
+	}
+	if r.LicenseElement != nil {
+		r.LicenseElement.validateAll(path+".license", issues)
+	}
+	if len(r.FhirVersion) < 1 {
+		issues.add("required", path+".fhirVersion", "field 'FhirVersion' must have at least 1 elements")
+	}
+	for i, item := range r.FhirVersionElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.fhirVersion[%d]", path, i), issues)
+	}
+	for i, item := range r.DependsOn {
+		item.validateAll(fmt.Sprintf("%s.dependsOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Global {
+		item.validateAll(fmt.Sprintf("%s.global[%d]", path, i), issues)
+	}
+	if r.Definition != nil {
+		r.Definition.validateAll(path+".definition", issues)
+	}
+	if r.Manifest != nil {
+		r.Manifest.validateAll(path+".manifest", issues)
 	}
-	return nil
 }
 
-type ImplementationGuideDefinitionGrouping struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Name        string  `json:"name" bson:"name"`                                   // Descriptive name for the package
-	Description *string `json:"description,omitempty" bson:"description,omitempty"` // Human readable text describing the package
+func (r *ImplementationGuide) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ImplementationGuide", &issues)
+	checkInvariants(r, "ImplementationGuide", &issues)
+	return issues
 }
 
-func (r *ImplementationGuideDefinitionGrouping) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
-	return nil
+var implementationGuideInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type ImplementationGuideDefinitionResource struct {
-	Id          *string    `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Reference   *Reference `json:"reference" bson:"reference"`                          // Location of the resource
-	FhirVersion []string   `json:"fhirVersion,omitempty" bson:"fhir_version,omitempty"` // Versions this applies to (if different to IG)
-	Name        *string    `json:"name,omitempty" bson:"name,omitempty"`                // Human readable name for the resource
-	Description *string    `json:"description,omitempty" bson:"description,omitempty"`  // Reason why included in guide
-	IsExample   *bool      `json:"isExample,omitempty" bson:"is_example,omitempty"`     // Is this an example
-	Profile     []string   `json:"profile,omitempty" bson:"profile,omitempty"`          // Profile(s) this resource is valid against
-	GroupingId  *string    `json:"groupingId,omitempty" bson:"grouping_id,omitempty"`   // Grouping this is part of
+func (r *ImplementationGuide) invariants() []invariant {
+	return implementationGuideInvariants
 }
 
-func (r *ImplementationGuideDefinitionResource) Validate() error {
-	if r.Reference == nil {
-		return fmt.Errorf("field 'Reference' is required")
+func (r ImplementationGuide) MarshalJSON() ([]byte, error) {
+	type alias ImplementationGuide
+	out := struct {
+		alias
+		FhirVersion        []*string  `json:"fhirVersion"`
+		FhirVersionElement []*Element `json:"_fhirVersion,omitempty"`
+	}{alias: alias(r)}
+	out.FhirVersion, out.FhirVersionElement = alignPrimitiveArray(r.FhirVersion, r.FhirVersionElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.Reference != nil {
-		if err := r.Reference.Validate(); err != nil {
-			return fmt.Errorf("Reference: %w", err)
-		}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-type ImplementationGuideDefinitionPage struct {
-	Id             *string                             `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	SourceUrl      *string                             `json:"sourceUrl,omitempty" bson:"source_url,omitempty"`           // Source for page
-	SourceString   *string                             `json:"sourceString,omitempty" bson:"source_string,omitempty"`     // Source for page
-	SourceMarkdown *string                             `json:"sourceMarkdown,omitempty" bson:"source_markdown,omitempty"` // Source for page
-	Name           string                              `json:"name" bson:"name"`                                          // Name of the page when published
-	Title          string                              `json:"title" bson:"title"`                                        // Short title shown for navigational assistance
-	Generation     string                              `json:"generation" bson:"generation"`                              // html | markdown | xml | generated
-	Page           []ImplementationGuideDefinitionPage `json:"page,omitempty" bson:"page,omitempty"`                      // Nested Pages / Sections
+	return data, nil
 }
 
-func (r *ImplementationGuideDefinitionPage) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+func (r *ImplementationGuide) UnmarshalJSON(data []byte) error {
+	type alias ImplementationGuide
+	aux := struct {
+		*alias
+		Contained   []json.RawMessage `json:"contained,omitempty"`
+		FhirVersion []*string         `json:"fhirVersion"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.Title == emptyString {
-		return fmt.Errorf("field 'Title' is required")
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.Generation == emptyString {
-		return fmt.Errorf("field 'Generation' is required")
+	if r.FhirVersion, err = splitPrimitiveArray(aux.FhirVersion, r.FhirVersionElement); err != nil {
+		return fmt.Errorf("fhirVersion: %w", err)
 	}
-	for i, item := range r.Page {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Page[%d]: %w", i, err)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", implementationGuideVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type ImplementationGuideManifest struct {
-	Id        *string                               `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Rendering *string                               `json:"rendering,omitempty" bson:"rendering,omitempty"` // Location of rendered implementation guide
-	Resource  []ImplementationGuideManifestResource `json:"resource" bson:"resource"`                       // Resource in the implementation guide
-	Page      []ImplementationGuideManifestPage     `json:"page,omitempty" bson:"page,omitempty"`           // HTML page within the parent IG
-	Image     []string                              `json:"image,omitempty" bson:"image,omitempty"`         // Image within the IG
-	Other     []string                              `json:"other,omitempty" bson:"other,omitempty"`         // Additional linkable file in IG
+func (r ImplementationGuide) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ImplementationGuideManifest) Validate() error {
-	if len(r.Resource) < 1 {
-		return fmt.Errorf("field 'Resource' must have at least 1 elements")
-	}
-	for i, item := range r.Resource {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Resource[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.Page {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Page[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *ImplementationGuide) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type ImplementationGuideManifestResource struct {
-	Id           *string    `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Reference    *Reference `json:"reference" bson:"reference"`                            // Location of the resource
-	IsExample    *bool      `json:"isExample,omitempty" bson:"is_example,omitempty"`       // Is this an example
-	Profile      []string   `json:"profile,omitempty" bson:"profile,omitempty"`            // Profile(s) this is an example of
-	RelativePath *string    `json:"relativePath,omitempty" bson:"relative_path,omitempty"` // Relative path for page in IG
+func (r ImplementationGuide) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *ImplementationGuideManifestResource) Validate() error {
-	if r.Reference == nil {
-		return fmt.Errorf("field 'Reference' is required")
-	}
-	if r.Reference != nil {
-		if err := r.Reference.Validate(); err != nil {
-			return fmt.Errorf("Reference: %w", err)
-		}
+func (r *ImplementationGuide) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ImplementationGuide) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(implementationGuideVersionAlgorithmVariants)
 	}
-	return nil
+	return "", nil
 }
 
-type ImplementationGuideManifestPage struct {
-	Id     *string  `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Name   string   `json:"name" bson:"name"`                         // HTML page name
-	Title  *string  `json:"title,omitempty" bson:"title,omitempty"`   // Title of the page, for references
-	Anchor []string `json:"anchor,omitempty" bson:"anchor,omitempty"` // Anchor available on the page
+var implementationGuideElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"packageId":     "id",
+	"license":       "code",
+	"fhirVersion":   "code",
 }
 
-func (r *ImplementationGuideManifestPage) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
-	return nil
+func (r *ImplementationGuide) elementTypes() map[string]string {
+	return implementationGuideElementTypes
 }
 
-type ImplementationGuideDependsOn struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
-	Uri       string  `json:"uri" bson:"uri"`                                  // Identity of the IG that this depends on
-	PackageId *string `json:"packageId,omitempty" bson:"package_id,omitempty"` // NPM Package name for IG this depends on
-	Version   *string `json:"version,omitempty" bson:"version,omitempty"`      // Version of the IG
-	Reason    *string `json:"reason,omitempty" bson:"reason,omitempty"`        // Why dependency exists
+var implementationGuideElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ImplementationGuide.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ImplementationGuide.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImplementationGuide.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ImplementationGuide.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ImplementationGuide.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ImplementationGuide.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.url", Name: "url", Field: 11, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "ImplementationGuide.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ImplementationGuide.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ImplementationGuide.name", Name: "name", Field: 18, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImplementationGuide.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ImplementationGuide.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ImplementationGuide.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ImplementationGuide.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ImplementationGuide.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ImplementationGuide.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ImplementationGuide.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ImplementationGuide.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ImplementationGuide.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ImplementationGuide.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.packageId", Name: "packageId", Field: 41, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "ImplementationGuide.license", Name: "license", Field: 43, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ImplementationGuide.fhirVersion", Name: "fhirVersion", Field: 45, Min: 1, Max: "*", Types: []string{"code"}},
+	{Path: "ImplementationGuide.dependsOn", Name: "dependsOn", Field: 47, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.global", Name: "global", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.definition", Name: "definition", Field: 49, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.manifest", Name: "manifest", Field: 50, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
 }
 
-func (r *ImplementationGuideDependsOn) Validate() error {
-	var emptyString string
-	if r.Uri == emptyString {
-		return fmt.Errorf("field 'Uri' is required")
-	}
-	return nil
+func (r *ImplementationGuide) ElementMetadata() []ElementMetadata {
+	return implementationGuideElementMetadata
 }
 
-type ImplementationGuideDefinitionParameter struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Code  *Coding `json:"code" bson:"code"`                 // Code that identifies parameter
-	Value string  `json:"value" bson:"value"`               // Value for named type
+// ImplementationGuideVersionAlgorithm is the type of ImplementationGuide.versionAlgorithm[x]. It is implemented by the ImplementationGuideVersionAlgorithm* variant types.
+type ImplementationGuideVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isImplementationGuideVersionAlgorithm()
 }
 
-func (r *ImplementationGuideDefinitionParameter) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
-	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
-	var emptyString string
-	if r.Value == emptyString {
-		return fmt.Errorf("field 'Value' is required")
-	}
+// ImplementationGuideVersionAlgorithmString is the string variant of ImplementationGuideVersionAlgorithm.
+type ImplementationGuideVersionAlgorithmString string
+
+func (v ImplementationGuideVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type ImplementationGuideDefinitionTemplate struct {
-	Id     *string `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Code   string  `json:"code" bson:"code"`                       // Type of template specified
-	Source string  `json:"source" bson:"source"`                   // The source location for the template
-	Scope  *string `json:"scope,omitempty" bson:"scope,omitempty"` // The scope in which the template applies
+func (v ImplementationGuideVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
 }
 
-func (r *ImplementationGuideDefinitionTemplate) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+func (v ImplementationGuideVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v ImplementationGuideVersionAlgorithmString) isImplementationGuideVersionAlgorithm() {}
+
+// ImplementationGuideVersionAlgorithmCoding is the Coding variant of ImplementationGuideVersionAlgorithm.
+type ImplementationGuideVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ImplementationGuideVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ImplementationGuideVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ImplementationGuideVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ImplementationGuideVersionAlgorithmCoding) isImplementationGuideVersionAlgorithm() {}
+
+var implementationGuideVersionAlgorithmVariants = []ImplementationGuideVersionAlgorithm{
+	ImplementationGuideVersionAlgorithmString(""),
+	ImplementationGuideVersionAlgorithmCoding{},
+}
+
+func (r *ImplementationGuide) GetResourceType() string {
+	return "ImplementationGuide"
+}
+
+func (r *ImplementationGuide) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.Source == emptyString {
-		return fmt.Errorf("field 'Source' is required")
+	return *r.Id
+}
+
+func (r *ImplementationGuide) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
+}
+
+func (r *ImplementationGuide) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ImplementationGuide) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ImplementationGuide) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ImplementationGuide) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ImplementationGuide) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ImplementationGuide)(nil)
+
+type ImplementationGuideDependsOn struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Uri               string      `json:"uri" bson:"uri"`                                                  // Identity of the IG that this depends on
+	UriElement        *Element    `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+	PackageId         *string     `json:"packageId,omitempty" bson:"package_id,omitempty"`                 // NPM Package name for IG this depends on
+	PackageIdElement  *Element    `json:"_packageId,omitempty" bson:"package_id_element,omitempty"`        // Extensions for packageId
+	Version           *string     `json:"version,omitempty" bson:"version,omitempty"`                      // Version of the IG
+	VersionElement    *Element    `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
+	Reason            *string     `json:"reason,omitempty" bson:"reason,omitempty"`                        // Why dependency exists
+	ReasonElement     *Element    `json:"_reason,omitempty" bson:"reason_element,omitempty"`               // Extensions for reason
+}
+
+func (r *ImplementationGuideDependsOn) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Uri == emptyString {
+		return fmt.Errorf("field 'Uri' is required")
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	if r.PackageIdElement != nil {
+		if err := r.PackageIdElement.Validate(); err != nil {
+			return fmt.Errorf("PackageIdElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if r.ReasonElement != nil {
+		if err := r.ReasonElement.Validate(); err != nil {
+			return fmt.Errorf("ReasonElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDependsOn) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Uri == emptyString {
+		issues.add("required", path+".uri", "field 'Uri' is required")
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+	if r.PackageIdElement != nil {
+		r.PackageIdElement.validateAll(path+".packageId", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if r.ReasonElement != nil {
+		r.ReasonElement.validateAll(path+".reason", issues)
+	}
+}
+
+var implementationGuideDependsOnInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDependsOn) invariants() []invariant {
+	return implementationGuideDependsOnInvariants
+}
+
+func (r ImplementationGuideDependsOn) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDependsOn) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDependsOn) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDependsOn) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideDependsOnElementTypes = map[string]string{
+	"uri":       "canonical",
+	"packageId": "id",
+	"reason":    "markdown",
+}
+
+func (r *ImplementationGuideDependsOn) elementTypes() map[string]string {
+	return implementationGuideDependsOnElementTypes
+}
+
+var implementationGuideDependsOnElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.dependsOn.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.dependsOn.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.dependsOn.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.dependsOn.uri", Name: "uri", Field: 3, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "ImplementationGuide.dependsOn.packageId", Name: "packageId", Field: 5, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "ImplementationGuide.dependsOn.version", Name: "version", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.dependsOn.reason", Name: "reason", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ImplementationGuideDependsOn) ElementMetadata() []ElementMetadata {
+	return implementationGuideDependsOnElementMetadata
+}
+
+type ImplementationGuideGlobal struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              string      `json:"type" bson:"type"`                                                // Type this profile applies to
+	TypeElement       *Element    `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Profile           string      `json:"profile" bson:"profile"`                                          // Profile that all resources must conform to
+	ProfileElement    *Element    `json:"_profile,omitempty" bson:"profile_element,omitempty"`             // Extensions for profile
+}
+
+func (r *ImplementationGuideGlobal) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Type == emptyString {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.Profile == emptyString {
+		return fmt.Errorf("field 'Profile' is required")
+	}
+	if r.ProfileElement != nil {
+		if err := r.ProfileElement.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideGlobal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Type == emptyString {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Profile == emptyString {
+		issues.add("required", path+".profile", "field 'Profile' is required")
+	}
+	if r.ProfileElement != nil {
+		r.ProfileElement.validateAll(path+".profile", issues)
+	}
+}
+
+var implementationGuideGlobalInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideGlobal) invariants() []invariant {
+	return implementationGuideGlobalInvariants
+}
+
+func (r ImplementationGuideGlobal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideGlobal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideGlobal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideGlobal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideGlobalElementTypes = map[string]string{
+	"type":    "code",
+	"profile": "canonical",
+}
+
+func (r *ImplementationGuideGlobal) elementTypes() map[string]string {
+	return implementationGuideGlobalElementTypes
+}
+
+var implementationGuideGlobalElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.global.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.global.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.global.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.global.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ImplementationGuide.global.profile", Name: "profile", Field: 5, Min: 1, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *ImplementationGuideGlobal) ElementMetadata() []ElementMetadata {
+	return implementationGuideGlobalElementMetadata
+}
+
+type ImplementationGuideDefinition struct {
+	Id                *string                                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Grouping          []ImplementationGuideDefinitionGrouping  `json:"grouping,omitempty" bson:"grouping,omitempty"`                    // Grouping used to present related resources in the IG
+	Resource          []ImplementationGuideDefinitionResource  `json:"resource,omitempty" bson:"resource,omitempty"`                    // Resource in the implementation guide
+	Page              *ImplementationGuideDefinitionPage       `json:"page,omitempty" bson:"page,omitempty"`                            // Page/Section in the Guide
+	Parameter         []ImplementationGuideDefinitionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                  // Defines how IG is built by tools
+	Template          []ImplementationGuideDefinitionTemplate  `json:"template,omitempty" bson:"template,omitempty"`                    // A template for building resources
+}
+
+func (r *ImplementationGuideDefinition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Grouping {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Grouping[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Resource {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Resource[%d]: %w", i, err)
+		}
+	}
+	if r.Page != nil {
+		if err := r.Page.Validate(); err != nil {
+			return fmt.Errorf("Page: %w", err)
+		}
+	}
+	for i, item := range r.Parameter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Parameter[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Template {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Template[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDefinition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Grouping {
+		item.validateAll(fmt.Sprintf("%s.grouping[%d]", path, i), issues)
+	}
+	for i, item := range r.Resource {
+		item.validateAll(fmt.Sprintf("%s.resource[%d]", path, i), issues)
+	}
+	if r.Page != nil {
+		r.Page.validateAll(path+".page", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	for i, item := range r.Template {
+		item.validateAll(fmt.Sprintf("%s.template[%d]", path, i), issues)
+	}
+}
+
+var implementationGuideDefinitionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDefinition) invariants() []invariant {
+	return implementationGuideDefinitionInvariants
+}
+
+func (r ImplementationGuideDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.definition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.definition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.definition.grouping", Name: "grouping", Field: 3, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.definition.resource", Name: "resource", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.definition.page", Name: "page", Field: 5, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.definition.parameter", Name: "parameter", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.definition.template", Name: "template", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ImplementationGuideDefinition) ElementMetadata() []ElementMetadata {
+	return implementationGuideDefinitionElementMetadata
+}
+
+type ImplementationGuideDefinitionGrouping struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name               string      `json:"name" bson:"name"`                                                // Descriptive name for the package
+	NameElement        *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Description        *string     `json:"description,omitempty" bson:"description,omitempty"`              // Human readable text describing the package
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+}
+
+func (r *ImplementationGuideDefinitionGrouping) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDefinitionGrouping) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+}
+
+var implementationGuideDefinitionGroupingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDefinitionGrouping) invariants() []invariant {
+	return implementationGuideDefinitionGroupingInvariants
+}
+
+func (r ImplementationGuideDefinitionGrouping) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDefinitionGrouping) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDefinitionGrouping) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDefinitionGrouping) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideDefinitionGroupingElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ImplementationGuideDefinitionGrouping) elementTypes() map[string]string {
+	return implementationGuideDefinitionGroupingElementTypes
+}
+
+var implementationGuideDefinitionGroupingElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.definition.grouping.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.grouping.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.definition.grouping.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.definition.grouping.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.grouping.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ImplementationGuideDefinitionGrouping) ElementMetadata() []ElementMetadata {
+	return implementationGuideDefinitionGroupingElementMetadata
+}
+
+type ImplementationGuideDefinitionResource struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference          *Reference  `json:"reference" bson:"reference"`                                      // Location of the resource
+	FhirVersion        []string    `json:"fhirVersion,omitempty" bson:"fhir_version,omitempty"`             // Versions this applies to (if different to IG)
+	FhirVersionElement []*Element  `json:"_fhirVersion,omitempty" bson:"fhir_version_element,omitempty"`    // Extensions for fhirVersion
+	Name               *string     `json:"name,omitempty" bson:"name,omitempty"`                            // Human readable name for the resource
+	NameElement        *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Description        *string     `json:"description,omitempty" bson:"description,omitempty"`              // Reason why included in guide
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	IsExample          *bool       `json:"isExample,omitempty" bson:"is_example,omitempty"`                 // Is this an example
+	IsExampleElement   *Element    `json:"_isExample,omitempty" bson:"is_example_element,omitempty"`        // Extensions for isExample
+	Profile            []string    `json:"profile,omitempty" bson:"profile,omitempty"`                      // Profile(s) this resource is valid against
+	ProfileElement     []*Element  `json:"_profile,omitempty" bson:"profile_element,omitempty"`             // Extensions for profile
+	GroupingId         *string     `json:"groupingId,omitempty" bson:"grouping_id,omitempty"`               // Grouping this is part of
+	GroupingIdElement  *Element    `json:"_groupingId,omitempty" bson:"grouping_id_element,omitempty"`      // Extensions for groupingId
+}
+
+func (r *ImplementationGuideDefinitionResource) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Reference == nil {
+		return fmt.Errorf("field 'Reference' is required")
+	}
+	if r.Reference != nil {
+		if err := r.Reference.Validate(); err != nil {
+			return fmt.Errorf("Reference: %w", err)
+		}
+	}
+	for i, item := range r.FhirVersionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("FhirVersionElement[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.IsExampleElement != nil {
+		if err := r.IsExampleElement.Validate(); err != nil {
+			return fmt.Errorf("IsExampleElement: %w", err)
+		}
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement[%d]: %w", i, err)
+		}
+	}
+	if r.GroupingIdElement != nil {
+		if err := r.GroupingIdElement.Validate(); err != nil {
+			return fmt.Errorf("GroupingIdElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDefinitionResource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Reference == nil {
+		issues.add("required", path+".reference", "field 'Reference' is required")
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+	for i, item := range r.FhirVersionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.fhirVersion[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.IsExampleElement != nil {
+		r.IsExampleElement.validateAll(path+".isExample", issues)
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profile[%d]", path, i), issues)
+	}
+	if r.GroupingIdElement != nil {
+		r.GroupingIdElement.validateAll(path+".groupingId", issues)
+	}
+}
+
+var implementationGuideDefinitionResourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDefinitionResource) invariants() []invariant {
+	return implementationGuideDefinitionResourceInvariants
+}
+
+func (r ImplementationGuideDefinitionResource) MarshalJSON() ([]byte, error) {
+	type alias ImplementationGuideDefinitionResource
+	out := struct {
+		alias
+		FhirVersion        []*string  `json:"fhirVersion,omitempty"`
+		FhirVersionElement []*Element `json:"_fhirVersion,omitempty"`
+		Profile            []*string  `json:"profile,omitempty"`
+		ProfileElement     []*Element `json:"_profile,omitempty"`
+	}{alias: alias(r)}
+	out.FhirVersion, out.FhirVersionElement = alignPrimitiveArray(r.FhirVersion, r.FhirVersionElement)
+	out.Profile, out.ProfileElement = alignPrimitiveArray(r.Profile, r.ProfileElement)
+	return json.Marshal(out)
+}
+
+func (r *ImplementationGuideDefinitionResource) UnmarshalJSON(data []byte) error {
+	type alias ImplementationGuideDefinitionResource
+	aux := struct {
+		*alias
+		FhirVersion []*string `json:"fhirVersion,omitempty"`
+		Profile     []*string `json:"profile,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.FhirVersion, err = splitPrimitiveArray(aux.FhirVersion, r.FhirVersionElement); err != nil {
+		return fmt.Errorf("fhirVersion: %w", err)
+	}
+	if r.Profile, err = splitPrimitiveArray(aux.Profile, r.ProfileElement); err != nil {
+		return fmt.Errorf("profile: %w", err)
+	}
+	return nil
+}
+
+func (r ImplementationGuideDefinitionResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDefinitionResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDefinitionResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDefinitionResource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideDefinitionResourceElementTypes = map[string]string{
+	"fhirVersion": "code",
+	"description": "markdown",
+	"profile":     "canonical",
+	"groupingId":  "id",
+}
+
+func (r *ImplementationGuideDefinitionResource) elementTypes() map[string]string {
+	return implementationGuideDefinitionResourceElementTypes
+}
+
+var implementationGuideDefinitionResourceElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.definition.resource.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.resource.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.definition.resource.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.definition.resource.reference", Name: "reference", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "ImplementationGuide.definition.resource.fhirVersion", Name: "fhirVersion", Field: 4, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "ImplementationGuide.definition.resource.name", Name: "name", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.resource.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ImplementationGuide.definition.resource.isExample", Name: "isExample", Field: 10, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ImplementationGuide.definition.resource.profile", Name: "profile", Field: 12, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ImplementationGuide.definition.resource.groupingId", Name: "groupingId", Field: 14, Min: 0, Max: "1", Types: []string{"id"}},
+}
+
+func (r *ImplementationGuideDefinitionResource) ElementMetadata() []ElementMetadata {
+	return implementationGuideDefinitionResourceElementMetadata
+}
+
+type ImplementationGuideDefinitionPage struct {
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Source            ImplementationGuideDefinitionPageSource `json:"-" bson:"source,omitempty"`                                       // Source for page
+	SourceElement     *Element                                `json:"-" bson:"source_element,omitempty"`                               // Extensions for source[x]
+	Name              string                                  `json:"name" bson:"name"`                                                // Name of the page when published
+	NameElement       *Element                                `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Title             string                                  `json:"title" bson:"title"`                                              // Short title shown for navigational assistance
+	TitleElement      *Element                                `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Generation        GuidePageGeneration                     `json:"generation" bson:"generation"`                                    // html | markdown | xml | generated
+	GenerationElement *Element                                `json:"_generation,omitempty" bson:"generation_element,omitempty"`       // Extensions for generation
+	Page              []ImplementationGuideDefinitionPage     `json:"page,omitempty" bson:"page,omitempty"`                            // Nested Pages / Sections
+
+	sourceVariants []string // JSON properties of ImplementationGuide.definition.page.source[x] when more than one was decoded
+}
+
+func (r *ImplementationGuideDefinitionPage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.sourceVariants) > 1 {
+		return fmt.Errorf("field 'Source' must have a single type, got %v", r.sourceVariants)
+	}
+	if r.Source != nil {
+		if err := r.Source.Validate(); err != nil {
+			return fmt.Errorf("Source: %w", err)
+		}
+	}
+	if r.SourceElement != nil {
+		if err := r.SourceElement.Validate(); err != nil {
+			return fmt.Errorf("SourceElement: %w", err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Title == emptyString {
+		return fmt.Errorf("field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Generation == "" {
+		return fmt.Errorf("field 'Generation' is required")
+	}
+	if !r.Generation.IsValid() {
+		return fmt.Errorf("field 'Generation' has invalid code '%s'", r.Generation)
+	}
+	if r.GenerationElement != nil {
+		if err := r.GenerationElement.Validate(); err != nil {
+			return fmt.Errorf("GenerationElement: %w", err)
+		}
+	}
+	for i, item := range r.Page {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Page[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDefinitionPage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.sourceVariants) > 1 {
+		issues.add("structure", path+".source", fmt.Sprintf("field 'Source' must have a single type, got %v", r.sourceVariants))
+	}
+	if r.Source != nil {
+		r.Source.validateAll(path+".source.ofType("+r.Source.FHIRType()+")", issues)
+	}
+	if r.SourceElement != nil {
+		r.SourceElement.validateAll(path+".source", issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Title == emptyString {
+		issues.add("required", path+".title", "field 'Title' is required")
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Generation == "" {
+		issues.add("required", path+".generation", "field 'Generation' is required")
+	}
+	if r.Generation != "" && !r.Generation.IsValid() {
+		issues.add("code-invalid", path+".generation", fmt.Sprintf("field 'Generation' has invalid code '%s'", r.Generation))
+	}
+	if r.GenerationElement != nil {
+		r.GenerationElement.validateAll(path+".generation", issues)
+	}
+	for i, item := range r.Page {
+		item.validateAll(fmt.Sprintf("%s.page[%d]", path, i), issues)
+	}
+}
+
+var implementationGuideDefinitionPageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDefinitionPage) invariants() []invariant {
+	return implementationGuideDefinitionPageInvariants
+}
+
+func (r ImplementationGuideDefinitionPage) MarshalJSON() ([]byte, error) {
+	type alias ImplementationGuideDefinitionPage
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "source", r.Source, r.SourceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ImplementationGuideDefinitionPage) UnmarshalJSON(data []byte) error {
+	type alias ImplementationGuideDefinitionPage
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Source, element, r.sourceVariants, err = unmarshalChoice(fields, "source", implementationGuideDefinitionPageSourceVariants); err != nil {
+		return err
+	}
+	r.SourceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.SourceElement); err != nil {
+			return fmt.Errorf("_source: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ImplementationGuideDefinitionPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDefinitionPage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDefinitionPage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDefinitionPage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ImplementationGuideDefinitionPage) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Source":
+		return "source", choiceValues(implementationGuideDefinitionPageSourceVariants)
+	}
+	return "", nil
+}
+
+var implementationGuideDefinitionPageElementTypes = map[string]string{
+	"name": "url",
+}
+
+func (r *ImplementationGuideDefinitionPage) elementTypes() map[string]string {
+	return implementationGuideDefinitionPageElementTypes
+}
+
+var implementationGuideDefinitionPageElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.definition.page.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.page.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.definition.page.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.definition.page.source[x]", Name: "source", Field: 3, Min: 0, Max: "1", Types: []string{"url", "string", "markdown"}},
+	{Path: "ImplementationGuide.definition.page.name", Name: "name", Field: 5, Min: 1, Max: "1", Types: []string{"url"}},
+	{Path: "ImplementationGuide.definition.page.title", Name: "title", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.page.generation", Name: "generation", Field: 9, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ImplementationGuide.definition.page.generation", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/guide-page-generation"}},
+	{Path: "ImplementationGuide.definition.page.page", Name: "page", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ImplementationGuideDefinitionPage) ElementMetadata() []ElementMetadata {
+	return implementationGuideDefinitionPageElementMetadata
+}
+
+// ImplementationGuideDefinitionPageSource is the type of ImplementationGuide.definition.page.source[x]. It is implemented by the ImplementationGuideDefinitionPageSource* variant types.
+type ImplementationGuideDefinitionPageSource interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isImplementationGuideDefinitionPageSource()
+}
+
+// ImplementationGuideDefinitionPageSourceUrl is the url variant of ImplementationGuideDefinitionPageSource.
+type ImplementationGuideDefinitionPageSourceUrl string
+
+func (v ImplementationGuideDefinitionPageSourceUrl) Validate() error {
+	return nil
+}
+
+func (v ImplementationGuideDefinitionPageSourceUrl) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ImplementationGuideDefinitionPageSourceUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ImplementationGuideDefinitionPageSourceUrl) isImplementationGuideDefinitionPageSource() {}
+
+// ImplementationGuideDefinitionPageSourceString is the string variant of ImplementationGuideDefinitionPageSource.
+type ImplementationGuideDefinitionPageSourceString string
+
+func (v ImplementationGuideDefinitionPageSourceString) Validate() error {
+	return nil
+}
+
+func (v ImplementationGuideDefinitionPageSourceString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ImplementationGuideDefinitionPageSourceString) FHIRType() string {
+	return "string"
+}
+
+func (v ImplementationGuideDefinitionPageSourceString) isImplementationGuideDefinitionPageSource() {}
+
+// ImplementationGuideDefinitionPageSourceMarkdown is the markdown variant of ImplementationGuideDefinitionPageSource.
+type ImplementationGuideDefinitionPageSourceMarkdown string
+
+func (v ImplementationGuideDefinitionPageSourceMarkdown) Validate() error {
+	return nil
+}
+
+func (v ImplementationGuideDefinitionPageSourceMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ImplementationGuideDefinitionPageSourceMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ImplementationGuideDefinitionPageSourceMarkdown) isImplementationGuideDefinitionPageSource() {
+}
+
+var implementationGuideDefinitionPageSourceVariants = []ImplementationGuideDefinitionPageSource{
+	ImplementationGuideDefinitionPageSourceUrl(""),
+	ImplementationGuideDefinitionPageSourceString(""),
+	ImplementationGuideDefinitionPageSourceMarkdown(""),
+}
+
+type ImplementationGuideDefinitionParameter struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *Coding     `json:"code" bson:"code"`                                                // Code that identifies parameter
+	Value             string      `json:"value" bson:"value"`                                              // Value for named type
+	ValueElement      *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *ImplementationGuideDefinitionParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDefinitionParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var implementationGuideDefinitionParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDefinitionParameter) invariants() []invariant {
+	return implementationGuideDefinitionParameterInvariants
+}
+
+func (r ImplementationGuideDefinitionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDefinitionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDefinitionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDefinitionParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideDefinitionParameterElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.definition.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.definition.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.definition.parameter.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "ImplementationGuide.definition.parameter.value", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ImplementationGuideDefinitionParameter) ElementMetadata() []ElementMetadata {
+	return implementationGuideDefinitionParameterElementMetadata
+}
+
+type ImplementationGuideDefinitionTemplate struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string      `json:"code" bson:"code"`                                                // Type of template specified
+	CodeElement       *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Source            string      `json:"source" bson:"source"`                                            // The source location for the template
+	SourceElement     *Element    `json:"_source,omitempty" bson:"source_element,omitempty"`               // Extensions for source
+	Scope             *string     `json:"scope,omitempty" bson:"scope,omitempty"`                          // The scope in which the template applies
+	ScopeElement      *Element    `json:"_scope,omitempty" bson:"scope_element,omitempty"`                 // Extensions for scope
+}
+
+func (r *ImplementationGuideDefinitionTemplate) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.Source == emptyString {
+		return fmt.Errorf("field 'Source' is required")
+	}
+	if r.SourceElement != nil {
+		if err := r.SourceElement.Validate(); err != nil {
+			return fmt.Errorf("SourceElement: %w", err)
+		}
+	}
+	if r.ScopeElement != nil {
+		if err := r.ScopeElement.Validate(); err != nil {
+			return fmt.Errorf("ScopeElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideDefinitionTemplate) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.Source == emptyString {
+		issues.add("required", path+".source", "field 'Source' is required")
+	}
+	if r.SourceElement != nil {
+		r.SourceElement.validateAll(path+".source", issues)
+	}
+	if r.ScopeElement != nil {
+		r.ScopeElement.validateAll(path+".scope", issues)
+	}
+}
+
+var implementationGuideDefinitionTemplateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideDefinitionTemplate) invariants() []invariant {
+	return implementationGuideDefinitionTemplateInvariants
+}
+
+func (r ImplementationGuideDefinitionTemplate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideDefinitionTemplate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideDefinitionTemplate) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideDefinitionTemplate) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideDefinitionTemplateElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *ImplementationGuideDefinitionTemplate) elementTypes() map[string]string {
+	return implementationGuideDefinitionTemplateElementTypes
+}
+
+var implementationGuideDefinitionTemplateElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.definition.template.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.template.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.definition.template.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.definition.template.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ImplementationGuide.definition.template.source", Name: "source", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.definition.template.scope", Name: "scope", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ImplementationGuideDefinitionTemplate) ElementMetadata() []ElementMetadata {
+	return implementationGuideDefinitionTemplateElementMetadata
+}
+
+type ImplementationGuideManifest struct {
+	Id                *string                               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Rendering         *string                               `json:"rendering,omitempty" bson:"rendering,omitempty"`                  // Location of rendered implementation guide
+	RenderingElement  *Element                              `json:"_rendering,omitempty" bson:"rendering_element,omitempty"`         // Extensions for rendering
+	Resource          []ImplementationGuideManifestResource `json:"resource" bson:"resource"`                                        // Resource in the implementation guide
+	Page              []ImplementationGuideManifestPage     `json:"page,omitempty" bson:"page,omitempty"`                            // HTML page within the parent IG
+	Image             []string                              `json:"image,omitempty" bson:"image,omitempty"`                          // Image within the IG
+	ImageElement      []*Element                            `json:"_image,omitempty" bson:"image_element,omitempty"`                 // Extensions for image
+	Other             []string                              `json:"other,omitempty" bson:"other,omitempty"`                          // Additional linkable file in IG
+	OtherElement      []*Element                            `json:"_other,omitempty" bson:"other_element,omitempty"`                 // Extensions for other
+}
+
+func (r *ImplementationGuideManifest) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.RenderingElement != nil {
+		if err := r.RenderingElement.Validate(); err != nil {
+			return fmt.Errorf("RenderingElement: %w", err)
+		}
+	}
+	if len(r.Resource) < 1 {
+		return fmt.Errorf("field 'Resource' must have at least 1 elements")
+	}
+	for i, item := range r.Resource {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Resource[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Page {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Page[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ImageElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ImageElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.OtherElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OtherElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideManifest) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.RenderingElement != nil {
+		r.RenderingElement.validateAll(path+".rendering", issues)
+	}
+	if len(r.Resource) < 1 {
+		issues.add("required", path+".resource", "field 'Resource' must have at least 1 elements")
+	}
+	for i, item := range r.Resource {
+		item.validateAll(fmt.Sprintf("%s.resource[%d]", path, i), issues)
+	}
+	for i, item := range r.Page {
+		item.validateAll(fmt.Sprintf("%s.page[%d]", path, i), issues)
+	}
+	for i, item := range r.ImageElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.image[%d]", path, i), issues)
+	}
+	for i, item := range r.OtherElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.other[%d]", path, i), issues)
+	}
+}
+
+var implementationGuideManifestInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideManifest) invariants() []invariant {
+	return implementationGuideManifestInvariants
+}
+
+func (r ImplementationGuideManifest) MarshalJSON() ([]byte, error) {
+	type alias ImplementationGuideManifest
+	out := struct {
+		alias
+		Image        []*string  `json:"image,omitempty"`
+		ImageElement []*Element `json:"_image,omitempty"`
+		Other        []*string  `json:"other,omitempty"`
+		OtherElement []*Element `json:"_other,omitempty"`
+	}{alias: alias(r)}
+	out.Image, out.ImageElement = alignPrimitiveArray(r.Image, r.ImageElement)
+	out.Other, out.OtherElement = alignPrimitiveArray(r.Other, r.OtherElement)
+	return json.Marshal(out)
+}
+
+func (r *ImplementationGuideManifest) UnmarshalJSON(data []byte) error {
+	type alias ImplementationGuideManifest
+	aux := struct {
+		*alias
+		Image []*string `json:"image,omitempty"`
+		Other []*string `json:"other,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Image, err = splitPrimitiveArray(aux.Image, r.ImageElement); err != nil {
+		return fmt.Errorf("image: %w", err)
+	}
+	if r.Other, err = splitPrimitiveArray(aux.Other, r.OtherElement); err != nil {
+		return fmt.Errorf("other: %w", err)
+	}
+	return nil
+}
+
+func (r ImplementationGuideManifest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideManifest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideManifest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideManifest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideManifestElementTypes = map[string]string{
+	"rendering": "url",
+}
+
+func (r *ImplementationGuideManifest) elementTypes() map[string]string {
+	return implementationGuideManifestElementTypes
+}
+
+var implementationGuideManifestElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.manifest.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.manifest.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.manifest.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.manifest.rendering", Name: "rendering", Field: 3, Min: 0, Max: "1", Types: []string{"url"}},
+	{Path: "ImplementationGuide.manifest.resource", Name: "resource", Field: 5, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.manifest.page", Name: "page", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ImplementationGuide.manifest.image", Name: "image", Field: 7, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "ImplementationGuide.manifest.other", Name: "other", Field: 9, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *ImplementationGuideManifest) ElementMetadata() []ElementMetadata {
+	return implementationGuideManifestElementMetadata
+}
+
+type ImplementationGuideManifestResource struct {
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference           *Reference  `json:"reference" bson:"reference"`                                      // Location of the resource
+	IsExample           *bool       `json:"isExample,omitempty" bson:"is_example,omitempty"`                 // Is this an example
+	IsExampleElement    *Element    `json:"_isExample,omitempty" bson:"is_example_element,omitempty"`        // Extensions for isExample
+	Profile             []string    `json:"profile,omitempty" bson:"profile,omitempty"`                      // Profile(s) this is an example of
+	ProfileElement      []*Element  `json:"_profile,omitempty" bson:"profile_element,omitempty"`             // Extensions for profile
+	RelativePath        *string     `json:"relativePath,omitempty" bson:"relative_path,omitempty"`           // Relative path for page in IG
+	RelativePathElement *Element    `json:"_relativePath,omitempty" bson:"relative_path_element,omitempty"`  // Extensions for relativePath
+}
+
+func (r *ImplementationGuideManifestResource) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Reference == nil {
+		return fmt.Errorf("field 'Reference' is required")
+	}
+	if r.Reference != nil {
+		if err := r.Reference.Validate(); err != nil {
+			return fmt.Errorf("Reference: %w", err)
+		}
+	}
+	if r.IsExampleElement != nil {
+		if err := r.IsExampleElement.Validate(); err != nil {
+			return fmt.Errorf("IsExampleElement: %w", err)
+		}
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement[%d]: %w", i, err)
+		}
+	}
+	if r.RelativePathElement != nil {
+		if err := r.RelativePathElement.Validate(); err != nil {
+			return fmt.Errorf("RelativePathElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideManifestResource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Reference == nil {
+		issues.add("required", path+".reference", "field 'Reference' is required")
+	}
+	if r.Reference != nil {
+		r.Reference.validateAll(path+".reference", issues)
+	}
+	if r.IsExampleElement != nil {
+		r.IsExampleElement.validateAll(path+".isExample", issues)
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profile[%d]", path, i), issues)
+	}
+	if r.RelativePathElement != nil {
+		r.RelativePathElement.validateAll(path+".relativePath", issues)
+	}
+}
+
+var implementationGuideManifestResourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideManifestResource) invariants() []invariant {
+	return implementationGuideManifestResourceInvariants
+}
+
+func (r ImplementationGuideManifestResource) MarshalJSON() ([]byte, error) {
+	type alias ImplementationGuideManifestResource
+	out := struct {
+		alias
+		Profile        []*string  `json:"profile,omitempty"`
+		ProfileElement []*Element `json:"_profile,omitempty"`
+	}{alias: alias(r)}
+	out.Profile, out.ProfileElement = alignPrimitiveArray(r.Profile, r.ProfileElement)
+	return json.Marshal(out)
+}
+
+func (r *ImplementationGuideManifestResource) UnmarshalJSON(data []byte) error {
+	type alias ImplementationGuideManifestResource
+	aux := struct {
+		*alias
+		Profile []*string `json:"profile,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Profile, err = splitPrimitiveArray(aux.Profile, r.ProfileElement); err != nil {
+		return fmt.Errorf("profile: %w", err)
+	}
+	return nil
+}
+
+func (r ImplementationGuideManifestResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideManifestResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideManifestResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideManifestResource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideManifestResourceElementTypes = map[string]string{
+	"profile":      "canonical",
+	"relativePath": "url",
+}
+
+func (r *ImplementationGuideManifestResource) elementTypes() map[string]string {
+	return implementationGuideManifestResourceElementTypes
+}
+
+var implementationGuideManifestResourceElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.manifest.resource.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.manifest.resource.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.manifest.resource.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.manifest.resource.reference", Name: "reference", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "ImplementationGuide.manifest.resource.isExample", Name: "isExample", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ImplementationGuide.manifest.resource.profile", Name: "profile", Field: 6, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ImplementationGuide.manifest.resource.relativePath", Name: "relativePath", Field: 8, Min: 0, Max: "1", Types: []string{"url"}},
+}
+
+func (r *ImplementationGuideManifestResource) ElementMetadata() []ElementMetadata {
+	return implementationGuideManifestResourceElementMetadata
+}
+
+type ImplementationGuideManifestPage struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string      `json:"name" bson:"name"`                                                // HTML page name
+	NameElement       *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Title             *string     `json:"title,omitempty" bson:"title,omitempty"`                          // Title of the page, for references
+	TitleElement      *Element    `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Anchor            []string    `json:"anchor,omitempty" bson:"anchor,omitempty"`                        // Anchor available on the page
+	AnchorElement     []*Element  `json:"_anchor,omitempty" bson:"anchor_element,omitempty"`               // Extensions for anchor
+}
+
+func (r *ImplementationGuideManifestPage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	for i, item := range r.AnchorElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AnchorElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ImplementationGuideManifestPage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	for i, item := range r.AnchorElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.anchor[%d]", path, i), issues)
+	}
+}
+
+var implementationGuideManifestPageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ImplementationGuideManifestPage) invariants() []invariant {
+	return implementationGuideManifestPageInvariants
+}
+
+func (r ImplementationGuideManifestPage) MarshalJSON() ([]byte, error) {
+	type alias ImplementationGuideManifestPage
+	out := struct {
+		alias
+		Anchor        []*string  `json:"anchor,omitempty"`
+		AnchorElement []*Element `json:"_anchor,omitempty"`
+	}{alias: alias(r)}
+	out.Anchor, out.AnchorElement = alignPrimitiveArray(r.Anchor, r.AnchorElement)
+	return json.Marshal(out)
+}
+
+func (r *ImplementationGuideManifestPage) UnmarshalJSON(data []byte) error {
+	type alias ImplementationGuideManifestPage
+	aux := struct {
+		*alias
+		Anchor []*string `json:"anchor,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Anchor, err = splitPrimitiveArray(aux.Anchor, r.AnchorElement); err != nil {
+		return fmt.Errorf("anchor: %w", err)
+	}
+	return nil
+}
+
+func (r ImplementationGuideManifestPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ImplementationGuideManifestPage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ImplementationGuideManifestPage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ImplementationGuideManifestPage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var implementationGuideManifestPageElementMetadata = []ElementMetadata{
+	{Path: "ImplementationGuide.manifest.page.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.manifest.page.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ImplementationGuide.manifest.page.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ImplementationGuide.manifest.page.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.manifest.page.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ImplementationGuide.manifest.page.anchor", Name: "anchor", Field: 7, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *ImplementationGuideManifestPage) ElementMetadata() []ElementMetadata {
+	return implementationGuideManifestPageElementMetadata
 }
@@ -2,30 +2,40 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An ingredient of a manufactured item or pharmaceutical product.
 type Ingredient struct {
-	ResourceType        string                   `json:"resourceType" bson:"resource_type"`                                   // Type of resource
-	Id                  *string                  `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
-	Meta                *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
-	ImplicitRules       *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
-	Language            *string                  `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
-	Text                *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
-	Contained           []json.RawMessage        `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
-	Identifier          *Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // An identifier or code by which the ingredient can be referenced
-	Status              string                   `json:"status" bson:"status"`                                                // draft | active | retired | unknown
-	For                 []Reference              `json:"for,omitempty" bson:"for,omitempty"`                                  // The product which this ingredient is a constituent part of
-	Role                *CodeableConcept         `json:"role" bson:"role"`                                                    // Purpose of the ingredient within the product, e.g. active, inactive
-	Function            []CodeableConcept        `json:"function,omitempty" bson:"function,omitempty"`                        // Precise action within the drug product, e.g. antioxidant, alkalizing agent
-	Group               *CodeableConcept         `json:"group,omitempty" bson:"group,omitempty"`                              // A classification of the ingredient according to where in the physical item it tends to be used, such the outer shell of a tablet, inner body or ink
-	AllergenicIndicator *bool                    `json:"allergenicIndicator,omitempty" bson:"allergenic_indicator,omitempty"` // If the ingredient is a known or suspected allergen
-	Comment             *string                  `json:"comment,omitempty" bson:"comment,omitempty"`                          // A place for providing any notes that are relevant to the component, e.g. removed during process, adjusted for loss on drying
-	Manufacturer        []IngredientManufacturer `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                // An organization that manufactures this ingredient
-	Substance           *IngredientSubstance     `json:"substance" bson:"substance"`                                          // The substance that comprises this ingredient
+	ResourceType               string                   `json:"resourceType" bson:"resource_type"`                                            // Type of resource
+	Id                         *string                  `json:"id,omitempty" bson:"id,omitempty"`                                             // Logical id of this artifact
+	Meta                       *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                                         // Metadata about the resource
+	ImplicitRules              *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                      // A set of rules under which this content was created
+	ImplicitRulesElement       *Element                 `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`             // Extensions for implicitRules
+	Language                   *string                  `json:"language,omitempty" bson:"language,omitempty"`                                 // Language of the resource content
+	LanguageElement            *Element                 `json:"_language,omitempty" bson:"language_element,omitempty"`                        // Extensions for language
+	Text                       *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                                         // Text summary of the resource, for human interpretation
+	Contained                  []Resource               `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
+	Extension                  []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored
+	Identifier                 *Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                             // An identifier or code by which the ingredient can be referenced
+	Status                     PublicationStatus        `json:"status" bson:"status"`                                                         // draft | active | retired | unknown
+	StatusElement              *Element                 `json:"_status,omitempty" bson:"status_element,omitempty"`                            // Extensions for status
+	For                        []Reference              `json:"for,omitempty" bson:"for,omitempty"`                                           // The product which this ingredient is a constituent part of
+	Role                       *CodeableConcept         `json:"role" bson:"role"`                                                             // Purpose of the ingredient within the product, e.g. active, inactive
+	Function                   []CodeableConcept        `json:"function,omitempty" bson:"function,omitempty"`                                 // Precise action within the drug product, e.g. antioxidant, alkalizing agent
+	Group                      *CodeableConcept         `json:"group,omitempty" bson:"group,omitempty"`                                       // A classification of the ingredient according to where in the physical item it tends to be used, such the outer shell of a tablet, inner body or ink
+	AllergenicIndicator        *bool                    `json:"allergenicIndicator,omitempty" bson:"allergenic_indicator,omitempty"`          // If the ingredient is a known or suspected allergen
+	AllergenicIndicatorElement *Element                 `json:"_allergenicIndicator,omitempty" bson:"allergenic_indicator_element,omitempty"` // Extensions for allergenicIndicator
+	Comment                    *string                  `json:"comment,omitempty" bson:"comment,omitempty"`                                   // A place for providing any notes that are relevant to the component, e.g. removed during process, adjusted for loss on drying
+	CommentElement             *Element                 `json:"_comment,omitempty" bson:"comment_element,omitempty"`                          // Extensions for comment
+	Manufacturer               []IngredientManufacturer `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                         // An organization that manufactures this ingredient
+	Substance                  *IngredientSubstance     `json:"substance" bson:"substance"`                                                   // The substance that comprises this ingredient
 }
 
+var ingredientForTargets = []string{"AdministrableProductDefinition", "ManufacturedItemDefinition", "MedicinalProductDefinition"}
+
 func (r *Ingredient) Validate() error {
 	if r.ResourceType != "Ingredient" {
 		return fmt.Errorf("invalid resourceType: expected 'Ingredient', got '%s'", r.ResourceType)
@@ -35,20 +45,60 @@ func (r *Ingredient) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	for i, item := range r.For {
+		if target := invalidReferenceTarget(item.Reference, item.Type, ingredientForTargets); target != "" {
+			return fmt.Errorf("field 'For[%d]' cannot refer to %s, expected one of %v", i, target, ingredientForTargets)
+		}
+	}
 	for i, item := range r.For {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("For[%d]: %w", i, err)
@@ -72,6 +122,16 @@ func (r *Ingredient) Validate() error {
 			return fmt.Errorf("Group: %w", err)
 		}
 	}
+	if r.AllergenicIndicatorElement != nil {
+		if err := r.AllergenicIndicatorElement.Validate(); err != nil {
+			return fmt.Errorf("AllergenicIndicatorElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
 	for i, item := range r.Manufacturer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Manufacturer[%d]: %w", i, err)
@@ -85,19 +145,256 @@ func (r *Ingredient) Validate() error {
 			return fmt.Errorf("Substance: %w", err)
 		}
 	}
+	return validateInvariants(r, "Ingredient")
+}
+
+func (r *Ingredient) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Ingredient" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Ingredient', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.For {
+		if target := invalidReferenceTarget(item.Reference, item.Type, ingredientForTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.for[%d]", path, i), fmt.Sprintf("field 'For[%d]' cannot refer to %s, expected one of %v", i, target, ingredientForTargets))
+		}
+	}
+	for i, item := range r.For {
+		item.validateAll(fmt.Sprintf("%s.for[%d]", path, i), issues)
+	}
+	if r.Role == nil {
+		issues.add("required", path+".role", "field 'Role' is required")
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	for i, item := range r.Function {
+		item.validateAll(fmt.Sprintf("%s.function[%d]", path, i), issues)
+	}
+	if r.Group != nil {
+		r.Group.validateAll(path+".group", issues)
+	}
+	if r.AllergenicIndicatorElement != nil {
+		r.AllergenicIndicatorElement.validateAll(path+".allergenicIndicator", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	for i, item := range r.Manufacturer {
+		item.validateAll(fmt.Sprintf("%s.manufacturer[%d]", path, i), issues)
+	}
+	if r.Substance == nil {
+		issues.add("required", path+".substance", "field 'Substance' is required")
+	}
+	if r.Substance != nil {
+		r.Substance.validateAll(path+".substance", issues)
+	}
+}
+
+func (r *Ingredient) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Ingredient", &issues)
+	checkInvariants(r, "Ingredient", &issues)
+	return issues
+}
+
+var ingredientInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Ingredient) invariants() []invariant {
+	return ingredientInvariants
+}
+
+func (r *Ingredient) UnmarshalJSON(data []byte) error {
+	type alias Ingredient
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Ingredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Ingredient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Ingredient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Ingredient) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var ingredientElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"comment":       "markdown",
+}
+
+func (r *Ingredient) elementTypes() map[string]string {
+	return ingredientElementTypes
+}
+
+var ingredientElementMetadata = []ElementMetadata{
+	{Path: "Ingredient.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Ingredient.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Ingredient.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Ingredient.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Ingredient.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Ingredient.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Ingredient.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Ingredient.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Ingredient.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Ingredient.identifier", Name: "identifier", Field: 11, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Ingredient.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Ingredient.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Ingredient.for", Name: "for", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition"}},
+	{Path: "Ingredient.role", Name: "role", Field: 15, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Ingredient.function", Name: "function", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Ingredient.group", Name: "group", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Ingredient.allergenicIndicator", Name: "allergenicIndicator", Field: 18, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Ingredient.comment", Name: "comment", Field: 20, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Ingredient.manufacturer", Name: "manufacturer", Field: 22, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Ingredient.substance", Name: "substance", Field: 23, Min: 1, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *Ingredient) ElementMetadata() []ElementMetadata {
+	return ingredientElementMetadata
+}
+
+func (r *Ingredient) GetResourceType() string {
+	return "Ingredient"
+}
+
+func (r *Ingredient) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Ingredient) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Ingredient) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Ingredient) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Ingredient) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Ingredient) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Ingredient) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Ingredient)(nil)
+
 type IngredientManufacturer struct {
-	Id           *string    `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Role         *string    `json:"role,omitempty" bson:"role,omitempty"` // allowed | possible | actual
-	Manufacturer *Reference `json:"manufacturer" bson:"manufacturer"`     // An organization that manufactures this ingredient
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Role              *IngredientManufacturerRole `json:"role,omitempty" bson:"role,omitempty"`                            // allowed | possible | actual
+	RoleElement       *Element                    `json:"_role,omitempty" bson:"role_element,omitempty"`                   // Extensions for role
+	Manufacturer      *Reference                  `json:"manufacturer" bson:"manufacturer"`                                // An organization that manufactures this ingredient
 }
 
+var ingredientManufacturerManufacturerTargets = []string{"Organization"}
+
 func (r *IngredientManufacturer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Role != nil && !r.Role.IsValid() {
+		return fmt.Errorf("field 'Role' has invalid code '%s'", *r.Role)
+	}
+	if r.RoleElement != nil {
+		if err := r.RoleElement.Validate(); err != nil {
+			return fmt.Errorf("RoleElement: %w", err)
+		}
+	}
 	if r.Manufacturer == nil {
 		return fmt.Errorf("field 'Manufacturer' is required")
 	}
+	if r.Manufacturer != nil {
+		if target := invalidReferenceTarget(r.Manufacturer.Reference, r.Manufacturer.Type, ingredientManufacturerManufacturerTargets); target != "" {
+			return fmt.Errorf("field 'Manufacturer' cannot refer to %s, expected one of %v", target, ingredientManufacturerManufacturerTargets)
+		}
+	}
 	if r.Manufacturer != nil {
 		if err := r.Manufacturer.Validate(); err != nil {
 			return fmt.Errorf("Manufacturer: %w", err)
@@ -106,13 +403,87 @@ func (r *IngredientManufacturer) Validate() error {
 	return nil
 }
 
+func (r *IngredientManufacturer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Role != nil && !r.Role.IsValid() {
+		issues.add("code-invalid", path+".role", fmt.Sprintf("field 'Role' has invalid code '%s'", *r.Role))
+	}
+	if r.RoleElement != nil {
+		r.RoleElement.validateAll(path+".role", issues)
+	}
+	if r.Manufacturer == nil {
+		issues.add("required", path+".manufacturer", "field 'Manufacturer' is required")
+	}
+	if r.Manufacturer != nil {
+		if target := invalidReferenceTarget(r.Manufacturer.Reference, r.Manufacturer.Type, ingredientManufacturerManufacturerTargets); target != "" {
+			issues.add("value", path+".manufacturer", fmt.Sprintf("field 'Manufacturer' cannot refer to %s, expected one of %v", target, ingredientManufacturerManufacturerTargets))
+		}
+	}
+	if r.Manufacturer != nil {
+		r.Manufacturer.validateAll(path+".manufacturer", issues)
+	}
+}
+
+var ingredientManufacturerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *IngredientManufacturer) invariants() []invariant {
+	return ingredientManufacturerInvariants
+}
+
+func (r IngredientManufacturer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *IngredientManufacturer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r IngredientManufacturer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *IngredientManufacturer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var ingredientManufacturerElementMetadata = []ElementMetadata{
+	{Path: "Ingredient.manufacturer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.manufacturer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Ingredient.manufacturer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Ingredient.manufacturer.role", Name: "role", Field: 3, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Ingredient.manufacturer.role", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/ingredient-manufacturer-role"}},
+	{Path: "Ingredient.manufacturer.manufacturer", Name: "manufacturer", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+}
+
+func (r *IngredientManufacturer) ElementMetadata() []ElementMetadata {
+	return ingredientManufacturerElementMetadata
+}
+
 type IngredientSubstance struct {
-	Id       *string                       `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Code     *CodeableReference            `json:"code" bson:"code"`                             // A code or full resource that represents the ingredient substance
-	Strength []IngredientSubstanceStrength `json:"strength,omitempty" bson:"strength,omitempty"` // The quantity of substance, per presentation, or per volume or mass, and type of quantity
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableReference            `json:"code" bson:"code"`                                                // A code or full resource that represents the ingredient substance
+	Strength          []IngredientSubstanceStrength `json:"strength,omitempty" bson:"strength,omitempty"`                    // The quantity of substance, per presentation, or per volume or mass, and type of quantity
 }
 
 func (r *IngredientSubstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -129,63 +500,115 @@ func (r *IngredientSubstance) Validate() error {
 	return nil
 }
 
+func (r *IngredientSubstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.Strength {
+		item.validateAll(fmt.Sprintf("%s.strength[%d]", path, i), issues)
+	}
+}
+
+var ingredientSubstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *IngredientSubstance) invariants() []invariant {
+	return ingredientSubstanceInvariants
+}
+
+func (r IngredientSubstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *IngredientSubstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r IngredientSubstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *IngredientSubstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var ingredientSubstanceElementMetadata = []ElementMetadata{
+	{Path: "Ingredient.substance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Ingredient.substance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Ingredient.substance.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Ingredient.substance.strength", Name: "strength", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *IngredientSubstance) ElementMetadata() []ElementMetadata {
+	return ingredientSubstanceElementMetadata
+}
+
 type IngredientSubstanceStrength struct {
-	Id                           *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                                       // Unique id for inter-element referencing
-	PresentationRatio            *Ratio                                         `json:"presentationRatio,omitempty" bson:"presentation_ratio,omitempty"`                        // The quantity of substance in the unit of presentation
-	PresentationRatioRange       *RatioRange                                    `json:"presentationRatioRange,omitempty" bson:"presentation_ratio_range,omitempty"`             // The quantity of substance in the unit of presentation
-	PresentationCodeableConcept  *CodeableConcept                               `json:"presentationCodeableConcept,omitempty" bson:"presentation_codeable_concept,omitempty"`   // The quantity of substance in the unit of presentation
-	PresentationQuantity         *Quantity                                      `json:"presentationQuantity,omitempty" bson:"presentation_quantity,omitempty"`                  // The quantity of substance in the unit of presentation
-	TextPresentation             *string                                        `json:"textPresentation,omitempty" bson:"text_presentation,omitempty"`                          // Text of either the whole presentation strength or a part of it (rest being in Strength.presentation as a ratio)
-	ConcentrationRatio           *Ratio                                         `json:"concentrationRatio,omitempty" bson:"concentration_ratio,omitempty"`                      // The strength per unitary volume (or mass)
-	ConcentrationRatioRange      *RatioRange                                    `json:"concentrationRatioRange,omitempty" bson:"concentration_ratio_range,omitempty"`           // The strength per unitary volume (or mass)
-	ConcentrationCodeableConcept *CodeableConcept                               `json:"concentrationCodeableConcept,omitempty" bson:"concentration_codeable_concept,omitempty"` // The strength per unitary volume (or mass)
-	ConcentrationQuantity        *Quantity                                      `json:"concentrationQuantity,omitempty" bson:"concentration_quantity,omitempty"`                // The strength per unitary volume (or mass)
-	TextConcentration            *string                                        `json:"textConcentration,omitempty" bson:"text_concentration,omitempty"`                        // Text of either the whole concentration strength or a part of it (rest being in Strength.concentration as a ratio)
-	Basis                        *CodeableConcept                               `json:"basis,omitempty" bson:"basis,omitempty"`                                                 // A code that indicates if the strength is, for example, based on the ingredient substance as stated or on the substance base (when the ingredient is a salt)
-	MeasurementPoint             *string                                        `json:"measurementPoint,omitempty" bson:"measurement_point,omitempty"`                          // When strength is measured at a particular point or distance
-	Country                      []CodeableConcept                              `json:"country,omitempty" bson:"country,omitempty"`                                             // Where the strength range applies
-	ReferenceStrength            []IngredientSubstanceStrengthReferenceStrength `json:"referenceStrength,omitempty" bson:"reference_strength,omitempty"`                        // Strength expressed in terms of a reference substance
+	Id                       *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension                []Extension                                    `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension                                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	Presentation             IngredientSubstanceStrengthPresentation        `json:"-" bson:"presentation,omitempty"`                                          // The quantity of substance in the unit of presentation
+	TextPresentation         *string                                        `json:"textPresentation,omitempty" bson:"text_presentation,omitempty"`            // Text of either the whole presentation strength or a part of it (rest being in Strength.presentation as a ratio)
+	TextPresentationElement  *Element                                       `json:"_textPresentation,omitempty" bson:"text_presentation_element,omitempty"`   // Extensions for textPresentation
+	Concentration            IngredientSubstanceStrengthConcentration       `json:"-" bson:"concentration,omitempty"`                                         // The strength per unitary volume (or mass)
+	TextConcentration        *string                                        `json:"textConcentration,omitempty" bson:"text_concentration,omitempty"`          // Text of either the whole concentration strength or a part of it (rest being in Strength.concentration as a ratio)
+	TextConcentrationElement *Element                                       `json:"_textConcentration,omitempty" bson:"text_concentration_element,omitempty"` // Extensions for textConcentration
+	Basis                    *CodeableConcept                               `json:"basis,omitempty" bson:"basis,omitempty"`                                   // A code that indicates if the strength is, for example, based on the ingredient substance as stated or on the substance base (when the ingredient is a salt)
+	MeasurementPoint         *string                                        `json:"measurementPoint,omitempty" bson:"measurement_point,omitempty"`            // When strength is measured at a particular point or distance
+	MeasurementPointElement  *Element                                       `json:"_measurementPoint,omitempty" bson:"measurement_point_element,omitempty"`   // Extensions for measurementPoint
+	Country                  []CodeableConcept                              `json:"country,omitempty" bson:"country,omitempty"`                               // Where the strength range applies
+	ReferenceStrength        []IngredientSubstanceStrengthReferenceStrength `json:"referenceStrength,omitempty" bson:"reference_strength,omitempty"`          // Strength expressed in terms of a reference substance
+
+	presentationVariants  []string // JSON properties of Ingredient.substance.strength.presentation[x] when more than one was decoded
+	concentrationVariants []string // JSON properties of Ingredient.substance.strength.concentration[x] when more than one was decoded
 }
 
 func (r *IngredientSubstanceStrength) Validate() error {
-	if r.PresentationRatio != nil {
-		if err := r.PresentationRatio.Validate(); err != nil {
-			return fmt.Errorf("PresentationRatio: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.PresentationRatioRange != nil {
-		if err := r.PresentationRatioRange.Validate(); err != nil {
-			return fmt.Errorf("PresentationRatioRange: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.PresentationCodeableConcept != nil {
-		if err := r.PresentationCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("PresentationCodeableConcept: %w", err)
-		}
+	if len(r.presentationVariants) > 1 {
+		return fmt.Errorf("field 'Presentation' must have a single type, got %v", r.presentationVariants)
 	}
-	if r.PresentationQuantity != nil {
-		if err := r.PresentationQuantity.Validate(); err != nil {
-			return fmt.Errorf("PresentationQuantity: %w", err)
+	if r.Presentation != nil {
+		if err := r.Presentation.Validate(); err != nil {
+			return fmt.Errorf("Presentation: %w", err)
 		}
 	}
-	if r.ConcentrationRatio != nil {
-		if err := r.ConcentrationRatio.Validate(); err != nil {
-			return fmt.Errorf("ConcentrationRatio: %w", err)
+	if r.TextPresentationElement != nil {
+		if err := r.TextPresentationElement.Validate(); err != nil {
+			return fmt.Errorf("TextPresentationElement: %w", err)
 		}
 	}
-	if r.ConcentrationRatioRange != nil {
-		if err := r.ConcentrationRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ConcentrationRatioRange: %w", err)
-		}
+	if len(r.concentrationVariants) > 1 {
+		return fmt.Errorf("field 'Concentration' must have a single type, got %v", r.concentrationVariants)
 	}
-	if r.ConcentrationCodeableConcept != nil {
-		if err := r.ConcentrationCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ConcentrationCodeableConcept: %w", err)
+	if r.Concentration != nil {
+		if err := r.Concentration.Validate(); err != nil {
+			return fmt.Errorf("Concentration: %w", err)
 		}
 	}
-	if r.ConcentrationQuantity != nil {
-		if err := r.ConcentrationQuantity.Validate(); err != nil {
-			return fmt.Errorf("ConcentrationQuantity: %w", err)
+	if r.TextConcentrationElement != nil {
+		if err := r.TextConcentrationElement.Validate(); err != nil {
+			return fmt.Errorf("TextConcentrationElement: %w", err)
 		}
 	}
 	if r.Basis != nil {
@@ -193,6 +616,11 @@ func (r *IngredientSubstanceStrength) Validate() error {
 			return fmt.Errorf("Basis: %w", err)
 		}
 	}
+	if r.MeasurementPointElement != nil {
+		if err := r.MeasurementPointElement.Validate(); err != nil {
+			return fmt.Errorf("MeasurementPointElement: %w", err)
+		}
+	}
 	for i, item := range r.Country {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Country[%d]: %w", i, err)
@@ -206,17 +634,349 @@ func (r *IngredientSubstanceStrength) Validate() error {
 	return nil
 }
 
+func (r *IngredientSubstanceStrength) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.presentationVariants) > 1 {
+		issues.add("structure", path+".presentation", fmt.Sprintf("field 'Presentation' must have a single type, got %v", r.presentationVariants))
+	}
+	if r.Presentation != nil {
+		r.Presentation.validateAll(path+".presentation.ofType("+r.Presentation.FHIRType()+")", issues)
+	}
+	if r.TextPresentationElement != nil {
+		r.TextPresentationElement.validateAll(path+".textPresentation", issues)
+	}
+	if len(r.concentrationVariants) > 1 {
+		issues.add("structure", path+".concentration", fmt.Sprintf("field 'Concentration' must have a single type, got %v", r.concentrationVariants))
+	}
+	if r.Concentration != nil {
+		r.Concentration.validateAll(path+".concentration.ofType("+r.Concentration.FHIRType()+")", issues)
+	}
+	if r.TextConcentrationElement != nil {
+		r.TextConcentrationElement.validateAll(path+".textConcentration", issues)
+	}
+	if r.Basis != nil {
+		r.Basis.validateAll(path+".basis", issues)
+	}
+	if r.MeasurementPointElement != nil {
+		r.MeasurementPointElement.validateAll(path+".measurementPoint", issues)
+	}
+	for i, item := range r.Country {
+		item.validateAll(fmt.Sprintf("%s.country[%d]", path, i), issues)
+	}
+	for i, item := range r.ReferenceStrength {
+		item.validateAll(fmt.Sprintf("%s.referenceStrength[%d]", path, i), issues)
+	}
+}
+
+var ingredientSubstanceStrengthInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *IngredientSubstanceStrength) invariants() []invariant {
+	return ingredientSubstanceStrengthInvariants
+}
+
+func (r IngredientSubstanceStrength) MarshalJSON() ([]byte, error) {
+	type alias IngredientSubstanceStrength
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "presentation", r.Presentation, nil); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "concentration", r.Concentration, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *IngredientSubstanceStrength) UnmarshalJSON(data []byte) error {
+	type alias IngredientSubstanceStrength
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Presentation, _, r.presentationVariants, err = unmarshalChoice(fields, "presentation", ingredientSubstanceStrengthPresentationVariants); err != nil {
+		return err
+	}
+	if r.Concentration, _, r.concentrationVariants, err = unmarshalChoice(fields, "concentration", ingredientSubstanceStrengthConcentrationVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r IngredientSubstanceStrength) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *IngredientSubstanceStrength) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r IngredientSubstanceStrength) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *IngredientSubstanceStrength) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *IngredientSubstanceStrength) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Presentation":
+		return "presentation", choiceValues(ingredientSubstanceStrengthPresentationVariants)
+	case "Concentration":
+		return "concentration", choiceValues(ingredientSubstanceStrengthConcentrationVariants)
+	}
+	return "", nil
+}
+
+var ingredientSubstanceStrengthElementMetadata = []ElementMetadata{
+	{Path: "Ingredient.substance.strength.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.strength.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Ingredient.substance.strength.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Ingredient.substance.strength.presentation[x]", Name: "presentation", Field: 3, Min: 0, Max: "1", Types: []string{"Ratio", "RatioRange", "CodeableConcept", "Quantity"}},
+	{Path: "Ingredient.substance.strength.textPresentation", Name: "textPresentation", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.strength.concentration[x]", Name: "concentration", Field: 6, Min: 0, Max: "1", Types: []string{"Ratio", "RatioRange", "CodeableConcept", "Quantity"}},
+	{Path: "Ingredient.substance.strength.textConcentration", Name: "textConcentration", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.strength.basis", Name: "basis", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Ingredient.substance.strength.measurementPoint", Name: "measurementPoint", Field: 10, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.strength.country", Name: "country", Field: 12, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Ingredient.substance.strength.referenceStrength", Name: "referenceStrength", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *IngredientSubstanceStrength) ElementMetadata() []ElementMetadata {
+	return ingredientSubstanceStrengthElementMetadata
+}
+
+// IngredientSubstanceStrengthPresentation is the type of Ingredient.substance.strength.presentation[x]. It is implemented by the IngredientSubstanceStrengthPresentation* variant types.
+type IngredientSubstanceStrengthPresentation interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isIngredientSubstanceStrengthPresentation()
+}
+
+// IngredientSubstanceStrengthPresentationRatio is the Ratio variant of IngredientSubstanceStrengthPresentation.
+type IngredientSubstanceStrengthPresentationRatio struct {
+	Ratio
+}
+
+func (v IngredientSubstanceStrengthPresentationRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v IngredientSubstanceStrengthPresentationRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthPresentationRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v IngredientSubstanceStrengthPresentationRatio) isIngredientSubstanceStrengthPresentation() {}
+
+// IngredientSubstanceStrengthPresentationRatioRange is the RatioRange variant of IngredientSubstanceStrengthPresentation.
+type IngredientSubstanceStrengthPresentationRatioRange struct {
+	RatioRange
+}
+
+func (v IngredientSubstanceStrengthPresentationRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v IngredientSubstanceStrengthPresentationRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthPresentationRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v IngredientSubstanceStrengthPresentationRatioRange) isIngredientSubstanceStrengthPresentation() {
+}
+
+// IngredientSubstanceStrengthPresentationCodeableConcept is the CodeableConcept variant of IngredientSubstanceStrengthPresentation.
+type IngredientSubstanceStrengthPresentationCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v IngredientSubstanceStrengthPresentationCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v IngredientSubstanceStrengthPresentationCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthPresentationCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v IngredientSubstanceStrengthPresentationCodeableConcept) isIngredientSubstanceStrengthPresentation() {
+}
+
+// IngredientSubstanceStrengthPresentationQuantity is the Quantity variant of IngredientSubstanceStrengthPresentation.
+type IngredientSubstanceStrengthPresentationQuantity struct {
+	Quantity
+}
+
+func (v IngredientSubstanceStrengthPresentationQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v IngredientSubstanceStrengthPresentationQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthPresentationQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v IngredientSubstanceStrengthPresentationQuantity) isIngredientSubstanceStrengthPresentation() {
+}
+
+var ingredientSubstanceStrengthPresentationVariants = []IngredientSubstanceStrengthPresentation{
+	IngredientSubstanceStrengthPresentationRatio{},
+	IngredientSubstanceStrengthPresentationRatioRange{},
+	IngredientSubstanceStrengthPresentationCodeableConcept{},
+	IngredientSubstanceStrengthPresentationQuantity{},
+}
+
+// IngredientSubstanceStrengthConcentration is the type of Ingredient.substance.strength.concentration[x]. It is implemented by the IngredientSubstanceStrengthConcentration* variant types.
+type IngredientSubstanceStrengthConcentration interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isIngredientSubstanceStrengthConcentration()
+}
+
+// IngredientSubstanceStrengthConcentrationRatio is the Ratio variant of IngredientSubstanceStrengthConcentration.
+type IngredientSubstanceStrengthConcentrationRatio struct {
+	Ratio
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatio) isIngredientSubstanceStrengthConcentration() {}
+
+// IngredientSubstanceStrengthConcentrationRatioRange is the RatioRange variant of IngredientSubstanceStrengthConcentration.
+type IngredientSubstanceStrengthConcentrationRatioRange struct {
+	RatioRange
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v IngredientSubstanceStrengthConcentrationRatioRange) isIngredientSubstanceStrengthConcentration() {
+}
+
+// IngredientSubstanceStrengthConcentrationCodeableConcept is the CodeableConcept variant of IngredientSubstanceStrengthConcentration.
+type IngredientSubstanceStrengthConcentrationCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v IngredientSubstanceStrengthConcentrationCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v IngredientSubstanceStrengthConcentrationCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthConcentrationCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v IngredientSubstanceStrengthConcentrationCodeableConcept) isIngredientSubstanceStrengthConcentration() {
+}
+
+// IngredientSubstanceStrengthConcentrationQuantity is the Quantity variant of IngredientSubstanceStrengthConcentration.
+type IngredientSubstanceStrengthConcentrationQuantity struct {
+	Quantity
+}
+
+func (v IngredientSubstanceStrengthConcentrationQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v IngredientSubstanceStrengthConcentrationQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthConcentrationQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v IngredientSubstanceStrengthConcentrationQuantity) isIngredientSubstanceStrengthConcentration() {
+}
+
+var ingredientSubstanceStrengthConcentrationVariants = []IngredientSubstanceStrengthConcentration{
+	IngredientSubstanceStrengthConcentrationRatio{},
+	IngredientSubstanceStrengthConcentrationRatioRange{},
+	IngredientSubstanceStrengthConcentrationCodeableConcept{},
+	IngredientSubstanceStrengthConcentrationQuantity{},
+}
+
 type IngredientSubstanceStrengthReferenceStrength struct {
-	Id                 *string            `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Substance          *CodeableReference `json:"substance" bson:"substance"`                                    // Relevant reference substance
-	StrengthRatio      *Ratio             `json:"strengthRatio" bson:"strength_ratio"`                           // Strength expressed in terms of a reference substance
-	StrengthRatioRange *RatioRange        `json:"strengthRatioRange" bson:"strength_ratio_range"`                // Strength expressed in terms of a reference substance
-	StrengthQuantity   *Quantity          `json:"strengthQuantity" bson:"strength_quantity"`                     // Strength expressed in terms of a reference substance
-	MeasurementPoint   *string            `json:"measurementPoint,omitempty" bson:"measurement_point,omitempty"` // When strength is measured at a particular point or distance
-	Country            []CodeableConcept  `json:"country,omitempty" bson:"country,omitempty"`                    // Where the strength range applies
+	Id                      *string                                              `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension                                          `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Substance               *CodeableReference                                   `json:"substance" bson:"substance"`                                             // Relevant reference substance
+	Strength                IngredientSubstanceStrengthReferenceStrengthStrength `json:"-" bson:"strength,omitempty"`                                            // Strength expressed in terms of a reference substance
+	MeasurementPoint        *string                                              `json:"measurementPoint,omitempty" bson:"measurement_point,omitempty"`          // When strength is measured at a particular point or distance
+	MeasurementPointElement *Element                                             `json:"_measurementPoint,omitempty" bson:"measurement_point_element,omitempty"` // Extensions for measurementPoint
+	Country                 []CodeableConcept                                    `json:"country,omitempty" bson:"country,omitempty"`                             // Where the strength range applies
+
+	strengthVariants []string // JSON properties of Ingredient.substance.strength.referenceStrength.strength[x] when more than one was decoded
 }
 
 func (r *IngredientSubstanceStrengthReferenceStrength) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Substance == nil {
 		return fmt.Errorf("field 'Substance' is required")
 	}
@@ -225,28 +985,20 @@ func (r *IngredientSubstanceStrengthReferenceStrength) Validate() error {
 			return fmt.Errorf("Substance: %w", err)
 		}
 	}
-	if r.StrengthRatio == nil {
-		return fmt.Errorf("field 'StrengthRatio' is required")
+	if len(r.strengthVariants) > 1 {
+		return fmt.Errorf("field 'Strength' must have a single type, got %v", r.strengthVariants)
 	}
-	if r.StrengthRatio != nil {
-		if err := r.StrengthRatio.Validate(); err != nil {
-			return fmt.Errorf("StrengthRatio: %w", err)
-		}
-	}
-	if r.StrengthRatioRange == nil {
-		return fmt.Errorf("field 'StrengthRatioRange' is required")
+	if r.Strength == nil {
+		return fmt.Errorf("field 'Strength' is required")
 	}
-	if r.StrengthRatioRange != nil {
-		if err := r.StrengthRatioRange.Validate(); err != nil {
-			return fmt.Errorf("StrengthRatioRange: %w", err)
+	if r.Strength != nil {
+		if err := r.Strength.Validate(); err != nil {
+			return fmt.Errorf("Strength: %w", err)
 		}
 	}
-	if r.StrengthQuantity == nil {
-		return fmt.Errorf("field 'StrengthQuantity' is required")
-	}
-	if r.StrengthQuantity != nil {
-		if err := r.StrengthQuantity.Validate(); err != nil {
-			return fmt.Errorf("StrengthQuantity: %w", err)
+	if r.MeasurementPointElement != nil {
+		if err := r.MeasurementPointElement.Validate(); err != nil {
+			return fmt.Errorf("MeasurementPointElement: %w", err)
 		}
 	}
 	for i, item := range r.Country {
@@ -256,3 +1008,187 @@ func (r *IngredientSubstanceStrengthReferenceStrength) Validate() error {
 	}
 	return nil
 }
+
+func (r *IngredientSubstanceStrengthReferenceStrength) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Substance == nil {
+		issues.add("required", path+".substance", "field 'Substance' is required")
+	}
+	if r.Substance != nil {
+		r.Substance.validateAll(path+".substance", issues)
+	}
+	if len(r.strengthVariants) > 1 {
+		issues.add("structure", path+".strength", fmt.Sprintf("field 'Strength' must have a single type, got %v", r.strengthVariants))
+	}
+	if r.Strength == nil {
+		issues.add("required", path+".strength", "field 'Strength' is required")
+	}
+	if r.Strength != nil {
+		r.Strength.validateAll(path+".strength.ofType("+r.Strength.FHIRType()+")", issues)
+	}
+	if r.MeasurementPointElement != nil {
+		r.MeasurementPointElement.validateAll(path+".measurementPoint", issues)
+	}
+	for i, item := range r.Country {
+		item.validateAll(fmt.Sprintf("%s.country[%d]", path, i), issues)
+	}
+}
+
+var ingredientSubstanceStrengthReferenceStrengthInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *IngredientSubstanceStrengthReferenceStrength) invariants() []invariant {
+	return ingredientSubstanceStrengthReferenceStrengthInvariants
+}
+
+func (r IngredientSubstanceStrengthReferenceStrength) MarshalJSON() ([]byte, error) {
+	type alias IngredientSubstanceStrengthReferenceStrength
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "strength", r.Strength, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *IngredientSubstanceStrengthReferenceStrength) UnmarshalJSON(data []byte) error {
+	type alias IngredientSubstanceStrengthReferenceStrength
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Strength, _, r.strengthVariants, err = unmarshalChoice(fields, "strength", ingredientSubstanceStrengthReferenceStrengthStrengthVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r IngredientSubstanceStrengthReferenceStrength) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *IngredientSubstanceStrengthReferenceStrength) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r IngredientSubstanceStrengthReferenceStrength) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *IngredientSubstanceStrengthReferenceStrength) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *IngredientSubstanceStrengthReferenceStrength) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Strength":
+		return "strength", choiceValues(ingredientSubstanceStrengthReferenceStrengthStrengthVariants)
+	}
+	return "", nil
+}
+
+var ingredientSubstanceStrengthReferenceStrengthElementMetadata = []ElementMetadata{
+	{Path: "Ingredient.substance.strength.referenceStrength.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.strength.referenceStrength.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Ingredient.substance.strength.referenceStrength.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Ingredient.substance.strength.referenceStrength.substance", Name: "substance", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Ingredient.substance.strength.referenceStrength.strength[x]", Name: "strength", Field: 4, Min: 1, Max: "1", Types: []string{"Ratio", "RatioRange", "Quantity"}},
+	{Path: "Ingredient.substance.strength.referenceStrength.measurementPoint", Name: "measurementPoint", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ingredient.substance.strength.referenceStrength.country", Name: "country", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *IngredientSubstanceStrengthReferenceStrength) ElementMetadata() []ElementMetadata {
+	return ingredientSubstanceStrengthReferenceStrengthElementMetadata
+}
+
+// IngredientSubstanceStrengthReferenceStrengthStrength is the type of Ingredient.substance.strength.referenceStrength.strength[x]. It is implemented by the IngredientSubstanceStrengthReferenceStrengthStrength* variant types.
+type IngredientSubstanceStrengthReferenceStrengthStrength interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isIngredientSubstanceStrengthReferenceStrengthStrength()
+}
+
+// IngredientSubstanceStrengthReferenceStrengthStrengthRatio is the Ratio variant of IngredientSubstanceStrengthReferenceStrengthStrength.
+type IngredientSubstanceStrengthReferenceStrengthStrengthRatio struct {
+	Ratio
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatio) isIngredientSubstanceStrengthReferenceStrengthStrength() {
+}
+
+// IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange is the RatioRange variant of IngredientSubstanceStrengthReferenceStrengthStrength.
+type IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange struct {
+	RatioRange
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange) isIngredientSubstanceStrengthReferenceStrengthStrength() {
+}
+
+// IngredientSubstanceStrengthReferenceStrengthStrengthQuantity is the Quantity variant of IngredientSubstanceStrengthReferenceStrengthStrength.
+type IngredientSubstanceStrengthReferenceStrengthStrengthQuantity struct {
+	Quantity
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v IngredientSubstanceStrengthReferenceStrengthStrengthQuantity) isIngredientSubstanceStrengthReferenceStrengthStrength() {
+}
+
+var ingredientSubstanceStrengthReferenceStrengthStrengthVariants = []IngredientSubstanceStrengthReferenceStrengthStrength{
+	IngredientSubstanceStrengthReferenceStrengthStrengthRatio{},
+	IngredientSubstanceStrengthReferenceStrengthStrengthRatioRange{},
+	IngredientSubstanceStrengthReferenceStrengthStrengthQuantity{},
+}
@@ -2,27 +2,38 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Details of a Health Insurance plan provided by an organization under an InsuranceProduct.
 type InsurancePlan struct {
-	ResourceType  string                      `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                     `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                     `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Business Identifier for Plan
-	Type          *CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                    // Classification of Plan
-	Product       *Reference                  `json:"product,omitempty" bson:"product,omitempty"`              // The product that this plan is available under
-	CoverageArea  []Reference                 `json:"coverageArea,omitempty" bson:"coverage_area,omitempty"`   // Where product-plan applies
-	Network       []Reference                 `json:"network,omitempty" bson:"network,omitempty"`              // What networks provide coverage
-	GeneralCost   []InsurancePlanGeneralCost  `json:"generalCost,omitempty" bson:"general_cost,omitempty"`     // Overall costs
-	SpecificCost  []InsurancePlanSpecificCost `json:"specificCost,omitempty" bson:"specific_cost,omitempty"`   // Individual cost elements
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier for Plan
+	Type                 *CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                             // Classification of Plan
+	Product              *Reference                  `json:"product,omitempty" bson:"product,omitempty"`                       // The product that this plan is available under
+	CoverageArea         []Reference                 `json:"coverageArea,omitempty" bson:"coverage_area,omitempty"`            // Where product-plan applies
+	Network              []Reference                 `json:"network,omitempty" bson:"network,omitempty"`                       // What networks provide coverage
+	GeneralCost          []InsurancePlanGeneralCost  `json:"generalCost,omitempty" bson:"general_cost,omitempty"`              // Overall costs
+	SpecificCost         []InsurancePlanSpecificCost `json:"specificCost,omitempty" bson:"specific_cost,omitempty"`            // Individual cost elements
 }
 
+var insurancePlanProductTargets = []string{"InsuranceProduct"}
+
+var insurancePlanCoverageAreaTargets = []string{"Location"}
+
+var insurancePlanNetworkTargets = []string{"Organization"}
+
 func (r *InsurancePlan) Validate() error {
 	if r.ResourceType != "InsurancePlan" {
 		return fmt.Errorf("invalid resourceType: expected 'InsurancePlan', got '%s'", r.ResourceType)
@@ -32,11 +43,39 @@ func (r *InsurancePlan) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -47,16 +86,31 @@ func (r *InsurancePlan) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.Product != nil {
+		if target := invalidReferenceTarget(r.Product.Reference, r.Product.Type, insurancePlanProductTargets); target != "" {
+			return fmt.Errorf("field 'Product' cannot refer to %s, expected one of %v", target, insurancePlanProductTargets)
+		}
+	}
 	if r.Product != nil {
 		if err := r.Product.Validate(); err != nil {
 			return fmt.Errorf("Product: %w", err)
 		}
 	}
+	for i, item := range r.CoverageArea {
+		if target := invalidReferenceTarget(item.Reference, item.Type, insurancePlanCoverageAreaTargets); target != "" {
+			return fmt.Errorf("field 'CoverageArea[%d]' cannot refer to %s, expected one of %v", i, target, insurancePlanCoverageAreaTargets)
+		}
+	}
 	for i, item := range r.CoverageArea {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("CoverageArea[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Network {
+		if target := invalidReferenceTarget(item.Reference, item.Type, insurancePlanNetworkTargets); target != "" {
+			return fmt.Errorf("field 'Network[%d]' cannot refer to %s, expected one of %v", i, target, insurancePlanNetworkTargets)
+		}
+	}
 	for i, item := range r.Network {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Network[%d]: %w", i, err)
@@ -72,38 +126,335 @@ func (r *InsurancePlan) Validate() error {
 			return fmt.Errorf("SpecificCost[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "InsurancePlan")
+}
+
+func (r *InsurancePlan) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "InsurancePlan" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'InsurancePlan', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Product != nil {
+		if target := invalidReferenceTarget(r.Product.Reference, r.Product.Type, insurancePlanProductTargets); target != "" {
+			issues.add("value", path+".product", fmt.Sprintf("field 'Product' cannot refer to %s, expected one of %v", target, insurancePlanProductTargets))
+		}
+	}
+	if r.Product != nil {
+		r.Product.validateAll(path+".product", issues)
+	}
+	for i, item := range r.CoverageArea {
+		if target := invalidReferenceTarget(item.Reference, item.Type, insurancePlanCoverageAreaTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.coverageArea[%d]", path, i), fmt.Sprintf("field 'CoverageArea[%d]' cannot refer to %s, expected one of %v", i, target, insurancePlanCoverageAreaTargets))
+		}
+	}
+	for i, item := range r.CoverageArea {
+		item.validateAll(fmt.Sprintf("%s.coverageArea[%d]", path, i), issues)
+	}
+	for i, item := range r.Network {
+		if target := invalidReferenceTarget(item.Reference, item.Type, insurancePlanNetworkTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.network[%d]", path, i), fmt.Sprintf("field 'Network[%d]' cannot refer to %s, expected one of %v", i, target, insurancePlanNetworkTargets))
+		}
+	}
+	for i, item := range r.Network {
+		item.validateAll(fmt.Sprintf("%s.network[%d]", path, i), issues)
+	}
+	for i, item := range r.GeneralCost {
+		item.validateAll(fmt.Sprintf("%s.generalCost[%d]", path, i), issues)
+	}
+	for i, item := range r.SpecificCost {
+		item.validateAll(fmt.Sprintf("%s.specificCost[%d]", path, i), issues)
+	}
+}
+
+func (r *InsurancePlan) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("InsurancePlan", &issues)
+	checkInvariants(r, "InsurancePlan", &issues)
+	return issues
+}
+
+var insurancePlanInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *InsurancePlan) invariants() []invariant {
+	return insurancePlanInvariants
+}
+
+func (r *InsurancePlan) UnmarshalJSON(data []byte) error {
+	type alias InsurancePlan
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r InsurancePlan) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsurancePlan) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsurancePlan) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsurancePlan) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insurancePlanElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *InsurancePlan) elementTypes() map[string]string {
+	return insurancePlanElementTypes
+}
+
+var insurancePlanElementMetadata = []ElementMetadata{
+	{Path: "InsurancePlan.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "InsurancePlan.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "InsurancePlan.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "InsurancePlan.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "InsurancePlan.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "InsurancePlan.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "InsurancePlan.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "InsurancePlan.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsurancePlan.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsurancePlan.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "InsurancePlan.type", Name: "type", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.product", Name: "product", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/InsuranceProduct"}},
+	{Path: "InsurancePlan.coverageArea", Name: "coverageArea", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "InsurancePlan.network", Name: "network", Field: 15, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "InsurancePlan.generalCost", Name: "generalCost", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "InsurancePlan.specificCost", Name: "specificCost", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *InsurancePlan) ElementMetadata() []ElementMetadata {
+	return insurancePlanElementMetadata
+}
+
+func (r *InsurancePlan) GetResourceType() string {
+	return "InsurancePlan"
+}
+
+func (r *InsurancePlan) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *InsurancePlan) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *InsurancePlan) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *InsurancePlan) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *InsurancePlan) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *InsurancePlan) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *InsurancePlan) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*InsurancePlan)(nil)
+
 type InsurancePlanGeneralCost struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
-	Type      *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`            // Classification of specific cost
-	GroupSize *int             `json:"groupSize,omitempty" bson:"group_size,omitempty"` // Number of enrollees
-	Cost      *Money           `json:"cost,omitempty" bson:"cost,omitempty"`            // Cost value
-	Comment   *string          `json:"comment,omitempty" bson:"comment,omitempty"`      // Additional cost information
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Classification of specific cost
+	GroupSize         *int             `json:"groupSize,omitempty" bson:"group_size,omitempty"`                 // Number of enrollees
+	GroupSizeElement  *Element         `json:"_groupSize,omitempty" bson:"group_size_element,omitempty"`        // Extensions for groupSize
+	Cost              *Money           `json:"cost,omitempty" bson:"cost,omitempty"`                            // Cost value
+	Comment           *string          `json:"comment,omitempty" bson:"comment,omitempty"`                      // Additional cost information
+	CommentElement    *Element         `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
 }
 
 func (r *InsurancePlanGeneralCost) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.GroupSizeElement != nil {
+		if err := r.GroupSizeElement.Validate(); err != nil {
+			return fmt.Errorf("GroupSizeElement: %w", err)
+		}
+	}
 	if r.Cost != nil {
 		if err := r.Cost.Validate(); err != nil {
 			return fmt.Errorf("Cost: %w", err)
 		}
 	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *InsurancePlanGeneralCost) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.GroupSizeElement != nil {
+		r.GroupSizeElement.validateAll(path+".groupSize", issues)
+	}
+	if r.Cost != nil {
+		r.Cost.validateAll(path+".cost", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+}
+
+var insurancePlanGeneralCostInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsurancePlanGeneralCost) invariants() []invariant {
+	return insurancePlanGeneralCostInvariants
+}
+
+func (r InsurancePlanGeneralCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsurancePlanGeneralCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsurancePlanGeneralCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsurancePlanGeneralCost) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insurancePlanGeneralCostElementTypes = map[string]string{
+	"groupSize": "positiveInt",
+}
+
+func (r *InsurancePlanGeneralCost) elementTypes() map[string]string {
+	return insurancePlanGeneralCostElementTypes
+}
+
+var insurancePlanGeneralCostElementMetadata = []ElementMetadata{
+	{Path: "InsurancePlan.generalCost.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsurancePlan.generalCost.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsurancePlan.generalCost.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsurancePlan.generalCost.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.generalCost.groupSize", Name: "groupSize", Field: 4, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "InsurancePlan.generalCost.cost", Name: "cost", Field: 6, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "InsurancePlan.generalCost.comment", Name: "comment", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *InsurancePlanGeneralCost) ElementMetadata() []ElementMetadata {
+	return insurancePlanGeneralCostElementMetadata
+}
+
 type InsurancePlanSpecificCost struct {
-	Id       *string                            `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Category *CodeableConcept                   `json:"category" bson:"category"`                   // General category of benefit
-	Benefit  []InsurancePlanSpecificCostBenefit `json:"benefit,omitempty" bson:"benefit,omitempty"` // Benefits list
+	Id                *string                            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category          *CodeableConcept                   `json:"category" bson:"category"`                                        // General category of benefit
+	Benefit           []InsurancePlanSpecificCostBenefit `json:"benefit,omitempty" bson:"benefit,omitempty"`                      // Benefits list
 }
 
 func (r *InsurancePlanSpecificCost) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Category == nil {
 		return fmt.Errorf("field 'Category' is required")
 	}
@@ -120,13 +471,79 @@ func (r *InsurancePlanSpecificCost) Validate() error {
 	return nil
 }
 
+func (r *InsurancePlanSpecificCost) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category == nil {
+		issues.add("required", path+".category", "field 'Category' is required")
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	for i, item := range r.Benefit {
+		item.validateAll(fmt.Sprintf("%s.benefit[%d]", path, i), issues)
+	}
+}
+
+var insurancePlanSpecificCostInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsurancePlanSpecificCost) invariants() []invariant {
+	return insurancePlanSpecificCostInvariants
+}
+
+func (r InsurancePlanSpecificCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsurancePlanSpecificCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsurancePlanSpecificCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsurancePlanSpecificCost) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insurancePlanSpecificCostElementMetadata = []ElementMetadata{
+	{Path: "InsurancePlan.specificCost.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsurancePlan.specificCost.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsurancePlan.specificCost.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsurancePlan.specificCost.category", Name: "category", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.specificCost.benefit", Name: "benefit", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *InsurancePlanSpecificCost) ElementMetadata() []ElementMetadata {
+	return insurancePlanSpecificCostElementMetadata
+}
+
 type InsurancePlanSpecificCostBenefit struct {
-	Id   *string                                `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Type *CodeableConcept                       `json:"type" bson:"type"`                     // Classification of benefit provided
-	Cost []InsurancePlanSpecificCostBenefitCost `json:"cost,omitempty" bson:"cost,omitempty"` // List of the costs
+	Id                *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                       `json:"type" bson:"type"`                                                // Classification of benefit provided
+	Cost              []InsurancePlanSpecificCostBenefitCost `json:"cost,omitempty" bson:"cost,omitempty"`                            // List of the costs
 }
 
 func (r *InsurancePlanSpecificCostBenefit) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -143,15 +560,81 @@ func (r *InsurancePlanSpecificCostBenefit) Validate() error {
 	return nil
 }
 
+func (r *InsurancePlanSpecificCostBenefit) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Cost {
+		item.validateAll(fmt.Sprintf("%s.cost[%d]", path, i), issues)
+	}
+}
+
+var insurancePlanSpecificCostBenefitInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsurancePlanSpecificCostBenefit) invariants() []invariant {
+	return insurancePlanSpecificCostBenefitInvariants
+}
+
+func (r InsurancePlanSpecificCostBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsurancePlanSpecificCostBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsurancePlanSpecificCostBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsurancePlanSpecificCostBenefit) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insurancePlanSpecificCostBenefitElementMetadata = []ElementMetadata{
+	{Path: "InsurancePlan.specificCost.benefit.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsurancePlan.specificCost.benefit.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsurancePlan.specificCost.benefit.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsurancePlan.specificCost.benefit.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.specificCost.benefit.cost", Name: "cost", Field: 4, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *InsurancePlanSpecificCostBenefit) ElementMetadata() []ElementMetadata {
+	return insurancePlanSpecificCostBenefitElementMetadata
+}
+
 type InsurancePlanSpecificCostBenefitCost struct {
-	Id            *string           `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Type          *CodeableConcept  `json:"type" bson:"type"`                                       // Classification of specific cost
-	Applicability *CodeableConcept  `json:"applicability,omitempty" bson:"applicability,omitempty"` // in-network | out-of-network | other
-	Qualifier     []CodeableConcept `json:"qualifier,omitempty" bson:"qualifier,omitempty"`         // Additional information about the cost
-	Value         *Quantity         `json:"value,omitempty" bson:"value,omitempty"`                 // The actual cost value
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept  `json:"type" bson:"type"`                                                // Classification of specific cost
+	Applicability     *CodeableConcept  `json:"applicability,omitempty" bson:"applicability,omitempty"`          // in-network | out-of-network | other
+	Qualifier         []CodeableConcept `json:"qualifier,omitempty" bson:"qualifier,omitempty"`                  // Additional information about the cost
+	Value             *Quantity         `json:"value,omitempty" bson:"value,omitempty"`                          // The actual cost value
 }
 
 func (r *InsurancePlanSpecificCostBenefitCost) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -177,3 +660,65 @@ func (r *InsurancePlanSpecificCostBenefitCost) Validate() error {
 	}
 	return nil
 }
+
+func (r *InsurancePlanSpecificCostBenefitCost) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Applicability != nil {
+		r.Applicability.validateAll(path+".applicability", issues)
+	}
+	for i, item := range r.Qualifier {
+		item.validateAll(fmt.Sprintf("%s.qualifier[%d]", path, i), issues)
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value", issues)
+	}
+}
+
+var insurancePlanSpecificCostBenefitCostInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsurancePlanSpecificCostBenefitCost) invariants() []invariant {
+	return insurancePlanSpecificCostBenefitCostInvariants
+}
+
+func (r InsurancePlanSpecificCostBenefitCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsurancePlanSpecificCostBenefitCost) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsurancePlanSpecificCostBenefitCost) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsurancePlanSpecificCostBenefitCost) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insurancePlanSpecificCostBenefitCostElementMetadata = []ElementMetadata{
+	{Path: "InsurancePlan.specificCost.benefit.cost.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsurancePlan.specificCost.benefit.cost.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsurancePlan.specificCost.benefit.cost.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsurancePlan.specificCost.benefit.cost.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.specificCost.benefit.cost.applicability", Name: "applicability", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.specificCost.benefit.cost.qualifier", Name: "qualifier", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "InsurancePlan.specificCost.benefit.cost.value", Name: "value", Field: 6, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *InsurancePlanSpecificCostBenefitCost) ElementMetadata() []ElementMetadata {
+	return insurancePlanSpecificCostBenefitCostElementMetadata
+}
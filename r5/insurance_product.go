@@ -2,34 +2,48 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Details of a Health Insurance product provided by an organization.
 type InsuranceProduct struct {
-	ResourceType   string                     `json:"resourceType" bson:"resource_type"`                         // Type of resource
-	Id             *string                    `json:"id,omitempty" bson:"id,omitempty"`                          // Logical id of this artifact
-	Meta           *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                      // Metadata about the resource
-	ImplicitRules  *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`   // A set of rules under which this content was created
-	Language       *string                    `json:"language,omitempty" bson:"language,omitempty"`              // Language of the resource content
-	Text           *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                      // Text summary of the resource, for human interpretation
-	Contained      []json.RawMessage          `json:"contained,omitempty" bson:"contained,omitempty"`            // Contained, inline Resources
-	Identifier     []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`          // Business Identifier for Product
-	Status         *string                    `json:"status,omitempty" bson:"status,omitempty"`                  // draft | active | retired | unknown
-	Type           []CodeableConcept          `json:"type,omitempty" bson:"type,omitempty"`                      // Kind of product
-	Name           *string                    `json:"name,omitempty" bson:"name,omitempty"`                      // Official name
-	Alias          []string                   `json:"alias,omitempty" bson:"alias,omitempty"`                    // Alternate names
-	Period         *Period                    `json:"period,omitempty" bson:"period,omitempty"`                  // When the product is available
-	OwnedBy        *Reference                 `json:"ownedBy,omitempty" bson:"owned_by,omitempty"`               // Product issuer
-	AdministeredBy *Reference                 `json:"administeredBy,omitempty" bson:"administered_by,omitempty"` // Product administrator
-	CoverageArea   []Reference                `json:"coverageArea,omitempty" bson:"coverage_area,omitempty"`     // Where product applies
-	Contact        []ExtendedContactDetail    `json:"contact,omitempty" bson:"contact,omitempty"`                // Official contact details relevant to the health insurance product
-	Endpoint       []Reference                `json:"endpoint,omitempty" bson:"endpoint,omitempty"`              // Technical endpoint
-	Network        []Reference                `json:"network,omitempty" bson:"network,omitempty"`                // What networks are Included
-	Coverage       []InsuranceProductCoverage `json:"coverage,omitempty" bson:"coverage,omitempty"`              // Coverage details
-	Related        []InsuranceProductRelated  `json:"related,omitempty" bson:"related,omitempty"`                // Associated insurance product
+	ResourceType         string                     `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                    `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                    `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                   `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                 `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier for Product
+	Status               *PublicationStatus         `json:"status,omitempty" bson:"status,omitempty"`                         // draft | active | retired | unknown
+	StatusElement        *Element                   `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Type                 []CodeableConcept          `json:"type,omitempty" bson:"type,omitempty"`                             // Kind of product
+	Name                 *string                    `json:"name,omitempty" bson:"name,omitempty"`                             // Official name
+	NameElement          *Element                   `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Alias                []string                   `json:"alias,omitempty" bson:"alias,omitempty"`                           // Alternate names
+	AliasElement         []*Element                 `json:"_alias,omitempty" bson:"alias_element,omitempty"`                  // Extensions for alias
+	Period               *Period                    `json:"period,omitempty" bson:"period,omitempty"`                         // When the product is available
+	OwnedBy              *Reference                 `json:"ownedBy,omitempty" bson:"owned_by,omitempty"`                      // Product issuer
+	AdministeredBy       *Reference                 `json:"administeredBy,omitempty" bson:"administered_by,omitempty"`        // Product administrator
+	CoverageArea         []Reference                `json:"coverageArea,omitempty" bson:"coverage_area,omitempty"`            // Where product applies
+	Contact              []ExtendedContactDetail    `json:"contact,omitempty" bson:"contact,omitempty"`                       // Official contact details relevant to the health insurance product
+	Endpoint             []Reference                `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                     // Technical endpoint
+	Network              []Reference                `json:"network,omitempty" bson:"network,omitempty"`                       // What networks are Included
+	Coverage             []InsuranceProductCoverage `json:"coverage,omitempty" bson:"coverage,omitempty"`                     // Coverage details
+	Related              []InsuranceProductRelated  `json:"related,omitempty" bson:"related,omitempty"`                       // Associated insurance product
 }
 
+var insuranceProductOwnedByTargets = []string{"Organization"}
+
+var insuranceProductAdministeredByTargets = []string{"Organization"}
+
+var insuranceProductEndpointTargets = []string{"Endpoint"}
+
 func (r *InsuranceProduct) Validate() error {
 	if r.ResourceType != "InsuranceProduct" {
 		return fmt.Errorf("invalid resourceType: expected 'InsuranceProduct', got '%s'", r.ResourceType)
@@ -39,31 +53,90 @@ func (r *InsuranceProduct) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AliasElement[%d]: %w", i, err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.OwnedBy != nil {
+		if target := invalidReferenceTarget(r.OwnedBy.Reference, r.OwnedBy.Type, insuranceProductOwnedByTargets); target != "" {
+			return fmt.Errorf("field 'OwnedBy' cannot refer to %s, expected one of %v", target, insuranceProductOwnedByTargets)
+		}
+	}
 	if r.OwnedBy != nil {
 		if err := r.OwnedBy.Validate(); err != nil {
 			return fmt.Errorf("OwnedBy: %w", err)
 		}
 	}
+	if r.AdministeredBy != nil {
+		if target := invalidReferenceTarget(r.AdministeredBy.Reference, r.AdministeredBy.Type, insuranceProductAdministeredByTargets); target != "" {
+			return fmt.Errorf("field 'AdministeredBy' cannot refer to %s, expected one of %v", target, insuranceProductAdministeredByTargets)
+		}
+	}
 	if r.AdministeredBy != nil {
 		if err := r.AdministeredBy.Validate(); err != nil {
 			return fmt.Errorf("AdministeredBy: %w", err)
@@ -79,6 +152,11 @@ func (r *InsuranceProduct) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, insuranceProductEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, insuranceProductEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
@@ -99,17 +177,273 @@ func (r *InsuranceProduct) Validate() error {
 			return fmt.Errorf("Related[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "InsuranceProduct")
+}
+
+func (r *InsuranceProduct) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "InsuranceProduct" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'InsuranceProduct', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.alias[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.OwnedBy != nil {
+		if target := invalidReferenceTarget(r.OwnedBy.Reference, r.OwnedBy.Type, insuranceProductOwnedByTargets); target != "" {
+			issues.add("value", path+".ownedBy", fmt.Sprintf("field 'OwnedBy' cannot refer to %s, expected one of %v", target, insuranceProductOwnedByTargets))
+		}
+	}
+	if r.OwnedBy != nil {
+		r.OwnedBy.validateAll(path+".ownedBy", issues)
+	}
+	if r.AdministeredBy != nil {
+		if target := invalidReferenceTarget(r.AdministeredBy.Reference, r.AdministeredBy.Type, insuranceProductAdministeredByTargets); target != "" {
+			issues.add("value", path+".administeredBy", fmt.Sprintf("field 'AdministeredBy' cannot refer to %s, expected one of %v", target, insuranceProductAdministeredByTargets))
+		}
+	}
+	if r.AdministeredBy != nil {
+		r.AdministeredBy.validateAll(path+".administeredBy", issues)
+	}
+	for i, item := range r.CoverageArea {
+		item.validateAll(fmt.Sprintf("%s.coverageArea[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, insuranceProductEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, insuranceProductEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+	for i, item := range r.Network {
+		item.validateAll(fmt.Sprintf("%s.network[%d]", path, i), issues)
+	}
+	for i, item := range r.Coverage {
+		item.validateAll(fmt.Sprintf("%s.coverage[%d]", path, i), issues)
+	}
+	for i, item := range r.Related {
+		item.validateAll(fmt.Sprintf("%s.related[%d]", path, i), issues)
+	}
+}
+
+func (r *InsuranceProduct) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("InsuranceProduct", &issues)
+	checkInvariants(r, "InsuranceProduct", &issues)
+	return issues
+}
+
+var insuranceProductInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *InsuranceProduct) invariants() []invariant {
+	return insuranceProductInvariants
+}
+
+func (r InsuranceProduct) MarshalJSON() ([]byte, error) {
+	type alias InsuranceProduct
+	out := struct {
+		alias
+		Alias        []*string  `json:"alias,omitempty"`
+		AliasElement []*Element `json:"_alias,omitempty"`
+	}{alias: alias(r)}
+	out.Alias, out.AliasElement = alignPrimitiveArray(r.Alias, r.AliasElement)
+	return json.Marshal(out)
+}
+
+func (r *InsuranceProduct) UnmarshalJSON(data []byte) error {
+	type alias InsuranceProduct
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Alias     []*string         `json:"alias,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Alias, err = splitPrimitiveArray(aux.Alias, r.AliasElement); err != nil {
+		return fmt.Errorf("alias: %w", err)
+	}
 	return nil
 }
 
+func (r InsuranceProduct) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsuranceProduct) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsuranceProduct) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsuranceProduct) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insuranceProductElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *InsuranceProduct) elementTypes() map[string]string {
+	return insuranceProductElementTypes
+}
+
+var insuranceProductElementMetadata = []ElementMetadata{
+	{Path: "InsuranceProduct.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "InsuranceProduct.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "InsuranceProduct.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "InsuranceProduct.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "InsuranceProduct.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "InsuranceProduct.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "InsuranceProduct.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "InsuranceProduct.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsuranceProduct.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsuranceProduct.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "InsuranceProduct.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "InsuranceProduct.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "InsuranceProduct.type", Name: "type", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "InsuranceProduct.name", Name: "name", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsuranceProduct.alias", Name: "alias", Field: 17, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "InsuranceProduct.period", Name: "period", Field: 19, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "InsuranceProduct.ownedBy", Name: "ownedBy", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "InsuranceProduct.administeredBy", Name: "administeredBy", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "InsuranceProduct.coverageArea", Name: "coverageArea", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "InsuranceProduct.contact", Name: "contact", Field: 23, Min: 0, Max: "*", Types: []string{"ExtendedContactDetail"}},
+	{Path: "InsuranceProduct.endpoint", Name: "endpoint", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+	{Path: "InsuranceProduct.network", Name: "network", Field: 25, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "InsuranceProduct.coverage", Name: "coverage", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "InsuranceProduct.related", Name: "related", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *InsuranceProduct) ElementMetadata() []ElementMetadata {
+	return insuranceProductElementMetadata
+}
+
+func (r *InsuranceProduct) GetResourceType() string {
+	return "InsuranceProduct"
+}
+
+func (r *InsuranceProduct) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *InsuranceProduct) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *InsuranceProduct) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *InsuranceProduct) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *InsuranceProduct) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *InsuranceProduct) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *InsuranceProduct) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*InsuranceProduct)(nil)
+
 type InsuranceProductCoverage struct {
-	Id      *string                           `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Type    *CodeableConcept                  `json:"type" bson:"type"`                           // Classification of Coverage
-	Network []Reference                       `json:"network,omitempty" bson:"network,omitempty"` // What networks provide coverage
-	Benefit []InsuranceProductCoverageBenefit `json:"benefit" bson:"benefit"`                     // List of benefits
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                  `json:"type" bson:"type"`                                                // Classification of Coverage
+	Network           []Reference                       `json:"network,omitempty" bson:"network,omitempty"`                      // What networks provide coverage
+	Benefit           []InsuranceProductCoverageBenefit `json:"benefit" bson:"benefit"`                                          // List of benefits
 }
 
 func (r *InsuranceProductCoverage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -134,14 +468,88 @@ func (r *InsuranceProductCoverage) Validate() error {
 	return nil
 }
 
+func (r *InsuranceProductCoverage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Network {
+		item.validateAll(fmt.Sprintf("%s.network[%d]", path, i), issues)
+	}
+	if len(r.Benefit) < 1 {
+		issues.add("required", path+".benefit", "field 'Benefit' must have at least 1 elements")
+	}
+	for i, item := range r.Benefit {
+		item.validateAll(fmt.Sprintf("%s.benefit[%d]", path, i), issues)
+	}
+}
+
+var insuranceProductCoverageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsuranceProductCoverage) invariants() []invariant {
+	return insuranceProductCoverageInvariants
+}
+
+func (r InsuranceProductCoverage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsuranceProductCoverage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsuranceProductCoverage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsuranceProductCoverage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insuranceProductCoverageElementMetadata = []ElementMetadata{
+	{Path: "InsuranceProduct.coverage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsuranceProduct.coverage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsuranceProduct.coverage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsuranceProduct.coverage.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsuranceProduct.coverage.network", Name: "network", Field: 4, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "InsuranceProduct.coverage.benefit", Name: "benefit", Field: 5, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *InsuranceProductCoverage) ElementMetadata() []ElementMetadata {
+	return insuranceProductCoverageElementMetadata
+}
+
 type InsuranceProductCoverageBenefit struct {
-	Id          *string                                `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type        *CodeableConcept                       `json:"type" bson:"type"`                                   // Classification of benefit provided
-	Requirement *string                                `json:"requirement,omitempty" bson:"requirement,omitempty"` // Referral requirements
-	Limit       []InsuranceProductCoverageBenefitLimit `json:"limit,omitempty" bson:"limit,omitempty"`             // Limits on the provided benefits
+	Id                 *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type               *CodeableConcept                       `json:"type" bson:"type"`                                                // Classification of benefit provided
+	Requirement        *string                                `json:"requirement,omitempty" bson:"requirement,omitempty"`              // Referral requirements
+	RequirementElement *Element                               `json:"_requirement,omitempty" bson:"requirement_element,omitempty"`     // Extensions for requirement
+	Limit              []InsuranceProductCoverageBenefitLimit `json:"limit,omitempty" bson:"limit,omitempty"`                          // Limits on the provided benefits
 }
 
 func (r *InsuranceProductCoverageBenefit) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -150,6 +558,11 @@ func (r *InsuranceProductCoverageBenefit) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.RequirementElement != nil {
+		if err := r.RequirementElement.Validate(); err != nil {
+			return fmt.Errorf("RequirementElement: %w", err)
+		}
+	}
 	for i, item := range r.Limit {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Limit[%d]: %w", i, err)
@@ -158,13 +571,83 @@ func (r *InsuranceProductCoverageBenefit) Validate() error {
 	return nil
 }
 
+func (r *InsuranceProductCoverageBenefit) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.RequirementElement != nil {
+		r.RequirementElement.validateAll(path+".requirement", issues)
+	}
+	for i, item := range r.Limit {
+		item.validateAll(fmt.Sprintf("%s.limit[%d]", path, i), issues)
+	}
+}
+
+var insuranceProductCoverageBenefitInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsuranceProductCoverageBenefit) invariants() []invariant {
+	return insuranceProductCoverageBenefitInvariants
+}
+
+func (r InsuranceProductCoverageBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsuranceProductCoverageBenefit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsuranceProductCoverageBenefit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsuranceProductCoverageBenefit) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insuranceProductCoverageBenefitElementMetadata = []ElementMetadata{
+	{Path: "InsuranceProduct.coverage.benefit.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsuranceProduct.coverage.benefit.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsuranceProduct.coverage.benefit.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsuranceProduct.coverage.benefit.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsuranceProduct.coverage.benefit.requirement", Name: "requirement", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsuranceProduct.coverage.benefit.limit", Name: "limit", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *InsuranceProductCoverageBenefit) ElementMetadata() []ElementMetadata {
+	return insuranceProductCoverageBenefitElementMetadata
+}
+
 type InsuranceProductCoverageBenefitLimit struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Value *Quantity        `json:"value,omitempty" bson:"value,omitempty"` // Maximum value allowed
-	Code  *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`   // Benefit limit details
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             *Quantity        `json:"value,omitempty" bson:"value,omitempty"`                          // Maximum value allowed
+	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // Benefit limit details
 }
 
 func (r *InsuranceProductCoverageBenefitLimit) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Value != nil {
 		if err := r.Value.Validate(); err != nil {
 			return fmt.Errorf("Value: %w", err)
@@ -178,14 +661,77 @@ func (r *InsuranceProductCoverageBenefitLimit) Validate() error {
 	return nil
 }
 
+func (r *InsuranceProductCoverageBenefitLimit) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+}
+
+var insuranceProductCoverageBenefitLimitInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsuranceProductCoverageBenefitLimit) invariants() []invariant {
+	return insuranceProductCoverageBenefitLimitInvariants
+}
+
+func (r InsuranceProductCoverageBenefitLimit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsuranceProductCoverageBenefitLimit) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsuranceProductCoverageBenefitLimit) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsuranceProductCoverageBenefitLimit) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insuranceProductCoverageBenefitLimitElementMetadata = []ElementMetadata{
+	{Path: "InsuranceProduct.coverage.benefit.limit.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsuranceProduct.coverage.benefit.limit.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsuranceProduct.coverage.benefit.limit.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsuranceProduct.coverage.benefit.limit.value", Name: "value", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "InsuranceProduct.coverage.benefit.limit.code", Name: "code", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *InsuranceProductCoverageBenefitLimit) ElementMetadata() []ElementMetadata {
+	return insuranceProductCoverageBenefitLimitElementMetadata
+}
+
 type InsuranceProductRelated struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Product      *Reference       `json:"product,omitempty" bson:"product,omitempty"`           // Related Product reference
-	Relationship *CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"` // Relationship of this product to the related product
-	Period       *Period          `json:"period,omitempty" bson:"period,omitempty"`             // Period that this Relationship is valid
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Product           *Reference       `json:"product,omitempty" bson:"product,omitempty"`                      // Related Product reference
+	Relationship      *CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"`            // Relationship of this product to the related product
+	Period            *Period          `json:"period,omitempty" bson:"period,omitempty"`                        // Period that this Relationship is valid
 }
 
 func (r *InsuranceProductRelated) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Product != nil {
 		if err := r.Product.Validate(); err != nil {
 			return fmt.Errorf("Product: %w", err)
@@ -203,3 +749,58 @@ func (r *InsuranceProductRelated) Validate() error {
 	}
 	return nil
 }
+
+func (r *InsuranceProductRelated) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Product != nil {
+		r.Product.validateAll(path+".product", issues)
+	}
+	if r.Relationship != nil {
+		r.Relationship.validateAll(path+".relationship", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var insuranceProductRelatedInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InsuranceProductRelated) invariants() []invariant {
+	return insuranceProductRelatedInvariants
+}
+
+func (r InsuranceProductRelated) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InsuranceProductRelated) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InsuranceProductRelated) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InsuranceProductRelated) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var insuranceProductRelatedElementMetadata = []ElementMetadata{
+	{Path: "InsuranceProduct.related.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "InsuranceProduct.related.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "InsuranceProduct.related.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "InsuranceProduct.related.product", Name: "product", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "InsuranceProduct.related.relationship", Name: "relationship", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "InsuranceProduct.related.period", Name: "period", Field: 5, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *InsuranceProductRelated) ElementMetadata() []ElementMetadata {
+	return insuranceProductRelatedElementMetadata
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// invariant is a constraint of a structure definition, such as obs-6, whose
+// FHIRPath expression must hold for every element it applies to.
+type invariant struct {
+	key        string
+	severity   string // error | warning
+	human      string
+	expression string
+	context    string // child element the invariant applies to; empty for the element itself
+}
+
+// invariantHolder is implemented by generated types that carry invariants.
+type invariantHolder interface {
+	invariants() []invariant
+}
+
+// checkInvariants evaluates the invariants of root and of every element
+// below it, recording each one that does not hold with its own severity.
+// An invariant that cannot be evaluated, for example because it uses a
+// function the evaluator does not support, is recorded as a warning under
+// issue code not-supported. Nested resources, such as contained resources
+// or Bundle entries, are left to their own ValidateAll.
+func checkInvariants(root any, path string, issues *ValidationIssues) {
+	evaluateInvariants(root, path, func(inv invariant, path string, err error) bool {
+		issue := ValidationIssue{
+			Severity: inv.severity,
+			Code:     "invariant",
+			Path:     path,
+			Message:  inv.key + ": " + inv.human,
+		}
+		if err != nil {
+			issue.Severity, issue.Code = "warning", "not-supported"
+			issue.Message = fmt.Sprintf("%s could not be checked: %v", inv.key, err)
+		}
+		*issues = append(*issues, issue)
+		return true
+	})
+}
+
+// validateInvariants returns the first error-level invariant of root or of
+// an element below it that does not hold. Warnings, and invariants that
+// cannot be evaluated, are not reported.
+func validateInvariants(root any, path string) error {
+	var err error
+	evaluateInvariants(root, path, func(inv invariant, path string, evalErr error) bool {
+		if inv.severity != "error" || evalErr != nil {
+			return true
+		}
+		err = fmt.Errorf("constraint %s failed at %s: %s", inv.key, path, inv.human)
+		return false
+	})
+	return err
+}
+
+// evaluateInvariants walks the elements of root and calls fail for each
+// invariant that does not hold, or with the error of one that cannot be
+// evaluated, stopping when fail returns false.
+func evaluateInvariants(root any, path string, fail func(inv invariant, path string, err error) bool) {
+	nodes := objectNode(root)
+	if len(nodes) != 1 || !nodes[0].object.IsValid() {
+		return
+	}
+	env := &fhirpathEnv{}
+	if nodes[0].isResource() {
+		env.resource, env.rootResource = nodes, nodes
+	}
+	walkInvariants(env, nodes[0].object, path, fail)
+}
+
+func walkInvariants(env *fhirpathEnv, obj reflect.Value, path string, fail func(invariant, string, error) bool) bool {
+	if holder, ok := obj.Addr().Interface().(invariantHolder); ok {
+		for _, inv := range holder.invariants() {
+			targets := []locatedValue{{value: obj, path: path}}
+			if inv.context != "" {
+				targets = childLocations(obj, inv.context, path)
+			}
+			for _, target := range targets {
+				holds, err := invariantHolds(env, inv, target)
+				if (!holds || err != nil) && !fail(inv, target.path, err) {
+					return false
+				}
+			}
+		}
+	}
+	for _, f := range fhirpathFields(obj.Type()) {
+		for _, child := range fieldLocations(obj, f, path) {
+			if child.value.Kind() != reflect.Struct || (fhirpathNode{object: child.value}).isResource() {
+				continue
+			}
+			if !walkInvariants(env, child.value, child.path, fail) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// invariantHolds evaluates inv for the element target. Only a false result
+// counts as a failure: an empty result does not. err reports an expression
+// that cannot be compiled or evaluated, or that yields no single boolean.
+func invariantHolds(env *fhirpathEnv, inv invariant, target locatedValue) (bool, error) {
+	expr, err := compileFHIRPath(inv.expression)
+	if err != nil {
+		return true, err
+	}
+	focus := fhirpathValues(target.value)
+	if len(focus) == 0 {
+		return true, nil
+	}
+	if target.fhirType != "" {
+		for i := range focus {
+			focus[i].fhirType = target.fhirType
+		}
+	}
+	c := *env
+	c.this, c.context = focus, focus
+	result, err := expr.eval(&c)
+	if err != nil {
+		return true, err
+	}
+	b, ok, err := singletonBool(result)
+	if err != nil {
+		return true, err
+	}
+	return !ok || b, nil
+}
+
+// locatedValue is an element together with its FHIRPath location.
+type locatedValue struct {
+	value    reflect.Value
+	path     string
+	fhirType string
+}
+
+func childLocations(obj reflect.Value, name, path string) []locatedValue {
+	for _, f := range fhirpathFields(obj.Type()) {
+		if f.name == name {
+			return fieldLocations(obj, f, path)
+		}
+	}
+	return nil
+}
+
+// fieldLocations returns the values of field f of obj with their
+// locations below path, such as Observation.component[1] or
+// Observation.value.ofType(Quantity).
+func fieldLocations(obj reflect.Value, f fhirpathField, path string) []locatedValue {
+	v := obj.Field(f.index)
+	if v.Kind() == reflect.Slice {
+		var result []locatedValue
+		for i := 0; i < v.Len(); i++ {
+			if item, fhirType := derefValue(v.Index(i)); item.IsValid() {
+				result = append(result, locatedValue{item, fmt.Sprintf("%s.%s[%d]", path, f.name, i), fhirType})
+			}
+		}
+		return result
+	}
+	item, fhirType := derefValue(v)
+	if !item.IsValid() {
+		return nil
+	}
+	location := path + "." + f.name
+	if fhirType != "" {
+		location += ".ofType(" + fhirType + ")"
+	}
+	return []locatedValue{{item, location, fhirType}}
+}
@@ -2,38 +2,57 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Invoice containing collected ChargeItems from an Account with calculated individual and total price for Billing purpose.
 type Invoice struct {
-	ResourceType        string               `json:"resourceType" bson:"resource_type"`                                    // Type of resource
-	Id                  *string              `json:"id,omitempty" bson:"id,omitempty"`                                     // Logical id of this artifact
-	Meta                *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                                 // Metadata about the resource
-	ImplicitRules       *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`              // A set of rules under which this content was created
-	Language            *string              `json:"language,omitempty" bson:"language,omitempty"`                         // Language of the resource content
-	Text                *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                                 // Text summary of the resource, for human interpretation
-	Contained           []json.RawMessage    `json:"contained,omitempty" bson:"contained,omitempty"`                       // Contained, inline Resources
-	Identifier          []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Business Identifier for item
-	Status              string               `json:"status" bson:"status"`                                                 // draft | issued | balanced | cancelled | entered-in-error
-	CancelledReason     *string              `json:"cancelledReason,omitempty" bson:"cancelled_reason,omitempty"`          // Reason for cancellation of this Invoice
-	Type                *CodeableConcept     `json:"type,omitempty" bson:"type,omitempty"`                                 // Type of Invoice
-	Subject             *Reference           `json:"subject,omitempty" bson:"subject,omitempty"`                           // Recipient(s) of goods and services
-	Recipient           *Reference           `json:"recipient,omitempty" bson:"recipient,omitempty"`                       // Recipient of this invoice
-	Creation            *string              `json:"creation,omitempty" bson:"creation,omitempty"`                         // When posted
-	PeriodDate          *string              `json:"periodDate,omitempty" bson:"period_date,omitempty"`                    // Billing date or period
-	PeriodPeriod        *Period              `json:"periodPeriod,omitempty" bson:"period_period,omitempty"`                // Billing date or period
-	Participant         []InvoiceParticipant `json:"participant,omitempty" bson:"participant,omitempty"`                   // Participant in creation of this Invoice
-	Issuer              *Reference           `json:"issuer,omitempty" bson:"issuer,omitempty"`                             // Issuing entity
-	Account             *Reference           `json:"account,omitempty" bson:"account,omitempty"`                           // Account that is being balanced
-	LineItem            []InvoiceLineItem    `json:"lineItem,omitempty" bson:"line_item,omitempty"`                        // Line items of this Invoice
-	TotalPriceComponent []MonetaryComponent  `json:"totalPriceComponent,omitempty" bson:"total_price_component,omitempty"` // Components of Invoice total
-	TotalNet            *Money               `json:"totalNet,omitempty" bson:"total_net,omitempty"`                        // Net total of this Invoice
-	TotalGross          *Money               `json:"totalGross,omitempty" bson:"total_gross,omitempty"`                    // Gross total of this Invoice
-	PaymentTerms        *string              `json:"paymentTerms,omitempty" bson:"payment_terms,omitempty"`                // Payment details
-	Note                []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                                 // Comments made about the invoice
+	ResourceType           string               `json:"resourceType" bson:"resource_type"`                                    // Type of resource
+	Id                     *string              `json:"id,omitempty" bson:"id,omitempty"`                                     // Logical id of this artifact
+	Meta                   *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                                 // Metadata about the resource
+	ImplicitRules          *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`              // A set of rules under which this content was created
+	ImplicitRulesElement   *Element             `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`     // Extensions for implicitRules
+	Language               *string              `json:"language,omitempty" bson:"language,omitempty"`                         // Language of the resource content
+	LanguageElement        *Element             `json:"_language,omitempty" bson:"language_element,omitempty"`                // Extensions for language
+	Text                   *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                                 // Text summary of the resource, for human interpretation
+	Contained              []Resource           `json:"contained,omitempty" bson:"contained,omitempty"`                       // Contained, inline Resources
+	Extension              []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored
+	Identifier             []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Business Identifier for item
+	Status                 InvoiceStatus        `json:"status" bson:"status"`                                                 // draft | issued | balanced | cancelled | entered-in-error
+	StatusElement          *Element             `json:"_status,omitempty" bson:"status_element,omitempty"`                    // Extensions for status
+	CancelledReason        *string              `json:"cancelledReason,omitempty" bson:"cancelled_reason,omitempty"`          // Reason for cancellation of this Invoice
+	CancelledReasonElement *Element             `json:"_cancelledReason,omitempty" bson:"cancelled_reason_element,omitempty"` // Extensions for cancelledReason
+	Type                   *CodeableConcept     `json:"type,omitempty" bson:"type,omitempty"`                                 // Type of Invoice
+	Subject                *Reference           `json:"subject,omitempty" bson:"subject,omitempty"`                           // Recipient(s) of goods and services
+	Recipient              *Reference           `json:"recipient,omitempty" bson:"recipient,omitempty"`                       // Recipient of this invoice
+	Creation               *DateTime            `json:"creation,omitempty" bson:"creation,omitempty"`                         // When posted
+	CreationElement        *Element             `json:"_creation,omitempty" bson:"creation_element,omitempty"`                // Extensions for creation
+	Period                 InvoicePeriod        `json:"-" bson:"period,omitempty"`                                            // Billing date or period
+	PeriodElement          *Element             `json:"-" bson:"period_element,omitempty"`                                    // Extensions for period[x]
+	Participant            []InvoiceParticipant `json:"participant,omitempty" bson:"participant,omitempty"`                   // Participant in creation of this Invoice
+	Issuer                 *Reference           `json:"issuer,omitempty" bson:"issuer,omitempty"`                             // Issuing entity
+	Account                *Reference           `json:"account,omitempty" bson:"account,omitempty"`                           // Account that is being balanced
+	LineItem               []InvoiceLineItem    `json:"lineItem,omitempty" bson:"line_item,omitempty"`                        // Line items of this Invoice
+	TotalPriceComponent    []MonetaryComponent  `json:"totalPriceComponent,omitempty" bson:"total_price_component,omitempty"` // Components of Invoice total
+	TotalNet               *Money               `json:"totalNet,omitempty" bson:"total_net,omitempty"`                        // Net total of this Invoice
+	TotalGross             *Money               `json:"totalGross,omitempty" bson:"total_gross,omitempty"`                    // Gross total of this Invoice
+	PaymentTerms           *string              `json:"paymentTerms,omitempty" bson:"payment_terms,omitempty"`                // Payment details
+	PaymentTermsElement    *Element             `json:"_paymentTerms,omitempty" bson:"payment_terms_element,omitempty"`       // Extensions for paymentTerms
+	Note                   []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                                 // Comments made about the invoice
+
+	periodVariants []string // JSON properties of Invoice.period[x] when more than one was decoded
 }
 
+var invoiceSubjectTargets = []string{"Group", "Patient"}
+
+var invoiceRecipientTargets = []string{"Organization", "Patient", "RelatedPerson"}
+
+var invoiceIssuerTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var invoiceAccountTargets = []string{"Account"}
+
 func (r *Invoice) Validate() error {
 	if r.ResourceType != "Invoice" {
 		return fmt.Errorf("invalid resourceType: expected 'Invoice', got '%s'", r.ResourceType)
@@ -43,38 +62,106 @@ func (r *Invoice) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.CancelledReasonElement != nil {
+		if err := r.CancelledReasonElement.Validate(); err != nil {
+			return fmt.Errorf("CancelledReasonElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, invoiceSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, invoiceSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Recipient != nil {
+		if target := invalidReferenceTarget(r.Recipient.Reference, r.Recipient.Type, invoiceRecipientTargets); target != "" {
+			return fmt.Errorf("field 'Recipient' cannot refer to %s, expected one of %v", target, invoiceRecipientTargets)
+		}
+	}
 	if r.Recipient != nil {
 		if err := r.Recipient.Validate(); err != nil {
 			return fmt.Errorf("Recipient: %w", err)
 		}
 	}
-	if r.PeriodPeriod != nil {
-		if err := r.PeriodPeriod.Validate(); err != nil {
-			return fmt.Errorf("PeriodPeriod: %w", err)
+	if r.Creation != nil {
+		if err := r.Creation.Validate(); err != nil {
+			return fmt.Errorf("Creation: %w", err)
+		}
+	}
+	if r.CreationElement != nil {
+		if err := r.CreationElement.Validate(); err != nil {
+			return fmt.Errorf("CreationElement: %w", err)
+		}
+	}
+	if len(r.periodVariants) > 1 {
+		return fmt.Errorf("field 'Period' must have a single type, got %v", r.periodVariants)
+	}
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
+		}
+	}
+	if r.PeriodElement != nil {
+		if err := r.PeriodElement.Validate(); err != nil {
+			return fmt.Errorf("PeriodElement: %w", err)
 		}
 	}
 	for i, item := range r.Participant {
@@ -82,11 +169,21 @@ func (r *Invoice) Validate() error {
 			return fmt.Errorf("Participant[%d]: %w", i, err)
 		}
 	}
+	if r.Issuer != nil {
+		if target := invalidReferenceTarget(r.Issuer.Reference, r.Issuer.Type, invoiceIssuerTargets); target != "" {
+			return fmt.Errorf("field 'Issuer' cannot refer to %s, expected one of %v", target, invoiceIssuerTargets)
+		}
+	}
 	if r.Issuer != nil {
 		if err := r.Issuer.Validate(); err != nil {
 			return fmt.Errorf("Issuer: %w", err)
 		}
 	}
+	if r.Account != nil {
+		if target := invalidReferenceTarget(r.Account.Reference, r.Account.Type, invoiceAccountTargets); target != "" {
+			return fmt.Errorf("field 'Account' cannot refer to %s, expected one of %v", target, invoiceAccountTargets)
+		}
+	}
 	if r.Account != nil {
 		if err := r.Account.Validate(); err != nil {
 			return fmt.Errorf("Account: %w", err)
@@ -112,21 +209,388 @@ func (r *Invoice) Validate() error {
 			return fmt.Errorf("TotalGross: %w", err)
 		}
 	}
+	if r.PaymentTermsElement != nil {
+		if err := r.PaymentTermsElement.Validate(); err != nil {
+			return fmt.Errorf("PaymentTermsElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Invoice")
+}
+
+func (r *Invoice) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Invoice" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Invoice', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.CancelledReasonElement != nil {
+		r.CancelledReasonElement.validateAll(path+".cancelledReason", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, invoiceSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, invoiceSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Recipient != nil {
+		if target := invalidReferenceTarget(r.Recipient.Reference, r.Recipient.Type, invoiceRecipientTargets); target != "" {
+			issues.add("value", path+".recipient", fmt.Sprintf("field 'Recipient' cannot refer to %s, expected one of %v", target, invoiceRecipientTargets))
+		}
+	}
+	if r.Recipient != nil {
+		r.Recipient.validateAll(path+".recipient", issues)
+	}
+	if r.Creation != nil {
+		if err := r.Creation.Validate(); err != nil {
+			issues.add("value", path+".creation", err.Error())
+		}
+	}
+	if r.CreationElement != nil {
+		r.CreationElement.validateAll(path+".creation", issues)
+	}
+	if len(r.periodVariants) > 1 {
+		issues.add("structure", path+".period", fmt.Sprintf("field 'Period' must have a single type, got %v", r.periodVariants))
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period.ofType("+r.Period.FHIRType()+")", issues)
+	}
+	if r.PeriodElement != nil {
+		r.PeriodElement.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	if r.Issuer != nil {
+		if target := invalidReferenceTarget(r.Issuer.Reference, r.Issuer.Type, invoiceIssuerTargets); target != "" {
+			issues.add("value", path+".issuer", fmt.Sprintf("field 'Issuer' cannot refer to %s, expected one of %v", target, invoiceIssuerTargets))
+		}
+	}
+	if r.Issuer != nil {
+		r.Issuer.validateAll(path+".issuer", issues)
+	}
+	if r.Account != nil {
+		if target := invalidReferenceTarget(r.Account.Reference, r.Account.Type, invoiceAccountTargets); target != "" {
+			issues.add("value", path+".account", fmt.Sprintf("field 'Account' cannot refer to %s, expected one of %v", target, invoiceAccountTargets))
+		}
+	}
+	if r.Account != nil {
+		r.Account.validateAll(path+".account", issues)
+	}
+	for i, item := range r.LineItem {
+		item.validateAll(fmt.Sprintf("%s.lineItem[%d]", path, i), issues)
+	}
+	for i, item := range r.TotalPriceComponent {
+		item.validateAll(fmt.Sprintf("%s.totalPriceComponent[%d]", path, i), issues)
+	}
+	if r.TotalNet != nil {
+		r.TotalNet.validateAll(path+".totalNet", issues)
+	}
+	if r.TotalGross != nil {
+		r.TotalGross.validateAll(path+".totalGross", issues)
+	}
+	if r.PaymentTermsElement != nil {
+		r.PaymentTermsElement.validateAll(path+".paymentTerms", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *Invoice) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Invoice", &issues)
+	checkInvariants(r, "Invoice", &issues)
+	return issues
+}
+
+var invoiceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Invoice) invariants() []invariant {
+	return invoiceInvariants
+}
+
+func (r Invoice) MarshalJSON() ([]byte, error) {
+	type alias Invoice
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "period", r.Period, r.PeriodElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Invoice) UnmarshalJSON(data []byte) error {
+	type alias Invoice
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Period, element, r.periodVariants, err = unmarshalChoice(fields, "period", invoicePeriodVariants); err != nil {
+		return err
+	}
+	r.PeriodElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.PeriodElement); err != nil {
+			return fmt.Errorf("_period: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r Invoice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Invoice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Invoice) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Invoice) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Invoice) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Period":
+		return "period", choiceValues(invoicePeriodVariants)
+	}
+	return "", nil
+}
+
+var invoiceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"paymentTerms":  "markdown",
+}
+
+func (r *Invoice) elementTypes() map[string]string {
+	return invoiceElementTypes
+}
+
+var invoiceElementMetadata = []ElementMetadata{
+	{Path: "Invoice.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Invoice.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Invoice.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Invoice.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Invoice.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Invoice.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Invoice.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Invoice.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Invoice.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Invoice.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Invoice.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Invoice.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/invoice-status"}},
+	{Path: "Invoice.cancelledReason", Name: "cancelledReason", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Invoice.type", Name: "type", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Invoice.subject", Name: "subject", Field: 17, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Invoice.recipient", Name: "recipient", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Invoice.creation", Name: "creation", Field: 19, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Invoice.period[x]", Name: "period", Field: 21, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "Invoice.participant", Name: "participant", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Invoice.issuer", Name: "issuer", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "Invoice.account", Name: "account", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account"}},
+	{Path: "Invoice.lineItem", Name: "lineItem", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Invoice.totalPriceComponent", Name: "totalPriceComponent", Field: 27, Min: 0, Max: "*", Types: []string{"MonetaryComponent"}},
+	{Path: "Invoice.totalNet", Name: "totalNet", Field: 28, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Invoice.totalGross", Name: "totalGross", Field: 29, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "Invoice.paymentTerms", Name: "paymentTerms", Field: 30, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Invoice.note", Name: "note", Field: 32, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *Invoice) ElementMetadata() []ElementMetadata {
+	return invoiceElementMetadata
+}
+
+// InvoicePeriod is the type of Invoice.period[x]. It is implemented by the InvoicePeriod* variant types.
+type InvoicePeriod interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isInvoicePeriod()
+}
+
+// InvoicePeriodDate is the date variant of InvoicePeriod.
+type InvoicePeriodDate struct {
+	Date
+}
+
+func (v InvoicePeriodDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v InvoicePeriodDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v InvoicePeriodDate) FHIRType() string {
+	return "date"
+}
+
+func (v InvoicePeriodDate) isInvoicePeriod() {}
+
+// InvoicePeriodPeriod is the Period variant of InvoicePeriod.
+type InvoicePeriodPeriod struct {
+	Period
+}
+
+func (v InvoicePeriodPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v InvoicePeriodPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v InvoicePeriodPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v InvoicePeriodPeriod) isInvoicePeriod() {}
+
+var invoicePeriodVariants = []InvoicePeriod{
+	InvoicePeriodDate{},
+	InvoicePeriodPeriod{},
+}
+
+func (r *Invoice) GetResourceType() string {
+	return "Invoice"
+}
+
+func (r *Invoice) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Invoice) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Invoice) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Invoice) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Invoice) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Invoice) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Invoice) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Invoice)(nil)
+
 type InvoiceParticipant struct {
-	Id    *string          `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Role  *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"` // Type of involvement in creation of this Invoice
-	Actor *Reference       `json:"actor" bson:"actor"`                   // Individual who was involved
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Role              *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // Type of involvement in creation of this Invoice
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Individual who was involved
 }
 
+var invoiceParticipantActorTargets = []string{"Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *InvoiceParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Role != nil {
 		if err := r.Role.Validate(); err != nil {
 			return fmt.Errorf("Role: %w", err)
@@ -135,6 +599,11 @@ func (r *InvoiceParticipant) Validate() error {
 	if r.Actor == nil {
 		return fmt.Errorf("field 'Actor' is required")
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, invoiceParticipantActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, invoiceParticipantActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -143,36 +612,118 @@ func (r *InvoiceParticipant) Validate() error {
 	return nil
 }
 
+func (r *InvoiceParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, invoiceParticipantActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, invoiceParticipantActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var invoiceParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InvoiceParticipant) invariants() []invariant {
+	return invoiceParticipantInvariants
+}
+
+func (r InvoiceParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InvoiceParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InvoiceParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InvoiceParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var invoiceParticipantElementMetadata = []ElementMetadata{
+	{Path: "Invoice.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Invoice.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Invoice.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Invoice.participant.role", Name: "role", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Invoice.participant.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *InvoiceParticipant) ElementMetadata() []ElementMetadata {
+	return invoiceParticipantElementMetadata
+}
+
 type InvoiceLineItem struct {
-	Id                        *string             `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Sequence                  *int                `json:"sequence,omitempty" bson:"sequence,omitempty"`                  // Sequence number of line item
-	ServicedDate              *string             `json:"servicedDate,omitempty" bson:"serviced_date,omitempty"`         // Service data or period
-	ServicedPeriod            *Period             `json:"servicedPeriod,omitempty" bson:"serviced_period,omitempty"`     // Service data or period
-	ChargeItemReference       *Reference          `json:"chargeItemReference" bson:"charge_item_reference"`              // Reference to ChargeItem containing details of this line item or an inline billing code
-	ChargeItemCodeableConcept *CodeableConcept    `json:"chargeItemCodeableConcept" bson:"charge_item_codeable_concept"` // Reference to ChargeItem containing details of this line item or an inline billing code
-	PriceComponent            []MonetaryComponent `json:"priceComponent,omitempty" bson:"price_component,omitempty"`     // Components of total line item price
+	Id                *string                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Sequence          *int                      `json:"sequence,omitempty" bson:"sequence,omitempty"`                    // Sequence number of line item
+	SequenceElement   *Element                  `json:"_sequence,omitempty" bson:"sequence_element,omitempty"`           // Extensions for sequence
+	Serviced          InvoiceLineItemServiced   `json:"-" bson:"serviced,omitempty"`                                     // Service data or period
+	ServicedElement   *Element                  `json:"-" bson:"serviced_element,omitempty"`                             // Extensions for serviced[x]
+	ChargeItem        InvoiceLineItemChargeItem `json:"-" bson:"charge_item,omitempty"`                                  // Reference to ChargeItem containing details of this line item or an inline billing code
+	PriceComponent    []MonetaryComponent       `json:"priceComponent,omitempty" bson:"price_component,omitempty"`       // Components of total line item price
+
+	servicedVariants   []string // JSON properties of Invoice.lineItem.serviced[x] when more than one was decoded
+	chargeItemVariants []string // JSON properties of Invoice.lineItem.chargeItem[x] when more than one was decoded
 }
 
 func (r *InvoiceLineItem) Validate() error {
-	if r.ServicedPeriod != nil {
-		if err := r.ServicedPeriod.Validate(); err != nil {
-			return fmt.Errorf("ServicedPeriod: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ChargeItemReference == nil {
-		return fmt.Errorf("field 'ChargeItemReference' is required")
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SequenceElement != nil {
+		if err := r.SequenceElement.Validate(); err != nil {
+			return fmt.Errorf("SequenceElement: %w", err)
+		}
+	}
+	if len(r.servicedVariants) > 1 {
+		return fmt.Errorf("field 'Serviced' must have a single type, got %v", r.servicedVariants)
+	}
+	if r.Serviced != nil {
+		if err := r.Serviced.Validate(); err != nil {
+			return fmt.Errorf("Serviced: %w", err)
+		}
 	}
-	if r.ChargeItemReference != nil {
-		if err := r.ChargeItemReference.Validate(); err != nil {
-			return fmt.Errorf("ChargeItemReference: %w", err)
+	if r.ServicedElement != nil {
+		if err := r.ServicedElement.Validate(); err != nil {
+			return fmt.Errorf("ServicedElement: %w", err)
 		}
 	}
-	if r.ChargeItemCodeableConcept == nil {
-		return fmt.Errorf("field 'ChargeItemCodeableConcept' is required")
+	if len(r.chargeItemVariants) > 1 {
+		return fmt.Errorf("field 'ChargeItem' must have a single type, got %v", r.chargeItemVariants)
+	}
+	if r.ChargeItem == nil {
+		return fmt.Errorf("field 'ChargeItem' is required")
 	}
-	if r.ChargeItemCodeableConcept != nil {
-		if err := r.ChargeItemCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ChargeItemCodeableConcept: %w", err)
+	if r.ChargeItem != nil {
+		if err := r.ChargeItem.Validate(); err != nil {
+			return fmt.Errorf("ChargeItem: %w", err)
 		}
 	}
 	for i, item := range r.PriceComponent {
@@ -182,3 +733,243 @@ func (r *InvoiceLineItem) Validate() error {
 	}
 	return nil
 }
+
+func (r *InvoiceLineItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SequenceElement != nil {
+		r.SequenceElement.validateAll(path+".sequence", issues)
+	}
+	if len(r.servicedVariants) > 1 {
+		issues.add("structure", path+".serviced", fmt.Sprintf("field 'Serviced' must have a single type, got %v", r.servicedVariants))
+	}
+	if r.Serviced != nil {
+		r.Serviced.validateAll(path+".serviced.ofType("+r.Serviced.FHIRType()+")", issues)
+	}
+	if r.ServicedElement != nil {
+		r.ServicedElement.validateAll(path+".serviced", issues)
+	}
+	if len(r.chargeItemVariants) > 1 {
+		issues.add("structure", path+".chargeItem", fmt.Sprintf("field 'ChargeItem' must have a single type, got %v", r.chargeItemVariants))
+	}
+	if r.ChargeItem == nil {
+		issues.add("required", path+".chargeItem", "field 'ChargeItem' is required")
+	}
+	if r.ChargeItem != nil {
+		r.ChargeItem.validateAll(path+".chargeItem.ofType("+r.ChargeItem.FHIRType()+")", issues)
+	}
+	for i, item := range r.PriceComponent {
+		item.validateAll(fmt.Sprintf("%s.priceComponent[%d]", path, i), issues)
+	}
+}
+
+var invoiceLineItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *InvoiceLineItem) invariants() []invariant {
+	return invoiceLineItemInvariants
+}
+
+func (r InvoiceLineItem) MarshalJSON() ([]byte, error) {
+	type alias InvoiceLineItem
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "serviced", r.Serviced, r.ServicedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "chargeItem", r.ChargeItem, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *InvoiceLineItem) UnmarshalJSON(data []byte) error {
+	type alias InvoiceLineItem
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Serviced, element, r.servicedVariants, err = unmarshalChoice(fields, "serviced", invoiceLineItemServicedVariants); err != nil {
+		return err
+	}
+	r.ServicedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ServicedElement); err != nil {
+			return fmt.Errorf("_serviced: %w", err)
+		}
+	}
+	if r.ChargeItem, _, r.chargeItemVariants, err = unmarshalChoice(fields, "chargeItem", invoiceLineItemChargeItemVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r InvoiceLineItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *InvoiceLineItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r InvoiceLineItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *InvoiceLineItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *InvoiceLineItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Serviced":
+		return "serviced", choiceValues(invoiceLineItemServicedVariants)
+	case "ChargeItem":
+		return "chargeItem", choiceValues(invoiceLineItemChargeItemVariants)
+	}
+	return "", nil
+}
+
+var invoiceLineItemElementTypes = map[string]string{
+	"sequence": "positiveInt",
+}
+
+func (r *InvoiceLineItem) elementTypes() map[string]string {
+	return invoiceLineItemElementTypes
+}
+
+var invoiceLineItemElementMetadata = []ElementMetadata{
+	{Path: "Invoice.lineItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Invoice.lineItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Invoice.lineItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Invoice.lineItem.sequence", Name: "sequence", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Invoice.lineItem.serviced[x]", Name: "serviced", Field: 5, Min: 0, Max: "1", Types: []string{"date", "Period"}},
+	{Path: "Invoice.lineItem.chargeItem[x]", Name: "chargeItem", Field: 7, Min: 1, Max: "1", Types: []string{"Reference", "CodeableConcept"}},
+	{Path: "Invoice.lineItem.priceComponent", Name: "priceComponent", Field: 8, Min: 0, Max: "*", Types: []string{"MonetaryComponent"}},
+}
+
+func (r *InvoiceLineItem) ElementMetadata() []ElementMetadata {
+	return invoiceLineItemElementMetadata
+}
+
+// InvoiceLineItemServiced is the type of Invoice.lineItem.serviced[x]. It is implemented by the InvoiceLineItemServiced* variant types.
+type InvoiceLineItemServiced interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isInvoiceLineItemServiced()
+}
+
+// InvoiceLineItemServicedDate is the date variant of InvoiceLineItemServiced.
+type InvoiceLineItemServicedDate struct {
+	Date
+}
+
+func (v InvoiceLineItemServicedDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v InvoiceLineItemServicedDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v InvoiceLineItemServicedDate) FHIRType() string {
+	return "date"
+}
+
+func (v InvoiceLineItemServicedDate) isInvoiceLineItemServiced() {}
+
+// InvoiceLineItemServicedPeriod is the Period variant of InvoiceLineItemServiced.
+type InvoiceLineItemServicedPeriod struct {
+	Period
+}
+
+func (v InvoiceLineItemServicedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v InvoiceLineItemServicedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v InvoiceLineItemServicedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v InvoiceLineItemServicedPeriod) isInvoiceLineItemServiced() {}
+
+var invoiceLineItemServicedVariants = []InvoiceLineItemServiced{
+	InvoiceLineItemServicedDate{},
+	InvoiceLineItemServicedPeriod{},
+}
+
+// InvoiceLineItemChargeItem is the type of Invoice.lineItem.chargeItem[x]. It is implemented by the InvoiceLineItemChargeItem* variant types.
+type InvoiceLineItemChargeItem interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isInvoiceLineItemChargeItem()
+}
+
+// InvoiceLineItemChargeItemReference is the Reference variant of InvoiceLineItemChargeItem.
+type InvoiceLineItemChargeItemReference struct {
+	Reference
+}
+
+func (v InvoiceLineItemChargeItemReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v InvoiceLineItemChargeItemReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v InvoiceLineItemChargeItemReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v InvoiceLineItemChargeItemReference) isInvoiceLineItemChargeItem() {}
+
+// InvoiceLineItemChargeItemCodeableConcept is the CodeableConcept variant of InvoiceLineItemChargeItem.
+type InvoiceLineItemChargeItemCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v InvoiceLineItemChargeItemCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v InvoiceLineItemChargeItemCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v InvoiceLineItemChargeItemCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v InvoiceLineItemChargeItemCodeableConcept) isInvoiceLineItemChargeItem() {}
+
+var invoiceLineItemChargeItemVariants = []InvoiceLineItemChargeItem{
+	InvoiceLineItemChargeItemReference{},
+	InvoiceLineItemChargeItemCodeableConcept{},
+}
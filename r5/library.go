@@ -2,53 +2,76 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The Library resource is a general-purpose container for knowledge artifact definitions. It can be used to describe and expose existing knowledge artifacts such as logic libraries and information model descriptions, as well as to describe a collection of knowledge artifacts.
 type Library struct {
-	ResourceType           string                `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string               `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string               `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string               `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this library, represented as a URI (globally unique)
-	Identifier             []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the library
-	Version                *string               `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the library
-	VersionAlgorithmString *string               `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding               `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string               `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this library (computer friendly)
-	Title                  *string               `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this library (human friendly)
-	Subtitle               *string               `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                               // Subordinate title of the library
-	Status                 string                `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                 `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Type                   *CodeableConcept      `json:"type" bson:"type"`                                                           // logic-library | model-definition | asset-collection | module-definition | etc.
-	SubjectCodeableConcept *CodeableConcept      `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"` // Type of individual the library content is focused on
-	SubjectReference       *Reference            `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`              // Type of individual the library content is focused on
-	Date                   *string               `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string               `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail       `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string               `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the library
-	UseContext             []UsageContext        `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept     `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the library (if applicable)
-	Purpose                *string               `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this library is defined
-	Usage                  *string               `json:"usage,omitempty" bson:"usage,omitempty"`                                     // Describes the clinical usage of the library
-	Copyright              *string               `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string               `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string               `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the library was approved by publisher
-	LastReviewDate         *string               `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the library was last reviewed by the publisher
-	EffectivePeriod        *Period               `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the library is expected to be used
-	Topic                  []CodeableConcept     `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail       `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the content
-	Editor                 []ContactDetail       `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the content
-	Reviewer               []ContactDetail       `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the content
-	Endorser               []ContactDetail       `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the content
-	RelatedArtifact        []RelatedArtifact     `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
-	Parameter              []ParameterDefinition `json:"parameter,omitempty" bson:"parameter,omitempty"`                             // Parameters defined by the library
-	DataRequirement        []DataRequirement     `json:"dataRequirement,omitempty" bson:"data_requirement,omitempty"`                // What data is referenced by this library
-	Content                []Attachment          `json:"content,omitempty" bson:"content,omitempty"`                                 // Contents of the library, either embedded or referenced
+	ResourceType            string                  `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                 `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                 `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource              `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                 `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this library, represented as a URI (globally unique)
+	UrlElement              *Element                `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the library
+	Version                 *string                 `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the library
+	VersionElement          *Element                `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        LibraryVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                 `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this library (computer friendly)
+	NameElement             *Element                `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                 `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this library (human friendly)
+	TitleElement            *Element                `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Subtitle                *string                 `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                        // Subordinate title of the library
+	SubtitleElement         *Element                `json:"_subtitle,omitempty" bson:"subtitle_element,omitempty"`               // Extensions for subtitle
+	Status                  PublicationStatus       `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                   `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Type                    *CodeableConcept        `json:"type" bson:"type"`                                                    // logic-library | model-definition | asset-collection | module-definition | etc.
+	Subject                 LibrarySubject          `json:"-" bson:"subject,omitempty"`                                          // Type of individual the library content is focused on
+	Date                    *DateTime               `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                 `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail         `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                 `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the library
+	DescriptionElement      *Element                `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext          `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept       `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the library (if applicable)
+	Purpose                 *string                 `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this library is defined
+	PurposeElement          *Element                `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Usage                   *string                 `json:"usage,omitempty" bson:"usage,omitempty"`                              // Describes the clinical usage of the library
+	UsageElement            *Element                `json:"_usage,omitempty" bson:"usage_element,omitempty"`                     // Extensions for usage
+	Copyright               *string                 `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                 `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                   `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the library was approved by publisher
+	ApprovalDateElement     *Element                `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                   `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the library was last reviewed by the publisher
+	LastReviewDateElement   *Element                `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                 `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the library is expected to be used
+	Topic                   []CodeableConcept       `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail         `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the content
+	Editor                  []ContactDetail         `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the content
+	Reviewer                []ContactDetail         `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the content
+	Endorser                []ContactDetail         `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the content
+	RelatedArtifact         []RelatedArtifact       `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations, etc
+	Parameter               []ParameterDefinition   `json:"parameter,omitempty" bson:"parameter,omitempty"`                      // Parameters defined by the library
+	DataRequirement         []DataRequirement       `json:"dataRequirement,omitempty" bson:"data_requirement,omitempty"`         // What data is referenced by this library
+	Content                 []Attachment            `json:"content,omitempty" bson:"content,omitempty"`                          // Contents of the library, either embedded or referenced
+
+	versionAlgorithmVariants []string // JSON properties of Library.versionAlgorithm[x] when more than one was decoded
+	subjectVariants          []string // JSON properties of Library.subject[x] when more than one was decoded
 }
 
 func (r *Library) Validate() error {
@@ -60,25 +83,98 @@ func (r *Library) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.SubtitleElement != nil {
+		if err := r.SubtitleElement.Validate(); err != nil {
+			return fmt.Errorf("SubtitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -87,14 +183,27 @@ func (r *Library) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -102,6 +211,11 @@ func (r *Library) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -112,6 +226,46 @@ func (r *Library) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -162,5 +316,470 @@ func (r *Library) Validate() error {
 			return fmt.Errorf("Content[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Library")
+}
+
+func (r *Library) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Library" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Library', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.SubtitleElement != nil {
+		r.SubtitleElement.validateAll(path+".subtitle", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	for i, item := range r.DataRequirement {
+		item.validateAll(fmt.Sprintf("%s.dataRequirement[%d]", path, i), issues)
+	}
+	for i, item := range r.Content {
+		item.validateAll(fmt.Sprintf("%s.content[%d]", path, i), issues)
+	}
+}
+
+func (r *Library) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Library", &issues)
+	checkInvariants(r, "Library", &issues)
+	return issues
+}
+
+var libraryInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Library) invariants() []invariant {
+	return libraryInvariants
+}
+
+func (r Library) MarshalJSON() ([]byte, error) {
+	type alias Library
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Library) UnmarshalJSON(data []byte) error {
+	type alias Library
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", libraryVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.Subject, _, r.subjectVariants, err = unmarshalChoice(fields, "subject", librarySubjectVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r Library) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Library) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Library) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Library) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Library) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(libraryVersionAlgorithmVariants)
+	case "Subject":
+		return "subject", choiceValues(librarySubjectVariants)
+	}
+	return "", nil
+}
+
+var libraryElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"usage":         "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *Library) elementTypes() map[string]string {
+	return libraryElementTypes
+}
+
+var libraryElementMetadata = []ElementMetadata{
+	{Path: "Library.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Library.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Library.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Library.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Library.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Library.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Library.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Library.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Library.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Library.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Library.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Library.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Library.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "Library.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Library.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Library.subtitle", Name: "subtitle", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Library.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Library.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Library.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Library.type", Name: "type", Field: 28, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Library.subject[x]", Name: "subject", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Library.date", Name: "date", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Library.publisher", Name: "publisher", Field: 32, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Library.contact", Name: "contact", Field: 34, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Library.description", Name: "description", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Library.useContext", Name: "useContext", Field: 37, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Library.jurisdiction", Name: "jurisdiction", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Library.purpose", Name: "purpose", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Library.usage", Name: "usage", Field: 41, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Library.copyright", Name: "copyright", Field: 43, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Library.copyrightLabel", Name: "copyrightLabel", Field: 45, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Library.approvalDate", Name: "approvalDate", Field: 47, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Library.lastReviewDate", Name: "lastReviewDate", Field: 49, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Library.effectivePeriod", Name: "effectivePeriod", Field: 51, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Library.topic", Name: "topic", Field: 52, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Library.author", Name: "author", Field: 53, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Library.editor", Name: "editor", Field: 54, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Library.reviewer", Name: "reviewer", Field: 55, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Library.endorser", Name: "endorser", Field: 56, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Library.relatedArtifact", Name: "relatedArtifact", Field: 57, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "Library.parameter", Name: "parameter", Field: 58, Min: 0, Max: "*", Types: []string{"ParameterDefinition"}},
+	{Path: "Library.dataRequirement", Name: "dataRequirement", Field: 59, Min: 0, Max: "*", Types: []string{"DataRequirement"}},
+	{Path: "Library.content", Name: "content", Field: 60, Min: 0, Max: "*", Types: []string{"Attachment"}},
+}
+
+func (r *Library) ElementMetadata() []ElementMetadata {
+	return libraryElementMetadata
+}
+
+// LibraryVersionAlgorithm is the type of Library.versionAlgorithm[x]. It is implemented by the LibraryVersionAlgorithm* variant types.
+type LibraryVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isLibraryVersionAlgorithm()
+}
+
+// LibraryVersionAlgorithmString is the string variant of LibraryVersionAlgorithm.
+type LibraryVersionAlgorithmString string
+
+func (v LibraryVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v LibraryVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v LibraryVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v LibraryVersionAlgorithmString) isLibraryVersionAlgorithm() {}
+
+// LibraryVersionAlgorithmCoding is the Coding variant of LibraryVersionAlgorithm.
+type LibraryVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v LibraryVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v LibraryVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v LibraryVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v LibraryVersionAlgorithmCoding) isLibraryVersionAlgorithm() {}
+
+var libraryVersionAlgorithmVariants = []LibraryVersionAlgorithm{
+	LibraryVersionAlgorithmString(""),
+	LibraryVersionAlgorithmCoding{},
+}
+
+// LibrarySubject is the type of Library.subject[x]. It is implemented by the LibrarySubject* variant types.
+type LibrarySubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isLibrarySubject()
+}
+
+// LibrarySubjectCodeableConcept is the CodeableConcept variant of LibrarySubject.
+type LibrarySubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v LibrarySubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v LibrarySubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v LibrarySubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v LibrarySubjectCodeableConcept) isLibrarySubject() {}
+
+// LibrarySubjectReference is the Reference variant of LibrarySubject.
+type LibrarySubjectReference struct {
+	Reference
+}
+
+func (v LibrarySubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v LibrarySubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v LibrarySubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v LibrarySubjectReference) isLibrarySubject() {}
+
+var librarySubjectVariants = []LibrarySubject{
+	LibrarySubjectCodeableConcept{},
+	LibrarySubjectReference{},
+}
+
+func (r *Library) GetResourceType() string {
+	return "Library"
+}
+
+func (r *Library) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Library) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Library) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Library) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Library) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Library) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Library) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Library)(nil)
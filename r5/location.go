@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,17 +12,26 @@ type Location struct {
 	Id                   *string                 `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
 	Meta                 *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
 	ImplicitRules        *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
+	ImplicitRulesElement *Element                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`      // Extensions for implicitRules
 	Language             *string                 `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
+	LanguageElement      *Element                `json:"_language,omitempty" bson:"language_element,omitempty"`                 // Extensions for language
 	Text                 *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Contained            []Resource              `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Extension            []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored
 	Identifier           []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // Unique code or number identifying the location to its users
-	Status               *string                 `json:"status,omitempty" bson:"status,omitempty"`                              // active | suspended | inactive
+	Status               *LocationStatus         `json:"status,omitempty" bson:"status,omitempty"`                              // active | suspended | inactive
+	StatusElement        *Element                `json:"_status,omitempty" bson:"status_element,omitempty"`                     // Extensions for status
 	OperationalStatus    *Coding                 `json:"operationalStatus,omitempty" bson:"operational_status,omitempty"`       // The operational status of the location (typically only for a bed/room)
 	Code                 []CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                                  // Codes that identify this location
 	Name                 *string                 `json:"name,omitempty" bson:"name,omitempty"`                                  // Name of the location as used by humans
+	NameElement          *Element                `json:"_name,omitempty" bson:"name_element,omitempty"`                         // Extensions for name
 	Alias                []string                `json:"alias,omitempty" bson:"alias,omitempty"`                                // A list of alternate names that the location is known as, or was known as, in the past
+	AliasElement         []*Element              `json:"_alias,omitempty" bson:"alias_element,omitempty"`                       // Extensions for alias
 	Description          *string                 `json:"description,omitempty" bson:"description,omitempty"`                    // Additional details about the location that could be displayed as further information to identify the location beyond its name
-	Mode                 *string                 `json:"mode,omitempty" bson:"mode,omitempty"`                                  // instance | kind
+	DescriptionElement   *Element                `json:"_description,omitempty" bson:"description_element,omitempty"`           // Extensions for description
+	Mode                 *LocationMode           `json:"mode,omitempty" bson:"mode,omitempty"`                                  // instance | kind
+	ModeElement          *Element                `json:"_mode,omitempty" bson:"mode_element,omitempty"`                         // Extensions for mode
 	Type                 []CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                                  // Types of services available at this location
 	Contact              []ExtendedContactDetail `json:"contact,omitempty" bson:"contact,omitempty"`                            // Official contact details for the location
 	Address              *Address                `json:"address,omitempty" bson:"address,omitempty"`                            // Physical location
@@ -35,6 +45,12 @@ type Location struct {
 	Endpoint             []Reference             `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                          // Technical endpoints providing access to services operated for the location
 }
 
+var locationManagingOrganizationTargets = []string{"Organization"}
+
+var locationPartOfTargets = []string{"Location"}
+
+var locationEndpointTargets = []string{"Endpoint"}
+
 func (r *Location) Validate() error {
 	if r.ResourceType != "Location" {
 		return fmt.Errorf("invalid resourceType: expected 'Location', got '%s'", r.ResourceType)
@@ -44,16 +60,52 @@ func (r *Location) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.OperationalStatus != nil {
 		if err := r.OperationalStatus.Validate(); err != nil {
 			return fmt.Errorf("OperationalStatus: %w", err)
@@ -64,6 +116,32 @@ func (r *Location) Validate() error {
 			return fmt.Errorf("Code[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AliasElement[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Mode != nil && !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", *r.Mode)
+	}
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
@@ -89,11 +167,21 @@ func (r *Location) Validate() error {
 			return fmt.Errorf("Position: %w", err)
 		}
 	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, locationManagingOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, locationManagingOrganizationTargets)
+		}
+	}
 	if r.ManagingOrganization != nil {
 		if err := r.ManagingOrganization.Validate(); err != nil {
 			return fmt.Errorf("ManagingOrganization: %w", err)
 		}
 	}
+	if r.PartOf != nil {
+		if target := invalidReferenceTarget(r.PartOf.Reference, r.PartOf.Type, locationPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf' cannot refer to %s, expected one of %v", target, locationPartOfTargets)
+		}
+	}
 	if r.PartOf != nil {
 		if err := r.PartOf.Validate(); err != nil {
 			return fmt.Errorf("PartOf: %w", err)
@@ -114,27 +202,421 @@ func (r *Location) Validate() error {
 			return fmt.Errorf("VirtualService[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, locationEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, locationEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Location")
+}
+
+func (r *Location) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Location" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Location', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.OperationalStatus != nil {
+		r.OperationalStatus.validateAll(path+".operationalStatus", issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.alias[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Mode != nil && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", *r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.Address != nil {
+		r.Address.validateAll(path+".address", issues)
+	}
+	if r.Form != nil {
+		r.Form.validateAll(path+".form", issues)
+	}
+	if r.Position != nil {
+		r.Position.validateAll(path+".position", issues)
+	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, locationManagingOrganizationTargets); target != "" {
+			issues.add("value", path+".managingOrganization", fmt.Sprintf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, locationManagingOrganizationTargets))
+		}
+	}
+	if r.ManagingOrganization != nil {
+		r.ManagingOrganization.validateAll(path+".managingOrganization", issues)
+	}
+	if r.PartOf != nil {
+		if target := invalidReferenceTarget(r.PartOf.Reference, r.PartOf.Type, locationPartOfTargets); target != "" {
+			issues.add("value", path+".partOf", fmt.Sprintf("field 'PartOf' cannot refer to %s, expected one of %v", target, locationPartOfTargets))
+		}
+	}
+	if r.PartOf != nil {
+		r.PartOf.validateAll(path+".partOf", issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
+	if r.HoursOfOperation != nil {
+		r.HoursOfOperation.validateAll(path+".hoursOfOperation", issues)
+	}
+	for i, item := range r.VirtualService {
+		item.validateAll(fmt.Sprintf("%s.virtualService[%d]", path, i), issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, locationEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, locationEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+}
+
+func (r *Location) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Location", &issues)
+	checkInvariants(r, "Location", &issues)
+	return issues
+}
+
+var locationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Location) invariants() []invariant {
+	return locationInvariants
+}
+
+func (r Location) MarshalJSON() ([]byte, error) {
+	type alias Location
+	out := struct {
+		alias
+		Alias        []*string  `json:"alias,omitempty"`
+		AliasElement []*Element `json:"_alias,omitempty"`
+	}{alias: alias(r)}
+	out.Alias, out.AliasElement = alignPrimitiveArray(r.Alias, r.AliasElement)
+	return json.Marshal(out)
+}
+
+func (r *Location) UnmarshalJSON(data []byte) error {
+	type alias Location
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Alias     []*string         `json:"alias,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Alias, err = splitPrimitiveArray(aux.Alias, r.AliasElement); err != nil {
+		return fmt.Errorf("alias: %w", err)
+	}
 	return nil
 }
 
+func (r Location) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Location) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Location) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Location) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var locationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *Location) elementTypes() map[string]string {
+	return locationElementTypes
+}
+
+var locationElementMetadata = []ElementMetadata{
+	{Path: "Location.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Location.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Location.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Location.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Location.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Location.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Location.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Location.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Location.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Location.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Location.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Location.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/location-status"}},
+	{Path: "Location.operationalStatus", Name: "operationalStatus", Field: 14, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "Location.code", Name: "code", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Location.name", Name: "name", Field: 16, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Location.alias", Name: "alias", Field: 18, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Location.description", Name: "description", Field: 20, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Location.mode", Name: "mode", Field: 22, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Location.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/location-mode"}},
+	{Path: "Location.type", Name: "type", Field: 24, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Location.contact", Name: "contact", Field: 25, Min: 0, Max: "*", Types: []string{"ExtendedContactDetail"}},
+	{Path: "Location.address", Name: "address", Field: 26, Min: 0, Max: "1", Types: []string{"Address"}},
+	{Path: "Location.form", Name: "form", Field: 27, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Location.position", Name: "position", Field: 28, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Location.managingOrganization", Name: "managingOrganization", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Location.partOf", Name: "partOf", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Location.characteristic", Name: "characteristic", Field: 31, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Location.hoursOfOperation", Name: "hoursOfOperation", Field: 32, Min: 0, Max: "1", Types: []string{"Availability"}},
+	{Path: "Location.virtualService", Name: "virtualService", Field: 33, Min: 0, Max: "*", Types: []string{"VirtualServiceDetail"}},
+	{Path: "Location.endpoint", Name: "endpoint", Field: 34, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+}
+
+func (r *Location) ElementMetadata() []ElementMetadata {
+	return locationElementMetadata
+}
+
+func (r *Location) GetResourceType() string {
+	return "Location"
+}
+
+func (r *Location) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Location) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Location) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Location) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Location) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Location) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Location) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Location)(nil)
+
 type LocationPosition struct {
-	Id        *string  `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Longitude float64  `json:"longitude" bson:"longitude"`                   // Longitude with WGS84 datum
-	Latitude  float64  `json:"latitude" bson:"latitude"`                     // Latitude with WGS84 datum
-	Altitude  *float64 `json:"altitude,omitempty" bson:"altitude,omitempty"` // Altitude with WGS84 datum
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Longitude         *Decimal    `json:"longitude" bson:"longitude"`                                      // Longitude with WGS84 datum
+	LongitudeElement  *Element    `json:"_longitude,omitempty" bson:"longitude_element,omitempty"`         // Extensions for longitude
+	Latitude          *Decimal    `json:"latitude" bson:"latitude"`                                        // Latitude with WGS84 datum
+	LatitudeElement   *Element    `json:"_latitude,omitempty" bson:"latitude_element,omitempty"`           // Extensions for latitude
+	Altitude          *Decimal    `json:"altitude,omitempty" bson:"altitude,omitempty"`                    // Altitude with WGS84 datum
+	AltitudeElement   *Element    `json:"_altitude,omitempty" bson:"altitude_element,omitempty"`           // Extensions for altitude
 }
 
 func (r *LocationPosition) Validate() error {
-	if r.Longitude == 0 {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Longitude == nil {
 		return fmt.Errorf("field 'Longitude' is required")
 	}
-	if r.Latitude == 0 {
+	if r.Longitude != nil {
+		if err := r.Longitude.Validate(); err != nil {
+			return fmt.Errorf("Longitude: %w", err)
+		}
+	}
+	if r.LongitudeElement != nil {
+		if err := r.LongitudeElement.Validate(); err != nil {
+			return fmt.Errorf("LongitudeElement: %w", err)
+		}
+	}
+	if r.Latitude == nil {
 		return fmt.Errorf("field 'Latitude' is required")
 	}
+	if r.Latitude != nil {
+		if err := r.Latitude.Validate(); err != nil {
+			return fmt.Errorf("Latitude: %w", err)
+		}
+	}
+	if r.LatitudeElement != nil {
+		if err := r.LatitudeElement.Validate(); err != nil {
+			return fmt.Errorf("LatitudeElement: %w", err)
+		}
+	}
+	if r.Altitude != nil {
+		if err := r.Altitude.Validate(); err != nil {
+			return fmt.Errorf("Altitude: %w", err)
+		}
+	}
+	if r.AltitudeElement != nil {
+		if err := r.AltitudeElement.Validate(); err != nil {
+			return fmt.Errorf("AltitudeElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *LocationPosition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Longitude == nil {
+		issues.add("required", path+".longitude", "field 'Longitude' is required")
+	}
+	if r.Longitude != nil {
+		if err := r.Longitude.Validate(); err != nil {
+			issues.add("value", path+".longitude", err.Error())
+		}
+	}
+	if r.LongitudeElement != nil {
+		r.LongitudeElement.validateAll(path+".longitude", issues)
+	}
+	if r.Latitude == nil {
+		issues.add("required", path+".latitude", "field 'Latitude' is required")
+	}
+	if r.Latitude != nil {
+		if err := r.Latitude.Validate(); err != nil {
+			issues.add("value", path+".latitude", err.Error())
+		}
+	}
+	if r.LatitudeElement != nil {
+		r.LatitudeElement.validateAll(path+".latitude", issues)
+	}
+	if r.Altitude != nil {
+		if err := r.Altitude.Validate(); err != nil {
+			issues.add("value", path+".altitude", err.Error())
+		}
+	}
+	if r.AltitudeElement != nil {
+		r.AltitudeElement.validateAll(path+".altitude", issues)
+	}
+}
+
+var locationPositionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *LocationPosition) invariants() []invariant {
+	return locationPositionInvariants
+}
+
+func (r LocationPosition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *LocationPosition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r LocationPosition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *LocationPosition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var locationPositionElementMetadata = []ElementMetadata{
+	{Path: "Location.position.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Location.position.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Location.position.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Location.position.longitude", Name: "longitude", Field: 3, Min: 1, Max: "1", Types: []string{"decimal"}},
+	{Path: "Location.position.latitude", Name: "latitude", Field: 5, Min: 1, Max: "1", Types: []string{"decimal"}},
+	{Path: "Location.position.altitude", Name: "altitude", Field: 7, Min: 0, Max: "1", Types: []string{"decimal"}},
+}
+
+func (r *LocationPosition) ElementMetadata() []ElementMetadata {
+	return locationPositionElementMetadata
+}
@@ -1,9 +1,545 @@
 package models
 
-// Given a code/system, or a Coding, get additional details about the concept, including definition, status, designations, and properties. One of the products of this operation is a full decomposition of a code from a structured terminology.  When invoking this operation, a client SHALL provide both a system and a code, either using the system+code parameters, or in the coding parameter. Other parameters are optional
-type Lookup struct {
+import "fmt"
+
+// LookupIn holds the input parameters of the $lookup operation. Given a code/system, or a Coding, get additional details about the concept, including definition, status, designations, and properties. One of the products of this operation is a full decomposition of a code from a structured terminology.  When invoking this operation, a client SHALL provide both a system and a code, either using the system+code parameters, or in the coding parameter. Other parameters are optional
+type LookupIn struct {
+	// The code that is to be located
+	Code *string
+	// The system for the code that is to be located
+	System *string
+	// The version of the system, if one was provided in the source data
+	Version *string
+	// A coding to look up
+	Coding *Coding
+	// The date for which the information should be returned
+	Date *DateTime
+	// The requested language for display
+	DisplayLanguage *string
+	// A property that the client wishes to be returned in the output
+	Property []string
+	// The supplement must be used when performing the look up
+	UseSupplement []string
+}
+
+func (p *LookupIn) Validate() error {
+	return nil
+}
+
+func (p *LookupIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *LookupIn) FromParameters(params *Parameters) error {
+	*p = LookupIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *LookupIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Code != nil {
+		params = append(params, ParametersParameter{Name: "code", Value: ParametersParameterValueCode(*p.Code)})
+	}
+	if p.System != nil {
+		params = append(params, ParametersParameter{Name: "system", Value: ParametersParameterValueUri(*p.System)})
+	}
+	if p.Version != nil {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueString(*p.Version)})
+	}
+	if p.Coding != nil {
+		params = append(params, ParametersParameter{Name: "coding", Value: ParametersParameterValueCoding{Coding: *p.Coding}})
+	}
+	if p.Date != nil {
+		params = append(params, ParametersParameter{Name: "date", Value: ParametersParameterValueDateTime{DateTime: *p.Date}})
+	}
+	if p.DisplayLanguage != nil {
+		params = append(params, ParametersParameter{Name: "displayLanguage", Value: ParametersParameterValueCode(*p.DisplayLanguage)})
+	}
+	for _, v := range p.Property {
+		params = append(params, ParametersParameter{Name: "property", Value: ParametersParameterValueCode(v)})
+	}
+	for _, v := range p.UseSupplement {
+		params = append(params, ParametersParameter{Name: "useSupplement", Value: ParametersParameterValueCanonical(v)})
+	}
+	return params
+}
+
+func (p *LookupIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "code":
+			if p.Code != nil {
+				return fmt.Errorf("parameter 'code' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Code = &value
+		case "system":
+			if p.System != nil {
+				return fmt.Errorf("parameter 'system' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.System = &value
+		case "version":
+			if p.Version != nil {
+				return fmt.Errorf("parameter 'version' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Version = &value
+		case "coding":
+			if p.Coding != nil {
+				return fmt.Errorf("parameter 'coding' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Coding = &v.Coding
+		case "date":
+			if p.Date != nil {
+				return fmt.Errorf("parameter 'date' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueDateTime](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Date = &v.DateTime
+		case "displayLanguage":
+			if p.DisplayLanguage != nil {
+				return fmt.Errorf("parameter 'displayLanguage' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.DisplayLanguage = &value
+		case "property":
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Property = append(p.Property, string(v))
+		case "useSupplement":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.UseSupplement = append(p.UseSupplement, string(v))
+		}
+	}
+	return nil
+}
+
+// LookupOut holds the output parameters of the $lookup operation.
+type LookupOut struct {
+	// A display name for the code system
+	Name *string
+	// The version that these details are based on
+	Version *string
+	// The preferred display for this concept
+	Display *string
+	// A statement of the meaning of the concept from the code system
+	Definition *string
+	// Additional representations for this concept
+	Designation []LookupOutDesignation
+	// One or more properties that contain additional information about the code
+	Property []LookupOutProperty
+}
+
+func (p *LookupOut) Validate() error {
+	if p.Name == nil {
+		return fmt.Errorf("parameter 'name' is required")
+	}
+	if p.Display == nil {
+		return fmt.Errorf("parameter 'display' is required")
+	}
+	for i := range p.Designation {
+		if err := p.Designation[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'designation'[%d]: %w", i, err)
+		}
+	}
+	for i := range p.Property {
+		if err := p.Property[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'property'[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *LookupOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *LookupOut) FromParameters(params *Parameters) error {
+	*p = LookupOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *LookupOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Name != nil {
+		params = append(params, ParametersParameter{Name: "name", Value: ParametersParameterValueString(*p.Name)})
+	}
+	if p.Version != nil {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueString(*p.Version)})
+	}
+	if p.Display != nil {
+		params = append(params, ParametersParameter{Name: "display", Value: ParametersParameterValueString(*p.Display)})
+	}
+	if p.Definition != nil {
+		params = append(params, ParametersParameter{Name: "definition", Value: ParametersParameterValueString(*p.Definition)})
+	}
+	for _, v := range p.Designation {
+		params = append(params, ParametersParameter{Name: "designation", Part: v.parameters()})
+	}
+	for _, v := range p.Property {
+		params = append(params, ParametersParameter{Name: "property", Part: v.parameters()})
+	}
+	return params
+}
+
+func (p *LookupOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "name":
+			if p.Name != nil {
+				return fmt.Errorf("parameter 'name' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Name = &value
+		case "version":
+			if p.Version != nil {
+				return fmt.Errorf("parameter 'version' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Version = &value
+		case "display":
+			if p.Display != nil {
+				return fmt.Errorf("parameter 'display' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Display = &value
+		case "definition":
+			if p.Definition != nil {
+				return fmt.Errorf("parameter 'definition' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Definition = &value
+		case "designation":
+			var v LookupOutDesignation
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'designation': %w", err)
+			}
+			p.Designation = append(p.Designation, v)
+		case "property":
+			var v LookupOutProperty
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'property': %w", err)
+			}
+			p.Property = append(p.Property, v)
+		}
+	}
+	return nil
+}
+
+// LookupOutDesignation holds the parts of the designation parameter.
+type LookupOutDesignation struct {
+	// The language this designation is defined for
+	Language *string
+	// A code that details how this designation would be used
+	Use *Coding
+	// Additional codes that detail how this designation would be used
+	AdditionalUse []Coding
+	// The text value for this designation
+	Value *string
+}
+
+func (p *LookupOutDesignation) Validate() error {
+	if p.Value == nil {
+		return fmt.Errorf("parameter 'value' is required")
+	}
+	return nil
+}
+
+func (p *LookupOutDesignation) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Language != nil {
+		params = append(params, ParametersParameter{Name: "language", Value: ParametersParameterValueCode(*p.Language)})
+	}
+	if p.Use != nil {
+		params = append(params, ParametersParameter{Name: "use", Value: ParametersParameterValueCoding{Coding: *p.Use}})
+	}
+	for _, v := range p.AdditionalUse {
+		params = append(params, ParametersParameter{Name: "additionalUse", Value: ParametersParameterValueCoding{Coding: v}})
+	}
+	if p.Value != nil {
+		params = append(params, ParametersParameter{Name: "value", Value: ParametersParameterValueString(*p.Value)})
+	}
+	return params
+}
+
+func (p *LookupOutDesignation) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "language":
+			if p.Language != nil {
+				return fmt.Errorf("parameter 'language' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Language = &value
+		case "use":
+			if p.Use != nil {
+				return fmt.Errorf("parameter 'use' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Use = &v.Coding
+		case "additionalUse":
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.AdditionalUse = append(p.AdditionalUse, v.Coding)
+		case "value":
+			if p.Value != nil {
+				return fmt.Errorf("parameter 'value' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Value = &value
+		}
+	}
+	return nil
+}
+
+// LookupOutProperty holds the parts of the property parameter.
+type LookupOutProperty struct {
+	// Identifies the property returned
+	Code *string
+	// The value of the property returned
+	Value ParametersParameterValue
+	// Human Readable representation of the property value
+	Description *string
+	// The source code system of the property
+	Source *string
+	// Nested Properties (mainly used for SNOMED CT decomposition, for relationship Groups)
+	Subproperty []LookupOutPropertySubproperty
+}
+
+func (p *LookupOutProperty) Validate() error {
+	if p.Code == nil {
+		return fmt.Errorf("parameter 'code' is required")
+	}
+	for i := range p.Subproperty {
+		if err := p.Subproperty[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'subproperty'[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *LookupOutProperty) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Code != nil {
+		params = append(params, ParametersParameter{Name: "code", Value: ParametersParameterValueCode(*p.Code)})
+	}
+	if p.Value != nil {
+		params = append(params, ParametersParameter{Name: "value", Value: p.Value})
+	}
+	if p.Description != nil {
+		params = append(params, ParametersParameter{Name: "description", Value: ParametersParameterValueString(*p.Description)})
+	}
+	if p.Source != nil {
+		params = append(params, ParametersParameter{Name: "source", Value: ParametersParameterValueCanonical(*p.Source)})
+	}
+	for _, v := range p.Subproperty {
+		params = append(params, ParametersParameter{Name: "subproperty", Part: v.parameters()})
+	}
+	return params
+}
+
+func (p *LookupOutProperty) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "code":
+			if p.Code != nil {
+				return fmt.Errorf("parameter 'code' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Code = &value
+		case "value":
+			if p.Value != nil {
+				return fmt.Errorf("parameter 'value' must occur at most once")
+			}
+			if param.Value == nil {
+				return fmt.Errorf("parameter 'value' must have a value")
+			}
+			p.Value = param.Value
+		case "description":
+			if p.Description != nil {
+				return fmt.Errorf("parameter 'description' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Description = &value
+		case "source":
+			if p.Source != nil {
+				return fmt.Errorf("parameter 'source' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Source = &value
+		case "subproperty":
+			var v LookupOutPropertySubproperty
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'subproperty': %w", err)
+			}
+			p.Subproperty = append(p.Subproperty, v)
+		}
+	}
+	return nil
+}
+
+// LookupOutPropertySubproperty holds the parts of the subproperty parameter.
+type LookupOutPropertySubproperty struct {
+	// Identifies the sub-property returned
+	Code *string
+	// The value of the sub-property returned
+	Value ParametersParameterValue
+	// Human Readable representation of the property value
+	Description *string
+	// The source code system of the sub-property
+	Source *string
+}
+
+func (p *LookupOutPropertySubproperty) Validate() error {
+	if p.Code == nil {
+		return fmt.Errorf("parameter 'code' is required")
+	}
+	if p.Value == nil {
+		return fmt.Errorf("parameter 'value' is required")
+	}
+	return nil
+}
+
+func (p *LookupOutPropertySubproperty) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Code != nil {
+		params = append(params, ParametersParameter{Name: "code", Value: ParametersParameterValueCode(*p.Code)})
+	}
+	if p.Value != nil {
+		params = append(params, ParametersParameter{Name: "value", Value: p.Value})
+	}
+	if p.Description != nil {
+		params = append(params, ParametersParameter{Name: "description", Value: ParametersParameterValueString(*p.Description)})
+	}
+	if p.Source != nil {
+		params = append(params, ParametersParameter{Name: "source", Value: ParametersParameterValueCanonical(*p.Source)})
+	}
+	return params
 }
 
-func (r *Lookup) Validate() error {
+func (p *LookupOutPropertySubproperty) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "code":
+			if p.Code != nil {
+				return fmt.Errorf("parameter 'code' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Code = &value
+		case "value":
+			if p.Value != nil {
+				return fmt.Errorf("parameter 'value' must occur at most once")
+			}
+			if param.Value == nil {
+				return fmt.Errorf("parameter 'value' must have a value")
+			}
+			p.Value = param.Value
+		case "description":
+			if p.Description != nil {
+				return fmt.Errorf("parameter 'description' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Description = &value
+		case "source":
+			if p.Source != nil {
+				return fmt.Errorf("parameter 'source' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Source = &value
+		}
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,12 +12,18 @@ type ManufacturedItemDefinition struct {
 	Id                   *string                               `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
 	Meta                 *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
 	ImplicitRules        *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
 	Language             *string                               `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement      *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
 	Text                 *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage                     `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Contained            []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension            []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension    []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
 	Identifier           []Identifier                          `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Unique identifier
-	Status               string                                `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	Status               PublicationStatus                     `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement        *Element                              `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
 	Name                 *string                               `json:"name,omitempty" bson:"name,omitempty"`                               // A descriptive name applied to this item
+	NameElement          *Element                              `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
 	ManufacturedDoseForm *CodeableConcept                      `json:"manufacturedDoseForm" bson:"manufactured_dose_form"`                 // Dose form as manufactured (before any necessary transformation)
 	UnitOfPresentation   *CodeableConcept                      `json:"unitOfPresentation,omitempty" bson:"unit_of_presentation,omitempty"` // The “real-world” units in which the quantity of the item is described
 	Manufacturer         []Reference                           `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`               // Manufacturer of the item, one of several possible
@@ -35,20 +42,60 @@ func (r *ManufacturedItemDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	if r.ManufacturedDoseForm == nil {
 		return fmt.Errorf("field 'ManufacturedDoseForm' is required")
 	}
@@ -87,23 +134,231 @@ func (r *ManufacturedItemDefinition) Validate() error {
 			return fmt.Errorf("Component[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "ManufacturedItemDefinition")
+}
+
+func (r *ManufacturedItemDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ManufacturedItemDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ManufacturedItemDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.ManufacturedDoseForm == nil {
+		issues.add("required", path+".manufacturedDoseForm", "field 'ManufacturedDoseForm' is required")
+	}
+	if r.ManufacturedDoseForm != nil {
+		r.ManufacturedDoseForm.validateAll(path+".manufacturedDoseForm", issues)
+	}
+	if r.UnitOfPresentation != nil {
+		r.UnitOfPresentation.validateAll(path+".unitOfPresentation", issues)
+	}
+	for i, item := range r.Manufacturer {
+		item.validateAll(fmt.Sprintf("%s.manufacturer[%d]", path, i), issues)
+	}
+	for i, item := range r.MarketingStatus {
+		item.validateAll(fmt.Sprintf("%s.marketingStatus[%d]", path, i), issues)
+	}
+	for i, item := range r.Ingredient {
+		item.validateAll(fmt.Sprintf("%s.ingredient[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+func (r *ManufacturedItemDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ManufacturedItemDefinition", &issues)
+	checkInvariants(r, "ManufacturedItemDefinition", &issues)
+	return issues
+}
+
+var manufacturedItemDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ManufacturedItemDefinition) invariants() []invariant {
+	return manufacturedItemDefinitionInvariants
+}
+
+func (r *ManufacturedItemDefinition) UnmarshalJSON(data []byte) error {
+	type alias ManufacturedItemDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r ManufacturedItemDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ManufacturedItemDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ManufacturedItemDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ManufacturedItemDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var manufacturedItemDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *ManufacturedItemDefinition) elementTypes() map[string]string {
+	return manufacturedItemDefinitionElementTypes
+}
+
+var manufacturedItemDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ManufacturedItemDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ManufacturedItemDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ManufacturedItemDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ManufacturedItemDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ManufacturedItemDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ManufacturedItemDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ManufacturedItemDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ManufacturedItemDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ManufacturedItemDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ManufacturedItemDefinition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ManufacturedItemDefinition.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ManufacturedItemDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ManufacturedItemDefinition.name", Name: "name", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ManufacturedItemDefinition.manufacturedDoseForm", Name: "manufacturedDoseForm", Field: 16, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.unitOfPresentation", Name: "unitOfPresentation", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.manufacturer", Name: "manufacturer", Field: 18, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ManufacturedItemDefinition.marketingStatus", Name: "marketingStatus", Field: 19, Min: 0, Max: "*", Types: []string{"MarketingStatus"}},
+	{Path: "ManufacturedItemDefinition.ingredient", Name: "ingredient", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.property", Name: "property", Field: 21, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ManufacturedItemDefinition.component", Name: "component", Field: 22, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ManufacturedItemDefinition) ElementMetadata() []ElementMetadata {
+	return manufacturedItemDefinitionElementMetadata
+}
+
+func (r *ManufacturedItemDefinition) GetResourceType() string {
+	return "ManufacturedItemDefinition"
+}
+
+func (r *ManufacturedItemDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ManufacturedItemDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ManufacturedItemDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ManufacturedItemDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ManufacturedItemDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ManufacturedItemDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ManufacturedItemDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ManufacturedItemDefinition)(nil)
+
 type ManufacturedItemDefinitionProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                                       // A code expressing the type of characteristic
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // A value for the characteristic
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // A value for the characteristic
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // A value for the characteristic
-	ValueDate            *string          `json:"valueDate,omitempty" bson:"value_date,omitempty"`                        // A value for the characteristic
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // A value for the characteristic
-	ValueMarkdown        *string          `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                // A value for the characteristic
-	ValueAttachment      *Attachment      `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`            // A value for the characteristic
-	ValueReference       *Reference       `json:"valueReference,omitempty" bson:"value_reference,omitempty"`              // A value for the characteristic
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                        `json:"type" bson:"type"`                                                // A code expressing the type of characteristic
+	Value             ManufacturedItemDefinitionPropertyValue `json:"-" bson:"value,omitempty"`                                        // A value for the characteristic
+	ValueElement      *Element                                `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of ManufacturedItemDefinition.property.value[x] when more than one was decoded
 }
 
 func (r *ManufacturedItemDefinitionProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -112,45 +367,326 @@ func (r *ManufacturedItemDefinitionProperty) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
+	return nil
+}
+
+func (r *ManufacturedItemDefinitionProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var manufacturedItemDefinitionPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ManufacturedItemDefinitionProperty) invariants() []invariant {
+	return manufacturedItemDefinitionPropertyInvariants
+}
+
+func (r ManufacturedItemDefinitionProperty) MarshalJSON() ([]byte, error) {
+	type alias ManufacturedItemDefinitionProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ManufacturedItemDefinitionProperty) UnmarshalJSON(data []byte) error {
+	type alias ManufacturedItemDefinitionProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", manufacturedItemDefinitionPropertyValueVariants); err != nil {
+		return err
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r ManufacturedItemDefinitionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ManufacturedItemDefinitionProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ManufacturedItemDefinitionProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ManufacturedItemDefinitionProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ManufacturedItemDefinitionProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(manufacturedItemDefinitionPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var manufacturedItemDefinitionPropertyElementMetadata = []ElementMetadata{
+	{Path: "ManufacturedItemDefinition.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ManufacturedItemDefinition.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ManufacturedItemDefinition.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ManufacturedItemDefinition.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.property.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Quantity", "Range", "date", "boolean", "markdown", "Attachment", "Reference"}},
+}
+
+func (r *ManufacturedItemDefinitionProperty) ElementMetadata() []ElementMetadata {
+	return manufacturedItemDefinitionPropertyElementMetadata
+}
+
+// ManufacturedItemDefinitionPropertyValue is the type of ManufacturedItemDefinition.property.value[x]. It is implemented by the ManufacturedItemDefinitionPropertyValue* variant types.
+type ManufacturedItemDefinitionPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isManufacturedItemDefinitionPropertyValue()
+}
+
+// ManufacturedItemDefinitionPropertyValueCodeableConcept is the CodeableConcept variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ManufacturedItemDefinitionPropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ManufacturedItemDefinitionPropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ManufacturedItemDefinitionPropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueCodeableConcept) isManufacturedItemDefinitionPropertyValue() {
+}
+
+// ManufacturedItemDefinitionPropertyValueQuantity is the Quantity variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueQuantity struct {
+	Quantity
+}
+
+func (v ManufacturedItemDefinitionPropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ManufacturedItemDefinitionPropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ManufacturedItemDefinitionPropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueQuantity) isManufacturedItemDefinitionPropertyValue() {
+}
+
+// ManufacturedItemDefinitionPropertyValueRange is the Range variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueRange struct {
+	Range
+}
+
+func (v ManufacturedItemDefinitionPropertyValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ManufacturedItemDefinitionPropertyValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ManufacturedItemDefinitionPropertyValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueRange) isManufacturedItemDefinitionPropertyValue() {}
+
+// ManufacturedItemDefinitionPropertyValueDate is the date variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueDate struct {
+	Date
+}
+
+func (v ManufacturedItemDefinitionPropertyValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ManufacturedItemDefinitionPropertyValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ManufacturedItemDefinitionPropertyValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueDate) isManufacturedItemDefinitionPropertyValue() {}
+
+// ManufacturedItemDefinitionPropertyValueBoolean is the boolean variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueBoolean bool
+
+func (v ManufacturedItemDefinitionPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ManufacturedItemDefinitionPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ManufacturedItemDefinitionPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueBoolean) isManufacturedItemDefinitionPropertyValue() {}
+
+// ManufacturedItemDefinitionPropertyValueMarkdown is the markdown variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueMarkdown string
+
+func (v ManufacturedItemDefinitionPropertyValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ManufacturedItemDefinitionPropertyValueMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ManufacturedItemDefinitionPropertyValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueMarkdown) isManufacturedItemDefinitionPropertyValue() {
+}
+
+// ManufacturedItemDefinitionPropertyValueAttachment is the Attachment variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueAttachment struct {
+	Attachment
+}
+
+func (v ManufacturedItemDefinitionPropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ManufacturedItemDefinitionPropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ManufacturedItemDefinitionPropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueAttachment) isManufacturedItemDefinitionPropertyValue() {
+}
+
+// ManufacturedItemDefinitionPropertyValueReference is the Reference variant of ManufacturedItemDefinitionPropertyValue.
+type ManufacturedItemDefinitionPropertyValueReference struct {
+	Reference
+}
+
+func (v ManufacturedItemDefinitionPropertyValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ManufacturedItemDefinitionPropertyValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ManufacturedItemDefinitionPropertyValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ManufacturedItemDefinitionPropertyValueReference) isManufacturedItemDefinitionPropertyValue() {
+}
+
+var manufacturedItemDefinitionPropertyValueVariants = []ManufacturedItemDefinitionPropertyValue{
+	ManufacturedItemDefinitionPropertyValueCodeableConcept{},
+	ManufacturedItemDefinitionPropertyValueQuantity{},
+	ManufacturedItemDefinitionPropertyValueRange{},
+	ManufacturedItemDefinitionPropertyValueDate{},
+	ManufacturedItemDefinitionPropertyValueBoolean(false),
+	ManufacturedItemDefinitionPropertyValueMarkdown(""),
+	ManufacturedItemDefinitionPropertyValueAttachment{},
+	ManufacturedItemDefinitionPropertyValueReference{},
+}
+
 type ManufacturedItemDefinitionComponent struct {
-	Id          *string                                          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type        *CodeableConcept                                 `json:"type" bson:"type"`                                   // Defining type of the component e.g. shell, layer, ink
-	Function    []CodeableConcept                                `json:"function,omitempty" bson:"function,omitempty"`       // The function of this component within the item e.g. delivers active ingredient, masks taste
-	Amount      []Quantity                                       `json:"amount,omitempty" bson:"amount,omitempty"`           // The measurable amount of total quantity of all substances in the component, expressible in different ways (e.g. by mass or volume)
-	Constituent []ManufacturedItemDefinitionComponentConstituent `json:"constituent,omitempty" bson:"constituent,omitempty"` // A reference to a constituent of the manufactured item as a whole, linked here so that its component location within the item can be indicated. This not where the item's ingredient are primarily stated (for which see Ingredient.for or ManufacturedItemDefinition.ingredient)
-	Property    []ManufacturedItemDefinitionProperty             `json:"property,omitempty" bson:"property,omitempty"`       // General characteristics of this component
-	Component   []ManufacturedItemDefinitionComponent            `json:"component,omitempty" bson:"component,omitempty"`     // A component that this component contains or is made from
+	Id                *string                                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                                 `json:"type" bson:"type"`                                                // Defining type of the component e.g. shell, layer, ink
+	Function          []CodeableConcept                                `json:"function,omitempty" bson:"function,omitempty"`                    // The function of this component within the item e.g. delivers active ingredient, masks taste
+	Amount            []Quantity                                       `json:"amount,omitempty" bson:"amount,omitempty"`                        // The measurable amount of total quantity of all substances in the component, expressible in different ways (e.g. by mass or volume)
+	Constituent       []ManufacturedItemDefinitionComponentConstituent `json:"constituent,omitempty" bson:"constituent,omitempty"`              // A reference to a constituent of the manufactured item as a whole, linked here so that its component location within the item can be indicated. This not where the item's ingredient are primarily stated (for which see Ingredient.for or ManufacturedItemDefinition.ingredient)
+	Property          []ManufacturedItemDefinitionProperty             `json:"property,omitempty" bson:"property,omitempty"`                    // General characteristics of this component
+	Component         []ManufacturedItemDefinitionComponent            `json:"component,omitempty" bson:"component,omitempty"`                  // A component that this component contains or is made from
 }
 
 func (r *ManufacturedItemDefinitionComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -187,15 +723,97 @@ func (r *ManufacturedItemDefinitionComponent) Validate() error {
 	return nil
 }
 
+func (r *ManufacturedItemDefinitionComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Function {
+		item.validateAll(fmt.Sprintf("%s.function[%d]", path, i), issues)
+	}
+	for i, item := range r.Amount {
+		item.validateAll(fmt.Sprintf("%s.amount[%d]", path, i), issues)
+	}
+	for i, item := range r.Constituent {
+		item.validateAll(fmt.Sprintf("%s.constituent[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+var manufacturedItemDefinitionComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ManufacturedItemDefinitionComponent) invariants() []invariant {
+	return manufacturedItemDefinitionComponentInvariants
+}
+
+func (r ManufacturedItemDefinitionComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ManufacturedItemDefinitionComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ManufacturedItemDefinitionComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ManufacturedItemDefinitionComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var manufacturedItemDefinitionComponentElementMetadata = []ElementMetadata{
+	{Path: "ManufacturedItemDefinition.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ManufacturedItemDefinition.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ManufacturedItemDefinition.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ManufacturedItemDefinition.component.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.component.function", Name: "function", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.component.amount", Name: "amount", Field: 5, Min: 0, Max: "*", Types: []string{"Quantity"}},
+	{Path: "ManufacturedItemDefinition.component.constituent", Name: "constituent", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ManufacturedItemDefinition.component.property", Name: "property", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ManufacturedItemDefinition.component.component", Name: "component", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ManufacturedItemDefinitionComponent) ElementMetadata() []ElementMetadata {
+	return manufacturedItemDefinitionComponentElementMetadata
+}
+
 type ManufacturedItemDefinitionComponentConstituent struct {
-	Id            *string             `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Amount        []Quantity          `json:"amount,omitempty" bson:"amount,omitempty"`                // The measurable amount of the substance, expressible in different ways (e.g. by mass or volume)
-	Location      []CodeableConcept   `json:"location,omitempty" bson:"location,omitempty"`            // The physical location of the constituent/ingredient within the component
-	Function      []CodeableConcept   `json:"function,omitempty" bson:"function,omitempty"`            // The function of this constituent within the component e.g. binder
-	HasIngredient []CodeableReference `json:"hasIngredient,omitempty" bson:"has_ingredient,omitempty"` // The ingredient that is the constituent of the given component
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Amount            []Quantity          `json:"amount,omitempty" bson:"amount,omitempty"`                        // The measurable amount of the substance, expressible in different ways (e.g. by mass or volume)
+	Location          []CodeableConcept   `json:"location,omitempty" bson:"location,omitempty"`                    // The physical location of the constituent/ingredient within the component
+	Function          []CodeableConcept   `json:"function,omitempty" bson:"function,omitempty"`                    // The function of this constituent within the component e.g. binder
+	HasIngredient     []CodeableReference `json:"hasIngredient,omitempty" bson:"has_ingredient,omitempty"`         // The ingredient that is the constituent of the given component
 }
 
 func (r *ManufacturedItemDefinitionComponentConstituent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Amount {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Amount[%d]: %w", i, err)
@@ -218,3 +836,62 @@ func (r *ManufacturedItemDefinitionComponentConstituent) Validate() error {
 	}
 	return nil
 }
+
+func (r *ManufacturedItemDefinitionComponentConstituent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Amount {
+		item.validateAll(fmt.Sprintf("%s.amount[%d]", path, i), issues)
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	for i, item := range r.Function {
+		item.validateAll(fmt.Sprintf("%s.function[%d]", path, i), issues)
+	}
+	for i, item := range r.HasIngredient {
+		item.validateAll(fmt.Sprintf("%s.hasIngredient[%d]", path, i), issues)
+	}
+}
+
+var manufacturedItemDefinitionComponentConstituentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ManufacturedItemDefinitionComponentConstituent) invariants() []invariant {
+	return manufacturedItemDefinitionComponentConstituentInvariants
+}
+
+func (r ManufacturedItemDefinitionComponentConstituent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ManufacturedItemDefinitionComponentConstituent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ManufacturedItemDefinitionComponentConstituent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ManufacturedItemDefinitionComponentConstituent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var manufacturedItemDefinitionComponentConstituentElementMetadata = []ElementMetadata{
+	{Path: "ManufacturedItemDefinition.component.constituent.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ManufacturedItemDefinition.component.constituent.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ManufacturedItemDefinition.component.constituent.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ManufacturedItemDefinition.component.constituent.amount", Name: "amount", Field: 3, Min: 0, Max: "*", Types: []string{"Quantity"}},
+	{Path: "ManufacturedItemDefinition.component.constituent.location", Name: "location", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.component.constituent.function", Name: "function", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ManufacturedItemDefinition.component.constituent.hasIngredient", Name: "hasIngredient", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+}
+
+func (r *ManufacturedItemDefinitionComponentConstituent) ElementMetadata() []ElementMetadata {
+	return manufacturedItemDefinitionComponentConstituentElementMetadata
+}
@@ -1,20 +1,34 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // MarketingStatus Type: The marketing status describes the date when an item is actually put on the market or the date as of which it is no longer available.
 type MarketingStatus struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Country      *CodeableConcept `json:"country,omitempty" bson:"country,omitempty"`           // The country in which the marketing status applies
-	Jurisdiction *CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"` // The jurisdiction in which the marketing status applies
-	Status       *CodeableConcept `json:"status" bson:"status"`                                 // This attribute provides information on the status of the marketing of the item
-	DateRange    *Period          `json:"dateRange,omitempty" bson:"date_range,omitempty"`      // The dates that the item is made available on the market by the owner (or where applicable, the manufacturer/distributor) in a country and/or jurisdiction. Note that “on the market” refers to the release of the item into the distribution chain
-	RestoreDate  *string          `json:"restoreDate,omitempty" bson:"restore_date,omitempty"`  // The date when the item is due to be placed back on the market by the owner, manufacturer or distributor, after a suspension
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Country            *CodeableConcept `json:"country,omitempty" bson:"country,omitempty"`                      // The country in which the marketing status applies
+	Jurisdiction       *CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`            // The jurisdiction in which the marketing status applies
+	Status             *CodeableConcept `json:"status" bson:"status"`                                            // This attribute provides information on the status of the marketing of the item
+	DateRange          *Period          `json:"dateRange,omitempty" bson:"date_range,omitempty"`                 // The dates that the item is made available on the market by the owner (or where applicable, the manufacturer/distributor) in a country and/or jurisdiction. Note that “on the market” refers to the release of the item into the distribution chain
+	RestoreDate        *DateTime        `json:"restoreDate,omitempty" bson:"restore_date,omitempty"`             // The date when the item is due to be placed back on the market by the owner, manufacturer or distributor, after a suspension
+	RestoreDateElement *Element         `json:"_restoreDate,omitempty" bson:"restore_date_element,omitempty"`    // Extensions for restoreDate
 }
 
 func (r *MarketingStatus) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Country != nil {
 		if err := r.Country.Validate(); err != nil {
 			return fmt.Errorf("Country: %w", err)
@@ -38,5 +52,93 @@ func (r *MarketingStatus) Validate() error {
 			return fmt.Errorf("DateRange: %w", err)
 		}
 	}
+	if r.RestoreDate != nil {
+		if err := r.RestoreDate.Validate(); err != nil {
+			return fmt.Errorf("RestoreDate: %w", err)
+		}
+	}
+	if r.RestoreDateElement != nil {
+		if err := r.RestoreDateElement.Validate(); err != nil {
+			return fmt.Errorf("RestoreDateElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *MarketingStatus) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Country != nil {
+		r.Country.validateAll(path+".country", issues)
+	}
+	if r.Jurisdiction != nil {
+		r.Jurisdiction.validateAll(path+".jurisdiction", issues)
+	}
+	if r.Status == nil {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.DateRange != nil {
+		r.DateRange.validateAll(path+".dateRange", issues)
+	}
+	if r.RestoreDate != nil {
+		if err := r.RestoreDate.Validate(); err != nil {
+			issues.add("value", path+".restoreDate", err.Error())
+		}
+	}
+	if r.RestoreDateElement != nil {
+		r.RestoreDateElement.validateAll(path+".restoreDate", issues)
+	}
+}
+
+func (r *MarketingStatus) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MarketingStatus", &issues)
+	checkInvariants(r, "MarketingStatus", &issues)
+	return issues
+}
+
+var marketingStatusInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MarketingStatus) invariants() []invariant {
+	return marketingStatusInvariants
+}
+
+func (r MarketingStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MarketingStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MarketingStatus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MarketingStatus) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var marketingStatusElementMetadata = []ElementMetadata{
+	{Path: "MarketingStatus.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MarketingStatus.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MarketingStatus.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MarketingStatus.country", Name: "country", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Summary: true},
+	{Path: "MarketingStatus.jurisdiction", Name: "jurisdiction", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Summary: true},
+	{Path: "MarketingStatus.status", Name: "status", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Summary: true},
+	{Path: "MarketingStatus.dateRange", Name: "dateRange", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}, Summary: true},
+	{Path: "MarketingStatus.restoreDate", Name: "restoreDate", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime"}, Summary: true},
+}
+
+func (r *MarketingStatus) ElementMetadata() []ElementMetadata {
+	return marketingStatusElementMetadata
+}
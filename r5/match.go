@@ -1,9 +1,154 @@
 package models
 
-// A Master Patient Index ([MPI](http://en.wikipedia.org/wiki/Enterprise_master_patient_index) ) is a service used to manage patient identification in a context where multiple patient databases exist. Healthcare applications and middleware use the MPI to match patients between the databases, and to store new patient details as they are encountered. MPIs are highly specialized applications, often tailored extensively to the institution's particular mix of patients. MPIs can also be run on a regional and national basis.    To ask an MPI to match a patient, clients use the "$match" operation, which accepts a patient resource which may be only partially complete. The data provided is interpreted as an MPI input and processed by an algorithm of some kind that uses the data to determine the most appropriate matches in the patient set.  Note that different MPI matching algorithms have different required inputs. Consult with the vendor implementing the $match operation as to its specific behaviors.    The generic $match operation does not specify any particular algorithm, nor a minimum set of information that must be provided when asking for an MPI match operation to be performed, but many implementations will have a set of minimum information, which may be declared in their definition of the $match operation by specifying a profile on the resource parameter, indicating which properties are required in the search.  The patient resource submitted to the operation does not have to be complete, nor does it need to pass validation (i.e. mandatory fields don't need to be populated), but it does have to be a valid instance, as it is used as the reference data to match against.    Implementers of the $match algorithm should consider the relevance of returning inactive patients, particularly ones associated with patient merges.  E.g. If an inactive patient is "matched" and its merged target resource will be included, then the inactive one may be excluded, however if a patient was just marked as inactive for other reasons, it could be included in the results.  (any specific MPI algorithm may or might not behave as in these examples)
-type Match struct {
+import "fmt"
+
+// MatchIn holds the input parameters of the $match operation. A Master Patient Index ([MPI](http://en.wikipedia.org/wiki/Enterprise_master_patient_index) ) is a service used to manage patient identification in a context where multiple patient databases exist. Healthcare applications and middleware use the MPI to match patients between the databases, and to store new patient details as they are encountered. MPIs are highly specialized applications, often tailored extensively to the institution's particular mix of patients. MPIs can also be run on a regional and national basis.    To ask an MPI to match a patient, clients use the "$match" operation, which accepts a patient resource which may be only partially complete. The data provided is interpreted as an MPI input and processed by an algorithm of some kind that uses the data to determine the most appropriate matches in the patient set.  Note that different MPI matching algorithms have different required inputs. Consult with the vendor implementing the $match operation as to its specific behaviors.    The generic $match operation does not specify any particular algorithm, nor a minimum set of information that must be provided when asking for an MPI match operation to be performed, but many implementations will have a set of minimum information, which may be declared in their definition of the $match operation by specifying a profile on the resource parameter, indicating which properties are required in the search.  The patient resource submitted to the operation does not have to be complete, nor does it need to pass validation (i.e. mandatory fields don't need to be populated), but it does have to be a valid instance, as it is used as the reference data to match against.    Implementers of the $match algorithm should consider the relevance of returning inactive patients, particularly ones associated with patient merges.  E.g. If an inactive patient is "matched" and its merged target resource will be included, then the inactive one may be excluded, however if a patient was just marked as inactive for other reasons, it could be included in the results.  (any specific MPI algorithm may or might not behave as in these examples)
+type MatchIn struct {
+	// Use this to provide an entire set of patient details for the MPI to match against
+	Resource Resource
+	// If there are multiple potential matches, the server should identify the single most appropriate match that should be used with the record
+	OnlySingleMatch *bool
+	// If there are multiple potential matches, the server should be certain that each of the records are for the same patients
+	OnlyCertainMatches *bool
+	// The maximum number of records to return
+	Count *int
+}
+
+func (p *MatchIn) Validate() error {
+	if p.Resource == nil {
+		return fmt.Errorf("parameter 'resource' is required")
+	}
+	return nil
+}
+
+func (p *MatchIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *MatchIn) FromParameters(params *Parameters) error {
+	*p = MatchIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *MatchIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Resource != nil {
+		params = append(params, ParametersParameter{Name: "resource", Resource: p.Resource})
+	}
+	if p.OnlySingleMatch != nil {
+		params = append(params, ParametersParameter{Name: "onlySingleMatch", Value: ParametersParameterValueBoolean(*p.OnlySingleMatch)})
+	}
+	if p.OnlyCertainMatches != nil {
+		params = append(params, ParametersParameter{Name: "onlyCertainMatches", Value: ParametersParameterValueBoolean(*p.OnlyCertainMatches)})
+	}
+	if p.Count != nil {
+		params = append(params, ParametersParameter{Name: "count", Value: ParametersParameterValueInteger(*p.Count)})
+	}
+	return params
+}
+
+func (p *MatchIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "resource":
+			if p.Resource != nil {
+				return fmt.Errorf("parameter 'resource' must occur at most once")
+			}
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.Resource = v
+		case "onlySingleMatch":
+			if p.OnlySingleMatch != nil {
+				return fmt.Errorf("parameter 'onlySingleMatch' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.OnlySingleMatch = &value
+		case "onlyCertainMatches":
+			if p.OnlyCertainMatches != nil {
+				return fmt.Errorf("parameter 'onlyCertainMatches' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.OnlyCertainMatches = &value
+		case "count":
+			if p.Count != nil {
+				return fmt.Errorf("parameter 'count' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueInteger](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := int(v)
+			p.Count = &value
+		}
+	}
+	return nil
+}
+
+// MatchOut holds the output parameters of the $match operation.
+type MatchOut struct {
+	// A bundle contain a set of Patient records that represent possible matches
+	Return *Bundle
+}
+
+func (p *MatchOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *MatchOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *MatchOut) FromParameters(params *Parameters) error {
+	*p = MatchOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *MatchOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Match) Validate() error {
+func (p *MatchOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
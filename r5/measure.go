@@ -2,60 +2,90 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The Measure resource provides the definition of a quality measure.
 type Measure struct {
-	ResourceType                    string                    `json:"resourceType" bson:"resource_type"`                                                            // Type of resource
-	Id                              *string                   `json:"id,omitempty" bson:"id,omitempty"`                                                             // Logical id of this artifact
-	Meta                            *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                                                         // Metadata about the resource
-	ImplicitRules                   *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                      // A set of rules under which this content was created
-	Language                        *string                   `json:"language,omitempty" bson:"language,omitempty"`                                                 // Language of the resource content
-	Text                            *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                                                         // Text summary of the resource, for human interpretation
-	Contained                       []json.RawMessage         `json:"contained,omitempty" bson:"contained,omitempty"`                                               // Contained, inline Resources
-	Url                             *string                   `json:"url,omitempty" bson:"url,omitempty"`                                                           // Canonical identifier for this measure, represented as a URI (globally unique)
-	Identifier                      []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                                             // Additional identifier for the measure
-	Version                         *string                   `json:"version,omitempty" bson:"version,omitempty"`                                                   // Business version of the measure
-	VersionAlgorithmString          *string                   `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"`                   // How to compare versions
-	VersionAlgorithmCoding          *Coding                   `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"`                   // How to compare versions
-	Name                            *string                   `json:"name,omitempty" bson:"name,omitempty"`                                                         // Name for this measure (computer friendly)
-	Title                           *string                   `json:"title,omitempty" bson:"title,omitempty"`                                                       // Name for this measure (human friendly)
-	Subtitle                        *string                   `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                                                 // Subordinate title of the measure
-	Status                          string                    `json:"status" bson:"status"`                                                                         // draft | active | retired | unknown
-	Experimental                    *bool                     `json:"experimental,omitempty" bson:"experimental,omitempty"`                                         // For testing only - never for real usage
-	SubjectCodeableConcept          *CodeableConcept          `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"`                   // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
-	SubjectReference                *Reference                `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`                                // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
-	Date                            *string                   `json:"date,omitempty" bson:"date,omitempty"`                                                         // Date last changed
-	Publisher                       *string                   `json:"publisher,omitempty" bson:"publisher,omitempty"`                                               // Name of the publisher/steward (organization or individual)
-	Contact                         []ContactDetail           `json:"contact,omitempty" bson:"contact,omitempty"`                                                   // Contact details for the publisher
-	Description                     *string                   `json:"description,omitempty" bson:"description,omitempty"`                                           // Natural language description of the measure
-	UseContext                      []UsageContext            `json:"useContext,omitempty" bson:"use_context,omitempty"`                                            // The context that the content is intended to support
-	Jurisdiction                    []CodeableConcept         `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                         // Jurisdiction of the authority that maintains the measure (if applicable)
-	Purpose                         *string                   `json:"purpose,omitempty" bson:"purpose,omitempty"`                                                   // Why this measure is defined
-	Usage                           *string                   `json:"usage,omitempty" bson:"usage,omitempty"`                                                       // Describes the clinical usage of the measure
-	Copyright                       *string                   `json:"copyright,omitempty" bson:"copyright,omitempty"`                                               // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel                  *string                   `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                                    // Copyright holder and year(s)
-	ApprovalDate                    *string                   `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                                        // When the measure was approved by publisher
-	LastReviewDate                  *string                   `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                                   // When the measure was last reviewed by the publisher
-	EffectivePeriod                 *Period                   `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                                  // When the measure is expected to be used
-	ReportingFrequency              *Quantity                 `json:"reportingFrequency,omitempty" bson:"reporting_frequency,omitempty"`                            // The frequency in which this measure should be reported (e.g. 1 '/a' - yearly, 4 '/a' - quarterly)
-	Topic                           []CodeableConcept         `json:"topic,omitempty" bson:"topic,omitempty"`                                                       // The category of the measure, such as Education, Treatment, Assessment, etc
-	Author                          []ContactDetail           `json:"author,omitempty" bson:"author,omitempty"`                                                     // Who authored the content
-	Editor                          []ContactDetail           `json:"editor,omitempty" bson:"editor,omitempty"`                                                     // Who edited the content
-	Reviewer                        []ContactDetail           `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                                                 // Who reviewed the content
-	Endorser                        []ContactDetail           `json:"endorser,omitempty" bson:"endorser,omitempty"`                                                 // Who endorsed the content
-	RelatedArtifact                 []RelatedArtifact         `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                                  // Additional documentation, citations, etc
-	Library                         []string                  `json:"library,omitempty" bson:"library,omitempty"`                                                   // Logic used by the measure
-	Disclaimer                      *string                   `json:"disclaimer,omitempty" bson:"disclaimer,omitempty"`                                             // Disclaimer for use of the measure or its referenced content
-	RiskAdjustment                  *string                   `json:"riskAdjustment,omitempty" bson:"risk_adjustment,omitempty"`                                    // How risk adjustment is applied for this measure
-	RateAggregation                 *string                   `json:"rateAggregation,omitempty" bson:"rate_aggregation,omitempty"`                                  // How is rate aggregation performed for this measure
-	Rationale                       *string                   `json:"rationale,omitempty" bson:"rationale,omitempty"`                                               // Justification for the measure in terms of impact, gap in care, and evidence
-	ClinicalRecommendationStatement *string                   `json:"clinicalRecommendationStatement,omitempty" bson:"clinical_recommendation_statement,omitempty"` // Summary of clinical guidelines
-	Term                            []MeasureTerm             `json:"term,omitempty" bson:"term,omitempty"`                                                         // Defined terms used in the measure documentation
-	Guidance                        *string                   `json:"guidance,omitempty" bson:"guidance,omitempty"`                                                 // Additional guidance for implementers (deprecated)
-	Group                           []MeasureGroup            `json:"group,omitempty" bson:"group,omitempty"`                                                       // Population criteria group
-	SupplementalData                []MeasureSupplementalData `json:"supplementalData,omitempty" bson:"supplemental_data,omitempty"`                                // What other data should be reported with the measure
+	ResourceType                           string                    `json:"resourceType" bson:"resource_type"`                                                                     // Type of resource
+	Id                                     *string                   `json:"id,omitempty" bson:"id,omitempty"`                                                                      // Logical id of this artifact
+	Meta                                   *Meta                     `json:"meta,omitempty" bson:"meta,omitempty"`                                                                  // Metadata about the resource
+	ImplicitRules                          *string                   `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                               // A set of rules under which this content was created
+	ImplicitRulesElement                   *Element                  `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                                      // Extensions for implicitRules
+	Language                               *string                   `json:"language,omitempty" bson:"language,omitempty"`                                                          // Language of the resource content
+	LanguageElement                        *Element                  `json:"_language,omitempty" bson:"language_element,omitempty"`                                                 // Extensions for language
+	Text                                   *Narrative                `json:"text,omitempty" bson:"text,omitempty"`                                                                  // Text summary of the resource, for human interpretation
+	Contained                              []Resource                `json:"contained,omitempty" bson:"contained,omitempty"`                                                        // Contained, inline Resources
+	Extension                              []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                                                        // Additional content defined by implementations
+	ModifierExtension                      []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                                       // Extensions that cannot be ignored
+	Url                                    *string                   `json:"url,omitempty" bson:"url,omitempty"`                                                                    // Canonical identifier for this measure, represented as a URI (globally unique)
+	UrlElement                             *Element                  `json:"_url,omitempty" bson:"url_element,omitempty"`                                                           // Extensions for url
+	Identifier                             []Identifier              `json:"identifier,omitempty" bson:"identifier,omitempty"`                                                      // Additional identifier for the measure
+	Version                                *string                   `json:"version,omitempty" bson:"version,omitempty"`                                                            // Business version of the measure
+	VersionElement                         *Element                  `json:"_version,omitempty" bson:"version_element,omitempty"`                                                   // Extensions for version
+	VersionAlgorithm                       MeasureVersionAlgorithm   `json:"-" bson:"version_algorithm,omitempty"`                                                                  // How to compare versions
+	VersionAlgorithmElement                *Element                  `json:"-" bson:"version_algorithm_element,omitempty"`                                                          // Extensions for versionAlgorithm[x]
+	Name                                   *string                   `json:"name,omitempty" bson:"name,omitempty"`                                                                  // Name for this measure (computer friendly)
+	NameElement                            *Element                  `json:"_name,omitempty" bson:"name_element,omitempty"`                                                         // Extensions for name
+	Title                                  *string                   `json:"title,omitempty" bson:"title,omitempty"`                                                                // Name for this measure (human friendly)
+	TitleElement                           *Element                  `json:"_title,omitempty" bson:"title_element,omitempty"`                                                       // Extensions for title
+	Subtitle                               *string                   `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                                                          // Subordinate title of the measure
+	SubtitleElement                        *Element                  `json:"_subtitle,omitempty" bson:"subtitle_element,omitempty"`                                                 // Extensions for subtitle
+	Status                                 PublicationStatus         `json:"status" bson:"status"`                                                                                  // draft | active | retired | unknown
+	StatusElement                          *Element                  `json:"_status,omitempty" bson:"status_element,omitempty"`                                                     // Extensions for status
+	Experimental                           *bool                     `json:"experimental,omitempty" bson:"experimental,omitempty"`                                                  // For testing only - never for real usage
+	ExperimentalElement                    *Element                  `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                                         // Extensions for experimental
+	Subject                                MeasureSubject            `json:"-" bson:"subject,omitempty"`                                                                            // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
+	Date                                   *DateTime                 `json:"date,omitempty" bson:"date,omitempty"`                                                                  // Date last changed
+	DateElement                            *Element                  `json:"_date,omitempty" bson:"date_element,omitempty"`                                                         // Extensions for date
+	Publisher                              *string                   `json:"publisher,omitempty" bson:"publisher,omitempty"`                                                        // Name of the publisher/steward (organization or individual)
+	PublisherElement                       *Element                  `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                                               // Extensions for publisher
+	Contact                                []ContactDetail           `json:"contact,omitempty" bson:"contact,omitempty"`                                                            // Contact details for the publisher
+	Description                            *string                   `json:"description,omitempty" bson:"description,omitempty"`                                                    // Natural language description of the measure
+	DescriptionElement                     *Element                  `json:"_description,omitempty" bson:"description_element,omitempty"`                                           // Extensions for description
+	UseContext                             []UsageContext            `json:"useContext,omitempty" bson:"use_context,omitempty"`                                                     // The context that the content is intended to support
+	Jurisdiction                           []CodeableConcept         `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                                  // Jurisdiction of the authority that maintains the measure (if applicable)
+	Purpose                                *string                   `json:"purpose,omitempty" bson:"purpose,omitempty"`                                                            // Why this measure is defined
+	PurposeElement                         *Element                  `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                                                   // Extensions for purpose
+	Usage                                  *string                   `json:"usage,omitempty" bson:"usage,omitempty"`                                                                // Describes the clinical usage of the measure
+	UsageElement                           *Element                  `json:"_usage,omitempty" bson:"usage_element,omitempty"`                                                       // Extensions for usage
+	Copyright                              *string                   `json:"copyright,omitempty" bson:"copyright,omitempty"`                                                        // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement                       *Element                  `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                                               // Extensions for copyright
+	CopyrightLabel                         *string                   `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                                             // Copyright holder and year(s)
+	CopyrightLabelElement                  *Element                  `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`                                    // Extensions for copyrightLabel
+	ApprovalDate                           *Date                     `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                                                 // When the measure was approved by publisher
+	ApprovalDateElement                    *Element                  `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`                                        // Extensions for approvalDate
+	LastReviewDate                         *Date                     `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                                            // When the measure was last reviewed by the publisher
+	LastReviewDateElement                  *Element                  `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`                                   // Extensions for lastReviewDate
+	EffectivePeriod                        *Period                   `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                                           // When the measure is expected to be used
+	ReportingFrequency                     *Quantity                 `json:"reportingFrequency,omitempty" bson:"reporting_frequency,omitempty"`                                     // The frequency in which this measure should be reported (e.g. 1 '/a' - yearly, 4 '/a' - quarterly)
+	Topic                                  []CodeableConcept         `json:"topic,omitempty" bson:"topic,omitempty"`                                                                // The category of the measure, such as Education, Treatment, Assessment, etc
+	Author                                 []ContactDetail           `json:"author,omitempty" bson:"author,omitempty"`                                                              // Who authored the content
+	Editor                                 []ContactDetail           `json:"editor,omitempty" bson:"editor,omitempty"`                                                              // Who edited the content
+	Reviewer                               []ContactDetail           `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                                                          // Who reviewed the content
+	Endorser                               []ContactDetail           `json:"endorser,omitempty" bson:"endorser,omitempty"`                                                          // Who endorsed the content
+	RelatedArtifact                        []RelatedArtifact         `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                                           // Additional documentation, citations, etc
+	Library                                []string                  `json:"library,omitempty" bson:"library,omitempty"`                                                            // Logic used by the measure
+	LibraryElement                         []*Element                `json:"_library,omitempty" bson:"library_element,omitempty"`                                                   // Extensions for library
+	Disclaimer                             *string                   `json:"disclaimer,omitempty" bson:"disclaimer,omitempty"`                                                      // Disclaimer for use of the measure or its referenced content
+	DisclaimerElement                      *Element                  `json:"_disclaimer,omitempty" bson:"disclaimer_element,omitempty"`                                             // Extensions for disclaimer
+	RiskAdjustment                         *string                   `json:"riskAdjustment,omitempty" bson:"risk_adjustment,omitempty"`                                             // How risk adjustment is applied for this measure
+	RiskAdjustmentElement                  *Element                  `json:"_riskAdjustment,omitempty" bson:"risk_adjustment_element,omitempty"`                                    // Extensions for riskAdjustment
+	RateAggregation                        *string                   `json:"rateAggregation,omitempty" bson:"rate_aggregation,omitempty"`                                           // How is rate aggregation performed for this measure
+	RateAggregationElement                 *Element                  `json:"_rateAggregation,omitempty" bson:"rate_aggregation_element,omitempty"`                                  // Extensions for rateAggregation
+	Rationale                              *string                   `json:"rationale,omitempty" bson:"rationale,omitempty"`                                                        // Justification for the measure in terms of impact, gap in care, and evidence
+	RationaleElement                       *Element                  `json:"_rationale,omitempty" bson:"rationale_element,omitempty"`                                               // Extensions for rationale
+	ClinicalRecommendationStatement        *string                   `json:"clinicalRecommendationStatement,omitempty" bson:"clinical_recommendation_statement,omitempty"`          // Summary of clinical guidelines
+	ClinicalRecommendationStatementElement *Element                  `json:"_clinicalRecommendationStatement,omitempty" bson:"clinical_recommendation_statement_element,omitempty"` // Extensions for clinicalRecommendationStatement
+	Term                                   []MeasureTerm             `json:"term,omitempty" bson:"term,omitempty"`                                                                  // Defined terms used in the measure documentation
+	Guidance                               *string                   `json:"guidance,omitempty" bson:"guidance,omitempty"`                                                          // Additional guidance for implementers (deprecated)
+	GuidanceElement                        *Element                  `json:"_guidance,omitempty" bson:"guidance_element,omitempty"`                                                 // Extensions for guidance
+	Group                                  []MeasureGroup            `json:"group,omitempty" bson:"group,omitempty"`                                                                // Population criteria group
+	SupplementalData                       []MeasureSupplementalData `json:"supplementalData,omitempty" bson:"supplemental_data,omitempty"`                                         // What other data should be reported with the measure
+
+	versionAlgorithmVariants []string // JSON properties of Measure.versionAlgorithm[x] when more than one was decoded
+	subjectVariants          []string // JSON properties of Measure.subject[x] when more than one was decoded
 }
 
 func (r *Measure) Validate() error {
@@ -67,33 +97,119 @@ func (r *Measure) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.SubtitleElement != nil {
+		if err := r.SubtitleElement.Validate(); err != nil {
+			return fmt.Errorf("SubtitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -101,6 +217,11 @@ func (r *Measure) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -111,6 +232,46 @@ func (r *Measure) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -151,11 +312,49 @@ func (r *Measure) Validate() error {
 			return fmt.Errorf("RelatedArtifact[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LibraryElement[%d]: %w", i, err)
+		}
+	}
+	if r.DisclaimerElement != nil {
+		if err := r.DisclaimerElement.Validate(); err != nil {
+			return fmt.Errorf("DisclaimerElement: %w", err)
+		}
+	}
+	if r.RiskAdjustmentElement != nil {
+		if err := r.RiskAdjustmentElement.Validate(); err != nil {
+			return fmt.Errorf("RiskAdjustmentElement: %w", err)
+		}
+	}
+	if r.RateAggregationElement != nil {
+		if err := r.RateAggregationElement.Validate(); err != nil {
+			return fmt.Errorf("RateAggregationElement: %w", err)
+		}
+	}
+	if r.RationaleElement != nil {
+		if err := r.RationaleElement.Validate(); err != nil {
+			return fmt.Errorf("RationaleElement: %w", err)
+		}
+	}
+	if r.ClinicalRecommendationStatementElement != nil {
+		if err := r.ClinicalRecommendationStatementElement.Validate(); err != nil {
+			return fmt.Errorf("ClinicalRecommendationStatementElement: %w", err)
+		}
+	}
 	for i, item := range r.Term {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Term[%d]: %w", i, err)
 		}
 	}
+	if r.GuidanceElement != nil {
+		if err := r.GuidanceElement.Validate(); err != nil {
+			return fmt.Errorf("GuidanceElement: %w", err)
+		}
+	}
 	for i, item := range r.Group {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Group[%d]: %w", i, err)
@@ -166,238 +365,1590 @@ func (r *Measure) Validate() error {
 			return fmt.Errorf("SupplementalData[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type MeasureGroup struct {
-	Id                          *string                  `json:"id,omitempty" bson:"id,omitempty"`                                                     // Unique id for inter-element referencing
-	LinkId                      *string                  `json:"linkId,omitempty" bson:"link_id,omitempty"`                                            // Unique id for group in measure
-	Title                       *string                  `json:"title,omitempty" bson:"title,omitempty"`                                               // Title of the group. This title is expected in the corresponding MeasureReport.group.title
-	Code                        *CodeableConcept         `json:"code,omitempty" bson:"code,omitempty"`                                                 // Meaning of the group
-	Description                 *string                  `json:"description,omitempty" bson:"description,omitempty"`                                   // Summary description
-	Type                        []CodeableConcept        `json:"type,omitempty" bson:"type,omitempty"`                                                 // process | outcome | structure | patient-reported-outcome
-	SubjectCodeableConcept      *CodeableConcept         `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"`           // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
-	SubjectReference            *Reference               `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`                        // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
-	Basis                       *string                  `json:"basis,omitempty" bson:"basis,omitempty"`                                               // Population basis
-	BasisRequirement            []DataRequirement        `json:"basisRequirement,omitempty" bson:"basis_requirement,omitempty"`                        // Population basis requirements
-	Scoring                     *CodeableConcept         `json:"scoring,omitempty" bson:"scoring,omitempty"`                                           // proportion | ratio | continuous-variable | cohort | composite
-	ScoringUnit                 *CodeableConcept         `json:"scoringUnit,omitempty" bson:"scoring_unit,omitempty"`                                  // What units?
-	ScoringPrecision            *int                     `json:"scoringPrecision,omitempty" bson:"scoring_precision,omitempty"`                        // How many decimals (The number of decimal places to include in the score when the score is a decimal-valued result)
-	CompositeScoring            *CodeableConcept         `json:"compositeScoring,omitempty" bson:"composite_scoring,omitempty"`                        // opportunity | all-or-nothing | linear | weighted
-	Component                   []MeasureGroupComponent  `json:"component,omitempty" bson:"component,omitempty"`                                       // A component of a composite measure
-	RateAggregation             *string                  `json:"rateAggregation,omitempty" bson:"rate_aggregation,omitempty"`                          // How is rate aggregation performed for this measure
-	ImprovementNotation         *CodeableConcept         `json:"improvementNotation,omitempty" bson:"improvement_notation,omitempty"`                  // increase | decrease
-	ImprovementNotationGuidance *string                  `json:"improvementNotationGuidance,omitempty" bson:"improvement_notation_guidance,omitempty"` // Explanation of improvement notation
-	Library                     []string                 `json:"library,omitempty" bson:"library,omitempty"`                                           // Logic used by the measure group
-	Population                  []MeasureGroupPopulation `json:"population,omitempty" bson:"population,omitempty"`                                     // Population criteria
-	Stratifier                  []MeasureGroupStratifier `json:"stratifier,omitempty" bson:"stratifier,omitempty"`                                     // Stratifier criteria for the measure
+	return validateInvariants(r, "Measure")
 }
 
-func (r *MeasureGroup) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+func (r *Measure) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Measure" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Measure', got '%s'", r.ResourceType))
 	}
-	for i, item := range r.Type {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Type[%d]: %w", i, err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	for i, item := range r.BasisRequirement {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("BasisRequirement[%d]: %w", i, err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.Scoring != nil {
-		if err := r.Scoring.Validate(); err != nil {
-			return fmt.Errorf("Scoring: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.ScoringUnit != nil {
-		if err := r.ScoringUnit.Validate(); err != nil {
-			return fmt.Errorf("ScoringUnit: %w", err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.CompositeScoring != nil {
-		if err := r.CompositeScoring.Validate(); err != nil {
-			return fmt.Errorf("CompositeScoring: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	for i, item := range r.Component {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Component[%d]: %w", i, err)
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.SubtitleElement != nil {
+		r.SubtitleElement.validateAll(path+".subtitle", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.ImprovementNotation != nil {
-		if err := r.ImprovementNotation.Validate(); err != nil {
-			return fmt.Errorf("ImprovementNotation: %w", err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	for i, item := range r.Population {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Population[%d]: %w", i, err)
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
 		}
 	}
-	for i, item := range r.Stratifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Stratifier[%d]: %w", i, err)
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	if r.ReportingFrequency != nil {
+		r.ReportingFrequency.validateAll(path+".reportingFrequency", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.library[%d]", path, i), issues)
+	}
+	if r.DisclaimerElement != nil {
+		r.DisclaimerElement.validateAll(path+".disclaimer", issues)
+	}
+	if r.RiskAdjustmentElement != nil {
+		r.RiskAdjustmentElement.validateAll(path+".riskAdjustment", issues)
+	}
+	if r.RateAggregationElement != nil {
+		r.RateAggregationElement.validateAll(path+".rateAggregation", issues)
+	}
+	if r.RationaleElement != nil {
+		r.RationaleElement.validateAll(path+".rationale", issues)
+	}
+	if r.ClinicalRecommendationStatementElement != nil {
+		r.ClinicalRecommendationStatementElement.validateAll(path+".clinicalRecommendationStatement", issues)
+	}
+	for i, item := range r.Term {
+		item.validateAll(fmt.Sprintf("%s.term[%d]", path, i), issues)
+	}
+	if r.GuidanceElement != nil {
+		r.GuidanceElement.validateAll(path+".guidance", issues)
+	}
+	for i, item := range r.Group {
+		item.validateAll(fmt.Sprintf("%s.group[%d]", path, i), issues)
+	}
+	for i, item := range r.SupplementalData {
+		item.validateAll(fmt.Sprintf("%s.supplementalData[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type MeasureGroupPopulation struct {
-	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
-	LinkId            *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                        // Unique id for population in measure
-	Title             *string          `json:"title,omitempty" bson:"title,omitempty"`                           // Title of the group's population. This title is expected in the corresponding MeasureReport.group.population.title
-	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                             // initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
-	Description       *string          `json:"description,omitempty" bson:"description,omitempty"`               // The human readable description of this population criteria
-	Criteria          *Expression      `json:"criteria,omitempty" bson:"criteria,omitempty"`                     // The criteria that defines this population
-	GroupDefinition   *Reference       `json:"groupDefinition,omitempty" bson:"group_definition,omitempty"`      // A group resource that defines this population
-	InputPopulationId *string          `json:"inputPopulationId,omitempty" bson:"input_population_id,omitempty"` // Which population
-	AggregateMethod   *CodeableConcept `json:"aggregateMethod,omitempty" bson:"aggregate_method,omitempty"`      // Aggregation method for a measure score (e.g. sum, average, median, minimum, maximum, count)
+func (r *Measure) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Measure", &issues)
+	checkInvariants(r, "Measure", &issues)
+	return issues
 }
 
-func (r *MeasureGroupPopulation) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
-	if r.Criteria != nil {
-		if err := r.Criteria.Validate(); err != nil {
-			return fmt.Errorf("Criteria: %w", err)
-		}
+var measureInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Measure) invariants() []invariant {
+	return measureInvariants
+}
+
+func (r Measure) MarshalJSON() ([]byte, error) {
+	type alias Measure
+	out := struct {
+		alias
+		Library        []*string  `json:"library,omitempty"`
+		LibraryElement []*Element `json:"_library,omitempty"`
+	}{alias: alias(r)}
+	out.Library, out.LibraryElement = alignPrimitiveArray(r.Library, r.LibraryElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.GroupDefinition != nil {
-		if err := r.GroupDefinition.Validate(); err != nil {
-			return fmt.Errorf("GroupDefinition: %w", err)
-		}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	if r.AggregateMethod != nil {
-		if err := r.AggregateMethod.Validate(); err != nil {
-			return fmt.Errorf("AggregateMethod: %w", err)
-		}
+	if data, err = marshalChoice(data, "subject", r.Subject, nil); err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-type MeasureGroupStratifier struct {
-	Id              *string                           `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	LinkId          *string                           `json:"linkId,omitempty" bson:"link_id,omitempty"`                   // Unique id for stratifier in measure
-	Title           *string                           `json:"title,omitempty" bson:"title,omitempty"`                      // Title of a group's stratifier. This title is expected in the corresponding MeasureReport.group.title
-	Code            *CodeableConcept                  `json:"code,omitempty" bson:"code,omitempty"`                        // Meaning of the stratifier
-	Description     *string                           `json:"description,omitempty" bson:"description,omitempty"`          // The human readable description of this stratifier
-	Criteria        *Expression                       `json:"criteria,omitempty" bson:"criteria,omitempty"`                // How the measure should be stratified
-	GroupDefinition *Reference                        `json:"groupDefinition,omitempty" bson:"group_definition,omitempty"` // A group resource that defines this population
-	Component       []MeasureGroupStratifierComponent `json:"component,omitempty" bson:"component,omitempty"`              // Stratifier criteria component for the measure
+	return data, nil
 }
 
-func (r *MeasureGroupStratifier) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+func (r *Measure) UnmarshalJSON(data []byte) error {
+	type alias Measure
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Library   []*string         `json:"library,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.Criteria != nil {
-		if err := r.Criteria.Validate(); err != nil {
-			return fmt.Errorf("Criteria: %w", err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.GroupDefinition != nil {
-		if err := r.GroupDefinition.Validate(); err != nil {
-			return fmt.Errorf("GroupDefinition: %w", err)
-		}
+	if r.Library, err = splitPrimitiveArray(aux.Library, r.LibraryElement); err != nil {
+		return fmt.Errorf("library: %w", err)
 	}
-	for i, item := range r.Component {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Component[%d]: %w", i, err)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", measureVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
+	if r.Subject, _, r.subjectVariants, err = unmarshalChoice(fields, "subject", measureSubjectVariants); err != nil {
+		return err
+	}
 	return nil
 }
 
-type MeasureGroupStratifierComponent struct {
-	Id              *string          `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	LinkId          *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                   // Unique id for stratifier component in measure
-	Code            *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                        // Meaning of the stratifier component
-	Description     *string          `json:"description,omitempty" bson:"description,omitempty"`          // The human readable description of this stratifier component
-	Criteria        *Expression      `json:"criteria,omitempty" bson:"criteria,omitempty"`                // Component of how the measure should be stratified
-	GroupDefinition *Reference       `json:"groupDefinition,omitempty" bson:"group_definition,omitempty"` // A group resource that defines this population
-	ValueSet        *string          `json:"valueSet,omitempty" bson:"value_set,omitempty"`               // What stratum values?
-	Unit            *string          `json:"unit,omitempty" bson:"unit,omitempty"`                        // What units?
+func (r Measure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *MeasureGroupStratifierComponent) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
-	if r.Criteria != nil {
-		if err := r.Criteria.Validate(); err != nil {
-			return fmt.Errorf("Criteria: %w", err)
-		}
-	}
-	if r.GroupDefinition != nil {
-		if err := r.GroupDefinition.Validate(); err != nil {
-			return fmt.Errorf("GroupDefinition: %w", err)
-		}
-	}
-	return nil
+func (r *Measure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type MeasureTerm struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Code       *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`             // What term?
-	Definition *string          `json:"definition,omitempty" bson:"definition,omitempty"` // Meaning of the term
+func (r Measure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *MeasureTerm) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+func (r *Measure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Measure) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(measureVersionAlgorithmVariants)
+	case "Subject":
+		return "subject", choiceValues(measureSubjectVariants)
 	}
-	return nil
+	return "", nil
 }
 
-type MeasureGroupComponent struct {
-	Id      *string  `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	Measure *string  `json:"measure,omitempty" bson:"measure,omitempty"`  // What measure?
-	GroupId *string  `json:"groupId,omitempty" bson:"group_id,omitempty"` // What group?
-	Weight  *float64 `json:"weight,omitempty" bson:"weight,omitempty"`    // What weight?
+var measureElementTypes = map[string]string{
+	"id":                              "id",
+	"implicitRules":                   "uri",
+	"language":                        "code",
+	"url":                             "uri",
+	"description":                     "markdown",
+	"purpose":                         "markdown",
+	"usage":                           "markdown",
+	"copyright":                       "markdown",
+	"library":                         "canonical",
+	"disclaimer":                      "markdown",
+	"riskAdjustment":                  "markdown",
+	"rateAggregation":                 "markdown",
+	"rationale":                       "markdown",
+	"clinicalRecommendationStatement": "markdown",
+	"guidance":                        "markdown",
 }
 
-func (r *MeasureGroupComponent) Validate() error {
-	return nil
+func (r *Measure) elementTypes() map[string]string {
+	return measureElementTypes
 }
 
-type MeasureSupplementalData struct {
-	Id          *string           `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	LinkId      *string           `json:"linkId,omitempty" bson:"link_id,omitempty"`          // Unique id for supplementalData in measure
-	Code        *CodeableConcept  `json:"code,omitempty" bson:"code,omitempty"`               // Meaning of the supplemental data
-	Usage       []CodeableConcept `json:"usage,omitempty" bson:"usage,omitempty"`             // supplemental-data | risk-adjustment-factor
-	Description *string           `json:"description,omitempty" bson:"description,omitempty"` // The human readable description of this supplemental data
-	Criteria    *Expression       `json:"criteria" bson:"criteria"`                           // Expression describing additional data to be reported
-	ValueSet    *string           `json:"valueSet,omitempty" bson:"value_set,omitempty"`      // What supplemental data values?
-	Unit        *string           `json:"unit,omitempty" bson:"unit,omitempty"`               // What units?
+var measureElementMetadata = []ElementMetadata{
+	{Path: "Measure.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Measure.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Measure.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Measure.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Measure.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Measure.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Measure.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Measure.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Measure.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Measure.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "Measure.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.subtitle", Name: "subtitle", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Measure.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Measure.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Measure.subject[x]", Name: "subject", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Measure.date", Name: "date", Field: 29, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Measure.publisher", Name: "publisher", Field: 31, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.contact", Name: "contact", Field: 33, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Measure.description", Name: "description", Field: 34, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.useContext", Name: "useContext", Field: 36, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Measure.jurisdiction", Name: "jurisdiction", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.purpose", Name: "purpose", Field: 38, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.usage", Name: "usage", Field: 40, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.copyright", Name: "copyright", Field: 42, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.copyrightLabel", Name: "copyrightLabel", Field: 44, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.approvalDate", Name: "approvalDate", Field: 46, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Measure.lastReviewDate", Name: "lastReviewDate", Field: 48, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Measure.effectivePeriod", Name: "effectivePeriod", Field: 50, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Measure.reportingFrequency", Name: "reportingFrequency", Field: 51, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Measure.topic", Name: "topic", Field: 52, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.author", Name: "author", Field: 53, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Measure.editor", Name: "editor", Field: 54, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Measure.reviewer", Name: "reviewer", Field: 55, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Measure.endorser", Name: "endorser", Field: 56, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Measure.relatedArtifact", Name: "relatedArtifact", Field: 57, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "Measure.library", Name: "library", Field: 58, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "Measure.disclaimer", Name: "disclaimer", Field: 60, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.riskAdjustment", Name: "riskAdjustment", Field: 62, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.rateAggregation", Name: "rateAggregation", Field: 64, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.rationale", Name: "rationale", Field: 66, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.clinicalRecommendationStatement", Name: "clinicalRecommendationStatement", Field: 68, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.term", Name: "term", Field: 70, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Measure.guidance", Name: "guidance", Field: 71, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group", Name: "group", Field: 73, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Measure.supplementalData", Name: "supplementalData", Field: 74, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
 }
 
-func (r *MeasureSupplementalData) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
+func (r *Measure) ElementMetadata() []ElementMetadata {
+	return measureElementMetadata
+}
+
+// MeasureVersionAlgorithm is the type of Measure.versionAlgorithm[x]. It is implemented by the MeasureVersionAlgorithm* variant types.
+type MeasureVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureVersionAlgorithm()
+}
+
+// MeasureVersionAlgorithmString is the string variant of MeasureVersionAlgorithm.
+type MeasureVersionAlgorithmString string
+
+func (v MeasureVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v MeasureVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MeasureVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v MeasureVersionAlgorithmString) isMeasureVersionAlgorithm() {}
+
+// MeasureVersionAlgorithmCoding is the Coding variant of MeasureVersionAlgorithm.
+type MeasureVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v MeasureVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v MeasureVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v MeasureVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v MeasureVersionAlgorithmCoding) isMeasureVersionAlgorithm() {}
+
+var measureVersionAlgorithmVariants = []MeasureVersionAlgorithm{
+	MeasureVersionAlgorithmString(""),
+	MeasureVersionAlgorithmCoding{},
+}
+
+// MeasureSubject is the type of Measure.subject[x]. It is implemented by the MeasureSubject* variant types.
+type MeasureSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureSubject()
+}
+
+// MeasureSubjectCodeableConcept is the CodeableConcept variant of MeasureSubject.
+type MeasureSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MeasureSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MeasureSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MeasureSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MeasureSubjectCodeableConcept) isMeasureSubject() {}
+
+// MeasureSubjectReference is the Reference variant of MeasureSubject.
+type MeasureSubjectReference struct {
+	Reference
+}
+
+func (v MeasureSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v MeasureSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v MeasureSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v MeasureSubjectReference) isMeasureSubject() {}
+
+var measureSubjectVariants = []MeasureSubject{
+	MeasureSubjectCodeableConcept{},
+	MeasureSubjectReference{},
+}
+
+func (r *Measure) GetResourceType() string {
+	return "Measure"
+}
+
+func (r *Measure) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Measure) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Measure) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Measure) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Measure) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Measure) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Measure) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Measure)(nil)
+
+type MeasureTerm struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // What term?
+	Definition        *string          `json:"definition,omitempty" bson:"definition,omitempty"`                // Meaning of the term
+	DefinitionElement *Element         `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+}
+
+func (r *MeasureTerm) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureTerm) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+}
+
+var measureTermInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureTerm) invariants() []invariant {
+	return measureTermInvariants
+}
+
+func (r MeasureTerm) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureTerm) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureTerm) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureTerm) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureTermElementTypes = map[string]string{
+	"definition": "markdown",
+}
+
+func (r *MeasureTerm) elementTypes() map[string]string {
+	return measureTermElementTypes
+}
+
+var measureTermElementMetadata = []ElementMetadata{
+	{Path: "Measure.term.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.term.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.term.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.term.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.term.definition", Name: "definition", Field: 4, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *MeasureTerm) ElementMetadata() []ElementMetadata {
+	return measureTermElementMetadata
+}
+
+type MeasureGroup struct {
+	Id                                 *string                  `json:"id,omitempty" bson:"id,omitempty"`                                                              // Unique id for inter-element referencing
+	Extension                          []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                                                // Additional content defined by implementations
+	ModifierExtension                  []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                               // Extensions that cannot be ignored even if unrecognized
+	LinkId                             *string                  `json:"linkId,omitempty" bson:"link_id,omitempty"`                                                     // Unique id for group in measure
+	LinkIdElement                      *Element                 `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                                            // Extensions for linkId
+	Title                              *string                  `json:"title,omitempty" bson:"title,omitempty"`                                                        // Title of the group. This title is expected in the corresponding MeasureReport.group.title
+	TitleElement                       *Element                 `json:"_title,omitempty" bson:"title_element,omitempty"`                                               // Extensions for title
+	Code                               *CodeableConcept         `json:"code,omitempty" bson:"code,omitempty"`                                                          // Meaning of the group
+	Description                        *string                  `json:"description,omitempty" bson:"description,omitempty"`                                            // Summary description
+	DescriptionElement                 *Element                 `json:"_description,omitempty" bson:"description_element,omitempty"`                                   // Extensions for description
+	Type                               []CodeableConcept        `json:"type,omitempty" bson:"type,omitempty"`                                                          // process | outcome | structure | patient-reported-outcome
+	Subject                            MeasureGroupSubject      `json:"-" bson:"subject,omitempty"`                                                                    // E.g. Patient, Practitioner, RelatedPerson, Organization, Location, Device
+	Basis                              *string                  `json:"basis,omitempty" bson:"basis,omitempty"`                                                        // Population basis
+	BasisElement                       *Element                 `json:"_basis,omitempty" bson:"basis_element,omitempty"`                                               // Extensions for basis
+	BasisRequirement                   []DataRequirement        `json:"basisRequirement,omitempty" bson:"basis_requirement,omitempty"`                                 // Population basis requirements
+	Scoring                            *CodeableConcept         `json:"scoring,omitempty" bson:"scoring,omitempty"`                                                    // proportion | ratio | continuous-variable | cohort | composite
+	ScoringUnit                        *CodeableConcept         `json:"scoringUnit,omitempty" bson:"scoring_unit,omitempty"`                                           // What units?
+	ScoringPrecision                   *int                     `json:"scoringPrecision,omitempty" bson:"scoring_precision,omitempty"`                                 // How many decimals (The number of decimal places to include in the score when the score is a decimal-valued result)
+	ScoringPrecisionElement            *Element                 `json:"_scoringPrecision,omitempty" bson:"scoring_precision_element,omitempty"`                        // Extensions for scoringPrecision
+	CompositeScoring                   *CodeableConcept         `json:"compositeScoring,omitempty" bson:"composite_scoring,omitempty"`                                 // opportunity | all-or-nothing | linear | weighted
+	Component                          []MeasureGroupComponent  `json:"component,omitempty" bson:"component,omitempty"`                                                // A component of a composite measure
+	RateAggregation                    *string                  `json:"rateAggregation,omitempty" bson:"rate_aggregation,omitempty"`                                   // How is rate aggregation performed for this measure
+	RateAggregationElement             *Element                 `json:"_rateAggregation,omitempty" bson:"rate_aggregation_element,omitempty"`                          // Extensions for rateAggregation
+	ImprovementNotation                *CodeableConcept         `json:"improvementNotation,omitempty" bson:"improvement_notation,omitempty"`                           // increase | decrease
+	ImprovementNotationGuidance        *string                  `json:"improvementNotationGuidance,omitempty" bson:"improvement_notation_guidance,omitempty"`          // Explanation of improvement notation
+	ImprovementNotationGuidanceElement *Element                 `json:"_improvementNotationGuidance,omitempty" bson:"improvement_notation_guidance_element,omitempty"` // Extensions for improvementNotationGuidance
+	Library                            []string                 `json:"library,omitempty" bson:"library,omitempty"`                                                    // Logic used by the measure group
+	LibraryElement                     []*Element               `json:"_library,omitempty" bson:"library_element,omitempty"`                                           // Extensions for library
+	Population                         []MeasureGroupPopulation `json:"population,omitempty" bson:"population,omitempty"`                                              // Population criteria
+	Stratifier                         []MeasureGroupStratifier `json:"stratifier,omitempty" bson:"stratifier,omitempty"`                                              // Stratifier criteria for the measure
+
+	subjectVariants []string // JSON properties of Measure.group.subject[x] when more than one was decoded
+}
+
+func (r *MeasureGroup) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Type {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Type[%d]: %w", i, err)
+		}
+	}
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.BasisElement != nil {
+		if err := r.BasisElement.Validate(); err != nil {
+			return fmt.Errorf("BasisElement: %w", err)
+		}
+	}
+	for i, item := range r.BasisRequirement {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BasisRequirement[%d]: %w", i, err)
+		}
+	}
+	if r.Scoring != nil {
+		if err := r.Scoring.Validate(); err != nil {
+			return fmt.Errorf("Scoring: %w", err)
+		}
+	}
+	if r.ScoringUnit != nil {
+		if err := r.ScoringUnit.Validate(); err != nil {
+			return fmt.Errorf("ScoringUnit: %w", err)
+		}
+	}
+	if r.ScoringPrecisionElement != nil {
+		if err := r.ScoringPrecisionElement.Validate(); err != nil {
+			return fmt.Errorf("ScoringPrecisionElement: %w", err)
+		}
+	}
+	if r.CompositeScoring != nil {
+		if err := r.CompositeScoring.Validate(); err != nil {
+			return fmt.Errorf("CompositeScoring: %w", err)
+		}
+	}
+	for i, item := range r.Component {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Component[%d]: %w", i, err)
+		}
+	}
+	if r.RateAggregationElement != nil {
+		if err := r.RateAggregationElement.Validate(); err != nil {
+			return fmt.Errorf("RateAggregationElement: %w", err)
+		}
+	}
+	if r.ImprovementNotation != nil {
+		if err := r.ImprovementNotation.Validate(); err != nil {
+			return fmt.Errorf("ImprovementNotation: %w", err)
+		}
+	}
+	if r.ImprovementNotationGuidanceElement != nil {
+		if err := r.ImprovementNotationGuidanceElement.Validate(); err != nil {
+			return fmt.Errorf("ImprovementNotationGuidanceElement: %w", err)
+		}
+	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LibraryElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Population {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Population[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Stratifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Stratifier[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureGroup) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.BasisElement != nil {
+		r.BasisElement.validateAll(path+".basis", issues)
+	}
+	for i, item := range r.BasisRequirement {
+		item.validateAll(fmt.Sprintf("%s.basisRequirement[%d]", path, i), issues)
+	}
+	if r.Scoring != nil {
+		r.Scoring.validateAll(path+".scoring", issues)
+	}
+	if r.ScoringUnit != nil {
+		r.ScoringUnit.validateAll(path+".scoringUnit", issues)
+	}
+	if r.ScoringPrecisionElement != nil {
+		r.ScoringPrecisionElement.validateAll(path+".scoringPrecision", issues)
+	}
+	if r.CompositeScoring != nil {
+		r.CompositeScoring.validateAll(path+".compositeScoring", issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+	if r.RateAggregationElement != nil {
+		r.RateAggregationElement.validateAll(path+".rateAggregation", issues)
+	}
+	if r.ImprovementNotation != nil {
+		r.ImprovementNotation.validateAll(path+".improvementNotation", issues)
+	}
+	if r.ImprovementNotationGuidanceElement != nil {
+		r.ImprovementNotationGuidanceElement.validateAll(path+".improvementNotationGuidance", issues)
+	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.library[%d]", path, i), issues)
+	}
+	for i, item := range r.Population {
+		item.validateAll(fmt.Sprintf("%s.population[%d]", path, i), issues)
+	}
+	for i, item := range r.Stratifier {
+		item.validateAll(fmt.Sprintf("%s.stratifier[%d]", path, i), issues)
+	}
+}
+
+var measureGroupInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureGroup) invariants() []invariant {
+	return measureGroupInvariants
+}
+
+func (r MeasureGroup) MarshalJSON() ([]byte, error) {
+	type alias MeasureGroup
+	out := struct {
+		alias
+		Library        []*string  `json:"library,omitempty"`
+		LibraryElement []*Element `json:"_library,omitempty"`
+	}{alias: alias(r)}
+	out.Library, out.LibraryElement = alignPrimitiveArray(r.Library, r.LibraryElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MeasureGroup) UnmarshalJSON(data []byte) error {
+	type alias MeasureGroup
+	aux := struct {
+		*alias
+		Library []*string `json:"library,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Library, err = splitPrimitiveArray(aux.Library, r.LibraryElement); err != nil {
+		return fmt.Errorf("library: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Subject, _, r.subjectVariants, err = unmarshalChoice(fields, "subject", measureGroupSubjectVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r MeasureGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureGroup) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MeasureGroup) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Subject":
+		return "subject", choiceValues(measureGroupSubjectVariants)
+	}
+	return "", nil
+}
+
+var measureGroupElementTypes = map[string]string{
+	"description":                 "markdown",
+	"basis":                       "code",
+	"scoringPrecision":            "positiveInt",
+	"rateAggregation":             "markdown",
+	"improvementNotationGuidance": "markdown",
+	"library":                     "canonical",
+}
+
+func (r *MeasureGroup) elementTypes() map[string]string {
+	return measureGroupElementTypes
+}
+
+var measureGroupElementMetadata = []ElementMetadata{
+	{Path: "Measure.group.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.group.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.group.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.code", Name: "code", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group.type", Name: "type", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.subject[x]", Name: "subject", Field: 11, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "Measure.group.basis", Name: "basis", Field: 12, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "Measure.group.basisRequirement", Name: "basisRequirement", Field: 14, Min: 0, Max: "*", Types: []string{"DataRequirement"}},
+	{Path: "Measure.group.scoring", Name: "scoring", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.scoringUnit", Name: "scoringUnit", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.scoringPrecision", Name: "scoringPrecision", Field: 17, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Measure.group.compositeScoring", Name: "compositeScoring", Field: 19, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.component", Name: "component", Field: 20, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Measure.group.rateAggregation", Name: "rateAggregation", Field: 21, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group.improvementNotation", Name: "improvementNotation", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.improvementNotationGuidance", Name: "improvementNotationGuidance", Field: 24, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group.library", Name: "library", Field: 26, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "Measure.group.population", Name: "population", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Measure.group.stratifier", Name: "stratifier", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MeasureGroup) ElementMetadata() []ElementMetadata {
+	return measureGroupElementMetadata
+}
+
+// MeasureGroupSubject is the type of Measure.group.subject[x]. It is implemented by the MeasureGroupSubject* variant types.
+type MeasureGroupSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureGroupSubject()
+}
+
+// MeasureGroupSubjectCodeableConcept is the CodeableConcept variant of MeasureGroupSubject.
+type MeasureGroupSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MeasureGroupSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MeasureGroupSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MeasureGroupSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MeasureGroupSubjectCodeableConcept) isMeasureGroupSubject() {}
+
+// MeasureGroupSubjectReference is the Reference variant of MeasureGroupSubject.
+type MeasureGroupSubjectReference struct {
+	Reference
+}
+
+func (v MeasureGroupSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v MeasureGroupSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v MeasureGroupSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v MeasureGroupSubjectReference) isMeasureGroupSubject() {}
+
+var measureGroupSubjectVariants = []MeasureGroupSubject{
+	MeasureGroupSubjectCodeableConcept{},
+	MeasureGroupSubjectReference{},
+}
+
+type MeasureGroupComponent struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Measure           *string     `json:"measure,omitempty" bson:"measure,omitempty"`                      // What measure?
+	MeasureElement    *Element    `json:"_measure,omitempty" bson:"measure_element,omitempty"`             // Extensions for measure
+	GroupId           *string     `json:"groupId,omitempty" bson:"group_id,omitempty"`                     // What group?
+	GroupIdElement    *Element    `json:"_groupId,omitempty" bson:"group_id_element,omitempty"`            // Extensions for groupId
+	Weight            *Decimal    `json:"weight,omitempty" bson:"weight,omitempty"`                        // What weight?
+	WeightElement     *Element    `json:"_weight,omitempty" bson:"weight_element,omitempty"`               // Extensions for weight
+}
+
+func (r *MeasureGroupComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.MeasureElement != nil {
+		if err := r.MeasureElement.Validate(); err != nil {
+			return fmt.Errorf("MeasureElement: %w", err)
+		}
+	}
+	if r.GroupIdElement != nil {
+		if err := r.GroupIdElement.Validate(); err != nil {
+			return fmt.Errorf("GroupIdElement: %w", err)
+		}
+	}
+	if r.Weight != nil {
+		if err := r.Weight.Validate(); err != nil {
+			return fmt.Errorf("Weight: %w", err)
+		}
+	}
+	if r.WeightElement != nil {
+		if err := r.WeightElement.Validate(); err != nil {
+			return fmt.Errorf("WeightElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureGroupComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.MeasureElement != nil {
+		r.MeasureElement.validateAll(path+".measure", issues)
+	}
+	if r.GroupIdElement != nil {
+		r.GroupIdElement.validateAll(path+".groupId", issues)
+	}
+	if r.Weight != nil {
+		if err := r.Weight.Validate(); err != nil {
+			issues.add("value", path+".weight", err.Error())
+		}
+	}
+	if r.WeightElement != nil {
+		r.WeightElement.validateAll(path+".weight", issues)
+	}
+}
+
+var measureGroupComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureGroupComponent) invariants() []invariant {
+	return measureGroupComponentInvariants
+}
+
+func (r MeasureGroupComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureGroupComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureGroupComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureGroupComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureGroupComponentElementTypes = map[string]string{
+	"measure": "canonical",
+}
+
+func (r *MeasureGroupComponent) elementTypes() map[string]string {
+	return measureGroupComponentElementTypes
+}
+
+var measureGroupComponentElementMetadata = []ElementMetadata{
+	{Path: "Measure.group.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.group.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.group.component.measure", Name: "measure", Field: 3, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Measure.group.component.groupId", Name: "groupId", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.component.weight", Name: "weight", Field: 7, Min: 0, Max: "1", Types: []string{"decimal"}},
+}
+
+func (r *MeasureGroupComponent) ElementMetadata() []ElementMetadata {
+	return measureGroupComponentElementMetadata
+}
+
+type MeasureGroupPopulation struct {
+	Id                       *string          `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
+	Extension                []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored even if unrecognized
+	LinkId                   *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                                 // Unique id for population in measure
+	LinkIdElement            *Element         `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                        // Extensions for linkId
+	Title                    *string          `json:"title,omitempty" bson:"title,omitempty"`                                    // Title of the group's population. This title is expected in the corresponding MeasureReport.group.population.title
+	TitleElement             *Element         `json:"_title,omitempty" bson:"title_element,omitempty"`                           // Extensions for title
+	Code                     *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                                      // initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
+	Description              *string          `json:"description,omitempty" bson:"description,omitempty"`                        // The human readable description of this population criteria
+	DescriptionElement       *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`               // Extensions for description
+	Criteria                 *Expression      `json:"criteria,omitempty" bson:"criteria,omitempty"`                              // The criteria that defines this population
+	GroupDefinition          *Reference       `json:"groupDefinition,omitempty" bson:"group_definition,omitempty"`               // A group resource that defines this population
+	InputPopulationId        *string          `json:"inputPopulationId,omitempty" bson:"input_population_id,omitempty"`          // Which population
+	InputPopulationIdElement *Element         `json:"_inputPopulationId,omitempty" bson:"input_population_id_element,omitempty"` // Extensions for inputPopulationId
+	AggregateMethod          *CodeableConcept `json:"aggregateMethod,omitempty" bson:"aggregate_method,omitempty"`               // Aggregation method for a measure score (e.g. sum, average, median, minimum, maximum, count)
+}
+
+func (r *MeasureGroupPopulation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Criteria != nil {
+		if err := r.Criteria.Validate(); err != nil {
+			return fmt.Errorf("Criteria: %w", err)
+		}
+	}
+	if r.GroupDefinition != nil {
+		if err := r.GroupDefinition.Validate(); err != nil {
+			return fmt.Errorf("GroupDefinition: %w", err)
+		}
+	}
+	if r.InputPopulationIdElement != nil {
+		if err := r.InputPopulationIdElement.Validate(); err != nil {
+			return fmt.Errorf("InputPopulationIdElement: %w", err)
+		}
+	}
+	if r.AggregateMethod != nil {
+		if err := r.AggregateMethod.Validate(); err != nil {
+			return fmt.Errorf("AggregateMethod: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureGroupPopulation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Criteria != nil {
+		r.Criteria.validateAll(path+".criteria", issues)
+	}
+	if r.GroupDefinition != nil {
+		r.GroupDefinition.validateAll(path+".groupDefinition", issues)
+	}
+	if r.InputPopulationIdElement != nil {
+		r.InputPopulationIdElement.validateAll(path+".inputPopulationId", issues)
+	}
+	if r.AggregateMethod != nil {
+		r.AggregateMethod.validateAll(path+".aggregateMethod", issues)
+	}
+}
+
+var measureGroupPopulationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureGroupPopulation) invariants() []invariant {
+	return measureGroupPopulationInvariants
+}
+
+func (r MeasureGroupPopulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureGroupPopulation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureGroupPopulation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureGroupPopulation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureGroupPopulationElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *MeasureGroupPopulation) elementTypes() map[string]string {
+	return measureGroupPopulationElementTypes
+}
+
+var measureGroupPopulationElementMetadata = []ElementMetadata{
+	{Path: "Measure.group.population.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.population.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.group.population.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.group.population.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.population.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.population.code", Name: "code", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.population.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group.population.criteria", Name: "criteria", Field: 10, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "Measure.group.population.groupDefinition", Name: "groupDefinition", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Measure.group.population.inputPopulationId", Name: "inputPopulationId", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.population.aggregateMethod", Name: "aggregateMethod", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MeasureGroupPopulation) ElementMetadata() []ElementMetadata {
+	return measureGroupPopulationElementMetadata
+}
+
+type MeasureGroupStratifier struct {
+	Id                 *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId             *string                           `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Unique id for stratifier in measure
+	LinkIdElement      *Element                          `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Title              *string                           `json:"title,omitempty" bson:"title,omitempty"`                          // Title of a group's stratifier. This title is expected in the corresponding MeasureReport.group.title
+	TitleElement       *Element                          `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Code               *CodeableConcept                  `json:"code,omitempty" bson:"code,omitempty"`                            // Meaning of the stratifier
+	Description        *string                           `json:"description,omitempty" bson:"description,omitempty"`              // The human readable description of this stratifier
+	DescriptionElement *Element                          `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Criteria           *Expression                       `json:"criteria,omitempty" bson:"criteria,omitempty"`                    // How the measure should be stratified
+	GroupDefinition    *Reference                        `json:"groupDefinition,omitempty" bson:"group_definition,omitempty"`     // A group resource that defines this population
+	Component          []MeasureGroupStratifierComponent `json:"component,omitempty" bson:"component,omitempty"`                  // Stratifier criteria component for the measure
+}
+
+func (r *MeasureGroupStratifier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Criteria != nil {
+		if err := r.Criteria.Validate(); err != nil {
+			return fmt.Errorf("Criteria: %w", err)
+		}
+	}
+	if r.GroupDefinition != nil {
+		if err := r.GroupDefinition.Validate(); err != nil {
+			return fmt.Errorf("GroupDefinition: %w", err)
+		}
+	}
+	for i, item := range r.Component {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Component[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureGroupStratifier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Criteria != nil {
+		r.Criteria.validateAll(path+".criteria", issues)
+	}
+	if r.GroupDefinition != nil {
+		r.GroupDefinition.validateAll(path+".groupDefinition", issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+var measureGroupStratifierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureGroupStratifier) invariants() []invariant {
+	return measureGroupStratifierInvariants
+}
+
+func (r MeasureGroupStratifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureGroupStratifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureGroupStratifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureGroupStratifier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureGroupStratifierElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *MeasureGroupStratifier) elementTypes() map[string]string {
+	return measureGroupStratifierElementTypes
+}
+
+var measureGroupStratifierElementMetadata = []ElementMetadata{
+	{Path: "Measure.group.stratifier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.stratifier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.group.stratifier.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.group.stratifier.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.stratifier.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.stratifier.code", Name: "code", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.stratifier.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group.stratifier.criteria", Name: "criteria", Field: 10, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "Measure.group.stratifier.groupDefinition", Name: "groupDefinition", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Measure.group.stratifier.component", Name: "component", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MeasureGroupStratifier) ElementMetadata() []ElementMetadata {
+	return measureGroupStratifierElementMetadata
+}
+
+type MeasureGroupStratifierComponent struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId             *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Unique id for stratifier component in measure
+	LinkIdElement      *Element         `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Code               *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // Meaning of the stratifier component
+	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`              // The human readable description of this stratifier component
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Criteria           *Expression      `json:"criteria,omitempty" bson:"criteria,omitempty"`                    // Component of how the measure should be stratified
+	GroupDefinition    *Reference       `json:"groupDefinition,omitempty" bson:"group_definition,omitempty"`     // A group resource that defines this population
+	ValueSet           *string          `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // What stratum values?
+	ValueSetElement    *Element         `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	Unit               *string          `json:"unit,omitempty" bson:"unit,omitempty"`                            // What units?
+	UnitElement        *Element         `json:"_unit,omitempty" bson:"unit_element,omitempty"`                   // Extensions for unit
+}
+
+func (r *MeasureGroupStratifierComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Criteria != nil {
+		if err := r.Criteria.Validate(); err != nil {
+			return fmt.Errorf("Criteria: %w", err)
+		}
+	}
+	if r.GroupDefinition != nil {
+		if err := r.GroupDefinition.Validate(); err != nil {
+			return fmt.Errorf("GroupDefinition: %w", err)
+		}
+	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	if r.UnitElement != nil {
+		if err := r.UnitElement.Validate(); err != nil {
+			return fmt.Errorf("UnitElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureGroupStratifierComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Criteria != nil {
+		r.Criteria.validateAll(path+".criteria", issues)
+	}
+	if r.GroupDefinition != nil {
+		r.GroupDefinition.validateAll(path+".groupDefinition", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	if r.UnitElement != nil {
+		r.UnitElement.validateAll(path+".unit", issues)
+	}
+}
+
+var measureGroupStratifierComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureGroupStratifierComponent) invariants() []invariant {
+	return measureGroupStratifierComponentInvariants
+}
+
+func (r MeasureGroupStratifierComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureGroupStratifierComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureGroupStratifierComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureGroupStratifierComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureGroupStratifierComponentElementTypes = map[string]string{
+	"description": "markdown",
+	"valueSet":    "canonical",
+}
+
+func (r *MeasureGroupStratifierComponent) elementTypes() map[string]string {
+	return measureGroupStratifierComponentElementTypes
+}
+
+var measureGroupStratifierComponentElementMetadata = []ElementMetadata{
+	{Path: "Measure.group.stratifier.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.stratifier.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.group.stratifier.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.group.stratifier.component.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.group.stratifier.component.code", Name: "code", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.group.stratifier.component.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.group.stratifier.component.criteria", Name: "criteria", Field: 8, Min: 0, Max: "1", Types: []string{"Expression"}},
+	{Path: "Measure.group.stratifier.component.groupDefinition", Name: "groupDefinition", Field: 9, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Measure.group.stratifier.component.valueSet", Name: "valueSet", Field: 10, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Measure.group.stratifier.component.unit", Name: "unit", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *MeasureGroupStratifierComponent) ElementMetadata() []ElementMetadata {
+	return measureGroupStratifierComponentElementMetadata
+}
+
+type MeasureSupplementalData struct {
+	Id                 *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId             *string           `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Unique id for supplementalData in measure
+	LinkIdElement      *Element          `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Code               *CodeableConcept  `json:"code,omitempty" bson:"code,omitempty"`                            // Meaning of the supplemental data
+	Usage              []CodeableConcept `json:"usage,omitempty" bson:"usage,omitempty"`                          // supplemental-data | risk-adjustment-factor
+	Description        *string           `json:"description,omitempty" bson:"description,omitempty"`              // The human readable description of this supplemental data
+	DescriptionElement *Element          `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Criteria           *Expression       `json:"criteria" bson:"criteria"`                                        // Expression describing additional data to be reported
+	ValueSet           *string           `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // What supplemental data values?
+	ValueSetElement    *Element          `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	Unit               *string           `json:"unit,omitempty" bson:"unit,omitempty"`                            // What units?
+	UnitElement        *Element          `json:"_unit,omitempty" bson:"unit_element,omitempty"`                   // Extensions for unit
+}
+
+func (r *MeasureSupplementalData) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
@@ -406,6 +1957,11 @@ func (r *MeasureSupplementalData) Validate() error {
 			return fmt.Errorf("Usage[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.Criteria == nil {
 		return fmt.Errorf("field 'Criteria' is required")
 	}
@@ -414,5 +1970,98 @@ func (r *MeasureSupplementalData) Validate() error {
 			return fmt.Errorf("Criteria: %w", err)
 		}
 	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
+	if r.UnitElement != nil {
+		if err := r.UnitElement.Validate(); err != nil {
+			return fmt.Errorf("UnitElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *MeasureSupplementalData) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.Usage {
+		item.validateAll(fmt.Sprintf("%s.usage[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Criteria == nil {
+		issues.add("required", path+".criteria", "field 'Criteria' is required")
+	}
+	if r.Criteria != nil {
+		r.Criteria.validateAll(path+".criteria", issues)
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+	if r.UnitElement != nil {
+		r.UnitElement.validateAll(path+".unit", issues)
+	}
+}
+
+var measureSupplementalDataInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureSupplementalData) invariants() []invariant {
+	return measureSupplementalDataInvariants
+}
+
+func (r MeasureSupplementalData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureSupplementalData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureSupplementalData) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureSupplementalData) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureSupplementalDataElementTypes = map[string]string{
+	"description": "markdown",
+	"valueSet":    "canonical",
+}
+
+func (r *MeasureSupplementalData) elementTypes() map[string]string {
+	return measureSupplementalDataElementTypes
+}
+
+var measureSupplementalDataElementMetadata = []ElementMetadata{
+	{Path: "Measure.supplementalData.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.supplementalData.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Measure.supplementalData.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Measure.supplementalData.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Measure.supplementalData.code", Name: "code", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.supplementalData.usage", Name: "usage", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Measure.supplementalData.description", Name: "description", Field: 7, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Measure.supplementalData.criteria", Name: "criteria", Field: 9, Min: 1, Max: "1", Types: []string{"Expression"}},
+	{Path: "Measure.supplementalData.valueSet", Name: "valueSet", Field: 10, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Measure.supplementalData.unit", Name: "unit", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *MeasureSupplementalData) ElementMetadata() []ElementMetadata {
+	return measureSupplementalDataElementMetadata
+}
@@ -2,37 +2,53 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The MeasureReport resource contains the results of the calculation of a measure; and optionally a reference to the resources involved in that calculation.
 type MeasureReport struct {
-	ResourceType      string               `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string              `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage    `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Identifier        []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Additional identifier for the MeasureReport
-	Category          *CodeableConcept     `json:"category,omitempty" bson:"category,omitempty"`                    // The category of measure report instance this is (example codes include deqm, ra, vbp)
-	Messages          *Reference           `json:"messages,omitempty" bson:"messages,omitempty"`                    // Evaluation messages
-	Status            string               `json:"status" bson:"status"`                                            // complete | pending | error
-	Type              string               `json:"type" bson:"type"`                                                // individual | subject-list | summary | data-exchange
-	DataUpdateType    *string              `json:"dataUpdateType,omitempty" bson:"data_update_type,omitempty"`      // incremental | snapshot
-	Measure           *string              `json:"measure,omitempty" bson:"measure,omitempty"`                      // What measure was calculated
-	Subject           *Reference           `json:"subject,omitempty" bson:"subject,omitempty"`                      // What individual(s) the report is for
-	Date              *string              `json:"date,omitempty" bson:"date,omitempty"`                            // When the measure report was generated
-	Reporter          *Reference           `json:"reporter,omitempty" bson:"reporter,omitempty"`                    // Who is reporting the data
-	ReportingVendor   *Reference           `json:"reportingVendor,omitempty" bson:"reporting_vendor,omitempty"`     // What vendor prepared the data
-	Location          []Reference          `json:"location,omitempty" bson:"location,omitempty"`                    // Where the reported data is from
-	Period            *Period              `json:"period" bson:"period"`                                            // What period the report covers
-	InputParameters   *Reference           `json:"inputParameters,omitempty" bson:"input_parameters,omitempty"`     // What parameters were provided to the report
-	Group             []MeasureReportGroup `json:"group,omitempty" bson:"group,omitempty"`                          // Measure results for each group
-	SupplementalData  []Reference          `json:"supplementalData,omitempty" bson:"supplemental_data,omitempty"`   // Additional information collected for the report
-	EvaluatedResource []Reference          `json:"evaluatedResource,omitempty" bson:"evaluated_resource,omitempty"` // What data was used to calculate the measure score
+	ResourceType          string                `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                    *string               `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                  *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules         *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement  *Element              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language              *string               `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement       *Element              `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                  *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained             []Resource            `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension             []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension     []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Identifier            []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the MeasureReport
+	Category              *CodeableConcept      `json:"category,omitempty" bson:"category,omitempty"`                        // The category of measure report instance this is (example codes include deqm, ra, vbp)
+	Messages              *Reference            `json:"messages,omitempty" bson:"messages,omitempty"`                        // Evaluation messages
+	Status                MeasureReportStatus   `json:"status" bson:"status"`                                                // complete | pending | error
+	StatusElement         *Element              `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Type                  MeasureReportType     `json:"type" bson:"type"`                                                    // individual | subject-list | summary | data-exchange
+	TypeElement           *Element              `json:"_type,omitempty" bson:"type_element,omitempty"`                       // Extensions for type
+	DataUpdateType        *SubmitDataUpdateType `json:"dataUpdateType,omitempty" bson:"data_update_type,omitempty"`          // incremental | snapshot
+	DataUpdateTypeElement *Element              `json:"_dataUpdateType,omitempty" bson:"data_update_type_element,omitempty"` // Extensions for dataUpdateType
+	Measure               *string               `json:"measure,omitempty" bson:"measure,omitempty"`                          // What measure was calculated
+	MeasureElement        *Element              `json:"_measure,omitempty" bson:"measure_element,omitempty"`                 // Extensions for measure
+	Subject               *Reference            `json:"subject,omitempty" bson:"subject,omitempty"`                          // What individual(s) the report is for
+	Date                  *DateTime             `json:"date,omitempty" bson:"date,omitempty"`                                // When the measure report was generated
+	DateElement           *Element              `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Reporter              *Reference            `json:"reporter,omitempty" bson:"reporter,omitempty"`                        // Who is reporting the data
+	ReportingVendor       *Reference            `json:"reportingVendor,omitempty" bson:"reporting_vendor,omitempty"`         // What vendor prepared the data
+	Location              []Reference           `json:"location,omitempty" bson:"location,omitempty"`                        // Where the reported data is from
+	Period                *Period               `json:"period" bson:"period"`                                                // What period the report covers
+	InputParameters       *Reference            `json:"inputParameters,omitempty" bson:"input_parameters,omitempty"`         // What parameters were provided to the report
+	Group                 []MeasureReportGroup  `json:"group,omitempty" bson:"group,omitempty"`                              // Measure results for each group
+	SupplementalData      []Reference           `json:"supplementalData,omitempty" bson:"supplemental_data,omitempty"`       // Additional information collected for the report
+	EvaluatedResource     []Reference           `json:"evaluatedResource,omitempty" bson:"evaluated_resource,omitempty"`     // What data was used to calculate the measure score
 }
 
+var measureReportSubjectTargets = []string{"CareTeam", "Device", "Group", "HealthcareService", "Location", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var measureReportReporterTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var measureReportLocationTargets = []string{"Location"}
+
 func (r *MeasureReport) Validate() error {
 	if r.ResourceType != "MeasureReport" {
 		return fmt.Errorf("invalid resourceType: expected 'MeasureReport', got '%s'", r.ResourceType)
@@ -42,11 +58,39 @@ func (r *MeasureReport) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -62,18 +106,66 @@ func (r *MeasureReport) Validate() error {
 			return fmt.Errorf("Messages: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Type == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.DataUpdateType != nil && !r.DataUpdateType.IsValid() {
+		return fmt.Errorf("field 'DataUpdateType' has invalid code '%s'", *r.DataUpdateType)
+	}
+	if r.DataUpdateTypeElement != nil {
+		if err := r.DataUpdateTypeElement.Validate(); err != nil {
+			return fmt.Errorf("DataUpdateTypeElement: %w", err)
+		}
+	}
+	if r.MeasureElement != nil {
+		if err := r.MeasureElement.Validate(); err != nil {
+			return fmt.Errorf("MeasureElement: %w", err)
+		}
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, measureReportSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, measureReportSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.Reporter != nil {
+		if target := invalidReferenceTarget(r.Reporter.Reference, r.Reporter.Type, measureReportReporterTargets); target != "" {
+			return fmt.Errorf("field 'Reporter' cannot refer to %s, expected one of %v", target, measureReportReporterTargets)
+		}
+	}
 	if r.Reporter != nil {
 		if err := r.Reporter.Validate(); err != nil {
 			return fmt.Errorf("Reporter: %w", err)
@@ -84,6 +176,11 @@ func (r *MeasureReport) Validate() error {
 			return fmt.Errorf("ReportingVendor: %w", err)
 		}
 	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, measureReportLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, measureReportLocationTargets)
+		}
+	}
 	for i, item := range r.Location {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Location[%d]: %w", i, err)
@@ -117,210 +214,358 @@ func (r *MeasureReport) Validate() error {
 			return fmt.Errorf("EvaluatedResource[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type MeasureReportGroup struct {
-	Id                          *string                        `json:"id,omitempty" bson:"id,omitempty"`                                                      // Unique id for inter-element referencing
-	LinkId                      *string                        `json:"linkId,omitempty" bson:"link_id,omitempty"`                                             // Pointer to specific group from Measure
-	Title                       *string                        `json:"title,omitempty" bson:"title,omitempty"`                                                // Title of a group. Note- this value is copied from this element in Measure
-	CalculatedDate              *string                        `json:"calculatedDate,omitempty" bson:"calculated_date,omitempty"`                             // The date the Measure Report was calculated
-	Code                        *CodeableConcept               `json:"code,omitempty" bson:"code,omitempty"`                                                  // Meaning of the group
-	Description                 *string                        `json:"description,omitempty" bson:"description,omitempty"`                                    // Summary description
-	Type                        *CodeableConcept               `json:"type,omitempty" bson:"type,omitempty"`                                                  // process | outcome | structure | patient-reported-outcome
-	Subject                     *Reference                     `json:"subject,omitempty" bson:"subject,omitempty"`                                            // What individual(s) the report is for
-	Scoring                     *CodeableConcept               `json:"scoring,omitempty" bson:"scoring,omitempty"`                                            // What scoring method (e.g. proportion, ratio, continuous-variable)
-	ImprovementNotation         *CodeableConcept               `json:"improvementNotation,omitempty" bson:"improvement_notation,omitempty"`                   // increase | decrease
-	ImprovementNotationGuidance *string                        `json:"improvementNotationGuidance,omitempty" bson:"improvement_notation_guidance,omitempty"`  // Explanation of improvement notation
-	Population                  []MeasureReportGroupPopulation `json:"population,omitempty" bson:"population,omitempty"`                                      // The populations in the group
-	MeasureScoreQuantity        *Quantity                      `json:"measureScoreQuantity,omitempty" bson:"measure_score_quantity,omitempty"`                // What score this group achieved
-	MeasureScoreDateTime        *string                        `json:"measureScoreDateTime,omitempty" bson:"measure_score_date_time,omitempty"`               // What score this group achieved
-	MeasureScoreCodeableConcept *CodeableConcept               `json:"measureScoreCodeableConcept,omitempty" bson:"measure_score_codeable_concept,omitempty"` // What score this group achieved
-	MeasureScorePeriod          *Period                        `json:"measureScorePeriod,omitempty" bson:"measure_score_period,omitempty"`                    // What score this group achieved
-	MeasureScoreRange           *Range                         `json:"measureScoreRange,omitempty" bson:"measure_score_range,omitempty"`                      // What score this group achieved
-	MeasureScoreDuration        *Duration                      `json:"measureScoreDuration,omitempty" bson:"measure_score_duration,omitempty"`                // What score this group achieved
-	MeasureScoreBoolean         *bool                          `json:"measureScoreBoolean,omitempty" bson:"measure_score_boolean,omitempty"`                  // What score this group achieved
-	MeasureScoreRatio           *Ratio                         `json:"measureScoreRatio,omitempty" bson:"measure_score_ratio,omitempty"`                      // What score this group achieved
-	Stratifier                  []MeasureReportGroupStratifier `json:"stratifier,omitempty" bson:"stratifier,omitempty"`                                      // Stratification results
+	return validateInvariants(r, "MeasureReport")
 }
 
-func (r *MeasureReportGroup) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+func (r *MeasureReport) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MeasureReport" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MeasureReport', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Messages != nil {
+		r.Messages.validateAll(path+".messages", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.DataUpdateType != nil && !r.DataUpdateType.IsValid() {
+		issues.add("code-invalid", path+".dataUpdateType", fmt.Sprintf("field 'DataUpdateType' has invalid code '%s'", *r.DataUpdateType))
+	}
+	if r.DataUpdateTypeElement != nil {
+		r.DataUpdateTypeElement.validateAll(path+".dataUpdateType", issues)
+	}
+	if r.MeasureElement != nil {
+		r.MeasureElement.validateAll(path+".measure", issues)
 	}
 	if r.Subject != nil {
-		if err := r.Subject.Validate(); err != nil {
-			return fmt.Errorf("Subject: %w", err)
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, measureReportSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, measureReportSubjectTargets))
 		}
 	}
-	if r.Scoring != nil {
-		if err := r.Scoring.Validate(); err != nil {
-			return fmt.Errorf("Scoring: %w", err)
-		}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
 	}
-	if r.ImprovementNotation != nil {
-		if err := r.ImprovementNotation.Validate(); err != nil {
-			return fmt.Errorf("ImprovementNotation: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	for i, item := range r.Population {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Population[%d]: %w", i, err)
-		}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	if r.MeasureScoreQuantity != nil {
-		if err := r.MeasureScoreQuantity.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreQuantity: %w", err)
+	if r.Reporter != nil {
+		if target := invalidReferenceTarget(r.Reporter.Reference, r.Reporter.Type, measureReportReporterTargets); target != "" {
+			issues.add("value", path+".reporter", fmt.Sprintf("field 'Reporter' cannot refer to %s, expected one of %v", target, measureReportReporterTargets))
 		}
 	}
-	if r.MeasureScoreCodeableConcept != nil {
-		if err := r.MeasureScoreCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreCodeableConcept: %w", err)
-		}
+	if r.Reporter != nil {
+		r.Reporter.validateAll(path+".reporter", issues)
 	}
-	if r.MeasureScorePeriod != nil {
-		if err := r.MeasureScorePeriod.Validate(); err != nil {
-			return fmt.Errorf("MeasureScorePeriod: %w", err)
-		}
+	if r.ReportingVendor != nil {
+		r.ReportingVendor.validateAll(path+".reportingVendor", issues)
 	}
-	if r.MeasureScoreRange != nil {
-		if err := r.MeasureScoreRange.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreRange: %w", err)
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, measureReportLocationTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.location[%d]", path, i), fmt.Sprintf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, measureReportLocationTargets))
 		}
 	}
-	if r.MeasureScoreDuration != nil {
-		if err := r.MeasureScoreDuration.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreDuration: %w", err)
-		}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
 	}
-	if r.MeasureScoreRatio != nil {
-		if err := r.MeasureScoreRatio.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreRatio: %w", err)
-		}
+	if r.Period == nil {
+		issues.add("required", path+".period", "field 'Period' is required")
 	}
-	for i, item := range r.Stratifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Stratifier[%d]: %w", i, err)
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.InputParameters != nil {
+		r.InputParameters.validateAll(path+".inputParameters", issues)
+	}
+	for i, item := range r.Group {
+		item.validateAll(fmt.Sprintf("%s.group[%d]", path, i), issues)
+	}
+	for i, item := range r.SupplementalData {
+		item.validateAll(fmt.Sprintf("%s.supplementalData[%d]", path, i), issues)
+	}
+	for i, item := range r.EvaluatedResource {
+		item.validateAll(fmt.Sprintf("%s.evaluatedResource[%d]", path, i), issues)
+	}
+}
+
+func (r *MeasureReport) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MeasureReport", &issues)
+	checkInvariants(r, "MeasureReport", &issues)
+	return issues
+}
+
+var measureReportInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MeasureReport) invariants() []invariant {
+	return measureReportInvariants
+}
+
+func (r *MeasureReport) UnmarshalJSON(data []byte) error {
+	type alias MeasureReport
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
-type MeasureReportGroupPopulation struct {
-	Id             *string          `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	LinkId         *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                 // Pointer to specific population from Measure
-	Title          *string          `json:"title,omitempty" bson:"title,omitempty"`                    // Title of a group. Note- this value is copied from this element in Measure
-	Code           *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                      // initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
-	Description    *string          `json:"description,omitempty" bson:"description,omitempty"`        // The human readable description of this population criteria
-	Count          *int             `json:"count,omitempty" bson:"count,omitempty"`                    // Size of the population
-	CountQuantity  *Quantity        `json:"countQuantity,omitempty" bson:"count_quantity,omitempty"`   // Size of the population as a quantity
-	SubjectResults *Reference       `json:"subjectResults,omitempty" bson:"subject_results,omitempty"` // For subject-list reports, the subject results in this population
-	SubjectReport  []Reference      `json:"subjectReport,omitempty" bson:"subject_report,omitempty"`   // For subject-list reports, references to the individual reports for subjects in this population
-	Subjects       *Reference       `json:"subjects,omitempty" bson:"subjects,omitempty"`              // What individual(s) in the population
+func (r MeasureReport) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *MeasureReportGroupPopulation) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
+func (r *MeasureReport) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReport) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReport) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureReportElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"measure":       "canonical",
+}
+
+func (r *MeasureReport) elementTypes() map[string]string {
+	return measureReportElementTypes
+}
+
+var measureReportElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MeasureReport.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MeasureReport.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MeasureReport.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MeasureReport.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MeasureReport.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MeasureReport.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MeasureReport.category", Name: "category", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.messages", Name: "messages", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MeasureReport.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MeasureReport.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/measure-report-status"}},
+	{Path: "MeasureReport.type", Name: "type", Field: 16, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MeasureReport.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/measure-report-type"}},
+	{Path: "MeasureReport.dataUpdateType", Name: "dataUpdateType", Field: 18, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MeasureReport.dataUpdateType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/submit-data-update-type"}},
+	{Path: "MeasureReport.measure", Name: "measure", Field: 20, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "MeasureReport.subject", Name: "subject", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "MeasureReport.date", Name: "date", Field: 23, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MeasureReport.reporter", Name: "reporter", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "MeasureReport.reportingVendor", Name: "reportingVendor", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MeasureReport.location", Name: "location", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "MeasureReport.period", Name: "period", Field: 28, Min: 1, Max: "1", Types: []string{"Period"}},
+	{Path: "MeasureReport.inputParameters", Name: "inputParameters", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MeasureReport.group", Name: "group", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MeasureReport.supplementalData", Name: "supplementalData", Field: 31, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MeasureReport.evaluatedResource", Name: "evaluatedResource", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+}
+
+func (r *MeasureReport) ElementMetadata() []ElementMetadata {
+	return measureReportElementMetadata
+}
+
+func (r *MeasureReport) GetResourceType() string {
+	return "MeasureReport"
+}
+
+func (r *MeasureReport) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MeasureReport) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MeasureReport) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MeasureReport) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MeasureReport) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MeasureReport) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MeasureReport) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MeasureReport)(nil)
+
+type MeasureReportGroup struct {
+	Id                                 *string                        `json:"id,omitempty" bson:"id,omitempty"`                                                              // Unique id for inter-element referencing
+	Extension                          []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                                                // Additional content defined by implementations
+	ModifierExtension                  []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                               // Extensions that cannot be ignored even if unrecognized
+	LinkId                             *string                        `json:"linkId,omitempty" bson:"link_id,omitempty"`                                                     // Pointer to specific group from Measure
+	LinkIdElement                      *Element                       `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                                            // Extensions for linkId
+	Title                              *string                        `json:"title,omitempty" bson:"title,omitempty"`                                                        // Title of a group. Note- this value is copied from this element in Measure
+	TitleElement                       *Element                       `json:"_title,omitempty" bson:"title_element,omitempty"`                                               // Extensions for title
+	CalculatedDate                     *DateTime                      `json:"calculatedDate,omitempty" bson:"calculated_date,omitempty"`                                     // The date the Measure Report was calculated
+	CalculatedDateElement              *Element                       `json:"_calculatedDate,omitempty" bson:"calculated_date_element,omitempty"`                            // Extensions for calculatedDate
+	Code                               *CodeableConcept               `json:"code,omitempty" bson:"code,omitempty"`                                                          // Meaning of the group
+	Description                        *string                        `json:"description,omitempty" bson:"description,omitempty"`                                            // Summary description
+	DescriptionElement                 *Element                       `json:"_description,omitempty" bson:"description_element,omitempty"`                                   // Extensions for description
+	Type                               *CodeableConcept               `json:"type,omitempty" bson:"type,omitempty"`                                                          // process | outcome | structure | patient-reported-outcome
+	Subject                            *Reference                     `json:"subject,omitempty" bson:"subject,omitempty"`                                                    // What individual(s) the report is for
+	Scoring                            *CodeableConcept               `json:"scoring,omitempty" bson:"scoring,omitempty"`                                                    // What scoring method (e.g. proportion, ratio, continuous-variable)
+	ImprovementNotation                *CodeableConcept               `json:"improvementNotation,omitempty" bson:"improvement_notation,omitempty"`                           // increase | decrease
+	ImprovementNotationGuidance        *string                        `json:"improvementNotationGuidance,omitempty" bson:"improvement_notation_guidance,omitempty"`          // Explanation of improvement notation
+	ImprovementNotationGuidanceElement *Element                       `json:"_improvementNotationGuidance,omitempty" bson:"improvement_notation_guidance_element,omitempty"` // Extensions for improvementNotationGuidance
+	Population                         []MeasureReportGroupPopulation `json:"population,omitempty" bson:"population,omitempty"`                                              // The populations in the group
+	MeasureScore                       MeasureReportGroupMeasureScore `json:"-" bson:"measure_score,omitempty"`                                                              // What score this group achieved
+	MeasureScoreElement                *Element                       `json:"-" bson:"measure_score_element,omitempty"`                                                      // Extensions for measureScore[x]
+	Stratifier                         []MeasureReportGroupStratifier `json:"stratifier,omitempty" bson:"stratifier,omitempty"`                                              // Stratification results
+
+	measureScoreVariants []string // JSON properties of MeasureReport.group.measureScore[x] when more than one was decoded
+}
+
+func (r *MeasureReportGroup) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.CountQuantity != nil {
-		if err := r.CountQuantity.Validate(); err != nil {
-			return fmt.Errorf("CountQuantity: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.SubjectResults != nil {
-		if err := r.SubjectResults.Validate(); err != nil {
-			return fmt.Errorf("SubjectResults: %w", err)
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
 		}
 	}
-	for i, item := range r.SubjectReport {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubjectReport[%d]: %w", i, err)
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
 		}
 	}
-	if r.Subjects != nil {
-		if err := r.Subjects.Validate(); err != nil {
-			return fmt.Errorf("Subjects: %w", err)
+	if r.CalculatedDate != nil {
+		if err := r.CalculatedDate.Validate(); err != nil {
+			return fmt.Errorf("CalculatedDate: %w", err)
+		}
+	}
+	if r.CalculatedDateElement != nil {
+		if err := r.CalculatedDateElement.Validate(); err != nil {
+			return fmt.Errorf("CalculatedDateElement: %w", err)
 		}
 	}
-	return nil
-}
-
-type MeasureReportGroupStratifier struct {
-	Id          *string                               `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	LinkId      *string                               `json:"linkId,omitempty" bson:"link_id,omitempty"`          // Pointer to specific stratifier from Measure
-	Title       *string                               `json:"title,omitempty" bson:"title,omitempty"`             // Title of a group's stratifier. Note- this value is copied from this element in Measure
-	Code        *CodeableConcept                      `json:"code,omitempty" bson:"code,omitempty"`               // What stratifier of the group
-	Description *string                               `json:"description,omitempty" bson:"description,omitempty"` // The human readable description of this stratifier
-	Stratum     []MeasureReportGroupStratifierStratum `json:"stratum,omitempty" bson:"stratum,omitempty"`         // Stratum results, one for each unique value, or set of values, in the stratifier, or stratifier components
-}
-
-func (r *MeasureReportGroupStratifier) Validate() error {
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	for i, item := range r.Stratum {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Stratum[%d]: %w", i, err)
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
 		}
 	}
-	return nil
-}
-
-type MeasureReportGroupStratifierStratum struct {
-	Id                          *string                                         `json:"id,omitempty" bson:"id,omitempty"`                                                      // Unique id for inter-element referencing
-	ValueCodeableConcept        *CodeableConcept                                `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`                // The stratum value, e.g. male
-	ValueBoolean                *bool                                           `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                                 // The stratum value, e.g. male
-	ValueQuantity               *Quantity                                       `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                               // The stratum value, e.g. male
-	ValueRange                  *Range                                          `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                     // The stratum value, e.g. male
-	ValueReference              *Reference                                      `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                             // The stratum value, e.g. male
-	Component                   []MeasureReportGroupStratifierStratumComponent  `json:"component,omitempty" bson:"component,omitempty"`                                        // Stratifier component values
-	Population                  []MeasureReportGroupStratifierStratumPopulation `json:"population,omitempty" bson:"population,omitempty"`                                      // Population results in this stratum
-	MeasureScoreQuantity        *Quantity                                       `json:"measureScoreQuantity,omitempty" bson:"measure_score_quantity,omitempty"`                // What score this stratum achieved
-	MeasureScoreDateTime        *string                                         `json:"measureScoreDateTime,omitempty" bson:"measure_score_date_time,omitempty"`               // What score this stratum achieved
-	MeasureScoreCodeableConcept *CodeableConcept                                `json:"measureScoreCodeableConcept,omitempty" bson:"measure_score_codeable_concept,omitempty"` // What score this stratum achieved
-	MeasureScorePeriod          *Period                                         `json:"measureScorePeriod,omitempty" bson:"measure_score_period,omitempty"`                    // What score this stratum achieved
-	MeasureScoreRange           *Range                                          `json:"measureScoreRange,omitempty" bson:"measure_score_range,omitempty"`                      // What score this stratum achieved
-	MeasureScoreDuration        *Duration                                       `json:"measureScoreDuration,omitempty" bson:"measure_score_duration,omitempty"`                // What score this stratum achieved
-	MeasureScoreBoolean         *bool                                           `json:"measureScoreBoolean,omitempty" bson:"measure_score_boolean,omitempty"`                  // What score this stratum achieved
-	MeasureScoreRatio           *Ratio                                          `json:"measureScoreRatio,omitempty" bson:"measure_score_ratio,omitempty"`                      // What score this stratum achieved
-}
-
-func (r *MeasureReportGroupStratifierStratum) Validate() error {
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.Scoring != nil {
+		if err := r.Scoring.Validate(); err != nil {
+			return fmt.Errorf("Scoring: %w", err)
 		}
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	if r.ImprovementNotation != nil {
+		if err := r.ImprovementNotation.Validate(); err != nil {
+			return fmt.Errorf("ImprovementNotation: %w", err)
 		}
 	}
-	for i, item := range r.Component {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Component[%d]: %w", i, err)
+	if r.ImprovementNotationGuidanceElement != nil {
+		if err := r.ImprovementNotationGuidanceElement.Validate(); err != nil {
+			return fmt.Errorf("ImprovementNotationGuidanceElement: %w", err)
 		}
 	}
 	for i, item := range r.Population {
@@ -328,115 +573,1483 @@ func (r *MeasureReportGroupStratifierStratum) Validate() error {
 			return fmt.Errorf("Population[%d]: %w", i, err)
 		}
 	}
-	if r.MeasureScoreQuantity != nil {
-		if err := r.MeasureScoreQuantity.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreQuantity: %w", err)
-		}
-	}
-	if r.MeasureScoreCodeableConcept != nil {
-		if err := r.MeasureScoreCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreCodeableConcept: %w", err)
-		}
-	}
-	if r.MeasureScorePeriod != nil {
-		if err := r.MeasureScorePeriod.Validate(); err != nil {
-			return fmt.Errorf("MeasureScorePeriod: %w", err)
-		}
+	if len(r.measureScoreVariants) > 1 {
+		return fmt.Errorf("field 'MeasureScore' must have a single type, got %v", r.measureScoreVariants)
 	}
-	if r.MeasureScoreRange != nil {
-		if err := r.MeasureScoreRange.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreRange: %w", err)
+	if r.MeasureScore != nil {
+		if err := r.MeasureScore.Validate(); err != nil {
+			return fmt.Errorf("MeasureScore: %w", err)
 		}
 	}
-	if r.MeasureScoreDuration != nil {
-		if err := r.MeasureScoreDuration.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreDuration: %w", err)
+	if r.MeasureScoreElement != nil {
+		if err := r.MeasureScoreElement.Validate(); err != nil {
+			return fmt.Errorf("MeasureScoreElement: %w", err)
 		}
 	}
-	if r.MeasureScoreRatio != nil {
-		if err := r.MeasureScoreRatio.Validate(); err != nil {
-			return fmt.Errorf("MeasureScoreRatio: %w", err)
+	for i, item := range r.Stratifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Stratifier[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type MeasureReportGroupStratifierStratumComponent struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	LinkId               *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`          // Pointer to specific stratifier component from Measure
-	Code                 *CodeableConcept `json:"code" bson:"code"`                                   // What stratifier component of the group
-	Description          *string          `json:"description,omitempty" bson:"description,omitempty"` // The human readable description of this stratifier component
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // The stratum component value, e.g. male
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // The stratum component value, e.g. male
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // The stratum component value, e.g. male
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // The stratum component value, e.g. male
-	ValueReference       *Reference       `json:"valueReference" bson:"value_reference"`              // The stratum component value, e.g. male
-}
-
-func (r *MeasureReportGroupStratifierStratumComponent) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
+func (r *MeasureReportGroup) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.CalculatedDate != nil {
+		if err := r.CalculatedDate.Validate(); err != nil {
+			issues.add("value", path+".calculatedDate", err.Error())
 		}
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.CalculatedDateElement != nil {
+		r.CalculatedDateElement.validateAll(path+".calculatedDate", issues)
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.Scoring != nil {
+		r.Scoring.validateAll(path+".scoring", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if r.ImprovementNotation != nil {
+		r.ImprovementNotation.validateAll(path+".improvementNotation", issues)
+	}
+	if r.ImprovementNotationGuidanceElement != nil {
+		r.ImprovementNotationGuidanceElement.validateAll(path+".improvementNotationGuidance", issues)
+	}
+	for i, item := range r.Population {
+		item.validateAll(fmt.Sprintf("%s.population[%d]", path, i), issues)
+	}
+	if len(r.measureScoreVariants) > 1 {
+		issues.add("structure", path+".measureScore", fmt.Sprintf("field 'MeasureScore' must have a single type, got %v", r.measureScoreVariants))
+	}
+	if r.MeasureScore != nil {
+		r.MeasureScore.validateAll(path+".measureScore.ofType("+r.MeasureScore.FHIRType()+")", issues)
+	}
+	if r.MeasureScoreElement != nil {
+		r.MeasureScoreElement.validateAll(path+".measureScore", issues)
+	}
+	for i, item := range r.Stratifier {
+		item.validateAll(fmt.Sprintf("%s.stratifier[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type MeasureReportGroupStratifierStratumPopulation struct {
-	Id             *string          `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	LinkId         *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                 // Pointer to specific population from Measure
-	Code           *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                      // initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
-	Count          *int             `json:"count,omitempty" bson:"count,omitempty"`                    // Size of the population
-	CountQuantity  *Quantity        `json:"countQuantity,omitempty" bson:"count_quantity,omitempty"`   // Size of the population as a quantity
-	SubjectResults *Reference       `json:"subjectResults,omitempty" bson:"subject_results,omitempty"` // For subject-list reports, the subject results in this population
-	SubjectReport  []Reference      `json:"subjectReport,omitempty" bson:"subject_report,omitempty"`   // For subject-list reports, a subject result in this population
-	Subjects       *Reference       `json:"subjects,omitempty" bson:"subjects,omitempty"`              // What individual(s) in the population
+var measureReportGroupInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *MeasureReportGroupStratifierStratumPopulation) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
+func (r *MeasureReportGroup) invariants() []invariant {
+	return measureReportGroupInvariants
+}
+
+func (r MeasureReportGroup) MarshalJSON() ([]byte, error) {
+	type alias MeasureReportGroup
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "measureScore", r.MeasureScore, r.MeasureScoreElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MeasureReportGroup) UnmarshalJSON(data []byte) error {
+	type alias MeasureReportGroup
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.MeasureScore, element, r.measureScoreVariants, err = unmarshalChoice(fields, "measureScore", measureReportGroupMeasureScoreVariants); err != nil {
+		return err
+	}
+	r.MeasureScoreElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.MeasureScoreElement); err != nil {
+			return fmt.Errorf("_measureScore: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MeasureReportGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureReportGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReportGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReportGroup) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MeasureReportGroup) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "MeasureScore":
+		return "measureScore", choiceValues(measureReportGroupMeasureScoreVariants)
+	}
+	return "", nil
+}
+
+var measureReportGroupElementTypes = map[string]string{
+	"description":                 "markdown",
+	"improvementNotationGuidance": "markdown",
+}
+
+func (r *MeasureReportGroup) elementTypes() map[string]string {
+	return measureReportGroupElementTypes
+}
+
+var measureReportGroupElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.group.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.group.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.group.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.calculatedDate", Name: "calculatedDate", Field: 7, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MeasureReport.group.code", Name: "code", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.description", Name: "description", Field: 10, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MeasureReport.group.type", Name: "type", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.subject", Name: "subject", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MeasureReport.group.scoring", Name: "scoring", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.improvementNotation", Name: "improvementNotation", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.improvementNotationGuidance", Name: "improvementNotationGuidance", Field: 16, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MeasureReport.group.population", Name: "population", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MeasureReport.group.measureScore[x]", Name: "measureScore", Field: 19, Min: 0, Max: "1", Types: []string{"Quantity", "dateTime", "CodeableConcept", "Period", "Range", "Duration", "boolean", "Ratio"}},
+	{Path: "MeasureReport.group.stratifier", Name: "stratifier", Field: 21, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MeasureReportGroup) ElementMetadata() []ElementMetadata {
+	return measureReportGroupElementMetadata
+}
+
+// MeasureReportGroupMeasureScore is the type of MeasureReport.group.measureScore[x]. It is implemented by the MeasureReportGroupMeasureScore* variant types.
+type MeasureReportGroupMeasureScore interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureReportGroupMeasureScore()
+}
+
+// MeasureReportGroupMeasureScoreQuantity is the Quantity variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreQuantity struct {
+	Quantity
+}
+
+func (v MeasureReportGroupMeasureScoreQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MeasureReportGroupMeasureScoreQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupMeasureScoreQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MeasureReportGroupMeasureScoreQuantity) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScoreDateTime is the dateTime variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreDateTime struct {
+	DateTime
+}
+
+func (v MeasureReportGroupMeasureScoreDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v MeasureReportGroupMeasureScoreDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v MeasureReportGroupMeasureScoreDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v MeasureReportGroupMeasureScoreDateTime) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScoreCodeableConcept is the CodeableConcept variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MeasureReportGroupMeasureScoreCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MeasureReportGroupMeasureScoreCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupMeasureScoreCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MeasureReportGroupMeasureScoreCodeableConcept) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScorePeriod is the Period variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScorePeriod struct {
+	Period
+}
+
+func (v MeasureReportGroupMeasureScorePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v MeasureReportGroupMeasureScorePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupMeasureScorePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v MeasureReportGroupMeasureScorePeriod) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScoreRange is the Range variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreRange struct {
+	Range
+}
+
+func (v MeasureReportGroupMeasureScoreRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v MeasureReportGroupMeasureScoreRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupMeasureScoreRange) FHIRType() string {
+	return "Range"
+}
+
+func (v MeasureReportGroupMeasureScoreRange) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScoreDuration is the Duration variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreDuration struct {
+	Duration
+}
+
+func (v MeasureReportGroupMeasureScoreDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v MeasureReportGroupMeasureScoreDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupMeasureScoreDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v MeasureReportGroupMeasureScoreDuration) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScoreBoolean is the boolean variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreBoolean bool
+
+func (v MeasureReportGroupMeasureScoreBoolean) Validate() error {
+	return nil
+}
+
+func (v MeasureReportGroupMeasureScoreBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MeasureReportGroupMeasureScoreBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v MeasureReportGroupMeasureScoreBoolean) isMeasureReportGroupMeasureScore() {}
+
+// MeasureReportGroupMeasureScoreRatio is the Ratio variant of MeasureReportGroupMeasureScore.
+type MeasureReportGroupMeasureScoreRatio struct {
+	Ratio
+}
+
+func (v MeasureReportGroupMeasureScoreRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v MeasureReportGroupMeasureScoreRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupMeasureScoreRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v MeasureReportGroupMeasureScoreRatio) isMeasureReportGroupMeasureScore() {}
+
+var measureReportGroupMeasureScoreVariants = []MeasureReportGroupMeasureScore{
+	MeasureReportGroupMeasureScoreQuantity{},
+	MeasureReportGroupMeasureScoreDateTime{},
+	MeasureReportGroupMeasureScoreCodeableConcept{},
+	MeasureReportGroupMeasureScorePeriod{},
+	MeasureReportGroupMeasureScoreRange{},
+	MeasureReportGroupMeasureScoreDuration{},
+	MeasureReportGroupMeasureScoreBoolean(false),
+	MeasureReportGroupMeasureScoreRatio{},
+}
+
+type MeasureReportGroupPopulation struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId             *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Pointer to specific population from Measure
+	LinkIdElement      *Element         `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Title              *string          `json:"title,omitempty" bson:"title,omitempty"`                          // Title of a group. Note- this value is copied from this element in Measure
+	TitleElement       *Element         `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Code               *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
+	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`              // The human readable description of this population criteria
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Count              *int             `json:"count,omitempty" bson:"count,omitempty"`                          // Size of the population
+	CountElement       *Element         `json:"_count,omitempty" bson:"count_element,omitempty"`                 // Extensions for count
+	CountQuantity      *Quantity        `json:"countQuantity,omitempty" bson:"count_quantity,omitempty"`         // Size of the population as a quantity
+	SubjectResults     *Reference       `json:"subjectResults,omitempty" bson:"subject_results,omitempty"`       // For subject-list reports, the subject results in this population
+	SubjectReport      []Reference      `json:"subjectReport,omitempty" bson:"subject_report,omitempty"`         // For subject-list reports, references to the individual reports for subjects in this population
+	Subjects           *Reference       `json:"subjects,omitempty" bson:"subjects,omitempty"`                    // What individual(s) in the population
+}
+
+func (r *MeasureReportGroupPopulation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
+		}
+	}
+	if r.CountQuantity != nil {
+		if err := r.CountQuantity.Validate(); err != nil {
+			return fmt.Errorf("CountQuantity: %w", err)
+		}
+	}
+	if r.SubjectResults != nil {
+		if err := r.SubjectResults.Validate(); err != nil {
+			return fmt.Errorf("SubjectResults: %w", err)
+		}
+	}
+	for i, item := range r.SubjectReport {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubjectReport[%d]: %w", i, err)
+		}
+	}
+	if r.Subjects != nil {
+		if err := r.Subjects.Validate(); err != nil {
+			return fmt.Errorf("Subjects: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureReportGroupPopulation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+	if r.CountQuantity != nil {
+		r.CountQuantity.validateAll(path+".countQuantity", issues)
+	}
+	if r.SubjectResults != nil {
+		r.SubjectResults.validateAll(path+".subjectResults", issues)
+	}
+	for i, item := range r.SubjectReport {
+		item.validateAll(fmt.Sprintf("%s.subjectReport[%d]", path, i), issues)
+	}
+	if r.Subjects != nil {
+		r.Subjects.validateAll(path+".subjects", issues)
+	}
+}
+
+var measureReportGroupPopulationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureReportGroupPopulation) invariants() []invariant {
+	return measureReportGroupPopulationInvariants
+}
+
+func (r MeasureReportGroupPopulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureReportGroupPopulation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReportGroupPopulation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReportGroupPopulation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureReportGroupPopulationElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *MeasureReportGroupPopulation) elementTypes() map[string]string {
+	return measureReportGroupPopulationElementTypes
+}
+
+var measureReportGroupPopulationElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.group.population.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.population.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.group.population.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.group.population.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.population.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.population.code", Name: "code", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.population.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MeasureReport.group.population.count", Name: "count", Field: 10, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "MeasureReport.group.population.countQuantity", Name: "countQuantity", Field: 12, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MeasureReport.group.population.subjectResults", Name: "subjectResults", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MeasureReport.group.population.subjectReport", Name: "subjectReport", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MeasureReport.group.population.subjects", Name: "subjects", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *MeasureReportGroupPopulation) ElementMetadata() []ElementMetadata {
+	return measureReportGroupPopulationElementMetadata
+}
+
+type MeasureReportGroupStratifier struct {
+	Id                 *string                               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId             *string                               `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Pointer to specific stratifier from Measure
+	LinkIdElement      *Element                              `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Title              *string                               `json:"title,omitempty" bson:"title,omitempty"`                          // Title of a group's stratifier. Note- this value is copied from this element in Measure
+	TitleElement       *Element                              `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Code               *CodeableConcept                      `json:"code,omitempty" bson:"code,omitempty"`                            // What stratifier of the group
+	Description        *string                               `json:"description,omitempty" bson:"description,omitempty"`              // The human readable description of this stratifier
+	DescriptionElement *Element                              `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Stratum            []MeasureReportGroupStratifierStratum `json:"stratum,omitempty" bson:"stratum,omitempty"`                      // Stratum results, one for each unique value, or set of values, in the stratifier, or stratifier components
+}
+
+func (r *MeasureReportGroupStratifier) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.Stratum {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Stratum[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureReportGroupStratifier) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Stratum {
+		item.validateAll(fmt.Sprintf("%s.stratum[%d]", path, i), issues)
+	}
+}
+
+var measureReportGroupStratifierInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureReportGroupStratifier) invariants() []invariant {
+	return measureReportGroupStratifierInvariants
+}
+
+func (r MeasureReportGroupStratifier) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureReportGroupStratifier) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReportGroupStratifier) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReportGroupStratifier) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureReportGroupStratifierElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *MeasureReportGroupStratifier) elementTypes() map[string]string {
+	return measureReportGroupStratifierElementTypes
+}
+
+var measureReportGroupStratifierElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.group.stratifier.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.group.stratifier.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.group.stratifier.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.title", Name: "title", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.code", Name: "code", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.stratifier.description", Name: "description", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MeasureReport.group.stratifier.stratum", Name: "stratum", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MeasureReportGroupStratifier) ElementMetadata() []ElementMetadata {
+	return measureReportGroupStratifierElementMetadata
+}
+
+type MeasureReportGroupStratifierStratum struct {
+	Id                  *string                                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension                                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension                                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value               MeasureReportGroupStratifierStratumValue        `json:"-" bson:"value,omitempty"`                                        // The stratum value, e.g. male
+	ValueElement        *Element                                        `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Component           []MeasureReportGroupStratifierStratumComponent  `json:"component,omitempty" bson:"component,omitempty"`                  // Stratifier component values
+	Population          []MeasureReportGroupStratifierStratumPopulation `json:"population,omitempty" bson:"population,omitempty"`                // Population results in this stratum
+	MeasureScore        MeasureReportGroupStratifierStratumMeasureScore `json:"-" bson:"measure_score,omitempty"`                                // What score this stratum achieved
+	MeasureScoreElement *Element                                        `json:"-" bson:"measure_score_element,omitempty"`                        // Extensions for measureScore[x]
+
+	valueVariants        []string // JSON properties of MeasureReport.group.stratifier.stratum.value[x] when more than one was decoded
+	measureScoreVariants []string // JSON properties of MeasureReport.group.stratifier.stratum.measureScore[x] when more than one was decoded
+}
+
+func (r *MeasureReportGroupStratifierStratum) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	for i, item := range r.Component {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Component[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Population {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Population[%d]: %w", i, err)
+		}
+	}
+	if len(r.measureScoreVariants) > 1 {
+		return fmt.Errorf("field 'MeasureScore' must have a single type, got %v", r.measureScoreVariants)
+	}
+	if r.MeasureScore != nil {
+		if err := r.MeasureScore.Validate(); err != nil {
+			return fmt.Errorf("MeasureScore: %w", err)
+		}
+	}
+	if r.MeasureScoreElement != nil {
+		if err := r.MeasureScoreElement.Validate(); err != nil {
+			return fmt.Errorf("MeasureScoreElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureReportGroupStratifierStratum) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+	for i, item := range r.Population {
+		item.validateAll(fmt.Sprintf("%s.population[%d]", path, i), issues)
+	}
+	if len(r.measureScoreVariants) > 1 {
+		issues.add("structure", path+".measureScore", fmt.Sprintf("field 'MeasureScore' must have a single type, got %v", r.measureScoreVariants))
+	}
+	if r.MeasureScore != nil {
+		r.MeasureScore.validateAll(path+".measureScore.ofType("+r.MeasureScore.FHIRType()+")", issues)
+	}
+	if r.MeasureScoreElement != nil {
+		r.MeasureScoreElement.validateAll(path+".measureScore", issues)
+	}
+}
+
+var measureReportGroupStratifierStratumInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureReportGroupStratifierStratum) invariants() []invariant {
+	return measureReportGroupStratifierStratumInvariants
+}
+
+func (r MeasureReportGroupStratifierStratum) MarshalJSON() ([]byte, error) {
+	type alias MeasureReportGroupStratifierStratum
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "measureScore", r.MeasureScore, r.MeasureScoreElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MeasureReportGroupStratifierStratum) UnmarshalJSON(data []byte) error {
+	type alias MeasureReportGroupStratifierStratum
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", measureReportGroupStratifierStratumValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	if r.MeasureScore, element, r.measureScoreVariants, err = unmarshalChoice(fields, "measureScore", measureReportGroupStratifierStratumMeasureScoreVariants); err != nil {
+		return err
+	}
+	r.MeasureScoreElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.MeasureScoreElement); err != nil {
+			return fmt.Errorf("_measureScore: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MeasureReportGroupStratifierStratum) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureReportGroupStratifierStratum) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReportGroupStratifierStratum) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReportGroupStratifierStratum) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MeasureReportGroupStratifierStratum) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(measureReportGroupStratifierStratumValueVariants)
+	case "MeasureScore":
+		return "measureScore", choiceValues(measureReportGroupStratifierStratumMeasureScoreVariants)
+	}
+	return "", nil
+}
+
+var measureReportGroupStratifierStratumElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.group.stratifier.stratum.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.stratum.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.group.stratifier.stratum.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.group.stratifier.stratum.value[x]", Name: "value", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept", "boolean", "Quantity", "Range", "Reference"}},
+	{Path: "MeasureReport.group.stratifier.stratum.component", Name: "component", Field: 5, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population", Name: "population", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MeasureReport.group.stratifier.stratum.measureScore[x]", Name: "measureScore", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity", "dateTime", "CodeableConcept", "Period", "Range", "Duration", "boolean", "Ratio"}},
+}
+
+func (r *MeasureReportGroupStratifierStratum) ElementMetadata() []ElementMetadata {
+	return measureReportGroupStratifierStratumElementMetadata
+}
+
+// MeasureReportGroupStratifierStratumValue is the type of MeasureReport.group.stratifier.stratum.value[x]. It is implemented by the MeasureReportGroupStratifierStratumValue* variant types.
+type MeasureReportGroupStratifierStratumValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureReportGroupStratifierStratumValue()
+}
+
+// MeasureReportGroupStratifierStratumValueCodeableConcept is the CodeableConcept variant of MeasureReportGroupStratifierStratumValue.
+type MeasureReportGroupStratifierStratumValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MeasureReportGroupStratifierStratumValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MeasureReportGroupStratifierStratumValueCodeableConcept) isMeasureReportGroupStratifierStratumValue() {
+}
+
+// MeasureReportGroupStratifierStratumValueBoolean is the boolean variant of MeasureReportGroupStratifierStratumValue.
+type MeasureReportGroupStratifierStratumValueBoolean bool
+
+func (v MeasureReportGroupStratifierStratumValueBoolean) Validate() error {
+	return nil
+}
+
+func (v MeasureReportGroupStratifierStratumValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MeasureReportGroupStratifierStratumValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v MeasureReportGroupStratifierStratumValueBoolean) isMeasureReportGroupStratifierStratumValue() {
+}
+
+// MeasureReportGroupStratifierStratumValueQuantity is the Quantity variant of MeasureReportGroupStratifierStratumValue.
+type MeasureReportGroupStratifierStratumValueQuantity struct {
+	Quantity
+}
+
+func (v MeasureReportGroupStratifierStratumValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MeasureReportGroupStratifierStratumValueQuantity) isMeasureReportGroupStratifierStratumValue() {
+}
+
+// MeasureReportGroupStratifierStratumValueRange is the Range variant of MeasureReportGroupStratifierStratumValue.
+type MeasureReportGroupStratifierStratumValueRange struct {
+	Range
+}
+
+func (v MeasureReportGroupStratifierStratumValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v MeasureReportGroupStratifierStratumValueRange) isMeasureReportGroupStratifierStratumValue() {}
+
+// MeasureReportGroupStratifierStratumValueReference is the Reference variant of MeasureReportGroupStratifierStratumValue.
+type MeasureReportGroupStratifierStratumValueReference struct {
+	Reference
+}
+
+func (v MeasureReportGroupStratifierStratumValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v MeasureReportGroupStratifierStratumValueReference) isMeasureReportGroupStratifierStratumValue() {
+}
+
+var measureReportGroupStratifierStratumValueVariants = []MeasureReportGroupStratifierStratumValue{
+	MeasureReportGroupStratifierStratumValueCodeableConcept{},
+	MeasureReportGroupStratifierStratumValueBoolean(false),
+	MeasureReportGroupStratifierStratumValueQuantity{},
+	MeasureReportGroupStratifierStratumValueRange{},
+	MeasureReportGroupStratifierStratumValueReference{},
+}
+
+// MeasureReportGroupStratifierStratumMeasureScore is the type of MeasureReport.group.stratifier.stratum.measureScore[x]. It is implemented by the MeasureReportGroupStratifierStratumMeasureScore* variant types.
+type MeasureReportGroupStratifierStratumMeasureScore interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureReportGroupStratifierStratumMeasureScore()
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreQuantity is the Quantity variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreQuantity struct {
+	Quantity
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreQuantity) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreDateTime is the dateTime variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreDateTime struct {
+	DateTime
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDateTime) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept is the CodeableConcept variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScorePeriod is the Period variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScorePeriod struct {
+	Period
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScorePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScorePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScorePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScorePeriod) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreRange is the Range variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreRange struct {
+	Range
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRange) FHIRType() string {
+	return "Range"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRange) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreDuration is the Duration variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreDuration struct {
+	Duration
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreDuration) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreBoolean is the boolean variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreBoolean bool
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreBoolean) Validate() error {
+	return nil
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreBoolean) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+// MeasureReportGroupStratifierStratumMeasureScoreRatio is the Ratio variant of MeasureReportGroupStratifierStratumMeasureScore.
+type MeasureReportGroupStratifierStratumMeasureScoreRatio struct {
+	Ratio
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v MeasureReportGroupStratifierStratumMeasureScoreRatio) isMeasureReportGroupStratifierStratumMeasureScore() {
+}
+
+var measureReportGroupStratifierStratumMeasureScoreVariants = []MeasureReportGroupStratifierStratumMeasureScore{
+	MeasureReportGroupStratifierStratumMeasureScoreQuantity{},
+	MeasureReportGroupStratifierStratumMeasureScoreDateTime{},
+	MeasureReportGroupStratifierStratumMeasureScoreCodeableConcept{},
+	MeasureReportGroupStratifierStratumMeasureScorePeriod{},
+	MeasureReportGroupStratifierStratumMeasureScoreRange{},
+	MeasureReportGroupStratifierStratumMeasureScoreDuration{},
+	MeasureReportGroupStratifierStratumMeasureScoreBoolean(false),
+	MeasureReportGroupStratifierStratumMeasureScoreRatio{},
+}
+
+type MeasureReportGroupStratifierStratumComponent struct {
+	Id                 *string                                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId             *string                                           `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Pointer to specific stratifier component from Measure
+	LinkIdElement      *Element                                          `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Code               *CodeableConcept                                  `json:"code" bson:"code"`                                                // What stratifier component of the group
+	Description        *string                                           `json:"description,omitempty" bson:"description,omitempty"`              // The human readable description of this stratifier component
+	DescriptionElement *Element                                          `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Value              MeasureReportGroupStratifierStratumComponentValue `json:"-" bson:"value,omitempty"`                                        // The stratum component value, e.g. male
+	ValueElement       *Element                                          `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of MeasureReport.group.stratifier.stratum.component.value[x] when more than one was decoded
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var measureReportGroupStratifierStratumComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) invariants() []invariant {
+	return measureReportGroupStratifierStratumComponentInvariants
+}
+
+func (r MeasureReportGroupStratifierStratumComponent) MarshalJSON() ([]byte, error) {
+	type alias MeasureReportGroupStratifierStratumComponent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalJSON(data []byte) error {
+	type alias MeasureReportGroupStratifierStratumComponent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", measureReportGroupStratifierStratumComponentValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MeasureReportGroupStratifierStratumComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReportGroupStratifierStratumComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(measureReportGroupStratifierStratumComponentValueVariants)
+	}
+	return "", nil
+}
+
+var measureReportGroupStratifierStratumComponentElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) elementTypes() map[string]string {
+	return measureReportGroupStratifierStratumComponentElementTypes
+}
+
+var measureReportGroupStratifierStratumComponentElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.group.stratifier.stratum.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.stratum.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.group.stratifier.stratum.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.group.stratifier.stratum.component.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.stratum.component.code", Name: "code", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.stratifier.stratum.component.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MeasureReport.group.stratifier.stratum.component.value[x]", Name: "value", Field: 8, Min: 1, Max: "1", Types: []string{"CodeableConcept", "boolean", "Quantity", "Range", "Reference"}},
+}
+
+func (r *MeasureReportGroupStratifierStratumComponent) ElementMetadata() []ElementMetadata {
+	return measureReportGroupStratifierStratumComponentElementMetadata
+}
+
+// MeasureReportGroupStratifierStratumComponentValue is the type of MeasureReport.group.stratifier.stratum.component.value[x]. It is implemented by the MeasureReportGroupStratifierStratumComponentValue* variant types.
+type MeasureReportGroupStratifierStratumComponentValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMeasureReportGroupStratifierStratumComponentValue()
+}
+
+// MeasureReportGroupStratifierStratumComponentValueCodeableConcept is the CodeableConcept variant of MeasureReportGroupStratifierStratumComponentValue.
+type MeasureReportGroupStratifierStratumComponentValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueCodeableConcept) isMeasureReportGroupStratifierStratumComponentValue() {
+}
+
+// MeasureReportGroupStratifierStratumComponentValueBoolean is the boolean variant of MeasureReportGroupStratifierStratumComponentValue.
+type MeasureReportGroupStratifierStratumComponentValueBoolean bool
+
+func (v MeasureReportGroupStratifierStratumComponentValueBoolean) Validate() error {
+	return nil
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueBoolean) isMeasureReportGroupStratifierStratumComponentValue() {
+}
+
+// MeasureReportGroupStratifierStratumComponentValueQuantity is the Quantity variant of MeasureReportGroupStratifierStratumComponentValue.
+type MeasureReportGroupStratifierStratumComponentValueQuantity struct {
+	Quantity
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueQuantity) isMeasureReportGroupStratifierStratumComponentValue() {
+}
+
+// MeasureReportGroupStratifierStratumComponentValueRange is the Range variant of MeasureReportGroupStratifierStratumComponentValue.
+type MeasureReportGroupStratifierStratumComponentValueRange struct {
+	Range
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueRange) isMeasureReportGroupStratifierStratumComponentValue() {
+}
+
+// MeasureReportGroupStratifierStratumComponentValueReference is the Reference variant of MeasureReportGroupStratifierStratumComponentValue.
+type MeasureReportGroupStratifierStratumComponentValueReference struct {
+	Reference
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v MeasureReportGroupStratifierStratumComponentValueReference) isMeasureReportGroupStratifierStratumComponentValue() {
+}
+
+var measureReportGroupStratifierStratumComponentValueVariants = []MeasureReportGroupStratifierStratumComponentValue{
+	MeasureReportGroupStratifierStratumComponentValueCodeableConcept{},
+	MeasureReportGroupStratifierStratumComponentValueBoolean(false),
+	MeasureReportGroupStratifierStratumComponentValueQuantity{},
+	MeasureReportGroupStratifierStratumComponentValueRange{},
+	MeasureReportGroupStratifierStratumComponentValueReference{},
+}
+
+type MeasureReportGroupStratifierStratumPopulation struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId            *string          `json:"linkId,omitempty" bson:"link_id,omitempty"`                       // Pointer to specific population from Measure
+	LinkIdElement     *Element         `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
+	Count             *int             `json:"count,omitempty" bson:"count,omitempty"`                          // Size of the population
+	CountElement      *Element         `json:"_count,omitempty" bson:"count_element,omitempty"`                 // Extensions for count
+	CountQuantity     *Quantity        `json:"countQuantity,omitempty" bson:"count_quantity,omitempty"`         // Size of the population as a quantity
+	SubjectResults    *Reference       `json:"subjectResults,omitempty" bson:"subject_results,omitempty"`       // For subject-list reports, the subject results in this population
+	SubjectReport     []Reference      `json:"subjectReport,omitempty" bson:"subject_report,omitempty"`         // For subject-list reports, a subject result in this population
+	Subjects          *Reference       `json:"subjects,omitempty" bson:"subjects,omitempty"`                    // What individual(s) in the population
+}
+
+func (r *MeasureReportGroupStratifierStratumPopulation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
+		}
+	}
 	if r.CountQuantity != nil {
 		if err := r.CountQuantity.Validate(); err != nil {
 			return fmt.Errorf("CountQuantity: %w", err)
@@ -459,3 +2072,74 @@ func (r *MeasureReportGroupStratifierStratumPopulation) Validate() error {
 	}
 	return nil
 }
+
+func (r *MeasureReportGroupStratifierStratumPopulation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+	if r.CountQuantity != nil {
+		r.CountQuantity.validateAll(path+".countQuantity", issues)
+	}
+	if r.SubjectResults != nil {
+		r.SubjectResults.validateAll(path+".subjectResults", issues)
+	}
+	for i, item := range r.SubjectReport {
+		item.validateAll(fmt.Sprintf("%s.subjectReport[%d]", path, i), issues)
+	}
+	if r.Subjects != nil {
+		r.Subjects.validateAll(path+".subjects", issues)
+	}
+}
+
+var measureReportGroupStratifierStratumPopulationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MeasureReportGroupStratifierStratumPopulation) invariants() []invariant {
+	return measureReportGroupStratifierStratumPopulationInvariants
+}
+
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MeasureReportGroupStratifierStratumPopulation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MeasureReportGroupStratifierStratumPopulation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MeasureReportGroupStratifierStratumPopulation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var measureReportGroupStratifierStratumPopulationElementMetadata = []ElementMetadata{
+	{Path: "MeasureReport.group.stratifier.stratum.population.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MeasureReport.group.stratifier.stratum.population.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.code", Name: "code", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.count", Name: "count", Field: 6, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.countQuantity", Name: "countQuantity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.subjectResults", Name: "subjectResults", Field: 9, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.subjectReport", Name: "subjectReport", Field: 10, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MeasureReport.group.stratifier.stratum.population.subjects", Name: "subjects", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *MeasureReportGroupStratifierStratumPopulation) ElementMetadata() []ElementMetadata {
+	return measureReportGroupStratifierStratumPopulationElementMetadata
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,12 +12,17 @@ type Medication struct {
 	Id                           *string                `json:"id,omitempty" bson:"id,omitempty"`                                                       // Logical id of this artifact
 	Meta                         *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                                                   // Metadata about the resource
 	ImplicitRules                *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                // A set of rules under which this content was created
+	ImplicitRulesElement         *Element               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                       // Extensions for implicitRules
 	Language                     *string                `json:"language,omitempty" bson:"language,omitempty"`                                           // Language of the resource content
+	LanguageElement              *Element               `json:"_language,omitempty" bson:"language_element,omitempty"`                                  // Extensions for language
 	Text                         *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                                                   // Text summary of the resource, for human interpretation
-	Contained                    []json.RawMessage      `json:"contained,omitempty" bson:"contained,omitempty"`                                         // Contained, inline Resources
+	Contained                    []Resource             `json:"contained,omitempty" bson:"contained,omitempty"`                                         // Contained, inline Resources
+	Extension                    []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                                         // Additional content defined by implementations
+	ModifierExtension            []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                        // Extensions that cannot be ignored
 	Identifier                   []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                                       // Business identifier for this medication
 	Code                         *CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                                                   // Codes that identify this medication
-	Status                       *string                `json:"status,omitempty" bson:"status,omitempty"`                                               // active | inactive | entered-in-error
+	Status                       *MedicationStatusCodes `json:"status,omitempty" bson:"status,omitempty"`                                               // active | inactive | entered-in-error
+	StatusElement                *Element               `json:"_status,omitempty" bson:"status_element,omitempty"`                                      // Extensions for status
 	MarketingAuthorizationHolder *Reference             `json:"marketingAuthorizationHolder,omitempty" bson:"marketing_authorization_holder,omitempty"` // Organization that has authorization to market medication
 	DoseForm                     *CodeableConcept       `json:"doseForm,omitempty" bson:"dose_form,omitempty"`                                          // powder | tablets | capsule +
 	PackageSize                  *MedicationPackageSize `json:"packageSize,omitempty" bson:"package_size,omitempty"`                                    // When the code does not specify the package size, this backbone element can be used to specify the overall amount of medication in the package
@@ -25,6 +31,8 @@ type Medication struct {
 	Definition                   *Reference             `json:"definition,omitempty" bson:"definition,omitempty"`                                       // Formal definition of the medication
 }
 
+var medicationMarketingAuthorizationHolderTargets = []string{"Organization"}
+
 func (r *Medication) Validate() error {
 	if r.ResourceType != "Medication" {
 		return fmt.Errorf("invalid resourceType: expected 'Medication', got '%s'", r.ResourceType)
@@ -34,11 +42,39 @@ func (r *Medication) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -49,6 +85,19 @@ func (r *Medication) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.MarketingAuthorizationHolder != nil {
+		if target := invalidReferenceTarget(r.MarketingAuthorizationHolder.Reference, r.MarketingAuthorizationHolder.Type, medicationMarketingAuthorizationHolderTargets); target != "" {
+			return fmt.Errorf("field 'MarketingAuthorizationHolder' cannot refer to %s, expected one of %v", target, medicationMarketingAuthorizationHolderTargets)
+		}
+	}
 	if r.MarketingAuthorizationHolder != nil {
 		if err := r.MarketingAuthorizationHolder.Validate(); err != nil {
 			return fmt.Errorf("MarketingAuthorizationHolder: %w", err)
@@ -79,16 +128,223 @@ func (r *Medication) Validate() error {
 			return fmt.Errorf("Definition: %w", err)
 		}
 	}
+	return validateInvariants(r, "Medication")
+}
+
+func (r *Medication) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Medication" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Medication', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.MarketingAuthorizationHolder != nil {
+		if target := invalidReferenceTarget(r.MarketingAuthorizationHolder.Reference, r.MarketingAuthorizationHolder.Type, medicationMarketingAuthorizationHolderTargets); target != "" {
+			issues.add("value", path+".marketingAuthorizationHolder", fmt.Sprintf("field 'MarketingAuthorizationHolder' cannot refer to %s, expected one of %v", target, medicationMarketingAuthorizationHolderTargets))
+		}
+	}
+	if r.MarketingAuthorizationHolder != nil {
+		r.MarketingAuthorizationHolder.validateAll(path+".marketingAuthorizationHolder", issues)
+	}
+	if r.DoseForm != nil {
+		r.DoseForm.validateAll(path+".doseForm", issues)
+	}
+	if r.PackageSize != nil {
+		r.PackageSize.validateAll(path+".packageSize", issues)
+	}
+	for i, item := range r.Ingredient {
+		item.validateAll(fmt.Sprintf("%s.ingredient[%d]", path, i), issues)
+	}
+	if r.Instance != nil {
+		r.Instance.validateAll(path+".instance", issues)
+	}
+	if r.Definition != nil {
+		r.Definition.validateAll(path+".definition", issues)
+	}
+}
+
+func (r *Medication) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Medication", &issues)
+	checkInvariants(r, "Medication", &issues)
+	return issues
+}
+
+var medicationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Medication) invariants() []invariant {
+	return medicationInvariants
+}
+
+func (r *Medication) UnmarshalJSON(data []byte) error {
+	type alias Medication
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Medication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Medication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Medication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Medication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Medication) elementTypes() map[string]string {
+	return medicationElementTypes
+}
+
+var medicationElementMetadata = []ElementMetadata{
+	{Path: "Medication.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Medication.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Medication.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Medication.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Medication.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Medication.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Medication.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Medication.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Medication.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Medication.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Medication.code", Name: "code", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Medication.status", Name: "status", Field: 13, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Medication.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medication-status"}},
+	{Path: "Medication.marketingAuthorizationHolder", Name: "marketingAuthorizationHolder", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Medication.doseForm", Name: "doseForm", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Medication.packageSize", Name: "packageSize", Field: 17, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Medication.ingredient", Name: "ingredient", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Medication.instance", Name: "instance", Field: 19, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Medication.definition", Name: "definition", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *Medication) ElementMetadata() []ElementMetadata {
+	return medicationElementMetadata
+}
+
+func (r *Medication) GetResourceType() string {
+	return "Medication"
+}
+
+func (r *Medication) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Medication) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Medication) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Medication) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Medication) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Medication) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Medication) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Medication)(nil)
+
 type MedicationPackageSize struct {
-	Id            *string   `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	NumberOfItems *Quantity `json:"numberOfItems,omitempty" bson:"number_of_items,omitempty"` // The number of items in the package that are represented by the code
-	AmountPerItem *Ratio    `json:"amountPerItem,omitempty" bson:"amount_per_item,omitempty"` // The amount per each item
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	NumberOfItems     *Quantity   `json:"numberOfItems,omitempty" bson:"number_of_items,omitempty"`        // The number of items in the package that are represented by the code
+	AmountPerItem     *Ratio      `json:"amountPerItem,omitempty" bson:"amount_per_item,omitempty"`        // The amount per each item
 }
 
 func (r *MedicationPackageSize) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.NumberOfItems != nil {
 		if err := r.NumberOfItems.Validate(); err != nil {
 			return fmt.Errorf("NumberOfItems: %w", err)
@@ -102,17 +358,81 @@ func (r *MedicationPackageSize) Validate() error {
 	return nil
 }
 
+func (r *MedicationPackageSize) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NumberOfItems != nil {
+		r.NumberOfItems.validateAll(path+".numberOfItems", issues)
+	}
+	if r.AmountPerItem != nil {
+		r.AmountPerItem.validateAll(path+".amountPerItem", issues)
+	}
+}
+
+var medicationPackageSizeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationPackageSize) invariants() []invariant {
+	return medicationPackageSizeInvariants
+}
+
+func (r MedicationPackageSize) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationPackageSize) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationPackageSize) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationPackageSize) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationPackageSizeElementMetadata = []ElementMetadata{
+	{Path: "Medication.packageSize.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Medication.packageSize.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Medication.packageSize.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Medication.packageSize.numberOfItems", Name: "numberOfItems", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Medication.packageSize.amountPerItem", Name: "amountPerItem", Field: 4, Min: 0, Max: "1", Types: []string{"Ratio"}},
+}
+
+func (r *MedicationPackageSize) ElementMetadata() []ElementMetadata {
+	return medicationPackageSizeElementMetadata
+}
+
 type MedicationIngredient struct {
-	Id                      *string            `json:"id,omitempty" bson:"id,omitempty"`                                             // Unique id for inter-element referencing
-	Item                    *CodeableReference `json:"item" bson:"item"`                                                             // The specific substance or medication that is the ingredient
-	IsActive                *bool              `json:"isActive,omitempty" bson:"is_active,omitempty"`                                // Active ingredient indicator
-	Role                    *CodeableConcept   `json:"role,omitempty" bson:"role,omitempty"`                                         // A code that defines the type of ingredient, active, base, etc.
-	StrengthRatio           *Ratio             `json:"strengthRatio,omitempty" bson:"strength_ratio,omitempty"`                      // Quantity of ingredient present
-	StrengthCodeableConcept *CodeableConcept   `json:"strengthCodeableConcept,omitempty" bson:"strength_codeable_concept,omitempty"` // Quantity of ingredient present
-	StrengthQuantity        *Quantity          `json:"strengthQuantity,omitempty" bson:"strength_quantity,omitempty"`                // Quantity of ingredient present
+	Id                *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Item              *CodeableReference           `json:"item" bson:"item"`                                                // The specific substance or medication that is the ingredient
+	IsActive          *bool                        `json:"isActive,omitempty" bson:"is_active,omitempty"`                   // Active ingredient indicator
+	IsActiveElement   *Element                     `json:"_isActive,omitempty" bson:"is_active_element,omitempty"`          // Extensions for isActive
+	Role              *CodeableConcept             `json:"role,omitempty" bson:"role,omitempty"`                            // A code that defines the type of ingredient, active, base, etc.
+	Strength          MedicationIngredientStrength `json:"-" bson:"strength,omitempty"`                                     // Quantity of ingredient present
+
+	strengthVariants []string // JSON properties of Medication.ingredient.strength[x] when more than one was decoded
 }
 
 func (r *MedicationIngredient) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Item == nil {
 		return fmt.Errorf("field 'Item' is required")
 	}
@@ -121,41 +441,306 @@ func (r *MedicationIngredient) Validate() error {
 			return fmt.Errorf("Item: %w", err)
 		}
 	}
+	if r.IsActiveElement != nil {
+		if err := r.IsActiveElement.Validate(); err != nil {
+			return fmt.Errorf("IsActiveElement: %w", err)
+		}
+	}
 	if r.Role != nil {
 		if err := r.Role.Validate(); err != nil {
 			return fmt.Errorf("Role: %w", err)
 		}
 	}
-	if r.StrengthRatio != nil {
-		if err := r.StrengthRatio.Validate(); err != nil {
-			return fmt.Errorf("StrengthRatio: %w", err)
-		}
+	if len(r.strengthVariants) > 1 {
+		return fmt.Errorf("field 'Strength' must have a single type, got %v", r.strengthVariants)
 	}
-	if r.StrengthCodeableConcept != nil {
-		if err := r.StrengthCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("StrengthCodeableConcept: %w", err)
+	if r.Strength != nil {
+		if err := r.Strength.Validate(); err != nil {
+			return fmt.Errorf("Strength: %w", err)
 		}
 	}
-	if r.StrengthQuantity != nil {
-		if err := r.StrengthQuantity.Validate(); err != nil {
-			return fmt.Errorf("StrengthQuantity: %w", err)
-		}
+	return nil
+}
+
+func (r *MedicationIngredient) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Item == nil {
+		issues.add("required", path+".item", "field 'Item' is required")
+	}
+	if r.Item != nil {
+		r.Item.validateAll(path+".item", issues)
+	}
+	if r.IsActiveElement != nil {
+		r.IsActiveElement.validateAll(path+".isActive", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if len(r.strengthVariants) > 1 {
+		issues.add("structure", path+".strength", fmt.Sprintf("field 'Strength' must have a single type, got %v", r.strengthVariants))
+	}
+	if r.Strength != nil {
+		r.Strength.validateAll(path+".strength.ofType("+r.Strength.FHIRType()+")", issues)
+	}
+}
+
+var medicationIngredientInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationIngredient) invariants() []invariant {
+	return medicationIngredientInvariants
+}
+
+func (r MedicationIngredient) MarshalJSON() ([]byte, error) {
+	type alias MedicationIngredient
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "strength", r.Strength, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MedicationIngredient) UnmarshalJSON(data []byte) error {
+	type alias MedicationIngredient
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Strength, _, r.strengthVariants, err = unmarshalChoice(fields, "strength", medicationIngredientStrengthVariants); err != nil {
+		return err
 	}
 	return nil
 }
 
+func (r MedicationIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationIngredient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationIngredient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationIngredient) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicationIngredient) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Strength":
+		return "strength", choiceValues(medicationIngredientStrengthVariants)
+	}
+	return "", nil
+}
+
+var medicationIngredientElementMetadata = []ElementMetadata{
+	{Path: "Medication.ingredient.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Medication.ingredient.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Medication.ingredient.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Medication.ingredient.item", Name: "item", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Medication.ingredient.isActive", Name: "isActive", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Medication.ingredient.role", Name: "role", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Medication.ingredient.strength[x]", Name: "strength", Field: 7, Min: 0, Max: "1", Types: []string{"Ratio", "CodeableConcept", "Quantity"}},
+}
+
+func (r *MedicationIngredient) ElementMetadata() []ElementMetadata {
+	return medicationIngredientElementMetadata
+}
+
+// MedicationIngredientStrength is the type of Medication.ingredient.strength[x]. It is implemented by the MedicationIngredientStrength* variant types.
+type MedicationIngredientStrength interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicationIngredientStrength()
+}
+
+// MedicationIngredientStrengthRatio is the Ratio variant of MedicationIngredientStrength.
+type MedicationIngredientStrengthRatio struct {
+	Ratio
+}
+
+func (v MedicationIngredientStrengthRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v MedicationIngredientStrengthRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v MedicationIngredientStrengthRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v MedicationIngredientStrengthRatio) isMedicationIngredientStrength() {}
+
+// MedicationIngredientStrengthCodeableConcept is the CodeableConcept variant of MedicationIngredientStrength.
+type MedicationIngredientStrengthCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MedicationIngredientStrengthCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MedicationIngredientStrengthCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MedicationIngredientStrengthCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MedicationIngredientStrengthCodeableConcept) isMedicationIngredientStrength() {}
+
+// MedicationIngredientStrengthQuantity is the Quantity variant of MedicationIngredientStrength.
+type MedicationIngredientStrengthQuantity struct {
+	Quantity
+}
+
+func (v MedicationIngredientStrengthQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MedicationIngredientStrengthQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MedicationIngredientStrengthQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MedicationIngredientStrengthQuantity) isMedicationIngredientStrength() {}
+
+var medicationIngredientStrengthVariants = []MedicationIngredientStrength{
+	MedicationIngredientStrengthRatio{},
+	MedicationIngredientStrengthCodeableConcept{},
+	MedicationIngredientStrengthQuantity{},
+}
+
 type MedicationInstance struct {
-	Id             *string      `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Identifier     []Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`          // Identifier for the physical instance, typically a serial number
-	LotNumber      *string      `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`           // Identifier assigned to batch
-	ExpirationDate *string      `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"` // When instance will expire
+	Id                    *string      `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
+	Extension             []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension     []Extension  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored even if unrecognized
+	Identifier            []Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Identifier for the physical instance, typically a serial number
+	LotNumber             *string      `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`                    // Identifier assigned to batch
+	LotNumberElement      *Element     `json:"_lotNumber,omitempty" bson:"lot_number_element,omitempty"`           // Extensions for lotNumber
+	ExpirationDate        *DateTime    `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`          // When instance will expire
+	ExpirationDateElement *Element     `json:"_expirationDate,omitempty" bson:"expiration_date_element,omitempty"` // Extensions for expirationDate
 }
 
 func (r *MedicationInstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.LotNumberElement != nil {
+		if err := r.LotNumberElement.Validate(); err != nil {
+			return fmt.Errorf("LotNumberElement: %w", err)
+		}
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDate: %w", err)
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		if err := r.ExpirationDateElement.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDateElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *MedicationInstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.LotNumberElement != nil {
+		r.LotNumberElement.validateAll(path+".lotNumber", issues)
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			issues.add("value", path+".expirationDate", err.Error())
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		r.ExpirationDateElement.validateAll(path+".expirationDate", issues)
+	}
+}
+
+var medicationInstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationInstance) invariants() []invariant {
+	return medicationInstanceInvariants
+}
+
+func (r MedicationInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationInstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationInstanceElementMetadata = []ElementMetadata{
+	{Path: "Medication.instance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Medication.instance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Medication.instance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Medication.instance.identifier", Name: "identifier", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Medication.instance.lotNumber", Name: "lotNumber", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Medication.instance.expirationDate", Name: "expirationDate", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+}
+
+func (r *MedicationInstance) ElementMetadata() []ElementMetadata {
+	return medicationInstanceElementMetadata
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,24 +12,30 @@ type MedicationAdministration struct {
 	Id                    *string                             `json:"id,omitempty" bson:"id,omitempty"`                                        // Logical id of this artifact
 	Meta                  *Meta                               `json:"meta,omitempty" bson:"meta,omitempty"`                                    // Metadata about the resource
 	ImplicitRules         *string                             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                 // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`        // Extensions for implicitRules
 	Language              *string                             `json:"language,omitempty" bson:"language,omitempty"`                            // Language of the resource content
+	LanguageElement       *Element                            `json:"_language,omitempty" bson:"language_element,omitempty"`                   // Extensions for language
 	Text                  *Narrative                          `json:"text,omitempty" bson:"text,omitempty"`                                    // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage                   `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Contained             []Resource                          `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Extension             []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension     []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored
 	Identifier            []Identifier                        `json:"identifier,omitempty" bson:"identifier,omitempty"`                        // External identifier
 	BasedOn               []Reference                         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                             // Plan this is fulfilled by this administration
 	PartOf                []Reference                         `json:"partOf,omitempty" bson:"part_of,omitempty"`                               // Part of referenced event
-	Status                string                              `json:"status" bson:"status"`                                                    // in-progress | not-done | on-hold | completed | entered-in-error | stopped | unknown
+	Status                MedicationAdministrationStatusCodes `json:"status" bson:"status"`                                                    // in-progress | not-done | on-hold | completed | entered-in-error | stopped | unknown
+	StatusElement         *Element                            `json:"_status,omitempty" bson:"status_element,omitempty"`                       // Extensions for status
 	StatusReason          []CodeableConcept                   `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                   // Reason status of the administration changed
 	Category              []CodeableConcept                   `json:"category,omitempty" bson:"category,omitempty"`                            // Type of medication administration
 	Medication            *CodeableReference                  `json:"medication" bson:"medication"`                                            // What was administered
 	Subject               *Reference                          `json:"subject" bson:"subject"`                                                  // Who received medication
 	Encounter             *Reference                          `json:"encounter,omitempty" bson:"encounter,omitempty"`                          // Encounter administered as part of
 	SupportingInformation []Reference                         `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"` // Additional information to support administration
-	OccurrenceDateTime    *string                             `json:"occurrenceDateTime" bson:"occurrence_date_time"`                          // Specific date/time or interval of time during which the administration took place (or did not take place)
-	OccurrencePeriod      *Period                             `json:"occurrencePeriod" bson:"occurrence_period"`                               // Specific date/time or interval of time during which the administration took place (or did not take place)
-	OccurrenceTiming      *Timing                             `json:"occurrenceTiming" bson:"occurrence_timing"`                               // Specific date/time or interval of time during which the administration took place (or did not take place)
-	Recorded              *string                             `json:"recorded,omitempty" bson:"recorded,omitempty"`                            // When the MedicationAdministration was first captured in the subject's record
+	Occurrence            MedicationAdministrationOccurrence  `json:"-" bson:"occurrence,omitempty"`                                           // Specific date/time or interval of time during which the administration took place (or did not take place)
+	OccurrenceElement     *Element                            `json:"-" bson:"occurrence_element,omitempty"`                                   // Extensions for occurrence[x]
+	Recorded              *DateTime                           `json:"recorded,omitempty" bson:"recorded,omitempty"`                            // When the MedicationAdministration was first captured in the subject's record
+	RecordedElement       *Element                            `json:"_recorded,omitempty" bson:"recorded_element,omitempty"`                   // Extensions for recorded
 	IsSubPotent           *bool                               `json:"isSubPotent,omitempty" bson:"is_sub_potent,omitempty"`                    // An indication that the full ordered dose was not administered
+	IsSubPotentElement    *Element                            `json:"_isSubPotent,omitempty" bson:"is_sub_potent_element,omitempty"`           // Extensions for isSubPotent
 	SubPotentReason       []CodeableConcept                   `json:"subPotentReason,omitempty" bson:"sub_potent_reason,omitempty"`            // Reason full dose was not administered
 	Performer             []MedicationAdministrationPerformer `json:"performer,omitempty" bson:"performer,omitempty"`                          // Who or what performed the medication administration and what type of performance they did
 	Reason                []CodeableReference                 `json:"reason,omitempty" bson:"reason,omitempty"`                                // Reason that supports why the medication was administered
@@ -37,8 +44,16 @@ type MedicationAdministration struct {
 	Note                  []Annotation                        `json:"note,omitempty" bson:"note,omitempty"`                                    // Information about the administration
 	Dosage                *MedicationAdministrationDosage     `json:"dosage,omitempty" bson:"dosage,omitempty"`                                // Details of how medication was taken
 	EventHistory          []Reference                         `json:"eventHistory,omitempty" bson:"event_history,omitempty"`                   // A list of events of interest in the lifecycle
+
+	occurrenceVariants []string // JSON properties of MedicationAdministration.occurrence[x] when more than one was decoded
 }
 
+var medicationAdministrationSubjectTargets = []string{"Group", "Patient"}
+
+var medicationAdministrationEncounterTargets = []string{"Encounter"}
+
+var medicationAdministrationRequestTargets = []string{"MedicationRequest"}
+
 func (r *MedicationAdministration) Validate() error {
 	if r.ResourceType != "MedicationAdministration" {
 		return fmt.Errorf("invalid resourceType: expected 'MedicationAdministration', got '%s'", r.ResourceType)
@@ -48,11 +63,39 @@ func (r *MedicationAdministration) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -68,10 +111,17 @@ func (r *MedicationAdministration) Validate() error {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusReason[%d]: %w", i, err)
@@ -93,11 +143,21 @@ func (r *MedicationAdministration) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationAdministrationSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationAdministrationSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationAdministrationEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationAdministrationEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -108,23 +168,35 @@ func (r *MedicationAdministration) Validate() error {
 			return fmt.Errorf("SupportingInformation[%d]: %w", i, err)
 		}
 	}
-	if r.OccurrenceDateTime == nil {
-		return fmt.Errorf("field 'OccurrenceDateTime' is required")
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
 	}
-	if r.OccurrencePeriod == nil {
-		return fmt.Errorf("field 'OccurrencePeriod' is required")
+	if r.Occurrence == nil {
+		return fmt.Errorf("field 'Occurrence' is required")
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
 		}
 	}
-	if r.OccurrenceTiming == nil {
-		return fmt.Errorf("field 'OccurrenceTiming' is required")
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
 	}
-	if r.OccurrenceTiming != nil {
-		if err := r.OccurrenceTiming.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceTiming: %w", err)
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			return fmt.Errorf("Recorded: %w", err)
+		}
+	}
+	if r.RecordedElement != nil {
+		if err := r.RecordedElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedElement: %w", err)
+		}
+	}
+	if r.IsSubPotentElement != nil {
+		if err := r.IsSubPotentElement.Validate(); err != nil {
+			return fmt.Errorf("IsSubPotentElement: %w", err)
 		}
 	}
 	for i, item := range r.SubPotentReason {
@@ -142,6 +214,11 @@ func (r *MedicationAdministration) Validate() error {
 			return fmt.Errorf("Reason[%d]: %w", i, err)
 		}
 	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, medicationAdministrationRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request' cannot refer to %s, expected one of %v", target, medicationAdministrationRequestTargets)
+		}
+	}
 	if r.Request != nil {
 		if err := r.Request.Validate(); err != nil {
 			return fmt.Errorf("Request: %w", err)
@@ -167,16 +244,415 @@ func (r *MedicationAdministration) Validate() error {
 			return fmt.Errorf("EventHistory[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "MedicationAdministration")
+}
+
+func (r *MedicationAdministration) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MedicationAdministration" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MedicationAdministration', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.StatusReason {
+		item.validateAll(fmt.Sprintf("%s.statusReason[%d]", path, i), issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Medication == nil {
+		issues.add("required", path+".medication", "field 'Medication' is required")
+	}
+	if r.Medication != nil {
+		r.Medication.validateAll(path+".medication", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationAdministrationSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationAdministrationSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationAdministrationEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationAdministrationEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	for i, item := range r.SupportingInformation {
+		item.validateAll(fmt.Sprintf("%s.supportingInformation[%d]", path, i), issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence == nil {
+		issues.add("required", path+".occurrence", "field 'Occurrence' is required")
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			issues.add("value", path+".recorded", err.Error())
+		}
+	}
+	if r.RecordedElement != nil {
+		r.RecordedElement.validateAll(path+".recorded", issues)
+	}
+	if r.IsSubPotentElement != nil {
+		r.IsSubPotentElement.validateAll(path+".isSubPotent", issues)
+	}
+	for i, item := range r.SubPotentReason {
+		item.validateAll(fmt.Sprintf("%s.subPotentReason[%d]", path, i), issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, medicationAdministrationRequestTargets); target != "" {
+			issues.add("value", path+".request", fmt.Sprintf("field 'Request' cannot refer to %s, expected one of %v", target, medicationAdministrationRequestTargets))
+		}
+	}
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
+	}
+	for i, item := range r.Device {
+		item.validateAll(fmt.Sprintf("%s.device[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.Dosage != nil {
+		r.Dosage.validateAll(path+".dosage", issues)
+	}
+	for i, item := range r.EventHistory {
+		item.validateAll(fmt.Sprintf("%s.eventHistory[%d]", path, i), issues)
+	}
+}
+
+func (r *MedicationAdministration) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MedicationAdministration", &issues)
+	checkInvariants(r, "MedicationAdministration", &issues)
+	return issues
+}
+
+var medicationAdministrationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MedicationAdministration) invariants() []invariant {
+	return medicationAdministrationInvariants
+}
+
+func (r MedicationAdministration) MarshalJSON() ([]byte, error) {
+	type alias MedicationAdministration
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MedicationAdministration) UnmarshalJSON(data []byte) error {
+	type alias MedicationAdministration
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", medicationAdministrationOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r MedicationAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationAdministration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationAdministration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationAdministration) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicationAdministration) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(medicationAdministrationOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var medicationAdministrationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *MedicationAdministration) elementTypes() map[string]string {
+	return medicationAdministrationElementTypes
+}
+
+var medicationAdministrationElementMetadata = []ElementMetadata{
+	{Path: "MedicationAdministration.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MedicationAdministration.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MedicationAdministration.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MedicationAdministration.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationAdministration.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MedicationAdministration.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MedicationAdministration.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MedicationAdministration.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationAdministration.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationAdministration.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MedicationAdministration.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationAdministration.partOf", Name: "partOf", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationAdministration.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationAdministration.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medication-admin-status"}},
+	{Path: "MedicationAdministration.statusReason", Name: "statusReason", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.category", Name: "category", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.medication", Name: "medication", Field: 18, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicationAdministration.subject", Name: "subject", Field: 19, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "MedicationAdministration.encounter", Name: "encounter", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "MedicationAdministration.supportingInformation", Name: "supportingInformation", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationAdministration.occurrence[x]", Name: "occurrence", Field: 22, Min: 1, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "MedicationAdministration.recorded", Name: "recorded", Field: 24, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationAdministration.isSubPotent", Name: "isSubPotent", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "MedicationAdministration.subPotentReason", Name: "subPotentReason", Field: 28, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.performer", Name: "performer", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicationAdministration.reason", Name: "reason", Field: 30, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "MedicationAdministration.request", Name: "request", Field: 31, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/MedicationRequest"}},
+	{Path: "MedicationAdministration.device", Name: "device", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "MedicationAdministration.note", Name: "note", Field: 33, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "MedicationAdministration.dosage", Name: "dosage", Field: 34, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MedicationAdministration.eventHistory", Name: "eventHistory", Field: 35, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *MedicationAdministration) ElementMetadata() []ElementMetadata {
+	return medicationAdministrationElementMetadata
+}
+
+// MedicationAdministrationOccurrence is the type of MedicationAdministration.occurrence[x]. It is implemented by the MedicationAdministrationOccurrence* variant types.
+type MedicationAdministrationOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicationAdministrationOccurrence()
+}
+
+// MedicationAdministrationOccurrenceDateTime is the dateTime variant of MedicationAdministrationOccurrence.
+type MedicationAdministrationOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v MedicationAdministrationOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v MedicationAdministrationOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v MedicationAdministrationOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v MedicationAdministrationOccurrenceDateTime) isMedicationAdministrationOccurrence() {}
+
+// MedicationAdministrationOccurrencePeriod is the Period variant of MedicationAdministrationOccurrence.
+type MedicationAdministrationOccurrencePeriod struct {
+	Period
+}
+
+func (v MedicationAdministrationOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v MedicationAdministrationOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v MedicationAdministrationOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v MedicationAdministrationOccurrencePeriod) isMedicationAdministrationOccurrence() {}
+
+// MedicationAdministrationOccurrenceTiming is the Timing variant of MedicationAdministrationOccurrence.
+type MedicationAdministrationOccurrenceTiming struct {
+	Timing
+}
+
+func (v MedicationAdministrationOccurrenceTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v MedicationAdministrationOccurrenceTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v MedicationAdministrationOccurrenceTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v MedicationAdministrationOccurrenceTiming) isMedicationAdministrationOccurrence() {}
+
+var medicationAdministrationOccurrenceVariants = []MedicationAdministrationOccurrence{
+	MedicationAdministrationOccurrenceDateTime{},
+	MedicationAdministrationOccurrencePeriod{},
+	MedicationAdministrationOccurrenceTiming{},
+}
+
+func (r *MedicationAdministration) GetResourceType() string {
+	return "MedicationAdministration"
+}
+
+func (r *MedicationAdministration) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MedicationAdministration) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MedicationAdministration) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MedicationAdministration) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MedicationAdministration) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MedicationAdministration) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MedicationAdministration) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MedicationAdministration)(nil)
+
 type MedicationAdministrationPerformer struct {
-	Id       *string            `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept   `json:"function,omitempty" bson:"function,omitempty"` // Type of performance
-	Actor    *CodeableReference `json:"actor" bson:"actor"`                           // Who or what performed the medication administration
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept   `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performance
+	Actor             *CodeableReference `json:"actor" bson:"actor"`                                              // Who or what performed the medication administration
 }
 
 func (r *MedicationAdministrationPerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -193,18 +669,91 @@ func (r *MedicationAdministrationPerformer) Validate() error {
 	return nil
 }
 
+func (r *MedicationAdministrationPerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var medicationAdministrationPerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationAdministrationPerformer) invariants() []invariant {
+	return medicationAdministrationPerformerInvariants
+}
+
+func (r MedicationAdministrationPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationAdministrationPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationAdministrationPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationAdministrationPerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationAdministrationPerformerElementMetadata = []ElementMetadata{
+	{Path: "MedicationAdministration.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationAdministration.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationAdministration.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationAdministration.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+}
+
+func (r *MedicationAdministrationPerformer) ElementMetadata() []ElementMetadata {
+	return medicationAdministrationPerformerElementMetadata
+}
+
 type MedicationAdministrationDosage struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Text         *string          `json:"text,omitempty" bson:"text,omitempty"`                  // Free text dosage instructions e.g. SIG
-	Site         *CodeableConcept `json:"site,omitempty" bson:"site,omitempty"`                  // Body site administered to
-	Route        *CodeableConcept `json:"route,omitempty" bson:"route,omitempty"`                // Path of substance into body
-	Method       *CodeableConcept `json:"method,omitempty" bson:"method,omitempty"`              // How drug was administered
-	Dose         *Quantity        `json:"dose,omitempty" bson:"dose,omitempty"`                  // Amount of medication per dose
-	RateRatio    *Ratio           `json:"rateRatio,omitempty" bson:"rate_ratio,omitempty"`       // Dose quantity per unit of time
-	RateQuantity *Quantity        `json:"rateQuantity,omitempty" bson:"rate_quantity,omitempty"` // Dose quantity per unit of time
+	Id                *string                            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Text              *string                            `json:"text,omitempty" bson:"text,omitempty"`                            // Free text dosage instructions e.g. SIG
+	TextElement       *Element                           `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+	Site              *CodeableConcept                   `json:"site,omitempty" bson:"site,omitempty"`                            // Body site administered to
+	Route             *CodeableConcept                   `json:"route,omitempty" bson:"route,omitempty"`                          // Path of substance into body
+	Method            *CodeableConcept                   `json:"method,omitempty" bson:"method,omitempty"`                        // How drug was administered
+	Dose              *Quantity                          `json:"dose,omitempty" bson:"dose,omitempty"`                            // Amount of medication per dose
+	Rate              MedicationAdministrationDosageRate `json:"-" bson:"rate,omitempty"`                                         // Dose quantity per unit of time
+
+	rateVariants []string // JSON properties of MedicationAdministration.dosage.rate[x] when more than one was decoded
 }
 
 func (r *MedicationAdministrationDosage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	if r.Site != nil {
 		if err := r.Site.Validate(); err != nil {
 			return fmt.Errorf("Site: %w", err)
@@ -225,15 +774,176 @@ func (r *MedicationAdministrationDosage) Validate() error {
 			return fmt.Errorf("Dose: %w", err)
 		}
 	}
-	if r.RateRatio != nil {
-		if err := r.RateRatio.Validate(); err != nil {
-			return fmt.Errorf("RateRatio: %w", err)
-		}
+	if len(r.rateVariants) > 1 {
+		return fmt.Errorf("field 'Rate' must have a single type, got %v", r.rateVariants)
 	}
-	if r.RateQuantity != nil {
-		if err := r.RateQuantity.Validate(); err != nil {
-			return fmt.Errorf("RateQuantity: %w", err)
+	if r.Rate != nil {
+		if err := r.Rate.Validate(); err != nil {
+			return fmt.Errorf("Rate: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r *MedicationAdministrationDosage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	if r.Site != nil {
+		r.Site.validateAll(path+".site", issues)
+	}
+	if r.Route != nil {
+		r.Route.validateAll(path+".route", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if r.Dose != nil {
+		r.Dose.validateAll(path+".dose", issues)
+	}
+	if len(r.rateVariants) > 1 {
+		issues.add("structure", path+".rate", fmt.Sprintf("field 'Rate' must have a single type, got %v", r.rateVariants))
+	}
+	if r.Rate != nil {
+		r.Rate.validateAll(path+".rate.ofType("+r.Rate.FHIRType()+")", issues)
+	}
+}
+
+var medicationAdministrationDosageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationAdministrationDosage) invariants() []invariant {
+	return medicationAdministrationDosageInvariants
+}
+
+func (r MedicationAdministrationDosage) MarshalJSON() ([]byte, error) {
+	type alias MedicationAdministrationDosage
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "rate", r.Rate, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MedicationAdministrationDosage) UnmarshalJSON(data []byte) error {
+	type alias MedicationAdministrationDosage
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Rate, _, r.rateVariants, err = unmarshalChoice(fields, "rate", medicationAdministrationDosageRateVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r MedicationAdministrationDosage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationAdministrationDosage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationAdministrationDosage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationAdministrationDosage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicationAdministrationDosage) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Rate":
+		return "rate", choiceValues(medicationAdministrationDosageRateVariants)
+	}
+	return "", nil
+}
+
+var medicationAdministrationDosageElementMetadata = []ElementMetadata{
+	{Path: "MedicationAdministration.dosage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationAdministration.dosage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationAdministration.dosage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationAdministration.dosage.text", Name: "text", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationAdministration.dosage.site", Name: "site", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.dosage.route", Name: "route", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.dosage.method", Name: "method", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationAdministration.dosage.dose", Name: "dose", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MedicationAdministration.dosage.rate[x]", Name: "rate", Field: 9, Min: 0, Max: "1", Types: []string{"Ratio", "Quantity"}},
+}
+
+func (r *MedicationAdministrationDosage) ElementMetadata() []ElementMetadata {
+	return medicationAdministrationDosageElementMetadata
+}
+
+// MedicationAdministrationDosageRate is the type of MedicationAdministration.dosage.rate[x]. It is implemented by the MedicationAdministrationDosageRate* variant types.
+type MedicationAdministrationDosageRate interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicationAdministrationDosageRate()
+}
+
+// MedicationAdministrationDosageRateRatio is the Ratio variant of MedicationAdministrationDosageRate.
+type MedicationAdministrationDosageRateRatio struct {
+	Ratio
+}
+
+func (v MedicationAdministrationDosageRateRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v MedicationAdministrationDosageRateRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v MedicationAdministrationDosageRateRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v MedicationAdministrationDosageRateRatio) isMedicationAdministrationDosageRate() {}
+
+// MedicationAdministrationDosageRateQuantity is the Quantity variant of MedicationAdministrationDosageRate.
+type MedicationAdministrationDosageRateQuantity struct {
+	Quantity
+}
+
+func (v MedicationAdministrationDosageRateQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MedicationAdministrationDosageRateQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MedicationAdministrationDosageRateQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MedicationAdministrationDosageRateQuantity) isMedicationAdministrationDosageRate() {}
+
+var medicationAdministrationDosageRateVariants = []MedicationAdministrationDosageRate{
+	MedicationAdministrationDosageRateRatio{},
+	MedicationAdministrationDosageRateQuantity{},
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,15 +12,21 @@ type MedicationDispense struct {
 	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                            // Logical id of this artifact
 	Meta                    *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                                        // Metadata about the resource
 	ImplicitRules           *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                     // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`            // Extensions for implicitRules
 	Language                *string                         `json:"language,omitempty" bson:"language,omitempty"`                                // Language of the resource content
+	LanguageElement         *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`                       // Extensions for language
 	Text                    *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                                        // Text summary of the resource, for human interpretation
-	Contained               []json.RawMessage               `json:"contained,omitempty" bson:"contained,omitempty"`                              // Contained, inline Resources
+	Contained               []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                              // Contained, inline Resources
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                              // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`             // Extensions that cannot be ignored
 	Identifier              []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                            // External identifier
 	BasedOn                 []Reference                     `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                 // Plan that is fulfilled by this dispense
 	PartOf                  []Reference                     `json:"partOf,omitempty" bson:"part_of,omitempty"`                                   // Event that dispense is part of
-	Status                  string                          `json:"status" bson:"status"`                                                        // preparation | in-progress | cancelled | on-hold | completed | entered-in-error | unfulfilled | declined | unknown
+	Status                  MedicationDispenseStatusCodes   `json:"status" bson:"status"`                                                        // preparation | in-progress | cancelled | on-hold | completed | entered-in-error | unfulfilled | declined | unknown
+	StatusElement           *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                           // Extensions for status
 	NotPerformedReason      *CodeableReference              `json:"notPerformedReason,omitempty" bson:"not_performed_reason,omitempty"`          // Why a dispense was not performed
-	StatusChanged           *string                         `json:"statusChanged,omitempty" bson:"status_changed,omitempty"`                     // When the status changed
+	StatusChanged           *DateTime                       `json:"statusChanged,omitempty" bson:"status_changed,omitempty"`                     // When the status changed
+	StatusChangedElement    *Element                        `json:"_statusChanged,omitempty" bson:"status_changed_element,omitempty"`            // Extensions for statusChanged
 	Category                []CodeableConcept               `json:"category,omitempty" bson:"category,omitempty"`                                // Type of medication dispense
 	Medication              *CodeableReference              `json:"medication" bson:"medication"`                                                // What medication was (or was intended to be) supplied
 	Subject                 *Reference                      `json:"subject" bson:"subject"`                                                      // Who the dispense is for
@@ -32,9 +39,13 @@ type MedicationDispense struct {
 	Quantity                *Quantity                       `json:"quantity,omitempty" bson:"quantity,omitempty"`                                // Amount of medication
 	DaysSupply              *Quantity                       `json:"daysSupply,omitempty" bson:"days_supply,omitempty"`                           // Amount of medication expressed as a timing amount
 	FillNumber              *int                            `json:"fillNumber,omitempty" bson:"fill_number,omitempty"`                           // A number that represents the known fill this dispense represents
-	Recorded                *string                         `json:"recorded,omitempty" bson:"recorded,omitempty"`                                // When the recording of the dispense started
-	WhenPrepared            *string                         `json:"whenPrepared,omitempty" bson:"when_prepared,omitempty"`                       // When product was packaged and reviewed
-	WhenHandedOver          *string                         `json:"whenHandedOver,omitempty" bson:"when_handed_over,omitempty"`                  // When product was given out
+	FillNumberElement       *Element                        `json:"_fillNumber,omitempty" bson:"fill_number_element,omitempty"`                  // Extensions for fillNumber
+	Recorded                *DateTime                       `json:"recorded,omitempty" bson:"recorded,omitempty"`                                // When the recording of the dispense started
+	RecordedElement         *Element                        `json:"_recorded,omitempty" bson:"recorded_element,omitempty"`                       // Extensions for recorded
+	WhenPrepared            *DateTime                       `json:"whenPrepared,omitempty" bson:"when_prepared,omitempty"`                       // When product was packaged and reviewed
+	WhenPreparedElement     *Element                        `json:"_whenPrepared,omitempty" bson:"when_prepared_element,omitempty"`              // Extensions for whenPrepared
+	WhenHandedOver          *DateTime                       `json:"whenHandedOver,omitempty" bson:"when_handed_over,omitempty"`                  // When product was given out
+	WhenHandedOverElement   *Element                        `json:"_whenHandedOver,omitempty" bson:"when_handed_over_element,omitempty"`         // Extensions for whenHandedOver
 	Destination             *Reference                      `json:"destination,omitempty" bson:"destination,omitempty"`                          // Where the medication was/will be sent
 	Receiver                []Reference                     `json:"receiver,omitempty" bson:"receiver,omitempty"`                                // Who collected the medication or where the medication was delivered
 	Note                    []Annotation                    `json:"note,omitempty" bson:"note,omitempty"`                                        // Information about the dispense
@@ -44,6 +55,18 @@ type MedicationDispense struct {
 	EventHistory            []Reference                     `json:"eventHistory,omitempty" bson:"event_history,omitempty"`                       // A list of relevant lifecycle events
 }
 
+var medicationDispenseSubjectTargets = []string{"Group", "Patient"}
+
+var medicationDispenseEncounterTargets = []string{"Encounter"}
+
+var medicationDispenseLocationTargets = []string{"Location"}
+
+var medicationDispenseAuthorizingPrescriptionTargets = []string{"MedicationRequest"}
+
+var medicationDispenseDestinationTargets = []string{"Location"}
+
+var medicationDispenseReceiverTargets = []string{"Group", "Location", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *MedicationDispense) Validate() error {
 	if r.ResourceType != "MedicationDispense" {
 		return fmt.Errorf("invalid resourceType: expected 'MedicationDispense', got '%s'", r.ResourceType)
@@ -53,11 +76,39 @@ func (r *MedicationDispense) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -73,15 +124,32 @@ func (r *MedicationDispense) Validate() error {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.NotPerformedReason != nil {
 		if err := r.NotPerformedReason.Validate(); err != nil {
 			return fmt.Errorf("NotPerformedReason: %w", err)
 		}
 	}
+	if r.StatusChanged != nil {
+		if err := r.StatusChanged.Validate(); err != nil {
+			return fmt.Errorf("StatusChanged: %w", err)
+		}
+	}
+	if r.StatusChangedElement != nil {
+		if err := r.StatusChangedElement.Validate(); err != nil {
+			return fmt.Errorf("StatusChangedElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -98,11 +166,21 @@ func (r *MedicationDispense) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationDispenseSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationDispenseSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationDispenseEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationDispenseEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -118,11 +196,21 @@ func (r *MedicationDispense) Validate() error {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
 		}
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, medicationDispenseLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, medicationDispenseLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
 		}
 	}
+	for i, item := range r.AuthorizingPrescription {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationDispenseAuthorizingPrescriptionTargets); target != "" {
+			return fmt.Errorf("field 'AuthorizingPrescription[%d]' cannot refer to %s, expected one of %v", i, target, medicationDispenseAuthorizingPrescriptionTargets)
+		}
+	}
 	for i, item := range r.AuthorizingPrescription {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("AuthorizingPrescription[%d]: %w", i, err)
@@ -143,11 +231,56 @@ func (r *MedicationDispense) Validate() error {
 			return fmt.Errorf("DaysSupply: %w", err)
 		}
 	}
+	if r.FillNumberElement != nil {
+		if err := r.FillNumberElement.Validate(); err != nil {
+			return fmt.Errorf("FillNumberElement: %w", err)
+		}
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			return fmt.Errorf("Recorded: %w", err)
+		}
+	}
+	if r.RecordedElement != nil {
+		if err := r.RecordedElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedElement: %w", err)
+		}
+	}
+	if r.WhenPrepared != nil {
+		if err := r.WhenPrepared.Validate(); err != nil {
+			return fmt.Errorf("WhenPrepared: %w", err)
+		}
+	}
+	if r.WhenPreparedElement != nil {
+		if err := r.WhenPreparedElement.Validate(); err != nil {
+			return fmt.Errorf("WhenPreparedElement: %w", err)
+		}
+	}
+	if r.WhenHandedOver != nil {
+		if err := r.WhenHandedOver.Validate(); err != nil {
+			return fmt.Errorf("WhenHandedOver: %w", err)
+		}
+	}
+	if r.WhenHandedOverElement != nil {
+		if err := r.WhenHandedOverElement.Validate(); err != nil {
+			return fmt.Errorf("WhenHandedOverElement: %w", err)
+		}
+	}
+	if r.Destination != nil {
+		if target := invalidReferenceTarget(r.Destination.Reference, r.Destination.Type, medicationDispenseDestinationTargets); target != "" {
+			return fmt.Errorf("field 'Destination' cannot refer to %s, expected one of %v", target, medicationDispenseDestinationTargets)
+		}
+	}
 	if r.Destination != nil {
 		if err := r.Destination.Validate(); err != nil {
 			return fmt.Errorf("Destination: %w", err)
 		}
 	}
+	for i, item := range r.Receiver {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationDispenseReceiverTargets); target != "" {
+			return fmt.Errorf("field 'Receiver[%d]' cannot refer to %s, expected one of %v", i, target, medicationDispenseReceiverTargets)
+		}
+	}
 	for i, item := range r.Receiver {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Receiver[%d]: %w", i, err)
@@ -178,16 +311,356 @@ func (r *MedicationDispense) Validate() error {
 			return fmt.Errorf("EventHistory[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "MedicationDispense")
+}
+
+func (r *MedicationDispense) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MedicationDispense" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MedicationDispense', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.NotPerformedReason != nil {
+		r.NotPerformedReason.validateAll(path+".notPerformedReason", issues)
+	}
+	if r.StatusChanged != nil {
+		if err := r.StatusChanged.Validate(); err != nil {
+			issues.add("value", path+".statusChanged", err.Error())
+		}
+	}
+	if r.StatusChangedElement != nil {
+		r.StatusChangedElement.validateAll(path+".statusChanged", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Medication == nil {
+		issues.add("required", path+".medication", "field 'Medication' is required")
+	}
+	if r.Medication != nil {
+		r.Medication.validateAll(path+".medication", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationDispenseSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationDispenseSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationDispenseEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationDispenseEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	for i, item := range r.SupportingInformation {
+		item.validateAll(fmt.Sprintf("%s.supportingInformation[%d]", path, i), issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, medicationDispenseLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, medicationDispenseLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.AuthorizingPrescription {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationDispenseAuthorizingPrescriptionTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.authorizingPrescription[%d]", path, i), fmt.Sprintf("field 'AuthorizingPrescription[%d]' cannot refer to %s, expected one of %v", i, target, medicationDispenseAuthorizingPrescriptionTargets))
+		}
+	}
+	for i, item := range r.AuthorizingPrescription {
+		item.validateAll(fmt.Sprintf("%s.authorizingPrescription[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.DaysSupply != nil {
+		r.DaysSupply.validateAll(path+".daysSupply", issues)
+	}
+	if r.FillNumberElement != nil {
+		r.FillNumberElement.validateAll(path+".fillNumber", issues)
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			issues.add("value", path+".recorded", err.Error())
+		}
+	}
+	if r.RecordedElement != nil {
+		r.RecordedElement.validateAll(path+".recorded", issues)
+	}
+	if r.WhenPrepared != nil {
+		if err := r.WhenPrepared.Validate(); err != nil {
+			issues.add("value", path+".whenPrepared", err.Error())
+		}
+	}
+	if r.WhenPreparedElement != nil {
+		r.WhenPreparedElement.validateAll(path+".whenPrepared", issues)
+	}
+	if r.WhenHandedOver != nil {
+		if err := r.WhenHandedOver.Validate(); err != nil {
+			issues.add("value", path+".whenHandedOver", err.Error())
+		}
+	}
+	if r.WhenHandedOverElement != nil {
+		r.WhenHandedOverElement.validateAll(path+".whenHandedOver", issues)
+	}
+	if r.Destination != nil {
+		if target := invalidReferenceTarget(r.Destination.Reference, r.Destination.Type, medicationDispenseDestinationTargets); target != "" {
+			issues.add("value", path+".destination", fmt.Sprintf("field 'Destination' cannot refer to %s, expected one of %v", target, medicationDispenseDestinationTargets))
+		}
+	}
+	if r.Destination != nil {
+		r.Destination.validateAll(path+".destination", issues)
+	}
+	for i, item := range r.Receiver {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationDispenseReceiverTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.receiver[%d]", path, i), fmt.Sprintf("field 'Receiver[%d]' cannot refer to %s, expected one of %v", i, target, medicationDispenseReceiverTargets))
+		}
+	}
+	for i, item := range r.Receiver {
+		item.validateAll(fmt.Sprintf("%s.receiver[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.DosageInstruction != nil {
+		r.DosageInstruction.validateAll(path+".dosageInstruction", issues)
+	}
+	if r.DoseAdministrationAid != nil {
+		r.DoseAdministrationAid.validateAll(path+".doseAdministrationAid", issues)
+	}
+	if r.Substitution != nil {
+		r.Substitution.validateAll(path+".substitution", issues)
+	}
+	for i, item := range r.EventHistory {
+		item.validateAll(fmt.Sprintf("%s.eventHistory[%d]", path, i), issues)
+	}
+}
+
+func (r *MedicationDispense) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MedicationDispense", &issues)
+	checkInvariants(r, "MedicationDispense", &issues)
+	return issues
+}
+
+var medicationDispenseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MedicationDispense) invariants() []invariant {
+	return medicationDispenseInvariants
+}
+
+func (r *MedicationDispense) UnmarshalJSON(data []byte) error {
+	type alias MedicationDispense
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r MedicationDispense) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationDispense) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationDispense) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationDispense) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationDispenseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"fillNumber":    "positiveInt",
+}
+
+func (r *MedicationDispense) elementTypes() map[string]string {
+	return medicationDispenseElementTypes
+}
+
+var medicationDispenseElementMetadata = []ElementMetadata{
+	{Path: "MedicationDispense.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MedicationDispense.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MedicationDispense.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MedicationDispense.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationDispense.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MedicationDispense.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MedicationDispense.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MedicationDispense.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationDispense.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationDispense.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MedicationDispense.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationDispense.partOf", Name: "partOf", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationDispense.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationDispense.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medicationdispense-status"}},
+	{Path: "MedicationDispense.notPerformedReason", Name: "notPerformedReason", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicationDispense.statusChanged", Name: "statusChanged", Field: 17, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationDispense.category", Name: "category", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationDispense.medication", Name: "medication", Field: 20, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicationDispense.subject", Name: "subject", Field: 21, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "MedicationDispense.encounter", Name: "encounter", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "MedicationDispense.supportingInformation", Name: "supportingInformation", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationDispense.performer", Name: "performer", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicationDispense.location", Name: "location", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "MedicationDispense.authorizingPrescription", Name: "authorizingPrescription", Field: 26, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/MedicationRequest"}},
+	{Path: "MedicationDispense.type", Name: "type", Field: 27, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationDispense.quantity", Name: "quantity", Field: 28, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MedicationDispense.daysSupply", Name: "daysSupply", Field: 29, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MedicationDispense.fillNumber", Name: "fillNumber", Field: 30, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "MedicationDispense.recorded", Name: "recorded", Field: 32, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationDispense.whenPrepared", Name: "whenPrepared", Field: 34, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationDispense.whenHandedOver", Name: "whenHandedOver", Field: 36, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationDispense.destination", Name: "destination", Field: 38, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "MedicationDispense.receiver", Name: "receiver", Field: 39, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "MedicationDispense.note", Name: "note", Field: 40, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "MedicationDispense.dosageInstruction", Name: "dosageInstruction", Field: 41, Min: 0, Max: "1", Types: []string{"DosageDetails"}},
+	{Path: "MedicationDispense.doseAdministrationAid", Name: "doseAdministrationAid", Field: 42, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationDispense.substitution", Name: "substitution", Field: 43, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MedicationDispense.eventHistory", Name: "eventHistory", Field: 44, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *MedicationDispense) ElementMetadata() []ElementMetadata {
+	return medicationDispenseElementMetadata
+}
+
+func (r *MedicationDispense) GetResourceType() string {
+	return "MedicationDispense"
+}
+
+func (r *MedicationDispense) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MedicationDispense) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MedicationDispense) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MedicationDispense) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MedicationDispense) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MedicationDispense) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MedicationDispense) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MedicationDispense)(nil)
+
 type MedicationDispensePerformer struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Who performed the dispense and what they did
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Individual who was performing
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Who performed the dispense and what they did
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Individual who was performing
 }
 
+var medicationDispensePerformerActorTargets = []string{"CareTeam", "Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *MedicationDispensePerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -196,6 +669,11 @@ func (r *MedicationDispensePerformer) Validate() error {
 	if r.Actor == nil {
 		return fmt.Errorf("field 'Actor' is required")
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, medicationDispensePerformerActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, medicationDispensePerformerActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -204,15 +682,94 @@ func (r *MedicationDispensePerformer) Validate() error {
 	return nil
 }
 
+func (r *MedicationDispensePerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, medicationDispensePerformerActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, medicationDispensePerformerActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var medicationDispensePerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationDispensePerformer) invariants() []invariant {
+	return medicationDispensePerformerInvariants
+}
+
+func (r MedicationDispensePerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationDispensePerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationDispensePerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationDispensePerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationDispensePerformerElementMetadata = []ElementMetadata{
+	{Path: "MedicationDispense.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationDispense.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationDispense.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationDispense.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationDispense.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *MedicationDispensePerformer) ElementMetadata() []ElementMetadata {
+	return medicationDispensePerformerElementMetadata
+}
+
 type MedicationDispenseSubstitution struct {
-	Id               *string           `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	WasSubstituted   bool              `json:"wasSubstituted" bson:"was_substituted"`                         // Whether a substitution was or was not performed on the dispense
-	Type             *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                          // Code signifying whether a different drug was dispensed from what was prescribed
-	Reason           []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                      // Why was substitution made
-	ResponsibleParty *Reference        `json:"responsibleParty,omitempty" bson:"responsible_party,omitempty"` // Who is responsible for the substitution
+	Id                    *string           `json:"id,omitempty" bson:"id,omitempty"`                                   // Unique id for inter-element referencing
+	Extension             []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension     []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored even if unrecognized
+	WasSubstituted        bool              `json:"wasSubstituted" bson:"was_substituted"`                              // Whether a substitution was or was not performed on the dispense
+	WasSubstitutedElement *Element          `json:"_wasSubstituted,omitempty" bson:"was_substituted_element,omitempty"` // Extensions for wasSubstituted
+	Type                  *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                               // Code signifying whether a different drug was dispensed from what was prescribed
+	Reason                []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                           // Why was substitution made
+	ResponsibleParty      *Reference        `json:"responsibleParty,omitempty" bson:"responsible_party,omitempty"`      // Who is responsible for the substitution
 }
 
+var medicationDispenseSubstitutionResponsiblePartyTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
 func (r *MedicationDispenseSubstitution) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.WasSubstitutedElement != nil {
+		if err := r.WasSubstitutedElement.Validate(); err != nil {
+			return fmt.Errorf("WasSubstitutedElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -223,6 +780,11 @@ func (r *MedicationDispenseSubstitution) Validate() error {
 			return fmt.Errorf("Reason[%d]: %w", i, err)
 		}
 	}
+	if r.ResponsibleParty != nil {
+		if target := invalidReferenceTarget(r.ResponsibleParty.Reference, r.ResponsibleParty.Type, medicationDispenseSubstitutionResponsiblePartyTargets); target != "" {
+			return fmt.Errorf("field 'ResponsibleParty' cannot refer to %s, expected one of %v", target, medicationDispenseSubstitutionResponsiblePartyTargets)
+		}
+	}
 	if r.ResponsibleParty != nil {
 		if err := r.ResponsibleParty.Validate(); err != nil {
 			return fmt.Errorf("ResponsibleParty: %w", err)
@@ -230,3 +792,67 @@ func (r *MedicationDispenseSubstitution) Validate() error {
 	}
 	return nil
 }
+
+func (r *MedicationDispenseSubstitution) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.WasSubstitutedElement != nil {
+		r.WasSubstitutedElement.validateAll(path+".wasSubstituted", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.ResponsibleParty != nil {
+		if target := invalidReferenceTarget(r.ResponsibleParty.Reference, r.ResponsibleParty.Type, medicationDispenseSubstitutionResponsiblePartyTargets); target != "" {
+			issues.add("value", path+".responsibleParty", fmt.Sprintf("field 'ResponsibleParty' cannot refer to %s, expected one of %v", target, medicationDispenseSubstitutionResponsiblePartyTargets))
+		}
+	}
+	if r.ResponsibleParty != nil {
+		r.ResponsibleParty.validateAll(path+".responsibleParty", issues)
+	}
+}
+
+var medicationDispenseSubstitutionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationDispenseSubstitution) invariants() []invariant {
+	return medicationDispenseSubstitutionInvariants
+}
+
+func (r MedicationDispenseSubstitution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationDispenseSubstitution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationDispenseSubstitution) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationDispenseSubstitution) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationDispenseSubstitutionElementMetadata = []ElementMetadata{
+	{Path: "MedicationDispense.substitution.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationDispense.substitution.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationDispense.substitution.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationDispense.substitution.wasSubstituted", Name: "wasSubstituted", Field: 3, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "MedicationDispense.substitution.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationDispense.substitution.reason", Name: "reason", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationDispense.substitution.responsibleParty", Name: "responsibleParty", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+}
+
+func (r *MedicationDispenseSubstitution) ElementMetadata() []ElementMetadata {
+	return medicationDispenseSubstitutionElementMetadata
+}
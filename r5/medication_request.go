@@ -2,54 +2,74 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An order or request for both supply of the medication and the instructions for administration of the medication to a patient. The resource is called "MedicationRequest" rather than "MedicationPrescription" or "MedicationOrder" to generalize the use across inpatient and outpatient settings, including care plans, etc., and to harmonize with workflow patterns.
 type MedicationRequest struct {
-	ResourceType            string                            `json:"resourceType" bson:"resource_type"`                                            // Type of resource
-	Id                      *string                           `json:"id,omitempty" bson:"id,omitempty"`                                             // Logical id of this artifact
-	Meta                    *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                                         // Metadata about the resource
-	ImplicitRules           *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                      // A set of rules under which this content was created
-	Language                *string                           `json:"language,omitempty" bson:"language,omitempty"`                                 // Language of the resource content
-	Text                    *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                                         // Text summary of the resource, for human interpretation
-	Contained               []json.RawMessage                 `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
-	Identifier              []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                             // External ids for this request
-	BasedOn                 []Reference                       `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                  // A plan or request that is fulfilled in whole or in part by this medication request
-	PriorPrescription       *Reference                        `json:"priorPrescription,omitempty" bson:"prior_prescription,omitempty"`              // Reference to an order/prescription that is being replaced by this MedicationRequest
-	GroupIdentifier         *Identifier                       `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`                  // Composite request this is part of
-	Status                  string                            `json:"status" bson:"status"`                                                         // active | on-hold | ended | stopped | completed | cancelled | entered-in-error | draft | unknown
-	StatusReason            *CodeableConcept                  `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                        // Reason for current status
-	StatusChanged           *string                           `json:"statusChanged,omitempty" bson:"status_changed,omitempty"`                      // When the status was changed
-	Intent                  string                            `json:"intent" bson:"intent"`                                                         // proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option (immutable)
-	Category                []CodeableConcept                 `json:"category,omitempty" bson:"category,omitempty"`                                 // Grouping or category of medication request
-	Priority                *string                           `json:"priority,omitempty" bson:"priority,omitempty"`                                 // routine | urgent | asap | stat
-	DoNotPerform            *bool                             `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`                       // If true, indicates the provider is ordering a patient should not take the specified medication
-	Medication              *CodeableReference                `json:"medication" bson:"medication"`                                                 // Medication to be taken
-	Subject                 *Reference                        `json:"subject" bson:"subject"`                                                       // Individual or group for whom the medication has been requested
-	InformationSource       []Reference                       `json:"informationSource,omitempty" bson:"information_source,omitempty"`              // The person or organization who provided the information about this request, if the source is someone other than the requestor
-	Encounter               *Reference                        `json:"encounter,omitempty" bson:"encounter,omitempty"`                               // Encounter created as part of encounter/admission/stay
-	SupportingInformation   []Reference                       `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`      // Information to support fulfilling of the medication
-	AuthoredOn              *string                           `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                            // When request was initially authored
-	Requester               *Reference                        `json:"requester,omitempty" bson:"requester,omitempty"`                               // Who/What requested the Request
-	IsRecordOfRequest       *bool                             `json:"isRecordOfRequest,omitempty" bson:"is_record_of_request,omitempty"`            // Whether this is record of a Medication Request or the actual request itself
-	PerformerType           *CodeableConcept                  `json:"performerType,omitempty" bson:"performer_type,omitempty"`                      // Desired kind of performer of the medication administration
-	Performer               []Reference                       `json:"performer,omitempty" bson:"performer,omitempty"`                               // Intended performer of administration
-	Device                  []CodeableReference               `json:"device,omitempty" bson:"device,omitempty"`                                     // Intended type of device for the administration
-	Recorder                *Reference                        `json:"recorder,omitempty" bson:"recorder,omitempty"`                                 // Person who entered the request
-	Reason                  []CodeableReference               `json:"reason,omitempty" bson:"reason,omitempty"`                                     // Reason or indication for ordering or not ordering the medication
-	CourseOfTherapyType     *CodeableConcept                  `json:"courseOfTherapyType,omitempty" bson:"course_of_therapy_type,omitempty"`        // Overall pattern of medication administration
-	Insurance               []Reference                       `json:"insurance,omitempty" bson:"insurance,omitempty"`                               // Associated insurance coverage
-	Note                    []Annotation                      `json:"note,omitempty" bson:"note,omitempty"`                                         // Information about the prescription
-	EffectiveTimingDuration *Duration                         `json:"effectiveTimingDuration,omitempty" bson:"effective_timing_duration,omitempty"` // Period over which the medication is to be taken, can be specified as a duration or a range
-	EffectiveTimingRange    *Range                            `json:"effectiveTimingRange,omitempty" bson:"effective_timing_range,omitempty"`       // Period over which the medication is to be taken, can be specified as a duration or a range
-	EffectiveTimingPeriod   *Period                           `json:"effectiveTimingPeriod,omitempty" bson:"effective_timing_period,omitempty"`     // Period over which the medication is to be taken, can be specified as a duration or a range
-	DosageInstruction       *DosageDetails                    `json:"dosageInstruction,omitempty" bson:"dosage_instruction,omitempty"`              // Specific instructions for how the medication should be taken
-	DispenseRequest         *MedicationRequestDispenseRequest `json:"dispenseRequest,omitempty" bson:"dispense_request,omitempty"`                  // Medication supply authorization
-	Substitution            *MedicationRequestSubstitution    `json:"substitution,omitempty" bson:"substitution,omitempty"`                         // Any restrictions on medication substitution
-	EventHistory            []Reference                       `json:"eventHistory,omitempty" bson:"event_history,omitempty"`                        // A list of events of interest in the lifecycle
+	ResourceType             string                            `json:"resourceType" bson:"resource_type"`                                          // Type of resource
+	Id                       *string                           `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
+	Meta                     *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
+	ImplicitRules            *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
+	ImplicitRulesElement     *Element                          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`           // Extensions for implicitRules
+	Language                 *string                           `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
+	LanguageElement          *Element                          `json:"_language,omitempty" bson:"language_element,omitempty"`                      // Extensions for language
+	Text                     *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
+	Contained                []Resource                        `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
+	Extension                []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                             // Additional content defined by implementations
+	ModifierExtension        []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`            // Extensions that cannot be ignored
+	Identifier               []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // External ids for this request
+	BasedOn                  []Reference                       `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                // A plan or request that is fulfilled in whole or in part by this medication request
+	PriorPrescription        *Reference                        `json:"priorPrescription,omitempty" bson:"prior_prescription,omitempty"`            // Reference to an order/prescription that is being replaced by this MedicationRequest
+	GroupIdentifier          *Identifier                       `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`                // Composite request this is part of
+	Status                   MedicationrequestStatus           `json:"status" bson:"status"`                                                       // active | on-hold | ended | stopped | completed | cancelled | entered-in-error | draft | unknown
+	StatusElement            *Element                          `json:"_status,omitempty" bson:"status_element,omitempty"`                          // Extensions for status
+	StatusReason             *CodeableConcept                  `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                      // Reason for current status
+	StatusChanged            *DateTime                         `json:"statusChanged,omitempty" bson:"status_changed,omitempty"`                    // When the status was changed
+	StatusChangedElement     *Element                          `json:"_statusChanged,omitempty" bson:"status_changed_element,omitempty"`           // Extensions for statusChanged
+	Intent                   MedicationRequestIntent           `json:"intent" bson:"intent"`                                                       // proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option (immutable)
+	IntentElement            *Element                          `json:"_intent,omitempty" bson:"intent_element,omitempty"`                          // Extensions for intent
+	Category                 []CodeableConcept                 `json:"category,omitempty" bson:"category,omitempty"`                               // Grouping or category of medication request
+	Priority                 *RequestPriority                  `json:"priority,omitempty" bson:"priority,omitempty"`                               // routine | urgent | asap | stat
+	PriorityElement          *Element                          `json:"_priority,omitempty" bson:"priority_element,omitempty"`                      // Extensions for priority
+	DoNotPerform             *bool                             `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`                     // If true, indicates the provider is ordering a patient should not take the specified medication
+	DoNotPerformElement      *Element                          `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`            // Extensions for doNotPerform
+	Medication               *CodeableReference                `json:"medication" bson:"medication"`                                               // Medication to be taken
+	Subject                  *Reference                        `json:"subject" bson:"subject"`                                                     // Individual or group for whom the medication has been requested
+	InformationSource        []Reference                       `json:"informationSource,omitempty" bson:"information_source,omitempty"`            // The person or organization who provided the information about this request, if the source is someone other than the requestor
+	Encounter                *Reference                        `json:"encounter,omitempty" bson:"encounter,omitempty"`                             // Encounter created as part of encounter/admission/stay
+	SupportingInformation    []Reference                       `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`    // Information to support fulfilling of the medication
+	AuthoredOn               *DateTime                         `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                          // When request was initially authored
+	AuthoredOnElement        *Element                          `json:"_authoredOn,omitempty" bson:"authored_on_element,omitempty"`                 // Extensions for authoredOn
+	Requester                *Reference                        `json:"requester,omitempty" bson:"requester,omitempty"`                             // Who/What requested the Request
+	IsRecordOfRequest        *bool                             `json:"isRecordOfRequest,omitempty" bson:"is_record_of_request,omitempty"`          // Whether this is record of a Medication Request or the actual request itself
+	IsRecordOfRequestElement *Element                          `json:"_isRecordOfRequest,omitempty" bson:"is_record_of_request_element,omitempty"` // Extensions for isRecordOfRequest
+	PerformerType            *CodeableConcept                  `json:"performerType,omitempty" bson:"performer_type,omitempty"`                    // Desired kind of performer of the medication administration
+	Performer                []Reference                       `json:"performer,omitempty" bson:"performer,omitempty"`                             // Intended performer of administration
+	Device                   []CodeableReference               `json:"device,omitempty" bson:"device,omitempty"`                                   // Intended type of device for the administration
+	Recorder                 *Reference                        `json:"recorder,omitempty" bson:"recorder,omitempty"`                               // Person who entered the request
+	Reason                   []CodeableReference               `json:"reason,omitempty" bson:"reason,omitempty"`                                   // Reason or indication for ordering or not ordering the medication
+	CourseOfTherapyType      *CodeableConcept                  `json:"courseOfTherapyType,omitempty" bson:"course_of_therapy_type,omitempty"`      // Overall pattern of medication administration
+	Insurance                []Reference                       `json:"insurance,omitempty" bson:"insurance,omitempty"`                             // Associated insurance coverage
+	Note                     []Annotation                      `json:"note,omitempty" bson:"note,omitempty"`                                       // Information about the prescription
+	EffectiveTiming          MedicationRequestEffectiveTiming  `json:"-" bson:"effective_timing,omitempty"`                                        // Period over which the medication is to be taken, can be specified as a duration or a range
+	DosageInstruction        *DosageDetails                    `json:"dosageInstruction,omitempty" bson:"dosage_instruction,omitempty"`            // Specific instructions for how the medication should be taken
+	DispenseRequest          *MedicationRequestDispenseRequest `json:"dispenseRequest,omitempty" bson:"dispense_request,omitempty"`                // Medication supply authorization
+	Substitution             *MedicationRequestSubstitution    `json:"substitution,omitempty" bson:"substitution,omitempty"`                       // Any restrictions on medication substitution
+	EventHistory             []Reference                       `json:"eventHistory,omitempty" bson:"event_history,omitempty"`                      // A list of events of interest in the lifecycle
+
+	effectiveTimingVariants []string // JSON properties of MedicationRequest.effectiveTiming[x] when more than one was decoded
 }
 
+var medicationRequestSubjectTargets = []string{"Group", "Patient"}
+
+var medicationRequestEncounterTargets = []string{"Encounter"}
+
+var medicationRequestRequesterTargets = []string{"Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var medicationRequestPerformerTargets = []string{"CareTeam", "DeviceDefinition", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *MedicationRequest) Validate() error {
 	if r.ResourceType != "MedicationRequest" {
 		return fmt.Errorf("invalid resourceType: expected 'MedicationRequest', got '%s'", r.ResourceType)
@@ -59,11 +79,39 @@ func (r *MedicationRequest) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -84,23 +132,61 @@ func (r *MedicationRequest) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.StatusReason != nil {
 		if err := r.StatusReason.Validate(); err != nil {
 			return fmt.Errorf("StatusReason: %w", err)
 		}
 	}
-	if r.Intent == emptyString {
+	if r.StatusChanged != nil {
+		if err := r.StatusChanged.Validate(); err != nil {
+			return fmt.Errorf("StatusChanged: %w", err)
+		}
+	}
+	if r.StatusChangedElement != nil {
+		if err := r.StatusChangedElement.Validate(); err != nil {
+			return fmt.Errorf("StatusChangedElement: %w", err)
+		}
+	}
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
+		}
+	}
 	if r.Medication == nil {
 		return fmt.Errorf("field 'Medication' is required")
 	}
@@ -112,6 +198,11 @@ func (r *MedicationRequest) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationRequestSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationRequestSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -122,6 +213,11 @@ func (r *MedicationRequest) Validate() error {
 			return fmt.Errorf("InformationSource[%d]: %w", i, err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationRequestEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationRequestEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -132,16 +228,41 @@ func (r *MedicationRequest) Validate() error {
 			return fmt.Errorf("SupportingInformation[%d]: %w", i, err)
 		}
 	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOn: %w", err)
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		if err := r.AuthoredOnElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOnElement: %w", err)
+		}
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, medicationRequestRequesterTargets); target != "" {
+			return fmt.Errorf("field 'Requester' cannot refer to %s, expected one of %v", target, medicationRequestRequesterTargets)
+		}
+	}
 	if r.Requester != nil {
 		if err := r.Requester.Validate(); err != nil {
 			return fmt.Errorf("Requester: %w", err)
 		}
 	}
+	if r.IsRecordOfRequestElement != nil {
+		if err := r.IsRecordOfRequestElement.Validate(); err != nil {
+			return fmt.Errorf("IsRecordOfRequestElement: %w", err)
+		}
+	}
 	if r.PerformerType != nil {
 		if err := r.PerformerType.Validate(); err != nil {
 			return fmt.Errorf("PerformerType: %w", err)
 		}
 	}
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationRequestPerformerTargets); target != "" {
+			return fmt.Errorf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, medicationRequestPerformerTargets)
+		}
+	}
 	for i, item := range r.Performer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
@@ -177,19 +298,12 @@ func (r *MedicationRequest) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
-	if r.EffectiveTimingDuration != nil {
-		if err := r.EffectiveTimingDuration.Validate(); err != nil {
-			return fmt.Errorf("EffectiveTimingDuration: %w", err)
-		}
-	}
-	if r.EffectiveTimingRange != nil {
-		if err := r.EffectiveTimingRange.Validate(); err != nil {
-			return fmt.Errorf("EffectiveTimingRange: %w", err)
-		}
+	if len(r.effectiveTimingVariants) > 1 {
+		return fmt.Errorf("field 'EffectiveTiming' must have a single type, got %v", r.effectiveTimingVariants)
 	}
-	if r.EffectiveTimingPeriod != nil {
-		if err := r.EffectiveTimingPeriod.Validate(); err != nil {
-			return fmt.Errorf("EffectiveTimingPeriod: %w", err)
+	if r.EffectiveTiming != nil {
+		if err := r.EffectiveTiming.Validate(); err != nil {
+			return fmt.Errorf("EffectiveTiming: %w", err)
 		}
 	}
 	if r.DosageInstruction != nil {
@@ -212,51 +326,474 @@ func (r *MedicationRequest) Validate() error {
 			return fmt.Errorf("EventHistory[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "MedicationRequest")
 }
 
-type MedicationRequestSubstitution struct {
-	Id                     *string          `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	AllowedBoolean         *bool            `json:"allowedBoolean" bson:"allowed_boolean"`                  // Whether substitution is allowed or not
-	AllowedCodeableConcept *CodeableConcept `json:"allowedCodeableConcept" bson:"allowed_codeable_concept"` // Whether substitution is allowed or not
-	Reason                 *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`               // Why should (not) substitution be made
+func (r *MedicationRequest) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MedicationRequest" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MedicationRequest', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.PriorPrescription != nil {
+		r.PriorPrescription.validateAll(path+".priorPrescription", issues)
+	}
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReason != nil {
+		r.StatusReason.validateAll(path+".statusReason", issues)
+	}
+	if r.StatusChanged != nil {
+		if err := r.StatusChanged.Validate(); err != nil {
+			issues.add("value", path+".statusChanged", err.Error())
+		}
+	}
+	if r.StatusChangedElement != nil {
+		r.StatusChangedElement.validateAll(path+".statusChanged", issues)
+	}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
+	}
+	if r.Medication == nil {
+		issues.add("required", path+".medication", "field 'Medication' is required")
+	}
+	if r.Medication != nil {
+		r.Medication.validateAll(path+".medication", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationRequestSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationRequestSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.InformationSource {
+		item.validateAll(fmt.Sprintf("%s.informationSource[%d]", path, i), issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationRequestEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationRequestEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	for i, item := range r.SupportingInformation {
+		item.validateAll(fmt.Sprintf("%s.supportingInformation[%d]", path, i), issues)
+	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			issues.add("value", path+".authoredOn", err.Error())
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		r.AuthoredOnElement.validateAll(path+".authoredOn", issues)
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, medicationRequestRequesterTargets); target != "" {
+			issues.add("value", path+".requester", fmt.Sprintf("field 'Requester' cannot refer to %s, expected one of %v", target, medicationRequestRequesterTargets))
+		}
+	}
+	if r.Requester != nil {
+		r.Requester.validateAll(path+".requester", issues)
+	}
+	if r.IsRecordOfRequestElement != nil {
+		r.IsRecordOfRequestElement.validateAll(path+".isRecordOfRequest", issues)
+	}
+	if r.PerformerType != nil {
+		r.PerformerType.validateAll(path+".performerType", issues)
+	}
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationRequestPerformerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.performer[%d]", path, i), fmt.Sprintf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, medicationRequestPerformerTargets))
+		}
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.Device {
+		item.validateAll(fmt.Sprintf("%s.device[%d]", path, i), issues)
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	if r.CourseOfTherapyType != nil {
+		r.CourseOfTherapyType.validateAll(path+".courseOfTherapyType", issues)
+	}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if len(r.effectiveTimingVariants) > 1 {
+		issues.add("structure", path+".effectiveTiming", fmt.Sprintf("field 'EffectiveTiming' must have a single type, got %v", r.effectiveTimingVariants))
+	}
+	if r.EffectiveTiming != nil {
+		r.EffectiveTiming.validateAll(path+".effectiveTiming.ofType("+r.EffectiveTiming.FHIRType()+")", issues)
+	}
+	if r.DosageInstruction != nil {
+		r.DosageInstruction.validateAll(path+".dosageInstruction", issues)
+	}
+	if r.DispenseRequest != nil {
+		r.DispenseRequest.validateAll(path+".dispenseRequest", issues)
+	}
+	if r.Substitution != nil {
+		r.Substitution.validateAll(path+".substitution", issues)
+	}
+	for i, item := range r.EventHistory {
+		item.validateAll(fmt.Sprintf("%s.eventHistory[%d]", path, i), issues)
+	}
 }
 
-func (r *MedicationRequestSubstitution) Validate() error {
-	if r.AllowedBoolean == nil {
-		return fmt.Errorf("field 'AllowedBoolean' is required")
+func (r *MedicationRequest) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MedicationRequest", &issues)
+	checkInvariants(r, "MedicationRequest", &issues)
+	return issues
+}
+
+var medicationRequestInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MedicationRequest) invariants() []invariant {
+	return medicationRequestInvariants
+}
+
+func (r MedicationRequest) MarshalJSON() ([]byte, error) {
+	type alias MedicationRequest
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.AllowedCodeableConcept == nil {
-		return fmt.Errorf("field 'AllowedCodeableConcept' is required")
+	if data, err = marshalChoice(data, "effectiveTiming", r.EffectiveTiming, nil); err != nil {
+		return nil, err
 	}
-	if r.AllowedCodeableConcept != nil {
-		if err := r.AllowedCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("AllowedCodeableConcept: %w", err)
-		}
+	return data, nil
+}
+
+func (r *MedicationRequest) UnmarshalJSON(data []byte) error {
+	type alias MedicationRequest
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.Reason != nil {
-		if err := r.Reason.Validate(); err != nil {
-			return fmt.Errorf("Reason: %w", err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.EffectiveTiming, _, r.effectiveTimingVariants, err = unmarshalChoice(fields, "effectiveTiming", medicationRequestEffectiveTimingVariants); err != nil {
+		return err
 	}
 	return nil
 }
 
+func (r MedicationRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicationRequest) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "EffectiveTiming":
+		return "effectiveTiming", choiceValues(medicationRequestEffectiveTimingVariants)
+	}
+	return "", nil
+}
+
+var medicationRequestElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *MedicationRequest) elementTypes() map[string]string {
+	return medicationRequestElementTypes
+}
+
+var medicationRequestElementMetadata = []ElementMetadata{
+	{Path: "MedicationRequest.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MedicationRequest.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MedicationRequest.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MedicationRequest.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MedicationRequest.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MedicationRequest.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MedicationRequest.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationRequest.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationRequest.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MedicationRequest.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationRequest.priorPrescription", Name: "priorPrescription", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MedicationRequest.groupIdentifier", Name: "groupIdentifier", Field: 14, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "MedicationRequest.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medicationrequest-status"}},
+	{Path: "MedicationRequest.statusReason", Name: "statusReason", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationRequest.statusChanged", Name: "statusChanged", Field: 18, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationRequest.intent", Name: "intent", Field: 20, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medicationrequest-intent"}},
+	{Path: "MedicationRequest.category", Name: "category", Field: 22, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationRequest.priority", Name: "priority", Field: 23, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "MedicationRequest.doNotPerform", Name: "doNotPerform", Field: 25, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "MedicationRequest.medication", Name: "medication", Field: 27, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicationRequest.subject", Name: "subject", Field: 28, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "MedicationRequest.informationSource", Name: "informationSource", Field: 29, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationRequest.encounter", Name: "encounter", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "MedicationRequest.supportingInformation", Name: "supportingInformation", Field: 31, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationRequest.authoredOn", Name: "authoredOn", Field: 32, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationRequest.requester", Name: "requester", Field: 34, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "MedicationRequest.isRecordOfRequest", Name: "isRecordOfRequest", Field: 35, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "MedicationRequest.performerType", Name: "performerType", Field: 37, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationRequest.performer", Name: "performer", Field: 38, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "MedicationRequest.device", Name: "device", Field: 39, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "MedicationRequest.recorder", Name: "recorder", Field: 40, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MedicationRequest.reason", Name: "reason", Field: 41, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "MedicationRequest.courseOfTherapyType", Name: "courseOfTherapyType", Field: 42, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationRequest.insurance", Name: "insurance", Field: 43, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationRequest.note", Name: "note", Field: 44, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "MedicationRequest.effectiveTiming[x]", Name: "effectiveTiming", Field: 45, Min: 0, Max: "1", Types: []string{"Duration", "Range", "Period"}},
+	{Path: "MedicationRequest.dosageInstruction", Name: "dosageInstruction", Field: 46, Min: 0, Max: "1", Types: []string{"DosageDetails"}},
+	{Path: "MedicationRequest.dispenseRequest", Name: "dispenseRequest", Field: 47, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MedicationRequest.substitution", Name: "substitution", Field: 48, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MedicationRequest.eventHistory", Name: "eventHistory", Field: 49, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *MedicationRequest) ElementMetadata() []ElementMetadata {
+	return medicationRequestElementMetadata
+}
+
+// MedicationRequestEffectiveTiming is the type of MedicationRequest.effectiveTiming[x]. It is implemented by the MedicationRequestEffectiveTiming* variant types.
+type MedicationRequestEffectiveTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicationRequestEffectiveTiming()
+}
+
+// MedicationRequestEffectiveTimingDuration is the Duration variant of MedicationRequestEffectiveTiming.
+type MedicationRequestEffectiveTimingDuration struct {
+	Duration
+}
+
+func (v MedicationRequestEffectiveTimingDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v MedicationRequestEffectiveTimingDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v MedicationRequestEffectiveTimingDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v MedicationRequestEffectiveTimingDuration) isMedicationRequestEffectiveTiming() {}
+
+// MedicationRequestEffectiveTimingRange is the Range variant of MedicationRequestEffectiveTiming.
+type MedicationRequestEffectiveTimingRange struct {
+	Range
+}
+
+func (v MedicationRequestEffectiveTimingRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v MedicationRequestEffectiveTimingRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v MedicationRequestEffectiveTimingRange) FHIRType() string {
+	return "Range"
+}
+
+func (v MedicationRequestEffectiveTimingRange) isMedicationRequestEffectiveTiming() {}
+
+// MedicationRequestEffectiveTimingPeriod is the Period variant of MedicationRequestEffectiveTiming.
+type MedicationRequestEffectiveTimingPeriod struct {
+	Period
+}
+
+func (v MedicationRequestEffectiveTimingPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v MedicationRequestEffectiveTimingPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v MedicationRequestEffectiveTimingPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v MedicationRequestEffectiveTimingPeriod) isMedicationRequestEffectiveTiming() {}
+
+var medicationRequestEffectiveTimingVariants = []MedicationRequestEffectiveTiming{
+	MedicationRequestEffectiveTimingDuration{},
+	MedicationRequestEffectiveTimingRange{},
+	MedicationRequestEffectiveTimingPeriod{},
+}
+
+func (r *MedicationRequest) GetResourceType() string {
+	return "MedicationRequest"
+}
+
+func (r *MedicationRequest) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MedicationRequest) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MedicationRequest) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MedicationRequest) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MedicationRequest) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MedicationRequest) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MedicationRequest) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MedicationRequest)(nil)
+
 type MedicationRequestDispenseRequest struct {
-	Id                     *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                            // Unique id for inter-element referencing
-	InitialFill            *MedicationRequestDispenseRequestInitialFill `json:"initialFill,omitempty" bson:"initial_fill,omitempty"`                         // First fill details
-	DispenseInterval       *Duration                                    `json:"dispenseInterval,omitempty" bson:"dispense_interval,omitempty"`               // Minimum period of time between dispenses
-	ValidityPeriod         *Period                                      `json:"validityPeriod,omitempty" bson:"validity_period,omitempty"`                   // Time period supply is authorized for
-	NumberOfRepeatsAllowed *int                                         `json:"numberOfRepeatsAllowed,omitempty" bson:"number_of_repeats_allowed,omitempty"` // Number of refills authorized
-	Quantity               *Quantity                                    `json:"quantity,omitempty" bson:"quantity,omitempty"`                                // Amount of medication to supply per dispense
-	ExpectedSupplyDuration *Duration                                    `json:"expectedSupplyDuration,omitempty" bson:"expected_supply_duration,omitempty"`  // Number of days supply per dispense
-	Dispenser              *Reference                                   `json:"dispenser,omitempty" bson:"dispenser,omitempty"`                              // Intended performer of dispense
-	DispenserInstruction   []CodeableConcept                            `json:"dispenserInstruction,omitempty" bson:"dispenser_instruction,omitempty"`       // Additional information for the dispenser
-	DoseAdministrationAid  *CodeableConcept                             `json:"doseAdministrationAid,omitempty" bson:"dose_administration_aid,omitempty"`    // Type of adherence packaging to use for the dispense
-	Destination            *Reference                                   `json:"destination,omitempty" bson:"destination,omitempty"`                          // Where the medication is expected to be delivered
+	Id                            *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                                     // Unique id for inter-element referencing
+	Extension                     []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                                       // Additional content defined by implementations
+	ModifierExtension             []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                      // Extensions that cannot be ignored even if unrecognized
+	InitialFill                   *MedicationRequestDispenseRequestInitialFill `json:"initialFill,omitempty" bson:"initial_fill,omitempty"`                                  // First fill details
+	DispenseInterval              *Duration                                    `json:"dispenseInterval,omitempty" bson:"dispense_interval,omitempty"`                        // Minimum period of time between dispenses
+	ValidityPeriod                *Period                                      `json:"validityPeriod,omitempty" bson:"validity_period,omitempty"`                            // Time period supply is authorized for
+	NumberOfRepeatsAllowed        *int                                         `json:"numberOfRepeatsAllowed,omitempty" bson:"number_of_repeats_allowed,omitempty"`          // Number of refills authorized
+	NumberOfRepeatsAllowedElement *Element                                     `json:"_numberOfRepeatsAllowed,omitempty" bson:"number_of_repeats_allowed_element,omitempty"` // Extensions for numberOfRepeatsAllowed
+	Quantity                      *Quantity                                    `json:"quantity,omitempty" bson:"quantity,omitempty"`                                         // Amount of medication to supply per dispense
+	ExpectedSupplyDuration        *Duration                                    `json:"expectedSupplyDuration,omitempty" bson:"expected_supply_duration,omitempty"`           // Number of days supply per dispense
+	Dispenser                     *Reference                                   `json:"dispenser,omitempty" bson:"dispenser,omitempty"`                                       // Intended performer of dispense
+	DispenserInstruction          []CodeableConcept                            `json:"dispenserInstruction,omitempty" bson:"dispenser_instruction,omitempty"`                // Additional information for the dispenser
+	DoseAdministrationAid         *CodeableConcept                             `json:"doseAdministrationAid,omitempty" bson:"dose_administration_aid,omitempty"`             // Type of adherence packaging to use for the dispense
+	Destination                   *Reference                                   `json:"destination,omitempty" bson:"destination,omitempty"`                                   // Where the medication is expected to be delivered
 }
 
+var medicationRequestDispenseRequestDispenserTargets = []string{"Organization"}
+
 func (r *MedicationRequestDispenseRequest) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.InitialFill != nil {
 		if err := r.InitialFill.Validate(); err != nil {
 			return fmt.Errorf("InitialFill: %w", err)
@@ -272,6 +809,11 @@ func (r *MedicationRequestDispenseRequest) Validate() error {
 			return fmt.Errorf("ValidityPeriod: %w", err)
 		}
 	}
+	if r.NumberOfRepeatsAllowedElement != nil {
+		if err := r.NumberOfRepeatsAllowedElement.Validate(); err != nil {
+			return fmt.Errorf("NumberOfRepeatsAllowedElement: %w", err)
+		}
+	}
 	if r.Quantity != nil {
 		if err := r.Quantity.Validate(); err != nil {
 			return fmt.Errorf("Quantity: %w", err)
@@ -282,6 +824,11 @@ func (r *MedicationRequestDispenseRequest) Validate() error {
 			return fmt.Errorf("ExpectedSupplyDuration: %w", err)
 		}
 	}
+	if r.Dispenser != nil {
+		if target := invalidReferenceTarget(r.Dispenser.Reference, r.Dispenser.Type, medicationRequestDispenseRequestDispenserTargets); target != "" {
+			return fmt.Errorf("field 'Dispenser' cannot refer to %s, expected one of %v", target, medicationRequestDispenseRequestDispenserTargets)
+		}
+	}
 	if r.Dispenser != nil {
 		if err := r.Dispenser.Validate(); err != nil {
 			return fmt.Errorf("Dispenser: %w", err)
@@ -305,13 +852,121 @@ func (r *MedicationRequestDispenseRequest) Validate() error {
 	return nil
 }
 
+func (r *MedicationRequestDispenseRequest) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.InitialFill != nil {
+		r.InitialFill.validateAll(path+".initialFill", issues)
+	}
+	if r.DispenseInterval != nil {
+		r.DispenseInterval.validateAll(path+".dispenseInterval", issues)
+	}
+	if r.ValidityPeriod != nil {
+		r.ValidityPeriod.validateAll(path+".validityPeriod", issues)
+	}
+	if r.NumberOfRepeatsAllowedElement != nil {
+		r.NumberOfRepeatsAllowedElement.validateAll(path+".numberOfRepeatsAllowed", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.ExpectedSupplyDuration != nil {
+		r.ExpectedSupplyDuration.validateAll(path+".expectedSupplyDuration", issues)
+	}
+	if r.Dispenser != nil {
+		if target := invalidReferenceTarget(r.Dispenser.Reference, r.Dispenser.Type, medicationRequestDispenseRequestDispenserTargets); target != "" {
+			issues.add("value", path+".dispenser", fmt.Sprintf("field 'Dispenser' cannot refer to %s, expected one of %v", target, medicationRequestDispenseRequestDispenserTargets))
+		}
+	}
+	if r.Dispenser != nil {
+		r.Dispenser.validateAll(path+".dispenser", issues)
+	}
+	for i, item := range r.DispenserInstruction {
+		item.validateAll(fmt.Sprintf("%s.dispenserInstruction[%d]", path, i), issues)
+	}
+	if r.DoseAdministrationAid != nil {
+		r.DoseAdministrationAid.validateAll(path+".doseAdministrationAid", issues)
+	}
+	if r.Destination != nil {
+		r.Destination.validateAll(path+".destination", issues)
+	}
+}
+
+var medicationRequestDispenseRequestInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationRequestDispenseRequest) invariants() []invariant {
+	return medicationRequestDispenseRequestInvariants
+}
+
+func (r MedicationRequestDispenseRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationRequestDispenseRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationRequestDispenseRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationRequestDispenseRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationRequestDispenseRequestElementTypes = map[string]string{
+	"numberOfRepeatsAllowed": "unsignedInt",
+}
+
+func (r *MedicationRequestDispenseRequest) elementTypes() map[string]string {
+	return medicationRequestDispenseRequestElementTypes
+}
+
+var medicationRequestDispenseRequestElementMetadata = []ElementMetadata{
+	{Path: "MedicationRequest.dispenseRequest.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationRequest.dispenseRequest.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationRequest.dispenseRequest.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationRequest.dispenseRequest.initialFill", Name: "initialFill", Field: 3, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MedicationRequest.dispenseRequest.dispenseInterval", Name: "dispenseInterval", Field: 4, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "MedicationRequest.dispenseRequest.validityPeriod", Name: "validityPeriod", Field: 5, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "MedicationRequest.dispenseRequest.numberOfRepeatsAllowed", Name: "numberOfRepeatsAllowed", Field: 6, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "MedicationRequest.dispenseRequest.quantity", Name: "quantity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MedicationRequest.dispenseRequest.expectedSupplyDuration", Name: "expectedSupplyDuration", Field: 9, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "MedicationRequest.dispenseRequest.dispenser", Name: "dispenser", Field: 10, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "MedicationRequest.dispenseRequest.dispenserInstruction", Name: "dispenserInstruction", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationRequest.dispenseRequest.doseAdministrationAid", Name: "doseAdministrationAid", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationRequest.dispenseRequest.destination", Name: "destination", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *MedicationRequestDispenseRequest) ElementMetadata() []ElementMetadata {
+	return medicationRequestDispenseRequestElementMetadata
+}
+
 type MedicationRequestDispenseRequestInitialFill struct {
-	Id       *string   `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Quantity *Quantity `json:"quantity,omitempty" bson:"quantity,omitempty"` // First fill quantity
-	Duration *Duration `json:"duration,omitempty" bson:"duration,omitempty"` // First fill duration
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Quantity          *Quantity   `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // First fill quantity
+	Duration          *Duration   `json:"duration,omitempty" bson:"duration,omitempty"`                    // First fill duration
 }
 
 func (r *MedicationRequestDispenseRequestInitialFill) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Quantity != nil {
 		if err := r.Quantity.Validate(); err != nil {
 			return fmt.Errorf("Quantity: %w", err)
@@ -324,3 +979,258 @@ func (r *MedicationRequestDispenseRequestInitialFill) Validate() error {
 	}
 	return nil
 }
+
+func (r *MedicationRequestDispenseRequestInitialFill) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.Duration != nil {
+		r.Duration.validateAll(path+".duration", issues)
+	}
+}
+
+var medicationRequestDispenseRequestInitialFillInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationRequestDispenseRequestInitialFill) invariants() []invariant {
+	return medicationRequestDispenseRequestInitialFillInvariants
+}
+
+func (r MedicationRequestDispenseRequestInitialFill) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationRequestDispenseRequestInitialFill) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationRequestDispenseRequestInitialFill) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationRequestDispenseRequestInitialFill) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationRequestDispenseRequestInitialFillElementMetadata = []ElementMetadata{
+	{Path: "MedicationRequest.dispenseRequest.initialFill.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationRequest.dispenseRequest.initialFill.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationRequest.dispenseRequest.initialFill.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationRequest.dispenseRequest.initialFill.quantity", Name: "quantity", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "MedicationRequest.dispenseRequest.initialFill.duration", Name: "duration", Field: 4, Min: 0, Max: "1", Types: []string{"Duration"}},
+}
+
+func (r *MedicationRequestDispenseRequestInitialFill) ElementMetadata() []ElementMetadata {
+	return medicationRequestDispenseRequestInitialFillElementMetadata
+}
+
+type MedicationRequestSubstitution struct {
+	Id                *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Allowed           MedicationRequestSubstitutionAllowed `json:"-" bson:"allowed,omitempty"`                                      // Whether substitution is allowed or not
+	AllowedElement    *Element                             `json:"-" bson:"allowed_element,omitempty"`                              // Extensions for allowed[x]
+	Reason            *CodeableConcept                     `json:"reason,omitempty" bson:"reason,omitempty"`                        // Why should (not) substitution be made
+
+	allowedVariants []string // JSON properties of MedicationRequest.substitution.allowed[x] when more than one was decoded
+}
+
+func (r *MedicationRequestSubstitution) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.allowedVariants) > 1 {
+		return fmt.Errorf("field 'Allowed' must have a single type, got %v", r.allowedVariants)
+	}
+	if r.Allowed == nil {
+		return fmt.Errorf("field 'Allowed' is required")
+	}
+	if r.Allowed != nil {
+		if err := r.Allowed.Validate(); err != nil {
+			return fmt.Errorf("Allowed: %w", err)
+		}
+	}
+	if r.AllowedElement != nil {
+		if err := r.AllowedElement.Validate(); err != nil {
+			return fmt.Errorf("AllowedElement: %w", err)
+		}
+	}
+	if r.Reason != nil {
+		if err := r.Reason.Validate(); err != nil {
+			return fmt.Errorf("Reason: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MedicationRequestSubstitution) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.allowedVariants) > 1 {
+		issues.add("structure", path+".allowed", fmt.Sprintf("field 'Allowed' must have a single type, got %v", r.allowedVariants))
+	}
+	if r.Allowed == nil {
+		issues.add("required", path+".allowed", "field 'Allowed' is required")
+	}
+	if r.Allowed != nil {
+		r.Allowed.validateAll(path+".allowed.ofType("+r.Allowed.FHIRType()+")", issues)
+	}
+	if r.AllowedElement != nil {
+		r.AllowedElement.validateAll(path+".allowed", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+}
+
+var medicationRequestSubstitutionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationRequestSubstitution) invariants() []invariant {
+	return medicationRequestSubstitutionInvariants
+}
+
+func (r MedicationRequestSubstitution) MarshalJSON() ([]byte, error) {
+	type alias MedicationRequestSubstitution
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "allowed", r.Allowed, r.AllowedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MedicationRequestSubstitution) UnmarshalJSON(data []byte) error {
+	type alias MedicationRequestSubstitution
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Allowed, element, r.allowedVariants, err = unmarshalChoice(fields, "allowed", medicationRequestSubstitutionAllowedVariants); err != nil {
+		return err
+	}
+	r.AllowedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AllowedElement); err != nil {
+			return fmt.Errorf("_allowed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MedicationRequestSubstitution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationRequestSubstitution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationRequestSubstitution) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationRequestSubstitution) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicationRequestSubstitution) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Allowed":
+		return "allowed", choiceValues(medicationRequestSubstitutionAllowedVariants)
+	}
+	return "", nil
+}
+
+var medicationRequestSubstitutionElementMetadata = []ElementMetadata{
+	{Path: "MedicationRequest.substitution.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationRequest.substitution.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationRequest.substitution.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationRequest.substitution.allowed[x]", Name: "allowed", Field: 3, Min: 1, Max: "1", Types: []string{"boolean", "CodeableConcept"}},
+	{Path: "MedicationRequest.substitution.reason", Name: "reason", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MedicationRequestSubstitution) ElementMetadata() []ElementMetadata {
+	return medicationRequestSubstitutionElementMetadata
+}
+
+// MedicationRequestSubstitutionAllowed is the type of MedicationRequest.substitution.allowed[x]. It is implemented by the MedicationRequestSubstitutionAllowed* variant types.
+type MedicationRequestSubstitutionAllowed interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicationRequestSubstitutionAllowed()
+}
+
+// MedicationRequestSubstitutionAllowedBoolean is the boolean variant of MedicationRequestSubstitutionAllowed.
+type MedicationRequestSubstitutionAllowedBoolean bool
+
+func (v MedicationRequestSubstitutionAllowedBoolean) Validate() error {
+	return nil
+}
+
+func (v MedicationRequestSubstitutionAllowedBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MedicationRequestSubstitutionAllowedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v MedicationRequestSubstitutionAllowedBoolean) isMedicationRequestSubstitutionAllowed() {}
+
+// MedicationRequestSubstitutionAllowedCodeableConcept is the CodeableConcept variant of MedicationRequestSubstitutionAllowed.
+type MedicationRequestSubstitutionAllowedCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MedicationRequestSubstitutionAllowedCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MedicationRequestSubstitutionAllowedCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MedicationRequestSubstitutionAllowedCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MedicationRequestSubstitutionAllowedCodeableConcept) isMedicationRequestSubstitutionAllowed() {
+}
+
+var medicationRequestSubstitutionAllowedVariants = []MedicationRequestSubstitutionAllowed{
+	MedicationRequestSubstitutionAllowedBoolean(false),
+	MedicationRequestSubstitutionAllowedCodeableConcept{},
+}
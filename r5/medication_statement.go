@@ -2,39 +2,53 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A record of a medication consumed by a patient.  A MedicationStatement may indicate that the patient may be taking the medication now or has taken the medication in the past or will be taking the medication in the future. The source of this information can be the patient, patient representative (e.g., spouse, significant other, family member, caregiver), or a clinician. A common scenario where this information is captured is during the history taking process during a patient encounter or stay. The medication information may come from sources such as the patient's memory, from a prescription bottle, or from a list of medications the patient, clinician or other party maintains. The primary difference between a MedicationStatement and a MedicationAdministration is that the medication administration has complete administration information and is based on actual administration information from the person who administered the medication. A MedicationStatement is often, if not always, less specific. There is no required date/time when the medication was administered, in fact we only know that a source has reported the patient is taking this medication, where details such as time, quantity, or rate or even medication product may be incomplete or missing or less precise. As stated earlier, the MedicationStatement information may come from the patient's memory, from a prescription bottle or from a list of medications the patient, clinician or other party. MedicationAdministration is more formal and is not missing detailed information.
 type MedicationStatement struct {
-	ResourceType               string                        `json:"resourceType" bson:"resource_type"`                                                  // Type of resource
-	Id                         *string                       `json:"id,omitempty" bson:"id,omitempty"`                                                   // Logical id of this artifact
-	Meta                       *Meta                         `json:"meta,omitempty" bson:"meta,omitempty"`                                               // Metadata about the resource
-	ImplicitRules              *string                       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                            // A set of rules under which this content was created
-	Language                   *string                       `json:"language,omitempty" bson:"language,omitempty"`                                       // Language of the resource content
-	Text                       *Narrative                    `json:"text,omitempty" bson:"text,omitempty"`                                               // Text summary of the resource, for human interpretation
-	Contained                  []json.RawMessage             `json:"contained,omitempty" bson:"contained,omitempty"`                                     // Contained, inline Resources
-	Identifier                 []Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`                                   // External identifier
-	PartOf                     []Reference                   `json:"partOf,omitempty" bson:"part_of,omitempty"`                                          // Part of referenced event
-	Status                     string                        `json:"status" bson:"status"`                                                               // recorded | entered-in-error | draft
-	Category                   []CodeableConcept             `json:"category,omitempty" bson:"category,omitempty"`                                       // Type of medication statement
-	Medication                 *CodeableReference            `json:"medication" bson:"medication"`                                                       // The medication that is/was taken (or not taken)
-	Subject                    *Reference                    `json:"subject" bson:"subject"`                                                             // Who is/was taking  the medication
-	Encounter                  *Reference                    `json:"encounter,omitempty" bson:"encounter,omitempty"`                                     // Encounter associated with MedicationStatement
-	EffectiveDateTime          *string                       `json:"effectiveDateTime,omitempty" bson:"effective_date_time,omitempty"`                   // The date/time or interval when the medication is/was/will be taken
-	EffectivePeriod            *Period                       `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                        // The date/time or interval when the medication is/was/will be taken
-	EffectiveTiming            *Timing                       `json:"effectiveTiming,omitempty" bson:"effective_timing,omitempty"`                        // The date/time or interval when the medication is/was/will be taken
-	DateAsserted               *string                       `json:"dateAsserted,omitempty" bson:"date_asserted,omitempty"`                              // When the usage was asserted?
-	Author                     *Reference                    `json:"author,omitempty" bson:"author,omitempty"`                                           // Who/What authored the statement
-	InformationSource          []Reference                   `json:"informationSource,omitempty" bson:"information_source,omitempty"`                    // Person or organization that provided the information about the taking of this medication
-	DerivedFrom                []Reference                   `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                                // Link to information used to derive the MedicationStatement
-	Reason                     []CodeableReference           `json:"reason,omitempty" bson:"reason,omitempty"`                                           // Reason for why the medication is being/was taken
-	Note                       []Annotation                  `json:"note,omitempty" bson:"note,omitempty"`                                               // Further information about the usage
-	RelatedClinicalInformation []Reference                   `json:"relatedClinicalInformation,omitempty" bson:"related_clinical_information,omitempty"` // Link to information relevant to the usage of a medication
-	Dosage                     *DosageDetails                `json:"dosage,omitempty" bson:"dosage,omitempty"`                                           // Details of how medication is/was taken or should be taken
-	Adherence                  *MedicationStatementAdherence `json:"adherence,omitempty" bson:"adherence,omitempty"`                                     // Indicates whether the medication is or is not being consumed or administered
+	ResourceType               string                         `json:"resourceType" bson:"resource_type"`                                                  // Type of resource
+	Id                         *string                        `json:"id,omitempty" bson:"id,omitempty"`                                                   // Logical id of this artifact
+	Meta                       *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                               // Metadata about the resource
+	ImplicitRules              *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                            // A set of rules under which this content was created
+	ImplicitRulesElement       *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                   // Extensions for implicitRules
+	Language                   *string                        `json:"language,omitempty" bson:"language,omitempty"`                                       // Language of the resource content
+	LanguageElement            *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`                              // Extensions for language
+	Text                       *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                               // Text summary of the resource, for human interpretation
+	Contained                  []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                                     // Contained, inline Resources
+	Extension                  []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                                     // Additional content defined by implementations
+	ModifierExtension          []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                    // Extensions that cannot be ignored
+	Identifier                 []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                                   // External identifier
+	PartOf                     []Reference                    `json:"partOf,omitempty" bson:"part_of,omitempty"`                                          // Part of referenced event
+	Status                     MedicationStatementStatusCodes `json:"status" bson:"status"`                                                               // recorded | entered-in-error | draft
+	StatusElement              *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                                  // Extensions for status
+	Category                   []CodeableConcept              `json:"category,omitempty" bson:"category,omitempty"`                                       // Type of medication statement
+	Medication                 *CodeableReference             `json:"medication" bson:"medication"`                                                       // The medication that is/was taken (or not taken)
+	Subject                    *Reference                     `json:"subject" bson:"subject"`                                                             // Who is/was taking  the medication
+	Encounter                  *Reference                     `json:"encounter,omitempty" bson:"encounter,omitempty"`                                     // Encounter associated with MedicationStatement
+	Effective                  MedicationStatementEffective   `json:"-" bson:"effective,omitempty"`                                                       // The date/time or interval when the medication is/was/will be taken
+	EffectiveElement           *Element                       `json:"-" bson:"effective_element,omitempty"`                                               // Extensions for effective[x]
+	DateAsserted               *DateTime                      `json:"dateAsserted,omitempty" bson:"date_asserted,omitempty"`                              // When the usage was asserted?
+	DateAssertedElement        *Element                       `json:"_dateAsserted,omitempty" bson:"date_asserted_element,omitempty"`                     // Extensions for dateAsserted
+	Author                     *Reference                     `json:"author,omitempty" bson:"author,omitempty"`                                           // Who/What authored the statement
+	InformationSource          []Reference                    `json:"informationSource,omitempty" bson:"information_source,omitempty"`                    // Person or organization that provided the information about the taking of this medication
+	DerivedFrom                []Reference                    `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                                // Link to information used to derive the MedicationStatement
+	Reason                     []CodeableReference            `json:"reason,omitempty" bson:"reason,omitempty"`                                           // Reason for why the medication is being/was taken
+	Note                       []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                                               // Further information about the usage
+	RelatedClinicalInformation []Reference                    `json:"relatedClinicalInformation,omitempty" bson:"related_clinical_information,omitempty"` // Link to information relevant to the usage of a medication
+	Dosage                     *DosageDetails                 `json:"dosage,omitempty" bson:"dosage,omitempty"`                                           // Details of how medication is/was taken or should be taken
+	Adherence                  *MedicationStatementAdherence  `json:"adherence,omitempty" bson:"adherence,omitempty"`                                     // Indicates whether the medication is or is not being consumed or administered
+
+	effectiveVariants []string // JSON properties of MedicationStatement.effective[x] when more than one was decoded
 }
 
+var medicationStatementSubjectTargets = []string{"Group", "Patient"}
+
+var medicationStatementEncounterTargets = []string{"Encounter"}
+
+var medicationStatementInformationSourceTargets = []string{"Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *MedicationStatement) Validate() error {
 	if r.ResourceType != "MedicationStatement" {
 		return fmt.Errorf("invalid resourceType: expected 'MedicationStatement', got '%s'", r.ResourceType)
@@ -44,11 +58,39 @@ func (r *MedicationStatement) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -59,10 +101,17 @@ func (r *MedicationStatement) Validate() error {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -79,24 +128,47 @@ func (r *MedicationStatement) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationStatementSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationStatementSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationStatementEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationStatementEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.EffectivePeriod != nil {
-		if err := r.EffectivePeriod.Validate(); err != nil {
-			return fmt.Errorf("EffectivePeriod: %w", err)
+	if len(r.effectiveVariants) > 1 {
+		return fmt.Errorf("field 'Effective' must have a single type, got %v", r.effectiveVariants)
+	}
+	if r.Effective != nil {
+		if err := r.Effective.Validate(); err != nil {
+			return fmt.Errorf("Effective: %w", err)
 		}
 	}
-	if r.EffectiveTiming != nil {
-		if err := r.EffectiveTiming.Validate(); err != nil {
-			return fmt.Errorf("EffectiveTiming: %w", err)
+	if r.EffectiveElement != nil {
+		if err := r.EffectiveElement.Validate(); err != nil {
+			return fmt.Errorf("EffectiveElement: %w", err)
+		}
+	}
+	if r.DateAsserted != nil {
+		if err := r.DateAsserted.Validate(); err != nil {
+			return fmt.Errorf("DateAsserted: %w", err)
+		}
+	}
+	if r.DateAssertedElement != nil {
+		if err := r.DateAssertedElement.Validate(); err != nil {
+			return fmt.Errorf("DateAssertedElement: %w", err)
 		}
 	}
 	if r.Author != nil {
@@ -104,6 +176,11 @@ func (r *MedicationStatement) Validate() error {
 			return fmt.Errorf("Author: %w", err)
 		}
 	}
+	for i, item := range r.InformationSource {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationStatementInformationSourceTargets); target != "" {
+			return fmt.Errorf("field 'InformationSource[%d]' cannot refer to %s, expected one of %v", i, target, medicationStatementInformationSourceTargets)
+		}
+	}
 	for i, item := range r.InformationSource {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("InformationSource[%d]: %w", i, err)
@@ -139,16 +216,396 @@ func (r *MedicationStatement) Validate() error {
 			return fmt.Errorf("Adherence: %w", err)
 		}
 	}
+	return validateInvariants(r, "MedicationStatement")
+}
+
+func (r *MedicationStatement) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MedicationStatement" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MedicationStatement', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Medication == nil {
+		issues.add("required", path+".medication", "field 'Medication' is required")
+	}
+	if r.Medication != nil {
+		r.Medication.validateAll(path+".medication", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, medicationStatementSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, medicationStatementSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, medicationStatementEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, medicationStatementEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.effectiveVariants) > 1 {
+		issues.add("structure", path+".effective", fmt.Sprintf("field 'Effective' must have a single type, got %v", r.effectiveVariants))
+	}
+	if r.Effective != nil {
+		r.Effective.validateAll(path+".effective.ofType("+r.Effective.FHIRType()+")", issues)
+	}
+	if r.EffectiveElement != nil {
+		r.EffectiveElement.validateAll(path+".effective", issues)
+	}
+	if r.DateAsserted != nil {
+		if err := r.DateAsserted.Validate(); err != nil {
+			issues.add("value", path+".dateAsserted", err.Error())
+		}
+	}
+	if r.DateAssertedElement != nil {
+		r.DateAssertedElement.validateAll(path+".dateAsserted", issues)
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+	for i, item := range r.InformationSource {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicationStatementInformationSourceTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.informationSource[%d]", path, i), fmt.Sprintf("field 'InformationSource[%d]' cannot refer to %s, expected one of %v", i, target, medicationStatementInformationSourceTargets))
+		}
+	}
+	for i, item := range r.InformationSource {
+		item.validateAll(fmt.Sprintf("%s.informationSource[%d]", path, i), issues)
+	}
+	for i, item := range r.DerivedFrom {
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedClinicalInformation {
+		item.validateAll(fmt.Sprintf("%s.relatedClinicalInformation[%d]", path, i), issues)
+	}
+	if r.Dosage != nil {
+		r.Dosage.validateAll(path+".dosage", issues)
+	}
+	if r.Adherence != nil {
+		r.Adherence.validateAll(path+".adherence", issues)
+	}
+}
+
+func (r *MedicationStatement) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MedicationStatement", &issues)
+	checkInvariants(r, "MedicationStatement", &issues)
+	return issues
+}
+
+var medicationStatementInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MedicationStatement) invariants() []invariant {
+	return medicationStatementInvariants
+}
+
+func (r MedicationStatement) MarshalJSON() ([]byte, error) {
+	type alias MedicationStatement
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "effective", r.Effective, r.EffectiveElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MedicationStatement) UnmarshalJSON(data []byte) error {
+	type alias MedicationStatement
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Effective, element, r.effectiveVariants, err = unmarshalChoice(fields, "effective", medicationStatementEffectiveVariants); err != nil {
+		return err
+	}
+	r.EffectiveElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EffectiveElement); err != nil {
+			return fmt.Errorf("_effective: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r MedicationStatement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationStatement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationStatement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationStatement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicationStatement) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Effective":
+		return "effective", choiceValues(medicationStatementEffectiveVariants)
+	}
+	return "", nil
+}
+
+var medicationStatementElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *MedicationStatement) elementTypes() map[string]string {
+	return medicationStatementElementTypes
+}
+
+var medicationStatementElementMetadata = []ElementMetadata{
+	{Path: "MedicationStatement.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MedicationStatement.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MedicationStatement.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MedicationStatement.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationStatement.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MedicationStatement.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MedicationStatement.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MedicationStatement.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationStatement.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationStatement.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MedicationStatement.partOf", Name: "partOf", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationStatement.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicationStatement.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/medication-statement-status"}},
+	{Path: "MedicationStatement.category", Name: "category", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationStatement.medication", Name: "medication", Field: 16, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicationStatement.subject", Name: "subject", Field: 17, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "MedicationStatement.encounter", Name: "encounter", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "MedicationStatement.effective[x]", Name: "effective", Field: 19, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "MedicationStatement.dateAsserted", Name: "dateAsserted", Field: 21, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicationStatement.author", Name: "author", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "MedicationStatement.informationSource", Name: "informationSource", Field: 24, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "MedicationStatement.derivedFrom", Name: "derivedFrom", Field: 25, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationStatement.reason", Name: "reason", Field: 26, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "MedicationStatement.note", Name: "note", Field: 27, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "MedicationStatement.relatedClinicalInformation", Name: "relatedClinicalInformation", Field: 28, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicationStatement.dosage", Name: "dosage", Field: 29, Min: 0, Max: "1", Types: []string{"DosageDetails"}},
+	{Path: "MedicationStatement.adherence", Name: "adherence", Field: 30, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *MedicationStatement) ElementMetadata() []ElementMetadata {
+	return medicationStatementElementMetadata
+}
+
+// MedicationStatementEffective is the type of MedicationStatement.effective[x]. It is implemented by the MedicationStatementEffective* variant types.
+type MedicationStatementEffective interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicationStatementEffective()
+}
+
+// MedicationStatementEffectiveDateTime is the dateTime variant of MedicationStatementEffective.
+type MedicationStatementEffectiveDateTime struct {
+	DateTime
+}
+
+func (v MedicationStatementEffectiveDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v MedicationStatementEffectiveDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v MedicationStatementEffectiveDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v MedicationStatementEffectiveDateTime) isMedicationStatementEffective() {}
+
+// MedicationStatementEffectivePeriod is the Period variant of MedicationStatementEffective.
+type MedicationStatementEffectivePeriod struct {
+	Period
+}
+
+func (v MedicationStatementEffectivePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v MedicationStatementEffectivePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v MedicationStatementEffectivePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v MedicationStatementEffectivePeriod) isMedicationStatementEffective() {}
+
+// MedicationStatementEffectiveTiming is the Timing variant of MedicationStatementEffective.
+type MedicationStatementEffectiveTiming struct {
+	Timing
+}
+
+func (v MedicationStatementEffectiveTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v MedicationStatementEffectiveTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v MedicationStatementEffectiveTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v MedicationStatementEffectiveTiming) isMedicationStatementEffective() {}
+
+var medicationStatementEffectiveVariants = []MedicationStatementEffective{
+	MedicationStatementEffectiveDateTime{},
+	MedicationStatementEffectivePeriod{},
+	MedicationStatementEffectiveTiming{},
+}
+
+func (r *MedicationStatement) GetResourceType() string {
+	return "MedicationStatement"
+}
+
+func (r *MedicationStatement) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MedicationStatement) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MedicationStatement) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MedicationStatement) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MedicationStatement) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MedicationStatement) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MedicationStatement) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MedicationStatement)(nil)
+
 type MedicationStatementAdherence struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Code   *CodeableConcept `json:"code" bson:"code"`                         // Type of adherence
-	Reason *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"` // Details of the reason for the current use of the medication
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code" bson:"code"`                                                // Type of adherence
+	Reason            *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // Details of the reason for the current use of the medication
 }
 
 func (r *MedicationStatementAdherence) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -164,3 +621,57 @@ func (r *MedicationStatementAdherence) Validate() error {
 	}
 	return nil
 }
+
+func (r *MedicationStatementAdherence) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+}
+
+var medicationStatementAdherenceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicationStatementAdherence) invariants() []invariant {
+	return medicationStatementAdherenceInvariants
+}
+
+func (r MedicationStatementAdherence) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicationStatementAdherence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicationStatementAdherence) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicationStatementAdherence) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicationStatementAdherenceElementMetadata = []ElementMetadata{
+	{Path: "MedicationStatement.adherence.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicationStatement.adherence.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicationStatement.adherence.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicationStatement.adherence.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicationStatement.adherence.reason", Name: "reason", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MedicationStatementAdherence) ElementMetadata() []ElementMetadata {
+	return medicationStatementAdherenceElementMetadata
+}
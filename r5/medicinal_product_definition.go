@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,19 +12,27 @@ type MedicinalProductDefinition struct {
 	Id                             *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                                            // Logical id of this artifact
 	Meta                           *Meta                                      `json:"meta,omitempty" bson:"meta,omitempty"`                                                        // Metadata about the resource
 	ImplicitRules                  *string                                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                     // A set of rules under which this content was created
+	ImplicitRulesElement           *Element                                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                            // Extensions for implicitRules
 	Language                       *string                                    `json:"language,omitempty" bson:"language,omitempty"`                                                // Language of the resource content
+	LanguageElement                *Element                                   `json:"_language,omitempty" bson:"language_element,omitempty"`                                       // Extensions for language
 	Text                           *Narrative                                 `json:"text,omitempty" bson:"text,omitempty"`                                                        // Text summary of the resource, for human interpretation
-	Contained                      []json.RawMessage                          `json:"contained,omitempty" bson:"contained,omitempty"`                                              // Contained, inline Resources
+	Contained                      []Resource                                 `json:"contained,omitempty" bson:"contained,omitempty"`                                              // Contained, inline Resources
+	Extension                      []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                                              // Additional content defined by implementations
+	ModifierExtension              []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                             // Extensions that cannot be ignored
 	Identifier                     []Identifier                               `json:"identifier,omitempty" bson:"identifier,omitempty"`                                            // Business identifier for this product. Could be an MPID
 	Type                           *CodeableConcept                           `json:"type,omitempty" bson:"type,omitempty"`                                                        // Regulatory type, e.g. Investigational or Authorized
 	Domain                         *CodeableConcept                           `json:"domain,omitempty" bson:"domain,omitempty"`                                                    // If this medicine applies to human or veterinary uses
 	Version                        *string                                    `json:"version,omitempty" bson:"version,omitempty"`                                                  // A business identifier relating to a specific version of the product
+	VersionElement                 *Element                                   `json:"_version,omitempty" bson:"version_element,omitempty"`                                         // Extensions for version
 	Status                         *CodeableConcept                           `json:"status,omitempty" bson:"status,omitempty"`                                                    // The status within the lifecycle of this product record
-	StatusDate                     *string                                    `json:"statusDate,omitempty" bson:"status_date,omitempty"`                                           // The date at which the given status became applicable
+	StatusDate                     *DateTime                                  `json:"statusDate,omitempty" bson:"status_date,omitempty"`                                           // The date at which the given status became applicable
+	StatusDateElement              *Element                                   `json:"_statusDate,omitempty" bson:"status_date_element,omitempty"`                                  // Extensions for statusDate
 	Description                    *string                                    `json:"description,omitempty" bson:"description,omitempty"`                                          // General description of this product
+	DescriptionElement             *Element                                   `json:"_description,omitempty" bson:"description_element,omitempty"`                                 // Extensions for description
 	CombinedPharmaceuticalDoseForm *CodeableConcept                           `json:"combinedPharmaceuticalDoseForm,omitempty" bson:"combined_pharmaceutical_dose_form,omitempty"` // The dose form for a single part product, or combined form of a multiple part product
 	Route                          []CodeableConcept                          `json:"route,omitempty" bson:"route,omitempty"`                                                      // The path by which the product is taken into or makes contact with the body
 	Indication                     *string                                    `json:"indication,omitempty" bson:"indication,omitempty"`                                            // Description of indication(s) for this product, used when structured indications are not required
+	IndicationElement              *Element                                   `json:"_indication,omitempty" bson:"indication_element,omitempty"`                                   // Extensions for indication
 	LegalStatusOfSupply            *CodeableConcept                           `json:"legalStatusOfSupply,omitempty" bson:"legal_status_of_supply,omitempty"`                       // The legal status of supply of the medicinal product as classified by the regulator
 	AdditionalMonitoringIndicator  *CodeableConcept                           `json:"additionalMonitoringIndicator,omitempty" bson:"additional_monitoring_indicator,omitempty"`    // Whether the Medicinal Product is subject to additional monitoring for regulatory reasons
 	SpecialMeasures                []CodeableConcept                          `json:"specialMeasures,omitempty" bson:"special_measures,omitempty"`                                 // Whether the Medicinal Product is subject to special measures for regulatory reasons
@@ -45,6 +54,8 @@ type MedicinalProductDefinition struct {
 	Characteristic                 []MedicinalProductDefinitionCharacteristic `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                                    // Key product features such as "sugar free", "modified release"
 }
 
+var medicinalProductDefinitionMasterFileTargets = []string{"DocumentReference"}
+
 func (r *MedicinalProductDefinition) Validate() error {
 	if r.ResourceType != "MedicinalProductDefinition" {
 		return fmt.Errorf("invalid resourceType: expected 'MedicinalProductDefinition', got '%s'", r.ResourceType)
@@ -54,11 +65,39 @@ func (r *MedicinalProductDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -74,11 +113,31 @@ func (r *MedicinalProductDefinition) Validate() error {
 			return fmt.Errorf("Domain: %w", err)
 		}
 	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
 	if r.Status != nil {
 		if err := r.Status.Validate(); err != nil {
 			return fmt.Errorf("Status: %w", err)
 		}
 	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			return fmt.Errorf("StatusDate: %w", err)
+		}
+	}
+	if r.StatusDateElement != nil {
+		if err := r.StatusDateElement.Validate(); err != nil {
+			return fmt.Errorf("StatusDateElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.CombinedPharmaceuticalDoseForm != nil {
 		if err := r.CombinedPharmaceuticalDoseForm.Validate(); err != nil {
 			return fmt.Errorf("CombinedPharmaceuticalDoseForm: %w", err)
@@ -89,6 +148,11 @@ func (r *MedicinalProductDefinition) Validate() error {
 			return fmt.Errorf("Route[%d]: %w", i, err)
 		}
 	}
+	if r.IndicationElement != nil {
+		if err := r.IndicationElement.Validate(); err != nil {
+			return fmt.Errorf("IndicationElement: %w", err)
+		}
+	}
 	if r.LegalStatusOfSupply != nil {
 		if err := r.LegalStatusOfSupply.Validate(); err != nil {
 			return fmt.Errorf("LegalStatusOfSupply: %w", err)
@@ -144,6 +208,11 @@ func (r *MedicinalProductDefinition) Validate() error {
 			return fmt.Errorf("AttachedDocument[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.MasterFile {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicinalProductDefinitionMasterFileTargets); target != "" {
+			return fmt.Errorf("field 'MasterFile[%d]' cannot refer to %s, expected one of %v", i, target, medicinalProductDefinitionMasterFileTargets)
+		}
+	}
 	for i, item := range r.MasterFile {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("MasterFile[%d]: %w", i, err)
@@ -187,147 +256,312 @@ func (r *MedicinalProductDefinition) Validate() error {
 			return fmt.Errorf("Characteristic[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type MedicinalProductDefinitionNamePart struct {
-	Id   *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Part string           `json:"part" bson:"part"`                 // A fragment of a product name
-	Type *CodeableConcept `json:"type" bson:"type"`                 // Identifying type for this part of the name (e.g. strength part)
+	return validateInvariants(r, "MedicinalProductDefinition")
 }
 
-func (r *MedicinalProductDefinitionNamePart) Validate() error {
-	var emptyString string
-	if r.Part == emptyString {
-		return fmt.Errorf("field 'Part' is required")
+func (r *MedicinalProductDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MedicinalProductDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MedicinalProductDefinition', got '%s'", r.ResourceType))
 	}
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	return nil
-}
-
-type MedicinalProductDefinitionNameUsage struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Country      *CodeableConcept `json:"country" bson:"country"`                               // Country code for where this name applies
-	Jurisdiction *CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"` // Jurisdiction code for where this name applies
-	Language     *CodeableConcept `json:"language" bson:"language"`                             // Language code for this name
-}
-
-func (r *MedicinalProductDefinitionNameUsage) Validate() error {
-	if r.Country == nil {
-		return fmt.Errorf("field 'Country' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.Country != nil {
-		if err := r.Country.Validate(); err != nil {
-			return fmt.Errorf("Country: %w", err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.Jurisdiction != nil {
-		if err := r.Jurisdiction.Validate(); err != nil {
-			return fmt.Errorf("Jurisdiction: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.Language == nil {
-		return fmt.Errorf("field 'Language' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Language != nil {
-		if err := r.Language.Validate(); err != nil {
-			return fmt.Errorf("Language: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type MedicinalProductDefinitionOperation struct {
-	Id                       *string            `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
-	Type                     *CodeableReference `json:"type,omitempty" bson:"type,omitempty"`                                          // The type of manufacturing operation e.g. manufacturing itself, re-packaging
-	EffectiveDate            *Period            `json:"effectiveDate,omitempty" bson:"effective_date,omitempty"`                       // Date range of applicability
-	Organization             []Reference        `json:"organization,omitempty" bson:"organization,omitempty"`                          // The organization responsible for the particular process, e.g. the manufacturer or importer
-	ConfidentialityIndicator *CodeableConcept   `json:"confidentialityIndicator,omitempty" bson:"confidentiality_indicator,omitempty"` // Specifies whether this process is considered proprietary or confidential
-}
-
-func (r *MedicinalProductDefinitionOperation) Validate() error {
 	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.EffectiveDate != nil {
-		if err := r.EffectiveDate.Validate(); err != nil {
-			return fmt.Errorf("EffectiveDate: %w", err)
-		}
+	if r.Domain != nil {
+		r.Domain.validateAll(path+".domain", issues)
 	}
-	for i, item := range r.Organization {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Organization[%d]: %w", i, err)
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			issues.add("value", path+".statusDate", err.Error())
 		}
 	}
-	if r.ConfidentialityIndicator != nil {
-		if err := r.ConfidentialityIndicator.Validate(); err != nil {
-			return fmt.Errorf("ConfidentialityIndicator: %w", err)
+	if r.StatusDateElement != nil {
+		r.StatusDateElement.validateAll(path+".statusDate", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.CombinedPharmaceuticalDoseForm != nil {
+		r.CombinedPharmaceuticalDoseForm.validateAll(path+".combinedPharmaceuticalDoseForm", issues)
+	}
+	for i, item := range r.Route {
+		item.validateAll(fmt.Sprintf("%s.route[%d]", path, i), issues)
+	}
+	if r.IndicationElement != nil {
+		r.IndicationElement.validateAll(path+".indication", issues)
+	}
+	if r.LegalStatusOfSupply != nil {
+		r.LegalStatusOfSupply.validateAll(path+".legalStatusOfSupply", issues)
+	}
+	if r.AdditionalMonitoringIndicator != nil {
+		r.AdditionalMonitoringIndicator.validateAll(path+".additionalMonitoringIndicator", issues)
+	}
+	for i, item := range r.SpecialMeasures {
+		item.validateAll(fmt.Sprintf("%s.specialMeasures[%d]", path, i), issues)
+	}
+	if r.PediatricUseIndicator != nil {
+		r.PediatricUseIndicator.validateAll(path+".pediatricUseIndicator", issues)
+	}
+	for i, item := range r.Classification {
+		item.validateAll(fmt.Sprintf("%s.classification[%d]", path, i), issues)
+	}
+	for i, item := range r.MarketingStatus {
+		item.validateAll(fmt.Sprintf("%s.marketingStatus[%d]", path, i), issues)
+	}
+	for i, item := range r.PackagedMedicinalProduct {
+		item.validateAll(fmt.Sprintf("%s.packagedMedicinalProduct[%d]", path, i), issues)
+	}
+	for i, item := range r.ComprisedOf {
+		item.validateAll(fmt.Sprintf("%s.comprisedOf[%d]", path, i), issues)
+	}
+	for i, item := range r.Ingredient {
+		item.validateAll(fmt.Sprintf("%s.ingredient[%d]", path, i), issues)
+	}
+	for i, item := range r.Impurity {
+		item.validateAll(fmt.Sprintf("%s.impurity[%d]", path, i), issues)
+	}
+	for i, item := range r.AttachedDocument {
+		item.validateAll(fmt.Sprintf("%s.attachedDocument[%d]", path, i), issues)
+	}
+	for i, item := range r.MasterFile {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicinalProductDefinitionMasterFileTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.masterFile[%d]", path, i), fmt.Sprintf("field 'MasterFile[%d]' cannot refer to %s, expected one of %v", i, target, medicinalProductDefinitionMasterFileTargets))
 		}
 	}
-	return nil
+	for i, item := range r.MasterFile {
+		item.validateAll(fmt.Sprintf("%s.masterFile[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.ClinicalTrial {
+		item.validateAll(fmt.Sprintf("%s.clinicalTrial[%d]", path, i), issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	if len(r.Name) < 1 {
+		issues.add("required", path+".name", "field 'Name' must have at least 1 elements")
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.CrossReference {
+		item.validateAll(fmt.Sprintf("%s.crossReference[%d]", path, i), issues)
+	}
+	for i, item := range r.Operation {
+		item.validateAll(fmt.Sprintf("%s.operation[%d]", path, i), issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
 }
 
-type MedicinalProductDefinitionCharacteristic struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                                       // A code expressing the type of characteristic
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // A value for the characteristic
-	ValueMarkdown        *string          `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                // A value for the characteristic
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // A value for the characteristic
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // A value for the characteristic
-	ValueInteger         *int             `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                  // A value for the characteristic
-	ValueDate            *string          `json:"valueDate,omitempty" bson:"value_date,omitempty"`                        // A value for the characteristic
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // A value for the characteristic
-	ValueAttachment      *Attachment      `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`            // A value for the characteristic
+func (r *MedicinalProductDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MedicinalProductDefinition", &issues)
+	checkInvariants(r, "MedicinalProductDefinition", &issues)
+	return issues
 }
 
-func (r *MedicinalProductDefinitionCharacteristic) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+var medicinalProductDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MedicinalProductDefinition) invariants() []invariant {
+	return medicinalProductDefinitionInvariants
+}
+
+func (r *MedicinalProductDefinition) UnmarshalJSON(data []byte) error {
+	type alias MedicinalProductDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	return nil
+}
+
+func (r MedicinalProductDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+	"indication":    "markdown",
+}
+
+func (r *MedicinalProductDefinition) elementTypes() map[string]string {
+	return medicinalProductDefinitionElementTypes
+}
+
+var medicinalProductDefinitionElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MedicinalProductDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MedicinalProductDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MedicinalProductDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MedicinalProductDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MedicinalProductDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MedicinalProductDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MedicinalProductDefinition.type", Name: "type", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.domain", Name: "domain", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.status", Name: "status", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.statusDate", Name: "statusDate", Field: 17, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MedicinalProductDefinition.description", Name: "description", Field: 19, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MedicinalProductDefinition.combinedPharmaceuticalDoseForm", Name: "combinedPharmaceuticalDoseForm", Field: 21, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.route", Name: "route", Field: 22, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.indication", Name: "indication", Field: 23, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MedicinalProductDefinition.legalStatusOfSupply", Name: "legalStatusOfSupply", Field: 25, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.additionalMonitoringIndicator", Name: "additionalMonitoringIndicator", Field: 26, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.specialMeasures", Name: "specialMeasures", Field: 27, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.pediatricUseIndicator", Name: "pediatricUseIndicator", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.classification", Name: "classification", Field: 29, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.marketingStatus", Name: "marketingStatus", Field: 30, Min: 0, Max: "*", Types: []string{"MarketingStatus"}},
+	{Path: "MedicinalProductDefinition.packagedMedicinalProduct", Name: "packagedMedicinalProduct", Field: 31, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.comprisedOf", Name: "comprisedOf", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicinalProductDefinition.ingredient", Name: "ingredient", Field: 33, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.impurity", Name: "impurity", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "MedicinalProductDefinition.attachedDocument", Name: "attachedDocument", Field: 35, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicinalProductDefinition.masterFile", Name: "masterFile", Field: 36, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DocumentReference"}},
+	{Path: "MedicinalProductDefinition.contact", Name: "contact", Field: 37, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicinalProductDefinition.clinicalTrial", Name: "clinicalTrial", Field: 38, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "MedicinalProductDefinition.code", Name: "code", Field: 39, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "MedicinalProductDefinition.name", Name: "name", Field: 40, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicinalProductDefinition.crossReference", Name: "crossReference", Field: 41, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicinalProductDefinition.operation", Name: "operation", Field: 42, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicinalProductDefinition.characteristic", Name: "characteristic", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MedicinalProductDefinition) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionElementMetadata
+}
+
+func (r *MedicinalProductDefinition) GetResourceType() string {
+	return "MedicinalProductDefinition"
+}
+
+func (r *MedicinalProductDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *MedicinalProductDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
+}
+
+func (r *MedicinalProductDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MedicinalProductDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
 }
 
+func (r *MedicinalProductDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MedicinalProductDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MedicinalProductDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MedicinalProductDefinition)(nil)
+
 type MedicinalProductDefinitionContact struct {
-	Id      *string          `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Type    *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"` // Allows the contact to be classified, for example QPPV, Pharmacovigilance Enquiry Information
-	Contact *Reference       `json:"contact" bson:"contact"`               // A product specific contact, person (in a role), or an organization
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Allows the contact to be classified, for example QPPV, Pharmacovigilance Enquiry Information
+	Contact           *Reference       `json:"contact" bson:"contact"`                                          // A product specific contact, person (in a role), or an organization
 }
 
+var medicinalProductDefinitionContactContactTargets = []string{"Organization", "PractitionerRole"}
+
 func (r *MedicinalProductDefinitionContact) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -336,6 +570,11 @@ func (r *MedicinalProductDefinitionContact) Validate() error {
 	if r.Contact == nil {
 		return fmt.Errorf("field 'Contact' is required")
 	}
+	if r.Contact != nil {
+		if target := invalidReferenceTarget(r.Contact.Reference, r.Contact.Type, medicinalProductDefinitionContactContactTargets); target != "" {
+			return fmt.Errorf("field 'Contact' cannot refer to %s, expected one of %v", target, medicinalProductDefinitionContactContactTargets)
+		}
+	}
 	if r.Contact != nil {
 		if err := r.Contact.Validate(); err != nil {
 			return fmt.Errorf("Contact: %w", err)
@@ -344,19 +583,96 @@ func (r *MedicinalProductDefinitionContact) Validate() error {
 	return nil
 }
 
+func (r *MedicinalProductDefinitionContact) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Contact == nil {
+		issues.add("required", path+".contact", "field 'Contact' is required")
+	}
+	if r.Contact != nil {
+		if target := invalidReferenceTarget(r.Contact.Reference, r.Contact.Type, medicinalProductDefinitionContactContactTargets); target != "" {
+			issues.add("value", path+".contact", fmt.Sprintf("field 'Contact' cannot refer to %s, expected one of %v", target, medicinalProductDefinitionContactContactTargets))
+		}
+	}
+	if r.Contact != nil {
+		r.Contact.validateAll(path+".contact", issues)
+	}
+}
+
+var medicinalProductDefinitionContactInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionContact) invariants() []invariant {
+	return medicinalProductDefinitionContactInvariants
+}
+
+func (r MedicinalProductDefinitionContact) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionContact) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionContact) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionContact) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionContactElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.contact.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.contact.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.contact.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.contact.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.contact.contact", Name: "contact", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+}
+
+func (r *MedicinalProductDefinitionContact) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionContactElementMetadata
+}
+
 type MedicinalProductDefinitionName struct {
-	Id          *string                               `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	ProductName string                                `json:"productName" bson:"product_name"`        // The full product name
-	Type        *CodeableConcept                      `json:"type,omitempty" bson:"type,omitempty"`   // Type of product name, such as rINN, BAN, Proprietary, Non-Proprietary
-	Part        []MedicinalProductDefinitionNamePart  `json:"part,omitempty" bson:"part,omitempty"`   // Coding words or phrases of the name
-	Usage       []MedicinalProductDefinitionNameUsage `json:"usage,omitempty" bson:"usage,omitempty"` // Country and jurisdiction where the name applies
+	Id                 *string                               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	ProductName        string                                `json:"productName" bson:"product_name"`                                 // The full product name
+	ProductNameElement *Element                              `json:"_productName,omitempty" bson:"product_name_element,omitempty"`    // Extensions for productName
+	Type               *CodeableConcept                      `json:"type,omitempty" bson:"type,omitempty"`                            // Type of product name, such as rINN, BAN, Proprietary, Non-Proprietary
+	Part               []MedicinalProductDefinitionNamePart  `json:"part,omitempty" bson:"part,omitempty"`                            // Coding words or phrases of the name
+	Usage              []MedicinalProductDefinitionNameUsage `json:"usage,omitempty" bson:"usage,omitempty"`                          // Country and jurisdiction where the name applies
 }
 
 func (r *MedicinalProductDefinitionName) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.ProductName == emptyString {
 		return fmt.Errorf("field 'ProductName' is required")
 	}
+	if r.ProductNameElement != nil {
+		if err := r.ProductNameElement.Validate(); err != nil {
+			return fmt.Errorf("ProductNameElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -375,25 +691,799 @@ func (r *MedicinalProductDefinitionName) Validate() error {
 	return nil
 }
 
-type MedicinalProductDefinitionCrossReference struct {
-	Id      *string            `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Product *CodeableReference `json:"product" bson:"product"`               // Reference to another product, e.g. for linking authorised to investigational product
-	Type    *CodeableConcept   `json:"type,omitempty" bson:"type,omitempty"` // The type of relationship, for instance branded to generic or virtual to actual product
-}
-
-func (r *MedicinalProductDefinitionCrossReference) Validate() error {
-	if r.Product == nil {
-		return fmt.Errorf("field 'Product' is required")
+func (r *MedicinalProductDefinitionName) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Product != nil {
-		if err := r.Product.Validate(); err != nil {
-			return fmt.Errorf("Product: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.ProductName == emptyString {
+		issues.add("required", path+".productName", "field 'ProductName' is required")
+	}
+	if r.ProductNameElement != nil {
+		r.ProductNameElement.validateAll(path+".productName", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Part {
+		item.validateAll(fmt.Sprintf("%s.part[%d]", path, i), issues)
+	}
+	for i, item := range r.Usage {
+		item.validateAll(fmt.Sprintf("%s.usage[%d]", path, i), issues)
+	}
+}
+
+var medicinalProductDefinitionNameInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionName) invariants() []invariant {
+	return medicinalProductDefinitionNameInvariants
+}
+
+func (r MedicinalProductDefinitionName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionName) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionNameElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.name.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.name.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.name.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.name.productName", Name: "productName", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.name.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.name.part", Name: "part", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MedicinalProductDefinition.name.usage", Name: "usage", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MedicinalProductDefinitionName) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionNameElementMetadata
+}
+
+type MedicinalProductDefinitionNamePart struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Part              string           `json:"part" bson:"part"`                                                // A fragment of a product name
+	PartElement       *Element         `json:"_part,omitempty" bson:"part_element,omitempty"`                   // Extensions for part
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Identifying type for this part of the name (e.g. strength part)
+}
+
+func (r *MedicinalProductDefinitionNamePart) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Part == emptyString {
+		return fmt.Errorf("field 'Part' is required")
+	}
+	if r.PartElement != nil {
+		if err := r.PartElement.Validate(); err != nil {
+			return fmt.Errorf("PartElement: %w", err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MedicinalProductDefinitionNamePart) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Part == emptyString {
+		issues.add("required", path+".part", "field 'Part' is required")
+	}
+	if r.PartElement != nil {
+		r.PartElement.validateAll(path+".part", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+}
+
+var medicinalProductDefinitionNamePartInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionNamePart) invariants() []invariant {
+	return medicinalProductDefinitionNamePartInvariants
+}
+
+func (r MedicinalProductDefinitionNamePart) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionNamePart) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionNamePart) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionNamePart) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionNamePartElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.name.part.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.name.part.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.name.part.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.name.part.part", Name: "part", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.name.part.type", Name: "type", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MedicinalProductDefinitionNamePart) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionNamePartElementMetadata
+}
+
+type MedicinalProductDefinitionNameUsage struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Country           *CodeableConcept `json:"country" bson:"country"`                                          // Country code for where this name applies
+	Jurisdiction      *CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`            // Jurisdiction code for where this name applies
+	Language          *CodeableConcept `json:"language" bson:"language"`                                        // Language code for this name
+}
+
+func (r *MedicinalProductDefinitionNameUsage) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Country == nil {
+		return fmt.Errorf("field 'Country' is required")
+	}
+	if r.Country != nil {
+		if err := r.Country.Validate(); err != nil {
+			return fmt.Errorf("Country: %w", err)
+		}
+	}
+	if r.Jurisdiction != nil {
+		if err := r.Jurisdiction.Validate(); err != nil {
+			return fmt.Errorf("Jurisdiction: %w", err)
+		}
+	}
+	if r.Language == nil {
+		return fmt.Errorf("field 'Language' is required")
+	}
+	if r.Language != nil {
+		if err := r.Language.Validate(); err != nil {
+			return fmt.Errorf("Language: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MedicinalProductDefinitionNameUsage) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Country == nil {
+		issues.add("required", path+".country", "field 'Country' is required")
+	}
+	if r.Country != nil {
+		r.Country.validateAll(path+".country", issues)
+	}
+	if r.Jurisdiction != nil {
+		r.Jurisdiction.validateAll(path+".jurisdiction", issues)
+	}
+	if r.Language == nil {
+		issues.add("required", path+".language", "field 'Language' is required")
+	}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
+	}
+}
+
+var medicinalProductDefinitionNameUsageInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionNameUsage) invariants() []invariant {
+	return medicinalProductDefinitionNameUsageInvariants
+}
+
+func (r MedicinalProductDefinitionNameUsage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionNameUsage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionNameUsage) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionNameUsage) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionNameUsageElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.name.usage.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.name.usage.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.name.usage.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.name.usage.country", Name: "country", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.name.usage.jurisdiction", Name: "jurisdiction", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.name.usage.language", Name: "language", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MedicinalProductDefinitionNameUsage) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionNameUsageElementMetadata
+}
+
+type MedicinalProductDefinitionCrossReference struct {
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Product           *CodeableReference `json:"product" bson:"product"`                                          // Reference to another product, e.g. for linking authorised to investigational product
+	Type              *CodeableConcept   `json:"type,omitempty" bson:"type,omitempty"`                            // The type of relationship, for instance branded to generic or virtual to actual product
+}
+
+func (r *MedicinalProductDefinitionCrossReference) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Product == nil {
+		return fmt.Errorf("field 'Product' is required")
+	}
+	if r.Product != nil {
+		if err := r.Product.Validate(); err != nil {
+			return fmt.Errorf("Product: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MedicinalProductDefinitionCrossReference) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Product == nil {
+		issues.add("required", path+".product", "field 'Product' is required")
+	}
+	if r.Product != nil {
+		r.Product.validateAll(path+".product", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+}
+
+var medicinalProductDefinitionCrossReferenceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionCrossReference) invariants() []invariant {
+	return medicinalProductDefinitionCrossReferenceInvariants
+}
+
+func (r MedicinalProductDefinitionCrossReference) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionCrossReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionCrossReference) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionCrossReference) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionCrossReferenceElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.crossReference.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.crossReference.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.crossReference.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.crossReference.product", Name: "product", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicinalProductDefinition.crossReference.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MedicinalProductDefinitionCrossReference) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionCrossReferenceElementMetadata
+}
+
+type MedicinalProductDefinitionOperation struct {
+	Id                       *string            `json:"id,omitempty" bson:"id,omitempty"`                                              // Unique id for inter-element referencing
+	Extension                []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                                // Additional content defined by implementations
+	ModifierExtension        []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`               // Extensions that cannot be ignored even if unrecognized
+	Type                     *CodeableReference `json:"type,omitempty" bson:"type,omitempty"`                                          // The type of manufacturing operation e.g. manufacturing itself, re-packaging
+	EffectiveDate            *Period            `json:"effectiveDate,omitempty" bson:"effective_date,omitempty"`                       // Date range of applicability
+	Organization             []Reference        `json:"organization,omitempty" bson:"organization,omitempty"`                          // The organization responsible for the particular process, e.g. the manufacturer or importer
+	ConfidentialityIndicator *CodeableConcept   `json:"confidentialityIndicator,omitempty" bson:"confidentiality_indicator,omitempty"` // Specifies whether this process is considered proprietary or confidential
+}
+
+var medicinalProductDefinitionOperationOrganizationTargets = []string{"Organization"}
+
+func (r *MedicinalProductDefinitionOperation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
 	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.EffectiveDate != nil {
+		if err := r.EffectiveDate.Validate(); err != nil {
+			return fmt.Errorf("EffectiveDate: %w", err)
+		}
+	}
+	for i, item := range r.Organization {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicinalProductDefinitionOperationOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'Organization[%d]' cannot refer to %s, expected one of %v", i, target, medicinalProductDefinitionOperationOrganizationTargets)
+		}
+	}
+	for i, item := range r.Organization {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Organization[%d]: %w", i, err)
+		}
+	}
+	if r.ConfidentialityIndicator != nil {
+		if err := r.ConfidentialityIndicator.Validate(); err != nil {
+			return fmt.Errorf("ConfidentialityIndicator: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *MedicinalProductDefinitionOperation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.EffectiveDate != nil {
+		r.EffectiveDate.validateAll(path+".effectiveDate", issues)
+	}
+	for i, item := range r.Organization {
+		if target := invalidReferenceTarget(item.Reference, item.Type, medicinalProductDefinitionOperationOrganizationTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.organization[%d]", path, i), fmt.Sprintf("field 'Organization[%d]' cannot refer to %s, expected one of %v", i, target, medicinalProductDefinitionOperationOrganizationTargets))
+		}
+	}
+	for i, item := range r.Organization {
+		item.validateAll(fmt.Sprintf("%s.organization[%d]", path, i), issues)
+	}
+	if r.ConfidentialityIndicator != nil {
+		r.ConfidentialityIndicator.validateAll(path+".confidentialityIndicator", issues)
+	}
+}
+
+var medicinalProductDefinitionOperationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionOperation) invariants() []invariant {
+	return medicinalProductDefinitionOperationInvariants
+}
+
+func (r MedicinalProductDefinitionOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionOperation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionOperation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionOperation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var medicinalProductDefinitionOperationElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.operation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.operation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.operation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.operation.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "MedicinalProductDefinition.operation.effectiveDate", Name: "effectiveDate", Field: 4, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "MedicinalProductDefinition.operation.organization", Name: "organization", Field: 5, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "MedicinalProductDefinition.operation.confidentialityIndicator", Name: "confidentialityIndicator", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *MedicinalProductDefinitionOperation) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionOperationElementMetadata
+}
+
+type MedicinalProductDefinitionCharacteristic struct {
+	Id                *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                              `json:"type" bson:"type"`                                                // A code expressing the type of characteristic
+	Value             MedicinalProductDefinitionCharacteristicValue `json:"-" bson:"value,omitempty"`                                        // A value for the characteristic
+	ValueElement      *Element                                      `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of MedicinalProductDefinition.characteristic.value[x] when more than one was decoded
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var medicinalProductDefinitionCharacteristicInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) invariants() []invariant {
+	return medicinalProductDefinitionCharacteristicInvariants
+}
+
+func (r MedicinalProductDefinitionCharacteristic) MarshalJSON() ([]byte, error) {
+	type alias MedicinalProductDefinitionCharacteristic
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) UnmarshalJSON(data []byte) error {
+	type alias MedicinalProductDefinitionCharacteristic
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", medicinalProductDefinitionCharacteristicValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MedicinalProductDefinitionCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MedicinalProductDefinitionCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(medicinalProductDefinitionCharacteristicValueVariants)
+	}
+	return "", nil
+}
+
+var medicinalProductDefinitionCharacteristicElementMetadata = []ElementMetadata{
+	{Path: "MedicinalProductDefinition.characteristic.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MedicinalProductDefinition.characteristic.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MedicinalProductDefinition.characteristic.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MedicinalProductDefinition.characteristic.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MedicinalProductDefinition.characteristic.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "markdown", "Quantity", "Range", "integer", "date", "boolean", "Attachment"}},
+}
+
+func (r *MedicinalProductDefinitionCharacteristic) ElementMetadata() []ElementMetadata {
+	return medicinalProductDefinitionCharacteristicElementMetadata
+}
+
+// MedicinalProductDefinitionCharacteristicValue is the type of MedicinalProductDefinition.characteristic.value[x]. It is implemented by the MedicinalProductDefinitionCharacteristicValue* variant types.
+type MedicinalProductDefinitionCharacteristicValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMedicinalProductDefinitionCharacteristicValue()
+}
+
+// MedicinalProductDefinitionCharacteristicValueCodeableConcept is the CodeableConcept variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueCodeableConcept) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueMarkdown is the markdown variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueMarkdown string
+
+func (v MedicinalProductDefinitionCharacteristicValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueMarkdown) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueQuantity is the Quantity variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueQuantity struct {
+	Quantity
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueQuantity) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueRange is the Range variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueRange struct {
+	Range
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueRange) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueInteger is the integer variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueInteger int
+
+func (v MedicinalProductDefinitionCharacteristicValueInteger) Validate() error {
+	return nil
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueInteger) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueDate is the date variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueDate struct {
+	Date
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueDate) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueBoolean is the boolean variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueBoolean bool
+
+func (v MedicinalProductDefinitionCharacteristicValueBoolean) Validate() error {
+	return nil
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueBoolean) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+// MedicinalProductDefinitionCharacteristicValueAttachment is the Attachment variant of MedicinalProductDefinitionCharacteristicValue.
+type MedicinalProductDefinitionCharacteristicValueAttachment struct {
+	Attachment
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v MedicinalProductDefinitionCharacteristicValueAttachment) isMedicinalProductDefinitionCharacteristicValue() {
+}
+
+var medicinalProductDefinitionCharacteristicValueVariants = []MedicinalProductDefinitionCharacteristicValue{
+	MedicinalProductDefinitionCharacteristicValueCodeableConcept{},
+	MedicinalProductDefinitionCharacteristicValueMarkdown(""),
+	MedicinalProductDefinitionCharacteristicValueQuantity{},
+	MedicinalProductDefinitionCharacteristicValueRange{},
+	MedicinalProductDefinitionCharacteristicValueInteger(0),
+	MedicinalProductDefinitionCharacteristicValueDate{},
+	MedicinalProductDefinitionCharacteristicValueBoolean(false),
+	MedicinalProductDefinitionCharacteristicValueAttachment{},
+}
@@ -2,45 +2,70 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Defines the characteristics of a message that can be shared between systems, including the type of event that initiates the message, the content to be transmitted and what response(s), if any, are permitted.
 type MessageDefinition struct {
-	ResourceType           string                             `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                            `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                            `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage                  `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                            `json:"url,omitempty" bson:"url,omitempty"`                                         // The cannonical URL for a given MessageDefinition
-	Identifier             []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business Identifier for a given MessageDefinition
-	Version                *string                            `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the message definition
-	VersionAlgorithmString *string                            `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                            `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                            `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this message definition (computer friendly)
-	Title                  *string                            `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this message definition (human friendly)
-	Replaces               []string                           `json:"replaces,omitempty" bson:"replaces,omitempty"`                               // Takes the place of
-	Status                 string                             `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                              `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   string                             `json:"date" bson:"date"`                                                           // Date last changed
-	Publisher              *string                            `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                    `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                            `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the message definition
-	UseContext             []UsageContext                     `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept                  `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the message definition (if applicable)
-	Purpose                *string                            `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this message definition is defined
-	Copyright              *string                            `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                            `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Base                   *string                            `json:"base,omitempty" bson:"base,omitempty"`                                       // Definition this one is based on
-	Parent                 []string                           `json:"parent,omitempty" bson:"parent,omitempty"`                                   // Protocol/workflow this is part of
-	EventCoding            *Coding                            `json:"eventCoding" bson:"event_coding"`                                            // Event code  or link to the EventDefinition
-	EventUri               *string                            `json:"eventUri" bson:"event_uri"`                                                  // Event code  or link to the EventDefinition
-	Category               *string                            `json:"category,omitempty" bson:"category,omitempty"`                               // consequence | currency | notification
-	Focus                  []MessageDefinitionFocus           `json:"focus,omitempty" bson:"focus,omitempty"`                                     // Resource(s) that are the subject of the event
-	ResponseRequired       *string                            `json:"responseRequired,omitempty" bson:"response_required,omitempty"`              // always | on-error | never | on-success
-	AllowedResponse        []MessageDefinitionAllowedResponse `json:"allowedResponse,omitempty" bson:"allowed_response,omitempty"`                // Responses to this message
+	ResourceType            string                             `json:"resourceType" bson:"resource_type"`                                      // Type of resource
+	Id                      *string                            `json:"id,omitempty" bson:"id,omitempty"`                                       // Logical id of this artifact
+	Meta                    *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                                   // Metadata about the resource
+	ImplicitRules           *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                           `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`       // Extensions for implicitRules
+	Language                *string                            `json:"language,omitempty" bson:"language,omitempty"`                           // Language of the resource content
+	LanguageElement         *Element                           `json:"_language,omitempty" bson:"language_element,omitempty"`                  // Extensions for language
+	Text                    *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                                   // Text summary of the resource, for human interpretation
+	Contained               []Resource                         `json:"contained,omitempty" bson:"contained,omitempty"`                         // Contained, inline Resources
+	Extension               []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored
+	Url                     *string                            `json:"url,omitempty" bson:"url,omitempty"`                                     // The cannonical URL for a given MessageDefinition
+	UrlElement              *Element                           `json:"_url,omitempty" bson:"url_element,omitempty"`                            // Extensions for url
+	Identifier              []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // Business Identifier for a given MessageDefinition
+	Version                 *string                            `json:"version,omitempty" bson:"version,omitempty"`                             // Business version of the message definition
+	VersionElement          *Element                           `json:"_version,omitempty" bson:"version_element,omitempty"`                    // Extensions for version
+	VersionAlgorithm        MessageDefinitionVersionAlgorithm  `json:"-" bson:"version_algorithm,omitempty"`                                   // How to compare versions
+	VersionAlgorithmElement *Element                           `json:"-" bson:"version_algorithm_element,omitempty"`                           // Extensions for versionAlgorithm[x]
+	Name                    *string                            `json:"name,omitempty" bson:"name,omitempty"`                                   // Name for this message definition (computer friendly)
+	NameElement             *Element                           `json:"_name,omitempty" bson:"name_element,omitempty"`                          // Extensions for name
+	Title                   *string                            `json:"title,omitempty" bson:"title,omitempty"`                                 // Name for this message definition (human friendly)
+	TitleElement            *Element                           `json:"_title,omitempty" bson:"title_element,omitempty"`                        // Extensions for title
+	Replaces                []string                           `json:"replaces,omitempty" bson:"replaces,omitempty"`                           // Takes the place of
+	ReplacesElement         []*Element                         `json:"_replaces,omitempty" bson:"replaces_element,omitempty"`                  // Extensions for replaces
+	Status                  PublicationStatus                  `json:"status" bson:"status"`                                                   // draft | active | retired | unknown
+	StatusElement           *Element                           `json:"_status,omitempty" bson:"status_element,omitempty"`                      // Extensions for status
+	Experimental            *bool                              `json:"experimental,omitempty" bson:"experimental,omitempty"`                   // For testing only - never for real usage
+	ExperimentalElement     *Element                           `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`          // Extensions for experimental
+	Date                    *DateTime                          `json:"date" bson:"date"`                                                       // Date last changed
+	DateElement             *Element                           `json:"_date,omitempty" bson:"date_element,omitempty"`                          // Extensions for date
+	Publisher               *string                            `json:"publisher,omitempty" bson:"publisher,omitempty"`                         // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                           `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                // Extensions for publisher
+	Contact                 []ContactDetail                    `json:"contact,omitempty" bson:"contact,omitempty"`                             // Contact details for the publisher
+	Description             *string                            `json:"description,omitempty" bson:"description,omitempty"`                     // Natural language description of the message definition
+	DescriptionElement      *Element                           `json:"_description,omitempty" bson:"description_element,omitempty"`            // Extensions for description
+	UseContext              []UsageContext                     `json:"useContext,omitempty" bson:"use_context,omitempty"`                      // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                  `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                   // Jurisdiction of the authority that maintains the message definition (if applicable)
+	Purpose                 *string                            `json:"purpose,omitempty" bson:"purpose,omitempty"`                             // Why this message definition is defined
+	PurposeElement          *Element                           `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                    // Extensions for purpose
+	Copyright               *string                            `json:"copyright,omitempty" bson:"copyright,omitempty"`                         // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                           `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                // Extensions for copyright
+	CopyrightLabel          *string                            `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`              // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                           `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`     // Extensions for copyrightLabel
+	Base                    *string                            `json:"base,omitempty" bson:"base,omitempty"`                                   // Definition this one is based on
+	BaseElement             *Element                           `json:"_base,omitempty" bson:"base_element,omitempty"`                          // Extensions for base
+	Parent                  []string                           `json:"parent,omitempty" bson:"parent,omitempty"`                               // Protocol/workflow this is part of
+	ParentElement           []*Element                         `json:"_parent,omitempty" bson:"parent_element,omitempty"`                      // Extensions for parent
+	Event                   MessageDefinitionEvent             `json:"-" bson:"event,omitempty"`                                               // Event code  or link to the EventDefinition
+	EventElement            *Element                           `json:"-" bson:"event_element,omitempty"`                                       // Extensions for event[x]
+	Category                *MessageSignificanceCategory       `json:"category,omitempty" bson:"category,omitempty"`                           // consequence | currency | notification
+	CategoryElement         *Element                           `json:"_category,omitempty" bson:"category_element,omitempty"`                  // Extensions for category
+	Focus                   []MessageDefinitionFocus           `json:"focus,omitempty" bson:"focus,omitempty"`                                 // Resource(s) that are the subject of the event
+	ResponseRequired        *MessageheaderResponseRequest      `json:"responseRequired,omitempty" bson:"response_required,omitempty"`          // always | on-error | never | on-success
+	ResponseRequiredElement *Element                           `json:"_responseRequired,omitempty" bson:"response_required_element,omitempty"` // Extensions for responseRequired
+	AllowedResponse         []MessageDefinitionAllowedResponse `json:"allowedResponse,omitempty" bson:"allowed_response,omitempty"`            // Responses to this message
+
+	versionAlgorithmVariants []string // JSON properties of MessageDefinition.versionAlgorithm[x] when more than one was decoded
+	eventVariants            []string // JSON properties of MessageDefinition.event[x] when more than one was decoded
 }
 
 func (r *MessageDefinition) Validate() error {
@@ -52,33 +77,129 @@ func (r *MessageDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	for i, item := range r.ReplacesElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReplacesElement[%d]: %w", i, err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Date == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date == nil {
 		return fmt.Errorf("field 'Date' is required")
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -89,59 +210,767 @@ func (r *MessageDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
-	if r.EventCoding == nil {
-		return fmt.Errorf("field 'EventCoding' is required")
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.BaseElement != nil {
+		if err := r.BaseElement.Validate(); err != nil {
+			return fmt.Errorf("BaseElement: %w", err)
+		}
+	}
+	for i, item := range r.ParentElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ParentElement[%d]: %w", i, err)
+		}
 	}
-	if r.EventCoding != nil {
-		if err := r.EventCoding.Validate(); err != nil {
-			return fmt.Errorf("EventCoding: %w", err)
+	if len(r.eventVariants) > 1 {
+		return fmt.Errorf("field 'Event' must have a single type, got %v", r.eventVariants)
+	}
+	if r.Event == nil {
+		return fmt.Errorf("field 'Event' is required")
+	}
+	if r.Event != nil {
+		if err := r.Event.Validate(); err != nil {
+			return fmt.Errorf("Event: %w", err)
 		}
 	}
-	if r.EventUri == nil {
-		return fmt.Errorf("field 'EventUri' is required")
+	if r.EventElement != nil {
+		if err := r.EventElement.Validate(); err != nil {
+			return fmt.Errorf("EventElement: %w", err)
+		}
+	}
+	if r.Category != nil && !r.Category.IsValid() {
+		return fmt.Errorf("field 'Category' has invalid code '%s'", *r.Category)
+	}
+	if r.CategoryElement != nil {
+		if err := r.CategoryElement.Validate(); err != nil {
+			return fmt.Errorf("CategoryElement: %w", err)
+		}
 	}
 	for i, item := range r.Focus {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Focus[%d]: %w", i, err)
 		}
 	}
+	if r.ResponseRequired != nil && !r.ResponseRequired.IsValid() {
+		return fmt.Errorf("field 'ResponseRequired' has invalid code '%s'", *r.ResponseRequired)
+	}
+	if r.ResponseRequiredElement != nil {
+		if err := r.ResponseRequiredElement.Validate(); err != nil {
+			return fmt.Errorf("ResponseRequiredElement: %w", err)
+		}
+	}
 	for i, item := range r.AllowedResponse {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("AllowedResponse[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "MessageDefinition")
+}
+
+func (r *MessageDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MessageDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MessageDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	for i, item := range r.ReplacesElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.BaseElement != nil {
+		r.BaseElement.validateAll(path+".base", issues)
+	}
+	for i, item := range r.ParentElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.parent[%d]", path, i), issues)
+	}
+	if len(r.eventVariants) > 1 {
+		issues.add("structure", path+".event", fmt.Sprintf("field 'Event' must have a single type, got %v", r.eventVariants))
+	}
+	if r.Event == nil {
+		issues.add("required", path+".event", "field 'Event' is required")
+	}
+	if r.Event != nil {
+		r.Event.validateAll(path+".event.ofType("+r.Event.FHIRType()+")", issues)
+	}
+	if r.EventElement != nil {
+		r.EventElement.validateAll(path+".event", issues)
+	}
+	if r.Category != nil && !r.Category.IsValid() {
+		issues.add("code-invalid", path+".category", fmt.Sprintf("field 'Category' has invalid code '%s'", *r.Category))
+	}
+	if r.CategoryElement != nil {
+		r.CategoryElement.validateAll(path+".category", issues)
+	}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
+	}
+	if r.ResponseRequired != nil && !r.ResponseRequired.IsValid() {
+		issues.add("code-invalid", path+".responseRequired", fmt.Sprintf("field 'ResponseRequired' has invalid code '%s'", *r.ResponseRequired))
+	}
+	if r.ResponseRequiredElement != nil {
+		r.ResponseRequiredElement.validateAll(path+".responseRequired", issues)
+	}
+	for i, item := range r.AllowedResponse {
+		item.validateAll(fmt.Sprintf("%s.allowedResponse[%d]", path, i), issues)
+	}
+}
+
+func (r *MessageDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MessageDefinition", &issues)
+	checkInvariants(r, "MessageDefinition", &issues)
+	return issues
+}
+
+var messageDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MessageDefinition) invariants() []invariant {
+	return messageDefinitionInvariants
+}
+
+func (r MessageDefinition) MarshalJSON() ([]byte, error) {
+	type alias MessageDefinition
+	out := struct {
+		alias
+		Replaces        []*string  `json:"replaces,omitempty"`
+		ReplacesElement []*Element `json:"_replaces,omitempty"`
+		Parent          []*string  `json:"parent,omitempty"`
+		ParentElement   []*Element `json:"_parent,omitempty"`
+	}{alias: alias(r)}
+	out.Replaces, out.ReplacesElement = alignPrimitiveArray(r.Replaces, r.ReplacesElement)
+	out.Parent, out.ParentElement = alignPrimitiveArray(r.Parent, r.ParentElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "event", r.Event, r.EventElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MessageDefinition) UnmarshalJSON(data []byte) error {
+	type alias MessageDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Replaces  []*string         `json:"replaces,omitempty"`
+		Parent    []*string         `json:"parent,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Replaces, err = splitPrimitiveArray(aux.Replaces, r.ReplacesElement); err != nil {
+		return fmt.Errorf("replaces: %w", err)
+	}
+	if r.Parent, err = splitPrimitiveArray(aux.Parent, r.ParentElement); err != nil {
+		return fmt.Errorf("parent: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", messageDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.Event, element, r.eventVariants, err = unmarshalChoice(fields, "event", messageDefinitionEventVariants); err != nil {
+		return err
+	}
+	r.EventElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EventElement); err != nil {
+			return fmt.Errorf("_event: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MessageDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MessageDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(messageDefinitionVersionAlgorithmVariants)
+	case "Event":
+		return "event", choiceValues(messageDefinitionEventVariants)
+	}
+	return "", nil
+}
+
+var messageDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"replaces":      "canonical",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"base":          "canonical",
+	"parent":        "canonical",
+}
+
+func (r *MessageDefinition) elementTypes() map[string]string {
+	return messageDefinitionElementTypes
+}
+
+var messageDefinitionElementMetadata = []ElementMetadata{
+	{Path: "MessageDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MessageDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MessageDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MessageDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MessageDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MessageDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MessageDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MessageDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "MessageDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MessageDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "MessageDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.replaces", Name: "replaces", Field: 22, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "MessageDefinition.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MessageDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "MessageDefinition.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "MessageDefinition.date", Name: "date", Field: 28, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MessageDefinition.publisher", Name: "publisher", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.contact", Name: "contact", Field: 32, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "MessageDefinition.description", Name: "description", Field: 33, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MessageDefinition.useContext", Name: "useContext", Field: 35, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "MessageDefinition.jurisdiction", Name: "jurisdiction", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MessageDefinition.purpose", Name: "purpose", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MessageDefinition.copyright", Name: "copyright", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MessageDefinition.copyrightLabel", Name: "copyrightLabel", Field: 41, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.base", Name: "base", Field: 43, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "MessageDefinition.parent", Name: "parent", Field: 45, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "MessageDefinition.event[x]", Name: "event", Field: 47, Min: 1, Max: "1", Types: []string{"Coding", "uri"}},
+	{Path: "MessageDefinition.category", Name: "category", Field: 49, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MessageDefinition.category", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/message-significance-category"}},
+	{Path: "MessageDefinition.focus", Name: "focus", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MessageDefinition.responseRequired", Name: "responseRequired", Field: 52, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MessageDefinition.responseRequired", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/messageheader-response-request"}},
+	{Path: "MessageDefinition.allowedResponse", Name: "allowedResponse", Field: 54, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *MessageDefinition) ElementMetadata() []ElementMetadata {
+	return messageDefinitionElementMetadata
+}
+
+// MessageDefinitionVersionAlgorithm is the type of MessageDefinition.versionAlgorithm[x]. It is implemented by the MessageDefinitionVersionAlgorithm* variant types.
+type MessageDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMessageDefinitionVersionAlgorithm()
+}
+
+// MessageDefinitionVersionAlgorithmString is the string variant of MessageDefinitionVersionAlgorithm.
+type MessageDefinitionVersionAlgorithmString string
+
+func (v MessageDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
 
+func (v MessageDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MessageDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v MessageDefinitionVersionAlgorithmString) isMessageDefinitionVersionAlgorithm() {}
+
+// MessageDefinitionVersionAlgorithmCoding is the Coding variant of MessageDefinitionVersionAlgorithm.
+type MessageDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v MessageDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v MessageDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v MessageDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v MessageDefinitionVersionAlgorithmCoding) isMessageDefinitionVersionAlgorithm() {}
+
+var messageDefinitionVersionAlgorithmVariants = []MessageDefinitionVersionAlgorithm{
+	MessageDefinitionVersionAlgorithmString(""),
+	MessageDefinitionVersionAlgorithmCoding{},
+}
+
+// MessageDefinitionEvent is the type of MessageDefinition.event[x]. It is implemented by the MessageDefinitionEvent* variant types.
+type MessageDefinitionEvent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMessageDefinitionEvent()
+}
+
+// MessageDefinitionEventCoding is the Coding variant of MessageDefinitionEvent.
+type MessageDefinitionEventCoding struct {
+	Coding
+}
+
+func (v MessageDefinitionEventCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v MessageDefinitionEventCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v MessageDefinitionEventCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v MessageDefinitionEventCoding) isMessageDefinitionEvent() {}
+
+// MessageDefinitionEventUri is the uri variant of MessageDefinitionEvent.
+type MessageDefinitionEventUri string
+
+func (v MessageDefinitionEventUri) Validate() error {
+	return nil
+}
+
+func (v MessageDefinitionEventUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MessageDefinitionEventUri) FHIRType() string {
+	return "uri"
+}
+
+func (v MessageDefinitionEventUri) isMessageDefinitionEvent() {}
+
+var messageDefinitionEventVariants = []MessageDefinitionEvent{
+	MessageDefinitionEventCoding{},
+	MessageDefinitionEventUri(""),
+}
+
+func (r *MessageDefinition) GetResourceType() string {
+	return "MessageDefinition"
+}
+
+func (r *MessageDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MessageDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MessageDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MessageDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MessageDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MessageDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MessageDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MessageDefinition)(nil)
+
 type MessageDefinitionFocus struct {
-	Id      *string `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Code    string  `json:"code" bson:"code"`                           // Type of resource
-	Profile *string `json:"profile,omitempty" bson:"profile,omitempty"` // Profile that must be adhered to by focus
-	Min     int     `json:"min" bson:"min"`                             // Minimum number of focuses of this type
-	Max     *string `json:"max,omitempty" bson:"max,omitempty"`         // Maximum number of focuses of this type
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string      `json:"code" bson:"code"`                                                // Type of resource
+	CodeElement       *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Profile           *string     `json:"profile,omitempty" bson:"profile,omitempty"`                      // Profile that must be adhered to by focus
+	ProfileElement    *Element    `json:"_profile,omitempty" bson:"profile_element,omitempty"`             // Extensions for profile
+	Min               int         `json:"min" bson:"min"`                                                  // Minimum number of focuses of this type
+	MinElement        *Element    `json:"_min,omitempty" bson:"min_element,omitempty"`                     // Extensions for min
+	Max               *string     `json:"max,omitempty" bson:"max,omitempty"`                              // Maximum number of focuses of this type
+	MaxElement        *Element    `json:"_max,omitempty" bson:"max_element,omitempty"`                     // Extensions for max
 }
 
 func (r *MessageDefinitionFocus) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Code == emptyString {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.ProfileElement != nil {
+		if err := r.ProfileElement.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement: %w", err)
+		}
+	}
 	if r.Min == 0 {
 		return fmt.Errorf("field 'Min' is required")
 	}
+	if r.MinElement != nil {
+		if err := r.MinElement.Validate(); err != nil {
+			return fmt.Errorf("MinElement: %w", err)
+		}
+	}
+	if r.MaxElement != nil {
+		if err := r.MaxElement.Validate(); err != nil {
+			return fmt.Errorf("MaxElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *MessageDefinitionFocus) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.ProfileElement != nil {
+		r.ProfileElement.validateAll(path+".profile", issues)
+	}
+	if r.Min == 0 {
+		issues.add("required", path+".min", "field 'Min' is required")
+	}
+	if r.MinElement != nil {
+		r.MinElement.validateAll(path+".min", issues)
+	}
+	if r.MaxElement != nil {
+		r.MaxElement.validateAll(path+".max", issues)
+	}
+}
+
+var messageDefinitionFocusInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MessageDefinitionFocus) invariants() []invariant {
+	return messageDefinitionFocusInvariants
+}
+
+func (r MessageDefinitionFocus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageDefinitionFocus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageDefinitionFocus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageDefinitionFocus) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var messageDefinitionFocusElementTypes = map[string]string{
+	"code":    "code",
+	"profile": "canonical",
+	"min":     "unsignedInt",
+}
+
+func (r *MessageDefinitionFocus) elementTypes() map[string]string {
+	return messageDefinitionFocusElementTypes
+}
+
+var messageDefinitionFocusElementMetadata = []ElementMetadata{
+	{Path: "MessageDefinition.focus.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.focus.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageDefinition.focus.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageDefinition.focus.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "MessageDefinition.focus.profile", Name: "profile", Field: 5, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "MessageDefinition.focus.min", Name: "min", Field: 7, Min: 1, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "MessageDefinition.focus.max", Name: "max", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *MessageDefinitionFocus) ElementMetadata() []ElementMetadata {
+	return messageDefinitionFocusElementMetadata
+}
+
 type MessageDefinitionAllowedResponse struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Message   string  `json:"message" bson:"message"`                         // Reference to allowed message definition response
-	Situation *string `json:"situation,omitempty" bson:"situation,omitempty"` // When should this response be used
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Message           string      `json:"message" bson:"message"`                                          // Reference to allowed message definition response
+	MessageElement    *Element    `json:"_message,omitempty" bson:"message_element,omitempty"`             // Extensions for message
+	Situation         *string     `json:"situation,omitempty" bson:"situation,omitempty"`                  // When should this response be used
+	SituationElement  *Element    `json:"_situation,omitempty" bson:"situation_element,omitempty"`         // Extensions for situation
 }
 
 func (r *MessageDefinitionAllowedResponse) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Message == emptyString {
 		return fmt.Errorf("field 'Message' is required")
 	}
+	if r.MessageElement != nil {
+		if err := r.MessageElement.Validate(); err != nil {
+			return fmt.Errorf("MessageElement: %w", err)
+		}
+	}
+	if r.SituationElement != nil {
+		if err := r.SituationElement.Validate(); err != nil {
+			return fmt.Errorf("SituationElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *MessageDefinitionAllowedResponse) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Message == emptyString {
+		issues.add("required", path+".message", "field 'Message' is required")
+	}
+	if r.MessageElement != nil {
+		r.MessageElement.validateAll(path+".message", issues)
+	}
+	if r.SituationElement != nil {
+		r.SituationElement.validateAll(path+".situation", issues)
+	}
+}
+
+var messageDefinitionAllowedResponseInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MessageDefinitionAllowedResponse) invariants() []invariant {
+	return messageDefinitionAllowedResponseInvariants
+}
+
+func (r MessageDefinitionAllowedResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageDefinitionAllowedResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageDefinitionAllowedResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageDefinitionAllowedResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var messageDefinitionAllowedResponseElementTypes = map[string]string{
+	"message":   "canonical",
+	"situation": "markdown",
+}
+
+func (r *MessageDefinitionAllowedResponse) elementTypes() map[string]string {
+	return messageDefinitionAllowedResponseElementTypes
+}
+
+var messageDefinitionAllowedResponseElementMetadata = []ElementMetadata{
+	{Path: "MessageDefinition.allowedResponse.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageDefinition.allowedResponse.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageDefinition.allowedResponse.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageDefinition.allowedResponse.message", Name: "message", Field: 3, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "MessageDefinition.allowedResponse.situation", Name: "situation", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *MessageDefinitionAllowedResponse) ElementMetadata() []ElementMetadata {
+	return messageDefinitionAllowedResponseElementMetadata
+}
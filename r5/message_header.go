@@ -2,27 +2,34 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The header for a message exchange that is either requesting or responding to an action.  The reference(s) that are the subject of the action as well as other information related to the action are typically transmitted in a bundle in which the MessageHeader resource instance is the first resource in the bundle.
 type MessageHeader struct {
-	ResourceType   string                     `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id             *string                    `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta           *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules  *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language       *string                    `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text           *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained      []json.RawMessage          `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	EventCoding    *Coding                    `json:"eventCoding" bson:"event_coding"`                         // The real world event that triggered this messsage
-	EventUri       *string                    `json:"eventUri" bson:"event_uri"`                               // The real world event that triggered this messsage
-	EventCanonical *string                    `json:"eventCanonical" bson:"event_canonical"`                   // The real world event that triggered this messsage
-	Destination    []MessageHeaderDestination `json:"destination,omitempty" bson:"destination,omitempty"`      // Message destination application(s)
-	Source         *MessageHeaderSource       `json:"source" bson:"source"`                                    // Message source application
-	Reason         *CodeableConcept           `json:"reason,omitempty" bson:"reason,omitempty"`                // Cause of event
-	Response       *MessageHeaderResponse     `json:"response,omitempty" bson:"response,omitempty"`            // If this is a reply to prior message
-	Focus          []Reference                `json:"focus,omitempty" bson:"focus,omitempty"`                  // The actual content of the message
-	Definition     *string                    `json:"definition,omitempty" bson:"definition,omitempty"`        // Link to the definition for this message
+	ResourceType         string                     `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                    `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                    `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                   `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                 `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Event                MessageHeaderEvent         `json:"-" bson:"event,omitempty"`                                         // The real world event that triggered this messsage
+	EventElement         *Element                   `json:"-" bson:"event_element,omitempty"`                                 // Extensions for event[x]
+	Destination          []MessageHeaderDestination `json:"destination,omitempty" bson:"destination,omitempty"`               // Message destination application(s)
+	Source               *MessageHeaderSource       `json:"source" bson:"source"`                                             // Message source application
+	Reason               *CodeableConcept           `json:"reason,omitempty" bson:"reason,omitempty"`                         // Cause of event
+	Response             *MessageHeaderResponse     `json:"response,omitempty" bson:"response,omitempty"`                     // If this is a reply to prior message
+	Focus                []Reference                `json:"focus,omitempty" bson:"focus,omitempty"`                           // The actual content of the message
+	Definition           *string                    `json:"definition,omitempty" bson:"definition,omitempty"`                 // Link to the definition for this message
+	DefinitionElement    *Element                   `json:"_definition,omitempty" bson:"definition_element,omitempty"`        // Extensions for definition
+
+	eventVariants []string // JSON properties of MessageHeader.event[x] when more than one was decoded
 }
 
 func (r *MessageHeader) Validate() error {
@@ -34,24 +41,54 @@ func (r *MessageHeader) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
-	if r.EventCoding == nil {
-		return fmt.Errorf("field 'EventCoding' is required")
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.EventCoding != nil {
-		if err := r.EventCoding.Validate(); err != nil {
-			return fmt.Errorf("EventCoding: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.EventUri == nil {
-		return fmt.Errorf("field 'EventUri' is required")
+	if len(r.eventVariants) > 1 {
+		return fmt.Errorf("field 'Event' must have a single type, got %v", r.eventVariants)
+	}
+	if r.Event == nil {
+		return fmt.Errorf("field 'Event' is required")
+	}
+	if r.Event != nil {
+		if err := r.Event.Validate(); err != nil {
+			return fmt.Errorf("Event: %w", err)
+		}
 	}
-	if r.EventCanonical == nil {
-		return fmt.Errorf("field 'EventCanonical' is required")
+	if r.EventElement != nil {
+		if err := r.EventElement.Validate(); err != nil {
+			return fmt.Errorf("EventElement: %w", err)
+		}
 	}
 	for i, item := range r.Destination {
 		if err := item.Validate(); err != nil {
@@ -81,21 +118,354 @@ func (r *MessageHeader) Validate() error {
 			return fmt.Errorf("Focus[%d]: %w", i, err)
 		}
 	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "MessageHeader")
+}
+
+func (r *MessageHeader) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MessageHeader" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MessageHeader', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.eventVariants) > 1 {
+		issues.add("structure", path+".event", fmt.Sprintf("field 'Event' must have a single type, got %v", r.eventVariants))
+	}
+	if r.Event == nil {
+		issues.add("required", path+".event", "field 'Event' is required")
+	}
+	if r.Event != nil {
+		r.Event.validateAll(path+".event.ofType("+r.Event.FHIRType()+")", issues)
+	}
+	if r.EventElement != nil {
+		r.EventElement.validateAll(path+".event", issues)
+	}
+	for i, item := range r.Destination {
+		item.validateAll(fmt.Sprintf("%s.destination[%d]", path, i), issues)
+	}
+	if r.Source == nil {
+		issues.add("required", path+".source", "field 'Source' is required")
+	}
+	if r.Source != nil {
+		r.Source.validateAll(path+".source", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+	if r.Response != nil {
+		r.Response.validateAll(path+".response", issues)
+	}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+}
+
+func (r *MessageHeader) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MessageHeader", &issues)
+	checkInvariants(r, "MessageHeader", &issues)
+	return issues
+}
+
+var messageHeaderInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *MessageHeader) invariants() []invariant {
+	return messageHeaderInvariants
+}
+
+func (r MessageHeader) MarshalJSON() ([]byte, error) {
+	type alias MessageHeader
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "event", r.Event, r.EventElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MessageHeader) UnmarshalJSON(data []byte) error {
+	type alias MessageHeader
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Event, element, r.eventVariants, err = unmarshalChoice(fields, "event", messageHeaderEventVariants); err != nil {
+		return err
+	}
+	r.EventElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EventElement); err != nil {
+			return fmt.Errorf("_event: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MessageHeader) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageHeader) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageHeader) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageHeader) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MessageHeader) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Event":
+		return "event", choiceValues(messageHeaderEventVariants)
+	}
+	return "", nil
+}
+
+var messageHeaderElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"definition":    "canonical",
+}
+
+func (r *MessageHeader) elementTypes() map[string]string {
+	return messageHeaderElementTypes
+}
+
+var messageHeaderElementMetadata = []ElementMetadata{
+	{Path: "MessageHeader.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MessageHeader.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MessageHeader.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MessageHeader.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MessageHeader.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MessageHeader.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MessageHeader.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MessageHeader.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageHeader.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageHeader.event[x]", Name: "event", Field: 11, Min: 1, Max: "1", Types: []string{"Coding", "uri", "canonical"}},
+	{Path: "MessageHeader.destination", Name: "destination", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "MessageHeader.source", Name: "source", Field: 14, Min: 1, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MessageHeader.reason", Name: "reason", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "MessageHeader.response", Name: "response", Field: 16, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "MessageHeader.focus", Name: "focus", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "MessageHeader.definition", Name: "definition", Field: 18, Min: 0, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *MessageHeader) ElementMetadata() []ElementMetadata {
+	return messageHeaderElementMetadata
+}
+
+// MessageHeaderEvent is the type of MessageHeader.event[x]. It is implemented by the MessageHeaderEvent* variant types.
+type MessageHeaderEvent interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMessageHeaderEvent()
+}
+
+// MessageHeaderEventCoding is the Coding variant of MessageHeaderEvent.
+type MessageHeaderEventCoding struct {
+	Coding
+}
+
+func (v MessageHeaderEventCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v MessageHeaderEventCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v MessageHeaderEventCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v MessageHeaderEventCoding) isMessageHeaderEvent() {}
+
+// MessageHeaderEventUri is the uri variant of MessageHeaderEvent.
+type MessageHeaderEventUri string
+
+func (v MessageHeaderEventUri) Validate() error {
 	return nil
 }
 
+func (v MessageHeaderEventUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MessageHeaderEventUri) FHIRType() string {
+	return "uri"
+}
+
+func (v MessageHeaderEventUri) isMessageHeaderEvent() {}
+
+// MessageHeaderEventCanonical is the canonical variant of MessageHeaderEvent.
+type MessageHeaderEventCanonical string
+
+func (v MessageHeaderEventCanonical) Validate() error {
+	return nil
+}
+
+func (v MessageHeaderEventCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MessageHeaderEventCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v MessageHeaderEventCanonical) isMessageHeaderEvent() {}
+
+var messageHeaderEventVariants = []MessageHeaderEvent{
+	MessageHeaderEventCoding{},
+	MessageHeaderEventUri(""),
+	MessageHeaderEventCanonical(""),
+}
+
+func (r *MessageHeader) GetResourceType() string {
+	return "MessageHeader"
+}
+
+func (r *MessageHeader) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *MessageHeader) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *MessageHeader) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *MessageHeader) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *MessageHeader) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *MessageHeader) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *MessageHeader) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*MessageHeader)(nil)
+
 type MessageHeaderDestination struct {
-	Id                *string    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	EndpointUrl       *string    `json:"endpointUrl,omitempty" bson:"endpoint_url,omitempty"`             // Actual destination address or Endpoint resource
-	EndpointReference *Reference `json:"endpointReference,omitempty" bson:"endpoint_reference,omitempty"` // Actual destination address or Endpoint resource
-	Name              *string    `json:"name,omitempty" bson:"name,omitempty"`                            // Name of system
-	Receiver          *Reference `json:"receiver,omitempty" bson:"receiver,omitempty"`                    // Intended "real-world" recipient for the data
+	Id                *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Endpoint          MessageHeaderDestinationEndpoint `json:"-" bson:"endpoint,omitempty"`                                     // Actual destination address or Endpoint resource
+	EndpointElement   *Element                         `json:"-" bson:"endpoint_element,omitempty"`                             // Extensions for endpoint[x]
+	Name              *string                          `json:"name,omitempty" bson:"name,omitempty"`                            // Name of system
+	NameElement       *Element                         `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Receiver          *Reference                       `json:"receiver,omitempty" bson:"receiver,omitempty"`                    // Intended "real-world" recipient for the data
+
+	endpointVariants []string // JSON properties of MessageHeader.destination.endpoint[x] when more than one was decoded
 }
 
+var messageHeaderDestinationReceiverTargets = []string{"Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *MessageHeaderDestination) Validate() error {
-	if r.EndpointReference != nil {
-		if err := r.EndpointReference.Validate(); err != nil {
-			return fmt.Errorf("EndpointReference: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.endpointVariants) > 1 {
+		return fmt.Errorf("field 'Endpoint' must have a single type, got %v", r.endpointVariants)
+	}
+	if r.Endpoint != nil {
+		if err := r.Endpoint.Validate(); err != nil {
+			return fmt.Errorf("Endpoint: %w", err)
+		}
+	}
+	if r.EndpointElement != nil {
+		if err := r.EndpointElement.Validate(); err != nil {
+			return fmt.Errorf("EndpointElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Receiver != nil {
+		if target := invalidReferenceTarget(r.Receiver.Reference, r.Receiver.Type, messageHeaderDestinationReceiverTargets); target != "" {
+			return fmt.Errorf("field 'Receiver' cannot refer to %s, expected one of %v", target, messageHeaderDestinationReceiverTargets)
 		}
 	}
 	if r.Receiver != nil {
@@ -106,21 +476,225 @@ func (r *MessageHeaderDestination) Validate() error {
 	return nil
 }
 
+func (r *MessageHeaderDestination) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.endpointVariants) > 1 {
+		issues.add("structure", path+".endpoint", fmt.Sprintf("field 'Endpoint' must have a single type, got %v", r.endpointVariants))
+	}
+	if r.Endpoint != nil {
+		r.Endpoint.validateAll(path+".endpoint.ofType("+r.Endpoint.FHIRType()+")", issues)
+	}
+	if r.EndpointElement != nil {
+		r.EndpointElement.validateAll(path+".endpoint", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Receiver != nil {
+		if target := invalidReferenceTarget(r.Receiver.Reference, r.Receiver.Type, messageHeaderDestinationReceiverTargets); target != "" {
+			issues.add("value", path+".receiver", fmt.Sprintf("field 'Receiver' cannot refer to %s, expected one of %v", target, messageHeaderDestinationReceiverTargets))
+		}
+	}
+	if r.Receiver != nil {
+		r.Receiver.validateAll(path+".receiver", issues)
+	}
+}
+
+var messageHeaderDestinationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MessageHeaderDestination) invariants() []invariant {
+	return messageHeaderDestinationInvariants
+}
+
+func (r MessageHeaderDestination) MarshalJSON() ([]byte, error) {
+	type alias MessageHeaderDestination
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "endpoint", r.Endpoint, r.EndpointElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MessageHeaderDestination) UnmarshalJSON(data []byte) error {
+	type alias MessageHeaderDestination
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Endpoint, element, r.endpointVariants, err = unmarshalChoice(fields, "endpoint", messageHeaderDestinationEndpointVariants); err != nil {
+		return err
+	}
+	r.EndpointElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EndpointElement); err != nil {
+			return fmt.Errorf("_endpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MessageHeaderDestination) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageHeaderDestination) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageHeaderDestination) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageHeaderDestination) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MessageHeaderDestination) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Endpoint":
+		return "endpoint", choiceValues(messageHeaderDestinationEndpointVariants)
+	}
+	return "", nil
+}
+
+var messageHeaderDestinationElementMetadata = []ElementMetadata{
+	{Path: "MessageHeader.destination.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.destination.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageHeader.destination.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageHeader.destination.endpoint[x]", Name: "endpoint", Field: 3, Min: 0, Max: "1", Types: []string{"url", "Reference"}},
+	{Path: "MessageHeader.destination.name", Name: "name", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.destination.receiver", Name: "receiver", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *MessageHeaderDestination) ElementMetadata() []ElementMetadata {
+	return messageHeaderDestinationElementMetadata
+}
+
+// MessageHeaderDestinationEndpoint is the type of MessageHeader.destination.endpoint[x]. It is implemented by the MessageHeaderDestinationEndpoint* variant types.
+type MessageHeaderDestinationEndpoint interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMessageHeaderDestinationEndpoint()
+}
+
+// MessageHeaderDestinationEndpointUrl is the url variant of MessageHeaderDestinationEndpoint.
+type MessageHeaderDestinationEndpointUrl string
+
+func (v MessageHeaderDestinationEndpointUrl) Validate() error {
+	return nil
+}
+
+func (v MessageHeaderDestinationEndpointUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MessageHeaderDestinationEndpointUrl) FHIRType() string {
+	return "url"
+}
+
+func (v MessageHeaderDestinationEndpointUrl) isMessageHeaderDestinationEndpoint() {}
+
+// MessageHeaderDestinationEndpointReference is the Reference variant of MessageHeaderDestinationEndpoint.
+type MessageHeaderDestinationEndpointReference struct {
+	Reference
+}
+
+func (v MessageHeaderDestinationEndpointReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v MessageHeaderDestinationEndpointReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v MessageHeaderDestinationEndpointReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v MessageHeaderDestinationEndpointReference) isMessageHeaderDestinationEndpoint() {}
+
+var messageHeaderDestinationEndpointVariants = []MessageHeaderDestinationEndpoint{
+	MessageHeaderDestinationEndpointUrl(""),
+	MessageHeaderDestinationEndpointReference{},
+}
+
 type MessageHeaderSource struct {
-	Id                *string       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	EndpointUrl       *string       `json:"endpointUrl,omitempty" bson:"endpoint_url,omitempty"`             // Actual source address or Endpoint resource
-	EndpointReference *Reference    `json:"endpointReference,omitempty" bson:"endpoint_reference,omitempty"` // Actual source address or Endpoint resource
-	Name              *string       `json:"name,omitempty" bson:"name,omitempty"`                            // Name of system
-	Software          *string       `json:"software,omitempty" bson:"software,omitempty"`                    // Name of software running the system
-	Version           *string       `json:"version,omitempty" bson:"version,omitempty"`                      // Version of software running
-	Contact           *ContactPoint `json:"contact,omitempty" bson:"contact,omitempty"`                      // Human contact for problems
-	Sender            *Reference    `json:"sender,omitempty" bson:"sender,omitempty"`                        // Real world sender of the message
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Endpoint          MessageHeaderSourceEndpoint `json:"-" bson:"endpoint,omitempty"`                                     // Actual source address or Endpoint resource
+	EndpointElement   *Element                    `json:"-" bson:"endpoint_element,omitempty"`                             // Extensions for endpoint[x]
+	Name              *string                     `json:"name,omitempty" bson:"name,omitempty"`                            // Name of system
+	NameElement       *Element                    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Software          *string                     `json:"software,omitempty" bson:"software,omitempty"`                    // Name of software running the system
+	SoftwareElement   *Element                    `json:"_software,omitempty" bson:"software_element,omitempty"`           // Extensions for software
+	Version           *string                     `json:"version,omitempty" bson:"version,omitempty"`                      // Version of software running
+	VersionElement    *Element                    `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
+	Contact           *ContactPoint               `json:"contact,omitempty" bson:"contact,omitempty"`                      // Human contact for problems
+	Sender            *Reference                  `json:"sender,omitempty" bson:"sender,omitempty"`                        // Real world sender of the message
+
+	endpointVariants []string // JSON properties of MessageHeader.source.endpoint[x] when more than one was decoded
 }
 
+var messageHeaderSourceSenderTargets = []string{"Device", "Organization", "Patient", "Practitioner", "PractitionerRole"}
+
 func (r *MessageHeaderSource) Validate() error {
-	if r.EndpointReference != nil {
-		if err := r.EndpointReference.Validate(); err != nil {
-			return fmt.Errorf("EndpointReference: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.endpointVariants) > 1 {
+		return fmt.Errorf("field 'Endpoint' must have a single type, got %v", r.endpointVariants)
+	}
+	if r.Endpoint != nil {
+		if err := r.Endpoint.Validate(); err != nil {
+			return fmt.Errorf("Endpoint: %w", err)
+		}
+	}
+	if r.EndpointElement != nil {
+		if err := r.EndpointElement.Validate(); err != nil {
+			return fmt.Errorf("EndpointElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.SoftwareElement != nil {
+		if err := r.SoftwareElement.Validate(); err != nil {
+			return fmt.Errorf("SoftwareElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
 	if r.Contact != nil {
@@ -128,6 +702,11 @@ func (r *MessageHeaderSource) Validate() error {
 			return fmt.Errorf("Contact: %w", err)
 		}
 	}
+	if r.Sender != nil {
+		if target := invalidReferenceTarget(r.Sender.Reference, r.Sender.Type, messageHeaderSourceSenderTargets); target != "" {
+			return fmt.Errorf("field 'Sender' cannot refer to %s, expected one of %v", target, messageHeaderSourceSenderTargets)
+		}
+	}
 	if r.Sender != nil {
 		if err := r.Sender.Validate(); err != nil {
 			return fmt.Errorf("Sender: %w", err)
@@ -136,14 +715,201 @@ func (r *MessageHeaderSource) Validate() error {
 	return nil
 }
 
+func (r *MessageHeaderSource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.endpointVariants) > 1 {
+		issues.add("structure", path+".endpoint", fmt.Sprintf("field 'Endpoint' must have a single type, got %v", r.endpointVariants))
+	}
+	if r.Endpoint != nil {
+		r.Endpoint.validateAll(path+".endpoint.ofType("+r.Endpoint.FHIRType()+")", issues)
+	}
+	if r.EndpointElement != nil {
+		r.EndpointElement.validateAll(path+".endpoint", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.SoftwareElement != nil {
+		r.SoftwareElement.validateAll(path+".software", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if r.Contact != nil {
+		r.Contact.validateAll(path+".contact", issues)
+	}
+	if r.Sender != nil {
+		if target := invalidReferenceTarget(r.Sender.Reference, r.Sender.Type, messageHeaderSourceSenderTargets); target != "" {
+			issues.add("value", path+".sender", fmt.Sprintf("field 'Sender' cannot refer to %s, expected one of %v", target, messageHeaderSourceSenderTargets))
+		}
+	}
+	if r.Sender != nil {
+		r.Sender.validateAll(path+".sender", issues)
+	}
+}
+
+var messageHeaderSourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MessageHeaderSource) invariants() []invariant {
+	return messageHeaderSourceInvariants
+}
+
+func (r MessageHeaderSource) MarshalJSON() ([]byte, error) {
+	type alias MessageHeaderSource
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "endpoint", r.Endpoint, r.EndpointElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MessageHeaderSource) UnmarshalJSON(data []byte) error {
+	type alias MessageHeaderSource
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Endpoint, element, r.endpointVariants, err = unmarshalChoice(fields, "endpoint", messageHeaderSourceEndpointVariants); err != nil {
+		return err
+	}
+	r.EndpointElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EndpointElement); err != nil {
+			return fmt.Errorf("_endpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MessageHeaderSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageHeaderSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageHeaderSource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageHeaderSource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MessageHeaderSource) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Endpoint":
+		return "endpoint", choiceValues(messageHeaderSourceEndpointVariants)
+	}
+	return "", nil
+}
+
+var messageHeaderSourceElementMetadata = []ElementMetadata{
+	{Path: "MessageHeader.source.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.source.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageHeader.source.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageHeader.source.endpoint[x]", Name: "endpoint", Field: 3, Min: 0, Max: "1", Types: []string{"url", "Reference"}},
+	{Path: "MessageHeader.source.name", Name: "name", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.source.software", Name: "software", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.source.version", Name: "version", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.source.contact", Name: "contact", Field: 11, Min: 0, Max: "1", Types: []string{"ContactPoint"}},
+	{Path: "MessageHeader.source.sender", Name: "sender", Field: 12, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+}
+
+func (r *MessageHeaderSource) ElementMetadata() []ElementMetadata {
+	return messageHeaderSourceElementMetadata
+}
+
+// MessageHeaderSourceEndpoint is the type of MessageHeader.source.endpoint[x]. It is implemented by the MessageHeaderSourceEndpoint* variant types.
+type MessageHeaderSourceEndpoint interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMessageHeaderSourceEndpoint()
+}
+
+// MessageHeaderSourceEndpointUrl is the url variant of MessageHeaderSourceEndpoint.
+type MessageHeaderSourceEndpointUrl string
+
+func (v MessageHeaderSourceEndpointUrl) Validate() error {
+	return nil
+}
+
+func (v MessageHeaderSourceEndpointUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MessageHeaderSourceEndpointUrl) FHIRType() string {
+	return "url"
+}
+
+func (v MessageHeaderSourceEndpointUrl) isMessageHeaderSourceEndpoint() {}
+
+// MessageHeaderSourceEndpointReference is the Reference variant of MessageHeaderSourceEndpoint.
+type MessageHeaderSourceEndpointReference struct {
+	Reference
+}
+
+func (v MessageHeaderSourceEndpointReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v MessageHeaderSourceEndpointReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v MessageHeaderSourceEndpointReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v MessageHeaderSourceEndpointReference) isMessageHeaderSourceEndpoint() {}
+
+var messageHeaderSourceEndpointVariants = []MessageHeaderSourceEndpoint{
+	MessageHeaderSourceEndpointUrl(""),
+	MessageHeaderSourceEndpointReference{},
+}
+
 type MessageHeaderResponse struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Identifier *Identifier `json:"identifier" bson:"identifier"`               // Bundle.identifier of original message
-	Code       string      `json:"code" bson:"code"`                           // ok | transient-error | fatal-error
-	Details    *Reference  `json:"details,omitempty" bson:"details,omitempty"` // Specific list of hints/warnings/errors
+	Id                *string      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        *Identifier  `json:"identifier" bson:"identifier"`                                    // Bundle.identifier of original message
+	Code              ResponseType `json:"code" bson:"code"`                                                // ok | transient-error | fatal-error
+	CodeElement       *Element     `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Details           *Reference   `json:"details,omitempty" bson:"details,omitempty"`                      // Specific list of hints/warnings/errors
 }
 
 func (r *MessageHeaderResponse) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Identifier == nil {
 		return fmt.Errorf("field 'Identifier' is required")
 	}
@@ -152,10 +918,17 @@ func (r *MessageHeaderResponse) Validate() error {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Code == emptyString {
+	if r.Code == "" {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if !r.Code.IsValid() {
+		return fmt.Errorf("field 'Code' has invalid code '%s'", r.Code)
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
 	if r.Details != nil {
 		if err := r.Details.Validate(); err != nil {
 			return fmt.Errorf("Details: %w", err)
@@ -163,3 +936,67 @@ func (r *MessageHeaderResponse) Validate() error {
 	}
 	return nil
 }
+
+func (r *MessageHeaderResponse) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Identifier == nil {
+		issues.add("required", path+".identifier", "field 'Identifier' is required")
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Code == "" {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != "" && !r.Code.IsValid() {
+		issues.add("code-invalid", path+".code", fmt.Sprintf("field 'Code' has invalid code '%s'", r.Code))
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.Details != nil {
+		r.Details.validateAll(path+".details", issues)
+	}
+}
+
+var messageHeaderResponseInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MessageHeaderResponse) invariants() []invariant {
+	return messageHeaderResponseInvariants
+}
+
+func (r MessageHeaderResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MessageHeaderResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MessageHeaderResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MessageHeaderResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var messageHeaderResponseElementMetadata = []ElementMetadata{
+	{Path: "MessageHeader.response.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MessageHeader.response.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MessageHeader.response.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MessageHeader.response.identifier", Name: "identifier", Field: 3, Min: 1, Max: "1", Types: []string{"Identifier"}},
+	{Path: "MessageHeader.response.code", Name: "code", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MessageHeader.response.code", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/response-code"}},
+	{Path: "MessageHeader.response.details", Name: "details", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *MessageHeaderResponse) ElementMetadata() []ElementMetadata {
+	return messageHeaderResponseElementMetadata
+}
@@ -1,21 +1,61 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Meta Type: The metadata about a resource. This is content in the resource that is maintained by the infrastructure. Changes to the content might not always be associated with version changes to the resource.
 type Meta struct {
-	Id          *string  `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	VersionId   *string  `json:"versionId,omitempty" bson:"version_id,omitempty"`     // Version specific identifier
-	LastUpdated *string  `json:"lastUpdated,omitempty" bson:"last_updated,omitempty"` // When the resource version last changed
-	Source      *string  `json:"source,omitempty" bson:"source,omitempty"`            // Identifies where the resource comes from
-	Profile     []string `json:"profile,omitempty" bson:"profile,omitempty"`          // Profiles this resource claims to conform to
-	Security    []Coding `json:"security,omitempty" bson:"security,omitempty"`        // Security Labels applied to this resource
-	Tag         []Coding `json:"tag,omitempty" bson:"tag,omitempty"`                  // Tags applied to this resource
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`               // Additional content defined by implementations
+	VersionId          *string     `json:"versionId,omitempty" bson:"version_id,omitempty"`              // Version specific identifier
+	VersionIdElement   *Element    `json:"_versionId,omitempty" bson:"version_id_element,omitempty"`     // Extensions for versionId
+	LastUpdated        *Instant    `json:"lastUpdated,omitempty" bson:"last_updated,omitempty"`          // When the resource version last changed
+	LastUpdatedElement *Element    `json:"_lastUpdated,omitempty" bson:"last_updated_element,omitempty"` // Extensions for lastUpdated
+	Source             *string     `json:"source,omitempty" bson:"source,omitempty"`                     // Identifies where the resource comes from
+	SourceElement      *Element    `json:"_source,omitempty" bson:"source_element,omitempty"`            // Extensions for source
+	Profile            []string    `json:"profile,omitempty" bson:"profile,omitempty"`                   // Profiles this resource claims to conform to
+	ProfileElement     []*Element  `json:"_profile,omitempty" bson:"profile_element,omitempty"`          // Extensions for profile
+	Security           []Coding    `json:"security,omitempty" bson:"security,omitempty"`                 // Security Labels applied to this resource
+	Tag                []Coding    `json:"tag,omitempty" bson:"tag,omitempty"`                           // Tags applied to this resource
 }
 
 func (r *Meta) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.VersionIdElement != nil {
+		if err := r.VersionIdElement.Validate(); err != nil {
+			return fmt.Errorf("VersionIdElement: %w", err)
+		}
+	}
+	if r.LastUpdated != nil {
+		if err := r.LastUpdated.Validate(); err != nil {
+			return fmt.Errorf("LastUpdated: %w", err)
+		}
+	}
+	if r.LastUpdatedElement != nil {
+		if err := r.LastUpdatedElement.Validate(); err != nil {
+			return fmt.Errorf("LastUpdatedElement: %w", err)
+		}
+	}
+	if r.SourceElement != nil {
+		if err := r.SourceElement.Validate(); err != nil {
+			return fmt.Errorf("SourceElement: %w", err)
+		}
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Security {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Security[%d]: %w", i, err)
@@ -28,3 +68,118 @@ func (r *Meta) Validate() error {
 	}
 	return nil
 }
+
+func (r *Meta) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.VersionIdElement != nil {
+		r.VersionIdElement.validateAll(path+".versionId", issues)
+	}
+	if r.LastUpdated != nil {
+		if err := r.LastUpdated.Validate(); err != nil {
+			issues.add("value", path+".lastUpdated", err.Error())
+		}
+	}
+	if r.LastUpdatedElement != nil {
+		r.LastUpdatedElement.validateAll(path+".lastUpdated", issues)
+	}
+	if r.SourceElement != nil {
+		r.SourceElement.validateAll(path+".source", issues)
+	}
+	for i, item := range r.ProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.profile[%d]", path, i), issues)
+	}
+	for i, item := range r.Security {
+		item.validateAll(fmt.Sprintf("%s.security[%d]", path, i), issues)
+	}
+	for i, item := range r.Tag {
+		item.validateAll(fmt.Sprintf("%s.tag[%d]", path, i), issues)
+	}
+}
+
+func (r *Meta) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Meta", &issues)
+	checkInvariants(r, "Meta", &issues)
+	return issues
+}
+
+var metaInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Meta) invariants() []invariant {
+	return metaInvariants
+}
+
+func (r Meta) MarshalJSON() ([]byte, error) {
+	type alias Meta
+	out := struct {
+		alias
+		Profile        []*string  `json:"profile,omitempty"`
+		ProfileElement []*Element `json:"_profile,omitempty"`
+	}{alias: alias(r)}
+	out.Profile, out.ProfileElement = alignPrimitiveArray(r.Profile, r.ProfileElement)
+	return json.Marshal(out)
+}
+
+func (r *Meta) UnmarshalJSON(data []byte) error {
+	type alias Meta
+	aux := struct {
+		*alias
+		Profile []*string `json:"profile,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Profile, err = splitPrimitiveArray(aux.Profile, r.ProfileElement); err != nil {
+		return fmt.Errorf("profile: %w", err)
+	}
+	return nil
+}
+
+func (r Meta) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Meta) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Meta) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Meta) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var metaElementTypes = map[string]string{
+	"versionId": "id",
+	"source":    "uri",
+	"profile":   "canonical",
+}
+
+func (r *Meta) elementTypes() map[string]string {
+	return metaElementTypes
+}
+
+var metaElementMetadata = []ElementMetadata{
+	{Path: "Meta.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Meta.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Meta.versionId", Name: "versionId", Field: 2, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Meta.lastUpdated", Name: "lastUpdated", Field: 4, Min: 0, Max: "1", Types: []string{"instant"}, Summary: true},
+	{Path: "Meta.source", Name: "source", Field: 6, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true},
+	{Path: "Meta.profile", Name: "profile", Field: 8, Min: 0, Max: "*", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition"}, Summary: true},
+	{Path: "Meta.security", Name: "security", Field: 10, Min: 0, Max: "*", Types: []string{"Coding"}, Binding: &BindingDefinition{Path: "Meta.security", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/security-labels", Description: "Security Labels from the Healthcare Privacy and Security Classification System."}, Summary: true},
+	{Path: "Meta.tag", Name: "tag", Field: 11, Min: 0, Max: "*", Types: []string{"Coding"}, Binding: &BindingDefinition{Path: "Meta.tag", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/common-tags", Description: "Codes that represent various types of tags, commonly workflow-related; e.g. \"Needs review by Dr. Jones\"."}, Summary: true},
+}
+
+func (r *Meta) ElementMetadata() []ElementMetadata {
+	return metaElementMetadata
+}
@@ -2,46 +2,68 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Common Interface declaration for conformance and knowledge artifact resources.
 type MetadataResource struct {
-	ResourceType           string            `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string           `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta             `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string           `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative        `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string           `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this {{title}}, represented as an absolute URI (globally unique)
-	Identifier             []Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the {{title}}
-	Version                *string           `json:"version,omitempty" bson:"version,omitempty"`                                 // Canonical version of the {{title}}
-	VersionAlgorithmString *string           `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding           `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string           `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this {{title}} (computer friendly)
-	Title                  *string           `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this {{title}} (human friendly)
-	Status                 string            `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool             `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string           `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string           `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail   `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string           `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the {{title}}
-	UseContext             []UsageContext    `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the {{title}} (if applicable)
-	Purpose                *string           `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this {{title}} is defined
-	Copyright              *string           `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string           `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Usage                  *string           `json:"usage,omitempty" bson:"usage,omitempty"`                                     // Describes the clinical usage of the {{title}}
-	ApprovalDate           *string           `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the {{title}} was approved by publisher
-	LastReviewDate         *string           `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the {{title}} was last reviewed by the publisher
-	EffectivePeriod        *Period           `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the {{title}} is expected to be used
-	Topic                  []CodeableConcept `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail   `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the {{title}}
-	Editor                 []ContactDetail   `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the {{title}}
-	Reviewer               []ContactDetail   `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the {{title}}
-	Endorser               []ContactDetail   `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the {{title}}
-	RelatedArtifact        []RelatedArtifact `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
+	ResourceType            string                           `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                          `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                         `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                          `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                         `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                       `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                          `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this {{title}}, represented as an absolute URI (globally unique)
+	UrlElement              *Element                         `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the {{title}}
+	Version                 *string                          `json:"version,omitempty" bson:"version,omitempty"`                          // Canonical version of the {{title}}
+	VersionElement          *Element                         `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        MetadataResourceVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                         `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                          `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this {{title}} (computer friendly)
+	NameElement             *Element                         `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                          `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this {{title}} (human friendly)
+	TitleElement            *Element                         `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Status                  PublicationStatus                `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                         `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                            `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                         `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Date                    *DateTime                        `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                         `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                          `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                         `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail                  `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                          `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the {{title}}
+	DescriptionElement      *Element                         `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext                   `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the {{title}} (if applicable)
+	Purpose                 *string                          `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this {{title}} is defined
+	PurposeElement          *Element                         `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                          `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                         `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                          `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                         `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	Usage                   *string                          `json:"usage,omitempty" bson:"usage,omitempty"`                              // Describes the clinical usage of the {{title}}
+	UsageElement            *Element                         `json:"_usage,omitempty" bson:"usage_element,omitempty"`                     // Extensions for usage
+	ApprovalDate            *Date                            `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the {{title}} was approved by publisher
+	ApprovalDateElement     *Element                         `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                            `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the {{title}} was last reviewed by the publisher
+	LastReviewDateElement   *Element                         `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                          `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the {{title}} is expected to be used
+	Topic                   []CodeableConcept                `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail                  `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the {{title}}
+	Editor                  []ContactDetail                  `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the {{title}}
+	Reviewer                []ContactDetail                  `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the {{title}}
+	Endorser                []ContactDetail                  `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the {{title}}
+	RelatedArtifact         []RelatedArtifact                `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations, etc
+
+	versionAlgorithmVariants []string // JSON properties of MetadataResource.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *MetadataResource) Validate() error {
@@ -53,30 +75,118 @@ func (r *MetadataResource) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -87,6 +197,46 @@ func (r *MetadataResource) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -122,5 +272,328 @@ func (r *MetadataResource) Validate() error {
 			return fmt.Errorf("RelatedArtifact[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "MetadataResource")
+}
+
+func (r *MetadataResource) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "MetadataResource" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'MetadataResource', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+}
+
+func (r *MetadataResource) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MetadataResource", &issues)
+	checkInvariants(r, "MetadataResource", &issues)
+	return issues
+}
+
+func (r MetadataResource) MarshalJSON() ([]byte, error) {
+	type alias MetadataResource
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *MetadataResource) UnmarshalJSON(data []byte) error {
+	type alias MetadataResource
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", metadataResourceVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r MetadataResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MetadataResource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MetadataResource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MetadataResource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *MetadataResource) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(metadataResourceVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var metadataResourceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"usage":         "markdown",
+}
+
+func (r *MetadataResource) elementTypes() map[string]string {
+	return metadataResourceElementTypes
+}
+
+var metadataResourceElementMetadata = []ElementMetadata{
+	{Path: "MetadataResource.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "MetadataResource.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "MetadataResource.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "MetadataResource.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MetadataResource.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "MetadataResource.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "MetadataResource.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "MetadataResource.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MetadataResource.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "MetadataResource.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "MetadataResource.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "MetadataResource.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MetadataResource.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "MetadataResource.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MetadataResource.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MetadataResource.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MetadataResource.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "MetadataResource.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "MetadataResource.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "MetadataResource.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MetadataResource.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "MetadataResource.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MetadataResource.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "MetadataResource.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MetadataResource.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MetadataResource.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MetadataResource.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MetadataResource.usage", Name: "usage", Field: 41, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "MetadataResource.approvalDate", Name: "approvalDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "MetadataResource.lastReviewDate", Name: "lastReviewDate", Field: 45, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "MetadataResource.effectivePeriod", Name: "effectivePeriod", Field: 47, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "MetadataResource.topic", Name: "topic", Field: 48, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "MetadataResource.author", Name: "author", Field: 49, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "MetadataResource.editor", Name: "editor", Field: 50, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "MetadataResource.reviewer", Name: "reviewer", Field: 51, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "MetadataResource.endorser", Name: "endorser", Field: 52, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "MetadataResource.relatedArtifact", Name: "relatedArtifact", Field: 53, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+}
+
+func (r *MetadataResource) ElementMetadata() []ElementMetadata {
+	return metadataResourceElementMetadata
+}
+
+// MetadataResourceVersionAlgorithm is the type of MetadataResource.versionAlgorithm[x]. It is implemented by the MetadataResourceVersionAlgorithm* variant types.
+type MetadataResourceVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isMetadataResourceVersionAlgorithm()
+}
+
+// MetadataResourceVersionAlgorithmString is the string variant of MetadataResourceVersionAlgorithm.
+type MetadataResourceVersionAlgorithmString string
+
+func (v MetadataResourceVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v MetadataResourceVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v MetadataResourceVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v MetadataResourceVersionAlgorithmString) isMetadataResourceVersionAlgorithm() {}
+
+// MetadataResourceVersionAlgorithmCoding is the Coding variant of MetadataResourceVersionAlgorithm.
+type MetadataResourceVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v MetadataResourceVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v MetadataResourceVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v MetadataResourceVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v MetadataResourceVersionAlgorithmCoding) isMetadataResourceVersionAlgorithm() {}
+
+var metadataResourceVersionAlgorithmVariants = []MetadataResourceVersionAlgorithm{
+	MetadataResourceVersionAlgorithmString(""),
+	MetadataResourceVersionAlgorithmCoding{},
+}
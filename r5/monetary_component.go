@@ -1,28 +1,54 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // MonetaryComponent Type: Financial line items use this datatype to commonly categorize the value, and other factors that may effect how the value should be interpreted.
 type MonetaryComponent struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Type   string           `json:"type" bson:"type"`                         // base | surcharge | discount | tax | informational
-	Code   *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`     // Codes may be used to differentiate between kinds of taxes, surcharges, discounts etc.
-	Factor *float64         `json:"factor,omitempty" bson:"factor,omitempty"` // Factor used for calculating this component
-	Amount *Money           `json:"amount,omitempty" bson:"amount,omitempty"` // Explicit value amount to be used
+	Id            *string            `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
+	Extension     []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`    // Additional content defined by implementations
+	Type          PriceComponentType `json:"type" bson:"type"`                                  // base | surcharge | discount | tax | informational
+	TypeElement   *Element           `json:"_type,omitempty" bson:"type_element,omitempty"`     // Extensions for type
+	Code          *CodeableConcept   `json:"code,omitempty" bson:"code,omitempty"`              // Codes may be used to differentiate between kinds of taxes, surcharges, discounts etc.
+	Factor        *Decimal           `json:"factor,omitempty" bson:"factor,omitempty"`          // Factor used for calculating this component
+	FactorElement *Element           `json:"_factor,omitempty" bson:"factor_element,omitempty"` // Extensions for factor
+	Amount        *Money             `json:"amount,omitempty" bson:"amount,omitempty"`          // Explicit value amount to be used
 }
 
 func (r *MonetaryComponent) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
 	if r.Amount != nil {
 		if err := r.Amount.Validate(); err != nil {
 			return fmt.Errorf("Amount: %w", err)
@@ -30,3 +56,76 @@ func (r *MonetaryComponent) Validate() error {
 	}
 	return nil
 }
+
+func (r *MonetaryComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+func (r *MonetaryComponent) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MonetaryComponent", &issues)
+	checkInvariants(r, "MonetaryComponent", &issues)
+	return issues
+}
+
+var monetaryComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *MonetaryComponent) invariants() []invariant {
+	return monetaryComponentInvariants
+}
+
+func (r MonetaryComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MonetaryComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MonetaryComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MonetaryComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var monetaryComponentElementMetadata = []ElementMetadata{
+	{Path: "MonetaryComponent.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "MonetaryComponent.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "MonetaryComponent.type", Name: "type", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "MonetaryComponent.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/price-component-type", Description: "The purpose for which an extended contact detail should be used."}, Summary: true},
+	{Path: "MonetaryComponent.code", Name: "code", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "MonetaryComponent.code", Strength: "example", ValueSet: "", Description: "Codes may be used to differentiate between kinds of taxes, surcharges, discounts etc."}, Summary: true},
+	{Path: "MonetaryComponent.factor", Name: "factor", Field: 5, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "MonetaryComponent.amount", Name: "amount", Field: 7, Min: 0, Max: "1", Types: []string{"Money"}, Summary: true},
+}
+
+func (r *MonetaryComponent) ElementMetadata() []ElementMetadata {
+	return monetaryComponentElementMetadata
+}
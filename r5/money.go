@@ -1,12 +1,107 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Money Type: An amount of economic utility in some recognized currency.
 type Money struct {
-	Id       *string  `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Value    *float64 `json:"value,omitempty" bson:"value,omitempty"`       // Numerical value (with implicit precision)
-	Currency *string  `json:"currency,omitempty" bson:"currency,omitempty"` // ISO 4217 Currency Code
+	Id              *string     `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
+	Extension       []Extension `json:"extension,omitempty" bson:"extension,omitempty"`        // Additional content defined by implementations
+	Value           *Decimal    `json:"value,omitempty" bson:"value,omitempty"`                // Numerical value (with implicit precision)
+	ValueElement    *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`       // Extensions for value
+	Currency        *string     `json:"currency,omitempty" bson:"currency,omitempty"`          // ISO 4217 Currency Code
+	CurrencyElement *Element    `json:"_currency,omitempty" bson:"currency_element,omitempty"` // Extensions for currency
 }
 
 func (r *Money) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.CurrencyElement != nil {
+		if err := r.CurrencyElement.Validate(); err != nil {
+			return fmt.Errorf("CurrencyElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Money) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			issues.add("value", path+".value", err.Error())
+		}
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.CurrencyElement != nil {
+		r.CurrencyElement.validateAll(path+".currency", issues)
+	}
+}
+
+func (r *Money) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Money", &issues)
+	checkInvariants(r, "Money", &issues)
+	return issues
+}
+
+var moneyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Money) invariants() []invariant {
+	return moneyInvariants
+}
+
+func (r Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Money) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Money) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var moneyElementTypes = map[string]string{
+	"currency": "code",
+}
+
+func (r *Money) elementTypes() map[string]string {
+	return moneyElementTypes
+}
+
+var moneyElementMetadata = []ElementMetadata{
+	{Path: "Money.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Money.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Money.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Money.currency", Name: "currency", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Money.currency", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/currencies", Description: "A code indicating the currency, taken from ISO 4217."}, Summary: true},
+}
+
+func (r *Money) ElementMetadata() []ElementMetadata {
+	return moneyElementMetadata
+}
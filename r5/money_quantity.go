@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
@@ -17,3 +18,51 @@ func (r *MoneyQuantity) Validate() error {
 	}
 	return nil
 }
+
+func (r *MoneyQuantity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Quantity {
+		item.validateAll(fmt.Sprintf("%s.Quantity[%d]", path, i), issues)
+	}
+}
+
+func (r *MoneyQuantity) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("MoneyQuantity", &issues)
+	checkInvariants(r, "MoneyQuantity", &issues)
+	return issues
+}
+
+var moneyQuantityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "mtqy-1", severity: "error", human: "There SHALL be a code if there is a value and it SHALL be an expression of currency.  If system is present, it SHALL be ISO 4217 (system = \"urn:iso:std:iso:4217\" - currency).", expression: "(code.exists() or value.empty()) and (system.empty() or system = 'urn:iso:std:iso:4217')"},
+	{key: "qty-3", severity: "error", human: "If a code for the unit is present, the system SHALL also be present", expression: "code.empty() or system.exists()"},
+	{key: "qty-4", severity: "warning", human: "Processing UCUM codes with annotations ({..}) can be misleading.", expression: "code.exists() implies code.matches('\\\\{.*?\\\\}').not()"},
+}
+
+func (r *MoneyQuantity) invariants() []invariant {
+	return moneyQuantityInvariants
+}
+
+func (r MoneyQuantity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *MoneyQuantity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r MoneyQuantity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *MoneyQuantity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var moneyQuantityElementMetadata = []ElementMetadata{
+	{Path: "Quantity", Name: "Quantity", Field: 0, Min: 0, Max: "*"},
+}
+
+func (r *MoneyQuantity) ElementMetadata() []ElementMetadata {
+	return moneyQuantityElementMetadata
+}
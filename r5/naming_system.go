@@ -2,50 +2,74 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A curated namespace that issues unique symbols within that namespace for the identification of concepts, people, devices, etc.  Represents a "System" used within the Identifier and Coding data types.
 type NamingSystem struct {
-	ResourceType           string                 `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage      `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this naming system, represented as a URI (globally unique)
-	Identifier             []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the naming system (business identifier)
-	Version                *string                `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the naming system
-	VersionAlgorithmString *string                `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                 `json:"name" bson:"name"`                                                           // Name for this naming system (computer friendly)
-	Title                  *string                `json:"title,omitempty" bson:"title,omitempty"`                                     // Title for this naming system (human friendly)
-	Status                 string                 `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Kind                   string                 `json:"kind" bson:"kind"`                                                           // codesystem | identifier | root
-	Experimental           *bool                  `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   string                 `json:"date" bson:"date"`                                                           // Date last changed
-	Publisher              *string                `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail        `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Responsible            *string                `json:"responsible,omitempty" bson:"responsible,omitempty"`                         // Who maintains system namespace?
-	Type                   *CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                                       // e.g. driver,  provider,  patient, bank etc
-	Description            *string                `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the naming system
-	UseContext             []UsageContext         `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept      `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the naming system (if applicable)
-	Purpose                *string                `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this naming system is defined
-	Copyright              *string                `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string                `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the NamingSystem was approved by publisher
-	LastReviewDate         *string                `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the NamingSystem was last reviewed by the publisher
-	EffectivePeriod        *Period                `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the NamingSystem is expected to be used
-	Topic                  []CodeableConcept      `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail        `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the CodeSystem
-	Editor                 []ContactDetail        `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the NamingSystem
-	Reviewer               []ContactDetail        `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the NamingSystem
-	Endorser               []ContactDetail        `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the NamingSystem
-	RelatedArtifact        []RelatedArtifact      `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
-	Usage                  *string                `json:"usage,omitempty" bson:"usage,omitempty"`                                     // How/where is it used
-	UniqueId               []NamingSystemUniqueId `json:"uniqueId" bson:"unique_id"`                                                  // Unique identifiers used for system
+	ResourceType            string                       `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                      `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                      `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                      `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this naming system, represented as a URI (globally unique)
+	UrlElement              *Element                     `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the naming system (business identifier)
+	Version                 *string                      `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the naming system
+	VersionElement          *Element                     `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        NamingSystemVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                     `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    string                       `json:"name" bson:"name"`                                                    // Name for this naming system (computer friendly)
+	NameElement             *Element                     `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                      `json:"title,omitempty" bson:"title,omitempty"`                              // Title for this naming system (human friendly)
+	TitleElement            *Element                     `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Status                  PublicationStatus            `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Kind                    NamingSystemType             `json:"kind" bson:"kind"`                                                    // codesystem | identifier | root
+	KindElement             *Element                     `json:"_kind,omitempty" bson:"kind_element,omitempty"`                       // Extensions for kind
+	Experimental            *bool                        `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                     `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Date                    *DateTime                    `json:"date" bson:"date"`                                                    // Date last changed
+	DateElement             *Element                     `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                      `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                     `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail              `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Responsible             *string                      `json:"responsible,omitempty" bson:"responsible,omitempty"`                  // Who maintains system namespace?
+	ResponsibleElement      *Element                     `json:"_responsible,omitempty" bson:"responsible_element,omitempty"`         // Extensions for responsible
+	Type                    *CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                                // e.g. driver,  provider,  patient, bank etc
+	Description             *string                      `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the naming system
+	DescriptionElement      *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext               `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept            `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the naming system (if applicable)
+	Purpose                 *string                      `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this naming system is defined
+	PurposeElement          *Element                     `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                      `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                     `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                      `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                     `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                        `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the NamingSystem was approved by publisher
+	ApprovalDateElement     *Element                     `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                        `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the NamingSystem was last reviewed by the publisher
+	LastReviewDateElement   *Element                     `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                      `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the NamingSystem is expected to be used
+	Topic                   []CodeableConcept            `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail              `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the CodeSystem
+	Editor                  []ContactDetail              `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the NamingSystem
+	Reviewer                []ContactDetail              `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the NamingSystem
+	Endorser                []ContactDetail              `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the NamingSystem
+	RelatedArtifact         []RelatedArtifact            `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations, etc
+	Usage                   *string                      `json:"usage,omitempty" bson:"usage,omitempty"`                              // How/where is it used
+	UsageElement            *Element                     `json:"_usage,omitempty" bson:"usage_element,omitempty"`                     // Extensions for usage
+	UniqueId                []NamingSystemUniqueId       `json:"uniqueId" bson:"unique_id"`                                           // Unique identifiers used for system
+
+	versionAlgorithmVariants []string // JSON properties of NamingSystem.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *NamingSystem) Validate() error {
@@ -57,44 +81,146 @@ func (r *NamingSystem) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	var emptyString string
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Kind == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Kind == "" {
 		return fmt.Errorf("field 'Kind' is required")
 	}
-	if r.Date == emptyString {
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date == nil {
 		return fmt.Errorf("field 'Date' is required")
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.ResponsibleElement != nil {
+		if err := r.ResponsibleElement.Validate(); err != nil {
+			return fmt.Errorf("ResponsibleElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -105,6 +231,41 @@ func (r *NamingSystem) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -140,6 +301,11 @@ func (r *NamingSystem) Validate() error {
 			return fmt.Errorf("RelatedArtifact[%d]: %w", i, err)
 		}
 	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
 	if len(r.UniqueId) < 1 {
 		return fmt.Errorf("field 'UniqueId' must have at least 1 elements")
 	}
@@ -148,31 +314,560 @@ func (r *NamingSystem) Validate() error {
 			return fmt.Errorf("UniqueId[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "NamingSystem")
+}
+
+func (r *NamingSystem) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "NamingSystem" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'NamingSystem', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.ResponsibleElement != nil {
+		r.ResponsibleElement.validateAll(path+".responsible", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if len(r.UniqueId) < 1 {
+		issues.add("required", path+".uniqueId", "field 'UniqueId' must have at least 1 elements")
+	}
+	for i, item := range r.UniqueId {
+		item.validateAll(fmt.Sprintf("%s.uniqueId[%d]", path, i), issues)
+	}
+}
+
+func (r *NamingSystem) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("NamingSystem", &issues)
+	checkInvariants(r, "NamingSystem", &issues)
+	return issues
+}
+
+var namingSystemInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *NamingSystem) invariants() []invariant {
+	return namingSystemInvariants
+}
+
+func (r NamingSystem) MarshalJSON() ([]byte, error) {
+	type alias NamingSystem
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NamingSystem) UnmarshalJSON(data []byte) error {
+	type alias NamingSystem
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", namingSystemVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r NamingSystem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NamingSystem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NamingSystem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NamingSystem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NamingSystem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(namingSystemVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var namingSystemElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *NamingSystem) elementTypes() map[string]string {
+	return namingSystemElementTypes
+}
+
+var namingSystemElementMetadata = []ElementMetadata{
+	{Path: "NamingSystem.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "NamingSystem.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "NamingSystem.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "NamingSystem.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NamingSystem.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "NamingSystem.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "NamingSystem.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "NamingSystem.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NamingSystem.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NamingSystem.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "NamingSystem.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "NamingSystem.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "NamingSystem.name", Name: "name", Field: 18, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NamingSystem.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "NamingSystem.kind", Name: "kind", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NamingSystem.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/namingsystem-type"}},
+	{Path: "NamingSystem.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "NamingSystem.date", Name: "date", Field: 28, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "NamingSystem.publisher", Name: "publisher", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.contact", Name: "contact", Field: 32, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "NamingSystem.responsible", Name: "responsible", Field: 33, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.type", Name: "type", Field: 35, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NamingSystem.description", Name: "description", Field: 36, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "NamingSystem.useContext", Name: "useContext", Field: 38, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "NamingSystem.jurisdiction", Name: "jurisdiction", Field: 39, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NamingSystem.purpose", Name: "purpose", Field: 40, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "NamingSystem.copyright", Name: "copyright", Field: 42, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "NamingSystem.copyrightLabel", Name: "copyrightLabel", Field: 44, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.approvalDate", Name: "approvalDate", Field: 46, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "NamingSystem.lastReviewDate", Name: "lastReviewDate", Field: 48, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "NamingSystem.effectivePeriod", Name: "effectivePeriod", Field: 50, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "NamingSystem.topic", Name: "topic", Field: 51, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NamingSystem.author", Name: "author", Field: 52, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "NamingSystem.editor", Name: "editor", Field: 53, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "NamingSystem.reviewer", Name: "reviewer", Field: 54, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "NamingSystem.endorser", Name: "endorser", Field: 55, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "NamingSystem.relatedArtifact", Name: "relatedArtifact", Field: 56, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "NamingSystem.usage", Name: "usage", Field: 57, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.uniqueId", Name: "uniqueId", Field: 59, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *NamingSystem) ElementMetadata() []ElementMetadata {
+	return namingSystemElementMetadata
+}
+
+// NamingSystemVersionAlgorithm is the type of NamingSystem.versionAlgorithm[x]. It is implemented by the NamingSystemVersionAlgorithm* variant types.
+type NamingSystemVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNamingSystemVersionAlgorithm()
+}
+
+// NamingSystemVersionAlgorithmString is the string variant of NamingSystemVersionAlgorithm.
+type NamingSystemVersionAlgorithmString string
+
+func (v NamingSystemVersionAlgorithmString) Validate() error {
 	return nil
 }
 
+func (v NamingSystemVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v NamingSystemVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v NamingSystemVersionAlgorithmString) isNamingSystemVersionAlgorithm() {}
+
+// NamingSystemVersionAlgorithmCoding is the Coding variant of NamingSystemVersionAlgorithm.
+type NamingSystemVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v NamingSystemVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v NamingSystemVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v NamingSystemVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v NamingSystemVersionAlgorithmCoding) isNamingSystemVersionAlgorithm() {}
+
+var namingSystemVersionAlgorithmVariants = []NamingSystemVersionAlgorithm{
+	NamingSystemVersionAlgorithmString(""),
+	NamingSystemVersionAlgorithmCoding{},
+}
+
+func (r *NamingSystem) GetResourceType() string {
+	return "NamingSystem"
+}
+
+func (r *NamingSystem) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *NamingSystem) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *NamingSystem) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *NamingSystem) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *NamingSystem) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *NamingSystem) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *NamingSystem) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*NamingSystem)(nil)
+
 type NamingSystemUniqueId struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Type          string  `json:"type" bson:"type"`                                       // oid | uuid | uri | iri-stem | v2csmnemonic | other
-	Value         string  `json:"value" bson:"value"`                                     // The unique identifier
-	Preferred     *bool   `json:"preferred,omitempty" bson:"preferred,omitempty"`         // Is this the id that should be used for this type
-	Comment       *string `json:"comment,omitempty" bson:"comment,omitempty"`             // Notes about identifier usage
-	Period        *Period `json:"period,omitempty" bson:"period,omitempty"`               // When is identifier valid?
-	Authoritative *bool   `json:"authoritative,omitempty" bson:"authoritative,omitempty"` // Whether the identifier is authoritative
+	Id                   *string                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type                 NamingSystemIdentifierType `json:"type" bson:"type"`                                                // oid | uuid | uri | iri-stem | v2csmnemonic | other
+	TypeElement          *Element                   `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Value                string                     `json:"value" bson:"value"`                                              // The unique identifier
+	ValueElement         *Element                   `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+	Preferred            *bool                      `json:"preferred,omitempty" bson:"preferred,omitempty"`                  // Is this the id that should be used for this type
+	PreferredElement     *Element                   `json:"_preferred,omitempty" bson:"preferred_element,omitempty"`         // Extensions for preferred
+	Comment              *string                    `json:"comment,omitempty" bson:"comment,omitempty"`                      // Notes about identifier usage
+	CommentElement       *Element                   `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
+	Period               *Period                    `json:"period,omitempty" bson:"period,omitempty"`                        // When is identifier valid?
+	Authoritative        *bool                      `json:"authoritative,omitempty" bson:"authoritative,omitempty"`          // Whether the identifier is authoritative
+	AuthoritativeElement *Element                   `json:"_authoritative,omitempty" bson:"authoritative_element,omitempty"` // Extensions for authoritative
 }
 
 func (r *NamingSystemUniqueId) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Value == emptyString {
 		return fmt.Errorf("field 'Value' is required")
 	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.PreferredElement != nil {
+		if err := r.PreferredElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.AuthoritativeElement != nil {
+		if err := r.AuthoritativeElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoritativeElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *NamingSystemUniqueId) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.PreferredElement != nil {
+		r.PreferredElement.validateAll(path+".preferred", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.AuthoritativeElement != nil {
+		r.AuthoritativeElement.validateAll(path+".authoritative", issues)
+	}
+}
+
+var namingSystemUniqueIdInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NamingSystemUniqueId) invariants() []invariant {
+	return namingSystemUniqueIdInvariants
+}
+
+func (r NamingSystemUniqueId) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NamingSystemUniqueId) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NamingSystemUniqueId) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NamingSystemUniqueId) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var namingSystemUniqueIdElementMetadata = []ElementMetadata{
+	{Path: "NamingSystem.uniqueId.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.uniqueId.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NamingSystem.uniqueId.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NamingSystem.uniqueId.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NamingSystem.uniqueId.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/namingsystem-identifier-type"}},
+	{Path: "NamingSystem.uniqueId.value", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.uniqueId.preferred", Name: "preferred", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "NamingSystem.uniqueId.comment", Name: "comment", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NamingSystem.uniqueId.period", Name: "period", Field: 11, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "NamingSystem.uniqueId.authoritative", Name: "authoritative", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *NamingSystemUniqueId) ElementMetadata() []ElementMetadata {
+	return namingSystemUniqueIdElementMetadata
+}
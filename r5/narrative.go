@@ -1,23 +1,109 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // Narrative Type: A human-readable summary of the resource conveying the essential clinical and business information for the resource.
 type Narrative struct {
-	Id     *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Status string  `json:"status" bson:"status"`             // generated | extensions | additional | empty
-	Div    string  `json:"div" bson:"div"`                   // Limited xhtml content
+	Id            *string         `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
+	Extension     []Extension     `json:"extension,omitempty" bson:"extension,omitempty"`    // Additional content defined by implementations
+	Status        NarrativeStatus `json:"status" bson:"status"`                              // generated | extensions | additional | empty
+	StatusElement *Element        `json:"_status,omitempty" bson:"status_element,omitempty"` // Extensions for status
+	Div           string          `json:"div" bson:"div"`                                    // Limited xhtml content
 }
 
 func (r *Narrative) Validate() error {
-	var emptyString string
-	if r.Status == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Div == emptyString {
 		return fmt.Errorf("field 'Div' is required")
 	}
 	return nil
 }
+
+func (r *Narrative) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	var emptyString string
+	if r.Div == emptyString {
+		issues.add("required", path+".div", "field 'Div' is required")
+	}
+}
+
+func (r *Narrative) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Narrative", &issues)
+	checkInvariants(r, "Narrative", &issues)
+	return issues
+}
+
+var narrativeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "txt-1", severity: "error", human: "The narrative SHALL contain only the basic html formatting elements and attributes described in chapters 7-11 (except section 4 of chapter 9) and 15 of the HTML 4.0 standard, <a> elements (either name or href), images and internally contained style attributes, and SHALL contain some non-whitespace characters", expression: "htmlChecks()", context: "div"},
+}
+
+func (r *Narrative) invariants() []invariant {
+	return narrativeInvariants
+}
+
+func (r Narrative) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Narrative) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Narrative) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Narrative) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var narrativeElementTypes = map[string]string{
+	"div": "xhtml",
+}
+
+func (r *Narrative) elementTypes() map[string]string {
+	return narrativeElementTypes
+}
+
+var narrativeElementMetadata = []ElementMetadata{
+	{Path: "Narrative.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Narrative.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Narrative.status", Name: "status", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Narrative.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/narrative-status", Description: "The status of a resource narrative."}},
+	{Path: "Narrative.div", Name: "div", Field: 4, Min: 1, Max: "1", Types: []string{"xhtml"}},
+}
+
+func (r *Narrative) ElementMetadata() []ElementMetadata {
+	return narrativeElementMetadata
+}
@@ -2,39 +2,53 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A record of intake by a patient.  A NutritionIntake may indicate that the patient may be consuming the food (i.e., solid and/or liquid), breastmilk, infant formula, supplements, enteral formula now or has consumed it in the past.  The source of this information can be the patient, significant other (such as a family member or spouse), or a clinician.  A common scenario where this information is captured is during the history taking process during a patient visit or stay or through an app that tracks food (i.e., solid and/or liquid), breastmilk, infant formula, supplements, enteral formula consumed.   The consumption information may come from sources such as the patient's memory, from a nutrition label, or from a clinician documenting observed intake.
 type NutritionIntake struct {
-	ResourceType       string                         `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                        `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                        `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier         []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // External identifier
-	BasedOn            []Reference                    `json:"basedOn,omitempty" bson:"based_on,omitempty"`                        // Fulfils plan, proposal or order
-	PartOf             []Reference                    `json:"partOf,omitempty" bson:"part_of,omitempty"`                          // Part of referenced event
-	Status             string                         `json:"status" bson:"status"`                                               // preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
-	StatusReason       []CodeableConcept              `json:"statusReason,omitempty" bson:"status_reason,omitempty"`              // Reason for current status
-	Code               *CodeableConcept               `json:"code,omitempty" bson:"code,omitempty"`                               // Code representing an overall type of nutrition intake
-	Subject            *Reference                     `json:"subject" bson:"subject"`                                             // Who is/was consuming the food (i.e. solid and/or liquid)
-	Encounter          *Reference                     `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Encounter associated with NutritionIntake
-	OccurrenceDateTime *string                        `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // The date/time or interval when the food (i.e. solid and/or liquid) is/was consumed
-	OccurrencePeriod   *Period                        `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // The date/time or interval when the food (i.e. solid and/or liquid) is/was consumed
-	Recorded           *string                        `json:"recorded,omitempty" bson:"recorded,omitempty"`                       // When the intake was recorded
-	ReportedBoolean    *bool                          `json:"reportedBoolean,omitempty" bson:"reported_boolean,omitempty"`        // Indicates if this is a reported rather than a primary record.  Can also indicate the source that provided the information about the consumption
-	ReportedReference  *Reference                     `json:"reportedReference,omitempty" bson:"reported_reference,omitempty"`    // Indicates if this is a reported rather than a primary record.  Can also indicate the source that provided the information about the consumption
-	NutritionItem      []NutritionIntakeNutritionItem `json:"nutritionItem,omitempty" bson:"nutrition_item,omitempty"`            // The nutrition product intended for consumption and/or administration
-	Performer          []NutritionIntakePerformer     `json:"performer,omitempty" bson:"performer,omitempty"`                     // Who or what performed the intake and how they were involved
-	Location           *Reference                     `json:"location,omitempty" bson:"location,omitempty"`                       // Where the intake occurred
-	DerivedFrom        []Reference                    `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                // Additional supporting information
-	Reason             []CodeableReference            `json:"reason,omitempty" bson:"reason,omitempty"`                           // Reason for why the food (i.e. solid and/or liquid) is /was consumed
-	Note               []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                               // Further information about the consumption
+	ResourceType         string                         `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                        `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                        `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External identifier
+	BasedOn              []Reference                    `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Fulfils plan, proposal or order
+	PartOf               []Reference                    `json:"partOf,omitempty" bson:"part_of,omitempty"`                        // Part of referenced event
+	Status               AdverseEventStatus             `json:"status" bson:"status"`                                             // preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
+	StatusElement        *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	StatusReason         []CodeableConcept              `json:"statusReason,omitempty" bson:"status_reason,omitempty"`            // Reason for current status
+	Code                 *CodeableConcept               `json:"code,omitempty" bson:"code,omitempty"`                             // Code representing an overall type of nutrition intake
+	Subject              *Reference                     `json:"subject" bson:"subject"`                                           // Who is/was consuming the food (i.e. solid and/or liquid)
+	Encounter            *Reference                     `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter associated with NutritionIntake
+	Occurrence           NutritionIntakeOccurrence      `json:"-" bson:"occurrence,omitempty"`                                    // The date/time or interval when the food (i.e. solid and/or liquid) is/was consumed
+	OccurrenceElement    *Element                       `json:"-" bson:"occurrence_element,omitempty"`                            // Extensions for occurrence[x]
+	Recorded             *DateTime                      `json:"recorded,omitempty" bson:"recorded,omitempty"`                     // When the intake was recorded
+	RecordedElement      *Element                       `json:"_recorded,omitempty" bson:"recorded_element,omitempty"`            // Extensions for recorded
+	Reported             NutritionIntakeReported        `json:"-" bson:"reported,omitempty"`                                      // Indicates if this is a reported rather than a primary record.  Can also indicate the source that provided the information about the consumption
+	ReportedElement      *Element                       `json:"-" bson:"reported_element,omitempty"`                              // Extensions for reported[x]
+	NutritionItem        []NutritionIntakeNutritionItem `json:"nutritionItem,omitempty" bson:"nutrition_item,omitempty"`          // The nutrition product intended for consumption and/or administration
+	Performer            []NutritionIntakePerformer     `json:"performer,omitempty" bson:"performer,omitempty"`                   // Who or what performed the intake and how they were involved
+	Location             *Reference                     `json:"location,omitempty" bson:"location,omitempty"`                     // Where the intake occurred
+	DerivedFrom          []Reference                    `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`              // Additional supporting information
+	Reason               []CodeableReference            `json:"reason,omitempty" bson:"reason,omitempty"`                         // Reason for why the food (i.e. solid and/or liquid) is /was consumed
+	Note                 []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                             // Further information about the consumption
+
+	occurrenceVariants []string // JSON properties of NutritionIntake.occurrence[x] when more than one was decoded
+	reportedVariants   []string // JSON properties of NutritionIntake.reported[x] when more than one was decoded
 }
 
+var nutritionIntakeSubjectTargets = []string{"Group", "Patient"}
+
+var nutritionIntakeEncounterTargets = []string{"Encounter"}
+
 func (r *NutritionIntake) Validate() error {
 	if r.ResourceType != "NutritionIntake" {
 		return fmt.Errorf("invalid resourceType: expected 'NutritionIntake', got '%s'", r.ResourceType)
@@ -44,11 +58,39 @@ func (r *NutritionIntake) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -64,10 +106,17 @@ func (r *NutritionIntake) Validate() error {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusReason[%d]: %w", i, err)
@@ -81,24 +130,60 @@ func (r *NutritionIntake) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, nutritionIntakeSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, nutritionIntakeSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, nutritionIntakeEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, nutritionIntakeEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
 		}
 	}
-	if r.ReportedReference != nil {
-		if err := r.ReportedReference.Validate(); err != nil {
-			return fmt.Errorf("ReportedReference: %w", err)
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			return fmt.Errorf("Recorded: %w", err)
+		}
+	}
+	if r.RecordedElement != nil {
+		if err := r.RecordedElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedElement: %w", err)
+		}
+	}
+	if len(r.reportedVariants) > 1 {
+		return fmt.Errorf("field 'Reported' must have a single type, got %v", r.reportedVariants)
+	}
+	if r.Reported != nil {
+		if err := r.Reported.Validate(); err != nil {
+			return fmt.Errorf("Reported: %w", err)
+		}
+	}
+	if r.ReportedElement != nil {
+		if err := r.ReportedElement.Validate(); err != nil {
+			return fmt.Errorf("ReportedElement: %w", err)
 		}
 	}
 	for i, item := range r.NutritionItem {
@@ -131,18 +216,437 @@ func (r *NutritionIntake) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "NutritionIntake")
+}
+
+func (r *NutritionIntake) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "NutritionIntake" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'NutritionIntake', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.StatusReason {
+		item.validateAll(fmt.Sprintf("%s.statusReason[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, nutritionIntakeSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, nutritionIntakeSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, nutritionIntakeEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, nutritionIntakeEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			issues.add("value", path+".recorded", err.Error())
+		}
+	}
+	if r.RecordedElement != nil {
+		r.RecordedElement.validateAll(path+".recorded", issues)
+	}
+	if len(r.reportedVariants) > 1 {
+		issues.add("structure", path+".reported", fmt.Sprintf("field 'Reported' must have a single type, got %v", r.reportedVariants))
+	}
+	if r.Reported != nil {
+		r.Reported.validateAll(path+".reported.ofType("+r.Reported.FHIRType()+")", issues)
+	}
+	if r.ReportedElement != nil {
+		r.ReportedElement.validateAll(path+".reported", issues)
+	}
+	for i, item := range r.NutritionItem {
+		item.validateAll(fmt.Sprintf("%s.nutritionItem[%d]", path, i), issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.DerivedFrom {
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *NutritionIntake) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("NutritionIntake", &issues)
+	checkInvariants(r, "NutritionIntake", &issues)
+	return issues
+}
+
+var nutritionIntakeInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *NutritionIntake) invariants() []invariant {
+	return nutritionIntakeInvariants
+}
+
+func (r NutritionIntake) MarshalJSON() ([]byte, error) {
+	type alias NutritionIntake
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "reported", r.Reported, r.ReportedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionIntake) UnmarshalJSON(data []byte) error {
+	type alias NutritionIntake
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", nutritionIntakeOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
+	if r.Reported, element, r.reportedVariants, err = unmarshalChoice(fields, "reported", nutritionIntakeReportedVariants); err != nil {
+		return err
+	}
+	r.ReportedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ReportedElement); err != nil {
+			return fmt.Errorf("_reported: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r NutritionIntake) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionIntake) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionIntake) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionIntake) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionIntake) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(nutritionIntakeOccurrenceVariants)
+	case "Reported":
+		return "reported", choiceValues(nutritionIntakeReportedVariants)
+	}
+	return "", nil
+}
+
+var nutritionIntakeElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *NutritionIntake) elementTypes() map[string]string {
+	return nutritionIntakeElementTypes
+}
+
+var nutritionIntakeElementMetadata = []ElementMetadata{
+	{Path: "NutritionIntake.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "NutritionIntake.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "NutritionIntake.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionIntake.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "NutritionIntake.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "NutritionIntake.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "NutritionIntake.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionIntake.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "NutritionIntake.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionIntake.partOf", Name: "partOf", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionIntake.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionIntake.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-status"}},
+	{Path: "NutritionIntake.statusReason", Name: "statusReason", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionIntake.code", Name: "code", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionIntake.subject", Name: "subject", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "NutritionIntake.encounter", Name: "encounter", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "NutritionIntake.occurrence[x]", Name: "occurrence", Field: 20, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "NutritionIntake.recorded", Name: "recorded", Field: 22, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "NutritionIntake.reported[x]", Name: "reported", Field: 24, Min: 0, Max: "1", Types: []string{"boolean", "Reference"}},
+	{Path: "NutritionIntake.nutritionItem", Name: "nutritionItem", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionIntake.performer", Name: "performer", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionIntake.location", Name: "location", Field: 28, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "NutritionIntake.derivedFrom", Name: "derivedFrom", Field: 29, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionIntake.reason", Name: "reason", Field: 30, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "NutritionIntake.note", Name: "note", Field: 31, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *NutritionIntake) ElementMetadata() []ElementMetadata {
+	return nutritionIntakeElementMetadata
+}
+
+// NutritionIntakeOccurrence is the type of NutritionIntake.occurrence[x]. It is implemented by the NutritionIntakeOccurrence* variant types.
+type NutritionIntakeOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionIntakeOccurrence()
+}
+
+// NutritionIntakeOccurrenceDateTime is the dateTime variant of NutritionIntakeOccurrence.
+type NutritionIntakeOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v NutritionIntakeOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v NutritionIntakeOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v NutritionIntakeOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v NutritionIntakeOccurrenceDateTime) isNutritionIntakeOccurrence() {}
+
+// NutritionIntakeOccurrencePeriod is the Period variant of NutritionIntakeOccurrence.
+type NutritionIntakeOccurrencePeriod struct {
+	Period
+}
+
+func (v NutritionIntakeOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v NutritionIntakeOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v NutritionIntakeOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v NutritionIntakeOccurrencePeriod) isNutritionIntakeOccurrence() {}
+
+var nutritionIntakeOccurrenceVariants = []NutritionIntakeOccurrence{
+	NutritionIntakeOccurrenceDateTime{},
+	NutritionIntakeOccurrencePeriod{},
+}
+
+// NutritionIntakeReported is the type of NutritionIntake.reported[x]. It is implemented by the NutritionIntakeReported* variant types.
+type NutritionIntakeReported interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionIntakeReported()
+}
+
+// NutritionIntakeReportedBoolean is the boolean variant of NutritionIntakeReported.
+type NutritionIntakeReportedBoolean bool
+
+func (v NutritionIntakeReportedBoolean) Validate() error {
 	return nil
 }
 
+func (v NutritionIntakeReportedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v NutritionIntakeReportedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v NutritionIntakeReportedBoolean) isNutritionIntakeReported() {}
+
+// NutritionIntakeReportedReference is the Reference variant of NutritionIntakeReported.
+type NutritionIntakeReportedReference struct {
+	Reference
+}
+
+func (v NutritionIntakeReportedReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v NutritionIntakeReportedReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v NutritionIntakeReportedReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v NutritionIntakeReportedReference) isNutritionIntakeReported() {}
+
+var nutritionIntakeReportedVariants = []NutritionIntakeReported{
+	NutritionIntakeReportedBoolean(false),
+	NutritionIntakeReportedReference{},
+}
+
+func (r *NutritionIntake) GetResourceType() string {
+	return "NutritionIntake"
+}
+
+func (r *NutritionIntake) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *NutritionIntake) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *NutritionIntake) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *NutritionIntake) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *NutritionIntake) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *NutritionIntake) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *NutritionIntake) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*NutritionIntake)(nil)
+
 type NutritionIntakeNutritionItem struct {
-	Id               *string                                       `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Type             *CodeableConcept                              `json:"type,omitempty" bson:"type,omitempty"`                          // The type of food (i.e. solid or liquid) product
-	NutritionProduct *CodeableReference                            `json:"nutritionProduct,omitempty" bson:"nutrition_product,omitempty"` // A product used for nutritional purposes (e.g. food or supplement)
-	ConsumedItem     []NutritionIntakeNutritionItemConsumedItem    `json:"consumedItem,omitempty" bson:"consumed_item,omitempty"`         // What nutrition item was consumed
-	NotConsumedItem  []NutritionIntakeNutritionItemNotConsumedItem `json:"notConsumedItem,omitempty" bson:"not_consumed_item,omitempty"`  // What nutrition item was not consumed
+	Id                *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                              `json:"type,omitempty" bson:"type,omitempty"`                            // The type of food (i.e. solid or liquid) product
+	NutritionProduct  *CodeableReference                            `json:"nutritionProduct,omitempty" bson:"nutrition_product,omitempty"`   // A product used for nutritional purposes (e.g. food or supplement)
+	ConsumedItem      []NutritionIntakeNutritionItemConsumedItem    `json:"consumedItem,omitempty" bson:"consumed_item,omitempty"`           // What nutrition item was consumed
+	NotConsumedItem   []NutritionIntakeNutritionItemNotConsumedItem `json:"notConsumedItem,omitempty" bson:"not_consumed_item,omitempty"`    // What nutrition item was not consumed
 }
 
 func (r *NutritionIntakeNutritionItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -166,16 +670,88 @@ func (r *NutritionIntakeNutritionItem) Validate() error {
 	return nil
 }
 
+func (r *NutritionIntakeNutritionItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.NutritionProduct != nil {
+		r.NutritionProduct.validateAll(path+".nutritionProduct", issues)
+	}
+	for i, item := range r.ConsumedItem {
+		item.validateAll(fmt.Sprintf("%s.consumedItem[%d]", path, i), issues)
+	}
+	for i, item := range r.NotConsumedItem {
+		item.validateAll(fmt.Sprintf("%s.notConsumedItem[%d]", path, i), issues)
+	}
+}
+
+var nutritionIntakeNutritionItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionIntakeNutritionItem) invariants() []invariant {
+	return nutritionIntakeNutritionItemInvariants
+}
+
+func (r NutritionIntakeNutritionItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionIntakeNutritionItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionIntakeNutritionItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionIntakeNutritionItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionIntakeNutritionItemElementMetadata = []ElementMetadata{
+	{Path: "NutritionIntake.nutritionItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionIntake.nutritionItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionIntake.nutritionItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.nutritionItem.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionIntake.nutritionItem.nutritionProduct", Name: "nutritionProduct", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem", Name: "consumedItem", Field: 5, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem", Name: "notConsumedItem", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *NutritionIntakeNutritionItem) ElementMetadata() []ElementMetadata {
+	return nutritionIntakeNutritionItemElementMetadata
+}
+
 type NutritionIntakeNutritionItemConsumedItem struct {
-	Id           *string                                               `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Schedule     *Timing                                               `json:"schedule,omitempty" bson:"schedule,omitempty"`          // Scheduled frequency of consumption
-	Amount       *Quantity                                             `json:"amount,omitempty" bson:"amount,omitempty"`              // Quantity of the specified food (i.e. solid and/or liquid)
-	RateQuantity *Quantity                                             `json:"rateQuantity,omitempty" bson:"rate_quantity,omitempty"` // Rate of enteral feeding administration
-	RateRatio    *Ratio                                                `json:"rateRatio,omitempty" bson:"rate_ratio,omitempty"`       // Rate of enteral feeding administration
-	TotalIntake  []NutritionIntakeNutritionItemConsumedItemTotalIntake `json:"totalIntake,omitempty" bson:"total_intake,omitempty"`   // Nutrients and/or energy contained in the intake
+	Id                *string                                               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Schedule          *Timing                                               `json:"schedule,omitempty" bson:"schedule,omitempty"`                    // Scheduled frequency of consumption
+	Amount            *Quantity                                             `json:"amount,omitempty" bson:"amount,omitempty"`                        // Quantity of the specified food (i.e. solid and/or liquid)
+	Rate              NutritionIntakeNutritionItemConsumedItemRate          `json:"-" bson:"rate,omitempty"`                                         // Rate of enteral feeding administration
+	TotalIntake       []NutritionIntakeNutritionItemConsumedItemTotalIntake `json:"totalIntake,omitempty" bson:"total_intake,omitempty"`             // Nutrients and/or energy contained in the intake
+
+	rateVariants []string // JSON properties of NutritionIntake.nutritionItem.consumedItem.rate[x] when more than one was decoded
 }
 
 func (r *NutritionIntakeNutritionItemConsumedItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Schedule != nil {
 		if err := r.Schedule.Validate(); err != nil {
 			return fmt.Errorf("Schedule: %w", err)
@@ -186,14 +762,12 @@ func (r *NutritionIntakeNutritionItemConsumedItem) Validate() error {
 			return fmt.Errorf("Amount: %w", err)
 		}
 	}
-	if r.RateQuantity != nil {
-		if err := r.RateQuantity.Validate(); err != nil {
-			return fmt.Errorf("RateQuantity: %w", err)
-		}
+	if len(r.rateVariants) > 1 {
+		return fmt.Errorf("field 'Rate' must have a single type, got %v", r.rateVariants)
 	}
-	if r.RateRatio != nil {
-		if err := r.RateRatio.Validate(); err != nil {
-			return fmt.Errorf("RateRatio: %w", err)
+	if r.Rate != nil {
+		if err := r.Rate.Validate(); err != nil {
+			return fmt.Errorf("Rate: %w", err)
 		}
 	}
 	for i, item := range r.TotalIntake {
@@ -204,14 +778,183 @@ func (r *NutritionIntakeNutritionItemConsumedItem) Validate() error {
 	return nil
 }
 
+func (r *NutritionIntakeNutritionItemConsumedItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Schedule != nil {
+		r.Schedule.validateAll(path+".schedule", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	if len(r.rateVariants) > 1 {
+		issues.add("structure", path+".rate", fmt.Sprintf("field 'Rate' must have a single type, got %v", r.rateVariants))
+	}
+	if r.Rate != nil {
+		r.Rate.validateAll(path+".rate.ofType("+r.Rate.FHIRType()+")", issues)
+	}
+	for i, item := range r.TotalIntake {
+		item.validateAll(fmt.Sprintf("%s.totalIntake[%d]", path, i), issues)
+	}
+}
+
+var nutritionIntakeNutritionItemConsumedItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItem) invariants() []invariant {
+	return nutritionIntakeNutritionItemConsumedItemInvariants
+}
+
+func (r NutritionIntakeNutritionItemConsumedItem) MarshalJSON() ([]byte, error) {
+	type alias NutritionIntakeNutritionItemConsumedItem
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "rate", r.Rate, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItem) UnmarshalJSON(data []byte) error {
+	type alias NutritionIntakeNutritionItemConsumedItem
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Rate, _, r.rateVariants, err = unmarshalChoice(fields, "rate", nutritionIntakeNutritionItemConsumedItemRateVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r NutritionIntakeNutritionItemConsumedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionIntakeNutritionItemConsumedItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItem) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Rate":
+		return "rate", choiceValues(nutritionIntakeNutritionItemConsumedItemRateVariants)
+	}
+	return "", nil
+}
+
+var nutritionIntakeNutritionItemConsumedItemElementMetadata = []ElementMetadata{
+	{Path: "NutritionIntake.nutritionItem.consumedItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.schedule", Name: "schedule", Field: 3, Min: 0, Max: "1", Types: []string{"Timing"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.amount", Name: "amount", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.rate[x]", Name: "rate", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity", "Ratio"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake", Name: "totalIntake", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItem) ElementMetadata() []ElementMetadata {
+	return nutritionIntakeNutritionItemConsumedItemElementMetadata
+}
+
+// NutritionIntakeNutritionItemConsumedItemRate is the type of NutritionIntake.nutritionItem.consumedItem.rate[x]. It is implemented by the NutritionIntakeNutritionItemConsumedItemRate* variant types.
+type NutritionIntakeNutritionItemConsumedItemRate interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionIntakeNutritionItemConsumedItemRate()
+}
+
+// NutritionIntakeNutritionItemConsumedItemRateQuantity is the Quantity variant of NutritionIntakeNutritionItemConsumedItemRate.
+type NutritionIntakeNutritionItemConsumedItemRateQuantity struct {
+	Quantity
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateQuantity) isNutritionIntakeNutritionItemConsumedItemRate() {
+}
+
+// NutritionIntakeNutritionItemConsumedItemRateRatio is the Ratio variant of NutritionIntakeNutritionItemConsumedItemRate.
+type NutritionIntakeNutritionItemConsumedItemRateRatio struct {
+	Ratio
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v NutritionIntakeNutritionItemConsumedItemRateRatio) isNutritionIntakeNutritionItemConsumedItemRate() {
+}
+
+var nutritionIntakeNutritionItemConsumedItemRateVariants = []NutritionIntakeNutritionItemConsumedItemRate{
+	NutritionIntakeNutritionItemConsumedItemRateQuantity{},
+	NutritionIntakeNutritionItemConsumedItemRateRatio{},
+}
+
 type NutritionIntakeNutritionItemConsumedItemTotalIntake struct {
-	Id       *string            `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Nutrient *CodeableReference `json:"nutrient" bson:"nutrient"`                 // Type of nutrient consumed in the intake
-	Amount   *Quantity          `json:"amount" bson:"amount"`                     // Total amount of nutrient consumed
-	Energy   *Quantity          `json:"energy,omitempty" bson:"energy,omitempty"` // Total energy consumed in kilocalories or kilojoules
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Nutrient          *CodeableReference `json:"nutrient" bson:"nutrient"`                                        // Type of nutrient consumed in the intake
+	Amount            *Quantity          `json:"amount" bson:"amount"`                                            // Total amount of nutrient consumed
+	Energy            *Quantity          `json:"energy,omitempty" bson:"energy,omitempty"`                        // Total energy consumed in kilocalories or kilojoules
 }
 
 func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Nutrient == nil {
 		return fmt.Errorf("field 'Nutrient' is required")
 	}
@@ -236,14 +979,87 @@ func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) Validate() error {
 	return nil
 }
 
+func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Nutrient == nil {
+		issues.add("required", path+".nutrient", "field 'Nutrient' is required")
+	}
+	if r.Nutrient != nil {
+		r.Nutrient.validateAll(path+".nutrient", issues)
+	}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	if r.Energy != nil {
+		r.Energy.validateAll(path+".energy", issues)
+	}
+}
+
+var nutritionIntakeNutritionItemConsumedItemTotalIntakeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) invariants() []invariant {
+	return nutritionIntakeNutritionItemConsumedItemTotalIntakeInvariants
+}
+
+func (r NutritionIntakeNutritionItemConsumedItemTotalIntake) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionIntakeNutritionItemConsumedItemTotalIntake) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionIntakeNutritionItemConsumedItemTotalIntakeElementMetadata = []ElementMetadata{
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake.nutrient", Name: "nutrient", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake.amount", Name: "amount", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionIntake.nutritionItem.consumedItem.totalIntake.energy", Name: "energy", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *NutritionIntakeNutritionItemConsumedItemTotalIntake) ElementMetadata() []ElementMetadata {
+	return nutritionIntakeNutritionItemConsumedItemTotalIntakeElementMetadata
+}
+
 type NutritionIntakeNutritionItemNotConsumedItem struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Reason   *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`     // Reason the nutrition item was not consumed
-	Schedule *Timing          `json:"schedule,omitempty" bson:"schedule,omitempty"` // The intended frequency of consumption that was not followed
-	Amount   *Quantity        `json:"amount,omitempty" bson:"amount,omitempty"`     // Quantity of the specified food (i.e. solid and/or liquid) that was not consumed
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reason            *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // Reason the nutrition item was not consumed
+	Schedule          *Timing          `json:"schedule,omitempty" bson:"schedule,omitempty"`                    // The intended frequency of consumption that was not followed
+	Amount            *Quantity        `json:"amount,omitempty" bson:"amount,omitempty"`                        // Quantity of the specified food (i.e. solid and/or liquid) that was not consumed
 }
 
 func (r *NutritionIntakeNutritionItemNotConsumedItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Reason != nil {
 		if err := r.Reason.Validate(); err != nil {
 			return fmt.Errorf("Reason: %w", err)
@@ -262,13 +1078,80 @@ func (r *NutritionIntakeNutritionItemNotConsumedItem) Validate() error {
 	return nil
 }
 
+func (r *NutritionIntakeNutritionItemNotConsumedItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+	if r.Schedule != nil {
+		r.Schedule.validateAll(path+".schedule", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+var nutritionIntakeNutritionItemNotConsumedItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionIntakeNutritionItemNotConsumedItem) invariants() []invariant {
+	return nutritionIntakeNutritionItemNotConsumedItemInvariants
+}
+
+func (r NutritionIntakeNutritionItemNotConsumedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionIntakeNutritionItemNotConsumedItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionIntakeNutritionItemNotConsumedItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionIntakeNutritionItemNotConsumedItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionIntakeNutritionItemNotConsumedItemElementMetadata = []ElementMetadata{
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem.reason", Name: "reason", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem.schedule", Name: "schedule", Field: 4, Min: 0, Max: "1", Types: []string{"Timing"}},
+	{Path: "NutritionIntake.nutritionItem.notConsumedItem.amount", Name: "amount", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *NutritionIntakeNutritionItemNotConsumedItem) ElementMetadata() []ElementMetadata {
+	return nutritionIntakeNutritionItemNotConsumedItemElementMetadata
+}
+
 type NutritionIntakePerformer struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Type of performer
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Who or what performed the intake
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performer
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Who or what performed the intake
 }
 
 func (r *NutritionIntakePerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -284,3 +1167,57 @@ func (r *NutritionIntakePerformer) Validate() error {
 	}
 	return nil
 }
+
+func (r *NutritionIntakePerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+}
+
+var nutritionIntakePerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionIntakePerformer) invariants() []invariant {
+	return nutritionIntakePerformerInvariants
+}
+
+func (r NutritionIntakePerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionIntakePerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionIntakePerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionIntakePerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionIntakePerformerElementMetadata = []ElementMetadata{
+	{Path: "NutritionIntake.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionIntake.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionIntake.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionIntake.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionIntake.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *NutritionIntakePerformer) ElementMetadata() []ElementMetadata {
+	return nutritionIntakePerformerElementMetadata
+}
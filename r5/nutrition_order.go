@@ -2,41 +2,57 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A request to supply a diet, formula feeding (enteral) or oral nutritional supplement to an individual or group.
 type NutritionOrder struct {
-	ResourceType           string                        `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                       `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                         `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                       `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                    `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage             `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Identifier             []Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Identifiers assigned to this order
-	BasedOn                []Reference                   `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                // What this order fulfills
-	GroupIdentifier        *Identifier                   `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`                // Composite Request ID
-	Status                 string                        `json:"status" bson:"status"`                                                       // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
-	Intent                 string                        `json:"intent" bson:"intent"`                                                       // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Priority               *string                       `json:"priority,omitempty" bson:"priority,omitempty"`                               // routine | urgent | asap | stat
-	Subject                *Reference                    `json:"subject" bson:"subject"`                                                     // Who requires the diet, formula or nutritional supplement
-	Encounter              *Reference                    `json:"encounter,omitempty" bson:"encounter,omitempty"`                             // The encounter associated with this nutrition order
-	SupportingInformation  []Reference                   `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`    // Information to support fulfilling of the nutrition order
-	DateTime               string                        `json:"dateTime" bson:"date_time"`                                                  // Date and time the nutrition order was requested
-	Requester              *Reference                    `json:"requester,omitempty" bson:"requester,omitempty"`                             // Who ordered the diet, formula or nutritional supplement
-	Performer              []CodeableReference           `json:"performer,omitempty" bson:"performer,omitempty"`                             // Who is intended to perform the administration of the nutrition order
-	AllergyIntolerance     []Reference                   `json:"allergyIntolerance,omitempty" bson:"allergy_intolerance,omitempty"`          // List of the patient's food and nutrition-related allergies and intolerances
-	FoodPreferenceModifier []CodeableConcept             `json:"foodPreferenceModifier,omitempty" bson:"food_preference_modifier,omitempty"` // Order-specific modifier about the type of food that should be given
-	ExcludeFoodModifier    []CodeableConcept             `json:"excludeFoodModifier,omitempty" bson:"exclude_food_modifier,omitempty"`       // Food that should not be given
-	OutsideFoodAllowed     *bool                         `json:"outsideFoodAllowed,omitempty" bson:"outside_food_allowed,omitempty"`         // Capture if patient is permitted to consume food from outside of current setting brought by the patient, family, and/or caregiver
-	OralDiet               *NutritionOrderOralDiet       `json:"oralDiet,omitempty" bson:"oral_diet,omitempty"`                              // Oral diet components
-	Supplement             []NutritionOrderSupplement    `json:"supplement,omitempty" bson:"supplement,omitempty"`                           // Supplement components
-	EnteralFormula         *NutritionOrderEnteralFormula `json:"enteralFormula,omitempty" bson:"enteral_formula,omitempty"`                  // Enteral formula product
-	Additive               []NutritionOrderAdditive      `json:"additive,omitempty" bson:"additive,omitempty"`                               // Modular additive to add to the oral diet, supplement, and/or enteral feeding
-	Note                   []Annotation                  `json:"note,omitempty" bson:"note,omitempty"`                                       // Comments
+	ResourceType              string                        `json:"resourceType" bson:"resource_type"`                                           // Type of resource
+	Id                        *string                       `json:"id,omitempty" bson:"id,omitempty"`                                            // Logical id of this artifact
+	Meta                      *Meta                         `json:"meta,omitempty" bson:"meta,omitempty"`                                        // Metadata about the resource
+	ImplicitRules             *string                       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                     // A set of rules under which this content was created
+	ImplicitRulesElement      *Element                      `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`            // Extensions for implicitRules
+	Language                  *string                       `json:"language,omitempty" bson:"language,omitempty"`                                // Language of the resource content
+	LanguageElement           *Element                      `json:"_language,omitempty" bson:"language_element,omitempty"`                       // Extensions for language
+	Text                      *Narrative                    `json:"text,omitempty" bson:"text,omitempty"`                                        // Text summary of the resource, for human interpretation
+	Contained                 []Resource                    `json:"contained,omitempty" bson:"contained,omitempty"`                              // Contained, inline Resources
+	Extension                 []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                              // Additional content defined by implementations
+	ModifierExtension         []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`             // Extensions that cannot be ignored
+	Identifier                []Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`                            // Identifiers assigned to this order
+	BasedOn                   []Reference                   `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                 // What this order fulfills
+	GroupIdentifier           *Identifier                   `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`                 // Composite Request ID
+	Status                    RequestStatus                 `json:"status" bson:"status"`                                                        // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
+	StatusElement             *Element                      `json:"_status,omitempty" bson:"status_element,omitempty"`                           // Extensions for status
+	Intent                    CarePlanIntent                `json:"intent" bson:"intent"`                                                        // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement             *Element                      `json:"_intent,omitempty" bson:"intent_element,omitempty"`                           // Extensions for intent
+	Priority                  *RequestPriority              `json:"priority,omitempty" bson:"priority,omitempty"`                                // routine | urgent | asap | stat
+	PriorityElement           *Element                      `json:"_priority,omitempty" bson:"priority_element,omitempty"`                       // Extensions for priority
+	Subject                   *Reference                    `json:"subject" bson:"subject"`                                                      // Who requires the diet, formula or nutritional supplement
+	Encounter                 *Reference                    `json:"encounter,omitempty" bson:"encounter,omitempty"`                              // The encounter associated with this nutrition order
+	SupportingInformation     []Reference                   `json:"supportingInformation,omitempty" bson:"supporting_information,omitempty"`     // Information to support fulfilling of the nutrition order
+	DateTime                  *DateTime                     `json:"dateTime" bson:"date_time"`                                                   // Date and time the nutrition order was requested
+	DateTimeElement           *Element                      `json:"_dateTime,omitempty" bson:"date_time_element,omitempty"`                      // Extensions for dateTime
+	Requester                 *Reference                    `json:"requester,omitempty" bson:"requester,omitempty"`                              // Who ordered the diet, formula or nutritional supplement
+	Performer                 []CodeableReference           `json:"performer,omitempty" bson:"performer,omitempty"`                              // Who is intended to perform the administration of the nutrition order
+	AllergyIntolerance        []Reference                   `json:"allergyIntolerance,omitempty" bson:"allergy_intolerance,omitempty"`           // List of the patient's food and nutrition-related allergies and intolerances
+	FoodPreferenceModifier    []CodeableConcept             `json:"foodPreferenceModifier,omitempty" bson:"food_preference_modifier,omitempty"`  // Order-specific modifier about the type of food that should be given
+	ExcludeFoodModifier       []CodeableConcept             `json:"excludeFoodModifier,omitempty" bson:"exclude_food_modifier,omitempty"`        // Food that should not be given
+	OutsideFoodAllowed        *bool                         `json:"outsideFoodAllowed,omitempty" bson:"outside_food_allowed,omitempty"`          // Capture if patient is permitted to consume food from outside of current setting brought by the patient, family, and/or caregiver
+	OutsideFoodAllowedElement *Element                      `json:"_outsideFoodAllowed,omitempty" bson:"outside_food_allowed_element,omitempty"` // Extensions for outsideFoodAllowed
+	OralDiet                  *NutritionOrderOralDiet       `json:"oralDiet,omitempty" bson:"oral_diet,omitempty"`                               // Oral diet components
+	Supplement                []NutritionOrderSupplement    `json:"supplement,omitempty" bson:"supplement,omitempty"`                            // Supplement components
+	EnteralFormula            *NutritionOrderEnteralFormula `json:"enteralFormula,omitempty" bson:"enteral_formula,omitempty"`                   // Enteral formula product
+	Additive                  []NutritionOrderAdditive      `json:"additive,omitempty" bson:"additive,omitempty"`                                // Modular additive to add to the oral diet, supplement, and/or enteral feeding
+	Note                      []Annotation                  `json:"note,omitempty" bson:"note,omitempty"`                                        // Comments
 }
 
+var nutritionOrderSubjectTargets = []string{"Group", "Patient"}
+
+var nutritionOrderEncounterTargets = []string{"Encounter"}
+
+var nutritionOrderRequesterTargets = []string{"Practitioner", "PractitionerRole"}
+
 func (r *NutritionOrder) Validate() error {
 	if r.ResourceType != "NutritionOrder" {
 		return fmt.Errorf("invalid resourceType: expected 'NutritionOrder', got '%s'", r.ResourceType)
@@ -46,11 +62,39 @@ func (r *NutritionOrder) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -66,21 +110,54 @@ func (r *NutritionOrder) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Intent == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, nutritionOrderSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, nutritionOrderSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, nutritionOrderEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, nutritionOrderEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -91,9 +168,24 @@ func (r *NutritionOrder) Validate() error {
 			return fmt.Errorf("SupportingInformation[%d]: %w", i, err)
 		}
 	}
-	if r.DateTime == emptyString {
+	if r.DateTime == nil {
 		return fmt.Errorf("field 'DateTime' is required")
 	}
+	if r.DateTime != nil {
+		if err := r.DateTime.Validate(); err != nil {
+			return fmt.Errorf("DateTime: %w", err)
+		}
+	}
+	if r.DateTimeElement != nil {
+		if err := r.DateTimeElement.Validate(); err != nil {
+			return fmt.Errorf("DateTimeElement: %w", err)
+		}
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, nutritionOrderRequesterTargets); target != "" {
+			return fmt.Errorf("field 'Requester' cannot refer to %s, expected one of %v", target, nutritionOrderRequesterTargets)
+		}
+	}
 	if r.Requester != nil {
 		if err := r.Requester.Validate(); err != nil {
 			return fmt.Errorf("Requester: %w", err)
@@ -119,6 +211,11 @@ func (r *NutritionOrder) Validate() error {
 			return fmt.Errorf("ExcludeFoodModifier[%d]: %w", i, err)
 		}
 	}
+	if r.OutsideFoodAllowedElement != nil {
+		if err := r.OutsideFoodAllowedElement.Validate(); err != nil {
+			return fmt.Errorf("OutsideFoodAllowedElement: %w", err)
+		}
+	}
 	if r.OralDiet != nil {
 		if err := r.OralDiet.Validate(); err != nil {
 			return fmt.Errorf("OralDiet: %w", err)
@@ -144,187 +241,309 @@ func (r *NutritionOrder) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type NutritionOrderOralDietNutrient struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Modifier *CodeableConcept `json:"modifier,omitempty" bson:"modifier,omitempty"` // Nutrient modified in the oral diet type
-	Amount   *Quantity        `json:"amount,omitempty" bson:"amount,omitempty"`     // Quantity of the specified nutrient
+	return validateInvariants(r, "NutritionOrder")
 }
 
-func (r *NutritionOrderOralDietNutrient) Validate() error {
-	if r.Modifier != nil {
-		if err := r.Modifier.Validate(); err != nil {
-			return fmt.Errorf("Modifier: %w", err)
-		}
+func (r *NutritionOrder) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "NutritionOrder" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'NutritionOrder', got '%s'", r.ResourceType))
 	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	return nil
-}
-
-type NutritionOrderOralDietTexture struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Modifier *CodeableConcept `json:"modifier,omitempty" bson:"modifier,omitempty"` // Food (i.e. solid and/or liquid) texture modifications in addition to those in the oral diet type
-	Type     *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`         // Food (i.e. solid and/or liquid) types that undergo texture alteration
-}
-
-func (r *NutritionOrderOralDietTexture) Validate() error {
-	if r.Modifier != nil {
-		if err := r.Modifier.Validate(); err != nil {
-			return fmt.Errorf("Modifier: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	return nil
-}
-
-type NutritionOrderEnteralFormula struct {
-	Id                            *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                                          // Unique id for inter-element referencing
-	Type                          *CodeableReference                           `json:"type,omitempty" bson:"type,omitempty"`                                                      // Type of patient enteral feeding
-	ProductName                   *string                                      `json:"productName,omitempty" bson:"product_name,omitempty"`                                       // Product or brand name of the enteral feeding
-	DeliveryDeviceCodeableConcept *CodeableConcept                             `json:"deliveryDeviceCodeableConcept,omitempty" bson:"delivery_device_codeable_concept,omitempty"` // Intended type of device for the enteral feeding administration
-	DeliveryDeviceCanonical       *string                                      `json:"deliveryDeviceCanonical,omitempty" bson:"delivery_device_canonical,omitempty"`              // Intended type of device for the enteral feeding administration
-	CaloricDensity                *Quantity                                    `json:"caloricDensity,omitempty" bson:"caloric_density,omitempty"`                                 // Amount of energy per specified volume of feeding that is required
-	RouteOfAdministration         []CodeableConcept                            `json:"routeOfAdministration,omitempty" bson:"route_of_administration,omitempty"`                  // How the enteral feeding should enter the patient's gastrointestinal tract
-	Administration                []NutritionOrderEnteralFormulaAdministration `json:"administration,omitempty" bson:"administration,omitempty"`                                  // Formula feeding instruction as structured data
-	MaxVolumeToAdminister         *Quantity                                    `json:"maxVolumeToAdminister,omitempty" bson:"max_volume_to_administer,omitempty"`                 // Upper limit on formula feeding volume per unit of time
-	AdministrationInstruction     *string                                      `json:"administrationInstruction,omitempty" bson:"administration_instruction,omitempty"`           // Formula feeding instructions expressed as text
-}
-
-func (r *NutritionOrderEnteralFormula) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.DeliveryDeviceCodeableConcept != nil {
-		if err := r.DeliveryDeviceCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DeliveryDeviceCodeableConcept: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.CaloricDensity != nil {
-		if err := r.CaloricDensity.Validate(); err != nil {
-			return fmt.Errorf("CaloricDensity: %w", err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.RouteOfAdministration {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("RouteOfAdministration[%d]: %w", i, err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	for i, item := range r.Administration {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Administration[%d]: %w", i, err)
-		}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.MaxVolumeToAdminister != nil {
-		if err := r.MaxVolumeToAdminister.Validate(); err != nil {
-			return fmt.Errorf("MaxVolumeToAdminister: %w", err)
-		}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type NutritionOrderEnteralFormulaAdministration struct {
-	Id           *string                                             `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Schedule     *NutritionOrderEnteralFormulaAdministrationSchedule `json:"schedule,omitempty" bson:"schedule,omitempty"`          // Scheduling information for enteral feeding products
-	Quantity     *Quantity                                           `json:"quantity,omitempty" bson:"quantity,omitempty"`          // The volume of formula feeding to provide
-	RateQuantity *Quantity                                           `json:"rateQuantity,omitempty" bson:"rate_quantity,omitempty"` // Speed with which the formula feeding is provided per period of time
-	RateRatio    *Ratio                                              `json:"rateRatio,omitempty" bson:"rate_ratio,omitempty"`       // Speed with which the formula feeding is provided per period of time
-}
-
-func (r *NutritionOrderEnteralFormulaAdministration) Validate() error {
-	if r.Schedule != nil {
-		if err := r.Schedule.Validate(); err != nil {
-			return fmt.Errorf("Schedule: %w", err)
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, nutritionOrderSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, nutritionOrderSubjectTargets))
 		}
 	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, nutritionOrderEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, nutritionOrderEncounterTargets))
 		}
 	}
-	if r.RateQuantity != nil {
-		if err := r.RateQuantity.Validate(); err != nil {
-			return fmt.Errorf("RateQuantity: %w", err)
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	for i, item := range r.SupportingInformation {
+		item.validateAll(fmt.Sprintf("%s.supportingInformation[%d]", path, i), issues)
+	}
+	if r.DateTime == nil {
+		issues.add("required", path+".dateTime", "field 'DateTime' is required")
+	}
+	if r.DateTime != nil {
+		if err := r.DateTime.Validate(); err != nil {
+			issues.add("value", path+".dateTime", err.Error())
 		}
 	}
-	if r.RateRatio != nil {
-		if err := r.RateRatio.Validate(); err != nil {
-			return fmt.Errorf("RateRatio: %w", err)
+	if r.DateTimeElement != nil {
+		r.DateTimeElement.validateAll(path+".dateTime", issues)
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, nutritionOrderRequesterTargets); target != "" {
+			issues.add("value", path+".requester", fmt.Sprintf("field 'Requester' cannot refer to %s, expected one of %v", target, nutritionOrderRequesterTargets))
 		}
 	}
-	return nil
+	if r.Requester != nil {
+		r.Requester.validateAll(path+".requester", issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.AllergyIntolerance {
+		item.validateAll(fmt.Sprintf("%s.allergyIntolerance[%d]", path, i), issues)
+	}
+	for i, item := range r.FoodPreferenceModifier {
+		item.validateAll(fmt.Sprintf("%s.foodPreferenceModifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ExcludeFoodModifier {
+		item.validateAll(fmt.Sprintf("%s.excludeFoodModifier[%d]", path, i), issues)
+	}
+	if r.OutsideFoodAllowedElement != nil {
+		r.OutsideFoodAllowedElement.validateAll(path+".outsideFoodAllowed", issues)
+	}
+	if r.OralDiet != nil {
+		r.OralDiet.validateAll(path+".oralDiet", issues)
+	}
+	for i, item := range r.Supplement {
+		item.validateAll(fmt.Sprintf("%s.supplement[%d]", path, i), issues)
+	}
+	if r.EnteralFormula != nil {
+		r.EnteralFormula.validateAll(path+".enteralFormula", issues)
+	}
+	for i, item := range r.Additive {
+		item.validateAll(fmt.Sprintf("%s.additive[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
 }
 
-type NutritionOrderEnteralFormulaAdministrationSchedule struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Timing      []Timing         `json:"timing,omitempty" bson:"timing,omitempty"`             // Scheduled frequency of enteral feeding
-	AsNeeded    *bool            `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`        // Take 'as needed'
-	AsNeededFor *CodeableConcept `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"` // Take 'as needed' for x
+func (r *NutritionOrder) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("NutritionOrder", &issues)
+	checkInvariants(r, "NutritionOrder", &issues)
+	return issues
 }
 
-func (r *NutritionOrderEnteralFormulaAdministrationSchedule) Validate() error {
-	for i, item := range r.Timing {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Timing[%d]: %w", i, err)
-		}
+var nutritionOrderInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *NutritionOrder) invariants() []invariant {
+	return nutritionOrderInvariants
+}
+
+func (r *NutritionOrder) UnmarshalJSON(data []byte) error {
+	type alias NutritionOrder
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.AsNeededFor != nil {
-		if err := r.AsNeededFor.Validate(); err != nil {
-			return fmt.Errorf("AsNeededFor: %w", err)
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
-type NutritionOrderAdditive struct {
-	Id                    *string            `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	ModularType           *CodeableReference `json:"modularType,omitempty" bson:"modular_type,omitempty"`                      // Type of modular component to add to the oral diet, supplement, and/or enteral feeding
-	ProductName           *string            `json:"productName,omitempty" bson:"product_name,omitempty"`                      // Product or brand name of the modular additive
-	Quantity              *Quantity          `json:"quantity,omitempty" bson:"quantity,omitempty"`                             // Amount of additive to be given or mixed in with the oral diet, supplement, and/or enteral feeding
-	RouteOfAdministration []CodeableConcept  `json:"routeOfAdministration,omitempty" bson:"route_of_administration,omitempty"` // How the additive should enter the patient's gastrointestinal tract
+func (r NutritionOrder) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *NutritionOrderAdditive) Validate() error {
-	if r.ModularType != nil {
-		if err := r.ModularType.Validate(); err != nil {
-			return fmt.Errorf("ModularType: %w", err)
-		}
-	}
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+func (r *NutritionOrder) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrder) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrder) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *NutritionOrder) elementTypes() map[string]string {
+	return nutritionOrderElementTypes
+}
+
+var nutritionOrderElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "NutritionOrder.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "NutritionOrder.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionOrder.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "NutritionOrder.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "NutritionOrder.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "NutritionOrder.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "NutritionOrder.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionOrder.groupIdentifier", Name: "groupIdentifier", Field: 13, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "NutritionOrder.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionOrder.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"}},
+	{Path: "NutritionOrder.intent", Name: "intent", Field: 16, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionOrder.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"}},
+	{Path: "NutritionOrder.priority", Name: "priority", Field: 18, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionOrder.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "NutritionOrder.subject", Name: "subject", Field: 20, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "NutritionOrder.encounter", Name: "encounter", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "NutritionOrder.supportingInformation", Name: "supportingInformation", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionOrder.dateTime", Name: "dateTime", Field: 23, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "NutritionOrder.requester", Name: "requester", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "NutritionOrder.performer", Name: "performer", Field: 26, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "NutritionOrder.allergyIntolerance", Name: "allergyIntolerance", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionOrder.foodPreferenceModifier", Name: "foodPreferenceModifier", Field: 28, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionOrder.excludeFoodModifier", Name: "excludeFoodModifier", Field: 29, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionOrder.outsideFoodAllowed", Name: "outsideFoodAllowed", Field: 30, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "NutritionOrder.oralDiet", Name: "oralDiet", Field: 32, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.supplement", Name: "supplement", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.enteralFormula", Name: "enteralFormula", Field: 34, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.additive", Name: "additive", Field: 35, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.note", Name: "note", Field: 36, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *NutritionOrder) ElementMetadata() []ElementMetadata {
+	return nutritionOrderElementMetadata
+}
+
+func (r *NutritionOrder) GetResourceType() string {
+	return "NutritionOrder"
+}
+
+func (r *NutritionOrder) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	for i, item := range r.RouteOfAdministration {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("RouteOfAdministration[%d]: %w", i, err)
-		}
+	return *r.Id
+}
+
+func (r *NutritionOrder) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
+}
+
+func (r *NutritionOrder) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *NutritionOrder) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *NutritionOrder) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *NutritionOrder) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *NutritionOrder) GetExtension() []Extension {
+	return r.Extension
 }
 
+var _ DomainResource = (*NutritionOrder)(nil)
+
 type NutritionOrderOralDiet struct {
-	Id             *string                          `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Type           []CodeableConcept                `json:"type,omitempty" bson:"type,omitempty"`                      // Type of oral diet or diet restrictions that can be consumed orally
-	Schedule       *NutritionOrderOralDietSchedule  `json:"schedule,omitempty" bson:"schedule,omitempty"`              // Scheduling information for oral diets
-	Nutrient       []NutritionOrderOralDietNutrient `json:"nutrient,omitempty" bson:"nutrient,omitempty"`              // The nutrient that is modified and the quantity in the diet
-	Texture        []NutritionOrderOralDietTexture  `json:"texture,omitempty" bson:"texture,omitempty"`                // Texture modifications in addition to the oral diet type
-	Instruction    *string                          `json:"instruction,omitempty" bson:"instruction,omitempty"`        // Instructions or additional information about the oral diet
-	CaloricDensity *Quantity                        `json:"caloricDensity,omitempty" bson:"caloric_density,omitempty"` // Amount of energy per specified volume of oral diet
+	Id                 *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type               []CodeableConcept                `json:"type,omitempty" bson:"type,omitempty"`                            // Type of oral diet or diet restrictions that can be consumed orally
+	Schedule           *NutritionOrderOralDietSchedule  `json:"schedule,omitempty" bson:"schedule,omitempty"`                    // Scheduling information for oral diets
+	Nutrient           []NutritionOrderOralDietNutrient `json:"nutrient,omitempty" bson:"nutrient,omitempty"`                    // The nutrient that is modified and the quantity in the diet
+	Texture            []NutritionOrderOralDietTexture  `json:"texture,omitempty" bson:"texture,omitempty"`                      // Texture modifications in addition to the oral diet type
+	Instruction        *string                          `json:"instruction,omitempty" bson:"instruction,omitempty"`              // Instructions or additional information about the oral diet
+	InstructionElement *Element                         `json:"_instruction,omitempty" bson:"instruction_element,omitempty"`     // Extensions for instruction
+	CaloricDensity     *Quantity                        `json:"caloricDensity,omitempty" bson:"caloric_density,omitempty"`       // Amount of energy per specified volume of oral diet
 }
 
 func (r *NutritionOrderOralDiet) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
@@ -345,6 +564,11 @@ func (r *NutritionOrderOralDiet) Validate() error {
 			return fmt.Errorf("Texture[%d]: %w", i, err)
 		}
 	}
+	if r.InstructionElement != nil {
+		if err := r.InstructionElement.Validate(); err != nil {
+			return fmt.Errorf("InstructionElement: %w", err)
+		}
+	}
 	if r.CaloricDensity != nil {
 		if err := r.CaloricDensity.Validate(); err != nil {
 			return fmt.Errorf("CaloricDensity: %w", err)
@@ -353,43 +577,368 @@ func (r *NutritionOrderOralDiet) Validate() error {
 	return nil
 }
 
-type NutritionOrderOralDietSchedule struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Timing      []Timing         `json:"timing,omitempty" bson:"timing,omitempty"`             // Scheduled frequency of diet
-	AsNeeded    *bool            `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`        // Take 'as needed'
-	AsNeededFor *CodeableConcept `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"` // Take 'as needed' for x
-}
-
-func (r *NutritionOrderOralDietSchedule) Validate() error {
-	for i, item := range r.Timing {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Timing[%d]: %w", i, err)
-		}
+func (r *NutritionOrderOralDiet) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.AsNeededFor != nil {
-		if err := r.AsNeededFor.Validate(); err != nil {
-			return fmt.Errorf("AsNeededFor: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.Schedule != nil {
+		r.Schedule.validateAll(path+".schedule", issues)
+	}
+	for i, item := range r.Nutrient {
+		item.validateAll(fmt.Sprintf("%s.nutrient[%d]", path, i), issues)
+	}
+	for i, item := range r.Texture {
+		item.validateAll(fmt.Sprintf("%s.texture[%d]", path, i), issues)
+	}
+	if r.InstructionElement != nil {
+		r.InstructionElement.validateAll(path+".instruction", issues)
+	}
+	if r.CaloricDensity != nil {
+		r.CaloricDensity.validateAll(path+".caloricDensity", issues)
 	}
-	return nil
 }
 
-type NutritionOrderSupplement struct {
-	Id             *string                           `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Type           *CodeableReference                `json:"type,omitempty" bson:"type,omitempty"`                      // Type of supplement product requested
-	ProductName    *string                           `json:"productName,omitempty" bson:"product_name,omitempty"`       // Product or brand name of the nutritional supplement
-	Schedule       *NutritionOrderSupplementSchedule `json:"schedule,omitempty" bson:"schedule,omitempty"`              // Scheduling information for supplements
-	Quantity       *Quantity                         `json:"quantity,omitempty" bson:"quantity,omitempty"`              // Amount of the nutritional supplement
-	Instruction    *string                           `json:"instruction,omitempty" bson:"instruction,omitempty"`        // Instructions or additional information about the oral supplement
-	CaloricDensity *Quantity                         `json:"caloricDensity,omitempty" bson:"caloric_density,omitempty"` // Amount of energy per specified volume of supplement that is required
+var nutritionOrderOralDietInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *NutritionOrderSupplement) Validate() error {
+func (r *NutritionOrderOralDiet) invariants() []invariant {
+	return nutritionOrderOralDietInvariants
+}
+
+func (r NutritionOrderOralDiet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderOralDiet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderOralDiet) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderOralDiet) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderOralDietElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.oralDiet.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.oralDiet.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.oralDiet.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.oralDiet.type", Name: "type", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionOrder.oralDiet.schedule", Name: "schedule", Field: 4, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.oralDiet.nutrient", Name: "nutrient", Field: 5, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.oralDiet.texture", Name: "texture", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.oralDiet.instruction", Name: "instruction", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.oralDiet.caloricDensity", Name: "caloricDensity", Field: 9, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *NutritionOrderOralDiet) ElementMetadata() []ElementMetadata {
+	return nutritionOrderOralDietElementMetadata
+}
+
+type NutritionOrderOralDietSchedule struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Timing            []Timing         `json:"timing,omitempty" bson:"timing,omitempty"`                        // Scheduled frequency of diet
+	AsNeeded          *bool            `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                   // Take 'as needed'
+	AsNeededElement   *Element         `json:"_asNeeded,omitempty" bson:"as_needed_element,omitempty"`          // Extensions for asNeeded
+	AsNeededFor       *CodeableConcept `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`            // Take 'as needed' for x
+}
+
+func (r *NutritionOrderOralDietSchedule) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Timing {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Timing[%d]: %w", i, err)
+		}
+	}
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
+		}
+	}
+	if r.AsNeededFor != nil {
+		if err := r.AsNeededFor.Validate(); err != nil {
+			return fmt.Errorf("AsNeededFor: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderOralDietSchedule) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Timing {
+		item.validateAll(fmt.Sprintf("%s.timing[%d]", path, i), issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
+	if r.AsNeededFor != nil {
+		r.AsNeededFor.validateAll(path+".asNeededFor", issues)
+	}
+}
+
+var nutritionOrderOralDietScheduleInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderOralDietSchedule) invariants() []invariant {
+	return nutritionOrderOralDietScheduleInvariants
+}
+
+func (r NutritionOrderOralDietSchedule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderOralDietSchedule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderOralDietSchedule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderOralDietSchedule) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderOralDietScheduleElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.oralDiet.schedule.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.oralDiet.schedule.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.oralDiet.schedule.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.oralDiet.schedule.timing", Name: "timing", Field: 3, Min: 0, Max: "*", Types: []string{"Timing"}},
+	{Path: "NutritionOrder.oralDiet.schedule.asNeeded", Name: "asNeeded", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "NutritionOrder.oralDiet.schedule.asNeededFor", Name: "asNeededFor", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *NutritionOrderOralDietSchedule) ElementMetadata() []ElementMetadata {
+	return nutritionOrderOralDietScheduleElementMetadata
+}
+
+type NutritionOrderOralDietNutrient struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Modifier          *CodeableConcept `json:"modifier,omitempty" bson:"modifier,omitempty"`                    // Nutrient modified in the oral diet type
+	Amount            *Quantity        `json:"amount,omitempty" bson:"amount,omitempty"`                        // Quantity of the specified nutrient
+}
+
+func (r *NutritionOrderOralDietNutrient) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Modifier != nil {
+		if err := r.Modifier.Validate(); err != nil {
+			return fmt.Errorf("Modifier: %w", err)
+		}
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderOralDietNutrient) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Modifier != nil {
+		r.Modifier.validateAll(path+".modifier", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+var nutritionOrderOralDietNutrientInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderOralDietNutrient) invariants() []invariant {
+	return nutritionOrderOralDietNutrientInvariants
+}
+
+func (r NutritionOrderOralDietNutrient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderOralDietNutrient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderOralDietNutrient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderOralDietNutrient) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderOralDietNutrientElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.oralDiet.nutrient.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.oralDiet.nutrient.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.oralDiet.nutrient.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.oralDiet.nutrient.modifier", Name: "modifier", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionOrder.oralDiet.nutrient.amount", Name: "amount", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *NutritionOrderOralDietNutrient) ElementMetadata() []ElementMetadata {
+	return nutritionOrderOralDietNutrientElementMetadata
+}
+
+type NutritionOrderOralDietTexture struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Modifier          *CodeableConcept `json:"modifier,omitempty" bson:"modifier,omitempty"`                    // Food (i.e. solid and/or liquid) texture modifications in addition to those in the oral diet type
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Food (i.e. solid and/or liquid) types that undergo texture alteration
+}
+
+func (r *NutritionOrderOralDietTexture) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Modifier != nil {
+		if err := r.Modifier.Validate(); err != nil {
+			return fmt.Errorf("Modifier: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderOralDietTexture) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Modifier != nil {
+		r.Modifier.validateAll(path+".modifier", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+}
+
+var nutritionOrderOralDietTextureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderOralDietTexture) invariants() []invariant {
+	return nutritionOrderOralDietTextureInvariants
+}
+
+func (r NutritionOrderOralDietTexture) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderOralDietTexture) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderOralDietTexture) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderOralDietTexture) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderOralDietTextureElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.oralDiet.texture.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.oralDiet.texture.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.oralDiet.texture.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.oralDiet.texture.modifier", Name: "modifier", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionOrder.oralDiet.texture.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *NutritionOrderOralDietTexture) ElementMetadata() []ElementMetadata {
+	return nutritionOrderOralDietTextureElementMetadata
+}
+
+type NutritionOrderSupplement struct {
+	Id                 *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type               *CodeableReference                `json:"type,omitempty" bson:"type,omitempty"`                            // Type of supplement product requested
+	ProductName        *string                           `json:"productName,omitempty" bson:"product_name,omitempty"`             // Product or brand name of the nutritional supplement
+	ProductNameElement *Element                          `json:"_productName,omitempty" bson:"product_name_element,omitempty"`    // Extensions for productName
+	Schedule           *NutritionOrderSupplementSchedule `json:"schedule,omitempty" bson:"schedule,omitempty"`                    // Scheduling information for supplements
+	Quantity           *Quantity                         `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // Amount of the nutritional supplement
+	Instruction        *string                           `json:"instruction,omitempty" bson:"instruction,omitempty"`              // Instructions or additional information about the oral supplement
+	InstructionElement *Element                          `json:"_instruction,omitempty" bson:"instruction_element,omitempty"`     // Extensions for instruction
+	CaloricDensity     *Quantity                         `json:"caloricDensity,omitempty" bson:"caloric_density,omitempty"`       // Amount of energy per specified volume of supplement that is required
+}
+
+func (r *NutritionOrderSupplement) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.ProductNameElement != nil {
+		if err := r.ProductNameElement.Validate(); err != nil {
+			return fmt.Errorf("ProductNameElement: %w", err)
+		}
+	}
 	if r.Schedule != nil {
 		if err := r.Schedule.Validate(); err != nil {
 			return fmt.Errorf("Schedule: %w", err)
@@ -400,6 +949,11 @@ func (r *NutritionOrderSupplement) Validate() error {
 			return fmt.Errorf("Quantity: %w", err)
 		}
 	}
+	if r.InstructionElement != nil {
+		if err := r.InstructionElement.Validate(); err != nil {
+			return fmt.Errorf("InstructionElement: %w", err)
+		}
+	}
 	if r.CaloricDensity != nil {
 		if err := r.CaloricDensity.Validate(); err != nil {
 			return fmt.Errorf("CaloricDensity: %w", err)
@@ -408,19 +962,104 @@ func (r *NutritionOrderSupplement) Validate() error {
 	return nil
 }
 
+func (r *NutritionOrderSupplement) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.ProductNameElement != nil {
+		r.ProductNameElement.validateAll(path+".productName", issues)
+	}
+	if r.Schedule != nil {
+		r.Schedule.validateAll(path+".schedule", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.InstructionElement != nil {
+		r.InstructionElement.validateAll(path+".instruction", issues)
+	}
+	if r.CaloricDensity != nil {
+		r.CaloricDensity.validateAll(path+".caloricDensity", issues)
+	}
+}
+
+var nutritionOrderSupplementInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderSupplement) invariants() []invariant {
+	return nutritionOrderSupplementInvariants
+}
+
+func (r NutritionOrderSupplement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderSupplement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderSupplement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderSupplement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderSupplementElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.supplement.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.supplement.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.supplement.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.supplement.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionOrder.supplement.productName", Name: "productName", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.supplement.schedule", Name: "schedule", Field: 6, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.supplement.quantity", Name: "quantity", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionOrder.supplement.instruction", Name: "instruction", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.supplement.caloricDensity", Name: "caloricDensity", Field: 10, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *NutritionOrderSupplement) ElementMetadata() []ElementMetadata {
+	return nutritionOrderSupplementElementMetadata
+}
+
 type NutritionOrderSupplementSchedule struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Timing      []Timing         `json:"timing,omitempty" bson:"timing,omitempty"`             // Scheduled frequency of supplement
-	AsNeeded    *bool            `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`        // Take 'as needed'
-	AsNeededFor *CodeableConcept `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"` // Take 'as needed' for x
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Timing            []Timing         `json:"timing,omitempty" bson:"timing,omitempty"`                        // Scheduled frequency of supplement
+	AsNeeded          *bool            `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                   // Take 'as needed'
+	AsNeededElement   *Element         `json:"_asNeeded,omitempty" bson:"as_needed_element,omitempty"`          // Extensions for asNeeded
+	AsNeededFor       *CodeableConcept `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`            // Take 'as needed' for x
 }
 
 func (r *NutritionOrderSupplementSchedule) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Timing {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Timing[%d]: %w", i, err)
 		}
 	}
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
+		}
+	}
 	if r.AsNeededFor != nil {
 		if err := r.AsNeededFor.Validate(); err != nil {
 			return fmt.Errorf("AsNeededFor: %w", err)
@@ -428,3 +1067,721 @@ func (r *NutritionOrderSupplementSchedule) Validate() error {
 	}
 	return nil
 }
+
+func (r *NutritionOrderSupplementSchedule) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Timing {
+		item.validateAll(fmt.Sprintf("%s.timing[%d]", path, i), issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
+	if r.AsNeededFor != nil {
+		r.AsNeededFor.validateAll(path+".asNeededFor", issues)
+	}
+}
+
+var nutritionOrderSupplementScheduleInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderSupplementSchedule) invariants() []invariant {
+	return nutritionOrderSupplementScheduleInvariants
+}
+
+func (r NutritionOrderSupplementSchedule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderSupplementSchedule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderSupplementSchedule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderSupplementSchedule) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderSupplementScheduleElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.supplement.schedule.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.supplement.schedule.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.supplement.schedule.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.supplement.schedule.timing", Name: "timing", Field: 3, Min: 0, Max: "*", Types: []string{"Timing"}},
+	{Path: "NutritionOrder.supplement.schedule.asNeeded", Name: "asNeeded", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "NutritionOrder.supplement.schedule.asNeededFor", Name: "asNeededFor", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *NutritionOrderSupplementSchedule) ElementMetadata() []ElementMetadata {
+	return nutritionOrderSupplementScheduleElementMetadata
+}
+
+type NutritionOrderEnteralFormula struct {
+	Id                               *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                                         // Unique id for inter-element referencing
+	Extension                        []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                                           // Additional content defined by implementations
+	ModifierExtension                []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                          // Extensions that cannot be ignored even if unrecognized
+	Type                             *CodeableReference                           `json:"type,omitempty" bson:"type,omitempty"`                                                     // Type of patient enteral feeding
+	ProductName                      *string                                      `json:"productName,omitempty" bson:"product_name,omitempty"`                                      // Product or brand name of the enteral feeding
+	ProductNameElement               *Element                                     `json:"_productName,omitempty" bson:"product_name_element,omitempty"`                             // Extensions for productName
+	DeliveryDevice                   NutritionOrderEnteralFormulaDeliveryDevice   `json:"-" bson:"delivery_device,omitempty"`                                                       // Intended type of device for the enteral feeding administration
+	DeliveryDeviceElement            *Element                                     `json:"-" bson:"delivery_device_element,omitempty"`                                               // Extensions for deliveryDevice[x]
+	CaloricDensity                   *Quantity                                    `json:"caloricDensity,omitempty" bson:"caloric_density,omitempty"`                                // Amount of energy per specified volume of feeding that is required
+	RouteOfAdministration            []CodeableConcept                            `json:"routeOfAdministration,omitempty" bson:"route_of_administration,omitempty"`                 // How the enteral feeding should enter the patient's gastrointestinal tract
+	Administration                   []NutritionOrderEnteralFormulaAdministration `json:"administration,omitempty" bson:"administration,omitempty"`                                 // Formula feeding instruction as structured data
+	MaxVolumeToAdminister            *Quantity                                    `json:"maxVolumeToAdminister,omitempty" bson:"max_volume_to_administer,omitempty"`                // Upper limit on formula feeding volume per unit of time
+	AdministrationInstruction        *string                                      `json:"administrationInstruction,omitempty" bson:"administration_instruction,omitempty"`          // Formula feeding instructions expressed as text
+	AdministrationInstructionElement *Element                                     `json:"_administrationInstruction,omitempty" bson:"administration_instruction_element,omitempty"` // Extensions for administrationInstruction
+
+	deliveryDeviceVariants []string // JSON properties of NutritionOrder.enteralFormula.deliveryDevice[x] when more than one was decoded
+}
+
+func (r *NutritionOrderEnteralFormula) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.ProductNameElement != nil {
+		if err := r.ProductNameElement.Validate(); err != nil {
+			return fmt.Errorf("ProductNameElement: %w", err)
+		}
+	}
+	if len(r.deliveryDeviceVariants) > 1 {
+		return fmt.Errorf("field 'DeliveryDevice' must have a single type, got %v", r.deliveryDeviceVariants)
+	}
+	if r.DeliveryDevice != nil {
+		if err := r.DeliveryDevice.Validate(); err != nil {
+			return fmt.Errorf("DeliveryDevice: %w", err)
+		}
+	}
+	if r.DeliveryDeviceElement != nil {
+		if err := r.DeliveryDeviceElement.Validate(); err != nil {
+			return fmt.Errorf("DeliveryDeviceElement: %w", err)
+		}
+	}
+	if r.CaloricDensity != nil {
+		if err := r.CaloricDensity.Validate(); err != nil {
+			return fmt.Errorf("CaloricDensity: %w", err)
+		}
+	}
+	for i, item := range r.RouteOfAdministration {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RouteOfAdministration[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Administration {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Administration[%d]: %w", i, err)
+		}
+	}
+	if r.MaxVolumeToAdminister != nil {
+		if err := r.MaxVolumeToAdminister.Validate(); err != nil {
+			return fmt.Errorf("MaxVolumeToAdminister: %w", err)
+		}
+	}
+	if r.AdministrationInstructionElement != nil {
+		if err := r.AdministrationInstructionElement.Validate(); err != nil {
+			return fmt.Errorf("AdministrationInstructionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderEnteralFormula) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.ProductNameElement != nil {
+		r.ProductNameElement.validateAll(path+".productName", issues)
+	}
+	if len(r.deliveryDeviceVariants) > 1 {
+		issues.add("structure", path+".deliveryDevice", fmt.Sprintf("field 'DeliveryDevice' must have a single type, got %v", r.deliveryDeviceVariants))
+	}
+	if r.DeliveryDevice != nil {
+		r.DeliveryDevice.validateAll(path+".deliveryDevice.ofType("+r.DeliveryDevice.FHIRType()+")", issues)
+	}
+	if r.DeliveryDeviceElement != nil {
+		r.DeliveryDeviceElement.validateAll(path+".deliveryDevice", issues)
+	}
+	if r.CaloricDensity != nil {
+		r.CaloricDensity.validateAll(path+".caloricDensity", issues)
+	}
+	for i, item := range r.RouteOfAdministration {
+		item.validateAll(fmt.Sprintf("%s.routeOfAdministration[%d]", path, i), issues)
+	}
+	for i, item := range r.Administration {
+		item.validateAll(fmt.Sprintf("%s.administration[%d]", path, i), issues)
+	}
+	if r.MaxVolumeToAdminister != nil {
+		r.MaxVolumeToAdminister.validateAll(path+".maxVolumeToAdminister", issues)
+	}
+	if r.AdministrationInstructionElement != nil {
+		r.AdministrationInstructionElement.validateAll(path+".administrationInstruction", issues)
+	}
+}
+
+var nutritionOrderEnteralFormulaInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderEnteralFormula) invariants() []invariant {
+	return nutritionOrderEnteralFormulaInvariants
+}
+
+func (r NutritionOrderEnteralFormula) MarshalJSON() ([]byte, error) {
+	type alias NutritionOrderEnteralFormula
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "deliveryDevice", r.DeliveryDevice, r.DeliveryDeviceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionOrderEnteralFormula) UnmarshalJSON(data []byte) error {
+	type alias NutritionOrderEnteralFormula
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.DeliveryDevice, element, r.deliveryDeviceVariants, err = unmarshalChoice(fields, "deliveryDevice", nutritionOrderEnteralFormulaDeliveryDeviceVariants); err != nil {
+		return err
+	}
+	r.DeliveryDeviceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeliveryDeviceElement); err != nil {
+			return fmt.Errorf("_deliveryDevice: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r NutritionOrderEnteralFormula) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderEnteralFormula) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderEnteralFormula) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderEnteralFormula) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionOrderEnteralFormula) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "DeliveryDevice":
+		return "deliveryDevice", choiceValues(nutritionOrderEnteralFormulaDeliveryDeviceVariants)
+	}
+	return "", nil
+}
+
+var nutritionOrderEnteralFormulaElementTypes = map[string]string{
+	"administrationInstruction": "markdown",
+}
+
+func (r *NutritionOrderEnteralFormula) elementTypes() map[string]string {
+	return nutritionOrderEnteralFormulaElementTypes
+}
+
+var nutritionOrderEnteralFormulaElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.enteralFormula.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.enteralFormula.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.enteralFormula.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.enteralFormula.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionOrder.enteralFormula.productName", Name: "productName", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.enteralFormula.deliveryDevice[x]", Name: "deliveryDevice", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept", "canonical"}},
+	{Path: "NutritionOrder.enteralFormula.caloricDensity", Name: "caloricDensity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionOrder.enteralFormula.routeOfAdministration", Name: "routeOfAdministration", Field: 9, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionOrder.enteralFormula.administration", Name: "administration", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.enteralFormula.maxVolumeToAdminister", Name: "maxVolumeToAdminister", Field: 11, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionOrder.enteralFormula.administrationInstruction", Name: "administrationInstruction", Field: 12, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *NutritionOrderEnteralFormula) ElementMetadata() []ElementMetadata {
+	return nutritionOrderEnteralFormulaElementMetadata
+}
+
+// NutritionOrderEnteralFormulaDeliveryDevice is the type of NutritionOrder.enteralFormula.deliveryDevice[x]. It is implemented by the NutritionOrderEnteralFormulaDeliveryDevice* variant types.
+type NutritionOrderEnteralFormulaDeliveryDevice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionOrderEnteralFormulaDeliveryDevice()
+}
+
+// NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept is the CodeableConcept variant of NutritionOrderEnteralFormulaDeliveryDevice.
+type NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept) isNutritionOrderEnteralFormulaDeliveryDevice() {
+}
+
+// NutritionOrderEnteralFormulaDeliveryDeviceCanonical is the canonical variant of NutritionOrderEnteralFormulaDeliveryDevice.
+type NutritionOrderEnteralFormulaDeliveryDeviceCanonical string
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCanonical) Validate() error {
+	return nil
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v NutritionOrderEnteralFormulaDeliveryDeviceCanonical) isNutritionOrderEnteralFormulaDeliveryDevice() {
+}
+
+var nutritionOrderEnteralFormulaDeliveryDeviceVariants = []NutritionOrderEnteralFormulaDeliveryDevice{
+	NutritionOrderEnteralFormulaDeliveryDeviceCodeableConcept{},
+	NutritionOrderEnteralFormulaDeliveryDeviceCanonical(""),
+}
+
+type NutritionOrderEnteralFormulaAdministration struct {
+	Id                *string                                             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Schedule          *NutritionOrderEnteralFormulaAdministrationSchedule `json:"schedule,omitempty" bson:"schedule,omitempty"`                    // Scheduling information for enteral feeding products
+	Quantity          *Quantity                                           `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // The volume of formula feeding to provide
+	Rate              NutritionOrderEnteralFormulaAdministrationRate      `json:"-" bson:"rate,omitempty"`                                         // Speed with which the formula feeding is provided per period of time
+
+	rateVariants []string // JSON properties of NutritionOrder.enteralFormula.administration.rate[x] when more than one was decoded
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Schedule != nil {
+		if err := r.Schedule.Validate(); err != nil {
+			return fmt.Errorf("Schedule: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	if len(r.rateVariants) > 1 {
+		return fmt.Errorf("field 'Rate' must have a single type, got %v", r.rateVariants)
+	}
+	if r.Rate != nil {
+		if err := r.Rate.Validate(); err != nil {
+			return fmt.Errorf("Rate: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Schedule != nil {
+		r.Schedule.validateAll(path+".schedule", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if len(r.rateVariants) > 1 {
+		issues.add("structure", path+".rate", fmt.Sprintf("field 'Rate' must have a single type, got %v", r.rateVariants))
+	}
+	if r.Rate != nil {
+		r.Rate.validateAll(path+".rate.ofType("+r.Rate.FHIRType()+")", issues)
+	}
+}
+
+var nutritionOrderEnteralFormulaAdministrationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) invariants() []invariant {
+	return nutritionOrderEnteralFormulaAdministrationInvariants
+}
+
+func (r NutritionOrderEnteralFormulaAdministration) MarshalJSON() ([]byte, error) {
+	type alias NutritionOrderEnteralFormulaAdministration
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "rate", r.Rate, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalJSON(data []byte) error {
+	type alias NutritionOrderEnteralFormulaAdministration
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Rate, _, r.rateVariants, err = unmarshalChoice(fields, "rate", nutritionOrderEnteralFormulaAdministrationRateVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r NutritionOrderEnteralFormulaAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderEnteralFormulaAdministration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Rate":
+		return "rate", choiceValues(nutritionOrderEnteralFormulaAdministrationRateVariants)
+	}
+	return "", nil
+}
+
+var nutritionOrderEnteralFormulaAdministrationElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.enteralFormula.administration.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.enteralFormula.administration.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.enteralFormula.administration.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.enteralFormula.administration.schedule", Name: "schedule", Field: 3, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "NutritionOrder.enteralFormula.administration.quantity", Name: "quantity", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionOrder.enteralFormula.administration.rate[x]", Name: "rate", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity", "Ratio"}},
+}
+
+func (r *NutritionOrderEnteralFormulaAdministration) ElementMetadata() []ElementMetadata {
+	return nutritionOrderEnteralFormulaAdministrationElementMetadata
+}
+
+// NutritionOrderEnteralFormulaAdministrationRate is the type of NutritionOrder.enteralFormula.administration.rate[x]. It is implemented by the NutritionOrderEnteralFormulaAdministrationRate* variant types.
+type NutritionOrderEnteralFormulaAdministrationRate interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionOrderEnteralFormulaAdministrationRate()
+}
+
+// NutritionOrderEnteralFormulaAdministrationRateQuantity is the Quantity variant of NutritionOrderEnteralFormulaAdministrationRate.
+type NutritionOrderEnteralFormulaAdministrationRateQuantity struct {
+	Quantity
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateQuantity) isNutritionOrderEnteralFormulaAdministrationRate() {
+}
+
+// NutritionOrderEnteralFormulaAdministrationRateRatio is the Ratio variant of NutritionOrderEnteralFormulaAdministrationRate.
+type NutritionOrderEnteralFormulaAdministrationRateRatio struct {
+	Ratio
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v NutritionOrderEnteralFormulaAdministrationRateRatio) isNutritionOrderEnteralFormulaAdministrationRate() {
+}
+
+var nutritionOrderEnteralFormulaAdministrationRateVariants = []NutritionOrderEnteralFormulaAdministrationRate{
+	NutritionOrderEnteralFormulaAdministrationRateQuantity{},
+	NutritionOrderEnteralFormulaAdministrationRateRatio{},
+}
+
+type NutritionOrderEnteralFormulaAdministrationSchedule struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Timing            []Timing         `json:"timing,omitempty" bson:"timing,omitempty"`                        // Scheduled frequency of enteral feeding
+	AsNeeded          *bool            `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                   // Take 'as needed'
+	AsNeededElement   *Element         `json:"_asNeeded,omitempty" bson:"as_needed_element,omitempty"`          // Extensions for asNeeded
+	AsNeededFor       *CodeableConcept `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`            // Take 'as needed' for x
+}
+
+func (r *NutritionOrderEnteralFormulaAdministrationSchedule) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Timing {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Timing[%d]: %w", i, err)
+		}
+	}
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
+		}
+	}
+	if r.AsNeededFor != nil {
+		if err := r.AsNeededFor.Validate(); err != nil {
+			return fmt.Errorf("AsNeededFor: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderEnteralFormulaAdministrationSchedule) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Timing {
+		item.validateAll(fmt.Sprintf("%s.timing[%d]", path, i), issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
+	if r.AsNeededFor != nil {
+		r.AsNeededFor.validateAll(path+".asNeededFor", issues)
+	}
+}
+
+var nutritionOrderEnteralFormulaAdministrationScheduleInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderEnteralFormulaAdministrationSchedule) invariants() []invariant {
+	return nutritionOrderEnteralFormulaAdministrationScheduleInvariants
+}
+
+func (r NutritionOrderEnteralFormulaAdministrationSchedule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderEnteralFormulaAdministrationSchedule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderEnteralFormulaAdministrationSchedule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderEnteralFormulaAdministrationSchedule) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderEnteralFormulaAdministrationScheduleElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.enteralFormula.administration.schedule.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.enteralFormula.administration.schedule.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.enteralFormula.administration.schedule.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.enteralFormula.administration.schedule.timing", Name: "timing", Field: 3, Min: 0, Max: "*", Types: []string{"Timing"}},
+	{Path: "NutritionOrder.enteralFormula.administration.schedule.asNeeded", Name: "asNeeded", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "NutritionOrder.enteralFormula.administration.schedule.asNeededFor", Name: "asNeededFor", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *NutritionOrderEnteralFormulaAdministrationSchedule) ElementMetadata() []ElementMetadata {
+	return nutritionOrderEnteralFormulaAdministrationScheduleElementMetadata
+}
+
+type NutritionOrderAdditive struct {
+	Id                    *string            `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension             []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension     []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	ModularType           *CodeableReference `json:"modularType,omitempty" bson:"modular_type,omitempty"`                      // Type of modular component to add to the oral diet, supplement, and/or enteral feeding
+	ProductName           *string            `json:"productName,omitempty" bson:"product_name,omitempty"`                      // Product or brand name of the modular additive
+	ProductNameElement    *Element           `json:"_productName,omitempty" bson:"product_name_element,omitempty"`             // Extensions for productName
+	Quantity              *Quantity          `json:"quantity,omitempty" bson:"quantity,omitempty"`                             // Amount of additive to be given or mixed in with the oral diet, supplement, and/or enteral feeding
+	RouteOfAdministration []CodeableConcept  `json:"routeOfAdministration,omitempty" bson:"route_of_administration,omitempty"` // How the additive should enter the patient's gastrointestinal tract
+}
+
+func (r *NutritionOrderAdditive) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.ModularType != nil {
+		if err := r.ModularType.Validate(); err != nil {
+			return fmt.Errorf("ModularType: %w", err)
+		}
+	}
+	if r.ProductNameElement != nil {
+		if err := r.ProductNameElement.Validate(); err != nil {
+			return fmt.Errorf("ProductNameElement: %w", err)
+		}
+	}
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
+		}
+	}
+	for i, item := range r.RouteOfAdministration {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RouteOfAdministration[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionOrderAdditive) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ModularType != nil {
+		r.ModularType.validateAll(path+".modularType", issues)
+	}
+	if r.ProductNameElement != nil {
+		r.ProductNameElement.validateAll(path+".productName", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	for i, item := range r.RouteOfAdministration {
+		item.validateAll(fmt.Sprintf("%s.routeOfAdministration[%d]", path, i), issues)
+	}
+}
+
+var nutritionOrderAdditiveInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionOrderAdditive) invariants() []invariant {
+	return nutritionOrderAdditiveInvariants
+}
+
+func (r NutritionOrderAdditive) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionOrderAdditive) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionOrderAdditive) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionOrderAdditive) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionOrderAdditiveElementMetadata = []ElementMetadata{
+	{Path: "NutritionOrder.additive.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.additive.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionOrder.additive.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionOrder.additive.modularType", Name: "modularType", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionOrder.additive.productName", Name: "productName", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionOrder.additive.quantity", Name: "quantity", Field: 6, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionOrder.additive.routeOfAdministration", Name: "routeOfAdministration", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *NutritionOrderAdditive) ElementMetadata() []ElementMetadata {
+	return nutritionOrderAdditiveElementMetadata
+}
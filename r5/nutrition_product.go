@@ -2,29 +2,36 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A food or supplement that is consumed by patients.
 type NutritionProduct struct {
-	ResourceType      string                           `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string                          `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage                `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Code              *CodeableConcept                 `json:"code,omitempty" bson:"code,omitempty"`                            // A code that can identify the product
-	Status            string                           `json:"status" bson:"status"`                                            // active | inactive | entered-in-error
-	Category          []CodeableConcept                `json:"category,omitempty" bson:"category,omitempty"`                    // Broad product groups, like Fruit, Grain, Beverages, or Vegetables Products
-	Manufacturer      []Reference                      `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`            // Manufacturer, representative or person officially responsible for the product
-	Nutrient          []NutritionProductNutrient       `json:"nutrient,omitempty" bson:"nutrient,omitempty"`                    // The product's nutritional information expressed by the nutrients
-	IngredientSummary *string                          `json:"ingredientSummary,omitempty" bson:"ingredient_summary,omitempty"` // Textual description of product ingredients
-	Ingredient        []NutritionProductIngredient     `json:"ingredient,omitempty" bson:"ingredient,omitempty"`                // Ingredients contained in this product
-	Energy            *Quantity                        `json:"energy,omitempty" bson:"energy,omitempty"`                        // The amount of energy present in the product expressed in kilocalories or kilojoules
-	Characteristic    []NutritionProductCharacteristic `json:"characteristic,omitempty" bson:"characteristic,omitempty"`        // Specifies descriptive properties of the nutrition product
-	Instance          []NutritionProductInstance       `json:"instance,omitempty" bson:"instance,omitempty"`                    // One or several physical instances or occurrences of the nutrition product
-	Note              []Annotation                     `json:"note,omitempty" bson:"note,omitempty"`                            // Comments made about the product
+	ResourceType             string                           `json:"resourceType" bson:"resource_type"`                                        // Type of resource
+	Id                       *string                          `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
+	Meta                     *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
+	ImplicitRules            *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
+	ImplicitRulesElement     *Element                         `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`         // Extensions for implicitRules
+	Language                 *string                          `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
+	LanguageElement          *Element                         `json:"_language,omitempty" bson:"language_element,omitempty"`                    // Extensions for language
+	Text                     *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
+	Contained                []Resource                       `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
+	Extension                []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored
+	Code                     *CodeableConcept                 `json:"code,omitempty" bson:"code,omitempty"`                                     // A code that can identify the product
+	Status                   NutritionProductStatus           `json:"status" bson:"status"`                                                     // active | inactive | entered-in-error
+	StatusElement            *Element                         `json:"_status,omitempty" bson:"status_element,omitempty"`                        // Extensions for status
+	Category                 []CodeableConcept                `json:"category,omitempty" bson:"category,omitempty"`                             // Broad product groups, like Fruit, Grain, Beverages, or Vegetables Products
+	Manufacturer             []Reference                      `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                     // Manufacturer, representative or person officially responsible for the product
+	Nutrient                 []NutritionProductNutrient       `json:"nutrient,omitempty" bson:"nutrient,omitempty"`                             // The product's nutritional information expressed by the nutrients
+	IngredientSummary        *string                          `json:"ingredientSummary,omitempty" bson:"ingredient_summary,omitempty"`          // Textual description of product ingredients
+	IngredientSummaryElement *Element                         `json:"_ingredientSummary,omitempty" bson:"ingredient_summary_element,omitempty"` // Extensions for ingredientSummary
+	Ingredient               []NutritionProductIngredient     `json:"ingredient,omitempty" bson:"ingredient,omitempty"`                         // Ingredients contained in this product
+	Energy                   *Quantity                        `json:"energy,omitempty" bson:"energy,omitempty"`                                 // The amount of energy present in the product expressed in kilocalories or kilojoules
+	Characteristic           []NutritionProductCharacteristic `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                 // Specifies descriptive properties of the nutrition product
+	Instance                 []NutritionProductInstance       `json:"instance,omitempty" bson:"instance,omitempty"`                             // One or several physical instances or occurrences of the nutrition product
+	Note                     []Annotation                     `json:"note,omitempty" bson:"note,omitempty"`                                     // Comments made about the product
 }
 
 func (r *NutritionProduct) Validate() error {
@@ -36,20 +43,55 @@ func (r *NutritionProduct) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -65,6 +107,11 @@ func (r *NutritionProduct) Validate() error {
 			return fmt.Errorf("Nutrient[%d]: %w", i, err)
 		}
 	}
+	if r.IngredientSummaryElement != nil {
+		if err := r.IngredientSummaryElement.Validate(); err != nil {
+			return fmt.Errorf("IngredientSummaryElement: %w", err)
+		}
+	}
 	for i, item := range r.Ingredient {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Ingredient[%d]: %w", i, err)
@@ -90,47 +137,232 @@ func (r *NutritionProduct) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "NutritionProduct")
 }
 
-type NutritionProductInstance struct {
-	Id                    *string      `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	Quantity              *Quantity    `json:"quantity,omitempty" bson:"quantity,omitempty"`                             // The amount of items or instances
-	Identifier            []Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // The identifier for the physical instance, typically a serial number or manufacturer number
-	Name                  *string      `json:"name,omitempty" bson:"name,omitempty"`                                     // The name or brand for the specific product
-	LotNumber             *string      `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`                          // The identification of the batch or lot of the product
-	Expiry                *string      `json:"expiry,omitempty" bson:"expiry,omitempty"`                                 // The expiry date or date and time for the product
-	UseBy                 *string      `json:"useBy,omitempty" bson:"use_by,omitempty"`                                  // The date until which the product is expected to be good for consumption
-	BiologicalSourceEvent *Identifier  `json:"biologicalSourceEvent,omitempty" bson:"biological_source_event,omitempty"` // An identifier of the donation, collection, or pooling event from which biological material in this nutrition product was derived
-}
-
-func (r *NutritionProductInstance) Validate() error {
-	if r.Quantity != nil {
-		if err := r.Quantity.Validate(); err != nil {
-			return fmt.Errorf("Quantity: %w", err)
-		}
+func (r *NutritionProduct) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "NutritionProduct" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'NutritionProduct', got '%s'", r.ResourceType))
 	}
-	for i, item := range r.Identifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Identifier[%d]: %w", i, err)
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.BiologicalSourceEvent != nil {
-		if err := r.BiologicalSourceEvent.Validate(); err != nil {
-			return fmt.Errorf("BiologicalSourceEvent: %w", err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	for i, item := range r.Manufacturer {
+		item.validateAll(fmt.Sprintf("%s.manufacturer[%d]", path, i), issues)
+	}
+	for i, item := range r.Nutrient {
+		item.validateAll(fmt.Sprintf("%s.nutrient[%d]", path, i), issues)
+	}
+	if r.IngredientSummaryElement != nil {
+		r.IngredientSummaryElement.validateAll(path+".ingredientSummary", issues)
+	}
+	for i, item := range r.Ingredient {
+		item.validateAll(fmt.Sprintf("%s.ingredient[%d]", path, i), issues)
+	}
+	if r.Energy != nil {
+		r.Energy.validateAll(path+".energy", issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
+	for i, item := range r.Instance {
+		item.validateAll(fmt.Sprintf("%s.instance[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *NutritionProduct) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("NutritionProduct", &issues)
+	checkInvariants(r, "NutritionProduct", &issues)
+	return issues
+}
+
+var nutritionProductInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *NutritionProduct) invariants() []invariant {
+	return nutritionProductInvariants
+}
+
+func (r *NutritionProduct) UnmarshalJSON(data []byte) error {
+	type alias NutritionProduct
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r NutritionProduct) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionProduct) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionProduct) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionProduct) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionProductElementTypes = map[string]string{
+	"id":                "id",
+	"implicitRules":     "uri",
+	"language":          "code",
+	"ingredientSummary": "markdown",
+}
+
+func (r *NutritionProduct) elementTypes() map[string]string {
+	return nutritionProductElementTypes
+}
+
+var nutritionProductElementMetadata = []ElementMetadata{
+	{Path: "NutritionProduct.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "NutritionProduct.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "NutritionProduct.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "NutritionProduct.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionProduct.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "NutritionProduct.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "NutritionProduct.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "NutritionProduct.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionProduct.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionProduct.code", Name: "code", Field: 11, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionProduct.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "NutritionProduct.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/nutritionproduct-status"}},
+	{Path: "NutritionProduct.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionProduct.manufacturer", Name: "manufacturer", Field: 15, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "NutritionProduct.nutrient", Name: "nutrient", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionProduct.ingredientSummary", Name: "ingredientSummary", Field: 17, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "NutritionProduct.ingredient", Name: "ingredient", Field: 19, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionProduct.energy", Name: "energy", Field: 20, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionProduct.characteristic", Name: "characteristic", Field: 21, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionProduct.instance", Name: "instance", Field: 22, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "NutritionProduct.note", Name: "note", Field: 23, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *NutritionProduct) ElementMetadata() []ElementMetadata {
+	return nutritionProductElementMetadata
+}
+
+func (r *NutritionProduct) GetResourceType() string {
+	return "NutritionProduct"
+}
+
+func (r *NutritionProduct) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *NutritionProduct) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *NutritionProduct) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *NutritionProduct) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *NutritionProduct) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *NutritionProduct) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *NutritionProduct) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*NutritionProduct)(nil)
+
 type NutritionProductNutrient struct {
-	Id             *string            `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Item           *CodeableReference `json:"item" bson:"item"`                                          // The (relevant) nutrients in the product
-	AmountRatio    *Ratio             `json:"amountRatio,omitempty" bson:"amount_ratio,omitempty"`       // The amount of nutrient present in the product
-	AmountQuantity *Quantity          `json:"amountQuantity,omitempty" bson:"amount_quantity,omitempty"` // The amount of nutrient present in the product
+	Id                *string                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Item              *CodeableReference             `json:"item" bson:"item"`                                                // The (relevant) nutrients in the product
+	Amount            NutritionProductNutrientAmount `json:"-" bson:"amount,omitempty"`                                       // The amount of nutrient present in the product
+
+	amountVariants []string // JSON properties of NutritionProduct.nutrient.amount[x] when more than one was decoded
 }
 
 func (r *NutritionProductNutrient) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Item == nil {
 		return fmt.Errorf("field 'Item' is required")
 	}
@@ -139,28 +371,190 @@ func (r *NutritionProductNutrient) Validate() error {
 			return fmt.Errorf("Item: %w", err)
 		}
 	}
-	if r.AmountRatio != nil {
-		if err := r.AmountRatio.Validate(); err != nil {
-			return fmt.Errorf("AmountRatio: %w", err)
-		}
+	if len(r.amountVariants) > 1 {
+		return fmt.Errorf("field 'Amount' must have a single type, got %v", r.amountVariants)
 	}
-	if r.AmountQuantity != nil {
-		if err := r.AmountQuantity.Validate(); err != nil {
-			return fmt.Errorf("AmountQuantity: %w", err)
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r *NutritionProductNutrient) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Item == nil {
+		issues.add("required", path+".item", "field 'Item' is required")
+	}
+	if r.Item != nil {
+		r.Item.validateAll(path+".item", issues)
+	}
+	if len(r.amountVariants) > 1 {
+		issues.add("structure", path+".amount", fmt.Sprintf("field 'Amount' must have a single type, got %v", r.amountVariants))
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount.ofType("+r.Amount.FHIRType()+")", issues)
+	}
+}
+
+var nutritionProductNutrientInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionProductNutrient) invariants() []invariant {
+	return nutritionProductNutrientInvariants
+}
+
+func (r NutritionProductNutrient) MarshalJSON() ([]byte, error) {
+	type alias NutritionProductNutrient
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "amount", r.Amount, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionProductNutrient) UnmarshalJSON(data []byte) error {
+	type alias NutritionProductNutrient
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Amount, _, r.amountVariants, err = unmarshalChoice(fields, "amount", nutritionProductNutrientAmountVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r NutritionProductNutrient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionProductNutrient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionProductNutrient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionProductNutrient) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionProductNutrient) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Amount":
+		return "amount", choiceValues(nutritionProductNutrientAmountVariants)
+	}
+	return "", nil
+}
+
+var nutritionProductNutrientElementMetadata = []ElementMetadata{
+	{Path: "NutritionProduct.nutrient.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionProduct.nutrient.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionProduct.nutrient.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionProduct.nutrient.item", Name: "item", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionProduct.nutrient.amount[x]", Name: "amount", Field: 4, Min: 0, Max: "1", Types: []string{"Ratio", "Quantity"}},
+}
+
+func (r *NutritionProductNutrient) ElementMetadata() []ElementMetadata {
+	return nutritionProductNutrientElementMetadata
+}
+
+// NutritionProductNutrientAmount is the type of NutritionProduct.nutrient.amount[x]. It is implemented by the NutritionProductNutrientAmount* variant types.
+type NutritionProductNutrientAmount interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionProductNutrientAmount()
+}
+
+// NutritionProductNutrientAmountRatio is the Ratio variant of NutritionProductNutrientAmount.
+type NutritionProductNutrientAmountRatio struct {
+	Ratio
+}
+
+func (v NutritionProductNutrientAmountRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v NutritionProductNutrientAmountRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v NutritionProductNutrientAmountRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v NutritionProductNutrientAmountRatio) isNutritionProductNutrientAmount() {}
+
+// NutritionProductNutrientAmountQuantity is the Quantity variant of NutritionProductNutrientAmount.
+type NutritionProductNutrientAmountQuantity struct {
+	Quantity
+}
+
+func (v NutritionProductNutrientAmountQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v NutritionProductNutrientAmountQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v NutritionProductNutrientAmountQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v NutritionProductNutrientAmountQuantity) isNutritionProductNutrientAmount() {}
+
+var nutritionProductNutrientAmountVariants = []NutritionProductNutrientAmount{
+	NutritionProductNutrientAmountRatio{},
+	NutritionProductNutrientAmountQuantity{},
+}
+
 type NutritionProductIngredient struct {
-	Id             *string            `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Item           *CodeableReference `json:"item" bson:"item"`                                          // The ingredient contained in the product
-	AmountRatio    *Ratio             `json:"amountRatio,omitempty" bson:"amount_ratio,omitempty"`       // The amount of ingredient that is in the product
-	AmountQuantity *Quantity          `json:"amountQuantity,omitempty" bson:"amount_quantity,omitempty"` // The amount of ingredient that is in the product
-	Allergen       *bool              `json:"allergen,omitempty" bson:"allergen,omitempty"`              // A known or suspected allergenic and/or substance that is associated with an intolerance
+	Id                *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Item              *CodeableReference               `json:"item" bson:"item"`                                                // The ingredient contained in the product
+	Amount            NutritionProductIngredientAmount `json:"-" bson:"amount,omitempty"`                                       // The amount of ingredient that is in the product
+	Allergen          *bool                            `json:"allergen,omitempty" bson:"allergen,omitempty"`                    // A known or suspected allergenic and/or substance that is associated with an intolerance
+	AllergenElement   *Element                         `json:"_allergen,omitempty" bson:"allergen_element,omitempty"`           // Extensions for allergen
+
+	amountVariants []string // JSON properties of NutritionProduct.ingredient.amount[x] when more than one was decoded
 }
 
 func (r *NutritionProductIngredient) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Item == nil {
 		return fmt.Errorf("field 'Item' is required")
 	}
@@ -169,31 +563,198 @@ func (r *NutritionProductIngredient) Validate() error {
 			return fmt.Errorf("Item: %w", err)
 		}
 	}
-	if r.AmountRatio != nil {
-		if err := r.AmountRatio.Validate(); err != nil {
-			return fmt.Errorf("AmountRatio: %w", err)
+	if len(r.amountVariants) > 1 {
+		return fmt.Errorf("field 'Amount' must have a single type, got %v", r.amountVariants)
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
 		}
 	}
-	if r.AmountQuantity != nil {
-		if err := r.AmountQuantity.Validate(); err != nil {
-			return fmt.Errorf("AmountQuantity: %w", err)
+	if r.AllergenElement != nil {
+		if err := r.AllergenElement.Validate(); err != nil {
+			return fmt.Errorf("AllergenElement: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r *NutritionProductIngredient) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Item == nil {
+		issues.add("required", path+".item", "field 'Item' is required")
+	}
+	if r.Item != nil {
+		r.Item.validateAll(path+".item", issues)
+	}
+	if len(r.amountVariants) > 1 {
+		issues.add("structure", path+".amount", fmt.Sprintf("field 'Amount' must have a single type, got %v", r.amountVariants))
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount.ofType("+r.Amount.FHIRType()+")", issues)
+	}
+	if r.AllergenElement != nil {
+		r.AllergenElement.validateAll(path+".allergen", issues)
+	}
+}
+
+var nutritionProductIngredientInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionProductIngredient) invariants() []invariant {
+	return nutritionProductIngredientInvariants
+}
+
+func (r NutritionProductIngredient) MarshalJSON() ([]byte, error) {
+	type alias NutritionProductIngredient
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "amount", r.Amount, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionProductIngredient) UnmarshalJSON(data []byte) error {
+	type alias NutritionProductIngredient
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Amount, _, r.amountVariants, err = unmarshalChoice(fields, "amount", nutritionProductIngredientAmountVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r NutritionProductIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionProductIngredient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionProductIngredient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionProductIngredient) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionProductIngredient) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Amount":
+		return "amount", choiceValues(nutritionProductIngredientAmountVariants)
+	}
+	return "", nil
+}
+
+var nutritionProductIngredientElementMetadata = []ElementMetadata{
+	{Path: "NutritionProduct.ingredient.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionProduct.ingredient.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionProduct.ingredient.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionProduct.ingredient.item", Name: "item", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "NutritionProduct.ingredient.amount[x]", Name: "amount", Field: 4, Min: 0, Max: "1", Types: []string{"Ratio", "Quantity"}},
+	{Path: "NutritionProduct.ingredient.allergen", Name: "allergen", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *NutritionProductIngredient) ElementMetadata() []ElementMetadata {
+	return nutritionProductIngredientElementMetadata
+}
+
+// NutritionProductIngredientAmount is the type of NutritionProduct.ingredient.amount[x]. It is implemented by the NutritionProductIngredientAmount* variant types.
+type NutritionProductIngredientAmount interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionProductIngredientAmount()
+}
+
+// NutritionProductIngredientAmountRatio is the Ratio variant of NutritionProductIngredientAmount.
+type NutritionProductIngredientAmountRatio struct {
+	Ratio
+}
+
+func (v NutritionProductIngredientAmountRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v NutritionProductIngredientAmountRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v NutritionProductIngredientAmountRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v NutritionProductIngredientAmountRatio) isNutritionProductIngredientAmount() {}
+
+// NutritionProductIngredientAmountQuantity is the Quantity variant of NutritionProductIngredientAmount.
+type NutritionProductIngredientAmountQuantity struct {
+	Quantity
+}
+
+func (v NutritionProductIngredientAmountQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v NutritionProductIngredientAmountQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v NutritionProductIngredientAmountQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v NutritionProductIngredientAmountQuantity) isNutritionProductIngredientAmount() {}
+
+var nutritionProductIngredientAmountVariants = []NutritionProductIngredientAmount{
+	NutritionProductIngredientAmountRatio{},
+	NutritionProductIngredientAmountQuantity{},
+}
+
 type NutritionProductCharacteristic struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                   // Code specifying the type of characteristic
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // The value of the characteristic
-	ValueString          *string          `json:"valueString" bson:"value_string"`                    // The value of the characteristic
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // The value of the characteristic
-	ValueBase64Binary    *string          `json:"valueBase64Binary" bson:"value_base64_binary"`       // The value of the characteristic
-	ValueAttachment      *Attachment      `json:"valueAttachment" bson:"value_attachment"`            // The value of the characteristic
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // The value of the characteristic
+	Id                *string                             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                    `json:"type" bson:"type"`                                                // Code specifying the type of characteristic
+	Value             NutritionProductCharacteristicValue `json:"-" bson:"value,omitempty"`                                        // The value of the characteristic
+	ValueElement      *Element                            `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of NutritionProduct.characteristic.value[x] when more than one was decoded
 }
 
 func (r *NutritionProductCharacteristic) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -202,38 +763,412 @@ func (r *NutritionProductCharacteristic) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NutritionProductCharacteristic) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var nutritionProductCharacteristicInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionProductCharacteristic) invariants() []invariant {
+	return nutritionProductCharacteristicInvariants
+}
+
+func (r NutritionProductCharacteristic) MarshalJSON() ([]byte, error) {
+	type alias NutritionProductCharacteristic
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *NutritionProductCharacteristic) UnmarshalJSON(data []byte) error {
+	type alias NutritionProductCharacteristic
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", nutritionProductCharacteristicValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r NutritionProductCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionProductCharacteristic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionProductCharacteristic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionProductCharacteristic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *NutritionProductCharacteristic) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(nutritionProductCharacteristicValueVariants)
+	}
+	return "", nil
+}
+
+var nutritionProductCharacteristicElementMetadata = []ElementMetadata{
+	{Path: "NutritionProduct.characteristic.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionProduct.characteristic.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionProduct.characteristic.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionProduct.characteristic.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "NutritionProduct.characteristic.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept", "string", "Quantity", "base64Binary", "Attachment", "boolean"}},
+}
+
+func (r *NutritionProductCharacteristic) ElementMetadata() []ElementMetadata {
+	return nutritionProductCharacteristicElementMetadata
+}
+
+// NutritionProductCharacteristicValue is the type of NutritionProduct.characteristic.value[x]. It is implemented by the NutritionProductCharacteristicValue* variant types.
+type NutritionProductCharacteristicValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isNutritionProductCharacteristicValue()
+}
+
+// NutritionProductCharacteristicValueCodeableConcept is the CodeableConcept variant of NutritionProductCharacteristicValue.
+type NutritionProductCharacteristicValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v NutritionProductCharacteristicValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v NutritionProductCharacteristicValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v NutritionProductCharacteristicValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v NutritionProductCharacteristicValueCodeableConcept) isNutritionProductCharacteristicValue() {}
+
+// NutritionProductCharacteristicValueString is the string variant of NutritionProductCharacteristicValue.
+type NutritionProductCharacteristicValueString string
+
+func (v NutritionProductCharacteristicValueString) Validate() error {
+	return nil
+}
+
+func (v NutritionProductCharacteristicValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v NutritionProductCharacteristicValueString) FHIRType() string {
+	return "string"
+}
+
+func (v NutritionProductCharacteristicValueString) isNutritionProductCharacteristicValue() {}
+
+// NutritionProductCharacteristicValueQuantity is the Quantity variant of NutritionProductCharacteristicValue.
+type NutritionProductCharacteristicValueQuantity struct {
+	Quantity
+}
+
+func (v NutritionProductCharacteristicValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v NutritionProductCharacteristicValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v NutritionProductCharacteristicValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v NutritionProductCharacteristicValueQuantity) isNutritionProductCharacteristicValue() {}
+
+// NutritionProductCharacteristicValueBase64Binary is the base64Binary variant of NutritionProductCharacteristicValue.
+type NutritionProductCharacteristicValueBase64Binary string
+
+func (v NutritionProductCharacteristicValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v NutritionProductCharacteristicValueBase64Binary) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v NutritionProductCharacteristicValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v NutritionProductCharacteristicValueBase64Binary) isNutritionProductCharacteristicValue() {}
+
+// NutritionProductCharacteristicValueAttachment is the Attachment variant of NutritionProductCharacteristicValue.
+type NutritionProductCharacteristicValueAttachment struct {
+	Attachment
+}
+
+func (v NutritionProductCharacteristicValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v NutritionProductCharacteristicValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v NutritionProductCharacteristicValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v NutritionProductCharacteristicValueAttachment) isNutritionProductCharacteristicValue() {}
+
+// NutritionProductCharacteristicValueBoolean is the boolean variant of NutritionProductCharacteristicValue.
+type NutritionProductCharacteristicValueBoolean bool
+
+func (v NutritionProductCharacteristicValueBoolean) Validate() error {
+	return nil
+}
+
+func (v NutritionProductCharacteristicValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v NutritionProductCharacteristicValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v NutritionProductCharacteristicValueBoolean) isNutritionProductCharacteristicValue() {}
+
+var nutritionProductCharacteristicValueVariants = []NutritionProductCharacteristicValue{
+	NutritionProductCharacteristicValueCodeableConcept{},
+	NutritionProductCharacteristicValueString(""),
+	NutritionProductCharacteristicValueQuantity{},
+	NutritionProductCharacteristicValueBase64Binary(""),
+	NutritionProductCharacteristicValueAttachment{},
+	NutritionProductCharacteristicValueBoolean(false),
+}
+
+type NutritionProductInstance struct {
+	Id                    *string      `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension             []Extension  `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension     []Extension  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	Quantity              *Quantity    `json:"quantity,omitempty" bson:"quantity,omitempty"`                             // The amount of items or instances
+	Identifier            []Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // The identifier for the physical instance, typically a serial number or manufacturer number
+	Name                  *string      `json:"name,omitempty" bson:"name,omitempty"`                                     // The name or brand for the specific product
+	NameElement           *Element     `json:"_name,omitempty" bson:"name_element,omitempty"`                            // Extensions for name
+	LotNumber             *string      `json:"lotNumber,omitempty" bson:"lot_number,omitempty"`                          // The identification of the batch or lot of the product
+	LotNumberElement      *Element     `json:"_lotNumber,omitempty" bson:"lot_number_element,omitempty"`                 // Extensions for lotNumber
+	Expiry                *DateTime    `json:"expiry,omitempty" bson:"expiry,omitempty"`                                 // The expiry date or date and time for the product
+	ExpiryElement         *Element     `json:"_expiry,omitempty" bson:"expiry_element,omitempty"`                        // Extensions for expiry
+	UseBy                 *DateTime    `json:"useBy,omitempty" bson:"use_by,omitempty"`                                  // The date until which the product is expected to be good for consumption
+	UseByElement          *Element     `json:"_useBy,omitempty" bson:"use_by_element,omitempty"`                         // Extensions for useBy
+	BiologicalSourceEvent *Identifier  `json:"biologicalSourceEvent,omitempty" bson:"biological_source_event,omitempty"` // An identifier of the donation, collection, or pooling event from which biological material in this nutrition product was derived
+}
+
+func (r *NutritionProductInstance) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	for i, item := range r.Identifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Identifier[%d]: %w", i, err)
+		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.LotNumberElement != nil {
+		if err := r.LotNumberElement.Validate(); err != nil {
+			return fmt.Errorf("LotNumberElement: %w", err)
 		}
 	}
-	if r.ValueBase64Binary == nil {
-		return fmt.Errorf("field 'ValueBase64Binary' is required")
+	if r.Expiry != nil {
+		if err := r.Expiry.Validate(); err != nil {
+			return fmt.Errorf("Expiry: %w", err)
+		}
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	if r.ExpiryElement != nil {
+		if err := r.ExpiryElement.Validate(); err != nil {
+			return fmt.Errorf("ExpiryElement: %w", err)
+		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.UseBy != nil {
+		if err := r.UseBy.Validate(); err != nil {
+			return fmt.Errorf("UseBy: %w", err)
 		}
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.UseByElement != nil {
+		if err := r.UseByElement.Validate(); err != nil {
+			return fmt.Errorf("UseByElement: %w", err)
+		}
+	}
+	if r.BiologicalSourceEvent != nil {
+		if err := r.BiologicalSourceEvent.Validate(); err != nil {
+			return fmt.Errorf("BiologicalSourceEvent: %w", err)
+		}
 	}
 	return nil
 }
+
+func (r *NutritionProductInstance) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.LotNumberElement != nil {
+		r.LotNumberElement.validateAll(path+".lotNumber", issues)
+	}
+	if r.Expiry != nil {
+		if err := r.Expiry.Validate(); err != nil {
+			issues.add("value", path+".expiry", err.Error())
+		}
+	}
+	if r.ExpiryElement != nil {
+		r.ExpiryElement.validateAll(path+".expiry", issues)
+	}
+	if r.UseBy != nil {
+		if err := r.UseBy.Validate(); err != nil {
+			issues.add("value", path+".useBy", err.Error())
+		}
+	}
+	if r.UseByElement != nil {
+		r.UseByElement.validateAll(path+".useBy", issues)
+	}
+	if r.BiologicalSourceEvent != nil {
+		r.BiologicalSourceEvent.validateAll(path+".biologicalSourceEvent", issues)
+	}
+}
+
+var nutritionProductInstanceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *NutritionProductInstance) invariants() []invariant {
+	return nutritionProductInstanceInvariants
+}
+
+func (r NutritionProductInstance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *NutritionProductInstance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r NutritionProductInstance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *NutritionProductInstance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var nutritionProductInstanceElementMetadata = []ElementMetadata{
+	{Path: "NutritionProduct.instance.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionProduct.instance.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "NutritionProduct.instance.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "NutritionProduct.instance.quantity", Name: "quantity", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "NutritionProduct.instance.identifier", Name: "identifier", Field: 4, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "NutritionProduct.instance.name", Name: "name", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionProduct.instance.lotNumber", Name: "lotNumber", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "NutritionProduct.instance.expiry", Name: "expiry", Field: 9, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "NutritionProduct.instance.useBy", Name: "useBy", Field: 11, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "NutritionProduct.instance.biologicalSourceEvent", Name: "biologicalSourceEvent", Field: 13, Min: 0, Max: "1", Types: []string{"Identifier"}},
+}
+
+func (r *NutritionProductInstance) ElementMetadata() []ElementMetadata {
+	return nutritionProductInstanceElementMetadata
+}
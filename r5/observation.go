@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,38 +12,33 @@ type Observation struct {
 	Id                    *string                     `json:"id,omitempty" bson:"id,omitempty"`                                        // Logical id of this artifact
 	Meta                  *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                                    // Metadata about the resource
 	ImplicitRules         *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                 // A set of rules under which this content was created
+	ImplicitRulesElement  *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`        // Extensions for implicitRules
 	Language              *string                     `json:"language,omitempty" bson:"language,omitempty"`                            // Language of the resource content
+	LanguageElement       *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`                   // Extensions for language
 	Text                  *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                                    // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Contained             []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
+	Extension             []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension     []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored
 	Identifier            []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                        // Business Identifier for observation
 	BasedOn               []Reference                 `json:"basedOn,omitempty" bson:"based_on,omitempty"`                             // Fulfills plan, proposal or order
 	TriggeredBy           []ObservationTriggeredBy    `json:"triggeredBy,omitempty" bson:"triggered_by,omitempty"`                     // Triggering observation(s)
 	PartOf                []Reference                 `json:"partOf,omitempty" bson:"part_of,omitempty"`                               // Part of referenced event
-	Status                string                      `json:"status" bson:"status"`                                                    // registered | specimen-in-process | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown | cannot-be-obtained
+	Status                ObservationStatus           `json:"status" bson:"status"`                                                    // registered | specimen-in-process | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown | cannot-be-obtained
+	StatusElement         *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                       // Extensions for status
 	Category              []CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`                            // Classification of  type of observation
 	Code                  *CodeableConcept            `json:"code" bson:"code"`                                                        // Type of observation (code / type)
 	Subject               *Reference                  `json:"subject,omitempty" bson:"subject,omitempty"`                              // Who and/or what the observation is about
 	Focus                 []Reference                 `json:"focus,omitempty" bson:"focus,omitempty"`                                  // What the observation is about, when it is not about the subject of record
 	Organizer             *bool                       `json:"organizer,omitempty" bson:"organizer,omitempty"`                          // This observation organizes/groups a set of sub-observations
+	OrganizerElement      *Element                    `json:"_organizer,omitempty" bson:"organizer_element,omitempty"`                 // Extensions for organizer
 	Encounter             *Reference                  `json:"encounter,omitempty" bson:"encounter,omitempty"`                          // Healthcare event during which this observation is made. If you need to place the observation within one or more episodes of care, use the workflow-episodeOfCare extension
-	EffectiveDateTime     *string                     `json:"effectiveDateTime,omitempty" bson:"effective_date_time,omitempty"`        // Clinically relevant time/time-period for observation
-	EffectivePeriod       *Period                     `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`             // Clinically relevant time/time-period for observation
-	EffectiveTiming       *Timing                     `json:"effectiveTiming,omitempty" bson:"effective_timing,omitempty"`             // Clinically relevant time/time-period for observation
-	EffectiveInstant      *string                     `json:"effectiveInstant,omitempty" bson:"effective_instant,omitempty"`           // Clinically relevant time/time-period for observation
-	Issued                *string                     `json:"issued,omitempty" bson:"issued,omitempty"`                                // Date/Time this version was made available
+	Effective             ObservationEffective        `json:"-" bson:"effective,omitempty"`                                            // Clinically relevant time/time-period for observation
+	EffectiveElement      *Element                    `json:"-" bson:"effective_element,omitempty"`                                    // Extensions for effective[x]
+	Issued                *Instant                    `json:"issued,omitempty" bson:"issued,omitempty"`                                // Date/Time this version was made available
+	IssuedElement         *Element                    `json:"_issued,omitempty" bson:"issued_element,omitempty"`                       // Extensions for issued
 	Performer             []Reference                 `json:"performer,omitempty" bson:"performer,omitempty"`                          // Who is responsible for the observation
-	ValueQuantity         *Quantity                   `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                 // Actual result
-	ValueCodeableConcept  *CodeableConcept            `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`  // Actual result
-	ValueString           *string                     `json:"valueString,omitempty" bson:"value_string,omitempty"`                     // Actual result
-	ValueBoolean          *bool                       `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                   // Actual result
-	ValueInteger          *int                        `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                   // Actual result
-	ValueRange            *Range                      `json:"valueRange,omitempty" bson:"value_range,omitempty"`                       // Actual result
-	ValueRatio            *Ratio                      `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                       // Actual result
-	ValueSampledData      *SampledData                `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`          // Actual result
-	ValueTime             *string                     `json:"valueTime,omitempty" bson:"value_time,omitempty"`                         // Actual result
-	ValueDateTime         *string                     `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                // Actual result
-	ValuePeriod           *Period                     `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                     // Actual result
-	ValueAttachment       *Attachment                 `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`             // Actual result
+	Value                 ObservationValue            `json:"-" bson:"value,omitempty"`                                                // Actual result
+	ValueElement          *Element                    `json:"-" bson:"value_element,omitempty"`                                        // Extensions for value[x]
 	DataAbsentReason      *CodeableConcept            `json:"dataAbsentReason,omitempty" bson:"data_absent_reason,omitempty"`          // Why the result value is missing
 	Interpretation        []CodeableConcept           `json:"interpretation,omitempty" bson:"interpretation,omitempty"`                // High, low, normal, etc
 	InterpretationContext []CodeableReference         `json:"interpretationContext,omitempty" bson:"interpretation_context,omitempty"` // Context for understanding the observation
@@ -56,8 +52,31 @@ type Observation struct {
 	HasMember             []Reference                 `json:"hasMember,omitempty" bson:"has_member,omitempty"`                         // Related resource that belongs to the Observation group
 	DerivedFrom           []Reference                 `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                     // Related resource from which the observation is made
 	Component             []ObservationComponent      `json:"component,omitempty" bson:"component,omitempty"`                          // Component results
+
+	effectiveVariants []string // JSON properties of Observation.effective[x] when more than one was decoded
+	valueVariants     []string // JSON properties of Observation.value[x] when more than one was decoded
 }
 
+var observationBasedOnTargets = []string{"CarePlan", "DeviceRequest", "MedicationRequest", "NutritionOrder", "ServiceRequest"}
+
+var observationPartOfTargets = []string{"MedicationAdministration", "MedicationDispense", "MedicationStatement", "Procedure", "Immunization", "ImagingStudy"}
+
+var observationSubjectTargets = []string{"Patient", "Group", "Device", "Location", "Organization", "Procedure", "Practitioner", "Medication", "Substance", "BiologicallyDerivedProduct", "NutritionProduct"}
+
+var observationEncounterTargets = []string{"Encounter"}
+
+var observationPerformerTargets = []string{"Practitioner", "PractitionerRole", "Organization", "CareTeam", "Patient", "RelatedPerson", "HealthcareService", "Group"}
+
+var observationBodyStructureTargets = []string{"BodyStructure"}
+
+var observationSpecimenTargets = []string{"Specimen", "Group"}
+
+var observationDeviceTargets = []string{"Device", "DeviceMetric"}
+
+var observationHasMemberTargets = []string{"Observation", "QuestionnaireResponse"}
+
+var observationDerivedFromTargets = []string{"DocumentReference", "ImagingStudy", "ImagingSelection", "QuestionnaireResponse", "Observation"}
+
 func (r *Observation) Validate() error {
 	if r.ResourceType != "Observation" {
 		return fmt.Errorf("invalid resourceType: expected 'Observation', got '%s'", r.ResourceType)
@@ -67,16 +86,49 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, observationBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
@@ -87,15 +139,27 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("TriggeredBy[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, observationPartOfTargets)
+		}
+	}
 	for i, item := range r.PartOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -109,6 +173,11 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, observationSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, observationSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -119,59 +188,65 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("Focus[%d]: %w", i, err)
 		}
 	}
+	if r.OrganizerElement != nil {
+		if err := r.OrganizerElement.Validate(); err != nil {
+			return fmt.Errorf("OrganizerElement: %w", err)
+		}
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, observationEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, observationEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.EffectivePeriod != nil {
-		if err := r.EffectivePeriod.Validate(); err != nil {
-			return fmt.Errorf("EffectivePeriod: %w", err)
-		}
+	if len(r.effectiveVariants) > 1 {
+		return fmt.Errorf("field 'Effective' must have a single type, got %v", r.effectiveVariants)
 	}
-	if r.EffectiveTiming != nil {
-		if err := r.EffectiveTiming.Validate(); err != nil {
-			return fmt.Errorf("EffectiveTiming: %w", err)
+	if r.Effective != nil {
+		if err := r.Effective.Validate(); err != nil {
+			return fmt.Errorf("Effective: %w", err)
 		}
 	}
-	for i, item := range r.Performer {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Performer[%d]: %w", i, err)
+	if r.EffectiveElement != nil {
+		if err := r.EffectiveElement.Validate(); err != nil {
+			return fmt.Errorf("EffectiveElement: %w", err)
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			return fmt.Errorf("Issued: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.IssuedElement != nil {
+		if err := r.IssuedElement.Validate(); err != nil {
+			return fmt.Errorf("IssuedElement: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationPerformerTargets); target != "" {
+			return fmt.Errorf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, observationPerformerTargets)
 		}
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	for i, item := range r.Performer {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Performer[%d]: %w", i, err)
 		}
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
 	if r.DataAbsentReason != nil {
@@ -199,6 +274,11 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("BodySite: %w", err)
 		}
 	}
+	if r.BodyStructure != nil && r.BodyStructure.Reference != nil {
+		if target := invalidReferenceTarget(r.BodyStructure.Reference.Reference, r.BodyStructure.Reference.Type, observationBodyStructureTargets); target != "" {
+			return fmt.Errorf("field 'BodyStructure' cannot refer to %s, expected one of %v", target, observationBodyStructureTargets)
+		}
+	}
 	if r.BodyStructure != nil {
 		if err := r.BodyStructure.Validate(); err != nil {
 			return fmt.Errorf("BodyStructure: %w", err)
@@ -209,11 +289,21 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("Method: %w", err)
 		}
 	}
+	if r.Specimen != nil {
+		if target := invalidReferenceTarget(r.Specimen.Reference, r.Specimen.Type, observationSpecimenTargets); target != "" {
+			return fmt.Errorf("field 'Specimen' cannot refer to %s, expected one of %v", target, observationSpecimenTargets)
+		}
+	}
 	if r.Specimen != nil {
 		if err := r.Specimen.Validate(); err != nil {
 			return fmt.Errorf("Specimen: %w", err)
 		}
 	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, observationDeviceTargets); target != "" {
+			return fmt.Errorf("field 'Device' cannot refer to %s, expected one of %v", target, observationDeviceTargets)
+		}
+	}
 	if r.Device != nil {
 		if err := r.Device.Validate(); err != nil {
 			return fmt.Errorf("Device: %w", err)
@@ -224,11 +314,21 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("ReferenceRange[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.HasMember {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationHasMemberTargets); target != "" {
+			return fmt.Errorf("field 'HasMember[%d]' cannot refer to %s, expected one of %v", i, target, observationHasMemberTargets)
+		}
+	}
 	for i, item := range r.HasMember {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("HasMember[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.DerivedFrom {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationDerivedFromTargets); target != "" {
+			return fmt.Errorf("field 'DerivedFrom[%d]' cannot refer to %s, expected one of %v", i, target, observationDerivedFromTargets)
+		}
+	}
 	for i, item := range r.DerivedFrom {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("DerivedFrom[%d]: %w", i, err)
@@ -239,155 +339,1438 @@ func (r *Observation) Validate() error {
 			return fmt.Errorf("Component[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ObservationTriggeredBy struct {
-	Id          *string    `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Observation *Reference `json:"observation" bson:"observation"`           // Triggering observation
-	Type        string     `json:"type" bson:"type"`                         // reflex | repeat | re-run
-	Reason      *string    `json:"reason,omitempty" bson:"reason,omitempty"` // Reason that the observation was triggered
+	return validateInvariants(r, "Observation")
 }
 
-func (r *ObservationTriggeredBy) Validate() error {
-	if r.Observation == nil {
-		return fmt.Errorf("field 'Observation' is required")
+func (r *Observation) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Observation" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Observation', got '%s'", r.ResourceType))
 	}
-	if r.Observation != nil {
-		if err := r.Observation.Validate(); err != nil {
-			return fmt.Errorf("Observation: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	var emptyString string
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	return nil
-}
-
-type ObservationReferenceRange struct {
-	Id          *string           `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Low         *Quantity         `json:"low,omitempty" bson:"low,omitempty"`                  // Low Range, if relevant
-	High        *Quantity         `json:"high,omitempty" bson:"high,omitempty"`                // High Range, if relevant
-	NormalValue *CodeableConcept  `json:"normalValue,omitempty" bson:"normal_value,omitempty"` // Normal value, if relevant
-	Type        *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                // Reference range qualifier
-	AppliesTo   []CodeableConcept `json:"appliesTo,omitempty" bson:"applies_to,omitempty"`     // Reference range population
-	Age         *Range            `json:"age,omitempty" bson:"age,omitempty"`                  // Applicable age range, if relevant
-	Text        *string           `json:"text,omitempty" bson:"text,omitempty"`                // Text based reference range in an observation
-}
-
-func (r *ObservationReferenceRange) Validate() error {
-	if r.Low != nil {
-		if err := r.Low.Validate(); err != nil {
-			return fmt.Errorf("Low: %w", err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.High != nil {
-		if err := r.High.Validate(); err != nil {
-			return fmt.Errorf("High: %w", err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.NormalValue != nil {
-		if err := r.NormalValue.Validate(); err != nil {
-			return fmt.Errorf("NormalValue: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.AppliesTo {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AppliesTo[%d]: %w", i, err)
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, observationBasedOnTargets))
 		}
 	}
-	if r.Age != nil {
-		if err := r.Age.Validate(); err != nil {
-			return fmt.Errorf("Age: %w", err)
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.TriggeredBy {
+		item.validateAll(fmt.Sprintf("%s.triggeredBy[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationPartOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.partOf[%d]", path, i), fmt.Sprintf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, observationPartOfTargets))
 		}
 	}
-	return nil
-}
-
-type ObservationComponent struct {
-	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Code                 *CodeableConcept            `json:"code" bson:"code"`                                                       // Type of component observation (code / type)
-	ValueQuantity        *Quantity                   `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // Actual component result
-	ValueCodeableConcept *CodeableConcept            `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // Actual component result
-	ValueString          *string                     `json:"valueString,omitempty" bson:"value_string,omitempty"`                    // Actual component result
-	ValueBoolean         *bool                       `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // Actual component result
-	ValueInteger         *int                        `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                  // Actual component result
-	ValueRange           *Range                      `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // Actual component result
-	ValueRatio           *Ratio                      `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                      // Actual component result
-	ValueSampledData     *SampledData                `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`         // Actual component result
-	ValueTime            *string                     `json:"valueTime,omitempty" bson:"value_time,omitempty"`                        // Actual component result
-	ValueDateTime        *string                     `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`               // Actual component result
-	ValuePeriod          *Period                     `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                    // Actual component result
-	ValueAttachment      *Attachment                 `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`            // Actual component result
-	DataAbsentReason     *CodeableConcept            `json:"dataAbsentReason,omitempty" bson:"data_absent_reason,omitempty"`         // Why the component result value is missing
-	Interpretation       []CodeableConcept           `json:"interpretation,omitempty" bson:"interpretation,omitempty"`               // High, low, normal, etc
-	ReferenceRange       []ObservationReferenceRange `json:"referenceRange,omitempty" bson:"reference_range,omitempty"`              // Provides guide for interpretation of component result value
-}
-
-func (r *ObservationComponent) Validate() error {
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
 	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
+		issues.add("required", path+".code", "field 'Code' is required")
 	}
 	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, observationSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, observationSubjectTargets))
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
+	}
+	if r.OrganizerElement != nil {
+		r.OrganizerElement.validateAll(path+".organizer", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, observationEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, observationEncounterTargets))
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.effectiveVariants) > 1 {
+		issues.add("structure", path+".effective", fmt.Sprintf("field 'Effective' must have a single type, got %v", r.effectiveVariants))
+	}
+	if r.Effective != nil {
+		r.Effective.validateAll(path+".effective.ofType("+r.Effective.FHIRType()+")", issues)
+	}
+	if r.EffectiveElement != nil {
+		r.EffectiveElement.validateAll(path+".effective", issues)
+	}
+	if r.Issued != nil {
+		if err := r.Issued.Validate(); err != nil {
+			issues.add("value", path+".issued", err.Error())
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.IssuedElement != nil {
+		r.IssuedElement.validateAll(path+".issued", issues)
+	}
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationPerformerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.performer[%d]", path, i), fmt.Sprintf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, observationPerformerTargets))
 		}
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.DataAbsentReason != nil {
+		r.DataAbsentReason.validateAll(path+".dataAbsentReason", issues)
+	}
+	for i, item := range r.Interpretation {
+		item.validateAll(fmt.Sprintf("%s.interpretation[%d]", path, i), issues)
+	}
+	for i, item := range r.InterpretationContext {
+		item.validateAll(fmt.Sprintf("%s.interpretationContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	if r.BodySite != nil {
+		r.BodySite.validateAll(path+".bodySite", issues)
+	}
+	if r.BodyStructure != nil && r.BodyStructure.Reference != nil {
+		if target := invalidReferenceTarget(r.BodyStructure.Reference.Reference, r.BodyStructure.Reference.Type, observationBodyStructureTargets); target != "" {
+			issues.add("value", path+".bodyStructure", fmt.Sprintf("field 'BodyStructure' cannot refer to %s, expected one of %v", target, observationBodyStructureTargets))
 		}
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
+	if r.BodyStructure != nil {
+		r.BodyStructure.validateAll(path+".bodyStructure", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if r.Specimen != nil {
+		if target := invalidReferenceTarget(r.Specimen.Reference, r.Specimen.Type, observationSpecimenTargets); target != "" {
+			issues.add("value", path+".specimen", fmt.Sprintf("field 'Specimen' cannot refer to %s, expected one of %v", target, observationSpecimenTargets))
 		}
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	if r.Specimen != nil {
+		r.Specimen.validateAll(path+".specimen", issues)
+	}
+	if r.Device != nil {
+		if target := invalidReferenceTarget(r.Device.Reference, r.Device.Type, observationDeviceTargets); target != "" {
+			issues.add("value", path+".device", fmt.Sprintf("field 'Device' cannot refer to %s, expected one of %v", target, observationDeviceTargets))
 		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.Device != nil {
+		r.Device.validateAll(path+".device", issues)
+	}
+	for i, item := range r.ReferenceRange {
+		item.validateAll(fmt.Sprintf("%s.referenceRange[%d]", path, i), issues)
+	}
+	for i, item := range r.HasMember {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationHasMemberTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.hasMember[%d]", path, i), fmt.Sprintf("field 'HasMember[%d]' cannot refer to %s, expected one of %v", i, target, observationHasMemberTargets))
 		}
 	}
-	if r.DataAbsentReason != nil {
-		if err := r.DataAbsentReason.Validate(); err != nil {
-			return fmt.Errorf("DataAbsentReason: %w", err)
+	for i, item := range r.HasMember {
+		item.validateAll(fmt.Sprintf("%s.hasMember[%d]", path, i), issues)
+	}
+	for i, item := range r.DerivedFrom {
+		if target := invalidReferenceTarget(item.Reference, item.Type, observationDerivedFromTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.derivedFrom[%d]", path, i), fmt.Sprintf("field 'DerivedFrom[%d]' cannot refer to %s, expected one of %v", i, target, observationDerivedFromTargets))
 		}
 	}
-	for i, item := range r.Interpretation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Interpretation[%d]: %w", i, err)
+	for i, item := range r.DerivedFrom {
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+func (r *Observation) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Observation", &issues)
+	checkInvariants(r, "Observation", &issues)
+	return issues
+}
+
+var observationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+	{key: "obs-6", severity: "error", human: "Observation.dataAbsentReason SHALL only be present if Observation.value[x] is not present", expression: "dataAbsentReason.empty() or value.empty()"},
+	{key: "obs-7", severity: "error", human: "If Observation.component.code is the same as Observation.code, then Observation.value SHALL NOT be present (the Observation.component.value[x] holds the value).", expression: "value.empty() or component.code.where(coding.intersect(%resource.code.coding).exists()).empty()"},
+	{key: "obs-10", severity: "error", human: "Observation.component.dataAbsentReason SHALL only be present if Observation.component.value[x] is not present", expression: "component.empty() or component.where(dataAbsentReason.exists()).all(value.empty())"},
+	{key: "obs-11", severity: "error", human: "if organizer exists and organizer = true, then value[x], dataAbsentReason and component SHALL NOT be present", expression: "(organizer.exists() and organizer.allTrue()) implies (value.empty() and dataAbsentReason.empty() and component.empty())"},
+	{key: "obs-12", severity: "warning", human: "All observations SHOULD have a performer", expression: "performer.exists()"},
+	{key: "obs-9", severity: "error", human: "If Observation.specimen is a reference to Group, the group can only have specimens", expression: "(reference.resolve().exists() and reference.resolve() is Group) implies reference.resolve().member.entity.resolve().all($this is Specimen)", context: "specimen"},
+}
+
+func (r *Observation) invariants() []invariant {
+	return observationInvariants
+}
+
+func (r Observation) MarshalJSON() ([]byte, error) {
+	type alias Observation
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "effective", r.Effective, r.EffectiveElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Observation) UnmarshalJSON(data []byte) error {
+	type alias Observation
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	for i, item := range r.ReferenceRange {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("ReferenceRange[%d]: %w", i, err)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Effective, element, r.effectiveVariants, err = unmarshalChoice(fields, "effective", observationEffectiveVariants); err != nil {
+		return err
+	}
+	r.EffectiveElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.EffectiveElement); err != nil {
+			return fmt.Errorf("_effective: %w", err)
+		}
+	}
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", observationValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r Observation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Observation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Observation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Observation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Observation) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Effective":
+		return "effective", choiceValues(observationEffectiveVariants)
+	case "Value":
+		return "value", choiceValues(observationValueVariants)
+	}
+	return "", nil
+}
+
+var observationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Observation) elementTypes() map[string]string {
+	return observationElementTypes
+}
+
+var observationElementMetadata = []ElementMetadata{
+	{Path: "Observation.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Observation.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Observation.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Observation.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Observation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Observation.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Observation.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Observation.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Observation.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Observation.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}, Summary: true},
+	{Path: "Observation.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}, Summary: true},
+	{Path: "Observation.triggeredBy", Name: "triggeredBy", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Observation.partOf", Name: "partOf", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImagingStudy"}, Summary: true},
+	{Path: "Observation.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Observation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status", Description: "Codes providing the status of an observation."}, Summary: true, Modifier: true},
+	{Path: "Observation.category", Name: "category", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.category", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/observation-category", Description: "Codes for high level observation categories."}},
+	{Path: "Observation.code", Name: "code", Field: 18, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.code", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-codes-observationorboth", Description: "Codes identifying names of simple observations."}, Summary: true},
+	{Path: "Observation.subject", Name: "subject", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/NutritionProduct"}, Summary: true},
+	{Path: "Observation.focus", Name: "focus", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Summary: true, Modifier: true},
+	{Path: "Observation.organizer", Name: "organizer", Field: 21, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true},
+	{Path: "Observation.encounter", Name: "encounter", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}, Summary: true},
+	{Path: "Observation.effective[x]", Name: "effective", Field: 24, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing", "instant"}, Summary: true},
+	{Path: "Observation.issued", Name: "issued", Field: 26, Min: 0, Max: "1", Types: []string{"instant"}, Summary: true},
+	{Path: "Observation.performer", Name: "performer", Field: 28, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Group"}, Summary: true},
+	{Path: "Observation.value[x]", Name: "value", Field: 29, Min: 0, Max: "1", Types: []string{"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Ratio", "SampledData", "time", "dateTime", "Period", "Attachment"}, Summary: true},
+	{Path: "Observation.dataAbsentReason", Name: "dataAbsentReason", Field: 31, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.dataAbsentReason", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/data-absent-reason", Description: "Codes specifying why the result (`Observation.value[x]`) is missing."}},
+	{Path: "Observation.interpretation", Name: "interpretation", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.interpretation", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation", Description: "Codes identifying interpretations of observations."}},
+	{Path: "Observation.interpretationContext", Name: "interpretationContext", Field: 33, Min: 0, Max: "*", Types: []string{"CodeableReference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Binding: &BindingDefinition{Path: "Observation.interpretationContext", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation-context", Description: "Codes identifying contextual factors that affect interpretation of observations."}},
+	{Path: "Observation.note", Name: "note", Field: 34, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Observation.bodySite", Name: "bodySite", Field: 35, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.bodySite", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/body-site", Description: "SNOMED CT Body site concepts"}},
+	{Path: "Observation.bodyStructure", Name: "bodyStructure", Field: 36, Min: 0, Max: "1", Types: []string{"CodeableReference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BodyStructure"}, Binding: &BindingDefinition{Path: "Observation.bodyStructure", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/body-site", Description: "SNOMED CT Body Structures"}},
+	{Path: "Observation.method", Name: "method", Field: 37, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.method", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-methods", Description: "Methods for simple observations."}},
+	{Path: "Observation.specimen", Name: "specimen", Field: 38, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/Group"}},
+	{Path: "Observation.device", Name: "device", Field: 39, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceMetric"}},
+	{Path: "Observation.referenceRange", Name: "referenceRange", Field: 40, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Observation.hasMember", Name: "hasMember", Field: 41, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse"}, Summary: true},
+	{Path: "Observation.derivedFrom", Name: "derivedFrom", Field: 42, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/Observation"}, Summary: true},
+	{Path: "Observation.component", Name: "component", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}, Summary: true},
+}
+
+func (r *Observation) ElementMetadata() []ElementMetadata {
+	return observationElementMetadata
+}
+
+// ObservationEffective is the type of Observation.effective[x]. It is implemented by the ObservationEffective* variant types.
+type ObservationEffective interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isObservationEffective()
+}
+
+// ObservationEffectiveDateTime is the dateTime variant of ObservationEffective.
+type ObservationEffectiveDateTime struct {
+	DateTime
+}
+
+func (v ObservationEffectiveDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ObservationEffectiveDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ObservationEffectiveDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ObservationEffectiveDateTime) isObservationEffective() {}
+
+// ObservationEffectivePeriod is the Period variant of ObservationEffective.
+type ObservationEffectivePeriod struct {
+	Period
+}
+
+func (v ObservationEffectivePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ObservationEffectivePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ObservationEffectivePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ObservationEffectivePeriod) isObservationEffective() {}
+
+// ObservationEffectiveTiming is the Timing variant of ObservationEffective.
+type ObservationEffectiveTiming struct {
+	Timing
+}
+
+func (v ObservationEffectiveTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ObservationEffectiveTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ObservationEffectiveTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ObservationEffectiveTiming) isObservationEffective() {}
+
+// ObservationEffectiveInstant is the instant variant of ObservationEffective.
+type ObservationEffectiveInstant struct {
+	Instant
+}
+
+func (v ObservationEffectiveInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ObservationEffectiveInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ObservationEffectiveInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ObservationEffectiveInstant) isObservationEffective() {}
+
+var observationEffectiveVariants = []ObservationEffective{
+	ObservationEffectiveDateTime{},
+	ObservationEffectivePeriod{},
+	ObservationEffectiveTiming{},
+	ObservationEffectiveInstant{},
+}
+
+// ObservationValue is the type of Observation.value[x]. It is implemented by the ObservationValue* variant types.
+type ObservationValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isObservationValue()
+}
+
+// ObservationValueQuantity is the Quantity variant of ObservationValue.
+type ObservationValueQuantity struct {
+	Quantity
+}
+
+func (v ObservationValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ObservationValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ObservationValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ObservationValueQuantity) isObservationValue() {}
+
+// ObservationValueCodeableConcept is the CodeableConcept variant of ObservationValue.
+type ObservationValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ObservationValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ObservationValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ObservationValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ObservationValueCodeableConcept) isObservationValue() {}
+
+// ObservationValueString is the string variant of ObservationValue.
+type ObservationValueString string
+
+func (v ObservationValueString) Validate() error {
+	return nil
+}
+
+func (v ObservationValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ObservationValueString) isObservationValue() {}
+
+// ObservationValueBoolean is the boolean variant of ObservationValue.
+type ObservationValueBoolean bool
+
+func (v ObservationValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ObservationValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ObservationValueBoolean) isObservationValue() {}
+
+// ObservationValueInteger is the integer variant of ObservationValue.
+type ObservationValueInteger int
+
+func (v ObservationValueInteger) Validate() error {
+	return nil
+}
+
+func (v ObservationValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ObservationValueInteger) isObservationValue() {}
+
+// ObservationValueRange is the Range variant of ObservationValue.
+type ObservationValueRange struct {
+	Range
+}
+
+func (v ObservationValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ObservationValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ObservationValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ObservationValueRange) isObservationValue() {}
+
+// ObservationValueRatio is the Ratio variant of ObservationValue.
+type ObservationValueRatio struct {
+	Ratio
+}
+
+func (v ObservationValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ObservationValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ObservationValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ObservationValueRatio) isObservationValue() {}
+
+// ObservationValueSampledData is the SampledData variant of ObservationValue.
+type ObservationValueSampledData struct {
+	SampledData
+}
+
+func (v ObservationValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ObservationValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ObservationValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ObservationValueSampledData) isObservationValue() {}
+
+// ObservationValueTime is the time variant of ObservationValue.
+type ObservationValueTime struct {
+	Time
+}
+
+func (v ObservationValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ObservationValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ObservationValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ObservationValueTime) isObservationValue() {}
+
+// ObservationValueDateTime is the dateTime variant of ObservationValue.
+type ObservationValueDateTime struct {
+	DateTime
+}
+
+func (v ObservationValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ObservationValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ObservationValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ObservationValueDateTime) isObservationValue() {}
+
+// ObservationValuePeriod is the Period variant of ObservationValue.
+type ObservationValuePeriod struct {
+	Period
+}
+
+func (v ObservationValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ObservationValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ObservationValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ObservationValuePeriod) isObservationValue() {}
+
+// ObservationValueAttachment is the Attachment variant of ObservationValue.
+type ObservationValueAttachment struct {
+	Attachment
+}
+
+func (v ObservationValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ObservationValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ObservationValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ObservationValueAttachment) isObservationValue() {}
+
+var observationValueVariants = []ObservationValue{
+	ObservationValueQuantity{},
+	ObservationValueCodeableConcept{},
+	ObservationValueString(""),
+	ObservationValueBoolean(false),
+	ObservationValueInteger(0),
+	ObservationValueRange{},
+	ObservationValueRatio{},
+	ObservationValueSampledData{},
+	ObservationValueTime{},
+	ObservationValueDateTime{},
+	ObservationValuePeriod{},
+	ObservationValueAttachment{},
+}
+
+func (r *Observation) GetResourceType() string {
+	return "Observation"
+}
+
+func (r *Observation) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Observation) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Observation) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Observation) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Observation) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Observation) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Observation) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Observation)(nil)
+
+type ObservationTriggeredBy struct {
+	Id                *string         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Observation       *Reference      `json:"observation" bson:"observation"`                                  // Triggering observation
+	Type              TriggeredBytype `json:"type" bson:"type"`                                                // reflex | repeat | re-run
+	TypeElement       *Element        `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Reason            *string         `json:"reason,omitempty" bson:"reason,omitempty"`                        // Reason that the observation was triggered
+	ReasonElement     *Element        `json:"_reason,omitempty" bson:"reason_element,omitempty"`               // Extensions for reason
+}
+
+var observationTriggeredByObservationTargets = []string{"Observation"}
+
+func (r *ObservationTriggeredBy) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Observation == nil {
+		return fmt.Errorf("field 'Observation' is required")
+	}
+	if r.Observation != nil {
+		if target := invalidReferenceTarget(r.Observation.Reference, r.Observation.Type, observationTriggeredByObservationTargets); target != "" {
+			return fmt.Errorf("field 'Observation' cannot refer to %s, expected one of %v", target, observationTriggeredByObservationTargets)
+		}
+	}
+	if r.Observation != nil {
+		if err := r.Observation.Validate(); err != nil {
+			return fmt.Errorf("Observation: %w", err)
+		}
+	}
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.ReasonElement != nil {
+		if err := r.ReasonElement.Validate(); err != nil {
+			return fmt.Errorf("ReasonElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ObservationTriggeredBy) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Observation == nil {
+		issues.add("required", path+".observation", "field 'Observation' is required")
+	}
+	if r.Observation != nil {
+		if target := invalidReferenceTarget(r.Observation.Reference, r.Observation.Type, observationTriggeredByObservationTargets); target != "" {
+			issues.add("value", path+".observation", fmt.Sprintf("field 'Observation' cannot refer to %s, expected one of %v", target, observationTriggeredByObservationTargets))
+		}
+	}
+	if r.Observation != nil {
+		r.Observation.validateAll(path+".observation", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.ReasonElement != nil {
+		r.ReasonElement.validateAll(path+".reason", issues)
+	}
+}
+
+var observationTriggeredByInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ObservationTriggeredBy) invariants() []invariant {
+	return observationTriggeredByInvariants
+}
+
+func (r ObservationTriggeredBy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ObservationTriggeredBy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ObservationTriggeredBy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ObservationTriggeredBy) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var observationTriggeredByElementMetadata = []ElementMetadata{
+	{Path: "Observation.triggeredBy.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Observation.triggeredBy.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Observation.triggeredBy.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Observation.triggeredBy.observation", Name: "observation", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Observation"}, Summary: true},
+	{Path: "Observation.triggeredBy.type", Name: "type", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Observation.triggeredBy.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-triggeredbytype", Description: "The type of TriggeredBy Observation."}, Summary: true},
+	{Path: "Observation.triggeredBy.reason", Name: "reason", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ObservationTriggeredBy) ElementMetadata() []ElementMetadata {
+	return observationTriggeredByElementMetadata
+}
+
+type ObservationReferenceRange struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Low               *Quantity         `json:"low,omitempty" bson:"low,omitempty"`                              // Low Range, if relevant
+	High              *Quantity         `json:"high,omitempty" bson:"high,omitempty"`                            // High Range, if relevant
+	NormalValue       *CodeableConcept  `json:"normalValue,omitempty" bson:"normal_value,omitempty"`             // Normal value, if relevant
+	Type              *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                            // Reference range qualifier
+	AppliesTo         []CodeableConcept `json:"appliesTo,omitempty" bson:"applies_to,omitempty"`                 // Reference range population
+	Age               *Range            `json:"age,omitempty" bson:"age,omitempty"`                              // Applicable age range, if relevant
+	Text              *string           `json:"text,omitempty" bson:"text,omitempty"`                            // Text based reference range in an observation
+	TextElement       *Element          `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+}
+
+func (r *ObservationReferenceRange) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Low != nil {
+		if err := r.Low.Validate(); err != nil {
+			return fmt.Errorf("Low: %w", err)
+		}
+	}
+	if r.High != nil {
+		if err := r.High.Validate(); err != nil {
+			return fmt.Errorf("High: %w", err)
+		}
+	}
+	if r.NormalValue != nil {
+		if err := r.NormalValue.Validate(); err != nil {
+			return fmt.Errorf("NormalValue: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	for i, item := range r.AppliesTo {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AppliesTo[%d]: %w", i, err)
+		}
+	}
+	if r.Age != nil {
+		if err := r.Age.Validate(); err != nil {
+			return fmt.Errorf("Age: %w", err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ObservationReferenceRange) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Low != nil {
+		r.Low.validateAll(path+".low", issues)
+	}
+	if r.High != nil {
+		r.High.validateAll(path+".high", issues)
+	}
+	if r.NormalValue != nil {
+		r.NormalValue.validateAll(path+".normalValue", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.AppliesTo {
+		item.validateAll(fmt.Sprintf("%s.appliesTo[%d]", path, i), issues)
+	}
+	if r.Age != nil {
+		r.Age.validateAll(path+".age", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+var observationReferenceRangeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "obs-3", severity: "error", human: "Must have at least a low or a high or text", expression: "low.exists() or high.exists() or text.exists()"},
+	{key: "obs-13", severity: "error", human: "If low.comparator exists, it must be '>=' or '>'.", expression: "comparator.exists() implies (comparator = '>=' or comparator = '>')", context: "low"},
+	{key: "obs-14", severity: "error", human: "If high.comparator exists, it must be '<=' or '<'.", expression: "comparator.exists() implies (comparator = '<=' or comparator = '<')", context: "high"},
+}
+
+func (r *ObservationReferenceRange) invariants() []invariant {
+	return observationReferenceRangeInvariants
+}
+
+func (r ObservationReferenceRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ObservationReferenceRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ObservationReferenceRange) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ObservationReferenceRange) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var observationReferenceRangeElementTypes = map[string]string{
+	"text": "markdown",
+}
+
+func (r *ObservationReferenceRange) elementTypes() map[string]string {
+	return observationReferenceRangeElementTypes
+}
+
+var observationReferenceRangeElementMetadata = []ElementMetadata{
+	{Path: "Observation.referenceRange.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Observation.referenceRange.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Observation.referenceRange.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Observation.referenceRange.low", Name: "low", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Observation.referenceRange.high", Name: "high", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Observation.referenceRange.normalValue", Name: "normalValue", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.referenceRange.normalValue", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-referencerange-normalvalue", Description: "Codes identifying the normal value of the observation."}},
+	{Path: "Observation.referenceRange.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.referenceRange.type", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/referencerange-meaning", Description: "Code for the meaning of a reference range."}},
+	{Path: "Observation.referenceRange.appliesTo", Name: "appliesTo", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.referenceRange.appliesTo", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/referencerange-appliesto", Description: "Codes identifying the population the reference range applies to."}},
+	{Path: "Observation.referenceRange.age", Name: "age", Field: 8, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "Observation.referenceRange.text", Name: "text", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ObservationReferenceRange) ElementMetadata() []ElementMetadata {
+	return observationReferenceRangeElementMetadata
+}
+
+type ObservationComponent struct {
+	Id                *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept            `json:"code" bson:"code"`                                                // Type of component observation (code / type)
+	Value             ObservationComponentValue   `json:"-" bson:"value,omitempty"`                                        // Actual component result
+	ValueElement      *Element                    `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	DataAbsentReason  *CodeableConcept            `json:"dataAbsentReason,omitempty" bson:"data_absent_reason,omitempty"`  // Why the component result value is missing
+	Interpretation    []CodeableConcept           `json:"interpretation,omitempty" bson:"interpretation,omitempty"`        // High, low, normal, etc
+	ReferenceRange    []ObservationReferenceRange `json:"referenceRange,omitempty" bson:"reference_range,omitempty"`       // Provides guide for interpretation of component result value
+
+	valueVariants []string // JSON properties of Observation.component.value[x] when more than one was decoded
+}
+
+func (r *ObservationComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.DataAbsentReason != nil {
+		if err := r.DataAbsentReason.Validate(); err != nil {
+			return fmt.Errorf("DataAbsentReason: %w", err)
+		}
+	}
+	for i, item := range r.Interpretation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Interpretation[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ReferenceRange {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReferenceRange[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ObservationComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.DataAbsentReason != nil {
+		r.DataAbsentReason.validateAll(path+".dataAbsentReason", issues)
+	}
+	for i, item := range r.Interpretation {
+		item.validateAll(fmt.Sprintf("%s.interpretation[%d]", path, i), issues)
+	}
+	for i, item := range r.ReferenceRange {
+		item.validateAll(fmt.Sprintf("%s.referenceRange[%d]", path, i), issues)
+	}
+}
+
+var observationComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ObservationComponent) invariants() []invariant {
+	return observationComponentInvariants
+}
+
+func (r ObservationComponent) MarshalJSON() ([]byte, error) {
+	type alias ObservationComponent
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ObservationComponent) UnmarshalJSON(data []byte) error {
+	type alias ObservationComponent
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", observationComponentValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ObservationComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ObservationComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ObservationComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ObservationComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ObservationComponent) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(observationComponentValueVariants)
+	}
+	return "", nil
+}
+
+var observationComponentElementMetadata = []ElementMetadata{
+	{Path: "Observation.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Observation.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Observation.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Observation.component.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.component.code", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/observation-codes-observationorboth", Description: "Codes identifying names of simple observations."}, Summary: true},
+	{Path: "Observation.component.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Ratio", "SampledData", "time", "dateTime", "Period", "Attachment"}, Summary: true},
+	{Path: "Observation.component.dataAbsentReason", Name: "dataAbsentReason", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.component.dataAbsentReason", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/data-absent-reason", Description: "Codes specifying why the result (`Observation.value[x]`) is missing."}},
+	{Path: "Observation.component.interpretation", Name: "interpretation", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Observation.component.interpretation", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/observation-interpretation", Description: "Codes identifying interpretations of observations."}},
+	{Path: "Observation.component.referenceRange", Name: "referenceRange", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ObservationComponent) ElementMetadata() []ElementMetadata {
+	return observationComponentElementMetadata
+}
+
+// ObservationComponentValue is the type of Observation.component.value[x]. It is implemented by the ObservationComponentValue* variant types.
+type ObservationComponentValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isObservationComponentValue()
+}
+
+// ObservationComponentValueQuantity is the Quantity variant of ObservationComponentValue.
+type ObservationComponentValueQuantity struct {
+	Quantity
+}
+
+func (v ObservationComponentValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ObservationComponentValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ObservationComponentValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ObservationComponentValueQuantity) isObservationComponentValue() {}
+
+// ObservationComponentValueCodeableConcept is the CodeableConcept variant of ObservationComponentValue.
+type ObservationComponentValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ObservationComponentValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ObservationComponentValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ObservationComponentValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ObservationComponentValueCodeableConcept) isObservationComponentValue() {}
+
+// ObservationComponentValueString is the string variant of ObservationComponentValue.
+type ObservationComponentValueString string
+
+func (v ObservationComponentValueString) Validate() error {
+	return nil
+}
+
+func (v ObservationComponentValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationComponentValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ObservationComponentValueString) isObservationComponentValue() {}
+
+// ObservationComponentValueBoolean is the boolean variant of ObservationComponentValue.
+type ObservationComponentValueBoolean bool
+
+func (v ObservationComponentValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ObservationComponentValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationComponentValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ObservationComponentValueBoolean) isObservationComponentValue() {}
+
+// ObservationComponentValueInteger is the integer variant of ObservationComponentValue.
+type ObservationComponentValueInteger int
+
+func (v ObservationComponentValueInteger) Validate() error {
+	return nil
+}
+
+func (v ObservationComponentValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationComponentValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ObservationComponentValueInteger) isObservationComponentValue() {}
+
+// ObservationComponentValueRange is the Range variant of ObservationComponentValue.
+type ObservationComponentValueRange struct {
+	Range
+}
+
+func (v ObservationComponentValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ObservationComponentValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ObservationComponentValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ObservationComponentValueRange) isObservationComponentValue() {}
+
+// ObservationComponentValueRatio is the Ratio variant of ObservationComponentValue.
+type ObservationComponentValueRatio struct {
+	Ratio
+}
+
+func (v ObservationComponentValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ObservationComponentValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ObservationComponentValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ObservationComponentValueRatio) isObservationComponentValue() {}
+
+// ObservationComponentValueSampledData is the SampledData variant of ObservationComponentValue.
+type ObservationComponentValueSampledData struct {
+	SampledData
+}
+
+func (v ObservationComponentValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ObservationComponentValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ObservationComponentValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ObservationComponentValueSampledData) isObservationComponentValue() {}
+
+// ObservationComponentValueTime is the time variant of ObservationComponentValue.
+type ObservationComponentValueTime struct {
+	Time
+}
+
+func (v ObservationComponentValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ObservationComponentValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ObservationComponentValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ObservationComponentValueTime) isObservationComponentValue() {}
+
+// ObservationComponentValueDateTime is the dateTime variant of ObservationComponentValue.
+type ObservationComponentValueDateTime struct {
+	DateTime
+}
+
+func (v ObservationComponentValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ObservationComponentValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ObservationComponentValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ObservationComponentValueDateTime) isObservationComponentValue() {}
+
+// ObservationComponentValuePeriod is the Period variant of ObservationComponentValue.
+type ObservationComponentValuePeriod struct {
+	Period
+}
+
+func (v ObservationComponentValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ObservationComponentValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ObservationComponentValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ObservationComponentValuePeriod) isObservationComponentValue() {}
+
+// ObservationComponentValueAttachment is the Attachment variant of ObservationComponentValue.
+type ObservationComponentValueAttachment struct {
+	Attachment
+}
+
+func (v ObservationComponentValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ObservationComponentValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ObservationComponentValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ObservationComponentValueAttachment) isObservationComponentValue() {}
+
+var observationComponentValueVariants = []ObservationComponentValue{
+	ObservationComponentValueQuantity{},
+	ObservationComponentValueCodeableConcept{},
+	ObservationComponentValueString(""),
+	ObservationComponentValueBoolean(false),
+	ObservationComponentValueInteger(0),
+	ObservationComponentValueRange{},
+	ObservationComponentValueRatio{},
+	ObservationComponentValueSampledData{},
+	ObservationComponentValueTime{},
+	ObservationComponentValueDateTime{},
+	ObservationComponentValuePeriod{},
+	ObservationComponentValueAttachment{},
+}
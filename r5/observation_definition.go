@@ -2,57 +2,84 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Set of definitional characteristics for a kind of observation or measurement produced or consumed by an orderable health care service.
 type ObservationDefinition struct {
-	ResourceType           string                                `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                               `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                               `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage                     `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                               `json:"url,omitempty" bson:"url,omitempty"`                                         // Logical canonical URL to reference this ObservationDefinition (globally unique)
-	Identifier             *Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business identifier of the ObservationDefinition
-	Version                *string                               `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the ObservationDefinition
-	VersionAlgorithmString *string                               `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                               `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                               `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this ObservationDefinition (computer friendly)
-	Title                  *string                               `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this ObservationDefinition (human friendly)
-	Status                 string                                `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                                 `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // If For testing only - never for real usage
-	Date                   *string                               `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                               `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // The name of the individual or organization that published the ObservationDefinition
-	Contact                []ContactDetail                       `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                               `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the ObservationDefinition
-	UseContext             []UsageContext                        `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // Content intends to support these contexts
-	Jurisdiction           []CodeableConcept                     `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the this ObservationDefinition (if applicable)
-	Purpose                *string                               `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this ObservationDefinition is defined
-	Copyright              *string                               `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                               `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string                               `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When ObservationDefinition was approved by publisher
-	LastReviewDate         *string                               `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // Date on which the asset content was last reviewed by the publisher
-	EffectivePeriod        *Period                               `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // The effective date range for the ObservationDefinition
-	DerivedFromCanonical   []string                              `json:"derivedFromCanonical,omitempty" bson:"derived_from_canonical,omitempty"`     // Based on FHIR definition of another observation
-	DerivedFromUri         []string                              `json:"derivedFromUri,omitempty" bson:"derived_from_uri,omitempty"`                 // Based on external definition
-	Subject                []CodeableConcept                     `json:"subject,omitempty" bson:"subject,omitempty"`                                 // Type of subject for the defined observation
-	PerformerType          *CodeableConcept                      `json:"performerType,omitempty" bson:"performer_type,omitempty"`                    // Desired kind of performer for such kind of observation
-	Category               []CodeableConcept                     `json:"category,omitempty" bson:"category,omitempty"`                               // General type of observation
-	Code                   *CodeableConcept                      `json:"code" bson:"code"`                                                           // Type of observation
-	PermittedDataType      []string                              `json:"permittedDataType,omitempty" bson:"permitted_data_type,omitempty"`           // Quantity | CodeableConcept | string | boolean | integer | Range | Ratio | SampledData | time | dateTime | Period
-	MultipleResultsAllowed *bool                                 `json:"multipleResultsAllowed,omitempty" bson:"multiple_results_allowed,omitempty"` // Multiple results allowed for conforming observations
-	BodyStructure          *CodeableReference                    `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`                    // Body structure to be observed
-	Method                 *CodeableConcept                      `json:"method,omitempty" bson:"method,omitempty"`                                   // Method used to produce the observation
-	Specimen               []Reference                           `json:"specimen,omitempty" bson:"specimen,omitempty"`                               // Kind of specimen used by this type of observation
-	DeviceReference        *Reference                            `json:"deviceReference,omitempty" bson:"device_reference,omitempty"`                // Measurement device or model of device
-	DeviceCanonical        *string                               `json:"deviceCanonical,omitempty" bson:"device_canonical,omitempty"`                // Measurement device or model of device
-	PreferredReportName    *string                               `json:"preferredReportName,omitempty" bson:"preferred_report_name,omitempty"`       // The preferred name to be used when reporting the observation results
-	PermittedUnit          []Coding                              `json:"permittedUnit,omitempty" bson:"permitted_unit,omitempty"`                    // Unit for quantitative results
-	QualifiedValue         []ObservationDefinitionQualifiedValue `json:"qualifiedValue,omitempty" bson:"qualified_value,omitempty"`                  // Set of qualified values for observation results
-	HasMember              []Reference                           `json:"hasMember,omitempty" bson:"has_member,omitempty"`                            // Definitions of related resources belonging to this kind of observation group
-	Component              []ObservationDefinitionComponent      `json:"component,omitempty" bson:"component,omitempty"`                             // Component results
+	ResourceType                  string                                `json:"resourceType" bson:"resource_type"`                                                   // Type of resource
+	Id                            *string                               `json:"id,omitempty" bson:"id,omitempty"`                                                    // Logical id of this artifact
+	Meta                          *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                                                // Metadata about the resource
+	ImplicitRules                 *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                             // A set of rules under which this content was created
+	ImplicitRulesElement          *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                    // Extensions for implicitRules
+	Language                      *string                               `json:"language,omitempty" bson:"language,omitempty"`                                        // Language of the resource content
+	LanguageElement               *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`                               // Extensions for language
+	Text                          *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                                                // Text summary of the resource, for human interpretation
+	Contained                     []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                                      // Contained, inline Resources
+	Extension                     []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                                      // Additional content defined by implementations
+	ModifierExtension             []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                     // Extensions that cannot be ignored
+	Url                           *string                               `json:"url,omitempty" bson:"url,omitempty"`                                                  // Logical canonical URL to reference this ObservationDefinition (globally unique)
+	UrlElement                    *Element                              `json:"_url,omitempty" bson:"url_element,omitempty"`                                         // Extensions for url
+	Identifier                    *Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`                                    // Business identifier of the ObservationDefinition
+	Version                       *string                               `json:"version,omitempty" bson:"version,omitempty"`                                          // Business version of the ObservationDefinition
+	VersionElement                *Element                              `json:"_version,omitempty" bson:"version_element,omitempty"`                                 // Extensions for version
+	VersionAlgorithm              ObservationDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                                // How to compare versions
+	VersionAlgorithmElement       *Element                              `json:"-" bson:"version_algorithm_element,omitempty"`                                        // Extensions for versionAlgorithm[x]
+	Name                          *string                               `json:"name,omitempty" bson:"name,omitempty"`                                                // Name for this ObservationDefinition (computer friendly)
+	NameElement                   *Element                              `json:"_name,omitempty" bson:"name_element,omitempty"`                                       // Extensions for name
+	Title                         *string                               `json:"title,omitempty" bson:"title,omitempty"`                                              // Name for this ObservationDefinition (human friendly)
+	TitleElement                  *Element                              `json:"_title,omitempty" bson:"title_element,omitempty"`                                     // Extensions for title
+	Status                        PublicationStatus                     `json:"status" bson:"status"`                                                                // draft | active | retired | unknown
+	StatusElement                 *Element                              `json:"_status,omitempty" bson:"status_element,omitempty"`                                   // Extensions for status
+	Experimental                  *bool                                 `json:"experimental,omitempty" bson:"experimental,omitempty"`                                // If For testing only - never for real usage
+	ExperimentalElement           *Element                              `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                       // Extensions for experimental
+	Date                          *DateTime                             `json:"date,omitempty" bson:"date,omitempty"`                                                // Date last changed
+	DateElement                   *Element                              `json:"_date,omitempty" bson:"date_element,omitempty"`                                       // Extensions for date
+	Publisher                     *string                               `json:"publisher,omitempty" bson:"publisher,omitempty"`                                      // The name of the individual or organization that published the ObservationDefinition
+	PublisherElement              *Element                              `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                             // Extensions for publisher
+	Contact                       []ContactDetail                       `json:"contact,omitempty" bson:"contact,omitempty"`                                          // Contact details for the publisher
+	Description                   *string                               `json:"description,omitempty" bson:"description,omitempty"`                                  // Natural language description of the ObservationDefinition
+	DescriptionElement            *Element                              `json:"_description,omitempty" bson:"description_element,omitempty"`                         // Extensions for description
+	UseContext                    []UsageContext                        `json:"useContext,omitempty" bson:"use_context,omitempty"`                                   // Content intends to support these contexts
+	Jurisdiction                  []CodeableConcept                     `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                                // Jurisdiction of the authority that maintains the this ObservationDefinition (if applicable)
+	Purpose                       *string                               `json:"purpose,omitempty" bson:"purpose,omitempty"`                                          // Why this ObservationDefinition is defined
+	PurposeElement                *Element                              `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                                 // Extensions for purpose
+	Copyright                     *string                               `json:"copyright,omitempty" bson:"copyright,omitempty"`                                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement              *Element                              `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                             // Extensions for copyright
+	CopyrightLabel                *string                               `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                           // Copyright holder and year(s)
+	CopyrightLabelElement         *Element                              `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`                  // Extensions for copyrightLabel
+	ApprovalDate                  *Date                                 `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                               // When ObservationDefinition was approved by publisher
+	ApprovalDateElement           *Element                              `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`                      // Extensions for approvalDate
+	LastReviewDate                *Date                                 `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                          // Date on which the asset content was last reviewed by the publisher
+	LastReviewDateElement         *Element                              `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`                 // Extensions for lastReviewDate
+	EffectivePeriod               *Period                               `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                         // The effective date range for the ObservationDefinition
+	DerivedFromCanonical          []string                              `json:"derivedFromCanonical,omitempty" bson:"derived_from_canonical,omitempty"`              // Based on FHIR definition of another observation
+	DerivedFromCanonicalElement   []*Element                            `json:"_derivedFromCanonical,omitempty" bson:"derived_from_canonical_element,omitempty"`     // Extensions for derivedFromCanonical
+	DerivedFromUri                []string                              `json:"derivedFromUri,omitempty" bson:"derived_from_uri,omitempty"`                          // Based on external definition
+	DerivedFromUriElement         []*Element                            `json:"_derivedFromUri,omitempty" bson:"derived_from_uri_element,omitempty"`                 // Extensions for derivedFromUri
+	Subject                       []CodeableConcept                     `json:"subject,omitempty" bson:"subject,omitempty"`                                          // Type of subject for the defined observation
+	PerformerType                 *CodeableConcept                      `json:"performerType,omitempty" bson:"performer_type,omitempty"`                             // Desired kind of performer for such kind of observation
+	Category                      []CodeableConcept                     `json:"category,omitempty" bson:"category,omitempty"`                                        // General type of observation
+	Code                          *CodeableConcept                      `json:"code" bson:"code"`                                                                    // Type of observation
+	PermittedDataType             []ObservationDataType                 `json:"permittedDataType,omitempty" bson:"permitted_data_type,omitempty"`                    // Quantity | CodeableConcept | string | boolean | integer | Range | Ratio | SampledData | time | dateTime | Period
+	PermittedDataTypeElement      []*Element                            `json:"_permittedDataType,omitempty" bson:"permitted_data_type_element,omitempty"`           // Extensions for permittedDataType
+	MultipleResultsAllowed        *bool                                 `json:"multipleResultsAllowed,omitempty" bson:"multiple_results_allowed,omitempty"`          // Multiple results allowed for conforming observations
+	MultipleResultsAllowedElement *Element                              `json:"_multipleResultsAllowed,omitempty" bson:"multiple_results_allowed_element,omitempty"` // Extensions for multipleResultsAllowed
+	BodyStructure                 *CodeableReference                    `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`                             // Body structure to be observed
+	Method                        *CodeableConcept                      `json:"method,omitempty" bson:"method,omitempty"`                                            // Method used to produce the observation
+	Specimen                      []Reference                           `json:"specimen,omitempty" bson:"specimen,omitempty"`                                        // Kind of specimen used by this type of observation
+	Device                        ObservationDefinitionDevice           `json:"-" bson:"device,omitempty"`                                                           // Measurement device or model of device
+	DeviceElement                 *Element                              `json:"-" bson:"device_element,omitempty"`                                                   // Extensions for device[x]
+	PreferredReportName           *string                               `json:"preferredReportName,omitempty" bson:"preferred_report_name,omitempty"`                // The preferred name to be used when reporting the observation results
+	PreferredReportNameElement    *Element                              `json:"_preferredReportName,omitempty" bson:"preferred_report_name_element,omitempty"`       // Extensions for preferredReportName
+	PermittedUnit                 []Coding                              `json:"permittedUnit,omitempty" bson:"permitted_unit,omitempty"`                             // Unit for quantitative results
+	QualifiedValue                []ObservationDefinitionQualifiedValue `json:"qualifiedValue,omitempty" bson:"qualified_value,omitempty"`                           // Set of qualified values for observation results
+	HasMember                     []Reference                           `json:"hasMember,omitempty" bson:"has_member,omitempty"`                                     // Definitions of related resources belonging to this kind of observation group
+	Component                     []ObservationDefinitionComponent      `json:"component,omitempty" bson:"component,omitempty"`                                      // Component results
+
+	versionAlgorithmVariants []string // JSON properties of ObservationDefinition.versionAlgorithm[x] when more than one was decoded
+	deviceVariants           []string // JSON properties of ObservationDefinition.device[x] when more than one was decoded
 }
 
 func (r *ObservationDefinition) Validate() error {
@@ -64,30 +91,118 @@ func (r *ObservationDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -98,11 +213,62 @@ func (r *ObservationDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
 		}
 	}
+	for i, item := range r.DerivedFromCanonicalElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromCanonicalElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DerivedFromUriElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromUriElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Subject {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Subject[%d]: %w", i, err)
@@ -126,6 +292,24 @@ func (r *ObservationDefinition) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	for i, item := range r.PermittedDataType {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'PermittedDataType[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.PermittedDataTypeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PermittedDataTypeElement[%d]: %w", i, err)
+		}
+	}
+	if r.MultipleResultsAllowedElement != nil {
+		if err := r.MultipleResultsAllowedElement.Validate(); err != nil {
+			return fmt.Errorf("MultipleResultsAllowedElement: %w", err)
+		}
+	}
 	if r.BodyStructure != nil {
 		if err := r.BodyStructure.Validate(); err != nil {
 			return fmt.Errorf("BodyStructure: %w", err)
@@ -141,9 +325,22 @@ func (r *ObservationDefinition) Validate() error {
 			return fmt.Errorf("Specimen[%d]: %w", i, err)
 		}
 	}
-	if r.DeviceReference != nil {
-		if err := r.DeviceReference.Validate(); err != nil {
-			return fmt.Errorf("DeviceReference: %w", err)
+	if len(r.deviceVariants) > 1 {
+		return fmt.Errorf("field 'Device' must have a single type, got %v", r.deviceVariants)
+	}
+	if r.Device != nil {
+		if err := r.Device.Validate(); err != nil {
+			return fmt.Errorf("Device: %w", err)
+		}
+	}
+	if r.DeviceElement != nil {
+		if err := r.DeviceElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceElement: %w", err)
+		}
+	}
+	if r.PreferredReportNameElement != nil {
+		if err := r.PreferredReportNameElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredReportNameElement: %w", err)
 		}
 	}
 	for i, item := range r.PermittedUnit {
@@ -166,27 +363,569 @@ func (r *ObservationDefinition) Validate() error {
 			return fmt.Errorf("Component[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "ObservationDefinition")
+}
+
+func (r *ObservationDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ObservationDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ObservationDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.DerivedFromCanonicalElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.derivedFromCanonical[%d]", path, i), issues)
+	}
+	for i, item := range r.DerivedFromUriElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.derivedFromUri[%d]", path, i), issues)
+	}
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
+	}
+	if r.PerformerType != nil {
+		r.PerformerType.validateAll(path+".performerType", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.PermittedDataType {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.permittedDataType[%d]", path, i), fmt.Sprintf("field 'PermittedDataType[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.PermittedDataTypeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.permittedDataType[%d]", path, i), issues)
+	}
+	if r.MultipleResultsAllowedElement != nil {
+		r.MultipleResultsAllowedElement.validateAll(path+".multipleResultsAllowed", issues)
+	}
+	if r.BodyStructure != nil {
+		r.BodyStructure.validateAll(path+".bodyStructure", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	for i, item := range r.Specimen {
+		item.validateAll(fmt.Sprintf("%s.specimen[%d]", path, i), issues)
+	}
+	if len(r.deviceVariants) > 1 {
+		issues.add("structure", path+".device", fmt.Sprintf("field 'Device' must have a single type, got %v", r.deviceVariants))
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device.ofType("+r.Device.FHIRType()+")", issues)
+	}
+	if r.DeviceElement != nil {
+		r.DeviceElement.validateAll(path+".device", issues)
+	}
+	if r.PreferredReportNameElement != nil {
+		r.PreferredReportNameElement.validateAll(path+".preferredReportName", issues)
+	}
+	for i, item := range r.PermittedUnit {
+		item.validateAll(fmt.Sprintf("%s.permittedUnit[%d]", path, i), issues)
+	}
+	for i, item := range r.QualifiedValue {
+		item.validateAll(fmt.Sprintf("%s.qualifiedValue[%d]", path, i), issues)
+	}
+	for i, item := range r.HasMember {
+		item.validateAll(fmt.Sprintf("%s.hasMember[%d]", path, i), issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+func (r *ObservationDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ObservationDefinition", &issues)
+	checkInvariants(r, "ObservationDefinition", &issues)
+	return issues
+}
+
+var observationDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ObservationDefinition) invariants() []invariant {
+	return observationDefinitionInvariants
+}
+
+func (r ObservationDefinition) MarshalJSON() ([]byte, error) {
+	type alias ObservationDefinition
+	out := struct {
+		alias
+		DerivedFromCanonical        []*string              `json:"derivedFromCanonical,omitempty"`
+		DerivedFromCanonicalElement []*Element             `json:"_derivedFromCanonical,omitempty"`
+		DerivedFromUri              []*string              `json:"derivedFromUri,omitempty"`
+		DerivedFromUriElement       []*Element             `json:"_derivedFromUri,omitempty"`
+		PermittedDataType           []*ObservationDataType `json:"permittedDataType,omitempty"`
+		PermittedDataTypeElement    []*Element             `json:"_permittedDataType,omitempty"`
+	}{alias: alias(r)}
+	out.DerivedFromCanonical, out.DerivedFromCanonicalElement = alignPrimitiveArray(r.DerivedFromCanonical, r.DerivedFromCanonicalElement)
+	out.DerivedFromUri, out.DerivedFromUriElement = alignPrimitiveArray(r.DerivedFromUri, r.DerivedFromUriElement)
+	out.PermittedDataType, out.PermittedDataTypeElement = alignPrimitiveArray(r.PermittedDataType, r.PermittedDataTypeElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "device", r.Device, r.DeviceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ObservationDefinition) UnmarshalJSON(data []byte) error {
+	type alias ObservationDefinition
+	aux := struct {
+		*alias
+		Contained            []json.RawMessage      `json:"contained,omitempty"`
+		DerivedFromCanonical []*string              `json:"derivedFromCanonical,omitempty"`
+		DerivedFromUri       []*string              `json:"derivedFromUri,omitempty"`
+		PermittedDataType    []*ObservationDataType `json:"permittedDataType,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.DerivedFromCanonical, err = splitPrimitiveArray(aux.DerivedFromCanonical, r.DerivedFromCanonicalElement); err != nil {
+		return fmt.Errorf("derivedFromCanonical: %w", err)
+	}
+	if r.DerivedFromUri, err = splitPrimitiveArray(aux.DerivedFromUri, r.DerivedFromUriElement); err != nil {
+		return fmt.Errorf("derivedFromUri: %w", err)
+	}
+	if r.PermittedDataType, err = splitPrimitiveArray(aux.PermittedDataType, r.PermittedDataTypeElement); err != nil {
+		return fmt.Errorf("permittedDataType: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", observationDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.Device, element, r.deviceVariants, err = unmarshalChoice(fields, "device", observationDefinitionDeviceVariants); err != nil {
+		return err
+	}
+	r.DeviceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeviceElement); err != nil {
+			return fmt.Errorf("_device: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r ObservationDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ObservationDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ObservationDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ObservationDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ObservationDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(observationDefinitionVersionAlgorithmVariants)
+	case "Device":
+		return "device", choiceValues(observationDefinitionDeviceVariants)
+	}
+	return "", nil
+}
+
+var observationDefinitionElementTypes = map[string]string{
+	"id":                   "id",
+	"implicitRules":        "uri",
+	"language":             "code",
+	"url":                  "uri",
+	"description":          "markdown",
+	"purpose":              "markdown",
+	"copyright":            "markdown",
+	"derivedFromCanonical": "canonical",
+	"derivedFromUri":       "uri",
+}
+
+func (r *ObservationDefinition) elementTypes() map[string]string {
+	return observationDefinitionElementTypes
+}
+
+var observationDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ObservationDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ObservationDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ObservationDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ObservationDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ObservationDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ObservationDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ObservationDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ObservationDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ObservationDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ObservationDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ObservationDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "ObservationDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ObservationDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ObservationDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ObservationDefinition.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ObservationDefinition.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ObservationDefinition.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ObservationDefinition.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ObservationDefinition.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ObservationDefinition.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ObservationDefinition.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ObservationDefinition.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.approvalDate", Name: "approvalDate", Field: 41, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ObservationDefinition.lastReviewDate", Name: "lastReviewDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ObservationDefinition.effectivePeriod", Name: "effectivePeriod", Field: 45, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ObservationDefinition.derivedFromCanonical", Name: "derivedFromCanonical", Field: 46, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ObservationDefinition.derivedFromUri", Name: "derivedFromUri", Field: 48, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "ObservationDefinition.subject", Name: "subject", Field: 50, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.performerType", Name: "performerType", Field: 51, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.category", Name: "category", Field: 52, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.code", Name: "code", Field: 53, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.permittedDataType", Name: "permittedDataType", Field: 54, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ObservationDefinition.permittedDataType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/permitted-data-type"}},
+	{Path: "ObservationDefinition.multipleResultsAllowed", Name: "multipleResultsAllowed", Field: 56, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ObservationDefinition.bodyStructure", Name: "bodyStructure", Field: 58, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ObservationDefinition.method", Name: "method", Field: 59, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.specimen", Name: "specimen", Field: 60, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ObservationDefinition.device[x]", Name: "device", Field: 61, Min: 0, Max: "1", Types: []string{"Reference", "canonical"}},
+	{Path: "ObservationDefinition.preferredReportName", Name: "preferredReportName", Field: 63, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.permittedUnit", Name: "permittedUnit", Field: 65, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "ObservationDefinition.qualifiedValue", Name: "qualifiedValue", Field: 66, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ObservationDefinition.hasMember", Name: "hasMember", Field: 67, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ObservationDefinition.component", Name: "component", Field: 68, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ObservationDefinition) ElementMetadata() []ElementMetadata {
+	return observationDefinitionElementMetadata
+}
+
+// ObservationDefinitionVersionAlgorithm is the type of ObservationDefinition.versionAlgorithm[x]. It is implemented by the ObservationDefinitionVersionAlgorithm* variant types.
+type ObservationDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isObservationDefinitionVersionAlgorithm()
+}
+
+// ObservationDefinitionVersionAlgorithmString is the string variant of ObservationDefinitionVersionAlgorithm.
+type ObservationDefinitionVersionAlgorithmString string
+
+func (v ObservationDefinitionVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v ObservationDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ObservationDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v ObservationDefinitionVersionAlgorithmString) isObservationDefinitionVersionAlgorithm() {}
+
+// ObservationDefinitionVersionAlgorithmCoding is the Coding variant of ObservationDefinitionVersionAlgorithm.
+type ObservationDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ObservationDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ObservationDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ObservationDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ObservationDefinitionVersionAlgorithmCoding) isObservationDefinitionVersionAlgorithm() {}
+
+var observationDefinitionVersionAlgorithmVariants = []ObservationDefinitionVersionAlgorithm{
+	ObservationDefinitionVersionAlgorithmString(""),
+	ObservationDefinitionVersionAlgorithmCoding{},
+}
+
+// ObservationDefinitionDevice is the type of ObservationDefinition.device[x]. It is implemented by the ObservationDefinitionDevice* variant types.
+type ObservationDefinitionDevice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isObservationDefinitionDevice()
+}
+
+// ObservationDefinitionDeviceReference is the Reference variant of ObservationDefinitionDevice.
+type ObservationDefinitionDeviceReference struct {
+	Reference
+}
+
+func (v ObservationDefinitionDeviceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ObservationDefinitionDeviceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ObservationDefinitionDeviceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ObservationDefinitionDeviceReference) isObservationDefinitionDevice() {}
+
+// ObservationDefinitionDeviceCanonical is the canonical variant of ObservationDefinitionDevice.
+type ObservationDefinitionDeviceCanonical string
+
+func (v ObservationDefinitionDeviceCanonical) Validate() error {
+	return nil
+}
+
+func (v ObservationDefinitionDeviceCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ObservationDefinitionDeviceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ObservationDefinitionDeviceCanonical) isObservationDefinitionDevice() {}
+
+var observationDefinitionDeviceVariants = []ObservationDefinitionDevice{
+	ObservationDefinitionDeviceReference{},
+	ObservationDefinitionDeviceCanonical(""),
+}
+
+func (r *ObservationDefinition) GetResourceType() string {
+	return "ObservationDefinition"
+}
+
+func (r *ObservationDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ObservationDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ObservationDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ObservationDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ObservationDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ObservationDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ObservationDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ObservationDefinition)(nil)
+
 type ObservationDefinitionQualifiedValue struct {
-	Id                         *string           `json:"id,omitempty" bson:"id,omitempty"`                                                     // Unique id for inter-element referencing
-	Context                    *CodeableConcept  `json:"context,omitempty" bson:"context,omitempty"`                                           // Context qualifier for the set of qualified values
-	AppliesTo                  []CodeableConcept `json:"appliesTo,omitempty" bson:"applies_to,omitempty"`                                      // Targetted population for the set of qualified values
-	SexParameterForClinicalUse *string           `json:"sexParameterForClinicalUse,omitempty" bson:"sex_parameter_for_clinical_use,omitempty"` // male | female | other | unknown
-	Age                        *Range            `json:"age,omitempty" bson:"age,omitempty"`                                                   // Applicable age range for the set of qualified values
-	GestationalAge             *Range            `json:"gestationalAge,omitempty" bson:"gestational_age,omitempty"`                            // Applicable gestational age range for the set of qualified values
-	Condition                  *string           `json:"condition,omitempty" bson:"condition,omitempty"`                                       // Validity criterion for the qualified value
-	RangeCategory              *string           `json:"rangeCategory,omitempty" bson:"range_category,omitempty"`                              // reference | critical | absolute
-	Range                      *Range            `json:"range,omitempty" bson:"range,omitempty"`                                               // The range for continuous or ordinal observations
-	ValidCodedValueSet         *string           `json:"validCodedValueSet,omitempty" bson:"valid_coded_value_set,omitempty"`                  // Value set of valid coded values as part of this set of qualified values
-	NormalCodedValueSet        *string           `json:"normalCodedValueSet,omitempty" bson:"normal_coded_value_set,omitempty"`                // Value set of normal coded values as part of this set of qualified values
-	AbnormalCodedValueSet      *string           `json:"abnormalCodedValueSet,omitempty" bson:"abnormal_coded_value_set,omitempty"`            // Value set of abnormal coded values as part of this set of qualified values
-	CriticalCodedValueSet      *string           `json:"criticalCodedValueSet,omitempty" bson:"critical_coded_value_set,omitempty"`            // Value set of critical coded values as part of this set of qualified values
-	Interpretation             []CodeableConcept `json:"interpretation,omitempty" bson:"interpretation,omitempty"`                             // Expected coded interpretation values
+	Id                                *string                   `json:"id,omitempty" bson:"id,omitempty"`                                                              // Unique id for inter-element referencing
+	Extension                         []Extension               `json:"extension,omitempty" bson:"extension,omitempty"`                                                // Additional content defined by implementations
+	ModifierExtension                 []Extension               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                               // Extensions that cannot be ignored even if unrecognized
+	Context                           *CodeableConcept          `json:"context,omitempty" bson:"context,omitempty"`                                                    // Context qualifier for the set of qualified values
+	AppliesTo                         []CodeableConcept         `json:"appliesTo,omitempty" bson:"applies_to,omitempty"`                                               // Targetted population for the set of qualified values
+	SexParameterForClinicalUse        *AdministrativeGender     `json:"sexParameterForClinicalUse,omitempty" bson:"sex_parameter_for_clinical_use,omitempty"`          // male | female | other | unknown
+	SexParameterForClinicalUseElement *Element                  `json:"_sexParameterForClinicalUse,omitempty" bson:"sex_parameter_for_clinical_use_element,omitempty"` // Extensions for sexParameterForClinicalUse
+	Age                               *Range                    `json:"age,omitempty" bson:"age,omitempty"`                                                            // Applicable age range for the set of qualified values
+	GestationalAge                    *Range                    `json:"gestationalAge,omitempty" bson:"gestational_age,omitempty"`                                     // Applicable gestational age range for the set of qualified values
+	Condition                         *string                   `json:"condition,omitempty" bson:"condition,omitempty"`                                                // Validity criterion for the qualified value
+	ConditionElement                  *Element                  `json:"_condition,omitempty" bson:"condition_element,omitempty"`                                       // Extensions for condition
+	RangeCategory                     *ObservationRangeCategory `json:"rangeCategory,omitempty" bson:"range_category,omitempty"`                                       // reference | critical | absolute
+	RangeCategoryElement              *Element                  `json:"_rangeCategory,omitempty" bson:"range_category_element,omitempty"`                              // Extensions for rangeCategory
+	Range                             *Range                    `json:"range,omitempty" bson:"range,omitempty"`                                                        // The range for continuous or ordinal observations
+	ValidCodedValueSet                *string                   `json:"validCodedValueSet,omitempty" bson:"valid_coded_value_set,omitempty"`                           // Value set of valid coded values as part of this set of qualified values
+	ValidCodedValueSetElement         *Element                  `json:"_validCodedValueSet,omitempty" bson:"valid_coded_value_set_element,omitempty"`                  // Extensions for validCodedValueSet
+	NormalCodedValueSet               *string                   `json:"normalCodedValueSet,omitempty" bson:"normal_coded_value_set,omitempty"`                         // Value set of normal coded values as part of this set of qualified values
+	NormalCodedValueSetElement        *Element                  `json:"_normalCodedValueSet,omitempty" bson:"normal_coded_value_set_element,omitempty"`                // Extensions for normalCodedValueSet
+	AbnormalCodedValueSet             *string                   `json:"abnormalCodedValueSet,omitempty" bson:"abnormal_coded_value_set,omitempty"`                     // Value set of abnormal coded values as part of this set of qualified values
+	AbnormalCodedValueSetElement      *Element                  `json:"_abnormalCodedValueSet,omitempty" bson:"abnormal_coded_value_set_element,omitempty"`            // Extensions for abnormalCodedValueSet
+	CriticalCodedValueSet             *string                   `json:"criticalCodedValueSet,omitempty" bson:"critical_coded_value_set,omitempty"`                     // Value set of critical coded values as part of this set of qualified values
+	CriticalCodedValueSetElement      *Element                  `json:"_criticalCodedValueSet,omitempty" bson:"critical_coded_value_set_element,omitempty"`            // Extensions for criticalCodedValueSet
+	Interpretation                    []CodeableConcept         `json:"interpretation,omitempty" bson:"interpretation,omitempty"`                                      // Expected coded interpretation values
 }
 
 func (r *ObservationDefinitionQualifiedValue) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Context != nil {
 		if err := r.Context.Validate(); err != nil {
 			return fmt.Errorf("Context: %w", err)
@@ -197,6 +936,14 @@ func (r *ObservationDefinitionQualifiedValue) Validate() error {
 			return fmt.Errorf("AppliesTo[%d]: %w", i, err)
 		}
 	}
+	if r.SexParameterForClinicalUse != nil && !r.SexParameterForClinicalUse.IsValid() {
+		return fmt.Errorf("field 'SexParameterForClinicalUse' has invalid code '%s'", *r.SexParameterForClinicalUse)
+	}
+	if r.SexParameterForClinicalUseElement != nil {
+		if err := r.SexParameterForClinicalUseElement.Validate(); err != nil {
+			return fmt.Errorf("SexParameterForClinicalUseElement: %w", err)
+		}
+	}
 	if r.Age != nil {
 		if err := r.Age.Validate(); err != nil {
 			return fmt.Errorf("Age: %w", err)
@@ -207,11 +954,44 @@ func (r *ObservationDefinitionQualifiedValue) Validate() error {
 			return fmt.Errorf("GestationalAge: %w", err)
 		}
 	}
+	if r.ConditionElement != nil {
+		if err := r.ConditionElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionElement: %w", err)
+		}
+	}
+	if r.RangeCategory != nil && !r.RangeCategory.IsValid() {
+		return fmt.Errorf("field 'RangeCategory' has invalid code '%s'", *r.RangeCategory)
+	}
+	if r.RangeCategoryElement != nil {
+		if err := r.RangeCategoryElement.Validate(); err != nil {
+			return fmt.Errorf("RangeCategoryElement: %w", err)
+		}
+	}
 	if r.Range != nil {
 		if err := r.Range.Validate(); err != nil {
 			return fmt.Errorf("Range: %w", err)
 		}
 	}
+	if r.ValidCodedValueSetElement != nil {
+		if err := r.ValidCodedValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValidCodedValueSetElement: %w", err)
+		}
+	}
+	if r.NormalCodedValueSetElement != nil {
+		if err := r.NormalCodedValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("NormalCodedValueSetElement: %w", err)
+		}
+	}
+	if r.AbnormalCodedValueSetElement != nil {
+		if err := r.AbnormalCodedValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("AbnormalCodedValueSetElement: %w", err)
+		}
+	}
+	if r.CriticalCodedValueSetElement != nil {
+		if err := r.CriticalCodedValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("CriticalCodedValueSetElement: %w", err)
+		}
+	}
 	for i, item := range r.Interpretation {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Interpretation[%d]: %w", i, err)
@@ -220,15 +1000,140 @@ func (r *ObservationDefinitionQualifiedValue) Validate() error {
 	return nil
 }
 
+func (r *ObservationDefinitionQualifiedValue) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Context != nil {
+		r.Context.validateAll(path+".context", issues)
+	}
+	for i, item := range r.AppliesTo {
+		item.validateAll(fmt.Sprintf("%s.appliesTo[%d]", path, i), issues)
+	}
+	if r.SexParameterForClinicalUse != nil && !r.SexParameterForClinicalUse.IsValid() {
+		issues.add("code-invalid", path+".sexParameterForClinicalUse", fmt.Sprintf("field 'SexParameterForClinicalUse' has invalid code '%s'", *r.SexParameterForClinicalUse))
+	}
+	if r.SexParameterForClinicalUseElement != nil {
+		r.SexParameterForClinicalUseElement.validateAll(path+".sexParameterForClinicalUse", issues)
+	}
+	if r.Age != nil {
+		r.Age.validateAll(path+".age", issues)
+	}
+	if r.GestationalAge != nil {
+		r.GestationalAge.validateAll(path+".gestationalAge", issues)
+	}
+	if r.ConditionElement != nil {
+		r.ConditionElement.validateAll(path+".condition", issues)
+	}
+	if r.RangeCategory != nil && !r.RangeCategory.IsValid() {
+		issues.add("code-invalid", path+".rangeCategory", fmt.Sprintf("field 'RangeCategory' has invalid code '%s'", *r.RangeCategory))
+	}
+	if r.RangeCategoryElement != nil {
+		r.RangeCategoryElement.validateAll(path+".rangeCategory", issues)
+	}
+	if r.Range != nil {
+		r.Range.validateAll(path+".range", issues)
+	}
+	if r.ValidCodedValueSetElement != nil {
+		r.ValidCodedValueSetElement.validateAll(path+".validCodedValueSet", issues)
+	}
+	if r.NormalCodedValueSetElement != nil {
+		r.NormalCodedValueSetElement.validateAll(path+".normalCodedValueSet", issues)
+	}
+	if r.AbnormalCodedValueSetElement != nil {
+		r.AbnormalCodedValueSetElement.validateAll(path+".abnormalCodedValueSet", issues)
+	}
+	if r.CriticalCodedValueSetElement != nil {
+		r.CriticalCodedValueSetElement.validateAll(path+".criticalCodedValueSet", issues)
+	}
+	for i, item := range r.Interpretation {
+		item.validateAll(fmt.Sprintf("%s.interpretation[%d]", path, i), issues)
+	}
+}
+
+var observationDefinitionQualifiedValueInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ObservationDefinitionQualifiedValue) invariants() []invariant {
+	return observationDefinitionQualifiedValueInvariants
+}
+
+func (r ObservationDefinitionQualifiedValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ObservationDefinitionQualifiedValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ObservationDefinitionQualifiedValue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ObservationDefinitionQualifiedValue) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var observationDefinitionQualifiedValueElementTypes = map[string]string{
+	"validCodedValueSet":    "canonical",
+	"normalCodedValueSet":   "canonical",
+	"abnormalCodedValueSet": "canonical",
+	"criticalCodedValueSet": "canonical",
+}
+
+func (r *ObservationDefinitionQualifiedValue) elementTypes() map[string]string {
+	return observationDefinitionQualifiedValueElementTypes
+}
+
+var observationDefinitionQualifiedValueElementMetadata = []ElementMetadata{
+	{Path: "ObservationDefinition.qualifiedValue.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.qualifiedValue.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ObservationDefinition.qualifiedValue.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ObservationDefinition.qualifiedValue.context", Name: "context", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.qualifiedValue.appliesTo", Name: "appliesTo", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.qualifiedValue.sexParameterForClinicalUse", Name: "sexParameterForClinicalUse", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ObservationDefinition.qualifiedValue.sexParameterForClinicalUse", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"}},
+	{Path: "ObservationDefinition.qualifiedValue.age", Name: "age", Field: 7, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "ObservationDefinition.qualifiedValue.gestationalAge", Name: "gestationalAge", Field: 8, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "ObservationDefinition.qualifiedValue.condition", Name: "condition", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.qualifiedValue.rangeCategory", Name: "rangeCategory", Field: 11, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ObservationDefinition.qualifiedValue.rangeCategory", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-range-category"}},
+	{Path: "ObservationDefinition.qualifiedValue.range", Name: "range", Field: 13, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "ObservationDefinition.qualifiedValue.validCodedValueSet", Name: "validCodedValueSet", Field: 14, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ObservationDefinition.qualifiedValue.normalCodedValueSet", Name: "normalCodedValueSet", Field: 16, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ObservationDefinition.qualifiedValue.abnormalCodedValueSet", Name: "abnormalCodedValueSet", Field: 18, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ObservationDefinition.qualifiedValue.criticalCodedValueSet", Name: "criticalCodedValueSet", Field: 20, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "ObservationDefinition.qualifiedValue.interpretation", Name: "interpretation", Field: 22, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ObservationDefinitionQualifiedValue) ElementMetadata() []ElementMetadata {
+	return observationDefinitionQualifiedValueElementMetadata
+}
+
 type ObservationDefinitionComponent struct {
-	Id                *string                               `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
-	Code              *CodeableConcept                      `json:"code" bson:"code"`                                                 // Type of observation
-	PermittedDataType []string                              `json:"permittedDataType,omitempty" bson:"permitted_data_type,omitempty"` // Quantity | CodeableConcept | string | boolean | integer | Range | Ratio | SampledData | time | dateTime | Period
-	PermittedUnit     []Coding                              `json:"permittedUnit,omitempty" bson:"permitted_unit,omitempty"`          // Unit for quantitative results
-	QualifiedValue    []ObservationDefinitionQualifiedValue `json:"qualifiedValue,omitempty" bson:"qualified_value,omitempty"`        // Set of qualified values for observation results
+	Id                       *string                               `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
+	Extension                []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                            // Additional content defined by implementations
+	ModifierExtension        []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`           // Extensions that cannot be ignored even if unrecognized
+	Code                     *CodeableConcept                      `json:"code" bson:"code"`                                                          // Type of observation
+	PermittedDataType        []ObservationDataType                 `json:"permittedDataType,omitempty" bson:"permitted_data_type,omitempty"`          // Quantity | CodeableConcept | string | boolean | integer | Range | Ratio | SampledData | time | dateTime | Period
+	PermittedDataTypeElement []*Element                            `json:"_permittedDataType,omitempty" bson:"permitted_data_type_element,omitempty"` // Extensions for permittedDataType
+	PermittedUnit            []Coding                              `json:"permittedUnit,omitempty" bson:"permitted_unit,omitempty"`                   // Unit for quantitative results
+	QualifiedValue           []ObservationDefinitionQualifiedValue `json:"qualifiedValue,omitempty" bson:"qualified_value,omitempty"`                 // Set of qualified values for observation results
 }
 
 func (r *ObservationDefinitionComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -237,6 +1142,19 @@ func (r *ObservationDefinitionComponent) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	for i, item := range r.PermittedDataType {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'PermittedDataType[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.PermittedDataTypeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PermittedDataTypeElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.PermittedUnit {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PermittedUnit[%d]: %w", i, err)
@@ -249,3 +1167,100 @@ func (r *ObservationDefinitionComponent) Validate() error {
 	}
 	return nil
 }
+
+func (r *ObservationDefinitionComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.PermittedDataType {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.permittedDataType[%d]", path, i), fmt.Sprintf("field 'PermittedDataType[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.PermittedDataTypeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.permittedDataType[%d]", path, i), issues)
+	}
+	for i, item := range r.PermittedUnit {
+		item.validateAll(fmt.Sprintf("%s.permittedUnit[%d]", path, i), issues)
+	}
+	for i, item := range r.QualifiedValue {
+		item.validateAll(fmt.Sprintf("%s.qualifiedValue[%d]", path, i), issues)
+	}
+}
+
+var observationDefinitionComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ObservationDefinitionComponent) invariants() []invariant {
+	return observationDefinitionComponentInvariants
+}
+
+func (r ObservationDefinitionComponent) MarshalJSON() ([]byte, error) {
+	type alias ObservationDefinitionComponent
+	out := struct {
+		alias
+		PermittedDataType        []*ObservationDataType `json:"permittedDataType,omitempty"`
+		PermittedDataTypeElement []*Element             `json:"_permittedDataType,omitempty"`
+	}{alias: alias(r)}
+	out.PermittedDataType, out.PermittedDataTypeElement = alignPrimitiveArray(r.PermittedDataType, r.PermittedDataTypeElement)
+	return json.Marshal(out)
+}
+
+func (r *ObservationDefinitionComponent) UnmarshalJSON(data []byte) error {
+	type alias ObservationDefinitionComponent
+	aux := struct {
+		*alias
+		PermittedDataType []*ObservationDataType `json:"permittedDataType,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.PermittedDataType, err = splitPrimitiveArray(aux.PermittedDataType, r.PermittedDataTypeElement); err != nil {
+		return fmt.Errorf("permittedDataType: %w", err)
+	}
+	return nil
+}
+
+func (r ObservationDefinitionComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ObservationDefinitionComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ObservationDefinitionComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ObservationDefinitionComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var observationDefinitionComponentElementMetadata = []ElementMetadata{
+	{Path: "ObservationDefinition.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ObservationDefinition.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ObservationDefinition.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ObservationDefinition.component.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ObservationDefinition.component.permittedDataType", Name: "permittedDataType", Field: 4, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ObservationDefinition.component.permittedDataType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/permitted-data-type"}},
+	{Path: "ObservationDefinition.component.permittedUnit", Name: "permittedUnit", Field: 6, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "ObservationDefinition.component.qualifiedValue", Name: "qualifiedValue", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ObservationDefinitionComponent) ElementMetadata() []ElementMetadata {
+	return observationDefinitionComponentElementMetadata
+}
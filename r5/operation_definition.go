@@ -2,50 +2,81 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A formal computable definition of an operation (on the RESTful interface) or a named query (using the search interaction).
 type OperationDefinition struct {
-	ResourceType           string                         `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                        `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                        `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                        `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this operation definition, represented as an absolute URI (globally unique)
-	Identifier             []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the implementation guide (business identifier)
-	Version                *string                        `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the operation definition
-	VersionAlgorithmString *string                        `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                        `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                         `json:"name" bson:"name"`                                                           // Name for this operation definition (computer friendly)
-	Title                  *string                        `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this operation definition (human friendly)
-	Status                 string                         `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Kind                   string                         `json:"kind" bson:"kind"`                                                           // operation | query
-	Experimental           *bool                          `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                        `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                        `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                        `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the operation definition
-	UseContext             []UsageContext                 `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept              `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the operation definition (if applicable)
-	Purpose                *string                        `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this operation definition is defined
-	Copyright              *string                        `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                        `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	AffectsState           *bool                          `json:"affectsState,omitempty" bson:"affects_state,omitempty"`                      // Whether content is changed by the operation
-	Synchronicity          *string                        `json:"synchronicity,omitempty" bson:"synchronicity,omitempty"`                     // synchronous | asynchronous | either
-	Code                   string                         `json:"code" bson:"code"`                                                           // Recommended name for operation in search url
-	Comment                *string                        `json:"comment,omitempty" bson:"comment,omitempty"`                                 // Additional information about use
-	Base                   *string                        `json:"base,omitempty" bson:"base,omitempty"`                                       // Marks this as a profile of the base
-	Resource               []string                       `json:"resource,omitempty" bson:"resource,omitempty"`                               // Types this operation applies to
-	System                 bool                           `json:"system" bson:"system"`                                                       // Invoke at the system level?
-	Type                   bool                           `json:"type" bson:"type"`                                                           // Invoke at the type level?
-	Instance               bool                           `json:"instance" bson:"instance"`                                                   // Invoke on an instance?
-	InputProfile           *string                        `json:"inputProfile,omitempty" bson:"input_profile,omitempty"`                      // Validation information for in parameters
-	OutputProfile          *string                        `json:"outputProfile,omitempty" bson:"output_profile,omitempty"`                    // Validation information for out parameters
-	Parameter              []OperationDefinitionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                             // Parameters for the operation/query
-	Overload               []OperationDefinitionOverload  `json:"overload,omitempty" bson:"overload,omitempty"`                               // Define overloaded variants for when  generating code
+	ResourceType            string                              `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                             `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                               `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                             `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                            `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                          `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                          `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     *string                             `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this operation definition, represented as an absolute URI (globally unique)
+	UrlElement              *Element                            `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                        `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the implementation guide (business identifier)
+	Version                 *string                             `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the operation definition
+	VersionElement          *Element                            `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        OperationDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                            `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    string                              `json:"name" bson:"name"`                                                   // Name for this operation definition (computer friendly)
+	NameElement             *Element                            `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                             `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this operation definition (human friendly)
+	TitleElement            *Element                            `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus                   `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                            `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Kind                    OperationKind                       `json:"kind" bson:"kind"`                                                   // operation | query
+	KindElement             *Element                            `json:"_kind,omitempty" bson:"kind_element,omitempty"`                      // Extensions for kind
+	Experimental            *bool                               `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                            `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                           `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                            `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                             `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                            `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                     `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                             `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the operation definition
+	DescriptionElement      *Element                            `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                      `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                   `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the operation definition (if applicable)
+	Purpose                 *string                             `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this operation definition is defined
+	PurposeElement          *Element                            `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                             `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                            `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                             `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                            `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	AffectsState            *bool                               `json:"affectsState,omitempty" bson:"affects_state,omitempty"`              // Whether content is changed by the operation
+	AffectsStateElement     *Element                            `json:"_affectsState,omitempty" bson:"affects_state_element,omitempty"`     // Extensions for affectsState
+	Synchronicity           *OperationSynchronicityControl      `json:"synchronicity,omitempty" bson:"synchronicity,omitempty"`             // synchronous | asynchronous | either
+	SynchronicityElement    *Element                            `json:"_synchronicity,omitempty" bson:"synchronicity_element,omitempty"`    // Extensions for synchronicity
+	Code                    string                              `json:"code" bson:"code"`                                                   // Recommended name for operation in search url
+	CodeElement             *Element                            `json:"_code,omitempty" bson:"code_element,omitempty"`                      // Extensions for code
+	Comment                 *string                             `json:"comment,omitempty" bson:"comment,omitempty"`                         // Additional information about use
+	CommentElement          *Element                            `json:"_comment,omitempty" bson:"comment_element,omitempty"`                // Extensions for comment
+	Base                    *string                             `json:"base,omitempty" bson:"base,omitempty"`                               // Marks this as a profile of the base
+	BaseElement             *Element                            `json:"_base,omitempty" bson:"base_element,omitempty"`                      // Extensions for base
+	Resource                []string                            `json:"resource,omitempty" bson:"resource,omitempty"`                       // Types this operation applies to
+	ResourceElement         []*Element                          `json:"_resource,omitempty" bson:"resource_element,omitempty"`              // Extensions for resource
+	System                  bool                                `json:"system" bson:"system"`                                               // Invoke at the system level?
+	SystemElement           *Element                            `json:"_system,omitempty" bson:"system_element,omitempty"`                  // Extensions for system
+	Type                    bool                                `json:"type" bson:"type"`                                                   // Invoke at the type level?
+	TypeElement             *Element                            `json:"_type,omitempty" bson:"type_element,omitempty"`                      // Extensions for type
+	Instance                bool                                `json:"instance" bson:"instance"`                                           // Invoke on an instance?
+	InstanceElement         *Element                            `json:"_instance,omitempty" bson:"instance_element,omitempty"`              // Extensions for instance
+	InputProfile            *string                             `json:"inputProfile,omitempty" bson:"input_profile,omitempty"`              // Validation information for in parameters
+	InputProfileElement     *Element                            `json:"_inputProfile,omitempty" bson:"input_profile_element,omitempty"`     // Extensions for inputProfile
+	OutputProfile           *string                             `json:"outputProfile,omitempty" bson:"output_profile,omitempty"`            // Validation information for out parameters
+	OutputProfileElement    *Element                            `json:"_outputProfile,omitempty" bson:"output_profile_element,omitempty"`   // Extensions for outputProfile
+	Parameter               []OperationDefinitionParameter      `json:"parameter,omitempty" bson:"parameter,omitempty"`                     // Parameters for the operation/query
+	Overload                []OperationDefinitionOverload       `json:"overload,omitempty" bson:"overload,omitempty"`                       // Define overloaded variants for when  generating code
+
+	versionAlgorithmVariants []string // JSON properties of OperationDefinition.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *OperationDefinition) Validate() error {
@@ -57,36 +88,133 @@ func (r *OperationDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	var emptyString string
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Kind == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Kind == "" {
 		return fmt.Errorf("field 'Kind' is required")
 	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -97,9 +225,85 @@ func (r *OperationDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.AffectsStateElement != nil {
+		if err := r.AffectsStateElement.Validate(); err != nil {
+			return fmt.Errorf("AffectsStateElement: %w", err)
+		}
+	}
+	if r.Synchronicity != nil && !r.Synchronicity.IsValid() {
+		return fmt.Errorf("field 'Synchronicity' has invalid code '%s'", *r.Synchronicity)
+	}
+	if r.SynchronicityElement != nil {
+		if err := r.SynchronicityElement.Validate(); err != nil {
+			return fmt.Errorf("SynchronicityElement: %w", err)
+		}
+	}
 	if r.Code == emptyString {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	if r.BaseElement != nil {
+		if err := r.BaseElement.Validate(); err != nil {
+			return fmt.Errorf("BaseElement: %w", err)
+		}
+	}
+	for i, item := range r.ResourceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ResourceElement[%d]: %w", i, err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.InstanceElement != nil {
+		if err := r.InstanceElement.Validate(); err != nil {
+			return fmt.Errorf("InstanceElement: %w", err)
+		}
+	}
+	if r.InputProfileElement != nil {
+		if err := r.InputProfileElement.Validate(); err != nil {
+			return fmt.Errorf("InputProfileElement: %w", err)
+		}
+	}
+	if r.OutputProfileElement != nil {
+		if err := r.OutputProfileElement.Validate(); err != nil {
+			return fmt.Errorf("OutputProfileElement: %w", err)
+		}
+	}
 	for i, item := range r.Parameter {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Parameter[%d]: %w", i, err)
@@ -110,40 +314,546 @@ func (r *OperationDefinition) Validate() error {
 			return fmt.Errorf("Overload[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "OperationDefinition")
+}
+
+func (r *OperationDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "OperationDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'OperationDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.AffectsStateElement != nil {
+		r.AffectsStateElement.validateAll(path+".affectsState", issues)
+	}
+	if r.Synchronicity != nil && !r.Synchronicity.IsValid() {
+		issues.add("code-invalid", path+".synchronicity", fmt.Sprintf("field 'Synchronicity' has invalid code '%s'", *r.Synchronicity))
+	}
+	if r.SynchronicityElement != nil {
+		r.SynchronicityElement.validateAll(path+".synchronicity", issues)
+	}
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+	if r.BaseElement != nil {
+		r.BaseElement.validateAll(path+".base", issues)
+	}
+	for i, item := range r.ResourceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.resource[%d]", path, i), issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.InstanceElement != nil {
+		r.InstanceElement.validateAll(path+".instance", issues)
+	}
+	if r.InputProfileElement != nil {
+		r.InputProfileElement.validateAll(path+".inputProfile", issues)
+	}
+	if r.OutputProfileElement != nil {
+		r.OutputProfileElement.validateAll(path+".outputProfile", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	for i, item := range r.Overload {
+		item.validateAll(fmt.Sprintf("%s.overload[%d]", path, i), issues)
+	}
+}
+
+func (r *OperationDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("OperationDefinition", &issues)
+	checkInvariants(r, "OperationDefinition", &issues)
+	return issues
+}
+
+var operationDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *OperationDefinition) invariants() []invariant {
+	return operationDefinitionInvariants
+}
+
+func (r OperationDefinition) MarshalJSON() ([]byte, error) {
+	type alias OperationDefinition
+	out := struct {
+		alias
+		Resource        []*string  `json:"resource,omitempty"`
+		ResourceElement []*Element `json:"_resource,omitempty"`
+	}{alias: alias(r)}
+	out.Resource, out.ResourceElement = alignPrimitiveArray(r.Resource, r.ResourceElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *OperationDefinition) UnmarshalJSON(data []byte) error {
+	type alias OperationDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Resource  []*string         `json:"resource,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Resource, err = splitPrimitiveArray(aux.Resource, r.ResourceElement); err != nil {
+		return fmt.Errorf("resource: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", operationDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r OperationDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *OperationDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(operationDefinitionVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var operationDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"code":          "code",
+	"comment":       "markdown",
+	"base":          "canonical",
+	"resource":      "code",
+	"inputProfile":  "canonical",
+	"outputProfile": "canonical",
+}
+
+func (r *OperationDefinition) elementTypes() map[string]string {
+	return operationDefinitionElementTypes
+}
+
+var operationDefinitionElementMetadata = []ElementMetadata{
+	{Path: "OperationDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "OperationDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "OperationDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "OperationDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "OperationDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "OperationDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "OperationDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "OperationDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "OperationDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "OperationDefinition.name", Name: "name", Field: 18, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "OperationDefinition.kind", Name: "kind", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-kind"}},
+	{Path: "OperationDefinition.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "OperationDefinition.date", Name: "date", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "OperationDefinition.publisher", Name: "publisher", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.contact", Name: "contact", Field: 32, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "OperationDefinition.description", Name: "description", Field: 33, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "OperationDefinition.useContext", Name: "useContext", Field: 35, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "OperationDefinition.jurisdiction", Name: "jurisdiction", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "OperationDefinition.purpose", Name: "purpose", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "OperationDefinition.copyright", Name: "copyright", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "OperationDefinition.copyrightLabel", Name: "copyrightLabel", Field: 41, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.affectsState", Name: "affectsState", Field: 43, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "OperationDefinition.synchronicity", Name: "synchronicity", Field: 45, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.synchronicity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/synchronicity-control"}},
+	{Path: "OperationDefinition.code", Name: "code", Field: 47, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "OperationDefinition.comment", Name: "comment", Field: 49, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "OperationDefinition.base", Name: "base", Field: 51, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "OperationDefinition.resource", Name: "resource", Field: 53, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "OperationDefinition.system", Name: "system", Field: 55, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "OperationDefinition.type", Name: "type", Field: 57, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "OperationDefinition.instance", Name: "instance", Field: 59, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "OperationDefinition.inputProfile", Name: "inputProfile", Field: 61, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "OperationDefinition.outputProfile", Name: "outputProfile", Field: 63, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "OperationDefinition.parameter", Name: "parameter", Field: 65, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "OperationDefinition.overload", Name: "overload", Field: 66, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *OperationDefinition) ElementMetadata() []ElementMetadata {
+	return operationDefinitionElementMetadata
+}
+
+// OperationDefinitionVersionAlgorithm is the type of OperationDefinition.versionAlgorithm[x]. It is implemented by the OperationDefinitionVersionAlgorithm* variant types.
+type OperationDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isOperationDefinitionVersionAlgorithm()
+}
+
+// OperationDefinitionVersionAlgorithmString is the string variant of OperationDefinitionVersionAlgorithm.
+type OperationDefinitionVersionAlgorithmString string
+
+func (v OperationDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
 
+func (v OperationDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v OperationDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v OperationDefinitionVersionAlgorithmString) isOperationDefinitionVersionAlgorithm() {}
+
+// OperationDefinitionVersionAlgorithmCoding is the Coding variant of OperationDefinitionVersionAlgorithm.
+type OperationDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v OperationDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v OperationDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v OperationDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v OperationDefinitionVersionAlgorithmCoding) isOperationDefinitionVersionAlgorithm() {}
+
+var operationDefinitionVersionAlgorithmVariants = []OperationDefinitionVersionAlgorithm{
+	OperationDefinitionVersionAlgorithmString(""),
+	OperationDefinitionVersionAlgorithmCoding{},
+}
+
+func (r *OperationDefinition) GetResourceType() string {
+	return "OperationDefinition"
+}
+
+func (r *OperationDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *OperationDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *OperationDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *OperationDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *OperationDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *OperationDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *OperationDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*OperationDefinition)(nil)
+
 type OperationDefinitionParameter struct {
-	Id             *string                                      `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Name           string                                       `json:"name" bson:"name"`                                          // Name in Parameters.parameter.name or in URL
-	Use            string                                       `json:"use" bson:"use"`                                            // in | out
-	Scope          []string                                     `json:"scope,omitempty" bson:"scope,omitempty"`                    // instance | type | system
-	Min            int                                          `json:"min" bson:"min"`                                            // Minimum Cardinality
-	Max            string                                       `json:"max" bson:"max"`                                            // Maximum Cardinality (a number or *)
-	Documentation  *string                                      `json:"documentation,omitempty" bson:"documentation,omitempty"`    // Description of meaning/use
-	Type           *string                                      `json:"type,omitempty" bson:"type,omitempty"`                      // What type this parameter has
-	AllowedType    []string                                     `json:"allowedType,omitempty" bson:"allowed_type,omitempty"`       // Allowed sub-type this parameter can have (if type is abstract)
-	TargetProfile  []string                                     `json:"targetProfile,omitempty" bson:"target_profile,omitempty"`   // If type is Reference | canonical, allowed targets. If type is 'Resource', then this constrains the allowed resource types
-	SearchType     *string                                      `json:"searchType,omitempty" bson:"search_type,omitempty"`         // number | date | string | token | reference | composite | quantity | uri | special | resource
-	Binding        *OperationDefinitionParameterBinding         `json:"binding,omitempty" bson:"binding,omitempty"`                // ValueSet details if this is coded
-	ReferencedFrom []OperationDefinitionParameterReferencedFrom `json:"referencedFrom,omitempty" bson:"referenced_from,omitempty"` // References to this parameter
-	Part           []OperationDefinitionParameter               `json:"part,omitempty" bson:"part,omitempty"`                      // Parts of a nested Parameter
+	Id                   *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored even if unrecognized
+	Name                 string                                       `json:"name" bson:"name"`                                                 // Name in Parameters.parameter.name or in URL
+	NameElement          *Element                                     `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Use                  OperationParameterUse                        `json:"use" bson:"use"`                                                   // in | out
+	UseElement           *Element                                     `json:"_use,omitempty" bson:"use_element,omitempty"`                      // Extensions for use
+	Scope                []OperationParameterScope                    `json:"scope,omitempty" bson:"scope,omitempty"`                           // instance | type | system
+	ScopeElement         []*Element                                   `json:"_scope,omitempty" bson:"scope_element,omitempty"`                  // Extensions for scope
+	Min                  int                                          `json:"min" bson:"min"`                                                   // Minimum Cardinality
+	MinElement           *Element                                     `json:"_min,omitempty" bson:"min_element,omitempty"`                      // Extensions for min
+	Max                  string                                       `json:"max" bson:"max"`                                                   // Maximum Cardinality (a number or *)
+	MaxElement           *Element                                     `json:"_max,omitempty" bson:"max_element,omitempty"`                      // Extensions for max
+	Documentation        *string                                      `json:"documentation,omitempty" bson:"documentation,omitempty"`           // Description of meaning/use
+	DocumentationElement *Element                                     `json:"_documentation,omitempty" bson:"documentation_element,omitempty"`  // Extensions for documentation
+	Type                 *string                                      `json:"type,omitempty" bson:"type,omitempty"`                             // What type this parameter has
+	TypeElement          *Element                                     `json:"_type,omitempty" bson:"type_element,omitempty"`                    // Extensions for type
+	AllowedType          []string                                     `json:"allowedType,omitempty" bson:"allowed_type,omitempty"`              // Allowed sub-type this parameter can have (if type is abstract)
+	AllowedTypeElement   []*Element                                   `json:"_allowedType,omitempty" bson:"allowed_type_element,omitempty"`     // Extensions for allowedType
+	TargetProfile        []string                                     `json:"targetProfile,omitempty" bson:"target_profile,omitempty"`          // If type is Reference | canonical, allowed targets. If type is 'Resource', then this constrains the allowed resource types
+	TargetProfileElement []*Element                                   `json:"_targetProfile,omitempty" bson:"target_profile_element,omitempty"` // Extensions for targetProfile
+	SearchType           *SearchParamType                             `json:"searchType,omitempty" bson:"search_type,omitempty"`                // number | date | string | token | reference | composite | quantity | uri | special | resource
+	SearchTypeElement    *Element                                     `json:"_searchType,omitempty" bson:"search_type_element,omitempty"`       // Extensions for searchType
+	Binding              *OperationDefinitionParameterBinding         `json:"binding,omitempty" bson:"binding,omitempty"`                       // ValueSet details if this is coded
+	ReferencedFrom       []OperationDefinitionParameterReferencedFrom `json:"referencedFrom,omitempty" bson:"referenced_from,omitempty"`        // References to this parameter
+	Part                 []OperationDefinitionParameter               `json:"part,omitempty" bson:"part,omitempty"`                             // Parts of a nested Parameter
 }
 
 func (r *OperationDefinitionParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Use == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Use == "" {
 		return fmt.Errorf("field 'Use' is required")
 	}
+	if !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
+	for i, item := range r.Scope {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Scope[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ScopeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ScopeElement[%d]: %w", i, err)
+		}
+	}
 	if r.Min == 0 {
 		return fmt.Errorf("field 'Min' is required")
 	}
+	if r.MinElement != nil {
+		if err := r.MinElement.Validate(); err != nil {
+			return fmt.Errorf("MinElement: %w", err)
+		}
+	}
 	if r.Max == emptyString {
 		return fmt.Errorf("field 'Max' is required")
 	}
+	if r.MaxElement != nil {
+		if err := r.MaxElement.Validate(); err != nil {
+			return fmt.Errorf("MaxElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	for i, item := range r.AllowedTypeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AllowedTypeElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TargetProfileElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TargetProfileElement[%d]: %w", i, err)
+		}
+	}
+	if r.SearchType != nil && !r.SearchType.IsValid() {
+		return fmt.Errorf("field 'SearchType' has invalid code '%s'", *r.SearchType)
+	}
+	if r.SearchTypeElement != nil {
+		if err := r.SearchTypeElement.Validate(); err != nil {
+			return fmt.Errorf("SearchTypeElement: %w", err)
+		}
+	}
 	if r.Binding != nil {
 		if err := r.Binding.Validate(); err != nil {
 			return fmt.Errorf("Binding: %w", err)
@@ -162,43 +872,508 @@ func (r *OperationDefinitionParameter) Validate() error {
 	return nil
 }
 
+func (r *OperationDefinitionParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Use == "" {
+		issues.add("required", path+".use", "field 'Use' is required")
+	}
+	if r.Use != "" && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	for i, item := range r.Scope {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.scope[%d]", path, i), fmt.Sprintf("field 'Scope[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ScopeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.scope[%d]", path, i), issues)
+	}
+	if r.Min == 0 {
+		issues.add("required", path+".min", "field 'Min' is required")
+	}
+	if r.MinElement != nil {
+		r.MinElement.validateAll(path+".min", issues)
+	}
+	if r.Max == emptyString {
+		issues.add("required", path+".max", "field 'Max' is required")
+	}
+	if r.MaxElement != nil {
+		r.MaxElement.validateAll(path+".max", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	for i, item := range r.AllowedTypeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.allowedType[%d]", path, i), issues)
+	}
+	for i, item := range r.TargetProfileElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.targetProfile[%d]", path, i), issues)
+	}
+	if r.SearchType != nil && !r.SearchType.IsValid() {
+		issues.add("code-invalid", path+".searchType", fmt.Sprintf("field 'SearchType' has invalid code '%s'", *r.SearchType))
+	}
+	if r.SearchTypeElement != nil {
+		r.SearchTypeElement.validateAll(path+".searchType", issues)
+	}
+	if r.Binding != nil {
+		r.Binding.validateAll(path+".binding", issues)
+	}
+	for i, item := range r.ReferencedFrom {
+		item.validateAll(fmt.Sprintf("%s.referencedFrom[%d]", path, i), issues)
+	}
+	for i, item := range r.Part {
+		item.validateAll(fmt.Sprintf("%s.part[%d]", path, i), issues)
+	}
+}
+
+var operationDefinitionParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *OperationDefinitionParameter) invariants() []invariant {
+	return operationDefinitionParameterInvariants
+}
+
+func (r OperationDefinitionParameter) MarshalJSON() ([]byte, error) {
+	type alias OperationDefinitionParameter
+	out := struct {
+		alias
+		Scope                []*OperationParameterScope `json:"scope,omitempty"`
+		ScopeElement         []*Element                 `json:"_scope,omitempty"`
+		AllowedType          []*string                  `json:"allowedType,omitempty"`
+		AllowedTypeElement   []*Element                 `json:"_allowedType,omitempty"`
+		TargetProfile        []*string                  `json:"targetProfile,omitempty"`
+		TargetProfileElement []*Element                 `json:"_targetProfile,omitempty"`
+	}{alias: alias(r)}
+	out.Scope, out.ScopeElement = alignPrimitiveArray(r.Scope, r.ScopeElement)
+	out.AllowedType, out.AllowedTypeElement = alignPrimitiveArray(r.AllowedType, r.AllowedTypeElement)
+	out.TargetProfile, out.TargetProfileElement = alignPrimitiveArray(r.TargetProfile, r.TargetProfileElement)
+	return json.Marshal(out)
+}
+
+func (r *OperationDefinitionParameter) UnmarshalJSON(data []byte) error {
+	type alias OperationDefinitionParameter
+	aux := struct {
+		*alias
+		Scope         []*OperationParameterScope `json:"scope,omitempty"`
+		AllowedType   []*string                  `json:"allowedType,omitempty"`
+		TargetProfile []*string                  `json:"targetProfile,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Scope, err = splitPrimitiveArray(aux.Scope, r.ScopeElement); err != nil {
+		return fmt.Errorf("scope: %w", err)
+	}
+	if r.AllowedType, err = splitPrimitiveArray(aux.AllowedType, r.AllowedTypeElement); err != nil {
+		return fmt.Errorf("allowedType: %w", err)
+	}
+	if r.TargetProfile, err = splitPrimitiveArray(aux.TargetProfile, r.TargetProfileElement); err != nil {
+		return fmt.Errorf("targetProfile: %w", err)
+	}
+	return nil
+}
+
+func (r OperationDefinitionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationDefinitionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationDefinitionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationDefinitionParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var operationDefinitionParameterElementTypes = map[string]string{
+	"name":          "code",
+	"min":           "unsignedInt",
+	"documentation": "markdown",
+	"type":          "code",
+	"allowedType":   "code",
+	"targetProfile": "canonical",
+}
+
+func (r *OperationDefinitionParameter) elementTypes() map[string]string {
+	return operationDefinitionParameterElementTypes
+}
+
+var operationDefinitionParameterElementMetadata = []ElementMetadata{
+	{Path: "OperationDefinition.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationDefinition.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationDefinition.parameter.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "OperationDefinition.parameter.use", Name: "use", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.parameter.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-parameter-use"}},
+	{Path: "OperationDefinition.parameter.scope", Name: "scope", Field: 7, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.parameter.scope", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-parameter-scope"}},
+	{Path: "OperationDefinition.parameter.min", Name: "min", Field: 9, Min: 1, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "OperationDefinition.parameter.max", Name: "max", Field: 11, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.parameter.documentation", Name: "documentation", Field: 13, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "OperationDefinition.parameter.type", Name: "type", Field: 15, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "OperationDefinition.parameter.allowedType", Name: "allowedType", Field: 17, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "OperationDefinition.parameter.targetProfile", Name: "targetProfile", Field: 19, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "OperationDefinition.parameter.searchType", Name: "searchType", Field: 21, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.parameter.searchType", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-param-type"}},
+	{Path: "OperationDefinition.parameter.binding", Name: "binding", Field: 23, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "OperationDefinition.parameter.referencedFrom", Name: "referencedFrom", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "OperationDefinition.parameter.part", Name: "part", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *OperationDefinitionParameter) ElementMetadata() []ElementMetadata {
+	return operationDefinitionParameterElementMetadata
+}
+
 type OperationDefinitionParameterBinding struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Strength string  `json:"strength" bson:"strength"`         // required | extensible | preferred | example | descriptive
-	ValueSet string  `json:"valueSet" bson:"value_set"`        // Source of value set
+	Id                *string         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Strength          BindingStrength `json:"strength" bson:"strength"`                                        // required | extensible | preferred | example | descriptive
+	StrengthElement   *Element        `json:"_strength,omitempty" bson:"strength_element,omitempty"`           // Extensions for strength
+	ValueSet          string          `json:"valueSet" bson:"value_set"`                                       // Source of value set
+	ValueSetElement   *Element        `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
 }
 
 func (r *OperationDefinitionParameterBinding) Validate() error {
-	var emptyString string
-	if r.Strength == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Strength == "" {
 		return fmt.Errorf("field 'Strength' is required")
 	}
+	if !r.Strength.IsValid() {
+		return fmt.Errorf("field 'Strength' has invalid code '%s'", r.Strength)
+	}
+	if r.StrengthElement != nil {
+		if err := r.StrengthElement.Validate(); err != nil {
+			return fmt.Errorf("StrengthElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.ValueSet == emptyString {
 		return fmt.Errorf("field 'ValueSet' is required")
 	}
+	if r.ValueSetElement != nil {
+		if err := r.ValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *OperationDefinitionParameterBinding) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Strength == "" {
+		issues.add("required", path+".strength", "field 'Strength' is required")
+	}
+	if r.Strength != "" && !r.Strength.IsValid() {
+		issues.add("code-invalid", path+".strength", fmt.Sprintf("field 'Strength' has invalid code '%s'", r.Strength))
+	}
+	if r.StrengthElement != nil {
+		r.StrengthElement.validateAll(path+".strength", issues)
+	}
+	var emptyString string
+	if r.ValueSet == emptyString {
+		issues.add("required", path+".valueSet", "field 'ValueSet' is required")
+	}
+	if r.ValueSetElement != nil {
+		r.ValueSetElement.validateAll(path+".valueSet", issues)
+	}
+}
+
+var operationDefinitionParameterBindingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *OperationDefinitionParameterBinding) invariants() []invariant {
+	return operationDefinitionParameterBindingInvariants
+}
+
+func (r OperationDefinitionParameterBinding) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationDefinitionParameterBinding) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationDefinitionParameterBinding) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationDefinitionParameterBinding) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var operationDefinitionParameterBindingElementTypes = map[string]string{
+	"valueSet": "canonical",
+}
+
+func (r *OperationDefinitionParameterBinding) elementTypes() map[string]string {
+	return operationDefinitionParameterBindingElementTypes
+}
+
+var operationDefinitionParameterBindingElementMetadata = []ElementMetadata{
+	{Path: "OperationDefinition.parameter.binding.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.parameter.binding.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationDefinition.parameter.binding.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationDefinition.parameter.binding.strength", Name: "strength", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationDefinition.parameter.binding.strength", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/binding-strength"}},
+	{Path: "OperationDefinition.parameter.binding.valueSet", Name: "valueSet", Field: 5, Min: 1, Max: "1", Types: []string{"canonical"}},
+}
+
+func (r *OperationDefinitionParameterBinding) ElementMetadata() []ElementMetadata {
+	return operationDefinitionParameterBindingElementMetadata
+}
+
 type OperationDefinitionParameterReferencedFrom struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"`              // Unique id for inter-element referencing
-	Source   string  `json:"source" bson:"source"`                          // Referencing parameter
-	SourceId *string `json:"sourceId,omitempty" bson:"source_id,omitempty"` // Element id of reference
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Source            string      `json:"source" bson:"source"`                                            // Referencing parameter
+	SourceElement     *Element    `json:"_source,omitempty" bson:"source_element,omitempty"`               // Extensions for source
+	SourceId          *string     `json:"sourceId,omitempty" bson:"source_id,omitempty"`                   // Element id of reference
+	SourceIdElement   *Element    `json:"_sourceId,omitempty" bson:"source_id_element,omitempty"`          // Extensions for sourceId
 }
 
 func (r *OperationDefinitionParameterReferencedFrom) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Source == emptyString {
 		return fmt.Errorf("field 'Source' is required")
 	}
+	if r.SourceElement != nil {
+		if err := r.SourceElement.Validate(); err != nil {
+			return fmt.Errorf("SourceElement: %w", err)
+		}
+	}
+	if r.SourceIdElement != nil {
+		if err := r.SourceIdElement.Validate(); err != nil {
+			return fmt.Errorf("SourceIdElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *OperationDefinitionParameterReferencedFrom) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Source == emptyString {
+		issues.add("required", path+".source", "field 'Source' is required")
+	}
+	if r.SourceElement != nil {
+		r.SourceElement.validateAll(path+".source", issues)
+	}
+	if r.SourceIdElement != nil {
+		r.SourceIdElement.validateAll(path+".sourceId", issues)
+	}
+}
+
+var operationDefinitionParameterReferencedFromInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *OperationDefinitionParameterReferencedFrom) invariants() []invariant {
+	return operationDefinitionParameterReferencedFromInvariants
+}
+
+func (r OperationDefinitionParameterReferencedFrom) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationDefinitionParameterReferencedFrom) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationDefinitionParameterReferencedFrom) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationDefinitionParameterReferencedFrom) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var operationDefinitionParameterReferencedFromElementMetadata = []ElementMetadata{
+	{Path: "OperationDefinition.parameter.referencedFrom.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.parameter.referencedFrom.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationDefinition.parameter.referencedFrom.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationDefinition.parameter.referencedFrom.source", Name: "source", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.parameter.referencedFrom.sourceId", Name: "sourceId", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *OperationDefinitionParameterReferencedFrom) ElementMetadata() []ElementMetadata {
+	return operationDefinitionParameterReferencedFromElementMetadata
+}
+
 type OperationDefinitionOverload struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	ParameterName []string `json:"parameterName,omitempty" bson:"parameter_name,omitempty"` // Name of parameter to include in overload
-	Comment       *string  `json:"comment,omitempty" bson:"comment,omitempty"`              // Comments to go on overload
+	Id                   *string     `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored even if unrecognized
+	ParameterName        []string    `json:"parameterName,omitempty" bson:"parameter_name,omitempty"`          // Name of parameter to include in overload
+	ParameterNameElement []*Element  `json:"_parameterName,omitempty" bson:"parameter_name_element,omitempty"` // Extensions for parameterName
+	Comment              *string     `json:"comment,omitempty" bson:"comment,omitempty"`                       // Comments to go on overload
+	CommentElement       *Element    `json:"_comment,omitempty" bson:"comment_element,omitempty"`              // Extensions for comment
 }
 
 func (r *OperationDefinitionOverload) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ParameterNameElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ParameterNameElement[%d]: %w", i, err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *OperationDefinitionOverload) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.ParameterNameElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.parameterName[%d]", path, i), issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+}
+
+var operationDefinitionOverloadInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *OperationDefinitionOverload) invariants() []invariant {
+	return operationDefinitionOverloadInvariants
+}
+
+func (r OperationDefinitionOverload) MarshalJSON() ([]byte, error) {
+	type alias OperationDefinitionOverload
+	out := struct {
+		alias
+		ParameterName        []*string  `json:"parameterName,omitempty"`
+		ParameterNameElement []*Element `json:"_parameterName,omitempty"`
+	}{alias: alias(r)}
+	out.ParameterName, out.ParameterNameElement = alignPrimitiveArray(r.ParameterName, r.ParameterNameElement)
+	return json.Marshal(out)
+}
+
+func (r *OperationDefinitionOverload) UnmarshalJSON(data []byte) error {
+	type alias OperationDefinitionOverload
+	aux := struct {
+		*alias
+		ParameterName []*string `json:"parameterName,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.ParameterName, err = splitPrimitiveArray(aux.ParameterName, r.ParameterNameElement); err != nil {
+		return fmt.Errorf("parameterName: %w", err)
+	}
+	return nil
+}
+
+func (r OperationDefinitionOverload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationDefinitionOverload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationDefinitionOverload) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationDefinitionOverload) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var operationDefinitionOverloadElementMetadata = []ElementMetadata{
+	{Path: "OperationDefinition.overload.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationDefinition.overload.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationDefinition.overload.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationDefinition.overload.parameterName", Name: "parameterName", Field: 3, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "OperationDefinition.overload.comment", Name: "comment", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *OperationDefinitionOverload) ElementMetadata() []ElementMetadata {
+	return operationDefinitionOverloadElementMetadata
+}
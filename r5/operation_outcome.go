@@ -2,19 +2,24 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A collection of error, warning, or information messages that result from a system action.
 type OperationOutcome struct {
-	ResourceType  string                  `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                 `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                 `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Issue         []OperationOutcomeIssue `json:"issue" bson:"issue"`                                      // A single issue associated with the action
+	ResourceType         string                  `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                 `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                 `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource              `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Issue                []OperationOutcomeIssue `json:"issue" bson:"issue"`                                               // A single issue associated with the action
 }
 
 func (r *OperationOutcome) Validate() error {
@@ -26,11 +31,39 @@ func (r *OperationOutcome) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if len(r.Issue) < 1 {
 		return fmt.Errorf("field 'Issue' must have at least 1 elements")
 	}
@@ -39,31 +72,365 @@ func (r *OperationOutcome) Validate() error {
 			return fmt.Errorf("Issue[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "OperationOutcome")
+}
+
+func (r *OperationOutcome) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "OperationOutcome" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'OperationOutcome', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Issue) < 1 {
+		issues.add("required", path+".issue", "field 'Issue' must have at least 1 elements")
+	}
+	for i, item := range r.Issue {
+		item.validateAll(fmt.Sprintf("%s.issue[%d]", path, i), issues)
+	}
+}
+
+func (r *OperationOutcome) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("OperationOutcome", &issues)
+	checkInvariants(r, "OperationOutcome", &issues)
+	return issues
+}
+
+var operationOutcomeInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *OperationOutcome) invariants() []invariant {
+	return operationOutcomeInvariants
+}
+
+func (r *OperationOutcome) UnmarshalJSON(data []byte) error {
+	type alias OperationOutcome
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r OperationOutcome) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationOutcome) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationOutcome) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationOutcome) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var operationOutcomeElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *OperationOutcome) elementTypes() map[string]string {
+	return operationOutcomeElementTypes
+}
+
+var operationOutcomeElementMetadata = []ElementMetadata{
+	{Path: "OperationOutcome.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "OperationOutcome.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "OperationOutcome.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "OperationOutcome.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationOutcome.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "OperationOutcome.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "OperationOutcome.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "OperationOutcome.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationOutcome.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationOutcome.issue", Name: "issue", Field: 11, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *OperationOutcome) ElementMetadata() []ElementMetadata {
+	return operationOutcomeElementMetadata
+}
+
+func (r *OperationOutcome) GetResourceType() string {
+	return "OperationOutcome"
+}
+
+func (r *OperationOutcome) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *OperationOutcome) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *OperationOutcome) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *OperationOutcome) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *OperationOutcome) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *OperationOutcome) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *OperationOutcome) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*OperationOutcome)(nil)
+
 type OperationOutcomeIssue struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Severity    string           `json:"severity" bson:"severity"`                           // fatal | error | warning | information | success
-	Code        string           `json:"code" bson:"code"`                                   // Error or warning code
-	Details     *CodeableConcept `json:"details,omitempty" bson:"details,omitempty"`         // Additional details about the error
-	Diagnostics *string          `json:"diagnostics,omitempty" bson:"diagnostics,omitempty"` // Additional diagnostic information about the issue
-	Location    []string         `json:"location,omitempty" bson:"location,omitempty"`       // Deprecated: Path of element(s) related to issue
-	Expression  []string         `json:"expression,omitempty" bson:"expression,omitempty"`   // FHIRPath of element(s) related to issue
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Severity           IssueSeverity    `json:"severity" bson:"severity"`                                        // fatal | error | warning | information | success
+	SeverityElement    *Element         `json:"_severity,omitempty" bson:"severity_element,omitempty"`           // Extensions for severity
+	Code               string           `json:"code" bson:"code"`                                                // Error or warning code
+	CodeElement        *Element         `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Details            *CodeableConcept `json:"details,omitempty" bson:"details,omitempty"`                      // Additional details about the error
+	Diagnostics        *string          `json:"diagnostics,omitempty" bson:"diagnostics,omitempty"`              // Additional diagnostic information about the issue
+	DiagnosticsElement *Element         `json:"_diagnostics,omitempty" bson:"diagnostics_element,omitempty"`     // Extensions for diagnostics
+	Location           []string         `json:"location,omitempty" bson:"location,omitempty"`                    // Deprecated: Path of element(s) related to issue
+	LocationElement    []*Element       `json:"_location,omitempty" bson:"location_element,omitempty"`           // Extensions for location
+	Expression         []string         `json:"expression,omitempty" bson:"expression,omitempty"`                // FHIRPath of element(s) related to issue
+	ExpressionElement  []*Element       `json:"_expression,omitempty" bson:"expression_element,omitempty"`       // Extensions for expression
 }
 
 func (r *OperationOutcomeIssue) Validate() error {
-	var emptyString string
-	if r.Severity == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Severity == "" {
 		return fmt.Errorf("field 'Severity' is required")
 	}
+	if !r.Severity.IsValid() {
+		return fmt.Errorf("field 'Severity' has invalid code '%s'", r.Severity)
+	}
+	if r.SeverityElement != nil {
+		if err := r.SeverityElement.Validate(); err != nil {
+			return fmt.Errorf("SeverityElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Code == emptyString {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
 	if r.Details != nil {
 		if err := r.Details.Validate(); err != nil {
 			return fmt.Errorf("Details: %w", err)
 		}
 	}
+	if r.DiagnosticsElement != nil {
+		if err := r.DiagnosticsElement.Validate(); err != nil {
+			return fmt.Errorf("DiagnosticsElement: %w", err)
+		}
+	}
+	for i, item := range r.LocationElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LocationElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ExpressionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *OperationOutcomeIssue) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Severity == "" {
+		issues.add("required", path+".severity", "field 'Severity' is required")
+	}
+	if r.Severity != "" && !r.Severity.IsValid() {
+		issues.add("code-invalid", path+".severity", fmt.Sprintf("field 'Severity' has invalid code '%s'", r.Severity))
+	}
+	if r.SeverityElement != nil {
+		r.SeverityElement.validateAll(path+".severity", issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.Details != nil {
+		r.Details.validateAll(path+".details", issues)
+	}
+	if r.DiagnosticsElement != nil {
+		r.DiagnosticsElement.validateAll(path+".diagnostics", issues)
+	}
+	for i, item := range r.LocationElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	for i, item := range r.ExpressionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.expression[%d]", path, i), issues)
+	}
+}
+
+var operationOutcomeIssueInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *OperationOutcomeIssue) invariants() []invariant {
+	return operationOutcomeIssueInvariants
+}
+
+func (r OperationOutcomeIssue) MarshalJSON() ([]byte, error) {
+	type alias OperationOutcomeIssue
+	out := struct {
+		alias
+		Location          []*string  `json:"location,omitempty"`
+		LocationElement   []*Element `json:"_location,omitempty"`
+		Expression        []*string  `json:"expression,omitempty"`
+		ExpressionElement []*Element `json:"_expression,omitempty"`
+	}{alias: alias(r)}
+	out.Location, out.LocationElement = alignPrimitiveArray(r.Location, r.LocationElement)
+	out.Expression, out.ExpressionElement = alignPrimitiveArray(r.Expression, r.ExpressionElement)
+	return json.Marshal(out)
+}
+
+func (r *OperationOutcomeIssue) UnmarshalJSON(data []byte) error {
+	type alias OperationOutcomeIssue
+	aux := struct {
+		*alias
+		Location   []*string `json:"location,omitempty"`
+		Expression []*string `json:"expression,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Location, err = splitPrimitiveArray(aux.Location, r.LocationElement); err != nil {
+		return fmt.Errorf("location: %w", err)
+	}
+	if r.Expression, err = splitPrimitiveArray(aux.Expression, r.ExpressionElement); err != nil {
+		return fmt.Errorf("expression: %w", err)
+	}
+	return nil
+}
+
+func (r OperationOutcomeIssue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OperationOutcomeIssue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OperationOutcomeIssue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OperationOutcomeIssue) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var operationOutcomeIssueElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *OperationOutcomeIssue) elementTypes() map[string]string {
+	return operationOutcomeIssueElementTypes
+}
+
+var operationOutcomeIssueElementMetadata = []ElementMetadata{
+	{Path: "OperationOutcome.issue.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationOutcome.issue.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OperationOutcome.issue.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OperationOutcome.issue.severity", Name: "severity", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OperationOutcome.issue.severity", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/issue-severity"}},
+	{Path: "OperationOutcome.issue.code", Name: "code", Field: 5, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "OperationOutcome.issue.details", Name: "details", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "OperationOutcome.issue.diagnostics", Name: "diagnostics", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "OperationOutcome.issue.location", Name: "location", Field: 10, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "OperationOutcome.issue.expression", Name: "expression", Field: 12, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *OperationOutcomeIssue) ElementMetadata() []ElementMetadata {
+	return operationOutcomeIssueElementMetadata
+}
@@ -0,0 +1,13 @@
+package models
+
+// OperationParameters is implemented by the input and output parameters of
+// every operation, such as ExpandIn and ExpandOut.
+type OperationParameters interface {
+	// Validate checks the number of each parameter against its definition.
+	Validate() error
+	// ToParameters returns the parameters as a Parameters resource.
+	ToParameters() (*Parameters, error)
+	// FromParameters sets the parameters from a Parameters resource,
+	// ignoring parameters the operation does not define.
+	FromParameters(params *Parameters) error
+}
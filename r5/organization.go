@@ -2,30 +2,43 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A formally or informally recognized grouping of people or organizations formed for the purpose of achieving some form of collective action.
 type Organization struct {
-	ResourceType  string                      `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                     `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                     `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Identifies this organization  across multiple systems
-	Active        *bool                       `json:"active,omitempty" bson:"active,omitempty"`                // Whether the organization's record is still in active use
-	Type          []CodeableConcept           `json:"type,omitempty" bson:"type,omitempty"`                    // Kind of organization
-	Name          *string                     `json:"name,omitempty" bson:"name,omitempty"`                    // Name used for the organization
-	Alias         []string                    `json:"alias,omitempty" bson:"alias,omitempty"`                  // A list of alternate names that the organization is known as, or was known as in the past
-	Description   *string                     `json:"description,omitempty" bson:"description,omitempty"`      // Additional details about the Organization that could be displayed as further information to identify the Organization beyond its name
-	Contact       []ExtendedContactDetail     `json:"contact,omitempty" bson:"contact,omitempty"`              // Official contact details for the Organization
-	PartOf        *Reference                  `json:"partOf,omitempty" bson:"part_of,omitempty"`               // The organization of which this organization forms a part
-	Endpoint      []Reference                 `json:"endpoint,omitempty" bson:"endpoint,omitempty"`            // Technical endpoints providing access to services operated for the organization
-	Qualification []OrganizationQualification `json:"qualification,omitempty" bson:"qualification,omitempty"`  // Qualifications, certifications, accreditations, licenses, training, etc. pertaining to the provision of care
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Identifies this organization  across multiple systems
+	Active               *bool                       `json:"active,omitempty" bson:"active,omitempty"`                         // Whether the organization's record is still in active use
+	ActiveElement        *Element                    `json:"_active,omitempty" bson:"active_element,omitempty"`                // Extensions for active
+	Type                 []CodeableConcept           `json:"type,omitempty" bson:"type,omitempty"`                             // Kind of organization
+	Name                 *string                     `json:"name,omitempty" bson:"name,omitempty"`                             // Name used for the organization
+	NameElement          *Element                    `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Alias                []string                    `json:"alias,omitempty" bson:"alias,omitempty"`                           // A list of alternate names that the organization is known as, or was known as in the past
+	AliasElement         []*Element                  `json:"_alias,omitempty" bson:"alias_element,omitempty"`                  // Extensions for alias
+	Description          *string                     `json:"description,omitempty" bson:"description,omitempty"`               // Additional details about the Organization that could be displayed as further information to identify the Organization beyond its name
+	DescriptionElement   *Element                    `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Contact              []ExtendedContactDetail     `json:"contact,omitempty" bson:"contact,omitempty"`                       // Official contact details for the Organization
+	PartOf               *Reference                  `json:"partOf,omitempty" bson:"part_of,omitempty"`                        // The organization of which this organization forms a part
+	Endpoint             []Reference                 `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                     // Technical endpoints providing access to services operated for the organization
+	Qualification        []OrganizationQualification `json:"qualification,omitempty" bson:"qualification,omitempty"`           // Qualifications, certifications, accreditations, licenses, training, etc. pertaining to the provision of care
 }
 
+var organizationPartOfTargets = []string{"Organization"}
+
+var organizationEndpointTargets = []string{"Endpoint"}
+
 func (r *Organization) Validate() error {
 	if r.ResourceType != "Organization" {
 		return fmt.Errorf("invalid resourceType: expected 'Organization', got '%s'", r.ResourceType)
@@ -35,31 +48,92 @@ func (r *Organization) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AliasElement[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.PartOf != nil {
+		if target := invalidReferenceTarget(r.PartOf.Reference, r.PartOf.Type, organizationPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf' cannot refer to %s, expected one of %v", target, organizationPartOfTargets)
+		}
+	}
 	if r.PartOf != nil {
 		if err := r.PartOf.Validate(); err != nil {
 			return fmt.Errorf("PartOf: %w", err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, organizationEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
@@ -70,19 +144,252 @@ func (r *Organization) Validate() error {
 			return fmt.Errorf("Qualification[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Organization")
+}
+
+func (r *Organization) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Organization" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Organization', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	for i, item := range r.AliasElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.alias[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.PartOf != nil {
+		if target := invalidReferenceTarget(r.PartOf.Reference, r.PartOf.Type, organizationPartOfTargets); target != "" {
+			issues.add("value", path+".partOf", fmt.Sprintf("field 'PartOf' cannot refer to %s, expected one of %v", target, organizationPartOfTargets))
+		}
+	}
+	if r.PartOf != nil {
+		r.PartOf.validateAll(path+".partOf", issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, organizationEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+	for i, item := range r.Qualification {
+		item.validateAll(fmt.Sprintf("%s.qualification[%d]", path, i), issues)
+	}
+}
+
+func (r *Organization) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Organization", &issues)
+	checkInvariants(r, "Organization", &issues)
+	return issues
+}
+
+var organizationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Organization) invariants() []invariant {
+	return organizationInvariants
+}
+
+func (r Organization) MarshalJSON() ([]byte, error) {
+	type alias Organization
+	out := struct {
+		alias
+		Alias        []*string  `json:"alias,omitempty"`
+		AliasElement []*Element `json:"_alias,omitempty"`
+	}{alias: alias(r)}
+	out.Alias, out.AliasElement = alignPrimitiveArray(r.Alias, r.AliasElement)
+	return json.Marshal(out)
+}
+
+func (r *Organization) UnmarshalJSON(data []byte) error {
+	type alias Organization
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Alias     []*string         `json:"alias,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Alias, err = splitPrimitiveArray(aux.Alias, r.AliasElement); err != nil {
+		return fmt.Errorf("alias: %w", err)
+	}
 	return nil
 }
 
+func (r Organization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Organization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Organization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Organization) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var organizationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *Organization) elementTypes() map[string]string {
+	return organizationElementTypes
+}
+
+var organizationElementMetadata = []ElementMetadata{
+	{Path: "Organization.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Organization.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Organization.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Organization.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Organization.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Organization.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Organization.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Organization.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Organization.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Organization.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Organization.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Organization.type", Name: "type", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Organization.name", Name: "name", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Organization.alias", Name: "alias", Field: 17, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "Organization.description", Name: "description", Field: 19, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Organization.contact", Name: "contact", Field: 21, Min: 0, Max: "*", Types: []string{"ExtendedContactDetail"}},
+	{Path: "Organization.partOf", Name: "partOf", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Organization.endpoint", Name: "endpoint", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+	{Path: "Organization.qualification", Name: "qualification", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Organization) ElementMetadata() []ElementMetadata {
+	return organizationElementMetadata
+}
+
+func (r *Organization) GetResourceType() string {
+	return "Organization"
+}
+
+func (r *Organization) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Organization) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Organization) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Organization) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Organization) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Organization) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Organization) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Organization)(nil)
+
 type OrganizationQualification struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Identifier []Identifier     `json:"identifier,omitempty" bson:"identifier,omitempty"` // An identifier for this qualification for the organization
-	Code       *CodeableConcept `json:"code" bson:"code"`                                 // Coded representation of the qualification
-	Status     *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`         // Status/progress of the qualification
-	Period     *Period          `json:"period,omitempty" bson:"period,omitempty"`         // Period during which the qualification is valid
-	Issuer     *Reference       `json:"issuer,omitempty" bson:"issuer,omitempty"`         // Organization that regulates and issues the qualification
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        []Identifier     `json:"identifier,omitempty" bson:"identifier,omitempty"`                // An identifier for this qualification for the organization
+	Code              *CodeableConcept `json:"code" bson:"code"`                                                // Coded representation of the qualification
+	Status            *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`                        // Status/progress of the qualification
+	Period            *Period          `json:"period,omitempty" bson:"period,omitempty"`                        // Period during which the qualification is valid
+	Issuer            *Reference       `json:"issuer,omitempty" bson:"issuer,omitempty"`                        // Organization that regulates and issues the qualification
 }
 
 func (r *OrganizationQualification) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -113,3 +420,69 @@ func (r *OrganizationQualification) Validate() error {
 	}
 	return nil
 }
+
+func (r *OrganizationQualification) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Issuer != nil {
+		r.Issuer.validateAll(path+".issuer", issues)
+	}
+}
+
+var organizationQualificationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *OrganizationQualification) invariants() []invariant {
+	return organizationQualificationInvariants
+}
+
+func (r OrganizationQualification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OrganizationQualification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OrganizationQualification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OrganizationQualification) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var organizationQualificationElementMetadata = []ElementMetadata{
+	{Path: "Organization.qualification.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Organization.qualification.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Organization.qualification.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Organization.qualification.identifier", Name: "identifier", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Organization.qualification.code", Name: "code", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Organization.qualification.status", Name: "status", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Organization.qualification.period", Name: "period", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Organization.qualification.issuer", Name: "issuer", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *OrganizationQualification) ElementMetadata() []ElementMetadata {
+	return organizationQualificationElementMetadata
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,11 +12,16 @@ type OrganizationAffiliation struct {
 	Id                        *string                 `json:"id,omitempty" bson:"id,omitempty"`                                                // Logical id of this artifact
 	Meta                      *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                                            // Metadata about the resource
 	ImplicitRules             *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                         // A set of rules under which this content was created
+	ImplicitRulesElement      *Element                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                // Extensions for implicitRules
 	Language                  *string                 `json:"language,omitempty" bson:"language,omitempty"`                                    // Language of the resource content
+	LanguageElement           *Element                `json:"_language,omitempty" bson:"language_element,omitempty"`                           // Extensions for language
 	Text                      *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                                            // Text summary of the resource, for human interpretation
-	Contained                 []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`                                  // Contained, inline Resources
+	Contained                 []Resource              `json:"contained,omitempty" bson:"contained,omitempty"`                                  // Contained, inline Resources
+	Extension                 []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                                  // Additional content defined by implementations
+	ModifierExtension         []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                 // Extensions that cannot be ignored
 	Identifier                []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                                // Business identifiers that are specific to this role
 	Active                    *bool                   `json:"active,omitempty" bson:"active,omitempty"`                                        // Whether this organization affiliation record is in active use
+	ActiveElement             *Element                `json:"_active,omitempty" bson:"active_element,omitempty"`                               // Extensions for active
 	Period                    *Period                 `json:"period,omitempty" bson:"period,omitempty"`                                        // The period during which the participatingOrganization is affiliated with the primary organization
 	Organization              *Reference              `json:"organization,omitempty" bson:"organization,omitempty"`                            // Organization where the role is available
 	ParticipatingOrganization *Reference              `json:"participatingOrganization,omitempty" bson:"participating_organization,omitempty"` // Organization that provides/performs the role (e.g. providing services or is a member of)
@@ -28,6 +34,18 @@ type OrganizationAffiliation struct {
 	Endpoint                  []Reference             `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                                    // Technical endpoints providing access to services operated for this role
 }
 
+var organizationAffiliationOrganizationTargets = []string{"Organization"}
+
+var organizationAffiliationParticipatingOrganizationTargets = []string{"Organization"}
+
+var organizationAffiliationNetworkTargets = []string{"Organization"}
+
+var organizationAffiliationLocationTargets = []string{"Location"}
+
+var organizationAffiliationHealthcareServiceTargets = []string{"HealthcareService"}
+
+var organizationAffiliationEndpointTargets = []string{"Endpoint"}
+
 func (r *OrganizationAffiliation) Validate() error {
 	if r.ResourceType != "OrganizationAffiliation" {
 		return fmt.Errorf("invalid resourceType: expected 'OrganizationAffiliation', got '%s'", r.ResourceType)
@@ -37,31 +55,79 @@ func (r *OrganizationAffiliation) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, organizationAffiliationOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'Organization' cannot refer to %s, expected one of %v", target, organizationAffiliationOrganizationTargets)
+		}
+	}
 	if r.Organization != nil {
 		if err := r.Organization.Validate(); err != nil {
 			return fmt.Errorf("Organization: %w", err)
 		}
 	}
+	if r.ParticipatingOrganization != nil {
+		if target := invalidReferenceTarget(r.ParticipatingOrganization.Reference, r.ParticipatingOrganization.Type, organizationAffiliationParticipatingOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'ParticipatingOrganization' cannot refer to %s, expected one of %v", target, organizationAffiliationParticipatingOrganizationTargets)
+		}
+	}
 	if r.ParticipatingOrganization != nil {
 		if err := r.ParticipatingOrganization.Validate(); err != nil {
 			return fmt.Errorf("ParticipatingOrganization: %w", err)
 		}
 	}
+	for i, item := range r.Network {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationNetworkTargets); target != "" {
+			return fmt.Errorf("field 'Network[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationNetworkTargets)
+		}
+	}
 	for i, item := range r.Network {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Network[%d]: %w", i, err)
@@ -77,11 +143,21 @@ func (r *OrganizationAffiliation) Validate() error {
 			return fmt.Errorf("Specialty[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationLocationTargets)
+		}
+	}
 	for i, item := range r.Location {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Location[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.HealthcareService {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationHealthcareServiceTargets); target != "" {
+			return fmt.Errorf("field 'HealthcareService[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationHealthcareServiceTargets)
+		}
+	}
 	for i, item := range r.HealthcareService {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("HealthcareService[%d]: %w", i, err)
@@ -92,10 +168,244 @@ func (r *OrganizationAffiliation) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "OrganizationAffiliation")
+}
+
+func (r *OrganizationAffiliation) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "OrganizationAffiliation" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'OrganizationAffiliation', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, organizationAffiliationOrganizationTargets); target != "" {
+			issues.add("value", path+".organization", fmt.Sprintf("field 'Organization' cannot refer to %s, expected one of %v", target, organizationAffiliationOrganizationTargets))
+		}
+	}
+	if r.Organization != nil {
+		r.Organization.validateAll(path+".organization", issues)
+	}
+	if r.ParticipatingOrganization != nil {
+		if target := invalidReferenceTarget(r.ParticipatingOrganization.Reference, r.ParticipatingOrganization.Type, organizationAffiliationParticipatingOrganizationTargets); target != "" {
+			issues.add("value", path+".participatingOrganization", fmt.Sprintf("field 'ParticipatingOrganization' cannot refer to %s, expected one of %v", target, organizationAffiliationParticipatingOrganizationTargets))
+		}
+	}
+	if r.ParticipatingOrganization != nil {
+		r.ParticipatingOrganization.validateAll(path+".participatingOrganization", issues)
+	}
+	for i, item := range r.Network {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationNetworkTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.network[%d]", path, i), fmt.Sprintf("field 'Network[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationNetworkTargets))
+		}
+	}
+	for i, item := range r.Network {
+		item.validateAll(fmt.Sprintf("%s.network[%d]", path, i), issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	for i, item := range r.Specialty {
+		item.validateAll(fmt.Sprintf("%s.specialty[%d]", path, i), issues)
+	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationLocationTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.location[%d]", path, i), fmt.Sprintf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationLocationTargets))
+		}
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	for i, item := range r.HealthcareService {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationHealthcareServiceTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.healthcareService[%d]", path, i), fmt.Sprintf("field 'HealthcareService[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationHealthcareServiceTargets))
+		}
+	}
+	for i, item := range r.HealthcareService {
+		item.validateAll(fmt.Sprintf("%s.healthcareService[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, organizationAffiliationEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, organizationAffiliationEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+}
+
+func (r *OrganizationAffiliation) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("OrganizationAffiliation", &issues)
+	checkInvariants(r, "OrganizationAffiliation", &issues)
+	return issues
+}
+
+var organizationAffiliationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *OrganizationAffiliation) invariants() []invariant {
+	return organizationAffiliationInvariants
+}
+
+func (r *OrganizationAffiliation) UnmarshalJSON(data []byte) error {
+	type alias OrganizationAffiliation
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r OrganizationAffiliation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *OrganizationAffiliation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r OrganizationAffiliation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *OrganizationAffiliation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var organizationAffiliationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *OrganizationAffiliation) elementTypes() map[string]string {
+	return organizationAffiliationElementTypes
+}
+
+var organizationAffiliationElementMetadata = []ElementMetadata{
+	{Path: "OrganizationAffiliation.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "OrganizationAffiliation.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "OrganizationAffiliation.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "OrganizationAffiliation.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "OrganizationAffiliation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "OrganizationAffiliation.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "OrganizationAffiliation.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "OrganizationAffiliation.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "OrganizationAffiliation.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "OrganizationAffiliation.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "OrganizationAffiliation.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "OrganizationAffiliation.period", Name: "period", Field: 14, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "OrganizationAffiliation.organization", Name: "organization", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "OrganizationAffiliation.participatingOrganization", Name: "participatingOrganization", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "OrganizationAffiliation.network", Name: "network", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "OrganizationAffiliation.code", Name: "code", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "OrganizationAffiliation.specialty", Name: "specialty", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "OrganizationAffiliation.location", Name: "location", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "OrganizationAffiliation.healthcareService", Name: "healthcareService", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/HealthcareService"}},
+	{Path: "OrganizationAffiliation.contact", Name: "contact", Field: 22, Min: 0, Max: "*", Types: []string{"ExtendedContactDetail"}},
+	{Path: "OrganizationAffiliation.endpoint", Name: "endpoint", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+}
+
+func (r *OrganizationAffiliation) ElementMetadata() []ElementMetadata {
+	return organizationAffiliationElementMetadata
+}
+
+func (r *OrganizationAffiliation) GetResourceType() string {
+	return "OrganizationAffiliation"
+}
+
+func (r *OrganizationAffiliation) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *OrganizationAffiliation) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *OrganizationAffiliation) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *OrganizationAffiliation) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *OrganizationAffiliation) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *OrganizationAffiliation) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *OrganizationAffiliation) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*OrganizationAffiliation)(nil)
@@ -2,35 +2,46 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A medically related item or items, in a container or package.
 type PackagedProductDefinition struct {
-	ResourceType          string                                         `json:"resourceType" bson:"resource_type"`                                        // Type of resource
-	Id                    *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                         // Logical id of this artifact
-	Meta                  *Meta                                          `json:"meta,omitempty" bson:"meta,omitempty"`                                     // Metadata about the resource
-	ImplicitRules         *string                                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                  // A set of rules under which this content was created
-	Language              *string                                        `json:"language,omitempty" bson:"language,omitempty"`                             // Language of the resource content
-	Text                  *Narrative                                     `json:"text,omitempty" bson:"text,omitempty"`                                     // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage                              `json:"contained,omitempty" bson:"contained,omitempty"`                           // Contained, inline Resources
-	Identifier            []Identifier                                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                         // A unique identifier for this package as whole - not for the content of the package
-	Name                  *string                                        `json:"name,omitempty" bson:"name,omitempty"`                                     // A name for this package. Typically as listed in a drug formulary, catalogue, inventory etc
-	Type                  *CodeableConcept                               `json:"type,omitempty" bson:"type,omitempty"`                                     // A high level category e.g. medicinal product, raw material, shipping container etc
-	PackageFor            []Reference                                    `json:"packageFor,omitempty" bson:"package_for,omitempty"`                        // The product that this is a pack for
-	Status                *CodeableConcept                               `json:"status,omitempty" bson:"status,omitempty"`                                 // The status within the lifecycle of this item. High level - not intended to duplicate details elsewhere e.g. legal status, or authorization/marketing status
-	StatusDate            *string                                        `json:"statusDate,omitempty" bson:"status_date,omitempty"`                        // The date at which the given status became applicable
-	ContainedItemQuantity []Quantity                                     `json:"containedItemQuantity,omitempty" bson:"contained_item_quantity,omitempty"` // A total of the complete count of contained items of a particular type/form, independent of sub-packaging or organization. This can be considered as the pack size. See also packaging.containedItem.amount (especially the long definition)
-	Description           *string                                        `json:"description,omitempty" bson:"description,omitempty"`                       // Textual description. Note that this is not the name of the package or product
-	LegalStatusOfSupply   []PackagedProductDefinitionLegalStatusOfSupply `json:"legalStatusOfSupply,omitempty" bson:"legal_status_of_supply,omitempty"`    // The legal status of supply of the packaged item as classified by the regulator
-	MarketingStatus       []MarketingStatus                              `json:"marketingStatus,omitempty" bson:"marketing_status,omitempty"`              // Allows specifying that an item is on the market for sale, or that it is not available, and the dates and locations associated
-	CopackagedIndicator   *bool                                          `json:"copackagedIndicator,omitempty" bson:"copackaged_indicator,omitempty"`      // Identifies if the drug product is supplied with another item such as a diluent or adjuvant
-	Manufacturer          []Reference                                    `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                     // Manufacturer of this package type (multiple means these are all possible manufacturers)
-	AttachedDocument      []Reference                                    `json:"attachedDocument,omitempty" bson:"attached_document,omitempty"`            // Additional information or supporting documentation about the packaged product
-	Packaging             *PackagedProductDefinitionPackaging            `json:"packaging,omitempty" bson:"packaging,omitempty"`                           // A packaging item, as a container for medically related items, possibly with other packaging items within, or a packaging component, such as bottle cap
-	Characteristic        []PackagedProductDefinitionPackagingProperty   `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                 // Allows the key features to be recorded, such as "hospital pack", "nurse prescribable"
+	ResourceType               string                                         `json:"resourceType" bson:"resource_type"`                                            // Type of resource
+	Id                         *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                             // Logical id of this artifact
+	Meta                       *Meta                                          `json:"meta,omitempty" bson:"meta,omitempty"`                                         // Metadata about the resource
+	ImplicitRules              *string                                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                      // A set of rules under which this content was created
+	ImplicitRulesElement       *Element                                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`             // Extensions for implicitRules
+	Language                   *string                                        `json:"language,omitempty" bson:"language,omitempty"`                                 // Language of the resource content
+	LanguageElement            *Element                                       `json:"_language,omitempty" bson:"language_element,omitempty"`                        // Extensions for language
+	Text                       *Narrative                                     `json:"text,omitempty" bson:"text,omitempty"`                                         // Text summary of the resource, for human interpretation
+	Contained                  []Resource                                     `json:"contained,omitempty" bson:"contained,omitempty"`                               // Contained, inline Resources
+	Extension                  []Extension                                    `json:"extension,omitempty" bson:"extension,omitempty"`                               // Additional content defined by implementations
+	ModifierExtension          []Extension                                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`              // Extensions that cannot be ignored
+	Identifier                 []Identifier                                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                             // A unique identifier for this package as whole - not for the content of the package
+	Name                       *string                                        `json:"name,omitempty" bson:"name,omitempty"`                                         // A name for this package. Typically as listed in a drug formulary, catalogue, inventory etc
+	NameElement                *Element                                       `json:"_name,omitempty" bson:"name_element,omitempty"`                                // Extensions for name
+	Type                       *CodeableConcept                               `json:"type,omitempty" bson:"type,omitempty"`                                         // A high level category e.g. medicinal product, raw material, shipping container etc
+	PackageFor                 []Reference                                    `json:"packageFor,omitempty" bson:"package_for,omitempty"`                            // The product that this is a pack for
+	Status                     *CodeableConcept                               `json:"status,omitempty" bson:"status,omitempty"`                                     // The status within the lifecycle of this item. High level - not intended to duplicate details elsewhere e.g. legal status, or authorization/marketing status
+	StatusDate                 *DateTime                                      `json:"statusDate,omitempty" bson:"status_date,omitempty"`                            // The date at which the given status became applicable
+	StatusDateElement          *Element                                       `json:"_statusDate,omitempty" bson:"status_date_element,omitempty"`                   // Extensions for statusDate
+	ContainedItemQuantity      []Quantity                                     `json:"containedItemQuantity,omitempty" bson:"contained_item_quantity,omitempty"`     // A total of the complete count of contained items of a particular type/form, independent of sub-packaging or organization. This can be considered as the pack size. See also packaging.containedItem.amount (especially the long definition)
+	Description                *string                                        `json:"description,omitempty" bson:"description,omitempty"`                           // Textual description. Note that this is not the name of the package or product
+	DescriptionElement         *Element                                       `json:"_description,omitempty" bson:"description_element,omitempty"`                  // Extensions for description
+	LegalStatusOfSupply        []PackagedProductDefinitionLegalStatusOfSupply `json:"legalStatusOfSupply,omitempty" bson:"legal_status_of_supply,omitempty"`        // The legal status of supply of the packaged item as classified by the regulator
+	MarketingStatus            []MarketingStatus                              `json:"marketingStatus,omitempty" bson:"marketing_status,omitempty"`                  // Allows specifying that an item is on the market for sale, or that it is not available, and the dates and locations associated
+	CopackagedIndicator        *bool                                          `json:"copackagedIndicator,omitempty" bson:"copackaged_indicator,omitempty"`          // Identifies if the drug product is supplied with another item such as a diluent or adjuvant
+	CopackagedIndicatorElement *Element                                       `json:"_copackagedIndicator,omitempty" bson:"copackaged_indicator_element,omitempty"` // Extensions for copackagedIndicator
+	Manufacturer               []Reference                                    `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`                         // Manufacturer of this package type (multiple means these are all possible manufacturers)
+	AttachedDocument           []Reference                                    `json:"attachedDocument,omitempty" bson:"attached_document,omitempty"`                // Additional information or supporting documentation about the packaged product
+	Packaging                  *PackagedProductDefinitionPackaging            `json:"packaging,omitempty" bson:"packaging,omitempty"`                               // A packaging item, as a container for medically related items, possibly with other packaging items within, or a packaging component, such as bottle cap
+	Characteristic             []PackagedProductDefinitionPackagingProperty   `json:"characteristic,omitempty" bson:"characteristic,omitempty"`                     // Allows the key features to be recorded, such as "hospital pack", "nurse prescribable"
 }
 
+var packagedProductDefinitionPackageForTargets = []string{"MedicinalProductDefinition"}
+
 func (r *PackagedProductDefinition) Validate() error {
 	if r.ResourceType != "PackagedProductDefinition" {
 		return fmt.Errorf("invalid resourceType: expected 'PackagedProductDefinition', got '%s'", r.ResourceType)
@@ -40,21 +51,59 @@ func (r *PackagedProductDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	for i, item := range r.PackageFor {
+		if target := invalidReferenceTarget(item.Reference, item.Type, packagedProductDefinitionPackageForTargets); target != "" {
+			return fmt.Errorf("field 'PackageFor[%d]' cannot refer to %s, expected one of %v", i, target, packagedProductDefinitionPackageForTargets)
+		}
+	}
 	for i, item := range r.PackageFor {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PackageFor[%d]: %w", i, err)
@@ -65,11 +114,26 @@ func (r *PackagedProductDefinition) Validate() error {
 			return fmt.Errorf("Status: %w", err)
 		}
 	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			return fmt.Errorf("StatusDate: %w", err)
+		}
+	}
+	if r.StatusDateElement != nil {
+		if err := r.StatusDateElement.Validate(); err != nil {
+			return fmt.Errorf("StatusDateElement: %w", err)
+		}
+	}
 	for i, item := range r.ContainedItemQuantity {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ContainedItemQuantity[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.LegalStatusOfSupply {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("LegalStatusOfSupply[%d]: %w", i, err)
@@ -80,6 +144,11 @@ func (r *PackagedProductDefinition) Validate() error {
 			return fmt.Errorf("MarketingStatus[%d]: %w", i, err)
 		}
 	}
+	if r.CopackagedIndicatorElement != nil {
+		if err := r.CopackagedIndicatorElement.Validate(); err != nil {
+			return fmt.Errorf("CopackagedIndicatorElement: %w", err)
+		}
+	}
 	for i, item := range r.Manufacturer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Manufacturer[%d]: %w", i, err)
@@ -100,76 +169,250 @@ func (r *PackagedProductDefinition) Validate() error {
 			return fmt.Errorf("Characteristic[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type PackagedProductDefinitionPackagingProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                                       // A code expressing the type of characteristic
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // A value for the characteristic
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // A value for the characteristic
-	ValueDate            *string          `json:"valueDate,omitempty" bson:"value_date,omitempty"`                        // A value for the characteristic
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // A value for the characteristic
-	ValueAttachment      *Attachment      `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`            // A value for the characteristic
+	return validateInvariants(r, "PackagedProductDefinition")
 }
 
-func (r *PackagedProductDefinitionPackagingProperty) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+func (r *PackagedProductDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "PackagedProductDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'PackagedProductDefinition', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.PackageFor {
+		if target := invalidReferenceTarget(item.Reference, item.Type, packagedProductDefinitionPackageForTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.packageFor[%d]", path, i), fmt.Sprintf("field 'PackageFor[%d]' cannot refer to %s, expected one of %v", i, target, packagedProductDefinitionPackageForTargets))
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	for i, item := range r.PackageFor {
+		item.validateAll(fmt.Sprintf("%s.packageFor[%d]", path, i), issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			issues.add("value", path+".statusDate", err.Error())
 		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.StatusDateElement != nil {
+		r.StatusDateElement.validateAll(path+".statusDate", issues)
+	}
+	for i, item := range r.ContainedItemQuantity {
+		item.validateAll(fmt.Sprintf("%s.containedItemQuantity[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.LegalStatusOfSupply {
+		item.validateAll(fmt.Sprintf("%s.legalStatusOfSupply[%d]", path, i), issues)
+	}
+	for i, item := range r.MarketingStatus {
+		item.validateAll(fmt.Sprintf("%s.marketingStatus[%d]", path, i), issues)
+	}
+	if r.CopackagedIndicatorElement != nil {
+		r.CopackagedIndicatorElement.validateAll(path+".copackagedIndicator", issues)
+	}
+	for i, item := range r.Manufacturer {
+		item.validateAll(fmt.Sprintf("%s.manufacturer[%d]", path, i), issues)
+	}
+	for i, item := range r.AttachedDocument {
+		item.validateAll(fmt.Sprintf("%s.attachedDocument[%d]", path, i), issues)
+	}
+	if r.Packaging != nil {
+		r.Packaging.validateAll(path+".packaging", issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
+}
+
+func (r *PackagedProductDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("PackagedProductDefinition", &issues)
+	checkInvariants(r, "PackagedProductDefinition", &issues)
+	return issues
+}
+
+var packagedProductDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *PackagedProductDefinition) invariants() []invariant {
+	return packagedProductDefinitionInvariants
+}
+
+func (r *PackagedProductDefinition) UnmarshalJSON(data []byte) error {
+	type alias PackagedProductDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
-type PackagedProductDefinitionPackagingContainedItem struct {
-	Id     *string            `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Item   *CodeableReference `json:"item" bson:"item"`                         // The actual item(s) of medication, as manufactured, or a device, or other medically related item (food, biologicals, raw materials, medical fluids, gases etc.), as contained in the package
-	Amount *Quantity          `json:"amount,omitempty" bson:"amount,omitempty"` // The number of this type of item within this packaging or for continuous items such as liquids it is the quantity (for example 25ml). See also PackagedProductDefinition.containedItemQuantity (especially the long definition)
+func (r PackagedProductDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *PackagedProductDefinitionPackagingContainedItem) Validate() error {
-	if r.Item == nil {
-		return fmt.Errorf("field 'Item' is required")
-	}
-	if r.Item != nil {
-		if err := r.Item.Validate(); err != nil {
-			return fmt.Errorf("Item: %w", err)
-		}
+func (r *PackagedProductDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PackagedProductDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PackagedProductDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var packagedProductDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *PackagedProductDefinition) elementTypes() map[string]string {
+	return packagedProductDefinitionElementTypes
+}
+
+var packagedProductDefinitionElementMetadata = []ElementMetadata{
+	{Path: "PackagedProductDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "PackagedProductDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "PackagedProductDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "PackagedProductDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PackagedProductDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "PackagedProductDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "PackagedProductDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "PackagedProductDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PackagedProductDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PackagedProductDefinition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "PackagedProductDefinition.name", Name: "name", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PackagedProductDefinition.type", Name: "type", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.packageFor", Name: "packageFor", Field: 15, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition"}},
+	{Path: "PackagedProductDefinition.status", Name: "status", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.statusDate", Name: "statusDate", Field: 17, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "PackagedProductDefinition.containedItemQuantity", Name: "containedItemQuantity", Field: 19, Min: 0, Max: "*", Types: []string{"Quantity"}},
+	{Path: "PackagedProductDefinition.description", Name: "description", Field: 20, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PackagedProductDefinition.legalStatusOfSupply", Name: "legalStatusOfSupply", Field: 22, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PackagedProductDefinition.marketingStatus", Name: "marketingStatus", Field: 23, Min: 0, Max: "*", Types: []string{"MarketingStatus"}},
+	{Path: "PackagedProductDefinition.copackagedIndicator", Name: "copackagedIndicator", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "PackagedProductDefinition.manufacturer", Name: "manufacturer", Field: 26, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "PackagedProductDefinition.attachedDocument", Name: "attachedDocument", Field: 27, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "PackagedProductDefinition.packaging", Name: "packaging", Field: 28, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "PackagedProductDefinition.characteristic", Name: "characteristic", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *PackagedProductDefinition) ElementMetadata() []ElementMetadata {
+	return packagedProductDefinitionElementMetadata
+}
+
+func (r *PackagedProductDefinition) GetResourceType() string {
+	return "PackagedProductDefinition"
+}
+
+func (r *PackagedProductDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *PackagedProductDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
+}
+
+func (r *PackagedProductDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *PackagedProductDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
 }
 
+func (r *PackagedProductDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *PackagedProductDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *PackagedProductDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*PackagedProductDefinition)(nil)
+
 type PackagedProductDefinitionLegalStatusOfSupply struct {
-	Id           *string          `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Code         *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                 // The actual status of supply. In what situation this package type may be supplied for use
-	Jurisdiction *CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"` // The place where the legal status of supply applies
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // The actual status of supply. In what situation this package type may be supplied for use
+	Jurisdiction      *CodeableConcept `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`            // The place where the legal status of supply applies
 }
 
 func (r *PackagedProductDefinitionLegalStatusOfSupply) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
@@ -183,22 +426,87 @@ func (r *PackagedProductDefinitionLegalStatusOfSupply) Validate() error {
 	return nil
 }
 
+func (r *PackagedProductDefinitionLegalStatusOfSupply) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Jurisdiction != nil {
+		r.Jurisdiction.validateAll(path+".jurisdiction", issues)
+	}
+}
+
+var packagedProductDefinitionLegalStatusOfSupplyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PackagedProductDefinitionLegalStatusOfSupply) invariants() []invariant {
+	return packagedProductDefinitionLegalStatusOfSupplyInvariants
+}
+
+func (r PackagedProductDefinitionLegalStatusOfSupply) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PackagedProductDefinitionLegalStatusOfSupply) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PackagedProductDefinitionLegalStatusOfSupply) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PackagedProductDefinitionLegalStatusOfSupply) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var packagedProductDefinitionLegalStatusOfSupplyElementMetadata = []ElementMetadata{
+	{Path: "PackagedProductDefinition.legalStatusOfSupply.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PackagedProductDefinition.legalStatusOfSupply.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PackagedProductDefinition.legalStatusOfSupply.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PackagedProductDefinition.legalStatusOfSupply.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.legalStatusOfSupply.jurisdiction", Name: "jurisdiction", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *PackagedProductDefinitionLegalStatusOfSupply) ElementMetadata() []ElementMetadata {
+	return packagedProductDefinitionLegalStatusOfSupplyElementMetadata
+}
+
 type PackagedProductDefinitionPackaging struct {
-	Id                *string                                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Identifier        []Identifier                                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                // An identifier that is specific to this particular part of the packaging. Including possibly a Data Carrier Identifier
-	Type              *CodeableConcept                                  `json:"type,omitempty" bson:"type,omitempty"`                            // The physical type of the container of the items
-	ComponentPart     *bool                                             `json:"componentPart,omitempty" bson:"component_part,omitempty"`         // Is this a part of the packaging (e.g. a cap or bottle stopper), rather than the packaging itself (e.g. a bottle or vial)
-	Quantity          *int                                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // The quantity of this level of packaging in the package that contains it (with the outermost level being 1)
-	Material          []CodeableConcept                                 `json:"material,omitempty" bson:"material,omitempty"`                    // Material type of the package item
-	AlternateMaterial []CodeableConcept                                 `json:"alternateMaterial,omitempty" bson:"alternate_material,omitempty"` // A possible alternate material for this part of the packaging, that is allowed to be used instead of the usual material
-	ShelfLifeStorage  []ProductShelfLife                                `json:"shelfLifeStorage,omitempty" bson:"shelf_life_storage,omitempty"`  // Shelf Life and storage information
-	Manufacturer      []Reference                                       `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`            // Manufacturer of this packaging item (multiple means these are all potential manufacturers)
-	Property          []PackagedProductDefinitionPackagingProperty      `json:"property,omitempty" bson:"property,omitempty"`                    // General characteristics of this item
-	ContainedItem     []PackagedProductDefinitionPackagingContainedItem `json:"containedItem,omitempty" bson:"contained_item,omitempty"`         // The item(s) within the packaging
-	Packaging         []PackagedProductDefinitionPackaging              `json:"packaging,omitempty" bson:"packaging,omitempty"`                  // Allows containers (and parts of containers) within containers, still as a part of single packaged product
+	Id                   *string                                           `json:"id,omitempty" bson:"id,omitempty"`                                 // Unique id for inter-element referencing
+	Extension            []Extension                                       `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored even if unrecognized
+	Identifier           []Identifier                                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // An identifier that is specific to this particular part of the packaging. Including possibly a Data Carrier Identifier
+	Type                 *CodeableConcept                                  `json:"type,omitempty" bson:"type,omitempty"`                             // The physical type of the container of the items
+	ComponentPart        *bool                                             `json:"componentPart,omitempty" bson:"component_part,omitempty"`          // Is this a part of the packaging (e.g. a cap or bottle stopper), rather than the packaging itself (e.g. a bottle or vial)
+	ComponentPartElement *Element                                          `json:"_componentPart,omitempty" bson:"component_part_element,omitempty"` // Extensions for componentPart
+	Quantity             *int                                              `json:"quantity,omitempty" bson:"quantity,omitempty"`                     // The quantity of this level of packaging in the package that contains it (with the outermost level being 1)
+	QuantityElement      *Element                                          `json:"_quantity,omitempty" bson:"quantity_element,omitempty"`            // Extensions for quantity
+	Material             []CodeableConcept                                 `json:"material,omitempty" bson:"material,omitempty"`                     // Material type of the package item
+	AlternateMaterial    []CodeableConcept                                 `json:"alternateMaterial,omitempty" bson:"alternate_material,omitempty"`  // A possible alternate material for this part of the packaging, that is allowed to be used instead of the usual material
+	ShelfLifeStorage     []ProductShelfLife                                `json:"shelfLifeStorage,omitempty" bson:"shelf_life_storage,omitempty"`   // Shelf Life and storage information
+	Manufacturer         []Reference                                       `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`             // Manufacturer of this packaging item (multiple means these are all potential manufacturers)
+	Property             []PackagedProductDefinitionPackagingProperty      `json:"property,omitempty" bson:"property,omitempty"`                     // General characteristics of this item
+	ContainedItem        []PackagedProductDefinitionPackagingContainedItem `json:"containedItem,omitempty" bson:"contained_item,omitempty"`          // The item(s) within the packaging
+	Packaging            []PackagedProductDefinitionPackaging              `json:"packaging,omitempty" bson:"packaging,omitempty"`                   // Allows containers (and parts of containers) within containers, still as a part of single packaged product
 }
 
 func (r *PackagedProductDefinitionPackaging) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -209,6 +517,16 @@ func (r *PackagedProductDefinitionPackaging) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.ComponentPartElement != nil {
+		if err := r.ComponentPartElement.Validate(); err != nil {
+			return fmt.Errorf("ComponentPartElement: %w", err)
+		}
+	}
+	if r.QuantityElement != nil {
+		if err := r.QuantityElement.Validate(); err != nil {
+			return fmt.Errorf("QuantityElement: %w", err)
+		}
+	}
 	for i, item := range r.Material {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Material[%d]: %w", i, err)
@@ -246,3 +564,449 @@ func (r *PackagedProductDefinitionPackaging) Validate() error {
 	}
 	return nil
 }
+
+func (r *PackagedProductDefinitionPackaging) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.ComponentPartElement != nil {
+		r.ComponentPartElement.validateAll(path+".componentPart", issues)
+	}
+	if r.QuantityElement != nil {
+		r.QuantityElement.validateAll(path+".quantity", issues)
+	}
+	for i, item := range r.Material {
+		item.validateAll(fmt.Sprintf("%s.material[%d]", path, i), issues)
+	}
+	for i, item := range r.AlternateMaterial {
+		item.validateAll(fmt.Sprintf("%s.alternateMaterial[%d]", path, i), issues)
+	}
+	for i, item := range r.ShelfLifeStorage {
+		item.validateAll(fmt.Sprintf("%s.shelfLifeStorage[%d]", path, i), issues)
+	}
+	for i, item := range r.Manufacturer {
+		item.validateAll(fmt.Sprintf("%s.manufacturer[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.ContainedItem {
+		item.validateAll(fmt.Sprintf("%s.containedItem[%d]", path, i), issues)
+	}
+	for i, item := range r.Packaging {
+		item.validateAll(fmt.Sprintf("%s.packaging[%d]", path, i), issues)
+	}
+}
+
+var packagedProductDefinitionPackagingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PackagedProductDefinitionPackaging) invariants() []invariant {
+	return packagedProductDefinitionPackagingInvariants
+}
+
+func (r PackagedProductDefinitionPackaging) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PackagedProductDefinitionPackaging) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PackagedProductDefinitionPackaging) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PackagedProductDefinitionPackaging) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var packagedProductDefinitionPackagingElementMetadata = []ElementMetadata{
+	{Path: "PackagedProductDefinition.packaging.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PackagedProductDefinition.packaging.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PackagedProductDefinition.packaging.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PackagedProductDefinition.packaging.identifier", Name: "identifier", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "PackagedProductDefinition.packaging.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.packaging.componentPart", Name: "componentPart", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "PackagedProductDefinition.packaging.quantity", Name: "quantity", Field: 7, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "PackagedProductDefinition.packaging.material", Name: "material", Field: 9, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.packaging.alternateMaterial", Name: "alternateMaterial", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.packaging.shelfLifeStorage", Name: "shelfLifeStorage", Field: 11, Min: 0, Max: "*", Types: []string{"ProductShelfLife"}},
+	{Path: "PackagedProductDefinition.packaging.manufacturer", Name: "manufacturer", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "PackagedProductDefinition.packaging.property", Name: "property", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PackagedProductDefinition.packaging.containedItem", Name: "containedItem", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PackagedProductDefinition.packaging.packaging", Name: "packaging", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *PackagedProductDefinitionPackaging) ElementMetadata() []ElementMetadata {
+	return packagedProductDefinitionPackagingElementMetadata
+}
+
+type PackagedProductDefinitionPackagingProperty struct {
+	Id                *string                                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                                `json:"type" bson:"type"`                                                // A code expressing the type of characteristic
+	Value             PackagedProductDefinitionPackagingPropertyValue `json:"-" bson:"value,omitempty"`                                        // A value for the characteristic
+	ValueElement      *Element                                        `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of PackagedProductDefinition.packaging.property.value[x] when more than one was decoded
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var packagedProductDefinitionPackagingPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) invariants() []invariant {
+	return packagedProductDefinitionPackagingPropertyInvariants
+}
+
+func (r PackagedProductDefinitionPackagingProperty) MarshalJSON() ([]byte, error) {
+	type alias PackagedProductDefinitionPackagingProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) UnmarshalJSON(data []byte) error {
+	type alias PackagedProductDefinitionPackagingProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", packagedProductDefinitionPackagingPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r PackagedProductDefinitionPackagingProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PackagedProductDefinitionPackagingProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(packagedProductDefinitionPackagingPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var packagedProductDefinitionPackagingPropertyElementMetadata = []ElementMetadata{
+	{Path: "PackagedProductDefinition.packaging.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PackagedProductDefinition.packaging.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PackagedProductDefinition.packaging.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PackagedProductDefinition.packaging.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PackagedProductDefinition.packaging.property.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Quantity", "date", "boolean", "Attachment"}},
+}
+
+func (r *PackagedProductDefinitionPackagingProperty) ElementMetadata() []ElementMetadata {
+	return packagedProductDefinitionPackagingPropertyElementMetadata
+}
+
+// PackagedProductDefinitionPackagingPropertyValue is the type of PackagedProductDefinition.packaging.property.value[x]. It is implemented by the PackagedProductDefinitionPackagingPropertyValue* variant types.
+type PackagedProductDefinitionPackagingPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPackagedProductDefinitionPackagingPropertyValue()
+}
+
+// PackagedProductDefinitionPackagingPropertyValueCodeableConcept is the CodeableConcept variant of PackagedProductDefinitionPackagingPropertyValue.
+type PackagedProductDefinitionPackagingPropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueCodeableConcept) isPackagedProductDefinitionPackagingPropertyValue() {
+}
+
+// PackagedProductDefinitionPackagingPropertyValueQuantity is the Quantity variant of PackagedProductDefinitionPackagingPropertyValue.
+type PackagedProductDefinitionPackagingPropertyValueQuantity struct {
+	Quantity
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueQuantity) isPackagedProductDefinitionPackagingPropertyValue() {
+}
+
+// PackagedProductDefinitionPackagingPropertyValueDate is the date variant of PackagedProductDefinitionPackagingPropertyValue.
+type PackagedProductDefinitionPackagingPropertyValueDate struct {
+	Date
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueDate) isPackagedProductDefinitionPackagingPropertyValue() {
+}
+
+// PackagedProductDefinitionPackagingPropertyValueBoolean is the boolean variant of PackagedProductDefinitionPackagingPropertyValue.
+type PackagedProductDefinitionPackagingPropertyValueBoolean bool
+
+func (v PackagedProductDefinitionPackagingPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueBoolean) isPackagedProductDefinitionPackagingPropertyValue() {
+}
+
+// PackagedProductDefinitionPackagingPropertyValueAttachment is the Attachment variant of PackagedProductDefinitionPackagingPropertyValue.
+type PackagedProductDefinitionPackagingPropertyValueAttachment struct {
+	Attachment
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v PackagedProductDefinitionPackagingPropertyValueAttachment) isPackagedProductDefinitionPackagingPropertyValue() {
+}
+
+var packagedProductDefinitionPackagingPropertyValueVariants = []PackagedProductDefinitionPackagingPropertyValue{
+	PackagedProductDefinitionPackagingPropertyValueCodeableConcept{},
+	PackagedProductDefinitionPackagingPropertyValueQuantity{},
+	PackagedProductDefinitionPackagingPropertyValueDate{},
+	PackagedProductDefinitionPackagingPropertyValueBoolean(false),
+	PackagedProductDefinitionPackagingPropertyValueAttachment{},
+}
+
+type PackagedProductDefinitionPackagingContainedItem struct {
+	Id                *string            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Item              *CodeableReference `json:"item" bson:"item"`                                                // The actual item(s) of medication, as manufactured, or a device, or other medically related item (food, biologicals, raw materials, medical fluids, gases etc.), as contained in the package
+	Amount            *Quantity          `json:"amount,omitempty" bson:"amount,omitempty"`                        // The number of this type of item within this packaging or for continuous items such as liquids it is the quantity (for example 25ml). See also PackagedProductDefinition.containedItemQuantity (especially the long definition)
+}
+
+func (r *PackagedProductDefinitionPackagingContainedItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Item == nil {
+		return fmt.Errorf("field 'Item' is required")
+	}
+	if r.Item != nil {
+		if err := r.Item.Validate(); err != nil {
+			return fmt.Errorf("Item: %w", err)
+		}
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PackagedProductDefinitionPackagingContainedItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Item == nil {
+		issues.add("required", path+".item", "field 'Item' is required")
+	}
+	if r.Item != nil {
+		r.Item.validateAll(path+".item", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+var packagedProductDefinitionPackagingContainedItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PackagedProductDefinitionPackagingContainedItem) invariants() []invariant {
+	return packagedProductDefinitionPackagingContainedItemInvariants
+}
+
+func (r PackagedProductDefinitionPackagingContainedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PackagedProductDefinitionPackagingContainedItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PackagedProductDefinitionPackagingContainedItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PackagedProductDefinitionPackagingContainedItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var packagedProductDefinitionPackagingContainedItemElementMetadata = []ElementMetadata{
+	{Path: "PackagedProductDefinition.packaging.containedItem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PackagedProductDefinition.packaging.containedItem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PackagedProductDefinition.packaging.containedItem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PackagedProductDefinition.packaging.containedItem.item", Name: "item", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "PackagedProductDefinition.packaging.containedItem.amount", Name: "amount", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *PackagedProductDefinitionPackagingContainedItem) ElementMetadata() []ElementMetadata {
+	return packagedProductDefinitionPackagingContainedItemElementMetadata
+}
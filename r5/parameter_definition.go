@@ -1,28 +1,177 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // ParameterDefinition Type: The parameters to the module. This collection specifies both the input and output parameters. Input parameters are provided by the caller as part of the $evaluate operation. Output parameters are included in the GuidanceResponse.
 type ParameterDefinition struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          *string `json:"name,omitempty" bson:"name,omitempty"`                   // Name used to access the parameter value
-	Use           string  `json:"use" bson:"use"`                                         // in | out
-	Min           *int    `json:"min,omitempty" bson:"min,omitempty"`                     // Minimum cardinality
-	Max           *string `json:"max,omitempty" bson:"max,omitempty"`                     // Maximum cardinality (a number of *)
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // A brief description of the parameter
-	Type          string  `json:"type" bson:"type"`                                       // What type of value
-	Profile       *string `json:"profile,omitempty" bson:"profile,omitempty"`             // What profile the value is expected to be
+	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	Name                 *string               `json:"name,omitempty" bson:"name,omitempty"`                            // Name used to access the parameter value
+	NameElement          *Element              `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Use                  OperationParameterUse `json:"use" bson:"use"`                                                  // in | out
+	UseElement           *Element              `json:"_use,omitempty" bson:"use_element,omitempty"`                     // Extensions for use
+	Min                  *int                  `json:"min,omitempty" bson:"min,omitempty"`                              // Minimum cardinality
+	MinElement           *Element              `json:"_min,omitempty" bson:"min_element,omitempty"`                     // Extensions for min
+	Max                  *string               `json:"max,omitempty" bson:"max,omitempty"`                              // Maximum cardinality (a number of *)
+	MaxElement           *Element              `json:"_max,omitempty" bson:"max_element,omitempty"`                     // Extensions for max
+	Documentation        *string               `json:"documentation,omitempty" bson:"documentation,omitempty"`          // A brief description of the parameter
+	DocumentationElement *Element              `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+	Type                 FHIRTypes             `json:"type" bson:"type"`                                                // What type of value
+	TypeElement          *Element              `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Profile              *string               `json:"profile,omitempty" bson:"profile,omitempty"`                      // What profile the value is expected to be
+	ProfileElement       *Element              `json:"_profile,omitempty" bson:"profile_element,omitempty"`             // Extensions for profile
 }
 
 func (r *ParameterDefinition) Validate() error {
-	var emptyString string
-	if r.Use == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Use == "" {
 		return fmt.Errorf("field 'Use' is required")
 	}
-	if r.Type == emptyString {
+	if !r.Use.IsValid() {
+		return fmt.Errorf("field 'Use' has invalid code '%s'", r.Use)
+	}
+	if r.UseElement != nil {
+		if err := r.UseElement.Validate(); err != nil {
+			return fmt.Errorf("UseElement: %w", err)
+		}
+	}
+	if r.MinElement != nil {
+		if err := r.MinElement.Validate(); err != nil {
+			return fmt.Errorf("MinElement: %w", err)
+		}
+	}
+	if r.MaxElement != nil {
+		if err := r.MaxElement.Validate(); err != nil {
+			return fmt.Errorf("MaxElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.ProfileElement != nil {
+		if err := r.ProfileElement.Validate(); err != nil {
+			return fmt.Errorf("ProfileElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *ParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Use == "" {
+		issues.add("required", path+".use", "field 'Use' is required")
+	}
+	if r.Use != "" && !r.Use.IsValid() {
+		issues.add("code-invalid", path+".use", fmt.Sprintf("field 'Use' has invalid code '%s'", r.Use))
+	}
+	if r.UseElement != nil {
+		r.UseElement.validateAll(path+".use", issues)
+	}
+	if r.MinElement != nil {
+		r.MinElement.validateAll(path+".min", issues)
+	}
+	if r.MaxElement != nil {
+		r.MaxElement.validateAll(path+".max", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.ProfileElement != nil {
+		r.ProfileElement.validateAll(path+".profile", issues)
+	}
+}
+
+func (r *ParameterDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ParameterDefinition", &issues)
+	checkInvariants(r, "ParameterDefinition", &issues)
+	return issues
+}
+
+var parameterDefinitionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ParameterDefinition) invariants() []invariant {
+	return parameterDefinitionInvariants
+}
+
+func (r ParameterDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ParameterDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ParameterDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ParameterDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var parameterDefinitionElementTypes = map[string]string{
+	"name":    "code",
+	"profile": "canonical",
+}
+
+func (r *ParameterDefinition) elementTypes() map[string]string {
+	return parameterDefinitionElementTypes
+}
+
+var parameterDefinitionElementMetadata = []ElementMetadata{
+	{Path: "ParameterDefinition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ParameterDefinition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ParameterDefinition.name", Name: "name", Field: 2, Min: 0, Max: "1", Types: []string{"code"}, Summary: true},
+	{Path: "ParameterDefinition.use", Name: "use", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ParameterDefinition.use", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/operation-parameter-use", Description: "Whether the parameter is input or output."}, Summary: true},
+	{Path: "ParameterDefinition.min", Name: "min", Field: 6, Min: 0, Max: "1", Types: []string{"integer"}, Summary: true},
+	{Path: "ParameterDefinition.max", Name: "max", Field: 8, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ParameterDefinition.documentation", Name: "documentation", Field: 10, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "ParameterDefinition.type", Name: "type", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ParameterDefinition.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/fhir-types", Description: "List of FHIR types (resources, data types)."}, Summary: true},
+	{Path: "ParameterDefinition.profile", Name: "profile", Field: 14, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/StructureDefinition"}, Summary: true},
+}
+
+func (r *ParameterDefinition) ElementMetadata() []ElementMetadata {
+	return parameterDefinitionElementMetadata
+}
@@ -2,17 +2,20 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource is used to pass information into and back from an operation (whether invoked directly from REST or within a messaging environment).  It is not persisted or allowed to be referenced by other resources except as described in the definition of the Parameters resource.
 type Parameters struct {
-	ResourceType  string                `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string               `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string               `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Parameter     []ParametersParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`          // Operation Parameter
+	ResourceType         string                `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string               `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element              `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Parameter            []ParametersParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                   // Operation Parameter
 }
 
 func (r *Parameters) Validate() error {
@@ -24,265 +27,1359 @@ func (r *Parameters) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	for i, item := range r.Parameter {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Parameter[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ParametersParameter struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                                    // Unique id for inter-element referencing
-	Name                       string                 `json:"name" bson:"name"`                                                                    // Name from the definition
-	ValueBase64Binary          *string                `json:"valueBase64Binary,omitempty" bson:"value_base64_binary,omitempty"`                    // If parameter is a data type
-	ValueBoolean               *bool                  `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                               // If parameter is a data type
-	ValueCanonical             *string                `json:"valueCanonical,omitempty" bson:"value_canonical,omitempty"`                           // If parameter is a data type
-	ValueCode                  *string                `json:"valueCode,omitempty" bson:"value_code,omitempty"`                                     // If parameter is a data type
-	ValueDate                  *string                `json:"valueDate,omitempty" bson:"value_date,omitempty"`                                     // If parameter is a data type
-	ValueDateTime              *string                `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"`                            // If parameter is a data type
-	ValueDecimal               *float64               `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`                               // If parameter is a data type
-	ValueId                    *string                `json:"valueId,omitempty" bson:"value_id,omitempty"`                                         // If parameter is a data type
-	ValueInstant               *string                `json:"valueInstant,omitempty" bson:"value_instant,omitempty"`                               // If parameter is a data type
-	ValueInteger               *int                   `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`                               // If parameter is a data type
-	ValueInteger64             *int64                 `json:"valueInteger64,omitempty" bson:"value_integer64,omitempty"`                           // If parameter is a data type
-	ValueMarkdown              *string                `json:"valueMarkdown,omitempty" bson:"value_markdown,omitempty"`                             // If parameter is a data type
-	ValueOid                   *string                `json:"valueOid,omitempty" bson:"value_oid,omitempty"`                                       // If parameter is a data type
-	ValuePositiveInt           *int                   `json:"valuePositiveInt,omitempty" bson:"value_positive_int,omitempty"`                      // If parameter is a data type
-	ValueString                *string                `json:"valueString,omitempty" bson:"value_string,omitempty"`                                 // If parameter is a data type
-	ValueTime                  *string                `json:"valueTime,omitempty" bson:"value_time,omitempty"`                                     // If parameter is a data type
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt,omitempty" bson:"value_unsigned_int,omitempty"`                      // If parameter is a data type
-	ValueUri                   *string                `json:"valueUri,omitempty" bson:"value_uri,omitempty"`                                       // If parameter is a data type
-	ValueUrl                   *string                `json:"valueUrl,omitempty" bson:"value_url,omitempty"`                                       // If parameter is a data type
-	ValueUuid                  *uuid                  `json:"valueUuid,omitempty" bson:"value_uuid,omitempty"`                                     // If parameter is a data type
-	ValueAddress               *Address               `json:"valueAddress,omitempty" bson:"value_address,omitempty"`                               // If parameter is a data type
-	ValueAge                   *Age                   `json:"valueAge,omitempty" bson:"value_age,omitempty"`                                       // If parameter is a data type
-	ValueAnnotation            *Annotation            `json:"valueAnnotation,omitempty" bson:"value_annotation,omitempty"`                         // If parameter is a data type
-	ValueAttachment            *Attachment            `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`                         // If parameter is a data type
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"`              // If parameter is a data type
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference,omitempty" bson:"value_codeable_reference,omitempty"`          // If parameter is a data type
-	ValueCoding                *Coding                `json:"valueCoding,omitempty" bson:"value_coding,omitempty"`                                 // If parameter is a data type
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint,omitempty" bson:"value_contact_point,omitempty"`                    // If parameter is a data type
-	ValueCount                 *Count                 `json:"valueCount,omitempty" bson:"value_count,omitempty"`                                   // If parameter is a data type
-	ValueDistance              *Distance              `json:"valueDistance,omitempty" bson:"value_distance,omitempty"`                             // If parameter is a data type
-	ValueDuration              *Duration              `json:"valueDuration,omitempty" bson:"value_duration,omitempty"`                             // If parameter is a data type
-	ValueHumanName             *HumanName             `json:"valueHumanName,omitempty" bson:"value_human_name,omitempty"`                          // If parameter is a data type
-	ValueIdentifier            *Identifier            `json:"valueIdentifier,omitempty" bson:"value_identifier,omitempty"`                         // If parameter is a data type
-	ValueMoney                 *Money                 `json:"valueMoney,omitempty" bson:"value_money,omitempty"`                                   // If parameter is a data type
-	ValuePeriod                *Period                `json:"valuePeriod,omitempty" bson:"value_period,omitempty"`                                 // If parameter is a data type
-	ValueQuantity              *Quantity              `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                             // If parameter is a data type
-	ValueRange                 *Range                 `json:"valueRange,omitempty" bson:"value_range,omitempty"`                                   // If parameter is a data type
-	ValueRatio                 *Ratio                 `json:"valueRatio,omitempty" bson:"value_ratio,omitempty"`                                   // If parameter is a data type
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange,omitempty" bson:"value_ratio_range,omitempty"`                        // If parameter is a data type
-	ValueReference             *Reference             `json:"valueReference,omitempty" bson:"value_reference,omitempty"`                           // If parameter is a data type
-	ValueSampledData           *SampledData           `json:"valueSampledData,omitempty" bson:"value_sampled_data,omitempty"`                      // If parameter is a data type
-	ValueSignature             *Signature             `json:"valueSignature,omitempty" bson:"value_signature,omitempty"`                           // If parameter is a data type
-	ValueTiming                *Timing                `json:"valueTiming,omitempty" bson:"value_timing,omitempty"`                                 // If parameter is a data type
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail,omitempty" bson:"value_contact_detail,omitempty"`                  // If parameter is a data type
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement,omitempty" bson:"value_data_requirement,omitempty"`              // If parameter is a data type
-	ValueExpression            *Expression            `json:"valueExpression,omitempty" bson:"value_expression,omitempty"`                         // If parameter is a data type
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition,omitempty" bson:"value_parameter_definition,omitempty"`      // If parameter is a data type
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact,omitempty" bson:"value_related_artifact,omitempty"`              // If parameter is a data type
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition,omitempty" bson:"value_trigger_definition,omitempty"`          // If parameter is a data type
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext,omitempty" bson:"value_usage_context,omitempty"`                    // If parameter is a data type
-	ValueAvailability          *Availability          `json:"valueAvailability,omitempty" bson:"value_availability,omitempty"`                     // If parameter is a data type
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail,omitempty" bson:"value_extended_contact_detail,omitempty"` // If parameter is a data type
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail,omitempty" bson:"value_virtual_service_detail,omitempty"`   // If parameter is a data type
-	ValueDosage                *Dosage                `json:"valueDosage,omitempty" bson:"value_dosage,omitempty"`                                 // If parameter is a data type
-	ValueMeta                  *Meta                  `json:"valueMeta,omitempty" bson:"value_meta,omitempty"`                                     // If parameter is a data type
-	Resource                   json.RawMessage        `json:"resource,omitempty" bson:"resource,omitempty"`                                        // If parameter is a whole resource
-	Part                       []ParametersParameter  `json:"part,omitempty" bson:"part,omitempty"`                                                // Named part of a multi-part parameter
+	return validateInvariants(r, "Parameters")
 }
 
-func (r *ParametersParameter) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
+func (r *Parameters) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Parameters" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Parameters', got '%s'", r.ResourceType))
 	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+}
+
+func (r *Parameters) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Parameters", &issues)
+	checkInvariants(r, "Parameters", &issues)
+	return issues
+}
+
+func (r Parameters) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Parameters) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Parameters) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Parameters) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var parametersElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Parameters) elementTypes() map[string]string {
+	return parametersElementTypes
+}
+
+var parametersElementMetadata = []ElementMetadata{
+	{Path: "Parameters.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Parameters.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Parameters.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Parameters.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Parameters.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Parameters.parameter", Name: "parameter", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Parameters) ElementMetadata() []ElementMetadata {
+	return parametersElementMetadata
+}
+
+func (r *Parameters) GetResourceType() string {
+	return "Parameters"
+}
+
+func (r *Parameters) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *Parameters) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	r.Id = &id
+}
+
+func (r *Parameters) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Parameters) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+var _ Resource = (*Parameters)(nil)
+
+type ParametersParameter struct {
+	Id                *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string                   `json:"name" bson:"name"`                                                // Name from the definition
+	NameElement       *Element                 `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Value             ParametersParameterValue `json:"-" bson:"value,omitempty"`                                        // If parameter is a data type
+	ValueElement      *Element                 `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Resource          Resource                 `json:"resource,omitempty" bson:"resource,omitempty"`                    // If parameter is a whole resource
+	Part              []ParametersParameter    `json:"part,omitempty" bson:"part,omitempty"`                            // Named part of a multi-part parameter
+
+	valueVariants []string // JSON properties of Parameters.parameter.value[x] when more than one was decoded
+}
+
+func (r *ParametersParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
-		}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
+	if r.Resource != nil {
+		if err := r.Resource.Validate(); err != nil {
+			return fmt.Errorf("Resource: %w", err)
 		}
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	for i, item := range r.Part {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Part[%d]: %w", i, err)
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	return nil
+}
+
+func (r *ParametersParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
-		}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
 	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.Resource != nil {
+		issues.addNested(path+".resource", r.Resource.ValidateAll())
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+	for i, item := range r.Part {
+		item.validateAll(fmt.Sprintf("%s.part[%d]", path, i), issues)
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+}
+
+var parametersParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ParametersParameter) invariants() []invariant {
+	return parametersParameterInvariants
+}
+
+func (r ParametersParameter) MarshalJSON() ([]byte, error) {
+	type alias ParametersParameter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
-		}
+	return data, nil
+}
+
+func (r *ParametersParameter) UnmarshalJSON(data []byte) error {
+	type alias ParametersParameter
+	aux := struct {
+		*alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	var err error
+	if r.Resource, err = unmarshalOptionalResource(aux.Resource); err != nil {
+		return fmt.Errorf("resource: %w", err)
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
-		}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
-		}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", parametersParameterValueVariants); err != nil {
+		return err
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
+	return nil
+}
+
+func (r ParametersParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ParametersParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ParametersParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ParametersParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ParametersParameter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(parametersParameterValueVariants)
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+	return "", nil
+}
+
+var parametersParameterElementMetadata = []ElementMetadata{
+	{Path: "Parameters.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Parameters.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Parameters.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Parameters.parameter.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Parameters.parameter.value[x]", Name: "value", Field: 5, Min: 0, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}},
+	{Path: "Parameters.parameter.resource", Name: "resource", Field: 7, Min: 0, Max: "1", Types: []string{"Resource"}},
+	{Path: "Parameters.parameter.part", Name: "part", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ParametersParameter) ElementMetadata() []ElementMetadata {
+	return parametersParameterElementMetadata
+}
+
+// ParametersParameterValue is the type of Parameters.parameter.value[x]. It is implemented by the ParametersParameterValue* variant types.
+type ParametersParameterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isParametersParameterValue()
+}
+
+// ParametersParameterValueBase64Binary is the base64Binary variant of ParametersParameterValue.
+type ParametersParameterValueBase64Binary string
+
+func (v ParametersParameterValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v ParametersParameterValueBase64Binary) isParametersParameterValue() {}
+
+// ParametersParameterValueBoolean is the boolean variant of ParametersParameterValue.
+type ParametersParameterValueBoolean bool
+
+func (v ParametersParameterValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ParametersParameterValueBoolean) isParametersParameterValue() {}
+
+// ParametersParameterValueCanonical is the canonical variant of ParametersParameterValue.
+type ParametersParameterValueCanonical string
+
+func (v ParametersParameterValueCanonical) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ParametersParameterValueCanonical) isParametersParameterValue() {}
+
+// ParametersParameterValueCode is the code variant of ParametersParameterValue.
+type ParametersParameterValueCode string
+
+func (v ParametersParameterValueCode) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ParametersParameterValueCode) isParametersParameterValue() {}
+
+// ParametersParameterValueDate is the date variant of ParametersParameterValue.
+type ParametersParameterValueDate struct {
+	Date
+}
+
+func (v ParametersParameterValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v ParametersParameterValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+}
+
+func (v ParametersParameterValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v ParametersParameterValueDate) isParametersParameterValue() {}
+
+// ParametersParameterValueDateTime is the dateTime variant of ParametersParameterValue.
+type ParametersParameterValueDateTime struct {
+	DateTime
+}
+
+func (v ParametersParameterValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ParametersParameterValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
-		}
+}
+
+func (v ParametersParameterValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ParametersParameterValueDateTime) isParametersParameterValue() {}
+
+// ParametersParameterValueDecimal is the decimal variant of ParametersParameterValue.
+type ParametersParameterValueDecimal struct {
+	Decimal
+}
+
+func (v ParametersParameterValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ParametersParameterValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	for i, item := range r.Part {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Part[%d]: %w", i, err)
-		}
+}
+
+func (v ParametersParameterValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ParametersParameterValueDecimal) isParametersParameterValue() {}
+
+// ParametersParameterValueId is the id variant of ParametersParameterValue.
+type ParametersParameterValueId string
+
+func (v ParametersParameterValueId) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueId) FHIRType() string {
+	return "id"
+}
+
+func (v ParametersParameterValueId) isParametersParameterValue() {}
+
+// ParametersParameterValueInstant is the instant variant of ParametersParameterValue.
+type ParametersParameterValueInstant struct {
+	Instant
+}
+
+func (v ParametersParameterValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v ParametersParameterValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
+}
+
+func (v ParametersParameterValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v ParametersParameterValueInstant) isParametersParameterValue() {}
+
+// ParametersParameterValueInteger is the integer variant of ParametersParameterValue.
+type ParametersParameterValueInteger int
+
+func (v ParametersParameterValueInteger) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ParametersParameterValueInteger) isParametersParameterValue() {}
+
+// ParametersParameterValueInteger64 is the integer64 variant of ParametersParameterValue.
+type ParametersParameterValueInteger64 int64
+
+func (v ParametersParameterValueInteger64) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v ParametersParameterValueInteger64) isParametersParameterValue() {}
+
+// ParametersParameterValueMarkdown is the markdown variant of ParametersParameterValue.
+type ParametersParameterValueMarkdown string
+
+func (v ParametersParameterValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ParametersParameterValueMarkdown) isParametersParameterValue() {}
+
+// ParametersParameterValueOid is the oid variant of ParametersParameterValue.
+type ParametersParameterValueOid string
+
+func (v ParametersParameterValueOid) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v ParametersParameterValueOid) isParametersParameterValue() {}
+
+// ParametersParameterValuePositiveInt is the positiveInt variant of ParametersParameterValue.
+type ParametersParameterValuePositiveInt int
+
+func (v ParametersParameterValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v ParametersParameterValuePositiveInt) isParametersParameterValue() {}
+
+// ParametersParameterValueString is the string variant of ParametersParameterValue.
+type ParametersParameterValueString string
+
+func (v ParametersParameterValueString) Validate() error {
 	return nil
 }
+
+func (v ParametersParameterValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ParametersParameterValueString) isParametersParameterValue() {}
+
+// ParametersParameterValueTime is the time variant of ParametersParameterValue.
+type ParametersParameterValueTime struct {
+	Time
+}
+
+func (v ParametersParameterValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v ParametersParameterValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ParametersParameterValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v ParametersParameterValueTime) isParametersParameterValue() {}
+
+// ParametersParameterValueUnsignedInt is the unsignedInt variant of ParametersParameterValue.
+type ParametersParameterValueUnsignedInt int
+
+func (v ParametersParameterValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v ParametersParameterValueUnsignedInt) isParametersParameterValue() {}
+
+// ParametersParameterValueUri is the uri variant of ParametersParameterValue.
+type ParametersParameterValueUri string
+
+func (v ParametersParameterValueUri) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ParametersParameterValueUri) isParametersParameterValue() {}
+
+// ParametersParameterValueUrl is the url variant of ParametersParameterValue.
+type ParametersParameterValueUrl string
+
+func (v ParametersParameterValueUrl) Validate() error {
+	return nil
+}
+
+func (v ParametersParameterValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ParametersParameterValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v ParametersParameterValueUrl) isParametersParameterValue() {}
+
+// ParametersParameterValueUuid is the uuid variant of ParametersParameterValue.
+type ParametersParameterValueUuid struct {
+	uuid
+}
+
+func (v ParametersParameterValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v ParametersParameterValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v ParametersParameterValueUuid) isParametersParameterValue() {}
+
+// ParametersParameterValueAddress is the Address variant of ParametersParameterValue.
+type ParametersParameterValueAddress struct {
+	Address
+}
+
+func (v ParametersParameterValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v ParametersParameterValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v ParametersParameterValueAddress) isParametersParameterValue() {}
+
+// ParametersParameterValueAge is the Age variant of ParametersParameterValue.
+type ParametersParameterValueAge struct {
+	Age
+}
+
+func (v ParametersParameterValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ParametersParameterValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ParametersParameterValueAge) isParametersParameterValue() {}
+
+// ParametersParameterValueAnnotation is the Annotation variant of ParametersParameterValue.
+type ParametersParameterValueAnnotation struct {
+	Annotation
+}
+
+func (v ParametersParameterValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v ParametersParameterValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v ParametersParameterValueAnnotation) isParametersParameterValue() {}
+
+// ParametersParameterValueAttachment is the Attachment variant of ParametersParameterValue.
+type ParametersParameterValueAttachment struct {
+	Attachment
+}
+
+func (v ParametersParameterValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ParametersParameterValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ParametersParameterValueAttachment) isParametersParameterValue() {}
+
+// ParametersParameterValueCodeableConcept is the CodeableConcept variant of ParametersParameterValue.
+type ParametersParameterValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ParametersParameterValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ParametersParameterValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ParametersParameterValueCodeableConcept) isParametersParameterValue() {}
+
+// ParametersParameterValueCodeableReference is the CodeableReference variant of ParametersParameterValue.
+type ParametersParameterValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v ParametersParameterValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v ParametersParameterValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v ParametersParameterValueCodeableReference) isParametersParameterValue() {}
+
+// ParametersParameterValueCoding is the Coding variant of ParametersParameterValue.
+type ParametersParameterValueCoding struct {
+	Coding
+}
+
+func (v ParametersParameterValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ParametersParameterValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ParametersParameterValueCoding) isParametersParameterValue() {}
+
+// ParametersParameterValueContactPoint is the ContactPoint variant of ParametersParameterValue.
+type ParametersParameterValueContactPoint struct {
+	ContactPoint
+}
+
+func (v ParametersParameterValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v ParametersParameterValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v ParametersParameterValueContactPoint) isParametersParameterValue() {}
+
+// ParametersParameterValueCount is the Count variant of ParametersParameterValue.
+type ParametersParameterValueCount struct {
+	Count
+}
+
+func (v ParametersParameterValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v ParametersParameterValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v ParametersParameterValueCount) isParametersParameterValue() {}
+
+// ParametersParameterValueDistance is the Distance variant of ParametersParameterValue.
+type ParametersParameterValueDistance struct {
+	Distance
+}
+
+func (v ParametersParameterValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v ParametersParameterValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v ParametersParameterValueDistance) isParametersParameterValue() {}
+
+// ParametersParameterValueDuration is the Duration variant of ParametersParameterValue.
+type ParametersParameterValueDuration struct {
+	Duration
+}
+
+func (v ParametersParameterValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ParametersParameterValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ParametersParameterValueDuration) isParametersParameterValue() {}
+
+// ParametersParameterValueHumanName is the HumanName variant of ParametersParameterValue.
+type ParametersParameterValueHumanName struct {
+	HumanName
+}
+
+func (v ParametersParameterValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v ParametersParameterValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v ParametersParameterValueHumanName) isParametersParameterValue() {}
+
+// ParametersParameterValueIdentifier is the Identifier variant of ParametersParameterValue.
+type ParametersParameterValueIdentifier struct {
+	Identifier
+}
+
+func (v ParametersParameterValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v ParametersParameterValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v ParametersParameterValueIdentifier) isParametersParameterValue() {}
+
+// ParametersParameterValueMoney is the Money variant of ParametersParameterValue.
+type ParametersParameterValueMoney struct {
+	Money
+}
+
+func (v ParametersParameterValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v ParametersParameterValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v ParametersParameterValueMoney) isParametersParameterValue() {}
+
+// ParametersParameterValuePeriod is the Period variant of ParametersParameterValue.
+type ParametersParameterValuePeriod struct {
+	Period
+}
+
+func (v ParametersParameterValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ParametersParameterValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ParametersParameterValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ParametersParameterValuePeriod) isParametersParameterValue() {}
+
+// ParametersParameterValueQuantity is the Quantity variant of ParametersParameterValue.
+type ParametersParameterValueQuantity struct {
+	Quantity
+}
+
+func (v ParametersParameterValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ParametersParameterValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ParametersParameterValueQuantity) isParametersParameterValue() {}
+
+// ParametersParameterValueRange is the Range variant of ParametersParameterValue.
+type ParametersParameterValueRange struct {
+	Range
+}
+
+func (v ParametersParameterValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ParametersParameterValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ParametersParameterValueRange) isParametersParameterValue() {}
+
+// ParametersParameterValueRatio is the Ratio variant of ParametersParameterValue.
+type ParametersParameterValueRatio struct {
+	Ratio
+}
+
+func (v ParametersParameterValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ParametersParameterValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ParametersParameterValueRatio) isParametersParameterValue() {}
+
+// ParametersParameterValueRatioRange is the RatioRange variant of ParametersParameterValue.
+type ParametersParameterValueRatioRange struct {
+	RatioRange
+}
+
+func (v ParametersParameterValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v ParametersParameterValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v ParametersParameterValueRatioRange) isParametersParameterValue() {}
+
+// ParametersParameterValueReference is the Reference variant of ParametersParameterValue.
+type ParametersParameterValueReference struct {
+	Reference
+}
+
+func (v ParametersParameterValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ParametersParameterValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ParametersParameterValueReference) isParametersParameterValue() {}
+
+// ParametersParameterValueSampledData is the SampledData variant of ParametersParameterValue.
+type ParametersParameterValueSampledData struct {
+	SampledData
+}
+
+func (v ParametersParameterValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v ParametersParameterValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v ParametersParameterValueSampledData) isParametersParameterValue() {}
+
+// ParametersParameterValueSignature is the Signature variant of ParametersParameterValue.
+type ParametersParameterValueSignature struct {
+	Signature
+}
+
+func (v ParametersParameterValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v ParametersParameterValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v ParametersParameterValueSignature) isParametersParameterValue() {}
+
+// ParametersParameterValueTiming is the Timing variant of ParametersParameterValue.
+type ParametersParameterValueTiming struct {
+	Timing
+}
+
+func (v ParametersParameterValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ParametersParameterValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ParametersParameterValueTiming) isParametersParameterValue() {}
+
+// ParametersParameterValueContactDetail is the ContactDetail variant of ParametersParameterValue.
+type ParametersParameterValueContactDetail struct {
+	ContactDetail
+}
+
+func (v ParametersParameterValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v ParametersParameterValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v ParametersParameterValueContactDetail) isParametersParameterValue() {}
+
+// ParametersParameterValueDataRequirement is the DataRequirement variant of ParametersParameterValue.
+type ParametersParameterValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v ParametersParameterValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v ParametersParameterValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v ParametersParameterValueDataRequirement) isParametersParameterValue() {}
+
+// ParametersParameterValueExpression is the Expression variant of ParametersParameterValue.
+type ParametersParameterValueExpression struct {
+	Expression
+}
+
+func (v ParametersParameterValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v ParametersParameterValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v ParametersParameterValueExpression) isParametersParameterValue() {}
+
+// ParametersParameterValueParameterDefinition is the ParameterDefinition variant of ParametersParameterValue.
+type ParametersParameterValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v ParametersParameterValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v ParametersParameterValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v ParametersParameterValueParameterDefinition) isParametersParameterValue() {}
+
+// ParametersParameterValueRelatedArtifact is the RelatedArtifact variant of ParametersParameterValue.
+type ParametersParameterValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v ParametersParameterValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v ParametersParameterValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v ParametersParameterValueRelatedArtifact) isParametersParameterValue() {}
+
+// ParametersParameterValueTriggerDefinition is the TriggerDefinition variant of ParametersParameterValue.
+type ParametersParameterValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v ParametersParameterValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v ParametersParameterValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v ParametersParameterValueTriggerDefinition) isParametersParameterValue() {}
+
+// ParametersParameterValueUsageContext is the UsageContext variant of ParametersParameterValue.
+type ParametersParameterValueUsageContext struct {
+	UsageContext
+}
+
+func (v ParametersParameterValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v ParametersParameterValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v ParametersParameterValueUsageContext) isParametersParameterValue() {}
+
+// ParametersParameterValueAvailability is the Availability variant of ParametersParameterValue.
+type ParametersParameterValueAvailability struct {
+	Availability
+}
+
+func (v ParametersParameterValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v ParametersParameterValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v ParametersParameterValueAvailability) isParametersParameterValue() {}
+
+// ParametersParameterValueExtendedContactDetail is the ExtendedContactDetail variant of ParametersParameterValue.
+type ParametersParameterValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v ParametersParameterValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v ParametersParameterValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v ParametersParameterValueExtendedContactDetail) isParametersParameterValue() {}
+
+// ParametersParameterValueVirtualServiceDetail is the VirtualServiceDetail variant of ParametersParameterValue.
+type ParametersParameterValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v ParametersParameterValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v ParametersParameterValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v ParametersParameterValueVirtualServiceDetail) isParametersParameterValue() {}
+
+// ParametersParameterValueDosage is the Dosage variant of ParametersParameterValue.
+type ParametersParameterValueDosage struct {
+	Dosage
+}
+
+func (v ParametersParameterValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v ParametersParameterValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v ParametersParameterValueDosage) isParametersParameterValue() {}
+
+// ParametersParameterValueMeta is the Meta variant of ParametersParameterValue.
+type ParametersParameterValueMeta struct {
+	Meta
+}
+
+func (v ParametersParameterValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v ParametersParameterValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v ParametersParameterValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v ParametersParameterValueMeta) isParametersParameterValue() {}
+
+var parametersParameterValueVariants = []ParametersParameterValue{
+	ParametersParameterValueBase64Binary(""),
+	ParametersParameterValueBoolean(false),
+	ParametersParameterValueCanonical(""),
+	ParametersParameterValueCode(""),
+	ParametersParameterValueDate{},
+	ParametersParameterValueDateTime{},
+	ParametersParameterValueDecimal{},
+	ParametersParameterValueId(""),
+	ParametersParameterValueInstant{},
+	ParametersParameterValueInteger(0),
+	ParametersParameterValueInteger64(0),
+	ParametersParameterValueMarkdown(""),
+	ParametersParameterValueOid(""),
+	ParametersParameterValuePositiveInt(0),
+	ParametersParameterValueString(""),
+	ParametersParameterValueTime{},
+	ParametersParameterValueUnsignedInt(0),
+	ParametersParameterValueUri(""),
+	ParametersParameterValueUrl(""),
+	ParametersParameterValueUuid{},
+	ParametersParameterValueAddress{},
+	ParametersParameterValueAge{},
+	ParametersParameterValueAnnotation{},
+	ParametersParameterValueAttachment{},
+	ParametersParameterValueCodeableConcept{},
+	ParametersParameterValueCodeableReference{},
+	ParametersParameterValueCoding{},
+	ParametersParameterValueContactPoint{},
+	ParametersParameterValueCount{},
+	ParametersParameterValueDistance{},
+	ParametersParameterValueDuration{},
+	ParametersParameterValueHumanName{},
+	ParametersParameterValueIdentifier{},
+	ParametersParameterValueMoney{},
+	ParametersParameterValuePeriod{},
+	ParametersParameterValueQuantity{},
+	ParametersParameterValueRange{},
+	ParametersParameterValueRatio{},
+	ParametersParameterValueRatioRange{},
+	ParametersParameterValueReference{},
+	ParametersParameterValueSampledData{},
+	ParametersParameterValueSignature{},
+	ParametersParameterValueTiming{},
+	ParametersParameterValueContactDetail{},
+	ParametersParameterValueDataRequirement{},
+	ParametersParameterValueExpression{},
+	ParametersParameterValueParameterDefinition{},
+	ParametersParameterValueRelatedArtifact{},
+	ParametersParameterValueTriggerDefinition{},
+	ParametersParameterValueUsageContext{},
+	ParametersParameterValueAvailability{},
+	ParametersParameterValueExtendedContactDetail{},
+	ParametersParameterValueVirtualServiceDetail{},
+	ParametersParameterValueDosage{},
+	ParametersParameterValueMeta{},
+}
@@ -2,38 +2,53 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Demographics and other administrative information about an individual or animal that is the subject of potential, past, current, or future health-related care, services, or processes.
 type Patient struct {
-	ResourceType         string                 `json:"resourceType" bson:"resource_type"`                                      // Type of resource
-	Id                   *string                `json:"id,omitempty" bson:"id,omitempty"`                                       // Logical id of this artifact
-	Meta                 *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                                   // Metadata about the resource
-	ImplicitRules        *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                // A set of rules under which this content was created
-	Language             *string                `json:"language,omitempty" bson:"language,omitempty"`                           // Language of the resource content
-	Text                 *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                                   // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage      `json:"contained,omitempty" bson:"contained,omitempty"`                         // Contained, inline Resources
-	Identifier           []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // An identifier for this patient
-	Active               *bool                  `json:"active,omitempty" bson:"active,omitempty"`                               // Whether this patient's record is in active use
-	Name                 []HumanName            `json:"name,omitempty" bson:"name,omitempty"`                                   // A name associated with the patient
-	Telecom              []ContactPoint         `json:"telecom,omitempty" bson:"telecom,omitempty"`                             // A contact detail for the individual
-	Gender               *string                `json:"gender,omitempty" bson:"gender,omitempty"`                               // male | female | other | unknown
-	BirthDate            *string                `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                        // The date of birth for the individual
-	DeceasedBoolean      *bool                  `json:"deceasedBoolean,omitempty" bson:"deceased_boolean,omitempty"`            // Indicates if/when the individual is deceased
-	DeceasedDateTime     *string                `json:"deceasedDateTime,omitempty" bson:"deceased_date_time,omitempty"`         // Indicates if/when the individual is deceased
-	Address              []Address              `json:"address,omitempty" bson:"address,omitempty"`                             // An address for the individual
-	MaritalStatus        *CodeableConcept       `json:"maritalStatus,omitempty" bson:"marital_status,omitempty"`                // Marital (civil) status of a patient
-	MultipleBirthBoolean *bool                  `json:"multipleBirthBoolean,omitempty" bson:"multiple_birth_boolean,omitempty"` // Whether patient is part of a multiple birth
-	MultipleBirthInteger *int                   `json:"multipleBirthInteger,omitempty" bson:"multiple_birth_integer,omitempty"` // Whether patient is part of a multiple birth
-	Photo                []Attachment           `json:"photo,omitempty" bson:"photo,omitempty"`                                 // Image of the patient
-	Contact              []PatientContact       `json:"contact,omitempty" bson:"contact,omitempty"`                             // A contact party (e.g. guardian, partner, friend) for the patient
-	Communication        []PatientCommunication `json:"communication,omitempty" bson:"communication,omitempty"`                 // A language which may be used to communicate with the patient about his or her health
-	GeneralPractitioner  []Reference            `json:"generalPractitioner,omitempty" bson:"general_practitioner,omitempty"`    // Patient's nominated primary care provider
-	ManagingOrganization *Reference             `json:"managingOrganization,omitempty" bson:"managing_organization,omitempty"`  // Organization that is the custodian of the patient record
-	Link                 []PatientLink          `json:"link,omitempty" bson:"link,omitempty"`                                   // Link to a Patient or RelatedPerson resource that concerns the same actual individual
+	ResourceType         string                 `json:"resourceType" bson:"resource_type"`                                     // Type of resource
+	Id                   *string                `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
+	Meta                 *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
+	ImplicitRules        *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
+	ImplicitRulesElement *Element               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`      // Extensions for implicitRules
+	Language             *string                `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
+	LanguageElement      *Element               `json:"_language,omitempty" bson:"language_element,omitempty"`                 // Extensions for language
+	Text                 *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
+	Contained            []Resource             `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Extension            []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored
+	Identifier           []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // An identifier for this patient
+	Active               *bool                  `json:"active,omitempty" bson:"active,omitempty"`                              // Whether this patient's record is in active use
+	ActiveElement        *Element               `json:"_active,omitempty" bson:"active_element,omitempty"`                     // Extensions for active
+	Name                 []HumanName            `json:"name,omitempty" bson:"name,omitempty"`                                  // A name associated with the patient
+	Telecom              []ContactPoint         `json:"telecom,omitempty" bson:"telecom,omitempty"`                            // A contact detail for the individual
+	Gender               *AdministrativeGender  `json:"gender,omitempty" bson:"gender,omitempty"`                              // male | female | other | unknown
+	GenderElement        *Element               `json:"_gender,omitempty" bson:"gender_element,omitempty"`                     // Extensions for gender
+	BirthDate            *Date                  `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                       // The date of birth for the individual
+	BirthDateElement     *Element               `json:"_birthDate,omitempty" bson:"birth_date_element,omitempty"`              // Extensions for birthDate
+	Deceased             PatientDeceased        `json:"-" bson:"deceased,omitempty"`                                           // Indicates if/when the individual is deceased
+	DeceasedElement      *Element               `json:"-" bson:"deceased_element,omitempty"`                                   // Extensions for deceased[x]
+	Address              []Address              `json:"address,omitempty" bson:"address,omitempty"`                            // An address for the individual
+	MaritalStatus        *CodeableConcept       `json:"maritalStatus,omitempty" bson:"marital_status,omitempty"`               // Marital (civil) status of a patient
+	MultipleBirth        PatientMultipleBirth   `json:"-" bson:"multiple_birth,omitempty"`                                     // Whether patient is part of a multiple birth
+	MultipleBirthElement *Element               `json:"-" bson:"multiple_birth_element,omitempty"`                             // Extensions for multipleBirth[x]
+	Photo                []Attachment           `json:"photo,omitempty" bson:"photo,omitempty"`                                // Image of the patient
+	Contact              []PatientContact       `json:"contact,omitempty" bson:"contact,omitempty"`                            // A contact party (e.g. guardian, partner, friend) for the patient
+	Communication        []PatientCommunication `json:"communication,omitempty" bson:"communication,omitempty"`                // A language which may be used to communicate with the patient about his or her health
+	GeneralPractitioner  []Reference            `json:"generalPractitioner,omitempty" bson:"general_practitioner,omitempty"`   // Patient's nominated primary care provider
+	ManagingOrganization *Reference             `json:"managingOrganization,omitempty" bson:"managing_organization,omitempty"` // Organization that is the custodian of the patient record
+	Link                 []PatientLink          `json:"link,omitempty" bson:"link,omitempty"`                                  // Link to a Patient or RelatedPerson resource that concerns the same actual individual
+
+	deceasedVariants      []string // JSON properties of Patient.deceased[x] when more than one was decoded
+	multipleBirthVariants []string // JSON properties of Patient.multipleBirth[x] when more than one was decoded
 }
 
+var patientGeneralPractitionerTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
+var patientManagingOrganizationTargets = []string{"Organization"}
+
 func (r *Patient) Validate() error {
 	if r.ResourceType != "Patient" {
 		return fmt.Errorf("invalid resourceType: expected 'Patient', got '%s'", r.ResourceType)
@@ -43,16 +58,49 @@ func (r *Patient) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	for i, item := range r.Name {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Name[%d]: %w", i, err)
@@ -63,6 +111,37 @@ func (r *Patient) Validate() error {
 			return fmt.Errorf("Telecom[%d]: %w", i, err)
 		}
 	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		return fmt.Errorf("field 'Gender' has invalid code '%s'", *r.Gender)
+	}
+	if r.GenderElement != nil {
+		if err := r.GenderElement.Validate(); err != nil {
+			return fmt.Errorf("GenderElement: %w", err)
+		}
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			return fmt.Errorf("BirthDate: %w", err)
+		}
+	}
+	if r.BirthDateElement != nil {
+		if err := r.BirthDateElement.Validate(); err != nil {
+			return fmt.Errorf("BirthDateElement: %w", err)
+		}
+	}
+	if len(r.deceasedVariants) > 1 {
+		return fmt.Errorf("field 'Deceased' must have a single type, got %v", r.deceasedVariants)
+	}
+	if r.Deceased != nil {
+		if err := r.Deceased.Validate(); err != nil {
+			return fmt.Errorf("Deceased: %w", err)
+		}
+	}
+	if r.DeceasedElement != nil {
+		if err := r.DeceasedElement.Validate(); err != nil {
+			return fmt.Errorf("DeceasedElement: %w", err)
+		}
+	}
 	for i, item := range r.Address {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Address[%d]: %w", i, err)
@@ -73,6 +152,19 @@ func (r *Patient) Validate() error {
 			return fmt.Errorf("MaritalStatus: %w", err)
 		}
 	}
+	if len(r.multipleBirthVariants) > 1 {
+		return fmt.Errorf("field 'MultipleBirth' must have a single type, got %v", r.multipleBirthVariants)
+	}
+	if r.MultipleBirth != nil {
+		if err := r.MultipleBirth.Validate(); err != nil {
+			return fmt.Errorf("MultipleBirth: %w", err)
+		}
+	}
+	if r.MultipleBirthElement != nil {
+		if err := r.MultipleBirthElement.Validate(); err != nil {
+			return fmt.Errorf("MultipleBirthElement: %w", err)
+		}
+	}
 	for i, item := range r.Photo {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Photo[%d]: %w", i, err)
@@ -88,11 +180,21 @@ func (r *Patient) Validate() error {
 			return fmt.Errorf("Communication[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.GeneralPractitioner {
+		if target := invalidReferenceTarget(item.Reference, item.Type, patientGeneralPractitionerTargets); target != "" {
+			return fmt.Errorf("field 'GeneralPractitioner[%d]' cannot refer to %s, expected one of %v", i, target, patientGeneralPractitionerTargets)
+		}
+	}
 	for i, item := range r.GeneralPractitioner {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("GeneralPractitioner[%d]: %w", i, err)
 		}
 	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, patientManagingOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, patientManagingOrganizationTargets)
+		}
+	}
 	if r.ManagingOrganization != nil {
 		if err := r.ManagingOrganization.Validate(); err != nil {
 			return fmt.Errorf("ManagingOrganization: %w", err)
@@ -103,24 +205,427 @@ func (r *Patient) Validate() error {
 			return fmt.Errorf("Link[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Patient")
+}
+
+func (r *Patient) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Patient" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Patient', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		issues.add("code-invalid", path+".gender", fmt.Sprintf("field 'Gender' has invalid code '%s'", *r.Gender))
+	}
+	if r.GenderElement != nil {
+		r.GenderElement.validateAll(path+".gender", issues)
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			issues.add("value", path+".birthDate", err.Error())
+		}
+	}
+	if r.BirthDateElement != nil {
+		r.BirthDateElement.validateAll(path+".birthDate", issues)
+	}
+	if len(r.deceasedVariants) > 1 {
+		issues.add("structure", path+".deceased", fmt.Sprintf("field 'Deceased' must have a single type, got %v", r.deceasedVariants))
+	}
+	if r.Deceased != nil {
+		r.Deceased.validateAll(path+".deceased.ofType("+r.Deceased.FHIRType()+")", issues)
+	}
+	if r.DeceasedElement != nil {
+		r.DeceasedElement.validateAll(path+".deceased", issues)
+	}
+	for i, item := range r.Address {
+		item.validateAll(fmt.Sprintf("%s.address[%d]", path, i), issues)
+	}
+	if r.MaritalStatus != nil {
+		r.MaritalStatus.validateAll(path+".maritalStatus", issues)
+	}
+	if len(r.multipleBirthVariants) > 1 {
+		issues.add("structure", path+".multipleBirth", fmt.Sprintf("field 'MultipleBirth' must have a single type, got %v", r.multipleBirthVariants))
+	}
+	if r.MultipleBirth != nil {
+		r.MultipleBirth.validateAll(path+".multipleBirth.ofType("+r.MultipleBirth.FHIRType()+")", issues)
+	}
+	if r.MultipleBirthElement != nil {
+		r.MultipleBirthElement.validateAll(path+".multipleBirth", issues)
+	}
+	for i, item := range r.Photo {
+		item.validateAll(fmt.Sprintf("%s.photo[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+	for i, item := range r.GeneralPractitioner {
+		if target := invalidReferenceTarget(item.Reference, item.Type, patientGeneralPractitionerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.generalPractitioner[%d]", path, i), fmt.Sprintf("field 'GeneralPractitioner[%d]' cannot refer to %s, expected one of %v", i, target, patientGeneralPractitionerTargets))
+		}
+	}
+	for i, item := range r.GeneralPractitioner {
+		item.validateAll(fmt.Sprintf("%s.generalPractitioner[%d]", path, i), issues)
+	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, patientManagingOrganizationTargets); target != "" {
+			issues.add("value", path+".managingOrganization", fmt.Sprintf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, patientManagingOrganizationTargets))
+		}
+	}
+	if r.ManagingOrganization != nil {
+		r.ManagingOrganization.validateAll(path+".managingOrganization", issues)
+	}
+	for i, item := range r.Link {
+		item.validateAll(fmt.Sprintf("%s.link[%d]", path, i), issues)
+	}
+}
+
+func (r *Patient) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Patient", &issues)
+	checkInvariants(r, "Patient", &issues)
+	return issues
+}
+
+var patientInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Patient) invariants() []invariant {
+	return patientInvariants
+}
+
+func (r Patient) MarshalJSON() ([]byte, error) {
+	type alias Patient
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "deceased", r.Deceased, r.DeceasedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "multipleBirth", r.MultipleBirth, r.MultipleBirthElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Patient) UnmarshalJSON(data []byte) error {
+	type alias Patient
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Deceased, element, r.deceasedVariants, err = unmarshalChoice(fields, "deceased", patientDeceasedVariants); err != nil {
+		return err
+	}
+	r.DeceasedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeceasedElement); err != nil {
+			return fmt.Errorf("_deceased: %w", err)
+		}
+	}
+	if r.MultipleBirth, element, r.multipleBirthVariants, err = unmarshalChoice(fields, "multipleBirth", patientMultipleBirthVariants); err != nil {
+		return err
+	}
+	r.MultipleBirthElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.MultipleBirthElement); err != nil {
+			return fmt.Errorf("_multipleBirth: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r Patient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Patient) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Patient) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Patient) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Patient) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Deceased":
+		return "deceased", choiceValues(patientDeceasedVariants)
+	case "MultipleBirth":
+		return "multipleBirth", choiceValues(patientMultipleBirthVariants)
+	}
+	return "", nil
+}
+
+var patientElementTypes = map[string]string{
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Patient) elementTypes() map[string]string {
+	return patientElementTypes
+}
+
+var patientElementMetadata = []ElementMetadata{
+	{Path: "Patient.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Patient.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Patient.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Patient.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Patient.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Patient.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Patient.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Patient.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Patient.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Patient.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}, Summary: true},
+	{Path: "Patient.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}, Summary: true, Modifier: true},
+	{Path: "Patient.name", Name: "name", Field: 14, Min: 0, Max: "*", Types: []string{"HumanName"}, Summary: true},
+	{Path: "Patient.telecom", Name: "telecom", Field: 15, Min: 0, Max: "*", Types: []string{"ContactPoint"}, Summary: true},
+	{Path: "Patient.gender", Name: "gender", Field: 16, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Patient.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender", Description: "The gender of a person used for administrative purposes."}, Summary: true},
+	{Path: "Patient.birthDate", Name: "birthDate", Field: 18, Min: 0, Max: "1", Types: []string{"date"}, Summary: true},
+	{Path: "Patient.deceased[x]", Name: "deceased", Field: 20, Min: 0, Max: "1", Types: []string{"boolean", "dateTime"}, Summary: true, Modifier: true},
+	{Path: "Patient.address", Name: "address", Field: 22, Min: 0, Max: "*", Types: []string{"Address"}, Summary: true},
+	{Path: "Patient.maritalStatus", Name: "maritalStatus", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Patient.maritalStatus", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/marital-status", Description: "The domestic partnership status of a person."}},
+	{Path: "Patient.multipleBirth[x]", Name: "multipleBirth", Field: 24, Min: 0, Max: "1", Types: []string{"boolean", "integer"}},
+	{Path: "Patient.photo", Name: "photo", Field: 26, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "Patient.contact", Name: "contact", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Patient.communication", Name: "communication", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Patient.generalPractitioner", Name: "generalPractitioner", Field: 29, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "Patient.managingOrganization", Name: "managingOrganization", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}, Summary: true},
+	{Path: "Patient.link", Name: "link", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}, Summary: true, Modifier: true},
+}
+
+func (r *Patient) ElementMetadata() []ElementMetadata {
+	return patientElementMetadata
+}
+
+// PatientDeceased is the type of Patient.deceased[x]. It is implemented by the PatientDeceased* variant types.
+type PatientDeceased interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPatientDeceased()
+}
+
+// PatientDeceasedBoolean is the boolean variant of PatientDeceased.
+type PatientDeceasedBoolean bool
+
+func (v PatientDeceasedBoolean) Validate() error {
+	return nil
+}
+
+func (v PatientDeceasedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PatientDeceasedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PatientDeceasedBoolean) isPatientDeceased() {}
+
+// PatientDeceasedDateTime is the dateTime variant of PatientDeceased.
+type PatientDeceasedDateTime struct {
+	DateTime
+}
+
+func (v PatientDeceasedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v PatientDeceasedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v PatientDeceasedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v PatientDeceasedDateTime) isPatientDeceased() {}
+
+var patientDeceasedVariants = []PatientDeceased{
+	PatientDeceasedBoolean(false),
+	PatientDeceasedDateTime{},
+}
+
+// PatientMultipleBirth is the type of Patient.multipleBirth[x]. It is implemented by the PatientMultipleBirth* variant types.
+type PatientMultipleBirth interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPatientMultipleBirth()
+}
+
+// PatientMultipleBirthBoolean is the boolean variant of PatientMultipleBirth.
+type PatientMultipleBirthBoolean bool
+
+func (v PatientMultipleBirthBoolean) Validate() error {
 	return nil
 }
 
+func (v PatientMultipleBirthBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PatientMultipleBirthBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PatientMultipleBirthBoolean) isPatientMultipleBirth() {}
+
+// PatientMultipleBirthInteger is the integer variant of PatientMultipleBirth.
+type PatientMultipleBirthInteger int
+
+func (v PatientMultipleBirthInteger) Validate() error {
+	return nil
+}
+
+func (v PatientMultipleBirthInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PatientMultipleBirthInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v PatientMultipleBirthInteger) isPatientMultipleBirth() {}
+
+var patientMultipleBirthVariants = []PatientMultipleBirth{
+	PatientMultipleBirthBoolean(false),
+	PatientMultipleBirthInteger(0),
+}
+
+func (r *Patient) GetResourceType() string {
+	return "Patient"
+}
+
+func (r *Patient) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Patient) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Patient) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Patient) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Patient) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Patient) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Patient) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Patient)(nil)
+
 type PatientContact struct {
-	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Relationship      []CodeableConcept `json:"relationship,omitempty" bson:"relationship,omitempty"`            // The kind of personal relationship
-	Role              []CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // The kind of functional role
-	Name              *HumanName        `json:"name,omitempty" bson:"name,omitempty"`                            // A name associated with the contact person
-	AdditionalName    []HumanName       `json:"additionalName,omitempty" bson:"additional_name,omitempty"`       // Additional names for the contact person
-	Telecom           []ContactPoint    `json:"telecom,omitempty" bson:"telecom,omitempty"`                      // A contact detail for the person
-	Address           *Address          `json:"address,omitempty" bson:"address,omitempty"`                      // Address for the contact person
-	AdditionalAddress []Address         `json:"additionalAddress,omitempty" bson:"additional_address,omitempty"` // Additional addresses for the contact person
-	Gender            *string           `json:"gender,omitempty" bson:"gender,omitempty"`                        // male | female | other | unknown
-	Organization      *Reference        `json:"organization,omitempty" bson:"organization,omitempty"`            // Organization that is associated with the contact
-	Period            *Period           `json:"period,omitempty" bson:"period,omitempty"`                        // The period during which this contact person or organization is valid to be contacted relating to this patient
+	Id                *string               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Relationship      []CodeableConcept     `json:"relationship,omitempty" bson:"relationship,omitempty"`            // The kind of personal relationship
+	Role              []CodeableConcept     `json:"role,omitempty" bson:"role,omitempty"`                            // The kind of functional role
+	Name              *HumanName            `json:"name,omitempty" bson:"name,omitempty"`                            // A name associated with the contact person
+	AdditionalName    []HumanName           `json:"additionalName,omitempty" bson:"additional_name,omitempty"`       // Additional names for the contact person
+	Telecom           []ContactPoint        `json:"telecom,omitempty" bson:"telecom,omitempty"`                      // A contact detail for the person
+	Address           *Address              `json:"address,omitempty" bson:"address,omitempty"`                      // Address for the contact person
+	AdditionalAddress []Address             `json:"additionalAddress,omitempty" bson:"additional_address,omitempty"` // Additional addresses for the contact person
+	Gender            *AdministrativeGender `json:"gender,omitempty" bson:"gender,omitempty"`                        // male | female | other | unknown
+	GenderElement     *Element              `json:"_gender,omitempty" bson:"gender_element,omitempty"`               // Extensions for gender
+	Organization      *Reference            `json:"organization,omitempty" bson:"organization,omitempty"`            // Organization that is associated with the contact
+	Period            *Period               `json:"period,omitempty" bson:"period,omitempty"`                        // The period during which this contact person or organization is valid to be contacted relating to this patient
 }
 
+var patientContactOrganizationTargets = []string{"Organization"}
+
 func (r *PatientContact) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Relationship {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Relationship[%d]: %w", i, err)
@@ -156,6 +661,19 @@ func (r *PatientContact) Validate() error {
 			return fmt.Errorf("AdditionalAddress[%d]: %w", i, err)
 		}
 	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		return fmt.Errorf("field 'Gender' has invalid code '%s'", *r.Gender)
+	}
+	if r.GenderElement != nil {
+		if err := r.GenderElement.Validate(); err != nil {
+			return fmt.Errorf("GenderElement: %w", err)
+		}
+	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, patientContactOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'Organization' cannot refer to %s, expected one of %v", target, patientContactOrganizationTargets)
+		}
+	}
 	if r.Organization != nil {
 		if err := r.Organization.Validate(); err != nil {
 			return fmt.Errorf("Organization: %w", err)
@@ -169,13 +687,118 @@ func (r *PatientContact) Validate() error {
 	return nil
 }
 
+func (r *PatientContact) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Relationship {
+		item.validateAll(fmt.Sprintf("%s.relationship[%d]", path, i), issues)
+	}
+	for i, item := range r.Role {
+		item.validateAll(fmt.Sprintf("%s.role[%d]", path, i), issues)
+	}
+	if r.Name != nil {
+		r.Name.validateAll(path+".name", issues)
+	}
+	for i, item := range r.AdditionalName {
+		item.validateAll(fmt.Sprintf("%s.additionalName[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	if r.Address != nil {
+		r.Address.validateAll(path+".address", issues)
+	}
+	for i, item := range r.AdditionalAddress {
+		item.validateAll(fmt.Sprintf("%s.additionalAddress[%d]", path, i), issues)
+	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		issues.add("code-invalid", path+".gender", fmt.Sprintf("field 'Gender' has invalid code '%s'", *r.Gender))
+	}
+	if r.GenderElement != nil {
+		r.GenderElement.validateAll(path+".gender", issues)
+	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, patientContactOrganizationTargets); target != "" {
+			issues.add("value", path+".organization", fmt.Sprintf("field 'Organization' cannot refer to %s, expected one of %v", target, patientContactOrganizationTargets))
+		}
+	}
+	if r.Organization != nil {
+		r.Organization.validateAll(path+".organization", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var patientContactInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "pat-1", severity: "error", human: "SHALL at least contain a contact's details or a reference to an organization", expression: "name.exists() or telecom.exists() or address.exists() or organization.exists()"},
+}
+
+func (r *PatientContact) invariants() []invariant {
+	return patientContactInvariants
+}
+
+func (r PatientContact) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PatientContact) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PatientContact) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PatientContact) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var patientContactElementMetadata = []ElementMetadata{
+	{Path: "Patient.contact.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Patient.contact.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Patient.contact.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Patient.contact.relationship", Name: "relationship", Field: 3, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Patient.contact.relationship", Strength: "preferred", ValueSet: "http://terminology.hl7.org/ValueSet/v3-PersonalRelationshipRoleType", Description: "The nature of the personal relationship between the patient and the contact person."}},
+	{Path: "Patient.contact.role", Name: "role", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Patient.contact.role", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/relatedperson-relationshiptype", Description: "The nature of the personal relationship between the patient and the contact person."}},
+	{Path: "Patient.contact.name", Name: "name", Field: 5, Min: 0, Max: "1", Types: []string{"HumanName"}},
+	{Path: "Patient.contact.additionalName", Name: "additionalName", Field: 6, Min: 0, Max: "*", Types: []string{"HumanName"}},
+	{Path: "Patient.contact.telecom", Name: "telecom", Field: 7, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "Patient.contact.address", Name: "address", Field: 8, Min: 0, Max: "1", Types: []string{"Address"}},
+	{Path: "Patient.contact.additionalAddress", Name: "additionalAddress", Field: 9, Min: 0, Max: "*", Types: []string{"Address"}},
+	{Path: "Patient.contact.gender", Name: "gender", Field: 10, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Patient.contact.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender", Description: "The gender of a person used for administrative purposes."}},
+	{Path: "Patient.contact.organization", Name: "organization", Field: 12, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Patient.contact.period", Name: "period", Field: 13, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *PatientContact) ElementMetadata() []ElementMetadata {
+	return patientContactElementMetadata
+}
+
 type PatientCommunication struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Language  *CodeableConcept `json:"language" bson:"language"`                       // The language which can be used to communicate with the patient about his or her health
-	Preferred *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"` // Language preference indicator
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Language          *CodeableConcept `json:"language" bson:"language"`                                        // The language which can be used to communicate with the patient about his or her health
+	Preferred         *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"`                  // Language preference indicator
+	PreferredElement  *Element         `json:"_preferred,omitempty" bson:"preferred_element,omitempty"`         // Extensions for preferred
 }
 
 func (r *PatientCommunication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Language == nil {
 		return fmt.Errorf("field 'Language' is required")
 	}
@@ -184,27 +807,178 @@ func (r *PatientCommunication) Validate() error {
 			return fmt.Errorf("Language: %w", err)
 		}
 	}
+	if r.PreferredElement != nil {
+		if err := r.PreferredElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *PatientCommunication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Language == nil {
+		issues.add("required", path+".language", "field 'Language' is required")
+	}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
+	}
+	if r.PreferredElement != nil {
+		r.PreferredElement.validateAll(path+".preferred", issues)
+	}
+}
+
+var patientCommunicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PatientCommunication) invariants() []invariant {
+	return patientCommunicationInvariants
+}
+
+func (r PatientCommunication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PatientCommunication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PatientCommunication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PatientCommunication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var patientCommunicationElementMetadata = []ElementMetadata{
+	{Path: "Patient.communication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Patient.communication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Patient.communication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Patient.communication.language", Name: "language", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Patient.communication.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Patient.communication.preferred", Name: "preferred", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *PatientCommunication) ElementMetadata() []ElementMetadata {
+	return patientCommunicationElementMetadata
+}
+
 type PatientLink struct {
-	Id    *string    `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Other *Reference `json:"other" bson:"other"`               // The other patient or related person resource that the link refers to
-	Type  string     `json:"type" bson:"type"`                 // replaced-by | replaces | refer | seealso
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Other             *Reference  `json:"other" bson:"other"`                                              // The other patient or related person resource that the link refers to
+	Type              LinkType    `json:"type" bson:"type"`                                                // replaced-by | replaces | refer | seealso
+	TypeElement       *Element    `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
 }
 
+var patientLinkOtherTargets = []string{"Patient", "RelatedPerson"}
+
 func (r *PatientLink) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Other == nil {
 		return fmt.Errorf("field 'Other' is required")
 	}
+	if r.Other != nil {
+		if target := invalidReferenceTarget(r.Other.Reference, r.Other.Type, patientLinkOtherTargets); target != "" {
+			return fmt.Errorf("field 'Other' cannot refer to %s, expected one of %v", target, patientLinkOtherTargets)
+		}
+	}
 	if r.Other != nil {
 		if err := r.Other.Validate(); err != nil {
 			return fmt.Errorf("Other: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Type == emptyString {
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *PatientLink) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Other == nil {
+		issues.add("required", path+".other", "field 'Other' is required")
+	}
+	if r.Other != nil {
+		if target := invalidReferenceTarget(r.Other.Reference, r.Other.Type, patientLinkOtherTargets); target != "" {
+			issues.add("value", path+".other", fmt.Sprintf("field 'Other' cannot refer to %s, expected one of %v", target, patientLinkOtherTargets))
+		}
+	}
+	if r.Other != nil {
+		r.Other.validateAll(path+".other", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+}
+
+var patientLinkInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PatientLink) invariants() []invariant {
+	return patientLinkInvariants
+}
+
+func (r PatientLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PatientLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PatientLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PatientLink) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var patientLinkElementMetadata = []ElementMetadata{
+	{Path: "Patient.link.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Patient.link.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Patient.link.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Patient.link.other", Name: "other", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}, Summary: true},
+	{Path: "Patient.link.type", Name: "type", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Patient.link.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/link-type", Description: "The type of link between this patient resource and another Patient resource, or Patient/RelatedPerson when using the `seealso` code"}, Summary: true},
+}
+
+func (r *PatientLink) ElementMetadata() []ElementMetadata {
+	return patientLinkElementMetadata
+}
@@ -2,50 +2,73 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource provides the details including amount of a payment and allocates the payment items being paid.
 type PaymentReconciliation struct {
-	ResourceType      string                             `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string                            `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string                            `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage                  `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Identifier        []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Business Identifier for a payment reconciliation
-	Type              *CodeableConcept                   `json:"type" bson:"type"`                                                // Category of payment
-	Status            string                             `json:"status" bson:"status"`                                            // active | cancelled | draft | entered-in-error
-	StatusReason      *string                            `json:"statusReason,omitempty" bson:"status_reason,omitempty"`           // Reason for status change
-	Kind              *CodeableConcept                   `json:"kind,omitempty" bson:"kind,omitempty"`                            // Workflow originating payment
-	Period            *Period                            `json:"period,omitempty" bson:"period,omitempty"`                        // Period covered
-	Created           string                             `json:"created" bson:"created"`                                          // Creation date
-	Enterer           *Reference                         `json:"enterer,omitempty" bson:"enterer,omitempty"`                      // Who entered the payment
-	IssuerType        *CodeableConcept                   `json:"issuerType,omitempty" bson:"issuer_type,omitempty"`               // Nature of the source
-	PaymentIssuer     *Reference                         `json:"paymentIssuer,omitempty" bson:"payment_issuer,omitempty"`         // Party generating payment
-	Request           *Reference                         `json:"request,omitempty" bson:"request,omitempty"`                      // Reference to requesting resource
-	Requestor         *Reference                         `json:"requestor,omitempty" bson:"requestor,omitempty"`                  // Responsible practitioner
-	Outcome           *string                            `json:"outcome,omitempty" bson:"outcome,omitempty"`                      // queued | complete | error | partial
-	Disposition       *string                            `json:"disposition,omitempty" bson:"disposition,omitempty"`              // Disposition message
-	Date              string                             `json:"date" bson:"date"`                                                // When payment issued
-	Location          *Reference                         `json:"location,omitempty" bson:"location,omitempty"`                    // Where payment collected
-	Method            *CodeableConcept                   `json:"method,omitempty" bson:"method,omitempty"`                        // Payment instrument
-	CardBrand         *string                            `json:"cardBrand,omitempty" bson:"card_brand,omitempty"`                 // Type of card
-	AccountNumber     *string                            `json:"accountNumber,omitempty" bson:"account_number,omitempty"`         // Digits for verification
-	ExpirationDate    *string                            `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`       // Expiration year-month
-	Processor         *string                            `json:"processor,omitempty" bson:"processor,omitempty"`                  // Processor name
-	ReferenceNumber   *string                            `json:"referenceNumber,omitempty" bson:"reference_number,omitempty"`     // Check number or payment reference
-	Authorization     *string                            `json:"authorization,omitempty" bson:"authorization,omitempty"`          // Authorization number
-	TenderedAmount    *Money                             `json:"tenderedAmount,omitempty" bson:"tendered_amount,omitempty"`       // Amount offered by the issuer
-	ReturnedAmount    *Money                             `json:"returnedAmount,omitempty" bson:"returned_amount,omitempty"`       // Amount returned by the receiver
-	Amount            *Money                             `json:"amount,omitempty" bson:"amount,omitempty"`                        // Total amount of Payment
-	PaymentIdentifier *Identifier                        `json:"paymentIdentifier,omitempty" bson:"payment_identifier,omitempty"` // Business identifier for the payment
-	Allocation        []PaymentReconciliationAllocation  `json:"allocation,omitempty" bson:"allocation,omitempty"`                // Settlement particulars
-	FormCode          *CodeableConcept                   `json:"formCode,omitempty" bson:"form_code,omitempty"`                   // Printed form identifier
-	ProcessNote       []PaymentReconciliationProcessNote `json:"processNote,omitempty" bson:"process_note,omitempty"`             // Note concerning processing
+	ResourceType           string                             `json:"resourceType" bson:"resource_type"`                                    // Type of resource
+	Id                     *string                            `json:"id,omitempty" bson:"id,omitempty"`                                     // Logical id of this artifact
+	Meta                   *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                                 // Metadata about the resource
+	ImplicitRules          *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`              // A set of rules under which this content was created
+	ImplicitRulesElement   *Element                           `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`     // Extensions for implicitRules
+	Language               *string                            `json:"language,omitempty" bson:"language,omitempty"`                         // Language of the resource content
+	LanguageElement        *Element                           `json:"_language,omitempty" bson:"language_element,omitempty"`                // Extensions for language
+	Text                   *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                                 // Text summary of the resource, for human interpretation
+	Contained              []Resource                         `json:"contained,omitempty" bson:"contained,omitempty"`                       // Contained, inline Resources
+	Extension              []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored
+	Identifier             []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Business Identifier for a payment reconciliation
+	Type                   *CodeableConcept                   `json:"type" bson:"type"`                                                     // Category of payment
+	Status                 ExplanationOfBenefitStatus         `json:"status" bson:"status"`                                                 // active | cancelled | draft | entered-in-error
+	StatusElement          *Element                           `json:"_status,omitempty" bson:"status_element,omitempty"`                    // Extensions for status
+	StatusReason           *string                            `json:"statusReason,omitempty" bson:"status_reason,omitempty"`                // Reason for status change
+	StatusReasonElement    *Element                           `json:"_statusReason,omitempty" bson:"status_reason_element,omitempty"`       // Extensions for statusReason
+	Kind                   *CodeableConcept                   `json:"kind,omitempty" bson:"kind,omitempty"`                                 // Workflow originating payment
+	Period                 *Period                            `json:"period,omitempty" bson:"period,omitempty"`                             // Period covered
+	Created                *DateTime                          `json:"created" bson:"created"`                                               // Creation date
+	CreatedElement         *Element                           `json:"_created,omitempty" bson:"created_element,omitempty"`                  // Extensions for created
+	Enterer                *Reference                         `json:"enterer,omitempty" bson:"enterer,omitempty"`                           // Who entered the payment
+	IssuerType             *CodeableConcept                   `json:"issuerType,omitempty" bson:"issuer_type,omitempty"`                    // Nature of the source
+	PaymentIssuer          *Reference                         `json:"paymentIssuer,omitempty" bson:"payment_issuer,omitempty"`              // Party generating payment
+	Request                *Reference                         `json:"request,omitempty" bson:"request,omitempty"`                           // Reference to requesting resource
+	Requestor              *Reference                         `json:"requestor,omitempty" bson:"requestor,omitempty"`                       // Responsible practitioner
+	Outcome                *PaymentOutcome                    `json:"outcome,omitempty" bson:"outcome,omitempty"`                           // queued | complete | error | partial
+	OutcomeElement         *Element                           `json:"_outcome,omitempty" bson:"outcome_element,omitempty"`                  // Extensions for outcome
+	Disposition            *string                            `json:"disposition,omitempty" bson:"disposition,omitempty"`                   // Disposition message
+	DispositionElement     *Element                           `json:"_disposition,omitempty" bson:"disposition_element,omitempty"`          // Extensions for disposition
+	Date                   *Date                              `json:"date" bson:"date"`                                                     // When payment issued
+	DateElement            *Element                           `json:"_date,omitempty" bson:"date_element,omitempty"`                        // Extensions for date
+	Location               *Reference                         `json:"location,omitempty" bson:"location,omitempty"`                         // Where payment collected
+	Method                 *CodeableConcept                   `json:"method,omitempty" bson:"method,omitempty"`                             // Payment instrument
+	CardBrand              *string                            `json:"cardBrand,omitempty" bson:"card_brand,omitempty"`                      // Type of card
+	CardBrandElement       *Element                           `json:"_cardBrand,omitempty" bson:"card_brand_element,omitempty"`             // Extensions for cardBrand
+	AccountNumber          *string                            `json:"accountNumber,omitempty" bson:"account_number,omitempty"`              // Digits for verification
+	AccountNumberElement   *Element                           `json:"_accountNumber,omitempty" bson:"account_number_element,omitempty"`     // Extensions for accountNumber
+	ExpirationDate         *Date                              `json:"expirationDate,omitempty" bson:"expiration_date,omitempty"`            // Expiration year-month
+	ExpirationDateElement  *Element                           `json:"_expirationDate,omitempty" bson:"expiration_date_element,omitempty"`   // Extensions for expirationDate
+	Processor              *string                            `json:"processor,omitempty" bson:"processor,omitempty"`                       // Processor name
+	ProcessorElement       *Element                           `json:"_processor,omitempty" bson:"processor_element,omitempty"`              // Extensions for processor
+	ReferenceNumber        *string                            `json:"referenceNumber,omitempty" bson:"reference_number,omitempty"`          // Check number or payment reference
+	ReferenceNumberElement *Element                           `json:"_referenceNumber,omitempty" bson:"reference_number_element,omitempty"` // Extensions for referenceNumber
+	Authorization          *string                            `json:"authorization,omitempty" bson:"authorization,omitempty"`               // Authorization number
+	AuthorizationElement   *Element                           `json:"_authorization,omitempty" bson:"authorization_element,omitempty"`      // Extensions for authorization
+	TenderedAmount         *Money                             `json:"tenderedAmount,omitempty" bson:"tendered_amount,omitempty"`            // Amount offered by the issuer
+	ReturnedAmount         *Money                             `json:"returnedAmount,omitempty" bson:"returned_amount,omitempty"`            // Amount returned by the receiver
+	Amount                 *Money                             `json:"amount,omitempty" bson:"amount,omitempty"`                             // Total amount of Payment
+	PaymentIdentifier      *Identifier                        `json:"paymentIdentifier,omitempty" bson:"payment_identifier,omitempty"`      // Business identifier for the payment
+	Allocation             []PaymentReconciliationAllocation  `json:"allocation,omitempty" bson:"allocation,omitempty"`                     // Settlement particulars
+	FormCode               *CodeableConcept                   `json:"formCode,omitempty" bson:"form_code,omitempty"`                        // Printed form identifier
+	ProcessNote            []PaymentReconciliationProcessNote `json:"processNote,omitempty" bson:"process_note,omitempty"`                  // Note concerning processing
 }
 
+var paymentReconciliationPaymentIssuerTargets = []string{"Organization", "Patient", "RelatedPerson"}
+
+var paymentReconciliationRequestTargets = []string{"Task"}
+
+var paymentReconciliationRequestorTargets = []string{"Organization", "Practitioner", "PractitionerRole"}
+
 func (r *PaymentReconciliation) Validate() error {
 	if r.ResourceType != "PaymentReconciliation" {
 		return fmt.Errorf("invalid resourceType: expected 'PaymentReconciliation', got '%s'", r.ResourceType)
@@ -55,11 +78,39 @@ func (r *PaymentReconciliation) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -73,10 +124,22 @@ func (r *PaymentReconciliation) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.StatusReasonElement != nil {
+		if err := r.StatusReasonElement.Validate(); err != nil {
+			return fmt.Errorf("StatusReasonElement: %w", err)
+		}
+	}
 	if r.Kind != nil {
 		if err := r.Kind.Validate(); err != nil {
 			return fmt.Errorf("Kind: %w", err)
@@ -87,9 +150,19 @@ func (r *PaymentReconciliation) Validate() error {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
-	if r.Created == emptyString {
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
 	if r.Enterer != nil {
 		if err := r.Enterer.Validate(); err != nil {
 			return fmt.Errorf("Enterer: %w", err)
@@ -100,24 +173,62 @@ func (r *PaymentReconciliation) Validate() error {
 			return fmt.Errorf("IssuerType: %w", err)
 		}
 	}
+	if r.PaymentIssuer != nil {
+		if target := invalidReferenceTarget(r.PaymentIssuer.Reference, r.PaymentIssuer.Type, paymentReconciliationPaymentIssuerTargets); target != "" {
+			return fmt.Errorf("field 'PaymentIssuer' cannot refer to %s, expected one of %v", target, paymentReconciliationPaymentIssuerTargets)
+		}
+	}
 	if r.PaymentIssuer != nil {
 		if err := r.PaymentIssuer.Validate(); err != nil {
 			return fmt.Errorf("PaymentIssuer: %w", err)
 		}
 	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, paymentReconciliationRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request' cannot refer to %s, expected one of %v", target, paymentReconciliationRequestTargets)
+		}
+	}
 	if r.Request != nil {
 		if err := r.Request.Validate(); err != nil {
 			return fmt.Errorf("Request: %w", err)
 		}
 	}
+	if r.Requestor != nil {
+		if target := invalidReferenceTarget(r.Requestor.Reference, r.Requestor.Type, paymentReconciliationRequestorTargets); target != "" {
+			return fmt.Errorf("field 'Requestor' cannot refer to %s, expected one of %v", target, paymentReconciliationRequestorTargets)
+		}
+	}
 	if r.Requestor != nil {
 		if err := r.Requestor.Validate(); err != nil {
 			return fmt.Errorf("Requestor: %w", err)
 		}
 	}
-	if r.Date == emptyString {
+	if r.Outcome != nil && !r.Outcome.IsValid() {
+		return fmt.Errorf("field 'Outcome' has invalid code '%s'", *r.Outcome)
+	}
+	if r.OutcomeElement != nil {
+		if err := r.OutcomeElement.Validate(); err != nil {
+			return fmt.Errorf("OutcomeElement: %w", err)
+		}
+	}
+	if r.DispositionElement != nil {
+		if err := r.DispositionElement.Validate(); err != nil {
+			return fmt.Errorf("DispositionElement: %w", err)
+		}
+	}
+	if r.Date == nil {
 		return fmt.Errorf("field 'Date' is required")
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
@@ -128,6 +239,41 @@ func (r *PaymentReconciliation) Validate() error {
 			return fmt.Errorf("Method: %w", err)
 		}
 	}
+	if r.CardBrandElement != nil {
+		if err := r.CardBrandElement.Validate(); err != nil {
+			return fmt.Errorf("CardBrandElement: %w", err)
+		}
+	}
+	if r.AccountNumberElement != nil {
+		if err := r.AccountNumberElement.Validate(); err != nil {
+			return fmt.Errorf("AccountNumberElement: %w", err)
+		}
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDate: %w", err)
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		if err := r.ExpirationDateElement.Validate(); err != nil {
+			return fmt.Errorf("ExpirationDateElement: %w", err)
+		}
+	}
+	if r.ProcessorElement != nil {
+		if err := r.ProcessorElement.Validate(); err != nil {
+			return fmt.Errorf("ProcessorElement: %w", err)
+		}
+	}
+	if r.ReferenceNumberElement != nil {
+		if err := r.ReferenceNumberElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceNumberElement: %w", err)
+		}
+	}
+	if r.AuthorizationElement != nil {
+		if err := r.AuthorizationElement.Validate(); err != nil {
+			return fmt.Errorf("AuthorizationElement: %w", err)
+		}
+	}
 	if r.TenderedAmount != nil {
 		if err := r.TenderedAmount.Validate(); err != nil {
 			return fmt.Errorf("TenderedAmount: %w", err)
@@ -163,30 +309,368 @@ func (r *PaymentReconciliation) Validate() error {
 			return fmt.Errorf("ProcessNote[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "PaymentReconciliation")
+}
+
+func (r *PaymentReconciliation) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "PaymentReconciliation" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'PaymentReconciliation', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReasonElement != nil {
+		r.StatusReasonElement.validateAll(path+".statusReason", issues)
+	}
+	if r.Kind != nil {
+		r.Kind.validateAll(path+".kind", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Enterer != nil {
+		r.Enterer.validateAll(path+".enterer", issues)
+	}
+	if r.IssuerType != nil {
+		r.IssuerType.validateAll(path+".issuerType", issues)
+	}
+	if r.PaymentIssuer != nil {
+		if target := invalidReferenceTarget(r.PaymentIssuer.Reference, r.PaymentIssuer.Type, paymentReconciliationPaymentIssuerTargets); target != "" {
+			issues.add("value", path+".paymentIssuer", fmt.Sprintf("field 'PaymentIssuer' cannot refer to %s, expected one of %v", target, paymentReconciliationPaymentIssuerTargets))
+		}
+	}
+	if r.PaymentIssuer != nil {
+		r.PaymentIssuer.validateAll(path+".paymentIssuer", issues)
+	}
+	if r.Request != nil {
+		if target := invalidReferenceTarget(r.Request.Reference, r.Request.Type, paymentReconciliationRequestTargets); target != "" {
+			issues.add("value", path+".request", fmt.Sprintf("field 'Request' cannot refer to %s, expected one of %v", target, paymentReconciliationRequestTargets))
+		}
+	}
+	if r.Request != nil {
+		r.Request.validateAll(path+".request", issues)
+	}
+	if r.Requestor != nil {
+		if target := invalidReferenceTarget(r.Requestor.Reference, r.Requestor.Type, paymentReconciliationRequestorTargets); target != "" {
+			issues.add("value", path+".requestor", fmt.Sprintf("field 'Requestor' cannot refer to %s, expected one of %v", target, paymentReconciliationRequestorTargets))
+		}
+	}
+	if r.Requestor != nil {
+		r.Requestor.validateAll(path+".requestor", issues)
+	}
+	if r.Outcome != nil && !r.Outcome.IsValid() {
+		issues.add("code-invalid", path+".outcome", fmt.Sprintf("field 'Outcome' has invalid code '%s'", *r.Outcome))
+	}
+	if r.OutcomeElement != nil {
+		r.OutcomeElement.validateAll(path+".outcome", issues)
+	}
+	if r.DispositionElement != nil {
+		r.DispositionElement.validateAll(path+".disposition", issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if r.CardBrandElement != nil {
+		r.CardBrandElement.validateAll(path+".cardBrand", issues)
+	}
+	if r.AccountNumberElement != nil {
+		r.AccountNumberElement.validateAll(path+".accountNumber", issues)
+	}
+	if r.ExpirationDate != nil {
+		if err := r.ExpirationDate.Validate(); err != nil {
+			issues.add("value", path+".expirationDate", err.Error())
+		}
+	}
+	if r.ExpirationDateElement != nil {
+		r.ExpirationDateElement.validateAll(path+".expirationDate", issues)
+	}
+	if r.ProcessorElement != nil {
+		r.ProcessorElement.validateAll(path+".processor", issues)
+	}
+	if r.ReferenceNumberElement != nil {
+		r.ReferenceNumberElement.validateAll(path+".referenceNumber", issues)
+	}
+	if r.AuthorizationElement != nil {
+		r.AuthorizationElement.validateAll(path+".authorization", issues)
+	}
+	if r.TenderedAmount != nil {
+		r.TenderedAmount.validateAll(path+".tenderedAmount", issues)
+	}
+	if r.ReturnedAmount != nil {
+		r.ReturnedAmount.validateAll(path+".returnedAmount", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	if r.PaymentIdentifier != nil {
+		r.PaymentIdentifier.validateAll(path+".paymentIdentifier", issues)
+	}
+	for i, item := range r.Allocation {
+		item.validateAll(fmt.Sprintf("%s.allocation[%d]", path, i), issues)
+	}
+	if r.FormCode != nil {
+		r.FormCode.validateAll(path+".formCode", issues)
+	}
+	for i, item := range r.ProcessNote {
+		item.validateAll(fmt.Sprintf("%s.processNote[%d]", path, i), issues)
+	}
+}
+
+func (r *PaymentReconciliation) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("PaymentReconciliation", &issues)
+	checkInvariants(r, "PaymentReconciliation", &issues)
+	return issues
+}
+
+var paymentReconciliationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *PaymentReconciliation) invariants() []invariant {
+	return paymentReconciliationInvariants
+}
+
+func (r *PaymentReconciliation) UnmarshalJSON(data []byte) error {
+	type alias PaymentReconciliation
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r PaymentReconciliation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PaymentReconciliation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PaymentReconciliation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PaymentReconciliation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var paymentReconciliationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *PaymentReconciliation) elementTypes() map[string]string {
+	return paymentReconciliationElementTypes
+}
+
+var paymentReconciliationElementMetadata = []ElementMetadata{
+	{Path: "PaymentReconciliation.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "PaymentReconciliation.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "PaymentReconciliation.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "PaymentReconciliation.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PaymentReconciliation.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "PaymentReconciliation.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "PaymentReconciliation.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "PaymentReconciliation.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PaymentReconciliation.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PaymentReconciliation.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "PaymentReconciliation.type", Name: "type", Field: 12, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.status", Name: "status", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PaymentReconciliation.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "PaymentReconciliation.statusReason", Name: "statusReason", Field: 15, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.kind", Name: "kind", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.period", Name: "period", Field: 18, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "PaymentReconciliation.created", Name: "created", Field: 19, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "PaymentReconciliation.enterer", Name: "enterer", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.issuerType", Name: "issuerType", Field: 22, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.paymentIssuer", Name: "paymentIssuer", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "PaymentReconciliation.request", Name: "request", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Task"}},
+	{Path: "PaymentReconciliation.requestor", Name: "requestor", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "PaymentReconciliation.outcome", Name: "outcome", Field: 26, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PaymentReconciliation.outcome", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/payment-outcome"}},
+	{Path: "PaymentReconciliation.disposition", Name: "disposition", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.date", Name: "date", Field: 30, Min: 1, Max: "1", Types: []string{"date"}},
+	{Path: "PaymentReconciliation.location", Name: "location", Field: 32, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.method", Name: "method", Field: 33, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.cardBrand", Name: "cardBrand", Field: 34, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.accountNumber", Name: "accountNumber", Field: 36, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.expirationDate", Name: "expirationDate", Field: 38, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "PaymentReconciliation.processor", Name: "processor", Field: 40, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.referenceNumber", Name: "referenceNumber", Field: 42, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.authorization", Name: "authorization", Field: 44, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.tenderedAmount", Name: "tenderedAmount", Field: 46, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "PaymentReconciliation.returnedAmount", Name: "returnedAmount", Field: 47, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "PaymentReconciliation.amount", Name: "amount", Field: 48, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "PaymentReconciliation.paymentIdentifier", Name: "paymentIdentifier", Field: 49, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "PaymentReconciliation.allocation", Name: "allocation", Field: 50, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PaymentReconciliation.formCode", Name: "formCode", Field: 51, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.processNote", Name: "processNote", Field: 52, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *PaymentReconciliation) ElementMetadata() []ElementMetadata {
+	return paymentReconciliationElementMetadata
+}
+
+func (r *PaymentReconciliation) GetResourceType() string {
+	return "PaymentReconciliation"
+}
+
+func (r *PaymentReconciliation) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *PaymentReconciliation) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *PaymentReconciliation) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *PaymentReconciliation) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *PaymentReconciliation) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *PaymentReconciliation) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *PaymentReconciliation) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*PaymentReconciliation)(nil)
+
 type PaymentReconciliationAllocation struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                          // Unique id for inter-element referencing
-	Identifier            *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`                          // Business identifier of the payment detail
-	Predecessor           *Identifier      `json:"predecessor,omitempty" bson:"predecessor,omitempty"`                        // Business identifier of the prior payment detail
-	Target                *Reference       `json:"target,omitempty" bson:"target,omitempty"`                                  // Subject of the payment
-	TargetItemString      *string          `json:"targetItemString,omitempty" bson:"target_item_string,omitempty"`            // Sub-element of the subject
-	TargetItemIdentifier  *Identifier      `json:"targetItemIdentifier,omitempty" bson:"target_item_identifier,omitempty"`    // Sub-element of the subject
-	TargetItemPositiveInt *int             `json:"targetItemPositiveInt,omitempty" bson:"target_item_positive_int,omitempty"` // Sub-element of the subject
-	Encounter             *Reference       `json:"encounter,omitempty" bson:"encounter,omitempty"`                            // Applied-to encounter
-	Account               *Reference       `json:"account,omitempty" bson:"account,omitempty"`                                // Applied-to account
-	Type                  *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                                      // Category of payment
-	Submitter             *Reference       `json:"submitter,omitempty" bson:"submitter,omitempty"`                            // Submitter of the request
-	Response              *Reference       `json:"response,omitempty" bson:"response,omitempty"`                              // Response committing to a payment
-	Date                  *string          `json:"date,omitempty" bson:"date,omitempty"`                                      // Date of commitment to pay
-	Responsible           *Reference       `json:"responsible,omitempty" bson:"responsible,omitempty"`                        // Contact for the response
-	Payee                 *Reference       `json:"payee,omitempty" bson:"payee,omitempty"`                                    // Recipient of the payment
-	Amount                *Money           `json:"amount,omitempty" bson:"amount,omitempty"`                                  // Amount allocated to this payable
-	NoteNumber            []int            `json:"noteNumber,omitempty" bson:"note_number,omitempty"`                         // Applicable note numbers
+	Id                *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        *Identifier                               `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Business identifier of the payment detail
+	Predecessor       *Identifier                               `json:"predecessor,omitempty" bson:"predecessor,omitempty"`              // Business identifier of the prior payment detail
+	Target            *Reference                                `json:"target,omitempty" bson:"target,omitempty"`                        // Subject of the payment
+	TargetItem        PaymentReconciliationAllocationTargetItem `json:"-" bson:"target_item,omitempty"`                                  // Sub-element of the subject
+	TargetItemElement *Element                                  `json:"-" bson:"target_item_element,omitempty"`                          // Extensions for targetItem[x]
+	Encounter         *Reference                                `json:"encounter,omitempty" bson:"encounter,omitempty"`                  // Applied-to encounter
+	Account           *Reference                                `json:"account,omitempty" bson:"account,omitempty"`                      // Applied-to account
+	Type              *CodeableConcept                          `json:"type,omitempty" bson:"type,omitempty"`                            // Category of payment
+	Submitter         *Reference                                `json:"submitter,omitempty" bson:"submitter,omitempty"`                  // Submitter of the request
+	Response          *Reference                                `json:"response,omitempty" bson:"response,omitempty"`                    // Response committing to a payment
+	Date              *Date                                     `json:"date,omitempty" bson:"date,omitempty"`                            // Date of commitment to pay
+	DateElement       *Element                                  `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+	Responsible       *Reference                                `json:"responsible,omitempty" bson:"responsible,omitempty"`              // Contact for the response
+	Payee             *Reference                                `json:"payee,omitempty" bson:"payee,omitempty"`                          // Recipient of the payment
+	Amount            *Money                                    `json:"amount,omitempty" bson:"amount,omitempty"`                        // Amount allocated to this payable
+	NoteNumber        []int                                     `json:"noteNumber,omitempty" bson:"note_number,omitempty"`               // Applicable note numbers
+	NoteNumberElement []*Element                                `json:"_noteNumber,omitempty" bson:"note_number_element,omitempty"`      // Extensions for noteNumber
+
+	targetItemVariants []string // JSON properties of PaymentReconciliation.allocation.targetItem[x] when more than one was decoded
 }
 
+var paymentReconciliationAllocationEncounterTargets = []string{"Encounter"}
+
+var paymentReconciliationAllocationAccountTargets = []string{"Account"}
+
 func (r *PaymentReconciliationAllocation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
@@ -202,9 +686,22 @@ func (r *PaymentReconciliationAllocation) Validate() error {
 			return fmt.Errorf("Target: %w", err)
 		}
 	}
-	if r.TargetItemIdentifier != nil {
-		if err := r.TargetItemIdentifier.Validate(); err != nil {
-			return fmt.Errorf("TargetItemIdentifier: %w", err)
+	if len(r.targetItemVariants) > 1 {
+		return fmt.Errorf("field 'TargetItem' must have a single type, got %v", r.targetItemVariants)
+	}
+	if r.TargetItem != nil {
+		if err := r.TargetItem.Validate(); err != nil {
+			return fmt.Errorf("TargetItem: %w", err)
+		}
+	}
+	if r.TargetItemElement != nil {
+		if err := r.TargetItemElement.Validate(); err != nil {
+			return fmt.Errorf("TargetItemElement: %w", err)
+		}
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, paymentReconciliationAllocationEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, paymentReconciliationAllocationEncounterTargets)
 		}
 	}
 	if r.Encounter != nil {
@@ -212,6 +709,11 @@ func (r *PaymentReconciliationAllocation) Validate() error {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.Account != nil {
+		if target := invalidReferenceTarget(r.Account.Reference, r.Account.Type, paymentReconciliationAllocationAccountTargets); target != "" {
+			return fmt.Errorf("field 'Account' cannot refer to %s, expected one of %v", target, paymentReconciliationAllocationAccountTargets)
+		}
+	}
 	if r.Account != nil {
 		if err := r.Account.Validate(); err != nil {
 			return fmt.Errorf("Account: %w", err)
@@ -232,6 +734,16 @@ func (r *PaymentReconciliationAllocation) Validate() error {
 			return fmt.Errorf("Response: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	if r.Responsible != nil {
 		if err := r.Responsible.Validate(); err != nil {
 			return fmt.Errorf("Responsible: %w", err)
@@ -247,22 +759,389 @@ func (r *PaymentReconciliationAllocation) Validate() error {
 			return fmt.Errorf("Amount: %w", err)
 		}
 	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("NoteNumberElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *PaymentReconciliationAllocation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Predecessor != nil {
+		r.Predecessor.validateAll(path+".predecessor", issues)
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target", issues)
+	}
+	if len(r.targetItemVariants) > 1 {
+		issues.add("structure", path+".targetItem", fmt.Sprintf("field 'TargetItem' must have a single type, got %v", r.targetItemVariants))
+	}
+	if r.TargetItem != nil {
+		r.TargetItem.validateAll(path+".targetItem.ofType("+r.TargetItem.FHIRType()+")", issues)
+	}
+	if r.TargetItemElement != nil {
+		r.TargetItemElement.validateAll(path+".targetItem", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, paymentReconciliationAllocationEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, paymentReconciliationAllocationEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Account != nil {
+		if target := invalidReferenceTarget(r.Account.Reference, r.Account.Type, paymentReconciliationAllocationAccountTargets); target != "" {
+			issues.add("value", path+".account", fmt.Sprintf("field 'Account' cannot refer to %s, expected one of %v", target, paymentReconciliationAllocationAccountTargets))
+		}
+	}
+	if r.Account != nil {
+		r.Account.validateAll(path+".account", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Submitter != nil {
+		r.Submitter.validateAll(path+".submitter", issues)
+	}
+	if r.Response != nil {
+		r.Response.validateAll(path+".response", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Responsible != nil {
+		r.Responsible.validateAll(path+".responsible", issues)
+	}
+	if r.Payee != nil {
+		r.Payee.validateAll(path+".payee", issues)
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+	for i, item := range r.NoteNumberElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.noteNumber[%d]", path, i), issues)
+	}
+}
+
+var paymentReconciliationAllocationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PaymentReconciliationAllocation) invariants() []invariant {
+	return paymentReconciliationAllocationInvariants
+}
+
+func (r PaymentReconciliationAllocation) MarshalJSON() ([]byte, error) {
+	type alias PaymentReconciliationAllocation
+	out := struct {
+		alias
+		NoteNumber        []*int     `json:"noteNumber,omitempty"`
+		NoteNumberElement []*Element `json:"_noteNumber,omitempty"`
+	}{alias: alias(r)}
+	out.NoteNumber, out.NoteNumberElement = alignPrimitiveArray(r.NoteNumber, r.NoteNumberElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "targetItem", r.TargetItem, r.TargetItemElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PaymentReconciliationAllocation) UnmarshalJSON(data []byte) error {
+	type alias PaymentReconciliationAllocation
+	aux := struct {
+		*alias
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.NoteNumber, err = splitPrimitiveArray(aux.NoteNumber, r.NoteNumberElement); err != nil {
+		return fmt.Errorf("noteNumber: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.TargetItem, element, r.targetItemVariants, err = unmarshalChoice(fields, "targetItem", paymentReconciliationAllocationTargetItemVariants); err != nil {
+		return err
+	}
+	r.TargetItemElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetItemElement); err != nil {
+			return fmt.Errorf("_targetItem: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r PaymentReconciliationAllocation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PaymentReconciliationAllocation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PaymentReconciliationAllocation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PaymentReconciliationAllocation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PaymentReconciliationAllocation) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "TargetItem":
+		return "targetItem", choiceValues(paymentReconciliationAllocationTargetItemVariants)
+	}
+	return "", nil
+}
+
+var paymentReconciliationAllocationElementTypes = map[string]string{
+	"noteNumber": "positiveInt",
+}
+
+func (r *PaymentReconciliationAllocation) elementTypes() map[string]string {
+	return paymentReconciliationAllocationElementTypes
+}
+
+var paymentReconciliationAllocationElementMetadata = []ElementMetadata{
+	{Path: "PaymentReconciliation.allocation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.allocation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PaymentReconciliation.allocation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PaymentReconciliation.allocation.identifier", Name: "identifier", Field: 3, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "PaymentReconciliation.allocation.predecessor", Name: "predecessor", Field: 4, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "PaymentReconciliation.allocation.target", Name: "target", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.allocation.targetItem[x]", Name: "targetItem", Field: 6, Min: 0, Max: "1", Types: []string{"string", "Identifier", "positiveInt"}},
+	{Path: "PaymentReconciliation.allocation.encounter", Name: "encounter", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "PaymentReconciliation.allocation.account", Name: "account", Field: 9, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account"}},
+	{Path: "PaymentReconciliation.allocation.type", Name: "type", Field: 10, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.allocation.submitter", Name: "submitter", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.allocation.response", Name: "response", Field: 12, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.allocation.date", Name: "date", Field: 13, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "PaymentReconciliation.allocation.responsible", Name: "responsible", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.allocation.payee", Name: "payee", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "PaymentReconciliation.allocation.amount", Name: "amount", Field: 17, Min: 0, Max: "1", Types: []string{"Money"}},
+	{Path: "PaymentReconciliation.allocation.noteNumber", Name: "noteNumber", Field: 18, Min: 0, Max: "*", Types: []string{"positiveInt"}},
+}
+
+func (r *PaymentReconciliationAllocation) ElementMetadata() []ElementMetadata {
+	return paymentReconciliationAllocationElementMetadata
+}
+
+// PaymentReconciliationAllocationTargetItem is the type of PaymentReconciliation.allocation.targetItem[x]. It is implemented by the PaymentReconciliationAllocationTargetItem* variant types.
+type PaymentReconciliationAllocationTargetItem interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPaymentReconciliationAllocationTargetItem()
+}
+
+// PaymentReconciliationAllocationTargetItemString is the string variant of PaymentReconciliationAllocationTargetItem.
+type PaymentReconciliationAllocationTargetItemString string
+
+func (v PaymentReconciliationAllocationTargetItemString) Validate() error {
+	return nil
+}
+
+func (v PaymentReconciliationAllocationTargetItemString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v PaymentReconciliationAllocationTargetItemString) FHIRType() string {
+	return "string"
+}
+
+func (v PaymentReconciliationAllocationTargetItemString) isPaymentReconciliationAllocationTargetItem() {
+}
+
+// PaymentReconciliationAllocationTargetItemIdentifier is the Identifier variant of PaymentReconciliationAllocationTargetItem.
+type PaymentReconciliationAllocationTargetItemIdentifier struct {
+	Identifier
+}
+
+func (v PaymentReconciliationAllocationTargetItemIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v PaymentReconciliationAllocationTargetItemIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v PaymentReconciliationAllocationTargetItemIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v PaymentReconciliationAllocationTargetItemIdentifier) isPaymentReconciliationAllocationTargetItem() {
+}
+
+// PaymentReconciliationAllocationTargetItemPositiveInt is the positiveInt variant of PaymentReconciliationAllocationTargetItem.
+type PaymentReconciliationAllocationTargetItemPositiveInt int
+
+func (v PaymentReconciliationAllocationTargetItemPositiveInt) Validate() error {
+	return nil
+}
+
+func (v PaymentReconciliationAllocationTargetItemPositiveInt) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v PaymentReconciliationAllocationTargetItemPositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v PaymentReconciliationAllocationTargetItemPositiveInt) isPaymentReconciliationAllocationTargetItem() {
+}
+
+var paymentReconciliationAllocationTargetItemVariants = []PaymentReconciliationAllocationTargetItem{
+	PaymentReconciliationAllocationTargetItemString(""),
+	PaymentReconciliationAllocationTargetItemIdentifier{},
+	PaymentReconciliationAllocationTargetItemPositiveInt(0),
+}
+
 type PaymentReconciliationProcessNote struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Class  *CodeableConcept `json:"class,omitempty" bson:"class,omitempty"`   // Business kind of note
-	Number *int             `json:"number,omitempty" bson:"number,omitempty"` // Note instance identifier
-	Type   *string          `json:"type,omitempty" bson:"type,omitempty"`     // display | print | printoper
-	Text   *string          `json:"text,omitempty" bson:"text,omitempty"`     // Note explanatory text
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Class             *CodeableConcept `json:"class,omitempty" bson:"class,omitempty"`                          // Business kind of note
+	Number            *int             `json:"number,omitempty" bson:"number,omitempty"`                        // Note instance identifier
+	NumberElement     *Element         `json:"_number,omitempty" bson:"number_element,omitempty"`               // Extensions for number
+	Type              *NoteType        `json:"type,omitempty" bson:"type,omitempty"`                            // display | print | printoper
+	TypeElement       *Element         `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Text              *string          `json:"text,omitempty" bson:"text,omitempty"`                            // Note explanatory text
+	TextElement       *Element         `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
 }
 
 func (r *PaymentReconciliationProcessNote) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Class != nil {
 		if err := r.Class.Validate(); err != nil {
 			return fmt.Errorf("Class: %w", err)
 		}
 	}
+	if r.NumberElement != nil {
+		if err := r.NumberElement.Validate(); err != nil {
+			return fmt.Errorf("NumberElement: %w", err)
+		}
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *PaymentReconciliationProcessNote) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Class != nil {
+		r.Class.validateAll(path+".class", issues)
+	}
+	if r.NumberElement != nil {
+		r.NumberElement.validateAll(path+".number", issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+var paymentReconciliationProcessNoteInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PaymentReconciliationProcessNote) invariants() []invariant {
+	return paymentReconciliationProcessNoteInvariants
+}
+
+func (r PaymentReconciliationProcessNote) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PaymentReconciliationProcessNote) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PaymentReconciliationProcessNote) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PaymentReconciliationProcessNote) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var paymentReconciliationProcessNoteElementTypes = map[string]string{
+	"number": "positiveInt",
+	"text":   "markdown",
+}
+
+func (r *PaymentReconciliationProcessNote) elementTypes() map[string]string {
+	return paymentReconciliationProcessNoteElementTypes
+}
+
+var paymentReconciliationProcessNoteElementMetadata = []ElementMetadata{
+	{Path: "PaymentReconciliation.processNote.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PaymentReconciliation.processNote.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PaymentReconciliation.processNote.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PaymentReconciliation.processNote.class", Name: "class", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PaymentReconciliation.processNote.number", Name: "number", Field: 4, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "PaymentReconciliation.processNote.type", Name: "type", Field: 6, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PaymentReconciliation.processNote.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/note-type"}},
+	{Path: "PaymentReconciliation.processNote.text", Name: "text", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *PaymentReconciliationProcessNote) ElementMetadata() []ElementMetadata {
+	return paymentReconciliationProcessNoteElementMetadata
+}
@@ -1,12 +1,110 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // Period Type: A time period defined by a start and end date and optionally time.
 type Period struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Start *string `json:"start,omitempty" bson:"start,omitempty"` // Starting time with inclusive boundary
-	End   *string `json:"end,omitempty" bson:"end,omitempty"`     // End time with inclusive boundary, if not ongoing
+	Id           *string     `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
+	Extension    []Extension `json:"extension,omitempty" bson:"extension,omitempty"`  // Additional content defined by implementations
+	Start        *DateTime   `json:"start,omitempty" bson:"start,omitempty"`          // Starting time with inclusive boundary
+	StartElement *Element    `json:"_start,omitempty" bson:"start_element,omitempty"` // Extensions for start
+	End          *DateTime   `json:"end,omitempty" bson:"end,omitempty"`              // End time with inclusive boundary, if not ongoing
+	EndElement   *Element    `json:"_end,omitempty" bson:"end_element,omitempty"`     // Extensions for end
 }
 
 func (r *Period) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			return fmt.Errorf("Start: %w", err)
+		}
+	}
+	if r.StartElement != nil {
+		if err := r.StartElement.Validate(); err != nil {
+			return fmt.Errorf("StartElement: %w", err)
+		}
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			return fmt.Errorf("End: %w", err)
+		}
+	}
+	if r.EndElement != nil {
+		if err := r.EndElement.Validate(); err != nil {
+			return fmt.Errorf("EndElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Period) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			issues.add("value", path+".start", err.Error())
+		}
+	}
+	if r.StartElement != nil {
+		r.StartElement.validateAll(path+".start", issues)
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			issues.add("value", path+".end", err.Error())
+		}
+	}
+	if r.EndElement != nil {
+		r.EndElement.validateAll(path+".end", issues)
+	}
+}
+
+func (r *Period) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Period", &issues)
+	checkInvariants(r, "Period", &issues)
+	return issues
+}
+
+var periodInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "per-1", severity: "error", human: "If present, start SHALL have a lower or equal value than end", expression: "start.hasValue().not() or end.hasValue().not() or (start.lowBoundary() <= end.highBoundary())"},
+}
+
+func (r *Period) invariants() []invariant {
+	return periodInvariants
+}
+
+func (r Period) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Period) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Period) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Period) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var periodElementMetadata = []ElementMetadata{
+	{Path: "Period.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Period.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Period.start", Name: "start", Field: 2, Min: 0, Max: "1", Types: []string{"dateTime"}, Summary: true},
+	{Path: "Period.end", Name: "end", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}, Summary: true},
+}
+
+func (r *Period) ElementMetadata() []ElementMetadata {
+	return periodElementMetadata
+}
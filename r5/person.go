@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -11,25 +12,36 @@ type Person struct {
 	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                      // Logical id of this artifact
 	Meta                 *Meta                 `json:"meta,omitempty" bson:"meta,omitempty"`                                  // Metadata about the resource
 	ImplicitRules        *string               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`               // A set of rules under which this content was created
+	ImplicitRulesElement *Element              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`      // Extensions for implicitRules
 	Language             *string               `json:"language,omitempty" bson:"language,omitempty"`                          // Language of the resource content
+	LanguageElement      *Element              `json:"_language,omitempty" bson:"language_element,omitempty"`                 // Extensions for language
 	Text                 *Narrative            `json:"text,omitempty" bson:"text,omitempty"`                                  // Text summary of the resource, for human interpretation
-	Contained            []json.RawMessage     `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Contained            []Resource            `json:"contained,omitempty" bson:"contained,omitempty"`                        // Contained, inline Resources
+	Extension            []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored
 	Identifier           []Identifier          `json:"identifier,omitempty" bson:"identifier,omitempty"`                      // A human identifier for this person
 	Active               *bool                 `json:"active,omitempty" bson:"active,omitempty"`                              // This person's record is in active use
+	ActiveElement        *Element              `json:"_active,omitempty" bson:"active_element,omitempty"`                     // Extensions for active
 	Name                 []HumanName           `json:"name,omitempty" bson:"name,omitempty"`                                  // A name associated with the person
 	Telecom              []ContactPoint        `json:"telecom,omitempty" bson:"telecom,omitempty"`                            // A contact detail for the person
-	Gender               *string               `json:"gender,omitempty" bson:"gender,omitempty"`                              // male | female | other | unknown
-	BirthDate            *string               `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                       // The date on which the person was born
-	DeceasedBoolean      *bool                 `json:"deceasedBoolean,omitempty" bson:"deceased_boolean,omitempty"`           // Indicates if the individual is deceased or not
-	DeceasedDateTime     *string               `json:"deceasedDateTime,omitempty" bson:"deceased_date_time,omitempty"`        // Indicates if the individual is deceased or not
+	Gender               *AdministrativeGender `json:"gender,omitempty" bson:"gender,omitempty"`                              // male | female | other | unknown
+	GenderElement        *Element              `json:"_gender,omitempty" bson:"gender_element,omitempty"`                     // Extensions for gender
+	BirthDate            *Date                 `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                       // The date on which the person was born
+	BirthDateElement     *Element              `json:"_birthDate,omitempty" bson:"birth_date_element,omitempty"`              // Extensions for birthDate
+	Deceased             PersonDeceased        `json:"-" bson:"deceased,omitempty"`                                           // Indicates if the individual is deceased or not
+	DeceasedElement      *Element              `json:"-" bson:"deceased_element,omitempty"`                                   // Extensions for deceased[x]
 	Address              []Address             `json:"address,omitempty" bson:"address,omitempty"`                            // One or more addresses for the person
 	MaritalStatus        *CodeableConcept      `json:"maritalStatus,omitempty" bson:"marital_status,omitempty"`               // Marital (civil) status of a person
 	Photo                []Attachment          `json:"photo,omitempty" bson:"photo,omitempty"`                                // Image of the person
 	Communication        []PersonCommunication `json:"communication,omitempty" bson:"communication,omitempty"`                // A language which may be used to communicate with the person about his or her health
 	ManagingOrganization *Reference            `json:"managingOrganization,omitempty" bson:"managing_organization,omitempty"` // The organization that is the custodian of the person record
 	Link                 []PersonLink          `json:"link,omitempty" bson:"link,omitempty"`                                  // Link to a resource that concerns the same actual person
+
+	deceasedVariants []string // JSON properties of Person.deceased[x] when more than one was decoded
 }
 
+var personManagingOrganizationTargets = []string{"Organization"}
+
 func (r *Person) Validate() error {
 	if r.ResourceType != "Person" {
 		return fmt.Errorf("invalid resourceType: expected 'Person', got '%s'", r.ResourceType)
@@ -39,16 +51,49 @@ func (r *Person) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	for i, item := range r.Name {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Name[%d]: %w", i, err)
@@ -59,6 +104,37 @@ func (r *Person) Validate() error {
 			return fmt.Errorf("Telecom[%d]: %w", i, err)
 		}
 	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		return fmt.Errorf("field 'Gender' has invalid code '%s'", *r.Gender)
+	}
+	if r.GenderElement != nil {
+		if err := r.GenderElement.Validate(); err != nil {
+			return fmt.Errorf("GenderElement: %w", err)
+		}
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			return fmt.Errorf("BirthDate: %w", err)
+		}
+	}
+	if r.BirthDateElement != nil {
+		if err := r.BirthDateElement.Validate(); err != nil {
+			return fmt.Errorf("BirthDateElement: %w", err)
+		}
+	}
+	if len(r.deceasedVariants) > 1 {
+		return fmt.Errorf("field 'Deceased' must have a single type, got %v", r.deceasedVariants)
+	}
+	if r.Deceased != nil {
+		if err := r.Deceased.Validate(); err != nil {
+			return fmt.Errorf("Deceased: %w", err)
+		}
+	}
+	if r.DeceasedElement != nil {
+		if err := r.DeceasedElement.Validate(); err != nil {
+			return fmt.Errorf("DeceasedElement: %w", err)
+		}
+	}
 	for i, item := range r.Address {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Address[%d]: %w", i, err)
@@ -79,6 +155,11 @@ func (r *Person) Validate() error {
 			return fmt.Errorf("Communication[%d]: %w", i, err)
 		}
 	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, personManagingOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, personManagingOrganizationTargets)
+		}
+	}
 	if r.ManagingOrganization != nil {
 		if err := r.ManagingOrganization.Validate(); err != nil {
 			return fmt.Errorf("ManagingOrganization: %w", err)
@@ -89,16 +170,338 @@ func (r *Person) Validate() error {
 			return fmt.Errorf("Link[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Person")
+}
+
+func (r *Person) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Person" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Person', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		issues.add("code-invalid", path+".gender", fmt.Sprintf("field 'Gender' has invalid code '%s'", *r.Gender))
+	}
+	if r.GenderElement != nil {
+		r.GenderElement.validateAll(path+".gender", issues)
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			issues.add("value", path+".birthDate", err.Error())
+		}
+	}
+	if r.BirthDateElement != nil {
+		r.BirthDateElement.validateAll(path+".birthDate", issues)
+	}
+	if len(r.deceasedVariants) > 1 {
+		issues.add("structure", path+".deceased", fmt.Sprintf("field 'Deceased' must have a single type, got %v", r.deceasedVariants))
+	}
+	if r.Deceased != nil {
+		r.Deceased.validateAll(path+".deceased.ofType("+r.Deceased.FHIRType()+")", issues)
+	}
+	if r.DeceasedElement != nil {
+		r.DeceasedElement.validateAll(path+".deceased", issues)
+	}
+	for i, item := range r.Address {
+		item.validateAll(fmt.Sprintf("%s.address[%d]", path, i), issues)
+	}
+	if r.MaritalStatus != nil {
+		r.MaritalStatus.validateAll(path+".maritalStatus", issues)
+	}
+	for i, item := range r.Photo {
+		item.validateAll(fmt.Sprintf("%s.photo[%d]", path, i), issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+	if r.ManagingOrganization != nil {
+		if target := invalidReferenceTarget(r.ManagingOrganization.Reference, r.ManagingOrganization.Type, personManagingOrganizationTargets); target != "" {
+			issues.add("value", path+".managingOrganization", fmt.Sprintf("field 'ManagingOrganization' cannot refer to %s, expected one of %v", target, personManagingOrganizationTargets))
+		}
+	}
+	if r.ManagingOrganization != nil {
+		r.ManagingOrganization.validateAll(path+".managingOrganization", issues)
+	}
+	for i, item := range r.Link {
+		item.validateAll(fmt.Sprintf("%s.link[%d]", path, i), issues)
+	}
+}
+
+func (r *Person) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Person", &issues)
+	checkInvariants(r, "Person", &issues)
+	return issues
+}
+
+var personInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Person) invariants() []invariant {
+	return personInvariants
+}
+
+func (r Person) MarshalJSON() ([]byte, error) {
+	type alias Person
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "deceased", r.Deceased, r.DeceasedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Person) UnmarshalJSON(data []byte) error {
+	type alias Person
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Deceased, element, r.deceasedVariants, err = unmarshalChoice(fields, "deceased", personDeceasedVariants); err != nil {
+		return err
+	}
+	r.DeceasedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeceasedElement); err != nil {
+			return fmt.Errorf("_deceased: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r Person) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Person) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Person) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Person) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Person) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Deceased":
+		return "deceased", choiceValues(personDeceasedVariants)
+	}
+	return "", nil
+}
+
+var personElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Person) elementTypes() map[string]string {
+	return personElementTypes
+}
+
+var personElementMetadata = []ElementMetadata{
+	{Path: "Person.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Person.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Person.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Person.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Person.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Person.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Person.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Person.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Person.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Person.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Person.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Person.name", Name: "name", Field: 14, Min: 0, Max: "*", Types: []string{"HumanName"}},
+	{Path: "Person.telecom", Name: "telecom", Field: 15, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "Person.gender", Name: "gender", Field: 16, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Person.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"}},
+	{Path: "Person.birthDate", Name: "birthDate", Field: 18, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Person.deceased[x]", Name: "deceased", Field: 20, Min: 0, Max: "1", Types: []string{"boolean", "dateTime"}},
+	{Path: "Person.address", Name: "address", Field: 22, Min: 0, Max: "*", Types: []string{"Address"}},
+	{Path: "Person.maritalStatus", Name: "maritalStatus", Field: 23, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Person.photo", Name: "photo", Field: 24, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "Person.communication", Name: "communication", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Person.managingOrganization", Name: "managingOrganization", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "Person.link", Name: "link", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Person) ElementMetadata() []ElementMetadata {
+	return personElementMetadata
+}
+
+// PersonDeceased is the type of Person.deceased[x]. It is implemented by the PersonDeceased* variant types.
+type PersonDeceased interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPersonDeceased()
+}
+
+// PersonDeceasedBoolean is the boolean variant of PersonDeceased.
+type PersonDeceasedBoolean bool
+
+func (v PersonDeceasedBoolean) Validate() error {
+	return nil
+}
+
+func (v PersonDeceasedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PersonDeceasedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PersonDeceasedBoolean) isPersonDeceased() {}
+
+// PersonDeceasedDateTime is the dateTime variant of PersonDeceased.
+type PersonDeceasedDateTime struct {
+	DateTime
+}
+
+func (v PersonDeceasedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v PersonDeceasedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v PersonDeceasedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v PersonDeceasedDateTime) isPersonDeceased() {}
+
+var personDeceasedVariants = []PersonDeceased{
+	PersonDeceasedBoolean(false),
+	PersonDeceasedDateTime{},
+}
+
+func (r *Person) GetResourceType() string {
+	return "Person"
+}
+
+func (r *Person) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Person) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Person) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Person) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Person) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Person) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Person) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Person)(nil)
+
 type PersonCommunication struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Language  *CodeableConcept `json:"language" bson:"language"`                       // The language which can be used to communicate with the person about his or her health
-	Preferred *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"` // Language preference indicator
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Language          *CodeableConcept `json:"language" bson:"language"`                                        // The language which can be used to communicate with the person about his or her health
+	Preferred         *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"`                  // Language preference indicator
+	PreferredElement  *Element         `json:"_preferred,omitempty" bson:"preferred_element,omitempty"`         // Extensions for preferred
 }
 
 func (r *PersonCommunication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Language == nil {
 		return fmt.Errorf("field 'Language' is required")
 	}
@@ -107,23 +510,172 @@ func (r *PersonCommunication) Validate() error {
 			return fmt.Errorf("Language: %w", err)
 		}
 	}
+	if r.PreferredElement != nil {
+		if err := r.PreferredElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *PersonCommunication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Language == nil {
+		issues.add("required", path+".language", "field 'Language' is required")
+	}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
+	}
+	if r.PreferredElement != nil {
+		r.PreferredElement.validateAll(path+".preferred", issues)
+	}
+}
+
+var personCommunicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PersonCommunication) invariants() []invariant {
+	return personCommunicationInvariants
+}
+
+func (r PersonCommunication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PersonCommunication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PersonCommunication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PersonCommunication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var personCommunicationElementMetadata = []ElementMetadata{
+	{Path: "Person.communication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Person.communication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Person.communication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Person.communication.language", Name: "language", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Person.communication.preferred", Name: "preferred", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *PersonCommunication) ElementMetadata() []ElementMetadata {
+	return personCommunicationElementMetadata
+}
+
 type PersonLink struct {
-	Id        *string    `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Target    *Reference `json:"target" bson:"target"`                           // The resource to which this actual person is associated
-	Assurance *string    `json:"assurance,omitempty" bson:"assurance,omitempty"` // level1 | level2 | level3 | level4
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Target            *Reference              `json:"target" bson:"target"`                                            // The resource to which this actual person is associated
+	Assurance         *IdentityAssuranceLevel `json:"assurance,omitempty" bson:"assurance,omitempty"`                  // level1 | level2 | level3 | level4
+	AssuranceElement  *Element                `json:"_assurance,omitempty" bson:"assurance_element,omitempty"`         // Extensions for assurance
 }
 
+var personLinkTargetTargets = []string{"Patient", "Person", "Practitioner", "RelatedPerson"}
+
 func (r *PersonLink) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Target == nil {
 		return fmt.Errorf("field 'Target' is required")
 	}
+	if r.Target != nil {
+		if target := invalidReferenceTarget(r.Target.Reference, r.Target.Type, personLinkTargetTargets); target != "" {
+			return fmt.Errorf("field 'Target' cannot refer to %s, expected one of %v", target, personLinkTargetTargets)
+		}
+	}
 	if r.Target != nil {
 		if err := r.Target.Validate(); err != nil {
 			return fmt.Errorf("Target: %w", err)
 		}
 	}
+	if r.Assurance != nil && !r.Assurance.IsValid() {
+		return fmt.Errorf("field 'Assurance' has invalid code '%s'", *r.Assurance)
+	}
+	if r.AssuranceElement != nil {
+		if err := r.AssuranceElement.Validate(); err != nil {
+			return fmt.Errorf("AssuranceElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *PersonLink) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		if target := invalidReferenceTarget(r.Target.Reference, r.Target.Type, personLinkTargetTargets); target != "" {
+			issues.add("value", path+".target", fmt.Sprintf("field 'Target' cannot refer to %s, expected one of %v", target, personLinkTargetTargets))
+		}
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target", issues)
+	}
+	if r.Assurance != nil && !r.Assurance.IsValid() {
+		issues.add("code-invalid", path+".assurance", fmt.Sprintf("field 'Assurance' has invalid code '%s'", *r.Assurance))
+	}
+	if r.AssuranceElement != nil {
+		r.AssuranceElement.validateAll(path+".assurance", issues)
+	}
+}
+
+var personLinkInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PersonLink) invariants() []invariant {
+	return personLinkInvariants
+}
+
+func (r PersonLink) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PersonLink) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PersonLink) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PersonLink) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var personLinkElementMetadata = []ElementMetadata{
+	{Path: "Person.link.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Person.link.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Person.link.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Person.link.target", Name: "target", Field: 3, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Person.link.assurance", Name: "assurance", Field: 4, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Person.link.assurance", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/identity-assuranceLevel"}},
+}
+
+func (r *PersonLink) ElementMetadata() []ElementMetadata {
+	return personLinkElementMetadata
+}
@@ -2,57 +2,82 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // This resource allows for the definition of various types of plans as a sharable, consumable, and executable artifact. The resource is general enough to support the description of a broad range of clinical and non-clinical artifacts such as clinical decision support rules, order sets, protocols, drug quality specifications, and drug manufacturing process.
 type PlanDefinition struct {
-	ResourceType            string                 `json:"resourceType" bson:"resource_type"`                                             // Type of resource
-	Id                      *string                `json:"id,omitempty" bson:"id,omitempty"`                                              // Logical id of this artifact
-	Meta                    *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                                          // Metadata about the resource
-	ImplicitRules           *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                       // A set of rules under which this content was created
-	Language                *string                `json:"language,omitempty" bson:"language,omitempty"`                                  // Language of the resource content
-	Text                    *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                                          // Text summary of the resource, for human interpretation
-	Contained               []json.RawMessage      `json:"contained,omitempty" bson:"contained,omitempty"`                                // Contained, inline Resources
-	Url                     *string                `json:"url,omitempty" bson:"url,omitempty"`                                            // Canonical identifier for this plan definition, represented as a URI (globally unique)
-	Identifier              []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                              // Additional identifier for the plan definition
-	Version                 *string                `json:"version,omitempty" bson:"version,omitempty"`                                    // Business version of the plan definition
-	VersionAlgorithmString  *string                `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"`    // How to compare versions
-	VersionAlgorithmCoding  *Coding                `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"`    // How to compare versions
-	Name                    *string                `json:"name,omitempty" bson:"name,omitempty"`                                          // Name for this plan definition (computer friendly)
-	Title                   *string                `json:"title,omitempty" bson:"title,omitempty"`                                        // Name for this plan definition (human friendly)
-	Subtitle                *string                `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                                  // Subordinate title of the plan definition
-	Type                    *CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                                          // order-set | protocol | eca-rule | workflow-definition | etc.
-	Status                  string                 `json:"status" bson:"status"`                                                          // draft | active | retired | unknown
-	Experimental            *bool                  `json:"experimental,omitempty" bson:"experimental,omitempty"`                          // For testing only - never for real usage
-	SubjectCodeableConcept  *CodeableConcept       `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"`    // Type of individual the plan definition is focused on
-	SubjectReference        *Reference             `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`                 // Type of individual the plan definition is focused on
-	SubjectCanonical        *string                `json:"subjectCanonical,omitempty" bson:"subject_canonical,omitempty"`                 // Type of individual the plan definition is focused on
-	Date                    *string                `json:"date,omitempty" bson:"date,omitempty"`                                          // Date last changed
-	Publisher               *string                `json:"publisher,omitempty" bson:"publisher,omitempty"`                                // Name of the publisher/steward (organization or individual)
-	Contact                 []ContactDetail        `json:"contact,omitempty" bson:"contact,omitempty"`                                    // Contact details for the publisher
-	Description             *string                `json:"description,omitempty" bson:"description,omitempty"`                            // Natural language description of the plan definition
-	UseContext              []UsageContext         `json:"useContext,omitempty" bson:"use_context,omitempty"`                             // The context that the content is intended to support
-	Jurisdiction            []CodeableConcept      `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                          // Jurisdiction of the authority that maintains the plan definition (if applicable)
-	Purpose                 *string                `json:"purpose,omitempty" bson:"purpose,omitempty"`                                    // Why this plan definition is defined
-	Usage                   *string                `json:"usage,omitempty" bson:"usage,omitempty"`                                        // Describes the clinical usage of the plan
-	Copyright               *string                `json:"copyright,omitempty" bson:"copyright,omitempty"`                                // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel          *string                `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                     // Copyright holder and year(s)
-	ApprovalDate            *string                `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                         // When the plan definition was approved by publisher
-	LastReviewDate          *string                `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                    // When the plan definition was last reviewed by the publisher
-	EffectivePeriod         *Period                `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                   // When the plan definition is expected to be used
-	Topic                   []CodeableConcept      `json:"topic,omitempty" bson:"topic,omitempty"`                                        // E.g. Education, Treatment, Assessment
-	Author                  []ContactDetail        `json:"author,omitempty" bson:"author,omitempty"`                                      // Who authored the content
-	Editor                  []ContactDetail        `json:"editor,omitempty" bson:"editor,omitempty"`                                      // Who edited the content
-	Reviewer                []ContactDetail        `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                                  // Who reviewed the content
-	Endorser                []ContactDetail        `json:"endorser,omitempty" bson:"endorser,omitempty"`                                  // Who endorsed the content
-	RelatedArtifact         []RelatedArtifact      `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                   // Additional documentation, citations
-	Library                 []string               `json:"library,omitempty" bson:"library,omitempty"`                                    // Logic used by the plan definition
-	Goal                    []PlanDefinitionGoal   `json:"goal,omitempty" bson:"goal,omitempty"`                                          // What the plan is trying to accomplish
-	Actor                   []PlanDefinitionActor  `json:"actor,omitempty" bson:"actor,omitempty"`                                        // Actors within the plan
-	Action                  []PlanDefinitionAction `json:"action,omitempty" bson:"action,omitempty"`                                      // Action defined by the plan
-	AsNeededBoolean         *bool                  `json:"asNeededBoolean,omitempty" bson:"as_needed_boolean,omitempty"`                  // Preconditions for service
-	AsNeededCodeableConcept *CodeableConcept       `json:"asNeededCodeableConcept,omitempty" bson:"as_needed_codeable_concept,omitempty"` // Preconditions for service
+	ResourceType            string                         `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                        `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                        `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                        `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this plan definition, represented as a URI (globally unique)
+	UrlElement              *Element                       `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the plan definition
+	Version                 *string                        `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the plan definition
+	VersionElement          *Element                       `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        PlanDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                       `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                        `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this plan definition (computer friendly)
+	NameElement             *Element                       `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                        `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this plan definition (human friendly)
+	TitleElement            *Element                       `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Subtitle                *string                        `json:"subtitle,omitempty" bson:"subtitle,omitempty"`                        // Subordinate title of the plan definition
+	SubtitleElement         *Element                       `json:"_subtitle,omitempty" bson:"subtitle_element,omitempty"`               // Extensions for subtitle
+	Type                    *CodeableConcept               `json:"type,omitempty" bson:"type,omitempty"`                                // order-set | protocol | eca-rule | workflow-definition | etc.
+	Status                  PublicationStatus              `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                          `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                       `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Subject                 PlanDefinitionSubject          `json:"-" bson:"subject,omitempty"`                                          // Type of individual the plan definition is focused on
+	SubjectElement          *Element                       `json:"-" bson:"subject_element,omitempty"`                                  // Extensions for subject[x]
+	Date                    *DateTime                      `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                       `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                        `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                       `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail                `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                        `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the plan definition
+	DescriptionElement      *Element                       `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext                 `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept              `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the plan definition (if applicable)
+	Purpose                 *string                        `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this plan definition is defined
+	PurposeElement          *Element                       `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Usage                   *string                        `json:"usage,omitempty" bson:"usage,omitempty"`                              // Describes the clinical usage of the plan
+	UsageElement            *Element                       `json:"_usage,omitempty" bson:"usage_element,omitempty"`                     // Extensions for usage
+	Copyright               *string                        `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                       `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                        `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                       `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                          `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the plan definition was approved by publisher
+	ApprovalDateElement     *Element                       `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                          `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the plan definition was last reviewed by the publisher
+	LastReviewDateElement   *Element                       `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                        `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the plan definition is expected to be used
+	Topic                   []CodeableConcept              `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment
+	Author                  []ContactDetail                `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the content
+	Editor                  []ContactDetail                `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the content
+	Reviewer                []ContactDetail                `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the content
+	Endorser                []ContactDetail                `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the content
+	RelatedArtifact         []RelatedArtifact              `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations
+	Library                 []string                       `json:"library,omitempty" bson:"library,omitempty"`                          // Logic used by the plan definition
+	LibraryElement          []*Element                     `json:"_library,omitempty" bson:"library_element,omitempty"`                 // Extensions for library
+	Goal                    []PlanDefinitionGoal           `json:"goal,omitempty" bson:"goal,omitempty"`                                // What the plan is trying to accomplish
+	Actor                   []PlanDefinitionActor          `json:"actor,omitempty" bson:"actor,omitempty"`                              // Actors within the plan
+	Action                  []PlanDefinitionAction         `json:"action,omitempty" bson:"action,omitempty"`                            // Action defined by the plan
+	AsNeeded                PlanDefinitionAsNeeded         `json:"-" bson:"as_needed,omitempty"`                                        // Preconditions for service
+	AsNeededElement         *Element                       `json:"-" bson:"as_needed_element,omitempty"`                                // Extensions for asNeeded[x]
+
+	versionAlgorithmVariants []string // JSON properties of PlanDefinition.versionAlgorithm[x] when more than one was decoded
+	subjectVariants          []string // JSON properties of PlanDefinition.subject[x] when more than one was decoded
+	asNeededVariants         []string // JSON properties of PlanDefinition.asNeeded[x] when more than one was decoded
 }
 
 func (r *PlanDefinition) Validate() error {
@@ -64,19 +89,80 @@ func (r *PlanDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.SubtitleElement != nil {
+		if err := r.SubtitleElement.Validate(); err != nil {
+			return fmt.Errorf("SubtitleElement: %w", err)
 		}
 	}
 	if r.Type != nil {
@@ -84,18 +170,48 @@ func (r *PlanDefinition) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.SubjectElement != nil {
+		if err := r.SubjectElement.Validate(); err != nil {
+			return fmt.Errorf("SubjectElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -103,6 +219,11 @@ func (r *PlanDefinition) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -113,6 +234,46 @@ func (r *PlanDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.UsageElement != nil {
+		if err := r.UsageElement.Validate(); err != nil {
+			return fmt.Errorf("UsageElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -148,6 +309,14 @@ func (r *PlanDefinition) Validate() error {
 			return fmt.Errorf("RelatedArtifact[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LibraryElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Goal {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Goal[%d]: %w", i, err)
@@ -163,421 +332,2966 @@ func (r *PlanDefinition) Validate() error {
 			return fmt.Errorf("Action[%d]: %w", i, err)
 		}
 	}
-	if r.AsNeededCodeableConcept != nil {
-		if err := r.AsNeededCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("AsNeededCodeableConcept: %w", err)
+	if len(r.asNeededVariants) > 1 {
+		return fmt.Errorf("field 'AsNeeded' must have a single type, got %v", r.asNeededVariants)
+	}
+	if r.AsNeeded != nil {
+		if err := r.AsNeeded.Validate(); err != nil {
+			return fmt.Errorf("AsNeeded: %w", err)
+		}
+	}
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
 		}
 	}
-	return nil
-}
-
-type PlanDefinitionActionParticipant struct {
-	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	ActorId       *string          `json:"actorId,omitempty" bson:"actor_id,omitempty"`             // What actor
-	Type          *string          `json:"type,omitempty" bson:"type,omitempty"`                    // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
-	TypeCanonical *string          `json:"typeCanonical,omitempty" bson:"type_canonical,omitempty"` // Who or what can participate
-	TypeReference *Reference       `json:"typeReference,omitempty" bson:"type_reference,omitempty"` // Who or what can participate
-	Role          *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                    // E.g. Nurse, Surgeon, Parent
-	Function      *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`            // E.g. Author, Reviewer, Witness, etc
+	return validateInvariants(r, "PlanDefinition")
 }
 
-func (r *PlanDefinitionActionParticipant) Validate() error {
-	if r.TypeReference != nil {
-		if err := r.TypeReference.Validate(); err != nil {
-			return fmt.Errorf("TypeReference: %w", err)
+func (r *PlanDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "PlanDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'PlanDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.SubtitleElement != nil {
+		r.SubtitleElement.validateAll(path+".subtitle", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.SubjectElement != nil {
+		r.SubjectElement.validateAll(path+".subject", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.Function != nil {
-		if err := r.Function.Validate(); err != nil {
-			return fmt.Errorf("Function: %w", err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.UsageElement != nil {
+		r.UsageElement.validateAll(path+".usage", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	return nil
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	for i, item := range r.LibraryElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.library[%d]", path, i), issues)
+	}
+	for i, item := range r.Goal {
+		item.validateAll(fmt.Sprintf("%s.goal[%d]", path, i), issues)
+	}
+	for i, item := range r.Actor {
+		item.validateAll(fmt.Sprintf("%s.actor[%d]", path, i), issues)
+	}
+	for i, item := range r.Action {
+		item.validateAll(fmt.Sprintf("%s.action[%d]", path, i), issues)
+	}
+	if len(r.asNeededVariants) > 1 {
+		issues.add("structure", path+".asNeeded", fmt.Sprintf("field 'AsNeeded' must have a single type, got %v", r.asNeededVariants))
+	}
+	if r.AsNeeded != nil {
+		r.AsNeeded.validateAll(path+".asNeeded.ofType("+r.AsNeeded.FHIRType()+")", issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
+	}
 }
 
-type PlanDefinitionActionDynamicValue struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Path       *string     `json:"path,omitempty" bson:"path,omitempty"`             // The path to the element to be set dynamically
-	Expression *Expression `json:"expression,omitempty" bson:"expression,omitempty"` // An expression that provides the dynamic value for the customization
+func (r *PlanDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("PlanDefinition", &issues)
+	checkInvariants(r, "PlanDefinition", &issues)
+	return issues
 }
 
-func (r *PlanDefinitionActionDynamicValue) Validate() error {
-	if r.Expression != nil {
-		if err := r.Expression.Validate(); err != nil {
-			return fmt.Errorf("Expression: %w", err)
-		}
-	}
-	return nil
+var planDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type PlanDefinitionGoalTarget struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	Measure               *CodeableConcept `json:"measure,omitempty" bson:"measure,omitempty"`                               // The parameter whose value is to be tracked
-	DetailQuantity        *Quantity        `json:"detailQuantity,omitempty" bson:"detail_quantity,omitempty"`                // The target value to be achieved
-	DetailRange           *Range           `json:"detailRange,omitempty" bson:"detail_range,omitempty"`                      // The target value to be achieved
-	DetailCodeableConcept *CodeableConcept `json:"detailCodeableConcept,omitempty" bson:"detail_codeable_concept,omitempty"` // The target value to be achieved
-	DetailString          *string          `json:"detailString,omitempty" bson:"detail_string,omitempty"`                    // The target value to be achieved
-	DetailBoolean         *bool            `json:"detailBoolean,omitempty" bson:"detail_boolean,omitempty"`                  // The target value to be achieved
-	DetailInteger         *int             `json:"detailInteger,omitempty" bson:"detail_integer,omitempty"`                  // The target value to be achieved
-	DetailRatio           *Ratio           `json:"detailRatio,omitempty" bson:"detail_ratio,omitempty"`                      // The target value to be achieved
-	Due                   *Duration        `json:"due,omitempty" bson:"due,omitempty"`                                       // Reach goal within
+func (r *PlanDefinition) invariants() []invariant {
+	return planDefinitionInvariants
 }
 
-func (r *PlanDefinitionGoalTarget) Validate() error {
-	if r.Measure != nil {
-		if err := r.Measure.Validate(); err != nil {
-			return fmt.Errorf("Measure: %w", err)
-		}
+func (r PlanDefinition) MarshalJSON() ([]byte, error) {
+	type alias PlanDefinition
+	out := struct {
+		alias
+		Library        []*string  `json:"library,omitempty"`
+		LibraryElement []*Element `json:"_library,omitempty"`
+	}{alias: alias(r)}
+	out.Library, out.LibraryElement = alignPrimitiveArray(r.Library, r.LibraryElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, r.SubjectElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "asNeeded", r.AsNeeded, r.AsNeededElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PlanDefinition) UnmarshalJSON(data []byte) error {
+	type alias PlanDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Library   []*string         `json:"library,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.DetailQuantity != nil {
-		if err := r.DetailQuantity.Validate(); err != nil {
-			return fmt.Errorf("DetailQuantity: %w", err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.DetailRange != nil {
-		if err := r.DetailRange.Validate(); err != nil {
-			return fmt.Errorf("DetailRange: %w", err)
-		}
+	if r.Library, err = splitPrimitiveArray(aux.Library, r.LibraryElement); err != nil {
+		return fmt.Errorf("library: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", planDefinitionVersionAlgorithmVariants); err != nil {
+		return err
 	}
-	if r.DetailCodeableConcept != nil {
-		if err := r.DetailCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DetailCodeableConcept: %w", err)
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
-	if r.DetailRatio != nil {
-		if err := r.DetailRatio.Validate(); err != nil {
-			return fmt.Errorf("DetailRatio: %w", err)
+	if r.Subject, element, r.subjectVariants, err = unmarshalChoice(fields, "subject", planDefinitionSubjectVariants); err != nil {
+		return err
+	}
+	r.SubjectElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.SubjectElement); err != nil {
+			return fmt.Errorf("_subject: %w", err)
 		}
 	}
-	if r.Due != nil {
-		if err := r.Due.Validate(); err != nil {
-			return fmt.Errorf("Due: %w", err)
+	if r.AsNeeded, element, r.asNeededVariants, err = unmarshalChoice(fields, "asNeeded", planDefinitionAsNeededVariants); err != nil {
+		return err
+	}
+	r.AsNeededElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AsNeededElement); err != nil {
+			return fmt.Errorf("_asNeeded: %w", err)
 		}
 	}
 	return nil
 }
 
-type PlanDefinitionActorOption struct {
-	Id            *string          `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Type          *string          `json:"type,omitempty" bson:"type,omitempty"`                    // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
-	TypeCanonical *string          `json:"typeCanonical,omitempty" bson:"type_canonical,omitempty"` // Who or what can participate
-	TypeReference *Reference       `json:"typeReference,omitempty" bson:"type_reference,omitempty"` // Who or what can participate
-	Role          *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                    // E.g. Nurse, Surgeon, Parent
+func (r PlanDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *PlanDefinitionActorOption) Validate() error {
-	if r.TypeReference != nil {
-		if err := r.TypeReference.Validate(); err != nil {
-			return fmt.Errorf("TypeReference: %w", err)
-		}
-	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
-	}
-	return nil
+func (r *PlanDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type PlanDefinitionActionCondition struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Kind       string      `json:"kind" bson:"kind"`                                 // applicability | start | stop
-	Expression *Expression `json:"expression,omitempty" bson:"expression,omitempty"` // Boolean-valued expression
+func (r PlanDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *PlanDefinitionActionCondition) Validate() error {
-	var emptyString string
-	if r.Kind == emptyString {
-		return fmt.Errorf("field 'Kind' is required")
-	}
-	if r.Expression != nil {
-		if err := r.Expression.Validate(); err != nil {
-			return fmt.Errorf("Expression: %w", err)
-		}
-	}
-	return nil
+func (r *PlanDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
 }
 
-type PlanDefinitionActionInput struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Title       *string          `json:"title,omitempty" bson:"title,omitempty"`              // User-visible title
-	Requirement *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`  // What data is provided
-	RelatedData *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"` // What data is provided
+func (r *PlanDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(planDefinitionVersionAlgorithmVariants)
+	case "Subject":
+		return "subject", choiceValues(planDefinitionSubjectVariants)
+	case "AsNeeded":
+		return "asNeeded", choiceValues(planDefinitionAsNeededVariants)
+	}
+	return "", nil
 }
 
-func (r *PlanDefinitionActionInput) Validate() error {
-	if r.Requirement != nil {
-		if err := r.Requirement.Validate(); err != nil {
-			return fmt.Errorf("Requirement: %w", err)
-		}
-	}
-	return nil
+var planDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"usage":         "markdown",
+	"copyright":     "markdown",
+	"library":       "canonical",
 }
 
-type PlanDefinitionActionOutput struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Title       *string          `json:"title,omitempty" bson:"title,omitempty"`              // User-visible title
-	Requirement *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`  // What data is provided
-	RelatedData *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"` // What data is provided
+func (r *PlanDefinition) elementTypes() map[string]string {
+	return planDefinitionElementTypes
 }
 
-func (r *PlanDefinitionActionOutput) Validate() error {
-	if r.Requirement != nil {
-		if err := r.Requirement.Validate(); err != nil {
-			return fmt.Errorf("Requirement: %w", err)
-		}
-	}
-	return nil
+var planDefinitionElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "PlanDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "PlanDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "PlanDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "PlanDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "PlanDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "PlanDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "PlanDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "PlanDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.subtitle", Name: "subtitle", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.type", Name: "type", Field: 24, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.status", Name: "status", Field: 25, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "PlanDefinition.experimental", Name: "experimental", Field: 27, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "PlanDefinition.subject[x]", Name: "subject", Field: 29, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "PlanDefinition.date", Name: "date", Field: 31, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "PlanDefinition.publisher", Name: "publisher", Field: 33, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.contact", Name: "contact", Field: 35, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "PlanDefinition.description", Name: "description", Field: 36, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.useContext", Name: "useContext", Field: 38, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "PlanDefinition.jurisdiction", Name: "jurisdiction", Field: 39, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.purpose", Name: "purpose", Field: 40, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.usage", Name: "usage", Field: 42, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.copyright", Name: "copyright", Field: 44, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.copyrightLabel", Name: "copyrightLabel", Field: 46, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.approvalDate", Name: "approvalDate", Field: 48, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "PlanDefinition.lastReviewDate", Name: "lastReviewDate", Field: 50, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "PlanDefinition.effectivePeriod", Name: "effectivePeriod", Field: 52, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "PlanDefinition.topic", Name: "topic", Field: 53, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.author", Name: "author", Field: 54, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "PlanDefinition.editor", Name: "editor", Field: 55, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "PlanDefinition.reviewer", Name: "reviewer", Field: 56, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "PlanDefinition.endorser", Name: "endorser", Field: 57, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "PlanDefinition.relatedArtifact", Name: "relatedArtifact", Field: 58, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "PlanDefinition.library", Name: "library", Field: 59, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "PlanDefinition.goal", Name: "goal", Field: 61, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.actor", Name: "actor", Field: 62, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action", Name: "action", Field: 63, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.asNeeded[x]", Name: "asNeeded", Field: 64, Min: 0, Max: "1", Types: []string{"boolean", "CodeableConcept"}},
 }
 
-type PlanDefinitionActionRelatedAction struct {
-	Id              *string   `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	TargetId        string    `json:"targetId" bson:"target_id"`                                   // What action is this related to
-	Relationship    string    `json:"relationship" bson:"relationship"`                            // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
-	EndRelationship *string   `json:"endRelationship,omitempty" bson:"end_relationship,omitempty"` // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
-	OffsetDuration  *Duration `json:"offsetDuration,omitempty" bson:"offset_duration,omitempty"`   // Time offset for the relationship
-	OffsetRange     *Range    `json:"offsetRange,omitempty" bson:"offset_range,omitempty"`         // Time offset for the relationship
+func (r *PlanDefinition) ElementMetadata() []ElementMetadata {
+	return planDefinitionElementMetadata
 }
 
-func (r *PlanDefinitionActionRelatedAction) Validate() error {
-	var emptyString string
-	if r.TargetId == emptyString {
-		return fmt.Errorf("field 'TargetId' is required")
-	}
-	if r.Relationship == emptyString {
-		return fmt.Errorf("field 'Relationship' is required")
-	}
-	if r.OffsetDuration != nil {
-		if err := r.OffsetDuration.Validate(); err != nil {
-			return fmt.Errorf("OffsetDuration: %w", err)
-		}
-	}
-	if r.OffsetRange != nil {
-		if err := r.OffsetRange.Validate(); err != nil {
-			return fmt.Errorf("OffsetRange: %w", err)
-		}
-	}
+// PlanDefinitionVersionAlgorithm is the type of PlanDefinition.versionAlgorithm[x]. It is implemented by the PlanDefinitionVersionAlgorithm* variant types.
+type PlanDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionVersionAlgorithm()
+}
+
+// PlanDefinitionVersionAlgorithmString is the string variant of PlanDefinitionVersionAlgorithm.
+type PlanDefinitionVersionAlgorithmString string
+
+func (v PlanDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type PlanDefinitionGoal struct {
-	Id            *string                    `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Category      *CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`           // E.g. Treatment, dietary, behavioral
-	Description   *CodeableConcept           `json:"description" bson:"description"`                         // Code or text describing the goal
-	Priority      *CodeableConcept           `json:"priority,omitempty" bson:"priority,omitempty"`           // high-priority | medium-priority | low-priority
-	Start         *CodeableConcept           `json:"start,omitempty" bson:"start,omitempty"`                 // When goal pursuit begins
-	Addresses     []CodeableConcept          `json:"addresses,omitempty" bson:"addresses,omitempty"`         // What does the goal address
-	Documentation []RelatedArtifact          `json:"documentation,omitempty" bson:"documentation,omitempty"` // Supporting documentation for the goal
-	Target        []PlanDefinitionGoalTarget `json:"target,omitempty" bson:"target,omitempty"`               // Target outcome for the goal
+func (v PlanDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
 }
 
-func (r *PlanDefinitionGoal) Validate() error {
-	if r.Category != nil {
-		if err := r.Category.Validate(); err != nil {
-			return fmt.Errorf("Category: %w", err)
-		}
-	}
-	if r.Description == nil {
-		return fmt.Errorf("field 'Description' is required")
-	}
-	if r.Description != nil {
-		if err := r.Description.Validate(); err != nil {
-			return fmt.Errorf("Description: %w", err)
-		}
-	}
-	if r.Priority != nil {
-		if err := r.Priority.Validate(); err != nil {
-			return fmt.Errorf("Priority: %w", err)
-		}
-	}
-	if r.Start != nil {
-		if err := r.Start.Validate(); err != nil {
-			return fmt.Errorf("Start: %w", err)
-		}
-	}
-	for i, item := range r.Addresses {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Addresses[%d]: %w", i, err)
-		}
-	}
+func (v PlanDefinitionVersionAlgorithmString) isPlanDefinitionVersionAlgorithm() {}
+
+// PlanDefinitionVersionAlgorithmCoding is the Coding variant of PlanDefinitionVersionAlgorithm.
+type PlanDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v PlanDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v PlanDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v PlanDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v PlanDefinitionVersionAlgorithmCoding) isPlanDefinitionVersionAlgorithm() {}
+
+var planDefinitionVersionAlgorithmVariants = []PlanDefinitionVersionAlgorithm{
+	PlanDefinitionVersionAlgorithmString(""),
+	PlanDefinitionVersionAlgorithmCoding{},
+}
+
+// PlanDefinitionSubject is the type of PlanDefinition.subject[x]. It is implemented by the PlanDefinitionSubject* variant types.
+type PlanDefinitionSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionSubject()
+}
+
+// PlanDefinitionSubjectCodeableConcept is the CodeableConcept variant of PlanDefinitionSubject.
+type PlanDefinitionSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v PlanDefinitionSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v PlanDefinitionSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v PlanDefinitionSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v PlanDefinitionSubjectCodeableConcept) isPlanDefinitionSubject() {}
+
+// PlanDefinitionSubjectReference is the Reference variant of PlanDefinitionSubject.
+type PlanDefinitionSubjectReference struct {
+	Reference
+}
+
+func (v PlanDefinitionSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v PlanDefinitionSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v PlanDefinitionSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v PlanDefinitionSubjectReference) isPlanDefinitionSubject() {}
+
+// PlanDefinitionSubjectCanonical is the canonical variant of PlanDefinitionSubject.
+type PlanDefinitionSubjectCanonical string
+
+func (v PlanDefinitionSubjectCanonical) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionSubjectCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionSubjectCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v PlanDefinitionSubjectCanonical) isPlanDefinitionSubject() {}
+
+var planDefinitionSubjectVariants = []PlanDefinitionSubject{
+	PlanDefinitionSubjectCodeableConcept{},
+	PlanDefinitionSubjectReference{},
+	PlanDefinitionSubjectCanonical(""),
+}
+
+// PlanDefinitionAsNeeded is the type of PlanDefinition.asNeeded[x]. It is implemented by the PlanDefinitionAsNeeded* variant types.
+type PlanDefinitionAsNeeded interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionAsNeeded()
+}
+
+// PlanDefinitionAsNeededBoolean is the boolean variant of PlanDefinitionAsNeeded.
+type PlanDefinitionAsNeededBoolean bool
+
+func (v PlanDefinitionAsNeededBoolean) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionAsNeededBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionAsNeededBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PlanDefinitionAsNeededBoolean) isPlanDefinitionAsNeeded() {}
+
+// PlanDefinitionAsNeededCodeableConcept is the CodeableConcept variant of PlanDefinitionAsNeeded.
+type PlanDefinitionAsNeededCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v PlanDefinitionAsNeededCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v PlanDefinitionAsNeededCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v PlanDefinitionAsNeededCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v PlanDefinitionAsNeededCodeableConcept) isPlanDefinitionAsNeeded() {}
+
+var planDefinitionAsNeededVariants = []PlanDefinitionAsNeeded{
+	PlanDefinitionAsNeededBoolean(false),
+	PlanDefinitionAsNeededCodeableConcept{},
+}
+
+func (r *PlanDefinition) GetResourceType() string {
+	return "PlanDefinition"
+}
+
+func (r *PlanDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *PlanDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *PlanDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *PlanDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *PlanDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *PlanDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *PlanDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*PlanDefinition)(nil)
+
+type PlanDefinitionGoal struct {
+	Id                *string                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Category          *CodeableConcept           `json:"category,omitempty" bson:"category,omitempty"`                    // E.g. Treatment, dietary, behavioral
+	Description       *CodeableConcept           `json:"description" bson:"description"`                                  // Code or text describing the goal
+	Priority          *CodeableConcept           `json:"priority,omitempty" bson:"priority,omitempty"`                    // high-priority | medium-priority | low-priority
+	Start             *CodeableConcept           `json:"start,omitempty" bson:"start,omitempty"`                          // When goal pursuit begins
+	Addresses         []CodeableConcept          `json:"addresses,omitempty" bson:"addresses,omitempty"`                  // What does the goal address
+	Documentation     []RelatedArtifact          `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Supporting documentation for the goal
+	Target            []PlanDefinitionGoalTarget `json:"target,omitempty" bson:"target,omitempty"`                        // Target outcome for the goal
+}
+
+func (r *PlanDefinitionGoal) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Category != nil {
+		if err := r.Category.Validate(); err != nil {
+			return fmt.Errorf("Category: %w", err)
+		}
+	}
+	if r.Description == nil {
+		return fmt.Errorf("field 'Description' is required")
+	}
+	if r.Description != nil {
+		if err := r.Description.Validate(); err != nil {
+			return fmt.Errorf("Description: %w", err)
+		}
+	}
+	if r.Priority != nil {
+		if err := r.Priority.Validate(); err != nil {
+			return fmt.Errorf("Priority: %w", err)
+		}
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			return fmt.Errorf("Start: %w", err)
+		}
+	}
+	for i, item := range r.Addresses {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Addresses[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Documentation {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Documentation[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Target {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Target[%d]: %w", i, err)
-		}
+	for i, item := range r.Target {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Target[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionGoal) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Category != nil {
+		r.Category.validateAll(path+".category", issues)
+	}
+	if r.Description == nil {
+		issues.add("required", path+".description", "field 'Description' is required")
+	}
+	if r.Description != nil {
+		r.Description.validateAll(path+".description", issues)
+	}
+	if r.Priority != nil {
+		r.Priority.validateAll(path+".priority", issues)
+	}
+	if r.Start != nil {
+		r.Start.validateAll(path+".start", issues)
+	}
+	for i, item := range r.Addresses {
+		item.validateAll(fmt.Sprintf("%s.addresses[%d]", path, i), issues)
+	}
+	for i, item := range r.Documentation {
+		item.validateAll(fmt.Sprintf("%s.documentation[%d]", path, i), issues)
+	}
+	for i, item := range r.Target {
+		item.validateAll(fmt.Sprintf("%s.target[%d]", path, i), issues)
+	}
+}
+
+var planDefinitionGoalInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionGoal) invariants() []invariant {
+	return planDefinitionGoalInvariants
+}
+
+func (r PlanDefinitionGoal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionGoal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionGoal) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionGoal) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var planDefinitionGoalElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.goal.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.goal.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.goal.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.goal.category", Name: "category", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.goal.description", Name: "description", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.goal.priority", Name: "priority", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.goal.start", Name: "start", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.goal.addresses", Name: "addresses", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.goal.documentation", Name: "documentation", Field: 8, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "PlanDefinition.goal.target", Name: "target", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *PlanDefinitionGoal) ElementMetadata() []ElementMetadata {
+	return planDefinitionGoalElementMetadata
+}
+
+type PlanDefinitionGoalTarget struct {
+	Id                *string                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Measure           *CodeableConcept               `json:"measure,omitempty" bson:"measure,omitempty"`                      // The parameter whose value is to be tracked
+	Detail            PlanDefinitionGoalTargetDetail `json:"-" bson:"detail,omitempty"`                                       // The target value to be achieved
+	DetailElement     *Element                       `json:"-" bson:"detail_element,omitempty"`                               // Extensions for detail[x]
+	Due               *Duration                      `json:"due,omitempty" bson:"due,omitempty"`                              // Reach goal within
+
+	detailVariants []string // JSON properties of PlanDefinition.goal.target.detail[x] when more than one was decoded
+}
+
+func (r *PlanDefinitionGoalTarget) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Measure != nil {
+		if err := r.Measure.Validate(); err != nil {
+			return fmt.Errorf("Measure: %w", err)
+		}
+	}
+	if len(r.detailVariants) > 1 {
+		return fmt.Errorf("field 'Detail' must have a single type, got %v", r.detailVariants)
+	}
+	if r.Detail != nil {
+		if err := r.Detail.Validate(); err != nil {
+			return fmt.Errorf("Detail: %w", err)
+		}
+	}
+	if r.DetailElement != nil {
+		if err := r.DetailElement.Validate(); err != nil {
+			return fmt.Errorf("DetailElement: %w", err)
+		}
+	}
+	if r.Due != nil {
+		if err := r.Due.Validate(); err != nil {
+			return fmt.Errorf("Due: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionGoalTarget) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Measure != nil {
+		r.Measure.validateAll(path+".measure", issues)
+	}
+	if len(r.detailVariants) > 1 {
+		issues.add("structure", path+".detail", fmt.Sprintf("field 'Detail' must have a single type, got %v", r.detailVariants))
+	}
+	if r.Detail != nil {
+		r.Detail.validateAll(path+".detail.ofType("+r.Detail.FHIRType()+")", issues)
+	}
+	if r.DetailElement != nil {
+		r.DetailElement.validateAll(path+".detail", issues)
+	}
+	if r.Due != nil {
+		r.Due.validateAll(path+".due", issues)
+	}
+}
+
+var planDefinitionGoalTargetInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionGoalTarget) invariants() []invariant {
+	return planDefinitionGoalTargetInvariants
+}
+
+func (r PlanDefinitionGoalTarget) MarshalJSON() ([]byte, error) {
+	type alias PlanDefinitionGoalTarget
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "detail", r.Detail, r.DetailElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PlanDefinitionGoalTarget) UnmarshalJSON(data []byte) error {
+	type alias PlanDefinitionGoalTarget
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Detail, element, r.detailVariants, err = unmarshalChoice(fields, "detail", planDefinitionGoalTargetDetailVariants); err != nil {
+		return err
+	}
+	r.DetailElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DetailElement); err != nil {
+			return fmt.Errorf("_detail: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r PlanDefinitionGoalTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionGoalTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionGoalTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionGoalTarget) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PlanDefinitionGoalTarget) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Detail":
+		return "detail", choiceValues(planDefinitionGoalTargetDetailVariants)
+	}
+	return "", nil
+}
+
+var planDefinitionGoalTargetElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.goal.target.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.goal.target.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.goal.target.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.goal.target.measure", Name: "measure", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.goal.target.detail[x]", Name: "detail", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity", "Range", "CodeableConcept", "string", "boolean", "integer", "Ratio"}},
+	{Path: "PlanDefinition.goal.target.due", Name: "due", Field: 6, Min: 0, Max: "1", Types: []string{"Duration"}},
+}
+
+func (r *PlanDefinitionGoalTarget) ElementMetadata() []ElementMetadata {
+	return planDefinitionGoalTargetElementMetadata
+}
+
+// PlanDefinitionGoalTargetDetail is the type of PlanDefinition.goal.target.detail[x]. It is implemented by the PlanDefinitionGoalTargetDetail* variant types.
+type PlanDefinitionGoalTargetDetail interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionGoalTargetDetail()
+}
+
+// PlanDefinitionGoalTargetDetailQuantity is the Quantity variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailQuantity struct {
+	Quantity
+}
+
+func (v PlanDefinitionGoalTargetDetailQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v PlanDefinitionGoalTargetDetailQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v PlanDefinitionGoalTargetDetailQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v PlanDefinitionGoalTargetDetailQuantity) isPlanDefinitionGoalTargetDetail() {}
+
+// PlanDefinitionGoalTargetDetailRange is the Range variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailRange struct {
+	Range
+}
+
+func (v PlanDefinitionGoalTargetDetailRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v PlanDefinitionGoalTargetDetailRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v PlanDefinitionGoalTargetDetailRange) FHIRType() string {
+	return "Range"
+}
+
+func (v PlanDefinitionGoalTargetDetailRange) isPlanDefinitionGoalTargetDetail() {}
+
+// PlanDefinitionGoalTargetDetailCodeableConcept is the CodeableConcept variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v PlanDefinitionGoalTargetDetailCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v PlanDefinitionGoalTargetDetailCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v PlanDefinitionGoalTargetDetailCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v PlanDefinitionGoalTargetDetailCodeableConcept) isPlanDefinitionGoalTargetDetail() {}
+
+// PlanDefinitionGoalTargetDetailString is the string variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailString string
+
+func (v PlanDefinitionGoalTargetDetailString) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionGoalTargetDetailString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionGoalTargetDetailString) FHIRType() string {
+	return "string"
+}
+
+func (v PlanDefinitionGoalTargetDetailString) isPlanDefinitionGoalTargetDetail() {}
+
+// PlanDefinitionGoalTargetDetailBoolean is the boolean variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailBoolean bool
+
+func (v PlanDefinitionGoalTargetDetailBoolean) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionGoalTargetDetailBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionGoalTargetDetailBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PlanDefinitionGoalTargetDetailBoolean) isPlanDefinitionGoalTargetDetail() {}
+
+// PlanDefinitionGoalTargetDetailInteger is the integer variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailInteger int
+
+func (v PlanDefinitionGoalTargetDetailInteger) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionGoalTargetDetailInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionGoalTargetDetailInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v PlanDefinitionGoalTargetDetailInteger) isPlanDefinitionGoalTargetDetail() {}
+
+// PlanDefinitionGoalTargetDetailRatio is the Ratio variant of PlanDefinitionGoalTargetDetail.
+type PlanDefinitionGoalTargetDetailRatio struct {
+	Ratio
+}
+
+func (v PlanDefinitionGoalTargetDetailRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v PlanDefinitionGoalTargetDetailRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v PlanDefinitionGoalTargetDetailRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v PlanDefinitionGoalTargetDetailRatio) isPlanDefinitionGoalTargetDetail() {}
+
+var planDefinitionGoalTargetDetailVariants = []PlanDefinitionGoalTargetDetail{
+	PlanDefinitionGoalTargetDetailQuantity{},
+	PlanDefinitionGoalTargetDetailRange{},
+	PlanDefinitionGoalTargetDetailCodeableConcept{},
+	PlanDefinitionGoalTargetDetailString(""),
+	PlanDefinitionGoalTargetDetailBoolean(false),
+	PlanDefinitionGoalTargetDetailInteger(0),
+	PlanDefinitionGoalTargetDetailRatio{},
+}
+
+type PlanDefinitionActor struct {
+	Id                 *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title              *string                     `json:"title,omitempty" bson:"title,omitempty"`                          // User-visible title
+	TitleElement       *Element                    `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Description        *string                     `json:"description,omitempty" bson:"description,omitempty"`              // Describes the actor
+	DescriptionElement *Element                    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Option             []PlanDefinitionActorOption `json:"option" bson:"option"`                                            // Who or what can be this actor
+}
+
+func (r *PlanDefinitionActor) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if len(r.Option) < 1 {
+		return fmt.Errorf("field 'Option' must have at least 1 elements")
+	}
+	for i, item := range r.Option {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Option[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionActor) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if len(r.Option) < 1 {
+		issues.add("required", path+".option", "field 'Option' must have at least 1 elements")
+	}
+	for i, item := range r.Option {
+		item.validateAll(fmt.Sprintf("%s.option[%d]", path, i), issues)
+	}
+}
+
+var planDefinitionActorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActor) invariants() []invariant {
+	return planDefinitionActorInvariants
+}
+
+func (r PlanDefinitionActor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActor) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var planDefinitionActorElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *PlanDefinitionActor) elementTypes() map[string]string {
+	return planDefinitionActorElementTypes
+}
+
+var planDefinitionActorElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.actor.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.actor.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.actor.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.actor.title", Name: "title", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.actor.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.actor.option", Name: "option", Field: 7, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *PlanDefinitionActor) ElementMetadata() []ElementMetadata {
+	return planDefinitionActorElementMetadata
+}
+
+type PlanDefinitionActorOption struct {
+	Id                *string                             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *ActionParticipantType              `json:"type,omitempty" bson:"type,omitempty"`                            // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
+	TypeElement       *Element                            `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	TypeChoice        PlanDefinitionActorOptionTypeChoice `json:"-" bson:"type_choice,omitempty"`                                  // Who or what can participate
+	TypeChoiceElement *Element                            `json:"-" bson:"type_choice_element,omitempty"`                          // Extensions for type[x]
+	Role              *CodeableConcept                    `json:"role,omitempty" bson:"role,omitempty"`                            // E.g. Nurse, Surgeon, Parent
+
+	typeChoiceVariants []string // JSON properties of PlanDefinition.actor.option.type[x] when more than one was decoded
+}
+
+func (r *PlanDefinitionActorOption) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		return fmt.Errorf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants)
+	}
+	if r.TypeChoice != nil {
+		if err := r.TypeChoice.Validate(); err != nil {
+			return fmt.Errorf("TypeChoice: %w", err)
+		}
+	}
+	if r.TypeChoiceElement != nil {
+		if err := r.TypeChoiceElement.Validate(); err != nil {
+			return fmt.Errorf("TypeChoiceElement: %w", err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionActorOption) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		issues.add("structure", path+".type", fmt.Sprintf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants))
+	}
+	if r.TypeChoice != nil {
+		r.TypeChoice.validateAll(path+".type.ofType("+r.TypeChoice.FHIRType()+")", issues)
+	}
+	if r.TypeChoiceElement != nil {
+		r.TypeChoiceElement.validateAll(path+".type", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+}
+
+var planDefinitionActorOptionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActorOption) invariants() []invariant {
+	return planDefinitionActorOptionInvariants
+}
+
+func (r PlanDefinitionActorOption) MarshalJSON() ([]byte, error) {
+	type alias PlanDefinitionActorOption
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "type", r.TypeChoice, r.TypeChoiceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PlanDefinitionActorOption) UnmarshalJSON(data []byte) error {
+	type alias PlanDefinitionActorOption
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.TypeChoice, element, r.typeChoiceVariants, err = unmarshalChoice(fields, "type", planDefinitionActorOptionTypeChoiceVariants); err != nil {
+		return err
+	}
+	r.TypeChoiceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TypeChoiceElement); err != nil {
+			return fmt.Errorf("_type: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r PlanDefinitionActorOption) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActorOption) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActorOption) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActorOption) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PlanDefinitionActorOption) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "TypeChoice":
+		return "type", choiceValues(planDefinitionActorOptionTypeChoiceVariants)
+	}
+	return "", nil
+}
+
+var planDefinitionActorOptionElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.actor.option.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.actor.option.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.actor.option.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.actor.option.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.actor.option.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"}},
+	{Path: "PlanDefinition.actor.option.type[x]", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"canonical", "Reference"}},
+	{Path: "PlanDefinition.actor.option.role", Name: "role", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *PlanDefinitionActorOption) ElementMetadata() []ElementMetadata {
+	return planDefinitionActorOptionElementMetadata
+}
+
+// PlanDefinitionActorOptionTypeChoice is the type of PlanDefinition.actor.option.type[x]. It is implemented by the PlanDefinitionActorOptionTypeChoice* variant types.
+type PlanDefinitionActorOptionTypeChoice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionActorOptionTypeChoice()
+}
+
+// PlanDefinitionActorOptionTypeChoiceCanonical is the canonical variant of PlanDefinitionActorOptionTypeChoice.
+type PlanDefinitionActorOptionTypeChoiceCanonical string
+
+func (v PlanDefinitionActorOptionTypeChoiceCanonical) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceCanonical) isPlanDefinitionActorOptionTypeChoice() {}
+
+// PlanDefinitionActorOptionTypeChoiceReference is the Reference variant of PlanDefinitionActorOptionTypeChoice.
+type PlanDefinitionActorOptionTypeChoiceReference struct {
+	Reference
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v PlanDefinitionActorOptionTypeChoiceReference) isPlanDefinitionActorOptionTypeChoice() {}
+
+var planDefinitionActorOptionTypeChoiceVariants = []PlanDefinitionActorOptionTypeChoice{
+	PlanDefinitionActorOptionTypeChoiceCanonical(""),
+	PlanDefinitionActorOptionTypeChoiceReference{},
+}
+
+type PlanDefinitionAction struct {
+	Id                           *string                             `json:"id,omitempty" bson:"id,omitempty"`                                                 // Unique id for inter-element referencing
+	Extension                    []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                                   // Additional content defined by implementations
+	ModifierExtension            []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                  // Extensions that cannot be ignored even if unrecognized
+	LinkId                       *string                             `json:"linkId,omitempty" bson:"link_id,omitempty"`                                        // Unique id for the action in the PlanDefinition
+	LinkIdElement                *Element                            `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                               // Extensions for linkId
+	Prefix                       *string                             `json:"prefix,omitempty" bson:"prefix,omitempty"`                                         // User-visible prefix for the action (e.g. 1. or A.)
+	PrefixElement                *Element                            `json:"_prefix,omitempty" bson:"prefix_element,omitempty"`                                // Extensions for prefix
+	Title                        *string                             `json:"title,omitempty" bson:"title,omitempty"`                                           // User-visible title
+	TitleElement                 *Element                            `json:"_title,omitempty" bson:"title_element,omitempty"`                                  // Extensions for title
+	Description                  *string                             `json:"description,omitempty" bson:"description,omitempty"`                               // Brief description of the action
+	DescriptionElement           *Element                            `json:"_description,omitempty" bson:"description_element,omitempty"`                      // Extensions for description
+	TextEquivalent               *string                             `json:"textEquivalent,omitempty" bson:"text_equivalent,omitempty"`                        // Static text equivalent of the action, used if the dynamic aspects cannot be interpreted by the receiving system
+	TextEquivalentElement        *Element                            `json:"_textEquivalent,omitempty" bson:"text_equivalent_element,omitempty"`               // Extensions for textEquivalent
+	Priority                     *RequestPriority                    `json:"priority,omitempty" bson:"priority,omitempty"`                                     // routine | urgent | asap | stat
+	PriorityElement              *Element                            `json:"_priority,omitempty" bson:"priority_element,omitempty"`                            // Extensions for priority
+	Code                         *CodeableConcept                    `json:"code,omitempty" bson:"code,omitempty"`                                             // Code representing the meaning of the action or sub-actions
+	Reason                       []CodeableConcept                   `json:"reason,omitempty" bson:"reason,omitempty"`                                         // Why the action should be performed
+	Documentation                []RelatedArtifact                   `json:"documentation,omitempty" bson:"documentation,omitempty"`                           // Supporting documentation for the intended performer of the action
+	GoalId                       []string                            `json:"goalId,omitempty" bson:"goal_id,omitempty"`                                        // What goals this action supports
+	GoalIdElement                []*Element                          `json:"_goalId,omitempty" bson:"goal_id_element,omitempty"`                               // Extensions for goalId
+	Subject                      PlanDefinitionActionSubject         `json:"-" bson:"subject,omitempty"`                                                       // Type of individual the action is focused on
+	SubjectElement               *Element                            `json:"-" bson:"subject_element,omitempty"`                                               // Extensions for subject[x]
+	Trigger                      []TriggerDefinition                 `json:"trigger,omitempty" bson:"trigger,omitempty"`                                       // When the action should be triggered
+	Condition                    []PlanDefinitionActionCondition     `json:"condition,omitempty" bson:"condition,omitempty"`                                   // Whether or not the action is applicable
+	Input                        []PlanDefinitionActionInput         `json:"input,omitempty" bson:"input,omitempty"`                                           // Input data requirements
+	Output                       []PlanDefinitionActionOutput        `json:"output,omitempty" bson:"output,omitempty"`                                         // Output data definition
+	RelatedAction                []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty" bson:"related_action,omitempty"`                          // Relationship to another action
+	Timing                       PlanDefinitionActionTiming          `json:"-" bson:"timing,omitempty"`                                                        // When the action should take place
+	Location                     *CodeableReference                  `json:"location,omitempty" bson:"location,omitempty"`                                     // Where it should happen
+	Participant                  []PlanDefinitionActionParticipant   `json:"participant,omitempty" bson:"participant,omitempty"`                               // Who should participate in the action
+	Type                         *CodeableConcept                    `json:"type,omitempty" bson:"type,omitempty"`                                             // create | update | remove | fire-event | etc.
+	ApplicabilityBehavior        *ActionApplicabilityBehavior        `json:"applicabilityBehavior,omitempty" bson:"applicability_behavior,omitempty"`          // all | any
+	ApplicabilityBehaviorElement *Element                            `json:"_applicabilityBehavior,omitempty" bson:"applicability_behavior_element,omitempty"` // Extensions for applicabilityBehavior
+	GroupingBehavior             *ActionGroupingBehavior             `json:"groupingBehavior,omitempty" bson:"grouping_behavior,omitempty"`                    // visual-group | logical-group | sentence-group
+	GroupingBehaviorElement      *Element                            `json:"_groupingBehavior,omitempty" bson:"grouping_behavior_element,omitempty"`           // Extensions for groupingBehavior
+	SelectionBehavior            *ActionSelectionBehavior            `json:"selectionBehavior,omitempty" bson:"selection_behavior,omitempty"`                  // any | all | all-or-none | exactly-one | at-most-one | one-or-more
+	SelectionBehaviorElement     *Element                            `json:"_selectionBehavior,omitempty" bson:"selection_behavior_element,omitempty"`         // Extensions for selectionBehavior
+	RequiredBehavior             *ActionRequiredBehavior             `json:"requiredBehavior,omitempty" bson:"required_behavior,omitempty"`                    // must | could | must-unless-documented
+	RequiredBehaviorElement      *Element                            `json:"_requiredBehavior,omitempty" bson:"required_behavior_element,omitempty"`           // Extensions for requiredBehavior
+	PrecheckBehavior             *ActionPrecheckBehavior             `json:"precheckBehavior,omitempty" bson:"precheck_behavior,omitempty"`                    // yes | no
+	PrecheckBehaviorElement      *Element                            `json:"_precheckBehavior,omitempty" bson:"precheck_behavior_element,omitempty"`           // Extensions for precheckBehavior
+	CardinalityBehavior          *ActionCardinalityBehavior          `json:"cardinalityBehavior,omitempty" bson:"cardinality_behavior,omitempty"`              // single | multiple
+	CardinalityBehaviorElement   *Element                            `json:"_cardinalityBehavior,omitempty" bson:"cardinality_behavior_element,omitempty"`     // Extensions for cardinalityBehavior
+	Definition                   PlanDefinitionActionDefinition      `json:"-" bson:"definition,omitempty"`                                                    // Description of the activity to be performed
+	DefinitionElement            *Element                            `json:"-" bson:"definition_element,omitempty"`                                            // Extensions for definition[x]
+	Transform                    *string                             `json:"transform,omitempty" bson:"transform,omitempty"`                                   // Transform to apply the template
+	TransformElement             *Element                            `json:"_transform,omitempty" bson:"transform_element,omitempty"`                          // Extensions for transform
+	DynamicValue                 []PlanDefinitionActionDynamicValue  `json:"dynamicValue,omitempty" bson:"dynamic_value,omitempty"`                            // Dynamic aspects of the definition
+	Action                       []PlanDefinitionAction              `json:"action,omitempty" bson:"action,omitempty"`                                         // A sub-action
+
+	subjectVariants    []string // JSON properties of PlanDefinition.action.subject[x] when more than one was decoded
+	timingVariants     []string // JSON properties of PlanDefinition.action.timing[x] when more than one was decoded
+	definitionVariants []string // JSON properties of PlanDefinition.action.definition[x] when more than one was decoded
+}
+
+func (r *PlanDefinitionAction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.PrefixElement != nil {
+		if err := r.PrefixElement.Validate(); err != nil {
+			return fmt.Errorf("PrefixElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.TextEquivalentElement != nil {
+		if err := r.TextEquivalentElement.Validate(); err != nil {
+			return fmt.Errorf("TextEquivalentElement: %w", err)
+		}
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	for i, item := range r.Reason {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Reason[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Documentation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Documentation[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.GoalIdElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("GoalIdElement[%d]: %w", i, err)
+		}
+	}
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.SubjectElement != nil {
+		if err := r.SubjectElement.Validate(); err != nil {
+			return fmt.Errorf("SubjectElement: %w", err)
+		}
+	}
+	for i, item := range r.Trigger {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Trigger[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Condition {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Condition[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Input {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Input[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Output {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Output[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.RelatedAction {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RelatedAction[%d]: %w", i, err)
+		}
+	}
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
+	}
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
+		}
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return fmt.Errorf("Location: %w", err)
+		}
+	}
+	for i, item := range r.Participant {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Participant[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.ApplicabilityBehavior != nil && !r.ApplicabilityBehavior.IsValid() {
+		return fmt.Errorf("field 'ApplicabilityBehavior' has invalid code '%s'", *r.ApplicabilityBehavior)
+	}
+	if r.ApplicabilityBehaviorElement != nil {
+		if err := r.ApplicabilityBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("ApplicabilityBehaviorElement: %w", err)
+		}
+	}
+	if r.GroupingBehavior != nil && !r.GroupingBehavior.IsValid() {
+		return fmt.Errorf("field 'GroupingBehavior' has invalid code '%s'", *r.GroupingBehavior)
+	}
+	if r.GroupingBehaviorElement != nil {
+		if err := r.GroupingBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("GroupingBehaviorElement: %w", err)
+		}
+	}
+	if r.SelectionBehavior != nil && !r.SelectionBehavior.IsValid() {
+		return fmt.Errorf("field 'SelectionBehavior' has invalid code '%s'", *r.SelectionBehavior)
+	}
+	if r.SelectionBehaviorElement != nil {
+		if err := r.SelectionBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("SelectionBehaviorElement: %w", err)
+		}
+	}
+	if r.RequiredBehavior != nil && !r.RequiredBehavior.IsValid() {
+		return fmt.Errorf("field 'RequiredBehavior' has invalid code '%s'", *r.RequiredBehavior)
+	}
+	if r.RequiredBehaviorElement != nil {
+		if err := r.RequiredBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("RequiredBehaviorElement: %w", err)
+		}
+	}
+	if r.PrecheckBehavior != nil && !r.PrecheckBehavior.IsValid() {
+		return fmt.Errorf("field 'PrecheckBehavior' has invalid code '%s'", *r.PrecheckBehavior)
+	}
+	if r.PrecheckBehaviorElement != nil {
+		if err := r.PrecheckBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("PrecheckBehaviorElement: %w", err)
+		}
+	}
+	if r.CardinalityBehavior != nil && !r.CardinalityBehavior.IsValid() {
+		return fmt.Errorf("field 'CardinalityBehavior' has invalid code '%s'", *r.CardinalityBehavior)
+	}
+	if r.CardinalityBehaviorElement != nil {
+		if err := r.CardinalityBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("CardinalityBehaviorElement: %w", err)
+		}
+	}
+	if len(r.definitionVariants) > 1 {
+		return fmt.Errorf("field 'Definition' must have a single type, got %v", r.definitionVariants)
+	}
+	if r.Definition != nil {
+		if err := r.Definition.Validate(); err != nil {
+			return fmt.Errorf("Definition: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	if r.TransformElement != nil {
+		if err := r.TransformElement.Validate(); err != nil {
+			return fmt.Errorf("TransformElement: %w", err)
+		}
+	}
+	for i, item := range r.DynamicValue {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DynamicValue[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Action {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Action[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionAction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	if r.PrefixElement != nil {
+		r.PrefixElement.validateAll(path+".prefix", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.TextEquivalentElement != nil {
+		r.TextEquivalentElement.validateAll(path+".textEquivalent", issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Documentation {
+		item.validateAll(fmt.Sprintf("%s.documentation[%d]", path, i), issues)
+	}
+	for i, item := range r.GoalIdElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.goalId[%d]", path, i), issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.SubjectElement != nil {
+		r.SubjectElement.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.Trigger {
+		item.validateAll(fmt.Sprintf("%s.trigger[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Input {
+		item.validateAll(fmt.Sprintf("%s.input[%d]", path, i), issues)
+	}
+	for i, item := range r.Output {
+		item.validateAll(fmt.Sprintf("%s.output[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedAction {
+		item.validateAll(fmt.Sprintf("%s.relatedAction[%d]", path, i), issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.ApplicabilityBehavior != nil && !r.ApplicabilityBehavior.IsValid() {
+		issues.add("code-invalid", path+".applicabilityBehavior", fmt.Sprintf("field 'ApplicabilityBehavior' has invalid code '%s'", *r.ApplicabilityBehavior))
+	}
+	if r.ApplicabilityBehaviorElement != nil {
+		r.ApplicabilityBehaviorElement.validateAll(path+".applicabilityBehavior", issues)
+	}
+	if r.GroupingBehavior != nil && !r.GroupingBehavior.IsValid() {
+		issues.add("code-invalid", path+".groupingBehavior", fmt.Sprintf("field 'GroupingBehavior' has invalid code '%s'", *r.GroupingBehavior))
+	}
+	if r.GroupingBehaviorElement != nil {
+		r.GroupingBehaviorElement.validateAll(path+".groupingBehavior", issues)
+	}
+	if r.SelectionBehavior != nil && !r.SelectionBehavior.IsValid() {
+		issues.add("code-invalid", path+".selectionBehavior", fmt.Sprintf("field 'SelectionBehavior' has invalid code '%s'", *r.SelectionBehavior))
+	}
+	if r.SelectionBehaviorElement != nil {
+		r.SelectionBehaviorElement.validateAll(path+".selectionBehavior", issues)
+	}
+	if r.RequiredBehavior != nil && !r.RequiredBehavior.IsValid() {
+		issues.add("code-invalid", path+".requiredBehavior", fmt.Sprintf("field 'RequiredBehavior' has invalid code '%s'", *r.RequiredBehavior))
+	}
+	if r.RequiredBehaviorElement != nil {
+		r.RequiredBehaviorElement.validateAll(path+".requiredBehavior", issues)
+	}
+	if r.PrecheckBehavior != nil && !r.PrecheckBehavior.IsValid() {
+		issues.add("code-invalid", path+".precheckBehavior", fmt.Sprintf("field 'PrecheckBehavior' has invalid code '%s'", *r.PrecheckBehavior))
+	}
+	if r.PrecheckBehaviorElement != nil {
+		r.PrecheckBehaviorElement.validateAll(path+".precheckBehavior", issues)
+	}
+	if r.CardinalityBehavior != nil && !r.CardinalityBehavior.IsValid() {
+		issues.add("code-invalid", path+".cardinalityBehavior", fmt.Sprintf("field 'CardinalityBehavior' has invalid code '%s'", *r.CardinalityBehavior))
+	}
+	if r.CardinalityBehaviorElement != nil {
+		r.CardinalityBehaviorElement.validateAll(path+".cardinalityBehavior", issues)
+	}
+	if len(r.definitionVariants) > 1 {
+		issues.add("structure", path+".definition", fmt.Sprintf("field 'Definition' must have a single type, got %v", r.definitionVariants))
+	}
+	if r.Definition != nil {
+		r.Definition.validateAll(path+".definition.ofType("+r.Definition.FHIRType()+")", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.TransformElement != nil {
+		r.TransformElement.validateAll(path+".transform", issues)
+	}
+	for i, item := range r.DynamicValue {
+		item.validateAll(fmt.Sprintf("%s.dynamicValue[%d]", path, i), issues)
+	}
+	for i, item := range r.Action {
+		item.validateAll(fmt.Sprintf("%s.action[%d]", path, i), issues)
+	}
+}
+
+var planDefinitionActionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionAction) invariants() []invariant {
+	return planDefinitionActionInvariants
+}
+
+func (r PlanDefinitionAction) MarshalJSON() ([]byte, error) {
+	type alias PlanDefinitionAction
+	out := struct {
+		alias
+		GoalId        []*string  `json:"goalId,omitempty"`
+		GoalIdElement []*Element `json:"_goalId,omitempty"`
+	}{alias: alias(r)}
+	out.GoalId, out.GoalIdElement = alignPrimitiveArray(r.GoalId, r.GoalIdElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, r.SubjectElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, nil); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PlanDefinitionAction) UnmarshalJSON(data []byte) error {
+	type alias PlanDefinitionAction
+	aux := struct {
+		*alias
+		GoalId []*string `json:"goalId,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.GoalId, err = splitPrimitiveArray(aux.GoalId, r.GoalIdElement); err != nil {
+		return fmt.Errorf("goalId: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Subject, element, r.subjectVariants, err = unmarshalChoice(fields, "subject", planDefinitionActionSubjectVariants); err != nil {
+		return err
+	}
+	r.SubjectElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.SubjectElement); err != nil {
+			return fmt.Errorf("_subject: %w", err)
+		}
+	}
+	if r.Timing, _, r.timingVariants, err = unmarshalChoice(fields, "timing", planDefinitionActionTimingVariants); err != nil {
+		return err
+	}
+	if r.Definition, element, r.definitionVariants, err = unmarshalChoice(fields, "definition", planDefinitionActionDefinitionVariants); err != nil {
+		return err
+	}
+	r.DefinitionElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DefinitionElement); err != nil {
+			return fmt.Errorf("_definition: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r PlanDefinitionAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionAction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PlanDefinitionAction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Subject":
+		return "subject", choiceValues(planDefinitionActionSubjectVariants)
+	case "Timing":
+		return "timing", choiceValues(planDefinitionActionTimingVariants)
+	case "Definition":
+		return "definition", choiceValues(planDefinitionActionDefinitionVariants)
+	}
+	return "", nil
+}
+
+var planDefinitionActionElementTypes = map[string]string{
+	"description":    "markdown",
+	"textEquivalent": "markdown",
+	"transform":      "canonical",
+}
+
+func (r *PlanDefinitionAction) elementTypes() map[string]string {
+	return planDefinitionActionElementTypes
+}
+
+var planDefinitionActionElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.prefix", Name: "prefix", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.title", Name: "title", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.description", Name: "description", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.action.textEquivalent", Name: "textEquivalent", Field: 11, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "PlanDefinition.action.priority", Name: "priority", Field: 13, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "PlanDefinition.action.code", Name: "code", Field: 15, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.action.reason", Name: "reason", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.action.documentation", Name: "documentation", Field: 17, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "PlanDefinition.action.goalId", Name: "goalId", Field: 18, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.subject[x]", Name: "subject", Field: 20, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "PlanDefinition.action.trigger", Name: "trigger", Field: 22, Min: 0, Max: "*", Types: []string{"TriggerDefinition"}},
+	{Path: "PlanDefinition.action.condition", Name: "condition", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action.input", Name: "input", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action.output", Name: "output", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action.relatedAction", Name: "relatedAction", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action.timing[x]", Name: "timing", Field: 27, Min: 0, Max: "1", Types: []string{"Age", "Duration", "Range", "Timing", "RelativeTime"}},
+	{Path: "PlanDefinition.action.location", Name: "location", Field: 28, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "PlanDefinition.action.participant", Name: "participant", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action.type", Name: "type", Field: 30, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.action.applicabilityBehavior", Name: "applicabilityBehavior", Field: 31, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.applicabilityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-applicability-behavior"}},
+	{Path: "PlanDefinition.action.groupingBehavior", Name: "groupingBehavior", Field: 33, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.groupingBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-grouping-behavior"}},
+	{Path: "PlanDefinition.action.selectionBehavior", Name: "selectionBehavior", Field: 35, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.selectionBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-selection-behavior"}},
+	{Path: "PlanDefinition.action.requiredBehavior", Name: "requiredBehavior", Field: 37, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.requiredBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-required-behavior"}},
+	{Path: "PlanDefinition.action.precheckBehavior", Name: "precheckBehavior", Field: 39, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.precheckBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-precheck-behavior"}},
+	{Path: "PlanDefinition.action.cardinalityBehavior", Name: "cardinalityBehavior", Field: 41, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.cardinalityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-cardinality-behavior"}},
+	{Path: "PlanDefinition.action.definition[x]", Name: "definition", Field: 43, Min: 0, Max: "1", Types: []string{"canonical", "uri"}},
+	{Path: "PlanDefinition.action.transform", Name: "transform", Field: 45, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "PlanDefinition.action.dynamicValue", Name: "dynamicValue", Field: 47, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "PlanDefinition.action.action", Name: "action", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *PlanDefinitionAction) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionElementMetadata
+}
+
+// PlanDefinitionActionSubject is the type of PlanDefinition.action.subject[x]. It is implemented by the PlanDefinitionActionSubject* variant types.
+type PlanDefinitionActionSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionActionSubject()
+}
+
+// PlanDefinitionActionSubjectCodeableConcept is the CodeableConcept variant of PlanDefinitionActionSubject.
+type PlanDefinitionActionSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v PlanDefinitionActionSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v PlanDefinitionActionSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v PlanDefinitionActionSubjectCodeableConcept) isPlanDefinitionActionSubject() {}
+
+// PlanDefinitionActionSubjectReference is the Reference variant of PlanDefinitionActionSubject.
+type PlanDefinitionActionSubjectReference struct {
+	Reference
+}
+
+func (v PlanDefinitionActionSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v PlanDefinitionActionSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v PlanDefinitionActionSubjectReference) isPlanDefinitionActionSubject() {}
+
+// PlanDefinitionActionSubjectCanonical is the canonical variant of PlanDefinitionActionSubject.
+type PlanDefinitionActionSubjectCanonical string
+
+func (v PlanDefinitionActionSubjectCanonical) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionActionSubjectCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionActionSubjectCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v PlanDefinitionActionSubjectCanonical) isPlanDefinitionActionSubject() {}
+
+var planDefinitionActionSubjectVariants = []PlanDefinitionActionSubject{
+	PlanDefinitionActionSubjectCodeableConcept{},
+	PlanDefinitionActionSubjectReference{},
+	PlanDefinitionActionSubjectCanonical(""),
+}
+
+// PlanDefinitionActionTiming is the type of PlanDefinition.action.timing[x]. It is implemented by the PlanDefinitionActionTiming* variant types.
+type PlanDefinitionActionTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionActionTiming()
+}
+
+// PlanDefinitionActionTimingAge is the Age variant of PlanDefinitionActionTiming.
+type PlanDefinitionActionTimingAge struct {
+	Age
+}
+
+func (v PlanDefinitionActionTimingAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v PlanDefinitionActionTimingAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionTimingAge) FHIRType() string {
+	return "Age"
+}
+
+func (v PlanDefinitionActionTimingAge) isPlanDefinitionActionTiming() {}
+
+// PlanDefinitionActionTimingDuration is the Duration variant of PlanDefinitionActionTiming.
+type PlanDefinitionActionTimingDuration struct {
+	Duration
+}
+
+func (v PlanDefinitionActionTimingDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v PlanDefinitionActionTimingDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionTimingDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v PlanDefinitionActionTimingDuration) isPlanDefinitionActionTiming() {}
+
+// PlanDefinitionActionTimingRange is the Range variant of PlanDefinitionActionTiming.
+type PlanDefinitionActionTimingRange struct {
+	Range
+}
+
+func (v PlanDefinitionActionTimingRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v PlanDefinitionActionTimingRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionTimingRange) FHIRType() string {
+	return "Range"
+}
+
+func (v PlanDefinitionActionTimingRange) isPlanDefinitionActionTiming() {}
+
+// PlanDefinitionActionTimingTiming is the Timing variant of PlanDefinitionActionTiming.
+type PlanDefinitionActionTimingTiming struct {
+	Timing
+}
+
+func (v PlanDefinitionActionTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v PlanDefinitionActionTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v PlanDefinitionActionTimingTiming) isPlanDefinitionActionTiming() {}
+
+// PlanDefinitionActionTimingRelativeTime is the RelativeTime variant of PlanDefinitionActionTiming.
+type PlanDefinitionActionTimingRelativeTime struct {
+	RelativeTime
+}
+
+func (v PlanDefinitionActionTimingRelativeTime) Validate() error {
+	return v.RelativeTime.Validate()
+}
+
+func (v PlanDefinitionActionTimingRelativeTime) validateAll(path string, issues *ValidationIssues) {
+	v.RelativeTime.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionTimingRelativeTime) FHIRType() string {
+	return "RelativeTime"
+}
+
+func (v PlanDefinitionActionTimingRelativeTime) isPlanDefinitionActionTiming() {}
+
+var planDefinitionActionTimingVariants = []PlanDefinitionActionTiming{
+	PlanDefinitionActionTimingAge{},
+	PlanDefinitionActionTimingDuration{},
+	PlanDefinitionActionTimingRange{},
+	PlanDefinitionActionTimingTiming{},
+	PlanDefinitionActionTimingRelativeTime{},
+}
+
+// PlanDefinitionActionDefinition is the type of PlanDefinition.action.definition[x]. It is implemented by the PlanDefinitionActionDefinition* variant types.
+type PlanDefinitionActionDefinition interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionActionDefinition()
+}
+
+// PlanDefinitionActionDefinitionCanonical is the canonical variant of PlanDefinitionActionDefinition.
+type PlanDefinitionActionDefinitionCanonical string
+
+func (v PlanDefinitionActionDefinitionCanonical) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionActionDefinitionCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionActionDefinitionCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v PlanDefinitionActionDefinitionCanonical) isPlanDefinitionActionDefinition() {}
+
+// PlanDefinitionActionDefinitionUri is the uri variant of PlanDefinitionActionDefinition.
+type PlanDefinitionActionDefinitionUri string
+
+func (v PlanDefinitionActionDefinitionUri) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionActionDefinitionUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PlanDefinitionActionDefinitionUri) FHIRType() string {
+	return "uri"
+}
+
+func (v PlanDefinitionActionDefinitionUri) isPlanDefinitionActionDefinition() {}
+
+var planDefinitionActionDefinitionVariants = []PlanDefinitionActionDefinition{
+	PlanDefinitionActionDefinitionCanonical(""),
+	PlanDefinitionActionDefinitionUri(""),
+}
+
+type PlanDefinitionActionCondition struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Kind              ActionConditionKind `json:"kind" bson:"kind"`                                                // applicability | start | stop
+	KindElement       *Element            `json:"_kind,omitempty" bson:"kind_element,omitempty"`                   // Extensions for kind
+	Expression        *Expression         `json:"expression,omitempty" bson:"expression,omitempty"`                // Boolean-valued expression
+}
+
+func (r *PlanDefinitionActionCondition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Kind == "" {
+		return fmt.Errorf("field 'Kind' is required")
+	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	if r.Expression != nil {
+		if err := r.Expression.Validate(); err != nil {
+			return fmt.Errorf("Expression: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionActionCondition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+}
+
+var planDefinitionActionConditionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActionCondition) invariants() []invariant {
+	return planDefinitionActionConditionInvariants
+}
+
+func (r PlanDefinitionActionCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActionCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActionCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActionCondition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var planDefinitionActionConditionElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.condition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.condition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.condition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.condition.kind", Name: "kind", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.condition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-condition-kind"}},
+	{Path: "PlanDefinition.action.condition.expression", Name: "expression", Field: 5, Min: 0, Max: "1", Types: []string{"Expression"}},
+}
+
+func (r *PlanDefinitionActionCondition) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionConditionElementMetadata
+}
+
+type PlanDefinitionActionInput struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title              *string          `json:"title,omitempty" bson:"title,omitempty"`                          // User-visible title
+	TitleElement       *Element         `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Requirement        *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`              // What data is provided
+	RelatedData        *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"`             // What data is provided
+	RelatedDataElement *Element         `json:"_relatedData,omitempty" bson:"related_data_element,omitempty"`    // Extensions for relatedData
+}
+
+func (r *PlanDefinitionActionInput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Requirement != nil {
+		if err := r.Requirement.Validate(); err != nil {
+			return fmt.Errorf("Requirement: %w", err)
+		}
+	}
+	if r.RelatedDataElement != nil {
+		if err := r.RelatedDataElement.Validate(); err != nil {
+			return fmt.Errorf("RelatedDataElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionActionInput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Requirement != nil {
+		r.Requirement.validateAll(path+".requirement", issues)
+	}
+	if r.RelatedDataElement != nil {
+		r.RelatedDataElement.validateAll(path+".relatedData", issues)
+	}
+}
+
+var planDefinitionActionInputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActionInput) invariants() []invariant {
+	return planDefinitionActionInputInvariants
+}
+
+func (r PlanDefinitionActionInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActionInput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActionInput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActionInput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var planDefinitionActionInputElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.input.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.input.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.input.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.input.title", Name: "title", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.input.requirement", Name: "requirement", Field: 5, Min: 0, Max: "1", Types: []string{"DataRequirement"}},
+	{Path: "PlanDefinition.action.input.relatedData", Name: "relatedData", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *PlanDefinitionActionInput) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionInputElementMetadata
+}
+
+type PlanDefinitionActionOutput struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title              *string          `json:"title,omitempty" bson:"title,omitempty"`                          // User-visible title
+	TitleElement       *Element         `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Requirement        *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`              // What data is provided
+	RelatedData        *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"`             // What data is provided
+	RelatedDataElement *Element         `json:"_relatedData,omitempty" bson:"related_data_element,omitempty"`    // Extensions for relatedData
+}
+
+func (r *PlanDefinitionActionOutput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Requirement != nil {
+		if err := r.Requirement.Validate(); err != nil {
+			return fmt.Errorf("Requirement: %w", err)
+		}
+	}
+	if r.RelatedDataElement != nil {
+		if err := r.RelatedDataElement.Validate(); err != nil {
+			return fmt.Errorf("RelatedDataElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *PlanDefinitionActionOutput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Requirement != nil {
+		r.Requirement.validateAll(path+".requirement", issues)
+	}
+	if r.RelatedDataElement != nil {
+		r.RelatedDataElement.validateAll(path+".relatedData", issues)
 	}
-	return nil
 }
 
-type PlanDefinitionActor struct {
-	Id          *string                     `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Title       *string                     `json:"title,omitempty" bson:"title,omitempty"`             // User-visible title
-	Description *string                     `json:"description,omitempty" bson:"description,omitempty"` // Describes the actor
-	Option      []PlanDefinitionActorOption `json:"option" bson:"option"`                               // Who or what can be this actor
+var planDefinitionActionOutputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *PlanDefinitionActor) Validate() error {
-	if len(r.Option) < 1 {
-		return fmt.Errorf("field 'Option' must have at least 1 elements")
-	}
-	for i, item := range r.Option {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Option[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *PlanDefinitionActionOutput) invariants() []invariant {
+	return planDefinitionActionOutputInvariants
 }
 
-type PlanDefinitionAction struct {
-	Id                     *string                             `json:"id,omitempty" bson:"id,omitempty"`                                           // Unique id for inter-element referencing
-	LinkId                 *string                             `json:"linkId,omitempty" bson:"link_id,omitempty"`                                  // Unique id for the action in the PlanDefinition
-	Prefix                 *string                             `json:"prefix,omitempty" bson:"prefix,omitempty"`                                   // User-visible prefix for the action (e.g. 1. or A.)
-	Title                  *string                             `json:"title,omitempty" bson:"title,omitempty"`                                     // User-visible title
-	Description            *string                             `json:"description,omitempty" bson:"description,omitempty"`                         // Brief description of the action
-	TextEquivalent         *string                             `json:"textEquivalent,omitempty" bson:"text_equivalent,omitempty"`                  // Static text equivalent of the action, used if the dynamic aspects cannot be interpreted by the receiving system
-	Priority               *string                             `json:"priority,omitempty" bson:"priority,omitempty"`                               // routine | urgent | asap | stat
-	Code                   *CodeableConcept                    `json:"code,omitempty" bson:"code,omitempty"`                                       // Code representing the meaning of the action or sub-actions
-	Reason                 []CodeableConcept                   `json:"reason,omitempty" bson:"reason,omitempty"`                                   // Why the action should be performed
-	Documentation          []RelatedArtifact                   `json:"documentation,omitempty" bson:"documentation,omitempty"`                     // Supporting documentation for the intended performer of the action
-	GoalId                 []string                            `json:"goalId,omitempty" bson:"goal_id,omitempty"`                                  // What goals this action supports
-	SubjectCodeableConcept *CodeableConcept                    `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"` // Type of individual the action is focused on
-	SubjectReference       *Reference                          `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`              // Type of individual the action is focused on
-	SubjectCanonical       *string                             `json:"subjectCanonical,omitempty" bson:"subject_canonical,omitempty"`              // Type of individual the action is focused on
-	Trigger                []TriggerDefinition                 `json:"trigger,omitempty" bson:"trigger,omitempty"`                                 // When the action should be triggered
-	Condition              []PlanDefinitionActionCondition     `json:"condition,omitempty" bson:"condition,omitempty"`                             // Whether or not the action is applicable
-	Input                  []PlanDefinitionActionInput         `json:"input,omitempty" bson:"input,omitempty"`                                     // Input data requirements
-	Output                 []PlanDefinitionActionOutput        `json:"output,omitempty" bson:"output,omitempty"`                                   // Output data definition
-	RelatedAction          []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty" bson:"related_action,omitempty"`                    // Relationship to another action
-	TimingAge              *Age                                `json:"timingAge,omitempty" bson:"timing_age,omitempty"`                            // When the action should take place
-	TimingDuration         *Duration                           `json:"timingDuration,omitempty" bson:"timing_duration,omitempty"`                  // When the action should take place
-	TimingRange            *Range                              `json:"timingRange,omitempty" bson:"timing_range,omitempty"`                        // When the action should take place
-	TimingTiming           *Timing                             `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`                      // When the action should take place
-	TimingRelativeTime     *RelativeTime                       `json:"timingRelativeTime,omitempty" bson:"timing_relative_time,omitempty"`         // When the action should take place
-	Location               *CodeableReference                  `json:"location,omitempty" bson:"location,omitempty"`                               // Where it should happen
-	Participant            []PlanDefinitionActionParticipant   `json:"participant,omitempty" bson:"participant,omitempty"`                         // Who should participate in the action
-	Type                   *CodeableConcept                    `json:"type,omitempty" bson:"type,omitempty"`                                       // create | update | remove | fire-event | etc.
-	ApplicabilityBehavior  *string                             `json:"applicabilityBehavior,omitempty" bson:"applicability_behavior,omitempty"`    // all | any
-	GroupingBehavior       *string                             `json:"groupingBehavior,omitempty" bson:"grouping_behavior,omitempty"`              // visual-group | logical-group | sentence-group
-	SelectionBehavior      *string                             `json:"selectionBehavior,omitempty" bson:"selection_behavior,omitempty"`            // any | all | all-or-none | exactly-one | at-most-one | one-or-more
-	RequiredBehavior       *string                             `json:"requiredBehavior,omitempty" bson:"required_behavior,omitempty"`              // must | could | must-unless-documented
-	PrecheckBehavior       *string                             `json:"precheckBehavior,omitempty" bson:"precheck_behavior,omitempty"`              // yes | no
-	CardinalityBehavior    *string                             `json:"cardinalityBehavior,omitempty" bson:"cardinality_behavior,omitempty"`        // single | multiple
-	DefinitionCanonical    *string                             `json:"definitionCanonical,omitempty" bson:"definition_canonical,omitempty"`        // Description of the activity to be performed
-	DefinitionUri          *string                             `json:"definitionUri,omitempty" bson:"definition_uri,omitempty"`                    // Description of the activity to be performed
-	Transform              *string                             `json:"transform,omitempty" bson:"transform,omitempty"`                             // Transform to apply the template
-	DynamicValue           []PlanDefinitionActionDynamicValue  `json:"dynamicValue,omitempty" bson:"dynamic_value,omitempty"`                      // Dynamic aspects of the definition
-	Action                 []PlanDefinitionAction              `json:"action,omitempty" bson:"action,omitempty"`                                   // A sub-action
+func (r PlanDefinitionActionOutput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *PlanDefinitionAction) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
-	for i, item := range r.Reason {
+func (r *PlanDefinitionActionOutput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActionOutput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActionOutput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var planDefinitionActionOutputElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.output.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.output.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.output.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.output.title", Name: "title", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.output.requirement", Name: "requirement", Field: 5, Min: 0, Max: "1", Types: []string{"DataRequirement"}},
+	{Path: "PlanDefinition.action.output.relatedData", Name: "relatedData", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *PlanDefinitionActionOutput) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionOutputElementMetadata
+}
+
+type PlanDefinitionActionRelatedAction struct {
+	Id                     *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	TargetId               string                                  `json:"targetId" bson:"target_id"`                                            // What action is this related to
+	TargetIdElement        *Element                                `json:"_targetId,omitempty" bson:"target_id_element,omitempty"`               // Extensions for targetId
+	Relationship           ActionRelationshipType                  `json:"relationship" bson:"relationship"`                                     // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
+	RelationshipElement    *Element                                `json:"_relationship,omitempty" bson:"relationship_element,omitempty"`        // Extensions for relationship
+	EndRelationship        *ActionRelationshipType                 `json:"endRelationship,omitempty" bson:"end_relationship,omitempty"`          // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
+	EndRelationshipElement *Element                                `json:"_endRelationship,omitempty" bson:"end_relationship_element,omitempty"` // Extensions for endRelationship
+	Offset                 PlanDefinitionActionRelatedActionOffset `json:"-" bson:"offset,omitempty"`                                            // Time offset for the relationship
+
+	offsetVariants []string // JSON properties of PlanDefinition.action.relatedAction.offset[x] when more than one was decoded
+}
+
+func (r *PlanDefinitionActionRelatedAction) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Reason[%d]: %w", i, err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Documentation {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Documentation[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	var emptyString string
+	if r.TargetId == emptyString {
+		return fmt.Errorf("field 'TargetId' is required")
+	}
+	if r.TargetIdElement != nil {
+		if err := r.TargetIdElement.Validate(); err != nil {
+			return fmt.Errorf("TargetIdElement: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if r.Relationship == "" {
+		return fmt.Errorf("field 'Relationship' is required")
+	}
+	if !r.Relationship.IsValid() {
+		return fmt.Errorf("field 'Relationship' has invalid code '%s'", r.Relationship)
+	}
+	if r.RelationshipElement != nil {
+		if err := r.RelationshipElement.Validate(); err != nil {
+			return fmt.Errorf("RelationshipElement: %w", err)
 		}
 	}
-	for i, item := range r.Trigger {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Trigger[%d]: %w", i, err)
+	if r.EndRelationship != nil && !r.EndRelationship.IsValid() {
+		return fmt.Errorf("field 'EndRelationship' has invalid code '%s'", *r.EndRelationship)
+	}
+	if r.EndRelationshipElement != nil {
+		if err := r.EndRelationshipElement.Validate(); err != nil {
+			return fmt.Errorf("EndRelationshipElement: %w", err)
 		}
 	}
-	for i, item := range r.Condition {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Condition[%d]: %w", i, err)
+	if len(r.offsetVariants) > 1 {
+		return fmt.Errorf("field 'Offset' must have a single type, got %v", r.offsetVariants)
+	}
+	if r.Offset != nil {
+		if err := r.Offset.Validate(); err != nil {
+			return fmt.Errorf("Offset: %w", err)
 		}
 	}
-	for i, item := range r.Input {
+	return nil
+}
+
+func (r *PlanDefinitionActionRelatedAction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.TargetId == emptyString {
+		issues.add("required", path+".targetId", "field 'TargetId' is required")
+	}
+	if r.TargetIdElement != nil {
+		r.TargetIdElement.validateAll(path+".targetId", issues)
+	}
+	if r.Relationship == "" {
+		issues.add("required", path+".relationship", "field 'Relationship' is required")
+	}
+	if r.Relationship != "" && !r.Relationship.IsValid() {
+		issues.add("code-invalid", path+".relationship", fmt.Sprintf("field 'Relationship' has invalid code '%s'", r.Relationship))
+	}
+	if r.RelationshipElement != nil {
+		r.RelationshipElement.validateAll(path+".relationship", issues)
+	}
+	if r.EndRelationship != nil && !r.EndRelationship.IsValid() {
+		issues.add("code-invalid", path+".endRelationship", fmt.Sprintf("field 'EndRelationship' has invalid code '%s'", *r.EndRelationship))
+	}
+	if r.EndRelationshipElement != nil {
+		r.EndRelationshipElement.validateAll(path+".endRelationship", issues)
+	}
+	if len(r.offsetVariants) > 1 {
+		issues.add("structure", path+".offset", fmt.Sprintf("field 'Offset' must have a single type, got %v", r.offsetVariants))
+	}
+	if r.Offset != nil {
+		r.Offset.validateAll(path+".offset.ofType("+r.Offset.FHIRType()+")", issues)
+	}
+}
+
+var planDefinitionActionRelatedActionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActionRelatedAction) invariants() []invariant {
+	return planDefinitionActionRelatedActionInvariants
+}
+
+func (r PlanDefinitionActionRelatedAction) MarshalJSON() ([]byte, error) {
+	type alias PlanDefinitionActionRelatedAction
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "offset", r.Offset, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PlanDefinitionActionRelatedAction) UnmarshalJSON(data []byte) error {
+	type alias PlanDefinitionActionRelatedAction
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Offset, _, r.offsetVariants, err = unmarshalChoice(fields, "offset", planDefinitionActionRelatedActionOffsetVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r PlanDefinitionActionRelatedAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActionRelatedAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActionRelatedAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActionRelatedAction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PlanDefinitionActionRelatedAction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Offset":
+		return "offset", choiceValues(planDefinitionActionRelatedActionOffsetVariants)
+	}
+	return "", nil
+}
+
+var planDefinitionActionRelatedActionElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.relatedAction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.relatedAction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.relatedAction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.relatedAction.targetId", Name: "targetId", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.relatedAction.relationship", Name: "relationship", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.relatedAction.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"}},
+	{Path: "PlanDefinition.action.relatedAction.endRelationship", Name: "endRelationship", Field: 7, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.relatedAction.endRelationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"}},
+	{Path: "PlanDefinition.action.relatedAction.offset[x]", Name: "offset", Field: 9, Min: 0, Max: "1", Types: []string{"Duration", "Range"}},
+}
+
+func (r *PlanDefinitionActionRelatedAction) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionRelatedActionElementMetadata
+}
+
+// PlanDefinitionActionRelatedActionOffset is the type of PlanDefinition.action.relatedAction.offset[x]. It is implemented by the PlanDefinitionActionRelatedActionOffset* variant types.
+type PlanDefinitionActionRelatedActionOffset interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionActionRelatedActionOffset()
+}
+
+// PlanDefinitionActionRelatedActionOffsetDuration is the Duration variant of PlanDefinitionActionRelatedActionOffset.
+type PlanDefinitionActionRelatedActionOffsetDuration struct {
+	Duration
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetDuration) isPlanDefinitionActionRelatedActionOffset() {
+}
+
+// PlanDefinitionActionRelatedActionOffsetRange is the Range variant of PlanDefinitionActionRelatedActionOffset.
+type PlanDefinitionActionRelatedActionOffsetRange struct {
+	Range
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetRange) FHIRType() string {
+	return "Range"
+}
+
+func (v PlanDefinitionActionRelatedActionOffsetRange) isPlanDefinitionActionRelatedActionOffset() {}
+
+var planDefinitionActionRelatedActionOffsetVariants = []PlanDefinitionActionRelatedActionOffset{
+	PlanDefinitionActionRelatedActionOffsetDuration{},
+	PlanDefinitionActionRelatedActionOffsetRange{},
+}
+
+type PlanDefinitionActionParticipant struct {
+	Id                *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	ActorId           *string                                   `json:"actorId,omitempty" bson:"actor_id,omitempty"`                     // What actor
+	ActorIdElement    *Element                                  `json:"_actorId,omitempty" bson:"actor_id_element,omitempty"`            // Extensions for actorId
+	Type              *ActionParticipantType                    `json:"type,omitempty" bson:"type,omitempty"`                            // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
+	TypeElement       *Element                                  `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	TypeChoice        PlanDefinitionActionParticipantTypeChoice `json:"-" bson:"type_choice,omitempty"`                                  // Who or what can participate
+	TypeChoiceElement *Element                                  `json:"-" bson:"type_choice_element,omitempty"`                          // Extensions for type[x]
+	Role              *CodeableConcept                          `json:"role,omitempty" bson:"role,omitempty"`                            // E.g. Nurse, Surgeon, Parent
+	Function          *CodeableConcept                          `json:"function,omitempty" bson:"function,omitempty"`                    // E.g. Author, Reviewer, Witness, etc
+
+	typeChoiceVariants []string // JSON properties of PlanDefinition.action.participant.type[x] when more than one was decoded
+}
+
+func (r *PlanDefinitionActionParticipant) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Input[%d]: %w", i, err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Output {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Output[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.RelatedAction {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("RelatedAction[%d]: %w", i, err)
+	if r.ActorIdElement != nil {
+		if err := r.ActorIdElement.Validate(); err != nil {
+			return fmt.Errorf("ActorIdElement: %w", err)
 		}
 	}
-	if r.TimingAge != nil {
-		if err := r.TimingAge.Validate(); err != nil {
-			return fmt.Errorf("TimingAge: %w", err)
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
 		}
 	}
-	if r.TimingDuration != nil {
-		if err := r.TimingDuration.Validate(); err != nil {
-			return fmt.Errorf("TimingDuration: %w", err)
+	if len(r.typeChoiceVariants) > 1 {
+		return fmt.Errorf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants)
+	}
+	if r.TypeChoice != nil {
+		if err := r.TypeChoice.Validate(); err != nil {
+			return fmt.Errorf("TypeChoice: %w", err)
 		}
 	}
-	if r.TimingRange != nil {
-		if err := r.TimingRange.Validate(); err != nil {
-			return fmt.Errorf("TimingRange: %w", err)
+	if r.TypeChoiceElement != nil {
+		if err := r.TypeChoiceElement.Validate(); err != nil {
+			return fmt.Errorf("TypeChoiceElement: %w", err)
 		}
 	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
 		}
 	}
-	if r.TimingRelativeTime != nil {
-		if err := r.TimingRelativeTime.Validate(); err != nil {
-			return fmt.Errorf("TimingRelativeTime: %w", err)
+	if r.Function != nil {
+		if err := r.Function.Validate(); err != nil {
+			return fmt.Errorf("Function: %w", err)
 		}
 	}
-	if r.Location != nil {
-		if err := r.Location.Validate(); err != nil {
-			return fmt.Errorf("Location: %w", err)
+	return nil
+}
+
+func (r *PlanDefinitionActionParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ActorIdElement != nil {
+		r.ActorIdElement.validateAll(path+".actorId", issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		issues.add("structure", path+".type", fmt.Sprintf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants))
+	}
+	if r.TypeChoice != nil {
+		r.TypeChoice.validateAll(path+".type.ofType("+r.TypeChoice.FHIRType()+")", issues)
+	}
+	if r.TypeChoiceElement != nil {
+		r.TypeChoiceElement.validateAll(path+".type", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+}
+
+var planDefinitionActionParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActionParticipant) invariants() []invariant {
+	return planDefinitionActionParticipantInvariants
+}
+
+func (r PlanDefinitionActionParticipant) MarshalJSON() ([]byte, error) {
+	type alias PlanDefinitionActionParticipant
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "type", r.TypeChoice, r.TypeChoiceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *PlanDefinitionActionParticipant) UnmarshalJSON(data []byte) error {
+	type alias PlanDefinitionActionParticipant
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.TypeChoice, element, r.typeChoiceVariants, err = unmarshalChoice(fields, "type", planDefinitionActionParticipantTypeChoiceVariants); err != nil {
+		return err
+	}
+	r.TypeChoiceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TypeChoiceElement); err != nil {
+			return fmt.Errorf("_type: %w", err)
 		}
 	}
-	for i, item := range r.Participant {
+	return nil
+}
+
+func (r PlanDefinitionActionParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActionParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActionParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActionParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *PlanDefinitionActionParticipant) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "TypeChoice":
+		return "type", choiceValues(planDefinitionActionParticipantTypeChoiceVariants)
+	}
+	return "", nil
+}
+
+var planDefinitionActionParticipantElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.participant.actorId", Name: "actorId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.participant.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PlanDefinition.action.participant.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"}},
+	{Path: "PlanDefinition.action.participant.type[x]", Name: "type", Field: 7, Min: 0, Max: "1", Types: []string{"canonical", "Reference"}},
+	{Path: "PlanDefinition.action.participant.role", Name: "role", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "PlanDefinition.action.participant.function", Name: "function", Field: 10, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *PlanDefinitionActionParticipant) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionParticipantElementMetadata
+}
+
+// PlanDefinitionActionParticipantTypeChoice is the type of PlanDefinition.action.participant.type[x]. It is implemented by the PlanDefinitionActionParticipantTypeChoice* variant types.
+type PlanDefinitionActionParticipantTypeChoice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPlanDefinitionActionParticipantTypeChoice()
+}
+
+// PlanDefinitionActionParticipantTypeChoiceCanonical is the canonical variant of PlanDefinitionActionParticipantTypeChoice.
+type PlanDefinitionActionParticipantTypeChoiceCanonical string
+
+func (v PlanDefinitionActionParticipantTypeChoiceCanonical) Validate() error {
+	return nil
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceCanonical) isPlanDefinitionActionParticipantTypeChoice() {
+}
+
+// PlanDefinitionActionParticipantTypeChoiceReference is the Reference variant of PlanDefinitionActionParticipantTypeChoice.
+type PlanDefinitionActionParticipantTypeChoiceReference struct {
+	Reference
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v PlanDefinitionActionParticipantTypeChoiceReference) isPlanDefinitionActionParticipantTypeChoice() {
+}
+
+var planDefinitionActionParticipantTypeChoiceVariants = []PlanDefinitionActionParticipantTypeChoice{
+	PlanDefinitionActionParticipantTypeChoiceCanonical(""),
+	PlanDefinitionActionParticipantTypeChoiceReference{},
+}
+
+type PlanDefinitionActionDynamicValue struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Path              *string     `json:"path,omitempty" bson:"path,omitempty"`                            // The path to the element to be set dynamically
+	PathElement       *Element    `json:"_path,omitempty" bson:"path_element,omitempty"`                   // Extensions for path
+	Expression        *Expression `json:"expression,omitempty" bson:"expression,omitempty"`                // An expression that provides the dynamic value for the customization
+}
+
+func (r *PlanDefinitionActionDynamicValue) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Participant[%d]: %w", i, err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.DynamicValue {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("DynamicValue[%d]: %w", i, err)
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
 		}
 	}
-	for i, item := range r.Action {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Action[%d]: %w", i, err)
+	if r.Expression != nil {
+		if err := r.Expression.Validate(); err != nil {
+			return fmt.Errorf("Expression: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r *PlanDefinitionActionDynamicValue) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+}
+
+var planDefinitionActionDynamicValueInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PlanDefinitionActionDynamicValue) invariants() []invariant {
+	return planDefinitionActionDynamicValueInvariants
+}
+
+func (r PlanDefinitionActionDynamicValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PlanDefinitionActionDynamicValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PlanDefinitionActionDynamicValue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PlanDefinitionActionDynamicValue) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var planDefinitionActionDynamicValueElementMetadata = []ElementMetadata{
+	{Path: "PlanDefinition.action.dynamicValue.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.dynamicValue.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PlanDefinition.action.dynamicValue.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PlanDefinition.action.dynamicValue.path", Name: "path", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PlanDefinition.action.dynamicValue.expression", Name: "expression", Field: 5, Min: 0, Max: "1", Types: []string{"Expression"}},
+}
+
+func (r *PlanDefinitionActionDynamicValue) ElementMetadata() []ElementMetadata {
+	return planDefinitionActionDynamicValueElementMetadata
+}
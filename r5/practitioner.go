@@ -2,30 +2,40 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A person who is directly or indirectly involved in the provisioning of healthcare or related services.
 type Practitioner struct {
-	ResourceType     string                      `json:"resourceType" bson:"resource_type"`                              // Type of resource
-	Id               *string                     `json:"id,omitempty" bson:"id,omitempty"`                               // Logical id of this artifact
-	Meta             *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                           // Metadata about the resource
-	ImplicitRules    *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`        // A set of rules under which this content was created
-	Language         *string                     `json:"language,omitempty" bson:"language,omitempty"`                   // Language of the resource content
-	Text             *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                           // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`                 // Contained, inline Resources
-	Identifier       []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`               // An identifier for the person as this agent
-	Active           *bool                       `json:"active,omitempty" bson:"active,omitempty"`                       // Whether this practitioner's record is in active use
-	Name             []HumanName                 `json:"name,omitempty" bson:"name,omitempty"`                           // The name(s) associated with the practitioner
-	Telecom          []ContactPoint              `json:"telecom,omitempty" bson:"telecom,omitempty"`                     // A contact detail for the practitioner (that apply to all roles)
-	Gender           *string                     `json:"gender,omitempty" bson:"gender,omitempty"`                       // male | female | other | unknown
-	BirthDate        *string                     `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                // The date  on which the practitioner was born
-	DeceasedBoolean  *bool                       `json:"deceasedBoolean,omitempty" bson:"deceased_boolean,omitempty"`    // Indicates if the practitioner is deceased or not
-	DeceasedDateTime *string                     `json:"deceasedDateTime,omitempty" bson:"deceased_date_time,omitempty"` // Indicates if the practitioner is deceased or not
-	Address          []Address                   `json:"address,omitempty" bson:"address,omitempty"`                     // Address(es) of the practitioner that are not role specific (typically home address)
-	Photo            []Attachment                `json:"photo,omitempty" bson:"photo,omitempty"`                         // Image of the person
-	Qualification    []PractitionerQualification `json:"qualification,omitempty" bson:"qualification,omitempty"`         // Qualifications, certifications, accreditations, licenses, training, etc. pertaining to the provision of care
-	Communication    []PractitionerCommunication `json:"communication,omitempty" bson:"communication,omitempty"`         // A language which may be used to communicate with the practitioner
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // An identifier for the person as this agent
+	Active               *bool                       `json:"active,omitempty" bson:"active,omitempty"`                         // Whether this practitioner's record is in active use
+	ActiveElement        *Element                    `json:"_active,omitempty" bson:"active_element,omitempty"`                // Extensions for active
+	Name                 []HumanName                 `json:"name,omitempty" bson:"name,omitempty"`                             // The name(s) associated with the practitioner
+	Telecom              []ContactPoint              `json:"telecom,omitempty" bson:"telecom,omitempty"`                       // A contact detail for the practitioner (that apply to all roles)
+	Gender               *AdministrativeGender       `json:"gender,omitempty" bson:"gender,omitempty"`                         // male | female | other | unknown
+	GenderElement        *Element                    `json:"_gender,omitempty" bson:"gender_element,omitempty"`                // Extensions for gender
+	BirthDate            *Date                       `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                  // The date  on which the practitioner was born
+	BirthDateElement     *Element                    `json:"_birthDate,omitempty" bson:"birth_date_element,omitempty"`         // Extensions for birthDate
+	Deceased             PractitionerDeceased        `json:"-" bson:"deceased,omitempty"`                                      // Indicates if the practitioner is deceased or not
+	DeceasedElement      *Element                    `json:"-" bson:"deceased_element,omitempty"`                              // Extensions for deceased[x]
+	Address              []Address                   `json:"address,omitempty" bson:"address,omitempty"`                       // Address(es) of the practitioner that are not role specific (typically home address)
+	Photo                []Attachment                `json:"photo,omitempty" bson:"photo,omitempty"`                           // Image of the person
+	Qualification        []PractitionerQualification `json:"qualification,omitempty" bson:"qualification,omitempty"`           // Qualifications, certifications, accreditations, licenses, training, etc. pertaining to the provision of care
+	Communication        []PractitionerCommunication `json:"communication,omitempty" bson:"communication,omitempty"`           // A language which may be used to communicate with the practitioner
+
+	deceasedVariants []string // JSON properties of Practitioner.deceased[x] when more than one was decoded
 }
 
 func (r *Practitioner) Validate() error {
@@ -37,16 +47,49 @@ func (r *Practitioner) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	for i, item := range r.Name {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Name[%d]: %w", i, err)
@@ -57,6 +100,37 @@ func (r *Practitioner) Validate() error {
 			return fmt.Errorf("Telecom[%d]: %w", i, err)
 		}
 	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		return fmt.Errorf("field 'Gender' has invalid code '%s'", *r.Gender)
+	}
+	if r.GenderElement != nil {
+		if err := r.GenderElement.Validate(); err != nil {
+			return fmt.Errorf("GenderElement: %w", err)
+		}
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			return fmt.Errorf("BirthDate: %w", err)
+		}
+	}
+	if r.BirthDateElement != nil {
+		if err := r.BirthDateElement.Validate(); err != nil {
+			return fmt.Errorf("BirthDateElement: %w", err)
+		}
+	}
+	if len(r.deceasedVariants) > 1 {
+		return fmt.Errorf("field 'Deceased' must have a single type, got %v", r.deceasedVariants)
+	}
+	if r.Deceased != nil {
+		if err := r.Deceased.Validate(); err != nil {
+			return fmt.Errorf("Deceased: %w", err)
+		}
+	}
+	if r.DeceasedElement != nil {
+		if err := r.DeceasedElement.Validate(); err != nil {
+			return fmt.Errorf("DeceasedElement: %w", err)
+		}
+	}
 	for i, item := range r.Address {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Address[%d]: %w", i, err)
@@ -77,19 +151,327 @@ func (r *Practitioner) Validate() error {
 			return fmt.Errorf("Communication[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Practitioner")
+}
+
+func (r *Practitioner) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Practitioner" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Practitioner', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		issues.add("code-invalid", path+".gender", fmt.Sprintf("field 'Gender' has invalid code '%s'", *r.Gender))
+	}
+	if r.GenderElement != nil {
+		r.GenderElement.validateAll(path+".gender", issues)
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			issues.add("value", path+".birthDate", err.Error())
+		}
+	}
+	if r.BirthDateElement != nil {
+		r.BirthDateElement.validateAll(path+".birthDate", issues)
+	}
+	if len(r.deceasedVariants) > 1 {
+		issues.add("structure", path+".deceased", fmt.Sprintf("field 'Deceased' must have a single type, got %v", r.deceasedVariants))
+	}
+	if r.Deceased != nil {
+		r.Deceased.validateAll(path+".deceased.ofType("+r.Deceased.FHIRType()+")", issues)
+	}
+	if r.DeceasedElement != nil {
+		r.DeceasedElement.validateAll(path+".deceased", issues)
+	}
+	for i, item := range r.Address {
+		item.validateAll(fmt.Sprintf("%s.address[%d]", path, i), issues)
+	}
+	for i, item := range r.Photo {
+		item.validateAll(fmt.Sprintf("%s.photo[%d]", path, i), issues)
+	}
+	for i, item := range r.Qualification {
+		item.validateAll(fmt.Sprintf("%s.qualification[%d]", path, i), issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+}
+
+func (r *Practitioner) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Practitioner", &issues)
+	checkInvariants(r, "Practitioner", &issues)
+	return issues
+}
+
+var practitionerInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Practitioner) invariants() []invariant {
+	return practitionerInvariants
+}
+
+func (r Practitioner) MarshalJSON() ([]byte, error) {
+	type alias Practitioner
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "deceased", r.Deceased, r.DeceasedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Practitioner) UnmarshalJSON(data []byte) error {
+	type alias Practitioner
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Deceased, element, r.deceasedVariants, err = unmarshalChoice(fields, "deceased", practitionerDeceasedVariants); err != nil {
+		return err
+	}
+	r.DeceasedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeceasedElement); err != nil {
+			return fmt.Errorf("_deceased: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r Practitioner) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Practitioner) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Practitioner) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Practitioner) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Practitioner) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Deceased":
+		return "deceased", choiceValues(practitionerDeceasedVariants)
+	}
+	return "", nil
+}
+
+var practitionerElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Practitioner) elementTypes() map[string]string {
+	return practitionerElementTypes
+}
+
+var practitionerElementMetadata = []ElementMetadata{
+	{Path: "Practitioner.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Practitioner.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Practitioner.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Practitioner.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Practitioner.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Practitioner.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Practitioner.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Practitioner.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Practitioner.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Practitioner.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Practitioner.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Practitioner.name", Name: "name", Field: 14, Min: 0, Max: "*", Types: []string{"HumanName"}},
+	{Path: "Practitioner.telecom", Name: "telecom", Field: 15, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "Practitioner.gender", Name: "gender", Field: 16, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Practitioner.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"}},
+	{Path: "Practitioner.birthDate", Name: "birthDate", Field: 18, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Practitioner.deceased[x]", Name: "deceased", Field: 20, Min: 0, Max: "1", Types: []string{"boolean", "dateTime"}},
+	{Path: "Practitioner.address", Name: "address", Field: 22, Min: 0, Max: "*", Types: []string{"Address"}},
+	{Path: "Practitioner.photo", Name: "photo", Field: 23, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "Practitioner.qualification", Name: "qualification", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Practitioner.communication", Name: "communication", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Practitioner) ElementMetadata() []ElementMetadata {
+	return practitionerElementMetadata
+}
+
+// PractitionerDeceased is the type of Practitioner.deceased[x]. It is implemented by the PractitionerDeceased* variant types.
+type PractitionerDeceased interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isPractitionerDeceased()
+}
+
+// PractitionerDeceasedBoolean is the boolean variant of PractitionerDeceased.
+type PractitionerDeceasedBoolean bool
+
+func (v PractitionerDeceasedBoolean) Validate() error {
 	return nil
 }
 
+func (v PractitionerDeceasedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v PractitionerDeceasedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v PractitionerDeceasedBoolean) isPractitionerDeceased() {}
+
+// PractitionerDeceasedDateTime is the dateTime variant of PractitionerDeceased.
+type PractitionerDeceasedDateTime struct {
+	DateTime
+}
+
+func (v PractitionerDeceasedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v PractitionerDeceasedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v PractitionerDeceasedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v PractitionerDeceasedDateTime) isPractitionerDeceased() {}
+
+var practitionerDeceasedVariants = []PractitionerDeceased{
+	PractitionerDeceasedBoolean(false),
+	PractitionerDeceasedDateTime{},
+}
+
+func (r *Practitioner) GetResourceType() string {
+	return "Practitioner"
+}
+
+func (r *Practitioner) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Practitioner) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Practitioner) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Practitioner) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Practitioner) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Practitioner) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Practitioner) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Practitioner)(nil)
+
 type PractitionerQualification struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Identifier []Identifier     `json:"identifier,omitempty" bson:"identifier,omitempty"` // An identifier for this qualification for the practitioner
-	Code       *CodeableConcept `json:"code" bson:"code"`                                 // Coded representation of the qualification
-	Status     *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`         // Status/progress  of the qualification
-	Period     *Period          `json:"period,omitempty" bson:"period,omitempty"`         // Period during which the qualification is valid
-	Issuer     *Reference       `json:"issuer,omitempty" bson:"issuer,omitempty"`         // Organization that regulates and issues the qualification
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        []Identifier     `json:"identifier,omitempty" bson:"identifier,omitempty"`                // An identifier for this qualification for the practitioner
+	Code              *CodeableConcept `json:"code" bson:"code"`                                                // Coded representation of the qualification
+	Status            *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`                        // Status/progress  of the qualification
+	Period            *Period          `json:"period,omitempty" bson:"period,omitempty"`                        // Period during which the qualification is valid
+	Issuer            *Reference       `json:"issuer,omitempty" bson:"issuer,omitempty"`                        // Organization that regulates and issues the qualification
 }
 
 func (r *PractitionerQualification) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -121,13 +503,92 @@ func (r *PractitionerQualification) Validate() error {
 	return nil
 }
 
+func (r *PractitionerQualification) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Issuer != nil {
+		r.Issuer.validateAll(path+".issuer", issues)
+	}
+}
+
+var practitionerQualificationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PractitionerQualification) invariants() []invariant {
+	return practitionerQualificationInvariants
+}
+
+func (r PractitionerQualification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PractitionerQualification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PractitionerQualification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PractitionerQualification) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var practitionerQualificationElementMetadata = []ElementMetadata{
+	{Path: "Practitioner.qualification.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Practitioner.qualification.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Practitioner.qualification.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Practitioner.qualification.identifier", Name: "identifier", Field: 3, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Practitioner.qualification.code", Name: "code", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Practitioner.qualification.status", Name: "status", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Practitioner.qualification.period", Name: "period", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Practitioner.qualification.issuer", Name: "issuer", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *PractitionerQualification) ElementMetadata() []ElementMetadata {
+	return practitionerQualificationElementMetadata
+}
+
 type PractitionerCommunication struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Language  *CodeableConcept `json:"language" bson:"language"`                       // The language code used to communicate with the practitioner
-	Preferred *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"` // Language preference indicator
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Language          *CodeableConcept `json:"language" bson:"language"`                                        // The language code used to communicate with the practitioner
+	Preferred         *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"`                  // Language preference indicator
+	PreferredElement  *Element         `json:"_preferred,omitempty" bson:"preferred_element,omitempty"`         // Extensions for preferred
 }
 
 func (r *PractitionerCommunication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Language == nil {
 		return fmt.Errorf("field 'Language' is required")
 	}
@@ -136,5 +597,64 @@ func (r *PractitionerCommunication) Validate() error {
 			return fmt.Errorf("Language: %w", err)
 		}
 	}
+	if r.PreferredElement != nil {
+		if err := r.PreferredElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *PractitionerCommunication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Language == nil {
+		issues.add("required", path+".language", "field 'Language' is required")
+	}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
+	}
+	if r.PreferredElement != nil {
+		r.PreferredElement.validateAll(path+".preferred", issues)
+	}
+}
+
+var practitionerCommunicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PractitionerCommunication) invariants() []invariant {
+	return practitionerCommunicationInvariants
+}
+
+func (r PractitionerCommunication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PractitionerCommunication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PractitionerCommunication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PractitionerCommunication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var practitionerCommunicationElementMetadata = []ElementMetadata{
+	{Path: "Practitioner.communication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Practitioner.communication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Practitioner.communication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Practitioner.communication.language", Name: "language", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Practitioner.communication.preferred", Name: "preferred", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *PractitionerCommunication) ElementMetadata() []ElementMetadata {
+	return practitionerCommunicationElementMetadata
+}
@@ -2,36 +2,55 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A specific set of Roles/Locations/specialties/services that a practitioner may perform, or has performed at an organization during a period of time.
 type PractitionerRole struct {
-	ResourceType      string                  `json:"resourceType" bson:"resource_type"`                               // Type of resource
-	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Logical id of this artifact
-	Meta              *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                            // Metadata about the resource
-	ImplicitRules     *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`         // A set of rules under which this content was created
-	Language          *string                 `json:"language,omitempty" bson:"language,omitempty"`                    // Language of the resource content
-	Text              *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                            // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`                  // Contained, inline Resources
-	Identifier        []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Identifiers for a role/location
-	Active            *bool                   `json:"active,omitempty" bson:"active,omitempty"`                        // Whether this practitioner role record is in active use
-	Period            *Period                 `json:"period,omitempty" bson:"period,omitempty"`                        // The period during which the practitioner is authorized to perform in these role(s)
-	Practitioner      *Reference              `json:"practitioner,omitempty" bson:"practitioner,omitempty"`            // Practitioner that provides services for the organization
-	Organization      *Reference              `json:"organization,omitempty" bson:"organization,omitempty"`            // Organization where the role is available
-	Network           []Reference             `json:"network,omitempty" bson:"network,omitempty"`                      // The network in which the PractitionerRole provides the role's services (if defined) at the indicated locations (if defined)
-	Code              []CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                            // Roles which this practitioner may perform
-	Display           *string                 `json:"display,omitempty" bson:"display,omitempty"`                      // Denormalized practitioner name, role, organization and location
-	Specialty         []CodeableConcept       `json:"specialty,omitempty" bson:"specialty,omitempty"`                  // Specific specialty of the practitioner
-	Location          []Reference             `json:"location,omitempty" bson:"location,omitempty"`                    // Location(s) where the practitioner provides care
-	HealthcareService []Reference             `json:"healthcareService,omitempty" bson:"healthcare_service,omitempty"` // Healthcare services provided for this role's Organization/Location(s)
-	Contact           []ExtendedContactDetail `json:"contact,omitempty" bson:"contact,omitempty"`                      // Official contact details relating to this PractitionerRole
-	Characteristic    []CodeableConcept       `json:"characteristic,omitempty" bson:"characteristic,omitempty"`        // Collection of characteristics (attributes)
-	Communication     []CodeableConcept       `json:"communication,omitempty" bson:"communication,omitempty"`          // A language the practitioner (in this role) can use in patient communication
-	Availability      *Availability           `json:"availability,omitempty" bson:"availability,omitempty"`            // Times the Practitioner is available at this location and/or healthcare service (including exceptions)
-	Endpoint          []Reference             `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                    // Endpoints for interacting with the practitioner in this role
+	ResourceType         string                  `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                 `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                 `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource              `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Identifiers for a role/location
+	Active               *bool                   `json:"active,omitempty" bson:"active,omitempty"`                         // Whether this practitioner role record is in active use
+	ActiveElement        *Element                `json:"_active,omitempty" bson:"active_element,omitempty"`                // Extensions for active
+	Period               *Period                 `json:"period,omitempty" bson:"period,omitempty"`                         // The period during which the practitioner is authorized to perform in these role(s)
+	Practitioner         *Reference              `json:"practitioner,omitempty" bson:"practitioner,omitempty"`             // Practitioner that provides services for the organization
+	Organization         *Reference              `json:"organization,omitempty" bson:"organization,omitempty"`             // Organization where the role is available
+	Network              []Reference             `json:"network,omitempty" bson:"network,omitempty"`                       // The network in which the PractitionerRole provides the role's services (if defined) at the indicated locations (if defined)
+	Code                 []CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                             // Roles which this practitioner may perform
+	Display              *string                 `json:"display,omitempty" bson:"display,omitempty"`                       // Denormalized practitioner name, role, organization and location
+	DisplayElement       *Element                `json:"_display,omitempty" bson:"display_element,omitempty"`              // Extensions for display
+	Specialty            []CodeableConcept       `json:"specialty,omitempty" bson:"specialty,omitempty"`                   // Specific specialty of the practitioner
+	Location             []Reference             `json:"location,omitempty" bson:"location,omitempty"`                     // Location(s) where the practitioner provides care
+	HealthcareService    []Reference             `json:"healthcareService,omitempty" bson:"healthcare_service,omitempty"`  // Healthcare services provided for this role's Organization/Location(s)
+	Contact              []ExtendedContactDetail `json:"contact,omitempty" bson:"contact,omitempty"`                       // Official contact details relating to this PractitionerRole
+	Characteristic       []CodeableConcept       `json:"characteristic,omitempty" bson:"characteristic,omitempty"`         // Collection of characteristics (attributes)
+	Communication        []CodeableConcept       `json:"communication,omitempty" bson:"communication,omitempty"`           // A language the practitioner (in this role) can use in patient communication
+	Availability         *Availability           `json:"availability,omitempty" bson:"availability,omitempty"`             // Times the Practitioner is available at this location and/or healthcare service (including exceptions)
+	Endpoint             []Reference             `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                     // Endpoints for interacting with the practitioner in this role
 }
 
+var practitionerRolePractitionerTargets = []string{"Practitioner"}
+
+var practitionerRoleOrganizationTargets = []string{"Organization"}
+
+var practitionerRoleNetworkTargets = []string{"Organization"}
+
+var practitionerRoleLocationTargets = []string{"Location"}
+
+var practitionerRoleHealthcareServiceTargets = []string{"HealthcareService"}
+
+var practitionerRoleEndpointTargets = []string{"Endpoint"}
+
 func (r *PractitionerRole) Validate() error {
 	if r.ResourceType != "PractitionerRole" {
 		return fmt.Errorf("invalid resourceType: expected 'PractitionerRole', got '%s'", r.ResourceType)
@@ -41,31 +60,79 @@ func (r *PractitionerRole) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	if r.Practitioner != nil {
+		if target := invalidReferenceTarget(r.Practitioner.Reference, r.Practitioner.Type, practitionerRolePractitionerTargets); target != "" {
+			return fmt.Errorf("field 'Practitioner' cannot refer to %s, expected one of %v", target, practitionerRolePractitionerTargets)
+		}
+	}
 	if r.Practitioner != nil {
 		if err := r.Practitioner.Validate(); err != nil {
 			return fmt.Errorf("Practitioner: %w", err)
 		}
 	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, practitionerRoleOrganizationTargets); target != "" {
+			return fmt.Errorf("field 'Organization' cannot refer to %s, expected one of %v", target, practitionerRoleOrganizationTargets)
+		}
+	}
 	if r.Organization != nil {
 		if err := r.Organization.Validate(); err != nil {
 			return fmt.Errorf("Organization: %w", err)
 		}
 	}
+	for i, item := range r.Network {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleNetworkTargets); target != "" {
+			return fmt.Errorf("field 'Network[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleNetworkTargets)
+		}
+	}
 	for i, item := range r.Network {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Network[%d]: %w", i, err)
@@ -76,16 +143,31 @@ func (r *PractitionerRole) Validate() error {
 			return fmt.Errorf("Code[%d]: %w", i, err)
 		}
 	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
 	for i, item := range r.Specialty {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Specialty[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleLocationTargets)
+		}
+	}
 	for i, item := range r.Location {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Location[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.HealthcareService {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleHealthcareServiceTargets); target != "" {
+			return fmt.Errorf("field 'HealthcareService[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleHealthcareServiceTargets)
+		}
+	}
 	for i, item := range r.HealthcareService {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("HealthcareService[%d]: %w", i, err)
@@ -111,10 +193,260 @@ func (r *PractitionerRole) Validate() error {
 			return fmt.Errorf("Availability: %w", err)
 		}
 	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleEndpointTargets); target != "" {
+			return fmt.Errorf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleEndpointTargets)
+		}
+	}
 	for i, item := range r.Endpoint {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Endpoint[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "PractitionerRole")
+}
+
+func (r *PractitionerRole) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "PractitionerRole" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'PractitionerRole', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Practitioner != nil {
+		if target := invalidReferenceTarget(r.Practitioner.Reference, r.Practitioner.Type, practitionerRolePractitionerTargets); target != "" {
+			issues.add("value", path+".practitioner", fmt.Sprintf("field 'Practitioner' cannot refer to %s, expected one of %v", target, practitionerRolePractitionerTargets))
+		}
+	}
+	if r.Practitioner != nil {
+		r.Practitioner.validateAll(path+".practitioner", issues)
+	}
+	if r.Organization != nil {
+		if target := invalidReferenceTarget(r.Organization.Reference, r.Organization.Type, practitionerRoleOrganizationTargets); target != "" {
+			issues.add("value", path+".organization", fmt.Sprintf("field 'Organization' cannot refer to %s, expected one of %v", target, practitionerRoleOrganizationTargets))
+		}
+	}
+	if r.Organization != nil {
+		r.Organization.validateAll(path+".organization", issues)
+	}
+	for i, item := range r.Network {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleNetworkTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.network[%d]", path, i), fmt.Sprintf("field 'Network[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleNetworkTargets))
+		}
+	}
+	for i, item := range r.Network {
+		item.validateAll(fmt.Sprintf("%s.network[%d]", path, i), issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	for i, item := range r.Specialty {
+		item.validateAll(fmt.Sprintf("%s.specialty[%d]", path, i), issues)
+	}
+	for i, item := range r.Location {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleLocationTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.location[%d]", path, i), fmt.Sprintf("field 'Location[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleLocationTargets))
+		}
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	for i, item := range r.HealthcareService {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleHealthcareServiceTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.healthcareService[%d]", path, i), fmt.Sprintf("field 'HealthcareService[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleHealthcareServiceTargets))
+		}
+	}
+	for i, item := range r.HealthcareService {
+		item.validateAll(fmt.Sprintf("%s.healthcareService[%d]", path, i), issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	for i, item := range r.Characteristic {
+		item.validateAll(fmt.Sprintf("%s.characteristic[%d]", path, i), issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+	if r.Availability != nil {
+		r.Availability.validateAll(path+".availability", issues)
+	}
+	for i, item := range r.Endpoint {
+		if target := invalidReferenceTarget(item.Reference, item.Type, practitionerRoleEndpointTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.endpoint[%d]", path, i), fmt.Sprintf("field 'Endpoint[%d]' cannot refer to %s, expected one of %v", i, target, practitionerRoleEndpointTargets))
+		}
+	}
+	for i, item := range r.Endpoint {
+		item.validateAll(fmt.Sprintf("%s.endpoint[%d]", path, i), issues)
+	}
+}
+
+func (r *PractitionerRole) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("PractitionerRole", &issues)
+	checkInvariants(r, "PractitionerRole", &issues)
+	return issues
+}
+
+var practitionerRoleInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *PractitionerRole) invariants() []invariant {
+	return practitionerRoleInvariants
+}
+
+func (r *PractitionerRole) UnmarshalJSON(data []byte) error {
+	type alias PractitionerRole
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r PractitionerRole) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PractitionerRole) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PractitionerRole) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PractitionerRole) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var practitionerRoleElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *PractitionerRole) elementTypes() map[string]string {
+	return practitionerRoleElementTypes
+}
+
+var practitionerRoleElementMetadata = []ElementMetadata{
+	{Path: "PractitionerRole.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "PractitionerRole.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "PractitionerRole.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "PractitionerRole.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "PractitionerRole.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "PractitionerRole.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "PractitionerRole.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "PractitionerRole.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "PractitionerRole.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "PractitionerRole.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "PractitionerRole.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "PractitionerRole.period", Name: "period", Field: 14, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "PractitionerRole.practitioner", Name: "practitioner", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner"}},
+	{Path: "PractitionerRole.organization", Name: "organization", Field: 16, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "PractitionerRole.network", Name: "network", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "PractitionerRole.code", Name: "code", Field: 18, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PractitionerRole.display", Name: "display", Field: 19, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PractitionerRole.specialty", Name: "specialty", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PractitionerRole.location", Name: "location", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "PractitionerRole.healthcareService", Name: "healthcareService", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/HealthcareService"}},
+	{Path: "PractitionerRole.contact", Name: "contact", Field: 24, Min: 0, Max: "*", Types: []string{"ExtendedContactDetail"}},
+	{Path: "PractitionerRole.characteristic", Name: "characteristic", Field: 25, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PractitionerRole.communication", Name: "communication", Field: 26, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "PractitionerRole.availability", Name: "availability", Field: 27, Min: 0, Max: "1", Types: []string{"Availability"}},
+	{Path: "PractitionerRole.endpoint", Name: "endpoint", Field: 28, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Endpoint"}},
+}
+
+func (r *PractitionerRole) ElementMetadata() []ElementMetadata {
+	return practitionerRoleElementMetadata
+}
+
+func (r *PractitionerRole) GetResourceType() string {
+	return "PractitionerRole"
+}
+
+func (r *PractitionerRole) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *PractitionerRole) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *PractitionerRole) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *PractitionerRole) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *PractitionerRole) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *PractitionerRole) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *PractitionerRole) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*PractitionerRole)(nil)
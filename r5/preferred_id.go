@@ -1,9 +1,63 @@
 package models
 
-// This operation returns the preferred identifiers for identifiers, and terminologies. The operation takes 2 parameters:       * a system identifier - either a URI, an OID, or a v2 table 0396 (other) code   * a code for what kind of identifier is desired (URI, OID, v2 table 0396 identifier)      and returns either the requested identifier, or an HTTP errors response with an OperationOutcome because either the provided identifier was not recognized, or the requested identiifer type is not known.      The principle use of this operation is when converting between v2, CDA and FHIR Identifier/CX/II and CodeableConcepts/C(N/W)E/CD but the operation may also find use when converting metadata such as profiles.
-type PreferredId struct {
+// PreferredIdIn holds the input parameters of the $PreferredId operation. This operation returns the preferred identifiers for identifiers, and terminologies. The operation takes 2 parameters:       * a system identifier - either a URI, an OID, or a v2 table 0396 (other) code   * a code for what kind of identifier is desired (URI, OID, v2 table 0396 identifier)      and returns either the requested identifier, or an HTTP errors response with an OperationOutcome because either the provided identifier was not recognized, or the requested identiifer type is not known.      The principle use of this operation is when converting between v2, CDA and FHIR Identifier/CX/II and CodeableConcepts/C(N/W)E/CD but the operation may also find use when converting metadata such as profiles.
+type PreferredIdIn struct {
 }
 
-func (r *PreferredId) Validate() error {
+func (p *PreferredIdIn) Validate() error {
+	return nil
+}
+
+func (p *PreferredIdIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *PreferredIdIn) FromParameters(params *Parameters) error {
+	*p = PreferredIdIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *PreferredIdIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *PreferredIdIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// PreferredIdOut holds the output parameters of the $PreferredId operation.
+type PreferredIdOut struct {
+}
+
+func (p *PreferredIdOut) Validate() error {
+	return nil
+}
+
+func (p *PreferredIdOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *PreferredIdOut) FromParameters(params *Parameters) error {
+	*p = PreferredIdOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *PreferredIdOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *PreferredIdOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
@@ -1,10 +1,67 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // PrimitiveType Type: The base type for all re-useable types defined that have a simple property.
 type PrimitiveType struct {
-	Id *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
 }
 
 func (r *PrimitiveType) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *PrimitiveType) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *PrimitiveType) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("PrimitiveType", &issues)
+	checkInvariants(r, "PrimitiveType", &issues)
+	return issues
+}
+
+var primitiveTypeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *PrimitiveType) invariants() []invariant {
+	return primitiveTypeInvariants
+}
+
+func (r PrimitiveType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *PrimitiveType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r PrimitiveType) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *PrimitiveType) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var primitiveTypeElementMetadata = []ElementMetadata{
+	{Path: "PrimitiveType.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "PrimitiveType.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+}
+
+func (r *PrimitiveType) ElementMetadata() []ElementMetadata {
+	return primitiveTypeElementMetadata
+}
@@ -2,53 +2,71 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An action that is or was performed on or for a patient, practitioner, device, organization, or location. For example, this can be a physical intervention on a patient like an operation, or less invasive like long term services, counseling, or hypnotherapy.  This can be a quality or safety inspection for a location, organization, or device.  This can be an accreditation procedure on a practitioner for licensing.
 type Procedure struct {
-	ResourceType       string                 `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage      `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier         []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // External Identifiers for this procedure
-	BasedOn            []Reference            `json:"basedOn,omitempty" bson:"based_on,omitempty"`                        // A request for this procedure
-	PartOf             []Reference            `json:"partOf,omitempty" bson:"part_of,omitempty"`                          // Part of referenced event
-	Status             string                 `json:"status" bson:"status"`                                               // preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
-	StatusReason       *CodeableConcept       `json:"statusReason,omitempty" bson:"status_reason,omitempty"`              // Reason for current status
-	Category           []CodeableConcept      `json:"category,omitempty" bson:"category,omitempty"`                       // Classification of the procedure
-	Code               *CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                               // Identification of the procedure
-	Subject            *Reference             `json:"subject" bson:"subject"`                                             // Individual or entity the procedure was performed on
-	Focus              *Reference             `json:"focus,omitempty" bson:"focus,omitempty"`                             // Who is the target of the procedure when it is not the subject of record only
-	Encounter          *Reference             `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // The Encounter during which this Procedure was created
-	OccurrenceDateTime *string                `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // When the procedure occurred or is occurring
-	OccurrencePeriod   *Period                `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // When the procedure occurred or is occurring
-	OccurrenceString   *string                `json:"occurrenceString,omitempty" bson:"occurrence_string,omitempty"`      // When the procedure occurred or is occurring
-	OccurrenceAge      *Age                   `json:"occurrenceAge,omitempty" bson:"occurrence_age,omitempty"`            // When the procedure occurred or is occurring
-	OccurrenceRange    *Range                 `json:"occurrenceRange,omitempty" bson:"occurrence_range,omitempty"`        // When the procedure occurred or is occurring
-	OccurrenceTiming   *Timing                `json:"occurrenceTiming,omitempty" bson:"occurrence_timing,omitempty"`      // When the procedure occurred or is occurring
-	Recorded           *string                `json:"recorded,omitempty" bson:"recorded,omitempty"`                       // When the procedure was first captured in the subject's record
-	Recorder           *Reference             `json:"recorder,omitempty" bson:"recorder,omitempty"`                       // Who recorded the procedure
-	ReportedBoolean    *bool                  `json:"reportedBoolean,omitempty" bson:"reported_boolean,omitempty"`        // Reported rather than primary record
-	ReportedReference  *Reference             `json:"reportedReference,omitempty" bson:"reported_reference,omitempty"`    // Reported rather than primary record
-	Performer          []ProcedurePerformer   `json:"performer,omitempty" bson:"performer,omitempty"`                     // Who performed the procedure and what they did
-	Location           *Reference             `json:"location,omitempty" bson:"location,omitempty"`                       // Where the procedure happened
-	Reason             []CodeableReference    `json:"reason,omitempty" bson:"reason,omitempty"`                           // The justification that the procedure was performed
-	BodySite           []CodeableConcept      `json:"bodySite,omitempty" bson:"body_site,omitempty"`                      // Target body sites
-	BodyStructure      *Reference             `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`            // Target body structure
-	Outcome            []CodeableReference    `json:"outcome,omitempty" bson:"outcome,omitempty"`                         // The result of procedure
-	Report             []Reference            `json:"report,omitempty" bson:"report,omitempty"`                           // Any report resulting from the procedure
-	Complication       []CodeableReference    `json:"complication,omitempty" bson:"complication,omitempty"`               // Complication following the procedure
-	FollowUp           []CodeableReference    `json:"followUp,omitempty" bson:"follow_up,omitempty"`                      // Instructions for follow up
-	Note               []Annotation           `json:"note,omitempty" bson:"note,omitempty"`                               // Additional information about the procedure
-	FocalDevice        []ProcedureFocalDevice `json:"focalDevice,omitempty" bson:"focal_device,omitempty"`                // Manipulated, implanted, or removed device
-	Used               []CodeableReference    `json:"used,omitempty" bson:"used,omitempty"`                               // Items used during procedure
-	SupportingInfo     []Reference            `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`          // Extra information relevant to the procedure
+	ResourceType         string                 `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                  `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element               `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element               `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative             `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource             `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier           `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External Identifiers for this procedure
+	BasedOn              []Reference            `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // A request for this procedure
+	PartOf               []Reference            `json:"partOf,omitempty" bson:"part_of,omitempty"`                        // Part of referenced event
+	Status               AdverseEventStatus     `json:"status" bson:"status"`                                             // preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
+	StatusElement        *Element               `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	StatusReason         *CodeableConcept       `json:"statusReason,omitempty" bson:"status_reason,omitempty"`            // Reason for current status
+	Category             []CodeableConcept      `json:"category,omitempty" bson:"category,omitempty"`                     // Classification of the procedure
+	Code                 *CodeableConcept       `json:"code,omitempty" bson:"code,omitempty"`                             // Identification of the procedure
+	Subject              *Reference             `json:"subject" bson:"subject"`                                           // Individual or entity the procedure was performed on
+	Focus                *Reference             `json:"focus,omitempty" bson:"focus,omitempty"`                           // Who is the target of the procedure when it is not the subject of record only
+	Encounter            *Reference             `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // The Encounter during which this Procedure was created
+	Occurrence           ProcedureOccurrence    `json:"-" bson:"occurrence,omitempty"`                                    // When the procedure occurred or is occurring
+	OccurrenceElement    *Element               `json:"-" bson:"occurrence_element,omitempty"`                            // Extensions for occurrence[x]
+	Recorded             *DateTime              `json:"recorded,omitempty" bson:"recorded,omitempty"`                     // When the procedure was first captured in the subject's record
+	RecordedElement      *Element               `json:"_recorded,omitempty" bson:"recorded_element,omitempty"`            // Extensions for recorded
+	Recorder             *Reference             `json:"recorder,omitempty" bson:"recorder,omitempty"`                     // Who recorded the procedure
+	Reported             ProcedureReported      `json:"-" bson:"reported,omitempty"`                                      // Reported rather than primary record
+	ReportedElement      *Element               `json:"-" bson:"reported_element,omitempty"`                              // Extensions for reported[x]
+	Performer            []ProcedurePerformer   `json:"performer,omitempty" bson:"performer,omitempty"`                   // Who performed the procedure and what they did
+	Location             *Reference             `json:"location,omitempty" bson:"location,omitempty"`                     // Where the procedure happened
+	Reason               []CodeableReference    `json:"reason,omitempty" bson:"reason,omitempty"`                         // The justification that the procedure was performed
+	BodySite             []CodeableConcept      `json:"bodySite,omitempty" bson:"body_site,omitempty"`                    // Target body sites
+	BodyStructure        *Reference             `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`          // Target body structure
+	Outcome              []CodeableReference    `json:"outcome,omitempty" bson:"outcome,omitempty"`                       // The result of procedure
+	Report               []Reference            `json:"report,omitempty" bson:"report,omitempty"`                         // Any report resulting from the procedure
+	Complication         []CodeableReference    `json:"complication,omitempty" bson:"complication,omitempty"`             // Complication following the procedure
+	FollowUp             []CodeableReference    `json:"followUp,omitempty" bson:"follow_up,omitempty"`                    // Instructions for follow up
+	Note                 []Annotation           `json:"note,omitempty" bson:"note,omitempty"`                             // Additional information about the procedure
+	FocalDevice          []ProcedureFocalDevice `json:"focalDevice,omitempty" bson:"focal_device,omitempty"`              // Manipulated, implanted, or removed device
+	Used                 []CodeableReference    `json:"used,omitempty" bson:"used,omitempty"`                             // Items used during procedure
+	SupportingInfo       []Reference            `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`        // Extra information relevant to the procedure
+
+	occurrenceVariants []string // JSON properties of Procedure.occurrence[x] when more than one was decoded
+	reportedVariants   []string // JSON properties of Procedure.reported[x] when more than one was decoded
 }
 
+var procedureBasedOnTargets = []string{"CarePlan", "MedicationRequest", "ServiceRequest"}
+
+var procedurePartOfTargets = []string{"MedicationAdministration", "Observation", "Procedure"}
+
+var procedureSubjectTargets = []string{"Device", "Group", "Location", "Organization", "Patient", "Practitioner"}
+
+var procedureEncounterTargets = []string{"Encounter"}
+
+var procedureLocationTargets = []string{"Location"}
+
+var procedureReportTargets = []string{"Bundle", "Composition", "DiagnosticReport", "DocumentReference"}
+
 func (r *Procedure) Validate() error {
 	if r.ResourceType != "Procedure" {
 		return fmt.Errorf("invalid resourceType: expected 'Procedure', got '%s'", r.ResourceType)
@@ -58,30 +76,75 @@ func (r *Procedure) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, procedureBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, procedureBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, procedurePartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, procedurePartOfTargets)
+		}
+	}
 	for i, item := range r.PartOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.StatusReason != nil {
 		if err := r.StatusReason.Validate(); err != nil {
 			return fmt.Errorf("StatusReason: %w", err)
@@ -100,6 +163,11 @@ func (r *Procedure) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, procedureSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, procedureSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -110,29 +178,37 @@ func (r *Procedure) Validate() error {
 			return fmt.Errorf("Focus: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, procedureEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, procedureEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
 		}
 	}
-	if r.OccurrenceAge != nil {
-		if err := r.OccurrenceAge.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceAge: %w", err)
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
 		}
 	}
-	if r.OccurrenceRange != nil {
-		if err := r.OccurrenceRange.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceRange: %w", err)
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			return fmt.Errorf("Recorded: %w", err)
 		}
 	}
-	if r.OccurrenceTiming != nil {
-		if err := r.OccurrenceTiming.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceTiming: %w", err)
+	if r.RecordedElement != nil {
+		if err := r.RecordedElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedElement: %w", err)
 		}
 	}
 	if r.Recorder != nil {
@@ -140,9 +216,17 @@ func (r *Procedure) Validate() error {
 			return fmt.Errorf("Recorder: %w", err)
 		}
 	}
-	if r.ReportedReference != nil {
-		if err := r.ReportedReference.Validate(); err != nil {
-			return fmt.Errorf("ReportedReference: %w", err)
+	if len(r.reportedVariants) > 1 {
+		return fmt.Errorf("field 'Reported' must have a single type, got %v", r.reportedVariants)
+	}
+	if r.Reported != nil {
+		if err := r.Reported.Validate(); err != nil {
+			return fmt.Errorf("Reported: %w", err)
+		}
+	}
+	if r.ReportedElement != nil {
+		if err := r.ReportedElement.Validate(); err != nil {
+			return fmt.Errorf("ReportedElement: %w", err)
 		}
 	}
 	for i, item := range r.Performer {
@@ -150,6 +234,11 @@ func (r *Procedure) Validate() error {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
 		}
 	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, procedureLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, procedureLocationTargets)
+		}
+	}
 	if r.Location != nil {
 		if err := r.Location.Validate(); err != nil {
 			return fmt.Errorf("Location: %w", err)
@@ -175,6 +264,11 @@ func (r *Procedure) Validate() error {
 			return fmt.Errorf("Outcome[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Report {
+		if target := invalidReferenceTarget(item.Reference, item.Type, procedureReportTargets); target != "" {
+			return fmt.Errorf("field 'Report[%d]' cannot refer to %s, expected one of %v", i, target, procedureReportTargets)
+		}
+	}
 	for i, item := range r.Report {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Report[%d]: %w", i, err)
@@ -210,18 +304,575 @@ func (r *Procedure) Validate() error {
 			return fmt.Errorf("SupportingInfo[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Procedure")
+}
+
+func (r *Procedure) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Procedure" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Procedure', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, procedureBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, procedureBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, procedurePartOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.partOf[%d]", path, i), fmt.Sprintf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, procedurePartOfTargets))
+		}
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.StatusReason != nil {
+		r.StatusReason.validateAll(path+".statusReason", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, procedureSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, procedureSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Focus != nil {
+		r.Focus.validateAll(path+".focus", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, procedureEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, procedureEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			issues.add("value", path+".recorded", err.Error())
+		}
+	}
+	if r.RecordedElement != nil {
+		r.RecordedElement.validateAll(path+".recorded", issues)
+	}
+	if r.Recorder != nil {
+		r.Recorder.validateAll(path+".recorder", issues)
+	}
+	if len(r.reportedVariants) > 1 {
+		issues.add("structure", path+".reported", fmt.Sprintf("field 'Reported' must have a single type, got %v", r.reportedVariants))
+	}
+	if r.Reported != nil {
+		r.Reported.validateAll(path+".reported.ofType("+r.Reported.FHIRType()+")", issues)
+	}
+	if r.ReportedElement != nil {
+		r.ReportedElement.validateAll(path+".reported", issues)
+	}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, procedureLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, procedureLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.BodySite {
+		item.validateAll(fmt.Sprintf("%s.bodySite[%d]", path, i), issues)
+	}
+	if r.BodyStructure != nil {
+		r.BodyStructure.validateAll(path+".bodyStructure", issues)
+	}
+	for i, item := range r.Outcome {
+		item.validateAll(fmt.Sprintf("%s.outcome[%d]", path, i), issues)
+	}
+	for i, item := range r.Report {
+		if target := invalidReferenceTarget(item.Reference, item.Type, procedureReportTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.report[%d]", path, i), fmt.Sprintf("field 'Report[%d]' cannot refer to %s, expected one of %v", i, target, procedureReportTargets))
+		}
+	}
+	for i, item := range r.Report {
+		item.validateAll(fmt.Sprintf("%s.report[%d]", path, i), issues)
+	}
+	for i, item := range r.Complication {
+		item.validateAll(fmt.Sprintf("%s.complication[%d]", path, i), issues)
+	}
+	for i, item := range r.FollowUp {
+		item.validateAll(fmt.Sprintf("%s.followUp[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.FocalDevice {
+		item.validateAll(fmt.Sprintf("%s.focalDevice[%d]", path, i), issues)
+	}
+	for i, item := range r.Used {
+		item.validateAll(fmt.Sprintf("%s.used[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+}
+
+func (r *Procedure) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Procedure", &issues)
+	checkInvariants(r, "Procedure", &issues)
+	return issues
+}
+
+var procedureInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Procedure) invariants() []invariant {
+	return procedureInvariants
+}
+
+func (r Procedure) MarshalJSON() ([]byte, error) {
+	type alias Procedure
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "reported", r.Reported, r.ReportedElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Procedure) UnmarshalJSON(data []byte) error {
+	type alias Procedure
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", procedureOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
+	if r.Reported, element, r.reportedVariants, err = unmarshalChoice(fields, "reported", procedureReportedVariants); err != nil {
+		return err
+	}
+	r.ReportedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ReportedElement); err != nil {
+			return fmt.Errorf("_reported: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r Procedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Procedure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Procedure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Procedure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Procedure) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(procedureOccurrenceVariants)
+	case "Reported":
+		return "reported", choiceValues(procedureReportedVariants)
+	}
+	return "", nil
+}
+
+var procedureElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Procedure) elementTypes() map[string]string {
+	return procedureElementTypes
+}
+
+var procedureElementMetadata = []ElementMetadata{
+	{Path: "Procedure.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Procedure.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Procedure.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Procedure.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Procedure.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Procedure.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Procedure.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Procedure.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Procedure.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Procedure.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Procedure.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "Procedure.partOf", Name: "partOf", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/Procedure"}},
+	{Path: "Procedure.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Procedure.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/adverse-event-status"}},
+	{Path: "Procedure.statusReason", Name: "statusReason", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Procedure.category", Name: "category", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Procedure.code", Name: "code", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Procedure.subject", Name: "subject", Field: 19, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner"}},
+	{Path: "Procedure.focus", Name: "focus", Field: 20, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Procedure.encounter", Name: "encounter", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Procedure.occurrence[x]", Name: "occurrence", Field: 22, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "string", "Age", "Range", "Timing"}},
+	{Path: "Procedure.recorded", Name: "recorded", Field: 24, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Procedure.recorder", Name: "recorder", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Procedure.reported[x]", Name: "reported", Field: 27, Min: 0, Max: "1", Types: []string{"boolean", "Reference"}},
+	{Path: "Procedure.performer", Name: "performer", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Procedure.location", Name: "location", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Procedure.reason", Name: "reason", Field: 31, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Procedure.bodySite", Name: "bodySite", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Procedure.bodyStructure", Name: "bodyStructure", Field: 33, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Procedure.outcome", Name: "outcome", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Procedure.report", Name: "report", Field: 35, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference"}},
+	{Path: "Procedure.complication", Name: "complication", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Procedure.followUp", Name: "followUp", Field: 37, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Procedure.note", Name: "note", Field: 38, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Procedure.focalDevice", Name: "focalDevice", Field: 39, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Procedure.used", Name: "used", Field: 40, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Procedure.supportingInfo", Name: "supportingInfo", Field: 41, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *Procedure) ElementMetadata() []ElementMetadata {
+	return procedureElementMetadata
+}
+
+// ProcedureOccurrence is the type of Procedure.occurrence[x]. It is implemented by the ProcedureOccurrence* variant types.
+type ProcedureOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isProcedureOccurrence()
+}
+
+// ProcedureOccurrenceDateTime is the dateTime variant of ProcedureOccurrence.
+type ProcedureOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v ProcedureOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ProcedureOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ProcedureOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ProcedureOccurrenceDateTime) isProcedureOccurrence() {}
+
+// ProcedureOccurrencePeriod is the Period variant of ProcedureOccurrence.
+type ProcedureOccurrencePeriod struct {
+	Period
+}
+
+func (v ProcedureOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ProcedureOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ProcedureOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ProcedureOccurrencePeriod) isProcedureOccurrence() {}
+
+// ProcedureOccurrenceString is the string variant of ProcedureOccurrence.
+type ProcedureOccurrenceString string
+
+func (v ProcedureOccurrenceString) Validate() error {
+	return nil
+}
+
+func (v ProcedureOccurrenceString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ProcedureOccurrenceString) FHIRType() string {
+	return "string"
+}
+
+func (v ProcedureOccurrenceString) isProcedureOccurrence() {}
+
+// ProcedureOccurrenceAge is the Age variant of ProcedureOccurrence.
+type ProcedureOccurrenceAge struct {
+	Age
+}
+
+func (v ProcedureOccurrenceAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v ProcedureOccurrenceAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v ProcedureOccurrenceAge) FHIRType() string {
+	return "Age"
+}
+
+func (v ProcedureOccurrenceAge) isProcedureOccurrence() {}
+
+// ProcedureOccurrenceRange is the Range variant of ProcedureOccurrence.
+type ProcedureOccurrenceRange struct {
+	Range
+}
+
+func (v ProcedureOccurrenceRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ProcedureOccurrenceRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ProcedureOccurrenceRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ProcedureOccurrenceRange) isProcedureOccurrence() {}
+
+// ProcedureOccurrenceTiming is the Timing variant of ProcedureOccurrence.
+type ProcedureOccurrenceTiming struct {
+	Timing
+}
+
+func (v ProcedureOccurrenceTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ProcedureOccurrenceTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ProcedureOccurrenceTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ProcedureOccurrenceTiming) isProcedureOccurrence() {}
+
+var procedureOccurrenceVariants = []ProcedureOccurrence{
+	ProcedureOccurrenceDateTime{},
+	ProcedureOccurrencePeriod{},
+	ProcedureOccurrenceString(""),
+	ProcedureOccurrenceAge{},
+	ProcedureOccurrenceRange{},
+	ProcedureOccurrenceTiming{},
+}
+
+// ProcedureReported is the type of Procedure.reported[x]. It is implemented by the ProcedureReported* variant types.
+type ProcedureReported interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isProcedureReported()
+}
+
+// ProcedureReportedBoolean is the boolean variant of ProcedureReported.
+type ProcedureReportedBoolean bool
+
+func (v ProcedureReportedBoolean) Validate() error {
+	return nil
+}
+
+func (v ProcedureReportedBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ProcedureReportedBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ProcedureReportedBoolean) isProcedureReported() {}
+
+// ProcedureReportedReference is the Reference variant of ProcedureReported.
+type ProcedureReportedReference struct {
+	Reference
+}
+
+func (v ProcedureReportedReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ProcedureReportedReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ProcedureReportedReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ProcedureReportedReference) isProcedureReported() {}
+
+var procedureReportedVariants = []ProcedureReported{
+	ProcedureReportedBoolean(false),
+	ProcedureReportedReference{},
+}
+
+func (r *Procedure) GetResourceType() string {
+	return "Procedure"
+}
+
+func (r *Procedure) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Procedure) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Procedure) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Procedure) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Procedure) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Procedure) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Procedure) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Procedure)(nil)
+
 type ProcedurePerformer struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Function   *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`       // Type of performance
-	Actor      *Reference       `json:"actor" bson:"actor"`                                 // Who performed the procedure
-	OnBehalfOf *Reference       `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"` // Organization the device or practitioner was acting for
-	Period     *Period          `json:"period,omitempty" bson:"period,omitempty"`           // When the performer performed the procedure
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performance
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Who performed the procedure
+	OnBehalfOf        *Reference       `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"`              // Organization the device or practitioner was acting for
+	Period            *Period          `json:"period,omitempty" bson:"period,omitempty"`                        // When the performer performed the procedure
 }
 
+var procedurePerformerActorTargets = []string{"CareTeam", "Device", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *ProcedurePerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -230,6 +881,11 @@ func (r *ProcedurePerformer) Validate() error {
 	if r.Actor == nil {
 		return fmt.Errorf("field 'Actor' is required")
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, procedurePerformerActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, procedurePerformerActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -248,13 +904,92 @@ func (r *ProcedurePerformer) Validate() error {
 	return nil
 }
 
+func (r *ProcedurePerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
+	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, procedurePerformerActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, procedurePerformerActorTargets))
+		}
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
+	}
+	if r.OnBehalfOf != nil {
+		r.OnBehalfOf.validateAll(path+".onBehalfOf", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var procedurePerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ProcedurePerformer) invariants() []invariant {
+	return procedurePerformerInvariants
+}
+
+func (r ProcedurePerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ProcedurePerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ProcedurePerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ProcedurePerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var procedurePerformerElementMetadata = []ElementMetadata{
+	{Path: "Procedure.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Procedure.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Procedure.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Procedure.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Procedure.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Procedure.performer.onBehalfOf", Name: "onBehalfOf", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Procedure.performer.period", Name: "period", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *ProcedurePerformer) ElementMetadata() []ElementMetadata {
+	return procedurePerformerElementMetadata
+}
+
 type ProcedureFocalDevice struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Action      *CodeableConcept `json:"action,omitempty" bson:"action,omitempty"` // Kind of change to device
-	Manipulated *Reference       `json:"manipulated" bson:"manipulated"`           // Device that was changed
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Action            *CodeableConcept `json:"action,omitempty" bson:"action,omitempty"`                        // Kind of change to device
+	Manipulated       *Reference       `json:"manipulated" bson:"manipulated"`                                  // Device that was changed
 }
 
 func (r *ProcedureFocalDevice) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Action != nil {
 		if err := r.Action.Validate(); err != nil {
 			return fmt.Errorf("Action: %w", err)
@@ -270,3 +1005,57 @@ func (r *ProcedureFocalDevice) Validate() error {
 	}
 	return nil
 }
+
+func (r *ProcedureFocalDevice) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Action != nil {
+		r.Action.validateAll(path+".action", issues)
+	}
+	if r.Manipulated == nil {
+		issues.add("required", path+".manipulated", "field 'Manipulated' is required")
+	}
+	if r.Manipulated != nil {
+		r.Manipulated.validateAll(path+".manipulated", issues)
+	}
+}
+
+var procedureFocalDeviceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ProcedureFocalDevice) invariants() []invariant {
+	return procedureFocalDeviceInvariants
+}
+
+func (r ProcedureFocalDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ProcedureFocalDevice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ProcedureFocalDevice) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ProcedureFocalDevice) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var procedureFocalDeviceElementMetadata = []ElementMetadata{
+	{Path: "Procedure.focalDevice.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Procedure.focalDevice.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Procedure.focalDevice.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Procedure.focalDevice.action", Name: "action", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Procedure.focalDevice.manipulated", Name: "manipulated", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ProcedureFocalDevice) ElementMetadata() []ElementMetadata {
+	return procedureFocalDeviceElementMetadata
+}
@@ -1,9 +1,136 @@
 package models
 
-// This operation accepts a message, processes it according to the definition of the event in the message header, and returns one or more response messages.    In addition to processing the message event, a server may choose to retain all or some the resources and make them available on a RESTful interface, but is not required to do so.
-type ProcessMessage struct {
+import "fmt"
+
+// ProcessMessageIn holds the input parameters of the $process-message operation. This operation accepts a message, processes it according to the definition of the event in the message header, and returns one or more response messages.    In addition to processing the message event, a server may choose to retain all or some the resources and make them available on a RESTful interface, but is not required to do so.
+type ProcessMessageIn struct {
+	// The message to process
+	Content *Bundle
+	// If 'true' the message is processed using the asynchronous messaging process
+	Async *bool
+	// A URL to submit response messages to, if asynchronous messaging is being used
+	ResponseUrl *string
+}
+
+func (p *ProcessMessageIn) Validate() error {
+	if p.Content == nil {
+		return fmt.Errorf("parameter 'content' is required")
+	}
+	return nil
+}
+
+func (p *ProcessMessageIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ProcessMessageIn) FromParameters(params *Parameters) error {
+	*p = ProcessMessageIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ProcessMessageIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Content != nil {
+		params = append(params, ParametersParameter{Name: "content", Resource: p.Content})
+	}
+	if p.Async != nil {
+		params = append(params, ParametersParameter{Name: "async", Value: ParametersParameterValueBoolean(*p.Async)})
+	}
+	if p.ResponseUrl != nil {
+		params = append(params, ParametersParameter{Name: "response-url", Value: ParametersParameterValueUrl(*p.ResponseUrl)})
+	}
+	return params
+}
+
+func (p *ProcessMessageIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "content":
+			if p.Content != nil {
+				return fmt.Errorf("parameter 'content' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Content = v
+		case "async":
+			if p.Async != nil {
+				return fmt.Errorf("parameter 'async' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.Async = &value
+		case "response-url":
+			if p.ResponseUrl != nil {
+				return fmt.Errorf("parameter 'response-url' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUrl](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.ResponseUrl = &value
+		}
+	}
+	return nil
+}
+
+// ProcessMessageOut holds the output parameters of the $process-message operation.
+type ProcessMessageOut struct {
+	// A response message, if synchronous messaging is being used
+	Return *Bundle
+}
+
+func (p *ProcessMessageOut) Validate() error {
+	return nil
+}
+
+func (p *ProcessMessageOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ProcessMessageOut) FromParameters(params *Parameters) error {
+	*p = ProcessMessageOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ProcessMessageOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *ProcessMessage) Validate() error {
+func (p *ProcessMessageOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*Bundle](param.Name, param.Resource, "Bundle")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
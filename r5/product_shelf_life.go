@@ -1,27 +1,51 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // ProductShelfLife Type: The shelf-life and storage information for a medicinal product item or container can be described using this class.
 type ProductShelfLife struct {
-	Id                           *string           `json:"id,omitempty" bson:"id,omitempty"`                                                        // Unique id for inter-element referencing
-	Type                         *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                                                    // This describes the shelf life, taking into account various scenarios such as shelf life of the packaged Medicinal Product itself, shelf life after transformation where necessary and shelf life after the first opening of a bottle, etc. The shelf life type shall be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
-	PeriodDuration               *Duration         `json:"periodDuration,omitempty" bson:"period_duration,omitempty"`                               // The shelf life time period can be specified using a numerical value for the period of time and its unit of time measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
-	PeriodString                 *string           `json:"periodString,omitempty" bson:"period_string,omitempty"`                                   // The shelf life time period can be specified using a numerical value for the period of time and its unit of time measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
-	SpecialPrecautionsForStorage []CodeableConcept `json:"specialPrecautionsForStorage,omitempty" bson:"special_precautions_for_storage,omitempty"` // Special precautions for storage, if any, can be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
+	Id                           *string                `json:"id,omitempty" bson:"id,omitempty"`                                                        // Unique id for inter-element referencing
+	Extension                    []Extension            `json:"extension,omitempty" bson:"extension,omitempty"`                                          // Additional content defined by implementations
+	ModifierExtension            []Extension            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                         // Extensions that cannot be ignored even if unrecognized
+	Type                         *CodeableConcept       `json:"type,omitempty" bson:"type,omitempty"`                                                    // This describes the shelf life, taking into account various scenarios such as shelf life of the packaged Medicinal Product itself, shelf life after transformation where necessary and shelf life after the first opening of a bottle, etc. The shelf life type shall be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
+	Period                       ProductShelfLifePeriod `json:"-" bson:"period,omitempty"`                                                               // The shelf life time period can be specified using a numerical value for the period of time and its unit of time measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	PeriodElement                *Element               `json:"-" bson:"period_element,omitempty"`                                                       // Extensions for period[x]
+	SpecialPrecautionsForStorage []CodeableConcept      `json:"specialPrecautionsForStorage,omitempty" bson:"special_precautions_for_storage,omitempty"` // Special precautions for storage, if any, can be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
+
+	periodVariants []string // JSON properties of ProductShelfLife.period[x] when more than one was decoded
 }
 
 func (r *ProductShelfLife) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.PeriodDuration != nil {
-		if err := r.PeriodDuration.Validate(); err != nil {
-			return fmt.Errorf("PeriodDuration: %w", err)
+	if len(r.periodVariants) > 1 {
+		return fmt.Errorf("field 'Period' must have a single type, got %v", r.periodVariants)
+	}
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
+		}
+	}
+	if r.PeriodElement != nil {
+		if err := r.PeriodElement.Validate(); err != nil {
+			return fmt.Errorf("PeriodElement: %w", err)
 		}
 	}
 	for i, item := range r.SpecialPrecautionsForStorage {
@@ -31,3 +55,167 @@ func (r *ProductShelfLife) Validate() error {
 	}
 	return nil
 }
+
+func (r *ProductShelfLife) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.periodVariants) > 1 {
+		issues.add("structure", path+".period", fmt.Sprintf("field 'Period' must have a single type, got %v", r.periodVariants))
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period.ofType("+r.Period.FHIRType()+")", issues)
+	}
+	if r.PeriodElement != nil {
+		r.PeriodElement.validateAll(path+".period", issues)
+	}
+	for i, item := range r.SpecialPrecautionsForStorage {
+		item.validateAll(fmt.Sprintf("%s.specialPrecautionsForStorage[%d]", path, i), issues)
+	}
+}
+
+func (r *ProductShelfLife) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ProductShelfLife", &issues)
+	checkInvariants(r, "ProductShelfLife", &issues)
+	return issues
+}
+
+var productShelfLifeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ProductShelfLife) invariants() []invariant {
+	return productShelfLifeInvariants
+}
+
+func (r ProductShelfLife) MarshalJSON() ([]byte, error) {
+	type alias ProductShelfLife
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "period", r.Period, r.PeriodElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ProductShelfLife) UnmarshalJSON(data []byte) error {
+	type alias ProductShelfLife
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Period, element, r.periodVariants, err = unmarshalChoice(fields, "period", productShelfLifePeriodVariants); err != nil {
+		return err
+	}
+	r.PeriodElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.PeriodElement); err != nil {
+			return fmt.Errorf("_period: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ProductShelfLife) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ProductShelfLife) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ProductShelfLife) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ProductShelfLife) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ProductShelfLife) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Period":
+		return "period", choiceValues(productShelfLifePeriodVariants)
+	}
+	return "", nil
+}
+
+var productShelfLifeElementMetadata = []ElementMetadata{
+	{Path: "ProductShelfLife.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ProductShelfLife.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ProductShelfLife.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ProductShelfLife.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Summary: true},
+	{Path: "ProductShelfLife.period[x]", Name: "period", Field: 4, Min: 0, Max: "1", Types: []string{"Duration", "string"}, Summary: true},
+	{Path: "ProductShelfLife.specialPrecautionsForStorage", Name: "specialPrecautionsForStorage", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}, Summary: true},
+}
+
+func (r *ProductShelfLife) ElementMetadata() []ElementMetadata {
+	return productShelfLifeElementMetadata
+}
+
+// ProductShelfLifePeriod is the type of ProductShelfLife.period[x]. It is implemented by the ProductShelfLifePeriod* variant types.
+type ProductShelfLifePeriod interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isProductShelfLifePeriod()
+}
+
+// ProductShelfLifePeriodDuration is the Duration variant of ProductShelfLifePeriod.
+type ProductShelfLifePeriodDuration struct {
+	Duration
+}
+
+func (v ProductShelfLifePeriodDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v ProductShelfLifePeriodDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v ProductShelfLifePeriodDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v ProductShelfLifePeriodDuration) isProductShelfLifePeriod() {}
+
+// ProductShelfLifePeriodString is the string variant of ProductShelfLifePeriod.
+type ProductShelfLifePeriodString string
+
+func (v ProductShelfLifePeriodString) Validate() error {
+	return nil
+}
+
+func (v ProductShelfLifePeriodString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ProductShelfLifePeriodString) FHIRType() string {
+	return "string"
+}
+
+func (v ProductShelfLifePeriodString) isProductShelfLifePeriod() {}
+
+var productShelfLifePeriodVariants = []ProductShelfLifePeriod{
+	ProductShelfLifePeriodDuration{},
+	ProductShelfLifePeriodString(""),
+}
@@ -0,0 +1,667 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ProfileDefinition is a constraint profile of the specification, such as
+// the vital signs blood pressure profile of Observation: the rules a
+// resource must meet, beyond those of its type, to conform to the profile.
+type ProfileDefinition struct {
+	URL  string
+	Name string
+	// Type is the resource type the profile constrains.
+	Type string
+	// BaseDefinition is the URL of the profile or resource type the profile
+	// is derived from.
+	BaseDefinition string
+	// Elements are the elements the profile constrains further than the
+	// resource type does, in snapshot order.
+	Elements []ProfileElement
+}
+
+// ProfileElement is an element of a profile with the rules the profile
+// sets on it.
+type ProfileElement struct {
+	// Path is the path of the element, such as Observation.component.code.
+	Path string
+	Min  int
+	// Max is the maximum number of items, -1 when unbounded.
+	Max int
+	// Types restricts the element to some of the types of its definition,
+	// such as Quantity for Observation.value[x], or the resource types a
+	// resource element may hold.
+	Types []string
+	// Fixed is the JSON of the value the element must equal exactly.
+	Fixed string
+	// Pattern is the JSON of a value the element must contain: every
+	// property of the pattern must be present with a matching value, and
+	// every item of a repeating property must match an item of the element.
+	Pattern string
+	// Profiles are the profiles the element's items must conform to, one of
+	// them when there are several.
+	Profiles    []string
+	Constraints []ProfileConstraint
+	// Slicing divides the items of a repeating element into slices with
+	// rules of their own, such as the systolic and diastolic components of
+	// a blood pressure.
+	Slicing *ProfileSlicing
+
+	// fixed and pattern are Fixed and Pattern decoded, which the profile
+	// table does once when the package is initialized.
+	fixed, pattern any
+}
+
+// ProfileSlicing describes how the items of an element are told apart
+// into slices and whether items outside the slices are allowed.
+type ProfileSlicing struct {
+	// Discriminators decide which slice an item belongs to: an item belongs
+	// to the first slice whose rules it meets for every discriminator.
+	Discriminators []ProfileDiscriminator
+	// Ordered requires the items of each slice to come before the items of
+	// the slices after it.
+	Ordered bool
+	Rules   string // closed | open | openAtEnd
+	Slices  []ProfileSlice
+}
+
+// ProfileDiscriminator names an element of the items, relative to them,
+// whose value, pattern, type, profile or presence tells the slices apart.
+type ProfileDiscriminator struct {
+	Type string // value | pattern | type | profile | exists
+	Path string // e.g. code, request.method, url or $this
+}
+
+// ProfileSlice is a slice of an element: its cardinality and the rules on
+// its items, whose paths are those of the sliced element.
+type ProfileSlice struct {
+	Name     string
+	Min      int
+	Max      int // -1 when unbounded
+	Elements []ProfileElement
+}
+
+// ProfileConstraint is an invariant a profile adds to an element.
+type ProfileConstraint struct {
+	Key        string
+	Severity   string // error | warning
+	Human      string
+	Expression string
+}
+
+// lookupProfile returns a profile by its canonical URL, for the profiles
+// elements and slices require of their items. It is set by the profile
+// table.
+var lookupProfile func(url string) (*ProfileDefinition, bool)
+
+// validate checks res, a pointer to a resource struct, against the rules
+// of the profile, recording an issue for each rule it breaks.
+func (p *ProfileDefinition) validate(res any) ValidationIssues {
+	var issues ValidationIssues
+	nodes := objectNode(res)
+	if len(nodes) != 1 || !nodes[0].object.IsValid() {
+		issues.add("structure", p.Type, fmt.Sprintf("profile %s: expected a %s", p.Name, p.Type))
+		return issues
+	}
+	root := nodes[0].object
+	if resourceType := resourceTypeOf(root); resourceType != p.Type {
+		issues.add("structure", resourceType, fmt.Sprintf("profile %s: constrains %s, not %s", p.Name, p.Type, resourceType))
+		return issues
+	}
+	c := profileChecker{
+		env:    &fhirpathEnv{resource: nodes, rootResource: nodes},
+		label:  "profile " + p.Name,
+		issues: &issues,
+	}
+	c.checkElements(p.Elements, locatedValue{value: root, path: p.Type}, p.Type)
+	return issues
+}
+
+// profileChecker checks the items of a resource against the elements of a
+// profile or of one of its slices, which label names in the issues.
+type profileChecker struct {
+	env    *fhirpathEnv
+	label  string
+	issues *ValidationIssues
+}
+
+// checkElements checks the descendants of root, an item of the element at
+// rootPath, against elements.
+func (c profileChecker) checkElements(elements []ProfileElement, root locatedValue, rootPath string) {
+	for _, el := range elements {
+		if el.Path == rootPath {
+			c.checkValue(el, root)
+			continue
+		}
+		parentPath, name := profileElementName(el.Path)
+		for _, parent := range profileLocations(root, rootPath, parentPath) {
+			var children []locatedValue
+			for _, child := range childLocations(parent.value, name, parent.path) {
+				if isComplexValue(child.value) || hasPrimitiveValue(child.value) {
+					children = append(children, child)
+				}
+			}
+			location := parent.path + "." + name
+			if len(children) < el.Min {
+				c.issues.add("required", location, fmt.Sprintf("%s: minimum required = %d, but only found %d", c.label, el.Min, len(children)))
+			}
+			if el.Max >= 0 && len(children) > el.Max {
+				c.issues.add("structure", location, fmt.Sprintf("%s: maximum allowed = %d, but found %d", c.label, el.Max, len(children)))
+			}
+			for _, child := range children {
+				c.checkValue(el, child)
+			}
+			if el.Slicing != nil {
+				c.checkSlicing(el, location, children)
+			}
+		}
+	}
+}
+
+// checkValue checks a single item of an element against the element's type,
+// fixed value, pattern, profiles and constraints.
+func (c profileChecker) checkValue(el ProfileElement, item locatedValue) {
+	if len(el.Types) > 0 {
+		if fhirType := itemType(item); fhirType != "" && !containsString(el.Types, fhirType) {
+			c.issues.add("structure", item.path, fmt.Sprintf("%s: type %s is not allowed, expected %s", c.label, fhirType, strings.Join(el.Types, " | ")))
+		}
+	}
+	if el.Fixed != "" || el.Pattern != "" {
+		value, err := profileJSON(item.value)
+		if err != nil {
+			c.issues.add("value", item.path, fmt.Sprintf("%s: %v", c.label, err))
+		} else if el.Fixed != "" && !reflect.DeepEqual(value, el.fixedValue()) {
+			c.issues.add("value", item.path, fmt.Sprintf("%s: value must be exactly %s", c.label, el.Fixed))
+		} else if el.Pattern != "" && !matchesPattern(el.patternValue(), value) {
+			c.issues.add("value", item.path, fmt.Sprintf("%s: value does not match the pattern %s", c.label, el.Pattern))
+		}
+	}
+	if !conformsToProfiles(el.Profiles, item) {
+		c.issues.add("structure", item.path, fmt.Sprintf("%s: value does not conform to %s", c.label, strings.Join(el.Profiles, " | ")))
+	}
+	for _, con := range el.Constraints {
+		inv := invariant{key: con.Key, severity: con.Severity, human: con.Human, expression: con.Expression}
+		holds, err := invariantHolds(c.env, inv, item)
+		if err != nil {
+			*c.issues = append(*c.issues, ValidationIssue{
+				Severity: "warning",
+				Code:     "not-supported",
+				Path:     item.path,
+				Message:  fmt.Sprintf("%s: %s could not be checked: %v", c.label, con.Key, err),
+			})
+		} else if !holds {
+			*c.issues = append(*c.issues, ValidationIssue{
+				Severity: con.Severity,
+				Code:     "invariant",
+				Path:     item.path,
+				Message:  fmt.Sprintf("%s: %s: %s", c.label, con.Key, con.Human),
+			})
+		}
+	}
+}
+
+// checkSlicing sorts the items of the sliced element el, found at location,
+// into its slices, and checks the slicing rules, the cardinality of each
+// slice and the rules of each slice on its items.
+func (c profileChecker) checkSlicing(el ProfileElement, location string, items []locatedValue) {
+	slicing := el.Slicing
+	counts := make([]int, len(slicing.Slices))
+	last, unmatched := -1, false
+	for _, item := range items {
+		i := slicing.sliceOf(el.Path, item, counts)
+		if i < 0 {
+			unmatched = true
+			if slicing.Rules == "closed" {
+				c.issues.add("structure", item.path, fmt.Sprintf("%s: item does not match any slice of %s", c.label, el.Path))
+			}
+			continue
+		}
+		slice := slicing.Slices[i]
+		counts[i]++
+		if slicing.Rules == "openAtEnd" && unmatched {
+			c.issues.add("structure", item.path, fmt.Sprintf("%s: slice %s: item comes after items that match no slice", c.label, slice.Name))
+		}
+		if slicing.Ordered && i < last {
+			c.issues.add("structure", item.path, fmt.Sprintf("%s: slice %s: item is out of order", c.label, slice.Name))
+		}
+		last = max(last, i)
+		sliceChecker := c
+		sliceChecker.label = c.label + ": slice " + slice.Name
+		sliceChecker.checkElements(slice.Elements, item, el.Path)
+	}
+	for i, slice := range slicing.Slices {
+		if counts[i] < slice.Min {
+			c.issues.add("required", location, fmt.Sprintf("%s: slice %s: minimum required = %d, but only found %d", c.label, slice.Name, slice.Min, counts[i]))
+		}
+		if slice.Max >= 0 && counts[i] > slice.Max {
+			c.issues.add("structure", location, fmt.Sprintf("%s: slice %s: maximum allowed = %d, but found %d", c.label, slice.Name, slice.Max, counts[i]))
+		}
+	}
+}
+
+// sliceOf returns the index of the slice item belongs to, or -1 when it
+// matches none. An item matching several slices goes to the first of them
+// with room left, so that a slice for any item, such as the other entries
+// of a Bundle, can follow slices for particular ones.
+func (s *ProfileSlicing) sliceOf(slicedPath string, item locatedValue, counts []int) int {
+	first := -1
+	for i, slice := range s.Slices {
+		if !s.matches(slicedPath, slice, item) {
+			continue
+		}
+		if slice.Max < 0 || counts[i] < slice.Max {
+			return i
+		}
+		if first < 0 {
+			first = i
+		}
+	}
+	return first
+}
+
+// matches reports whether item meets the rules slice sets for every
+// discriminator. A discriminator the slice sets no rule for matches any
+// item.
+func (s *ProfileSlicing) matches(slicedPath string, slice ProfileSlice, item locatedValue) bool {
+	for _, d := range s.Discriminators {
+		path := slicedPath
+		if d.Path != "$this" {
+			path += "." + d.Path
+		}
+		el, ok := slice.element(path)
+		if !ok {
+			continue
+		}
+		values := discriminatorValues(item, d.Path)
+		switch d.Type {
+		case "value", "pattern":
+			if el.Fixed == "" && el.Pattern == "" {
+				continue
+			}
+			if !anyValue(values, func(v locatedValue) bool { return matchesFixed(el, v) }) {
+				return false
+			}
+		case "type":
+			if len(el.Types) == 0 {
+				continue
+			}
+			if !anyValue(values, func(v locatedValue) bool { return containsString(el.Types, itemType(v)) }) {
+				return false
+			}
+		case "profile":
+			if len(el.Profiles) == 0 {
+				continue
+			}
+			if !anyValue(values, func(v locatedValue) bool { return conformsToProfiles(el.Profiles, v) }) {
+				return false
+			}
+		case "exists":
+			if (el.Min > 0 && len(values) == 0) || (el.Max == 0 && len(values) > 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// element returns the element of the slice at path, ignoring the [x] of
+// choice elements.
+func (s ProfileSlice) element(path string) (ProfileElement, bool) {
+	path = strings.ReplaceAll(path, "[x]", "")
+	for _, el := range s.Elements {
+		if strings.ReplaceAll(el.Path, "[x]", "") == path {
+			return el, true
+		}
+	}
+	return ProfileElement{}, false
+}
+
+func anyValue(values []locatedValue, match func(locatedValue) bool) bool {
+	for _, v := range values {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// discriminatorValues returns the values a discriminator path selects in
+// item: $this, element names and the extension('url') and ofType(type)
+// functions.
+func discriminatorValues(item locatedValue, path string) []locatedValue {
+	items := []locatedValue{item}
+	if path == "$this" {
+		return items
+	}
+	for _, step := range splitDiscriminatorPath(path) {
+		var next []locatedValue
+		for _, v := range items {
+			switch {
+			case strings.HasPrefix(step, "extension("):
+				url := strings.Trim(strings.TrimSuffix(strings.TrimPrefix(step, "extension("), ")"), "'")
+				for _, ext := range structChildren(v, "extension") {
+					for _, u := range structChildren(ext, "url") {
+						if s, ok := primitiveString(u.value); ok && s == url {
+							next = append(next, ext)
+						}
+					}
+				}
+			case strings.HasPrefix(step, "ofType("):
+				if itemType(v) == strings.TrimSuffix(strings.TrimPrefix(step, "ofType("), ")") {
+					next = append(next, v)
+				}
+			default:
+				next = append(next, structChildren(v, strings.TrimSuffix(step, "[x]"))...)
+			}
+		}
+		items = next
+	}
+	return items
+}
+
+func structChildren(item locatedValue, name string) []locatedValue {
+	if item.value.Kind() != reflect.Struct {
+		return nil
+	}
+	return childLocations(item.value, name, item.path)
+}
+
+// splitDiscriminatorPath splits a discriminator path into its steps, keeping
+// the dots of quoted function arguments, as in extension('http://...').
+func splitDiscriminatorPath(path string) []string {
+	var steps []string
+	start, quoted := 0, false
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '\'':
+			quoted = !quoted
+		case '.':
+			if !quoted {
+				steps = append(steps, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(steps, path[start:])
+}
+
+// itemType returns the FHIR type of an item: the type of a choice value or
+// the type of a resource.
+func itemType(item locatedValue) string {
+	if item.value.Kind() == reflect.Struct {
+		if resourceType := resourceTypeOf(item.value); resourceType != "" {
+			return resourceType
+		}
+	}
+	return item.fhirType
+}
+
+// matchesFixed reports whether item equals the fixed value of el or
+// contains its pattern.
+func matchesFixed(el ProfileElement, item locatedValue) bool {
+	value, err := profileJSON(item.value)
+	if err != nil {
+		return false
+	}
+	if el.Fixed != "" && !reflect.DeepEqual(value, el.fixedValue()) {
+		return false
+	}
+	return el.Pattern == "" || matchesPattern(el.patternValue(), value)
+}
+
+// decodeProfileValues decodes the fixed values and patterns of the elements
+// of profiles and of their slices, so that checks need not decode them on
+// every item.
+func decodeProfileValues(profiles []ProfileDefinition) {
+	for i := range profiles {
+		decodeElementValues(profiles[i].Elements)
+	}
+}
+
+func decodeElementValues(elements []ProfileElement) {
+	for i := range elements {
+		el := &elements[i]
+		if el.Fixed != "" {
+			el.fixed = mustProfileJSON(el.Fixed)
+		}
+		if el.Pattern != "" {
+			el.pattern = mustProfileJSON(el.Pattern)
+		}
+		if el.Slicing != nil {
+			for j := range el.Slicing.Slices {
+				decodeElementValues(el.Slicing.Slices[j].Elements)
+			}
+		}
+	}
+}
+
+// fixedValue returns the decoded Fixed, decoding it for elements built
+// outside the profile table.
+func (el ProfileElement) fixedValue() any {
+	if el.fixed != nil {
+		return el.fixed
+	}
+	return mustProfileJSON(el.Fixed)
+}
+
+// patternValue returns the decoded Pattern, as fixedValue does Fixed.
+func (el ProfileElement) patternValue() any {
+	if el.pattern != nil {
+		return el.pattern
+	}
+	return mustProfileJSON(el.Pattern)
+}
+
+// conformsToProfiles reports whether item conforms to one of profiles.
+// Profiles not in the table, such as those of extensions, are not checked.
+func conformsToProfiles(profiles []string, item locatedValue) bool {
+	if len(profiles) == 0 || lookupProfile == nil {
+		return true
+	}
+	for _, url := range profiles {
+		profile, ok := lookupProfile(url)
+		if !ok {
+			return true
+		}
+		value := item.value
+		if value.CanAddr() {
+			value = value.Addr()
+		}
+		if !profile.validate(value.Interface()).HasErrors() {
+			return true
+		}
+	}
+	return false
+}
+
+// profileElementName splits an element path into the path of its parent
+// and its name, without the [x] of choice elements.
+func profileElementName(path string) (string, string) {
+	i := strings.LastIndexByte(path, '.')
+	return path[:i], strings.TrimSuffix(path[i+1:], "[x]")
+}
+
+// profileLocations returns the items an element path selects below root,
+// an item of the element at rootPath, with their locations.
+func profileLocations(root locatedValue, rootPath, path string) []locatedValue {
+	items := []locatedValue{root}
+	rest, _ := strings.CutPrefix(path, rootPath)
+	for _, name := range strings.Split(strings.TrimPrefix(rest, "."), ".") {
+		if name == "" {
+			continue
+		}
+		name = strings.TrimSuffix(name, "[x]")
+		var next []locatedValue
+		for _, item := range items {
+			next = append(next, structChildren(item, name)...)
+		}
+		items = next
+	}
+	return items
+}
+
+func hasPrimitiveValue(v reflect.Value) bool {
+	_, ok := primitiveString(v)
+	return ok
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// profileJSON returns the JSON value of an item, decoded into maps, slices
+// and scalars for comparison with fixed values and patterns.
+func profileJSON(v reflect.Value) (any, error) {
+	if v.CanAddr() {
+		v = v.Addr()
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return decodeProfileJSON(data)
+}
+
+// decodeProfileJSON decodes JSON keeping numbers as json.Number, so that
+// decimals compare by their text and 1.50 does not equal 1.5.
+func decodeProfileJSON(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var value any
+	err := d.Decode(&value)
+	return value, err
+}
+
+func mustProfileJSON(data string) any {
+	value, err := decodeProfileJSON([]byte(data))
+	if err != nil {
+		panic(fmt.Sprintf("invalid profile value %s: %v", data, err))
+	}
+	return value
+}
+
+// matchesPattern reports whether value contains pattern: objects match when
+// each property of the pattern matches, arrays when each item of the
+// pattern matches some item of the value, and scalars when they are equal.
+func matchesPattern(pattern, value any) bool {
+	switch p := pattern.(type) {
+	case map[string]any:
+		v, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, item := range p {
+			if !matchesPattern(item, v[key]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		v, ok := value.([]any)
+		if !ok {
+			return false
+		}
+		for _, item := range p {
+			found := false
+			for _, candidate := range v {
+				if matchesPattern(item, candidate) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(pattern, value)
+}
+
+// template returns the JSON object of a new resource of the profile: its
+// resource type, the profile in meta.profile, and the fixed values and
+// patterns of the profile's top-level elements, with an item for each
+// required slice of them.
+func (p *ProfileDefinition) template() map[string]any {
+	value := map[string]any{
+		"resourceType": p.Type,
+		"meta":         map[string]any{"profile": []any{p.URL}},
+	}
+	for _, el := range p.Elements {
+		if el.Path == p.Type || strings.HasSuffix(el.Path, "[x]") {
+			continue
+		}
+		parent, name := profileElementName(el.Path)
+		if parent != p.Type {
+			continue
+		}
+		var items []any
+		if item, ok := elementValue(el); ok {
+			items = append(items, item)
+		}
+		if el.Slicing != nil {
+			for _, slice := range el.Slicing.Slices {
+				if item, ok := slice.template(el.Path); ok {
+					for i := 0; i < slice.Min; i++ {
+						items = append(items, item)
+					}
+				}
+			}
+		}
+		switch {
+		case len(items) == 0:
+		case el.Max == 1:
+			value[name] = items[0]
+		default:
+			value[name] = items
+		}
+	}
+	return value
+}
+
+// template returns an item of the slice of the element at slicedPath: the
+// slice's fixed value or pattern, or an object of those of its children.
+func (s ProfileSlice) template(slicedPath string) (any, bool) {
+	item := map[string]any{}
+	for _, el := range s.Elements {
+		if el.Path == slicedPath {
+			if value, ok := elementValue(el); ok {
+				return value, true
+			}
+			continue
+		}
+		parent, name := profileElementName(el.Path)
+		if parent != slicedPath || strings.HasSuffix(el.Path, "[x]") {
+			continue
+		}
+		if value, ok := elementValue(el); ok {
+			if el.Max == 1 {
+				item[name] = value
+			} else {
+				item[name] = []any{value}
+			}
+		}
+	}
+	return item, len(item) > 0
+}
+
+// elementValue returns the fixed value or pattern of an element.
+func elementValue(el ProfileElement) (any, bool) {
+	data := el.Fixed
+	if data == "" {
+		data = el.Pattern
+	}
+	if data == "" {
+		return nil, false
+	}
+	return mustProfileJSON(data), true
+}
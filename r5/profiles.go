@@ -0,0 +1,918 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+var profileDefinitions = []ProfileDefinition{
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/familymemberhistory-genetic", Name: "FamilyMemberHistoryForGeneticsAnalysis", Type: "FamilyMemberHistory", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory",
+		Elements: []ProfileElement{
+			{Path: "FamilyMemberHistory.extension", Min: 0, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "url"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "parent", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "FamilyMemberHistory.extension", Min: 0, Max: -1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/family-member-history-genetics-parent"}},
+						{Path: "FamilyMemberHistory.extension.url", Min: 1, Max: 1, Fixed: "\"http://hl7.org/fhir/StructureDefinition/family-member-history-genetics-parent\""},
+					}},
+					{Name: "sibling", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "FamilyMemberHistory.extension", Min: 0, Max: -1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/family-member-history-genetics-sibling"}},
+						{Path: "FamilyMemberHistory.extension.url", Min: 1, Max: 1, Fixed: "\"http://hl7.org/fhir/StructureDefinition/family-member-history-genetics-sibling\""},
+					}},
+					{Name: "observations", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "FamilyMemberHistory.extension", Min: 0, Max: -1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/family-member-history-genetics-observation"}},
+						{Path: "FamilyMemberHistory.extension.url", Min: 1, Max: 1, Fixed: "\"http://hl7.org/fhir/StructureDefinition/family-member-history-genetics-observation\""},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/provenance-relevant-history", Name: "ProvenanceRelevantHistory", Type: "Provenance", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Provenance",
+		Elements: []ProfileElement{
+			{Path: "Provenance.occurred[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Provenance.activity", Min: 1, Max: 1},
+			{Path: "Provenance.agent", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "type"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "Author", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Provenance.agent.type", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"AUT\",\"system\":\"http://terminology.hl7.org/CodeSystem/v3-ParticipationType\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Provenance.agent.type", Min: 1, Max: 1},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/medicalproductofhumanorigin", Name: "MedicalProductOfHumanOrigin", Type: "BiologicallyDerivedProduct", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct",
+		Elements: []ProfileElement{
+			{Path: "BiologicallyDerivedProduct.productCategory", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "MPHOCode", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "BiologicallyDerivedProduct.productCategory", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"mpho\",\"system\":\"http://hl7.org/fhir/product-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "BiologicallyDerivedProduct.productCode", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.productCode.coding", Min: 1, Max: -1},
+			{Path: "BiologicallyDerivedProduct.productCode.coding.system", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.productCode.coding.code", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.identifier", Min: 1, Max: -1},
+			{Path: "BiologicallyDerivedProduct.identifier.system", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.identifier.value", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.biologicalSourceEvent", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.biologicalSourceEvent.system", Min: 1, Max: 1},
+			{Path: "BiologicallyDerivedProduct.biologicalSourceEvent.value", Min: 1, Max: 1},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/vitalsigns", Name: "Observationvitalsignsbase", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Observation",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/vitalspanel", Name: "Observationvitalspanel", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"85353-1\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 0, Types: []string{"Quantity"}},
+			{Path: "Observation.hasMember", Min: 2, Max: -1},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/resprate", Name: "Observationresprate", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"9279-1\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/oxygensat", Name: "Observationoxygensat", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"2708-6\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/heartrate", Name: "Observationheartrate", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"8867-4\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/headcircum", Name: "Observationheadcircum", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"9843-4\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/bp", Name: "Observationbp", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"85354-9\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 0, Types: []string{"Quantity"}},
+			{Path: "Observation.component", Min: 2, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "code"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "SystolicBP", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"8480-6\",\"system\":\"http://loinc.org\"}]}"},
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+							Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+							Rules:          "closed",
+							Slices: []ProfileSlice{
+								{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+									{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+								}},
+							},
+						}},
+					}},
+					{Name: "DiastolicBP", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"8462-4\",\"system\":\"http://loinc.org\"}]}"},
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+							Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+							Rules:          "closed",
+							Slices: []ProfileSlice{
+								{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+									{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+								}},
+							},
+						}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/bodyweight", Name: "Observationbodyweight", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"29463-7\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/bodytemp", Name: "Observationbodytemp", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"8310-5\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/bodyheight", Name: "Observationbodyheight", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"8302-2\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/bmi", Name: "Observationbmi", Type: "Observation", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/vitalsigns",
+		Elements: []ProfileElement{
+			{Path: "Observation", Min: 0, Max: -1, Constraints: []ProfileConstraint{
+				{Key: "vsp-1", Severity: "error", Human: "if Observation.effective[x] (as dateTime) has a value then that value shall be precise at least to the day", Expression: "(effective as dateTime).toString().length() >= 8"},
+				{Key: "vsp-2", Severity: "error", Human: "If there is no component or hasMember element then either a value[x] or a data absent reason must be present.", Expression: "(component.empty() and hasMember.empty()) implies (dataAbsentReason.exists() or value.exists())"},
+			}},
+			{Path: "Observation.category", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "pattern", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "VSCat", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.category", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"vital-signs\",\"system\":\"http://terminology.hl7.org/CodeSystem/observation-category\"}]}"},
+					}},
+				},
+			}},
+			{Path: "Observation.code", Min: 1, Max: 1, Pattern: "{\"coding\":[{\"code\":\"39156-5\",\"system\":\"http://loinc.org\"}]}"},
+			{Path: "Observation.subject", Min: 1, Max: 1},
+			{Path: "Observation.effective[x]", Min: 1, Max: 1, Types: []string{"dateTime"}},
+			{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+			{Path: "Observation.component.value[x]", Min: 0, Max: 1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "type", Path: "$this"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "valueQuantity", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Observation.component.value[x]", Min: 0, Max: 1, Types: []string{"Quantity"}},
+					}},
+				},
+			}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/catalog", Name: "ProfileForCatalog", Type: "Composition", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Composition",
+		Elements: []ProfileElement{
+			{Path: "Composition.extension", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "url"}},
+				Rules:          "open",
+				Slices: []ProfileSlice{
+					{Name: "ValidityPeriod", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Composition.extension", Min: 1, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/cqm-ValidityPeriod"}},
+						{Path: "Composition.extension.url", Min: 1, Max: 1, Fixed: "\"http://hl7.org/fhir/StructureDefinition/cqm-ValidityPeriod\""},
+					}},
+				},
+			}},
+			{Path: "Composition.type", Min: 1, Max: 1, Fixed: "{\"text\":\"Catalog\"}"},
+			{Path: "Composition.category", Min: 1, Max: 1},
+			{Path: "Composition.subject", Min: 0, Max: 0},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/history-bundle", Name: "HistoryBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"history\""},
+			{Path: "Bundle.entry", Min: 0, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "request.method"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "put", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"PUT\""},
+						{Path: "Bundle.entry.response", Min: 1, Max: 1},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "post", Min: 0, Max: 0, Elements: []ProfileElement{
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"POST\""},
+						{Path: "Bundle.entry.response", Min: 1, Max: 1},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "get", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"GET\""},
+						{Path: "Bundle.entry.response", Min: 1, Max: 1},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "delete", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"DELETE\""},
+						{Path: "Bundle.entry.response", Min: 1, Max: 1},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "patch", Min: 0, Max: 0, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+				},
+			}},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/search-set-bundle", Name: "SearchSetBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"searchset\""},
+			{Path: "Bundle.entry", Min: 0, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "search.mode"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "operationOutcome", Min: 0, Max: 1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1, Types: []string{"OperationOutcome"}},
+						{Path: "Bundle.entry.search.mode", Min: 1, Max: 1, Pattern: "\"outcome\""},
+						{Path: "Bundle.entry.request", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "other", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search.mode", Min: 0, Max: 1},
+						{Path: "Bundle.entry.request", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+				},
+			}},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/subscription-notification-bundle", Name: "SubscriptionNotificationBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"subscription-notification\""},
+			{Path: "Bundle.total", Min: 0, Max: 0},
+			{Path: "Bundle.entry", Min: 1, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "exists", Path: "$this"}},
+				Ordered:        true,
+				Rules:          "openAtEnd",
+				Slices: []ProfileSlice{
+					{Name: "first", Min: 1, Max: 1, Elements: []ProfileElement{
+						{Path: "Bundle.entry", Min: 1, Max: 1},
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1, Types: []string{"SubscriptionStatus"}},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "other", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry", Min: 0, Max: -1},
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+				},
+			}},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/batch-bundle", Name: "BatchBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"bundle\""},
+			{Path: "Bundle.total", Min: 0, Max: 0},
+			{Path: "Bundle.entry", Min: 0, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "request.method"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "put", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"PUT\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "post", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"POST\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "get", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"GET\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "delete", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"DELETE\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "patch", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"PATCH\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "head", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"HEAD\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+				},
+			}},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/batch-response-bundle", Name: "BatchResponseBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"batch-response\""},
+			{Path: "Bundle.total", Min: 0, Max: 0},
+			{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+			{Path: "Bundle.entry.search", Min: 0, Max: 0},
+			{Path: "Bundle.entry.request", Min: 0, Max: 0},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/transaction-bundle", Name: "TransactionBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"transaction\""},
+			{Path: "Bundle.total", Min: 0, Max: 0},
+			{Path: "Bundle.entry", Min: 0, Max: -1, Slicing: &ProfileSlicing{
+				Discriminators: []ProfileDiscriminator{{Type: "value", Path: "request.method"}},
+				Rules:          "closed",
+				Slices: []ProfileSlice{
+					{Name: "put", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"PUT\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "post", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"POST\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "get", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"GET\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "delete", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"DELETE\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "patch", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"PATCH\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+					{Name: "head", Min: 0, Max: -1, Elements: []ProfileElement{
+						{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+						{Path: "Bundle.entry.resource", Min: 0, Max: 0},
+						{Path: "Bundle.entry.search", Min: 0, Max: 0},
+						{Path: "Bundle.entry.request", Min: 1, Max: 1},
+						{Path: "Bundle.entry.request.method", Min: 1, Max: 1, Pattern: "\"HEAD\""},
+						{Path: "Bundle.entry.response", Min: 0, Max: 0},
+						{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+					}},
+				},
+			}},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/transaction-response-bundle", Name: "TransactionResponseBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.type", Min: 1, Max: 1, Pattern: "\"transaction-response\""},
+			{Path: "Bundle.total", Min: 0, Max: 0},
+			{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+			{Path: "Bundle.entry.search", Min: 0, Max: 0},
+			{Path: "Bundle.entry.request", Min: 0, Max: 0},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+	{
+		URL: "http://hl7.org/fhir/StructureDefinition/document-bundle", Name: "DocumentBundle", Type: "Bundle", BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Elements: []ProfileElement{
+			{Path: "Bundle.identifier", Min: 1, Max: 1},
+			{Path: "Bundle.identifier.system", Min: 1, Max: 1},
+			{Path: "Bundle.identifier.value", Min: 1, Max: 1},
+			{Path: "Bundle.type", Min: 1, Max: 1, Fixed: "\"document\""},
+			{Path: "Bundle.timestamp", Min: 1, Max: 1},
+			{Path: "Bundle.total", Min: 0, Max: 0},
+			{Path: "Bundle.entry", Min: 1, Max: -1},
+			{Path: "Bundle.entry.fullUrl", Min: 1, Max: 1},
+			{Path: "Bundle.entry.resource", Min: 1, Max: 1},
+			{Path: "Bundle.entry.search", Min: 0, Max: 0},
+			{Path: "Bundle.entry.request", Min: 0, Max: 0},
+			{Path: "Bundle.entry.response", Min: 0, Max: 0},
+			{Path: "Bundle.entry.response.outcome", Min: 0, Max: 1, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+			{Path: "Bundle.issues", Min: 0, Max: 0, Profiles: []string{"http://hl7.org/fhir/StructureDefinition/OperationOutcome"}},
+		},
+	},
+}
+
+var (
+	FamilyMemberHistoryForGeneticsAnalysisProfile = &profileDefinitions[0]
+	ProvenanceRelevantHistoryProfile              = &profileDefinitions[1]
+	MedicalProductOfHumanOriginProfile            = &profileDefinitions[2]
+	ObservationvitalsignsbaseProfile              = &profileDefinitions[3]
+	ObservationvitalspanelProfile                 = &profileDefinitions[4]
+	ObservationresprateProfile                    = &profileDefinitions[5]
+	ObservationoxygensatProfile                   = &profileDefinitions[6]
+	ObservationheartrateProfile                   = &profileDefinitions[7]
+	ObservationheadcircumProfile                  = &profileDefinitions[8]
+	ObservationbpProfile                          = &profileDefinitions[9]
+	ObservationbodyweightProfile                  = &profileDefinitions[10]
+	ObservationbodytempProfile                    = &profileDefinitions[11]
+	ObservationbodyheightProfile                  = &profileDefinitions[12]
+	ObservationbmiProfile                         = &profileDefinitions[13]
+	ProfileForCatalogProfile                      = &profileDefinitions[14]
+	HistoryBundleProfile                          = &profileDefinitions[15]
+	SearchSetBundleProfile                        = &profileDefinitions[16]
+	SubscriptionNotificationBundleProfile         = &profileDefinitions[17]
+	BatchBundleProfile                            = &profileDefinitions[18]
+	BatchResponseBundleProfile                    = &profileDefinitions[19]
+	TransactionBundleProfile                      = &profileDefinitions[20]
+	TransactionResponseBundleProfile              = &profileDefinitions[21]
+	DocumentBundleProfile                         = &profileDefinitions[22]
+)
+
+func init() {
+	lookupProfile = ProfileByURL
+	decodeProfileValues(profileDefinitions)
+}
+
+// Profiles returns the constraint profiles of the specification.
+func Profiles() []*ProfileDefinition {
+	profiles := make([]*ProfileDefinition, len(profileDefinitions))
+	for i := range profileDefinitions {
+		profiles[i] = &profileDefinitions[i]
+	}
+	return profiles
+}
+
+// ProfilesFor returns the constraint profiles of a resource type.
+func ProfilesFor(resourceType string) []*ProfileDefinition {
+	var profiles []*ProfileDefinition
+	for i := range profileDefinitions {
+		if profileDefinitions[i].Type == resourceType {
+			profiles = append(profiles, &profileDefinitions[i])
+		}
+	}
+	return profiles
+}
+
+// ProfileByURL returns a constraint profile by its canonical URL, as
+// meta.profile refers to it.
+func ProfileByURL(url string) (*ProfileDefinition, bool) {
+	for i := range profileDefinitions {
+		if profileDefinitions[i].URL == url {
+			return &profileDefinitions[i], true
+		}
+	}
+	return nil, false
+}
+
+// Validate checks res against the profile: the cardinality, types, fixed
+// values, patterns and constraints the profile sets on its elements. It
+// reports only what the profile adds; ValidateAll checks the rest.
+func (p *ProfileDefinition) Validate(res Resource) ValidationIssues {
+	return p.validate(res)
+}
+
+// New returns a resource of the profile's type claiming the profile in
+// meta.profile, with the fixed values and patterns of its top-level
+// elements set, such as the LOINC code of a vital sign.
+func (p *ProfileDefinition) New() (Resource, error) {
+	data, err := json.Marshal(p.template())
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalResource(data)
+}
+
+// ValidateProfiles checks res against each profile of the specification
+// its meta.profile claims, ignoring the version of versioned URLs. Profiles
+// defined elsewhere are not checked.
+func ValidateProfiles(res Resource) ValidationIssues {
+	meta := res.GetMeta()
+	if meta == nil {
+		return nil
+	}
+	var issues ValidationIssues
+	for _, url := range meta.Profile {
+		url, _, _ = strings.Cut(url, "|")
+		if profile, ok := ProfileByURL(url); ok {
+			issues = append(issues, profile.Validate(res)...)
+		}
+	}
+	return issues
+}
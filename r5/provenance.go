@@ -2,35 +2,51 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Provenance of a resource is a record that describes entities and processes involved in producing and delivering or otherwise influencing that resource. Provenance provides a critical foundation for assessing authenticity, enabling trust, and allowing reproducibility. Provenance assertions are a form of contextual metadata and can themselves become important records with their own provenance. Provenance statement indicates clinical significance in terms of confidence in authenticity, reliability, and trustworthiness, integrity, and stage in lifecycle (e.g. Document Completion - has the artifact been legally authenticated), all of which MAY impact security, privacy, and trust policies.
 type Provenance struct {
-	ResourceType     string              `json:"resourceType" bson:"resource_type"`                              // Type of resource
-	Id               *string             `json:"id,omitempty" bson:"id,omitempty"`                               // Logical id of this artifact
-	Meta             *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                           // Metadata about the resource
-	ImplicitRules    *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`        // A set of rules under which this content was created
-	Language         *string             `json:"language,omitempty" bson:"language,omitempty"`                   // Language of the resource content
-	Text             *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                           // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`                 // Contained, inline Resources
-	Target           []Reference         `json:"target" bson:"target"`                                           // Target Reference(s) (usually version specific)
-	OccurredPeriod   *Period             `json:"occurredPeriod,omitempty" bson:"occurred_period,omitempty"`      // When the activity occurred
-	OccurredDateTime *string             `json:"occurredDateTime,omitempty" bson:"occurred_date_time,omitempty"` // When the activity occurred
-	Recorded         *string             `json:"recorded,omitempty" bson:"recorded,omitempty"`                   // When the activity was recorded / updated
-	Policy           []string            `json:"policy,omitempty" bson:"policy,omitempty"`                       // Policy or plan the activity was defined by
-	Location         *Reference          `json:"location,omitempty" bson:"location,omitempty"`                   // Where the activity occurred
-	Authorization    []CodeableReference `json:"authorization,omitempty" bson:"authorization,omitempty"`         // Authorization (purposeOfUse) related to the event
-	Why              *string             `json:"why,omitempty" bson:"why,omitempty"`                             // Why was the event performed?
-	Activity         *CodeableConcept    `json:"activity,omitempty" bson:"activity,omitempty"`                   // Activity that occurred
-	BasedOn          []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                    // Workflow authorization within which this event occurred
-	Patient          *Reference          `json:"patient,omitempty" bson:"patient,omitempty"`                     // The patient is the subject of the data created/updated (.target) by the activity
-	Encounter        *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                 // Encounter within which this event occurred or which the event is tightly associated
-	Agent            []ProvenanceAgent   `json:"agent" bson:"agent"`                                             // Actor involved
-	Entity           []ProvenanceEntity  `json:"entity,omitempty" bson:"entity,omitempty"`                       // An entity used in this activity
-	Signature        []Signature         `json:"signature,omitempty" bson:"signature,omitempty"`                 // Signature on target
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Target               []Reference         `json:"target" bson:"target"`                                             // Target Reference(s) (usually version specific)
+	Occurred             ProvenanceOccurred  `json:"-" bson:"occurred,omitempty"`                                      // When the activity occurred
+	OccurredElement      *Element            `json:"-" bson:"occurred_element,omitempty"`                              // Extensions for occurred[x]
+	Recorded             *Instant            `json:"recorded,omitempty" bson:"recorded,omitempty"`                     // When the activity was recorded / updated
+	RecordedElement      *Element            `json:"_recorded,omitempty" bson:"recorded_element,omitempty"`            // Extensions for recorded
+	Policy               []string            `json:"policy,omitempty" bson:"policy,omitempty"`                         // Policy or plan the activity was defined by
+	PolicyElement        []*Element          `json:"_policy,omitempty" bson:"policy_element,omitempty"`                // Extensions for policy
+	Location             *Reference          `json:"location,omitempty" bson:"location,omitempty"`                     // Where the activity occurred
+	Authorization        []CodeableReference `json:"authorization,omitempty" bson:"authorization,omitempty"`           // Authorization (purposeOfUse) related to the event
+	Why                  *string             `json:"why,omitempty" bson:"why,omitempty"`                               // Why was the event performed?
+	WhyElement           *Element            `json:"_why,omitempty" bson:"why_element,omitempty"`                      // Extensions for why
+	Activity             *CodeableConcept    `json:"activity,omitempty" bson:"activity,omitempty"`                     // Activity that occurred
+	BasedOn              []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Workflow authorization within which this event occurred
+	Patient              *Reference          `json:"patient,omitempty" bson:"patient,omitempty"`                       // The patient is the subject of the data created/updated (.target) by the activity
+	Encounter            *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter within which this event occurred or which the event is tightly associated
+	Agent                []ProvenanceAgent   `json:"agent" bson:"agent"`                                               // Actor involved
+	Entity               []ProvenanceEntity  `json:"entity,omitempty" bson:"entity,omitempty"`                         // An entity used in this activity
+	Signature            []Signature         `json:"signature,omitempty" bson:"signature,omitempty"`                   // Signature on target
+
+	occurredVariants []string // JSON properties of Provenance.occurred[x] when more than one was decoded
 }
 
+var provenanceLocationTargets = []string{"Location"}
+
+var provenancePatientTargets = []string{"Patient"}
+
+var provenanceEncounterTargets = []string{"Encounter"}
+
 func (r *Provenance) Validate() error {
 	if r.ResourceType != "Provenance" {
 		return fmt.Errorf("invalid resourceType: expected 'Provenance', got '%s'", r.ResourceType)
@@ -40,11 +56,39 @@ func (r *Provenance) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if len(r.Target) < 1 {
 		return fmt.Errorf("field 'Target' must have at least 1 elements")
 	}
@@ -53,9 +97,40 @@ func (r *Provenance) Validate() error {
 			return fmt.Errorf("Target[%d]: %w", i, err)
 		}
 	}
-	if r.OccurredPeriod != nil {
-		if err := r.OccurredPeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurredPeriod: %w", err)
+	if len(r.occurredVariants) > 1 {
+		return fmt.Errorf("field 'Occurred' must have a single type, got %v", r.occurredVariants)
+	}
+	if r.Occurred != nil {
+		if err := r.Occurred.Validate(); err != nil {
+			return fmt.Errorf("Occurred: %w", err)
+		}
+	}
+	if r.OccurredElement != nil {
+		if err := r.OccurredElement.Validate(); err != nil {
+			return fmt.Errorf("OccurredElement: %w", err)
+		}
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			return fmt.Errorf("Recorded: %w", err)
+		}
+	}
+	if r.RecordedElement != nil {
+		if err := r.RecordedElement.Validate(); err != nil {
+			return fmt.Errorf("RecordedElement: %w", err)
+		}
+	}
+	for i, item := range r.PolicyElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PolicyElement[%d]: %w", i, err)
+		}
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, provenanceLocationTargets); target != "" {
+			return fmt.Errorf("field 'Location' cannot refer to %s, expected one of %v", target, provenanceLocationTargets)
 		}
 	}
 	if r.Location != nil {
@@ -68,6 +143,11 @@ func (r *Provenance) Validate() error {
 			return fmt.Errorf("Authorization[%d]: %w", i, err)
 		}
 	}
+	if r.WhyElement != nil {
+		if err := r.WhyElement.Validate(); err != nil {
+			return fmt.Errorf("WhyElement: %w", err)
+		}
+	}
 	if r.Activity != nil {
 		if err := r.Activity.Validate(); err != nil {
 			return fmt.Errorf("Activity: %w", err)
@@ -78,11 +158,21 @@ func (r *Provenance) Validate() error {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, provenancePatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, provenancePatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, provenanceEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, provenanceEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -106,18 +196,376 @@ func (r *Provenance) Validate() error {
 			return fmt.Errorf("Signature[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Provenance")
+}
+
+func (r *Provenance) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Provenance" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Provenance', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Target) < 1 {
+		issues.add("required", path+".target", "field 'Target' must have at least 1 elements")
+	}
+	for i, item := range r.Target {
+		item.validateAll(fmt.Sprintf("%s.target[%d]", path, i), issues)
+	}
+	if len(r.occurredVariants) > 1 {
+		issues.add("structure", path+".occurred", fmt.Sprintf("field 'Occurred' must have a single type, got %v", r.occurredVariants))
+	}
+	if r.Occurred != nil {
+		r.Occurred.validateAll(path+".occurred.ofType("+r.Occurred.FHIRType()+")", issues)
+	}
+	if r.OccurredElement != nil {
+		r.OccurredElement.validateAll(path+".occurred", issues)
+	}
+	if r.Recorded != nil {
+		if err := r.Recorded.Validate(); err != nil {
+			issues.add("value", path+".recorded", err.Error())
+		}
+	}
+	if r.RecordedElement != nil {
+		r.RecordedElement.validateAll(path+".recorded", issues)
+	}
+	for i, item := range r.PolicyElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.policy[%d]", path, i), issues)
+	}
+	if r.Location != nil {
+		if target := invalidReferenceTarget(r.Location.Reference, r.Location.Type, provenanceLocationTargets); target != "" {
+			issues.add("value", path+".location", fmt.Sprintf("field 'Location' cannot refer to %s, expected one of %v", target, provenanceLocationTargets))
+		}
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Authorization {
+		item.validateAll(fmt.Sprintf("%s.authorization[%d]", path, i), issues)
+	}
+	if r.WhyElement != nil {
+		r.WhyElement.validateAll(path+".why", issues)
+	}
+	if r.Activity != nil {
+		r.Activity.validateAll(path+".activity", issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, provenancePatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, provenancePatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, provenanceEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, provenanceEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.Agent) < 1 {
+		issues.add("required", path+".agent", "field 'Agent' must have at least 1 elements")
+	}
+	for i, item := range r.Agent {
+		item.validateAll(fmt.Sprintf("%s.agent[%d]", path, i), issues)
+	}
+	for i, item := range r.Entity {
+		item.validateAll(fmt.Sprintf("%s.entity[%d]", path, i), issues)
+	}
+	for i, item := range r.Signature {
+		item.validateAll(fmt.Sprintf("%s.signature[%d]", path, i), issues)
+	}
+}
+
+func (r *Provenance) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Provenance", &issues)
+	checkInvariants(r, "Provenance", &issues)
+	return issues
+}
+
+var provenanceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Provenance) invariants() []invariant {
+	return provenanceInvariants
+}
+
+func (r Provenance) MarshalJSON() ([]byte, error) {
+	type alias Provenance
+	out := struct {
+		alias
+		Policy        []*string  `json:"policy,omitempty"`
+		PolicyElement []*Element `json:"_policy,omitempty"`
+	}{alias: alias(r)}
+	out.Policy, out.PolicyElement = alignPrimitiveArray(r.Policy, r.PolicyElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurred", r.Occurred, r.OccurredElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Provenance) UnmarshalJSON(data []byte) error {
+	type alias Provenance
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Policy    []*string         `json:"policy,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.Policy, err = splitPrimitiveArray(aux.Policy, r.PolicyElement); err != nil {
+		return fmt.Errorf("policy: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurred, element, r.occurredVariants, err = unmarshalChoice(fields, "occurred", provenanceOccurredVariants); err != nil {
+		return err
+	}
+	r.OccurredElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurredElement); err != nil {
+			return fmt.Errorf("_occurred: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r Provenance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Provenance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Provenance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Provenance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Provenance) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurred":
+		return "occurred", choiceValues(provenanceOccurredVariants)
+	}
+	return "", nil
+}
+
+var provenanceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"policy":        "uri",
+	"why":           "markdown",
+}
+
+func (r *Provenance) elementTypes() map[string]string {
+	return provenanceElementTypes
+}
+
+var provenanceElementMetadata = []ElementMetadata{
+	{Path: "Provenance.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Provenance.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Provenance.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Provenance.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Provenance.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Provenance.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Provenance.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Provenance.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Provenance.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Provenance.target", Name: "target", Field: 11, Min: 1, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Provenance.occurred[x]", Name: "occurred", Field: 12, Min: 0, Max: "1", Types: []string{"Period", "dateTime"}},
+	{Path: "Provenance.recorded", Name: "recorded", Field: 14, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Provenance.policy", Name: "policy", Field: 16, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "Provenance.location", Name: "location", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location"}},
+	{Path: "Provenance.authorization", Name: "authorization", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Provenance.why", Name: "why", Field: 20, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Provenance.activity", Name: "activity", Field: 22, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Provenance.basedOn", Name: "basedOn", Field: 23, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}},
+	{Path: "Provenance.patient", Name: "patient", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "Provenance.encounter", Name: "encounter", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Provenance.agent", Name: "agent", Field: 26, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Provenance.entity", Name: "entity", Field: 27, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Provenance.signature", Name: "signature", Field: 28, Min: 0, Max: "*", Types: []string{"Signature"}},
+}
+
+func (r *Provenance) ElementMetadata() []ElementMetadata {
+	return provenanceElementMetadata
+}
+
+// ProvenanceOccurred is the type of Provenance.occurred[x]. It is implemented by the ProvenanceOccurred* variant types.
+type ProvenanceOccurred interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isProvenanceOccurred()
+}
+
+// ProvenanceOccurredPeriod is the Period variant of ProvenanceOccurred.
+type ProvenanceOccurredPeriod struct {
+	Period
+}
+
+func (v ProvenanceOccurredPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ProvenanceOccurredPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ProvenanceOccurredPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ProvenanceOccurredPeriod) isProvenanceOccurred() {}
+
+// ProvenanceOccurredDateTime is the dateTime variant of ProvenanceOccurred.
+type ProvenanceOccurredDateTime struct {
+	DateTime
+}
+
+func (v ProvenanceOccurredDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ProvenanceOccurredDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ProvenanceOccurredDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ProvenanceOccurredDateTime) isProvenanceOccurred() {}
+
+var provenanceOccurredVariants = []ProvenanceOccurred{
+	ProvenanceOccurredPeriod{},
+	ProvenanceOccurredDateTime{},
+}
+
+func (r *Provenance) GetResourceType() string {
+	return "Provenance"
+}
+
+func (r *Provenance) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Provenance) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Provenance) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Provenance) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Provenance) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Provenance) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Provenance) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Provenance)(nil)
+
 type ProvenanceAgent struct {
-	Id         *string           `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Type       *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`               // How the agent participated
-	Role       []CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`               // What the agents role was
-	Who        *Reference        `json:"who" bson:"who"`                                     // The agent that participated in the event
-	OnBehalfOf *Reference        `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"` // The agent that delegated
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                            // How the agent participated
+	Role              []CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                            // What the agents role was
+	Who               *Reference        `json:"who" bson:"who"`                                                  // The agent that participated in the event
+	OnBehalfOf        *Reference        `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"`              // The agent that delegated
 }
 
+var provenanceAgentWhoTargets = []string{"CareTeam", "Device", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var provenanceAgentOnBehalfOfTargets = []string{"Practitioner", "PractitionerRole", "Organization", "CareTeam", "Patient", "Group", "HealthcareService"}
+
 func (r *ProvenanceAgent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
@@ -131,11 +579,21 @@ func (r *ProvenanceAgent) Validate() error {
 	if r.Who == nil {
 		return fmt.Errorf("field 'Who' is required")
 	}
+	if r.Who != nil {
+		if target := invalidReferenceTarget(r.Who.Reference, r.Who.Type, provenanceAgentWhoTargets); target != "" {
+			return fmt.Errorf("field 'Who' cannot refer to %s, expected one of %v", target, provenanceAgentWhoTargets)
+		}
+	}
 	if r.Who != nil {
 		if err := r.Who.Validate(); err != nil {
 			return fmt.Errorf("Who: %w", err)
 		}
 	}
+	if r.OnBehalfOf != nil {
+		if target := invalidReferenceTarget(r.OnBehalfOf.Reference, r.OnBehalfOf.Type, provenanceAgentOnBehalfOfTargets); target != "" {
+			return fmt.Errorf("field 'OnBehalfOf' cannot refer to %s, expected one of %v", target, provenanceAgentOnBehalfOfTargets)
+		}
+	}
 	if r.OnBehalfOf != nil {
 		if err := r.OnBehalfOf.Validate(); err != nil {
 			return fmt.Errorf("OnBehalfOf: %w", err)
@@ -144,18 +602,114 @@ func (r *ProvenanceAgent) Validate() error {
 	return nil
 }
 
+func (r *ProvenanceAgent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	for i, item := range r.Role {
+		item.validateAll(fmt.Sprintf("%s.role[%d]", path, i), issues)
+	}
+	if r.Who == nil {
+		issues.add("required", path+".who", "field 'Who' is required")
+	}
+	if r.Who != nil {
+		if target := invalidReferenceTarget(r.Who.Reference, r.Who.Type, provenanceAgentWhoTargets); target != "" {
+			issues.add("value", path+".who", fmt.Sprintf("field 'Who' cannot refer to %s, expected one of %v", target, provenanceAgentWhoTargets))
+		}
+	}
+	if r.Who != nil {
+		r.Who.validateAll(path+".who", issues)
+	}
+	if r.OnBehalfOf != nil {
+		if target := invalidReferenceTarget(r.OnBehalfOf.Reference, r.OnBehalfOf.Type, provenanceAgentOnBehalfOfTargets); target != "" {
+			issues.add("value", path+".onBehalfOf", fmt.Sprintf("field 'OnBehalfOf' cannot refer to %s, expected one of %v", target, provenanceAgentOnBehalfOfTargets))
+		}
+	}
+	if r.OnBehalfOf != nil {
+		r.OnBehalfOf.validateAll(path+".onBehalfOf", issues)
+	}
+}
+
+var provenanceAgentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "prov-1", severity: "error", human: "Who and onBehalfOf cannot be the same", expression: "who.resolve().exists() and onBehalfOf.resolve().exists() implies who.resolve() != onBehalfOf.resolve()"},
+	{key: "prov-2", severity: "error", human: "If who is a PractitionerRole, onBehalfOf can't reference the same Practitioner", expression: "who.resolve().ofType(PractitionerRole).practitioner.resolve().exists() and onBehalfOf.resolve().ofType(Practitioner).exists() implies who.resolve().practitioner.resolve() != onBehalfOf.resolve()"},
+	{key: "prov-3", severity: "error", human: "If who is an organization, onBehalfOf can't be a PractitionerRole within that organization", expression: "who.resolve().ofType(Organization).exists() and onBehalfOf.resolve().ofType(PractitionerRole).organization.resolve().exists() implies who.resolve() != onBehalfOf.resolve().organization.resolve()"},
+	{key: "prov-4", severity: "error", human: "If who is an organization, onBehalfOf can't be a healthcare service within that organization", expression: "who.resolve().ofType(Organization).exists() and onBehalfOf.resolve().ofType(HealthcareService).providedBy.resolve().exists() implies who.resolve() != onBehalfOf.resolve().ofType(HealthcareService).providedBy.resolve()"},
+}
+
+func (r *ProvenanceAgent) invariants() []invariant {
+	return provenanceAgentInvariants
+}
+
+func (r ProvenanceAgent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ProvenanceAgent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ProvenanceAgent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ProvenanceAgent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var provenanceAgentElementMetadata = []ElementMetadata{
+	{Path: "Provenance.agent.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Provenance.agent.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Provenance.agent.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Provenance.agent.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Provenance.agent.role", Name: "role", Field: 4, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Provenance.agent.who", Name: "who", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Provenance.agent.onBehalfOf", Name: "onBehalfOf", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService"}},
+}
+
+func (r *ProvenanceAgent) ElementMetadata() []ElementMetadata {
+	return provenanceAgentElementMetadata
+}
+
 type ProvenanceEntity struct {
-	Id    *string           `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Role  string            `json:"role" bson:"role"`                       // revision | quotation | source | instantiates | removal
-	What  *Reference        `json:"what" bson:"what"`                       // Identity of entity
-	Agent []ProvenanceAgent `json:"agent,omitempty" bson:"agent,omitempty"` // Entity is attributed to this agent
+	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Role              ProvenanceEntityRole `json:"role" bson:"role"`                                                // revision | quotation | source | instantiates | removal
+	RoleElement       *Element             `json:"_role,omitempty" bson:"role_element,omitempty"`                   // Extensions for role
+	What              *Reference           `json:"what" bson:"what"`                                                // Identity of entity
+	Agent             []ProvenanceAgent    `json:"agent,omitempty" bson:"agent,omitempty"`                          // Entity is attributed to this agent
 }
 
 func (r *ProvenanceEntity) Validate() error {
-	var emptyString string
-	if r.Role == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Role == "" {
 		return fmt.Errorf("field 'Role' is required")
 	}
+	if !r.Role.IsValid() {
+		return fmt.Errorf("field 'Role' has invalid code '%s'", r.Role)
+	}
+	if r.RoleElement != nil {
+		if err := r.RoleElement.Validate(); err != nil {
+			return fmt.Errorf("RoleElement: %w", err)
+		}
+	}
 	if r.What == nil {
 		return fmt.Errorf("field 'What' is required")
 	}
@@ -171,3 +725,67 @@ func (r *ProvenanceEntity) Validate() error {
 	}
 	return nil
 }
+
+func (r *ProvenanceEntity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Role == "" {
+		issues.add("required", path+".role", "field 'Role' is required")
+	}
+	if r.Role != "" && !r.Role.IsValid() {
+		issues.add("code-invalid", path+".role", fmt.Sprintf("field 'Role' has invalid code '%s'", r.Role))
+	}
+	if r.RoleElement != nil {
+		r.RoleElement.validateAll(path+".role", issues)
+	}
+	if r.What == nil {
+		issues.add("required", path+".what", "field 'What' is required")
+	}
+	if r.What != nil {
+		r.What.validateAll(path+".what", issues)
+	}
+	for i, item := range r.Agent {
+		item.validateAll(fmt.Sprintf("%s.agent[%d]", path, i), issues)
+	}
+}
+
+var provenanceEntityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ProvenanceEntity) invariants() []invariant {
+	return provenanceEntityInvariants
+}
+
+func (r ProvenanceEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ProvenanceEntity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ProvenanceEntity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ProvenanceEntity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var provenanceEntityElementMetadata = []ElementMetadata{
+	{Path: "Provenance.entity.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Provenance.entity.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Provenance.entity.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Provenance.entity.role", Name: "role", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Provenance.entity.role", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/provenance-entity-role"}},
+	{Path: "Provenance.entity.what", Name: "what", Field: 5, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}},
+	{Path: "Provenance.entity.agent", Name: "agent", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ProvenanceEntity) ElementMetadata() []ElementMetadata {
+	return provenanceEntityElementMetadata
+}
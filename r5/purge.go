@@ -1,9 +1,63 @@
 package models
 
-// This operation is used to request the removal of all current and historical versions for all resources in a patient compartment.  The result will be an OperationOutcome with results and/or details about execution.  Following are some common 'issue-type' values: - 'success' the request has been completed to the server's satisfaction - the patient and associated resources are no longer accessible - 'incomplete' the request is partially complete, but additional processing will continue (e.g., the server is continuing to clean out resources)  When supported, it is recommended (though not required) to support an [Asynchronous Request Pattern](async.html).  Note that the deletion of resources typically involves many policy decisions.  Implementers are expected to use this operation in conjunction with their policies for such a request - e.g., soft vs. hard delete, audibility/traceability, evaluation of referential integrity, etc.
-type Purge struct {
+// PurgeIn holds the input parameters of the $Purge operation. This operation is used to request the removal of all current and historical versions for all resources in a patient compartment.  The result will be an OperationOutcome with results and/or details about execution.  Following are some common 'issue-type' values: - 'success' the request has been completed to the server's satisfaction - the patient and associated resources are no longer accessible - 'incomplete' the request is partially complete, but additional processing will continue (e.g., the server is continuing to clean out resources)  When supported, it is recommended (though not required) to support an [Asynchronous Request Pattern](async.html).  Note that the deletion of resources typically involves many policy decisions.  Implementers are expected to use this operation in conjunction with their policies for such a request - e.g., soft vs. hard delete, audibility/traceability, evaluation of referential integrity, etc.
+type PurgeIn struct {
 }
 
-func (r *Purge) Validate() error {
+func (p *PurgeIn) Validate() error {
+	return nil
+}
+
+func (p *PurgeIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *PurgeIn) FromParameters(params *Parameters) error {
+	*p = PurgeIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *PurgeIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *PurgeIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// PurgeOut holds the output parameters of the $Purge operation.
+type PurgeOut struct {
+}
+
+func (p *PurgeOut) Validate() error {
+	return nil
+}
+
+func (p *PurgeOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *PurgeOut) FromParameters(params *Parameters) error {
+	*p = PurgeOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *PurgeOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *PurgeOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
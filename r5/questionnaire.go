@@ -2,43 +2,66 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A structured set of questions intended to guide the collection of answers from end-users. Questionnaires provide detailed control over order, presentation, phraseology and grouping to allow coherent, consistent data collection.
 type Questionnaire struct {
-	ResourceType           string              `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string             `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string             `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string             `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this questionnaire, represented as an absolute URI (globally unique)
-	Identifier             []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business identifier for questionnaire
-	Version                *string             `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the questionnaire
-	VersionAlgorithmString *string             `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding             `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string             `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this questionnaire (computer friendly)
-	Title                  *string             `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this questionnaire (human friendly)
-	DerivedFrom            []string            `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                        // Based on Questionnaire
-	Status                 string              `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool               `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	SubjectType            []string            `json:"subjectType,omitempty" bson:"subject_type,omitempty"`                        // Resource that can be subject of QuestionnaireResponse
-	Date                   *string             `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string             `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail     `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string             `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the questionnaire
-	UseContext             []UsageContext      `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept   `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the questionnaire (if applicable)
-	Purpose                *string             `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this questionnaire is defined
-	Copyright              *string             `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string             `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string             `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the questionnaire was approved by publisher
-	LastReviewDate         *string             `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the questionnaire was last reviewed by the publisher
-	EffectivePeriod        *Period             `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the questionnaire is expected to be used
-	Code                   []Coding            `json:"code,omitempty" bson:"code,omitempty"`                                       // Concept that represents the overall questionnaire
-	Item                   []QuestionnaireItem `json:"item,omitempty" bson:"item,omitempty"`                                       // Questions and sections within the Questionnaire
+	ResourceType            string                        `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                       `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                         `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                       `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                      `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                       `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                      `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                    `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                    `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                       `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this questionnaire, represented as an absolute URI (globally unique)
+	UrlElement              *Element                      `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Business identifier for questionnaire
+	Version                 *string                       `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the questionnaire
+	VersionElement          *Element                      `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        QuestionnaireVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                      `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                       `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this questionnaire (computer friendly)
+	NameElement             *Element                      `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                       `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this questionnaire (human friendly)
+	TitleElement            *Element                      `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	DerivedFrom             []string                      `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                 // Based on Questionnaire
+	DerivedFromElement      []*Element                    `json:"_derivedFrom,omitempty" bson:"derived_from_element,omitempty"`        // Extensions for derivedFrom
+	Status                  PublicationStatus             `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                      `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                         `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                      `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	SubjectType             []string                      `json:"subjectType,omitempty" bson:"subject_type,omitempty"`                 // Resource that can be subject of QuestionnaireResponse
+	SubjectTypeElement      []*Element                    `json:"_subjectType,omitempty" bson:"subject_type_element,omitempty"`        // Extensions for subjectType
+	Date                    *DateTime                     `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                      `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                       `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                      `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail               `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                       `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the questionnaire
+	DescriptionElement      *Element                      `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext                `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept             `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the questionnaire (if applicable)
+	Purpose                 *string                       `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this questionnaire is defined
+	PurposeElement          *Element                      `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                       `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                      `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                       `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                      `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                         `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the questionnaire was approved by publisher
+	ApprovalDateElement     *Element                      `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                         `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the questionnaire was last reviewed by the publisher
+	LastReviewDateElement   *Element                      `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                       `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the questionnaire is expected to be used
+	Code                    []Coding                      `json:"code,omitempty" bson:"code,omitempty"`                                // Concept that represents the overall questionnaire
+	Item                    []QuestionnaireItem           `json:"item,omitempty" bson:"item,omitempty"`                                // Questions and sections within the Questionnaire
+
+	versionAlgorithmVariants []string // JSON properties of Questionnaire.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *Questionnaire) Validate() error {
@@ -50,30 +73,134 @@ func (r *Questionnaire) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	for i, item := range r.DerivedFromElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromElement[%d]: %w", i, err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	for i, item := range r.SubjectTypeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubjectTypeElement[%d]: %w", i, err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -84,6 +211,41 @@ func (r *Questionnaire) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -99,288 +261,1911 @@ func (r *Questionnaire) Validate() error {
 			return fmt.Errorf("Item[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type QuestionnaireItem struct {
-	Id               *string                         `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	LinkId           string                          `json:"linkId" bson:"link_id"`                                         // Unique id for item in questionnaire
-	Definition       []string                        `json:"definition,omitempty" bson:"definition,omitempty"`              // ElementDefinition - details for the item
-	Code             []Coding                        `json:"code,omitempty" bson:"code,omitempty"`                          // Corresponding concept for this item in a terminology
-	Prefix           *string                         `json:"prefix,omitempty" bson:"prefix,omitempty"`                      // E.g. "1(a)", "2.5.3"
-	Text             *string                         `json:"text,omitempty" bson:"text,omitempty"`                          // Primary text for the item
-	Type             string                          `json:"type" bson:"type"`                                              // group | display | boolean | decimal | integer | date | dateTime +
-	EnableWhen       []QuestionnaireItemEnableWhen   `json:"enableWhen,omitempty" bson:"enable_when,omitempty"`             // Only allow data when
-	EnableBehavior   *string                         `json:"enableBehavior,omitempty" bson:"enable_behavior,omitempty"`     // all | any
-	DisabledDisplay  *string                         `json:"disabledDisplay,omitempty" bson:"disabled_display,omitempty"`   // hidden | protected
-	Required         *bool                           `json:"required,omitempty" bson:"required,omitempty"`                  // Whether the item must be included in data results
-	Repeats          *bool                           `json:"repeats,omitempty" bson:"repeats,omitempty"`                    // Whether the item may repeat
-	ReadOnly         *bool                           `json:"readOnly,omitempty" bson:"read_only,omitempty"`                 // Don't allow human editing
-	MaxLength        *int                            `json:"maxLength,omitempty" bson:"max_length,omitempty"`               // No more than these many characters
-	AnswerConstraint *string                         `json:"answerConstraint,omitempty" bson:"answer_constraint,omitempty"` // optionsOnly | optionsOrType | optionsOrString
-	AnswerValueSet   *string                         `json:"answerValueSet,omitempty" bson:"answer_value_set,omitempty"`    // ValueSet containing permitted answers
-	AnswerOption     []QuestionnaireItemAnswerOption `json:"answerOption,omitempty" bson:"answer_option,omitempty"`         // Permitted answer
-	Initial          []QuestionnaireItemInitial      `json:"initial,omitempty" bson:"initial,omitempty"`                    // Initial value(s) when item is first rendered
-	Item             []QuestionnaireItem             `json:"item,omitempty" bson:"item,omitempty"`                          // Nested questionnaire items
+	return validateInvariants(r, "Questionnaire")
 }
 
-func (r *QuestionnaireItem) Validate() error {
-	var emptyString string
-	if r.LinkId == emptyString {
-		return fmt.Errorf("field 'LinkId' is required")
+func (r *Questionnaire) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Questionnaire" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Questionnaire', got '%s'", r.ResourceType))
 	}
-	for i, item := range r.Code {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Code[%d]: %w", i, err)
-		}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.Type == emptyString {
-		return fmt.Errorf("field 'Type' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	for i, item := range r.EnableWhen {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("EnableWhen[%d]: %w", i, err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	for i, item := range r.AnswerOption {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AnswerOption[%d]: %w", i, err)
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	for i, item := range r.Initial {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Initial[%d]: %w", i, err)
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	for i, item := range r.DerivedFromElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
 	}
-	for i, item := range r.Item {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Item[%d]: %w", i, err)
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	for i, item := range r.SubjectTypeElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.subjectType[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type QuestionnaireItemEnableWhen struct {
-	Id               *string     `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	Question         string      `json:"question" bson:"question"`                  // The linkId of question that determines whether item is enabled/disabled
-	Operator         string      `json:"operator" bson:"operator"`                  // exists | = | != | > | < | >= | <=
-	AnswerBoolean    *bool       `json:"answerBoolean" bson:"answer_boolean"`       // Value for question comparison based on operator
-	AnswerDecimal    *float64    `json:"answerDecimal" bson:"answer_decimal"`       // Value for question comparison based on operator
-	AnswerInteger    *int        `json:"answerInteger" bson:"answer_integer"`       // Value for question comparison based on operator
-	AnswerDate       *string     `json:"answerDate" bson:"answer_date"`             // Value for question comparison based on operator
-	AnswerDateTime   *string     `json:"answerDateTime" bson:"answer_date_time"`    // Value for question comparison based on operator
-	AnswerTime       *string     `json:"answerTime" bson:"answer_time"`             // Value for question comparison based on operator
-	AnswerString     *string     `json:"answerString" bson:"answer_string"`         // Value for question comparison based on operator
-	AnswerCoding     *Coding     `json:"answerCoding" bson:"answer_coding"`         // Value for question comparison based on operator
-	AnswerQuantity   *Quantity   `json:"answerQuantity" bson:"answer_quantity"`     // Value for question comparison based on operator
-	AnswerReference  *Reference  `json:"answerReference" bson:"answer_reference"`   // Value for question comparison based on operator
-	AnswerUri        *string     `json:"answerUri" bson:"answer_uri"`               // Value for question comparison based on operator
-	AnswerAttachment *Attachment `json:"answerAttachment" bson:"answer_attachment"` // Value for question comparison based on operator
-}
-
-func (r *QuestionnaireItemEnableWhen) Validate() error {
-	var emptyString string
-	if r.Question == emptyString {
-		return fmt.Errorf("field 'Question' is required")
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
 	}
-	if r.Operator == emptyString {
-		return fmt.Errorf("field 'Operator' is required")
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	if r.AnswerBoolean == nil {
-		return fmt.Errorf("field 'AnswerBoolean' is required")
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
 	}
-	if r.AnswerDecimal == nil {
-		return fmt.Errorf("field 'AnswerDecimal' is required")
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
 	}
-	if r.AnswerInteger == nil {
-		return fmt.Errorf("field 'AnswerInteger' is required")
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.AnswerDate == nil {
-		return fmt.Errorf("field 'AnswerDate' is required")
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
 	}
-	if r.AnswerDateTime == nil {
-		return fmt.Errorf("field 'AnswerDateTime' is required")
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
 	}
-	if r.AnswerTime == nil {
-		return fmt.Errorf("field 'AnswerTime' is required")
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
 	}
-	if r.AnswerString == nil {
-		return fmt.Errorf("field 'AnswerString' is required")
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
 	}
-	if r.AnswerCoding == nil {
-		return fmt.Errorf("field 'AnswerCoding' is required")
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
 	}
-	if r.AnswerCoding != nil {
-		if err := r.AnswerCoding.Validate(); err != nil {
-			return fmt.Errorf("AnswerCoding: %w", err)
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	if r.AnswerQuantity == nil {
-		return fmt.Errorf("field 'AnswerQuantity' is required")
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
 	}
-	if r.AnswerQuantity != nil {
-		if err := r.AnswerQuantity.Validate(); err != nil {
-			return fmt.Errorf("AnswerQuantity: %w", err)
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
 		}
 	}
-	if r.AnswerReference == nil {
-		return fmt.Errorf("field 'AnswerReference' is required")
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
 	}
-	if r.AnswerReference != nil {
-		if err := r.AnswerReference.Validate(); err != nil {
-			return fmt.Errorf("AnswerReference: %w", err)
+}
+
+func (r *Questionnaire) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Questionnaire", &issues)
+	checkInvariants(r, "Questionnaire", &issues)
+	return issues
+}
+
+var questionnaireInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Questionnaire) invariants() []invariant {
+	return questionnaireInvariants
+}
+
+func (r Questionnaire) MarshalJSON() ([]byte, error) {
+	type alias Questionnaire
+	out := struct {
+		alias
+		DerivedFrom        []*string  `json:"derivedFrom,omitempty"`
+		DerivedFromElement []*Element `json:"_derivedFrom,omitempty"`
+		SubjectType        []*string  `json:"subjectType,omitempty"`
+		SubjectTypeElement []*Element `json:"_subjectType,omitempty"`
+	}{alias: alias(r)}
+	out.DerivedFrom, out.DerivedFromElement = alignPrimitiveArray(r.DerivedFrom, r.DerivedFromElement)
+	out.SubjectType, out.SubjectTypeElement = alignPrimitiveArray(r.SubjectType, r.SubjectTypeElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Questionnaire) UnmarshalJSON(data []byte) error {
+	type alias Questionnaire
+	aux := struct {
+		*alias
+		Contained   []json.RawMessage `json:"contained,omitempty"`
+		DerivedFrom []*string         `json:"derivedFrom,omitempty"`
+		SubjectType []*string         `json:"subjectType,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.DerivedFrom, err = splitPrimitiveArray(aux.DerivedFrom, r.DerivedFromElement); err != nil {
+		return fmt.Errorf("derivedFrom: %w", err)
+	}
+	if r.SubjectType, err = splitPrimitiveArray(aux.SubjectType, r.SubjectTypeElement); err != nil {
+		return fmt.Errorf("subjectType: %w", err)
 	}
-	if r.AnswerUri == nil {
-		return fmt.Errorf("field 'AnswerUri' is required")
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.AnswerAttachment == nil {
-		return fmt.Errorf("field 'AnswerAttachment' is required")
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", questionnaireVersionAlgorithmVariants); err != nil {
+		return err
 	}
-	if r.AnswerAttachment != nil {
-		if err := r.AnswerAttachment.Validate(); err != nil {
-			return fmt.Errorf("AnswerAttachment: %w", err)
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type QuestionnaireItemAnswerOption struct {
-	Id              *string    `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	ValueDecimal    *float64   `json:"valueDecimal" bson:"value_decimal"`                           // Answer value
-	ValueInteger    *int       `json:"valueInteger" bson:"value_integer"`                           // Answer value
-	ValueDate       *string    `json:"valueDate" bson:"value_date"`                                 // Answer value
-	ValueDateTime   *string    `json:"valueDateTime" bson:"value_date_time"`                        // Answer value
-	ValueTime       *string    `json:"valueTime" bson:"value_time"`                                 // Answer value
-	ValueString     *string    `json:"valueString" bson:"value_string"`                             // Answer value
-	ValueUri        *string    `json:"valueUri" bson:"value_uri"`                                   // Answer value
-	ValueCoding     *Coding    `json:"valueCoding" bson:"value_coding"`                             // Answer value
-	ValueQuantity   *Quantity  `json:"valueQuantity" bson:"value_quantity"`                         // Answer value
-	ValueReference  *Reference `json:"valueReference" bson:"value_reference"`                       // Answer value
-	InitialSelected *bool      `json:"initialSelected,omitempty" bson:"initial_selected,omitempty"` // Whether option is selected by default
+func (r Questionnaire) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *QuestionnaireItemAnswerOption) Validate() error {
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+func (r *Questionnaire) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Questionnaire) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Questionnaire) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Questionnaire) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(questionnaireVersionAlgorithmVariants)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	return "", nil
+}
+
+var questionnaireElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"derivedFrom":   "canonical",
+	"subjectType":   "code",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *Questionnaire) elementTypes() map[string]string {
+	return questionnaireElementTypes
+}
+
+var questionnaireElementMetadata = []ElementMetadata{
+	{Path: "Questionnaire.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Questionnaire.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Questionnaire.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Questionnaire.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Questionnaire.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Questionnaire.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Questionnaire.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Questionnaire.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Questionnaire.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Questionnaire.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Questionnaire.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "Questionnaire.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.derivedFrom", Name: "derivedFrom", Field: 22, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "Questionnaire.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Questionnaire.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Questionnaire.subjectType", Name: "subjectType", Field: 28, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "Questionnaire.date", Name: "date", Field: 30, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Questionnaire.publisher", Name: "publisher", Field: 32, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.contact", Name: "contact", Field: 34, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Questionnaire.description", Name: "description", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Questionnaire.useContext", Name: "useContext", Field: 37, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Questionnaire.jurisdiction", Name: "jurisdiction", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Questionnaire.purpose", Name: "purpose", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Questionnaire.copyright", Name: "copyright", Field: 41, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Questionnaire.copyrightLabel", Name: "copyrightLabel", Field: 43, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.approvalDate", Name: "approvalDate", Field: 45, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Questionnaire.lastReviewDate", Name: "lastReviewDate", Field: 47, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "Questionnaire.effectivePeriod", Name: "effectivePeriod", Field: 49, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Questionnaire.code", Name: "code", Field: 50, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Questionnaire.item", Name: "item", Field: 51, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Questionnaire) ElementMetadata() []ElementMetadata {
+	return questionnaireElementMetadata
+}
+
+// QuestionnaireVersionAlgorithm is the type of Questionnaire.versionAlgorithm[x]. It is implemented by the QuestionnaireVersionAlgorithm* variant types.
+type QuestionnaireVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isQuestionnaireVersionAlgorithm()
+}
+
+// QuestionnaireVersionAlgorithmString is the string variant of QuestionnaireVersionAlgorithm.
+type QuestionnaireVersionAlgorithmString string
+
+func (v QuestionnaireVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v QuestionnaireVersionAlgorithmString) isQuestionnaireVersionAlgorithm() {}
+
+// QuestionnaireVersionAlgorithmCoding is the Coding variant of QuestionnaireVersionAlgorithm.
+type QuestionnaireVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v QuestionnaireVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v QuestionnaireVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v QuestionnaireVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v QuestionnaireVersionAlgorithmCoding) isQuestionnaireVersionAlgorithm() {}
+
+var questionnaireVersionAlgorithmVariants = []QuestionnaireVersionAlgorithm{
+	QuestionnaireVersionAlgorithmString(""),
+	QuestionnaireVersionAlgorithmCoding{},
+}
+
+func (r *Questionnaire) GetResourceType() string {
+	return "Questionnaire"
+}
+
+func (r *Questionnaire) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
+	return *r.Id
+}
+
+func (r *Questionnaire) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	r.Id = &id
+}
+
+func (r *Questionnaire) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Questionnaire) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Questionnaire) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Questionnaire) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Questionnaire) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Questionnaire)(nil)
+
+type QuestionnaireItem struct {
+	Id                      *string                           `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	LinkId                  string                            `json:"linkId" bson:"link_id"`                                                  // Unique id for item in questionnaire
+	LinkIdElement           *Element                          `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                     // Extensions for linkId
+	Definition              []string                          `json:"definition,omitempty" bson:"definition,omitempty"`                       // ElementDefinition - details for the item
+	DefinitionElement       []*Element                        `json:"_definition,omitempty" bson:"definition_element,omitempty"`              // Extensions for definition
+	Code                    []Coding                          `json:"code,omitempty" bson:"code,omitempty"`                                   // Corresponding concept for this item in a terminology
+	Prefix                  *string                           `json:"prefix,omitempty" bson:"prefix,omitempty"`                               // E.g. "1(a)", "2.5.3"
+	PrefixElement           *Element                          `json:"_prefix,omitempty" bson:"prefix_element,omitempty"`                      // Extensions for prefix
+	Text                    *string                           `json:"text,omitempty" bson:"text,omitempty"`                                   // Primary text for the item
+	TextElement             *Element                          `json:"_text,omitempty" bson:"text_element,omitempty"`                          // Extensions for text
+	Type                    QuestionnaireItemTypeUsable       `json:"type" bson:"type"`                                                       // group | display | boolean | decimal | integer | date | dateTime +
+	TypeElement             *Element                          `json:"_type,omitempty" bson:"type_element,omitempty"`                          // Extensions for type
+	EnableWhen              []QuestionnaireItemEnableWhen     `json:"enableWhen,omitempty" bson:"enable_when,omitempty"`                      // Only allow data when
+	EnableBehavior          *ActionApplicabilityBehavior      `json:"enableBehavior,omitempty" bson:"enable_behavior,omitempty"`              // all | any
+	EnableBehaviorElement   *Element                          `json:"_enableBehavior,omitempty" bson:"enable_behavior_element,omitempty"`     // Extensions for enableBehavior
+	DisabledDisplay         *QuestionnaireItemDisabledDisplay `json:"disabledDisplay,omitempty" bson:"disabled_display,omitempty"`            // hidden | protected
+	DisabledDisplayElement  *Element                          `json:"_disabledDisplay,omitempty" bson:"disabled_display_element,omitempty"`   // Extensions for disabledDisplay
+	Required                *bool                             `json:"required,omitempty" bson:"required,omitempty"`                           // Whether the item must be included in data results
+	RequiredElement         *Element                          `json:"_required,omitempty" bson:"required_element,omitempty"`                  // Extensions for required
+	Repeats                 *bool                             `json:"repeats,omitempty" bson:"repeats,omitempty"`                             // Whether the item may repeat
+	RepeatsElement          *Element                          `json:"_repeats,omitempty" bson:"repeats_element,omitempty"`                    // Extensions for repeats
+	ReadOnly                *bool                             `json:"readOnly,omitempty" bson:"read_only,omitempty"`                          // Don't allow human editing
+	ReadOnlyElement         *Element                          `json:"_readOnly,omitempty" bson:"read_only_element,omitempty"`                 // Extensions for readOnly
+	MaxLength               *int                              `json:"maxLength,omitempty" bson:"max_length,omitempty"`                        // No more than these many characters
+	MaxLengthElement        *Element                          `json:"_maxLength,omitempty" bson:"max_length_element,omitempty"`               // Extensions for maxLength
+	AnswerConstraint        *QuestionnaireAnswerConstraint    `json:"answerConstraint,omitempty" bson:"answer_constraint,omitempty"`          // optionsOnly | optionsOrType | optionsOrString
+	AnswerConstraintElement *Element                          `json:"_answerConstraint,omitempty" bson:"answer_constraint_element,omitempty"` // Extensions for answerConstraint
+	AnswerValueSet          *string                           `json:"answerValueSet,omitempty" bson:"answer_value_set,omitempty"`             // ValueSet containing permitted answers
+	AnswerValueSetElement   *Element                          `json:"_answerValueSet,omitempty" bson:"answer_value_set_element,omitempty"`    // Extensions for answerValueSet
+	AnswerOption            []QuestionnaireItemAnswerOption   `json:"answerOption,omitempty" bson:"answer_option,omitempty"`                  // Permitted answer
+	Initial                 []QuestionnaireItemInitial        `json:"initial,omitempty" bson:"initial,omitempty"`                             // Initial value(s) when item is first rendered
+	Item                    []QuestionnaireItem               `json:"item,omitempty" bson:"item,omitempty"`                                   // Nested questionnaire items
+}
+
+func (r *QuestionnaireItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	var emptyString string
+	if r.LinkId == emptyString {
+		return fmt.Errorf("field 'LinkId' is required")
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	for i, item := range r.DefinitionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement[%d]: %w", i, err)
+		}
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	for i, item := range r.Code {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Code[%d]: %w", i, err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.PrefixElement != nil {
+		if err := r.PrefixElement.Validate(); err != nil {
+			return fmt.Errorf("PrefixElement: %w", err)
+		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
 		}
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.Type == "" {
+		return fmt.Errorf("field 'Type' is required")
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
 	}
-	return nil
-}
-
-type QuestionnaireItemInitial struct {
-	Id              *string     `json:"id,omitempty" bson:"id,omitempty"`        // Unique id for inter-element referencing
-	ValueBoolean    *bool       `json:"valueBoolean" bson:"value_boolean"`       // Actual value for initializing the question
-	ValueDecimal    *float64    `json:"valueDecimal" bson:"value_decimal"`       // Actual value for initializing the question
-	ValueInteger    *int        `json:"valueInteger" bson:"value_integer"`       // Actual value for initializing the question
-	ValueDate       *string     `json:"valueDate" bson:"value_date"`             // Actual value for initializing the question
-	ValueDateTime   *string     `json:"valueDateTime" bson:"value_date_time"`    // Actual value for initializing the question
-	ValueTime       *string     `json:"valueTime" bson:"value_time"`             // Actual value for initializing the question
-	ValueString     *string     `json:"valueString" bson:"value_string"`         // Actual value for initializing the question
-	ValueUri        *string     `json:"valueUri" bson:"value_uri"`               // Actual value for initializing the question
-	ValueAttachment *Attachment `json:"valueAttachment" bson:"value_attachment"` // Actual value for initializing the question
-	ValueCoding     *Coding     `json:"valueCoding" bson:"value_coding"`         // Actual value for initializing the question
-	ValueQuantity   *Quantity   `json:"valueQuantity" bson:"value_quantity"`     // Actual value for initializing the question
-	ValueReference  *Reference  `json:"valueReference" bson:"value_reference"`   // Actual value for initializing the question
-}
-
-func (r *QuestionnaireItemInitial) Validate() error {
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	for i, item := range r.EnableWhen {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("EnableWhen[%d]: %w", i, err)
+		}
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.EnableBehavior != nil && !r.EnableBehavior.IsValid() {
+		return fmt.Errorf("field 'EnableBehavior' has invalid code '%s'", *r.EnableBehavior)
 	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
+	if r.EnableBehaviorElement != nil {
+		if err := r.EnableBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("EnableBehaviorElement: %w", err)
+		}
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	if r.DisabledDisplay != nil && !r.DisabledDisplay.IsValid() {
+		return fmt.Errorf("field 'DisabledDisplay' has invalid code '%s'", *r.DisabledDisplay)
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	if r.DisabledDisplayElement != nil {
+		if err := r.DisabledDisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisabledDisplayElement: %w", err)
+		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.RequiredElement != nil {
+		if err := r.RequiredElement.Validate(); err != nil {
+			return fmt.Errorf("RequiredElement: %w", err)
+		}
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	if r.RepeatsElement != nil {
+		if err := r.RepeatsElement.Validate(); err != nil {
+			return fmt.Errorf("RepeatsElement: %w", err)
+		}
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	if r.ReadOnlyElement != nil {
+		if err := r.ReadOnlyElement.Validate(); err != nil {
+			return fmt.Errorf("ReadOnlyElement: %w", err)
+		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	if r.MaxLengthElement != nil {
+		if err := r.MaxLengthElement.Validate(); err != nil {
+			return fmt.Errorf("MaxLengthElement: %w", err)
 		}
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	if r.AnswerConstraint != nil && !r.AnswerConstraint.IsValid() {
+		return fmt.Errorf("field 'AnswerConstraint' has invalid code '%s'", *r.AnswerConstraint)
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.AnswerConstraintElement != nil {
+		if err := r.AnswerConstraintElement.Validate(); err != nil {
+			return fmt.Errorf("AnswerConstraintElement: %w", err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.AnswerValueSetElement != nil {
+		if err := r.AnswerValueSetElement.Validate(); err != nil {
+			return fmt.Errorf("AnswerValueSetElement: %w", err)
+		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	for i, item := range r.AnswerOption {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AnswerOption[%d]: %w", i, err)
 		}
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	for i, item := range r.Initial {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Initial[%d]: %w", i, err)
+		}
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	for i, item := range r.Item {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Item[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
+
+func (r *QuestionnaireItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.LinkId == emptyString {
+		issues.add("required", path+".linkId", "field 'LinkId' is required")
+	}
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
+	}
+	for i, item := range r.DefinitionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.definition[%d]", path, i), issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	if r.PrefixElement != nil {
+		r.PrefixElement.validateAll(path+".prefix", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	for i, item := range r.EnableWhen {
+		item.validateAll(fmt.Sprintf("%s.enableWhen[%d]", path, i), issues)
+	}
+	if r.EnableBehavior != nil && !r.EnableBehavior.IsValid() {
+		issues.add("code-invalid", path+".enableBehavior", fmt.Sprintf("field 'EnableBehavior' has invalid code '%s'", *r.EnableBehavior))
+	}
+	if r.EnableBehaviorElement != nil {
+		r.EnableBehaviorElement.validateAll(path+".enableBehavior", issues)
+	}
+	if r.DisabledDisplay != nil && !r.DisabledDisplay.IsValid() {
+		issues.add("code-invalid", path+".disabledDisplay", fmt.Sprintf("field 'DisabledDisplay' has invalid code '%s'", *r.DisabledDisplay))
+	}
+	if r.DisabledDisplayElement != nil {
+		r.DisabledDisplayElement.validateAll(path+".disabledDisplay", issues)
+	}
+	if r.RequiredElement != nil {
+		r.RequiredElement.validateAll(path+".required", issues)
+	}
+	if r.RepeatsElement != nil {
+		r.RepeatsElement.validateAll(path+".repeats", issues)
+	}
+	if r.ReadOnlyElement != nil {
+		r.ReadOnlyElement.validateAll(path+".readOnly", issues)
+	}
+	if r.MaxLengthElement != nil {
+		r.MaxLengthElement.validateAll(path+".maxLength", issues)
+	}
+	if r.AnswerConstraint != nil && !r.AnswerConstraint.IsValid() {
+		issues.add("code-invalid", path+".answerConstraint", fmt.Sprintf("field 'AnswerConstraint' has invalid code '%s'", *r.AnswerConstraint))
+	}
+	if r.AnswerConstraintElement != nil {
+		r.AnswerConstraintElement.validateAll(path+".answerConstraint", issues)
+	}
+	if r.AnswerValueSetElement != nil {
+		r.AnswerValueSetElement.validateAll(path+".answerValueSet", issues)
+	}
+	for i, item := range r.AnswerOption {
+		item.validateAll(fmt.Sprintf("%s.answerOption[%d]", path, i), issues)
+	}
+	for i, item := range r.Initial {
+		item.validateAll(fmt.Sprintf("%s.initial[%d]", path, i), issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
+	}
+}
+
+var questionnaireItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *QuestionnaireItem) invariants() []invariant {
+	return questionnaireItemInvariants
+}
+
+func (r QuestionnaireItem) MarshalJSON() ([]byte, error) {
+	type alias QuestionnaireItem
+	out := struct {
+		alias
+		Definition        []*string  `json:"definition,omitempty"`
+		DefinitionElement []*Element `json:"_definition,omitempty"`
+	}{alias: alias(r)}
+	out.Definition, out.DefinitionElement = alignPrimitiveArray(r.Definition, r.DefinitionElement)
+	return json.Marshal(out)
+}
+
+func (r *QuestionnaireItem) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireItem
+	aux := struct {
+		*alias
+		Definition []*string `json:"definition,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Definition, err = splitPrimitiveArray(aux.Definition, r.DefinitionElement); err != nil {
+		return fmt.Errorf("definition: %w", err)
+	}
+	return nil
+}
+
+func (r QuestionnaireItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var questionnaireItemElementTypes = map[string]string{
+	"definition":     "uri",
+	"answerValueSet": "canonical",
+}
+
+func (r *QuestionnaireItem) elementTypes() map[string]string {
+	return questionnaireItemElementTypes
+}
+
+var questionnaireItemElementMetadata = []ElementMetadata{
+	{Path: "Questionnaire.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Questionnaire.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Questionnaire.item.linkId", Name: "linkId", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.definition", Name: "definition", Field: 5, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "Questionnaire.item.code", Name: "code", Field: 7, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Questionnaire.item.prefix", Name: "prefix", Field: 8, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.text", Name: "text", Field: 10, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.type", Name: "type", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.item.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/item-type-useable"}},
+	{Path: "Questionnaire.item.enableWhen", Name: "enableWhen", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Questionnaire.item.enableBehavior", Name: "enableBehavior", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.item.enableBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-applicability-behavior"}},
+	{Path: "Questionnaire.item.disabledDisplay", Name: "disabledDisplay", Field: 17, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.item.disabledDisplay", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-disabled-display"}},
+	{Path: "Questionnaire.item.required", Name: "required", Field: 19, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Questionnaire.item.repeats", Name: "repeats", Field: 21, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Questionnaire.item.readOnly", Name: "readOnly", Field: 23, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Questionnaire.item.maxLength", Name: "maxLength", Field: 25, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "Questionnaire.item.answerConstraint", Name: "answerConstraint", Field: 27, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.item.answerConstraint", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-answer-constraint"}},
+	{Path: "Questionnaire.item.answerValueSet", Name: "answerValueSet", Field: 29, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Questionnaire.item.answerOption", Name: "answerOption", Field: 31, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Questionnaire.item.initial", Name: "initial", Field: 32, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Questionnaire.item.item", Name: "item", Field: 33, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *QuestionnaireItem) ElementMetadata() []ElementMetadata {
+	return questionnaireItemElementMetadata
+}
+
+type QuestionnaireItemEnableWhen struct {
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Question          string                            `json:"question" bson:"question"`                                        // The linkId of question that determines whether item is enabled/disabled
+	QuestionElement   *Element                          `json:"_question,omitempty" bson:"question_element,omitempty"`           // Extensions for question
+	Operator          QuestionnaireItemOperator         `json:"operator" bson:"operator"`                                        // exists | = | != | > | < | >= | <=
+	OperatorElement   *Element                          `json:"_operator,omitempty" bson:"operator_element,omitempty"`           // Extensions for operator
+	Answer            QuestionnaireItemEnableWhenAnswer `json:"-" bson:"answer,omitempty"`                                       // Value for question comparison based on operator
+	AnswerElement     *Element                          `json:"-" bson:"answer_element,omitempty"`                               // Extensions for answer[x]
+
+	answerVariants []string // JSON properties of Questionnaire.item.enableWhen.answer[x] when more than one was decoded
+}
+
+func (r *QuestionnaireItemEnableWhen) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Question == emptyString {
+		return fmt.Errorf("field 'Question' is required")
+	}
+	if r.QuestionElement != nil {
+		if err := r.QuestionElement.Validate(); err != nil {
+			return fmt.Errorf("QuestionElement: %w", err)
+		}
+	}
+	if r.Operator == "" {
+		return fmt.Errorf("field 'Operator' is required")
+	}
+	if !r.Operator.IsValid() {
+		return fmt.Errorf("field 'Operator' has invalid code '%s'", r.Operator)
+	}
+	if r.OperatorElement != nil {
+		if err := r.OperatorElement.Validate(); err != nil {
+			return fmt.Errorf("OperatorElement: %w", err)
+		}
+	}
+	if len(r.answerVariants) > 1 {
+		return fmt.Errorf("field 'Answer' must have a single type, got %v", r.answerVariants)
+	}
+	if r.Answer == nil {
+		return fmt.Errorf("field 'Answer' is required")
+	}
+	if r.Answer != nil {
+		if err := r.Answer.Validate(); err != nil {
+			return fmt.Errorf("Answer: %w", err)
+		}
+	}
+	if r.AnswerElement != nil {
+		if err := r.AnswerElement.Validate(); err != nil {
+			return fmt.Errorf("AnswerElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *QuestionnaireItemEnableWhen) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Question == emptyString {
+		issues.add("required", path+".question", "field 'Question' is required")
+	}
+	if r.QuestionElement != nil {
+		r.QuestionElement.validateAll(path+".question", issues)
+	}
+	if r.Operator == "" {
+		issues.add("required", path+".operator", "field 'Operator' is required")
+	}
+	if r.Operator != "" && !r.Operator.IsValid() {
+		issues.add("code-invalid", path+".operator", fmt.Sprintf("field 'Operator' has invalid code '%s'", r.Operator))
+	}
+	if r.OperatorElement != nil {
+		r.OperatorElement.validateAll(path+".operator", issues)
+	}
+	if len(r.answerVariants) > 1 {
+		issues.add("structure", path+".answer", fmt.Sprintf("field 'Answer' must have a single type, got %v", r.answerVariants))
+	}
+	if r.Answer == nil {
+		issues.add("required", path+".answer", "field 'Answer' is required")
+	}
+	if r.Answer != nil {
+		r.Answer.validateAll(path+".answer.ofType("+r.Answer.FHIRType()+")", issues)
+	}
+	if r.AnswerElement != nil {
+		r.AnswerElement.validateAll(path+".answer", issues)
+	}
+}
+
+var questionnaireItemEnableWhenInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *QuestionnaireItemEnableWhen) invariants() []invariant {
+	return questionnaireItemEnableWhenInvariants
+}
+
+func (r QuestionnaireItemEnableWhen) MarshalJSON() ([]byte, error) {
+	type alias QuestionnaireItemEnableWhen
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "answer", r.Answer, r.AnswerElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *QuestionnaireItemEnableWhen) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireItemEnableWhen
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Answer, element, r.answerVariants, err = unmarshalChoice(fields, "answer", questionnaireItemEnableWhenAnswerVariants); err != nil {
+		return err
+	}
+	r.AnswerElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AnswerElement); err != nil {
+			return fmt.Errorf("_answer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r QuestionnaireItemEnableWhen) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireItemEnableWhen) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireItemEnableWhen) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireItemEnableWhen) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *QuestionnaireItemEnableWhen) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Answer":
+		return "answer", choiceValues(questionnaireItemEnableWhenAnswerVariants)
+	}
+	return "", nil
+}
+
+var questionnaireItemEnableWhenElementMetadata = []ElementMetadata{
+	{Path: "Questionnaire.item.enableWhen.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.enableWhen.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Questionnaire.item.enableWhen.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Questionnaire.item.enableWhen.question", Name: "question", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.enableWhen.operator", Name: "operator", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Questionnaire.item.enableWhen.operator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-enable-operator"}},
+	{Path: "Questionnaire.item.enableWhen.answer[x]", Name: "answer", Field: 7, Min: 1, Max: "1", Types: []string{"boolean", "decimal", "integer", "date", "dateTime", "time", "string", "Coding", "Quantity", "Reference", "uri", "Attachment"}},
+}
+
+func (r *QuestionnaireItemEnableWhen) ElementMetadata() []ElementMetadata {
+	return questionnaireItemEnableWhenElementMetadata
+}
+
+// QuestionnaireItemEnableWhenAnswer is the type of Questionnaire.item.enableWhen.answer[x]. It is implemented by the QuestionnaireItemEnableWhenAnswer* variant types.
+type QuestionnaireItemEnableWhenAnswer interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isQuestionnaireItemEnableWhenAnswer()
+}
+
+// QuestionnaireItemEnableWhenAnswerBoolean is the boolean variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerBoolean bool
+
+func (v QuestionnaireItemEnableWhenAnswerBoolean) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemEnableWhenAnswerBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireItemEnableWhenAnswerBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerBoolean) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerDecimal is the decimal variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerDecimal struct {
+	Decimal
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDecimal) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerInteger is the integer variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerInteger int
+
+func (v QuestionnaireItemEnableWhenAnswerInteger) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemEnableWhenAnswerInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireItemEnableWhenAnswerInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerInteger) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerDate is the date variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerDate struct {
+	Date
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDate) FHIRType() string {
+	return "date"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDate) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerDateTime is the dateTime variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerDateTime struct {
+	DateTime
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerDateTime) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerTime is the time variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerTime struct {
+	Time
+}
+
+func (v QuestionnaireItemEnableWhenAnswerTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemEnableWhenAnswerTime) FHIRType() string {
+	return "time"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerTime) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerString is the string variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerString string
+
+func (v QuestionnaireItemEnableWhenAnswerString) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemEnableWhenAnswerString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemEnableWhenAnswerString) FHIRType() string {
+	return "string"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerString) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerCoding is the Coding variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerCoding struct {
+	Coding
+}
+
+func (v QuestionnaireItemEnableWhenAnswerCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemEnableWhenAnswerCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerCoding) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerQuantity is the Quantity variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerQuantity struct {
+	Quantity
+}
+
+func (v QuestionnaireItemEnableWhenAnswerQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemEnableWhenAnswerQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerQuantity) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerReference is the Reference variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerReference struct {
+	Reference
+}
+
+func (v QuestionnaireItemEnableWhenAnswerReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemEnableWhenAnswerReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerReference) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerUri is the uri variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerUri string
+
+func (v QuestionnaireItemEnableWhenAnswerUri) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemEnableWhenAnswerUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemEnableWhenAnswerUri) FHIRType() string {
+	return "uri"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerUri) isQuestionnaireItemEnableWhenAnswer() {}
+
+// QuestionnaireItemEnableWhenAnswerAttachment is the Attachment variant of QuestionnaireItemEnableWhenAnswer.
+type QuestionnaireItemEnableWhenAnswerAttachment struct {
+	Attachment
+}
+
+func (v QuestionnaireItemEnableWhenAnswerAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v QuestionnaireItemEnableWhenAnswerAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemEnableWhenAnswerAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v QuestionnaireItemEnableWhenAnswerAttachment) isQuestionnaireItemEnableWhenAnswer() {}
+
+var questionnaireItemEnableWhenAnswerVariants = []QuestionnaireItemEnableWhenAnswer{
+	QuestionnaireItemEnableWhenAnswerBoolean(false),
+	QuestionnaireItemEnableWhenAnswerDecimal{},
+	QuestionnaireItemEnableWhenAnswerInteger(0),
+	QuestionnaireItemEnableWhenAnswerDate{},
+	QuestionnaireItemEnableWhenAnswerDateTime{},
+	QuestionnaireItemEnableWhenAnswerTime{},
+	QuestionnaireItemEnableWhenAnswerString(""),
+	QuestionnaireItemEnableWhenAnswerCoding{},
+	QuestionnaireItemEnableWhenAnswerQuantity{},
+	QuestionnaireItemEnableWhenAnswerReference{},
+	QuestionnaireItemEnableWhenAnswerUri(""),
+	QuestionnaireItemEnableWhenAnswerAttachment{},
+}
+
+type QuestionnaireItemAnswerOption struct {
+	Id                     *string                            `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	Value                  QuestionnaireItemAnswerOptionValue `json:"-" bson:"value,omitempty"`                                             // Answer value
+	ValueElement           *Element                           `json:"-" bson:"value_element,omitempty"`                                     // Extensions for value[x]
+	InitialSelected        *bool                              `json:"initialSelected,omitempty" bson:"initial_selected,omitempty"`          // Whether option is selected by default
+	InitialSelectedElement *Element                           `json:"_initialSelected,omitempty" bson:"initial_selected_element,omitempty"` // Extensions for initialSelected
+
+	valueVariants []string // JSON properties of Questionnaire.item.answerOption.value[x] when more than one was decoded
+}
+
+func (r *QuestionnaireItemAnswerOption) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	if r.InitialSelectedElement != nil {
+		if err := r.InitialSelectedElement.Validate(); err != nil {
+			return fmt.Errorf("InitialSelectedElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *QuestionnaireItemAnswerOption) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.InitialSelectedElement != nil {
+		r.InitialSelectedElement.validateAll(path+".initialSelected", issues)
+	}
+}
+
+var questionnaireItemAnswerOptionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *QuestionnaireItemAnswerOption) invariants() []invariant {
+	return questionnaireItemAnswerOptionInvariants
+}
+
+func (r QuestionnaireItemAnswerOption) MarshalJSON() ([]byte, error) {
+	type alias QuestionnaireItemAnswerOption
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *QuestionnaireItemAnswerOption) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireItemAnswerOption
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", questionnaireItemAnswerOptionValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r QuestionnaireItemAnswerOption) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireItemAnswerOption) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireItemAnswerOption) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireItemAnswerOption) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *QuestionnaireItemAnswerOption) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(questionnaireItemAnswerOptionValueVariants)
+	}
+	return "", nil
+}
+
+var questionnaireItemAnswerOptionElementMetadata = []ElementMetadata{
+	{Path: "Questionnaire.item.answerOption.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.answerOption.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Questionnaire.item.answerOption.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Questionnaire.item.answerOption.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"decimal", "integer", "date", "dateTime", "time", "string", "uri", "Coding", "Quantity", "Reference"}},
+	{Path: "Questionnaire.item.answerOption.initialSelected", Name: "initialSelected", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *QuestionnaireItemAnswerOption) ElementMetadata() []ElementMetadata {
+	return questionnaireItemAnswerOptionElementMetadata
+}
+
+// QuestionnaireItemAnswerOptionValue is the type of Questionnaire.item.answerOption.value[x]. It is implemented by the QuestionnaireItemAnswerOptionValue* variant types.
+type QuestionnaireItemAnswerOptionValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isQuestionnaireItemAnswerOptionValue()
+}
+
+// QuestionnaireItemAnswerOptionValueDecimal is the decimal variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueDecimal struct {
+	Decimal
+}
+
+func (v QuestionnaireItemAnswerOptionValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemAnswerOptionValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v QuestionnaireItemAnswerOptionValueDecimal) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueInteger is the integer variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueInteger int
+
+func (v QuestionnaireItemAnswerOptionValueInteger) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemAnswerOptionValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireItemAnswerOptionValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v QuestionnaireItemAnswerOptionValueInteger) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueDate is the date variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueDate struct {
+	Date
+}
+
+func (v QuestionnaireItemAnswerOptionValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemAnswerOptionValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v QuestionnaireItemAnswerOptionValueDate) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueDateTime is the dateTime variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueDateTime struct {
+	DateTime
+}
+
+func (v QuestionnaireItemAnswerOptionValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemAnswerOptionValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v QuestionnaireItemAnswerOptionValueDateTime) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueTime is the time variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueTime struct {
+	Time
+}
+
+func (v QuestionnaireItemAnswerOptionValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemAnswerOptionValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v QuestionnaireItemAnswerOptionValueTime) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueString is the string variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueString string
+
+func (v QuestionnaireItemAnswerOptionValueString) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemAnswerOptionValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireItemAnswerOptionValueString) FHIRType() string {
+	return "string"
+}
+
+func (v QuestionnaireItemAnswerOptionValueString) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueUri is the uri variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueUri string
+
+func (v QuestionnaireItemAnswerOptionValueUri) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemAnswerOptionValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemAnswerOptionValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v QuestionnaireItemAnswerOptionValueUri) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueCoding is the Coding variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueCoding struct {
+	Coding
+}
+
+func (v QuestionnaireItemAnswerOptionValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemAnswerOptionValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v QuestionnaireItemAnswerOptionValueCoding) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueQuantity is the Quantity variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueQuantity struct {
+	Quantity
+}
+
+func (v QuestionnaireItemAnswerOptionValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemAnswerOptionValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v QuestionnaireItemAnswerOptionValueQuantity) isQuestionnaireItemAnswerOptionValue() {}
+
+// QuestionnaireItemAnswerOptionValueReference is the Reference variant of QuestionnaireItemAnswerOptionValue.
+type QuestionnaireItemAnswerOptionValueReference struct {
+	Reference
+}
+
+func (v QuestionnaireItemAnswerOptionValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v QuestionnaireItemAnswerOptionValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemAnswerOptionValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v QuestionnaireItemAnswerOptionValueReference) isQuestionnaireItemAnswerOptionValue() {}
+
+var questionnaireItemAnswerOptionValueVariants = []QuestionnaireItemAnswerOptionValue{
+	QuestionnaireItemAnswerOptionValueDecimal{},
+	QuestionnaireItemAnswerOptionValueInteger(0),
+	QuestionnaireItemAnswerOptionValueDate{},
+	QuestionnaireItemAnswerOptionValueDateTime{},
+	QuestionnaireItemAnswerOptionValueTime{},
+	QuestionnaireItemAnswerOptionValueString(""),
+	QuestionnaireItemAnswerOptionValueUri(""),
+	QuestionnaireItemAnswerOptionValueCoding{},
+	QuestionnaireItemAnswerOptionValueQuantity{},
+	QuestionnaireItemAnswerOptionValueReference{},
+}
+
+type QuestionnaireItemInitial struct {
+	Id                *string                       `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                   `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             QuestionnaireItemInitialValue `json:"-" bson:"value,omitempty"`                                        // Actual value for initializing the question
+	ValueElement      *Element                      `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Questionnaire.item.initial.value[x] when more than one was decoded
+}
+
+func (r *QuestionnaireItemInitial) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *QuestionnaireItemInitial) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var questionnaireItemInitialInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *QuestionnaireItemInitial) invariants() []invariant {
+	return questionnaireItemInitialInvariants
+}
+
+func (r QuestionnaireItemInitial) MarshalJSON() ([]byte, error) {
+	type alias QuestionnaireItemInitial
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *QuestionnaireItemInitial) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireItemInitial
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", questionnaireItemInitialValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r QuestionnaireItemInitial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireItemInitial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireItemInitial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireItemInitial) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *QuestionnaireItemInitial) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(questionnaireItemInitialValueVariants)
+	}
+	return "", nil
+}
+
+var questionnaireItemInitialElementMetadata = []ElementMetadata{
+	{Path: "Questionnaire.item.initial.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Questionnaire.item.initial.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Questionnaire.item.initial.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Questionnaire.item.initial.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"boolean", "decimal", "integer", "date", "dateTime", "time", "string", "uri", "Attachment", "Coding", "Quantity", "Reference"}},
+}
+
+func (r *QuestionnaireItemInitial) ElementMetadata() []ElementMetadata {
+	return questionnaireItemInitialElementMetadata
+}
+
+// QuestionnaireItemInitialValue is the type of Questionnaire.item.initial.value[x]. It is implemented by the QuestionnaireItemInitialValue* variant types.
+type QuestionnaireItemInitialValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isQuestionnaireItemInitialValue()
+}
+
+// QuestionnaireItemInitialValueBoolean is the boolean variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueBoolean bool
+
+func (v QuestionnaireItemInitialValueBoolean) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemInitialValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemInitialValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v QuestionnaireItemInitialValueBoolean) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueDecimal is the decimal variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueDecimal struct {
+	Decimal
+}
+
+func (v QuestionnaireItemInitialValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v QuestionnaireItemInitialValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemInitialValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v QuestionnaireItemInitialValueDecimal) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueInteger is the integer variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueInteger int
+
+func (v QuestionnaireItemInitialValueInteger) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemInitialValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemInitialValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v QuestionnaireItemInitialValueInteger) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueDate is the date variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueDate struct {
+	Date
+}
+
+func (v QuestionnaireItemInitialValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v QuestionnaireItemInitialValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemInitialValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v QuestionnaireItemInitialValueDate) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueDateTime is the dateTime variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueDateTime struct {
+	DateTime
+}
+
+func (v QuestionnaireItemInitialValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v QuestionnaireItemInitialValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemInitialValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v QuestionnaireItemInitialValueDateTime) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueTime is the time variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueTime struct {
+	Time
+}
+
+func (v QuestionnaireItemInitialValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v QuestionnaireItemInitialValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireItemInitialValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v QuestionnaireItemInitialValueTime) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueString is the string variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueString string
+
+func (v QuestionnaireItemInitialValueString) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemInitialValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemInitialValueString) FHIRType() string {
+	return "string"
+}
+
+func (v QuestionnaireItemInitialValueString) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueUri is the uri variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueUri string
+
+func (v QuestionnaireItemInitialValueUri) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireItemInitialValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireItemInitialValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v QuestionnaireItemInitialValueUri) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueAttachment is the Attachment variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueAttachment struct {
+	Attachment
+}
+
+func (v QuestionnaireItemInitialValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v QuestionnaireItemInitialValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemInitialValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v QuestionnaireItemInitialValueAttachment) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueCoding is the Coding variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueCoding struct {
+	Coding
+}
+
+func (v QuestionnaireItemInitialValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v QuestionnaireItemInitialValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemInitialValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v QuestionnaireItemInitialValueCoding) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueQuantity is the Quantity variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueQuantity struct {
+	Quantity
+}
+
+func (v QuestionnaireItemInitialValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v QuestionnaireItemInitialValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemInitialValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v QuestionnaireItemInitialValueQuantity) isQuestionnaireItemInitialValue() {}
+
+// QuestionnaireItemInitialValueReference is the Reference variant of QuestionnaireItemInitialValue.
+type QuestionnaireItemInitialValueReference struct {
+	Reference
+}
+
+func (v QuestionnaireItemInitialValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v QuestionnaireItemInitialValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v QuestionnaireItemInitialValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v QuestionnaireItemInitialValueReference) isQuestionnaireItemInitialValue() {}
+
+var questionnaireItemInitialValueVariants = []QuestionnaireItemInitialValue{
+	QuestionnaireItemInitialValueBoolean(false),
+	QuestionnaireItemInitialValueDecimal{},
+	QuestionnaireItemInitialValueInteger(0),
+	QuestionnaireItemInitialValueDate{},
+	QuestionnaireItemInitialValueDateTime{},
+	QuestionnaireItemInitialValueTime{},
+	QuestionnaireItemInitialValueString(""),
+	QuestionnaireItemInitialValueUri(""),
+	QuestionnaireItemInitialValueAttachment{},
+	QuestionnaireItemInitialValueCoding{},
+	QuestionnaireItemInitialValueQuantity{},
+	QuestionnaireItemInitialValueReference{},
+}
@@ -2,31 +2,49 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A structured set of questions and their answers. The questions are ordered and grouped into coherent subsets, corresponding to the structure of the grouping of the questionnaire being responded to.
 type QuestionnaireResponse struct {
-	ResourceType  string                      `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                     `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                     `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Business identifier for this set of answers
-	BasedOn       []Reference                 `json:"basedOn,omitempty" bson:"based_on,omitempty"`             // Request fulfilled by this QuestionnaireResponse
-	PartOf        []Reference                 `json:"partOf,omitempty" bson:"part_of,omitempty"`               // Part of referenced event
-	Questionnaire string                      `json:"questionnaire" bson:"questionnaire"`                      // Canonical URL of Questionnaire being answered
-	Status        string                      `json:"status" bson:"status"`                                    // in-progress | completed | amended | entered-in-error | stopped
-	Subject       *Reference                  `json:"subject,omitempty" bson:"subject,omitempty"`              // The subject of the questions
-	Encounter     *Reference                  `json:"encounter,omitempty" bson:"encounter,omitempty"`          // Encounter the questionnaire response is part of
-	Authored      *string                     `json:"authored,omitempty" bson:"authored,omitempty"`            // Date the answers were gathered
-	Author        *Reference                  `json:"author,omitempty" bson:"author,omitempty"`                // The individual or device that received and recorded the answers
-	Source        *Reference                  `json:"source,omitempty" bson:"source,omitempty"`                // The individual or device that answered the questions
-	Item          []QuestionnaireResponseItem `json:"item,omitempty" bson:"item,omitempty"`                    // Groups and questions
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier for this set of answers
+	BasedOn              []Reference                 `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Request fulfilled by this QuestionnaireResponse
+	PartOf               []Reference                 `json:"partOf,omitempty" bson:"part_of,omitempty"`                        // Part of referenced event
+	Questionnaire        string                      `json:"questionnaire" bson:"questionnaire"`                               // Canonical URL of Questionnaire being answered
+	QuestionnaireElement *Element                    `json:"_questionnaire,omitempty" bson:"questionnaire_element,omitempty"`  // Extensions for questionnaire
+	Status               QuestionnaireResponseStatus `json:"status" bson:"status"`                                             // in-progress | completed | amended | entered-in-error | stopped
+	StatusElement        *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Subject              *Reference                  `json:"subject,omitempty" bson:"subject,omitempty"`                       // The subject of the questions
+	Encounter            *Reference                  `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Encounter the questionnaire response is part of
+	Authored             *DateTime                   `json:"authored,omitempty" bson:"authored,omitempty"`                     // Date the answers were gathered
+	AuthoredElement      *Element                    `json:"_authored,omitempty" bson:"authored_element,omitempty"`            // Extensions for authored
+	Author               *Reference                  `json:"author,omitempty" bson:"author,omitempty"`                         // The individual or device that received and recorded the answers
+	Source               *Reference                  `json:"source,omitempty" bson:"source,omitempty"`                         // The individual or device that answered the questions
+	Item                 []QuestionnaireResponseItem `json:"item,omitempty" bson:"item,omitempty"`                             // Groups and questions
 }
 
+var questionnaireResponseBasedOnTargets = []string{"CarePlan", "ServiceRequest"}
+
+var questionnaireResponsePartOfTargets = []string{"Observation", "Procedure"}
+
+var questionnaireResponseEncounterTargets = []string{"Encounter"}
+
+var questionnaireResponseAuthorTargets = []string{"Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var questionnaireResponseSourceTargets = []string{"Device", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *QuestionnaireResponse) Validate() error {
 	if r.ResourceType != "QuestionnaireResponse" {
 		return fmt.Errorf("invalid resourceType: expected 'QuestionnaireResponse', got '%s'", r.ResourceType)
@@ -36,21 +54,59 @@ func (r *QuestionnaireResponse) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, questionnaireResponseBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, questionnaireResponseBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, questionnaireResponsePartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, questionnaireResponsePartOfTargets)
+		}
+	}
 	for i, item := range r.PartOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
@@ -60,24 +116,62 @@ func (r *QuestionnaireResponse) Validate() error {
 	if r.Questionnaire == emptyString {
 		return fmt.Errorf("field 'Questionnaire' is required")
 	}
-	if r.Status == emptyString {
+	if r.QuestionnaireElement != nil {
+		if err := r.QuestionnaireElement.Validate(); err != nil {
+			return fmt.Errorf("QuestionnaireElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, questionnaireResponseEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, questionnaireResponseEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.Authored != nil {
+		if err := r.Authored.Validate(); err != nil {
+			return fmt.Errorf("Authored: %w", err)
+		}
+	}
+	if r.AuthoredElement != nil {
+		if err := r.AuthoredElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredElement: %w", err)
+		}
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, questionnaireResponseAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author' cannot refer to %s, expected one of %v", target, questionnaireResponseAuthorTargets)
+		}
+	}
 	if r.Author != nil {
 		if err := r.Author.Validate(); err != nil {
 			return fmt.Errorf("Author: %w", err)
 		}
 	}
+	if r.Source != nil {
+		if target := invalidReferenceTarget(r.Source.Reference, r.Source.Type, questionnaireResponseSourceTargets); target != "" {
+			return fmt.Errorf("field 'Source' cannot refer to %s, expected one of %v", target, questionnaireResponseSourceTargets)
+		}
+	}
 	if r.Source != nil {
 		if err := r.Source.Validate(); err != nil {
 			return fmt.Errorf("Source: %w", err)
@@ -88,23 +182,292 @@ func (r *QuestionnaireResponse) Validate() error {
 			return fmt.Errorf("Item[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "QuestionnaireResponse")
+}
+
+func (r *QuestionnaireResponse) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "QuestionnaireResponse" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'QuestionnaireResponse', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, questionnaireResponseBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, questionnaireResponseBasedOnTargets))
+		}
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, questionnaireResponsePartOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.partOf[%d]", path, i), fmt.Sprintf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, questionnaireResponsePartOfTargets))
+		}
+	}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Questionnaire == emptyString {
+		issues.add("required", path+".questionnaire", "field 'Questionnaire' is required")
+	}
+	if r.QuestionnaireElement != nil {
+		r.QuestionnaireElement.validateAll(path+".questionnaire", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, questionnaireResponseEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, questionnaireResponseEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.Authored != nil {
+		if err := r.Authored.Validate(); err != nil {
+			issues.add("value", path+".authored", err.Error())
+		}
+	}
+	if r.AuthoredElement != nil {
+		r.AuthoredElement.validateAll(path+".authored", issues)
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, questionnaireResponseAuthorTargets); target != "" {
+			issues.add("value", path+".author", fmt.Sprintf("field 'Author' cannot refer to %s, expected one of %v", target, questionnaireResponseAuthorTargets))
+		}
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+	if r.Source != nil {
+		if target := invalidReferenceTarget(r.Source.Reference, r.Source.Type, questionnaireResponseSourceTargets); target != "" {
+			issues.add("value", path+".source", fmt.Sprintf("field 'Source' cannot refer to %s, expected one of %v", target, questionnaireResponseSourceTargets))
+		}
+	}
+	if r.Source != nil {
+		r.Source.validateAll(path+".source", issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
+	}
+}
+
+func (r *QuestionnaireResponse) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("QuestionnaireResponse", &issues)
+	checkInvariants(r, "QuestionnaireResponse", &issues)
+	return issues
+}
+
+var questionnaireResponseInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *QuestionnaireResponse) invariants() []invariant {
+	return questionnaireResponseInvariants
+}
+
+func (r *QuestionnaireResponse) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireResponse
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r QuestionnaireResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireResponse) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireResponse) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireResponse) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var questionnaireResponseElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"questionnaire": "canonical",
+}
+
+func (r *QuestionnaireResponse) elementTypes() map[string]string {
+	return questionnaireResponseElementTypes
+}
+
+var questionnaireResponseElementMetadata = []ElementMetadata{
+	{Path: "QuestionnaireResponse.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "QuestionnaireResponse.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "QuestionnaireResponse.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "QuestionnaireResponse.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "QuestionnaireResponse.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "QuestionnaireResponse.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "QuestionnaireResponse.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "QuestionnaireResponse.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "QuestionnaireResponse.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "QuestionnaireResponse.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "QuestionnaireResponse.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "QuestionnaireResponse.partOf", Name: "partOf", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/Procedure"}},
+	{Path: "QuestionnaireResponse.questionnaire", Name: "questionnaire", Field: 14, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "QuestionnaireResponse.status", Name: "status", Field: 16, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "QuestionnaireResponse.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/questionnaire-answers-status"}},
+	{Path: "QuestionnaireResponse.subject", Name: "subject", Field: 18, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "QuestionnaireResponse.encounter", Name: "encounter", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "QuestionnaireResponse.authored", Name: "authored", Field: 20, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "QuestionnaireResponse.author", Name: "author", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "QuestionnaireResponse.source", Name: "source", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "QuestionnaireResponse.item", Name: "item", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *QuestionnaireResponse) ElementMetadata() []ElementMetadata {
+	return questionnaireResponseElementMetadata
+}
+
+func (r *QuestionnaireResponse) GetResourceType() string {
+	return "QuestionnaireResponse"
+}
+
+func (r *QuestionnaireResponse) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *QuestionnaireResponse) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *QuestionnaireResponse) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *QuestionnaireResponse) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *QuestionnaireResponse) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *QuestionnaireResponse) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *QuestionnaireResponse) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*QuestionnaireResponse)(nil)
+
 type QuestionnaireResponseItem struct {
-	Id         *string                           `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	LinkId     string                            `json:"linkId" bson:"link_id"`                            // Pointer to specific item from Questionnaire
-	Definition []string                          `json:"definition,omitempty" bson:"definition,omitempty"` // ElementDefinition - details for the item
-	Text       *string                           `json:"text,omitempty" bson:"text,omitempty"`             // Name for group or question text
-	Answer     []QuestionnaireResponseItemAnswer `json:"answer,omitempty" bson:"answer,omitempty"`         // The response(s) to the question
-	Item       []QuestionnaireResponseItem       `json:"item,omitempty" bson:"item,omitempty"`             // Child items of group item
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LinkId            string                            `json:"linkId" bson:"link_id"`                                           // Pointer to specific item from Questionnaire
+	LinkIdElement     *Element                          `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`              // Extensions for linkId
+	Definition        []string                          `json:"definition,omitempty" bson:"definition,omitempty"`                // ElementDefinition - details for the item
+	DefinitionElement []*Element                        `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+	Text              *string                           `json:"text,omitempty" bson:"text,omitempty"`                            // Name for group or question text
+	TextElement       *Element                          `json:"_text,omitempty" bson:"text_element,omitempty"`                   // Extensions for text
+	Answer            []QuestionnaireResponseItemAnswer `json:"answer,omitempty" bson:"answer,omitempty"`                        // The response(s) to the question
+	Item              []QuestionnaireResponseItem       `json:"item,omitempty" bson:"item,omitempty"`                            // Child items of group item
 }
 
 func (r *QuestionnaireResponseItem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.LinkId == emptyString {
 		return fmt.Errorf("field 'LinkId' is required")
 	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	for i, item := range r.DefinitionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement[%d]: %w", i, err)
+		}
+	}
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
+		}
+	}
 	for i, item := range r.Answer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Answer[%d]: %w", i, err)
@@ -118,78 +481,147 @@ func (r *QuestionnaireResponseItem) Validate() error {
 	return nil
 }
 
-type QuestionnaireResponseItemAnswer struct {
-	Id              *string                     `json:"id,omitempty" bson:"id,omitempty"`        // Unique id for inter-element referencing
-	ValueBoolean    *bool                       `json:"valueBoolean" bson:"value_boolean"`       // Single-valued answer to the question
-	ValueDecimal    *float64                    `json:"valueDecimal" bson:"value_decimal"`       // Single-valued answer to the question
-	ValueInteger    *int                        `json:"valueInteger" bson:"value_integer"`       // Single-valued answer to the question
-	ValueDate       *string                     `json:"valueDate" bson:"value_date"`             // Single-valued answer to the question
-	ValueDateTime   *string                     `json:"valueDateTime" bson:"value_date_time"`    // Single-valued answer to the question
-	ValueTime       *string                     `json:"valueTime" bson:"value_time"`             // Single-valued answer to the question
-	ValueString     *string                     `json:"valueString" bson:"value_string"`         // Single-valued answer to the question
-	ValueUri        *string                     `json:"valueUri" bson:"value_uri"`               // Single-valued answer to the question
-	ValueAttachment *Attachment                 `json:"valueAttachment" bson:"value_attachment"` // Single-valued answer to the question
-	ValueCoding     *Coding                     `json:"valueCoding" bson:"value_coding"`         // Single-valued answer to the question
-	ValueQuantity   *Quantity                   `json:"valueQuantity" bson:"value_quantity"`     // Single-valued answer to the question
-	ValueReference  *Reference                  `json:"valueReference" bson:"value_reference"`   // Single-valued answer to the question
-	Item            []QuestionnaireResponseItem `json:"item,omitempty" bson:"item,omitempty"`    // Child items of question
-}
-
-func (r *QuestionnaireResponseItemAnswer) Validate() error {
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+func (r *QuestionnaireResponseItem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	var emptyString string
+	if r.LinkId == emptyString {
+		issues.add("required", path+".linkId", "field 'LinkId' is required")
 	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	for i, item := range r.DefinitionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.definition[%d]", path, i), issues)
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	for i, item := range r.Answer {
+		item.validateAll(fmt.Sprintf("%s.answer[%d]", path, i), issues)
 	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+}
+
+var questionnaireResponseItemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *QuestionnaireResponseItem) invariants() []invariant {
+	return questionnaireResponseItemInvariants
+}
+
+func (r QuestionnaireResponseItem) MarshalJSON() ([]byte, error) {
+	type alias QuestionnaireResponseItem
+	out := struct {
+		alias
+		Definition        []*string  `json:"definition,omitempty"`
+		DefinitionElement []*Element `json:"_definition,omitempty"`
+	}{alias: alias(r)}
+	out.Definition, out.DefinitionElement = alignPrimitiveArray(r.Definition, r.DefinitionElement)
+	return json.Marshal(out)
+}
+
+func (r *QuestionnaireResponseItem) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireResponseItem
+	aux := struct {
+		*alias
+		Definition []*string `json:"definition,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Definition, err = splitPrimitiveArray(aux.Definition, r.DefinitionElement); err != nil {
+		return fmt.Errorf("definition: %w", err)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	return nil
+}
+
+func (r QuestionnaireResponseItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireResponseItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireResponseItem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireResponseItem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var questionnaireResponseItemElementTypes = map[string]string{
+	"definition": "uri",
+}
+
+func (r *QuestionnaireResponseItem) elementTypes() map[string]string {
+	return questionnaireResponseItemElementTypes
+}
+
+var questionnaireResponseItemElementMetadata = []ElementMetadata{
+	{Path: "QuestionnaireResponse.item.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "QuestionnaireResponse.item.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "QuestionnaireResponse.item.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "QuestionnaireResponse.item.linkId", Name: "linkId", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "QuestionnaireResponse.item.definition", Name: "definition", Field: 5, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "QuestionnaireResponse.item.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "QuestionnaireResponse.item.answer", Name: "answer", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "QuestionnaireResponse.item.item", Name: "item", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *QuestionnaireResponseItem) ElementMetadata() []ElementMetadata {
+	return questionnaireResponseItemElementMetadata
+}
+
+type QuestionnaireResponseItemAnswer struct {
+	Id                *string                              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             QuestionnaireResponseItemAnswerValue `json:"-" bson:"value,omitempty"`                                        // Single-valued answer to the question
+	ValueElement      *Element                             `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	Item              []QuestionnaireResponseItem          `json:"item,omitempty" bson:"item,omitempty"`                            // Child items of question
+
+	valueVariants []string // JSON properties of QuestionnaireResponse.item.answer.value[x] when more than one was decoded
+}
+
+func (r *QuestionnaireResponseItemAnswer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
-	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
 	for i, item := range r.Item {
@@ -199,3 +631,358 @@ func (r *QuestionnaireResponseItemAnswer) Validate() error {
 	}
 	return nil
 }
+
+func (r *QuestionnaireResponseItemAnswer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	for i, item := range r.Item {
+		item.validateAll(fmt.Sprintf("%s.item[%d]", path, i), issues)
+	}
+}
+
+var questionnaireResponseItemAnswerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *QuestionnaireResponseItemAnswer) invariants() []invariant {
+	return questionnaireResponseItemAnswerInvariants
+}
+
+func (r QuestionnaireResponseItemAnswer) MarshalJSON() ([]byte, error) {
+	type alias QuestionnaireResponseItemAnswer
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *QuestionnaireResponseItemAnswer) UnmarshalJSON(data []byte) error {
+	type alias QuestionnaireResponseItemAnswer
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", questionnaireResponseItemAnswerValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r QuestionnaireResponseItemAnswer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *QuestionnaireResponseItemAnswer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r QuestionnaireResponseItemAnswer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *QuestionnaireResponseItemAnswer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *QuestionnaireResponseItemAnswer) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(questionnaireResponseItemAnswerValueVariants)
+	}
+	return "", nil
+}
+
+var questionnaireResponseItemAnswerElementMetadata = []ElementMetadata{
+	{Path: "QuestionnaireResponse.item.answer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "QuestionnaireResponse.item.answer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "QuestionnaireResponse.item.answer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "QuestionnaireResponse.item.answer.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"boolean", "decimal", "integer", "date", "dateTime", "time", "string", "uri", "Attachment", "Coding", "Quantity", "Reference"}},
+	{Path: "QuestionnaireResponse.item.answer.item", Name: "item", Field: 5, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *QuestionnaireResponseItemAnswer) ElementMetadata() []ElementMetadata {
+	return questionnaireResponseItemAnswerElementMetadata
+}
+
+// QuestionnaireResponseItemAnswerValue is the type of QuestionnaireResponse.item.answer.value[x]. It is implemented by the QuestionnaireResponseItemAnswerValue* variant types.
+type QuestionnaireResponseItemAnswerValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isQuestionnaireResponseItemAnswerValue()
+}
+
+// QuestionnaireResponseItemAnswerValueBoolean is the boolean variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueBoolean bool
+
+func (v QuestionnaireResponseItemAnswerValueBoolean) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireResponseItemAnswerValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireResponseItemAnswerValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v QuestionnaireResponseItemAnswerValueBoolean) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueDecimal is the decimal variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueDecimal struct {
+	Decimal
+}
+
+func (v QuestionnaireResponseItemAnswerValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireResponseItemAnswerValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v QuestionnaireResponseItemAnswerValueDecimal) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueInteger is the integer variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueInteger int
+
+func (v QuestionnaireResponseItemAnswerValueInteger) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireResponseItemAnswerValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireResponseItemAnswerValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v QuestionnaireResponseItemAnswerValueInteger) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueDate is the date variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueDate struct {
+	Date
+}
+
+func (v QuestionnaireResponseItemAnswerValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireResponseItemAnswerValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v QuestionnaireResponseItemAnswerValueDate) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueDateTime is the dateTime variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueDateTime struct {
+	DateTime
+}
+
+func (v QuestionnaireResponseItemAnswerValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireResponseItemAnswerValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v QuestionnaireResponseItemAnswerValueDateTime) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueTime is the time variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueTime struct {
+	Time
+}
+
+func (v QuestionnaireResponseItemAnswerValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v QuestionnaireResponseItemAnswerValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v QuestionnaireResponseItemAnswerValueTime) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueString is the string variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueString string
+
+func (v QuestionnaireResponseItemAnswerValueString) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireResponseItemAnswerValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v QuestionnaireResponseItemAnswerValueString) FHIRType() string {
+	return "string"
+}
+
+func (v QuestionnaireResponseItemAnswerValueString) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueUri is the uri variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueUri string
+
+func (v QuestionnaireResponseItemAnswerValueUri) Validate() error {
+	return nil
+}
+
+func (v QuestionnaireResponseItemAnswerValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v QuestionnaireResponseItemAnswerValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v QuestionnaireResponseItemAnswerValueUri) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueAttachment is the Attachment variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueAttachment struct {
+	Attachment
+}
+
+func (v QuestionnaireResponseItemAnswerValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v QuestionnaireResponseItemAnswerValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v QuestionnaireResponseItemAnswerValueAttachment) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueCoding is the Coding variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueCoding struct {
+	Coding
+}
+
+func (v QuestionnaireResponseItemAnswerValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v QuestionnaireResponseItemAnswerValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v QuestionnaireResponseItemAnswerValueCoding) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueQuantity is the Quantity variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueQuantity struct {
+	Quantity
+}
+
+func (v QuestionnaireResponseItemAnswerValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v QuestionnaireResponseItemAnswerValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v QuestionnaireResponseItemAnswerValueQuantity) isQuestionnaireResponseItemAnswerValue() {}
+
+// QuestionnaireResponseItemAnswerValueReference is the Reference variant of QuestionnaireResponseItemAnswerValue.
+type QuestionnaireResponseItemAnswerValueReference struct {
+	Reference
+}
+
+func (v QuestionnaireResponseItemAnswerValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v QuestionnaireResponseItemAnswerValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v QuestionnaireResponseItemAnswerValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v QuestionnaireResponseItemAnswerValueReference) isQuestionnaireResponseItemAnswerValue() {}
+
+var questionnaireResponseItemAnswerValueVariants = []QuestionnaireResponseItemAnswerValue{
+	QuestionnaireResponseItemAnswerValueBoolean(false),
+	QuestionnaireResponseItemAnswerValueDecimal{},
+	QuestionnaireResponseItemAnswerValueInteger(0),
+	QuestionnaireResponseItemAnswerValueDate{},
+	QuestionnaireResponseItemAnswerValueDateTime{},
+	QuestionnaireResponseItemAnswerValueTime{},
+	QuestionnaireResponseItemAnswerValueString(""),
+	QuestionnaireResponseItemAnswerValueUri(""),
+	QuestionnaireResponseItemAnswerValueAttachment{},
+	QuestionnaireResponseItemAnswerValueCoding{},
+	QuestionnaireResponseItemAnswerValueQuantity{},
+	QuestionnaireResponseItemAnswerValueReference{},
+}
@@ -1,17 +1,24 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // Range Type: A set of ordered Quantities defined by a low and high limit.
 type Range struct {
-	Id   *string   `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Low  *Quantity `json:"low,omitempty" bson:"low,omitempty"`   // Low limit
-	High *Quantity `json:"high,omitempty" bson:"high,omitempty"` // High limit
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Low       *Quantity   `json:"low,omitempty" bson:"low,omitempty"`             // Low limit
+	High      *Quantity   `json:"high,omitempty" bson:"high,omitempty"`           // High limit
 }
 
 func (r *Range) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Low != nil {
 		if err := r.Low.Validate(); err != nil {
 			return fmt.Errorf("Low: %w", err)
@@ -24,3 +31,58 @@ func (r *Range) Validate() error {
 	}
 	return nil
 }
+
+func (r *Range) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Low != nil {
+		r.Low.validateAll(path+".low", issues)
+	}
+	if r.High != nil {
+		r.High.validateAll(path+".high", issues)
+	}
+}
+
+func (r *Range) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Range", &issues)
+	checkInvariants(r, "Range", &issues)
+	return issues
+}
+
+var rangeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "rng-2", severity: "error", human: "If present, low SHALL have a lower value than high", expression: "low.value.empty() or high.value.empty() or low.lowBoundary().comparable(high.highBoundary()).not() or (low.lowBoundary() <= high.highBoundary())"},
+}
+
+func (r *Range) invariants() []invariant {
+	return rangeInvariants
+}
+
+func (r Range) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Range) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Range) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Range) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var rangeElementMetadata = []ElementMetadata{
+	{Path: "Range.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Range.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Range.low", Name: "low", Field: 2, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "Range.high", Name: "high", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+}
+
+func (r *Range) ElementMetadata() []ElementMetadata {
+	return rangeElementMetadata
+}
@@ -1,17 +1,24 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // Ratio Type: A relationship of two Quantity values - expressed as a numerator and a denominator.
 type Ratio struct {
-	Id          *string   `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Numerator   *Quantity `json:"numerator,omitempty" bson:"numerator,omitempty"`     // Numerator value
-	Denominator *Quantity `json:"denominator,omitempty" bson:"denominator,omitempty"` // Denominator value
+	Id          *string     `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
+	Extension   []Extension `json:"extension,omitempty" bson:"extension,omitempty"`     // Additional content defined by implementations
+	Numerator   *Quantity   `json:"numerator,omitempty" bson:"numerator,omitempty"`     // Numerator value
+	Denominator *Quantity   `json:"denominator,omitempty" bson:"denominator,omitempty"` // Denominator value
 }
 
 func (r *Ratio) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Numerator != nil {
 		if err := r.Numerator.Validate(); err != nil {
 			return fmt.Errorf("Numerator: %w", err)
@@ -24,3 +31,58 @@ func (r *Ratio) Validate() error {
 	}
 	return nil
 }
+
+func (r *Ratio) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Numerator != nil {
+		r.Numerator.validateAll(path+".numerator", issues)
+	}
+	if r.Denominator != nil {
+		r.Denominator.validateAll(path+".denominator", issues)
+	}
+}
+
+func (r *Ratio) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Ratio", &issues)
+	checkInvariants(r, "Ratio", &issues)
+	return issues
+}
+
+var ratioInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "rat-1", severity: "error", human: "Numerator and denominator SHALL both be present, or both are absent. If both are absent, there SHALL be some extension present", expression: "(numerator.exists() and denominator.exists()) or (numerator.empty() and denominator.empty() and extension.exists())"},
+}
+
+func (r *Ratio) invariants() []invariant {
+	return ratioInvariants
+}
+
+func (r Ratio) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Ratio) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Ratio) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Ratio) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var ratioElementMetadata = []ElementMetadata{
+	{Path: "Ratio.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Ratio.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Ratio.numerator", Name: "numerator", Field: 2, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "Ratio.denominator", Name: "denominator", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+}
+
+func (r *Ratio) ElementMetadata() []ElementMetadata {
+	return ratioElementMetadata
+}
@@ -1,18 +1,25 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // RatioRange Type: A range of ratios expressed as a low and high numerator and a denominator.
 type RatioRange struct {
-	Id            *string   `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	LowNumerator  *Quantity `json:"lowNumerator,omitempty" bson:"low_numerator,omitempty"`   // Low Numerator limit
-	HighNumerator *Quantity `json:"highNumerator,omitempty" bson:"high_numerator,omitempty"` // High Numerator limit
-	Denominator   *Quantity `json:"denominator,omitempty" bson:"denominator,omitempty"`      // Denominator value
+	Id            *string     `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
+	Extension     []Extension `json:"extension,omitempty" bson:"extension,omitempty"`          // Additional content defined by implementations
+	LowNumerator  *Quantity   `json:"lowNumerator,omitempty" bson:"low_numerator,omitempty"`   // Low Numerator limit
+	HighNumerator *Quantity   `json:"highNumerator,omitempty" bson:"high_numerator,omitempty"` // High Numerator limit
+	Denominator   *Quantity   `json:"denominator,omitempty" bson:"denominator,omitempty"`      // Denominator value
 }
 
 func (r *RatioRange) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.LowNumerator != nil {
 		if err := r.LowNumerator.Validate(); err != nil {
 			return fmt.Errorf("LowNumerator: %w", err)
@@ -30,3 +37,63 @@ func (r *RatioRange) Validate() error {
 	}
 	return nil
 }
+
+func (r *RatioRange) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.LowNumerator != nil {
+		r.LowNumerator.validateAll(path+".lowNumerator", issues)
+	}
+	if r.HighNumerator != nil {
+		r.HighNumerator.validateAll(path+".highNumerator", issues)
+	}
+	if r.Denominator != nil {
+		r.Denominator.validateAll(path+".denominator", issues)
+	}
+}
+
+func (r *RatioRange) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RatioRange", &issues)
+	checkInvariants(r, "RatioRange", &issues)
+	return issues
+}
+
+var ratioRangeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "ratrng-1", severity: "error", human: "One of lowNumerator or highNumerator and denominator SHALL be present, or all are absent. If all are absent, there SHALL be some extension present", expression: "((lowNumerator.exists() or highNumerator.exists()) and denominator.exists()) or (lowNumerator.empty() and highNumerator.empty() and denominator.empty() and extension.exists())"},
+	{key: "ratrng-2", severity: "error", human: "If present, lowNumerator SHALL have a lower value than highNumerator", expression: "lowNumerator.hasValue().not() or highNumerator.hasValue().not()  or (lowNumerator.lowBoundary() <= highNumerator.highBoundary())"},
+}
+
+func (r *RatioRange) invariants() []invariant {
+	return ratioRangeInvariants
+}
+
+func (r RatioRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RatioRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RatioRange) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RatioRange) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var ratioRangeElementMetadata = []ElementMetadata{
+	{Path: "RatioRange.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RatioRange.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RatioRange.lowNumerator", Name: "lowNumerator", Field: 2, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "RatioRange.highNumerator", Name: "highNumerator", Field: 3, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "RatioRange.denominator", Name: "denominator", Field: 4, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+}
+
+func (r *RatioRange) ElementMetadata() []ElementMetadata {
+	return ratioRangeElementMetadata
+}
@@ -1,23 +1,120 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // Reference Type: A reference from one resource to another.
 type Reference struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Reference  *string     `json:"reference,omitempty" bson:"reference,omitempty"`   // Literal reference, Relative, internal or absolute URL
-	Type       *string     `json:"type,omitempty" bson:"type,omitempty"`             // Type the reference refers to (e.g. "Patient") - must be a resource in resources
-	Identifier *Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"` // Logical reference, when literal reference is not known
-	Display    *string     `json:"display,omitempty" bson:"display,omitempty"`       // Text alternative for the resource
+	Id               *string     `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
+	Extension        []Extension `json:"extension,omitempty" bson:"extension,omitempty"`          // Additional content defined by implementations
+	Reference        *string     `json:"reference,omitempty" bson:"reference,omitempty"`          // Literal reference, Relative, internal or absolute URL
+	ReferenceElement *Element    `json:"_reference,omitempty" bson:"reference_element,omitempty"` // Extensions for reference
+	Type             *string     `json:"type,omitempty" bson:"type,omitempty"`                    // Type the reference refers to (e.g. "Patient") - must be a resource in resources
+	TypeElement      *Element    `json:"_type,omitempty" bson:"type_element,omitempty"`           // Extensions for type
+	Identifier       *Identifier `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Logical reference, when literal reference is not known
+	Display          *string     `json:"display,omitempty" bson:"display,omitempty"`              // Text alternative for the resource
+	DisplayElement   *Element    `json:"_display,omitempty" bson:"display_element,omitempty"`     // Extensions for display
 }
 
 func (r *Reference) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Reference) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+}
+
+func (r *Reference) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Reference", &issues)
+	checkInvariants(r, "Reference", &issues)
+	return issues
+}
+
+var referenceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "ref-1", severity: "error", human: "SHALL have a contained resource if a local reference is provided", expression: "reference.exists()  implies (reference.startsWith('#').not() or (reference.substring(1).trace('url') in %rootResource.contained.id.trace('ids')) or (reference='#' and %rootResource!=%resource))"},
+	{key: "ref-2", severity: "error", human: "At least one of reference, identifier and display SHALL be present (unless an extension is provided).", expression: "reference.exists() or identifier.exists() or display.exists() or extension.exists()"},
+}
+
+func (r *Reference) invariants() []invariant {
+	return referenceInvariants
+}
+
+func (r Reference) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Reference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Reference) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Reference) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var referenceElementTypes = map[string]string{
+	"type": "uri",
+}
+
+func (r *Reference) elementTypes() map[string]string {
+	return referenceElementTypes
+}
+
+var referenceElementMetadata = []ElementMetadata{
+	{Path: "Reference.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Reference.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Reference.reference", Name: "reference", Field: 2, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "Reference.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"uri"}, Binding: &BindingDefinition{Path: "Reference.type", Strength: "extensible", ValueSet: "http://hl7.org/fhir/ValueSet/resource-types", Description: "Aa resource (or, for logical models, the URI of the logical model)."}, Summary: true},
+	{Path: "Reference.identifier", Name: "identifier", Field: 6, Min: 0, Max: "1", Types: []string{"Identifier"}, Summary: true},
+	{Path: "Reference.display", Name: "display", Field: 7, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *Reference) ElementMetadata() []ElementMetadata {
+	return referenceElementMetadata
+}
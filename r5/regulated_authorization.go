@@ -2,35 +2,46 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Regulatory approval, clearance or licensing related to a regulated product, treatment, facility or activity that is cited in a guidance, regulation, rule or legislative act. An example is Market Authorization relating to a Medicinal Product.
 type RegulatedAuthorization struct {
-	ResourceType     string                      `json:"resourceType" bson:"resource_type"`                             // Type of resource
-	Id               *string                     `json:"id,omitempty" bson:"id,omitempty"`                              // Logical id of this artifact
-	Meta             *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                          // Metadata about the resource
-	ImplicitRules    *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`       // A set of rules under which this content was created
-	Language         *string                     `json:"language,omitempty" bson:"language,omitempty"`                  // Language of the resource content
-	Text             *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                          // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage           `json:"contained,omitempty" bson:"contained,omitempty"`                // Contained, inline Resources
-	Identifier       []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`              // Business identifier for the authorization, typically assigned by the authorizing body
-	Subject          []Reference                 `json:"subject,omitempty" bson:"subject,omitempty"`                    // The product type, treatment, facility or activity that is being authorized
-	Type             *CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                          // Overall type of this authorization, for example drug marketing approval, orphan drug designation
-	Description      *string                     `json:"description,omitempty" bson:"description,omitempty"`            // General textual supporting information
-	Region           []CodeableConcept           `json:"region,omitempty" bson:"region,omitempty"`                      // The territory in which the authorization has been granted
-	Status           *CodeableConcept            `json:"status,omitempty" bson:"status,omitempty"`                      // The status that is authorised e.g. approved. Intermediate states can be tracked with cases and applications
-	StatusDate       *string                     `json:"statusDate,omitempty" bson:"status_date,omitempty"`             // The date at which the current status was assigned
-	ValidityPeriod   *Period                     `json:"validityPeriod,omitempty" bson:"validity_period,omitempty"`     // The time period in which the regulatory approval etc. is in effect, e.g. a Marketing Authorization includes the date of authorization and/or expiration date
-	Indication       []CodeableReference         `json:"indication,omitempty" bson:"indication,omitempty"`              // Condition for which the use of the regulated product applies
-	IntendedUse      *CodeableConcept            `json:"intendedUse,omitempty" bson:"intended_use,omitempty"`           // The intended use of the product, e.g. prevention, treatment
-	Basis            []CodeableConcept           `json:"basis,omitempty" bson:"basis,omitempty"`                        // The legal/regulatory framework or reasons under which this authorization is granted
-	Holder           *Reference                  `json:"holder,omitempty" bson:"holder,omitempty"`                      // The organization that has been granted this authorization, by the regulator
-	Regulator        *Reference                  `json:"regulator,omitempty" bson:"regulator,omitempty"`                // The regulatory authority or authorizing body granting the authorization
-	AttachedDocument []Reference                 `json:"attachedDocument,omitempty" bson:"attached_document,omitempty"` // Additional information or supporting documentation about the authorization
-	Case             *RegulatedAuthorizationCase `json:"case,omitempty" bson:"case,omitempty"`                          // The case or regulatory procedure for granting or amending a regulated authorization. Note: This area is subject to ongoing review and the workgroup is seeking implementer feedback on its use (see link at bottom of page)
+	ResourceType         string                      `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                     `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                     `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business identifier for the authorization, typically assigned by the authorizing body
+	Subject              []Reference                 `json:"subject,omitempty" bson:"subject,omitempty"`                       // The product type, treatment, facility or activity that is being authorized
+	Type                 *CodeableConcept            `json:"type,omitempty" bson:"type,omitempty"`                             // Overall type of this authorization, for example drug marketing approval, orphan drug designation
+	Description          *string                     `json:"description,omitempty" bson:"description,omitempty"`               // General textual supporting information
+	DescriptionElement   *Element                    `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Region               []CodeableConcept           `json:"region,omitempty" bson:"region,omitempty"`                         // The territory in which the authorization has been granted
+	Status               *CodeableConcept            `json:"status,omitempty" bson:"status,omitempty"`                         // The status that is authorised e.g. approved. Intermediate states can be tracked with cases and applications
+	StatusDate           *DateTime                   `json:"statusDate,omitempty" bson:"status_date,omitempty"`                // The date at which the current status was assigned
+	StatusDateElement    *Element                    `json:"_statusDate,omitempty" bson:"status_date_element,omitempty"`       // Extensions for statusDate
+	ValidityPeriod       *Period                     `json:"validityPeriod,omitempty" bson:"validity_period,omitempty"`        // The time period in which the regulatory approval etc. is in effect, e.g. a Marketing Authorization includes the date of authorization and/or expiration date
+	Indication           []CodeableReference         `json:"indication,omitempty" bson:"indication,omitempty"`                 // Condition for which the use of the regulated product applies
+	IntendedUse          *CodeableConcept            `json:"intendedUse,omitempty" bson:"intended_use,omitempty"`              // The intended use of the product, e.g. prevention, treatment
+	Basis                []CodeableConcept           `json:"basis,omitempty" bson:"basis,omitempty"`                           // The legal/regulatory framework or reasons under which this authorization is granted
+	Holder               *Reference                  `json:"holder,omitempty" bson:"holder,omitempty"`                         // The organization that has been granted this authorization, by the regulator
+	Regulator            *Reference                  `json:"regulator,omitempty" bson:"regulator,omitempty"`                   // The regulatory authority or authorizing body granting the authorization
+	AttachedDocument     []Reference                 `json:"attachedDocument,omitempty" bson:"attached_document,omitempty"`    // Additional information or supporting documentation about the authorization
+	Case                 *RegulatedAuthorizationCase `json:"case,omitempty" bson:"case,omitempty"`                             // The case or regulatory procedure for granting or amending a regulated authorization. Note: This area is subject to ongoing review and the workgroup is seeking implementer feedback on its use (see link at bottom of page)
 }
 
+var regulatedAuthorizationSubjectTargets = []string{"ActivityDefinition", "BiologicallyDerivedProduct", "DeviceDefinition", "Ingredient", "Location", "ManufacturedItemDefinition", "MedicinalProductDefinition", "NutritionProduct", "ObservationDefinition", "Organization", "PackagedProductDefinition", "PlanDefinition", "Practitioner", "ResearchStudy", "SubstanceDefinition"}
+
+var regulatedAuthorizationHolderTargets = []string{"Organization"}
+
 func (r *RegulatedAuthorization) Validate() error {
 	if r.ResourceType != "RegulatedAuthorization" {
 		return fmt.Errorf("invalid resourceType: expected 'RegulatedAuthorization', got '%s'", r.ResourceType)
@@ -40,16 +51,49 @@ func (r *RegulatedAuthorization) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Subject {
+		if target := invalidReferenceTarget(item.Reference, item.Type, regulatedAuthorizationSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject[%d]' cannot refer to %s, expected one of %v", i, target, regulatedAuthorizationSubjectTargets)
+		}
+	}
 	for i, item := range r.Subject {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Subject[%d]: %w", i, err)
@@ -60,6 +104,11 @@ func (r *RegulatedAuthorization) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Region {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Region[%d]: %w", i, err)
@@ -70,6 +119,16 @@ func (r *RegulatedAuthorization) Validate() error {
 			return fmt.Errorf("Status: %w", err)
 		}
 	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			return fmt.Errorf("StatusDate: %w", err)
+		}
+	}
+	if r.StatusDateElement != nil {
+		if err := r.StatusDateElement.Validate(); err != nil {
+			return fmt.Errorf("StatusDateElement: %w", err)
+		}
+	}
 	if r.ValidityPeriod != nil {
 		if err := r.ValidityPeriod.Validate(); err != nil {
 			return fmt.Errorf("ValidityPeriod: %w", err)
@@ -90,6 +149,11 @@ func (r *RegulatedAuthorization) Validate() error {
 			return fmt.Errorf("Basis[%d]: %w", i, err)
 		}
 	}
+	if r.Holder != nil {
+		if target := invalidReferenceTarget(r.Holder.Reference, r.Holder.Type, regulatedAuthorizationHolderTargets); target != "" {
+			return fmt.Errorf("field 'Holder' cannot refer to %s, expected one of %v", target, regulatedAuthorizationHolderTargets)
+		}
+	}
 	if r.Holder != nil {
 		if err := r.Holder.Validate(); err != nil {
 			return fmt.Errorf("Holder: %w", err)
@@ -110,20 +174,261 @@ func (r *RegulatedAuthorization) Validate() error {
 			return fmt.Errorf("Case: %w", err)
 		}
 	}
+	return validateInvariants(r, "RegulatedAuthorization")
+}
+
+func (r *RegulatedAuthorization) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "RegulatedAuthorization" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'RegulatedAuthorization', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.Subject {
+		if target := invalidReferenceTarget(item.Reference, item.Type, regulatedAuthorizationSubjectTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.subject[%d]", path, i), fmt.Sprintf("field 'Subject[%d]' cannot refer to %s, expected one of %v", i, target, regulatedAuthorizationSubjectTargets))
+		}
+	}
+	for i, item := range r.Subject {
+		item.validateAll(fmt.Sprintf("%s.subject[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.Region {
+		item.validateAll(fmt.Sprintf("%s.region[%d]", path, i), issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			issues.add("value", path+".statusDate", err.Error())
+		}
+	}
+	if r.StatusDateElement != nil {
+		r.StatusDateElement.validateAll(path+".statusDate", issues)
+	}
+	if r.ValidityPeriod != nil {
+		r.ValidityPeriod.validateAll(path+".validityPeriod", issues)
+	}
+	for i, item := range r.Indication {
+		item.validateAll(fmt.Sprintf("%s.indication[%d]", path, i), issues)
+	}
+	if r.IntendedUse != nil {
+		r.IntendedUse.validateAll(path+".intendedUse", issues)
+	}
+	for i, item := range r.Basis {
+		item.validateAll(fmt.Sprintf("%s.basis[%d]", path, i), issues)
+	}
+	if r.Holder != nil {
+		if target := invalidReferenceTarget(r.Holder.Reference, r.Holder.Type, regulatedAuthorizationHolderTargets); target != "" {
+			issues.add("value", path+".holder", fmt.Sprintf("field 'Holder' cannot refer to %s, expected one of %v", target, regulatedAuthorizationHolderTargets))
+		}
+	}
+	if r.Holder != nil {
+		r.Holder.validateAll(path+".holder", issues)
+	}
+	if r.Regulator != nil {
+		r.Regulator.validateAll(path+".regulator", issues)
+	}
+	for i, item := range r.AttachedDocument {
+		item.validateAll(fmt.Sprintf("%s.attachedDocument[%d]", path, i), issues)
+	}
+	if r.Case != nil {
+		r.Case.validateAll(path+".case", issues)
+	}
+}
+
+func (r *RegulatedAuthorization) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RegulatedAuthorization", &issues)
+	checkInvariants(r, "RegulatedAuthorization", &issues)
+	return issues
+}
+
+var regulatedAuthorizationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *RegulatedAuthorization) invariants() []invariant {
+	return regulatedAuthorizationInvariants
+}
+
+func (r *RegulatedAuthorization) UnmarshalJSON(data []byte) error {
+	type alias RegulatedAuthorization
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r RegulatedAuthorization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RegulatedAuthorization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RegulatedAuthorization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RegulatedAuthorization) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var regulatedAuthorizationElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *RegulatedAuthorization) elementTypes() map[string]string {
+	return regulatedAuthorizationElementTypes
+}
+
+var regulatedAuthorizationElementMetadata = []ElementMetadata{
+	{Path: "RegulatedAuthorization.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "RegulatedAuthorization.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "RegulatedAuthorization.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "RegulatedAuthorization.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RegulatedAuthorization.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "RegulatedAuthorization.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "RegulatedAuthorization.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "RegulatedAuthorization.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RegulatedAuthorization.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RegulatedAuthorization.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "RegulatedAuthorization.subject", Name: "subject", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition"}},
+	{Path: "RegulatedAuthorization.type", Name: "type", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.description", Name: "description", Field: 14, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "RegulatedAuthorization.region", Name: "region", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.status", Name: "status", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.statusDate", Name: "statusDate", Field: 18, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "RegulatedAuthorization.validityPeriod", Name: "validityPeriod", Field: 20, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "RegulatedAuthorization.indication", Name: "indication", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "RegulatedAuthorization.intendedUse", Name: "intendedUse", Field: 22, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.basis", Name: "basis", Field: 23, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.holder", Name: "holder", Field: 24, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization"}},
+	{Path: "RegulatedAuthorization.regulator", Name: "regulator", Field: 25, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "RegulatedAuthorization.attachedDocument", Name: "attachedDocument", Field: 26, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "RegulatedAuthorization.case", Name: "case", Field: 27, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *RegulatedAuthorization) ElementMetadata() []ElementMetadata {
+	return regulatedAuthorizationElementMetadata
+}
+
+func (r *RegulatedAuthorization) GetResourceType() string {
+	return "RegulatedAuthorization"
+}
+
+func (r *RegulatedAuthorization) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *RegulatedAuthorization) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *RegulatedAuthorization) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *RegulatedAuthorization) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *RegulatedAuthorization) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *RegulatedAuthorization) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *RegulatedAuthorization) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*RegulatedAuthorization)(nil)
+
 type RegulatedAuthorizationCase struct {
-	Id           *string                      `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Identifier   *Identifier                  `json:"identifier,omitempty" bson:"identifier,omitempty"`       // Identifier by which this case can be referenced
-	Type         *CodeableConcept             `json:"type,omitempty" bson:"type,omitempty"`                   // The defining type of case
-	Status       *CodeableConcept             `json:"status,omitempty" bson:"status,omitempty"`               // The status associated with the case
-	DatePeriod   *Period                      `json:"datePeriod,omitempty" bson:"date_period,omitempty"`      // Relevant date for this case
-	DateDateTime *string                      `json:"dateDateTime,omitempty" bson:"date_date_time,omitempty"` // Relevant date for this case
-	Application  []RegulatedAuthorizationCase `json:"application,omitempty" bson:"application,omitempty"`     // Applications submitted to obtain a regulated authorization. Steps within the longer running case or procedure
+	Id                *string                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        *Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Identifier by which this case can be referenced
+	Type              *CodeableConcept               `json:"type,omitempty" bson:"type,omitempty"`                            // The defining type of case
+	Status            *CodeableConcept               `json:"status,omitempty" bson:"status,omitempty"`                        // The status associated with the case
+	Date              RegulatedAuthorizationCaseDate `json:"-" bson:"date,omitempty"`                                         // Relevant date for this case
+	DateElement       *Element                       `json:"-" bson:"date_element,omitempty"`                                 // Extensions for date[x]
+	Application       []RegulatedAuthorizationCase   `json:"application,omitempty" bson:"application,omitempty"`              // Applications submitted to obtain a regulated authorization. Steps within the longer running case or procedure
+
+	dateVariants []string // JSON properties of RegulatedAuthorization.case.date[x] when more than one was decoded
 }
 
 func (r *RegulatedAuthorizationCase) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
@@ -139,9 +444,17 @@ func (r *RegulatedAuthorizationCase) Validate() error {
 			return fmt.Errorf("Status: %w", err)
 		}
 	}
-	if r.DatePeriod != nil {
-		if err := r.DatePeriod.Validate(); err != nil {
-			return fmt.Errorf("DatePeriod: %w", err)
+	if len(r.dateVariants) > 1 {
+		return fmt.Errorf("field 'Date' must have a single type, got %v", r.dateVariants)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
 		}
 	}
 	for i, item := range r.Application {
@@ -151,3 +464,174 @@ func (r *RegulatedAuthorizationCase) Validate() error {
 	}
 	return nil
 }
+
+func (r *RegulatedAuthorizationCase) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if len(r.dateVariants) > 1 {
+		issues.add("structure", path+".date", fmt.Sprintf("field 'Date' must have a single type, got %v", r.dateVariants))
+	}
+	if r.Date != nil {
+		r.Date.validateAll(path+".date.ofType("+r.Date.FHIRType()+")", issues)
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	for i, item := range r.Application {
+		item.validateAll(fmt.Sprintf("%s.application[%d]", path, i), issues)
+	}
+}
+
+var regulatedAuthorizationCaseInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RegulatedAuthorizationCase) invariants() []invariant {
+	return regulatedAuthorizationCaseInvariants
+}
+
+func (r RegulatedAuthorizationCase) MarshalJSON() ([]byte, error) {
+	type alias RegulatedAuthorizationCase
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "date", r.Date, r.DateElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RegulatedAuthorizationCase) UnmarshalJSON(data []byte) error {
+	type alias RegulatedAuthorizationCase
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Date, element, r.dateVariants, err = unmarshalChoice(fields, "date", regulatedAuthorizationCaseDateVariants); err != nil {
+		return err
+	}
+	r.DateElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DateElement); err != nil {
+			return fmt.Errorf("_date: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r RegulatedAuthorizationCase) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RegulatedAuthorizationCase) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RegulatedAuthorizationCase) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RegulatedAuthorizationCase) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RegulatedAuthorizationCase) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Date":
+		return "date", choiceValues(regulatedAuthorizationCaseDateVariants)
+	}
+	return "", nil
+}
+
+var regulatedAuthorizationCaseElementMetadata = []ElementMetadata{
+	{Path: "RegulatedAuthorization.case.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RegulatedAuthorization.case.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RegulatedAuthorization.case.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RegulatedAuthorization.case.identifier", Name: "identifier", Field: 3, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "RegulatedAuthorization.case.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.case.status", Name: "status", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RegulatedAuthorization.case.date[x]", Name: "date", Field: 6, Min: 0, Max: "1", Types: []string{"Period", "dateTime"}},
+	{Path: "RegulatedAuthorization.case.application", Name: "application", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *RegulatedAuthorizationCase) ElementMetadata() []ElementMetadata {
+	return regulatedAuthorizationCaseElementMetadata
+}
+
+// RegulatedAuthorizationCaseDate is the type of RegulatedAuthorization.case.date[x]. It is implemented by the RegulatedAuthorizationCaseDate* variant types.
+type RegulatedAuthorizationCaseDate interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRegulatedAuthorizationCaseDate()
+}
+
+// RegulatedAuthorizationCaseDatePeriod is the Period variant of RegulatedAuthorizationCaseDate.
+type RegulatedAuthorizationCaseDatePeriod struct {
+	Period
+}
+
+func (v RegulatedAuthorizationCaseDatePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v RegulatedAuthorizationCaseDatePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v RegulatedAuthorizationCaseDatePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v RegulatedAuthorizationCaseDatePeriod) isRegulatedAuthorizationCaseDate() {}
+
+// RegulatedAuthorizationCaseDateDateTime is the dateTime variant of RegulatedAuthorizationCaseDate.
+type RegulatedAuthorizationCaseDateDateTime struct {
+	DateTime
+}
+
+func (v RegulatedAuthorizationCaseDateDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v RegulatedAuthorizationCaseDateDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v RegulatedAuthorizationCaseDateDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v RegulatedAuthorizationCaseDateDateTime) isRegulatedAuthorizationCaseDate() {}
+
+var regulatedAuthorizationCaseDateVariants = []RegulatedAuthorizationCaseDate{
+	RegulatedAuthorizationCaseDatePeriod{},
+	RegulatedAuthorizationCaseDateDateTime{},
+}
@@ -1,49 +1,326 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // RelatedArtifact Type: Related artifacts such as dependencies, components, additional documentation, justification, or bibliographic references.
 type RelatedArtifact struct {
-	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
-	Type               string      `json:"type" bson:"type"`                                                  // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of
-	Label              *string     `json:"label,omitempty" bson:"label,omitempty"`                            // Short label
-	Display            *string     `json:"display,omitempty" bson:"display,omitempty"`                        // Brief description of the related artifact
-	Citation           *string     `json:"citation,omitempty" bson:"citation,omitempty"`                      // Bibliographic citation for the artifact
-	Document           *Attachment `json:"document,omitempty" bson:"document,omitempty"`                      // What document is being referenced
-	Resource           *string     `json:"resource,omitempty" bson:"resource,omitempty"`                      // What artifact is being referenced
-	ResourceReference  *Reference  `json:"resourceReference,omitempty" bson:"resource_reference,omitempty"`   // What artifact, if not a conformance resource
-	ArtifactMarkdown   *string     `json:"artifactMarkdown,omitempty" bson:"artifact_markdown,omitempty"`     // What document, citation, artifact, or resource is being referenced
-	ArtifactAttachment *Attachment `json:"artifactAttachment,omitempty" bson:"artifact_attachment,omitempty"` // What document, citation, artifact, or resource is being referenced
-	ArtifactCanonical  *string     `json:"artifactCanonical,omitempty" bson:"artifact_canonical,omitempty"`   // What document, citation, artifact, or resource is being referenced
-	ArtifactReference  *Reference  `json:"artifactReference,omitempty" bson:"artifact_reference,omitempty"`   // What document, citation, artifact, or resource is being referenced
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	Type              RelatedArtifactType     `json:"type" bson:"type"`                                                // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of
+	TypeElement       *Element                `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Label             *string                 `json:"label,omitempty" bson:"label,omitempty"`                          // Short label
+	LabelElement      *Element                `json:"_label,omitempty" bson:"label_element,omitempty"`                 // Extensions for label
+	Display           *string                 `json:"display,omitempty" bson:"display,omitempty"`                      // Brief description of the related artifact
+	DisplayElement    *Element                `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	Citation          *string                 `json:"citation,omitempty" bson:"citation,omitempty"`                    // Bibliographic citation for the artifact
+	CitationElement   *Element                `json:"_citation,omitempty" bson:"citation_element,omitempty"`           // Extensions for citation
+	Document          *Attachment             `json:"document,omitempty" bson:"document,omitempty"`                    // What document is being referenced
+	Resource          *string                 `json:"resource,omitempty" bson:"resource,omitempty"`                    // What artifact is being referenced
+	ResourceElement   *Element                `json:"_resource,omitempty" bson:"resource_element,omitempty"`           // Extensions for resource
+	ResourceReference *Reference              `json:"resourceReference,omitempty" bson:"resource_reference,omitempty"` // What artifact, if not a conformance resource
+	Artifact          RelatedArtifactArtifact `json:"-" bson:"artifact,omitempty"`                                     // What document, citation, artifact, or resource is being referenced
+	ArtifactElement   *Element                `json:"-" bson:"artifact_element,omitempty"`                             // Extensions for artifact[x]
+
+	artifactVariants []string // JSON properties of RelatedArtifact.artifact[x] when more than one was decoded
 }
 
 func (r *RelatedArtifact) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.LabelElement != nil {
+		if err := r.LabelElement.Validate(); err != nil {
+			return fmt.Errorf("LabelElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	if r.CitationElement != nil {
+		if err := r.CitationElement.Validate(); err != nil {
+			return fmt.Errorf("CitationElement: %w", err)
+		}
+	}
 	if r.Document != nil {
 		if err := r.Document.Validate(); err != nil {
 			return fmt.Errorf("Document: %w", err)
 		}
 	}
+	if r.ResourceElement != nil {
+		if err := r.ResourceElement.Validate(); err != nil {
+			return fmt.Errorf("ResourceElement: %w", err)
+		}
+	}
 	if r.ResourceReference != nil {
 		if err := r.ResourceReference.Validate(); err != nil {
 			return fmt.Errorf("ResourceReference: %w", err)
 		}
 	}
-	if r.ArtifactAttachment != nil {
-		if err := r.ArtifactAttachment.Validate(); err != nil {
-			return fmt.Errorf("ArtifactAttachment: %w", err)
+	if len(r.artifactVariants) > 1 {
+		return fmt.Errorf("field 'Artifact' must have a single type, got %v", r.artifactVariants)
+	}
+	if r.Artifact != nil {
+		if err := r.Artifact.Validate(); err != nil {
+			return fmt.Errorf("Artifact: %w", err)
+		}
+	}
+	if r.ArtifactElement != nil {
+		if err := r.ArtifactElement.Validate(); err != nil {
+			return fmt.Errorf("ArtifactElement: %w", err)
 		}
 	}
-	if r.ArtifactReference != nil {
-		if err := r.ArtifactReference.Validate(); err != nil {
-			return fmt.Errorf("ArtifactReference: %w", err)
+	return nil
+}
+
+func (r *RelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.LabelElement != nil {
+		r.LabelElement.validateAll(path+".label", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	if r.CitationElement != nil {
+		r.CitationElement.validateAll(path+".citation", issues)
+	}
+	if r.Document != nil {
+		r.Document.validateAll(path+".document", issues)
+	}
+	if r.ResourceElement != nil {
+		r.ResourceElement.validateAll(path+".resource", issues)
+	}
+	if r.ResourceReference != nil {
+		r.ResourceReference.validateAll(path+".resourceReference", issues)
+	}
+	if len(r.artifactVariants) > 1 {
+		issues.add("structure", path+".artifact", fmt.Sprintf("field 'Artifact' must have a single type, got %v", r.artifactVariants))
+	}
+	if r.Artifact != nil {
+		r.Artifact.validateAll(path+".artifact.ofType("+r.Artifact.FHIRType()+")", issues)
+	}
+	if r.ArtifactElement != nil {
+		r.ArtifactElement.validateAll(path+".artifact", issues)
+	}
+}
+
+func (r *RelatedArtifact) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RelatedArtifact", &issues)
+	checkInvariants(r, "RelatedArtifact", &issues)
+	return issues
+}
+
+var relatedArtifactInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "rla-1", severity: "warning", human: "Citation must be inline or a canonical reference to a Citation", expression: "type = 'citation' implies ((citation.exists() xor resource.exists()) or (artifact is markdown or artifact is canonical))"},
+	{key: "rla-2", severity: "warning", human: "Documentation must be an attachment", expression: "type = 'documentation' implies (document.exists() or artifact is Attachment)"},
+	{key: "rla-3", severity: "warning", human: "Justification must be an attachment or a reference to an ArtifactAssessment", expression: "type = 'justification' implies ((document.exists() or resourceReference.exists()) or (artifact is Attachment or artifact is Reference))"},
+}
+
+func (r *RelatedArtifact) invariants() []invariant {
+	return relatedArtifactInvariants
+}
+
+func (r RelatedArtifact) MarshalJSON() ([]byte, error) {
+	type alias RelatedArtifact
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "artifact", r.Artifact, r.ArtifactElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RelatedArtifact) UnmarshalJSON(data []byte) error {
+	type alias RelatedArtifact
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Artifact, element, r.artifactVariants, err = unmarshalChoice(fields, "artifact", relatedArtifactArtifactVariants); err != nil {
+		return err
+	}
+	r.ArtifactElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ArtifactElement); err != nil {
+			return fmt.Errorf("_artifact: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r RelatedArtifact) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RelatedArtifact) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RelatedArtifact) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RelatedArtifact) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RelatedArtifact) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Artifact":
+		return "artifact", choiceValues(relatedArtifactArtifactVariants)
+	}
+	return "", nil
+}
+
+var relatedArtifactElementTypes = map[string]string{
+	"citation": "markdown",
+	"resource": "canonical",
+}
+
+func (r *RelatedArtifact) elementTypes() map[string]string {
+	return relatedArtifactElementTypes
+}
+
+var relatedArtifactElementMetadata = []ElementMetadata{
+	{Path: "RelatedArtifact.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RelatedArtifact.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RelatedArtifact.type", Name: "type", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RelatedArtifact.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/related-artifact-type", Description: "The type of relationship to the related artifact."}, Summary: true},
+	{Path: "RelatedArtifact.label", Name: "label", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "RelatedArtifact.display", Name: "display", Field: 6, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "RelatedArtifact.citation", Name: "citation", Field: 8, Min: 0, Max: "1", Types: []string{"markdown"}, Summary: true},
+	{Path: "RelatedArtifact.document", Name: "document", Field: 10, Min: 0, Max: "1", Types: []string{"Attachment"}, Summary: true},
+	{Path: "RelatedArtifact.resource", Name: "resource", Field: 11, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Summary: true},
+	{Path: "RelatedArtifact.resourceReference", Name: "resourceReference", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Summary: true},
+	{Path: "RelatedArtifact.artifact[x]", Name: "artifact", Field: 14, Min: 0, Max: "1", Types: []string{"markdown", "Attachment", "canonical", "Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource", "http://hl7.org/fhir/StructureDefinition/Resource"}, Summary: true},
+}
+
+func (r *RelatedArtifact) ElementMetadata() []ElementMetadata {
+	return relatedArtifactElementMetadata
+}
+
+// RelatedArtifactArtifact is the type of RelatedArtifact.artifact[x]. It is implemented by the RelatedArtifactArtifact* variant types.
+type RelatedArtifactArtifact interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRelatedArtifactArtifact()
+}
+
+// RelatedArtifactArtifactMarkdown is the markdown variant of RelatedArtifactArtifact.
+type RelatedArtifactArtifactMarkdown string
+
+func (v RelatedArtifactArtifactMarkdown) Validate() error {
+	return nil
+}
+
+func (v RelatedArtifactArtifactMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v RelatedArtifactArtifactMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v RelatedArtifactArtifactMarkdown) isRelatedArtifactArtifact() {}
+
+// RelatedArtifactArtifactAttachment is the Attachment variant of RelatedArtifactArtifact.
+type RelatedArtifactArtifactAttachment struct {
+	Attachment
+}
+
+func (v RelatedArtifactArtifactAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v RelatedArtifactArtifactAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v RelatedArtifactArtifactAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v RelatedArtifactArtifactAttachment) isRelatedArtifactArtifact() {}
+
+// RelatedArtifactArtifactCanonical is the canonical variant of RelatedArtifactArtifact.
+type RelatedArtifactArtifactCanonical string
+
+func (v RelatedArtifactArtifactCanonical) Validate() error {
+	return nil
+}
+
+func (v RelatedArtifactArtifactCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v RelatedArtifactArtifactCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v RelatedArtifactArtifactCanonical) isRelatedArtifactArtifact() {}
+
+// RelatedArtifactArtifactReference is the Reference variant of RelatedArtifactArtifact.
+type RelatedArtifactArtifactReference struct {
+	Reference
+}
+
+func (v RelatedArtifactArtifactReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v RelatedArtifactArtifactReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v RelatedArtifactArtifactReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v RelatedArtifactArtifactReference) isRelatedArtifactArtifact() {}
+
+var relatedArtifactArtifactVariants = []RelatedArtifactArtifact{
+	RelatedArtifactArtifactMarkdown(""),
+	RelatedArtifactArtifactAttachment{},
+	RelatedArtifactArtifactCanonical(""),
+	RelatedArtifactArtifactReference{},
+}
@@ -2,33 +2,43 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Information about a person that is involved in a patient's health or the care for a patient, but who is not the primary target of healthcare.
 type RelatedPerson struct {
-	ResourceType  string                       `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string                      `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string                      `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage            `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`        // A human identifier for this person
-	Active        *bool                        `json:"active,omitempty" bson:"active,omitempty"`                // Whether this related person's record is in active use
-	Patient       *Reference                   `json:"patient" bson:"patient"`                                  // The patient this person is related to
-	Relationship  []CodeableConcept            `json:"relationship,omitempty" bson:"relationship,omitempty"`    // The personal relationship of the related person to the patient
-	Role          []CodeableConcept            `json:"role,omitempty" bson:"role,omitempty"`                    // The functional role of the related person to the patient
-	Name          []HumanName                  `json:"name,omitempty" bson:"name,omitempty"`                    // A name associated with the person
-	Telecom       []ContactPoint               `json:"telecom,omitempty" bson:"telecom,omitempty"`              // A contact detail for the person
-	Gender        *string                      `json:"gender,omitempty" bson:"gender,omitempty"`                // male | female | other | unknown
-	BirthDate     *string                      `json:"birthDate,omitempty" bson:"birth_date,omitempty"`         // The date on which the related person was born
-	Address       []Address                    `json:"address,omitempty" bson:"address,omitempty"`              // Address where the related person can be contacted or visited
-	Photo         []Attachment                 `json:"photo,omitempty" bson:"photo,omitempty"`                  // Image of the person
-	Period        *Period                      `json:"period,omitempty" bson:"period,omitempty"`                // Period of time that this relationship is considered valid
-	Communication []RelatedPersonCommunication `json:"communication,omitempty" bson:"communication,omitempty"`  // A language which may be used to communicate with the related person about the patient's health
+	ResourceType         string                       `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                      `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                      `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // A human identifier for this person
+	Active               *bool                        `json:"active,omitempty" bson:"active,omitempty"`                         // Whether this related person's record is in active use
+	ActiveElement        *Element                     `json:"_active,omitempty" bson:"active_element,omitempty"`                // Extensions for active
+	Patient              *Reference                   `json:"patient" bson:"patient"`                                           // The patient this person is related to
+	Relationship         []CodeableConcept            `json:"relationship,omitempty" bson:"relationship,omitempty"`             // The personal relationship of the related person to the patient
+	Role                 []CodeableConcept            `json:"role,omitempty" bson:"role,omitempty"`                             // The functional role of the related person to the patient
+	Name                 []HumanName                  `json:"name,omitempty" bson:"name,omitempty"`                             // A name associated with the person
+	Telecom              []ContactPoint               `json:"telecom,omitempty" bson:"telecom,omitempty"`                       // A contact detail for the person
+	Gender               *AdministrativeGender        `json:"gender,omitempty" bson:"gender,omitempty"`                         // male | female | other | unknown
+	GenderElement        *Element                     `json:"_gender,omitempty" bson:"gender_element,omitempty"`                // Extensions for gender
+	BirthDate            *Date                        `json:"birthDate,omitempty" bson:"birth_date,omitempty"`                  // The date on which the related person was born
+	BirthDateElement     *Element                     `json:"_birthDate,omitempty" bson:"birth_date_element,omitempty"`         // Extensions for birthDate
+	Address              []Address                    `json:"address,omitempty" bson:"address,omitempty"`                       // Address where the related person can be contacted or visited
+	Photo                []Attachment                 `json:"photo,omitempty" bson:"photo,omitempty"`                           // Image of the person
+	Period               *Period                      `json:"period,omitempty" bson:"period,omitempty"`                         // Period of time that this relationship is considered valid
+	Communication        []RelatedPersonCommunication `json:"communication,omitempty" bson:"communication,omitempty"`           // A language which may be used to communicate with the related person about the patient's health
 }
 
+var relatedPersonPatientTargets = []string{"Patient"}
+
 func (r *RelatedPerson) Validate() error {
 	if r.ResourceType != "RelatedPerson" {
 		return fmt.Errorf("invalid resourceType: expected 'RelatedPerson', got '%s'", r.ResourceType)
@@ -38,19 +48,57 @@ func (r *RelatedPerson) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, relatedPersonPatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, relatedPersonPatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
@@ -76,6 +124,24 @@ func (r *RelatedPerson) Validate() error {
 			return fmt.Errorf("Telecom[%d]: %w", i, err)
 		}
 	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		return fmt.Errorf("field 'Gender' has invalid code '%s'", *r.Gender)
+	}
+	if r.GenderElement != nil {
+		if err := r.GenderElement.Validate(); err != nil {
+			return fmt.Errorf("GenderElement: %w", err)
+		}
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			return fmt.Errorf("BirthDate: %w", err)
+		}
+	}
+	if r.BirthDateElement != nil {
+		if err := r.BirthDateElement.Validate(); err != nil {
+			return fmt.Errorf("BirthDateElement: %w", err)
+		}
+	}
 	for i, item := range r.Address {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Address[%d]: %w", i, err)
@@ -96,16 +162,248 @@ func (r *RelatedPerson) Validate() error {
 			return fmt.Errorf("Communication[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "RelatedPerson")
+}
+
+func (r *RelatedPerson) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "RelatedPerson" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'RelatedPerson', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, relatedPersonPatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, relatedPersonPatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	for i, item := range r.Relationship {
+		item.validateAll(fmt.Sprintf("%s.relationship[%d]", path, i), issues)
+	}
+	for i, item := range r.Role {
+		item.validateAll(fmt.Sprintf("%s.role[%d]", path, i), issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.Telecom {
+		item.validateAll(fmt.Sprintf("%s.telecom[%d]", path, i), issues)
+	}
+	if r.Gender != nil && !r.Gender.IsValid() {
+		issues.add("code-invalid", path+".gender", fmt.Sprintf("field 'Gender' has invalid code '%s'", *r.Gender))
+	}
+	if r.GenderElement != nil {
+		r.GenderElement.validateAll(path+".gender", issues)
+	}
+	if r.BirthDate != nil {
+		if err := r.BirthDate.Validate(); err != nil {
+			issues.add("value", path+".birthDate", err.Error())
+		}
+	}
+	if r.BirthDateElement != nil {
+		r.BirthDateElement.validateAll(path+".birthDate", issues)
+	}
+	for i, item := range r.Address {
+		item.validateAll(fmt.Sprintf("%s.address[%d]", path, i), issues)
+	}
+	for i, item := range r.Photo {
+		item.validateAll(fmt.Sprintf("%s.photo[%d]", path, i), issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Communication {
+		item.validateAll(fmt.Sprintf("%s.communication[%d]", path, i), issues)
+	}
+}
+
+func (r *RelatedPerson) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RelatedPerson", &issues)
+	checkInvariants(r, "RelatedPerson", &issues)
+	return issues
+}
+
+var relatedPersonInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *RelatedPerson) invariants() []invariant {
+	return relatedPersonInvariants
+}
+
+func (r *RelatedPerson) UnmarshalJSON(data []byte) error {
+	type alias RelatedPerson
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r RelatedPerson) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RelatedPerson) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RelatedPerson) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RelatedPerson) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var relatedPersonElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *RelatedPerson) elementTypes() map[string]string {
+	return relatedPersonElementTypes
+}
+
+var relatedPersonElementMetadata = []ElementMetadata{
+	{Path: "RelatedPerson.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "RelatedPerson.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "RelatedPerson.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "RelatedPerson.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RelatedPerson.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "RelatedPerson.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "RelatedPerson.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "RelatedPerson.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RelatedPerson.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RelatedPerson.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "RelatedPerson.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "RelatedPerson.patient", Name: "patient", Field: 14, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "RelatedPerson.relationship", Name: "relationship", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "RelatedPerson.role", Name: "role", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "RelatedPerson.name", Name: "name", Field: 17, Min: 0, Max: "*", Types: []string{"HumanName"}},
+	{Path: "RelatedPerson.telecom", Name: "telecom", Field: 18, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "RelatedPerson.gender", Name: "gender", Field: 19, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RelatedPerson.gender", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/administrative-gender"}},
+	{Path: "RelatedPerson.birthDate", Name: "birthDate", Field: 21, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "RelatedPerson.address", Name: "address", Field: 23, Min: 0, Max: "*", Types: []string{"Address"}},
+	{Path: "RelatedPerson.photo", Name: "photo", Field: 24, Min: 0, Max: "*", Types: []string{"Attachment"}},
+	{Path: "RelatedPerson.period", Name: "period", Field: 25, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "RelatedPerson.communication", Name: "communication", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *RelatedPerson) ElementMetadata() []ElementMetadata {
+	return relatedPersonElementMetadata
+}
+
+func (r *RelatedPerson) GetResourceType() string {
+	return "RelatedPerson"
+}
+
+func (r *RelatedPerson) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *RelatedPerson) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *RelatedPerson) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *RelatedPerson) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *RelatedPerson) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *RelatedPerson) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *RelatedPerson) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*RelatedPerson)(nil)
+
 type RelatedPersonCommunication struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Language  *CodeableConcept `json:"language" bson:"language"`                       // The language which can be used to communicate with the related person about the patient's health
-	Preferred *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"` // Language preference indicator
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Language          *CodeableConcept `json:"language" bson:"language"`                                        // The language which can be used to communicate with the related person about the patient's health
+	Preferred         *bool            `json:"preferred,omitempty" bson:"preferred,omitempty"`                  // Language preference indicator
+	PreferredElement  *Element         `json:"_preferred,omitempty" bson:"preferred_element,omitempty"`         // Extensions for preferred
 }
 
 func (r *RelatedPersonCommunication) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Language == nil {
 		return fmt.Errorf("field 'Language' is required")
 	}
@@ -114,5 +412,64 @@ func (r *RelatedPersonCommunication) Validate() error {
 			return fmt.Errorf("Language: %w", err)
 		}
 	}
+	if r.PreferredElement != nil {
+		if err := r.PreferredElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *RelatedPersonCommunication) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Language == nil {
+		issues.add("required", path+".language", "field 'Language' is required")
+	}
+	if r.Language != nil {
+		r.Language.validateAll(path+".language", issues)
+	}
+	if r.PreferredElement != nil {
+		r.PreferredElement.validateAll(path+".preferred", issues)
+	}
+}
+
+var relatedPersonCommunicationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RelatedPersonCommunication) invariants() []invariant {
+	return relatedPersonCommunicationInvariants
+}
+
+func (r RelatedPersonCommunication) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RelatedPersonCommunication) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RelatedPersonCommunication) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RelatedPersonCommunication) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var relatedPersonCommunicationElementMetadata = []ElementMetadata{
+	{Path: "RelatedPerson.communication.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RelatedPerson.communication.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RelatedPerson.communication.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RelatedPerson.communication.language", Name: "language", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RelatedPerson.communication.preferred", Name: "preferred", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *RelatedPersonCommunication) ElementMetadata() []ElementMetadata {
+	return relatedPersonCommunicationElementMetadata
+}
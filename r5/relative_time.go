@@ -1,41 +1,252 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // RelativeTime Type: RelativeTime expresses a time or time period as relative to the time of an event defined in data types other than dateTime.
 type RelativeTime struct {
-	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	ContextReference  *Reference       `json:"contextReference,omitempty" bson:"context_reference,omitempty"`   // The specific event occurrence or resource context used as a base point (reference point) in time
-	ContextDefinition *string          `json:"contextDefinition,omitempty" bson:"context_definition,omitempty"` // The type of event used as a base point
-	ContextPath       *string          `json:"contextPath,omitempty" bson:"context_path,omitempty"`             // Path to the element defining the basis for the relative time
-	ContextCode       *CodeableConcept `json:"contextCode,omitempty" bson:"context_code,omitempty"`             // Coded representation of the event used as a base point (reference point) in time
-	OffsetDuration    *Duration        `json:"offsetDuration,omitempty" bson:"offset_duration,omitempty"`       // An offset or offset range before (negative values) or after (positive values) the event
-	OffsetRange       *Range           `json:"offsetRange,omitempty" bson:"offset_range,omitempty"`             // An offset or offset range before (negative values) or after (positive values) the event
-	Text              *string          `json:"text,omitempty" bson:"text,omitempty"`                            // Free-text description
+	Id                       *string            `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension                []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	ModifierExtension        []Extension        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`          // Extensions that cannot be ignored even if unrecognized
+	ContextReference         *Reference         `json:"contextReference,omitempty" bson:"context_reference,omitempty"`            // The specific event occurrence or resource context used as a base point (reference point) in time
+	ContextDefinition        *string            `json:"contextDefinition,omitempty" bson:"context_definition,omitempty"`          // The type of event used as a base point
+	ContextDefinitionElement *Element           `json:"_contextDefinition,omitempty" bson:"context_definition_element,omitempty"` // Extensions for contextDefinition
+	ContextPath              *string            `json:"contextPath,omitempty" bson:"context_path,omitempty"`                      // Path to the element defining the basis for the relative time
+	ContextPathElement       *Element           `json:"_contextPath,omitempty" bson:"context_path_element,omitempty"`             // Extensions for contextPath
+	ContextCode              *CodeableConcept   `json:"contextCode,omitempty" bson:"context_code,omitempty"`                      // Coded representation of the event used as a base point (reference point) in time
+	Offset                   RelativeTimeOffset `json:"-" bson:"offset,omitempty"`                                                // An offset or offset range before (negative values) or after (positive values) the event
+	Text                     *string            `json:"text,omitempty" bson:"text,omitempty"`                                     // Free-text description
+	TextElement              *Element           `json:"_text,omitempty" bson:"text_element,omitempty"`                            // Extensions for text
+
+	offsetVariants []string // JSON properties of RelativeTime.offset[x] when more than one was decoded
 }
 
 func (r *RelativeTime) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.ContextReference != nil {
 		if err := r.ContextReference.Validate(); err != nil {
 			return fmt.Errorf("ContextReference: %w", err)
 		}
 	}
+	if r.ContextDefinitionElement != nil {
+		if err := r.ContextDefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("ContextDefinitionElement: %w", err)
+		}
+	}
+	if r.ContextPathElement != nil {
+		if err := r.ContextPathElement.Validate(); err != nil {
+			return fmt.Errorf("ContextPathElement: %w", err)
+		}
+	}
 	if r.ContextCode != nil {
 		if err := r.ContextCode.Validate(); err != nil {
 			return fmt.Errorf("ContextCode: %w", err)
 		}
 	}
-	if r.OffsetDuration != nil {
-		if err := r.OffsetDuration.Validate(); err != nil {
-			return fmt.Errorf("OffsetDuration: %w", err)
+	if len(r.offsetVariants) > 1 {
+		return fmt.Errorf("field 'Offset' must have a single type, got %v", r.offsetVariants)
+	}
+	if r.Offset != nil {
+		if err := r.Offset.Validate(); err != nil {
+			return fmt.Errorf("Offset: %w", err)
 		}
 	}
-	if r.OffsetRange != nil {
-		if err := r.OffsetRange.Validate(); err != nil {
-			return fmt.Errorf("OffsetRange: %w", err)
+	if r.TextElement != nil {
+		if err := r.TextElement.Validate(); err != nil {
+			return fmt.Errorf("TextElement: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r *RelativeTime) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ContextReference != nil {
+		r.ContextReference.validateAll(path+".contextReference", issues)
+	}
+	if r.ContextDefinitionElement != nil {
+		r.ContextDefinitionElement.validateAll(path+".contextDefinition", issues)
+	}
+	if r.ContextPathElement != nil {
+		r.ContextPathElement.validateAll(path+".contextPath", issues)
+	}
+	if r.ContextCode != nil {
+		r.ContextCode.validateAll(path+".contextCode", issues)
+	}
+	if len(r.offsetVariants) > 1 {
+		issues.add("structure", path+".offset", fmt.Sprintf("field 'Offset' must have a single type, got %v", r.offsetVariants))
+	}
+	if r.Offset != nil {
+		r.Offset.validateAll(path+".offset.ofType("+r.Offset.FHIRType()+")", issues)
+	}
+	if r.TextElement != nil {
+		r.TextElement.validateAll(path+".text", issues)
+	}
+}
+
+func (r *RelativeTime) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RelativeTime", &issues)
+	checkInvariants(r, "RelativeTime", &issues)
+	return issues
+}
+
+var relativeTimeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "rlt-1", severity: "error", human: "A RelativeTime can have either a contextReference or contextDefinition but not both", expression: "contextReference.empty() or contextDefinition.empty()"},
+	{key: "rlt-2", severity: "error", human: "If RelativeTime has a contextPath, it SHALL have either a contextReference or a contextDefinition", expression: "contextPath.empty() or contextReference.exists() or contextDefinition.exists()"},
+}
+
+func (r *RelativeTime) invariants() []invariant {
+	return relativeTimeInvariants
+}
+
+func (r RelativeTime) MarshalJSON() ([]byte, error) {
+	type alias RelativeTime
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "offset", r.Offset, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RelativeTime) UnmarshalJSON(data []byte) error {
+	type alias RelativeTime
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Offset, _, r.offsetVariants, err = unmarshalChoice(fields, "offset", relativeTimeOffsetVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r RelativeTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RelativeTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RelativeTime) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RelativeTime) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RelativeTime) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Offset":
+		return "offset", choiceValues(relativeTimeOffsetVariants)
+	}
+	return "", nil
+}
+
+var relativeTimeElementTypes = map[string]string{
+	"contextDefinition": "canonical",
+}
+
+func (r *RelativeTime) elementTypes() map[string]string {
+	return relativeTimeElementTypes
+}
+
+var relativeTimeElementMetadata = []ElementMetadata{
+	{Path: "RelativeTime.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RelativeTime.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RelativeTime.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RelativeTime.contextReference", Name: "contextReference", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Resource"}, Summary: true},
+	{Path: "RelativeTime.contextDefinition", Name: "contextDefinition", Field: 4, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition"}, Summary: true},
+	{Path: "RelativeTime.contextPath", Name: "contextPath", Field: 6, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "RelativeTime.contextCode", Name: "contextCode", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "RelativeTime.contextCode", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/relativetime-codes", Description: "The event used as a base point (reference point) in time."}, Summary: true},
+	{Path: "RelativeTime.offset[x]", Name: "offset", Field: 9, Min: 0, Max: "1", Types: []string{"Duration", "Range"}, Summary: true},
+	{Path: "RelativeTime.text", Name: "text", Field: 10, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *RelativeTime) ElementMetadata() []ElementMetadata {
+	return relativeTimeElementMetadata
+}
+
+// RelativeTimeOffset is the type of RelativeTime.offset[x]. It is implemented by the RelativeTimeOffset* variant types.
+type RelativeTimeOffset interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRelativeTimeOffset()
+}
+
+// RelativeTimeOffsetDuration is the Duration variant of RelativeTimeOffset.
+type RelativeTimeOffsetDuration struct {
+	Duration
+}
+
+func (v RelativeTimeOffsetDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v RelativeTimeOffsetDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v RelativeTimeOffsetDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v RelativeTimeOffsetDuration) isRelativeTimeOffset() {}
+
+// RelativeTimeOffsetRange is the Range variant of RelativeTimeOffset.
+type RelativeTimeOffsetRange struct {
+	Range
+}
+
+func (v RelativeTimeOffsetRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v RelativeTimeOffsetRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v RelativeTimeOffsetRange) FHIRType() string {
+	return "Range"
+}
+
+func (v RelativeTimeOffsetRange) isRelativeTimeOffset() {}
+
+var relativeTimeOffsetVariants = []RelativeTimeOffset{
+	RelativeTimeOffsetDuration{},
+	RelativeTimeOffsetRange{},
+}
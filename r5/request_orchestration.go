@@ -2,38 +2,55 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A set of related requests that can be used to capture intended activities that have inter-dependencies such as "give this medication after that one".
 type RequestOrchestration struct {
-	ResourceType          string                       `json:"resourceType" bson:"resource_type"`                                       // Type of resource
-	Id                    *string                      `json:"id,omitempty" bson:"id,omitempty"`                                        // Logical id of this artifact
-	Meta                  *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                    // Metadata about the resource
-	ImplicitRules         *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                 // A set of rules under which this content was created
-	Language              *string                      `json:"language,omitempty" bson:"language,omitempty"`                            // Language of the resource content
-	Text                  *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                    // Text summary of the resource, for human interpretation
-	Contained             []json.RawMessage            `json:"contained,omitempty" bson:"contained,omitempty"`                          // Contained, inline Resources
-	Identifier            []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                        // Business identifier
-	InstantiatesCanonical []string                     `json:"instantiatesCanonical,omitempty" bson:"instantiates_canonical,omitempty"` // Instantiates FHIR protocol or definition
-	InstantiatesUri       []string                     `json:"instantiatesUri,omitempty" bson:"instantiates_uri,omitempty"`             // Instantiates external protocol or definition
-	BasedOn               []Reference                  `json:"basedOn,omitempty" bson:"based_on,omitempty"`                             // Fulfills plan, proposal, or order
-	Replaces              []Reference                  `json:"replaces,omitempty" bson:"replaces,omitempty"`                            // Request(s) replaced by this request
-	GroupIdentifier       *Identifier                  `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`             // Composite request this is part of
-	Status                string                       `json:"status" bson:"status"`                                                    // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
-	Intent                string                       `json:"intent" bson:"intent"`                                                    // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Priority              *string                      `json:"priority,omitempty" bson:"priority,omitempty"`                            // routine | urgent | asap | stat
-	Code                  *CodeableConcept             `json:"code,omitempty" bson:"code,omitempty"`                                    // What's being requested/ordered
-	Subject               *Reference                   `json:"subject,omitempty" bson:"subject,omitempty"`                              // Who the request orchestration is about
-	Encounter             *Reference                   `json:"encounter,omitempty" bson:"encounter,omitempty"`                          // Created as part of
-	AuthoredOn            *string                      `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                       // When the request orchestration was authored
-	Author                *Reference                   `json:"author,omitempty" bson:"author,omitempty"`                                // Device or practitioner that authored the request orchestration
-	Reason                []CodeableReference          `json:"reason,omitempty" bson:"reason,omitempty"`                                // Why the request orchestration is needed
-	Goal                  []Reference                  `json:"goal,omitempty" bson:"goal,omitempty"`                                    // What goals
-	Note                  []Annotation                 `json:"note,omitempty" bson:"note,omitempty"`                                    // Additional notes about the response
-	Action                []RequestOrchestrationAction `json:"action,omitempty" bson:"action,omitempty"`                                // Proposed actions, if any
+	ResourceType                 string                       `json:"resourceType" bson:"resource_type"`                                                // Type of resource
+	Id                           *string                      `json:"id,omitempty" bson:"id,omitempty"`                                                 // Logical id of this artifact
+	Meta                         *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                                             // Metadata about the resource
+	ImplicitRules                *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                          // A set of rules under which this content was created
+	ImplicitRulesElement         *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                 // Extensions for implicitRules
+	Language                     *string                      `json:"language,omitempty" bson:"language,omitempty"`                                     // Language of the resource content
+	LanguageElement              *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`                            // Extensions for language
+	Text                         *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                                             // Text summary of the resource, for human interpretation
+	Contained                    []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                                   // Contained, inline Resources
+	Extension                    []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                                   // Additional content defined by implementations
+	ModifierExtension            []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                  // Extensions that cannot be ignored
+	Identifier                   []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                                 // Business identifier
+	InstantiatesCanonical        []string                     `json:"instantiatesCanonical,omitempty" bson:"instantiates_canonical,omitempty"`          // Instantiates FHIR protocol or definition
+	InstantiatesCanonicalElement []*Element                   `json:"_instantiatesCanonical,omitempty" bson:"instantiates_canonical_element,omitempty"` // Extensions for instantiatesCanonical
+	InstantiatesUri              []string                     `json:"instantiatesUri,omitempty" bson:"instantiates_uri,omitempty"`                      // Instantiates external protocol or definition
+	InstantiatesUriElement       []*Element                   `json:"_instantiatesUri,omitempty" bson:"instantiates_uri_element,omitempty"`             // Extensions for instantiatesUri
+	BasedOn                      []Reference                  `json:"basedOn,omitempty" bson:"based_on,omitempty"`                                      // Fulfills plan, proposal, or order
+	Replaces                     []Reference                  `json:"replaces,omitempty" bson:"replaces,omitempty"`                                     // Request(s) replaced by this request
+	GroupIdentifier              *Identifier                  `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`                      // Composite request this is part of
+	Status                       RequestStatus                `json:"status" bson:"status"`                                                             // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
+	StatusElement                *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                                // Extensions for status
+	Intent                       RequestIntent                `json:"intent" bson:"intent"`                                                             // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement                *Element                     `json:"_intent,omitempty" bson:"intent_element,omitempty"`                                // Extensions for intent
+	Priority                     *RequestPriority             `json:"priority,omitempty" bson:"priority,omitempty"`                                     // routine | urgent | asap | stat
+	PriorityElement              *Element                     `json:"_priority,omitempty" bson:"priority_element,omitempty"`                            // Extensions for priority
+	Code                         *CodeableConcept             `json:"code,omitempty" bson:"code,omitempty"`                                             // What's being requested/ordered
+	Subject                      *Reference                   `json:"subject,omitempty" bson:"subject,omitempty"`                                       // Who the request orchestration is about
+	Encounter                    *Reference                   `json:"encounter,omitempty" bson:"encounter,omitempty"`                                   // Created as part of
+	AuthoredOn                   *DateTime                    `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                                // When the request orchestration was authored
+	AuthoredOnElement            *Element                     `json:"_authoredOn,omitempty" bson:"authored_on_element,omitempty"`                       // Extensions for authoredOn
+	Author                       *Reference                   `json:"author,omitempty" bson:"author,omitempty"`                                         // Device or practitioner that authored the request orchestration
+	Reason                       []CodeableReference          `json:"reason,omitempty" bson:"reason,omitempty"`                                         // Why the request orchestration is needed
+	Goal                         []Reference                  `json:"goal,omitempty" bson:"goal,omitempty"`                                             // What goals
+	Note                         []Annotation                 `json:"note,omitempty" bson:"note,omitempty"`                                             // Additional notes about the response
+	Action                       []RequestOrchestrationAction `json:"action,omitempty" bson:"action,omitempty"`                                         // Proposed actions, if any
 }
 
+var requestOrchestrationSubjectTargets = []string{"CareTeam", "Device", "Group", "HealthcareService", "Location", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var requestOrchestrationEncounterTargets = []string{"Encounter"}
+
+var requestOrchestrationAuthorTargets = []string{"Device", "Practitioner", "PractitionerRole"}
+
 func (r *RequestOrchestration) Validate() error {
 	if r.ResourceType != "RequestOrchestration" {
 		return fmt.Errorf("invalid resourceType: expected 'RequestOrchestration', got '%s'", r.ResourceType)
@@ -43,16 +60,60 @@ func (r *RequestOrchestration) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.InstantiatesCanonicalElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InstantiatesCanonicalElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.InstantiatesUriElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("InstantiatesUriElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
@@ -68,28 +129,76 @@ func (r *RequestOrchestration) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Intent == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, requestOrchestrationSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, requestOrchestrationSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, requestOrchestrationEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, requestOrchestrationEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOn: %w", err)
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		if err := r.AuthoredOnElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOnElement: %w", err)
+		}
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, requestOrchestrationAuthorTargets); target != "" {
+			return fmt.Errorf("field 'Author' cannot refer to %s, expected one of %v", target, requestOrchestrationAuthorTargets)
+		}
+	}
 	if r.Author != nil {
 		if err := r.Author.Validate(); err != nil {
 			return fmt.Errorf("Author: %w", err)
@@ -115,84 +224,394 @@ func (r *RequestOrchestration) Validate() error {
 			return fmt.Errorf("Action[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "RequestOrchestration")
 }
 
-type RequestOrchestrationActionCondition struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Kind       string      `json:"kind" bson:"kind"`                                 // applicability | start | stop
-	Expression *Expression `json:"expression,omitempty" bson:"expression,omitempty"` // Boolean-valued expression
+func (r *RequestOrchestration) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "RequestOrchestration" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'RequestOrchestration', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.InstantiatesCanonicalElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.instantiatesCanonical[%d]", path, i), issues)
+	}
+	for i, item := range r.InstantiatesUriElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.instantiatesUri[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	for i, item := range r.Replaces {
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, requestOrchestrationSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, requestOrchestrationSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, requestOrchestrationEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, requestOrchestrationEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			issues.add("value", path+".authoredOn", err.Error())
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		r.AuthoredOnElement.validateAll(path+".authoredOn", issues)
+	}
+	if r.Author != nil {
+		if target := invalidReferenceTarget(r.Author.Reference, r.Author.Type, requestOrchestrationAuthorTargets); target != "" {
+			issues.add("value", path+".author", fmt.Sprintf("field 'Author' cannot refer to %s, expected one of %v", target, requestOrchestrationAuthorTargets))
+		}
+	}
+	if r.Author != nil {
+		r.Author.validateAll(path+".author", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Goal {
+		item.validateAll(fmt.Sprintf("%s.goal[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Action {
+		item.validateAll(fmt.Sprintf("%s.action[%d]", path, i), issues)
+	}
 }
 
-func (r *RequestOrchestrationActionCondition) Validate() error {
-	var emptyString string
-	if r.Kind == emptyString {
-		return fmt.Errorf("field 'Kind' is required")
+func (r *RequestOrchestration) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RequestOrchestration", &issues)
+	checkInvariants(r, "RequestOrchestration", &issues)
+	return issues
+}
+
+var requestOrchestrationInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *RequestOrchestration) invariants() []invariant {
+	return requestOrchestrationInvariants
+}
+
+func (r RequestOrchestration) MarshalJSON() ([]byte, error) {
+	type alias RequestOrchestration
+	out := struct {
+		alias
+		InstantiatesCanonical        []*string  `json:"instantiatesCanonical,omitempty"`
+		InstantiatesCanonicalElement []*Element `json:"_instantiatesCanonical,omitempty"`
+		InstantiatesUri              []*string  `json:"instantiatesUri,omitempty"`
+		InstantiatesUriElement       []*Element `json:"_instantiatesUri,omitempty"`
+	}{alias: alias(r)}
+	out.InstantiatesCanonical, out.InstantiatesCanonicalElement = alignPrimitiveArray(r.InstantiatesCanonical, r.InstantiatesCanonicalElement)
+	out.InstantiatesUri, out.InstantiatesUriElement = alignPrimitiveArray(r.InstantiatesUri, r.InstantiatesUriElement)
+	return json.Marshal(out)
+}
+
+func (r *RequestOrchestration) UnmarshalJSON(data []byte) error {
+	type alias RequestOrchestration
+	aux := struct {
+		*alias
+		Contained             []json.RawMessage `json:"contained,omitempty"`
+		InstantiatesCanonical []*string         `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string         `json:"instantiatesUri,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.Expression != nil {
-		if err := r.Expression.Validate(); err != nil {
-			return fmt.Errorf("Expression: %w", err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.InstantiatesCanonical, err = splitPrimitiveArray(aux.InstantiatesCanonical, r.InstantiatesCanonicalElement); err != nil {
+		return fmt.Errorf("instantiatesCanonical: %w", err)
+	}
+	if r.InstantiatesUri, err = splitPrimitiveArray(aux.InstantiatesUri, r.InstantiatesUriElement); err != nil {
+		return fmt.Errorf("instantiatesUri: %w", err)
 	}
 	return nil
 }
 
-type RequestOrchestrationActionInput struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Title       *string          `json:"title,omitempty" bson:"title,omitempty"`              // User-visible title
-	Requirement *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`  // What data is provided
-	RelatedData *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"` // What data is provided
+func (r RequestOrchestration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *RequestOrchestrationActionInput) Validate() error {
-	if r.Requirement != nil {
-		if err := r.Requirement.Validate(); err != nil {
-			return fmt.Errorf("Requirement: %w", err)
-		}
+func (r *RequestOrchestration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestration) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestration) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requestOrchestrationElementTypes = map[string]string{
+	"id":                    "id",
+	"implicitRules":         "uri",
+	"language":              "code",
+	"instantiatesCanonical": "canonical",
+	"instantiatesUri":       "uri",
+}
+
+func (r *RequestOrchestration) elementTypes() map[string]string {
+	return requestOrchestrationElementTypes
+}
+
+var requestOrchestrationElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "RequestOrchestration.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "RequestOrchestration.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "RequestOrchestration.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "RequestOrchestration.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "RequestOrchestration.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "RequestOrchestration.instantiatesCanonical", Name: "instantiatesCanonical", Field: 12, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "RequestOrchestration.instantiatesUri", Name: "instantiatesUri", Field: 14, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "RequestOrchestration.basedOn", Name: "basedOn", Field: 16, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "RequestOrchestration.replaces", Name: "replaces", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "RequestOrchestration.groupIdentifier", Name: "groupIdentifier", Field: 18, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "RequestOrchestration.status", Name: "status", Field: 19, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"}},
+	{Path: "RequestOrchestration.intent", Name: "intent", Field: 21, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-intent"}},
+	{Path: "RequestOrchestration.priority", Name: "priority", Field: 23, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "RequestOrchestration.code", Name: "code", Field: 25, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RequestOrchestration.subject", Name: "subject", Field: 26, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "RequestOrchestration.encounter", Name: "encounter", Field: 27, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "RequestOrchestration.authoredOn", Name: "authoredOn", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "RequestOrchestration.author", Name: "author", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "RequestOrchestration.reason", Name: "reason", Field: 31, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "RequestOrchestration.goal", Name: "goal", Field: 32, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "RequestOrchestration.note", Name: "note", Field: 33, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "RequestOrchestration.action", Name: "action", Field: 34, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *RequestOrchestration) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationElementMetadata
+}
+
+func (r *RequestOrchestration) GetResourceType() string {
+	return "RequestOrchestration"
+}
+
+func (r *RequestOrchestration) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	return nil
+	return *r.Id
+}
+
+func (r *RequestOrchestration) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *RequestOrchestration) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *RequestOrchestration) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *RequestOrchestration) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *RequestOrchestration) GetContained() []Resource {
+	return r.Contained
 }
 
+func (r *RequestOrchestration) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*RequestOrchestration)(nil)
+
 type RequestOrchestrationAction struct {
-	Id                    *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                        // Unique id for inter-element referencing
-	LinkId                *string                                   `json:"linkId,omitempty" bson:"link_id,omitempty"`                               // Pointer to specific item from the PlanDefinition
-	Prefix                *string                                   `json:"prefix,omitempty" bson:"prefix,omitempty"`                                // User-visible prefix for the action (e.g. 1. or A.)
-	Title                 *string                                   `json:"title,omitempty" bson:"title,omitempty"`                                  // User-visible title
-	Description           *string                                   `json:"description,omitempty" bson:"description,omitempty"`                      // Short description of the action
-	TextEquivalent        *string                                   `json:"textEquivalent,omitempty" bson:"text_equivalent,omitempty"`               // Static text equivalent of the action, used if the dynamic aspects cannot be interpreted by the receiving system
-	Priority              *string                                   `json:"priority,omitempty" bson:"priority,omitempty"`                            // routine | urgent | asap | stat
-	Code                  []CodeableConcept                         `json:"code,omitempty" bson:"code,omitempty"`                                    // Code representing the meaning of the action or sub-actions
-	Documentation         []RelatedArtifact                         `json:"documentation,omitempty" bson:"documentation,omitempty"`                  // Supporting documentation for the intended performer of the action
-	Goal                  []Reference                               `json:"goal,omitempty" bson:"goal,omitempty"`                                    // What goals
-	Condition             []RequestOrchestrationActionCondition     `json:"condition,omitempty" bson:"condition,omitempty"`                          // Whether or not the action is applicable
-	Input                 []RequestOrchestrationActionInput         `json:"input,omitempty" bson:"input,omitempty"`                                  // Input data requirements
-	Output                []RequestOrchestrationActionOutput        `json:"output,omitempty" bson:"output,omitempty"`                                // Output data definition
-	RelatedAction         []RequestOrchestrationActionRelatedAction `json:"relatedAction,omitempty" bson:"related_action,omitempty"`                 // Relationship to another action
-	TimingDateTime        *string                                   `json:"timingDateTime,omitempty" bson:"timing_date_time,omitempty"`              // When the action should take place
-	TimingAge             *Age                                      `json:"timingAge,omitempty" bson:"timing_age,omitempty"`                         // When the action should take place
-	TimingPeriod          *Period                                   `json:"timingPeriod,omitempty" bson:"timing_period,omitempty"`                   // When the action should take place
-	TimingDuration        *Duration                                 `json:"timingDuration,omitempty" bson:"timing_duration,omitempty"`               // When the action should take place
-	TimingRange           *Range                                    `json:"timingRange,omitempty" bson:"timing_range,omitempty"`                     // When the action should take place
-	TimingTiming          *Timing                                   `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`                   // When the action should take place
-	TimingRelativeTime    *RelativeTime                             `json:"timingRelativeTime,omitempty" bson:"timing_relative_time,omitempty"`      // When the action should take place
-	Location              *CodeableReference                        `json:"location,omitempty" bson:"location,omitempty"`                            // Where it should happen
-	Participant           []RequestOrchestrationActionParticipant   `json:"participant,omitempty" bson:"participant,omitempty"`                      // Who should perform the action
-	Type                  *CodeableConcept                          `json:"type,omitempty" bson:"type,omitempty"`                                    // create | update | remove | fire-event
-	ApplicabilityBehavior *string                                   `json:"applicabilityBehavior,omitempty" bson:"applicability_behavior,omitempty"` // all | any
-	GroupingBehavior      *string                                   `json:"groupingBehavior,omitempty" bson:"grouping_behavior,omitempty"`           // visual-group | logical-group | sentence-group
-	SelectionBehavior     *string                                   `json:"selectionBehavior,omitempty" bson:"selection_behavior,omitempty"`         // any | all | all-or-none | exactly-one | at-most-one | one-or-more
-	RequiredBehavior      *string                                   `json:"requiredBehavior,omitempty" bson:"required_behavior,omitempty"`           // must | could | must-unless-documented
-	PrecheckBehavior      *string                                   `json:"precheckBehavior,omitempty" bson:"precheck_behavior,omitempty"`           // yes | no
-	CardinalityBehavior   *string                                   `json:"cardinalityBehavior,omitempty" bson:"cardinality_behavior,omitempty"`     // single | multiple
-	Resource              *Reference                                `json:"resource,omitempty" bson:"resource,omitempty"`                            // The target of the action
-	DefinitionCanonical   *string                                   `json:"definitionCanonical,omitempty" bson:"definition_canonical,omitempty"`     // Description of the activity to be performed
-	DefinitionUri         *string                                   `json:"definitionUri,omitempty" bson:"definition_uri,omitempty"`                 // Description of the activity to be performed
-	Transform             *string                                   `json:"transform,omitempty" bson:"transform,omitempty"`                          // Transform to apply the template
-	DynamicValue          []RequestOrchestrationActionDynamicValue  `json:"dynamicValue,omitempty" bson:"dynamic_value,omitempty"`                   // Dynamic aspects of the definition
-	Action                []RequestOrchestrationAction              `json:"action,omitempty" bson:"action,omitempty"`                                // Sub action
+	Id                           *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                                 // Unique id for inter-element referencing
+	Extension                    []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                                   // Additional content defined by implementations
+	ModifierExtension            []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                  // Extensions that cannot be ignored even if unrecognized
+	LinkId                       *string                                   `json:"linkId,omitempty" bson:"link_id,omitempty"`                                        // Pointer to specific item from the PlanDefinition
+	LinkIdElement                *Element                                  `json:"_linkId,omitempty" bson:"link_id_element,omitempty"`                               // Extensions for linkId
+	Prefix                       *string                                   `json:"prefix,omitempty" bson:"prefix,omitempty"`                                         // User-visible prefix for the action (e.g. 1. or A.)
+	PrefixElement                *Element                                  `json:"_prefix,omitempty" bson:"prefix_element,omitempty"`                                // Extensions for prefix
+	Title                        *string                                   `json:"title,omitempty" bson:"title,omitempty"`                                           // User-visible title
+	TitleElement                 *Element                                  `json:"_title,omitempty" bson:"title_element,omitempty"`                                  // Extensions for title
+	Description                  *string                                   `json:"description,omitempty" bson:"description,omitempty"`                               // Short description of the action
+	DescriptionElement           *Element                                  `json:"_description,omitempty" bson:"description_element,omitempty"`                      // Extensions for description
+	TextEquivalent               *string                                   `json:"textEquivalent,omitempty" bson:"text_equivalent,omitempty"`                        // Static text equivalent of the action, used if the dynamic aspects cannot be interpreted by the receiving system
+	TextEquivalentElement        *Element                                  `json:"_textEquivalent,omitempty" bson:"text_equivalent_element,omitempty"`               // Extensions for textEquivalent
+	Priority                     *RequestPriority                          `json:"priority,omitempty" bson:"priority,omitempty"`                                     // routine | urgent | asap | stat
+	PriorityElement              *Element                                  `json:"_priority,omitempty" bson:"priority_element,omitempty"`                            // Extensions for priority
+	Code                         []CodeableConcept                         `json:"code,omitempty" bson:"code,omitempty"`                                             // Code representing the meaning of the action or sub-actions
+	Documentation                []RelatedArtifact                         `json:"documentation,omitempty" bson:"documentation,omitempty"`                           // Supporting documentation for the intended performer of the action
+	Goal                         []Reference                               `json:"goal,omitempty" bson:"goal,omitempty"`                                             // What goals
+	Condition                    []RequestOrchestrationActionCondition     `json:"condition,omitempty" bson:"condition,omitempty"`                                   // Whether or not the action is applicable
+	Input                        []RequestOrchestrationActionInput         `json:"input,omitempty" bson:"input,omitempty"`                                           // Input data requirements
+	Output                       []RequestOrchestrationActionOutput        `json:"output,omitempty" bson:"output,omitempty"`                                         // Output data definition
+	RelatedAction                []RequestOrchestrationActionRelatedAction `json:"relatedAction,omitempty" bson:"related_action,omitempty"`                          // Relationship to another action
+	Timing                       RequestOrchestrationActionTiming          `json:"-" bson:"timing,omitempty"`                                                        // When the action should take place
+	TimingElement                *Element                                  `json:"-" bson:"timing_element,omitempty"`                                                // Extensions for timing[x]
+	Location                     *CodeableReference                        `json:"location,omitempty" bson:"location,omitempty"`                                     // Where it should happen
+	Participant                  []RequestOrchestrationActionParticipant   `json:"participant,omitempty" bson:"participant,omitempty"`                               // Who should perform the action
+	Type                         *CodeableConcept                          `json:"type,omitempty" bson:"type,omitempty"`                                             // create | update | remove | fire-event
+	ApplicabilityBehavior        *ActionApplicabilityBehavior              `json:"applicabilityBehavior,omitempty" bson:"applicability_behavior,omitempty"`          // all | any
+	ApplicabilityBehaviorElement *Element                                  `json:"_applicabilityBehavior,omitempty" bson:"applicability_behavior_element,omitempty"` // Extensions for applicabilityBehavior
+	GroupingBehavior             *ActionGroupingBehavior                   `json:"groupingBehavior,omitempty" bson:"grouping_behavior,omitempty"`                    // visual-group | logical-group | sentence-group
+	GroupingBehaviorElement      *Element                                  `json:"_groupingBehavior,omitempty" bson:"grouping_behavior_element,omitempty"`           // Extensions for groupingBehavior
+	SelectionBehavior            *ActionSelectionBehavior                  `json:"selectionBehavior,omitempty" bson:"selection_behavior,omitempty"`                  // any | all | all-or-none | exactly-one | at-most-one | one-or-more
+	SelectionBehaviorElement     *Element                                  `json:"_selectionBehavior,omitempty" bson:"selection_behavior_element,omitempty"`         // Extensions for selectionBehavior
+	RequiredBehavior             *ActionRequiredBehavior                   `json:"requiredBehavior,omitempty" bson:"required_behavior,omitempty"`                    // must | could | must-unless-documented
+	RequiredBehaviorElement      *Element                                  `json:"_requiredBehavior,omitempty" bson:"required_behavior_element,omitempty"`           // Extensions for requiredBehavior
+	PrecheckBehavior             *ActionPrecheckBehavior                   `json:"precheckBehavior,omitempty" bson:"precheck_behavior,omitempty"`                    // yes | no
+	PrecheckBehaviorElement      *Element                                  `json:"_precheckBehavior,omitempty" bson:"precheck_behavior_element,omitempty"`           // Extensions for precheckBehavior
+	CardinalityBehavior          *ActionCardinalityBehavior                `json:"cardinalityBehavior,omitempty" bson:"cardinality_behavior,omitempty"`              // single | multiple
+	CardinalityBehaviorElement   *Element                                  `json:"_cardinalityBehavior,omitempty" bson:"cardinality_behavior_element,omitempty"`     // Extensions for cardinalityBehavior
+	Resource                     *Reference                                `json:"resource,omitempty" bson:"resource,omitempty"`                                     // The target of the action
+	Definition                   RequestOrchestrationActionDefinition      `json:"-" bson:"definition,omitempty"`                                                    // Description of the activity to be performed
+	DefinitionElement            *Element                                  `json:"-" bson:"definition_element,omitempty"`                                            // Extensions for definition[x]
+	Transform                    *string                                   `json:"transform,omitempty" bson:"transform,omitempty"`                                   // Transform to apply the template
+	TransformElement             *Element                                  `json:"_transform,omitempty" bson:"transform_element,omitempty"`                          // Extensions for transform
+	DynamicValue                 []RequestOrchestrationActionDynamicValue  `json:"dynamicValue,omitempty" bson:"dynamic_value,omitempty"`                            // Dynamic aspects of the definition
+	Action                       []RequestOrchestrationAction              `json:"action,omitempty" bson:"action,omitempty"`                                         // Sub action
+
+	timingVariants     []string // JSON properties of RequestOrchestration.action.timing[x] when more than one was decoded
+	definitionVariants []string // JSON properties of RequestOrchestration.action.definition[x] when more than one was decoded
 }
 
 func (r *RequestOrchestrationAction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LinkIdElement != nil {
+		if err := r.LinkIdElement.Validate(); err != nil {
+			return fmt.Errorf("LinkIdElement: %w", err)
+		}
+	}
+	if r.PrefixElement != nil {
+		if err := r.PrefixElement.Validate(); err != nil {
+			return fmt.Errorf("PrefixElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.TextEquivalentElement != nil {
+		if err := r.TextEquivalentElement.Validate(); err != nil {
+			return fmt.Errorf("TextEquivalentElement: %w", err)
+		}
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
 	for i, item := range r.Code {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Code[%d]: %w", i, err)
@@ -228,34 +647,17 @@ func (r *RequestOrchestrationAction) Validate() error {
 			return fmt.Errorf("RelatedAction[%d]: %w", i, err)
 		}
 	}
-	if r.TimingAge != nil {
-		if err := r.TimingAge.Validate(); err != nil {
-			return fmt.Errorf("TimingAge: %w", err)
-		}
-	}
-	if r.TimingPeriod != nil {
-		if err := r.TimingPeriod.Validate(); err != nil {
-			return fmt.Errorf("TimingPeriod: %w", err)
-		}
-	}
-	if r.TimingDuration != nil {
-		if err := r.TimingDuration.Validate(); err != nil {
-			return fmt.Errorf("TimingDuration: %w", err)
-		}
-	}
-	if r.TimingRange != nil {
-		if err := r.TimingRange.Validate(); err != nil {
-			return fmt.Errorf("TimingRange: %w", err)
-		}
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
 	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
 		}
 	}
-	if r.TimingRelativeTime != nil {
-		if err := r.TimingRelativeTime.Validate(); err != nil {
-			return fmt.Errorf("TimingRelativeTime: %w", err)
+	if r.TimingElement != nil {
+		if err := r.TimingElement.Validate(); err != nil {
+			return fmt.Errorf("TimingElement: %w", err)
 		}
 	}
 	if r.Location != nil {
@@ -273,11 +675,77 @@ func (r *RequestOrchestrationAction) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.ApplicabilityBehavior != nil && !r.ApplicabilityBehavior.IsValid() {
+		return fmt.Errorf("field 'ApplicabilityBehavior' has invalid code '%s'", *r.ApplicabilityBehavior)
+	}
+	if r.ApplicabilityBehaviorElement != nil {
+		if err := r.ApplicabilityBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("ApplicabilityBehaviorElement: %w", err)
+		}
+	}
+	if r.GroupingBehavior != nil && !r.GroupingBehavior.IsValid() {
+		return fmt.Errorf("field 'GroupingBehavior' has invalid code '%s'", *r.GroupingBehavior)
+	}
+	if r.GroupingBehaviorElement != nil {
+		if err := r.GroupingBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("GroupingBehaviorElement: %w", err)
+		}
+	}
+	if r.SelectionBehavior != nil && !r.SelectionBehavior.IsValid() {
+		return fmt.Errorf("field 'SelectionBehavior' has invalid code '%s'", *r.SelectionBehavior)
+	}
+	if r.SelectionBehaviorElement != nil {
+		if err := r.SelectionBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("SelectionBehaviorElement: %w", err)
+		}
+	}
+	if r.RequiredBehavior != nil && !r.RequiredBehavior.IsValid() {
+		return fmt.Errorf("field 'RequiredBehavior' has invalid code '%s'", *r.RequiredBehavior)
+	}
+	if r.RequiredBehaviorElement != nil {
+		if err := r.RequiredBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("RequiredBehaviorElement: %w", err)
+		}
+	}
+	if r.PrecheckBehavior != nil && !r.PrecheckBehavior.IsValid() {
+		return fmt.Errorf("field 'PrecheckBehavior' has invalid code '%s'", *r.PrecheckBehavior)
+	}
+	if r.PrecheckBehaviorElement != nil {
+		if err := r.PrecheckBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("PrecheckBehaviorElement: %w", err)
+		}
+	}
+	if r.CardinalityBehavior != nil && !r.CardinalityBehavior.IsValid() {
+		return fmt.Errorf("field 'CardinalityBehavior' has invalid code '%s'", *r.CardinalityBehavior)
+	}
+	if r.CardinalityBehaviorElement != nil {
+		if err := r.CardinalityBehaviorElement.Validate(); err != nil {
+			return fmt.Errorf("CardinalityBehaviorElement: %w", err)
+		}
+	}
 	if r.Resource != nil {
 		if err := r.Resource.Validate(); err != nil {
 			return fmt.Errorf("Resource: %w", err)
 		}
 	}
+	if len(r.definitionVariants) > 1 {
+		return fmt.Errorf("field 'Definition' must have a single type, got %v", r.definitionVariants)
+	}
+	if r.Definition != nil {
+		if err := r.Definition.Validate(); err != nil {
+			return fmt.Errorf("Definition: %w", err)
+		}
+	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
+	if r.TransformElement != nil {
+		if err := r.TransformElement.Validate(); err != nil {
+			return fmt.Errorf("TransformElement: %w", err)
+		}
+	}
 	for i, item := range r.DynamicValue {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("DynamicValue[%d]: %w", i, err)
@@ -291,94 +759,1342 @@ func (r *RequestOrchestrationAction) Validate() error {
 	return nil
 }
 
-type RequestOrchestrationActionOutput struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Title       *string          `json:"title,omitempty" bson:"title,omitempty"`              // User-visible title
-	Requirement *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`  // What data is provided
-	RelatedData *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"` // What data is provided
-}
-
-func (r *RequestOrchestrationActionOutput) Validate() error {
-	if r.Requirement != nil {
-		if err := r.Requirement.Validate(); err != nil {
-			return fmt.Errorf("Requirement: %w", err)
-		}
+func (r *RequestOrchestrationAction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type RequestOrchestrationActionRelatedAction struct {
-	Id              *string   `json:"id,omitempty" bson:"id,omitempty"`                            // Unique id for inter-element referencing
-	TargetId        string    `json:"targetId" bson:"target_id"`                                   // What action this is related to
-	Relationship    string    `json:"relationship" bson:"relationship"`                            // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
-	EndRelationship *string   `json:"endRelationship,omitempty" bson:"end_relationship,omitempty"` // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
-	OffsetDuration  *Duration `json:"offsetDuration,omitempty" bson:"offset_duration,omitempty"`   // Time offset for the relationship
-	OffsetRange     *Range    `json:"offsetRange,omitempty" bson:"offset_range,omitempty"`         // Time offset for the relationship
-}
-
-func (r *RequestOrchestrationActionRelatedAction) Validate() error {
-	var emptyString string
-	if r.TargetId == emptyString {
-		return fmt.Errorf("field 'TargetId' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Relationship == emptyString {
-		return fmt.Errorf("field 'Relationship' is required")
+	if r.LinkIdElement != nil {
+		r.LinkIdElement.validateAll(path+".linkId", issues)
 	}
-	if r.OffsetDuration != nil {
-		if err := r.OffsetDuration.Validate(); err != nil {
-			return fmt.Errorf("OffsetDuration: %w", err)
-		}
+	if r.PrefixElement != nil {
+		r.PrefixElement.validateAll(path+".prefix", issues)
 	}
-	if r.OffsetRange != nil {
-		if err := r.OffsetRange.Validate(); err != nil {
-			return fmt.Errorf("OffsetRange: %w", err)
-		}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	return nil
-}
-
-type RequestOrchestrationActionParticipant struct {
-	Id             *string          `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Type           *string          `json:"type,omitempty" bson:"type,omitempty"`                      // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
-	TypeCanonical  *string          `json:"typeCanonical,omitempty" bson:"type_canonical,omitempty"`   // Who or what can participate
-	TypeReference  *Reference       `json:"typeReference,omitempty" bson:"type_reference,omitempty"`   // Who or what can participate
-	Role           *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                      // E.g. Nurse, Surgeon, Parent, etc
-	Function       *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`              // E.g. Author, Reviewer, Witness, etc
-	ActorCanonical *string          `json:"actorCanonical,omitempty" bson:"actor_canonical,omitempty"` // Who/what is participating?
-	ActorReference *Reference       `json:"actorReference,omitempty" bson:"actor_reference,omitempty"` // Who/what is participating?
-}
-
-func (r *RequestOrchestrationActionParticipant) Validate() error {
-	if r.TypeReference != nil {
-		if err := r.TypeReference.Validate(); err != nil {
-			return fmt.Errorf("TypeReference: %w", err)
-		}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+	if r.TextEquivalentElement != nil {
+		r.TextEquivalentElement.validateAll(path+".textEquivalent", issues)
 	}
-	if r.Function != nil {
-		if err := r.Function.Validate(); err != nil {
-			return fmt.Errorf("Function: %w", err)
-		}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
 	}
-	if r.ActorReference != nil {
-		if err := r.ActorReference.Validate(); err != nil {
-			return fmt.Errorf("ActorReference: %w", err)
-		}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
 	}
-	return nil
-}
-
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	for i, item := range r.Documentation {
+		item.validateAll(fmt.Sprintf("%s.documentation[%d]", path, i), issues)
+	}
+	for i, item := range r.Goal {
+		item.validateAll(fmt.Sprintf("%s.goal[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Input {
+		item.validateAll(fmt.Sprintf("%s.input[%d]", path, i), issues)
+	}
+	for i, item := range r.Output {
+		item.validateAll(fmt.Sprintf("%s.output[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedAction {
+		item.validateAll(fmt.Sprintf("%s.relatedAction[%d]", path, i), issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if r.TimingElement != nil {
+		r.TimingElement.validateAll(path+".timing", issues)
+	}
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
+	}
+	for i, item := range r.Participant {
+		item.validateAll(fmt.Sprintf("%s.participant[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.ApplicabilityBehavior != nil && !r.ApplicabilityBehavior.IsValid() {
+		issues.add("code-invalid", path+".applicabilityBehavior", fmt.Sprintf("field 'ApplicabilityBehavior' has invalid code '%s'", *r.ApplicabilityBehavior))
+	}
+	if r.ApplicabilityBehaviorElement != nil {
+		r.ApplicabilityBehaviorElement.validateAll(path+".applicabilityBehavior", issues)
+	}
+	if r.GroupingBehavior != nil && !r.GroupingBehavior.IsValid() {
+		issues.add("code-invalid", path+".groupingBehavior", fmt.Sprintf("field 'GroupingBehavior' has invalid code '%s'", *r.GroupingBehavior))
+	}
+	if r.GroupingBehaviorElement != nil {
+		r.GroupingBehaviorElement.validateAll(path+".groupingBehavior", issues)
+	}
+	if r.SelectionBehavior != nil && !r.SelectionBehavior.IsValid() {
+		issues.add("code-invalid", path+".selectionBehavior", fmt.Sprintf("field 'SelectionBehavior' has invalid code '%s'", *r.SelectionBehavior))
+	}
+	if r.SelectionBehaviorElement != nil {
+		r.SelectionBehaviorElement.validateAll(path+".selectionBehavior", issues)
+	}
+	if r.RequiredBehavior != nil && !r.RequiredBehavior.IsValid() {
+		issues.add("code-invalid", path+".requiredBehavior", fmt.Sprintf("field 'RequiredBehavior' has invalid code '%s'", *r.RequiredBehavior))
+	}
+	if r.RequiredBehaviorElement != nil {
+		r.RequiredBehaviorElement.validateAll(path+".requiredBehavior", issues)
+	}
+	if r.PrecheckBehavior != nil && !r.PrecheckBehavior.IsValid() {
+		issues.add("code-invalid", path+".precheckBehavior", fmt.Sprintf("field 'PrecheckBehavior' has invalid code '%s'", *r.PrecheckBehavior))
+	}
+	if r.PrecheckBehaviorElement != nil {
+		r.PrecheckBehaviorElement.validateAll(path+".precheckBehavior", issues)
+	}
+	if r.CardinalityBehavior != nil && !r.CardinalityBehavior.IsValid() {
+		issues.add("code-invalid", path+".cardinalityBehavior", fmt.Sprintf("field 'CardinalityBehavior' has invalid code '%s'", *r.CardinalityBehavior))
+	}
+	if r.CardinalityBehaviorElement != nil {
+		r.CardinalityBehaviorElement.validateAll(path+".cardinalityBehavior", issues)
+	}
+	if r.Resource != nil {
+		r.Resource.validateAll(path+".resource", issues)
+	}
+	if len(r.definitionVariants) > 1 {
+		issues.add("structure", path+".definition", fmt.Sprintf("field 'Definition' must have a single type, got %v", r.definitionVariants))
+	}
+	if r.Definition != nil {
+		r.Definition.validateAll(path+".definition.ofType("+r.Definition.FHIRType()+")", issues)
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.TransformElement != nil {
+		r.TransformElement.validateAll(path+".transform", issues)
+	}
+	for i, item := range r.DynamicValue {
+		item.validateAll(fmt.Sprintf("%s.dynamicValue[%d]", path, i), issues)
+	}
+	for i, item := range r.Action {
+		item.validateAll(fmt.Sprintf("%s.action[%d]", path, i), issues)
+	}
+}
+
+var requestOrchestrationActionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationAction) invariants() []invariant {
+	return requestOrchestrationActionInvariants
+}
+
+func (r RequestOrchestrationAction) MarshalJSON() ([]byte, error) {
+	type alias RequestOrchestrationAction
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, r.TimingElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "definition", r.Definition, r.DefinitionElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RequestOrchestrationAction) UnmarshalJSON(data []byte) error {
+	type alias RequestOrchestrationAction
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Timing, element, r.timingVariants, err = unmarshalChoice(fields, "timing", requestOrchestrationActionTimingVariants); err != nil {
+		return err
+	}
+	r.TimingElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TimingElement); err != nil {
+			return fmt.Errorf("_timing: %w", err)
+		}
+	}
+	if r.Definition, element, r.definitionVariants, err = unmarshalChoice(fields, "definition", requestOrchestrationActionDefinitionVariants); err != nil {
+		return err
+	}
+	r.DefinitionElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DefinitionElement); err != nil {
+			return fmt.Errorf("_definition: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r RequestOrchestrationAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationAction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RequestOrchestrationAction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Timing":
+		return "timing", choiceValues(requestOrchestrationActionTimingVariants)
+	case "Definition":
+		return "definition", choiceValues(requestOrchestrationActionDefinitionVariants)
+	}
+	return "", nil
+}
+
+var requestOrchestrationActionElementTypes = map[string]string{
+	"description":    "markdown",
+	"textEquivalent": "markdown",
+	"transform":      "canonical",
+}
+
+func (r *RequestOrchestrationAction) elementTypes() map[string]string {
+	return requestOrchestrationActionElementTypes
+}
+
+var requestOrchestrationActionElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.linkId", Name: "linkId", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.prefix", Name: "prefix", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.title", Name: "title", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.description", Name: "description", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "RequestOrchestration.action.textEquivalent", Name: "textEquivalent", Field: 11, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "RequestOrchestration.action.priority", Name: "priority", Field: 13, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "RequestOrchestration.action.code", Name: "code", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "RequestOrchestration.action.documentation", Name: "documentation", Field: 16, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "RequestOrchestration.action.goal", Name: "goal", Field: 17, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "RequestOrchestration.action.condition", Name: "condition", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RequestOrchestration.action.input", Name: "input", Field: 19, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RequestOrchestration.action.output", Name: "output", Field: 20, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RequestOrchestration.action.relatedAction", Name: "relatedAction", Field: 21, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RequestOrchestration.action.timing[x]", Name: "timing", Field: 22, Min: 0, Max: "1", Types: []string{"dateTime", "Age", "Period", "Duration", "Range", "Timing", "RelativeTime"}},
+	{Path: "RequestOrchestration.action.location", Name: "location", Field: 24, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "RequestOrchestration.action.participant", Name: "participant", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RequestOrchestration.action.type", Name: "type", Field: 26, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RequestOrchestration.action.applicabilityBehavior", Name: "applicabilityBehavior", Field: 27, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.applicabilityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-applicability-behavior"}},
+	{Path: "RequestOrchestration.action.groupingBehavior", Name: "groupingBehavior", Field: 29, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.groupingBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-grouping-behavior"}},
+	{Path: "RequestOrchestration.action.selectionBehavior", Name: "selectionBehavior", Field: 31, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.selectionBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-selection-behavior"}},
+	{Path: "RequestOrchestration.action.requiredBehavior", Name: "requiredBehavior", Field: 33, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.requiredBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-required-behavior"}},
+	{Path: "RequestOrchestration.action.precheckBehavior", Name: "precheckBehavior", Field: 35, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.precheckBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-precheck-behavior"}},
+	{Path: "RequestOrchestration.action.cardinalityBehavior", Name: "cardinalityBehavior", Field: 37, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.cardinalityBehavior", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-cardinality-behavior"}},
+	{Path: "RequestOrchestration.action.resource", Name: "resource", Field: 39, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "RequestOrchestration.action.definition[x]", Name: "definition", Field: 40, Min: 0, Max: "1", Types: []string{"canonical", "uri"}},
+	{Path: "RequestOrchestration.action.transform", Name: "transform", Field: 42, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "RequestOrchestration.action.dynamicValue", Name: "dynamicValue", Field: 44, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RequestOrchestration.action.action", Name: "action", Field: 45, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *RequestOrchestrationAction) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionElementMetadata
+}
+
+// RequestOrchestrationActionTiming is the type of RequestOrchestration.action.timing[x]. It is implemented by the RequestOrchestrationActionTiming* variant types.
+type RequestOrchestrationActionTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRequestOrchestrationActionTiming()
+}
+
+// RequestOrchestrationActionTimingDateTime is the dateTime variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingDateTime struct {
+	DateTime
+}
+
+func (v RequestOrchestrationActionTimingDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v RequestOrchestrationActionTimingDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v RequestOrchestrationActionTimingDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v RequestOrchestrationActionTimingDateTime) isRequestOrchestrationActionTiming() {}
+
+// RequestOrchestrationActionTimingAge is the Age variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingAge struct {
+	Age
+}
+
+func (v RequestOrchestrationActionTimingAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v RequestOrchestrationActionTimingAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionTimingAge) FHIRType() string {
+	return "Age"
+}
+
+func (v RequestOrchestrationActionTimingAge) isRequestOrchestrationActionTiming() {}
+
+// RequestOrchestrationActionTimingPeriod is the Period variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingPeriod struct {
+	Period
+}
+
+func (v RequestOrchestrationActionTimingPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v RequestOrchestrationActionTimingPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionTimingPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v RequestOrchestrationActionTimingPeriod) isRequestOrchestrationActionTiming() {}
+
+// RequestOrchestrationActionTimingDuration is the Duration variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingDuration struct {
+	Duration
+}
+
+func (v RequestOrchestrationActionTimingDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v RequestOrchestrationActionTimingDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionTimingDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v RequestOrchestrationActionTimingDuration) isRequestOrchestrationActionTiming() {}
+
+// RequestOrchestrationActionTimingRange is the Range variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingRange struct {
+	Range
+}
+
+func (v RequestOrchestrationActionTimingRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v RequestOrchestrationActionTimingRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionTimingRange) FHIRType() string {
+	return "Range"
+}
+
+func (v RequestOrchestrationActionTimingRange) isRequestOrchestrationActionTiming() {}
+
+// RequestOrchestrationActionTimingTiming is the Timing variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingTiming struct {
+	Timing
+}
+
+func (v RequestOrchestrationActionTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v RequestOrchestrationActionTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v RequestOrchestrationActionTimingTiming) isRequestOrchestrationActionTiming() {}
+
+// RequestOrchestrationActionTimingRelativeTime is the RelativeTime variant of RequestOrchestrationActionTiming.
+type RequestOrchestrationActionTimingRelativeTime struct {
+	RelativeTime
+}
+
+func (v RequestOrchestrationActionTimingRelativeTime) Validate() error {
+	return v.RelativeTime.Validate()
+}
+
+func (v RequestOrchestrationActionTimingRelativeTime) validateAll(path string, issues *ValidationIssues) {
+	v.RelativeTime.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionTimingRelativeTime) FHIRType() string {
+	return "RelativeTime"
+}
+
+func (v RequestOrchestrationActionTimingRelativeTime) isRequestOrchestrationActionTiming() {}
+
+var requestOrchestrationActionTimingVariants = []RequestOrchestrationActionTiming{
+	RequestOrchestrationActionTimingDateTime{},
+	RequestOrchestrationActionTimingAge{},
+	RequestOrchestrationActionTimingPeriod{},
+	RequestOrchestrationActionTimingDuration{},
+	RequestOrchestrationActionTimingRange{},
+	RequestOrchestrationActionTimingTiming{},
+	RequestOrchestrationActionTimingRelativeTime{},
+}
+
+// RequestOrchestrationActionDefinition is the type of RequestOrchestration.action.definition[x]. It is implemented by the RequestOrchestrationActionDefinition* variant types.
+type RequestOrchestrationActionDefinition interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRequestOrchestrationActionDefinition()
+}
+
+// RequestOrchestrationActionDefinitionCanonical is the canonical variant of RequestOrchestrationActionDefinition.
+type RequestOrchestrationActionDefinitionCanonical string
+
+func (v RequestOrchestrationActionDefinitionCanonical) Validate() error {
+	return nil
+}
+
+func (v RequestOrchestrationActionDefinitionCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v RequestOrchestrationActionDefinitionCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v RequestOrchestrationActionDefinitionCanonical) isRequestOrchestrationActionDefinition() {}
+
+// RequestOrchestrationActionDefinitionUri is the uri variant of RequestOrchestrationActionDefinition.
+type RequestOrchestrationActionDefinitionUri string
+
+func (v RequestOrchestrationActionDefinitionUri) Validate() error {
+	return nil
+}
+
+func (v RequestOrchestrationActionDefinitionUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v RequestOrchestrationActionDefinitionUri) FHIRType() string {
+	return "uri"
+}
+
+func (v RequestOrchestrationActionDefinitionUri) isRequestOrchestrationActionDefinition() {}
+
+var requestOrchestrationActionDefinitionVariants = []RequestOrchestrationActionDefinition{
+	RequestOrchestrationActionDefinitionCanonical(""),
+	RequestOrchestrationActionDefinitionUri(""),
+}
+
+type RequestOrchestrationActionCondition struct {
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Kind              ActionConditionKind `json:"kind" bson:"kind"`                                                // applicability | start | stop
+	KindElement       *Element            `json:"_kind,omitempty" bson:"kind_element,omitempty"`                   // Extensions for kind
+	Expression        *Expression         `json:"expression,omitempty" bson:"expression,omitempty"`                // Boolean-valued expression
+}
+
+func (r *RequestOrchestrationActionCondition) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Kind == "" {
+		return fmt.Errorf("field 'Kind' is required")
+	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	if r.Expression != nil {
+		if err := r.Expression.Validate(); err != nil {
+			return fmt.Errorf("Expression: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RequestOrchestrationActionCondition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+}
+
+var requestOrchestrationActionConditionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationActionCondition) invariants() []invariant {
+	return requestOrchestrationActionConditionInvariants
+}
+
+func (r RequestOrchestrationActionCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationActionCondition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationActionCondition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationActionCondition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requestOrchestrationActionConditionElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.condition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.condition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.condition.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.condition.kind", Name: "kind", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.condition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-condition-kind"}},
+	{Path: "RequestOrchestration.action.condition.expression", Name: "expression", Field: 5, Min: 0, Max: "1", Types: []string{"Expression"}},
+}
+
+func (r *RequestOrchestrationActionCondition) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionConditionElementMetadata
+}
+
+type RequestOrchestrationActionInput struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title              *string          `json:"title,omitempty" bson:"title,omitempty"`                          // User-visible title
+	TitleElement       *Element         `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Requirement        *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`              // What data is provided
+	RelatedData        *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"`             // What data is provided
+	RelatedDataElement *Element         `json:"_relatedData,omitempty" bson:"related_data_element,omitempty"`    // Extensions for relatedData
+}
+
+func (r *RequestOrchestrationActionInput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Requirement != nil {
+		if err := r.Requirement.Validate(); err != nil {
+			return fmt.Errorf("Requirement: %w", err)
+		}
+	}
+	if r.RelatedDataElement != nil {
+		if err := r.RelatedDataElement.Validate(); err != nil {
+			return fmt.Errorf("RelatedDataElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RequestOrchestrationActionInput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Requirement != nil {
+		r.Requirement.validateAll(path+".requirement", issues)
+	}
+	if r.RelatedDataElement != nil {
+		r.RelatedDataElement.validateAll(path+".relatedData", issues)
+	}
+}
+
+var requestOrchestrationActionInputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationActionInput) invariants() []invariant {
+	return requestOrchestrationActionInputInvariants
+}
+
+func (r RequestOrchestrationActionInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationActionInput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationActionInput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationActionInput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requestOrchestrationActionInputElementTypes = map[string]string{
+	"relatedData": "id",
+}
+
+func (r *RequestOrchestrationActionInput) elementTypes() map[string]string {
+	return requestOrchestrationActionInputElementTypes
+}
+
+var requestOrchestrationActionInputElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.input.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.input.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.input.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.input.title", Name: "title", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.input.requirement", Name: "requirement", Field: 5, Min: 0, Max: "1", Types: []string{"DataRequirement"}},
+	{Path: "RequestOrchestration.action.input.relatedData", Name: "relatedData", Field: 6, Min: 0, Max: "1", Types: []string{"id"}},
+}
+
+func (r *RequestOrchestrationActionInput) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionInputElementMetadata
+}
+
+type RequestOrchestrationActionOutput struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Title              *string          `json:"title,omitempty" bson:"title,omitempty"`                          // User-visible title
+	TitleElement       *Element         `json:"_title,omitempty" bson:"title_element,omitempty"`                 // Extensions for title
+	Requirement        *DataRequirement `json:"requirement,omitempty" bson:"requirement,omitempty"`              // What data is provided
+	RelatedData        *string          `json:"relatedData,omitempty" bson:"related_data,omitempty"`             // What data is provided
+	RelatedDataElement *Element         `json:"_relatedData,omitempty" bson:"related_data_element,omitempty"`    // Extensions for relatedData
+}
+
+func (r *RequestOrchestrationActionOutput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Requirement != nil {
+		if err := r.Requirement.Validate(); err != nil {
+			return fmt.Errorf("Requirement: %w", err)
+		}
+	}
+	if r.RelatedDataElement != nil {
+		if err := r.RelatedDataElement.Validate(); err != nil {
+			return fmt.Errorf("RelatedDataElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RequestOrchestrationActionOutput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Requirement != nil {
+		r.Requirement.validateAll(path+".requirement", issues)
+	}
+	if r.RelatedDataElement != nil {
+		r.RelatedDataElement.validateAll(path+".relatedData", issues)
+	}
+}
+
+var requestOrchestrationActionOutputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationActionOutput) invariants() []invariant {
+	return requestOrchestrationActionOutputInvariants
+}
+
+func (r RequestOrchestrationActionOutput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationActionOutput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationActionOutput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationActionOutput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requestOrchestrationActionOutputElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.output.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.output.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.output.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.output.title", Name: "title", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.output.requirement", Name: "requirement", Field: 5, Min: 0, Max: "1", Types: []string{"DataRequirement"}},
+	{Path: "RequestOrchestration.action.output.relatedData", Name: "relatedData", Field: 6, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *RequestOrchestrationActionOutput) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionOutputElementMetadata
+}
+
+type RequestOrchestrationActionRelatedAction struct {
+	Id                     *string                                       `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension                                   `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension                                   `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	TargetId               string                                        `json:"targetId" bson:"target_id"`                                            // What action this is related to
+	TargetIdElement        *Element                                      `json:"_targetId,omitempty" bson:"target_id_element,omitempty"`               // Extensions for targetId
+	Relationship           ActionRelationshipType                        `json:"relationship" bson:"relationship"`                                     // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
+	RelationshipElement    *Element                                      `json:"_relationship,omitempty" bson:"relationship_element,omitempty"`        // Extensions for relationship
+	EndRelationship        *ActionRelationshipType                       `json:"endRelationship,omitempty" bson:"end_relationship,omitempty"`          // before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
+	EndRelationshipElement *Element                                      `json:"_endRelationship,omitempty" bson:"end_relationship_element,omitempty"` // Extensions for endRelationship
+	Offset                 RequestOrchestrationActionRelatedActionOffset `json:"-" bson:"offset,omitempty"`                                            // Time offset for the relationship
+
+	offsetVariants []string // JSON properties of RequestOrchestration.action.relatedAction.offset[x] when more than one was decoded
+}
+
+func (r *RequestOrchestrationActionRelatedAction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.TargetId == emptyString {
+		return fmt.Errorf("field 'TargetId' is required")
+	}
+	if r.TargetIdElement != nil {
+		if err := r.TargetIdElement.Validate(); err != nil {
+			return fmt.Errorf("TargetIdElement: %w", err)
+		}
+	}
+	if r.Relationship == "" {
+		return fmt.Errorf("field 'Relationship' is required")
+	}
+	if !r.Relationship.IsValid() {
+		return fmt.Errorf("field 'Relationship' has invalid code '%s'", r.Relationship)
+	}
+	if r.RelationshipElement != nil {
+		if err := r.RelationshipElement.Validate(); err != nil {
+			return fmt.Errorf("RelationshipElement: %w", err)
+		}
+	}
+	if r.EndRelationship != nil && !r.EndRelationship.IsValid() {
+		return fmt.Errorf("field 'EndRelationship' has invalid code '%s'", *r.EndRelationship)
+	}
+	if r.EndRelationshipElement != nil {
+		if err := r.EndRelationshipElement.Validate(); err != nil {
+			return fmt.Errorf("EndRelationshipElement: %w", err)
+		}
+	}
+	if len(r.offsetVariants) > 1 {
+		return fmt.Errorf("field 'Offset' must have a single type, got %v", r.offsetVariants)
+	}
+	if r.Offset != nil {
+		if err := r.Offset.Validate(); err != nil {
+			return fmt.Errorf("Offset: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RequestOrchestrationActionRelatedAction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.TargetId == emptyString {
+		issues.add("required", path+".targetId", "field 'TargetId' is required")
+	}
+	if r.TargetIdElement != nil {
+		r.TargetIdElement.validateAll(path+".targetId", issues)
+	}
+	if r.Relationship == "" {
+		issues.add("required", path+".relationship", "field 'Relationship' is required")
+	}
+	if r.Relationship != "" && !r.Relationship.IsValid() {
+		issues.add("code-invalid", path+".relationship", fmt.Sprintf("field 'Relationship' has invalid code '%s'", r.Relationship))
+	}
+	if r.RelationshipElement != nil {
+		r.RelationshipElement.validateAll(path+".relationship", issues)
+	}
+	if r.EndRelationship != nil && !r.EndRelationship.IsValid() {
+		issues.add("code-invalid", path+".endRelationship", fmt.Sprintf("field 'EndRelationship' has invalid code '%s'", *r.EndRelationship))
+	}
+	if r.EndRelationshipElement != nil {
+		r.EndRelationshipElement.validateAll(path+".endRelationship", issues)
+	}
+	if len(r.offsetVariants) > 1 {
+		issues.add("structure", path+".offset", fmt.Sprintf("field 'Offset' must have a single type, got %v", r.offsetVariants))
+	}
+	if r.Offset != nil {
+		r.Offset.validateAll(path+".offset.ofType("+r.Offset.FHIRType()+")", issues)
+	}
+}
+
+var requestOrchestrationActionRelatedActionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationActionRelatedAction) invariants() []invariant {
+	return requestOrchestrationActionRelatedActionInvariants
+}
+
+func (r RequestOrchestrationActionRelatedAction) MarshalJSON() ([]byte, error) {
+	type alias RequestOrchestrationActionRelatedAction
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "offset", r.Offset, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RequestOrchestrationActionRelatedAction) UnmarshalJSON(data []byte) error {
+	type alias RequestOrchestrationActionRelatedAction
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Offset, _, r.offsetVariants, err = unmarshalChoice(fields, "offset", requestOrchestrationActionRelatedActionOffsetVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r RequestOrchestrationActionRelatedAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationActionRelatedAction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationActionRelatedAction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationActionRelatedAction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RequestOrchestrationActionRelatedAction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Offset":
+		return "offset", choiceValues(requestOrchestrationActionRelatedActionOffsetVariants)
+	}
+	return "", nil
+}
+
+var requestOrchestrationActionRelatedActionElementTypes = map[string]string{
+	"targetId": "id",
+}
+
+func (r *RequestOrchestrationActionRelatedAction) elementTypes() map[string]string {
+	return requestOrchestrationActionRelatedActionElementTypes
+}
+
+var requestOrchestrationActionRelatedActionElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.relatedAction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.relatedAction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.relatedAction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.relatedAction.targetId", Name: "targetId", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "RequestOrchestration.action.relatedAction.relationship", Name: "relationship", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.relatedAction.relationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"}},
+	{Path: "RequestOrchestration.action.relatedAction.endRelationship", Name: "endRelationship", Field: 7, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.relatedAction.endRelationship", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-relationship-type"}},
+	{Path: "RequestOrchestration.action.relatedAction.offset[x]", Name: "offset", Field: 9, Min: 0, Max: "1", Types: []string{"Duration", "Range"}},
+}
+
+func (r *RequestOrchestrationActionRelatedAction) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionRelatedActionElementMetadata
+}
+
+// RequestOrchestrationActionRelatedActionOffset is the type of RequestOrchestration.action.relatedAction.offset[x]. It is implemented by the RequestOrchestrationActionRelatedActionOffset* variant types.
+type RequestOrchestrationActionRelatedActionOffset interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRequestOrchestrationActionRelatedActionOffset()
+}
+
+// RequestOrchestrationActionRelatedActionOffsetDuration is the Duration variant of RequestOrchestrationActionRelatedActionOffset.
+type RequestOrchestrationActionRelatedActionOffsetDuration struct {
+	Duration
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetDuration) isRequestOrchestrationActionRelatedActionOffset() {
+}
+
+// RequestOrchestrationActionRelatedActionOffsetRange is the Range variant of RequestOrchestrationActionRelatedActionOffset.
+type RequestOrchestrationActionRelatedActionOffsetRange struct {
+	Range
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetRange) FHIRType() string {
+	return "Range"
+}
+
+func (v RequestOrchestrationActionRelatedActionOffsetRange) isRequestOrchestrationActionRelatedActionOffset() {
+}
+
+var requestOrchestrationActionRelatedActionOffsetVariants = []RequestOrchestrationActionRelatedActionOffset{
+	RequestOrchestrationActionRelatedActionOffsetDuration{},
+	RequestOrchestrationActionRelatedActionOffsetRange{},
+}
+
+type RequestOrchestrationActionParticipant struct {
+	Id                *string                                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *ActionParticipantType                          `json:"type,omitempty" bson:"type,omitempty"`                            // careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
+	TypeElement       *Element                                        `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	TypeChoice        RequestOrchestrationActionParticipantTypeChoice `json:"-" bson:"type_choice,omitempty"`                                  // Who or what can participate
+	TypeChoiceElement *Element                                        `json:"-" bson:"type_choice_element,omitempty"`                          // Extensions for type[x]
+	Role              *CodeableConcept                                `json:"role,omitempty" bson:"role,omitempty"`                            // E.g. Nurse, Surgeon, Parent, etc
+	Function          *CodeableConcept                                `json:"function,omitempty" bson:"function,omitempty"`                    // E.g. Author, Reviewer, Witness, etc
+	Actor             RequestOrchestrationActionParticipantActor      `json:"-" bson:"actor,omitempty"`                                        // Who/what is participating?
+	ActorElement      *Element                                        `json:"-" bson:"actor_element,omitempty"`                                // Extensions for actor[x]
+
+	typeChoiceVariants []string // JSON properties of RequestOrchestration.action.participant.type[x] when more than one was decoded
+	actorVariants      []string // JSON properties of RequestOrchestration.action.participant.actor[x] when more than one was decoded
+}
+
+func (r *RequestOrchestrationActionParticipant) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", *r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		return fmt.Errorf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants)
+	}
+	if r.TypeChoice != nil {
+		if err := r.TypeChoice.Validate(); err != nil {
+			return fmt.Errorf("TypeChoice: %w", err)
+		}
+	}
+	if r.TypeChoiceElement != nil {
+		if err := r.TypeChoiceElement.Validate(); err != nil {
+			return fmt.Errorf("TypeChoiceElement: %w", err)
+		}
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	if r.Function != nil {
+		if err := r.Function.Validate(); err != nil {
+			return fmt.Errorf("Function: %w", err)
+		}
+	}
+	if len(r.actorVariants) > 1 {
+		return fmt.Errorf("field 'Actor' must have a single type, got %v", r.actorVariants)
+	}
+	if r.Actor != nil {
+		if err := r.Actor.Validate(); err != nil {
+			return fmt.Errorf("Actor: %w", err)
+		}
+	}
+	if r.ActorElement != nil {
+		if err := r.ActorElement.Validate(); err != nil {
+			return fmt.Errorf("ActorElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RequestOrchestrationActionParticipant) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", *r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if len(r.typeChoiceVariants) > 1 {
+		issues.add("structure", path+".type", fmt.Sprintf("field 'TypeChoice' must have a single type, got %v", r.typeChoiceVariants))
+	}
+	if r.TypeChoice != nil {
+		r.TypeChoice.validateAll(path+".type.ofType("+r.TypeChoice.FHIRType()+")", issues)
+	}
+	if r.TypeChoiceElement != nil {
+		r.TypeChoiceElement.validateAll(path+".type", issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
+	}
+	if len(r.actorVariants) > 1 {
+		issues.add("structure", path+".actor", fmt.Sprintf("field 'Actor' must have a single type, got %v", r.actorVariants))
+	}
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor.ofType("+r.Actor.FHIRType()+")", issues)
+	}
+	if r.ActorElement != nil {
+		r.ActorElement.validateAll(path+".actor", issues)
+	}
+}
+
+var requestOrchestrationActionParticipantInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationActionParticipant) invariants() []invariant {
+	return requestOrchestrationActionParticipantInvariants
+}
+
+func (r RequestOrchestrationActionParticipant) MarshalJSON() ([]byte, error) {
+	type alias RequestOrchestrationActionParticipant
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "type", r.TypeChoice, r.TypeChoiceElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "actor", r.Actor, r.ActorElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RequestOrchestrationActionParticipant) UnmarshalJSON(data []byte) error {
+	type alias RequestOrchestrationActionParticipant
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.TypeChoice, element, r.typeChoiceVariants, err = unmarshalChoice(fields, "type", requestOrchestrationActionParticipantTypeChoiceVariants); err != nil {
+		return err
+	}
+	r.TypeChoiceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TypeChoiceElement); err != nil {
+			return fmt.Errorf("_type: %w", err)
+		}
+	}
+	if r.Actor, element, r.actorVariants, err = unmarshalChoice(fields, "actor", requestOrchestrationActionParticipantActorVariants); err != nil {
+		return err
+	}
+	r.ActorElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ActorElement); err != nil {
+			return fmt.Errorf("_actor: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r RequestOrchestrationActionParticipant) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationActionParticipant) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationActionParticipant) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationActionParticipant) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RequestOrchestrationActionParticipant) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "TypeChoice":
+		return "type", choiceValues(requestOrchestrationActionParticipantTypeChoiceVariants)
+	case "Actor":
+		return "actor", choiceValues(requestOrchestrationActionParticipantActorVariants)
+	}
+	return "", nil
+}
+
+var requestOrchestrationActionParticipantElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.participant.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.participant.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.participant.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.participant.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RequestOrchestration.action.participant.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/action-participant-type"}},
+	{Path: "RequestOrchestration.action.participant.type[x]", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"canonical", "Reference"}},
+	{Path: "RequestOrchestration.action.participant.role", Name: "role", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RequestOrchestration.action.participant.function", Name: "function", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RequestOrchestration.action.participant.actor[x]", Name: "actor", Field: 9, Min: 0, Max: "1", Types: []string{"canonical", "Reference"}},
+}
+
+func (r *RequestOrchestrationActionParticipant) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionParticipantElementMetadata
+}
+
+// RequestOrchestrationActionParticipantTypeChoice is the type of RequestOrchestration.action.participant.type[x]. It is implemented by the RequestOrchestrationActionParticipantTypeChoice* variant types.
+type RequestOrchestrationActionParticipantTypeChoice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRequestOrchestrationActionParticipantTypeChoice()
+}
+
+// RequestOrchestrationActionParticipantTypeChoiceCanonical is the canonical variant of RequestOrchestrationActionParticipantTypeChoice.
+type RequestOrchestrationActionParticipantTypeChoiceCanonical string
+
+func (v RequestOrchestrationActionParticipantTypeChoiceCanonical) Validate() error {
+	return nil
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceCanonical) isRequestOrchestrationActionParticipantTypeChoice() {
+}
+
+// RequestOrchestrationActionParticipantTypeChoiceReference is the Reference variant of RequestOrchestrationActionParticipantTypeChoice.
+type RequestOrchestrationActionParticipantTypeChoiceReference struct {
+	Reference
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v RequestOrchestrationActionParticipantTypeChoiceReference) isRequestOrchestrationActionParticipantTypeChoice() {
+}
+
+var requestOrchestrationActionParticipantTypeChoiceVariants = []RequestOrchestrationActionParticipantTypeChoice{
+	RequestOrchestrationActionParticipantTypeChoiceCanonical(""),
+	RequestOrchestrationActionParticipantTypeChoiceReference{},
+}
+
+// RequestOrchestrationActionParticipantActor is the type of RequestOrchestration.action.participant.actor[x]. It is implemented by the RequestOrchestrationActionParticipantActor* variant types.
+type RequestOrchestrationActionParticipantActor interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRequestOrchestrationActionParticipantActor()
+}
+
+// RequestOrchestrationActionParticipantActorCanonical is the canonical variant of RequestOrchestrationActionParticipantActor.
+type RequestOrchestrationActionParticipantActorCanonical string
+
+func (v RequestOrchestrationActionParticipantActorCanonical) Validate() error {
+	return nil
+}
+
+func (v RequestOrchestrationActionParticipantActorCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v RequestOrchestrationActionParticipantActorCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v RequestOrchestrationActionParticipantActorCanonical) isRequestOrchestrationActionParticipantActor() {
+}
+
+// RequestOrchestrationActionParticipantActorReference is the Reference variant of RequestOrchestrationActionParticipantActor.
+type RequestOrchestrationActionParticipantActorReference struct {
+	Reference
+}
+
+func (v RequestOrchestrationActionParticipantActorReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v RequestOrchestrationActionParticipantActorReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v RequestOrchestrationActionParticipantActorReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v RequestOrchestrationActionParticipantActorReference) isRequestOrchestrationActionParticipantActor() {
+}
+
+var requestOrchestrationActionParticipantActorVariants = []RequestOrchestrationActionParticipantActor{
+	RequestOrchestrationActionParticipantActorCanonical(""),
+	RequestOrchestrationActionParticipantActorReference{},
+}
+
 type RequestOrchestrationActionDynamicValue struct {
-	Id         *string     `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Path       *string     `json:"path,omitempty" bson:"path,omitempty"`             // The path to the element to be set dynamically
-	Expression *Expression `json:"expression,omitempty" bson:"expression,omitempty"` // An expression that provides the dynamic value for the customization
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Path              *string     `json:"path,omitempty" bson:"path,omitempty"`                            // The path to the element to be set dynamically
+	PathElement       *Element    `json:"_path,omitempty" bson:"path_element,omitempty"`                   // Extensions for path
+	Expression        *Expression `json:"expression,omitempty" bson:"expression,omitempty"`                // An expression that provides the dynamic value for the customization
 }
 
 func (r *RequestOrchestrationActionDynamicValue) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.PathElement != nil {
+		if err := r.PathElement.Validate(); err != nil {
+			return fmt.Errorf("PathElement: %w", err)
+		}
+	}
 	if r.Expression != nil {
 		if err := r.Expression.Validate(); err != nil {
 			return fmt.Errorf("Expression: %w", err)
@@ -386,3 +2102,54 @@ func (r *RequestOrchestrationActionDynamicValue) Validate() error {
 	}
 	return nil
 }
+
+func (r *RequestOrchestrationActionDynamicValue) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.PathElement != nil {
+		r.PathElement.validateAll(path+".path", issues)
+	}
+	if r.Expression != nil {
+		r.Expression.validateAll(path+".expression", issues)
+	}
+}
+
+var requestOrchestrationActionDynamicValueInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequestOrchestrationActionDynamicValue) invariants() []invariant {
+	return requestOrchestrationActionDynamicValueInvariants
+}
+
+func (r RequestOrchestrationActionDynamicValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequestOrchestrationActionDynamicValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequestOrchestrationActionDynamicValue) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequestOrchestrationActionDynamicValue) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requestOrchestrationActionDynamicValueElementMetadata = []ElementMetadata{
+	{Path: "RequestOrchestration.action.dynamicValue.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.dynamicValue.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RequestOrchestration.action.dynamicValue.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RequestOrchestration.action.dynamicValue.path", Name: "path", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RequestOrchestration.action.dynamicValue.expression", Name: "expression", Field: 5, Min: 0, Max: "1", Types: []string{"Expression"}},
+}
+
+func (r *RequestOrchestrationActionDynamicValue) ElementMetadata() []ElementMetadata {
+	return requestOrchestrationActionDynamicValueElementMetadata
+}
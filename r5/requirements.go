@@ -2,41 +2,62 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The Requirements resource is used to describe an actor - a human or an application that plays a role in data exchange, and that may have obligations associated with the role the actor plays.
 type Requirements struct {
-	ResourceType           string                  `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                 `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                 `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                 `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this Requirements, represented as a URI (globally unique)
-	Identifier             []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the Requirements (business identifier)
-	Version                *string                 `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the Requirements
-	VersionAlgorithmString *string                 `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                 `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                 `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this Requirements (computer friendly)
-	Title                  *string                 `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this Requirements (human friendly)
-	Status                 string                  `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                   `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                 `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                 `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail         `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                 `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the requirements
-	UseContext             []UsageContext          `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept       `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the Requirements (if applicable)
-	Purpose                *string                 `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this Requirements is defined
-	Copyright              *string                 `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                 `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	DerivedFrom            []string                `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                        // Other set of Requirements this builds on
-	Imports                []RequirementsImports   `json:"imports,omitempty" bson:"imports,omitempty"`                                 // External requirements that apply here
-	Reference              []string                `json:"reference,omitempty" bson:"reference,omitempty"`                             // External artifact (rule/document etc. that) created this set of requirements
-	Actor                  []RequirementsActor     `json:"actor,omitempty" bson:"actor,omitempty"`                                     // Actor for these requirements
-	Statement              []RequirementsStatement `json:"statement,omitempty" bson:"statement,omitempty"`                             // Conformance requirement statement
+	ResourceType            string                       `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                      `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                      `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     *string                      `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this Requirements, represented as a URI (globally unique)
+	UrlElement              *Element                     `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the Requirements (business identifier)
+	Version                 *string                      `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the Requirements
+	VersionElement          *Element                     `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        RequirementsVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                     `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    *string                      `json:"name,omitempty" bson:"name,omitempty"`                               // Name for this Requirements (computer friendly)
+	NameElement             *Element                     `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                      `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this Requirements (human friendly)
+	TitleElement            *Element                     `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus            `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                        `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                     `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                    `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                     `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                      `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                     `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail              `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                      `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the requirements
+	DescriptionElement      *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext               `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept            `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the Requirements (if applicable)
+	Purpose                 *string                      `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this Requirements is defined
+	PurposeElement          *Element                     `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                      `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                     `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                      `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                     `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	DerivedFrom             []string                     `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                // Other set of Requirements this builds on
+	DerivedFromElement      []*Element                   `json:"_derivedFrom,omitempty" bson:"derived_from_element,omitempty"`       // Extensions for derivedFrom
+	Imports                 []RequirementsImports        `json:"imports,omitempty" bson:"imports,omitempty"`                         // External requirements that apply here
+	Reference               []string                     `json:"reference,omitempty" bson:"reference,omitempty"`                     // External artifact (rule/document etc. that) created this set of requirements
+	ReferenceElement        []*Element                   `json:"_reference,omitempty" bson:"reference_element,omitempty"`            // Extensions for reference
+	Actor                   []RequirementsActor          `json:"actor,omitempty" bson:"actor,omitempty"`                             // Actor for these requirements
+	Statement               []RequirementsStatement      `json:"statement,omitempty" bson:"statement,omitempty"`                     // Conformance requirement statement
+
+	versionAlgorithmVariants []string // JSON properties of Requirements.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *Requirements) Validate() error {
@@ -48,30 +69,118 @@ func (r *Requirements) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -82,11 +191,42 @@ func (r *Requirements) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	for i, item := range r.DerivedFromElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Imports {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Imports[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ReferenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Actor {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Actor[%d]: %w", i, err)
@@ -97,61 +237,690 @@ func (r *Requirements) Validate() error {
 			return fmt.Errorf("Statement[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Requirements")
+}
+
+func (r *Requirements) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Requirements" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Requirements', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	for i, item := range r.DerivedFromElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
+	}
+	for i, item := range r.Imports {
+		item.validateAll(fmt.Sprintf("%s.imports[%d]", path, i), issues)
+	}
+	for i, item := range r.ReferenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.reference[%d]", path, i), issues)
+	}
+	for i, item := range r.Actor {
+		item.validateAll(fmt.Sprintf("%s.actor[%d]", path, i), issues)
+	}
+	for i, item := range r.Statement {
+		item.validateAll(fmt.Sprintf("%s.statement[%d]", path, i), issues)
+	}
+}
+
+func (r *Requirements) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Requirements", &issues)
+	checkInvariants(r, "Requirements", &issues)
+	return issues
+}
+
+var requirementsInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Requirements) invariants() []invariant {
+	return requirementsInvariants
+}
+
+func (r Requirements) MarshalJSON() ([]byte, error) {
+	type alias Requirements
+	out := struct {
+		alias
+		DerivedFrom        []*string  `json:"derivedFrom,omitempty"`
+		DerivedFromElement []*Element `json:"_derivedFrom,omitempty"`
+		Reference          []*string  `json:"reference,omitempty"`
+		ReferenceElement   []*Element `json:"_reference,omitempty"`
+	}{alias: alias(r)}
+	out.DerivedFrom, out.DerivedFromElement = alignPrimitiveArray(r.DerivedFrom, r.DerivedFromElement)
+	out.Reference, out.ReferenceElement = alignPrimitiveArray(r.Reference, r.ReferenceElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *Requirements) UnmarshalJSON(data []byte) error {
+	type alias Requirements
+	aux := struct {
+		*alias
+		Contained   []json.RawMessage `json:"contained,omitempty"`
+		DerivedFrom []*string         `json:"derivedFrom,omitempty"`
+		Reference   []*string         `json:"reference,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.DerivedFrom, err = splitPrimitiveArray(aux.DerivedFrom, r.DerivedFromElement); err != nil {
+		return fmt.Errorf("derivedFrom: %w", err)
+	}
+	if r.Reference, err = splitPrimitiveArray(aux.Reference, r.ReferenceElement); err != nil {
+		return fmt.Errorf("reference: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", requirementsVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r Requirements) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Requirements) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Requirements) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Requirements) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *Requirements) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(requirementsVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var requirementsElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"derivedFrom":   "canonical",
+	"reference":     "url",
+}
+
+func (r *Requirements) elementTypes() map[string]string {
+	return requirementsElementTypes
+}
+
+var requirementsElementMetadata = []ElementMetadata{
+	{Path: "Requirements.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Requirements.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Requirements.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Requirements.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Requirements.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Requirements.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Requirements.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Requirements.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Requirements.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Requirements.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Requirements.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Requirements.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "Requirements.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Requirements.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "Requirements.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Requirements.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Requirements.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "Requirements.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Requirements.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "Requirements.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Requirements.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Requirements.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Requirements.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.derivedFrom", Name: "derivedFrom", Field: 41, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "Requirements.imports", Name: "imports", Field: 43, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Requirements.reference", Name: "reference", Field: 44, Min: 0, Max: "*", Types: []string{"url"}},
+	{Path: "Requirements.actor", Name: "actor", Field: 46, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Requirements.statement", Name: "statement", Field: 47, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Requirements) ElementMetadata() []ElementMetadata {
+	return requirementsElementMetadata
+}
+
+// RequirementsVersionAlgorithm is the type of Requirements.versionAlgorithm[x]. It is implemented by the RequirementsVersionAlgorithm* variant types.
+type RequirementsVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRequirementsVersionAlgorithm()
+}
+
+// RequirementsVersionAlgorithmString is the string variant of RequirementsVersionAlgorithm.
+type RequirementsVersionAlgorithmString string
+
+func (v RequirementsVersionAlgorithmString) Validate() error {
 	return nil
 }
 
+func (v RequirementsVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v RequirementsVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v RequirementsVersionAlgorithmString) isRequirementsVersionAlgorithm() {}
+
+// RequirementsVersionAlgorithmCoding is the Coding variant of RequirementsVersionAlgorithm.
+type RequirementsVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v RequirementsVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v RequirementsVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v RequirementsVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v RequirementsVersionAlgorithmCoding) isRequirementsVersionAlgorithm() {}
+
+var requirementsVersionAlgorithmVariants = []RequirementsVersionAlgorithm{
+	RequirementsVersionAlgorithmString(""),
+	RequirementsVersionAlgorithmCoding{},
+}
+
+func (r *Requirements) GetResourceType() string {
+	return "Requirements"
+}
+
+func (r *Requirements) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Requirements) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Requirements) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Requirements) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Requirements) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Requirements) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Requirements) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Requirements)(nil)
+
 type RequirementsImports struct {
-	Id        *string  `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Reference string   `json:"reference" bson:"reference"`         // Source of imported statements
-	Key       []string `json:"key,omitempty" bson:"key,omitempty"` // Statement key
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         string      `json:"reference" bson:"reference"`                                      // Source of imported statements
+	ReferenceElement  *Element    `json:"_reference,omitempty" bson:"reference_element,omitempty"`         // Extensions for reference
+	Key               []string    `json:"key,omitempty" bson:"key,omitempty"`                              // Statement key
+	KeyElement        []*Element  `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
 }
 
 func (r *RequirementsImports) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Reference == emptyString {
 		return fmt.Errorf("field 'Reference' is required")
 	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
+	for i, item := range r.KeyElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("KeyElement[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
+func (r *RequirementsImports) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Reference == emptyString {
+		issues.add("required", path+".reference", "field 'Reference' is required")
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+	for i, item := range r.KeyElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.key[%d]", path, i), issues)
+	}
+}
+
+var requirementsImportsInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequirementsImports) invariants() []invariant {
+	return requirementsImportsInvariants
+}
+
+func (r RequirementsImports) MarshalJSON() ([]byte, error) {
+	type alias RequirementsImports
+	out := struct {
+		alias
+		Key        []*string  `json:"key,omitempty"`
+		KeyElement []*Element `json:"_key,omitempty"`
+	}{alias: alias(r)}
+	out.Key, out.KeyElement = alignPrimitiveArray(r.Key, r.KeyElement)
+	return json.Marshal(out)
+}
+
+func (r *RequirementsImports) UnmarshalJSON(data []byte) error {
+	type alias RequirementsImports
+	aux := struct {
+		*alias
+		Key []*string `json:"key,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Key, err = splitPrimitiveArray(aux.Key, r.KeyElement); err != nil {
+		return fmt.Errorf("key: %w", err)
+	}
+	return nil
+}
+
+func (r RequirementsImports) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequirementsImports) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequirementsImports) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequirementsImports) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requirementsImportsElementTypes = map[string]string{
+	"reference": "canonical",
+	"key":       "id",
+}
+
+func (r *RequirementsImports) elementTypes() map[string]string {
+	return requirementsImportsElementTypes
+}
+
+var requirementsImportsElementMetadata = []ElementMetadata{
+	{Path: "Requirements.imports.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.imports.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Requirements.imports.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Requirements.imports.reference", Name: "reference", Field: 3, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "Requirements.imports.key", Name: "key", Field: 5, Min: 0, Max: "*", Types: []string{"id"}},
+}
+
+func (r *RequirementsImports) ElementMetadata() []ElementMetadata {
+	return requirementsImportsElementMetadata
+}
+
 type RequirementsActor struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Reference string  `json:"reference" bson:"reference"`         // Actor referenced
-	Key       *string `json:"key,omitempty" bson:"key,omitempty"` // Unique label for actor (used in statements)
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         string      `json:"reference" bson:"reference"`                                      // Actor referenced
+	ReferenceElement  *Element    `json:"_reference,omitempty" bson:"reference_element,omitempty"`         // Extensions for reference
+	Key               *string     `json:"key,omitempty" bson:"key,omitempty"`                              // Unique label for actor (used in statements)
+	KeyElement        *Element    `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
 }
 
 func (r *RequirementsActor) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Reference == emptyString {
 		return fmt.Errorf("field 'Reference' is required")
 	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *RequirementsActor) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Reference == emptyString {
+		issues.add("required", path+".reference", "field 'Reference' is required")
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+}
+
+var requirementsActorInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequirementsActor) invariants() []invariant {
+	return requirementsActorInvariants
+}
+
+func (r RequirementsActor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequirementsActor) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequirementsActor) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequirementsActor) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requirementsActorElementTypes = map[string]string{
+	"reference": "canonical",
+	"key":       "id",
+}
+
+func (r *RequirementsActor) elementTypes() map[string]string {
+	return requirementsActorElementTypes
+}
+
+var requirementsActorElementMetadata = []ElementMetadata{
+	{Path: "Requirements.actor.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.actor.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Requirements.actor.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Requirements.actor.reference", Name: "reference", Field: 3, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "Requirements.actor.key", Name: "key", Field: 5, Min: 0, Max: "1", Types: []string{"id"}},
+}
+
+func (r *RequirementsActor) ElementMetadata() []ElementMetadata {
+	return requirementsActorElementMetadata
+}
+
 type RequirementsStatement struct {
-	Id             *string                           `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Key            string                            `json:"key" bson:"key"`                                           // Key that identifies this statement
-	Label          *string                           `json:"label,omitempty" bson:"label,omitempty"`                   // Short Human label for this statement
-	Conformance    []string                          `json:"conformance,omitempty" bson:"conformance,omitempty"`       // SHALL | SHOULD | MAY | SHOULD-NOT | SHALL-NOT
-	Conditionality *bool                             `json:"conditionality,omitempty" bson:"conditionality,omitempty"` // Set to true if requirements statement is conditional
-	Requirement    string                            `json:"requirement" bson:"requirement"`                           // The actual requirement
-	Category       []Coding                          `json:"category,omitempty" bson:"category,omitempty"`             // High level categorization of a statement
-	DerivedFrom    *RequirementsStatementDerivedFrom `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`      // Another statement this is refining, tightening, or establishing more context for
-	PartOf         *RequirementsStatementPartOf      `json:"partOf,omitempty" bson:"part_of,omitempty"`                // Higher-level requirement or statement which this is a logical sub-requirement of
-	SatisfiedBy    []string                          `json:"satisfiedBy,omitempty" bson:"satisfied_by,omitempty"`      // Design artifact that satisfies this requirement
-	Reference      []string                          `json:"reference,omitempty" bson:"reference,omitempty"`           // External artifact (rule/document etc. that) created this requirement
-	Source         []Reference                       `json:"source,omitempty" bson:"source,omitempty"`                 // Who asked for this statement
-	Actor          []string                          `json:"actor,omitempty" bson:"actor,omitempty"`                   // Key of relevant actor
+	Id                    *string                           `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
+	Extension             []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension     []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored even if unrecognized
+	Key                   string                            `json:"key" bson:"key"`                                                    // Key that identifies this statement
+	KeyElement            *Element                          `json:"_key,omitempty" bson:"key_element,omitempty"`                       // Extensions for key
+	Label                 *string                           `json:"label,omitempty" bson:"label,omitempty"`                            // Short Human label for this statement
+	LabelElement          *Element                          `json:"_label,omitempty" bson:"label_element,omitempty"`                   // Extensions for label
+	Conformance           []ConformanceExpectation          `json:"conformance,omitempty" bson:"conformance,omitempty"`                // SHALL | SHOULD | MAY | SHOULD-NOT | SHALL-NOT
+	ConformanceElement    []*Element                        `json:"_conformance,omitempty" bson:"conformance_element,omitempty"`       // Extensions for conformance
+	Conditionality        *bool                             `json:"conditionality,omitempty" bson:"conditionality,omitempty"`          // Set to true if requirements statement is conditional
+	ConditionalityElement *Element                          `json:"_conditionality,omitempty" bson:"conditionality_element,omitempty"` // Extensions for conditionality
+	Requirement           string                            `json:"requirement" bson:"requirement"`                                    // The actual requirement
+	RequirementElement    *Element                          `json:"_requirement,omitempty" bson:"requirement_element,omitempty"`       // Extensions for requirement
+	Category              []Coding                          `json:"category,omitempty" bson:"category,omitempty"`                      // High level categorization of a statement
+	DerivedFrom           *RequirementsStatementDerivedFrom `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`               // Another statement this is refining, tightening, or establishing more context for
+	PartOf                *RequirementsStatementPartOf      `json:"partOf,omitempty" bson:"part_of,omitempty"`                         // Higher-level requirement or statement which this is a logical sub-requirement of
+	SatisfiedBy           []string                          `json:"satisfiedBy,omitempty" bson:"satisfied_by,omitempty"`               // Design artifact that satisfies this requirement
+	SatisfiedByElement    []*Element                        `json:"_satisfiedBy,omitempty" bson:"satisfied_by_element,omitempty"`      // Extensions for satisfiedBy
+	Reference             []string                          `json:"reference,omitempty" bson:"reference,omitempty"`                    // External artifact (rule/document etc. that) created this requirement
+	ReferenceElement      []*Element                        `json:"_reference,omitempty" bson:"reference_element,omitempty"`           // Extensions for reference
+	Source                []Reference                       `json:"source,omitempty" bson:"source,omitempty"`                          // Who asked for this statement
+	Actor                 []string                          `json:"actor,omitempty" bson:"actor,omitempty"`                            // Key of relevant actor
+	ActorElement          []*Element                        `json:"_actor,omitempty" bson:"actor_element,omitempty"`                   // Extensions for actor
 }
 
 func (r *RequirementsStatement) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Key == emptyString {
 		return fmt.Errorf("field 'Key' is required")
 	}
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
+	}
+	if r.LabelElement != nil {
+		if err := r.LabelElement.Validate(); err != nil {
+			return fmt.Errorf("LabelElement: %w", err)
+		}
+	}
+	for i, item := range r.Conformance {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Conformance[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ConformanceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ConformanceElement[%d]: %w", i, err)
+		}
+	}
+	if r.ConditionalityElement != nil {
+		if err := r.ConditionalityElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionalityElement: %w", err)
+		}
+	}
 	if r.Requirement == emptyString {
 		return fmt.Errorf("field 'Requirement' is required")
 	}
+	if r.RequirementElement != nil {
+		if err := r.RequirementElement.Validate(); err != nil {
+			return fmt.Errorf("RequirementElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -167,38 +936,413 @@ func (r *RequirementsStatement) Validate() error {
 			return fmt.Errorf("PartOf: %w", err)
 		}
 	}
+	for i, item := range r.SatisfiedByElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SatisfiedByElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ReferenceElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Source {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Source[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ActorElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ActorElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *RequirementsStatement) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+	if r.LabelElement != nil {
+		r.LabelElement.validateAll(path+".label", issues)
+	}
+	for i, item := range r.Conformance {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.conformance[%d]", path, i), fmt.Sprintf("field 'Conformance[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ConformanceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.conformance[%d]", path, i), issues)
+	}
+	if r.ConditionalityElement != nil {
+		r.ConditionalityElement.validateAll(path+".conditionality", issues)
+	}
+	if r.Requirement == emptyString {
+		issues.add("required", path+".requirement", "field 'Requirement' is required")
+	}
+	if r.RequirementElement != nil {
+		r.RequirementElement.validateAll(path+".requirement", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.DerivedFrom != nil {
+		r.DerivedFrom.validateAll(path+".derivedFrom", issues)
+	}
+	if r.PartOf != nil {
+		r.PartOf.validateAll(path+".partOf", issues)
+	}
+	for i, item := range r.SatisfiedByElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.satisfiedBy[%d]", path, i), issues)
+	}
+	for i, item := range r.ReferenceElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.reference[%d]", path, i), issues)
+	}
+	for i, item := range r.Source {
+		item.validateAll(fmt.Sprintf("%s.source[%d]", path, i), issues)
+	}
+	for i, item := range r.ActorElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.actor[%d]", path, i), issues)
+	}
+}
+
+var requirementsStatementInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequirementsStatement) invariants() []invariant {
+	return requirementsStatementInvariants
+}
+
+func (r RequirementsStatement) MarshalJSON() ([]byte, error) {
+	type alias RequirementsStatement
+	out := struct {
+		alias
+		Conformance        []*ConformanceExpectation `json:"conformance,omitempty"`
+		ConformanceElement []*Element                `json:"_conformance,omitempty"`
+		SatisfiedBy        []*string                 `json:"satisfiedBy,omitempty"`
+		SatisfiedByElement []*Element                `json:"_satisfiedBy,omitempty"`
+		Reference          []*string                 `json:"reference,omitempty"`
+		ReferenceElement   []*Element                `json:"_reference,omitempty"`
+		Actor              []*string                 `json:"actor,omitempty"`
+		ActorElement       []*Element                `json:"_actor,omitempty"`
+	}{alias: alias(r)}
+	out.Conformance, out.ConformanceElement = alignPrimitiveArray(r.Conformance, r.ConformanceElement)
+	out.SatisfiedBy, out.SatisfiedByElement = alignPrimitiveArray(r.SatisfiedBy, r.SatisfiedByElement)
+	out.Reference, out.ReferenceElement = alignPrimitiveArray(r.Reference, r.ReferenceElement)
+	out.Actor, out.ActorElement = alignPrimitiveArray(r.Actor, r.ActorElement)
+	return json.Marshal(out)
+}
+
+func (r *RequirementsStatement) UnmarshalJSON(data []byte) error {
+	type alias RequirementsStatement
+	aux := struct {
+		*alias
+		Conformance []*ConformanceExpectation `json:"conformance,omitempty"`
+		SatisfiedBy []*string                 `json:"satisfiedBy,omitempty"`
+		Reference   []*string                 `json:"reference,omitempty"`
+		Actor       []*string                 `json:"actor,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Conformance, err = splitPrimitiveArray(aux.Conformance, r.ConformanceElement); err != nil {
+		return fmt.Errorf("conformance: %w", err)
+	}
+	if r.SatisfiedBy, err = splitPrimitiveArray(aux.SatisfiedBy, r.SatisfiedByElement); err != nil {
+		return fmt.Errorf("satisfiedBy: %w", err)
+	}
+	if r.Reference, err = splitPrimitiveArray(aux.Reference, r.ReferenceElement); err != nil {
+		return fmt.Errorf("reference: %w", err)
+	}
+	if r.Actor, err = splitPrimitiveArray(aux.Actor, r.ActorElement); err != nil {
+		return fmt.Errorf("actor: %w", err)
+	}
 	return nil
 }
 
+func (r RequirementsStatement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequirementsStatement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequirementsStatement) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequirementsStatement) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requirementsStatementElementTypes = map[string]string{
+	"key":         "id",
+	"requirement": "markdown",
+	"satisfiedBy": "url",
+	"reference":   "url",
+	"actor":       "id",
+}
+
+func (r *RequirementsStatement) elementTypes() map[string]string {
+	return requirementsStatementElementTypes
+}
+
+var requirementsStatementElementMetadata = []ElementMetadata{
+	{Path: "Requirements.statement.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.statement.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Requirements.statement.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Requirements.statement.key", Name: "key", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "Requirements.statement.label", Name: "label", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.statement.conformance", Name: "conformance", Field: 7, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Requirements.statement.conformance", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/conformance-expectation"}},
+	{Path: "Requirements.statement.conditionality", Name: "conditionality", Field: 9, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Requirements.statement.requirement", Name: "requirement", Field: 11, Min: 1, Max: "1", Types: []string{"markdown"}},
+	{Path: "Requirements.statement.category", Name: "category", Field: 13, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "Requirements.statement.derivedFrom", Name: "derivedFrom", Field: 14, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Requirements.statement.partOf", Name: "partOf", Field: 15, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Requirements.statement.satisfiedBy", Name: "satisfiedBy", Field: 16, Min: 0, Max: "*", Types: []string{"url"}},
+	{Path: "Requirements.statement.reference", Name: "reference", Field: 18, Min: 0, Max: "*", Types: []string{"url"}},
+	{Path: "Requirements.statement.source", Name: "source", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Requirements.statement.actor", Name: "actor", Field: 21, Min: 0, Max: "*", Types: []string{"id"}},
+}
+
+func (r *RequirementsStatement) ElementMetadata() []ElementMetadata {
+	return requirementsStatementElementMetadata
+}
+
 type RequirementsStatementDerivedFrom struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Reference *string `json:"reference,omitempty" bson:"reference,omitempty"` // Pointer to Requirements instance
-	Key       string  `json:"key" bson:"key"`                                 // Key of referenced statement
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         *string     `json:"reference,omitempty" bson:"reference,omitempty"`                  // Pointer to Requirements instance
+	ReferenceElement  *Element    `json:"_reference,omitempty" bson:"reference_element,omitempty"`         // Extensions for reference
+	Key               string      `json:"key" bson:"key"`                                                  // Key of referenced statement
+	KeyElement        *Element    `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
 }
 
 func (r *RequirementsStatementDerivedFrom) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.Key == emptyString {
 		return fmt.Errorf("field 'Key' is required")
 	}
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *RequirementsStatementDerivedFrom) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+}
+
+var requirementsStatementDerivedFromInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequirementsStatementDerivedFrom) invariants() []invariant {
+	return requirementsStatementDerivedFromInvariants
+}
+
+func (r RequirementsStatementDerivedFrom) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequirementsStatementDerivedFrom) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequirementsStatementDerivedFrom) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequirementsStatementDerivedFrom) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requirementsStatementDerivedFromElementTypes = map[string]string{
+	"reference": "canonical",
+	"key":       "id",
+}
+
+func (r *RequirementsStatementDerivedFrom) elementTypes() map[string]string {
+	return requirementsStatementDerivedFromElementTypes
+}
+
+var requirementsStatementDerivedFromElementMetadata = []ElementMetadata{
+	{Path: "Requirements.statement.derivedFrom.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.statement.derivedFrom.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Requirements.statement.derivedFrom.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Requirements.statement.derivedFrom.reference", Name: "reference", Field: 3, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Requirements.statement.derivedFrom.key", Name: "key", Field: 5, Min: 1, Max: "1", Types: []string{"id"}},
+}
+
+func (r *RequirementsStatementDerivedFrom) ElementMetadata() []ElementMetadata {
+	return requirementsStatementDerivedFromElementMetadata
+}
+
 type RequirementsStatementPartOf struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Reference *string `json:"reference,omitempty" bson:"reference,omitempty"` // Pointer to Requirements instance
-	Key       string  `json:"key" bson:"key"`                                 // Key of referenced statement
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Reference         *string     `json:"reference,omitempty" bson:"reference,omitempty"`                  // Pointer to Requirements instance
+	ReferenceElement  *Element    `json:"_reference,omitempty" bson:"reference_element,omitempty"`         // Extensions for reference
+	Key               string      `json:"key" bson:"key"`                                                  // Key of referenced statement
+	KeyElement        *Element    `json:"_key,omitempty" bson:"key_element,omitempty"`                     // Extensions for key
 }
 
 func (r *RequirementsStatementPartOf) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.ReferenceElement != nil {
+		if err := r.ReferenceElement.Validate(); err != nil {
+			return fmt.Errorf("ReferenceElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.Key == emptyString {
 		return fmt.Errorf("field 'Key' is required")
 	}
+	if r.KeyElement != nil {
+		if err := r.KeyElement.Validate(); err != nil {
+			return fmt.Errorf("KeyElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *RequirementsStatementPartOf) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ReferenceElement != nil {
+		r.ReferenceElement.validateAll(path+".reference", issues)
+	}
+	var emptyString string
+	if r.Key == emptyString {
+		issues.add("required", path+".key", "field 'Key' is required")
+	}
+	if r.KeyElement != nil {
+		r.KeyElement.validateAll(path+".key", issues)
+	}
+}
+
+var requirementsStatementPartOfInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RequirementsStatementPartOf) invariants() []invariant {
+	return requirementsStatementPartOfInvariants
+}
+
+func (r RequirementsStatementPartOf) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RequirementsStatementPartOf) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RequirementsStatementPartOf) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RequirementsStatementPartOf) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var requirementsStatementPartOfElementTypes = map[string]string{
+	"reference": "canonical",
+	"key":       "id",
+}
+
+func (r *RequirementsStatementPartOf) elementTypes() map[string]string {
+	return requirementsStatementPartOfElementTypes
+}
+
+var requirementsStatementPartOfElementMetadata = []ElementMetadata{
+	{Path: "Requirements.statement.partOf.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Requirements.statement.partOf.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Requirements.statement.partOf.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Requirements.statement.partOf.reference", Name: "reference", Field: 3, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "Requirements.statement.partOf.key", Name: "key", Field: 5, Min: 1, Max: "1", Types: []string{"id"}},
+}
+
+func (r *RequirementsStatementPartOf) ElementMetadata() []ElementMetadata {
+	return requirementsStatementPartOfElementMetadata
+}
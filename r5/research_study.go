@@ -2,52 +2,72 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A scientific study intended to increase health-related knowledge. For example, clinical trials are research studies that involve people. These studies may be related to new ways to screen, prevent, diagnose, and treat disease. They may also study certain outcomes and certain groups of people by looking at data collected in the past or future.
 type ResearchStudy struct {
-	ResourceType       string                         `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                        `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                        `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Url                *string                        `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this study resource
-	Identifier         []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Business Identifier for study
-	Version            *string                        `json:"version,omitempty" bson:"version,omitempty"`                         // The business version for the study record
-	Name               *string                        `json:"name,omitempty" bson:"name,omitempty"`                               // Name for this study (computer friendly)
-	Title              *string                        `json:"title,omitempty" bson:"title,omitempty"`                             // Human readable name of the study
-	Label              []ResearchStudyLabel           `json:"label,omitempty" bson:"label,omitempty"`                             // Additional names for the study
-	Protocol           []Reference                    `json:"protocol,omitempty" bson:"protocol,omitempty"`                       // Steps followed in executing study
-	PartOf             []Reference                    `json:"partOf,omitempty" bson:"part_of,omitempty"`                          // Part of larger study
-	CiteAs             *string                        `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                          // How to cite this ResearchStudy
-	RelatesTo          []ResearchStudyRelatesTo       `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                    // Relationships to other Resources
-	Date               *string                        `json:"date,omitempty" bson:"date,omitempty"`                               // Date the resource last changed
-	Status             string                         `json:"status" bson:"status"`                                               // draft | active | retired | unknown
-	PrimaryPurposeType *CodeableConcept               `json:"primaryPurposeType,omitempty" bson:"primary_purpose_type,omitempty"` // treatment | prevention | diagnostic | supportive-care | screening | health-services-research | basic-science | device-feasibility
-	Phase              *CodeableConcept               `json:"phase,omitempty" bson:"phase,omitempty"`                             // Classifier used for clinical trials
-	StudyDesign        []CodeableConcept              `json:"studyDesign,omitempty" bson:"study_design,omitempty"`                // Classifications of the study design characteristics
-	Focus              []CodeableReference            `json:"focus,omitempty" bson:"focus,omitempty"`                             // Drugs, devices, etc. under study
-	Condition          []CodeableConcept              `json:"condition,omitempty" bson:"condition,omitempty"`                     // Condition being studied
-	Keyword            []CodeableConcept              `json:"keyword,omitempty" bson:"keyword,omitempty"`                         // Used to search for the study
-	Region             []CodeableConcept              `json:"region,omitempty" bson:"region,omitempty"`                           // Geographic area for the study
-	DescriptionSummary *string                        `json:"descriptionSummary,omitempty" bson:"description_summary,omitempty"`  // Brief text explaining the study
-	Description        *string                        `json:"description,omitempty" bson:"description,omitempty"`                 // Detailed narrative of the study
-	Period             *Period                        `json:"period,omitempty" bson:"period,omitempty"`                           // When the study began and ended
-	Site               []Reference                    `json:"site,omitempty" bson:"site,omitempty"`                               // Facility where study activities are conducted
-	Note               []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                               // Comments made about the study
-	Classifier         []CodeableConcept              `json:"classifier,omitempty" bson:"classifier,omitempty"`                   // Classification for the study
-	AssociatedParty    []ResearchStudyAssociatedParty `json:"associatedParty,omitempty" bson:"associated_party,omitempty"`        // Sponsors, collaborators, and other parties
-	ProgressStatus     []ResearchStudyProgressStatus  `json:"progressStatus,omitempty" bson:"progress_status,omitempty"`          // Status of study with time for that status
-	WhyStopped         *CodeableConcept               `json:"whyStopped,omitempty" bson:"why_stopped,omitempty"`                  // accrual-goal-met | closed-due-to-toxicity | closed-due-to-lack-of-study-progress | temporarily-closed-per-study-design
-	Recruitment        *ResearchStudyRecruitment      `json:"recruitment,omitempty" bson:"recruitment,omitempty"`                 // Target or actual group of participants enrolled in study
-	ComparisonGroup    []ResearchStudyComparisonGroup `json:"comparisonGroup,omitempty" bson:"comparison_group,omitempty"`        // Defined path through the study for a subject
-	Objective          []ResearchStudyObjective       `json:"objective,omitempty" bson:"objective,omitempty"`                     // A goal for the study
-	Result             []Reference                    `json:"result,omitempty" bson:"result,omitempty"`                           // Link to results generated during the study
+	ResourceType              string                         `json:"resourceType" bson:"resource_type"`                                          // Type of resource
+	Id                        *string                        `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
+	Meta                      *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
+	ImplicitRules             *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
+	ImplicitRulesElement      *Element                       `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`           // Extensions for implicitRules
+	Language                  *string                        `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
+	LanguageElement           *Element                       `json:"_language,omitempty" bson:"language_element,omitempty"`                      // Extensions for language
+	Text                      *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
+	Contained                 []Resource                     `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
+	Extension                 []Extension                    `json:"extension,omitempty" bson:"extension,omitempty"`                             // Additional content defined by implementations
+	ModifierExtension         []Extension                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`            // Extensions that cannot be ignored
+	Url                       *string                        `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this study resource
+	UrlElement                *Element                       `json:"_url,omitempty" bson:"url_element,omitempty"`                                // Extensions for url
+	Identifier                []Identifier                   `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business Identifier for study
+	Version                   *string                        `json:"version,omitempty" bson:"version,omitempty"`                                 // The business version for the study record
+	VersionElement            *Element                       `json:"_version,omitempty" bson:"version_element,omitempty"`                        // Extensions for version
+	Name                      *string                        `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this study (computer friendly)
+	NameElement               *Element                       `json:"_name,omitempty" bson:"name_element,omitempty"`                              // Extensions for name
+	Title                     *string                        `json:"title,omitempty" bson:"title,omitempty"`                                     // Human readable name of the study
+	TitleElement              *Element                       `json:"_title,omitempty" bson:"title_element,omitempty"`                            // Extensions for title
+	Label                     []ResearchStudyLabel           `json:"label,omitempty" bson:"label,omitempty"`                                     // Additional names for the study
+	Protocol                  []Reference                    `json:"protocol,omitempty" bson:"protocol,omitempty"`                               // Steps followed in executing study
+	PartOf                    []Reference                    `json:"partOf,omitempty" bson:"part_of,omitempty"`                                  // Part of larger study
+	CiteAs                    *string                        `json:"citeAs,omitempty" bson:"cite_as,omitempty"`                                  // How to cite this ResearchStudy
+	CiteAsElement             *Element                       `json:"_citeAs,omitempty" bson:"cite_as_element,omitempty"`                         // Extensions for citeAs
+	RelatesTo                 []ResearchStudyRelatesTo       `json:"relatesTo,omitempty" bson:"relates_to,omitempty"`                            // Relationships to other Resources
+	Date                      *DateTime                      `json:"date,omitempty" bson:"date,omitempty"`                                       // Date the resource last changed
+	DateElement               *Element                       `json:"_date,omitempty" bson:"date_element,omitempty"`                              // Extensions for date
+	Status                    PublicationStatus              `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
+	StatusElement             *Element                       `json:"_status,omitempty" bson:"status_element,omitempty"`                          // Extensions for status
+	PrimaryPurposeType        *CodeableConcept               `json:"primaryPurposeType,omitempty" bson:"primary_purpose_type,omitempty"`         // treatment | prevention | diagnostic | supportive-care | screening | health-services-research | basic-science | device-feasibility
+	Phase                     *CodeableConcept               `json:"phase,omitempty" bson:"phase,omitempty"`                                     // Classifier used for clinical trials
+	StudyDesign               []CodeableConcept              `json:"studyDesign,omitempty" bson:"study_design,omitempty"`                        // Classifications of the study design characteristics
+	Focus                     []CodeableReference            `json:"focus,omitempty" bson:"focus,omitempty"`                                     // Drugs, devices, etc. under study
+	Condition                 []CodeableConcept              `json:"condition,omitempty" bson:"condition,omitempty"`                             // Condition being studied
+	Keyword                   []CodeableConcept              `json:"keyword,omitempty" bson:"keyword,omitempty"`                                 // Used to search for the study
+	Region                    []CodeableConcept              `json:"region,omitempty" bson:"region,omitempty"`                                   // Geographic area for the study
+	DescriptionSummary        *string                        `json:"descriptionSummary,omitempty" bson:"description_summary,omitempty"`          // Brief text explaining the study
+	DescriptionSummaryElement *Element                       `json:"_descriptionSummary,omitempty" bson:"description_summary_element,omitempty"` // Extensions for descriptionSummary
+	Description               *string                        `json:"description,omitempty" bson:"description,omitempty"`                         // Detailed narrative of the study
+	DescriptionElement        *Element                       `json:"_description,omitempty" bson:"description_element,omitempty"`                // Extensions for description
+	Period                    *Period                        `json:"period,omitempty" bson:"period,omitempty"`                                   // When the study began and ended
+	Site                      []Reference                    `json:"site,omitempty" bson:"site,omitempty"`                                       // Facility where study activities are conducted
+	Note                      []Annotation                   `json:"note,omitempty" bson:"note,omitempty"`                                       // Comments made about the study
+	Classifier                []CodeableConcept              `json:"classifier,omitempty" bson:"classifier,omitempty"`                           // Classification for the study
+	AssociatedParty           []ResearchStudyAssociatedParty `json:"associatedParty,omitempty" bson:"associated_party,omitempty"`                // Sponsors, collaborators, and other parties
+	ProgressStatus            []ResearchStudyProgressStatus  `json:"progressStatus,omitempty" bson:"progress_status,omitempty"`                  // Status of study with time for that status
+	WhyStopped                *CodeableConcept               `json:"whyStopped,omitempty" bson:"why_stopped,omitempty"`                          // accrual-goal-met | closed-due-to-toxicity | closed-due-to-lack-of-study-progress | temporarily-closed-per-study-design
+	Recruitment               *ResearchStudyRecruitment      `json:"recruitment,omitempty" bson:"recruitment,omitempty"`                         // Target or actual group of participants enrolled in study
+	ComparisonGroup           []ResearchStudyComparisonGroup `json:"comparisonGroup,omitempty" bson:"comparison_group,omitempty"`                // Defined path through the study for a subject
+	Objective                 []ResearchStudyObjective       `json:"objective,omitempty" bson:"objective,omitempty"`                             // A goal for the study
+	Result                    []Reference                    `json:"result,omitempty" bson:"result,omitempty"`                                   // Link to results generated during the study
 }
 
+var researchStudyProtocolTargets = []string{"PlanDefinition"}
+
+var researchStudyPartOfTargets = []string{"ResearchStudy"}
+
+var researchStudySiteTargets = []string{"Location", "Organization", "ResearchStudy"}
+
 func (r *ResearchStudy) Validate() error {
 	if r.ResourceType != "ResearchStudy" {
 		return fmt.Errorf("invalid resourceType: expected 'ResearchStudy', got '%s'", r.ResourceType)
@@ -57,40 +77,120 @@ func (r *ResearchStudy) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
 	for i, item := range r.Label {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Label[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Protocol {
+		if target := invalidReferenceTarget(item.Reference, item.Type, researchStudyProtocolTargets); target != "" {
+			return fmt.Errorf("field 'Protocol[%d]' cannot refer to %s, expected one of %v", i, target, researchStudyProtocolTargets)
+		}
+	}
 	for i, item := range r.Protocol {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Protocol[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, researchStudyPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, researchStudyPartOfTargets)
+		}
+	}
 	for i, item := range r.PartOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
+	if r.CiteAsElement != nil {
+		if err := r.CiteAsElement.Validate(); err != nil {
+			return fmt.Errorf("CiteAsElement: %w", err)
+		}
+	}
 	for i, item := range r.RelatesTo {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RelatesTo[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.PrimaryPurposeType != nil {
 		if err := r.PrimaryPurposeType.Validate(); err != nil {
 			return fmt.Errorf("PrimaryPurposeType: %w", err)
@@ -126,11 +226,26 @@ func (r *ResearchStudy) Validate() error {
 			return fmt.Errorf("Region[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionSummaryElement != nil {
+		if err := r.DescriptionSummaryElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionSummaryElement: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
 		}
 	}
+	for i, item := range r.Site {
+		if target := invalidReferenceTarget(item.Reference, item.Type, researchStudySiteTargets); target != "" {
+			return fmt.Errorf("field 'Site[%d]' cannot refer to %s, expected one of %v", i, target, researchStudySiteTargets)
+		}
+	}
 	for i, item := range r.Site {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Site[%d]: %w", i, err)
@@ -181,235 +296,1337 @@ func (r *ResearchStudy) Validate() error {
 			return fmt.Errorf("Result[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "ResearchStudy")
 }
 
-type ResearchStudyLabel struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Type     *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`         // primary | official | scientific | plain-language | subtitle | short-title | acronym | earlier-title | language | auto-translated | human-use | machine-use | duplicate-uid
-	Value    *string          `json:"value,omitempty" bson:"value,omitempty"`       // The name
-	Language *string          `json:"language,omitempty" bson:"language,omitempty"` // Used to express the specific language
-}
-
-func (r *ResearchStudyLabel) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+func (r *ResearchStudy) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ResearchStudy" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ResearchStudy', got '%s'", r.ResourceType))
 	}
-	return nil
-}
-
-type ResearchStudyRelatesTo struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`          // Unique id for inter-element referencing
-	Type             *CodeableConcept `json:"type" bson:"type"`                          // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
-	TargetUri        *string          `json:"targetUri" bson:"target_uri"`               // The artifact that is related to this ResearchStudy
-	TargetAttachment *Attachment      `json:"targetAttachment" bson:"target_attachment"` // The artifact that is related to this ResearchStudy
-	TargetCanonical  *string          `json:"targetCanonical" bson:"target_canonical"`   // The artifact that is related to this ResearchStudy
-	TargetReference  *Reference       `json:"targetReference" bson:"target_reference"`   // The artifact that is related to this ResearchStudy
-	TargetMarkdown   *string          `json:"targetMarkdown" bson:"target_markdown"`     // The artifact that is related to this ResearchStudy
-}
-
-func (r *ResearchStudyRelatesTo) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.TargetUri == nil {
-		return fmt.Errorf("field 'TargetUri' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.TargetAttachment == nil {
-		return fmt.Errorf("field 'TargetAttachment' is required")
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.TargetAttachment != nil {
-		if err := r.TargetAttachment.Validate(); err != nil {
-			return fmt.Errorf("TargetAttachment: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.TargetCanonical == nil {
-		return fmt.Errorf("field 'TargetCanonical' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.TargetReference == nil {
-		return fmt.Errorf("field 'TargetReference' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.TargetReference != nil {
-		if err := r.TargetReference.Validate(); err != nil {
-			return fmt.Errorf("TargetReference: %w", err)
-		}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	if r.TargetMarkdown == nil {
-		return fmt.Errorf("field 'TargetMarkdown' is required")
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ResearchStudyAssociatedParty struct {
-	Id         *string           `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Name       *string           `json:"name,omitempty" bson:"name,omitempty"`             // Name of associated party
-	Role       *CodeableConcept  `json:"role" bson:"role"`                                 // sponsor | lead-sponsor | sponsor-investigator | primary-investigator | collaborator | funding-source | general-contact | recruitment-contact | sub-investigator | study-chair | irb | data-monitoring
-	Period     []Period          `json:"period,omitempty" bson:"period,omitempty"`         // When active in the role
-	Classifier []CodeableConcept `json:"classifier,omitempty" bson:"classifier,omitempty"` // nih | fda | government | nonprofit | academic | industry
-	Party      *Reference        `json:"party,omitempty" bson:"party,omitempty"`           // Individual or organization associated with study (use practitionerRole to specify their organisation)
-}
-
-func (r *ResearchStudyAssociatedParty) Validate() error {
-	if r.Role == nil {
-		return fmt.Errorf("field 'Role' is required")
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	for i, item := range r.Period {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Period[%d]: %w", i, err)
-		}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
 	}
-	for i, item := range r.Classifier {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Classifier[%d]: %w", i, err)
+	for i, item := range r.Label {
+		item.validateAll(fmt.Sprintf("%s.label[%d]", path, i), issues)
+	}
+	for i, item := range r.Protocol {
+		if target := invalidReferenceTarget(item.Reference, item.Type, researchStudyProtocolTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.protocol[%d]", path, i), fmt.Sprintf("field 'Protocol[%d]' cannot refer to %s, expected one of %v", i, target, researchStudyProtocolTargets))
 		}
 	}
-	if r.Party != nil {
-		if err := r.Party.Validate(); err != nil {
-			return fmt.Errorf("Party: %w", err)
+	for i, item := range r.Protocol {
+		item.validateAll(fmt.Sprintf("%s.protocol[%d]", path, i), issues)
+	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, researchStudyPartOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.partOf[%d]", path, i), fmt.Sprintf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, researchStudyPartOfTargets))
 		}
 	}
-	return nil
-}
-
-type ResearchStudyProgressStatus struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	State  *CodeableConcept `json:"state" bson:"state"`                       // Label for status or state (e.g. recruitment status)
-	Actual *bool            `json:"actual,omitempty" bson:"actual,omitempty"` // Actual if true else anticipated
-	Period *Period          `json:"period,omitempty" bson:"period,omitempty"` // Date range
-}
-
-func (r *ResearchStudyProgressStatus) Validate() error {
-	if r.State == nil {
-		return fmt.Errorf("field 'State' is required")
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
 	}
-	if r.State != nil {
-		if err := r.State.Validate(); err != nil {
-			return fmt.Errorf("State: %w", err)
+	if r.CiteAsElement != nil {
+		r.CiteAsElement.validateAll(path+".citeAs", issues)
+	}
+	for i, item := range r.RelatesTo {
+		item.validateAll(fmt.Sprintf("%s.relatesTo[%d]", path, i), issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.PrimaryPurposeType != nil {
+		r.PrimaryPurposeType.validateAll(path+".primaryPurposeType", issues)
+	}
+	if r.Phase != nil {
+		r.Phase.validateAll(path+".phase", issues)
+	}
+	for i, item := range r.StudyDesign {
+		item.validateAll(fmt.Sprintf("%s.studyDesign[%d]", path, i), issues)
+	}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Keyword {
+		item.validateAll(fmt.Sprintf("%s.keyword[%d]", path, i), issues)
+	}
+	for i, item := range r.Region {
+		item.validateAll(fmt.Sprintf("%s.region[%d]", path, i), issues)
+	}
+	if r.DescriptionSummaryElement != nil {
+		r.DescriptionSummaryElement.validateAll(path+".descriptionSummary", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
 	if r.Period != nil {
-		if err := r.Period.Validate(); err != nil {
-			return fmt.Errorf("Period: %w", err)
+		r.Period.validateAll(path+".period", issues)
+	}
+	for i, item := range r.Site {
+		if target := invalidReferenceTarget(item.Reference, item.Type, researchStudySiteTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.site[%d]", path, i), fmt.Sprintf("field 'Site[%d]' cannot refer to %s, expected one of %v", i, target, researchStudySiteTargets))
 		}
 	}
-	return nil
+	for i, item := range r.Site {
+		item.validateAll(fmt.Sprintf("%s.site[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Classifier {
+		item.validateAll(fmt.Sprintf("%s.classifier[%d]", path, i), issues)
+	}
+	for i, item := range r.AssociatedParty {
+		item.validateAll(fmt.Sprintf("%s.associatedParty[%d]", path, i), issues)
+	}
+	for i, item := range r.ProgressStatus {
+		item.validateAll(fmt.Sprintf("%s.progressStatus[%d]", path, i), issues)
+	}
+	if r.WhyStopped != nil {
+		r.WhyStopped.validateAll(path+".whyStopped", issues)
+	}
+	if r.Recruitment != nil {
+		r.Recruitment.validateAll(path+".recruitment", issues)
+	}
+	for i, item := range r.ComparisonGroup {
+		item.validateAll(fmt.Sprintf("%s.comparisonGroup[%d]", path, i), issues)
+	}
+	for i, item := range r.Objective {
+		item.validateAll(fmt.Sprintf("%s.objective[%d]", path, i), issues)
+	}
+	for i, item := range r.Result {
+		item.validateAll(fmt.Sprintf("%s.result[%d]", path, i), issues)
+	}
 }
 
-type ResearchStudyRecruitment struct {
-	Id           *string    `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	TargetNumber *int       `json:"targetNumber,omitempty" bson:"target_number,omitempty"` // Estimated total number of participants to be enrolled
-	ActualNumber *int       `json:"actualNumber,omitempty" bson:"actual_number,omitempty"` // Actual total number of participants enrolled in study
-	Eligibility  *Reference `json:"eligibility,omitempty" bson:"eligibility,omitempty"`    // Inclusion and exclusion criteria
-	ActualGroup  *Reference `json:"actualGroup,omitempty" bson:"actual_group,omitempty"`   // Group of participants who were enrolled in study
-	Description  *string    `json:"description,omitempty" bson:"description,omitempty"`    // Description of the recruitment
+func (r *ResearchStudy) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ResearchStudy", &issues)
+	checkInvariants(r, "ResearchStudy", &issues)
+	return issues
 }
 
-func (r *ResearchStudyRecruitment) Validate() error {
-	if r.Eligibility != nil {
-		if err := r.Eligibility.Validate(); err != nil {
-			return fmt.Errorf("Eligibility: %w", err)
-		}
+var researchStudyInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ResearchStudy) invariants() []invariant {
+	return researchStudyInvariants
+}
+
+func (r *ResearchStudy) UnmarshalJSON(data []byte) error {
+	type alias ResearchStudy
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ActualGroup != nil {
-		if err := r.ActualGroup.Validate(); err != nil {
-			return fmt.Errorf("ActualGroup: %w", err)
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
-type ResearchStudyComparisonGroup struct {
-	Id            *string    `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	TargetNumber  *int       `json:"targetNumber,omitempty" bson:"target_number,omitempty"`   // Estimated total number of participants to be enrolled in the comparison group
-	ActualNumber  *int       `json:"actualNumber,omitempty" bson:"actual_number,omitempty"`   // Actual total number of participants enrolled in the comparison group
-	Eligibility   *Reference `json:"eligibility,omitempty" bson:"eligibility,omitempty"`      // Inclusion and exclusion criteria for the comparison group
-	ObservedGroup *Reference `json:"observedGroup,omitempty" bson:"observed_group,omitempty"` // Group of participants who were enrolled in the comparison group
-	Description   *string    `json:"description,omitempty" bson:"description,omitempty"`      // Description of the comparison Group
+func (r ResearchStudy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ResearchStudyComparisonGroup) Validate() error {
-	if r.Eligibility != nil {
-		if err := r.Eligibility.Validate(); err != nil {
-			return fmt.Errorf("Eligibility: %w", err)
-		}
+func (r *ResearchStudy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudy) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyElementTypes = map[string]string{
+	"id":                 "id",
+	"implicitRules":      "uri",
+	"language":           "code",
+	"url":                "uri",
+	"citeAs":             "markdown",
+	"descriptionSummary": "markdown",
+	"description":        "markdown",
+}
+
+func (r *ResearchStudy) elementTypes() map[string]string {
+	return researchStudyElementTypes
+}
+
+var researchStudyElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ResearchStudy.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ResearchStudy.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ResearchStudy.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ResearchStudy.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ResearchStudy.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ResearchStudy.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ResearchStudy.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ResearchStudy.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.name", Name: "name", Field: 16, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.title", Name: "title", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.label", Name: "label", Field: 20, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.protocol", Name: "protocol", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/PlanDefinition"}},
+	{Path: "ResearchStudy.partOf", Name: "partOf", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ResearchStudy"}},
+	{Path: "ResearchStudy.citeAs", Name: "citeAs", Field: 23, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ResearchStudy.relatesTo", Name: "relatesTo", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ResearchStudy.status", Name: "status", Field: 28, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ResearchStudy.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ResearchStudy.primaryPurposeType", Name: "primaryPurposeType", Field: 30, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.phase", Name: "phase", Field: 31, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.studyDesign", Name: "studyDesign", Field: 32, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.focus", Name: "focus", Field: 33, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ResearchStudy.condition", Name: "condition", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.keyword", Name: "keyword", Field: 35, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.region", Name: "region", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.descriptionSummary", Name: "descriptionSummary", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ResearchStudy.description", Name: "description", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ResearchStudy.period", Name: "period", Field: 41, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ResearchStudy.site", Name: "site", Field: 42, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/ResearchStudy"}},
+	{Path: "ResearchStudy.note", Name: "note", Field: 43, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "ResearchStudy.classifier", Name: "classifier", Field: 44, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.associatedParty", Name: "associatedParty", Field: 45, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.progressStatus", Name: "progressStatus", Field: 46, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.whyStopped", Name: "whyStopped", Field: 47, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.recruitment", Name: "recruitment", Field: 48, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.comparisonGroup", Name: "comparisonGroup", Field: 49, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.objective", Name: "objective", Field: 50, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchStudy.result", Name: "result", Field: 51, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *ResearchStudy) ElementMetadata() []ElementMetadata {
+	return researchStudyElementMetadata
+}
+
+func (r *ResearchStudy) GetResourceType() string {
+	return "ResearchStudy"
+}
+
+func (r *ResearchStudy) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.ObservedGroup != nil {
-		if err := r.ObservedGroup.Validate(); err != nil {
-			return fmt.Errorf("ObservedGroup: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *ResearchStudy) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
 }
 
-type ResearchStudyObjective struct {
-	Id             *string                                `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	Name           *string                                `json:"name,omitempty" bson:"name,omitempty"`                      // Label for the objective
-	Type           *CodeableConcept                       `json:"type,omitempty" bson:"type,omitempty"`                      // primary | secondary | exploratory
-	Description    *string                                `json:"description,omitempty" bson:"description,omitempty"`        // Description of the objective
-	OutcomeMeasure []ResearchStudyObjectiveOutcomeMeasure `json:"outcomeMeasure,omitempty" bson:"outcome_measure,omitempty"` // A variable measured during the study
+func (r *ResearchStudy) GetMeta() *Meta {
+	return r.Meta
 }
 
-func (r *ResearchStudyObjective) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+func (r *ResearchStudy) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ResearchStudy) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ResearchStudy) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ResearchStudy) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ResearchStudy)(nil)
+
+type ResearchStudyLabel struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // primary | official | scientific | plain-language | subtitle | short-title | acronym | earlier-title | language | auto-translated | human-use | machine-use | duplicate-uid
+	Value             *string          `json:"value,omitempty" bson:"value,omitempty"`                          // The name
+	ValueElement      *Element         `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+	Language          *string          `json:"language,omitempty" bson:"language,omitempty"`                    // Used to express the specific language
+	LanguageElement   *Element         `json:"_language,omitempty" bson:"language_element,omitempty"`           // Extensions for language
+}
+
+func (r *ResearchStudyLabel) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.OutcomeMeasure {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("OutcomeMeasure[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ResearchStudyObjectiveOutcomeMeasure struct {
-	Id                   *string                                             `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Name                 *string                                             `json:"name,omitempty" bson:"name,omitempty"`                                   // Label for the outcome measure
-	Type                 *CodeableConcept                                    `json:"type,omitempty" bson:"type,omitempty"`                                   // primary | secondary | exploratory
-	Description          *string                                             `json:"description,omitempty" bson:"description,omitempty"`                     // Description of the outcome measure
-	Endpoint             *Reference                                          `json:"endpoint" bson:"endpoint"`                                               // Definition of the outcome measure
-	Population           *Reference                                          `json:"population,omitempty" bson:"population,omitempty"`                       // Population for this estimand
-	Intervention         *Reference                                          `json:"intervention,omitempty" bson:"intervention,omitempty"`                   // Comparison group of interest
-	Comparator           *Reference                                          `json:"comparator,omitempty" bson:"comparator,omitempty"`                       // Comparison group for comparison
-	SummaryMeasure       *CodeableConcept                                    `json:"summaryMeasure,omitempty" bson:"summary_measure,omitempty"`              // Statistical measure for treatment effect estimate
-	EndpointAnalysisPlan *Reference                                          `json:"endpointAnalysisPlan,omitempty" bson:"endpoint_analysis_plan,omitempty"` // Statistical analysis plan for a single endpoint
-	EventHandling        []ResearchStudyObjectiveOutcomeMeasureEventHandling `json:"eventHandling,omitempty" bson:"event_handling,omitempty"`                // Handling of intercurrent event
-}
-
-func (r *ResearchStudyObjectiveOutcomeMeasure) Validate() error {
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.Endpoint == nil {
-		return fmt.Errorf("field 'Endpoint' is required")
-	}
-	if r.Endpoint != nil {
-		if err := r.Endpoint.Validate(); err != nil {
-			return fmt.Errorf("Endpoint: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.Population != nil {
-		if err := r.Population.Validate(); err != nil {
-			return fmt.Errorf("Population: %w", err)
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
 		}
 	}
-	if r.Intervention != nil {
-		if err := r.Intervention.Validate(); err != nil {
+	return nil
+}
+
+func (r *ResearchStudyLabel) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+}
+
+var researchStudyLabelInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyLabel) invariants() []invariant {
+	return researchStudyLabelInvariants
+}
+
+func (r ResearchStudyLabel) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyLabel) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyLabel) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyLabel) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyLabelElementTypes = map[string]string{
+	"language": "code",
+}
+
+func (r *ResearchStudyLabel) elementTypes() map[string]string {
+	return researchStudyLabelElementTypes
+}
+
+var researchStudyLabelElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.label.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.label.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.label.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.label.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.label.value", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.label.language", Name: "language", Field: 6, Min: 0, Max: "1", Types: []string{"code"}},
+}
+
+func (r *ResearchStudyLabel) ElementMetadata() []ElementMetadata {
+	return researchStudyLabelElementMetadata
+}
+
+type ResearchStudyRelatesTo struct {
+	Id                *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept             `json:"type" bson:"type"`                                                // documentation | justification | citation | predecessor | successor | derived-from | depends-on | composed-of | part-of | amends | amended-with | appends | appended-with | cites | cited-by | comments-on | comment-in | contains | contained-in | corrects | correction-in | replaces | replaced-with | retracts | retracted-by | signs | similar-to | supports | supported-with | transforms | transformed-into | transformed-with | documents | specification-of | created-with | cite-as | reprint | reprint-of | summarizes
+	Target            ResearchStudyRelatesToTarget `json:"-" bson:"target,omitempty"`                                       // The artifact that is related to this ResearchStudy
+	TargetElement     *Element                     `json:"-" bson:"target_element,omitempty"`                               // Extensions for target[x]
+
+	targetVariants []string // JSON properties of ResearchStudy.relatesTo.target[x] when more than one was decoded
+}
+
+func (r *ResearchStudyRelatesTo) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.targetVariants) > 1 {
+		return fmt.Errorf("field 'Target' must have a single type, got %v", r.targetVariants)
+	}
+	if r.Target == nil {
+		return fmt.Errorf("field 'Target' is required")
+	}
+	if r.Target != nil {
+		if err := r.Target.Validate(); err != nil {
+			return fmt.Errorf("Target: %w", err)
+		}
+	}
+	if r.TargetElement != nil {
+		if err := r.TargetElement.Validate(); err != nil {
+			return fmt.Errorf("TargetElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ResearchStudyRelatesTo) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.targetVariants) > 1 {
+		issues.add("structure", path+".target", fmt.Sprintf("field 'Target' must have a single type, got %v", r.targetVariants))
+	}
+	if r.Target == nil {
+		issues.add("required", path+".target", "field 'Target' is required")
+	}
+	if r.Target != nil {
+		r.Target.validateAll(path+".target.ofType("+r.Target.FHIRType()+")", issues)
+	}
+	if r.TargetElement != nil {
+		r.TargetElement.validateAll(path+".target", issues)
+	}
+}
+
+var researchStudyRelatesToInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyRelatesTo) invariants() []invariant {
+	return researchStudyRelatesToInvariants
+}
+
+func (r ResearchStudyRelatesTo) MarshalJSON() ([]byte, error) {
+	type alias ResearchStudyRelatesTo
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "target", r.Target, r.TargetElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ResearchStudyRelatesTo) UnmarshalJSON(data []byte) error {
+	type alias ResearchStudyRelatesTo
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Target, element, r.targetVariants, err = unmarshalChoice(fields, "target", researchStudyRelatesToTargetVariants); err != nil {
+		return err
+	}
+	r.TargetElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TargetElement); err != nil {
+			return fmt.Errorf("_target: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ResearchStudyRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyRelatesTo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyRelatesTo) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyRelatesTo) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ResearchStudyRelatesTo) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Target":
+		return "target", choiceValues(researchStudyRelatesToTargetVariants)
+	}
+	return "", nil
+}
+
+var researchStudyRelatesToElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.relatesTo.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.relatesTo.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.relatesTo.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.relatesTo.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.relatesTo.target[x]", Name: "target", Field: 4, Min: 1, Max: "1", Types: []string{"uri", "Attachment", "canonical", "Reference", "markdown"}},
+}
+
+func (r *ResearchStudyRelatesTo) ElementMetadata() []ElementMetadata {
+	return researchStudyRelatesToElementMetadata
+}
+
+// ResearchStudyRelatesToTarget is the type of ResearchStudy.relatesTo.target[x]. It is implemented by the ResearchStudyRelatesToTarget* variant types.
+type ResearchStudyRelatesToTarget interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isResearchStudyRelatesToTarget()
+}
+
+// ResearchStudyRelatesToTargetUri is the uri variant of ResearchStudyRelatesToTarget.
+type ResearchStudyRelatesToTargetUri string
+
+func (v ResearchStudyRelatesToTargetUri) Validate() error {
+	return nil
+}
+
+func (v ResearchStudyRelatesToTargetUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ResearchStudyRelatesToTargetUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ResearchStudyRelatesToTargetUri) isResearchStudyRelatesToTarget() {}
+
+// ResearchStudyRelatesToTargetAttachment is the Attachment variant of ResearchStudyRelatesToTarget.
+type ResearchStudyRelatesToTargetAttachment struct {
+	Attachment
+}
+
+func (v ResearchStudyRelatesToTargetAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v ResearchStudyRelatesToTargetAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v ResearchStudyRelatesToTargetAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v ResearchStudyRelatesToTargetAttachment) isResearchStudyRelatesToTarget() {}
+
+// ResearchStudyRelatesToTargetCanonical is the canonical variant of ResearchStudyRelatesToTarget.
+type ResearchStudyRelatesToTargetCanonical string
+
+func (v ResearchStudyRelatesToTargetCanonical) Validate() error {
+	return nil
+}
+
+func (v ResearchStudyRelatesToTargetCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ResearchStudyRelatesToTargetCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ResearchStudyRelatesToTargetCanonical) isResearchStudyRelatesToTarget() {}
+
+// ResearchStudyRelatesToTargetReference is the Reference variant of ResearchStudyRelatesToTarget.
+type ResearchStudyRelatesToTargetReference struct {
+	Reference
+}
+
+func (v ResearchStudyRelatesToTargetReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ResearchStudyRelatesToTargetReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ResearchStudyRelatesToTargetReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ResearchStudyRelatesToTargetReference) isResearchStudyRelatesToTarget() {}
+
+// ResearchStudyRelatesToTargetMarkdown is the markdown variant of ResearchStudyRelatesToTarget.
+type ResearchStudyRelatesToTargetMarkdown string
+
+func (v ResearchStudyRelatesToTargetMarkdown) Validate() error {
+	return nil
+}
+
+func (v ResearchStudyRelatesToTargetMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ResearchStudyRelatesToTargetMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ResearchStudyRelatesToTargetMarkdown) isResearchStudyRelatesToTarget() {}
+
+var researchStudyRelatesToTargetVariants = []ResearchStudyRelatesToTarget{
+	ResearchStudyRelatesToTargetUri(""),
+	ResearchStudyRelatesToTargetAttachment{},
+	ResearchStudyRelatesToTargetCanonical(""),
+	ResearchStudyRelatesToTargetReference{},
+	ResearchStudyRelatesToTargetMarkdown(""),
+}
+
+type ResearchStudyAssociatedParty struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              *string           `json:"name,omitempty" bson:"name,omitempty"`                            // Name of associated party
+	NameElement       *Element          `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Role              *CodeableConcept  `json:"role" bson:"role"`                                                // sponsor | lead-sponsor | sponsor-investigator | primary-investigator | collaborator | funding-source | general-contact | recruitment-contact | sub-investigator | study-chair | irb | data-monitoring
+	Period            []Period          `json:"period,omitempty" bson:"period,omitempty"`                        // When active in the role
+	Classifier        []CodeableConcept `json:"classifier,omitempty" bson:"classifier,omitempty"`                // nih | fda | government | nonprofit | academic | industry
+	Party             *Reference        `json:"party,omitempty" bson:"party,omitempty"`                          // Individual or organization associated with study (use practitionerRole to specify their organisation)
+}
+
+func (r *ResearchStudyAssociatedParty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Role == nil {
+		return fmt.Errorf("field 'Role' is required")
+	}
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
+		}
+	}
+	for i, item := range r.Period {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Period[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Classifier {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Classifier[%d]: %w", i, err)
+		}
+	}
+	if r.Party != nil {
+		if err := r.Party.Validate(); err != nil {
+			return fmt.Errorf("Party: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ResearchStudyAssociatedParty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Role == nil {
+		issues.add("required", path+".role", "field 'Role' is required")
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	for i, item := range r.Period {
+		item.validateAll(fmt.Sprintf("%s.period[%d]", path, i), issues)
+	}
+	for i, item := range r.Classifier {
+		item.validateAll(fmt.Sprintf("%s.classifier[%d]", path, i), issues)
+	}
+	if r.Party != nil {
+		r.Party.validateAll(path+".party", issues)
+	}
+}
+
+var researchStudyAssociatedPartyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyAssociatedParty) invariants() []invariant {
+	return researchStudyAssociatedPartyInvariants
+}
+
+func (r ResearchStudyAssociatedParty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyAssociatedParty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyAssociatedParty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyAssociatedParty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyAssociatedPartyElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.associatedParty.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.associatedParty.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.associatedParty.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.associatedParty.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.associatedParty.role", Name: "role", Field: 5, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.associatedParty.period", Name: "period", Field: 6, Min: 0, Max: "*", Types: []string{"Period"}},
+	{Path: "ResearchStudy.associatedParty.classifier", Name: "classifier", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.associatedParty.party", Name: "party", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *ResearchStudyAssociatedParty) ElementMetadata() []ElementMetadata {
+	return researchStudyAssociatedPartyElementMetadata
+}
+
+type ResearchStudyProgressStatus struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	State             *CodeableConcept `json:"state" bson:"state"`                                              // Label for status or state (e.g. recruitment status)
+	Actual            *bool            `json:"actual,omitempty" bson:"actual,omitempty"`                        // Actual if true else anticipated
+	ActualElement     *Element         `json:"_actual,omitempty" bson:"actual_element,omitempty"`               // Extensions for actual
+	Period            *Period          `json:"period,omitempty" bson:"period,omitempty"`                        // Date range
+}
+
+func (r *ResearchStudyProgressStatus) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.State == nil {
+		return fmt.Errorf("field 'State' is required")
+	}
+	if r.State != nil {
+		if err := r.State.Validate(); err != nil {
+			return fmt.Errorf("State: %w", err)
+		}
+	}
+	if r.ActualElement != nil {
+		if err := r.ActualElement.Validate(); err != nil {
+			return fmt.Errorf("ActualElement: %w", err)
+		}
+	}
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ResearchStudyProgressStatus) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.State == nil {
+		issues.add("required", path+".state", "field 'State' is required")
+	}
+	if r.State != nil {
+		r.State.validateAll(path+".state", issues)
+	}
+	if r.ActualElement != nil {
+		r.ActualElement.validateAll(path+".actual", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+}
+
+var researchStudyProgressStatusInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyProgressStatus) invariants() []invariant {
+	return researchStudyProgressStatusInvariants
+}
+
+func (r ResearchStudyProgressStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyProgressStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyProgressStatus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyProgressStatus) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyProgressStatusElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.progressStatus.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.progressStatus.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.progressStatus.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.progressStatus.state", Name: "state", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.progressStatus.actual", Name: "actual", Field: 4, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ResearchStudy.progressStatus.period", Name: "period", Field: 6, Min: 0, Max: "1", Types: []string{"Period"}},
+}
+
+func (r *ResearchStudyProgressStatus) ElementMetadata() []ElementMetadata {
+	return researchStudyProgressStatusElementMetadata
+}
+
+type ResearchStudyRecruitment struct {
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	TargetNumber        *int        `json:"targetNumber,omitempty" bson:"target_number,omitempty"`           // Estimated total number of participants to be enrolled
+	TargetNumberElement *Element    `json:"_targetNumber,omitempty" bson:"target_number_element,omitempty"`  // Extensions for targetNumber
+	ActualNumber        *int        `json:"actualNumber,omitempty" bson:"actual_number,omitempty"`           // Actual total number of participants enrolled in study
+	ActualNumberElement *Element    `json:"_actualNumber,omitempty" bson:"actual_number_element,omitempty"`  // Extensions for actualNumber
+	Eligibility         *Reference  `json:"eligibility,omitempty" bson:"eligibility,omitempty"`              // Inclusion and exclusion criteria
+	ActualGroup         *Reference  `json:"actualGroup,omitempty" bson:"actual_group,omitempty"`             // Group of participants who were enrolled in study
+	Description         *string     `json:"description,omitempty" bson:"description,omitempty"`              // Description of the recruitment
+	DescriptionElement  *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+}
+
+var researchStudyRecruitmentEligibilityTargets = []string{"Group"}
+
+func (r *ResearchStudyRecruitment) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TargetNumberElement != nil {
+		if err := r.TargetNumberElement.Validate(); err != nil {
+			return fmt.Errorf("TargetNumberElement: %w", err)
+		}
+	}
+	if r.ActualNumberElement != nil {
+		if err := r.ActualNumberElement.Validate(); err != nil {
+			return fmt.Errorf("ActualNumberElement: %w", err)
+		}
+	}
+	if r.Eligibility != nil {
+		if target := invalidReferenceTarget(r.Eligibility.Reference, r.Eligibility.Type, researchStudyRecruitmentEligibilityTargets); target != "" {
+			return fmt.Errorf("field 'Eligibility' cannot refer to %s, expected one of %v", target, researchStudyRecruitmentEligibilityTargets)
+		}
+	}
+	if r.Eligibility != nil {
+		if err := r.Eligibility.Validate(); err != nil {
+			return fmt.Errorf("Eligibility: %w", err)
+		}
+	}
+	if r.ActualGroup != nil {
+		if err := r.ActualGroup.Validate(); err != nil {
+			return fmt.Errorf("ActualGroup: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ResearchStudyRecruitment) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TargetNumberElement != nil {
+		r.TargetNumberElement.validateAll(path+".targetNumber", issues)
+	}
+	if r.ActualNumberElement != nil {
+		r.ActualNumberElement.validateAll(path+".actualNumber", issues)
+	}
+	if r.Eligibility != nil {
+		if target := invalidReferenceTarget(r.Eligibility.Reference, r.Eligibility.Type, researchStudyRecruitmentEligibilityTargets); target != "" {
+			issues.add("value", path+".eligibility", fmt.Sprintf("field 'Eligibility' cannot refer to %s, expected one of %v", target, researchStudyRecruitmentEligibilityTargets))
+		}
+	}
+	if r.Eligibility != nil {
+		r.Eligibility.validateAll(path+".eligibility", issues)
+	}
+	if r.ActualGroup != nil {
+		r.ActualGroup.validateAll(path+".actualGroup", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+}
+
+var researchStudyRecruitmentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyRecruitment) invariants() []invariant {
+	return researchStudyRecruitmentInvariants
+}
+
+func (r ResearchStudyRecruitment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyRecruitment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyRecruitment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyRecruitment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyRecruitmentElementTypes = map[string]string{
+	"targetNumber": "unsignedInt",
+	"actualNumber": "unsignedInt",
+	"description":  "markdown",
+}
+
+func (r *ResearchStudyRecruitment) elementTypes() map[string]string {
+	return researchStudyRecruitmentElementTypes
+}
+
+var researchStudyRecruitmentElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.recruitment.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.recruitment.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.recruitment.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.recruitment.targetNumber", Name: "targetNumber", Field: 3, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ResearchStudy.recruitment.actualNumber", Name: "actualNumber", Field: 5, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ResearchStudy.recruitment.eligibility", Name: "eligibility", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group"}},
+	{Path: "ResearchStudy.recruitment.actualGroup", Name: "actualGroup", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.recruitment.description", Name: "description", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ResearchStudyRecruitment) ElementMetadata() []ElementMetadata {
+	return researchStudyRecruitmentElementMetadata
+}
+
+type ResearchStudyComparisonGroup struct {
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	TargetNumber        *int        `json:"targetNumber,omitempty" bson:"target_number,omitempty"`           // Estimated total number of participants to be enrolled in the comparison group
+	TargetNumberElement *Element    `json:"_targetNumber,omitempty" bson:"target_number_element,omitempty"`  // Extensions for targetNumber
+	ActualNumber        *int        `json:"actualNumber,omitempty" bson:"actual_number,omitempty"`           // Actual total number of participants enrolled in the comparison group
+	ActualNumberElement *Element    `json:"_actualNumber,omitempty" bson:"actual_number_element,omitempty"`  // Extensions for actualNumber
+	Eligibility         *Reference  `json:"eligibility,omitempty" bson:"eligibility,omitempty"`              // Inclusion and exclusion criteria for the comparison group
+	ObservedGroup       *Reference  `json:"observedGroup,omitempty" bson:"observed_group,omitempty"`         // Group of participants who were enrolled in the comparison group
+	Description         *string     `json:"description,omitempty" bson:"description,omitempty"`              // Description of the comparison Group
+	DescriptionElement  *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+}
+
+func (r *ResearchStudyComparisonGroup) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TargetNumberElement != nil {
+		if err := r.TargetNumberElement.Validate(); err != nil {
+			return fmt.Errorf("TargetNumberElement: %w", err)
+		}
+	}
+	if r.ActualNumberElement != nil {
+		if err := r.ActualNumberElement.Validate(); err != nil {
+			return fmt.Errorf("ActualNumberElement: %w", err)
+		}
+	}
+	if r.Eligibility != nil {
+		if err := r.Eligibility.Validate(); err != nil {
+			return fmt.Errorf("Eligibility: %w", err)
+		}
+	}
+	if r.ObservedGroup != nil {
+		if err := r.ObservedGroup.Validate(); err != nil {
+			return fmt.Errorf("ObservedGroup: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ResearchStudyComparisonGroup) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TargetNumberElement != nil {
+		r.TargetNumberElement.validateAll(path+".targetNumber", issues)
+	}
+	if r.ActualNumberElement != nil {
+		r.ActualNumberElement.validateAll(path+".actualNumber", issues)
+	}
+	if r.Eligibility != nil {
+		r.Eligibility.validateAll(path+".eligibility", issues)
+	}
+	if r.ObservedGroup != nil {
+		r.ObservedGroup.validateAll(path+".observedGroup", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+}
+
+var researchStudyComparisonGroupInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyComparisonGroup) invariants() []invariant {
+	return researchStudyComparisonGroupInvariants
+}
+
+func (r ResearchStudyComparisonGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyComparisonGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyComparisonGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyComparisonGroup) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyComparisonGroupElementTypes = map[string]string{
+	"targetNumber": "unsignedInt",
+	"actualNumber": "unsignedInt",
+	"description":  "markdown",
+}
+
+func (r *ResearchStudyComparisonGroup) elementTypes() map[string]string {
+	return researchStudyComparisonGroupElementTypes
+}
+
+var researchStudyComparisonGroupElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.comparisonGroup.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.comparisonGroup.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.comparisonGroup.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.comparisonGroup.targetNumber", Name: "targetNumber", Field: 3, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ResearchStudy.comparisonGroup.actualNumber", Name: "actualNumber", Field: 5, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "ResearchStudy.comparisonGroup.eligibility", Name: "eligibility", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.comparisonGroup.observedGroup", Name: "observedGroup", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.comparisonGroup.description", Name: "description", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ResearchStudyComparisonGroup) ElementMetadata() []ElementMetadata {
+	return researchStudyComparisonGroupElementMetadata
+}
+
+type ResearchStudyObjective struct {
+	Id                 *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name               *string                                `json:"name,omitempty" bson:"name,omitempty"`                            // Label for the objective
+	NameElement        *Element                               `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Type               *CodeableConcept                       `json:"type,omitempty" bson:"type,omitempty"`                            // primary | secondary | exploratory
+	Description        *string                                `json:"description,omitempty" bson:"description,omitempty"`              // Description of the objective
+	DescriptionElement *Element                               `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	OutcomeMeasure     []ResearchStudyObjectiveOutcomeMeasure `json:"outcomeMeasure,omitempty" bson:"outcome_measure,omitempty"`       // A variable measured during the study
+}
+
+func (r *ResearchStudyObjective) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.OutcomeMeasure {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OutcomeMeasure[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ResearchStudyObjective) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.OutcomeMeasure {
+		item.validateAll(fmt.Sprintf("%s.outcomeMeasure[%d]", path, i), issues)
+	}
+}
+
+var researchStudyObjectiveInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyObjective) invariants() []invariant {
+	return researchStudyObjectiveInvariants
+}
+
+func (r ResearchStudyObjective) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyObjective) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyObjective) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyObjective) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyObjectiveElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ResearchStudyObjective) elementTypes() map[string]string {
+	return researchStudyObjectiveElementTypes
+}
+
+var researchStudyObjectiveElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.objective.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.objective.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.objective.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.objective.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.objective.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.objective.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure", Name: "outcomeMeasure", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ResearchStudyObjective) ElementMetadata() []ElementMetadata {
+	return researchStudyObjectiveElementMetadata
+}
+
+type ResearchStudyObjectiveOutcomeMeasure struct {
+	Id                   *string                                             `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension            []Extension                                         `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension    []Extension                                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Name                 *string                                             `json:"name,omitempty" bson:"name,omitempty"`                                   // Label for the outcome measure
+	NameElement          *Element                                            `json:"_name,omitempty" bson:"name_element,omitempty"`                          // Extensions for name
+	Type                 *CodeableConcept                                    `json:"type,omitempty" bson:"type,omitempty"`                                   // primary | secondary | exploratory
+	Description          *string                                             `json:"description,omitempty" bson:"description,omitempty"`                     // Description of the outcome measure
+	DescriptionElement   *Element                                            `json:"_description,omitempty" bson:"description_element,omitempty"`            // Extensions for description
+	Endpoint             *Reference                                          `json:"endpoint" bson:"endpoint"`                                               // Definition of the outcome measure
+	Population           *Reference                                          `json:"population,omitempty" bson:"population,omitempty"`                       // Population for this estimand
+	Intervention         *Reference                                          `json:"intervention,omitempty" bson:"intervention,omitempty"`                   // Comparison group of interest
+	Comparator           *Reference                                          `json:"comparator,omitempty" bson:"comparator,omitempty"`                       // Comparison group for comparison
+	SummaryMeasure       *CodeableConcept                                    `json:"summaryMeasure,omitempty" bson:"summary_measure,omitempty"`              // Statistical measure for treatment effect estimate
+	EndpointAnalysisPlan *Reference                                          `json:"endpointAnalysisPlan,omitempty" bson:"endpoint_analysis_plan,omitempty"` // Statistical analysis plan for a single endpoint
+	EventHandling        []ResearchStudyObjectiveOutcomeMeasureEventHandling `json:"eventHandling,omitempty" bson:"event_handling,omitempty"`                // Handling of intercurrent event
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Endpoint == nil {
+		return fmt.Errorf("field 'Endpoint' is required")
+	}
+	if r.Endpoint != nil {
+		if err := r.Endpoint.Validate(); err != nil {
+			return fmt.Errorf("Endpoint: %w", err)
+		}
+	}
+	if r.Population != nil {
+		if err := r.Population.Validate(); err != nil {
+			return fmt.Errorf("Population: %w", err)
+		}
+	}
+	if r.Intervention != nil {
+		if err := r.Intervention.Validate(); err != nil {
 			return fmt.Errorf("Intervention: %w", err)
 		}
 	}
@@ -436,15 +1653,122 @@ func (r *ResearchStudyObjectiveOutcomeMeasure) Validate() error {
 	return nil
 }
 
+func (r *ResearchStudyObjectiveOutcomeMeasure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Endpoint == nil {
+		issues.add("required", path+".endpoint", "field 'Endpoint' is required")
+	}
+	if r.Endpoint != nil {
+		r.Endpoint.validateAll(path+".endpoint", issues)
+	}
+	if r.Population != nil {
+		r.Population.validateAll(path+".population", issues)
+	}
+	if r.Intervention != nil {
+		r.Intervention.validateAll(path+".intervention", issues)
+	}
+	if r.Comparator != nil {
+		r.Comparator.validateAll(path+".comparator", issues)
+	}
+	if r.SummaryMeasure != nil {
+		r.SummaryMeasure.validateAll(path+".summaryMeasure", issues)
+	}
+	if r.EndpointAnalysisPlan != nil {
+		r.EndpointAnalysisPlan.validateAll(path+".endpointAnalysisPlan", issues)
+	}
+	for i, item := range r.EventHandling {
+		item.validateAll(fmt.Sprintf("%s.eventHandling[%d]", path, i), issues)
+	}
+}
+
+var researchStudyObjectiveOutcomeMeasureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasure) invariants() []invariant {
+	return researchStudyObjectiveOutcomeMeasureInvariants
+}
+
+func (r ResearchStudyObjectiveOutcomeMeasure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyObjectiveOutcomeMeasure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyObjectiveOutcomeMeasureElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasure) elementTypes() map[string]string {
+	return researchStudyObjectiveOutcomeMeasureElementTypes
+}
+
+var researchStudyObjectiveOutcomeMeasureElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.objective.outcomeMeasure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.objective.outcomeMeasure.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.endpoint", Name: "endpoint", Field: 8, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.population", Name: "population", Field: 9, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.intervention", Name: "intervention", Field: 10, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.comparator", Name: "comparator", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.summaryMeasure", Name: "summaryMeasure", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.endpointAnalysisPlan", Name: "endpointAnalysisPlan", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling", Name: "eventHandling", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasure) ElementMetadata() []ElementMetadata {
+	return researchStudyObjectiveOutcomeMeasureElementMetadata
+}
+
 type ResearchStudyObjectiveOutcomeMeasureEventHandling struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Event       *CodeableConcept `json:"event,omitempty" bson:"event,omitempty"`             // The event
-	Group       *CodeableConcept `json:"group,omitempty" bson:"group,omitempty"`             // The group that is affected by this event handling
-	Handling    *CodeableConcept `json:"handling,omitempty" bson:"handling,omitempty"`       // How the data is handled
-	Description *string          `json:"description,omitempty" bson:"description,omitempty"` // Text summary of event handling
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Event              *CodeableConcept `json:"event,omitempty" bson:"event,omitempty"`                          // The event
+	Group              *CodeableConcept `json:"group,omitempty" bson:"group,omitempty"`                          // The group that is affected by this event handling
+	Handling           *CodeableConcept `json:"handling,omitempty" bson:"handling,omitempty"`                    // How the data is handled
+	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`              // Text summary of event handling
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
 }
 
 func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Event != nil {
 		if err := r.Event.Validate(); err != nil {
 			return fmt.Errorf("Event: %w", err)
@@ -460,5 +1784,77 @@ func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) Validate() error {
 			return fmt.Errorf("Handling: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Event != nil {
+		r.Event.validateAll(path+".event", issues)
+	}
+	if r.Group != nil {
+		r.Group.validateAll(path+".group", issues)
+	}
+	if r.Handling != nil {
+		r.Handling.validateAll(path+".handling", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+}
+
+var researchStudyObjectiveOutcomeMeasureEventHandlingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) invariants() []invariant {
+	return researchStudyObjectiveOutcomeMeasureEventHandlingInvariants
+}
+
+func (r ResearchStudyObjectiveOutcomeMeasureEventHandling) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchStudyObjectiveOutcomeMeasureEventHandling) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchStudyObjectiveOutcomeMeasureEventHandlingElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) elementTypes() map[string]string {
+	return researchStudyObjectiveOutcomeMeasureEventHandlingElementTypes
+}
+
+var researchStudyObjectiveOutcomeMeasureEventHandlingElementMetadata = []ElementMetadata{
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.event", Name: "event", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.group", Name: "group", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.handling", Name: "handling", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchStudy.objective.outcomeMeasure.eventHandling.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ResearchStudyObjectiveOutcomeMeasureEventHandling) ElementMetadata() []ElementMetadata {
+	return researchStudyObjectiveOutcomeMeasureEventHandlingElementMetadata
+}
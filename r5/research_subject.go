@@ -2,29 +2,39 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A ResearchSubject is a participant or object which is the recipient of investigative activities in a research study.
 type ResearchSubject struct {
-	ResourceType     string                            `json:"resourceType" bson:"resource_type"`                             // Type of resource
-	Id               *string                           `json:"id,omitempty" bson:"id,omitempty"`                              // Logical id of this artifact
-	Meta             *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                          // Metadata about the resource
-	ImplicitRules    *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`       // A set of rules under which this content was created
-	Language         *string                           `json:"language,omitempty" bson:"language,omitempty"`                  // Language of the resource content
-	Text             *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                          // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage                 `json:"contained,omitempty" bson:"contained,omitempty"`                // Contained, inline Resources
-	Identifier       []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`              // Business Identifier for research subject in a study
-	Status           string                            `json:"status" bson:"status"`                                          // draft | active | retired | unknown
-	Period           *Period                           `json:"period,omitempty" bson:"period,omitempty"`                      // Start and end of participation
-	Study            *Reference                        `json:"study" bson:"study"`                                            // Study subject is part of
-	Subject          *Reference                        `json:"subject" bson:"subject"`                                        // Who or what is part of study
-	SubjectState     []ResearchSubjectSubjectState     `json:"subjectState,omitempty" bson:"subject_state,omitempty"`         // A duration in the lifecycle of the ResearchSubject within a ResearchStudy
-	SubjectMilestone []ResearchSubjectSubjectMilestone `json:"subjectMilestone,omitempty" bson:"subject_milestone,omitempty"` // A significant event in the progress of a ResearchSubject
-	ComparisonGroup  []CodeableReference               `json:"comparisonGroup,omitempty" bson:"comparison_group,omitempty"`   // A group to which the subject is assigned
-	Consent          []Reference                       `json:"consent,omitempty" bson:"consent,omitempty"`                    // Agreement to participate in study
+	ResourceType         string                            `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                           `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                           `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                          `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                        `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier for research subject in a study
+	Status               PublicationStatus                 `json:"status" bson:"status"`                                             // draft | active | retired | unknown
+	StatusElement        *Element                          `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Period               *Period                           `json:"period,omitempty" bson:"period,omitempty"`                         // Start and end of participation
+	Study                *Reference                        `json:"study" bson:"study"`                                               // Study subject is part of
+	Subject              *Reference                        `json:"subject" bson:"subject"`                                           // Who or what is part of study
+	SubjectState         []ResearchSubjectSubjectState     `json:"subjectState,omitempty" bson:"subject_state,omitempty"`            // A duration in the lifecycle of the ResearchSubject within a ResearchStudy
+	SubjectMilestone     []ResearchSubjectSubjectMilestone `json:"subjectMilestone,omitempty" bson:"subject_milestone,omitempty"`    // A significant event in the progress of a ResearchSubject
+	ComparisonGroup      []CodeableReference               `json:"comparisonGroup,omitempty" bson:"comparison_group,omitempty"`      // A group to which the subject is assigned
+	Consent              []Reference                       `json:"consent,omitempty" bson:"consent,omitempty"`                       // Agreement to participate in study
 }
 
+var researchSubjectStudyTargets = []string{"ResearchStudy"}
+
+var researchSubjectSubjectTargets = []string{"BiologicallyDerivedProduct", "Device", "Group", "Medication", "Patient", "Specimen", "Substance", "SubstanceDefinition"}
+
 func (r *ResearchSubject) Validate() error {
 	if r.ResourceType != "ResearchSubject" {
 		return fmt.Errorf("invalid resourceType: expected 'ResearchSubject', got '%s'", r.ResourceType)
@@ -34,20 +44,55 @@ func (r *ResearchSubject) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Period != nil {
 		if err := r.Period.Validate(); err != nil {
 			return fmt.Errorf("Period: %w", err)
@@ -56,6 +101,11 @@ func (r *ResearchSubject) Validate() error {
 	if r.Study == nil {
 		return fmt.Errorf("field 'Study' is required")
 	}
+	if r.Study != nil {
+		if target := invalidReferenceTarget(r.Study.Reference, r.Study.Type, researchSubjectStudyTargets); target != "" {
+			return fmt.Errorf("field 'Study' cannot refer to %s, expected one of %v", target, researchSubjectStudyTargets)
+		}
+	}
 	if r.Study != nil {
 		if err := r.Study.Validate(); err != nil {
 			return fmt.Errorf("Study: %w", err)
@@ -64,6 +114,11 @@ func (r *ResearchSubject) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, researchSubjectSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, researchSubjectSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -89,18 +144,241 @@ func (r *ResearchSubject) Validate() error {
 			return fmt.Errorf("Consent[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "ResearchSubject")
+}
+
+func (r *ResearchSubject) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ResearchSubject" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ResearchSubject', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
+	}
+	if r.Study == nil {
+		issues.add("required", path+".study", "field 'Study' is required")
+	}
+	if r.Study != nil {
+		if target := invalidReferenceTarget(r.Study.Reference, r.Study.Type, researchSubjectStudyTargets); target != "" {
+			issues.add("value", path+".study", fmt.Sprintf("field 'Study' cannot refer to %s, expected one of %v", target, researchSubjectStudyTargets))
+		}
+	}
+	if r.Study != nil {
+		r.Study.validateAll(path+".study", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, researchSubjectSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, researchSubjectSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.SubjectState {
+		item.validateAll(fmt.Sprintf("%s.subjectState[%d]", path, i), issues)
+	}
+	for i, item := range r.SubjectMilestone {
+		item.validateAll(fmt.Sprintf("%s.subjectMilestone[%d]", path, i), issues)
+	}
+	for i, item := range r.ComparisonGroup {
+		item.validateAll(fmt.Sprintf("%s.comparisonGroup[%d]", path, i), issues)
+	}
+	for i, item := range r.Consent {
+		item.validateAll(fmt.Sprintf("%s.consent[%d]", path, i), issues)
+	}
+}
+
+func (r *ResearchSubject) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ResearchSubject", &issues)
+	checkInvariants(r, "ResearchSubject", &issues)
+	return issues
+}
+
+var researchSubjectInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ResearchSubject) invariants() []invariant {
+	return researchSubjectInvariants
+}
+
+func (r *ResearchSubject) UnmarshalJSON(data []byte) error {
+	type alias ResearchSubject
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r ResearchSubject) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchSubject) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchSubject) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchSubject) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchSubjectElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *ResearchSubject) elementTypes() map[string]string {
+	return researchSubjectElementTypes
+}
+
+var researchSubjectElementMetadata = []ElementMetadata{
+	{Path: "ResearchSubject.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ResearchSubject.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ResearchSubject.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ResearchSubject.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ResearchSubject.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ResearchSubject.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ResearchSubject.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ResearchSubject.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchSubject.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchSubject.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ResearchSubject.status", Name: "status", Field: 12, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ResearchSubject.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ResearchSubject.period", Name: "period", Field: 14, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ResearchSubject.study", Name: "study", Field: 15, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ResearchStudy"}},
+	{Path: "ResearchSubject.subject", Name: "subject", Field: 16, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition"}},
+	{Path: "ResearchSubject.subjectState", Name: "subjectState", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchSubject.subjectMilestone", Name: "subjectMilestone", Field: 18, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ResearchSubject.comparisonGroup", Name: "comparisonGroup", Field: 19, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ResearchSubject.consent", Name: "consent", Field: 20, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *ResearchSubject) ElementMetadata() []ElementMetadata {
+	return researchSubjectElementMetadata
+}
+
+func (r *ResearchSubject) GetResourceType() string {
+	return "ResearchSubject"
+}
+
+func (r *ResearchSubject) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ResearchSubject) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ResearchSubject) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ResearchSubject) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ResearchSubject) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ResearchSubject) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ResearchSubject) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ResearchSubject)(nil)
+
 type ResearchSubjectSubjectState struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	Code      *CodeableConcept `json:"code" bson:"code"`                            // candidate | in-prescreening | in-screening | eligible | ineligible | on-study | on-study-intervention | in-follow-up | off-study
-	StartDate string           `json:"startDate" bson:"start_date"`                 // The date a research subject entered the given state
-	EndDate   *string          `json:"endDate,omitempty" bson:"end_date,omitempty"` // The date a research subject exited or left the given state
-	Reason    *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`    // State change reason
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code" bson:"code"`                                                // candidate | in-prescreening | in-screening | eligible | ineligible | on-study | on-study-intervention | in-follow-up | off-study
+	StartDate         *DateTime        `json:"startDate" bson:"start_date"`                                     // The date a research subject entered the given state
+	StartDateElement  *Element         `json:"_startDate,omitempty" bson:"start_date_element,omitempty"`        // Extensions for startDate
+	EndDate           *DateTime        `json:"endDate,omitempty" bson:"end_date,omitempty"`                     // The date a research subject exited or left the given state
+	EndDateElement    *Element         `json:"_endDate,omitempty" bson:"end_date_element,omitempty"`            // Extensions for endDate
+	Reason            *CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`                        // State change reason
 }
 
 func (r *ResearchSubjectSubjectState) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -109,10 +387,29 @@ func (r *ResearchSubjectSubjectState) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	var emptyString string
-	if r.StartDate == emptyString {
+	if r.StartDate == nil {
 		return fmt.Errorf("field 'StartDate' is required")
 	}
+	if r.StartDate != nil {
+		if err := r.StartDate.Validate(); err != nil {
+			return fmt.Errorf("StartDate: %w", err)
+		}
+	}
+	if r.StartDateElement != nil {
+		if err := r.StartDateElement.Validate(); err != nil {
+			return fmt.Errorf("StartDateElement: %w", err)
+		}
+	}
+	if r.EndDate != nil {
+		if err := r.EndDate.Validate(); err != nil {
+			return fmt.Errorf("EndDate: %w", err)
+		}
+	}
+	if r.EndDateElement != nil {
+		if err := r.EndDateElement.Validate(); err != nil {
+			return fmt.Errorf("EndDateElement: %w", err)
+		}
+	}
 	if r.Reason != nil {
 		if err := r.Reason.Validate(); err != nil {
 			return fmt.Errorf("Reason: %w", err)
@@ -121,14 +418,102 @@ func (r *ResearchSubjectSubjectState) Validate() error {
 	return nil
 }
 
+func (r *ResearchSubjectSubjectState) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.StartDate == nil {
+		issues.add("required", path+".startDate", "field 'StartDate' is required")
+	}
+	if r.StartDate != nil {
+		if err := r.StartDate.Validate(); err != nil {
+			issues.add("value", path+".startDate", err.Error())
+		}
+	}
+	if r.StartDateElement != nil {
+		r.StartDateElement.validateAll(path+".startDate", issues)
+	}
+	if r.EndDate != nil {
+		if err := r.EndDate.Validate(); err != nil {
+			issues.add("value", path+".endDate", err.Error())
+		}
+	}
+	if r.EndDateElement != nil {
+		r.EndDateElement.validateAll(path+".endDate", issues)
+	}
+	if r.Reason != nil {
+		r.Reason.validateAll(path+".reason", issues)
+	}
+}
+
+var researchSubjectSubjectStateInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchSubjectSubjectState) invariants() []invariant {
+	return researchSubjectSubjectStateInvariants
+}
+
+func (r ResearchSubjectSubjectState) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchSubjectSubjectState) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchSubjectSubjectState) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchSubjectSubjectState) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchSubjectSubjectStateElementMetadata = []ElementMetadata{
+	{Path: "ResearchSubject.subjectState.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchSubject.subjectState.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchSubject.subjectState.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchSubject.subjectState.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchSubject.subjectState.startDate", Name: "startDate", Field: 4, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ResearchSubject.subjectState.endDate", Name: "endDate", Field: 6, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ResearchSubject.subjectState.reason", Name: "reason", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ResearchSubjectSubjectState) ElementMetadata() []ElementMetadata {
+	return researchSubjectSubjectStateElementMetadata
+}
+
 type ResearchSubjectSubjectMilestone struct {
-	Id        *string           `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Milestone *CodeableConcept  `json:"milestone" bson:"milestone"`
-	Date      *string           `json:"date,omitempty" bson:"date,omitempty"` // The date/time when this milestone event was completed
-	Reason    []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Milestone         *CodeableConcept  `json:"milestone" bson:"milestone"`
+	Date              *DateTime         `json:"date,omitempty" bson:"date,omitempty"`          // The date/time when this milestone event was completed
+	DateElement       *Element          `json:"_date,omitempty" bson:"date_element,omitempty"` // Extensions for date
+	Reason            []CodeableConcept `json:"reason,omitempty" bson:"reason,omitempty"`
 }
 
 func (r *ResearchSubjectSubjectMilestone) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Milestone == nil {
 		return fmt.Errorf("field 'Milestone' is required")
 	}
@@ -137,6 +522,16 @@ func (r *ResearchSubjectSubjectMilestone) Validate() error {
 			return fmt.Errorf("Milestone: %w", err)
 		}
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
 	for i, item := range r.Reason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Reason[%d]: %w", i, err)
@@ -144,3 +539,66 @@ func (r *ResearchSubjectSubjectMilestone) Validate() error {
 	}
 	return nil
 }
+
+func (r *ResearchSubjectSubjectMilestone) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Milestone == nil {
+		issues.add("required", path+".milestone", "field 'Milestone' is required")
+	}
+	if r.Milestone != nil {
+		r.Milestone.validateAll(path+".milestone", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+}
+
+var researchSubjectSubjectMilestoneInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ResearchSubjectSubjectMilestone) invariants() []invariant {
+	return researchSubjectSubjectMilestoneInvariants
+}
+
+func (r ResearchSubjectSubjectMilestone) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ResearchSubjectSubjectMilestone) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ResearchSubjectSubjectMilestone) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ResearchSubjectSubjectMilestone) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var researchSubjectSubjectMilestoneElementMetadata = []ElementMetadata{
+	{Path: "ResearchSubject.subjectMilestone.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ResearchSubject.subjectMilestone.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ResearchSubject.subjectMilestone.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ResearchSubject.subjectMilestone.milestone", Name: "milestone", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ResearchSubject.subjectMilestone.date", Name: "date", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ResearchSubject.subjectMilestone.reason", Name: "reason", Field: 6, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *ResearchSubjectSubjectMilestone) ElementMetadata() []ElementMetadata {
+	return researchSubjectSubjectMilestoneElementMetadata
+}
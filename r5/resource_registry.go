@@ -0,0 +1,232 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+var resourceFactories = map[string]func() Resource{
+	"Account":                        func() Resource { return &Account{} },
+	"ActivityDefinition":             func() Resource { return &ActivityDefinition{} },
+	"ActorDefinition":                func() Resource { return &ActorDefinition{} },
+	"AdministrableProductDefinition": func() Resource { return &AdministrableProductDefinition{} },
+	"AdverseEvent":                   func() Resource { return &AdverseEvent{} },
+	"AllergyIntolerance":             func() Resource { return &AllergyIntolerance{} },
+	"Appointment":                    func() Resource { return &Appointment{} },
+	"AppointmentResponse":            func() Resource { return &AppointmentResponse{} },
+	"ArtifactAssessment":             func() Resource { return &ArtifactAssessment{} },
+	"AuditEvent":                     func() Resource { return &AuditEvent{} },
+	"Basic":                          func() Resource { return &Basic{} },
+	"Binary":                         func() Resource { return &Binary{} },
+	"BiologicallyDerivedProduct":     func() Resource { return &BiologicallyDerivedProduct{} },
+	"BodyStructure":                  func() Resource { return &BodyStructure{} },
+	"Bundle":                         func() Resource { return &Bundle{} },
+	"CapabilityStatement":            func() Resource { return &CapabilityStatement{} },
+	"CarePlan":                       func() Resource { return &CarePlan{} },
+	"CareTeam":                       func() Resource { return &CareTeam{} },
+	"Claim":                          func() Resource { return &Claim{} },
+	"ClaimResponse":                  func() Resource { return &ClaimResponse{} },
+	"ClinicalUseDefinition":          func() Resource { return &ClinicalUseDefinition{} },
+	"CodeSystem":                     func() Resource { return &CodeSystem{} },
+	"Communication":                  func() Resource { return &Communication{} },
+	"CommunicationRequest":           func() Resource { return &CommunicationRequest{} },
+	"CompartmentDefinition":          func() Resource { return &CompartmentDefinition{} },
+	"Composition":                    func() Resource { return &Composition{} },
+	"ConceptMap":                     func() Resource { return &ConceptMap{} },
+	"Condition":                      func() Resource { return &Condition{} },
+	"Consent":                        func() Resource { return &Consent{} },
+	"Contract":                       func() Resource { return &Contract{} },
+	"CoverageEligibilityRequest":     func() Resource { return &CoverageEligibilityRequest{} },
+	"CoverageEligibilityResponse":    func() Resource { return &CoverageEligibilityResponse{} },
+	"DetectedIssue":                  func() Resource { return &DetectedIssue{} },
+	"Device":                         func() Resource { return &Device{} },
+	"DeviceAlert":                    func() Resource { return &DeviceAlert{} },
+	"DeviceAssociation":              func() Resource { return &DeviceAssociation{} },
+	"DeviceDefinition":               func() Resource { return &DeviceDefinition{} },
+	"DeviceMetric":                   func() Resource { return &DeviceMetric{} },
+	"DeviceRequest":                  func() Resource { return &DeviceRequest{} },
+	"DiagnosticReport":               func() Resource { return &DiagnosticReport{} },
+	"DocumentReference":              func() Resource { return &DocumentReference{} },
+	"Encounter":                      func() Resource { return &Encounter{} },
+	"Endpoint":                       func() Resource { return &Endpoint{} },
+	"EnrollmentRequest":              func() Resource { return &EnrollmentRequest{} },
+	"EnrollmentResponse":             func() Resource { return &EnrollmentResponse{} },
+	"EpisodeOfCare":                  func() Resource { return &EpisodeOfCare{} },
+	"EventDefinition":                func() Resource { return &EventDefinition{} },
+	"Evidence":                       func() Resource { return &Evidence{} },
+	"EvidenceVariable":               func() Resource { return &EvidenceVariable{} },
+	"ExampleScenario":                func() Resource { return &ExampleScenario{} },
+	"ExplanationOfBenefit":           func() Resource { return &ExplanationOfBenefit{} },
+	"FamilyMemberHistory":            func() Resource { return &FamilyMemberHistory{} },
+	"Flag":                           func() Resource { return &Flag{} },
+	"Goal":                           func() Resource { return &Goal{} },
+	"Group":                          func() Resource { return &Group{} },
+	"GuidanceResponse":               func() Resource { return &GuidanceResponse{} },
+	"HealthcareService":              func() Resource { return &HealthcareService{} },
+	"ImagingSelection":               func() Resource { return &ImagingSelection{} },
+	"ImagingStudy":                   func() Resource { return &ImagingStudy{} },
+	"Immunization":                   func() Resource { return &Immunization{} },
+	"ImplementationGuide":            func() Resource { return &ImplementationGuide{} },
+	"Ingredient":                     func() Resource { return &Ingredient{} },
+	"InsurancePlan":                  func() Resource { return &InsurancePlan{} },
+	"InsuranceProduct":               func() Resource { return &InsuranceProduct{} },
+	"Invoice":                        func() Resource { return &Invoice{} },
+	"Library":                        func() Resource { return &Library{} },
+	"List":                           func() Resource { return &List{} },
+	"Location":                       func() Resource { return &Location{} },
+	"ManufacturedItemDefinition":     func() Resource { return &ManufacturedItemDefinition{} },
+	"Measure":                        func() Resource { return &Measure{} },
+	"MeasureReport":                  func() Resource { return &MeasureReport{} },
+	"Medication":                     func() Resource { return &Medication{} },
+	"MedicationAdministration":       func() Resource { return &MedicationAdministration{} },
+	"MedicationDispense":             func() Resource { return &MedicationDispense{} },
+	"MedicationRequest":              func() Resource { return &MedicationRequest{} },
+	"MedicationStatement":            func() Resource { return &MedicationStatement{} },
+	"MedicinalProductDefinition":     func() Resource { return &MedicinalProductDefinition{} },
+	"MessageDefinition":              func() Resource { return &MessageDefinition{} },
+	"MessageHeader":                  func() Resource { return &MessageHeader{} },
+	"NamingSystem":                   func() Resource { return &NamingSystem{} },
+	"NutritionIntake":                func() Resource { return &NutritionIntake{} },
+	"NutritionOrder":                 func() Resource { return &NutritionOrder{} },
+	"NutritionProduct":               func() Resource { return &NutritionProduct{} },
+	"Observation":                    func() Resource { return &Observation{} },
+	"ObservationDefinition":          func() Resource { return &ObservationDefinition{} },
+	"OperationDefinition":            func() Resource { return &OperationDefinition{} },
+	"OperationOutcome":               func() Resource { return &OperationOutcome{} },
+	"Organization":                   func() Resource { return &Organization{} },
+	"OrganizationAffiliation":        func() Resource { return &OrganizationAffiliation{} },
+	"PackagedProductDefinition":      func() Resource { return &PackagedProductDefinition{} },
+	"Parameters":                     func() Resource { return &Parameters{} },
+	"Patient":                        func() Resource { return &Patient{} },
+	"PaymentNotice":                  func() Resource { return &PaymentNotice{} },
+	"PaymentReconciliation":          func() Resource { return &PaymentReconciliation{} },
+	"Person":                         func() Resource { return &Person{} },
+	"PlanDefinition":                 func() Resource { return &PlanDefinition{} },
+	"Practitioner":                   func() Resource { return &Practitioner{} },
+	"PractitionerRole":               func() Resource { return &PractitionerRole{} },
+	"Procedure":                      func() Resource { return &Procedure{} },
+	"Provenance":                     func() Resource { return &Provenance{} },
+	"Questionnaire":                  func() Resource { return &Questionnaire{} },
+	"QuestionnaireResponse":          func() Resource { return &QuestionnaireResponse{} },
+	"RegulatedAuthorization":         func() Resource { return &RegulatedAuthorization{} },
+	"RelatedPerson":                  func() Resource { return &RelatedPerson{} },
+	"RequestOrchestration":           func() Resource { return &RequestOrchestration{} },
+	"Requirements":                   func() Resource { return &Requirements{} },
+	"ResearchStudy":                  func() Resource { return &ResearchStudy{} },
+	"ResearchSubject":                func() Resource { return &ResearchSubject{} },
+	"RiskAssessment":                 func() Resource { return &RiskAssessment{} },
+	"Schedule":                       func() Resource { return &Schedule{} },
+	"SearchParameter":                func() Resource { return &SearchParameter{} },
+	"ServiceRequest":                 func() Resource { return &ServiceRequest{} },
+	"Slot":                           func() Resource { return &Slot{} },
+	"Specimen":                       func() Resource { return &Specimen{} },
+	"SpecimenDefinition":             func() Resource { return &SpecimenDefinition{} },
+	"StructureDefinition":            func() Resource { return &StructureDefinition{} },
+	"StructureMap":                   func() Resource { return &StructureMap{} },
+	"Subscription":                   func() Resource { return &Subscription{} },
+	"SubscriptionStatus":             func() Resource { return &SubscriptionStatus{} },
+	"SubscriptionTopic":              func() Resource { return &SubscriptionTopic{} },
+	"Substance":                      func() Resource { return &Substance{} },
+	"SubstanceDefinition":            func() Resource { return &SubstanceDefinition{} },
+	"Task":                           func() Resource { return &Task{} },
+	"TerminologyCapabilities":        func() Resource { return &TerminologyCapabilities{} },
+	"ValueSet":                       func() Resource { return &ValueSet{} },
+	"VisionPrescription":             func() Resource { return &VisionPrescription{} },
+}
+
+// NewResource returns a new, empty resource of the given type. ok is false
+// when the type is not a known concrete resource.
+func NewResource(resourceType string) (res Resource, ok bool) {
+	factory, ok := resourceFactories[resourceType]
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// UnmarshalResource decodes a JSON resource into the concrete type named by
+// its resourceType, e.g. *Patient or *Bundle.
+func UnmarshalResource(data []byte) (Resource, error) {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	if header.ResourceType == "" {
+		return nil, fmt.Errorf("missing resourceType")
+	}
+	res, ok := NewResource(header.ResourceType)
+	if !ok {
+		return nil, fmt.Errorf("unknown resourceType '%s'", header.ResourceType)
+	}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf("%s: %w", header.ResourceType, err)
+	}
+	return res, nil
+}
+
+func unmarshalOptionalResource(data json.RawMessage) (Resource, error) {
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+	return UnmarshalResource(data)
+}
+
+func init() {
+	newResourceValue = func(resourceType string) (any, bool) {
+		return NewResource(resourceType)
+	}
+}
+
+// MarshalResourceXML encodes a resource as FHIR XML, in the element named
+// after its type.
+func MarshalResourceXML(res Resource) ([]byte, error) {
+	return xml.Marshal(res)
+}
+
+// UnmarshalResourceXML decodes a FHIR XML resource into the concrete type
+// named by its root element, e.g. *Patient or *Bundle.
+func UnmarshalResourceXML(data []byte) (Resource, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Space != fhirNamespace {
+			return nil, fmt.Errorf("element %s is not in the FHIR namespace", start.Name.Local)
+		}
+		res, ok := NewResource(start.Name.Local)
+		if !ok {
+			return nil, fmt.Errorf("unknown resourceType '%s'", start.Name.Local)
+		}
+		if err := d.DecodeElement(res, &start); err != nil {
+			return nil, fmt.Errorf("%s: %w", start.Name.Local, err)
+		}
+		return res, nil
+	}
+}
+
+// MarshalResourceTurtle encodes a resource as FHIR RDF in Turtle syntax. A
+// resource with an id is named by its type and id relative to base, which
+// defaults to http://hl7.org/fhir/ and also resolves relative references.
+func MarshalResourceTurtle(res Resource, base string) ([]byte, error) {
+	return marshalTurtle(res, base)
+}
+
+// UnmarshalResourceTurtle decodes the FHIR RDF resource of a Turtle document,
+// the node with fhir:nodeRole fhir:treeRoot, into its concrete type.
+func UnmarshalResourceTurtle(data []byte) (Resource, error) {
+	res, err := unmarshalTurtle(data)
+	if err != nil {
+		return nil, err
+	}
+	return res.(Resource), nil
+}
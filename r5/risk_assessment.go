@@ -2,37 +2,54 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An assessment of the likely outcome(s) for a patient or other subject as well as the likelihood of each outcome.
 type RiskAssessment struct {
-	ResourceType       string                     `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                    `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                    `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage          `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier         []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Unique identifier for the assessment
-	BasedOn            *Reference                 `json:"basedOn,omitempty" bson:"based_on,omitempty"`                        // Request fulfilled by this assessment
-	Parent             *Reference                 `json:"parent,omitempty" bson:"parent,omitempty"`                           // Part of this occurrence
-	Status             string                     `json:"status" bson:"status"`                                               // registered | specimen-in-process | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown | cannot-be-obtained
-	Method             *CodeableConcept           `json:"method,omitempty" bson:"method,omitempty"`                           // Evaluation mechanism
-	Code               *CodeableConcept           `json:"code,omitempty" bson:"code,omitempty"`                               // Type of assessment
-	Subject            *Reference                 `json:"subject" bson:"subject"`                                             // Who/what does assessment apply to?
-	Encounter          *Reference                 `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Where was assessment performed?
-	OccurrenceDateTime *string                    `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // When was assessment made?
-	OccurrencePeriod   *Period                    `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // When was assessment made?
-	Condition          *Reference                 `json:"condition,omitempty" bson:"condition,omitempty"`                     // Condition assessed
-	Performer          *Reference                 `json:"performer,omitempty" bson:"performer,omitempty"`                     // Who did assessment?
-	Reason             []CodeableReference        `json:"reason,omitempty" bson:"reason,omitempty"`                           // Why was the assessment necessary?
-	Basis              []Reference                `json:"basis,omitempty" bson:"basis,omitempty"`                             // Information used in assessment
-	Prediction         []RiskAssessmentPrediction `json:"prediction,omitempty" bson:"prediction,omitempty"`                   // Outcome predicted
-	Mitigation         *string                    `json:"mitigation,omitempty" bson:"mitigation,omitempty"`                   // How to reduce risk
-	Note               []Annotation               `json:"note,omitempty" bson:"note,omitempty"`                               // Comments on the risk assessment
+	ResourceType         string                     `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                    `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                   `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                    `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                   `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                 `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Unique identifier for the assessment
+	BasedOn              *Reference                 `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // Request fulfilled by this assessment
+	Parent               *Reference                 `json:"parent,omitempty" bson:"parent,omitempty"`                         // Part of this occurrence
+	Status               ObservationStatus          `json:"status" bson:"status"`                                             // registered | specimen-in-process | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown | cannot-be-obtained
+	StatusElement        *Element                   `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Method               *CodeableConcept           `json:"method,omitempty" bson:"method,omitempty"`                         // Evaluation mechanism
+	Code                 *CodeableConcept           `json:"code,omitempty" bson:"code,omitempty"`                             // Type of assessment
+	Subject              *Reference                 `json:"subject" bson:"subject"`                                           // Who/what does assessment apply to?
+	Encounter            *Reference                 `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Where was assessment performed?
+	Occurrence           RiskAssessmentOccurrence   `json:"-" bson:"occurrence,omitempty"`                                    // When was assessment made?
+	OccurrenceElement    *Element                   `json:"-" bson:"occurrence_element,omitempty"`                            // Extensions for occurrence[x]
+	Condition            *Reference                 `json:"condition,omitempty" bson:"condition,omitempty"`                   // Condition assessed
+	Performer            *Reference                 `json:"performer,omitempty" bson:"performer,omitempty"`                   // Who did assessment?
+	Reason               []CodeableReference        `json:"reason,omitempty" bson:"reason,omitempty"`                         // Why was the assessment necessary?
+	Basis                []Reference                `json:"basis,omitempty" bson:"basis,omitempty"`                           // Information used in assessment
+	Prediction           []RiskAssessmentPrediction `json:"prediction,omitempty" bson:"prediction,omitempty"`                 // Outcome predicted
+	Mitigation           *string                    `json:"mitigation,omitempty" bson:"mitigation,omitempty"`                 // How to reduce risk
+	MitigationElement    *Element                   `json:"_mitigation,omitempty" bson:"mitigation_element,omitempty"`        // Extensions for mitigation
+	Note                 []Annotation               `json:"note,omitempty" bson:"note,omitempty"`                             // Comments on the risk assessment
+
+	occurrenceVariants []string // JSON properties of RiskAssessment.occurrence[x] when more than one was decoded
 }
 
+var riskAssessmentSubjectTargets = []string{"Group", "Patient"}
+
+var riskAssessmentEncounterTargets = []string{"Encounter"}
+
+var riskAssessmentConditionTargets = []string{"Condition"}
+
+var riskAssessmentPerformerTargets = []string{"Device", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *RiskAssessment) Validate() error {
 	if r.ResourceType != "RiskAssessment" {
 		return fmt.Errorf("invalid resourceType: expected 'RiskAssessment', got '%s'", r.ResourceType)
@@ -42,11 +59,39 @@ func (r *RiskAssessment) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -62,10 +107,17 @@ func (r *RiskAssessment) Validate() error {
 			return fmt.Errorf("Parent: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Method != nil {
 		if err := r.Method.Validate(); err != nil {
 			return fmt.Errorf("Method: %w", err)
@@ -79,19 +131,42 @@ func (r *RiskAssessment) Validate() error {
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, riskAssessmentSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, riskAssessmentSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, riskAssessmentEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, riskAssessmentEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
+		}
+	}
+	if r.Condition != nil {
+		if target := invalidReferenceTarget(r.Condition.Reference, r.Condition.Type, riskAssessmentConditionTargets); target != "" {
+			return fmt.Errorf("field 'Condition' cannot refer to %s, expected one of %v", target, riskAssessmentConditionTargets)
 		}
 	}
 	if r.Condition != nil {
@@ -99,6 +174,11 @@ func (r *RiskAssessment) Validate() error {
 			return fmt.Errorf("Condition: %w", err)
 		}
 	}
+	if r.Performer != nil {
+		if target := invalidReferenceTarget(r.Performer.Reference, r.Performer.Type, riskAssessmentPerformerTargets); target != "" {
+			return fmt.Errorf("field 'Performer' cannot refer to %s, expected one of %v", target, riskAssessmentPerformerTargets)
+		}
+	}
 	if r.Performer != nil {
 		if err := r.Performer.Validate(); err != nil {
 			return fmt.Errorf("Performer: %w", err)
@@ -119,41 +199,405 @@ func (r *RiskAssessment) Validate() error {
 			return fmt.Errorf("Prediction[%d]: %w", i, err)
 		}
 	}
+	if r.MitigationElement != nil {
+		if err := r.MitigationElement.Validate(); err != nil {
+			return fmt.Errorf("MitigationElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "RiskAssessment")
+}
+
+func (r *RiskAssessment) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "RiskAssessment" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'RiskAssessment', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.BasedOn != nil {
+		r.BasedOn.validateAll(path+".basedOn", issues)
+	}
+	if r.Parent != nil {
+		r.Parent.validateAll(path+".parent", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, riskAssessmentSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, riskAssessmentSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, riskAssessmentEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, riskAssessmentEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.Condition != nil {
+		if target := invalidReferenceTarget(r.Condition.Reference, r.Condition.Type, riskAssessmentConditionTargets); target != "" {
+			issues.add("value", path+".condition", fmt.Sprintf("field 'Condition' cannot refer to %s, expected one of %v", target, riskAssessmentConditionTargets))
+		}
+	}
+	if r.Condition != nil {
+		r.Condition.validateAll(path+".condition", issues)
+	}
+	if r.Performer != nil {
+		if target := invalidReferenceTarget(r.Performer.Reference, r.Performer.Type, riskAssessmentPerformerTargets); target != "" {
+			issues.add("value", path+".performer", fmt.Sprintf("field 'Performer' cannot refer to %s, expected one of %v", target, riskAssessmentPerformerTargets))
+		}
+	}
+	if r.Performer != nil {
+		r.Performer.validateAll(path+".performer", issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Basis {
+		item.validateAll(fmt.Sprintf("%s.basis[%d]", path, i), issues)
+	}
+	for i, item := range r.Prediction {
+		item.validateAll(fmt.Sprintf("%s.prediction[%d]", path, i), issues)
+	}
+	if r.MitigationElement != nil {
+		r.MitigationElement.validateAll(path+".mitigation", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *RiskAssessment) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("RiskAssessment", &issues)
+	checkInvariants(r, "RiskAssessment", &issues)
+	return issues
+}
+
+var riskAssessmentInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *RiskAssessment) invariants() []invariant {
+	return riskAssessmentInvariants
+}
+
+func (r RiskAssessment) MarshalJSON() ([]byte, error) {
+	type alias RiskAssessment
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RiskAssessment) UnmarshalJSON(data []byte) error {
+	type alias RiskAssessment
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", riskAssessmentOccurrenceVariants); err != nil {
+		return err
+	}
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r RiskAssessment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RiskAssessment) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RiskAssessment) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RiskAssessment) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RiskAssessment) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Occurrence":
+		return "occurrence", choiceValues(riskAssessmentOccurrenceVariants)
+	}
+	return "", nil
+}
+
+var riskAssessmentElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *RiskAssessment) elementTypes() map[string]string {
+	return riskAssessmentElementTypes
+}
+
+var riskAssessmentElementMetadata = []ElementMetadata{
+	{Path: "RiskAssessment.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "RiskAssessment.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "RiskAssessment.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "RiskAssessment.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RiskAssessment.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "RiskAssessment.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "RiskAssessment.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "RiskAssessment.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RiskAssessment.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RiskAssessment.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "RiskAssessment.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "RiskAssessment.parent", Name: "parent", Field: 13, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "RiskAssessment.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "RiskAssessment.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/observation-status"}},
+	{Path: "RiskAssessment.method", Name: "method", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RiskAssessment.code", Name: "code", Field: 17, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RiskAssessment.subject", Name: "subject", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "RiskAssessment.encounter", Name: "encounter", Field: 19, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "RiskAssessment.occurrence[x]", Name: "occurrence", Field: 20, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "RiskAssessment.condition", Name: "condition", Field: 22, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Condition"}},
+	{Path: "RiskAssessment.performer", Name: "performer", Field: 23, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "RiskAssessment.reason", Name: "reason", Field: 24, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "RiskAssessment.basis", Name: "basis", Field: 25, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "RiskAssessment.prediction", Name: "prediction", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "RiskAssessment.mitigation", Name: "mitigation", Field: 27, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RiskAssessment.note", Name: "note", Field: 29, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *RiskAssessment) ElementMetadata() []ElementMetadata {
+	return riskAssessmentElementMetadata
+}
+
+// RiskAssessmentOccurrence is the type of RiskAssessment.occurrence[x]. It is implemented by the RiskAssessmentOccurrence* variant types.
+type RiskAssessmentOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRiskAssessmentOccurrence()
+}
+
+// RiskAssessmentOccurrenceDateTime is the dateTime variant of RiskAssessmentOccurrence.
+type RiskAssessmentOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v RiskAssessmentOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v RiskAssessmentOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v RiskAssessmentOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v RiskAssessmentOccurrenceDateTime) isRiskAssessmentOccurrence() {}
+
+// RiskAssessmentOccurrencePeriod is the Period variant of RiskAssessmentOccurrence.
+type RiskAssessmentOccurrencePeriod struct {
+	Period
+}
+
+func (v RiskAssessmentOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v RiskAssessmentOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v RiskAssessmentOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v RiskAssessmentOccurrencePeriod) isRiskAssessmentOccurrence() {}
+
+var riskAssessmentOccurrenceVariants = []RiskAssessmentOccurrence{
+	RiskAssessmentOccurrenceDateTime{},
+	RiskAssessmentOccurrencePeriod{},
+}
+
+func (r *RiskAssessment) GetResourceType() string {
+	return "RiskAssessment"
+}
+
+func (r *RiskAssessment) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *RiskAssessment) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *RiskAssessment) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *RiskAssessment) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *RiskAssessment) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *RiskAssessment) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *RiskAssessment) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*RiskAssessment)(nil)
+
 type RiskAssessmentPrediction struct {
-	Id                  *string          `json:"id,omitempty" bson:"id,omitempty"`                                    // Unique id for inter-element referencing
-	Outcome             *CodeableConcept `json:"outcome,omitempty" bson:"outcome,omitempty"`                          // Possible outcome for the subject
-	ProbabilityDecimal  *float64         `json:"probabilityDecimal,omitempty" bson:"probability_decimal,omitempty"`   // Likelihood of specified outcome
-	ProbabilityQuantity *Quantity        `json:"probabilityQuantity,omitempty" bson:"probability_quantity,omitempty"` // Likelihood of specified outcome
-	ProbabilityRange    *Range           `json:"probabilityRange,omitempty" bson:"probability_range,omitempty"`       // Likelihood of specified outcome
-	QualitativeRisk     *CodeableConcept `json:"qualitativeRisk,omitempty" bson:"qualitative_risk,omitempty"`         // Likelihood of specified outcome as a qualitative value
-	RelativeRisk        *float64         `json:"relativeRisk,omitempty" bson:"relative_risk,omitempty"`               // Relative likelihood
-	WhenPeriod          *Period          `json:"whenPeriod,omitempty" bson:"when_period,omitempty"`                   // Timeframe or age range
-	WhenRange           *Range           `json:"whenRange,omitempty" bson:"when_range,omitempty"`                     // Timeframe or age range
-	Rationale           *string          `json:"rationale,omitempty" bson:"rationale,omitempty"`                      // Explanation of prediction
+	Id                  *string                             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Outcome             *CodeableConcept                    `json:"outcome,omitempty" bson:"outcome,omitempty"`                      // Possible outcome for the subject
+	Probability         RiskAssessmentPredictionProbability `json:"-" bson:"probability,omitempty"`                                  // Likelihood of specified outcome
+	ProbabilityElement  *Element                            `json:"-" bson:"probability_element,omitempty"`                          // Extensions for probability[x]
+	QualitativeRisk     *CodeableConcept                    `json:"qualitativeRisk,omitempty" bson:"qualitative_risk,omitempty"`     // Likelihood of specified outcome as a qualitative value
+	RelativeRisk        *Decimal                            `json:"relativeRisk,omitempty" bson:"relative_risk,omitempty"`           // Relative likelihood
+	RelativeRiskElement *Element                            `json:"_relativeRisk,omitempty" bson:"relative_risk_element,omitempty"`  // Extensions for relativeRisk
+	When                RiskAssessmentPredictionWhen        `json:"-" bson:"when,omitempty"`                                         // Timeframe or age range
+	Rationale           *string                             `json:"rationale,omitempty" bson:"rationale,omitempty"`                  // Explanation of prediction
+	RationaleElement    *Element                            `json:"_rationale,omitempty" bson:"rationale_element,omitempty"`         // Extensions for rationale
+
+	probabilityVariants []string // JSON properties of RiskAssessment.prediction.probability[x] when more than one was decoded
+	whenVariants        []string // JSON properties of RiskAssessment.prediction.when[x] when more than one was decoded
 }
 
 func (r *RiskAssessmentPrediction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Outcome != nil {
 		if err := r.Outcome.Validate(); err != nil {
 			return fmt.Errorf("Outcome: %w", err)
 		}
 	}
-	if r.ProbabilityQuantity != nil {
-		if err := r.ProbabilityQuantity.Validate(); err != nil {
-			return fmt.Errorf("ProbabilityQuantity: %w", err)
+	if len(r.probabilityVariants) > 1 {
+		return fmt.Errorf("field 'Probability' must have a single type, got %v", r.probabilityVariants)
+	}
+	if r.Probability != nil {
+		if err := r.Probability.Validate(); err != nil {
+			return fmt.Errorf("Probability: %w", err)
 		}
 	}
-	if r.ProbabilityRange != nil {
-		if err := r.ProbabilityRange.Validate(); err != nil {
-			return fmt.Errorf("ProbabilityRange: %w", err)
+	if r.ProbabilityElement != nil {
+		if err := r.ProbabilityElement.Validate(); err != nil {
+			return fmt.Errorf("ProbabilityElement: %w", err)
 		}
 	}
 	if r.QualitativeRisk != nil {
@@ -161,15 +605,290 @@ func (r *RiskAssessmentPrediction) Validate() error {
 			return fmt.Errorf("QualitativeRisk: %w", err)
 		}
 	}
-	if r.WhenPeriod != nil {
-		if err := r.WhenPeriod.Validate(); err != nil {
-			return fmt.Errorf("WhenPeriod: %w", err)
+	if r.RelativeRisk != nil {
+		if err := r.RelativeRisk.Validate(); err != nil {
+			return fmt.Errorf("RelativeRisk: %w", err)
+		}
+	}
+	if r.RelativeRiskElement != nil {
+		if err := r.RelativeRiskElement.Validate(); err != nil {
+			return fmt.Errorf("RelativeRiskElement: %w", err)
+		}
+	}
+	if len(r.whenVariants) > 1 {
+		return fmt.Errorf("field 'When' must have a single type, got %v", r.whenVariants)
+	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
 		}
 	}
-	if r.WhenRange != nil {
-		if err := r.WhenRange.Validate(); err != nil {
-			return fmt.Errorf("WhenRange: %w", err)
+	if r.RationaleElement != nil {
+		if err := r.RationaleElement.Validate(); err != nil {
+			return fmt.Errorf("RationaleElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *RiskAssessmentPrediction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Outcome != nil {
+		r.Outcome.validateAll(path+".outcome", issues)
+	}
+	if len(r.probabilityVariants) > 1 {
+		issues.add("structure", path+".probability", fmt.Sprintf("field 'Probability' must have a single type, got %v", r.probabilityVariants))
+	}
+	if r.Probability != nil {
+		r.Probability.validateAll(path+".probability.ofType("+r.Probability.FHIRType()+")", issues)
+	}
+	if r.ProbabilityElement != nil {
+		r.ProbabilityElement.validateAll(path+".probability", issues)
+	}
+	if r.QualitativeRisk != nil {
+		r.QualitativeRisk.validateAll(path+".qualitativeRisk", issues)
+	}
+	if r.RelativeRisk != nil {
+		if err := r.RelativeRisk.Validate(); err != nil {
+			issues.add("value", path+".relativeRisk", err.Error())
+		}
+	}
+	if r.RelativeRiskElement != nil {
+		r.RelativeRiskElement.validateAll(path+".relativeRisk", issues)
+	}
+	if len(r.whenVariants) > 1 {
+		issues.add("structure", path+".when", fmt.Sprintf("field 'When' must have a single type, got %v", r.whenVariants))
+	}
+	if r.When != nil {
+		r.When.validateAll(path+".when.ofType("+r.When.FHIRType()+")", issues)
+	}
+	if r.RationaleElement != nil {
+		r.RationaleElement.validateAll(path+".rationale", issues)
+	}
+}
+
+var riskAssessmentPredictionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *RiskAssessmentPrediction) invariants() []invariant {
+	return riskAssessmentPredictionInvariants
+}
+
+func (r RiskAssessmentPrediction) MarshalJSON() ([]byte, error) {
+	type alias RiskAssessmentPrediction
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "probability", r.Probability, r.ProbabilityElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "when", r.When, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *RiskAssessmentPrediction) UnmarshalJSON(data []byte) error {
+	type alias RiskAssessmentPrediction
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Probability, element, r.probabilityVariants, err = unmarshalChoice(fields, "probability", riskAssessmentPredictionProbabilityVariants); err != nil {
+		return err
+	}
+	r.ProbabilityElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ProbabilityElement); err != nil {
+			return fmt.Errorf("_probability: %w", err)
 		}
 	}
+	if r.When, _, r.whenVariants, err = unmarshalChoice(fields, "when", riskAssessmentPredictionWhenVariants); err != nil {
+		return err
+	}
 	return nil
 }
+
+func (r RiskAssessmentPrediction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *RiskAssessmentPrediction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r RiskAssessmentPrediction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *RiskAssessmentPrediction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *RiskAssessmentPrediction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Probability":
+		return "probability", choiceValues(riskAssessmentPredictionProbabilityVariants)
+	case "When":
+		return "when", choiceValues(riskAssessmentPredictionWhenVariants)
+	}
+	return "", nil
+}
+
+var riskAssessmentPredictionElementMetadata = []ElementMetadata{
+	{Path: "RiskAssessment.prediction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "RiskAssessment.prediction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "RiskAssessment.prediction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "RiskAssessment.prediction.outcome", Name: "outcome", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RiskAssessment.prediction.probability[x]", Name: "probability", Field: 4, Min: 0, Max: "1", Types: []string{"decimal", "Quantity", "Range"}},
+	{Path: "RiskAssessment.prediction.qualitativeRisk", Name: "qualitativeRisk", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "RiskAssessment.prediction.relativeRisk", Name: "relativeRisk", Field: 7, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "RiskAssessment.prediction.when[x]", Name: "when", Field: 9, Min: 0, Max: "1", Types: []string{"Period", "Range"}},
+	{Path: "RiskAssessment.prediction.rationale", Name: "rationale", Field: 10, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *RiskAssessmentPrediction) ElementMetadata() []ElementMetadata {
+	return riskAssessmentPredictionElementMetadata
+}
+
+// RiskAssessmentPredictionProbability is the type of RiskAssessment.prediction.probability[x]. It is implemented by the RiskAssessmentPredictionProbability* variant types.
+type RiskAssessmentPredictionProbability interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRiskAssessmentPredictionProbability()
+}
+
+// RiskAssessmentPredictionProbabilityDecimal is the decimal variant of RiskAssessmentPredictionProbability.
+type RiskAssessmentPredictionProbabilityDecimal struct {
+	Decimal
+}
+
+func (v RiskAssessmentPredictionProbabilityDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v RiskAssessmentPredictionProbabilityDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v RiskAssessmentPredictionProbabilityDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v RiskAssessmentPredictionProbabilityDecimal) isRiskAssessmentPredictionProbability() {}
+
+// RiskAssessmentPredictionProbabilityQuantity is the Quantity variant of RiskAssessmentPredictionProbability.
+type RiskAssessmentPredictionProbabilityQuantity struct {
+	Quantity
+}
+
+func (v RiskAssessmentPredictionProbabilityQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v RiskAssessmentPredictionProbabilityQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v RiskAssessmentPredictionProbabilityQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v RiskAssessmentPredictionProbabilityQuantity) isRiskAssessmentPredictionProbability() {}
+
+// RiskAssessmentPredictionProbabilityRange is the Range variant of RiskAssessmentPredictionProbability.
+type RiskAssessmentPredictionProbabilityRange struct {
+	Range
+}
+
+func (v RiskAssessmentPredictionProbabilityRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v RiskAssessmentPredictionProbabilityRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v RiskAssessmentPredictionProbabilityRange) FHIRType() string {
+	return "Range"
+}
+
+func (v RiskAssessmentPredictionProbabilityRange) isRiskAssessmentPredictionProbability() {}
+
+var riskAssessmentPredictionProbabilityVariants = []RiskAssessmentPredictionProbability{
+	RiskAssessmentPredictionProbabilityDecimal{},
+	RiskAssessmentPredictionProbabilityQuantity{},
+	RiskAssessmentPredictionProbabilityRange{},
+}
+
+// RiskAssessmentPredictionWhen is the type of RiskAssessment.prediction.when[x]. It is implemented by the RiskAssessmentPredictionWhen* variant types.
+type RiskAssessmentPredictionWhen interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isRiskAssessmentPredictionWhen()
+}
+
+// RiskAssessmentPredictionWhenPeriod is the Period variant of RiskAssessmentPredictionWhen.
+type RiskAssessmentPredictionWhenPeriod struct {
+	Period
+}
+
+func (v RiskAssessmentPredictionWhenPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v RiskAssessmentPredictionWhenPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v RiskAssessmentPredictionWhenPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v RiskAssessmentPredictionWhenPeriod) isRiskAssessmentPredictionWhen() {}
+
+// RiskAssessmentPredictionWhenRange is the Range variant of RiskAssessmentPredictionWhen.
+type RiskAssessmentPredictionWhenRange struct {
+	Range
+}
+
+func (v RiskAssessmentPredictionWhenRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v RiskAssessmentPredictionWhenRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v RiskAssessmentPredictionWhenRange) FHIRType() string {
+	return "Range"
+}
+
+func (v RiskAssessmentPredictionWhenRange) isRiskAssessmentPredictionWhen() {}
+
+var riskAssessmentPredictionWhenVariants = []RiskAssessmentPredictionWhen{
+	RiskAssessmentPredictionWhenPeriod{},
+	RiskAssessmentPredictionWhenRange{},
+}
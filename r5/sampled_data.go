@@ -1,25 +1,41 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // SampledData Type: A series of measurements taken by a device, with upper and lower limits. There may be more than one dimension in the data.
 type SampledData struct {
-	Id           *string   `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
-	Origin       *Quantity `json:"origin" bson:"origin"`                              // Zero value and units
-	Interval     *float64  `json:"interval,omitempty" bson:"interval,omitempty"`      // Number of intervalUnits between samples
-	IntervalUnit string    `json:"intervalUnit" bson:"interval_unit"`                 // The measurement unit of the interval between samples
-	Factor       *float64  `json:"factor,omitempty" bson:"factor,omitempty"`          // Multiply data by this before adding to origin
-	LowerLimit   *float64  `json:"lowerLimit,omitempty" bson:"lower_limit,omitempty"` // Lower limit of detection
-	UpperLimit   *float64  `json:"upperLimit,omitempty" bson:"upper_limit,omitempty"` // Upper limit of detection
-	Dimensions   int       `json:"dimensions" bson:"dimensions"`                      // Number of sample points at each time point
-	CodeMap      *string   `json:"codeMap,omitempty" bson:"code_map,omitempty"`       // Defines the codes used in the data
-	Offsets      *string   `json:"offsets,omitempty" bson:"offsets,omitempty"`        // Offsets, typically in time, at which data values were taken
-	Data         *string   `json:"data,omitempty" bson:"data,omitempty"`              // Decimal values with spaces, or "E" | "U" | "L", or another code
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                               // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                 // Additional content defined by implementations
+	Origin              *Quantity   `json:"origin" bson:"origin"`                                           // Zero value and units
+	Interval            *Decimal    `json:"interval,omitempty" bson:"interval,omitempty"`                   // Number of intervalUnits between samples
+	IntervalElement     *Element    `json:"_interval,omitempty" bson:"interval_element,omitempty"`          // Extensions for interval
+	IntervalUnit        string      `json:"intervalUnit" bson:"interval_unit"`                              // The measurement unit of the interval between samples
+	IntervalUnitElement *Element    `json:"_intervalUnit,omitempty" bson:"interval_unit_element,omitempty"` // Extensions for intervalUnit
+	Factor              *Decimal    `json:"factor,omitempty" bson:"factor,omitempty"`                       // Multiply data by this before adding to origin
+	FactorElement       *Element    `json:"_factor,omitempty" bson:"factor_element,omitempty"`              // Extensions for factor
+	LowerLimit          *Decimal    `json:"lowerLimit,omitempty" bson:"lower_limit,omitempty"`              // Lower limit of detection
+	LowerLimitElement   *Element    `json:"_lowerLimit,omitempty" bson:"lower_limit_element,omitempty"`     // Extensions for lowerLimit
+	UpperLimit          *Decimal    `json:"upperLimit,omitempty" bson:"upper_limit,omitempty"`              // Upper limit of detection
+	UpperLimitElement   *Element    `json:"_upperLimit,omitempty" bson:"upper_limit_element,omitempty"`     // Extensions for upperLimit
+	Dimensions          int         `json:"dimensions" bson:"dimensions"`                                   // Number of sample points at each time point
+	DimensionsElement   *Element    `json:"_dimensions,omitempty" bson:"dimensions_element,omitempty"`      // Extensions for dimensions
+	CodeMap             *string     `json:"codeMap,omitempty" bson:"code_map,omitempty"`                    // Defines the codes used in the data
+	CodeMapElement      *Element    `json:"_codeMap,omitempty" bson:"code_map_element,omitempty"`           // Extensions for codeMap
+	Offsets             *string     `json:"offsets,omitempty" bson:"offsets,omitempty"`                     // Offsets, typically in time, at which data values were taken
+	OffsetsElement      *Element    `json:"_offsets,omitempty" bson:"offsets_element,omitempty"`            // Extensions for offsets
+	Data                *string     `json:"data,omitempty" bson:"data,omitempty"`                           // Decimal values with spaces, or "E" | "U" | "L", or another code
+	DataElement         *Element    `json:"_data,omitempty" bson:"data_element,omitempty"`                  // Extensions for data
 }
 
 func (r *SampledData) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Origin == nil {
 		return fmt.Errorf("field 'Origin' is required")
 	}
@@ -28,12 +44,204 @@ func (r *SampledData) Validate() error {
 			return fmt.Errorf("Origin: %w", err)
 		}
 	}
+	if r.Interval != nil {
+		if err := r.Interval.Validate(); err != nil {
+			return fmt.Errorf("Interval: %w", err)
+		}
+	}
+	if r.IntervalElement != nil {
+		if err := r.IntervalElement.Validate(); err != nil {
+			return fmt.Errorf("IntervalElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.IntervalUnit == emptyString {
 		return fmt.Errorf("field 'IntervalUnit' is required")
 	}
+	if r.IntervalUnitElement != nil {
+		if err := r.IntervalUnitElement.Validate(); err != nil {
+			return fmt.Errorf("IntervalUnitElement: %w", err)
+		}
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			return fmt.Errorf("Factor: %w", err)
+		}
+	}
+	if r.FactorElement != nil {
+		if err := r.FactorElement.Validate(); err != nil {
+			return fmt.Errorf("FactorElement: %w", err)
+		}
+	}
+	if r.LowerLimit != nil {
+		if err := r.LowerLimit.Validate(); err != nil {
+			return fmt.Errorf("LowerLimit: %w", err)
+		}
+	}
+	if r.LowerLimitElement != nil {
+		if err := r.LowerLimitElement.Validate(); err != nil {
+			return fmt.Errorf("LowerLimitElement: %w", err)
+		}
+	}
+	if r.UpperLimit != nil {
+		if err := r.UpperLimit.Validate(); err != nil {
+			return fmt.Errorf("UpperLimit: %w", err)
+		}
+	}
+	if r.UpperLimitElement != nil {
+		if err := r.UpperLimitElement.Validate(); err != nil {
+			return fmt.Errorf("UpperLimitElement: %w", err)
+		}
+	}
 	if r.Dimensions == 0 {
 		return fmt.Errorf("field 'Dimensions' is required")
 	}
+	if r.DimensionsElement != nil {
+		if err := r.DimensionsElement.Validate(); err != nil {
+			return fmt.Errorf("DimensionsElement: %w", err)
+		}
+	}
+	if r.CodeMapElement != nil {
+		if err := r.CodeMapElement.Validate(); err != nil {
+			return fmt.Errorf("CodeMapElement: %w", err)
+		}
+	}
+	if r.OffsetsElement != nil {
+		if err := r.OffsetsElement.Validate(); err != nil {
+			return fmt.Errorf("OffsetsElement: %w", err)
+		}
+	}
+	if r.DataElement != nil {
+		if err := r.DataElement.Validate(); err != nil {
+			return fmt.Errorf("DataElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *SampledData) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Origin == nil {
+		issues.add("required", path+".origin", "field 'Origin' is required")
+	}
+	if r.Origin != nil {
+		r.Origin.validateAll(path+".origin", issues)
+	}
+	if r.Interval != nil {
+		if err := r.Interval.Validate(); err != nil {
+			issues.add("value", path+".interval", err.Error())
+		}
+	}
+	if r.IntervalElement != nil {
+		r.IntervalElement.validateAll(path+".interval", issues)
+	}
+	var emptyString string
+	if r.IntervalUnit == emptyString {
+		issues.add("required", path+".intervalUnit", "field 'IntervalUnit' is required")
+	}
+	if r.IntervalUnitElement != nil {
+		r.IntervalUnitElement.validateAll(path+".intervalUnit", issues)
+	}
+	if r.Factor != nil {
+		if err := r.Factor.Validate(); err != nil {
+			issues.add("value", path+".factor", err.Error())
+		}
+	}
+	if r.FactorElement != nil {
+		r.FactorElement.validateAll(path+".factor", issues)
+	}
+	if r.LowerLimit != nil {
+		if err := r.LowerLimit.Validate(); err != nil {
+			issues.add("value", path+".lowerLimit", err.Error())
+		}
+	}
+	if r.LowerLimitElement != nil {
+		r.LowerLimitElement.validateAll(path+".lowerLimit", issues)
+	}
+	if r.UpperLimit != nil {
+		if err := r.UpperLimit.Validate(); err != nil {
+			issues.add("value", path+".upperLimit", err.Error())
+		}
+	}
+	if r.UpperLimitElement != nil {
+		r.UpperLimitElement.validateAll(path+".upperLimit", issues)
+	}
+	if r.Dimensions == 0 {
+		issues.add("required", path+".dimensions", "field 'Dimensions' is required")
+	}
+	if r.DimensionsElement != nil {
+		r.DimensionsElement.validateAll(path+".dimensions", issues)
+	}
+	if r.CodeMapElement != nil {
+		r.CodeMapElement.validateAll(path+".codeMap", issues)
+	}
+	if r.OffsetsElement != nil {
+		r.OffsetsElement.validateAll(path+".offsets", issues)
+	}
+	if r.DataElement != nil {
+		r.DataElement.validateAll(path+".data", issues)
+	}
+}
+
+func (r *SampledData) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SampledData", &issues)
+	checkInvariants(r, "SampledData", &issues)
+	return issues
+}
+
+var sampledDataInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "sdd-1", severity: "error", human: "A SampledData SHALL have either an interval and offsets but not both", expression: "interval.exists().not() xor offsets.exists().not()"},
+}
+
+func (r *SampledData) invariants() []invariant {
+	return sampledDataInvariants
+}
+
+func (r SampledData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SampledData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SampledData) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SampledData) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var sampledDataElementTypes = map[string]string{
+	"intervalUnit": "code",
+	"dimensions":   "positiveInt",
+	"codeMap":      "canonical",
+}
+
+func (r *SampledData) elementTypes() map[string]string {
+	return sampledDataElementTypes
+}
+
+var sampledDataElementMetadata = []ElementMetadata{
+	{Path: "SampledData.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SampledData.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SampledData.origin", Name: "origin", Field: 2, Min: 1, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "SampledData.interval", Name: "interval", Field: 3, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "SampledData.intervalUnit", Name: "intervalUnit", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SampledData.intervalUnit", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/ucum-units", Description: "Units of measure allowed for an element."}, Summary: true},
+	{Path: "SampledData.factor", Name: "factor", Field: 7, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "SampledData.lowerLimit", Name: "lowerLimit", Field: 9, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "SampledData.upperLimit", Name: "upperLimit", Field: 11, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "SampledData.dimensions", Name: "dimensions", Field: 13, Min: 1, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "SampledData.codeMap", Name: "codeMap", Field: 15, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ConceptMap"}},
+	{Path: "SampledData.offsets", Name: "offsets", Field: 17, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SampledData.data", Name: "data", Field: 19, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SampledData) ElementMetadata() []ElementMetadata {
+	return sampledDataElementMetadata
+}
@@ -2,29 +2,39 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A container for slots of time that may be available for booking appointments.
 type Schedule struct {
-	ResourceType    string              `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id              *string             `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta            *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules   *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language        *string             `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	Text            *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                        // Text summary of the resource, for human interpretation
-	Contained       []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`              // Contained, inline Resources
-	Identifier      []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`            // External Ids for this item
-	Active          *bool               `json:"active,omitempty" bson:"active,omitempty"`                    // Whether this schedule is in active use
-	ServiceCategory []CodeableConcept   `json:"serviceCategory,omitempty" bson:"service_category,omitempty"` // High-level category
-	ServiceType     []CodeableReference `json:"serviceType,omitempty" bson:"service_type,omitempty"`         // Specific service
-	Specialty       []CodeableConcept   `json:"specialty,omitempty" bson:"specialty,omitempty"`              // Type of specialty needed
-	Name            *string             `json:"name,omitempty" bson:"name,omitempty"`                        // Human-readable label
-	Actor           []Reference         `json:"actor" bson:"actor"`                                          // Resource(s) that availability information is being provided for
-	PlanningHorizon *Period             `json:"planningHorizon,omitempty" bson:"planning_horizon,omitempty"` // Period of time covered by schedule
-	Comment         *string             `json:"comment,omitempty" bson:"comment,omitempty"`                  // Comments on availability
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External Ids for this item
+	Active               *bool               `json:"active,omitempty" bson:"active,omitempty"`                         // Whether this schedule is in active use
+	ActiveElement        *Element            `json:"_active,omitempty" bson:"active_element,omitempty"`                // Extensions for active
+	ServiceCategory      []CodeableConcept   `json:"serviceCategory,omitempty" bson:"service_category,omitempty"`      // High-level category
+	ServiceType          []CodeableReference `json:"serviceType,omitempty" bson:"service_type,omitempty"`              // Specific service
+	Specialty            []CodeableConcept   `json:"specialty,omitempty" bson:"specialty,omitempty"`                   // Type of specialty needed
+	Name                 *string             `json:"name,omitempty" bson:"name,omitempty"`                             // Human-readable label
+	NameElement          *Element            `json:"_name,omitempty" bson:"name_element,omitempty"`                    // Extensions for name
+	Actor                []Reference         `json:"actor" bson:"actor"`                                               // Resource(s) that availability information is being provided for
+	PlanningHorizon      *Period             `json:"planningHorizon,omitempty" bson:"planning_horizon,omitempty"`      // Period of time covered by schedule
+	Comment              *string             `json:"comment,omitempty" bson:"comment,omitempty"`                       // Comments on availability
+	CommentElement       *Element            `json:"_comment,omitempty" bson:"comment_element,omitempty"`              // Extensions for comment
 }
 
+var scheduleActorTargets = []string{"CareTeam", "Device", "HealthcareService", "Location", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Schedule) Validate() error {
 	if r.ResourceType != "Schedule" {
 		return fmt.Errorf("invalid resourceType: expected 'Schedule', got '%s'", r.ResourceType)
@@ -34,16 +44,49 @@ func (r *Schedule) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.ActiveElement != nil {
+		if err := r.ActiveElement.Validate(); err != nil {
+			return fmt.Errorf("ActiveElement: %w", err)
+		}
+	}
 	for i, item := range r.ServiceCategory {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("ServiceCategory[%d]: %w", i, err)
@@ -59,9 +102,19 @@ func (r *Schedule) Validate() error {
 			return fmt.Errorf("Specialty[%d]: %w", i, err)
 		}
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	if len(r.Actor) < 1 {
 		return fmt.Errorf("field 'Actor' must have at least 1 elements")
 	}
+	for i, item := range r.Actor {
+		if target := invalidReferenceTarget(item.Reference, item.Type, scheduleActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor[%d]' cannot refer to %s, expected one of %v", i, target, scheduleActorTargets)
+		}
+	}
 	for i, item := range r.Actor {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Actor[%d]: %w", i, err)
@@ -72,5 +125,206 @@ func (r *Schedule) Validate() error {
 			return fmt.Errorf("PlanningHorizon: %w", err)
 		}
 	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "Schedule")
+}
+
+func (r *Schedule) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Schedule" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Schedule', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.ActiveElement != nil {
+		r.ActiveElement.validateAll(path+".active", issues)
+	}
+	for i, item := range r.ServiceCategory {
+		item.validateAll(fmt.Sprintf("%s.serviceCategory[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceType {
+		item.validateAll(fmt.Sprintf("%s.serviceType[%d]", path, i), issues)
+	}
+	for i, item := range r.Specialty {
+		item.validateAll(fmt.Sprintf("%s.specialty[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if len(r.Actor) < 1 {
+		issues.add("required", path+".actor", "field 'Actor' must have at least 1 elements")
+	}
+	for i, item := range r.Actor {
+		if target := invalidReferenceTarget(item.Reference, item.Type, scheduleActorTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.actor[%d]", path, i), fmt.Sprintf("field 'Actor[%d]' cannot refer to %s, expected one of %v", i, target, scheduleActorTargets))
+		}
+	}
+	for i, item := range r.Actor {
+		item.validateAll(fmt.Sprintf("%s.actor[%d]", path, i), issues)
+	}
+	if r.PlanningHorizon != nil {
+		r.PlanningHorizon.validateAll(path+".planningHorizon", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+}
+
+func (r *Schedule) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Schedule", &issues)
+	checkInvariants(r, "Schedule", &issues)
+	return issues
+}
+
+var scheduleInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Schedule) invariants() []invariant {
+	return scheduleInvariants
+}
+
+func (r *Schedule) UnmarshalJSON(data []byte) error {
+	type alias Schedule
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r Schedule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Schedule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Schedule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Schedule) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var scheduleElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"comment":       "markdown",
+}
+
+func (r *Schedule) elementTypes() map[string]string {
+	return scheduleElementTypes
+}
+
+var scheduleElementMetadata = []ElementMetadata{
+	{Path: "Schedule.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Schedule.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Schedule.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Schedule.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Schedule.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Schedule.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Schedule.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Schedule.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Schedule.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Schedule.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Schedule.active", Name: "active", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Schedule.serviceCategory", Name: "serviceCategory", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Schedule.serviceType", Name: "serviceType", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Schedule.specialty", Name: "specialty", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Schedule.name", Name: "name", Field: 17, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Schedule.actor", Name: "actor", Field: 19, Min: 1, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Schedule.planningHorizon", Name: "planningHorizon", Field: 20, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Schedule.comment", Name: "comment", Field: 21, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *Schedule) ElementMetadata() []ElementMetadata {
+	return scheduleElementMetadata
+}
+
+func (r *Schedule) GetResourceType() string {
+	return "Schedule"
+}
+
+func (r *Schedule) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Schedule) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Schedule) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Schedule) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Schedule) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Schedule) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Schedule) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Schedule)(nil)
@@ -2,51 +2,84 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A search parameter that defines a named search item that can be used to search/filter on a resource.
 type SearchParameter struct {
-	ResourceType           string                     `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                    `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                    `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage          `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    string                     `json:"url" bson:"url"`                                                             // Canonical identifier for this search parameter, represented as a URI (globally unique)
-	Identifier             []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the search parameter (business identifier)
-	Version                *string                    `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the search parameter
-	VersionAlgorithmString *string                    `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                    `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                     `json:"name" bson:"name"`                                                           // Name for this search parameter (computer friendly)
-	Title                  *string                    `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this search parameter (human friendly)
-	DerivedFrom            *string                    `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                        // Original definition for the search parameter
-	Status                 string                     `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                      `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                    `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                    `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail            `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            string                     `json:"description" bson:"description"`                                             // Natural language description of the search parameter
-	UseContext             []UsageContext             `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept          `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the search parameter (if applicable)
-	Purpose                *string                    `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this search parameter is defined
-	Copyright              *string                    `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                    `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Code                   string                     `json:"code" bson:"code"`                                                           // Recommended name for parameter in search url
-	AliasCode              []string                   `json:"aliasCode,omitempty" bson:"alias_code,omitempty"`                            // Additional recommended names for parameter in search url
-	Base                   []string                   `json:"base" bson:"base"`                                                           // The resource type(s) this search parameter applies to
-	Type                   string                     `json:"type" bson:"type"`                                                           // number | date | string | token | reference | composite | quantity | uri | special | resource
-	Expression             *string                    `json:"expression,omitempty" bson:"expression,omitempty"`                           // FHIRPath expression that extracts the values
-	ProcessingMode         *string                    `json:"processingMode,omitempty" bson:"processing_mode,omitempty"`                  // normal | phonetic | other
-	Constraint             *string                    `json:"constraint,omitempty" bson:"constraint,omitempty"`                           // FHIRPath expression that constraints the usage of this SearchParameter
-	Target                 []string                   `json:"target,omitempty" bson:"target,omitempty"`                                   // Types of resource (if a resource reference)
-	MultipleOr             *bool                      `json:"multipleOr,omitempty" bson:"multiple_or,omitempty"`                          // Allow multiple values per parameter (or)
-	MultipleAnd            *bool                      `json:"multipleAnd,omitempty" bson:"multiple_and,omitempty"`                        // Allow multiple parameters (and)
-	Comparator             []string                   `json:"comparator,omitempty" bson:"comparator,omitempty"`                           // eq | ne | gt | lt | ge | le | sa | eb | ap
-	Modifier               []string                   `json:"modifier,omitempty" bson:"modifier,omitempty"`                               // missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
-	Chain                  []string                   `json:"chain,omitempty" bson:"chain,omitempty"`                                     // Chained names supported
-	Component              []SearchParameterComponent `json:"component,omitempty" bson:"component,omitempty"`                             // For Composite resources to define the parts
+	ResourceType            string                          `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                           `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                         `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                        `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                         `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                        `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                      `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                      `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     string                          `json:"url" bson:"url"`                                                     // Canonical identifier for this search parameter, represented as a URI (globally unique)
+	UrlElement              *Element                        `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the search parameter (business identifier)
+	Version                 *string                         `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the search parameter
+	VersionElement          *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        SearchParameterVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                        `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    string                          `json:"name" bson:"name"`                                                   // Name for this search parameter (computer friendly)
+	NameElement             *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                         `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this search parameter (human friendly)
+	TitleElement            *Element                        `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	DerivedFrom             *string                         `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                // Original definition for the search parameter
+	DerivedFromElement      *Element                        `json:"_derivedFrom,omitempty" bson:"derived_from_element,omitempty"`       // Extensions for derivedFrom
+	Status                  PublicationStatus               `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                        `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                           `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                        `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                       `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                        `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                         `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                        `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                 `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             string                          `json:"description" bson:"description"`                                     // Natural language description of the search parameter
+	DescriptionElement      *Element                        `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                  `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept               `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the search parameter (if applicable)
+	Purpose                 *string                         `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this search parameter is defined
+	PurposeElement          *Element                        `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                         `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                        `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	Code                    string                          `json:"code" bson:"code"`                                                   // Recommended name for parameter in search url
+	CodeElement             *Element                        `json:"_code,omitempty" bson:"code_element,omitempty"`                      // Extensions for code
+	AliasCode               []string                        `json:"aliasCode,omitempty" bson:"alias_code,omitempty"`                    // Additional recommended names for parameter in search url
+	AliasCodeElement        []*Element                      `json:"_aliasCode,omitempty" bson:"alias_code_element,omitempty"`           // Extensions for aliasCode
+	Base                    []string                        `json:"base" bson:"base"`                                                   // The resource type(s) this search parameter applies to
+	BaseElement             []*Element                      `json:"_base,omitempty" bson:"base_element,omitempty"`                      // Extensions for base
+	Type                    SearchParamType                 `json:"type" bson:"type"`                                                   // number | date | string | token | reference | composite | quantity | uri | special | resource
+	TypeElement             *Element                        `json:"_type,omitempty" bson:"type_element,omitempty"`                      // Extensions for type
+	Expression              *string                         `json:"expression,omitempty" bson:"expression,omitempty"`                   // FHIRPath expression that extracts the values
+	ExpressionElement       *Element                        `json:"_expression,omitempty" bson:"expression_element,omitempty"`          // Extensions for expression
+	ProcessingMode          *SearchProcessingModeType       `json:"processingMode,omitempty" bson:"processing_mode,omitempty"`          // normal | phonetic | other
+	ProcessingModeElement   *Element                        `json:"_processingMode,omitempty" bson:"processing_mode_element,omitempty"` // Extensions for processingMode
+	Constraint              *string                         `json:"constraint,omitempty" bson:"constraint,omitempty"`                   // FHIRPath expression that constraints the usage of this SearchParameter
+	ConstraintElement       *Element                        `json:"_constraint,omitempty" bson:"constraint_element,omitempty"`          // Extensions for constraint
+	Target                  []string                        `json:"target,omitempty" bson:"target,omitempty"`                           // Types of resource (if a resource reference)
+	TargetElement           []*Element                      `json:"_target,omitempty" bson:"target_element,omitempty"`                  // Extensions for target
+	MultipleOr              *bool                           `json:"multipleOr,omitempty" bson:"multiple_or,omitempty"`                  // Allow multiple values per parameter (or)
+	MultipleOrElement       *Element                        `json:"_multipleOr,omitempty" bson:"multiple_or_element,omitempty"`         // Extensions for multipleOr
+	MultipleAnd             *bool                           `json:"multipleAnd,omitempty" bson:"multiple_and,omitempty"`                // Allow multiple parameters (and)
+	MultipleAndElement      *Element                        `json:"_multipleAnd,omitempty" bson:"multiple_and_element,omitempty"`       // Extensions for multipleAnd
+	Comparator              []SearchComparator              `json:"comparator,omitempty" bson:"comparator,omitempty"`                   // eq | ne | gt | lt | ge | le | sa | eb | ap
+	ComparatorElement       []*Element                      `json:"_comparator,omitempty" bson:"comparator_element,omitempty"`          // Extensions for comparator
+	Modifier                []SearchModifierAllCodes        `json:"modifier,omitempty" bson:"modifier,omitempty"`                       // missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
+	ModifierElement         []*Element                      `json:"_modifier,omitempty" bson:"modifier_element,omitempty"`              // Extensions for modifier
+	Chain                   []string                        `json:"chain,omitempty" bson:"chain,omitempty"`                             // Chained names supported
+	ChainElement            []*Element                      `json:"_chain,omitempty" bson:"chain_element,omitempty"`                    // Extensions for chain
+	Component               []SearchParameterComponent      `json:"component,omitempty" bson:"component,omitempty"`                     // For Composite resources to define the parts
+
+	versionAlgorithmVariants []string // JSON properties of SearchParameter.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *SearchParameter) Validate() error {
@@ -58,31 +91,120 @@ func (r *SearchParameter) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.DerivedFromElement != nil {
+		if err := r.DerivedFromElement.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
@@ -91,6 +213,11 @@ func (r *SearchParameter) Validate() error {
 	if r.Description == emptyString {
 		return fmt.Errorf("field 'Description' is required")
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -101,36 +228,725 @@ func (r *SearchParameter) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
 	if r.Code == emptyString {
 		return fmt.Errorf("field 'Code' is required")
 	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	for i, item := range r.AliasCodeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AliasCodeElement[%d]: %w", i, err)
+		}
+	}
 	if len(r.Base) < 1 {
 		return fmt.Errorf("field 'Base' must have at least 1 elements")
 	}
-	if r.Type == emptyString {
+	for i, item := range r.BaseElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("BaseElement[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.ExpressionElement != nil {
+		if err := r.ExpressionElement.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement: %w", err)
+		}
+	}
+	if r.ProcessingMode != nil && !r.ProcessingMode.IsValid() {
+		return fmt.Errorf("field 'ProcessingMode' has invalid code '%s'", *r.ProcessingMode)
+	}
+	if r.ProcessingModeElement != nil {
+		if err := r.ProcessingModeElement.Validate(); err != nil {
+			return fmt.Errorf("ProcessingModeElement: %w", err)
+		}
+	}
+	if r.ConstraintElement != nil {
+		if err := r.ConstraintElement.Validate(); err != nil {
+			return fmt.Errorf("ConstraintElement: %w", err)
+		}
+	}
+	for i, item := range r.TargetElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TargetElement[%d]: %w", i, err)
+		}
+	}
+	if r.MultipleOrElement != nil {
+		if err := r.MultipleOrElement.Validate(); err != nil {
+			return fmt.Errorf("MultipleOrElement: %w", err)
+		}
+	}
+	if r.MultipleAndElement != nil {
+		if err := r.MultipleAndElement.Validate(); err != nil {
+			return fmt.Errorf("MultipleAndElement: %w", err)
+		}
+	}
+	for i, item := range r.Comparator {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Comparator[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ComparatorElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ComparatorElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Modifier[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ModifierElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ChainElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ChainElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Component {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Component[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "SearchParameter")
+}
+
+func (r *SearchParameter) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "SearchParameter" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'SearchParameter', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.DerivedFromElement != nil {
+		r.DerivedFromElement.validateAll(path+".derivedFrom", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.Description == emptyString {
+		issues.add("required", path+".description", "field 'Description' is required")
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	for i, item := range r.AliasCodeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.aliasCode[%d]", path, i), issues)
+	}
+	if len(r.Base) < 1 {
+		issues.add("required", path+".base", "field 'Base' must have at least 1 elements")
+	}
+	for i, item := range r.BaseElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.base[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.ExpressionElement != nil {
+		r.ExpressionElement.validateAll(path+".expression", issues)
+	}
+	if r.ProcessingMode != nil && !r.ProcessingMode.IsValid() {
+		issues.add("code-invalid", path+".processingMode", fmt.Sprintf("field 'ProcessingMode' has invalid code '%s'", *r.ProcessingMode))
+	}
+	if r.ProcessingModeElement != nil {
+		r.ProcessingModeElement.validateAll(path+".processingMode", issues)
+	}
+	if r.ConstraintElement != nil {
+		r.ConstraintElement.validateAll(path+".constraint", issues)
+	}
+	for i, item := range r.TargetElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.target[%d]", path, i), issues)
+	}
+	if r.MultipleOrElement != nil {
+		r.MultipleOrElement.validateAll(path+".multipleOr", issues)
+	}
+	if r.MultipleAndElement != nil {
+		r.MultipleAndElement.validateAll(path+".multipleAnd", issues)
+	}
+	for i, item := range r.Comparator {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.comparator[%d]", path, i), fmt.Sprintf("field 'Comparator[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ComparatorElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.comparator[%d]", path, i), issues)
+	}
+	for i, item := range r.Modifier {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.modifier[%d]", path, i), fmt.Sprintf("field 'Modifier[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ModifierElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ChainElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.chain[%d]", path, i), issues)
+	}
+	for i, item := range r.Component {
+		item.validateAll(fmt.Sprintf("%s.component[%d]", path, i), issues)
+	}
+}
+
+func (r *SearchParameter) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SearchParameter", &issues)
+	checkInvariants(r, "SearchParameter", &issues)
+	return issues
+}
+
+var searchParameterInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *SearchParameter) invariants() []invariant {
+	return searchParameterInvariants
+}
+
+func (r SearchParameter) MarshalJSON() ([]byte, error) {
+	type alias SearchParameter
+	out := struct {
+		alias
+		AliasCode         []*string                 `json:"aliasCode,omitempty"`
+		AliasCodeElement  []*Element                `json:"_aliasCode,omitempty"`
+		Base              []*string                 `json:"base"`
+		BaseElement       []*Element                `json:"_base,omitempty"`
+		Target            []*string                 `json:"target,omitempty"`
+		TargetElement     []*Element                `json:"_target,omitempty"`
+		Comparator        []*SearchComparator       `json:"comparator,omitempty"`
+		ComparatorElement []*Element                `json:"_comparator,omitempty"`
+		Modifier          []*SearchModifierAllCodes `json:"modifier,omitempty"`
+		ModifierElement   []*Element                `json:"_modifier,omitempty"`
+		Chain             []*string                 `json:"chain,omitempty"`
+		ChainElement      []*Element                `json:"_chain,omitempty"`
+	}{alias: alias(r)}
+	out.AliasCode, out.AliasCodeElement = alignPrimitiveArray(r.AliasCode, r.AliasCodeElement)
+	out.Base, out.BaseElement = alignPrimitiveArray(r.Base, r.BaseElement)
+	out.Target, out.TargetElement = alignPrimitiveArray(r.Target, r.TargetElement)
+	out.Comparator, out.ComparatorElement = alignPrimitiveArray(r.Comparator, r.ComparatorElement)
+	out.Modifier, out.ModifierElement = alignPrimitiveArray(r.Modifier, r.ModifierElement)
+	out.Chain, out.ChainElement = alignPrimitiveArray(r.Chain, r.ChainElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SearchParameter) UnmarshalJSON(data []byte) error {
+	type alias SearchParameter
+	aux := struct {
+		*alias
+		Contained  []json.RawMessage         `json:"contained,omitempty"`
+		AliasCode  []*string                 `json:"aliasCode,omitempty"`
+		Base       []*string                 `json:"base"`
+		Target     []*string                 `json:"target,omitempty"`
+		Comparator []*SearchComparator       `json:"comparator,omitempty"`
+		Modifier   []*SearchModifierAllCodes `json:"modifier,omitempty"`
+		Chain      []*string                 `json:"chain,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.AliasCode, err = splitPrimitiveArray(aux.AliasCode, r.AliasCodeElement); err != nil {
+		return fmt.Errorf("aliasCode: %w", err)
+	}
+	if r.Base, err = splitPrimitiveArray(aux.Base, r.BaseElement); err != nil {
+		return fmt.Errorf("base: %w", err)
+	}
+	if r.Target, err = splitPrimitiveArray(aux.Target, r.TargetElement); err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+	if r.Comparator, err = splitPrimitiveArray(aux.Comparator, r.ComparatorElement); err != nil {
+		return fmt.Errorf("comparator: %w", err)
+	}
+	if r.Modifier, err = splitPrimitiveArray(aux.Modifier, r.ModifierElement); err != nil {
+		return fmt.Errorf("modifier: %w", err)
+	}
+	if r.Chain, err = splitPrimitiveArray(aux.Chain, r.ChainElement); err != nil {
+		return fmt.Errorf("chain: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", searchParameterVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r SearchParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SearchParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SearchParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SearchParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SearchParameter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(searchParameterVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var searchParameterElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"derivedFrom":   "canonical",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"code":          "code",
+	"aliasCode":     "code",
+	"base":          "code",
+	"target":        "code",
+}
+
+func (r *SearchParameter) elementTypes() map[string]string {
+	return searchParameterElementTypes
+}
+
+var searchParameterElementMetadata = []ElementMetadata{
+	{Path: "SearchParameter.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "SearchParameter.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "SearchParameter.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "SearchParameter.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SearchParameter.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "SearchParameter.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "SearchParameter.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "SearchParameter.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SearchParameter.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SearchParameter.url", Name: "url", Field: 11, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "SearchParameter.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "SearchParameter.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "SearchParameter.name", Name: "name", Field: 18, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.derivedFrom", Name: "derivedFrom", Field: 22, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "SearchParameter.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SearchParameter.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "SearchParameter.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SearchParameter.date", Name: "date", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "SearchParameter.publisher", Name: "publisher", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.contact", Name: "contact", Field: 32, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "SearchParameter.description", Name: "description", Field: 33, Min: 1, Max: "1", Types: []string{"markdown"}},
+	{Path: "SearchParameter.useContext", Name: "useContext", Field: 35, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "SearchParameter.jurisdiction", Name: "jurisdiction", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SearchParameter.purpose", Name: "purpose", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SearchParameter.copyright", Name: "copyright", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SearchParameter.copyrightLabel", Name: "copyrightLabel", Field: 41, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.code", Name: "code", Field: 43, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "SearchParameter.aliasCode", Name: "aliasCode", Field: 45, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "SearchParameter.base", Name: "base", Field: 47, Min: 1, Max: "*", Types: []string{"code"}},
+	{Path: "SearchParameter.type", Name: "type", Field: 49, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SearchParameter.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-param-type"}},
+	{Path: "SearchParameter.expression", Name: "expression", Field: 51, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.processingMode", Name: "processingMode", Field: 53, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SearchParameter.processingMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-processingmode"}},
+	{Path: "SearchParameter.constraint", Name: "constraint", Field: 55, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.target", Name: "target", Field: 57, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "SearchParameter.multipleOr", Name: "multipleOr", Field: 59, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SearchParameter.multipleAnd", Name: "multipleAnd", Field: 61, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SearchParameter.comparator", Name: "comparator", Field: 63, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SearchParameter.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-comparator"}},
+	{Path: "SearchParameter.modifier", Name: "modifier", Field: 65, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SearchParameter.modifier", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-modifier-all-codes"}},
+	{Path: "SearchParameter.chain", Name: "chain", Field: 67, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "SearchParameter.component", Name: "component", Field: 69, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SearchParameter) ElementMetadata() []ElementMetadata {
+	return searchParameterElementMetadata
+}
+
+// SearchParameterVersionAlgorithm is the type of SearchParameter.versionAlgorithm[x]. It is implemented by the SearchParameterVersionAlgorithm* variant types.
+type SearchParameterVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSearchParameterVersionAlgorithm()
+}
+
+// SearchParameterVersionAlgorithmString is the string variant of SearchParameterVersionAlgorithm.
+type SearchParameterVersionAlgorithmString string
+
+func (v SearchParameterVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v SearchParameterVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SearchParameterVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v SearchParameterVersionAlgorithmString) isSearchParameterVersionAlgorithm() {}
+
+// SearchParameterVersionAlgorithmCoding is the Coding variant of SearchParameterVersionAlgorithm.
+type SearchParameterVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v SearchParameterVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v SearchParameterVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v SearchParameterVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v SearchParameterVersionAlgorithmCoding) isSearchParameterVersionAlgorithm() {}
+
+var searchParameterVersionAlgorithmVariants = []SearchParameterVersionAlgorithm{
+	SearchParameterVersionAlgorithmString(""),
+	SearchParameterVersionAlgorithmCoding{},
+}
+
+func (r *SearchParameter) GetResourceType() string {
+	return "SearchParameter"
+}
+
+func (r *SearchParameter) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *SearchParameter) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *SearchParameter) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *SearchParameter) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *SearchParameter) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *SearchParameter) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *SearchParameter) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*SearchParameter)(nil)
+
 type SearchParameterComponent struct {
-	Id         *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Definition string  `json:"definition" bson:"definition"`     // Defines how the part works
-	Expression string  `json:"expression" bson:"expression"`     // Sub-expression relative to main expression
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Definition        string      `json:"definition" bson:"definition"`                                    // Defines how the part works
+	DefinitionElement *Element    `json:"_definition,omitempty" bson:"definition_element,omitempty"`       // Extensions for definition
+	Expression        string      `json:"expression" bson:"expression"`                                    // Sub-expression relative to main expression
+	ExpressionElement *Element    `json:"_expression,omitempty" bson:"expression_element,omitempty"`       // Extensions for expression
 }
 
 func (r *SearchParameterComponent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Definition == emptyString {
 		return fmt.Errorf("field 'Definition' is required")
 	}
+	if r.DefinitionElement != nil {
+		if err := r.DefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("DefinitionElement: %w", err)
+		}
+	}
 	if r.Expression == emptyString {
 		return fmt.Errorf("field 'Expression' is required")
 	}
+	if r.ExpressionElement != nil {
+		if err := r.ExpressionElement.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *SearchParameterComponent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Definition == emptyString {
+		issues.add("required", path+".definition", "field 'Definition' is required")
+	}
+	if r.DefinitionElement != nil {
+		r.DefinitionElement.validateAll(path+".definition", issues)
+	}
+	if r.Expression == emptyString {
+		issues.add("required", path+".expression", "field 'Expression' is required")
+	}
+	if r.ExpressionElement != nil {
+		r.ExpressionElement.validateAll(path+".expression", issues)
+	}
+}
+
+var searchParameterComponentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SearchParameterComponent) invariants() []invariant {
+	return searchParameterComponentInvariants
+}
+
+func (r SearchParameterComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SearchParameterComponent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SearchParameterComponent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SearchParameterComponent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var searchParameterComponentElementTypes = map[string]string{
+	"definition": "canonical",
+}
+
+func (r *SearchParameterComponent) elementTypes() map[string]string {
+	return searchParameterComponentElementTypes
+}
+
+var searchParameterComponentElementMetadata = []ElementMetadata{
+	{Path: "SearchParameter.component.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SearchParameter.component.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SearchParameter.component.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SearchParameter.component.definition", Name: "definition", Field: 3, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "SearchParameter.component.expression", Name: "expression", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SearchParameterComponent) ElementMetadata() []ElementMetadata {
+	return searchParameterComponentElementMetadata
+}
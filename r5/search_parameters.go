@@ -0,0 +1,1432 @@
+package models
+
+var searchParameterDefinitions = []SearchParameterDefinition{
+	{URL: "http://hl7.org/fhir/SearchParameter/DomainResource-text", Code: "_text", Base: []string{"DomainResource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-content", Code: "_content", Base: []string{"Resource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-filter", Code: "_filter", Base: []string{"Resource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-has", Code: "_has", Base: []string{"Resource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-id", Code: "_id", Base: []string{"Resource"}, Type: "token", Expression: "Resource.id", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-in", Code: "_in", Base: []string{"Resource"}, Type: "reference", Expression: "Resource.id", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-language", Code: "_language", Base: []string{"Resource"}, Type: "token", Expression: "Resource.language", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-lastUpdated", Code: "_lastUpdated", Base: []string{"Resource"}, Type: "date", Expression: "Resource.meta.lastUpdated", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-list", Code: "_list", Base: []string{"Resource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-profile", Code: "_profile", Base: []string{"Resource"}, Type: "reference", Expression: "Resource.meta.profile", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-query", Code: "_query", Base: []string{"Resource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-security", Code: "_security", Base: []string{"Resource"}, Type: "token", Expression: "Resource.meta.security", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-source", Code: "_source", Base: []string{"Resource"}, Type: "uri", Expression: "Resource.meta.source", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-tag", Code: "_tag", Base: []string{"Resource"}, Type: "token", Expression: "Resource.meta.tag", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Resource-type", Code: "_type", Base: []string{"Resource"}, Type: "special", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-guarantor", Code: "guarantor", Base: []string{"Account"}, Type: "reference", Expression: "Account.guarantor.party", Target: []string{"Organization", "RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-guarantor-account", Code: "guarantor-account", Base: []string{"Account"}, Type: "reference", Expression: "Account.guarantor.account", Target: []string{"Account"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/clinical-identifier", Code: "identifier", Base: []string{"Account", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "Basic", "BiologicallyDerivedProduct", "BodyStructure", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "Communication", "CommunicationRequest", "Composition", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "EnrollmentRequest", "EpisodeOfCare", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "GuidanceResponse", "ImagingSelection", "ImagingStudy", "Immunization", "Invoice", "List", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "NutritionIntake", "NutritionOrder", "Observation", "Person", "Procedure", "QuestionnaireResponse", "RelatedPerson", "RequestOrchestration", "ResearchSubject", "RiskAssessment", "ServiceRequest", "Specimen", "Task", "VisionPrescription"}, Type: "token", Expression: "Account.identifier | AdverseEvent.identifier | AllergyIntolerance.identifier | Appointment.identifier | AppointmentResponse.identifier | Basic.identifier | BiologicallyDerivedProduct.identifier | BodyStructure.identifier | CarePlan.identifier | CareTeam.identifier | Claim.identifier | ClaimResponse.identifier | Communication.identifier | CommunicationRequest.identifier | Composition.identifier | Condition.identifier | Consent.identifier | Contract.identifier | Coverage.identifier | CoverageEligibilityRequest.identifier | CoverageEligibilityResponse.identifier | DetectedIssue.identifier | DeviceRequest.identifier | DiagnosticReport.identifier | DocumentReference.identifier | Encounter.identifier | EnrollmentRequest.identifier | EpisodeOfCare.identifier | ExplanationOfBenefit.identifier | FamilyMemberHistory.identifier | Flag.identifier | Goal.identifier | GuidanceResponse.identifier | ImagingSelection.identifier | ImagingStudy.identifier | Immunization.identifier | Invoice.identifier | List.identifier | MeasureReport.identifier | Medication.identifier | MedicationAdministration.identifier | MedicationDispense.identifier | MedicationRequest.identifier | MedicationStatement.identifier | NutritionIntake.identifier | NutritionOrder.identifier | Observation.identifier | Person.identifier | Procedure.identifier | QuestionnaireResponse.identifier | RelatedPerson.identifier | RequestOrchestration.identifier | ResearchSubject.identifier | RiskAssessment.identifier | ServiceRequest.identifier | Specimen.identifier | Task.identifier | VisionPrescription.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-name", Code: "name", Base: []string{"Account"}, Type: "string", Expression: "Account.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-owner", Code: "owner", Base: []string{"Account"}, Type: "reference", Expression: "Account.owner", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/clinical-patient", Code: "patient", Base: []string{"Account", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "AuditEvent", "Basic", "BiologicallyDerivedProduct", "BodyStructure", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "Communication", "CommunicationRequest", "Composition", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "DeviceAssociation", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "EnrollmentRequest", "EpisodeOfCare", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "GuidanceResponse", "ImagingSelection", "ImagingStudy", "Immunization", "Invoice", "List", "MeasureReport", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "NutritionIntake", "NutritionOrder", "Observation", "Person", "Procedure", "Provenance", "QuestionnaireResponse", "RelatedPerson", "RequestOrchestration", "ResearchSubject", "RiskAssessment", "ServiceRequest", "Specimen", "Task", "VisionPrescription"}, Type: "reference", Expression: "Account.subject.where(resolve() is Patient) | AdverseEvent.subject.where(resolve() is Patient) | AllergyIntolerance.patient | Appointment.participant.actor.where(resolve() is Patient) | Appointment.subject.where(resolve() is Patient) | AppointmentResponse.actor.where(resolve() is Patient) | AuditEvent.patient | Basic.subject.where(resolve() is Patient) | BiologicallyDerivedProduct.collection.sourcePatient | BodyStructure.patient | CarePlan.subject.where(resolve() is Patient) | CareTeam.subject.where(resolve() is Patient) | Claim.subject.where(resolve() is Patient) | Claim.item.subject.where(resolve() is Patient) | ClaimResponse.subject.where(resolve() is Patient) | ClaimResponse.addItem.subject.where(resolve() is Patient) | Communication.subject.where(resolve() is Patient) | CommunicationRequest.subject.where(resolve() is Patient) | Composition.subject.where(resolve() is Patient) | Condition.subject.where(resolve() is Patient) | Consent.subject.where(resolve() is Patient) | Contract.subject.where(resolve() is Patient) | Coverage.beneficiary | CoverageEligibilityRequest.patient | CoverageEligibilityResponse.patient | DetectedIssue.subject.where(resolve() is Patient) | DeviceAssociation.subject.where(resolve() is Patient) | DeviceRequest.subject.where(resolve() is Patient) | DiagnosticReport.subject.where(resolve() is Patient) | DocumentReference.subject.where(resolve() is Patient) | Encounter.subject.where(resolve() is Patient) | EnrollmentRequest.candidate.where(resolve() is Patient) | EpisodeOfCare.subject.where(resolve() is Patient) | ExplanationOfBenefit.subject.where(resolve() is Patient) | ExplanationOfBenefit.item.subject.where(resolve() is Patient) | ExplanationOfBenefit.addItem.subject.where(resolve() is Patient) | FamilyMemberHistory.patient | Flag.subject.where(resolve() is Patient) | Goal.subject.where(resolve() is Patient) | GuidanceResponse.subject.where(resolve() is Patient) | ImagingSelection.subject.where(resolve() is Patient) | ImagingStudy.subject.where(resolve() is Patient) | Immunization.patient | Invoice.subject.where(resolve() is Patient) | List.subject.where(resolve() is Patient) | MeasureReport.subject.where(resolve() is Patient) | MedicationAdministration.subject.where(resolve() is Patient) | MedicationDispense.subject.where(resolve() is Patient) | MedicationRequest.subject.where(resolve() is Patient) | MedicationStatement.subject.where(resolve() is Patient) | NutritionIntake.subject.where(resolve() is Patient) | NutritionOrder.subject.where(resolve() is Patient) | Observation.subject.where(resolve() is Patient) | Person.link.target.where(resolve() is Patient) | Procedure.subject.where(resolve() is Patient) | Provenance.patient | QuestionnaireResponse.subject.where(resolve() is Patient) | RelatedPerson.patient | RequestOrchestration.subject.where(resolve() is Patient) | ResearchSubject.subject.where(resolve() is Patient) | RiskAssessment.subject.where(resolve() is Patient) | ServiceRequest.subject.where(resolve() is Patient) | Specimen.subject.where(resolve() is Patient) | Task.for.where(resolve() is Patient) | VisionPrescription.patient", Target: []string{"Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-period", Code: "period", Base: []string{"Account"}, Type: "date", Expression: "Account.servicePeriod", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-status", Code: "status", Base: []string{"Account"}, Type: "token", Expression: "Account.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Account-subject", Code: "subject", Base: []string{"Account"}, Type: "reference", Expression: "Account.subject", Target: []string{"HealthcareService", "Device", "Organization", "PractitionerRole", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/clinical-type", Code: "type", Base: []string{"Account", "AllergyIntolerance", "Composition", "Coverage", "DocumentReference", "Encounter", "EpisodeOfCare", "Invoice", "MedicationDispense", "Specimen"}, Type: "token", Expression: "Account.type | AllergyIntolerance.type | Composition.type | Coverage.type | DocumentReference.type | Encounter.type | EpisodeOfCare.type | Invoice.type | MedicationDispense.type | Specimen.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-composed-of", Code: "composed-of", Base: []string{"ActivityDefinition", "EventDefinition", "Library", "Measure", "PlanDefinition"}, Type: "reference", Expression: "ActivityDefinition.relatedArtifact.where(type='composed-of').resource | EventDefinition.relatedArtifact.where(type='composed-of').resource | Library.relatedArtifact.where(type='composed-of').resource | Measure.relatedArtifact.where(type='composed-of').resource | PlanDefinition.relatedArtifact.where(type='composed-of').resource", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context", Code: "context", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "token", Expression: "(ActivityDefinition.useContext.value.ofType(CodeableConcept)) | (ActorDefinition.useContext.value.ofType(CodeableConcept)) | (CapabilityStatement.useContext.value.ofType(CodeableConcept)) | (CodeSystem.useContext.value.ofType(CodeableConcept)) | (CompartmentDefinition.useContext.value.ofType(CodeableConcept)) | (ConceptMap.useContext.value.ofType(CodeableConcept)) | (EventDefinition.useContext.value.ofType(CodeableConcept)) | (Evidence.useContext.value.ofType(CodeableConcept)) | (EvidenceVariable.useContext.value.ofType(CodeableConcept)) | (ExampleScenario.useContext.value.ofType(CodeableConcept)) | (ImplementationGuide.useContext.value.ofType(CodeableConcept)) | (Library.useContext.value.ofType(CodeableConcept)) | (Measure.useContext.value.ofType(CodeableConcept)) | (MessageDefinition.useContext.value.ofType(CodeableConcept)) | (NamingSystem.useContext.value.ofType(CodeableConcept)) | (OperationDefinition.useContext.value.ofType(CodeableConcept)) | (PlanDefinition.useContext.value.ofType(CodeableConcept)) | (Questionnaire.useContext.value.ofType(CodeableConcept)) | (Requirements.useContext.value.ofType(CodeableConcept)) | (SearchParameter.useContext.value.ofType(CodeableConcept)) | (StructureDefinition.useContext.value.ofType(CodeableConcept)) | (StructureMap.useContext.value.ofType(CodeableConcept)) | (TerminologyCapabilities.useContext.value.ofType(CodeableConcept)) | (ValueSet.useContext.value.ofType(CodeableConcept))", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-quantity", Code: "context-quantity", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "quantity", Expression: "(ActivityDefinition.useContext.value.ofType(Quantity)) | (ActivityDefinition.useContext.value.ofType(Range)) | (ActorDefinition.useContext.value.ofType(Quantity)) | (ActorDefinition.useContext.value.ofType(Range)) | (CapabilityStatement.useContext.value.ofType(Quantity)) | (CapabilityStatement.useContext.value.ofType(Range)) | (CodeSystem.useContext.value.ofType(Quantity)) | (CodeSystem.useContext.value.ofType(Range)) | (CompartmentDefinition.useContext.value.ofType(Quantity)) | (CompartmentDefinition.useContext.value.ofType(Range)) | (ConceptMap.useContext.value.ofType(Quantity)) | (ConceptMap.useContext.value.ofType(Range)) | (EventDefinition.useContext.value.ofType(Quantity)) | (EventDefinition.useContext.value.ofType(Range)) | (Evidence.useContext.value.ofType(Quantity)) | (Evidence.useContext.value.ofType(Range)) | (EvidenceVariable.useContext.value.ofType(Quantity)) | (EvidenceVariable.useContext.value.ofType(Range)) | (ExampleScenario.useContext.value.ofType(Quantity)) | (ExampleScenario.useContext.value.ofType(Range)) | (ImplementationGuide.useContext.value.ofType(Quantity)) | (ImplementationGuide.useContext.value.ofType(Range)) | (Library.useContext.value.ofType(Quantity)) | (Library.useContext.value.ofType(Range)) | (Measure.useContext.value.ofType(Quantity)) | (Measure.useContext.value.ofType(Range)) | (MessageDefinition.useContext.value.ofType(Quantity)) | (MessageDefinition.useContext.value.ofType(Range)) | (NamingSystem.useContext.value.ofType(Quantity)) | (NamingSystem.useContext.value.ofType(Range)) | (OperationDefinition.useContext.value.ofType(Quantity)) | (OperationDefinition.useContext.value.ofType(Range)) | (PlanDefinition.useContext.value.ofType(Quantity)) | (PlanDefinition.useContext.value.ofType(Range)) | (Questionnaire.useContext.value.ofType(Quantity)) | (Questionnaire.useContext.value.ofType(Range)) | (Requirements.useContext.value.ofType(Quantity)) | (Requirements.useContext.value.ofType(Range)) | (SearchParameter.useContext.value.ofType(Quantity)) | (SearchParameter.useContext.value.ofType(Range)) | (StructureDefinition.useContext.value.ofType(Quantity)) | (StructureDefinition.useContext.value.ofType(Range)) | (StructureMap.useContext.value.ofType(Quantity)) | (StructureMap.useContext.value.ofType(Range)) | (TerminologyCapabilities.useContext.value.ofType(Quantity)) | (TerminologyCapabilities.useContext.value.ofType(Range)) | (ValueSet.useContext.value.ofType(Quantity)) | (ValueSet.useContext.value.ofType(Range))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-type", Code: "context-type", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "token", Expression: "ActivityDefinition.useContext.code | ActorDefinition.useContext.code | CapabilityStatement.useContext.code | CodeSystem.useContext.code | CompartmentDefinition.useContext.code | ConceptMap.useContext.code | EventDefinition.useContext.code | Evidence.useContext.code | EvidenceVariable.useContext.code | ExampleScenario.useContext.code | ImplementationGuide.useContext.code | Library.useContext.code | Measure.useContext.code | MessageDefinition.useContext.code | NamingSystem.useContext.code | OperationDefinition.useContext.code | PlanDefinition.useContext.code | Questionnaire.useContext.code | Requirements.useContext.code | SearchParameter.useContext.code | StructureDefinition.useContext.code | StructureMap.useContext.code | TerminologyCapabilities.useContext.code | ValueSet.useContext.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-date", Code: "date", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, Type: "date", Expression: "ActivityDefinition.date | ActorDefinition.date | CapabilityStatement.date | CodeSystem.date | CompartmentDefinition.date | ConceptMap.date | EventDefinition.date | Evidence.date | EvidenceVariable.date | ExampleScenario.date | ImplementationGuide.date | Library.date | Measure.date | MessageDefinition.date | NamingSystem.date | OperationDefinition.date | PlanDefinition.date | Questionnaire.date | Requirements.date | SearchParameter.date | StructureDefinition.date | StructureMap.date | SubscriptionTopic.date | TerminologyCapabilities.date | ValueSet.date", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-depends-on", Code: "depends-on", Base: []string{"ActivityDefinition", "EventDefinition", "Library", "Measure", "PlanDefinition"}, Type: "reference", Expression: "ActivityDefinition.relatedArtifact.where(type='depends-on').resource | ActivityDefinition.library | EventDefinition.relatedArtifact.where(type='depends-on').resource | Library.relatedArtifact.where(type='depends-on').resource | Measure.relatedArtifact.where(type='depends-on').resource | Measure.library | PlanDefinition.relatedArtifact.where(type='depends-on').resource | PlanDefinition.library", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-derived-from", Code: "derived-from", Base: []string{"ActivityDefinition", "CodeSystem", "ConceptMap", "EventDefinition", "Library", "Measure", "NamingSystem", "PlanDefinition", "ValueSet"}, Type: "reference", Expression: "ActivityDefinition.relatedArtifact.where(type='derived-from').resource | CodeSystem.relatedArtifact.where(type='derived-from').resource | ConceptMap.relatedArtifact.where(type='derived-from').resource | EventDefinition.relatedArtifact.where(type='derived-from').resource | Library.relatedArtifact.where(type='derived-from').resource | Measure.relatedArtifact.where(type='derived-from').resource | NamingSystem.relatedArtifact.where(type='derived-from').resource | PlanDefinition.relatedArtifact.where(type='derived-from').resource | ValueSet.relatedArtifact.where(type='derived-from').resource", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-description", Code: "description", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "string", Expression: "ActivityDefinition.description | ActorDefinition.description | CapabilityStatement.description | CodeSystem.description | CompartmentDefinition.description | ConceptMap.description | EventDefinition.description | Evidence.description | EvidenceVariable.description | ImplementationGuide.description | Library.description | Measure.description | MessageDefinition.description | NamingSystem.description | OperationDefinition.description | PlanDefinition.description | Questionnaire.description | Requirements.description | SearchParameter.description | StructureDefinition.description | StructureMap.description | TerminologyCapabilities.description | ValueSet.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-effective", Code: "effective", Base: []string{"ActivityDefinition", "CodeSystem", "ConceptMap", "EventDefinition", "Library", "Measure", "NamingSystem", "PlanDefinition", "Questionnaire", "ValueSet"}, Type: "date", Expression: "ActivityDefinition.effectivePeriod | CodeSystem.effectivePeriod | ConceptMap.effectivePeriod | EventDefinition.effectivePeriod | Library.effectivePeriod | Measure.effectivePeriod | NamingSystem.effectivePeriod | PlanDefinition.effectivePeriod | Questionnaire.effectivePeriod | ValueSet.effectivePeriod", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActivityDefinition-experimental", Code: "experimental", Base: []string{"ActivityDefinition"}, Type: "token", Expression: "ActivityDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-identifier", Code: "identifier", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "ConceptMap", "DeviceDefinition", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "Group", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "ObservationDefinition", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "SpecimenDefinition", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, Type: "token", Expression: "ActivityDefinition.identifier | ActorDefinition.identifier | CapabilityStatement.identifier | CodeSystem.identifier | ConceptMap.identifier | DeviceDefinition.identifier | DeviceDefinition.udiDeviceIdentifier | EventDefinition.identifier | Evidence.identifier | EvidenceVariable.identifier | ExampleScenario.identifier | Group.identifier | ImplementationGuide.identifier | Library.identifier | Measure.identifier | MessageDefinition.identifier | NamingSystem.identifier | ObservationDefinition.identifier | OperationDefinition.identifier | PlanDefinition.identifier | Questionnaire.identifier | Requirements.identifier | SearchParameter.identifier | SpecimenDefinition.identifier | StructureDefinition.identifier | StructureMap.identifier | SubscriptionTopic.identifier | TerminologyCapabilities.identifier | ValueSet.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-jurisdiction", Code: "jurisdiction", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "ConceptMap", "EventDefinition", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "token", Expression: "ActivityDefinition.jurisdiction | ActorDefinition.jurisdiction | CapabilityStatement.jurisdiction | CodeSystem.jurisdiction | ConceptMap.jurisdiction | EventDefinition.jurisdiction | ExampleScenario.jurisdiction | ImplementationGuide.jurisdiction | Library.jurisdiction | Measure.jurisdiction | MessageDefinition.jurisdiction | NamingSystem.jurisdiction | OperationDefinition.jurisdiction | PlanDefinition.jurisdiction | Questionnaire.jurisdiction | Requirements.jurisdiction | SearchParameter.jurisdiction | StructureDefinition.jurisdiction | StructureMap.jurisdiction | TerminologyCapabilities.jurisdiction | ValueSet.jurisdiction", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActivityDefinition-kind", Code: "kind", Base: []string{"ActivityDefinition"}, Type: "token", Expression: "ActivityDefinition.kind", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-name", Code: "name", Base: []string{"ActivityDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "EvidenceVariable", "ExampleScenario", "Group", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "string", Expression: "ActivityDefinition.name | CapabilityStatement.name | CodeSystem.name | CompartmentDefinition.name | ConceptMap.name | EventDefinition.name | EvidenceVariable.name | ExampleScenario.name | Group.name | ImplementationGuide.name | Library.name | Measure.name | MessageDefinition.name | NamingSystem.name | OperationDefinition.name | PlanDefinition.name | Questionnaire.name | Requirements.name | SearchParameter.name | StructureDefinition.name | StructureMap.name | TerminologyCapabilities.name | ValueSet.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-predecessor", Code: "predecessor", Base: []string{"ActivityDefinition", "CodeSystem", "ConceptMap", "EventDefinition", "Library", "Measure", "NamingSystem", "PlanDefinition", "ValueSet"}, Type: "reference", Expression: "ActivityDefinition.relatedArtifact.where(type='predecessor').resource | CodeSystem.relatedArtifact.where(type='predecessor').resource | ConceptMap.relatedArtifact.where(type='predecessor').resource | EventDefinition.relatedArtifact.where(type='predecessor').resource | Library.relatedArtifact.where(type='predecessor').resource | Measure.relatedArtifact.where(type='predecessor').resource | NamingSystem.relatedArtifact.where(type='predecessor').resource | PlanDefinition.relatedArtifact.where(type='predecessor').resource | ValueSet.relatedArtifact.where(type='predecessor').resource", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-publisher", Code: "publisher", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "DeviceDefinition", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "string", Expression: "ActivityDefinition.publisher | ActorDefinition.publisher | CapabilityStatement.publisher | CodeSystem.publisher | CompartmentDefinition.publisher | ConceptMap.publisher | DeviceDefinition.contact.name | DeviceDefinition.publisher | EventDefinition.publisher | Evidence.publisher | EvidenceVariable.publisher | ExampleScenario.publisher | ImplementationGuide.publisher | Library.publisher | Measure.publisher | MessageDefinition.publisher | NamingSystem.publisher | OperationDefinition.publisher | PlanDefinition.publisher | Questionnaire.publisher | Requirements.publisher | SearchParameter.publisher | StructureDefinition.publisher | StructureMap.publisher | TerminologyCapabilities.publisher | ValueSet.publisher", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-status", Code: "status", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "Group", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "ObservationDefinition", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "SpecimenDefinition", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, Type: "token", Expression: "ActivityDefinition.status | ActorDefinition.status | CapabilityStatement.status | CodeSystem.status | CompartmentDefinition.status | ConceptMap.status | EventDefinition.status | Evidence.status | EvidenceVariable.status | ExampleScenario.status | Group.status | ImplementationGuide.status | Library.status | Measure.status | MessageDefinition.status | NamingSystem.status | ObservationDefinition.status | OperationDefinition.status | PlanDefinition.status | Questionnaire.status | Requirements.status | SearchParameter.status | SpecimenDefinition.status | StructureDefinition.status | StructureMap.status | SubscriptionTopic.status | TerminologyCapabilities.status | ValueSet.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActivityDefinition-subject-canonical", Code: "subject-canonical", Base: []string{"ActivityDefinition"}, Type: "reference", Expression: "ActivityDefinition.subject as canonical", Target: []string{"MedicinalProductDefinition", "AdministrableProductDefinition", "Group", "PackagedProductDefinition", "EvidenceVariable", "SubstanceDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActivityDefinition-subject-code", Code: "subject-code", Base: []string{"ActivityDefinition"}, Type: "token", Expression: "ActivityDefinition.subject as CodeableConcept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActivityDefinition-subject-reference", Code: "subject-reference", Base: []string{"ActivityDefinition"}, Type: "reference", Expression: "ActivityDefinition.subject as Reference", Target: []string{"MedicinalProductDefinition", "AdministrableProductDefinition", "Group", "PackagedProductDefinition", "EvidenceVariable", "SubstanceDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-successor", Code: "successor", Base: []string{"ActivityDefinition", "EventDefinition", "Library", "Measure", "PlanDefinition"}, Type: "reference", Expression: "ActivityDefinition.relatedArtifact.where(type='successor').resource | EventDefinition.relatedArtifact.where(type='successor').resource | Library.relatedArtifact.where(type='successor').resource | Measure.relatedArtifact.where(type='successor').resource | PlanDefinition.relatedArtifact.where(type='successor').resource", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-title", Code: "title", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "ObservationDefinition", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SpecimenDefinition", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, Type: "string", Expression: "ActivityDefinition.title | ActorDefinition.title | CapabilityStatement.title | CodeSystem.title | ConceptMap.title | EventDefinition.title | Evidence.title | EvidenceVariable.title | ImplementationGuide.title | Library.title | Measure.title | MessageDefinition.title | ObservationDefinition.title | OperationDefinition.title | PlanDefinition.title | Questionnaire.title | Requirements.title | SpecimenDefinition.title | StructureDefinition.title | StructureMap.title | SubscriptionTopic.title | TerminologyCapabilities.title | ValueSet.title", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MetadataResource-topic", Code: "topic", Base: []string{"ActivityDefinition", "CodeSystem", "ConceptMap", "EventDefinition", "EvidenceVariable", "Library", "Measure", "NamingSystem", "PlanDefinition", "ValueSet"}, Type: "token", Expression: "ActivityDefinition.topic | CodeSystem.topic | ConceptMap.topic | EventDefinition.topic | Library.topic | Measure.topic | NamingSystem.topic | PlanDefinition.topic | ValueSet.topic", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-url", Code: "url", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "DeviceDefinition", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "Group", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "ObservationDefinition", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "SpecimenDefinition", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, Type: "uri", Expression: "ActivityDefinition.url | ActorDefinition.url | CapabilityStatement.url | CodeSystem.url | CompartmentDefinition.url | ConceptMap.url | DeviceDefinition.url | EventDefinition.url | Evidence.url | EvidenceVariable.url | ExampleScenario.url | Group.url | ImplementationGuide.url | Library.url | Measure.url | MessageDefinition.url | NamingSystem.url | ObservationDefinition.url | OperationDefinition.url | PlanDefinition.url | Questionnaire.url | Requirements.url | SearchParameter.url | SpecimenDefinition.url | StructureDefinition.url | StructureMap.url | SubscriptionTopic.url | TerminologyCapabilities.url | ValueSet.url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-version", Code: "version", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "DeviceDefinition", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, Type: "token", Expression: "ActivityDefinition.version | ActorDefinition.version | CapabilityStatement.version | CodeSystem.version | CompartmentDefinition.version | ConceptMap.version | DeviceDefinition.deviceVersion.value | EventDefinition.version | Evidence.version | EvidenceVariable.version | ExampleScenario.version | ImplementationGuide.version | Library.version | Measure.version | MessageDefinition.version | NamingSystem.version | OperationDefinition.version | PlanDefinition.version | Questionnaire.version | Requirements.version | SearchParameter.version | StructureDefinition.version | StructureMap.version | SubscriptionTopic.version | TerminologyCapabilities.version | ValueSet.version", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-type-quantity", Code: "context-type-quantity", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "composite", Expression: "ActivityDefinition.useContext | ActorDefinition.useContext | CapabilityStatement.useContext | CodeSystem.useContext | CompartmentDefinition.useContext | ConceptMap.useContext | EventDefinition.useContext | Evidence.useContext | EvidenceVariable.useContext | ExampleScenario.useContext | ImplementationGuide.useContext | Library.useContext | Measure.useContext | MessageDefinition.useContext | NamingSystem.useContext | OperationDefinition.useContext | PlanDefinition.useContext | Questionnaire.useContext | Requirements.useContext | SearchParameter.useContext | StructureDefinition.useContext | StructureMap.useContext | TerminologyCapabilities.useContext | ValueSet.useContext", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-type", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-quantity", Expression: "value.ofType(Quantity) | value.ofType(Range)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-type-value", Code: "context-type-value", Base: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "ConceptMap", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "SearchParameter", "StructureDefinition", "StructureMap", "TerminologyCapabilities", "ValueSet"}, Type: "composite", Expression: "ActivityDefinition.useContext | ActorDefinition.useContext | CapabilityStatement.useContext | CodeSystem.useContext | CompartmentDefinition.useContext | ConceptMap.useContext | EventDefinition.useContext | Evidence.useContext | EvidenceVariable.useContext | ExampleScenario.useContext | ImplementationGuide.useContext | Library.useContext | Measure.useContext | MessageDefinition.useContext | NamingSystem.useContext | OperationDefinition.useContext | PlanDefinition.useContext | Questionnaire.useContext | Requirements.useContext | SearchParameter.useContext | StructureDefinition.useContext | StructureMap.useContext | TerminologyCapabilities.useContext | ValueSet.useContext", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context-type", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/CanonicalResource-context", Expression: "value.ofType(CodeableConcept)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActorDefinition-experimental", Code: "experimental", Base: []string{"ActorDefinition"}, Type: "token", Expression: "ActorDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ActorDefinition-type", Code: "type", Base: []string{"ActorDefinition"}, Type: "token", Expression: "ActorDefinition.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-device", Code: "device", Base: []string{"AdministrableProductDefinition"}, Type: "reference", Expression: "AdministrableProductDefinition.device", Target: []string{"DeviceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-dose-form", Code: "dose-form", Base: []string{"AdministrableProductDefinition"}, Type: "token", Expression: "AdministrableProductDefinition.administrableDoseForm", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-form-of", Code: "form-of", Base: []string{"AdministrableProductDefinition"}, Type: "reference", Expression: "AdministrableProductDefinition.formOf", Target: []string{"MedicinalProductDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-identifier", Code: "identifier", Base: []string{"AdministrableProductDefinition"}, Type: "token", Expression: "AdministrableProductDefinition.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-ingredient", Code: "ingredient", Base: []string{"AdministrableProductDefinition"}, Type: "token", Expression: "AdministrableProductDefinition.ingredient", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-manufactured-item", Code: "manufactured-item", Base: []string{"AdministrableProductDefinition"}, Type: "reference", Expression: "AdministrableProductDefinition.producedFrom", Target: []string{"ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-route", Code: "route", Base: []string{"AdministrableProductDefinition"}, Type: "token", Expression: "AdministrableProductDefinition.routeOfAdministration.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-status", Code: "status", Base: []string{"AdministrableProductDefinition"}, Type: "token", Expression: "AdministrableProductDefinition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdministrableProductDefinition-target-species", Code: "target-species", Base: []string{"AdministrableProductDefinition"}, Type: "token", Expression: "AdministrableProductDefinition.routeOfAdministration.targetSpecies.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-actuality", Code: "actuality", Base: []string{"AdverseEvent"}, Type: "token", Expression: "AdverseEvent.actuality", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-category", Code: "category", Base: []string{"AdverseEvent"}, Type: "token", Expression: "AdverseEvent.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/clinical-code", Code: "code", Base: []string{"AdverseEvent", "AllergyIntolerance", "Basic", "Condition", "DetectedIssue", "DiagnosticReport", "FamilyMemberHistory", "ImagingSelection", "List", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "NutritionIntake", "Observation", "Procedure", "RequestOrchestration", "Task"}, Type: "token", Expression: "AdverseEvent.code | AllergyIntolerance.code | AllergyIntolerance.reaction.substance | Basic.code | Condition.code | DetectedIssue.code | DiagnosticReport.code | FamilyMemberHistory.condition.code | ImagingSelection.code | List.code | Medication.code | MedicationAdministration.medication.concept | MedicationDispense.medication.concept | MedicationRequest.medication.concept | MedicationStatement.medication.concept | NutritionIntake.code | Observation.code | Procedure.code | RequestOrchestration.code | Task.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-effect", Code: "effect", Base: []string{"AdverseEvent"}, Type: "date", Expression: "AdverseEvent.effect.ofType(dateTime) | AdverseEvent.effect.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-location", Code: "location", Base: []string{"AdverseEvent"}, Type: "reference", Expression: "AdverseEvent.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-occurrence", Code: "occurrence", Base: []string{"AdverseEvent"}, Type: "date", Expression: "AdverseEvent.suspectEntity.occurrence.ofType(dateTime) | AdverseEvent.suspectEntity.occurrence.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-recorder", Code: "recorder", Base: []string{"AdverseEvent"}, Type: "reference", Expression: "AdverseEvent.recorder", Target: []string{"RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-resultingeffect-code", Code: "resultingeffect-code", Base: []string{"AdverseEvent"}, Type: "token", Expression: "AdverseEvent.resultingEffect.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-resultingeffect-reference", Code: "resultingeffect-reference", Base: []string{"AdverseEvent"}, Type: "reference", Expression: "AdverseEvent.resultingEffect.reference", Target: []string{"Observation", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-seriousness", Code: "seriousness", Base: []string{"AdverseEvent"}, Type: "token", Expression: "AdverseEvent.seriousness", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-status", Code: "status", Base: []string{"AdverseEvent"}, Type: "token", Expression: "AdverseEvent.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-study", Code: "study", Base: []string{"AdverseEvent"}, Type: "reference", Expression: "AdverseEvent.study", Target: []string{"ResearchStudy"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-subject", Code: "subject", Base: []string{"AdverseEvent"}, Type: "reference", Expression: "AdverseEvent.subject", Target: []string{"RelatedPerson", "Group", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AdverseEvent-substance", Code: "substance", Base: []string{"AdverseEvent"}, Type: "reference", Expression: "AdverseEvent.suspectEntity.instance.reference", Target: []string{"Device", "Procedure", "MedicationStatement", "ResearchStudy", "MedicationAdministration", "BiologicallyDerivedProduct", "Immunization", "Substance", "SubstanceDefinition", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-asserter", Code: "asserter", Base: []string{"AllergyIntolerance"}, Type: "reference", Expression: "AllergyIntolerance.asserter", Target: []string{"RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-category", Code: "category", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-clinical-status", Code: "clinical-status", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.clinicalStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-criticality", Code: "criticality", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.criticality", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/clinical-date", Code: "date", Base: []string{"AllergyIntolerance", "Appointment", "AuditEvent", "CarePlan", "CareTeam", "Composition", "Consent", "DiagnosticReport", "DocumentReference", "Encounter", "EpisodeOfCare", "FamilyMemberHistory", "Flag", "Immunization", "Invoice", "List", "MeasureReport", "NutritionIntake", "Observation", "Procedure", "ResearchSubject", "RiskAssessment"}, Type: "date", Expression: "AllergyIntolerance.recordedDate | (start | requestedPeriod.start).first() | AuditEvent.recorded | CarePlan.period | Composition.date | Consent.date | DiagnosticReport.effective.ofType(dateTime) | DiagnosticReport.effective.ofType(Period) | DocumentReference.date | Encounter.actualPeriod | EpisodeOfCare.period | FamilyMemberHistory.date | Flag.period | (Immunization.occurrence.ofType(dateTime)) | Invoice.creation | List.date | MeasureReport.date | NutritionIntake.occurrence.ofType(dateTime) | NutritionIntake.occurrence.ofType(Period) | Observation.effective.ofType(dateTime) | Observation.effective.ofType(Period) | Observation.effective.ofType(Timing) | Observation.effective.ofType(instant) | Procedure.occurrence.ofType(dateTime) | Procedure.occurrence.ofType(Period) | Procedure.occurrence.ofType(Timing) | ResearchSubject.period | (RiskAssessment.occurrence.ofType(dateTime))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-last-reaction-date", Code: "last-reaction-date", Base: []string{"AllergyIntolerance"}, Type: "date", Expression: "AllergyIntolerance.lastReactionOccurrence", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-manifestation-code", Code: "manifestation-code", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.reaction.manifestation.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-manifestation-reference", Code: "manifestation-reference", Base: []string{"AllergyIntolerance"}, Type: "reference", Expression: "AllergyIntolerance.reaction.manifestation.reference", Target: []string{"Observation"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-route", Code: "route", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.reaction.exposureRoute", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-severity", Code: "severity", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.reaction.severity", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AllergyIntolerance-verification-status", Code: "verification-status", Base: []string{"AllergyIntolerance"}, Type: "token", Expression: "AllergyIntolerance.verificationStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-actor", Code: "actor", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.participant.actor", Target: []string{"HealthcareService", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-appointment-type", Code: "appointment-type", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.appointmentType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-based-on", Code: "based-on", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.basedOn", Target: []string{"RequestOrchestration", "DeviceRequest", "ServiceRequest", "CarePlan", "MedicationRequest", "NutritionOrder", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-group", Code: "group", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.participant.actor.where(resolve() is Group) | Appointment.subject.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-has-recurrence-template", Code: "has-recurrence-template", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.recurrenceTemplate.exists()", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-is-recurring", Code: "is-recurring", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.recurrenceTemplate.exists() | Appointment.originatingAppointment.exists()", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-location", Code: "location", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.participant.actor.where(resolve() is Location)", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-occurrence-changed", Code: "occurrence-changed", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.occurrenceChanged", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-originating-appointment", Code: "originating-appointment", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.originatingAppointment", Target: []string{"Appointment"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-part-status", Code: "part-status", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.participant.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-practitioner", Code: "practitioner", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.participant.actor.where(resolve() is Practitioner)", Target: []string{"Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-previous-appointment", Code: "previous-appointment", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.previousAppointment", Target: []string{"Appointment"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-reason-code", Code: "reason-code", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.reason.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-reason-reference", Code: "reason-reference", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.reason.reference", Target: []string{"Procedure", "Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-requested-period", Code: "requested-period", Base: []string{"Appointment"}, Type: "date", Expression: "requestedPeriod", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-service-category", Code: "service-category", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.serviceCategory", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-service-type", Code: "service-type", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.serviceType.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-service-type-reference", Code: "service-type-reference", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.serviceType.reference", Target: []string{"HealthcareService"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-slot", Code: "slot", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.slot", Target: []string{"Slot"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-specialty", Code: "specialty", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.specialty", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-status", Code: "status", Base: []string{"Appointment"}, Type: "token", Expression: "Appointment.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-subject", Code: "subject", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Appointment-supporting-info", Code: "supporting-info", Base: []string{"Appointment"}, Type: "reference", Expression: "Appointment.supportingInformation", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AppointmentResponse-actor", Code: "actor", Base: []string{"AppointmentResponse"}, Type: "reference", Expression: "AppointmentResponse.actor", Target: []string{"HealthcareService", "Device", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AppointmentResponse-appointment", Code: "appointment", Base: []string{"AppointmentResponse"}, Type: "reference", Expression: "AppointmentResponse.appointment", Target: []string{"Appointment"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AppointmentResponse-group", Code: "group", Base: []string{"AppointmentResponse"}, Type: "reference", Expression: "AppointmentResponse.actor.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AppointmentResponse-location", Code: "location", Base: []string{"AppointmentResponse"}, Type: "reference", Expression: "AppointmentResponse.actor.where(resolve() is Location)", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AppointmentResponse-part-status", Code: "part-status", Base: []string{"AppointmentResponse"}, Type: "token", Expression: "AppointmentResponse.participantStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AppointmentResponse-practitioner", Code: "practitioner", Base: []string{"AppointmentResponse"}, Type: "reference", Expression: "AppointmentResponse.actor.where(resolve() is Practitioner)", Target: []string{"Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ArtifactAssessment-date", Code: "date", Base: []string{"ArtifactAssessment"}, Type: "date", Expression: "ArtifactAssessment.date", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ArtifactAssessment-identifier", Code: "identifier", Base: []string{"ArtifactAssessment"}, Type: "token", Expression: "ArtifactAssessment.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-action", Code: "action", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.action", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-agent", Code: "agent", Base: []string{"AuditEvent"}, Type: "reference", Expression: "AuditEvent.agent.who", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "DeviceDefinition", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-agent-role", Code: "agent-role", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.agent.role", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-based-on", Code: "based-on", Base: []string{"AuditEvent"}, Type: "reference", Expression: "AuditEvent.basedOn", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/clinical-encounter", Code: "encounter", Base: []string{"AuditEvent", "CarePlan", "Claim", "Communication", "CommunicationRequest", "Composition", "Condition", "DeviceRequest", "DiagnosticReport", "ExplanationOfBenefit", "Flag", "ImagingStudy", "List", "MedicationDispense", "MedicationStatement", "NutritionIntake", "NutritionOrder", "Observation", "Procedure", "Provenance", "QuestionnaireResponse", "RequestOrchestration", "RiskAssessment", "ServiceRequest", "Task", "VisionPrescription"}, Type: "reference", Expression: "AuditEvent.encounter | CarePlan.encounter | Claim.item.encounter | Communication.encounter | CommunicationRequest.encounter | Composition.encounter | Condition.encounter | DeviceRequest.encounter | DiagnosticReport.encounter | ExplanationOfBenefit.item.encounter | Flag.encounter | ImagingStudy.encounter | List.encounter | MedicationDispense.encounter | MedicationStatement.encounter | NutritionIntake.encounter | NutritionOrder.encounter | Observation.encounter | Procedure.encounter | Provenance.encounter | QuestionnaireResponse.encounter | RequestOrchestration.encounter | RiskAssessment.encounter | ServiceRequest.encounter | Task.encounter | VisionPrescription.encounter", Target: []string{"Encounter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-entity", Code: "entity", Base: []string{"AuditEvent"}, Type: "reference", Expression: "AuditEvent.entity.what", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-entity-desc", Code: "entity-desc", Base: []string{"AuditEvent"}, Type: "string", Expression: "AuditEvent.entity.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-entity-role", Code: "entity-role", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.entity.role", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-outcome", Code: "outcome", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.outcome.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-policy", Code: "policy", Base: []string{"AuditEvent"}, Type: "uri", Expression: "AuditEvent.agent.policy", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-purpose", Code: "purpose", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.authorization | AuditEvent.agent.authorization", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-source", Code: "source", Base: []string{"AuditEvent"}, Type: "reference", Expression: "AuditEvent.source.observer", Target: []string{"Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-subtype", Code: "subtype", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.subtype", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/AuditEvent-type", Code: "type", Base: []string{"AuditEvent"}, Type: "token", Expression: "AuditEvent.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Basic-author", Code: "author", Base: []string{"Basic"}, Type: "reference", Expression: "Basic.author", Target: []string{"Organization", "Device", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Basic-created", Code: "created", Base: []string{"Basic"}, Type: "date", Expression: "Basic.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Basic-subject", Code: "subject", Base: []string{"Basic"}, Type: "reference", Expression: "Basic.subject", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-biological-source-event", Code: "biological-source-event", Base: []string{"BiologicallyDerivedProduct"}, Type: "token", Expression: "BiologicallyDerivedProduct.biologicalSourceEvent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-code", Code: "code", Base: []string{"BiologicallyDerivedProduct"}, Type: "token", Expression: "BiologicallyDerivedProduct.productCode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-collector", Code: "collector", Base: []string{"BiologicallyDerivedProduct"}, Type: "reference", Expression: "BiologicallyDerivedProduct.collection.collector", Target: []string{"PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-parent", Code: "parent", Base: []string{"BiologicallyDerivedProduct"}, Type: "reference", Expression: "BiologicallyDerivedProduct.parent", Target: []string{"BiologicallyDerivedProduct"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-product-category", Code: "product-category", Base: []string{"BiologicallyDerivedProduct"}, Type: "token", Expression: "BiologicallyDerivedProduct.productCategory", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-product-status", Code: "product-status", Base: []string{"BiologicallyDerivedProduct"}, Type: "token", Expression: "BiologicallyDerivedProduct.productStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-request", Code: "request", Base: []string{"BiologicallyDerivedProduct"}, Type: "reference", Expression: "BiologicallyDerivedProduct.request", Target: []string{"ServiceRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BiologicallyDerivedProduct-serial-number", Code: "serial-number", Base: []string{"BiologicallyDerivedProduct"}, Type: "token", Expression: "BiologicallyDerivedProduct.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BodyStructure-excludedstructure", Code: "excluded_structure", Base: []string{"BodyStructure"}, Type: "token", Expression: "BodyStructure.excludedStructure.structure", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BodyStructure-includedstructure", Code: "included_structure", Base: []string{"BodyStructure"}, Type: "token", Expression: "BodyStructure.includedStructure.structure", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/BodyStructure-morphology", Code: "morphology", Base: []string{"BodyStructure"}, Type: "token", Expression: "BodyStructure.includedStructure.morphology", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Bundle-composition", Code: "composition", Base: []string{"Bundle"}, Type: "resource", Expression: "Bundle.entry[0].resource as Composition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Bundle-identifier", Code: "identifier", Base: []string{"Bundle"}, Type: "token", Expression: "Bundle.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Bundle-message", Code: "message", Base: []string{"Bundle"}, Type: "resource", Expression: "Bundle.entry[0].resource as MessageHeader", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Bundle-timestamp", Code: "timestamp", Base: []string{"Bundle"}, Type: "date", Expression: "Bundle.timestamp", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Bundle-type", Code: "type", Base: []string{"Bundle"}, Type: "token", Expression: "Bundle.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-experimental", Code: "experimental", Base: []string{"CapabilityStatement"}, Type: "token", Expression: "CapabilityStatement.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-fhirversion", Code: "fhirversion", Base: []string{"CapabilityStatement"}, Type: "token", Expression: "CapabilityStatement.fhirVersion", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-format", Code: "format", Base: []string{"CapabilityStatement"}, Type: "token", Expression: "CapabilityStatement.format", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-guide", Code: "guide", Base: []string{"CapabilityStatement"}, Type: "reference", Expression: "CapabilityStatement.implementationGuide", Target: []string{"ImplementationGuide"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-mode", Code: "mode", Base: []string{"CapabilityStatement"}, Type: "token", Expression: "CapabilityStatement.rest.mode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-resource", Code: "resource", Base: []string{"CapabilityStatement"}, Type: "uri", Expression: "CapabilityStatement.rest.resource.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-resource-profile", Code: "resource-profile", Base: []string{"CapabilityStatement"}, Type: "reference", Expression: "CapabilityStatement.rest.resource.profile", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-security-service", Code: "security-service", Base: []string{"CapabilityStatement"}, Type: "token", Expression: "CapabilityStatement.rest.security.service", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-software", Code: "software", Base: []string{"CapabilityStatement"}, Type: "string", Expression: "CapabilityStatement.software.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CapabilityStatement-supported-profile", Code: "supported-profile", Base: []string{"CapabilityStatement"}, Type: "reference", Expression: "CapabilityStatement.rest.resource.supportedProfile", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-activity-reference", Code: "activity-reference", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.activity.plannedActivityReference", Target: []string{"Appointment", "RequestOrchestration", "DeviceRequest", "ServiceRequest", "CommunicationRequest", "Task", "MedicationRequest", "NutritionOrder", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-based-on", Code: "based-on", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.basedOn", Target: []string{"RequestOrchestration", "ServiceRequest", "CarePlan", "NutritionOrder"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-care-team", Code: "care-team", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.careTeam", Target: []string{"CareTeam"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-category", Code: "category", Base: []string{"CarePlan"}, Type: "token", Expression: "CarePlan.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-condition", Code: "condition", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.addresses.reference", Target: []string{"Procedure", "MedicationAdministration", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-custodian", Code: "custodian", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.custodian", Target: []string{"Device", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-goal", Code: "goal", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.goal", Target: []string{"Goal"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-intent", Code: "intent", Base: []string{"CarePlan"}, Type: "token", Expression: "CarePlan.intent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-part-of", Code: "part-of", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.partOf", Target: []string{"CarePlan"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-replaces", Code: "replaces", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.replaces", Target: []string{"CarePlan"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-status", Code: "status", Base: []string{"CarePlan"}, Type: "token", Expression: "CarePlan.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CarePlan-subject", Code: "subject", Base: []string{"CarePlan"}, Type: "reference", Expression: "CarePlan.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CareTeam-category", Code: "category", Base: []string{"CareTeam"}, Type: "token", Expression: "CareTeam.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CareTeam-name", Code: "name", Base: []string{"CareTeam"}, Type: "string", Expression: "CareTeam.name | CareTeam.extension('http://hl7.org/fhir/StructureDefinition/careteam-alias').value", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CareTeam-participant", Code: "participant", Base: []string{"CareTeam"}, Type: "reference", Expression: "CareTeam.participant.member", Target: []string{"HealthcareService", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CareTeam-status", Code: "status", Base: []string{"CareTeam"}, Type: "token", Expression: "CareTeam.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CareTeam-subject", Code: "subject", Base: []string{"CareTeam"}, Type: "reference", Expression: "CareTeam.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-care-team", Code: "care-team", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.careTeam.provider", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-created", Code: "created", Base: []string{"Claim"}, Type: "date", Expression: "Claim.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-detail-udi", Code: "detail-udi", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.item.detail.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-enterer", Code: "enterer", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.enterer", Target: []string{"RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-facility", Code: "facility", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.facility", Target: []string{"Organization", "Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-group", Code: "group", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.subject.where(resolve() is Group) | Claim.item.subject.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-insurer", Code: "insurer", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.insurer", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-item-udi", Code: "item-udi", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.item.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-payee", Code: "payee", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.payee.party", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-priority", Code: "priority", Base: []string{"Claim"}, Type: "token", Expression: "Claim.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-procedure-udi", Code: "procedure-udi", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.procedure.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-provider", Code: "provider", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.provider", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-status", Code: "status", Base: []string{"Claim"}, Type: "token", Expression: "Claim.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-subdetail-udi", Code: "subdetail-udi", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.item.detail.subDetail.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-subject", Code: "subject", Base: []string{"Claim"}, Type: "reference", Expression: "Claim.subject | Claim.item.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Claim-use", Code: "use", Base: []string{"Claim"}, Type: "token", Expression: "Claim.use", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-created", Code: "created", Base: []string{"ClaimResponse"}, Type: "date", Expression: "ClaimResponse.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-disposition", Code: "disposition", Base: []string{"ClaimResponse"}, Type: "string", Expression: "ClaimResponse.disposition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-group", Code: "group", Base: []string{"ClaimResponse"}, Type: "reference", Expression: "ClaimResponse.subject.where(resolve() is Group) | ClaimResponse.addItem.subject.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-insurer", Code: "insurer", Base: []string{"ClaimResponse"}, Type: "reference", Expression: "ClaimResponse.insurer", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-outcome", Code: "outcome", Base: []string{"ClaimResponse"}, Type: "token", Expression: "ClaimResponse.outcome", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-payment-date", Code: "payment-date", Base: []string{"ClaimResponse"}, Type: "date", Expression: "ClaimResponse.payment.date", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-request", Code: "request", Base: []string{"ClaimResponse"}, Type: "reference", Expression: "ClaimResponse.request", Target: []string{"Claim"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-requestor", Code: "requestor", Base: []string{"ClaimResponse"}, Type: "reference", Expression: "ClaimResponse.requestor", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-status", Code: "status", Base: []string{"ClaimResponse"}, Type: "token", Expression: "ClaimResponse.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-subject", Code: "subject", Base: []string{"ClaimResponse"}, Type: "reference", Expression: "ClaimResponse.subject | ClaimResponse.addItem.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClaimResponse-use", Code: "use", Base: []string{"ClaimResponse"}, Type: "token", Expression: "ClaimResponse.use", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-contraindication", Code: "contraindication", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.contraindication.diseaseSymptomProcedure.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-contraindication-reference", Code: "contraindication-reference", Base: []string{"ClinicalUseDefinition"}, Type: "reference", Expression: "ClinicalUseDefinition.contraindication.diseaseSymptomProcedure.reference", Target: []string{"ObservationDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-effect", Code: "effect", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.undesirableEffect.symptomConditionEffect.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-effect-reference", Code: "effect-reference", Base: []string{"ClinicalUseDefinition"}, Type: "reference", Expression: "ClinicalUseDefinition.undesirableEffect.symptomConditionEffect.reference", Target: []string{"ObservationDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-identifier", Code: "identifier", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-indication", Code: "indication", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.indication.diseaseSymptomProcedure.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-indication-reference", Code: "indication-reference", Base: []string{"ClinicalUseDefinition"}, Type: "reference", Expression: "ClinicalUseDefinition.indication.diseaseSymptomProcedure.reference", Target: []string{"ObservationDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-interaction", Code: "interaction", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.interaction.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-product", Code: "product", Base: []string{"ClinicalUseDefinition"}, Type: "reference", Expression: "ClinicalUseDefinition.subject.reference.where(resolve() is MedicinalProductDefinition)", Target: []string{"MedicinalProductDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-status", Code: "status", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-subject", Code: "subject", Base: []string{"ClinicalUseDefinition"}, Type: "reference", Expression: "ClinicalUseDefinition.subject.reference", Target: []string{"MedicinalProductDefinition", "Device", "DeviceDefinition", "NutritionProduct", "ActivityDefinition", "PlanDefinition", "BiologicallyDerivedProduct", "SubstanceDefinition", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-subject-code", Code: "subject-code", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.subject.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ClinicalUseDefinition-type", Code: "type", Base: []string{"ClinicalUseDefinition"}, Type: "token", Expression: "ClinicalUseDefinition.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CodeSystem-code", Code: "code", Base: []string{"CodeSystem"}, Type: "token", Expression: "CodeSystem.repeat(concept).code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CodeSystem-content-mode", Code: "content-mode", Base: []string{"CodeSystem"}, Type: "token", Expression: "CodeSystem.content", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CodeSystem-experimental", Code: "experimental", Base: []string{"CodeSystem"}, Type: "token", Expression: "CodeSystem.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CodeSystem-language", Code: "language", Base: []string{"CodeSystem"}, Type: "token", Expression: "CodeSystem.repeat(concept).designation.language", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CodeSystem-supplements", Code: "supplements", Base: []string{"CodeSystem"}, Type: "reference", Expression: "CodeSystem.supplements", Target: []string{"CodeSystem"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CodeSystem-system", Code: "system", Base: []string{"CodeSystem"}, Type: "uri", Expression: "CodeSystem.url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-based-on", Code: "based-on", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.basedOn", Target: []string{"DeviceRequest", "ServiceRequest", "CarePlan", "CommunicationRequest", "Task", "MedicationRequest", "NutritionOrder", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-category", Code: "category", Base: []string{"Communication"}, Type: "token", Expression: "Communication.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-in-response-to", Code: "in-response-to", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.inResponseTo", Target: []string{"Communication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-medium", Code: "medium", Base: []string{"Communication"}, Type: "token", Expression: "Communication.medium", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-part-of", Code: "part-of", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.partOf", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-reason-code", Code: "reason-code", Base: []string{"Communication"}, Type: "token", Expression: "Communication.reason.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-reason-reference", Code: "reason-reference", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.reason.reference", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-received", Code: "received", Base: []string{"Communication"}, Type: "date", Expression: "Communication.received", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-recipient", Code: "recipient", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.recipient", Target: []string{"HealthcareService", "CareTeam", "Device", "Organization", "Endpoint", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-sender", Code: "sender", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.sender", Target: []string{"HealthcareService", "Device", "Organization", "Endpoint", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-sent", Code: "sent", Base: []string{"Communication"}, Type: "date", Expression: "Communication.sent", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-status", Code: "status", Base: []string{"Communication"}, Type: "token", Expression: "Communication.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-subject", Code: "subject", Base: []string{"Communication"}, Type: "reference", Expression: "Communication.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Communication-topic", Code: "topic", Base: []string{"Communication"}, Type: "token", Expression: "Communication.topic", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-about", Code: "about", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.about", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-authored", Code: "authored", Base: []string{"CommunicationRequest"}, Type: "date", Expression: "CommunicationRequest.authoredOn", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-based-on", Code: "based-on", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.basedOn", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-category", Code: "category", Base: []string{"CommunicationRequest"}, Type: "token", Expression: "CommunicationRequest.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-group-identifier", Code: "group-identifier", Base: []string{"CommunicationRequest"}, Type: "token", Expression: "CommunicationRequest.groupIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-information-provider", Code: "information-provider", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.informationProvider", Target: []string{"HealthcareService", "Device", "Organization", "Endpoint", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-medium", Code: "medium", Base: []string{"CommunicationRequest"}, Type: "token", Expression: "CommunicationRequest.medium", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-occurrence", Code: "occurrence", Base: []string{"CommunicationRequest"}, Type: "date", Expression: "CommunicationRequest.occurrence.ofType(dateTime) | CommunicationRequest.occurrence.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-priority", Code: "priority", Base: []string{"CommunicationRequest"}, Type: "token", Expression: "CommunicationRequest.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-recipient", Code: "recipient", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.recipient", Target: []string{"HealthcareService", "Device", "Organization", "CareTeam", "Endpoint", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-replaces", Code: "replaces", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.replaces", Target: []string{"CommunicationRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-requester", Code: "requester", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.requester", Target: []string{"Organization", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-status", Code: "status", Base: []string{"CommunicationRequest"}, Type: "token", Expression: "CommunicationRequest.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CommunicationRequest-subject", Code: "subject", Base: []string{"CommunicationRequest"}, Type: "reference", Expression: "CommunicationRequest.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CompartmentDefinition-code", Code: "code", Base: []string{"CompartmentDefinition"}, Type: "token", Expression: "CompartmentDefinition.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CompartmentDefinition-resource", Code: "resource", Base: []string{"CompartmentDefinition"}, Type: "token", Expression: "CompartmentDefinition.resource.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-attester", Code: "attester", Base: []string{"Composition"}, Type: "reference", Expression: "Composition.attester.party", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-author", Code: "author", Base: []string{"Composition"}, Type: "reference", Expression: "Composition.author", Target: []string{"CareTeam", "Device", "Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-category", Code: "category", Base: []string{"Composition"}, Type: "token", Expression: "Composition.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-entry", Code: "entry", Base: []string{"Composition"}, Type: "reference", Expression: "Composition.repeat(section).entry", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-event-code", Code: "event-code", Base: []string{"Composition"}, Type: "token", Expression: "Composition.event.detail.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-event-reference", Code: "event-reference", Base: []string{"Composition"}, Type: "reference", Expression: "Composition.event.detail.reference", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-period", Code: "period", Base: []string{"Composition"}, Type: "date", Expression: "Composition.event.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-section", Code: "section", Base: []string{"Composition"}, Type: "token", Expression: "Composition.repeat(section).code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-section-text", Code: "section-text", Base: []string{"Composition"}, Type: "special", Expression: "Composition.repeat(section).text", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-status", Code: "status", Base: []string{"Composition"}, Type: "token", Expression: "Composition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-subject", Code: "subject", Base: []string{"Composition"}, Type: "reference", Expression: "Composition.subject", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-title", Code: "title", Base: []string{"Composition"}, Type: "string", Expression: "Composition.title", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-url", Code: "url", Base: []string{"Composition"}, Type: "uri", Expression: "Composition.url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-version", Code: "version", Base: []string{"Composition"}, Type: "token", Expression: "Composition.version", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Composition-section-code-text", Code: "section-code-text", Base: []string{"Composition"}, Type: "composite", Expression: "Composition.repeat(section)", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Composition-section", Expression: "section.code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Composition-section-text", Expression: "section.text"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-experimental", Code: "experimental", Base: []string{"ConceptMap"}, Type: "token", Expression: "ConceptMap.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-mapping-property", Code: "mapping-property", Base: []string{"ConceptMap"}, Type: "uri", Expression: "ConceptMap.property.uri", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-other-map", Code: "other-map", Base: []string{"ConceptMap"}, Type: "reference", Expression: "ConceptMap.group.unmapped.otherMap", Target: []string{"ConceptMap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-source-code", Code: "source-code", Base: []string{"ConceptMap"}, Type: "token", Expression: "ConceptMap.group.element.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-source-group-system", Code: "source-group-system", Base: []string{"ConceptMap"}, Type: "reference", Expression: "ConceptMap.group.source", Target: []string{"CodeSystem"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-source-scope", Code: "source-scope", Base: []string{"ConceptMap"}, Type: "reference", Expression: "(ConceptMap.sourceScope as canonical)", Target: []string{"ValueSet"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-source-scope-uri", Code: "source-scope-uri", Base: []string{"ConceptMap"}, Type: "uri", Expression: "(ConceptMap.sourceScope as uri)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-target-code", Code: "target-code", Base: []string{"ConceptMap"}, Type: "token", Expression: "ConceptMap.group.element.target.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-target-group-system", Code: "target-group-system", Base: []string{"ConceptMap"}, Type: "reference", Expression: "ConceptMap.group.target", Target: []string{"CodeSystem"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-target-scope", Code: "target-scope", Base: []string{"ConceptMap"}, Type: "reference", Expression: "(ConceptMap.targetScope as canonical)", Target: []string{"ValueSet"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ConceptMap-target-scope-uri", Code: "target-scope-uri", Base: []string{"ConceptMap"}, Type: "uri", Expression: "(ConceptMap.targetScope as uri)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-abatement-age", Code: "abatement-age", Base: []string{"Condition"}, Type: "quantity", Expression: "Condition.abatement.ofType(Age) | Condition.abatement.ofType(Range)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-abatement-date", Code: "abatement-date", Base: []string{"Condition"}, Type: "date", Expression: "Condition.abatement.ofType(dateTime) | Condition.abatement.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-abatement-string", Code: "abatement-string", Base: []string{"Condition"}, Type: "string", Expression: "Condition.abatement.ofType(string)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-asserter", Code: "asserter", Base: []string{"Condition"}, Type: "reference", Expression: "Condition.asserter", Target: []string{"Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-body-site", Code: "body-site", Base: []string{"Condition"}, Type: "token", Expression: "Condition.bodySite", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-category", Code: "category", Base: []string{"Condition"}, Type: "token", Expression: "Condition.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-clinical-status", Code: "clinical-status", Base: []string{"Condition"}, Type: "token", Expression: "Condition.clinicalStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-evidence", Code: "evidence", Base: []string{"Condition"}, Type: "token", Expression: "Condition.evidence.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-evidence-detail", Code: "evidence-detail", Base: []string{"Condition"}, Type: "reference", Expression: "Condition.evidence.reference", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-onset-age", Code: "onset-age", Base: []string{"Condition"}, Type: "quantity", Expression: "Condition.onset.ofType(Age) | Condition.onset.ofType(Range)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-onset-date", Code: "onset-date", Base: []string{"Condition"}, Type: "date", Expression: "Condition.onset.ofType(dateTime) | Condition.onset.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-onset-info", Code: "onset-info", Base: []string{"Condition"}, Type: "string", Expression: "Condition.onset.ofType(string)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-recorded-date", Code: "recorded-date", Base: []string{"Condition"}, Type: "date", Expression: "Condition.recordedDate", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-severity", Code: "severity", Base: []string{"Condition"}, Type: "token", Expression: "Condition.severity", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-stage", Code: "stage", Base: []string{"Condition"}, Type: "token", Expression: "Condition.stage.summary", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-subject", Code: "subject", Base: []string{"Condition"}, Type: "reference", Expression: "Condition.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Condition-verification-status", Code: "verification-status", Base: []string{"Condition"}, Type: "token", Expression: "Condition.verificationStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-action", Code: "action", Base: []string{"Consent"}, Type: "token", Expression: "Consent.repeat(provision).code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-actor", Code: "actor", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.repeat(provision).actor.reference", Target: []string{"Device", "CareTeam", "Organization", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-category", Code: "category", Base: []string{"Consent"}, Type: "token", Expression: "Consent.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-controller", Code: "controller", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.controller", Target: []string{"HealthcareService", "Organization", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-data", Code: "data", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.repeat(provision).data.reference", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-grantee", Code: "grantee", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.grantee", Target: []string{"HealthcareService", "CareTeam", "Organization", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-manager", Code: "manager", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.manager", Target: []string{"HealthcareService", "Organization", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-period", Code: "period", Base: []string{"Consent"}, Type: "date", Expression: "Consent.repeat(provision).period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-purpose", Code: "purpose", Base: []string{"Consent"}, Type: "token", Expression: "Consent.repeat(provision).purpose", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-security-label", Code: "security-label", Base: []string{"Consent"}, Type: "token", Expression: "Consent.repeat(provision).securityLabel", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-source-reference", Code: "source-reference", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.sourceReference", Target: []string{"Consent", "DocumentReference", "Contract", "QuestionnaireResponse"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-status", Code: "status", Base: []string{"Consent"}, Type: "token", Expression: "Consent.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-subject", Code: "subject", Base: []string{"Consent"}, Type: "reference", Expression: "Consent.subject", Target: []string{"ResearchSubject", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-verified", Code: "verified", Base: []string{"Consent"}, Type: "token", Expression: "Consent.verification.verified", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Consent-verified-date", Code: "verified-date", Base: []string{"Consent"}, Type: "date", Expression: "Consent.verification.date", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-authority", Code: "authority", Base: []string{"Contract"}, Type: "reference", Expression: "Contract.authority", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-domain", Code: "domain", Base: []string{"Contract"}, Type: "reference", Expression: "Contract.domain", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-instantiates", Code: "instantiates", Base: []string{"Contract"}, Type: "uri", Expression: "Contract.instantiatesUri", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-issued", Code: "issued", Base: []string{"Contract"}, Type: "date", Expression: "Contract.issued", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-signer", Code: "signer", Base: []string{"Contract"}, Type: "reference", Expression: "Contract.signer.party", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-status", Code: "status", Base: []string{"Contract"}, Type: "token", Expression: "Contract.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-subject", Code: "subject", Base: []string{"Contract"}, Type: "reference", Expression: "Contract.subject", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Contract-url", Code: "url", Base: []string{"Contract"}, Type: "uri", Expression: "Contract.url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-beneficiary", Code: "beneficiary", Base: []string{"Coverage"}, Type: "reference", Expression: "Coverage.beneficiary", Target: []string{"Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-class-type", Code: "class-type", Base: []string{"Coverage"}, Type: "token", Expression: "Coverage.class.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-class-value", Code: "class-value", Base: []string{"Coverage"}, Type: "token", Expression: "Coverage.class.value", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-dependent", Code: "dependent", Base: []string{"Coverage"}, Type: "string", Expression: "Coverage.dependent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-insurer", Code: "insurer", Base: []string{"Coverage"}, Type: "reference", Expression: "Coverage.insurer", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-paymentby-party", Code: "paymentby-party", Base: []string{"Coverage"}, Type: "reference", Expression: "Coverage.paymentBy.party", Target: []string{"Organization", "RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-period", Code: "period", Base: []string{"Coverage"}, Type: "date", Expression: "Coverage.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-policy-holder", Code: "policy-holder", Base: []string{"Coverage"}, Type: "reference", Expression: "Coverage.policyHolder", Target: []string{"Organization", "RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-status", Code: "status", Base: []string{"Coverage"}, Type: "token", Expression: "Coverage.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-subscriber", Code: "subscriber", Base: []string{"Coverage"}, Type: "reference", Expression: "Coverage.subscriber", Target: []string{"RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Coverage-subscriberid", Code: "subscriberid", Base: []string{"Coverage"}, Type: "token", Expression: "Coverage.subscriberId", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityRequest-created", Code: "created", Base: []string{"CoverageEligibilityRequest"}, Type: "date", Expression: "CoverageEligibilityRequest.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityRequest-enterer", Code: "enterer", Base: []string{"CoverageEligibilityRequest"}, Type: "reference", Expression: "CoverageEligibilityRequest.enterer", Target: []string{"PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityRequest-facility", Code: "facility", Base: []string{"CoverageEligibilityRequest"}, Type: "reference", Expression: "CoverageEligibilityRequest.facility", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityRequest-provider", Code: "provider", Base: []string{"CoverageEligibilityRequest"}, Type: "reference", Expression: "CoverageEligibilityRequest.provider", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityRequest-status", Code: "status", Base: []string{"CoverageEligibilityRequest"}, Type: "token", Expression: "CoverageEligibilityRequest.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-created", Code: "created", Base: []string{"CoverageEligibilityResponse"}, Type: "date", Expression: "CoverageEligibilityResponse.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-disposition", Code: "disposition", Base: []string{"CoverageEligibilityResponse"}, Type: "string", Expression: "CoverageEligibilityResponse.disposition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-insurer", Code: "insurer", Base: []string{"CoverageEligibilityResponse"}, Type: "reference", Expression: "CoverageEligibilityResponse.insurer", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-outcome", Code: "outcome", Base: []string{"CoverageEligibilityResponse"}, Type: "token", Expression: "CoverageEligibilityResponse.outcome", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-request", Code: "request", Base: []string{"CoverageEligibilityResponse"}, Type: "reference", Expression: "CoverageEligibilityResponse.request", Target: []string{"CoverageEligibilityRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-requestor", Code: "requestor", Base: []string{"CoverageEligibilityResponse"}, Type: "reference", Expression: "CoverageEligibilityResponse.requestor", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/CoverageEligibilityResponse-status", Code: "status", Base: []string{"CoverageEligibilityResponse"}, Type: "token", Expression: "CoverageEligibilityResponse.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DetectedIssue-author", Code: "author", Base: []string{"DetectedIssue"}, Type: "reference", Expression: "DetectedIssue.author", Target: []string{"Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DetectedIssue-category", Code: "category", Base: []string{"DetectedIssue"}, Type: "token", Expression: "DetectedIssue.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DetectedIssue-identified", Code: "identified", Base: []string{"DetectedIssue"}, Type: "date", Expression: "DetectedIssue.identified.ofType(dateTime) | DetectedIssue.identified.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DetectedIssue-implicated", Code: "implicated", Base: []string{"DetectedIssue"}, Type: "reference", Expression: "DetectedIssue.implicated", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DetectedIssue-status", Code: "status", Base: []string{"DetectedIssue"}, Type: "token", Expression: "DetectedIssue.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DetectedIssue-subject", Code: "subject", Base: []string{"DetectedIssue"}, Type: "reference", Expression: "DetectedIssue.subject", Target: []string{"Device", "Organization", "Procedure", "NutritionProduct", "Group", "Practitioner", "BiologicallyDerivedProduct", "Substance", "Location", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-biological-source-event", Code: "biological-source-event", Base: []string{"Device"}, Type: "token", Expression: "Device.biologicalSourceEvent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-code", Code: "code", Base: []string{"Device"}, Type: "token", Expression: "Device.type |  Device.definition.resolve().classification.type ", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-definition", Code: "definition", Base: []string{"Device"}, Type: "reference", Expression: "Device.definition", Target: []string{"DeviceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-device-name", Code: "device-name", Base: []string{"Device"}, Type: "string", Expression: "Device.name.value | Device.type.coding.display | Device.type.text", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-expiration-date", Code: "expiration-date", Base: []string{"Device"}, Type: "date", Expression: "Device.expirationDate", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-identifier", Code: "identifier", Base: []string{"Device"}, Type: "token", Expression: "Device.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-location", Code: "location", Base: []string{"Device"}, Type: "reference", Expression: "Device.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-lot-number", Code: "lot-number", Base: []string{"Device"}, Type: "string", Expression: "Device.lotNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-manufacture-date", Code: "manufacture-date", Base: []string{"Device"}, Type: "date", Expression: "Device.manufactureDate", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-manufacturer", Code: "manufacturer", Base: []string{"Device"}, Type: "string", Expression: "Device.manufacturer", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-model", Code: "model", Base: []string{"Device"}, Type: "string", Expression: "Device.modelNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-parent", Code: "parent", Base: []string{"Device"}, Type: "reference", Expression: "Device.parent", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-serial-number", Code: "serial-number", Base: []string{"Device"}, Type: "string", Expression: "Device.serialNumber | Device.identifier.where(type='SNO')", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-specification", Code: "specification", Base: []string{"Device"}, Type: "token", Expression: "Device.conformsTo.specification", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-status", Code: "status", Base: []string{"Device"}, Type: "token", Expression: "Device.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-type", Code: "type", Base: []string{"Device"}, Type: "token", Expression: "Device.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-udi-carrier-hrf", Code: "udi-carrier-hrf", Base: []string{"Device"}, Type: "string", Expression: "Device.udiCarrier.carrierHRF", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-udi-di", Code: "udi-di", Base: []string{"Device"}, Type: "string", Expression: "Device.udiCarrier.deviceIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-version", Code: "version", Base: []string{"Device"}, Type: "string", Expression: "Device.deviceVersion.value", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-code-value-concept", Code: "code-value-concept", Base: []string{"Device"}, Type: "composite", Expression: "Device", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Device-specification", Expression: "specification.ofType(CodeableConcept)"}, {Definition: "http://hl7.org/fhir/SearchParameter/Device-version", Expression: "version.ofType(string)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-specification-version", Code: "specification-version", Base: []string{"Device"}, Type: "composite", Expression: "Device.conformsTo", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Device-specification", Expression: "specification"}, {Definition: "http://hl7.org/fhir/SearchParameter/Device-version", Expression: "version"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Device-version-type", Code: "version-type", Base: []string{"Device"}, Type: "composite", Expression: "Device.deviceVersion", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Device-type", Expression: "type"}, {Definition: "http://hl7.org/fhir/SearchParameter/Device-value", Expression: "value"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-acknowledged", Code: "acknowledged", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.acknowledged", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-acknowledged-by", Code: "acknowledged-by", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.acknowledgedBy", Target: []string{"Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-annunciator-concept", Code: "annunciator-concept", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.signal.annunciator.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-annunciator-device", Code: "annunciator-device", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.signal.annunciator.reference", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-category", Code: "category", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-code", Code: "code", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-derived-from", Code: "derived-from", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.derivedFrom.observation", Target: []string{"Observation"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-device", Code: "device", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.device", Target: []string{"Device", "DeviceMetric"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-encounter", Code: "encounter", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.encounter", Target: []string{"Encounter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-identifier", Code: "identifier", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-indication", Code: "indication", Base: []string{"DeviceAlert"}, Type: "date", Expression: "DeviceAlert.signal.indication", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-location", Code: "location", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-manifestation", Code: "manifestation", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.signal.manifestation", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-occurrence", Code: "occurrence", Base: []string{"DeviceAlert"}, Type: "date", Expression: "DeviceAlert.occurrence.ofType(Period) | occurrence.ofType(dateTime)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-patient", Code: "patient", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.subject.where(resolve() is Patient)", Target: []string{"Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-presence", Code: "presence", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.presence", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-priority", Code: "priority", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-procedure", Code: "procedure", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.procedure", Target: []string{"Procedure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-signal-presence", Code: "signal-presence", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.signal.presence", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-status", Code: "status", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-subject", Code: "subject", Base: []string{"DeviceAlert"}, Type: "reference", Expression: "DeviceAlert.subject", Target: []string{"Device", "NutritionProduct", "BiologicallyDerivedProduct", "Group", "Specimen", "Location", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAlert-type", Code: "type", Base: []string{"DeviceAlert"}, Type: "token", Expression: "DeviceAlert.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-date", Code: "date", Base: []string{"DeviceAssociation"}, Type: "date", Expression: "DeviceAssociation.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-device", Code: "device", Base: []string{"DeviceAssociation"}, Type: "reference", Expression: "DeviceAssociation.device", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-focus", Code: "focus", Base: []string{"DeviceAssociation"}, Type: "reference", Expression: "DeviceAssociation.focus", Target: []string{"CareTeam", "Device", "Organization", "RelatedPerson", "BiologicallyDerivedProduct", "Group", "Practitioner", "Specimen", "Substance", "Location", "NutritionProduct", "PractitionerRole", "Medication", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-identifier", Code: "identifier", Base: []string{"DeviceAssociation"}, Type: "token", Expression: "DeviceAssociation.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-relationship", Code: "relationship", Base: []string{"DeviceAssociation"}, Type: "token", Expression: "DeviceAssociation.relationship", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-status", Code: "status", Base: []string{"DeviceAssociation"}, Type: "token", Expression: "DeviceAssociation.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceAssociation-subject", Code: "subject", Base: []string{"DeviceAssociation"}, Type: "reference", Expression: "DeviceAssociation.subject", Target: []string{"CareTeam", "Device", "Organization", "RelatedPerson", "BiologicallyDerivedProduct", "Group", "Practitioner", "Specimen", "Substance", "Location", "NutritionProduct", "PractitionerRole", "Medication", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-conforms-to-category", Code: "conforms-to-category", Base: []string{"DeviceDefinition"}, Type: "token", Expression: "DeviceDefinition.conformsTo.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-device-name", Code: "device-name", Base: []string{"DeviceDefinition"}, Type: "string", Expression: "DeviceDefinition.deviceName.name | DeviceDefinition.classification.type.coding.display | DeviceDefinition.classification.type.text", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-experimental", Code: "experimental", Base: []string{"DeviceDefinition"}, Type: "token", Expression: "DeviceDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-has-part-canonical", Code: "has-part-canonical", Base: []string{"DeviceDefinition"}, Type: "reference", Expression: "DeviceDefinition.hasPart.definition.ofType(canonical)", Target: []string{"DeviceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-has-part-code", Code: "has-part-code", Base: []string{"DeviceDefinition"}, Type: "token", Expression: "DeviceDefinition.hasPart.definition.ofType(CodeableConcept)", Target: []string{"DeviceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-manufacturer", Code: "manufacturer", Base: []string{"DeviceDefinition"}, Type: "reference", Expression: "DeviceDefinition.manufacturer", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-model-number", Code: "model-number", Base: []string{"DeviceDefinition"}, Type: "token", Expression: "DeviceDefinition.modelNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-part-number", Code: "part-number", Base: []string{"DeviceDefinition"}, Type: "string", Expression: "DeviceDefinition.partNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-specification", Code: "specification", Base: []string{"DeviceDefinition"}, Type: "token", Expression: "DeviceDefinition.conformsTo.specification", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-type", Code: "type", Base: []string{"DeviceDefinition"}, Type: "token", Expression: "DeviceDefinition.classification.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-specification-version", Code: "specification-version", Base: []string{"DeviceDefinition"}, Type: "composite", Expression: "DeviceDefinition.conformsTo", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-specification", Expression: "specification"}, {Definition: "http://hl7.org/fhir/SearchParameter/CanonicalResource-version", Expression: "version"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-version-type", Code: "version-type", Base: []string{"DeviceDefinition"}, Type: "composite", Expression: "DeviceDefinition.deviceVersion", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-type", Expression: "type"}, {Definition: "http://hl7.org/fhir/SearchParameter/DeviceDefinition-value", Expression: "value"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceMetric-category", Code: "category", Base: []string{"DeviceMetric"}, Type: "token", Expression: "DeviceMetric.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceMetric-device", Code: "device", Base: []string{"DeviceMetric"}, Type: "reference", Expression: "DeviceMetric.device", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceMetric-identifier", Code: "identifier", Base: []string{"DeviceMetric"}, Type: "token", Expression: "DeviceMetric.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceMetric-status", Code: "status", Base: []string{"DeviceMetric"}, Type: "token", Expression: "DeviceMetric.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceMetric-type", Code: "type", Base: []string{"DeviceMetric"}, Type: "token", Expression: "DeviceMetric.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-authored-on", Code: "authored-on", Base: []string{"DeviceRequest"}, Type: "date", Expression: "DeviceRequest.authoredOn", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-based-on", Code: "based-on", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.basedOn", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-device", Code: "device", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.product.ofType(Reference)", Target: []string{"Device", "DeviceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-event-date", Code: "event-date", Base: []string{"DeviceRequest"}, Type: "date", Expression: "(DeviceRequest.occurrence.ofType(dateTime)) | (DeviceRequest.occurrence.ofType(Period))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-group-identifier", Code: "group-identifier", Base: []string{"DeviceRequest"}, Type: "token", Expression: "DeviceRequest.groupIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-insurance", Code: "insurance", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.insurance", Target: []string{"ClaimResponse", "Coverage"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-intent", Code: "intent", Base: []string{"DeviceRequest"}, Type: "token", Expression: "DeviceRequest.intent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-location-code", Code: "location-code", Base: []string{"DeviceRequest"}, Type: "token", Expression: "DeviceRequest.location.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-performer", Code: "performer", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.performer.reference", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-performer-code", Code: "performer-code", Base: []string{"DeviceRequest"}, Type: "token", Expression: "DeviceRequest.performer.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-prior-request", Code: "prior-request", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.replaces", Target: []string{"DeviceRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-product", Code: "product", Base: []string{"DeviceRequest"}, Type: "token", Expression: "DeviceRequest.product.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-requester", Code: "requester", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.requester", Target: []string{"Device", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-status", Code: "status", Base: []string{"DeviceRequest"}, Type: "token", Expression: "DeviceRequest.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DeviceRequest-subject", Code: "subject", Base: []string{"DeviceRequest"}, Type: "reference", Expression: "DeviceRequest.subject", Target: []string{"Device", "Group", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-based-on", Code: "based-on", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.basedOn", Target: []string{"ServiceRequest", "CarePlan", "MedicationRequest", "NutritionOrder"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-category", Code: "category", Base: []string{"DiagnosticReport"}, Type: "token", Expression: "DiagnosticReport.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-conclusioncode-code", Code: "conclusioncode-code", Base: []string{"DiagnosticReport"}, Type: "token", Expression: "DiagnosticReport.conclusionCode.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-conclusioncode-reference", Code: "conclusioncode-reference", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.conclusionCode.reference", Target: []string{"Observation", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-issued", Code: "issued", Base: []string{"DiagnosticReport"}, Type: "date", Expression: "DiagnosticReport.issued", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-media", Code: "media", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.media.link", Target: []string{"DocumentReference"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-performer", Code: "performer", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.performer", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-procedure", Code: "procedure", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.procedure", Target: []string{"Procedure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-result", Code: "result", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.result", Target: []string{"Observation"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-results-interpreter", Code: "results-interpreter", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.resultsInterpreter", Target: []string{"Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-specimen", Code: "specimen", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.specimen", Target: []string{"Specimen"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-status", Code: "status", Base: []string{"DiagnosticReport"}, Type: "token", Expression: "DiagnosticReport.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-study", Code: "study", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.study", Target: []string{"ImagingStudy"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DiagnosticReport-subject", Code: "subject", Base: []string{"DiagnosticReport"}, Type: "reference", Expression: "DiagnosticReport.subject", Target: []string{"Device", "Organization", "Group", "Practitioner", "BiologicallyDerivedProduct", "Substance", "Location", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-attester", Code: "attester", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.attester.party", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-author", Code: "author", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.author", Target: []string{"Organization", "Device", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-based-on", Code: "based-on", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.basedOn", Target: []string{"Claim", "RequestOrchestration", "DeviceRequest", "ServiceRequest", "EnrollmentRequest", "CarePlan", "Contract", "MedicationRequest", "CoverageEligibilityRequest", "VisionPrescription", "Appointment", "CommunicationRequest", "AppointmentResponse", "NutritionOrder"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-body-structure", Code: "body-structure", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.bodyStructure.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-body-structure-reference", Code: "body-structure-reference", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.bodyStructure.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-category", Code: "category", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-contenttype", Code: "contenttype", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.content.attachment.contentType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-context", Code: "context", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.context", Target: []string{"Appointment", "Encounter", "EpisodeOfCare"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-creation", Code: "creation", Base: []string{"DocumentReference"}, Type: "date", Expression: "DocumentReference.content.attachment.creation", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-custodian", Code: "custodian", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.custodian", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-description", Code: "description", Base: []string{"DocumentReference"}, Type: "string", Expression: "DocumentReference.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-doc-status", Code: "doc-status", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.docStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-event-code", Code: "event-code", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.event.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-event-reference", Code: "event-reference", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.event.reference", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-facility", Code: "facility", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.facilityType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-format-canonical", Code: "format-canonical", Base: []string{"DocumentReference"}, Type: "uri", Expression: "(DocumentReference.content.profile.value.ofType(canonical))", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-format-code", Code: "format-code", Base: []string{"DocumentReference"}, Type: "token", Expression: "(DocumentReference.content.profile.value.ofType(Coding))", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-format-uri", Code: "format-uri", Base: []string{"DocumentReference"}, Type: "uri", Expression: "(DocumentReference.content.profile.value.ofType(uri))", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-language", Code: "language", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.content.attachment.language", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-location", Code: "location", Base: []string{"DocumentReference"}, Type: "uri", Expression: "DocumentReference.content.attachment.url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-modality", Code: "modality", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.modality", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-period", Code: "period", Base: []string{"DocumentReference"}, Type: "date", Expression: "DocumentReference.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-related", Code: "related", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.related", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-relatesto", Code: "relatesto", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.relatesTo.target", Target: []string{"DocumentReference"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-relation", Code: "relation", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.relatesTo.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-security-label", Code: "security-label", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.securityLabel", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-setting", Code: "setting", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.practiceSetting", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-status", Code: "status", Base: []string{"DocumentReference"}, Type: "token", Expression: "DocumentReference.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-subject", Code: "subject", Base: []string{"DocumentReference"}, Type: "reference", Expression: "DocumentReference.subject", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-version", Code: "version", Base: []string{"DocumentReference"}, Type: "string", Expression: "DocumentReference.version", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/DocumentReference-relationship", Code: "relationship", Base: []string{"DocumentReference"}, Type: "composite", Expression: "DocumentReference.relatesTo", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/DocumentReference-relatesto", Expression: "target"}, {Definition: "http://hl7.org/fhir/SearchParameter/DocumentReference-relation", Expression: "code"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-account", Code: "account", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.account", Target: []string{"Account"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-appointment", Code: "appointment", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.appointment", Target: []string{"Appointment"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-based-on", Code: "based-on", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.basedOn", Target: []string{"RequestOrchestration", "DeviceRequest", "ServiceRequest", "CarePlan", "MedicationRequest", "NutritionOrder", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-business-status", Code: "business-status", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.businessStatus.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-careteam", Code: "careteam", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.careTeam", Target: []string{"CareTeam"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-class", Code: "class", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.class", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-date-start", Code: "date-start", Base: []string{"Encounter"}, Type: "date", Expression: "Encounter.actualPeriod.start", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-diagnosis-code", Code: "diagnosis-code", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.diagnosis.condition.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-diagnosis-reference", Code: "diagnosis-reference", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.diagnosis.condition.reference", Target: []string{"Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-end-date", Code: "end-date", Base: []string{"Encounter"}, Type: "date", Expression: "Encounter.actualPeriod.end", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-episode-of-care", Code: "episode-of-care", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.episodeOfCare", Target: []string{"EpisodeOfCare"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-length", Code: "length", Base: []string{"Encounter"}, Type: "quantity", Expression: "Encounter.length", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-location", Code: "location", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.location.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-location-period", Code: "location-period", Base: []string{"Encounter"}, Type: "date", Expression: "Encounter.location.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-part-of", Code: "part-of", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.partOf", Target: []string{"Encounter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-participant", Code: "participant", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.participant.actor", Target: []string{"HealthcareService", "Device", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-participant-type", Code: "participant-type", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.participant.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-practitioner", Code: "practitioner", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.participant.actor.where(resolve() is Practitioner)", Target: []string{"Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-reason-code", Code: "reason-code", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.reason.value.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-reason-reference", Code: "reason-reference", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.reason.value.reference", Target: []string{"Procedure", "Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-service-provider", Code: "service-provider", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.serviceProvider", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-special-arrangement", Code: "special-arrangement", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.specialArrangement", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-status", Code: "status", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-subject", Code: "subject", Base: []string{"Encounter"}, Type: "reference", Expression: "Encounter.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-subject-status", Code: "subject-status", Base: []string{"Encounter"}, Type: "token", Expression: "Encounter.subjectStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Encounter-location-value-period", Code: "location-value-period", Base: []string{"Encounter"}, Type: "composite", Expression: "Encounter.location", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Encounter-location", Expression: "location"}, {Definition: "http://hl7.org/fhir/SearchParameter/Encounter-location-period", Expression: "period"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-connection-type", Code: "connection-type", Base: []string{"Endpoint"}, Type: "token", Expression: "Endpoint.connectionType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-identifier", Code: "identifier", Base: []string{"Endpoint"}, Type: "token", Expression: "Endpoint.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-name", Code: "name", Base: []string{"Endpoint"}, Type: "string", Expression: "Endpoint.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-organization", Code: "organization", Base: []string{"Endpoint"}, Type: "reference", Expression: "Endpoint.managingOrganization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-payload-profile", Code: "payload-profile", Base: []string{"Endpoint"}, Type: "reference", Expression: "Endpoint.payload.profileCanonical | Endpoint.payload.profileUri", Target: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "Composition", "ConceptMap", "Contract", "DeviceDefinition", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "Group", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "ObservationDefinition", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "ResearchStudy", "SearchParameter", "SpecimenDefinition", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-payload-type", Code: "payload-type", Base: []string{"Endpoint"}, Type: "token", Expression: "Endpoint.payload.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Endpoint-status", Code: "status", Base: []string{"Endpoint"}, Type: "token", Expression: "Endpoint.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentRequest-group", Code: "group", Base: []string{"EnrollmentRequest"}, Type: "reference", Expression: "EnrollmentRequest.candidate.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentRequest-status", Code: "status", Base: []string{"EnrollmentRequest"}, Type: "token", Expression: "EnrollmentRequest.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentRequest-subject", Code: "subject", Base: []string{"EnrollmentRequest"}, Type: "reference", Expression: "EnrollmentRequest.candidate", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentResponse-group", Code: "group", Base: []string{"EnrollmentResponse"}, Type: "reference", Expression: "EnrollmentResponse.candidate.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentResponse-identifier", Code: "identifier", Base: []string{"EnrollmentResponse"}, Type: "token", Expression: "EnrollmentResponse.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentResponse-patient", Code: "patient", Base: []string{"EnrollmentResponse"}, Type: "reference", Expression: "EnrollmentResponse.candidate.where(resolve() is Patient)", Target: []string{"Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentResponse-request", Code: "request", Base: []string{"EnrollmentResponse"}, Type: "reference", Expression: "EnrollmentResponse.request", Target: []string{"EnrollmentRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EnrollmentResponse-status", Code: "status", Base: []string{"EnrollmentResponse"}, Type: "token", Expression: "EnrollmentResponse.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-care-manager", Code: "care-manager", Base: []string{"EpisodeOfCare"}, Type: "reference", Expression: "EpisodeOfCare.careManager.where(resolve() is Practitioner)", Target: []string{"Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-diagnosis-code", Code: "diagnosis-code", Base: []string{"EpisodeOfCare"}, Type: "token", Expression: "EpisodeOfCare.diagnosis.condition.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-diagnosis-reference", Code: "diagnosis-reference", Base: []string{"EpisodeOfCare"}, Type: "reference", Expression: "EpisodeOfCare.diagnosis.condition.reference", Target: []string{"Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-incoming-referral", Code: "incoming-referral", Base: []string{"EpisodeOfCare"}, Type: "reference", Expression: "EpisodeOfCare.referralRequest", Target: []string{"ServiceRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-organization", Code: "organization", Base: []string{"EpisodeOfCare"}, Type: "reference", Expression: "EpisodeOfCare.managingOrganization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-reason-code", Code: "reason-code", Base: []string{"EpisodeOfCare"}, Type: "token", Expression: "EpisodeOfCare.reason.value.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-reason-reference", Code: "reason-reference", Base: []string{"EpisodeOfCare"}, Type: "reference", Expression: "EpisodeOfCare.reason.value.reference", Target: []string{"HealthcareService", "Procedure", "Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-status", Code: "status", Base: []string{"EpisodeOfCare"}, Type: "token", Expression: "EpisodeOfCare.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EpisodeOfCare-subject", Code: "subject", Base: []string{"EpisodeOfCare"}, Type: "reference", Expression: "EpisodeOfCare.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EventDefinition-experimental", Code: "experimental", Base: []string{"EventDefinition"}, Type: "token", Expression: "EventDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Evidence-experimental", Code: "experimental", Base: []string{"Evidence"}, Type: "token", Expression: "Evidence.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/EvidenceVariable-experimental", Code: "experimental", Base: []string{"EvidenceVariable"}, Type: "token", Expression: "EvidenceVariable.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExampleScenario-experimental", Code: "experimental", Base: []string{"ExampleScenario"}, Type: "token", Expression: "ExampleScenario.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-care-team", Code: "care-team", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.careTeam.provider", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-claim", Code: "claim", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.claim", Target: []string{"Claim"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-coverage", Code: "coverage", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.insurance.coverage", Target: []string{"Coverage"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-created", Code: "created", Base: []string{"ExplanationOfBenefit"}, Type: "date", Expression: "ExplanationOfBenefit.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-detail-udi", Code: "detail-udi", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.item.detail.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-disposition", Code: "disposition", Base: []string{"ExplanationOfBenefit"}, Type: "string", Expression: "ExplanationOfBenefit.disposition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-enterer", Code: "enterer", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.enterer", Target: []string{"RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-facility", Code: "facility", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.facility", Target: []string{"Organization", "Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-group", Code: "group", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.subject.where(resolve() is Group) | ExplanationOfBenefit.item.subject.where(resolve() is Group) | ExplanationOfBenefit.addItem.subject.where(resolve() is Group)", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-item-udi", Code: "item-udi", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.item.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-payee", Code: "payee", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.payee.party", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-procedure-udi", Code: "procedure-udi", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.procedure.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-provider", Code: "provider", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.provider", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-status", Code: "status", Base: []string{"ExplanationOfBenefit"}, Type: "token", Expression: "ExplanationOfBenefit.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-subdetail-udi", Code: "subdetail-udi", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.item.detail.subDetail.udi", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ExplanationOfBenefit-subject", Code: "subject", Base: []string{"ExplanationOfBenefit"}, Type: "reference", Expression: "ExplanationOfBenefit.subject | ExplanationOfBenefit.item.subject | ExplanationOfBenefit.addItem.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/FamilyMemberHistory-relationship", Code: "relationship", Base: []string{"FamilyMemberHistory"}, Type: "token", Expression: "FamilyMemberHistory.relationship", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/FamilyMemberHistory-sex", Code: "sex", Base: []string{"FamilyMemberHistory"}, Type: "token", Expression: "FamilyMemberHistory.sex", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/FamilyMemberHistory-status", Code: "status", Base: []string{"FamilyMemberHistory"}, Type: "token", Expression: "FamilyMemberHistory.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Flag-author", Code: "author", Base: []string{"Flag"}, Type: "reference", Expression: "Flag.author", Target: []string{"Device", "Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Flag-category", Code: "category", Base: []string{"Flag"}, Type: "token", Expression: "Flag.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Flag-status", Code: "status", Base: []string{"Flag"}, Type: "token", Expression: "Flag.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Flag-subject", Code: "subject", Base: []string{"Flag"}, Type: "reference", Expression: "Flag.subject", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Procedure", "PlanDefinition", "Group", "Practitioner", "Location", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-achievement-status", Code: "achievement-status", Base: []string{"Goal"}, Type: "token", Expression: "Goal.achievementStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-addresses", Code: "addresses", Base: []string{"Goal"}, Type: "reference", Expression: "Goal.addresses", Target: []string{"NutritionIntake", "RiskAssessment", "Procedure", "Observation", "MedicationStatement", "ServiceRequest", "MedicationRequest", "Condition", "NutritionOrder"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-category", Code: "category", Base: []string{"Goal"}, Type: "token", Expression: "Goal.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-description", Code: "description", Base: []string{"Goal"}, Type: "token", Expression: "Goal.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-lifecycle-status", Code: "lifecycle-status", Base: []string{"Goal"}, Type: "token", Expression: "Goal.lifecycleStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-start-date", Code: "start-date", Base: []string{"Goal"}, Type: "date", Expression: "(Goal.start.ofType(date))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-subject", Code: "subject", Base: []string{"Goal"}, Type: "reference", Expression: "Goal.subject", Target: []string{"Organization", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-target-date", Code: "target-date", Base: []string{"Goal"}, Type: "date", Expression: "(Goal.target.due.ofType(date))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Goal-target-measure", Code: "target-measure", Base: []string{"Goal"}, Type: "token", Expression: "Goal.target.measure", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-characteristic", Code: "characteristic", Base: []string{"Group"}, Type: "token", Expression: "Group.characteristic.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-code", Code: "code", Base: []string{"Group"}, Type: "token", Expression: "Group.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-exclude", Code: "exclude", Base: []string{"Group"}, Type: "token", Expression: "Group.characteristic.exclude", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-experimental", Code: "experimental", Base: []string{"Group"}, Type: "token", Expression: "Group.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-managing-entity", Code: "managing-entity", Base: []string{"Group"}, Type: "reference", Expression: "Group.managingEntity", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-member", Code: "member", Base: []string{"Group"}, Type: "reference", Expression: "Group.member.entity", Target: []string{"CareTeam", "Device", "Organization", "RelatedPerson", "Group", "Practitioner", "BiologicallyDerivedProduct", "Specimen", "Substance", "SubstanceDefinition", "Location", "MedicinalProductDefinition", "HealthcareService", "PractitionerRole", "NutritionProduct", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-membership", Code: "membership", Base: []string{"Group"}, Type: "token", Expression: "Group.membership", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-quantity", Code: "quantity", Base: []string{"Group"}, Type: "quantity", Expression: "(Group.characteristic.value.ofType(Quantity)) | (Group.characteristic.value.ofType(Range))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-type", Code: "type", Base: []string{"Group"}, Type: "token", Expression: "Group.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-value", Code: "value", Base: []string{"Group"}, Type: "token", Expression: "(Group.characteristic.value.ofType(CodeableConcept)) | (Group.characteristic.value.ofType(boolean))", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-characteristic-quantity", Code: "characteristic-quantity", Base: []string{"Group"}, Type: "composite", Expression: "Group.characteristic", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Group-characteristic", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Group-quantity", Expression: "(value.ofType(Quantity)) | (value.ofType(Range))"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-characteristic-reference", Code: "characteristic-reference", Base: []string{"Group"}, Type: "composite", Expression: "Group.characteristic", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Group-characteristic", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Group-reference", Expression: "value.ofType(Reference))"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Group-characteristic-value", Code: "characteristic-value", Base: []string{"Group"}, Type: "composite", Expression: "Group.characteristic", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Group-characteristic", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Group-value", Expression: "(value.ofType(CodeableConcept)) | (value.ofType(boolean))"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/GuidanceResponse-request", Code: "request", Base: []string{"GuidanceResponse"}, Type: "token", Expression: "GuidanceResponse.requestIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/GuidanceResponse-status", Code: "status", Base: []string{"GuidanceResponse"}, Type: "token", Expression: "GuidanceResponse.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/GuidanceResponse-subject", Code: "subject", Base: []string{"GuidanceResponse"}, Type: "reference", Expression: "GuidanceResponse.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-active", Code: "active", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-characteristic", Code: "characteristic", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.characteristic", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-communication", Code: "communication", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.communication", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-coverage-area", Code: "coverage-area", Base: []string{"HealthcareService"}, Type: "reference", Expression: "HealthcareService.coverageArea", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-eligibility", Code: "eligibility", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.eligibility.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-endpoint", Code: "endpoint", Base: []string{"HealthcareService"}, Type: "reference", Expression: "HealthcareService.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-identifier", Code: "identifier", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-location", Code: "location", Base: []string{"HealthcareService"}, Type: "reference", Expression: "HealthcareService.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-name", Code: "name", Base: []string{"HealthcareService"}, Type: "string", Expression: "HealthcareService.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-offered-in", Code: "offered-in", Base: []string{"HealthcareService"}, Type: "reference", Expression: "HealthcareService.offeredIn", Target: []string{"HealthcareService"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-organization", Code: "organization", Base: []string{"HealthcareService"}, Type: "reference", Expression: "HealthcareService.providedBy", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-program", Code: "program", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.program", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-service-category", Code: "service-category", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-service-type", Code: "service-type", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/HealthcareService-specialty", Code: "specialty", Base: []string{"HealthcareService"}, Type: "token", Expression: "HealthcareService.specialty", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-based-on", Code: "based-on", Base: []string{"ImagingSelection"}, Type: "reference", Expression: "ImagingSelection.basedOn", Target: []string{"Appointment", "ServiceRequest", "CarePlan", "Task"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-body-site", Code: "body-site", Base: []string{"ImagingSelection"}, Type: "token", Expression: "ImagingSelection.bodySite.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-body-structure", Code: "body-structure", Base: []string{"ImagingSelection"}, Type: "reference", Expression: "ImagingSelection.bodySite.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-category", Code: "category", Base: []string{"ImagingSelection"}, Type: "token", Expression: "ImagingSelection.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-derived-from", Code: "derived-from", Base: []string{"ImagingSelection"}, Type: "reference", Expression: "ImagingSelection.derivedFrom", Target: []string{"ImagingStudy", "DocumentReference"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-issued", Code: "issued", Base: []string{"ImagingSelection"}, Type: "date", Expression: "ImagingSelection.issued", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-modality", Code: "modality", Base: []string{"ImagingSelection"}, Type: "token", Expression: "ImagingSelection.modality", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-status", Code: "status", Base: []string{"ImagingSelection"}, Type: "token", Expression: "ImagingSelection.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-study-uid", Code: "study-uid", Base: []string{"ImagingSelection"}, Type: "token", Expression: "ImagingSelection.studyUid", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingSelection-subject", Code: "subject", Base: []string{"ImagingSelection"}, Type: "reference", Expression: "ImagingSelection.subject", Target: []string{"Device", "Procedure", "Group", "Practitioner", "Specimen", "Substance", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-based-on", Code: "based-on", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.basedOn", Target: []string{"Appointment", "ServiceRequest", "CarePlan", "Task"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-body-site", Code: "body-site", Base: []string{"ImagingStudy"}, Type: "token", Expression: "ImagingStudy.series.bodySite.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-body-structure", Code: "body-structure", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.series.bodySite.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-dicom-class", Code: "dicom-class", Base: []string{"ImagingStudy"}, Type: "uri", Expression: "ImagingStudy.series.instance.sopClass", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-endpoint", Code: "endpoint", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.endpoint | ImagingStudy.series.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-instance", Code: "instance", Base: []string{"ImagingStudy"}, Type: "token", Expression: "ImagingStudy.series.instance.uid", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-modality", Code: "modality", Base: []string{"ImagingStudy"}, Type: "token", Expression: "ImagingStudy.series.modality", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-performer", Code: "performer", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.series.performer.actor", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-procedure", Code: "procedure", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.procedure", Target: []string{"Procedure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-reason-concept", Code: "reason-concept", Base: []string{"ImagingStudy"}, Type: "token", Expression: "ImagingStudy.reason.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-reason-reference", Code: "reason-reference", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.reason.reference", Target: []string{"DocumentReference", "Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-referrer", Code: "referrer", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.referrer", Target: []string{"PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-series", Code: "series", Base: []string{"ImagingStudy"}, Type: "token", Expression: "ImagingStudy.series.uid", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-started", Code: "started", Base: []string{"ImagingStudy"}, Type: "date", Expression: "ImagingStudy.started", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-status", Code: "status", Base: []string{"ImagingStudy"}, Type: "token", Expression: "ImagingStudy.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImagingStudy-subject", Code: "subject", Base: []string{"ImagingStudy"}, Type: "reference", Expression: "ImagingStudy.subject", Target: []string{"Device", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-encounter", Code: "encounter", Base: []string{"Immunization"}, Type: "reference", Expression: "Immunization.encounter", Target: []string{"Encounter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-location", Code: "location", Base: []string{"Immunization"}, Type: "reference", Expression: "Immunization.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-lot-number", Code: "lot-number", Base: []string{"Immunization"}, Type: "string", Expression: "Immunization.lotNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-manufacturer", Code: "manufacturer", Base: []string{"Immunization"}, Type: "reference", Expression: "Immunization.manufacturer.reference", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-performer", Code: "performer", Base: []string{"Immunization"}, Type: "reference", Expression: "Immunization.performer.actor", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-reaction", Code: "reaction", Base: []string{"Immunization"}, Type: "reference", Expression: "Immunization.reaction.manifestation.reference", Target: []string{"Observation"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-reaction-date", Code: "reaction-date", Base: []string{"Immunization"}, Type: "date", Expression: "Immunization.reaction.date", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-reason-code", Code: "reason-code", Base: []string{"Immunization"}, Type: "token", Expression: "Immunization.reason.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-reason-reference", Code: "reason-reference", Base: []string{"Immunization"}, Type: "reference", Expression: "Immunization.reason.reference", Target: []string{"Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-series", Code: "series", Base: []string{"Immunization"}, Type: "string", Expression: "Immunization.protocolApplied.series", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-status", Code: "status", Base: []string{"Immunization"}, Type: "token", Expression: "Immunization.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-status-reason", Code: "status-reason", Base: []string{"Immunization"}, Type: "token", Expression: "Immunization.statusReason", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-target-disease", Code: "target-disease", Base: []string{"Immunization"}, Type: "token", Expression: "Immunization.protocolApplied.targetDisease", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Immunization-vaccine-code", Code: "vaccine-code", Base: []string{"Immunization"}, Type: "token", Expression: "Immunization.vaccineCode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImplementationGuide-depends-on", Code: "depends-on", Base: []string{"ImplementationGuide"}, Type: "reference", Expression: "ImplementationGuide.dependsOn.uri", Target: []string{"ImplementationGuide"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImplementationGuide-experimental", Code: "experimental", Base: []string{"ImplementationGuide"}, Type: "token", Expression: "ImplementationGuide.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImplementationGuide-global", Code: "global", Base: []string{"ImplementationGuide"}, Type: "reference", Expression: "ImplementationGuide.global.profile", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ImplementationGuide-resource", Code: "resource", Base: []string{"ImplementationGuide"}, Type: "reference", Expression: "ImplementationGuide.definition.resource.reference", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-for", Code: "for", Base: []string{"Ingredient"}, Type: "reference", Expression: "Ingredient.for", Target: []string{"MedicinalProductDefinition", "AdministrableProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-function", Code: "function", Base: []string{"Ingredient"}, Type: "token", Expression: "Ingredient.function", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-identifier", Code: "identifier", Base: []string{"Ingredient"}, Type: "token", Expression: "Ingredient.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-manufacturer", Code: "manufacturer", Base: []string{"Ingredient"}, Type: "reference", Expression: "Ingredient.manufacturer.manufacturer", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-role", Code: "role", Base: []string{"Ingredient"}, Type: "token", Expression: "Ingredient.role", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-status", Code: "status", Base: []string{"Ingredient"}, Type: "token", Expression: "Ingredient.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-concentration-denominator", Code: "strength-concentration-denominator", Base: []string{"Ingredient"}, Type: "quantity", Expression: "Ingredient.substance.strength.concentration.ofType(Ratio).denominator", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-concentration-numerator", Code: "strength-concentration-numerator", Base: []string{"Ingredient"}, Type: "quantity", Expression: "Ingredient.substance.strength.concentration.ofType(Ratio).numerator", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-concentration-quantity", Code: "strength-concentration-quantity", Base: []string{"Ingredient"}, Type: "quantity", Expression: "Ingredient.substance.strength.concentration.ofType(Quantity)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-presentation-denominator", Code: "strength-presentation-denominator", Base: []string{"Ingredient"}, Type: "quantity", Expression: "Ingredient.substance.strength.presentation.ofType(Ratio).denominator", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-presentation-numerator", Code: "strength-presentation-numerator", Base: []string{"Ingredient"}, Type: "quantity", Expression: "Ingredient.substance.strength.presentation.ofType(Ratio).numerator", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-presentation-quantity", Code: "strength-presentation-quantity", Base: []string{"Ingredient"}, Type: "quantity", Expression: "Ingredient.substance.strength.presentation.ofType(Quantity)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-substance", Code: "substance", Base: []string{"Ingredient"}, Type: "reference", Expression: "Ingredient.substance.code.reference", Target: []string{"SubstanceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-substance-code", Code: "substance-code", Base: []string{"Ingredient"}, Type: "token", Expression: "Ingredient.substance.code.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-substance-definition", Code: "substance-definition", Base: []string{"Ingredient"}, Type: "reference", Expression: "Ingredient.substance.code.reference", Target: []string{"SubstanceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-concentration-ratio", Code: "strength-concentration-ratio", Base: []string{"Ingredient"}, Type: "composite", Expression: "Ingredient.substance.strength.concentration.ofType(Ratio)", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Ingredient-numerator", Expression: "strength-concentration-numerator"}, {Definition: "http://hl7.org/fhir/SearchParameter/Ingredient-denominator", Expression: "strength-concentration-denominator"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Ingredient-strength-presentation-ratio", Code: "strength-presentation-ratio", Base: []string{"Ingredient"}, Type: "composite", Expression: "Ingredient.substance.strength.presentation.ofType(Ratio)", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Ingredient-numerator", Expression: "strength-presentation-numerator"}, {Definition: "http://hl7.org/fhir/SearchParameter/Ingredient-denominator", Expression: "strength-presentation-denominator"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsurancePlan-coverage-area", Code: "coverage-area", Base: []string{"InsurancePlan"}, Type: "reference", Expression: "InsurancePlan.coverageArea", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsurancePlan-identifier", Code: "identifier", Base: []string{"InsurancePlan"}, Type: "token", Expression: "InsurancePlan.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsurancePlan-network", Code: "network", Base: []string{"InsurancePlan"}, Type: "reference", Expression: "InsurancePlan.network", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsurancePlan-product", Code: "product", Base: []string{"InsurancePlan"}, Type: "reference", Expression: "InsurancePlan.product", Target: []string{"InsuranceProduct"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsurancePlan-type", Code: "type", Base: []string{"InsurancePlan"}, Type: "token", Expression: "InsurancePlan.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-administered-by", Code: "administered-by", Base: []string{"InsuranceProduct"}, Type: "reference", Expression: "InsuranceProduct.administeredBy", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-contact-address", Code: "contact-address", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.contact.address", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-contact-address-city", Code: "contact-address-city", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.contact.address.city", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-contact-address-country", Code: "contact-address-country", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.contact.address.country", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-contact-address-postalcode", Code: "contact-address-postalcode", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.contact.address.postalCode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-contact-address-state", Code: "contact-address-state", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.contact.address.state", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-contact-address-use", Code: "contact-address-use", Base: []string{"InsuranceProduct"}, Type: "token", Expression: "InsuranceProduct.contact.address.use", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-endpoint", Code: "endpoint", Base: []string{"InsuranceProduct"}, Type: "reference", Expression: "InsuranceProduct.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-identifier", Code: "identifier", Base: []string{"InsuranceProduct"}, Type: "token", Expression: "InsuranceProduct.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-name", Code: "name", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.name | InsuranceProduct.alias", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-owned-by", Code: "owned-by", Base: []string{"InsuranceProduct"}, Type: "reference", Expression: "InsuranceProduct.ownedBy", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-phonetic", Code: "phonetic", Base: []string{"InsuranceProduct"}, Type: "string", Expression: "InsuranceProduct.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-status", Code: "status", Base: []string{"InsuranceProduct"}, Type: "token", Expression: "InsuranceProduct.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/InsuranceProduct-type", Code: "type", Base: []string{"InsuranceProduct"}, Type: "token", Expression: "InsuranceProduct.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-account", Code: "account", Base: []string{"Invoice"}, Type: "reference", Expression: "Invoice.account", Target: []string{"Account"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-issuer", Code: "issuer", Base: []string{"Invoice"}, Type: "reference", Expression: "Invoice.issuer", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-participant", Code: "participant", Base: []string{"Invoice"}, Type: "reference", Expression: "Invoice.participant.actor", Target: []string{"Organization", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-participant-role", Code: "participant-role", Base: []string{"Invoice"}, Type: "token", Expression: "Invoice.participant.role", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-recipient", Code: "recipient", Base: []string{"Invoice"}, Type: "reference", Expression: "Invoice.recipient", Target: []string{"Organization", "RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-status", Code: "status", Base: []string{"Invoice"}, Type: "token", Expression: "Invoice.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-subject", Code: "subject", Base: []string{"Invoice"}, Type: "reference", Expression: "Invoice.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-totalgross", Code: "totalgross", Base: []string{"Invoice"}, Type: "quantity", Expression: "Invoice.totalGross", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Invoice-totalnet", Code: "totalnet", Base: []string{"Invoice"}, Type: "quantity", Expression: "Invoice.totalNet", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Library-content-type", Code: "content-type", Base: []string{"Library"}, Type: "token", Expression: "Library.content.contentType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Library-experimental", Code: "experimental", Base: []string{"Library"}, Type: "token", Expression: "Library.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Library-subject-canonical", Code: "subject-canonical", Base: []string{"Library"}, Type: "reference", Expression: "Library.subject as canonical", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Library-subject-code", Code: "subject-code", Base: []string{"Library"}, Type: "token", Expression: "Library.subject as CodeableConcept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Library-subject-reference", Code: "subject-reference", Base: []string{"Library"}, Type: "reference", Expression: "Library.subject as Reference", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Library-type", Code: "type", Base: []string{"Library"}, Type: "token", Expression: "Library.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-empty-reason", Code: "empty-reason", Base: []string{"List"}, Type: "token", Expression: "List.emptyReason", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-item", Code: "item", Base: []string{"List"}, Type: "reference", Expression: "List.entry.item", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-notes", Code: "notes", Base: []string{"List"}, Type: "string", Expression: "List.note.text", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-source", Code: "source", Base: []string{"List"}, Type: "reference", Expression: "List.source", Target: []string{"Device", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-status", Code: "status", Base: []string{"List"}, Type: "token", Expression: "List.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-subject", Code: "subject", Base: []string{"List"}, Type: "reference", Expression: "List.subject", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/List-title", Code: "title", Base: []string{"List"}, Type: "string", Expression: "List.title", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-address", Code: "address", Base: []string{"Location"}, Type: "string", Expression: "Location.address", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-address-city", Code: "address-city", Base: []string{"Location"}, Type: "string", Expression: "Location.address.city", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-address-country", Code: "address-country", Base: []string{"Location"}, Type: "string", Expression: "Location.address.country", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-address-postalcode", Code: "address-postalcode", Base: []string{"Location"}, Type: "string", Expression: "Location.address.postalCode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-address-state", Code: "address-state", Base: []string{"Location"}, Type: "string", Expression: "Location.address.state", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-address-use", Code: "address-use", Base: []string{"Location"}, Type: "token", Expression: "Location.address.use", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-characteristic", Code: "characteristic", Base: []string{"Location"}, Type: "token", Expression: "Location.form", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-code", Code: "code", Base: []string{"Location"}, Type: "token", Expression: "Location.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-contains", Code: "contains", Base: []string{"Location"}, Type: "special", Expression: "Location.extension('http://hl7.org/fhir/StructureDefinition/location-boundary-geojson').value", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-endpoint", Code: "endpoint", Base: []string{"Location"}, Type: "reference", Expression: "Location.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-identifier", Code: "identifier", Base: []string{"Location"}, Type: "token", Expression: "Location.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-mode", Code: "mode", Base: []string{"Location"}, Type: "token", Expression: "Location.mode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-name", Code: "name", Base: []string{"Location"}, Type: "string", Expression: "Location.name | Location.alias", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-near", Code: "near", Base: []string{"Location"}, Type: "special", Expression: "Location.position", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-operational-status", Code: "operational-status", Base: []string{"Location"}, Type: "token", Expression: "Location.operationalStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-organization", Code: "organization", Base: []string{"Location"}, Type: "reference", Expression: "Location.managingOrganization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-partof", Code: "partof", Base: []string{"Location"}, Type: "reference", Expression: "Location.partOf", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-status", Code: "status", Base: []string{"Location"}, Type: "token", Expression: "Location.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Location-type", Code: "type", Base: []string{"Location"}, Type: "token", Expression: "Location.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ManufacturedItemDefinition-dose-form", Code: "dose-form", Base: []string{"ManufacturedItemDefinition"}, Type: "token", Expression: "ManufacturedItemDefinition.manufacturedDoseForm", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ManufacturedItemDefinition-identifier", Code: "identifier", Base: []string{"ManufacturedItemDefinition"}, Type: "token", Expression: "ManufacturedItemDefinition.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ManufacturedItemDefinition-ingredient", Code: "ingredient", Base: []string{"ManufacturedItemDefinition"}, Type: "token", Expression: "ManufacturedItemDefinition.ingredient", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ManufacturedItemDefinition-name", Code: "name", Base: []string{"ManufacturedItemDefinition"}, Type: "token", Expression: "ManufacturedItemDefinition.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ManufacturedItemDefinition-status", Code: "status", Base: []string{"ManufacturedItemDefinition"}, Type: "token", Expression: "ManufacturedItemDefinition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Measure-experimental", Code: "experimental", Base: []string{"Measure"}, Type: "token", Expression: "Measure.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Measure-subject-canonical", Code: "subject-canonical", Base: []string{"Measure"}, Type: "reference", Expression: "Measure.subject as canonical", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Measure-subject-code", Code: "subject-code", Base: []string{"Measure"}, Type: "token", Expression: "Measure.subject as CodeableConcept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Measure-subject-reference", Code: "subject-reference", Base: []string{"Measure"}, Type: "reference", Expression: "Measure.subject as Reference", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-evaluated-resource", Code: "evaluated-resource", Base: []string{"MeasureReport"}, Type: "reference", Expression: "MeasureReport.evaluatedResource", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-location", Code: "location", Base: []string{"MeasureReport"}, Type: "reference", Expression: "MeasureReport.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-measure", Code: "measure", Base: []string{"MeasureReport"}, Type: "reference", Expression: "MeasureReport.measure", Target: []string{"Measure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-period", Code: "period", Base: []string{"MeasureReport"}, Type: "date", Expression: "MeasureReport.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-reporter", Code: "reporter", Base: []string{"MeasureReport"}, Type: "reference", Expression: "MeasureReport.reporter", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-status", Code: "status", Base: []string{"MeasureReport"}, Type: "token", Expression: "MeasureReport.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MeasureReport-subject", Code: "subject", Base: []string{"MeasureReport"}, Type: "reference", Expression: "MeasureReport.subject", Target: []string{"HealthcareService", "CareTeam", "Device", "Organization", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-expiration-date", Code: "expiration-date", Base: []string{"Medication"}, Type: "date", Expression: "Medication.instance.expirationDate", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-form", Code: "form", Base: []string{"Medication"}, Type: "token", Expression: "Medication.doseForm", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-ingredient", Code: "ingredient", Base: []string{"Medication"}, Type: "reference", Expression: "Medication.ingredient.item.reference", Target: []string{"Substance", "SubstanceDefinition", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-ingredient-code", Code: "ingredient-code", Base: []string{"Medication"}, Type: "token", Expression: "Medication.ingredient.item.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-lot-number", Code: "lot-number", Base: []string{"Medication"}, Type: "token", Expression: "Medication.instance.lotNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-marketingauthorizationholder", Code: "marketingauthorizationholder", Base: []string{"Medication"}, Type: "reference", Expression: "Medication.marketingAuthorizationHolder", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-serial-number", Code: "serial-number", Base: []string{"Medication"}, Type: "token", Expression: "Medication.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Medication-status", Code: "status", Base: []string{"Medication"}, Type: "token", Expression: "Medication.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/medications-date", Code: "date", Base: []string{"MedicationAdministration"}, Type: "date", Expression: "MedicationAdministration.occurrence.ofType(dateTime) | MedicationAdministration.occurrence.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-device", Code: "device", Base: []string{"MedicationAdministration"}, Type: "reference", Expression: "MedicationAdministration.device.reference", Target: []string{"Device"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/medications-encounter", Code: "encounter", Base: []string{"MedicationAdministration", "MedicationRequest"}, Type: "reference", Expression: "MedicationAdministration.encounter | MedicationRequest.encounter", Target: []string{"Encounter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/medications-medication", Code: "medication", Base: []string{"MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement"}, Type: "reference", Expression: "MedicationAdministration.medication.reference | MedicationDispense.medication.reference | MedicationRequest.medication.reference | MedicationStatement.medication.reference", Target: []string{"Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-performer", Code: "performer", Base: []string{"MedicationAdministration"}, Type: "reference", Expression: "MedicationAdministration.performer.actor.reference", Target: []string{"Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-performer-device-code", Code: "performer-device-code", Base: []string{"MedicationAdministration"}, Type: "token", Expression: "MedicationAdministration.performer.actor.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-reason-given", Code: "reason-given", Base: []string{"MedicationAdministration"}, Type: "reference", Expression: "MedicationAdministration.reason.reference", Target: []string{"Procedure", "Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-reason-given-code", Code: "reason-given-code", Base: []string{"MedicationAdministration"}, Type: "token", Expression: "MedicationAdministration.reason.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-reason-not-given", Code: "reason-not-given", Base: []string{"MedicationAdministration"}, Type: "token", Expression: "MedicationAdministration.statusReason", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-request", Code: "request", Base: []string{"MedicationAdministration"}, Type: "reference", Expression: "MedicationAdministration.request", Target: []string{"MedicationRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/medications-status", Code: "status", Base: []string{"MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement"}, Type: "token", Expression: "MedicationAdministration.status | MedicationDispense.status | MedicationRequest.status | MedicationStatement.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationAdministration-subject", Code: "subject", Base: []string{"MedicationAdministration"}, Type: "reference", Expression: "MedicationAdministration.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-destination", Code: "destination", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.destination", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-location", Code: "location", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-performer", Code: "performer", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.performer.actor", Target: []string{"Organization", "Device", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/medications-prescription", Code: "prescription", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.authorizingPrescription", Target: []string{"MedicationRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-receiver", Code: "receiver", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.receiver", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-recorded", Code: "recorded", Base: []string{"MedicationDispense"}, Type: "date", Expression: "MedicationDispense.recorded", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-responsibleparty", Code: "responsibleparty", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.substitution.responsibleParty", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-subject", Code: "subject", Base: []string{"MedicationDispense"}, Type: "reference", Expression: "MedicationDispense.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-whenhandedover", Code: "whenhandedover", Base: []string{"MedicationDispense"}, Type: "date", Expression: "MedicationDispense.whenHandedOver", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationDispense-whenprepared", Code: "whenprepared", Base: []string{"MedicationDispense"}, Type: "date", Expression: "MedicationDispense.whenPrepared", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-authoredon", Code: "authoredon", Base: []string{"MedicationRequest"}, Type: "date", Expression: "MedicationRequest.authoredOn", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-category", Code: "category", Base: []string{"MedicationRequest"}, Type: "token", Expression: "MedicationRequest.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-group-identifier", Code: "group-identifier", Base: []string{"MedicationRequest"}, Type: "token", Expression: "MedicationRequest.groupIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-group-or-identifier", Code: "group-or-identifier", Base: []string{"MedicationRequest"}, Type: "token", Expression: "MedicationRequest.groupIdentifier | MedicationRequest.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-intended-dispenser", Code: "intended-dispenser", Base: []string{"MedicationRequest"}, Type: "reference", Expression: "MedicationRequest.dispenseRequest.dispenser", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-intended-performer", Code: "intended-performer", Base: []string{"MedicationRequest"}, Type: "reference", Expression: "MedicationRequest.performer", Target: []string{"HealthcareService", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "DeviceDefinition", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-intended-performertype", Code: "intended-performertype", Base: []string{"MedicationRequest"}, Type: "token", Expression: "MedicationRequest.performerType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-intent", Code: "intent", Base: []string{"MedicationRequest"}, Type: "token", Expression: "MedicationRequest.intent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-priority", Code: "priority", Base: []string{"MedicationRequest"}, Type: "token", Expression: "MedicationRequest.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-requester", Code: "requester", Base: []string{"MedicationRequest"}, Type: "reference", Expression: "MedicationRequest.requester", Target: []string{"Organization", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationRequest-subject", Code: "subject", Base: []string{"MedicationRequest"}, Type: "reference", Expression: "MedicationRequest.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationStatement-adherence", Code: "adherence", Base: []string{"MedicationStatement"}, Type: "token", Expression: "MedicationStatement.adherence.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationStatement-category", Code: "category", Base: []string{"MedicationStatement"}, Type: "token", Expression: "MedicationStatement.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationStatement-effective", Code: "effective", Base: []string{"MedicationStatement"}, Type: "date", Expression: "MedicationStatement.effective.ofType(dateTime) | MedicationStatement.effective.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationStatement-source", Code: "source", Base: []string{"MedicationStatement"}, Type: "reference", Expression: "MedicationStatement.informationSource", Target: []string{"Device", "Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicationStatement-subject", Code: "subject", Base: []string{"MedicationStatement"}, Type: "reference", Expression: "MedicationStatement.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-characteristic", Code: "characteristic", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.characteristic.value.ofType(Quantity) | MedicinalProductDefinition.characteristic.value.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-characteristic-type", Code: "characteristic-type", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.characteristic.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-contact", Code: "contact", Base: []string{"MedicinalProductDefinition"}, Type: "reference", Expression: "MedicinalProductDefinition.contact.contact", Target: []string{"Organization", "PractitionerRole"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-domain", Code: "domain", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.domain", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-identifier", Code: "identifier", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-ingredient", Code: "ingredient", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.ingredient", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-master-file", Code: "master-file", Base: []string{"MedicinalProductDefinition"}, Type: "reference", Expression: "MedicinalProductDefinition.masterFile", Target: []string{"DocumentReference"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-name", Code: "name", Base: []string{"MedicinalProductDefinition"}, Type: "string", Expression: "MedicinalProductDefinition.name.productName", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-name-country", Code: "name-country", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.name.usage.country", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-name-language", Code: "name-language", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.name.usage.language", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-organization", Code: "operation-organization", Base: []string{"MedicinalProductDefinition"}, Type: "reference", Expression: "MedicinalProductDefinition.operation.organization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-type", Code: "operation-type", Base: []string{"MedicinalProductDefinition"}, Type: "reference", Expression: "MedicinalProductDefinition.operation.type.reference", Target: []string{"ActivityDefinition", "PlanDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-type-code", Code: "operation-type-code", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.operation.type.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-product-classification", Code: "product-classification", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.classification", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-status", Code: "status", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-type", Code: "type", Base: []string{"MedicinalProductDefinition"}, Type: "token", Expression: "MedicinalProductDefinition.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-organization-type", Code: "operation-organization-type", Base: []string{"MedicinalProductDefinition"}, Type: "composite", Expression: "MedicinalProductDefinition", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-organization", Expression: "operation-organization"}, {Definition: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-type", Expression: "operation-type"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-organization-type-code", Code: "operation-organization-type-code", Base: []string{"MedicinalProductDefinition"}, Type: "composite", Expression: "MedicinalProductDefinition", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-operation-organization", Expression: "operation-organization"}, {Definition: "http://hl7.org/fhir/SearchParameter/MedicinalProductDefinition-type-code", Expression: "operation-type-code"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageDefinition-category", Code: "category", Base: []string{"MessageDefinition"}, Type: "token", Expression: "MessageDefinition.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageDefinition-event", Code: "event", Base: []string{"MessageDefinition"}, Type: "token", Expression: "MessageDefinition.event.ofType(Coding)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageDefinition-experimental", Code: "experimental", Base: []string{"MessageDefinition"}, Type: "token", Expression: "MessageDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageDefinition-focus", Code: "focus", Base: []string{"MessageDefinition"}, Type: "token", Expression: "MessageDefinition.focus.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-code", Code: "code", Base: []string{"MessageHeader"}, Type: "token", Expression: "MessageHeader.response.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-destination", Code: "destination", Base: []string{"MessageHeader"}, Type: "string", Expression: "MessageHeader.destination.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-event", Code: "event", Base: []string{"MessageHeader"}, Type: "token", Expression: "MessageHeader.event.ofType(Coding) | MessageHeader.event.ofType(canonical)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-focus", Code: "focus", Base: []string{"MessageHeader"}, Type: "reference", Expression: "MessageHeader.focus", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-receiver", Code: "receiver", Base: []string{"MessageHeader"}, Type: "reference", Expression: "MessageHeader.destination.receiver", Target: []string{"Device", "Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-response-id", Code: "response-id", Base: []string{"MessageHeader"}, Type: "token", Expression: "MessageHeader.response.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-sender", Code: "sender", Base: []string{"MessageHeader"}, Type: "reference", Expression: "MessageHeader.source.sender", Target: []string{"Device", "Organization", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/MessageHeader-source", Code: "source", Base: []string{"MessageHeader"}, Type: "string", Expression: "MessageHeader.source.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-contact", Code: "contact", Base: []string{"NamingSystem"}, Type: "string", Expression: "NamingSystem.contact.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-experimental", Code: "experimental", Base: []string{"NamingSystem"}, Type: "token", Expression: "NamingSystem.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-id-type", Code: "id-type", Base: []string{"NamingSystem"}, Type: "token", Expression: "NamingSystem.uniqueId.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-kind", Code: "kind", Base: []string{"NamingSystem"}, Type: "token", Expression: "NamingSystem.kind", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-period", Code: "period", Base: []string{"NamingSystem"}, Type: "date", Expression: "NamingSystem.uniqueId.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-responsible", Code: "responsible", Base: []string{"NamingSystem"}, Type: "string", Expression: "NamingSystem.responsible", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-telecom", Code: "telecom", Base: []string{"NamingSystem"}, Type: "token", Expression: "NamingSystem.contact.telecom", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-type", Code: "type", Base: []string{"NamingSystem"}, Type: "token", Expression: "NamingSystem.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NamingSystem-value", Code: "value", Base: []string{"NamingSystem"}, Type: "string", Expression: "NamingSystem.uniqueId.value", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionIntake-nutrition", Code: "nutrition", Base: []string{"NutritionIntake"}, Type: "token", Expression: "NutritionIntake.nutritionItem.nutritionProduct.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionIntake-source", Code: "source", Base: []string{"NutritionIntake"}, Type: "reference", Expression: "(NutritionIntake.reported as Reference)", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionIntake-status", Code: "status", Base: []string{"NutritionIntake"}, Type: "token", Expression: "NutritionIntake.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionIntake-subject", Code: "subject", Base: []string{"NutritionIntake"}, Type: "reference", Expression: "NutritionIntake.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-additive", Code: "additive", Base: []string{"NutritionOrder"}, Type: "token", Expression: "NutritionOrder.additive.modularType.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-datetime", Code: "datetime", Base: []string{"NutritionOrder"}, Type: "date", Expression: "NutritionOrder.dateTime", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-formula", Code: "formula", Base: []string{"NutritionOrder"}, Type: "token", Expression: "NutritionOrder.enteralFormula.type.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-group-identifier", Code: "group-identifier", Base: []string{"NutritionOrder"}, Type: "token", Expression: "NutritionOrder.groupIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-oraldiet", Code: "oraldiet", Base: []string{"NutritionOrder"}, Type: "token", Expression: "NutritionOrder.oralDiet.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-requester", Code: "requester", Base: []string{"NutritionOrder"}, Type: "reference", Expression: "NutritionOrder.requester", Target: []string{"PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-status", Code: "status", Base: []string{"NutritionOrder"}, Type: "token", Expression: "NutritionOrder.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-subject", Code: "subject", Base: []string{"NutritionOrder"}, Type: "reference", Expression: "NutritionOrder.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionOrder-supplement", Code: "supplement", Base: []string{"NutritionOrder"}, Type: "token", Expression: "NutritionOrder.supplement.type.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-code", Code: "code", Base: []string{"NutritionProduct"}, Type: "token", Expression: "NutritionProduct.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-expiration-date", Code: "expiration-date", Base: []string{"NutritionProduct"}, Type: "date", Expression: "NutritionProduct.instance.expiry", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-identifier", Code: "identifier", Base: []string{"NutritionProduct"}, Type: "token", Expression: "NutritionProduct.instance.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-ingredient-item", Code: "ingredient-item", Base: []string{"NutritionProduct"}, Type: "token", Expression: "NutritionProduct.ingredient.item.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-lot-number", Code: "lot-number", Base: []string{"NutritionProduct"}, Type: "token", Expression: "NutritionProduct.instance.lotNumber", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-serial-number", Code: "serial-number", Base: []string{"NutritionProduct"}, Type: "token", Expression: "NutritionProduct.instance.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/NutritionProduct-status", Code: "status", Base: []string{"NutritionProduct"}, Type: "token", Expression: "NutritionProduct.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-based-on", Code: "based-on", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.basedOn", Target: []string{"DeviceRequest", "ServiceRequest", "CarePlan", "MedicationRequest", "NutritionOrder"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-body-site", Code: "body-site", Base: []string{"Observation"}, Type: "token", Expression: "Observation.bodySite", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-body-structure-code", Code: "body-structure-code", Base: []string{"Observation"}, Type: "token", Expression: "Observation.bodyStructure.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-category", Code: "category", Base: []string{"Observation"}, Type: "token", Expression: "Observation.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-code", Code: "combo-code", Base: []string{"Observation"}, Type: "token", Expression: "Observation.code | Observation.component.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-data-absent-reason", Code: "combo-data-absent-reason", Base: []string{"Observation"}, Type: "token", Expression: "Observation.dataAbsentReason | Observation.component.dataAbsentReason", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-interpretation", Code: "combo-interpretation", Base: []string{"Observation"}, Type: "token", Expression: "Observation.interpretation | Observation.component.interpretation", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-value-concept", Code: "combo-value-concept", Base: []string{"Observation"}, Type: "token", Expression: "Observation.value.ofType(CodeableConcept) | Observation.component.value.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-value-quantity", Code: "combo-value-quantity", Base: []string{"Observation"}, Type: "quantity", Expression: "Observation.value.ofType(Quantity) |  Observation.component.value.ofType(Quantity)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-code", Code: "component-code", Base: []string{"Observation"}, Type: "token", Expression: "Observation.component.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-data-absent-reason", Code: "component-data-absent-reason", Base: []string{"Observation"}, Type: "token", Expression: "Observation.component.dataAbsentReason", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-interpretation", Code: "component-interpretation", Base: []string{"Observation"}, Type: "token", Expression: "Observation.component.interpretation", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-value-concept", Code: "component-value-concept", Base: []string{"Observation"}, Type: "token", Expression: "Observation.component.value.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-value-quantity", Code: "component-value-quantity", Base: []string{"Observation"}, Type: "quantity", Expression: "Observation.component.value.ofType(Quantity)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-data-absent-reason", Code: "data-absent-reason", Base: []string{"Observation"}, Type: "token", Expression: "Observation.dataAbsentReason", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-derived-from", Code: "derived-from", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.derivedFrom", Target: []string{"ImagingStudy", "DocumentReference", "Observation", "ImagingSelection", "QuestionnaireResponse"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-device", Code: "device", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.device", Target: []string{"Device", "DeviceMetric"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-focus", Code: "focus", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.focus", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-has-member", Code: "has-member", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.hasMember", Target: []string{"Observation", "QuestionnaireResponse"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-interpretation", Code: "interpretation", Base: []string{"Observation"}, Type: "token", Expression: "Observation.interpretation", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-method", Code: "method", Base: []string{"Observation"}, Type: "token", Expression: "Observation.method", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-part-of", Code: "part-of", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.partOf", Target: []string{"ImagingStudy", "Procedure", "MedicationStatement", "MedicationAdministration", "Immunization", "MedicationDispense"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-performer", Code: "performer", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.performer", Target: []string{"HealthcareService", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-reference", Code: "reference", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.bodyStructure.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-specimen", Code: "specimen", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.specimen", Target: []string{"Group", "Specimen"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-status", Code: "status", Base: []string{"Observation"}, Type: "token", Expression: "Observation.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-subject", Code: "subject", Base: []string{"Observation"}, Type: "reference", Expression: "Observation.subject", Target: []string{"Device", "Organization", "Procedure", "NutritionProduct", "Group", "Practitioner", "BiologicallyDerivedProduct", "Substance", "Location", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-value-concept", Code: "value-concept", Base: []string{"Observation"}, Type: "token", Expression: "Observation.value.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-value-date", Code: "value-date", Base: []string{"Observation"}, Type: "date", Expression: "Observation.value.ofType(dateTime) | Observation.value.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-value-quantity", Code: "value-quantity", Base: []string{"Observation"}, Type: "quantity", Expression: "Observation.value.ofType(Quantity)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-value-string", Code: "value-string", Base: []string{"Observation"}, Type: "string", Expression: "Observation.value.ofType(string)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-code-value-concept", Code: "code-value-concept", Base: []string{"Observation"}, Type: "composite", Expression: "Observation", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/clinical-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-value-concept", Expression: "value.ofType(CodeableConcept)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-code-value-date", Code: "code-value-date", Base: []string{"Observation"}, Type: "composite", Expression: "Observation", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/clinical-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-value-date", Expression: "value.ofType(dateTime) | value.ofType(Period)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-code-value-quantity", Code: "code-value-quantity", Base: []string{"Observation"}, Type: "composite", Expression: "Observation", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/clinical-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-value-quantity", Expression: "value.ofType(Quantity)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-code-value-string", Code: "code-value-string", Base: []string{"Observation"}, Type: "composite", Expression: "Observation", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/clinical-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-value-string", Expression: "value.ofType(string)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-code-value-concept", Code: "combo-code-value-concept", Base: []string{"Observation"}, Type: "composite", Expression: "Observation | Observation.component", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Observation-combo-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-combo-value-concept", Expression: "value.ofType(CodeableConcept)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-combo-code-value-quantity", Code: "combo-code-value-quantity", Base: []string{"Observation"}, Type: "composite", Expression: "Observation | Observation.component", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Observation-combo-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-combo-value-quantity", Expression: "value.ofType(Quantity)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-code-value-concept", Code: "component-code-value-concept", Base: []string{"Observation"}, Type: "composite", Expression: "Observation.component", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Observation-component-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-component-value-concept", Expression: "value.ofType(CodeableConcept)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/Observation-component-code-value-quantity", Code: "component-code-value-quantity", Base: []string{"Observation"}, Type: "composite", Expression: "Observation.component", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Observation-component-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Observation-component-value-quantity", Expression: "value.ofType(Quantity)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/ObservationDefinition-body-structure-code", Code: "body-structure-code", Base: []string{"ObservationDefinition"}, Type: "token", Expression: "ObservationDefinition.bodyStructure.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ObservationDefinition-category", Code: "category", Base: []string{"ObservationDefinition"}, Type: "token", Expression: "ObservationDefinition.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ObservationDefinition-code", Code: "code", Base: []string{"ObservationDefinition"}, Type: "token", Expression: "ObservationDefinition.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ObservationDefinition-experimental", Code: "experimental", Base: []string{"ObservationDefinition"}, Type: "token", Expression: "ObservationDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ObservationDefinition-method", Code: "method", Base: []string{"ObservationDefinition"}, Type: "token", Expression: "ObservationDefinition.method", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ObservationDefinition-reference", Code: "reference", Base: []string{"ObservationDefinition"}, Type: "reference", Expression: "ObservationDefinition.bodyStructure.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-base", Code: "base", Base: []string{"OperationDefinition"}, Type: "reference", Expression: "OperationDefinition.base", Target: []string{"OperationDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-code", Code: "code", Base: []string{"OperationDefinition"}, Type: "token", Expression: "OperationDefinition.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-experimental", Code: "experimental", Base: []string{"OperationDefinition"}, Type: "token", Expression: "OperationDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-input-profile", Code: "input-profile", Base: []string{"OperationDefinition"}, Type: "reference", Expression: "OperationDefinition.inputProfile", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-instance", Code: "instance", Base: []string{"OperationDefinition"}, Type: "token", Expression: "OperationDefinition.instance", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-kind", Code: "kind", Base: []string{"OperationDefinition"}, Type: "token", Expression: "OperationDefinition.kind", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-output-profile", Code: "output-profile", Base: []string{"OperationDefinition"}, Type: "reference", Expression: "OperationDefinition.outputProfile", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-system", Code: "system", Base: []string{"OperationDefinition"}, Type: "token", Expression: "OperationDefinition.system", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OperationDefinition-type", Code: "type", Base: []string{"OperationDefinition"}, Type: "token", Expression: "OperationDefinition.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-active", Code: "active", Base: []string{"Organization"}, Type: "token", Expression: "Organization.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-address", Code: "address", Base: []string{"Organization"}, Type: "string", Expression: "Organization.contact.address", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-address-city", Code: "address-city", Base: []string{"Organization"}, Type: "string", Expression: "Organization.contact.address.city", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-address-country", Code: "address-country", Base: []string{"Organization"}, Type: "string", Expression: "Organization.contact.address.country", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-address-postalcode", Code: "address-postalcode", Base: []string{"Organization"}, Type: "string", Expression: "Organization.contact.address.postalCode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-address-state", Code: "address-state", Base: []string{"Organization"}, Type: "string", Expression: "Organization.contact.address.state", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-address-use", Code: "address-use", Base: []string{"Organization"}, Type: "token", Expression: "Organization.contact.address.use", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-endpoint", Code: "endpoint", Base: []string{"Organization"}, Type: "reference", Expression: "Organization.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-identifier", Code: "identifier", Base: []string{"Organization"}, Type: "token", Expression: "Organization.identifier | Organization.qualification.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-name", Code: "name", Base: []string{"Organization"}, Type: "string", Expression: "Organization.name | Organization.alias", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-partof", Code: "partof", Base: []string{"Organization"}, Type: "reference", Expression: "Organization.partOf", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-phonetic", Code: "phonetic", Base: []string{"Organization"}, Type: "string", Expression: "Organization.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Organization-type", Code: "type", Base: []string{"Organization"}, Type: "token", Expression: "Organization.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-active", Code: "active", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-date", Code: "date", Base: []string{"OrganizationAffiliation"}, Type: "date", Expression: "OrganizationAffiliation.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-email", Code: "email", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.contact.telecom.where(system='email')", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-endpoint", Code: "endpoint", Base: []string{"OrganizationAffiliation"}, Type: "reference", Expression: "OrganizationAffiliation.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-identifier", Code: "identifier", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-location", Code: "location", Base: []string{"OrganizationAffiliation"}, Type: "reference", Expression: "OrganizationAffiliation.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-network", Code: "network", Base: []string{"OrganizationAffiliation"}, Type: "reference", Expression: "OrganizationAffiliation.network", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-participating-organization", Code: "participating-organization", Base: []string{"OrganizationAffiliation"}, Type: "reference", Expression: "OrganizationAffiliation.participatingOrganization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-phone", Code: "phone", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.contact.telecom.where(system='phone')", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-primary-organization", Code: "primary-organization", Base: []string{"OrganizationAffiliation"}, Type: "reference", Expression: "OrganizationAffiliation.organization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-role", Code: "role", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-service", Code: "service", Base: []string{"OrganizationAffiliation"}, Type: "reference", Expression: "OrganizationAffiliation.healthcareService", Target: []string{"HealthcareService"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-specialty", Code: "specialty", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.specialty", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/OrganizationAffiliation-telecom", Code: "telecom", Base: []string{"OrganizationAffiliation"}, Type: "token", Expression: "OrganizationAffiliation.contact.telecom", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-biological", Code: "biological", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-contained-item", Code: "contained-item", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-device", Code: "device", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-identifier", Code: "identifier", Base: []string{"PackagedProductDefinition"}, Type: "token", Expression: "PackagedProductDefinition.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-manufactured-item", Code: "manufactured-item", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-medication", Code: "medication", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-name", Code: "name", Base: []string{"PackagedProductDefinition"}, Type: "token", Expression: "PackagedProductDefinition.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-nutrition", Code: "nutrition", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-package", Code: "package", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.repeat(packaging).containedItem.item.reference", Target: []string{"DeviceDefinition", "NutritionProduct", "BiologicallyDerivedProduct", "PackagedProductDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-package-for", Code: "package-for", Base: []string{"PackagedProductDefinition"}, Type: "reference", Expression: "PackagedProductDefinition.packageFor", Target: []string{"MedicinalProductDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PackagedProductDefinition-status", Code: "status", Base: []string{"PackagedProductDefinition"}, Type: "token", Expression: "PackagedProductDefinition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-active", Code: "active", Base: []string{"Patient"}, Type: "token", Expression: "Patient.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-address", Code: "address", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "string", Expression: "Patient.address | Person.address | Practitioner.address | RelatedPerson.address", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-address-city", Code: "address-city", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "string", Expression: "Patient.address.city | Person.address.city | Practitioner.address.city | RelatedPerson.address.city", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-address-country", Code: "address-country", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "string", Expression: "Patient.address.country | Person.address.country | Practitioner.address.country | RelatedPerson.address.country", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-address-postalcode", Code: "address-postalcode", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "string", Expression: "Patient.address.postalCode | Person.address.postalCode | Practitioner.address.postalCode | RelatedPerson.address.postalCode", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-address-state", Code: "address-state", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "string", Expression: "Patient.address.state | Person.address.state | Practitioner.address.state | RelatedPerson.address.state", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-address-use", Code: "address-use", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "token", Expression: "Patient.address.use | Person.address.use | Practitioner.address.use | RelatedPerson.address.use", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-birthdate", Code: "birthdate", Base: []string{"Patient", "Person", "RelatedPerson"}, Type: "date", Expression: "Patient.birthDate | Person.birthDate | RelatedPerson.birthDate", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-death-date", Code: "death-date", Base: []string{"Patient"}, Type: "date", Expression: "(Patient.deceased.ofType(dateTime))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-deceased", Code: "deceased", Base: []string{"Patient"}, Type: "token", Expression: "Patient.deceased.exists() and Patient.deceased != false", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-email", Code: "email", Base: []string{"Patient", "Person", "Practitioner", "PractitionerRole", "RelatedPerson"}, Type: "token", Expression: "Patient.telecom.where(system='email') | Person.telecom.where(system='email') | Practitioner.telecom.where(system='email') | PractitionerRole.contact.telecom.where(system='email') | RelatedPerson.telecom.where(system='email')", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-family", Code: "family", Base: []string{"Patient", "Practitioner"}, Type: "string", Expression: "Patient.name.family | Practitioner.name.family", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-gender", Code: "gender", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "token", Expression: "Patient.gender | Person.gender | Practitioner.gender | RelatedPerson.gender", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-general-practitioner", Code: "general-practitioner", Base: []string{"Patient"}, Type: "reference", Expression: "Patient.generalPractitioner", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-given", Code: "given", Base: []string{"Patient", "Practitioner"}, Type: "string", Expression: "Patient.name.given | Practitioner.name.given", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-identifier", Code: "identifier", Base: []string{"Patient"}, Type: "token", Expression: "Patient.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-language", Code: "language", Base: []string{"Patient"}, Type: "token", Expression: "Patient.communication.language", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-link", Code: "link", Base: []string{"Patient"}, Type: "reference", Expression: "Patient.link.other", Target: []string{"RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-name", Code: "name", Base: []string{"Patient"}, Type: "string", Expression: "Patient.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Patient-organization", Code: "organization", Base: []string{"Patient"}, Type: "reference", Expression: "Patient.managingOrganization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-phone", Code: "phone", Base: []string{"Patient", "Person", "Practitioner", "PractitionerRole", "RelatedPerson"}, Type: "token", Expression: "Patient.telecom.where(system='phone') | Person.telecom.where(system='phone') | Practitioner.telecom.where(system='phone') | PractitionerRole.contact.telecom.where(system='phone') | RelatedPerson.telecom.where(system='phone')", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-phonetic", Code: "phonetic", Base: []string{"Patient", "Person", "Practitioner", "RelatedPerson"}, Type: "string", Expression: "Patient.name | Person.name | Practitioner.name | RelatedPerson.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/individual-telecom", Code: "telecom", Base: []string{"Patient", "Person", "Practitioner", "PractitionerRole", "RelatedPerson"}, Type: "token", Expression: "Patient.telecom | Person.telecom | Practitioner.telecom | PractitionerRole.contact.telecom | RelatedPerson.telecom", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-created", Code: "created", Base: []string{"PaymentNotice"}, Type: "date", Expression: "PaymentNotice.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-identifier", Code: "identifier", Base: []string{"PaymentNotice"}, Type: "token", Expression: "PaymentNotice.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-payment-status", Code: "payment-status", Base: []string{"PaymentNotice"}, Type: "token", Expression: "PaymentNotice.paymentStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-reporter", Code: "reporter", Base: []string{"PaymentNotice"}, Type: "reference", Expression: "PaymentNotice.reporter", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-request", Code: "request", Base: []string{"PaymentNotice"}, Type: "reference", Expression: "PaymentNotice.request", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-response", Code: "response", Base: []string{"PaymentNotice"}, Type: "reference", Expression: "PaymentNotice.response", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentNotice-status", Code: "status", Base: []string{"PaymentNotice"}, Type: "token", Expression: "PaymentNotice.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-allocation-account", Code: "allocation-account", Base: []string{"PaymentReconciliation"}, Type: "reference", Expression: "PaymentReconciliation.allocation.account", Target: []string{"Account"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-allocation-encounter", Code: "allocation-encounter", Base: []string{"PaymentReconciliation"}, Type: "reference", Expression: "PaymentReconciliation.allocation.encounter", Target: []string{"Encounter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-created", Code: "created", Base: []string{"PaymentReconciliation"}, Type: "date", Expression: "PaymentReconciliation.created", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-disposition", Code: "disposition", Base: []string{"PaymentReconciliation"}, Type: "string", Expression: "PaymentReconciliation.disposition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-identifier", Code: "identifier", Base: []string{"PaymentReconciliation"}, Type: "token", Expression: "PaymentReconciliation.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-outcome", Code: "outcome", Base: []string{"PaymentReconciliation"}, Type: "token", Expression: "PaymentReconciliation.outcome", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-payment-issuer", Code: "payment-issuer", Base: []string{"PaymentReconciliation"}, Type: "reference", Expression: "PaymentReconciliation.paymentIssuer", Target: []string{"Organization", "RelatedPerson", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-request", Code: "request", Base: []string{"PaymentReconciliation"}, Type: "reference", Expression: "PaymentReconciliation.request", Target: []string{"Task"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-requestor", Code: "requestor", Base: []string{"PaymentReconciliation"}, Type: "reference", Expression: "PaymentReconciliation.requestor", Target: []string{"Organization", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PaymentReconciliation-status", Code: "status", Base: []string{"PaymentReconciliation"}, Type: "token", Expression: "PaymentReconciliation.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-death-date", Code: "death-date", Base: []string{"Person"}, Type: "date", Expression: "(Person.deceased.ofType(dateTime))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-deceased", Code: "deceased", Base: []string{"Person"}, Type: "token", Expression: "Person.deceased.exists() and Person.deceased != false", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-family", Code: "family", Base: []string{"Person"}, Type: "string", Expression: "Person.name.family", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-given", Code: "given", Base: []string{"Person"}, Type: "string", Expression: "Person.name.given", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-link", Code: "link", Base: []string{"Person"}, Type: "reference", Expression: "Person.link.target", Target: []string{"RelatedPerson", "Practitioner", "Person", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-name", Code: "name", Base: []string{"Person"}, Type: "string", Expression: "Person.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-organization", Code: "organization", Base: []string{"Person"}, Type: "reference", Expression: "Person.managingOrganization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-practitioner", Code: "practitioner", Base: []string{"Person"}, Type: "reference", Expression: "Person.link.target.where(resolve() is Practitioner)", Target: []string{"Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Person-relatedperson", Code: "relatedperson", Base: []string{"Person"}, Type: "reference", Expression: "Person.link.target.where(resolve() is RelatedPerson)", Target: []string{"RelatedPerson"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PlanDefinition-definition", Code: "definition", Base: []string{"PlanDefinition"}, Type: "reference", Expression: "PlanDefinition.repeat(action).definition.ofType(canonical) | PlanDefinition.repeat(action).definition.ofType(uri)", Target: []string{"Measure", "OperationDefinition", "ObservationDefinition", "ActivityDefinition", "PlanDefinition", "MessageDefinition", "Questionnaire", "SpecimenDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PlanDefinition-experimental", Code: "experimental", Base: []string{"PlanDefinition"}, Type: "token", Expression: "PlanDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PlanDefinition-subject-canonical", Code: "subject-canonical", Base: []string{"PlanDefinition"}, Type: "reference", Expression: "PlanDefinition.subject as canonical", Target: []string{"MedicinalProductDefinition", "AdministrableProductDefinition", "Group", "PackagedProductDefinition", "EvidenceVariable", "SubstanceDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PlanDefinition-subject-code", Code: "subject-code", Base: []string{"PlanDefinition"}, Type: "token", Expression: "PlanDefinition.subject as CodeableConcept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PlanDefinition-subject-reference", Code: "subject-reference", Base: []string{"PlanDefinition"}, Type: "reference", Expression: "PlanDefinition.subject as Reference", Target: []string{"MedicinalProductDefinition", "AdministrableProductDefinition", "Group", "PackagedProductDefinition", "EvidenceVariable", "SubstanceDefinition", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PlanDefinition-type", Code: "type", Base: []string{"PlanDefinition"}, Type: "token", Expression: "PlanDefinition.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-active", Code: "active", Base: []string{"Practitioner"}, Type: "token", Expression: "Practitioner.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-communication", Code: "communication", Base: []string{"Practitioner"}, Type: "token", Expression: "Practitioner.communication.language", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-death-date", Code: "death-date", Base: []string{"Practitioner"}, Type: "date", Expression: "(Practitioner.deceased.ofType(dateTime))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-deceased", Code: "deceased", Base: []string{"Practitioner"}, Type: "token", Expression: "Practitioner.deceased.exists() and Practitioner.deceased != false", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-identifier", Code: "identifier", Base: []string{"Practitioner"}, Type: "token", Expression: "Practitioner.identifier | Practitioner.qualification.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-name", Code: "name", Base: []string{"Practitioner"}, Type: "string", Expression: "Practitioner.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-qualification-code", Code: "qualification-code", Base: []string{"Practitioner"}, Type: "token", Expression: "Practitioner.qualification.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-qualification-period", Code: "qualification-period", Base: []string{"Practitioner"}, Type: "date", Expression: "Practitioner.qualification.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Practitioner-qual-code-period", Code: "qual-code-period", Base: []string{"Practitioner"}, Type: "composite", Expression: "Practitioner.qualification", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/Practitioner-qualification-code", Expression: "code"}, {Definition: "http://hl7.org/fhir/SearchParameter/Practitioner-qualification-period", Expression: "period"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-active", Code: "active", Base: []string{"PractitionerRole"}, Type: "token", Expression: "PractitionerRole.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-characteristic", Code: "characteristic", Base: []string{"PractitionerRole"}, Type: "token", Expression: "PractitionerRole.characteristic", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-communication", Code: "communication", Base: []string{"PractitionerRole"}, Type: "token", Expression: "PractitionerRole.communication", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-date", Code: "date", Base: []string{"PractitionerRole"}, Type: "date", Expression: "PractitionerRole.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-endpoint", Code: "endpoint", Base: []string{"PractitionerRole"}, Type: "reference", Expression: "PractitionerRole.endpoint", Target: []string{"Endpoint"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-identifier", Code: "identifier", Base: []string{"PractitionerRole"}, Type: "token", Expression: "PractitionerRole.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-location", Code: "location", Base: []string{"PractitionerRole"}, Type: "reference", Expression: "PractitionerRole.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-network", Code: "network", Base: []string{"PractitionerRole"}, Type: "reference", Expression: "PractitionerRole.network", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-organization", Code: "organization", Base: []string{"PractitionerRole"}, Type: "reference", Expression: "PractitionerRole.organization", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-practitioner", Code: "practitioner", Base: []string{"PractitionerRole"}, Type: "reference", Expression: "PractitionerRole.practitioner", Target: []string{"Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-role", Code: "role", Base: []string{"PractitionerRole"}, Type: "token", Expression: "PractitionerRole.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-service", Code: "service", Base: []string{"PractitionerRole"}, Type: "reference", Expression: "PractitionerRole.healthcareService", Target: []string{"HealthcareService"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/PractitionerRole-specialty", Code: "specialty", Base: []string{"PractitionerRole"}, Type: "token", Expression: "PractitionerRole.specialty", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-based-on", Code: "based-on", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.basedOn", Target: []string{"ServiceRequest", "CarePlan", "MedicationRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-category", Code: "category", Base: []string{"Procedure"}, Type: "token", Expression: "Procedure.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-location", Code: "location", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-part-of", Code: "part-of", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.partOf", Target: []string{"Procedure", "Observation", "MedicationAdministration"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-performer", Code: "performer", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.performer.actor", Target: []string{"HealthcareService", "Organization", "Device", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-reason-code", Code: "reason-code", Base: []string{"Procedure"}, Type: "token", Expression: "Procedure.reason.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-reason-reference", Code: "reason-reference", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.reason.reference", Target: []string{"Procedure", "DocumentReference", "Observation", "DiagnosticReport", "Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-report", Code: "report", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.report", Target: []string{"Composition", "Bundle", "DocumentReference", "DiagnosticReport"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-status", Code: "status", Base: []string{"Procedure"}, Type: "token", Expression: "Procedure.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Procedure-subject", Code: "subject", Base: []string{"Procedure"}, Type: "reference", Expression: "Procedure.subject", Target: []string{"Device", "Organization", "Group", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-activity", Code: "activity", Base: []string{"Provenance"}, Type: "token", Expression: "Provenance.activity", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-agent", Code: "agent", Base: []string{"Provenance"}, Type: "reference", Expression: "Provenance.agent.who", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-agent-role", Code: "agent-role", Base: []string{"Provenance"}, Type: "token", Expression: "Provenance.agent.role", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-agent-type", Code: "agent-type", Base: []string{"Provenance"}, Type: "token", Expression: "Provenance.agent.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-based-on", Code: "based-on", Base: []string{"Provenance"}, Type: "reference", Expression: "Provenance.basedOn", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-entity", Code: "entity", Base: []string{"Provenance"}, Type: "reference", Expression: "Provenance.entity.what", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-location", Code: "location", Base: []string{"Provenance"}, Type: "reference", Expression: "Provenance.location", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-recorded", Code: "recorded", Base: []string{"Provenance"}, Type: "date", Expression: "Provenance.recorded", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-signature-type", Code: "signature-type", Base: []string{"Provenance"}, Type: "token", Expression: "Provenance.signature.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-target", Code: "target", Base: []string{"Provenance"}, Type: "reference", Expression: "Provenance.target", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Provenance-when", Code: "when", Base: []string{"Provenance"}, Type: "date", Expression: "(Provenance.occurred.ofType(dateTime))", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Questionnaire-combo-code", Code: "combo-code", Base: []string{"Questionnaire"}, Type: "token", Expression: "Questionnaire.code | Questionnaire.repeat(item).code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Questionnaire-definition", Code: "definition", Base: []string{"Questionnaire"}, Type: "uri", Expression: "Questionnaire.repeat(item).definition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Questionnaire-experimental", Code: "experimental", Base: []string{"Questionnaire"}, Type: "token", Expression: "Questionnaire.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Questionnaire-item-code", Code: "item-code", Base: []string{"Questionnaire"}, Type: "token", Expression: "Questionnaire.repeat(item).code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Questionnaire-questionnaire-code", Code: "questionnaire-code", Base: []string{"Questionnaire"}, Type: "token", Expression: "Questionnaire.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Questionnaire-subject-type", Code: "subject-type", Base: []string{"Questionnaire"}, Type: "token", Expression: "Questionnaire.subjectType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-concept", Code: "answer-concept", Base: []string{"QuestionnaireResponse"}, Type: "token", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(boolean) | QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(Coding)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-date", Code: "answer-date", Base: []string{"QuestionnaireResponse"}, Type: "date", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(date) | QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(dateTime)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-number", Code: "answer-number", Base: []string{"QuestionnaireResponse"}, Type: "number", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(integer) | QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(decimal)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-quantity", Code: "answer-quantity", Base: []string{"QuestionnaireResponse"}, Type: "quantity", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(Quantity)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-reference", Code: "answer-reference", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(Reference)", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-string", Code: "answer-string", Base: []string{"QuestionnaireResponse"}, Type: "string", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).answer.value.ofType(string)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-author", Code: "author", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.author", Target: []string{"Device", "Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-authored", Code: "authored", Base: []string{"QuestionnaireResponse"}, Type: "date", Expression: "QuestionnaireResponse.authored", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-based-on", Code: "based-on", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.basedOn", Target: []string{"ServiceRequest", "CarePlan"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-subject", Code: "item-subject", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).where(extension('http://hl7.org/fhir/StructureDefinition/questionnaireresponse-isSubject').exists()).answer.value.ofType(Reference)", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Code: "linkid", Base: []string{"QuestionnaireResponse"}, Type: "token", Expression: "QuestionnaireResponse.repeat(item.combine(item.answer.item)).linkId", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-part-of", Code: "part-of", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.partOf", Target: []string{"Procedure", "Observation"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-questionnaire", Code: "questionnaire", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.questionnaire", Target: []string{"Questionnaire"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-source", Code: "source", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.source", Target: []string{"Device", "Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-status", Code: "status", Base: []string{"QuestionnaireResponse"}, Type: "token", Expression: "QuestionnaireResponse.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-subject", Code: "subject", Base: []string{"QuestionnaireResponse"}, Type: "reference", Expression: "QuestionnaireResponse.subject", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-concept", Code: "item-concept", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-concept", Expression: "item.answer.value.ofType(boolean) | item.answer.value.ofType(Coding)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-date", Code: "item-date", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-date", Expression: "item.answer.value.ofType(date) | item.answer.value.ofType(dateTime)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-number", Code: "item-number", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-number", Expression: "item.answer.value.ofType(integer) | item.answer.value.ofType(decimal)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-quantity", Code: "item-quantity", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-quantity", Expression: "item.answer.value.ofType(Quantity)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-reference", Code: "item-reference", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-reference", Expression: "item.answer.value.ofType(Reference)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-string", Code: "item-string", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-string", Expression: "item.answer.value.ofType(String)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-item-uri", Code: "item-uri", Base: []string{"QuestionnaireResponse"}, Type: "composite", Expression: "QuestionnaireResponse", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-linkid", Expression: "item.linkId"}, {Definition: "http://hl7.org/fhir/SearchParameter/QuestionnaireResponse-answer-uri", Expression: "item.answer.value.ofType(Uri)"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-case", Code: "case", Base: []string{"RegulatedAuthorization"}, Type: "token", Expression: "RegulatedAuthorization.case.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-case-type", Code: "case-type", Base: []string{"RegulatedAuthorization"}, Type: "token", Expression: "RegulatedAuthorization.case.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-holder", Code: "holder", Base: []string{"RegulatedAuthorization"}, Type: "reference", Expression: "RegulatedAuthorization.holder", Target: []string{"Organization"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-identifier", Code: "identifier", Base: []string{"RegulatedAuthorization"}, Type: "token", Expression: "RegulatedAuthorization.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-region", Code: "region", Base: []string{"RegulatedAuthorization"}, Type: "token", Expression: "RegulatedAuthorization.region", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-status", Code: "status", Base: []string{"RegulatedAuthorization"}, Type: "token", Expression: "RegulatedAuthorization.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-subject", Code: "subject", Base: []string{"RegulatedAuthorization"}, Type: "reference", Expression: "RegulatedAuthorization.subject", Target: []string{"Ingredient", "Organization", "ObservationDefinition", "ActivityDefinition", "PlanDefinition", "BiologicallyDerivedProduct", "Practitioner", "PackagedProductDefinition", "SubstanceDefinition", "Location", "MedicinalProductDefinition", "NutritionProduct", "DeviceDefinition", "ResearchStudy", "ManufacturedItemDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-type", Code: "type", Base: []string{"RegulatedAuthorization"}, Type: "token", Expression: "RegulatedAuthorization.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RegulatedAuthorization-validity", Code: "validity", Base: []string{"RegulatedAuthorization"}, Type: "date", Expression: "RegulatedAuthorization.validityPeriod", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RelatedPerson-active", Code: "active", Base: []string{"RelatedPerson"}, Type: "token", Expression: "RelatedPerson.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RelatedPerson-family", Code: "family", Base: []string{"RelatedPerson"}, Type: "string", Expression: "RelatedPerson.name.family", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RelatedPerson-given", Code: "given", Base: []string{"RelatedPerson"}, Type: "string", Expression: "RelatedPerson.name.given", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RelatedPerson-name", Code: "name", Base: []string{"RelatedPerson"}, Type: "string", Expression: "RelatedPerson.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RelatedPerson-relationship", Code: "relationship", Base: []string{"RelatedPerson"}, Type: "token", Expression: "RelatedPerson.relationship", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RelatedPerson-role", Code: "role", Base: []string{"RelatedPerson"}, Type: "token", Expression: "RelatedPerson.relationship", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-action-resource", Code: "action-resource", Base: []string{"RequestOrchestration"}, Type: "reference", Expression: "RequestOrchestration.repeat(action).resource", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-author", Code: "author", Base: []string{"RequestOrchestration"}, Type: "reference", Expression: "RequestOrchestration.author", Target: []string{"Device", "PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-authored", Code: "authored", Base: []string{"RequestOrchestration"}, Type: "date", Expression: "RequestOrchestration.authoredOn", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-based-on", Code: "based-on", Base: []string{"RequestOrchestration"}, Type: "reference", Expression: "RequestOrchestration.basedOn", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-group-identifier", Code: "group-identifier", Base: []string{"RequestOrchestration"}, Type: "token", Expression: "RequestOrchestration.groupIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-instantiates-canonical", Code: "instantiates-canonical", Base: []string{"RequestOrchestration"}, Type: "reference", Expression: "RequestOrchestration.instantiatesCanonical", Target: []string{"ActivityDefinition", "ActorDefinition", "CapabilityStatement", "CodeSystem", "CompartmentDefinition", "Composition", "ConceptMap", "Contract", "DeviceDefinition", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "Group", "ImplementationGuide", "Library", "Measure", "MessageDefinition", "NamingSystem", "ObservationDefinition", "OperationDefinition", "PlanDefinition", "Questionnaire", "Requirements", "ResearchStudy", "SearchParameter", "SpecimenDefinition", "StructureDefinition", "StructureMap", "SubscriptionTopic", "TerminologyCapabilities", "ValueSet"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-instantiates-uri", Code: "instantiates-uri", Base: []string{"RequestOrchestration"}, Type: "uri", Expression: "RequestOrchestration.instantiatesUri", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-intent", Code: "intent", Base: []string{"RequestOrchestration"}, Type: "token", Expression: "RequestOrchestration.intent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-participant", Code: "participant", Base: []string{"RequestOrchestration"}, Type: "reference", Expression: "RequestOrchestration.repeat(action).participant.actor.ofType(Reference) | RequestOrchestration.repeat(action).participant.actor.ofType(canonical)", Target: []string{"StructureDefinition", "CareTeam", "Device", "Organization", "RelatedPerson", "Group", "BiologicallyDerivedProduct", "Practitioner", "Specimen", "CapabilityStatement", "Substance", "SubstanceDefinition", "Location", "ActorDefinition", "MedicinalProductDefinition", "HealthcareService", "Endpoint", "DeviceDefinition", "PractitionerRole", "SpecimenDefinition", "Medication", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-priority", Code: "priority", Base: []string{"RequestOrchestration"}, Type: "token", Expression: "RequestOrchestration.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-status", Code: "status", Base: []string{"RequestOrchestration"}, Type: "token", Expression: "RequestOrchestration.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RequestOrchestration-subject", Code: "subject", Base: []string{"RequestOrchestration"}, Type: "reference", Expression: "RequestOrchestration.subject", Target: []string{"HealthcareService", "CareTeam", "Device", "Organization", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Requirements-actor", Code: "actor", Base: []string{"Requirements"}, Type: "reference", Expression: "Requirements.actor.reference", Target: []string{"ActorDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Requirements-derived-from", Code: "derived-from", Base: []string{"Requirements"}, Type: "reference", Expression: "Requirements.derivedFrom", Target: []string{"Requirements"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Requirements-experimental", Code: "experimental", Base: []string{"Requirements"}, Type: "token", Expression: "Requirements.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-classifier", Code: "classifier", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.classifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-condition", Code: "condition", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.condition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-date", Code: "date", Base: []string{"ResearchStudy"}, Type: "date", Expression: "ResearchStudy.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-description", Code: "description", Base: []string{"ResearchStudy"}, Type: "string", Expression: "ResearchStudy.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-eligibility", Code: "eligibility", Base: []string{"ResearchStudy"}, Type: "reference", Expression: "ResearchStudy.recruitment.eligibility", Target: []string{"Group"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-focus-code", Code: "focus-code", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.focus.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-focus-reference", Code: "focus-reference", Base: []string{"ResearchStudy"}, Type: "reference", Expression: "ResearchStudy.focus.reference", Target: []string{"MedicinalProductDefinition", "DeviceDefinition", "PlanDefinition", "SubstanceDefinition", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-identifier", Code: "identifier", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-keyword", Code: "keyword", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.keyword", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-name", Code: "name", Base: []string{"ResearchStudy"}, Type: "string", Expression: "ResearchStudy.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-objective-description", Code: "objective-description", Base: []string{"ResearchStudy"}, Type: "string", Expression: "ResearchStudy.objective.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-objective-type", Code: "objective-type", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.objective.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-part-of", Code: "part-of", Base: []string{"ResearchStudy"}, Type: "reference", Expression: "ResearchStudy.partOf", Target: []string{"ResearchStudy"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-phase", Code: "phase", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.phase", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-actual", Code: "progress-actual", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.progressStatus.actual", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-period", Code: "progress-period", Base: []string{"ResearchStudy"}, Type: "date", Expression: "ResearchStudy.progressStatus.period", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-state", Code: "progress-state", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.progressStatus.state", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-protocol", Code: "protocol", Base: []string{"ResearchStudy"}, Type: "reference", Expression: "ResearchStudy.protocol", Target: []string{"PlanDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-recruitment-actual", Code: "recruitment-actual", Base: []string{"ResearchStudy"}, Type: "number", Expression: "ResearchStudy.recruitment.actualNumber", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-recruitment-target", Code: "recruitment-target", Base: []string{"ResearchStudy"}, Type: "number", Expression: "ResearchStudy.recruitment.targetNumber", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-region", Code: "region", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.region", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-site", Code: "site", Base: []string{"ResearchStudy"}, Type: "reference", Expression: "ResearchStudy.site", Target: []string{"Organization", "ResearchStudy", "Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-status", Code: "status", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-study-design", Code: "study-design", Base: []string{"ResearchStudy"}, Type: "token", Expression: "ResearchStudy.studyDesign", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-title", Code: "title", Base: []string{"ResearchStudy"}, Type: "string", Expression: "ResearchStudy.title", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-status-state-actual", Code: "progress-status-state-actual", Base: []string{"ResearchStudy"}, Type: "composite", Expression: "ResearchStudy.progressStatus", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-state", Expression: "progress-state"}, {Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-actual", Expression: "progress-actual"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-status-state-period", Code: "progress-status-state-period", Base: []string{"ResearchStudy"}, Type: "composite", Expression: "ResearchStudy.progressStatus", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-state", Expression: "progress-state"}, {Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-period", Expression: "progress-period"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-status-state-period-actual", Code: "progress-status-state-period-actual", Base: []string{"ResearchStudy"}, Type: "composite", Expression: "ResearchStudy.progressStatus", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-state", Expression: "progress-state"}, {Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-period", Expression: "progress-period"}, {Definition: "http://hl7.org/fhir/SearchParameter/ResearchStudy-progress-actual", Expression: "progress-actual"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchSubject-status", Code: "status", Base: []string{"ResearchSubject"}, Type: "token", Expression: "ResearchSubject.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchSubject-study", Code: "study", Base: []string{"ResearchSubject"}, Type: "reference", Expression: "ResearchSubject.study", Target: []string{"ResearchStudy"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchSubject-subject", Code: "subject", Base: []string{"ResearchSubject"}, Type: "reference", Expression: "ResearchSubject.subject", Target: []string{"Device", "Group", "BiologicallyDerivedProduct", "Specimen", "Substance", "SubstanceDefinition", "Patient", "Medication"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ResearchSubject-subjectstate", Code: "subject_state", Base: []string{"ResearchSubject"}, Type: "token", Expression: "ResearchSubject.subjectState.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-condition", Code: "condition", Base: []string{"RiskAssessment"}, Type: "reference", Expression: "RiskAssessment.condition", Target: []string{"Condition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-method", Code: "method", Base: []string{"RiskAssessment"}, Type: "token", Expression: "RiskAssessment.method", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-performer", Code: "performer", Base: []string{"RiskAssessment"}, Type: "reference", Expression: "RiskAssessment.performer", Target: []string{"Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-probability", Code: "probability", Base: []string{"RiskAssessment"}, Type: "number", Expression: "RiskAssessment.prediction.probability.ofType(decimal)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-probability-quantity", Code: "probability-quantity", Base: []string{"RiskAssessment"}, Type: "quantity", Expression: "RiskAssessment.prediction.probability.ofType(Quantity) | RiskAssessment.prediction.probability.ofType(Range)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-risk", Code: "risk", Base: []string{"RiskAssessment"}, Type: "token", Expression: "RiskAssessment.prediction.qualitativeRisk", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/RiskAssessment-subject", Code: "subject", Base: []string{"RiskAssessment"}, Type: "reference", Expression: "RiskAssessment.subject", Target: []string{"Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-active", Code: "active", Base: []string{"Schedule"}, Type: "token", Expression: "Schedule.active", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-actor", Code: "actor", Base: []string{"Schedule"}, Type: "reference", Expression: "Schedule.actor", Target: []string{"HealthcareService", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-date", Code: "date", Base: []string{"Schedule"}, Type: "date", Expression: "Schedule.planningHorizon", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-identifier", Code: "identifier", Base: []string{"Schedule"}, Type: "token", Expression: "Schedule.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-name", Code: "name", Base: []string{"Schedule"}, Type: "string", Expression: "Schedule.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-service-category", Code: "service-category", Base: []string{"Schedule"}, Type: "token", Expression: "Schedule.serviceCategory", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-service-type", Code: "service-type", Base: []string{"Schedule"}, Type: "token", Expression: "Schedule.serviceType.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-service-type-reference", Code: "service-type-reference", Base: []string{"Schedule"}, Type: "reference", Expression: "Schedule.serviceType.reference", Target: []string{"HealthcareService"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Schedule-specialty", Code: "specialty", Base: []string{"Schedule"}, Type: "token", Expression: "Schedule.specialty", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-base", Code: "base", Base: []string{"SearchParameter"}, Type: "token", Expression: "SearchParameter.base", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-code", Code: "code", Base: []string{"SearchParameter"}, Type: "token", Expression: "SearchParameter.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-component", Code: "component", Base: []string{"SearchParameter"}, Type: "reference", Expression: "SearchParameter.component.definition", Target: []string{"SearchParameter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-derived-from", Code: "derived-from", Base: []string{"SearchParameter"}, Type: "reference", Expression: "SearchParameter.derivedFrom", Target: []string{"SearchParameter"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-experimental", Code: "experimental", Base: []string{"SearchParameter"}, Type: "token", Expression: "SearchParameter.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-target", Code: "target", Base: []string{"SearchParameter"}, Type: "token", Expression: "SearchParameter.target", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SearchParameter-type", Code: "type", Base: []string{"SearchParameter"}, Type: "token", Expression: "SearchParameter.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-authored", Code: "authored", Base: []string{"ServiceRequest"}, Type: "date", Expression: "ServiceRequest.authoredOn", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-based-on", Code: "based-on", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.basedOn", Target: []string{"RequestOrchestration", "DocumentReference", "ServiceRequest", "CarePlan", "MedicationRequest", "NutritionOrder"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-body-structure-code", Code: "body-structure-code", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.bodyStructure.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-category", Code: "category", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-code-concept", Code: "code-concept", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.code.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-code-reference", Code: "code-reference", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.code.reference", Target: []string{"ActivityDefinition", "PlanDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-group-or-identifier", Code: "group-or-identifier", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.requisition | ServiceRequest.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-intent", Code: "intent", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.intent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-location-code", Code: "location-code", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.location.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-location-reference", Code: "location-reference", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.location.reference", Target: []string{"Location"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-occurrence", Code: "occurrence", Base: []string{"ServiceRequest"}, Type: "date", Expression: "ServiceRequest.occurrence.ofType(dateTime) | ServiceRequest.occurrence.ofType(Period) | ServiceRequest.occurrence.ofType(Timing)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-performer", Code: "performer", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.performer", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-performer-type", Code: "performer-type", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.performerType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-priority", Code: "priority", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-reference", Code: "reference", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.bodyStructure.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-replaces", Code: "replaces", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.replaces", Target: []string{"RequestOrchestration", "ServiceRequest", "DeviceRequest", "CarePlan", "CommunicationRequest", "MedicationRequest", "NutritionOrder", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-requester", Code: "requester", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.requester", Target: []string{"Organization", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-requisition", Code: "requisition", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.requisition", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-status", Code: "status", Base: []string{"ServiceRequest"}, Type: "token", Expression: "ServiceRequest.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ServiceRequest-subject", Code: "subject", Base: []string{"ServiceRequest"}, Type: "reference", Expression: "ServiceRequest.subject", Target: []string{"Device", "Group", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-appointment-type", Code: "appointment-type", Base: []string{"Slot"}, Type: "token", Expression: "Slot.appointmentType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-identifier", Code: "identifier", Base: []string{"Slot"}, Type: "token", Expression: "Slot.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-schedule", Code: "schedule", Base: []string{"Slot"}, Type: "reference", Expression: "Slot.schedule", Target: []string{"Schedule"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-service-category", Code: "service-category", Base: []string{"Slot"}, Type: "token", Expression: "Slot.serviceCategory", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-service-type", Code: "service-type", Base: []string{"Slot"}, Type: "token", Expression: "Slot.serviceType.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-service-type-reference", Code: "service-type-reference", Base: []string{"Slot"}, Type: "reference", Expression: "Slot.serviceType.reference", Target: []string{"HealthcareService"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-specialty", Code: "specialty", Base: []string{"Slot"}, Type: "token", Expression: "Slot.specialty", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-start", Code: "start", Base: []string{"Slot"}, Type: "date", Expression: "Slot.start", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Slot-status", Code: "status", Base: []string{"Slot"}, Type: "token", Expression: "Slot.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-bodysite", Code: "bodysite", Base: []string{"Specimen"}, Type: "reference", Expression: "Specimen.collection.bodySite.reference", Target: []string{"BodyStructure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-collected", Code: "collected", Base: []string{"Specimen"}, Type: "date", Expression: "Specimen.collection.collected.ofType(dateTime) | Specimen.collection.collected.ofType(Period)", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-collection-device-code", Code: "collection-device-code", Base: []string{"Specimen"}, Type: "token", Expression: "Specimen.collection.device.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-collector", Code: "collector", Base: []string{"Specimen"}, Type: "reference", Expression: "Specimen.collection.collector", Target: []string{"Organization", "RelatedPerson", "PractitionerRole", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-container-device-code", Code: "container-device-code", Base: []string{"Specimen"}, Type: "token", Expression: "Specimen.container.device.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-parent", Code: "parent", Base: []string{"Specimen"}, Type: "reference", Expression: "Specimen.parent", Target: []string{"Specimen"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-procedure", Code: "procedure", Base: []string{"Specimen"}, Type: "reference", Expression: "Specimen.collection.procedure", Target: []string{"Procedure"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-processing-device-code", Code: "processing-device-code", Base: []string{"Specimen"}, Type: "token", Expression: "Specimen.processing.device.ofType(CodeableConcept)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-request", Code: "request", Base: []string{"Specimen"}, Type: "reference", Expression: "Specimen.request", Target: []string{"ServiceRequest"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-status", Code: "status", Base: []string{"Specimen"}, Type: "token", Expression: "Specimen.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Specimen-subject", Code: "subject", Base: []string{"Specimen"}, Type: "reference", Expression: "Specimen.subject", Target: []string{"Device", "NutritionProduct", "Group", "BiologicallyDerivedProduct", "Substance", "Location", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SpecimenDefinition-container", Code: "container", Base: []string{"SpecimenDefinition"}, Type: "token", Expression: "SpecimenDefinition.typeTested.container.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SpecimenDefinition-experimental", Code: "experimental", Base: []string{"SpecimenDefinition"}, Type: "token", Expression: "SpecimenDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SpecimenDefinition-is-derived", Code: "is-derived", Base: []string{"SpecimenDefinition"}, Type: "token", Expression: "SpecimenDefinition.typeTested.isDerived", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SpecimenDefinition-type", Code: "type", Base: []string{"SpecimenDefinition"}, Type: "token", Expression: "SpecimenDefinition.typeCollected", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SpecimenDefinition-type-tested", Code: "type-tested", Base: []string{"SpecimenDefinition"}, Type: "token", Expression: "SpecimenDefinition.typeTested.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-abstract", Code: "abstract", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.abstract", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-base", Code: "base", Base: []string{"StructureDefinition"}, Type: "reference", Expression: "StructureDefinition.baseDefinition", Target: []string{"StructureDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-base-path", Code: "base-path", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.snapshot.element.base.path | StructureDefinition.differential.element.base.path", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-derivation", Code: "derivation", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.derivation", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-experimental", Code: "experimental", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-ext-context-expression", Code: "ext-context-expression", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.context.expression", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-ext-context-type", Code: "ext-context-type", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.context.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-keyword", Code: "keyword", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.keyword", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-kind", Code: "kind", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.kind", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-path", Code: "path", Base: []string{"StructureDefinition"}, Type: "token", Expression: "StructureDefinition.snapshot.element.path | StructureDefinition.differential.element.path", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-type", Code: "type", Base: []string{"StructureDefinition"}, Type: "uri", Expression: "StructureDefinition.type", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-valueset", Code: "valueset", Base: []string{"StructureDefinition"}, Type: "reference", Expression: "StructureDefinition.snapshot.element.binding.valueSet", Target: []string{"ValueSet"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureDefinition-ext-context", Code: "ext-context", Base: []string{"StructureDefinition"}, Type: "composite", Expression: "StructureDefinition.context", MultipleOr: false, Components: []SearchParameterDefinitionComponent{{Definition: "http://hl7.org/fhir/SearchParameter/StructureDefinition-ext-context-type", Expression: "type"}, {Definition: "http://hl7.org/fhir/SearchParameter/StructureDefinition-ext-context-expression", Expression: "expression"}}},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureMap-experimental", Code: "experimental", Base: []string{"StructureMap"}, Type: "token", Expression: "StructureMap.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureMap-produced", Code: "produced", Base: []string{"StructureMap"}, Type: "uri", Expression: "StructureMap.structure.where(mode = 'produced').url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureMap-queried", Code: "queried", Base: []string{"StructureMap"}, Type: "uri", Expression: "StructureMap.structure.where(mode = 'queried').url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureMap-source", Code: "source", Base: []string{"StructureMap"}, Type: "uri", Expression: "StructureMap.structure.where(mode = 'source').url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/StructureMap-target", Code: "target", Base: []string{"StructureMap"}, Type: "uri", Expression: "StructureMap.structure.where(mode = 'target').url", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-contact", Code: "contact", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.contact", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-content-level", Code: "content-level", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.content", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-filter-event", Code: "filter-event", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.filterBy.event", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-filter-value", Code: "filter-value", Base: []string{"Subscription"}, Type: "string", Expression: "Subscription.filterBy.value", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-identifier", Code: "identifier", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-name", Code: "name", Base: []string{"Subscription"}, Type: "string", Expression: "Subscription.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-owner", Code: "owner", Base: []string{"Subscription"}, Type: "reference", Expression: "Subscription.managingEntity", Target: []string{"HealthcareService", "CareTeam", "Device", "Organization", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-payload", Code: "payload", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.contentType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-status", Code: "status", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-topic", Code: "topic", Base: []string{"Subscription"}, Type: "uri", Expression: "Subscription.topic", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-type", Code: "type", Base: []string{"Subscription"}, Type: "token", Expression: "Subscription.channelType", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Subscription-url", Code: "url", Base: []string{"Subscription"}, Type: "uri", Expression: "Subscription.endpoint", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubscriptionTopic-derived-or-self", Code: "derived-or-self", Base: []string{"SubscriptionTopic"}, Type: "uri", Expression: "SubscriptionTopic.url | SubscriptionTopic.derivedFrom", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubscriptionTopic-effective", Code: "effective", Base: []string{"SubscriptionTopic"}, Type: "date", Expression: "SubscriptionTopic.effectivePeriod", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubscriptionTopic-event", Code: "event", Base: []string{"SubscriptionTopic"}, Type: "token", Expression: "SubscriptionTopic.trigger.event", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubscriptionTopic-experimental", Code: "experimental", Base: []string{"SubscriptionTopic"}, Type: "token", Expression: "SubscriptionTopic.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubscriptionTopic-resource", Code: "resource", Base: []string{"SubscriptionTopic"}, Type: "uri", Expression: "SubscriptionTopic.trigger.resource", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubscriptionTopic-trigger-description", Code: "trigger-description", Base: []string{"SubscriptionTopic"}, Type: "string", Expression: "SubscriptionTopic.trigger.description", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-category", Code: "category", Base: []string{"Substance"}, Type: "token", Expression: "Substance.category", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-code", Code: "code", Base: []string{"Substance"}, Type: "token", Expression: "Substance.code.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-code-reference", Code: "code-reference", Base: []string{"Substance"}, Type: "reference", Expression: "Substance.code.reference", Target: []string{"SubstanceDefinition"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-expiry", Code: "expiry", Base: []string{"Substance"}, Type: "date", Expression: "Substance.expiry", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-identifier", Code: "identifier", Base: []string{"Substance"}, Type: "token", Expression: "Substance.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-quantity", Code: "quantity", Base: []string{"Substance"}, Type: "quantity", Expression: "Substance.quantity", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Substance-status", Code: "status", Base: []string{"Substance"}, Type: "token", Expression: "Substance.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubstanceDefinition-classification", Code: "classification", Base: []string{"SubstanceDefinition"}, Type: "token", Expression: "SubstanceDefinition.classification", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubstanceDefinition-code", Code: "code", Base: []string{"SubstanceDefinition"}, Type: "token", Expression: "SubstanceDefinition.code.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubstanceDefinition-domain", Code: "domain", Base: []string{"SubstanceDefinition"}, Type: "token", Expression: "SubstanceDefinition.domain", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubstanceDefinition-identifier", Code: "identifier", Base: []string{"SubstanceDefinition"}, Type: "token", Expression: "SubstanceDefinition.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubstanceDefinition-name", Code: "name", Base: []string{"SubstanceDefinition"}, Type: "string", Expression: "SubstanceDefinition.name.name", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/SubstanceDefinition-status", Code: "status", Base: []string{"SubstanceDefinition"}, Type: "token", Expression: "SubstanceDefinition.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-actor", Code: "actor", Base: []string{"Task"}, Type: "reference", Expression: "Task.performer.actor", Target: []string{"Device", "Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-authored-on", Code: "authored-on", Base: []string{"Task"}, Type: "date", Expression: "Task.authoredOn", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-based-on", Code: "based-on", Base: []string{"Task"}, Type: "reference", Expression: "Task.basedOn", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-business-status", Code: "business-status", Base: []string{"Task"}, Type: "token", Expression: "Task.businessStatus", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-focus-canonical", Code: "focus-canonical", Base: []string{"Task"}, Type: "uri", Expression: "Task.focus.value.ofType(canonical)", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-focus-reference", Code: "focus-reference", Base: []string{"Task"}, Type: "reference", Expression: "Task.focus.value.ofType(Reference)", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-group-identifier", Code: "group-identifier", Base: []string{"Task"}, Type: "token", Expression: "Task.groupIdentifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-input", Code: "input", Base: []string{"Task"}, Type: "reference", Expression: "Task.input.value.ofType(Reference)", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-intent", Code: "intent", Base: []string{"Task"}, Type: "token", Expression: "Task.intent", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-modified", Code: "modified", Base: []string{"Task"}, Type: "date", Expression: "Task.lastModified", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-output", Code: "output", Base: []string{"Task"}, Type: "reference", Expression: "Task.output.value.ofType(Reference)", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-owner", Code: "owner", Base: []string{"Task"}, Type: "reference", Expression: "Task.owner", Target: []string{"Organization", "CareTeam", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-part-of", Code: "part-of", Base: []string{"Task"}, Type: "reference", Expression: "Task.partOf", Target: []string{"Task"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-performer", Code: "performer", Base: []string{"Task"}, Type: "token", Expression: "Task.requestedPerformer.concept", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-period", Code: "period", Base: []string{"Task"}, Type: "date", Expression: "Task.executionPeriod", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-priority", Code: "priority", Base: []string{"Task"}, Type: "token", Expression: "Task.priority", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-requestedperformer-reference", Code: "requestedperformer-reference", Base: []string{"Task"}, Type: "reference", Expression: "Task.requestedPerformer.reference", Target: []string{"HealthcareService", "Organization", "CareTeam", "Device", "RelatedPerson", "PractitionerRole", "Practitioner", "Group", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-requester", Code: "requester", Base: []string{"Task"}, Type: "reference", Expression: "Task.requester", Target: []string{"Device", "Organization", "RelatedPerson", "PractitionerRole", "Group", "Practitioner", "Patient"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-status", Code: "status", Base: []string{"Task"}, Type: "token", Expression: "Task.status", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/Task-subject", Code: "subject", Base: []string{"Task"}, Type: "reference", Expression: "Task.for", Target: []string{"Account", "ActivityDefinition", "ActorDefinition", "AdministrableProductDefinition", "AdverseEvent", "AllergyIntolerance", "Appointment", "AppointmentResponse", "ArtifactAssessment", "AuditEvent", "Basic", "Binary", "BiologicallyDerivedProduct", "BodyStructure", "Bundle", "CapabilityStatement", "CarePlan", "CareTeam", "Claim", "ClaimResponse", "ClinicalUseDefinition", "CodeSystem", "Communication", "CommunicationRequest", "CompartmentDefinition", "Composition", "ConceptMap", "Condition", "Consent", "Contract", "Coverage", "CoverageEligibilityRequest", "CoverageEligibilityResponse", "DetectedIssue", "Device", "DeviceAlert", "DeviceAssociation", "DeviceDefinition", "DeviceMetric", "DeviceRequest", "DiagnosticReport", "DocumentReference", "Encounter", "Endpoint", "EnrollmentRequest", "EnrollmentResponse", "EpisodeOfCare", "EventDefinition", "Evidence", "EvidenceVariable", "ExampleScenario", "ExplanationOfBenefit", "FamilyMemberHistory", "Flag", "Goal", "Group", "GuidanceResponse", "HealthcareService", "ImagingSelection", "ImagingStudy", "Immunization", "ImplementationGuide", "Ingredient", "InsurancePlan", "InsuranceProduct", "Invoice", "Library", "List", "Location", "ManufacturedItemDefinition", "Measure", "MeasureReport", "Medication", "MedicationAdministration", "MedicationDispense", "MedicationRequest", "MedicationStatement", "MedicinalProductDefinition", "MessageDefinition", "MessageHeader", "NamingSystem", "NutritionIntake", "NutritionOrder", "NutritionProduct", "Observation", "ObservationDefinition", "OperationDefinition", "OperationOutcome", "Organization", "OrganizationAffiliation", "PackagedProductDefinition", "Parameters", "Patient", "PaymentNotice", "PaymentReconciliation", "Person", "PlanDefinition", "Practitioner", "PractitionerRole", "Procedure", "Provenance", "Questionnaire", "QuestionnaireResponse", "RegulatedAuthorization", "RelatedPerson", "RequestOrchestration", "Requirements", "ResearchStudy", "ResearchSubject", "RiskAssessment", "Schedule", "SearchParameter", "ServiceRequest", "Slot", "Specimen", "SpecimenDefinition", "StructureDefinition", "StructureMap", "Subscription", "SubscriptionStatus", "SubscriptionTopic", "Substance", "SubstanceDefinition", "Task", "TerminologyCapabilities", "ValueSet", "VisionPrescription"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/TerminologyCapabilities-experimental", Code: "experimental", Base: []string{"TerminologyCapabilities"}, Type: "token", Expression: "TerminologyCapabilities.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ValueSet-code", Code: "code", Base: []string{"ValueSet"}, Type: "token", Expression: "ValueSet.expansion.repeat(contains).code | ValueSet.compose.include.concept.code", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ValueSet-expansion", Code: "expansion", Base: []string{"ValueSet"}, Type: "uri", Expression: "ValueSet.expansion.identifier", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ValueSet-experimental", Code: "experimental", Base: []string{"ValueSet"}, Type: "token", Expression: "ValueSet.experimental", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/ValueSet-reference", Code: "reference", Base: []string{"ValueSet"}, Type: "uri", Expression: "ValueSet.compose.include.system", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/VisionPrescription-datewritten", Code: "datewritten", Base: []string{"VisionPrescription"}, Type: "date", Expression: "VisionPrescription.dateWritten", Comparator: []string{"eq", "ne", "gt", "ge", "lt", "le", "sa", "eb", "ap"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/VisionPrescription-prescriber", Code: "prescriber", Base: []string{"VisionPrescription"}, Type: "reference", Expression: "VisionPrescription.prescriber", Target: []string{"PractitionerRole", "Practitioner"}, MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/VisionPrescription-product", Code: "product", Base: []string{"VisionPrescription"}, Type: "token", Expression: "VisionPrescription.lensSpecification.product", MultipleOr: true},
+	{URL: "http://hl7.org/fhir/SearchParameter/VisionPrescription-status", Code: "status", Base: []string{"VisionPrescription"}, Type: "token", Expression: "VisionPrescription.status", MultipleOr: true},
+}
+
+// searchParameterIndex maps each resource type to the positions of its
+// parameters in searchParameterDefinitions, including those defined on
+// Resource and DomainResource.
+var searchParameterIndex = map[string][]int{
+	"Account":                        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24},
+	"ActivityDefinition":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51},
+	"ActorDefinition":                {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 40, 41, 46, 48, 49, 50, 51, 52, 53},
+	"AdministrableProductDefinition": {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 54, 55, 56, 57, 58, 59, 60, 61, 62},
+	"AdverseEvent":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76},
+	"AllergyIntolerance":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 65, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87},
+	"Appointment":                    {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110},
+	"AppointmentResponse":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 111, 112, 113, 114, 115, 116},
+	"ArtifactAssessment":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 117, 118},
+	"AuditEvent":                     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 20, 81, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132},
+	"Basic":                          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 133, 134, 135},
+	"Binary":                         {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+	"BiologicallyDerivedProduct":     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 136, 137, 138, 139, 140, 141, 142, 143},
+	"BodyStructure":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 144, 145, 146},
+	"Bundle":                         {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 147, 148, 149, 150, 151},
+	"CapabilityStatement":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161},
+	"CarePlan":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 123, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173},
+	"CareTeam":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 174, 175, 176, 177, 178},
+	"Claim":                          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194},
+	"ClaimResponse":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205},
+	"ClinicalUseDefinition":          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218},
+	"CodeSystem":                     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 31, 32, 33, 35, 36, 38, 39, 40, 41, 46, 47, 48, 49, 50, 51, 219, 220, 221, 222, 223, 224},
+	"Communication":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238},
+	"CommunicationRequest":           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252},
+	"CompartmentDefinition":          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 38, 40, 41, 48, 49, 50, 51, 253, 254},
+	"Composition":                    {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 81, 123, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269},
+	"ConceptMap":                     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 31, 32, 33, 35, 36, 38, 39, 40, 41, 46, 47, 48, 49, 50, 51, 270, 271, 272, 273, 274, 275, 276, 277, 278, 279, 280},
+	"Condition":                      {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 123, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297},
+	"Consent":                        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309, 310, 311, 312},
+	"Contract":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 313, 314, 315, 316, 317, 318, 319, 320},
+	"CoverageEligibilityRequest":     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 332, 333, 334, 335, 336},
+	"CoverageEligibilityResponse":    {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 337, 338, 339, 340, 341, 342, 343},
+	"DetectedIssue":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 344, 345, 346, 347, 348, 349},
+	"Device":                         {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 350, 351, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366, 367, 368, 369, 370, 371},
+	"DeviceAlert":                    {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 372, 373, 374, 375, 376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391, 392, 393},
+	"DeviceAssociation":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 20, 394, 395, 396, 397, 398, 399, 400},
+	"DeviceDefinition":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 35, 40, 48, 49, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412},
+	"DeviceMetric":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 413, 414, 415, 416, 417},
+	"DeviceRequest":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 418, 419, 420, 421, 422, 423, 424, 425, 426, 427, 428, 429, 430, 431, 432},
+	"DiagnosticReport":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 81, 123, 433, 434, 435, 436, 437, 438, 439, 440, 441, 442, 443, 444, 445, 446},
+	"DocumentReference":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 81, 447, 448, 449, 450, 451, 452, 453, 454, 455, 456, 457, 458, 459, 460, 461, 462, 463, 464, 465, 466, 467, 468, 469, 470, 471, 472, 473, 474, 475, 476, 477},
+	"Encounter":                      {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 81, 478, 479, 480, 481, 482, 483, 484, 485, 486, 487, 488, 489, 490, 491, 492, 493, 494, 495, 496, 497, 498, 499, 500, 501, 502, 503},
+	"Endpoint":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 504, 505, 506, 507, 508, 509, 510},
+	"EnrollmentRequest":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 511, 512, 513},
+	"EnrollmentResponse":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 514, 515, 516, 517, 518},
+	"EpisodeOfCare":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 81, 519, 520, 521, 522, 523, 524, 525, 526, 527},
+	"EventDefinition":                {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 25, 26, 27, 28, 29, 30, 31, 32, 33, 35, 36, 38, 39, 40, 41, 45, 46, 47, 48, 49, 50, 51, 528},
+	"Evidence":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 40, 41, 46, 48, 49, 50, 51, 529},
+	"EvidenceVariable":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 38, 40, 41, 46, 47, 48, 49, 50, 51, 530},
+	"ExampleScenario":                {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 35, 36, 38, 40, 41, 48, 49, 50, 51, 531},
+	"ExplanationOfBenefit":           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 532, 533, 534, 535, 536, 537, 538, 539, 540, 541, 542, 543, 544, 545, 546, 547},
+	"FamilyMemberHistory":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 81, 548, 549, 550},
+	"Flag":                           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 123, 551, 552, 553, 554},
+	"Goal":                           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 555, 556, 557, 558, 559, 560, 561, 562, 563},
+	"Group":                          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 35, 38, 41, 48, 564, 565, 566, 567, 568, 569, 570, 571, 572, 573, 574, 575, 576},
+	"GuidanceResponse":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 577, 578, 579},
+	"HealthcareService":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 580, 581, 582, 583, 584, 585, 586, 587, 588, 589, 590, 591, 592, 593, 594},
+	"ImagingSelection":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 595, 596, 597, 598, 599, 600, 601, 602, 603, 604},
+	"ImagingStudy":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 605, 606, 607, 608, 609, 610, 611, 612, 613, 614, 615, 616, 617, 618, 619, 620},
+	"Immunization":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 621, 622, 623, 624, 625, 626, 627, 628, 629, 630, 631, 632, 633, 634},
+	"ImplementationGuide":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 635, 636, 637, 638},
+	"Ingredient":                     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 639, 640, 641, 642, 643, 644, 645, 646, 647, 648, 649, 650, 651, 652, 653, 654, 655},
+	"InsurancePlan":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 656, 657, 658, 659, 660},
+	"InsuranceProduct":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 661, 662, 663, 664, 665, 666, 667, 668, 669, 670, 671, 672, 673, 674},
+	"Invoice":                        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 81, 675, 676, 677, 678, 679, 680, 681, 682, 683},
+	"Library":                        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 25, 26, 27, 28, 29, 30, 31, 32, 33, 35, 36, 38, 39, 40, 41, 45, 46, 47, 48, 49, 50, 51, 684, 685, 686, 687, 688, 689},
+	"List":                           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 81, 123, 690, 691, 692, 693, 694, 695, 696},
+	"Location":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 697, 698, 699, 700, 701, 702, 703, 704, 705, 706, 707, 708, 709, 710, 711, 712, 713, 714, 715},
+	"ManufacturedItemDefinition":     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 716, 717, 718, 719, 720},
+	"Measure":                        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 25, 26, 27, 28, 29, 30, 31, 32, 33, 35, 36, 38, 39, 40, 41, 45, 46, 47, 48, 49, 50, 51, 721, 722, 723, 724},
+	"MeasureReport":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 725, 726, 727, 728, 729, 730, 731},
+	"Medication":                     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 65, 732, 733, 734, 735, 736, 737, 738, 739},
+	"MedicationAdministration":       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 740, 741, 742, 743, 744, 745, 746, 747, 748, 749, 750, 751},
+	"MedicationDispense":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 65, 123, 743, 750, 752, 753, 754, 755, 756, 757, 758, 759, 760, 761},
+	"MedicationRequest":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 742, 743, 750, 762, 763, 764, 765, 766, 767, 768, 769, 770, 771, 772},
+	"MedicationStatement":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 123, 743, 750, 773, 774, 775, 776, 777},
+	"MedicinalProductDefinition":     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 778, 779, 780, 781, 782, 783, 784, 785, 786, 787, 788, 789, 790, 791, 792, 793, 794, 795},
+	"MessageDefinition":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 796, 797, 798, 799},
+	"MessageHeader":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 800, 801, 802, 803, 804, 805, 806, 807},
+	"NamingSystem":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 31, 32, 33, 35, 36, 38, 39, 40, 41, 47, 48, 49, 50, 51, 808, 809, 810, 811, 812, 813, 814, 815, 816},
+	"NutritionIntake":                {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 81, 123, 817, 818, 819, 820},
+	"NutritionOrder":                 {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 821, 822, 823, 824, 825, 826, 827, 828, 829},
+	"NutritionProduct":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 830, 831, 832, 833, 834, 835, 836},
+	"Observation":                    {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 81, 123, 837, 838, 839, 840, 841, 842, 843, 844, 845, 846, 847, 848, 849, 850, 851, 852, 853, 854, 855, 856, 857, 858, 859, 860, 861, 862, 863, 864, 865, 866, 867, 868, 869, 870, 871, 872, 873, 874, 875},
+	"ObservationDefinition":          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 35, 41, 46, 48, 876, 877, 878, 879, 880, 881},
+	"OperationDefinition":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 882, 883, 884, 885, 886, 887, 888, 889, 890},
+	"OperationOutcome":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+	"Organization":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 891, 892, 893, 894, 895, 896, 897, 898, 899, 900, 901, 902, 903},
+	"OrganizationAffiliation":        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 904, 905, 906, 907, 908, 909, 910, 911, 912, 913, 914, 915, 916, 917},
+	"PackagedProductDefinition":      {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 918, 919, 920, 921, 922, 923, 924, 925, 926, 927, 928},
+	"Parameters":                     {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+	"Patient":                        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 929, 930, 931, 932, 933, 934, 935, 936, 937, 938, 939, 940, 941, 942, 943, 944, 945, 946, 947, 948, 949, 950, 951},
+	"PaymentNotice":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 952, 953, 954, 955, 956, 957, 958},
+	"PaymentReconciliation":          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 959, 960, 961, 962, 963, 964, 965, 966, 967, 968},
+	"Person":                         {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 930, 931, 932, 933, 934, 935, 936, 939, 941, 949, 950, 951, 969, 970, 971, 972, 973, 974, 975, 976, 977},
+	"PlanDefinition":                 {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 25, 26, 27, 28, 29, 30, 31, 32, 33, 35, 36, 38, 39, 40, 41, 45, 46, 47, 48, 49, 50, 51, 978, 979, 980, 981, 982, 983},
+	"Practitioner":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 930, 931, 932, 933, 934, 935, 939, 940, 941, 943, 949, 950, 951, 984, 985, 986, 987, 988, 989, 990, 991, 992},
+	"PractitionerRole":               {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 939, 949, 951, 993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004, 1005},
+	"Procedure":                      {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 81, 123, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015},
+	"Provenance":                     {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 20, 123, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026},
+	"Questionnaire":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 33, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 1027, 1028, 1029, 1030, 1031, 1032},
+	"QuestionnaireResponse":          {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055},
+	"RegulatedAuthorization":         {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064},
+	"RelatedPerson":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 930, 931, 932, 933, 934, 935, 936, 939, 941, 949, 950, 951, 1065, 1066, 1067, 1068, 1069, 1070},
+	"RequestOrchestration":           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 123, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082},
+	"Requirements":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 1083, 1084, 1085},
+	"ResearchStudy":                  {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113},
+	"ResearchSubject":                {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 1114, 1115, 1116, 1117},
+	"RiskAssessment":                 {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 81, 123, 1118, 1119, 1120, 1121, 1122, 1123, 1124},
+	"Schedule":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133},
+	"SearchParameter":                {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 48, 49, 50, 51, 1134, 1135, 1136, 1137, 1138, 1139, 1140},
+	"ServiceRequest":                 {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160},
+	"Slot":                           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169},
+	"Specimen":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 24, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180},
+	"SpecimenDefinition":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 35, 41, 46, 48, 1181, 1182, 1183, 1184, 1185},
+	"StructureDefinition":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198},
+	"StructureMap":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 1199, 1200, 1201, 1202, 1203},
+	"Subscription":                   {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215},
+	"SubscriptionStatus":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+	"SubscriptionTopic":              {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 29, 35, 41, 46, 48, 49, 1216, 1217, 1218, 1219, 1220, 1221},
+	"Substance":                      {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1222, 1223, 1224, 1225, 1226, 1227, 1228},
+	"SubstanceDefinition":            {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 1229, 1230, 1231, 1232, 1233, 1234},
+	"Task":                           {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 65, 123, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254},
+	"TerminologyCapabilities":        {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 32, 35, 36, 38, 40, 41, 46, 48, 49, 50, 51, 1255},
+	"ValueSet":                       {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 26, 27, 28, 29, 31, 32, 33, 35, 36, 38, 39, 40, 41, 46, 47, 48, 49, 50, 51, 1256, 1257, 1258, 1259},
+	"VisionPrescription":             {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17, 20, 123, 1260, 1261, 1262, 1263},
+}
+
+// SearchParametersFor returns the search parameters of a resource type.
+func SearchParametersFor(resourceType string) []*SearchParameterDefinition {
+	positions := searchParameterIndex[resourceType]
+	params := make([]*SearchParameterDefinition, len(positions))
+	for i, pos := range positions {
+		params[i] = &searchParameterDefinitions[pos]
+	}
+	return params
+}
+
+// LookupSearchParameter returns the search parameter of a resource type by
+// its code, such as birthdate for Patient.
+func LookupSearchParameter(resourceType, code string) (*SearchParameterDefinition, bool) {
+	for _, pos := range searchParameterIndex[resourceType] {
+		if searchParameterDefinitions[pos].Code == code {
+			return &searchParameterDefinitions[pos], true
+		}
+	}
+	return nil, false
+}
+
+// SearchParameterByURL returns a search parameter by its canonical URL, as
+// the components of composite parameters refer to them.
+func SearchParameterByURL(url string) (*SearchParameterDefinition, bool) {
+	for i := range searchParameterDefinitions {
+		if searchParameterDefinitions[i].URL == url {
+			return &searchParameterDefinitions[i], true
+		}
+	}
+	return nil, false
+}
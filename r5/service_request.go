@@ -2,56 +2,79 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A record of a request for service such as diagnostic investigations, treatments, or operations to be performed.
 type ServiceRequest struct {
-	ResourceType       string                             `json:"resourceType" bson:"resource_type"`                                  // Type of resource
-	Id                 *string                            `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
-	Meta               *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
-	ImplicitRules      *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
-	Language           *string                            `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
-	Text               *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage                  `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
-	Identifier         []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Identifiers assigned to this order
-	BasedOn            []Reference                        `json:"basedOn,omitempty" bson:"based_on,omitempty"`                        // What request fulfills
-	Replaces           []Reference                        `json:"replaces,omitempty" bson:"replaces,omitempty"`                       // What request replaces
-	Requisition        *Identifier                        `json:"requisition,omitempty" bson:"requisition,omitempty"`                 // Composite Request ID
-	Status             string                             `json:"status" bson:"status"`                                               // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
-	StatusReason       []CodeableConcept                  `json:"statusReason,omitempty" bson:"status_reason,omitempty"`              // Reason for current status
-	Intent             string                             `json:"intent" bson:"intent"`                                               // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Category           []CodeableConcept                  `json:"category,omitempty" bson:"category,omitempty"`                       // Classification of service
-	Priority           *string                            `json:"priority,omitempty" bson:"priority,omitempty"`                       // routine | urgent | asap | stat
-	DoNotPerform       *bool                              `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`             // True if service/procedure should not be performed
-	Code               *CodeableReference                 `json:"code,omitempty" bson:"code,omitempty"`                               // What is being requested/ordered
-	OrderDetail        []ServiceRequestOrderDetail        `json:"orderDetail,omitempty" bson:"order_detail,omitempty"`                // Additional information about the request
-	QuantityQuantity   *Quantity                          `json:"quantityQuantity,omitempty" bson:"quantity_quantity,omitempty"`      // Service amount
-	QuantityRatio      *Ratio                             `json:"quantityRatio,omitempty" bson:"quantity_ratio,omitempty"`            // Service amount
-	QuantityRange      *Range                             `json:"quantityRange,omitempty" bson:"quantity_range,omitempty"`            // Service amount
-	Subject            *Reference                         `json:"subject" bson:"subject"`                                             // Individual or Entity the service is ordered for
-	Focus              []Reference                        `json:"focus,omitempty" bson:"focus,omitempty"`                             // What the service request is about, when it is not about the subject of record
-	Encounter          *Reference                         `json:"encounter,omitempty" bson:"encounter,omitempty"`                     // Encounter in which the request was created
-	OccurrenceDateTime *string                            `json:"occurrenceDateTime,omitempty" bson:"occurrence_date_time,omitempty"` // When service should occur
-	OccurrencePeriod   *Period                            `json:"occurrencePeriod,omitempty" bson:"occurrence_period,omitempty"`      // When service should occur
-	OccurrenceTiming   *Timing                            `json:"occurrenceTiming,omitempty" bson:"occurrence_timing,omitempty"`      // When service should occur
-	AsNeeded           *bool                              `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                      // Perform the service "as needed"
-	AsNeededFor        []CodeableConcept                  `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`               // Specified criteria for the service
-	AuthoredOn         *string                            `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                  // Date request signed
-	Requester          *Reference                         `json:"requester,omitempty" bson:"requester,omitempty"`                     // Who/what is requesting service
-	PerformerType      *CodeableConcept                   `json:"performerType,omitempty" bson:"performer_type,omitempty"`            // Performer role
-	Performer          []Reference                        `json:"performer,omitempty" bson:"performer,omitempty"`                     // Requested performer
-	Location           []CodeableReference                `json:"location,omitempty" bson:"location,omitempty"`                       // Requested location
-	Reason             []CodeableReference                `json:"reason,omitempty" bson:"reason,omitempty"`                           // Reason or indication for requesting the service
-	Insurance          []Reference                        `json:"insurance,omitempty" bson:"insurance,omitempty"`                     // Associated insurance coverage
-	SupportingInfo     []CodeableReference                `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`          // Additional clinical information
-	Specimen           []Reference                        `json:"specimen,omitempty" bson:"specimen,omitempty"`                       // Procedure Samples
-	BodyStructure      *CodeableReference                 `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`            // BodyStructure-based location on the body
-	Note               []Annotation                       `json:"note,omitempty" bson:"note,omitempty"`                               // Comments
-	PatientInstruction []ServiceRequestPatientInstruction `json:"patientInstruction,omitempty" bson:"patient_instruction,omitempty"`  // Patient or consumer-oriented instructions
-	RelevantHistory    []Reference                        `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`        // Request provenance
+	ResourceType         string                             `json:"resourceType" bson:"resource_type"`                                 // Type of resource
+	Id                   *string                            `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
+	Meta                 *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
+	ImplicitRules        *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
+	ImplicitRulesElement *Element                           `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`  // Extensions for implicitRules
+	Language             *string                            `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
+	LanguageElement      *Element                           `json:"_language,omitempty" bson:"language_element,omitempty"`             // Extensions for language
+	Text                 *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
+	Contained            []Resource                         `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
+	Extension            []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension    []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored
+	Identifier           []Identifier                       `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // Identifiers assigned to this order
+	BasedOn              []Reference                        `json:"basedOn,omitempty" bson:"based_on,omitempty"`                       // What request fulfills
+	Replaces             []Reference                        `json:"replaces,omitempty" bson:"replaces,omitempty"`                      // What request replaces
+	Requisition          *Identifier                        `json:"requisition,omitempty" bson:"requisition,omitempty"`                // Composite Request ID
+	Status               RequestStatus                      `json:"status" bson:"status"`                                              // draft | active | on-hold | entered-in-error | ended | completed | revoked | unknown
+	StatusElement        *Element                           `json:"_status,omitempty" bson:"status_element,omitempty"`                 // Extensions for status
+	StatusReason         []CodeableConcept                  `json:"statusReason,omitempty" bson:"status_reason,omitempty"`             // Reason for current status
+	Intent               CarePlanIntent                     `json:"intent" bson:"intent"`                                              // proposal | solicit-offer | offer-response | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement        *Element                           `json:"_intent,omitempty" bson:"intent_element,omitempty"`                 // Extensions for intent
+	Category             []CodeableConcept                  `json:"category,omitempty" bson:"category,omitempty"`                      // Classification of service
+	Priority             *RequestPriority                   `json:"priority,omitempty" bson:"priority,omitempty"`                      // routine | urgent | asap | stat
+	PriorityElement      *Element                           `json:"_priority,omitempty" bson:"priority_element,omitempty"`             // Extensions for priority
+	DoNotPerform         *bool                              `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`            // True if service/procedure should not be performed
+	DoNotPerformElement  *Element                           `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`   // Extensions for doNotPerform
+	Code                 *CodeableReference                 `json:"code,omitempty" bson:"code,omitempty"`                              // What is being requested/ordered
+	OrderDetail          []ServiceRequestOrderDetail        `json:"orderDetail,omitempty" bson:"order_detail,omitempty"`               // Additional information about the request
+	Quantity             ServiceRequestQuantity             `json:"-" bson:"quantity,omitempty"`                                       // Service amount
+	Subject              *Reference                         `json:"subject" bson:"subject"`                                            // Individual or Entity the service is ordered for
+	Focus                []Reference                        `json:"focus,omitempty" bson:"focus,omitempty"`                            // What the service request is about, when it is not about the subject of record
+	Encounter            *Reference                         `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // Encounter in which the request was created
+	Occurrence           ServiceRequestOccurrence           `json:"-" bson:"occurrence,omitempty"`                                     // When service should occur
+	OccurrenceElement    *Element                           `json:"-" bson:"occurrence_element,omitempty"`                             // Extensions for occurrence[x]
+	AsNeeded             *bool                              `json:"asNeeded,omitempty" bson:"as_needed,omitempty"`                     // Perform the service "as needed"
+	AsNeededElement      *Element                           `json:"_asNeeded,omitempty" bson:"as_needed_element,omitempty"`            // Extensions for asNeeded
+	AsNeededFor          []CodeableConcept                  `json:"asNeededFor,omitempty" bson:"as_needed_for,omitempty"`              // Specified criteria for the service
+	AuthoredOn           *DateTime                          `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                 // Date request signed
+	AuthoredOnElement    *Element                           `json:"_authoredOn,omitempty" bson:"authored_on_element,omitempty"`        // Extensions for authoredOn
+	Requester            *Reference                         `json:"requester,omitempty" bson:"requester,omitempty"`                    // Who/what is requesting service
+	PerformerType        *CodeableConcept                   `json:"performerType,omitempty" bson:"performer_type,omitempty"`           // Performer role
+	Performer            []Reference                        `json:"performer,omitempty" bson:"performer,omitempty"`                    // Requested performer
+	Location             []CodeableReference                `json:"location,omitempty" bson:"location,omitempty"`                      // Requested location
+	Reason               []CodeableReference                `json:"reason,omitempty" bson:"reason,omitempty"`                          // Reason or indication for requesting the service
+	Insurance            []Reference                        `json:"insurance,omitempty" bson:"insurance,omitempty"`                    // Associated insurance coverage
+	SupportingInfo       []CodeableReference                `json:"supportingInfo,omitempty" bson:"supporting_info,omitempty"`         // Additional clinical information
+	Specimen             []Reference                        `json:"specimen,omitempty" bson:"specimen,omitempty"`                      // Procedure Samples
+	BodyStructure        *CodeableReference                 `json:"bodyStructure,omitempty" bson:"body_structure,omitempty"`           // BodyStructure-based location on the body
+	Note                 []Annotation                       `json:"note,omitempty" bson:"note,omitempty"`                              // Comments
+	PatientInstruction   []ServiceRequestPatientInstruction `json:"patientInstruction,omitempty" bson:"patient_instruction,omitempty"` // Patient or consumer-oriented instructions
+	RelevantHistory      []Reference                        `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`       // Request provenance
+
+	quantityVariants   []string // JSON properties of ServiceRequest.quantity[x] when more than one was decoded
+	occurrenceVariants []string // JSON properties of ServiceRequest.occurrence[x] when more than one was decoded
 }
 
+var serviceRequestBasedOnTargets = []string{"CarePlan", "DocumentReference", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest"}
+
+var serviceRequestReplacesTargets = []string{"CarePlan", "CommunicationRequest", "DeviceRequest", "MedicationRequest", "NutritionOrder", "RequestOrchestration", "ServiceRequest", "VisionPrescription"}
+
+var serviceRequestSubjectTargets = []string{"Device", "Group", "Location", "Patient"}
+
+var serviceRequestEncounterTargets = []string{"Encounter"}
+
+var serviceRequestRequesterTargets = []string{"Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var serviceRequestPerformerTargets = []string{"CareTeam", "Device", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *ServiceRequest) Validate() error {
 	if r.ResourceType != "ServiceRequest" {
 		return fmt.Errorf("invalid resourceType: expected 'ServiceRequest', got '%s'", r.ResourceType)
@@ -61,21 +84,59 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, serviceRequestBasedOnTargets); target != "" {
+			return fmt.Errorf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, serviceRequestBasedOnTargets)
+		}
+	}
 	for i, item := range r.BasedOn {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("BasedOn[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, serviceRequestReplacesTargets); target != "" {
+			return fmt.Errorf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, serviceRequestReplacesTargets)
+		}
+	}
 	for i, item := range r.Replaces {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Replaces[%d]: %w", i, err)
@@ -86,23 +147,51 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("Requisition: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusReason[%d]: %w", i, err)
 		}
 	}
-	if r.Intent == emptyString {
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
 		}
 	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
@@ -113,24 +202,22 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("OrderDetail[%d]: %w", i, err)
 		}
 	}
-	if r.QuantityQuantity != nil {
-		if err := r.QuantityQuantity.Validate(); err != nil {
-			return fmt.Errorf("QuantityQuantity: %w", err)
-		}
-	}
-	if r.QuantityRatio != nil {
-		if err := r.QuantityRatio.Validate(); err != nil {
-			return fmt.Errorf("QuantityRatio: %w", err)
-		}
+	if len(r.quantityVariants) > 1 {
+		return fmt.Errorf("field 'Quantity' must have a single type, got %v", r.quantityVariants)
 	}
-	if r.QuantityRange != nil {
-		if err := r.QuantityRange.Validate(); err != nil {
-			return fmt.Errorf("QuantityRange: %w", err)
+	if r.Quantity != nil {
+		if err := r.Quantity.Validate(); err != nil {
+			return fmt.Errorf("Quantity: %w", err)
 		}
 	}
 	if r.Subject == nil {
 		return fmt.Errorf("field 'Subject' is required")
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, serviceRequestSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, serviceRequestSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
@@ -141,19 +228,32 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("Focus[%d]: %w", i, err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, serviceRequestEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, serviceRequestEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.OccurrencePeriod != nil {
-		if err := r.OccurrencePeriod.Validate(); err != nil {
-			return fmt.Errorf("OccurrencePeriod: %w", err)
+	if len(r.occurrenceVariants) > 1 {
+		return fmt.Errorf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants)
+	}
+	if r.Occurrence != nil {
+		if err := r.Occurrence.Validate(); err != nil {
+			return fmt.Errorf("Occurrence: %w", err)
+		}
+	}
+	if r.OccurrenceElement != nil {
+		if err := r.OccurrenceElement.Validate(); err != nil {
+			return fmt.Errorf("OccurrenceElement: %w", err)
 		}
 	}
-	if r.OccurrenceTiming != nil {
-		if err := r.OccurrenceTiming.Validate(); err != nil {
-			return fmt.Errorf("OccurrenceTiming: %w", err)
+	if r.AsNeededElement != nil {
+		if err := r.AsNeededElement.Validate(); err != nil {
+			return fmt.Errorf("AsNeededElement: %w", err)
 		}
 	}
 	for i, item := range r.AsNeededFor {
@@ -161,6 +261,21 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("AsNeededFor[%d]: %w", i, err)
 		}
 	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOn: %w", err)
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		if err := r.AuthoredOnElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOnElement: %w", err)
+		}
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, serviceRequestRequesterTargets); target != "" {
+			return fmt.Errorf("field 'Requester' cannot refer to %s, expected one of %v", target, serviceRequestRequesterTargets)
+		}
+	}
 	if r.Requester != nil {
 		if err := r.Requester.Validate(); err != nil {
 			return fmt.Errorf("Requester: %w", err)
@@ -171,6 +286,11 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("PerformerType: %w", err)
 		}
 	}
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, serviceRequestPerformerTargets); target != "" {
+			return fmt.Errorf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, serviceRequestPerformerTargets)
+		}
+	}
 	for i, item := range r.Performer {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Performer[%d]: %w", i, err)
@@ -221,120 +341,1259 @@ func (r *ServiceRequest) Validate() error {
 			return fmt.Errorf("RelevantHistory[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type ServiceRequestOrderDetail struct {
-	Id                            *string                              `json:"id,omitempty" bson:"id,omitempty"`                                                          // Unique id for inter-element referencing
-	ParameterFocusCodeableConcept *CodeableConcept                     `json:"parameterFocusCodeableConcept,omitempty" bson:"parameter_focus_codeable_concept,omitempty"` // The context of the order details by reference
-	ParameterFocusReference       *Reference                           `json:"parameterFocusReference,omitempty" bson:"parameter_focus_reference,omitempty"`              // The context of the order details by reference
-	ParameterFocusCanonical       *string                              `json:"parameterFocusCanonical,omitempty" bson:"parameter_focus_canonical,omitempty"`              // The context of the order details by reference
-	Parameter                     []ServiceRequestOrderDetailParameter `json:"parameter" bson:"parameter"`                                                                // The parameter details for the service being requested
+	return validateInvariants(r, "ServiceRequest")
 }
 
-func (r *ServiceRequestOrderDetail) Validate() error {
-	if r.ParameterFocusCodeableConcept != nil {
-		if err := r.ParameterFocusCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ParameterFocusCodeableConcept: %w", err)
+func (r *ServiceRequest) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ServiceRequest" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ServiceRequest', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.ParameterFocusReference != nil {
-		if err := r.ParameterFocusReference.Validate(); err != nil {
-			return fmt.Errorf("ParameterFocusReference: %w", err)
+	for i, item := range r.BasedOn {
+		if target := invalidReferenceTarget(item.Reference, item.Type, serviceRequestBasedOnTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.basedOn[%d]", path, i), fmt.Sprintf("field 'BasedOn[%d]' cannot refer to %s, expected one of %v", i, target, serviceRequestBasedOnTargets))
 		}
 	}
-	if len(r.Parameter) < 1 {
-		return fmt.Errorf("field 'Parameter' must have at least 1 elements")
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
 	}
-	for i, item := range r.Parameter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Parameter[%d]: %w", i, err)
+	for i, item := range r.Replaces {
+		if target := invalidReferenceTarget(item.Reference, item.Type, serviceRequestReplacesTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.replaces[%d]", path, i), fmt.Sprintf("field 'Replaces[%d]' cannot refer to %s, expected one of %v", i, target, serviceRequestReplacesTargets))
 		}
 	}
-	return nil
-}
-
-type ServiceRequestOrderDetailParameter struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code                 *CodeableConcept `json:"code" bson:"code"`                                   // The detail of the order being requested
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // The value for the order detail
-	ValueRatio           *Ratio           `json:"valueRatio" bson:"value_ratio"`                      // The value for the order detail
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // The value for the order detail
-	ValueBoolean         *bool            `json:"valueBoolean" bson:"value_boolean"`                  // The value for the order detail
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // The value for the order detail
-	ValueString          *string          `json:"valueString" bson:"value_string"`                    // The value for the order detail
-	ValuePeriod          *Period          `json:"valuePeriod" bson:"value_period"`                    // The value for the order detail
-}
-
-func (r *ServiceRequestOrderDetailParameter) Validate() error {
-	if r.Code == nil {
-		return fmt.Errorf("field 'Code' is required")
+	for i, item := range r.Replaces {
+		item.validateAll(fmt.Sprintf("%s.replaces[%d]", path, i), issues)
+	}
+	if r.Requisition != nil {
+		r.Requisition.validateAll(path+".requisition", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.StatusReason {
+		item.validateAll(fmt.Sprintf("%s.statusReason[%d]", path, i), issues)
+	}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
+	}
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
+	}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
 	}
 	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
+		r.Code.validateAll(path+".code", issues)
+	}
+	for i, item := range r.OrderDetail {
+		item.validateAll(fmt.Sprintf("%s.orderDetail[%d]", path, i), issues)
+	}
+	if len(r.quantityVariants) > 1 {
+		issues.add("structure", path+".quantity", fmt.Sprintf("field 'Quantity' must have a single type, got %v", r.quantityVariants))
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity.ofType("+r.Quantity.FHIRType()+")", issues)
+	}
+	if r.Subject == nil {
+		issues.add("required", path+".subject", "field 'Subject' is required")
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, serviceRequestSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, serviceRequestSubjectTargets))
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, serviceRequestEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, serviceRequestEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if len(r.occurrenceVariants) > 1 {
+		issues.add("structure", path+".occurrence", fmt.Sprintf("field 'Occurrence' must have a single type, got %v", r.occurrenceVariants))
+	}
+	if r.Occurrence != nil {
+		r.Occurrence.validateAll(path+".occurrence.ofType("+r.Occurrence.FHIRType()+")", issues)
+	}
+	if r.OccurrenceElement != nil {
+		r.OccurrenceElement.validateAll(path+".occurrence", issues)
+	}
+	if r.AsNeededElement != nil {
+		r.AsNeededElement.validateAll(path+".asNeeded", issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	for i, item := range r.AsNeededFor {
+		item.validateAll(fmt.Sprintf("%s.asNeededFor[%d]", path, i), issues)
+	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			issues.add("value", path+".authoredOn", err.Error())
 		}
 	}
-	if r.ValueRatio == nil {
-		return fmt.Errorf("field 'ValueRatio' is required")
+	if r.AuthoredOnElement != nil {
+		r.AuthoredOnElement.validateAll(path+".authoredOn", issues)
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, serviceRequestRequesterTargets); target != "" {
+			issues.add("value", path+".requester", fmt.Sprintf("field 'Requester' cannot refer to %s, expected one of %v", target, serviceRequestRequesterTargets))
 		}
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.Requester != nil {
+		r.Requester.validateAll(path+".requester", issues)
+	}
+	if r.PerformerType != nil {
+		r.PerformerType.validateAll(path+".performerType", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	for i, item := range r.Performer {
+		if target := invalidReferenceTarget(item.Reference, item.Type, serviceRequestPerformerTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.performer[%d]", path, i), fmt.Sprintf("field 'Performer[%d]' cannot refer to %s, expected one of %v", i, target, serviceRequestPerformerTargets))
 		}
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
+	}
+	for i, item := range r.Location {
+		item.validateAll(fmt.Sprintf("%s.location[%d]", path, i), issues)
+	}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
+	}
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
+	}
+	for i, item := range r.SupportingInfo {
+		item.validateAll(fmt.Sprintf("%s.supportingInfo[%d]", path, i), issues)
+	}
+	for i, item := range r.Specimen {
+		item.validateAll(fmt.Sprintf("%s.specimen[%d]", path, i), issues)
+	}
+	if r.BodyStructure != nil {
+		r.BodyStructure.validateAll(path+".bodyStructure", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.PatientInstruction {
+		item.validateAll(fmt.Sprintf("%s.patientInstruction[%d]", path, i), issues)
+	}
+	for i, item := range r.RelevantHistory {
+		item.validateAll(fmt.Sprintf("%s.relevantHistory[%d]", path, i), issues)
+	}
+}
+
+func (r *ServiceRequest) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ServiceRequest", &issues)
+	checkInvariants(r, "ServiceRequest", &issues)
+	return issues
+}
+
+var serviceRequestInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ServiceRequest) invariants() []invariant {
+	return serviceRequestInvariants
+}
+
+func (r ServiceRequest) MarshalJSON() ([]byte, error) {
+	type alias ServiceRequest
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "quantity", r.Quantity, nil); err != nil {
+		return nil, err
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if data, err = marshalChoice(data, "occurrence", r.Occurrence, r.OccurrenceElement); err != nil {
+		return nil, err
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	return data, nil
+}
+
+func (r *ServiceRequest) UnmarshalJSON(data []byte) error {
+	type alias ServiceRequest
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	var element json.RawMessage
+	if r.Quantity, _, r.quantityVariants, err = unmarshalChoice(fields, "quantity", serviceRequestQuantityVariants); err != nil {
+		return err
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
+	if r.Occurrence, element, r.occurrenceVariants, err = unmarshalChoice(fields, "occurrence", serviceRequestOccurrenceVariants); err != nil {
+		return err
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	r.OccurrenceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.OccurrenceElement); err != nil {
+			return fmt.Errorf("_occurrence: %w", err)
 		}
 	}
 	return nil
 }
 
-type ServiceRequestPatientInstruction struct {
-	Id                   *string    `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	InstructionMarkdown  *string    `json:"instructionMarkdown,omitempty" bson:"instruction_markdown,omitempty"`   // Patient or consumer-oriented instructions
-	InstructionReference *Reference `json:"instructionReference,omitempty" bson:"instruction_reference,omitempty"` // Patient or consumer-oriented instructions
+func (r ServiceRequest) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ServiceRequestPatientInstruction) Validate() error {
-	if r.InstructionReference != nil {
-		if err := r.InstructionReference.Validate(); err != nil {
-			return fmt.Errorf("InstructionReference: %w", err)
-		}
+func (r *ServiceRequest) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ServiceRequest) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ServiceRequest) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ServiceRequest) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Quantity":
+		return "quantity", choiceValues(serviceRequestQuantityVariants)
+	case "Occurrence":
+		return "occurrence", choiceValues(serviceRequestOccurrenceVariants)
 	}
-	return nil
+	return "", nil
+}
+
+var serviceRequestElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *ServiceRequest) elementTypes() map[string]string {
+	return serviceRequestElementTypes
+}
+
+var serviceRequestElementMetadata = []ElementMetadata{
+	{Path: "ServiceRequest.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ServiceRequest.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ServiceRequest.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ServiceRequest.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ServiceRequest.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ServiceRequest.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ServiceRequest.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ServiceRequest.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ServiceRequest.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ServiceRequest.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ServiceRequest.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "ServiceRequest.replaces", Name: "replaces", Field: 13, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "ServiceRequest.requisition", Name: "requisition", Field: 14, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "ServiceRequest.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ServiceRequest.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-status"}},
+	{Path: "ServiceRequest.statusReason", Name: "statusReason", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ServiceRequest.intent", Name: "intent", Field: 18, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ServiceRequest.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/care-plan-intent"}},
+	{Path: "ServiceRequest.category", Name: "category", Field: 20, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ServiceRequest.priority", Name: "priority", Field: 21, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ServiceRequest.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "ServiceRequest.doNotPerform", Name: "doNotPerform", Field: 23, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ServiceRequest.code", Name: "code", Field: 25, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ServiceRequest.orderDetail", Name: "orderDetail", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ServiceRequest.quantity[x]", Name: "quantity", Field: 27, Min: 0, Max: "1", Types: []string{"Quantity", "Ratio", "Range"}},
+	{Path: "ServiceRequest.subject", Name: "subject", Field: 28, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "ServiceRequest.focus", Name: "focus", Field: 29, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ServiceRequest.encounter", Name: "encounter", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "ServiceRequest.occurrence[x]", Name: "occurrence", Field: 31, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Timing"}},
+	{Path: "ServiceRequest.asNeeded", Name: "asNeeded", Field: 33, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ServiceRequest.asNeededFor", Name: "asNeededFor", Field: 35, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ServiceRequest.authoredOn", Name: "authoredOn", Field: 36, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ServiceRequest.requester", Name: "requester", Field: 38, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "ServiceRequest.performerType", Name: "performerType", Field: 39, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ServiceRequest.performer", Name: "performer", Field: 40, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "ServiceRequest.location", Name: "location", Field: 41, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ServiceRequest.reason", Name: "reason", Field: 42, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ServiceRequest.insurance", Name: "insurance", Field: 43, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ServiceRequest.supportingInfo", Name: "supportingInfo", Field: 44, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "ServiceRequest.specimen", Name: "specimen", Field: 45, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "ServiceRequest.bodyStructure", Name: "bodyStructure", Field: 46, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "ServiceRequest.note", Name: "note", Field: 47, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "ServiceRequest.patientInstruction", Name: "patientInstruction", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ServiceRequest.relevantHistory", Name: "relevantHistory", Field: 49, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *ServiceRequest) ElementMetadata() []ElementMetadata {
+	return serviceRequestElementMetadata
+}
+
+// ServiceRequestQuantity is the type of ServiceRequest.quantity[x]. It is implemented by the ServiceRequestQuantity* variant types.
+type ServiceRequestQuantity interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isServiceRequestQuantity()
+}
+
+// ServiceRequestQuantityQuantity is the Quantity variant of ServiceRequestQuantity.
+type ServiceRequestQuantityQuantity struct {
+	Quantity
+}
+
+func (v ServiceRequestQuantityQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ServiceRequestQuantityQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ServiceRequestQuantityQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ServiceRequestQuantityQuantity) isServiceRequestQuantity() {}
+
+// ServiceRequestQuantityRatio is the Ratio variant of ServiceRequestQuantity.
+type ServiceRequestQuantityRatio struct {
+	Ratio
+}
+
+func (v ServiceRequestQuantityRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ServiceRequestQuantityRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ServiceRequestQuantityRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ServiceRequestQuantityRatio) isServiceRequestQuantity() {}
+
+// ServiceRequestQuantityRange is the Range variant of ServiceRequestQuantity.
+type ServiceRequestQuantityRange struct {
+	Range
+}
+
+func (v ServiceRequestQuantityRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ServiceRequestQuantityRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ServiceRequestQuantityRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ServiceRequestQuantityRange) isServiceRequestQuantity() {}
+
+var serviceRequestQuantityVariants = []ServiceRequestQuantity{
+	ServiceRequestQuantityQuantity{},
+	ServiceRequestQuantityRatio{},
+	ServiceRequestQuantityRange{},
+}
+
+// ServiceRequestOccurrence is the type of ServiceRequest.occurrence[x]. It is implemented by the ServiceRequestOccurrence* variant types.
+type ServiceRequestOccurrence interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isServiceRequestOccurrence()
+}
+
+// ServiceRequestOccurrenceDateTime is the dateTime variant of ServiceRequestOccurrence.
+type ServiceRequestOccurrenceDateTime struct {
+	DateTime
+}
+
+func (v ServiceRequestOccurrenceDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ServiceRequestOccurrenceDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ServiceRequestOccurrenceDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ServiceRequestOccurrenceDateTime) isServiceRequestOccurrence() {}
+
+// ServiceRequestOccurrencePeriod is the Period variant of ServiceRequestOccurrence.
+type ServiceRequestOccurrencePeriod struct {
+	Period
+}
+
+func (v ServiceRequestOccurrencePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ServiceRequestOccurrencePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ServiceRequestOccurrencePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ServiceRequestOccurrencePeriod) isServiceRequestOccurrence() {}
+
+// ServiceRequestOccurrenceTiming is the Timing variant of ServiceRequestOccurrence.
+type ServiceRequestOccurrenceTiming struct {
+	Timing
+}
+
+func (v ServiceRequestOccurrenceTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v ServiceRequestOccurrenceTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v ServiceRequestOccurrenceTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v ServiceRequestOccurrenceTiming) isServiceRequestOccurrence() {}
+
+var serviceRequestOccurrenceVariants = []ServiceRequestOccurrence{
+	ServiceRequestOccurrenceDateTime{},
+	ServiceRequestOccurrencePeriod{},
+	ServiceRequestOccurrenceTiming{},
+}
+
+func (r *ServiceRequest) GetResourceType() string {
+	return "ServiceRequest"
+}
+
+func (r *ServiceRequest) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ServiceRequest) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ServiceRequest) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ServiceRequest) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ServiceRequest) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ServiceRequest) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ServiceRequest) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ServiceRequest)(nil)
+
+type ServiceRequestOrderDetail struct {
+	Id                    *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension             []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension     []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	ParameterFocus        ServiceRequestOrderDetailParameterFocus `json:"-" bson:"parameter_focus,omitempty"`                              // The context of the order details by reference
+	ParameterFocusElement *Element                                `json:"-" bson:"parameter_focus_element,omitempty"`                      // Extensions for parameterFocus[x]
+	Parameter             []ServiceRequestOrderDetailParameter    `json:"parameter" bson:"parameter"`                                      // The parameter details for the service being requested
+
+	parameterFocusVariants []string // JSON properties of ServiceRequest.orderDetail.parameterFocus[x] when more than one was decoded
+}
+
+func (r *ServiceRequestOrderDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.parameterFocusVariants) > 1 {
+		return fmt.Errorf("field 'ParameterFocus' must have a single type, got %v", r.parameterFocusVariants)
+	}
+	if r.ParameterFocus != nil {
+		if err := r.ParameterFocus.Validate(); err != nil {
+			return fmt.Errorf("ParameterFocus: %w", err)
+		}
+	}
+	if r.ParameterFocusElement != nil {
+		if err := r.ParameterFocusElement.Validate(); err != nil {
+			return fmt.Errorf("ParameterFocusElement: %w", err)
+		}
+	}
+	if len(r.Parameter) < 1 {
+		return fmt.Errorf("field 'Parameter' must have at least 1 elements")
+	}
+	for i, item := range r.Parameter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Parameter[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ServiceRequestOrderDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.parameterFocusVariants) > 1 {
+		issues.add("structure", path+".parameterFocus", fmt.Sprintf("field 'ParameterFocus' must have a single type, got %v", r.parameterFocusVariants))
+	}
+	if r.ParameterFocus != nil {
+		r.ParameterFocus.validateAll(path+".parameterFocus.ofType("+r.ParameterFocus.FHIRType()+")", issues)
+	}
+	if r.ParameterFocusElement != nil {
+		r.ParameterFocusElement.validateAll(path+".parameterFocus", issues)
+	}
+	if len(r.Parameter) < 1 {
+		issues.add("required", path+".parameter", "field 'Parameter' must have at least 1 elements")
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+}
+
+var serviceRequestOrderDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ServiceRequestOrderDetail) invariants() []invariant {
+	return serviceRequestOrderDetailInvariants
+}
+
+func (r ServiceRequestOrderDetail) MarshalJSON() ([]byte, error) {
+	type alias ServiceRequestOrderDetail
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "parameterFocus", r.ParameterFocus, r.ParameterFocusElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ServiceRequestOrderDetail) UnmarshalJSON(data []byte) error {
+	type alias ServiceRequestOrderDetail
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.ParameterFocus, element, r.parameterFocusVariants, err = unmarshalChoice(fields, "parameterFocus", serviceRequestOrderDetailParameterFocusVariants); err != nil {
+		return err
+	}
+	r.ParameterFocusElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ParameterFocusElement); err != nil {
+			return fmt.Errorf("_parameterFocus: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ServiceRequestOrderDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ServiceRequestOrderDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ServiceRequestOrderDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ServiceRequestOrderDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ServiceRequestOrderDetail) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "ParameterFocus":
+		return "parameterFocus", choiceValues(serviceRequestOrderDetailParameterFocusVariants)
+	}
+	return "", nil
+}
+
+var serviceRequestOrderDetailElementMetadata = []ElementMetadata{
+	{Path: "ServiceRequest.orderDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ServiceRequest.orderDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ServiceRequest.orderDetail.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ServiceRequest.orderDetail.parameterFocus[x]", Name: "parameterFocus", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "ServiceRequest.orderDetail.parameter", Name: "parameter", Field: 5, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ServiceRequestOrderDetail) ElementMetadata() []ElementMetadata {
+	return serviceRequestOrderDetailElementMetadata
+}
+
+// ServiceRequestOrderDetailParameterFocus is the type of ServiceRequest.orderDetail.parameterFocus[x]. It is implemented by the ServiceRequestOrderDetailParameterFocus* variant types.
+type ServiceRequestOrderDetailParameterFocus interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isServiceRequestOrderDetailParameterFocus()
+}
+
+// ServiceRequestOrderDetailParameterFocusCodeableConcept is the CodeableConcept variant of ServiceRequestOrderDetailParameterFocus.
+type ServiceRequestOrderDetailParameterFocusCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCodeableConcept) isServiceRequestOrderDetailParameterFocus() {
+}
+
+// ServiceRequestOrderDetailParameterFocusReference is the Reference variant of ServiceRequestOrderDetailParameterFocus.
+type ServiceRequestOrderDetailParameterFocusReference struct {
+	Reference
+}
+
+func (v ServiceRequestOrderDetailParameterFocusReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterFocusReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterFocusReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ServiceRequestOrderDetailParameterFocusReference) isServiceRequestOrderDetailParameterFocus() {
+}
+
+// ServiceRequestOrderDetailParameterFocusCanonical is the canonical variant of ServiceRequestOrderDetailParameterFocus.
+type ServiceRequestOrderDetailParameterFocusCanonical string
+
+func (v ServiceRequestOrderDetailParameterFocusCanonical) Validate() error {
+	return nil
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCanonical) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v ServiceRequestOrderDetailParameterFocusCanonical) isServiceRequestOrderDetailParameterFocus() {
+}
+
+var serviceRequestOrderDetailParameterFocusVariants = []ServiceRequestOrderDetailParameterFocus{
+	ServiceRequestOrderDetailParameterFocusCodeableConcept{},
+	ServiceRequestOrderDetailParameterFocusReference{},
+	ServiceRequestOrderDetailParameterFocusCanonical(""),
+}
+
+type ServiceRequestOrderDetailParameter struct {
+	Id                *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept                        `json:"code" bson:"code"`                                                // The detail of the order being requested
+	Value             ServiceRequestOrderDetailParameterValue `json:"-" bson:"value,omitempty"`                                        // The value for the order detail
+	ValueElement      *Element                                `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of ServiceRequest.orderDetail.parameter.value[x] when more than one was decoded
+}
+
+func (r *ServiceRequestOrderDetailParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code == nil {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ServiceRequestOrderDetailParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var serviceRequestOrderDetailParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ServiceRequestOrderDetailParameter) invariants() []invariant {
+	return serviceRequestOrderDetailParameterInvariants
+}
+
+func (r ServiceRequestOrderDetailParameter) MarshalJSON() ([]byte, error) {
+	type alias ServiceRequestOrderDetailParameter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ServiceRequestOrderDetailParameter) UnmarshalJSON(data []byte) error {
+	type alias ServiceRequestOrderDetailParameter
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", serviceRequestOrderDetailParameterValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ServiceRequestOrderDetailParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ServiceRequestOrderDetailParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ServiceRequestOrderDetailParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ServiceRequestOrderDetailParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ServiceRequestOrderDetailParameter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(serviceRequestOrderDetailParameterValueVariants)
+	}
+	return "", nil
+}
+
+var serviceRequestOrderDetailParameterElementMetadata = []ElementMetadata{
+	{Path: "ServiceRequest.orderDetail.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ServiceRequest.orderDetail.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ServiceRequest.orderDetail.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ServiceRequest.orderDetail.parameter.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "ServiceRequest.orderDetail.parameter.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"Quantity", "Ratio", "Range", "boolean", "CodeableConcept", "string", "Period"}},
+}
+
+func (r *ServiceRequestOrderDetailParameter) ElementMetadata() []ElementMetadata {
+	return serviceRequestOrderDetailParameterElementMetadata
+}
+
+// ServiceRequestOrderDetailParameterValue is the type of ServiceRequest.orderDetail.parameter.value[x]. It is implemented by the ServiceRequestOrderDetailParameterValue* variant types.
+type ServiceRequestOrderDetailParameterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isServiceRequestOrderDetailParameterValue()
+}
+
+// ServiceRequestOrderDetailParameterValueQuantity is the Quantity variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValueQuantity struct {
+	Quantity
+}
+
+func (v ServiceRequestOrderDetailParameterValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v ServiceRequestOrderDetailParameterValueQuantity) isServiceRequestOrderDetailParameterValue() {
+}
+
+// ServiceRequestOrderDetailParameterValueRatio is the Ratio variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValueRatio struct {
+	Ratio
+}
+
+func (v ServiceRequestOrderDetailParameterValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v ServiceRequestOrderDetailParameterValueRatio) isServiceRequestOrderDetailParameterValue() {}
+
+// ServiceRequestOrderDetailParameterValueRange is the Range variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValueRange struct {
+	Range
+}
+
+func (v ServiceRequestOrderDetailParameterValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v ServiceRequestOrderDetailParameterValueRange) isServiceRequestOrderDetailParameterValue() {}
+
+// ServiceRequestOrderDetailParameterValueBoolean is the boolean variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValueBoolean bool
+
+func (v ServiceRequestOrderDetailParameterValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ServiceRequestOrderDetailParameterValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ServiceRequestOrderDetailParameterValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ServiceRequestOrderDetailParameterValueBoolean) isServiceRequestOrderDetailParameterValue() {}
+
+// ServiceRequestOrderDetailParameterValueCodeableConcept is the CodeableConcept variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v ServiceRequestOrderDetailParameterValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v ServiceRequestOrderDetailParameterValueCodeableConcept) isServiceRequestOrderDetailParameterValue() {
+}
+
+// ServiceRequestOrderDetailParameterValueString is the string variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValueString string
+
+func (v ServiceRequestOrderDetailParameterValueString) Validate() error {
+	return nil
+}
+
+func (v ServiceRequestOrderDetailParameterValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ServiceRequestOrderDetailParameterValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ServiceRequestOrderDetailParameterValueString) isServiceRequestOrderDetailParameterValue() {}
+
+// ServiceRequestOrderDetailParameterValuePeriod is the Period variant of ServiceRequestOrderDetailParameterValue.
+type ServiceRequestOrderDetailParameterValuePeriod struct {
+	Period
+}
+
+func (v ServiceRequestOrderDetailParameterValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v ServiceRequestOrderDetailParameterValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v ServiceRequestOrderDetailParameterValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v ServiceRequestOrderDetailParameterValuePeriod) isServiceRequestOrderDetailParameterValue() {}
+
+var serviceRequestOrderDetailParameterValueVariants = []ServiceRequestOrderDetailParameterValue{
+	ServiceRequestOrderDetailParameterValueQuantity{},
+	ServiceRequestOrderDetailParameterValueRatio{},
+	ServiceRequestOrderDetailParameterValueRange{},
+	ServiceRequestOrderDetailParameterValueBoolean(false),
+	ServiceRequestOrderDetailParameterValueCodeableConcept{},
+	ServiceRequestOrderDetailParameterValueString(""),
+	ServiceRequestOrderDetailParameterValuePeriod{},
+}
+
+type ServiceRequestPatientInstruction struct {
+	Id                 *string                                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Instruction        ServiceRequestPatientInstructionInstruction `json:"-" bson:"instruction,omitempty"`                                  // Patient or consumer-oriented instructions
+	InstructionElement *Element                                    `json:"-" bson:"instruction_element,omitempty"`                          // Extensions for instruction[x]
+
+	instructionVariants []string // JSON properties of ServiceRequest.patientInstruction.instruction[x] when more than one was decoded
+}
+
+func (r *ServiceRequestPatientInstruction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.instructionVariants) > 1 {
+		return fmt.Errorf("field 'Instruction' must have a single type, got %v", r.instructionVariants)
+	}
+	if r.Instruction != nil {
+		if err := r.Instruction.Validate(); err != nil {
+			return fmt.Errorf("Instruction: %w", err)
+		}
+	}
+	if r.InstructionElement != nil {
+		if err := r.InstructionElement.Validate(); err != nil {
+			return fmt.Errorf("InstructionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ServiceRequestPatientInstruction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.instructionVariants) > 1 {
+		issues.add("structure", path+".instruction", fmt.Sprintf("field 'Instruction' must have a single type, got %v", r.instructionVariants))
+	}
+	if r.Instruction != nil {
+		r.Instruction.validateAll(path+".instruction.ofType("+r.Instruction.FHIRType()+")", issues)
+	}
+	if r.InstructionElement != nil {
+		r.InstructionElement.validateAll(path+".instruction", issues)
+	}
+}
+
+var serviceRequestPatientInstructionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ServiceRequestPatientInstruction) invariants() []invariant {
+	return serviceRequestPatientInstructionInvariants
+}
+
+func (r ServiceRequestPatientInstruction) MarshalJSON() ([]byte, error) {
+	type alias ServiceRequestPatientInstruction
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "instruction", r.Instruction, r.InstructionElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ServiceRequestPatientInstruction) UnmarshalJSON(data []byte) error {
+	type alias ServiceRequestPatientInstruction
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Instruction, element, r.instructionVariants, err = unmarshalChoice(fields, "instruction", serviceRequestPatientInstructionInstructionVariants); err != nil {
+		return err
+	}
+	r.InstructionElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.InstructionElement); err != nil {
+			return fmt.Errorf("_instruction: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ServiceRequestPatientInstruction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ServiceRequestPatientInstruction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ServiceRequestPatientInstruction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ServiceRequestPatientInstruction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ServiceRequestPatientInstruction) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Instruction":
+		return "instruction", choiceValues(serviceRequestPatientInstructionInstructionVariants)
+	}
+	return "", nil
+}
+
+var serviceRequestPatientInstructionElementMetadata = []ElementMetadata{
+	{Path: "ServiceRequest.patientInstruction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ServiceRequest.patientInstruction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ServiceRequest.patientInstruction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ServiceRequest.patientInstruction.instruction[x]", Name: "instruction", Field: 3, Min: 0, Max: "1", Types: []string{"markdown", "Reference"}},
+}
+
+func (r *ServiceRequestPatientInstruction) ElementMetadata() []ElementMetadata {
+	return serviceRequestPatientInstructionElementMetadata
+}
+
+// ServiceRequestPatientInstructionInstruction is the type of ServiceRequest.patientInstruction.instruction[x]. It is implemented by the ServiceRequestPatientInstructionInstruction* variant types.
+type ServiceRequestPatientInstructionInstruction interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isServiceRequestPatientInstructionInstruction()
+}
+
+// ServiceRequestPatientInstructionInstructionMarkdown is the markdown variant of ServiceRequestPatientInstructionInstruction.
+type ServiceRequestPatientInstructionInstructionMarkdown string
+
+func (v ServiceRequestPatientInstructionInstructionMarkdown) Validate() error {
+	return nil
+}
+
+func (v ServiceRequestPatientInstructionInstructionMarkdown) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ServiceRequestPatientInstructionInstructionMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v ServiceRequestPatientInstructionInstructionMarkdown) isServiceRequestPatientInstructionInstruction() {
+}
+
+// ServiceRequestPatientInstructionInstructionReference is the Reference variant of ServiceRequestPatientInstructionInstruction.
+type ServiceRequestPatientInstructionInstructionReference struct {
+	Reference
+}
+
+func (v ServiceRequestPatientInstructionInstructionReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v ServiceRequestPatientInstructionInstructionReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v ServiceRequestPatientInstructionInstructionReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v ServiceRequestPatientInstructionInstructionReference) isServiceRequestPatientInstructionInstruction() {
+}
+
+var serviceRequestPatientInstructionInstructionVariants = []ServiceRequestPatientInstructionInstruction{
+	ServiceRequestPatientInstructionInstructionMarkdown(""),
+	ServiceRequestPatientInstructionInstructionReference{},
 }
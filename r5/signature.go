@@ -1,36 +1,185 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 // Signature Type: A signature along with supporting context. The signature may be a digital signature that is cryptographic in nature, or some other signature acceptable to the domain. This other signature may be as simple as a graphical image representing a hand-written signature, or a signature ceremony Different signature approaches have different utilities.
 type Signature struct {
-	Id           *string    `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Type         []Coding   `json:"type,omitempty" bson:"type,omitempty"`                  // Indication of the reason the entity signed the object(s)
-	When         *string    `json:"when,omitempty" bson:"when,omitempty"`                  // When the signature was created
-	Who          *Reference `json:"who,omitempty" bson:"who,omitempty"`                    // Who signed
-	OnBehalfOf   *Reference `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"`    // The party represented
-	TargetFormat *string    `json:"targetFormat,omitempty" bson:"target_format,omitempty"` // The technical format of the signed resources
-	SigFormat    *string    `json:"sigFormat,omitempty" bson:"sig_format,omitempty"`       // The technical format of the signature
-	Data         *string    `json:"data,omitempty" bson:"data,omitempty"`                  // The actual signature content (XML Signature, JSON Jose, picture, etc.)
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                               // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                 // Additional content defined by implementations
+	Type                []Coding    `json:"type,omitempty" bson:"type,omitempty"`                           // Indication of the reason the entity signed the object(s)
+	When                *Instant    `json:"when,omitempty" bson:"when,omitempty"`                           // When the signature was created
+	WhenElement         *Element    `json:"_when,omitempty" bson:"when_element,omitempty"`                  // Extensions for when
+	Who                 *Reference  `json:"who,omitempty" bson:"who,omitempty"`                             // Who signed
+	OnBehalfOf          *Reference  `json:"onBehalfOf,omitempty" bson:"on_behalf_of,omitempty"`             // The party represented
+	TargetFormat        *string     `json:"targetFormat,omitempty" bson:"target_format,omitempty"`          // The technical format of the signed resources
+	TargetFormatElement *Element    `json:"_targetFormat,omitempty" bson:"target_format_element,omitempty"` // Extensions for targetFormat
+	SigFormat           *string     `json:"sigFormat,omitempty" bson:"sig_format,omitempty"`                // The technical format of the signature
+	SigFormatElement    *Element    `json:"_sigFormat,omitempty" bson:"sig_format_element,omitempty"`       // Extensions for sigFormat
+	Data                *string     `json:"data,omitempty" bson:"data,omitempty"`                           // The actual signature content (XML Signature, JSON Jose, picture, etc.)
+	DataElement         *Element    `json:"_data,omitempty" bson:"data_element,omitempty"`                  // Extensions for data
 }
 
+var signatureWhoTargets = []string{"Practitioner", "PractitionerRole", "RelatedPerson", "Patient", "Device", "Organization"}
+
+var signatureOnBehalfOfTargets = []string{"Practitioner", "PractitionerRole", "RelatedPerson", "Patient", "Device", "Organization"}
+
 func (r *Signature) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Type {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Type[%d]: %w", i, err)
 		}
 	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			return fmt.Errorf("When: %w", err)
+		}
+	}
+	if r.WhenElement != nil {
+		if err := r.WhenElement.Validate(); err != nil {
+			return fmt.Errorf("WhenElement: %w", err)
+		}
+	}
+	if r.Who != nil {
+		if target := invalidReferenceTarget(r.Who.Reference, r.Who.Type, signatureWhoTargets); target != "" {
+			return fmt.Errorf("field 'Who' cannot refer to %s, expected one of %v", target, signatureWhoTargets)
+		}
+	}
 	if r.Who != nil {
 		if err := r.Who.Validate(); err != nil {
 			return fmt.Errorf("Who: %w", err)
 		}
 	}
+	if r.OnBehalfOf != nil {
+		if target := invalidReferenceTarget(r.OnBehalfOf.Reference, r.OnBehalfOf.Type, signatureOnBehalfOfTargets); target != "" {
+			return fmt.Errorf("field 'OnBehalfOf' cannot refer to %s, expected one of %v", target, signatureOnBehalfOfTargets)
+		}
+	}
 	if r.OnBehalfOf != nil {
 		if err := r.OnBehalfOf.Validate(); err != nil {
 			return fmt.Errorf("OnBehalfOf: %w", err)
 		}
 	}
+	if r.TargetFormatElement != nil {
+		if err := r.TargetFormatElement.Validate(); err != nil {
+			return fmt.Errorf("TargetFormatElement: %w", err)
+		}
+	}
+	if r.SigFormatElement != nil {
+		if err := r.SigFormatElement.Validate(); err != nil {
+			return fmt.Errorf("SigFormatElement: %w", err)
+		}
+	}
+	if r.DataElement != nil {
+		if err := r.DataElement.Validate(); err != nil {
+			return fmt.Errorf("DataElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *Signature) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.Type {
+		item.validateAll(fmt.Sprintf("%s.type[%d]", path, i), issues)
+	}
+	if r.When != nil {
+		if err := r.When.Validate(); err != nil {
+			issues.add("value", path+".when", err.Error())
+		}
+	}
+	if r.WhenElement != nil {
+		r.WhenElement.validateAll(path+".when", issues)
+	}
+	if r.Who != nil {
+		if target := invalidReferenceTarget(r.Who.Reference, r.Who.Type, signatureWhoTargets); target != "" {
+			issues.add("value", path+".who", fmt.Sprintf("field 'Who' cannot refer to %s, expected one of %v", target, signatureWhoTargets))
+		}
+	}
+	if r.Who != nil {
+		r.Who.validateAll(path+".who", issues)
+	}
+	if r.OnBehalfOf != nil {
+		if target := invalidReferenceTarget(r.OnBehalfOf.Reference, r.OnBehalfOf.Type, signatureOnBehalfOfTargets); target != "" {
+			issues.add("value", path+".onBehalfOf", fmt.Sprintf("field 'OnBehalfOf' cannot refer to %s, expected one of %v", target, signatureOnBehalfOfTargets))
+		}
+	}
+	if r.OnBehalfOf != nil {
+		r.OnBehalfOf.validateAll(path+".onBehalfOf", issues)
+	}
+	if r.TargetFormatElement != nil {
+		r.TargetFormatElement.validateAll(path+".targetFormat", issues)
+	}
+	if r.SigFormatElement != nil {
+		r.SigFormatElement.validateAll(path+".sigFormat", issues)
+	}
+	if r.DataElement != nil {
+		r.DataElement.validateAll(path+".data", issues)
+	}
+}
+
+func (r *Signature) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Signature", &issues)
+	checkInvariants(r, "Signature", &issues)
+	return issues
+}
+
+var signatureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Signature) invariants() []invariant {
+	return signatureInvariants
+}
+
+func (r Signature) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Signature) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Signature) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Signature) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var signatureElementTypes = map[string]string{
+	"targetFormat": "code",
+	"sigFormat":    "code",
+	"data":         "base64Binary",
+}
+
+func (r *Signature) elementTypes() map[string]string {
+	return signatureElementTypes
+}
+
+var signatureElementMetadata = []ElementMetadata{
+	{Path: "Signature.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Signature.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Signature.type", Name: "type", Field: 2, Min: 0, Max: "*", Types: []string{"Coding"}, Binding: &BindingDefinition{Path: "Signature.type", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/signature-type", Description: "An indication of the reason that an entity signed the object."}, Summary: true},
+	{Path: "Signature.when", Name: "when", Field: 3, Min: 0, Max: "1", Types: []string{"instant"}, Summary: true},
+	{Path: "Signature.who", Name: "who", Field: 5, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization"}, Summary: true},
+	{Path: "Signature.onBehalfOf", Name: "onBehalfOf", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Organization"}, Summary: true},
+	{Path: "Signature.targetFormat", Name: "targetFormat", Field: 7, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Signature.targetFormat", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/mimetypes", Description: "BCP 13 (RFCs 2045, 2046, 2047, 4288, 4289 and 2049)"}},
+	{Path: "Signature.sigFormat", Name: "sigFormat", Field: 9, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Signature.sigFormat", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/mimetypes", Description: "BCP 13 (RFCs 2045, 2046, 2047, 4288, 4289 and 2049)"}},
+	{Path: "Signature.data", Name: "data", Field: 11, Min: 0, Max: "1", Types: []string{"base64Binary"}},
+}
+
+func (r *Signature) ElementMetadata() []ElementMetadata {
+	return signatureElementMetadata
+}
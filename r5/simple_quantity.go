@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
@@ -17,3 +18,51 @@ func (r *SimpleQuantity) Validate() error {
 	}
 	return nil
 }
+
+func (r *SimpleQuantity) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Quantity {
+		item.validateAll(fmt.Sprintf("%s.Quantity[%d]", path, i), issues)
+	}
+}
+
+func (r *SimpleQuantity) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SimpleQuantity", &issues)
+	checkInvariants(r, "SimpleQuantity", &issues)
+	return issues
+}
+
+var simpleQuantityInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "qty-3", severity: "error", human: "If a code for the unit is present, the system SHALL also be present", expression: "code.empty() or system.exists()"},
+	{key: "qty-4", severity: "warning", human: "Processing UCUM codes with annotations ({..}) can be misleading.", expression: "code.exists() implies code.matches('\\\\{.*?\\\\}').not()"},
+	{key: "sqty-1", severity: "error", human: "The comparator is not used on a SimpleQuantity", expression: "comparator.empty()"},
+}
+
+func (r *SimpleQuantity) invariants() []invariant {
+	return simpleQuantityInvariants
+}
+
+func (r SimpleQuantity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SimpleQuantity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SimpleQuantity) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SimpleQuantity) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var simpleQuantityElementMetadata = []ElementMetadata{
+	{Path: "Quantity", Name: "Quantity", Field: 0, Min: 0, Max: "*"},
+}
+
+func (r *SimpleQuantity) ElementMetadata() []ElementMetadata {
+	return simpleQuantityElementMetadata
+}
@@ -2,31 +2,43 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A slot of time on a schedule that may be available for booking appointments.
 type Slot struct {
-	ResourceType    string              `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id              *string             `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta            *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules   *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language        *string             `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	Text            *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                        // Text summary of the resource, for human interpretation
-	Contained       []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`              // Contained, inline Resources
-	Identifier      []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`            // External Ids for this item
-	ServiceCategory []CodeableConcept   `json:"serviceCategory,omitempty" bson:"service_category,omitempty"` // A broad categorization of the service that is to be performed during this appointment
-	ServiceType     []CodeableReference `json:"serviceType,omitempty" bson:"service_type,omitempty"`         // The type of appointments that can be booked into this slot (ideally this would be an identifiable service - which is at a location, rather than the location itself). If provided then this overrides the value provided on the Schedule resource
-	Specialty       []CodeableConcept   `json:"specialty,omitempty" bson:"specialty,omitempty"`              // The specialty of a practitioner that would be required to perform the service requested in this appointment
-	AppointmentType []CodeableConcept   `json:"appointmentType,omitempty" bson:"appointment_type,omitempty"` // The style of appointment or patient that may be booked in the slot (not service type)
-	Schedule        *Reference          `json:"schedule" bson:"schedule"`                                    // The schedule resource that this slot defines an interval of status information
-	Status          string              `json:"status" bson:"status"`                                        // busy | free | busy-unavailable | busy-tentative | entered-in-error
-	Start           string              `json:"start" bson:"start"`                                          // Date/Time that the slot is to begin
-	End             string              `json:"end" bson:"end"`                                              // Date/Time that the slot is to conclude
-	Overbooked      *bool               `json:"overbooked,omitempty" bson:"overbooked,omitempty"`            // This slot has already been overbooked, appointments are unlikely to be accepted for this time
-	Comment         *string             `json:"comment,omitempty" bson:"comment,omitempty"`                  // Comments on the slot to describe any extended information. Such as custom constraints on the slot
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // External Ids for this item
+	ServiceCategory      []CodeableConcept   `json:"serviceCategory,omitempty" bson:"service_category,omitempty"`      // A broad categorization of the service that is to be performed during this appointment
+	ServiceType          []CodeableReference `json:"serviceType,omitempty" bson:"service_type,omitempty"`              // The type of appointments that can be booked into this slot (ideally this would be an identifiable service - which is at a location, rather than the location itself). If provided then this overrides the value provided on the Schedule resource
+	Specialty            []CodeableConcept   `json:"specialty,omitempty" bson:"specialty,omitempty"`                   // The specialty of a practitioner that would be required to perform the service requested in this appointment
+	AppointmentType      []CodeableConcept   `json:"appointmentType,omitempty" bson:"appointment_type,omitempty"`      // The style of appointment or patient that may be booked in the slot (not service type)
+	Schedule             *Reference          `json:"schedule" bson:"schedule"`                                         // The schedule resource that this slot defines an interval of status information
+	Status               SlotStatus          `json:"status" bson:"status"`                                             // busy | free | busy-unavailable | busy-tentative | entered-in-error
+	StatusElement        *Element            `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Start                *Instant            `json:"start" bson:"start"`                                               // Date/Time that the slot is to begin
+	StartElement         *Element            `json:"_start,omitempty" bson:"start_element,omitempty"`                  // Extensions for start
+	End                  *Instant            `json:"end" bson:"end"`                                                   // Date/Time that the slot is to conclude
+	EndElement           *Element            `json:"_end,omitempty" bson:"end_element,omitempty"`                      // Extensions for end
+	Overbooked           *bool               `json:"overbooked,omitempty" bson:"overbooked,omitempty"`                 // This slot has already been overbooked, appointments are unlikely to be accepted for this time
+	OverbookedElement    *Element            `json:"_overbooked,omitempty" bson:"overbooked_element,omitempty"`        // Extensions for overbooked
+	Comment              *string             `json:"comment,omitempty" bson:"comment,omitempty"`                       // Comments on the slot to describe any extended information. Such as custom constraints on the slot
+	CommentElement       *Element            `json:"_comment,omitempty" bson:"comment_element,omitempty"`              // Extensions for comment
 }
 
+var slotScheduleTargets = []string{"Schedule"}
+
 func (r *Slot) Validate() error {
 	if r.ResourceType != "Slot" {
 		return fmt.Errorf("invalid resourceType: expected 'Slot', got '%s'", r.ResourceType)
@@ -36,11 +48,39 @@ func (r *Slot) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -69,20 +109,287 @@ func (r *Slot) Validate() error {
 	if r.Schedule == nil {
 		return fmt.Errorf("field 'Schedule' is required")
 	}
+	if r.Schedule != nil {
+		if target := invalidReferenceTarget(r.Schedule.Reference, r.Schedule.Type, slotScheduleTargets); target != "" {
+			return fmt.Errorf("field 'Schedule' cannot refer to %s, expected one of %v", target, slotScheduleTargets)
+		}
+	}
 	if r.Schedule != nil {
 		if err := r.Schedule.Validate(); err != nil {
 			return fmt.Errorf("Schedule: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Start == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Start == nil {
 		return fmt.Errorf("field 'Start' is required")
 	}
-	if r.End == emptyString {
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			return fmt.Errorf("Start: %w", err)
+		}
+	}
+	if r.StartElement != nil {
+		if err := r.StartElement.Validate(); err != nil {
+			return fmt.Errorf("StartElement: %w", err)
+		}
+	}
+	if r.End == nil {
 		return fmt.Errorf("field 'End' is required")
 	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			return fmt.Errorf("End: %w", err)
+		}
+	}
+	if r.EndElement != nil {
+		if err := r.EndElement.Validate(); err != nil {
+			return fmt.Errorf("EndElement: %w", err)
+		}
+	}
+	if r.OverbookedElement != nil {
+		if err := r.OverbookedElement.Validate(); err != nil {
+			return fmt.Errorf("OverbookedElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "Slot")
+}
+
+func (r *Slot) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Slot" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Slot', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceCategory {
+		item.validateAll(fmt.Sprintf("%s.serviceCategory[%d]", path, i), issues)
+	}
+	for i, item := range r.ServiceType {
+		item.validateAll(fmt.Sprintf("%s.serviceType[%d]", path, i), issues)
+	}
+	for i, item := range r.Specialty {
+		item.validateAll(fmt.Sprintf("%s.specialty[%d]", path, i), issues)
+	}
+	for i, item := range r.AppointmentType {
+		item.validateAll(fmt.Sprintf("%s.appointmentType[%d]", path, i), issues)
+	}
+	if r.Schedule == nil {
+		issues.add("required", path+".schedule", "field 'Schedule' is required")
+	}
+	if r.Schedule != nil {
+		if target := invalidReferenceTarget(r.Schedule.Reference, r.Schedule.Type, slotScheduleTargets); target != "" {
+			issues.add("value", path+".schedule", fmt.Sprintf("field 'Schedule' cannot refer to %s, expected one of %v", target, slotScheduleTargets))
+		}
+	}
+	if r.Schedule != nil {
+		r.Schedule.validateAll(path+".schedule", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Start == nil {
+		issues.add("required", path+".start", "field 'Start' is required")
+	}
+	if r.Start != nil {
+		if err := r.Start.Validate(); err != nil {
+			issues.add("value", path+".start", err.Error())
+		}
+	}
+	if r.StartElement != nil {
+		r.StartElement.validateAll(path+".start", issues)
+	}
+	if r.End == nil {
+		issues.add("required", path+".end", "field 'End' is required")
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			issues.add("value", path+".end", err.Error())
+		}
+	}
+	if r.EndElement != nil {
+		r.EndElement.validateAll(path+".end", issues)
+	}
+	if r.OverbookedElement != nil {
+		r.OverbookedElement.validateAll(path+".overbooked", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+}
+
+func (r *Slot) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Slot", &issues)
+	checkInvariants(r, "Slot", &issues)
+	return issues
+}
+
+var slotInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Slot) invariants() []invariant {
+	return slotInvariants
+}
+
+func (r *Slot) UnmarshalJSON(data []byte) error {
+	type alias Slot
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r Slot) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Slot) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Slot) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Slot) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var slotElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Slot) elementTypes() map[string]string {
+	return slotElementTypes
+}
+
+var slotElementMetadata = []ElementMetadata{
+	{Path: "Slot.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Slot.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Slot.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Slot.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Slot.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Slot.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Slot.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Slot.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Slot.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Slot.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Slot.serviceCategory", Name: "serviceCategory", Field: 12, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Slot.serviceType", Name: "serviceType", Field: 13, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Slot.specialty", Name: "specialty", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Slot.appointmentType", Name: "appointmentType", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Slot.schedule", Name: "schedule", Field: 16, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Schedule"}},
+	{Path: "Slot.status", Name: "status", Field: 17, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Slot.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/slotstatus"}},
+	{Path: "Slot.start", Name: "start", Field: 19, Min: 1, Max: "1", Types: []string{"instant"}},
+	{Path: "Slot.end", Name: "end", Field: 21, Min: 1, Max: "1", Types: []string{"instant"}},
+	{Path: "Slot.overbooked", Name: "overbooked", Field: 23, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Slot.comment", Name: "comment", Field: 25, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *Slot) ElementMetadata() []ElementMetadata {
+	return slotElementMetadata
+}
+
+func (r *Slot) GetResourceType() string {
+	return "Slot"
+}
+
+func (r *Slot) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Slot) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Slot) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Slot) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Slot) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Slot) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Slot) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Slot)(nil)
@@ -1,9 +1,121 @@
 package models
 
-// Generates a [StructureDefinition](structuredefinition.html) instance  with  a snapshot, based on a differential in a specified [StructureDefinition](structuredefinition.html).     If the operation is not called at the instance level, either *definition* or *url* 'in' parameters must be provided. If more than one is specified, servers may raise an error or may resolve with the parameter of their choice. If called at the instance level, these parameters will be ignored. Snapshot generation is dependent on profiles that are referenced - if those profiles change, the snapshot can change. For a frozen package, $snapshot is idempotent.
-type Snapshot struct {
+import "fmt"
+
+// SnapshotIn holds the input parameters of the $snapshot operation. Generates a [StructureDefinition](structuredefinition.html) instance  with  a snapshot, based on a differential in a specified [StructureDefinition](structuredefinition.html).     If the operation is not called at the instance level, either *definition* or *url* 'in' parameters must be provided. If more than one is specified, servers may raise an error or may resolve with the parameter of their choice. If called at the instance level, these parameters will be ignored. Snapshot generation is dependent on profiles that are referenced - if those profiles change, the snapshot can change. For a frozen package, $snapshot is idempotent.
+type SnapshotIn struct {
+	// The StructureDefinition is provided directly as part of the request
+	Definition *StructureDefinition
+	// The StructureDefinition's canonical URL
+	Url *string
+}
+
+func (p *SnapshotIn) Validate() error {
+	return nil
+}
+
+func (p *SnapshotIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *SnapshotIn) FromParameters(params *Parameters) error {
+	*p = SnapshotIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *SnapshotIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Definition != nil {
+		params = append(params, ParametersParameter{Name: "definition", Resource: p.Definition})
+	}
+	if p.Url != nil {
+		params = append(params, ParametersParameter{Name: "url", Value: ParametersParameterValueString(*p.Url)})
+	}
+	return params
+}
+
+func (p *SnapshotIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "definition":
+			if p.Definition != nil {
+				return fmt.Errorf("parameter 'definition' must occur at most once")
+			}
+			v, err := parameterResource[*StructureDefinition](param.Name, param.Resource, "StructureDefinition")
+			if err != nil {
+				return err
+			}
+			p.Definition = v
+		case "url":
+			if p.Url != nil {
+				return fmt.Errorf("parameter 'url' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Url = &value
+		}
+	}
+	return nil
+}
+
+// SnapshotOut holds the output parameters of the $snapshot operation.
+type SnapshotOut struct {
+	// The structure definition with a snapshot
+	Return *StructureDefinition
+}
+
+func (p *SnapshotOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *SnapshotOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *SnapshotOut) FromParameters(params *Parameters) error {
+	*p = SnapshotOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *SnapshotOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Snapshot) Validate() error {
+func (p *SnapshotOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*StructureDefinition](param.Name, param.Resource, "StructureDefinition")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
@@ -2,35 +2,49 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A sample to be used for analysis.
 type Specimen struct {
-	ResourceType  string               `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string              `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string              `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage    `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Primary specimen identifier
-	Status        *string              `json:"status,omitempty" bson:"status,omitempty"`                // available | unavailable | unsatisfactory | entered-in-error
-	Type          *CodeableConcept     `json:"type,omitempty" bson:"type,omitempty"`                    // Kind of material that forms the specimen
-	Subject       *Reference           `json:"subject,omitempty" bson:"subject,omitempty"`              // Where the specimen came from. This may be from patient(s), from a location (e.g., the source of an environmental sample), or a sampling of a substance, a biologically-derived product, or a device
-	ReceivedTime  *string              `json:"receivedTime,omitempty" bson:"received_time,omitempty"`   // The time when specimen is received by the testing laboratory
-	Parent        []Reference          `json:"parent,omitempty" bson:"parent,omitempty"`                // Specimen from which this specimen originated
-	Request       []Reference          `json:"request,omitempty" bson:"request,omitempty"`              // Why the specimen was collected
-	Combined      *string              `json:"combined,omitempty" bson:"combined,omitempty"`            // grouped | pooled
-	Role          []CodeableConcept    `json:"role,omitempty" bson:"role,omitempty"`                    // The role the specimen serves
-	Feature       []SpecimenFeature    `json:"feature,omitempty" bson:"feature,omitempty"`              // The physical feature of a specimen
-	Collection    *SpecimenCollection  `json:"collection,omitempty" bson:"collection,omitempty"`        // Collection details
-	Processing    []SpecimenProcessing `json:"processing,omitempty" bson:"processing,omitempty"`        // Processing and processing step details
-	Container     []SpecimenContainer  `json:"container,omitempty" bson:"container,omitempty"`          // Direct container of specimen (tube/slide, etc.)
-	Condition     []CodeableConcept    `json:"condition,omitempty" bson:"condition,omitempty"`          // State of the specimen
-	Note          []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                    // Comments
+	ResourceType         string               `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string              `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element             `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string              `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element             `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource           `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Primary specimen identifier
+	Status               *SpecimenStatus      `json:"status,omitempty" bson:"status,omitempty"`                         // available | unavailable | unsatisfactory | entered-in-error
+	StatusElement        *Element             `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Type                 *CodeableConcept     `json:"type,omitempty" bson:"type,omitempty"`                             // Kind of material that forms the specimen
+	Subject              *Reference           `json:"subject,omitempty" bson:"subject,omitempty"`                       // Where the specimen came from. This may be from patient(s), from a location (e.g., the source of an environmental sample), or a sampling of a substance, a biologically-derived product, or a device
+	ReceivedTime         *DateTime            `json:"receivedTime,omitempty" bson:"received_time,omitempty"`            // The time when specimen is received by the testing laboratory
+	ReceivedTimeElement  *Element             `json:"_receivedTime,omitempty" bson:"received_time_element,omitempty"`   // Extensions for receivedTime
+	Parent               []Reference          `json:"parent,omitempty" bson:"parent,omitempty"`                         // Specimen from which this specimen originated
+	Request              []Reference          `json:"request,omitempty" bson:"request,omitempty"`                       // Why the specimen was collected
+	Combined             *SpecimenCombined    `json:"combined,omitempty" bson:"combined,omitempty"`                     // grouped | pooled
+	CombinedElement      *Element             `json:"_combined,omitempty" bson:"combined_element,omitempty"`            // Extensions for combined
+	Role                 []CodeableConcept    `json:"role,omitempty" bson:"role,omitempty"`                             // The role the specimen serves
+	Feature              []SpecimenFeature    `json:"feature,omitempty" bson:"feature,omitempty"`                       // The physical feature of a specimen
+	Collection           *SpecimenCollection  `json:"collection,omitempty" bson:"collection,omitempty"`                 // Collection details
+	Processing           []SpecimenProcessing `json:"processing,omitempty" bson:"processing,omitempty"`                 // Processing and processing step details
+	Container            []SpecimenContainer  `json:"container,omitempty" bson:"container,omitempty"`                   // Direct container of specimen (tube/slide, etc.)
+	Condition            []CodeableConcept    `json:"condition,omitempty" bson:"condition,omitempty"`                   // State of the specimen
+	Note                 []Annotation         `json:"note,omitempty" bson:"note,omitempty"`                             // Comments
 }
 
+var specimenSubjectTargets = []string{"BiologicallyDerivedProduct", "Device", "Group", "Location", "NutritionProduct", "Patient", "Substance"}
+
+var specimenParentTargets = []string{"Specimen"}
+
+var specimenRequestTargets = []string{"ServiceRequest"}
+
 func (r *Specimen) Validate() error {
 	if r.ResourceType != "Specimen" {
 		return fmt.Errorf("invalid resourceType: expected 'Specimen', got '%s'", r.ResourceType)
@@ -40,36 +54,105 @@ func (r *Specimen) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, specimenSubjectTargets); target != "" {
+			return fmt.Errorf("field 'Subject' cannot refer to %s, expected one of %v", target, specimenSubjectTargets)
+		}
+	}
 	if r.Subject != nil {
 		if err := r.Subject.Validate(); err != nil {
 			return fmt.Errorf("Subject: %w", err)
 		}
 	}
+	if r.ReceivedTime != nil {
+		if err := r.ReceivedTime.Validate(); err != nil {
+			return fmt.Errorf("ReceivedTime: %w", err)
+		}
+	}
+	if r.ReceivedTimeElement != nil {
+		if err := r.ReceivedTimeElement.Validate(); err != nil {
+			return fmt.Errorf("ReceivedTimeElement: %w", err)
+		}
+	}
+	for i, item := range r.Parent {
+		if target := invalidReferenceTarget(item.Reference, item.Type, specimenParentTargets); target != "" {
+			return fmt.Errorf("field 'Parent[%d]' cannot refer to %s, expected one of %v", i, target, specimenParentTargets)
+		}
+	}
 	for i, item := range r.Parent {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Parent[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.Request {
+		if target := invalidReferenceTarget(item.Reference, item.Type, specimenRequestTargets); target != "" {
+			return fmt.Errorf("field 'Request[%d]' cannot refer to %s, expected one of %v", i, target, specimenRequestTargets)
+		}
+	}
 	for i, item := range r.Request {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Request[%d]: %w", i, err)
 		}
 	}
+	if r.Combined != nil && !r.Combined.IsValid() {
+		return fmt.Errorf("field 'Combined' has invalid code '%s'", *r.Combined)
+	}
+	if r.CombinedElement != nil {
+		if err := r.CombinedElement.Validate(); err != nil {
+			return fmt.Errorf("CombinedElement: %w", err)
+		}
+	}
 	for i, item := range r.Role {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Role[%d]: %w", i, err)
@@ -105,16 +188,266 @@ func (r *Specimen) Validate() error {
 			return fmt.Errorf("Note[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "Specimen")
+}
+
+func (r *Specimen) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Specimen" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Specimen', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Subject != nil {
+		if target := invalidReferenceTarget(r.Subject.Reference, r.Subject.Type, specimenSubjectTargets); target != "" {
+			issues.add("value", path+".subject", fmt.Sprintf("field 'Subject' cannot refer to %s, expected one of %v", target, specimenSubjectTargets))
+		}
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject", issues)
+	}
+	if r.ReceivedTime != nil {
+		if err := r.ReceivedTime.Validate(); err != nil {
+			issues.add("value", path+".receivedTime", err.Error())
+		}
+	}
+	if r.ReceivedTimeElement != nil {
+		r.ReceivedTimeElement.validateAll(path+".receivedTime", issues)
+	}
+	for i, item := range r.Parent {
+		if target := invalidReferenceTarget(item.Reference, item.Type, specimenParentTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.parent[%d]", path, i), fmt.Sprintf("field 'Parent[%d]' cannot refer to %s, expected one of %v", i, target, specimenParentTargets))
+		}
+	}
+	for i, item := range r.Parent {
+		item.validateAll(fmt.Sprintf("%s.parent[%d]", path, i), issues)
+	}
+	for i, item := range r.Request {
+		if target := invalidReferenceTarget(item.Reference, item.Type, specimenRequestTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.request[%d]", path, i), fmt.Sprintf("field 'Request[%d]' cannot refer to %s, expected one of %v", i, target, specimenRequestTargets))
+		}
+	}
+	for i, item := range r.Request {
+		item.validateAll(fmt.Sprintf("%s.request[%d]", path, i), issues)
+	}
+	if r.Combined != nil && !r.Combined.IsValid() {
+		issues.add("code-invalid", path+".combined", fmt.Sprintf("field 'Combined' has invalid code '%s'", *r.Combined))
+	}
+	if r.CombinedElement != nil {
+		r.CombinedElement.validateAll(path+".combined", issues)
+	}
+	for i, item := range r.Role {
+		item.validateAll(fmt.Sprintf("%s.role[%d]", path, i), issues)
+	}
+	for i, item := range r.Feature {
+		item.validateAll(fmt.Sprintf("%s.feature[%d]", path, i), issues)
+	}
+	if r.Collection != nil {
+		r.Collection.validateAll(path+".collection", issues)
+	}
+	for i, item := range r.Processing {
+		item.validateAll(fmt.Sprintf("%s.processing[%d]", path, i), issues)
+	}
+	for i, item := range r.Container {
+		item.validateAll(fmt.Sprintf("%s.container[%d]", path, i), issues)
+	}
+	for i, item := range r.Condition {
+		item.validateAll(fmt.Sprintf("%s.condition[%d]", path, i), issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+func (r *Specimen) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Specimen", &issues)
+	checkInvariants(r, "Specimen", &issues)
+	return issues
+}
+
+var specimenInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Specimen) invariants() []invariant {
+	return specimenInvariants
+}
+
+func (r *Specimen) UnmarshalJSON(data []byte) error {
+	type alias Specimen
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Specimen) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Specimen) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Specimen) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Specimen) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var specimenElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *Specimen) elementTypes() map[string]string {
+	return specimenElementTypes
+}
+
+var specimenElementMetadata = []ElementMetadata{
+	{Path: "Specimen.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Specimen.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Specimen.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Specimen.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Specimen.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Specimen.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Specimen.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Specimen.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Specimen.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Specimen.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Specimen.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Specimen.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/specimen-status"}},
+	{Path: "Specimen.type", Name: "type", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Specimen.subject", Name: "subject", Field: 15, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Substance"}},
+	{Path: "Specimen.receivedTime", Name: "receivedTime", Field: 16, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Specimen.parent", Name: "parent", Field: 18, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Specimen"}},
+	{Path: "Specimen.request", Name: "request", Field: 19, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/ServiceRequest"}},
+	{Path: "Specimen.combined", Name: "combined", Field: 20, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Specimen.combined", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/specimen-combined"}},
+	{Path: "Specimen.role", Name: "role", Field: 22, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Specimen.feature", Name: "feature", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Specimen.collection", Name: "collection", Field: 24, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Specimen.processing", Name: "processing", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Specimen.container", Name: "container", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Specimen.condition", Name: "condition", Field: 27, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Specimen.note", Name: "note", Field: 28, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *Specimen) ElementMetadata() []ElementMetadata {
+	return specimenElementMetadata
+}
+
+func (r *Specimen) GetResourceType() string {
+	return "Specimen"
+}
+
+func (r *Specimen) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Specimen) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Specimen) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Specimen) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Specimen) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Specimen) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Specimen) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Specimen)(nil)
+
 type SpecimenFeature struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type        *CodeableConcept `json:"type" bson:"type"`                 // Highlighted feature
-	Description string           `json:"description" bson:"description"`   // Information about the feature
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type               *CodeableConcept `json:"type" bson:"type"`                                                // Highlighted feature
+	Description        string           `json:"description" bson:"description"`                                  // Information about the feature
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
 }
 
 func (r *SpecimenFeature) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -127,35 +460,129 @@ func (r *SpecimenFeature) Validate() error {
 	if r.Description == emptyString {
 		return fmt.Errorf("field 'Description' is required")
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *SpecimenFeature) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	var emptyString string
+	if r.Description == emptyString {
+		issues.add("required", path+".description", "field 'Description' is required")
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+}
+
+var specimenFeatureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenFeature) invariants() []invariant {
+	return specimenFeatureInvariants
+}
+
+func (r SpecimenFeature) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenFeature) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenFeature) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenFeature) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var specimenFeatureElementMetadata = []ElementMetadata{
+	{Path: "Specimen.feature.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Specimen.feature.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Specimen.feature.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Specimen.feature.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Specimen.feature.description", Name: "description", Field: 4, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SpecimenFeature) ElementMetadata() []ElementMetadata {
+	return specimenFeatureElementMetadata
+}
+
 type SpecimenCollection struct {
-	Id                           *string            `json:"id,omitempty" bson:"id,omitempty"`                                                        // Unique id for inter-element referencing
-	Collector                    *Reference         `json:"collector,omitempty" bson:"collector,omitempty"`                                          // Who collected the specimen
-	CollectedDateTime            *string            `json:"collectedDateTime,omitempty" bson:"collected_date_time,omitempty"`                        // Collection time
-	CollectedPeriod              *Period            `json:"collectedPeriod,omitempty" bson:"collected_period,omitempty"`                             // Collection time
-	Duration                     *Duration          `json:"duration,omitempty" bson:"duration,omitempty"`                                            // How long it took to collect specimen
-	Quantity                     *Quantity          `json:"quantity,omitempty" bson:"quantity,omitempty"`                                            // The quantity of specimen collected
-	Method                       *CodeableConcept   `json:"method,omitempty" bson:"method,omitempty"`                                                // Technique used to perform collection
-	DeviceCodeableConcept        *CodeableConcept   `json:"deviceCodeableConcept,omitempty" bson:"device_codeable_concept,omitempty"`                // Device used to perform collection
-	DeviceReference              *Reference         `json:"deviceReference,omitempty" bson:"device_reference,omitempty"`                             // Device used to perform collection
-	DeviceCanonical              *string            `json:"deviceCanonical,omitempty" bson:"device_canonical,omitempty"`                             // Device used to perform collection
-	Procedure                    *Reference         `json:"procedure,omitempty" bson:"procedure,omitempty"`                                          // The procedure that collects the specimen
-	BodySite                     *CodeableReference `json:"bodySite,omitempty" bson:"body_site,omitempty"`                                           // Anatomical collection site
-	FastingStatusCodeableConcept *CodeableConcept   `json:"fastingStatusCodeableConcept,omitempty" bson:"fasting_status_codeable_concept,omitempty"` // Whether or how long patient abstained from food and/or drink
-	FastingStatusDuration        *Duration          `json:"fastingStatusDuration,omitempty" bson:"fasting_status_duration,omitempty"`                // Whether or how long patient abstained from food and/or drink
+	Id                *string                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Collector         *Reference                      `json:"collector,omitempty" bson:"collector,omitempty"`                  // Who collected the specimen
+	Collected         SpecimenCollectionCollected     `json:"-" bson:"collected,omitempty"`                                    // Collection time
+	CollectedElement  *Element                        `json:"-" bson:"collected_element,omitempty"`                            // Extensions for collected[x]
+	Duration          *Duration                       `json:"duration,omitempty" bson:"duration,omitempty"`                    // How long it took to collect specimen
+	Quantity          *Quantity                       `json:"quantity,omitempty" bson:"quantity,omitempty"`                    // The quantity of specimen collected
+	Method            *CodeableConcept                `json:"method,omitempty" bson:"method,omitempty"`                        // Technique used to perform collection
+	Device            SpecimenCollectionDevice        `json:"-" bson:"device,omitempty"`                                       // Device used to perform collection
+	DeviceElement     *Element                        `json:"-" bson:"device_element,omitempty"`                               // Extensions for device[x]
+	Procedure         *Reference                      `json:"procedure,omitempty" bson:"procedure,omitempty"`                  // The procedure that collects the specimen
+	BodySite          *CodeableReference              `json:"bodySite,omitempty" bson:"body_site,omitempty"`                   // Anatomical collection site
+	FastingStatus     SpecimenCollectionFastingStatus `json:"-" bson:"fasting_status,omitempty"`                               // Whether or how long patient abstained from food and/or drink
+
+	collectedVariants     []string // JSON properties of Specimen.collection.collected[x] when more than one was decoded
+	deviceVariants        []string // JSON properties of Specimen.collection.device[x] when more than one was decoded
+	fastingStatusVariants []string // JSON properties of Specimen.collection.fastingStatus[x] when more than one was decoded
 }
 
+var specimenCollectionCollectorTargets = []string{"Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var specimenCollectionProcedureTargets = []string{"Procedure"}
+
 func (r *SpecimenCollection) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Collector != nil {
+		if target := invalidReferenceTarget(r.Collector.Reference, r.Collector.Type, specimenCollectionCollectorTargets); target != "" {
+			return fmt.Errorf("field 'Collector' cannot refer to %s, expected one of %v", target, specimenCollectionCollectorTargets)
+		}
+	}
 	if r.Collector != nil {
 		if err := r.Collector.Validate(); err != nil {
 			return fmt.Errorf("Collector: %w", err)
 		}
 	}
-	if r.CollectedPeriod != nil {
-		if err := r.CollectedPeriod.Validate(); err != nil {
-			return fmt.Errorf("CollectedPeriod: %w", err)
+	if len(r.collectedVariants) > 1 {
+		return fmt.Errorf("field 'Collected' must have a single type, got %v", r.collectedVariants)
+	}
+	if r.Collected != nil {
+		if err := r.Collected.Validate(); err != nil {
+			return fmt.Errorf("Collected: %w", err)
+		}
+	}
+	if r.CollectedElement != nil {
+		if err := r.CollectedElement.Validate(); err != nil {
+			return fmt.Errorf("CollectedElement: %w", err)
 		}
 	}
 	if r.Duration != nil {
@@ -173,14 +600,22 @@ func (r *SpecimenCollection) Validate() error {
 			return fmt.Errorf("Method: %w", err)
 		}
 	}
-	if r.DeviceCodeableConcept != nil {
-		if err := r.DeviceCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DeviceCodeableConcept: %w", err)
+	if len(r.deviceVariants) > 1 {
+		return fmt.Errorf("field 'Device' must have a single type, got %v", r.deviceVariants)
+	}
+	if r.Device != nil {
+		if err := r.Device.Validate(); err != nil {
+			return fmt.Errorf("Device: %w", err)
+		}
+	}
+	if r.DeviceElement != nil {
+		if err := r.DeviceElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceElement: %w", err)
 		}
 	}
-	if r.DeviceReference != nil {
-		if err := r.DeviceReference.Validate(); err != nil {
-			return fmt.Errorf("DeviceReference: %w", err)
+	if r.Procedure != nil {
+		if target := invalidReferenceTarget(r.Procedure.Reference, r.Procedure.Type, specimenCollectionProcedureTargets); target != "" {
+			return fmt.Errorf("field 'Procedure' cannot refer to %s, expected one of %v", target, specimenCollectionProcedureTargets)
 		}
 	}
 	if r.Procedure != nil {
@@ -193,104 +628,941 @@ func (r *SpecimenCollection) Validate() error {
 			return fmt.Errorf("BodySite: %w", err)
 		}
 	}
-	if r.FastingStatusCodeableConcept != nil {
-		if err := r.FastingStatusCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("FastingStatusCodeableConcept: %w", err)
-		}
+	if len(r.fastingStatusVariants) > 1 {
+		return fmt.Errorf("field 'FastingStatus' must have a single type, got %v", r.fastingStatusVariants)
 	}
-	if r.FastingStatusDuration != nil {
-		if err := r.FastingStatusDuration.Validate(); err != nil {
-			return fmt.Errorf("FastingStatusDuration: %w", err)
+	if r.FastingStatus != nil {
+		if err := r.FastingStatus.Validate(); err != nil {
+			return fmt.Errorf("FastingStatus: %w", err)
 		}
 	}
 	return nil
 }
 
-type SpecimenProcessing struct {
-	Id                    *string             `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	Description           *string             `json:"description,omitempty" bson:"description,omitempty"`                       // Textual description of procedure
-	Method                *CodeableConcept    `json:"method,omitempty" bson:"method,omitempty"`                                 // Indicates the treatment step  applied to the specimen
-	Performer             *Reference          `json:"performer,omitempty" bson:"performer,omitempty"`                           // Entity processing specimen
-	DeviceCodeableConcept *CodeableConcept    `json:"deviceCodeableConcept,omitempty" bson:"device_codeable_concept,omitempty"` // Device used to process the specimen
-	DeviceReference       *Reference          `json:"deviceReference,omitempty" bson:"device_reference,omitempty"`              // Device used to process the specimen
-	DeviceCanonical       *string             `json:"deviceCanonical,omitempty" bson:"device_canonical,omitempty"`              // Device used to process the specimen
-	Additive              []CodeableReference `json:"additive,omitempty" bson:"additive,omitempty"`                             // Material used in the processing step
-	TimeDateTime          *string             `json:"timeDateTime,omitempty" bson:"time_date_time,omitempty"`                   // Date and time of specimen processing
-	TimePeriod            *Period             `json:"timePeriod,omitempty" bson:"time_period,omitempty"`                        // Date and time of specimen processing
-	TimeDuration          *Duration           `json:"timeDuration,omitempty" bson:"time_duration,omitempty"`                    // Date and time of specimen processing
-}
-
-func (r *SpecimenProcessing) Validate() error {
-	if r.Method != nil {
-		if err := r.Method.Validate(); err != nil {
-			return fmt.Errorf("Method: %w", err)
-		}
+func (r *SpecimenCollection) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Performer != nil {
-		if err := r.Performer.Validate(); err != nil {
-			return fmt.Errorf("Performer: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.DeviceCodeableConcept != nil {
-		if err := r.DeviceCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DeviceCodeableConcept: %w", err)
+	if r.Collector != nil {
+		if target := invalidReferenceTarget(r.Collector.Reference, r.Collector.Type, specimenCollectionCollectorTargets); target != "" {
+			issues.add("value", path+".collector", fmt.Sprintf("field 'Collector' cannot refer to %s, expected one of %v", target, specimenCollectionCollectorTargets))
 		}
 	}
-	if r.DeviceReference != nil {
-		if err := r.DeviceReference.Validate(); err != nil {
-			return fmt.Errorf("DeviceReference: %w", err)
-		}
+	if r.Collector != nil {
+		r.Collector.validateAll(path+".collector", issues)
 	}
-	for i, item := range r.Additive {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Additive[%d]: %w", i, err)
-		}
+	if len(r.collectedVariants) > 1 {
+		issues.add("structure", path+".collected", fmt.Sprintf("field 'Collected' must have a single type, got %v", r.collectedVariants))
 	}
-	if r.TimePeriod != nil {
-		if err := r.TimePeriod.Validate(); err != nil {
-			return fmt.Errorf("TimePeriod: %w", err)
-		}
+	if r.Collected != nil {
+		r.Collected.validateAll(path+".collected.ofType("+r.Collected.FHIRType()+")", issues)
+	}
+	if r.CollectedElement != nil {
+		r.CollectedElement.validateAll(path+".collected", issues)
+	}
+	if r.Duration != nil {
+		r.Duration.validateAll(path+".duration", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if len(r.deviceVariants) > 1 {
+		issues.add("structure", path+".device", fmt.Sprintf("field 'Device' must have a single type, got %v", r.deviceVariants))
 	}
-	if r.TimeDuration != nil {
-		if err := r.TimeDuration.Validate(); err != nil {
-			return fmt.Errorf("TimeDuration: %w", err)
+	if r.Device != nil {
+		r.Device.validateAll(path+".device.ofType("+r.Device.FHIRType()+")", issues)
+	}
+	if r.DeviceElement != nil {
+		r.DeviceElement.validateAll(path+".device", issues)
+	}
+	if r.Procedure != nil {
+		if target := invalidReferenceTarget(r.Procedure.Reference, r.Procedure.Type, specimenCollectionProcedureTargets); target != "" {
+			issues.add("value", path+".procedure", fmt.Sprintf("field 'Procedure' cannot refer to %s, expected one of %v", target, specimenCollectionProcedureTargets))
 		}
 	}
-	return nil
+	if r.Procedure != nil {
+		r.Procedure.validateAll(path+".procedure", issues)
+	}
+	if r.BodySite != nil {
+		r.BodySite.validateAll(path+".bodySite", issues)
+	}
+	if len(r.fastingStatusVariants) > 1 {
+		issues.add("structure", path+".fastingStatus", fmt.Sprintf("field 'FastingStatus' must have a single type, got %v", r.fastingStatusVariants))
+	}
+	if r.FastingStatus != nil {
+		r.FastingStatus.validateAll(path+".fastingStatus.ofType("+r.FastingStatus.FHIRType()+")", issues)
+	}
 }
 
-type SpecimenContainer struct {
-	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	DeviceCodeableConcept *CodeableConcept `json:"deviceCodeableConcept" bson:"device_codeable_concept"`          // Device resource for the container
-	DeviceReference       *Reference       `json:"deviceReference" bson:"device_reference"`                       // Device resource for the container
-	DeviceCanonical       *string          `json:"deviceCanonical" bson:"device_canonical"`                       // Device resource for the container
-	SpecimenQuantity      *Quantity        `json:"specimenQuantity,omitempty" bson:"specimen_quantity,omitempty"` // Quantity of specimen within container
+var specimenCollectionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *SpecimenContainer) Validate() error {
-	if r.DeviceCodeableConcept == nil {
-		return fmt.Errorf("field 'DeviceCodeableConcept' is required")
+func (r *SpecimenCollection) invariants() []invariant {
+	return specimenCollectionInvariants
+}
+
+func (r SpecimenCollection) MarshalJSON() ([]byte, error) {
+	type alias SpecimenCollection
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	if r.DeviceCodeableConcept != nil {
-		if err := r.DeviceCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("DeviceCodeableConcept: %w", err)
-		}
+	if data, err = marshalChoice(data, "collected", r.Collected, r.CollectedElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "device", r.Device, r.DeviceElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "fastingStatus", r.FastingStatus, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SpecimenCollection) UnmarshalJSON(data []byte) error {
+	type alias SpecimenCollection
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.DeviceReference == nil {
-		return fmt.Errorf("field 'DeviceReference' is required")
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.DeviceReference != nil {
-		if err := r.DeviceReference.Validate(); err != nil {
-			return fmt.Errorf("DeviceReference: %w", err)
+	var element json.RawMessage
+	if r.Collected, element, r.collectedVariants, err = unmarshalChoice(fields, "collected", specimenCollectionCollectedVariants); err != nil {
+		return err
+	}
+	r.CollectedElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.CollectedElement); err != nil {
+			return fmt.Errorf("_collected: %w", err)
 		}
 	}
-	if r.DeviceCanonical == nil {
-		return fmt.Errorf("field 'DeviceCanonical' is required")
+	if r.Device, element, r.deviceVariants, err = unmarshalChoice(fields, "device", specimenCollectionDeviceVariants); err != nil {
+		return err
 	}
-	if r.SpecimenQuantity != nil {
-		if err := r.SpecimenQuantity.Validate(); err != nil {
-			return fmt.Errorf("SpecimenQuantity: %w", err)
+	r.DeviceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeviceElement); err != nil {
+			return fmt.Errorf("_device: %w", err)
 		}
 	}
+	if r.FastingStatus, _, r.fastingStatusVariants, err = unmarshalChoice(fields, "fastingStatus", specimenCollectionFastingStatusVariants); err != nil {
+		return err
+	}
 	return nil
 }
+
+func (r SpecimenCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenCollection) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenCollection) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenCollection) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SpecimenCollection) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Collected":
+		return "collected", choiceValues(specimenCollectionCollectedVariants)
+	case "Device":
+		return "device", choiceValues(specimenCollectionDeviceVariants)
+	case "FastingStatus":
+		return "fastingStatus", choiceValues(specimenCollectionFastingStatusVariants)
+	}
+	return "", nil
+}
+
+var specimenCollectionElementMetadata = []ElementMetadata{
+	{Path: "Specimen.collection.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Specimen.collection.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Specimen.collection.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Specimen.collection.collector", Name: "collector", Field: 3, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Specimen.collection.collected[x]", Name: "collected", Field: 4, Min: 0, Max: "1", Types: []string{"dateTime", "Period"}},
+	{Path: "Specimen.collection.duration", Name: "duration", Field: 6, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "Specimen.collection.quantity", Name: "quantity", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "Specimen.collection.method", Name: "method", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Specimen.collection.device[x]", Name: "device", Field: 9, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "Specimen.collection.procedure", Name: "procedure", Field: 11, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Procedure"}},
+	{Path: "Specimen.collection.bodySite", Name: "bodySite", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Specimen.collection.fastingStatus[x]", Name: "fastingStatus", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Duration"}},
+}
+
+func (r *SpecimenCollection) ElementMetadata() []ElementMetadata {
+	return specimenCollectionElementMetadata
+}
+
+// SpecimenCollectionCollected is the type of Specimen.collection.collected[x]. It is implemented by the SpecimenCollectionCollected* variant types.
+type SpecimenCollectionCollected interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenCollectionCollected()
+}
+
+// SpecimenCollectionCollectedDateTime is the dateTime variant of SpecimenCollectionCollected.
+type SpecimenCollectionCollectedDateTime struct {
+	DateTime
+}
+
+func (v SpecimenCollectionCollectedDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v SpecimenCollectionCollectedDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v SpecimenCollectionCollectedDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v SpecimenCollectionCollectedDateTime) isSpecimenCollectionCollected() {}
+
+// SpecimenCollectionCollectedPeriod is the Period variant of SpecimenCollectionCollected.
+type SpecimenCollectionCollectedPeriod struct {
+	Period
+}
+
+func (v SpecimenCollectionCollectedPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v SpecimenCollectionCollectedPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v SpecimenCollectionCollectedPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v SpecimenCollectionCollectedPeriod) isSpecimenCollectionCollected() {}
+
+var specimenCollectionCollectedVariants = []SpecimenCollectionCollected{
+	SpecimenCollectionCollectedDateTime{},
+	SpecimenCollectionCollectedPeriod{},
+}
+
+// SpecimenCollectionDevice is the type of Specimen.collection.device[x]. It is implemented by the SpecimenCollectionDevice* variant types.
+type SpecimenCollectionDevice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenCollectionDevice()
+}
+
+// SpecimenCollectionDeviceCodeableConcept is the CodeableConcept variant of SpecimenCollectionDevice.
+type SpecimenCollectionDeviceCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SpecimenCollectionDeviceCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SpecimenCollectionDeviceCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SpecimenCollectionDeviceCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SpecimenCollectionDeviceCodeableConcept) isSpecimenCollectionDevice() {}
+
+// SpecimenCollectionDeviceReference is the Reference variant of SpecimenCollectionDevice.
+type SpecimenCollectionDeviceReference struct {
+	Reference
+}
+
+func (v SpecimenCollectionDeviceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v SpecimenCollectionDeviceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v SpecimenCollectionDeviceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v SpecimenCollectionDeviceReference) isSpecimenCollectionDevice() {}
+
+// SpecimenCollectionDeviceCanonical is the canonical variant of SpecimenCollectionDevice.
+type SpecimenCollectionDeviceCanonical string
+
+func (v SpecimenCollectionDeviceCanonical) Validate() error {
+	return nil
+}
+
+func (v SpecimenCollectionDeviceCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SpecimenCollectionDeviceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v SpecimenCollectionDeviceCanonical) isSpecimenCollectionDevice() {}
+
+var specimenCollectionDeviceVariants = []SpecimenCollectionDevice{
+	SpecimenCollectionDeviceCodeableConcept{},
+	SpecimenCollectionDeviceReference{},
+	SpecimenCollectionDeviceCanonical(""),
+}
+
+// SpecimenCollectionFastingStatus is the type of Specimen.collection.fastingStatus[x]. It is implemented by the SpecimenCollectionFastingStatus* variant types.
+type SpecimenCollectionFastingStatus interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenCollectionFastingStatus()
+}
+
+// SpecimenCollectionFastingStatusCodeableConcept is the CodeableConcept variant of SpecimenCollectionFastingStatus.
+type SpecimenCollectionFastingStatusCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SpecimenCollectionFastingStatusCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SpecimenCollectionFastingStatusCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SpecimenCollectionFastingStatusCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SpecimenCollectionFastingStatusCodeableConcept) isSpecimenCollectionFastingStatus() {}
+
+// SpecimenCollectionFastingStatusDuration is the Duration variant of SpecimenCollectionFastingStatus.
+type SpecimenCollectionFastingStatusDuration struct {
+	Duration
+}
+
+func (v SpecimenCollectionFastingStatusDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v SpecimenCollectionFastingStatusDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v SpecimenCollectionFastingStatusDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v SpecimenCollectionFastingStatusDuration) isSpecimenCollectionFastingStatus() {}
+
+var specimenCollectionFastingStatusVariants = []SpecimenCollectionFastingStatus{
+	SpecimenCollectionFastingStatusCodeableConcept{},
+	SpecimenCollectionFastingStatusDuration{},
+}
+
+type SpecimenProcessing struct {
+	Id                 *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Description        *string                  `json:"description,omitempty" bson:"description,omitempty"`              // Textual description of procedure
+	DescriptionElement *Element                 `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Method             *CodeableConcept         `json:"method,omitempty" bson:"method,omitempty"`                        // Indicates the treatment step  applied to the specimen
+	Performer          *Reference               `json:"performer,omitempty" bson:"performer,omitempty"`                  // Entity processing specimen
+	Device             SpecimenProcessingDevice `json:"-" bson:"device,omitempty"`                                       // Device used to process the specimen
+	DeviceElement      *Element                 `json:"-" bson:"device_element,omitempty"`                               // Extensions for device[x]
+	Additive           []CodeableReference      `json:"additive,omitempty" bson:"additive,omitempty"`                    // Material used in the processing step
+	Time               SpecimenProcessingTime   `json:"-" bson:"time,omitempty"`                                         // Date and time of specimen processing
+	TimeElement        *Element                 `json:"-" bson:"time_element,omitempty"`                                 // Extensions for time[x]
+
+	deviceVariants []string // JSON properties of Specimen.processing.device[x] when more than one was decoded
+	timeVariants   []string // JSON properties of Specimen.processing.time[x] when more than one was decoded
+}
+
+func (r *SpecimenProcessing) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Method != nil {
+		if err := r.Method.Validate(); err != nil {
+			return fmt.Errorf("Method: %w", err)
+		}
+	}
+	if r.Performer != nil {
+		if err := r.Performer.Validate(); err != nil {
+			return fmt.Errorf("Performer: %w", err)
+		}
+	}
+	if len(r.deviceVariants) > 1 {
+		return fmt.Errorf("field 'Device' must have a single type, got %v", r.deviceVariants)
+	}
+	if r.Device != nil {
+		if err := r.Device.Validate(); err != nil {
+			return fmt.Errorf("Device: %w", err)
+		}
+	}
+	if r.DeviceElement != nil {
+		if err := r.DeviceElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceElement: %w", err)
+		}
+	}
+	for i, item := range r.Additive {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Additive[%d]: %w", i, err)
+		}
+	}
+	if len(r.timeVariants) > 1 {
+		return fmt.Errorf("field 'Time' must have a single type, got %v", r.timeVariants)
+	}
+	if r.Time != nil {
+		if err := r.Time.Validate(); err != nil {
+			return fmt.Errorf("Time: %w", err)
+		}
+	}
+	if r.TimeElement != nil {
+		if err := r.TimeElement.Validate(); err != nil {
+			return fmt.Errorf("TimeElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SpecimenProcessing) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if r.Performer != nil {
+		r.Performer.validateAll(path+".performer", issues)
+	}
+	if len(r.deviceVariants) > 1 {
+		issues.add("structure", path+".device", fmt.Sprintf("field 'Device' must have a single type, got %v", r.deviceVariants))
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device.ofType("+r.Device.FHIRType()+")", issues)
+	}
+	if r.DeviceElement != nil {
+		r.DeviceElement.validateAll(path+".device", issues)
+	}
+	for i, item := range r.Additive {
+		item.validateAll(fmt.Sprintf("%s.additive[%d]", path, i), issues)
+	}
+	if len(r.timeVariants) > 1 {
+		issues.add("structure", path+".time", fmt.Sprintf("field 'Time' must have a single type, got %v", r.timeVariants))
+	}
+	if r.Time != nil {
+		r.Time.validateAll(path+".time.ofType("+r.Time.FHIRType()+")", issues)
+	}
+	if r.TimeElement != nil {
+		r.TimeElement.validateAll(path+".time", issues)
+	}
+}
+
+var specimenProcessingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenProcessing) invariants() []invariant {
+	return specimenProcessingInvariants
+}
+
+func (r SpecimenProcessing) MarshalJSON() ([]byte, error) {
+	type alias SpecimenProcessing
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "device", r.Device, r.DeviceElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "time", r.Time, r.TimeElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SpecimenProcessing) UnmarshalJSON(data []byte) error {
+	type alias SpecimenProcessing
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Device, element, r.deviceVariants, err = unmarshalChoice(fields, "device", specimenProcessingDeviceVariants); err != nil {
+		return err
+	}
+	r.DeviceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeviceElement); err != nil {
+			return fmt.Errorf("_device: %w", err)
+		}
+	}
+	if r.Time, element, r.timeVariants, err = unmarshalChoice(fields, "time", specimenProcessingTimeVariants); err != nil {
+		return err
+	}
+	r.TimeElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TimeElement); err != nil {
+			return fmt.Errorf("_time: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r SpecimenProcessing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenProcessing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenProcessing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenProcessing) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SpecimenProcessing) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Device":
+		return "device", choiceValues(specimenProcessingDeviceVariants)
+	case "Time":
+		return "time", choiceValues(specimenProcessingTimeVariants)
+	}
+	return "", nil
+}
+
+var specimenProcessingElementMetadata = []ElementMetadata{
+	{Path: "Specimen.processing.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Specimen.processing.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Specimen.processing.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Specimen.processing.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Specimen.processing.method", Name: "method", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Specimen.processing.performer", Name: "performer", Field: 6, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Specimen.processing.device[x]", Name: "device", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "Specimen.processing.additive", Name: "additive", Field: 9, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Specimen.processing.time[x]", Name: "time", Field: 10, Min: 0, Max: "1", Types: []string{"dateTime", "Period", "Duration"}},
+}
+
+func (r *SpecimenProcessing) ElementMetadata() []ElementMetadata {
+	return specimenProcessingElementMetadata
+}
+
+// SpecimenProcessingDevice is the type of Specimen.processing.device[x]. It is implemented by the SpecimenProcessingDevice* variant types.
+type SpecimenProcessingDevice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenProcessingDevice()
+}
+
+// SpecimenProcessingDeviceCodeableConcept is the CodeableConcept variant of SpecimenProcessingDevice.
+type SpecimenProcessingDeviceCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SpecimenProcessingDeviceCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SpecimenProcessingDeviceCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SpecimenProcessingDeviceCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SpecimenProcessingDeviceCodeableConcept) isSpecimenProcessingDevice() {}
+
+// SpecimenProcessingDeviceReference is the Reference variant of SpecimenProcessingDevice.
+type SpecimenProcessingDeviceReference struct {
+	Reference
+}
+
+func (v SpecimenProcessingDeviceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v SpecimenProcessingDeviceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v SpecimenProcessingDeviceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v SpecimenProcessingDeviceReference) isSpecimenProcessingDevice() {}
+
+// SpecimenProcessingDeviceCanonical is the canonical variant of SpecimenProcessingDevice.
+type SpecimenProcessingDeviceCanonical string
+
+func (v SpecimenProcessingDeviceCanonical) Validate() error {
+	return nil
+}
+
+func (v SpecimenProcessingDeviceCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SpecimenProcessingDeviceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v SpecimenProcessingDeviceCanonical) isSpecimenProcessingDevice() {}
+
+var specimenProcessingDeviceVariants = []SpecimenProcessingDevice{
+	SpecimenProcessingDeviceCodeableConcept{},
+	SpecimenProcessingDeviceReference{},
+	SpecimenProcessingDeviceCanonical(""),
+}
+
+// SpecimenProcessingTime is the type of Specimen.processing.time[x]. It is implemented by the SpecimenProcessingTime* variant types.
+type SpecimenProcessingTime interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenProcessingTime()
+}
+
+// SpecimenProcessingTimeDateTime is the dateTime variant of SpecimenProcessingTime.
+type SpecimenProcessingTimeDateTime struct {
+	DateTime
+}
+
+func (v SpecimenProcessingTimeDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v SpecimenProcessingTimeDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v SpecimenProcessingTimeDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v SpecimenProcessingTimeDateTime) isSpecimenProcessingTime() {}
+
+// SpecimenProcessingTimePeriod is the Period variant of SpecimenProcessingTime.
+type SpecimenProcessingTimePeriod struct {
+	Period
+}
+
+func (v SpecimenProcessingTimePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v SpecimenProcessingTimePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v SpecimenProcessingTimePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v SpecimenProcessingTimePeriod) isSpecimenProcessingTime() {}
+
+// SpecimenProcessingTimeDuration is the Duration variant of SpecimenProcessingTime.
+type SpecimenProcessingTimeDuration struct {
+	Duration
+}
+
+func (v SpecimenProcessingTimeDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v SpecimenProcessingTimeDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v SpecimenProcessingTimeDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v SpecimenProcessingTimeDuration) isSpecimenProcessingTime() {}
+
+var specimenProcessingTimeVariants = []SpecimenProcessingTime{
+	SpecimenProcessingTimeDateTime{},
+	SpecimenProcessingTimePeriod{},
+	SpecimenProcessingTimeDuration{},
+}
+
+type SpecimenContainer struct {
+	Id                *string                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Device            SpecimenContainerDevice `json:"-" bson:"device,omitempty"`                                       // Device resource for the container
+	DeviceElement     *Element                `json:"-" bson:"device_element,omitempty"`                               // Extensions for device[x]
+	SpecimenQuantity  *Quantity               `json:"specimenQuantity,omitempty" bson:"specimen_quantity,omitempty"`   // Quantity of specimen within container
+
+	deviceVariants []string // JSON properties of Specimen.container.device[x] when more than one was decoded
+}
+
+func (r *SpecimenContainer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.deviceVariants) > 1 {
+		return fmt.Errorf("field 'Device' must have a single type, got %v", r.deviceVariants)
+	}
+	if r.Device == nil {
+		return fmt.Errorf("field 'Device' is required")
+	}
+	if r.Device != nil {
+		if err := r.Device.Validate(); err != nil {
+			return fmt.Errorf("Device: %w", err)
+		}
+	}
+	if r.DeviceElement != nil {
+		if err := r.DeviceElement.Validate(); err != nil {
+			return fmt.Errorf("DeviceElement: %w", err)
+		}
+	}
+	if r.SpecimenQuantity != nil {
+		if err := r.SpecimenQuantity.Validate(); err != nil {
+			return fmt.Errorf("SpecimenQuantity: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SpecimenContainer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.deviceVariants) > 1 {
+		issues.add("structure", path+".device", fmt.Sprintf("field 'Device' must have a single type, got %v", r.deviceVariants))
+	}
+	if r.Device == nil {
+		issues.add("required", path+".device", "field 'Device' is required")
+	}
+	if r.Device != nil {
+		r.Device.validateAll(path+".device.ofType("+r.Device.FHIRType()+")", issues)
+	}
+	if r.DeviceElement != nil {
+		r.DeviceElement.validateAll(path+".device", issues)
+	}
+	if r.SpecimenQuantity != nil {
+		r.SpecimenQuantity.validateAll(path+".specimenQuantity", issues)
+	}
+}
+
+var specimenContainerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenContainer) invariants() []invariant {
+	return specimenContainerInvariants
+}
+
+func (r SpecimenContainer) MarshalJSON() ([]byte, error) {
+	type alias SpecimenContainer
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "device", r.Device, r.DeviceElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SpecimenContainer) UnmarshalJSON(data []byte) error {
+	type alias SpecimenContainer
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Device, element, r.deviceVariants, err = unmarshalChoice(fields, "device", specimenContainerDeviceVariants); err != nil {
+		return err
+	}
+	r.DeviceElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.DeviceElement); err != nil {
+			return fmt.Errorf("_device: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r SpecimenContainer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenContainer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenContainer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenContainer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SpecimenContainer) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Device":
+		return "device", choiceValues(specimenContainerDeviceVariants)
+	}
+	return "", nil
+}
+
+var specimenContainerElementMetadata = []ElementMetadata{
+	{Path: "Specimen.container.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Specimen.container.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Specimen.container.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Specimen.container.device[x]", Name: "device", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference", "canonical"}},
+	{Path: "Specimen.container.specimenQuantity", Name: "specimenQuantity", Field: 5, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *SpecimenContainer) ElementMetadata() []ElementMetadata {
+	return specimenContainerElementMetadata
+}
+
+// SpecimenContainerDevice is the type of Specimen.container.device[x]. It is implemented by the SpecimenContainerDevice* variant types.
+type SpecimenContainerDevice interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenContainerDevice()
+}
+
+// SpecimenContainerDeviceCodeableConcept is the CodeableConcept variant of SpecimenContainerDevice.
+type SpecimenContainerDeviceCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SpecimenContainerDeviceCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SpecimenContainerDeviceCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SpecimenContainerDeviceCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SpecimenContainerDeviceCodeableConcept) isSpecimenContainerDevice() {}
+
+// SpecimenContainerDeviceReference is the Reference variant of SpecimenContainerDevice.
+type SpecimenContainerDeviceReference struct {
+	Reference
+}
+
+func (v SpecimenContainerDeviceReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v SpecimenContainerDeviceReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v SpecimenContainerDeviceReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v SpecimenContainerDeviceReference) isSpecimenContainerDevice() {}
+
+// SpecimenContainerDeviceCanonical is the canonical variant of SpecimenContainerDevice.
+type SpecimenContainerDeviceCanonical string
+
+func (v SpecimenContainerDeviceCanonical) Validate() error {
+	return nil
+}
+
+func (v SpecimenContainerDeviceCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SpecimenContainerDeviceCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v SpecimenContainerDeviceCanonical) isSpecimenContainerDevice() {}
+
+var specimenContainerDeviceVariants = []SpecimenContainerDevice{
+	SpecimenContainerDeviceCodeableConcept{},
+	SpecimenContainerDeviceReference{},
+	SpecimenContainerDeviceCanonical(""),
+}
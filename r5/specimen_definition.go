@@ -2,48 +2,72 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A kind of specimen with associated set of requirements.
 type SpecimenDefinition struct {
-	ResourceType           string                         `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                        `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                          `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                        `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                        `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                     `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage              `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                        `json:"url,omitempty" bson:"url,omitempty"`                                         // Logical canonical URL to reference this SpecimenDefinition (globally unique)
-	Identifier             *Identifier                    `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business identifier
-	Version                *string                        `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the SpecimenDefinition
-	VersionAlgorithmString *string                        `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                        `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                        `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this {{title}} (computer friendly)
-	Title                  *string                        `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this SpecimenDefinition (Human friendly)
-	DerivedFromCanonical   []string                       `json:"derivedFromCanonical,omitempty" bson:"derived_from_canonical,omitempty"`     // Based on FHIR definition of another SpecimenDefinition
-	DerivedFromUri         []string                       `json:"derivedFromUri,omitempty" bson:"derived_from_uri,omitempty"`                 // Based on external definition
-	Status                 string                         `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                          `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // If this SpecimenDefinition is not for real usage
-	SubjectCodeableConcept *CodeableConcept               `json:"subjectCodeableConcept,omitempty" bson:"subject_codeable_concept,omitempty"` // Type of subject for specimen collection
-	SubjectReference       *Reference                     `json:"subjectReference,omitempty" bson:"subject_reference,omitempty"`              // Type of subject for specimen collection
-	Date                   *string                        `json:"date,omitempty" bson:"date,omitempty"`                                       // Date status first applied
-	Publisher              *string                        `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // The name of the individual or organization that published the SpecimenDefinition
-	Contact                []ContactDetail                `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                        `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the SpecimenDefinition
-	UseContext             []UsageContext                 `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // Content intends to support these contexts
-	Jurisdiction           []CodeableConcept              `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the this SpecimenDefinition (if applicable)
-	Purpose                *string                        `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this SpecimenDefinition is defined
-	Copyright              *string                        `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                        `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string                        `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When SpecimenDefinition was approved by publisher
-	LastReviewDate         *string                        `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // The date on which the asset content was last reviewed by the publisher
-	EffectivePeriod        *Period                        `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // The effective date range for the SpecimenDefinition
-	TypeCollected          *CodeableConcept               `json:"typeCollected,omitempty" bson:"type_collected,omitempty"`                    // Kind of material to collect
-	PatientPreparation     []CodeableConcept              `json:"patientPreparation,omitempty" bson:"patient_preparation,omitempty"`          // Patient preparation for collection
-	TimeAspect             *string                        `json:"timeAspect,omitempty" bson:"time_aspect,omitempty"`                          // Time aspect for collection
-	Collection             []CodeableConcept              `json:"collection,omitempty" bson:"collection,omitempty"`                           // Specimen collection procedure
-	TypeTested             []SpecimenDefinitionTypeTested `json:"typeTested,omitempty" bson:"type_tested,omitempty"`                          // Specimen in container intended for testing by lab
+	ResourceType                string                             `json:"resourceType" bson:"resource_type"`                                               // Type of resource
+	Id                          *string                            `json:"id,omitempty" bson:"id,omitempty"`                                                // Logical id of this artifact
+	Meta                        *Meta                              `json:"meta,omitempty" bson:"meta,omitempty"`                                            // Metadata about the resource
+	ImplicitRules               *string                            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                         // A set of rules under which this content was created
+	ImplicitRulesElement        *Element                           `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                // Extensions for implicitRules
+	Language                    *string                            `json:"language,omitempty" bson:"language,omitempty"`                                    // Language of the resource content
+	LanguageElement             *Element                           `json:"_language,omitempty" bson:"language_element,omitempty"`                           // Extensions for language
+	Text                        *Narrative                         `json:"text,omitempty" bson:"text,omitempty"`                                            // Text summary of the resource, for human interpretation
+	Contained                   []Resource                         `json:"contained,omitempty" bson:"contained,omitempty"`                                  // Contained, inline Resources
+	Extension                   []Extension                        `json:"extension,omitempty" bson:"extension,omitempty"`                                  // Additional content defined by implementations
+	ModifierExtension           []Extension                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                 // Extensions that cannot be ignored
+	Url                         *string                            `json:"url,omitempty" bson:"url,omitempty"`                                              // Logical canonical URL to reference this SpecimenDefinition (globally unique)
+	UrlElement                  *Element                           `json:"_url,omitempty" bson:"url_element,omitempty"`                                     // Extensions for url
+	Identifier                  *Identifier                        `json:"identifier,omitempty" bson:"identifier,omitempty"`                                // Business identifier
+	Version                     *string                            `json:"version,omitempty" bson:"version,omitempty"`                                      // Business version of the SpecimenDefinition
+	VersionElement              *Element                           `json:"_version,omitempty" bson:"version_element,omitempty"`                             // Extensions for version
+	VersionAlgorithm            SpecimenDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                            // How to compare versions
+	VersionAlgorithmElement     *Element                           `json:"-" bson:"version_algorithm_element,omitempty"`                                    // Extensions for versionAlgorithm[x]
+	Name                        *string                            `json:"name,omitempty" bson:"name,omitempty"`                                            // Name for this {{title}} (computer friendly)
+	NameElement                 *Element                           `json:"_name,omitempty" bson:"name_element,omitempty"`                                   // Extensions for name
+	Title                       *string                            `json:"title,omitempty" bson:"title,omitempty"`                                          // Name for this SpecimenDefinition (Human friendly)
+	TitleElement                *Element                           `json:"_title,omitempty" bson:"title_element,omitempty"`                                 // Extensions for title
+	DerivedFromCanonical        []string                           `json:"derivedFromCanonical,omitempty" bson:"derived_from_canonical,omitempty"`          // Based on FHIR definition of another SpecimenDefinition
+	DerivedFromCanonicalElement []*Element                         `json:"_derivedFromCanonical,omitempty" bson:"derived_from_canonical_element,omitempty"` // Extensions for derivedFromCanonical
+	DerivedFromUri              []string                           `json:"derivedFromUri,omitempty" bson:"derived_from_uri,omitempty"`                      // Based on external definition
+	DerivedFromUriElement       []*Element                         `json:"_derivedFromUri,omitempty" bson:"derived_from_uri_element,omitempty"`             // Extensions for derivedFromUri
+	Status                      PublicationStatus                  `json:"status" bson:"status"`                                                            // draft | active | retired | unknown
+	StatusElement               *Element                           `json:"_status,omitempty" bson:"status_element,omitempty"`                               // Extensions for status
+	Experimental                *bool                              `json:"experimental,omitempty" bson:"experimental,omitempty"`                            // If this SpecimenDefinition is not for real usage
+	ExperimentalElement         *Element                           `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`                   // Extensions for experimental
+	Subject                     SpecimenDefinitionSubject          `json:"-" bson:"subject,omitempty"`                                                      // Type of subject for specimen collection
+	Date                        *DateTime                          `json:"date,omitempty" bson:"date,omitempty"`                                            // Date status first applied
+	DateElement                 *Element                           `json:"_date,omitempty" bson:"date_element,omitempty"`                                   // Extensions for date
+	Publisher                   *string                            `json:"publisher,omitempty" bson:"publisher,omitempty"`                                  // The name of the individual or organization that published the SpecimenDefinition
+	PublisherElement            *Element                           `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                         // Extensions for publisher
+	Contact                     []ContactDetail                    `json:"contact,omitempty" bson:"contact,omitempty"`                                      // Contact details for the publisher
+	Description                 *string                            `json:"description,omitempty" bson:"description,omitempty"`                              // Natural language description of the SpecimenDefinition
+	DescriptionElement          *Element                           `json:"_description,omitempty" bson:"description_element,omitempty"`                     // Extensions for description
+	UseContext                  []UsageContext                     `json:"useContext,omitempty" bson:"use_context,omitempty"`                               // Content intends to support these contexts
+	Jurisdiction                []CodeableConcept                  `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                            // Jurisdiction of the authority that maintains the this SpecimenDefinition (if applicable)
+	Purpose                     *string                            `json:"purpose,omitempty" bson:"purpose,omitempty"`                                      // Why this SpecimenDefinition is defined
+	PurposeElement              *Element                           `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                             // Extensions for purpose
+	Copyright                   *string                            `json:"copyright,omitempty" bson:"copyright,omitempty"`                                  // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement            *Element                           `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                         // Extensions for copyright
+	CopyrightLabel              *string                            `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                       // Copyright holder and year(s)
+	CopyrightLabelElement       *Element                           `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`              // Extensions for copyrightLabel
+	ApprovalDate                *Date                              `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                           // When SpecimenDefinition was approved by publisher
+	ApprovalDateElement         *Element                           `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`                  // Extensions for approvalDate
+	LastReviewDate              *Date                              `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                      // The date on which the asset content was last reviewed by the publisher
+	LastReviewDateElement       *Element                           `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"`             // Extensions for lastReviewDate
+	EffectivePeriod             *Period                            `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                     // The effective date range for the SpecimenDefinition
+	TypeCollected               *CodeableConcept                   `json:"typeCollected,omitempty" bson:"type_collected,omitempty"`                         // Kind of material to collect
+	PatientPreparation          []CodeableConcept                  `json:"patientPreparation,omitempty" bson:"patient_preparation,omitempty"`               // Patient preparation for collection
+	TimeAspect                  *string                            `json:"timeAspect,omitempty" bson:"time_aspect,omitempty"`                               // Time aspect for collection
+	TimeAspectElement           *Element                           `json:"_timeAspect,omitempty" bson:"time_aspect_element,omitempty"`                      // Extensions for timeAspect
+	Collection                  []CodeableConcept                  `json:"collection,omitempty" bson:"collection,omitempty"`                                // Specimen collection procedure
+	TypeTested                  []SpecimenDefinitionTypeTested     `json:"typeTested,omitempty" bson:"type_tested,omitempty"`                               // Specimen in container intended for testing by lab
+
+	versionAlgorithmVariants []string // JSON properties of SpecimenDefinition.versionAlgorithm[x] when more than one was decoded
+	subjectVariants          []string // JSON properties of SpecimenDefinition.subject[x] when more than one was decoded
 }
 
 func (r *SpecimenDefinition) Validate() error {
@@ -55,33 +79,130 @@ func (r *SpecimenDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	if r.Identifier != nil {
 		if err := r.Identifier.Validate(); err != nil {
 			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	for i, item := range r.DerivedFromCanonicalElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromCanonicalElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.DerivedFromUriElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromUriElement[%d]: %w", i, err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.SubjectCodeableConcept != nil {
-		if err := r.SubjectCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubjectCodeableConcept: %w", err)
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
 		}
 	}
-	if r.SubjectReference != nil {
-		if err := r.SubjectReference.Validate(); err != nil {
-			return fmt.Errorf("SubjectReference: %w", err)
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if len(r.subjectVariants) > 1 {
+		return fmt.Errorf("field 'Subject' must have a single type, got %v", r.subjectVariants)
+	}
+	if r.Subject != nil {
+		if err := r.Subject.Validate(); err != nil {
+			return fmt.Errorf("Subject: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
 		}
 	}
 	for i, item := range r.Contact {
@@ -89,6 +210,11 @@ func (r *SpecimenDefinition) Validate() error {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -99,6 +225,41 @@ func (r *SpecimenDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -114,6 +275,11 @@ func (r *SpecimenDefinition) Validate() error {
 			return fmt.Errorf("PatientPreparation[%d]: %w", i, err)
 		}
 	}
+	if r.TimeAspectElement != nil {
+		if err := r.TimeAspectElement.Validate(); err != nil {
+			return fmt.Errorf("TimeAspectElement: %w", err)
+		}
+	}
 	for i, item := range r.Collection {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Collection[%d]: %w", i, err)
@@ -124,43 +290,545 @@ func (r *SpecimenDefinition) Validate() error {
 			return fmt.Errorf("TypeTested[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "SpecimenDefinition")
+}
+
+func (r *SpecimenDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "SpecimenDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'SpecimenDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	for i, item := range r.DerivedFromCanonicalElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.derivedFromCanonical[%d]", path, i), issues)
+	}
+	for i, item := range r.DerivedFromUriElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.derivedFromUri[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if len(r.subjectVariants) > 1 {
+		issues.add("structure", path+".subject", fmt.Sprintf("field 'Subject' must have a single type, got %v", r.subjectVariants))
+	}
+	if r.Subject != nil {
+		r.Subject.validateAll(path+".subject.ofType("+r.Subject.FHIRType()+")", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	if r.TypeCollected != nil {
+		r.TypeCollected.validateAll(path+".typeCollected", issues)
+	}
+	for i, item := range r.PatientPreparation {
+		item.validateAll(fmt.Sprintf("%s.patientPreparation[%d]", path, i), issues)
+	}
+	if r.TimeAspectElement != nil {
+		r.TimeAspectElement.validateAll(path+".timeAspect", issues)
+	}
+	for i, item := range r.Collection {
+		item.validateAll(fmt.Sprintf("%s.collection[%d]", path, i), issues)
+	}
+	for i, item := range r.TypeTested {
+		item.validateAll(fmt.Sprintf("%s.typeTested[%d]", path, i), issues)
+	}
+}
+
+func (r *SpecimenDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SpecimenDefinition", &issues)
+	checkInvariants(r, "SpecimenDefinition", &issues)
+	return issues
+}
+
+var specimenDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *SpecimenDefinition) invariants() []invariant {
+	return specimenDefinitionInvariants
+}
+
+func (r SpecimenDefinition) MarshalJSON() ([]byte, error) {
+	type alias SpecimenDefinition
+	out := struct {
+		alias
+		DerivedFromCanonical        []*string  `json:"derivedFromCanonical,omitempty"`
+		DerivedFromCanonicalElement []*Element `json:"_derivedFromCanonical,omitempty"`
+		DerivedFromUri              []*string  `json:"derivedFromUri,omitempty"`
+		DerivedFromUriElement       []*Element `json:"_derivedFromUri,omitempty"`
+	}{alias: alias(r)}
+	out.DerivedFromCanonical, out.DerivedFromCanonicalElement = alignPrimitiveArray(r.DerivedFromCanonical, r.DerivedFromCanonicalElement)
+	out.DerivedFromUri, out.DerivedFromUriElement = alignPrimitiveArray(r.DerivedFromUri, r.DerivedFromUriElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "subject", r.Subject, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SpecimenDefinition) UnmarshalJSON(data []byte) error {
+	type alias SpecimenDefinition
+	aux := struct {
+		*alias
+		Contained            []json.RawMessage `json:"contained,omitempty"`
+		DerivedFromCanonical []*string         `json:"derivedFromCanonical,omitempty"`
+		DerivedFromUri       []*string         `json:"derivedFromUri,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.DerivedFromCanonical, err = splitPrimitiveArray(aux.DerivedFromCanonical, r.DerivedFromCanonicalElement); err != nil {
+		return fmt.Errorf("derivedFromCanonical: %w", err)
+	}
+	if r.DerivedFromUri, err = splitPrimitiveArray(aux.DerivedFromUri, r.DerivedFromUriElement); err != nil {
+		return fmt.Errorf("derivedFromUri: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", specimenDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	if r.Subject, _, r.subjectVariants, err = unmarshalChoice(fields, "subject", specimenDefinitionSubjectVariants); err != nil {
+		return err
+	}
 	return nil
 }
 
+func (r SpecimenDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SpecimenDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(specimenDefinitionVersionAlgorithmVariants)
+	case "Subject":
+		return "subject", choiceValues(specimenDefinitionSubjectVariants)
+	}
+	return "", nil
+}
+
+var specimenDefinitionElementTypes = map[string]string{
+	"id":                   "id",
+	"implicitRules":        "uri",
+	"language":             "code",
+	"url":                  "uri",
+	"derivedFromCanonical": "canonical",
+	"derivedFromUri":       "uri",
+	"description":          "markdown",
+	"purpose":              "markdown",
+	"copyright":            "markdown",
+}
+
+func (r *SpecimenDefinition) elementTypes() map[string]string {
+	return specimenDefinitionElementTypes
+}
+
+var specimenDefinitionElementMetadata = []ElementMetadata{
+	{Path: "SpecimenDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "SpecimenDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "SpecimenDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "SpecimenDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SpecimenDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "SpecimenDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "SpecimenDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "SpecimenDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SpecimenDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SpecimenDefinition.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "SpecimenDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "SpecimenDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "SpecimenDefinition.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.derivedFromCanonical", Name: "derivedFromCanonical", Field: 22, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "SpecimenDefinition.derivedFromUri", Name: "derivedFromUri", Field: 24, Min: 0, Max: "*", Types: []string{"uri"}},
+	{Path: "SpecimenDefinition.status", Name: "status", Field: 26, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SpecimenDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "SpecimenDefinition.experimental", Name: "experimental", Field: 28, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SpecimenDefinition.subject[x]", Name: "subject", Field: 30, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+	{Path: "SpecimenDefinition.date", Name: "date", Field: 31, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "SpecimenDefinition.publisher", Name: "publisher", Field: 33, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.contact", Name: "contact", Field: 35, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "SpecimenDefinition.description", Name: "description", Field: 36, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SpecimenDefinition.useContext", Name: "useContext", Field: 38, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "SpecimenDefinition.jurisdiction", Name: "jurisdiction", Field: 39, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.purpose", Name: "purpose", Field: 40, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SpecimenDefinition.copyright", Name: "copyright", Field: 42, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SpecimenDefinition.copyrightLabel", Name: "copyrightLabel", Field: 44, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.approvalDate", Name: "approvalDate", Field: 46, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "SpecimenDefinition.lastReviewDate", Name: "lastReviewDate", Field: 48, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "SpecimenDefinition.effectivePeriod", Name: "effectivePeriod", Field: 50, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "SpecimenDefinition.typeCollected", Name: "typeCollected", Field: 51, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.patientPreparation", Name: "patientPreparation", Field: 52, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.timeAspect", Name: "timeAspect", Field: 53, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.collection", Name: "collection", Field: 55, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested", Name: "typeTested", Field: 56, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SpecimenDefinition) ElementMetadata() []ElementMetadata {
+	return specimenDefinitionElementMetadata
+}
+
+// SpecimenDefinitionVersionAlgorithm is the type of SpecimenDefinition.versionAlgorithm[x]. It is implemented by the SpecimenDefinitionVersionAlgorithm* variant types.
+type SpecimenDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenDefinitionVersionAlgorithm()
+}
+
+// SpecimenDefinitionVersionAlgorithmString is the string variant of SpecimenDefinitionVersionAlgorithm.
+type SpecimenDefinitionVersionAlgorithmString string
+
+func (v SpecimenDefinitionVersionAlgorithmString) Validate() error {
+	return nil
+}
+
+func (v SpecimenDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v SpecimenDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v SpecimenDefinitionVersionAlgorithmString) isSpecimenDefinitionVersionAlgorithm() {}
+
+// SpecimenDefinitionVersionAlgorithmCoding is the Coding variant of SpecimenDefinitionVersionAlgorithm.
+type SpecimenDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v SpecimenDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v SpecimenDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v SpecimenDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v SpecimenDefinitionVersionAlgorithmCoding) isSpecimenDefinitionVersionAlgorithm() {}
+
+var specimenDefinitionVersionAlgorithmVariants = []SpecimenDefinitionVersionAlgorithm{
+	SpecimenDefinitionVersionAlgorithmString(""),
+	SpecimenDefinitionVersionAlgorithmCoding{},
+}
+
+// SpecimenDefinitionSubject is the type of SpecimenDefinition.subject[x]. It is implemented by the SpecimenDefinitionSubject* variant types.
+type SpecimenDefinitionSubject interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenDefinitionSubject()
+}
+
+// SpecimenDefinitionSubjectCodeableConcept is the CodeableConcept variant of SpecimenDefinitionSubject.
+type SpecimenDefinitionSubjectCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SpecimenDefinitionSubjectCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SpecimenDefinitionSubjectCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SpecimenDefinitionSubjectCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SpecimenDefinitionSubjectCodeableConcept) isSpecimenDefinitionSubject() {}
+
+// SpecimenDefinitionSubjectReference is the Reference variant of SpecimenDefinitionSubject.
+type SpecimenDefinitionSubjectReference struct {
+	Reference
+}
+
+func (v SpecimenDefinitionSubjectReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v SpecimenDefinitionSubjectReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v SpecimenDefinitionSubjectReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v SpecimenDefinitionSubjectReference) isSpecimenDefinitionSubject() {}
+
+var specimenDefinitionSubjectVariants = []SpecimenDefinitionSubject{
+	SpecimenDefinitionSubjectCodeableConcept{},
+	SpecimenDefinitionSubjectReference{},
+}
+
+func (r *SpecimenDefinition) GetResourceType() string {
+	return "SpecimenDefinition"
+}
+
+func (r *SpecimenDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *SpecimenDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *SpecimenDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *SpecimenDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *SpecimenDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *SpecimenDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *SpecimenDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*SpecimenDefinition)(nil)
+
 type SpecimenDefinitionTypeTested struct {
 	Id                 *string                                `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
+	Extension          []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension  []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored even if unrecognized
 	IsDerived          *bool                                  `json:"isDerived,omitempty" bson:"is_derived,omitempty"`                   // Primary or secondary specimen
+	IsDerivedElement   *Element                               `json:"_isDerived,omitempty" bson:"is_derived_element,omitempty"`          // Extensions for isDerived
 	Type               *CodeableConcept                       `json:"type,omitempty" bson:"type,omitempty"`                              // Type of intended specimen
-	Preference         string                                 `json:"preference" bson:"preference"`                                      // preferred | alternate
+	Preference         SpecimenContainedPreference            `json:"preference" bson:"preference"`                                      // preferred | alternate
+	PreferenceElement  *Element                               `json:"_preference,omitempty" bson:"preference_element,omitempty"`         // Extensions for preference
 	Container          *SpecimenDefinitionTypeTestedContainer `json:"container,omitempty" bson:"container,omitempty"`                    // The specimen's container
 	Requirement        *string                                `json:"requirement,omitempty" bson:"requirement,omitempty"`                // Requirements for specimen delivery and special handling
+	RequirementElement *Element                               `json:"_requirement,omitempty" bson:"requirement_element,omitempty"`       // Extensions for requirement
 	RetentionTime      *Duration                              `json:"retentionTime,omitempty" bson:"retention_time,omitempty"`           // The usual time for retaining this kind of specimen
 	SingleUse          *bool                                  `json:"singleUse,omitempty" bson:"single_use,omitempty"`                   // Specimen for single use only
+	SingleUseElement   *Element                               `json:"_singleUse,omitempty" bson:"single_use_element,omitempty"`          // Extensions for singleUse
 	RejectionCriterion []CodeableConcept                      `json:"rejectionCriterion,omitempty" bson:"rejection_criterion,omitempty"` // Criterion specified for specimen rejection
 	Handling           []SpecimenDefinitionTypeTestedHandling `json:"handling,omitempty" bson:"handling,omitempty"`                      // Specimen handling before testing
 	TestingDestination []CodeableConcept                      `json:"testingDestination,omitempty" bson:"testing_destination,omitempty"` // Where the specimen will be tested
 }
 
 func (r *SpecimenDefinitionTypeTested) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.IsDerivedElement != nil {
+		if err := r.IsDerivedElement.Validate(); err != nil {
+			return fmt.Errorf("IsDerivedElement: %w", err)
+		}
+	}
 	if r.Type != nil {
 		if err := r.Type.Validate(); err != nil {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Preference == emptyString {
+	if r.Preference == "" {
 		return fmt.Errorf("field 'Preference' is required")
 	}
+	if !r.Preference.IsValid() {
+		return fmt.Errorf("field 'Preference' has invalid code '%s'", r.Preference)
+	}
+	if r.PreferenceElement != nil {
+		if err := r.PreferenceElement.Validate(); err != nil {
+			return fmt.Errorf("PreferenceElement: %w", err)
+		}
+	}
 	if r.Container != nil {
 		if err := r.Container.Validate(); err != nil {
 			return fmt.Errorf("Container: %w", err)
 		}
 	}
+	if r.RequirementElement != nil {
+		if err := r.RequirementElement.Validate(); err != nil {
+			return fmt.Errorf("RequirementElement: %w", err)
+		}
+	}
 	if r.RetentionTime != nil {
 		if err := r.RetentionTime.Validate(); err != nil {
 			return fmt.Errorf("RetentionTime: %w", err)
 		}
 	}
+	if r.SingleUseElement != nil {
+		if err := r.SingleUseElement.Validate(); err != nil {
+			return fmt.Errorf("SingleUseElement: %w", err)
+		}
+	}
 	for i, item := range r.RejectionCriterion {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RejectionCriterion[%d]: %w", i, err)
@@ -179,20 +847,133 @@ func (r *SpecimenDefinitionTypeTested) Validate() error {
 	return nil
 }
 
+func (r *SpecimenDefinitionTypeTested) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.IsDerivedElement != nil {
+		r.IsDerivedElement.validateAll(path+".isDerived", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Preference == "" {
+		issues.add("required", path+".preference", "field 'Preference' is required")
+	}
+	if r.Preference != "" && !r.Preference.IsValid() {
+		issues.add("code-invalid", path+".preference", fmt.Sprintf("field 'Preference' has invalid code '%s'", r.Preference))
+	}
+	if r.PreferenceElement != nil {
+		r.PreferenceElement.validateAll(path+".preference", issues)
+	}
+	if r.Container != nil {
+		r.Container.validateAll(path+".container", issues)
+	}
+	if r.RequirementElement != nil {
+		r.RequirementElement.validateAll(path+".requirement", issues)
+	}
+	if r.RetentionTime != nil {
+		r.RetentionTime.validateAll(path+".retentionTime", issues)
+	}
+	if r.SingleUseElement != nil {
+		r.SingleUseElement.validateAll(path+".singleUse", issues)
+	}
+	for i, item := range r.RejectionCriterion {
+		item.validateAll(fmt.Sprintf("%s.rejectionCriterion[%d]", path, i), issues)
+	}
+	for i, item := range r.Handling {
+		item.validateAll(fmt.Sprintf("%s.handling[%d]", path, i), issues)
+	}
+	for i, item := range r.TestingDestination {
+		item.validateAll(fmt.Sprintf("%s.testingDestination[%d]", path, i), issues)
+	}
+}
+
+var specimenDefinitionTypeTestedInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenDefinitionTypeTested) invariants() []invariant {
+	return specimenDefinitionTypeTestedInvariants
+}
+
+func (r SpecimenDefinitionTypeTested) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenDefinitionTypeTested) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenDefinitionTypeTested) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenDefinitionTypeTested) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var specimenDefinitionTypeTestedElementTypes = map[string]string{
+	"requirement": "markdown",
+}
+
+func (r *SpecimenDefinitionTypeTested) elementTypes() map[string]string {
+	return specimenDefinitionTypeTestedElementTypes
+}
+
+var specimenDefinitionTypeTestedElementMetadata = []ElementMetadata{
+	{Path: "SpecimenDefinition.typeTested.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.typeTested.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SpecimenDefinition.typeTested.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SpecimenDefinition.typeTested.isDerived", Name: "isDerived", Field: 3, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SpecimenDefinition.typeTested.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested.preference", Name: "preference", Field: 6, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SpecimenDefinition.typeTested.preference", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/specimen-contained-preference"}},
+	{Path: "SpecimenDefinition.typeTested.container", Name: "container", Field: 8, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "SpecimenDefinition.typeTested.requirement", Name: "requirement", Field: 9, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SpecimenDefinition.typeTested.retentionTime", Name: "retentionTime", Field: 11, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "SpecimenDefinition.typeTested.singleUse", Name: "singleUse", Field: 12, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SpecimenDefinition.typeTested.rejectionCriterion", Name: "rejectionCriterion", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested.handling", Name: "handling", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SpecimenDefinition.typeTested.testingDestination", Name: "testingDestination", Field: 16, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *SpecimenDefinitionTypeTested) ElementMetadata() []ElementMetadata {
+	return specimenDefinitionTypeTestedElementMetadata
+}
+
 type SpecimenDefinitionTypeTestedContainer struct {
-	Id                    *string                                         `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
-	Material              *CodeableConcept                                `json:"material,omitempty" bson:"material,omitempty"`                             // The material type used for the container
-	Type                  *CodeableConcept                                `json:"type,omitempty" bson:"type,omitempty"`                                     // Kind of container associated with the kind of specimen
-	Cap                   *CodeableConcept                                `json:"cap,omitempty" bson:"cap,omitempty"`                                       // Color of container cap
-	Description           *string                                         `json:"description,omitempty" bson:"description,omitempty"`                       // The description of the kind of container
-	Capacity              *Quantity                                       `json:"capacity,omitempty" bson:"capacity,omitempty"`                             // The capacity of this kind of container
-	MinimumVolumeQuantity *Quantity                                       `json:"minimumVolumeQuantity,omitempty" bson:"minimum_volume_quantity,omitempty"` // Minimum volume
-	MinimumVolumeString   *string                                         `json:"minimumVolumeString,omitempty" bson:"minimum_volume_string,omitempty"`     // Minimum volume
-	Additive              []SpecimenDefinitionTypeTestedContainerAdditive `json:"additive,omitempty" bson:"additive,omitempty"`                             // Additive associated with container
-	Preparation           *string                                         `json:"preparation,omitempty" bson:"preparation,omitempty"`                       // Special processing applied to the container for this specimen type
+	Id                   *string                                            `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                                        `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Material             *CodeableConcept                                   `json:"material,omitempty" bson:"material,omitempty"`                    // The material type used for the container
+	Type                 *CodeableConcept                                   `json:"type,omitempty" bson:"type,omitempty"`                            // Kind of container associated with the kind of specimen
+	Cap                  *CodeableConcept                                   `json:"cap,omitempty" bson:"cap,omitempty"`                              // Color of container cap
+	Description          *string                                            `json:"description,omitempty" bson:"description,omitempty"`              // The description of the kind of container
+	DescriptionElement   *Element                                           `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Capacity             *Quantity                                          `json:"capacity,omitempty" bson:"capacity,omitempty"`                    // The capacity of this kind of container
+	MinimumVolume        SpecimenDefinitionTypeTestedContainerMinimumVolume `json:"-" bson:"minimum_volume,omitempty"`                               // Minimum volume
+	MinimumVolumeElement *Element                                           `json:"-" bson:"minimum_volume_element,omitempty"`                       // Extensions for minimumVolume[x]
+	Additive             []SpecimenDefinitionTypeTestedContainerAdditive    `json:"additive,omitempty" bson:"additive,omitempty"`                    // Additive associated with container
+	Preparation          *string                                            `json:"preparation,omitempty" bson:"preparation,omitempty"`              // Special processing applied to the container for this specimen type
+	PreparationElement   *Element                                           `json:"_preparation,omitempty" bson:"preparation_element,omitempty"`     // Extensions for preparation
+
+	minimumVolumeVariants []string // JSON properties of SpecimenDefinition.typeTested.container.minimumVolume[x] when more than one was decoded
 }
 
 func (r *SpecimenDefinitionTypeTestedContainer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Material != nil {
 		if err := r.Material.Validate(); err != nil {
 			return fmt.Errorf("Material: %w", err)
@@ -208,14 +989,27 @@ func (r *SpecimenDefinitionTypeTestedContainer) Validate() error {
 			return fmt.Errorf("Cap: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	if r.Capacity != nil {
 		if err := r.Capacity.Validate(); err != nil {
 			return fmt.Errorf("Capacity: %w", err)
 		}
 	}
-	if r.MinimumVolumeQuantity != nil {
-		if err := r.MinimumVolumeQuantity.Validate(); err != nil {
-			return fmt.Errorf("MinimumVolumeQuantity: %w", err)
+	if len(r.minimumVolumeVariants) > 1 {
+		return fmt.Errorf("field 'MinimumVolume' must have a single type, got %v", r.minimumVolumeVariants)
+	}
+	if r.MinimumVolume != nil {
+		if err := r.MinimumVolume.Validate(); err != nil {
+			return fmt.Errorf("MinimumVolume: %w", err)
+		}
+	}
+	if r.MinimumVolumeElement != nil {
+		if err := r.MinimumVolumeElement.Validate(); err != nil {
+			return fmt.Errorf("MinimumVolumeElement: %w", err)
 		}
 	}
 	for i, item := range r.Additive {
@@ -223,44 +1017,407 @@ func (r *SpecimenDefinitionTypeTestedContainer) Validate() error {
 			return fmt.Errorf("Additive[%d]: %w", i, err)
 		}
 	}
+	if r.PreparationElement != nil {
+		if err := r.PreparationElement.Validate(); err != nil {
+			return fmt.Errorf("PreparationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Material != nil {
+		r.Material.validateAll(path+".material", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Cap != nil {
+		r.Cap.validateAll(path+".cap", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Capacity != nil {
+		r.Capacity.validateAll(path+".capacity", issues)
+	}
+	if len(r.minimumVolumeVariants) > 1 {
+		issues.add("structure", path+".minimumVolume", fmt.Sprintf("field 'MinimumVolume' must have a single type, got %v", r.minimumVolumeVariants))
+	}
+	if r.MinimumVolume != nil {
+		r.MinimumVolume.validateAll(path+".minimumVolume.ofType("+r.MinimumVolume.FHIRType()+")", issues)
+	}
+	if r.MinimumVolumeElement != nil {
+		r.MinimumVolumeElement.validateAll(path+".minimumVolume", issues)
+	}
+	for i, item := range r.Additive {
+		item.validateAll(fmt.Sprintf("%s.additive[%d]", path, i), issues)
+	}
+	if r.PreparationElement != nil {
+		r.PreparationElement.validateAll(path+".preparation", issues)
+	}
+}
+
+var specimenDefinitionTypeTestedContainerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) invariants() []invariant {
+	return specimenDefinitionTypeTestedContainerInvariants
+}
+
+func (r SpecimenDefinitionTypeTestedContainer) MarshalJSON() ([]byte, error) {
+	type alias SpecimenDefinitionTypeTestedContainer
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "minimumVolume", r.MinimumVolume, r.MinimumVolumeElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalJSON(data []byte) error {
+	type alias SpecimenDefinitionTypeTestedContainer
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.MinimumVolume, element, r.minimumVolumeVariants, err = unmarshalChoice(fields, "minimumVolume", specimenDefinitionTypeTestedContainerMinimumVolumeVariants); err != nil {
+		return err
+	}
+	r.MinimumVolumeElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.MinimumVolumeElement); err != nil {
+			return fmt.Errorf("_minimumVolume: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r SpecimenDefinitionTypeTestedContainer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenDefinitionTypeTestedContainer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "MinimumVolume":
+		return "minimumVolume", choiceValues(specimenDefinitionTypeTestedContainerMinimumVolumeVariants)
+	}
+	return "", nil
+}
+
+var specimenDefinitionTypeTestedContainerElementTypes = map[string]string{
+	"description": "markdown",
+	"preparation": "markdown",
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) elementTypes() map[string]string {
+	return specimenDefinitionTypeTestedContainerElementTypes
+}
+
+var specimenDefinitionTypeTestedContainerElementMetadata = []ElementMetadata{
+	{Path: "SpecimenDefinition.typeTested.container.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.typeTested.container.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SpecimenDefinition.typeTested.container.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SpecimenDefinition.typeTested.container.material", Name: "material", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested.container.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested.container.cap", Name: "cap", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested.container.description", Name: "description", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SpecimenDefinition.typeTested.container.capacity", Name: "capacity", Field: 8, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "SpecimenDefinition.typeTested.container.minimumVolume[x]", Name: "minimumVolume", Field: 9, Min: 0, Max: "1", Types: []string{"Quantity", "string"}},
+	{Path: "SpecimenDefinition.typeTested.container.additive", Name: "additive", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SpecimenDefinition.typeTested.container.preparation", Name: "preparation", Field: 12, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *SpecimenDefinitionTypeTestedContainer) ElementMetadata() []ElementMetadata {
+	return specimenDefinitionTypeTestedContainerElementMetadata
+}
+
+// SpecimenDefinitionTypeTestedContainerMinimumVolume is the type of SpecimenDefinition.typeTested.container.minimumVolume[x]. It is implemented by the SpecimenDefinitionTypeTestedContainerMinimumVolume* variant types.
+type SpecimenDefinitionTypeTestedContainerMinimumVolume interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenDefinitionTypeTestedContainerMinimumVolume()
+}
+
+// SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity is the Quantity variant of SpecimenDefinitionTypeTestedContainerMinimumVolume.
+type SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity struct {
+	Quantity
+}
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity) isSpecimenDefinitionTypeTestedContainerMinimumVolume() {
+}
+
+// SpecimenDefinitionTypeTestedContainerMinimumVolumeString is the string variant of SpecimenDefinitionTypeTestedContainerMinimumVolume.
+type SpecimenDefinitionTypeTestedContainerMinimumVolumeString string
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeString) Validate() error {
 	return nil
 }
 
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeString) FHIRType() string {
+	return "string"
+}
+
+func (v SpecimenDefinitionTypeTestedContainerMinimumVolumeString) isSpecimenDefinitionTypeTestedContainerMinimumVolume() {
+}
+
+var specimenDefinitionTypeTestedContainerMinimumVolumeVariants = []SpecimenDefinitionTypeTestedContainerMinimumVolume{
+	SpecimenDefinitionTypeTestedContainerMinimumVolumeQuantity{},
+	SpecimenDefinitionTypeTestedContainerMinimumVolumeString(""),
+}
+
 type SpecimenDefinitionTypeTestedContainerAdditive struct {
-	Id                      *string          `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	AdditiveCodeableConcept *CodeableConcept `json:"additiveCodeableConcept" bson:"additive_codeable_concept"` // Additive associated with container
-	AdditiveReference       *Reference       `json:"additiveReference" bson:"additive_reference"`              // Additive associated with container
+	Id                *string                                               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Additive          SpecimenDefinitionTypeTestedContainerAdditiveAdditive `json:"-" bson:"additive,omitempty"`                                     // Additive associated with container
+
+	additiveVariants []string // JSON properties of SpecimenDefinition.typeTested.container.additive.additive[x] when more than one was decoded
 }
 
 func (r *SpecimenDefinitionTypeTestedContainerAdditive) Validate() error {
-	if r.AdditiveCodeableConcept == nil {
-		return fmt.Errorf("field 'AdditiveCodeableConcept' is required")
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
 	}
-	if r.AdditiveCodeableConcept != nil {
-		if err := r.AdditiveCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("AdditiveCodeableConcept: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.AdditiveReference == nil {
-		return fmt.Errorf("field 'AdditiveReference' is required")
+	if len(r.additiveVariants) > 1 {
+		return fmt.Errorf("field 'Additive' must have a single type, got %v", r.additiveVariants)
+	}
+	if r.Additive == nil {
+		return fmt.Errorf("field 'Additive' is required")
 	}
-	if r.AdditiveReference != nil {
-		if err := r.AdditiveReference.Validate(); err != nil {
-			return fmt.Errorf("AdditiveReference: %w", err)
+	if r.Additive != nil {
+		if err := r.Additive.Validate(); err != nil {
+			return fmt.Errorf("Additive: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.additiveVariants) > 1 {
+		issues.add("structure", path+".additive", fmt.Sprintf("field 'Additive' must have a single type, got %v", r.additiveVariants))
+	}
+	if r.Additive == nil {
+		issues.add("required", path+".additive", "field 'Additive' is required")
+	}
+	if r.Additive != nil {
+		r.Additive.validateAll(path+".additive.ofType("+r.Additive.FHIRType()+")", issues)
+	}
+}
+
+var specimenDefinitionTypeTestedContainerAdditiveInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) invariants() []invariant {
+	return specimenDefinitionTypeTestedContainerAdditiveInvariants
+}
+
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalJSON() ([]byte, error) {
+	type alias SpecimenDefinitionTypeTestedContainerAdditive
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "additive", r.Additive, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalJSON(data []byte) error {
+	type alias SpecimenDefinitionTypeTestedContainerAdditive
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Additive, _, r.additiveVariants, err = unmarshalChoice(fields, "additive", specimenDefinitionTypeTestedContainerAdditiveAdditiveVariants); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenDefinitionTypeTestedContainerAdditive) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Additive":
+		return "additive", choiceValues(specimenDefinitionTypeTestedContainerAdditiveAdditiveVariants)
+	}
+	return "", nil
+}
+
+var specimenDefinitionTypeTestedContainerAdditiveElementMetadata = []ElementMetadata{
+	{Path: "SpecimenDefinition.typeTested.container.additive.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.typeTested.container.additive.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SpecimenDefinition.typeTested.container.additive.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SpecimenDefinition.typeTested.container.additive.additive[x]", Name: "additive", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Reference"}},
+}
+
+func (r *SpecimenDefinitionTypeTestedContainerAdditive) ElementMetadata() []ElementMetadata {
+	return specimenDefinitionTypeTestedContainerAdditiveElementMetadata
+}
+
+// SpecimenDefinitionTypeTestedContainerAdditiveAdditive is the type of SpecimenDefinition.typeTested.container.additive.additive[x]. It is implemented by the SpecimenDefinitionTypeTestedContainerAdditiveAdditive* variant types.
+type SpecimenDefinitionTypeTestedContainerAdditiveAdditive interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSpecimenDefinitionTypeTestedContainerAdditiveAdditive()
+}
+
+// SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept is the CodeableConcept variant of SpecimenDefinitionTypeTestedContainerAdditiveAdditive.
+type SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept) isSpecimenDefinitionTypeTestedContainerAdditiveAdditive() {
+}
+
+// SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference is the Reference variant of SpecimenDefinitionTypeTestedContainerAdditiveAdditive.
+type SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference struct {
+	Reference
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference) isSpecimenDefinitionTypeTestedContainerAdditiveAdditive() {
+}
+
+var specimenDefinitionTypeTestedContainerAdditiveAdditiveVariants = []SpecimenDefinitionTypeTestedContainerAdditiveAdditive{
+	SpecimenDefinitionTypeTestedContainerAdditiveAdditiveCodeableConcept{},
+	SpecimenDefinitionTypeTestedContainerAdditiveAdditiveReference{},
+}
+
 type SpecimenDefinitionTypeTestedHandling struct {
 	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension            []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension    []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
 	TemperatureQualifier *CodeableConcept `json:"temperatureQualifier,omitempty" bson:"temperature_qualifier,omitempty"` // Qualifies the interval of temperature
 	TemperatureRange     *Range           `json:"temperatureRange,omitempty" bson:"temperature_range,omitempty"`         // Temperature range for these handling instructions
 	MaxDuration          *Duration        `json:"maxDuration,omitempty" bson:"max_duration,omitempty"`                   // Maximum preservation time
 	Instruction          *string          `json:"instruction,omitempty" bson:"instruction,omitempty"`                    // Preservation instruction
+	InstructionElement   *Element         `json:"_instruction,omitempty" bson:"instruction_element,omitempty"`           // Extensions for instruction
 }
 
 func (r *SpecimenDefinitionTypeTestedHandling) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.TemperatureQualifier != nil {
 		if err := r.TemperatureQualifier.Validate(); err != nil {
 			return fmt.Errorf("TemperatureQualifier: %w", err)
@@ -276,5 +1433,77 @@ func (r *SpecimenDefinitionTypeTestedHandling) Validate() error {
 			return fmt.Errorf("MaxDuration: %w", err)
 		}
 	}
+	if r.InstructionElement != nil {
+		if err := r.InstructionElement.Validate(); err != nil {
+			return fmt.Errorf("InstructionElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *SpecimenDefinitionTypeTestedHandling) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TemperatureQualifier != nil {
+		r.TemperatureQualifier.validateAll(path+".temperatureQualifier", issues)
+	}
+	if r.TemperatureRange != nil {
+		r.TemperatureRange.validateAll(path+".temperatureRange", issues)
+	}
+	if r.MaxDuration != nil {
+		r.MaxDuration.validateAll(path+".maxDuration", issues)
+	}
+	if r.InstructionElement != nil {
+		r.InstructionElement.validateAll(path+".instruction", issues)
+	}
+}
+
+var specimenDefinitionTypeTestedHandlingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SpecimenDefinitionTypeTestedHandling) invariants() []invariant {
+	return specimenDefinitionTypeTestedHandlingInvariants
+}
+
+func (r SpecimenDefinitionTypeTestedHandling) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SpecimenDefinitionTypeTestedHandling) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SpecimenDefinitionTypeTestedHandling) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SpecimenDefinitionTypeTestedHandling) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var specimenDefinitionTypeTestedHandlingElementTypes = map[string]string{
+	"instruction": "markdown",
+}
+
+func (r *SpecimenDefinitionTypeTestedHandling) elementTypes() map[string]string {
+	return specimenDefinitionTypeTestedHandlingElementTypes
+}
+
+var specimenDefinitionTypeTestedHandlingElementMetadata = []ElementMetadata{
+	{Path: "SpecimenDefinition.typeTested.handling.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SpecimenDefinition.typeTested.handling.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SpecimenDefinition.typeTested.handling.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SpecimenDefinition.typeTested.handling.temperatureQualifier", Name: "temperatureQualifier", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SpecimenDefinition.typeTested.handling.temperatureRange", Name: "temperatureRange", Field: 4, Min: 0, Max: "1", Types: []string{"Range"}},
+	{Path: "SpecimenDefinition.typeTested.handling.maxDuration", Name: "maxDuration", Field: 5, Min: 0, Max: "1", Types: []string{"Duration"}},
+	{Path: "SpecimenDefinition.typeTested.handling.instruction", Name: "instruction", Field: 6, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *SpecimenDefinitionTypeTestedHandling) ElementMetadata() []ElementMetadata {
+	return specimenDefinitionTypeTestedHandlingElementMetadata
+}
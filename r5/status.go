@@ -1,9 +1,63 @@
 package models
 
-// This operation is used to get current status information about one or more topic-based Subscriptions, each described by a SubscriptionStatus resource.
-type Status struct {
+// StatusIn holds the input parameters of the $Status operation. This operation is used to get current status information about one or more topic-based Subscriptions, each described by a SubscriptionStatus resource.
+type StatusIn struct {
 }
 
-func (r *Status) Validate() error {
+func (p *StatusIn) Validate() error {
+	return nil
+}
+
+func (p *StatusIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *StatusIn) FromParameters(params *Parameters) error {
+	*p = StatusIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *StatusIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *StatusIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// StatusOut holds the output parameters of the $Status operation.
+type StatusOut struct {
+}
+
+func (p *StatusOut) Validate() error {
+	return nil
+}
+
+func (p *StatusOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *StatusOut) FromParameters(params *Parameters) error {
+	*p = StatusOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *StatusOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *StatusOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
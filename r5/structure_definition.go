@@ -2,48 +2,74 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A definition of a FHIR structure. This resource is used to describe the underlying resources, data types defined in FHIR, and also for describing extensions and constraints on resources and data types.
 type StructureDefinition struct {
-	ResourceType           string                           `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                          `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                            `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                          `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                          `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                       `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage                `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    string                           `json:"url" bson:"url"`                                                             // Canonical identifier for this structure definition, represented as a URI (globally unique)
-	Identifier             []Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the structure definition
-	Version                *string                          `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the structure definition
-	VersionAlgorithmString *string                          `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                          `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                           `json:"name" bson:"name"`                                                           // Name for this structure definition (computer friendly)
-	Title                  *string                          `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this structure definition (human friendly)
-	Status                 string                           `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                            `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                          `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                          `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                  `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                          `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the structure definition
-	UseContext             []UsageContext                   `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept                `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the structure definition (if applicable)
-	Purpose                *string                          `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this structure definition is defined
-	Copyright              *string                          `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                          `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Keyword                []Coding                         `json:"keyword,omitempty" bson:"keyword,omitempty"`                                 // Assist with indexing and finding
-	FhirVersion            *string                          `json:"fhirVersion,omitempty" bson:"fhir_version,omitempty"`                        // FHIR Version this StructureDefinition targets
-	Mapping                []StructureDefinitionMapping     `json:"mapping,omitempty" bson:"mapping,omitempty"`                                 // External specification that the content is mapped to
-	Kind                   string                           `json:"kind" bson:"kind"`                                                           // primitive-type | complex-type | resource | logical
-	Abstract               bool                             `json:"abstract" bson:"abstract"`                                                   // Whether the structure is abstract
-	Context                []StructureDefinitionContext     `json:"context,omitempty" bson:"context,omitempty"`                                 // If an extension, where it can be used in instances
-	ContextInvariant       []string                         `json:"contextInvariant,omitempty" bson:"context_invariant,omitempty"`              // FHIRPath invariants - when the extension can be used
-	Type                   string                           `json:"type" bson:"type"`                                                           // Type defined or constrained by this structure
-	BaseDefinition         *string                          `json:"baseDefinition,omitempty" bson:"base_definition,omitempty"`                  // Definition that this type is constrained/specialized from
-	Derivation             *string                          `json:"derivation,omitempty" bson:"derivation,omitempty"`                           // specialization | constraint - How relates to base definition
-	Snapshot               *StructureDefinitionSnapshot     `json:"snapshot,omitempty" bson:"snapshot,omitempty"`                               // Snapshot view of the structure
-	Differential           *StructureDefinitionDifferential `json:"differential,omitempty" bson:"differential,omitempty"`                       // Differential view of the structure
+	ResourceType            string                              `json:"resourceType" bson:"resource_type"`                                      // Type of resource
+	Id                      *string                             `json:"id,omitempty" bson:"id,omitempty"`                                       // Logical id of this artifact
+	Meta                    *Meta                               `json:"meta,omitempty" bson:"meta,omitempty"`                                   // Metadata about the resource
+	ImplicitRules           *string                             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`       // Extensions for implicitRules
+	Language                *string                             `json:"language,omitempty" bson:"language,omitempty"`                           // Language of the resource content
+	LanguageElement         *Element                            `json:"_language,omitempty" bson:"language_element,omitempty"`                  // Extensions for language
+	Text                    *Narrative                          `json:"text,omitempty" bson:"text,omitempty"`                                   // Text summary of the resource, for human interpretation
+	Contained               []Resource                          `json:"contained,omitempty" bson:"contained,omitempty"`                         // Contained, inline Resources
+	Extension               []Extension                         `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored
+	Url                     string                              `json:"url" bson:"url"`                                                         // Canonical identifier for this structure definition, represented as a URI (globally unique)
+	UrlElement              *Element                            `json:"_url,omitempty" bson:"url_element,omitempty"`                            // Extensions for url
+	Identifier              []Identifier                        `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // Additional identifier for the structure definition
+	Version                 *string                             `json:"version,omitempty" bson:"version,omitempty"`                             // Business version of the structure definition
+	VersionElement          *Element                            `json:"_version,omitempty" bson:"version_element,omitempty"`                    // Extensions for version
+	VersionAlgorithm        StructureDefinitionVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                   // How to compare versions
+	VersionAlgorithmElement *Element                            `json:"-" bson:"version_algorithm_element,omitempty"`                           // Extensions for versionAlgorithm[x]
+	Name                    string                              `json:"name" bson:"name"`                                                       // Name for this structure definition (computer friendly)
+	NameElement             *Element                            `json:"_name,omitempty" bson:"name_element,omitempty"`                          // Extensions for name
+	Title                   *string                             `json:"title,omitempty" bson:"title,omitempty"`                                 // Name for this structure definition (human friendly)
+	TitleElement            *Element                            `json:"_title,omitempty" bson:"title_element,omitempty"`                        // Extensions for title
+	Status                  PublicationStatus                   `json:"status" bson:"status"`                                                   // draft | active | retired | unknown
+	StatusElement           *Element                            `json:"_status,omitempty" bson:"status_element,omitempty"`                      // Extensions for status
+	Experimental            *bool                               `json:"experimental,omitempty" bson:"experimental,omitempty"`                   // For testing only - never for real usage
+	ExperimentalElement     *Element                            `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`          // Extensions for experimental
+	Date                    *DateTime                           `json:"date,omitempty" bson:"date,omitempty"`                                   // Date last changed
+	DateElement             *Element                            `json:"_date,omitempty" bson:"date_element,omitempty"`                          // Extensions for date
+	Publisher               *string                             `json:"publisher,omitempty" bson:"publisher,omitempty"`                         // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                            `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`                // Extensions for publisher
+	Contact                 []ContactDetail                     `json:"contact,omitempty" bson:"contact,omitempty"`                             // Contact details for the publisher
+	Description             *string                             `json:"description,omitempty" bson:"description,omitempty"`                     // Natural language description of the structure definition
+	DescriptionElement      *Element                            `json:"_description,omitempty" bson:"description_element,omitempty"`            // Extensions for description
+	UseContext              []UsageContext                      `json:"useContext,omitempty" bson:"use_context,omitempty"`                      // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                   `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                   // Jurisdiction of the authority that maintains the structure definition (if applicable)
+	Purpose                 *string                             `json:"purpose,omitempty" bson:"purpose,omitempty"`                             // Why this structure definition is defined
+	PurposeElement          *Element                            `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                    // Extensions for purpose
+	Copyright               *string                             `json:"copyright,omitempty" bson:"copyright,omitempty"`                         // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                            `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`                // Extensions for copyright
+	CopyrightLabel          *string                             `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`              // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                            `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`     // Extensions for copyrightLabel
+	Keyword                 []Coding                            `json:"keyword,omitempty" bson:"keyword,omitempty"`                             // Assist with indexing and finding
+	FhirVersion             *string                             `json:"fhirVersion,omitempty" bson:"fhir_version,omitempty"`                    // FHIR Version this StructureDefinition targets
+	FhirVersionElement      *Element                            `json:"_fhirVersion,omitempty" bson:"fhir_version_element,omitempty"`           // Extensions for fhirVersion
+	Mapping                 []StructureDefinitionMapping        `json:"mapping,omitempty" bson:"mapping,omitempty"`                             // External specification that the content is mapped to
+	Kind                    StructureDefinitionKind             `json:"kind" bson:"kind"`                                                       // primitive-type | complex-type | resource | logical
+	KindElement             *Element                            `json:"_kind,omitempty" bson:"kind_element,omitempty"`                          // Extensions for kind
+	Abstract                bool                                `json:"abstract" bson:"abstract"`                                               // Whether the structure is abstract
+	AbstractElement         *Element                            `json:"_abstract,omitempty" bson:"abstract_element,omitempty"`                  // Extensions for abstract
+	Context                 []StructureDefinitionContext        `json:"context,omitempty" bson:"context,omitempty"`                             // If an extension, where it can be used in instances
+	ContextInvariant        []string                            `json:"contextInvariant,omitempty" bson:"context_invariant,omitempty"`          // FHIRPath invariants - when the extension can be used
+	ContextInvariantElement []*Element                          `json:"_contextInvariant,omitempty" bson:"context_invariant_element,omitempty"` // Extensions for contextInvariant
+	Type                    string                              `json:"type" bson:"type"`                                                       // Type defined or constrained by this structure
+	TypeElement             *Element                            `json:"_type,omitempty" bson:"type_element,omitempty"`                          // Extensions for type
+	BaseDefinition          *string                             `json:"baseDefinition,omitempty" bson:"base_definition,omitempty"`              // Definition that this type is constrained/specialized from
+	BaseDefinitionElement   *Element                            `json:"_baseDefinition,omitempty" bson:"base_definition_element,omitempty"`     // Extensions for baseDefinition
+	Derivation              *string                             `json:"derivation,omitempty" bson:"derivation,omitempty"`                       // specialization | constraint - How relates to base definition
+	DerivationElement       *Element                            `json:"_derivation,omitempty" bson:"derivation_element,omitempty"`              // Extensions for derivation
+	Snapshot                *StructureDefinitionSnapshot        `json:"snapshot,omitempty" bson:"snapshot,omitempty"`                           // Snapshot view of the structure
+	Differential            *StructureDefinitionDifferential    `json:"differential,omitempty" bson:"differential,omitempty"`                   // Differential view of the structure
+
+	versionAlgorithmVariants []string // JSON properties of StructureDefinition.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *StructureDefinition) Validate() error {
@@ -55,36 +81,125 @@ func (r *StructureDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -95,27 +210,83 @@ func (r *StructureDefinition) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
 	for i, item := range r.Keyword {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Keyword[%d]: %w", i, err)
 		}
 	}
+	if r.FhirVersionElement != nil {
+		if err := r.FhirVersionElement.Validate(); err != nil {
+			return fmt.Errorf("FhirVersionElement: %w", err)
+		}
+	}
 	for i, item := range r.Mapping {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Mapping[%d]: %w", i, err)
 		}
 	}
-	if r.Kind == emptyString {
+	if r.Kind == "" {
 		return fmt.Errorf("field 'Kind' is required")
 	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
+	if r.AbstractElement != nil {
+		if err := r.AbstractElement.Validate(); err != nil {
+			return fmt.Errorf("AbstractElement: %w", err)
+		}
+	}
 	for i, item := range r.Context {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Context[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ContextInvariantElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ContextInvariantElement[%d]: %w", i, err)
+		}
+	}
 	if r.Type == emptyString {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.BaseDefinitionElement != nil {
+		if err := r.BaseDefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("BaseDefinitionElement: %w", err)
+		}
+	}
+	if r.DerivationElement != nil {
+		if err := r.DerivationElement.Validate(); err != nil {
+			return fmt.Errorf("DerivationElement: %w", err)
+		}
+	}
 	if r.Snapshot != nil {
 		if err := r.Snapshot.Validate(); err != nil {
 			return fmt.Errorf("Snapshot: %w", err)
@@ -126,48 +297,660 @@ func (r *StructureDefinition) Validate() error {
 			return fmt.Errorf("Differential: %w", err)
 		}
 	}
+	return validateInvariants(r, "StructureDefinition")
+}
+
+func (r *StructureDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "StructureDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'StructureDefinition', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	for i, item := range r.Keyword {
+		item.validateAll(fmt.Sprintf("%s.keyword[%d]", path, i), issues)
+	}
+	if r.FhirVersionElement != nil {
+		r.FhirVersionElement.validateAll(path+".fhirVersion", issues)
+	}
+	for i, item := range r.Mapping {
+		item.validateAll(fmt.Sprintf("%s.mapping[%d]", path, i), issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.AbstractElement != nil {
+		r.AbstractElement.validateAll(path+".abstract", issues)
+	}
+	for i, item := range r.Context {
+		item.validateAll(fmt.Sprintf("%s.context[%d]", path, i), issues)
+	}
+	for i, item := range r.ContextInvariantElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.contextInvariant[%d]", path, i), issues)
+	}
+	if r.Type == emptyString {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.BaseDefinitionElement != nil {
+		r.BaseDefinitionElement.validateAll(path+".baseDefinition", issues)
+	}
+	if r.DerivationElement != nil {
+		r.DerivationElement.validateAll(path+".derivation", issues)
+	}
+	if r.Snapshot != nil {
+		r.Snapshot.validateAll(path+".snapshot", issues)
+	}
+	if r.Differential != nil {
+		r.Differential.validateAll(path+".differential", issues)
+	}
+}
+
+func (r *StructureDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("StructureDefinition", &issues)
+	checkInvariants(r, "StructureDefinition", &issues)
+	return issues
+}
+
+var structureDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *StructureDefinition) invariants() []invariant {
+	return structureDefinitionInvariants
+}
+
+func (r StructureDefinition) MarshalJSON() ([]byte, error) {
+	type alias StructureDefinition
+	out := struct {
+		alias
+		ContextInvariant        []*string  `json:"contextInvariant,omitempty"`
+		ContextInvariantElement []*Element `json:"_contextInvariant,omitempty"`
+	}{alias: alias(r)}
+	out.ContextInvariant, out.ContextInvariantElement = alignPrimitiveArray(r.ContextInvariant, r.ContextInvariantElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *StructureDefinition) UnmarshalJSON(data []byte) error {
+	type alias StructureDefinition
+	aux := struct {
+		*alias
+		Contained        []json.RawMessage `json:"contained,omitempty"`
+		ContextInvariant []*string         `json:"contextInvariant,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.ContextInvariant, err = splitPrimitiveArray(aux.ContextInvariant, r.ContextInvariantElement); err != nil {
+		return fmt.Errorf("contextInvariant: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", structureDefinitionVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r StructureDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *StructureDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(structureDefinitionVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var structureDefinitionElementTypes = map[string]string{
+	"id":             "id",
+	"implicitRules":  "uri",
+	"language":       "code",
+	"url":            "uri",
+	"description":    "markdown",
+	"purpose":        "markdown",
+	"copyright":      "markdown",
+	"fhirVersion":    "code",
+	"type":           "uri",
+	"baseDefinition": "canonical",
+	"derivation":     "code",
+}
+
+func (r *StructureDefinition) elementTypes() map[string]string {
+	return structureDefinitionElementTypes
+}
+
+var structureDefinitionElementMetadata = []ElementMetadata{
+	{Path: "StructureDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "StructureDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "StructureDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "StructureDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "StructureDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "StructureDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "StructureDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureDefinition.url", Name: "url", Field: 11, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "StructureDefinition.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "StructureDefinition.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "StructureDefinition.name", Name: "name", Field: 18, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureDefinition.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "StructureDefinition.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "StructureDefinition.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "StructureDefinition.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "StructureDefinition.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "StructureDefinition.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "StructureDefinition.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "StructureDefinition.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "StructureDefinition.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "StructureDefinition.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.keyword", Name: "keyword", Field: 41, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "StructureDefinition.fhirVersion", Name: "fhirVersion", Field: 42, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "StructureDefinition.mapping", Name: "mapping", Field: 44, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureDefinition.kind", Name: "kind", Field: 45, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureDefinition.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/structure-definition-kind"}},
+	{Path: "StructureDefinition.abstract", Name: "abstract", Field: 47, Min: 1, Max: "1", Types: []string{"boolean"}},
+	{Path: "StructureDefinition.context", Name: "context", Field: 49, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureDefinition.contextInvariant", Name: "contextInvariant", Field: 50, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "StructureDefinition.type", Name: "type", Field: 52, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "StructureDefinition.baseDefinition", Name: "baseDefinition", Field: 54, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "StructureDefinition.derivation", Name: "derivation", Field: 56, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "StructureDefinition.snapshot", Name: "snapshot", Field: 58, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "StructureDefinition.differential", Name: "differential", Field: 59, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *StructureDefinition) ElementMetadata() []ElementMetadata {
+	return structureDefinitionElementMetadata
+}
+
+// StructureDefinitionVersionAlgorithm is the type of StructureDefinition.versionAlgorithm[x]. It is implemented by the StructureDefinitionVersionAlgorithm* variant types.
+type StructureDefinitionVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isStructureDefinitionVersionAlgorithm()
+}
+
+// StructureDefinitionVersionAlgorithmString is the string variant of StructureDefinitionVersionAlgorithm.
+type StructureDefinitionVersionAlgorithmString string
+
+func (v StructureDefinitionVersionAlgorithmString) Validate() error {
 	return nil
 }
 
+func (v StructureDefinitionVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v StructureDefinitionVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v StructureDefinitionVersionAlgorithmString) isStructureDefinitionVersionAlgorithm() {}
+
+// StructureDefinitionVersionAlgorithmCoding is the Coding variant of StructureDefinitionVersionAlgorithm.
+type StructureDefinitionVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v StructureDefinitionVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v StructureDefinitionVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v StructureDefinitionVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v StructureDefinitionVersionAlgorithmCoding) isStructureDefinitionVersionAlgorithm() {}
+
+var structureDefinitionVersionAlgorithmVariants = []StructureDefinitionVersionAlgorithm{
+	StructureDefinitionVersionAlgorithmString(""),
+	StructureDefinitionVersionAlgorithmCoding{},
+}
+
+func (r *StructureDefinition) GetResourceType() string {
+	return "StructureDefinition"
+}
+
+func (r *StructureDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *StructureDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *StructureDefinition) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *StructureDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *StructureDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *StructureDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *StructureDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*StructureDefinition)(nil)
+
 type StructureDefinitionMapping struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Identity string  `json:"identity" bson:"identity"`                   // Internal id when this mapping is used
-	Uri      *string `json:"uri,omitempty" bson:"uri,omitempty"`         // Identifies what this mapping refers to
-	Name     *string `json:"name,omitempty" bson:"name,omitempty"`       // Names what this mapping refers to
-	Comment  *string `json:"comment,omitempty" bson:"comment,omitempty"` // Versions, Issues, Scope limitations etc
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identity          string      `json:"identity" bson:"identity"`                                        // Internal id when this mapping is used
+	IdentityElement   *Element    `json:"_identity,omitempty" bson:"identity_element,omitempty"`           // Extensions for identity
+	Uri               *string     `json:"uri,omitempty" bson:"uri,omitempty"`                              // Identifies what this mapping refers to
+	UriElement        *Element    `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+	Name              *string     `json:"name,omitempty" bson:"name,omitempty"`                            // Names what this mapping refers to
+	NameElement       *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Comment           *string     `json:"comment,omitempty" bson:"comment,omitempty"`                      // Versions, Issues, Scope limitations etc
+	CommentElement    *Element    `json:"_comment,omitempty" bson:"comment_element,omitempty"`             // Extensions for comment
 }
 
 func (r *StructureDefinitionMapping) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Identity == emptyString {
 		return fmt.Errorf("field 'Identity' is required")
 	}
+	if r.IdentityElement != nil {
+		if err := r.IdentityElement.Validate(); err != nil {
+			return fmt.Errorf("IdentityElement: %w", err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.CommentElement != nil {
+		if err := r.CommentElement.Validate(); err != nil {
+			return fmt.Errorf("CommentElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *StructureDefinitionMapping) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Identity == emptyString {
+		issues.add("required", path+".identity", "field 'Identity' is required")
+	}
+	if r.IdentityElement != nil {
+		r.IdentityElement.validateAll(path+".identity", issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.CommentElement != nil {
+		r.CommentElement.validateAll(path+".comment", issues)
+	}
+}
+
+var structureDefinitionMappingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureDefinitionMapping) invariants() []invariant {
+	return structureDefinitionMappingInvariants
+}
+
+func (r StructureDefinitionMapping) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureDefinitionMapping) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureDefinitionMapping) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureDefinitionMapping) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureDefinitionMappingElementTypes = map[string]string{
+	"identity": "id",
+	"uri":      "uri",
+}
+
+func (r *StructureDefinitionMapping) elementTypes() map[string]string {
+	return structureDefinitionMappingElementTypes
+}
+
+var structureDefinitionMappingElementMetadata = []ElementMetadata{
+	{Path: "StructureDefinition.mapping.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.mapping.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureDefinition.mapping.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureDefinition.mapping.identity", Name: "identity", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "StructureDefinition.mapping.uri", Name: "uri", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "StructureDefinition.mapping.name", Name: "name", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.mapping.comment", Name: "comment", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureDefinitionMapping) ElementMetadata() []ElementMetadata {
+	return structureDefinitionMappingElementMetadata
+}
+
 type StructureDefinitionContext struct {
-	Id         *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Type       string  `json:"type" bson:"type"`                 // fhirpath | element | extension
-	Expression string  `json:"expression" bson:"expression"`     // Where the extension can be used in instances
+	Id                *string              `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              ExtensionContextType `json:"type" bson:"type"`                                                // fhirpath | element | extension
+	TypeElement       *Element             `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Expression        string               `json:"expression" bson:"expression"`                                    // Where the extension can be used in instances
+	ExpressionElement *Element             `json:"_expression,omitempty" bson:"expression_element,omitempty"`       // Extensions for expression
 }
 
 func (r *StructureDefinitionContext) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Expression == emptyString {
 		return fmt.Errorf("field 'Expression' is required")
 	}
+	if r.ExpressionElement != nil {
+		if err := r.ExpressionElement.Validate(); err != nil {
+			return fmt.Errorf("ExpressionElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *StructureDefinitionContext) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	var emptyString string
+	if r.Expression == emptyString {
+		issues.add("required", path+".expression", "field 'Expression' is required")
+	}
+	if r.ExpressionElement != nil {
+		r.ExpressionElement.validateAll(path+".expression", issues)
+	}
+}
+
+var structureDefinitionContextInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureDefinitionContext) invariants() []invariant {
+	return structureDefinitionContextInvariants
+}
+
+func (r StructureDefinitionContext) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureDefinitionContext) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureDefinitionContext) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureDefinitionContext) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureDefinitionContextElementMetadata = []ElementMetadata{
+	{Path: "StructureDefinition.context.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.context.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureDefinition.context.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureDefinition.context.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureDefinition.context.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/extension-context-type"}},
+	{Path: "StructureDefinition.context.expression", Name: "expression", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureDefinitionContext) ElementMetadata() []ElementMetadata {
+	return structureDefinitionContextElementMetadata
+}
+
 type StructureDefinitionSnapshot struct {
-	Id      *string             `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Element []ElementDefinition `json:"element" bson:"element"`           // Definition of elements in the resource (if no StructureDefinition)
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Element           []ElementDefinition `json:"element" bson:"element"`                                          // Definition of elements in the resource (if no StructureDefinition)
 }
 
 func (r *StructureDefinitionSnapshot) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if len(r.Element) < 1 {
 		return fmt.Errorf("field 'Element' must have at least 1 elements")
 	}
@@ -179,12 +962,74 @@ func (r *StructureDefinitionSnapshot) Validate() error {
 	return nil
 }
 
+func (r *StructureDefinitionSnapshot) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Element) < 1 {
+		issues.add("required", path+".element", "field 'Element' must have at least 1 elements")
+	}
+	for i, item := range r.Element {
+		item.validateAll(fmt.Sprintf("%s.element[%d]", path, i), issues)
+	}
+}
+
+var structureDefinitionSnapshotInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureDefinitionSnapshot) invariants() []invariant {
+	return structureDefinitionSnapshotInvariants
+}
+
+func (r StructureDefinitionSnapshot) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureDefinitionSnapshot) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureDefinitionSnapshot) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureDefinitionSnapshot) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureDefinitionSnapshotElementMetadata = []ElementMetadata{
+	{Path: "StructureDefinition.snapshot.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.snapshot.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureDefinition.snapshot.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureDefinition.snapshot.element", Name: "element", Field: 3, Min: 1, Max: "*", Types: []string{"ElementDefinition"}},
+}
+
+func (r *StructureDefinitionSnapshot) ElementMetadata() []ElementMetadata {
+	return structureDefinitionSnapshotElementMetadata
+}
+
 type StructureDefinitionDifferential struct {
-	Id      *string             `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Element []ElementDefinition `json:"element" bson:"element"`           // Definition of elements in the resource (if no StructureDefinition)
+	Id                *string             `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Element           []ElementDefinition `json:"element" bson:"element"`                                          // Definition of elements in the resource (if no StructureDefinition)
 }
 
 func (r *StructureDefinitionDifferential) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if len(r.Element) < 1 {
 		return fmt.Errorf("field 'Element' must have at least 1 elements")
 	}
@@ -195,3 +1040,53 @@ func (r *StructureDefinitionDifferential) Validate() error {
 	}
 	return nil
 }
+
+func (r *StructureDefinitionDifferential) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.Element) < 1 {
+		issues.add("required", path+".element", "field 'Element' must have at least 1 elements")
+	}
+	for i, item := range r.Element {
+		item.validateAll(fmt.Sprintf("%s.element[%d]", path, i), issues)
+	}
+}
+
+var structureDefinitionDifferentialInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureDefinitionDifferential) invariants() []invariant {
+	return structureDefinitionDifferentialInvariants
+}
+
+func (r StructureDefinitionDifferential) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureDefinitionDifferential) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureDefinitionDifferential) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureDefinitionDifferential) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureDefinitionDifferentialElementMetadata = []ElementMetadata{
+	{Path: "StructureDefinition.differential.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureDefinition.differential.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureDefinition.differential.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureDefinition.differential.element", Name: "element", Field: 3, Min: 1, Max: "*", Types: []string{"ElementDefinition"}},
+}
+
+func (r *StructureDefinitionDifferential) ElementMetadata() []ElementMetadata {
+	return structureDefinitionDifferentialElementMetadata
+}
@@ -2,40 +2,60 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A Map of relationships between 2 structures that can be used to transform data.
 type StructureMap struct {
-	ResourceType           string                  `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                 `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                 `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    string                  `json:"url" bson:"url"`                                                             // Canonical identifier for this structure map, represented as a URI (globally unique)
-	Identifier             []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the structure map
-	Version                *string                 `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the structure map
-	VersionAlgorithmString *string                 `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                 `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   string                  `json:"name" bson:"name"`                                                           // Name for this structure map (computer friendly)
-	Title                  *string                 `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this structure map (human friendly)
-	Status                 string                  `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                   `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string                 `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string                 `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail         `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                 `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the structure map
-	UseContext             []UsageContext          `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept       `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the structure map (if applicable)
-	Purpose                *string                 `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this structure map is defined
-	Copyright              *string                 `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                 `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Structure              []StructureMapStructure `json:"structure,omitempty" bson:"structure,omitempty"`                             // Structure Definition used by this map
-	Import                 []string                `json:"import,omitempty" bson:"import,omitempty"`                                   // Other maps used by this map (canonical URLs)
-	Const                  []StructureMapConst     `json:"const,omitempty" bson:"const,omitempty"`                                     // Definition of the constant value used in the map rules
-	Group                  []StructureMapGroup     `json:"group" bson:"group"`                                                         // Named sections for reader convenience
+	ResourceType            string                       `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                      `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                        `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                      `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                     `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                      `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                     `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                   `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                   `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     string                       `json:"url" bson:"url"`                                                     // Canonical identifier for this structure map, represented as a URI (globally unique)
+	UrlElement              *Element                     `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                 `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the structure map
+	Version                 *string                      `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the structure map
+	VersionElement          *Element                     `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        StructureMapVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                     `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    string                       `json:"name" bson:"name"`                                                   // Name for this structure map (computer friendly)
+	NameElement             *Element                     `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                      `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this structure map (human friendly)
+	TitleElement            *Element                     `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus            `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                     `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                        `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                     `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                    `json:"date,omitempty" bson:"date,omitempty"`                               // Date last changed
+	DateElement             *Element                     `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                      `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                     `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail              `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                      `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the structure map
+	DescriptionElement      *Element                     `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext               `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept            `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the structure map (if applicable)
+	Purpose                 *string                      `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this structure map is defined
+	PurposeElement          *Element                     `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                      `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                     `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                      `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                     `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	Structure               []StructureMapStructure      `json:"structure,omitempty" bson:"structure,omitempty"`                     // Structure Definition used by this map
+	Import                  []string                     `json:"import,omitempty" bson:"import,omitempty"`                           // Other maps used by this map (canonical URLs)
+	ImportElement           []*Element                   `json:"_import,omitempty" bson:"import_element,omitempty"`                  // Extensions for import
+	Const                   []StructureMapConst          `json:"const,omitempty" bson:"const,omitempty"`                             // Definition of the constant value used in the map rules
+	Group                   []StructureMapGroup          `json:"group" bson:"group"`                                                 // Named sections for reader convenience
+
+	versionAlgorithmVariants []string // JSON properties of StructureMap.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *StructureMap) Validate() error {
@@ -47,36 +67,125 @@ func (r *StructureMap) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
 		}
 	}
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -87,11 +196,34 @@ func (r *StructureMap) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
 	for i, item := range r.Structure {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Structure[%d]: %w", i, err)
 		}
 	}
+	for i, item := range r.ImportElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ImportElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Const {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Const[%d]: %w", i, err)
@@ -105,226 +237,1855 @@ func (r *StructureMap) Validate() error {
 			return fmt.Errorf("Group[%d]: %w", i, err)
 		}
 	}
-	return nil
-}
-
-type StructureMapStructure struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Url           string  `json:"url" bson:"url"`                                         // Canonical reference to structure definition
-	Mode          string  `json:"mode" bson:"mode"`                                       // source | queried | target | produced
-	Alias         *string `json:"alias,omitempty" bson:"alias,omitempty"`                 // Name for type in this map
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Documentation on use of structure
+	return validateInvariants(r, "StructureMap")
 }
 
-func (r *StructureMapStructure) Validate() error {
+func (r *StructureMap) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "StructureMap" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'StructureMap', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
 	var emptyString string
 	if r.Url == emptyString {
-		return fmt.Errorf("field 'Url' is required")
+		issues.add("required", path+".url", "field 'Url' is required")
 	}
-	if r.Mode == emptyString {
-		return fmt.Errorf("field 'Mode' is required")
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
 	}
-	return nil
-}
-
-type StructureMapGroup struct {
-	Id            *string                  `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          string                   `json:"name" bson:"name"`                                       // Human-readable label
-	Extends       *string                  `json:"extends,omitempty" bson:"extends,omitempty"`             // Another group that this group adds rules to
-	TypeMode      *string                  `json:"typeMode,omitempty" bson:"type_mode,omitempty"`          // types | type-and-types
-	Documentation *string                  `json:"documentation,omitempty" bson:"documentation,omitempty"` // Additional description/explanation for group
-	Input         []StructureMapGroupInput `json:"input" bson:"input"`                                     // Named instance provided when invoking the map
-	Rule          []StructureMapGroupRule  `json:"rule,omitempty" bson:"rule,omitempty"`                   // Transform Rule from source to target
-}
-
-func (r *StructureMapGroup) Validate() error {
-	var emptyString string
 	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+		issues.add("required", path+".name", "field 'Name' is required")
 	}
-	if len(r.Input) < 1 {
-		return fmt.Errorf("field 'Input' must have at least 1 elements")
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
 	}
-	for i, item := range r.Input {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Input[%d]: %w", i, err)
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	for i, item := range r.Rule {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Rule[%d]: %w", i, err)
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	for i, item := range r.Structure {
+		item.validateAll(fmt.Sprintf("%s.structure[%d]", path, i), issues)
+	}
+	for i, item := range r.ImportElement {
+		if item == nil {
+			continue
 		}
+		item.validateAll(fmt.Sprintf("%s.import[%d]", path, i), issues)
+	}
+	for i, item := range r.Const {
+		item.validateAll(fmt.Sprintf("%s.const[%d]", path, i), issues)
+	}
+	if len(r.Group) < 1 {
+		issues.add("required", path+".group", "field 'Group' must have at least 1 elements")
+	}
+	for i, item := range r.Group {
+		item.validateAll(fmt.Sprintf("%s.group[%d]", path, i), issues)
 	}
-	return nil
 }
 
-type StructureMapGroupInput struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          string  `json:"name" bson:"name"`                                       // Name for this instance of data
-	Type          *string `json:"type,omitempty" bson:"type,omitempty"`                   // Type for this instance of data
-	Mode          string  `json:"mode" bson:"mode"`                                       // source | target
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Documentation for this instance of data
+func (r *StructureMap) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("StructureMap", &issues)
+	checkInvariants(r, "StructureMap", &issues)
+	return issues
 }
 
-func (r *StructureMapGroupInput) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
-	if r.Mode == emptyString {
-		return fmt.Errorf("field 'Mode' is required")
-	}
-	return nil
+var structureMapInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type StructureMapGroupRule struct {
-	Id            *string                          `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          *string                          `json:"name,omitempty" bson:"name,omitempty"`                   // Name of the rule for internal references
-	Source        []StructureMapGroupRuleSource    `json:"source" bson:"source"`                                   // Source inputs to the mapping
-	Target        []StructureMapGroupRuleTarget    `json:"target,omitempty" bson:"target,omitempty"`               // Content to create because of this mapping rule
-	Rule          []StructureMapGroupRule          `json:"rule,omitempty" bson:"rule,omitempty"`                   // Rules contained in this rule
-	Dependent     []StructureMapGroupRuleDependent `json:"dependent,omitempty" bson:"dependent,omitempty"`         // Which other rules to apply in the context of this rule
-	Documentation *string                          `json:"documentation,omitempty" bson:"documentation,omitempty"` // Documentation for this instance of data
+func (r *StructureMap) invariants() []invariant {
+	return structureMapInvariants
 }
 
-func (r *StructureMapGroupRule) Validate() error {
-	if len(r.Source) < 1 {
-		return fmt.Errorf("field 'Source' must have at least 1 elements")
+func (r StructureMap) MarshalJSON() ([]byte, error) {
+	type alias StructureMap
+	out := struct {
+		alias
+		Import        []*string  `json:"import,omitempty"`
+		ImportElement []*Element `json:"_import,omitempty"`
+	}{alias: alias(r)}
+	out.Import, out.ImportElement = alignPrimitiveArray(r.Import, r.ImportElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	for i, item := range r.Source {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Source[%d]: %w", i, err)
-		}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
 	}
-	for i, item := range r.Target {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Target[%d]: %w", i, err)
-		}
+	return data, nil
+}
+
+func (r *StructureMap) UnmarshalJSON(data []byte) error {
+	type alias StructureMap
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+		Import    []*string         `json:"import,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	for i, item := range r.Rule {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Rule[%d]: %w", i, err)
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	for i, item := range r.Dependent {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Dependent[%d]: %w", i, err)
+	if r.Import, err = splitPrimitiveArray(aux.Import, r.ImportElement); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", structureMapVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type StructureMapGroupRuleSource struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Context      string  `json:"context" bson:"context"`                                // Type or variable this rule applies to
-	Min          *int    `json:"min,omitempty" bson:"min,omitempty"`                    // Specified minimum cardinality
-	Max          *string `json:"max,omitempty" bson:"max,omitempty"`                    // Specified maximum cardinality (number or *)
-	Type         *string `json:"type,omitempty" bson:"type,omitempty"`                  // Rule only applies if source has this type
-	DefaultValue *string `json:"defaultValue,omitempty" bson:"default_value,omitempty"` // Default value if no value exists
-	Element      *string `json:"element,omitempty" bson:"element,omitempty"`            // Optional field for this source
-	ListMode     *string `json:"listMode,omitempty" bson:"list_mode,omitempty"`         // first | not_first | last | not_last | only_one
-	Variable     *string `json:"variable,omitempty" bson:"variable,omitempty"`          // Named context for field, if a field is specified
-	Condition    *string `json:"condition,omitempty" bson:"condition,omitempty"`        // FHIRPath expression  - must be true or the rule does not apply
-	Check        *string `json:"check,omitempty" bson:"check,omitempty"`                // FHIRPath expression  - must be true or the mapping engine throws an error instead of completing
-	LogMessage   *string `json:"logMessage,omitempty" bson:"log_message,omitempty"`     // Message to put in log if source exists (FHIRPath)
+func (r StructureMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *StructureMapGroupRuleSource) Validate() error {
-	var emptyString string
-	if r.Context == emptyString {
-		return fmt.Errorf("field 'Context' is required")
+func (r *StructureMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMap) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMap) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *StructureMap) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(structureMapVersionAlgorithmVariants)
 	}
+	return "", nil
+}
+
+var structureMapElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+	"import":        "canonical",
+}
+
+func (r *StructureMap) elementTypes() map[string]string {
+	return structureMapElementTypes
+}
+
+var structureMapElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "StructureMap.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "StructureMap.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "StructureMap.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "StructureMap.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "StructureMap.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.url", Name: "url", Field: 11, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "StructureMap.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "StructureMap.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "StructureMap.name", Name: "name", Field: 18, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "StructureMap.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "StructureMap.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "StructureMap.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "StructureMap.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "StructureMap.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "StructureMap.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "StructureMap.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "StructureMap.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "StructureMap.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.structure", Name: "structure", Field: 41, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.import", Name: "import", Field: 42, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "StructureMap.const", Name: "const", Field: 44, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.group", Name: "group", Field: 45, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *StructureMap) ElementMetadata() []ElementMetadata {
+	return structureMapElementMetadata
+}
+
+// StructureMapVersionAlgorithm is the type of StructureMap.versionAlgorithm[x]. It is implemented by the StructureMapVersionAlgorithm* variant types.
+type StructureMapVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isStructureMapVersionAlgorithm()
+}
+
+// StructureMapVersionAlgorithmString is the string variant of StructureMapVersionAlgorithm.
+type StructureMapVersionAlgorithmString string
+
+func (v StructureMapVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type StructureMapGroupRuleTarget struct {
-	Id         *string                                `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Context    *string                                `json:"context,omitempty" bson:"context,omitempty"`         // Variable this rule applies to
-	Element    *string                                `json:"element,omitempty" bson:"element,omitempty"`         // Field to create in the context
-	Variable   *string                                `json:"variable,omitempty" bson:"variable,omitempty"`       // Named context for field, if desired, and a field is specified
-	ListMode   []string                               `json:"listMode,omitempty" bson:"list_mode,omitempty"`      // first | share | last | single
-	ListRuleId *string                                `json:"listRuleId,omitempty" bson:"list_rule_id,omitempty"` // Internal rule reference for shared list items
-	Transform  *string                                `json:"transform,omitempty" bson:"transform,omitempty"`     // create | copy +
-	Parameter  []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`     // Parameters to the transform
+func (v StructureMapVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v StructureMapVersionAlgorithmString) FHIRType() string {
+	return "string"
 }
 
-func (r *StructureMapGroupRuleTarget) Validate() error {
-	for i, item := range r.Parameter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Parameter[%d]: %w", i, err)
-		}
+func (v StructureMapVersionAlgorithmString) isStructureMapVersionAlgorithm() {}
+
+// StructureMapVersionAlgorithmCoding is the Coding variant of StructureMapVersionAlgorithm.
+type StructureMapVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v StructureMapVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v StructureMapVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v StructureMapVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v StructureMapVersionAlgorithmCoding) isStructureMapVersionAlgorithm() {}
+
+var structureMapVersionAlgorithmVariants = []StructureMapVersionAlgorithm{
+	StructureMapVersionAlgorithmString(""),
+	StructureMapVersionAlgorithmCoding{},
+}
+
+func (r *StructureMap) GetResourceType() string {
+	return "StructureMap"
+}
+
+func (r *StructureMap) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	return nil
+	return *r.Id
 }
 
-type StructureMapGroupRuleTargetParameter struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	ValueId       *string  `json:"valueId" bson:"value_id"`              // Parameter value - variable or literal
-	ValueString   *string  `json:"valueString" bson:"value_string"`      // Parameter value - variable or literal
-	ValueBoolean  *bool    `json:"valueBoolean" bson:"value_boolean"`    // Parameter value - variable or literal
-	ValueInteger  *int     `json:"valueInteger" bson:"value_integer"`    // Parameter value - variable or literal
-	ValueDecimal  *float64 `json:"valueDecimal" bson:"value_decimal"`    // Parameter value - variable or literal
-	ValueDate     *string  `json:"valueDate" bson:"value_date"`          // Parameter value - variable or literal
-	ValueTime     *string  `json:"valueTime" bson:"value_time"`          // Parameter value - variable or literal
-	ValueDateTime *string  `json:"valueDateTime" bson:"value_date_time"` // Parameter value - variable or literal
+func (r *StructureMap) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
 }
 
-func (r *StructureMapGroupRuleTargetParameter) Validate() error {
-	if r.ValueId == nil {
-		return fmt.Errorf("field 'ValueId' is required")
+func (r *StructureMap) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *StructureMap) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *StructureMap) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *StructureMap) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *StructureMap) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*StructureMap)(nil)
+
+type StructureMapStructure struct {
+	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Url                  string                `json:"url" bson:"url"`                                                  // Canonical reference to structure definition
+	UrlElement           *Element              `json:"_url,omitempty" bson:"url_element,omitempty"`                     // Extensions for url
+	Mode                 StructureMapModelMode `json:"mode" bson:"mode"`                                                // source | queried | target | produced
+	ModeElement          *Element              `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Alias                *string               `json:"alias,omitempty" bson:"alias,omitempty"`                          // Name for type in this map
+	AliasElement         *Element              `json:"_alias,omitempty" bson:"alias_element,omitempty"`                 // Extensions for alias
+	Documentation        *string               `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Documentation on use of structure
+	DocumentationElement *Element              `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *StructureMapStructure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	var emptyString string
+	if r.Url == emptyString {
+		return fmt.Errorf("field 'Url' is required")
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.Mode == "" {
+		return fmt.Errorf("field 'Mode' is required")
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	if !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", r.Mode)
 	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
 	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
+	if r.AliasElement != nil {
+		if err := r.AliasElement.Validate(); err != nil {
+			return fmt.Errorf("AliasElement: %w", err)
+		}
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
 	}
 	return nil
 }
 
-type StructureMapConst struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // Unique id for inter-element referencing
-	Name  *string `json:"name,omitempty" bson:"name,omitempty"`   // Constant name
-	Value *string `json:"value,omitempty" bson:"value,omitempty"` // FHIRPath exression - value of the constant
+func (r *StructureMapStructure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	if r.Mode == "" {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != "" && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	if r.AliasElement != nil {
+		r.AliasElement.validateAll(path+".alias", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
 }
 
-func (r *StructureMapConst) Validate() error {
-	return nil
+var structureMapStructureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-type StructureMapGroupRuleDependent struct {
-	Id        *string                                `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Name      string                                 `json:"name" bson:"name"`                 // Name of a rule or group to apply
-	Parameter []StructureMapGroupRuleTargetParameter `json:"parameter" bson:"parameter"`       // Parameter to pass to the rule or group
+func (r *StructureMapStructure) invariants() []invariant {
+	return structureMapStructureInvariants
 }
 
-func (r *StructureMapGroupRuleDependent) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
-	}
-	if len(r.Parameter) < 1 {
-		return fmt.Errorf("field 'Parameter' must have at least 1 elements")
-	}
-	for i, item := range r.Parameter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Parameter[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r StructureMapStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapStructure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapStructureElementTypes = map[string]string{
+	"url": "canonical",
+}
+
+func (r *StructureMapStructure) elementTypes() map[string]string {
+	return structureMapStructureElementTypes
+}
+
+var structureMapStructureElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.structure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.structure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.structure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.structure.url", Name: "url", Field: 3, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "StructureMap.structure.mode", Name: "mode", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.structure.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-model-mode"}},
+	{Path: "StructureMap.structure.alias", Name: "alias", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.structure.documentation", Name: "documentation", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureMapStructure) ElementMetadata() []ElementMetadata {
+	return structureMapStructureElementMetadata
+}
+
+type StructureMapConst struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              *string     `json:"name,omitempty" bson:"name,omitempty"`                            // Constant name
+	NameElement       *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Value             *string     `json:"value,omitempty" bson:"value,omitempty"`                          // FHIRPath exression - value of the constant
+	ValueElement      *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *StructureMapConst) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapConst) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var structureMapConstInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapConst) invariants() []invariant {
+	return structureMapConstInvariants
+}
+
+func (r StructureMapConst) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapConst) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapConst) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapConst) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapConstElementTypes = map[string]string{
+	"name": "id",
+}
+
+func (r *StructureMapConst) elementTypes() map[string]string {
+	return structureMapConstElementTypes
+}
+
+var structureMapConstElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.const.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.const.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.const.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.const.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.const.value", Name: "value", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureMapConst) ElementMetadata() []ElementMetadata {
+	return structureMapConstElementMetadata
+}
+
+type StructureMapGroup struct {
+	Id                   *string                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name                 string                     `json:"name" bson:"name"`                                                // Human-readable label
+	NameElement          *Element                   `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Extends              *string                    `json:"extends,omitempty" bson:"extends,omitempty"`                      // Another group that this group adds rules to
+	ExtendsElement       *Element                   `json:"_extends,omitempty" bson:"extends_element,omitempty"`             // Extensions for extends
+	TypeMode             *StructureMapGroupTypeMode `json:"typeMode,omitempty" bson:"type_mode,omitempty"`                   // types | type-and-types
+	TypeModeElement      *Element                   `json:"_typeMode,omitempty" bson:"type_mode_element,omitempty"`          // Extensions for typeMode
+	Documentation        *string                    `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Additional description/explanation for group
+	DocumentationElement *Element                   `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+	Input                []StructureMapGroupInput   `json:"input" bson:"input"`                                              // Named instance provided when invoking the map
+	Rule                 []StructureMapGroupRule    `json:"rule,omitempty" bson:"rule,omitempty"`                            // Transform Rule from source to target
+}
+
+func (r *StructureMapGroup) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.ExtendsElement != nil {
+		if err := r.ExtendsElement.Validate(); err != nil {
+			return fmt.Errorf("ExtendsElement: %w", err)
+		}
+	}
+	if r.TypeMode != nil && !r.TypeMode.IsValid() {
+		return fmt.Errorf("field 'TypeMode' has invalid code '%s'", *r.TypeMode)
+	}
+	if r.TypeModeElement != nil {
+		if err := r.TypeModeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeModeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	if len(r.Input) < 1 {
+		return fmt.Errorf("field 'Input' must have at least 1 elements")
+	}
+	for i, item := range r.Input {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Input[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Rule {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Rule[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroup) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.ExtendsElement != nil {
+		r.ExtendsElement.validateAll(path+".extends", issues)
+	}
+	if r.TypeMode != nil && !r.TypeMode.IsValid() {
+		issues.add("code-invalid", path+".typeMode", fmt.Sprintf("field 'TypeMode' has invalid code '%s'", *r.TypeMode))
+	}
+	if r.TypeModeElement != nil {
+		r.TypeModeElement.validateAll(path+".typeMode", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+	if len(r.Input) < 1 {
+		issues.add("required", path+".input", "field 'Input' must have at least 1 elements")
+	}
+	for i, item := range r.Input {
+		item.validateAll(fmt.Sprintf("%s.input[%d]", path, i), issues)
+	}
+	for i, item := range r.Rule {
+		item.validateAll(fmt.Sprintf("%s.rule[%d]", path, i), issues)
+	}
+}
+
+var structureMapGroupInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroup) invariants() []invariant {
+	return structureMapGroupInvariants
+}
+
+func (r StructureMapGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroup) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroup) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapGroupElementTypes = map[string]string{
+	"name":    "id",
+	"extends": "id",
+}
+
+func (r *StructureMapGroup) elementTypes() map[string]string {
+	return structureMapGroupElementTypes
+}
+
+var structureMapGroupElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.extends", Name: "extends", Field: 5, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.typeMode", Name: "typeMode", Field: 7, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.group.typeMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-group-type-mode"}},
+	{Path: "StructureMap.group.documentation", Name: "documentation", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.input", Name: "input", Field: 11, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.group.rule", Name: "rule", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *StructureMapGroup) ElementMetadata() []ElementMetadata {
+	return structureMapGroupElementMetadata
+}
+
+type StructureMapGroupInput struct {
+	Id                   *string               `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension           `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name                 string                `json:"name" bson:"name"`                                                // Name for this instance of data
+	NameElement          *Element              `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Type                 *string               `json:"type,omitempty" bson:"type,omitempty"`                            // Type for this instance of data
+	TypeElement          *Element              `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	Mode                 StructureMapInputMode `json:"mode" bson:"mode"`                                                // source | target
+	ModeElement          *Element              `json:"_mode,omitempty" bson:"mode_element,omitempty"`                   // Extensions for mode
+	Documentation        *string               `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Documentation for this instance of data
+	DocumentationElement *Element              `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *StructureMapGroupInput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.Mode == "" {
+		return fmt.Errorf("field 'Mode' is required")
+	}
+	if !r.Mode.IsValid() {
+		return fmt.Errorf("field 'Mode' has invalid code '%s'", r.Mode)
+	}
+	if r.ModeElement != nil {
+		if err := r.ModeElement.Validate(); err != nil {
+			return fmt.Errorf("ModeElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroupInput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.Mode == "" {
+		issues.add("required", path+".mode", "field 'Mode' is required")
+	}
+	if r.Mode != "" && !r.Mode.IsValid() {
+		issues.add("code-invalid", path+".mode", fmt.Sprintf("field 'Mode' has invalid code '%s'", r.Mode))
+	}
+	if r.ModeElement != nil {
+		r.ModeElement.validateAll(path+".mode", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var structureMapGroupInputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroupInput) invariants() []invariant {
+	return structureMapGroupInputInvariants
+}
+
+func (r StructureMapGroupInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroupInput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroupInput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroupInput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapGroupInputElementTypes = map[string]string{
+	"name": "id",
+}
+
+func (r *StructureMapGroupInput) elementTypes() map[string]string {
+	return structureMapGroupInputElementTypes
+}
+
+var structureMapGroupInputElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.input.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.input.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.input.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.input.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.input.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.input.mode", Name: "mode", Field: 7, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.group.input.mode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-input-mode"}},
+	{Path: "StructureMap.group.input.documentation", Name: "documentation", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureMapGroupInput) ElementMetadata() []ElementMetadata {
+	return structureMapGroupInputElementMetadata
+}
+
+type StructureMapGroupRule struct {
+	Id                   *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name                 *string                          `json:"name,omitempty" bson:"name,omitempty"`                            // Name of the rule for internal references
+	NameElement          *Element                         `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Source               []StructureMapGroupRuleSource    `json:"source" bson:"source"`                                            // Source inputs to the mapping
+	Target               []StructureMapGroupRuleTarget    `json:"target,omitempty" bson:"target,omitempty"`                        // Content to create because of this mapping rule
+	Rule                 []StructureMapGroupRule          `json:"rule,omitempty" bson:"rule,omitempty"`                            // Rules contained in this rule
+	Dependent            []StructureMapGroupRuleDependent `json:"dependent,omitempty" bson:"dependent,omitempty"`                  // Which other rules to apply in the context of this rule
+	Documentation        *string                          `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Documentation for this instance of data
+	DocumentationElement *Element                         `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *StructureMapGroupRule) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if len(r.Source) < 1 {
+		return fmt.Errorf("field 'Source' must have at least 1 elements")
+	}
+	for i, item := range r.Source {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Source[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Target {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Target[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Rule {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Rule[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Dependent {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Dependent[%d]: %w", i, err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroupRule) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if len(r.Source) < 1 {
+		issues.add("required", path+".source", "field 'Source' must have at least 1 elements")
+	}
+	for i, item := range r.Source {
+		item.validateAll(fmt.Sprintf("%s.source[%d]", path, i), issues)
+	}
+	for i, item := range r.Target {
+		item.validateAll(fmt.Sprintf("%s.target[%d]", path, i), issues)
+	}
+	for i, item := range r.Rule {
+		item.validateAll(fmt.Sprintf("%s.rule[%d]", path, i), issues)
+	}
+	for i, item := range r.Dependent {
+		item.validateAll(fmt.Sprintf("%s.dependent[%d]", path, i), issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var structureMapGroupRuleInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroupRule) invariants() []invariant {
+	return structureMapGroupRuleInvariants
+}
+
+func (r StructureMapGroupRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroupRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroupRule) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroupRule) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapGroupRuleElementTypes = map[string]string{
+	"name": "id",
+}
+
+func (r *StructureMapGroupRule) elementTypes() map[string]string {
+	return structureMapGroupRuleElementTypes
+}
+
+var structureMapGroupRuleElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.rule.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.rule.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.rule.name", Name: "name", Field: 3, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.rule.source", Name: "source", Field: 5, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.group.rule.target", Name: "target", Field: 6, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.group.rule.rule", Name: "rule", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.group.rule.dependent", Name: "dependent", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "StructureMap.group.rule.documentation", Name: "documentation", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureMapGroupRule) ElementMetadata() []ElementMetadata {
+	return structureMapGroupRuleElementMetadata
+}
+
+type StructureMapGroupRuleSource struct {
+	Id                  *string                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Context             string                      `json:"context" bson:"context"`                                          // Type or variable this rule applies to
+	ContextElement      *Element                    `json:"_context,omitempty" bson:"context_element,omitempty"`             // Extensions for context
+	Min                 *int                        `json:"min,omitempty" bson:"min,omitempty"`                              // Specified minimum cardinality
+	MinElement          *Element                    `json:"_min,omitempty" bson:"min_element,omitempty"`                     // Extensions for min
+	Max                 *string                     `json:"max,omitempty" bson:"max,omitempty"`                              // Specified maximum cardinality (number or *)
+	MaxElement          *Element                    `json:"_max,omitempty" bson:"max_element,omitempty"`                     // Extensions for max
+	Type                *string                     `json:"type,omitempty" bson:"type,omitempty"`                            // Rule only applies if source has this type
+	TypeElement         *Element                    `json:"_type,omitempty" bson:"type_element,omitempty"`                   // Extensions for type
+	DefaultValue        *string                     `json:"defaultValue,omitempty" bson:"default_value,omitempty"`           // Default value if no value exists
+	DefaultValueElement *Element                    `json:"_defaultValue,omitempty" bson:"default_value_element,omitempty"`  // Extensions for defaultValue
+	Element             *string                     `json:"element,omitempty" bson:"element,omitempty"`                      // Optional field for this source
+	ElementElement      *Element                    `json:"_element,omitempty" bson:"element_element,omitempty"`             // Extensions for element
+	ListMode            *StructureMapSourceListMode `json:"listMode,omitempty" bson:"list_mode,omitempty"`                   // first | not_first | last | not_last | only_one
+	ListModeElement     *Element                    `json:"_listMode,omitempty" bson:"list_mode_element,omitempty"`          // Extensions for listMode
+	Variable            *string                     `json:"variable,omitempty" bson:"variable,omitempty"`                    // Named context for field, if a field is specified
+	VariableElement     *Element                    `json:"_variable,omitempty" bson:"variable_element,omitempty"`           // Extensions for variable
+	Condition           *string                     `json:"condition,omitempty" bson:"condition,omitempty"`                  // FHIRPath expression  - must be true or the rule does not apply
+	ConditionElement    *Element                    `json:"_condition,omitempty" bson:"condition_element,omitempty"`         // Extensions for condition
+	Check               *string                     `json:"check,omitempty" bson:"check,omitempty"`                          // FHIRPath expression  - must be true or the mapping engine throws an error instead of completing
+	CheckElement        *Element                    `json:"_check,omitempty" bson:"check_element,omitempty"`                 // Extensions for check
+	LogMessage          *string                     `json:"logMessage,omitempty" bson:"log_message,omitempty"`               // Message to put in log if source exists (FHIRPath)
+	LogMessageElement   *Element                    `json:"_logMessage,omitempty" bson:"log_message_element,omitempty"`      // Extensions for logMessage
+}
+
+func (r *StructureMapGroupRuleSource) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Context == emptyString {
+		return fmt.Errorf("field 'Context' is required")
+	}
+	if r.ContextElement != nil {
+		if err := r.ContextElement.Validate(); err != nil {
+			return fmt.Errorf("ContextElement: %w", err)
+		}
+	}
+	if r.MinElement != nil {
+		if err := r.MinElement.Validate(); err != nil {
+			return fmt.Errorf("MinElement: %w", err)
+		}
+	}
+	if r.MaxElement != nil {
+		if err := r.MaxElement.Validate(); err != nil {
+			return fmt.Errorf("MaxElement: %w", err)
+		}
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.DefaultValueElement != nil {
+		if err := r.DefaultValueElement.Validate(); err != nil {
+			return fmt.Errorf("DefaultValueElement: %w", err)
+		}
+	}
+	if r.ElementElement != nil {
+		if err := r.ElementElement.Validate(); err != nil {
+			return fmt.Errorf("ElementElement: %w", err)
+		}
+	}
+	if r.ListMode != nil && !r.ListMode.IsValid() {
+		return fmt.Errorf("field 'ListMode' has invalid code '%s'", *r.ListMode)
+	}
+	if r.ListModeElement != nil {
+		if err := r.ListModeElement.Validate(); err != nil {
+			return fmt.Errorf("ListModeElement: %w", err)
+		}
+	}
+	if r.VariableElement != nil {
+		if err := r.VariableElement.Validate(); err != nil {
+			return fmt.Errorf("VariableElement: %w", err)
+		}
+	}
+	if r.ConditionElement != nil {
+		if err := r.ConditionElement.Validate(); err != nil {
+			return fmt.Errorf("ConditionElement: %w", err)
+		}
+	}
+	if r.CheckElement != nil {
+		if err := r.CheckElement.Validate(); err != nil {
+			return fmt.Errorf("CheckElement: %w", err)
+		}
+	}
+	if r.LogMessageElement != nil {
+		if err := r.LogMessageElement.Validate(); err != nil {
+			return fmt.Errorf("LogMessageElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroupRuleSource) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Context == emptyString {
+		issues.add("required", path+".context", "field 'Context' is required")
+	}
+	if r.ContextElement != nil {
+		r.ContextElement.validateAll(path+".context", issues)
+	}
+	if r.MinElement != nil {
+		r.MinElement.validateAll(path+".min", issues)
+	}
+	if r.MaxElement != nil {
+		r.MaxElement.validateAll(path+".max", issues)
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.DefaultValueElement != nil {
+		r.DefaultValueElement.validateAll(path+".defaultValue", issues)
+	}
+	if r.ElementElement != nil {
+		r.ElementElement.validateAll(path+".element", issues)
+	}
+	if r.ListMode != nil && !r.ListMode.IsValid() {
+		issues.add("code-invalid", path+".listMode", fmt.Sprintf("field 'ListMode' has invalid code '%s'", *r.ListMode))
+	}
+	if r.ListModeElement != nil {
+		r.ListModeElement.validateAll(path+".listMode", issues)
+	}
+	if r.VariableElement != nil {
+		r.VariableElement.validateAll(path+".variable", issues)
+	}
+	if r.ConditionElement != nil {
+		r.ConditionElement.validateAll(path+".condition", issues)
+	}
+	if r.CheckElement != nil {
+		r.CheckElement.validateAll(path+".check", issues)
+	}
+	if r.LogMessageElement != nil {
+		r.LogMessageElement.validateAll(path+".logMessage", issues)
+	}
+}
+
+var structureMapGroupRuleSourceInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroupRuleSource) invariants() []invariant {
+	return structureMapGroupRuleSourceInvariants
+}
+
+func (r StructureMapGroupRuleSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroupRuleSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroupRuleSource) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroupRuleSource) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapGroupRuleSourceElementTypes = map[string]string{
+	"context":  "id",
+	"min":      "unsignedInt",
+	"variable": "id",
+}
+
+func (r *StructureMapGroupRuleSource) elementTypes() map[string]string {
+	return structureMapGroupRuleSourceElementTypes
+}
+
+var structureMapGroupRuleSourceElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.rule.source.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.rule.source.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.rule.source.context", Name: "context", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.rule.source.min", Name: "min", Field: 5, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "StructureMap.group.rule.source.max", Name: "max", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.type", Name: "type", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.defaultValue", Name: "defaultValue", Field: 11, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.element", Name: "element", Field: 13, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.listMode", Name: "listMode", Field: 15, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.group.rule.source.listMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-source-list-mode"}},
+	{Path: "StructureMap.group.rule.source.variable", Name: "variable", Field: 17, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.rule.source.condition", Name: "condition", Field: 19, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.check", Name: "check", Field: 21, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.source.logMessage", Name: "logMessage", Field: 23, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *StructureMapGroupRuleSource) ElementMetadata() []ElementMetadata {
+	return structureMapGroupRuleSourceElementMetadata
+}
+
+type StructureMapGroupRuleTarget struct {
+	Id                *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Context           *string                                `json:"context,omitempty" bson:"context,omitempty"`                      // Variable this rule applies to
+	ContextElement    *Element                               `json:"_context,omitempty" bson:"context_element,omitempty"`             // Extensions for context
+	Element           *string                                `json:"element,omitempty" bson:"element,omitempty"`                      // Field to create in the context
+	ElementElement    *Element                               `json:"_element,omitempty" bson:"element_element,omitempty"`             // Extensions for element
+	Variable          *string                                `json:"variable,omitempty" bson:"variable,omitempty"`                    // Named context for field, if desired, and a field is specified
+	VariableElement   *Element                               `json:"_variable,omitempty" bson:"variable_element,omitempty"`           // Extensions for variable
+	ListMode          []StructureMapTargetListMode           `json:"listMode,omitempty" bson:"list_mode,omitempty"`                   // first | share | last | single
+	ListModeElement   []*Element                             `json:"_listMode,omitempty" bson:"list_mode_element,omitempty"`          // Extensions for listMode
+	ListRuleId        *string                                `json:"listRuleId,omitempty" bson:"list_rule_id,omitempty"`              // Internal rule reference for shared list items
+	ListRuleIdElement *Element                               `json:"_listRuleId,omitempty" bson:"list_rule_id_element,omitempty"`     // Extensions for listRuleId
+	Transform         *StructureMapTransform                 `json:"transform,omitempty" bson:"transform,omitempty"`                  // create | copy +
+	TransformElement  *Element                               `json:"_transform,omitempty" bson:"transform_element,omitempty"`         // Extensions for transform
+	Parameter         []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                  // Parameters to the transform
+}
+
+func (r *StructureMapGroupRuleTarget) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.ContextElement != nil {
+		if err := r.ContextElement.Validate(); err != nil {
+			return fmt.Errorf("ContextElement: %w", err)
+		}
+	}
+	if r.ElementElement != nil {
+		if err := r.ElementElement.Validate(); err != nil {
+			return fmt.Errorf("ElementElement: %w", err)
+		}
+	}
+	if r.VariableElement != nil {
+		if err := r.VariableElement.Validate(); err != nil {
+			return fmt.Errorf("VariableElement: %w", err)
+		}
+	}
+	for i, item := range r.ListMode {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'ListMode[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ListModeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ListModeElement[%d]: %w", i, err)
+		}
+	}
+	if r.ListRuleIdElement != nil {
+		if err := r.ListRuleIdElement.Validate(); err != nil {
+			return fmt.Errorf("ListRuleIdElement: %w", err)
+		}
+	}
+	if r.Transform != nil && !r.Transform.IsValid() {
+		return fmt.Errorf("field 'Transform' has invalid code '%s'", *r.Transform)
+	}
+	if r.TransformElement != nil {
+		if err := r.TransformElement.Validate(); err != nil {
+			return fmt.Errorf("TransformElement: %w", err)
+		}
+	}
+	for i, item := range r.Parameter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Parameter[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroupRuleTarget) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ContextElement != nil {
+		r.ContextElement.validateAll(path+".context", issues)
+	}
+	if r.ElementElement != nil {
+		r.ElementElement.validateAll(path+".element", issues)
+	}
+	if r.VariableElement != nil {
+		r.VariableElement.validateAll(path+".variable", issues)
+	}
+	for i, item := range r.ListMode {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.listMode[%d]", path, i), fmt.Sprintf("field 'ListMode[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ListModeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.listMode[%d]", path, i), issues)
+	}
+	if r.ListRuleIdElement != nil {
+		r.ListRuleIdElement.validateAll(path+".listRuleId", issues)
+	}
+	if r.Transform != nil && !r.Transform.IsValid() {
+		issues.add("code-invalid", path+".transform", fmt.Sprintf("field 'Transform' has invalid code '%s'", *r.Transform))
+	}
+	if r.TransformElement != nil {
+		r.TransformElement.validateAll(path+".transform", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+}
+
+var structureMapGroupRuleTargetInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroupRuleTarget) invariants() []invariant {
+	return structureMapGroupRuleTargetInvariants
+}
+
+func (r StructureMapGroupRuleTarget) MarshalJSON() ([]byte, error) {
+	type alias StructureMapGroupRuleTarget
+	out := struct {
+		alias
+		ListMode        []*StructureMapTargetListMode `json:"listMode,omitempty"`
+		ListModeElement []*Element                    `json:"_listMode,omitempty"`
+	}{alias: alias(r)}
+	out.ListMode, out.ListModeElement = alignPrimitiveArray(r.ListMode, r.ListModeElement)
+	return json.Marshal(out)
+}
+
+func (r *StructureMapGroupRuleTarget) UnmarshalJSON(data []byte) error {
+	type alias StructureMapGroupRuleTarget
+	aux := struct {
+		*alias
+		ListMode []*StructureMapTargetListMode `json:"listMode,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.ListMode, err = splitPrimitiveArray(aux.ListMode, r.ListModeElement); err != nil {
+		return fmt.Errorf("listMode: %w", err)
+	}
+	return nil
+}
+
+func (r StructureMapGroupRuleTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroupRuleTarget) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroupRuleTarget) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroupRuleTarget) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapGroupRuleTargetElementTypes = map[string]string{
+	"variable":   "id",
+	"listRuleId": "id",
+}
+
+func (r *StructureMapGroupRuleTarget) elementTypes() map[string]string {
+	return structureMapGroupRuleTargetElementTypes
+}
+
+var structureMapGroupRuleTargetElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.rule.target.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.target.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.rule.target.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.rule.target.context", Name: "context", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.target.element", Name: "element", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.target.variable", Name: "variable", Field: 7, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.rule.target.listMode", Name: "listMode", Field: 9, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.group.rule.target.listMode", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-target-list-mode"}},
+	{Path: "StructureMap.group.rule.target.listRuleId", Name: "listRuleId", Field: 11, Min: 0, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.rule.target.transform", Name: "transform", Field: 13, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "StructureMap.group.rule.target.transform", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/map-transform"}},
+	{Path: "StructureMap.group.rule.target.parameter", Name: "parameter", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *StructureMapGroupRuleTarget) ElementMetadata() []ElementMetadata {
+	return structureMapGroupRuleTargetElementMetadata
+}
+
+type StructureMapGroupRuleTargetParameter struct {
+	Id                *string                                   `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                               `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                               `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             StructureMapGroupRuleTargetParameterValue `json:"-" bson:"value,omitempty"`                                        // Parameter value - variable or literal
+	ValueElement      *Element                                  `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of StructureMap.group.rule.target.parameter.value[x] when more than one was decoded
+}
+
+func (r *StructureMapGroupRuleTargetParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroupRuleTargetParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var structureMapGroupRuleTargetParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroupRuleTargetParameter) invariants() []invariant {
+	return structureMapGroupRuleTargetParameterInvariants
+}
+
+func (r StructureMapGroupRuleTargetParameter) MarshalJSON() ([]byte, error) {
+	type alias StructureMapGroupRuleTargetParameter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalJSON(data []byte) error {
+	type alias StructureMapGroupRuleTargetParameter
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", structureMapGroupRuleTargetParameterValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r StructureMapGroupRuleTargetParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroupRuleTargetParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroupRuleTargetParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *StructureMapGroupRuleTargetParameter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(structureMapGroupRuleTargetParameterValueVariants)
+	}
+	return "", nil
+}
+
+var structureMapGroupRuleTargetParameterElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.rule.target.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.target.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.rule.target.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.rule.target.parameter.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"id", "string", "boolean", "integer", "decimal", "date", "time", "dateTime"}},
+}
+
+func (r *StructureMapGroupRuleTargetParameter) ElementMetadata() []ElementMetadata {
+	return structureMapGroupRuleTargetParameterElementMetadata
+}
+
+// StructureMapGroupRuleTargetParameterValue is the type of StructureMap.group.rule.target.parameter.value[x]. It is implemented by the StructureMapGroupRuleTargetParameterValue* variant types.
+type StructureMapGroupRuleTargetParameterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isStructureMapGroupRuleTargetParameterValue()
+}
+
+// StructureMapGroupRuleTargetParameterValueId is the id variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueId string
+
+func (v StructureMapGroupRuleTargetParameterValueId) Validate() error {
+	return nil
+}
+
+func (v StructureMapGroupRuleTargetParameterValueId) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v StructureMapGroupRuleTargetParameterValueId) FHIRType() string {
+	return "id"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueId) isStructureMapGroupRuleTargetParameterValue() {}
+
+// StructureMapGroupRuleTargetParameterValueString is the string variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueString string
+
+func (v StructureMapGroupRuleTargetParameterValueString) Validate() error {
+	return nil
+}
+
+func (v StructureMapGroupRuleTargetParameterValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v StructureMapGroupRuleTargetParameterValueString) FHIRType() string {
+	return "string"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueString) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+// StructureMapGroupRuleTargetParameterValueBoolean is the boolean variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueBoolean bool
+
+func (v StructureMapGroupRuleTargetParameterValueBoolean) Validate() error {
+	return nil
+}
+
+func (v StructureMapGroupRuleTargetParameterValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v StructureMapGroupRuleTargetParameterValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueBoolean) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+// StructureMapGroupRuleTargetParameterValueInteger is the integer variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueInteger int
+
+func (v StructureMapGroupRuleTargetParameterValueInteger) Validate() error {
+	return nil
+}
+
+func (v StructureMapGroupRuleTargetParameterValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v StructureMapGroupRuleTargetParameterValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueInteger) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+// StructureMapGroupRuleTargetParameterValueDecimal is the decimal variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueDecimal struct {
+	Decimal
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDecimal) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+// StructureMapGroupRuleTargetParameterValueDate is the date variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueDate struct {
+	Date
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDate) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+// StructureMapGroupRuleTargetParameterValueTime is the time variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueTime struct {
+	Time
+}
+
+func (v StructureMapGroupRuleTargetParameterValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v StructureMapGroupRuleTargetParameterValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v StructureMapGroupRuleTargetParameterValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueTime) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+// StructureMapGroupRuleTargetParameterValueDateTime is the dateTime variant of StructureMapGroupRuleTargetParameterValue.
+type StructureMapGroupRuleTargetParameterValueDateTime struct {
+	DateTime
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v StructureMapGroupRuleTargetParameterValueDateTime) isStructureMapGroupRuleTargetParameterValue() {
+}
+
+var structureMapGroupRuleTargetParameterValueVariants = []StructureMapGroupRuleTargetParameterValue{
+	StructureMapGroupRuleTargetParameterValueId(""),
+	StructureMapGroupRuleTargetParameterValueString(""),
+	StructureMapGroupRuleTargetParameterValueBoolean(false),
+	StructureMapGroupRuleTargetParameterValueInteger(0),
+	StructureMapGroupRuleTargetParameterValueDecimal{},
+	StructureMapGroupRuleTargetParameterValueDate{},
+	StructureMapGroupRuleTargetParameterValueTime{},
+	StructureMapGroupRuleTargetParameterValueDateTime{},
+}
+
+type StructureMapGroupRuleDependent struct {
+	Id                *string                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string                                 `json:"name" bson:"name"`                                                // Name of a rule or group to apply
+	NameElement       *Element                               `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Parameter         []StructureMapGroupRuleTargetParameter `json:"parameter" bson:"parameter"`                                      // Parameter to pass to the rule or group
+}
+
+func (r *StructureMapGroupRuleDependent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if len(r.Parameter) < 1 {
+		return fmt.Errorf("field 'Parameter' must have at least 1 elements")
+	}
+	for i, item := range r.Parameter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Parameter[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *StructureMapGroupRuleDependent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if len(r.Parameter) < 1 {
+		issues.add("required", path+".parameter", "field 'Parameter' must have at least 1 elements")
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+}
+
+var structureMapGroupRuleDependentInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *StructureMapGroupRuleDependent) invariants() []invariant {
+	return structureMapGroupRuleDependentInvariants
+}
+
+func (r StructureMapGroupRuleDependent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *StructureMapGroupRuleDependent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r StructureMapGroupRuleDependent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *StructureMapGroupRuleDependent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var structureMapGroupRuleDependentElementTypes = map[string]string{
+	"name": "id",
+}
+
+func (r *StructureMapGroupRuleDependent) elementTypes() map[string]string {
+	return structureMapGroupRuleDependentElementTypes
+}
+
+var structureMapGroupRuleDependentElementMetadata = []ElementMetadata{
+	{Path: "StructureMap.group.rule.dependent.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "StructureMap.group.rule.dependent.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "StructureMap.group.rule.dependent.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "StructureMap.group.rule.dependent.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"id"}},
+	{Path: "StructureMap.group.rule.dependent.parameter", Name: "parameter", Field: 5, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *StructureMapGroupRuleDependent) ElementMetadata() []ElementMetadata {
+	return structureMapGroupRuleDependentElementMetadata
 }
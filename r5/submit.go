@@ -1,9 +1,63 @@
 package models
 
-// This operation is used to submit an EligibilityRequest for assessment either as a single EligibilityRequest resource instance or as a Bundle containing the EligibilityRequest and other referenced resources, or Bundle containing a batch of EligibilityRequest resources, either as single EligibilityRequests resources or Bundle resources, for processing. The only input parameter is the single EligibilityRequest or Bundle resource and the only output is a single EligibilityResponse, Bundle of EligibilityResponses or an OperationOutcome resource.
-type Submit struct {
+// SubmitIn holds the input parameters of the $Submit operation. This operation is used to submit an EligibilityRequest for assessment either as a single EligibilityRequest resource instance or as a Bundle containing the EligibilityRequest and other referenced resources, or Bundle containing a batch of EligibilityRequest resources, either as single EligibilityRequests resources or Bundle resources, for processing. The only input parameter is the single EligibilityRequest or Bundle resource and the only output is a single EligibilityResponse, Bundle of EligibilityResponses or an OperationOutcome resource.
+type SubmitIn struct {
 }
 
-func (r *Submit) Validate() error {
+func (p *SubmitIn) Validate() error {
+	return nil
+}
+
+func (p *SubmitIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *SubmitIn) FromParameters(params *Parameters) error {
+	*p = SubmitIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *SubmitIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *SubmitIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// SubmitOut holds the output parameters of the $Submit operation.
+type SubmitOut struct {
+}
+
+func (p *SubmitOut) Validate() error {
+	return nil
+}
+
+func (p *SubmitOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *SubmitOut) FromParameters(params *Parameters) error {
+	*p = SubmitOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *SubmitOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *SubmitOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
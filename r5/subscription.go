@@ -2,37 +2,55 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The subscription resource describes a particular client's request to be notified about a SubscriptionTopic.
 type Subscription struct {
-	ResourceType    string                  `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id              *string                 `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta            *Meta                   `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules   *string                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language        *string                 `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	Text            *Narrative              `json:"text,omitempty" bson:"text,omitempty"`                        // Text summary of the resource, for human interpretation
-	Contained       []json.RawMessage       `json:"contained,omitempty" bson:"contained,omitempty"`              // Contained, inline Resources
-	Identifier      []Identifier            `json:"identifier,omitempty" bson:"identifier,omitempty"`            // Additional identifiers (business identifier)
-	Name            *string                 `json:"name,omitempty" bson:"name,omitempty"`                        // Human readable name for this subscription
-	Status          string                  `json:"status" bson:"status"`                                        // requested | active | error | off | entered-in-error
-	Topic           string                  `json:"topic" bson:"topic"`                                          // Reference to the subscription topic being subscribed to
-	Contact         []ContactPoint          `json:"contact,omitempty" bson:"contact,omitempty"`                  // Contact details for source (e.g. troubleshooting)
-	End             *string                 `json:"end,omitempty" bson:"end,omitempty"`                          // When to automatically delete the subscription
-	ManagingEntity  *Reference              `json:"managingEntity,omitempty" bson:"managing_entity,omitempty"`   // Entity responsible for Subscription changes
-	Reason          *string                 `json:"reason,omitempty" bson:"reason,omitempty"`                    // Description of why this subscription was created
-	FilterBy        []SubscriptionFilterBy  `json:"filterBy,omitempty" bson:"filter_by,omitempty"`               // Criteria for narrowing the subscription topic stream
-	ChannelType     *Coding                 `json:"channelType" bson:"channel_type"`                             // Channel type for notifications
-	Endpoint        *string                 `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                // URL where the channel sends notifications
-	Parameter       []SubscriptionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`              // Channel type dependent information
-	HeartbeatPeriod *int                    `json:"heartbeatPeriod,omitempty" bson:"heartbeat_period,omitempty"` // Interval in seconds to send 'heartbeat' notification
-	Timeout         *int                    `json:"timeout,omitempty" bson:"timeout,omitempty"`                  // Timeout in seconds to attempt notification delivery
-	ContentType     *string                 `json:"contentType,omitempty" bson:"content_type,omitempty"`         // MIME type to send, or omit for no payload
-	Content         *string                 `json:"content,omitempty" bson:"content,omitempty"`                  // empty | id-only | full-resource
-	MaxCount        *int                    `json:"maxCount,omitempty" bson:"max_count,omitempty"`               // Maximum number of events that can be combined in a single notification
+	ResourceType           string                      `json:"resourceType" bson:"resource_type"`                                    // Type of resource
+	Id                     *string                     `json:"id,omitempty" bson:"id,omitempty"`                                     // Logical id of this artifact
+	Meta                   *Meta                       `json:"meta,omitempty" bson:"meta,omitempty"`                                 // Metadata about the resource
+	ImplicitRules          *string                     `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`              // A set of rules under which this content was created
+	ImplicitRulesElement   *Element                    `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`     // Extensions for implicitRules
+	Language               *string                     `json:"language,omitempty" bson:"language,omitempty"`                         // Language of the resource content
+	LanguageElement        *Element                    `json:"_language,omitempty" bson:"language_element,omitempty"`                // Extensions for language
+	Text                   *Narrative                  `json:"text,omitempty" bson:"text,omitempty"`                                 // Text summary of the resource, for human interpretation
+	Contained              []Resource                  `json:"contained,omitempty" bson:"contained,omitempty"`                       // Contained, inline Resources
+	Extension              []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored
+	Identifier             []Identifier                `json:"identifier,omitempty" bson:"identifier,omitempty"`                     // Additional identifiers (business identifier)
+	Name                   *string                     `json:"name,omitempty" bson:"name,omitempty"`                                 // Human readable name for this subscription
+	NameElement            *Element                    `json:"_name,omitempty" bson:"name_element,omitempty"`                        // Extensions for name
+	Status                 SubscriptionStatusCodes     `json:"status" bson:"status"`                                                 // requested | active | error | off | entered-in-error
+	StatusElement          *Element                    `json:"_status,omitempty" bson:"status_element,omitempty"`                    // Extensions for status
+	Topic                  string                      `json:"topic" bson:"topic"`                                                   // Reference to the subscription topic being subscribed to
+	TopicElement           *Element                    `json:"_topic,omitempty" bson:"topic_element,omitempty"`                      // Extensions for topic
+	Contact                []ContactPoint              `json:"contact,omitempty" bson:"contact,omitempty"`                           // Contact details for source (e.g. troubleshooting)
+	End                    *Instant                    `json:"end,omitempty" bson:"end,omitempty"`                                   // When to automatically delete the subscription
+	EndElement             *Element                    `json:"_end,omitempty" bson:"end_element,omitempty"`                          // Extensions for end
+	ManagingEntity         *Reference                  `json:"managingEntity,omitempty" bson:"managing_entity,omitempty"`            // Entity responsible for Subscription changes
+	Reason                 *string                     `json:"reason,omitempty" bson:"reason,omitempty"`                             // Description of why this subscription was created
+	ReasonElement          *Element                    `json:"_reason,omitempty" bson:"reason_element,omitempty"`                    // Extensions for reason
+	FilterBy               []SubscriptionFilterBy      `json:"filterBy,omitempty" bson:"filter_by,omitempty"`                        // Criteria for narrowing the subscription topic stream
+	ChannelType            *Coding                     `json:"channelType" bson:"channel_type"`                                      // Channel type for notifications
+	Endpoint               *string                     `json:"endpoint,omitempty" bson:"endpoint,omitempty"`                         // URL where the channel sends notifications
+	EndpointElement        *Element                    `json:"_endpoint,omitempty" bson:"endpoint_element,omitempty"`                // Extensions for endpoint
+	Parameter              []SubscriptionParameter     `json:"parameter,omitempty" bson:"parameter,omitempty"`                       // Channel type dependent information
+	HeartbeatPeriod        *int                        `json:"heartbeatPeriod,omitempty" bson:"heartbeat_period,omitempty"`          // Interval in seconds to send 'heartbeat' notification
+	HeartbeatPeriodElement *Element                    `json:"_heartbeatPeriod,omitempty" bson:"heartbeat_period_element,omitempty"` // Extensions for heartbeatPeriod
+	Timeout                *int                        `json:"timeout,omitempty" bson:"timeout,omitempty"`                           // Timeout in seconds to attempt notification delivery
+	TimeoutElement         *Element                    `json:"_timeout,omitempty" bson:"timeout_element,omitempty"`                  // Extensions for timeout
+	ContentType            *string                     `json:"contentType,omitempty" bson:"content_type,omitempty"`                  // MIME type to send, or omit for no payload
+	ContentTypeElement     *Element                    `json:"_contentType,omitempty" bson:"content_type_element,omitempty"`         // Extensions for contentType
+	Content                *SubscriptionPayloadContent `json:"content,omitempty" bson:"content,omitempty"`                           // empty | id-only | full-resource
+	ContentElement         *Element                    `json:"_content,omitempty" bson:"content_element,omitempty"`                  // Extensions for content
+	MaxCount               *int                        `json:"maxCount,omitempty" bson:"max_count,omitempty"`                        // Maximum number of events that can be combined in a single notification
+	MaxCountElement        *Element                    `json:"_maxCount,omitempty" bson:"max_count_element,omitempty"`               // Extensions for maxCount
 }
 
+var subscriptionManagingEntityTargets = []string{"CareTeam", "Device", "Group", "HealthcareService", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Subscription) Validate() error {
 	if r.ResourceType != "Subscription" {
 		return fmt.Errorf("invalid resourceType: expected 'Subscription', got '%s'", r.ResourceType)
@@ -42,33 +60,99 @@ func (r *Subscription) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	var emptyString string
 	if r.Topic == emptyString {
 		return fmt.Errorf("field 'Topic' is required")
 	}
+	if r.TopicElement != nil {
+		if err := r.TopicElement.Validate(); err != nil {
+			return fmt.Errorf("TopicElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			return fmt.Errorf("End: %w", err)
+		}
+	}
+	if r.EndElement != nil {
+		if err := r.EndElement.Validate(); err != nil {
+			return fmt.Errorf("EndElement: %w", err)
+		}
+	}
+	if r.ManagingEntity != nil {
+		if target := invalidReferenceTarget(r.ManagingEntity.Reference, r.ManagingEntity.Type, subscriptionManagingEntityTargets); target != "" {
+			return fmt.Errorf("field 'ManagingEntity' cannot refer to %s, expected one of %v", target, subscriptionManagingEntityTargets)
+		}
+	}
 	if r.ManagingEntity != nil {
 		if err := r.ManagingEntity.Validate(); err != nil {
 			return fmt.Errorf("ManagingEntity: %w", err)
 		}
 	}
+	if r.ReasonElement != nil {
+		if err := r.ReasonElement.Validate(); err != nil {
+			return fmt.Errorf("ReasonElement: %w", err)
+		}
+	}
 	for i, item := range r.FilterBy {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("FilterBy[%d]: %w", i, err)
@@ -82,32 +166,364 @@ func (r *Subscription) Validate() error {
 			return fmt.Errorf("ChannelType: %w", err)
 		}
 	}
+	if r.EndpointElement != nil {
+		if err := r.EndpointElement.Validate(); err != nil {
+			return fmt.Errorf("EndpointElement: %w", err)
+		}
+	}
 	for i, item := range r.Parameter {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Parameter[%d]: %w", i, err)
 		}
 	}
+	if r.HeartbeatPeriodElement != nil {
+		if err := r.HeartbeatPeriodElement.Validate(); err != nil {
+			return fmt.Errorf("HeartbeatPeriodElement: %w", err)
+		}
+	}
+	if r.TimeoutElement != nil {
+		if err := r.TimeoutElement.Validate(); err != nil {
+			return fmt.Errorf("TimeoutElement: %w", err)
+		}
+	}
+	if r.ContentTypeElement != nil {
+		if err := r.ContentTypeElement.Validate(); err != nil {
+			return fmt.Errorf("ContentTypeElement: %w", err)
+		}
+	}
+	if r.Content != nil && !r.Content.IsValid() {
+		return fmt.Errorf("field 'Content' has invalid code '%s'", *r.Content)
+	}
+	if r.ContentElement != nil {
+		if err := r.ContentElement.Validate(); err != nil {
+			return fmt.Errorf("ContentElement: %w", err)
+		}
+	}
+	if r.MaxCountElement != nil {
+		if err := r.MaxCountElement.Validate(); err != nil {
+			return fmt.Errorf("MaxCountElement: %w", err)
+		}
+	}
+	return validateInvariants(r, "Subscription")
+}
+
+func (r *Subscription) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Subscription" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Subscription', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	var emptyString string
+	if r.Topic == emptyString {
+		issues.add("required", path+".topic", "field 'Topic' is required")
+	}
+	if r.TopicElement != nil {
+		r.TopicElement.validateAll(path+".topic", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.End != nil {
+		if err := r.End.Validate(); err != nil {
+			issues.add("value", path+".end", err.Error())
+		}
+	}
+	if r.EndElement != nil {
+		r.EndElement.validateAll(path+".end", issues)
+	}
+	if r.ManagingEntity != nil {
+		if target := invalidReferenceTarget(r.ManagingEntity.Reference, r.ManagingEntity.Type, subscriptionManagingEntityTargets); target != "" {
+			issues.add("value", path+".managingEntity", fmt.Sprintf("field 'ManagingEntity' cannot refer to %s, expected one of %v", target, subscriptionManagingEntityTargets))
+		}
+	}
+	if r.ManagingEntity != nil {
+		r.ManagingEntity.validateAll(path+".managingEntity", issues)
+	}
+	if r.ReasonElement != nil {
+		r.ReasonElement.validateAll(path+".reason", issues)
+	}
+	for i, item := range r.FilterBy {
+		item.validateAll(fmt.Sprintf("%s.filterBy[%d]", path, i), issues)
+	}
+	if r.ChannelType == nil {
+		issues.add("required", path+".channelType", "field 'ChannelType' is required")
+	}
+	if r.ChannelType != nil {
+		r.ChannelType.validateAll(path+".channelType", issues)
+	}
+	if r.EndpointElement != nil {
+		r.EndpointElement.validateAll(path+".endpoint", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	if r.HeartbeatPeriodElement != nil {
+		r.HeartbeatPeriodElement.validateAll(path+".heartbeatPeriod", issues)
+	}
+	if r.TimeoutElement != nil {
+		r.TimeoutElement.validateAll(path+".timeout", issues)
+	}
+	if r.ContentTypeElement != nil {
+		r.ContentTypeElement.validateAll(path+".contentType", issues)
+	}
+	if r.Content != nil && !r.Content.IsValid() {
+		issues.add("code-invalid", path+".content", fmt.Sprintf("field 'Content' has invalid code '%s'", *r.Content))
+	}
+	if r.ContentElement != nil {
+		r.ContentElement.validateAll(path+".content", issues)
+	}
+	if r.MaxCountElement != nil {
+		r.MaxCountElement.validateAll(path+".maxCount", issues)
+	}
+}
+
+func (r *Subscription) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Subscription", &issues)
+	checkInvariants(r, "Subscription", &issues)
+	return issues
+}
+
+var subscriptionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Subscription) invariants() []invariant {
+	return subscriptionInvariants
+}
+
+func (r *Subscription) UnmarshalJSON(data []byte) error {
+	type alias Subscription
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r Subscription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Subscription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Subscription) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Subscription) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionElementTypes = map[string]string{
+	"id":              "id",
+	"implicitRules":   "uri",
+	"language":        "code",
+	"topic":           "canonical",
+	"endpoint":        "url",
+	"heartbeatPeriod": "unsignedInt",
+	"timeout":         "unsignedInt",
+	"contentType":     "code",
+	"maxCount":        "positiveInt",
+}
+
+func (r *Subscription) elementTypes() map[string]string {
+	return subscriptionElementTypes
+}
+
+var subscriptionElementMetadata = []ElementMetadata{
+	{Path: "Subscription.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Subscription.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Subscription.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Subscription.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Subscription.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Subscription.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Subscription.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Subscription.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Subscription.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Subscription.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Subscription.name", Name: "name", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Subscription.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-status"}},
+	{Path: "Subscription.topic", Name: "topic", Field: 16, Min: 1, Max: "1", Types: []string{"canonical"}},
+	{Path: "Subscription.contact", Name: "contact", Field: 18, Min: 0, Max: "*", Types: []string{"ContactPoint"}},
+	{Path: "Subscription.end", Name: "end", Field: 19, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "Subscription.managingEntity", Name: "managingEntity", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Subscription.reason", Name: "reason", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.filterBy", Name: "filterBy", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Subscription.channelType", Name: "channelType", Field: 25, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "Subscription.endpoint", Name: "endpoint", Field: 26, Min: 0, Max: "1", Types: []string{"url"}},
+	{Path: "Subscription.parameter", Name: "parameter", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Subscription.heartbeatPeriod", Name: "heartbeatPeriod", Field: 29, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Subscription.timeout", Name: "timeout", Field: 31, Min: 0, Max: "1", Types: []string{"unsignedInt"}},
+	{Path: "Subscription.contentType", Name: "contentType", Field: 33, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "Subscription.content", Name: "content", Field: 35, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Subscription.content", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-payload-content"}},
+	{Path: "Subscription.maxCount", Name: "maxCount", Field: 37, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+}
+
+func (r *Subscription) ElementMetadata() []ElementMetadata {
+	return subscriptionElementMetadata
+}
+
+func (r *Subscription) GetResourceType() string {
+	return "Subscription"
+}
+
+func (r *Subscription) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Subscription) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Subscription) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Subscription) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Subscription) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Subscription) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Subscription) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Subscription)(nil)
+
 type SubscriptionFilterBy struct {
-	Id              *string           `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Resource        *string           `json:"resource,omitempty" bson:"resource,omitempty"`     // Allowed Resource (reference to definition) for this Subscription filter
-	FilterParameter string            `json:"filterParameter" bson:"filter_parameter"`          // Filter label defined in SubscriptionTopic
-	Comparator      *string           `json:"comparator,omitempty" bson:"comparator,omitempty"` // eq | ne | gt | lt | ge | le | sa | eb | ap
-	Modifier        *string           `json:"modifier,omitempty" bson:"modifier,omitempty"`     // missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
-	Value           string            `json:"value" bson:"value"`                               // Literal value or resource path
-	Event           []CodeableConcept `json:"event,omitempty" bson:"event,omitempty"`           // Event to filter by
+	Id                     *string                 `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ModifierExtension      []Extension             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`      // Extensions that cannot be ignored even if unrecognized
+	Resource               *string                 `json:"resource,omitempty" bson:"resource,omitempty"`                         // Allowed Resource (reference to definition) for this Subscription filter
+	ResourceElement        *Element                `json:"_resource,omitempty" bson:"resource_element,omitempty"`                // Extensions for resource
+	FilterParameter        string                  `json:"filterParameter" bson:"filter_parameter"`                              // Filter label defined in SubscriptionTopic
+	FilterParameterElement *Element                `json:"_filterParameter,omitempty" bson:"filter_parameter_element,omitempty"` // Extensions for filterParameter
+	Comparator             *SearchComparator       `json:"comparator,omitempty" bson:"comparator,omitempty"`                     // eq | ne | gt | lt | ge | le | sa | eb | ap
+	ComparatorElement      *Element                `json:"_comparator,omitempty" bson:"comparator_element,omitempty"`            // Extensions for comparator
+	Modifier               *SearchModifierAllCodes `json:"modifier,omitempty" bson:"modifier,omitempty"`                         // missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
+	ModifierElement        *Element                `json:"_modifier,omitempty" bson:"modifier_element,omitempty"`                // Extensions for modifier
+	Value                  string                  `json:"value" bson:"value"`                                                   // Literal value or resource path
+	ValueElement           *Element                `json:"_value,omitempty" bson:"value_element,omitempty"`                      // Extensions for value
+	Event                  []CodeableConcept       `json:"event,omitempty" bson:"event,omitempty"`                               // Event to filter by
 }
 
 func (r *SubscriptionFilterBy) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.ResourceElement != nil {
+		if err := r.ResourceElement.Validate(); err != nil {
+			return fmt.Errorf("ResourceElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.FilterParameter == emptyString {
 		return fmt.Errorf("field 'FilterParameter' is required")
 	}
+	if r.FilterParameterElement != nil {
+		if err := r.FilterParameterElement.Validate(); err != nil {
+			return fmt.Errorf("FilterParameterElement: %w", err)
+		}
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		return fmt.Errorf("field 'Comparator' has invalid code '%s'", *r.Comparator)
+	}
+	if r.ComparatorElement != nil {
+		if err := r.ComparatorElement.Validate(); err != nil {
+			return fmt.Errorf("ComparatorElement: %w", err)
+		}
+	}
+	if r.Modifier != nil && !r.Modifier.IsValid() {
+		return fmt.Errorf("field 'Modifier' has invalid code '%s'", *r.Modifier)
+	}
+	if r.ModifierElement != nil {
+		if err := r.ModifierElement.Validate(); err != nil {
+			return fmt.Errorf("ModifierElement: %w", err)
+		}
+	}
 	if r.Value == emptyString {
 		return fmt.Errorf("field 'Value' is required")
 	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
 	for i, item := range r.Event {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Event[%d]: %w", i, err)
@@ -116,19 +532,189 @@ func (r *SubscriptionFilterBy) Validate() error {
 	return nil
 }
 
+func (r *SubscriptionFilterBy) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.ResourceElement != nil {
+		r.ResourceElement.validateAll(path+".resource", issues)
+	}
+	var emptyString string
+	if r.FilterParameter == emptyString {
+		issues.add("required", path+".filterParameter", "field 'FilterParameter' is required")
+	}
+	if r.FilterParameterElement != nil {
+		r.FilterParameterElement.validateAll(path+".filterParameter", issues)
+	}
+	if r.Comparator != nil && !r.Comparator.IsValid() {
+		issues.add("code-invalid", path+".comparator", fmt.Sprintf("field 'Comparator' has invalid code '%s'", *r.Comparator))
+	}
+	if r.ComparatorElement != nil {
+		r.ComparatorElement.validateAll(path+".comparator", issues)
+	}
+	if r.Modifier != nil && !r.Modifier.IsValid() {
+		issues.add("code-invalid", path+".modifier", fmt.Sprintf("field 'Modifier' has invalid code '%s'", *r.Modifier))
+	}
+	if r.ModifierElement != nil {
+		r.ModifierElement.validateAll(path+".modifier", issues)
+	}
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	for i, item := range r.Event {
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
+	}
+}
+
+var subscriptionFilterByInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionFilterBy) invariants() []invariant {
+	return subscriptionFilterByInvariants
+}
+
+func (r SubscriptionFilterBy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionFilterBy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionFilterBy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionFilterBy) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionFilterByElementTypes = map[string]string{
+	"resource": "uri",
+}
+
+func (r *SubscriptionFilterBy) elementTypes() map[string]string {
+	return subscriptionFilterByElementTypes
+}
+
+var subscriptionFilterByElementMetadata = []ElementMetadata{
+	{Path: "Subscription.filterBy.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.filterBy.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Subscription.filterBy.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Subscription.filterBy.resource", Name: "resource", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "Subscription.filterBy.filterParameter", Name: "filterParameter", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.filterBy.comparator", Name: "comparator", Field: 7, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Subscription.filterBy.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-comparator"}},
+	{Path: "Subscription.filterBy.modifier", Name: "modifier", Field: 9, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Subscription.filterBy.modifier", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-modifier-all-codes"}},
+	{Path: "Subscription.filterBy.value", Name: "value", Field: 11, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.filterBy.event", Name: "event", Field: 13, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *SubscriptionFilterBy) ElementMetadata() []ElementMetadata {
+	return subscriptionFilterByElementMetadata
+}
+
 type SubscriptionParameter struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Name  string  `json:"name" bson:"name"`                 // Name (key) of the parameter
-	Value string  `json:"value" bson:"value"`               // Value of the parameter to use or pass through
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string      `json:"name" bson:"name"`                                                // Name (key) of the parameter
+	NameElement       *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Value             string      `json:"value" bson:"value"`                                              // Value of the parameter to use or pass through
+	ValueElement      *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
 }
 
 func (r *SubscriptionParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	if r.Value == emptyString {
 		return fmt.Errorf("field 'Value' is required")
 	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *SubscriptionParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var subscriptionParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionParameter) invariants() []invariant {
+	return subscriptionParameterInvariants
+}
+
+func (r SubscriptionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionParameterElementMetadata = []ElementMetadata{
+	{Path: "Subscription.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Subscription.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Subscription.parameter.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "Subscription.parameter.value", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SubscriptionParameter) ElementMetadata() []ElementMetadata {
+	return subscriptionParameterElementMetadata
+}
@@ -2,25 +2,34 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The SubscriptionStatus resource describes the state of a Subscription during notifications. It is not persisted.
 type SubscriptionStatus struct {
-	ResourceType                 string                                `json:"resourceType" bson:"resource_type"`                                                       // Type of resource
-	Id                           *string                               `json:"id,omitempty" bson:"id,omitempty"`                                                        // Logical id of this artifact
-	Meta                         *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                                                    // Metadata about the resource
-	ImplicitRules                *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                 // A set of rules under which this content was created
-	Language                     *string                               `json:"language,omitempty" bson:"language,omitempty"`                                            // Language of the resource content
-	Text                         *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                                                    // Text summary of the resource, for human interpretation
-	Contained                    []json.RawMessage                     `json:"contained,omitempty" bson:"contained,omitempty"`                                          // Contained, inline Resources
-	Status                       *string                               `json:"status,omitempty" bson:"status,omitempty"`                                                // requested | active | error | off | entered-in-error
-	Type                         string                                `json:"type" bson:"type"`                                                                        // handshake | heartbeat | event-notification | query-status | query-event
-	EventsSinceSubscriptionStart *int64                                `json:"eventsSinceSubscriptionStart,omitempty" bson:"events_since_subscription_start,omitempty"` // Events since the Subscription was created
-	NotificationEvent            []SubscriptionStatusNotificationEvent `json:"notificationEvent,omitempty" bson:"notification_event,omitempty"`                         // Detailed information about any events relevant to this notification
-	Subscription                 *Reference                            `json:"subscription" bson:"subscription"`                                                        // Reference to the Subscription responsible for this notification
-	Topic                        *string                               `json:"topic,omitempty" bson:"topic,omitempty"`                                                  // Reference to the SubscriptionTopic this notification relates to
-	Error                        []CodeableConcept                     `json:"error,omitempty" bson:"error,omitempty"`                                                  // List of errors on the subscription
+	ResourceType                        string                                `json:"resourceType" bson:"resource_type"`                                                                // Type of resource
+	Id                                  *string                               `json:"id,omitempty" bson:"id,omitempty"`                                                                 // Logical id of this artifact
+	Meta                                *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                                                             // Metadata about the resource
+	ImplicitRules                       *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                                          // A set of rules under which this content was created
+	ImplicitRulesElement                *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`                                 // Extensions for implicitRules
+	Language                            *string                               `json:"language,omitempty" bson:"language,omitempty"`                                                     // Language of the resource content
+	LanguageElement                     *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`                                            // Extensions for language
+	Text                                *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                                                             // Text summary of the resource, for human interpretation
+	Contained                           []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                                                   // Contained, inline Resources
+	Extension                           []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                                                   // Additional content defined by implementations
+	ModifierExtension                   []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                                  // Extensions that cannot be ignored
+	Status                              *SubscriptionStatusCodes              `json:"status,omitempty" bson:"status,omitempty"`                                                         // requested | active | error | off | entered-in-error
+	StatusElement                       *Element                              `json:"_status,omitempty" bson:"status_element,omitempty"`                                                // Extensions for status
+	Type                                SubscriptionNotificationType          `json:"type" bson:"type"`                                                                                 // handshake | heartbeat | event-notification | query-status | query-event
+	TypeElement                         *Element                              `json:"_type,omitempty" bson:"type_element,omitempty"`                                                    // Extensions for type
+	EventsSinceSubscriptionStart        *int64                                `json:"eventsSinceSubscriptionStart,omitempty" bson:"events_since_subscription_start,omitempty"`          // Events since the Subscription was created
+	EventsSinceSubscriptionStartElement *Element                              `json:"_eventsSinceSubscriptionStart,omitempty" bson:"events_since_subscription_start_element,omitempty"` // Extensions for eventsSinceSubscriptionStart
+	NotificationEvent                   []SubscriptionStatusNotificationEvent `json:"notificationEvent,omitempty" bson:"notification_event,omitempty"`                                  // Detailed information about any events relevant to this notification
+	Subscription                        *Reference                            `json:"subscription" bson:"subscription"`                                                                 // Reference to the Subscription responsible for this notification
+	Topic                               *string                               `json:"topic,omitempty" bson:"topic,omitempty"`                                                           // Reference to the SubscriptionTopic this notification relates to
+	TopicElement                        *Element                              `json:"_topic,omitempty" bson:"topic_element,omitempty"`                                                  // Extensions for topic
+	Error                               []CodeableConcept                     `json:"error,omitempty" bson:"error,omitempty"`                                                           // List of errors on the subscription
 }
 
 func (r *SubscriptionStatus) Validate() error {
@@ -32,15 +41,63 @@ func (r *SubscriptionStatus) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.EventsSinceSubscriptionStartElement != nil {
+		if err := r.EventsSinceSubscriptionStartElement.Validate(); err != nil {
+			return fmt.Errorf("EventsSinceSubscriptionStartElement: %w", err)
+		}
+	}
 	for i, item := range r.NotificationEvent {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("NotificationEvent[%d]: %w", i, err)
@@ -54,52 +111,260 @@ func (r *SubscriptionStatus) Validate() error {
 			return fmt.Errorf("Subscription: %w", err)
 		}
 	}
+	if r.TopicElement != nil {
+		if err := r.TopicElement.Validate(); err != nil {
+			return fmt.Errorf("TopicElement: %w", err)
+		}
+	}
 	for i, item := range r.Error {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Error[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "SubscriptionStatus")
 }
 
-type SubscriptionStatusNotificationEventAuthorizationHint struct {
-	Id                *string `json:"id,omitempty" bson:"id,omitempty"`            // Unique id for inter-element referencing
-	AuthorizationType *Coding `json:"authorizationType" bson:"authorization_type"` // Classification of the authorization hint, e.g., 'oAuthChallengeToken'
-	Value             *string `json:"value,omitempty" bson:"value,omitempty"`      // Authorization value, as defined by the 'authorizationType'
+func (r *SubscriptionStatus) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "SubscriptionStatus" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'SubscriptionStatus', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.EventsSinceSubscriptionStartElement != nil {
+		r.EventsSinceSubscriptionStartElement.validateAll(path+".eventsSinceSubscriptionStart", issues)
+	}
+	for i, item := range r.NotificationEvent {
+		item.validateAll(fmt.Sprintf("%s.notificationEvent[%d]", path, i), issues)
+	}
+	if r.Subscription == nil {
+		issues.add("required", path+".subscription", "field 'Subscription' is required")
+	}
+	if r.Subscription != nil {
+		r.Subscription.validateAll(path+".subscription", issues)
+	}
+	if r.TopicElement != nil {
+		r.TopicElement.validateAll(path+".topic", issues)
+	}
+	for i, item := range r.Error {
+		item.validateAll(fmt.Sprintf("%s.error[%d]", path, i), issues)
+	}
 }
 
-func (r *SubscriptionStatusNotificationEventAuthorizationHint) Validate() error {
-	if r.AuthorizationType == nil {
-		return fmt.Errorf("field 'AuthorizationType' is required")
+func (r *SubscriptionStatus) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SubscriptionStatus", &issues)
+	checkInvariants(r, "SubscriptionStatus", &issues)
+	return issues
+}
+
+var subscriptionStatusInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *SubscriptionStatus) invariants() []invariant {
+	return subscriptionStatusInvariants
+}
+
+func (r *SubscriptionStatus) UnmarshalJSON(data []byte) error {
+	type alias SubscriptionStatus
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.AuthorizationType != nil {
-		if err := r.AuthorizationType.Validate(); err != nil {
-			return fmt.Errorf("AuthorizationType: %w", err)
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
+func (r SubscriptionStatus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionStatus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionStatus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionStatus) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionStatusElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"topic":         "canonical",
+}
+
+func (r *SubscriptionStatus) elementTypes() map[string]string {
+	return subscriptionStatusElementTypes
+}
+
+var subscriptionStatusElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionStatus.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "SubscriptionStatus.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "SubscriptionStatus.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionStatus.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionStatus.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "SubscriptionStatus.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "SubscriptionStatus.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "SubscriptionStatus.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionStatus.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionStatus.status", Name: "status", Field: 11, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionStatus.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-status"}},
+	{Path: "SubscriptionStatus.type", Name: "type", Field: 13, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionStatus.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscription-notification-type"}},
+	{Path: "SubscriptionStatus.eventsSinceSubscriptionStart", Name: "eventsSinceSubscriptionStart", Field: 15, Min: 0, Max: "1", Types: []string{"integer64"}},
+	{Path: "SubscriptionStatus.notificationEvent", Name: "notificationEvent", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubscriptionStatus.subscription", Name: "subscription", Field: 18, Min: 1, Max: "1", Types: []string{"Reference"}},
+	{Path: "SubscriptionStatus.topic", Name: "topic", Field: 19, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "SubscriptionStatus.error", Name: "error", Field: 21, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *SubscriptionStatus) ElementMetadata() []ElementMetadata {
+	return subscriptionStatusElementMetadata
+}
+
+func (r *SubscriptionStatus) GetResourceType() string {
+	return "SubscriptionStatus"
+}
+
+func (r *SubscriptionStatus) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *SubscriptionStatus) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *SubscriptionStatus) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *SubscriptionStatus) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *SubscriptionStatus) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *SubscriptionStatus) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *SubscriptionStatus) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*SubscriptionStatus)(nil)
+
 type SubscriptionStatusNotificationEvent struct {
-	Id                *string                                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	EventNumber       int64                                                  `json:"eventNumber" bson:"event_number"`                                 // Sequencing index of this event
-	TriggerEvent      []CodeableConcept                                      `json:"triggerEvent,omitempty" bson:"trigger_event,omitempty"`           // Event that triggered this notification
-	Timestamp         *string                                                `json:"timestamp,omitempty" bson:"timestamp,omitempty"`                  // The instant this event occurred
-	Focus             *Reference                                             `json:"focus,omitempty" bson:"focus,omitempty"`                          // Reference to the primary resource or information of this event
-	AdditionalContext []Reference                                            `json:"additionalContext,omitempty" bson:"additional_context,omitempty"` // References related to the focus resource and/or context of this event
-	RelatedQuery      []SubscriptionStatusNotificationEventRelatedQuery      `json:"relatedQuery,omitempty" bson:"related_query,omitempty"`           // Query describing data relevant to this notification
-	AuthorizationHint []SubscriptionStatusNotificationEventAuthorizationHint `json:"authorizationHint,omitempty" bson:"authorization_hint,omitempty"` // Authorization information relevant to a notification
+	Id                 *string                                                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                                            `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                                            `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	EventNumber        int64                                                  `json:"eventNumber" bson:"event_number"`                                 // Sequencing index of this event
+	EventNumberElement *Element                                               `json:"_eventNumber,omitempty" bson:"event_number_element,omitempty"`    // Extensions for eventNumber
+	TriggerEvent       []CodeableConcept                                      `json:"triggerEvent,omitempty" bson:"trigger_event,omitempty"`           // Event that triggered this notification
+	Timestamp          *Instant                                               `json:"timestamp,omitempty" bson:"timestamp,omitempty"`                  // The instant this event occurred
+	TimestampElement   *Element                                               `json:"_timestamp,omitempty" bson:"timestamp_element,omitempty"`         // Extensions for timestamp
+	Focus              *Reference                                             `json:"focus,omitempty" bson:"focus,omitempty"`                          // Reference to the primary resource or information of this event
+	AdditionalContext  []Reference                                            `json:"additionalContext,omitempty" bson:"additional_context,omitempty"` // References related to the focus resource and/or context of this event
+	RelatedQuery       []SubscriptionStatusNotificationEventRelatedQuery      `json:"relatedQuery,omitempty" bson:"related_query,omitempty"`           // Query describing data relevant to this notification
+	AuthorizationHint  []SubscriptionStatusNotificationEventAuthorizationHint `json:"authorizationHint,omitempty" bson:"authorization_hint,omitempty"` // Authorization information relevant to a notification
 }
 
 func (r *SubscriptionStatusNotificationEvent) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.EventNumber == 0 {
 		return fmt.Errorf("field 'EventNumber' is required")
 	}
+	if r.EventNumberElement != nil {
+		if err := r.EventNumberElement.Validate(); err != nil {
+			return fmt.Errorf("EventNumberElement: %w", err)
+		}
+	}
 	for i, item := range r.TriggerEvent {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("TriggerEvent[%d]: %w", i, err)
 		}
 	}
+	if r.Timestamp != nil {
+		if err := r.Timestamp.Validate(); err != nil {
+			return fmt.Errorf("Timestamp: %w", err)
+		}
+	}
+	if r.TimestampElement != nil {
+		if err := r.TimestampElement.Validate(); err != nil {
+			return fmt.Errorf("TimestampElement: %w", err)
+		}
+	}
 	if r.Focus != nil {
 		if err := r.Focus.Validate(); err != nil {
 			return fmt.Errorf("Focus: %w", err)
@@ -123,13 +388,105 @@ func (r *SubscriptionStatusNotificationEvent) Validate() error {
 	return nil
 }
 
+func (r *SubscriptionStatusNotificationEvent) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.EventNumber == 0 {
+		issues.add("required", path+".eventNumber", "field 'EventNumber' is required")
+	}
+	if r.EventNumberElement != nil {
+		r.EventNumberElement.validateAll(path+".eventNumber", issues)
+	}
+	for i, item := range r.TriggerEvent {
+		item.validateAll(fmt.Sprintf("%s.triggerEvent[%d]", path, i), issues)
+	}
+	if r.Timestamp != nil {
+		if err := r.Timestamp.Validate(); err != nil {
+			issues.add("value", path+".timestamp", err.Error())
+		}
+	}
+	if r.TimestampElement != nil {
+		r.TimestampElement.validateAll(path+".timestamp", issues)
+	}
+	if r.Focus != nil {
+		r.Focus.validateAll(path+".focus", issues)
+	}
+	for i, item := range r.AdditionalContext {
+		item.validateAll(fmt.Sprintf("%s.additionalContext[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedQuery {
+		item.validateAll(fmt.Sprintf("%s.relatedQuery[%d]", path, i), issues)
+	}
+	for i, item := range r.AuthorizationHint {
+		item.validateAll(fmt.Sprintf("%s.authorizationHint[%d]", path, i), issues)
+	}
+}
+
+var subscriptionStatusNotificationEventInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionStatusNotificationEvent) invariants() []invariant {
+	return subscriptionStatusNotificationEventInvariants
+}
+
+func (r SubscriptionStatusNotificationEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionStatusNotificationEvent) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionStatusNotificationEvent) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionStatusNotificationEvent) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionStatusNotificationEventElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionStatus.notificationEvent.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionStatus.notificationEvent.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionStatus.notificationEvent.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionStatus.notificationEvent.eventNumber", Name: "eventNumber", Field: 3, Min: 1, Max: "1", Types: []string{"integer64"}},
+	{Path: "SubscriptionStatus.notificationEvent.triggerEvent", Name: "triggerEvent", Field: 5, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubscriptionStatus.notificationEvent.timestamp", Name: "timestamp", Field: 6, Min: 0, Max: "1", Types: []string{"instant"}},
+	{Path: "SubscriptionStatus.notificationEvent.focus", Name: "focus", Field: 8, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "SubscriptionStatus.notificationEvent.additionalContext", Name: "additionalContext", Field: 9, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "SubscriptionStatus.notificationEvent.relatedQuery", Name: "relatedQuery", Field: 10, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubscriptionStatus.notificationEvent.authorizationHint", Name: "authorizationHint", Field: 11, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SubscriptionStatusNotificationEvent) ElementMetadata() []ElementMetadata {
+	return subscriptionStatusNotificationEventElementMetadata
+}
+
 type SubscriptionStatusNotificationEventRelatedQuery struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
-	QueryType *Coding `json:"queryType,omitempty" bson:"query_type,omitempty"` // Coded information describing the type of data this query provides
-	Query     string  `json:"query" bson:"query"`                              // Query to perform
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	QueryType         *Coding     `json:"queryType,omitempty" bson:"query_type,omitempty"`                 // Coded information describing the type of data this query provides
+	Query             string      `json:"query" bson:"query"`                                              // Query to perform
+	QueryElement      *Element    `json:"_query,omitempty" bson:"query_element,omitempty"`                 // Extensions for query
 }
 
 func (r *SubscriptionStatusNotificationEventRelatedQuery) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.QueryType != nil {
 		if err := r.QueryType.Validate(); err != nil {
 			return fmt.Errorf("QueryType: %w", err)
@@ -139,5 +496,155 @@ func (r *SubscriptionStatusNotificationEventRelatedQuery) Validate() error {
 	if r.Query == emptyString {
 		return fmt.Errorf("field 'Query' is required")
 	}
+	if r.QueryElement != nil {
+		if err := r.QueryElement.Validate(); err != nil {
+			return fmt.Errorf("QueryElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *SubscriptionStatusNotificationEventRelatedQuery) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.QueryType != nil {
+		r.QueryType.validateAll(path+".queryType", issues)
+	}
+	var emptyString string
+	if r.Query == emptyString {
+		issues.add("required", path+".query", "field 'Query' is required")
+	}
+	if r.QueryElement != nil {
+		r.QueryElement.validateAll(path+".query", issues)
+	}
+}
+
+var subscriptionStatusNotificationEventRelatedQueryInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionStatusNotificationEventRelatedQuery) invariants() []invariant {
+	return subscriptionStatusNotificationEventRelatedQueryInvariants
+}
+
+func (r SubscriptionStatusNotificationEventRelatedQuery) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionStatusNotificationEventRelatedQuery) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionStatusNotificationEventRelatedQuery) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionStatusNotificationEventRelatedQuery) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionStatusNotificationEventRelatedQueryElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionStatus.notificationEvent.relatedQuery.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionStatus.notificationEvent.relatedQuery.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionStatus.notificationEvent.relatedQuery.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionStatus.notificationEvent.relatedQuery.queryType", Name: "queryType", Field: 3, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "SubscriptionStatus.notificationEvent.relatedQuery.query", Name: "query", Field: 4, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SubscriptionStatusNotificationEventRelatedQuery) ElementMetadata() []ElementMetadata {
+	return subscriptionStatusNotificationEventRelatedQueryElementMetadata
+}
+
+type SubscriptionStatusNotificationEventAuthorizationHint struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	AuthorizationType *Coding     `json:"authorizationType" bson:"authorization_type"`                     // Classification of the authorization hint, e.g., 'oAuthChallengeToken'
+	Value             *string     `json:"value,omitempty" bson:"value,omitempty"`                          // Authorization value, as defined by the 'authorizationType'
+	ValueElement      *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *SubscriptionStatusNotificationEventAuthorizationHint) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.AuthorizationType == nil {
+		return fmt.Errorf("field 'AuthorizationType' is required")
+	}
+	if r.AuthorizationType != nil {
+		if err := r.AuthorizationType.Validate(); err != nil {
+			return fmt.Errorf("AuthorizationType: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SubscriptionStatusNotificationEventAuthorizationHint) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.AuthorizationType == nil {
+		issues.add("required", path+".authorizationType", "field 'AuthorizationType' is required")
+	}
+	if r.AuthorizationType != nil {
+		r.AuthorizationType.validateAll(path+".authorizationType", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var subscriptionStatusNotificationEventAuthorizationHintInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionStatusNotificationEventAuthorizationHint) invariants() []invariant {
+	return subscriptionStatusNotificationEventAuthorizationHintInvariants
+}
+
+func (r SubscriptionStatusNotificationEventAuthorizationHint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionStatusNotificationEventAuthorizationHint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionStatusNotificationEventAuthorizationHint) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionStatusNotificationEventAuthorizationHint) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionStatusNotificationEventAuthorizationHintElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionStatus.notificationEvent.authorizationHint.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionStatus.notificationEvent.authorizationHint.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionStatus.notificationEvent.authorizationHint.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionStatus.notificationEvent.authorizationHint.authorizationType", Name: "authorizationType", Field: 3, Min: 1, Max: "1", Types: []string{"Coding"}},
+	{Path: "SubscriptionStatus.notificationEvent.authorizationHint.value", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SubscriptionStatusNotificationEventAuthorizationHint) ElementMetadata() []ElementMetadata {
+	return subscriptionStatusNotificationEventAuthorizationHintElementMetadata
+}
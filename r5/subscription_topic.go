@@ -2,41 +2,63 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Describes a stream of resource state changes identified by trigger criteria and annotated with labels useful to filter projections from this topic.
 type SubscriptionTopic struct {
-	ResourceType           string                     `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                    `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                      `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                    `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                    `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                 `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage          `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    string                     `json:"url" bson:"url"`                                                             // Canonical identifier for this subscription topic, represented as an absolute URI (globally unique)
-	Identifier             []Identifier               `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Business identifier for subscription topic
-	Version                *string                    `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the subscription topic
-	VersionAlgorithmString *string                    `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                    `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                    `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this subscription topic (computer friendly)
-	Title                  *string                    `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this subscription topic (human friendly)
-	DerivedFrom            []string                   `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                        // Based on FHIR protocol or definition
-	Status                 string                     `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                      `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // If For testing only - never for real usage
-	Date                   *string                    `json:"date,omitempty" bson:"date,omitempty"`                                       // Date status first applied
-	Publisher              *string                    `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // The name of the individual or organization that published the SubscriptionTopic
-	Contact                []ContactDetail            `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                    `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the SubscriptionTopic
-	UseContext             []UsageContext             `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // Content intends to support these contexts
-	Jurisdiction           []CodeableConcept          `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Intended jurisdiction of the SubscriptionTopic (if applicable)
-	Purpose                *string                    `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this SubscriptionTopic is defined
-	Copyright              *string                    `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                    `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string                    `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When SubscriptionTopic is/was approved by publisher
-	LastReviewDate         *string                    `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // Date the Subscription Topic was last reviewed by the publisher
-	EffectivePeriod        *Period                    `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // The effective date range for the SubscriptionTopic
-	Trigger                []SubscriptionTopicTrigger `json:"trigger,omitempty" bson:"trigger,omitempty"`                                 // Definition of a trigger for the subscription topic
+	ResourceType            string                            `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                           `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                             `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                           `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                          `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                           `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                          `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative                        `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource                        `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     string                            `json:"url" bson:"url"`                                                      // Canonical identifier for this subscription topic, represented as an absolute URI (globally unique)
+	UrlElement              *Element                          `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Business identifier for subscription topic
+	Version                 *string                           `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the subscription topic
+	VersionElement          *Element                          `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        SubscriptionTopicVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                          `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                           `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this subscription topic (computer friendly)
+	NameElement             *Element                          `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                           `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this subscription topic (human friendly)
+	TitleElement            *Element                          `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	DerivedFrom             []string                          `json:"derivedFrom,omitempty" bson:"derived_from,omitempty"`                 // Based on FHIR protocol or definition
+	DerivedFromElement      []*Element                        `json:"_derivedFrom,omitempty" bson:"derived_from_element,omitempty"`        // Extensions for derivedFrom
+	Status                  PublicationStatus                 `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                          `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                             `json:"experimental,omitempty" bson:"experimental,omitempty"`                // If For testing only - never for real usage
+	ExperimentalElement     *Element                          `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Date                    *DateTime                         `json:"date,omitempty" bson:"date,omitempty"`                                // Date status first applied
+	DateElement             *Element                          `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                           `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // The name of the individual or organization that published the SubscriptionTopic
+	PublisherElement        *Element                          `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail                   `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                           `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the SubscriptionTopic
+	DescriptionElement      *Element                          `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext                    `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // Content intends to support these contexts
+	Jurisdiction            []CodeableConcept                 `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Intended jurisdiction of the SubscriptionTopic (if applicable)
+	Purpose                 *string                           `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this SubscriptionTopic is defined
+	PurposeElement          *Element                          `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                           `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                          `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                           `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                          `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                             `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When SubscriptionTopic is/was approved by publisher
+	ApprovalDateElement     *Element                          `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                             `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // Date the Subscription Topic was last reviewed by the publisher
+	LastReviewDateElement   *Element                          `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                           `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // The effective date range for the SubscriptionTopic
+	Trigger                 []SubscriptionTopicTrigger        `json:"trigger,omitempty" bson:"trigger,omitempty"`                          // Definition of a trigger for the subscription topic
+
+	versionAlgorithmVariants []string // JSON properties of SubscriptionTopic.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *SubscriptionTopic) Validate() error {
@@ -48,33 +70,130 @@ func (r *SubscriptionTopic) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Url == emptyString {
 		return fmt.Errorf("field 'Url' is required")
 	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	for i, item := range r.DerivedFromElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DerivedFromElement[%d]: %w", i, err)
 		}
 	}
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -85,6 +204,41 @@ func (r *SubscriptionTopic) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -95,31 +249,440 @@ func (r *SubscriptionTopic) Validate() error {
 			return fmt.Errorf("Trigger[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "SubscriptionTopic")
+}
+
+func (r *SubscriptionTopic) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "SubscriptionTopic" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'SubscriptionTopic', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Url == emptyString {
+		issues.add("required", path+".url", "field 'Url' is required")
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	for i, item := range r.DerivedFromElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.derivedFrom[%d]", path, i), issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
+	}
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
+	}
+	for i, item := range r.Trigger {
+		item.validateAll(fmt.Sprintf("%s.trigger[%d]", path, i), issues)
+	}
+}
+
+func (r *SubscriptionTopic) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SubscriptionTopic", &issues)
+	checkInvariants(r, "SubscriptionTopic", &issues)
+	return issues
+}
+
+var subscriptionTopicInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *SubscriptionTopic) invariants() []invariant {
+	return subscriptionTopicInvariants
+}
+
+func (r SubscriptionTopic) MarshalJSON() ([]byte, error) {
+	type alias SubscriptionTopic
+	out := struct {
+		alias
+		DerivedFrom        []*string  `json:"derivedFrom,omitempty"`
+		DerivedFromElement []*Element `json:"_derivedFrom,omitempty"`
+	}{alias: alias(r)}
+	out.DerivedFrom, out.DerivedFromElement = alignPrimitiveArray(r.DerivedFrom, r.DerivedFromElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SubscriptionTopic) UnmarshalJSON(data []byte) error {
+	type alias SubscriptionTopic
+	aux := struct {
+		*alias
+		Contained   []json.RawMessage `json:"contained,omitempty"`
+		DerivedFrom []*string         `json:"derivedFrom,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	if r.DerivedFrom, err = splitPrimitiveArray(aux.DerivedFrom, r.DerivedFromElement); err != nil {
+		return fmt.Errorf("derivedFrom: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", subscriptionTopicVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r SubscriptionTopic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionTopic) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionTopic) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionTopic) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SubscriptionTopic) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(subscriptionTopicVersionAlgorithmVariants)
+	}
+	return "", nil
+}
+
+var subscriptionTopicElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"derivedFrom":   "canonical",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
+}
+
+func (r *SubscriptionTopic) elementTypes() map[string]string {
+	return subscriptionTopicElementTypes
+}
+
+var subscriptionTopicElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionTopic.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "SubscriptionTopic.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "SubscriptionTopic.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionTopic.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "SubscriptionTopic.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "SubscriptionTopic.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "SubscriptionTopic.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionTopic.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.url", Name: "url", Field: 11, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "SubscriptionTopic.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "SubscriptionTopic.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "SubscriptionTopic.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.derivedFrom", Name: "derivedFrom", Field: 22, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "SubscriptionTopic.status", Name: "status", Field: 24, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionTopic.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "SubscriptionTopic.experimental", Name: "experimental", Field: 26, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SubscriptionTopic.date", Name: "date", Field: 28, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "SubscriptionTopic.publisher", Name: "publisher", Field: 30, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.contact", Name: "contact", Field: 32, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "SubscriptionTopic.description", Name: "description", Field: 33, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubscriptionTopic.useContext", Name: "useContext", Field: 35, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "SubscriptionTopic.jurisdiction", Name: "jurisdiction", Field: 36, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubscriptionTopic.purpose", Name: "purpose", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubscriptionTopic.copyright", Name: "copyright", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubscriptionTopic.copyrightLabel", Name: "copyrightLabel", Field: 41, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.approvalDate", Name: "approvalDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "SubscriptionTopic.lastReviewDate", Name: "lastReviewDate", Field: 45, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "SubscriptionTopic.effectivePeriod", Name: "effectivePeriod", Field: 47, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "SubscriptionTopic.trigger", Name: "trigger", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SubscriptionTopic) ElementMetadata() []ElementMetadata {
+	return subscriptionTopicElementMetadata
+}
+
+// SubscriptionTopicVersionAlgorithm is the type of SubscriptionTopic.versionAlgorithm[x]. It is implemented by the SubscriptionTopicVersionAlgorithm* variant types.
+type SubscriptionTopicVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSubscriptionTopicVersionAlgorithm()
+}
+
+// SubscriptionTopicVersionAlgorithmString is the string variant of SubscriptionTopicVersionAlgorithm.
+type SubscriptionTopicVersionAlgorithmString string
+
+func (v SubscriptionTopicVersionAlgorithmString) Validate() error {
 	return nil
 }
 
+func (v SubscriptionTopicVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SubscriptionTopicVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v SubscriptionTopicVersionAlgorithmString) isSubscriptionTopicVersionAlgorithm() {}
+
+// SubscriptionTopicVersionAlgorithmCoding is the Coding variant of SubscriptionTopicVersionAlgorithm.
+type SubscriptionTopicVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v SubscriptionTopicVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v SubscriptionTopicVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v SubscriptionTopicVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v SubscriptionTopicVersionAlgorithmCoding) isSubscriptionTopicVersionAlgorithm() {}
+
+var subscriptionTopicVersionAlgorithmVariants = []SubscriptionTopicVersionAlgorithm{
+	SubscriptionTopicVersionAlgorithmString(""),
+	SubscriptionTopicVersionAlgorithmCoding{},
+}
+
+func (r *SubscriptionTopic) GetResourceType() string {
+	return "SubscriptionTopic"
+}
+
+func (r *SubscriptionTopic) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *SubscriptionTopic) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *SubscriptionTopic) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *SubscriptionTopic) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *SubscriptionTopic) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *SubscriptionTopic) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *SubscriptionTopic) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*SubscriptionTopic)(nil)
+
 type SubscriptionTopicTrigger struct {
-	Id                   *string                                     `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
-	Description          *string                                     `json:"description,omitempty" bson:"description,omitempty"`                    // Text representation of the resource trigger
-	Resource             string                                      `json:"resource" bson:"resource"`                                              // Key Data Type, Resource (reference to definition), or relevant definition for this trigger
-	SupportedInteraction []string                                    `json:"supportedInteraction,omitempty" bson:"supported_interaction,omitempty"` // create | update | delete
-	QueryCriteria        *SubscriptionTopicTriggerQueryCriteria      `json:"queryCriteria,omitempty" bson:"query_criteria,omitempty"`               // Query based trigger rule
-	FhirPathCriteria     *string                                     `json:"fhirPathCriteria,omitempty" bson:"fhir_path_criteria,omitempty"`        // FHIRPath based trigger rule
-	Event                *CodeableConcept                            `json:"event,omitempty" bson:"event,omitempty"`                                // Event which can trigger a notification from the SubscriptionTopic
-	CanFilterBy          []SubscriptionTopicTriggerCanFilterBy       `json:"canFilterBy,omitempty" bson:"can_filter_by,omitempty"`                  // Properties by which a Subscription can filter notifications based on this trigger
-	NotificationShape    []SubscriptionTopicTriggerNotificationShape `json:"notificationShape,omitempty" bson:"notification_shape,omitempty"`       // Properties for describing the shape of notifications generated by this trigger
+	Id                          *string                                     `json:"id,omitempty" bson:"id,omitempty"`                                               // Unique id for inter-element referencing
+	Extension                   []Extension                                 `json:"extension,omitempty" bson:"extension,omitempty"`                                 // Additional content defined by implementations
+	ModifierExtension           []Extension                                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                // Extensions that cannot be ignored even if unrecognized
+	Description                 *string                                     `json:"description,omitempty" bson:"description,omitempty"`                             // Text representation of the resource trigger
+	DescriptionElement          *Element                                    `json:"_description,omitempty" bson:"description_element,omitempty"`                    // Extensions for description
+	Resource                    string                                      `json:"resource" bson:"resource"`                                                       // Key Data Type, Resource (reference to definition), or relevant definition for this trigger
+	ResourceElement             *Element                                    `json:"_resource,omitempty" bson:"resource_element,omitempty"`                          // Extensions for resource
+	SupportedInteraction        []string                                    `json:"supportedInteraction,omitempty" bson:"supported_interaction,omitempty"`          // create | update | delete
+	SupportedInteractionElement []*Element                                  `json:"_supportedInteraction,omitempty" bson:"supported_interaction_element,omitempty"` // Extensions for supportedInteraction
+	QueryCriteria               *SubscriptionTopicTriggerQueryCriteria      `json:"queryCriteria,omitempty" bson:"query_criteria,omitempty"`                        // Query based trigger rule
+	FhirPathCriteria            *string                                     `json:"fhirPathCriteria,omitempty" bson:"fhir_path_criteria,omitempty"`                 // FHIRPath based trigger rule
+	FhirPathCriteriaElement     *Element                                    `json:"_fhirPathCriteria,omitempty" bson:"fhir_path_criteria_element,omitempty"`        // Extensions for fhirPathCriteria
+	Event                       *CodeableConcept                            `json:"event,omitempty" bson:"event,omitempty"`                                         // Event which can trigger a notification from the SubscriptionTopic
+	CanFilterBy                 []SubscriptionTopicTriggerCanFilterBy       `json:"canFilterBy,omitempty" bson:"can_filter_by,omitempty"`                           // Properties by which a Subscription can filter notifications based on this trigger
+	NotificationShape           []SubscriptionTopicTriggerNotificationShape `json:"notificationShape,omitempty" bson:"notification_shape,omitempty"`                // Properties for describing the shape of notifications generated by this trigger
 }
 
 func (r *SubscriptionTopicTrigger) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.Resource == emptyString {
 		return fmt.Errorf("field 'Resource' is required")
 	}
+	if r.ResourceElement != nil {
+		if err := r.ResourceElement.Validate(); err != nil {
+			return fmt.Errorf("ResourceElement: %w", err)
+		}
+	}
+	for i, item := range r.SupportedInteractionElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SupportedInteractionElement[%d]: %w", i, err)
+		}
+	}
 	if r.QueryCriteria != nil {
 		if err := r.QueryCriteria.Validate(); err != nil {
 			return fmt.Errorf("QueryCriteria: %w", err)
 		}
 	}
+	if r.FhirPathCriteriaElement != nil {
+		if err := r.FhirPathCriteriaElement.Validate(); err != nil {
+			return fmt.Errorf("FhirPathCriteriaElement: %w", err)
+		}
+	}
 	if r.Event != nil {
 		if err := r.Event.Validate(); err != nil {
 			return fmt.Errorf("Event: %w", err)
@@ -138,50 +701,517 @@ func (r *SubscriptionTopicTrigger) Validate() error {
 	return nil
 }
 
+func (r *SubscriptionTopicTrigger) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	var emptyString string
+	if r.Resource == emptyString {
+		issues.add("required", path+".resource", "field 'Resource' is required")
+	}
+	if r.ResourceElement != nil {
+		r.ResourceElement.validateAll(path+".resource", issues)
+	}
+	for i, item := range r.SupportedInteractionElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.supportedInteraction[%d]", path, i), issues)
+	}
+	if r.QueryCriteria != nil {
+		r.QueryCriteria.validateAll(path+".queryCriteria", issues)
+	}
+	if r.FhirPathCriteriaElement != nil {
+		r.FhirPathCriteriaElement.validateAll(path+".fhirPathCriteria", issues)
+	}
+	if r.Event != nil {
+		r.Event.validateAll(path+".event", issues)
+	}
+	for i, item := range r.CanFilterBy {
+		item.validateAll(fmt.Sprintf("%s.canFilterBy[%d]", path, i), issues)
+	}
+	for i, item := range r.NotificationShape {
+		item.validateAll(fmt.Sprintf("%s.notificationShape[%d]", path, i), issues)
+	}
+}
+
+var subscriptionTopicTriggerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionTopicTrigger) invariants() []invariant {
+	return subscriptionTopicTriggerInvariants
+}
+
+func (r SubscriptionTopicTrigger) MarshalJSON() ([]byte, error) {
+	type alias SubscriptionTopicTrigger
+	out := struct {
+		alias
+		SupportedInteraction        []*string  `json:"supportedInteraction,omitempty"`
+		SupportedInteractionElement []*Element `json:"_supportedInteraction,omitempty"`
+	}{alias: alias(r)}
+	out.SupportedInteraction, out.SupportedInteractionElement = alignPrimitiveArray(r.SupportedInteraction, r.SupportedInteractionElement)
+	return json.Marshal(out)
+}
+
+func (r *SubscriptionTopicTrigger) UnmarshalJSON(data []byte) error {
+	type alias SubscriptionTopicTrigger
+	aux := struct {
+		*alias
+		SupportedInteraction []*string `json:"supportedInteraction,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.SupportedInteraction, err = splitPrimitiveArray(aux.SupportedInteraction, r.SupportedInteractionElement); err != nil {
+		return fmt.Errorf("supportedInteraction: %w", err)
+	}
+	return nil
+}
+
+func (r SubscriptionTopicTrigger) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionTopicTrigger) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionTopicTrigger) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionTopicTrigger) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionTopicTriggerElementTypes = map[string]string{
+	"description":          "markdown",
+	"resource":             "uri",
+	"supportedInteraction": "code",
+}
+
+func (r *SubscriptionTopicTrigger) elementTypes() map[string]string {
+	return subscriptionTopicTriggerElementTypes
+}
+
+var subscriptionTopicTriggerElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionTopic.trigger.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionTopic.trigger.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.trigger.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubscriptionTopic.trigger.resource", Name: "resource", Field: 5, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "SubscriptionTopic.trigger.supportedInteraction", Name: "supportedInteraction", Field: 7, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria", Name: "queryCriteria", Field: 9, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "SubscriptionTopic.trigger.fhirPathCriteria", Name: "fhirPathCriteria", Field: 10, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.event", Name: "event", Field: 12, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy", Name: "canFilterBy", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape", Name: "notificationShape", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SubscriptionTopicTrigger) ElementMetadata() []ElementMetadata {
+	return subscriptionTopicTriggerElementMetadata
+}
+
 type SubscriptionTopicTriggerQueryCriteria struct {
-	Id              *string `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
-	Previous        *string `json:"previous,omitempty" bson:"previous,omitempty"`                 // Rule applied to previous resource state
-	ResultForCreate *string `json:"resultForCreate,omitempty" bson:"result_for_create,omitempty"` // test-passes | test-fails
-	Current         *string `json:"current,omitempty" bson:"current,omitempty"`                   // Rule applied to current resource state
-	ResultForDelete *string `json:"resultForDelete,omitempty" bson:"result_for_delete,omitempty"` // test-passes | test-fails
-	RequireBoth     *bool   `json:"requireBoth,omitempty" bson:"require_both,omitempty"`          // Both must be true flag
+	Id                     *string                    `json:"id,omitempty" bson:"id,omitempty"`                                      // Unique id for inter-element referencing
+	Extension              []Extension                `json:"extension,omitempty" bson:"extension,omitempty"`                        // Additional content defined by implementations
+	ModifierExtension      []Extension                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`       // Extensions that cannot be ignored even if unrecognized
+	Previous               *string                    `json:"previous,omitempty" bson:"previous,omitempty"`                          // Rule applied to previous resource state
+	PreviousElement        *Element                   `json:"_previous,omitempty" bson:"previous_element,omitempty"`                 // Extensions for previous
+	ResultForCreate        *CriteriaNotExistsBehavior `json:"resultForCreate,omitempty" bson:"result_for_create,omitempty"`          // test-passes | test-fails
+	ResultForCreateElement *Element                   `json:"_resultForCreate,omitempty" bson:"result_for_create_element,omitempty"` // Extensions for resultForCreate
+	Current                *string                    `json:"current,omitempty" bson:"current,omitempty"`                            // Rule applied to current resource state
+	CurrentElement         *Element                   `json:"_current,omitempty" bson:"current_element,omitempty"`                   // Extensions for current
+	ResultForDelete        *CriteriaNotExistsBehavior `json:"resultForDelete,omitempty" bson:"result_for_delete,omitempty"`          // test-passes | test-fails
+	ResultForDeleteElement *Element                   `json:"_resultForDelete,omitempty" bson:"result_for_delete_element,omitempty"` // Extensions for resultForDelete
+	RequireBoth            *bool                      `json:"requireBoth,omitempty" bson:"require_both,omitempty"`                   // Both must be true flag
+	RequireBothElement     *Element                   `json:"_requireBoth,omitempty" bson:"require_both_element,omitempty"`          // Extensions for requireBoth
 }
 
 func (r *SubscriptionTopicTriggerQueryCriteria) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.PreviousElement != nil {
+		if err := r.PreviousElement.Validate(); err != nil {
+			return fmt.Errorf("PreviousElement: %w", err)
+		}
+	}
+	if r.ResultForCreate != nil && !r.ResultForCreate.IsValid() {
+		return fmt.Errorf("field 'ResultForCreate' has invalid code '%s'", *r.ResultForCreate)
+	}
+	if r.ResultForCreateElement != nil {
+		if err := r.ResultForCreateElement.Validate(); err != nil {
+			return fmt.Errorf("ResultForCreateElement: %w", err)
+		}
+	}
+	if r.CurrentElement != nil {
+		if err := r.CurrentElement.Validate(); err != nil {
+			return fmt.Errorf("CurrentElement: %w", err)
+		}
+	}
+	if r.ResultForDelete != nil && !r.ResultForDelete.IsValid() {
+		return fmt.Errorf("field 'ResultForDelete' has invalid code '%s'", *r.ResultForDelete)
+	}
+	if r.ResultForDeleteElement != nil {
+		if err := r.ResultForDeleteElement.Validate(); err != nil {
+			return fmt.Errorf("ResultForDeleteElement: %w", err)
+		}
+	}
+	if r.RequireBothElement != nil {
+		if err := r.RequireBothElement.Validate(); err != nil {
+			return fmt.Errorf("RequireBothElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *SubscriptionTopicTriggerQueryCriteria) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.PreviousElement != nil {
+		r.PreviousElement.validateAll(path+".previous", issues)
+	}
+	if r.ResultForCreate != nil && !r.ResultForCreate.IsValid() {
+		issues.add("code-invalid", path+".resultForCreate", fmt.Sprintf("field 'ResultForCreate' has invalid code '%s'", *r.ResultForCreate))
+	}
+	if r.ResultForCreateElement != nil {
+		r.ResultForCreateElement.validateAll(path+".resultForCreate", issues)
+	}
+	if r.CurrentElement != nil {
+		r.CurrentElement.validateAll(path+".current", issues)
+	}
+	if r.ResultForDelete != nil && !r.ResultForDelete.IsValid() {
+		issues.add("code-invalid", path+".resultForDelete", fmt.Sprintf("field 'ResultForDelete' has invalid code '%s'", *r.ResultForDelete))
+	}
+	if r.ResultForDeleteElement != nil {
+		r.ResultForDeleteElement.validateAll(path+".resultForDelete", issues)
+	}
+	if r.RequireBothElement != nil {
+		r.RequireBothElement.validateAll(path+".requireBoth", issues)
+	}
+}
+
+var subscriptionTopicTriggerQueryCriteriaInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionTopicTriggerQueryCriteria) invariants() []invariant {
+	return subscriptionTopicTriggerQueryCriteriaInvariants
+}
+
+func (r SubscriptionTopicTriggerQueryCriteria) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionTopicTriggerQueryCriteria) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionTopicTriggerQueryCriteria) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionTopicTriggerQueryCriteria) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionTopicTriggerQueryCriteriaElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionTopic.trigger.queryCriteria.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.previous", Name: "previous", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.resultForCreate", Name: "resultForCreate", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionTopic.trigger.queryCriteria.resultForCreate", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscriptiontopic-cr-behavior"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.current", Name: "current", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.resultForDelete", Name: "resultForDelete", Field: 9, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionTopic.trigger.queryCriteria.resultForDelete", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/subscriptiontopic-cr-behavior"}},
+	{Path: "SubscriptionTopic.trigger.queryCriteria.requireBoth", Name: "requireBoth", Field: 11, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *SubscriptionTopicTriggerQueryCriteria) ElementMetadata() []ElementMetadata {
+	return subscriptionTopicTriggerQueryCriteriaElementMetadata
+}
+
 type SubscriptionTopicTriggerCanFilterBy struct {
-	Id               *string  `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Description      *string  `json:"description,omitempty" bson:"description,omitempty"`            // Description of this filter parameter
-	Resource         *string  `json:"resource,omitempty" bson:"resource,omitempty"`                  // URL of the triggering Resource that this filter applies to
-	FilterParameter  string   `json:"filterParameter" bson:"filter_parameter"`                       // Human-readable and computation-friendly name for a filter parameter usable by subscriptions on this topic, via Subscription.filterBy.filterParameter
-	FilterDefinition *string  `json:"filterDefinition,omitempty" bson:"filter_definition,omitempty"` // Canonical URL for a filterParameter definition
-	Comparator       []string `json:"comparator,omitempty" bson:"comparator,omitempty"`              // eq | ne | gt | lt | ge | le | sa | eb | ap
-	Modifier         []string `json:"modifier,omitempty" bson:"modifier,omitempty"`                  // missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
+	Id                      *string                  `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Description             *string                  `json:"description,omitempty" bson:"description,omitempty"`                     // Description of this filter parameter
+	DescriptionElement      *Element                 `json:"_description,omitempty" bson:"description_element,omitempty"`            // Extensions for description
+	Resource                *string                  `json:"resource,omitempty" bson:"resource,omitempty"`                           // URL of the triggering Resource that this filter applies to
+	ResourceElement         *Element                 `json:"_resource,omitempty" bson:"resource_element,omitempty"`                  // Extensions for resource
+	FilterParameter         string                   `json:"filterParameter" bson:"filter_parameter"`                                // Human-readable and computation-friendly name for a filter parameter usable by subscriptions on this topic, via Subscription.filterBy.filterParameter
+	FilterParameterElement  *Element                 `json:"_filterParameter,omitempty" bson:"filter_parameter_element,omitempty"`   // Extensions for filterParameter
+	FilterDefinition        *string                  `json:"filterDefinition,omitempty" bson:"filter_definition,omitempty"`          // Canonical URL for a filterParameter definition
+	FilterDefinitionElement *Element                 `json:"_filterDefinition,omitempty" bson:"filter_definition_element,omitempty"` // Extensions for filterDefinition
+	Comparator              []SearchComparator       `json:"comparator,omitempty" bson:"comparator,omitempty"`                       // eq | ne | gt | lt | ge | le | sa | eb | ap
+	ComparatorElement       []*Element               `json:"_comparator,omitempty" bson:"comparator_element,omitempty"`              // Extensions for comparator
+	Modifier                []SearchModifierAllCodes `json:"modifier,omitempty" bson:"modifier,omitempty"`                           // missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
+	ModifierElement         []*Element               `json:"_modifier,omitempty" bson:"modifier_element,omitempty"`                  // Extensions for modifier
 }
 
 func (r *SubscriptionTopicTriggerCanFilterBy) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.ResourceElement != nil {
+		if err := r.ResourceElement.Validate(); err != nil {
+			return fmt.Errorf("ResourceElement: %w", err)
+		}
+	}
 	var emptyString string
 	if r.FilterParameter == emptyString {
 		return fmt.Errorf("field 'FilterParameter' is required")
 	}
+	if r.FilterParameterElement != nil {
+		if err := r.FilterParameterElement.Validate(); err != nil {
+			return fmt.Errorf("FilterParameterElement: %w", err)
+		}
+	}
+	if r.FilterDefinitionElement != nil {
+		if err := r.FilterDefinitionElement.Validate(); err != nil {
+			return fmt.Errorf("FilterDefinitionElement: %w", err)
+		}
+	}
+	for i, item := range r.Comparator {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Comparator[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ComparatorElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ComparatorElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Modifier {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'Modifier[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.ModifierElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.ResourceElement != nil {
+		r.ResourceElement.validateAll(path+".resource", issues)
+	}
+	var emptyString string
+	if r.FilterParameter == emptyString {
+		issues.add("required", path+".filterParameter", "field 'FilterParameter' is required")
+	}
+	if r.FilterParameterElement != nil {
+		r.FilterParameterElement.validateAll(path+".filterParameter", issues)
+	}
+	if r.FilterDefinitionElement != nil {
+		r.FilterDefinitionElement.validateAll(path+".filterDefinition", issues)
+	}
+	for i, item := range r.Comparator {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.comparator[%d]", path, i), fmt.Sprintf("field 'Comparator[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ComparatorElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.comparator[%d]", path, i), issues)
+	}
+	for i, item := range r.Modifier {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.modifier[%d]", path, i), fmt.Sprintf("field 'Modifier[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.ModifierElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.modifier[%d]", path, i), issues)
+	}
+}
+
+var subscriptionTopicTriggerCanFilterByInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) invariants() []invariant {
+	return subscriptionTopicTriggerCanFilterByInvariants
+}
+
+func (r SubscriptionTopicTriggerCanFilterBy) MarshalJSON() ([]byte, error) {
+	type alias SubscriptionTopicTriggerCanFilterBy
+	out := struct {
+		alias
+		Comparator        []*SearchComparator       `json:"comparator,omitempty"`
+		ComparatorElement []*Element                `json:"_comparator,omitempty"`
+		Modifier          []*SearchModifierAllCodes `json:"modifier,omitempty"`
+		ModifierElement   []*Element                `json:"_modifier,omitempty"`
+	}{alias: alias(r)}
+	out.Comparator, out.ComparatorElement = alignPrimitiveArray(r.Comparator, r.ComparatorElement)
+	out.Modifier, out.ModifierElement = alignPrimitiveArray(r.Modifier, r.ModifierElement)
+	return json.Marshal(out)
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) UnmarshalJSON(data []byte) error {
+	type alias SubscriptionTopicTriggerCanFilterBy
+	aux := struct {
+		*alias
+		Comparator []*SearchComparator       `json:"comparator,omitempty"`
+		Modifier   []*SearchModifierAllCodes `json:"modifier,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Comparator, err = splitPrimitiveArray(aux.Comparator, r.ComparatorElement); err != nil {
+		return fmt.Errorf("comparator: %w", err)
+	}
+	if r.Modifier, err = splitPrimitiveArray(aux.Modifier, r.ModifierElement); err != nil {
+		return fmt.Errorf("modifier: %w", err)
+	}
 	return nil
 }
 
+func (r SubscriptionTopicTriggerCanFilterBy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionTopicTriggerCanFilterBy) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionTopicTriggerCanFilterByElementTypes = map[string]string{
+	"description":      "markdown",
+	"resource":         "uri",
+	"filterDefinition": "uri",
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) elementTypes() map[string]string {
+	return subscriptionTopicTriggerCanFilterByElementTypes
+}
+
+var subscriptionTopicTriggerCanFilterByElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionTopic.trigger.canFilterBy.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.description", Name: "description", Field: 3, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.resource", Name: "resource", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.filterParameter", Name: "filterParameter", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.filterDefinition", Name: "filterDefinition", Field: 9, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.comparator", Name: "comparator", Field: 11, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionTopic.trigger.canFilterBy.comparator", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-comparator"}},
+	{Path: "SubscriptionTopic.trigger.canFilterBy.modifier", Name: "modifier", Field: 13, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubscriptionTopic.trigger.canFilterBy.modifier", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/search-modifier-all-codes"}},
+}
+
+func (r *SubscriptionTopicTriggerCanFilterBy) ElementMetadata() []ElementMetadata {
+	return subscriptionTopicTriggerCanFilterByElementMetadata
+}
+
 type SubscriptionTopicTriggerNotificationShape struct {
-	Id           *string                                                 `json:"id,omitempty" bson:"id,omitempty"`                      // Unique id for inter-element referencing
-	Resource     string                                                  `json:"resource" bson:"resource"`                              // URL of the key definition that is the focus in a notification shape
-	Include      []string                                                `json:"include,omitempty" bson:"include,omitempty"`            // Include directives, rooted in the resource for this shape
-	RevInclude   []string                                                `json:"revInclude,omitempty" bson:"rev_include,omitempty"`     // Reverse include directives, rooted in the resource for this shape
-	RelatedQuery []SubscriptionTopicTriggerNotificationShapeRelatedQuery `json:"relatedQuery,omitempty" bson:"related_query,omitempty"` // Query describing data relevant to this notification
+	Id                *string                                                 `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                             `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Resource          string                                                  `json:"resource" bson:"resource"`                                        // URL of the key definition that is the focus in a notification shape
+	ResourceElement   *Element                                                `json:"_resource,omitempty" bson:"resource_element,omitempty"`           // Extensions for resource
+	Include           []string                                                `json:"include,omitempty" bson:"include,omitempty"`                      // Include directives, rooted in the resource for this shape
+	IncludeElement    []*Element                                              `json:"_include,omitempty" bson:"include_element,omitempty"`             // Extensions for include
+	RevInclude        []string                                                `json:"revInclude,omitempty" bson:"rev_include,omitempty"`               // Reverse include directives, rooted in the resource for this shape
+	RevIncludeElement []*Element                                              `json:"_revInclude,omitempty" bson:"rev_include_element,omitempty"`      // Extensions for revInclude
+	RelatedQuery      []SubscriptionTopicTriggerNotificationShapeRelatedQuery `json:"relatedQuery,omitempty" bson:"related_query,omitempty"`           // Query describing data relevant to this notification
 }
 
 func (r *SubscriptionTopicTriggerNotificationShape) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Resource == emptyString {
 		return fmt.Errorf("field 'Resource' is required")
 	}
+	if r.ResourceElement != nil {
+		if err := r.ResourceElement.Validate(); err != nil {
+			return fmt.Errorf("ResourceElement: %w", err)
+		}
+	}
+	for i, item := range r.IncludeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("IncludeElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.RevIncludeElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("RevIncludeElement[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.RelatedQuery {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("RelatedQuery[%d]: %w", i, err)
@@ -190,13 +1220,137 @@ func (r *SubscriptionTopicTriggerNotificationShape) Validate() error {
 	return nil
 }
 
+func (r *SubscriptionTopicTriggerNotificationShape) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Resource == emptyString {
+		issues.add("required", path+".resource", "field 'Resource' is required")
+	}
+	if r.ResourceElement != nil {
+		r.ResourceElement.validateAll(path+".resource", issues)
+	}
+	for i, item := range r.IncludeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.include[%d]", path, i), issues)
+	}
+	for i, item := range r.RevIncludeElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.revInclude[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedQuery {
+		item.validateAll(fmt.Sprintf("%s.relatedQuery[%d]", path, i), issues)
+	}
+}
+
+var subscriptionTopicTriggerNotificationShapeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionTopicTriggerNotificationShape) invariants() []invariant {
+	return subscriptionTopicTriggerNotificationShapeInvariants
+}
+
+func (r SubscriptionTopicTriggerNotificationShape) MarshalJSON() ([]byte, error) {
+	type alias SubscriptionTopicTriggerNotificationShape
+	out := struct {
+		alias
+		Include           []*string  `json:"include,omitempty"`
+		IncludeElement    []*Element `json:"_include,omitempty"`
+		RevInclude        []*string  `json:"revInclude,omitempty"`
+		RevIncludeElement []*Element `json:"_revInclude,omitempty"`
+	}{alias: alias(r)}
+	out.Include, out.IncludeElement = alignPrimitiveArray(r.Include, r.IncludeElement)
+	out.RevInclude, out.RevIncludeElement = alignPrimitiveArray(r.RevInclude, r.RevIncludeElement)
+	return json.Marshal(out)
+}
+
+func (r *SubscriptionTopicTriggerNotificationShape) UnmarshalJSON(data []byte) error {
+	type alias SubscriptionTopicTriggerNotificationShape
+	aux := struct {
+		*alias
+		Include    []*string `json:"include,omitempty"`
+		RevInclude []*string `json:"revInclude,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Include, err = splitPrimitiveArray(aux.Include, r.IncludeElement); err != nil {
+		return fmt.Errorf("include: %w", err)
+	}
+	if r.RevInclude, err = splitPrimitiveArray(aux.RevInclude, r.RevIncludeElement); err != nil {
+		return fmt.Errorf("revInclude: %w", err)
+	}
+	return nil
+}
+
+func (r SubscriptionTopicTriggerNotificationShape) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionTopicTriggerNotificationShape) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionTopicTriggerNotificationShape) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionTopicTriggerNotificationShape) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionTopicTriggerNotificationShapeElementTypes = map[string]string{
+	"resource": "uri",
+}
+
+func (r *SubscriptionTopicTriggerNotificationShape) elementTypes() map[string]string {
+	return subscriptionTopicTriggerNotificationShapeElementTypes
+}
+
+var subscriptionTopicTriggerNotificationShapeElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionTopic.trigger.notificationShape.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.trigger.notificationShape.resource", Name: "resource", Field: 3, Min: 1, Max: "1", Types: []string{"uri"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.include", Name: "include", Field: 5, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.revInclude", Name: "revInclude", Field: 7, Min: 0, Max: "*", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.relatedQuery", Name: "relatedQuery", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SubscriptionTopicTriggerNotificationShape) ElementMetadata() []ElementMetadata {
+	return subscriptionTopicTriggerNotificationShapeElementMetadata
+}
+
 type SubscriptionTopicTriggerNotificationShapeRelatedQuery struct {
-	Id        *string `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
-	QueryType *Coding `json:"queryType,omitempty" bson:"query_type,omitempty"` // Coded information describing the type of data this query provides
-	Query     string  `json:"query" bson:"query"`                              // Query to perform
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	QueryType         *Coding     `json:"queryType,omitempty" bson:"query_type,omitempty"`                 // Coded information describing the type of data this query provides
+	Query             string      `json:"query" bson:"query"`                                              // Query to perform
+	QueryElement      *Element    `json:"_query,omitempty" bson:"query_element,omitempty"`                 // Extensions for query
 }
 
 func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.QueryType != nil {
 		if err := r.QueryType.Validate(); err != nil {
 			return fmt.Errorf("QueryType: %w", err)
@@ -206,5 +1360,65 @@ func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) Validate() error
 	if r.Query == emptyString {
 		return fmt.Errorf("field 'Query' is required")
 	}
+	if r.QueryElement != nil {
+		if err := r.QueryElement.Validate(); err != nil {
+			return fmt.Errorf("QueryElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.QueryType != nil {
+		r.QueryType.validateAll(path+".queryType", issues)
+	}
+	var emptyString string
+	if r.Query == emptyString {
+		issues.add("required", path+".query", "field 'Query' is required")
+	}
+	if r.QueryElement != nil {
+		r.QueryElement.validateAll(path+".query", issues)
+	}
+}
+
+var subscriptionTopicTriggerNotificationShapeRelatedQueryInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) invariants() []invariant {
+	return subscriptionTopicTriggerNotificationShapeRelatedQueryInvariants
+}
+
+func (r SubscriptionTopicTriggerNotificationShapeRelatedQuery) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubscriptionTopicTriggerNotificationShapeRelatedQuery) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var subscriptionTopicTriggerNotificationShapeRelatedQueryElementMetadata = []ElementMetadata{
+	{Path: "SubscriptionTopic.trigger.notificationShape.relatedQuery.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.relatedQuery.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.relatedQuery.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubscriptionTopic.trigger.notificationShape.relatedQuery.queryType", Name: "queryType", Field: 3, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "SubscriptionTopic.trigger.notificationShape.relatedQuery.query", Name: "query", Field: 4, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *SubscriptionTopicTriggerNotificationShapeRelatedQuery) ElementMetadata() []ElementMetadata {
+	return subscriptionTopicTriggerNotificationShapeRelatedQueryElementMetadata
+}
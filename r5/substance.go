@@ -2,25 +2,33 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A homogeneous material with a definite composition.
 type Substance struct {
-	ResourceType  string             `json:"resourceType" bson:"resource_type"`                       // Type of resource
-	Id            *string            `json:"id,omitempty" bson:"id,omitempty"`                        // Logical id of this artifact
-	Meta          *Meta              `json:"meta,omitempty" bson:"meta,omitempty"`                    // Metadata about the resource
-	ImplicitRules *string            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"` // A set of rules under which this content was created
-	Language      *string            `json:"language,omitempty" bson:"language,omitempty"`            // Language of the resource content
-	Text          *Narrative         `json:"text,omitempty" bson:"text,omitempty"`                    // Text summary of the resource, for human interpretation
-	Contained     []json.RawMessage  `json:"contained,omitempty" bson:"contained,omitempty"`          // Contained, inline Resources
-	Identifier    []Identifier       `json:"identifier,omitempty" bson:"identifier,omitempty"`        // Unique identifier
-	Status        *string            `json:"status,omitempty" bson:"status,omitempty"`                // active | inactive | entered-in-error
-	Category      []CodeableConcept  `json:"category,omitempty" bson:"category,omitempty"`            // What class/type of substance this is
-	Code          *CodeableReference `json:"code" bson:"code"`                                        // What substance this is
-	Description   *string            `json:"description,omitempty" bson:"description,omitempty"`      // Textual description of the substance, comments
-	Expiry        *string            `json:"expiry,omitempty" bson:"expiry,omitempty"`                // When no longer valid to use
-	Quantity      *Quantity          `json:"quantity,omitempty" bson:"quantity,omitempty"`            // Amount of substance in the package
+	ResourceType         string               `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string              `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string              `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element             `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string              `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element             `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative           `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource           `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension          `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension          `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier         `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Unique identifier
+	Status               *FHIRSubstanceStatus `json:"status,omitempty" bson:"status,omitempty"`                         // active | inactive | entered-in-error
+	StatusElement        *Element             `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Category             []CodeableConcept    `json:"category,omitempty" bson:"category,omitempty"`                     // What class/type of substance this is
+	Code                 *CodeableReference   `json:"code" bson:"code"`                                                 // What substance this is
+	Description          *string              `json:"description,omitempty" bson:"description,omitempty"`               // Textual description of the substance, comments
+	DescriptionElement   *Element             `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Expiry               *DateTime            `json:"expiry,omitempty" bson:"expiry,omitempty"`                         // When no longer valid to use
+	ExpiryElement        *Element             `json:"_expiry,omitempty" bson:"expiry_element,omitempty"`                // Extensions for expiry
+	Quantity             *Quantity            `json:"quantity,omitempty" bson:"quantity,omitempty"`                     // Amount of substance in the package
 }
 
 func (r *Substance) Validate() error {
@@ -32,16 +40,52 @@ func (r *Substance) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.Status != nil && !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", *r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.Category {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Category[%d]: %w", i, err)
@@ -55,10 +99,216 @@ func (r *Substance) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.Expiry != nil {
+		if err := r.Expiry.Validate(); err != nil {
+			return fmt.Errorf("Expiry: %w", err)
+		}
+	}
+	if r.ExpiryElement != nil {
+		if err := r.ExpiryElement.Validate(); err != nil {
+			return fmt.Errorf("ExpiryElement: %w", err)
+		}
+	}
 	if r.Quantity != nil {
 		if err := r.Quantity.Validate(); err != nil {
 			return fmt.Errorf("Quantity: %w", err)
 		}
 	}
+	return validateInvariants(r, "Substance")
+}
+
+func (r *Substance) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Substance" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Substance', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.Status != nil && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", *r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	for i, item := range r.Category {
+		item.validateAll(fmt.Sprintf("%s.category[%d]", path, i), issues)
+	}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.Expiry != nil {
+		if err := r.Expiry.Validate(); err != nil {
+			issues.add("value", path+".expiry", err.Error())
+		}
+	}
+	if r.ExpiryElement != nil {
+		r.ExpiryElement.validateAll(path+".expiry", issues)
+	}
+	if r.Quantity != nil {
+		r.Quantity.validateAll(path+".quantity", issues)
+	}
+}
+
+func (r *Substance) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Substance", &issues)
+	checkInvariants(r, "Substance", &issues)
+	return issues
+}
+
+var substanceInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Substance) invariants() []invariant {
+	return substanceInvariants
+}
+
+func (r *Substance) UnmarshalJSON(data []byte) error {
+	type alias Substance
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
+
+func (r Substance) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Substance) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Substance) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Substance) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *Substance) elementTypes() map[string]string {
+	return substanceElementTypes
+}
+
+var substanceElementMetadata = []ElementMetadata{
+	{Path: "Substance.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Substance.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Substance.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Substance.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Substance.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Substance.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Substance.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Substance.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Substance.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Substance.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Substance.status", Name: "status", Field: 12, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Substance.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/substance-status"}},
+	{Path: "Substance.category", Name: "category", Field: 14, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "Substance.code", Name: "code", Field: 15, Min: 1, Max: "1", Types: []string{"CodeableReference"}},
+	{Path: "Substance.description", Name: "description", Field: 16, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Substance.expiry", Name: "expiry", Field: 18, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Substance.quantity", Name: "quantity", Field: 20, Min: 0, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *Substance) ElementMetadata() []ElementMetadata {
+	return substanceElementMetadata
+}
+
+func (r *Substance) GetResourceType() string {
+	return "Substance"
+}
+
+func (r *Substance) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *Substance) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *Substance) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Substance) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Substance) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Substance) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Substance) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Substance)(nil)
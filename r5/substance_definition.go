@@ -2,37 +2,44 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // The detailed description of a substance, typically at a level beyond what is used for prescribing.
 type SubstanceDefinition struct {
-	ResourceType     string                                `json:"resourceType" bson:"resource_type"`                            // Type of resource
-	Id               *string                               `json:"id,omitempty" bson:"id,omitempty"`                             // Logical id of this artifact
-	Meta             *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                         // Metadata about the resource
-	ImplicitRules    *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`      // A set of rules under which this content was created
-	Language         *string                               `json:"language,omitempty" bson:"language,omitempty"`                 // Language of the resource content
-	Text             *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                         // Text summary of the resource, for human interpretation
-	Contained        []json.RawMessage                     `json:"contained,omitempty" bson:"contained,omitempty"`               // Contained, inline Resources
-	Identifier       []Identifier                          `json:"identifier,omitempty" bson:"identifier,omitempty"`             // Identifier by which this substance is known
-	Version          *string                               `json:"version,omitempty" bson:"version,omitempty"`                   // A business level edition or revision identifier
-	Status           *CodeableConcept                      `json:"status,omitempty" bson:"status,omitempty"`                     // Status of substance within the catalogue e.g. active, retired
-	Classification   []CodeableConcept                     `json:"classification,omitempty" bson:"classification,omitempty"`     // A categorization, high level e.g. polymer or nucleic acid, or food, chemical, biological, or lower e.g. polymer linear or branch chain, or type of impurity
-	Domain           *CodeableConcept                      `json:"domain,omitempty" bson:"domain,omitempty"`                     // The applicable usage of the substance, as an example human or veterinary
-	Grade            []CodeableConcept                     `json:"grade,omitempty" bson:"grade,omitempty"`                       // The quality standard, established benchmark, to which substance complies (e.g. USP/NF, BP)
-	Description      *string                               `json:"description,omitempty" bson:"description,omitempty"`           // Textual description of the substance
-	Note             []Annotation                          `json:"note,omitempty" bson:"note,omitempty"`                         // Textual comment about the substance's catalogue or registry record
-	Manufacturer     []Reference                           `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`         // The entity that creates, makes, produces or fabricates the substance
-	Supplier         []Reference                           `json:"supplier,omitempty" bson:"supplier,omitempty"`                 // An entity that is the source for the substance. It may be different from the manufacturer
-	Moiety           []SubstanceDefinitionMoiety           `json:"moiety,omitempty" bson:"moiety,omitempty"`                     // Moiety, for structural modifications
-	Characterization []SubstanceDefinitionCharacterization `json:"characterization,omitempty" bson:"characterization,omitempty"` // General specifications for this substance
-	Property         []SubstanceDefinitionProperty         `json:"property,omitempty" bson:"property,omitempty"`                 // General specifications for this substance
-	MolecularWeight  []SubstanceDefinitionMolecularWeight  `json:"molecularWeight,omitempty" bson:"molecular_weight,omitempty"`  // The average mass of a molecule of a compound
-	Structure        *SubstanceDefinitionStructure         `json:"structure,omitempty" bson:"structure,omitempty"`               // Structural information
-	Code             []SubstanceDefinitionCode             `json:"code,omitempty" bson:"code,omitempty"`                         // Codes associated with the substance
-	Name             []SubstanceDefinitionName             `json:"name,omitempty" bson:"name,omitempty"`                         // Names applicable to this substance
-	Relationship     []SubstanceDefinitionRelationship     `json:"relationship,omitempty" bson:"relationship,omitempty"`         // A link between this substance and another
-	SourceMaterial   *SubstanceDefinitionSourceMaterial    `json:"sourceMaterial,omitempty" bson:"source_material,omitempty"`    // Material or taxonomic/anatomical source
+	ResourceType         string                                `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                               `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                               `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                          `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Identifier by which this substance is known
+	Version              *string                               `json:"version,omitempty" bson:"version,omitempty"`                       // A business level edition or revision identifier
+	VersionElement       *Element                              `json:"_version,omitempty" bson:"version_element,omitempty"`              // Extensions for version
+	Status               *CodeableConcept                      `json:"status,omitempty" bson:"status,omitempty"`                         // Status of substance within the catalogue e.g. active, retired
+	Classification       []CodeableConcept                     `json:"classification,omitempty" bson:"classification,omitempty"`         // A categorization, high level e.g. polymer or nucleic acid, or food, chemical, biological, or lower e.g. polymer linear or branch chain, or type of impurity
+	Domain               *CodeableConcept                      `json:"domain,omitempty" bson:"domain,omitempty"`                         // The applicable usage of the substance, as an example human or veterinary
+	Grade                []CodeableConcept                     `json:"grade,omitempty" bson:"grade,omitempty"`                           // The quality standard, established benchmark, to which substance complies (e.g. USP/NF, BP)
+	Description          *string                               `json:"description,omitempty" bson:"description,omitempty"`               // Textual description of the substance
+	DescriptionElement   *Element                              `json:"_description,omitempty" bson:"description_element,omitempty"`      // Extensions for description
+	Note                 []Annotation                          `json:"note,omitempty" bson:"note,omitempty"`                             // Textual comment about the substance's catalogue or registry record
+	Manufacturer         []Reference                           `json:"manufacturer,omitempty" bson:"manufacturer,omitempty"`             // The entity that creates, makes, produces or fabricates the substance
+	Supplier             []Reference                           `json:"supplier,omitempty" bson:"supplier,omitempty"`                     // An entity that is the source for the substance. It may be different from the manufacturer
+	Moiety               []SubstanceDefinitionMoiety           `json:"moiety,omitempty" bson:"moiety,omitempty"`                         // Moiety, for structural modifications
+	Characterization     []SubstanceDefinitionCharacterization `json:"characterization,omitempty" bson:"characterization,omitempty"`     // General specifications for this substance
+	Property             []SubstanceDefinitionProperty         `json:"property,omitempty" bson:"property,omitempty"`                     // General specifications for this substance
+	MolecularWeight      []SubstanceDefinitionMolecularWeight  `json:"molecularWeight,omitempty" bson:"molecular_weight,omitempty"`      // The average mass of a molecule of a compound
+	Structure            *SubstanceDefinitionStructure         `json:"structure,omitempty" bson:"structure,omitempty"`                   // Structural information
+	Code                 []SubstanceDefinitionCode             `json:"code,omitempty" bson:"code,omitempty"`                             // Codes associated with the substance
+	Name                 []SubstanceDefinitionName             `json:"name,omitempty" bson:"name,omitempty"`                             // Names applicable to this substance
+	Relationship         []SubstanceDefinitionRelationship     `json:"relationship,omitempty" bson:"relationship,omitempty"`             // A link between this substance and another
+	SourceMaterial       *SubstanceDefinitionSourceMaterial    `json:"sourceMaterial,omitempty" bson:"source_material,omitempty"`        // Material or taxonomic/anatomical source
 }
 
 func (r *SubstanceDefinition) Validate() error {
@@ -44,16 +51,49 @@ func (r *SubstanceDefinition) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
 	if r.Status != nil {
 		if err := r.Status.Validate(); err != nil {
 			return fmt.Errorf("Status: %w", err)
@@ -74,6 +114,11 @@ func (r *SubstanceDefinition) Validate() error {
 			return fmt.Errorf("Grade[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -134,372 +179,1687 @@ func (r *SubstanceDefinition) Validate() error {
 			return fmt.Errorf("SourceMaterial: %w", err)
 		}
 	}
-	return nil
-}
-
-type SubstanceDefinitionName struct {
-	Id           *string                           `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Name         string                            `json:"name" bson:"name"`                                     // The actual name
-	Type         *CodeableConcept                  `json:"type,omitempty" bson:"type,omitempty"`                 // Name type e.g. 'systematic',  'scientific, 'brand'
-	Status       *CodeableConcept                  `json:"status,omitempty" bson:"status,omitempty"`             // The status of the name e.g. 'current', 'proposed'
-	Preferred    *bool                             `json:"preferred,omitempty" bson:"preferred,omitempty"`       // If this is the preferred name for this substance
-	Language     []CodeableConcept                 `json:"language,omitempty" bson:"language,omitempty"`         // Human language that the name is written in
-	Domain       []CodeableConcept                 `json:"domain,omitempty" bson:"domain,omitempty"`             // The use context of this name e.g. as an active ingredient or as a food colour additive
-	Jurisdiction []CodeableConcept                 `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"` // The jurisdiction where this name applies
-	Synonym      []SubstanceDefinitionName         `json:"synonym,omitempty" bson:"synonym,omitempty"`           // A synonym of this particular name, by which the substance is also known
-	Translation  []SubstanceDefinitionName         `json:"translation,omitempty" bson:"translation,omitempty"`   // A translation for this name into another human language
-	Official     []SubstanceDefinitionNameOfficial `json:"official,omitempty" bson:"official,omitempty"`         // Details of the official nature of this name
-	Source       []Reference                       `json:"source,omitempty" bson:"source,omitempty"`             // Supporting literature
+	return validateInvariants(r, "SubstanceDefinition")
 }
 
-func (r *SubstanceDefinitionName) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+func (r *SubstanceDefinition) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "SubstanceDefinition" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'SubstanceDefinition', got '%s'", r.ResourceType))
 	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
 	if r.Status != nil {
-		if err := r.Status.Validate(); err != nil {
-			return fmt.Errorf("Status: %w", err)
-		}
+		r.Status.validateAll(path+".status", issues)
 	}
-	for i, item := range r.Language {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Language[%d]: %w", i, err)
-		}
+	for i, item := range r.Classification {
+		item.validateAll(fmt.Sprintf("%s.classification[%d]", path, i), issues)
 	}
-	for i, item := range r.Domain {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Domain[%d]: %w", i, err)
-		}
+	if r.Domain != nil {
+		r.Domain.validateAll(path+".domain", issues)
 	}
-	for i, item := range r.Jurisdiction {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
-		}
+	for i, item := range r.Grade {
+		item.validateAll(fmt.Sprintf("%s.grade[%d]", path, i), issues)
 	}
-	for i, item := range r.Synonym {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Synonym[%d]: %w", i, err)
-		}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	for i, item := range r.Translation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Translation[%d]: %w", i, err)
-		}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
 	}
-	for i, item := range r.Official {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Official[%d]: %w", i, err)
-		}
+	for i, item := range r.Manufacturer {
+		item.validateAll(fmt.Sprintf("%s.manufacturer[%d]", path, i), issues)
 	}
-	for i, item := range r.Source {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Source[%d]: %w", i, err)
-		}
+	for i, item := range r.Supplier {
+		item.validateAll(fmt.Sprintf("%s.supplier[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type SubstanceDefinitionSourceMaterial struct {
-	Id              *string           `json:"id,omitempty" bson:"id,omitempty"`                             // Unique id for inter-element referencing
-	Type            *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                         // Classification of the origin of the raw material. e.g. cat hair is an Animal source type
-	Genus           *CodeableConcept  `json:"genus,omitempty" bson:"genus,omitempty"`                       // The genus of an organism e.g. the Latin epithet of the plant/animal scientific name
-	Species         *CodeableConcept  `json:"species,omitempty" bson:"species,omitempty"`                   // The species of an organism e.g. the Latin epithet of the species of the plant/animal
-	Part            *CodeableConcept  `json:"part,omitempty" bson:"part,omitempty"`                         // An anatomical origin of the source material within an organism
-	CountryOfOrigin []CodeableConcept `json:"countryOfOrigin,omitempty" bson:"country_of_origin,omitempty"` // The country or countries where the material is harvested
-}
-
-func (r *SubstanceDefinitionSourceMaterial) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
+	for i, item := range r.Moiety {
+		item.validateAll(fmt.Sprintf("%s.moiety[%d]", path, i), issues)
 	}
-	if r.Genus != nil {
-		if err := r.Genus.Validate(); err != nil {
-			return fmt.Errorf("Genus: %w", err)
-		}
+	for i, item := range r.Characterization {
+		item.validateAll(fmt.Sprintf("%s.characterization[%d]", path, i), issues)
 	}
-	if r.Species != nil {
-		if err := r.Species.Validate(); err != nil {
-			return fmt.Errorf("Species: %w", err)
-		}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
 	}
-	if r.Part != nil {
-		if err := r.Part.Validate(); err != nil {
-			return fmt.Errorf("Part: %w", err)
-		}
+	for i, item := range r.MolecularWeight {
+		item.validateAll(fmt.Sprintf("%s.molecularWeight[%d]", path, i), issues)
 	}
-	for i, item := range r.CountryOfOrigin {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("CountryOfOrigin[%d]: %w", i, err)
-		}
+	if r.Structure != nil {
+		r.Structure.validateAll(path+".structure", issues)
+	}
+	for i, item := range r.Code {
+		item.validateAll(fmt.Sprintf("%s.code[%d]", path, i), issues)
+	}
+	for i, item := range r.Name {
+		item.validateAll(fmt.Sprintf("%s.name[%d]", path, i), issues)
+	}
+	for i, item := range r.Relationship {
+		item.validateAll(fmt.Sprintf("%s.relationship[%d]", path, i), issues)
+	}
+	if r.SourceMaterial != nil {
+		r.SourceMaterial.validateAll(path+".sourceMaterial", issues)
 	}
-	return nil
 }
 
-type SubstanceDefinitionCharacterization struct {
-	Id          *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Technique   *CodeableConcept `json:"technique,omitempty" bson:"technique,omitempty"`     // The method used to find the characterization e.g. HPLC
-	Form        *CodeableConcept `json:"form,omitempty" bson:"form,omitempty"`               // Describes the nature of the chemical entity and explains, for instance, whether this is a base or a salt form
-	Description *string          `json:"description,omitempty" bson:"description,omitempty"` // The description or justification in support of the interpretation of the data file
-	File        []Attachment     `json:"file,omitempty" bson:"file,omitempty"`               // The data produced by the analytical instrument or a pictorial representation of that data. Examples: a JCAMP, JDX, or ADX file, or a chromatogram or spectrum analysis
+func (r *SubstanceDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("SubstanceDefinition", &issues)
+	checkInvariants(r, "SubstanceDefinition", &issues)
+	return issues
 }
 
-func (r *SubstanceDefinitionCharacterization) Validate() error {
-	if r.Technique != nil {
-		if err := r.Technique.Validate(); err != nil {
-			return fmt.Errorf("Technique: %w", err)
-		}
-	}
-	if r.Form != nil {
-		if err := r.Form.Validate(); err != nil {
-			return fmt.Errorf("Form: %w", err)
-		}
-	}
-	for i, item := range r.File {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("File[%d]: %w", i, err)
-		}
-	}
-	return nil
+var substanceDefinitionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
 }
 
-type SubstanceDefinitionMolecularWeight struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Method *CodeableConcept `json:"method,omitempty" bson:"method,omitempty"` // The method by which the weight was determined
-	Type   *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`     // Type of molecular weight e.g. exact, average, weight average
-	Amount *Quantity        `json:"amount" bson:"amount"`                     // Used to capture quantitative values for a variety of elements
+func (r *SubstanceDefinition) invariants() []invariant {
+	return substanceDefinitionInvariants
 }
 
-func (r *SubstanceDefinitionMolecularWeight) Validate() error {
-	if r.Method != nil {
-		if err := r.Method.Validate(); err != nil {
-			return fmt.Errorf("Method: %w", err)
-		}
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.Amount == nil {
-		return fmt.Errorf("field 'Amount' is required")
-	}
-	if r.Amount != nil {
-		if err := r.Amount.Validate(); err != nil {
-			return fmt.Errorf("Amount: %w", err)
-		}
+func (r *SubstanceDefinition) UnmarshalJSON(data []byte) error {
+	type alias SubstanceDefinition
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
 	}
 	return nil
 }
 
-type SubstanceDefinitionStructureRepresentation struct {
-	Id             *string          `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Type           *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                     // The kind of structural representation (e.g. full, partial)
-	Representation *string          `json:"representation,omitempty" bson:"representation,omitempty"` // The structural representation as a text string in a standard format
-	Format         *CodeableConcept `json:"format,omitempty" bson:"format,omitempty"`                 // The format of the representation e.g. InChI, SMILES, MOLFILE (note: not the physical file format)
-	Document       *Reference       `json:"document,omitempty" bson:"document,omitempty"`             // An attachment with the structural representation e.g. a structure graphic or AnIML file
+func (r SubstanceDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *SubstanceDefinitionStructureRepresentation) Validate() error {
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.Format != nil {
-		if err := r.Format.Validate(); err != nil {
-			return fmt.Errorf("Format: %w", err)
-		}
-	}
-	if r.Document != nil {
-		if err := r.Document.Validate(); err != nil {
-			return fmt.Errorf("Document: %w", err)
-		}
-	}
-	return nil
+func (r *SubstanceDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type SubstanceDefinitionCode struct {
-	Id         *string          `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
-	Code       *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`              // The specific code
-	Status     *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`          // Status of the code assignment, for example 'provisional', 'approved'
-	StatusDate *string          `json:"statusDate,omitempty" bson:"status_date,omitempty"` // The date at which the code status was changed
-	Note       []Annotation     `json:"note,omitempty" bson:"note,omitempty"`              // Any comment can be provided in this field
-	Source     []Reference      `json:"source,omitempty" bson:"source,omitempty"`          // Supporting literature
+func (r SubstanceDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *SubstanceDefinitionCode) Validate() error {
-	if r.Code != nil {
-		if err := r.Code.Validate(); err != nil {
-			return fmt.Errorf("Code: %w", err)
-		}
-	}
-	if r.Status != nil {
-		if err := r.Status.Validate(); err != nil {
-			return fmt.Errorf("Status: %w", err)
-		}
-	}
-	for i, item := range r.Note {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Note[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.Source {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Source[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *SubstanceDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
 }
 
-type SubstanceDefinitionNameOfficial struct {
-	Id        *string          `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	Authority *CodeableConcept `json:"authority,omitempty" bson:"authority,omitempty"` // Which authority uses this official name
-	Status    *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`       // The status of the official name, for example 'draft', 'active'
-	Date      *string          `json:"date,omitempty" bson:"date,omitempty"`           // Date of official name change
+var substanceDefinitionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
 }
 
-func (r *SubstanceDefinitionNameOfficial) Validate() error {
-	if r.Authority != nil {
-		if err := r.Authority.Validate(); err != nil {
-			return fmt.Errorf("Authority: %w", err)
-		}
+func (r *SubstanceDefinition) elementTypes() map[string]string {
+	return substanceDefinitionElementTypes
+}
+
+var substanceDefinitionElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "SubstanceDefinition.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "SubstanceDefinition.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "SubstanceDefinition.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "SubstanceDefinition.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "SubstanceDefinition.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "SubstanceDefinition.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "SubstanceDefinition.version", Name: "version", Field: 12, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.status", Name: "status", Field: 14, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.classification", Name: "classification", Field: 15, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.domain", Name: "domain", Field: 16, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.grade", Name: "grade", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.description", Name: "description", Field: 18, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubstanceDefinition.note", Name: "note", Field: 20, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "SubstanceDefinition.manufacturer", Name: "manufacturer", Field: 21, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "SubstanceDefinition.supplier", Name: "supplier", Field: 22, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "SubstanceDefinition.moiety", Name: "moiety", Field: 23, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.characterization", Name: "characterization", Field: 24, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.property", Name: "property", Field: 25, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.molecularWeight", Name: "molecularWeight", Field: 26, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.structure", Name: "structure", Field: 27, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.code", Name: "code", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.name", Name: "name", Field: 29, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.relationship", Name: "relationship", Field: 30, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.sourceMaterial", Name: "sourceMaterial", Field: 31, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *SubstanceDefinition) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionElementMetadata
+}
+
+func (r *SubstanceDefinition) GetResourceType() string {
+	return "SubstanceDefinition"
+}
+
+func (r *SubstanceDefinition) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.Status != nil {
-		if err := r.Status.Validate(); err != nil {
-			return fmt.Errorf("Status: %w", err)
-		}
+	return *r.Id
+}
+
+func (r *SubstanceDefinition) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	return nil
+	r.Id = &id
 }
 
-type SubstanceDefinitionRelationship struct {
-	Id                                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                                                    // Unique id for inter-element referencing
-	SubstanceDefinitionReference       *Reference       `json:"substanceDefinitionReference,omitempty" bson:"substance_definition_reference,omitempty"`              // A pointer to another substance, as a resource or a representational code
-	SubstanceDefinitionCodeableConcept *CodeableConcept `json:"substanceDefinitionCodeableConcept,omitempty" bson:"substance_definition_codeable_concept,omitempty"` // A pointer to another substance, as a resource or a representational code
-	Type                               *CodeableConcept `json:"type" bson:"type"`                                                                                    // For example "salt to parent", "active moiety"
-	IsDefining                         *bool            `json:"isDefining,omitempty" bson:"is_defining,omitempty"`                                                   // For example where an enzyme strongly bonds with a particular substance, this is a defining relationship for that enzyme, out of several possible relationships
-	AmountQuantity                     *Quantity        `json:"amountQuantity,omitempty" bson:"amount_quantity,omitempty"`                                           // A numeric factor for the relationship, e.g. that a substance salt has some percentage of active substance in relation to some other
-	AmountRatio                        *Ratio           `json:"amountRatio,omitempty" bson:"amount_ratio,omitempty"`                                                 // A numeric factor for the relationship, e.g. that a substance salt has some percentage of active substance in relation to some other
-	AmountString                       *string          `json:"amountString,omitempty" bson:"amount_string,omitempty"`                                               // A numeric factor for the relationship, e.g. that a substance salt has some percentage of active substance in relation to some other
-	RatioHighLimitAmount               *Ratio           `json:"ratioHighLimitAmount,omitempty" bson:"ratio_high_limit_amount,omitempty"`                             // For use when the numeric has an uncertain range
-	Comparator                         *CodeableConcept `json:"comparator,omitempty" bson:"comparator,omitempty"`                                                    // An operator for the amount, for example "average", "approximately", "less than"
-	Source                             []Reference      `json:"source,omitempty" bson:"source,omitempty"`                                                            // Supporting literature
+func (r *SubstanceDefinition) GetMeta() *Meta {
+	return r.Meta
 }
 
-func (r *SubstanceDefinitionRelationship) Validate() error {
-	if r.SubstanceDefinitionReference != nil {
-		if err := r.SubstanceDefinitionReference.Validate(); err != nil {
-			return fmt.Errorf("SubstanceDefinitionReference: %w", err)
+func (r *SubstanceDefinition) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *SubstanceDefinition) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *SubstanceDefinition) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *SubstanceDefinition) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*SubstanceDefinition)(nil)
+
+type SubstanceDefinitionMoiety struct {
+	Id                      *string                         `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
+	Extension               []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                         // Additional content defined by implementations
+	ModifierExtension       []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`        // Extensions that cannot be ignored even if unrecognized
+	Role                    *CodeableConcept                `json:"role,omitempty" bson:"role,omitempty"`                                   // Role that the moiety is playing
+	Identifier              *Identifier                     `json:"identifier,omitempty" bson:"identifier,omitempty"`                       // Identifier by which this moiety substance is known
+	Name                    *string                         `json:"name,omitempty" bson:"name,omitempty"`                                   // Textual name for this moiety substance
+	NameElement             *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                          // Extensions for name
+	Stereochemistry         *CodeableConcept                `json:"stereochemistry,omitempty" bson:"stereochemistry,omitempty"`             // Stereochemistry type
+	OpticalActivity         *CodeableConcept                `json:"opticalActivity,omitempty" bson:"optical_activity,omitempty"`            // Optical activity type
+	MolecularFormula        *string                         `json:"molecularFormula,omitempty" bson:"molecular_formula,omitempty"`          // Molecular formula for this moiety (e.g. with the Hill system)
+	MolecularFormulaElement *Element                        `json:"_molecularFormula,omitempty" bson:"molecular_formula_element,omitempty"` // Extensions for molecularFormula
+	Amount                  SubstanceDefinitionMoietyAmount `json:"-" bson:"amount,omitempty"`                                              // Quantitative value for this moiety
+	AmountElement           *Element                        `json:"-" bson:"amount_element,omitempty"`                                      // Extensions for amount[x]
+	MeasurementType         *CodeableConcept                `json:"measurementType,omitempty" bson:"measurement_type,omitempty"`            // The measurement type of the quantitative value
+
+	amountVariants []string // JSON properties of SubstanceDefinition.moiety.amount[x] when more than one was decoded
+}
+
+func (r *SubstanceDefinitionMoiety) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.SubstanceDefinitionCodeableConcept != nil {
-		if err := r.SubstanceDefinitionCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("SubstanceDefinitionCodeableConcept: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
+	if r.Role != nil {
+		if err := r.Role.Validate(); err != nil {
+			return fmt.Errorf("Role: %w", err)
 		}
 	}
-	if r.AmountQuantity != nil {
-		if err := r.AmountQuantity.Validate(); err != nil {
-			return fmt.Errorf("AmountQuantity: %w", err)
+	if r.Identifier != nil {
+		if err := r.Identifier.Validate(); err != nil {
+			return fmt.Errorf("Identifier: %w", err)
 		}
 	}
-	if r.AmountRatio != nil {
-		if err := r.AmountRatio.Validate(); err != nil {
-			return fmt.Errorf("AmountRatio: %w", err)
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
 		}
 	}
-	if r.RatioHighLimitAmount != nil {
-		if err := r.RatioHighLimitAmount.Validate(); err != nil {
-			return fmt.Errorf("RatioHighLimitAmount: %w", err)
+	if r.Stereochemistry != nil {
+		if err := r.Stereochemistry.Validate(); err != nil {
+			return fmt.Errorf("Stereochemistry: %w", err)
 		}
 	}
-	if r.Comparator != nil {
-		if err := r.Comparator.Validate(); err != nil {
-			return fmt.Errorf("Comparator: %w", err)
+	if r.OpticalActivity != nil {
+		if err := r.OpticalActivity.Validate(); err != nil {
+			return fmt.Errorf("OpticalActivity: %w", err)
+		}
+	}
+	if r.MolecularFormulaElement != nil {
+		if err := r.MolecularFormulaElement.Validate(); err != nil {
+			return fmt.Errorf("MolecularFormulaElement: %w", err)
+		}
+	}
+	if len(r.amountVariants) > 1 {
+		return fmt.Errorf("field 'Amount' must have a single type, got %v", r.amountVariants)
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	if r.AmountElement != nil {
+		if err := r.AmountElement.Validate(); err != nil {
+			return fmt.Errorf("AmountElement: %w", err)
+		}
+	}
+	if r.MeasurementType != nil {
+		if err := r.MeasurementType.Validate(); err != nil {
+			return fmt.Errorf("MeasurementType: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionMoiety) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Role != nil {
+		r.Role.validateAll(path+".role", issues)
+	}
+	if r.Identifier != nil {
+		r.Identifier.validateAll(path+".identifier", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Stereochemistry != nil {
+		r.Stereochemistry.validateAll(path+".stereochemistry", issues)
+	}
+	if r.OpticalActivity != nil {
+		r.OpticalActivity.validateAll(path+".opticalActivity", issues)
+	}
+	if r.MolecularFormulaElement != nil {
+		r.MolecularFormulaElement.validateAll(path+".molecularFormula", issues)
+	}
+	if len(r.amountVariants) > 1 {
+		issues.add("structure", path+".amount", fmt.Sprintf("field 'Amount' must have a single type, got %v", r.amountVariants))
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount.ofType("+r.Amount.FHIRType()+")", issues)
+	}
+	if r.AmountElement != nil {
+		r.AmountElement.validateAll(path+".amount", issues)
+	}
+	if r.MeasurementType != nil {
+		r.MeasurementType.validateAll(path+".measurementType", issues)
+	}
+}
+
+var substanceDefinitionMoietyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionMoiety) invariants() []invariant {
+	return substanceDefinitionMoietyInvariants
+}
+
+func (r SubstanceDefinitionMoiety) MarshalJSON() ([]byte, error) {
+	type alias SubstanceDefinitionMoiety
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "amount", r.Amount, r.AmountElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SubstanceDefinitionMoiety) UnmarshalJSON(data []byte) error {
+	type alias SubstanceDefinitionMoiety
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Amount, element, r.amountVariants, err = unmarshalChoice(fields, "amount", substanceDefinitionMoietyAmountVariants); err != nil {
+		return err
+	}
+	r.AmountElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AmountElement); err != nil {
+			return fmt.Errorf("_amount: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r SubstanceDefinitionMoiety) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionMoiety) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionMoiety) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionMoiety) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SubstanceDefinitionMoiety) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Amount":
+		return "amount", choiceValues(substanceDefinitionMoietyAmountVariants)
+	}
+	return "", nil
+}
+
+var substanceDefinitionMoietyElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.moiety.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.moiety.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.moiety.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.moiety.role", Name: "role", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.moiety.identifier", Name: "identifier", Field: 4, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "SubstanceDefinition.moiety.name", Name: "name", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.moiety.stereochemistry", Name: "stereochemistry", Field: 7, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.moiety.opticalActivity", Name: "opticalActivity", Field: 8, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.moiety.molecularFormula", Name: "molecularFormula", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.moiety.amount[x]", Name: "amount", Field: 11, Min: 0, Max: "1", Types: []string{"Quantity", "string"}},
+	{Path: "SubstanceDefinition.moiety.measurementType", Name: "measurementType", Field: 13, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+}
+
+func (r *SubstanceDefinitionMoiety) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionMoietyElementMetadata
+}
+
+// SubstanceDefinitionMoietyAmount is the type of SubstanceDefinition.moiety.amount[x]. It is implemented by the SubstanceDefinitionMoietyAmount* variant types.
+type SubstanceDefinitionMoietyAmount interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSubstanceDefinitionMoietyAmount()
+}
+
+// SubstanceDefinitionMoietyAmountQuantity is the Quantity variant of SubstanceDefinitionMoietyAmount.
+type SubstanceDefinitionMoietyAmountQuantity struct {
+	Quantity
+}
+
+func (v SubstanceDefinitionMoietyAmountQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v SubstanceDefinitionMoietyAmountQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionMoietyAmountQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v SubstanceDefinitionMoietyAmountQuantity) isSubstanceDefinitionMoietyAmount() {}
+
+// SubstanceDefinitionMoietyAmountString is the string variant of SubstanceDefinitionMoietyAmount.
+type SubstanceDefinitionMoietyAmountString string
+
+func (v SubstanceDefinitionMoietyAmountString) Validate() error {
+	return nil
+}
+
+func (v SubstanceDefinitionMoietyAmountString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SubstanceDefinitionMoietyAmountString) FHIRType() string {
+	return "string"
+}
+
+func (v SubstanceDefinitionMoietyAmountString) isSubstanceDefinitionMoietyAmount() {}
+
+var substanceDefinitionMoietyAmountVariants = []SubstanceDefinitionMoietyAmount{
+	SubstanceDefinitionMoietyAmountQuantity{},
+	SubstanceDefinitionMoietyAmountString(""),
+}
+
+type SubstanceDefinitionCharacterization struct {
+	Id                 *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Technique          *CodeableConcept `json:"technique,omitempty" bson:"technique,omitempty"`                  // The method used to find the characterization e.g. HPLC
+	Form               *CodeableConcept `json:"form,omitempty" bson:"form,omitempty"`                            // Describes the nature of the chemical entity and explains, for instance, whether this is a base or a salt form
+	Description        *string          `json:"description,omitempty" bson:"description,omitempty"`              // The description or justification in support of the interpretation of the data file
+	DescriptionElement *Element         `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	File               []Attachment     `json:"file,omitempty" bson:"file,omitempty"`                            // The data produced by the analytical instrument or a pictorial representation of that data. Examples: a JCAMP, JDX, or ADX file, or a chromatogram or spectrum analysis
+}
+
+func (r *SubstanceDefinitionCharacterization) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Technique != nil {
+		if err := r.Technique.Validate(); err != nil {
+			return fmt.Errorf("Technique: %w", err)
+		}
+	}
+	if r.Form != nil {
+		if err := r.Form.Validate(); err != nil {
+			return fmt.Errorf("Form: %w", err)
+		}
+	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	for i, item := range r.File {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("File[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionCharacterization) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Technique != nil {
+		r.Technique.validateAll(path+".technique", issues)
+	}
+	if r.Form != nil {
+		r.Form.validateAll(path+".form", issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.File {
+		item.validateAll(fmt.Sprintf("%s.file[%d]", path, i), issues)
+	}
+}
+
+var substanceDefinitionCharacterizationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionCharacterization) invariants() []invariant {
+	return substanceDefinitionCharacterizationInvariants
+}
+
+func (r SubstanceDefinitionCharacterization) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionCharacterization) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionCharacterization) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionCharacterization) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionCharacterizationElementTypes = map[string]string{
+	"description": "markdown",
+}
+
+func (r *SubstanceDefinitionCharacterization) elementTypes() map[string]string {
+	return substanceDefinitionCharacterizationElementTypes
+}
+
+var substanceDefinitionCharacterizationElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.characterization.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.characterization.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.characterization.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.characterization.technique", Name: "technique", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.characterization.form", Name: "form", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.characterization.description", Name: "description", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "SubstanceDefinition.characterization.file", Name: "file", Field: 7, Min: 0, Max: "*", Types: []string{"Attachment"}},
+}
+
+func (r *SubstanceDefinitionCharacterization) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionCharacterizationElementMetadata
+}
+
+type SubstanceDefinitionProperty struct {
+	Id                *string                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept                 `json:"type" bson:"type"`                                                // A code expressing the type of property
+	Value             SubstanceDefinitionPropertyValue `json:"-" bson:"value,omitempty"`                                        // A value for the property
+	ValueElement      *Element                         `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of SubstanceDefinition.property.value[x] when more than one was decoded
+}
+
+func (r *SubstanceDefinitionProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var substanceDefinitionPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionProperty) invariants() []invariant {
+	return substanceDefinitionPropertyInvariants
+}
+
+func (r SubstanceDefinitionProperty) MarshalJSON() ([]byte, error) {
+	type alias SubstanceDefinitionProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SubstanceDefinitionProperty) UnmarshalJSON(data []byte) error {
+	type alias SubstanceDefinitionProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", substanceDefinitionPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r SubstanceDefinitionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SubstanceDefinitionProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(substanceDefinitionPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var substanceDefinitionPropertyElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.property.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.property.value[x]", Name: "value", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept", "Quantity", "Range", "date", "boolean", "Attachment"}},
+}
+
+func (r *SubstanceDefinitionProperty) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionPropertyElementMetadata
+}
+
+// SubstanceDefinitionPropertyValue is the type of SubstanceDefinition.property.value[x]. It is implemented by the SubstanceDefinitionPropertyValue* variant types.
+type SubstanceDefinitionPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSubstanceDefinitionPropertyValue()
+}
+
+// SubstanceDefinitionPropertyValueCodeableConcept is the CodeableConcept variant of SubstanceDefinitionPropertyValue.
+type SubstanceDefinitionPropertyValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SubstanceDefinitionPropertyValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SubstanceDefinitionPropertyValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionPropertyValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SubstanceDefinitionPropertyValueCodeableConcept) isSubstanceDefinitionPropertyValue() {}
+
+// SubstanceDefinitionPropertyValueQuantity is the Quantity variant of SubstanceDefinitionPropertyValue.
+type SubstanceDefinitionPropertyValueQuantity struct {
+	Quantity
+}
+
+func (v SubstanceDefinitionPropertyValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v SubstanceDefinitionPropertyValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionPropertyValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v SubstanceDefinitionPropertyValueQuantity) isSubstanceDefinitionPropertyValue() {}
+
+// SubstanceDefinitionPropertyValueRange is the Range variant of SubstanceDefinitionPropertyValue.
+type SubstanceDefinitionPropertyValueRange struct {
+	Range
+}
+
+func (v SubstanceDefinitionPropertyValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v SubstanceDefinitionPropertyValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionPropertyValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v SubstanceDefinitionPropertyValueRange) isSubstanceDefinitionPropertyValue() {}
+
+// SubstanceDefinitionPropertyValueDate is the date variant of SubstanceDefinitionPropertyValue.
+type SubstanceDefinitionPropertyValueDate struct {
+	Date
+}
+
+func (v SubstanceDefinitionPropertyValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v SubstanceDefinitionPropertyValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v SubstanceDefinitionPropertyValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v SubstanceDefinitionPropertyValueDate) isSubstanceDefinitionPropertyValue() {}
+
+// SubstanceDefinitionPropertyValueBoolean is the boolean variant of SubstanceDefinitionPropertyValue.
+type SubstanceDefinitionPropertyValueBoolean bool
+
+func (v SubstanceDefinitionPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v SubstanceDefinitionPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v SubstanceDefinitionPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v SubstanceDefinitionPropertyValueBoolean) isSubstanceDefinitionPropertyValue() {}
+
+// SubstanceDefinitionPropertyValueAttachment is the Attachment variant of SubstanceDefinitionPropertyValue.
+type SubstanceDefinitionPropertyValueAttachment struct {
+	Attachment
+}
+
+func (v SubstanceDefinitionPropertyValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v SubstanceDefinitionPropertyValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionPropertyValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v SubstanceDefinitionPropertyValueAttachment) isSubstanceDefinitionPropertyValue() {}
+
+var substanceDefinitionPropertyValueVariants = []SubstanceDefinitionPropertyValue{
+	SubstanceDefinitionPropertyValueCodeableConcept{},
+	SubstanceDefinitionPropertyValueQuantity{},
+	SubstanceDefinitionPropertyValueRange{},
+	SubstanceDefinitionPropertyValueDate{},
+	SubstanceDefinitionPropertyValueBoolean(false),
+	SubstanceDefinitionPropertyValueAttachment{},
+}
+
+type SubstanceDefinitionMolecularWeight struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Method            *CodeableConcept `json:"method,omitempty" bson:"method,omitempty"`                        // The method by which the weight was determined
+	Type              *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                            // Type of molecular weight e.g. exact, average, weight average
+	Amount            *Quantity        `json:"amount" bson:"amount"`                                            // Used to capture quantitative values for a variety of elements
+}
+
+func (r *SubstanceDefinitionMolecularWeight) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Method != nil {
+		if err := r.Method.Validate(); err != nil {
+			return fmt.Errorf("Method: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Amount == nil {
+		return fmt.Errorf("field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionMolecularWeight) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Method != nil {
+		r.Method.validateAll(path+".method", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount", issues)
+	}
+}
+
+var substanceDefinitionMolecularWeightInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionMolecularWeight) invariants() []invariant {
+	return substanceDefinitionMolecularWeightInvariants
+}
+
+func (r SubstanceDefinitionMolecularWeight) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionMolecularWeight) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionMolecularWeight) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionMolecularWeight) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionMolecularWeightElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.molecularWeight.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.molecularWeight.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.molecularWeight.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.molecularWeight.method", Name: "method", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.molecularWeight.type", Name: "type", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.molecularWeight.amount", Name: "amount", Field: 5, Min: 1, Max: "1", Types: []string{"Quantity"}},
+}
+
+func (r *SubstanceDefinitionMolecularWeight) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionMolecularWeightElementMetadata
+}
+
+type SubstanceDefinitionStructure struct {
+	Id                              *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                                         // Unique id for inter-element referencing
+	Extension                       []Extension                                  `json:"extension,omitempty" bson:"extension,omitempty"`                                           // Additional content defined by implementations
+	ModifierExtension               []Extension                                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`                          // Extensions that cannot be ignored even if unrecognized
+	Stereochemistry                 *CodeableConcept                             `json:"stereochemistry,omitempty" bson:"stereochemistry,omitempty"`                               // Stereochemistry type
+	OpticalActivity                 *CodeableConcept                             `json:"opticalActivity,omitempty" bson:"optical_activity,omitempty"`                              // Optical activity type
+	MolecularFormula                *string                                      `json:"molecularFormula,omitempty" bson:"molecular_formula,omitempty"`                            // An expression which states the number and type of atoms present in a molecule of a substance
+	MolecularFormulaElement         *Element                                     `json:"_molecularFormula,omitempty" bson:"molecular_formula_element,omitempty"`                   // Extensions for molecularFormula
+	MolecularFormulaByMoiety        *string                                      `json:"molecularFormulaByMoiety,omitempty" bson:"molecular_formula_by_moiety,omitempty"`          // Specified per moiety according to the Hill system
+	MolecularFormulaByMoietyElement *Element                                     `json:"_molecularFormulaByMoiety,omitempty" bson:"molecular_formula_by_moiety_element,omitempty"` // Extensions for molecularFormulaByMoiety
+	MolecularWeight                 *SubstanceDefinitionMolecularWeight          `json:"molecularWeight,omitempty" bson:"molecular_weight,omitempty"`                              // The molecular weight or weight range
+	Technique                       []CodeableConcept                            `json:"technique,omitempty" bson:"technique,omitempty"`                                           // The method used to find the structure e.g. X-ray, NMR
+	SourceDocument                  []Reference                                  `json:"sourceDocument,omitempty" bson:"source_document,omitempty"`                                // Source of information for the structure
+	Representation                  []SubstanceDefinitionStructureRepresentation `json:"representation,omitempty" bson:"representation,omitempty"`                                 // A depiction of the structure of the substance
+}
+
+func (r *SubstanceDefinitionStructure) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Stereochemistry != nil {
+		if err := r.Stereochemistry.Validate(); err != nil {
+			return fmt.Errorf("Stereochemistry: %w", err)
+		}
+	}
+	if r.OpticalActivity != nil {
+		if err := r.OpticalActivity.Validate(); err != nil {
+			return fmt.Errorf("OpticalActivity: %w", err)
+		}
+	}
+	if r.MolecularFormulaElement != nil {
+		if err := r.MolecularFormulaElement.Validate(); err != nil {
+			return fmt.Errorf("MolecularFormulaElement: %w", err)
+		}
+	}
+	if r.MolecularFormulaByMoietyElement != nil {
+		if err := r.MolecularFormulaByMoietyElement.Validate(); err != nil {
+			return fmt.Errorf("MolecularFormulaByMoietyElement: %w", err)
+		}
+	}
+	if r.MolecularWeight != nil {
+		if err := r.MolecularWeight.Validate(); err != nil {
+			return fmt.Errorf("MolecularWeight: %w", err)
+		}
+	}
+	for i, item := range r.Technique {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Technique[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.SourceDocument {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SourceDocument[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Representation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Representation[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionStructure) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Stereochemistry != nil {
+		r.Stereochemistry.validateAll(path+".stereochemistry", issues)
+	}
+	if r.OpticalActivity != nil {
+		r.OpticalActivity.validateAll(path+".opticalActivity", issues)
+	}
+	if r.MolecularFormulaElement != nil {
+		r.MolecularFormulaElement.validateAll(path+".molecularFormula", issues)
+	}
+	if r.MolecularFormulaByMoietyElement != nil {
+		r.MolecularFormulaByMoietyElement.validateAll(path+".molecularFormulaByMoiety", issues)
+	}
+	if r.MolecularWeight != nil {
+		r.MolecularWeight.validateAll(path+".molecularWeight", issues)
+	}
+	for i, item := range r.Technique {
+		item.validateAll(fmt.Sprintf("%s.technique[%d]", path, i), issues)
+	}
+	for i, item := range r.SourceDocument {
+		item.validateAll(fmt.Sprintf("%s.sourceDocument[%d]", path, i), issues)
+	}
+	for i, item := range r.Representation {
+		item.validateAll(fmt.Sprintf("%s.representation[%d]", path, i), issues)
+	}
+}
+
+var substanceDefinitionStructureInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionStructure) invariants() []invariant {
+	return substanceDefinitionStructureInvariants
+}
+
+func (r SubstanceDefinitionStructure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionStructure) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionStructure) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionStructure) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionStructureElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.structure.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.structure.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.structure.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.structure.stereochemistry", Name: "stereochemistry", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.structure.opticalActivity", Name: "opticalActivity", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.structure.molecularFormula", Name: "molecularFormula", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.structure.molecularFormulaByMoiety", Name: "molecularFormulaByMoiety", Field: 7, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.structure.molecularWeight", Name: "molecularWeight", Field: 9, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.structure.technique", Name: "technique", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.structure.sourceDocument", Name: "sourceDocument", Field: 11, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "SubstanceDefinition.structure.representation", Name: "representation", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *SubstanceDefinitionStructure) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionStructureElementMetadata
+}
+
+type SubstanceDefinitionStructureRepresentation struct {
+	Id                    *string          `json:"id,omitempty" bson:"id,omitempty"`                                  // Unique id for inter-element referencing
+	Extension             []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension     []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored even if unrecognized
+	Type                  *CodeableConcept `json:"type,omitempty" bson:"type,omitempty"`                              // The kind of structural representation (e.g. full, partial)
+	Representation        *string          `json:"representation,omitempty" bson:"representation,omitempty"`          // The structural representation as a text string in a standard format
+	RepresentationElement *Element         `json:"_representation,omitempty" bson:"representation_element,omitempty"` // Extensions for representation
+	Format                *CodeableConcept `json:"format,omitempty" bson:"format,omitempty"`                          // The format of the representation e.g. InChI, SMILES, MOLFILE (note: not the physical file format)
+	Document              *Reference       `json:"document,omitempty" bson:"document,omitempty"`                      // An attachment with the structural representation e.g. a structure graphic or AnIML file
+}
+
+func (r *SubstanceDefinitionStructureRepresentation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.RepresentationElement != nil {
+		if err := r.RepresentationElement.Validate(); err != nil {
+			return fmt.Errorf("RepresentationElement: %w", err)
+		}
+	}
+	if r.Format != nil {
+		if err := r.Format.Validate(); err != nil {
+			return fmt.Errorf("Format: %w", err)
+		}
+	}
+	if r.Document != nil {
+		if err := r.Document.Validate(); err != nil {
+			return fmt.Errorf("Document: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionStructureRepresentation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.RepresentationElement != nil {
+		r.RepresentationElement.validateAll(path+".representation", issues)
+	}
+	if r.Format != nil {
+		r.Format.validateAll(path+".format", issues)
+	}
+	if r.Document != nil {
+		r.Document.validateAll(path+".document", issues)
+	}
+}
+
+var substanceDefinitionStructureRepresentationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionStructureRepresentation) invariants() []invariant {
+	return substanceDefinitionStructureRepresentationInvariants
+}
+
+func (r SubstanceDefinitionStructureRepresentation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionStructureRepresentation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionStructureRepresentation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionStructureRepresentation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionStructureRepresentationElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.structure.representation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.structure.representation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.structure.representation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.structure.representation.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.structure.representation.representation", Name: "representation", Field: 4, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.structure.representation.format", Name: "format", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.structure.representation.document", Name: "document", Field: 7, Min: 0, Max: "1", Types: []string{"Reference"}},
+}
+
+func (r *SubstanceDefinitionStructureRepresentation) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionStructureRepresentationElementMetadata
+}
+
+type SubstanceDefinitionCode struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // The specific code
+	Status            *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`                        // Status of the code assignment, for example 'provisional', 'approved'
+	StatusDate        *DateTime        `json:"statusDate,omitempty" bson:"status_date,omitempty"`               // The date at which the code status was changed
+	StatusDateElement *Element         `json:"_statusDate,omitempty" bson:"status_date_element,omitempty"`      // Extensions for statusDate
+	Note              []Annotation     `json:"note,omitempty" bson:"note,omitempty"`                            // Any comment can be provided in this field
+	Source            []Reference      `json:"source,omitempty" bson:"source,omitempty"`                        // Supporting literature
+}
+
+func (r *SubstanceDefinitionCode) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Code != nil {
+		if err := r.Code.Validate(); err != nil {
+			return fmt.Errorf("Code: %w", err)
+		}
+	}
+	if r.Status != nil {
+		if err := r.Status.Validate(); err != nil {
+			return fmt.Errorf("Status: %w", err)
+		}
+	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			return fmt.Errorf("StatusDate: %w", err)
+		}
+	}
+	if r.StatusDateElement != nil {
+		if err := r.StatusDateElement.Validate(); err != nil {
+			return fmt.Errorf("StatusDateElement: %w", err)
+		}
+	}
+	for i, item := range r.Note {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Note[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Source {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Source[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionCode) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.StatusDate != nil {
+		if err := r.StatusDate.Validate(); err != nil {
+			issues.add("value", path+".statusDate", err.Error())
+		}
+	}
+	if r.StatusDateElement != nil {
+		r.StatusDateElement.validateAll(path+".statusDate", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+	for i, item := range r.Source {
+		item.validateAll(fmt.Sprintf("%s.source[%d]", path, i), issues)
+	}
+}
+
+var substanceDefinitionCodeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionCode) invariants() []invariant {
+	return substanceDefinitionCodeInvariants
+}
+
+func (r SubstanceDefinitionCode) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionCode) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionCode) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionCode) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionCodeElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.code.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.code.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.code.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.code.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.code.status", Name: "status", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.code.statusDate", Name: "statusDate", Field: 5, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "SubstanceDefinition.code.note", Name: "note", Field: 7, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "SubstanceDefinition.code.source", Name: "source", Field: 8, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *SubstanceDefinitionCode) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionCodeElementMetadata
+}
+
+type SubstanceDefinitionName struct {
+	Id                *string                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string                            `json:"name" bson:"name"`                                                // The actual name
+	NameElement       *Element                          `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Type              *CodeableConcept                  `json:"type,omitempty" bson:"type,omitempty"`                            // Name type e.g. 'systematic',  'scientific, 'brand'
+	Status            *CodeableConcept                  `json:"status,omitempty" bson:"status,omitempty"`                        // The status of the name e.g. 'current', 'proposed'
+	Preferred         *bool                             `json:"preferred,omitempty" bson:"preferred,omitempty"`                  // If this is the preferred name for this substance
+	PreferredElement  *Element                          `json:"_preferred,omitempty" bson:"preferred_element,omitempty"`         // Extensions for preferred
+	Language          []CodeableConcept                 `json:"language,omitempty" bson:"language,omitempty"`                    // Human language that the name is written in
+	Domain            []CodeableConcept                 `json:"domain,omitempty" bson:"domain,omitempty"`                        // The use context of this name e.g. as an active ingredient or as a food colour additive
+	Jurisdiction      []CodeableConcept                 `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`            // The jurisdiction where this name applies
+	Synonym           []SubstanceDefinitionName         `json:"synonym,omitempty" bson:"synonym,omitempty"`                      // A synonym of this particular name, by which the substance is also known
+	Translation       []SubstanceDefinitionName         `json:"translation,omitempty" bson:"translation,omitempty"`              // A translation for this name into another human language
+	Official          []SubstanceDefinitionNameOfficial `json:"official,omitempty" bson:"official,omitempty"`                    // Details of the official nature of this name
+	Source            []Reference                       `json:"source,omitempty" bson:"source,omitempty"`                        // Supporting literature
+}
+
+func (r *SubstanceDefinitionName) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Status != nil {
+		if err := r.Status.Validate(); err != nil {
+			return fmt.Errorf("Status: %w", err)
+		}
+	}
+	if r.PreferredElement != nil {
+		if err := r.PreferredElement.Validate(); err != nil {
+			return fmt.Errorf("PreferredElement: %w", err)
+		}
+	}
+	for i, item := range r.Language {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Language[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Domain {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Domain[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Jurisdiction {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Synonym {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Synonym[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Translation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Translation[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Official {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Official[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Source {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Source[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *SubstanceDefinitionName) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
+	}
+	if r.PreferredElement != nil {
+		r.PreferredElement.validateAll(path+".preferred", issues)
+	}
+	for i, item := range r.Language {
+		item.validateAll(fmt.Sprintf("%s.language[%d]", path, i), issues)
+	}
+	for i, item := range r.Domain {
+		item.validateAll(fmt.Sprintf("%s.domain[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	for i, item := range r.Synonym {
+		item.validateAll(fmt.Sprintf("%s.synonym[%d]", path, i), issues)
+	}
+	for i, item := range r.Translation {
+		item.validateAll(fmt.Sprintf("%s.translation[%d]", path, i), issues)
+	}
+	for i, item := range r.Official {
+		item.validateAll(fmt.Sprintf("%s.official[%d]", path, i), issues)
+	}
+	for i, item := range r.Source {
+		item.validateAll(fmt.Sprintf("%s.source[%d]", path, i), issues)
+	}
+}
+
+var substanceDefinitionNameInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionName) invariants() []invariant {
+	return substanceDefinitionNameInvariants
+}
+
+func (r SubstanceDefinitionName) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionName) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionName) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionName) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionNameElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.name.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.name.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.name.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.name.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.name.type", Name: "type", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.status", Name: "status", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.preferred", Name: "preferred", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SubstanceDefinition.name.language", Name: "language", Field: 9, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.domain", Name: "domain", Field: 10, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.jurisdiction", Name: "jurisdiction", Field: 11, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.synonym", Name: "synonym", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.name.translation", Name: "translation", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.name.official", Name: "official", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "SubstanceDefinition.name.source", Name: "source", Field: 15, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *SubstanceDefinitionName) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionNameElementMetadata
+}
+
+type SubstanceDefinitionNameOfficial struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Authority         *CodeableConcept `json:"authority,omitempty" bson:"authority,omitempty"`                  // Which authority uses this official name
+	Status            *CodeableConcept `json:"status,omitempty" bson:"status,omitempty"`                        // The status of the official name, for example 'draft', 'active'
+	Date              *DateTime        `json:"date,omitempty" bson:"date,omitempty"`                            // Date of official name change
+	DateElement       *Element         `json:"_date,omitempty" bson:"date_element,omitempty"`                   // Extensions for date
+}
+
+func (r *SubstanceDefinitionNameOfficial) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Authority != nil {
+		if err := r.Authority.Validate(); err != nil {
+			return fmt.Errorf("Authority: %w", err)
+		}
+	}
+	if r.Status != nil {
+		if err := r.Status.Validate(); err != nil {
+			return fmt.Errorf("Status: %w", err)
 		}
 	}
-	for i, item := range r.Source {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Source[%d]: %w", i, err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
 		}
 	}
 	return nil
 }
 
-type SubstanceDefinitionMoiety struct {
-	Id               *string          `json:"id,omitempty" bson:"id,omitempty"`                              // Unique id for inter-element referencing
-	Role             *CodeableConcept `json:"role,omitempty" bson:"role,omitempty"`                          // Role that the moiety is playing
-	Identifier       *Identifier      `json:"identifier,omitempty" bson:"identifier,omitempty"`              // Identifier by which this moiety substance is known
-	Name             *string          `json:"name,omitempty" bson:"name,omitempty"`                          // Textual name for this moiety substance
-	Stereochemistry  *CodeableConcept `json:"stereochemistry,omitempty" bson:"stereochemistry,omitempty"`    // Stereochemistry type
-	OpticalActivity  *CodeableConcept `json:"opticalActivity,omitempty" bson:"optical_activity,omitempty"`   // Optical activity type
-	MolecularFormula *string          `json:"molecularFormula,omitempty" bson:"molecular_formula,omitempty"` // Molecular formula for this moiety (e.g. with the Hill system)
-	AmountQuantity   *Quantity        `json:"amountQuantity,omitempty" bson:"amount_quantity,omitempty"`     // Quantitative value for this moiety
-	AmountString     *string          `json:"amountString,omitempty" bson:"amount_string,omitempty"`         // Quantitative value for this moiety
-	MeasurementType  *CodeableConcept `json:"measurementType,omitempty" bson:"measurement_type,omitempty"`   // The measurement type of the quantitative value
-}
-
-func (r *SubstanceDefinitionMoiety) Validate() error {
-	if r.Role != nil {
-		if err := r.Role.Validate(); err != nil {
-			return fmt.Errorf("Role: %w", err)
-		}
+func (r *SubstanceDefinitionNameOfficial) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.Identifier != nil {
-		if err := r.Identifier.Validate(); err != nil {
-			return fmt.Errorf("Identifier: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.Stereochemistry != nil {
-		if err := r.Stereochemistry.Validate(); err != nil {
-			return fmt.Errorf("Stereochemistry: %w", err)
-		}
+	if r.Authority != nil {
+		r.Authority.validateAll(path+".authority", issues)
 	}
-	if r.OpticalActivity != nil {
-		if err := r.OpticalActivity.Validate(); err != nil {
-			return fmt.Errorf("OpticalActivity: %w", err)
-		}
+	if r.Status != nil {
+		r.Status.validateAll(path+".status", issues)
 	}
-	if r.AmountQuantity != nil {
-		if err := r.AmountQuantity.Validate(); err != nil {
-			return fmt.Errorf("AmountQuantity: %w", err)
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.MeasurementType != nil {
-		if err := r.MeasurementType.Validate(); err != nil {
-			return fmt.Errorf("MeasurementType: %w", err)
-		}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	return nil
 }
 
-type SubstanceDefinitionProperty struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                                       // Unique id for inter-element referencing
-	Type                 *CodeableConcept `json:"type" bson:"type"`                                                       // A code expressing the type of property
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty" bson:"value_codeable_concept,omitempty"` // A value for the property
-	ValueQuantity        *Quantity        `json:"valueQuantity,omitempty" bson:"value_quantity,omitempty"`                // A value for the property
-	ValueRange           *Range           `json:"valueRange,omitempty" bson:"value_range,omitempty"`                      // A value for the property
-	ValueDate            *string          `json:"valueDate,omitempty" bson:"value_date,omitempty"`                        // A value for the property
-	ValueBoolean         *bool            `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`                  // A value for the property
-	ValueAttachment      *Attachment      `json:"valueAttachment,omitempty" bson:"value_attachment,omitempty"`            // A value for the property
+var substanceDefinitionNameOfficialInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
 }
 
-func (r *SubstanceDefinitionProperty) Validate() error {
+func (r *SubstanceDefinitionNameOfficial) invariants() []invariant {
+	return substanceDefinitionNameOfficialInvariants
+}
+
+func (r SubstanceDefinitionNameOfficial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionNameOfficial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionNameOfficial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionNameOfficial) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionNameOfficialElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.name.official.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.name.official.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.name.official.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.name.official.authority", Name: "authority", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.official.status", Name: "status", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.name.official.date", Name: "date", Field: 5, Min: 0, Max: "1", Types: []string{"dateTime"}},
+}
+
+func (r *SubstanceDefinitionNameOfficial) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionNameOfficialElementMetadata
+}
+
+type SubstanceDefinitionRelationship struct {
+	Id                   *string                                            `json:"id,omitempty" bson:"id,omitempty"`                                        // Unique id for inter-element referencing
+	Extension            []Extension                                        `json:"extension,omitempty" bson:"extension,omitempty"`                          // Additional content defined by implementations
+	ModifierExtension    []Extension                                        `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`         // Extensions that cannot be ignored even if unrecognized
+	SubstanceDefinition  SubstanceDefinitionRelationshipSubstanceDefinition `json:"-" bson:"substance_definition,omitempty"`                                 // A pointer to another substance, as a resource or a representational code
+	Type                 *CodeableConcept                                   `json:"type" bson:"type"`                                                        // For example "salt to parent", "active moiety"
+	IsDefining           *bool                                              `json:"isDefining,omitempty" bson:"is_defining,omitempty"`                       // For example where an enzyme strongly bonds with a particular substance, this is a defining relationship for that enzyme, out of several possible relationships
+	IsDefiningElement    *Element                                           `json:"_isDefining,omitempty" bson:"is_defining_element,omitempty"`              // Extensions for isDefining
+	Amount               SubstanceDefinitionRelationshipAmount              `json:"-" bson:"amount,omitempty"`                                               // A numeric factor for the relationship, e.g. that a substance salt has some percentage of active substance in relation to some other
+	AmountElement        *Element                                           `json:"-" bson:"amount_element,omitempty"`                                       // Extensions for amount[x]
+	RatioHighLimitAmount *Ratio                                             `json:"ratioHighLimitAmount,omitempty" bson:"ratio_high_limit_amount,omitempty"` // For use when the numeric has an uncertain range
+	Comparator           *CodeableConcept                                   `json:"comparator,omitempty" bson:"comparator,omitempty"`                        // An operator for the amount, for example "average", "approximately", "less than"
+	Source               []Reference                                        `json:"source,omitempty" bson:"source,omitempty"`                                // Supporting literature
+
+	substanceDefinitionVariants []string // JSON properties of SubstanceDefinition.relationship.substanceDefinition[x] when more than one was decoded
+	amountVariants              []string // JSON properties of SubstanceDefinition.relationship.amount[x] when more than one was decoded
+}
+
+func (r *SubstanceDefinitionRelationship) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if len(r.substanceDefinitionVariants) > 1 {
+		return fmt.Errorf("field 'SubstanceDefinition' must have a single type, got %v", r.substanceDefinitionVariants)
+	}
+	if r.SubstanceDefinition != nil {
+		if err := r.SubstanceDefinition.Validate(); err != nil {
+			return fmt.Errorf("SubstanceDefinition: %w", err)
+		}
+	}
 	if r.Type == nil {
 		return fmt.Errorf("field 'Type' is required")
 	}
@@ -508,71 +1868,412 @@ func (r *SubstanceDefinitionProperty) Validate() error {
 			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.IsDefiningElement != nil {
+		if err := r.IsDefiningElement.Validate(); err != nil {
+			return fmt.Errorf("IsDefiningElement: %w", err)
+		}
+	}
+	if len(r.amountVariants) > 1 {
+		return fmt.Errorf("field 'Amount' must have a single type, got %v", r.amountVariants)
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	if r.AmountElement != nil {
+		if err := r.AmountElement.Validate(); err != nil {
+			return fmt.Errorf("AmountElement: %w", err)
 		}
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.RatioHighLimitAmount != nil {
+		if err := r.RatioHighLimitAmount.Validate(); err != nil {
+			return fmt.Errorf("RatioHighLimitAmount: %w", err)
 		}
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.Comparator != nil {
+		if err := r.Comparator.Validate(); err != nil {
+			return fmt.Errorf("Comparator: %w", err)
 		}
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	for i, item := range r.Source {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Source[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
 
-type SubstanceDefinitionStructure struct {
-	Id                       *string                                      `json:"id,omitempty" bson:"id,omitempty"`                                                // Unique id for inter-element referencing
-	Stereochemistry          *CodeableConcept                             `json:"stereochemistry,omitempty" bson:"stereochemistry,omitempty"`                      // Stereochemistry type
-	OpticalActivity          *CodeableConcept                             `json:"opticalActivity,omitempty" bson:"optical_activity,omitempty"`                     // Optical activity type
-	MolecularFormula         *string                                      `json:"molecularFormula,omitempty" bson:"molecular_formula,omitempty"`                   // An expression which states the number and type of atoms present in a molecule of a substance
-	MolecularFormulaByMoiety *string                                      `json:"molecularFormulaByMoiety,omitempty" bson:"molecular_formula_by_moiety,omitempty"` // Specified per moiety according to the Hill system
-	MolecularWeight          *SubstanceDefinitionMolecularWeight          `json:"molecularWeight,omitempty" bson:"molecular_weight,omitempty"`                     // The molecular weight or weight range
-	Technique                []CodeableConcept                            `json:"technique,omitempty" bson:"technique,omitempty"`                                  // The method used to find the structure e.g. X-ray, NMR
-	SourceDocument           []Reference                                  `json:"sourceDocument,omitempty" bson:"source_document,omitempty"`                       // Source of information for the structure
-	Representation           []SubstanceDefinitionStructureRepresentation `json:"representation,omitempty" bson:"representation,omitempty"`                        // A depiction of the structure of the substance
+func (r *SubstanceDefinitionRelationship) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if len(r.substanceDefinitionVariants) > 1 {
+		issues.add("structure", path+".substanceDefinition", fmt.Sprintf("field 'SubstanceDefinition' must have a single type, got %v", r.substanceDefinitionVariants))
+	}
+	if r.SubstanceDefinition != nil {
+		r.SubstanceDefinition.validateAll(path+".substanceDefinition.ofType("+r.SubstanceDefinition.FHIRType()+")", issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.IsDefiningElement != nil {
+		r.IsDefiningElement.validateAll(path+".isDefining", issues)
+	}
+	if len(r.amountVariants) > 1 {
+		issues.add("structure", path+".amount", fmt.Sprintf("field 'Amount' must have a single type, got %v", r.amountVariants))
+	}
+	if r.Amount != nil {
+		r.Amount.validateAll(path+".amount.ofType("+r.Amount.FHIRType()+")", issues)
+	}
+	if r.AmountElement != nil {
+		r.AmountElement.validateAll(path+".amount", issues)
+	}
+	if r.RatioHighLimitAmount != nil {
+		r.RatioHighLimitAmount.validateAll(path+".ratioHighLimitAmount", issues)
+	}
+	if r.Comparator != nil {
+		r.Comparator.validateAll(path+".comparator", issues)
+	}
+	for i, item := range r.Source {
+		item.validateAll(fmt.Sprintf("%s.source[%d]", path, i), issues)
+	}
 }
 
-func (r *SubstanceDefinitionStructure) Validate() error {
-	if r.Stereochemistry != nil {
-		if err := r.Stereochemistry.Validate(); err != nil {
-			return fmt.Errorf("Stereochemistry: %w", err)
-		}
+var substanceDefinitionRelationshipInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionRelationship) invariants() []invariant {
+	return substanceDefinitionRelationshipInvariants
+}
+
+func (r SubstanceDefinitionRelationship) MarshalJSON() ([]byte, error) {
+	type alias SubstanceDefinitionRelationship
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "substanceDefinition", r.SubstanceDefinition, nil); err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "amount", r.Amount, r.AmountElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *SubstanceDefinitionRelationship) UnmarshalJSON(data []byte) error {
+	type alias SubstanceDefinitionRelationship
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.OpticalActivity != nil {
-		if err := r.OpticalActivity.Validate(); err != nil {
-			return fmt.Errorf("OpticalActivity: %w", err)
-		}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.MolecularWeight != nil {
-		if err := r.MolecularWeight.Validate(); err != nil {
-			return fmt.Errorf("MolecularWeight: %w", err)
+	var element json.RawMessage
+	if r.SubstanceDefinition, _, r.substanceDefinitionVariants, err = unmarshalChoice(fields, "substanceDefinition", substanceDefinitionRelationshipSubstanceDefinitionVariants); err != nil {
+		return err
+	}
+	if r.Amount, element, r.amountVariants, err = unmarshalChoice(fields, "amount", substanceDefinitionRelationshipAmountVariants); err != nil {
+		return err
+	}
+	r.AmountElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AmountElement); err != nil {
+			return fmt.Errorf("_amount: %w", err)
 		}
 	}
-	for i, item := range r.Technique {
+	return nil
+}
+
+func (r SubstanceDefinitionRelationship) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionRelationship) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionRelationship) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionRelationship) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *SubstanceDefinitionRelationship) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "SubstanceDefinition":
+		return "substanceDefinition", choiceValues(substanceDefinitionRelationshipSubstanceDefinitionVariants)
+	case "Amount":
+		return "amount", choiceValues(substanceDefinitionRelationshipAmountVariants)
+	}
+	return "", nil
+}
+
+var substanceDefinitionRelationshipElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.relationship.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.relationship.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.relationship.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.relationship.substanceDefinition[x]", Name: "substanceDefinition", Field: 3, Min: 0, Max: "1", Types: []string{"Reference", "CodeableConcept"}},
+	{Path: "SubstanceDefinition.relationship.type", Name: "type", Field: 4, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.relationship.isDefining", Name: "isDefining", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "SubstanceDefinition.relationship.amount[x]", Name: "amount", Field: 7, Min: 0, Max: "1", Types: []string{"Quantity", "Ratio", "string"}},
+	{Path: "SubstanceDefinition.relationship.ratioHighLimitAmount", Name: "ratioHighLimitAmount", Field: 9, Min: 0, Max: "1", Types: []string{"Ratio"}},
+	{Path: "SubstanceDefinition.relationship.comparator", Name: "comparator", Field: 10, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.relationship.source", Name: "source", Field: 11, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *SubstanceDefinitionRelationship) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionRelationshipElementMetadata
+}
+
+// SubstanceDefinitionRelationshipSubstanceDefinition is the type of SubstanceDefinition.relationship.substanceDefinition[x]. It is implemented by the SubstanceDefinitionRelationshipSubstanceDefinition* variant types.
+type SubstanceDefinitionRelationshipSubstanceDefinition interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSubstanceDefinitionRelationshipSubstanceDefinition()
+}
+
+// SubstanceDefinitionRelationshipSubstanceDefinitionReference is the Reference variant of SubstanceDefinitionRelationshipSubstanceDefinition.
+type SubstanceDefinitionRelationshipSubstanceDefinitionReference struct {
+	Reference
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionReference) isSubstanceDefinitionRelationshipSubstanceDefinition() {
+}
+
+// SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept is the CodeableConcept variant of SubstanceDefinitionRelationshipSubstanceDefinition.
+type SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept) isSubstanceDefinitionRelationshipSubstanceDefinition() {
+}
+
+var substanceDefinitionRelationshipSubstanceDefinitionVariants = []SubstanceDefinitionRelationshipSubstanceDefinition{
+	SubstanceDefinitionRelationshipSubstanceDefinitionReference{},
+	SubstanceDefinitionRelationshipSubstanceDefinitionCodeableConcept{},
+}
+
+// SubstanceDefinitionRelationshipAmount is the type of SubstanceDefinition.relationship.amount[x]. It is implemented by the SubstanceDefinitionRelationshipAmount* variant types.
+type SubstanceDefinitionRelationshipAmount interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isSubstanceDefinitionRelationshipAmount()
+}
+
+// SubstanceDefinitionRelationshipAmountQuantity is the Quantity variant of SubstanceDefinitionRelationshipAmount.
+type SubstanceDefinitionRelationshipAmountQuantity struct {
+	Quantity
+}
+
+func (v SubstanceDefinitionRelationshipAmountQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v SubstanceDefinitionRelationshipAmountQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionRelationshipAmountQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v SubstanceDefinitionRelationshipAmountQuantity) isSubstanceDefinitionRelationshipAmount() {}
+
+// SubstanceDefinitionRelationshipAmountRatio is the Ratio variant of SubstanceDefinitionRelationshipAmount.
+type SubstanceDefinitionRelationshipAmountRatio struct {
+	Ratio
+}
+
+func (v SubstanceDefinitionRelationshipAmountRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v SubstanceDefinitionRelationshipAmountRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v SubstanceDefinitionRelationshipAmountRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v SubstanceDefinitionRelationshipAmountRatio) isSubstanceDefinitionRelationshipAmount() {}
+
+// SubstanceDefinitionRelationshipAmountString is the string variant of SubstanceDefinitionRelationshipAmount.
+type SubstanceDefinitionRelationshipAmountString string
+
+func (v SubstanceDefinitionRelationshipAmountString) Validate() error {
+	return nil
+}
+
+func (v SubstanceDefinitionRelationshipAmountString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v SubstanceDefinitionRelationshipAmountString) FHIRType() string {
+	return "string"
+}
+
+func (v SubstanceDefinitionRelationshipAmountString) isSubstanceDefinitionRelationshipAmount() {}
+
+var substanceDefinitionRelationshipAmountVariants = []SubstanceDefinitionRelationshipAmount{
+	SubstanceDefinitionRelationshipAmountQuantity{},
+	SubstanceDefinitionRelationshipAmountRatio{},
+	SubstanceDefinitionRelationshipAmountString(""),
+}
+
+type SubstanceDefinitionSourceMaterial struct {
+	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept  `json:"type,omitempty" bson:"type,omitempty"`                            // Classification of the origin of the raw material. e.g. cat hair is an Animal source type
+	Genus             *CodeableConcept  `json:"genus,omitempty" bson:"genus,omitempty"`                          // The genus of an organism e.g. the Latin epithet of the plant/animal scientific name
+	Species           *CodeableConcept  `json:"species,omitempty" bson:"species,omitempty"`                      // The species of an organism e.g. the Latin epithet of the species of the plant/animal
+	Part              *CodeableConcept  `json:"part,omitempty" bson:"part,omitempty"`                            // An anatomical origin of the source material within an organism
+	CountryOfOrigin   []CodeableConcept `json:"countryOfOrigin,omitempty" bson:"country_of_origin,omitempty"`    // The country or countries where the material is harvested
+}
+
+func (r *SubstanceDefinitionSourceMaterial) Validate() error {
+	for i, item := range r.Extension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Technique[%d]: %w", i, err)
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.SourceDocument {
+	for i, item := range r.ModifierExtension {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SourceDocument[%d]: %w", i, err)
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	for i, item := range r.Representation {
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if r.Genus != nil {
+		if err := r.Genus.Validate(); err != nil {
+			return fmt.Errorf("Genus: %w", err)
+		}
+	}
+	if r.Species != nil {
+		if err := r.Species.Validate(); err != nil {
+			return fmt.Errorf("Species: %w", err)
+		}
+	}
+	if r.Part != nil {
+		if err := r.Part.Validate(); err != nil {
+			return fmt.Errorf("Part: %w", err)
+		}
+	}
+	for i, item := range r.CountryOfOrigin {
 		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Representation[%d]: %w", i, err)
+			return fmt.Errorf("CountryOfOrigin[%d]: %w", i, err)
 		}
 	}
 	return nil
 }
+
+func (r *SubstanceDefinitionSourceMaterial) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if r.Genus != nil {
+		r.Genus.validateAll(path+".genus", issues)
+	}
+	if r.Species != nil {
+		r.Species.validateAll(path+".species", issues)
+	}
+	if r.Part != nil {
+		r.Part.validateAll(path+".part", issues)
+	}
+	for i, item := range r.CountryOfOrigin {
+		item.validateAll(fmt.Sprintf("%s.countryOfOrigin[%d]", path, i), issues)
+	}
+}
+
+var substanceDefinitionSourceMaterialInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *SubstanceDefinitionSourceMaterial) invariants() []invariant {
+	return substanceDefinitionSourceMaterialInvariants
+}
+
+func (r SubstanceDefinitionSourceMaterial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *SubstanceDefinitionSourceMaterial) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r SubstanceDefinitionSourceMaterial) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *SubstanceDefinitionSourceMaterial) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var substanceDefinitionSourceMaterialElementMetadata = []ElementMetadata{
+	{Path: "SubstanceDefinition.sourceMaterial.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "SubstanceDefinition.sourceMaterial.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "SubstanceDefinition.sourceMaterial.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "SubstanceDefinition.sourceMaterial.type", Name: "type", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.sourceMaterial.genus", Name: "genus", Field: 4, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.sourceMaterial.species", Name: "species", Field: 5, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.sourceMaterial.part", Name: "part", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "SubstanceDefinition.sourceMaterial.countryOfOrigin", Name: "countryOfOrigin", Field: 7, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+}
+
+func (r *SubstanceDefinitionSourceMaterial) ElementMetadata() []ElementMetadata {
+	return substanceDefinitionSourceMaterialElementMetadata
+}
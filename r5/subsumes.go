@@ -1,9 +1,181 @@
 package models
 
-// Test the subsumption relationship between code/Coding A and code/Coding B given the semantics of subsumption in the underlying code system (see [hierarchyMeaning](codesystem-definitions.html#CodeSystem.hierarchyMeaning)).  When invoking this operation, a client SHALL provide both A and B codes, either as code or Coding parameters. The system parameter is required unless the operation is invoked on an instance of a code system resource. Other parameters are optional
-type Subsumes struct {
+import "fmt"
+
+// SubsumesIn holds the input parameters of the $subsumes operation. Test the subsumption relationship between code/Coding A and code/Coding B given the semantics of subsumption in the underlying code system (see [hierarchyMeaning](codesystem-definitions.html#CodeSystem.hierarchyMeaning)).  When invoking this operation, a client SHALL provide both A and B codes, either as code or Coding parameters. The system parameter is required unless the operation is invoked on an instance of a code system resource. Other parameters are optional
+type SubsumesIn struct {
+	// The "A" code that is to be tested
+	CodeA *string
+	// The "B" code that is to be tested
+	CodeB *string
+	// The code system in which subsumption testing is to be performed
+	System *string
+	// The version of the code system, if one was provided in the source data
+	Version *string
+	// The "A" Coding that is to be tested
+	CodingA *Coding
+	// The "B" Coding that is to be tested
+	CodingB *Coding
+}
+
+func (p *SubsumesIn) Validate() error {
+	return nil
+}
+
+func (p *SubsumesIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *SubsumesIn) FromParameters(params *Parameters) error {
+	*p = SubsumesIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *SubsumesIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.CodeA != nil {
+		params = append(params, ParametersParameter{Name: "codeA", Value: ParametersParameterValueCode(*p.CodeA)})
+	}
+	if p.CodeB != nil {
+		params = append(params, ParametersParameter{Name: "codeB", Value: ParametersParameterValueCode(*p.CodeB)})
+	}
+	if p.System != nil {
+		params = append(params, ParametersParameter{Name: "system", Value: ParametersParameterValueUri(*p.System)})
+	}
+	if p.Version != nil {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueString(*p.Version)})
+	}
+	if p.CodingA != nil {
+		params = append(params, ParametersParameter{Name: "codingA", Value: ParametersParameterValueCoding{Coding: *p.CodingA}})
+	}
+	if p.CodingB != nil {
+		params = append(params, ParametersParameter{Name: "codingB", Value: ParametersParameterValueCoding{Coding: *p.CodingB}})
+	}
+	return params
+}
+
+func (p *SubsumesIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "codeA":
+			if p.CodeA != nil {
+				return fmt.Errorf("parameter 'codeA' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.CodeA = &value
+		case "codeB":
+			if p.CodeB != nil {
+				return fmt.Errorf("parameter 'codeB' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.CodeB = &value
+		case "system":
+			if p.System != nil {
+				return fmt.Errorf("parameter 'system' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.System = &value
+		case "version":
+			if p.Version != nil {
+				return fmt.Errorf("parameter 'version' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Version = &value
+		case "codingA":
+			if p.CodingA != nil {
+				return fmt.Errorf("parameter 'codingA' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.CodingA = &v.Coding
+		case "codingB":
+			if p.CodingB != nil {
+				return fmt.Errorf("parameter 'codingB' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.CodingB = &v.Coding
+		}
+	}
+	return nil
+}
+
+// SubsumesOut holds the output parameters of the $subsumes operation.
+type SubsumesOut struct {
+	// The subsumption relationship between code/Coding "A" and code/Coding "B"
+	Outcome *string
+}
+
+func (p *SubsumesOut) Validate() error {
+	if p.Outcome == nil {
+		return fmt.Errorf("parameter 'outcome' is required")
+	}
+	return nil
+}
+
+func (p *SubsumesOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *SubsumesOut) FromParameters(params *Parameters) error {
+	*p = SubsumesOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *SubsumesOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Outcome != nil {
+		params = append(params, ParametersParameter{Name: "outcome", Value: ParametersParameterValueCode(*p.Outcome)})
+	}
+	return params
 }
 
-func (r *Subsumes) Validate() error {
+func (p *SubsumesOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "outcome":
+			if p.Outcome != nil {
+				return fmt.Errorf("parameter 'outcome' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Outcome = &value
+		}
+	}
 	return nil
 }
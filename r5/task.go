@@ -2,51 +2,71 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A task to be performed as a part of a workflow and the related information like inputs, outputs and execution progress.
 type Task struct {
-	ResourceType       string              `json:"resourceType" bson:"resource_type"`                                 // Type of resource
-	Id                 *string             `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
-	Meta               *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
-	ImplicitRules      *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
-	Language           *string             `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
-	Text               *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
-	Contained          []json.RawMessage   `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
-	Identifier         []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // Task Instance Identifier
-	BasedOn            []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                       // Request fulfilled by this task
-	GroupIdentifier    *Identifier         `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`       // Requisition or grouper id
-	PartOf             []Reference         `json:"partOf,omitempty" bson:"part_of,omitempty"`                         // Composite task
-	Status             string              `json:"status" bson:"status"`                                              // draft | requested | received | accepted | +
-	StatusReason       []CodeableReference `json:"statusReason,omitempty" bson:"status_reason,omitempty"`             // Reason for current status
-	BusinessStatus     *CodeableConcept    `json:"businessStatus,omitempty" bson:"business_status,omitempty"`         // E.g. "Specimen collected", "IV prepped"
-	Intent             string              `json:"intent" bson:"intent"`                                              // unknown | proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option
-	Priority           *string             `json:"priority,omitempty" bson:"priority,omitempty"`                      // routine | urgent | asap | stat
-	DoNotPerform       *bool               `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`            // True if Task is prohibiting action
-	Code               *CodeableConcept    `json:"code,omitempty" bson:"code,omitempty"`                              // Task Type
-	Description        *string             `json:"description,omitempty" bson:"description,omitempty"`                // Human-readable explanation of task
-	Focus              []TaskFocus         `json:"focus,omitempty" bson:"focus,omitempty"`                            // What task is acting on
-	For                *Reference          `json:"for,omitempty" bson:"for,omitempty"`                                // Beneficiary of the Task
-	Encounter          *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // Healthcare event during which this task originated
-	RequestedPeriod    *Period             `json:"requestedPeriod,omitempty" bson:"requested_period,omitempty"`       // When the task should be performed
-	ExecutionPeriod    *Period             `json:"executionPeriod,omitempty" bson:"execution_period,omitempty"`       // Start and end time of execution
-	AuthoredOn         *string             `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                 // Task Creation Date
-	LastModified       *string             `json:"lastModified,omitempty" bson:"last_modified,omitempty"`             // Task Last Modified Date
-	Requester          *Reference          `json:"requester,omitempty" bson:"requester,omitempty"`                    // Who is asking for task to be done
-	RequestedPerformer []CodeableReference `json:"requestedPerformer,omitempty" bson:"requested_performer,omitempty"` // Who should perform the Task
-	Owner              *Reference          `json:"owner,omitempty" bson:"owner,omitempty"`                            // Responsible individual
-	Performer          []TaskPerformer     `json:"performer,omitempty" bson:"performer,omitempty"`                    // Who or what performed the task
-	Location           *Reference          `json:"location,omitempty" bson:"location,omitempty"`                      // Where task occurs
-	Reason             []CodeableReference `json:"reason,omitempty" bson:"reason,omitempty"`                          // Why task is needed
-	Insurance          []Reference         `json:"insurance,omitempty" bson:"insurance,omitempty"`                    // Associated insurance coverage
-	Note               []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                              // Comments made about the task
-	RelevantHistory    []Reference         `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`       // Key events in history of the Task
-	Restriction        *TaskRestriction    `json:"restriction,omitempty" bson:"restriction,omitempty"`                // Constraints on fulfillment tasks
-	Input              []TaskInput         `json:"input,omitempty" bson:"input,omitempty"`                            // Information used to perform task
-	Output             []TaskOutput        `json:"output,omitempty" bson:"output,omitempty"`                          // Information produced as part of task
+	ResourceType         string              `json:"resourceType" bson:"resource_type"`                                 // Type of resource
+	Id                   *string             `json:"id,omitempty" bson:"id,omitempty"`                                  // Logical id of this artifact
+	Meta                 *Meta               `json:"meta,omitempty" bson:"meta,omitempty"`                              // Metadata about the resource
+	ImplicitRules        *string             `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`           // A set of rules under which this content was created
+	ImplicitRulesElement *Element            `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`  // Extensions for implicitRules
+	Language             *string             `json:"language,omitempty" bson:"language,omitempty"`                      // Language of the resource content
+	LanguageElement      *Element            `json:"_language,omitempty" bson:"language_element,omitempty"`             // Extensions for language
+	Text                 *Narrative          `json:"text,omitempty" bson:"text,omitempty"`                              // Text summary of the resource, for human interpretation
+	Contained            []Resource          `json:"contained,omitempty" bson:"contained,omitempty"`                    // Contained, inline Resources
+	Extension            []Extension         `json:"extension,omitempty" bson:"extension,omitempty"`                    // Additional content defined by implementations
+	ModifierExtension    []Extension         `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`   // Extensions that cannot be ignored
+	Identifier           []Identifier        `json:"identifier,omitempty" bson:"identifier,omitempty"`                  // Task Instance Identifier
+	BasedOn              []Reference         `json:"basedOn,omitempty" bson:"based_on,omitempty"`                       // Request fulfilled by this task
+	GroupIdentifier      *Identifier         `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`       // Requisition or grouper id
+	PartOf               []Reference         `json:"partOf,omitempty" bson:"part_of,omitempty"`                         // Composite task
+	Status               TaskStatus          `json:"status" bson:"status"`                                              // draft | requested | received | accepted | +
+	StatusElement        *Element            `json:"_status,omitempty" bson:"status_element,omitempty"`                 // Extensions for status
+	StatusReason         []CodeableReference `json:"statusReason,omitempty" bson:"status_reason,omitempty"`             // Reason for current status
+	BusinessStatus       *CodeableConcept    `json:"businessStatus,omitempty" bson:"business_status,omitempty"`         // E.g. "Specimen collected", "IV prepped"
+	Intent               TaskIntent          `json:"intent" bson:"intent"`                                              // unknown | proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option
+	IntentElement        *Element            `json:"_intent,omitempty" bson:"intent_element,omitempty"`                 // Extensions for intent
+	Priority             *RequestPriority    `json:"priority,omitempty" bson:"priority,omitempty"`                      // routine | urgent | asap | stat
+	PriorityElement      *Element            `json:"_priority,omitempty" bson:"priority_element,omitempty"`             // Extensions for priority
+	DoNotPerform         *bool               `json:"doNotPerform,omitempty" bson:"do_not_perform,omitempty"`            // True if Task is prohibiting action
+	DoNotPerformElement  *Element            `json:"_doNotPerform,omitempty" bson:"do_not_perform_element,omitempty"`   // Extensions for doNotPerform
+	Code                 *CodeableConcept    `json:"code,omitempty" bson:"code,omitempty"`                              // Task Type
+	Description          *string             `json:"description,omitempty" bson:"description,omitempty"`                // Human-readable explanation of task
+	DescriptionElement   *Element            `json:"_description,omitempty" bson:"description_element,omitempty"`       // Extensions for description
+	Focus                []TaskFocus         `json:"focus,omitempty" bson:"focus,omitempty"`                            // What task is acting on
+	For                  *Reference          `json:"for,omitempty" bson:"for,omitempty"`                                // Beneficiary of the Task
+	Encounter            *Reference          `json:"encounter,omitempty" bson:"encounter,omitempty"`                    // Healthcare event during which this task originated
+	RequestedPeriod      *Period             `json:"requestedPeriod,omitempty" bson:"requested_period,omitempty"`       // When the task should be performed
+	ExecutionPeriod      *Period             `json:"executionPeriod,omitempty" bson:"execution_period,omitempty"`       // Start and end time of execution
+	AuthoredOn           *DateTime           `json:"authoredOn,omitempty" bson:"authored_on,omitempty"`                 // Task Creation Date
+	AuthoredOnElement    *Element            `json:"_authoredOn,omitempty" bson:"authored_on_element,omitempty"`        // Extensions for authoredOn
+	LastModified         *DateTime           `json:"lastModified,omitempty" bson:"last_modified,omitempty"`             // Task Last Modified Date
+	LastModifiedElement  *Element            `json:"_lastModified,omitempty" bson:"last_modified_element,omitempty"`    // Extensions for lastModified
+	Requester            *Reference          `json:"requester,omitempty" bson:"requester,omitempty"`                    // Who is asking for task to be done
+	RequestedPerformer   []CodeableReference `json:"requestedPerformer,omitempty" bson:"requested_performer,omitempty"` // Who should perform the Task
+	Owner                *Reference          `json:"owner,omitempty" bson:"owner,omitempty"`                            // Responsible individual
+	Performer            []TaskPerformer     `json:"performer,omitempty" bson:"performer,omitempty"`                    // Who or what performed the task
+	Location             *Reference          `json:"location,omitempty" bson:"location,omitempty"`                      // Where task occurs
+	Reason               []CodeableReference `json:"reason,omitempty" bson:"reason,omitempty"`                          // Why task is needed
+	Insurance            []Reference         `json:"insurance,omitempty" bson:"insurance,omitempty"`                    // Associated insurance coverage
+	Note                 []Annotation        `json:"note,omitempty" bson:"note,omitempty"`                              // Comments made about the task
+	RelevantHistory      []Reference         `json:"relevantHistory,omitempty" bson:"relevant_history,omitempty"`       // Key events in history of the Task
+	Restriction          *TaskRestriction    `json:"restriction,omitempty" bson:"restriction,omitempty"`                // Constraints on fulfillment tasks
+	Input                []TaskInput         `json:"input,omitempty" bson:"input,omitempty"`                            // Information used to perform task
+	Output               []TaskOutput        `json:"output,omitempty" bson:"output,omitempty"`                          // Information produced as part of task
 }
 
+var taskPartOfTargets = []string{"Task"}
+
+var taskEncounterTargets = []string{"Encounter"}
+
+var taskRequesterTargets = []string{"Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
+var taskOwnerTargets = []string{"CareTeam", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *Task) Validate() error {
 	if r.ResourceType != "Task" {
 		return fmt.Errorf("invalid resourceType: expected 'Task', got '%s'", r.ResourceType)
@@ -56,11 +76,39 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -76,15 +124,27 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, taskPartOfTargets); target != "" {
+			return fmt.Errorf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, taskPartOfTargets)
+		}
+	}
 	for i, item := range r.PartOf {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("PartOf[%d]: %w", i, err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
 	for i, item := range r.StatusReason {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("StatusReason[%d]: %w", i, err)
@@ -95,14 +155,40 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("BusinessStatus: %w", err)
 		}
 	}
-	if r.Intent == emptyString {
+	if r.Intent == "" {
 		return fmt.Errorf("field 'Intent' is required")
 	}
+	if !r.Intent.IsValid() {
+		return fmt.Errorf("field 'Intent' has invalid code '%s'", r.Intent)
+	}
+	if r.IntentElement != nil {
+		if err := r.IntentElement.Validate(); err != nil {
+			return fmt.Errorf("IntentElement: %w", err)
+		}
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
+	if r.DoNotPerformElement != nil {
+		if err := r.DoNotPerformElement.Validate(); err != nil {
+			return fmt.Errorf("DoNotPerformElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.Focus {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Focus[%d]: %w", i, err)
@@ -113,6 +199,11 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("For: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, taskEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, taskEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
@@ -128,6 +219,31 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("ExecutionPeriod: %w", err)
 		}
 	}
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOn: %w", err)
+		}
+	}
+	if r.AuthoredOnElement != nil {
+		if err := r.AuthoredOnElement.Validate(); err != nil {
+			return fmt.Errorf("AuthoredOnElement: %w", err)
+		}
+	}
+	if r.LastModified != nil {
+		if err := r.LastModified.Validate(); err != nil {
+			return fmt.Errorf("LastModified: %w", err)
+		}
+	}
+	if r.LastModifiedElement != nil {
+		if err := r.LastModifiedElement.Validate(); err != nil {
+			return fmt.Errorf("LastModifiedElement: %w", err)
+		}
+	}
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, taskRequesterTargets); target != "" {
+			return fmt.Errorf("field 'Requester' cannot refer to %s, expected one of %v", target, taskRequesterTargets)
+		}
+	}
 	if r.Requester != nil {
 		if err := r.Requester.Validate(); err != nil {
 			return fmt.Errorf("Requester: %w", err)
@@ -138,6 +254,11 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("RequestedPerformer[%d]: %w", i, err)
 		}
 	}
+	if r.Owner != nil {
+		if target := invalidReferenceTarget(r.Owner.Reference, r.Owner.Type, taskOwnerTargets); target != "" {
+			return fmt.Errorf("field 'Owner' cannot refer to %s, expected one of %v", target, taskOwnerTargets)
+		}
+	}
 	if r.Owner != nil {
 		if err := r.Owner.Validate(); err != nil {
 			return fmt.Errorf("Owner: %w", err)
@@ -188,454 +309,543 @@ func (r *Task) Validate() error {
 			return fmt.Errorf("Output[%d]: %w", i, err)
 		}
 	}
-	return nil
+	return validateInvariants(r, "Task")
 }
 
-type TaskOutput struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Type                       *CodeableConcept       `json:"type" bson:"type"`                                                // Label for output
-	ValueBase64Binary          *string                `json:"valueBase64Binary" bson:"value_base64_binary"`                    // Result of output
-	ValueBoolean               *bool                  `json:"valueBoolean" bson:"value_boolean"`                               // Result of output
-	ValueCanonical             *string                `json:"valueCanonical" bson:"value_canonical"`                           // Result of output
-	ValueCode                  *string                `json:"valueCode" bson:"value_code"`                                     // Result of output
-	ValueDate                  *string                `json:"valueDate" bson:"value_date"`                                     // Result of output
-	ValueDateTime              *string                `json:"valueDateTime" bson:"value_date_time"`                            // Result of output
-	ValueDecimal               *float64               `json:"valueDecimal" bson:"value_decimal"`                               // Result of output
-	ValueId                    *string                `json:"valueId" bson:"value_id"`                                         // Result of output
-	ValueInstant               *string                `json:"valueInstant" bson:"value_instant"`                               // Result of output
-	ValueInteger               *int                   `json:"valueInteger" bson:"value_integer"`                               // Result of output
-	ValueInteger64             *int64                 `json:"valueInteger64" bson:"value_integer64"`                           // Result of output
-	ValueMarkdown              *string                `json:"valueMarkdown" bson:"value_markdown"`                             // Result of output
-	ValueOid                   *string                `json:"valueOid" bson:"value_oid"`                                       // Result of output
-	ValuePositiveInt           *int                   `json:"valuePositiveInt" bson:"value_positive_int"`                      // Result of output
-	ValueString                *string                `json:"valueString" bson:"value_string"`                                 // Result of output
-	ValueTime                  *string                `json:"valueTime" bson:"value_time"`                                     // Result of output
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt" bson:"value_unsigned_int"`                      // Result of output
-	ValueUri                   *string                `json:"valueUri" bson:"value_uri"`                                       // Result of output
-	ValueUrl                   *string                `json:"valueUrl" bson:"value_url"`                                       // Result of output
-	ValueUuid                  *uuid                  `json:"valueUuid" bson:"value_uuid"`                                     // Result of output
-	ValueAddress               *Address               `json:"valueAddress" bson:"value_address"`                               // Result of output
-	ValueAge                   *Age                   `json:"valueAge" bson:"value_age"`                                       // Result of output
-	ValueAnnotation            *Annotation            `json:"valueAnnotation" bson:"value_annotation"`                         // Result of output
-	ValueAttachment            *Attachment            `json:"valueAttachment" bson:"value_attachment"`                         // Result of output
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept" bson:"value_codeable_concept"`              // Result of output
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference" bson:"value_codeable_reference"`          // Result of output
-	ValueCoding                *Coding                `json:"valueCoding" bson:"value_coding"`                                 // Result of output
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint" bson:"value_contact_point"`                    // Result of output
-	ValueCount                 *Count                 `json:"valueCount" bson:"value_count"`                                   // Result of output
-	ValueDistance              *Distance              `json:"valueDistance" bson:"value_distance"`                             // Result of output
-	ValueDuration              *Duration              `json:"valueDuration" bson:"value_duration"`                             // Result of output
-	ValueHumanName             *HumanName             `json:"valueHumanName" bson:"value_human_name"`                          // Result of output
-	ValueIdentifier            *Identifier            `json:"valueIdentifier" bson:"value_identifier"`                         // Result of output
-	ValueMoney                 *Money                 `json:"valueMoney" bson:"value_money"`                                   // Result of output
-	ValuePeriod                *Period                `json:"valuePeriod" bson:"value_period"`                                 // Result of output
-	ValueQuantity              *Quantity              `json:"valueQuantity" bson:"value_quantity"`                             // Result of output
-	ValueRange                 *Range                 `json:"valueRange" bson:"value_range"`                                   // Result of output
-	ValueRatio                 *Ratio                 `json:"valueRatio" bson:"value_ratio"`                                   // Result of output
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange" bson:"value_ratio_range"`                        // Result of output
-	ValueReference             *Reference             `json:"valueReference" bson:"value_reference"`                           // Result of output
-	ValueSampledData           *SampledData           `json:"valueSampledData" bson:"value_sampled_data"`                      // Result of output
-	ValueSignature             *Signature             `json:"valueSignature" bson:"value_signature"`                           // Result of output
-	ValueTiming                *Timing                `json:"valueTiming" bson:"value_timing"`                                 // Result of output
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail" bson:"value_contact_detail"`                  // Result of output
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement" bson:"value_data_requirement"`              // Result of output
-	ValueExpression            *Expression            `json:"valueExpression" bson:"value_expression"`                         // Result of output
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition" bson:"value_parameter_definition"`      // Result of output
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact" bson:"value_related_artifact"`              // Result of output
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition" bson:"value_trigger_definition"`          // Result of output
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext" bson:"value_usage_context"`                    // Result of output
-	ValueAvailability          *Availability          `json:"valueAvailability" bson:"value_availability"`                     // Result of output
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail" bson:"value_extended_contact_detail"` // Result of output
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail" bson:"value_virtual_service_detail"`   // Result of output
-	ValueDosage                *Dosage                `json:"valueDosage" bson:"value_dosage"`                                 // Result of output
-	ValueMeta                  *Meta                  `json:"valueMeta" bson:"value_meta"`                                     // Result of output
-}
-
-func (r *TaskOutput) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.ValueBase64Binary == nil {
-		return fmt.Errorf("field 'ValueBase64Binary' is required")
-	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
-	}
-	if r.ValueCanonical == nil {
-		return fmt.Errorf("field 'ValueCanonical' is required")
-	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
-	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
-	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
-	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
-	}
-	if r.ValueId == nil {
-		return fmt.Errorf("field 'ValueId' is required")
-	}
-	if r.ValueInstant == nil {
-		return fmt.Errorf("field 'ValueInstant' is required")
-	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
-	}
-	if r.ValueInteger64 == nil {
-		return fmt.Errorf("field 'ValueInteger64' is required")
-	}
-	if r.ValueMarkdown == nil {
-		return fmt.Errorf("field 'ValueMarkdown' is required")
-	}
-	if r.ValueOid == nil {
-		return fmt.Errorf("field 'ValueOid' is required")
-	}
-	if r.ValuePositiveInt == nil {
-		return fmt.Errorf("field 'ValuePositiveInt' is required")
-	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
-	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
-	}
-	if r.ValueUnsignedInt == nil {
-		return fmt.Errorf("field 'ValueUnsignedInt' is required")
-	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
+func (r *Task) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "Task" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'Task', got '%s'", r.ResourceType))
 	}
-	if r.ValueUrl == nil {
-		return fmt.Errorf("field 'ValueUrl' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.ValueUuid == nil {
-		return fmt.Errorf("field 'ValueUuid' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	if r.ValueAddress == nil {
-		return fmt.Errorf("field 'ValueAddress' is required")
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	if r.ValueAge == nil {
-		return fmt.Errorf("field 'ValueAge' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueAnnotation == nil {
-		return fmt.Errorf("field 'ValueAnnotation' is required")
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
 	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
 	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
+	for i, item := range r.PartOf {
+		if target := invalidReferenceTarget(item.Reference, item.Type, taskPartOfTargets); target != "" {
+			issues.add("value", fmt.Sprintf("%s.partOf[%d]", path, i), fmt.Sprintf("field 'PartOf[%d]' cannot refer to %s, expected one of %v", i, target, taskPartOfTargets))
 		}
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
+	for i, item := range r.PartOf {
+		item.validateAll(fmt.Sprintf("%s.partOf[%d]", path, i), issues)
 	}
-	if r.ValueCodeableReference == nil {
-		return fmt.Errorf("field 'ValueCodeableReference' is required")
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
-		}
+	for i, item := range r.StatusReason {
+		item.validateAll(fmt.Sprintf("%s.statusReason[%d]", path, i), issues)
 	}
-	if r.ValueContactPoint == nil {
-		return fmt.Errorf("field 'ValueContactPoint' is required")
+	if r.BusinessStatus != nil {
+		r.BusinessStatus.validateAll(path+".businessStatus", issues)
 	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
-		}
+	if r.Intent == "" {
+		issues.add("required", path+".intent", "field 'Intent' is required")
 	}
-	if r.ValueCount == nil {
-		return fmt.Errorf("field 'ValueCount' is required")
+	if r.Intent != "" && !r.Intent.IsValid() {
+		issues.add("code-invalid", path+".intent", fmt.Sprintf("field 'Intent' has invalid code '%s'", r.Intent))
 	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
-		}
+	if r.IntentElement != nil {
+		r.IntentElement.validateAll(path+".intent", issues)
 	}
-	if r.ValueDistance == nil {
-		return fmt.Errorf("field 'ValueDistance' is required")
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
 	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
-		}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
 	}
-	if r.ValueDuration == nil {
-		return fmt.Errorf("field 'ValueDuration' is required")
+	if r.DoNotPerformElement != nil {
+		r.DoNotPerformElement.validateAll(path+".doNotPerform", issues)
 	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
-		}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
 	}
-	if r.ValueHumanName == nil {
-		return fmt.Errorf("field 'ValueHumanName' is required")
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
-		}
+	for i, item := range r.Focus {
+		item.validateAll(fmt.Sprintf("%s.focus[%d]", path, i), issues)
 	}
-	if r.ValueIdentifier == nil {
-		return fmt.Errorf("field 'ValueIdentifier' is required")
+	if r.For != nil {
+		r.For.validateAll(path+".for", issues)
 	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, taskEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, taskEncounterTargets))
 		}
 	}
-	if r.ValueMoney == nil {
-		return fmt.Errorf("field 'ValueMoney' is required")
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
 	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
+	if r.RequestedPeriod != nil {
+		r.RequestedPeriod.validateAll(path+".requestedPeriod", issues)
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
+	if r.ExecutionPeriod != nil {
+		r.ExecutionPeriod.validateAll(path+".executionPeriod", issues)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
+	if r.AuthoredOn != nil {
+		if err := r.AuthoredOn.Validate(); err != nil {
+			issues.add("value", path+".authoredOn", err.Error())
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	if r.AuthoredOnElement != nil {
+		r.AuthoredOnElement.validateAll(path+".authoredOn", issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+	if r.LastModified != nil {
+		if err := r.LastModified.Validate(); err != nil {
+			issues.add("value", path+".lastModified", err.Error())
 		}
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.LastModifiedElement != nil {
+		r.LastModifiedElement.validateAll(path+".lastModified", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	if r.Requester != nil {
+		if target := invalidReferenceTarget(r.Requester.Reference, r.Requester.Type, taskRequesterTargets); target != "" {
+			issues.add("value", path+".requester", fmt.Sprintf("field 'Requester' cannot refer to %s, expected one of %v", target, taskRequesterTargets))
 		}
 	}
-	if r.ValueRatio == nil {
-		return fmt.Errorf("field 'ValueRatio' is required")
-	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
-		}
+	if r.Requester != nil {
+		r.Requester.validateAll(path+".requester", issues)
 	}
-	if r.ValueRatioRange == nil {
-		return fmt.Errorf("field 'ValueRatioRange' is required")
+	for i, item := range r.RequestedPerformer {
+		item.validateAll(fmt.Sprintf("%s.requestedPerformer[%d]", path, i), issues)
 	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
+	if r.Owner != nil {
+		if target := invalidReferenceTarget(r.Owner.Reference, r.Owner.Type, taskOwnerTargets); target != "" {
+			issues.add("value", path+".owner", fmt.Sprintf("field 'Owner' cannot refer to %s, expected one of %v", target, taskOwnerTargets))
 		}
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.Owner != nil {
+		r.Owner.validateAll(path+".owner", issues)
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	for i, item := range r.Performer {
+		item.validateAll(fmt.Sprintf("%s.performer[%d]", path, i), issues)
 	}
-	if r.ValueSampledData == nil {
-		return fmt.Errorf("field 'ValueSampledData' is required")
+	if r.Location != nil {
+		r.Location.validateAll(path+".location", issues)
 	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
-		}
+	for i, item := range r.Reason {
+		item.validateAll(fmt.Sprintf("%s.reason[%d]", path, i), issues)
 	}
-	if r.ValueSignature == nil {
-		return fmt.Errorf("field 'ValueSignature' is required")
+	for i, item := range r.Insurance {
+		item.validateAll(fmt.Sprintf("%s.insurance[%d]", path, i), issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
 	}
-	if r.ValueTiming == nil {
-		return fmt.Errorf("field 'ValueTiming' is required")
+	for i, item := range r.RelevantHistory {
+		item.validateAll(fmt.Sprintf("%s.relevantHistory[%d]", path, i), issues)
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.Restriction != nil {
+		r.Restriction.validateAll(path+".restriction", issues)
 	}
-	if r.ValueContactDetail == nil {
-		return fmt.Errorf("field 'ValueContactDetail' is required")
+	for i, item := range r.Input {
+		item.validateAll(fmt.Sprintf("%s.input[%d]", path, i), issues)
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+	for i, item := range r.Output {
+		item.validateAll(fmt.Sprintf("%s.output[%d]", path, i), issues)
 	}
-	if r.ValueDataRequirement == nil {
-		return fmt.Errorf("field 'ValueDataRequirement' is required")
+}
+
+func (r *Task) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Task", &issues)
+	checkInvariants(r, "Task", &issues)
+	return issues
+}
+
+var taskInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *Task) invariants() []invariant {
+	return taskInvariants
+}
+
+func (r *Task) UnmarshalJSON(data []byte) error {
+	type alias Task
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	return nil
+}
+
+func (r Task) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Task) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Task) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Task) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var taskElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"description":   "markdown",
+}
+
+func (r *Task) elementTypes() map[string]string {
+	return taskElementTypes
+}
+
+var taskElementMetadata = []ElementMetadata{
+	{Path: "Task.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "Task.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "Task.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "Task.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Task.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "Task.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "Task.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "Task.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Task.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Task.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "Task.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Task.groupIdentifier", Name: "groupIdentifier", Field: 13, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "Task.partOf", Name: "partOf", Field: 14, Min: 0, Max: "*", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Task"}},
+	{Path: "Task.status", Name: "status", Field: 15, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Task.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/task-status"}},
+	{Path: "Task.statusReason", Name: "statusReason", Field: 17, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Task.businessStatus", Name: "businessStatus", Field: 18, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Task.intent", Name: "intent", Field: 19, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Task.intent", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/task-intent"}},
+	{Path: "Task.priority", Name: "priority", Field: 21, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Task.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "Task.doNotPerform", Name: "doNotPerform", Field: 23, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "Task.code", Name: "code", Field: 25, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Task.description", Name: "description", Field: 26, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "Task.focus", Name: "focus", Field: 28, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Task.for", Name: "for", Field: 29, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Account", "http://hl7.org/fhir/StructureDefinition/ActivityDefinition", "http://hl7.org/fhir/StructureDefinition/ActorDefinition", "http://hl7.org/fhir/StructureDefinition/AdministrableProductDefinition", "http://hl7.org/fhir/StructureDefinition/AdverseEvent", "http://hl7.org/fhir/StructureDefinition/AllergyIntolerance", "http://hl7.org/fhir/StructureDefinition/Appointment", "http://hl7.org/fhir/StructureDefinition/AppointmentResponse", "http://hl7.org/fhir/StructureDefinition/ArtifactAssessment", "http://hl7.org/fhir/StructureDefinition/AuditEvent", "http://hl7.org/fhir/StructureDefinition/Basic", "http://hl7.org/fhir/StructureDefinition/Binary", "http://hl7.org/fhir/StructureDefinition/BiologicallyDerivedProduct", "http://hl7.org/fhir/StructureDefinition/BodyStructure", "http://hl7.org/fhir/StructureDefinition/Bundle", "http://hl7.org/fhir/StructureDefinition/CapabilityStatement", "http://hl7.org/fhir/StructureDefinition/CarePlan", "http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Claim", "http://hl7.org/fhir/StructureDefinition/ClaimResponse", "http://hl7.org/fhir/StructureDefinition/ClinicalUseDefinition", "http://hl7.org/fhir/StructureDefinition/CodeSystem", "http://hl7.org/fhir/StructureDefinition/Communication", "http://hl7.org/fhir/StructureDefinition/CommunicationRequest", "http://hl7.org/fhir/StructureDefinition/CompartmentDefinition", "http://hl7.org/fhir/StructureDefinition/Composition", "http://hl7.org/fhir/StructureDefinition/ConceptMap", "http://hl7.org/fhir/StructureDefinition/Condition", "http://hl7.org/fhir/StructureDefinition/Consent", "http://hl7.org/fhir/StructureDefinition/Contract", "http://hl7.org/fhir/StructureDefinition/Coverage", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityRequest", "http://hl7.org/fhir/StructureDefinition/CoverageEligibilityResponse", "http://hl7.org/fhir/StructureDefinition/DetectedIssue", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/DeviceAlert", "http://hl7.org/fhir/StructureDefinition/DeviceAssociation", "http://hl7.org/fhir/StructureDefinition/DeviceDefinition", "http://hl7.org/fhir/StructureDefinition/DeviceMetric", "http://hl7.org/fhir/StructureDefinition/DeviceRequest", "http://hl7.org/fhir/StructureDefinition/DiagnosticReport", "http://hl7.org/fhir/StructureDefinition/DocumentReference", "http://hl7.org/fhir/StructureDefinition/Encounter", "http://hl7.org/fhir/StructureDefinition/Endpoint", "http://hl7.org/fhir/StructureDefinition/EnrollmentRequest", "http://hl7.org/fhir/StructureDefinition/EnrollmentResponse", "http://hl7.org/fhir/StructureDefinition/EpisodeOfCare", "http://hl7.org/fhir/StructureDefinition/EventDefinition", "http://hl7.org/fhir/StructureDefinition/Evidence", "http://hl7.org/fhir/StructureDefinition/EvidenceVariable", "http://hl7.org/fhir/StructureDefinition/ExampleScenario", "http://hl7.org/fhir/StructureDefinition/ExplanationOfBenefit", "http://hl7.org/fhir/StructureDefinition/FamilyMemberHistory", "http://hl7.org/fhir/StructureDefinition/Flag", "http://hl7.org/fhir/StructureDefinition/Goal", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/GuidanceResponse", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/ImagingSelection", "http://hl7.org/fhir/StructureDefinition/ImagingStudy", "http://hl7.org/fhir/StructureDefinition/Immunization", "http://hl7.org/fhir/StructureDefinition/ImplementationGuide", "http://hl7.org/fhir/StructureDefinition/Ingredient", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/InsuranceProduct", "http://hl7.org/fhir/StructureDefinition/Invoice", "http://hl7.org/fhir/StructureDefinition/Library", "http://hl7.org/fhir/StructureDefinition/List", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/ManufacturedItemDefinition", "http://hl7.org/fhir/StructureDefinition/Measure", "http://hl7.org/fhir/StructureDefinition/MeasureReport", "http://hl7.org/fhir/StructureDefinition/Medication", "http://hl7.org/fhir/StructureDefinition/MedicationAdministration", "http://hl7.org/fhir/StructureDefinition/MedicationDispense", "http://hl7.org/fhir/StructureDefinition/MedicationRequest", "http://hl7.org/fhir/StructureDefinition/MedicationStatement", "http://hl7.org/fhir/StructureDefinition/MedicinalProductDefinition", "http://hl7.org/fhir/StructureDefinition/MessageDefinition", "http://hl7.org/fhir/StructureDefinition/MessageHeader", "http://hl7.org/fhir/StructureDefinition/NamingSystem", "http://hl7.org/fhir/StructureDefinition/NutritionIntake", "http://hl7.org/fhir/StructureDefinition/NutritionOrder", "http://hl7.org/fhir/StructureDefinition/NutritionProduct", "http://hl7.org/fhir/StructureDefinition/Observation", "http://hl7.org/fhir/StructureDefinition/ObservationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationDefinition", "http://hl7.org/fhir/StructureDefinition/OperationOutcome", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/OrganizationAffiliation", "http://hl7.org/fhir/StructureDefinition/PackagedProductDefinition", "http://hl7.org/fhir/StructureDefinition/Parameters", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/PaymentNotice", "http://hl7.org/fhir/StructureDefinition/PaymentReconciliation", "http://hl7.org/fhir/StructureDefinition/Person", "http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/Procedure", "http://hl7.org/fhir/StructureDefinition/Provenance", "http://hl7.org/fhir/StructureDefinition/Questionnaire", "http://hl7.org/fhir/StructureDefinition/QuestionnaireResponse", "http://hl7.org/fhir/StructureDefinition/RegulatedAuthorization", "http://hl7.org/fhir/StructureDefinition/RelatedPerson", "http://hl7.org/fhir/StructureDefinition/RequestOrchestration", "http://hl7.org/fhir/StructureDefinition/Requirements", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/ResearchSubject", "http://hl7.org/fhir/StructureDefinition/RiskAssessment", "http://hl7.org/fhir/StructureDefinition/Schedule", "http://hl7.org/fhir/StructureDefinition/SearchParameter", "http://hl7.org/fhir/StructureDefinition/ServiceRequest", "http://hl7.org/fhir/StructureDefinition/Slot", "http://hl7.org/fhir/StructureDefinition/Specimen", "http://hl7.org/fhir/StructureDefinition/SpecimenDefinition", "http://hl7.org/fhir/StructureDefinition/StructureDefinition", "http://hl7.org/fhir/StructureDefinition/StructureMap", "http://hl7.org/fhir/StructureDefinition/Subscription", "http://hl7.org/fhir/StructureDefinition/SubscriptionStatus", "http://hl7.org/fhir/StructureDefinition/SubscriptionTopic", "http://hl7.org/fhir/StructureDefinition/Substance", "http://hl7.org/fhir/StructureDefinition/SubstanceDefinition", "http://hl7.org/fhir/StructureDefinition/Task", "http://hl7.org/fhir/StructureDefinition/TerminologyCapabilities", "http://hl7.org/fhir/StructureDefinition/ValueSet", "http://hl7.org/fhir/StructureDefinition/VisionPrescription"}},
+	{Path: "Task.encounter", Name: "encounter", Field: 30, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "Task.requestedPeriod", Name: "requestedPeriod", Field: 31, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Task.executionPeriod", Name: "executionPeriod", Field: 32, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Task.authoredOn", Name: "authoredOn", Field: 33, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Task.lastModified", Name: "lastModified", Field: 35, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "Task.requester", Name: "requester", Field: 37, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Task.requestedPerformer", Name: "requestedPerformer", Field: 38, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Task.owner", Name: "owner", Field: 39, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+	{Path: "Task.performer", Name: "performer", Field: 40, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Task.location", Name: "location", Field: 41, Min: 0, Max: "1", Types: []string{"Reference"}},
+	{Path: "Task.reason", Name: "reason", Field: 42, Min: 0, Max: "*", Types: []string{"CodeableReference"}},
+	{Path: "Task.insurance", Name: "insurance", Field: 43, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Task.note", Name: "note", Field: 44, Min: 0, Max: "*", Types: []string{"Annotation"}},
+	{Path: "Task.relevantHistory", Name: "relevantHistory", Field: 45, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "Task.restriction", Name: "restriction", Field: 46, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "Task.input", Name: "input", Field: 47, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "Task.output", Name: "output", Field: 48, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *Task) ElementMetadata() []ElementMetadata {
+	return taskElementMetadata
+}
+
+func (r *Task) GetResourceType() string {
+	return "Task"
+}
+
+func (r *Task) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	if r.ValueExpression == nil {
-		return fmt.Errorf("field 'ValueExpression' is required")
+	return *r.Id
+}
+
+func (r *Task) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
+	r.Id = &id
+}
+
+func (r *Task) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *Task) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *Task) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *Task) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *Task) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*Task)(nil)
+
+type TaskFocus struct {
+	Id                *string        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Value             TaskFocusValue `json:"-" bson:"value,omitempty"`                                        // What task is acting on
+	ValueElement      *Element       `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Task.focus.value[x] when more than one was decoded
+}
+
+func (r *TaskFocus) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueParameterDefinition == nil {
-		return fmt.Errorf("field 'ValueParameterDefinition' is required")
-	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueRelatedArtifact == nil {
-		return fmt.Errorf("field 'ValueRelatedArtifact' is required")
-	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueTriggerDefinition == nil {
-		return fmt.Errorf("field 'ValueTriggerDefinition' is required")
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueUsageContext == nil {
-		return fmt.Errorf("field 'ValueUsageContext' is required")
-	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueAvailability == nil {
-		return fmt.Errorf("field 'ValueAvailability' is required")
+	return nil
+}
+
+func (r *TaskFocus) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueExtendedContactDetail == nil {
-		return fmt.Errorf("field 'ValueExtendedContactDetail' is required")
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
 	}
-	if r.ValueVirtualServiceDetail == nil {
-		return fmt.Errorf("field 'ValueVirtualServiceDetail' is required")
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
-	if r.ValueDosage == nil {
-		return fmt.Errorf("field 'ValueDosage' is required")
+}
+
+var taskFocusInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TaskFocus) invariants() []invariant {
+	return taskFocusInvariants
+}
+
+func (r TaskFocus) MarshalJSON() ([]byte, error) {
+	type alias TaskFocus
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *TaskFocus) UnmarshalJSON(data []byte) error {
+	type alias TaskFocus
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueMeta == nil {
-		return fmt.Errorf("field 'ValueMeta' is required")
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", taskFocusValueVariants); err != nil {
+		return err
 	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
 	return nil
 }
 
-type TaskFocus struct {
-	Id             *string    `json:"id,omitempty" bson:"id,omitempty"`      // Unique id for inter-element referencing
-	ValueReference *Reference `json:"valueReference" bson:"value_reference"` // What task is acting on
-	ValueCanonical *string    `json:"valueCanonical" bson:"value_canonical"` // What task is acting on
+func (r TaskFocus) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *TaskFocus) Validate() error {
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
-	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
-	}
-	if r.ValueCanonical == nil {
-		return fmt.Errorf("field 'ValueCanonical' is required")
+func (r *TaskFocus) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TaskFocus) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TaskFocus) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *TaskFocus) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(taskFocusValueVariants)
 	}
+	return "", nil
+}
+
+var taskFocusElementMetadata = []ElementMetadata{
+	{Path: "Task.focus.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Task.focus.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Task.focus.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Task.focus.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"Reference", "canonical"}},
+}
+
+func (r *TaskFocus) ElementMetadata() []ElementMetadata {
+	return taskFocusElementMetadata
+}
+
+// TaskFocusValue is the type of Task.focus.value[x]. It is implemented by the TaskFocusValue* variant types.
+type TaskFocusValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isTaskFocusValue()
+}
+
+// TaskFocusValueReference is the Reference variant of TaskFocusValue.
+type TaskFocusValueReference struct {
+	Reference
+}
+
+func (v TaskFocusValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v TaskFocusValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v TaskFocusValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v TaskFocusValueReference) isTaskFocusValue() {}
+
+// TaskFocusValueCanonical is the canonical variant of TaskFocusValue.
+type TaskFocusValueCanonical string
+
+func (v TaskFocusValueCanonical) Validate() error {
 	return nil
 }
 
+func (v TaskFocusValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskFocusValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v TaskFocusValueCanonical) isTaskFocusValue() {}
+
+var taskFocusValueVariants = []TaskFocusValue{
+	TaskFocusValueReference{},
+	TaskFocusValueCanonical(""),
+}
+
 type TaskPerformer struct {
-	Id       *string          `json:"id,omitempty" bson:"id,omitempty"`             // Unique id for inter-element referencing
-	Function *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"` // Type of performance
-	Actor    *Reference       `json:"actor" bson:"actor"`                           // Who performed the task
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Function          *CodeableConcept `json:"function,omitempty" bson:"function,omitempty"`                    // Type of performance
+	Actor             *Reference       `json:"actor" bson:"actor"`                                              // Who performed the task
 }
 
+var taskPerformerActorTargets = []string{"CareTeam", "Device", "Group", "Organization", "Patient", "Practitioner", "PractitionerRole", "RelatedPerson"}
+
 func (r *TaskPerformer) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Function != nil {
 		if err := r.Function.Validate(); err != nil {
 			return fmt.Errorf("Function: %w", err)
@@ -644,6 +854,11 @@ func (r *TaskPerformer) Validate() error {
 	if r.Actor == nil {
 		return fmt.Errorf("field 'Actor' is required")
 	}
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, taskPerformerActorTargets); target != "" {
+			return fmt.Errorf("field 'Actor' cannot refer to %s, expected one of %v", target, taskPerformerActorTargets)
+		}
+	}
 	if r.Actor != nil {
 		if err := r.Actor.Validate(); err != nil {
 			return fmt.Errorf("Actor: %w", err)
@@ -652,440 +867,2615 @@ func (r *TaskPerformer) Validate() error {
 	return nil
 }
 
-type TaskRestriction struct {
-	Id          *string     `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Repetitions *int        `json:"repetitions,omitempty" bson:"repetitions,omitempty"` // How many times to repeat
-	Period      *Period     `json:"period,omitempty" bson:"period,omitempty"`           // When fulfillment is sought
-	Recipient   []Reference `json:"recipient,omitempty" bson:"recipient,omitempty"`     // Individual or entity from whom fulfillment is being sought
-}
-
-func (r *TaskRestriction) Validate() error {
-	if r.Period != nil {
-		if err := r.Period.Validate(); err != nil {
-			return fmt.Errorf("Period: %w", err)
-		}
+func (r *TaskPerformer) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	for i, item := range r.Recipient {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Recipient[%d]: %w", i, err)
-		}
-	}
-	return nil
-}
-
-type TaskInput struct {
-	Id                         *string                `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Type                       *CodeableConcept       `json:"type" bson:"type"`                                                // Label for the input
-	ValueBase64Binary          *string                `json:"valueBase64Binary" bson:"value_base64_binary"`                    // Content to use in performing the task
-	ValueBoolean               *bool                  `json:"valueBoolean" bson:"value_boolean"`                               // Content to use in performing the task
-	ValueCanonical             *string                `json:"valueCanonical" bson:"value_canonical"`                           // Content to use in performing the task
-	ValueCode                  *string                `json:"valueCode" bson:"value_code"`                                     // Content to use in performing the task
-	ValueDate                  *string                `json:"valueDate" bson:"value_date"`                                     // Content to use in performing the task
-	ValueDateTime              *string                `json:"valueDateTime" bson:"value_date_time"`                            // Content to use in performing the task
-	ValueDecimal               *float64               `json:"valueDecimal" bson:"value_decimal"`                               // Content to use in performing the task
-	ValueId                    *string                `json:"valueId" bson:"value_id"`                                         // Content to use in performing the task
-	ValueInstant               *string                `json:"valueInstant" bson:"value_instant"`                               // Content to use in performing the task
-	ValueInteger               *int                   `json:"valueInteger" bson:"value_integer"`                               // Content to use in performing the task
-	ValueInteger64             *int64                 `json:"valueInteger64" bson:"value_integer64"`                           // Content to use in performing the task
-	ValueMarkdown              *string                `json:"valueMarkdown" bson:"value_markdown"`                             // Content to use in performing the task
-	ValueOid                   *string                `json:"valueOid" bson:"value_oid"`                                       // Content to use in performing the task
-	ValuePositiveInt           *int                   `json:"valuePositiveInt" bson:"value_positive_int"`                      // Content to use in performing the task
-	ValueString                *string                `json:"valueString" bson:"value_string"`                                 // Content to use in performing the task
-	ValueTime                  *string                `json:"valueTime" bson:"value_time"`                                     // Content to use in performing the task
-	ValueUnsignedInt           *int                   `json:"valueUnsignedInt" bson:"value_unsigned_int"`                      // Content to use in performing the task
-	ValueUri                   *string                `json:"valueUri" bson:"value_uri"`                                       // Content to use in performing the task
-	ValueUrl                   *string                `json:"valueUrl" bson:"value_url"`                                       // Content to use in performing the task
-	ValueUuid                  *uuid                  `json:"valueUuid" bson:"value_uuid"`                                     // Content to use in performing the task
-	ValueAddress               *Address               `json:"valueAddress" bson:"value_address"`                               // Content to use in performing the task
-	ValueAge                   *Age                   `json:"valueAge" bson:"value_age"`                                       // Content to use in performing the task
-	ValueAnnotation            *Annotation            `json:"valueAnnotation" bson:"value_annotation"`                         // Content to use in performing the task
-	ValueAttachment            *Attachment            `json:"valueAttachment" bson:"value_attachment"`                         // Content to use in performing the task
-	ValueCodeableConcept       *CodeableConcept       `json:"valueCodeableConcept" bson:"value_codeable_concept"`              // Content to use in performing the task
-	ValueCodeableReference     *CodeableReference     `json:"valueCodeableReference" bson:"value_codeable_reference"`          // Content to use in performing the task
-	ValueCoding                *Coding                `json:"valueCoding" bson:"value_coding"`                                 // Content to use in performing the task
-	ValueContactPoint          *ContactPoint          `json:"valueContactPoint" bson:"value_contact_point"`                    // Content to use in performing the task
-	ValueCount                 *Count                 `json:"valueCount" bson:"value_count"`                                   // Content to use in performing the task
-	ValueDistance              *Distance              `json:"valueDistance" bson:"value_distance"`                             // Content to use in performing the task
-	ValueDuration              *Duration              `json:"valueDuration" bson:"value_duration"`                             // Content to use in performing the task
-	ValueHumanName             *HumanName             `json:"valueHumanName" bson:"value_human_name"`                          // Content to use in performing the task
-	ValueIdentifier            *Identifier            `json:"valueIdentifier" bson:"value_identifier"`                         // Content to use in performing the task
-	ValueMoney                 *Money                 `json:"valueMoney" bson:"value_money"`                                   // Content to use in performing the task
-	ValuePeriod                *Period                `json:"valuePeriod" bson:"value_period"`                                 // Content to use in performing the task
-	ValueQuantity              *Quantity              `json:"valueQuantity" bson:"value_quantity"`                             // Content to use in performing the task
-	ValueRange                 *Range                 `json:"valueRange" bson:"value_range"`                                   // Content to use in performing the task
-	ValueRatio                 *Ratio                 `json:"valueRatio" bson:"value_ratio"`                                   // Content to use in performing the task
-	ValueRatioRange            *RatioRange            `json:"valueRatioRange" bson:"value_ratio_range"`                        // Content to use in performing the task
-	ValueReference             *Reference             `json:"valueReference" bson:"value_reference"`                           // Content to use in performing the task
-	ValueSampledData           *SampledData           `json:"valueSampledData" bson:"value_sampled_data"`                      // Content to use in performing the task
-	ValueSignature             *Signature             `json:"valueSignature" bson:"value_signature"`                           // Content to use in performing the task
-	ValueTiming                *Timing                `json:"valueTiming" bson:"value_timing"`                                 // Content to use in performing the task
-	ValueContactDetail         *ContactDetail         `json:"valueContactDetail" bson:"value_contact_detail"`                  // Content to use in performing the task
-	ValueDataRequirement       *DataRequirement       `json:"valueDataRequirement" bson:"value_data_requirement"`              // Content to use in performing the task
-	ValueExpression            *Expression            `json:"valueExpression" bson:"value_expression"`                         // Content to use in performing the task
-	ValueParameterDefinition   *ParameterDefinition   `json:"valueParameterDefinition" bson:"value_parameter_definition"`      // Content to use in performing the task
-	ValueRelatedArtifact       *RelatedArtifact       `json:"valueRelatedArtifact" bson:"value_related_artifact"`              // Content to use in performing the task
-	ValueTriggerDefinition     *TriggerDefinition     `json:"valueTriggerDefinition" bson:"value_trigger_definition"`          // Content to use in performing the task
-	ValueUsageContext          *UsageContext          `json:"valueUsageContext" bson:"value_usage_context"`                    // Content to use in performing the task
-	ValueAvailability          *Availability          `json:"valueAvailability" bson:"value_availability"`                     // Content to use in performing the task
-	ValueExtendedContactDetail *ExtendedContactDetail `json:"valueExtendedContactDetail" bson:"value_extended_contact_detail"` // Content to use in performing the task
-	ValueVirtualServiceDetail  *VirtualServiceDetail  `json:"valueVirtualServiceDetail" bson:"value_virtual_service_detail"`   // Content to use in performing the task
-	ValueDosage                *Dosage                `json:"valueDosage" bson:"value_dosage"`                                 // Content to use in performing the task
-	ValueMeta                  *Meta                  `json:"valueMeta" bson:"value_meta"`                                     // Content to use in performing the task
-}
-
-func (r *TaskInput) Validate() error {
-	if r.Type == nil {
-		return fmt.Errorf("field 'Type' is required")
-	}
-	if r.Type != nil {
-		if err := r.Type.Validate(); err != nil {
-			return fmt.Errorf("Type: %w", err)
-		}
-	}
-	if r.ValueBase64Binary == nil {
-		return fmt.Errorf("field 'ValueBase64Binary' is required")
-	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
-	}
-	if r.ValueCanonical == nil {
-		return fmt.Errorf("field 'ValueCanonical' is required")
-	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
-	}
-	if r.ValueDate == nil {
-		return fmt.Errorf("field 'ValueDate' is required")
-	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
-	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
-	}
-	if r.ValueId == nil {
-		return fmt.Errorf("field 'ValueId' is required")
-	}
-	if r.ValueInstant == nil {
-		return fmt.Errorf("field 'ValueInstant' is required")
-	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
-	}
-	if r.ValueInteger64 == nil {
-		return fmt.Errorf("field 'ValueInteger64' is required")
-	}
-	if r.ValueMarkdown == nil {
-		return fmt.Errorf("field 'ValueMarkdown' is required")
-	}
-	if r.ValueOid == nil {
-		return fmt.Errorf("field 'ValueOid' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValuePositiveInt == nil {
-		return fmt.Errorf("field 'ValuePositiveInt' is required")
-	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
-	}
-	if r.ValueTime == nil {
-		return fmt.Errorf("field 'ValueTime' is required")
-	}
-	if r.ValueUnsignedInt == nil {
-		return fmt.Errorf("field 'ValueUnsignedInt' is required")
-	}
-	if r.ValueUri == nil {
-		return fmt.Errorf("field 'ValueUri' is required")
-	}
-	if r.ValueUrl == nil {
-		return fmt.Errorf("field 'ValueUrl' is required")
-	}
-	if r.ValueUuid == nil {
-		return fmt.Errorf("field 'ValueUuid' is required")
-	}
-	if r.ValueUuid != nil {
-		if err := r.ValueUuid.Validate(); err != nil {
-			return fmt.Errorf("ValueUuid: %w", err)
-		}
-	}
-	if r.ValueAddress == nil {
-		return fmt.Errorf("field 'ValueAddress' is required")
-	}
-	if r.ValueAddress != nil {
-		if err := r.ValueAddress.Validate(); err != nil {
-			return fmt.Errorf("ValueAddress: %w", err)
-		}
-	}
-	if r.ValueAge == nil {
-		return fmt.Errorf("field 'ValueAge' is required")
-	}
-	if r.ValueAge != nil {
-		if err := r.ValueAge.Validate(); err != nil {
-			return fmt.Errorf("ValueAge: %w", err)
-		}
-	}
-	if r.ValueAnnotation == nil {
-		return fmt.Errorf("field 'ValueAnnotation' is required")
-	}
-	if r.ValueAnnotation != nil {
-		if err := r.ValueAnnotation.Validate(); err != nil {
-			return fmt.Errorf("ValueAnnotation: %w", err)
-		}
-	}
-	if r.ValueAttachment == nil {
-		return fmt.Errorf("field 'ValueAttachment' is required")
-	}
-	if r.ValueAttachment != nil {
-		if err := r.ValueAttachment.Validate(); err != nil {
-			return fmt.Errorf("ValueAttachment: %w", err)
-		}
-	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
-	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
-		}
-	}
-	if r.ValueCodeableReference == nil {
-		return fmt.Errorf("field 'ValueCodeableReference' is required")
-	}
-	if r.ValueCodeableReference != nil {
-		if err := r.ValueCodeableReference.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableReference: %w", err)
-		}
-	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
-	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
-		}
+	if r.Function != nil {
+		r.Function.validateAll(path+".function", issues)
 	}
-	if r.ValueContactPoint == nil {
-		return fmt.Errorf("field 'ValueContactPoint' is required")
+	if r.Actor == nil {
+		issues.add("required", path+".actor", "field 'Actor' is required")
 	}
-	if r.ValueContactPoint != nil {
-		if err := r.ValueContactPoint.Validate(); err != nil {
-			return fmt.Errorf("ValueContactPoint: %w", err)
+	if r.Actor != nil {
+		if target := invalidReferenceTarget(r.Actor.Reference, r.Actor.Type, taskPerformerActorTargets); target != "" {
+			issues.add("value", path+".actor", fmt.Sprintf("field 'Actor' cannot refer to %s, expected one of %v", target, taskPerformerActorTargets))
 		}
 	}
-	if r.ValueCount == nil {
-		return fmt.Errorf("field 'ValueCount' is required")
+	if r.Actor != nil {
+		r.Actor.validateAll(path+".actor", issues)
 	}
-	if r.ValueCount != nil {
-		if err := r.ValueCount.Validate(); err != nil {
-			return fmt.Errorf("ValueCount: %w", err)
+}
+
+var taskPerformerInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TaskPerformer) invariants() []invariant {
+	return taskPerformerInvariants
+}
+
+func (r TaskPerformer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TaskPerformer) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TaskPerformer) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TaskPerformer) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var taskPerformerElementMetadata = []ElementMetadata{
+	{Path: "Task.performer.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Task.performer.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Task.performer.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Task.performer.function", Name: "function", Field: 3, Min: 0, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Task.performer.actor", Name: "actor", Field: 4, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/CareTeam", "http://hl7.org/fhir/StructureDefinition/Device", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Organization", "http://hl7.org/fhir/StructureDefinition/Patient", "http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole", "http://hl7.org/fhir/StructureDefinition/RelatedPerson"}},
+}
+
+func (r *TaskPerformer) ElementMetadata() []ElementMetadata {
+	return taskPerformerElementMetadata
+}
+
+type TaskRestriction struct {
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Repetitions        *int        `json:"repetitions,omitempty" bson:"repetitions,omitempty"`              // How many times to repeat
+	RepetitionsElement *Element    `json:"_repetitions,omitempty" bson:"repetitions_element,omitempty"`     // Extensions for repetitions
+	Period             *Period     `json:"period,omitempty" bson:"period,omitempty"`                        // When fulfillment is sought
+	Recipient          []Reference `json:"recipient,omitempty" bson:"recipient,omitempty"`                  // Individual or entity from whom fulfillment is being sought
+}
+
+func (r *TaskRestriction) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueDistance == nil {
-		return fmt.Errorf("field 'ValueDistance' is required")
-	}
-	if r.ValueDistance != nil {
-		if err := r.ValueDistance.Validate(); err != nil {
-			return fmt.Errorf("ValueDistance: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueDuration == nil {
-		return fmt.Errorf("field 'ValueDuration' is required")
-	}
-	if r.ValueDuration != nil {
-		if err := r.ValueDuration.Validate(); err != nil {
-			return fmt.Errorf("ValueDuration: %w", err)
+	if r.RepetitionsElement != nil {
+		if err := r.RepetitionsElement.Validate(); err != nil {
+			return fmt.Errorf("RepetitionsElement: %w", err)
 		}
 	}
-	if r.ValueHumanName == nil {
-		return fmt.Errorf("field 'ValueHumanName' is required")
-	}
-	if r.ValueHumanName != nil {
-		if err := r.ValueHumanName.Validate(); err != nil {
-			return fmt.Errorf("ValueHumanName: %w", err)
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
 		}
 	}
-	if r.ValueIdentifier == nil {
-		return fmt.Errorf("field 'ValueIdentifier' is required")
-	}
-	if r.ValueIdentifier != nil {
-		if err := r.ValueIdentifier.Validate(); err != nil {
-			return fmt.Errorf("ValueIdentifier: %w", err)
+	for i, item := range r.Recipient {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Recipient[%d]: %w", i, err)
 		}
 	}
-	if r.ValueMoney == nil {
-		return fmt.Errorf("field 'ValueMoney' is required")
+	return nil
+}
+
+func (r *TaskRestriction) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueMoney != nil {
-		if err := r.ValueMoney.Validate(); err != nil {
-			return fmt.Errorf("ValueMoney: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValuePeriod == nil {
-		return fmt.Errorf("field 'ValuePeriod' is required")
+	if r.RepetitionsElement != nil {
+		r.RepetitionsElement.validateAll(path+".repetitions", issues)
 	}
-	if r.ValuePeriod != nil {
-		if err := r.ValuePeriod.Validate(); err != nil {
-			return fmt.Errorf("ValuePeriod: %w", err)
-		}
+	if r.Period != nil {
+		r.Period.validateAll(path+".period", issues)
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	for i, item := range r.Recipient {
+		item.validateAll(fmt.Sprintf("%s.recipient[%d]", path, i), issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
+}
+
+var taskRestrictionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TaskRestriction) invariants() []invariant {
+	return taskRestrictionInvariants
+}
+
+func (r TaskRestriction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TaskRestriction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TaskRestriction) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TaskRestriction) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var taskRestrictionElementTypes = map[string]string{
+	"repetitions": "positiveInt",
+}
+
+func (r *TaskRestriction) elementTypes() map[string]string {
+	return taskRestrictionElementTypes
+}
+
+var taskRestrictionElementMetadata = []ElementMetadata{
+	{Path: "Task.restriction.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Task.restriction.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Task.restriction.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Task.restriction.repetitions", Name: "repetitions", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}},
+	{Path: "Task.restriction.period", Name: "period", Field: 5, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "Task.restriction.recipient", Name: "recipient", Field: 6, Min: 0, Max: "*", Types: []string{"Reference"}},
+}
+
+func (r *TaskRestriction) ElementMetadata() []ElementMetadata {
+	return taskRestrictionElementMetadata
+}
+
+type TaskInput struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Label for the input
+	Value             TaskInputValue   `json:"-" bson:"value,omitempty"`                                        // Content to use in performing the task
+	ValueElement      *Element         `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Task.input.value[x] when more than one was decoded
+}
+
+func (r *TaskInput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
-	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
 		}
 	}
-	if r.ValueRatio == nil {
-		return fmt.Errorf("field 'ValueRatio' is required")
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
 	}
-	if r.ValueRatio != nil {
-		if err := r.ValueRatio.Validate(); err != nil {
-			return fmt.Errorf("ValueRatio: %w", err)
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
 		}
 	}
-	if r.ValueRatioRange == nil {
-		return fmt.Errorf("field 'ValueRatioRange' is required")
-	}
-	if r.ValueRatioRange != nil {
-		if err := r.ValueRatioRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRatioRange: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueSampledData == nil {
-		return fmt.Errorf("field 'ValueSampledData' is required")
-	}
-	if r.ValueSampledData != nil {
-		if err := r.ValueSampledData.Validate(); err != nil {
-			return fmt.Errorf("ValueSampledData: %w", err)
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
 		}
 	}
-	if r.ValueSignature == nil {
-		return fmt.Errorf("field 'ValueSignature' is required")
+	return nil
+}
+
+func (r *TaskInput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueSignature != nil {
-		if err := r.ValueSignature.Validate(); err != nil {
-			return fmt.Errorf("ValueSignature: %w", err)
-		}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	if r.ValueTiming == nil {
-		return fmt.Errorf("field 'ValueTiming' is required")
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
 	}
-	if r.ValueTiming != nil {
-		if err := r.ValueTiming.Validate(); err != nil {
-			return fmt.Errorf("ValueTiming: %w", err)
-		}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
 	}
-	if r.ValueContactDetail == nil {
-		return fmt.Errorf("field 'ValueContactDetail' is required")
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueContactDetail != nil {
-		if err := r.ValueContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueContactDetail: %w", err)
-		}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
 	}
-	if r.ValueDataRequirement == nil {
-		return fmt.Errorf("field 'ValueDataRequirement' is required")
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
 	}
-	if r.ValueDataRequirement != nil {
-		if err := r.ValueDataRequirement.Validate(); err != nil {
-			return fmt.Errorf("ValueDataRequirement: %w", err)
-		}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
 	}
-	if r.ValueExpression == nil {
-		return fmt.Errorf("field 'ValueExpression' is required")
+}
+
+var taskInputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TaskInput) invariants() []invariant {
+	return taskInputInvariants
+}
+
+func (r TaskInput) MarshalJSON() ([]byte, error) {
+	type alias TaskInput
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *TaskInput) UnmarshalJSON(data []byte) error {
+	type alias TaskInput
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
 	}
-	if r.ValueExpression != nil {
-		if err := r.ValueExpression.Validate(); err != nil {
-			return fmt.Errorf("ValueExpression: %w", err)
-		}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
 	}
-	if r.ValueParameterDefinition == nil {
-		return fmt.Errorf("field 'ValueParameterDefinition' is required")
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", taskInputValueVariants); err != nil {
+		return err
 	}
-	if r.ValueParameterDefinition != nil {
-		if err := r.ValueParameterDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueParameterDefinition: %w", err)
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
 		}
 	}
-	if r.ValueRelatedArtifact == nil {
-		return fmt.Errorf("field 'ValueRelatedArtifact' is required")
-	}
-	if r.ValueRelatedArtifact != nil {
-		if err := r.ValueRelatedArtifact.Validate(); err != nil {
-			return fmt.Errorf("ValueRelatedArtifact: %w", err)
-		}
+	return nil
+}
+
+func (r TaskInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TaskInput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TaskInput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TaskInput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *TaskInput) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(taskInputValueVariants)
 	}
-	if r.ValueTriggerDefinition == nil {
-		return fmt.Errorf("field 'ValueTriggerDefinition' is required")
+	return "", nil
+}
+
+var taskInputElementMetadata = []ElementMetadata{
+	{Path: "Task.input.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Task.input.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Task.input.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Task.input.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Task.input.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}},
+}
+
+func (r *TaskInput) ElementMetadata() []ElementMetadata {
+	return taskInputElementMetadata
+}
+
+// TaskInputValue is the type of Task.input.value[x]. It is implemented by the TaskInputValue* variant types.
+type TaskInputValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isTaskInputValue()
+}
+
+// TaskInputValueBase64Binary is the base64Binary variant of TaskInputValue.
+type TaskInputValueBase64Binary string
+
+func (v TaskInputValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v TaskInputValueBase64Binary) isTaskInputValue() {}
+
+// TaskInputValueBoolean is the boolean variant of TaskInputValue.
+type TaskInputValueBoolean bool
+
+func (v TaskInputValueBoolean) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v TaskInputValueBoolean) isTaskInputValue() {}
+
+// TaskInputValueCanonical is the canonical variant of TaskInputValue.
+type TaskInputValueCanonical string
+
+func (v TaskInputValueCanonical) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v TaskInputValueCanonical) isTaskInputValue() {}
+
+// TaskInputValueCode is the code variant of TaskInputValue.
+type TaskInputValueCode string
+
+func (v TaskInputValueCode) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v TaskInputValueCode) isTaskInputValue() {}
+
+// TaskInputValueDate is the date variant of TaskInputValue.
+type TaskInputValueDate struct {
+	Date
+}
+
+func (v TaskInputValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v TaskInputValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueTriggerDefinition != nil {
-		if err := r.ValueTriggerDefinition.Validate(); err != nil {
-			return fmt.Errorf("ValueTriggerDefinition: %w", err)
-		}
+}
+
+func (v TaskInputValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v TaskInputValueDate) isTaskInputValue() {}
+
+// TaskInputValueDateTime is the dateTime variant of TaskInputValue.
+type TaskInputValueDateTime struct {
+	DateTime
+}
+
+func (v TaskInputValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v TaskInputValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueUsageContext == nil {
-		return fmt.Errorf("field 'ValueUsageContext' is required")
+}
+
+func (v TaskInputValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v TaskInputValueDateTime) isTaskInputValue() {}
+
+// TaskInputValueDecimal is the decimal variant of TaskInputValue.
+type TaskInputValueDecimal struct {
+	Decimal
+}
+
+func (v TaskInputValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v TaskInputValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueUsageContext != nil {
-		if err := r.ValueUsageContext.Validate(); err != nil {
-			return fmt.Errorf("ValueUsageContext: %w", err)
-		}
+}
+
+func (v TaskInputValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v TaskInputValueDecimal) isTaskInputValue() {}
+
+// TaskInputValueId is the id variant of TaskInputValue.
+type TaskInputValueId string
+
+func (v TaskInputValueId) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueId) FHIRType() string {
+	return "id"
+}
+
+func (v TaskInputValueId) isTaskInputValue() {}
+
+// TaskInputValueInstant is the instant variant of TaskInputValue.
+type TaskInputValueInstant struct {
+	Instant
+}
+
+func (v TaskInputValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v TaskInputValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
-	if r.ValueAvailability == nil {
-		return fmt.Errorf("field 'ValueAvailability' is required")
-	}
-	if r.ValueAvailability != nil {
-		if err := r.ValueAvailability.Validate(); err != nil {
-			return fmt.Errorf("ValueAvailability: %w", err)
-		}
-	}
-	if r.ValueExtendedContactDetail == nil {
-		return fmt.Errorf("field 'ValueExtendedContactDetail' is required")
-	}
-	if r.ValueExtendedContactDetail != nil {
-		if err := r.ValueExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueExtendedContactDetail: %w", err)
-		}
-	}
-	if r.ValueVirtualServiceDetail == nil {
-		return fmt.Errorf("field 'ValueVirtualServiceDetail' is required")
-	}
-	if r.ValueVirtualServiceDetail != nil {
-		if err := r.ValueVirtualServiceDetail.Validate(); err != nil {
-			return fmt.Errorf("ValueVirtualServiceDetail: %w", err)
-		}
-	}
-	if r.ValueDosage == nil {
-		return fmt.Errorf("field 'ValueDosage' is required")
-	}
-	if r.ValueDosage != nil {
-		if err := r.ValueDosage.Validate(); err != nil {
-			return fmt.Errorf("ValueDosage: %w", err)
-		}
-	}
-	if r.ValueMeta == nil {
-		return fmt.Errorf("field 'ValueMeta' is required")
-	}
-	if r.ValueMeta != nil {
-		if err := r.ValueMeta.Validate(); err != nil {
-			return fmt.Errorf("ValueMeta: %w", err)
-		}
+}
+
+func (v TaskInputValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v TaskInputValueInstant) isTaskInputValue() {}
+
+// TaskInputValueInteger is the integer variant of TaskInputValue.
+type TaskInputValueInteger int
+
+func (v TaskInputValueInteger) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v TaskInputValueInteger) isTaskInputValue() {}
+
+// TaskInputValueInteger64 is the integer64 variant of TaskInputValue.
+type TaskInputValueInteger64 int64
+
+func (v TaskInputValueInteger64) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v TaskInputValueInteger64) isTaskInputValue() {}
+
+// TaskInputValueMarkdown is the markdown variant of TaskInputValue.
+type TaskInputValueMarkdown string
+
+func (v TaskInputValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v TaskInputValueMarkdown) isTaskInputValue() {}
+
+// TaskInputValueOid is the oid variant of TaskInputValue.
+type TaskInputValueOid string
+
+func (v TaskInputValueOid) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v TaskInputValueOid) isTaskInputValue() {}
+
+// TaskInputValuePositiveInt is the positiveInt variant of TaskInputValue.
+type TaskInputValuePositiveInt int
+
+func (v TaskInputValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v TaskInputValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v TaskInputValuePositiveInt) isTaskInputValue() {}
+
+// TaskInputValueString is the string variant of TaskInputValue.
+type TaskInputValueString string
+
+func (v TaskInputValueString) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueString) FHIRType() string {
+	return "string"
+}
+
+func (v TaskInputValueString) isTaskInputValue() {}
+
+// TaskInputValueTime is the time variant of TaskInputValue.
+type TaskInputValueTime struct {
+	Time
+}
+
+func (v TaskInputValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v TaskInputValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
 	}
+}
+
+func (v TaskInputValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v TaskInputValueTime) isTaskInputValue() {}
+
+// TaskInputValueUnsignedInt is the unsignedInt variant of TaskInputValue.
+type TaskInputValueUnsignedInt int
+
+func (v TaskInputValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v TaskInputValueUnsignedInt) isTaskInputValue() {}
+
+// TaskInputValueUri is the uri variant of TaskInputValue.
+type TaskInputValueUri string
+
+func (v TaskInputValueUri) Validate() error {
+	return nil
+}
+
+func (v TaskInputValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v TaskInputValueUri) isTaskInputValue() {}
+
+// TaskInputValueUrl is the url variant of TaskInputValue.
+type TaskInputValueUrl string
+
+func (v TaskInputValueUrl) Validate() error {
 	return nil
 }
+
+func (v TaskInputValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskInputValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v TaskInputValueUrl) isTaskInputValue() {}
+
+// TaskInputValueUuid is the uuid variant of TaskInputValue.
+type TaskInputValueUuid struct {
+	uuid
+}
+
+func (v TaskInputValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v TaskInputValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v TaskInputValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v TaskInputValueUuid) isTaskInputValue() {}
+
+// TaskInputValueAddress is the Address variant of TaskInputValue.
+type TaskInputValueAddress struct {
+	Address
+}
+
+func (v TaskInputValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v TaskInputValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v TaskInputValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v TaskInputValueAddress) isTaskInputValue() {}
+
+// TaskInputValueAge is the Age variant of TaskInputValue.
+type TaskInputValueAge struct {
+	Age
+}
+
+func (v TaskInputValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v TaskInputValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v TaskInputValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v TaskInputValueAge) isTaskInputValue() {}
+
+// TaskInputValueAnnotation is the Annotation variant of TaskInputValue.
+type TaskInputValueAnnotation struct {
+	Annotation
+}
+
+func (v TaskInputValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v TaskInputValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v TaskInputValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v TaskInputValueAnnotation) isTaskInputValue() {}
+
+// TaskInputValueAttachment is the Attachment variant of TaskInputValue.
+type TaskInputValueAttachment struct {
+	Attachment
+}
+
+func (v TaskInputValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v TaskInputValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v TaskInputValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v TaskInputValueAttachment) isTaskInputValue() {}
+
+// TaskInputValueCodeableConcept is the CodeableConcept variant of TaskInputValue.
+type TaskInputValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v TaskInputValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v TaskInputValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v TaskInputValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v TaskInputValueCodeableConcept) isTaskInputValue() {}
+
+// TaskInputValueCodeableReference is the CodeableReference variant of TaskInputValue.
+type TaskInputValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v TaskInputValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v TaskInputValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v TaskInputValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v TaskInputValueCodeableReference) isTaskInputValue() {}
+
+// TaskInputValueCoding is the Coding variant of TaskInputValue.
+type TaskInputValueCoding struct {
+	Coding
+}
+
+func (v TaskInputValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v TaskInputValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v TaskInputValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v TaskInputValueCoding) isTaskInputValue() {}
+
+// TaskInputValueContactPoint is the ContactPoint variant of TaskInputValue.
+type TaskInputValueContactPoint struct {
+	ContactPoint
+}
+
+func (v TaskInputValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v TaskInputValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v TaskInputValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v TaskInputValueContactPoint) isTaskInputValue() {}
+
+// TaskInputValueCount is the Count variant of TaskInputValue.
+type TaskInputValueCount struct {
+	Count
+}
+
+func (v TaskInputValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v TaskInputValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v TaskInputValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v TaskInputValueCount) isTaskInputValue() {}
+
+// TaskInputValueDistance is the Distance variant of TaskInputValue.
+type TaskInputValueDistance struct {
+	Distance
+}
+
+func (v TaskInputValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v TaskInputValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v TaskInputValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v TaskInputValueDistance) isTaskInputValue() {}
+
+// TaskInputValueDuration is the Duration variant of TaskInputValue.
+type TaskInputValueDuration struct {
+	Duration
+}
+
+func (v TaskInputValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v TaskInputValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v TaskInputValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v TaskInputValueDuration) isTaskInputValue() {}
+
+// TaskInputValueHumanName is the HumanName variant of TaskInputValue.
+type TaskInputValueHumanName struct {
+	HumanName
+}
+
+func (v TaskInputValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v TaskInputValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v TaskInputValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v TaskInputValueHumanName) isTaskInputValue() {}
+
+// TaskInputValueIdentifier is the Identifier variant of TaskInputValue.
+type TaskInputValueIdentifier struct {
+	Identifier
+}
+
+func (v TaskInputValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v TaskInputValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v TaskInputValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v TaskInputValueIdentifier) isTaskInputValue() {}
+
+// TaskInputValueMoney is the Money variant of TaskInputValue.
+type TaskInputValueMoney struct {
+	Money
+}
+
+func (v TaskInputValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v TaskInputValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v TaskInputValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v TaskInputValueMoney) isTaskInputValue() {}
+
+// TaskInputValuePeriod is the Period variant of TaskInputValue.
+type TaskInputValuePeriod struct {
+	Period
+}
+
+func (v TaskInputValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v TaskInputValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v TaskInputValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v TaskInputValuePeriod) isTaskInputValue() {}
+
+// TaskInputValueQuantity is the Quantity variant of TaskInputValue.
+type TaskInputValueQuantity struct {
+	Quantity
+}
+
+func (v TaskInputValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v TaskInputValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v TaskInputValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v TaskInputValueQuantity) isTaskInputValue() {}
+
+// TaskInputValueRange is the Range variant of TaskInputValue.
+type TaskInputValueRange struct {
+	Range
+}
+
+func (v TaskInputValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v TaskInputValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v TaskInputValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v TaskInputValueRange) isTaskInputValue() {}
+
+// TaskInputValueRatio is the Ratio variant of TaskInputValue.
+type TaskInputValueRatio struct {
+	Ratio
+}
+
+func (v TaskInputValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v TaskInputValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v TaskInputValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v TaskInputValueRatio) isTaskInputValue() {}
+
+// TaskInputValueRatioRange is the RatioRange variant of TaskInputValue.
+type TaskInputValueRatioRange struct {
+	RatioRange
+}
+
+func (v TaskInputValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v TaskInputValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v TaskInputValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v TaskInputValueRatioRange) isTaskInputValue() {}
+
+// TaskInputValueReference is the Reference variant of TaskInputValue.
+type TaskInputValueReference struct {
+	Reference
+}
+
+func (v TaskInputValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v TaskInputValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v TaskInputValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v TaskInputValueReference) isTaskInputValue() {}
+
+// TaskInputValueSampledData is the SampledData variant of TaskInputValue.
+type TaskInputValueSampledData struct {
+	SampledData
+}
+
+func (v TaskInputValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v TaskInputValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v TaskInputValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v TaskInputValueSampledData) isTaskInputValue() {}
+
+// TaskInputValueSignature is the Signature variant of TaskInputValue.
+type TaskInputValueSignature struct {
+	Signature
+}
+
+func (v TaskInputValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v TaskInputValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v TaskInputValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v TaskInputValueSignature) isTaskInputValue() {}
+
+// TaskInputValueTiming is the Timing variant of TaskInputValue.
+type TaskInputValueTiming struct {
+	Timing
+}
+
+func (v TaskInputValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v TaskInputValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v TaskInputValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v TaskInputValueTiming) isTaskInputValue() {}
+
+// TaskInputValueContactDetail is the ContactDetail variant of TaskInputValue.
+type TaskInputValueContactDetail struct {
+	ContactDetail
+}
+
+func (v TaskInputValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v TaskInputValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v TaskInputValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v TaskInputValueContactDetail) isTaskInputValue() {}
+
+// TaskInputValueDataRequirement is the DataRequirement variant of TaskInputValue.
+type TaskInputValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v TaskInputValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v TaskInputValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v TaskInputValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v TaskInputValueDataRequirement) isTaskInputValue() {}
+
+// TaskInputValueExpression is the Expression variant of TaskInputValue.
+type TaskInputValueExpression struct {
+	Expression
+}
+
+func (v TaskInputValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v TaskInputValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v TaskInputValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v TaskInputValueExpression) isTaskInputValue() {}
+
+// TaskInputValueParameterDefinition is the ParameterDefinition variant of TaskInputValue.
+type TaskInputValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v TaskInputValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v TaskInputValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v TaskInputValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v TaskInputValueParameterDefinition) isTaskInputValue() {}
+
+// TaskInputValueRelatedArtifact is the RelatedArtifact variant of TaskInputValue.
+type TaskInputValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v TaskInputValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v TaskInputValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v TaskInputValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v TaskInputValueRelatedArtifact) isTaskInputValue() {}
+
+// TaskInputValueTriggerDefinition is the TriggerDefinition variant of TaskInputValue.
+type TaskInputValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v TaskInputValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v TaskInputValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v TaskInputValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v TaskInputValueTriggerDefinition) isTaskInputValue() {}
+
+// TaskInputValueUsageContext is the UsageContext variant of TaskInputValue.
+type TaskInputValueUsageContext struct {
+	UsageContext
+}
+
+func (v TaskInputValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v TaskInputValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v TaskInputValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v TaskInputValueUsageContext) isTaskInputValue() {}
+
+// TaskInputValueAvailability is the Availability variant of TaskInputValue.
+type TaskInputValueAvailability struct {
+	Availability
+}
+
+func (v TaskInputValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v TaskInputValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v TaskInputValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v TaskInputValueAvailability) isTaskInputValue() {}
+
+// TaskInputValueExtendedContactDetail is the ExtendedContactDetail variant of TaskInputValue.
+type TaskInputValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v TaskInputValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v TaskInputValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v TaskInputValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v TaskInputValueExtendedContactDetail) isTaskInputValue() {}
+
+// TaskInputValueVirtualServiceDetail is the VirtualServiceDetail variant of TaskInputValue.
+type TaskInputValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v TaskInputValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v TaskInputValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v TaskInputValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v TaskInputValueVirtualServiceDetail) isTaskInputValue() {}
+
+// TaskInputValueDosage is the Dosage variant of TaskInputValue.
+type TaskInputValueDosage struct {
+	Dosage
+}
+
+func (v TaskInputValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v TaskInputValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v TaskInputValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v TaskInputValueDosage) isTaskInputValue() {}
+
+// TaskInputValueMeta is the Meta variant of TaskInputValue.
+type TaskInputValueMeta struct {
+	Meta
+}
+
+func (v TaskInputValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v TaskInputValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v TaskInputValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v TaskInputValueMeta) isTaskInputValue() {}
+
+var taskInputValueVariants = []TaskInputValue{
+	TaskInputValueBase64Binary(""),
+	TaskInputValueBoolean(false),
+	TaskInputValueCanonical(""),
+	TaskInputValueCode(""),
+	TaskInputValueDate{},
+	TaskInputValueDateTime{},
+	TaskInputValueDecimal{},
+	TaskInputValueId(""),
+	TaskInputValueInstant{},
+	TaskInputValueInteger(0),
+	TaskInputValueInteger64(0),
+	TaskInputValueMarkdown(""),
+	TaskInputValueOid(""),
+	TaskInputValuePositiveInt(0),
+	TaskInputValueString(""),
+	TaskInputValueTime{},
+	TaskInputValueUnsignedInt(0),
+	TaskInputValueUri(""),
+	TaskInputValueUrl(""),
+	TaskInputValueUuid{},
+	TaskInputValueAddress{},
+	TaskInputValueAge{},
+	TaskInputValueAnnotation{},
+	TaskInputValueAttachment{},
+	TaskInputValueCodeableConcept{},
+	TaskInputValueCodeableReference{},
+	TaskInputValueCoding{},
+	TaskInputValueContactPoint{},
+	TaskInputValueCount{},
+	TaskInputValueDistance{},
+	TaskInputValueDuration{},
+	TaskInputValueHumanName{},
+	TaskInputValueIdentifier{},
+	TaskInputValueMoney{},
+	TaskInputValuePeriod{},
+	TaskInputValueQuantity{},
+	TaskInputValueRange{},
+	TaskInputValueRatio{},
+	TaskInputValueRatioRange{},
+	TaskInputValueReference{},
+	TaskInputValueSampledData{},
+	TaskInputValueSignature{},
+	TaskInputValueTiming{},
+	TaskInputValueContactDetail{},
+	TaskInputValueDataRequirement{},
+	TaskInputValueExpression{},
+	TaskInputValueParameterDefinition{},
+	TaskInputValueRelatedArtifact{},
+	TaskInputValueTriggerDefinition{},
+	TaskInputValueUsageContext{},
+	TaskInputValueAvailability{},
+	TaskInputValueExtendedContactDetail{},
+	TaskInputValueVirtualServiceDetail{},
+	TaskInputValueDosage{},
+	TaskInputValueMeta{},
+}
+
+type TaskOutput struct {
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Type              *CodeableConcept `json:"type" bson:"type"`                                                // Label for output
+	Value             TaskOutputValue  `json:"-" bson:"value,omitempty"`                                        // Result of output
+	ValueElement      *Element         `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of Task.output.value[x] when more than one was decoded
+}
+
+func (r *TaskOutput) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == nil {
+		return fmt.Errorf("field 'Type' is required")
+	}
+	if r.Type != nil {
+		if err := r.Type.Validate(); err != nil {
+			return fmt.Errorf("Type: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *TaskOutput) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Type == nil {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != nil {
+		r.Type.validateAll(path+".type", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var taskOutputInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TaskOutput) invariants() []invariant {
+	return taskOutputInvariants
+}
+
+func (r TaskOutput) MarshalJSON() ([]byte, error) {
+	type alias TaskOutput
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *TaskOutput) UnmarshalJSON(data []byte) error {
+	type alias TaskOutput
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", taskOutputValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r TaskOutput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TaskOutput) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TaskOutput) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TaskOutput) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *TaskOutput) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(taskOutputValueVariants)
+	}
+	return "", nil
+}
+
+var taskOutputElementMetadata = []ElementMetadata{
+	{Path: "Task.output.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Task.output.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Task.output.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Task.output.type", Name: "type", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "Task.output.value[x]", Name: "value", Field: 4, Min: 1, Max: "1", Types: []string{"base64Binary", "boolean", "canonical", "code", "date", "dateTime", "decimal", "id", "instant", "integer", "integer64", "markdown", "oid", "positiveInt", "string", "time", "unsignedInt", "uri", "url", "uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"}},
+}
+
+func (r *TaskOutput) ElementMetadata() []ElementMetadata {
+	return taskOutputElementMetadata
+}
+
+// TaskOutputValue is the type of Task.output.value[x]. It is implemented by the TaskOutputValue* variant types.
+type TaskOutputValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isTaskOutputValue()
+}
+
+// TaskOutputValueBase64Binary is the base64Binary variant of TaskOutputValue.
+type TaskOutputValueBase64Binary string
+
+func (v TaskOutputValueBase64Binary) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueBase64Binary) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueBase64Binary) FHIRType() string {
+	return "base64Binary"
+}
+
+func (v TaskOutputValueBase64Binary) isTaskOutputValue() {}
+
+// TaskOutputValueBoolean is the boolean variant of TaskOutputValue.
+type TaskOutputValueBoolean bool
+
+func (v TaskOutputValueBoolean) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v TaskOutputValueBoolean) isTaskOutputValue() {}
+
+// TaskOutputValueCanonical is the canonical variant of TaskOutputValue.
+type TaskOutputValueCanonical string
+
+func (v TaskOutputValueCanonical) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueCanonical) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueCanonical) FHIRType() string {
+	return "canonical"
+}
+
+func (v TaskOutputValueCanonical) isTaskOutputValue() {}
+
+// TaskOutputValueCode is the code variant of TaskOutputValue.
+type TaskOutputValueCode string
+
+func (v TaskOutputValueCode) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v TaskOutputValueCode) isTaskOutputValue() {}
+
+// TaskOutputValueDate is the date variant of TaskOutputValue.
+type TaskOutputValueDate struct {
+	Date
+}
+
+func (v TaskOutputValueDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v TaskOutputValueDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TaskOutputValueDate) FHIRType() string {
+	return "date"
+}
+
+func (v TaskOutputValueDate) isTaskOutputValue() {}
+
+// TaskOutputValueDateTime is the dateTime variant of TaskOutputValue.
+type TaskOutputValueDateTime struct {
+	DateTime
+}
+
+func (v TaskOutputValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v TaskOutputValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TaskOutputValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v TaskOutputValueDateTime) isTaskOutputValue() {}
+
+// TaskOutputValueDecimal is the decimal variant of TaskOutputValue.
+type TaskOutputValueDecimal struct {
+	Decimal
+}
+
+func (v TaskOutputValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v TaskOutputValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TaskOutputValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v TaskOutputValueDecimal) isTaskOutputValue() {}
+
+// TaskOutputValueId is the id variant of TaskOutputValue.
+type TaskOutputValueId string
+
+func (v TaskOutputValueId) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueId) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueId) FHIRType() string {
+	return "id"
+}
+
+func (v TaskOutputValueId) isTaskOutputValue() {}
+
+// TaskOutputValueInstant is the instant variant of TaskOutputValue.
+type TaskOutputValueInstant struct {
+	Instant
+}
+
+func (v TaskOutputValueInstant) Validate() error {
+	return v.Instant.Validate()
+}
+
+func (v TaskOutputValueInstant) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Instant.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TaskOutputValueInstant) FHIRType() string {
+	return "instant"
+}
+
+func (v TaskOutputValueInstant) isTaskOutputValue() {}
+
+// TaskOutputValueInteger is the integer variant of TaskOutputValue.
+type TaskOutputValueInteger int
+
+func (v TaskOutputValueInteger) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v TaskOutputValueInteger) isTaskOutputValue() {}
+
+// TaskOutputValueInteger64 is the integer64 variant of TaskOutputValue.
+type TaskOutputValueInteger64 int64
+
+func (v TaskOutputValueInteger64) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueInteger64) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueInteger64) FHIRType() string {
+	return "integer64"
+}
+
+func (v TaskOutputValueInteger64) isTaskOutputValue() {}
+
+// TaskOutputValueMarkdown is the markdown variant of TaskOutputValue.
+type TaskOutputValueMarkdown string
+
+func (v TaskOutputValueMarkdown) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueMarkdown) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueMarkdown) FHIRType() string {
+	return "markdown"
+}
+
+func (v TaskOutputValueMarkdown) isTaskOutputValue() {}
+
+// TaskOutputValueOid is the oid variant of TaskOutputValue.
+type TaskOutputValueOid string
+
+func (v TaskOutputValueOid) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueOid) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueOid) FHIRType() string {
+	return "oid"
+}
+
+func (v TaskOutputValueOid) isTaskOutputValue() {}
+
+// TaskOutputValuePositiveInt is the positiveInt variant of TaskOutputValue.
+type TaskOutputValuePositiveInt int
+
+func (v TaskOutputValuePositiveInt) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValuePositiveInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValuePositiveInt) FHIRType() string {
+	return "positiveInt"
+}
+
+func (v TaskOutputValuePositiveInt) isTaskOutputValue() {}
+
+// TaskOutputValueString is the string variant of TaskOutputValue.
+type TaskOutputValueString string
+
+func (v TaskOutputValueString) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueString) FHIRType() string {
+	return "string"
+}
+
+func (v TaskOutputValueString) isTaskOutputValue() {}
+
+// TaskOutputValueTime is the time variant of TaskOutputValue.
+type TaskOutputValueTime struct {
+	Time
+}
+
+func (v TaskOutputValueTime) Validate() error {
+	return v.Time.Validate()
+}
+
+func (v TaskOutputValueTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Time.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TaskOutputValueTime) FHIRType() string {
+	return "time"
+}
+
+func (v TaskOutputValueTime) isTaskOutputValue() {}
+
+// TaskOutputValueUnsignedInt is the unsignedInt variant of TaskOutputValue.
+type TaskOutputValueUnsignedInt int
+
+func (v TaskOutputValueUnsignedInt) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueUnsignedInt) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueUnsignedInt) FHIRType() string {
+	return "unsignedInt"
+}
+
+func (v TaskOutputValueUnsignedInt) isTaskOutputValue() {}
+
+// TaskOutputValueUri is the uri variant of TaskOutputValue.
+type TaskOutputValueUri string
+
+func (v TaskOutputValueUri) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v TaskOutputValueUri) isTaskOutputValue() {}
+
+// TaskOutputValueUrl is the url variant of TaskOutputValue.
+type TaskOutputValueUrl string
+
+func (v TaskOutputValueUrl) Validate() error {
+	return nil
+}
+
+func (v TaskOutputValueUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v TaskOutputValueUrl) FHIRType() string {
+	return "url"
+}
+
+func (v TaskOutputValueUrl) isTaskOutputValue() {}
+
+// TaskOutputValueUuid is the uuid variant of TaskOutputValue.
+type TaskOutputValueUuid struct {
+	uuid
+}
+
+func (v TaskOutputValueUuid) Validate() error {
+	return v.uuid.Validate()
+}
+
+func (v TaskOutputValueUuid) validateAll(path string, issues *ValidationIssues) {
+	v.uuid.validateAll(path, issues)
+}
+
+func (v TaskOutputValueUuid) FHIRType() string {
+	return "uuid"
+}
+
+func (v TaskOutputValueUuid) isTaskOutputValue() {}
+
+// TaskOutputValueAddress is the Address variant of TaskOutputValue.
+type TaskOutputValueAddress struct {
+	Address
+}
+
+func (v TaskOutputValueAddress) Validate() error {
+	return v.Address.Validate()
+}
+
+func (v TaskOutputValueAddress) validateAll(path string, issues *ValidationIssues) {
+	v.Address.validateAll(path, issues)
+}
+
+func (v TaskOutputValueAddress) FHIRType() string {
+	return "Address"
+}
+
+func (v TaskOutputValueAddress) isTaskOutputValue() {}
+
+// TaskOutputValueAge is the Age variant of TaskOutputValue.
+type TaskOutputValueAge struct {
+	Age
+}
+
+func (v TaskOutputValueAge) Validate() error {
+	return v.Age.Validate()
+}
+
+func (v TaskOutputValueAge) validateAll(path string, issues *ValidationIssues) {
+	v.Age.validateAll(path, issues)
+}
+
+func (v TaskOutputValueAge) FHIRType() string {
+	return "Age"
+}
+
+func (v TaskOutputValueAge) isTaskOutputValue() {}
+
+// TaskOutputValueAnnotation is the Annotation variant of TaskOutputValue.
+type TaskOutputValueAnnotation struct {
+	Annotation
+}
+
+func (v TaskOutputValueAnnotation) Validate() error {
+	return v.Annotation.Validate()
+}
+
+func (v TaskOutputValueAnnotation) validateAll(path string, issues *ValidationIssues) {
+	v.Annotation.validateAll(path, issues)
+}
+
+func (v TaskOutputValueAnnotation) FHIRType() string {
+	return "Annotation"
+}
+
+func (v TaskOutputValueAnnotation) isTaskOutputValue() {}
+
+// TaskOutputValueAttachment is the Attachment variant of TaskOutputValue.
+type TaskOutputValueAttachment struct {
+	Attachment
+}
+
+func (v TaskOutputValueAttachment) Validate() error {
+	return v.Attachment.Validate()
+}
+
+func (v TaskOutputValueAttachment) validateAll(path string, issues *ValidationIssues) {
+	v.Attachment.validateAll(path, issues)
+}
+
+func (v TaskOutputValueAttachment) FHIRType() string {
+	return "Attachment"
+}
+
+func (v TaskOutputValueAttachment) isTaskOutputValue() {}
+
+// TaskOutputValueCodeableConcept is the CodeableConcept variant of TaskOutputValue.
+type TaskOutputValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v TaskOutputValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v TaskOutputValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v TaskOutputValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v TaskOutputValueCodeableConcept) isTaskOutputValue() {}
+
+// TaskOutputValueCodeableReference is the CodeableReference variant of TaskOutputValue.
+type TaskOutputValueCodeableReference struct {
+	CodeableReference
+}
+
+func (v TaskOutputValueCodeableReference) Validate() error {
+	return v.CodeableReference.Validate()
+}
+
+func (v TaskOutputValueCodeableReference) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableReference.validateAll(path, issues)
+}
+
+func (v TaskOutputValueCodeableReference) FHIRType() string {
+	return "CodeableReference"
+}
+
+func (v TaskOutputValueCodeableReference) isTaskOutputValue() {}
+
+// TaskOutputValueCoding is the Coding variant of TaskOutputValue.
+type TaskOutputValueCoding struct {
+	Coding
+}
+
+func (v TaskOutputValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v TaskOutputValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v TaskOutputValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v TaskOutputValueCoding) isTaskOutputValue() {}
+
+// TaskOutputValueContactPoint is the ContactPoint variant of TaskOutputValue.
+type TaskOutputValueContactPoint struct {
+	ContactPoint
+}
+
+func (v TaskOutputValueContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v TaskOutputValueContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v TaskOutputValueContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v TaskOutputValueContactPoint) isTaskOutputValue() {}
+
+// TaskOutputValueCount is the Count variant of TaskOutputValue.
+type TaskOutputValueCount struct {
+	Count
+}
+
+func (v TaskOutputValueCount) Validate() error {
+	return v.Count.Validate()
+}
+
+func (v TaskOutputValueCount) validateAll(path string, issues *ValidationIssues) {
+	v.Count.validateAll(path, issues)
+}
+
+func (v TaskOutputValueCount) FHIRType() string {
+	return "Count"
+}
+
+func (v TaskOutputValueCount) isTaskOutputValue() {}
+
+// TaskOutputValueDistance is the Distance variant of TaskOutputValue.
+type TaskOutputValueDistance struct {
+	Distance
+}
+
+func (v TaskOutputValueDistance) Validate() error {
+	return v.Distance.Validate()
+}
+
+func (v TaskOutputValueDistance) validateAll(path string, issues *ValidationIssues) {
+	v.Distance.validateAll(path, issues)
+}
+
+func (v TaskOutputValueDistance) FHIRType() string {
+	return "Distance"
+}
+
+func (v TaskOutputValueDistance) isTaskOutputValue() {}
+
+// TaskOutputValueDuration is the Duration variant of TaskOutputValue.
+type TaskOutputValueDuration struct {
+	Duration
+}
+
+func (v TaskOutputValueDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v TaskOutputValueDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v TaskOutputValueDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v TaskOutputValueDuration) isTaskOutputValue() {}
+
+// TaskOutputValueHumanName is the HumanName variant of TaskOutputValue.
+type TaskOutputValueHumanName struct {
+	HumanName
+}
+
+func (v TaskOutputValueHumanName) Validate() error {
+	return v.HumanName.Validate()
+}
+
+func (v TaskOutputValueHumanName) validateAll(path string, issues *ValidationIssues) {
+	v.HumanName.validateAll(path, issues)
+}
+
+func (v TaskOutputValueHumanName) FHIRType() string {
+	return "HumanName"
+}
+
+func (v TaskOutputValueHumanName) isTaskOutputValue() {}
+
+// TaskOutputValueIdentifier is the Identifier variant of TaskOutputValue.
+type TaskOutputValueIdentifier struct {
+	Identifier
+}
+
+func (v TaskOutputValueIdentifier) Validate() error {
+	return v.Identifier.Validate()
+}
+
+func (v TaskOutputValueIdentifier) validateAll(path string, issues *ValidationIssues) {
+	v.Identifier.validateAll(path, issues)
+}
+
+func (v TaskOutputValueIdentifier) FHIRType() string {
+	return "Identifier"
+}
+
+func (v TaskOutputValueIdentifier) isTaskOutputValue() {}
+
+// TaskOutputValueMoney is the Money variant of TaskOutputValue.
+type TaskOutputValueMoney struct {
+	Money
+}
+
+func (v TaskOutputValueMoney) Validate() error {
+	return v.Money.Validate()
+}
+
+func (v TaskOutputValueMoney) validateAll(path string, issues *ValidationIssues) {
+	v.Money.validateAll(path, issues)
+}
+
+func (v TaskOutputValueMoney) FHIRType() string {
+	return "Money"
+}
+
+func (v TaskOutputValueMoney) isTaskOutputValue() {}
+
+// TaskOutputValuePeriod is the Period variant of TaskOutputValue.
+type TaskOutputValuePeriod struct {
+	Period
+}
+
+func (v TaskOutputValuePeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v TaskOutputValuePeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v TaskOutputValuePeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v TaskOutputValuePeriod) isTaskOutputValue() {}
+
+// TaskOutputValueQuantity is the Quantity variant of TaskOutputValue.
+type TaskOutputValueQuantity struct {
+	Quantity
+}
+
+func (v TaskOutputValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v TaskOutputValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v TaskOutputValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v TaskOutputValueQuantity) isTaskOutputValue() {}
+
+// TaskOutputValueRange is the Range variant of TaskOutputValue.
+type TaskOutputValueRange struct {
+	Range
+}
+
+func (v TaskOutputValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v TaskOutputValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v TaskOutputValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v TaskOutputValueRange) isTaskOutputValue() {}
+
+// TaskOutputValueRatio is the Ratio variant of TaskOutputValue.
+type TaskOutputValueRatio struct {
+	Ratio
+}
+
+func (v TaskOutputValueRatio) Validate() error {
+	return v.Ratio.Validate()
+}
+
+func (v TaskOutputValueRatio) validateAll(path string, issues *ValidationIssues) {
+	v.Ratio.validateAll(path, issues)
+}
+
+func (v TaskOutputValueRatio) FHIRType() string {
+	return "Ratio"
+}
+
+func (v TaskOutputValueRatio) isTaskOutputValue() {}
+
+// TaskOutputValueRatioRange is the RatioRange variant of TaskOutputValue.
+type TaskOutputValueRatioRange struct {
+	RatioRange
+}
+
+func (v TaskOutputValueRatioRange) Validate() error {
+	return v.RatioRange.Validate()
+}
+
+func (v TaskOutputValueRatioRange) validateAll(path string, issues *ValidationIssues) {
+	v.RatioRange.validateAll(path, issues)
+}
+
+func (v TaskOutputValueRatioRange) FHIRType() string {
+	return "RatioRange"
+}
+
+func (v TaskOutputValueRatioRange) isTaskOutputValue() {}
+
+// TaskOutputValueReference is the Reference variant of TaskOutputValue.
+type TaskOutputValueReference struct {
+	Reference
+}
+
+func (v TaskOutputValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v TaskOutputValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v TaskOutputValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v TaskOutputValueReference) isTaskOutputValue() {}
+
+// TaskOutputValueSampledData is the SampledData variant of TaskOutputValue.
+type TaskOutputValueSampledData struct {
+	SampledData
+}
+
+func (v TaskOutputValueSampledData) Validate() error {
+	return v.SampledData.Validate()
+}
+
+func (v TaskOutputValueSampledData) validateAll(path string, issues *ValidationIssues) {
+	v.SampledData.validateAll(path, issues)
+}
+
+func (v TaskOutputValueSampledData) FHIRType() string {
+	return "SampledData"
+}
+
+func (v TaskOutputValueSampledData) isTaskOutputValue() {}
+
+// TaskOutputValueSignature is the Signature variant of TaskOutputValue.
+type TaskOutputValueSignature struct {
+	Signature
+}
+
+func (v TaskOutputValueSignature) Validate() error {
+	return v.Signature.Validate()
+}
+
+func (v TaskOutputValueSignature) validateAll(path string, issues *ValidationIssues) {
+	v.Signature.validateAll(path, issues)
+}
+
+func (v TaskOutputValueSignature) FHIRType() string {
+	return "Signature"
+}
+
+func (v TaskOutputValueSignature) isTaskOutputValue() {}
+
+// TaskOutputValueTiming is the Timing variant of TaskOutputValue.
+type TaskOutputValueTiming struct {
+	Timing
+}
+
+func (v TaskOutputValueTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v TaskOutputValueTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v TaskOutputValueTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v TaskOutputValueTiming) isTaskOutputValue() {}
+
+// TaskOutputValueContactDetail is the ContactDetail variant of TaskOutputValue.
+type TaskOutputValueContactDetail struct {
+	ContactDetail
+}
+
+func (v TaskOutputValueContactDetail) Validate() error {
+	return v.ContactDetail.Validate()
+}
+
+func (v TaskOutputValueContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ContactDetail.validateAll(path, issues)
+}
+
+func (v TaskOutputValueContactDetail) FHIRType() string {
+	return "ContactDetail"
+}
+
+func (v TaskOutputValueContactDetail) isTaskOutputValue() {}
+
+// TaskOutputValueDataRequirement is the DataRequirement variant of TaskOutputValue.
+type TaskOutputValueDataRequirement struct {
+	DataRequirement
+}
+
+func (v TaskOutputValueDataRequirement) Validate() error {
+	return v.DataRequirement.Validate()
+}
+
+func (v TaskOutputValueDataRequirement) validateAll(path string, issues *ValidationIssues) {
+	v.DataRequirement.validateAll(path, issues)
+}
+
+func (v TaskOutputValueDataRequirement) FHIRType() string {
+	return "DataRequirement"
+}
+
+func (v TaskOutputValueDataRequirement) isTaskOutputValue() {}
+
+// TaskOutputValueExpression is the Expression variant of TaskOutputValue.
+type TaskOutputValueExpression struct {
+	Expression
+}
+
+func (v TaskOutputValueExpression) Validate() error {
+	return v.Expression.Validate()
+}
+
+func (v TaskOutputValueExpression) validateAll(path string, issues *ValidationIssues) {
+	v.Expression.validateAll(path, issues)
+}
+
+func (v TaskOutputValueExpression) FHIRType() string {
+	return "Expression"
+}
+
+func (v TaskOutputValueExpression) isTaskOutputValue() {}
+
+// TaskOutputValueParameterDefinition is the ParameterDefinition variant of TaskOutputValue.
+type TaskOutputValueParameterDefinition struct {
+	ParameterDefinition
+}
+
+func (v TaskOutputValueParameterDefinition) Validate() error {
+	return v.ParameterDefinition.Validate()
+}
+
+func (v TaskOutputValueParameterDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.ParameterDefinition.validateAll(path, issues)
+}
+
+func (v TaskOutputValueParameterDefinition) FHIRType() string {
+	return "ParameterDefinition"
+}
+
+func (v TaskOutputValueParameterDefinition) isTaskOutputValue() {}
+
+// TaskOutputValueRelatedArtifact is the RelatedArtifact variant of TaskOutputValue.
+type TaskOutputValueRelatedArtifact struct {
+	RelatedArtifact
+}
+
+func (v TaskOutputValueRelatedArtifact) Validate() error {
+	return v.RelatedArtifact.Validate()
+}
+
+func (v TaskOutputValueRelatedArtifact) validateAll(path string, issues *ValidationIssues) {
+	v.RelatedArtifact.validateAll(path, issues)
+}
+
+func (v TaskOutputValueRelatedArtifact) FHIRType() string {
+	return "RelatedArtifact"
+}
+
+func (v TaskOutputValueRelatedArtifact) isTaskOutputValue() {}
+
+// TaskOutputValueTriggerDefinition is the TriggerDefinition variant of TaskOutputValue.
+type TaskOutputValueTriggerDefinition struct {
+	TriggerDefinition
+}
+
+func (v TaskOutputValueTriggerDefinition) Validate() error {
+	return v.TriggerDefinition.Validate()
+}
+
+func (v TaskOutputValueTriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	v.TriggerDefinition.validateAll(path, issues)
+}
+
+func (v TaskOutputValueTriggerDefinition) FHIRType() string {
+	return "TriggerDefinition"
+}
+
+func (v TaskOutputValueTriggerDefinition) isTaskOutputValue() {}
+
+// TaskOutputValueUsageContext is the UsageContext variant of TaskOutputValue.
+type TaskOutputValueUsageContext struct {
+	UsageContext
+}
+
+func (v TaskOutputValueUsageContext) Validate() error {
+	return v.UsageContext.Validate()
+}
+
+func (v TaskOutputValueUsageContext) validateAll(path string, issues *ValidationIssues) {
+	v.UsageContext.validateAll(path, issues)
+}
+
+func (v TaskOutputValueUsageContext) FHIRType() string {
+	return "UsageContext"
+}
+
+func (v TaskOutputValueUsageContext) isTaskOutputValue() {}
+
+// TaskOutputValueAvailability is the Availability variant of TaskOutputValue.
+type TaskOutputValueAvailability struct {
+	Availability
+}
+
+func (v TaskOutputValueAvailability) Validate() error {
+	return v.Availability.Validate()
+}
+
+func (v TaskOutputValueAvailability) validateAll(path string, issues *ValidationIssues) {
+	v.Availability.validateAll(path, issues)
+}
+
+func (v TaskOutputValueAvailability) FHIRType() string {
+	return "Availability"
+}
+
+func (v TaskOutputValueAvailability) isTaskOutputValue() {}
+
+// TaskOutputValueExtendedContactDetail is the ExtendedContactDetail variant of TaskOutputValue.
+type TaskOutputValueExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v TaskOutputValueExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v TaskOutputValueExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v TaskOutputValueExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v TaskOutputValueExtendedContactDetail) isTaskOutputValue() {}
+
+// TaskOutputValueVirtualServiceDetail is the VirtualServiceDetail variant of TaskOutputValue.
+type TaskOutputValueVirtualServiceDetail struct {
+	VirtualServiceDetail
+}
+
+func (v TaskOutputValueVirtualServiceDetail) Validate() error {
+	return v.VirtualServiceDetail.Validate()
+}
+
+func (v TaskOutputValueVirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	v.VirtualServiceDetail.validateAll(path, issues)
+}
+
+func (v TaskOutputValueVirtualServiceDetail) FHIRType() string {
+	return "VirtualServiceDetail"
+}
+
+func (v TaskOutputValueVirtualServiceDetail) isTaskOutputValue() {}
+
+// TaskOutputValueDosage is the Dosage variant of TaskOutputValue.
+type TaskOutputValueDosage struct {
+	Dosage
+}
+
+func (v TaskOutputValueDosage) Validate() error {
+	return v.Dosage.Validate()
+}
+
+func (v TaskOutputValueDosage) validateAll(path string, issues *ValidationIssues) {
+	v.Dosage.validateAll(path, issues)
+}
+
+func (v TaskOutputValueDosage) FHIRType() string {
+	return "Dosage"
+}
+
+func (v TaskOutputValueDosage) isTaskOutputValue() {}
+
+// TaskOutputValueMeta is the Meta variant of TaskOutputValue.
+type TaskOutputValueMeta struct {
+	Meta
+}
+
+func (v TaskOutputValueMeta) Validate() error {
+	return v.Meta.Validate()
+}
+
+func (v TaskOutputValueMeta) validateAll(path string, issues *ValidationIssues) {
+	v.Meta.validateAll(path, issues)
+}
+
+func (v TaskOutputValueMeta) FHIRType() string {
+	return "Meta"
+}
+
+func (v TaskOutputValueMeta) isTaskOutputValue() {}
+
+var taskOutputValueVariants = []TaskOutputValue{
+	TaskOutputValueBase64Binary(""),
+	TaskOutputValueBoolean(false),
+	TaskOutputValueCanonical(""),
+	TaskOutputValueCode(""),
+	TaskOutputValueDate{},
+	TaskOutputValueDateTime{},
+	TaskOutputValueDecimal{},
+	TaskOutputValueId(""),
+	TaskOutputValueInstant{},
+	TaskOutputValueInteger(0),
+	TaskOutputValueInteger64(0),
+	TaskOutputValueMarkdown(""),
+	TaskOutputValueOid(""),
+	TaskOutputValuePositiveInt(0),
+	TaskOutputValueString(""),
+	TaskOutputValueTime{},
+	TaskOutputValueUnsignedInt(0),
+	TaskOutputValueUri(""),
+	TaskOutputValueUrl(""),
+	TaskOutputValueUuid{},
+	TaskOutputValueAddress{},
+	TaskOutputValueAge{},
+	TaskOutputValueAnnotation{},
+	TaskOutputValueAttachment{},
+	TaskOutputValueCodeableConcept{},
+	TaskOutputValueCodeableReference{},
+	TaskOutputValueCoding{},
+	TaskOutputValueContactPoint{},
+	TaskOutputValueCount{},
+	TaskOutputValueDistance{},
+	TaskOutputValueDuration{},
+	TaskOutputValueHumanName{},
+	TaskOutputValueIdentifier{},
+	TaskOutputValueMoney{},
+	TaskOutputValuePeriod{},
+	TaskOutputValueQuantity{},
+	TaskOutputValueRange{},
+	TaskOutputValueRatio{},
+	TaskOutputValueRatioRange{},
+	TaskOutputValueReference{},
+	TaskOutputValueSampledData{},
+	TaskOutputValueSignature{},
+	TaskOutputValueTiming{},
+	TaskOutputValueContactDetail{},
+	TaskOutputValueDataRequirement{},
+	TaskOutputValueExpression{},
+	TaskOutputValueParameterDefinition{},
+	TaskOutputValueRelatedArtifact{},
+	TaskOutputValueTriggerDefinition{},
+	TaskOutputValueUsageContext{},
+	TaskOutputValueAvailability{},
+	TaskOutputValueExtendedContactDetail{},
+	TaskOutputValueVirtualServiceDetail{},
+	TaskOutputValueDosage{},
+	TaskOutputValueMeta{},
+}
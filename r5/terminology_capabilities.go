@@ -2,46 +2,68 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A TerminologyCapabilities resource documents a set of capabilities (behaviors) of a FHIR Terminology Server that may be used as a statement of actual server functionality or a statement of required or desired server implementation.
 type TerminologyCapabilities struct {
-	ResourceType           string                                 `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string                                `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta                                  `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string                                `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string                                `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative                             `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage                      `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string                                `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this terminology capabilities, represented as a URI (globally unique)
-	Identifier             []Identifier                           `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the terminology capabilities
-	Version                *string                                `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the terminology capabilities
-	VersionAlgorithmString *string                                `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding                                `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string                                `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this terminology capabilities (computer friendly)
-	Title                  *string                                `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this terminology capabilities (human friendly)
-	Status                 string                                 `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool                                  `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   string                                 `json:"date" bson:"date"`                                                           // Date last changed
-	Publisher              *string                                `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail                        `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string                                `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the terminology capabilities
-	UseContext             []UsageContext                         `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept                      `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the terminology capabilities (if applicable)
-	Purpose                *string                                `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this terminology capabilities is defined
-	Copyright              *string                                `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string                                `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	Kind                   string                                 `json:"kind" bson:"kind"`                                                           // instance | capability | requirements
-	Software               *TerminologyCapabilitiesSoftware       `json:"software,omitempty" bson:"software,omitempty"`                               // Software that is covered by this terminology capability statement
-	Implementation         *TerminologyCapabilitiesImplementation `json:"implementation,omitempty" bson:"implementation,omitempty"`                   // If this describes a specific instance
-	LockedDate             *bool                                  `json:"lockedDate,omitempty" bson:"locked_date,omitempty"`                          // Whether lockedDate is supported
-	CodeSystem             []TerminologyCapabilitiesCodeSystem    `json:"codeSystem,omitempty" bson:"code_system,omitempty"`                          // A code system supported by the server
-	Supplements            *TerminologyCapabilitiesSupplements    `json:"supplements,omitempty" bson:"supplements,omitempty"`                         // Supplement Support Information
-	Expansion              *TerminologyCapabilitiesExpansion      `json:"expansion,omitempty" bson:"expansion,omitempty"`                             // Information about the [ValueSet/$expand](valueset-operation-expand.html) operation
-	CodeSearch             *string                                `json:"codeSearch,omitempty" bson:"code_search,omitempty"`                          // in-compose | in-expansion | in-compose-or-expansion
-	ValidateCode           *TerminologyCapabilitiesValidateCode   `json:"validateCode,omitempty" bson:"validate_code,omitempty"`                      // Information about the [ValueSet/$validate-code](valueset-operation-validate-code.html) operation
-	Translation            *TerminologyCapabilitiesTranslation    `json:"translation,omitempty" bson:"translation,omitempty"`                         // Information about the [ConceptMap/$translate](conceptmap-operation-translate.html) operation
+	ResourceType            string                                  `json:"resourceType" bson:"resource_type"`                                  // Type of resource
+	Id                      *string                                 `json:"id,omitempty" bson:"id,omitempty"`                                   // Logical id of this artifact
+	Meta                    *Meta                                   `json:"meta,omitempty" bson:"meta,omitempty"`                               // Metadata about the resource
+	ImplicitRules           *string                                 `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`            // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                                `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`   // Extensions for implicitRules
+	Language                *string                                 `json:"language,omitempty" bson:"language,omitempty"`                       // Language of the resource content
+	LanguageElement         *Element                                `json:"_language,omitempty" bson:"language_element,omitempty"`              // Extensions for language
+	Text                    *Narrative                              `json:"text,omitempty" bson:"text,omitempty"`                               // Text summary of the resource, for human interpretation
+	Contained               []Resource                              `json:"contained,omitempty" bson:"contained,omitempty"`                     // Contained, inline Resources
+	Extension               []Extension                             `json:"extension,omitempty" bson:"extension,omitempty"`                     // Additional content defined by implementations
+	ModifierExtension       []Extension                             `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`    // Extensions that cannot be ignored
+	Url                     *string                                 `json:"url,omitempty" bson:"url,omitempty"`                                 // Canonical identifier for this terminology capabilities, represented as a URI (globally unique)
+	UrlElement              *Element                                `json:"_url,omitempty" bson:"url_element,omitempty"`                        // Extensions for url
+	Identifier              []Identifier                            `json:"identifier,omitempty" bson:"identifier,omitempty"`                   // Additional identifier for the terminology capabilities
+	Version                 *string                                 `json:"version,omitempty" bson:"version,omitempty"`                         // Business version of the terminology capabilities
+	VersionElement          *Element                                `json:"_version,omitempty" bson:"version_element,omitempty"`                // Extensions for version
+	VersionAlgorithm        TerminologyCapabilitiesVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                               // How to compare versions
+	VersionAlgorithmElement *Element                                `json:"-" bson:"version_algorithm_element,omitempty"`                       // Extensions for versionAlgorithm[x]
+	Name                    *string                                 `json:"name,omitempty" bson:"name,omitempty"`                               // Name for this terminology capabilities (computer friendly)
+	NameElement             *Element                                `json:"_name,omitempty" bson:"name_element,omitempty"`                      // Extensions for name
+	Title                   *string                                 `json:"title,omitempty" bson:"title,omitempty"`                             // Name for this terminology capabilities (human friendly)
+	TitleElement            *Element                                `json:"_title,omitempty" bson:"title_element,omitempty"`                    // Extensions for title
+	Status                  PublicationStatus                       `json:"status" bson:"status"`                                               // draft | active | retired | unknown
+	StatusElement           *Element                                `json:"_status,omitempty" bson:"status_element,omitempty"`                  // Extensions for status
+	Experimental            *bool                                   `json:"experimental,omitempty" bson:"experimental,omitempty"`               // For testing only - never for real usage
+	ExperimentalElement     *Element                                `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`      // Extensions for experimental
+	Date                    *DateTime                               `json:"date" bson:"date"`                                                   // Date last changed
+	DateElement             *Element                                `json:"_date,omitempty" bson:"date_element,omitempty"`                      // Extensions for date
+	Publisher               *string                                 `json:"publisher,omitempty" bson:"publisher,omitempty"`                     // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                                `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`            // Extensions for publisher
+	Contact                 []ContactDetail                         `json:"contact,omitempty" bson:"contact,omitempty"`                         // Contact details for the publisher
+	Description             *string                                 `json:"description,omitempty" bson:"description,omitempty"`                 // Natural language description of the terminology capabilities
+	DescriptionElement      *Element                                `json:"_description,omitempty" bson:"description_element,omitempty"`        // Extensions for description
+	UseContext              []UsageContext                          `json:"useContext,omitempty" bson:"use_context,omitempty"`                  // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept                       `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`               // Jurisdiction of the authority that maintains the terminology capabilities (if applicable)
+	Purpose                 *string                                 `json:"purpose,omitempty" bson:"purpose,omitempty"`                         // Why this terminology capabilities is defined
+	PurposeElement          *Element                                `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                // Extensions for purpose
+	Copyright               *string                                 `json:"copyright,omitempty" bson:"copyright,omitempty"`                     // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                                `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`            // Extensions for copyright
+	CopyrightLabel          *string                                 `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`          // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                                `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"` // Extensions for copyrightLabel
+	Kind                    CapabilityStatementKind                 `json:"kind" bson:"kind"`                                                   // instance | capability | requirements
+	KindElement             *Element                                `json:"_kind,omitempty" bson:"kind_element,omitempty"`                      // Extensions for kind
+	Software                *TerminologyCapabilitiesSoftware        `json:"software,omitempty" bson:"software,omitempty"`                       // Software that is covered by this terminology capability statement
+	Implementation          *TerminologyCapabilitiesImplementation  `json:"implementation,omitempty" bson:"implementation,omitempty"`           // If this describes a specific instance
+	LockedDate              *bool                                   `json:"lockedDate,omitempty" bson:"locked_date,omitempty"`                  // Whether lockedDate is supported
+	LockedDateElement       *Element                                `json:"_lockedDate,omitempty" bson:"locked_date_element,omitempty"`         // Extensions for lockedDate
+	CodeSystem              []TerminologyCapabilitiesCodeSystem     `json:"codeSystem,omitempty" bson:"code_system,omitempty"`                  // A code system supported by the server
+	Supplements             *TerminologyCapabilitiesSupplements     `json:"supplements,omitempty" bson:"supplements,omitempty"`                 // Supplement Support Information
+	Expansion               *TerminologyCapabilitiesExpansion       `json:"expansion,omitempty" bson:"expansion,omitempty"`                     // Information about the [ValueSet/$expand](valueset-operation-expand.html) operation
+	CodeSearch              *CodeSearchSupport                      `json:"codeSearch,omitempty" bson:"code_search,omitempty"`                  // in-compose | in-expansion | in-compose-or-expansion
+	CodeSearchElement       *Element                                `json:"_codeSearch,omitempty" bson:"code_search_element,omitempty"`         // Extensions for codeSearch
+	ValidateCode            *TerminologyCapabilitiesValidateCode    `json:"validateCode,omitempty" bson:"validate_code,omitempty"`              // Information about the [ValueSet/$validate-code](valueset-operation-validate-code.html) operation
+	Translation             *TerminologyCapabilitiesTranslation     `json:"translation,omitempty" bson:"translation,omitempty"`                 // Information about the [ConceptMap/$translate](conceptmap-operation-translate.html) operation
+
+	versionAlgorithmVariants []string // JSON properties of TerminologyCapabilities.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *TerminologyCapabilities) Validate() error {
@@ -53,33 +75,121 @@ func (r *TerminologyCapabilities) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Date == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date == nil {
 		return fmt.Errorf("field 'Date' is required")
 	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -90,9 +200,32 @@ func (r *TerminologyCapabilities) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
-	if r.Kind == emptyString {
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.Kind == "" {
 		return fmt.Errorf("field 'Kind' is required")
 	}
+	if !r.Kind.IsValid() {
+		return fmt.Errorf("field 'Kind' has invalid code '%s'", r.Kind)
+	}
+	if r.KindElement != nil {
+		if err := r.KindElement.Validate(); err != nil {
+			return fmt.Errorf("KindElement: %w", err)
+		}
+	}
 	if r.Software != nil {
 		if err := r.Software.Validate(); err != nil {
 			return fmt.Errorf("Software: %w", err)
@@ -103,6 +236,11 @@ func (r *TerminologyCapabilities) Validate() error {
 			return fmt.Errorf("Implementation: %w", err)
 		}
 	}
+	if r.LockedDateElement != nil {
+		if err := r.LockedDateElement.Validate(); err != nil {
+			return fmt.Errorf("LockedDateElement: %w", err)
+		}
+	}
 	for i, item := range r.CodeSystem {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("CodeSystem[%d]: %w", i, err)
@@ -118,6 +256,14 @@ func (r *TerminologyCapabilities) Validate() error {
 			return fmt.Errorf("Expansion: %w", err)
 		}
 	}
+	if r.CodeSearch != nil && !r.CodeSearch.IsValid() {
+		return fmt.Errorf("field 'CodeSearch' has invalid code '%s'", *r.CodeSearch)
+	}
+	if r.CodeSearchElement != nil {
+		if err := r.CodeSearchElement.Validate(); err != nil {
+			return fmt.Errorf("CodeSearchElement: %w", err)
+		}
+	}
 	if r.ValidateCode != nil {
 		if err := r.ValidateCode.Validate(); err != nil {
 			return fmt.Errorf("ValidateCode: %w", err)
@@ -128,151 +274,1553 @@ func (r *TerminologyCapabilities) Validate() error {
 			return fmt.Errorf("Translation: %w", err)
 		}
 	}
-	return nil
-}
-
-type TerminologyCapabilitiesCodeSystem struct {
-	Id          *string                                    `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Uri         *string                                    `json:"uri,omitempty" bson:"uri,omitempty"`                 // Canonical identifier for the code system, represented as a URI (no version portion)
-	Supplement  []string                                   `json:"supplement,omitempty" bson:"supplement,omitempty"`   // Canonical identifier for a supported supplement to this code system (including supplement version)
-	Version     []TerminologyCapabilitiesCodeSystemVersion `json:"version,omitempty" bson:"version,omitempty"`         // Version of Code System supported
-	Content     string                                     `json:"content" bson:"content"`                             // not-present | example | fragment | complete | supplement
-	Subsumption *bool                                      `json:"subsumption,omitempty" bson:"subsumption,omitempty"` // Whether subsumption is supported
+	return validateInvariants(r, "TerminologyCapabilities")
 }
 
-func (r *TerminologyCapabilitiesCodeSystem) Validate() error {
-	for i, item := range r.Version {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Version[%d]: %w", i, err)
+func (r *TerminologyCapabilities) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "TerminologyCapabilities" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'TerminologyCapabilities', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	var emptyString string
-	if r.Content == emptyString {
-		return fmt.Errorf("field 'Content' is required")
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
+	}
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date == nil {
+		issues.add("required", path+".date", "field 'Date' is required")
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
+		}
+	}
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
+	}
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
+	}
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
+	}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
+	}
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.Kind == "" {
+		issues.add("required", path+".kind", "field 'Kind' is required")
+	}
+	if r.Kind != "" && !r.Kind.IsValid() {
+		issues.add("code-invalid", path+".kind", fmt.Sprintf("field 'Kind' has invalid code '%s'", r.Kind))
+	}
+	if r.KindElement != nil {
+		r.KindElement.validateAll(path+".kind", issues)
+	}
+	if r.Software != nil {
+		r.Software.validateAll(path+".software", issues)
+	}
+	if r.Implementation != nil {
+		r.Implementation.validateAll(path+".implementation", issues)
+	}
+	if r.LockedDateElement != nil {
+		r.LockedDateElement.validateAll(path+".lockedDate", issues)
+	}
+	for i, item := range r.CodeSystem {
+		item.validateAll(fmt.Sprintf("%s.codeSystem[%d]", path, i), issues)
+	}
+	if r.Supplements != nil {
+		r.Supplements.validateAll(path+".supplements", issues)
+	}
+	if r.Expansion != nil {
+		r.Expansion.validateAll(path+".expansion", issues)
+	}
+	if r.CodeSearch != nil && !r.CodeSearch.IsValid() {
+		issues.add("code-invalid", path+".codeSearch", fmt.Sprintf("field 'CodeSearch' has invalid code '%s'", *r.CodeSearch))
+	}
+	if r.CodeSearchElement != nil {
+		r.CodeSearchElement.validateAll(path+".codeSearch", issues)
+	}
+	if r.ValidateCode != nil {
+		r.ValidateCode.validateAll(path+".validateCode", issues)
+	}
+	if r.Translation != nil {
+		r.Translation.validateAll(path+".translation", issues)
 	}
-	return nil
 }
 
-type TerminologyCapabilitiesCodeSystemVersion struct {
-	Id            *string                                          `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Code          *string                                          `json:"code,omitempty" bson:"code,omitempty"`                   // Version identifier for this version
-	IsDefault     *bool                                            `json:"isDefault,omitempty" bson:"is_default,omitempty"`        // If this is the default version for this code system
-	Supplement    []string                                         `json:"supplement,omitempty" bson:"supplement,omitempty"`       // Canonical identifier for a supported supplement to this code system version (including supplement version)
-	Compositional *bool                                            `json:"compositional,omitempty" bson:"compositional,omitempty"` // If compositional grammar is supported
-	Language      []string                                         `json:"language,omitempty" bson:"language,omitempty"`           // Language Displays supported
-	Filter        []TerminologyCapabilitiesCodeSystemVersionFilter `json:"filter,omitempty" bson:"filter,omitempty"`               // Filter Properties supported
-	Property      []string                                         `json:"property,omitempty" bson:"property,omitempty"`           // Properties supported for $lookup
+func (r *TerminologyCapabilities) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("TerminologyCapabilities", &issues)
+	checkInvariants(r, "TerminologyCapabilities", &issues)
+	return issues
 }
 
-func (r *TerminologyCapabilitiesCodeSystemVersion) Validate() error {
-	for i, item := range r.Filter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Filter[%d]: %w", i, err)
+var terminologyCapabilitiesInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *TerminologyCapabilities) invariants() []invariant {
+	return terminologyCapabilitiesInvariants
+}
+
+func (r TerminologyCapabilities) MarshalJSON() ([]byte, error) {
+	type alias TerminologyCapabilities
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *TerminologyCapabilities) UnmarshalJSON(data []byte) error {
+	type alias TerminologyCapabilities
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", terminologyCapabilitiesVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type TerminologyCapabilitiesCodeSystemVersionFilter struct {
-	Id   *string  `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Code string   `json:"code" bson:"code"`                 // Code of the property supported
-	Op   []string `json:"op" bson:"op"`                     // Operations supported for the property
+func (r TerminologyCapabilities) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *TerminologyCapabilitiesCodeSystemVersionFilter) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
-	}
-	if len(r.Op) < 1 {
-		return fmt.Errorf("field 'Op' must have at least 1 elements")
+func (r *TerminologyCapabilities) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilities) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilities) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *TerminologyCapabilities) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(terminologyCapabilitiesVersionAlgorithmVariants)
 	}
-	return nil
+	return "", nil
 }
 
-type TerminologyCapabilitiesSupplements struct {
-	Id      *string `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Globals *string `json:"globals,omitempty" bson:"globals,omitempty"` // not-supported | explicit | implicit
+var terminologyCapabilitiesElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
 }
 
-func (r *TerminologyCapabilitiesSupplements) Validate() error {
+func (r *TerminologyCapabilities) elementTypes() map[string]string {
+	return terminologyCapabilitiesElementTypes
+}
+
+var terminologyCapabilitiesElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "TerminologyCapabilities.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "TerminologyCapabilities.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TerminologyCapabilities.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "TerminologyCapabilities.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "TerminologyCapabilities.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "TerminologyCapabilities.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "TerminologyCapabilities.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "TerminologyCapabilities.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "TerminologyCapabilities.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TerminologyCapabilities.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "TerminologyCapabilities.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.date", Name: "date", Field: 26, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "TerminologyCapabilities.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "TerminologyCapabilities.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "TerminologyCapabilities.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "TerminologyCapabilities.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "TerminologyCapabilities.purpose", Name: "purpose", Field: 35, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "TerminologyCapabilities.copyright", Name: "copyright", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "TerminologyCapabilities.copyrightLabel", Name: "copyrightLabel", Field: 39, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.kind", Name: "kind", Field: 41, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TerminologyCapabilities.kind", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/capability-statement-kind"}},
+	{Path: "TerminologyCapabilities.software", Name: "software", Field: 43, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.implementation", Name: "implementation", Field: 44, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.lockedDate", Name: "lockedDate", Field: 45, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.codeSystem", Name: "codeSystem", Field: 47, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.supplements", Name: "supplements", Field: 48, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.expansion", Name: "expansion", Field: 49, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.codeSearch", Name: "codeSearch", Field: 50, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TerminologyCapabilities.codeSearch", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/code-search-support"}},
+	{Path: "TerminologyCapabilities.validateCode", Name: "validateCode", Field: 52, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.translation", Name: "translation", Field: 53, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *TerminologyCapabilities) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesElementMetadata
+}
+
+// TerminologyCapabilitiesVersionAlgorithm is the type of TerminologyCapabilities.versionAlgorithm[x]. It is implemented by the TerminologyCapabilitiesVersionAlgorithm* variant types.
+type TerminologyCapabilitiesVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isTerminologyCapabilitiesVersionAlgorithm()
+}
+
+// TerminologyCapabilitiesVersionAlgorithmString is the string variant of TerminologyCapabilitiesVersionAlgorithm.
+type TerminologyCapabilitiesVersionAlgorithmString string
+
+func (v TerminologyCapabilitiesVersionAlgorithmString) Validate() error {
 	return nil
 }
 
-type TerminologyCapabilitiesExpansion struct {
-	Id           *string                                     `json:"id,omitempty" bson:"id,omitempty"`                     // Unique id for inter-element referencing
-	Hierarchical *bool                                       `json:"hierarchical,omitempty" bson:"hierarchical,omitempty"` // Whether the server can return nested value sets
-	Paging       *bool                                       `json:"paging,omitempty" bson:"paging,omitempty"`             // Whether the server supports paging on expansion
-	Incomplete   *bool                                       `json:"incomplete,omitempty" bson:"incomplete,omitempty"`     // Allow request for incomplete expansions?
-	Parameter    []TerminologyCapabilitiesExpansionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`       // Supported expansion parameter
-	TextFilter   *string                                     `json:"textFilter,omitempty" bson:"text_filter,omitempty"`    // Documentation about text searching works
+func (v TerminologyCapabilitiesVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {
 }
 
-func (r *TerminologyCapabilitiesExpansion) Validate() error {
-	for i, item := range r.Parameter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Parameter[%d]: %w", i, err)
-		}
+func (v TerminologyCapabilitiesVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v TerminologyCapabilitiesVersionAlgorithmString) isTerminologyCapabilitiesVersionAlgorithm() {}
+
+// TerminologyCapabilitiesVersionAlgorithmCoding is the Coding variant of TerminologyCapabilitiesVersionAlgorithm.
+type TerminologyCapabilitiesVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v TerminologyCapabilitiesVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v TerminologyCapabilitiesVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v TerminologyCapabilitiesVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v TerminologyCapabilitiesVersionAlgorithmCoding) isTerminologyCapabilitiesVersionAlgorithm() {}
+
+var terminologyCapabilitiesVersionAlgorithmVariants = []TerminologyCapabilitiesVersionAlgorithm{
+	TerminologyCapabilitiesVersionAlgorithmString(""),
+	TerminologyCapabilitiesVersionAlgorithmCoding{},
+}
+
+func (r *TerminologyCapabilities) GetResourceType() string {
+	return "TerminologyCapabilities"
+}
+
+func (r *TerminologyCapabilities) GetID() string {
+	if r.Id == nil {
+		return ""
 	}
-	return nil
+	return *r.Id
 }
 
-type TerminologyCapabilitiesValidateCode struct {
-	Id           *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Translations bool    `json:"translations" bson:"translations"` // Whether translations are validated
+func (r *TerminologyCapabilities) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
 }
 
-func (r *TerminologyCapabilitiesValidateCode) Validate() error {
-	return nil
+func (r *TerminologyCapabilities) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *TerminologyCapabilities) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *TerminologyCapabilities) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *TerminologyCapabilities) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *TerminologyCapabilities) GetExtension() []Extension {
+	return r.Extension
 }
 
+var _ DomainResource = (*TerminologyCapabilities)(nil)
+
 type TerminologyCapabilitiesSoftware struct {
-	Id      *string `json:"id,omitempty" bson:"id,omitempty"`           // Unique id for inter-element referencing
-	Name    string  `json:"name" bson:"name"`                           // A name the software is known by
-	Version *string `json:"version,omitempty" bson:"version,omitempty"` // Version covered by this statement
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string      `json:"name" bson:"name"`                                                // A name the software is known by
+	NameElement       *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Version           *string     `json:"version,omitempty" bson:"version,omitempty"`                      // Version covered by this statement
+	VersionElement    *Element    `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
 }
 
 func (r *TerminologyCapabilitiesSoftware) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Name == emptyString {
 		return fmt.Errorf("field 'Name' is required")
 	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
 	return nil
 }
 
+func (r *TerminologyCapabilitiesSoftware) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+}
+
+var terminologyCapabilitiesSoftwareInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesSoftware) invariants() []invariant {
+	return terminologyCapabilitiesSoftwareInvariants
+}
+
+func (r TerminologyCapabilitiesSoftware) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesSoftware) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesSoftware) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesSoftware) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesSoftwareElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.software.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.software.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.software.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.software.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.software.version", Name: "version", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+}
+
+func (r *TerminologyCapabilitiesSoftware) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesSoftwareElementMetadata
+}
+
 type TerminologyCapabilitiesImplementation struct {
-	Id          *string `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Description string  `json:"description" bson:"description"`     // Describes this specific instance
-	Url         *string `json:"url,omitempty" bson:"url,omitempty"` // Base URL for the implementation
+	Id                 *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Description        string      `json:"description" bson:"description"`                                  // Describes this specific instance
+	DescriptionElement *Element    `json:"_description,omitempty" bson:"description_element,omitempty"`     // Extensions for description
+	Url                *string     `json:"url,omitempty" bson:"url,omitempty"`                              // Base URL for the implementation
+	UrlElement         *Element    `json:"_url,omitempty" bson:"url_element,omitempty"`                     // Extensions for url
 }
 
 func (r *TerminologyCapabilitiesImplementation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	var emptyString string
 	if r.Description == emptyString {
 		return fmt.Errorf("field 'Description' is required")
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	return nil
 }
 
-type TerminologyCapabilitiesExpansionParameter struct {
-	Id            *string `json:"id,omitempty" bson:"id,omitempty"`                       // Unique id for inter-element referencing
-	Name          string  `json:"name" bson:"name"`                                       // Name of the supported expansion parameter
-	Documentation *string `json:"documentation,omitempty" bson:"documentation,omitempty"` // Description of support for parameter
-}
-
-func (r *TerminologyCapabilitiesExpansionParameter) Validate() error {
+func (r *TerminologyCapabilitiesImplementation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
 	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+	if r.Description == emptyString {
+		issues.add("required", path+".description", "field 'Description' is required")
+	}
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
+	}
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
+	}
+}
+
+var terminologyCapabilitiesImplementationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesImplementation) invariants() []invariant {
+	return terminologyCapabilitiesImplementationInvariants
+}
+
+func (r TerminologyCapabilitiesImplementation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesImplementation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesImplementation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesImplementation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesImplementationElementTypes = map[string]string{
+	"description": "markdown",
+	"url":         "url",
+}
+
+func (r *TerminologyCapabilitiesImplementation) elementTypes() map[string]string {
+	return terminologyCapabilitiesImplementationElementTypes
+}
+
+var terminologyCapabilitiesImplementationElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.implementation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.implementation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.implementation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.implementation.description", Name: "description", Field: 3, Min: 1, Max: "1", Types: []string{"markdown"}},
+	{Path: "TerminologyCapabilities.implementation.url", Name: "url", Field: 5, Min: 0, Max: "1", Types: []string{"url"}},
+}
+
+func (r *TerminologyCapabilitiesImplementation) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesImplementationElementMetadata
+}
+
+type TerminologyCapabilitiesCodeSystem struct {
+	Id                 *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension          []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension  []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Uri                *string                                    `json:"uri,omitempty" bson:"uri,omitempty"`                              // Canonical identifier for the code system, represented as a URI (no version portion)
+	UriElement         *Element                                   `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+	Supplement         []string                                   `json:"supplement,omitempty" bson:"supplement,omitempty"`                // Canonical identifier for a supported supplement to this code system (including supplement version)
+	SupplementElement  []*Element                                 `json:"_supplement,omitempty" bson:"supplement_element,omitempty"`       // Extensions for supplement
+	Version            []TerminologyCapabilitiesCodeSystemVersion `json:"version,omitempty" bson:"version,omitempty"`                      // Version of Code System supported
+	Content            CodeSystemContentMode                      `json:"content" bson:"content"`                                          // not-present | example | fragment | complete | supplement
+	ContentElement     *Element                                   `json:"_content,omitempty" bson:"content_element,omitempty"`             // Extensions for content
+	Subsumption        *bool                                      `json:"subsumption,omitempty" bson:"subsumption,omitempty"`              // Whether subsumption is supported
+	SubsumptionElement *Element                                   `json:"_subsumption,omitempty" bson:"subsumption_element,omitempty"`     // Extensions for subsumption
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	for i, item := range r.SupplementElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SupplementElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Version {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Version[%d]: %w", i, err)
+		}
+	}
+	if r.Content == "" {
+		return fmt.Errorf("field 'Content' is required")
+	}
+	if !r.Content.IsValid() {
+		return fmt.Errorf("field 'Content' has invalid code '%s'", r.Content)
+	}
+	if r.ContentElement != nil {
+		if err := r.ContentElement.Validate(); err != nil {
+			return fmt.Errorf("ContentElement: %w", err)
+		}
+	}
+	if r.SubsumptionElement != nil {
+		if err := r.SubsumptionElement.Validate(); err != nil {
+			return fmt.Errorf("SubsumptionElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+	for i, item := range r.SupplementElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.supplement[%d]", path, i), issues)
+	}
+	for i, item := range r.Version {
+		item.validateAll(fmt.Sprintf("%s.version[%d]", path, i), issues)
+	}
+	if r.Content == "" {
+		issues.add("required", path+".content", "field 'Content' is required")
+	}
+	if r.Content != "" && !r.Content.IsValid() {
+		issues.add("code-invalid", path+".content", fmt.Sprintf("field 'Content' has invalid code '%s'", r.Content))
+	}
+	if r.ContentElement != nil {
+		r.ContentElement.validateAll(path+".content", issues)
+	}
+	if r.SubsumptionElement != nil {
+		r.SubsumptionElement.validateAll(path+".subsumption", issues)
+	}
+}
+
+var terminologyCapabilitiesCodeSystemInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) invariants() []invariant {
+	return terminologyCapabilitiesCodeSystemInvariants
+}
+
+func (r TerminologyCapabilitiesCodeSystem) MarshalJSON() ([]byte, error) {
+	type alias TerminologyCapabilitiesCodeSystem
+	out := struct {
+		alias
+		Supplement        []*string  `json:"supplement,omitempty"`
+		SupplementElement []*Element `json:"_supplement,omitempty"`
+	}{alias: alias(r)}
+	out.Supplement, out.SupplementElement = alignPrimitiveArray(r.Supplement, r.SupplementElement)
+	return json.Marshal(out)
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalJSON(data []byte) error {
+	type alias TerminologyCapabilitiesCodeSystem
+	aux := struct {
+		*alias
+		Supplement []*string `json:"supplement,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Supplement, err = splitPrimitiveArray(aux.Supplement, r.SupplementElement); err != nil {
+		return fmt.Errorf("supplement: %w", err)
+	}
+	return nil
+}
+
+func (r TerminologyCapabilitiesCodeSystem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesCodeSystem) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesCodeSystemElementTypes = map[string]string{
+	"uri":        "canonical",
+	"supplement": "canonical",
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) elementTypes() map[string]string {
+	return terminologyCapabilitiesCodeSystemElementTypes
+}
+
+var terminologyCapabilitiesCodeSystemElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.codeSystem.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.codeSystem.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.codeSystem.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.codeSystem.uri", Name: "uri", Field: 3, Min: 0, Max: "1", Types: []string{"canonical"}},
+	{Path: "TerminologyCapabilities.codeSystem.supplement", Name: "supplement", Field: 5, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "TerminologyCapabilities.codeSystem.version", Name: "version", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.codeSystem.content", Name: "content", Field: 8, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TerminologyCapabilities.codeSystem.content", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/codesystem-content-mode"}},
+	{Path: "TerminologyCapabilities.codeSystem.subsumption", Name: "subsumption", Field: 10, Min: 0, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *TerminologyCapabilitiesCodeSystem) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesCodeSystemElementMetadata
+}
+
+type TerminologyCapabilitiesCodeSystemVersion struct {
+	Id                   *string                                          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension                                      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension                                      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code                 *string                                          `json:"code,omitempty" bson:"code,omitempty"`                            // Version identifier for this version
+	CodeElement          *Element                                         `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	IsDefault            *bool                                            `json:"isDefault,omitempty" bson:"is_default,omitempty"`                 // If this is the default version for this code system
+	IsDefaultElement     *Element                                         `json:"_isDefault,omitempty" bson:"is_default_element,omitempty"`        // Extensions for isDefault
+	Supplement           []string                                         `json:"supplement,omitempty" bson:"supplement,omitempty"`                // Canonical identifier for a supported supplement to this code system version (including supplement version)
+	SupplementElement    []*Element                                       `json:"_supplement,omitempty" bson:"supplement_element,omitempty"`       // Extensions for supplement
+	Compositional        *bool                                            `json:"compositional,omitempty" bson:"compositional,omitempty"`          // If compositional grammar is supported
+	CompositionalElement *Element                                         `json:"_compositional,omitempty" bson:"compositional_element,omitempty"` // Extensions for compositional
+	Language             []string                                         `json:"language,omitempty" bson:"language,omitempty"`                    // Language Displays supported
+	LanguageElement      []*Element                                       `json:"_language,omitempty" bson:"language_element,omitempty"`           // Extensions for language
+	Filter               []TerminologyCapabilitiesCodeSystemVersionFilter `json:"filter,omitempty" bson:"filter,omitempty"`                        // Filter Properties supported
+	Property             []string                                         `json:"property,omitempty" bson:"property,omitempty"`                    // Properties supported for $lookup
+	PropertyElement      []*Element                                       `json:"_property,omitempty" bson:"property_element,omitempty"`           // Extensions for property
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.IsDefaultElement != nil {
+		if err := r.IsDefaultElement.Validate(); err != nil {
+			return fmt.Errorf("IsDefaultElement: %w", err)
+		}
+	}
+	for i, item := range r.SupplementElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SupplementElement[%d]: %w", i, err)
+		}
+	}
+	if r.CompositionalElement != nil {
+		if err := r.CompositionalElement.Validate(); err != nil {
+			return fmt.Errorf("CompositionalElement: %w", err)
+		}
+	}
+	for i, item := range r.LanguageElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Filter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Filter[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.PropertyElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PropertyElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.IsDefaultElement != nil {
+		r.IsDefaultElement.validateAll(path+".isDefault", issues)
+	}
+	for i, item := range r.SupplementElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.supplement[%d]", path, i), issues)
+	}
+	if r.CompositionalElement != nil {
+		r.CompositionalElement.validateAll(path+".compositional", issues)
+	}
+	for i, item := range r.LanguageElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.language[%d]", path, i), issues)
+	}
+	for i, item := range r.Filter {
+		item.validateAll(fmt.Sprintf("%s.filter[%d]", path, i), issues)
+	}
+	for i, item := range r.PropertyElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+}
+
+var terminologyCapabilitiesCodeSystemVersionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) invariants() []invariant {
+	return terminologyCapabilitiesCodeSystemVersionInvariants
+}
+
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalJSON() ([]byte, error) {
+	type alias TerminologyCapabilitiesCodeSystemVersion
+	out := struct {
+		alias
+		Supplement        []*string  `json:"supplement,omitempty"`
+		SupplementElement []*Element `json:"_supplement,omitempty"`
+		Language          []*string  `json:"language,omitempty"`
+		LanguageElement   []*Element `json:"_language,omitempty"`
+		Property          []*string  `json:"property,omitempty"`
+		PropertyElement   []*Element `json:"_property,omitempty"`
+	}{alias: alias(r)}
+	out.Supplement, out.SupplementElement = alignPrimitiveArray(r.Supplement, r.SupplementElement)
+	out.Language, out.LanguageElement = alignPrimitiveArray(r.Language, r.LanguageElement)
+	out.Property, out.PropertyElement = alignPrimitiveArray(r.Property, r.PropertyElement)
+	return json.Marshal(out)
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalJSON(data []byte) error {
+	type alias TerminologyCapabilitiesCodeSystemVersion
+	aux := struct {
+		*alias
+		Supplement []*string `json:"supplement,omitempty"`
+		Language   []*string `json:"language,omitempty"`
+		Property   []*string `json:"property,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Supplement, err = splitPrimitiveArray(aux.Supplement, r.SupplementElement); err != nil {
+		return fmt.Errorf("supplement: %w", err)
+	}
+	if r.Language, err = splitPrimitiveArray(aux.Language, r.LanguageElement); err != nil {
+		return fmt.Errorf("language: %w", err)
+	}
+	if r.Property, err = splitPrimitiveArray(aux.Property, r.PropertyElement); err != nil {
+		return fmt.Errorf("property: %w", err)
+	}
+	return nil
+}
+
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesCodeSystemVersion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesCodeSystemVersionElementTypes = map[string]string{
+	"supplement": "canonical",
+	"language":   "code",
+	"property":   "code",
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) elementTypes() map[string]string {
+	return terminologyCapabilitiesCodeSystemVersionElementTypes
+}
+
+var terminologyCapabilitiesCodeSystemVersionElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.codeSystem.version.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.codeSystem.version.code", Name: "code", Field: 3, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.isDefault", Name: "isDefault", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.supplement", Name: "supplement", Field: 7, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.compositional", Name: "compositional", Field: 9, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.language", Name: "language", Field: 11, Min: 0, Max: "*", Types: []string{"code"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.filter", Name: "filter", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.property", Name: "property", Field: 14, Min: 0, Max: "*", Types: []string{"code"}},
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersion) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesCodeSystemVersionElementMetadata
+}
+
+type TerminologyCapabilitiesCodeSystemVersionFilter struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string      `json:"code" bson:"code"`                                                // Code of the property supported
+	CodeElement       *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Op                []string    `json:"op" bson:"op"`                                                    // Operations supported for the property
+	OpElement         []*Element  `json:"_op,omitempty" bson:"op_element,omitempty"`                       // Extensions for op
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if len(r.Op) < 1 {
+		return fmt.Errorf("field 'Op' must have at least 1 elements")
+	}
+	for i, item := range r.OpElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("OpElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if len(r.Op) < 1 {
+		issues.add("required", path+".op", "field 'Op' must have at least 1 elements")
+	}
+	for i, item := range r.OpElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.op[%d]", path, i), issues)
+	}
+}
+
+var terminologyCapabilitiesCodeSystemVersionFilterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) invariants() []invariant {
+	return terminologyCapabilitiesCodeSystemVersionFilterInvariants
+}
+
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalJSON() ([]byte, error) {
+	type alias TerminologyCapabilitiesCodeSystemVersionFilter
+	out := struct {
+		alias
+		Op        []*string  `json:"op"`
+		OpElement []*Element `json:"_op,omitempty"`
+	}{alias: alias(r)}
+	out.Op, out.OpElement = alignPrimitiveArray(r.Op, r.OpElement)
+	return json.Marshal(out)
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalJSON(data []byte) error {
+	type alias TerminologyCapabilitiesCodeSystemVersionFilter
+	aux := struct {
+		*alias
+		Op []*string `json:"op"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Op, err = splitPrimitiveArray(aux.Op, r.OpElement); err != nil {
+		return fmt.Errorf("op: %w", err)
+	}
+	return nil
+}
+
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesCodeSystemVersionFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesCodeSystemVersionFilterElementTypes = map[string]string{
+	"code": "code",
+	"op":   "code",
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) elementTypes() map[string]string {
+	return terminologyCapabilitiesCodeSystemVersionFilterElementTypes
+}
+
+var terminologyCapabilitiesCodeSystemVersionFilterElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.codeSystem.version.filter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.filter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.filter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.codeSystem.version.filter.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "TerminologyCapabilities.codeSystem.version.filter.op", Name: "op", Field: 5, Min: 1, Max: "*", Types: []string{"code"}},
+}
+
+func (r *TerminologyCapabilitiesCodeSystemVersionFilter) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesCodeSystemVersionFilterElementMetadata
+}
+
+type TerminologyCapabilitiesSupplements struct {
+	Id                *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Globals           *GlobalLangPackSupportVS `json:"globals,omitempty" bson:"globals,omitempty"`                      // not-supported | explicit | implicit
+	GlobalsElement    *Element                 `json:"_globals,omitempty" bson:"globals_element,omitempty"`             // Extensions for globals
+}
+
+func (r *TerminologyCapabilitiesSupplements) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Globals != nil && !r.Globals.IsValid() {
+		return fmt.Errorf("field 'Globals' has invalid code '%s'", *r.Globals)
+	}
+	if r.GlobalsElement != nil {
+		if err := r.GlobalsElement.Validate(); err != nil {
+			return fmt.Errorf("GlobalsElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *TerminologyCapabilitiesSupplements) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Globals != nil && !r.Globals.IsValid() {
+		issues.add("code-invalid", path+".globals", fmt.Sprintf("field 'Globals' has invalid code '%s'", *r.Globals))
+	}
+	if r.GlobalsElement != nil {
+		r.GlobalsElement.validateAll(path+".globals", issues)
+	}
+}
+
+var terminologyCapabilitiesSupplementsInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesSupplements) invariants() []invariant {
+	return terminologyCapabilitiesSupplementsInvariants
+}
+
+func (r TerminologyCapabilitiesSupplements) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesSupplements) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesSupplements) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesSupplements) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesSupplementsElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.supplements.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.supplements.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.supplements.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.supplements.globals", Name: "globals", Field: 3, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TerminologyCapabilities.supplements.globals", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/global-langpack-support"}},
+}
+
+func (r *TerminologyCapabilitiesSupplements) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesSupplementsElementMetadata
+}
+
+type TerminologyCapabilitiesExpansion struct {
+	Id                  *string                                     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension                                 `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension                                 `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Hierarchical        *bool                                       `json:"hierarchical,omitempty" bson:"hierarchical,omitempty"`            // Whether the server can return nested value sets
+	HierarchicalElement *Element                                    `json:"_hierarchical,omitempty" bson:"hierarchical_element,omitempty"`   // Extensions for hierarchical
+	Paging              *bool                                       `json:"paging,omitempty" bson:"paging,omitempty"`                        // Whether the server supports paging on expansion
+	PagingElement       *Element                                    `json:"_paging,omitempty" bson:"paging_element,omitempty"`               // Extensions for paging
+	Incomplete          *bool                                       `json:"incomplete,omitempty" bson:"incomplete,omitempty"`                // Allow request for incomplete expansions?
+	IncompleteElement   *Element                                    `json:"_incomplete,omitempty" bson:"incomplete_element,omitempty"`       // Extensions for incomplete
+	Parameter           []TerminologyCapabilitiesExpansionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                  // Supported expansion parameter
+	TextFilter          *string                                     `json:"textFilter,omitempty" bson:"text_filter,omitempty"`               // Documentation about text searching works
+	TextFilterElement   *Element                                    `json:"_textFilter,omitempty" bson:"text_filter_element,omitempty"`      // Extensions for textFilter
+}
+
+func (r *TerminologyCapabilitiesExpansion) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.HierarchicalElement != nil {
+		if err := r.HierarchicalElement.Validate(); err != nil {
+			return fmt.Errorf("HierarchicalElement: %w", err)
+		}
+	}
+	if r.PagingElement != nil {
+		if err := r.PagingElement.Validate(); err != nil {
+			return fmt.Errorf("PagingElement: %w", err)
+		}
+	}
+	if r.IncompleteElement != nil {
+		if err := r.IncompleteElement.Validate(); err != nil {
+			return fmt.Errorf("IncompleteElement: %w", err)
+		}
+	}
+	for i, item := range r.Parameter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Parameter[%d]: %w", i, err)
+		}
+	}
+	if r.TextFilterElement != nil {
+		if err := r.TextFilterElement.Validate(); err != nil {
+			return fmt.Errorf("TextFilterElement: %w", err)
+		}
 	}
 	return nil
 }
 
+func (r *TerminologyCapabilitiesExpansion) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.HierarchicalElement != nil {
+		r.HierarchicalElement.validateAll(path+".hierarchical", issues)
+	}
+	if r.PagingElement != nil {
+		r.PagingElement.validateAll(path+".paging", issues)
+	}
+	if r.IncompleteElement != nil {
+		r.IncompleteElement.validateAll(path+".incomplete", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	if r.TextFilterElement != nil {
+		r.TextFilterElement.validateAll(path+".textFilter", issues)
+	}
+}
+
+var terminologyCapabilitiesExpansionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesExpansion) invariants() []invariant {
+	return terminologyCapabilitiesExpansionInvariants
+}
+
+func (r TerminologyCapabilitiesExpansion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesExpansion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesExpansion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesExpansion) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesExpansionElementTypes = map[string]string{
+	"textFilter": "markdown",
+}
+
+func (r *TerminologyCapabilitiesExpansion) elementTypes() map[string]string {
+	return terminologyCapabilitiesExpansionElementTypes
+}
+
+var terminologyCapabilitiesExpansionElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.expansion.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.expansion.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.expansion.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.expansion.hierarchical", Name: "hierarchical", Field: 3, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.expansion.paging", Name: "paging", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.expansion.incomplete", Name: "incomplete", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "TerminologyCapabilities.expansion.parameter", Name: "parameter", Field: 9, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "TerminologyCapabilities.expansion.textFilter", Name: "textFilter", Field: 10, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *TerminologyCapabilitiesExpansion) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesExpansionElementMetadata
+}
+
+type TerminologyCapabilitiesExpansionParameter struct {
+	Id                   *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension            []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension    []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name                 string      `json:"name" bson:"name"`                                                // Name of the supported expansion parameter
+	NameElement          *Element    `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Documentation        *string     `json:"documentation,omitempty" bson:"documentation,omitempty"`          // Description of support for parameter
+	DocumentationElement *Element    `json:"_documentation,omitempty" bson:"documentation_element,omitempty"` // Extensions for documentation
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.DocumentationElement != nil {
+		if err := r.DocumentationElement.Validate(); err != nil {
+			return fmt.Errorf("DocumentationElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.DocumentationElement != nil {
+		r.DocumentationElement.validateAll(path+".documentation", issues)
+	}
+}
+
+var terminologyCapabilitiesExpansionParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) invariants() []invariant {
+	return terminologyCapabilitiesExpansionParameterInvariants
+}
+
+func (r TerminologyCapabilitiesExpansionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesExpansionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesExpansionParameterElementTypes = map[string]string{
+	"name":          "code",
+	"documentation": "markdown",
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) elementTypes() map[string]string {
+	return terminologyCapabilitiesExpansionParameterElementTypes
+}
+
+var terminologyCapabilitiesExpansionParameterElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.expansion.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.expansion.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.expansion.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.expansion.parameter.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "TerminologyCapabilities.expansion.parameter.documentation", Name: "documentation", Field: 5, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *TerminologyCapabilitiesExpansionParameter) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesExpansionParameterElementMetadata
+}
+
+type TerminologyCapabilitiesValidateCode struct {
+	Id                  *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension           []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension   []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Translations        bool        `json:"translations" bson:"translations"`                                // Whether translations are validated
+	TranslationsElement *Element    `json:"_translations,omitempty" bson:"translations_element,omitempty"`   // Extensions for translations
+}
+
+func (r *TerminologyCapabilitiesValidateCode) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.TranslationsElement != nil {
+		if err := r.TranslationsElement.Validate(); err != nil {
+			return fmt.Errorf("TranslationsElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *TerminologyCapabilitiesValidateCode) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.TranslationsElement != nil {
+		r.TranslationsElement.validateAll(path+".translations", issues)
+	}
+}
+
+var terminologyCapabilitiesValidateCodeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesValidateCode) invariants() []invariant {
+	return terminologyCapabilitiesValidateCodeInvariants
+}
+
+func (r TerminologyCapabilitiesValidateCode) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesValidateCode) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesValidateCode) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesValidateCode) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesValidateCodeElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.validateCode.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.validateCode.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.validateCode.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.validateCode.translations", Name: "translations", Field: 3, Min: 1, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *TerminologyCapabilitiesValidateCode) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesValidateCodeElementMetadata
+}
+
 type TerminologyCapabilitiesTranslation struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	NeedsMap bool    `json:"needsMap" bson:"needs_map"`        // Whether the client must identify the map
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	NeedsMap          bool        `json:"needsMap" bson:"needs_map"`                                       // Whether the client must identify the map
+	NeedsMapElement   *Element    `json:"_needsMap,omitempty" bson:"needs_map_element,omitempty"`          // Extensions for needsMap
 }
 
 func (r *TerminologyCapabilitiesTranslation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.NeedsMapElement != nil {
+		if err := r.NeedsMapElement.Validate(); err != nil {
+			return fmt.Errorf("NeedsMapElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *TerminologyCapabilitiesTranslation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.NeedsMapElement != nil {
+		r.NeedsMapElement.validateAll(path+".needsMap", issues)
+	}
+}
+
+var terminologyCapabilitiesTranslationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *TerminologyCapabilitiesTranslation) invariants() []invariant {
+	return terminologyCapabilitiesTranslationInvariants
+}
+
+func (r TerminologyCapabilitiesTranslation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TerminologyCapabilitiesTranslation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TerminologyCapabilitiesTranslation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TerminologyCapabilitiesTranslation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var terminologyCapabilitiesTranslationElementMetadata = []ElementMetadata{
+	{Path: "TerminologyCapabilities.translation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TerminologyCapabilities.translation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TerminologyCapabilities.translation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "TerminologyCapabilities.translation.needsMap", Name: "needsMap", Field: 3, Min: 1, Max: "1", Types: []string{"boolean"}},
+}
+
+func (r *TerminologyCapabilitiesTranslation) ElementMetadata() []ElementMetadata {
+	return terminologyCapabilitiesTranslationElementMetadata
+}
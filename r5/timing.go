@@ -1,18 +1,46 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // Timing Type: Specifies an event that may occur multiple times. Timing schedules are used to record when things are planned, expected or requested to occur. The most common usage is in dosage instructions for medications. They are also used when planning care of various kinds, and may be used for reporting the schedule to which past regular activities were carried out.
 type Timing struct {
-	Id     *string          `json:"id,omitempty" bson:"id,omitempty"`         // Unique id for inter-element referencing
-	Event  []string         `json:"event,omitempty" bson:"event,omitempty"`   // When the event occurs
-	Repeat *TimingRepeat    `json:"repeat,omitempty" bson:"repeat,omitempty"` // When the event is to occur
-	Code   *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`     // C | BID | TID | QID | AM | PM | QD | QOD | +
+	Id                *string          `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension      `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension      `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Event             []DateTime       `json:"event,omitempty" bson:"event,omitempty"`                          // When the event occurs
+	EventElement      []*Element       `json:"_event,omitempty" bson:"event_element,omitempty"`                 // Extensions for event
+	Repeat            *TimingRepeat    `json:"repeat,omitempty" bson:"repeat,omitempty"`                        // When the event is to occur
+	Code              *CodeableConcept `json:"code,omitempty" bson:"code,omitempty"`                            // C | BID | TID | QID | AM | PM | QD | QOD | +
 }
 
 func (r *Timing) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Event {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Event[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.EventElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("EventElement[%d]: %w", i, err)
+		}
+	}
 	if r.Repeat != nil {
 		if err := r.Repeat.Validate(); err != nil {
 			return fmt.Errorf("Repeat: %w", err)
@@ -26,43 +54,229 @@ func (r *Timing) Validate() error {
 	return nil
 }
 
+func (r *Timing) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Event {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.event[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.EventElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.event[%d]", path, i), issues)
+	}
+	if r.Repeat != nil {
+		r.Repeat.validateAll(path+".repeat", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+}
+
+func (r *Timing) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("Timing", &issues)
+	checkInvariants(r, "Timing", &issues)
+	return issues
+}
+
+var timingInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *Timing) invariants() []invariant {
+	return timingInvariants
+}
+
+func (r Timing) MarshalJSON() ([]byte, error) {
+	type alias Timing
+	out := struct {
+		alias
+		Event        []*DateTime `json:"event,omitempty"`
+		EventElement []*Element  `json:"_event,omitempty"`
+	}{alias: alias(r)}
+	out.Event, out.EventElement = alignPrimitiveArray(r.Event, r.EventElement)
+	return json.Marshal(out)
+}
+
+func (r *Timing) UnmarshalJSON(data []byte) error {
+	type alias Timing
+	aux := struct {
+		*alias
+		Event []*DateTime `json:"event,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Event, err = splitPrimitiveArray(aux.Event, r.EventElement); err != nil {
+		return fmt.Errorf("event: %w", err)
+	}
+	return nil
+}
+
+func (r Timing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *Timing) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r Timing) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *Timing) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var timingElementMetadata = []ElementMetadata{
+	{Path: "Timing.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Timing.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Timing.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "Timing.event", Name: "event", Field: 3, Min: 0, Max: "*", Types: []string{"dateTime"}, Summary: true},
+	{Path: "Timing.repeat", Name: "repeat", Field: 5, Min: 0, Max: "1", Types: []string{"Element"}, Summary: true},
+	{Path: "Timing.code", Name: "code", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Binding: &BindingDefinition{Path: "Timing.code", Strength: "preferred", ValueSet: "http://hl7.org/fhir/ValueSet/timing-abbreviation", Description: "Code for a known / defined timing pattern."}, Summary: true},
+}
+
+func (r *Timing) ElementMetadata() []ElementMetadata {
+	return timingElementMetadata
+}
+
 type TimingRepeat struct {
-	Id             *string   `json:"id,omitempty" bson:"id,omitempty"`                          // Unique id for inter-element referencing
-	BoundsDuration *Duration `json:"boundsDuration,omitempty" bson:"bounds_duration,omitempty"` // Length/Range of lengths, or (Start and/or end) limits
-	BoundsRange    *Range    `json:"boundsRange,omitempty" bson:"bounds_range,omitempty"`       // Length/Range of lengths, or (Start and/or end) limits
-	BoundsPeriod   *Period   `json:"boundsPeriod,omitempty" bson:"bounds_period,omitempty"`     // Length/Range of lengths, or (Start and/or end) limits
-	Count          *int      `json:"count,omitempty" bson:"count,omitempty"`                    // Number of times to repeat
-	CountMax       *int      `json:"countMax,omitempty" bson:"count_max,omitempty"`             // Maximum number of times to repeat
-	Duration       *float64  `json:"duration,omitempty" bson:"duration,omitempty"`              // How long when it happens
-	DurationMax    *float64  `json:"durationMax,omitempty" bson:"duration_max,omitempty"`       // How long when it happens (Max)
-	DurationUnit   *string   `json:"durationUnit,omitempty" bson:"duration_unit,omitempty"`     // s | min | h | d | wk | mo | a - unit of time (UCUM)
-	Frequency      *int      `json:"frequency,omitempty" bson:"frequency,omitempty"`            // Indicates the number of repetitions that should occur within a period. I.e. Event occurs frequency times per period
-	FrequencyMax   *int      `json:"frequencyMax,omitempty" bson:"frequency_max,omitempty"`     // Event occurs up to frequencyMax times per period
-	Period         *float64  `json:"period,omitempty" bson:"period,omitempty"`                  // The duration to which the frequency applies. I.e. Event occurs frequency times per period
-	PeriodMax      *float64  `json:"periodMax,omitempty" bson:"period_max,omitempty"`           // Upper limit of period (3-4 hours)
-	PeriodUnit     *string   `json:"periodUnit,omitempty" bson:"period_unit,omitempty"`         // s | min | h | d | wk | mo | a - unit of time (UCUM)
-	StartOffset    *Quantity `json:"startOffset,omitempty" bson:"start_offset,omitempty"`       // Events within the repeat period do not start until startOffset has elapsed
-	EndOffset      *Quantity `json:"endOffset,omitempty" bson:"end_offset,omitempty"`           // Events within the repeat period step once endOffset before the end of the period
-	DayOfWeek      []string  `json:"dayOfWeek,omitempty" bson:"day_of_week,omitempty"`          // mon | tue | wed | thu | fri | sat | sun
-	TimeOfDay      []string  `json:"timeOfDay,omitempty" bson:"time_of_day,omitempty"`          // Time of day for action
-	When           []string  `json:"when,omitempty" bson:"when,omitempty"`                      // Code for time period of occurrence
-	Offset         *int      `json:"offset,omitempty" bson:"offset,omitempty"`                  // Minutes from event (before or after)
+	Id                  *string            `json:"id,omitempty" bson:"id,omitempty"`                               // Unique id for inter-element referencing
+	Extension           []Extension        `json:"extension,omitempty" bson:"extension,omitempty"`                 // Additional content defined by implementations
+	Bounds              TimingRepeatBounds `json:"-" bson:"bounds,omitempty"`                                      // Length/Range of lengths, or (Start and/or end) limits
+	Count               *int               `json:"count,omitempty" bson:"count,omitempty"`                         // Number of times to repeat
+	CountElement        *Element           `json:"_count,omitempty" bson:"count_element,omitempty"`                // Extensions for count
+	CountMax            *int               `json:"countMax,omitempty" bson:"count_max,omitempty"`                  // Maximum number of times to repeat
+	CountMaxElement     *Element           `json:"_countMax,omitempty" bson:"count_max_element,omitempty"`         // Extensions for countMax
+	Duration            *Decimal           `json:"duration,omitempty" bson:"duration,omitempty"`                   // How long when it happens
+	DurationElement     *Element           `json:"_duration,omitempty" bson:"duration_element,omitempty"`          // Extensions for duration
+	DurationMax         *Decimal           `json:"durationMax,omitempty" bson:"duration_max,omitempty"`            // How long when it happens (Max)
+	DurationMaxElement  *Element           `json:"_durationMax,omitempty" bson:"duration_max_element,omitempty"`   // Extensions for durationMax
+	DurationUnit        *UnitsOfTime       `json:"durationUnit,omitempty" bson:"duration_unit,omitempty"`          // s | min | h | d | wk | mo | a - unit of time (UCUM)
+	DurationUnitElement *Element           `json:"_durationUnit,omitempty" bson:"duration_unit_element,omitempty"` // Extensions for durationUnit
+	Frequency           *int               `json:"frequency,omitempty" bson:"frequency,omitempty"`                 // Indicates the number of repetitions that should occur within a period. I.e. Event occurs frequency times per period
+	FrequencyElement    *Element           `json:"_frequency,omitempty" bson:"frequency_element,omitempty"`        // Extensions for frequency
+	FrequencyMax        *int               `json:"frequencyMax,omitempty" bson:"frequency_max,omitempty"`          // Event occurs up to frequencyMax times per period
+	FrequencyMaxElement *Element           `json:"_frequencyMax,omitempty" bson:"frequency_max_element,omitempty"` // Extensions for frequencyMax
+	Period              *Decimal           `json:"period,omitempty" bson:"period,omitempty"`                       // The duration to which the frequency applies. I.e. Event occurs frequency times per period
+	PeriodElement       *Element           `json:"_period,omitempty" bson:"period_element,omitempty"`              // Extensions for period
+	PeriodMax           *Decimal           `json:"periodMax,omitempty" bson:"period_max,omitempty"`                // Upper limit of period (3-4 hours)
+	PeriodMaxElement    *Element           `json:"_periodMax,omitempty" bson:"period_max_element,omitempty"`       // Extensions for periodMax
+	PeriodUnit          *UnitsOfTime       `json:"periodUnit,omitempty" bson:"period_unit,omitempty"`              // s | min | h | d | wk | mo | a - unit of time (UCUM)
+	PeriodUnitElement   *Element           `json:"_periodUnit,omitempty" bson:"period_unit_element,omitempty"`     // Extensions for periodUnit
+	StartOffset         *Quantity          `json:"startOffset,omitempty" bson:"start_offset,omitempty"`            // Events within the repeat period do not start until startOffset has elapsed
+	EndOffset           *Quantity          `json:"endOffset,omitempty" bson:"end_offset,omitempty"`                // Events within the repeat period step once endOffset before the end of the period
+	DayOfWeek           []DaysOfWeek       `json:"dayOfWeek,omitempty" bson:"day_of_week,omitempty"`               // mon | tue | wed | thu | fri | sat | sun
+	DayOfWeekElement    []*Element         `json:"_dayOfWeek,omitempty" bson:"day_of_week_element,omitempty"`      // Extensions for dayOfWeek
+	TimeOfDay           []Time             `json:"timeOfDay,omitempty" bson:"time_of_day,omitempty"`               // Time of day for action
+	TimeOfDayElement    []*Element         `json:"_timeOfDay,omitempty" bson:"time_of_day_element,omitempty"`      // Extensions for timeOfDay
+	When                []EventTiming      `json:"when,omitempty" bson:"when,omitempty"`                           // Code for time period of occurrence
+	WhenElement         []*Element         `json:"_when,omitempty" bson:"when_element,omitempty"`                  // Extensions for when
+	Offset              *int               `json:"offset,omitempty" bson:"offset,omitempty"`                       // Minutes from event (before or after)
+	OffsetElement       *Element           `json:"_offset,omitempty" bson:"offset_element,omitempty"`              // Extensions for offset
+
+	boundsVariants []string // JSON properties of Timing.repeat.bounds[x] when more than one was decoded
 }
 
 func (r *TimingRepeat) Validate() error {
-	if r.BoundsDuration != nil {
-		if err := r.BoundsDuration.Validate(); err != nil {
-			return fmt.Errorf("BoundsDuration: %w", err)
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if len(r.boundsVariants) > 1 {
+		return fmt.Errorf("field 'Bounds' must have a single type, got %v", r.boundsVariants)
+	}
+	if r.Bounds != nil {
+		if err := r.Bounds.Validate(); err != nil {
+			return fmt.Errorf("Bounds: %w", err)
 		}
 	}
-	if r.BoundsRange != nil {
-		if err := r.BoundsRange.Validate(); err != nil {
-			return fmt.Errorf("BoundsRange: %w", err)
+	if r.CountElement != nil {
+		if err := r.CountElement.Validate(); err != nil {
+			return fmt.Errorf("CountElement: %w", err)
 		}
 	}
-	if r.BoundsPeriod != nil {
-		if err := r.BoundsPeriod.Validate(); err != nil {
-			return fmt.Errorf("BoundsPeriod: %w", err)
+	if r.CountMaxElement != nil {
+		if err := r.CountMaxElement.Validate(); err != nil {
+			return fmt.Errorf("CountMaxElement: %w", err)
+		}
+	}
+	if r.Duration != nil {
+		if err := r.Duration.Validate(); err != nil {
+			return fmt.Errorf("Duration: %w", err)
+		}
+	}
+	if r.DurationElement != nil {
+		if err := r.DurationElement.Validate(); err != nil {
+			return fmt.Errorf("DurationElement: %w", err)
+		}
+	}
+	if r.DurationMax != nil {
+		if err := r.DurationMax.Validate(); err != nil {
+			return fmt.Errorf("DurationMax: %w", err)
+		}
+	}
+	if r.DurationMaxElement != nil {
+		if err := r.DurationMaxElement.Validate(); err != nil {
+			return fmt.Errorf("DurationMaxElement: %w", err)
+		}
+	}
+	if r.DurationUnit != nil && !r.DurationUnit.IsValid() {
+		return fmt.Errorf("field 'DurationUnit' has invalid code '%s'", *r.DurationUnit)
+	}
+	if r.DurationUnitElement != nil {
+		if err := r.DurationUnitElement.Validate(); err != nil {
+			return fmt.Errorf("DurationUnitElement: %w", err)
+		}
+	}
+	if r.FrequencyElement != nil {
+		if err := r.FrequencyElement.Validate(); err != nil {
+			return fmt.Errorf("FrequencyElement: %w", err)
+		}
+	}
+	if r.FrequencyMaxElement != nil {
+		if err := r.FrequencyMaxElement.Validate(); err != nil {
+			return fmt.Errorf("FrequencyMaxElement: %w", err)
+		}
+	}
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			return fmt.Errorf("Period: %w", err)
+		}
+	}
+	if r.PeriodElement != nil {
+		if err := r.PeriodElement.Validate(); err != nil {
+			return fmt.Errorf("PeriodElement: %w", err)
+		}
+	}
+	if r.PeriodMax != nil {
+		if err := r.PeriodMax.Validate(); err != nil {
+			return fmt.Errorf("PeriodMax: %w", err)
+		}
+	}
+	if r.PeriodMaxElement != nil {
+		if err := r.PeriodMaxElement.Validate(); err != nil {
+			return fmt.Errorf("PeriodMaxElement: %w", err)
+		}
+	}
+	if r.PeriodUnit != nil && !r.PeriodUnit.IsValid() {
+		return fmt.Errorf("field 'PeriodUnit' has invalid code '%s'", *r.PeriodUnit)
+	}
+	if r.PeriodUnitElement != nil {
+		if err := r.PeriodUnitElement.Validate(); err != nil {
+			return fmt.Errorf("PeriodUnitElement: %w", err)
 		}
 	}
 	if r.StartOffset != nil {
@@ -75,5 +289,366 @@ func (r *TimingRepeat) Validate() error {
 			return fmt.Errorf("EndOffset: %w", err)
 		}
 	}
+	for i, item := range r.DayOfWeek {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'DayOfWeek[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.DayOfWeekElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("DayOfWeekElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TimeOfDay {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TimeOfDay[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.TimeOfDayElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("TimeOfDayElement[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.When {
+		if !item.IsValid() {
+			return fmt.Errorf("field 'When[%d]' has invalid code '%s'", i, item)
+		}
+	}
+	for i, item := range r.WhenElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("WhenElement[%d]: %w", i, err)
+		}
+	}
+	if r.OffsetElement != nil {
+		if err := r.OffsetElement.Validate(); err != nil {
+			return fmt.Errorf("OffsetElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *TimingRepeat) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if len(r.boundsVariants) > 1 {
+		issues.add("structure", path+".bounds", fmt.Sprintf("field 'Bounds' must have a single type, got %v", r.boundsVariants))
+	}
+	if r.Bounds != nil {
+		r.Bounds.validateAll(path+".bounds.ofType("+r.Bounds.FHIRType()+")", issues)
+	}
+	if r.CountElement != nil {
+		r.CountElement.validateAll(path+".count", issues)
+	}
+	if r.CountMaxElement != nil {
+		r.CountMaxElement.validateAll(path+".countMax", issues)
+	}
+	if r.Duration != nil {
+		if err := r.Duration.Validate(); err != nil {
+			issues.add("value", path+".duration", err.Error())
+		}
+	}
+	if r.DurationElement != nil {
+		r.DurationElement.validateAll(path+".duration", issues)
+	}
+	if r.DurationMax != nil {
+		if err := r.DurationMax.Validate(); err != nil {
+			issues.add("value", path+".durationMax", err.Error())
+		}
+	}
+	if r.DurationMaxElement != nil {
+		r.DurationMaxElement.validateAll(path+".durationMax", issues)
+	}
+	if r.DurationUnit != nil && !r.DurationUnit.IsValid() {
+		issues.add("code-invalid", path+".durationUnit", fmt.Sprintf("field 'DurationUnit' has invalid code '%s'", *r.DurationUnit))
+	}
+	if r.DurationUnitElement != nil {
+		r.DurationUnitElement.validateAll(path+".durationUnit", issues)
+	}
+	if r.FrequencyElement != nil {
+		r.FrequencyElement.validateAll(path+".frequency", issues)
+	}
+	if r.FrequencyMaxElement != nil {
+		r.FrequencyMaxElement.validateAll(path+".frequencyMax", issues)
+	}
+	if r.Period != nil {
+		if err := r.Period.Validate(); err != nil {
+			issues.add("value", path+".period", err.Error())
+		}
+	}
+	if r.PeriodElement != nil {
+		r.PeriodElement.validateAll(path+".period", issues)
+	}
+	if r.PeriodMax != nil {
+		if err := r.PeriodMax.Validate(); err != nil {
+			issues.add("value", path+".periodMax", err.Error())
+		}
+	}
+	if r.PeriodMaxElement != nil {
+		r.PeriodMaxElement.validateAll(path+".periodMax", issues)
+	}
+	if r.PeriodUnit != nil && !r.PeriodUnit.IsValid() {
+		issues.add("code-invalid", path+".periodUnit", fmt.Sprintf("field 'PeriodUnit' has invalid code '%s'", *r.PeriodUnit))
+	}
+	if r.PeriodUnitElement != nil {
+		r.PeriodUnitElement.validateAll(path+".periodUnit", issues)
+	}
+	if r.StartOffset != nil {
+		r.StartOffset.validateAll(path+".startOffset", issues)
+	}
+	if r.EndOffset != nil {
+		r.EndOffset.validateAll(path+".endOffset", issues)
+	}
+	for i, item := range r.DayOfWeek {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.dayOfWeek[%d]", path, i), fmt.Sprintf("field 'DayOfWeek[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.DayOfWeekElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.dayOfWeek[%d]", path, i), issues)
+	}
+	for i, item := range r.TimeOfDay {
+		if err := item.Validate(); err != nil {
+			issues.add("value", fmt.Sprintf("%s.timeOfDay[%d]", path, i), err.Error())
+		}
+	}
+	for i, item := range r.TimeOfDayElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.timeOfDay[%d]", path, i), issues)
+	}
+	for i, item := range r.When {
+		if !item.IsValid() {
+			issues.add("code-invalid", fmt.Sprintf("%s.when[%d]", path, i), fmt.Sprintf("field 'When[%d]' has invalid code '%s'", i, item))
+		}
+	}
+	for i, item := range r.WhenElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.when[%d]", path, i), issues)
+	}
+	if r.OffsetElement != nil {
+		r.OffsetElement.validateAll(path+".offset", issues)
+	}
+}
+
+var timingRepeatInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children or both", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "tim-1", severity: "error", human: "if there's a duration, there needs to be duration units", expression: "duration.empty() or durationUnit.exists()"},
+	{key: "tim-2", severity: "error", human: "if there's a period, there needs to be period units", expression: "period.empty() or periodUnit.exists()"},
+	{key: "tim-4", severity: "error", human: "duration SHALL be a non-negative value", expression: "duration.exists() implies duration >= 0"},
+	{key: "tim-5", severity: "error", human: "period SHALL be a non-negative value", expression: "period.exists() implies period >= 0"},
+	{key: "tim-6", severity: "error", human: "If there's a periodMax, there must be a period", expression: "periodMax.empty() or period.exists()"},
+	{key: "tim-7", severity: "error", human: "If there's a durationMax, there must be a duration", expression: "durationMax.empty() or duration.exists()"},
+	{key: "tim-8", severity: "error", human: "If there's a countMax, there must be a count", expression: "countMax.empty() or count.exists()"},
+	{key: "tim-9", severity: "error", human: "If there's an offset, there must be a when (and not C, CM, CD, CV)", expression: "offset.empty() or (when.exists() and when.select($this in ('C' | 'CM' | 'CD' | 'CV')).allFalse())"},
+	{key: "tim-10", severity: "error", human: "If there's a timeOfDay, there cannot be a when, or vice versa", expression: "timeOfDay.empty() or when.empty()"},
+	{key: "tim-11", severity: "error", human: "Only use startOffset when frequency is present and > 1", expression: "startOffset.exists() implies (frequency > 1)"},
+	{key: "tim-12", severity: "error", human: "Only use endOffset when frequency is present and > 1", expression: "endOffset.exists() implies (frequency > 1)"},
+}
+
+func (r *TimingRepeat) invariants() []invariant {
+	return timingRepeatInvariants
+}
+
+func (r TimingRepeat) MarshalJSON() ([]byte, error) {
+	type alias TimingRepeat
+	out := struct {
+		alias
+		DayOfWeek        []*DaysOfWeek  `json:"dayOfWeek,omitempty"`
+		DayOfWeekElement []*Element     `json:"_dayOfWeek,omitempty"`
+		TimeOfDay        []*Time        `json:"timeOfDay,omitempty"`
+		TimeOfDayElement []*Element     `json:"_timeOfDay,omitempty"`
+		When             []*EventTiming `json:"when,omitempty"`
+		WhenElement      []*Element     `json:"_when,omitempty"`
+	}{alias: alias(r)}
+	out.DayOfWeek, out.DayOfWeekElement = alignPrimitiveArray(r.DayOfWeek, r.DayOfWeekElement)
+	out.TimeOfDay, out.TimeOfDayElement = alignPrimitiveArray(r.TimeOfDay, r.TimeOfDayElement)
+	out.When, out.WhenElement = alignPrimitiveArray(r.When, r.WhenElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "bounds", r.Bounds, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *TimingRepeat) UnmarshalJSON(data []byte) error {
+	type alias TimingRepeat
+	aux := struct {
+		*alias
+		DayOfWeek []*DaysOfWeek  `json:"dayOfWeek,omitempty"`
+		TimeOfDay []*Time        `json:"timeOfDay,omitempty"`
+		When      []*EventTiming `json:"when,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.DayOfWeek, err = splitPrimitiveArray(aux.DayOfWeek, r.DayOfWeekElement); err != nil {
+		return fmt.Errorf("dayOfWeek: %w", err)
+	}
+	if r.TimeOfDay, err = splitPrimitiveArray(aux.TimeOfDay, r.TimeOfDayElement); err != nil {
+		return fmt.Errorf("timeOfDay: %w", err)
+	}
+	if r.When, err = splitPrimitiveArray(aux.When, r.WhenElement); err != nil {
+		return fmt.Errorf("when: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Bounds, _, r.boundsVariants, err = unmarshalChoice(fields, "bounds", timingRepeatBoundsVariants); err != nil {
+		return err
+	}
 	return nil
 }
+
+func (r TimingRepeat) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TimingRepeat) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TimingRepeat) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TimingRepeat) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *TimingRepeat) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Bounds":
+		return "bounds", choiceValues(timingRepeatBoundsVariants)
+	}
+	return "", nil
+}
+
+var timingRepeatElementTypes = map[string]string{
+	"count":        "positiveInt",
+	"countMax":     "positiveInt",
+	"frequency":    "positiveInt",
+	"frequencyMax": "positiveInt",
+	"offset":       "unsignedInt",
+}
+
+func (r *TimingRepeat) elementTypes() map[string]string {
+	return timingRepeatElementTypes
+}
+
+var timingRepeatElementMetadata = []ElementMetadata{
+	{Path: "Timing.repeat.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "Timing.repeat.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "Timing.repeat.bounds[x]", Name: "bounds", Field: 2, Min: 0, Max: "1", Types: []string{"Duration", "Range", "Period"}, Summary: true},
+	{Path: "Timing.repeat.count", Name: "count", Field: 3, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "Timing.repeat.countMax", Name: "countMax", Field: 5, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "Timing.repeat.duration", Name: "duration", Field: 7, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Timing.repeat.durationMax", Name: "durationMax", Field: 9, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Timing.repeat.durationUnit", Name: "durationUnit", Field: 11, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Timing.repeat.durationUnit", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/units-of-time", Description: "A unit of time (units from UCUM)."}, Summary: true},
+	{Path: "Timing.repeat.frequency", Name: "frequency", Field: 13, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "Timing.repeat.frequencyMax", Name: "frequencyMax", Field: 15, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "Timing.repeat.period", Name: "period", Field: 17, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Timing.repeat.periodMax", Name: "periodMax", Field: 19, Min: 0, Max: "1", Types: []string{"decimal"}, Summary: true},
+	{Path: "Timing.repeat.periodUnit", Name: "periodUnit", Field: 21, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Timing.repeat.periodUnit", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/units-of-time", Description: "A unit of time (units from UCUM)."}, Summary: true},
+	{Path: "Timing.repeat.startOffset", Name: "startOffset", Field: 23, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "Timing.repeat.endOffset", Name: "endOffset", Field: 24, Min: 0, Max: "1", Types: []string{"Quantity"}, Summary: true},
+	{Path: "Timing.repeat.dayOfWeek", Name: "dayOfWeek", Field: 25, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Timing.repeat.dayOfWeek", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/days-of-week"}, Summary: true},
+	{Path: "Timing.repeat.timeOfDay", Name: "timeOfDay", Field: 27, Min: 0, Max: "*", Types: []string{"time"}, Summary: true},
+	{Path: "Timing.repeat.when", Name: "when", Field: 29, Min: 0, Max: "*", Types: []string{"code"}, Binding: &BindingDefinition{Path: "Timing.repeat.when", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/event-timing", Description: "Real-world event relating to the schedule."}, Summary: true},
+	{Path: "Timing.repeat.offset", Name: "offset", Field: 31, Min: 0, Max: "1", Types: []string{"unsignedInt"}, Summary: true},
+}
+
+func (r *TimingRepeat) ElementMetadata() []ElementMetadata {
+	return timingRepeatElementMetadata
+}
+
+// TimingRepeatBounds is the type of Timing.repeat.bounds[x]. It is implemented by the TimingRepeatBounds* variant types.
+type TimingRepeatBounds interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isTimingRepeatBounds()
+}
+
+// TimingRepeatBoundsDuration is the Duration variant of TimingRepeatBounds.
+type TimingRepeatBoundsDuration struct {
+	Duration
+}
+
+func (v TimingRepeatBoundsDuration) Validate() error {
+	return v.Duration.Validate()
+}
+
+func (v TimingRepeatBoundsDuration) validateAll(path string, issues *ValidationIssues) {
+	v.Duration.validateAll(path, issues)
+}
+
+func (v TimingRepeatBoundsDuration) FHIRType() string {
+	return "Duration"
+}
+
+func (v TimingRepeatBoundsDuration) isTimingRepeatBounds() {}
+
+// TimingRepeatBoundsRange is the Range variant of TimingRepeatBounds.
+type TimingRepeatBoundsRange struct {
+	Range
+}
+
+func (v TimingRepeatBoundsRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v TimingRepeatBoundsRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v TimingRepeatBoundsRange) FHIRType() string {
+	return "Range"
+}
+
+func (v TimingRepeatBoundsRange) isTimingRepeatBounds() {}
+
+// TimingRepeatBoundsPeriod is the Period variant of TimingRepeatBounds.
+type TimingRepeatBoundsPeriod struct {
+	Period
+}
+
+func (v TimingRepeatBoundsPeriod) Validate() error {
+	return v.Period.Validate()
+}
+
+func (v TimingRepeatBoundsPeriod) validateAll(path string, issues *ValidationIssues) {
+	v.Period.validateAll(path, issues)
+}
+
+func (v TimingRepeatBoundsPeriod) FHIRType() string {
+	return "Period"
+}
+
+func (v TimingRepeatBoundsPeriod) isTimingRepeatBounds() {}
+
+var timingRepeatBoundsVariants = []TimingRepeatBounds{
+	TimingRepeatBoundsDuration{},
+	TimingRepeatBoundsRange{},
+	TimingRepeatBoundsPeriod{},
+}
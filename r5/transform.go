@@ -1,9 +1,146 @@
 package models
 
-// The transform operation takes input content, applies a structure map transform, and then returns the output.
-type Transform struct {
+import "fmt"
+
+// TransformIn holds the input parameters of the $transform operation. The transform operation takes input content, applies a structure map transform, and then returns the output.
+type TransformIn struct {
+	// The structure map to apply
+	Source *string
+	// The logical content to transform
+	Content Resource
+	// Additional structure maps the transform can use
+	SupportingMap []*StructureMap
+	// Additional structure definitions the transform can use
+	StructureDefinition []*StructureDefinition
+}
+
+func (p *TransformIn) Validate() error {
+	if p.Content == nil {
+		return fmt.Errorf("parameter 'content' is required")
+	}
+	return nil
+}
+
+func (p *TransformIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *TransformIn) FromParameters(params *Parameters) error {
+	*p = TransformIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *TransformIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Source != nil {
+		params = append(params, ParametersParameter{Name: "source", Value: ParametersParameterValueUri(*p.Source)})
+	}
+	if p.Content != nil {
+		params = append(params, ParametersParameter{Name: "content", Resource: p.Content})
+	}
+	for _, v := range p.SupportingMap {
+		params = append(params, ParametersParameter{Name: "supportingMap", Resource: v})
+	}
+	for _, v := range p.StructureDefinition {
+		params = append(params, ParametersParameter{Name: "structureDefinition", Resource: v})
+	}
+	return params
+}
+
+func (p *TransformIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "source":
+			if p.Source != nil {
+				return fmt.Errorf("parameter 'source' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Source = &value
+		case "content":
+			if p.Content != nil {
+				return fmt.Errorf("parameter 'content' must occur at most once")
+			}
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.Content = v
+		case "supportingMap":
+			v, err := parameterResource[*StructureMap](param.Name, param.Resource, "StructureMap")
+			if err != nil {
+				return err
+			}
+			p.SupportingMap = append(p.SupportingMap, v)
+		case "structureDefinition":
+			v, err := parameterResource[*StructureDefinition](param.Name, param.Resource, "StructureDefinition")
+			if err != nil {
+				return err
+			}
+			p.StructureDefinition = append(p.StructureDefinition, v)
+		}
+	}
+	return nil
+}
+
+// TransformOut holds the output parameters of the $transform operation.
+type TransformOut struct {
+	// The result of the transform
+	Return Resource
+}
+
+func (p *TransformOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *TransformOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *TransformOut) FromParameters(params *Parameters) error {
+	*p = TransformOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *TransformOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Transform) Validate() error {
+func (p *TransformOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
@@ -1,9 +1,530 @@
 package models
 
-// Translate a code from one value set to another, based on the specified ConceptMap resource. If no ConceptMap resource is specified, then other additional knowledge available to the server may be used.      One (and only one) of the in parameters (sourceCode, sourceCoding, sourceCodeableConcept, targetCode, targetCoding, or targetCodeableConcept) SHALL be provided, to identify the code that is to be translated.       The operation returns a set of parameters including a 'result' for whether there is an acceptable match, and a list of possible matches. Note that the list of matches may include notes of codes for which mapping is specifically excluded (i.e. 'not-related-to'), so implementers have to check the target.relationship for each match. If a source* parameter is provided, the $translate operation will return all matches whereby the provided source concept is the source of a mapping relationship (in a specified ConceptMap or otherwise known to the server). If a target* parameter is provided, the $translate operation will return all matches whereby the provided target concept is the target of a mapping relationship (in a specified ConceptMap or otherwise known to the server). Note: The source value set is an optional parameter because in some cases, the client cannot know what the source value set is. However, without a source value set, the server may be unable to safely identify an applicable concept map, and would return an error. For this reason, a source value set SHOULD always be provided. Note that servers may be able to identify an appropriate concept map without a source value set if there is a full mapping for the entire code system in the concept map, or by manual intervention.
-type Translate struct {
+import "fmt"
+
+// TranslateIn holds the input parameters of the $translate operation. Translate a code from one value set to another, based on the specified ConceptMap resource. If no ConceptMap resource is specified, then other additional knowledge available to the server may be used.      One (and only one) of the in parameters (sourceCode, sourceCoding, sourceCodeableConcept, targetCode, targetCoding, or targetCodeableConcept) SHALL be provided, to identify the code that is to be translated.       The operation returns a set of parameters including a 'result' for whether there is an acceptable match, and a list of possible matches. Note that the list of matches may include notes of codes for which mapping is specifically excluded (i.e. 'not-related-to'), so implementers have to check the target.relationship for each match. If a source* parameter is provided, the $translate operation will return all matches whereby the provided source concept is the source of a mapping relationship (in a specified ConceptMap or otherwise known to the server). If a target* parameter is provided, the $translate operation will return all matches whereby the provided target concept is the target of a mapping relationship (in a specified ConceptMap or otherwise known to the server). Note: The source value set is an optional parameter because in some cases, the client cannot know what the source value set is. However, without a source value set, the server may be unable to safely identify an applicable concept map, and would return an error. For this reason, a source value set SHOULD always be provided. Note that servers may be able to identify an appropriate concept map without a source value set if there is a full mapping for the entire code system in the concept map, or by manual intervention.
+type TranslateIn struct {
+	// A canonical URL for a concept map
+	Url *string
+	// The concept map is provided directly as part of the request
+	ConceptMap *ConceptMap
+	// The identifier that is used to identify a specific version of the concept map to be used for the translation
+	ConceptMapVersion *string
+	// The code that is to be translated
+	SourceCode *string
+	// The system for the code that is to be translated
+	System *string
+	// The version of the system, if one was provided in the source data
+	Version *string
+	// Limits the scope of the $translate operation to source codes that are members of this value set
+	SourceScope *string
+	// A coding to translate
+	SourceCoding *Coding
+	// A full CodeableConcept to translate
+	SourceCodeableConcept *CodeableConcept
+	// The target code that is to be translated to
+	TargetCode *string
+	// A target coding to translate to
+	TargetCoding *Coding
+	// A full CodeableConcept to translate to
+	TargetCodeableConcept *CodeableConcept
+	// Limits the scope of the $translate operation to target codes that are members of this value set
+	TargetScope *string
+	// Identifies a target code system in which a mapping is sought
+	TargetSystem *string
+	// Data from another attribute that may help produce the correct mapping
+	Dependency []TranslateInDependency
+}
+
+func (p *TranslateIn) Validate() error {
+	for i := range p.Dependency {
+		if err := p.Dependency[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'dependency'[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *TranslateIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *TranslateIn) FromParameters(params *Parameters) error {
+	*p = TranslateIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *TranslateIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Url != nil {
+		params = append(params, ParametersParameter{Name: "url", Value: ParametersParameterValueUri(*p.Url)})
+	}
+	if p.ConceptMap != nil {
+		params = append(params, ParametersParameter{Name: "conceptMap", Resource: p.ConceptMap})
+	}
+	if p.ConceptMapVersion != nil {
+		params = append(params, ParametersParameter{Name: "conceptMapVersion", Value: ParametersParameterValueString(*p.ConceptMapVersion)})
+	}
+	if p.SourceCode != nil {
+		params = append(params, ParametersParameter{Name: "sourceCode", Value: ParametersParameterValueCode(*p.SourceCode)})
+	}
+	if p.System != nil {
+		params = append(params, ParametersParameter{Name: "system", Value: ParametersParameterValueUri(*p.System)})
+	}
+	if p.Version != nil {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueString(*p.Version)})
+	}
+	if p.SourceScope != nil {
+		params = append(params, ParametersParameter{Name: "sourceScope", Value: ParametersParameterValueUri(*p.SourceScope)})
+	}
+	if p.SourceCoding != nil {
+		params = append(params, ParametersParameter{Name: "sourceCoding", Value: ParametersParameterValueCoding{Coding: *p.SourceCoding}})
+	}
+	if p.SourceCodeableConcept != nil {
+		params = append(params, ParametersParameter{Name: "sourceCodeableConcept", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.SourceCodeableConcept}})
+	}
+	if p.TargetCode != nil {
+		params = append(params, ParametersParameter{Name: "targetCode", Value: ParametersParameterValueCode(*p.TargetCode)})
+	}
+	if p.TargetCoding != nil {
+		params = append(params, ParametersParameter{Name: "targetCoding", Value: ParametersParameterValueCoding{Coding: *p.TargetCoding}})
+	}
+	if p.TargetCodeableConcept != nil {
+		params = append(params, ParametersParameter{Name: "targetCodeableConcept", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.TargetCodeableConcept}})
+	}
+	if p.TargetScope != nil {
+		params = append(params, ParametersParameter{Name: "targetScope", Value: ParametersParameterValueUri(*p.TargetScope)})
+	}
+	if p.TargetSystem != nil {
+		params = append(params, ParametersParameter{Name: "targetSystem", Value: ParametersParameterValueUri(*p.TargetSystem)})
+	}
+	for _, v := range p.Dependency {
+		params = append(params, ParametersParameter{Name: "dependency", Part: v.parameters()})
+	}
+	return params
+}
+
+func (p *TranslateIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "url":
+			if p.Url != nil {
+				return fmt.Errorf("parameter 'url' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Url = &value
+		case "conceptMap":
+			if p.ConceptMap != nil {
+				return fmt.Errorf("parameter 'conceptMap' must occur at most once")
+			}
+			v, err := parameterResource[*ConceptMap](param.Name, param.Resource, "ConceptMap")
+			if err != nil {
+				return err
+			}
+			p.ConceptMap = v
+		case "conceptMapVersion":
+			if p.ConceptMapVersion != nil {
+				return fmt.Errorf("parameter 'conceptMapVersion' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.ConceptMapVersion = &value
+		case "sourceCode":
+			if p.SourceCode != nil {
+				return fmt.Errorf("parameter 'sourceCode' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.SourceCode = &value
+		case "system":
+			if p.System != nil {
+				return fmt.Errorf("parameter 'system' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.System = &value
+		case "version":
+			if p.Version != nil {
+				return fmt.Errorf("parameter 'version' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Version = &value
+		case "sourceScope":
+			if p.SourceScope != nil {
+				return fmt.Errorf("parameter 'sourceScope' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.SourceScope = &value
+		case "sourceCoding":
+			if p.SourceCoding != nil {
+				return fmt.Errorf("parameter 'sourceCoding' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.SourceCoding = &v.Coding
+		case "sourceCodeableConcept":
+			if p.SourceCodeableConcept != nil {
+				return fmt.Errorf("parameter 'sourceCodeableConcept' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.SourceCodeableConcept = &v.CodeableConcept
+		case "targetCode":
+			if p.TargetCode != nil {
+				return fmt.Errorf("parameter 'targetCode' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.TargetCode = &value
+		case "targetCoding":
+			if p.TargetCoding != nil {
+				return fmt.Errorf("parameter 'targetCoding' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.TargetCoding = &v.Coding
+		case "targetCodeableConcept":
+			if p.TargetCodeableConcept != nil {
+				return fmt.Errorf("parameter 'targetCodeableConcept' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.TargetCodeableConcept = &v.CodeableConcept
+		case "targetScope":
+			if p.TargetScope != nil {
+				return fmt.Errorf("parameter 'targetScope' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.TargetScope = &value
+		case "targetSystem":
+			if p.TargetSystem != nil {
+				return fmt.Errorf("parameter 'targetSystem' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.TargetSystem = &value
+		case "dependency":
+			var v TranslateInDependency
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'dependency': %w", err)
+			}
+			p.Dependency = append(p.Dependency, v)
+		}
+	}
+	return nil
+}
+
+// TranslateInDependency holds the parts of the dependency parameter.
+type TranslateInDependency struct {
+	// The attribute for this dependency
+	Attribute *string
+	// The data value for this dependency
+	Value ParametersParameterValue
+}
+
+func (p *TranslateInDependency) Validate() error {
+	return nil
+}
+
+func (p *TranslateInDependency) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Attribute != nil {
+		params = append(params, ParametersParameter{Name: "attribute", Value: ParametersParameterValueUri(*p.Attribute)})
+	}
+	if p.Value != nil {
+		params = append(params, ParametersParameter{Name: "value", Value: p.Value})
+	}
+	return params
+}
+
+func (p *TranslateInDependency) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "attribute":
+			if p.Attribute != nil {
+				return fmt.Errorf("parameter 'attribute' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Attribute = &value
+		case "value":
+			if p.Value != nil {
+				return fmt.Errorf("parameter 'value' must occur at most once")
+			}
+			if param.Value == nil {
+				return fmt.Errorf("parameter 'value' must have a value")
+			}
+			p.Value = param.Value
+		}
+	}
+	return nil
+}
+
+// TranslateOut holds the output parameters of the $translate operation.
+type TranslateOut struct {
+	// True if the concept could be translated successfully
+	Result *bool
+	// Error details, for display to a human
+	Message *string
+	// A concept in the target value set with a relationship type
+	Match []TranslateOutMatch
+}
+
+func (p *TranslateOut) Validate() error {
+	if p.Result == nil {
+		return fmt.Errorf("parameter 'result' is required")
+	}
+	for i := range p.Match {
+		if err := p.Match[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'match'[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *TranslateOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *TranslateOut) FromParameters(params *Parameters) error {
+	*p = TranslateOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *TranslateOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Result != nil {
+		params = append(params, ParametersParameter{Name: "result", Value: ParametersParameterValueBoolean(*p.Result)})
+	}
+	if p.Message != nil {
+		params = append(params, ParametersParameter{Name: "message", Value: ParametersParameterValueString(*p.Message)})
+	}
+	for _, v := range p.Match {
+		params = append(params, ParametersParameter{Name: "match", Part: v.parameters()})
+	}
+	return params
+}
+
+func (p *TranslateOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "result":
+			if p.Result != nil {
+				return fmt.Errorf("parameter 'result' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.Result = &value
+		case "message":
+			if p.Message != nil {
+				return fmt.Errorf("parameter 'message' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Message = &value
+		case "match":
+			var v TranslateOutMatch
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'match': %w", err)
+			}
+			p.Match = append(p.Match, v)
+		}
+	}
+	return nil
+}
+
+// TranslateOutMatch holds the parts of the match parameter.
+type TranslateOutMatch struct {
+	// A code indicating the relationship (e.g., equivalent) of the translation
+	Relationship *string
+	// The translation outcome
+	Concept *Coding
+	// A property of this mapping
+	Property []TranslateOutMatchProperty
+	// The canonical reference to the concept map from which this mapping comes from
+	OriginMap *string
+}
+
+func (p *TranslateOutMatch) Validate() error {
+	for i := range p.Property {
+		if err := p.Property[i].Validate(); err != nil {
+			return fmt.Errorf("parameter 'property'[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *TranslateOutMatch) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Relationship != nil {
+		params = append(params, ParametersParameter{Name: "relationship", Value: ParametersParameterValueCode(*p.Relationship)})
+	}
+	if p.Concept != nil {
+		params = append(params, ParametersParameter{Name: "concept", Value: ParametersParameterValueCoding{Coding: *p.Concept}})
+	}
+	for _, v := range p.Property {
+		params = append(params, ParametersParameter{Name: "property", Part: v.parameters()})
+	}
+	if p.OriginMap != nil {
+		params = append(params, ParametersParameter{Name: "originMap", Value: ParametersParameterValueUri(*p.OriginMap)})
+	}
+	return params
+}
+
+func (p *TranslateOutMatch) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "relationship":
+			if p.Relationship != nil {
+				return fmt.Errorf("parameter 'relationship' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Relationship = &value
+		case "concept":
+			if p.Concept != nil {
+				return fmt.Errorf("parameter 'concept' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Concept = &v.Coding
+		case "property":
+			var v TranslateOutMatchProperty
+			if err := v.setParameters(param.Part); err != nil {
+				return fmt.Errorf("parameter 'property': %w", err)
+			}
+			p.Property = append(p.Property, v)
+		case "originMap":
+			if p.OriginMap != nil {
+				return fmt.Errorf("parameter 'originMap' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.OriginMap = &value
+		}
+	}
+	return nil
+}
+
+// TranslateOutMatchProperty holds the parts of the property parameter.
+type TranslateOutMatchProperty struct {
+	// The uri of the property
+	Uri *string
+	// The value of the property
+	Value ParametersParameterValue
+}
+
+func (p *TranslateOutMatchProperty) Validate() error {
+	if p.Uri == nil {
+		return fmt.Errorf("parameter 'uri' is required")
+	}
+	if p.Value == nil {
+		return fmt.Errorf("parameter 'value' is required")
+	}
+	return nil
+}
+
+func (p *TranslateOutMatchProperty) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Uri != nil {
+		params = append(params, ParametersParameter{Name: "uri", Value: ParametersParameterValueUri(*p.Uri)})
+	}
+	if p.Value != nil {
+		params = append(params, ParametersParameter{Name: "value", Value: p.Value})
+	}
+	return params
 }
 
-func (r *Translate) Validate() error {
+func (p *TranslateOutMatchProperty) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "uri":
+			if p.Uri != nil {
+				return fmt.Errorf("parameter 'uri' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Uri = &value
+		case "value":
+			if p.Value != nil {
+				return fmt.Errorf("parameter 'value' must occur at most once")
+			}
+			if param.Value == nil {
+				return fmt.Errorf("parameter 'value' must have a value")
+			}
+			p.Value = param.Value
+		}
+	}
 	return nil
 }
@@ -1,9 +1,63 @@
 package models
 
-// This operation returns an identifier of the target type. The operation takes 5 parameters:       * a source identifier value - either a URI, an OID, or a v2 table 0396 (other) code   *  a code for what type of identifier the source identifier is       * a code for what kind of identifier is desired (URI, OID, v2 table 0396 identifier)       * an optional parameter preferredOnly for whether only the preferred identifier is desired       * an optional date to return only identifiers that have a validity period that includes that date     and returns either the requested identifier(s), or an HTTP errors response with an OperationOutcome because either the provided identifier was not recognized, or the requested identiifer type is not known.
-type TranslateId struct {
+// TranslateIdIn holds the input parameters of the $TranslateId operation. This operation returns an identifier of the target type. The operation takes 5 parameters:       * a source identifier value - either a URI, an OID, or a v2 table 0396 (other) code   *  a code for what type of identifier the source identifier is       * a code for what kind of identifier is desired (URI, OID, v2 table 0396 identifier)       * an optional parameter preferredOnly for whether only the preferred identifier is desired       * an optional date to return only identifiers that have a validity period that includes that date     and returns either the requested identifier(s), or an HTTP errors response with an OperationOutcome because either the provided identifier was not recognized, or the requested identiifer type is not known.
+type TranslateIdIn struct {
 }
 
-func (r *TranslateId) Validate() error {
+func (p *TranslateIdIn) Validate() error {
+	return nil
+}
+
+func (p *TranslateIdIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *TranslateIdIn) FromParameters(params *Parameters) error {
+	*p = TranslateIdIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *TranslateIdIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *TranslateIdIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// TranslateIdOut holds the output parameters of the $TranslateId operation.
+type TranslateIdOut struct {
+}
+
+func (p *TranslateIdOut) Validate() error {
+	return nil
+}
+
+func (p *TranslateIdOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *TranslateIdOut) FromParameters(params *Parameters) error {
+	*p = TranslateIdOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *TranslateIdOut) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *TranslateIdOut) setParameters(params []ParametersParameter) error {
 	return nil
 }
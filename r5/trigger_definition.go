@@ -1,36 +1,73 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // TriggerDefinition Type: A description of a triggering event. Triggering events can be named events, data events, or periodic, as determined by the type element.
 type TriggerDefinition struct {
-	Id                *string           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
-	Type              string            `json:"type" bson:"type"`                                                // named-event | periodic | data-changed | data-added | data-modified | data-removed | data-accessed | data-access-ended | subscription-topic
-	Name              *string           `json:"name,omitempty" bson:"name,omitempty"`                            // Name or URI that identifies the event
-	Code              *CodeableConcept  `json:"code,omitempty" bson:"code,omitempty"`                            // Coded definition of the event
-	SubscriptionTopic *string           `json:"subscriptionTopic,omitempty" bson:"subscription_topic,omitempty"` // What event
-	TimingTiming      *Timing           `json:"timingTiming,omitempty" bson:"timing_timing,omitempty"`           // Timing of the event
-	TimingDate        *string           `json:"timingDate,omitempty" bson:"timing_date,omitempty"`               // Timing of the event
-	TimingDateTime    *string           `json:"timingDateTime,omitempty" bson:"timing_date_time,omitempty"`      // Timing of the event
-	Data              []DataRequirement `json:"data,omitempty" bson:"data,omitempty"`                            // Triggering data of the event (multiple = 'and')
-	Condition         *Expression       `json:"condition,omitempty" bson:"condition,omitempty"`                  // Whether the event triggers (boolean expression)
+	Id                       *string                 `json:"id,omitempty" bson:"id,omitempty"`                                         // Unique id for inter-element referencing
+	Extension                []Extension             `json:"extension,omitempty" bson:"extension,omitempty"`                           // Additional content defined by implementations
+	Type                     TriggerType             `json:"type" bson:"type"`                                                         // named-event | periodic | data-changed | data-added | data-modified | data-removed | data-accessed | data-access-ended | subscription-topic
+	TypeElement              *Element                `json:"_type,omitempty" bson:"type_element,omitempty"`                            // Extensions for type
+	Name                     *string                 `json:"name,omitempty" bson:"name,omitempty"`                                     // Name or URI that identifies the event
+	NameElement              *Element                `json:"_name,omitempty" bson:"name_element,omitempty"`                            // Extensions for name
+	Code                     *CodeableConcept        `json:"code,omitempty" bson:"code,omitempty"`                                     // Coded definition of the event
+	SubscriptionTopic        *string                 `json:"subscriptionTopic,omitempty" bson:"subscription_topic,omitempty"`          // What event
+	SubscriptionTopicElement *Element                `json:"_subscriptionTopic,omitempty" bson:"subscription_topic_element,omitempty"` // Extensions for subscriptionTopic
+	Timing                   TriggerDefinitionTiming `json:"-" bson:"timing,omitempty"`                                                // Timing of the event
+	TimingElement            *Element                `json:"-" bson:"timing_element,omitempty"`                                        // Extensions for timing[x]
+	Data                     []DataRequirement       `json:"data,omitempty" bson:"data,omitempty"`                                     // Triggering data of the event (multiple = 'and')
+	Condition                *Expression             `json:"condition,omitempty" bson:"condition,omitempty"`                           // Whether the event triggers (boolean expression)
+
+	timingVariants []string // JSON properties of TriggerDefinition.timing[x] when more than one was decoded
 }
 
 func (r *TriggerDefinition) Validate() error {
-	var emptyString string
-	if r.Type == emptyString {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	if r.Type == "" {
 		return fmt.Errorf("field 'Type' is required")
 	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("field 'Type' has invalid code '%s'", r.Type)
+	}
+	if r.TypeElement != nil {
+		if err := r.TypeElement.Validate(); err != nil {
+			return fmt.Errorf("TypeElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
 	if r.Code != nil {
 		if err := r.Code.Validate(); err != nil {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.TimingTiming != nil {
-		if err := r.TimingTiming.Validate(); err != nil {
-			return fmt.Errorf("TimingTiming: %w", err)
+	if r.SubscriptionTopicElement != nil {
+		if err := r.SubscriptionTopicElement.Validate(); err != nil {
+			return fmt.Errorf("SubscriptionTopicElement: %w", err)
+		}
+	}
+	if len(r.timingVariants) > 1 {
+		return fmt.Errorf("field 'Timing' must have a single type, got %v", r.timingVariants)
+	}
+	if r.Timing != nil {
+		if err := r.Timing.Validate(); err != nil {
+			return fmt.Errorf("Timing: %w", err)
+		}
+	}
+	if r.TimingElement != nil {
+		if err := r.TimingElement.Validate(); err != nil {
+			return fmt.Errorf("TimingElement: %w", err)
 		}
 	}
 	for i, item := range r.Data {
@@ -45,3 +82,223 @@ func (r *TriggerDefinition) Validate() error {
 	}
 	return nil
 }
+
+func (r *TriggerDefinition) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.Type == "" {
+		issues.add("required", path+".type", "field 'Type' is required")
+	}
+	if r.Type != "" && !r.Type.IsValid() {
+		issues.add("code-invalid", path+".type", fmt.Sprintf("field 'Type' has invalid code '%s'", r.Type))
+	}
+	if r.TypeElement != nil {
+		r.TypeElement.validateAll(path+".type", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
+	}
+	if r.SubscriptionTopicElement != nil {
+		r.SubscriptionTopicElement.validateAll(path+".subscriptionTopic", issues)
+	}
+	if len(r.timingVariants) > 1 {
+		issues.add("structure", path+".timing", fmt.Sprintf("field 'Timing' must have a single type, got %v", r.timingVariants))
+	}
+	if r.Timing != nil {
+		r.Timing.validateAll(path+".timing.ofType("+r.Timing.FHIRType()+")", issues)
+	}
+	if r.TimingElement != nil {
+		r.TimingElement.validateAll(path+".timing", issues)
+	}
+	for i, item := range r.Data {
+		item.validateAll(fmt.Sprintf("%s.data[%d]", path, i), issues)
+	}
+	if r.Condition != nil {
+		r.Condition.validateAll(path+".condition", issues)
+	}
+}
+
+func (r *TriggerDefinition) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("TriggerDefinition", &issues)
+	checkInvariants(r, "TriggerDefinition", &issues)
+	return issues
+}
+
+var triggerDefinitionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+	{key: "trd-1", severity: "error", human: "Either timing, or a data requirement, but not both", expression: "data.empty() or timing.empty()"},
+	{key: "trd-3", severity: "error", human: "A named event requires a name or code, a periodic event requires timing, a data event requires data, and a subscription topic requires a reference to a subscription topic", expression: "(type = 'named-event' implies (name.exists() or code.exists())) and (type = 'periodic' implies timing.exists()) and (type.startsWith('data-') implies data.exists()) and (type = 'subscription-topic' implies subscriptionTopic.exists())"},
+}
+
+func (r *TriggerDefinition) invariants() []invariant {
+	return triggerDefinitionInvariants
+}
+
+func (r TriggerDefinition) MarshalJSON() ([]byte, error) {
+	type alias TriggerDefinition
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "timing", r.Timing, r.TimingElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *TriggerDefinition) UnmarshalJSON(data []byte) error {
+	type alias TriggerDefinition
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Timing, element, r.timingVariants, err = unmarshalChoice(fields, "timing", triggerDefinitionTimingVariants); err != nil {
+		return err
+	}
+	r.TimingElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.TimingElement); err != nil {
+			return fmt.Errorf("_timing: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r TriggerDefinition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *TriggerDefinition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r TriggerDefinition) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *TriggerDefinition) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *TriggerDefinition) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Timing":
+		return "timing", choiceValues(triggerDefinitionTimingVariants)
+	}
+	return "", nil
+}
+
+var triggerDefinitionElementTypes = map[string]string{
+	"subscriptionTopic": "canonical",
+}
+
+func (r *TriggerDefinition) elementTypes() map[string]string {
+	return triggerDefinitionElementTypes
+}
+
+var triggerDefinitionElementMetadata = []ElementMetadata{
+	{Path: "TriggerDefinition.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "TriggerDefinition.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "TriggerDefinition.type", Name: "type", Field: 2, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "TriggerDefinition.type", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/trigger-type", Description: "The type of trigger."}, Summary: true},
+	{Path: "TriggerDefinition.name", Name: "name", Field: 4, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+	{Path: "TriggerDefinition.code", Name: "code", Field: 6, Min: 0, Max: "1", Types: []string{"CodeableConcept"}, Summary: true},
+	{Path: "TriggerDefinition.subscriptionTopic", Name: "subscriptionTopic", Field: 7, Min: 0, Max: "1", Types: []string{"canonical"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/SubscriptionTopic"}, Summary: true},
+	{Path: "TriggerDefinition.timing[x]", Name: "timing", Field: 9, Min: 0, Max: "1", Types: []string{"Timing", "date", "dateTime"}, Summary: true},
+	{Path: "TriggerDefinition.data", Name: "data", Field: 11, Min: 0, Max: "*", Types: []string{"DataRequirement"}, Summary: true},
+	{Path: "TriggerDefinition.condition", Name: "condition", Field: 12, Min: 0, Max: "1", Types: []string{"Expression"}, Summary: true},
+}
+
+func (r *TriggerDefinition) ElementMetadata() []ElementMetadata {
+	return triggerDefinitionElementMetadata
+}
+
+// TriggerDefinitionTiming is the type of TriggerDefinition.timing[x]. It is implemented by the TriggerDefinitionTiming* variant types.
+type TriggerDefinitionTiming interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isTriggerDefinitionTiming()
+}
+
+// TriggerDefinitionTimingTiming is the Timing variant of TriggerDefinitionTiming.
+type TriggerDefinitionTimingTiming struct {
+	Timing
+}
+
+func (v TriggerDefinitionTimingTiming) Validate() error {
+	return v.Timing.Validate()
+}
+
+func (v TriggerDefinitionTimingTiming) validateAll(path string, issues *ValidationIssues) {
+	v.Timing.validateAll(path, issues)
+}
+
+func (v TriggerDefinitionTimingTiming) FHIRType() string {
+	return "Timing"
+}
+
+func (v TriggerDefinitionTimingTiming) isTriggerDefinitionTiming() {}
+
+// TriggerDefinitionTimingDate is the date variant of TriggerDefinitionTiming.
+type TriggerDefinitionTimingDate struct {
+	Date
+}
+
+func (v TriggerDefinitionTimingDate) Validate() error {
+	return v.Date.Validate()
+}
+
+func (v TriggerDefinitionTimingDate) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Date.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TriggerDefinitionTimingDate) FHIRType() string {
+	return "date"
+}
+
+func (v TriggerDefinitionTimingDate) isTriggerDefinitionTiming() {}
+
+// TriggerDefinitionTimingDateTime is the dateTime variant of TriggerDefinitionTiming.
+type TriggerDefinitionTimingDateTime struct {
+	DateTime
+}
+
+func (v TriggerDefinitionTimingDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v TriggerDefinitionTimingDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v TriggerDefinitionTimingDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v TriggerDefinitionTimingDateTime) isTriggerDefinitionTiming() {}
+
+var triggerDefinitionTimingVariants = []TriggerDefinitionTiming{
+	TriggerDefinitionTimingTiming{},
+	TriggerDefinitionTimingDate{},
+	TriggerDefinitionTimingDateTime{},
+}
@@ -0,0 +1,1029 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	fhirRDFNamespace = "http://hl7.org/fhir/"
+	rdfNamespace     = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xsdNamespace     = "http://www.w3.org/2001/XMLSchema#"
+	owlNamespace     = "http://www.w3.org/2002/07/owl#"
+	rdfType          = rdfNamespace + "type"
+)
+
+// turtlePrefixes are the prefixes written in Turtle documents, in order.
+var turtlePrefixes = []struct{ prefix, namespace string }{
+	{"fhir", fhirRDFNamespace},
+	{"owl", owlNamespace},
+	{"rdf", rdfNamespace},
+	{"xsd", xsdNamespace},
+}
+
+// rdfNode is a subject of an RDF graph: a named resource or a blank node.
+type rdfNode struct {
+	iri   string // "" for blank nodes
+	props []rdfProperty
+}
+
+// rdfProperty is a triple of a node. The object is an *rdfNode, rdfIRI,
+// rdfLiteral or rdfList.
+type rdfProperty struct {
+	predicate string
+	object    any
+}
+
+type rdfIRI string
+
+// rdfLiteral is a literal with its datatype IRI, "" for plain strings.
+type rdfLiteral struct {
+	lexical  string
+	datatype string
+}
+
+type rdfList []any
+
+func (n *rdfNode) add(predicate string, object any) {
+	n.props = append(n.props, rdfProperty{predicate: predicate, object: object})
+}
+
+// get returns the first object of predicate.
+func (n *rdfNode) get(predicate string) (any, bool) {
+	for _, p := range n.props {
+		if p.predicate == predicate {
+			return p.object, true
+		}
+	}
+	return nil, false
+}
+
+// fhirType returns the FHIR type named by the rdf:type of n, or "".
+func (n *rdfNode) fhirType() string {
+	for _, p := range n.props {
+		if iri, ok := p.object.(rdfIRI); ok && p.predicate == rdfType {
+			if name, ok := strings.CutPrefix(string(iri), fhirRDFNamespace); ok {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// marshalTurtle writes v, a pointer to a resource struct, as FHIR RDF in
+// Turtle syntax. A resource with an id is named by base, its type and id.
+func marshalTurtle(v any, base string) ([]byte, error) {
+	rv := indirectValue(reflect.ValueOf(v))
+	if !rv.IsValid() || resourceTypeOf(rv) == "" {
+		return nil, fmt.Errorf("cannot write %T as a resource", v)
+	}
+	if base == "" {
+		base = fhirRDFNamespace
+	} else if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	b := rdfBuilder{base: base}
+	root := b.resource(rv, true)
+
+	var w turtleWriter
+	for _, p := range turtlePrefixes {
+		fmt.Fprintf(&w.b, "@prefix %s: <%s> .\n", p.prefix, p.namespace)
+	}
+	w.b.WriteString("\n")
+	subject := "[]"
+	if root.iri != "" {
+		fmt.Fprintf(&w.b, "<%s.ttl> a owl:Ontology ;\n  owl:imports fhir:fhir.ttl .\n\n", root.iri)
+		subject = "<" + root.iri + ">"
+	}
+	w.b.WriteString(subject)
+	for i, p := range root.props {
+		if i > 0 {
+			w.b.WriteString(" ;\n ")
+		}
+		w.b.WriteString(" ")
+		w.property(p, 1)
+	}
+	w.b.WriteString(" .\n")
+	return []byte(w.b.String()), nil
+}
+
+// rdfBuilder builds the RDF graph of a resource following the FHIR RDF
+// rules: every element is a blank node, primitives hold their value in
+// fhir:v, repeating elements are lists and choice elements are typed.
+type rdfBuilder struct {
+	base string
+}
+
+func (b rdfBuilder) resource(v reflect.Value, root bool) *rdfNode {
+	resourceType := resourceTypeOf(v)
+	n := &rdfNode{}
+	if id, ok := primitiveString(v.FieldByName("Id")); ok && root {
+		n.iri = b.base + resourceType + "/" + id
+	}
+	n.add(rdfType, rdfIRI(fhirRDFNamespace+resourceType))
+	if root {
+		n.add(fhirRDFNamespace+"nodeRole", rdfIRI(fhirRDFNamespace+"treeRoot"))
+	}
+	b.fields(n, v)
+	return n
+}
+
+func (b rdfBuilder) fields(n *rdfNode, v reflect.Value) {
+	for _, f := range elementFields(v.Type()) {
+		fv := v.Field(f.index)
+		var element reflect.Value
+		if f.element >= 0 {
+			element = v.Field(f.element)
+		}
+		predicate := fhirRDFNamespace + f.name
+		switch {
+		case f.xhtml:
+			if div := fv.String(); div != "" {
+				n.add(predicate, rdfLiteral{lexical: div, datatype: rdfNamespace + "XMLLiteral"})
+			}
+		case f.variants != nil:
+			if fv.IsNil() {
+				continue
+			}
+			fhirType := fv.Interface().(choiceValue).FHIRType()
+			if obj := b.value(choiceItem(fv, element), element, fhirType); obj != nil {
+				obj.props = append([]rdfProperty{{predicate: rdfType, object: rdfIRI(fhirRDFNamespace + fhirType)}}, obj.props...)
+				n.add(predicate, obj)
+			}
+		case fv.Kind() == reflect.Slice:
+			count := fv.Len()
+			if element.IsValid() && element.Kind() == reflect.Slice && element.Len() > count {
+				count = element.Len()
+			}
+			var list rdfList
+			for i := 0; i < count; i++ {
+				var item, itemElement reflect.Value
+				if i < fv.Len() {
+					item = fv.Index(i)
+				}
+				if element.IsValid() && element.Kind() == reflect.Slice && i < element.Len() {
+					itemElement = element.Index(i)
+				}
+				if obj := b.item(f, item, itemElement); obj != nil {
+					list = append(list, obj)
+				}
+			}
+			if len(list) > 0 {
+				n.add(predicate, list)
+			}
+		default:
+			if obj := b.item(f, fv, element); obj != nil {
+				n.add(predicate, obj)
+			}
+		}
+	}
+}
+
+func (b rdfBuilder) item(f elementField, v, element reflect.Value) *rdfNode {
+	if !f.resource {
+		return b.value(v, element, f.fhirType)
+	}
+	if v = indirectValue(v); !v.IsValid() {
+		return nil
+	}
+	return b.resource(v, false)
+}
+
+// value returns the node of a complex value, or of a primitive with its
+// value and the id and extensions of its Element companion. It returns
+// nil for absent primitives.
+func (b rdfBuilder) value(v, element reflect.Value, fhirType string) *rdfNode {
+	v = indirectValue(v)
+	element = indirectValue(element)
+	n := &rdfNode{}
+	if isComplexValue(v) {
+		b.fields(n, v)
+		if v.Type().Name() == "Reference" {
+			b.link(n, v)
+		}
+		return n
+	}
+	if s, ok := primitiveString(v); ok {
+		n.add(fhirRDFNamespace+"v", rdfLiteral{lexical: s, datatype: rdfDatatype(fhirType, s)})
+	}
+	if element.IsValid() {
+		b.fields(n, element)
+	}
+	if len(n.props) == 0 {
+		return nil
+	}
+	return n
+}
+
+// link adds the fhir:link to the target of a literal reference, resolving
+// relative references against the base. Contained references are left
+// out, having no IRI of their own.
+func (b rdfBuilder) link(n *rdfNode, v reflect.Value) {
+	reference, ok := primitiveString(v.FieldByName("Reference"))
+	if !ok || strings.HasPrefix(reference, "#") {
+		return
+	}
+	if !strings.Contains(reference, ":") {
+		reference = b.base + reference
+	}
+	n.props = append([]rdfProperty{{predicate: fhirRDFNamespace + "link", object: rdfIRI(reference)}}, n.props...)
+}
+
+// rdfDatatype returns the XML Schema datatype of a FHIR primitive value,
+// or "" for plain strings. Dates take the datatype of their precision.
+func rdfDatatype(fhirType, lexical string) string {
+	var datatype string
+	switch fhirType {
+	case "boolean":
+		datatype = "boolean"
+	case "integer":
+		datatype = "integer"
+	case "positiveInt":
+		datatype = "positiveInteger"
+	case "unsignedInt":
+		datatype = "nonNegativeInteger"
+	case "integer64":
+		datatype = "long"
+	case "decimal":
+		datatype = "decimal"
+	case "date", "dateTime":
+		switch len(lexical) {
+		case 4:
+			datatype = "gYear"
+		case 7:
+			datatype = "gYearMonth"
+		case 10:
+			datatype = "date"
+		default:
+			datatype = "dateTime"
+		}
+	case "instant":
+		datatype = "dateTime"
+	case "time":
+		datatype = "time"
+	case "uri", "url", "canonical", "oid", "uuid":
+		datatype = "anyURI"
+	case "base64Binary":
+		datatype = "base64Binary"
+	default:
+		return ""
+	}
+	return xsdNamespace + datatype
+}
+
+type turtleWriter struct {
+	b strings.Builder
+}
+
+func (w *turtleWriter) property(p rdfProperty, depth int) {
+	if p.predicate == rdfType {
+		w.b.WriteString("a")
+	} else {
+		w.b.WriteString(turtleIRI(p.predicate))
+	}
+	w.b.WriteString(" ")
+	w.object(p.object, depth)
+}
+
+func (w *turtleWriter) object(obj any, depth int) {
+	switch o := obj.(type) {
+	case rdfIRI:
+		w.b.WriteString(turtleIRI(string(o)))
+	case rdfLiteral:
+		w.literal(o)
+	case rdfList:
+		w.b.WriteString("(")
+		for _, item := range o {
+			w.b.WriteString(" ")
+			w.object(item, depth)
+		}
+		w.b.WriteString(" )")
+	case *rdfNode:
+		if len(o.props) == 0 {
+			w.b.WriteString("[ ]")
+			return
+		}
+		if isInlineNode(o) {
+			w.b.WriteString("[ ")
+			w.property(o.props[0], depth)
+			w.b.WriteString(" ]")
+			return
+		}
+		indent := strings.Repeat("  ", depth+1)
+		w.b.WriteString("[")
+		for i, p := range o.props {
+			if i > 0 {
+				w.b.WriteString(" ;")
+			}
+			w.b.WriteString("\n" + indent)
+			w.property(p, depth+1)
+		}
+		w.b.WriteString("\n" + strings.Repeat("  ", depth) + "]")
+	}
+}
+
+// isInlineNode reports whether a blank node is written on one line: it has
+// a single property whose object is a literal, an IRI or such a node.
+func isInlineNode(n *rdfNode) bool {
+	if len(n.props) != 1 {
+		return false
+	}
+	switch o := n.props[0].object.(type) {
+	case rdfList:
+		return false
+	case *rdfNode:
+		return isInlineNode(o)
+	}
+	return true
+}
+
+func (w *turtleWriter) literal(l rdfLiteral) {
+	switch l.datatype {
+	case xsdNamespace + "boolean", xsdNamespace + "integer":
+		w.b.WriteString(l.lexical)
+		return
+	}
+	w.b.WriteString(`"`)
+	for _, r := range l.lexical {
+		switch r {
+		case '"':
+			w.b.WriteString(`\"`)
+		case '\\':
+			w.b.WriteString(`\\`)
+		case '\n':
+			w.b.WriteString(`\n`)
+		case '\r':
+			w.b.WriteString(`\r`)
+		case '\t':
+			w.b.WriteString(`\t`)
+		default:
+			w.b.WriteRune(r)
+		}
+	}
+	w.b.WriteString(`"`)
+	if l.datatype != "" {
+		w.b.WriteString("^^" + turtleIRI(l.datatype))
+	}
+}
+
+// turtleIRI writes an IRI as a prefixed name where one of the document
+// prefixes allows it.
+func turtleIRI(iri string) string {
+	for _, p := range turtlePrefixes {
+		if local, ok := strings.CutPrefix(iri, p.namespace); ok && isPrefixedLocal(local) {
+			return p.prefix + ":" + local
+		}
+	}
+	return "<" + iri + ">"
+}
+
+func isPrefixedLocal(local string) bool {
+	if local == "" || strings.HasSuffix(local, ".") {
+		return false
+	}
+	for i, r := range local {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && (i == 0 || (r != '-' && r != '.')) {
+			return false
+		}
+	}
+	return true
+}
+
+// unmarshalTurtle decodes the FHIR RDF resource of a Turtle document: the
+// node with fhir:nodeRole fhir:treeRoot or, without one, the first node
+// typed with a resource type.
+func unmarshalTurtle(data []byte) (any, error) {
+	p := turtleParser{src: string(data), prefixes: map[string]string{}, subjects: map[string]*rdfNode{}}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	if newResourceValue == nil {
+		return nil, fmt.Errorf("no resource registry")
+	}
+	d := turtleDecoder{subjects: p.subjects}
+	var root *rdfNode
+	for _, n := range p.order {
+		if role, ok := n.get(fhirRDFNamespace + "nodeRole"); ok && role == rdfIRI(fhirRDFNamespace+"treeRoot") {
+			root = n
+			break
+		}
+	}
+	for _, n := range p.order {
+		if root != nil {
+			break
+		}
+		if _, ok := newResourceValue(n.fhirType()); ok {
+			root = n
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no FHIR resource in the document")
+	}
+	return d.resource(root, "")
+}
+
+type turtleDecoder struct {
+	subjects map[string]*rdfNode
+}
+
+// node returns obj as a node, following IRIs to the subjects they name.
+func (d turtleDecoder) node(obj any) (*rdfNode, bool) {
+	switch o := obj.(type) {
+	case *rdfNode:
+		return o, true
+	case rdfIRI:
+		n, ok := d.subjects[string(o)]
+		return n, ok
+	}
+	return nil, false
+}
+
+func (d turtleDecoder) resource(n *rdfNode, path string) (any, error) {
+	resourceType := n.fhirType()
+	res, ok := newResourceValue(resourceType)
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown resource type %q", strings.TrimPrefix(path, "."), resourceType)
+	}
+	rv := reflect.ValueOf(res).Elem()
+	rv.FieldByName("ResourceType").SetString(resourceType)
+	if path == "" {
+		path = resourceType
+	}
+	if err := d.fields(n, rv, path); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// fields decodes the properties of n into the struct v. Properties outside
+// the FHIR namespace, fhir:link and unknown elements are skipped.
+func (d turtleDecoder) fields(n *rdfNode, v reflect.Value, path string) error {
+	fields := elementFields(v.Type())
+	byName := make(map[string]int, len(fields))
+	for i, f := range fields {
+		byName[f.name] = i
+	}
+	for _, p := range n.props {
+		name, ok := strings.CutPrefix(p.predicate, fhirRDFNamespace)
+		if !ok {
+			continue
+		}
+		i, ok := byName[name]
+		if !ok {
+			continue
+		}
+		if err := d.field(p.object, v, fields[i], childPath(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d turtleDecoder) field(obj any, v reflect.Value, f elementField, path string) error {
+	fv := v.Field(f.index)
+	var element reflect.Value
+	if f.element >= 0 {
+		element = v.Field(f.element)
+	}
+
+	switch {
+	case f.xhtml:
+		lit, ok := obj.(rdfLiteral)
+		if !ok {
+			return fmt.Errorf("%s: expected a literal", path)
+		}
+		fv.SetString(lit.lexical)
+		return nil
+	case f.variants != nil:
+		n, ok := d.node(obj)
+		if !ok {
+			return fmt.Errorf("%s: expected a node", path)
+		}
+		fhirType := n.fhirType()
+		for _, variant := range f.variants {
+			if variant.FHIRType() != fhirType {
+				continue
+			}
+			item := reflect.New(reflect.TypeOf(variant)).Elem()
+			if err := d.value(n, item, element, path); err != nil {
+				return err
+			}
+			fv.Set(item)
+			return nil
+		}
+		return fmt.Errorf("%s: unexpected type %q", path, fhirType)
+	case fv.Kind() == reflect.Slice:
+		items, ok := obj.(rdfList)
+		if !ok {
+			items = rdfList{obj}
+		}
+		for _, itemObj := range items {
+			item := reflect.New(fv.Type().Elem()).Elem()
+			var itemElement reflect.Value
+			if element.IsValid() && element.Kind() == reflect.Slice {
+				itemElement = reflect.New(element.Type().Elem()).Elem()
+			}
+			if err := d.item(itemObj, item, itemElement, f, path); err != nil {
+				return err
+			}
+			if itemElement.IsValid() && !itemElement.IsZero() {
+				for element.Len() < fv.Len() {
+					element.Set(reflect.Append(element, reflect.Zero(element.Type().Elem())))
+				}
+				element.Set(reflect.Append(element, itemElement))
+			}
+			fv.Set(reflect.Append(fv, item))
+		}
+		return nil
+	}
+	return d.item(obj, fv, element, f, path)
+}
+
+func (d turtleDecoder) item(obj any, v, element reflect.Value, f elementField, path string) error {
+	n, ok := d.node(obj)
+	if !ok {
+		return fmt.Errorf("%s: expected a node", path)
+	}
+	if !f.resource {
+		return d.value(n, v, element, path)
+	}
+	res, err := d.resource(n, path)
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(res))
+	return nil
+}
+
+// value decodes the node n into v, allocating pointers. The id and
+// extensions of a primitive go to its Element companion, when v has one
+// and they are present.
+func (d turtleDecoder) value(n *rdfNode, v, element reflect.Value, path string) error {
+	if v.Kind() == reflect.Pointer {
+		target := reflect.New(v.Type().Elem())
+		if err := d.value(n, target.Elem(), element, path); err != nil {
+			return err
+		}
+		if _, ok := n.get(fhirRDFNamespace + "v"); ok || isComplexValue(target.Elem()) {
+			v.Set(target)
+		}
+		return nil
+	}
+	if v.Kind() == reflect.Struct && v.NumField() == 1 && v.Type().Field(0).Anonymous {
+		return d.value(n, v.Field(0), element, path)
+	}
+	if isComplexValue(v) {
+		return d.fields(n, v, path)
+	}
+
+	if obj, ok := n.get(fhirRDFNamespace + "v"); ok {
+		lit, ok := obj.(rdfLiteral)
+		if !ok {
+			return fmt.Errorf("%s: expected a literal value", path)
+		}
+		if err := setPrimitive(v, lit.lexical); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if !element.IsValid() {
+		return nil
+	}
+	companion := reflect.New(element.Type().Elem())
+	if err := d.fields(n, companion.Elem(), path); err != nil {
+		return err
+	}
+	if !companion.Elem().IsZero() {
+		element.Set(companion)
+	}
+	return nil
+}
+
+// turtleParser parses Turtle documents into their subjects. It supports
+// the whole syntax except the relative resolution of IRIs against @base
+// beyond simple concatenation.
+type turtleParser struct {
+	src      string
+	pos      int
+	base     string
+	prefixes map[string]string
+	subjects map[string]*rdfNode
+	order    []*rdfNode
+}
+
+func (p *turtleParser) errorf(format string, args ...any) error {
+	line := 1 + strings.Count(p.src[:p.pos], "\n")
+	return fmt.Errorf("turtle: line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *turtleParser) parse() error {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return nil
+		}
+		switch {
+		case p.keyword("@prefix"):
+			if err := p.prefix(true); err != nil {
+				return err
+			}
+		case p.keyword("PREFIX"):
+			if err := p.prefix(false); err != nil {
+				return err
+			}
+		case p.keyword("@base"), p.keyword("BASE"):
+			dotted := p.src[p.pos-len("@base"):p.pos] == "@base"
+			p.skipSpace()
+			iri, err := p.iriRef()
+			if err != nil {
+				return err
+			}
+			p.base = iri
+			if dotted {
+				if err := p.expect('.'); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := p.triples(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// keyword consumes a directive keyword, case-insensitively for the
+// SPARQL-style forms.
+func (p *turtleParser) keyword(word string) bool {
+	end := p.pos + len(word)
+	if end > len(p.src) {
+		return false
+	}
+	text := p.src[p.pos:end]
+	if text != word && (word[0] == '@' || !strings.EqualFold(text, word)) {
+		return false
+	}
+	if end < len(p.src) && !unicode.IsSpace(rune(p.src[end])) && p.src[end] != '<' {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *turtleParser) prefix(dotted bool) error {
+	p.skipSpace()
+	colon := strings.IndexByte(p.src[p.pos:], ':')
+	if colon < 0 {
+		return p.errorf("expected a prefix")
+	}
+	name := strings.TrimSpace(p.src[p.pos : p.pos+colon])
+	p.pos += colon + 1
+	p.skipSpace()
+	iri, err := p.iriRef()
+	if err != nil {
+		return err
+	}
+	p.prefixes[name] = iri
+	if dotted {
+		return p.expect('.')
+	}
+	return nil
+}
+
+func (p *turtleParser) triples() error {
+	var subject *rdfNode
+	switch {
+	case p.peek() == '[':
+		n, err := p.blankNode()
+		if err != nil {
+			return err
+		}
+		subject = n
+		p.order = append(p.order, n)
+		p.skipSpace()
+		if p.peek() == '.' {
+			p.pos++
+			return nil
+		}
+	default:
+		obj, err := p.term()
+		if err != nil {
+			return err
+		}
+		switch o := obj.(type) {
+		case rdfIRI:
+			subject = p.subject(string(o))
+		case *rdfNode:
+			subject = o
+		default:
+			return p.errorf("unexpected subject")
+		}
+	}
+	if err := p.predicateObjects(subject, '.'); err != nil {
+		return err
+	}
+	return p.expect('.')
+}
+
+// subject returns the node of a named or labelled subject, creating it on
+// first use.
+func (p *turtleParser) subject(key string) *rdfNode {
+	n, ok := p.subjects[key]
+	if !ok {
+		n = &rdfNode{iri: key}
+		p.subjects[key] = n
+		p.order = append(p.order, n)
+	}
+	return n
+}
+
+// predicateObjects parses a predicate-object list into n, up to the
+// terminator, which it leaves unconsumed.
+func (p *turtleParser) predicateObjects(n *rdfNode, terminator byte) error {
+	for {
+		p.skipSpace()
+		if p.peek() == terminator {
+			return nil
+		}
+		var predicate string
+		if p.peek() == 'a' && p.pos+1 < len(p.src) && (unicode.IsSpace(rune(p.src[p.pos+1])) || strings.ContainsRune("[<\"(", rune(p.src[p.pos+1]))) {
+			p.pos++
+			predicate = rdfType
+		} else {
+			obj, err := p.term()
+			if err != nil {
+				return err
+			}
+			iri, ok := obj.(rdfIRI)
+			if !ok {
+				return p.errorf("expected a predicate")
+			}
+			predicate = string(iri)
+		}
+		for {
+			obj, err := p.object()
+			if err != nil {
+				return err
+			}
+			n.add(predicate, obj)
+			p.skipSpace()
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		p.skipSpace()
+		if p.peek() != ';' {
+			return nil
+		}
+		for p.peek() == ';' {
+			p.pos++
+			p.skipSpace()
+		}
+	}
+}
+
+func (p *turtleParser) object() (any, error) {
+	p.skipSpace()
+	switch p.peek() {
+	case '[':
+		return p.blankNode()
+	case '(':
+		p.pos++
+		var list rdfList
+		for {
+			p.skipSpace()
+			if p.peek() == ')' {
+				p.pos++
+				return list, nil
+			}
+			if p.pos >= len(p.src) {
+				return nil, p.errorf("unterminated collection")
+			}
+			item, err := p.object()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+	case '"', '\'':
+		return p.literal()
+	}
+	return p.term()
+}
+
+func (p *turtleParser) blankNode() (*rdfNode, error) {
+	p.pos++ // [
+	n := &rdfNode{}
+	if err := p.predicateObjects(n, ']'); err != nil {
+		return nil, err
+	}
+	if err := p.expect(']'); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// term parses an IRI, prefixed name, blank node label, number or boolean.
+func (p *turtleParser) term() (any, error) {
+	p.skipSpace()
+	if p.peek() == '<' {
+		iri, err := p.iriRef()
+		return rdfIRI(iri), err
+	}
+	start := p.pos
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if unicode.IsSpace(r) || strings.ContainsRune(";,[]()<>\"'^", r) {
+			break
+		}
+		if r == '.' {
+			// a dot ends the token unless a name character follows it
+			next, _ := utf8.DecodeRuneInString(p.src[p.pos+size:])
+			if p.pos+size >= len(p.src) || !(unicode.IsLetter(next) || unicode.IsDigit(next) || next == '_' || next == '-' || next == ':') {
+				break
+			}
+		}
+		p.pos += size
+	}
+	token := p.src[start:p.pos]
+	switch {
+	case token == "":
+		return nil, p.errorf("unexpected %q", p.peek())
+	case token == "true" || token == "false":
+		return rdfLiteral{lexical: token, datatype: xsdNamespace + "boolean"}, nil
+	case strings.HasPrefix(token, "_:"):
+		return p.subject(token), nil
+	case strings.ContainsAny(token[:1], "+-.0123456789"):
+		datatype := "integer"
+		if strings.ContainsAny(token, "eE") {
+			datatype = "double"
+		} else if strings.Contains(token, ".") {
+			datatype = "decimal"
+		}
+		return rdfLiteral{lexical: token, datatype: xsdNamespace + datatype}, nil
+	}
+	prefix, local, ok := strings.Cut(token, ":")
+	if !ok {
+		return nil, p.errorf("unexpected %q", token)
+	}
+	namespace, ok := p.prefixes[prefix]
+	if !ok {
+		return nil, p.errorf("undefined prefix %q", prefix)
+	}
+	return rdfIRI(namespace + unescapeLocal(local)), nil
+}
+
+func unescapeLocal(local string) string {
+	if !strings.Contains(local, `\`) {
+		return local
+	}
+	var b strings.Builder
+	for i := 0; i < len(local); i++ {
+		if local[i] == '\\' && i+1 < len(local) {
+			i++
+		}
+		b.WriteByte(local[i])
+	}
+	return b.String()
+}
+
+func (p *turtleParser) iriRef() (string, error) {
+	if p.peek() != '<' {
+		return "", p.errorf("expected an IRI")
+	}
+	end := strings.IndexByte(p.src[p.pos:], '>')
+	if end < 0 {
+		return "", p.errorf("unterminated IRI")
+	}
+	iri := p.src[p.pos+1 : p.pos+end]
+	p.pos += end + 1
+	if p.base != "" && !strings.Contains(iri, ":") {
+		iri = p.base + iri
+	}
+	return iri, nil
+}
+
+func (p *turtleParser) literal() (rdfLiteral, error) {
+	quote := p.src[p.pos : p.pos+1]
+	if strings.HasPrefix(p.src[p.pos:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	p.pos += len(quote)
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.src) {
+			return rdfLiteral{}, p.errorf("unterminated string")
+		}
+		if strings.HasPrefix(p.src[p.pos:], quote) {
+			p.pos += len(quote)
+			break
+		}
+		c := p.src[p.pos]
+		if len(quote) == 1 && (c == '\n' || c == '\r') {
+			return rdfLiteral{}, p.errorf("newline in string")
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			p.pos++
+			continue
+		}
+		if p.pos+1 >= len(p.src) {
+			return rdfLiteral{}, p.errorf("unterminated string")
+		}
+		esc := p.src[p.pos+1]
+		p.pos += 2
+		switch esc {
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case '"', '\'', '\\':
+			b.WriteByte(esc)
+		case 'u', 'U':
+			digits := 4
+			if esc == 'U' {
+				digits = 8
+			}
+			if p.pos+digits > len(p.src) {
+				return rdfLiteral{}, p.errorf("invalid escape")
+			}
+			var r rune
+			if _, err := fmt.Sscanf(p.src[p.pos:p.pos+digits], "%x", &r); err != nil {
+				return rdfLiteral{}, p.errorf("invalid escape")
+			}
+			b.WriteRune(r)
+			p.pos += digits
+		default:
+			return rdfLiteral{}, p.errorf("invalid escape \\%c", esc)
+		}
+	}
+
+	lit := rdfLiteral{lexical: b.String()}
+	switch {
+	case p.peek() == '@':
+		p.pos++
+		for p.pos < len(p.src) && (isASCIILetterOrDigit(p.src[p.pos]) || p.src[p.pos] == '-') {
+			p.pos++
+		}
+	case strings.HasPrefix(p.src[p.pos:], "^^"):
+		p.pos += 2
+		datatype, err := p.term()
+		if err != nil {
+			return rdfLiteral{}, err
+		}
+		iri, ok := datatype.(rdfIRI)
+		if !ok {
+			return rdfLiteral{}, p.errorf("expected a datatype IRI")
+		}
+		lit.datatype = string(iri)
+	}
+	return lit, nil
+}
+
+func isASCIILetterOrDigit(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func (p *turtleParser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		if p.pos >= len(p.src) {
+			return p.errorf("expected %q, got end of document", c)
+		}
+		return p.errorf("expected %q, got %q", c, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+func (p *turtleParser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// skipSpace skips whitespace and comments.
+func (p *turtleParser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
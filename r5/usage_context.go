@@ -1,20 +1,27 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // UsageContext Type: Specifies clinical/business/etc. metadata that can be used to retrieve, index and/or categorize an artifact. This metadata can either be specific to the applicable population (e.g., age category, DRG) or the specific context of care (e.g., venue, care setting, provider of care).
 type UsageContext struct {
-	Id                   *string          `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code                 *Coding          `json:"code" bson:"code"`                                   // Type of context being specified
-	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept" bson:"value_codeable_concept"` // Value that defines the context
-	ValueQuantity        *Quantity        `json:"valueQuantity" bson:"value_quantity"`                // Value that defines the context
-	ValueRange           *Range           `json:"valueRange" bson:"value_range"`                      // Value that defines the context
-	ValueReference       *Reference       `json:"valueReference" bson:"value_reference"`              // Value that defines the context
+	Id        *string           `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
+	Extension []Extension       `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Code      *Coding           `json:"code" bson:"code"`                               // Type of context being specified
+	Value     UsageContextValue `json:"-" bson:"value,omitempty"`                       // Value that defines the context
+
+	valueVariants []string // JSON properties of UsageContext.value[x] when more than one was decoded
 }
 
 func (r *UsageContext) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.Code == nil {
 		return fmt.Errorf("field 'Code' is required")
 	}
@@ -23,37 +30,212 @@ func (r *UsageContext) Validate() error {
 			return fmt.Errorf("Code: %w", err)
 		}
 	}
-	if r.ValueCodeableConcept == nil {
-		return fmt.Errorf("field 'ValueCodeableConcept' is required")
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
 	}
-	if r.ValueCodeableConcept != nil {
-		if err := r.ValueCodeableConcept.Validate(); err != nil {
-			return fmt.Errorf("ValueCodeableConcept: %w", err)
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
 		}
 	}
-	if r.ValueQuantity == nil {
-		return fmt.Errorf("field 'ValueQuantity' is required")
+	return nil
+}
+
+func (r *UsageContext) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	if r.ValueQuantity != nil {
-		if err := r.ValueQuantity.Validate(); err != nil {
-			return fmt.Errorf("ValueQuantity: %w", err)
-		}
+	if r.Code == nil {
+		issues.add("required", path+".code", "field 'Code' is required")
 	}
-	if r.ValueRange == nil {
-		return fmt.Errorf("field 'ValueRange' is required")
+	if r.Code != nil {
+		r.Code.validateAll(path+".code", issues)
 	}
-	if r.ValueRange != nil {
-		if err := r.ValueRange.Validate(); err != nil {
-			return fmt.Errorf("ValueRange: %w", err)
-		}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
 	}
-	if r.ValueReference == nil {
-		return fmt.Errorf("field 'ValueReference' is required")
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
 	}
-	if r.ValueReference != nil {
-		if err := r.ValueReference.Validate(); err != nil {
-			return fmt.Errorf("ValueReference: %w", err)
-		}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+}
+
+func (r *UsageContext) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("UsageContext", &issues)
+	checkInvariants(r, "UsageContext", &issues)
+	return issues
+}
+
+var usageContextInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *UsageContext) invariants() []invariant {
+	return usageContextInvariants
+}
+
+func (r UsageContext) MarshalJSON() ([]byte, error) {
+	type alias UsageContext
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *UsageContext) UnmarshalJSON(data []byte) error {
+	type alias UsageContext
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if r.Value, _, r.valueVariants, err = unmarshalChoice(fields, "value", usageContextValueVariants); err != nil {
+		return err
 	}
 	return nil
 }
+
+func (r UsageContext) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *UsageContext) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r UsageContext) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *UsageContext) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *UsageContext) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(usageContextValueVariants)
+	}
+	return "", nil
+}
+
+var usageContextElementMetadata = []ElementMetadata{
+	{Path: "UsageContext.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "UsageContext.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "UsageContext.code", Name: "code", Field: 2, Min: 1, Max: "1", Types: []string{"Coding"}, Binding: &BindingDefinition{Path: "UsageContext.code", Strength: "extensible", ValueSet: "http://terminology.hl7.org/ValueSet/usage-context-type", Description: "A code that specifies a type of context being specified by a usage context."}, Summary: true},
+	{Path: "UsageContext.value[x]", Name: "value", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept", "Quantity", "Range", "Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/PlanDefinition", "http://hl7.org/fhir/StructureDefinition/ResearchStudy", "http://hl7.org/fhir/StructureDefinition/InsurancePlan", "http://hl7.org/fhir/StructureDefinition/HealthcareService", "http://hl7.org/fhir/StructureDefinition/Group", "http://hl7.org/fhir/StructureDefinition/Location", "http://hl7.org/fhir/StructureDefinition/Organization"}, Binding: &BindingDefinition{Path: "UsageContext.value[x]", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/use-context", Description: "A code that defines the specific value for the context being specified."}, Summary: true},
+}
+
+func (r *UsageContext) ElementMetadata() []ElementMetadata {
+	return usageContextElementMetadata
+}
+
+// UsageContextValue is the type of UsageContext.value[x]. It is implemented by the UsageContextValue* variant types.
+type UsageContextValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isUsageContextValue()
+}
+
+// UsageContextValueCodeableConcept is the CodeableConcept variant of UsageContextValue.
+type UsageContextValueCodeableConcept struct {
+	CodeableConcept
+}
+
+func (v UsageContextValueCodeableConcept) Validate() error {
+	return v.CodeableConcept.Validate()
+}
+
+func (v UsageContextValueCodeableConcept) validateAll(path string, issues *ValidationIssues) {
+	v.CodeableConcept.validateAll(path, issues)
+}
+
+func (v UsageContextValueCodeableConcept) FHIRType() string {
+	return "CodeableConcept"
+}
+
+func (v UsageContextValueCodeableConcept) isUsageContextValue() {}
+
+// UsageContextValueQuantity is the Quantity variant of UsageContextValue.
+type UsageContextValueQuantity struct {
+	Quantity
+}
+
+func (v UsageContextValueQuantity) Validate() error {
+	return v.Quantity.Validate()
+}
+
+func (v UsageContextValueQuantity) validateAll(path string, issues *ValidationIssues) {
+	v.Quantity.validateAll(path, issues)
+}
+
+func (v UsageContextValueQuantity) FHIRType() string {
+	return "Quantity"
+}
+
+func (v UsageContextValueQuantity) isUsageContextValue() {}
+
+// UsageContextValueRange is the Range variant of UsageContextValue.
+type UsageContextValueRange struct {
+	Range
+}
+
+func (v UsageContextValueRange) Validate() error {
+	return v.Range.Validate()
+}
+
+func (v UsageContextValueRange) validateAll(path string, issues *ValidationIssues) {
+	v.Range.validateAll(path, issues)
+}
+
+func (v UsageContextValueRange) FHIRType() string {
+	return "Range"
+}
+
+func (v UsageContextValueRange) isUsageContextValue() {}
+
+// UsageContextValueReference is the Reference variant of UsageContextValue.
+type UsageContextValueReference struct {
+	Reference
+}
+
+func (v UsageContextValueReference) Validate() error {
+	return v.Reference.Validate()
+}
+
+func (v UsageContextValueReference) validateAll(path string, issues *ValidationIssues) {
+	v.Reference.validateAll(path, issues)
+}
+
+func (v UsageContextValueReference) FHIRType() string {
+	return "Reference"
+}
+
+func (v UsageContextValueReference) isUsageContextValue() {}
+
+var usageContextValueVariants = []UsageContextValue{
+	UsageContextValueCodeableConcept{},
+	UsageContextValueQuantity{},
+	UsageContextValueRange{},
+	UsageContextValueReference{},
+}
@@ -1,11 +1,69 @@
 package models
 
+import (
+	"encoding/xml"
+	"fmt"
+)
+
 // uuid type: A UUID, represented as a URI
 type uuid struct {
-	Id    *string `json:"id,omitempty" bson:"id,omitempty"`       // xml:id (or equivalent in JSON)
-	Value *string `json:"value,omitempty" bson:"value,omitempty"` // Primitive value for uuid
+	Id        *string     `json:"id,omitempty" bson:"id,omitempty"`               // xml:id (or equivalent in JSON)
+	Extension []Extension `json:"extension,omitempty" bson:"extension,omitempty"` // Additional content defined by implementations
+	Value     *string     `json:"value,omitempty" bson:"value,omitempty"`         // Primitive value for uuid
 }
 
 func (r *uuid) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
+
+func (r *uuid) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+}
+
+func (r *uuid) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("uuid", &issues)
+	checkInvariants(r, "uuid", &issues)
+	return issues
+}
+
+func (r uuid) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *uuid) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r uuid) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *uuid) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var uuidElementTypes = map[string]string{
+	"value": "uuid",
+}
+
+func (r *uuid) elementTypes() map[string]string {
+	return uuidElementTypes
+}
+
+var uuidElementMetadata = []ElementMetadata{
+	{Path: "uuid.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "uuid.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "uuid.value", Name: "value", Field: 2, Min: 0, Max: "1", Types: []string{"uuid"}},
+}
+
+func (r *uuid) ElementMetadata() []ElementMetadata {
+	return uuidElementMetadata
+}
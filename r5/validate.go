@@ -1,9 +1,136 @@
 package models
 
-// The validate operation checks whether the attached content would be acceptable either generally, as a create, an update or as a delete to an existing resource.  The action the server takes depends on the mode parameter:    * [mode not provided]: The server checks the content of the resource against any schema, constraint rules, and other general terminology rules  * create: The server checks the content, and then checks that the content would be acceptable as a create (e.g. that the content would not violate any uniqueness constraints)  * update: The server checks the content, and then checks that it would accept it as an update against the nominated specific resource (e.g. that there are no changes to immutable fields the server does not allow to change, and checking version integrity if appropriate)  * delete: The server ignores the content, and checks that the nominated resource is allowed to be deleted (e.g. checking referential integrity rules)    Modes update and delete can only be used when the operation is invoked at the resource instance level.   The return from this operation is an [OperationOutcome](operationoutcome.html)  Note that this operation is not the only way to validate resources - see [Validating Resources](validation.html) for further information.
-type Validate struct {
+import "fmt"
+
+// ValidateIn holds the input parameters of the $validate operation. The validate operation checks whether the attached content would be acceptable either generally, as a create, an update or as a delete to an existing resource.  The action the server takes depends on the mode parameter:    * [mode not provided]: The server checks the content of the resource against any schema, constraint rules, and other general terminology rules  * create: The server checks the content, and then checks that the content would be acceptable as a create (e.g. that the content would not violate any uniqueness constraints)  * update: The server checks the content, and then checks that it would accept it as an update against the nominated specific resource (e.g. that there are no changes to immutable fields the server does not allow to change, and checking version integrity if appropriate)  * delete: The server ignores the content, and checks that the nominated resource is allowed to be deleted (e.g. checking referential integrity rules)    Modes update and delete can only be used when the operation is invoked at the resource instance level.   The return from this operation is an [OperationOutcome](operationoutcome.html)  Note that this operation is not the only way to validate resources - see [Validating Resources](validation.html) for further information.
+type ValidateIn struct {
+	// Must be present unless the mode is "delete"
+	Resource Resource
+	// Default is 'no action'
+	Mode *string
+	// If this is nominated, then the resource is validated against this specific profile
+	Profile *string
+}
+
+func (p *ValidateIn) Validate() error {
+	return nil
+}
+
+func (p *ValidateIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ValidateIn) FromParameters(params *Parameters) error {
+	*p = ValidateIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ValidateIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Resource != nil {
+		params = append(params, ParametersParameter{Name: "resource", Resource: p.Resource})
+	}
+	if p.Mode != nil {
+		params = append(params, ParametersParameter{Name: "mode", Value: ParametersParameterValueCode(*p.Mode)})
+	}
+	if p.Profile != nil {
+		params = append(params, ParametersParameter{Name: "profile", Value: ParametersParameterValueUri(*p.Profile)})
+	}
+	return params
+}
+
+func (p *ValidateIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "resource":
+			if p.Resource != nil {
+				return fmt.Errorf("parameter 'resource' must occur at most once")
+			}
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.Resource = v
+		case "mode":
+			if p.Mode != nil {
+				return fmt.Errorf("parameter 'mode' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Mode = &value
+		case "profile":
+			if p.Profile != nil {
+				return fmt.Errorf("parameter 'profile' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Profile = &value
+		}
+	}
+	return nil
+}
+
+// ValidateOut holds the output parameters of the $validate operation.
+type ValidateOut struct {
+	// If the operation outcome does not list any errors, and a mode was specified, then this is an indication that the operation would be expected to succeed
+	Return *OperationOutcome
+}
+
+func (p *ValidateOut) Validate() error {
+	if p.Return == nil {
+		return fmt.Errorf("parameter 'return' is required")
+	}
+	return nil
+}
+
+func (p *ValidateOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ValidateOut) FromParameters(params *Parameters) error {
+	*p = ValidateOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ValidateOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Return != nil {
+		params = append(params, ParametersParameter{Name: "return", Resource: p.Return})
+	}
+	return params
 }
 
-func (r *Validate) Validate() error {
+func (p *ValidateOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "return":
+			if p.Return != nil {
+				return fmt.Errorf("parameter 'return' must occur at most once")
+			}
+			v, err := parameterResource[*OperationOutcome](param.Name, param.Resource, "OperationOutcome")
+			if err != nil {
+				return err
+			}
+			p.Return = v
+		}
+	}
 	return nil
 }
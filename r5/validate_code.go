@@ -1,9 +1,409 @@
 package models
 
-// Validate that a coded value is in the set of codes allowed by a value set.  If the operation is not called at the instance level, one of the in parameters url, context or valueSet must be provided.  One (and only one) of the in parameters code, coding, or codeableConcept must be provided. If a code is provided, either a system or inferSystem **SHOULD** be provided. The operation returns a result (true / false), an error message, and the recommended display for the code. When validating a code or a coding, then the code, system and version output parameters **SHOULD** be populated when possible. When a validating a CodeableConcept, then the codeableConcept output parameter **SHOULD** be populated when possible.
-type ValidateCode struct {
+import "fmt"
+
+// ValidateCodeIn holds the input parameters of the $validate-code operation. Validate that a coded value is in the set of codes allowed by a value set.  If the operation is not called at the instance level, one of the in parameters url, context or valueSet must be provided.  One (and only one) of the in parameters code, coding, or codeableConcept must be provided. If a code is provided, either a system or inferSystem **SHOULD** be provided. The operation returns a result (true / false), an error message, and the recommended display for the code. When validating a code or a coding, then the code, system and version output parameters **SHOULD** be populated when possible. When a validating a CodeableConcept, then the codeableConcept output parameter **SHOULD** be populated when possible.
+type ValidateCodeIn struct {
+	// Value set Canonical URL
+	Url *string
+	// The context of the value set, so that the server can resolve this to a value set to validate against
+	Context *string
+	// The value set is provided directly as part of the request
+	ValueSet *ValueSet
+	// The identifier that is used to identify a specific version of the value set to be used when validating the code
+	ValueSetVersion *string
+	// The code that is to be validated
+	Code *string
+	// The system for the code that is to be validated
+	System *string
+	// The version of the system, if one was provided in the source data
+	SystemVersion *string
+	// The display associated with the code, if provided
+	Display *string
+	// A coding to validate
+	Coding *Coding
+	// A full codeableConcept to validate
+	CodeableConcept *CodeableConcept
+	// The date for which the validation should be checked
+	Date *DateTime
+	// If this parameter has a value of true, the client is stating that the validation is being performed in a context where a concept designated as 'abstract' is appropriate/allowed to be used
+	Abstract *bool
+	// Specifies the language to be used for description when validating the display property
+	DisplayLanguage *string
+	// The supplement must be used when validating the code
+	UseSupplement []string
+	// Resources that are used in the validation, such as a code system or value set not known to the server
+	TxResource []Resource
+}
+
+func (p *ValidateCodeIn) Validate() error {
+	return nil
+}
+
+func (p *ValidateCodeIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ValidateCodeIn) FromParameters(params *Parameters) error {
+	*p = ValidateCodeIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ValidateCodeIn) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Url != nil {
+		params = append(params, ParametersParameter{Name: "url", Value: ParametersParameterValueUri(*p.Url)})
+	}
+	if p.Context != nil {
+		params = append(params, ParametersParameter{Name: "context", Value: ParametersParameterValueUri(*p.Context)})
+	}
+	if p.ValueSet != nil {
+		params = append(params, ParametersParameter{Name: "valueSet", Resource: p.ValueSet})
+	}
+	if p.ValueSetVersion != nil {
+		params = append(params, ParametersParameter{Name: "valueSetVersion", Value: ParametersParameterValueString(*p.ValueSetVersion)})
+	}
+	if p.Code != nil {
+		params = append(params, ParametersParameter{Name: "code", Value: ParametersParameterValueCode(*p.Code)})
+	}
+	if p.System != nil {
+		params = append(params, ParametersParameter{Name: "system", Value: ParametersParameterValueUri(*p.System)})
+	}
+	if p.SystemVersion != nil {
+		params = append(params, ParametersParameter{Name: "systemVersion", Value: ParametersParameterValueString(*p.SystemVersion)})
+	}
+	if p.Display != nil {
+		params = append(params, ParametersParameter{Name: "display", Value: ParametersParameterValueString(*p.Display)})
+	}
+	if p.Coding != nil {
+		params = append(params, ParametersParameter{Name: "coding", Value: ParametersParameterValueCoding{Coding: *p.Coding}})
+	}
+	if p.CodeableConcept != nil {
+		params = append(params, ParametersParameter{Name: "codeableConcept", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.CodeableConcept}})
+	}
+	if p.Date != nil {
+		params = append(params, ParametersParameter{Name: "date", Value: ParametersParameterValueDateTime{DateTime: *p.Date}})
+	}
+	if p.Abstract != nil {
+		params = append(params, ParametersParameter{Name: "abstract", Value: ParametersParameterValueBoolean(*p.Abstract)})
+	}
+	if p.DisplayLanguage != nil {
+		params = append(params, ParametersParameter{Name: "displayLanguage", Value: ParametersParameterValueCode(*p.DisplayLanguage)})
+	}
+	for _, v := range p.UseSupplement {
+		params = append(params, ParametersParameter{Name: "useSupplement", Value: ParametersParameterValueCanonical(v)})
+	}
+	for _, v := range p.TxResource {
+		params = append(params, ParametersParameter{Name: "tx-resource", Resource: v})
+	}
+	return params
+}
+
+func (p *ValidateCodeIn) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "url":
+			if p.Url != nil {
+				return fmt.Errorf("parameter 'url' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Url = &value
+		case "context":
+			if p.Context != nil {
+				return fmt.Errorf("parameter 'context' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Context = &value
+		case "valueSet":
+			if p.ValueSet != nil {
+				return fmt.Errorf("parameter 'valueSet' must occur at most once")
+			}
+			v, err := parameterResource[*ValueSet](param.Name, param.Resource, "ValueSet")
+			if err != nil {
+				return err
+			}
+			p.ValueSet = v
+		case "valueSetVersion":
+			if p.ValueSetVersion != nil {
+				return fmt.Errorf("parameter 'valueSetVersion' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.ValueSetVersion = &value
+		case "code":
+			if p.Code != nil {
+				return fmt.Errorf("parameter 'code' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Code = &value
+		case "system":
+			if p.System != nil {
+				return fmt.Errorf("parameter 'system' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.System = &value
+		case "systemVersion":
+			if p.SystemVersion != nil {
+				return fmt.Errorf("parameter 'systemVersion' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.SystemVersion = &value
+		case "display":
+			if p.Display != nil {
+				return fmt.Errorf("parameter 'display' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Display = &value
+		case "coding":
+			if p.Coding != nil {
+				return fmt.Errorf("parameter 'coding' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCoding](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Coding = &v.Coding
+		case "codeableConcept":
+			if p.CodeableConcept != nil {
+				return fmt.Errorf("parameter 'codeableConcept' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.CodeableConcept = &v.CodeableConcept
+		case "date":
+			if p.Date != nil {
+				return fmt.Errorf("parameter 'date' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueDateTime](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Date = &v.DateTime
+		case "abstract":
+			if p.Abstract != nil {
+				return fmt.Errorf("parameter 'abstract' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.Abstract = &value
+		case "displayLanguage":
+			if p.DisplayLanguage != nil {
+				return fmt.Errorf("parameter 'displayLanguage' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.DisplayLanguage = &value
+		case "useSupplement":
+			v, err := parameterValue[ParametersParameterValueCanonical](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.UseSupplement = append(p.UseSupplement, string(v))
+		case "tx-resource":
+			v, err := parameterResource[Resource](param.Name, param.Resource, "Resource")
+			if err != nil {
+				return err
+			}
+			p.TxResource = append(p.TxResource, v)
+		}
+	}
+	return nil
+}
+
+// ValidateCodeOut holds the output parameters of the $validate-code operation.
+type ValidateCodeOut struct {
+	// True if the concept details supplied are valid
+	Result *bool
+	// Error details, if result = false. If this is provided when result = true, the message carries hints and warnings
+	Message *string
+	// A valid display for the concept if the system wishes to display this to a user
+	Display *string
+	// The code that was validated
+	Code *string
+	// The system for the code that was validated
+	System *string
+	// The version of the system of the code that was validated
+	Version *string
+	// A codeableConcept containing codings for all the validated codes
+	CodeableConcept *CodeableConcept
+	// List of itemised issues with paths constrained to simple FHIRPath
+	Issues *OperationOutcome
+}
+
+func (p *ValidateCodeOut) Validate() error {
+	if p.Result == nil {
+		return fmt.Errorf("parameter 'result' is required")
+	}
+	return nil
+}
+
+func (p *ValidateCodeOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *ValidateCodeOut) FromParameters(params *Parameters) error {
+	*p = ValidateCodeOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *ValidateCodeOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	if p.Result != nil {
+		params = append(params, ParametersParameter{Name: "result", Value: ParametersParameterValueBoolean(*p.Result)})
+	}
+	if p.Message != nil {
+		params = append(params, ParametersParameter{Name: "message", Value: ParametersParameterValueString(*p.Message)})
+	}
+	if p.Display != nil {
+		params = append(params, ParametersParameter{Name: "display", Value: ParametersParameterValueString(*p.Display)})
+	}
+	if p.Code != nil {
+		params = append(params, ParametersParameter{Name: "code", Value: ParametersParameterValueCode(*p.Code)})
+	}
+	if p.System != nil {
+		params = append(params, ParametersParameter{Name: "system", Value: ParametersParameterValueUri(*p.System)})
+	}
+	if p.Version != nil {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueString(*p.Version)})
+	}
+	if p.CodeableConcept != nil {
+		params = append(params, ParametersParameter{Name: "codeableConcept", Value: ParametersParameterValueCodeableConcept{CodeableConcept: *p.CodeableConcept}})
+	}
+	if p.Issues != nil {
+		params = append(params, ParametersParameter{Name: "issues", Resource: p.Issues})
+	}
+	return params
 }
 
-func (r *ValidateCode) Validate() error {
+func (p *ValidateCodeOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "result":
+			if p.Result != nil {
+				return fmt.Errorf("parameter 'result' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueBoolean](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := bool(v)
+			p.Result = &value
+		case "message":
+			if p.Message != nil {
+				return fmt.Errorf("parameter 'message' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Message = &value
+		case "display":
+			if p.Display != nil {
+				return fmt.Errorf("parameter 'display' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Display = &value
+		case "code":
+			if p.Code != nil {
+				return fmt.Errorf("parameter 'code' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Code = &value
+		case "system":
+			if p.System != nil {
+				return fmt.Errorf("parameter 'system' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueUri](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.System = &value
+		case "version":
+			if p.Version != nil {
+				return fmt.Errorf("parameter 'version' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueString](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Version = &value
+		case "codeableConcept":
+			if p.CodeableConcept != nil {
+				return fmt.Errorf("parameter 'codeableConcept' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCodeableConcept](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.CodeableConcept = &v.CodeableConcept
+		case "issues":
+			if p.Issues != nil {
+				return fmt.Errorf("parameter 'issues' must occur at most once")
+			}
+			v, err := parameterResource[*OperationOutcome](param.Name, param.Resource, "OperationOutcome")
+			if err != nil {
+				return err
+			}
+			p.Issues = v
+		}
+	}
 	return nil
 }
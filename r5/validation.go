@@ -0,0 +1,64 @@
+package models
+
+import "strings"
+
+// ValidationIssue is a single problem found by ValidateAll.
+type ValidationIssue struct {
+	Severity string // fatal | error | warning | information
+	Code     string // OperationOutcome issue type, e.g. required, value, code-invalid
+	Path     string // FHIRPath location, e.g. Patient.identifier[2].system
+	Message  string
+}
+
+func (i ValidationIssue) String() string {
+	return i.Path + ": " + i.Message
+}
+
+// ValidationIssues is the result of ValidateAll: every issue found while
+// walking a resource, in document order.
+type ValidationIssues []ValidationIssue
+
+// HasErrors reports whether any issue has severity error or fatal.
+func (l ValidationIssues) HasErrors() bool {
+	for _, issue := range l {
+		if issue.Severity == "error" || issue.Severity == "fatal" {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the issues as an error, or nil when none of them is an error.
+func (l ValidationIssues) Err() error {
+	if !l.HasErrors() {
+		return nil
+	}
+	return l
+}
+
+func (l ValidationIssues) Error() string {
+	messages := make([]string, len(l))
+	for i, issue := range l {
+		messages[i] = issue.String()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// add records an error-level issue at path.
+func (l *ValidationIssues) add(code, path, message string) {
+	*l = append(*l, ValidationIssue{Severity: "error", Code: code, Path: path, Message: message})
+}
+
+// addNested records the issues of a resource nested at path, such as a
+// contained resource or a Bundle entry, rebasing their locations from the
+// nested resource's own type name onto path.
+func (l *ValidationIssues) addNested(path string, nested ValidationIssues) {
+	for _, issue := range nested {
+		if root, rest, ok := strings.Cut(issue.Path, "."); ok && root != "" {
+			issue.Path = path + "." + rest
+		} else {
+			issue.Path = path
+		}
+		*l = append(*l, issue)
+	}
+}
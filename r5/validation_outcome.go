@@ -0,0 +1,27 @@
+package models
+
+// OperationOutcome converts the issues into an OperationOutcome resource,
+// one issue per entry with its FHIRPath location as the expression. An
+// empty list yields a single informational issue, as OperationOutcome
+// requires at least one.
+func (l ValidationIssues) OperationOutcome() *OperationOutcome {
+	outcome := &OperationOutcome{ResourceType: "OperationOutcome"}
+	for _, issue := range l {
+		diagnostics := issue.Message
+		outcome.Issue = append(outcome.Issue, OperationOutcomeIssue{
+			Severity:    IssueSeverity(issue.Severity),
+			Code:        issue.Code,
+			Diagnostics: &diagnostics,
+			Expression:  []string{issue.Path},
+		})
+	}
+	if len(outcome.Issue) == 0 {
+		diagnostics := "No issues detected"
+		outcome.Issue = append(outcome.Issue, OperationOutcomeIssue{
+			Severity:    "information",
+			Code:        "informational",
+			Diagnostics: &diagnostics,
+		})
+	}
+	return outcome
+}
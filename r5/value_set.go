@@ -2,48 +2,70 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // A ValueSet resource instance specifies a set of codes drawn from one or more code systems, intended for use in a particular context. Value sets link between [[[CodeSystem]]] definitions and their use in [coded elements](terminologies.html).
 type ValueSet struct {
-	ResourceType           string             `json:"resourceType" bson:"resource_type"`                                          // Type of resource
-	Id                     *string            `json:"id,omitempty" bson:"id,omitempty"`                                           // Logical id of this artifact
-	Meta                   *Meta              `json:"meta,omitempty" bson:"meta,omitempty"`                                       // Metadata about the resource
-	ImplicitRules          *string            `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`                    // A set of rules under which this content was created
-	Language               *string            `json:"language,omitempty" bson:"language,omitempty"`                               // Language of the resource content
-	Text                   *Narrative         `json:"text,omitempty" bson:"text,omitempty"`                                       // Text summary of the resource, for human interpretation
-	Contained              []json.RawMessage  `json:"contained,omitempty" bson:"contained,omitempty"`                             // Contained, inline Resources
-	Url                    *string            `json:"url,omitempty" bson:"url,omitempty"`                                         // Canonical identifier for this value set, represented as a URI (globally unique)
-	Identifier             []Identifier       `json:"identifier,omitempty" bson:"identifier,omitempty"`                           // Additional identifier for the value set (business identifier)
-	Version                *string            `json:"version,omitempty" bson:"version,omitempty"`                                 // Business version of the value set
-	VersionAlgorithmString *string            `json:"versionAlgorithmString,omitempty" bson:"version_algorithm_string,omitempty"` // How to compare versions
-	VersionAlgorithmCoding *Coding            `json:"versionAlgorithmCoding,omitempty" bson:"version_algorithm_coding,omitempty"` // How to compare versions
-	Name                   *string            `json:"name,omitempty" bson:"name,omitempty"`                                       // Name for this value set (computer friendly)
-	Title                  *string            `json:"title,omitempty" bson:"title,omitempty"`                                     // Name for this value set (human friendly)
-	Status                 string             `json:"status" bson:"status"`                                                       // draft | active | retired | unknown
-	Experimental           *bool              `json:"experimental,omitempty" bson:"experimental,omitempty"`                       // For testing only - never for real usage
-	Date                   *string            `json:"date,omitempty" bson:"date,omitempty"`                                       // Date last changed
-	Publisher              *string            `json:"publisher,omitempty" bson:"publisher,omitempty"`                             // Name of the publisher/steward (organization or individual)
-	Contact                []ContactDetail    `json:"contact,omitempty" bson:"contact,omitempty"`                                 // Contact details for the publisher
-	Description            *string            `json:"description,omitempty" bson:"description,omitempty"`                         // Natural language description of the value set
-	UseContext             []UsageContext     `json:"useContext,omitempty" bson:"use_context,omitempty"`                          // The context that the content is intended to support
-	Jurisdiction           []CodeableConcept  `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                       // Jurisdiction of the authority that maintains the  value set (if applicable)
-	Immutable              *bool              `json:"immutable,omitempty" bson:"immutable,omitempty"`                             // Indicates whether or not any change to the content logical definition may occur
-	Purpose                *string            `json:"purpose,omitempty" bson:"purpose,omitempty"`                                 // Why this value set is defined
-	Copyright              *string            `json:"copyright,omitempty" bson:"copyright,omitempty"`                             // Notice about intellectual property ownership, can include restrictions on use
-	CopyrightLabel         *string            `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`                  // Copyright holder and year(s)
-	ApprovalDate           *string            `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`                      // When the ValueSet was approved by publisher
-	LastReviewDate         *string            `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`                 // When the ValueSet was last reviewed by the publisher
-	EffectivePeriod        *Period            `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`                // When the ValueSet is expected to be used
-	Topic                  []CodeableConcept  `json:"topic,omitempty" bson:"topic,omitempty"`                                     // E.g. Education, Treatment, Assessment, etc
-	Author                 []ContactDetail    `json:"author,omitempty" bson:"author,omitempty"`                                   // Who authored the ValueSet
-	Editor                 []ContactDetail    `json:"editor,omitempty" bson:"editor,omitempty"`                                   // Who edited the ValueSet
-	Reviewer               []ContactDetail    `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                               // Who reviewed the ValueSet
-	Endorser               []ContactDetail    `json:"endorser,omitempty" bson:"endorser,omitempty"`                               // Who endorsed the ValueSet
-	RelatedArtifact        []RelatedArtifact  `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`                // Additional documentation, citations, etc
-	Compose                *ValueSetCompose   `json:"compose,omitempty" bson:"compose,omitempty"`                                 // Content logical definition of the value set (CLD)
-	Expansion              *ValueSetExpansion `json:"expansion,omitempty" bson:"expansion,omitempty"`                             // Used when the value set is "expanded"
+	ResourceType            string                   `json:"resourceType" bson:"resource_type"`                                   // Type of resource
+	Id                      *string                  `json:"id,omitempty" bson:"id,omitempty"`                                    // Logical id of this artifact
+	Meta                    *Meta                    `json:"meta,omitempty" bson:"meta,omitempty"`                                // Metadata about the resource
+	ImplicitRules           *string                  `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`             // A set of rules under which this content was created
+	ImplicitRulesElement    *Element                 `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"`    // Extensions for implicitRules
+	Language                *string                  `json:"language,omitempty" bson:"language,omitempty"`                        // Language of the resource content
+	LanguageElement         *Element                 `json:"_language,omitempty" bson:"language_element,omitempty"`               // Extensions for language
+	Text                    *Narrative               `json:"text,omitempty" bson:"text,omitempty"`                                // Text summary of the resource, for human interpretation
+	Contained               []Resource               `json:"contained,omitempty" bson:"contained,omitempty"`                      // Contained, inline Resources
+	Extension               []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                      // Additional content defined by implementations
+	ModifierExtension       []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`     // Extensions that cannot be ignored
+	Url                     *string                  `json:"url,omitempty" bson:"url,omitempty"`                                  // Canonical identifier for this value set, represented as a URI (globally unique)
+	UrlElement              *Element                 `json:"_url,omitempty" bson:"url_element,omitempty"`                         // Extensions for url
+	Identifier              []Identifier             `json:"identifier,omitempty" bson:"identifier,omitempty"`                    // Additional identifier for the value set (business identifier)
+	Version                 *string                  `json:"version,omitempty" bson:"version,omitempty"`                          // Business version of the value set
+	VersionElement          *Element                 `json:"_version,omitempty" bson:"version_element,omitempty"`                 // Extensions for version
+	VersionAlgorithm        ValueSetVersionAlgorithm `json:"-" bson:"version_algorithm,omitempty"`                                // How to compare versions
+	VersionAlgorithmElement *Element                 `json:"-" bson:"version_algorithm_element,omitempty"`                        // Extensions for versionAlgorithm[x]
+	Name                    *string                  `json:"name,omitempty" bson:"name,omitempty"`                                // Name for this value set (computer friendly)
+	NameElement             *Element                 `json:"_name,omitempty" bson:"name_element,omitempty"`                       // Extensions for name
+	Title                   *string                  `json:"title,omitempty" bson:"title,omitempty"`                              // Name for this value set (human friendly)
+	TitleElement            *Element                 `json:"_title,omitempty" bson:"title_element,omitempty"`                     // Extensions for title
+	Status                  PublicationStatus        `json:"status" bson:"status"`                                                // draft | active | retired | unknown
+	StatusElement           *Element                 `json:"_status,omitempty" bson:"status_element,omitempty"`                   // Extensions for status
+	Experimental            *bool                    `json:"experimental,omitempty" bson:"experimental,omitempty"`                // For testing only - never for real usage
+	ExperimentalElement     *Element                 `json:"_experimental,omitempty" bson:"experimental_element,omitempty"`       // Extensions for experimental
+	Date                    *DateTime                `json:"date,omitempty" bson:"date,omitempty"`                                // Date last changed
+	DateElement             *Element                 `json:"_date,omitempty" bson:"date_element,omitempty"`                       // Extensions for date
+	Publisher               *string                  `json:"publisher,omitempty" bson:"publisher,omitempty"`                      // Name of the publisher/steward (organization or individual)
+	PublisherElement        *Element                 `json:"_publisher,omitempty" bson:"publisher_element,omitempty"`             // Extensions for publisher
+	Contact                 []ContactDetail          `json:"contact,omitempty" bson:"contact,omitempty"`                          // Contact details for the publisher
+	Description             *string                  `json:"description,omitempty" bson:"description,omitempty"`                  // Natural language description of the value set
+	DescriptionElement      *Element                 `json:"_description,omitempty" bson:"description_element,omitempty"`         // Extensions for description
+	UseContext              []UsageContext           `json:"useContext,omitempty" bson:"use_context,omitempty"`                   // The context that the content is intended to support
+	Jurisdiction            []CodeableConcept        `json:"jurisdiction,omitempty" bson:"jurisdiction,omitempty"`                // Jurisdiction of the authority that maintains the  value set (if applicable)
+	Immutable               *bool                    `json:"immutable,omitempty" bson:"immutable,omitempty"`                      // Indicates whether or not any change to the content logical definition may occur
+	ImmutableElement        *Element                 `json:"_immutable,omitempty" bson:"immutable_element,omitempty"`             // Extensions for immutable
+	Purpose                 *string                  `json:"purpose,omitempty" bson:"purpose,omitempty"`                          // Why this value set is defined
+	PurposeElement          *Element                 `json:"_purpose,omitempty" bson:"purpose_element,omitempty"`                 // Extensions for purpose
+	Copyright               *string                  `json:"copyright,omitempty" bson:"copyright,omitempty"`                      // Notice about intellectual property ownership, can include restrictions on use
+	CopyrightElement        *Element                 `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`             // Extensions for copyright
+	CopyrightLabel          *string                  `json:"copyrightLabel,omitempty" bson:"copyright_label,omitempty"`           // Copyright holder and year(s)
+	CopyrightLabelElement   *Element                 `json:"_copyrightLabel,omitempty" bson:"copyright_label_element,omitempty"`  // Extensions for copyrightLabel
+	ApprovalDate            *Date                    `json:"approvalDate,omitempty" bson:"approval_date,omitempty"`               // When the ValueSet was approved by publisher
+	ApprovalDateElement     *Element                 `json:"_approvalDate,omitempty" bson:"approval_date_element,omitempty"`      // Extensions for approvalDate
+	LastReviewDate          *Date                    `json:"lastReviewDate,omitempty" bson:"last_review_date,omitempty"`          // When the ValueSet was last reviewed by the publisher
+	LastReviewDateElement   *Element                 `json:"_lastReviewDate,omitempty" bson:"last_review_date_element,omitempty"` // Extensions for lastReviewDate
+	EffectivePeriod         *Period                  `json:"effectivePeriod,omitempty" bson:"effective_period,omitempty"`         // When the ValueSet is expected to be used
+	Topic                   []CodeableConcept        `json:"topic,omitempty" bson:"topic,omitempty"`                              // E.g. Education, Treatment, Assessment, etc
+	Author                  []ContactDetail          `json:"author,omitempty" bson:"author,omitempty"`                            // Who authored the ValueSet
+	Editor                  []ContactDetail          `json:"editor,omitempty" bson:"editor,omitempty"`                            // Who edited the ValueSet
+	Reviewer                []ContactDetail          `json:"reviewer,omitempty" bson:"reviewer,omitempty"`                        // Who reviewed the ValueSet
+	Endorser                []ContactDetail          `json:"endorser,omitempty" bson:"endorser,omitempty"`                        // Who endorsed the ValueSet
+	RelatedArtifact         []RelatedArtifact        `json:"relatedArtifact,omitempty" bson:"related_artifact,omitempty"`         // Additional documentation, citations, etc
+	Compose                 *ValueSetCompose         `json:"compose,omitempty" bson:"compose,omitempty"`                          // Content logical definition of the value set (CLD)
+	Expansion               *ValueSetExpansion       `json:"expansion,omitempty" bson:"expansion,omitempty"`                      // Used when the value set is "expanded"
+
+	versionAlgorithmVariants []string // JSON properties of ValueSet.versionAlgorithm[x] when more than one was decoded
 }
 
 func (r *ValueSet) Validate() error {
@@ -55,30 +77,118 @@ func (r *ValueSet) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.UrlElement != nil {
+		if err := r.UrlElement.Validate(); err != nil {
+			return fmt.Errorf("UrlElement: %w", err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
 		}
 	}
-	if r.VersionAlgorithmCoding != nil {
-		if err := r.VersionAlgorithmCoding.Validate(); err != nil {
-			return fmt.Errorf("VersionAlgorithmCoding: %w", err)
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if len(r.versionAlgorithmVariants) > 1 {
+		return fmt.Errorf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants)
+	}
+	if r.VersionAlgorithm != nil {
+		if err := r.VersionAlgorithm.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithm: %w", err)
+		}
+	}
+	if r.VersionAlgorithmElement != nil {
+		if err := r.VersionAlgorithmElement.Validate(); err != nil {
+			return fmt.Errorf("VersionAlgorithmElement: %w", err)
+		}
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if r.TitleElement != nil {
+		if err := r.TitleElement.Validate(); err != nil {
+			return fmt.Errorf("TitleElement: %w", err)
+		}
+	}
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.ExperimentalElement != nil {
+		if err := r.ExperimentalElement.Validate(); err != nil {
+			return fmt.Errorf("ExperimentalElement: %w", err)
+		}
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			return fmt.Errorf("Date: %w", err)
+		}
+	}
+	if r.DateElement != nil {
+		if err := r.DateElement.Validate(); err != nil {
+			return fmt.Errorf("DateElement: %w", err)
+		}
+	}
+	if r.PublisherElement != nil {
+		if err := r.PublisherElement.Validate(); err != nil {
+			return fmt.Errorf("PublisherElement: %w", err)
+		}
+	}
 	for i, item := range r.Contact {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Contact[%d]: %w", i, err)
 		}
 	}
+	if r.DescriptionElement != nil {
+		if err := r.DescriptionElement.Validate(); err != nil {
+			return fmt.Errorf("DescriptionElement: %w", err)
+		}
+	}
 	for i, item := range r.UseContext {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("UseContext[%d]: %w", i, err)
@@ -89,6 +199,46 @@ func (r *ValueSet) Validate() error {
 			return fmt.Errorf("Jurisdiction[%d]: %w", i, err)
 		}
 	}
+	if r.ImmutableElement != nil {
+		if err := r.ImmutableElement.Validate(); err != nil {
+			return fmt.Errorf("ImmutableElement: %w", err)
+		}
+	}
+	if r.PurposeElement != nil {
+		if err := r.PurposeElement.Validate(); err != nil {
+			return fmt.Errorf("PurposeElement: %w", err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	if r.CopyrightLabelElement != nil {
+		if err := r.CopyrightLabelElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightLabelElement: %w", err)
+		}
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDate: %w", err)
+		}
+	}
+	if r.ApprovalDateElement != nil {
+		if err := r.ApprovalDateElement.Validate(); err != nil {
+			return fmt.Errorf("ApprovalDateElement: %w", err)
+		}
+	}
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDate: %w", err)
+		}
+	}
+	if r.LastReviewDateElement != nil {
+		if err := r.LastReviewDateElement.Validate(); err != nil {
+			return fmt.Errorf("LastReviewDateElement: %w", err)
+		}
+	}
 	if r.EffectivePeriod != nil {
 		if err := r.EffectivePeriod.Validate(); err != nil {
 			return fmt.Errorf("EffectivePeriod: %w", err)
@@ -134,321 +284,2468 @@ func (r *ValueSet) Validate() error {
 			return fmt.Errorf("Expansion: %w", err)
 		}
 	}
-	return nil
-}
-
-type ValueSetComposeIncludeFilter struct {
-	Id       *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Property string  `json:"property" bson:"property"`         // A property/filter defined by the code system
-	Op       string  `json:"op" bson:"op"`                     // = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
-	Value    string  `json:"value" bson:"value"`               // Code from the system, or regex criteria, or boolean value for exists
+	return validateInvariants(r, "ValueSet")
 }
 
-func (r *ValueSetComposeIncludeFilter) Validate() error {
-	var emptyString string
-	if r.Property == emptyString {
-		return fmt.Errorf("field 'Property' is required")
+func (r *ValueSet) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "ValueSet" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'ValueSet', got '%s'", r.ResourceType))
 	}
-	if r.Op == emptyString {
-		return fmt.Errorf("field 'Op' is required")
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
 	}
-	if r.Value == emptyString {
-		return fmt.Errorf("field 'Value' is required")
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
 	}
-	return nil
-}
-
-type ValueSetExpansion struct {
-	Id         *string                      `json:"id,omitempty" bson:"id,omitempty"`                 // Unique id for inter-element referencing
-	Identifier *string                      `json:"identifier,omitempty" bson:"identifier,omitempty"` // Identifies the value set expansion (business identifier)
-	Next       *string                      `json:"next,omitempty" bson:"next,omitempty"`             // Opaque urls for paging through expansion results
-	Timestamp  string                       `json:"timestamp" bson:"timestamp"`                       // Time ValueSet expansion happened
-	Total      *int                         `json:"total,omitempty" bson:"total,omitempty"`           // Total number of codes in the expansion
-	Offset     *int                         `json:"offset,omitempty" bson:"offset,omitempty"`         // Offset at which this resource starts
-	Parameter  []ValueSetExpansionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`   // Parameter that controlled the expansion process
-	Property   []ValueSetExpansionProperty  `json:"property,omitempty" bson:"property,omitempty"`     // Additional information supplied about each concept
-	Contains   []ValueSetExpansionContains  `json:"contains,omitempty" bson:"contains,omitempty"`     // Codes in the value set
-}
-
-func (r *ValueSetExpansion) Validate() error {
-	var emptyString string
-	if r.Timestamp == emptyString {
-		return fmt.Errorf("field 'Timestamp' is required")
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
 	}
-	for i, item := range r.Parameter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Parameter[%d]: %w", i, err)
-		}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
 	}
-	for i, item := range r.Property {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Property[%d]: %w", i, err)
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
 		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
 	}
-	for i, item := range r.Contains {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Contains[%d]: %w", i, err)
-		}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ValueSetExpansionParameter struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`                         // Unique id for inter-element referencing
-	Name          string   `json:"name" bson:"name"`                                         // Name as assigned by the client or server
-	ValueString   *string  `json:"valueString,omitempty" bson:"value_string,omitempty"`      // Value of the named parameter
-	ValueBoolean  *bool    `json:"valueBoolean,omitempty" bson:"value_boolean,omitempty"`    // Value of the named parameter
-	ValueInteger  *int     `json:"valueInteger,omitempty" bson:"value_integer,omitempty"`    // Value of the named parameter
-	ValueDecimal  *float64 `json:"valueDecimal,omitempty" bson:"value_decimal,omitempty"`    // Value of the named parameter
-	ValueUri      *string  `json:"valueUri,omitempty" bson:"value_uri,omitempty"`            // Value of the named parameter
-	ValueCode     *string  `json:"valueCode,omitempty" bson:"value_code,omitempty"`          // Value of the named parameter
-	ValueDateTime *string  `json:"valueDateTime,omitempty" bson:"value_date_time,omitempty"` // Value of the named parameter
-}
-
-func (r *ValueSetExpansionParameter) Validate() error {
-	var emptyString string
-	if r.Name == emptyString {
-		return fmt.Errorf("field 'Name' is required")
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ValueSetExpansionProperty struct {
-	Id   *string `json:"id,omitempty" bson:"id,omitempty"`   // Unique id for inter-element referencing
-	Code string  `json:"code" bson:"code"`                   // Identifies the property on the concepts, and when referred to in operations
-	Uri  *string `json:"uri,omitempty" bson:"uri,omitempty"` // Formal identifier for the property
-}
-
-func (r *ValueSetExpansionProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+	if r.UrlElement != nil {
+		r.UrlElement.validateAll(path+".url", issues)
 	}
-	return nil
-}
-
-type ValueSetExpansionContains struct {
-	Id          *string                                    `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	System      *string                                    `json:"system,omitempty" bson:"system,omitempty"`           // System value for the code
-	Abstract    *bool                                      `json:"abstract,omitempty" bson:"abstract,omitempty"`       // If user cannot select this entry
-	Inactive    *bool                                      `json:"inactive,omitempty" bson:"inactive,omitempty"`       // If concept is inactive in the code system
-	Version     *string                                    `json:"version,omitempty" bson:"version,omitempty"`         // Version in which this code/display is defined
-	Code        *string                                    `json:"code,omitempty" bson:"code,omitempty"`               // Code - if blank, this is not a selectable code
-	Display     *string                                    `json:"display,omitempty" bson:"display,omitempty"`         // User display for the concept
-	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty" bson:"designation,omitempty"` // Additional representations for this item
-	Property    []ValueSetExpansionContainsProperty        `json:"property,omitempty" bson:"property,omitempty"`       // Property value for the concept
-	Contains    []ValueSetExpansionContains                `json:"contains,omitempty" bson:"contains,omitempty"`       // Codes contained under this entry
-}
-
-func (r *ValueSetExpansionContains) Validate() error {
-	for i, item := range r.Designation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Designation[%d]: %w", i, err)
-		}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
 	}
-	for i, item := range r.Property {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Property[%d]: %w", i, err)
-		}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
 	}
-	for i, item := range r.Contains {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Contains[%d]: %w", i, err)
-		}
+	if len(r.versionAlgorithmVariants) > 1 {
+		issues.add("structure", path+".versionAlgorithm", fmt.Sprintf("field 'VersionAlgorithm' must have a single type, got %v", r.versionAlgorithmVariants))
 	}
-	return nil
-}
-
-type ValueSetExpansionContainsProperty struct {
-	Id            *string                                        `json:"id,omitempty" bson:"id,omitempty"`                    // Unique id for inter-element referencing
-	Code          string                                         `json:"code" bson:"code"`                                    // Reference to ValueSet.expansion.property.code
-	ValueCode     *string                                        `json:"valueCode" bson:"value_code"`                         // Value of the property for this concept
-	ValueCoding   *Coding                                        `json:"valueCoding" bson:"value_coding"`                     // Value of the property for this concept
-	ValueString   *string                                        `json:"valueString" bson:"value_string"`                     // Value of the property for this concept
-	ValueInteger  *int                                           `json:"valueInteger" bson:"value_integer"`                   // Value of the property for this concept
-	ValueBoolean  *bool                                          `json:"valueBoolean" bson:"value_boolean"`                   // Value of the property for this concept
-	ValueDateTime *string                                        `json:"valueDateTime" bson:"value_date_time"`                // Value of the property for this concept
-	ValueDecimal  *float64                                       `json:"valueDecimal" bson:"value_decimal"`                   // Value of the property for this concept
-	SubProperty   []ValueSetExpansionContainsPropertySubProperty `json:"subProperty,omitempty" bson:"sub_property,omitempty"` // SubProperty value for the concept
-}
-
-func (r *ValueSetExpansionContainsProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+	if r.VersionAlgorithm != nil {
+		r.VersionAlgorithm.validateAll(path+".versionAlgorithm.ofType("+r.VersionAlgorithm.FHIRType()+")", issues)
+	}
+	if r.VersionAlgorithmElement != nil {
+		r.VersionAlgorithmElement.validateAll(path+".versionAlgorithm", issues)
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if r.TitleElement != nil {
+		r.TitleElement.validateAll(path+".title", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
 	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.ExperimentalElement != nil {
+		r.ExperimentalElement.validateAll(path+".experimental", issues)
+	}
+	if r.Date != nil {
+		if err := r.Date.Validate(); err != nil {
+			issues.add("value", path+".date", err.Error())
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.DateElement != nil {
+		r.DateElement.validateAll(path+".date", issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.PublisherElement != nil {
+		r.PublisherElement.validateAll(path+".publisher", issues)
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	for i, item := range r.Contact {
+		item.validateAll(fmt.Sprintf("%s.contact[%d]", path, i), issues)
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	if r.DescriptionElement != nil {
+		r.DescriptionElement.validateAll(path+".description", issues)
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	for i, item := range r.UseContext {
+		item.validateAll(fmt.Sprintf("%s.useContext[%d]", path, i), issues)
 	}
-	for i, item := range r.SubProperty {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("SubProperty[%d]: %w", i, err)
-		}
+	for i, item := range r.Jurisdiction {
+		item.validateAll(fmt.Sprintf("%s.jurisdiction[%d]", path, i), issues)
 	}
-	return nil
-}
-
-type ValueSetExpansionContainsPropertySubProperty struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`     // Unique id for inter-element referencing
-	Code          string   `json:"code" bson:"code"`                     // Reference to ValueSet.expansion.property.code
-	ValueCode     *string  `json:"valueCode" bson:"value_code"`          // Value of the subproperty for this concept
-	ValueCoding   *Coding  `json:"valueCoding" bson:"value_coding"`      // Value of the subproperty for this concept
-	ValueString   *string  `json:"valueString" bson:"value_string"`      // Value of the subproperty for this concept
-	ValueInteger  *int     `json:"valueInteger" bson:"value_integer"`    // Value of the subproperty for this concept
-	ValueBoolean  *bool    `json:"valueBoolean" bson:"value_boolean"`    // Value of the subproperty for this concept
-	ValueDateTime *string  `json:"valueDateTime" bson:"value_date_time"` // Value of the subproperty for this concept
-	ValueDecimal  *float64 `json:"valueDecimal" bson:"value_decimal"`    // Value of the subproperty for this concept
-}
-
-func (r *ValueSetExpansionContainsPropertySubProperty) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
+	if r.ImmutableElement != nil {
+		r.ImmutableElement.validateAll(path+".immutable", issues)
 	}
-	if r.ValueCode == nil {
-		return fmt.Errorf("field 'ValueCode' is required")
+	if r.PurposeElement != nil {
+		r.PurposeElement.validateAll(path+".purpose", issues)
 	}
-	if r.ValueCoding == nil {
-		return fmt.Errorf("field 'ValueCoding' is required")
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
 	}
-	if r.ValueCoding != nil {
-		if err := r.ValueCoding.Validate(); err != nil {
-			return fmt.Errorf("ValueCoding: %w", err)
+	if r.CopyrightLabelElement != nil {
+		r.CopyrightLabelElement.validateAll(path+".copyrightLabel", issues)
+	}
+	if r.ApprovalDate != nil {
+		if err := r.ApprovalDate.Validate(); err != nil {
+			issues.add("value", path+".approvalDate", err.Error())
 		}
 	}
-	if r.ValueString == nil {
-		return fmt.Errorf("field 'ValueString' is required")
+	if r.ApprovalDateElement != nil {
+		r.ApprovalDateElement.validateAll(path+".approvalDate", issues)
 	}
-	if r.ValueInteger == nil {
-		return fmt.Errorf("field 'ValueInteger' is required")
+	if r.LastReviewDate != nil {
+		if err := r.LastReviewDate.Validate(); err != nil {
+			issues.add("value", path+".lastReviewDate", err.Error())
+		}
 	}
-	if r.ValueBoolean == nil {
-		return fmt.Errorf("field 'ValueBoolean' is required")
+	if r.LastReviewDateElement != nil {
+		r.LastReviewDateElement.validateAll(path+".lastReviewDate", issues)
 	}
-	if r.ValueDateTime == nil {
-		return fmt.Errorf("field 'ValueDateTime' is required")
+	if r.EffectivePeriod != nil {
+		r.EffectivePeriod.validateAll(path+".effectivePeriod", issues)
 	}
-	if r.ValueDecimal == nil {
-		return fmt.Errorf("field 'ValueDecimal' is required")
+	for i, item := range r.Topic {
+		item.validateAll(fmt.Sprintf("%s.topic[%d]", path, i), issues)
+	}
+	for i, item := range r.Author {
+		item.validateAll(fmt.Sprintf("%s.author[%d]", path, i), issues)
+	}
+	for i, item := range r.Editor {
+		item.validateAll(fmt.Sprintf("%s.editor[%d]", path, i), issues)
+	}
+	for i, item := range r.Reviewer {
+		item.validateAll(fmt.Sprintf("%s.reviewer[%d]", path, i), issues)
+	}
+	for i, item := range r.Endorser {
+		item.validateAll(fmt.Sprintf("%s.endorser[%d]", path, i), issues)
+	}
+	for i, item := range r.RelatedArtifact {
+		item.validateAll(fmt.Sprintf("%s.relatedArtifact[%d]", path, i), issues)
+	}
+	if r.Compose != nil {
+		r.Compose.validateAll(path+".compose", issues)
+	}
+	if r.Expansion != nil {
+		r.Expansion.validateAll(path+".expansion", issues)
 	}
-	return nil
 }
 
-type ValueSetCompose struct {
-	Id         *string                  `json:"id,omitempty" bson:"id,omitempty"`                  // Unique id for inter-element referencing
-	LockedDate *string                  `json:"lockedDate,omitempty" bson:"locked_date,omitempty"` // Fixed date for references with no specified version (transitive)
-	Inactive   *bool                    `json:"inactive,omitempty" bson:"inactive,omitempty"`      // Whether inactive codes are in the value set
-	Include    []ValueSetComposeInclude `json:"include" bson:"include"`                            // Include one or more codes from a code system or other value set(s)
-	Exclude    []ValueSetComposeInclude `json:"exclude,omitempty" bson:"exclude,omitempty"`        // Explicitly exclude codes from a code system or other value sets
-	Property   []string                 `json:"property,omitempty" bson:"property,omitempty"`      // Property to return if client doesn't override
+func (r *ValueSet) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("ValueSet", &issues)
+	checkInvariants(r, "ValueSet", &issues)
+	return issues
 }
 
-func (r *ValueSetCompose) Validate() error {
-	if len(r.Include) < 1 {
-		return fmt.Errorf("field 'Include' must have at least 1 elements")
+var valueSetInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *ValueSet) invariants() []invariant {
+	return valueSetInvariants
+}
+
+func (r ValueSet) MarshalJSON() ([]byte, error) {
+	type alias ValueSet
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
 	}
-	for i, item := range r.Include {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Include[%d]: %w", i, err)
+	if data, err = marshalChoice(data, "versionAlgorithm", r.VersionAlgorithm, r.VersionAlgorithmElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ValueSet) UnmarshalJSON(data []byte) error {
+	type alias ValueSet
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
 		}
+		r.Contained = append(r.Contained, res)
 	}
-	for i, item := range r.Exclude {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Exclude[%d]: %w", i, err)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.VersionAlgorithm, element, r.versionAlgorithmVariants, err = unmarshalChoice(fields, "versionAlgorithm", valueSetVersionAlgorithmVariants); err != nil {
+		return err
+	}
+	r.VersionAlgorithmElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.VersionAlgorithmElement); err != nil {
+			return fmt.Errorf("_versionAlgorithm: %w", err)
 		}
 	}
 	return nil
 }
 
-type ValueSetComposeInclude struct {
-	Id        *string                         `json:"id,omitempty" bson:"id,omitempty"`               // Unique id for inter-element referencing
-	System    *string                         `json:"system,omitempty" bson:"system,omitempty"`       // The system the codes come from
-	Version   *string                         `json:"version,omitempty" bson:"version,omitempty"`     // Specific version of the code system referred to
-	Concept   []ValueSetComposeIncludeConcept `json:"concept,omitempty" bson:"concept,omitempty"`     // A concept defined in the system
-	Filter    []ValueSetComposeIncludeFilter  `json:"filter,omitempty" bson:"filter,omitempty"`       // Select codes/concepts by their properties (including relationships)
-	ValueSet  []string                        `json:"valueSet,omitempty" bson:"value_set,omitempty"`  // Select the contents included in this value set
-	Copyright *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"` // A copyright statement for the specific code system included in the value set
+func (r ValueSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
 }
 
-func (r *ValueSetComposeInclude) Validate() error {
-	for i, item := range r.Concept {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Concept[%d]: %w", i, err)
-		}
-	}
-	for i, item := range r.Filter {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Filter[%d]: %w", i, err)
-		}
-	}
-	return nil
+func (r *ValueSet) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
 }
 
-type ValueSetComposeIncludeConcept struct {
-	Id          *string                                    `json:"id,omitempty" bson:"id,omitempty"`                   // Unique id for inter-element referencing
-	Code        string                                     `json:"code" bson:"code"`                                   // Code or expression from system
-	Display     *string                                    `json:"display,omitempty" bson:"display,omitempty"`         // Text to display for this code for this value set in this valueset
-	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty" bson:"designation,omitempty"` // Additional representations for this concept
+func (r ValueSet) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
 }
 
-func (r *ValueSetComposeIncludeConcept) Validate() error {
-	var emptyString string
-	if r.Code == emptyString {
-		return fmt.Errorf("field 'Code' is required")
-	}
-	for i, item := range r.Designation {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("Designation[%d]: %w", i, err)
-		}
+func (r *ValueSet) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ValueSet) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "VersionAlgorithm":
+		return "versionAlgorithm", choiceValues(valueSetVersionAlgorithmVariants)
 	}
-	return nil
+	return "", nil
 }
 
-type ValueSetComposeIncludeConceptDesignation struct {
-	Id            *string  `json:"id,omitempty" bson:"id,omitempty"`                        // Unique id for inter-element referencing
-	Language      *string  `json:"language,omitempty" bson:"language,omitempty"`            // Human language of the designation
-	Use           *Coding  `json:"use,omitempty" bson:"use,omitempty"`                      // Types of uses of designations
-	AdditionalUse []Coding `json:"additionalUse,omitempty" bson:"additional_use,omitempty"` // Additional ways how this designation would be used
-	Value         string   `json:"value" bson:"value"`                                      // The text value for this designation
+var valueSetElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+	"url":           "uri",
+	"description":   "markdown",
+	"purpose":       "markdown",
+	"copyright":     "markdown",
 }
 
-func (r *ValueSetComposeIncludeConceptDesignation) Validate() error {
-	if r.Use != nil {
-		if err := r.Use.Validate(); err != nil {
-			return fmt.Errorf("Use: %w", err)
-		}
-	}
-	for i, item := range r.AdditionalUse {
-		if err := item.Validate(); err != nil {
-			return fmt.Errorf("AdditionalUse[%d]: %w", i, err)
-		}
-	}
-	var emptyString string
-	if r.Value == emptyString {
-		return fmt.Errorf("field 'Value' is required")
-	}
+func (r *ValueSet) elementTypes() map[string]string {
+	return valueSetElementTypes
+}
+
+var valueSetElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "ValueSet.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "ValueSet.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ValueSet.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "ValueSet.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "ValueSet.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "ValueSet.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.url", Name: "url", Field: 11, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ValueSet.identifier", Name: "identifier", Field: 13, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "ValueSet.version", Name: "version", Field: 14, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.versionAlgorithm[x]", Name: "versionAlgorithm", Field: 16, Min: 0, Max: "1", Types: []string{"string", "Coding"}},
+	{Path: "ValueSet.name", Name: "name", Field: 18, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.title", Name: "title", Field: 20, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.status", Name: "status", Field: 22, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ValueSet.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/publication-status"}},
+	{Path: "ValueSet.experimental", Name: "experimental", Field: 24, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ValueSet.date", Name: "date", Field: 26, Min: 0, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ValueSet.publisher", Name: "publisher", Field: 28, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.contact", Name: "contact", Field: 30, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ValueSet.description", Name: "description", Field: 31, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ValueSet.useContext", Name: "useContext", Field: 33, Min: 0, Max: "*", Types: []string{"UsageContext"}},
+	{Path: "ValueSet.jurisdiction", Name: "jurisdiction", Field: 34, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ValueSet.immutable", Name: "immutable", Field: 35, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ValueSet.purpose", Name: "purpose", Field: 37, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ValueSet.copyright", Name: "copyright", Field: 39, Min: 0, Max: "1", Types: []string{"markdown"}},
+	{Path: "ValueSet.copyrightLabel", Name: "copyrightLabel", Field: 41, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.approvalDate", Name: "approvalDate", Field: 43, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ValueSet.lastReviewDate", Name: "lastReviewDate", Field: 45, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ValueSet.effectivePeriod", Name: "effectivePeriod", Field: 47, Min: 0, Max: "1", Types: []string{"Period"}},
+	{Path: "ValueSet.topic", Name: "topic", Field: 48, Min: 0, Max: "*", Types: []string{"CodeableConcept"}},
+	{Path: "ValueSet.author", Name: "author", Field: 49, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ValueSet.editor", Name: "editor", Field: 50, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ValueSet.reviewer", Name: "reviewer", Field: 51, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ValueSet.endorser", Name: "endorser", Field: 52, Min: 0, Max: "*", Types: []string{"ContactDetail"}},
+	{Path: "ValueSet.relatedArtifact", Name: "relatedArtifact", Field: 53, Min: 0, Max: "*", Types: []string{"RelatedArtifact"}},
+	{Path: "ValueSet.compose", Name: "compose", Field: 54, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.expansion", Name: "expansion", Field: 55, Min: 0, Max: "1", Types: []string{"BackboneElement"}},
+}
+
+func (r *ValueSet) ElementMetadata() []ElementMetadata {
+	return valueSetElementMetadata
+}
+
+// ValueSetVersionAlgorithm is the type of ValueSet.versionAlgorithm[x]. It is implemented by the ValueSetVersionAlgorithm* variant types.
+type ValueSetVersionAlgorithm interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isValueSetVersionAlgorithm()
+}
+
+// ValueSetVersionAlgorithmString is the string variant of ValueSetVersionAlgorithm.
+type ValueSetVersionAlgorithmString string
+
+func (v ValueSetVersionAlgorithmString) Validate() error {
 	return nil
 }
+
+func (v ValueSetVersionAlgorithmString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ValueSetVersionAlgorithmString) FHIRType() string {
+	return "string"
+}
+
+func (v ValueSetVersionAlgorithmString) isValueSetVersionAlgorithm() {}
+
+// ValueSetVersionAlgorithmCoding is the Coding variant of ValueSetVersionAlgorithm.
+type ValueSetVersionAlgorithmCoding struct {
+	Coding
+}
+
+func (v ValueSetVersionAlgorithmCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ValueSetVersionAlgorithmCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ValueSetVersionAlgorithmCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ValueSetVersionAlgorithmCoding) isValueSetVersionAlgorithm() {}
+
+var valueSetVersionAlgorithmVariants = []ValueSetVersionAlgorithm{
+	ValueSetVersionAlgorithmString(""),
+	ValueSetVersionAlgorithmCoding{},
+}
+
+func (r *ValueSet) GetResourceType() string {
+	return "ValueSet"
+}
+
+func (r *ValueSet) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *ValueSet) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *ValueSet) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *ValueSet) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *ValueSet) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *ValueSet) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *ValueSet) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*ValueSet)(nil)
+
+type ValueSetCompose struct {
+	Id                *string                  `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension              `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension              `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	LockedDate        *Date                    `json:"lockedDate,omitempty" bson:"locked_date,omitempty"`               // Fixed date for references with no specified version (transitive)
+	LockedDateElement *Element                 `json:"_lockedDate,omitempty" bson:"locked_date_element,omitempty"`      // Extensions for lockedDate
+	Inactive          *bool                    `json:"inactive,omitempty" bson:"inactive,omitempty"`                    // Whether inactive codes are in the value set
+	InactiveElement   *Element                 `json:"_inactive,omitempty" bson:"inactive_element,omitempty"`           // Extensions for inactive
+	Include           []ValueSetComposeInclude `json:"include" bson:"include"`                                          // Include one or more codes from a code system or other value set(s)
+	Exclude           []ValueSetComposeInclude `json:"exclude,omitempty" bson:"exclude,omitempty"`                      // Explicitly exclude codes from a code system or other value sets
+	Property          []string                 `json:"property,omitempty" bson:"property,omitempty"`                    // Property to return if client doesn't override
+	PropertyElement   []*Element               `json:"_property,omitempty" bson:"property_element,omitempty"`           // Extensions for property
+}
+
+func (r *ValueSetCompose) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LockedDate != nil {
+		if err := r.LockedDate.Validate(); err != nil {
+			return fmt.Errorf("LockedDate: %w", err)
+		}
+	}
+	if r.LockedDateElement != nil {
+		if err := r.LockedDateElement.Validate(); err != nil {
+			return fmt.Errorf("LockedDateElement: %w", err)
+		}
+	}
+	if r.InactiveElement != nil {
+		if err := r.InactiveElement.Validate(); err != nil {
+			return fmt.Errorf("InactiveElement: %w", err)
+		}
+	}
+	if len(r.Include) < 1 {
+		return fmt.Errorf("field 'Include' must have at least 1 elements")
+	}
+	for i, item := range r.Include {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Include[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Exclude {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Exclude[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.PropertyElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("PropertyElement[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetCompose) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LockedDate != nil {
+		if err := r.LockedDate.Validate(); err != nil {
+			issues.add("value", path+".lockedDate", err.Error())
+		}
+	}
+	if r.LockedDateElement != nil {
+		r.LockedDateElement.validateAll(path+".lockedDate", issues)
+	}
+	if r.InactiveElement != nil {
+		r.InactiveElement.validateAll(path+".inactive", issues)
+	}
+	if len(r.Include) < 1 {
+		issues.add("required", path+".include", "field 'Include' must have at least 1 elements")
+	}
+	for i, item := range r.Include {
+		item.validateAll(fmt.Sprintf("%s.include[%d]", path, i), issues)
+	}
+	for i, item := range r.Exclude {
+		item.validateAll(fmt.Sprintf("%s.exclude[%d]", path, i), issues)
+	}
+	for i, item := range r.PropertyElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+}
+
+var valueSetComposeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetCompose) invariants() []invariant {
+	return valueSetComposeInvariants
+}
+
+func (r ValueSetCompose) MarshalJSON() ([]byte, error) {
+	type alias ValueSetCompose
+	out := struct {
+		alias
+		Property        []*string  `json:"property,omitempty"`
+		PropertyElement []*Element `json:"_property,omitempty"`
+	}{alias: alias(r)}
+	out.Property, out.PropertyElement = alignPrimitiveArray(r.Property, r.PropertyElement)
+	return json.Marshal(out)
+}
+
+func (r *ValueSetCompose) UnmarshalJSON(data []byte) error {
+	type alias ValueSetCompose
+	aux := struct {
+		*alias
+		Property []*string `json:"property,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.Property, err = splitPrimitiveArray(aux.Property, r.PropertyElement); err != nil {
+		return fmt.Errorf("property: %w", err)
+	}
+	return nil
+}
+
+func (r ValueSetCompose) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetCompose) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetCompose) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetCompose) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetComposeElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.compose.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.compose.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.compose.lockedDate", Name: "lockedDate", Field: 3, Min: 0, Max: "1", Types: []string{"date"}},
+	{Path: "ValueSet.compose.inactive", Name: "inactive", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ValueSet.compose.include", Name: "include", Field: 7, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.compose.exclude", Name: "exclude", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.compose.property", Name: "property", Field: 9, Min: 0, Max: "*", Types: []string{"string"}},
+}
+
+func (r *ValueSetCompose) ElementMetadata() []ElementMetadata {
+	return valueSetComposeElementMetadata
+}
+
+type ValueSetComposeInclude struct {
+	Id                *string                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	System            *string                         `json:"system,omitempty" bson:"system,omitempty"`                        // The system the codes come from
+	SystemElement     *Element                        `json:"_system,omitempty" bson:"system_element,omitempty"`               // Extensions for system
+	Version           *string                         `json:"version,omitempty" bson:"version,omitempty"`                      // Specific version of the code system referred to
+	VersionElement    *Element                        `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
+	Concept           []ValueSetComposeIncludeConcept `json:"concept,omitempty" bson:"concept,omitempty"`                      // A concept defined in the system
+	Filter            []ValueSetComposeIncludeFilter  `json:"filter,omitempty" bson:"filter,omitempty"`                        // Select codes/concepts by their properties (including relationships)
+	ValueSet          []string                        `json:"valueSet,omitempty" bson:"value_set,omitempty"`                   // Select the contents included in this value set
+	ValueSetElement   []*Element                      `json:"_valueSet,omitempty" bson:"value_set_element,omitempty"`          // Extensions for valueSet
+	Copyright         *string                         `json:"copyright,omitempty" bson:"copyright,omitempty"`                  // A copyright statement for the specific code system included in the value set
+	CopyrightElement  *Element                        `json:"_copyright,omitempty" bson:"copyright_element,omitempty"`         // Extensions for copyright
+}
+
+func (r *ValueSetComposeInclude) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	for i, item := range r.Concept {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Concept[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Filter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Filter[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ValueSetElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ValueSetElement[%d]: %w", i, err)
+		}
+	}
+	if r.CopyrightElement != nil {
+		if err := r.CopyrightElement.Validate(); err != nil {
+			return fmt.Errorf("CopyrightElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetComposeInclude) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	for i, item := range r.Concept {
+		item.validateAll(fmt.Sprintf("%s.concept[%d]", path, i), issues)
+	}
+	for i, item := range r.Filter {
+		item.validateAll(fmt.Sprintf("%s.filter[%d]", path, i), issues)
+	}
+	for i, item := range r.ValueSetElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.valueSet[%d]", path, i), issues)
+	}
+	if r.CopyrightElement != nil {
+		r.CopyrightElement.validateAll(path+".copyright", issues)
+	}
+}
+
+var valueSetComposeIncludeInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetComposeInclude) invariants() []invariant {
+	return valueSetComposeIncludeInvariants
+}
+
+func (r ValueSetComposeInclude) MarshalJSON() ([]byte, error) {
+	type alias ValueSetComposeInclude
+	out := struct {
+		alias
+		ValueSet        []*string  `json:"valueSet,omitempty"`
+		ValueSetElement []*Element `json:"_valueSet,omitempty"`
+	}{alias: alias(r)}
+	out.ValueSet, out.ValueSetElement = alignPrimitiveArray(r.ValueSet, r.ValueSetElement)
+	return json.Marshal(out)
+}
+
+func (r *ValueSetComposeInclude) UnmarshalJSON(data []byte) error {
+	type alias ValueSetComposeInclude
+	aux := struct {
+		*alias
+		ValueSet []*string `json:"valueSet,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.ValueSet, err = splitPrimitiveArray(aux.ValueSet, r.ValueSetElement); err != nil {
+		return fmt.Errorf("valueSet: %w", err)
+	}
+	return nil
+}
+
+func (r ValueSetComposeInclude) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetComposeInclude) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetComposeInclude) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetComposeInclude) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetComposeIncludeElementTypes = map[string]string{
+	"system":    "uri",
+	"valueSet":  "canonical",
+	"copyright": "markdown",
+}
+
+func (r *ValueSetComposeInclude) elementTypes() map[string]string {
+	return valueSetComposeIncludeElementTypes
+}
+
+var valueSetComposeIncludeElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.compose.include.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.include.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.compose.include.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.compose.include.system", Name: "system", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ValueSet.compose.include.version", Name: "version", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.include.concept", Name: "concept", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.compose.include.filter", Name: "filter", Field: 8, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.compose.include.valueSet", Name: "valueSet", Field: 9, Min: 0, Max: "*", Types: []string{"canonical"}},
+	{Path: "ValueSet.compose.include.copyright", Name: "copyright", Field: 11, Min: 0, Max: "1", Types: []string{"markdown"}},
+}
+
+func (r *ValueSetComposeInclude) ElementMetadata() []ElementMetadata {
+	return valueSetComposeIncludeElementMetadata
+}
+
+type ValueSetComposeIncludeConcept struct {
+	Id                *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string                                     `json:"code" bson:"code"`                                                // Code or expression from system
+	CodeElement       *Element                                   `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Display           *string                                    `json:"display,omitempty" bson:"display,omitempty"`                      // Text to display for this code for this value set in this valueset
+	DisplayElement    *Element                                   `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	Designation       []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty" bson:"designation,omitempty"`              // Additional representations for this concept
+}
+
+func (r *ValueSetComposeIncludeConcept) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	for i, item := range r.Designation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Designation[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetComposeIncludeConcept) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	for i, item := range r.Designation {
+		item.validateAll(fmt.Sprintf("%s.designation[%d]", path, i), issues)
+	}
+}
+
+var valueSetComposeIncludeConceptInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetComposeIncludeConcept) invariants() []invariant {
+	return valueSetComposeIncludeConceptInvariants
+}
+
+func (r ValueSetComposeIncludeConcept) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetComposeIncludeConcept) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetComposeIncludeConcept) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetComposeIncludeConcept) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetComposeIncludeConceptElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *ValueSetComposeIncludeConcept) elementTypes() map[string]string {
+	return valueSetComposeIncludeConceptElementTypes
+}
+
+var valueSetComposeIncludeConceptElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.compose.include.concept.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.include.concept.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.compose.include.concept.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.compose.include.concept.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.compose.include.concept.display", Name: "display", Field: 5, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.include.concept.designation", Name: "designation", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ValueSetComposeIncludeConcept) ElementMetadata() []ElementMetadata {
+	return valueSetComposeIncludeConceptElementMetadata
+}
+
+type ValueSetComposeIncludeConceptDesignation struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Language          *string     `json:"language,omitempty" bson:"language,omitempty"`                    // Human language of the designation
+	LanguageElement   *Element    `json:"_language,omitempty" bson:"language_element,omitempty"`           // Extensions for language
+	Use               *Coding     `json:"use,omitempty" bson:"use,omitempty"`                              // Types of uses of designations
+	AdditionalUse     []Coding    `json:"additionalUse,omitempty" bson:"additional_use,omitempty"`         // Additional ways how this designation would be used
+	Value             string      `json:"value" bson:"value"`                                              // The text value for this designation
+	ValueElement      *Element    `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
+	if r.Use != nil {
+		if err := r.Use.Validate(); err != nil {
+			return fmt.Errorf("Use: %w", err)
+		}
+	}
+	for i, item := range r.AdditionalUse {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AdditionalUse[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Use != nil {
+		r.Use.validateAll(path+".use", issues)
+	}
+	for i, item := range r.AdditionalUse {
+		item.validateAll(fmt.Sprintf("%s.additionalUse[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var valueSetComposeIncludeConceptDesignationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) invariants() []invariant {
+	return valueSetComposeIncludeConceptDesignationInvariants
+}
+
+func (r ValueSetComposeIncludeConceptDesignation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetComposeIncludeConceptDesignation) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetComposeIncludeConceptDesignationElementTypes = map[string]string{
+	"language": "code",
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) elementTypes() map[string]string {
+	return valueSetComposeIncludeConceptDesignationElementTypes
+}
+
+var valueSetComposeIncludeConceptDesignationElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.compose.include.concept.designation.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.include.concept.designation.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.compose.include.concept.designation.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.compose.include.concept.designation.language", Name: "language", Field: 3, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.compose.include.concept.designation.use", Name: "use", Field: 5, Min: 0, Max: "1", Types: []string{"Coding"}},
+	{Path: "ValueSet.compose.include.concept.designation.additionalUse", Name: "additionalUse", Field: 6, Min: 0, Max: "*", Types: []string{"Coding"}},
+	{Path: "ValueSet.compose.include.concept.designation.value", Name: "value", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ValueSetComposeIncludeConceptDesignation) ElementMetadata() []ElementMetadata {
+	return valueSetComposeIncludeConceptDesignationElementMetadata
+}
+
+type ValueSetComposeIncludeFilter struct {
+	Id                *string        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Property          string         `json:"property" bson:"property"`                                        // A property/filter defined by the code system
+	PropertyElement   *Element       `json:"_property,omitempty" bson:"property_element,omitempty"`           // Extensions for property
+	Op                FilterOperator `json:"op" bson:"op"`                                                    // = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
+	OpElement         *Element       `json:"_op,omitempty" bson:"op_element,omitempty"`                       // Extensions for op
+	Value             string         `json:"value" bson:"value"`                                              // Code from the system, or regex criteria, or boolean value for exists
+	ValueElement      *Element       `json:"_value,omitempty" bson:"value_element,omitempty"`                 // Extensions for value
+}
+
+func (r *ValueSetComposeIncludeFilter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Property == emptyString {
+		return fmt.Errorf("field 'Property' is required")
+	}
+	if r.PropertyElement != nil {
+		if err := r.PropertyElement.Validate(); err != nil {
+			return fmt.Errorf("PropertyElement: %w", err)
+		}
+	}
+	if r.Op == "" {
+		return fmt.Errorf("field 'Op' is required")
+	}
+	if !r.Op.IsValid() {
+		return fmt.Errorf("field 'Op' has invalid code '%s'", r.Op)
+	}
+	if r.OpElement != nil {
+		if err := r.OpElement.Validate(); err != nil {
+			return fmt.Errorf("OpElement: %w", err)
+		}
+	}
+	if r.Value == emptyString {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetComposeIncludeFilter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Property == emptyString {
+		issues.add("required", path+".property", "field 'Property' is required")
+	}
+	if r.PropertyElement != nil {
+		r.PropertyElement.validateAll(path+".property", issues)
+	}
+	if r.Op == "" {
+		issues.add("required", path+".op", "field 'Op' is required")
+	}
+	if r.Op != "" && !r.Op.IsValid() {
+		issues.add("code-invalid", path+".op", fmt.Sprintf("field 'Op' has invalid code '%s'", r.Op))
+	}
+	if r.OpElement != nil {
+		r.OpElement.validateAll(path+".op", issues)
+	}
+	if r.Value == emptyString {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var valueSetComposeIncludeFilterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetComposeIncludeFilter) invariants() []invariant {
+	return valueSetComposeIncludeFilterInvariants
+}
+
+func (r ValueSetComposeIncludeFilter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetComposeIncludeFilter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetComposeIncludeFilter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetComposeIncludeFilter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetComposeIncludeFilterElementTypes = map[string]string{
+	"property": "code",
+}
+
+func (r *ValueSetComposeIncludeFilter) elementTypes() map[string]string {
+	return valueSetComposeIncludeFilterElementTypes
+}
+
+var valueSetComposeIncludeFilterElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.compose.include.filter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.compose.include.filter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.compose.include.filter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.compose.include.filter.property", Name: "property", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.compose.include.filter.op", Name: "op", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "ValueSet.compose.include.filter.op", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/filter-operator"}},
+	{Path: "ValueSet.compose.include.filter.value", Name: "value", Field: 7, Min: 1, Max: "1", Types: []string{"string"}},
+}
+
+func (r *ValueSetComposeIncludeFilter) ElementMetadata() []ElementMetadata {
+	return valueSetComposeIncludeFilterElementMetadata
+}
+
+type ValueSetExpansion struct {
+	Id                *string                      `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                  `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                  `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Identifier        *string                      `json:"identifier,omitempty" bson:"identifier,omitempty"`                // Identifies the value set expansion (business identifier)
+	IdentifierElement *Element                     `json:"_identifier,omitempty" bson:"identifier_element,omitempty"`       // Extensions for identifier
+	Next              *string                      `json:"next,omitempty" bson:"next,omitempty"`                            // Opaque urls for paging through expansion results
+	NextElement       *Element                     `json:"_next,omitempty" bson:"next_element,omitempty"`                   // Extensions for next
+	Timestamp         *DateTime                    `json:"timestamp" bson:"timestamp"`                                      // Time ValueSet expansion happened
+	TimestampElement  *Element                     `json:"_timestamp,omitempty" bson:"timestamp_element,omitempty"`         // Extensions for timestamp
+	Total             *int                         `json:"total,omitempty" bson:"total,omitempty"`                          // Total number of codes in the expansion
+	TotalElement      *Element                     `json:"_total,omitempty" bson:"total_element,omitempty"`                 // Extensions for total
+	Offset            *int                         `json:"offset,omitempty" bson:"offset,omitempty"`                        // Offset at which this resource starts
+	OffsetElement     *Element                     `json:"_offset,omitempty" bson:"offset_element,omitempty"`               // Extensions for offset
+	Parameter         []ValueSetExpansionParameter `json:"parameter,omitempty" bson:"parameter,omitempty"`                  // Parameter that controlled the expansion process
+	Property          []ValueSetExpansionProperty  `json:"property,omitempty" bson:"property,omitempty"`                    // Additional information supplied about each concept
+	Contains          []ValueSetExpansionContains  `json:"contains,omitempty" bson:"contains,omitempty"`                    // Codes in the value set
+}
+
+func (r *ValueSetExpansion) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.IdentifierElement != nil {
+		if err := r.IdentifierElement.Validate(); err != nil {
+			return fmt.Errorf("IdentifierElement: %w", err)
+		}
+	}
+	if r.NextElement != nil {
+		if err := r.NextElement.Validate(); err != nil {
+			return fmt.Errorf("NextElement: %w", err)
+		}
+	}
+	if r.Timestamp == nil {
+		return fmt.Errorf("field 'Timestamp' is required")
+	}
+	if r.Timestamp != nil {
+		if err := r.Timestamp.Validate(); err != nil {
+			return fmt.Errorf("Timestamp: %w", err)
+		}
+	}
+	if r.TimestampElement != nil {
+		if err := r.TimestampElement.Validate(); err != nil {
+			return fmt.Errorf("TimestampElement: %w", err)
+		}
+	}
+	if r.TotalElement != nil {
+		if err := r.TotalElement.Validate(); err != nil {
+			return fmt.Errorf("TotalElement: %w", err)
+		}
+	}
+	if r.OffsetElement != nil {
+		if err := r.OffsetElement.Validate(); err != nil {
+			return fmt.Errorf("OffsetElement: %w", err)
+		}
+	}
+	for i, item := range r.Parameter {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Parameter[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Property {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Property[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Contains {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contains[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetExpansion) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.IdentifierElement != nil {
+		r.IdentifierElement.validateAll(path+".identifier", issues)
+	}
+	if r.NextElement != nil {
+		r.NextElement.validateAll(path+".next", issues)
+	}
+	if r.Timestamp == nil {
+		issues.add("required", path+".timestamp", "field 'Timestamp' is required")
+	}
+	if r.Timestamp != nil {
+		if err := r.Timestamp.Validate(); err != nil {
+			issues.add("value", path+".timestamp", err.Error())
+		}
+	}
+	if r.TimestampElement != nil {
+		r.TimestampElement.validateAll(path+".timestamp", issues)
+	}
+	if r.TotalElement != nil {
+		r.TotalElement.validateAll(path+".total", issues)
+	}
+	if r.OffsetElement != nil {
+		r.OffsetElement.validateAll(path+".offset", issues)
+	}
+	for i, item := range r.Parameter {
+		item.validateAll(fmt.Sprintf("%s.parameter[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Contains {
+		item.validateAll(fmt.Sprintf("%s.contains[%d]", path, i), issues)
+	}
+}
+
+var valueSetExpansionInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetExpansion) invariants() []invariant {
+	return valueSetExpansionInvariants
+}
+
+func (r ValueSetExpansion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetExpansion) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetExpansion) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetExpansion) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetExpansionElementTypes = map[string]string{
+	"identifier": "uri",
+	"next":       "uri",
+}
+
+func (r *ValueSetExpansion) elementTypes() map[string]string {
+	return valueSetExpansionElementTypes
+}
+
+var valueSetExpansionElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.expansion.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.expansion.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.expansion.identifier", Name: "identifier", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ValueSet.expansion.next", Name: "next", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ValueSet.expansion.timestamp", Name: "timestamp", Field: 7, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "ValueSet.expansion.total", Name: "total", Field: 9, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "ValueSet.expansion.offset", Name: "offset", Field: 11, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "ValueSet.expansion.parameter", Name: "parameter", Field: 13, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.expansion.property", Name: "property", Field: 14, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.expansion.contains", Name: "contains", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ValueSetExpansion) ElementMetadata() []ElementMetadata {
+	return valueSetExpansionElementMetadata
+}
+
+type ValueSetExpansionParameter struct {
+	Id                *string                         `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                     `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                     `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Name              string                          `json:"name" bson:"name"`                                                // Name as assigned by the client or server
+	NameElement       *Element                        `json:"_name,omitempty" bson:"name_element,omitempty"`                   // Extensions for name
+	Value             ValueSetExpansionParameterValue `json:"-" bson:"value,omitempty"`                                        // Value of the named parameter
+	ValueElement      *Element                        `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of ValueSet.expansion.parameter.value[x] when more than one was decoded
+}
+
+func (r *ValueSetExpansionParameter) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		return fmt.Errorf("field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		if err := r.NameElement.Validate(); err != nil {
+			return fmt.Errorf("NameElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetExpansionParameter) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Name == emptyString {
+		issues.add("required", path+".name", "field 'Name' is required")
+	}
+	if r.NameElement != nil {
+		r.NameElement.validateAll(path+".name", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var valueSetExpansionParameterInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetExpansionParameter) invariants() []invariant {
+	return valueSetExpansionParameterInvariants
+}
+
+func (r ValueSetExpansionParameter) MarshalJSON() ([]byte, error) {
+	type alias ValueSetExpansionParameter
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ValueSetExpansionParameter) UnmarshalJSON(data []byte) error {
+	type alias ValueSetExpansionParameter
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", valueSetExpansionParameterValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ValueSetExpansionParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetExpansionParameter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetExpansionParameter) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetExpansionParameter) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ValueSetExpansionParameter) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(valueSetExpansionParameterValueVariants)
+	}
+	return "", nil
+}
+
+var valueSetExpansionParameterElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.expansion.parameter.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.parameter.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.expansion.parameter.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.expansion.parameter.name", Name: "name", Field: 3, Min: 1, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.parameter.value[x]", Name: "value", Field: 5, Min: 0, Max: "1", Types: []string{"string", "boolean", "integer", "decimal", "uri", "code", "dateTime"}},
+}
+
+func (r *ValueSetExpansionParameter) ElementMetadata() []ElementMetadata {
+	return valueSetExpansionParameterElementMetadata
+}
+
+// ValueSetExpansionParameterValue is the type of ValueSet.expansion.parameter.value[x]. It is implemented by the ValueSetExpansionParameterValue* variant types.
+type ValueSetExpansionParameterValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isValueSetExpansionParameterValue()
+}
+
+// ValueSetExpansionParameterValueString is the string variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueString string
+
+func (v ValueSetExpansionParameterValueString) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionParameterValueString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ValueSetExpansionParameterValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ValueSetExpansionParameterValueString) isValueSetExpansionParameterValue() {}
+
+// ValueSetExpansionParameterValueBoolean is the boolean variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueBoolean bool
+
+func (v ValueSetExpansionParameterValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionParameterValueBoolean) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ValueSetExpansionParameterValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ValueSetExpansionParameterValueBoolean) isValueSetExpansionParameterValue() {}
+
+// ValueSetExpansionParameterValueInteger is the integer variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueInteger int
+
+func (v ValueSetExpansionParameterValueInteger) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionParameterValueInteger) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ValueSetExpansionParameterValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ValueSetExpansionParameterValueInteger) isValueSetExpansionParameterValue() {}
+
+// ValueSetExpansionParameterValueDecimal is the decimal variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueDecimal struct {
+	Decimal
+}
+
+func (v ValueSetExpansionParameterValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ValueSetExpansionParameterValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ValueSetExpansionParameterValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ValueSetExpansionParameterValueDecimal) isValueSetExpansionParameterValue() {}
+
+// ValueSetExpansionParameterValueUri is the uri variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueUri string
+
+func (v ValueSetExpansionParameterValueUri) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionParameterValueUri) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ValueSetExpansionParameterValueUri) FHIRType() string {
+	return "uri"
+}
+
+func (v ValueSetExpansionParameterValueUri) isValueSetExpansionParameterValue() {}
+
+// ValueSetExpansionParameterValueCode is the code variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueCode string
+
+func (v ValueSetExpansionParameterValueCode) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionParameterValueCode) validateAll(path string, issues *ValidationIssues) {}
+
+func (v ValueSetExpansionParameterValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ValueSetExpansionParameterValueCode) isValueSetExpansionParameterValue() {}
+
+// ValueSetExpansionParameterValueDateTime is the dateTime variant of ValueSetExpansionParameterValue.
+type ValueSetExpansionParameterValueDateTime struct {
+	DateTime
+}
+
+func (v ValueSetExpansionParameterValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ValueSetExpansionParameterValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ValueSetExpansionParameterValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ValueSetExpansionParameterValueDateTime) isValueSetExpansionParameterValue() {}
+
+var valueSetExpansionParameterValueVariants = []ValueSetExpansionParameterValue{
+	ValueSetExpansionParameterValueString(""),
+	ValueSetExpansionParameterValueBoolean(false),
+	ValueSetExpansionParameterValueInteger(0),
+	ValueSetExpansionParameterValueDecimal{},
+	ValueSetExpansionParameterValueUri(""),
+	ValueSetExpansionParameterValueCode(""),
+	ValueSetExpansionParameterValueDateTime{},
+}
+
+type ValueSetExpansionProperty struct {
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string      `json:"code" bson:"code"`                                                // Identifies the property on the concepts, and when referred to in operations
+	CodeElement       *Element    `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Uri               *string     `json:"uri,omitempty" bson:"uri,omitempty"`                              // Formal identifier for the property
+	UriElement        *Element    `json:"_uri,omitempty" bson:"uri_element,omitempty"`                     // Extensions for uri
+}
+
+func (r *ValueSetExpansionProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.UriElement != nil {
+		if err := r.UriElement.Validate(); err != nil {
+			return fmt.Errorf("UriElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetExpansionProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.UriElement != nil {
+		r.UriElement.validateAll(path+".uri", issues)
+	}
+}
+
+var valueSetExpansionPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetExpansionProperty) invariants() []invariant {
+	return valueSetExpansionPropertyInvariants
+}
+
+func (r ValueSetExpansionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetExpansionProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetExpansionProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetExpansionProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetExpansionPropertyElementTypes = map[string]string{
+	"code": "code",
+	"uri":  "uri",
+}
+
+func (r *ValueSetExpansionProperty) elementTypes() map[string]string {
+	return valueSetExpansionPropertyElementTypes
+}
+
+var valueSetExpansionPropertyElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.expansion.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.expansion.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.expansion.property.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.expansion.property.uri", Name: "uri", Field: 5, Min: 0, Max: "1", Types: []string{"uri"}},
+}
+
+func (r *ValueSetExpansionProperty) ElementMetadata() []ElementMetadata {
+	return valueSetExpansionPropertyElementMetadata
+}
+
+type ValueSetExpansionContains struct {
+	Id                *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	System            *string                                    `json:"system,omitempty" bson:"system,omitempty"`                        // System value for the code
+	SystemElement     *Element                                   `json:"_system,omitempty" bson:"system_element,omitempty"`               // Extensions for system
+	Abstract          *bool                                      `json:"abstract,omitempty" bson:"abstract,omitempty"`                    // If user cannot select this entry
+	AbstractElement   *Element                                   `json:"_abstract,omitempty" bson:"abstract_element,omitempty"`           // Extensions for abstract
+	Inactive          *bool                                      `json:"inactive,omitempty" bson:"inactive,omitempty"`                    // If concept is inactive in the code system
+	InactiveElement   *Element                                   `json:"_inactive,omitempty" bson:"inactive_element,omitempty"`           // Extensions for inactive
+	Version           *string                                    `json:"version,omitempty" bson:"version,omitempty"`                      // Version in which this code/display is defined
+	VersionElement    *Element                                   `json:"_version,omitempty" bson:"version_element,omitempty"`             // Extensions for version
+	Code              *string                                    `json:"code,omitempty" bson:"code,omitempty"`                            // Code - if blank, this is not a selectable code
+	CodeElement       *Element                                   `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Display           *string                                    `json:"display,omitempty" bson:"display,omitempty"`                      // User display for the concept
+	DisplayElement    *Element                                   `json:"_display,omitempty" bson:"display_element,omitempty"`             // Extensions for display
+	Designation       []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty" bson:"designation,omitempty"`              // Additional representations for this item
+	Property          []ValueSetExpansionContainsProperty        `json:"property,omitempty" bson:"property,omitempty"`                    // Property value for the concept
+	Contains          []ValueSetExpansionContains                `json:"contains,omitempty" bson:"contains,omitempty"`                    // Codes contained under this entry
+}
+
+func (r *ValueSetExpansionContains) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.SystemElement != nil {
+		if err := r.SystemElement.Validate(); err != nil {
+			return fmt.Errorf("SystemElement: %w", err)
+		}
+	}
+	if r.AbstractElement != nil {
+		if err := r.AbstractElement.Validate(); err != nil {
+			return fmt.Errorf("AbstractElement: %w", err)
+		}
+	}
+	if r.InactiveElement != nil {
+		if err := r.InactiveElement.Validate(); err != nil {
+			return fmt.Errorf("InactiveElement: %w", err)
+		}
+	}
+	if r.VersionElement != nil {
+		if err := r.VersionElement.Validate(); err != nil {
+			return fmt.Errorf("VersionElement: %w", err)
+		}
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if r.DisplayElement != nil {
+		if err := r.DisplayElement.Validate(); err != nil {
+			return fmt.Errorf("DisplayElement: %w", err)
+		}
+	}
+	for i, item := range r.Designation {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Designation[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Property {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Property[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Contains {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contains[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetExpansionContains) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.SystemElement != nil {
+		r.SystemElement.validateAll(path+".system", issues)
+	}
+	if r.AbstractElement != nil {
+		r.AbstractElement.validateAll(path+".abstract", issues)
+	}
+	if r.InactiveElement != nil {
+		r.InactiveElement.validateAll(path+".inactive", issues)
+	}
+	if r.VersionElement != nil {
+		r.VersionElement.validateAll(path+".version", issues)
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if r.DisplayElement != nil {
+		r.DisplayElement.validateAll(path+".display", issues)
+	}
+	for i, item := range r.Designation {
+		item.validateAll(fmt.Sprintf("%s.designation[%d]", path, i), issues)
+	}
+	for i, item := range r.Property {
+		item.validateAll(fmt.Sprintf("%s.property[%d]", path, i), issues)
+	}
+	for i, item := range r.Contains {
+		item.validateAll(fmt.Sprintf("%s.contains[%d]", path, i), issues)
+	}
+}
+
+var valueSetExpansionContainsInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetExpansionContains) invariants() []invariant {
+	return valueSetExpansionContainsInvariants
+}
+
+func (r ValueSetExpansionContains) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetExpansionContains) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetExpansionContains) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetExpansionContains) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var valueSetExpansionContainsElementTypes = map[string]string{
+	"system": "uri",
+	"code":   "code",
+}
+
+func (r *ValueSetExpansionContains) elementTypes() map[string]string {
+	return valueSetExpansionContainsElementTypes
+}
+
+var valueSetExpansionContainsElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.expansion.contains.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.contains.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.expansion.contains.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.expansion.contains.system", Name: "system", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}},
+	{Path: "ValueSet.expansion.contains.abstract", Name: "abstract", Field: 5, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ValueSet.expansion.contains.inactive", Name: "inactive", Field: 7, Min: 0, Max: "1", Types: []string{"boolean"}},
+	{Path: "ValueSet.expansion.contains.version", Name: "version", Field: 9, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.contains.code", Name: "code", Field: 11, Min: 0, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.expansion.contains.display", Name: "display", Field: 13, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.contains.designation", Name: "designation", Field: 15, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.expansion.contains.property", Name: "property", Field: 16, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "ValueSet.expansion.contains.contains", Name: "contains", Field: 17, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ValueSetExpansionContains) ElementMetadata() []ElementMetadata {
+	return valueSetExpansionContainsElementMetadata
+}
+
+type ValueSetExpansionContainsProperty struct {
+	Id                *string                                        `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                    `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                    `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string                                         `json:"code" bson:"code"`                                                // Reference to ValueSet.expansion.property.code
+	CodeElement       *Element                                       `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Value             ValueSetExpansionContainsPropertyValue         `json:"-" bson:"value,omitempty"`                                        // Value of the property for this concept
+	ValueElement      *Element                                       `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+	SubProperty       []ValueSetExpansionContainsPropertySubProperty `json:"subProperty,omitempty" bson:"sub_property,omitempty"`             // SubProperty value for the concept
+
+	valueVariants []string // JSON properties of ValueSet.expansion.contains.property.value[x] when more than one was decoded
+}
+
+func (r *ValueSetExpansionContainsProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	for i, item := range r.SubProperty {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("SubProperty[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetExpansionContainsProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+	for i, item := range r.SubProperty {
+		item.validateAll(fmt.Sprintf("%s.subProperty[%d]", path, i), issues)
+	}
+}
+
+var valueSetExpansionContainsPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetExpansionContainsProperty) invariants() []invariant {
+	return valueSetExpansionContainsPropertyInvariants
+}
+
+func (r ValueSetExpansionContainsProperty) MarshalJSON() ([]byte, error) {
+	type alias ValueSetExpansionContainsProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ValueSetExpansionContainsProperty) UnmarshalJSON(data []byte) error {
+	type alias ValueSetExpansionContainsProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", valueSetExpansionContainsPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ValueSetExpansionContainsProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetExpansionContainsProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetExpansionContainsProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetExpansionContainsProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ValueSetExpansionContainsProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(valueSetExpansionContainsPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var valueSetExpansionContainsPropertyElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *ValueSetExpansionContainsProperty) elementTypes() map[string]string {
+	return valueSetExpansionContainsPropertyElementTypes
+}
+
+var valueSetExpansionContainsPropertyElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.expansion.contains.property.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.contains.property.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.expansion.contains.property.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.expansion.contains.property.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.expansion.contains.property.value[x]", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"code", "Coding", "string", "integer", "boolean", "dateTime", "decimal"}},
+	{Path: "ValueSet.expansion.contains.property.subProperty", Name: "subProperty", Field: 7, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *ValueSetExpansionContainsProperty) ElementMetadata() []ElementMetadata {
+	return valueSetExpansionContainsPropertyElementMetadata
+}
+
+// ValueSetExpansionContainsPropertyValue is the type of ValueSet.expansion.contains.property.value[x]. It is implemented by the ValueSetExpansionContainsPropertyValue* variant types.
+type ValueSetExpansionContainsPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isValueSetExpansionContainsPropertyValue()
+}
+
+// ValueSetExpansionContainsPropertyValueCode is the code variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueCode string
+
+func (v ValueSetExpansionContainsPropertyValueCode) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertyValueCode) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertyValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ValueSetExpansionContainsPropertyValueCode) isValueSetExpansionContainsPropertyValue() {}
+
+// ValueSetExpansionContainsPropertyValueCoding is the Coding variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueCoding struct {
+	Coding
+}
+
+func (v ValueSetExpansionContainsPropertyValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ValueSetExpansionContainsPropertyValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ValueSetExpansionContainsPropertyValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ValueSetExpansionContainsPropertyValueCoding) isValueSetExpansionContainsPropertyValue() {}
+
+// ValueSetExpansionContainsPropertyValueString is the string variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueString string
+
+func (v ValueSetExpansionContainsPropertyValueString) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertyValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ValueSetExpansionContainsPropertyValueString) isValueSetExpansionContainsPropertyValue() {}
+
+// ValueSetExpansionContainsPropertyValueInteger is the integer variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueInteger int
+
+func (v ValueSetExpansionContainsPropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertyValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ValueSetExpansionContainsPropertyValueInteger) isValueSetExpansionContainsPropertyValue() {}
+
+// ValueSetExpansionContainsPropertyValueBoolean is the boolean variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueBoolean bool
+
+func (v ValueSetExpansionContainsPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ValueSetExpansionContainsPropertyValueBoolean) isValueSetExpansionContainsPropertyValue() {}
+
+// ValueSetExpansionContainsPropertyValueDateTime is the dateTime variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueDateTime struct {
+	DateTime
+}
+
+func (v ValueSetExpansionContainsPropertyValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ValueSetExpansionContainsPropertyValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ValueSetExpansionContainsPropertyValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ValueSetExpansionContainsPropertyValueDateTime) isValueSetExpansionContainsPropertyValue() {}
+
+// ValueSetExpansionContainsPropertyValueDecimal is the decimal variant of ValueSetExpansionContainsPropertyValue.
+type ValueSetExpansionContainsPropertyValueDecimal struct {
+	Decimal
+}
+
+func (v ValueSetExpansionContainsPropertyValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ValueSetExpansionContainsPropertyValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ValueSetExpansionContainsPropertyValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ValueSetExpansionContainsPropertyValueDecimal) isValueSetExpansionContainsPropertyValue() {}
+
+var valueSetExpansionContainsPropertyValueVariants = []ValueSetExpansionContainsPropertyValue{
+	ValueSetExpansionContainsPropertyValueCode(""),
+	ValueSetExpansionContainsPropertyValueCoding{},
+	ValueSetExpansionContainsPropertyValueString(""),
+	ValueSetExpansionContainsPropertyValueInteger(0),
+	ValueSetExpansionContainsPropertyValueBoolean(false),
+	ValueSetExpansionContainsPropertyValueDateTime{},
+	ValueSetExpansionContainsPropertyValueDecimal{},
+}
+
+type ValueSetExpansionContainsPropertySubProperty struct {
+	Id                *string                                           `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                       `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                       `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Code              string                                            `json:"code" bson:"code"`                                                // Reference to ValueSet.expansion.property.code
+	CodeElement       *Element                                          `json:"_code,omitempty" bson:"code_element,omitempty"`                   // Extensions for code
+	Value             ValueSetExpansionContainsPropertySubPropertyValue `json:"-" bson:"value,omitempty"`                                        // Value of the subproperty for this concept
+	ValueElement      *Element                                          `json:"-" bson:"value_element,omitempty"`                                // Extensions for value[x]
+
+	valueVariants []string // JSON properties of ValueSet.expansion.contains.property.subProperty.value[x] when more than one was decoded
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		return fmt.Errorf("field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		if err := r.CodeElement.Validate(); err != nil {
+			return fmt.Errorf("CodeElement: %w", err)
+		}
+	}
+	if len(r.valueVariants) > 1 {
+		return fmt.Errorf("field 'Value' must have a single type, got %v", r.valueVariants)
+	}
+	if r.Value == nil {
+		return fmt.Errorf("field 'Value' is required")
+	}
+	if r.Value != nil {
+		if err := r.Value.Validate(); err != nil {
+			return fmt.Errorf("Value: %w", err)
+		}
+	}
+	if r.ValueElement != nil {
+		if err := r.ValueElement.Validate(); err != nil {
+			return fmt.Errorf("ValueElement: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	var emptyString string
+	if r.Code == emptyString {
+		issues.add("required", path+".code", "field 'Code' is required")
+	}
+	if r.CodeElement != nil {
+		r.CodeElement.validateAll(path+".code", issues)
+	}
+	if len(r.valueVariants) > 1 {
+		issues.add("structure", path+".value", fmt.Sprintf("field 'Value' must have a single type, got %v", r.valueVariants))
+	}
+	if r.Value == nil {
+		issues.add("required", path+".value", "field 'Value' is required")
+	}
+	if r.Value != nil {
+		r.Value.validateAll(path+".value.ofType("+r.Value.FHIRType()+")", issues)
+	}
+	if r.ValueElement != nil {
+		r.ValueElement.validateAll(path+".value", issues)
+	}
+}
+
+var valueSetExpansionContainsPropertySubPropertyInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) invariants() []invariant {
+	return valueSetExpansionContainsPropertySubPropertyInvariants
+}
+
+func (r ValueSetExpansionContainsPropertySubProperty) MarshalJSON() ([]byte, error) {
+	type alias ValueSetExpansionContainsPropertySubProperty
+	out := struct {
+		alias
+	}{alias: alias(r)}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "value", r.Value, r.ValueElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) UnmarshalJSON(data []byte) error {
+	type alias ValueSetExpansionContainsPropertySubProperty
+	aux := struct {
+		*alias
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Value, element, r.valueVariants, err = unmarshalChoice(fields, "value", valueSetExpansionContainsPropertySubPropertyValueVariants); err != nil {
+		return err
+	}
+	r.ValueElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.ValueElement); err != nil {
+			return fmt.Errorf("_value: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ValueSetExpansionContainsPropertySubProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r ValueSetExpansionContainsPropertySubProperty) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Value":
+		return "value", choiceValues(valueSetExpansionContainsPropertySubPropertyValueVariants)
+	}
+	return "", nil
+}
+
+var valueSetExpansionContainsPropertySubPropertyElementTypes = map[string]string{
+	"code": "code",
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) elementTypes() map[string]string {
+	return valueSetExpansionContainsPropertySubPropertyElementTypes
+}
+
+var valueSetExpansionContainsPropertySubPropertyElementMetadata = []ElementMetadata{
+	{Path: "ValueSet.expansion.contains.property.subProperty.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "ValueSet.expansion.contains.property.subProperty.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "ValueSet.expansion.contains.property.subProperty.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "ValueSet.expansion.contains.property.subProperty.code", Name: "code", Field: 3, Min: 1, Max: "1", Types: []string{"code"}},
+	{Path: "ValueSet.expansion.contains.property.subProperty.value[x]", Name: "value", Field: 5, Min: 1, Max: "1", Types: []string{"code", "Coding", "string", "integer", "boolean", "dateTime", "decimal"}},
+}
+
+func (r *ValueSetExpansionContainsPropertySubProperty) ElementMetadata() []ElementMetadata {
+	return valueSetExpansionContainsPropertySubPropertyElementMetadata
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValue is the type of ValueSet.expansion.contains.property.subProperty.value[x]. It is implemented by the ValueSetExpansionContainsPropertySubPropertyValue* variant types.
+type ValueSetExpansionContainsPropertySubPropertyValue interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isValueSetExpansionContainsPropertySubPropertyValue()
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueCode is the code variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueCode string
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCode) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCode) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCode) FHIRType() string {
+	return "code"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCode) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueCoding is the Coding variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueCoding struct {
+	Coding
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCoding) Validate() error {
+	return v.Coding.Validate()
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCoding) validateAll(path string, issues *ValidationIssues) {
+	v.Coding.validateAll(path, issues)
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCoding) FHIRType() string {
+	return "Coding"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueCoding) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueString is the string variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueString string
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueString) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueString) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueString) FHIRType() string {
+	return "string"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueString) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueInteger is the integer variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueInteger int
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueInteger) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueInteger) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueInteger) FHIRType() string {
+	return "integer"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueInteger) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueBoolean is the boolean variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueBoolean bool
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueBoolean) Validate() error {
+	return nil
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueBoolean) validateAll(path string, issues *ValidationIssues) {
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueBoolean) FHIRType() string {
+	return "boolean"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueBoolean) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueDateTime is the dateTime variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueDateTime struct {
+	DateTime
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDateTime) Validate() error {
+	return v.DateTime.Validate()
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDateTime) validateAll(path string, issues *ValidationIssues) {
+	if err := v.DateTime.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDateTime) FHIRType() string {
+	return "dateTime"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDateTime) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+// ValueSetExpansionContainsPropertySubPropertyValueDecimal is the decimal variant of ValueSetExpansionContainsPropertySubPropertyValue.
+type ValueSetExpansionContainsPropertySubPropertyValueDecimal struct {
+	Decimal
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDecimal) Validate() error {
+	return v.Decimal.Validate()
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDecimal) validateAll(path string, issues *ValidationIssues) {
+	if err := v.Decimal.Validate(); err != nil {
+		issues.add("value", path, err.Error())
+	}
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDecimal) FHIRType() string {
+	return "decimal"
+}
+
+func (v ValueSetExpansionContainsPropertySubPropertyValueDecimal) isValueSetExpansionContainsPropertySubPropertyValue() {
+}
+
+var valueSetExpansionContainsPropertySubPropertyValueVariants = []ValueSetExpansionContainsPropertySubPropertyValue{
+	ValueSetExpansionContainsPropertySubPropertyValueCode(""),
+	ValueSetExpansionContainsPropertySubPropertyValueCoding{},
+	ValueSetExpansionContainsPropertySubPropertyValueString(""),
+	ValueSetExpansionContainsPropertySubPropertyValueInteger(0),
+	ValueSetExpansionContainsPropertySubPropertyValueBoolean(false),
+	ValueSetExpansionContainsPropertySubPropertyValueDateTime{},
+	ValueSetExpansionContainsPropertySubPropertyValueDecimal{},
+}
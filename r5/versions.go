@@ -1,9 +1,102 @@
 package models
 
-// Using the [FHIR Version Mime Type Parameter](http.html#version-parameter), a server can support [multiple versions on the same end-point](versioning.html#mt-version). The only way for client to find out what versions a server supports in this fashion is the $versions operation. The client invokes the operation with no parameters. and the server returns the list of supported versions, along with the default version it will use if no fhirVersion parameter is present
-type Versions struct {
+import "fmt"
+
+// VersionsIn holds the input parameters of the $versions operation. Using the [FHIR Version Mime Type Parameter](http.html#version-parameter), a server can support [multiple versions on the same end-point](versioning.html#mt-version). The only way for client to find out what versions a server supports in this fashion is the $versions operation. The client invokes the operation with no parameters. and the server returns the list of supported versions, along with the default version it will use if no fhirVersion parameter is present
+type VersionsIn struct {
+}
+
+func (p *VersionsIn) Validate() error {
+	return nil
+}
+
+func (p *VersionsIn) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *VersionsIn) FromParameters(params *Parameters) error {
+	*p = VersionsIn{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *VersionsIn) parameters() []ParametersParameter {
+	return nil
+}
+
+func (p *VersionsIn) setParameters(params []ParametersParameter) error {
+	return nil
+}
+
+// VersionsOut holds the output parameters of the $versions operation.
+type VersionsOut struct {
+	// A version supported by the server
+	Version []string
+	// The default version for the server
+	Default *string
+}
+
+func (p *VersionsOut) Validate() error {
+	if len(p.Version) < 1 {
+		return fmt.Errorf("parameter 'version' must occur at least 1 times")
+	}
+	if p.Default == nil {
+		return fmt.Errorf("parameter 'default' is required")
+	}
+	return nil
+}
+
+func (p *VersionsOut) ToParameters() (*Parameters, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &Parameters{ResourceType: "Parameters", Parameter: p.parameters()}, nil
+}
+
+func (p *VersionsOut) FromParameters(params *Parameters) error {
+	*p = VersionsOut{}
+	if err := p.setParameters(params.Parameter); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+func (p *VersionsOut) parameters() []ParametersParameter {
+	var params []ParametersParameter
+	for _, v := range p.Version {
+		params = append(params, ParametersParameter{Name: "version", Value: ParametersParameterValueCode(v)})
+	}
+	if p.Default != nil {
+		params = append(params, ParametersParameter{Name: "default", Value: ParametersParameterValueCode(*p.Default)})
+	}
+	return params
 }
 
-func (r *Versions) Validate() error {
+func (p *VersionsOut) setParameters(params []ParametersParameter) error {
+	for _, param := range params {
+		switch param.Name {
+		case "version":
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			p.Version = append(p.Version, string(v))
+		case "default":
+			if p.Default != nil {
+				return fmt.Errorf("parameter 'default' must occur at most once")
+			}
+			v, err := parameterValue[ParametersParameterValueCode](param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+			value := string(v)
+			p.Default = &value
+		}
+	}
 	return nil
 }
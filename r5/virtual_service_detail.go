@@ -1,37 +1,292 @@
 package models
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // VirtualServiceDetail Type: The set of values required to describe a virtual service's connection details, including some limitations of the service.
 type VirtualServiceDetail struct {
-	Id                           *string                `json:"id,omitempty" bson:"id,omitempty"`                                                        // Unique id for inter-element referencing
-	ChannelType                  *Coding                `json:"channelType,omitempty" bson:"channel_type,omitempty"`                                     // Channel Type
-	AddressUrl                   *string                `json:"addressUrl,omitempty" bson:"address_url,omitempty"`                                       // Contact address/number
-	AddressString                *string                `json:"addressString,omitempty" bson:"address_string,omitempty"`                                 // Contact address/number
-	AddressContactPoint          *ContactPoint          `json:"addressContactPoint,omitempty" bson:"address_contact_point,omitempty"`                    // Contact address/number
-	AddressExtendedContactDetail *ExtendedContactDetail `json:"addressExtendedContactDetail,omitempty" bson:"address_extended_contact_detail,omitempty"` // Contact address/number
-	AdditionalInfo               []string               `json:"additionalInfo,omitempty" bson:"additional_info,omitempty"`                               // Web address to see alternative connection details
-	MaxParticipants              *int                   `json:"maxParticipants,omitempty" bson:"max_participants,omitempty"`                             // Maximum number of participants supported by the virtual service
-	SessionKey                   *string                `json:"sessionKey,omitempty" bson:"session_key,omitempty"`                                       // Session Key required by the virtual service
+	Id                     *string                     `json:"id,omitempty" bson:"id,omitempty"`                                     // Unique id for inter-element referencing
+	Extension              []Extension                 `json:"extension,omitempty" bson:"extension,omitempty"`                       // Additional content defined by implementations
+	ChannelType            *Coding                     `json:"channelType,omitempty" bson:"channel_type,omitempty"`                  // Channel Type
+	Address                VirtualServiceDetailAddress `json:"-" bson:"address,omitempty"`                                           // Contact address/number
+	AddressElement         *Element                    `json:"-" bson:"address_element,omitempty"`                                   // Extensions for address[x]
+	AdditionalInfo         []string                    `json:"additionalInfo,omitempty" bson:"additional_info,omitempty"`            // Web address to see alternative connection details
+	AdditionalInfoElement  []*Element                  `json:"_additionalInfo,omitempty" bson:"additional_info_element,omitempty"`   // Extensions for additionalInfo
+	MaxParticipants        *int                        `json:"maxParticipants,omitempty" bson:"max_participants,omitempty"`          // Maximum number of participants supported by the virtual service
+	MaxParticipantsElement *Element                    `json:"_maxParticipants,omitempty" bson:"max_participants_element,omitempty"` // Extensions for maxParticipants
+	SessionKey             *string                     `json:"sessionKey,omitempty" bson:"session_key,omitempty"`                    // Session Key required by the virtual service
+	SessionKeyElement      *Element                    `json:"_sessionKey,omitempty" bson:"session_key_element,omitempty"`           // Extensions for sessionKey
+
+	addressVariants []string // JSON properties of VirtualServiceDetail.address[x] when more than one was decoded
 }
 
 func (r *VirtualServiceDetail) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
 	if r.ChannelType != nil {
 		if err := r.ChannelType.Validate(); err != nil {
 			return fmt.Errorf("ChannelType: %w", err)
 		}
 	}
-	if r.AddressContactPoint != nil {
-		if err := r.AddressContactPoint.Validate(); err != nil {
-			return fmt.Errorf("AddressContactPoint: %w", err)
+	if len(r.addressVariants) > 1 {
+		return fmt.Errorf("field 'Address' must have a single type, got %v", r.addressVariants)
+	}
+	if r.Address != nil {
+		if err := r.Address.Validate(); err != nil {
+			return fmt.Errorf("Address: %w", err)
+		}
+	}
+	if r.AddressElement != nil {
+		if err := r.AddressElement.Validate(); err != nil {
+			return fmt.Errorf("AddressElement: %w", err)
+		}
+	}
+	for i, item := range r.AdditionalInfoElement {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("AdditionalInfoElement[%d]: %w", i, err)
+		}
+	}
+	if r.MaxParticipantsElement != nil {
+		if err := r.MaxParticipantsElement.Validate(); err != nil {
+			return fmt.Errorf("MaxParticipantsElement: %w", err)
+		}
+	}
+	if r.SessionKeyElement != nil {
+		if err := r.SessionKeyElement.Validate(); err != nil {
+			return fmt.Errorf("SessionKeyElement: %w", err)
 		}
 	}
-	if r.AddressExtendedContactDetail != nil {
-		if err := r.AddressExtendedContactDetail.Validate(); err != nil {
-			return fmt.Errorf("AddressExtendedContactDetail: %w", err)
+	return nil
+}
+
+func (r *VirtualServiceDetail) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	if r.ChannelType != nil {
+		r.ChannelType.validateAll(path+".channelType", issues)
+	}
+	if len(r.addressVariants) > 1 {
+		issues.add("structure", path+".address", fmt.Sprintf("field 'Address' must have a single type, got %v", r.addressVariants))
+	}
+	if r.Address != nil {
+		r.Address.validateAll(path+".address.ofType("+r.Address.FHIRType()+")", issues)
+	}
+	if r.AddressElement != nil {
+		r.AddressElement.validateAll(path+".address", issues)
+	}
+	for i, item := range r.AdditionalInfoElement {
+		if item == nil {
+			continue
+		}
+		item.validateAll(fmt.Sprintf("%s.additionalInfo[%d]", path, i), issues)
+	}
+	if r.MaxParticipantsElement != nil {
+		r.MaxParticipantsElement.validateAll(path+".maxParticipants", issues)
+	}
+	if r.SessionKeyElement != nil {
+		r.SessionKeyElement.validateAll(path+".sessionKey", issues)
+	}
+}
+
+func (r *VirtualServiceDetail) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("VirtualServiceDetail", &issues)
+	checkInvariants(r, "VirtualServiceDetail", &issues)
+	return issues
+}
+
+var virtualServiceDetailInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *VirtualServiceDetail) invariants() []invariant {
+	return virtualServiceDetailInvariants
+}
+
+func (r VirtualServiceDetail) MarshalJSON() ([]byte, error) {
+	type alias VirtualServiceDetail
+	out := struct {
+		alias
+		AdditionalInfo        []*string  `json:"additionalInfo,omitempty"`
+		AdditionalInfoElement []*Element `json:"_additionalInfo,omitempty"`
+	}{alias: alias(r)}
+	out.AdditionalInfo, out.AdditionalInfoElement = alignPrimitiveArray(r.AdditionalInfo, r.AdditionalInfoElement)
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = marshalChoice(data, "address", r.Address, r.AddressElement); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *VirtualServiceDetail) UnmarshalJSON(data []byte) error {
+	type alias VirtualServiceDetail
+	aux := struct {
+		*alias
+		AdditionalInfo []*string `json:"additionalInfo,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if r.AdditionalInfo, err = splitPrimitiveArray(aux.AdditionalInfo, r.AdditionalInfoElement); err != nil {
+		return fmt.Errorf("additionalInfo: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var element json.RawMessage
+	if r.Address, element, r.addressVariants, err = unmarshalChoice(fields, "address", virtualServiceDetailAddressVariants); err != nil {
+		return err
+	}
+	r.AddressElement = nil
+	if element != nil {
+		if err := json.Unmarshal(element, &r.AddressElement); err != nil {
+			return fmt.Errorf("_address: %w", err)
 		}
 	}
 	return nil
 }
+
+func (r VirtualServiceDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *VirtualServiceDetail) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r VirtualServiceDetail) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *VirtualServiceDetail) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+func (r *VirtualServiceDetail) choiceVariants(field string) (string, []choiceValue) {
+	switch field {
+	case "Address":
+		return "address", choiceValues(virtualServiceDetailAddressVariants)
+	}
+	return "", nil
+}
+
+var virtualServiceDetailElementTypes = map[string]string{
+	"additionalInfo":  "url",
+	"maxParticipants": "positiveInt",
+}
+
+func (r *VirtualServiceDetail) elementTypes() map[string]string {
+	return virtualServiceDetailElementTypes
+}
+
+var virtualServiceDetailElementMetadata = []ElementMetadata{
+	{Path: "VirtualServiceDetail.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "VirtualServiceDetail.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "VirtualServiceDetail.channelType", Name: "channelType", Field: 2, Min: 0, Max: "1", Types: []string{"Coding"}, Binding: &BindingDefinition{Path: "VirtualServiceDetail.channelType", Strength: "example", ValueSet: "http://hl7.org/fhir/ValueSet/virtual-service-type", Description: "The purpose for which an extended contact detail should be used."}, Summary: true},
+	{Path: "VirtualServiceDetail.address[x]", Name: "address", Field: 3, Min: 0, Max: "1", Types: []string{"url", "string", "ContactPoint", "ExtendedContactDetail"}, Summary: true},
+	{Path: "VirtualServiceDetail.additionalInfo", Name: "additionalInfo", Field: 5, Min: 0, Max: "*", Types: []string{"url"}, Summary: true},
+	{Path: "VirtualServiceDetail.maxParticipants", Name: "maxParticipants", Field: 7, Min: 0, Max: "1", Types: []string{"positiveInt"}, Summary: true},
+	{Path: "VirtualServiceDetail.sessionKey", Name: "sessionKey", Field: 9, Min: 0, Max: "1", Types: []string{"string"}, Summary: true},
+}
+
+func (r *VirtualServiceDetail) ElementMetadata() []ElementMetadata {
+	return virtualServiceDetailElementMetadata
+}
+
+// VirtualServiceDetailAddress is the type of VirtualServiceDetail.address[x]. It is implemented by the VirtualServiceDetailAddress* variant types.
+type VirtualServiceDetailAddress interface {
+	FHIRType() string
+	Validate() error
+	validateAll(path string, issues *ValidationIssues)
+	isVirtualServiceDetailAddress()
+}
+
+// VirtualServiceDetailAddressUrl is the url variant of VirtualServiceDetailAddress.
+type VirtualServiceDetailAddressUrl string
+
+func (v VirtualServiceDetailAddressUrl) Validate() error {
+	return nil
+}
+
+func (v VirtualServiceDetailAddressUrl) validateAll(path string, issues *ValidationIssues) {}
+
+func (v VirtualServiceDetailAddressUrl) FHIRType() string {
+	return "url"
+}
+
+func (v VirtualServiceDetailAddressUrl) isVirtualServiceDetailAddress() {}
+
+// VirtualServiceDetailAddressString is the string variant of VirtualServiceDetailAddress.
+type VirtualServiceDetailAddressString string
+
+func (v VirtualServiceDetailAddressString) Validate() error {
+	return nil
+}
+
+func (v VirtualServiceDetailAddressString) validateAll(path string, issues *ValidationIssues) {}
+
+func (v VirtualServiceDetailAddressString) FHIRType() string {
+	return "string"
+}
+
+func (v VirtualServiceDetailAddressString) isVirtualServiceDetailAddress() {}
+
+// VirtualServiceDetailAddressContactPoint is the ContactPoint variant of VirtualServiceDetailAddress.
+type VirtualServiceDetailAddressContactPoint struct {
+	ContactPoint
+}
+
+func (v VirtualServiceDetailAddressContactPoint) Validate() error {
+	return v.ContactPoint.Validate()
+}
+
+func (v VirtualServiceDetailAddressContactPoint) validateAll(path string, issues *ValidationIssues) {
+	v.ContactPoint.validateAll(path, issues)
+}
+
+func (v VirtualServiceDetailAddressContactPoint) FHIRType() string {
+	return "ContactPoint"
+}
+
+func (v VirtualServiceDetailAddressContactPoint) isVirtualServiceDetailAddress() {}
+
+// VirtualServiceDetailAddressExtendedContactDetail is the ExtendedContactDetail variant of VirtualServiceDetailAddress.
+type VirtualServiceDetailAddressExtendedContactDetail struct {
+	ExtendedContactDetail
+}
+
+func (v VirtualServiceDetailAddressExtendedContactDetail) Validate() error {
+	return v.ExtendedContactDetail.Validate()
+}
+
+func (v VirtualServiceDetailAddressExtendedContactDetail) validateAll(path string, issues *ValidationIssues) {
+	v.ExtendedContactDetail.validateAll(path, issues)
+}
+
+func (v VirtualServiceDetailAddressExtendedContactDetail) FHIRType() string {
+	return "ExtendedContactDetail"
+}
+
+func (v VirtualServiceDetailAddressExtendedContactDetail) isVirtualServiceDetailAddress() {}
+
+var virtualServiceDetailAddressVariants = []VirtualServiceDetailAddress{
+	VirtualServiceDetailAddressUrl(""),
+	VirtualServiceDetailAddressString(""),
+	VirtualServiceDetailAddressContactPoint{},
+	VirtualServiceDetailAddressExtendedContactDetail{},
+}
@@ -2,31 +2,46 @@ package models
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
 // An authorization for the provision of glasses and/or contact lenses to a patient.
 type VisionPrescription struct {
-	ResourceType      string                                `json:"resourceType" bson:"resource_type"`                           // Type of resource
-	Id                *string                               `json:"id,omitempty" bson:"id,omitempty"`                            // Logical id of this artifact
-	Meta              *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                        // Metadata about the resource
-	ImplicitRules     *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`     // A set of rules under which this content was created
-	Language          *string                               `json:"language,omitempty" bson:"language,omitempty"`                // Language of the resource content
-	Text              *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                        // Text summary of the resource, for human interpretation
-	Contained         []json.RawMessage                     `json:"contained,omitempty" bson:"contained,omitempty"`              // Contained, inline Resources
-	Identifier        []Identifier                          `json:"identifier,omitempty" bson:"identifier,omitempty"`            // Business Identifier for vision prescription
-	BasedOn           []Reference                           `json:"basedOn,omitempty" bson:"based_on,omitempty"`                 // What prescription fulfills
-	GroupIdentifier   *Identifier                           `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"` // Composite request identifier
-	Status            string                                `json:"status" bson:"status"`                                        // active | cancelled | draft | entered-in-error
-	Priority          *string                               `json:"priority,omitempty" bson:"priority,omitempty"`                // routine | urgent | asap | stat
-	Created           string                                `json:"created" bson:"created"`                                      // Response creation date
-	Patient           *Reference                            `json:"patient" bson:"patient"`                                      // Who prescription is for
-	Encounter         *Reference                            `json:"encounter,omitempty" bson:"encounter,omitempty"`              // Created during encounter / admission / stay
-	DateWritten       string                                `json:"dateWritten" bson:"date_written"`                             // When prescription was authorized
-	Prescriber        *Reference                            `json:"prescriber" bson:"prescriber"`                                // Who authorized the vision prescription
-	LensSpecification []VisionPrescriptionLensSpecification `json:"lensSpecification" bson:"lens_specification"`                 // Vision lens authorization
+	ResourceType         string                                `json:"resourceType" bson:"resource_type"`                                // Type of resource
+	Id                   *string                               `json:"id,omitempty" bson:"id,omitempty"`                                 // Logical id of this artifact
+	Meta                 *Meta                                 `json:"meta,omitempty" bson:"meta,omitempty"`                             // Metadata about the resource
+	ImplicitRules        *string                               `json:"implicitRules,omitempty" bson:"implicit_rules,omitempty"`          // A set of rules under which this content was created
+	ImplicitRulesElement *Element                              `json:"_implicitRules,omitempty" bson:"implicit_rules_element,omitempty"` // Extensions for implicitRules
+	Language             *string                               `json:"language,omitempty" bson:"language,omitempty"`                     // Language of the resource content
+	LanguageElement      *Element                              `json:"_language,omitempty" bson:"language_element,omitempty"`            // Extensions for language
+	Text                 *Narrative                            `json:"text,omitempty" bson:"text,omitempty"`                             // Text summary of the resource, for human interpretation
+	Contained            []Resource                            `json:"contained,omitempty" bson:"contained,omitempty"`                   // Contained, inline Resources
+	Extension            []Extension                           `json:"extension,omitempty" bson:"extension,omitempty"`                   // Additional content defined by implementations
+	ModifierExtension    []Extension                           `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"`  // Extensions that cannot be ignored
+	Identifier           []Identifier                          `json:"identifier,omitempty" bson:"identifier,omitempty"`                 // Business Identifier for vision prescription
+	BasedOn              []Reference                           `json:"basedOn,omitempty" bson:"based_on,omitempty"`                      // What prescription fulfills
+	GroupIdentifier      *Identifier                           `json:"groupIdentifier,omitempty" bson:"group_identifier,omitempty"`      // Composite request identifier
+	Status               ExplanationOfBenefitStatus            `json:"status" bson:"status"`                                             // active | cancelled | draft | entered-in-error
+	StatusElement        *Element                              `json:"_status,omitempty" bson:"status_element,omitempty"`                // Extensions for status
+	Priority             *RequestPriority                      `json:"priority,omitempty" bson:"priority,omitempty"`                     // routine | urgent | asap | stat
+	PriorityElement      *Element                              `json:"_priority,omitempty" bson:"priority_element,omitempty"`            // Extensions for priority
+	Created              *DateTime                             `json:"created" bson:"created"`                                           // Response creation date
+	CreatedElement       *Element                              `json:"_created,omitempty" bson:"created_element,omitempty"`              // Extensions for created
+	Patient              *Reference                            `json:"patient" bson:"patient"`                                           // Who prescription is for
+	Encounter            *Reference                            `json:"encounter,omitempty" bson:"encounter,omitempty"`                   // Created during encounter / admission / stay
+	DateWritten          *DateTime                             `json:"dateWritten" bson:"date_written"`                                  // When prescription was authorized
+	DateWrittenElement   *Element                              `json:"_dateWritten,omitempty" bson:"date_written_element,omitempty"`     // Extensions for dateWritten
+	Prescriber           *Reference                            `json:"prescriber" bson:"prescriber"`                                     // Who authorized the vision prescription
+	LensSpecification    []VisionPrescriptionLensSpecification `json:"lensSpecification" bson:"lens_specification"`                      // Vision lens authorization
 }
 
+var visionPrescriptionPatientTargets = []string{"Patient"}
+
+var visionPrescriptionEncounterTargets = []string{"Encounter"}
+
+var visionPrescriptionPrescriberTargets = []string{"Practitioner", "PractitionerRole"}
+
 func (r *VisionPrescription) Validate() error {
 	if r.ResourceType != "VisionPrescription" {
 		return fmt.Errorf("invalid resourceType: expected 'VisionPrescription', got '%s'", r.ResourceType)
@@ -36,11 +51,39 @@ func (r *VisionPrescription) Validate() error {
 			return fmt.Errorf("Meta: %w", err)
 		}
 	}
+	if r.ImplicitRulesElement != nil {
+		if err := r.ImplicitRulesElement.Validate(); err != nil {
+			return fmt.Errorf("ImplicitRulesElement: %w", err)
+		}
+	}
+	if r.LanguageElement != nil {
+		if err := r.LanguageElement.Validate(); err != nil {
+			return fmt.Errorf("LanguageElement: %w", err)
+		}
+	}
 	if r.Text != nil {
 		if err := r.Text.Validate(); err != nil {
 			return fmt.Errorf("Text: %w", err)
 		}
 	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Contained[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	for i, item := range r.Identifier {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Identifier[%d]: %w", i, err)
@@ -56,32 +99,82 @@ func (r *VisionPrescription) Validate() error {
 			return fmt.Errorf("GroupIdentifier: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Status == emptyString {
+	if r.Status == "" {
 		return fmt.Errorf("field 'Status' is required")
 	}
-	if r.Created == emptyString {
+	if !r.Status.IsValid() {
+		return fmt.Errorf("field 'Status' has invalid code '%s'", r.Status)
+	}
+	if r.StatusElement != nil {
+		if err := r.StatusElement.Validate(); err != nil {
+			return fmt.Errorf("StatusElement: %w", err)
+		}
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		return fmt.Errorf("field 'Priority' has invalid code '%s'", *r.Priority)
+	}
+	if r.PriorityElement != nil {
+		if err := r.PriorityElement.Validate(); err != nil {
+			return fmt.Errorf("PriorityElement: %w", err)
+		}
+	}
+	if r.Created == nil {
 		return fmt.Errorf("field 'Created' is required")
 	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			return fmt.Errorf("Created: %w", err)
+		}
+	}
+	if r.CreatedElement != nil {
+		if err := r.CreatedElement.Validate(); err != nil {
+			return fmt.Errorf("CreatedElement: %w", err)
+		}
+	}
 	if r.Patient == nil {
 		return fmt.Errorf("field 'Patient' is required")
 	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, visionPrescriptionPatientTargets); target != "" {
+			return fmt.Errorf("field 'Patient' cannot refer to %s, expected one of %v", target, visionPrescriptionPatientTargets)
+		}
+	}
 	if r.Patient != nil {
 		if err := r.Patient.Validate(); err != nil {
 			return fmt.Errorf("Patient: %w", err)
 		}
 	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, visionPrescriptionEncounterTargets); target != "" {
+			return fmt.Errorf("field 'Encounter' cannot refer to %s, expected one of %v", target, visionPrescriptionEncounterTargets)
+		}
+	}
 	if r.Encounter != nil {
 		if err := r.Encounter.Validate(); err != nil {
 			return fmt.Errorf("Encounter: %w", err)
 		}
 	}
-	if r.DateWritten == emptyString {
+	if r.DateWritten == nil {
 		return fmt.Errorf("field 'DateWritten' is required")
 	}
+	if r.DateWritten != nil {
+		if err := r.DateWritten.Validate(); err != nil {
+			return fmt.Errorf("DateWritten: %w", err)
+		}
+	}
+	if r.DateWrittenElement != nil {
+		if err := r.DateWrittenElement.Validate(); err != nil {
+			return fmt.Errorf("DateWrittenElement: %w", err)
+		}
+	}
 	if r.Prescriber == nil {
 		return fmt.Errorf("field 'Prescriber' is required")
 	}
+	if r.Prescriber != nil {
+		if target := invalidReferenceTarget(r.Prescriber.Reference, r.Prescriber.Type, visionPrescriptionPrescriberTargets); target != "" {
+			return fmt.Errorf("field 'Prescriber' cannot refer to %s, expected one of %v", target, visionPrescriptionPrescriberTargets)
+		}
+	}
 	if r.Prescriber != nil {
 		if err := r.Prescriber.Validate(); err != nil {
 			return fmt.Errorf("Prescriber: %w", err)
@@ -95,28 +188,294 @@ func (r *VisionPrescription) Validate() error {
 			return fmt.Errorf("LensSpecification[%d]: %w", i, err)
 		}
 	}
+	return validateInvariants(r, "VisionPrescription")
+}
+
+func (r *VisionPrescription) validateAll(path string, issues *ValidationIssues) {
+	if r.ResourceType != "VisionPrescription" {
+		issues.add("invalid", path+".resourceType", fmt.Sprintf("invalid resourceType: expected 'VisionPrescription', got '%s'", r.ResourceType))
+	}
+	if r.Meta != nil {
+		r.Meta.validateAll(path+".meta", issues)
+	}
+	if r.ImplicitRulesElement != nil {
+		r.ImplicitRulesElement.validateAll(path+".implicitRules", issues)
+	}
+	if r.LanguageElement != nil {
+		r.LanguageElement.validateAll(path+".language", issues)
+	}
+	if r.Text != nil {
+		r.Text.validateAll(path+".text", issues)
+	}
+	for i, item := range r.Contained {
+		if item == nil {
+			continue
+		}
+		issues.addNested(fmt.Sprintf("%s.contained[%d]", path, i), item.ValidateAll())
+	}
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	for i, item := range r.Identifier {
+		item.validateAll(fmt.Sprintf("%s.identifier[%d]", path, i), issues)
+	}
+	for i, item := range r.BasedOn {
+		item.validateAll(fmt.Sprintf("%s.basedOn[%d]", path, i), issues)
+	}
+	if r.GroupIdentifier != nil {
+		r.GroupIdentifier.validateAll(path+".groupIdentifier", issues)
+	}
+	if r.Status == "" {
+		issues.add("required", path+".status", "field 'Status' is required")
+	}
+	if r.Status != "" && !r.Status.IsValid() {
+		issues.add("code-invalid", path+".status", fmt.Sprintf("field 'Status' has invalid code '%s'", r.Status))
+	}
+	if r.StatusElement != nil {
+		r.StatusElement.validateAll(path+".status", issues)
+	}
+	if r.Priority != nil && !r.Priority.IsValid() {
+		issues.add("code-invalid", path+".priority", fmt.Sprintf("field 'Priority' has invalid code '%s'", *r.Priority))
+	}
+	if r.PriorityElement != nil {
+		r.PriorityElement.validateAll(path+".priority", issues)
+	}
+	if r.Created == nil {
+		issues.add("required", path+".created", "field 'Created' is required")
+	}
+	if r.Created != nil {
+		if err := r.Created.Validate(); err != nil {
+			issues.add("value", path+".created", err.Error())
+		}
+	}
+	if r.CreatedElement != nil {
+		r.CreatedElement.validateAll(path+".created", issues)
+	}
+	if r.Patient == nil {
+		issues.add("required", path+".patient", "field 'Patient' is required")
+	}
+	if r.Patient != nil {
+		if target := invalidReferenceTarget(r.Patient.Reference, r.Patient.Type, visionPrescriptionPatientTargets); target != "" {
+			issues.add("value", path+".patient", fmt.Sprintf("field 'Patient' cannot refer to %s, expected one of %v", target, visionPrescriptionPatientTargets))
+		}
+	}
+	if r.Patient != nil {
+		r.Patient.validateAll(path+".patient", issues)
+	}
+	if r.Encounter != nil {
+		if target := invalidReferenceTarget(r.Encounter.Reference, r.Encounter.Type, visionPrescriptionEncounterTargets); target != "" {
+			issues.add("value", path+".encounter", fmt.Sprintf("field 'Encounter' cannot refer to %s, expected one of %v", target, visionPrescriptionEncounterTargets))
+		}
+	}
+	if r.Encounter != nil {
+		r.Encounter.validateAll(path+".encounter", issues)
+	}
+	if r.DateWritten == nil {
+		issues.add("required", path+".dateWritten", "field 'DateWritten' is required")
+	}
+	if r.DateWritten != nil {
+		if err := r.DateWritten.Validate(); err != nil {
+			issues.add("value", path+".dateWritten", err.Error())
+		}
+	}
+	if r.DateWrittenElement != nil {
+		r.DateWrittenElement.validateAll(path+".dateWritten", issues)
+	}
+	if r.Prescriber == nil {
+		issues.add("required", path+".prescriber", "field 'Prescriber' is required")
+	}
+	if r.Prescriber != nil {
+		if target := invalidReferenceTarget(r.Prescriber.Reference, r.Prescriber.Type, visionPrescriptionPrescriberTargets); target != "" {
+			issues.add("value", path+".prescriber", fmt.Sprintf("field 'Prescriber' cannot refer to %s, expected one of %v", target, visionPrescriptionPrescriberTargets))
+		}
+	}
+	if r.Prescriber != nil {
+		r.Prescriber.validateAll(path+".prescriber", issues)
+	}
+	if len(r.LensSpecification) < 1 {
+		issues.add("required", path+".lensSpecification", "field 'LensSpecification' must have at least 1 elements")
+	}
+	for i, item := range r.LensSpecification {
+		item.validateAll(fmt.Sprintf("%s.lensSpecification[%d]", path, i), issues)
+	}
+}
+
+func (r *VisionPrescription) ValidateAll() ValidationIssues {
+	var issues ValidationIssues
+	r.validateAll("VisionPrescription", &issues)
+	checkInvariants(r, "VisionPrescription", &issues)
+	return issues
+}
+
+var visionPrescriptionInvariants = []invariant{
+	{key: "dom-2", severity: "error", human: "If the resource is contained in another resource, it SHALL NOT contain nested Resources", expression: "contained.contained.empty()"},
+	{key: "dom-3", severity: "error", human: "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", expression: "contained.where((('#'+id.trace('id') in %resource.descendants().select(reference | as(uri))) or descendants().where(reference='#' | as(uri)='#').exists()).not()).trace('unmatched', id).empty()"},
+	{key: "dom-4", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+	{key: "dom-5", severity: "error", human: "If a resource is contained in another resource, it SHALL NOT have a security label", expression: "contained.meta.security.empty()"},
+	{key: "dom-6", severity: "warning", human: "A resource should have narrative for robust management", expression: "text.`div`.exists()"},
+}
+
+func (r *VisionPrescription) invariants() []invariant {
+	return visionPrescriptionInvariants
+}
+
+func (r *VisionPrescription) UnmarshalJSON(data []byte) error {
+	type alias VisionPrescription
+	aux := struct {
+		*alias
+		Contained []json.RawMessage `json:"contained,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Contained = nil
+	for i, raw := range aux.Contained {
+		res, err := unmarshalOptionalResource(raw)
+		if err != nil {
+			return fmt.Errorf("contained[%d]: %w", i, err)
+		}
+		r.Contained = append(r.Contained, res)
+	}
 	return nil
 }
 
+func (r VisionPrescription) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *VisionPrescription) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r VisionPrescription) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *VisionPrescription) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var visionPrescriptionElementTypes = map[string]string{
+	"id":            "id",
+	"implicitRules": "uri",
+	"language":      "code",
+}
+
+func (r *VisionPrescription) elementTypes() map[string]string {
+	return visionPrescriptionElementTypes
+}
+
+var visionPrescriptionElementMetadata = []ElementMetadata{
+	{Path: "VisionPrescription.id", Name: "id", Field: 1, Min: 0, Max: "1", Types: []string{"id"}, Summary: true},
+	{Path: "VisionPrescription.meta", Name: "meta", Field: 2, Min: 0, Max: "1", Types: []string{"Meta"}, Summary: true},
+	{Path: "VisionPrescription.implicitRules", Name: "implicitRules", Field: 3, Min: 0, Max: "1", Types: []string{"uri"}, Summary: true, Modifier: true},
+	{Path: "VisionPrescription.language", Name: "language", Field: 5, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "VisionPrescription.language", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/all-languages", Description: "IETF language tag for a human language"}},
+	{Path: "VisionPrescription.text", Name: "text", Field: 7, Min: 0, Max: "1", Types: []string{"Narrative"}},
+	{Path: "VisionPrescription.contained", Name: "contained", Field: 8, Min: 0, Max: "*", Types: []string{"Resource"}},
+	{Path: "VisionPrescription.extension", Name: "extension", Field: 9, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "VisionPrescription.modifierExtension", Name: "modifierExtension", Field: 10, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "VisionPrescription.identifier", Name: "identifier", Field: 11, Min: 0, Max: "*", Types: []string{"Identifier"}},
+	{Path: "VisionPrescription.basedOn", Name: "basedOn", Field: 12, Min: 0, Max: "*", Types: []string{"Reference"}},
+	{Path: "VisionPrescription.groupIdentifier", Name: "groupIdentifier", Field: 13, Min: 0, Max: "1", Types: []string{"Identifier"}},
+	{Path: "VisionPrescription.status", Name: "status", Field: 14, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "VisionPrescription.status", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/explanationofbenefit-status"}},
+	{Path: "VisionPrescription.priority", Name: "priority", Field: 16, Min: 0, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "VisionPrescription.priority", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/request-priority"}},
+	{Path: "VisionPrescription.created", Name: "created", Field: 18, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "VisionPrescription.patient", Name: "patient", Field: 20, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Patient"}},
+	{Path: "VisionPrescription.encounter", Name: "encounter", Field: 21, Min: 0, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Encounter"}},
+	{Path: "VisionPrescription.dateWritten", Name: "dateWritten", Field: 22, Min: 1, Max: "1", Types: []string{"dateTime"}},
+	{Path: "VisionPrescription.prescriber", Name: "prescriber", Field: 24, Min: 1, Max: "1", Types: []string{"Reference"}, TargetProfiles: []string{"http://hl7.org/fhir/StructureDefinition/Practitioner", "http://hl7.org/fhir/StructureDefinition/PractitionerRole"}},
+	{Path: "VisionPrescription.lensSpecification", Name: "lensSpecification", Field: 25, Min: 1, Max: "*", Types: []string{"BackboneElement"}},
+}
+
+func (r *VisionPrescription) ElementMetadata() []ElementMetadata {
+	return visionPrescriptionElementMetadata
+}
+
+func (r *VisionPrescription) GetResourceType() string {
+	return "VisionPrescription"
+}
+
+func (r *VisionPrescription) GetID() string {
+	if r.Id == nil {
+		return ""
+	}
+	return *r.Id
+}
+
+func (r *VisionPrescription) SetID(id string) {
+	if id == "" {
+		r.Id = nil
+		return
+	}
+	r.Id = &id
+}
+
+func (r *VisionPrescription) GetMeta() *Meta {
+	return r.Meta
+}
+
+func (r *VisionPrescription) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+func (r *VisionPrescription) GetText() *Narrative {
+	return r.Text
+}
+
+func (r *VisionPrescription) GetContained() []Resource {
+	return r.Contained
+}
+
+func (r *VisionPrescription) GetExtension() []Extension {
+	return r.Extension
+}
+
+var _ DomainResource = (*VisionPrescription)(nil)
+
 type VisionPrescriptionLensSpecification struct {
-	Id        *string                                    `json:"id,omitempty" bson:"id,omitempty"`                // Unique id for inter-element referencing
-	Product   *CodeableConcept                           `json:"product" bson:"product"`                          // Product to be supplied
-	Eye       string                                     `json:"eye" bson:"eye"`                                  // right | left
-	Sphere    *float64                                   `json:"sphere,omitempty" bson:"sphere,omitempty"`        // Power of the lens
-	Cylinder  *float64                                   `json:"cylinder,omitempty" bson:"cylinder,omitempty"`    // Lens power for astigmatism
-	Axis      *int                                       `json:"axis,omitempty" bson:"axis,omitempty"`            // Lens meridian which contain no power for astigmatism
-	Prism     []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty" bson:"prism,omitempty"`          // Eye alignment compensation
-	Add       *float64                                   `json:"add,omitempty" bson:"add,omitempty"`              // Added power for multifocal levels
-	Power     *float64                                   `json:"power,omitempty" bson:"power,omitempty"`          // Contact lens power
-	BackCurve *float64                                   `json:"backCurve,omitempty" bson:"back_curve,omitempty"` // Contact lens back curvature
-	Diameter  *float64                                   `json:"diameter,omitempty" bson:"diameter,omitempty"`    // Contact lens diameter
-	Duration  *Quantity                                  `json:"duration,omitempty" bson:"duration,omitempty"`    // Lens wear duration
-	Color     *string                                    `json:"color,omitempty" bson:"color,omitempty"`          // Color required
-	Brand     *string                                    `json:"brand,omitempty" bson:"brand,omitempty"`          // Brand required
-	Note      []Annotation                               `json:"note,omitempty" bson:"note,omitempty"`            // Notes for coatings
+	Id                *string                                    `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension                                `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension                                `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Product           *CodeableConcept                           `json:"product" bson:"product"`                                          // Product to be supplied
+	Eye               VisionEyes                                 `json:"eye" bson:"eye"`                                                  // right | left
+	EyeElement        *Element                                   `json:"_eye,omitempty" bson:"eye_element,omitempty"`                     // Extensions for eye
+	Sphere            *Decimal                                   `json:"sphere,omitempty" bson:"sphere,omitempty"`                        // Power of the lens
+	SphereElement     *Element                                   `json:"_sphere,omitempty" bson:"sphere_element,omitempty"`               // Extensions for sphere
+	Cylinder          *Decimal                                   `json:"cylinder,omitempty" bson:"cylinder,omitempty"`                    // Lens power for astigmatism
+	CylinderElement   *Element                                   `json:"_cylinder,omitempty" bson:"cylinder_element,omitempty"`           // Extensions for cylinder
+	Axis              *int                                       `json:"axis,omitempty" bson:"axis,omitempty"`                            // Lens meridian which contain no power for astigmatism
+	AxisElement       *Element                                   `json:"_axis,omitempty" bson:"axis_element,omitempty"`                   // Extensions for axis
+	Prism             []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty" bson:"prism,omitempty"`                          // Eye alignment compensation
+	Add               *Decimal                                   `json:"add,omitempty" bson:"add,omitempty"`                              // Added power for multifocal levels
+	AddElement        *Element                                   `json:"_add,omitempty" bson:"add_element,omitempty"`                     // Extensions for add
+	Power             *Decimal                                   `json:"power,omitempty" bson:"power,omitempty"`                          // Contact lens power
+	PowerElement      *Element                                   `json:"_power,omitempty" bson:"power_element,omitempty"`                 // Extensions for power
+	BackCurve         *Decimal                                   `json:"backCurve,omitempty" bson:"back_curve,omitempty"`                 // Contact lens back curvature
+	BackCurveElement  *Element                                   `json:"_backCurve,omitempty" bson:"back_curve_element,omitempty"`        // Extensions for backCurve
+	Diameter          *Decimal                                   `json:"diameter,omitempty" bson:"diameter,omitempty"`                    // Contact lens diameter
+	DiameterElement   *Element                                   `json:"_diameter,omitempty" bson:"diameter_element,omitempty"`           // Extensions for diameter
+	Duration          *Quantity                                  `json:"duration,omitempty" bson:"duration,omitempty"`                    // Lens wear duration
+	Color             *string                                    `json:"color,omitempty" bson:"color,omitempty"`                          // Color required
+	ColorElement      *Element                                   `json:"_color,omitempty" bson:"color_element,omitempty"`                 // Extensions for color
+	Brand             *string                                    `json:"brand,omitempty" bson:"brand,omitempty"`                          // Brand required
+	BrandElement      *Element                                   `json:"_brand,omitempty" bson:"brand_element,omitempty"`                 // Extensions for brand
+	Note              []Annotation                               `json:"note,omitempty" bson:"note,omitempty"`                            // Notes for coatings
 }
 
 func (r *VisionPrescriptionLensSpecification) Validate() error {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
 	if r.Product == nil {
 		return fmt.Errorf("field 'Product' is required")
 	}
@@ -125,20 +484,102 @@ func (r *VisionPrescriptionLensSpecification) Validate() error {
 			return fmt.Errorf("Product: %w", err)
 		}
 	}
-	var emptyString string
-	if r.Eye == emptyString {
+	if r.Eye == "" {
 		return fmt.Errorf("field 'Eye' is required")
 	}
+	if !r.Eye.IsValid() {
+		return fmt.Errorf("field 'Eye' has invalid code '%s'", r.Eye)
+	}
+	if r.EyeElement != nil {
+		if err := r.EyeElement.Validate(); err != nil {
+			return fmt.Errorf("EyeElement: %w", err)
+		}
+	}
+	if r.Sphere != nil {
+		if err := r.Sphere.Validate(); err != nil {
+			return fmt.Errorf("Sphere: %w", err)
+		}
+	}
+	if r.SphereElement != nil {
+		if err := r.SphereElement.Validate(); err != nil {
+			return fmt.Errorf("SphereElement: %w", err)
+		}
+	}
+	if r.Cylinder != nil {
+		if err := r.Cylinder.Validate(); err != nil {
+			return fmt.Errorf("Cylinder: %w", err)
+		}
+	}
+	if r.CylinderElement != nil {
+		if err := r.CylinderElement.Validate(); err != nil {
+			return fmt.Errorf("CylinderElement: %w", err)
+		}
+	}
+	if r.AxisElement != nil {
+		if err := r.AxisElement.Validate(); err != nil {
+			return fmt.Errorf("AxisElement: %w", err)
+		}
+	}
 	for i, item := range r.Prism {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Prism[%d]: %w", i, err)
 		}
 	}
+	if r.Add != nil {
+		if err := r.Add.Validate(); err != nil {
+			return fmt.Errorf("Add: %w", err)
+		}
+	}
+	if r.AddElement != nil {
+		if err := r.AddElement.Validate(); err != nil {
+			return fmt.Errorf("AddElement: %w", err)
+		}
+	}
+	if r.Power != nil {
+		if err := r.Power.Validate(); err != nil {
+			return fmt.Errorf("Power: %w", err)
+		}
+	}
+	if r.PowerElement != nil {
+		if err := r.PowerElement.Validate(); err != nil {
+			return fmt.Errorf("PowerElement: %w", err)
+		}
+	}
+	if r.BackCurve != nil {
+		if err := r.BackCurve.Validate(); err != nil {
+			return fmt.Errorf("BackCurve: %w", err)
+		}
+	}
+	if r.BackCurveElement != nil {
+		if err := r.BackCurveElement.Validate(); err != nil {
+			return fmt.Errorf("BackCurveElement: %w", err)
+		}
+	}
+	if r.Diameter != nil {
+		if err := r.Diameter.Validate(); err != nil {
+			return fmt.Errorf("Diameter: %w", err)
+		}
+	}
+	if r.DiameterElement != nil {
+		if err := r.DiameterElement.Validate(); err != nil {
+			return fmt.Errorf("DiameterElement: %w", err)
+		}
+	}
 	if r.Duration != nil {
 		if err := r.Duration.Validate(); err != nil {
 			return fmt.Errorf("Duration: %w", err)
 		}
 	}
+	if r.ColorElement != nil {
+		if err := r.ColorElement.Validate(); err != nil {
+			return fmt.Errorf("ColorElement: %w", err)
+		}
+	}
+	if r.BrandElement != nil {
+		if err := r.BrandElement.Validate(); err != nil {
+			return fmt.Errorf("BrandElement: %w", err)
+		}
+	}
 	for i, item := range r.Note {
 		if err := item.Validate(); err != nil {
 			return fmt.Errorf("Note[%d]: %w", i, err)
@@ -147,19 +588,253 @@ func (r *VisionPrescriptionLensSpecification) Validate() error {
 	return nil
 }
 
+func (r *VisionPrescriptionLensSpecification) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Product == nil {
+		issues.add("required", path+".product", "field 'Product' is required")
+	}
+	if r.Product != nil {
+		r.Product.validateAll(path+".product", issues)
+	}
+	if r.Eye == "" {
+		issues.add("required", path+".eye", "field 'Eye' is required")
+	}
+	if r.Eye != "" && !r.Eye.IsValid() {
+		issues.add("code-invalid", path+".eye", fmt.Sprintf("field 'Eye' has invalid code '%s'", r.Eye))
+	}
+	if r.EyeElement != nil {
+		r.EyeElement.validateAll(path+".eye", issues)
+	}
+	if r.Sphere != nil {
+		if err := r.Sphere.Validate(); err != nil {
+			issues.add("value", path+".sphere", err.Error())
+		}
+	}
+	if r.SphereElement != nil {
+		r.SphereElement.validateAll(path+".sphere", issues)
+	}
+	if r.Cylinder != nil {
+		if err := r.Cylinder.Validate(); err != nil {
+			issues.add("value", path+".cylinder", err.Error())
+		}
+	}
+	if r.CylinderElement != nil {
+		r.CylinderElement.validateAll(path+".cylinder", issues)
+	}
+	if r.AxisElement != nil {
+		r.AxisElement.validateAll(path+".axis", issues)
+	}
+	for i, item := range r.Prism {
+		item.validateAll(fmt.Sprintf("%s.prism[%d]", path, i), issues)
+	}
+	if r.Add != nil {
+		if err := r.Add.Validate(); err != nil {
+			issues.add("value", path+".add", err.Error())
+		}
+	}
+	if r.AddElement != nil {
+		r.AddElement.validateAll(path+".add", issues)
+	}
+	if r.Power != nil {
+		if err := r.Power.Validate(); err != nil {
+			issues.add("value", path+".power", err.Error())
+		}
+	}
+	if r.PowerElement != nil {
+		r.PowerElement.validateAll(path+".power", issues)
+	}
+	if r.BackCurve != nil {
+		if err := r.BackCurve.Validate(); err != nil {
+			issues.add("value", path+".backCurve", err.Error())
+		}
+	}
+	if r.BackCurveElement != nil {
+		r.BackCurveElement.validateAll(path+".backCurve", issues)
+	}
+	if r.Diameter != nil {
+		if err := r.Diameter.Validate(); err != nil {
+			issues.add("value", path+".diameter", err.Error())
+		}
+	}
+	if r.DiameterElement != nil {
+		r.DiameterElement.validateAll(path+".diameter", issues)
+	}
+	if r.Duration != nil {
+		r.Duration.validateAll(path+".duration", issues)
+	}
+	if r.ColorElement != nil {
+		r.ColorElement.validateAll(path+".color", issues)
+	}
+	if r.BrandElement != nil {
+		r.BrandElement.validateAll(path+".brand", issues)
+	}
+	for i, item := range r.Note {
+		item.validateAll(fmt.Sprintf("%s.note[%d]", path, i), issues)
+	}
+}
+
+var visionPrescriptionLensSpecificationInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *VisionPrescriptionLensSpecification) invariants() []invariant {
+	return visionPrescriptionLensSpecificationInvariants
+}
+
+func (r VisionPrescriptionLensSpecification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *VisionPrescriptionLensSpecification) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r VisionPrescriptionLensSpecification) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *VisionPrescriptionLensSpecification) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var visionPrescriptionLensSpecificationElementMetadata = []ElementMetadata{
+	{Path: "VisionPrescription.lensSpecification.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "VisionPrescription.lensSpecification.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "VisionPrescription.lensSpecification.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "VisionPrescription.lensSpecification.product", Name: "product", Field: 3, Min: 1, Max: "1", Types: []string{"CodeableConcept"}},
+	{Path: "VisionPrescription.lensSpecification.eye", Name: "eye", Field: 4, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "VisionPrescription.lensSpecification.eye", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/vision-eye-codes"}},
+	{Path: "VisionPrescription.lensSpecification.sphere", Name: "sphere", Field: 6, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.cylinder", Name: "cylinder", Field: 8, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.axis", Name: "axis", Field: 10, Min: 0, Max: "1", Types: []string{"integer"}},
+	{Path: "VisionPrescription.lensSpecification.prism", Name: "prism", Field: 12, Min: 0, Max: "*", Types: []string{"BackboneElement"}},
+	{Path: "VisionPrescription.lensSpecification.add", Name: "add", Field: 13, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.power", Name: "power", Field: 15, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.backCurve", Name: "backCurve", Field: 17, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.diameter", Name: "diameter", Field: 19, Min: 0, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.duration", Name: "duration", Field: 21, Min: 0, Max: "1", Types: []string{"Quantity"}},
+	{Path: "VisionPrescription.lensSpecification.color", Name: "color", Field: 22, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "VisionPrescription.lensSpecification.brand", Name: "brand", Field: 24, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "VisionPrescription.lensSpecification.note", Name: "note", Field: 26, Min: 0, Max: "*", Types: []string{"Annotation"}},
+}
+
+func (r *VisionPrescriptionLensSpecification) ElementMetadata() []ElementMetadata {
+	return visionPrescriptionLensSpecificationElementMetadata
+}
+
 type VisionPrescriptionLensSpecificationPrism struct {
-	Id     *string `json:"id,omitempty" bson:"id,omitempty"` // Unique id for inter-element referencing
-	Amount float64 `json:"amount" bson:"amount"`             // Amount of adjustment
-	Base   string  `json:"base" bson:"base"`                 // up | down | in | out
+	Id                *string     `json:"id,omitempty" bson:"id,omitempty"`                                // Unique id for inter-element referencing
+	Extension         []Extension `json:"extension,omitempty" bson:"extension,omitempty"`                  // Additional content defined by implementations
+	ModifierExtension []Extension `json:"modifierExtension,omitempty" bson:"modifier_extension,omitempty"` // Extensions that cannot be ignored even if unrecognized
+	Amount            *Decimal    `json:"amount" bson:"amount"`                                            // Amount of adjustment
+	AmountElement     *Element    `json:"_amount,omitempty" bson:"amount_element,omitempty"`               // Extensions for amount
+	Base              VisionBase  `json:"base" bson:"base"`                                                // up | down | in | out
+	BaseElement       *Element    `json:"_base,omitempty" bson:"base_element,omitempty"`                   // Extensions for base
 }
 
 func (r *VisionPrescriptionLensSpecificationPrism) Validate() error {
-	if r.Amount == 0 {
+	for i, item := range r.Extension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("Extension[%d]: %w", i, err)
+		}
+	}
+	for i, item := range r.ModifierExtension {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("ModifierExtension[%d]: %w", i, err)
+		}
+	}
+	if r.Amount == nil {
 		return fmt.Errorf("field 'Amount' is required")
 	}
-	var emptyString string
-	if r.Base == emptyString {
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			return fmt.Errorf("Amount: %w", err)
+		}
+	}
+	if r.AmountElement != nil {
+		if err := r.AmountElement.Validate(); err != nil {
+			return fmt.Errorf("AmountElement: %w", err)
+		}
+	}
+	if r.Base == "" {
 		return fmt.Errorf("field 'Base' is required")
 	}
+	if !r.Base.IsValid() {
+		return fmt.Errorf("field 'Base' has invalid code '%s'", r.Base)
+	}
+	if r.BaseElement != nil {
+		if err := r.BaseElement.Validate(); err != nil {
+			return fmt.Errorf("BaseElement: %w", err)
+		}
+	}
 	return nil
 }
+
+func (r *VisionPrescriptionLensSpecificationPrism) validateAll(path string, issues *ValidationIssues) {
+	for i, item := range r.Extension {
+		item.validateAll(fmt.Sprintf("%s.extension[%d]", path, i), issues)
+	}
+	for i, item := range r.ModifierExtension {
+		item.validateAll(fmt.Sprintf("%s.modifierExtension[%d]", path, i), issues)
+	}
+	if r.Amount == nil {
+		issues.add("required", path+".amount", "field 'Amount' is required")
+	}
+	if r.Amount != nil {
+		if err := r.Amount.Validate(); err != nil {
+			issues.add("value", path+".amount", err.Error())
+		}
+	}
+	if r.AmountElement != nil {
+		r.AmountElement.validateAll(path+".amount", issues)
+	}
+	if r.Base == "" {
+		issues.add("required", path+".base", "field 'Base' is required")
+	}
+	if r.Base != "" && !r.Base.IsValid() {
+		issues.add("code-invalid", path+".base", fmt.Sprintf("field 'Base' has invalid code '%s'", r.Base))
+	}
+	if r.BaseElement != nil {
+		r.BaseElement.validateAll(path+".base", issues)
+	}
+}
+
+var visionPrescriptionLensSpecificationPrismInvariants = []invariant{
+	{key: "ele-1", severity: "error", human: "All FHIR elements must have a @value or children", expression: "hasValue() or (children().count() > id.count())"},
+}
+
+func (r *VisionPrescriptionLensSpecificationPrism) invariants() []invariant {
+	return visionPrescriptionLensSpecificationPrismInvariants
+}
+
+func (r VisionPrescriptionLensSpecificationPrism) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, &r)
+}
+
+func (r *VisionPrescriptionLensSpecificationPrism) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, r)
+}
+
+func (r VisionPrescriptionLensSpecificationPrism) MarshalBSON() ([]byte, error) {
+	return marshalBSON(&r)
+}
+
+func (r *VisionPrescriptionLensSpecificationPrism) UnmarshalBSON(data []byte) error {
+	return unmarshalBSON(data, r)
+}
+
+var visionPrescriptionLensSpecificationPrismElementMetadata = []ElementMetadata{
+	{Path: "VisionPrescription.lensSpecification.prism.id", Name: "id", Field: 0, Min: 0, Max: "1", Types: []string{"string"}},
+	{Path: "VisionPrescription.lensSpecification.prism.extension", Name: "extension", Field: 1, Min: 0, Max: "*", Types: []string{"Extension"}},
+	{Path: "VisionPrescription.lensSpecification.prism.modifierExtension", Name: "modifierExtension", Field: 2, Min: 0, Max: "*", Types: []string{"Extension"}, Summary: true, Modifier: true},
+	{Path: "VisionPrescription.lensSpecification.prism.amount", Name: "amount", Field: 3, Min: 1, Max: "1", Types: []string{"decimal"}},
+	{Path: "VisionPrescription.lensSpecification.prism.base", Name: "base", Field: 5, Min: 1, Max: "1", Types: []string{"code"}, Binding: &BindingDefinition{Path: "VisionPrescription.lensSpecification.prism.base", Strength: "required", ValueSet: "http://hl7.org/fhir/ValueSet/vision-base-codes"}},
+}
+
+func (r *VisionPrescriptionLensSpecificationPrism) ElementMetadata() []ElementMetadata {
+	return visionPrescriptionLensSpecificationPrismElementMetadata
+}
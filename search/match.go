@@ -0,0 +1,450 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/gruzdev-dev/fhir/fhirpath"
+	models "github.com/gruzdev-dev/fhir/r5"
+	"github.com/gruzdev-dev/fhir/terminology"
+)
+
+// Source provides the resources chained parameters follow references to
+// and reverse chained parameters search, such as a bundle or a database.
+type Source interface {
+	fhirpath.Resolver
+	// Resources returns the resources of a type that reverse chains are
+	// evaluated on.
+	Resources(resourceType string) ([]models.Resource, error)
+}
+
+// BundleSource returns a Source over the entries of a bundle, resolving
+// references as fhirpath.BundleResolver does.
+func BundleSource(bundle *models.Bundle) Source {
+	return bundleSource{Resolver: fhirpath.BundleResolver(bundle), bundle: bundle}
+}
+
+type bundleSource struct {
+	fhirpath.Resolver
+	bundle *models.Bundle
+}
+
+func (s bundleSource) Resources(resourceType string) ([]models.Resource, error) {
+	var resources []models.Resource
+	for _, entry := range s.bundle.Entry {
+		if entry.Resource != nil && entry.Resource.GetResourceType() == resourceType {
+			resources = append(resources, entry.Resource)
+		}
+	}
+	return resources, nil
+}
+
+// Option configures Match.
+type Option func(*matcher)
+
+// WithSource sets the source chained and reverse chained parameters are
+// evaluated with. Matching such parameters without a source is an error.
+func WithSource(source Source) Option {
+	return func(m *matcher) { m.source = source }
+}
+
+// WithNow sets the time the ap prefix of dates approximates relative to,
+// which defaults to the current time.
+func WithNow(now time.Time) Option {
+	return func(m *matcher) { m.now = now }
+}
+
+// WithTerminology sets the terminology service the :in and :not-in
+// modifiers of token parameters check codes against value sets with.
+// Matching them without a service is an error.
+func WithTerminology(svc terminology.TerminologyService) Option {
+	return func(m *matcher) { m.terminology = svc }
+}
+
+type matcher struct {
+	source      Source
+	terminology terminology.TerminologyService
+	now         time.Time
+}
+
+// Match reports whether res is of the query's resource type and its _type
+// types, and matches all of its parameters. Parameters that need a
+// subsumption-aware terminology server or a full-text index, such as
+// :above on tokens, :text-advanced, _text and _filter, are reported as
+// errors.
+func (q *Query) Match(res models.Resource, opts ...Option) (bool, error) {
+	m := &matcher{now: time.Now()}
+	for _, opt := range opts {
+		opt(m)
+	}
+	resourceType := res.GetResourceType()
+	if (q.ResourceType != "" && resourceType != q.ResourceType) || (len(q.Types) > 0 && !contains(q.Types, resourceType)) {
+		return false, nil
+	}
+	for _, p := range q.Parameters {
+		ok, err := m.match(res, p)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (m *matcher) match(res models.Resource, p *Parameter) (bool, error) {
+	if p.Has != nil {
+		return m.matchReverseChain(res, p.Has)
+	}
+	if p.Definition.Type == "special" || p.Definition.Expression == "" {
+		return false, fmt.Errorf("parameter %s: matching %s parameters is not supported", p.Name, p.Definition.Type)
+	}
+	values, err := ExtractParameter(res, p.Definition)
+	if err != nil {
+		return false, err
+	}
+	if p.Modifier == "missing" {
+		return (len(values) == 0) == (p.Values[0].Raw == "true"), nil
+	}
+	if p.Chain != nil {
+		return m.matchChain(res, p, values)
+	}
+
+	matched := false
+	for _, want := range p.Values {
+		for _, v := range values {
+			ok, err := m.matchValue(p.Definition, p.Modifier, p.TargetType, want, v)
+			if err != nil {
+				return false, fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			if ok {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			break
+		}
+	}
+	if p.Modifier == "not" || p.Modifier == "not-in" {
+		return !matched, nil
+	}
+	return matched, nil
+}
+
+// matchChain reports whether a resource res refers to through the
+// parameter matches the chained parameter.
+func (m *matcher) matchChain(res models.Resource, p *Parameter, values []Value) (bool, error) {
+	for _, v := range values {
+		ref, ok := v.(Reference)
+		if !ok || ref.Reference == "" || (p.TargetType != "" && ref.Type != "" && ref.Type != p.TargetType) {
+			continue
+		}
+		target, err := m.resolve(res, ref.Reference)
+		if err != nil {
+			return false, fmt.Errorf("parameter %s: %w", p.Name, err)
+		}
+		if target == nil || (p.TargetType != "" && target.GetResourceType() != p.TargetType) {
+			continue
+		}
+		ok, err = m.match(target, p.Chain)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// resolve resolves a reference of res, looking up references to contained
+// resources in res itself.
+func (m *matcher) resolve(res models.Resource, reference string) (models.Resource, error) {
+	if id, ok := strings.CutPrefix(reference, "#"); ok {
+		if dr, ok := res.(models.DomainResource); ok {
+			for _, contained := range dr.GetContained() {
+				if contained != nil && contained.GetID() == id {
+					return contained, nil
+				}
+			}
+		}
+		return nil, nil
+	}
+	if m.source == nil {
+		return nil, fmt.Errorf("resolving %s needs a source", reference)
+	}
+	return m.source.Resolve(reference)
+}
+
+// matchReverseChain reports whether a resource of the chain's type refers
+// to res and matches the chain's parameter.
+func (m *matcher) matchReverseChain(res models.Resource, has *ReverseChain) (bool, error) {
+	if m.source == nil {
+		return false, fmt.Errorf("parameter _has:%s:%s needs a source", has.ResourceType, has.Reference.Code)
+	}
+	candidates, err := m.source.Resources(has.ResourceType)
+	if err != nil {
+		return false, err
+	}
+	id := res.GetID()
+	for _, candidate := range candidates {
+		refs, err := ExtractParameter(candidate, has.Reference)
+		if err != nil {
+			return false, err
+		}
+		refers := false
+		for _, v := range refs {
+			if ref, ok := v.(Reference); ok && ref.Type == res.GetResourceType() && ref.ID == id {
+				refers = true
+				break
+			}
+		}
+		if !refers {
+			continue
+		}
+		ok, err := m.match(candidate, has.Parameter)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func (m *matcher) matchValue(def *models.SearchParameterDefinition, modifier, targetType string, want ParameterValue, v Value) (bool, error) {
+	paramType := def.Type
+	switch modifier {
+	case "of-type", "text-advanced":
+		return false, fmt.Errorf("modifier :%s is not supported", modifier)
+	case "above", "below":
+		if paramType != "uri" {
+			return false, fmt.Errorf("modifier :%s is not supported on %s parameters", modifier, paramType)
+		}
+	case "text":
+		if paramType != "token" {
+			return false, fmt.Errorf("modifier :text is not supported on %s parameters", paramType)
+		}
+	}
+
+	switch paramType {
+	case "token":
+		t, ok := v.(Token)
+		if !ok {
+			return false, nil
+		}
+		switch modifier {
+		case "in", "not-in":
+			return m.inValueSet(want.Raw, t)
+		case "text":
+			return strings.HasPrefix(fold(t.Display), fold(want.Raw)), nil
+		case "code-text":
+			return strings.HasPrefix(fold(t.Code), fold(want.Raw)), nil
+		}
+		return matchToken(want, t), nil
+	case "string":
+		s, ok := v.(String)
+		if !ok {
+			return false, nil
+		}
+		switch modifier {
+		case "exact":
+			return string(s) == want.Raw, nil
+		case "contains":
+			return strings.Contains(fold(string(s)), fold(want.Raw)), nil
+		}
+		return strings.HasPrefix(fold(string(s)), fold(want.Raw)), nil
+	case "uri":
+		u, ok := v.(URI)
+		if !ok {
+			return false, nil
+		}
+		switch modifier {
+		case "below":
+			return strings.HasPrefix(string(u), want.Raw), nil
+		case "above":
+			return strings.HasPrefix(want.Raw, string(u)), nil
+		case "contains":
+			return strings.Contains(string(u), want.Raw), nil
+		}
+		return string(u) == want.Raw, nil
+	case "reference":
+		ref, ok := v.(Reference)
+		if !ok {
+			return false, nil
+		}
+		switch modifier {
+		case "identifier":
+			return ref.Identifier != nil && matchToken(want, *ref.Identifier), nil
+		case "code-text":
+			return false, fmt.Errorf("modifier :code-text is not supported on reference parameters")
+		}
+		if targetType != "" && ref.Type != targetType {
+			return false, nil
+		}
+		return matchReference(want.Reference, ref), nil
+	case "date":
+		r, ok := v.(DateRange)
+		return ok && m.matchDate(want.Prefix, want.Range, r), nil
+	case "number":
+		n, ok := v.(Number)
+		return ok && matchNumber(want.Prefix, want.Number, n.Value), nil
+	case "quantity":
+		q, ok := v.(Quantity)
+		if !ok || !matchNumber(want.Prefix, want.Number, q.Value) {
+			return false, nil
+		}
+		if want.Code == "" {
+			return true, nil
+		}
+		if want.System != "" {
+			return q.System == want.System && q.Code == want.Code, nil
+		}
+		return q.Code == want.Code || q.Unit == want.Code, nil
+	case "composite":
+		c, ok := v.(Composite)
+		if !ok || len(c.Components) != len(want.Components) {
+			return false, nil
+		}
+		for i, component := range want.Components {
+			componentDef, ok := models.SearchParameterByURL(def.Components[i].Definition)
+			if !ok {
+				return false, fmt.Errorf("unknown component %s", def.Components[i].Definition)
+			}
+			matched := false
+			for _, cv := range c.Components[i] {
+				ok, err := m.matchValue(componentDef, "", "", component, cv)
+				if err != nil {
+					return false, err
+				}
+				if ok {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("matching %s parameters is not supported", paramType)
+}
+
+// matchToken matches code, system|code, |code and system|.
+func matchToken(want ParameterValue, t Token) bool {
+	if !want.HasSystem {
+		return t.Code == want.Code
+	}
+	if want.System == "" {
+		return t.System == "" && t.Code == want.Code
+	}
+	return t.System == want.System && (want.Code == "" || t.Code == want.Code)
+}
+
+func matchReference(want, ref Reference) bool {
+	switch {
+	case want.ID != "":
+		return ref.ID == want.ID && (want.Type == "" || ref.Type == want.Type) &&
+			(want.Version == "" || ref.Version == want.Version)
+	case want.URL != "":
+		return (ref.URL == want.URL || ref.Reference == want.URL) && (want.Version == "" || ref.Version == want.Version)
+	}
+	return ref.Reference == want.Reference
+}
+
+// matchDate compares the range of an index value with the range of a
+// search value by the prefix. A zero Start or End of the index value is
+// unbounded on that side.
+func (m *matcher) matchDate(prefix Prefix, want, r DateRange) bool {
+	startsBefore := func(t time.Time) bool { return r.Start.IsZero() || r.Start.Before(t) }
+	endsAfter := func(t time.Time) bool { return r.End.IsZero() || r.End.After(t) }
+	switch prefix {
+	case PrefixNe:
+		return !m.matchDate(PrefixEq, want, r)
+	case PrefixGt:
+		return endsAfter(want.End)
+	case PrefixLt:
+		return startsBefore(want.Start)
+	case PrefixGe:
+		return endsAfter(want.Start)
+	case PrefixLe:
+		return startsBefore(want.End)
+	case PrefixSa:
+		return !r.Start.IsZero() && !r.Start.Before(want.End)
+	case PrefixEb:
+		return !r.End.IsZero() && !r.End.After(want.Start)
+	case PrefixAp:
+		// within a tenth of the time between the value and now
+		margin := m.now.Sub(want.Start)
+		if margin < 0 {
+			margin = -margin
+		}
+		margin /= 10
+		return startsBefore(want.End.Add(margin)) && endsAfter(want.Start.Add(-margin))
+	}
+	return !startsBefore(want.Start) && !endsAfter(want.End)
+}
+
+// matchNumber compares an index value with a search value by the prefix.
+// Equality allows for the precision of the search value: 100 matches
+// values from 99.5 up to 100.5.
+func matchNumber(prefix Prefix, want, n models.Decimal) bool {
+	cmp := n.Cmp(want)
+	switch prefix {
+	case PrefixNe:
+		return !matchNumber(PrefixEq, want, n)
+	case PrefixGt, PrefixSa:
+		return cmp > 0
+	case PrefixLt, PrefixEb:
+		return cmp < 0
+	case PrefixGe:
+		return cmp >= 0
+	case PrefixLe:
+		return cmp <= 0
+	case PrefixAp:
+		diff := new(big.Rat).Sub(n.Rat(), want.Rat())
+		margin := new(big.Rat).Mul(want.Rat(), big.NewRat(1, 10))
+		return diff.Abs(diff).Cmp(margin.Abs(margin)) <= 0
+	}
+	half := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(want.Precision())), nil))
+	half.Mul(half, big.NewRat(1, 2))
+	low := new(big.Rat).Sub(want.Rat(), half)
+	high := new(big.Rat).Add(want.Rat(), half)
+	return n.Rat().Cmp(low) >= 0 && n.Rat().Cmp(high) < 0
+}
+
+// foldReplacer removes the accents of Latin letters, which string search
+// ignores.
+var foldReplacer = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"ç", "c", "è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ý", "y", "ÿ", "y",
+)
+
+// fold returns s without case and accents, for string comparison.
+func fold(s string) string {
+	return foldReplacer.Replace(strings.ToLower(s))
+}
+
+// inValueSet reports whether a token is in a value set. A code of a code
+// system the service does not know is not in the value set.
+func (m *matcher) inValueSet(valueSet string, t Token) (bool, error) {
+	if m.terminology == nil {
+		return false, fmt.Errorf("checking value set %s needs a terminology service", valueSet)
+	}
+	if t.Code == "" {
+		return false, nil
+	}
+	coding := models.Coding{Code: &t.Code}
+	if t.System != "" {
+		coding.System = &t.System
+	}
+	result, err := m.terminology.ValidateCode(valueSet, coding)
+	if errors.Is(err, terminology.ErrUnknownCodeSystem) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return result.Valid, nil
+}
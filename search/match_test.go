@@ -0,0 +1,225 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+	"github.com/gruzdev-dev/fhir/terminology"
+)
+
+func matchTestBundle(t *testing.T) *models.Bundle {
+	id, name := "1", "Gastroenterology"
+	organization := &models.Organization{Id: &id, Name: &name}
+	return &models.Bundle{Entry: []models.BundleEntry{
+		{Resource: loadResource(t, "patient-example.json")},
+		{Resource: loadResource(t, "observation-bp.json")},
+		{Resource: organization},
+	}}
+}
+
+func TestMatch(t *testing.T) {
+	bundle := matchTestBundle(t)
+	patient, observation := bundle.Entry[0].Resource, bundle.Entry[1].Resource
+	now := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		res   models.Resource
+		query string
+		want  bool
+	}{
+		{patient, "", true},
+		{patient, "_id=example", true},
+		{patient, "family=chal", true},
+		{patient, "family=halm", false},
+		{patient, "family:contains=halm", true},
+		{patient, "family:exact=chalmers", false},
+		{patient, "family:exact=Chalmers", true},
+		{patient, "given=jim&family=windsor", true},
+		{patient, "given=jim&family=smith", false},
+		{patient, "family=smith,windsor", true},
+		{patient, "gender=female", false},
+		{patient, "gender:not=female", true},
+		{patient, "gender:not=female,male", false},
+		{patient, "identifier=urn:oid:1.2.36.146.595.217.0.1|12345", true},
+		{patient, "identifier=urn:oid:1.2.36.146.595.217.0.1|", true},
+		{patient, "identifier=|12345", false},
+		{patient, "active=true", true},
+		{patient, "birthdate=1974", true},
+		{patient, "birthdate=1974-12-24", false},
+		{patient, "birthdate=lt1975&birthdate=gt1974-06", true},
+		{patient, "birthdate=sa1974-12-25", false},
+		{patient, "birthdate=eb1975", true},
+		{patient, "birthdate=ne1974-12", false},
+		{patient, "deceased:missing=true", false},
+		{patient, "email:missing=true", true},
+		{patient, "organization=Organization/1", true},
+		{patient, "organization=1", true},
+		{patient, "organization=2", false},
+		{patient, "organization.name=gastro", true},
+		{patient, "organization.name=cardio", false},
+		{patient, "_has:Observation:subject:code=http://loinc.org|85354-9", true},
+		{patient, "_has:Observation:subject:code=http://loinc.org|29463-7", false},
+		{observation, "code=http://loinc.org|85354-9", true},
+		{observation, "code:text=blood pressure", true},
+		{observation, "code:code-text=8535", true},
+		{observation, "combo-code=8480-6", true},
+		{observation, "subject=Patient/example&status=final", true},
+		{observation, "subject:Patient=example", true},
+		{observation, "subject:Group=example", false},
+		{observation, "subject:Patient.gender=male", true},
+		{observation, "patient.birthdate=lt1970", false},
+		{observation, "encounter=Encounter/visit-1", true},
+		{observation, "performer:identifier=urn:oid:2.16.840.1.113883.4.6|1234567893", true},
+		{observation, "date=ge2012-09-17", true},
+		{observation, "date=eq2012-09-17", false},
+		{observation, "date=gt2024", true},
+		{observation, "date=lt2012-09-17", false},
+		{observation, "date=ap2013", true},
+		{observation, "component-value-quantity=107", true},
+		{observation, "component-value-quantity=107.0", true},
+		{observation, "component-value-quantity=107.6", false},
+		{observation, "component-value-quantity=gt100|http://unitsofmeasure.org|mm[Hg]", true},
+		{observation, "component-value-quantity=gt100|http://unitsofmeasure.org|kPa", false},
+		{observation, "component-value-quantity=ap100", true},
+		{observation, "component-value-quantity=le59", false},
+		{observation, "component-code-value-quantity=http://loinc.org|8480-6$gt100", true},
+		{observation, "component-code-value-quantity=http://loinc.org|8462-4$gt100", false},
+		{observation, "_id=example", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.res.GetResourceType()+"?"+tt.query, func(t *testing.T) {
+			q, err := Parse(tt.res.GetResourceType(), tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := q.Match(tt.res, WithSource(BundleSource(bundle)), WithNow(now))
+			if err != nil {
+				t.Fatalf("Match() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatch_ResourceType(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+	if ok, err := MustParse("Observation", "").Match(patient); err != nil || ok {
+		t.Errorf("Match() of a Patient against an Observation query = %v, %v, want false", ok, err)
+	}
+}
+
+func TestMatch_Types(t *testing.T) {
+	bundle := matchTestBundle(t)
+	patient, observation, organization := bundle.Entry[0].Resource, bundle.Entry[1].Resource, bundle.Entry[2].Resource
+
+	tests := []struct {
+		res   models.Resource
+		query string
+		want  bool
+	}{
+		{patient, "_id=example", true},
+		{organization, "_id=1", true},
+		{patient, "_type=Patient,Observation", true},
+		{observation, "_type=Patient,Observation&_id=blood-pressure", true},
+		{organization, "_type=Patient,Observation", false},
+		{patient, "_type=Observation,Condition&patient=Patient/example", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.res.GetResourceType()+"?"+tt.query, func(t *testing.T) {
+			got, err := MustParse("", tt.query).Match(tt.res)
+			if err != nil {
+				t.Fatalf("Match() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if ok, err := MustParse("Patient", "_type=Observation").Match(patient); err != nil || ok {
+		t.Errorf("Match() of a Patient outside _type = %v, %v, want false", ok, err)
+	}
+}
+
+func TestMatch_ValueSet(t *testing.T) {
+	observation := loadResource(t, "observation-bp.json")
+	valueSets, err := models.UnmarshalResource([]byte(`{"resourceType": "Bundle", "type": "collection", "entry": [
+		{"resource": {"resourceType": "ValueSet", "url": "http://example.org/ValueSet/vitals", "status": "active",
+			"compose": {"include": [{"system": "http://loinc.org", "concept": [{"code": "85354-9"}]}]}}},
+		{"resource": {"resourceType": "ValueSet", "url": "http://example.org/ValueSet/labs", "status": "active",
+			"compose": {"include": [{"system": "http://loinc.org", "concept": [{"code": "2345-7"}]}]}}}
+	]}`))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	svc := terminology.NewInMemoryService(valueSets)
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"code:in=http://example.org/ValueSet/vitals", true},
+		{"code:in=http://example.org/ValueSet/labs", false},
+		{"code:in=http://example.org/ValueSet/labs,http://example.org/ValueSet/vitals", true},
+		{"code:not-in=http://example.org/ValueSet/vitals", false},
+		{"code:not-in=http://example.org/ValueSet/labs", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := MustParse("Observation", tt.query).Match(observation, WithTerminology(svc))
+			if err != nil {
+				t.Fatalf("Match() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := MustParse("Observation", "code:in=http://example.org/ValueSet/unknown").Match(observation, WithTerminology(svc)); err == nil {
+		t.Error("Match() of an unknown value set, want error")
+	}
+}
+
+func TestMatch_Errors(t *testing.T) {
+	bundle := matchTestBundle(t)
+	patient, observation := bundle.Entry[0].Resource, bundle.Entry[1].Resource
+
+	tests := []struct {
+		res   models.Resource
+		query string
+	}{
+		{observation, "code:in=http://example.org/ValueSet/vitals"},
+		{observation, "code:below=http://loinc.org|85354-9"},
+		{patient, "_text=peter"},
+		{patient, "organization.name=gastro"},
+		{patient, "_has:Observation:subject:status=final"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q := MustParse(tt.res.GetResourceType(), tt.query)
+			if got, err := q.Match(tt.res); err == nil {
+				t.Errorf("Match() without a source = %v, want error", got)
+			}
+		})
+	}
+}
+
+func TestMatch_Contained(t *testing.T) {
+	id, name := "org", "Contained Clinic"
+	patient := loadResource(t, "patient-example.json").(*models.Patient)
+	patient.Contained = []models.Resource{&models.Organization{Id: &id, Name: &name}}
+	reference := "#org"
+	patient.ManagingOrganization = &models.Reference{Reference: &reference}
+
+	ok, err := MustParse("Patient", "organization.name=contained").Match(patient)
+	if err != nil {
+		t.Fatalf("Match() error = %v", err)
+	}
+	if !ok {
+		t.Error("Match() = false, want the contained organization to match")
+	}
+}
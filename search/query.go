@@ -0,0 +1,649 @@
+package search
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// Query is a parsed FHIR search of a resource type, such as the query
+// string of GET [base]/Observation?subject=Patient/1&date=ge2024-01, or
+// across types when ResourceType is empty. Types holds the resource types
+// of _type, which limit either search.
+// A resource matches the query when it is of its types and matches all of
+// its Parameters; the remaining fields shape the result and are not
+// matched.
+type Query struct {
+	ResourceType  string
+	Types         []string
+	Parameters    []*Parameter
+	Sort          []Sort
+	Count         *int
+	MaxResults    *int
+	Include       []Include
+	RevInclude    []Include
+	Summary       string
+	Elements      []string
+	Total         string
+	Contained     string
+	ContainedType string
+}
+
+// Parameter is one search parameter of a query. Values are alternatives,
+// as in code=a,b; the same parameter repeated in a query string is parsed
+// as separate Parameters that must all match.
+//
+// A chained parameter, such as subject:Patient.name=peter, has Chain set
+// to the parameter evaluated on the referenced resources, and a reverse
+// chained one, such as _has:Observation:patient:code=1234-5, has Has set
+// instead; Values is then empty.
+type Parameter struct {
+	Name       string
+	Definition *models.SearchParameterDefinition
+	Modifier   string
+	TargetType string
+	Chain      *Parameter
+	Has        *ReverseChain
+	Values     []ParameterValue
+}
+
+// ReverseChain selects resources referenced by a resource of ResourceType
+// through its Reference parameter, when that resource matches Parameter.
+type ReverseChain struct {
+	ResourceType string
+	Reference    *models.SearchParameterDefinition
+	Parameter    *Parameter
+}
+
+// Prefix is the comparison prefix of a number, date or quantity value,
+// such as ge in date=ge2024-01.
+type Prefix string
+
+const (
+	PrefixEq Prefix = "eq"
+	PrefixNe Prefix = "ne"
+	PrefixGt Prefix = "gt"
+	PrefixLt Prefix = "lt"
+	PrefixGe Prefix = "ge"
+	PrefixLe Prefix = "le"
+	PrefixSa Prefix = "sa"
+	PrefixEb Prefix = "eb"
+	PrefixAp Prefix = "ap"
+)
+
+// ParameterValue is one value of a parameter, parsed by the parameter's
+// type. Raw holds the unescaped value as written, and is all that is kept
+// for string, uri and special parameters and for modifiers taking text,
+// such as :text, :contains or :in.
+type ParameterValue struct {
+	Prefix Prefix
+	Raw    string
+
+	// System and Code are the parts of token values (system|code) and the
+	// unit of quantity values; HasSystem tells code from |code, which
+	// matches codes without a system.
+	System    string
+	Code      string
+	HasSystem bool
+
+	// Number is the value of number and quantity values.
+	Number models.Decimal
+
+	// Range is the instants a date value covers at its precision.
+	Range DateRange
+
+	// Reference is the target of a reference value. A plain id leaves Type
+	// empty unless the parameter names a target type.
+	Reference Reference
+
+	// Components are the values of a composite value, in the order of the
+	// parameter's components.
+	Components []ParameterValue
+}
+
+// Sort is a sort key of _sort.
+type Sort struct {
+	Parameter  *models.SearchParameterDefinition
+	Descending bool
+}
+
+// Include is an _include or _revinclude of the resources ResourceType
+// references through Parameter, limited to TargetType when it is set. A
+// nil Parameter includes through every reference parameter (Patient:*).
+type Include struct {
+	ResourceType string
+	Parameter    *models.SearchParameterDefinition
+	TargetType   string
+	Iterate      bool
+}
+
+// typeModifiers are the modifiers each type of parameter accepts, besides
+// :missing, which all accept. Reference parameters also accept the type
+// of a target resource as modifier.
+var typeModifiers = map[string][]string{
+	"string":    {"exact", "contains", "text", "text-advanced"},
+	"token":     {"not", "text", "code-text", "text-advanced", "in", "not-in", "above", "below", "of-type"},
+	"reference": {"identifier", "above", "below", "code-text", "text", "text-advanced"},
+	"uri":       {"above", "below", "contains"},
+}
+
+// textModifiers are the modifiers whose values are text rather than
+// values of the parameter's type.
+var textModifiers = map[string]bool{
+	"exact": true, "contains": true, "text": true, "code-text": true, "text-advanced": true,
+	"in": true, "not-in": true, "of-type": true, "above": true, "below": true,
+}
+
+// ignoredParameters are parameters of the HTTP interface rather than of
+// search.
+var ignoredParameters = map[string]bool{"_format": true, "_pretty": true}
+
+var resultEnums = map[string][]string{
+	"_summary":       {"true", "false", "text", "data", "count"},
+	"_total":         {"none", "estimate", "accurate"},
+	"_contained":     {"true", "false", "both"},
+	"_containedType": {"container", "contained"},
+}
+
+// resourceParameterURL is the prefix of the canonical URLs of the search
+// parameters defined on Resource, which a search across all types uses.
+const resourceParameterURL = "http://hl7.org/fhir/SearchParameter/Resource-"
+
+var prefixPattern = regexp.MustCompile(`^(eq|ne|gt|lt|ge|le|sa|eb|ap)[-0-9]`)
+
+// Parse parses a search query string of resourceType, with or without the
+// leading "?", validating the parameters, modifiers and prefixes against
+// the search parameters of the specification. An empty resourceType parses
+// a search across the types of _type, or across all types without it, and
+// accepts only parameters those types share. Parameters without a value,
+// as in subject=, are ignored.
+func Parse(resourceType, query string) (*Query, error) {
+	if _, ok := models.NewResource(resourceType); !ok && resourceType != "" {
+		return nil, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+	q := &Query{ResourceType: resourceType}
+	var keys, values []string
+	for _, part := range strings.Split(strings.TrimPrefix(query, "?"), "&") {
+		if part == "" {
+			continue
+		}
+		rawKey, rawValue, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("parameter %q has no value", part)
+		}
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", rawKey, err)
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", key, err)
+		}
+		if value == "" {
+			continue
+		}
+		// _type is parsed first, as the other parameters are looked up on
+		// its types
+		if name, _, _ := strings.Cut(key, ":"); name == "_type" {
+			if err := q.addTypes(key, value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		keys, values = append(keys, key), append(values, value)
+	}
+	for i, key := range keys {
+		if err := q.add(key, values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// MustParse is like Parse but panics if the query is invalid.
+func MustParse(resourceType, query string) *Query {
+	q, err := Parse(resourceType, query)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+func (q *Query) add(key, value string) error {
+	name, modifier, _ := strings.Cut(key, ":")
+	switch name {
+	case "_sort":
+		return q.addSort(value)
+	case "_count", "_maxresults":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("parameter %s: invalid count %q", name, value)
+		}
+		if name == "_count" {
+			q.Count = &n
+		} else {
+			q.MaxResults = &n
+		}
+		return nil
+	case "_include", "_revinclude":
+		inc, err := parseInclude(name, modifier, value)
+		if err != nil {
+			return err
+		}
+		if name == "_include" {
+			q.Include = append(q.Include, inc)
+		} else {
+			q.RevInclude = append(q.RevInclude, inc)
+		}
+		return nil
+	case "_elements":
+		q.Elements = append(q.Elements, strings.Split(value, ",")...)
+		return nil
+	case "_summary", "_total", "_contained", "_containedType":
+		if !contains(resultEnums[name], value) {
+			return fmt.Errorf("parameter %s: invalid value %q", name, value)
+		}
+		switch name {
+		case "_summary":
+			q.Summary = value
+		case "_total":
+			q.Total = value
+		case "_contained":
+			q.Contained = value
+		case "_containedType":
+			q.ContainedType = value
+		}
+		return nil
+	}
+	if ignoredParameters[name] {
+		return nil
+	}
+	p, err := parseParameter(q.searchTypes(), key, value)
+	if err != nil {
+		return err
+	}
+	q.Parameters = append(q.Parameters, p)
+	return nil
+}
+
+// addTypes parses the resource types of _type.
+func (q *Query) addTypes(key, value string) error {
+	if key != "_type" {
+		return fmt.Errorf("parameter _type: modifiers are not supported")
+	}
+	for _, t := range strings.Split(value, ",") {
+		if _, ok := models.NewResource(t); !ok {
+			return fmt.Errorf("parameter _type: unknown resource type %q", t)
+		}
+		q.Types = append(q.Types, t)
+	}
+	return nil
+}
+
+// searchTypes returns the resource types parameters are looked up on: the
+// query's resource type, else the types of _type, or none for a search
+// across all types.
+func (q *Query) searchTypes() []string {
+	if q.ResourceType != "" {
+		return []string{q.ResourceType}
+	}
+	return q.Types
+}
+
+func (q *Query) addSort(value string) error {
+	types := q.searchTypes()
+	for _, key := range strings.Split(value, ",") {
+		code := strings.TrimPrefix(key, "-")
+		def, ok := lookupParameter(types, code)
+		if !ok {
+			return fmt.Errorf("parameter _sort: unknown parameter %q for %s", code, typeList(types))
+		}
+		q.Sort = append(q.Sort, Sort{Parameter: def, Descending: strings.HasPrefix(key, "-")})
+	}
+	return nil
+}
+
+// parseInclude parses the value of an _include or _revinclude, such as
+// Observation:subject:Patient or Observation:*.
+func parseInclude(name, modifier, value string) (Include, error) {
+	if modifier != "" && modifier != "iterate" {
+		return Include{}, fmt.Errorf("parameter %s: unknown modifier %q", name, modifier)
+	}
+	inc := Include{Iterate: modifier == "iterate"}
+	if value == "*" {
+		return inc, nil
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Include{}, fmt.Errorf("parameter %s: invalid value %q", name, value)
+	}
+	inc.ResourceType = parts[0]
+	if _, ok := models.NewResource(inc.ResourceType); !ok {
+		return Include{}, fmt.Errorf("parameter %s: unknown resource type %q", name, inc.ResourceType)
+	}
+	if parts[1] != "*" {
+		def, ok := models.LookupSearchParameter(inc.ResourceType, parts[1])
+		if !ok || def.Type != "reference" {
+			return Include{}, fmt.Errorf("parameter %s: %s has no reference parameter %q", name, inc.ResourceType, parts[1])
+		}
+		inc.Parameter = def
+	}
+	if len(parts) == 3 {
+		inc.TargetType = parts[2]
+		if inc.Parameter != nil && len(inc.Parameter.Target) > 0 && !contains(inc.Parameter.Target, inc.TargetType) {
+			return Include{}, fmt.Errorf("parameter %s: %s:%s does not refer to %s", name, inc.ResourceType, parts[1], inc.TargetType)
+		}
+	}
+	return inc, nil
+}
+
+// lookupParameter returns the search parameter code that all of types
+// define with the same definition, or the parameter of Resource when types
+// is empty.
+func lookupParameter(types []string, code string) (*models.SearchParameterDefinition, bool) {
+	if len(types) == 0 {
+		def, ok := models.SearchParameterByURL(resourceParameterURL + strings.TrimPrefix(code, "_"))
+		return def, ok && def.Code == code
+	}
+	var def *models.SearchParameterDefinition
+	for _, t := range types {
+		d, ok := models.LookupSearchParameter(t, code)
+		if !ok || (def != nil && d != def) {
+			return nil, false
+		}
+		def = d
+	}
+	return def, true
+}
+
+// typeList renders the resource types of a search for an error message.
+func typeList(types []string) string {
+	if len(types) == 0 {
+		return "all resource types"
+	}
+	return strings.Join(types, ", ")
+}
+
+// parseParameter parses a search parameter of the resource types by its
+// key, which may carry a modifier, a chain or a _has prefix.
+func parseParameter(types []string, key, value string) (*Parameter, error) {
+	if strings.HasPrefix(key, "_has:") {
+		return parseReverseChain(types, key, value)
+	}
+	head, chain, chained := strings.Cut(key, ".")
+	name, modifier, _ := strings.Cut(head, ":")
+	def, ok := lookupParameter(types, name)
+	if !ok {
+		return nil, fmt.Errorf("unknown parameter %q for %s", name, typeList(types))
+	}
+	p := &Parameter{Name: name, Definition: def}
+	if modifier != "" {
+		if _, isType := models.NewResource(modifier); isType && def.Type == "reference" {
+			if len(def.Target) > 0 && !contains(def.Target, modifier) {
+				return nil, fmt.Errorf("parameter %s: does not refer to %s", name, modifier)
+			}
+			p.TargetType = modifier
+		} else if modifier == "missing" || contains(typeModifiers[def.Type], modifier) {
+			p.Modifier = modifier
+		} else {
+			return nil, fmt.Errorf("parameter %s: unknown modifier %q for a %s parameter", name, modifier, def.Type)
+		}
+	}
+
+	if chained {
+		if def.Type != "reference" || p.Modifier != "" {
+			return nil, fmt.Errorf("parameter %s: only reference parameters can be chained", name)
+		}
+		target, err := chainTarget(p, chain)
+		if err != nil {
+			return nil, err
+		}
+		p.Chain, err = parseParameter([]string{target}, chain, value)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", name, err)
+		}
+		return p, nil
+	}
+
+	if p.Modifier == "missing" {
+		if value != "true" && value != "false" {
+			return nil, fmt.Errorf("parameter %s: :missing takes true or false, got %q", name, value)
+		}
+		p.Values = []ParameterValue{{Raw: value}}
+		return p, nil
+	}
+	for _, part := range splitEscaped(value, ',') {
+		v, err := parseValue(def, p.Modifier, p.TargetType, part)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", name, err)
+		}
+		p.Values = append(p.Values, v)
+	}
+	return p, nil
+}
+
+// chainTarget returns the resource type a chain continues on: the type
+// the parameter names, or else the only target that defines the chained
+// parameter. Targets sharing the definition, as for _id or the patient
+// parameter of clinical resources, need no type.
+func chainTarget(p *Parameter, chain string) (string, error) {
+	if p.TargetType != "" {
+		return p.TargetType, nil
+	}
+	code, _, _ := strings.Cut(chain, ".")
+	code, _, _ = strings.Cut(code, ":")
+	var target string
+	var def *models.SearchParameterDefinition
+	for _, t := range p.Definition.Target {
+		d, ok := models.LookupSearchParameter(t, code)
+		if code == "_has" {
+			d, ok = nil, true
+		}
+		if !ok {
+			continue
+		}
+		if target != "" && (d == nil || d != def) {
+			return "", fmt.Errorf("parameter %s: chain %s is ambiguous, name the target type as %s:Type.%s", p.Name, chain, p.Name, chain)
+		}
+		if target == "" {
+			target, def = t, d
+		}
+	}
+	if target == "" {
+		return "", fmt.Errorf("parameter %s: no target defines parameter %q", p.Name, code)
+	}
+	return target, nil
+}
+
+// parseReverseChain parses _has:Type:reference:parameter, where parameter
+// may itself be a reverse chain.
+func parseReverseChain(types []string, key, value string) (*Parameter, error) {
+	parts := strings.SplitN(strings.TrimPrefix(key, "_has:"), ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("parameter %s: expected _has:Type:reference:parameter", key)
+	}
+	sourceType, refCode, inner := parts[0], parts[1], parts[2]
+	if _, ok := models.NewResource(sourceType); !ok {
+		return nil, fmt.Errorf("parameter %s: unknown resource type %q", key, sourceType)
+	}
+	ref, ok := models.LookupSearchParameter(sourceType, refCode)
+	if !ok || ref.Type != "reference" {
+		return nil, fmt.Errorf("parameter %s: %s has no reference parameter %q", key, sourceType, refCode)
+	}
+	for _, t := range types {
+		if len(ref.Target) > 0 && !contains(ref.Target, t) {
+			return nil, fmt.Errorf("parameter %s: %s:%s does not refer to %s", key, sourceType, refCode, t)
+		}
+	}
+	p, err := parseParameter([]string{sourceType}, inner, value)
+	if err != nil {
+		return nil, fmt.Errorf("parameter %s: %w", key, err)
+	}
+	return &Parameter{Name: "_has", Has: &ReverseChain{ResourceType: sourceType, Reference: ref, Parameter: p}}, nil
+}
+
+// parseValue parses one value of a parameter, still escaped.
+func parseValue(def *models.SearchParameterDefinition, modifier, targetType, s string) (ParameterValue, error) {
+	if textModifiers[modifier] {
+		return ParameterValue{Raw: unescape(s)}, nil
+	}
+	v := ParameterValue{Raw: unescape(s)}
+	switch def.Type {
+	case "number", "date", "quantity":
+		v.Prefix = PrefixEq
+		if prefixPattern.MatchString(s) {
+			v.Prefix, s = Prefix(s[:2]), s[2:]
+			if len(def.Comparator) > 0 && !contains(def.Comparator, string(v.Prefix)) {
+				return v, fmt.Errorf("prefix %s is not allowed", v.Prefix)
+			}
+		}
+	}
+
+	switch def.Type {
+	case "token":
+		parseToken(&v, s)
+	case "reference":
+		if modifier == "identifier" {
+			parseToken(&v, s)
+			break
+		}
+		v.Reference = parseReferenceValue(v.Raw, targetType)
+	case "number":
+		n, err := models.ParseDecimal(s)
+		if err != nil {
+			return v, err
+		}
+		v.Number = n
+	case "quantity":
+		parts := splitEscaped(s, '|')
+		if len(parts) != 1 && len(parts) != 3 {
+			return v, fmt.Errorf("invalid quantity %q", v.Raw)
+		}
+		n, err := models.ParseDecimal(parts[0])
+		if err != nil {
+			return v, err
+		}
+		v.Number = n
+		if len(parts) == 3 {
+			v.System, v.Code = unescape(parts[1]), unescape(parts[2])
+		}
+	case "date":
+		r, err := parseDateValue(s)
+		if err != nil {
+			return v, err
+		}
+		v.Range = r
+	case "composite":
+		parts := splitEscaped(s, '$')
+		if len(parts) != len(def.Components) {
+			return v, fmt.Errorf("composite value %q has %d components, want %d", v.Raw, len(parts), len(def.Components))
+		}
+		for i, part := range parts {
+			component, ok := models.SearchParameterByURL(def.Components[i].Definition)
+			if !ok {
+				return v, fmt.Errorf("unknown component %s", def.Components[i].Definition)
+			}
+			cv, err := parseValue(component, "", "", part)
+			if err != nil {
+				return v, err
+			}
+			v.Components = append(v.Components, cv)
+		}
+	}
+	return v, nil
+}
+
+func parseToken(v *ParameterValue, s string) {
+	parts := splitEscaped(s, '|')
+	if len(parts) == 1 {
+		v.Code = unescape(parts[0])
+		return
+	}
+	v.HasSystem = true
+	v.System = unescape(parts[0])
+	v.Code = unescape(strings.Join(parts[1:], "|"))
+}
+
+// parseReferenceValue parses a reference value: Type/id, an absolute URL,
+// a canonical with an optional |version, or a plain id.
+func parseReferenceValue(s, targetType string) Reference {
+	if url, version, ok := strings.Cut(s, "|"); ok {
+		ref := parseReference(url)
+		ref.Reference, ref.URL, ref.Version = s, url, version
+		return ref
+	}
+	ref := parseReference(s)
+	if ref.Type == "" && ref.URL == "" && !strings.Contains(s, "/") && !strings.Contains(s, ":") {
+		ref.Type, ref.ID = targetType, s
+	} else if ref.Type == "" && strings.Contains(s, ":") {
+		ref.URL = s
+	}
+	return ref
+}
+
+// parseDateValue returns the instants a date value covers. Besides the
+// formats of date and dateTime it accepts times without seconds.
+func parseDateValue(s string) (DateRange, error) {
+	dt, err := models.ParseDateTime(s)
+	if err == nil {
+		if r, ok := temporalRange(dt); ok {
+			return r, nil
+		}
+	}
+	if i := strings.Index(s, "T"); i >= 0 && len(s) >= i+6 && (len(s) == i+6 || s[i+6] != ':') {
+		withSeconds := s[:i+6] + ":00" + s[i+6:]
+		if dt, err := models.ParseDateTime(withSeconds); err == nil {
+			if r, ok := temporalRange(dt); ok {
+				return DateRange{Start: r.Start, End: r.Start.Add(time.Minute)}, nil
+			}
+		}
+	}
+	return DateRange{}, fmt.Errorf("invalid date %q", s)
+}
+
+// splitEscaped splits s at each sep not escaped with a backslash, keeping
+// the escapes for unescape.
+func splitEscaped(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescape removes the backslashes escaping ',', '$', '|' and '\' in
+// parameter values.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
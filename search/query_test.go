@@ -0,0 +1,205 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+func TestParse(t *testing.T) {
+	q, err := Parse("Observation", "?subject=Patient/1&date=ge2024-01&code:in=http://example.org/ValueSet/vitals"+
+		"&_has:Provenance:target:agent=Practitioner/7&_sort=-date,status&_count=50")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if q.ResourceType != "Observation" || len(q.Parameters) != 4 {
+		t.Fatalf("Parse() = %+v, want 4 Observation parameters", q)
+	}
+
+	subject := q.Parameters[0]
+	want := Reference{Reference: "Patient/1", Type: "Patient", ID: "1"}
+	if subject.Definition.Code != "subject" || len(subject.Values) != 1 || subject.Values[0].Reference != want {
+		t.Errorf("subject = %+v, want Patient/1", subject)
+	}
+
+	date := q.Parameters[1].Values[0]
+	wantRange := DateRange{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	if date.Prefix != PrefixGe || date.Range != wantRange {
+		t.Errorf("date = %+v, want ge January 2024", date)
+	}
+
+	code := q.Parameters[2]
+	if code.Modifier != "in" || code.Values[0].Raw != "http://example.org/ValueSet/vitals" {
+		t.Errorf("code = %+v, want :in the value set", code)
+	}
+
+	has := q.Parameters[3].Has
+	if has == nil || has.ResourceType != "Provenance" || has.Reference.Code != "target" || has.Parameter.Definition.Code != "agent" {
+		t.Errorf("_has = %+v, want Provenance:target:agent", has)
+	}
+
+	if len(q.Sort) != 2 || q.Sort[0].Parameter.Code != "date" || !q.Sort[0].Descending || q.Sort[1].Descending {
+		t.Errorf("Sort = %+v, want -date,status", q.Sort)
+	}
+	if q.Count == nil || *q.Count != 50 {
+		t.Errorf("Count = %v, want 50", q.Count)
+	}
+}
+
+func TestParse_Values(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		query        string
+		want         []ParameterValue
+	}{
+		{
+			resourceType: "Patient",
+			query:        "identifier=http://example.org/mrn|123,|456,http://example.org/mrn|,789",
+			want: []ParameterValue{
+				{Raw: "http://example.org/mrn|123", System: "http://example.org/mrn", Code: "123", HasSystem: true},
+				{Raw: "|456", Code: "456", HasSystem: true},
+				{Raw: "http://example.org/mrn|", System: "http://example.org/mrn", HasSystem: true},
+				{Raw: "789", Code: "789"},
+			},
+		},
+		{
+			resourceType: "Patient",
+			query:        `family=O\,Brien`,
+			want:         []ParameterValue{{Raw: "O,Brien"}},
+		},
+		{
+			resourceType: "Observation",
+			query:        "value-quantity=lt5.4|http://unitsofmeasure.org|mg",
+			want: []ParameterValue{{Prefix: PrefixLt, Raw: "lt5.4|http://unitsofmeasure.org|mg",
+				Number: models.MustParseDecimal("5.4"), System: "http://unitsofmeasure.org", Code: "mg"}},
+		},
+		{
+			resourceType: "Observation",
+			query:        "subject:Patient=23",
+			want:         []ParameterValue{{Raw: "23", Reference: Reference{Reference: "23", Type: "Patient", ID: "23"}}},
+		},
+		{
+			resourceType: "Observation",
+			query:        "code-value-quantity=http://loinc.org|8480-6$gt150",
+			want: []ParameterValue{{Raw: "http://loinc.org|8480-6$gt150", Components: []ParameterValue{
+				{Raw: "http://loinc.org|8480-6", System: "http://loinc.org", Code: "8480-6", HasSystem: true},
+				{Prefix: PrefixGt, Raw: "gt150", Number: models.MustParseDecimal("150")},
+			}}},
+		},
+		{
+			resourceType: "Questionnaire",
+			query:        "url=http://example.org/Questionnaire/q|2.0",
+			want:         []ParameterValue{{Raw: "http://example.org/Questionnaire/q|2.0"}},
+		},
+		{
+			resourceType: "Encounter",
+			query:        "date=2024-03-15T10:30",
+			want: []ParameterValue{{Prefix: PrefixEq, Raw: "2024-03-15T10:30", Range: DateRange{
+				Start: time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC), End: time.Date(2024, 3, 15, 10, 31, 0, 0, time.UTC),
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := Parse(tt.resourceType, tt.query)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := q.Parameters[0].Values; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Chain(t *testing.T) {
+	q := MustParse("Observation", "subject:Patient.organization.name=Acme&patient.birthdate=lt2000")
+
+	chain := q.Parameters[0]
+	if chain.TargetType != "Patient" || chain.Chain == nil || chain.Chain.Definition.Code != "organization" {
+		t.Fatalf("chain = %+v, want subject:Patient.organization", chain)
+	}
+	if next := chain.Chain.Chain; next == nil || next.Definition.Code != "name" || next.Values[0].Raw != "Acme" {
+		t.Errorf("chain = %+v, want organization.name=Acme", chain.Chain)
+	}
+
+	patient := q.Parameters[1]
+	if patient.Chain == nil || patient.Chain.Definition.Code != "birthdate" || patient.Chain.Values[0].Prefix != PrefixLt {
+		t.Errorf("chain = %+v, want patient.birthdate=lt2000", patient)
+	}
+}
+
+func TestParse_Types(t *testing.T) {
+	q := MustParse("", "patient=Patient/1&_type=Observation,Condition&_lastUpdated=gt2024&code=")
+	if q.ResourceType != "" || !reflect.DeepEqual(q.Types, []string{"Observation", "Condition"}) {
+		t.Fatalf("Parse() = %+v, want a search of Observation and Condition", q)
+	}
+	if len(q.Parameters) != 2 || q.Parameters[0].Definition.Code != "patient" || q.Parameters[1].Definition.Code != "_lastUpdated" {
+		t.Errorf("Parameters = %+v, want patient and _lastUpdated without the empty code", q.Parameters)
+	}
+
+	q = MustParse("", "_id=1&_tag=http://example.org|a")
+	if len(q.Types) != 0 || len(q.Parameters) != 2 {
+		t.Errorf("Parse() = %+v, want a search of all types", q)
+	}
+}
+
+func TestParse_Include(t *testing.T) {
+	q := MustParse("MedicationRequest", "_include=MedicationRequest:patient&_include:iterate=Patient:general-practitioner:Practitioner"+
+		"&_revinclude=Provenance:target&_include=*")
+	if len(q.Include) != 3 || len(q.RevInclude) != 1 {
+		t.Fatalf("Parse() = %+v, want 3 includes and 1 revinclude", q)
+	}
+	if inc := q.Include[0]; inc.ResourceType != "MedicationRequest" || inc.Parameter.Code != "patient" || inc.Iterate {
+		t.Errorf("Include[0] = %+v, want MedicationRequest:patient", inc)
+	}
+	if inc := q.Include[1]; inc.TargetType != "Practitioner" || !inc.Iterate {
+		t.Errorf("Include[1] = %+v, want :iterate with target Practitioner", inc)
+	}
+	if inc := q.Include[2]; inc.ResourceType != "" || inc.Parameter != nil {
+		t.Errorf("Include[2] = %+v, want the wildcard", inc)
+	}
+	if inc := q.RevInclude[0]; inc.ResourceType != "Provenance" || inc.Parameter.Code != "target" {
+		t.Errorf("RevInclude[0] = %+v, want Provenance:target", inc)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		query        string
+	}{
+		{"Unknown", "_id=1"},
+		{"Patient", "unknown=1"},
+		{"Patient", "family:below=Smith"},
+		{"Patient", "birthdate=2024-13"},
+		{"Patient", "birthdate=xx2024"},
+		{"Patient", "family=gtSmith&gender:missing=maybe"},
+		{"Patient", "gender"},
+		{"Observation", "subject:Account=1"},
+		{"Observation", "code.name=x"},
+		{"Observation", "subject.name=peter"},
+		{"Observation", "code-value-quantity=http://loinc.org|8480-6"},
+		{"Patient", "_has:Observation:code:status=final"},
+		{"Patient", "_has:Observation:patient"},
+		{"Patient", "_count=-1"},
+		{"Patient", "_sort=unknown"},
+		{"Patient", "_include=Patient:name"},
+		{"Patient", "_include:recurse=Patient:organization"},
+		{"Patient", "_summary=everything"},
+		{"Patient", "_type=Unknown"},
+		{"Patient", "_type:not=Patient"},
+		{"", "family=Smith"},
+		{"", "_type=Patient,Observation&family=Smith"},
+		{"", "_type=Observation,Condition&_sort=value-quantity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if q, err := Parse(tt.resourceType, tt.query); err == nil {
+				t.Errorf("Parse() = %+v, want error", q)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package search implements the search parameters of the FHIR
+// specification: it extracts the values resources are indexed by, and
+// parses and evaluates search queries.
+//
+// Each search parameter selects elements of a resource with a FHIRPath
+// expression; Extract evaluates the expressions of every parameter of the
+// resource's type and converts the selected elements to index values of the
+// parameter's type:
+//
+//	indexes, err := search.Extract(observation)
+//	for _, index := range indexes {
+//		// index.Parameter.Code is e.g. "code", index.Values its tokens
+//	}
+//
+// Parse parses the query string of a search into a Query, validated against
+// the parameters of the resource type, and Query.Match evaluates it against
+// a resource:
+//
+//	q, err := search.Parse("Observation", "subject=Patient/1&date=ge2024-01")
+//	ok, err := q.Match(observation, search.WithSource(search.BundleSource(bundle)))
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruzdev-dev/fhir/fhirpath"
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// Index is the values a resource is indexed by for one search parameter.
+type Index struct {
+	Parameter *models.SearchParameterDefinition
+	Values    []Value
+}
+
+// Extract returns the index values of res for each search parameter of its
+// type that selects at least one value. Parameters without an expression,
+// such as _text and _filter, and parameters of type special and resource
+// are left out.
+func Extract(res models.Resource) ([]Index, error) {
+	var indexes []Index
+	for _, param := range models.SearchParametersFor(res.GetResourceType()) {
+		values, err := ExtractParameter(res, param)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > 0 {
+			indexes = append(indexes, Index{Parameter: param, Values: values})
+		}
+	}
+	return indexes, nil
+}
+
+// ExtractParameter returns the index values of res for a single search
+// parameter.
+func ExtractParameter(res models.Resource, param *models.SearchParameterDefinition) ([]Value, error) {
+	if param.Expression == "" || param.Type == "special" || param.Type == "resource" {
+		return nil, nil
+	}
+	items, err := evaluate(res, res, param.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("search parameter %s: %w", param.Code, err)
+	}
+	if param.Type == "composite" {
+		return compositeValues(res, param, items)
+	}
+	var values []Value
+	for _, item := range items {
+		values = append(values, convert(param.Type, item)...)
+	}
+	return values, nil
+}
+
+// compositeValues evaluates the components of a composite parameter on
+// each item its expression selects.
+func compositeValues(res models.Resource, param *models.SearchParameterDefinition, items []any) ([]Value, error) {
+	var values []Value
+	for _, item := range items {
+		composite := Composite{Components: make([][]Value, len(param.Components))}
+		complete := true
+		for i, component := range param.Components {
+			def, ok := models.SearchParameterByURL(component.Definition)
+			if !ok {
+				return nil, fmt.Errorf("search parameter %s: unknown component %s", param.Code, component.Definition)
+			}
+			parts, err := evaluate(item, res, component.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("search parameter %s: %w", param.Code, err)
+			}
+			for _, part := range parts {
+				composite.Components[i] = append(composite.Components[i], convert(def.Type, part)...)
+			}
+			if len(composite.Components[i]) == 0 {
+				complete = false
+			}
+		}
+		if complete {
+			values = append(values, composite)
+		}
+	}
+	return values, nil
+}
+
+func evaluate(input any, res models.Resource, expression string) ([]any, error) {
+	expr, err := fhirpath.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	return expr.Evaluate(input, fhirpath.WithResource(res), fhirpath.WithResolver(typeResolver))
+}
+
+// typeResolver resolves a reference to an empty resource of the type it
+// names. Expressions such as Observation.subject.where(resolve() is
+// Patient) only test the type of the target, which the reference itself
+// tells.
+var typeResolver = fhirpath.ResolverFunc(func(reference string) (models.Resource, error) {
+	ref := parseReference(reference)
+	if ref.Type == "" {
+		return nil, nil
+	}
+	res, ok := models.NewResource(ref.Type)
+	if !ok {
+		return nil, nil
+	}
+	res.SetID(ref.ID)
+	return res, nil
+})
+
+// parseReference splits a literal reference into its parts: Patient/1,
+// Patient/1/_history/2 and http://example.org/fhir/Patient/1 all name a
+// type and id. Other absolute references are kept as URL only.
+func parseReference(reference string) Reference {
+	ref := Reference{Reference: reference}
+	if strings.HasPrefix(reference, "#") {
+		return ref
+	}
+	path := reference
+	if i := strings.Index(path, "/_history/"); i >= 0 {
+		ref.Version = path[i+len("/_history/"):]
+		path = path[:i]
+	}
+	if strings.Contains(path, "://") {
+		ref.URL = path
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) >= 2 {
+		typ, id := parts[len(parts)-2], parts[len(parts)-1]
+		if _, ok := models.NewResource(typ); ok && id != "" {
+			ref.Type, ref.ID = typ, id
+		}
+	}
+	return ref
+}
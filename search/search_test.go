@@ -0,0 +1,161 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+func loadResource(t *testing.T, name string) models.Resource {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	res, err := models.UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("UnmarshalResource(%s) error = %v", name, err)
+	}
+	return res
+}
+
+func extractParameter(t *testing.T, res models.Resource, code string) []Value {
+	t.Helper()
+	param, ok := models.LookupSearchParameter(res.GetResourceType(), code)
+	if !ok {
+		t.Fatalf("LookupSearchParameter(%s, %s) not found", res.GetResourceType(), code)
+	}
+	values, err := ExtractParameter(res, param)
+	if err != nil {
+		t.Fatalf("ExtractParameter(%s) error = %v", code, err)
+	}
+	return values
+}
+
+func TestExtractParameter_Patient(t *testing.T) {
+	patient := loadResource(t, "patient-example.json")
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		code string
+		want []Value
+	}{
+		{code: "_id", want: []Value{Token{Code: "example"}}},
+		{code: "active", want: []Value{Token{Code: "true"}}},
+		{code: "gender", want: []Value{Token{Code: "male"}}},
+		{code: "identifier", want: []Value{Token{System: "urn:oid:1.2.36.146.595.217.0.1", Code: "12345"}}},
+		{code: "family", want: []Value{String("Chalmers"), String("Windsor")}},
+		{code: "birthdate", want: []Value{DateRange{Start: day(1974, 12, 25), End: day(1974, 12, 26)}}},
+		{code: "phone", want: []Value{
+			Token{System: "phone", Code: "(03) 5555 6473"},
+			Token{System: "phone", Code: "(03) 3410 5613"},
+			Token{System: "phone", Code: "(03) 5555 8834"},
+		}},
+		{code: "organization", want: []Value{Reference{Reference: "Organization/1", Type: "Organization", ID: "1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got := extractParameter(t, patient, tt.code)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractParameter(%s) = %#v, want %#v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractParameter_Observation(t *testing.T) {
+	observation := loadResource(t, "observation-bp.json")
+	loinc := func(code, display string) Token {
+		return Token{System: "http://loinc.org", Code: code, Display: display}
+	}
+
+	tests := []struct {
+		code string
+		want []Value
+	}{
+		{code: "code", want: []Value{loinc("85354-9", "Blood pressure systolic & diastolic")}},
+		{code: "subject", want: []Value{Reference{Reference: "Patient/example", Type: "Patient", ID: "example"}}},
+		{code: "patient", want: []Value{Reference{Reference: "Patient/example", Type: "Patient", ID: "example"}}},
+		{code: "encounter", want: []Value{Reference{
+			Reference: "http://example.org/fhir/Encounter/visit-1/_history/2",
+			Type:      "Encounter", ID: "visit-1", Version: "2", URL: "http://example.org/fhir/Encounter/visit-1",
+		}}},
+		{code: "performer", want: []Value{Reference{Identifier: &Token{System: "urn:oid:2.16.840.1.113883.4.6", Code: "1234567893"}}}},
+		{code: "date", want: []Value{DateRange{Start: time.Date(2012, 9, 17, 9, 0, 0, 0, time.UTC)}}},
+		{code: "component-value-quantity", want: []Value{
+			Quantity{Value: models.MustParseDecimal("107"), System: "http://unitsofmeasure.org", Code: "mm[Hg]", Unit: "mmHg"},
+			Quantity{Value: models.MustParseDecimal("60"), System: "http://unitsofmeasure.org", Code: "mm[Hg]", Unit: "mmHg"},
+		}},
+		{code: "component-code-value-quantity", want: []Value{
+			Composite{Components: [][]Value{
+				{loinc("8480-6", "Systolic blood pressure")},
+				{Quantity{Value: models.MustParseDecimal("107"), System: "http://unitsofmeasure.org", Code: "mm[Hg]", Unit: "mmHg"}},
+			}},
+			Composite{Components: [][]Value{
+				{loinc("8462-4", "Diastolic blood pressure")},
+				{Quantity{Value: models.MustParseDecimal("60"), System: "http://unitsofmeasure.org", Code: "mm[Hg]", Unit: "mmHg"}},
+			}},
+		}},
+		{code: "value-quantity", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got := extractParameter(t, observation, tt.code)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractParameter(%s) = %#v, want %#v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	observation := loadResource(t, "observation-bp.json")
+	indexes, err := Extract(observation)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	codes := make(map[string]int)
+	for _, index := range indexes {
+		if len(index.Values) == 0 {
+			t.Errorf("Extract() returned %s without values", index.Parameter.Code)
+		}
+		codes[index.Parameter.Code] = len(index.Values)
+	}
+	for code, want := range map[string]int{"_id": 1, "status": 1, "category": 1, "combo-code": 3, "component-code": 2} {
+		if codes[code] != want {
+			t.Errorf("Extract() %s values = %d, want %d", code, codes[code], want)
+		}
+	}
+	for _, code := range []string{"value-quantity", "_text", "_filter"} {
+		if _, ok := codes[code]; ok {
+			t.Errorf("Extract() returned %s", code)
+		}
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      Reference
+	}{
+		{reference: "Patient/1", want: Reference{Type: "Patient", ID: "1"}},
+		{reference: "Patient/1/_history/3", want: Reference{Type: "Patient", ID: "1", Version: "3"}},
+		{reference: "https://example.org/fhir/Patient/1", want: Reference{Type: "Patient", ID: "1", URL: "https://example.org/fhir/Patient/1"}},
+		{reference: "urn:uuid:0d6c8a5e-2a8c-4d8f-9b55-0c5e0a1c9c3e", want: Reference{}},
+		{reference: "#contained", want: Reference{}},
+		{reference: "Unknown/1", want: Reference{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			tt.want.Reference = tt.reference
+			if got := parseReference(tt.reference); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseReference() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package search
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// Value is an index value of a search parameter: a Token, String,
+// Reference, DateRange, Quantity, Number, URI or Composite.
+type Value interface {
+	isValue()
+}
+
+// Token is the index value of a token parameter. Display holds the text
+// the :text modifier searches, such as CodeableConcept.text.
+type Token struct {
+	System  string
+	Code    string
+	Display string
+}
+
+// String is the index value of a string parameter.
+type String string
+
+// Reference is the index value of a reference parameter. Type and ID are
+// set for literal references to a resource, URL for absolute references
+// and canonicals, and Identifier for logical references.
+type Reference struct {
+	Reference  string
+	Type       string
+	ID         string
+	Version    string
+	URL        string
+	Identifier *Token
+}
+
+// DateRange is the index value of a date parameter: the instants from
+// Start up to End, exclusive. A zero Start or End leaves the range open on
+// that side, as for a Period without an end.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Quantity is the index value of a quantity parameter.
+type Quantity struct {
+	Value  models.Decimal
+	System string
+	Code   string
+	Unit   string
+}
+
+// Number is the index value of a number parameter.
+type Number struct {
+	Value models.Decimal
+}
+
+// URI is the index value of a uri parameter.
+type URI string
+
+// Composite is the index value of a composite parameter, with the values
+// of each of its components in order.
+type Composite struct {
+	Components [][]Value
+}
+
+func (Token) isValue()     {}
+func (String) isValue()    {}
+func (Reference) isValue() {}
+func (DateRange) isValue() {}
+func (Quantity) isValue()  {}
+func (Number) isValue()    {}
+func (URI) isValue()       {}
+func (Composite) isValue() {}
+
+// currencySystem is the system of the codes of Money.currency.
+const currencySystem = "urn:iso:std:iso:4217"
+
+// convert converts an item a search parameter's expression selected to the
+// index values of the parameter's type. Items that have no value of that
+// type, such as a Range for a quantity parameter, convert to nothing.
+func convert(paramType string, item any) []Value {
+	switch paramType {
+	case "token":
+		return tokenValues(item)
+	case "string":
+		return stringValues(item)
+	case "reference":
+		return referenceValues(item)
+	case "date":
+		return dateValues(item)
+	case "quantity":
+		return quantityValues(item)
+	case "number":
+		return numberValues(item)
+	case "uri":
+		if s, ok := item.(string); ok && s != "" {
+			return []Value{URI(s)}
+		}
+	}
+	return nil
+}
+
+func tokenValues(item any) []Value {
+	switch v := item.(type) {
+	case *models.Coding:
+		return []Value{codingToken(v)}
+	case *models.CodeableConcept:
+		return conceptTokens(v)
+	case *models.CodeableReference:
+		if v.Concept != nil {
+			return conceptTokens(v.Concept)
+		}
+	case *models.Identifier:
+		token := Token{System: deref(v.System), Code: deref(v.Value)}
+		if v.Type != nil {
+			token.Display = deref(v.Type.Text)
+		}
+		return []Value{token}
+	case *models.ContactPoint:
+		token := Token{Code: deref(v.Value)}
+		if v.System != nil {
+			token.System = string(*v.System)
+		}
+		return []Value{token}
+	case string:
+		return []Value{Token{Code: v}}
+	case bool:
+		return []Value{Token{Code: strconv.FormatBool(v)}}
+	}
+	return nil
+}
+
+func codingToken(c *models.Coding) Token {
+	return Token{System: deref(c.System), Code: deref(c.Code), Display: deref(c.Display)}
+}
+
+func conceptTokens(c *models.CodeableConcept) []Value {
+	var values []Value
+	for i := range c.Coding {
+		token := codingToken(&c.Coding[i])
+		if c.Text != nil {
+			token.Display = *c.Text
+		}
+		values = append(values, token)
+	}
+	if len(values) == 0 && c.Text != nil {
+		values = append(values, Token{Display: *c.Text})
+	}
+	return values
+}
+
+func stringValues(item any) []Value {
+	var parts []string
+	switch v := item.(type) {
+	case string:
+		parts = []string{v}
+	case *models.HumanName:
+		parts = append(parts, deref(v.Text), deref(v.Family))
+		parts = append(parts, v.Given...)
+		parts = append(parts, v.Prefix...)
+		parts = append(parts, v.Suffix...)
+	case *models.Address:
+		parts = append(parts, deref(v.Text))
+		parts = append(parts, v.Line...)
+		parts = append(parts, deref(v.City), deref(v.District), deref(v.State), deref(v.PostalCode), deref(v.Country))
+	}
+	var values []Value
+	for _, part := range parts {
+		if part != "" {
+			values = append(values, String(part))
+		}
+	}
+	return values
+}
+
+func referenceValues(item any) []Value {
+	switch v := item.(type) {
+	case *models.Reference:
+		var ref Reference
+		if v.Reference != nil {
+			ref = parseReference(*v.Reference)
+		}
+		if ref.Type == "" && v.Type != nil {
+			ref.Type = *v.Type
+		}
+		if v.Identifier != nil {
+			ref.Identifier = &Token{System: deref(v.Identifier.System), Code: deref(v.Identifier.Value)}
+		}
+		if ref.Reference == "" && ref.Identifier == nil {
+			return nil
+		}
+		return []Value{ref}
+	case *models.CodeableReference:
+		if v.Reference != nil {
+			return referenceValues(v.Reference)
+		}
+	case string:
+		// canonical and uri elements, with an optional |version
+		ref := Reference{Reference: v, URL: v}
+		for i := len(v) - 1; i >= 0; i-- {
+			if v[i] == '|' {
+				ref.URL, ref.Version = v[:i], v[i+1:]
+				break
+			}
+		}
+		if parsed := parseReference(ref.URL); parsed.Type != "" {
+			ref.Type, ref.ID = parsed.Type, parsed.ID
+		}
+		return []Value{ref}
+	}
+	return nil
+}
+
+func dateValues(item any) []Value {
+	switch v := item.(type) {
+	case models.Date, models.DateTime, models.Instant:
+		if r, ok := temporalRange(v); ok {
+			return []Value{r}
+		}
+	case *models.Period:
+		var r DateRange
+		if v.Start != nil {
+			start, ok := temporalRange(*v.Start)
+			if !ok {
+				return nil
+			}
+			r.Start = start.Start
+		}
+		if v.End != nil {
+			end, ok := temporalRange(*v.End)
+			if !ok {
+				return nil
+			}
+			r.End = end.End
+		}
+		if v.Start == nil && v.End == nil {
+			return nil
+		}
+		return []Value{r}
+	case *models.Timing:
+		var values []Value
+		for _, event := range v.Event {
+			if r, ok := temporalRange(event); ok {
+				values = append(values, r)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// temporalRange returns the instants a date, dateTime or instant covers at
+// its precision.
+func temporalRange(item any) (DateRange, bool) {
+	ranger, ok := item.(interface {
+		Range() (time.Time, time.Time, error)
+	})
+	if !ok {
+		return DateRange{}, false
+	}
+	start, end, err := ranger.Range()
+	if err != nil {
+		return DateRange{}, false
+	}
+	return DateRange{Start: start, End: end}, true
+}
+
+func quantityValues(item any) []Value {
+	switch v := item.(type) {
+	case *models.Money:
+		if v.Value == nil {
+			return nil
+		}
+		return []Value{Quantity{Value: *v.Value, System: currencySystem, Code: deref(v.Currency)}}
+	case models.Decimal:
+		return []Value{Quantity{Value: v}}
+	case int64:
+		return []Value{Quantity{Value: models.MustParseDecimal(strconv.FormatInt(v, 10))}}
+	}
+
+	// Quantity and its profiles Age, Count, Distance, Duration and
+	// SimpleQuantity share the same elements.
+	rv := reflect.ValueOf(item)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	rv = rv.Elem()
+	field := rv.FieldByName("Value")
+	if !field.IsValid() {
+		return nil
+	}
+	value, ok := field.Interface().(*models.Decimal)
+	if !ok || value == nil {
+		return nil
+	}
+	q := Quantity{Value: *value}
+	for name, dst := range map[string]*string{"System": &q.System, "Code": &q.Code, "Unit": &q.Unit} {
+		if field := rv.FieldByName(name); field.IsValid() {
+			if s, ok := field.Interface().(*string); ok {
+				*dst = deref(s)
+			}
+		}
+	}
+	return []Value{q}
+}
+
+func numberValues(item any) []Value {
+	switch v := item.(type) {
+	case models.Decimal:
+		return []Value{Number{Value: v}}
+	case int64:
+		return []Value{Number{Value: models.MustParseDecimal(strconv.FormatInt(v, 10))}}
+	}
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
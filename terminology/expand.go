@@ -0,0 +1,397 @@
+package terminology
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// expansion is the content of a value set: the concepts it lists, and the
+// code systems it includes whole or by filter but whose codes are not
+// known, so that whether their codes are in the value set cannot be told.
+type expansion struct {
+	concepts []Concept
+	index    map[string]int // system|code
+	systems  map[string]bool
+	open     map[string]bool
+}
+
+func newExpansion() *expansion {
+	return &expansion{index: make(map[string]int), systems: make(map[string]bool), open: make(map[string]bool)}
+}
+
+func (e *expansion) add(c Concept) {
+	e.systems[c.System] = true
+	key := c.System + "|" + c.Code
+	if _, ok := e.index[key]; ok {
+		return
+	}
+	e.index[key] = len(e.concepts)
+	e.concepts = append(e.concepts, c)
+}
+
+func (e *expansion) addAll(other *expansion) {
+	for _, c := range other.concepts {
+		e.add(c)
+	}
+	for system := range other.systems {
+		e.systems[system] = true
+	}
+	for system := range other.open {
+		e.open[system] = true
+	}
+}
+
+// find returns the concept of a code, of any system when system is empty.
+func (e *expansion) find(system, code string) (Concept, bool) {
+	if system != "" {
+		i, ok := e.index[system+"|"+code]
+		if !ok {
+			return Concept{}, false
+		}
+		return e.concepts[i], true
+	}
+	for _, c := range e.concepts {
+		if c.Code == code {
+			return c, true
+		}
+	}
+	return Concept{}, false
+}
+
+// contains reports whether a concept is or may be in the expansion.
+func (e *expansion) contains(c Concept) bool {
+	_, ok := e.index[c.System+"|"+c.Code]
+	return ok || e.open[c.System]
+}
+
+// filter returns the concepts of e that keep reports true for.
+func (e *expansion) filter(keep func(Concept) bool) *expansion {
+	result := newExpansion()
+	for _, c := range e.concepts {
+		if keep(c) {
+			result.add(c)
+		}
+	}
+	for system := range e.systems {
+		result.systems[system] = true
+	}
+	for system := range e.open {
+		result.open[system] = true
+	}
+	return result
+}
+
+// openSystems returns the code systems of e whose codes are not known.
+func (e *expansion) openSystems() []string {
+	systems := make([]string, 0, len(e.open))
+	for system := range e.open {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+	return systems
+}
+
+// ValidateCode reports whether coding is in a value set. Abstract concepts
+// are not valid codes.
+func (s *InMemoryService) ValidateCode(valueSet string, coding models.Coding) (CodeResult, error) {
+	e, err := s.expansion(valueSet)
+	if err != nil {
+		return CodeResult{}, err
+	}
+	var system string
+	if coding.System != nil {
+		system = *coding.System
+	}
+	result := CodeResult{SystemIncluded: system == "" || e.systems[system]}
+	if coding.Code == nil || *coding.Code == "" {
+		result.Message = "the coding has no code"
+		return result, nil
+	}
+	code := *coding.Code
+	if c, ok := e.find(system, code); ok {
+		if c.Abstract {
+			result.Message = fmt.Sprintf("code %s is abstract and cannot be used", codeString(coding))
+			return result, nil
+		}
+		result.Valid, result.Concept = true, c
+		return result, nil
+	}
+	if system != "" && e.open[system] {
+		return CodeResult{}, fmt.Errorf("%w: %s", ErrUnknownCodeSystem, system)
+	}
+	if system == "" && len(e.open) > 0 {
+		return CodeResult{}, fmt.Errorf("%w: %s", ErrUnknownCodeSystem, e.openSystems()[0])
+	}
+	result.Message = fmt.Sprintf("code %s is not in value set %s", codeString(coding), canonical(valueSet))
+	return result, nil
+}
+
+// Expand returns the concepts of a value set, in the order of its compose
+// rules.
+func (s *InMemoryService) Expand(valueSet string) ([]Concept, error) {
+	e, err := s.expansion(valueSet)
+	if err != nil {
+		return nil, err
+	}
+	if open := e.openSystems(); len(open) > 0 {
+		return nil, fmt.Errorf("value set %s: %w: %s", canonical(valueSet), ErrUnknownCodeSystem, strings.Join(open, ", "))
+	}
+	return append([]Concept(nil), e.concepts...), nil
+}
+
+// expansion returns the expansion of a value set, expanding it on first
+// use.
+func (s *InMemoryService) expansion(valueSet string) (*expansion, error) {
+	url := canonical(valueSet)
+	s.mu.Lock()
+	e, ok := s.expansions[url]
+	s.mu.Unlock()
+	if ok {
+		return e, nil
+	}
+	e, err := s.expand(url, make(map[string]bool))
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.expansions[url] = e
+	s.mu.Unlock()
+	return e, nil
+}
+
+// expand computes the expansion of the value set url. expanding holds the
+// value sets being expanded, to detect value sets that include themselves.
+func (s *InMemoryService) expand(url string, expanding map[string]bool) (*expansion, error) {
+	vs, ok := s.valueSets[url]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownValueSet, url)
+	}
+	if expanding[url] {
+		return nil, fmt.Errorf("value set %s includes itself", url)
+	}
+	expanding[url] = true
+	defer delete(expanding, url)
+
+	e := newExpansion()
+	if vs.Compose == nil {
+		if vs.Expansion != nil {
+			addContains(e, vs.Expansion.Contains)
+		}
+		return e, nil
+	}
+	for _, include := range vs.Compose.Include {
+		part, err := s.include(url, include, expanding)
+		if err != nil {
+			return nil, err
+		}
+		e.addAll(part)
+	}
+	for _, exclude := range vs.Compose.Exclude {
+		part, err := s.include(url, exclude, expanding)
+		if err != nil {
+			return nil, err
+		}
+		e = e.filter(func(c Concept) bool { return !part.contains(c) })
+		for system := range part.open {
+			delete(e.open, system)
+		}
+	}
+	return e, nil
+}
+
+// addContains adds the codes of an expansion given by a value set itself.
+func addContains(e *expansion, contains []models.ValueSetExpansionContains) {
+	for _, c := range contains {
+		if c.Code != nil {
+			concept := Concept{Code: *c.Code}
+			if c.System != nil {
+				concept.System = *c.System
+			}
+			if c.Version != nil {
+				concept.Version = *c.Version
+			}
+			if c.Display != nil {
+				concept.Display = *c.Display
+			}
+			concept.Abstract = c.Abstract != nil && *c.Abstract
+			concept.Inactive = c.Inactive != nil && *c.Inactive
+			e.add(concept)
+		}
+		addContains(e, c.Contains)
+	}
+}
+
+// include returns the concepts an include or exclude rule of the value set
+// url selects: the codes of its system, listed or filtered, that are in
+// every value set it names.
+func (s *InMemoryService) include(url string, rule models.ValueSetComposeInclude, expanding map[string]bool) (*expansion, error) {
+	var result *expansion
+	if rule.System != nil {
+		part, err := s.systemConcepts(url, *rule.System, rule)
+		if err != nil {
+			return nil, err
+		}
+		result = part
+	}
+	for _, ref := range rule.ValueSet {
+		other, err := s.expand(canonical(ref), expanding)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = newExpansion()
+			result.addAll(other)
+			continue
+		}
+		intersection := result.filter(other.contains)
+		intersection.open = make(map[string]bool)
+		for system := range result.open {
+			if other.open[system] {
+				intersection.open[system] = true
+			}
+		}
+		for _, c := range other.concepts {
+			if result.open[c.System] {
+				intersection.add(c)
+			}
+		}
+		result = intersection
+	}
+	if result == nil {
+		result = newExpansion()
+	}
+	return result, nil
+}
+
+// systemConcepts returns the concepts of system a rule selects: those it
+// lists, or those of the code system that pass its filters.
+func (s *InMemoryService) systemConcepts(url, system string, rule models.ValueSetComposeInclude) (*expansion, error) {
+	system = canonical(system)
+	e := newExpansion()
+	e.systems[system] = true
+	cs := s.codeSystems[system]
+	if len(rule.Concept) > 0 {
+		for _, c := range rule.Concept {
+			concept := Concept{System: system, Code: c.Code}
+			if cs != nil {
+				if node, ok := cs.concepts[c.Code]; ok {
+					concept = node.Concept
+				}
+			}
+			if c.Display != nil {
+				concept.Display = *c.Display
+			}
+			e.add(concept)
+		}
+		return e, nil
+	}
+	if cs == nil || !cs.complete {
+		e.open[system] = true
+		return e, nil
+	}
+	for _, node := range cs.order {
+		keep := true
+		for _, f := range rule.Filter {
+			ok, err := cs.matches(node, f)
+			if err != nil {
+				return nil, fmt.Errorf("value set %s: %w", url, err)
+			}
+			if !ok {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			e.add(node.Concept)
+		}
+	}
+	return e, nil
+}
+
+// matches reports whether a concept passes a filter of a value set rule.
+// Filters on the concept hierarchy use the concept or code property; other
+// properties are those of the concepts.
+func (cs *codeSystem) matches(node *conceptNode, f models.ValueSetComposeIncludeFilter) (bool, error) {
+	if f.Property == "concept" || f.Property == "code" {
+		code := node.Code
+		switch f.Op {
+		case models.FilterOperatorIsA:
+			return code == f.Value || cs.descends(code, f.Value), nil
+		case models.FilterOperatorDescendentOf:
+			return cs.descends(code, f.Value), nil
+		case models.FilterOperatorIsNotA:
+			return code != f.Value && !cs.descends(code, f.Value), nil
+		case models.FilterOperatorGeneralizes:
+			return code == f.Value || cs.descends(f.Value, code), nil
+		case models.FilterOperatorChildOf:
+			return containsString(node.parents, f.Value), nil
+		case models.FilterOperatorDescendentLeaf:
+			return len(node.children) == 0 && cs.descends(code, f.Value), nil
+		}
+		return matchValue(f, []string{code})
+	}
+	var values []string
+	for _, p := range node.properties {
+		if p.Code == f.Property {
+			values = append(values, propertyString(p.Value))
+		}
+	}
+	if f.Op == models.FilterOperatorExists {
+		return (len(values) > 0) == (f.Value == "true"), nil
+	}
+	return matchValue(f, values)
+}
+
+// matchValue reports whether one of values passes a filter comparing
+// values.
+func matchValue(f models.ValueSetComposeIncludeFilter, values []string) (bool, error) {
+	switch f.Op {
+	case models.FilterOperatorCode:
+		return containsString(values, f.Value), nil
+	case models.FilterOperatorIn, models.FilterOperatorNotIn:
+		in := false
+		for _, v := range strings.Split(f.Value, ",") {
+			if containsString(values, strings.TrimSpace(v)) {
+				in = true
+			}
+		}
+		return in == (f.Op == models.FilterOperatorIn), nil
+	case models.FilterOperatorRegex:
+		re, err := regexp.Compile("^(?:" + f.Value + ")$")
+		if err != nil {
+			return false, fmt.Errorf("filter %s regex %q: %w", f.Property, f.Value, err)
+		}
+		for _, v := range values {
+			if re.MatchString(v) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported filter %s %s %s", f.Property, f.Op, f.Value)
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// codeString renders a coding as system|code, or as its code alone.
+func codeString(coding models.Coding) string {
+	var code string
+	if coding.Code != nil {
+		code = *coding.Code
+	}
+	if coding.System == nil || *coding.System == "" {
+		return fmt.Sprintf("%q", code)
+	}
+	return fmt.Sprintf("%q", *coding.System+"|"+code)
+}
@@ -0,0 +1,288 @@
+package terminology
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// InMemoryService is a TerminologyService over CodeSystem, ValueSet and
+// ConceptMap resources held in memory. Value sets are expanded on first
+// use from their compose rules, or taken from their expansion when they
+// have none. It is safe for concurrent use once its resources are added.
+type InMemoryService struct {
+	codeSystems map[string]*codeSystem
+	valueSets   map[string]*models.ValueSet
+	conceptMaps []*models.ConceptMap
+
+	mu         sync.Mutex
+	expansions map[string]*expansion
+}
+
+// NewInMemoryService returns a service over resources, which may be code
+// systems, value sets, concept maps and bundles of them.
+func NewInMemoryService(resources ...models.Resource) *InMemoryService {
+	s := &InMemoryService{
+		codeSystems: make(map[string]*codeSystem),
+		valueSets:   make(map[string]*models.ValueSet),
+		expansions:  make(map[string]*expansion),
+	}
+	for _, res := range resources {
+		s.Add(res)
+	}
+	return s
+}
+
+// Load returns a service over the resources of FHIR JSON files, such as
+// valuesets.json and conceptmaps.json of the specification.
+func Load(paths ...string) (*InMemoryService, error) {
+	s := NewInMemoryService()
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		res, err := models.UnmarshalResource(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		s.Add(res)
+	}
+	return s, nil
+}
+
+// Add adds a code system, value set or concept map, or the entries of a
+// bundle, replacing a resource with the same URL. Other resources are
+// ignored.
+func (s *InMemoryService) Add(res models.Resource) {
+	switch r := res.(type) {
+	case *models.Bundle:
+		for _, entry := range r.Entry {
+			if entry.Resource != nil {
+				s.Add(entry.Resource)
+			}
+		}
+	case *models.CodeSystem:
+		if r.Url != nil {
+			s.codeSystems[*r.Url] = newCodeSystem(r)
+		}
+	case *models.ValueSet:
+		if r.Url != nil {
+			s.valueSets[*r.Url] = r
+		}
+	case *models.ConceptMap:
+		s.conceptMaps = append(s.conceptMaps, r)
+	default:
+		return
+	}
+	s.mu.Lock()
+	s.expansions = make(map[string]*expansion)
+	s.mu.Unlock()
+}
+
+// Lookup returns a concept of a code system.
+func (s *InMemoryService) Lookup(system, code string) (Concept, error) {
+	cs, ok := s.codeSystems[canonical(system)]
+	if !ok {
+		return Concept{}, fmt.Errorf("%w: %s", ErrUnknownCodeSystem, system)
+	}
+	node, ok := cs.concepts[code]
+	if !ok {
+		return Concept{}, fmt.Errorf("%w: %s in %s", ErrUnknownCode, code, system)
+	}
+	return node.Concept, nil
+}
+
+// Subsumes reports how codeA relates to codeB in the hierarchy of their
+// code system, given by nested concepts and parent properties.
+func (s *InMemoryService) Subsumes(system, codeA, codeB string) (Subsumption, error) {
+	cs, ok := s.codeSystems[canonical(system)]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownCodeSystem, system)
+	}
+	for _, code := range []string{codeA, codeB} {
+		if _, ok := cs.concepts[code]; !ok {
+			return "", fmt.Errorf("%w: %s in %s", ErrUnknownCode, code, system)
+		}
+	}
+	switch {
+	case codeA == codeB:
+		return Equivalent, nil
+	case cs.descends(codeB, codeA):
+		return Subsumes, nil
+	case cs.descends(codeA, codeB):
+		return SubsumedBy, nil
+	}
+	return NotSubsumed, nil
+}
+
+// Translate returns the concepts coding maps to in the concept maps of the
+// service, in the order they were added. Targets that are not related to
+// the code, or that name a value set rather than a code, are left out.
+func (s *InMemoryService) Translate(coding models.Coding, targetSystem string) ([]Translation, error) {
+	if coding.System == nil || coding.Code == nil {
+		return nil, fmt.Errorf("translate: coding needs a system and a code")
+	}
+	var translations []Translation
+	for _, cm := range s.conceptMaps {
+		var url string
+		if cm.Url != nil {
+			url = *cm.Url
+		}
+		for _, group := range cm.Group {
+			if group.Source == nil || canonical(*group.Source) != canonical(*coding.System) {
+				continue
+			}
+			var system string
+			if group.Target != nil {
+				system = canonical(*group.Target)
+			}
+			if targetSystem != "" && system != canonical(targetSystem) {
+				continue
+			}
+			for _, el := range group.Element {
+				if el.Code == nil || *el.Code != *coding.Code {
+					continue
+				}
+				for _, target := range el.Target {
+					if target.Code == nil || target.Relationship == models.ConceptMapRelationshipNotRelatedTo {
+						continue
+					}
+					concept := Concept{System: system, Code: *target.Code}
+					if target.Display != nil {
+						concept.Display = *target.Display
+					}
+					translations = append(translations, Translation{
+						Concept:      concept,
+						Relationship: string(target.Relationship),
+						ConceptMap:   url,
+					})
+				}
+			}
+		}
+	}
+	return translations, nil
+}
+
+// codeSystem is a code system indexed by code, with its hierarchy.
+type codeSystem struct {
+	url string
+	// complete is false for code systems that list only some of their
+	// codes, whose other codes cannot be told from unknown ones.
+	complete bool
+	concepts map[string]*conceptNode
+	order    []*conceptNode
+}
+
+type conceptNode struct {
+	Concept
+	parents    []string
+	children   []string
+	properties []models.CodeSystemConceptProperty
+}
+
+func newCodeSystem(r *models.CodeSystem) *codeSystem {
+	cs := &codeSystem{
+		url:      *r.Url,
+		complete: r.Content == models.CodeSystemContentModeComplete,
+		concepts: make(map[string]*conceptNode),
+	}
+	var version string
+	if r.Version != nil {
+		version = *r.Version
+	}
+	var add func(concepts []models.CodeSystemConcept, parent string)
+	add = func(concepts []models.CodeSystemConcept, parent string) {
+		for _, c := range concepts {
+			node := &conceptNode{
+				Concept:    Concept{System: cs.url, Version: version, Code: c.Code},
+				properties: c.Property,
+			}
+			if c.Display != nil {
+				node.Display = *c.Display
+			}
+			if c.Definition != nil {
+				node.Definition = *c.Definition
+			}
+			if parent != "" {
+				node.parents = append(node.parents, parent)
+			}
+			for _, p := range c.Property {
+				switch p.Code {
+				case "parent", "subsumedBy":
+					if v := propertyString(p.Value); v != "" && v != parent {
+						node.parents = append(node.parents, v)
+					}
+				case "notSelectable", "abstract":
+					node.Abstract = propertyString(p.Value) == "true"
+				case "inactive":
+					node.Inactive = propertyString(p.Value) == "true"
+				case "status":
+					v := propertyString(p.Value)
+					node.Inactive = v == "retired" || v == "inactive"
+				}
+			}
+			cs.concepts[c.Code] = node
+			cs.order = append(cs.order, node)
+			add(c.Concept, c.Code)
+		}
+	}
+	add(r.Concept, "")
+	for _, node := range cs.order {
+		for _, parent := range node.parents {
+			if p, ok := cs.concepts[parent]; ok {
+				p.children = append(p.children, node.Code)
+			}
+		}
+	}
+	return cs
+}
+
+// descends reports whether code is a descendant of ancestor.
+func (cs *codeSystem) descends(code, ancestor string) bool {
+	seen := make(map[string]bool)
+	pending := []string{code}
+	for len(pending) > 0 {
+		node, ok := cs.concepts[pending[0]]
+		pending = pending[1:]
+		if !ok {
+			continue
+		}
+		for _, parent := range node.parents {
+			if parent == ancestor {
+				return true
+			}
+			if !seen[parent] {
+				seen[parent] = true
+				pending = append(pending, parent)
+			}
+		}
+	}
+	return false
+}
+
+// propertyString returns the value of a concept property as a string, the
+// code of a Coding.
+func propertyString(v models.CodeSystemConceptPropertyValue) string {
+	switch v := v.(type) {
+	case models.CodeSystemConceptPropertyValueCode:
+		return string(v)
+	case models.CodeSystemConceptPropertyValueString:
+		return string(v)
+	case models.CodeSystemConceptPropertyValueInteger:
+		return fmt.Sprint(int(v))
+	case models.CodeSystemConceptPropertyValueBoolean:
+		return fmt.Sprint(bool(v))
+	case models.CodeSystemConceptPropertyValueCoding:
+		if v.Code != nil {
+			return *v.Code
+		}
+	case models.CodeSystemConceptPropertyValueDateTime:
+		return v.DateTime.String()
+	case models.CodeSystemConceptPropertyValueDecimal:
+		return v.Decimal.String()
+	}
+	return ""
+}
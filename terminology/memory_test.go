@@ -0,0 +1,223 @@
+package terminology
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+const testTerminology = `{"resourceType": "Bundle", "type": "collection", "entry": [
+	{"resource": {"resourceType": "CodeSystem", "url": "http://example.org/cs/shapes", "version": "1", "status": "active",
+		"content": "complete", "concept": [
+			{"code": "shape", "display": "Shape", "property": [{"code": "notSelectable", "valueBoolean": true}], "concept": [
+				{"code": "polygon", "display": "Polygon", "concept": [
+					{"code": "triangle", "display": "Triangle", "definition": "A polygon with three sides",
+						"property": [{"code": "sides", "valueInteger": 3}]},
+					{"code": "square", "display": "Square", "property": [{"code": "sides", "valueInteger": 4}]}
+				]},
+				{"code": "circle", "display": "Circle"}
+			]},
+			{"code": "rhombus", "display": "Rhombus", "property": [{"code": "parent", "valueCode": "polygon"},
+				{"code": "status", "valueCode": "retired"}]}
+		]}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://example.org/vs/shapes", "status": "active",
+		"compose": {"include": [{"system": "http://example.org/cs/shapes"}]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://example.org/vs/polygons", "status": "active",
+		"compose": {"include": [{"system": "http://example.org/cs/shapes", "filter": [{"property": "concept", "op": "descendent-of", "value": "polygon"}]}],
+			"exclude": [{"system": "http://example.org/cs/shapes", "concept": [{"code": "rhombus"}]}]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://example.org/vs/four-sides", "status": "active",
+		"compose": {"include": [{"valueSet": ["http://example.org/vs/shapes|1"], "system": "http://example.org/cs/shapes",
+			"filter": [{"property": "sides", "op": "=", "value": "4"}]}]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://example.org/vs/findings", "status": "active",
+		"compose": {"include": [
+			{"system": "http://example.org/cs/shapes", "concept": [{"code": "circle", "display": "Round"}]},
+			{"system": "http://snomed.info/sct"}
+		]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://example.org/vs/expanded", "status": "active",
+		"expansion": {"timestamp": "2024-01-01", "contains": [
+			{"system": "http://example.org/cs/colors", "abstract": true, "code": "colors", "contains": [
+				{"system": "http://example.org/cs/colors", "code": "red", "display": "Red"}
+			]}
+		]}}},
+	{"resource": {"resourceType": "ConceptMap", "url": "http://example.org/cm/shapes", "status": "active", "group": [
+		{"source": "http://example.org/cs/shapes", "target": "http://example.org/cs/forms", "element": [
+			{"code": "square", "target": [
+				{"code": "quadrilateral", "display": "Quadrilateral", "relationship": "source-is-narrower-than-target"},
+				{"code": "cube", "relationship": "not-related-to"}
+			]}
+		]}
+	]}}
+]}`
+
+func testService(t *testing.T) *InMemoryService {
+	t.Helper()
+	res, err := models.UnmarshalResource([]byte(testTerminology))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	return NewInMemoryService(res)
+}
+
+func coding(system, code string) models.Coding {
+	c := models.Coding{Code: &code}
+	if system != "" {
+		c.System = &system
+	}
+	return c
+}
+
+const shapes = "http://example.org/cs/shapes"
+
+func TestInMemoryService_ValidateCode(t *testing.T) {
+	svc := testService(t)
+	tests := []struct {
+		name     string
+		valueSet string
+		coding   models.Coding
+		want     bool
+		included bool
+		wantErr  error
+	}{
+		{name: "whole system", valueSet: "http://example.org/vs/shapes", coding: coding(shapes, "circle"), want: true, included: true},
+		{name: "abstract", valueSet: "http://example.org/vs/shapes", coding: coding(shapes, "shape"), included: true},
+		{name: "unknown code", valueSet: "http://example.org/vs/shapes", coding: coding(shapes, "star"), included: true},
+		{name: "other system", valueSet: "http://example.org/vs/shapes", coding: coding("http://loinc.org", "circle")},
+		{name: "code without system", valueSet: "http://example.org/vs/shapes|1", coding: coding("", "square"), want: true, included: true},
+		{name: "descendant", valueSet: "http://example.org/vs/polygons", coding: coding(shapes, "triangle"), want: true, included: true},
+		{name: "filter parent", valueSet: "http://example.org/vs/polygons", coding: coding(shapes, "polygon"), included: true},
+		{name: "excluded", valueSet: "http://example.org/vs/polygons", coding: coding(shapes, "rhombus"), included: true},
+		{name: "property filter", valueSet: "http://example.org/vs/four-sides", coding: coding(shapes, "square"), want: true, included: true},
+		{name: "property filter mismatch", valueSet: "http://example.org/vs/four-sides", coding: coding(shapes, "triangle"), included: true},
+		{name: "listed", valueSet: "http://example.org/vs/findings", coding: coding(shapes, "circle"), want: true, included: true},
+		{name: "not listed", valueSet: "http://example.org/vs/findings", coding: coding(shapes, "square"), included: true},
+		{name: "unknown system", valueSet: "http://example.org/vs/findings", coding: coding("http://snomed.info/sct", "22298006"), wantErr: ErrUnknownCodeSystem},
+		{name: "expansion", valueSet: "http://example.org/vs/expanded", coding: coding("http://example.org/cs/colors", "red"), want: true, included: true},
+		{name: "unknown value set", valueSet: "http://example.org/vs/unknown", coding: coding(shapes, "circle"), wantErr: ErrUnknownValueSet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.ValidateCode(tt.valueSet, tt.coding)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ValidateCode() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateCode() error = %v", err)
+			}
+			if got.Valid != tt.want || got.SystemIncluded != tt.included {
+				t.Errorf("ValidateCode() = %+v, want Valid %v and SystemIncluded %v", got, tt.want, tt.included)
+			}
+			if !got.Valid && got.Message == "" {
+				t.Error("ValidateCode() gave no message for an invalid code")
+			}
+		})
+	}
+
+	got, _ := svc.ValidateCode("http://example.org/vs/findings", coding(shapes, "circle"))
+	if got.Concept.Display != "Round" {
+		t.Errorf("ValidateCode() concept = %+v, want the display of the value set", got.Concept)
+	}
+}
+
+func TestInMemoryService_Expand(t *testing.T) {
+	svc := testService(t)
+	concepts, err := svc.Expand("http://example.org/vs/polygons")
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	var codes []string
+	for _, c := range concepts {
+		codes = append(codes, c.Code)
+	}
+	if !reflect.DeepEqual(codes, []string{"triangle", "square"}) {
+		t.Errorf("Expand() = %v, want triangle and square", codes)
+	}
+
+	if _, err := svc.Expand("http://example.org/vs/findings"); !errors.Is(err, ErrUnknownCodeSystem) {
+		t.Errorf("Expand() error = %v, want ErrUnknownCodeSystem", err)
+	}
+}
+
+func TestInMemoryService_Lookup(t *testing.T) {
+	svc := testService(t)
+	got, err := svc.Lookup(shapes, "triangle")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	want := Concept{System: shapes, Version: "1", Code: "triangle", Display: "Triangle", Definition: "A polygon with three sides"}
+	if got != want {
+		t.Errorf("Lookup() = %+v, want %+v", got, want)
+	}
+	if got, _ := svc.Lookup(shapes, "rhombus"); !got.Inactive {
+		t.Errorf("Lookup(rhombus) = %+v, want a retired concept", got)
+	}
+	if _, err := svc.Lookup(shapes, "star"); !errors.Is(err, ErrUnknownCode) {
+		t.Errorf("Lookup() error = %v, want ErrUnknownCode", err)
+	}
+	if _, err := svc.Lookup("http://loinc.org", "1234-5"); !errors.Is(err, ErrUnknownCodeSystem) {
+		t.Errorf("Lookup() error = %v, want ErrUnknownCodeSystem", err)
+	}
+}
+
+func TestInMemoryService_Subsumes(t *testing.T) {
+	svc := testService(t)
+	tests := []struct {
+		a, b string
+		want Subsumption
+	}{
+		{a: "square", b: "square", want: Equivalent},
+		{a: "shape", b: "triangle", want: Subsumes},
+		{a: "rhombus", b: "polygon", want: SubsumedBy},
+		{a: "circle", b: "square", want: NotSubsumed},
+	}
+	for _, tt := range tests {
+		got, err := svc.Subsumes(shapes, tt.a, tt.b)
+		if err != nil || got != tt.want {
+			t.Errorf("Subsumes(%s, %s) = %q, %v, want %q", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+	if _, err := svc.Subsumes(shapes, "circle", "star"); !errors.Is(err, ErrUnknownCode) {
+		t.Errorf("Subsumes() error = %v, want ErrUnknownCode", err)
+	}
+}
+
+func TestInMemoryService_Translate(t *testing.T) {
+	svc := testService(t)
+	got, err := svc.Translate(coding(shapes, "square"), "")
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	want := []Translation{{
+		Concept:      Concept{System: "http://example.org/cs/forms", Code: "quadrilateral", Display: "Quadrilateral"},
+		Relationship: "source-is-narrower-than-target",
+		ConceptMap:   "http://example.org/cm/shapes",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Translate() = %+v, want %+v", got, want)
+	}
+	if got, _ := svc.Translate(coding(shapes, "square"), "http://example.org/cs/other"); len(got) != 0 {
+		t.Errorf("Translate() = %+v, want nothing for another target system", got)
+	}
+	if _, err := svc.Translate(coding("", "square"), ""); err == nil {
+		t.Error("Translate() accepted a coding without a system")
+	}
+}
+
+func TestLoad_ConceptMaps(t *testing.T) {
+	svc, err := Load(filepath.Join("..", "spec", "conceptmaps.json"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	got, err := svc.Translate(coding("http://hl7.org/fhir/administrative-gender", "male"), "http://terminology.hl7.org/CodeSystem/v2-0001")
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Concept.Code != "M" || got[0].Relationship != "equivalent" {
+		t.Errorf("Translate(male) = %+v, want v2 code M", got)
+	}
+}
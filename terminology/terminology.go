@@ -0,0 +1,108 @@
+// Package terminology answers questions about codes: whether a code is in
+// a value set, what a value set contains, what a code means, how two codes
+// of a hierarchy relate and what a code maps to in another code system.
+//
+// TerminologyService is the interface of these operations, after those of
+// a FHIR terminology server. InMemoryService implements it over the
+// CodeSystem, ValueSet and ConceptMap resources of the specification:
+//
+//	svc, err := terminology.Load("spec/valuesets.json", "spec/conceptmaps.json")
+//	result, err := svc.ValidateCode("http://hl7.org/fhir/ValueSet/administrative-gender", coding)
+//
+// ValidateBindings checks the coded elements of a resource against the
+// value sets they are bound to:
+//
+//	issues, err := terminology.ValidateBindings(svc, patient)
+package terminology
+
+import (
+	"errors"
+	"strings"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+var (
+	// ErrUnknownValueSet is returned for a value set the service does not
+	// know.
+	ErrUnknownValueSet = errors.New("unknown value set")
+	// ErrUnknownCodeSystem is returned when an answer depends on the codes
+	// of a code system the service does not know, or knows only in part.
+	ErrUnknownCodeSystem = errors.New("unknown code system")
+	// ErrUnknownCode is returned for a code that is not in its code system.
+	ErrUnknownCode = errors.New("unknown code")
+)
+
+// TerminologyService is a terminology server. Value sets, code systems and
+// concept maps are named by their canonical URLs, with or without a
+// |version suffix.
+type TerminologyService interface {
+	// ValidateCode reports whether coding is in a value set. A coding
+	// without a system, as the code of a code element is, matches a code
+	// of any system of the value set.
+	ValidateCode(valueSet string, coding models.Coding) (CodeResult, error)
+	// Expand returns the concepts of a value set.
+	Expand(valueSet string) ([]Concept, error)
+	// Lookup returns a concept of a code system.
+	Lookup(system, code string) (Concept, error)
+	// Subsumes reports how codeA relates to codeB in the hierarchy of their
+	// code system.
+	Subsumes(system, codeA, codeB string) (Subsumption, error)
+	// Translate returns the concepts coding maps to in targetSystem, or in
+	// any system when targetSystem is empty.
+	Translate(coding models.Coding, targetSystem string) ([]Translation, error)
+}
+
+// Concept is a code of a code system.
+type Concept struct {
+	System  string
+	Version string
+	Code    string
+	Display string
+	// Definition is the meaning of the concept, as its code system defines
+	// it.
+	Definition string
+	// Abstract concepts group other concepts and cannot be used as codes.
+	Abstract bool
+	Inactive bool
+}
+
+// CodeResult is the answer of ValidateCode.
+type CodeResult struct {
+	Valid bool
+	// Concept is the concept of the value set the code stands for, when it
+	// is valid.
+	Concept Concept
+	// Message says why the code is not valid.
+	Message string
+	// SystemIncluded reports whether the value set draws any codes from the
+	// code system of the coding. A code element has no system of its own
+	// and is always drawn from the value set.
+	SystemIncluded bool
+}
+
+// Subsumption is the relation of two codes of a hierarchical code system.
+type Subsumption string
+
+const (
+	Equivalent  Subsumption = "equivalent"
+	Subsumes    Subsumption = "subsumes"    // codeA is an ancestor of codeB
+	SubsumedBy  Subsumption = "subsumed-by" // codeA is a descendant of codeB
+	NotSubsumed Subsumption = "not-subsumed"
+)
+
+// Translation is a concept a code maps to in a concept map.
+type Translation struct {
+	Concept Concept
+	// Relationship is how the source code relates to the concept, such as
+	// equivalent or source-is-broader-than-target.
+	Relationship string
+	// ConceptMap is the URL of the concept map of the translation.
+	ConceptMap string
+}
+
+// canonical strips the version of a canonical URL.
+func canonical(url string) string {
+	url, _, _ = strings.Cut(url, "|")
+	return url
+}
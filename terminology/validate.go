@@ -0,0 +1,100 @@
+package terminology
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+// ValidateBindings checks the code, Coding and CodeableConcept elements of
+// res against the value sets they are bound to, recording issues under
+// issue code code-invalid:
+//
+//   - a required binding is an error unless one of the item's codes is in
+//     the value set;
+//   - an extensible binding is an error when a code of a system the value
+//     set draws on is not in it, and a warning when the item's codes are
+//     all from other systems, while text alone is accepted;
+//   - preferred and example bindings are warnings when none of the item's
+//     codes is in the value set.
+//
+// Codes that svc cannot check, because it does not know their code system
+// or the value set, are skipped and the item's other codes checked. An item
+// with a code that could not be checked has no issue, since that code may be
+// the one in the value set. Other errors of svc abort the validation.
+func ValidateBindings(svc TerminologyService, res models.Resource) (models.ValidationIssues, error) {
+	var issues models.ValidationIssues
+	for _, v := range models.BoundValues(res) {
+		issue, err := checkBinding(svc, v)
+		if err != nil {
+			return issues, fmt.Errorf("%s: %w", v.Path, err)
+		}
+		if issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+	return issues, nil
+}
+
+// checkBinding checks an item against its binding, returning the issue it
+// has, if any.
+func checkBinding(svc TerminologyService, v models.BoundValue) (*models.ValidationIssue, error) {
+	binding := v.Binding
+	var codes []string
+	foreign := true
+	unknown := false
+	for _, coding := range v.Codings {
+		if coding.Code == nil || *coding.Code == "" {
+			continue
+		}
+		result, err := svc.ValidateCode(binding.ValueSet, coding)
+		if errors.Is(err, ErrUnknownValueSet) || errors.Is(err, ErrUnknownCodeSystem) {
+			unknown = true
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if result.Valid {
+			return nil, nil
+		}
+		codes = append(codes, codeString(coding))
+		if result.SystemIncluded {
+			foreign = false
+		}
+	}
+
+	if unknown {
+		return nil, nil
+	}
+
+	severity := "warning"
+	switch binding.Strength {
+	case "required":
+		severity = "error"
+	case "extensible":
+		if len(codes) > 0 && !foreign {
+			severity = "error"
+		}
+	}
+	var message string
+	switch {
+	case len(codes) > 0:
+		message = fmt.Sprintf("%s not in value set %s (%s binding)", codeList(codes), binding.ValueSet, binding.Strength)
+	case binding.Strength == "required":
+		message = fmt.Sprintf("no code from value set %s (required binding)", binding.ValueSet)
+	default:
+		return nil, nil
+	}
+	return &models.ValidationIssue{Severity: severity, Code: "code-invalid", Path: v.Path, Message: message}, nil
+}
+
+// codeList renders the codes of an item for an issue message.
+func codeList(codes []string) string {
+	if len(codes) == 1 {
+		return "code " + codes[0] + " is"
+	}
+	return "codes " + strings.Join(codes, ", ") + " are"
+}
@@ -0,0 +1,137 @@
+package terminology
+
+import (
+	"strings"
+	"testing"
+
+	models "github.com/gruzdev-dev/fhir/r5"
+)
+
+const observationTerminology = `{"resourceType": "Bundle", "type": "collection", "entry": [
+	{"resource": {"resourceType": "CodeSystem", "url": "http://hl7.org/fhir/observation-status", "status": "active",
+		"content": "complete", "concept": [{"code": "registered"}, {"code": "final"}, {"code": "amended"}]}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://hl7.org/fhir/ValueSet/observation-status", "status": "active",
+		"compose": {"include": [{"system": "http://hl7.org/fhir/observation-status"}]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://hl7.org/fhir/ValueSet/observation-category", "status": "active",
+		"compose": {"include": [{"system": "http://terminology.hl7.org/CodeSystem/observation-category",
+			"concept": [{"code": "vital-signs"}, {"code": "laboratory"}]}]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://hl7.org/fhir/ValueSet/observation-interpretation", "status": "active",
+		"compose": {"include": [{"system": "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation",
+			"concept": [{"code": "H"}, {"code": "L"}, {"code": "N"}]}]}}},
+	{"resource": {"resourceType": "ValueSet", "url": "http://hl7.org/fhir/ValueSet/observation-codes-observationorboth", "status": "active",
+		"compose": {"include": [{"system": "http://loinc.org"}]}}}
+]}`
+
+const interpretationSystem = "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation"
+
+func TestValidateBindings(t *testing.T) {
+	res, err := models.UnmarshalResource([]byte(observationTerminology))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	svc := NewInMemoryService(res)
+
+	tests := []struct {
+		name   string
+		modify func(*models.Observation)
+		want   []string
+	}{
+		{name: "conforms"},
+		{
+			name:   "required code",
+			modify: func(o *models.Observation) { o.Status = "done" },
+			want:   []string{`error Observation.status: code "done" is not in value set http://hl7.org/fhir/ValueSet/observation-status (required binding)`},
+		},
+		{
+			name: "extensible code of the bound system",
+			modify: func(o *models.Observation) {
+				o.Interpretation = []models.CodeableConcept{{Coding: []models.Coding{coding(interpretationSystem, "HH")}}}
+			},
+			want: []string{`error Observation.interpretation[0]: code "` + interpretationSystem + `|HH" is not in value set http://hl7.org/fhir/ValueSet/observation-interpretation (extensible binding)`},
+		},
+		{
+			name: "extensible code of another system",
+			modify: func(o *models.Observation) {
+				o.Interpretation = []models.CodeableConcept{{Coding: []models.Coding{coding("http://example.org/flags", "HH")}}}
+			},
+			want: []string{`warning Observation.interpretation[0]: code "http://example.org/flags|HH" is not in value set`},
+		},
+		{
+			name: "extensible text",
+			modify: func(o *models.Observation) {
+				text := "Very high"
+				o.Interpretation = []models.CodeableConcept{{Text: &text}}
+			},
+		},
+		{
+			name: "unknown code system",
+			modify: func(o *models.Observation) {
+				o.Code.Coding = append(o.Code.Coding, coding("http://example.org/codes", "pulse"))
+			},
+		},
+		{
+			name: "preferred",
+			modify: func(o *models.Observation) {
+				o.Category[0].Coding = append(o.Category[0].Coding, coding("http://example.org/categories", "cardiology"))
+				o.Category = append(o.Category, models.CodeableConcept{Coding: []models.Coding{coding("http://example.org/categories", "cardiology")}})
+			},
+			want: []string{`warning Observation.category[1]: code "http://example.org/categories|cardiology" is not in value set http://hl7.org/fhir/ValueSet/observation-category (preferred binding)`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obs := &models.Observation{
+				ResourceType: "Observation",
+				Status:       "final",
+				Category: []models.CodeableConcept{{Coding: []models.Coding{
+					coding("http://terminology.hl7.org/CodeSystem/observation-category", "vital-signs"),
+				}}},
+				Code:           &models.CodeableConcept{Coding: []models.Coding{coding("http://loinc.org", "8867-4")}},
+				Interpretation: []models.CodeableConcept{{Coding: []models.Coding{coding(interpretationSystem, "N")}}},
+			}
+			if tt.modify != nil {
+				tt.modify(obs)
+			}
+			issues, err := ValidateBindings(svc, obs)
+			if err != nil {
+				t.Fatalf("ValidateBindings() error = %v", err)
+			}
+			if len(issues) != len(tt.want) {
+				t.Fatalf("ValidateBindings() = %v, want %d issues", issues, len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := issues[i].Severity + " " + issues[i].String()
+				if !strings.HasPrefix(got, want) || issues[i].Code != "code-invalid" {
+					t.Errorf("issue %d = %s (%s), want %s", i, got, issues[i].Code, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckBinding_UnknownCode(t *testing.T) {
+	res, err := models.UnmarshalResource([]byte(observationTerminology))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	svc := NewInMemoryService(res)
+
+	// the LOINC code cannot be checked, so it may be the one in the value set
+	v := models.BoundValue{
+		Binding: &models.BindingDefinition{
+			Path:     "Observation.code",
+			Strength: "required",
+			ValueSet: "http://hl7.org/fhir/ValueSet/observation-codes-observationorboth",
+		},
+		Path:    "Observation.code",
+		Type:    "CodeableConcept",
+		Codings: []models.Coding{coding("http://loinc.org", "8867-4"), coding("http://example.org/codes", "pulse")},
+	}
+	issue, err := checkBinding(svc, v)
+	if err != nil {
+		t.Fatalf("checkBinding() error = %v", err)
+	}
+	if issue != nil {
+		t.Errorf("checkBinding() = %v, want no issue", issue)
+	}
+}
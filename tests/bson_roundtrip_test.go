@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+// bsonResource is implemented by the generated resources, whose BSON methods
+// the MongoDB driver calls in place of its own struct codec.
+type bsonResource interface {
+	r5.Resource
+	MarshalBSON() ([]byte, error)
+	UnmarshalBSON(data []byte) error
+}
+
+// bsonRoundTrip encodes a resource as BSON and decodes it into a new
+// resource of the same type.
+func bsonRoundTrip(t *testing.T, res r5.Resource) (r5.Resource, []byte) {
+	t.Helper()
+
+	data, err := res.(bsonResource).MarshalBSON()
+	if err != nil {
+		t.Fatalf("MarshalBSON() error = %v", err)
+	}
+	back, ok := r5.NewResource(res.GetResourceType())
+	if !ok {
+		t.Fatalf("NewResource(%s) failed", res.GetResourceType())
+	}
+	if err := back.(bsonResource).UnmarshalBSON(data); err != nil {
+		t.Fatalf("UnmarshalBSON() error = %v", err)
+	}
+	return back, data
+}
+
+func TestBSON_GoldenRoundTrip(t *testing.T) {
+	files := []string{
+		"bundle_polymorphic.json",
+		"observation_choice.json",
+		"observation_contained.json",
+		"observation_decimal.json",
+		"observation_extensions.json",
+		"observation_temporal.json",
+		"patient_choice_extension.json",
+		"patient_example.json",
+		"patient_extensions.json",
+		"patient_primitive_extensions.json",
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			data := loadGolden(t, file)
+			res, err := r5.UnmarshalResource(data)
+			if err != nil {
+				t.Fatalf("UnmarshalResource() error = %v", err)
+			}
+
+			back, _ := bsonRoundTrip(t, res)
+			asJSON, err := json.Marshal(back)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			assertJSONEqual(t, data, asJSON)
+		})
+	}
+}
+
+func TestBSON_Keys(t *testing.T) {
+	tests := map[string][]string{
+		// resources carry their type
+		"bundle_polymorphic.json": {"resource_type", "entry", "resource"},
+		// choice elements are named after the type they hold
+		"observation_choice.json": {"value_string", "value_element", "effective_period"},
+	}
+
+	for file, keys := range tests {
+		t.Run(file, func(t *testing.T) {
+			res, err := r5.UnmarshalResource(loadGolden(t, file))
+			if err != nil {
+				t.Fatalf("UnmarshalResource() error = %v", err)
+			}
+			_, data := bsonRoundTrip(t, res)
+			for _, key := range keys {
+				if !bytes.Contains(data, []byte(key+"\x00")) {
+					t.Errorf("BSON document has no %q key", key)
+				}
+			}
+		})
+	}
+}
+
+func TestBSON_Precision(t *testing.T) {
+	quantity := r5.Quantity{Value: ptr(r5.MustParseDecimal("1.50")), Unit: ptr("mmol/L")}
+	data, err := quantity.MarshalBSON()
+	if err != nil {
+		t.Fatalf("MarshalBSON() error = %v", err)
+	}
+
+	var back r5.Quantity
+	if err := back.UnmarshalBSON(data); err != nil {
+		t.Fatalf("UnmarshalBSON() error = %v", err)
+	}
+	if back.Value == nil || back.Value.String() != "1.50" {
+		t.Errorf("Value = %v, want 1.50", back.Value)
+	}
+}
+
+func TestBSON_UnknownKeysIgnored(t *testing.T) {
+	patient := r5.Patient{ResourceType: "Patient", Id: ptr("p1")}
+	data, err := patient.MarshalBSON()
+	if err != nil {
+		t.Fatalf("MarshalBSON() error = %v", err)
+	}
+
+	// prepend the _id MongoDB adds to stored documents
+	id := append([]byte{0x07}, "_id\x00"...)
+	id = append(id, make([]byte, 12)...)
+	doc := append(append([]byte{0, 0, 0, 0}, id...), data[4:]...)
+	binary.LittleEndian.PutUint32(doc, uint32(len(doc)))
+
+	var back r5.Patient
+	if err := back.UnmarshalBSON(doc); err != nil {
+		t.Fatalf("UnmarshalBSON() error = %v", err)
+	}
+	if back.Id == nil || *back.Id != "p1" {
+		t.Errorf("Id = %v, want p1", back.Id)
+	}
+}
+
+func TestBSON_Errors(t *testing.T) {
+	bundle := r5.Bundle{
+		ResourceType: "Bundle",
+		Type:         r5.BundleTypeCollection,
+		Entry:        []r5.BundleEntry{{Resource: &r5.Patient{ResourceType: "Patient"}}},
+	}
+	data, err := bundle.MarshalBSON()
+	if err != nil {
+		t.Fatalf("MarshalBSON() error = %v", err)
+	}
+	unknown := bytes.Replace(data, []byte("Patient"), []byte("Unknown"), 1)
+
+	tests := map[string][]byte{
+		"truncated":             data[:len(data)-3],
+		"unknown resource type": unknown,
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var back r5.Bundle
+			if err := back.UnmarshalBSON(doc); err == nil {
+				t.Error("UnmarshalBSON() expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+func TestElementMetadata_FieldIndexes(t *testing.T) {
+	holders := []r5.ElementMetadataHolder{
+		&r5.Patient{}, &r5.PatientContact{}, &r5.Observation{}, &r5.ObservationComponent{},
+		&r5.Questionnaire{}, &r5.QuestionnaireItem{}, &r5.Bundle{}, &r5.HumanName{}, &r5.FHIRString{},
+	}
+	for _, holder := range holders {
+		typ := reflect.TypeOf(holder).Elem()
+		metadata := holder.ElementMetadata()
+		if len(metadata) == 0 {
+			t.Errorf("%s has no element metadata", typ.Name())
+		}
+		for _, m := range metadata {
+			field := typ.Field(m.Field)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name != "-" && name != m.Name {
+				t.Errorf("%s: field %d is %s with JSON name %q, want %q", m.Path, m.Field, field.Name, name, m.Name)
+			}
+		}
+	}
+}
+
+func TestElementMetadataByPath(t *testing.T) {
+	tests := []struct {
+		path  string
+		check func(*r5.ElementMetadata) bool
+	}{
+		{"Patient.contact.name", func(m *r5.ElementMetadata) bool {
+			return m.Max == "1" && reflect.DeepEqual(m.Types, []string{"HumanName"})
+		}},
+		{"Patient.active", func(m *r5.ElementMetadata) bool { return m.Summary && m.Modifier }},
+		{"Observation.status", func(m *r5.ElementMetadata) bool {
+			return m.Min == 1 && m.Binding != nil && m.Binding.Strength == "required" &&
+				m.Binding.ValueSet == "http://hl7.org/fhir/ValueSet/observation-status"
+		}},
+		{"Observation.subject", func(m *r5.ElementMetadata) bool {
+			return len(m.TargetProfiles) > 0 && m.TargetProfiles[0] == "http://hl7.org/fhir/StructureDefinition/Patient"
+		}},
+		{"Observation.value[x]", func(m *r5.ElementMetadata) bool { return m.Name == "value" && len(m.Types) > 1 }},
+		{"Questionnaire.item.item", func(m *r5.ElementMetadata) bool { return m.Max == "*" && len(m.Types) == 1 }},
+	}
+	for _, tt := range tests {
+		m, ok := r5.ElementMetadataByPath(tt.path)
+		if !ok {
+			t.Errorf("ElementMetadataByPath(%s) found nothing", tt.path)
+			continue
+		}
+		if m.Path != tt.path || !tt.check(m) {
+			t.Errorf("ElementMetadataByPath(%s) = %+v", tt.path, *m)
+		}
+	}
+	if _, ok := r5.ElementMetadataByPath("Patient.unknown"); ok {
+		t.Error("ElementMetadataByPath(Patient.unknown) found an element")
+	}
+}
@@ -595,3 +595,100 @@ func TestGenerator_ValidateMethodWorks(t *testing.T) {
 		t.Errorf("parseGeneratedCode() error = %v, code should be valid Go", err)
 	}
 }
+
+func TestGenerator_DeterministicOutput(t *testing.T) {
+	var dirs []string
+	for run := 0; run < 2; run++ {
+		outputDir, cleanup, err := createTempOutputDir()
+		if err != nil {
+			t.Fatalf("createTempOutputDir() error = %v", err)
+		}
+		defer cleanup()
+
+		g := gen.NewGenerator("", outputDir)
+		for _, name := range []string{"documentreference", "observation", "patient"} {
+			spec, err := loadTestSpec(name)
+			if err != nil {
+				t.Fatalf("loadTestSpec() error = %v", err)
+			}
+			g.Definitions[spec.Name] = spec
+		}
+		if err := g.Generate(); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		dirs = append(dirs, outputDir)
+	}
+
+	entries, err := os.ReadDir(dirs[0])
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	for _, entry := range entries {
+		first, err := os.ReadFile(filepath.Join(dirs[0], entry.Name()))
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		second, err := os.ReadFile(filepath.Join(dirs[1], entry.Name()))
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		if string(first) != string(second) {
+			t.Errorf("%s differs between runs", entry.Name())
+		}
+	}
+}
+
+func TestGenerator_Options(t *testing.T) {
+	outputDir, cleanup, err := createTempOutputDir()
+	if err != nil {
+		t.Fatalf("createTempOutputDir() error = %v", err)
+	}
+	defer cleanup()
+
+	if err := createGoMod(outputDir); err != nil {
+		t.Fatalf("createGoMod() error = %v", err)
+	}
+
+	g := gen.NewGenerator("", outputDir)
+	for _, name := range []string{"simple_resource", "nested_structures"} {
+		spec, err := loadTestSpec(name)
+		if err != nil {
+			t.Fatalf("loadTestSpec() error = %v", err)
+		}
+		g.Definitions[spec.Name] = spec
+	}
+	g.Options = gen.Options{
+		PackageName: "fhir",
+		Resources:   []string{"SimpleTestResource"},
+	}
+
+	if err := g.Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(outputDir, "nested_structures_test_resource.go")); !os.IsNotExist(err) {
+		t.Error("a resource left out of Resources should not be generated")
+	}
+	generatedCode, err := os.ReadFile(filepath.Join(outputDir, "simple_test_resource.go"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	code := string(generatedCode)
+	if !strings.HasPrefix(code, "package fhir\n") {
+		t.Error("generated code should be in package fhir")
+	}
+	for _, unwanted := range []string{"bson:", "MarshalXML", "is required"} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("generated code should not contain %q", unwanted)
+		}
+	}
+
+	if err := compileGeneratedCode(outputDir); err != nil {
+		t.Fatalf("compileGeneratedCode() error = %v", err)
+	}
+
+	g.Options.Resources = []string{"UnknownResource"}
+	if err := g.Generate(); err == nil {
+		t.Error("Generate() should reject an unknown resource")
+	}
+}
@@ -0,0 +1,656 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+func loadGolden(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "golden", name))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	return data
+}
+
+func assertJSONEqual(t *testing.T, want, got []byte) {
+	t.Helper()
+
+	var wantTree, gotTree any
+	if err := json.Unmarshal(want, &wantTree); err != nil {
+		t.Fatalf("unmarshal want: %v", err)
+	}
+	if err := json.Unmarshal(got, &gotTree); err != nil {
+		t.Fatalf("unmarshal got: %v", err)
+	}
+	if !reflect.DeepEqual(wantTree, gotTree) {
+		t.Errorf("round-trip mismatch\nwant: %s\ngot:  %s", want, got)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestGolden_ExtensionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		resource interface{ Validate() error }
+	}{
+		{
+			name:     "Patient with US Core extensions",
+			file:     "patient_extensions.json",
+			resource: &r5.Patient{},
+		},
+		{
+			name:     "Observation with nested element extensions",
+			file:     "observation_extensions.json",
+			resource: &r5.Observation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := loadGolden(t, tt.file)
+
+			if err := json.Unmarshal(data, tt.resource); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if err := tt.resource.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+
+			out, err := json.Marshal(tt.resource)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			assertJSONEqual(t, data, out)
+		})
+	}
+}
+
+func TestGolden_ExtensionValidation(t *testing.T) {
+	var patient r5.Patient
+	if err := json.Unmarshal(loadGolden(t, "patient_extensions.json"), &patient); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if len(patient.Extension) != 3 {
+		t.Fatalf("len(Extension) = %d, want 3", len(patient.Extension))
+	}
+	if got := patient.Extension[0].Extension[0].Url; got != "ombCategory" {
+		t.Errorf("nested extension url = %q, want ombCategory", got)
+	}
+
+	patient.ModifierExtension[0].Url = ""
+	if err := patient.Validate(); err == nil {
+		t.Error("Validate() should reject a modifierExtension without url")
+	}
+}
+
+func TestGolden_PrimitiveElementExtensions(t *testing.T) {
+	data := loadGolden(t, "patient_primitive_extensions.json")
+
+	var patient r5.Patient
+	if err := json.Unmarshal(data, &patient); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := patient.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	if patient.BirthDate != nil {
+		t.Errorf("BirthDate = %v, want nil", *patient.BirthDate)
+	}
+	if patient.BirthDateElement == nil || len(patient.BirthDateElement.Extension) != 1 {
+		t.Fatal("BirthDateElement should carry the data-absent-reason extension")
+	}
+	if patient.ActiveElement == nil || patient.ActiveElement.Id == nil || *patient.ActiveElement.Id != "active-flag" {
+		t.Error("ActiveElement id not decoded")
+	}
+
+	name := patient.Name[0]
+	if len(name.Given) != 3 || len(name.GivenElement) != 3 {
+		t.Fatalf("len(Given) = %d, len(GivenElement) = %d, want 3 and 3", len(name.Given), len(name.GivenElement))
+	}
+	if name.GivenElement[0] != nil {
+		t.Error("GivenElement[0] should be nil")
+	}
+	if got := name.GivenElement[1].Extension[0].Url; got != "http://hl7.org/fhir/StructureDefinition/data-absent-reason" {
+		t.Errorf("GivenElement[1] extension url = %q", got)
+	}
+
+	out, err := json.Marshal(&patient)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+}
+
+func TestGolden_PrimitiveElementExtensionsOmitted(t *testing.T) {
+	name := r5.HumanName{
+		Given:        []string{"Amy", "Lee"},
+		GivenElement: []*r5.Element{nil, nil},
+	}
+
+	out, err := json.Marshal(name)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, []byte(`{"given":["Amy","Lee"]}`), out)
+}
+
+func TestGolden_PrimitiveElementExtensionsNonString(t *testing.T) {
+	data := []byte(`{
+		"sequence": 1,
+		"careTeamSequence": [1, null, 0],
+		"_careTeamSequence": [null, {"extension": [{"url": "http://example.org/absent", "valueBoolean": true}]}, null]
+	}`)
+
+	var item r5.ClaimItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(item.CareTeamSequence, []int{1, 0, 0}) {
+		t.Errorf("CareTeamSequence = %v, want [1 0 0]", item.CareTeamSequence)
+	}
+
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+
+	item.CareTeamSequence = append(item.CareTeamSequence, 4)
+	out, err = json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"careTeamSequence":[1,null,0,4]`) {
+		t.Errorf("Marshal() after append = %s, want careTeamSequence [1,null,0,4]", out)
+	}
+}
+
+func TestGolden_PrimitiveElementLengthMismatch(t *testing.T) {
+	data := []byte(`{"given": ["Amy", "Lee"], "_given": [{"id": "a"}]}`)
+
+	var name r5.HumanName
+	err := json.Unmarshal(data, &name)
+	if err == nil || !strings.Contains(err.Error(), "given:") {
+		t.Errorf("Unmarshal() error = %v, want a length mismatch on given", err)
+	}
+}
+
+func TestGolden_DecimalPrecision(t *testing.T) {
+	data := loadGolden(t, "observation_decimal.json")
+
+	var obs r5.Observation
+	if err := json.Unmarshal(data, &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := obs.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	quantity, ok := obs.Value.(r5.ObservationValueQuantity)
+	if !ok {
+		t.Fatalf("Value = %T, want r5.ObservationValueQuantity", obs.Value)
+	}
+	if got := quantity.Value.String(); got != "1.50" {
+		t.Errorf("Value.Value = %s, want 1.50", got)
+	}
+	if got := quantity.Value.Precision(); got != 2 {
+		t.Errorf("Value.Value.Precision() = %d, want 2", got)
+	}
+
+	out, err := json.Marshal(&obs)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+	for _, literal := range []string{`"value":1.50`, `"value":3.10`, `"value":6.200`, `"valueDecimal":0.99999999999999999999`} {
+		if !strings.Contains(string(out), literal) {
+			t.Errorf("Marshal() output lost precision, want %s in %s", literal, out)
+		}
+	}
+}
+
+func TestGolden_TemporalPrecision(t *testing.T) {
+	data := loadGolden(t, "observation_temporal.json")
+
+	var obs r5.Observation
+	if err := json.Unmarshal(data, &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := obs.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	value, ok := obs.Value.(r5.ObservationValueDateTime)
+	if !ok {
+		t.Fatalf("Value = %T, want r5.ObservationValueDateTime", obs.Value)
+	}
+	if got := value.Precision(); got != r5.PrecisionMonth {
+		t.Errorf("Value.Precision() = %v, want month", got)
+	}
+	start, end, err := value.Range()
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if start.Month() != time.February || end.Month() != time.March {
+		t.Errorf("Range() = [%v, %v), want February 2015", start, end)
+	}
+
+	issued, err := obs.Issued.Time()
+	if err != nil {
+		t.Fatalf("Issued.Time() error = %v", err)
+	}
+	effectiveDateTime, ok := obs.Effective.(r5.ObservationEffectiveDateTime)
+	if !ok {
+		t.Fatalf("Effective = %T, want r5.ObservationEffectiveDateTime", obs.Effective)
+	}
+	effective, err := effectiveDateTime.Time()
+	if err != nil {
+		t.Fatalf("Effective.Time() error = %v", err)
+	}
+	if got := issued.Sub(effective); got != 7*time.Hour {
+		t.Errorf("issued - effective = %v, want 7h", got)
+	}
+
+	out, err := json.Marshal(&obs)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+}
+
+func TestGolden_TemporalValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"invalid effectiveDateTime", `"effectiveDateTime": "2015-02-30"`},
+		{"instant without offset", `"issued": "2015-02-07T13:28:17"`},
+		{"time with offset", `"valueTime": "13:28:17Z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `{"resourceType": "Observation", "status": "final", "code": {"text": "x"}, ` + tt.patch + `}`
+
+			var obs r5.Observation
+			if err := json.Unmarshal([]byte(data), &obs); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if err := obs.Validate(); err == nil {
+				t.Error("Validate() should reject the value")
+			}
+		})
+	}
+}
+
+func TestGolden_RequiredBindingEnums(t *testing.T) {
+	var obs r5.Observation
+	if err := json.Unmarshal(loadGolden(t, "observation_extensions.json"), &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if obs.Status != r5.ObservationStatusFinal {
+		t.Errorf("Status = %q, want %q", obs.Status, r5.ObservationStatusFinal)
+	}
+
+	obs.Status = "Final"
+	err := obs.Validate()
+	if err == nil || !strings.Contains(err.Error(), "invalid code 'Final'") {
+		t.Errorf("Validate() error = %v, want invalid code error", err)
+	}
+
+	obs.Status = r5.ObservationStatusAmended
+	if err := obs.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestGolden_PolymorphicResources(t *testing.T) {
+	data := loadGolden(t, "bundle_polymorphic.json")
+
+	res, err := r5.UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	bundle, ok := res.(*r5.Bundle)
+	if !ok {
+		t.Fatalf("UnmarshalResource() = %T, want *r5.Bundle", res)
+	}
+	if err := bundle.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	patient, ok := bundle.Entry[0].Resource.(*r5.Patient)
+	if !ok {
+		t.Fatalf("Entry[0].Resource = %T, want *r5.Patient", bundle.Entry[0].Resource)
+	}
+	org, ok := patient.Contained[0].(*r5.Organization)
+	if !ok {
+		t.Fatalf("Contained[0] = %T, want *r5.Organization", patient.Contained[0])
+	}
+	if org.Name == nil || *org.Name != "Acme Clinic" {
+		t.Errorf("contained organization name = %v", org.Name)
+	}
+	if _, ok := bundle.Entry[1].Resource.(*r5.Observation); !ok {
+		t.Errorf("Entry[1].Resource = %T, want *r5.Observation", bundle.Entry[1].Resource)
+	}
+	params, ok := bundle.Entry[2].Resource.(*r5.Parameters)
+	if !ok {
+		t.Fatalf("Entry[2].Resource = %T, want *r5.Parameters", bundle.Entry[2].Resource)
+	}
+	if got := params.Parameter[0].Resource.GetResourceType(); got != "Patient" {
+		t.Errorf("parameter resource type = %s, want Patient", got)
+	}
+
+	out, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+}
+
+func TestGolden_PolymorphicResourceErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"missing resourceType", `{"id": "x"}`},
+		{"unknown resourceType", `{"resourceType": "Unicorn"}`},
+		{"unknown contained resourceType", `{"resourceType": "Patient", "contained": [{"resourceType": "Unicorn"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := r5.UnmarshalResource([]byte(tt.data)); err == nil {
+				t.Error("UnmarshalResource() expected error")
+			}
+		})
+	}
+
+	if _, ok := r5.NewResource("Patient"); !ok {
+		t.Error("NewResource(Patient) should be registered")
+	}
+	if _, ok := r5.NewResource("DomainResource"); ok {
+		t.Error("abstract resources should not be registered")
+	}
+}
+
+func TestGolden_ResourceAccessors(t *testing.T) {
+	data := loadGolden(t, "bundle_polymorphic.json")
+
+	res, err := r5.UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	bundle := res.(*r5.Bundle)
+
+	var domain []r5.DomainResource
+	for _, entry := range bundle.Entry {
+		if dr, ok := entry.Resource.(r5.DomainResource); ok {
+			domain = append(domain, dr)
+		}
+	}
+	if len(domain) != 2 {
+		t.Fatalf("got %d domain resources, want 2 (Parameters is not a DomainResource)", len(domain))
+	}
+	if got := len(domain[0].GetContained()); got != 1 {
+		t.Errorf("GetContained() returned %d resources, want 1", got)
+	}
+
+	for _, entry := range bundle.Entry {
+		r := entry.Resource
+		r.SetID("renamed")
+		if got := r.GetID(); got != "renamed" {
+			t.Errorf("%s GetID() = %q, want renamed", r.GetResourceType(), got)
+		}
+		r.SetMeta(&r5.Meta{})
+		if r.GetMeta() == nil {
+			t.Errorf("%s GetMeta() = nil after SetMeta", r.GetResourceType())
+		}
+	}
+
+	var empty r5.Patient
+	if empty.GetID() != "" {
+		t.Error("GetID() on a resource without id should be empty")
+	}
+	empty.SetID("")
+	if empty.Id != nil {
+		t.Error("SetID(\"\") should clear the id")
+	}
+}
+
+func TestGolden_ChoiceTypes(t *testing.T) {
+	data := loadGolden(t, "observation_choice.json")
+
+	var obs r5.Observation
+	if err := json.Unmarshal(data, &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := obs.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	if got, ok := obs.Value.(r5.ObservationValueString); !ok || got != "see components" {
+		t.Errorf("Value = %#v, want ObservationValueString", obs.Value)
+	}
+	if obs.ValueElement == nil || len(obs.ValueElement.Extension) != 1 {
+		t.Errorf("ValueElement = %+v, want the _valueString extension", obs.ValueElement)
+	} else if _, ok := obs.ValueElement.Extension[0].Value.(r5.ExtensionValueBoolean); !ok {
+		t.Errorf("extension Value = %T, want r5.ExtensionValueBoolean", obs.ValueElement.Extension[0].Value)
+	}
+	if _, ok := obs.Effective.(r5.ObservationEffectivePeriod); !ok {
+		t.Errorf("Effective = %T, want r5.ObservationEffectivePeriod", obs.Effective)
+	}
+	if _, ok := obs.Component[0].Value.(r5.ObservationComponentValueQuantity); !ok {
+		t.Errorf("Component[0].Value = %T, want r5.ObservationComponentValueQuantity", obs.Component[0].Value)
+	}
+	if got := obs.Component[1].Value.FHIRType(); got != "CodeableConcept" {
+		t.Errorf("Component[1].Value.FHIRType() = %s, want CodeableConcept", got)
+	}
+
+	out, err := json.Marshal(&obs)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+}
+
+func TestGolden_ChoiceTypesExtensionOnly(t *testing.T) {
+	data := loadGolden(t, "patient_choice_extension.json")
+
+	var patient r5.Patient
+	if err := json.Unmarshal(data, &patient); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := patient.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if patient.Deceased != r5.PatientDeceasedBoolean(false) {
+		t.Errorf("Deceased = %#v, want the zero PatientDeceasedBoolean", patient.Deceased)
+	}
+	if patient.DeceasedElement == nil || patient.DeceasedElement.Id == nil || *patient.DeceasedElement.Id != "e" {
+		t.Fatalf("DeceasedElement = %+v, want the _deceasedBoolean element", patient.DeceasedElement)
+	}
+
+	out, err := json.Marshal(&patient)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, data, out)
+}
+
+func TestGolden_ChoiceTypesBuild(t *testing.T) {
+	obs := r5.Observation{
+		ResourceType: "Observation",
+		Status:       r5.ObservationStatusFinal,
+		Code:         &r5.CodeableConcept{Text: ptr("Body weight")},
+		Value: r5.ObservationValueQuantity{Quantity: r5.Quantity{
+			Value: ptr(r5.MustParseDecimal("72.50")),
+			Unit:  ptr("kg"),
+		}},
+	}
+	if err := obs.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	out, err := json.Marshal(obs)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"valueQuantity":{"value":72.50,"unit":"kg"}`) {
+		t.Errorf("Marshal() = %s, want valueQuantity", out)
+	}
+}
+
+func TestGolden_ChoiceTypesMultipleVariants(t *testing.T) {
+	data := `{"resourceType": "Observation", "status": "final", "code": {"text": "x"}, "valueString": "a", "valueBoolean": true}`
+
+	var obs r5.Observation
+	if err := json.Unmarshal([]byte(data), &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	err := obs.Validate()
+	if err == nil {
+		t.Fatal("Validate() should reject more than one value[x] variant")
+	}
+	if !strings.Contains(err.Error(), "valueString") || !strings.Contains(err.Error(), "valueBoolean") {
+		t.Errorf("Validate() error = %v, want both properties named", err)
+	}
+}
+
+func TestGolden_ValidateAll(t *testing.T) {
+	data := loadGolden(t, "patient_invalid.json")
+
+	res, err := r5.UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	if err := res.Validate(); err == nil {
+		t.Fatal("Validate() should fail")
+	}
+
+	issues := res.ValidateAll()
+	got := make(map[string]string)
+	warnings := make(map[string]bool)
+	for _, issue := range issues {
+		switch issue.Severity {
+		case "error":
+			got[issue.Path] = issue.Code
+		case "warning":
+			warnings[issue.Path+" "+issue.Message[:strings.Index(issue.Message, ":")]] = true
+		default:
+			t.Errorf("issue %v has severity %s", issue, issue.Severity)
+		}
+	}
+	want := map[string]string{
+		"Patient":                      "invariant",
+		"Patient.contained[0].status":  "required",
+		"Patient.contained[0].code":    "required",
+		"Patient.identifier[1].use":    "code-invalid",
+		"Patient.name[0].period.start": "value",
+		"Patient.gender":               "code-invalid",
+		"Patient.birthDate":            "value",
+		"Patient.deceased":             "structure",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateAll() paths = %v, want %v", got, want)
+	}
+	wantWarnings := map[string]bool{
+		"Patient dom-6":               true,
+		"Patient.contained[0] dom-6":  true,
+		"Patient.contained[0] obs-12": true,
+	}
+	if !reflect.DeepEqual(warnings, wantWarnings) {
+		t.Errorf("ValidateAll() warnings = %v, want %v", warnings, wantWarnings)
+	}
+	if issues.Err() == nil {
+		t.Error("Err() = nil, want error")
+	}
+
+	outcome := issues.OperationOutcome()
+	if err := outcome.Validate(); err != nil {
+		t.Errorf("OperationOutcome().Validate() error = %v", err)
+	}
+	if len(outcome.Issue) != len(issues) {
+		t.Fatalf("OperationOutcome() has %d issues, want %d", len(outcome.Issue), len(issues))
+	}
+	first := outcome.Issue[0]
+	if first.Severity != r5.IssueSeverityError || first.Code != issues[0].Code ||
+		len(first.Expression) != 1 || first.Expression[0] != issues[0].Path {
+		t.Errorf("OperationOutcome().Issue[0] = %+v, want %+v", first, issues[0])
+	}
+}
+
+func TestGolden_ValidateAllClean(t *testing.T) {
+	data := loadGolden(t, "observation_choice.json")
+
+	var obs r5.Observation
+	if err := json.Unmarshal(data, &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	issues := obs.ValidateAll()
+	if len(issues) != 0 {
+		t.Fatalf("ValidateAll() = %v, want no issues", issues)
+	}
+
+	outcome := issues.OperationOutcome()
+	if len(outcome.Issue) != 1 || outcome.Issue[0].Severity != r5.IssueSeverityInformation {
+		t.Errorf("OperationOutcome() = %+v, want a single informational issue", outcome.Issue)
+	}
+}
+
+func TestGolden_Invariants(t *testing.T) {
+	data := loadGolden(t, "observation_choice.json")
+
+	var obs r5.Observation
+	if err := json.Unmarshal(data, &obs); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	obs.DataAbsentReason = &r5.CodeableConcept{Text: ptr("not performed")}
+	err := obs.Validate()
+	if err == nil || !strings.Contains(err.Error(), "obs-6") {
+		t.Fatalf("Validate() error = %v, want obs-6", err)
+	}
+
+	obs.DataAbsentReason = nil
+	obs.Performer = nil
+	if err := obs.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, warnings should not fail", err)
+	}
+
+	obs.Component[0].Value = r5.ObservationComponentValueQuantity{Quantity: r5.Quantity{
+		Value: ptr(r5.MustParseDecimal("120")),
+		Code:  ptr("mm[Hg]"),
+	}}
+
+	issues := obs.ValidateAll()
+	want := []string{
+		"warning Observation: obs-12: All observations SHOULD have a performer",
+		"error Observation.component[0].value.ofType(Quantity): qty-3: If a code for the unit is present, the system SHALL also be present",
+	}
+	var got []string
+	for _, issue := range issues {
+		if issue.Code != "invariant" {
+			t.Errorf("issue %v has code %s, want invariant", issue, issue.Code)
+		}
+		got = append(got, issue.Severity+" "+issue.Path+": "+issue.Message)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateAll() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/gruzdev-dev/fhir/fhirpath"
+)
+
+// TestInvariants_Compile compiles the FHIRPath expression of every
+// invariant and profile constraint generated into r5, so that a constraint
+// using syntax or a function the engine does not support fails here rather
+// than being reported as unchecked at validation time.
+func TestInvariants_Compile(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "r5", "*.go"))
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+
+	fset := token.NewFileSet()
+	count := 0
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatalf("ParseFile(%s) error = %v", file, err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			array, ok := lit.Type.(*ast.ArrayType)
+			if !ok {
+				return true
+			}
+			elem, ok := array.Elt.(*ast.Ident)
+			if !ok || (elem.Name != "invariant" && elem.Name != "ProfileConstraint") {
+				return true
+			}
+			for _, item := range lit.Elts {
+				key, expression := constraintFields(item)
+				if expression == "" {
+					continue
+				}
+				count++
+				if _, err := fhirpath.Compile(expression); err != nil {
+					t.Errorf("%s: %s: %v", fset.Position(item.Pos()), key, err)
+				}
+			}
+			return false
+		})
+	}
+	if count == 0 {
+		t.Fatal("found no invariants in r5")
+	}
+}
+
+// constraintFields returns the key and expression of an invariant or
+// ProfileConstraint literal.
+func constraintFields(item ast.Expr) (key, expression string) {
+	lit, ok := item.(*ast.CompositeLit)
+	if !ok {
+		return "", ""
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		name, _ := kv.Key.(*ast.Ident)
+		value, _ := kv.Value.(*ast.BasicLit)
+		if name == nil || value == nil || value.Kind != token.STRING {
+			continue
+		}
+		s, err := strconv.Unquote(value.Value)
+		if err != nil {
+			continue
+		}
+		switch name.Name {
+		case "key", "Key":
+			key = s
+		case "expression", "Expression":
+			expression = s
+		}
+	}
+	return key, expression
+}
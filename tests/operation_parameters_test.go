@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+func TestOperationParameters_ExpandRoundTrip(t *testing.T) {
+	in := r5.ExpandIn{
+		Url:         ptr("http://hl7.org/fhir/ValueSet/observation-status"),
+		Count:       ptr(10),
+		Designation: []string{"en", "de"},
+		TxResource:  []r5.Resource{&r5.CodeSystem{ResourceType: "CodeSystem", Id: ptr("local")}},
+	}
+	params, err := in.ToParameters()
+	if err != nil {
+		t.Fatalf("ToParameters() error = %v", err)
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, want := range []string{
+		`{"name":"url","valueUri":"http://hl7.org/fhir/ValueSet/observation-status"}`,
+		`{"name":"count","valueInteger":10}`,
+		`{"name":"designation","valueString":"de"}`,
+		`{"name":"tx-resource","resource":{"resourceType":"CodeSystem","id":"local"`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Parameters JSON %s does not contain %s", data, want)
+		}
+	}
+
+	var decoded r5.Parameters
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	var got r5.ExpandIn
+	if err := got.FromParameters(&decoded); err != nil {
+		t.Fatalf("FromParameters() error = %v", err)
+	}
+	if got.Url == nil || *got.Url != *in.Url || got.Count == nil || *got.Count != 10 {
+		t.Errorf("FromParameters() Url = %v, Count = %v", got.Url, got.Count)
+	}
+	if strings.Join(got.Designation, ",") != "en,de" {
+		t.Errorf("FromParameters() Designation = %v, want [en de]", got.Designation)
+	}
+	if len(got.TxResource) != 1 || got.TxResource[0].GetID() != "local" {
+		t.Errorf("FromParameters() TxResource = %v", got.TxResource)
+	}
+}
+
+func TestOperationParameters_LookupParts(t *testing.T) {
+	data := `{
+		"resourceType": "Parameters",
+		"parameter": [
+			{"name": "name", "valueString": "LOINC"},
+			{"name": "display", "valueString": "Body weight"},
+			{"name": "designation", "part": [
+				{"name": "language", "valueCode": "de"},
+				{"name": "value", "valueString": "Körpergewicht"}
+			]},
+			{"name": "property", "part": [
+				{"name": "code", "valueCode": "COMPONENT"},
+				{"name": "value", "valueCoding": {"system": "http://loinc.org", "code": "LP35925-4"}},
+				{"name": "subproperty", "part": [
+					{"name": "code", "valueCode": "scale"},
+					{"name": "value", "valueString": "Qn"}
+				]}
+			]},
+			{"name": "server-extension", "valueBoolean": true}
+		]
+	}`
+	var params r5.Parameters
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	var out r5.LookupOut
+	if err := out.FromParameters(&params); err != nil {
+		t.Fatalf("FromParameters() error = %v", err)
+	}
+	if out.Display == nil || *out.Display != "Body weight" {
+		t.Errorf("Display = %v, want Body weight", out.Display)
+	}
+	if len(out.Designation) != 1 || *out.Designation[0].Language != "de" || *out.Designation[0].Value != "Körpergewicht" {
+		t.Errorf("Designation = %+v", out.Designation)
+	}
+	if len(out.Property) != 1 || *out.Property[0].Code != "COMPONENT" {
+		t.Fatalf("Property = %+v", out.Property)
+	}
+	if coding, ok := out.Property[0].Value.(r5.ParametersParameterValueCoding); !ok || *coding.Code != "LP35925-4" {
+		t.Errorf("Property[0].Value = %#v, want the LP35925-4 Coding", out.Property[0].Value)
+	}
+	if sub := out.Property[0].Subproperty; len(sub) != 1 || sub[0].Value != r5.ParametersParameterValue(r5.ParametersParameterValueString("Qn")) {
+		t.Errorf("Subproperty = %+v", sub)
+	}
+
+	back, err := out.ToParameters()
+	if err != nil {
+		t.Fatalf("ToParameters() error = %v", err)
+	}
+	if len(back.Parameter) != 4 || back.Parameter[3].Name != "property" || len(back.Parameter[3].Part) != 3 {
+		t.Errorf("ToParameters() = %+v", back.Parameter)
+	}
+}
+
+func TestOperationParameters_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  []r5.ParametersParameter
+		wantErr string
+	}{
+		{"missing required", []r5.ParametersParameter{
+			{Name: "message", Value: r5.ParametersParameterValueString("unknown code")},
+		}, "parameter 'result' is required"},
+		{"wrong type", []r5.ParametersParameter{
+			{Name: "result", Value: r5.ParametersParameterValueString("true")},
+		}, "parameter 'result' must have a value of type boolean, got string"},
+		{"repeated", []r5.ParametersParameter{
+			{Name: "result", Value: r5.ParametersParameterValueBoolean(true)},
+			{Name: "result", Value: r5.ParametersParameterValueBoolean(false)},
+		}, "parameter 'result' must occur at most once"},
+		{"wrong resource", []r5.ParametersParameter{
+			{Name: "result", Value: r5.ParametersParameterValueBoolean(false)},
+			{Name: "issues", Resource: &r5.Patient{ResourceType: "Patient"}},
+		}, "parameter 'issues' must have a resource of type OperationOutcome, got Patient"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out r5.ValidateCodeOut
+			err := out.FromParameters(&r5.Parameters{ResourceType: "Parameters", Parameter: tt.params})
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("FromParameters() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+
+	lookupTests := []struct {
+		name    string
+		params  []r5.ParametersParameter
+		wantErr string
+	}{
+		{"missing display", []r5.ParametersParameter{
+			{Name: "name", Value: r5.ParametersParameterValueString("LOINC")},
+		}, "parameter 'display' is required"},
+		{"repeated name", []r5.ParametersParameter{
+			{Name: "name", Value: r5.ParametersParameterValueString("LOINC")},
+			{Name: "name", Value: r5.ParametersParameterValueString("SNOMED CT")},
+			{Name: "display", Value: r5.ParametersParameterValueString("Body weight")},
+		}, "parameter 'name' must occur at most once"},
+	}
+	for _, tt := range lookupTests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out r5.LookupOut
+			err := out.FromParameters(&r5.Parameters{ResourceType: "Parameters", Parameter: tt.params})
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("FromParameters() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+
+	var out r5.LookupOut
+	if _, err := out.ToParameters(); err == nil || err.Error() != "parameter 'name' is required" {
+		t.Errorf("ToParameters() error = %v, want parameter 'name' is required", err)
+	}
+	out = r5.LookupOut{Name: ptr("LOINC"), Display: ptr("Body weight"), Designation: []r5.LookupOutDesignation{{}}}
+	if _, err := out.ToParameters(); err == nil || err.Error() != "parameter 'designation'[0]: parameter 'value' is required" {
+		t.Errorf("ToParameters() error = %v, want the missing designation value", err)
+	}
+}
+
+var _ []r5.OperationParameters = []r5.OperationParameters{&r5.ExpandIn{}, &r5.ExpandOut{}, &r5.ValidateCodeIn{}, &r5.ApplyOut{}}
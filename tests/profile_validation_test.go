@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+const bloodPressureJSON = `{
+	"resourceType": "Observation",
+	"meta": {"profile": ["http://hl7.org/fhir/StructureDefinition/bp|5.0.0"]},
+	"status": "final",
+	"category": [{"coding": [{"system": "http://terminology.hl7.org/CodeSystem/observation-category", "code": "vital-signs"}]}],
+	"code": {"coding": [{"system": "http://loinc.org", "code": "85354-9", "display": "Blood pressure panel"}]},
+	"subject": {"reference": "Patient/example"},
+	"effectiveDateTime": "2024-09-17",
+	"component": [
+		{"code": {"coding": [{"system": "http://loinc.org", "code": "8480-6"}]},
+			"valueQuantity": {"value": 107, "unit": "mmHg", "system": "http://unitsofmeasure.org", "code": "mm[Hg]"}},
+		{"code": {"coding": [{"system": "http://loinc.org", "code": "8462-4"}]},
+			"valueQuantity": {"value": 60, "unit": "mmHg", "system": "http://unitsofmeasure.org", "code": "mm[Hg]"}}
+	]
+}`
+
+func unmarshalObservation(t *testing.T, data string) *r5.Observation {
+	t.Helper()
+	res, err := r5.UnmarshalResource([]byte(data))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	obs, ok := res.(*r5.Observation)
+	if !ok {
+		t.Fatalf("UnmarshalResource() = %T, want *Observation", res)
+	}
+	return obs
+}
+
+func TestProfile_BloodPressure(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*r5.Observation)
+		want   string
+	}{
+		{name: "conforms"},
+		{
+			name:   "other code",
+			modify: func(o *r5.Observation) { o.Code.Coding[0].Code = ptr("29463-7") },
+			want:   "Observation.code: profile Observationbp: value does not match the pattern",
+		},
+		{
+			name:   "one component",
+			modify: func(o *r5.Observation) { o.Component = o.Component[:1] },
+			want:   "Observation.component: profile Observationbp: minimum required = 2, but only found 1",
+		},
+		{
+			name:   "no subject",
+			modify: func(o *r5.Observation) { o.Subject = nil },
+			want:   "Observation.subject: profile Observationbp: minimum required = 1, but only found 0",
+		},
+		{
+			name:   "no category",
+			modify: func(o *r5.Observation) { o.Category = nil },
+			want:   "Observation.category: profile Observationbp: minimum required = 1, but only found 0",
+		},
+		{
+			name:   "no systolic",
+			modify: func(o *r5.Observation) { o.Component[0].Code.Coding[0].Code = ptr("8478-0") },
+			want:   "Observation.component: profile Observationbp: slice SystolicBP: minimum required = 1, but only found 0",
+		},
+		{
+			name: "systolic as text",
+			modify: func(o *r5.Observation) {
+				o.Component[0].Value = r5.ObservationComponentValueString("107/60")
+			},
+			want: "Observation.component[0].value.ofType(string): profile Observationbp: slice SystolicBP: item does not match any slice of Observation.component.value[x]",
+		},
+		{
+			name: "imprecise effective",
+			modify: func(o *r5.Observation) {
+				year, _ := r5.ParseDateTime("2024")
+				o.Effective = r5.ObservationEffectiveDateTime{DateTime: year}
+			},
+			want: "profile Observationbp: vsp-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obs := unmarshalObservation(t, bloodPressureJSON)
+			if tt.modify != nil {
+				tt.modify(obs)
+			}
+			issues := r5.ObservationbpProfile.Validate(obs)
+			if tt.want == "" {
+				if len(issues) > 0 {
+					t.Errorf("Validate() = %v, want no issues", issues)
+				}
+				return
+			}
+			if !strings.Contains(issues.Error(), tt.want) {
+				t.Errorf("Validate() = %v, want an issue containing %q", issues, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfile_ValidateProfiles(t *testing.T) {
+	obs := unmarshalObservation(t, bloodPressureJSON)
+	if issues := r5.ValidateProfiles(obs); len(issues) > 0 {
+		t.Errorf("ValidateProfiles() = %v, want no issues", issues)
+	}
+
+	obs.Component = nil
+	if issues := r5.ValidateProfiles(obs); len(issues) == 0 {
+		t.Error("ValidateProfiles() found no issues in a blood pressure without components")
+	}
+
+	obs.Meta.Profile = []string{"http://example.org/StructureDefinition/unknown"}
+	if issues := r5.ValidateProfiles(obs); len(issues) > 0 {
+		t.Errorf("ValidateProfiles() = %v, want unknown profiles to be skipped", issues)
+	}
+}
+
+func TestProfile_New(t *testing.T) {
+	res, err := r5.ObservationbpProfile.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	obs, ok := res.(*r5.Observation)
+	if !ok {
+		t.Fatalf("New() = %T, want *Observation", res)
+	}
+	if obs.Code == nil || len(obs.Code.Coding) != 1 || obs.Code.Coding[0].Code == nil || *obs.Code.Coding[0].Code != "85354-9" {
+		t.Errorf("New() code = %+v, want LOINC 85354-9", obs.Code)
+	}
+	if len(obs.Component) != 2 || *obs.Component[1].Code.Coding[0].Code != "8462-4" {
+		t.Errorf("New() component = %+v, want the systolic and diastolic slices", obs.Component)
+	}
+	if obs.Meta == nil || len(obs.Meta.Profile) != 1 || obs.Meta.Profile[0] != r5.ObservationbpProfile.URL {
+		t.Errorf("New() meta = %+v, want the profile URL", obs.Meta)
+	}
+}
+
+func TestProfile_Lookup(t *testing.T) {
+	profile, ok := r5.ProfileByURL("http://hl7.org/fhir/StructureDefinition/document-bundle")
+	if !ok || profile != r5.DocumentBundleProfile {
+		t.Fatalf("ProfileByURL() = %v, %v, want DocumentBundle", profile, ok)
+	}
+	var names []string
+	for _, p := range r5.ProfilesFor("Bundle") {
+		names = append(names, p.Name)
+	}
+	if !strings.Contains(strings.Join(names, ","), "TransactionBundle") {
+		t.Errorf("ProfilesFor(Bundle) = %v, want TransactionBundle", names)
+	}
+}
+
+func TestProfile_DocumentBundle(t *testing.T) {
+	res, err := r5.UnmarshalResource([]byte(`{"resourceType": "Bundle", "type": "collection",
+		"entry": [{"resource": {"resourceType": "Patient", "id": "p1"}}]}`))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	got := r5.DocumentBundleProfile.Validate(res).Error()
+	for _, want := range []string{
+		"Bundle.identifier: profile DocumentBundle: minimum required = 1",
+		"Bundle.type: profile DocumentBundle: value must be exactly \"document\"",
+		"Bundle.timestamp: profile DocumentBundle: minimum required = 1",
+		"Bundle.entry[0].fullUrl: profile DocumentBundle: minimum required = 1",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Validate() = %s, want an issue containing %q", got, want)
+		}
+	}
+}
+
+func TestProfile_TransactionBundleSlices(t *testing.T) {
+	res, err := r5.UnmarshalResource([]byte(`{"resourceType": "Bundle", "type": "transaction", "entry": [
+		{"fullUrl": "urn:uuid:1", "resource": {"resourceType": "Patient"}, "request": {"method": "POST", "url": "Patient"}},
+		{"resource": {"resourceType": "Patient", "id": "p2"}}
+	]}`))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	issues := r5.TransactionBundleProfile.Validate(res)
+	want := "Bundle.entry[1]: profile TransactionBundle: item does not match any slice of Bundle.entry"
+	if len(issues) != 1 || issues[0].String() != want {
+		t.Errorf("Validate() = %v, want %q", issues, want)
+	}
+}
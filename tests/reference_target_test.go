@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+func TestValidation_ReferenceTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject r5.Reference
+		wantErr string
+	}{
+		{"relative", r5.Reference{Reference: ptr("Patient/1")}, ""},
+		{"versioned", r5.Reference{Reference: ptr("Group/g1/_history/2")}, ""},
+		{"absolute", r5.Reference{Reference: ptr("http://example.org/fhir/Device/d1")}, ""},
+		{"urn:uuid", r5.Reference{Reference: ptr("urn:uuid:3f1a0e4c-8b2d-4c1e-9a6f-2d7b5e8c9f01")}, ""},
+		{"urn:uuid with type", r5.Reference{Reference: ptr("urn:uuid:3f1a0e4c-8b2d-4c1e-9a6f-2d7b5e8c9f01"), Type: ptr("Location")}, ""},
+		{"identifier with type", r5.Reference{Type: ptr("Practitioner"), Display: ptr("Dr. Smith")}, ""},
+		{"relative not a target", r5.Reference{Reference: ptr("Specimen/1")}, "Specimen"},
+		{"absolute not a target", r5.Reference{Reference: ptr("http://example.org/fhir/Encounter/e1")}, "Encounter"},
+		{"urn:uuid with type not a target", r5.Reference{Reference: ptr("urn:uuid:3f1a0e4c-8b2d-4c1e-9a6f-2d7b5e8c9f01"), Type: ptr("Specimen")}, "Specimen"},
+		{"type not a target", r5.Reference{Type: ptr("http://hl7.org/fhir/StructureDefinition/Encounter"), Display: ptr("Admission")}, "Encounter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject := tt.subject
+			obs := &r5.Observation{
+				ResourceType: "Observation",
+				Status:       r5.ObservationStatusFinal,
+				Code:         &r5.CodeableConcept{Text: ptr("Body weight")},
+				Subject:      &subject,
+			}
+			err := obs.Validate()
+			issues := valueIssues(obs.ValidateAll())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				if len(issues) > 0 {
+					t.Errorf("ValidateAll() value issues = %v", issues)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), "cannot refer to "+tt.wantErr) {
+				t.Errorf("Validate() error = %v, want a reference to %s rejected", err, tt.wantErr)
+			}
+			if len(issues) != 1 || issues[0].Code != "value" || issues[0].Path != "Observation.subject" {
+				t.Errorf("ValidateAll() value issues = %v, want one at Observation.subject", issues)
+			}
+		})
+	}
+}
+
+func TestValidation_ReferenceTargetsRepeating(t *testing.T) {
+	obs := &r5.Observation{
+		ResourceType: "Observation",
+		Status:       r5.ObservationStatusFinal,
+		Code:         &r5.CodeableConcept{Text: ptr("Body weight")},
+		Performer: []r5.Reference{
+			{Reference: ptr("Practitioner/1")},
+			{Reference: ptr("Device/1")},
+		},
+	}
+	issues := valueIssues(obs.ValidateAll())
+	if len(issues) != 1 || issues[0].Path != "Observation.performer[1]" {
+		t.Errorf("ValidateAll() value issues = %v, want one at Observation.performer[1]", issues)
+	}
+}
+
+// valueIssues leaves out the issues of invariants, which the resources of
+// these tests do not all satisfy.
+func valueIssues(issues r5.ValidationIssues) r5.ValidationIssues {
+	var values r5.ValidationIssues
+	for _, issue := range issues {
+		if issue.Code == "value" {
+			values = append(values, issue)
+		}
+	}
+	return values
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+func TestTurtle_Example(t *testing.T) {
+	data := loadGolden(t, "patient_example.ttl")
+
+	res, err := r5.UnmarshalResourceTurtle(data)
+	if err != nil {
+		t.Fatalf("UnmarshalResourceTurtle() error = %v", err)
+	}
+	if err := res.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	asJSON, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	assertJSONEqual(t, loadGolden(t, "patient_example.json"), asJSON)
+
+	out, err := r5.MarshalResourceTurtle(res, "")
+	if err != nil {
+		t.Fatalf("MarshalResourceTurtle() error = %v", err)
+	}
+	if string(out) != string(data) {
+		t.Errorf("MarshalResourceTurtle() =\n%s\nwant\n%s", out, data)
+	}
+}
+
+func TestTurtle_GoldenRoundTrip(t *testing.T) {
+	files := []string{
+		"bundle_polymorphic.json",
+		"observation_choice.json",
+		"observation_contained.json",
+		"observation_decimal.json",
+		"observation_extensions.json",
+		"observation_temporal.json",
+		"patient_choice_extension.json",
+		"patient_extensions.json",
+		"patient_primitive_extensions.json",
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			data := loadGolden(t, file)
+			res, err := r5.UnmarshalResource(data)
+			if err != nil {
+				t.Fatalf("UnmarshalResource() error = %v", err)
+			}
+
+			out, err := r5.MarshalResourceTurtle(res, "")
+			if err != nil {
+				t.Fatalf("MarshalResourceTurtle() error = %v", err)
+			}
+			back, err := r5.UnmarshalResourceTurtle(out)
+			if err != nil {
+				t.Fatalf("UnmarshalResourceTurtle() error = %v\n%s", err, out)
+			}
+
+			asJSON, err := json.Marshal(back)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			assertJSONEqual(t, data, asJSON)
+		})
+	}
+}
+
+func TestTurtle_Literals(t *testing.T) {
+	res, err := r5.UnmarshalResource(loadGolden(t, "observation_contained.json"))
+	if err != nil {
+		t.Fatalf("UnmarshalResource() error = %v", err)
+	}
+	obs := res.(*r5.Observation)
+	obs.Subject = &r5.Reference{Reference: ptr("Patient/pat")}
+	obs.Focus = []r5.Reference{{Reference: ptr("urn:uuid:9d4f4c1e-6f4b-4c8e-9c57-0d7a3f1f6e2a")}}
+
+	out, err := r5.MarshalResourceTurtle(obs, "https://fhir.example.org/r5")
+	if err != nil {
+		t.Fatalf("MarshalResourceTurtle() error = %v", err)
+	}
+	for _, want := range []string{
+		"<https://fhir.example.org/r5/Observation/contained> a fhir:Observation ;",
+		"fhir:link <https://fhir.example.org/r5/Patient/pat> ;\n    fhir:reference [ fhir:v \"Patient/pat\" ]",
+		"fhir:link <urn:uuid:9d4f4c1e-6f4b-4c8e-9c57-0d7a3f1f6e2a> ;",
+		"fhir:device [ fhir:reference [ fhir:v \"#dev\" ] ]",
+		`fhir:issued [ fhir:v "2024-03-01T09:00:00Z"^^xsd:dateTime ]`,
+		`fhir:value [ fhir:v "72.0"^^xsd:decimal ]`,
+		`fhir:system [ fhir:v "http://loinc.org"^^xsd:anyURI ]`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("MarshalResourceTurtle() =\n%s\nmissing %q", out, want)
+		}
+	}
+}
+
+func TestTurtle_UnmarshalResourceErrors(t *testing.T) {
+	tests := map[string]string{
+		"empty":         ``,
+		"unknown type":  `@prefix fhir: <http://hl7.org/fhir/> . [] a fhir:Unknown ; fhir:nodeRole fhir:treeRoot .`,
+		"syntax":        `@prefix fhir: <http://hl7.org/fhir/> . [] a fhir:Patient ; fhir:active [ fhir:v true ] `,
+		"invalid value": `@prefix fhir: <http://hl7.org/fhir/> . [] a fhir:Patient ; fhir:active [ fhir:v "maybe" ] .`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if res, err := r5.UnmarshalResourceTurtle([]byte(data)); err == nil {
+				t.Errorf("UnmarshalResourceTurtle() = %+v, want error", res)
+			}
+		})
+	}
+}
@@ -32,6 +32,9 @@ func TestValidation_RequiredFields(t *testing.T) {
 	if err := g.WriteResource(spec); err != nil {
 		t.Fatalf("WriteResource() error = %v", err)
 	}
+	if err := g.WriteRuntime(); err != nil {
+		t.Fatalf("WriteRuntime() error = %v", err)
+	}
 
 	fileName := "simple_test_resource.go"
 	filePath := filepath.Join(outputDir, fileName)
@@ -128,6 +131,9 @@ func TestValidation_EmptyStructures(t *testing.T) {
 	if err := g.WriteResource(emptySpec); err != nil {
 		t.Fatalf("WriteResource() error = %v", err)
 	}
+	if err := g.WriteRuntime(); err != nil {
+		t.Fatalf("WriteRuntime() error = %v", err)
+	}
 
 	fileName := "empty_test_resource.go"
 	filePath := filepath.Join(outputDir, fileName)
@@ -152,8 +158,17 @@ func TestValidation_EmptyStructures(t *testing.T) {
 	}
 }
 
+// createGoMod makes outputDir a module of its own. The validation runtime
+// imports the FHIRPath hook of this module, which is replaced by the
+// checkout under test.
 func createGoMod(outputDir string) error {
-	modContent := "module testmodels\n\ngo 1.21\n"
+	root, err := filepath.Abs("..")
+	if err != nil {
+		return err
+	}
+	modContent := "module testmodels\n\ngo 1.21\n\n" +
+		"require github.com/gruzdev-dev/fhir v0.0.0\n\n" +
+		"replace github.com/gruzdev-dev/fhir => " + root + "\n"
 	return os.WriteFile(filepath.Join(outputDir, "go.mod"), []byte(modContent), 0644)
 }
 
@@ -223,6 +238,41 @@ func createPatternTestSpec() gen.StructureDefinition {
 	}
 }
 
+func createPatternValueTestSpec() gen.StructureDefinition {
+	return gen.StructureDefinition{
+		Name: "PatternValueTestResource",
+		Snapshot: gen.Snapshot{
+			Element: []gen.ElementDefinition{
+				{ID: "PatternValueTestResource", Path: "PatternValueTestResource", Min: 0, Max: "*"},
+				{
+					ID:           "PatternValueTestResource.code",
+					Path:         "PatternValueTestResource.code",
+					Min:          0,
+					Max:          "1",
+					Type:         []gen.ElementDataType{{Code: "BackboneElement"}},
+					PatternValue: map[string]any{"system": "http://loinc.org"},
+				},
+				{ID: "PatternValueTestResource.code.system", Path: "PatternValueTestResource.code.system", Min: 0, Max: "1",
+					Type: []gen.ElementDataType{{Code: "string"}}},
+				{ID: "PatternValueTestResource.code.code", Path: "PatternValueTestResource.code.code", Min: 0, Max: "1",
+					Type: []gen.ElementDataType{{Code: "string"}}},
+				{
+					ID:    "PatternValueTestResource.unit",
+					Path:  "PatternValueTestResource.unit",
+					Min:   0,
+					Max:   "1",
+					Type:  []gen.ElementDataType{{Code: "BackboneElement"}},
+					Fixed: map[string]any{"system": "http://unitsofmeasure.org", "code": "mm[Hg]"},
+				},
+				{ID: "PatternValueTestResource.unit.system", Path: "PatternValueTestResource.unit.system", Min: 0, Max: "1",
+					Type: []gen.ElementDataType{{Code: "string"}}},
+				{ID: "PatternValueTestResource.unit.code", Path: "PatternValueTestResource.unit.code", Min: 0, Max: "1",
+					Type: []gen.ElementDataType{{Code: "string"}}},
+			},
+		},
+	}
+}
+
 func intPtr(i int) *int {
 	return &i
 }
@@ -246,6 +296,9 @@ func TestValidation_MaxLength(t *testing.T) {
 	if err := g.WriteResource(spec); err != nil {
 		t.Fatalf("WriteResource() error = %v", err)
 	}
+	if err := g.WriteRuntime(); err != nil {
+		t.Fatalf("WriteRuntime() error = %v", err)
+	}
 
 	fileName := "max_length_test_resource.go"
 	filePath := filepath.Join(outputDir, fileName)
@@ -310,6 +363,9 @@ func TestValidation_Pattern(t *testing.T) {
 	if err := g.WriteResource(spec); err != nil {
 		t.Fatalf("WriteResource() error = %v", err)
 	}
+	if err := g.WriteRuntime(); err != nil {
+		t.Fatalf("WriteRuntime() error = %v", err)
+	}
 
 	fileName := "pattern_test_resource.go"
 	filePath := filepath.Join(outputDir, fileName)
@@ -360,6 +416,72 @@ func TestValidation_Pattern(t *testing.T) {
 	}
 }
 
+func TestValidation_PatternValue(t *testing.T) {
+	spec := createPatternValueTestSpec()
+
+	outputDir, cleanup, err := createTempOutputDir()
+	if err != nil {
+		t.Fatalf("createTempOutputDir() error = %v", err)
+	}
+	defer cleanup()
+
+	if err := createGoMod(outputDir); err != nil {
+		t.Fatalf("createGoMod() error = %v", err)
+	}
+
+	g := gen.NewGenerator("", outputDir)
+	g.Definitions[spec.Name] = spec
+
+	if err := g.WriteResource(spec); err != nil {
+		t.Fatalf("WriteResource() error = %v", err)
+	}
+	if err := g.WriteRuntime(); err != nil {
+		t.Fatalf("WriteRuntime() error = %v", err)
+	}
+
+	fileName := "pattern_value_test_resource.go"
+	testCases := []ValidationTestCase{
+		{
+			Name:    "matches pattern",
+			Data:    `&PatternValueTestResource{Code: &PatternValueTestResourceCode{System: stringPtr("http://loinc.org"), Code: stringPtr("85354-9")}}`,
+			WantErr: false,
+		},
+		{
+			Name:    "does not match pattern",
+			Data:    `&PatternValueTestResource{Code: &PatternValueTestResourceCode{System: stringPtr("http://snomed.info/sct")}}`,
+			WantErr: true,
+			ErrMsg:  "field 'Code' does not match the pattern",
+		},
+		{
+			Name:    "equals fixed value",
+			Data:    `&PatternValueTestResource{Unit: &PatternValueTestResourceUnit{System: stringPtr("http://unitsofmeasure.org"), Code: stringPtr("mm[Hg]")}}`,
+			WantErr: false,
+		},
+		{
+			Name:    "extends fixed value",
+			Data:    `&PatternValueTestResource{Unit: &PatternValueTestResourceUnit{System: stringPtr("http://unitsofmeasure.org")}}`,
+			WantErr: true,
+			ErrMsg:  "field 'Unit' must be",
+		},
+	}
+
+	if err := createValidationTestFile(outputDir, "PatternValueTestResource", fileName, testCases); err != nil {
+		t.Fatalf("createValidationTestFile() error = %v", err)
+	}
+
+	if err := addHelperFunctions(outputDir); err != nil {
+		t.Fatalf("addHelperFunctions() error = %v", err)
+	}
+
+	if err := compileGeneratedCode(outputDir); err != nil {
+		t.Fatalf("compileGeneratedCode() error = %v", err)
+	}
+
+	if err := runValidationTests(outputDir); err != nil {
+		t.Errorf("runValidationTests() error = %v", err)
+	}
+}
+
 func TestValidation_NestedStructures(t *testing.T) {
 	spec, err := loadTestSpec("nested_structures")
 	if err != nil {
@@ -382,6 +504,9 @@ func TestValidation_NestedStructures(t *testing.T) {
 	if err := g.WriteResource(spec); err != nil {
 		t.Fatalf("WriteResource() error = %v", err)
 	}
+	if err := g.WriteRuntime(); err != nil {
+		t.Fatalf("WriteRuntime() error = %v", err)
+	}
 
 	fileName := "nested_structures_test_resource.go"
 	filePath := filepath.Join(outputDir, fileName)
@@ -447,6 +572,9 @@ func TestValidation_RealResources(t *testing.T) {
 			if err := g.WriteResource(spec); err != nil {
 				t.Fatalf("WriteResource() error = %v", err)
 			}
+			if err := g.WriteRuntime(); err != nil {
+				t.Fatalf("WriteRuntime() error = %v", err)
+			}
 
 			structName := spec.Name
 			fileName := snakeCase(structName) + ".go"
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	r5 "github.com/gruzdev-dev/fhir/r5"
+)
+
+// xmlTokens returns the tokens of an XML document for comparison, leaving
+// out the declaration, comments and the whitespace between elements.
+func xmlTokens(t *testing.T, data []byte) []xml.Token {
+	t.Helper()
+
+	var tokens []xml.Token
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return tokens
+		}
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		switch tok := tok.(type) {
+		case xml.ProcInst, xml.Comment, xml.Directive:
+			continue
+		case xml.CharData:
+			if len(bytes.TrimSpace(tok)) == 0 {
+				continue
+			}
+		}
+		tokens = append(tokens, xml.CopyToken(tok))
+	}
+}
+
+func assertXMLEqual(t *testing.T, want, got []byte) {
+	t.Helper()
+
+	if !reflect.DeepEqual(xmlTokens(t, want), xmlTokens(t, got)) {
+		t.Errorf("XML round-trip mismatch\nwant: %s\ngot:  %s", want, got)
+	}
+}
+
+func TestXML_Examples(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+		json string
+	}{
+		{"Patient example", "patient_example.xml", "patient_example.json"},
+		{"Observation with contained resources", "observation_contained.xml", "observation_contained.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := loadGolden(t, tt.xml)
+
+			res, err := r5.UnmarshalResourceXML(data)
+			if err != nil {
+				t.Fatalf("UnmarshalResourceXML() error = %v", err)
+			}
+			if err := res.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+
+			asJSON, err := json.Marshal(res)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			assertJSONEqual(t, loadGolden(t, tt.json), asJSON)
+
+			out, err := r5.MarshalResourceXML(res)
+			if err != nil {
+				t.Fatalf("MarshalResourceXML() error = %v", err)
+			}
+			assertXMLEqual(t, data, out)
+		})
+	}
+}
+
+func TestXML_GoldenRoundTrip(t *testing.T) {
+	files := []string{
+		"bundle_polymorphic.json",
+		"observation_choice.json",
+		"observation_decimal.json",
+		"observation_extensions.json",
+		"observation_temporal.json",
+		"patient_choice_extension.json",
+		"patient_extensions.json",
+		"patient_primitive_extensions.json",
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			data := loadGolden(t, file)
+			res, err := r5.UnmarshalResource(data)
+			if err != nil {
+				t.Fatalf("UnmarshalResource() error = %v", err)
+			}
+
+			out, err := r5.MarshalResourceXML(res)
+			if err != nil {
+				t.Fatalf("MarshalResourceXML() error = %v", err)
+			}
+			back, err := r5.UnmarshalResourceXML(out)
+			if err != nil {
+				t.Fatalf("UnmarshalResourceXML() error = %v\n%s", err, out)
+			}
+
+			asJSON, err := json.Marshal(back)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			assertJSONEqual(t, data, asJSON)
+		})
+	}
+}
+
+func TestXML_Patient(t *testing.T) {
+	data := loadGolden(t, "patient_example.xml")
+
+	var patient r5.Patient
+	if err := xml.Unmarshal(data, &patient); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if patient.ResourceType != "Patient" || patient.BirthDateElement == nil || len(patient.BirthDateElement.Extension) != 1 {
+		t.Fatalf("xml.Unmarshal() = %+v, want the birthDate extension", patient)
+	}
+	if deceased, ok := patient.Deceased.(r5.PatientDeceasedBoolean); !ok || bool(deceased) {
+		t.Errorf("Deceased = %#v, want deceasedBoolean false", patient.Deceased)
+	}
+
+	var observation r5.Observation
+	if err := xml.Unmarshal(data, &observation); err == nil {
+		t.Error("xml.Unmarshal() of a Patient into an Observation succeeded, want error")
+	}
+}
+
+func TestXML_UnmarshalResourceErrors(t *testing.T) {
+	tests := map[string]string{
+		"namespace":     `<Patient><id value="1"/></Patient>`,
+		"unknown type":  `<Unknown xmlns="http://hl7.org/fhir"/>`,
+		"empty":         ``,
+		"invalid value": `<Patient xmlns="http://hl7.org/fhir"><active value="maybe"/></Patient>`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if res, err := r5.UnmarshalResourceXML([]byte(data)); err == nil {
+				t.Errorf("UnmarshalResourceXML() = %+v, want error", res)
+			}
+		})
+	}
+}